@@ -378,3 +378,9 @@ func GetExperimentIDsByGroupOApi(ctx context.Context, c *app.RequestContext) {
 
 	c.JSON(consts.StatusOK, resp)
 }
+
+// CompareExperimentsOApi .
+// @router /v1/loop/evaluation/experiments/compare [POST]
+func CompareExperimentsOApi(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localEvalOpenAPIClient.CompareExperimentsOApi)
+}
@@ -181,3 +181,57 @@ func UpdateEvaluationSetItemVersion(ctx context.Context, c *app.RequestContext)
 func ListEvaluationSetTemplates(ctx context.Context, c *app.RequestContext) {
 	invokeAndRender(ctx, c, localEvalSetSvc.ListEvaluationSetTemplates)
 }
+
+// DiffEvaluationSetVersions .
+// @router /api/evaluation/v1/evaluation_sets/:evaluation_set_id/versions/diff [POST]
+func DiffEvaluationSetVersions(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localEvalSetSvc.DiffEvaluationSetVersions)
+}
+
+// MergeEvaluationSetVersion .
+// @router /api/evaluation/v1/evaluation_sets/:evaluation_set_id/versions/:version_id/merge [POST]
+func MergeEvaluationSetVersion(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localEvalSetSvc.MergeEvaluationSetVersion)
+}
+
+// StartEvaluationSetDedupJob .
+// @router /api/evaluation/v1/evaluation_sets/:evaluation_set_id/dedup_jobs [POST]
+func StartEvaluationSetDedupJob(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localEvalSetSvc.StartEvaluationSetDedupJob)
+}
+
+// GetEvaluationSetDedupJob .
+// @router /api/evaluation/v1/evaluation_sets/:evaluation_set_id/dedup_jobs/:job_id [GET]
+func GetEvaluationSetDedupJob(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localEvalSetSvc.GetEvaluationSetDedupJob)
+}
+
+// ArchiveEvaluationSetDuplicates .
+// @router /api/evaluation/v1/evaluation_sets/:evaluation_set_id/dedup_jobs/:job_id/archive [POST]
+func ArchiveEvaluationSetDuplicates(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localEvalSetSvc.ArchiveEvaluationSetDuplicates)
+}
+
+// GenerateEvaluationSetItems .
+// @router /api/evaluation/v1/evaluation_sets/:evaluation_set_id/generated_items [POST]
+func GenerateEvaluationSetItems(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localEvalSetSvc.GenerateEvaluationSetItems)
+}
+
+// ListGeneratedEvaluationSetItems .
+// @router /api/evaluation/v1/evaluation_sets/:evaluation_set_id/generated_items/list [POST]
+func ListGeneratedEvaluationSetItems(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localEvalSetSvc.ListGeneratedEvaluationSetItems)
+}
+
+// ReviewGeneratedEvaluationSetItems .
+// @router /api/evaluation/v1/evaluation_sets/:evaluation_set_id/generated_items/review [POST]
+func ReviewGeneratedEvaluationSetItems(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localEvalSetSvc.ReviewGeneratedEvaluationSetItems)
+}
+
+// GetEvaluationSetItemLineage .
+// @router /api/evaluation/v1/evaluation_sets/:evaluation_set_id/items/:item_id/lineage [GET]
+func GetEvaluationSetItemLineage(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localEvalSetSvc.GetEvaluationSetItemLineage)
+}
@@ -244,3 +244,21 @@ func AsyncDebugEvaluator(ctx context.Context, c *app.RequestContext) {
 
 	c.JSON(consts.StatusOK, resp)
 }
+
+// RunEvaluatorCalibration .
+// @router /api/evaluation/v1/evaluators_versions/:evaluator_version_id/calibrations [POST]
+func RunEvaluatorCalibration(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localEvaluatorSvc.RunEvaluatorCalibration)
+}
+
+// GetEvaluatorCalibrationRecord .
+// @router /api/evaluation/v1/evaluator_calibrations/:record_id [GET]
+func GetEvaluatorCalibrationRecord(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localEvaluatorSvc.GetEvaluatorCalibrationRecord)
+}
+
+// ListEvaluatorCalibrationRecords .
+// @router /api/evaluation/v1/evaluator_calibrations/list [POST]
+func ListEvaluatorCalibrationRecords(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localEvaluatorSvc.ListEvaluatorCalibrationRecords)
+}
@@ -354,3 +354,51 @@ func GetExperimentIDsByGroup(ctx context.Context, c *app.RequestContext) {
 func UpdateExptRunConf(ctx context.Context, c *app.RequestContext) {
 	invokeAndRender(ctx, c, localExptSvc.UpdateExptRunConf)
 }
+
+// SubmitExptPairwiseRank .
+// @router /api/evaluation/v1/experiments/pairwise_rank [POST]
+func SubmitExptPairwiseRank(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localExptSvc.SubmitExptPairwiseRank)
+}
+
+// GetExptPairwiseRank .
+// @router /api/evaluation/v1/experiments/pairwise_rank/:record_id [POST]
+func GetExptPairwiseRank(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localExptSvc.GetExptPairwiseRank)
+}
+
+// GetExptRegressionReport .
+// @router /api/evaluation/v1/experiments/regression_report [POST]
+func GetExptRegressionReport(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localExptSvc.GetExptRegressionReport)
+}
+
+// ExportExptRegressionReport .
+// @router /api/evaluation/v1/experiments/regression_report/export [POST]
+func ExportExptRegressionReport(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localExptSvc.ExportExptRegressionReport)
+}
+
+// InvalidateExptResultCache .
+// @router /api/evaluation/v1/experiments/result_cache/invalidate [POST]
+func InvalidateExptResultCache(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localExptSvc.InvalidateExptResultCache)
+}
+
+// GetExptSampledAggrResult .
+// @router /api/evaluation/v1/experiments/:expt_id/sampled_aggr_result [POST]
+func GetExptSampledAggrResult(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localExptSvc.GetExptSampledAggrResult_)
+}
+
+// GetExptGroupedAggrResult .
+// @router /api/evaluation/v1/experiments/grouped_aggr_result [POST]
+func GetExptGroupedAggrResult(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localExptSvc.GetExptGroupedAggrResult_)
+}
+
+// CompareExperiments .
+// @router /api/evaluation/v1/experiments/compare [POST]
+func CompareExperiments(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localExptSvc.CompareExperiments)
+}
@@ -128,8 +128,20 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 				_evaluation_set_id.PUT("/schema", append(_updateevaluationsetschemaMw(handler), apis.UpdateEvaluationSetSchema)...)
 				_evaluation_set_id.POST("/versions", append(_versions1Mw(handler), apis.CreateEvaluationSetVersion)...)
 				_versions1 := _evaluation_set_id.Group("/versions", _versions1Mw(handler)...)
+				_versions1.POST("/diff", append(_diffevaluationsetversionsMw(handler), apis.DiffEvaluationSetVersions)...)
 				_versions1.POST("/list", append(_listevaluationsetversionsMw(handler), apis.ListEvaluationSetVersions)...)
-				_versions1.GET("/:version_id", append(_getevaluationsetversionMw(handler), apis.GetEvaluationSetVersion)...)
+				_versions1.GET("/:version_id", append(_version_id0Mw(handler), apis.GetEvaluationSetVersion)...)
+				_version_id0 := _versions1.Group("/:version_id", _version_id0Mw(handler)...)
+				_version_id0.POST("/merge", append(_mergeevaluationsetversionMw(handler), apis.MergeEvaluationSetVersion)...)
+				_evaluation_set_id.POST("/dedup_jobs", append(_dedup_jobsMw(handler), apis.StartEvaluationSetDedupJob)...)
+				_dedup_jobs := _evaluation_set_id.Group("/dedup_jobs", _dedup_jobsMw(handler)...)
+				_dedup_jobs.GET("/:job_id", append(_job_idMw(handler), apis.GetEvaluationSetDedupJob)...)
+				_job_id := _dedup_jobs.Group("/:job_id", _job_idMw(handler)...)
+				_job_id.POST("/archive", append(_archiveevaluationsetduplicatesMw(handler), apis.ArchiveEvaluationSetDuplicates)...)
+				_evaluation_set_id.POST("/generated_items", append(_generated_itemsMw(handler), apis.GenerateEvaluationSetItems)...)
+				_generated_items := _evaluation_set_id.Group("/generated_items", _generated_itemsMw(handler)...)
+				_generated_items.POST("/list", append(_listgeneratedevaluationsetitemsMw(handler), apis.ListGeneratedEvaluationSetItems)...)
+				_generated_items.POST("/review", append(_reviewgeneratedevaluationsetitemsMw(handler), apis.ReviewGeneratedEvaluationSetItems)...)
 				{
 					_item_defs := _evaluation_set_id.Group("/item_defs", _item_defsMw(handler)...)
 					_item_defs.GET("/:item_id", append(_getevaluationsetitemdefMw(handler), apis.GetEvaluationSetItemDef)...)
@@ -144,6 +156,7 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 					_items1.POST("/clear", append(_clearevaluationsetdraftitemMw(handler), apis.ClearEvaluationSetDraftItem)...)
 					_items1.PUT("/:item_id", append(_item_idMw(handler), apis.UpdateEvaluationSetItem)...)
 					_item_id := _items1.Group("/:item_id", _item_idMw(handler)...)
+					_item_id.GET("/lineage", append(_getevaluationsetitemlineageMw(handler), apis.GetEvaluationSetItemLineage)...)
 					{
 						_versions2 := _item_id.Group("/versions", _versions2Mw(handler)...)
 						_versions2.GET("/:item_version_id", append(_getevaluationsetitemversionMw(handler), apis.GetEvaluationSetItemVersion)...)
@@ -217,6 +230,11 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 					_evaluation_set_versions := _v11.Group("/evaluation_set_versions", _evaluation_set_versionsMw(handler)...)
 					_evaluation_set_versions.POST("/batch_get", append(_batchgetevaluationsetversionsMw(handler), apis.BatchGetEvaluationSetVersions)...)
 				}
+				{
+					_evaluator_calibrations := _v11.Group("/evaluator_calibrations", _evaluator_calibrationsMw(handler)...)
+					_evaluator_calibrations.POST("/list", append(_listevaluatorcalibrationrecordsMw(handler), apis.ListEvaluatorCalibrationRecords)...)
+					_evaluator_calibrations.GET("/:record_id", append(_getevaluatorcalibrationrecordMw(handler), apis.GetEvaluatorCalibrationRecord)...)
+				}
 				{
 					_evaluator_records := _v11.Group("/evaluator_records", _evaluator_recordsMw(handler)...)
 					_evaluator_records.POST("/batch_get", append(_batchgetevaluatorrecordsMw(handler), apis.BatchGetEvaluatorRecords)...)
@@ -240,6 +258,7 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 					_evaluators_versions.GET("/:evaluator_version_id", append(_evaluator_version_idMw(handler), apis.GetEvaluatorVersion)...)
 					_evaluator_version_id := _evaluators_versions.Group("/:evaluator_version_id", _evaluator_version_idMw(handler)...)
 					_evaluator_version_id.POST("/async_run", append(_asyncrunevaluatorMw(handler), apis.AsyncRunEvaluator)...)
+					_evaluator_version_id.POST("/calibrations", append(_runevaluatorcalibrationMw(handler), apis.RunEvaluatorCalibration)...)
 					_evaluator_version_id.POST("/run", append(_runevaluatorMw(handler), apis.RunEvaluator)...)
 				}
 				{
@@ -247,6 +266,7 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 					_experiments.DELETE("/batch_delete", append(_batchdeleteexperimentsMw(handler), apis.BatchDeleteExperiments)...)
 					_experiments.POST("/batch_get", append(_batchgetexperimentsMw(handler), apis.BatchGetExperiments)...)
 					_experiments.POST("/check_name", append(_checkexperimentnameMw(handler), apis.CheckExperimentName)...)
+					_experiments.POST("/compare", append(_compareexperimentsMw(handler), apis.CompareExperiments)...)
 					_experiments.DELETE("/:expt_id", append(_expt_idMw(handler), apis.DeleteExperiment)...)
 					_expt_id := _experiments.Group("/:expt_id", _expt_idMw(handler)...)
 					_expt_id.POST("/aggr_results", append(_calculateexperimentaggrresultMw(handler), apis.CalculateExperimentAggrResult)...)
@@ -256,6 +276,7 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 					_expt_id.POST("/insight_analysis", append(_insightanalysisexperimentMw(handler), apis.InsightAnalysisExperiment)...)
 					_expt_id.POST("/kill", append(_killexperimentMw(handler), apis.KillExperiment)...)
 					_expt_id.POST("/retry", append(_retryexperimentMw(handler), apis.RetryExperiment)...)
+					_expt_id.POST("/sampled_aggr_result", append(_getexptsampledaggrresultMw(handler), apis.GetExptSampledAggrResult)...)
 					{
 						_annotate_record := _expt_id.Group("/annotate_record", _annotate_recordMw(handler)...)
 						_annotate_record.POST("/create", append(_createannotaterecordMw(handler), apis.CreateAnnotateRecord)...)
@@ -285,7 +306,18 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 					_experiments.PATCH("/:expt_id", append(_expt_id0Mw(handler), apis.UpdateExperiment)...)
 					_expt_id0 := _experiments.Group("/:expt_id", _expt_id0Mw(handler)...)
 					_expt_id0.PATCH("/run_conf", append(_updateexptrunconfMw(handler), apis.UpdateExptRunConf)...)
+					_experiments.POST("/grouped_aggr_result", append(_getexptgroupedaggrresultMw(handler), apis.GetExptGroupedAggrResult)...)
 					_experiments.POST("/list", append(_listexperimentsMw(handler), apis.ListExperiments)...)
+					_experiments.POST("/pairwise_rank", append(_pairwise_rankMw(handler), apis.SubmitExptPairwiseRank)...)
+					_pairwise_rank := _experiments.Group("/pairwise_rank", _pairwise_rankMw(handler)...)
+					_pairwise_rank.POST("/:record_id", append(_getexptpairwiserankMw(handler), apis.GetExptPairwiseRank)...)
+					_experiments.POST("/regression_report", append(_regression_reportMw(handler), apis.GetExptRegressionReport)...)
+					_regression_report := _experiments.Group("/regression_report", _regression_reportMw(handler)...)
+					_regression_report.POST("/export", append(_exportexptregressionreportMw(handler), apis.ExportExptRegressionReport)...)
+					{
+						_result_cache := _experiments.Group("/result_cache", _result_cacheMw(handler)...)
+						_result_cache.POST("/invalidate", append(_invalidateexptresultcacheMw(handler), apis.InvalidateExptResultCache)...)
+					}
 					_experiments.POST("/submit", append(_submitexperimentMw(handler), apis.SubmitExperiment)...)
 					{
 						_aggr_results := _experiments.Group("/aggr_results", _aggr_resultsMw(handler)...)
@@ -573,6 +605,7 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 				_experiment_templates0.POST("/update_meta", append(_updateexpttemplatemetaoapiMw(handler), apis.UpdateExptTemplateMetaOApi)...)
 				_evaluation0.POST("/experiments", append(_experiments0Mw(handler), apis.SubmitExperimentOApi)...)
 				_experiments0 := _evaluation0.Group("/experiments", _experiments0Mw(handler)...)
+				_experiments0.POST("/compare", append(_compareexperimentsoapiMw(handler), apis.CompareExperimentsOApi)...)
 				_experiments0.GET("/:experiment_id", append(_experiment_idMw(handler), apis.GetExperimentsOApi)...)
 				_experiment_id := _experiments0.Group("/:experiment_id", _experiment_idMw(handler)...)
 				_experiment_id.POST("/aggr_results", append(_getexperimentaggrresultoapiMw(handler), apis.GetExperimentAggrResultOApi)...)
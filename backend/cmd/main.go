@@ -41,6 +41,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/infra/redis"
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/foundation/lofile"
 	"github.com/coze-dev/coze-loop/backend/loop_gen/coze/loop/observability/lotrace"
+	evalruntime "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/runtime"
 	"github.com/coze-dev/coze-loop/backend/pkg/conf"
 	"github.com/coze-dev/coze-loop/backend/pkg/conf/viper"
 	"github.com/coze-dev/coze-loop/backend/pkg/file"
@@ -48,6 +49,12 @@ import (
 )
 
 func main() {
+	// 本地沙箱子进程通过 /proc/self/exe 重新启动当前二进制, 须先于任何初始化进入沙箱初始化流程
+	evalruntime.RunSandboxInitIfRequested()
+	if err := evalruntime.CheckCodeRuntimeMode(); err != nil {
+		panic(err)
+	}
+
 	ctx := context.Background()
 	c, err := newComponent(ctx)
 	if err != nil {
@@ -42,6 +42,7 @@ type Client interface {
 	GetExperimentIDsByGroupOApi(ctx context.Context, req *openapi.GetExperimentIDsByGroupOApiRequest, callOptions ...callopt.Option) (r *openapi.GetExperimentIDsByGroupOApiResponse, err error)
 	ListExperimentResultOApi(ctx context.Context, req *openapi.ListExperimentResultOApiRequest, callOptions ...callopt.Option) (r *openapi.ListExperimentResultOApiResponse, err error)
 	GetExperimentAggrResultOApi(ctx context.Context, req *openapi.GetExperimentAggrResultOApiRequest, callOptions ...callopt.Option) (r *openapi.GetExperimentAggrResultOApiResponse, err error)
+	CompareExperimentsOApi(ctx context.Context, req *openapi.CompareExperimentsOApiRequest, callOptions ...callopt.Option) (r *openapi.CompareExperimentsOApiResponse, err error)
 	RetryExperimentOApi(ctx context.Context, req *openapi.RetryExperimentOApiRequest, callOptions ...callopt.Option) (r *openapi.RetryExperimentOApiResponse, err error)
 	KillExperimentOApi(ctx context.Context, req *openapi.KillExperimentOApiRequest, callOptions ...callopt.Option) (r *openapi.KillExperimentOApiResponse, err error)
 	ExportExperimentResultOApi(ctx context.Context, req *openapi.ExportExperimentResultOApiRequest, callOptions ...callopt.Option) (r *openapi.ExportExperimentResultOApiResponse, err error)
@@ -254,6 +255,11 @@ func (p *kEvalOpenAPIServiceClient) GetExperimentAggrResultOApi(ctx context.Cont
 	return p.kClient.GetExperimentAggrResultOApi(ctx, req)
 }
 
+func (p *kEvalOpenAPIServiceClient) CompareExperimentsOApi(ctx context.Context, req *openapi.CompareExperimentsOApiRequest, callOptions ...callopt.Option) (r *openapi.CompareExperimentsOApiResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CompareExperimentsOApi(ctx, req)
+}
+
 func (p *kEvalOpenAPIServiceClient) RetryExperimentOApi(ctx context.Context, req *openapi.RetryExperimentOApiRequest, callOptions ...callopt.Option) (r *openapi.RetryExperimentOApiResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.RetryExperimentOApi(ctx, req)
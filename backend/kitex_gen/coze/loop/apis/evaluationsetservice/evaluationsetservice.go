@@ -98,6 +98,20 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"DiffEvaluationSetVersions": kitex.NewMethodInfo(
+		diffEvaluationSetVersionsHandler,
+		newEvaluationSetServiceDiffEvaluationSetVersionsArgs,
+		newEvaluationSetServiceDiffEvaluationSetVersionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"MergeEvaluationSetVersion": kitex.NewMethodInfo(
+		mergeEvaluationSetVersionHandler,
+		newEvaluationSetServiceMergeEvaluationSetVersionArgs,
+		newEvaluationSetServiceMergeEvaluationSetVersionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"UpdateEvaluationSetSchema": kitex.NewMethodInfo(
 		updateEvaluationSetSchemaHandler,
 		newEvaluationSetServiceUpdateEvaluationSetSchemaArgs,
@@ -126,6 +140,55 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"StartEvaluationSetDedupJob": kitex.NewMethodInfo(
+		startEvaluationSetDedupJobHandler,
+		newEvaluationSetServiceStartEvaluationSetDedupJobArgs,
+		newEvaluationSetServiceStartEvaluationSetDedupJobResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetEvaluationSetDedupJob": kitex.NewMethodInfo(
+		getEvaluationSetDedupJobHandler,
+		newEvaluationSetServiceGetEvaluationSetDedupJobArgs,
+		newEvaluationSetServiceGetEvaluationSetDedupJobResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ArchiveEvaluationSetDuplicates": kitex.NewMethodInfo(
+		archiveEvaluationSetDuplicatesHandler,
+		newEvaluationSetServiceArchiveEvaluationSetDuplicatesArgs,
+		newEvaluationSetServiceArchiveEvaluationSetDuplicatesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GenerateEvaluationSetItems": kitex.NewMethodInfo(
+		generateEvaluationSetItemsHandler,
+		newEvaluationSetServiceGenerateEvaluationSetItemsArgs,
+		newEvaluationSetServiceGenerateEvaluationSetItemsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListGeneratedEvaluationSetItems": kitex.NewMethodInfo(
+		listGeneratedEvaluationSetItemsHandler,
+		newEvaluationSetServiceListGeneratedEvaluationSetItemsArgs,
+		newEvaluationSetServiceListGeneratedEvaluationSetItemsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ReviewGeneratedEvaluationSetItems": kitex.NewMethodInfo(
+		reviewGeneratedEvaluationSetItemsHandler,
+		newEvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs,
+		newEvaluationSetServiceReviewGeneratedEvaluationSetItemsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetEvaluationSetItemLineage": kitex.NewMethodInfo(
+		getEvaluationSetItemLineageHandler,
+		newEvaluationSetServiceGetEvaluationSetItemLineageArgs,
+		newEvaluationSetServiceGetEvaluationSetItemLineageResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"ListEvaluationSetItems": kitex.NewMethodInfo(
 		listEvaluationSetItemsHandler,
 		newEvaluationSetServiceListEvaluationSetItemsArgs,
@@ -464,6 +527,44 @@ func newEvaluationSetServiceBatchGetEvaluationSetVersionsResult() interface{} {
 	return eval_set.NewEvaluationSetServiceBatchGetEvaluationSetVersionsResult()
 }
 
+func diffEvaluationSetVersionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*eval_set.EvaluationSetServiceDiffEvaluationSetVersionsArgs)
+	realResult := result.(*eval_set.EvaluationSetServiceDiffEvaluationSetVersionsResult)
+	success, err := handler.(eval_set.EvaluationSetService).DiffEvaluationSetVersions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluationSetServiceDiffEvaluationSetVersionsArgs() interface{} {
+	return eval_set.NewEvaluationSetServiceDiffEvaluationSetVersionsArgs()
+}
+
+func newEvaluationSetServiceDiffEvaluationSetVersionsResult() interface{} {
+	return eval_set.NewEvaluationSetServiceDiffEvaluationSetVersionsResult()
+}
+
+func mergeEvaluationSetVersionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*eval_set.EvaluationSetServiceMergeEvaluationSetVersionArgs)
+	realResult := result.(*eval_set.EvaluationSetServiceMergeEvaluationSetVersionResult)
+	success, err := handler.(eval_set.EvaluationSetService).MergeEvaluationSetVersion(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluationSetServiceMergeEvaluationSetVersionArgs() interface{} {
+	return eval_set.NewEvaluationSetServiceMergeEvaluationSetVersionArgs()
+}
+
+func newEvaluationSetServiceMergeEvaluationSetVersionResult() interface{} {
+	return eval_set.NewEvaluationSetServiceMergeEvaluationSetVersionResult()
+}
+
 func updateEvaluationSetSchemaHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*eval_set.EvaluationSetServiceUpdateEvaluationSetSchemaArgs)
 	realResult := result.(*eval_set.EvaluationSetServiceUpdateEvaluationSetSchemaResult)
@@ -540,6 +641,139 @@ func newEvaluationSetServiceBatchDeleteEvaluationSetItemsResult() interface{} {
 	return eval_set.NewEvaluationSetServiceBatchDeleteEvaluationSetItemsResult()
 }
 
+func startEvaluationSetDedupJobHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*eval_set.EvaluationSetServiceStartEvaluationSetDedupJobArgs)
+	realResult := result.(*eval_set.EvaluationSetServiceStartEvaluationSetDedupJobResult)
+	success, err := handler.(eval_set.EvaluationSetService).StartEvaluationSetDedupJob(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluationSetServiceStartEvaluationSetDedupJobArgs() interface{} {
+	return eval_set.NewEvaluationSetServiceStartEvaluationSetDedupJobArgs()
+}
+
+func newEvaluationSetServiceStartEvaluationSetDedupJobResult() interface{} {
+	return eval_set.NewEvaluationSetServiceStartEvaluationSetDedupJobResult()
+}
+
+func getEvaluationSetDedupJobHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*eval_set.EvaluationSetServiceGetEvaluationSetDedupJobArgs)
+	realResult := result.(*eval_set.EvaluationSetServiceGetEvaluationSetDedupJobResult)
+	success, err := handler.(eval_set.EvaluationSetService).GetEvaluationSetDedupJob(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluationSetServiceGetEvaluationSetDedupJobArgs() interface{} {
+	return eval_set.NewEvaluationSetServiceGetEvaluationSetDedupJobArgs()
+}
+
+func newEvaluationSetServiceGetEvaluationSetDedupJobResult() interface{} {
+	return eval_set.NewEvaluationSetServiceGetEvaluationSetDedupJobResult()
+}
+
+func archiveEvaluationSetDuplicatesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*eval_set.EvaluationSetServiceArchiveEvaluationSetDuplicatesArgs)
+	realResult := result.(*eval_set.EvaluationSetServiceArchiveEvaluationSetDuplicatesResult)
+	success, err := handler.(eval_set.EvaluationSetService).ArchiveEvaluationSetDuplicates(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluationSetServiceArchiveEvaluationSetDuplicatesArgs() interface{} {
+	return eval_set.NewEvaluationSetServiceArchiveEvaluationSetDuplicatesArgs()
+}
+
+func newEvaluationSetServiceArchiveEvaluationSetDuplicatesResult() interface{} {
+	return eval_set.NewEvaluationSetServiceArchiveEvaluationSetDuplicatesResult()
+}
+
+func generateEvaluationSetItemsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*eval_set.EvaluationSetServiceGenerateEvaluationSetItemsArgs)
+	realResult := result.(*eval_set.EvaluationSetServiceGenerateEvaluationSetItemsResult)
+	success, err := handler.(eval_set.EvaluationSetService).GenerateEvaluationSetItems(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluationSetServiceGenerateEvaluationSetItemsArgs() interface{} {
+	return eval_set.NewEvaluationSetServiceGenerateEvaluationSetItemsArgs()
+}
+
+func newEvaluationSetServiceGenerateEvaluationSetItemsResult() interface{} {
+	return eval_set.NewEvaluationSetServiceGenerateEvaluationSetItemsResult()
+}
+
+func listGeneratedEvaluationSetItemsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*eval_set.EvaluationSetServiceListGeneratedEvaluationSetItemsArgs)
+	realResult := result.(*eval_set.EvaluationSetServiceListGeneratedEvaluationSetItemsResult)
+	success, err := handler.(eval_set.EvaluationSetService).ListGeneratedEvaluationSetItems(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluationSetServiceListGeneratedEvaluationSetItemsArgs() interface{} {
+	return eval_set.NewEvaluationSetServiceListGeneratedEvaluationSetItemsArgs()
+}
+
+func newEvaluationSetServiceListGeneratedEvaluationSetItemsResult() interface{} {
+	return eval_set.NewEvaluationSetServiceListGeneratedEvaluationSetItemsResult()
+}
+
+func reviewGeneratedEvaluationSetItemsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*eval_set.EvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs)
+	realResult := result.(*eval_set.EvaluationSetServiceReviewGeneratedEvaluationSetItemsResult)
+	success, err := handler.(eval_set.EvaluationSetService).ReviewGeneratedEvaluationSetItems(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs() interface{} {
+	return eval_set.NewEvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs()
+}
+
+func newEvaluationSetServiceReviewGeneratedEvaluationSetItemsResult() interface{} {
+	return eval_set.NewEvaluationSetServiceReviewGeneratedEvaluationSetItemsResult()
+}
+
+func getEvaluationSetItemLineageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*eval_set.EvaluationSetServiceGetEvaluationSetItemLineageArgs)
+	realResult := result.(*eval_set.EvaluationSetServiceGetEvaluationSetItemLineageResult)
+	success, err := handler.(eval_set.EvaluationSetService).GetEvaluationSetItemLineage(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluationSetServiceGetEvaluationSetItemLineageArgs() interface{} {
+	return eval_set.NewEvaluationSetServiceGetEvaluationSetItemLineageArgs()
+}
+
+func newEvaluationSetServiceGetEvaluationSetItemLineageResult() interface{} {
+	return eval_set.NewEvaluationSetServiceGetEvaluationSetItemLineageResult()
+}
+
 func listEvaluationSetItemsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*eval_set.EvaluationSetServiceListEvaluationSetItemsArgs)
 	realResult := result.(*eval_set.EvaluationSetServiceListEvaluationSetItemsResult)
@@ -881,6 +1115,26 @@ func (p *kClient) BatchGetEvaluationSetVersions(ctx context.Context, req *eval_s
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) DiffEvaluationSetVersions(ctx context.Context, req *eval_set.DiffEvaluationSetVersionsRequest) (r *eval_set.DiffEvaluationSetVersionsResponse, err error) {
+	var _args eval_set.EvaluationSetServiceDiffEvaluationSetVersionsArgs
+	_args.Req = req
+	var _result eval_set.EvaluationSetServiceDiffEvaluationSetVersionsResult
+	if err = p.c.Call(ctx, "DiffEvaluationSetVersions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MergeEvaluationSetVersion(ctx context.Context, req *eval_set.MergeEvaluationSetVersionRequest) (r *eval_set.MergeEvaluationSetVersionResponse, err error) {
+	var _args eval_set.EvaluationSetServiceMergeEvaluationSetVersionArgs
+	_args.Req = req
+	var _result eval_set.EvaluationSetServiceMergeEvaluationSetVersionResult
+	if err = p.c.Call(ctx, "MergeEvaluationSetVersion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) UpdateEvaluationSetSchema(ctx context.Context, req *eval_set.UpdateEvaluationSetSchemaRequest) (r *eval_set.UpdateEvaluationSetSchemaResponse, err error) {
 	var _args eval_set.EvaluationSetServiceUpdateEvaluationSetSchemaArgs
 	_args.Req = req
@@ -921,6 +1175,76 @@ func (p *kClient) BatchDeleteEvaluationSetItems(ctx context.Context, req *eval_s
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) StartEvaluationSetDedupJob(ctx context.Context, req *eval_set.StartEvaluationSetDedupJobRequest) (r *eval_set.StartEvaluationSetDedupJobResponse, err error) {
+	var _args eval_set.EvaluationSetServiceStartEvaluationSetDedupJobArgs
+	_args.Req = req
+	var _result eval_set.EvaluationSetServiceStartEvaluationSetDedupJobResult
+	if err = p.c.Call(ctx, "StartEvaluationSetDedupJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetEvaluationSetDedupJob(ctx context.Context, req *eval_set.GetEvaluationSetDedupJobRequest) (r *eval_set.GetEvaluationSetDedupJobResponse, err error) {
+	var _args eval_set.EvaluationSetServiceGetEvaluationSetDedupJobArgs
+	_args.Req = req
+	var _result eval_set.EvaluationSetServiceGetEvaluationSetDedupJobResult
+	if err = p.c.Call(ctx, "GetEvaluationSetDedupJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ArchiveEvaluationSetDuplicates(ctx context.Context, req *eval_set.ArchiveEvaluationSetDuplicatesRequest) (r *eval_set.ArchiveEvaluationSetDuplicatesResponse, err error) {
+	var _args eval_set.EvaluationSetServiceArchiveEvaluationSetDuplicatesArgs
+	_args.Req = req
+	var _result eval_set.EvaluationSetServiceArchiveEvaluationSetDuplicatesResult
+	if err = p.c.Call(ctx, "ArchiveEvaluationSetDuplicates", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GenerateEvaluationSetItems(ctx context.Context, req *eval_set.GenerateEvaluationSetItemsRequest) (r *eval_set.GenerateEvaluationSetItemsResponse, err error) {
+	var _args eval_set.EvaluationSetServiceGenerateEvaluationSetItemsArgs
+	_args.Req = req
+	var _result eval_set.EvaluationSetServiceGenerateEvaluationSetItemsResult
+	if err = p.c.Call(ctx, "GenerateEvaluationSetItems", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListGeneratedEvaluationSetItems(ctx context.Context, req *eval_set.ListGeneratedEvaluationSetItemsRequest) (r *eval_set.ListGeneratedEvaluationSetItemsResponse, err error) {
+	var _args eval_set.EvaluationSetServiceListGeneratedEvaluationSetItemsArgs
+	_args.Req = req
+	var _result eval_set.EvaluationSetServiceListGeneratedEvaluationSetItemsResult
+	if err = p.c.Call(ctx, "ListGeneratedEvaluationSetItems", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReviewGeneratedEvaluationSetItems(ctx context.Context, req *eval_set.ReviewGeneratedEvaluationSetItemsRequest) (r *eval_set.ReviewGeneratedEvaluationSetItemsResponse, err error) {
+	var _args eval_set.EvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs
+	_args.Req = req
+	var _result eval_set.EvaluationSetServiceReviewGeneratedEvaluationSetItemsResult
+	if err = p.c.Call(ctx, "ReviewGeneratedEvaluationSetItems", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetEvaluationSetItemLineage(ctx context.Context, req *eval_set.GetEvaluationSetItemLineageRequest) (r *eval_set.GetEvaluationSetItemLineageResponse, err error) {
+	var _args eval_set.EvaluationSetServiceGetEvaluationSetItemLineageArgs
+	_args.Req = req
+	var _result eval_set.EvaluationSetServiceGetEvaluationSetItemLineageResult
+	if err = p.c.Call(ctx, "GetEvaluationSetItemLineage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) ListEvaluationSetItems(ctx context.Context, req *eval_set.ListEvaluationSetItemsRequest) (r *eval_set.ListEvaluationSetItemsResponse, err error) {
 	var _args eval_set.EvaluationSetServiceListEvaluationSetItemsArgs
 	_args.Req = req
@@ -35,6 +35,9 @@ type Client interface {
 	UpdateEvaluatorRecord(ctx context.Context, req *evaluator.UpdateEvaluatorRecordRequest, callOptions ...callopt.Option) (r *evaluator.UpdateEvaluatorRecordResponse, err error)
 	GetEvaluatorRecord(ctx context.Context, req *evaluator.GetEvaluatorRecordRequest, callOptions ...callopt.Option) (r *evaluator.GetEvaluatorRecordResponse, err error)
 	BatchGetEvaluatorRecords(ctx context.Context, req *evaluator.BatchGetEvaluatorRecordsRequest, callOptions ...callopt.Option) (r *evaluator.BatchGetEvaluatorRecordsResponse, err error)
+	RunEvaluatorCalibration(ctx context.Context, req *evaluator.RunEvaluatorCalibrationRequest, callOptions ...callopt.Option) (r *evaluator.RunEvaluatorCalibrationResponse, err error)
+	GetEvaluatorCalibrationRecord(ctx context.Context, req *evaluator.GetEvaluatorCalibrationRecordRequest, callOptions ...callopt.Option) (r *evaluator.GetEvaluatorCalibrationRecordResponse, err error)
+	ListEvaluatorCalibrationRecords(ctx context.Context, req *evaluator.ListEvaluatorCalibrationRecordsRequest, callOptions ...callopt.Option) (r *evaluator.ListEvaluatorCalibrationRecordsResponse, err error)
 	ValidateEvaluator(ctx context.Context, request *evaluator.ValidateEvaluatorRequest, callOptions ...callopt.Option) (r *evaluator.ValidateEvaluatorResponse, err error)
 	ListTemplatesV2(ctx context.Context, request *evaluator.ListTemplatesV2Request, callOptions ...callopt.Option) (r *evaluator.ListTemplatesV2Response, err error)
 	GetTemplateV2(ctx context.Context, request *evaluator.GetTemplateV2Request, callOptions ...callopt.Option) (r *evaluator.GetTemplateV2Response, err error)
@@ -195,6 +198,21 @@ func (p *kEvaluatorServiceClient) BatchGetEvaluatorRecords(ctx context.Context,
 	return p.kClient.BatchGetEvaluatorRecords(ctx, req)
 }
 
+func (p *kEvaluatorServiceClient) RunEvaluatorCalibration(ctx context.Context, req *evaluator.RunEvaluatorCalibrationRequest, callOptions ...callopt.Option) (r *evaluator.RunEvaluatorCalibrationResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RunEvaluatorCalibration(ctx, req)
+}
+
+func (p *kEvaluatorServiceClient) GetEvaluatorCalibrationRecord(ctx context.Context, req *evaluator.GetEvaluatorCalibrationRecordRequest, callOptions ...callopt.Option) (r *evaluator.GetEvaluatorCalibrationRecordResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetEvaluatorCalibrationRecord(ctx, req)
+}
+
+func (p *kEvaluatorServiceClient) ListEvaluatorCalibrationRecords(ctx context.Context, req *evaluator.ListEvaluatorCalibrationRecordsRequest, callOptions ...callopt.Option) (r *evaluator.ListEvaluatorCalibrationRecordsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListEvaluatorCalibrationRecords(ctx, req)
+}
+
 func (p *kEvaluatorServiceClient) ValidateEvaluator(ctx context.Context, request *evaluator.ValidateEvaluatorRequest, callOptions ...callopt.Option) (r *evaluator.ValidateEvaluatorResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ValidateEvaluator(ctx, request)
@@ -48,6 +48,14 @@ type Client interface {
 	FeedbackExptInsightAnalysisReport(ctx context.Context, req *expt.FeedbackExptInsightAnalysisReportRequest, callOptions ...callopt.Option) (r *expt.FeedbackExptInsightAnalysisReportResponse, err error)
 	ListExptInsightAnalysisComment(ctx context.Context, req *expt.ListExptInsightAnalysisCommentRequest, callOptions ...callopt.Option) (r *expt.ListExptInsightAnalysisCommentResponse, err error)
 	GetAnalysisRecordFeedbackVote(ctx context.Context, req *expt.GetAnalysisRecordFeedbackVoteRequest, callOptions ...callopt.Option) (r *expt.GetAnalysisRecordFeedbackVoteResponse, err error)
+	CompareExperiments(ctx context.Context, req *expt.CompareExperimentsRequest, callOptions ...callopt.Option) (r *expt.CompareExperimentsResponse, err error)
+	GetExptRegressionReport(ctx context.Context, req *expt.GetExptRegressionReportRequest, callOptions ...callopt.Option) (r *expt.GetExptRegressionReportResponse, err error)
+	ExportExptRegressionReport(ctx context.Context, req *expt.ExportExptRegressionReportRequest, callOptions ...callopt.Option) (r *expt.ExportExptRegressionReportResponse, err error)
+	GetExptGroupedAggrResult_(ctx context.Context, req *expt.GetExptGroupedAggrResultRequest, callOptions ...callopt.Option) (r *expt.GetExptGroupedAggrResultResponse, err error)
+	GetExptSampledAggrResult_(ctx context.Context, req *expt.GetExptSampledAggrResultRequest, callOptions ...callopt.Option) (r *expt.GetExptSampledAggrResultResponse, err error)
+	InvalidateExptResultCache(ctx context.Context, req *expt.InvalidateExptResultCacheRequest, callOptions ...callopt.Option) (r *expt.InvalidateExptResultCacheResponse, err error)
+	SubmitExptPairwiseRank(ctx context.Context, req *expt.SubmitExptPairwiseRankRequest, callOptions ...callopt.Option) (r *expt.SubmitExptPairwiseRankResponse, err error)
+	GetExptPairwiseRank(ctx context.Context, req *expt.GetExptPairwiseRankRequest, callOptions ...callopt.Option) (r *expt.GetExptPairwiseRankResponse, err error)
 	CreateExperimentTemplate(ctx context.Context, req *expt.CreateExperimentTemplateRequest, callOptions ...callopt.Option) (r *expt.CreateExperimentTemplateResponse, err error)
 	BatchGetExperimentTemplate(ctx context.Context, req *expt.BatchGetExperimentTemplateRequest, callOptions ...callopt.Option) (r *expt.BatchGetExperimentTemplateResponse, err error)
 	UpdateExperimentTemplateMeta(ctx context.Context, req *expt.UpdateExperimentTemplateMetaRequest, callOptions ...callopt.Option) (r *expt.UpdateExperimentTemplateMetaResponse, err error)
@@ -272,6 +280,46 @@ func (p *kExperimentServiceClient) GetAnalysisRecordFeedbackVote(ctx context.Con
 	return p.kClient.GetAnalysisRecordFeedbackVote(ctx, req)
 }
 
+func (p *kExperimentServiceClient) CompareExperiments(ctx context.Context, req *expt.CompareExperimentsRequest, callOptions ...callopt.Option) (r *expt.CompareExperimentsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CompareExperiments(ctx, req)
+}
+
+func (p *kExperimentServiceClient) GetExptRegressionReport(ctx context.Context, req *expt.GetExptRegressionReportRequest, callOptions ...callopt.Option) (r *expt.GetExptRegressionReportResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetExptRegressionReport(ctx, req)
+}
+
+func (p *kExperimentServiceClient) ExportExptRegressionReport(ctx context.Context, req *expt.ExportExptRegressionReportRequest, callOptions ...callopt.Option) (r *expt.ExportExptRegressionReportResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportExptRegressionReport(ctx, req)
+}
+
+func (p *kExperimentServiceClient) GetExptGroupedAggrResult_(ctx context.Context, req *expt.GetExptGroupedAggrResultRequest, callOptions ...callopt.Option) (r *expt.GetExptGroupedAggrResultResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetExptGroupedAggrResult_(ctx, req)
+}
+
+func (p *kExperimentServiceClient) GetExptSampledAggrResult_(ctx context.Context, req *expt.GetExptSampledAggrResultRequest, callOptions ...callopt.Option) (r *expt.GetExptSampledAggrResultResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetExptSampledAggrResult_(ctx, req)
+}
+
+func (p *kExperimentServiceClient) InvalidateExptResultCache(ctx context.Context, req *expt.InvalidateExptResultCacheRequest, callOptions ...callopt.Option) (r *expt.InvalidateExptResultCacheResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.InvalidateExptResultCache(ctx, req)
+}
+
+func (p *kExperimentServiceClient) SubmitExptPairwiseRank(ctx context.Context, req *expt.SubmitExptPairwiseRankRequest, callOptions ...callopt.Option) (r *expt.SubmitExptPairwiseRankResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SubmitExptPairwiseRank(ctx, req)
+}
+
+func (p *kExperimentServiceClient) GetExptPairwiseRank(ctx context.Context, req *expt.GetExptPairwiseRankRequest, callOptions ...callopt.Option) (r *expt.GetExptPairwiseRankResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetExptPairwiseRank(ctx, req)
+}
+
 func (p *kExperimentServiceClient) CreateExperimentTemplate(ctx context.Context, req *expt.CreateExperimentTemplateRequest, callOptions ...callopt.Option) (r *expt.CreateExperimentTemplateResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.CreateExperimentTemplate(ctx, req)
@@ -21,6 +21,54 @@ const (
 	EvaluationSetTypeDefault = "default"
 
 	EvaluationSetTypeVersionedItem = "versioned_item"
+
+	EvaluationSetChangeTypeAdded = "added"
+
+	EvaluationSetChangeTypeRemoved = "removed"
+
+	EvaluationSetChangeTypeModified = "modified"
+
+	EvaluationSetDedupMethodMinHash = "minhash"
+
+	EvaluationSetDedupMethodSimHash = "simhash"
+
+	EvaluationSetDuplicateKindExact = "exact"
+
+	EvaluationSetDuplicateKindNear = "near"
+
+	EvaluationSetDedupJobStatusUnknown = "Unknown"
+
+	EvaluationSetDedupJobStatusRunning = "Running"
+
+	EvaluationSetDedupJobStatusSuccess = "Success"
+
+	EvaluationSetDedupJobStatusFailed = "Failed"
+
+	EvaluationSetSynthesisStrategyParaphrase = "paraphrase"
+
+	EvaluationSetSynthesisStrategyAdversarial = "adversarial"
+
+	EvaluationSetSynthesisStrategyEdgeCase = "edge_case"
+
+	GeneratedItemReviewStatusPendingReview = "pending_review"
+
+	GeneratedItemReviewStatusApproved = "approved"
+
+	EvaluationSetItemSourceTypeManual = "manual"
+
+	EvaluationSetItemSourceTypeFileImport = "file_import"
+
+	EvaluationSetItemSourceTypeTraceExport = "trace_export"
+
+	EvaluationSetItemSourceTypeOpenAPI = "openapi"
+
+	EvaluationSetItemSourceTypeSynthetic = "synthetic"
+
+	EvaluationSetItemSourceTypeVersionMerge = "version_merge"
+
+	EvaluationSetItemSourceTypeExptResult_ = "expt_result"
+
+	EvaluationSetItemSourceTypeUnknown = "unknown"
 )
 
 type TagFilterRelation = string
@@ -29,6 +77,20 @@ type BizCategory = string
 
 type EvaluationSetType = string
 
+type EvaluationSetChangeType = string
+
+type EvaluationSetDedupMethod = string
+
+type EvaluationSetDuplicateKind = string
+
+type EvaluationSetDedupJobStatus = string
+
+type EvaluationSetSynthesisStrategy = string
+
+type GeneratedItemReviewStatus = string
+
+type EvaluationSetItemSourceType = string
+
 type ResourceTagRef struct {
 	TagName string `thrift:"tag_name,1,required" frugal:"1,required,string" form:"tag_name,required" json:"tag_name,required" query:"tag_name,required"`
 }
@@ -5676,6 +5738,8 @@ type EvaluationSetItem struct {
 	ItemVersion   *string `thrift:"item_version,21,optional" frugal:"21,optional,string" form:"item_version" json:"item_version,omitempty" query:"item_version"`
 	// 系统资源标签
 	Tags []*ResourceTag `thrift:"tags,24,optional" frugal:"24,optional,list<ResourceTag>" form:"tags" json:"tags,omitempty" query:"tags"`
+	// 行来源, 按来源筛选时返回
+	Provenance *EvaluationSetItemProvenance `thrift:"provenance,25,optional" frugal:"25,optional,EvaluationSetItemProvenance" form:"provenance" json:"provenance,omitempty" query:"provenance"`
 	// 系统信息
 	BaseInfo *common.BaseInfo `thrift:"base_info,100,optional" frugal:"100,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
 }
@@ -5819,6 +5883,18 @@ func (p *EvaluationSetItem) GetTags() (v []*ResourceTag) {
 	return p.Tags
 }
 
+var EvaluationSetItem_Provenance_DEFAULT *EvaluationSetItemProvenance
+
+func (p *EvaluationSetItem) GetProvenance() (v *EvaluationSetItemProvenance) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetProvenance() {
+		return EvaluationSetItem_Provenance_DEFAULT
+	}
+	return p.Provenance
+}
+
 var EvaluationSetItem_BaseInfo_DEFAULT *common.BaseInfo
 
 func (p *EvaluationSetItem) GetBaseInfo() (v *common.BaseInfo) {
@@ -5863,6 +5939,9 @@ func (p *EvaluationSetItem) SetItemVersion(val *string) {
 func (p *EvaluationSetItem) SetTags(val []*ResourceTag) {
 	p.Tags = val
 }
+func (p *EvaluationSetItem) SetProvenance(val *EvaluationSetItemProvenance) {
+	p.Provenance = val
+}
 func (p *EvaluationSetItem) SetBaseInfo(val *common.BaseInfo) {
 	p.BaseInfo = val
 }
@@ -5879,6 +5958,7 @@ var fieldIDToName_EvaluationSetItem = map[int16]string{
 	20:  "item_version_id",
 	21:  "item_version",
 	24:  "tags",
+	25:  "provenance",
 	100: "base_info",
 }
 
@@ -5926,6 +6006,10 @@ func (p *EvaluationSetItem) IsSetTags() bool {
 	return p.Tags != nil
 }
 
+func (p *EvaluationSetItem) IsSetProvenance() bool {
+	return p.Provenance != nil
+}
+
 func (p *EvaluationSetItem) IsSetBaseInfo() bool {
 	return p.BaseInfo != nil
 }
@@ -6036,6 +6120,14 @@ func (p *EvaluationSetItem) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 25:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField25(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 100:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField100(iprot); err != nil {
@@ -6218,6 +6310,14 @@ func (p *EvaluationSetItem) ReadField24(iprot thrift.TProtocol) error {
 	p.Tags = _field
 	return nil
 }
+func (p *EvaluationSetItem) ReadField25(iprot thrift.TProtocol) error {
+	_field := NewEvaluationSetItemProvenance()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Provenance = _field
+	return nil
+}
 func (p *EvaluationSetItem) ReadField100(iprot thrift.TProtocol) error {
 	_field := common.NewBaseInfo()
 	if err := _field.Read(iprot); err != nil {
@@ -6277,6 +6377,10 @@ func (p *EvaluationSetItem) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 24
 			goto WriteFieldError
 		}
+		if err = p.writeField25(oprot); err != nil {
+			fieldId = 25
+			goto WriteFieldError
+		}
 		if err = p.writeField100(oprot); err != nil {
 			fieldId = 100
 			goto WriteFieldError
@@ -6513,6 +6617,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 24 end error: ", p), err)
 }
+func (p *EvaluationSetItem) writeField25(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProvenance() {
+		if err = oprot.WriteFieldBegin("provenance", thrift.STRUCT, 25); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Provenance.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 25 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 25 end error: ", p), err)
+}
 func (p *EvaluationSetItem) writeField100(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBaseInfo() {
 		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 100); err != nil {
@@ -6579,6 +6701,9 @@ func (p *EvaluationSetItem) DeepEqual(ano *EvaluationSetItem) bool {
 	if !p.Field24DeepEqual(ano.Tags) {
 		return false
 	}
+	if !p.Field25DeepEqual(ano.Provenance) {
+		return false
+	}
 	if !p.Field100DeepEqual(ano.BaseInfo) {
 		return false
 	}
@@ -6719,6 +6844,13 @@ func (p *EvaluationSetItem) Field24DeepEqual(src []*ResourceTag) bool {
 	}
 	return true
 }
+func (p *EvaluationSetItem) Field25DeepEqual(src *EvaluationSetItemProvenance) bool {
+
+	if !p.Provenance.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *EvaluationSetItem) Field100DeepEqual(src *common.BaseInfo) bool {
 
 	if !p.BaseInfo.DeepEqual(src) {
@@ -8779,3 +8911,7310 @@ func (p *FieldData) Field4DeepEqual(src *string) bool {
 	}
 	return true
 }
+
+// 字段 schema 的变更, 按字段 key 匹配
+type EvaluationSetSchemaFieldDiff struct {
+	Key        *string                  `thrift:"key,1,optional" frugal:"1,optional,string" form:"key" json:"key,omitempty" query:"key"`
+	Name       *string                  `thrift:"name,2,optional" frugal:"2,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	ChangeType *EvaluationSetChangeType `thrift:"change_type,3,optional" frugal:"3,optional,string" form:"change_type" json:"change_type,omitempty" query:"change_type"`
+	Base       *FieldSchema             `thrift:"base,4,optional" frugal:"4,optional,FieldSchema" form:"base" json:"base,omitempty" query:"base"`
+	Target     *FieldSchema             `thrift:"target,5,optional" frugal:"5,optional,FieldSchema" form:"target" json:"target,omitempty" query:"target"`
+	// 发生变化的属性, 仅 modified 时有值
+	ChangedAttrs []string `thrift:"changed_attrs,6,optional" frugal:"6,optional,list<string>" form:"changed_attrs" json:"changed_attrs,omitempty" query:"changed_attrs"`
+}
+
+func NewEvaluationSetSchemaFieldDiff() *EvaluationSetSchemaFieldDiff {
+	return &EvaluationSetSchemaFieldDiff{}
+}
+
+func (p *EvaluationSetSchemaFieldDiff) InitDefault() {
+}
+
+var EvaluationSetSchemaFieldDiff_Key_DEFAULT string
+
+func (p *EvaluationSetSchemaFieldDiff) GetKey() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetKey() {
+		return EvaluationSetSchemaFieldDiff_Key_DEFAULT
+	}
+	return *p.Key
+}
+
+var EvaluationSetSchemaFieldDiff_Name_DEFAULT string
+
+func (p *EvaluationSetSchemaFieldDiff) GetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetName() {
+		return EvaluationSetSchemaFieldDiff_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var EvaluationSetSchemaFieldDiff_ChangeType_DEFAULT EvaluationSetChangeType
+
+func (p *EvaluationSetSchemaFieldDiff) GetChangeType() (v EvaluationSetChangeType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetChangeType() {
+		return EvaluationSetSchemaFieldDiff_ChangeType_DEFAULT
+	}
+	return *p.ChangeType
+}
+
+var EvaluationSetSchemaFieldDiff_Base_DEFAULT *FieldSchema
+
+func (p *EvaluationSetSchemaFieldDiff) GetBase() (v *FieldSchema) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return EvaluationSetSchemaFieldDiff_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var EvaluationSetSchemaFieldDiff_Target_DEFAULT *FieldSchema
+
+func (p *EvaluationSetSchemaFieldDiff) GetTarget() (v *FieldSchema) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTarget() {
+		return EvaluationSetSchemaFieldDiff_Target_DEFAULT
+	}
+	return p.Target
+}
+
+var EvaluationSetSchemaFieldDiff_ChangedAttrs_DEFAULT []string
+
+func (p *EvaluationSetSchemaFieldDiff) GetChangedAttrs() (v []string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetChangedAttrs() {
+		return EvaluationSetSchemaFieldDiff_ChangedAttrs_DEFAULT
+	}
+	return p.ChangedAttrs
+}
+func (p *EvaluationSetSchemaFieldDiff) SetKey(val *string) {
+	p.Key = val
+}
+func (p *EvaluationSetSchemaFieldDiff) SetName(val *string) {
+	p.Name = val
+}
+func (p *EvaluationSetSchemaFieldDiff) SetChangeType(val *EvaluationSetChangeType) {
+	p.ChangeType = val
+}
+func (p *EvaluationSetSchemaFieldDiff) SetBase(val *FieldSchema) {
+	p.Base = val
+}
+func (p *EvaluationSetSchemaFieldDiff) SetTarget(val *FieldSchema) {
+	p.Target = val
+}
+func (p *EvaluationSetSchemaFieldDiff) SetChangedAttrs(val []string) {
+	p.ChangedAttrs = val
+}
+
+var fieldIDToName_EvaluationSetSchemaFieldDiff = map[int16]string{
+	1: "key",
+	2: "name",
+	3: "change_type",
+	4: "base",
+	5: "target",
+	6: "changed_attrs",
+}
+
+func (p *EvaluationSetSchemaFieldDiff) IsSetKey() bool {
+	return p.Key != nil
+}
+
+func (p *EvaluationSetSchemaFieldDiff) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *EvaluationSetSchemaFieldDiff) IsSetChangeType() bool {
+	return p.ChangeType != nil
+}
+
+func (p *EvaluationSetSchemaFieldDiff) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *EvaluationSetSchemaFieldDiff) IsSetTarget() bool {
+	return p.Target != nil
+}
+
+func (p *EvaluationSetSchemaFieldDiff) IsSetChangedAttrs() bool {
+	return p.ChangedAttrs != nil
+}
+
+func (p *EvaluationSetSchemaFieldDiff) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetSchemaFieldDiff[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluationSetSchemaFieldDiff) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Key = _field
+	return nil
+}
+func (p *EvaluationSetSchemaFieldDiff) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *EvaluationSetSchemaFieldDiff) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *EvaluationSetChangeType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ChangeType = _field
+	return nil
+}
+func (p *EvaluationSetSchemaFieldDiff) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewFieldSchema()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+func (p *EvaluationSetSchemaFieldDiff) ReadField5(iprot thrift.TProtocol) error {
+	_field := NewFieldSchema()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Target = _field
+	return nil
+}
+func (p *EvaluationSetSchemaFieldDiff) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ChangedAttrs = _field
+	return nil
+}
+
+func (p *EvaluationSetSchemaFieldDiff) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluationSetSchemaFieldDiff"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluationSetSchemaFieldDiff) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKey() {
+		if err = oprot.WriteFieldBegin("key", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Key); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluationSetSchemaFieldDiff) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluationSetSchemaFieldDiff) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChangeType() {
+		if err = oprot.WriteFieldBegin("change_type", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ChangeType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluationSetSchemaFieldDiff) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluationSetSchemaFieldDiff) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTarget() {
+		if err = oprot.WriteFieldBegin("target", thrift.STRUCT, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Target.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvaluationSetSchemaFieldDiff) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChangedAttrs() {
+		if err = oprot.WriteFieldBegin("changed_attrs", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.ChangedAttrs)); err != nil {
+			return err
+		}
+		for _, v := range p.ChangedAttrs {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *EvaluationSetSchemaFieldDiff) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluationSetSchemaFieldDiff(%+v)", *p)
+
+}
+
+func (p *EvaluationSetSchemaFieldDiff) DeepEqual(ano *EvaluationSetSchemaFieldDiff) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Key) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ChangeType) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Target) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.ChangedAttrs) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluationSetSchemaFieldDiff) Field1DeepEqual(src *string) bool {
+
+	if p.Key == src {
+		return true
+	} else if p.Key == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Key, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetSchemaFieldDiff) Field2DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetSchemaFieldDiff) Field3DeepEqual(src *EvaluationSetChangeType) bool {
+
+	if p.ChangeType == src {
+		return true
+	} else if p.ChangeType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ChangeType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetSchemaFieldDiff) Field4DeepEqual(src *FieldSchema) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetSchemaFieldDiff) Field5DeepEqual(src *FieldSchema) bool {
+
+	if !p.Target.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetSchemaFieldDiff) Field6DeepEqual(src []string) bool {
+
+	if len(p.ChangedAttrs) != len(src) {
+		return false
+	}
+	for i, v := range p.ChangedAttrs {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// 单个 turn 中单个字段内容的变更
+type EvaluationSetFieldDiff struct {
+	TurnIdx    *int32                   `thrift:"turn_idx,1,optional" frugal:"1,optional,i32" form:"turn_idx" json:"turn_idx,omitempty" query:"turn_idx"`
+	FieldKey   *string                  `thrift:"field_key,2,optional" frugal:"2,optional,string" form:"field_key" json:"field_key,omitempty" query:"field_key"`
+	FieldName  *string                  `thrift:"field_name,3,optional" frugal:"3,optional,string" form:"field_name" json:"field_name,omitempty" query:"field_name"`
+	ChangeType *EvaluationSetChangeType `thrift:"change_type,4,optional" frugal:"4,optional,string" form:"change_type" json:"change_type,omitempty" query:"change_type"`
+	Base       *common.Content          `thrift:"base,5,optional" frugal:"5,optional,common.Content" form:"base" json:"base,omitempty" query:"base"`
+	Target     *common.Content          `thrift:"target,6,optional" frugal:"6,optional,common.Content" form:"target" json:"target,omitempty" query:"target"`
+}
+
+func NewEvaluationSetFieldDiff() *EvaluationSetFieldDiff {
+	return &EvaluationSetFieldDiff{}
+}
+
+func (p *EvaluationSetFieldDiff) InitDefault() {
+}
+
+var EvaluationSetFieldDiff_TurnIdx_DEFAULT int32
+
+func (p *EvaluationSetFieldDiff) GetTurnIdx() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTurnIdx() {
+		return EvaluationSetFieldDiff_TurnIdx_DEFAULT
+	}
+	return *p.TurnIdx
+}
+
+var EvaluationSetFieldDiff_FieldKey_DEFAULT string
+
+func (p *EvaluationSetFieldDiff) GetFieldKey() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFieldKey() {
+		return EvaluationSetFieldDiff_FieldKey_DEFAULT
+	}
+	return *p.FieldKey
+}
+
+var EvaluationSetFieldDiff_FieldName_DEFAULT string
+
+func (p *EvaluationSetFieldDiff) GetFieldName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFieldName() {
+		return EvaluationSetFieldDiff_FieldName_DEFAULT
+	}
+	return *p.FieldName
+}
+
+var EvaluationSetFieldDiff_ChangeType_DEFAULT EvaluationSetChangeType
+
+func (p *EvaluationSetFieldDiff) GetChangeType() (v EvaluationSetChangeType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetChangeType() {
+		return EvaluationSetFieldDiff_ChangeType_DEFAULT
+	}
+	return *p.ChangeType
+}
+
+var EvaluationSetFieldDiff_Base_DEFAULT *common.Content
+
+func (p *EvaluationSetFieldDiff) GetBase() (v *common.Content) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return EvaluationSetFieldDiff_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var EvaluationSetFieldDiff_Target_DEFAULT *common.Content
+
+func (p *EvaluationSetFieldDiff) GetTarget() (v *common.Content) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTarget() {
+		return EvaluationSetFieldDiff_Target_DEFAULT
+	}
+	return p.Target
+}
+func (p *EvaluationSetFieldDiff) SetTurnIdx(val *int32) {
+	p.TurnIdx = val
+}
+func (p *EvaluationSetFieldDiff) SetFieldKey(val *string) {
+	p.FieldKey = val
+}
+func (p *EvaluationSetFieldDiff) SetFieldName(val *string) {
+	p.FieldName = val
+}
+func (p *EvaluationSetFieldDiff) SetChangeType(val *EvaluationSetChangeType) {
+	p.ChangeType = val
+}
+func (p *EvaluationSetFieldDiff) SetBase(val *common.Content) {
+	p.Base = val
+}
+func (p *EvaluationSetFieldDiff) SetTarget(val *common.Content) {
+	p.Target = val
+}
+
+var fieldIDToName_EvaluationSetFieldDiff = map[int16]string{
+	1: "turn_idx",
+	2: "field_key",
+	3: "field_name",
+	4: "change_type",
+	5: "base",
+	6: "target",
+}
+
+func (p *EvaluationSetFieldDiff) IsSetTurnIdx() bool {
+	return p.TurnIdx != nil
+}
+
+func (p *EvaluationSetFieldDiff) IsSetFieldKey() bool {
+	return p.FieldKey != nil
+}
+
+func (p *EvaluationSetFieldDiff) IsSetFieldName() bool {
+	return p.FieldName != nil
+}
+
+func (p *EvaluationSetFieldDiff) IsSetChangeType() bool {
+	return p.ChangeType != nil
+}
+
+func (p *EvaluationSetFieldDiff) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *EvaluationSetFieldDiff) IsSetTarget() bool {
+	return p.Target != nil
+}
+
+func (p *EvaluationSetFieldDiff) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetFieldDiff[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluationSetFieldDiff) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TurnIdx = _field
+	return nil
+}
+func (p *EvaluationSetFieldDiff) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FieldKey = _field
+	return nil
+}
+func (p *EvaluationSetFieldDiff) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FieldName = _field
+	return nil
+}
+func (p *EvaluationSetFieldDiff) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *EvaluationSetChangeType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ChangeType = _field
+	return nil
+}
+func (p *EvaluationSetFieldDiff) ReadField5(iprot thrift.TProtocol) error {
+	_field := common.NewContent()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+func (p *EvaluationSetFieldDiff) ReadField6(iprot thrift.TProtocol) error {
+	_field := common.NewContent()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Target = _field
+	return nil
+}
+
+func (p *EvaluationSetFieldDiff) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluationSetFieldDiff"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluationSetFieldDiff) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTurnIdx() {
+		if err = oprot.WriteFieldBegin("turn_idx", thrift.I32, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.TurnIdx); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluationSetFieldDiff) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldKey() {
+		if err = oprot.WriteFieldBegin("field_key", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.FieldKey); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluationSetFieldDiff) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldName() {
+		if err = oprot.WriteFieldBegin("field_name", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.FieldName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluationSetFieldDiff) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChangeType() {
+		if err = oprot.WriteFieldBegin("change_type", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ChangeType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluationSetFieldDiff) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvaluationSetFieldDiff) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTarget() {
+		if err = oprot.WriteFieldBegin("target", thrift.STRUCT, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Target.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *EvaluationSetFieldDiff) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluationSetFieldDiff(%+v)", *p)
+
+}
+
+func (p *EvaluationSetFieldDiff) DeepEqual(ano *EvaluationSetFieldDiff) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.TurnIdx) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.FieldKey) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.FieldName) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ChangeType) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Target) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluationSetFieldDiff) Field1DeepEqual(src *int32) bool {
+
+	if p.TurnIdx == src {
+		return true
+	} else if p.TurnIdx == nil || src == nil {
+		return false
+	}
+	if *p.TurnIdx != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetFieldDiff) Field2DeepEqual(src *string) bool {
+
+	if p.FieldKey == src {
+		return true
+	} else if p.FieldKey == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.FieldKey, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetFieldDiff) Field3DeepEqual(src *string) bool {
+
+	if p.FieldName == src {
+		return true
+	} else if p.FieldName == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.FieldName, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetFieldDiff) Field4DeepEqual(src *EvaluationSetChangeType) bool {
+
+	if p.ChangeType == src {
+		return true
+	} else if p.ChangeType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ChangeType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetFieldDiff) Field5DeepEqual(src *common.Content) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetFieldDiff) Field6DeepEqual(src *common.Content) bool {
+
+	if !p.Target.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+// 行级变更, 按 item_id 匹配
+type EvaluationSetItemDiff struct {
+	ItemID     *int64                   `thrift:"item_id,1,optional" frugal:"1,optional,i64" json:"item_id" form:"item_id" query:"item_id"`
+	ItemKey    *string                  `thrift:"item_key,2,optional" frugal:"2,optional,string" form:"item_key" json:"item_key,omitempty" query:"item_key"`
+	ChangeType *EvaluationSetChangeType `thrift:"change_type,3,optional" frugal:"3,optional,string" form:"change_type" json:"change_type,omitempty" query:"change_type"`
+	// 新增时为空
+	BaseItem *EvaluationSetItem `thrift:"base_item,4,optional" frugal:"4,optional,EvaluationSetItem" form:"base_item" json:"base_item,omitempty" query:"base_item"`
+	// 删除时为空
+	TargetItem *EvaluationSetItem `thrift:"target_item,5,optional" frugal:"5,optional,EvaluationSetItem" form:"target_item" json:"target_item,omitempty" query:"target_item"`
+	// 仅 modified 时有值
+	FieldDiffs []*EvaluationSetFieldDiff `thrift:"field_diffs,6,optional" frugal:"6,optional,list<EvaluationSetFieldDiff>" form:"field_diffs" json:"field_diffs,omitempty" query:"field_diffs"`
+}
+
+func NewEvaluationSetItemDiff() *EvaluationSetItemDiff {
+	return &EvaluationSetItemDiff{}
+}
+
+func (p *EvaluationSetItemDiff) InitDefault() {
+}
+
+var EvaluationSetItemDiff_ItemID_DEFAULT int64
+
+func (p *EvaluationSetItemDiff) GetItemID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetItemID() {
+		return EvaluationSetItemDiff_ItemID_DEFAULT
+	}
+	return *p.ItemID
+}
+
+var EvaluationSetItemDiff_ItemKey_DEFAULT string
+
+func (p *EvaluationSetItemDiff) GetItemKey() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetItemKey() {
+		return EvaluationSetItemDiff_ItemKey_DEFAULT
+	}
+	return *p.ItemKey
+}
+
+var EvaluationSetItemDiff_ChangeType_DEFAULT EvaluationSetChangeType
+
+func (p *EvaluationSetItemDiff) GetChangeType() (v EvaluationSetChangeType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetChangeType() {
+		return EvaluationSetItemDiff_ChangeType_DEFAULT
+	}
+	return *p.ChangeType
+}
+
+var EvaluationSetItemDiff_BaseItem_DEFAULT *EvaluationSetItem
+
+func (p *EvaluationSetItemDiff) GetBaseItem() (v *EvaluationSetItem) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseItem() {
+		return EvaluationSetItemDiff_BaseItem_DEFAULT
+	}
+	return p.BaseItem
+}
+
+var EvaluationSetItemDiff_TargetItem_DEFAULT *EvaluationSetItem
+
+func (p *EvaluationSetItemDiff) GetTargetItem() (v *EvaluationSetItem) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetItem() {
+		return EvaluationSetItemDiff_TargetItem_DEFAULT
+	}
+	return p.TargetItem
+}
+
+var EvaluationSetItemDiff_FieldDiffs_DEFAULT []*EvaluationSetFieldDiff
+
+func (p *EvaluationSetItemDiff) GetFieldDiffs() (v []*EvaluationSetFieldDiff) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFieldDiffs() {
+		return EvaluationSetItemDiff_FieldDiffs_DEFAULT
+	}
+	return p.FieldDiffs
+}
+func (p *EvaluationSetItemDiff) SetItemID(val *int64) {
+	p.ItemID = val
+}
+func (p *EvaluationSetItemDiff) SetItemKey(val *string) {
+	p.ItemKey = val
+}
+func (p *EvaluationSetItemDiff) SetChangeType(val *EvaluationSetChangeType) {
+	p.ChangeType = val
+}
+func (p *EvaluationSetItemDiff) SetBaseItem(val *EvaluationSetItem) {
+	p.BaseItem = val
+}
+func (p *EvaluationSetItemDiff) SetTargetItem(val *EvaluationSetItem) {
+	p.TargetItem = val
+}
+func (p *EvaluationSetItemDiff) SetFieldDiffs(val []*EvaluationSetFieldDiff) {
+	p.FieldDiffs = val
+}
+
+var fieldIDToName_EvaluationSetItemDiff = map[int16]string{
+	1: "item_id",
+	2: "item_key",
+	3: "change_type",
+	4: "base_item",
+	5: "target_item",
+	6: "field_diffs",
+}
+
+func (p *EvaluationSetItemDiff) IsSetItemID() bool {
+	return p.ItemID != nil
+}
+
+func (p *EvaluationSetItemDiff) IsSetItemKey() bool {
+	return p.ItemKey != nil
+}
+
+func (p *EvaluationSetItemDiff) IsSetChangeType() bool {
+	return p.ChangeType != nil
+}
+
+func (p *EvaluationSetItemDiff) IsSetBaseItem() bool {
+	return p.BaseItem != nil
+}
+
+func (p *EvaluationSetItemDiff) IsSetTargetItem() bool {
+	return p.TargetItem != nil
+}
+
+func (p *EvaluationSetItemDiff) IsSetFieldDiffs() bool {
+	return p.FieldDiffs != nil
+}
+
+func (p *EvaluationSetItemDiff) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetItemDiff[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluationSetItemDiff) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ItemID = _field
+	return nil
+}
+func (p *EvaluationSetItemDiff) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ItemKey = _field
+	return nil
+}
+func (p *EvaluationSetItemDiff) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *EvaluationSetChangeType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ChangeType = _field
+	return nil
+}
+func (p *EvaluationSetItemDiff) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewEvaluationSetItem()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseItem = _field
+	return nil
+}
+func (p *EvaluationSetItemDiff) ReadField5(iprot thrift.TProtocol) error {
+	_field := NewEvaluationSetItem()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.TargetItem = _field
+	return nil
+}
+func (p *EvaluationSetItemDiff) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*EvaluationSetFieldDiff, 0, size)
+	values := make([]EvaluationSetFieldDiff, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FieldDiffs = _field
+	return nil
+}
+
+func (p *EvaluationSetItemDiff) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluationSetItemDiff"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluationSetItemDiff) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItemID() {
+		if err = oprot.WriteFieldBegin("item_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ItemID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluationSetItemDiff) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItemKey() {
+		if err = oprot.WriteFieldBegin("item_key", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ItemKey); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluationSetItemDiff) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChangeType() {
+		if err = oprot.WriteFieldBegin("change_type", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ChangeType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluationSetItemDiff) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseItem() {
+		if err = oprot.WriteFieldBegin("base_item", thrift.STRUCT, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseItem.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluationSetItemDiff) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetItem() {
+		if err = oprot.WriteFieldBegin("target_item", thrift.STRUCT, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.TargetItem.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvaluationSetItemDiff) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldDiffs() {
+		if err = oprot.WriteFieldBegin("field_diffs", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FieldDiffs)); err != nil {
+			return err
+		}
+		for _, v := range p.FieldDiffs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *EvaluationSetItemDiff) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluationSetItemDiff(%+v)", *p)
+
+}
+
+func (p *EvaluationSetItemDiff) DeepEqual(ano *EvaluationSetItemDiff) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ItemID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ItemKey) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ChangeType) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.BaseItem) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.TargetItem) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.FieldDiffs) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluationSetItemDiff) Field1DeepEqual(src *int64) bool {
+
+	if p.ItemID == src {
+		return true
+	} else if p.ItemID == nil || src == nil {
+		return false
+	}
+	if *p.ItemID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetItemDiff) Field2DeepEqual(src *string) bool {
+
+	if p.ItemKey == src {
+		return true
+	} else if p.ItemKey == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ItemKey, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetItemDiff) Field3DeepEqual(src *EvaluationSetChangeType) bool {
+
+	if p.ChangeType == src {
+		return true
+	} else if p.ChangeType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ChangeType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetItemDiff) Field4DeepEqual(src *EvaluationSetItem) bool {
+
+	if !p.BaseItem.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetItemDiff) Field5DeepEqual(src *EvaluationSetItem) bool {
+
+	if !p.TargetItem.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetItemDiff) Field6DeepEqual(src []*EvaluationSetFieldDiff) bool {
+
+	if len(p.FieldDiffs) != len(src) {
+		return false
+	}
+	for i, v := range p.FieldDiffs {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+// 从 base 到 target 的变更, 版本 ID 为空表示草稿
+type EvaluationSetVersionDiff struct {
+	EvaluationSetID  *int64                          `thrift:"evaluation_set_id,1,optional" frugal:"1,optional,i64" json:"evaluation_set_id" form:"evaluation_set_id" query:"evaluation_set_id"`
+	BaseVersionID    *int64                          `thrift:"base_version_id,2,optional" frugal:"2,optional,i64" json:"base_version_id" form:"base_version_id" query:"base_version_id"`
+	TargetVersionID  *int64                          `thrift:"target_version_id,3,optional" frugal:"3,optional,i64" json:"target_version_id" form:"target_version_id" query:"target_version_id"`
+	SchemaDiffs      []*EvaluationSetSchemaFieldDiff `thrift:"schema_diffs,4,optional" frugal:"4,optional,list<EvaluationSetSchemaFieldDiff>" form:"schema_diffs" json:"schema_diffs,omitempty" query:"schema_diffs"`
+	ItemDiffs        []*EvaluationSetItemDiff        `thrift:"item_diffs,5,optional" frugal:"5,optional,list<EvaluationSetItemDiff>" form:"item_diffs" json:"item_diffs,omitempty" query:"item_diffs"`
+	AddedItemCnt     *int32                          `thrift:"added_item_cnt,6,optional" frugal:"6,optional,i32" form:"added_item_cnt" json:"added_item_cnt,omitempty" query:"added_item_cnt"`
+	RemovedItemCnt   *int32                          `thrift:"removed_item_cnt,7,optional" frugal:"7,optional,i32" form:"removed_item_cnt" json:"removed_item_cnt,omitempty" query:"removed_item_cnt"`
+	ModifiedItemCnt  *int32                          `thrift:"modified_item_cnt,8,optional" frugal:"8,optional,i32" form:"modified_item_cnt" json:"modified_item_cnt,omitempty" query:"modified_item_cnt"`
+	UnchangedItemCnt *int32                          `thrift:"unchanged_item_cnt,9,optional" frugal:"9,optional,i32" form:"unchanged_item_cnt" json:"unchanged_item_cnt,omitempty" query:"unchanged_item_cnt"`
+	// 行数超出对比上限, 行级对比不完整
+	Truncated *bool `thrift:"truncated,10,optional" frugal:"10,optional,bool" form:"truncated" json:"truncated,omitempty" query:"truncated"`
+}
+
+func NewEvaluationSetVersionDiff() *EvaluationSetVersionDiff {
+	return &EvaluationSetVersionDiff{}
+}
+
+func (p *EvaluationSetVersionDiff) InitDefault() {
+}
+
+var EvaluationSetVersionDiff_EvaluationSetID_DEFAULT int64
+
+func (p *EvaluationSetVersionDiff) GetEvaluationSetID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluationSetID() {
+		return EvaluationSetVersionDiff_EvaluationSetID_DEFAULT
+	}
+	return *p.EvaluationSetID
+}
+
+var EvaluationSetVersionDiff_BaseVersionID_DEFAULT int64
+
+func (p *EvaluationSetVersionDiff) GetBaseVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseVersionID() {
+		return EvaluationSetVersionDiff_BaseVersionID_DEFAULT
+	}
+	return *p.BaseVersionID
+}
+
+var EvaluationSetVersionDiff_TargetVersionID_DEFAULT int64
+
+func (p *EvaluationSetVersionDiff) GetTargetVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetVersionID() {
+		return EvaluationSetVersionDiff_TargetVersionID_DEFAULT
+	}
+	return *p.TargetVersionID
+}
+
+var EvaluationSetVersionDiff_SchemaDiffs_DEFAULT []*EvaluationSetSchemaFieldDiff
+
+func (p *EvaluationSetVersionDiff) GetSchemaDiffs() (v []*EvaluationSetSchemaFieldDiff) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSchemaDiffs() {
+		return EvaluationSetVersionDiff_SchemaDiffs_DEFAULT
+	}
+	return p.SchemaDiffs
+}
+
+var EvaluationSetVersionDiff_ItemDiffs_DEFAULT []*EvaluationSetItemDiff
+
+func (p *EvaluationSetVersionDiff) GetItemDiffs() (v []*EvaluationSetItemDiff) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetItemDiffs() {
+		return EvaluationSetVersionDiff_ItemDiffs_DEFAULT
+	}
+	return p.ItemDiffs
+}
+
+var EvaluationSetVersionDiff_AddedItemCnt_DEFAULT int32
+
+func (p *EvaluationSetVersionDiff) GetAddedItemCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAddedItemCnt() {
+		return EvaluationSetVersionDiff_AddedItemCnt_DEFAULT
+	}
+	return *p.AddedItemCnt
+}
+
+var EvaluationSetVersionDiff_RemovedItemCnt_DEFAULT int32
+
+func (p *EvaluationSetVersionDiff) GetRemovedItemCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRemovedItemCnt() {
+		return EvaluationSetVersionDiff_RemovedItemCnt_DEFAULT
+	}
+	return *p.RemovedItemCnt
+}
+
+var EvaluationSetVersionDiff_ModifiedItemCnt_DEFAULT int32
+
+func (p *EvaluationSetVersionDiff) GetModifiedItemCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModifiedItemCnt() {
+		return EvaluationSetVersionDiff_ModifiedItemCnt_DEFAULT
+	}
+	return *p.ModifiedItemCnt
+}
+
+var EvaluationSetVersionDiff_UnchangedItemCnt_DEFAULT int32
+
+func (p *EvaluationSetVersionDiff) GetUnchangedItemCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUnchangedItemCnt() {
+		return EvaluationSetVersionDiff_UnchangedItemCnt_DEFAULT
+	}
+	return *p.UnchangedItemCnt
+}
+
+var EvaluationSetVersionDiff_Truncated_DEFAULT bool
+
+func (p *EvaluationSetVersionDiff) GetTruncated() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTruncated() {
+		return EvaluationSetVersionDiff_Truncated_DEFAULT
+	}
+	return *p.Truncated
+}
+func (p *EvaluationSetVersionDiff) SetEvaluationSetID(val *int64) {
+	p.EvaluationSetID = val
+}
+func (p *EvaluationSetVersionDiff) SetBaseVersionID(val *int64) {
+	p.BaseVersionID = val
+}
+func (p *EvaluationSetVersionDiff) SetTargetVersionID(val *int64) {
+	p.TargetVersionID = val
+}
+func (p *EvaluationSetVersionDiff) SetSchemaDiffs(val []*EvaluationSetSchemaFieldDiff) {
+	p.SchemaDiffs = val
+}
+func (p *EvaluationSetVersionDiff) SetItemDiffs(val []*EvaluationSetItemDiff) {
+	p.ItemDiffs = val
+}
+func (p *EvaluationSetVersionDiff) SetAddedItemCnt(val *int32) {
+	p.AddedItemCnt = val
+}
+func (p *EvaluationSetVersionDiff) SetRemovedItemCnt(val *int32) {
+	p.RemovedItemCnt = val
+}
+func (p *EvaluationSetVersionDiff) SetModifiedItemCnt(val *int32) {
+	p.ModifiedItemCnt = val
+}
+func (p *EvaluationSetVersionDiff) SetUnchangedItemCnt(val *int32) {
+	p.UnchangedItemCnt = val
+}
+func (p *EvaluationSetVersionDiff) SetTruncated(val *bool) {
+	p.Truncated = val
+}
+
+var fieldIDToName_EvaluationSetVersionDiff = map[int16]string{
+	1:  "evaluation_set_id",
+	2:  "base_version_id",
+	3:  "target_version_id",
+	4:  "schema_diffs",
+	5:  "item_diffs",
+	6:  "added_item_cnt",
+	7:  "removed_item_cnt",
+	8:  "modified_item_cnt",
+	9:  "unchanged_item_cnt",
+	10: "truncated",
+}
+
+func (p *EvaluationSetVersionDiff) IsSetEvaluationSetID() bool {
+	return p.EvaluationSetID != nil
+}
+
+func (p *EvaluationSetVersionDiff) IsSetBaseVersionID() bool {
+	return p.BaseVersionID != nil
+}
+
+func (p *EvaluationSetVersionDiff) IsSetTargetVersionID() bool {
+	return p.TargetVersionID != nil
+}
+
+func (p *EvaluationSetVersionDiff) IsSetSchemaDiffs() bool {
+	return p.SchemaDiffs != nil
+}
+
+func (p *EvaluationSetVersionDiff) IsSetItemDiffs() bool {
+	return p.ItemDiffs != nil
+}
+
+func (p *EvaluationSetVersionDiff) IsSetAddedItemCnt() bool {
+	return p.AddedItemCnt != nil
+}
+
+func (p *EvaluationSetVersionDiff) IsSetRemovedItemCnt() bool {
+	return p.RemovedItemCnt != nil
+}
+
+func (p *EvaluationSetVersionDiff) IsSetModifiedItemCnt() bool {
+	return p.ModifiedItemCnt != nil
+}
+
+func (p *EvaluationSetVersionDiff) IsSetUnchangedItemCnt() bool {
+	return p.UnchangedItemCnt != nil
+}
+
+func (p *EvaluationSetVersionDiff) IsSetTruncated() bool {
+	return p.Truncated != nil
+}
+
+func (p *EvaluationSetVersionDiff) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetVersionDiff[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluationSetVersionDiff) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluationSetID = _field
+	return nil
+}
+func (p *EvaluationSetVersionDiff) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaseVersionID = _field
+	return nil
+}
+func (p *EvaluationSetVersionDiff) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetVersionID = _field
+	return nil
+}
+func (p *EvaluationSetVersionDiff) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*EvaluationSetSchemaFieldDiff, 0, size)
+	values := make([]EvaluationSetSchemaFieldDiff, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.SchemaDiffs = _field
+	return nil
+}
+func (p *EvaluationSetVersionDiff) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*EvaluationSetItemDiff, 0, size)
+	values := make([]EvaluationSetItemDiff, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ItemDiffs = _field
+	return nil
+}
+func (p *EvaluationSetVersionDiff) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.AddedItemCnt = _field
+	return nil
+}
+func (p *EvaluationSetVersionDiff) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RemovedItemCnt = _field
+	return nil
+}
+func (p *EvaluationSetVersionDiff) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ModifiedItemCnt = _field
+	return nil
+}
+func (p *EvaluationSetVersionDiff) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UnchangedItemCnt = _field
+	return nil
+}
+func (p *EvaluationSetVersionDiff) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Truncated = _field
+	return nil
+}
+
+func (p *EvaluationSetVersionDiff) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluationSetVersionDiff"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluationSetVersionDiff) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluationSetID() {
+		if err = oprot.WriteFieldBegin("evaluation_set_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluationSetID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluationSetVersionDiff) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseVersionID() {
+		if err = oprot.WriteFieldBegin("base_version_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.BaseVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluationSetVersionDiff) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetVersionID() {
+		if err = oprot.WriteFieldBegin("target_version_id", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TargetVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluationSetVersionDiff) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSchemaDiffs() {
+		if err = oprot.WriteFieldBegin("schema_diffs", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.SchemaDiffs)); err != nil {
+			return err
+		}
+		for _, v := range p.SchemaDiffs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluationSetVersionDiff) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItemDiffs() {
+		if err = oprot.WriteFieldBegin("item_diffs", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ItemDiffs)); err != nil {
+			return err
+		}
+		for _, v := range p.ItemDiffs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvaluationSetVersionDiff) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAddedItemCnt() {
+		if err = oprot.WriteFieldBegin("added_item_cnt", thrift.I32, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.AddedItemCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *EvaluationSetVersionDiff) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRemovedItemCnt() {
+		if err = oprot.WriteFieldBegin("removed_item_cnt", thrift.I32, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.RemovedItemCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *EvaluationSetVersionDiff) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModifiedItemCnt() {
+		if err = oprot.WriteFieldBegin("modified_item_cnt", thrift.I32, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.ModifiedItemCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *EvaluationSetVersionDiff) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUnchangedItemCnt() {
+		if err = oprot.WriteFieldBegin("unchanged_item_cnt", thrift.I32, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.UnchangedItemCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *EvaluationSetVersionDiff) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTruncated() {
+		if err = oprot.WriteFieldBegin("truncated", thrift.BOOL, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Truncated); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *EvaluationSetVersionDiff) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluationSetVersionDiff(%+v)", *p)
+
+}
+
+func (p *EvaluationSetVersionDiff) DeepEqual(ano *EvaluationSetVersionDiff) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EvaluationSetID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.BaseVersionID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.TargetVersionID) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.SchemaDiffs) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.ItemDiffs) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.AddedItemCnt) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.RemovedItemCnt) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.ModifiedItemCnt) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.UnchangedItemCnt) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.Truncated) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluationSetVersionDiff) Field1DeepEqual(src *int64) bool {
+
+	if p.EvaluationSetID == src {
+		return true
+	} else if p.EvaluationSetID == nil || src == nil {
+		return false
+	}
+	if *p.EvaluationSetID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetVersionDiff) Field2DeepEqual(src *int64) bool {
+
+	if p.BaseVersionID == src {
+		return true
+	} else if p.BaseVersionID == nil || src == nil {
+		return false
+	}
+	if *p.BaseVersionID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetVersionDiff) Field3DeepEqual(src *int64) bool {
+
+	if p.TargetVersionID == src {
+		return true
+	} else if p.TargetVersionID == nil || src == nil {
+		return false
+	}
+	if *p.TargetVersionID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetVersionDiff) Field4DeepEqual(src []*EvaluationSetSchemaFieldDiff) bool {
+
+	if len(p.SchemaDiffs) != len(src) {
+		return false
+	}
+	for i, v := range p.SchemaDiffs {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluationSetVersionDiff) Field5DeepEqual(src []*EvaluationSetItemDiff) bool {
+
+	if len(p.ItemDiffs) != len(src) {
+		return false
+	}
+	for i, v := range p.ItemDiffs {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluationSetVersionDiff) Field6DeepEqual(src *int32) bool {
+
+	if p.AddedItemCnt == src {
+		return true
+	} else if p.AddedItemCnt == nil || src == nil {
+		return false
+	}
+	if *p.AddedItemCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetVersionDiff) Field7DeepEqual(src *int32) bool {
+
+	if p.RemovedItemCnt == src {
+		return true
+	} else if p.RemovedItemCnt == nil || src == nil {
+		return false
+	}
+	if *p.RemovedItemCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetVersionDiff) Field8DeepEqual(src *int32) bool {
+
+	if p.ModifiedItemCnt == src {
+		return true
+	} else if p.ModifiedItemCnt == nil || src == nil {
+		return false
+	}
+	if *p.ModifiedItemCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetVersionDiff) Field9DeepEqual(src *int32) bool {
+
+	if p.UnchangedItemCnt == src {
+		return true
+	} else if p.UnchangedItemCnt == nil || src == nil {
+		return false
+	}
+	if *p.UnchangedItemCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetVersionDiff) Field10DeepEqual(src *bool) bool {
+
+	if p.Truncated == src {
+		return true
+	} else if p.Truncated == nil || src == nil {
+		return false
+	}
+	if *p.Truncated != *src {
+		return false
+	}
+	return true
+}
+
+type EvaluationSetDedupConf struct {
+	// 参与去重的字段, 为空时使用全部文本字段
+	FieldNames []string `thrift:"field_names,1,optional" frugal:"1,optional,list<string>" form:"field_names" json:"field_names,omitempty" query:"field_names"`
+	// 默认 minhash
+	Method *EvaluationSetDedupMethod `thrift:"method,2,optional" frugal:"2,optional,string" form:"method" json:"method,omitempty" query:"method"`
+	// 近似重复相似度阈值 (0, 1], 默认 0.85
+	Threshold *float64 `thrift:"threshold,3,optional" frugal:"3,optional,double" form:"threshold" json:"threshold,omitempty" query:"threshold"`
+	// MinHash 片段长度 (token 数), 默认 3
+	ShingleSize *int32 `thrift:"shingle_size,4,optional" frugal:"4,optional,i32" form:"shingle_size" json:"shingle_size,omitempty" query:"shingle_size"`
+	// 仅检测精确重复
+	ExactOnly *bool `thrift:"exact_only,5,optional" frugal:"5,optional,bool" form:"exact_only" json:"exact_only,omitempty" query:"exact_only"`
+}
+
+func NewEvaluationSetDedupConf() *EvaluationSetDedupConf {
+	return &EvaluationSetDedupConf{}
+}
+
+func (p *EvaluationSetDedupConf) InitDefault() {
+}
+
+var EvaluationSetDedupConf_FieldNames_DEFAULT []string
+
+func (p *EvaluationSetDedupConf) GetFieldNames() (v []string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFieldNames() {
+		return EvaluationSetDedupConf_FieldNames_DEFAULT
+	}
+	return p.FieldNames
+}
+
+var EvaluationSetDedupConf_Method_DEFAULT EvaluationSetDedupMethod
+
+func (p *EvaluationSetDedupConf) GetMethod() (v EvaluationSetDedupMethod) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMethod() {
+		return EvaluationSetDedupConf_Method_DEFAULT
+	}
+	return *p.Method
+}
+
+var EvaluationSetDedupConf_Threshold_DEFAULT float64
+
+func (p *EvaluationSetDedupConf) GetThreshold() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetThreshold() {
+		return EvaluationSetDedupConf_Threshold_DEFAULT
+	}
+	return *p.Threshold
+}
+
+var EvaluationSetDedupConf_ShingleSize_DEFAULT int32
+
+func (p *EvaluationSetDedupConf) GetShingleSize() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetShingleSize() {
+		return EvaluationSetDedupConf_ShingleSize_DEFAULT
+	}
+	return *p.ShingleSize
+}
+
+var EvaluationSetDedupConf_ExactOnly_DEFAULT bool
+
+func (p *EvaluationSetDedupConf) GetExactOnly() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExactOnly() {
+		return EvaluationSetDedupConf_ExactOnly_DEFAULT
+	}
+	return *p.ExactOnly
+}
+func (p *EvaluationSetDedupConf) SetFieldNames(val []string) {
+	p.FieldNames = val
+}
+func (p *EvaluationSetDedupConf) SetMethod(val *EvaluationSetDedupMethod) {
+	p.Method = val
+}
+func (p *EvaluationSetDedupConf) SetThreshold(val *float64) {
+	p.Threshold = val
+}
+func (p *EvaluationSetDedupConf) SetShingleSize(val *int32) {
+	p.ShingleSize = val
+}
+func (p *EvaluationSetDedupConf) SetExactOnly(val *bool) {
+	p.ExactOnly = val
+}
+
+var fieldIDToName_EvaluationSetDedupConf = map[int16]string{
+	1: "field_names",
+	2: "method",
+	3: "threshold",
+	4: "shingle_size",
+	5: "exact_only",
+}
+
+func (p *EvaluationSetDedupConf) IsSetFieldNames() bool {
+	return p.FieldNames != nil
+}
+
+func (p *EvaluationSetDedupConf) IsSetMethod() bool {
+	return p.Method != nil
+}
+
+func (p *EvaluationSetDedupConf) IsSetThreshold() bool {
+	return p.Threshold != nil
+}
+
+func (p *EvaluationSetDedupConf) IsSetShingleSize() bool {
+	return p.ShingleSize != nil
+}
+
+func (p *EvaluationSetDedupConf) IsSetExactOnly() bool {
+	return p.ExactOnly != nil
+}
+
+func (p *EvaluationSetDedupConf) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetDedupConf[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluationSetDedupConf) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FieldNames = _field
+	return nil
+}
+func (p *EvaluationSetDedupConf) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *EvaluationSetDedupMethod
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Method = _field
+	return nil
+}
+func (p *EvaluationSetDedupConf) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Threshold = _field
+	return nil
+}
+func (p *EvaluationSetDedupConf) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ShingleSize = _field
+	return nil
+}
+func (p *EvaluationSetDedupConf) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ExactOnly = _field
+	return nil
+}
+
+func (p *EvaluationSetDedupConf) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluationSetDedupConf"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluationSetDedupConf) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldNames() {
+		if err = oprot.WriteFieldBegin("field_names", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.FieldNames)); err != nil {
+			return err
+		}
+		for _, v := range p.FieldNames {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluationSetDedupConf) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMethod() {
+		if err = oprot.WriteFieldBegin("method", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Method); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluationSetDedupConf) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetThreshold() {
+		if err = oprot.WriteFieldBegin("threshold", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Threshold); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluationSetDedupConf) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetShingleSize() {
+		if err = oprot.WriteFieldBegin("shingle_size", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.ShingleSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluationSetDedupConf) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExactOnly() {
+		if err = oprot.WriteFieldBegin("exact_only", thrift.BOOL, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.ExactOnly); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *EvaluationSetDedupConf) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluationSetDedupConf(%+v)", *p)
+
+}
+
+func (p *EvaluationSetDedupConf) DeepEqual(ano *EvaluationSetDedupConf) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.FieldNames) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Method) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Threshold) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ShingleSize) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.ExactOnly) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluationSetDedupConf) Field1DeepEqual(src []string) bool {
+
+	if len(p.FieldNames) != len(src) {
+		return false
+	}
+	for i, v := range p.FieldNames {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluationSetDedupConf) Field2DeepEqual(src *EvaluationSetDedupMethod) bool {
+
+	if p.Method == src {
+		return true
+	} else if p.Method == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Method, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDedupConf) Field3DeepEqual(src *float64) bool {
+
+	if p.Threshold == src {
+		return true
+	} else if p.Threshold == nil || src == nil {
+		return false
+	}
+	if *p.Threshold != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDedupConf) Field4DeepEqual(src *int32) bool {
+
+	if p.ShingleSize == src {
+		return true
+	} else if p.ShingleSize == nil || src == nil {
+		return false
+	}
+	if *p.ShingleSize != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDedupConf) Field5DeepEqual(src *bool) bool {
+
+	if p.ExactOnly == src {
+		return true
+	} else if p.ExactOnly == nil || src == nil {
+		return false
+	}
+	if *p.ExactOnly != *src {
+		return false
+	}
+	return true
+}
+
+// 一组互为重复的行, 保留 keep_item_id, 其余为待归档的重复行
+type EvaluationSetDuplicateCluster struct {
+	Kind             *EvaluationSetDuplicateKind `thrift:"kind,1,optional" frugal:"1,optional,string" form:"kind" json:"kind,omitempty" query:"kind"`
+	KeepItemID       *int64                      `thrift:"keep_item_id,2,optional" frugal:"2,optional,i64" json:"keep_item_id" form:"keep_item_id" query:"keep_item_id"`
+	DuplicateItemIds []int64                     `thrift:"duplicate_item_ids,3,optional" frugal:"3,optional,list<i64>" json:"duplicate_item_ids" form:"duplicate_item_ids" query:"duplicate_item_ids"`
+	// 簇内最低相似度, 精确重复为 1
+	Similarity *float64 `thrift:"similarity,4,optional" frugal:"4,optional,double" form:"similarity" json:"similarity,omitempty" query:"similarity"`
+}
+
+func NewEvaluationSetDuplicateCluster() *EvaluationSetDuplicateCluster {
+	return &EvaluationSetDuplicateCluster{}
+}
+
+func (p *EvaluationSetDuplicateCluster) InitDefault() {
+}
+
+var EvaluationSetDuplicateCluster_Kind_DEFAULT EvaluationSetDuplicateKind
+
+func (p *EvaluationSetDuplicateCluster) GetKind() (v EvaluationSetDuplicateKind) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetKind() {
+		return EvaluationSetDuplicateCluster_Kind_DEFAULT
+	}
+	return *p.Kind
+}
+
+var EvaluationSetDuplicateCluster_KeepItemID_DEFAULT int64
+
+func (p *EvaluationSetDuplicateCluster) GetKeepItemID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetKeepItemID() {
+		return EvaluationSetDuplicateCluster_KeepItemID_DEFAULT
+	}
+	return *p.KeepItemID
+}
+
+var EvaluationSetDuplicateCluster_DuplicateItemIds_DEFAULT []int64
+
+func (p *EvaluationSetDuplicateCluster) GetDuplicateItemIds() (v []int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDuplicateItemIds() {
+		return EvaluationSetDuplicateCluster_DuplicateItemIds_DEFAULT
+	}
+	return p.DuplicateItemIds
+}
+
+var EvaluationSetDuplicateCluster_Similarity_DEFAULT float64
+
+func (p *EvaluationSetDuplicateCluster) GetSimilarity() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSimilarity() {
+		return EvaluationSetDuplicateCluster_Similarity_DEFAULT
+	}
+	return *p.Similarity
+}
+func (p *EvaluationSetDuplicateCluster) SetKind(val *EvaluationSetDuplicateKind) {
+	p.Kind = val
+}
+func (p *EvaluationSetDuplicateCluster) SetKeepItemID(val *int64) {
+	p.KeepItemID = val
+}
+func (p *EvaluationSetDuplicateCluster) SetDuplicateItemIds(val []int64) {
+	p.DuplicateItemIds = val
+}
+func (p *EvaluationSetDuplicateCluster) SetSimilarity(val *float64) {
+	p.Similarity = val
+}
+
+var fieldIDToName_EvaluationSetDuplicateCluster = map[int16]string{
+	1: "kind",
+	2: "keep_item_id",
+	3: "duplicate_item_ids",
+	4: "similarity",
+}
+
+func (p *EvaluationSetDuplicateCluster) IsSetKind() bool {
+	return p.Kind != nil
+}
+
+func (p *EvaluationSetDuplicateCluster) IsSetKeepItemID() bool {
+	return p.KeepItemID != nil
+}
+
+func (p *EvaluationSetDuplicateCluster) IsSetDuplicateItemIds() bool {
+	return p.DuplicateItemIds != nil
+}
+
+func (p *EvaluationSetDuplicateCluster) IsSetSimilarity() bool {
+	return p.Similarity != nil
+}
+
+func (p *EvaluationSetDuplicateCluster) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetDuplicateCluster[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluationSetDuplicateCluster) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *EvaluationSetDuplicateKind
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Kind = _field
+	return nil
+}
+func (p *EvaluationSetDuplicateCluster) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.KeepItemID = _field
+	return nil
+}
+func (p *EvaluationSetDuplicateCluster) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.DuplicateItemIds = _field
+	return nil
+}
+func (p *EvaluationSetDuplicateCluster) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Similarity = _field
+	return nil
+}
+
+func (p *EvaluationSetDuplicateCluster) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluationSetDuplicateCluster"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluationSetDuplicateCluster) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKind() {
+		if err = oprot.WriteFieldBegin("kind", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Kind); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluationSetDuplicateCluster) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeepItemID() {
+		if err = oprot.WriteFieldBegin("keep_item_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.KeepItemID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluationSetDuplicateCluster) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDuplicateItemIds() {
+		if err = oprot.WriteFieldBegin("duplicate_item_ids", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I64, len(p.DuplicateItemIds)); err != nil {
+			return err
+		}
+		for _, v := range p.DuplicateItemIds {
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluationSetDuplicateCluster) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSimilarity() {
+		if err = oprot.WriteFieldBegin("similarity", thrift.DOUBLE, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Similarity); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *EvaluationSetDuplicateCluster) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluationSetDuplicateCluster(%+v)", *p)
+
+}
+
+func (p *EvaluationSetDuplicateCluster) DeepEqual(ano *EvaluationSetDuplicateCluster) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Kind) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.KeepItemID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.DuplicateItemIds) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Similarity) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluationSetDuplicateCluster) Field1DeepEqual(src *EvaluationSetDuplicateKind) bool {
+
+	if p.Kind == src {
+		return true
+	} else if p.Kind == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Kind, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDuplicateCluster) Field2DeepEqual(src *int64) bool {
+
+	if p.KeepItemID == src {
+		return true
+	} else if p.KeepItemID == nil || src == nil {
+		return false
+	}
+	if *p.KeepItemID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDuplicateCluster) Field3DeepEqual(src []int64) bool {
+
+	if len(p.DuplicateItemIds) != len(src) {
+		return false
+	}
+	for i, v := range p.DuplicateItemIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluationSetDuplicateCluster) Field4DeepEqual(src *float64) bool {
+
+	if p.Similarity == src {
+		return true
+	} else if p.Similarity == nil || src == nil {
+		return false
+	}
+	if *p.Similarity != *src {
+		return false
+	}
+	return true
+}
+
+type EvaluationSetDedupReport struct {
+	ScannedItemCnt *int32 `thrift:"scanned_item_cnt,1,optional" frugal:"1,optional,i32" form:"scanned_item_cnt" json:"scanned_item_cnt,omitempty" query:"scanned_item_cnt"`
+	// 所选字段无文本内容的行数
+	SkippedItemCnt *int32 `thrift:"skipped_item_cnt,2,optional" frugal:"2,optional,i32" form:"skipped_item_cnt" json:"skipped_item_cnt,omitempty" query:"skipped_item_cnt"`
+	// 行数超出扫描上限, 仅检测了前 50000 行
+	Truncated         *bool                            `thrift:"truncated,3,optional" frugal:"3,optional,bool" form:"truncated" json:"truncated,omitempty" query:"truncated"`
+	ExactDuplicateCnt *int32                           `thrift:"exact_duplicate_cnt,4,optional" frugal:"4,optional,i32" form:"exact_duplicate_cnt" json:"exact_duplicate_cnt,omitempty" query:"exact_duplicate_cnt"`
+	NearDuplicateCnt  *int32                           `thrift:"near_duplicate_cnt,5,optional" frugal:"5,optional,i32" form:"near_duplicate_cnt" json:"near_duplicate_cnt,omitempty" query:"near_duplicate_cnt"`
+	Clusters          []*EvaluationSetDuplicateCluster `thrift:"clusters,6,optional" frugal:"6,optional,list<EvaluationSetDuplicateCluster>" form:"clusters" json:"clusters,omitempty" query:"clusters"`
+}
+
+func NewEvaluationSetDedupReport() *EvaluationSetDedupReport {
+	return &EvaluationSetDedupReport{}
+}
+
+func (p *EvaluationSetDedupReport) InitDefault() {
+}
+
+var EvaluationSetDedupReport_ScannedItemCnt_DEFAULT int32
+
+func (p *EvaluationSetDedupReport) GetScannedItemCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScannedItemCnt() {
+		return EvaluationSetDedupReport_ScannedItemCnt_DEFAULT
+	}
+	return *p.ScannedItemCnt
+}
+
+var EvaluationSetDedupReport_SkippedItemCnt_DEFAULT int32
+
+func (p *EvaluationSetDedupReport) GetSkippedItemCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSkippedItemCnt() {
+		return EvaluationSetDedupReport_SkippedItemCnt_DEFAULT
+	}
+	return *p.SkippedItemCnt
+}
+
+var EvaluationSetDedupReport_Truncated_DEFAULT bool
+
+func (p *EvaluationSetDedupReport) GetTruncated() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTruncated() {
+		return EvaluationSetDedupReport_Truncated_DEFAULT
+	}
+	return *p.Truncated
+}
+
+var EvaluationSetDedupReport_ExactDuplicateCnt_DEFAULT int32
+
+func (p *EvaluationSetDedupReport) GetExactDuplicateCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExactDuplicateCnt() {
+		return EvaluationSetDedupReport_ExactDuplicateCnt_DEFAULT
+	}
+	return *p.ExactDuplicateCnt
+}
+
+var EvaluationSetDedupReport_NearDuplicateCnt_DEFAULT int32
+
+func (p *EvaluationSetDedupReport) GetNearDuplicateCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetNearDuplicateCnt() {
+		return EvaluationSetDedupReport_NearDuplicateCnt_DEFAULT
+	}
+	return *p.NearDuplicateCnt
+}
+
+var EvaluationSetDedupReport_Clusters_DEFAULT []*EvaluationSetDuplicateCluster
+
+func (p *EvaluationSetDedupReport) GetClusters() (v []*EvaluationSetDuplicateCluster) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetClusters() {
+		return EvaluationSetDedupReport_Clusters_DEFAULT
+	}
+	return p.Clusters
+}
+func (p *EvaluationSetDedupReport) SetScannedItemCnt(val *int32) {
+	p.ScannedItemCnt = val
+}
+func (p *EvaluationSetDedupReport) SetSkippedItemCnt(val *int32) {
+	p.SkippedItemCnt = val
+}
+func (p *EvaluationSetDedupReport) SetTruncated(val *bool) {
+	p.Truncated = val
+}
+func (p *EvaluationSetDedupReport) SetExactDuplicateCnt(val *int32) {
+	p.ExactDuplicateCnt = val
+}
+func (p *EvaluationSetDedupReport) SetNearDuplicateCnt(val *int32) {
+	p.NearDuplicateCnt = val
+}
+func (p *EvaluationSetDedupReport) SetClusters(val []*EvaluationSetDuplicateCluster) {
+	p.Clusters = val
+}
+
+var fieldIDToName_EvaluationSetDedupReport = map[int16]string{
+	1: "scanned_item_cnt",
+	2: "skipped_item_cnt",
+	3: "truncated",
+	4: "exact_duplicate_cnt",
+	5: "near_duplicate_cnt",
+	6: "clusters",
+}
+
+func (p *EvaluationSetDedupReport) IsSetScannedItemCnt() bool {
+	return p.ScannedItemCnt != nil
+}
+
+func (p *EvaluationSetDedupReport) IsSetSkippedItemCnt() bool {
+	return p.SkippedItemCnt != nil
+}
+
+func (p *EvaluationSetDedupReport) IsSetTruncated() bool {
+	return p.Truncated != nil
+}
+
+func (p *EvaluationSetDedupReport) IsSetExactDuplicateCnt() bool {
+	return p.ExactDuplicateCnt != nil
+}
+
+func (p *EvaluationSetDedupReport) IsSetNearDuplicateCnt() bool {
+	return p.NearDuplicateCnt != nil
+}
+
+func (p *EvaluationSetDedupReport) IsSetClusters() bool {
+	return p.Clusters != nil
+}
+
+func (p *EvaluationSetDedupReport) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetDedupReport[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluationSetDedupReport) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ScannedItemCnt = _field
+	return nil
+}
+func (p *EvaluationSetDedupReport) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SkippedItemCnt = _field
+	return nil
+}
+func (p *EvaluationSetDedupReport) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Truncated = _field
+	return nil
+}
+func (p *EvaluationSetDedupReport) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ExactDuplicateCnt = _field
+	return nil
+}
+func (p *EvaluationSetDedupReport) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NearDuplicateCnt = _field
+	return nil
+}
+func (p *EvaluationSetDedupReport) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*EvaluationSetDuplicateCluster, 0, size)
+	values := make([]EvaluationSetDuplicateCluster, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Clusters = _field
+	return nil
+}
+
+func (p *EvaluationSetDedupReport) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluationSetDedupReport"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluationSetDedupReport) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScannedItemCnt() {
+		if err = oprot.WriteFieldBegin("scanned_item_cnt", thrift.I32, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.ScannedItemCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluationSetDedupReport) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSkippedItemCnt() {
+		if err = oprot.WriteFieldBegin("skipped_item_cnt", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.SkippedItemCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluationSetDedupReport) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTruncated() {
+		if err = oprot.WriteFieldBegin("truncated", thrift.BOOL, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Truncated); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluationSetDedupReport) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExactDuplicateCnt() {
+		if err = oprot.WriteFieldBegin("exact_duplicate_cnt", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.ExactDuplicateCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluationSetDedupReport) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNearDuplicateCnt() {
+		if err = oprot.WriteFieldBegin("near_duplicate_cnt", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.NearDuplicateCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvaluationSetDedupReport) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetClusters() {
+		if err = oprot.WriteFieldBegin("clusters", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Clusters)); err != nil {
+			return err
+		}
+		for _, v := range p.Clusters {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *EvaluationSetDedupReport) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluationSetDedupReport(%+v)", *p)
+
+}
+
+func (p *EvaluationSetDedupReport) DeepEqual(ano *EvaluationSetDedupReport) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ScannedItemCnt) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.SkippedItemCnt) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Truncated) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ExactDuplicateCnt) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.NearDuplicateCnt) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Clusters) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluationSetDedupReport) Field1DeepEqual(src *int32) bool {
+
+	if p.ScannedItemCnt == src {
+		return true
+	} else if p.ScannedItemCnt == nil || src == nil {
+		return false
+	}
+	if *p.ScannedItemCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDedupReport) Field2DeepEqual(src *int32) bool {
+
+	if p.SkippedItemCnt == src {
+		return true
+	} else if p.SkippedItemCnt == nil || src == nil {
+		return false
+	}
+	if *p.SkippedItemCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDedupReport) Field3DeepEqual(src *bool) bool {
+
+	if p.Truncated == src {
+		return true
+	} else if p.Truncated == nil || src == nil {
+		return false
+	}
+	if *p.Truncated != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDedupReport) Field4DeepEqual(src *int32) bool {
+
+	if p.ExactDuplicateCnt == src {
+		return true
+	} else if p.ExactDuplicateCnt == nil || src == nil {
+		return false
+	}
+	if *p.ExactDuplicateCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDedupReport) Field5DeepEqual(src *int32) bool {
+
+	if p.NearDuplicateCnt == src {
+		return true
+	} else if p.NearDuplicateCnt == nil || src == nil {
+		return false
+	}
+	if *p.NearDuplicateCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDedupReport) Field6DeepEqual(src []*EvaluationSetDuplicateCluster) bool {
+
+	if len(p.Clusters) != len(src) {
+		return false
+	}
+	for i, v := range p.Clusters {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+// 评测集去重检测任务
+type EvaluationSetDedupJob struct {
+	JobID           int64 `thrift:"job_id,1,required" frugal:"1,required,i64" json:"job_id" form:"job_id,required" query:"job_id,required"`
+	WorkspaceID     int64 `thrift:"workspace_id,2,required" frugal:"2,required,i64" json:"workspace_id" form:"workspace_id,required" query:"workspace_id,required"`
+	EvaluationSetID int64 `thrift:"evaluation_set_id,3,required" frugal:"3,required,i64" json:"evaluation_set_id" form:"evaluation_set_id,required" query:"evaluation_set_id,required"`
+	// 为空表示草稿
+	VersionID *int64                      `thrift:"version_id,4,optional" frugal:"4,optional,i64" json:"version_id" form:"version_id" query:"version_id"`
+	Status    EvaluationSetDedupJobStatus `thrift:"status,5,required" frugal:"5,required,string" form:"status,required" json:"status,required" query:"status,required"`
+	Conf      *EvaluationSetDedupConf     `thrift:"conf,6,optional" frugal:"6,optional,EvaluationSetDedupConf" form:"conf" json:"conf,omitempty" query:"conf"`
+	// 成功后有值
+	Report   *EvaluationSetDedupReport `thrift:"report,7,optional" frugal:"7,optional,EvaluationSetDedupReport" form:"report" json:"report,omitempty" query:"report"`
+	ErrorMsg *string                   `thrift:"error_msg,8,optional" frugal:"8,optional,string" form:"error_msg" json:"error_msg,omitempty" query:"error_msg"`
+	BaseInfo *common.BaseInfo          `thrift:"base_info,9,optional" frugal:"9,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+}
+
+func NewEvaluationSetDedupJob() *EvaluationSetDedupJob {
+	return &EvaluationSetDedupJob{}
+}
+
+func (p *EvaluationSetDedupJob) InitDefault() {
+}
+
+func (p *EvaluationSetDedupJob) GetJobID() (v int64) {
+	if p != nil {
+		return p.JobID
+	}
+	return
+}
+
+func (p *EvaluationSetDedupJob) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
+	}
+	return
+}
+
+func (p *EvaluationSetDedupJob) GetEvaluationSetID() (v int64) {
+	if p != nil {
+		return p.EvaluationSetID
+	}
+	return
+}
+
+var EvaluationSetDedupJob_VersionID_DEFAULT int64
+
+func (p *EvaluationSetDedupJob) GetVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetVersionID() {
+		return EvaluationSetDedupJob_VersionID_DEFAULT
+	}
+	return *p.VersionID
+}
+
+func (p *EvaluationSetDedupJob) GetStatus() (v EvaluationSetDedupJobStatus) {
+	if p != nil {
+		return p.Status
+	}
+	return
+}
+
+var EvaluationSetDedupJob_Conf_DEFAULT *EvaluationSetDedupConf
+
+func (p *EvaluationSetDedupJob) GetConf() (v *EvaluationSetDedupConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetConf() {
+		return EvaluationSetDedupJob_Conf_DEFAULT
+	}
+	return p.Conf
+}
+
+var EvaluationSetDedupJob_Report_DEFAULT *EvaluationSetDedupReport
+
+func (p *EvaluationSetDedupJob) GetReport() (v *EvaluationSetDedupReport) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReport() {
+		return EvaluationSetDedupJob_Report_DEFAULT
+	}
+	return p.Report
+}
+
+var EvaluationSetDedupJob_ErrorMsg_DEFAULT string
+
+func (p *EvaluationSetDedupJob) GetErrorMsg() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetErrorMsg() {
+		return EvaluationSetDedupJob_ErrorMsg_DEFAULT
+	}
+	return *p.ErrorMsg
+}
+
+var EvaluationSetDedupJob_BaseInfo_DEFAULT *common.BaseInfo
+
+func (p *EvaluationSetDedupJob) GetBaseInfo() (v *common.BaseInfo) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseInfo() {
+		return EvaluationSetDedupJob_BaseInfo_DEFAULT
+	}
+	return p.BaseInfo
+}
+func (p *EvaluationSetDedupJob) SetJobID(val int64) {
+	p.JobID = val
+}
+func (p *EvaluationSetDedupJob) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
+}
+func (p *EvaluationSetDedupJob) SetEvaluationSetID(val int64) {
+	p.EvaluationSetID = val
+}
+func (p *EvaluationSetDedupJob) SetVersionID(val *int64) {
+	p.VersionID = val
+}
+func (p *EvaluationSetDedupJob) SetStatus(val EvaluationSetDedupJobStatus) {
+	p.Status = val
+}
+func (p *EvaluationSetDedupJob) SetConf(val *EvaluationSetDedupConf) {
+	p.Conf = val
+}
+func (p *EvaluationSetDedupJob) SetReport(val *EvaluationSetDedupReport) {
+	p.Report = val
+}
+func (p *EvaluationSetDedupJob) SetErrorMsg(val *string) {
+	p.ErrorMsg = val
+}
+func (p *EvaluationSetDedupJob) SetBaseInfo(val *common.BaseInfo) {
+	p.BaseInfo = val
+}
+
+var fieldIDToName_EvaluationSetDedupJob = map[int16]string{
+	1: "job_id",
+	2: "workspace_id",
+	3: "evaluation_set_id",
+	4: "version_id",
+	5: "status",
+	6: "conf",
+	7: "report",
+	8: "error_msg",
+	9: "base_info",
+}
+
+func (p *EvaluationSetDedupJob) IsSetVersionID() bool {
+	return p.VersionID != nil
+}
+
+func (p *EvaluationSetDedupJob) IsSetConf() bool {
+	return p.Conf != nil
+}
+
+func (p *EvaluationSetDedupJob) IsSetReport() bool {
+	return p.Report != nil
+}
+
+func (p *EvaluationSetDedupJob) IsSetErrorMsg() bool {
+	return p.ErrorMsg != nil
+}
+
+func (p *EvaluationSetDedupJob) IsSetBaseInfo() bool {
+	return p.BaseInfo != nil
+}
+
+func (p *EvaluationSetDedupJob) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetJobID bool = false
+	var issetWorkspaceID bool = false
+	var issetEvaluationSetID bool = false
+	var issetStatus bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetJobID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluationSetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStatus = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetJobID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluationSetID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStatus {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetDedupJob[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_EvaluationSetDedupJob[fieldId]))
+}
+
+func (p *EvaluationSetDedupJob) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.JobID = _field
+	return nil
+}
+func (p *EvaluationSetDedupJob) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *EvaluationSetDedupJob) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EvaluationSetID = _field
+	return nil
+}
+func (p *EvaluationSetDedupJob) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.VersionID = _field
+	return nil
+}
+func (p *EvaluationSetDedupJob) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field EvaluationSetDedupJobStatus
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *EvaluationSetDedupJob) ReadField6(iprot thrift.TProtocol) error {
+	_field := NewEvaluationSetDedupConf()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Conf = _field
+	return nil
+}
+func (p *EvaluationSetDedupJob) ReadField7(iprot thrift.TProtocol) error {
+	_field := NewEvaluationSetDedupReport()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Report = _field
+	return nil
+}
+func (p *EvaluationSetDedupJob) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ErrorMsg = _field
+	return nil
+}
+func (p *EvaluationSetDedupJob) ReadField9(iprot thrift.TProtocol) error {
+	_field := common.NewBaseInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseInfo = _field
+	return nil
+}
+
+func (p *EvaluationSetDedupJob) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluationSetDedupJob"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluationSetDedupJob) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.JobID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluationSetDedupJob) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluationSetDedupJob) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluation_set_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvaluationSetID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluationSetDedupJob) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersionID() {
+		if err = oprot.WriteFieldBegin("version_id", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.VersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluationSetDedupJob) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvaluationSetDedupJob) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConf() {
+		if err = oprot.WriteFieldBegin("conf", thrift.STRUCT, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Conf.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *EvaluationSetDedupJob) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReport() {
+		if err = oprot.WriteFieldBegin("report", thrift.STRUCT, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Report.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *EvaluationSetDedupJob) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrorMsg() {
+		if err = oprot.WriteFieldBegin("error_msg", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ErrorMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *EvaluationSetDedupJob) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseInfo() {
+		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *EvaluationSetDedupJob) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluationSetDedupJob(%+v)", *p)
+
+}
+
+func (p *EvaluationSetDedupJob) DeepEqual(ano *EvaluationSetDedupJob) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.JobID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.EvaluationSetID) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.VersionID) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Conf) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Report) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.ErrorMsg) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.BaseInfo) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluationSetDedupJob) Field1DeepEqual(src int64) bool {
+
+	if p.JobID != src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDedupJob) Field2DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDedupJob) Field3DeepEqual(src int64) bool {
+
+	if p.EvaluationSetID != src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDedupJob) Field4DeepEqual(src *int64) bool {
+
+	if p.VersionID == src {
+		return true
+	} else if p.VersionID == nil || src == nil {
+		return false
+	}
+	if *p.VersionID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDedupJob) Field5DeepEqual(src EvaluationSetDedupJobStatus) bool {
+
+	if strings.Compare(p.Status, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDedupJob) Field6DeepEqual(src *EvaluationSetDedupConf) bool {
+
+	if !p.Conf.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDedupJob) Field7DeepEqual(src *EvaluationSetDedupReport) bool {
+
+	if !p.Report.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDedupJob) Field8DeepEqual(src *string) bool {
+
+	if p.ErrorMsg == src {
+		return true
+	} else if p.ErrorMsg == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ErrorMsg, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetDedupJob) Field9DeepEqual(src *common.BaseInfo) bool {
+
+	if !p.BaseInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type EvaluationSetSynthesisConf struct {
+	ModelConfig *common.ModelConfig `thrift:"model_config,1,optional" frugal:"1,optional,common.ModelConfig" form:"model_config" json:"model_config,omitempty" query:"model_config"`
+	// 生成行数 (0, 200]
+	Count *int32 `thrift:"count,2,optional" frugal:"2,optional,i32" form:"count" json:"count,omitempty" query:"count"`
+	// 各批次轮流使用, 为空时仅改写
+	Strategies []EvaluationSetSynthesisStrategy `thrift:"strategies,3,optional" frugal:"3,optional,list<string>" form:"strategies" json:"strategies,omitempty" query:"strategies"`
+	// 需要生成的字段, 为空时生成全部文本字段
+	FieldNames []string `thrift:"field_names,4,optional" frugal:"4,optional,list<string>" form:"field_names" json:"field_names,omitempty" query:"field_names"`
+	// 附加的生成要求, 如领域、语言、难度
+	Instruction *string `thrift:"instruction,5,optional" frugal:"5,optional,string" form:"instruction" json:"instruction,omitempty" query:"instruction"`
+	// 单次模型调用生成的行数, 默认 10
+	BatchSize *int32 `thrift:"batch_size,6,optional" frugal:"6,optional,i32" form:"batch_size" json:"batch_size,omitempty" query:"batch_size"`
+}
+
+func NewEvaluationSetSynthesisConf() *EvaluationSetSynthesisConf {
+	return &EvaluationSetSynthesisConf{}
+}
+
+func (p *EvaluationSetSynthesisConf) InitDefault() {
+}
+
+var EvaluationSetSynthesisConf_ModelConfig_DEFAULT *common.ModelConfig
+
+func (p *EvaluationSetSynthesisConf) GetModelConfig() (v *common.ModelConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModelConfig() {
+		return EvaluationSetSynthesisConf_ModelConfig_DEFAULT
+	}
+	return p.ModelConfig
+}
+
+var EvaluationSetSynthesisConf_Count_DEFAULT int32
+
+func (p *EvaluationSetSynthesisConf) GetCount() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCount() {
+		return EvaluationSetSynthesisConf_Count_DEFAULT
+	}
+	return *p.Count
+}
+
+var EvaluationSetSynthesisConf_Strategies_DEFAULT []EvaluationSetSynthesisStrategy
+
+func (p *EvaluationSetSynthesisConf) GetStrategies() (v []EvaluationSetSynthesisStrategy) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStrategies() {
+		return EvaluationSetSynthesisConf_Strategies_DEFAULT
+	}
+	return p.Strategies
+}
+
+var EvaluationSetSynthesisConf_FieldNames_DEFAULT []string
+
+func (p *EvaluationSetSynthesisConf) GetFieldNames() (v []string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFieldNames() {
+		return EvaluationSetSynthesisConf_FieldNames_DEFAULT
+	}
+	return p.FieldNames
+}
+
+var EvaluationSetSynthesisConf_Instruction_DEFAULT string
+
+func (p *EvaluationSetSynthesisConf) GetInstruction() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetInstruction() {
+		return EvaluationSetSynthesisConf_Instruction_DEFAULT
+	}
+	return *p.Instruction
+}
+
+var EvaluationSetSynthesisConf_BatchSize_DEFAULT int32
+
+func (p *EvaluationSetSynthesisConf) GetBatchSize() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBatchSize() {
+		return EvaluationSetSynthesisConf_BatchSize_DEFAULT
+	}
+	return *p.BatchSize
+}
+func (p *EvaluationSetSynthesisConf) SetModelConfig(val *common.ModelConfig) {
+	p.ModelConfig = val
+}
+func (p *EvaluationSetSynthesisConf) SetCount(val *int32) {
+	p.Count = val
+}
+func (p *EvaluationSetSynthesisConf) SetStrategies(val []EvaluationSetSynthesisStrategy) {
+	p.Strategies = val
+}
+func (p *EvaluationSetSynthesisConf) SetFieldNames(val []string) {
+	p.FieldNames = val
+}
+func (p *EvaluationSetSynthesisConf) SetInstruction(val *string) {
+	p.Instruction = val
+}
+func (p *EvaluationSetSynthesisConf) SetBatchSize(val *int32) {
+	p.BatchSize = val
+}
+
+var fieldIDToName_EvaluationSetSynthesisConf = map[int16]string{
+	1: "model_config",
+	2: "count",
+	3: "strategies",
+	4: "field_names",
+	5: "instruction",
+	6: "batch_size",
+}
+
+func (p *EvaluationSetSynthesisConf) IsSetModelConfig() bool {
+	return p.ModelConfig != nil
+}
+
+func (p *EvaluationSetSynthesisConf) IsSetCount() bool {
+	return p.Count != nil
+}
+
+func (p *EvaluationSetSynthesisConf) IsSetStrategies() bool {
+	return p.Strategies != nil
+}
+
+func (p *EvaluationSetSynthesisConf) IsSetFieldNames() bool {
+	return p.FieldNames != nil
+}
+
+func (p *EvaluationSetSynthesisConf) IsSetInstruction() bool {
+	return p.Instruction != nil
+}
+
+func (p *EvaluationSetSynthesisConf) IsSetBatchSize() bool {
+	return p.BatchSize != nil
+}
+
+func (p *EvaluationSetSynthesisConf) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetSynthesisConf[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluationSetSynthesisConf) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewModelConfig()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ModelConfig = _field
+	return nil
+}
+func (p *EvaluationSetSynthesisConf) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Count = _field
+	return nil
+}
+func (p *EvaluationSetSynthesisConf) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]EvaluationSetSynthesisStrategy, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem EvaluationSetSynthesisStrategy
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Strategies = _field
+	return nil
+}
+func (p *EvaluationSetSynthesisConf) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FieldNames = _field
+	return nil
+}
+func (p *EvaluationSetSynthesisConf) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Instruction = _field
+	return nil
+}
+func (p *EvaluationSetSynthesisConf) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BatchSize = _field
+	return nil
+}
+
+func (p *EvaluationSetSynthesisConf) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluationSetSynthesisConf"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluationSetSynthesisConf) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelConfig() {
+		if err = oprot.WriteFieldBegin("model_config", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ModelConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluationSetSynthesisConf) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCount() {
+		if err = oprot.WriteFieldBegin("count", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Count); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluationSetSynthesisConf) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStrategies() {
+		if err = oprot.WriteFieldBegin("strategies", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Strategies)); err != nil {
+			return err
+		}
+		for _, v := range p.Strategies {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluationSetSynthesisConf) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldNames() {
+		if err = oprot.WriteFieldBegin("field_names", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.FieldNames)); err != nil {
+			return err
+		}
+		for _, v := range p.FieldNames {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluationSetSynthesisConf) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetInstruction() {
+		if err = oprot.WriteFieldBegin("instruction", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Instruction); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvaluationSetSynthesisConf) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBatchSize() {
+		if err = oprot.WriteFieldBegin("batch_size", thrift.I32, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.BatchSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *EvaluationSetSynthesisConf) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluationSetSynthesisConf(%+v)", *p)
+
+}
+
+func (p *EvaluationSetSynthesisConf) DeepEqual(ano *EvaluationSetSynthesisConf) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ModelConfig) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Count) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Strategies) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.FieldNames) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Instruction) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.BatchSize) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluationSetSynthesisConf) Field1DeepEqual(src *common.ModelConfig) bool {
+
+	if !p.ModelConfig.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetSynthesisConf) Field2DeepEqual(src *int32) bool {
+
+	if p.Count == src {
+		return true
+	} else if p.Count == nil || src == nil {
+		return false
+	}
+	if *p.Count != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetSynthesisConf) Field3DeepEqual(src []EvaluationSetSynthesisStrategy) bool {
+
+	if len(p.Strategies) != len(src) {
+		return false
+	}
+	for i, v := range p.Strategies {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluationSetSynthesisConf) Field4DeepEqual(src []string) bool {
+
+	if len(p.FieldNames) != len(src) {
+		return false
+	}
+	for i, v := range p.FieldNames {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluationSetSynthesisConf) Field5DeepEqual(src *string) bool {
+
+	if p.Instruction == src {
+		return true
+	} else if p.Instruction == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Instruction, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetSynthesisConf) Field6DeepEqual(src *int32) bool {
+
+	if p.BatchSize == src {
+		return true
+	} else if p.BatchSize == nil || src == nil {
+		return false
+	}
+	if *p.BatchSize != *src {
+		return false
+	}
+	return true
+}
+
+// 模型生成的行及其审核状态
+type GeneratedEvaluationSetItem struct {
+	Item *EvaluationSetItem `thrift:"item,1,optional" frugal:"1,optional,EvaluationSetItem" form:"item" json:"item,omitempty" query:"item"`
+	// 未标记时为空
+	ReviewStatus *GeneratedItemReviewStatus `thrift:"review_status,2,optional" frugal:"2,optional,string" form:"review_status" json:"review_status,omitempty" query:"review_status"`
+}
+
+func NewGeneratedEvaluationSetItem() *GeneratedEvaluationSetItem {
+	return &GeneratedEvaluationSetItem{}
+}
+
+func (p *GeneratedEvaluationSetItem) InitDefault() {
+}
+
+var GeneratedEvaluationSetItem_Item_DEFAULT *EvaluationSetItem
+
+func (p *GeneratedEvaluationSetItem) GetItem() (v *EvaluationSetItem) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetItem() {
+		return GeneratedEvaluationSetItem_Item_DEFAULT
+	}
+	return p.Item
+}
+
+var GeneratedEvaluationSetItem_ReviewStatus_DEFAULT GeneratedItemReviewStatus
+
+func (p *GeneratedEvaluationSetItem) GetReviewStatus() (v GeneratedItemReviewStatus) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReviewStatus() {
+		return GeneratedEvaluationSetItem_ReviewStatus_DEFAULT
+	}
+	return *p.ReviewStatus
+}
+func (p *GeneratedEvaluationSetItem) SetItem(val *EvaluationSetItem) {
+	p.Item = val
+}
+func (p *GeneratedEvaluationSetItem) SetReviewStatus(val *GeneratedItemReviewStatus) {
+	p.ReviewStatus = val
+}
+
+var fieldIDToName_GeneratedEvaluationSetItem = map[int16]string{
+	1: "item",
+	2: "review_status",
+}
+
+func (p *GeneratedEvaluationSetItem) IsSetItem() bool {
+	return p.Item != nil
+}
+
+func (p *GeneratedEvaluationSetItem) IsSetReviewStatus() bool {
+	return p.ReviewStatus != nil
+}
+
+func (p *GeneratedEvaluationSetItem) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GeneratedEvaluationSetItem[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GeneratedEvaluationSetItem) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewEvaluationSetItem()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Item = _field
+	return nil
+}
+func (p *GeneratedEvaluationSetItem) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *GeneratedItemReviewStatus
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ReviewStatus = _field
+	return nil
+}
+
+func (p *GeneratedEvaluationSetItem) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GeneratedEvaluationSetItem"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GeneratedEvaluationSetItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItem() {
+		if err = oprot.WriteFieldBegin("item", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Item.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GeneratedEvaluationSetItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReviewStatus() {
+		if err = oprot.WriteFieldBegin("review_status", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ReviewStatus); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GeneratedEvaluationSetItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GeneratedEvaluationSetItem(%+v)", *p)
+
+}
+
+func (p *GeneratedEvaluationSetItem) DeepEqual(ano *GeneratedEvaluationSetItem) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Item) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ReviewStatus) {
+		return false
+	}
+	return true
+}
+
+func (p *GeneratedEvaluationSetItem) Field1DeepEqual(src *EvaluationSetItem) bool {
+
+	if !p.Item.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *GeneratedEvaluationSetItem) Field2DeepEqual(src *GeneratedItemReviewStatus) bool {
+
+	if p.ReviewStatus == src {
+		return true
+	} else if p.ReviewStatus == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ReviewStatus, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+// 评测集行的来源信息, item_id 跨版本稳定, 来源对草稿与各版本通用
+type EvaluationSetItemProvenance struct {
+	ItemID     *int64                       `thrift:"item_id,1,optional" frugal:"1,optional,i64" json:"item_id" form:"item_id" query:"item_id"`
+	SourceType *EvaluationSetItemSourceType `thrift:"source_type,2,optional" frugal:"2,optional,string" form:"source_type" json:"source_type,omitempty" query:"source_type"`
+	// trace 导出时的来源 span
+	TraceID *string `thrift:"trace_id,3,optional" frugal:"3,optional,string" form:"trace_id" json:"trace_id,omitempty" query:"trace_id"`
+	SpanID  *string `thrift:"span_id,4,optional" frugal:"4,optional,string" form:"span_id" json:"span_id,omitempty" query:"span_id"`
+	// 文件导入任务
+	ImportJobID *int64 `thrift:"import_job_id,5,optional" frugal:"5,optional,i64" json:"import_job_id" form:"import_job_id" query:"import_job_id"`
+	// 由实验结果回流时的来源实验
+	SourceExptID *int64 `thrift:"source_expt_id,6,optional" frugal:"6,optional,i64" json:"source_expt_id" form:"source_expt_id" query:"source_expt_id"`
+	// 由版本合并写入时的来源版本
+	SourceVersionID *int64 `thrift:"source_version_id,7,optional" frugal:"7,optional,i64" json:"source_version_id" form:"source_version_id" query:"source_version_id"`
+	// 创建者
+	Author *string `thrift:"author,8,optional" frugal:"8,optional,string" form:"author" json:"author,omitempty" query:"author"`
+	// 最后编辑者, 编辑不改变来源
+	UpdatedBy *string `thrift:"updated_by,9,optional" frugal:"9,optional,string" form:"updated_by" json:"updated_by,omitempty" query:"updated_by"`
+	CreatedAt *int64  `thrift:"created_at,10,optional" frugal:"10,optional,i64" json:"created_at" form:"created_at" query:"created_at"`
+	UpdatedAt *int64  `thrift:"updated_at,11,optional" frugal:"11,optional,i64" json:"updated_at" form:"updated_at" query:"updated_at"`
+}
+
+func NewEvaluationSetItemProvenance() *EvaluationSetItemProvenance {
+	return &EvaluationSetItemProvenance{}
+}
+
+func (p *EvaluationSetItemProvenance) InitDefault() {
+}
+
+var EvaluationSetItemProvenance_ItemID_DEFAULT int64
+
+func (p *EvaluationSetItemProvenance) GetItemID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetItemID() {
+		return EvaluationSetItemProvenance_ItemID_DEFAULT
+	}
+	return *p.ItemID
+}
+
+var EvaluationSetItemProvenance_SourceType_DEFAULT EvaluationSetItemSourceType
+
+func (p *EvaluationSetItemProvenance) GetSourceType() (v EvaluationSetItemSourceType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSourceType() {
+		return EvaluationSetItemProvenance_SourceType_DEFAULT
+	}
+	return *p.SourceType
+}
+
+var EvaluationSetItemProvenance_TraceID_DEFAULT string
+
+func (p *EvaluationSetItemProvenance) GetTraceID() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTraceID() {
+		return EvaluationSetItemProvenance_TraceID_DEFAULT
+	}
+	return *p.TraceID
+}
+
+var EvaluationSetItemProvenance_SpanID_DEFAULT string
+
+func (p *EvaluationSetItemProvenance) GetSpanID() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSpanID() {
+		return EvaluationSetItemProvenance_SpanID_DEFAULT
+	}
+	return *p.SpanID
+}
+
+var EvaluationSetItemProvenance_ImportJobID_DEFAULT int64
+
+func (p *EvaluationSetItemProvenance) GetImportJobID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetImportJobID() {
+		return EvaluationSetItemProvenance_ImportJobID_DEFAULT
+	}
+	return *p.ImportJobID
+}
+
+var EvaluationSetItemProvenance_SourceExptID_DEFAULT int64
+
+func (p *EvaluationSetItemProvenance) GetSourceExptID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSourceExptID() {
+		return EvaluationSetItemProvenance_SourceExptID_DEFAULT
+	}
+	return *p.SourceExptID
+}
+
+var EvaluationSetItemProvenance_SourceVersionID_DEFAULT int64
+
+func (p *EvaluationSetItemProvenance) GetSourceVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSourceVersionID() {
+		return EvaluationSetItemProvenance_SourceVersionID_DEFAULT
+	}
+	return *p.SourceVersionID
+}
+
+var EvaluationSetItemProvenance_Author_DEFAULT string
+
+func (p *EvaluationSetItemProvenance) GetAuthor() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAuthor() {
+		return EvaluationSetItemProvenance_Author_DEFAULT
+	}
+	return *p.Author
+}
+
+var EvaluationSetItemProvenance_UpdatedBy_DEFAULT string
+
+func (p *EvaluationSetItemProvenance) GetUpdatedBy() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUpdatedBy() {
+		return EvaluationSetItemProvenance_UpdatedBy_DEFAULT
+	}
+	return *p.UpdatedBy
+}
+
+var EvaluationSetItemProvenance_CreatedAt_DEFAULT int64
+
+func (p *EvaluationSetItemProvenance) GetCreatedAt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCreatedAt() {
+		return EvaluationSetItemProvenance_CreatedAt_DEFAULT
+	}
+	return *p.CreatedAt
+}
+
+var EvaluationSetItemProvenance_UpdatedAt_DEFAULT int64
+
+func (p *EvaluationSetItemProvenance) GetUpdatedAt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUpdatedAt() {
+		return EvaluationSetItemProvenance_UpdatedAt_DEFAULT
+	}
+	return *p.UpdatedAt
+}
+func (p *EvaluationSetItemProvenance) SetItemID(val *int64) {
+	p.ItemID = val
+}
+func (p *EvaluationSetItemProvenance) SetSourceType(val *EvaluationSetItemSourceType) {
+	p.SourceType = val
+}
+func (p *EvaluationSetItemProvenance) SetTraceID(val *string) {
+	p.TraceID = val
+}
+func (p *EvaluationSetItemProvenance) SetSpanID(val *string) {
+	p.SpanID = val
+}
+func (p *EvaluationSetItemProvenance) SetImportJobID(val *int64) {
+	p.ImportJobID = val
+}
+func (p *EvaluationSetItemProvenance) SetSourceExptID(val *int64) {
+	p.SourceExptID = val
+}
+func (p *EvaluationSetItemProvenance) SetSourceVersionID(val *int64) {
+	p.SourceVersionID = val
+}
+func (p *EvaluationSetItemProvenance) SetAuthor(val *string) {
+	p.Author = val
+}
+func (p *EvaluationSetItemProvenance) SetUpdatedBy(val *string) {
+	p.UpdatedBy = val
+}
+func (p *EvaluationSetItemProvenance) SetCreatedAt(val *int64) {
+	p.CreatedAt = val
+}
+func (p *EvaluationSetItemProvenance) SetUpdatedAt(val *int64) {
+	p.UpdatedAt = val
+}
+
+var fieldIDToName_EvaluationSetItemProvenance = map[int16]string{
+	1:  "item_id",
+	2:  "source_type",
+	3:  "trace_id",
+	4:  "span_id",
+	5:  "import_job_id",
+	6:  "source_expt_id",
+	7:  "source_version_id",
+	8:  "author",
+	9:  "updated_by",
+	10: "created_at",
+	11: "updated_at",
+}
+
+func (p *EvaluationSetItemProvenance) IsSetItemID() bool {
+	return p.ItemID != nil
+}
+
+func (p *EvaluationSetItemProvenance) IsSetSourceType() bool {
+	return p.SourceType != nil
+}
+
+func (p *EvaluationSetItemProvenance) IsSetTraceID() bool {
+	return p.TraceID != nil
+}
+
+func (p *EvaluationSetItemProvenance) IsSetSpanID() bool {
+	return p.SpanID != nil
+}
+
+func (p *EvaluationSetItemProvenance) IsSetImportJobID() bool {
+	return p.ImportJobID != nil
+}
+
+func (p *EvaluationSetItemProvenance) IsSetSourceExptID() bool {
+	return p.SourceExptID != nil
+}
+
+func (p *EvaluationSetItemProvenance) IsSetSourceVersionID() bool {
+	return p.SourceVersionID != nil
+}
+
+func (p *EvaluationSetItemProvenance) IsSetAuthor() bool {
+	return p.Author != nil
+}
+
+func (p *EvaluationSetItemProvenance) IsSetUpdatedBy() bool {
+	return p.UpdatedBy != nil
+}
+
+func (p *EvaluationSetItemProvenance) IsSetCreatedAt() bool {
+	return p.CreatedAt != nil
+}
+
+func (p *EvaluationSetItemProvenance) IsSetUpdatedAt() bool {
+	return p.UpdatedAt != nil
+}
+
+func (p *EvaluationSetItemProvenance) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetItemProvenance[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluationSetItemProvenance) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ItemID = _field
+	return nil
+}
+func (p *EvaluationSetItemProvenance) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *EvaluationSetItemSourceType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SourceType = _field
+	return nil
+}
+func (p *EvaluationSetItemProvenance) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TraceID = _field
+	return nil
+}
+func (p *EvaluationSetItemProvenance) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SpanID = _field
+	return nil
+}
+func (p *EvaluationSetItemProvenance) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ImportJobID = _field
+	return nil
+}
+func (p *EvaluationSetItemProvenance) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SourceExptID = _field
+	return nil
+}
+func (p *EvaluationSetItemProvenance) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SourceVersionID = _field
+	return nil
+}
+func (p *EvaluationSetItemProvenance) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Author = _field
+	return nil
+}
+func (p *EvaluationSetItemProvenance) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UpdatedBy = _field
+	return nil
+}
+func (p *EvaluationSetItemProvenance) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *EvaluationSetItemProvenance) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+
+func (p *EvaluationSetItemProvenance) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluationSetItemProvenance"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluationSetItemProvenance) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItemID() {
+		if err = oprot.WriteFieldBegin("item_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ItemID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluationSetItemProvenance) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSourceType() {
+		if err = oprot.WriteFieldBegin("source_type", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SourceType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluationSetItemProvenance) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTraceID() {
+		if err = oprot.WriteFieldBegin("trace_id", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TraceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluationSetItemProvenance) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSpanID() {
+		if err = oprot.WriteFieldBegin("span_id", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SpanID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluationSetItemProvenance) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetImportJobID() {
+		if err = oprot.WriteFieldBegin("import_job_id", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ImportJobID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvaluationSetItemProvenance) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSourceExptID() {
+		if err = oprot.WriteFieldBegin("source_expt_id", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.SourceExptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *EvaluationSetItemProvenance) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSourceVersionID() {
+		if err = oprot.WriteFieldBegin("source_version_id", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.SourceVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *EvaluationSetItemProvenance) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAuthor() {
+		if err = oprot.WriteFieldBegin("author", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Author); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *EvaluationSetItemProvenance) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUpdatedBy() {
+		if err = oprot.WriteFieldBegin("updated_by", thrift.STRING, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UpdatedBy); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *EvaluationSetItemProvenance) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCreatedAt() {
+		if err = oprot.WriteFieldBegin("created_at", thrift.I64, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.CreatedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *EvaluationSetItemProvenance) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUpdatedAt() {
+		if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UpdatedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
+func (p *EvaluationSetItemProvenance) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluationSetItemProvenance(%+v)", *p)
+
+}
+
+func (p *EvaluationSetItemProvenance) DeepEqual(ano *EvaluationSetItemProvenance) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ItemID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.SourceType) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.TraceID) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.SpanID) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.ImportJobID) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.SourceExptID) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.SourceVersionID) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.Author) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.UpdatedBy) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.CreatedAt) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.UpdatedAt) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluationSetItemProvenance) Field1DeepEqual(src *int64) bool {
+
+	if p.ItemID == src {
+		return true
+	} else if p.ItemID == nil || src == nil {
+		return false
+	}
+	if *p.ItemID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetItemProvenance) Field2DeepEqual(src *EvaluationSetItemSourceType) bool {
+
+	if p.SourceType == src {
+		return true
+	} else if p.SourceType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.SourceType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetItemProvenance) Field3DeepEqual(src *string) bool {
+
+	if p.TraceID == src {
+		return true
+	} else if p.TraceID == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.TraceID, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetItemProvenance) Field4DeepEqual(src *string) bool {
+
+	if p.SpanID == src {
+		return true
+	} else if p.SpanID == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.SpanID, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetItemProvenance) Field5DeepEqual(src *int64) bool {
+
+	if p.ImportJobID == src {
+		return true
+	} else if p.ImportJobID == nil || src == nil {
+		return false
+	}
+	if *p.ImportJobID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetItemProvenance) Field6DeepEqual(src *int64) bool {
+
+	if p.SourceExptID == src {
+		return true
+	} else if p.SourceExptID == nil || src == nil {
+		return false
+	}
+	if *p.SourceExptID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetItemProvenance) Field7DeepEqual(src *int64) bool {
+
+	if p.SourceVersionID == src {
+		return true
+	} else if p.SourceVersionID == nil || src == nil {
+		return false
+	}
+	if *p.SourceVersionID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetItemProvenance) Field8DeepEqual(src *string) bool {
+
+	if p.Author == src {
+		return true
+	} else if p.Author == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Author, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetItemProvenance) Field9DeepEqual(src *string) bool {
+
+	if p.UpdatedBy == src {
+		return true
+	} else if p.UpdatedBy == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.UpdatedBy, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetItemProvenance) Field10DeepEqual(src *int64) bool {
+
+	if p.CreatedAt == src {
+		return true
+	} else if p.CreatedAt == nil || src == nil {
+		return false
+	}
+	if *p.CreatedAt != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluationSetItemProvenance) Field11DeepEqual(src *int64) bool {
+
+	if p.UpdatedAt == src {
+		return true
+	} else if p.UpdatedAt == nil || src == nil {
+		return false
+	}
+	if *p.UpdatedAt != *src {
+		return false
+	}
+	return true
+}
+
+// 按来源筛选评测集行, 各条件之间为且, 条件内多值为或
+type EvaluationSetItemProvenanceFilter struct {
+	SourceTypes   []EvaluationSetItemSourceType `thrift:"source_types,1,optional" frugal:"1,optional,list<string>" form:"source_types" json:"source_types,omitempty" query:"source_types"`
+	TraceIds      []string                      `thrift:"trace_ids,2,optional" frugal:"2,optional,list<string>" form:"trace_ids" json:"trace_ids,omitempty" query:"trace_ids"`
+	ImportJobIds  []int64                       `thrift:"import_job_ids,3,optional" frugal:"3,optional,list<i64>" json:"import_job_ids" form:"import_job_ids" query:"import_job_ids"`
+	SourceExptIds []int64                       `thrift:"source_expt_ids,4,optional" frugal:"4,optional,list<i64>" json:"source_expt_ids" form:"source_expt_ids" query:"source_expt_ids"`
+	Authors       []string                      `thrift:"authors,5,optional" frugal:"5,optional,list<string>" form:"authors" json:"authors,omitempty" query:"authors"`
+}
+
+func NewEvaluationSetItemProvenanceFilter() *EvaluationSetItemProvenanceFilter {
+	return &EvaluationSetItemProvenanceFilter{}
+}
+
+func (p *EvaluationSetItemProvenanceFilter) InitDefault() {
+}
+
+var EvaluationSetItemProvenanceFilter_SourceTypes_DEFAULT []EvaluationSetItemSourceType
+
+func (p *EvaluationSetItemProvenanceFilter) GetSourceTypes() (v []EvaluationSetItemSourceType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSourceTypes() {
+		return EvaluationSetItemProvenanceFilter_SourceTypes_DEFAULT
+	}
+	return p.SourceTypes
+}
+
+var EvaluationSetItemProvenanceFilter_TraceIds_DEFAULT []string
+
+func (p *EvaluationSetItemProvenanceFilter) GetTraceIds() (v []string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTraceIds() {
+		return EvaluationSetItemProvenanceFilter_TraceIds_DEFAULT
+	}
+	return p.TraceIds
+}
+
+var EvaluationSetItemProvenanceFilter_ImportJobIds_DEFAULT []int64
+
+func (p *EvaluationSetItemProvenanceFilter) GetImportJobIds() (v []int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetImportJobIds() {
+		return EvaluationSetItemProvenanceFilter_ImportJobIds_DEFAULT
+	}
+	return p.ImportJobIds
+}
+
+var EvaluationSetItemProvenanceFilter_SourceExptIds_DEFAULT []int64
+
+func (p *EvaluationSetItemProvenanceFilter) GetSourceExptIds() (v []int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSourceExptIds() {
+		return EvaluationSetItemProvenanceFilter_SourceExptIds_DEFAULT
+	}
+	return p.SourceExptIds
+}
+
+var EvaluationSetItemProvenanceFilter_Authors_DEFAULT []string
+
+func (p *EvaluationSetItemProvenanceFilter) GetAuthors() (v []string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAuthors() {
+		return EvaluationSetItemProvenanceFilter_Authors_DEFAULT
+	}
+	return p.Authors
+}
+func (p *EvaluationSetItemProvenanceFilter) SetSourceTypes(val []EvaluationSetItemSourceType) {
+	p.SourceTypes = val
+}
+func (p *EvaluationSetItemProvenanceFilter) SetTraceIds(val []string) {
+	p.TraceIds = val
+}
+func (p *EvaluationSetItemProvenanceFilter) SetImportJobIds(val []int64) {
+	p.ImportJobIds = val
+}
+func (p *EvaluationSetItemProvenanceFilter) SetSourceExptIds(val []int64) {
+	p.SourceExptIds = val
+}
+func (p *EvaluationSetItemProvenanceFilter) SetAuthors(val []string) {
+	p.Authors = val
+}
+
+var fieldIDToName_EvaluationSetItemProvenanceFilter = map[int16]string{
+	1: "source_types",
+	2: "trace_ids",
+	3: "import_job_ids",
+	4: "source_expt_ids",
+	5: "authors",
+}
+
+func (p *EvaluationSetItemProvenanceFilter) IsSetSourceTypes() bool {
+	return p.SourceTypes != nil
+}
+
+func (p *EvaluationSetItemProvenanceFilter) IsSetTraceIds() bool {
+	return p.TraceIds != nil
+}
+
+func (p *EvaluationSetItemProvenanceFilter) IsSetImportJobIds() bool {
+	return p.ImportJobIds != nil
+}
+
+func (p *EvaluationSetItemProvenanceFilter) IsSetSourceExptIds() bool {
+	return p.SourceExptIds != nil
+}
+
+func (p *EvaluationSetItemProvenanceFilter) IsSetAuthors() bool {
+	return p.Authors != nil
+}
+
+func (p *EvaluationSetItemProvenanceFilter) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetItemProvenanceFilter[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluationSetItemProvenanceFilter) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]EvaluationSetItemSourceType, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem EvaluationSetItemSourceType
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.SourceTypes = _field
+	return nil
+}
+func (p *EvaluationSetItemProvenanceFilter) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.TraceIds = _field
+	return nil
+}
+func (p *EvaluationSetItemProvenanceFilter) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ImportJobIds = _field
+	return nil
+}
+func (p *EvaluationSetItemProvenanceFilter) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.SourceExptIds = _field
+	return nil
+}
+func (p *EvaluationSetItemProvenanceFilter) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Authors = _field
+	return nil
+}
+
+func (p *EvaluationSetItemProvenanceFilter) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluationSetItemProvenanceFilter"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluationSetItemProvenanceFilter) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSourceTypes() {
+		if err = oprot.WriteFieldBegin("source_types", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.SourceTypes)); err != nil {
+			return err
+		}
+		for _, v := range p.SourceTypes {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluationSetItemProvenanceFilter) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTraceIds() {
+		if err = oprot.WriteFieldBegin("trace_ids", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.TraceIds)); err != nil {
+			return err
+		}
+		for _, v := range p.TraceIds {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluationSetItemProvenanceFilter) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetImportJobIds() {
+		if err = oprot.WriteFieldBegin("import_job_ids", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I64, len(p.ImportJobIds)); err != nil {
+			return err
+		}
+		for _, v := range p.ImportJobIds {
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluationSetItemProvenanceFilter) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSourceExptIds() {
+		if err = oprot.WriteFieldBegin("source_expt_ids", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I64, len(p.SourceExptIds)); err != nil {
+			return err
+		}
+		for _, v := range p.SourceExptIds {
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluationSetItemProvenanceFilter) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAuthors() {
+		if err = oprot.WriteFieldBegin("authors", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Authors)); err != nil {
+			return err
+		}
+		for _, v := range p.Authors {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *EvaluationSetItemProvenanceFilter) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluationSetItemProvenanceFilter(%+v)", *p)
+
+}
+
+func (p *EvaluationSetItemProvenanceFilter) DeepEqual(ano *EvaluationSetItemProvenanceFilter) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.SourceTypes) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TraceIds) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ImportJobIds) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.SourceExptIds) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Authors) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluationSetItemProvenanceFilter) Field1DeepEqual(src []EvaluationSetItemSourceType) bool {
+
+	if len(p.SourceTypes) != len(src) {
+		return false
+	}
+	for i, v := range p.SourceTypes {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluationSetItemProvenanceFilter) Field2DeepEqual(src []string) bool {
+
+	if len(p.TraceIds) != len(src) {
+		return false
+	}
+	for i, v := range p.TraceIds {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluationSetItemProvenanceFilter) Field3DeepEqual(src []int64) bool {
+
+	if len(p.ImportJobIds) != len(src) {
+		return false
+	}
+	for i, v := range p.ImportJobIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluationSetItemProvenanceFilter) Field4DeepEqual(src []int64) bool {
+
+	if len(p.SourceExptIds) != len(src) {
+		return false
+	}
+	for i, v := range p.SourceExptIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluationSetItemProvenanceFilter) Field5DeepEqual(src []string) bool {
+
+	if len(p.Authors) != len(src) {
+		return false
+	}
+	for i, v := range p.Authors {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -122,6 +122,11 @@ func (p *EvaluationSetTemplate) IsValid() error {
 	return nil
 }
 func (p *EvaluationSetItem) IsValid() error {
+	if p.Provenance != nil {
+		if err := p.Provenance.IsValid(); err != nil {
+			return fmt.Errorf("field Provenance not valid, %w", err)
+		}
+	}
 	if p.BaseInfo != nil {
 		if err := p.BaseInfo.IsValid(); err != nil {
 			return fmt.Errorf("field BaseInfo not valid, %w", err)
@@ -156,3 +161,94 @@ func (p *FieldData) IsValid() error {
 	}
 	return nil
 }
+func (p *EvaluationSetSchemaFieldDiff) IsValid() error {
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	if p.Target != nil {
+		if err := p.Target.IsValid(); err != nil {
+			return fmt.Errorf("field Target not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *EvaluationSetFieldDiff) IsValid() error {
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	if p.Target != nil {
+		if err := p.Target.IsValid(); err != nil {
+			return fmt.Errorf("field Target not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *EvaluationSetItemDiff) IsValid() error {
+	if p.BaseItem != nil {
+		if err := p.BaseItem.IsValid(); err != nil {
+			return fmt.Errorf("field BaseItem not valid, %w", err)
+		}
+	}
+	if p.TargetItem != nil {
+		if err := p.TargetItem.IsValid(); err != nil {
+			return fmt.Errorf("field TargetItem not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *EvaluationSetVersionDiff) IsValid() error {
+	return nil
+}
+func (p *EvaluationSetDedupConf) IsValid() error {
+	return nil
+}
+func (p *EvaluationSetDuplicateCluster) IsValid() error {
+	return nil
+}
+func (p *EvaluationSetDedupReport) IsValid() error {
+	return nil
+}
+func (p *EvaluationSetDedupJob) IsValid() error {
+	if p.Conf != nil {
+		if err := p.Conf.IsValid(); err != nil {
+			return fmt.Errorf("field Conf not valid, %w", err)
+		}
+	}
+	if p.Report != nil {
+		if err := p.Report.IsValid(); err != nil {
+			return fmt.Errorf("field Report not valid, %w", err)
+		}
+	}
+	if p.BaseInfo != nil {
+		if err := p.BaseInfo.IsValid(); err != nil {
+			return fmt.Errorf("field BaseInfo not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *EvaluationSetSynthesisConf) IsValid() error {
+	if p.ModelConfig != nil {
+		if err := p.ModelConfig.IsValid(); err != nil {
+			return fmt.Errorf("field ModelConfig not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GeneratedEvaluationSetItem) IsValid() error {
+	if p.Item != nil {
+		if err := p.Item.IsValid(); err != nil {
+			return fmt.Errorf("field Item not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *EvaluationSetItemProvenance) IsValid() error {
+	return nil
+}
+func (p *EvaluationSetItemProvenanceFilter) IsValid() error {
+	return nil
+}
@@ -52,6 +52,34 @@ const (
 
 	EvaluatorVersionTypeBuiltinVisible = "BuiltinVisible"
 
+	RuleTypeExactMatch = "exact_match"
+
+	RuleTypeRegexMatch = "regex_match"
+
+	RuleTypeJSONSchema = "json_schema"
+
+	RuleTypeContains = "contains"
+
+	RuleTypeNumericTolerance = "numeric_tolerance"
+
+	RuleTypeLevenshtein = "levenshtein"
+
+	RuleTypeRougeL = "rouge_l"
+
+	RuleTypeBLEU = "bleu"
+
+	CompositeStrategyMean = "mean"
+
+	CompositeStrategyMedian = "median"
+
+	CompositeStrategyMajority = "majority"
+
+	CompositeStrategyMin = "min"
+
+	CompositeStrategyWeighted = "weighted"
+
+	CompositeStrategyCode = "code"
+
 	EvaluatorFilterLogicOpUnknown = "Unknown"
 
 	EvaluatorFilterLogicOpAnd = "And"
@@ -81,6 +109,26 @@ const (
 	HTTPMethodGet = "get"
 
 	HTTPMethodPost = "post"
+
+	EvaluatorCalibrationStatusUnknown = "Unknown"
+
+	EvaluatorCalibrationStatusRunning = "Running"
+
+	EvaluatorCalibrationStatusSuccess = "Success"
+
+	EvaluatorCalibrationStatusFailed = "Failed"
+
+	EvaluatorPromotionActionWarn = "Warn"
+
+	EvaluatorPromotionActionBlock = "Block"
+
+	EvaluatorPromotionVerdictPass = "Pass"
+
+	EvaluatorPromotionVerdictWarn = "Warn"
+
+	EvaluatorPromotionVerdictBlock = "Block"
+
+	EvaluatorPromotionVerdictSkipped = "Skipped"
 )
 
 type EvaluatorType int64
@@ -90,6 +138,12 @@ const (
 	EvaluatorType_Code      EvaluatorType = 2
 	EvaluatorType_CustomRPC EvaluatorType = 3
 	EvaluatorType_Agent     EvaluatorType = 4
+	// 成对 (A-vs-B) 评估器, 同时接收两个实验的输出并给出偏好
+	EvaluatorType_Pairwise EvaluatorType = 5
+	// 规则评估器, 在服务进程内按声明式规则计算
+	EvaluatorType_Rule EvaluatorType = 6
+	// 组合评估器, 合并多个组件评估器版本的得分
+	EvaluatorType_Composite EvaluatorType = 7
 )
 
 func (p EvaluatorType) String() string {
@@ -102,6 +156,12 @@ func (p EvaluatorType) String() string {
 		return "CustomRPC"
 	case EvaluatorType_Agent:
 		return "Agent"
+	case EvaluatorType_Pairwise:
+		return "Pairwise"
+	case EvaluatorType_Rule:
+		return "Rule"
+	case EvaluatorType_Composite:
+		return "Composite"
 	}
 	return "<UNSET>"
 }
@@ -116,6 +176,12 @@ func EvaluatorTypeFromString(s string) (EvaluatorType, error) {
 		return EvaluatorType_CustomRPC, nil
 	case "Agent":
 		return EvaluatorType_Agent, nil
+	case "Pairwise":
+		return EvaluatorType_Pairwise, nil
+	case "Rule":
+		return EvaluatorType_Rule, nil
+	case "Composite":
+		return EvaluatorType_Composite, nil
 	}
 	return EvaluatorType(0), fmt.Errorf("not a valid EvaluatorType string")
 }
@@ -337,6 +403,10 @@ type EvaluatorAccessProtocol = string
 
 type EvaluatorVersionType = string
 
+type RuleType = string
+
+type CompositeStrategy = string
+
 // 筛选逻辑操作符
 type EvaluatorFilterLogicOp = string
 
@@ -347,6 +417,13 @@ type EvaluatorExtraOutputType = string
 
 type EvaluatorHTTPMethod = string
 
+type EvaluatorCalibrationStatus = string
+
+// 版本提交前回归检查未通过时的处理方式
+type EvaluatorPromotionAction = string
+
+type EvaluatorPromotionVerdict = string
+
 type Tool struct {
 	Type     ToolType  `thrift:"type,1" frugal:"1,default,ToolType" mapstructure:"type" form:"type" json:"type" query:"type"`
 	Function *Function `thrift:"function,2,optional" frugal:"2,optional,Function" mapstructure:"function" form:"function" json:"function,omitempty" query:"function"`
@@ -3890,126 +3967,85 @@ func (p *CustomRPCEvaluator) Field13DeepEqual(src *bool) bool {
 	return true
 }
 
-type EvaluatorVersion struct {
-	// 版本id
-	ID               *int64            `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id" form:"id" query:"id"`
-	Version          *string           `thrift:"version,3,optional" frugal:"3,optional,string" form:"version" json:"version,omitempty" query:"version"`
-	Description      *string           `thrift:"description,4,optional" frugal:"4,optional,string" form:"description" json:"description,omitempty" query:"description"`
-	BaseInfo         *common.BaseInfo  `thrift:"base_info,5,optional" frugal:"5,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
-	EvaluatorContent *EvaluatorContent `thrift:"evaluator_content,6,optional" frugal:"6,optional,EvaluatorContent" form:"evaluator_content" json:"evaluator_content,omitempty" query:"evaluator_content"`
-}
-
-func NewEvaluatorVersion() *EvaluatorVersion {
-	return &EvaluatorVersion{}
-}
-
-func (p *EvaluatorVersion) InitDefault() {
+// 成对评估器, judge prompt 中以 {{output_a}} / {{output_b}} 引用两侧输出, 模型须输出 {"preference": "A"|"B"|"tie", "reason": "..."}
+type PairwiseEvaluator struct {
+	MessageList []*common.Message   `thrift:"message_list,1,optional" frugal:"1,optional,list<common.Message>" form:"message_list" json:"message_list,omitempty" query:"message_list"`
+	ModelConfig *common.ModelConfig `thrift:"model_config,2,optional" frugal:"2,optional,common.ModelConfig" form:"model_config" json:"model_config,omitempty" query:"model_config"`
+	// 默认交换 A/B 位置再判一次, 两次不一致记为平局; 置 true 只判一次
+	DisablePositionSwap *bool `thrift:"disable_position_swap,3,optional" frugal:"3,optional,bool" form:"disable_position_swap" json:"disable_position_swap,omitempty" query:"disable_position_swap"`
 }
 
-var EvaluatorVersion_ID_DEFAULT int64
-
-func (p *EvaluatorVersion) GetID() (v int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetID() {
-		return EvaluatorVersion_ID_DEFAULT
-	}
-	return *p.ID
+func NewPairwiseEvaluator() *PairwiseEvaluator {
+	return &PairwiseEvaluator{}
 }
 
-var EvaluatorVersion_Version_DEFAULT string
-
-func (p *EvaluatorVersion) GetVersion() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetVersion() {
-		return EvaluatorVersion_Version_DEFAULT
-	}
-	return *p.Version
+func (p *PairwiseEvaluator) InitDefault() {
 }
 
-var EvaluatorVersion_Description_DEFAULT string
+var PairwiseEvaluator_MessageList_DEFAULT []*common.Message
 
-func (p *EvaluatorVersion) GetDescription() (v string) {
+func (p *PairwiseEvaluator) GetMessageList() (v []*common.Message) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDescription() {
-		return EvaluatorVersion_Description_DEFAULT
+	if !p.IsSetMessageList() {
+		return PairwiseEvaluator_MessageList_DEFAULT
 	}
-	return *p.Description
+	return p.MessageList
 }
 
-var EvaluatorVersion_BaseInfo_DEFAULT *common.BaseInfo
+var PairwiseEvaluator_ModelConfig_DEFAULT *common.ModelConfig
 
-func (p *EvaluatorVersion) GetBaseInfo() (v *common.BaseInfo) {
+func (p *PairwiseEvaluator) GetModelConfig() (v *common.ModelConfig) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetBaseInfo() {
-		return EvaluatorVersion_BaseInfo_DEFAULT
+	if !p.IsSetModelConfig() {
+		return PairwiseEvaluator_ModelConfig_DEFAULT
 	}
-	return p.BaseInfo
+	return p.ModelConfig
 }
 
-var EvaluatorVersion_EvaluatorContent_DEFAULT *EvaluatorContent
+var PairwiseEvaluator_DisablePositionSwap_DEFAULT bool
 
-func (p *EvaluatorVersion) GetEvaluatorContent() (v *EvaluatorContent) {
+func (p *PairwiseEvaluator) GetDisablePositionSwap() (v bool) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEvaluatorContent() {
-		return EvaluatorVersion_EvaluatorContent_DEFAULT
+	if !p.IsSetDisablePositionSwap() {
+		return PairwiseEvaluator_DisablePositionSwap_DEFAULT
 	}
-	return p.EvaluatorContent
-}
-func (p *EvaluatorVersion) SetID(val *int64) {
-	p.ID = val
-}
-func (p *EvaluatorVersion) SetVersion(val *string) {
-	p.Version = val
-}
-func (p *EvaluatorVersion) SetDescription(val *string) {
-	p.Description = val
+	return *p.DisablePositionSwap
 }
-func (p *EvaluatorVersion) SetBaseInfo(val *common.BaseInfo) {
-	p.BaseInfo = val
-}
-func (p *EvaluatorVersion) SetEvaluatorContent(val *EvaluatorContent) {
-	p.EvaluatorContent = val
+func (p *PairwiseEvaluator) SetMessageList(val []*common.Message) {
+	p.MessageList = val
 }
-
-var fieldIDToName_EvaluatorVersion = map[int16]string{
-	1: "id",
-	3: "version",
-	4: "description",
-	5: "base_info",
-	6: "evaluator_content",
+func (p *PairwiseEvaluator) SetModelConfig(val *common.ModelConfig) {
+	p.ModelConfig = val
 }
-
-func (p *EvaluatorVersion) IsSetID() bool {
-	return p.ID != nil
+func (p *PairwiseEvaluator) SetDisablePositionSwap(val *bool) {
+	p.DisablePositionSwap = val
 }
 
-func (p *EvaluatorVersion) IsSetVersion() bool {
-	return p.Version != nil
+var fieldIDToName_PairwiseEvaluator = map[int16]string{
+	1: "message_list",
+	2: "model_config",
+	3: "disable_position_swap",
 }
 
-func (p *EvaluatorVersion) IsSetDescription() bool {
-	return p.Description != nil
+func (p *PairwiseEvaluator) IsSetMessageList() bool {
+	return p.MessageList != nil
 }
 
-func (p *EvaluatorVersion) IsSetBaseInfo() bool {
-	return p.BaseInfo != nil
+func (p *PairwiseEvaluator) IsSetModelConfig() bool {
+	return p.ModelConfig != nil
 }
 
-func (p *EvaluatorVersion) IsSetEvaluatorContent() bool {
-	return p.EvaluatorContent != nil
+func (p *PairwiseEvaluator) IsSetDisablePositionSwap() bool {
+	return p.DisablePositionSwap != nil
 }
 
-func (p *EvaluatorVersion) Read(iprot thrift.TProtocol) (err error) {
+func (p *PairwiseEvaluator) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4028,40 +4064,24 @@ func (p *EvaluatorVersion) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
+		case 2:
 			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField5(iprot); err != nil {
+				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 6:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField6(iprot); err != nil {
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -4086,7 +4106,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorVersion[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PairwiseEvaluator[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4096,59 +4116,52 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorVersion) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *PairwiseEvaluator) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.ID = _field
-	return nil
-}
-func (p *EvaluatorVersion) ReadField3(iprot thrift.TProtocol) error {
+	_field := make([]*common.Message, 0, size)
+	values := make([]common.Message, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Version = _field
-	return nil
-}
-func (p *EvaluatorVersion) ReadField4(iprot thrift.TProtocol) error {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Description = _field
+	p.MessageList = _field
 	return nil
 }
-func (p *EvaluatorVersion) ReadField5(iprot thrift.TProtocol) error {
-	_field := common.NewBaseInfo()
+func (p *PairwiseEvaluator) ReadField2(iprot thrift.TProtocol) error {
+	_field := common.NewModelConfig()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.BaseInfo = _field
+	p.ModelConfig = _field
 	return nil
 }
-func (p *EvaluatorVersion) ReadField6(iprot thrift.TProtocol) error {
-	_field := NewEvaluatorContent()
-	if err := _field.Read(iprot); err != nil {
+func (p *PairwiseEvaluator) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.EvaluatorContent = _field
+	p.DisablePositionSwap = _field
 	return nil
 }
 
-func (p *EvaluatorVersion) Write(oprot thrift.TProtocol) (err error) {
+func (p *PairwiseEvaluator) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorVersion"); err != nil {
+	if err = oprot.WriteStructBegin("PairwiseEvaluator"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4156,20 +4169,12 @@ func (p *EvaluatorVersion) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
 			goto WriteFieldError
 		}
 	}
@@ -4190,12 +4195,20 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorVersion) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetID() {
-		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *PairwiseEvaluator) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMessageList() {
+		if err = oprot.WriteFieldBegin("message_list", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.ID); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.MessageList)); err != nil {
+			return err
+		}
+		for _, v := range p.MessageList {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4208,12 +4221,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorVersion) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetVersion() {
-		if err = oprot.WriteFieldBegin("version", thrift.STRING, 3); err != nil {
+func (p *PairwiseEvaluator) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelConfig() {
+		if err = oprot.WriteFieldBegin("model_config", thrift.STRUCT, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Version); err != nil {
+		if err := p.ModelConfig.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4222,16 +4235,16 @@ func (p *EvaluatorVersion) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *EvaluatorVersion) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDescription() {
-		if err = oprot.WriteFieldBegin("description", thrift.STRING, 4); err != nil {
+func (p *PairwiseEvaluator) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDisablePositionSwap() {
+		if err = oprot.WriteFieldBegin("disable_position_swap", thrift.BOOL, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Description); err != nil {
+		if err := oprot.WriteBool(*p.DisablePositionSwap); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4240,292 +4253,368 @@ func (p *EvaluatorVersion) writeField4(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *EvaluatorVersion) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBaseInfo() {
-		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.BaseInfo.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-func (p *EvaluatorVersion) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorContent() {
-		if err = oprot.WriteFieldBegin("evaluator_content", thrift.STRUCT, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.EvaluatorContent.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *EvaluatorVersion) String() string {
+func (p *PairwiseEvaluator) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorVersion(%+v)", *p)
+	return fmt.Sprintf("PairwiseEvaluator(%+v)", *p)
 
 }
 
-func (p *EvaluatorVersion) DeepEqual(ano *EvaluatorVersion) bool {
+func (p *PairwiseEvaluator) DeepEqual(ano *PairwiseEvaluator) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ID) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.Version) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.Description) {
+	if !p.Field1DeepEqual(ano.MessageList) {
 		return false
 	}
-	if !p.Field5DeepEqual(ano.BaseInfo) {
+	if !p.Field2DeepEqual(ano.ModelConfig) {
 		return false
 	}
-	if !p.Field6DeepEqual(ano.EvaluatorContent) {
+	if !p.Field3DeepEqual(ano.DisablePositionSwap) {
 		return false
 	}
 	return true
 }
 
-func (p *EvaluatorVersion) Field1DeepEqual(src *int64) bool {
+func (p *PairwiseEvaluator) Field1DeepEqual(src []*common.Message) bool {
 
-	if p.ID == src {
-		return true
-	} else if p.ID == nil || src == nil {
+	if len(p.MessageList) != len(src) {
 		return false
 	}
-	if *p.ID != *src {
-		return false
+	for i, v := range p.MessageList {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
 	return true
 }
-func (p *EvaluatorVersion) Field3DeepEqual(src *string) bool {
+func (p *PairwiseEvaluator) Field2DeepEqual(src *common.ModelConfig) bool {
 
-	if p.Version == src {
-		return true
-	} else if p.Version == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.Version, *src) != 0 {
+	if !p.ModelConfig.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorVersion) Field4DeepEqual(src *string) bool {
+func (p *PairwiseEvaluator) Field3DeepEqual(src *bool) bool {
 
-	if p.Description == src {
+	if p.DisablePositionSwap == src {
 		return true
-	} else if p.Description == nil || src == nil {
+	} else if p.DisablePositionSwap == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Description, *src) != 0 {
+	if *p.DisablePositionSwap != *src {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorVersion) Field5DeepEqual(src *common.BaseInfo) bool {
 
-	if !p.BaseInfo.DeepEqual(src) {
-		return false
+// 规则评估器
+type RuleEvaluator struct {
+	RuleType *RuleType `thrift:"rule_type,1,optional" frugal:"1,optional,string" form:"rule_type" json:"rule_type,omitempty" query:"rule_type"`
+	// 待评估内容所在的输入字段, 默认 actual_output
+	ActualFieldKey *string `thrift:"actual_field_key,2,optional" frugal:"2,optional,string" form:"actual_field_key" json:"actual_field_key,omitempty" query:"actual_field_key"`
+	// 参考答案所在的输入字段, 默认 reference_output
+	ReferenceFieldKey *string `thrift:"reference_field_key,3,optional" frugal:"3,optional,string" form:"reference_field_key" json:"reference_field_key,omitempty" query:"reference_field_key"`
+	IgnoreCase        *bool   `thrift:"ignore_case,4,optional" frugal:"4,optional,bool" form:"ignore_case" json:"ignore_case,omitempty" query:"ignore_case"`
+	TrimSpace         *bool   `thrift:"trim_space,5,optional" frugal:"5,optional,bool" form:"trim_space" json:"trim_space,omitempty" query:"trim_space"`
+	// regex_match
+	Pattern *string `thrift:"pattern,6,optional" frugal:"6,optional,string" form:"pattern" json:"pattern,omitempty" query:"pattern"`
+	// json_schema
+	JSONSchema *string `thrift:"json_schema,7,optional" frugal:"7,optional,string" form:"json_schema" json:"json_schema,omitempty" query:"json_schema"`
+	// contains
+	Keywords []string `thrift:"keywords,8,optional" frugal:"8,optional,list<string>" form:"keywords" json:"keywords,omitempty" query:"keywords"`
+	// contains, 为 true 时得分为命中关键词占比
+	ContainsAll *bool `thrift:"contains_all,9,optional" frugal:"9,optional,bool" form:"contains_all" json:"contains_all,omitempty" query:"contains_all"`
+	// numeric_tolerance
+	Tolerance *float64 `thrift:"tolerance,10,optional" frugal:"10,optional,double" form:"tolerance" json:"tolerance,omitempty" query:"tolerance"`
+	// numeric_tolerance, 为 true 时按 |reference| * tolerance 计算
+	Relative *bool `thrift:"relative,11,optional" frugal:"11,optional,bool" form:"relative" json:"relative,omitempty" query:"relative"`
+	// bleu, 默认 4
+	BleuMaxN *int32 `thrift:"bleu_max_n,12,optional" frugal:"12,optional,i32" form:"bleu_max_n" json:"bleu_max_n,omitempty" query:"bleu_max_n"`
+	// 非空时将相似度类得分按阈值二值化
+	PassThreshold *float64 `thrift:"pass_threshold,13,optional" frugal:"13,optional,double" form:"pass_threshold" json:"pass_threshold,omitempty" query:"pass_threshold"`
+}
+
+func NewRuleEvaluator() *RuleEvaluator {
+	return &RuleEvaluator{}
+}
+
+func (p *RuleEvaluator) InitDefault() {
+}
+
+var RuleEvaluator_RuleType_DEFAULT RuleType
+
+func (p *RuleEvaluator) GetRuleType() (v RuleType) {
+	if p == nil {
+		return
 	}
-	return true
+	if !p.IsSetRuleType() {
+		return RuleEvaluator_RuleType_DEFAULT
+	}
+	return *p.RuleType
 }
-func (p *EvaluatorVersion) Field6DeepEqual(src *EvaluatorContent) bool {
 
-	if !p.EvaluatorContent.DeepEqual(src) {
-		return false
+var RuleEvaluator_ActualFieldKey_DEFAULT string
+
+func (p *RuleEvaluator) GetActualFieldKey() (v string) {
+	if p == nil {
+		return
 	}
-	return true
+	if !p.IsSetActualFieldKey() {
+		return RuleEvaluator_ActualFieldKey_DEFAULT
+	}
+	return *p.ActualFieldKey
 }
 
-type EvaluatorContent struct {
-	ReceiveChatHistory *bool                `thrift:"receive_chat_history,1,optional" frugal:"1,optional,bool" mapstructure:"receive_chat_history" form:"receive_chat_history" json:"receive_chat_history,omitempty" query:"receive_chat_history"`
-	InputSchemas       []*common.ArgsSchema `thrift:"input_schemas,2,optional" frugal:"2,optional,list<common.ArgsSchema>" mapstructure:"input_schemas" form:"input_schemas" json:"input_schemas,omitempty" query:"input_schemas"`
-	OutputSchemas      []*common.ArgsSchema `thrift:"output_schemas,3,optional" frugal:"3,optional,list<common.ArgsSchema>" mapstructure:"output_schemas" form:"output_schemas" json:"output_schemas,omitempty" query:"output_schemas"`
-	// 101-200 Evaluator类型
-	PromptEvaluator    *PromptEvaluator    `thrift:"prompt_evaluator,101,optional" frugal:"101,optional,PromptEvaluator" mapstructure:"prompt_evaluator" form:"prompt_evaluator" json:"prompt_evaluator,omitempty" query:"prompt_evaluator"`
-	CodeEvaluator      *CodeEvaluator      `thrift:"code_evaluator,102,optional" frugal:"102,optional,CodeEvaluator" form:"code_evaluator" json:"code_evaluator,omitempty" query:"code_evaluator"`
-	CustomRPCEvaluator *CustomRPCEvaluator `thrift:"custom_rpc_evaluator,103,optional" frugal:"103,optional,CustomRPCEvaluator" form:"custom_rpc_evaluator" json:"custom_rpc_evaluator,omitempty" query:"custom_rpc_evaluator"`
-	AgentEvaluator     *AgentEvaluator     `thrift:"agent_evaluator,104,optional" frugal:"104,optional,AgentEvaluator" form:"agent_evaluator" json:"agent_evaluator,omitempty" query:"agent_evaluator"`
+var RuleEvaluator_ReferenceFieldKey_DEFAULT string
+
+func (p *RuleEvaluator) GetReferenceFieldKey() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReferenceFieldKey() {
+		return RuleEvaluator_ReferenceFieldKey_DEFAULT
+	}
+	return *p.ReferenceFieldKey
 }
 
-func NewEvaluatorContent() *EvaluatorContent {
-	return &EvaluatorContent{}
+var RuleEvaluator_IgnoreCase_DEFAULT bool
+
+func (p *RuleEvaluator) GetIgnoreCase() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetIgnoreCase() {
+		return RuleEvaluator_IgnoreCase_DEFAULT
+	}
+	return *p.IgnoreCase
 }
 
-func (p *EvaluatorContent) InitDefault() {
+var RuleEvaluator_TrimSpace_DEFAULT bool
+
+func (p *RuleEvaluator) GetTrimSpace() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTrimSpace() {
+		return RuleEvaluator_TrimSpace_DEFAULT
+	}
+	return *p.TrimSpace
 }
 
-var EvaluatorContent_ReceiveChatHistory_DEFAULT bool
+var RuleEvaluator_Pattern_DEFAULT string
 
-func (p *EvaluatorContent) GetReceiveChatHistory() (v bool) {
+func (p *RuleEvaluator) GetPattern() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetReceiveChatHistory() {
-		return EvaluatorContent_ReceiveChatHistory_DEFAULT
+	if !p.IsSetPattern() {
+		return RuleEvaluator_Pattern_DEFAULT
 	}
-	return *p.ReceiveChatHistory
+	return *p.Pattern
 }
 
-var EvaluatorContent_InputSchemas_DEFAULT []*common.ArgsSchema
+var RuleEvaluator_JSONSchema_DEFAULT string
 
-func (p *EvaluatorContent) GetInputSchemas() (v []*common.ArgsSchema) {
+func (p *RuleEvaluator) GetJSONSchema() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetInputSchemas() {
-		return EvaluatorContent_InputSchemas_DEFAULT
+	if !p.IsSetJSONSchema() {
+		return RuleEvaluator_JSONSchema_DEFAULT
 	}
-	return p.InputSchemas
+	return *p.JSONSchema
 }
 
-var EvaluatorContent_OutputSchemas_DEFAULT []*common.ArgsSchema
+var RuleEvaluator_Keywords_DEFAULT []string
 
-func (p *EvaluatorContent) GetOutputSchemas() (v []*common.ArgsSchema) {
+func (p *RuleEvaluator) GetKeywords() (v []string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetOutputSchemas() {
-		return EvaluatorContent_OutputSchemas_DEFAULT
+	if !p.IsSetKeywords() {
+		return RuleEvaluator_Keywords_DEFAULT
 	}
-	return p.OutputSchemas
+	return p.Keywords
 }
 
-var EvaluatorContent_PromptEvaluator_DEFAULT *PromptEvaluator
+var RuleEvaluator_ContainsAll_DEFAULT bool
 
-func (p *EvaluatorContent) GetPromptEvaluator() (v *PromptEvaluator) {
+func (p *RuleEvaluator) GetContainsAll() (v bool) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetPromptEvaluator() {
-		return EvaluatorContent_PromptEvaluator_DEFAULT
+	if !p.IsSetContainsAll() {
+		return RuleEvaluator_ContainsAll_DEFAULT
 	}
-	return p.PromptEvaluator
+	return *p.ContainsAll
 }
 
-var EvaluatorContent_CodeEvaluator_DEFAULT *CodeEvaluator
+var RuleEvaluator_Tolerance_DEFAULT float64
 
-func (p *EvaluatorContent) GetCodeEvaluator() (v *CodeEvaluator) {
+func (p *RuleEvaluator) GetTolerance() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetCodeEvaluator() {
-		return EvaluatorContent_CodeEvaluator_DEFAULT
+	if !p.IsSetTolerance() {
+		return RuleEvaluator_Tolerance_DEFAULT
 	}
-	return p.CodeEvaluator
+	return *p.Tolerance
 }
 
-var EvaluatorContent_CustomRPCEvaluator_DEFAULT *CustomRPCEvaluator
+var RuleEvaluator_Relative_DEFAULT bool
 
-func (p *EvaluatorContent) GetCustomRPCEvaluator() (v *CustomRPCEvaluator) {
+func (p *RuleEvaluator) GetRelative() (v bool) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetCustomRPCEvaluator() {
-		return EvaluatorContent_CustomRPCEvaluator_DEFAULT
+	if !p.IsSetRelative() {
+		return RuleEvaluator_Relative_DEFAULT
 	}
-	return p.CustomRPCEvaluator
+	return *p.Relative
 }
 
-var EvaluatorContent_AgentEvaluator_DEFAULT *AgentEvaluator
+var RuleEvaluator_BleuMaxN_DEFAULT int32
 
-func (p *EvaluatorContent) GetAgentEvaluator() (v *AgentEvaluator) {
+func (p *RuleEvaluator) GetBleuMaxN() (v int32) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetAgentEvaluator() {
-		return EvaluatorContent_AgentEvaluator_DEFAULT
+	if !p.IsSetBleuMaxN() {
+		return RuleEvaluator_BleuMaxN_DEFAULT
 	}
-	return p.AgentEvaluator
+	return *p.BleuMaxN
 }
-func (p *EvaluatorContent) SetReceiveChatHistory(val *bool) {
-	p.ReceiveChatHistory = val
+
+var RuleEvaluator_PassThreshold_DEFAULT float64
+
+func (p *RuleEvaluator) GetPassThreshold() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPassThreshold() {
+		return RuleEvaluator_PassThreshold_DEFAULT
+	}
+	return *p.PassThreshold
 }
-func (p *EvaluatorContent) SetInputSchemas(val []*common.ArgsSchema) {
-	p.InputSchemas = val
+func (p *RuleEvaluator) SetRuleType(val *RuleType) {
+	p.RuleType = val
 }
-func (p *EvaluatorContent) SetOutputSchemas(val []*common.ArgsSchema) {
-	p.OutputSchemas = val
+func (p *RuleEvaluator) SetActualFieldKey(val *string) {
+	p.ActualFieldKey = val
 }
-func (p *EvaluatorContent) SetPromptEvaluator(val *PromptEvaluator) {
-	p.PromptEvaluator = val
+func (p *RuleEvaluator) SetReferenceFieldKey(val *string) {
+	p.ReferenceFieldKey = val
 }
-func (p *EvaluatorContent) SetCodeEvaluator(val *CodeEvaluator) {
-	p.CodeEvaluator = val
+func (p *RuleEvaluator) SetIgnoreCase(val *bool) {
+	p.IgnoreCase = val
 }
-func (p *EvaluatorContent) SetCustomRPCEvaluator(val *CustomRPCEvaluator) {
-	p.CustomRPCEvaluator = val
+func (p *RuleEvaluator) SetTrimSpace(val *bool) {
+	p.TrimSpace = val
 }
-func (p *EvaluatorContent) SetAgentEvaluator(val *AgentEvaluator) {
-	p.AgentEvaluator = val
+func (p *RuleEvaluator) SetPattern(val *string) {
+	p.Pattern = val
+}
+func (p *RuleEvaluator) SetJSONSchema(val *string) {
+	p.JSONSchema = val
+}
+func (p *RuleEvaluator) SetKeywords(val []string) {
+	p.Keywords = val
+}
+func (p *RuleEvaluator) SetContainsAll(val *bool) {
+	p.ContainsAll = val
+}
+func (p *RuleEvaluator) SetTolerance(val *float64) {
+	p.Tolerance = val
+}
+func (p *RuleEvaluator) SetRelative(val *bool) {
+	p.Relative = val
+}
+func (p *RuleEvaluator) SetBleuMaxN(val *int32) {
+	p.BleuMaxN = val
+}
+func (p *RuleEvaluator) SetPassThreshold(val *float64) {
+	p.PassThreshold = val
 }
 
-var fieldIDToName_EvaluatorContent = map[int16]string{
-	1:   "receive_chat_history",
-	2:   "input_schemas",
-	3:   "output_schemas",
-	101: "prompt_evaluator",
-	102: "code_evaluator",
-	103: "custom_rpc_evaluator",
-	104: "agent_evaluator",
+var fieldIDToName_RuleEvaluator = map[int16]string{
+	1:  "rule_type",
+	2:  "actual_field_key",
+	3:  "reference_field_key",
+	4:  "ignore_case",
+	5:  "trim_space",
+	6:  "pattern",
+	7:  "json_schema",
+	8:  "keywords",
+	9:  "contains_all",
+	10: "tolerance",
+	11: "relative",
+	12: "bleu_max_n",
+	13: "pass_threshold",
 }
 
-func (p *EvaluatorContent) IsSetReceiveChatHistory() bool {
-	return p.ReceiveChatHistory != nil
+func (p *RuleEvaluator) IsSetRuleType() bool {
+	return p.RuleType != nil
 }
 
-func (p *EvaluatorContent) IsSetInputSchemas() bool {
-	return p.InputSchemas != nil
+func (p *RuleEvaluator) IsSetActualFieldKey() bool {
+	return p.ActualFieldKey != nil
 }
 
-func (p *EvaluatorContent) IsSetOutputSchemas() bool {
-	return p.OutputSchemas != nil
+func (p *RuleEvaluator) IsSetReferenceFieldKey() bool {
+	return p.ReferenceFieldKey != nil
 }
 
-func (p *EvaluatorContent) IsSetPromptEvaluator() bool {
-	return p.PromptEvaluator != nil
+func (p *RuleEvaluator) IsSetIgnoreCase() bool {
+	return p.IgnoreCase != nil
 }
 
-func (p *EvaluatorContent) IsSetCodeEvaluator() bool {
-	return p.CodeEvaluator != nil
+func (p *RuleEvaluator) IsSetTrimSpace() bool {
+	return p.TrimSpace != nil
 }
 
-func (p *EvaluatorContent) IsSetCustomRPCEvaluator() bool {
-	return p.CustomRPCEvaluator != nil
+func (p *RuleEvaluator) IsSetPattern() bool {
+	return p.Pattern != nil
 }
 
-func (p *EvaluatorContent) IsSetAgentEvaluator() bool {
-	return p.AgentEvaluator != nil
+func (p *RuleEvaluator) IsSetJSONSchema() bool {
+	return p.JSONSchema != nil
 }
 
-func (p *EvaluatorContent) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleEvaluator) IsSetKeywords() bool {
+	return p.Keywords != nil
+}
+
+func (p *RuleEvaluator) IsSetContainsAll() bool {
+	return p.ContainsAll != nil
+}
+
+func (p *RuleEvaluator) IsSetTolerance() bool {
+	return p.Tolerance != nil
+}
+
+func (p *RuleEvaluator) IsSetRelative() bool {
+	return p.Relative != nil
+}
+
+func (p *RuleEvaluator) IsSetBleuMaxN() bool {
+	return p.BleuMaxN != nil
+}
+
+func (p *RuleEvaluator) IsSetPassThreshold() bool {
+	return p.PassThreshold != nil
+}
+
+func (p *RuleEvaluator) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4544,7 +4633,7 @@ func (p *EvaluatorContent) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4552,7 +4641,7 @@ func (p *EvaluatorContent) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4560,51 +4649,99 @@ func (p *EvaluatorContent) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 101:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField101(iprot); err != nil {
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 102:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField102(iprot); err != nil {
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 103:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField103(iprot); err != nil {
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 104:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField104(iprot); err != nil {
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
 		}
 	}
@@ -4618,7 +4755,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorContent[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleEvaluator[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4628,7 +4765,40 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorContent) ReadField1(iprot thrift.TProtocol) error {
+func (p *RuleEvaluator) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *RuleType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RuleType = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ActualFieldKey = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ReferenceFieldKey = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field *bool
 	if v, err := iprot.ReadBool(); err != nil {
@@ -4636,45 +4806,55 @@ func (p *EvaluatorContent) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.ReceiveChatHistory = _field
+	p.IgnoreCase = _field
 	return nil
 }
-func (p *EvaluatorContent) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *RuleEvaluator) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	_field := make([]*common.ArgsSchema, 0, size)
-	values := make([]common.ArgsSchema, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	p.TrimSpace = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField6(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	if err := iprot.ReadListEnd(); err != nil {
+	p.Pattern = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.InputSchemas = _field
+	p.JSONSchema = _field
 	return nil
 }
-func (p *EvaluatorContent) ReadField3(iprot thrift.TProtocol) error {
+func (p *RuleEvaluator) ReadField8(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*common.ArgsSchema, 0, size)
-	values := make([]common.ArgsSchema, size)
+	_field := make([]string, 0, size)
 	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
 
-		if err := _elem.Read(iprot); err != nil {
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
 			return err
+		} else {
+			_elem = v
 		}
 
 		_field = append(_field, _elem)
@@ -4682,45 +4862,68 @@ func (p *EvaluatorContent) ReadField3(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.OutputSchemas = _field
+	p.Keywords = _field
 	return nil
 }
-func (p *EvaluatorContent) ReadField101(iprot thrift.TProtocol) error {
-	_field := NewPromptEvaluator()
-	if err := _field.Read(iprot); err != nil {
+func (p *RuleEvaluator) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.PromptEvaluator = _field
+	p.ContainsAll = _field
 	return nil
 }
-func (p *EvaluatorContent) ReadField102(iprot thrift.TProtocol) error {
-	_field := NewCodeEvaluator()
-	if err := _field.Read(iprot); err != nil {
+func (p *RuleEvaluator) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.CodeEvaluator = _field
+	p.Tolerance = _field
 	return nil
 }
-func (p *EvaluatorContent) ReadField103(iprot thrift.TProtocol) error {
-	_field := NewCustomRPCEvaluator()
-	if err := _field.Read(iprot); err != nil {
+func (p *RuleEvaluator) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.CustomRPCEvaluator = _field
+	p.Relative = _field
 	return nil
 }
-func (p *EvaluatorContent) ReadField104(iprot thrift.TProtocol) error {
-	_field := NewAgentEvaluator()
-	if err := _field.Read(iprot); err != nil {
+func (p *RuleEvaluator) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BleuMaxN = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.AgentEvaluator = _field
+	p.PassThreshold = _field
 	return nil
 }
 
-func (p *EvaluatorContent) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleEvaluator) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorContent"); err != nil {
+	if err = oprot.WriteStructBegin("RuleEvaluator"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4736,20 +4939,44 @@ func (p *EvaluatorContent) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField101(oprot); err != nil {
-			fieldId = 101
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField102(oprot); err != nil {
-			fieldId = 102
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
 			goto WriteFieldError
 		}
-		if err = p.writeField103(oprot); err != nil {
-			fieldId = 103
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
 			goto WriteFieldError
 		}
-		if err = p.writeField104(oprot); err != nil {
-			fieldId = 104
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
 			goto WriteFieldError
 		}
 	}
@@ -4770,12 +4997,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorContent) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetReceiveChatHistory() {
-		if err = oprot.WriteFieldBegin("receive_chat_history", thrift.BOOL, 1); err != nil {
+func (p *RuleEvaluator) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRuleType() {
+		if err = oprot.WriteFieldBegin("rule_type", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.ReceiveChatHistory); err != nil {
+		if err := oprot.WriteString(*p.RuleType); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4788,20 +5015,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorContent) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetInputSchemas() {
-		if err = oprot.WriteFieldBegin("input_schemas", thrift.LIST, 2); err != nil {
+func (p *RuleEvaluator) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetActualFieldKey() {
+		if err = oprot.WriteFieldBegin("actual_field_key", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.InputSchemas)); err != nil {
-			return err
-		}
-		for _, v := range p.InputSchemas {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteString(*p.ActualFieldKey); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4814,20 +5033,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *EvaluatorContent) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOutputSchemas() {
-		if err = oprot.WriteFieldBegin("output_schemas", thrift.LIST, 3); err != nil {
+func (p *RuleEvaluator) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReferenceFieldKey() {
+		if err = oprot.WriteFieldBegin("reference_field_key", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.OutputSchemas)); err != nil {
-			return err
-		}
-		for _, v := range p.OutputSchemas {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteString(*p.ReferenceFieldKey); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4840,12 +5051,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *EvaluatorContent) writeField101(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPromptEvaluator() {
-		if err = oprot.WriteFieldBegin("prompt_evaluator", thrift.STRUCT, 101); err != nil {
+func (p *RuleEvaluator) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIgnoreCase() {
+		if err = oprot.WriteFieldBegin("ignore_case", thrift.BOOL, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.PromptEvaluator.Write(oprot); err != nil {
+		if err := oprot.WriteBool(*p.IgnoreCase); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4854,16 +5065,16 @@ func (p *EvaluatorContent) writeField101(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 101 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 101 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *EvaluatorContent) writeField102(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCodeEvaluator() {
-		if err = oprot.WriteFieldBegin("code_evaluator", thrift.STRUCT, 102); err != nil {
+func (p *RuleEvaluator) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTrimSpace() {
+		if err = oprot.WriteFieldBegin("trim_space", thrift.BOOL, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.CodeEvaluator.Write(oprot); err != nil {
+		if err := oprot.WriteBool(*p.TrimSpace); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4872,16 +5083,16 @@ func (p *EvaluatorContent) writeField102(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 102 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 102 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *EvaluatorContent) writeField103(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCustomRPCEvaluator() {
-		if err = oprot.WriteFieldBegin("custom_rpc_evaluator", thrift.STRUCT, 103); err != nil {
+func (p *RuleEvaluator) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPattern() {
+		if err = oprot.WriteFieldBegin("pattern", thrift.STRING, 6); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.CustomRPCEvaluator.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.Pattern); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4890,16 +5101,16 @@ func (p *EvaluatorContent) writeField103(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 103 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 103 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-func (p *EvaluatorContent) writeField104(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAgentEvaluator() {
-		if err = oprot.WriteFieldBegin("agent_evaluator", thrift.STRUCT, 104); err != nil {
+func (p *RuleEvaluator) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetJSONSchema() {
+		if err = oprot.WriteFieldBegin("json_schema", thrift.STRING, 7); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.AgentEvaluator.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.JSONSchema); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4908,236 +5119,398 @@ func (p *EvaluatorContent) writeField104(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 104 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 104 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeywords() {
+		if err = oprot.WriteFieldBegin("keywords", thrift.LIST, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Keywords)); err != nil {
+			return err
+		}
+		for _, v := range p.Keywords {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetContainsAll() {
+		if err = oprot.WriteFieldBegin("contains_all", thrift.BOOL, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.ContainsAll); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTolerance() {
+		if err = oprot.WriteFieldBegin("tolerance", thrift.DOUBLE, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Tolerance); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRelative() {
+		if err = oprot.WriteFieldBegin("relative", thrift.BOOL, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Relative); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBleuMaxN() {
+		if err = oprot.WriteFieldBegin("bleu_max_n", thrift.I32, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.BleuMaxN); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPassThreshold() {
+		if err = oprot.WriteFieldBegin("pass_threshold", thrift.DOUBLE, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.PassThreshold); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
 }
 
-func (p *EvaluatorContent) String() string {
+func (p *RuleEvaluator) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorContent(%+v)", *p)
+	return fmt.Sprintf("RuleEvaluator(%+v)", *p)
 
 }
 
-func (p *EvaluatorContent) DeepEqual(ano *EvaluatorContent) bool {
+func (p *RuleEvaluator) DeepEqual(ano *RuleEvaluator) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ReceiveChatHistory) {
+	if !p.Field1DeepEqual(ano.RuleType) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.InputSchemas) {
+	if !p.Field2DeepEqual(ano.ActualFieldKey) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.OutputSchemas) {
+	if !p.Field3DeepEqual(ano.ReferenceFieldKey) {
 		return false
 	}
-	if !p.Field101DeepEqual(ano.PromptEvaluator) {
+	if !p.Field4DeepEqual(ano.IgnoreCase) {
 		return false
 	}
-	if !p.Field102DeepEqual(ano.CodeEvaluator) {
+	if !p.Field5DeepEqual(ano.TrimSpace) {
 		return false
 	}
-	if !p.Field103DeepEqual(ano.CustomRPCEvaluator) {
+	if !p.Field6DeepEqual(ano.Pattern) {
 		return false
 	}
-	if !p.Field104DeepEqual(ano.AgentEvaluator) {
+	if !p.Field7DeepEqual(ano.JSONSchema) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.Keywords) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.ContainsAll) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.Tolerance) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.Relative) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.BleuMaxN) {
+		return false
+	}
+	if !p.Field13DeepEqual(ano.PassThreshold) {
 		return false
 	}
 	return true
 }
 
-func (p *EvaluatorContent) Field1DeepEqual(src *bool) bool {
+func (p *RuleEvaluator) Field1DeepEqual(src *RuleType) bool {
 
-	if p.ReceiveChatHistory == src {
+	if p.RuleType == src {
 		return true
-	} else if p.ReceiveChatHistory == nil || src == nil {
+	} else if p.RuleType == nil || src == nil {
 		return false
 	}
-	if *p.ReceiveChatHistory != *src {
+	if strings.Compare(*p.RuleType, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorContent) Field2DeepEqual(src []*common.ArgsSchema) bool {
+func (p *RuleEvaluator) Field2DeepEqual(src *string) bool {
 
-	if len(p.InputSchemas) != len(src) {
+	if p.ActualFieldKey == src {
+		return true
+	} else if p.ActualFieldKey == nil || src == nil {
 		return false
 	}
-	for i, v := range p.InputSchemas {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if strings.Compare(*p.ActualFieldKey, *src) != 0 {
+		return false
 	}
 	return true
 }
-func (p *EvaluatorContent) Field3DeepEqual(src []*common.ArgsSchema) bool {
+func (p *RuleEvaluator) Field3DeepEqual(src *string) bool {
 
-	if len(p.OutputSchemas) != len(src) {
+	if p.ReferenceFieldKey == src {
+		return true
+	} else if p.ReferenceFieldKey == nil || src == nil {
 		return false
 	}
-	for i, v := range p.OutputSchemas {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if strings.Compare(*p.ReferenceFieldKey, *src) != 0 {
+		return false
 	}
 	return true
 }
-func (p *EvaluatorContent) Field101DeepEqual(src *PromptEvaluator) bool {
+func (p *RuleEvaluator) Field4DeepEqual(src *bool) bool {
 
-	if !p.PromptEvaluator.DeepEqual(src) {
+	if p.IgnoreCase == src {
+		return true
+	} else if p.IgnoreCase == nil || src == nil {
+		return false
+	}
+	if *p.IgnoreCase != *src {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorContent) Field102DeepEqual(src *CodeEvaluator) bool {
+func (p *RuleEvaluator) Field5DeepEqual(src *bool) bool {
 
-	if !p.CodeEvaluator.DeepEqual(src) {
+	if p.TrimSpace == src {
+		return true
+	} else if p.TrimSpace == nil || src == nil {
+		return false
+	}
+	if *p.TrimSpace != *src {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorContent) Field103DeepEqual(src *CustomRPCEvaluator) bool {
+func (p *RuleEvaluator) Field6DeepEqual(src *string) bool {
 
-	if !p.CustomRPCEvaluator.DeepEqual(src) {
+	if p.Pattern == src {
+		return true
+	} else if p.Pattern == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Pattern, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorContent) Field104DeepEqual(src *AgentEvaluator) bool {
+func (p *RuleEvaluator) Field7DeepEqual(src *string) bool {
 
-	if !p.AgentEvaluator.DeepEqual(src) {
+	if p.JSONSchema == src {
+		return true
+	} else if p.JSONSchema == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.JSONSchema, *src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *RuleEvaluator) Field8DeepEqual(src []string) bool {
 
-// 明确有顺序的 evaluator 与版本映射元素
-type EvaluatorIDVersionItem struct {
-	EvaluatorID        *int64              `thrift:"evaluator_id,1,optional" frugal:"1,optional,i64" json:"evaluator_id" form:"evaluator_id" query:"evaluator_id"`
-	Version            *string             `thrift:"version,2,optional" frugal:"2,optional,string" form:"version" json:"version,omitempty" query:"version"`
-	RunConfig          *EvaluatorRunConfig `thrift:"run_config,3,optional" frugal:"3,optional,EvaluatorRunConfig" json:"run_config" form:"run_config" query:"run_config"`
-	EvaluatorVersionID *int64              `thrift:"evaluator_version_id,4,optional" frugal:"4,optional,i64" json:"evaluator_version_id" form:"evaluator_version_id" query:"evaluator_version_id"`
-	ScoreWeight        *float64            `thrift:"score_weight,5,optional" frugal:"5,optional,double" json:"score_weight" form:"score_weight" query:"score_weight"`
+	if len(p.Keywords) != len(src) {
+		return false
+	}
+	for i, v := range p.Keywords {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
 }
+func (p *RuleEvaluator) Field9DeepEqual(src *bool) bool {
 
-func NewEvaluatorIDVersionItem() *EvaluatorIDVersionItem {
-	return &EvaluatorIDVersionItem{}
+	if p.ContainsAll == src {
+		return true
+	} else if p.ContainsAll == nil || src == nil {
+		return false
+	}
+	if *p.ContainsAll != *src {
+		return false
+	}
+	return true
 }
+func (p *RuleEvaluator) Field10DeepEqual(src *float64) bool {
 
-func (p *EvaluatorIDVersionItem) InitDefault() {
+	if p.Tolerance == src {
+		return true
+	} else if p.Tolerance == nil || src == nil {
+		return false
+	}
+	if *p.Tolerance != *src {
+		return false
+	}
+	return true
 }
+func (p *RuleEvaluator) Field11DeepEqual(src *bool) bool {
 
-var EvaluatorIDVersionItem_EvaluatorID_DEFAULT int64
-
-func (p *EvaluatorIDVersionItem) GetEvaluatorID() (v int64) {
-	if p == nil {
-		return
+	if p.Relative == src {
+		return true
+	} else if p.Relative == nil || src == nil {
+		return false
 	}
-	if !p.IsSetEvaluatorID() {
-		return EvaluatorIDVersionItem_EvaluatorID_DEFAULT
+	if *p.Relative != *src {
+		return false
 	}
-	return *p.EvaluatorID
+	return true
 }
+func (p *RuleEvaluator) Field12DeepEqual(src *int32) bool {
 
-var EvaluatorIDVersionItem_Version_DEFAULT string
-
-func (p *EvaluatorIDVersionItem) GetVersion() (v string) {
-	if p == nil {
-		return
+	if p.BleuMaxN == src {
+		return true
+	} else if p.BleuMaxN == nil || src == nil {
+		return false
 	}
-	if !p.IsSetVersion() {
-		return EvaluatorIDVersionItem_Version_DEFAULT
+	if *p.BleuMaxN != *src {
+		return false
 	}
-	return *p.Version
+	return true
 }
+func (p *RuleEvaluator) Field13DeepEqual(src *float64) bool {
 
-var EvaluatorIDVersionItem_RunConfig_DEFAULT *EvaluatorRunConfig
-
-func (p *EvaluatorIDVersionItem) GetRunConfig() (v *EvaluatorRunConfig) {
-	if p == nil {
-		return
+	if p.PassThreshold == src {
+		return true
+	} else if p.PassThreshold == nil || src == nil {
+		return false
 	}
-	if !p.IsSetRunConfig() {
-		return EvaluatorIDVersionItem_RunConfig_DEFAULT
+	if *p.PassThreshold != *src {
+		return false
 	}
-	return p.RunConfig
+	return true
 }
 
-var EvaluatorIDVersionItem_EvaluatorVersionID_DEFAULT int64
+type CompositeComponent struct {
+	EvaluatorVersionID *int64 `thrift:"evaluator_version_id,1,optional" frugal:"1,optional,i64" json:"evaluator_version_id" form:"evaluator_version_id" query:"evaluator_version_id"`
+	// 仅 weighted 策略使用
+	Weight *float64 `thrift:"weight,2,optional" frugal:"2,optional,double" form:"weight" json:"weight,omitempty" query:"weight"`
+}
 
-func (p *EvaluatorIDVersionItem) GetEvaluatorVersionID() (v int64) {
+func NewCompositeComponent() *CompositeComponent {
+	return &CompositeComponent{}
+}
+
+func (p *CompositeComponent) InitDefault() {
+}
+
+var CompositeComponent_EvaluatorVersionID_DEFAULT int64
+
+func (p *CompositeComponent) GetEvaluatorVersionID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetEvaluatorVersionID() {
-		return EvaluatorIDVersionItem_EvaluatorVersionID_DEFAULT
+		return CompositeComponent_EvaluatorVersionID_DEFAULT
 	}
 	return *p.EvaluatorVersionID
 }
 
-var EvaluatorIDVersionItem_ScoreWeight_DEFAULT float64
+var CompositeComponent_Weight_DEFAULT float64
 
-func (p *EvaluatorIDVersionItem) GetScoreWeight() (v float64) {
+func (p *CompositeComponent) GetWeight() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetScoreWeight() {
-		return EvaluatorIDVersionItem_ScoreWeight_DEFAULT
+	if !p.IsSetWeight() {
+		return CompositeComponent_Weight_DEFAULT
 	}
-	return *p.ScoreWeight
+	return *p.Weight
 }
-func (p *EvaluatorIDVersionItem) SetEvaluatorID(val *int64) {
-	p.EvaluatorID = val
+func (p *CompositeComponent) SetEvaluatorVersionID(val *int64) {
+	p.EvaluatorVersionID = val
 }
-func (p *EvaluatorIDVersionItem) SetVersion(val *string) {
-	p.Version = val
-}
-func (p *EvaluatorIDVersionItem) SetRunConfig(val *EvaluatorRunConfig) {
-	p.RunConfig = val
-}
-func (p *EvaluatorIDVersionItem) SetEvaluatorVersionID(val *int64) {
-	p.EvaluatorVersionID = val
-}
-func (p *EvaluatorIDVersionItem) SetScoreWeight(val *float64) {
-	p.ScoreWeight = val
-}
-
-var fieldIDToName_EvaluatorIDVersionItem = map[int16]string{
-	1: "evaluator_id",
-	2: "version",
-	3: "run_config",
-	4: "evaluator_version_id",
-	5: "score_weight",
-}
-
-func (p *EvaluatorIDVersionItem) IsSetEvaluatorID() bool {
-	return p.EvaluatorID != nil
-}
-
-func (p *EvaluatorIDVersionItem) IsSetVersion() bool {
-	return p.Version != nil
+func (p *CompositeComponent) SetWeight(val *float64) {
+	p.Weight = val
 }
 
-func (p *EvaluatorIDVersionItem) IsSetRunConfig() bool {
-	return p.RunConfig != nil
+var fieldIDToName_CompositeComponent = map[int16]string{
+	1: "evaluator_version_id",
+	2: "weight",
 }
 
-func (p *EvaluatorIDVersionItem) IsSetEvaluatorVersionID() bool {
+func (p *CompositeComponent) IsSetEvaluatorVersionID() bool {
 	return p.EvaluatorVersionID != nil
 }
 
-func (p *EvaluatorIDVersionItem) IsSetScoreWeight() bool {
-	return p.ScoreWeight != nil
+func (p *CompositeComponent) IsSetWeight() bool {
+	return p.Weight != nil
 }
 
-func (p *EvaluatorIDVersionItem) Read(iprot thrift.TProtocol) (err error) {
+func (p *CompositeComponent) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5164,32 +5537,8 @@ func (p *EvaluatorIDVersionItem) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
 			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField5(iprot); err != nil {
+				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -5214,7 +5563,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorIDVersionItem[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompositeComponent[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5224,37 +5573,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorIDVersionItem) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.EvaluatorID = _field
-	return nil
-}
-func (p *EvaluatorIDVersionItem) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Version = _field
-	return nil
-}
-func (p *EvaluatorIDVersionItem) ReadField3(iprot thrift.TProtocol) error {
-	_field := NewEvaluatorRunConfig()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.RunConfig = _field
-	return nil
-}
-func (p *EvaluatorIDVersionItem) ReadField4(iprot thrift.TProtocol) error {
+func (p *CompositeComponent) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -5265,7 +5584,7 @@ func (p *EvaluatorIDVersionItem) ReadField4(iprot thrift.TProtocol) error {
 	p.EvaluatorVersionID = _field
 	return nil
 }
-func (p *EvaluatorIDVersionItem) ReadField5(iprot thrift.TProtocol) error {
+func (p *CompositeComponent) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *float64
 	if v, err := iprot.ReadDouble(); err != nil {
@@ -5273,13 +5592,13 @@ func (p *EvaluatorIDVersionItem) ReadField5(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.ScoreWeight = _field
+	p.Weight = _field
 	return nil
 }
 
-func (p *EvaluatorIDVersionItem) Write(oprot thrift.TProtocol) (err error) {
+func (p *CompositeComponent) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorIDVersionItem"); err != nil {
+	if err = oprot.WriteStructBegin("CompositeComponent"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5291,18 +5610,6 @@ func (p *EvaluatorIDVersionItem) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5321,12 +5628,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorIDVersionItem) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorID() {
-		if err = oprot.WriteFieldBegin("evaluator_id", thrift.I64, 1); err != nil {
+func (p *CompositeComponent) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersionID() {
+		if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.EvaluatorID); err != nil {
+		if err := oprot.WriteI64(*p.EvaluatorVersionID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5339,12 +5646,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorIDVersionItem) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetVersion() {
-		if err = oprot.WriteFieldBegin("version", thrift.STRING, 2); err != nil {
+func (p *CompositeComponent) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWeight() {
+		if err = oprot.WriteFieldBegin("weight", thrift.DOUBLE, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Version); err != nil {
+		if err := oprot.WriteDouble(*p.Weight); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5357,125 +5664,31 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *EvaluatorIDVersionItem) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRunConfig() {
-		if err = oprot.WriteFieldBegin("run_config", thrift.STRUCT, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.RunConfig.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *EvaluatorIDVersionItem) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorVersionID() {
-		if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.EvaluatorVersionID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *EvaluatorIDVersionItem) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetScoreWeight() {
-		if err = oprot.WriteFieldBegin("score_weight", thrift.DOUBLE, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteDouble(*p.ScoreWeight); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
 
-func (p *EvaluatorIDVersionItem) String() string {
+func (p *CompositeComponent) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorIDVersionItem(%+v)", *p)
+	return fmt.Sprintf("CompositeComponent(%+v)", *p)
 
 }
 
-func (p *EvaluatorIDVersionItem) DeepEqual(ano *EvaluatorIDVersionItem) bool {
+func (p *CompositeComponent) DeepEqual(ano *CompositeComponent) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.EvaluatorID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.Version) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.RunConfig) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.EvaluatorVersionID) {
-		return false
-	}
-	if !p.Field5DeepEqual(ano.ScoreWeight) {
-		return false
-	}
-	return true
-}
-
-func (p *EvaluatorIDVersionItem) Field1DeepEqual(src *int64) bool {
-
-	if p.EvaluatorID == src {
-		return true
-	} else if p.EvaluatorID == nil || src == nil {
-		return false
-	}
-	if *p.EvaluatorID != *src {
-		return false
-	}
-	return true
-}
-func (p *EvaluatorIDVersionItem) Field2DeepEqual(src *string) bool {
-
-	if p.Version == src {
-		return true
-	} else if p.Version == nil || src == nil {
+	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
 		return false
 	}
-	if strings.Compare(*p.Version, *src) != 0 {
+	if !p.Field2DeepEqual(ano.Weight) {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorIDVersionItem) Field3DeepEqual(src *EvaluatorRunConfig) bool {
 
-	if !p.RunConfig.DeepEqual(src) {
-		return false
-	}
-	return true
-}
-func (p *EvaluatorIDVersionItem) Field4DeepEqual(src *int64) bool {
+func (p *CompositeComponent) Field1DeepEqual(src *int64) bool {
 
 	if p.EvaluatorVersionID == src {
 		return true
@@ -5487,117 +5700,141 @@ func (p *EvaluatorIDVersionItem) Field4DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *EvaluatorIDVersionItem) Field5DeepEqual(src *float64) bool {
+func (p *CompositeComponent) Field2DeepEqual(src *float64) bool {
 
-	if p.ScoreWeight == src {
+	if p.Weight == src {
 		return true
-	} else if p.ScoreWeight == nil || src == nil {
+	} else if p.Weight == nil || src == nil {
 		return false
 	}
-	if *p.ScoreWeight != *src {
+	if *p.Weight != *src {
 		return false
 	}
 	return true
 }
 
-type EvaluatorInfo struct {
-	Benchmark     *string `thrift:"benchmark,1,optional" frugal:"1,optional,string" json:"benchmark" form:"benchmark" query:"benchmark"`
-	Vendor        *string `thrift:"vendor,2,optional" frugal:"2,optional,string" json:"vendor" form:"vendor" query:"vendor"`
-	VendorURL     *string `thrift:"vendor_url,3,optional" frugal:"3,optional,string" json:"vendor_url" form:"vendor_url" query:"vendor_url"`
-	UserManualURL *string `thrift:"user_manual_url,4,optional" frugal:"4,optional,string" json:"user_manual_url" form:"user_manual_url" query:"user_manual_url"`
+// 组合评估器
+type CompositeEvaluator struct {
+	Components []*CompositeComponent `thrift:"components,1,optional" frugal:"1,optional,list<CompositeComponent>" form:"components" json:"components,omitempty" query:"components"`
+	Strategy   *CompositeStrategy    `thrift:"strategy,2,optional" frugal:"2,optional,string" form:"strategy" json:"strategy,omitempty" query:"strategy"`
+	// 参与合并所需的最少成功组件数, 为 0 时要求全部组件成功
+	MinSuccessCnt *int32 `thrift:"min_success_cnt,3,optional" frugal:"3,optional,i32" form:"min_success_cnt" json:"min_success_cnt,omitempty" query:"min_success_cnt"`
+	// code 策略的合并代码
+	CodeContent  *string       `thrift:"code_content,4,optional" frugal:"4,optional,string" form:"code_content" json:"code_content,omitempty" query:"code_content"`
+	LanguageType *LanguageType `thrift:"language_type,5,optional" frugal:"5,optional,string" form:"language_type" json:"language_type,omitempty" query:"language_type"`
 }
 
-func NewEvaluatorInfo() *EvaluatorInfo {
-	return &EvaluatorInfo{}
+func NewCompositeEvaluator() *CompositeEvaluator {
+	return &CompositeEvaluator{}
 }
 
-func (p *EvaluatorInfo) InitDefault() {
+func (p *CompositeEvaluator) InitDefault() {
 }
 
-var EvaluatorInfo_Benchmark_DEFAULT string
+var CompositeEvaluator_Components_DEFAULT []*CompositeComponent
 
-func (p *EvaluatorInfo) GetBenchmark() (v string) {
+func (p *CompositeEvaluator) GetComponents() (v []*CompositeComponent) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetBenchmark() {
-		return EvaluatorInfo_Benchmark_DEFAULT
+	if !p.IsSetComponents() {
+		return CompositeEvaluator_Components_DEFAULT
 	}
-	return *p.Benchmark
+	return p.Components
 }
 
-var EvaluatorInfo_Vendor_DEFAULT string
+var CompositeEvaluator_Strategy_DEFAULT CompositeStrategy
 
-func (p *EvaluatorInfo) GetVendor() (v string) {
+func (p *CompositeEvaluator) GetStrategy() (v CompositeStrategy) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetVendor() {
-		return EvaluatorInfo_Vendor_DEFAULT
+	if !p.IsSetStrategy() {
+		return CompositeEvaluator_Strategy_DEFAULT
 	}
-	return *p.Vendor
+	return *p.Strategy
 }
 
-var EvaluatorInfo_VendorURL_DEFAULT string
+var CompositeEvaluator_MinSuccessCnt_DEFAULT int32
 
-func (p *EvaluatorInfo) GetVendorURL() (v string) {
+func (p *CompositeEvaluator) GetMinSuccessCnt() (v int32) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetVendorURL() {
-		return EvaluatorInfo_VendorURL_DEFAULT
+	if !p.IsSetMinSuccessCnt() {
+		return CompositeEvaluator_MinSuccessCnt_DEFAULT
 	}
-	return *p.VendorURL
+	return *p.MinSuccessCnt
 }
 
-var EvaluatorInfo_UserManualURL_DEFAULT string
+var CompositeEvaluator_CodeContent_DEFAULT string
 
-func (p *EvaluatorInfo) GetUserManualURL() (v string) {
+func (p *CompositeEvaluator) GetCodeContent() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetUserManualURL() {
-		return EvaluatorInfo_UserManualURL_DEFAULT
+	if !p.IsSetCodeContent() {
+		return CompositeEvaluator_CodeContent_DEFAULT
 	}
-	return *p.UserManualURL
-}
-func (p *EvaluatorInfo) SetBenchmark(val *string) {
-	p.Benchmark = val
-}
-func (p *EvaluatorInfo) SetVendor(val *string) {
-	p.Vendor = val
+	return *p.CodeContent
 }
-func (p *EvaluatorInfo) SetVendorURL(val *string) {
-	p.VendorURL = val
+
+var CompositeEvaluator_LanguageType_DEFAULT LanguageType
+
+func (p *CompositeEvaluator) GetLanguageType() (v LanguageType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLanguageType() {
+		return CompositeEvaluator_LanguageType_DEFAULT
+	}
+	return *p.LanguageType
 }
-func (p *EvaluatorInfo) SetUserManualURL(val *string) {
-	p.UserManualURL = val
+func (p *CompositeEvaluator) SetComponents(val []*CompositeComponent) {
+	p.Components = val
+}
+func (p *CompositeEvaluator) SetStrategy(val *CompositeStrategy) {
+	p.Strategy = val
+}
+func (p *CompositeEvaluator) SetMinSuccessCnt(val *int32) {
+	p.MinSuccessCnt = val
+}
+func (p *CompositeEvaluator) SetCodeContent(val *string) {
+	p.CodeContent = val
+}
+func (p *CompositeEvaluator) SetLanguageType(val *LanguageType) {
+	p.LanguageType = val
 }
 
-var fieldIDToName_EvaluatorInfo = map[int16]string{
-	1: "benchmark",
-	2: "vendor",
-	3: "vendor_url",
-	4: "user_manual_url",
+var fieldIDToName_CompositeEvaluator = map[int16]string{
+	1: "components",
+	2: "strategy",
+	3: "min_success_cnt",
+	4: "code_content",
+	5: "language_type",
 }
 
-func (p *EvaluatorInfo) IsSetBenchmark() bool {
-	return p.Benchmark != nil
+func (p *CompositeEvaluator) IsSetComponents() bool {
+	return p.Components != nil
 }
 
-func (p *EvaluatorInfo) IsSetVendor() bool {
-	return p.Vendor != nil
+func (p *CompositeEvaluator) IsSetStrategy() bool {
+	return p.Strategy != nil
 }
 
-func (p *EvaluatorInfo) IsSetVendorURL() bool {
-	return p.VendorURL != nil
+func (p *CompositeEvaluator) IsSetMinSuccessCnt() bool {
+	return p.MinSuccessCnt != nil
 }
 
-func (p *EvaluatorInfo) IsSetUserManualURL() bool {
-	return p.UserManualURL != nil
+func (p *CompositeEvaluator) IsSetCodeContent() bool {
+	return p.CodeContent != nil
 }
 
-func (p *EvaluatorInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *CompositeEvaluator) IsSetLanguageType() bool {
+	return p.LanguageType != nil
+}
+
+func (p *CompositeEvaluator) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5616,7 +5853,7 @@ func (p *EvaluatorInfo) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5632,7 +5869,7 @@ func (p *EvaluatorInfo) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5647,6 +5884,14 @@ func (p *EvaluatorInfo) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5666,7 +5911,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompositeEvaluator[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5676,29 +5921,52 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorInfo) ReadField1(iprot thrift.TProtocol) error {
+func (p *CompositeEvaluator) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*CompositeComponent, 0, size)
+	values := make([]CompositeComponent, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *string
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Components = _field
+	return nil
+}
+func (p *CompositeEvaluator) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *CompositeStrategy
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Benchmark = _field
+	p.Strategy = _field
 	return nil
 }
-func (p *EvaluatorInfo) ReadField2(iprot thrift.TProtocol) error {
+func (p *CompositeEvaluator) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Vendor = _field
+	p.MinSuccessCnt = _field
 	return nil
 }
-func (p *EvaluatorInfo) ReadField3(iprot thrift.TProtocol) error {
+func (p *CompositeEvaluator) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -5706,24 +5974,24 @@ func (p *EvaluatorInfo) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.VendorURL = _field
+	p.CodeContent = _field
 	return nil
 }
-func (p *EvaluatorInfo) ReadField4(iprot thrift.TProtocol) error {
+func (p *CompositeEvaluator) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field *string
+	var _field *LanguageType
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.UserManualURL = _field
+	p.LanguageType = _field
 	return nil
 }
 
-func (p *EvaluatorInfo) Write(oprot thrift.TProtocol) (err error) {
+func (p *CompositeEvaluator) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorInfo"); err != nil {
+	if err = oprot.WriteStructBegin("CompositeEvaluator"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5743,6 +6011,10 @@ func (p *EvaluatorInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5761,12 +6033,20 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBenchmark() {
-		if err = oprot.WriteFieldBegin("benchmark", thrift.STRING, 1); err != nil {
+func (p *CompositeEvaluator) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetComponents() {
+		if err = oprot.WriteFieldBegin("components", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Benchmark); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Components)); err != nil {
+			return err
+		}
+		for _, v := range p.Components {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5779,12 +6059,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetVendor() {
-		if err = oprot.WriteFieldBegin("vendor", thrift.STRING, 2); err != nil {
+func (p *CompositeEvaluator) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStrategy() {
+		if err = oprot.WriteFieldBegin("strategy", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Vendor); err != nil {
+		if err := oprot.WriteString(*p.Strategy); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5797,12 +6077,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *EvaluatorInfo) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetVendorURL() {
-		if err = oprot.WriteFieldBegin("vendor_url", thrift.STRING, 3); err != nil {
+func (p *CompositeEvaluator) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMinSuccessCnt() {
+		if err = oprot.WriteFieldBegin("min_success_cnt", thrift.I32, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.VendorURL); err != nil {
+		if err := oprot.WriteI32(*p.MinSuccessCnt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5815,12 +6095,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *EvaluatorInfo) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserManualURL() {
-		if err = oprot.WriteFieldBegin("user_manual_url", thrift.STRING, 4); err != nil {
+func (p *CompositeEvaluator) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCodeContent() {
+		if err = oprot.WriteFieldBegin("code_content", thrift.STRING, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.UserManualURL); err != nil {
+		if err := oprot.WriteString(*p.CodeContent); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5833,416 +6113,239 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
+func (p *CompositeEvaluator) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLanguageType() {
+		if err = oprot.WriteFieldBegin("language_type", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.LanguageType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
 
-func (p *EvaluatorInfo) String() string {
+func (p *CompositeEvaluator) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorInfo(%+v)", *p)
+	return fmt.Sprintf("CompositeEvaluator(%+v)", *p)
 
 }
 
-func (p *EvaluatorInfo) DeepEqual(ano *EvaluatorInfo) bool {
+func (p *CompositeEvaluator) DeepEqual(ano *CompositeEvaluator) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Benchmark) {
+	if !p.Field1DeepEqual(ano.Components) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Vendor) {
+	if !p.Field2DeepEqual(ano.Strategy) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.VendorURL) {
+	if !p.Field3DeepEqual(ano.MinSuccessCnt) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.UserManualURL) {
+	if !p.Field4DeepEqual(ano.CodeContent) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.LanguageType) {
 		return false
 	}
 	return true
 }
 
-func (p *EvaluatorInfo) Field1DeepEqual(src *string) bool {
+func (p *CompositeEvaluator) Field1DeepEqual(src []*CompositeComponent) bool {
 
-	if p.Benchmark == src {
+	if len(p.Components) != len(src) {
+		return false
+	}
+	for i, v := range p.Components {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *CompositeEvaluator) Field2DeepEqual(src *CompositeStrategy) bool {
+
+	if p.Strategy == src {
 		return true
-	} else if p.Benchmark == nil || src == nil {
+	} else if p.Strategy == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Benchmark, *src) != 0 {
+	if strings.Compare(*p.Strategy, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorInfo) Field2DeepEqual(src *string) bool {
+func (p *CompositeEvaluator) Field3DeepEqual(src *int32) bool {
 
-	if p.Vendor == src {
+	if p.MinSuccessCnt == src {
 		return true
-	} else if p.Vendor == nil || src == nil {
+	} else if p.MinSuccessCnt == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Vendor, *src) != 0 {
+	if *p.MinSuccessCnt != *src {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorInfo) Field3DeepEqual(src *string) bool {
+func (p *CompositeEvaluator) Field4DeepEqual(src *string) bool {
 
-	if p.VendorURL == src {
+	if p.CodeContent == src {
 		return true
-	} else if p.VendorURL == nil || src == nil {
+	} else if p.CodeContent == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.VendorURL, *src) != 0 {
+	if strings.Compare(*p.CodeContent, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorInfo) Field4DeepEqual(src *string) bool {
+func (p *CompositeEvaluator) Field5DeepEqual(src *LanguageType) bool {
 
-	if p.UserManualURL == src {
+	if p.LanguageType == src {
 		return true
-	} else if p.UserManualURL == nil || src == nil {
+	} else if p.LanguageType == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.UserManualURL, *src) != 0 {
+	if strings.Compare(*p.LanguageType, *src) != 0 {
 		return false
 	}
 	return true
 }
 
-type Evaluator struct {
-	EvaluatorID           *int64            `thrift:"evaluator_id,1,optional" frugal:"1,optional,i64" json:"evaluator_id" form:"evaluator_id" query:"evaluator_id"`
-	WorkspaceID           *int64            `thrift:"workspace_id,2,optional" frugal:"2,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
-	EvaluatorType         *EvaluatorType    `thrift:"evaluator_type,3,optional" frugal:"3,optional,EvaluatorType" form:"evaluator_type" json:"evaluator_type,omitempty" query:"evaluator_type"`
-	Name                  *string           `thrift:"name,4,optional" frugal:"4,optional,string" form:"name" json:"name,omitempty" query:"name"`
-	Description           *string           `thrift:"description,5,optional" frugal:"5,optional,string" form:"description" json:"description,omitempty" query:"description"`
-	DraftSubmitted        *bool             `thrift:"draft_submitted,6,optional" frugal:"6,optional,bool" form:"draft_submitted" json:"draft_submitted,omitempty" query:"draft_submitted"`
-	BaseInfo              *common.BaseInfo  `thrift:"base_info,7,optional" frugal:"7,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
-	CurrentVersion        *EvaluatorVersion `thrift:"current_version,11,optional" frugal:"11,optional,EvaluatorVersion" form:"current_version" json:"current_version,omitempty" query:"current_version"`
-	LatestVersion         *string           `thrift:"latest_version,12,optional" frugal:"12,optional,string" form:"latest_version" json:"latest_version,omitempty" query:"latest_version"`
-	Builtin               *bool             `thrift:"builtin,20,optional" frugal:"20,optional,bool" json:"builtin" form:"builtin" query:"builtin"`
-	EvaluatorInfo         *EvaluatorInfo    `thrift:"evaluator_info,21,optional" frugal:"21,optional,EvaluatorInfo" json:"evaluator_info" form:"evaluator_info" query:"evaluator_info"`
-	BuiltinVisibleVersion *string           `thrift:"builtin_visible_version,22,optional" frugal:"22,optional,string" json:"builtin_visible_version" form:"builtin_visible_version" query:"builtin_visible_version"`
-	// 默认白盒
-	BoxType *EvaluatorBoxType `thrift:"box_type,23,optional" frugal:"23,optional,string" json:"box_type" form:"box_type" query:"box_type"`
-	// 来源
-	SourceType *EvaluatorSourceType                                  `thrift:"source_type,24,optional" frugal:"24,optional,string" form:"source_type" json:"source_type,omitempty" query:"source_type"`
-	Tags       map[EvaluatorTagLangType]map[EvaluatorTagKey][]string `thrift:"tags,100,optional" frugal:"100,optional,map<string:map<string:list<string>>>" json:"tags" form:"tags" query:"tags"`
+type EvaluatorVersion struct {
+	// 版本id
+	ID               *int64            `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id" form:"id" query:"id"`
+	Version          *string           `thrift:"version,3,optional" frugal:"3,optional,string" form:"version" json:"version,omitempty" query:"version"`
+	Description      *string           `thrift:"description,4,optional" frugal:"4,optional,string" form:"description" json:"description,omitempty" query:"description"`
+	BaseInfo         *common.BaseInfo  `thrift:"base_info,5,optional" frugal:"5,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+	EvaluatorContent *EvaluatorContent `thrift:"evaluator_content,6,optional" frugal:"6,optional,EvaluatorContent" form:"evaluator_content" json:"evaluator_content,omitempty" query:"evaluator_content"`
 }
 
-func NewEvaluator() *Evaluator {
-	return &Evaluator{}
+func NewEvaluatorVersion() *EvaluatorVersion {
+	return &EvaluatorVersion{}
 }
 
-func (p *Evaluator) InitDefault() {
+func (p *EvaluatorVersion) InitDefault() {
 }
 
-var Evaluator_EvaluatorID_DEFAULT int64
+var EvaluatorVersion_ID_DEFAULT int64
 
-func (p *Evaluator) GetEvaluatorID() (v int64) {
+func (p *EvaluatorVersion) GetID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEvaluatorID() {
-		return Evaluator_EvaluatorID_DEFAULT
+	if !p.IsSetID() {
+		return EvaluatorVersion_ID_DEFAULT
 	}
-	return *p.EvaluatorID
+	return *p.ID
 }
 
-var Evaluator_WorkspaceID_DEFAULT int64
+var EvaluatorVersion_Version_DEFAULT string
 
-func (p *Evaluator) GetWorkspaceID() (v int64) {
+func (p *EvaluatorVersion) GetVersion() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetWorkspaceID() {
-		return Evaluator_WorkspaceID_DEFAULT
+	if !p.IsSetVersion() {
+		return EvaluatorVersion_Version_DEFAULT
 	}
-	return *p.WorkspaceID
+	return *p.Version
 }
 
-var Evaluator_EvaluatorType_DEFAULT EvaluatorType
+var EvaluatorVersion_Description_DEFAULT string
 
-func (p *Evaluator) GetEvaluatorType() (v EvaluatorType) {
+func (p *EvaluatorVersion) GetDescription() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEvaluatorType() {
-		return Evaluator_EvaluatorType_DEFAULT
+	if !p.IsSetDescription() {
+		return EvaluatorVersion_Description_DEFAULT
 	}
-	return *p.EvaluatorType
+	return *p.Description
 }
 
-var Evaluator_Name_DEFAULT string
+var EvaluatorVersion_BaseInfo_DEFAULT *common.BaseInfo
 
-func (p *Evaluator) GetName() (v string) {
+func (p *EvaluatorVersion) GetBaseInfo() (v *common.BaseInfo) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetName() {
-		return Evaluator_Name_DEFAULT
+	if !p.IsSetBaseInfo() {
+		return EvaluatorVersion_BaseInfo_DEFAULT
 	}
-	return *p.Name
+	return p.BaseInfo
 }
 
-var Evaluator_Description_DEFAULT string
+var EvaluatorVersion_EvaluatorContent_DEFAULT *EvaluatorContent
 
-func (p *Evaluator) GetDescription() (v string) {
+func (p *EvaluatorVersion) GetEvaluatorContent() (v *EvaluatorContent) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDescription() {
-		return Evaluator_Description_DEFAULT
+	if !p.IsSetEvaluatorContent() {
+		return EvaluatorVersion_EvaluatorContent_DEFAULT
 	}
-	return *p.Description
+	return p.EvaluatorContent
 }
-
-var Evaluator_DraftSubmitted_DEFAULT bool
-
-func (p *Evaluator) GetDraftSubmitted() (v bool) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetDraftSubmitted() {
-		return Evaluator_DraftSubmitted_DEFAULT
-	}
-	return *p.DraftSubmitted
-}
-
-var Evaluator_BaseInfo_DEFAULT *common.BaseInfo
-
-func (p *Evaluator) GetBaseInfo() (v *common.BaseInfo) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetBaseInfo() {
-		return Evaluator_BaseInfo_DEFAULT
-	}
-	return p.BaseInfo
-}
-
-var Evaluator_CurrentVersion_DEFAULT *EvaluatorVersion
-
-func (p *Evaluator) GetCurrentVersion() (v *EvaluatorVersion) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetCurrentVersion() {
-		return Evaluator_CurrentVersion_DEFAULT
-	}
-	return p.CurrentVersion
-}
-
-var Evaluator_LatestVersion_DEFAULT string
-
-func (p *Evaluator) GetLatestVersion() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetLatestVersion() {
-		return Evaluator_LatestVersion_DEFAULT
-	}
-	return *p.LatestVersion
-}
-
-var Evaluator_Builtin_DEFAULT bool
-
-func (p *Evaluator) GetBuiltin() (v bool) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetBuiltin() {
-		return Evaluator_Builtin_DEFAULT
-	}
-	return *p.Builtin
-}
-
-var Evaluator_EvaluatorInfo_DEFAULT *EvaluatorInfo
-
-func (p *Evaluator) GetEvaluatorInfo() (v *EvaluatorInfo) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetEvaluatorInfo() {
-		return Evaluator_EvaluatorInfo_DEFAULT
-	}
-	return p.EvaluatorInfo
-}
-
-var Evaluator_BuiltinVisibleVersion_DEFAULT string
-
-func (p *Evaluator) GetBuiltinVisibleVersion() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetBuiltinVisibleVersion() {
-		return Evaluator_BuiltinVisibleVersion_DEFAULT
-	}
-	return *p.BuiltinVisibleVersion
-}
-
-var Evaluator_BoxType_DEFAULT EvaluatorBoxType
-
-func (p *Evaluator) GetBoxType() (v EvaluatorBoxType) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetBoxType() {
-		return Evaluator_BoxType_DEFAULT
-	}
-	return *p.BoxType
-}
-
-var Evaluator_SourceType_DEFAULT EvaluatorSourceType
-
-func (p *Evaluator) GetSourceType() (v EvaluatorSourceType) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetSourceType() {
-		return Evaluator_SourceType_DEFAULT
-	}
-	return *p.SourceType
-}
-
-var Evaluator_Tags_DEFAULT map[EvaluatorTagLangType]map[EvaluatorTagKey][]string
-
-func (p *Evaluator) GetTags() (v map[EvaluatorTagLangType]map[EvaluatorTagKey][]string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetTags() {
-		return Evaluator_Tags_DEFAULT
-	}
-	return p.Tags
-}
-func (p *Evaluator) SetEvaluatorID(val *int64) {
-	p.EvaluatorID = val
-}
-func (p *Evaluator) SetWorkspaceID(val *int64) {
-	p.WorkspaceID = val
-}
-func (p *Evaluator) SetEvaluatorType(val *EvaluatorType) {
-	p.EvaluatorType = val
+func (p *EvaluatorVersion) SetID(val *int64) {
+	p.ID = val
 }
-func (p *Evaluator) SetName(val *string) {
-	p.Name = val
+func (p *EvaluatorVersion) SetVersion(val *string) {
+	p.Version = val
 }
-func (p *Evaluator) SetDescription(val *string) {
+func (p *EvaluatorVersion) SetDescription(val *string) {
 	p.Description = val
 }
-func (p *Evaluator) SetDraftSubmitted(val *bool) {
-	p.DraftSubmitted = val
-}
-func (p *Evaluator) SetBaseInfo(val *common.BaseInfo) {
+func (p *EvaluatorVersion) SetBaseInfo(val *common.BaseInfo) {
 	p.BaseInfo = val
 }
-func (p *Evaluator) SetCurrentVersion(val *EvaluatorVersion) {
-	p.CurrentVersion = val
-}
-func (p *Evaluator) SetLatestVersion(val *string) {
-	p.LatestVersion = val
-}
-func (p *Evaluator) SetBuiltin(val *bool) {
-	p.Builtin = val
-}
-func (p *Evaluator) SetEvaluatorInfo(val *EvaluatorInfo) {
-	p.EvaluatorInfo = val
-}
-func (p *Evaluator) SetBuiltinVisibleVersion(val *string) {
-	p.BuiltinVisibleVersion = val
-}
-func (p *Evaluator) SetBoxType(val *EvaluatorBoxType) {
-	p.BoxType = val
-}
-func (p *Evaluator) SetSourceType(val *EvaluatorSourceType) {
-	p.SourceType = val
-}
-func (p *Evaluator) SetTags(val map[EvaluatorTagLangType]map[EvaluatorTagKey][]string) {
-	p.Tags = val
-}
-
-var fieldIDToName_Evaluator = map[int16]string{
-	1:   "evaluator_id",
-	2:   "workspace_id",
-	3:   "evaluator_type",
-	4:   "name",
-	5:   "description",
-	6:   "draft_submitted",
-	7:   "base_info",
-	11:  "current_version",
-	12:  "latest_version",
-	20:  "builtin",
-	21:  "evaluator_info",
-	22:  "builtin_visible_version",
-	23:  "box_type",
-	24:  "source_type",
-	100: "tags",
-}
-
-func (p *Evaluator) IsSetEvaluatorID() bool {
-	return p.EvaluatorID != nil
+func (p *EvaluatorVersion) SetEvaluatorContent(val *EvaluatorContent) {
+	p.EvaluatorContent = val
 }
 
-func (p *Evaluator) IsSetWorkspaceID() bool {
-	return p.WorkspaceID != nil
+var fieldIDToName_EvaluatorVersion = map[int16]string{
+	1: "id",
+	3: "version",
+	4: "description",
+	5: "base_info",
+	6: "evaluator_content",
 }
 
-func (p *Evaluator) IsSetEvaluatorType() bool {
-	return p.EvaluatorType != nil
+func (p *EvaluatorVersion) IsSetID() bool {
+	return p.ID != nil
 }
 
-func (p *Evaluator) IsSetName() bool {
-	return p.Name != nil
+func (p *EvaluatorVersion) IsSetVersion() bool {
+	return p.Version != nil
 }
 
-func (p *Evaluator) IsSetDescription() bool {
+func (p *EvaluatorVersion) IsSetDescription() bool {
 	return p.Description != nil
 }
 
-func (p *Evaluator) IsSetDraftSubmitted() bool {
-	return p.DraftSubmitted != nil
-}
-
-func (p *Evaluator) IsSetBaseInfo() bool {
+func (p *EvaluatorVersion) IsSetBaseInfo() bool {
 	return p.BaseInfo != nil
 }
 
-func (p *Evaluator) IsSetCurrentVersion() bool {
-	return p.CurrentVersion != nil
-}
-
-func (p *Evaluator) IsSetLatestVersion() bool {
-	return p.LatestVersion != nil
-}
-
-func (p *Evaluator) IsSetBuiltin() bool {
-	return p.Builtin != nil
-}
-
-func (p *Evaluator) IsSetEvaluatorInfo() bool {
-	return p.EvaluatorInfo != nil
-}
-
-func (p *Evaluator) IsSetBuiltinVisibleVersion() bool {
-	return p.BuiltinVisibleVersion != nil
-}
-
-func (p *Evaluator) IsSetBoxType() bool {
-	return p.BoxType != nil
-}
-
-func (p *Evaluator) IsSetSourceType() bool {
-	return p.SourceType != nil
-}
-
-func (p *Evaluator) IsSetTags() bool {
-	return p.Tags != nil
+func (p *EvaluatorVersion) IsSetEvaluatorContent() bool {
+	return p.EvaluatorContent != nil
 }
 
-func (p *Evaluator) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorVersion) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6268,16 +6371,8 @@ func (p *Evaluator) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6293,7 +6388,7 @@ func (p *Evaluator) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6301,80 +6396,8 @@ func (p *Evaluator) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 6:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 11:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField11(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 12:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField12(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 20:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField20(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 21:
 			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField21(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 22:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField22(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 23:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField23(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 24:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField24(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 100:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField100(iprot); err != nil {
+				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -6399,7 +6422,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Evaluator[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorVersion[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6409,18 +6432,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *Evaluator) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.EvaluatorID = _field
-	return nil
-}
-func (p *Evaluator) ReadField2(iprot thrift.TProtocol) error {
+func (p *EvaluatorVersion) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -6428,22 +6440,10 @@ func (p *Evaluator) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.WorkspaceID = _field
-	return nil
-}
-func (p *Evaluator) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *EvaluatorType
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		tmp := EvaluatorType(v)
-		_field = &tmp
-	}
-	p.EvaluatorType = _field
+	p.ID = _field
 	return nil
 }
-func (p *Evaluator) ReadField4(iprot thrift.TProtocol) error {
+func (p *EvaluatorVersion) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -6451,10 +6451,10 @@ func (p *Evaluator) ReadField4(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Name = _field
+	p.Version = _field
 	return nil
 }
-func (p *Evaluator) ReadField5(iprot thrift.TProtocol) error {
+func (p *EvaluatorVersion) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -6465,18 +6465,7 @@ func (p *Evaluator) ReadField5(iprot thrift.TProtocol) error {
 	p.Description = _field
 	return nil
 }
-func (p *Evaluator) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.DraftSubmitted = _field
-	return nil
-}
-func (p *Evaluator) ReadField7(iprot thrift.TProtocol) error {
+func (p *EvaluatorVersion) ReadField5(iprot thrift.TProtocol) error {
 	_field := common.NewBaseInfo()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -6484,286 +6473,106 @@ func (p *Evaluator) ReadField7(iprot thrift.TProtocol) error {
 	p.BaseInfo = _field
 	return nil
 }
-func (p *Evaluator) ReadField11(iprot thrift.TProtocol) error {
-	_field := NewEvaluatorVersion()
+func (p *EvaluatorVersion) ReadField6(iprot thrift.TProtocol) error {
+	_field := NewEvaluatorContent()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.CurrentVersion = _field
+	p.EvaluatorContent = _field
 	return nil
 }
-func (p *Evaluator) ReadField12(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *EvaluatorVersion) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorVersion"); err != nil {
+		goto WriteStructBeginError
 	}
-	p.LatestVersion = _field
-	return nil
-}
-func (p *Evaluator) ReadField20(iprot thrift.TProtocol) error {
-
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
-		return err
-	} else {
-		_field = &v
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
-	p.Builtin = _field
-	return nil
-}
-func (p *Evaluator) ReadField21(iprot thrift.TProtocol) error {
-	_field := NewEvaluatorInfo()
-	if err := _field.Read(iprot); err != nil {
-		return err
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	p.EvaluatorInfo = _field
 	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *Evaluator) ReadField22(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *EvaluatorVersion) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	p.BuiltinVisibleVersion = _field
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *Evaluator) ReadField23(iprot thrift.TProtocol) error {
-
-	var _field *EvaluatorBoxType
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *EvaluatorVersion) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersion() {
+		if err = oprot.WriteFieldBegin("version", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Version); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	p.BoxType = _field
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *Evaluator) ReadField24(iprot thrift.TProtocol) error {
-
-	var _field *EvaluatorSourceType
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.SourceType = _field
-	return nil
-}
-func (p *Evaluator) ReadField100(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[EvaluatorTagLangType]map[EvaluatorTagKey][]string, size)
-	for i := 0; i < size; i++ {
-		var _key EvaluatorTagLangType
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-		_, _, size, err := iprot.ReadMapBegin()
-		if err != nil {
-			return err
-		}
-		_val := make(map[EvaluatorTagKey][]string, size)
-		for i := 0; i < size; i++ {
-			var _key1 EvaluatorTagKey
-			if v, err := iprot.ReadString(); err != nil {
-				return err
-			} else {
-				_key1 = v
-			}
-			_, size, err := iprot.ReadListBegin()
-			if err != nil {
-				return err
-			}
-			_val1 := make([]string, 0, size)
-			for i := 0; i < size; i++ {
-
-				var _elem string
-				if v, err := iprot.ReadString(); err != nil {
-					return err
-				} else {
-					_elem = v
-				}
-
-				_val1 = append(_val1, _elem)
-			}
-			if err := iprot.ReadListEnd(); err != nil {
-				return err
-			}
-
-			_val[_key1] = _val1
-		}
-		if err := iprot.ReadMapEnd(); err != nil {
-			return err
-		}
-
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return err
-	}
-	p.Tags = _field
-	return nil
-}
-
-func (p *Evaluator) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("Evaluator"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
-			goto WriteFieldError
-		}
-		if err = p.writeField12(oprot); err != nil {
-			fieldId = 12
-			goto WriteFieldError
-		}
-		if err = p.writeField20(oprot); err != nil {
-			fieldId = 20
-			goto WriteFieldError
-		}
-		if err = p.writeField21(oprot); err != nil {
-			fieldId = 21
-			goto WriteFieldError
-		}
-		if err = p.writeField22(oprot); err != nil {
-			fieldId = 22
-			goto WriteFieldError
-		}
-		if err = p.writeField23(oprot); err != nil {
-			fieldId = 23
-			goto WriteFieldError
-		}
-		if err = p.writeField24(oprot); err != nil {
-			fieldId = 24
-			goto WriteFieldError
-		}
-		if err = p.writeField100(oprot); err != nil {
-			fieldId = 100
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
-}
-
-func (p *Evaluator) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorID() {
-		if err = oprot.WriteFieldBegin("evaluator_id", thrift.I64, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.EvaluatorID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *Evaluator) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetWorkspaceID() {
-		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-func (p *Evaluator) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorType() {
-		if err = oprot.WriteFieldBegin("evaluator_type", thrift.I32, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(int32(*p.EvaluatorType)); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *Evaluator) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetName() {
-		if err = oprot.WriteFieldBegin("name", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Name); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *EvaluatorVersion) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err = oprot.WriteFieldBegin("description", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Description); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -6771,12 +6580,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *Evaluator) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDescription() {
-		if err = oprot.WriteFieldBegin("description", thrift.STRING, 5); err != nil {
+func (p *EvaluatorVersion) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseInfo() {
+		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Description); err != nil {
+		if err := p.BaseInfo.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6789,12 +6598,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *Evaluator) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDraftSubmitted() {
-		if err = oprot.WriteFieldBegin("draft_submitted", thrift.BOOL, 6); err != nil {
+func (p *EvaluatorVersion) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorContent() {
+		if err = oprot.WriteFieldBegin("evaluator_content", thrift.STRUCT, 6); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.DraftSubmitted); err != nil {
+		if err := p.EvaluatorContent.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6807,665 +6616,315 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-func (p *Evaluator) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBaseInfo() {
-		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 7); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.BaseInfo.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+
+func (p *EvaluatorVersion) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return fmt.Sprintf("EvaluatorVersion(%+v)", *p)
+
 }
-func (p *Evaluator) writeField11(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCurrentVersion() {
-		if err = oprot.WriteFieldBegin("current_version", thrift.STRUCT, 11); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.CurrentVersion.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
-}
-func (p *Evaluator) writeField12(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLatestVersion() {
-		if err = oprot.WriteFieldBegin("latest_version", thrift.STRING, 12); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.LatestVersion); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
-}
-func (p *Evaluator) writeField20(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBuiltin() {
-		if err = oprot.WriteFieldBegin("builtin", thrift.BOOL, 20); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteBool(*p.Builtin); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 20 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 20 end error: ", p), err)
-}
-func (p *Evaluator) writeField21(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorInfo() {
-		if err = oprot.WriteFieldBegin("evaluator_info", thrift.STRUCT, 21); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.EvaluatorInfo.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 21 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 21 end error: ", p), err)
-}
-func (p *Evaluator) writeField22(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBuiltinVisibleVersion() {
-		if err = oprot.WriteFieldBegin("builtin_visible_version", thrift.STRING, 22); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.BuiltinVisibleVersion); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 22 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 22 end error: ", p), err)
-}
-func (p *Evaluator) writeField23(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBoxType() {
-		if err = oprot.WriteFieldBegin("box_type", thrift.STRING, 23); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.BoxType); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 23 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 23 end error: ", p), err)
-}
-func (p *Evaluator) writeField24(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSourceType() {
-		if err = oprot.WriteFieldBegin("source_type", thrift.STRING, 24); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.SourceType); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 24 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 24 end error: ", p), err)
-}
-func (p *Evaluator) writeField100(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTags() {
-		if err = oprot.WriteFieldBegin("tags", thrift.MAP, 100); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.MAP, len(p.Tags)); err != nil {
-			return err
-		}
-		for k, v := range p.Tags {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteMapBegin(thrift.STRING, thrift.LIST, len(v)); err != nil {
-				return err
-			}
-			for k, v := range v {
-				if err := oprot.WriteString(k); err != nil {
-					return err
-				}
-				if err := oprot.WriteListBegin(thrift.STRING, len(v)); err != nil {
-					return err
-				}
-				for _, v := range v {
-					if err := oprot.WriteString(v); err != nil {
-						return err
-					}
-				}
-				if err := oprot.WriteListEnd(); err != nil {
-					return err
-				}
-			}
-			if err := oprot.WriteMapEnd(); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteMapEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
-}
-
-func (p *Evaluator) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("Evaluator(%+v)", *p)
 
-}
-
-func (p *Evaluator) DeepEqual(ano *Evaluator) bool {
+func (p *EvaluatorVersion) DeepEqual(ano *EvaluatorVersion) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.EvaluatorID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.WorkspaceID) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.EvaluatorType) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.Name) {
-		return false
-	}
-	if !p.Field5DeepEqual(ano.Description) {
-		return false
-	}
-	if !p.Field6DeepEqual(ano.DraftSubmitted) {
-		return false
-	}
-	if !p.Field7DeepEqual(ano.BaseInfo) {
-		return false
-	}
-	if !p.Field11DeepEqual(ano.CurrentVersion) {
-		return false
-	}
-	if !p.Field12DeepEqual(ano.LatestVersion) {
-		return false
-	}
-	if !p.Field20DeepEqual(ano.Builtin) {
-		return false
-	}
-	if !p.Field21DeepEqual(ano.EvaluatorInfo) {
+	if !p.Field1DeepEqual(ano.ID) {
 		return false
 	}
-	if !p.Field22DeepEqual(ano.BuiltinVisibleVersion) {
+	if !p.Field3DeepEqual(ano.Version) {
 		return false
 	}
-	if !p.Field23DeepEqual(ano.BoxType) {
+	if !p.Field4DeepEqual(ano.Description) {
 		return false
 	}
-	if !p.Field24DeepEqual(ano.SourceType) {
+	if !p.Field5DeepEqual(ano.BaseInfo) {
 		return false
 	}
-	if !p.Field100DeepEqual(ano.Tags) {
+	if !p.Field6DeepEqual(ano.EvaluatorContent) {
 		return false
 	}
 	return true
 }
 
-func (p *Evaluator) Field1DeepEqual(src *int64) bool {
+func (p *EvaluatorVersion) Field1DeepEqual(src *int64) bool {
 
-	if p.EvaluatorID == src {
+	if p.ID == src {
 		return true
-	} else if p.EvaluatorID == nil || src == nil {
+	} else if p.ID == nil || src == nil {
 		return false
 	}
-	if *p.EvaluatorID != *src {
+	if *p.ID != *src {
 		return false
 	}
 	return true
 }
-func (p *Evaluator) Field2DeepEqual(src *int64) bool {
+func (p *EvaluatorVersion) Field3DeepEqual(src *string) bool {
 
-	if p.WorkspaceID == src {
+	if p.Version == src {
 		return true
-	} else if p.WorkspaceID == nil || src == nil {
+	} else if p.Version == nil || src == nil {
 		return false
 	}
-	if *p.WorkspaceID != *src {
+	if strings.Compare(*p.Version, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *Evaluator) Field3DeepEqual(src *EvaluatorType) bool {
+func (p *EvaluatorVersion) Field4DeepEqual(src *string) bool {
 
-	if p.EvaluatorType == src {
+	if p.Description == src {
 		return true
-	} else if p.EvaluatorType == nil || src == nil {
+	} else if p.Description == nil || src == nil {
 		return false
 	}
-	if *p.EvaluatorType != *src {
+	if strings.Compare(*p.Description, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *Evaluator) Field4DeepEqual(src *string) bool {
+func (p *EvaluatorVersion) Field5DeepEqual(src *common.BaseInfo) bool {
 
-	if p.Name == src {
-		return true
-	} else if p.Name == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.Name, *src) != 0 {
+	if !p.BaseInfo.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *Evaluator) Field5DeepEqual(src *string) bool {
+func (p *EvaluatorVersion) Field6DeepEqual(src *EvaluatorContent) bool {
 
-	if p.Description == src {
-		return true
-	} else if p.Description == nil || src == nil {
+	if !p.EvaluatorContent.DeepEqual(src) {
 		return false
 	}
-	if strings.Compare(*p.Description, *src) != 0 {
-		return false
-	}
-	return true
-}
-func (p *Evaluator) Field6DeepEqual(src *bool) bool {
-
-	if p.DraftSubmitted == src {
-		return true
-	} else if p.DraftSubmitted == nil || src == nil {
-		return false
-	}
-	if *p.DraftSubmitted != *src {
-		return false
-	}
-	return true
-}
-func (p *Evaluator) Field7DeepEqual(src *common.BaseInfo) bool {
-
-	if !p.BaseInfo.DeepEqual(src) {
-		return false
-	}
-	return true
-}
-func (p *Evaluator) Field11DeepEqual(src *EvaluatorVersion) bool {
-
-	if !p.CurrentVersion.DeepEqual(src) {
-		return false
-	}
-	return true
-}
-func (p *Evaluator) Field12DeepEqual(src *string) bool {
-
-	if p.LatestVersion == src {
-		return true
-	} else if p.LatestVersion == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.LatestVersion, *src) != 0 {
-		return false
-	}
-	return true
-}
-func (p *Evaluator) Field20DeepEqual(src *bool) bool {
-
-	if p.Builtin == src {
-		return true
-	} else if p.Builtin == nil || src == nil {
-		return false
-	}
-	if *p.Builtin != *src {
-		return false
-	}
-	return true
-}
-func (p *Evaluator) Field21DeepEqual(src *EvaluatorInfo) bool {
-
-	if !p.EvaluatorInfo.DeepEqual(src) {
-		return false
-	}
-	return true
-}
-func (p *Evaluator) Field22DeepEqual(src *string) bool {
-
-	if p.BuiltinVisibleVersion == src {
-		return true
-	} else if p.BuiltinVisibleVersion == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.BuiltinVisibleVersion, *src) != 0 {
-		return false
-	}
-	return true
-}
-func (p *Evaluator) Field23DeepEqual(src *EvaluatorBoxType) bool {
-
-	if p.BoxType == src {
-		return true
-	} else if p.BoxType == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.BoxType, *src) != 0 {
-		return false
-	}
-	return true
-}
-func (p *Evaluator) Field24DeepEqual(src *EvaluatorSourceType) bool {
-
-	if p.SourceType == src {
-		return true
-	} else if p.SourceType == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.SourceType, *src) != 0 {
-		return false
-	}
-	return true
-}
-func (p *Evaluator) Field100DeepEqual(src map[EvaluatorTagLangType]map[EvaluatorTagKey][]string) bool {
-
-	if len(p.Tags) != len(src) {
-		return false
-	}
-	for k, v := range p.Tags {
-		_src := src[k]
-		if len(v) != len(_src) {
-			return false
-		}
-		for k, v := range v {
-			_src1 := _src[k]
-			if len(v) != len(_src1) {
-				return false
-			}
-			for i, v := range v {
-				_src2 := _src1[i]
-				if strings.Compare(v, _src2) != 0 {
-					return false
-				}
-			}
-		}
-	}
 	return true
 }
 
-type EvaluatorTemplate struct {
-	ID            *int64         `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id" form:"id" query:"id"`
-	WorkspaceID   *int64         `thrift:"workspace_id,2,optional" frugal:"2,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
-	EvaluatorType *EvaluatorType `thrift:"evaluator_type,3,optional" frugal:"3,optional,EvaluatorType" form:"evaluator_type" json:"evaluator_type,omitempty" query:"evaluator_type"`
-	Name          *string        `thrift:"name,4,optional" frugal:"4,optional,string" form:"name" json:"name,omitempty" query:"name"`
-	Description   *string        `thrift:"description,5,optional" frugal:"5,optional,string" form:"description" json:"description,omitempty" query:"description"`
-	// 热度
-	Popularity       *int64                                                `thrift:"popularity,6,optional" frugal:"6,optional,i64" json:"popularity" form:"popularity" query:"popularity"`
-	EvaluatorInfo    *EvaluatorInfo                                        `thrift:"evaluator_info,7,optional" frugal:"7,optional,EvaluatorInfo" json:"evaluator_info" form:"evaluator_info" query:"evaluator_info"`
-	Tags             map[EvaluatorTagLangType]map[EvaluatorTagKey][]string `thrift:"tags,9,optional" frugal:"9,optional,map<string:map<string:list<string>>>" json:"tags" form:"tags" query:"tags"`
-	EvaluatorContent *EvaluatorContent                                     `thrift:"evaluator_content,101,optional" frugal:"101,optional,EvaluatorContent" form:"evaluator_content" json:"evaluator_content,omitempty" query:"evaluator_content"`
-	BaseInfo         *common.BaseInfo                                      `thrift:"base_info,255,optional" frugal:"255,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+type EvaluatorContent struct {
+	ReceiveChatHistory *bool                `thrift:"receive_chat_history,1,optional" frugal:"1,optional,bool" mapstructure:"receive_chat_history" form:"receive_chat_history" json:"receive_chat_history,omitempty" query:"receive_chat_history"`
+	InputSchemas       []*common.ArgsSchema `thrift:"input_schemas,2,optional" frugal:"2,optional,list<common.ArgsSchema>" mapstructure:"input_schemas" form:"input_schemas" json:"input_schemas,omitempty" query:"input_schemas"`
+	OutputSchemas      []*common.ArgsSchema `thrift:"output_schemas,3,optional" frugal:"3,optional,list<common.ArgsSchema>" mapstructure:"output_schemas" form:"output_schemas" json:"output_schemas,omitempty" query:"output_schemas"`
+	// 101-200 Evaluator类型
+	PromptEvaluator    *PromptEvaluator    `thrift:"prompt_evaluator,101,optional" frugal:"101,optional,PromptEvaluator" mapstructure:"prompt_evaluator" form:"prompt_evaluator" json:"prompt_evaluator,omitempty" query:"prompt_evaluator"`
+	CodeEvaluator      *CodeEvaluator      `thrift:"code_evaluator,102,optional" frugal:"102,optional,CodeEvaluator" form:"code_evaluator" json:"code_evaluator,omitempty" query:"code_evaluator"`
+	CustomRPCEvaluator *CustomRPCEvaluator `thrift:"custom_rpc_evaluator,103,optional" frugal:"103,optional,CustomRPCEvaluator" form:"custom_rpc_evaluator" json:"custom_rpc_evaluator,omitempty" query:"custom_rpc_evaluator"`
+	AgentEvaluator     *AgentEvaluator     `thrift:"agent_evaluator,104,optional" frugal:"104,optional,AgentEvaluator" form:"agent_evaluator" json:"agent_evaluator,omitempty" query:"agent_evaluator"`
+	PairwiseEvaluator  *PairwiseEvaluator  `thrift:"pairwise_evaluator,105,optional" frugal:"105,optional,PairwiseEvaluator" form:"pairwise_evaluator" json:"pairwise_evaluator,omitempty" query:"pairwise_evaluator"`
+	RuleEvaluator      *RuleEvaluator      `thrift:"rule_evaluator,106,optional" frugal:"106,optional,RuleEvaluator" form:"rule_evaluator" json:"rule_evaluator,omitempty" query:"rule_evaluator"`
+	CompositeEvaluator *CompositeEvaluator `thrift:"composite_evaluator,107,optional" frugal:"107,optional,CompositeEvaluator" form:"composite_evaluator" json:"composite_evaluator,omitempty" query:"composite_evaluator"`
 }
 
-func NewEvaluatorTemplate() *EvaluatorTemplate {
-	return &EvaluatorTemplate{}
+func NewEvaluatorContent() *EvaluatorContent {
+	return &EvaluatorContent{}
 }
 
-func (p *EvaluatorTemplate) InitDefault() {
+func (p *EvaluatorContent) InitDefault() {
 }
 
-var EvaluatorTemplate_ID_DEFAULT int64
+var EvaluatorContent_ReceiveChatHistory_DEFAULT bool
 
-func (p *EvaluatorTemplate) GetID() (v int64) {
+func (p *EvaluatorContent) GetReceiveChatHistory() (v bool) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetID() {
-		return EvaluatorTemplate_ID_DEFAULT
+	if !p.IsSetReceiveChatHistory() {
+		return EvaluatorContent_ReceiveChatHistory_DEFAULT
 	}
-	return *p.ID
+	return *p.ReceiveChatHistory
 }
 
-var EvaluatorTemplate_WorkspaceID_DEFAULT int64
+var EvaluatorContent_InputSchemas_DEFAULT []*common.ArgsSchema
 
-func (p *EvaluatorTemplate) GetWorkspaceID() (v int64) {
+func (p *EvaluatorContent) GetInputSchemas() (v []*common.ArgsSchema) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetWorkspaceID() {
-		return EvaluatorTemplate_WorkspaceID_DEFAULT
+	if !p.IsSetInputSchemas() {
+		return EvaluatorContent_InputSchemas_DEFAULT
 	}
-	return *p.WorkspaceID
+	return p.InputSchemas
 }
 
-var EvaluatorTemplate_EvaluatorType_DEFAULT EvaluatorType
+var EvaluatorContent_OutputSchemas_DEFAULT []*common.ArgsSchema
 
-func (p *EvaluatorTemplate) GetEvaluatorType() (v EvaluatorType) {
+func (p *EvaluatorContent) GetOutputSchemas() (v []*common.ArgsSchema) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEvaluatorType() {
-		return EvaluatorTemplate_EvaluatorType_DEFAULT
+	if !p.IsSetOutputSchemas() {
+		return EvaluatorContent_OutputSchemas_DEFAULT
 	}
-	return *p.EvaluatorType
+	return p.OutputSchemas
 }
 
-var EvaluatorTemplate_Name_DEFAULT string
+var EvaluatorContent_PromptEvaluator_DEFAULT *PromptEvaluator
 
-func (p *EvaluatorTemplate) GetName() (v string) {
+func (p *EvaluatorContent) GetPromptEvaluator() (v *PromptEvaluator) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetName() {
-		return EvaluatorTemplate_Name_DEFAULT
+	if !p.IsSetPromptEvaluator() {
+		return EvaluatorContent_PromptEvaluator_DEFAULT
 	}
-	return *p.Name
+	return p.PromptEvaluator
 }
 
-var EvaluatorTemplate_Description_DEFAULT string
+var EvaluatorContent_CodeEvaluator_DEFAULT *CodeEvaluator
 
-func (p *EvaluatorTemplate) GetDescription() (v string) {
+func (p *EvaluatorContent) GetCodeEvaluator() (v *CodeEvaluator) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDescription() {
-		return EvaluatorTemplate_Description_DEFAULT
+	if !p.IsSetCodeEvaluator() {
+		return EvaluatorContent_CodeEvaluator_DEFAULT
 	}
-	return *p.Description
+	return p.CodeEvaluator
 }
 
-var EvaluatorTemplate_Popularity_DEFAULT int64
+var EvaluatorContent_CustomRPCEvaluator_DEFAULT *CustomRPCEvaluator
 
-func (p *EvaluatorTemplate) GetPopularity() (v int64) {
+func (p *EvaluatorContent) GetCustomRPCEvaluator() (v *CustomRPCEvaluator) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetPopularity() {
-		return EvaluatorTemplate_Popularity_DEFAULT
+	if !p.IsSetCustomRPCEvaluator() {
+		return EvaluatorContent_CustomRPCEvaluator_DEFAULT
 	}
-	return *p.Popularity
+	return p.CustomRPCEvaluator
 }
 
-var EvaluatorTemplate_EvaluatorInfo_DEFAULT *EvaluatorInfo
+var EvaluatorContent_AgentEvaluator_DEFAULT *AgentEvaluator
 
-func (p *EvaluatorTemplate) GetEvaluatorInfo() (v *EvaluatorInfo) {
+func (p *EvaluatorContent) GetAgentEvaluator() (v *AgentEvaluator) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEvaluatorInfo() {
-		return EvaluatorTemplate_EvaluatorInfo_DEFAULT
+	if !p.IsSetAgentEvaluator() {
+		return EvaluatorContent_AgentEvaluator_DEFAULT
 	}
-	return p.EvaluatorInfo
+	return p.AgentEvaluator
 }
 
-var EvaluatorTemplate_Tags_DEFAULT map[EvaluatorTagLangType]map[EvaluatorTagKey][]string
+var EvaluatorContent_PairwiseEvaluator_DEFAULT *PairwiseEvaluator
 
-func (p *EvaluatorTemplate) GetTags() (v map[EvaluatorTagLangType]map[EvaluatorTagKey][]string) {
+func (p *EvaluatorContent) GetPairwiseEvaluator() (v *PairwiseEvaluator) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTags() {
-		return EvaluatorTemplate_Tags_DEFAULT
+	if !p.IsSetPairwiseEvaluator() {
+		return EvaluatorContent_PairwiseEvaluator_DEFAULT
 	}
-	return p.Tags
+	return p.PairwiseEvaluator
 }
 
-var EvaluatorTemplate_EvaluatorContent_DEFAULT *EvaluatorContent
+var EvaluatorContent_RuleEvaluator_DEFAULT *RuleEvaluator
 
-func (p *EvaluatorTemplate) GetEvaluatorContent() (v *EvaluatorContent) {
+func (p *EvaluatorContent) GetRuleEvaluator() (v *RuleEvaluator) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEvaluatorContent() {
-		return EvaluatorTemplate_EvaluatorContent_DEFAULT
+	if !p.IsSetRuleEvaluator() {
+		return EvaluatorContent_RuleEvaluator_DEFAULT
 	}
-	return p.EvaluatorContent
+	return p.RuleEvaluator
 }
 
-var EvaluatorTemplate_BaseInfo_DEFAULT *common.BaseInfo
+var EvaluatorContent_CompositeEvaluator_DEFAULT *CompositeEvaluator
 
-func (p *EvaluatorTemplate) GetBaseInfo() (v *common.BaseInfo) {
+func (p *EvaluatorContent) GetCompositeEvaluator() (v *CompositeEvaluator) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetBaseInfo() {
-		return EvaluatorTemplate_BaseInfo_DEFAULT
+	if !p.IsSetCompositeEvaluator() {
+		return EvaluatorContent_CompositeEvaluator_DEFAULT
 	}
-	return p.BaseInfo
+	return p.CompositeEvaluator
 }
-func (p *EvaluatorTemplate) SetID(val *int64) {
-	p.ID = val
+func (p *EvaluatorContent) SetReceiveChatHistory(val *bool) {
+	p.ReceiveChatHistory = val
 }
-func (p *EvaluatorTemplate) SetWorkspaceID(val *int64) {
-	p.WorkspaceID = val
+func (p *EvaluatorContent) SetInputSchemas(val []*common.ArgsSchema) {
+	p.InputSchemas = val
 }
-func (p *EvaluatorTemplate) SetEvaluatorType(val *EvaluatorType) {
-	p.EvaluatorType = val
+func (p *EvaluatorContent) SetOutputSchemas(val []*common.ArgsSchema) {
+	p.OutputSchemas = val
 }
-func (p *EvaluatorTemplate) SetName(val *string) {
-	p.Name = val
+func (p *EvaluatorContent) SetPromptEvaluator(val *PromptEvaluator) {
+	p.PromptEvaluator = val
 }
-func (p *EvaluatorTemplate) SetDescription(val *string) {
-	p.Description = val
+func (p *EvaluatorContent) SetCodeEvaluator(val *CodeEvaluator) {
+	p.CodeEvaluator = val
 }
-func (p *EvaluatorTemplate) SetPopularity(val *int64) {
-	p.Popularity = val
+func (p *EvaluatorContent) SetCustomRPCEvaluator(val *CustomRPCEvaluator) {
+	p.CustomRPCEvaluator = val
 }
-func (p *EvaluatorTemplate) SetEvaluatorInfo(val *EvaluatorInfo) {
-	p.EvaluatorInfo = val
+func (p *EvaluatorContent) SetAgentEvaluator(val *AgentEvaluator) {
+	p.AgentEvaluator = val
 }
-func (p *EvaluatorTemplate) SetTags(val map[EvaluatorTagLangType]map[EvaluatorTagKey][]string) {
-	p.Tags = val
+func (p *EvaluatorContent) SetPairwiseEvaluator(val *PairwiseEvaluator) {
+	p.PairwiseEvaluator = val
 }
-func (p *EvaluatorTemplate) SetEvaluatorContent(val *EvaluatorContent) {
-	p.EvaluatorContent = val
+func (p *EvaluatorContent) SetRuleEvaluator(val *RuleEvaluator) {
+	p.RuleEvaluator = val
 }
-func (p *EvaluatorTemplate) SetBaseInfo(val *common.BaseInfo) {
-	p.BaseInfo = val
+func (p *EvaluatorContent) SetCompositeEvaluator(val *CompositeEvaluator) {
+	p.CompositeEvaluator = val
 }
 
-var fieldIDToName_EvaluatorTemplate = map[int16]string{
-	1:   "id",
-	2:   "workspace_id",
-	3:   "evaluator_type",
-	4:   "name",
-	5:   "description",
-	6:   "popularity",
-	7:   "evaluator_info",
-	9:   "tags",
-	101: "evaluator_content",
-	255: "base_info",
+var fieldIDToName_EvaluatorContent = map[int16]string{
+	1:   "receive_chat_history",
+	2:   "input_schemas",
+	3:   "output_schemas",
+	101: "prompt_evaluator",
+	102: "code_evaluator",
+	103: "custom_rpc_evaluator",
+	104: "agent_evaluator",
+	105: "pairwise_evaluator",
+	106: "rule_evaluator",
+	107: "composite_evaluator",
 }
 
-func (p *EvaluatorTemplate) IsSetID() bool {
-	return p.ID != nil
+func (p *EvaluatorContent) IsSetReceiveChatHistory() bool {
+	return p.ReceiveChatHistory != nil
 }
 
-func (p *EvaluatorTemplate) IsSetWorkspaceID() bool {
-	return p.WorkspaceID != nil
+func (p *EvaluatorContent) IsSetInputSchemas() bool {
+	return p.InputSchemas != nil
 }
 
-func (p *EvaluatorTemplate) IsSetEvaluatorType() bool {
-	return p.EvaluatorType != nil
+func (p *EvaluatorContent) IsSetOutputSchemas() bool {
+	return p.OutputSchemas != nil
 }
 
-func (p *EvaluatorTemplate) IsSetName() bool {
-	return p.Name != nil
+func (p *EvaluatorContent) IsSetPromptEvaluator() bool {
+	return p.PromptEvaluator != nil
 }
 
-func (p *EvaluatorTemplate) IsSetDescription() bool {
-	return p.Description != nil
+func (p *EvaluatorContent) IsSetCodeEvaluator() bool {
+	return p.CodeEvaluator != nil
 }
 
-func (p *EvaluatorTemplate) IsSetPopularity() bool {
-	return p.Popularity != nil
+func (p *EvaluatorContent) IsSetCustomRPCEvaluator() bool {
+	return p.CustomRPCEvaluator != nil
 }
 
-func (p *EvaluatorTemplate) IsSetEvaluatorInfo() bool {
-	return p.EvaluatorInfo != nil
+func (p *EvaluatorContent) IsSetAgentEvaluator() bool {
+	return p.AgentEvaluator != nil
 }
 
-func (p *EvaluatorTemplate) IsSetTags() bool {
-	return p.Tags != nil
+func (p *EvaluatorContent) IsSetPairwiseEvaluator() bool {
+	return p.PairwiseEvaluator != nil
 }
 
-func (p *EvaluatorTemplate) IsSetEvaluatorContent() bool {
-	return p.EvaluatorContent != nil
+func (p *EvaluatorContent) IsSetRuleEvaluator() bool {
+	return p.RuleEvaluator != nil
 }
 
-func (p *EvaluatorTemplate) IsSetBaseInfo() bool {
-	return p.BaseInfo != nil
+func (p *EvaluatorContent) IsSetCompositeEvaluator() bool {
+	return p.CompositeEvaluator != nil
 }
 
-func (p *EvaluatorTemplate) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorContent) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7484,7 +6943,7 @@ func (p *EvaluatorTemplate) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7492,7 +6951,7 @@ func (p *EvaluatorTemplate) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7500,64 +6959,64 @@ func (p *EvaluatorTemplate) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
+		case 101:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField101(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
+		case 102:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField102(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField6(iprot); err != nil {
+		case 103:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField103(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 7:
+		case 104:
 			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField7(iprot); err != nil {
+				if err = p.ReadField104(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 9:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField9(iprot); err != nil {
+		case 105:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField105(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 101:
+		case 106:
 			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField101(iprot); err != nil {
+				if err = p.ReadField106(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 255:
+		case 107:
 			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField255(iprot); err != nil {
+				if err = p.ReadField107(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -7582,7 +7041,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorTemplate[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorContent[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7592,160 +7051,123 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorTemplate) ReadField1(iprot thrift.TProtocol) error {
+func (p *EvaluatorContent) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.ID = _field
+	p.ReceiveChatHistory = _field
 	return nil
 }
-func (p *EvaluatorTemplate) ReadField2(iprot thrift.TProtocol) error {
+func (p *EvaluatorContent) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*common.ArgsSchema, 0, size)
+	values := make([]common.ArgsSchema, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.WorkspaceID = _field
+	p.InputSchemas = _field
 	return nil
 }
-func (p *EvaluatorTemplate) ReadField3(iprot thrift.TProtocol) error {
+func (p *EvaluatorContent) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*common.ArgsSchema, 0, size)
+	values := make([]common.ArgsSchema, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *EvaluatorType
-	if v, err := iprot.ReadI32(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		tmp := EvaluatorType(v)
-		_field = &tmp
 	}
-	p.EvaluatorType = _field
+	p.OutputSchemas = _field
 	return nil
 }
-func (p *EvaluatorTemplate) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *EvaluatorContent) ReadField101(iprot thrift.TProtocol) error {
+	_field := NewPromptEvaluator()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Name = _field
+	p.PromptEvaluator = _field
 	return nil
 }
-func (p *EvaluatorTemplate) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *EvaluatorContent) ReadField102(iprot thrift.TProtocol) error {
+	_field := NewCodeEvaluator()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Description = _field
+	p.CodeEvaluator = _field
 	return nil
 }
-func (p *EvaluatorTemplate) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *EvaluatorContent) ReadField103(iprot thrift.TProtocol) error {
+	_field := NewCustomRPCEvaluator()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Popularity = _field
+	p.CustomRPCEvaluator = _field
 	return nil
 }
-func (p *EvaluatorTemplate) ReadField7(iprot thrift.TProtocol) error {
-	_field := NewEvaluatorInfo()
+func (p *EvaluatorContent) ReadField104(iprot thrift.TProtocol) error {
+	_field := NewAgentEvaluator()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.EvaluatorInfo = _field
+	p.AgentEvaluator = _field
 	return nil
 }
-func (p *EvaluatorTemplate) ReadField9(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
+func (p *EvaluatorContent) ReadField105(iprot thrift.TProtocol) error {
+	_field := NewPairwiseEvaluator()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	_field := make(map[EvaluatorTagLangType]map[EvaluatorTagKey][]string, size)
-	for i := 0; i < size; i++ {
-		var _key EvaluatorTagLangType
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-		_, _, size, err := iprot.ReadMapBegin()
-		if err != nil {
-			return err
-		}
-		_val := make(map[EvaluatorTagKey][]string, size)
-		for i := 0; i < size; i++ {
-			var _key1 EvaluatorTagKey
-			if v, err := iprot.ReadString(); err != nil {
-				return err
-			} else {
-				_key1 = v
-			}
-			_, size, err := iprot.ReadListBegin()
-			if err != nil {
-				return err
-			}
-			_val1 := make([]string, 0, size)
-			for i := 0; i < size; i++ {
-
-				var _elem string
-				if v, err := iprot.ReadString(); err != nil {
-					return err
-				} else {
-					_elem = v
-				}
-
-				_val1 = append(_val1, _elem)
-			}
-			if err := iprot.ReadListEnd(); err != nil {
-				return err
-			}
-
-			_val[_key1] = _val1
-		}
-		if err := iprot.ReadMapEnd(); err != nil {
-			return err
-		}
-
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
+	p.PairwiseEvaluator = _field
+	return nil
+}
+func (p *EvaluatorContent) ReadField106(iprot thrift.TProtocol) error {
+	_field := NewRuleEvaluator()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Tags = _field
+	p.RuleEvaluator = _field
 	return nil
 }
-func (p *EvaluatorTemplate) ReadField101(iprot thrift.TProtocol) error {
-	_field := NewEvaluatorContent()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.EvaluatorContent = _field
-	return nil
-}
-func (p *EvaluatorTemplate) ReadField255(iprot thrift.TProtocol) error {
-	_field := common.NewBaseInfo()
+func (p *EvaluatorContent) ReadField107(iprot thrift.TProtocol) error {
+	_field := NewCompositeEvaluator()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.BaseInfo = _field
+	p.CompositeEvaluator = _field
 	return nil
 }
 
-func (p *EvaluatorTemplate) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorContent) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorTemplate"); err != nil {
+	if err = oprot.WriteStructBegin("EvaluatorContent"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7761,32 +7183,32 @@ func (p *EvaluatorTemplate) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
+		if err = p.writeField101(oprot); err != nil {
+			fieldId = 101
 			goto WriteFieldError
 		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
+		if err = p.writeField102(oprot); err != nil {
+			fieldId = 102
 			goto WriteFieldError
 		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
+		if err = p.writeField103(oprot); err != nil {
+			fieldId = 103
 			goto WriteFieldError
 		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
+		if err = p.writeField104(oprot); err != nil {
+			fieldId = 104
 			goto WriteFieldError
 		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
+		if err = p.writeField105(oprot); err != nil {
+			fieldId = 105
 			goto WriteFieldError
 		}
-		if err = p.writeField101(oprot); err != nil {
-			fieldId = 101
+		if err = p.writeField106(oprot); err != nil {
+			fieldId = 106
 			goto WriteFieldError
 		}
-		if err = p.writeField255(oprot); err != nil {
-			fieldId = 255
+		if err = p.writeField107(oprot); err != nil {
+			fieldId = 107
 			goto WriteFieldError
 		}
 	}
@@ -7807,12 +7229,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorTemplate) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetID() {
-		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *EvaluatorContent) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReceiveChatHistory() {
+		if err = oprot.WriteFieldBegin("receive_chat_history", thrift.BOOL, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.ID); err != nil {
+		if err := oprot.WriteBool(*p.ReceiveChatHistory); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7825,12 +7247,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorTemplate) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetWorkspaceID() {
-		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
+func (p *EvaluatorContent) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetInputSchemas() {
+		if err = oprot.WriteFieldBegin("input_schemas", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.InputSchemas)); err != nil {
+			return err
+		}
+		for _, v := range p.InputSchemas {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7843,12 +7273,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *EvaluatorTemplate) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorType() {
-		if err = oprot.WriteFieldBegin("evaluator_type", thrift.I32, 3); err != nil {
+func (p *EvaluatorContent) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputSchemas() {
+		if err = oprot.WriteFieldBegin("output_schemas", thrift.LIST, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(int32(*p.EvaluatorType)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.OutputSchemas)); err != nil {
+			return err
+		}
+		for _, v := range p.OutputSchemas {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7861,12 +7299,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *EvaluatorTemplate) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetName() {
-		if err = oprot.WriteFieldBegin("name", thrift.STRING, 4); err != nil {
+func (p *EvaluatorContent) writeField101(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPromptEvaluator() {
+		if err = oprot.WriteFieldBegin("prompt_evaluator", thrift.STRUCT, 101); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Name); err != nil {
+		if err := p.PromptEvaluator.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7875,16 +7313,16 @@ func (p *EvaluatorTemplate) writeField4(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 101 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 101 end error: ", p), err)
 }
-func (p *EvaluatorTemplate) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDescription() {
-		if err = oprot.WriteFieldBegin("description", thrift.STRING, 5); err != nil {
+func (p *EvaluatorContent) writeField102(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCodeEvaluator() {
+		if err = oprot.WriteFieldBegin("code_evaluator", thrift.STRUCT, 102); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Description); err != nil {
+		if err := p.CodeEvaluator.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7893,16 +7331,16 @@ func (p *EvaluatorTemplate) writeField5(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 102 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 102 end error: ", p), err)
 }
-func (p *EvaluatorTemplate) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPopularity() {
-		if err = oprot.WriteFieldBegin("popularity", thrift.I64, 6); err != nil {
+func (p *EvaluatorContent) writeField103(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCustomRPCEvaluator() {
+		if err = oprot.WriteFieldBegin("custom_rpc_evaluator", thrift.STRUCT, 103); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.Popularity); err != nil {
+		if err := p.CustomRPCEvaluator.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7911,16 +7349,16 @@ func (p *EvaluatorTemplate) writeField6(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 103 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 103 end error: ", p), err)
 }
-func (p *EvaluatorTemplate) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorInfo() {
-		if err = oprot.WriteFieldBegin("evaluator_info", thrift.STRUCT, 7); err != nil {
+func (p *EvaluatorContent) writeField104(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAgentEvaluator() {
+		if err = oprot.WriteFieldBegin("agent_evaluator", thrift.STRUCT, 104); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.EvaluatorInfo.Write(oprot); err != nil {
+		if err := p.AgentEvaluator.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7929,46 +7367,16 @@ func (p *EvaluatorTemplate) writeField7(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 104 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 104 end error: ", p), err)
 }
-func (p *EvaluatorTemplate) writeField9(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTags() {
-		if err = oprot.WriteFieldBegin("tags", thrift.MAP, 9); err != nil {
+func (p *EvaluatorContent) writeField105(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPairwiseEvaluator() {
+		if err = oprot.WriteFieldBegin("pairwise_evaluator", thrift.STRUCT, 105); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.MAP, len(p.Tags)); err != nil {
-			return err
-		}
-		for k, v := range p.Tags {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteMapBegin(thrift.STRING, thrift.LIST, len(v)); err != nil {
-				return err
-			}
-			for k, v := range v {
-				if err := oprot.WriteString(k); err != nil {
-					return err
-				}
-				if err := oprot.WriteListBegin(thrift.STRING, len(v)); err != nil {
-					return err
-				}
-				for _, v := range v {
-					if err := oprot.WriteString(v); err != nil {
-						return err
-					}
-				}
-				if err := oprot.WriteListEnd(); err != nil {
-					return err
-				}
-			}
-			if err := oprot.WriteMapEnd(); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteMapEnd(); err != nil {
+		if err := p.PairwiseEvaluator.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7977,16 +7385,16 @@ func (p *EvaluatorTemplate) writeField9(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 105 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 105 end error: ", p), err)
 }
-func (p *EvaluatorTemplate) writeField101(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorContent() {
-		if err = oprot.WriteFieldBegin("evaluator_content", thrift.STRUCT, 101); err != nil {
+func (p *EvaluatorContent) writeField106(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRuleEvaluator() {
+		if err = oprot.WriteFieldBegin("rule_evaluator", thrift.STRUCT, 106); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.EvaluatorContent.Write(oprot); err != nil {
+		if err := p.RuleEvaluator.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7995,16 +7403,16 @@ func (p *EvaluatorTemplate) writeField101(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 101 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 106 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 101 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 106 end error: ", p), err)
 }
-func (p *EvaluatorTemplate) writeField255(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBaseInfo() {
-		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 255); err != nil {
+func (p *EvaluatorContent) writeField107(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCompositeEvaluator() {
+		if err = oprot.WriteFieldBegin("composite_evaluator", thrift.STRUCT, 107); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.BaseInfo.Write(oprot); err != nil {
+		if err := p.CompositeEvaluator.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8013,236 +7421,266 @@ func (p *EvaluatorTemplate) writeField255(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 107 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 107 end error: ", p), err)
 }
 
-func (p *EvaluatorTemplate) String() string {
+func (p *EvaluatorContent) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorTemplate(%+v)", *p)
+	return fmt.Sprintf("EvaluatorContent(%+v)", *p)
 
 }
 
-func (p *EvaluatorTemplate) DeepEqual(ano *EvaluatorTemplate) bool {
+func (p *EvaluatorContent) DeepEqual(ano *EvaluatorContent) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ID) {
+	if !p.Field1DeepEqual(ano.ReceiveChatHistory) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.WorkspaceID) {
+	if !p.Field2DeepEqual(ano.InputSchemas) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.EvaluatorType) {
+	if !p.Field3DeepEqual(ano.OutputSchemas) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.Name) {
+	if !p.Field101DeepEqual(ano.PromptEvaluator) {
 		return false
 	}
-	if !p.Field5DeepEqual(ano.Description) {
+	if !p.Field102DeepEqual(ano.CodeEvaluator) {
 		return false
 	}
-	if !p.Field6DeepEqual(ano.Popularity) {
+	if !p.Field103DeepEqual(ano.CustomRPCEvaluator) {
 		return false
 	}
-	if !p.Field7DeepEqual(ano.EvaluatorInfo) {
+	if !p.Field104DeepEqual(ano.AgentEvaluator) {
 		return false
 	}
-	if !p.Field9DeepEqual(ano.Tags) {
+	if !p.Field105DeepEqual(ano.PairwiseEvaluator) {
 		return false
 	}
-	if !p.Field101DeepEqual(ano.EvaluatorContent) {
+	if !p.Field106DeepEqual(ano.RuleEvaluator) {
 		return false
 	}
-	if !p.Field255DeepEqual(ano.BaseInfo) {
+	if !p.Field107DeepEqual(ano.CompositeEvaluator) {
 		return false
 	}
 	return true
 }
 
-func (p *EvaluatorTemplate) Field1DeepEqual(src *int64) bool {
+func (p *EvaluatorContent) Field1DeepEqual(src *bool) bool {
 
-	if p.ID == src {
+	if p.ReceiveChatHistory == src {
 		return true
-	} else if p.ID == nil || src == nil {
+	} else if p.ReceiveChatHistory == nil || src == nil {
 		return false
 	}
-	if *p.ID != *src {
+	if *p.ReceiveChatHistory != *src {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorTemplate) Field2DeepEqual(src *int64) bool {
+func (p *EvaluatorContent) Field2DeepEqual(src []*common.ArgsSchema) bool {
 
-	if p.WorkspaceID == src {
-		return true
-	} else if p.WorkspaceID == nil || src == nil {
+	if len(p.InputSchemas) != len(src) {
 		return false
 	}
-	if *p.WorkspaceID != *src {
-		return false
+	for i, v := range p.InputSchemas {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
 	return true
 }
-func (p *EvaluatorTemplate) Field3DeepEqual(src *EvaluatorType) bool {
+func (p *EvaluatorContent) Field3DeepEqual(src []*common.ArgsSchema) bool {
 
-	if p.EvaluatorType == src {
-		return true
-	} else if p.EvaluatorType == nil || src == nil {
+	if len(p.OutputSchemas) != len(src) {
 		return false
 	}
-	if *p.EvaluatorType != *src {
-		return false
+	for i, v := range p.OutputSchemas {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
 	return true
 }
-func (p *EvaluatorTemplate) Field4DeepEqual(src *string) bool {
+func (p *EvaluatorContent) Field101DeepEqual(src *PromptEvaluator) bool {
 
-	if p.Name == src {
-		return true
-	} else if p.Name == nil || src == nil {
+	if !p.PromptEvaluator.DeepEqual(src) {
 		return false
 	}
-	if strings.Compare(*p.Name, *src) != 0 {
+	return true
+}
+func (p *EvaluatorContent) Field102DeepEqual(src *CodeEvaluator) bool {
+
+	if !p.CodeEvaluator.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorTemplate) Field5DeepEqual(src *string) bool {
+func (p *EvaluatorContent) Field103DeepEqual(src *CustomRPCEvaluator) bool {
 
-	if p.Description == src {
-		return true
-	} else if p.Description == nil || src == nil {
+	if !p.CustomRPCEvaluator.DeepEqual(src) {
 		return false
 	}
-	if strings.Compare(*p.Description, *src) != 0 {
+	return true
+}
+func (p *EvaluatorContent) Field104DeepEqual(src *AgentEvaluator) bool {
+
+	if !p.AgentEvaluator.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorTemplate) Field6DeepEqual(src *int64) bool {
+func (p *EvaluatorContent) Field105DeepEqual(src *PairwiseEvaluator) bool {
 
-	if p.Popularity == src {
-		return true
-	} else if p.Popularity == nil || src == nil {
-		return false
-	}
-	if *p.Popularity != *src {
+	if !p.PairwiseEvaluator.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorTemplate) Field7DeepEqual(src *EvaluatorInfo) bool {
+func (p *EvaluatorContent) Field106DeepEqual(src *RuleEvaluator) bool {
 
-	if !p.EvaluatorInfo.DeepEqual(src) {
+	if !p.RuleEvaluator.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorTemplate) Field9DeepEqual(src map[EvaluatorTagLangType]map[EvaluatorTagKey][]string) bool {
+func (p *EvaluatorContent) Field107DeepEqual(src *CompositeEvaluator) bool {
 
-	if len(p.Tags) != len(src) {
+	if !p.CompositeEvaluator.DeepEqual(src) {
 		return false
 	}
-	for k, v := range p.Tags {
-		_src := src[k]
-		if len(v) != len(_src) {
-			return false
-		}
-		for k, v := range v {
-			_src1 := _src[k]
-			if len(v) != len(_src1) {
-				return false
-			}
-			for i, v := range v {
-				_src2 := _src1[i]
-				if strings.Compare(v, _src2) != 0 {
-					return false
-				}
-			}
-		}
-	}
 	return true
 }
-func (p *EvaluatorTemplate) Field101DeepEqual(src *EvaluatorContent) bool {
 
-	if !p.EvaluatorContent.DeepEqual(src) {
-		return false
-	}
-	return true
+// 明确有顺序的 evaluator 与版本映射元素
+type EvaluatorIDVersionItem struct {
+	EvaluatorID        *int64              `thrift:"evaluator_id,1,optional" frugal:"1,optional,i64" json:"evaluator_id" form:"evaluator_id" query:"evaluator_id"`
+	Version            *string             `thrift:"version,2,optional" frugal:"2,optional,string" form:"version" json:"version,omitempty" query:"version"`
+	RunConfig          *EvaluatorRunConfig `thrift:"run_config,3,optional" frugal:"3,optional,EvaluatorRunConfig" json:"run_config" form:"run_config" query:"run_config"`
+	EvaluatorVersionID *int64              `thrift:"evaluator_version_id,4,optional" frugal:"4,optional,i64" json:"evaluator_version_id" form:"evaluator_version_id" query:"evaluator_version_id"`
+	ScoreWeight        *float64            `thrift:"score_weight,5,optional" frugal:"5,optional,double" json:"score_weight" form:"score_weight" query:"score_weight"`
 }
-func (p *EvaluatorTemplate) Field255DeepEqual(src *common.BaseInfo) bool {
 
-	if !p.BaseInfo.DeepEqual(src) {
-		return false
-	}
-	return true
+func NewEvaluatorIDVersionItem() *EvaluatorIDVersionItem {
+	return &EvaluatorIDVersionItem{}
 }
 
-// Evaluator筛选器选项
-type EvaluatorFilterOption struct {
-	// 模糊搜索关键词，在所有tag中搜索
-	SearchKeyword *string `thrift:"search_keyword,1,optional" frugal:"1,optional,string" form:"search_keyword" json:"search_keyword,omitempty" query:"search_keyword"`
-	// 筛选条件
-	Filters *EvaluatorFilters `thrift:"filters,2,optional" frugal:"2,optional,EvaluatorFilters" form:"filters" json:"filters,omitempty" query:"filters"`
+func (p *EvaluatorIDVersionItem) InitDefault() {
 }
 
-func NewEvaluatorFilterOption() *EvaluatorFilterOption {
-	return &EvaluatorFilterOption{}
+var EvaluatorIDVersionItem_EvaluatorID_DEFAULT int64
+
+func (p *EvaluatorIDVersionItem) GetEvaluatorID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorID() {
+		return EvaluatorIDVersionItem_EvaluatorID_DEFAULT
+	}
+	return *p.EvaluatorID
 }
 
-func (p *EvaluatorFilterOption) InitDefault() {
+var EvaluatorIDVersionItem_Version_DEFAULT string
+
+func (p *EvaluatorIDVersionItem) GetVersion() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetVersion() {
+		return EvaluatorIDVersionItem_Version_DEFAULT
+	}
+	return *p.Version
 }
 
-var EvaluatorFilterOption_SearchKeyword_DEFAULT string
+var EvaluatorIDVersionItem_RunConfig_DEFAULT *EvaluatorRunConfig
 
-func (p *EvaluatorFilterOption) GetSearchKeyword() (v string) {
+func (p *EvaluatorIDVersionItem) GetRunConfig() (v *EvaluatorRunConfig) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetSearchKeyword() {
-		return EvaluatorFilterOption_SearchKeyword_DEFAULT
+	if !p.IsSetRunConfig() {
+		return EvaluatorIDVersionItem_RunConfig_DEFAULT
 	}
-	return *p.SearchKeyword
+	return p.RunConfig
 }
 
-var EvaluatorFilterOption_Filters_DEFAULT *EvaluatorFilters
+var EvaluatorIDVersionItem_EvaluatorVersionID_DEFAULT int64
 
-func (p *EvaluatorFilterOption) GetFilters() (v *EvaluatorFilters) {
+func (p *EvaluatorIDVersionItem) GetEvaluatorVersionID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFilters() {
-		return EvaluatorFilterOption_Filters_DEFAULT
+	if !p.IsSetEvaluatorVersionID() {
+		return EvaluatorIDVersionItem_EvaluatorVersionID_DEFAULT
 	}
-	return p.Filters
+	return *p.EvaluatorVersionID
 }
-func (p *EvaluatorFilterOption) SetSearchKeyword(val *string) {
-	p.SearchKeyword = val
+
+var EvaluatorIDVersionItem_ScoreWeight_DEFAULT float64
+
+func (p *EvaluatorIDVersionItem) GetScoreWeight() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScoreWeight() {
+		return EvaluatorIDVersionItem_ScoreWeight_DEFAULT
+	}
+	return *p.ScoreWeight
 }
-func (p *EvaluatorFilterOption) SetFilters(val *EvaluatorFilters) {
-	p.Filters = val
+func (p *EvaluatorIDVersionItem) SetEvaluatorID(val *int64) {
+	p.EvaluatorID = val
+}
+func (p *EvaluatorIDVersionItem) SetVersion(val *string) {
+	p.Version = val
+}
+func (p *EvaluatorIDVersionItem) SetRunConfig(val *EvaluatorRunConfig) {
+	p.RunConfig = val
+}
+func (p *EvaluatorIDVersionItem) SetEvaluatorVersionID(val *int64) {
+	p.EvaluatorVersionID = val
+}
+func (p *EvaluatorIDVersionItem) SetScoreWeight(val *float64) {
+	p.ScoreWeight = val
 }
 
-var fieldIDToName_EvaluatorFilterOption = map[int16]string{
-	1: "search_keyword",
-	2: "filters",
+var fieldIDToName_EvaluatorIDVersionItem = map[int16]string{
+	1: "evaluator_id",
+	2: "version",
+	3: "run_config",
+	4: "evaluator_version_id",
+	5: "score_weight",
 }
 
-func (p *EvaluatorFilterOption) IsSetSearchKeyword() bool {
-	return p.SearchKeyword != nil
+func (p *EvaluatorIDVersionItem) IsSetEvaluatorID() bool {
+	return p.EvaluatorID != nil
 }
 
-func (p *EvaluatorFilterOption) IsSetFilters() bool {
-	return p.Filters != nil
+func (p *EvaluatorIDVersionItem) IsSetVersion() bool {
+	return p.Version != nil
 }
 
-func (p *EvaluatorFilterOption) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorIDVersionItem) IsSetRunConfig() bool {
+	return p.RunConfig != nil
+}
+
+func (p *EvaluatorIDVersionItem) IsSetEvaluatorVersionID() bool {
+	return p.EvaluatorVersionID != nil
+}
+
+func (p *EvaluatorIDVersionItem) IsSetScoreWeight() bool {
+	return p.ScoreWeight != nil
+}
+
+func (p *EvaluatorIDVersionItem) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -8261,7 +7699,7 @@ func (p *EvaluatorFilterOption) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8269,13 +7707,37 @@ func (p *EvaluatorFilterOption) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -8295,7 +7757,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFilterOption[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorIDVersionItem[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8305,7 +7767,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorFilterOption) ReadField1(iprot thrift.TProtocol) error {
+func (p *EvaluatorIDVersionItem) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatorID = _field
+	return nil
+}
+func (p *EvaluatorIDVersionItem) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -8313,21 +7786,43 @@ func (p *EvaluatorFilterOption) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.SearchKeyword = _field
+	p.Version = _field
 	return nil
 }
-func (p *EvaluatorFilterOption) ReadField2(iprot thrift.TProtocol) error {
-	_field := NewEvaluatorFilters()
+func (p *EvaluatorIDVersionItem) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewEvaluatorRunConfig()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Filters = _field
+	p.RunConfig = _field
+	return nil
+}
+func (p *EvaluatorIDVersionItem) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatorVersionID = _field
 	return nil
 }
+func (p *EvaluatorIDVersionItem) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *EvaluatorFilterOption) Write(oprot thrift.TProtocol) (err error) {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ScoreWeight = _field
+	return nil
+}
+
+func (p *EvaluatorIDVersionItem) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorFilterOption"); err != nil {
+	if err = oprot.WriteStructBegin("EvaluatorIDVersionItem"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8339,6 +7834,18 @@ func (p *EvaluatorFilterOption) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -8357,12 +7864,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorFilterOption) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSearchKeyword() {
-		if err = oprot.WriteFieldBegin("search_keyword", thrift.STRING, 1); err != nil {
+func (p *EvaluatorIDVersionItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorID() {
+		if err = oprot.WriteFieldBegin("evaluator_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.SearchKeyword); err != nil {
+		if err := oprot.WriteI64(*p.EvaluatorID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8375,12 +7882,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorFilterOption) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFilters() {
-		if err = oprot.WriteFieldBegin("filters", thrift.STRUCT, 2); err != nil {
+func (p *EvaluatorIDVersionItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersion() {
+		if err = oprot.WriteFieldBegin("version", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Filters.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.Version); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8393,130 +7900,247 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *EvaluatorIDVersionItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRunConfig() {
+		if err = oprot.WriteFieldBegin("run_config", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.RunConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluatorIDVersionItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersionID() {
+		if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluatorVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluatorIDVersionItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScoreWeight() {
+		if err = oprot.WriteFieldBegin("score_weight", thrift.DOUBLE, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.ScoreWeight); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
 
-func (p *EvaluatorFilterOption) String() string {
+func (p *EvaluatorIDVersionItem) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorFilterOption(%+v)", *p)
+	return fmt.Sprintf("EvaluatorIDVersionItem(%+v)", *p)
 
 }
 
-func (p *EvaluatorFilterOption) DeepEqual(ano *EvaluatorFilterOption) bool {
+func (p *EvaluatorIDVersionItem) DeepEqual(ano *EvaluatorIDVersionItem) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.SearchKeyword) {
+	if !p.Field1DeepEqual(ano.EvaluatorID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Filters) {
+	if !p.Field2DeepEqual(ano.Version) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.RunConfig) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.EvaluatorVersionID) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.ScoreWeight) {
 		return false
 	}
 	return true
 }
 
-func (p *EvaluatorFilterOption) Field1DeepEqual(src *string) bool {
+func (p *EvaluatorIDVersionItem) Field1DeepEqual(src *int64) bool {
 
-	if p.SearchKeyword == src {
+	if p.EvaluatorID == src {
 		return true
-	} else if p.SearchKeyword == nil || src == nil {
+	} else if p.EvaluatorID == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.SearchKeyword, *src) != 0 {
+	if *p.EvaluatorID != *src {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorFilterOption) Field2DeepEqual(src *EvaluatorFilters) bool {
+func (p *EvaluatorIDVersionItem) Field2DeepEqual(src *string) bool {
 
-	if !p.Filters.DeepEqual(src) {
+	if p.Version == src {
+		return true
+	} else if p.Version == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Version, *src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *EvaluatorIDVersionItem) Field3DeepEqual(src *EvaluatorRunConfig) bool {
 
-// Evaluator筛选条件
-type EvaluatorFilters struct {
-	// 筛选条件列表
-	FilterConditions []*EvaluatorFilterCondition `thrift:"filter_conditions,1,optional" frugal:"1,optional,list<EvaluatorFilterCondition>" form:"filter_conditions" json:"filter_conditions,omitempty" query:"filter_conditions"`
-	// 逻辑操作符
-	LogicOp    *EvaluatorFilterLogicOp `thrift:"logic_op,2,optional" frugal:"2,optional,string" form:"logic_op" json:"logic_op,omitempty" query:"logic_op"`
-	SubFilters []*EvaluatorFilters     `thrift:"sub_filters,3,optional" frugal:"3,optional,list<EvaluatorFilters>" form:"sub_filters" json:"sub_filters,omitempty" query:"sub_filters"`
+	if !p.RunConfig.DeepEqual(src) {
+		return false
+	}
+	return true
 }
+func (p *EvaluatorIDVersionItem) Field4DeepEqual(src *int64) bool {
 
-func NewEvaluatorFilters() *EvaluatorFilters {
-	return &EvaluatorFilters{}
+	if p.EvaluatorVersionID == src {
+		return true
+	} else if p.EvaluatorVersionID == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatorVersionID != *src {
+		return false
+	}
+	return true
 }
+func (p *EvaluatorIDVersionItem) Field5DeepEqual(src *float64) bool {
 
-func (p *EvaluatorFilters) InitDefault() {
+	if p.ScoreWeight == src {
+		return true
+	} else if p.ScoreWeight == nil || src == nil {
+		return false
+	}
+	if *p.ScoreWeight != *src {
+		return false
+	}
+	return true
 }
 
-var EvaluatorFilters_FilterConditions_DEFAULT []*EvaluatorFilterCondition
+type EvaluatorInfo struct {
+	Benchmark     *string `thrift:"benchmark,1,optional" frugal:"1,optional,string" json:"benchmark" form:"benchmark" query:"benchmark"`
+	Vendor        *string `thrift:"vendor,2,optional" frugal:"2,optional,string" json:"vendor" form:"vendor" query:"vendor"`
+	VendorURL     *string `thrift:"vendor_url,3,optional" frugal:"3,optional,string" json:"vendor_url" form:"vendor_url" query:"vendor_url"`
+	UserManualURL *string `thrift:"user_manual_url,4,optional" frugal:"4,optional,string" json:"user_manual_url" form:"user_manual_url" query:"user_manual_url"`
+}
 
-func (p *EvaluatorFilters) GetFilterConditions() (v []*EvaluatorFilterCondition) {
+func NewEvaluatorInfo() *EvaluatorInfo {
+	return &EvaluatorInfo{}
+}
+
+func (p *EvaluatorInfo) InitDefault() {
+}
+
+var EvaluatorInfo_Benchmark_DEFAULT string
+
+func (p *EvaluatorInfo) GetBenchmark() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFilterConditions() {
-		return EvaluatorFilters_FilterConditions_DEFAULT
+	if !p.IsSetBenchmark() {
+		return EvaluatorInfo_Benchmark_DEFAULT
 	}
-	return p.FilterConditions
+	return *p.Benchmark
 }
 
-var EvaluatorFilters_LogicOp_DEFAULT EvaluatorFilterLogicOp
+var EvaluatorInfo_Vendor_DEFAULT string
 
-func (p *EvaluatorFilters) GetLogicOp() (v EvaluatorFilterLogicOp) {
+func (p *EvaluatorInfo) GetVendor() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetLogicOp() {
-		return EvaluatorFilters_LogicOp_DEFAULT
+	if !p.IsSetVendor() {
+		return EvaluatorInfo_Vendor_DEFAULT
 	}
-	return *p.LogicOp
+	return *p.Vendor
 }
 
-var EvaluatorFilters_SubFilters_DEFAULT []*EvaluatorFilters
+var EvaluatorInfo_VendorURL_DEFAULT string
 
-func (p *EvaluatorFilters) GetSubFilters() (v []*EvaluatorFilters) {
+func (p *EvaluatorInfo) GetVendorURL() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetSubFilters() {
-		return EvaluatorFilters_SubFilters_DEFAULT
+	if !p.IsSetVendorURL() {
+		return EvaluatorInfo_VendorURL_DEFAULT
 	}
-	return p.SubFilters
+	return *p.VendorURL
 }
-func (p *EvaluatorFilters) SetFilterConditions(val []*EvaluatorFilterCondition) {
-	p.FilterConditions = val
+
+var EvaluatorInfo_UserManualURL_DEFAULT string
+
+func (p *EvaluatorInfo) GetUserManualURL() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUserManualURL() {
+		return EvaluatorInfo_UserManualURL_DEFAULT
+	}
+	return *p.UserManualURL
 }
-func (p *EvaluatorFilters) SetLogicOp(val *EvaluatorFilterLogicOp) {
-	p.LogicOp = val
+func (p *EvaluatorInfo) SetBenchmark(val *string) {
+	p.Benchmark = val
 }
-func (p *EvaluatorFilters) SetSubFilters(val []*EvaluatorFilters) {
-	p.SubFilters = val
+func (p *EvaluatorInfo) SetVendor(val *string) {
+	p.Vendor = val
+}
+func (p *EvaluatorInfo) SetVendorURL(val *string) {
+	p.VendorURL = val
+}
+func (p *EvaluatorInfo) SetUserManualURL(val *string) {
+	p.UserManualURL = val
 }
 
-var fieldIDToName_EvaluatorFilters = map[int16]string{
-	1: "filter_conditions",
-	2: "logic_op",
-	3: "sub_filters",
+var fieldIDToName_EvaluatorInfo = map[int16]string{
+	1: "benchmark",
+	2: "vendor",
+	3: "vendor_url",
+	4: "user_manual_url",
 }
 
-func (p *EvaluatorFilters) IsSetFilterConditions() bool {
-	return p.FilterConditions != nil
+func (p *EvaluatorInfo) IsSetBenchmark() bool {
+	return p.Benchmark != nil
 }
 
-func (p *EvaluatorFilters) IsSetLogicOp() bool {
-	return p.LogicOp != nil
+func (p *EvaluatorInfo) IsSetVendor() bool {
+	return p.Vendor != nil
 }
 
-func (p *EvaluatorFilters) IsSetSubFilters() bool {
-	return p.SubFilters != nil
+func (p *EvaluatorInfo) IsSetVendorURL() bool {
+	return p.VendorURL != nil
 }
 
-func (p *EvaluatorFilters) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorInfo) IsSetUserManualURL() bool {
+	return p.UserManualURL != nil
+}
+
+func (p *EvaluatorInfo) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -8535,7 +8159,7 @@ func (p *EvaluatorFilters) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8551,13 +8175,21 @@ func (p *EvaluatorFilters) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -8577,7 +8209,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFilters[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8587,67 +8219,54 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorFilters) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *EvaluatorInfo) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	_field := make([]*EvaluatorFilterCondition, 0, size)
-	values := make([]EvaluatorFilterCondition, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	p.Benchmark = _field
+	return nil
+}
+func (p *EvaluatorInfo) ReadField2(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.FilterConditions = _field
+	p.Vendor = _field
 	return nil
 }
-func (p *EvaluatorFilters) ReadField2(iprot thrift.TProtocol) error {
+func (p *EvaluatorInfo) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *EvaluatorFilterLogicOp
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.LogicOp = _field
+	p.VendorURL = _field
 	return nil
 }
-func (p *EvaluatorFilters) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*EvaluatorFilters, 0, size)
-	values := make([]EvaluatorFilters, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *EvaluatorInfo) ReadField4(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.SubFilters = _field
+	p.UserManualURL = _field
 	return nil
 }
 
-func (p *EvaluatorFilters) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorFilters"); err != nil {
+	if err = oprot.WriteStructBegin("EvaluatorInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8663,6 +8282,10 @@ func (p *EvaluatorFilters) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -8681,20 +8304,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorFilters) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFilterConditions() {
-		if err = oprot.WriteFieldBegin("filter_conditions", thrift.LIST, 1); err != nil {
+func (p *EvaluatorInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBenchmark() {
+		if err = oprot.WriteFieldBegin("benchmark", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FilterConditions)); err != nil {
-			return err
-		}
-		for _, v := range p.FilterConditions {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteString(*p.Benchmark); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8707,12 +8322,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorFilters) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLogicOp() {
-		if err = oprot.WriteFieldBegin("logic_op", thrift.STRING, 2); err != nil {
+func (p *EvaluatorInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVendor() {
+		if err = oprot.WriteFieldBegin("vendor", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.LogicOp); err != nil {
+		if err := oprot.WriteString(*p.Vendor); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8725,20 +8340,30 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *EvaluatorFilters) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSubFilters() {
-		if err = oprot.WriteFieldBegin("sub_filters", thrift.LIST, 3); err != nil {
+func (p *EvaluatorInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVendorURL() {
+		if err = oprot.WriteFieldBegin("vendor_url", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.SubFilters)); err != nil {
+		if err := oprot.WriteString(*p.VendorURL); err != nil {
 			return err
 		}
-		for _, v := range p.SubFilters {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err := oprot.WriteListEnd(); err != nil {
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluatorInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserManualURL() {
+		if err = oprot.WriteFieldBegin("user_manual_url", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserManualURL); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8747,466 +8372,420 @@ func (p *EvaluatorFilters) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *EvaluatorFilters) String() string {
+func (p *EvaluatorInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorFilters(%+v)", *p)
+	return fmt.Sprintf("EvaluatorInfo(%+v)", *p)
 
 }
 
-func (p *EvaluatorFilters) DeepEqual(ano *EvaluatorFilters) bool {
+func (p *EvaluatorInfo) DeepEqual(ano *EvaluatorInfo) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.FilterConditions) {
+	if !p.Field1DeepEqual(ano.Benchmark) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.LogicOp) {
+	if !p.Field2DeepEqual(ano.Vendor) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.SubFilters) {
+	if !p.Field3DeepEqual(ano.VendorURL) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.UserManualURL) {
 		return false
 	}
 	return true
 }
 
-func (p *EvaluatorFilters) Field1DeepEqual(src []*EvaluatorFilterCondition) bool {
+func (p *EvaluatorInfo) Field1DeepEqual(src *string) bool {
 
-	if len(p.FilterConditions) != len(src) {
+	if p.Benchmark == src {
+		return true
+	} else if p.Benchmark == nil || src == nil {
 		return false
 	}
-	for i, v := range p.FilterConditions {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if strings.Compare(*p.Benchmark, *src) != 0 {
+		return false
 	}
 	return true
 }
-func (p *EvaluatorFilters) Field2DeepEqual(src *EvaluatorFilterLogicOp) bool {
+func (p *EvaluatorInfo) Field2DeepEqual(src *string) bool {
 
-	if p.LogicOp == src {
+	if p.Vendor == src {
 		return true
-	} else if p.LogicOp == nil || src == nil {
+	} else if p.Vendor == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.LogicOp, *src) != 0 {
+	if strings.Compare(*p.Vendor, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorFilters) Field3DeepEqual(src []*EvaluatorFilters) bool {
+func (p *EvaluatorInfo) Field3DeepEqual(src *string) bool {
 
-	if len(p.SubFilters) != len(src) {
+	if p.VendorURL == src {
+		return true
+	} else if p.VendorURL == nil || src == nil {
 		return false
 	}
-	for i, v := range p.SubFilters {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if strings.Compare(*p.VendorURL, *src) != 0 {
+		return false
 	}
 	return true
 }
+func (p *EvaluatorInfo) Field4DeepEqual(src *string) bool {
 
-// Evaluator筛选条件
-type EvaluatorFilterCondition struct {
-	// 筛选字段
-	TagKey EvaluatorTagKey `thrift:"tag_key,1,required" frugal:"1,required,string" form:"tag_key,required" json:"tag_key,required" query:"tag_key,required"`
-	// 操作符
-	Operator EvaluatorFilterOperatorType `thrift:"operator,2,required" frugal:"2,required,string" form:"operator,required" json:"operator,required" query:"operator,required"`
-	// 操作值
-	Value string `thrift:"value,3,required" frugal:"3,required,string" form:"value,required" json:"value,required" query:"value,required"`
+	if p.UserManualURL == src {
+		return true
+	} else if p.UserManualURL == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.UserManualURL, *src) != 0 {
+		return false
+	}
+	return true
 }
 
-func NewEvaluatorFilterCondition() *EvaluatorFilterCondition {
-	return &EvaluatorFilterCondition{}
+type Evaluator struct {
+	EvaluatorID           *int64            `thrift:"evaluator_id,1,optional" frugal:"1,optional,i64" json:"evaluator_id" form:"evaluator_id" query:"evaluator_id"`
+	WorkspaceID           *int64            `thrift:"workspace_id,2,optional" frugal:"2,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	EvaluatorType         *EvaluatorType    `thrift:"evaluator_type,3,optional" frugal:"3,optional,EvaluatorType" form:"evaluator_type" json:"evaluator_type,omitempty" query:"evaluator_type"`
+	Name                  *string           `thrift:"name,4,optional" frugal:"4,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	Description           *string           `thrift:"description,5,optional" frugal:"5,optional,string" form:"description" json:"description,omitempty" query:"description"`
+	DraftSubmitted        *bool             `thrift:"draft_submitted,6,optional" frugal:"6,optional,bool" form:"draft_submitted" json:"draft_submitted,omitempty" query:"draft_submitted"`
+	BaseInfo              *common.BaseInfo  `thrift:"base_info,7,optional" frugal:"7,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+	CurrentVersion        *EvaluatorVersion `thrift:"current_version,11,optional" frugal:"11,optional,EvaluatorVersion" form:"current_version" json:"current_version,omitempty" query:"current_version"`
+	LatestVersion         *string           `thrift:"latest_version,12,optional" frugal:"12,optional,string" form:"latest_version" json:"latest_version,omitempty" query:"latest_version"`
+	Builtin               *bool             `thrift:"builtin,20,optional" frugal:"20,optional,bool" json:"builtin" form:"builtin" query:"builtin"`
+	EvaluatorInfo         *EvaluatorInfo    `thrift:"evaluator_info,21,optional" frugal:"21,optional,EvaluatorInfo" json:"evaluator_info" form:"evaluator_info" query:"evaluator_info"`
+	BuiltinVisibleVersion *string           `thrift:"builtin_visible_version,22,optional" frugal:"22,optional,string" json:"builtin_visible_version" form:"builtin_visible_version" query:"builtin_visible_version"`
+	// 默认白盒
+	BoxType *EvaluatorBoxType `thrift:"box_type,23,optional" frugal:"23,optional,string" json:"box_type" form:"box_type" query:"box_type"`
+	// 来源
+	SourceType *EvaluatorSourceType                                  `thrift:"source_type,24,optional" frugal:"24,optional,string" form:"source_type" json:"source_type,omitempty" query:"source_type"`
+	Tags       map[EvaluatorTagLangType]map[EvaluatorTagKey][]string `thrift:"tags,100,optional" frugal:"100,optional,map<string:map<string:list<string>>>" json:"tags" form:"tags" query:"tags"`
 }
 
-func (p *EvaluatorFilterCondition) InitDefault() {
+func NewEvaluator() *Evaluator {
+	return &Evaluator{}
 }
 
-func (p *EvaluatorFilterCondition) GetTagKey() (v EvaluatorTagKey) {
-	if p != nil {
-		return p.TagKey
-	}
-	return
+func (p *Evaluator) InitDefault() {
 }
 
-func (p *EvaluatorFilterCondition) GetOperator() (v EvaluatorFilterOperatorType) {
-	if p != nil {
-		return p.Operator
+var Evaluator_EvaluatorID_DEFAULT int64
+
+func (p *Evaluator) GetEvaluatorID() (v int64) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetEvaluatorID() {
+		return Evaluator_EvaluatorID_DEFAULT
+	}
+	return *p.EvaluatorID
 }
 
-func (p *EvaluatorFilterCondition) GetValue() (v string) {
-	if p != nil {
-		return p.Value
+var Evaluator_WorkspaceID_DEFAULT int64
+
+func (p *Evaluator) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
 	}
-	return
-}
-func (p *EvaluatorFilterCondition) SetTagKey(val EvaluatorTagKey) {
-	p.TagKey = val
-}
-func (p *EvaluatorFilterCondition) SetOperator(val EvaluatorFilterOperatorType) {
-	p.Operator = val
-}
-func (p *EvaluatorFilterCondition) SetValue(val string) {
-	p.Value = val
+	if !p.IsSetWorkspaceID() {
+		return Evaluator_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
 }
 
-var fieldIDToName_EvaluatorFilterCondition = map[int16]string{
-	1: "tag_key",
-	2: "operator",
-	3: "value",
+var Evaluator_EvaluatorType_DEFAULT EvaluatorType
+
+func (p *Evaluator) GetEvaluatorType() (v EvaluatorType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorType() {
+		return Evaluator_EvaluatorType_DEFAULT
+	}
+	return *p.EvaluatorType
 }
 
-func (p *EvaluatorFilterCondition) Read(iprot thrift.TProtocol) (err error) {
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	var issetTagKey bool = false
-	var issetOperator bool = false
-	var issetValue bool = false
+var Evaluator_Name_DEFAULT string
 
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+func (p *Evaluator) GetName() (v string) {
+	if p == nil {
+		return
 	}
+	if !p.IsSetName() {
+		return Evaluator_Name_DEFAULT
+	}
+	return *p.Name
+}
 
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
+var Evaluator_Description_DEFAULT string
 
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetTagKey = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetOperator = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetValue = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
+func (p *Evaluator) GetDescription() (v string) {
+	if p == nil {
+		return
 	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	if !p.IsSetDescription() {
+		return Evaluator_Description_DEFAULT
 	}
+	return *p.Description
+}
 
-	if !issetTagKey {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
+var Evaluator_DraftSubmitted_DEFAULT bool
 
-	if !issetOperator {
-		fieldId = 2
-		goto RequiredFieldNotSetError
+func (p *Evaluator) GetDraftSubmitted() (v bool) {
+	if p == nil {
+		return
 	}
-
-	if !issetValue {
-		fieldId = 3
-		goto RequiredFieldNotSetError
+	if !p.IsSetDraftSubmitted() {
+		return Evaluator_DraftSubmitted_DEFAULT
 	}
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFilterCondition[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_EvaluatorFilterCondition[fieldId]))
+	return *p.DraftSubmitted
 }
 
-func (p *EvaluatorFilterCondition) ReadField1(iprot thrift.TProtocol) error {
+var Evaluator_BaseInfo_DEFAULT *common.BaseInfo
 
-	var _field EvaluatorTagKey
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
+func (p *Evaluator) GetBaseInfo() (v *common.BaseInfo) {
+	if p == nil {
+		return
 	}
-	p.TagKey = _field
-	return nil
-}
-func (p *EvaluatorFilterCondition) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field EvaluatorFilterOperatorType
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
+	if !p.IsSetBaseInfo() {
+		return Evaluator_BaseInfo_DEFAULT
 	}
-	p.Operator = _field
-	return nil
+	return p.BaseInfo
 }
-func (p *EvaluatorFilterCondition) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Value = _field
-	return nil
-}
+var Evaluator_CurrentVersion_DEFAULT *EvaluatorVersion
 
-func (p *EvaluatorFilterCondition) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorFilterCondition"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
+func (p *Evaluator) GetCurrentVersion() (v *EvaluatorVersion) {
+	if p == nil {
+		return
 	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	if !p.IsSetCurrentVersion() {
+		return Evaluator_CurrentVersion_DEFAULT
 	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+	return p.CurrentVersion
 }
 
-func (p *EvaluatorFilterCondition) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("tag_key", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.TagKey); err != nil {
-		return err
+var Evaluator_LatestVersion_DEFAULT string
+
+func (p *Evaluator) GetLatestVersion() (v string) {
+	if p == nil {
+		return
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	if !p.IsSetLatestVersion() {
+		return Evaluator_LatestVersion_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return *p.LatestVersion
 }
-func (p *EvaluatorFilterCondition) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("operator", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Operator); err != nil {
-		return err
+
+var Evaluator_Builtin_DEFAULT bool
+
+func (p *Evaluator) GetBuiltin() (v bool) {
+	if p == nil {
+		return
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	if !p.IsSetBuiltin() {
+		return Evaluator_Builtin_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return *p.Builtin
 }
-func (p *EvaluatorFilterCondition) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("value", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Value); err != nil {
-		return err
+
+var Evaluator_EvaluatorInfo_DEFAULT *EvaluatorInfo
+
+func (p *Evaluator) GetEvaluatorInfo() (v *EvaluatorInfo) {
+	if p == nil {
+		return
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	if !p.IsSetEvaluatorInfo() {
+		return Evaluator_EvaluatorInfo_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return p.EvaluatorInfo
 }
 
-func (p *EvaluatorFilterCondition) String() string {
+var Evaluator_BuiltinVisibleVersion_DEFAULT string
+
+func (p *Evaluator) GetBuiltinVisibleVersion() (v string) {
 	if p == nil {
-		return "<nil>"
+		return
 	}
-	return fmt.Sprintf("EvaluatorFilterCondition(%+v)", *p)
-
+	if !p.IsSetBuiltinVisibleVersion() {
+		return Evaluator_BuiltinVisibleVersion_DEFAULT
+	}
+	return *p.BuiltinVisibleVersion
 }
 
-func (p *EvaluatorFilterCondition) DeepEqual(ano *EvaluatorFilterCondition) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
-	}
-	if !p.Field1DeepEqual(ano.TagKey) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.Operator) {
-		return false
+var Evaluator_BoxType_DEFAULT EvaluatorBoxType
+
+func (p *Evaluator) GetBoxType() (v EvaluatorBoxType) {
+	if p == nil {
+		return
 	}
-	if !p.Field3DeepEqual(ano.Value) {
-		return false
+	if !p.IsSetBoxType() {
+		return Evaluator_BoxType_DEFAULT
 	}
-	return true
+	return *p.BoxType
 }
 
-func (p *EvaluatorFilterCondition) Field1DeepEqual(src EvaluatorTagKey) bool {
+var Evaluator_SourceType_DEFAULT EvaluatorSourceType
 
-	if strings.Compare(p.TagKey, src) != 0 {
-		return false
+func (p *Evaluator) GetSourceType() (v EvaluatorSourceType) {
+	if p == nil {
+		return
 	}
-	return true
-}
-func (p *EvaluatorFilterCondition) Field2DeepEqual(src EvaluatorFilterOperatorType) bool {
-
-	if strings.Compare(p.Operator, src) != 0 {
-		return false
+	if !p.IsSetSourceType() {
+		return Evaluator_SourceType_DEFAULT
 	}
-	return true
+	return *p.SourceType
 }
-func (p *EvaluatorFilterCondition) Field3DeepEqual(src string) bool {
 
-	if strings.Compare(p.Value, src) != 0 {
-		return false
+var Evaluator_Tags_DEFAULT map[EvaluatorTagLangType]map[EvaluatorTagKey][]string
+
+func (p *Evaluator) GetTags() (v map[EvaluatorTagLangType]map[EvaluatorTagKey][]string) {
+	if p == nil {
+		return
 	}
-	return true
+	if !p.IsSetTags() {
+		return Evaluator_Tags_DEFAULT
+	}
+	return p.Tags
+}
+func (p *Evaluator) SetEvaluatorID(val *int64) {
+	p.EvaluatorID = val
+}
+func (p *Evaluator) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *Evaluator) SetEvaluatorType(val *EvaluatorType) {
+	p.EvaluatorType = val
+}
+func (p *Evaluator) SetName(val *string) {
+	p.Name = val
+}
+func (p *Evaluator) SetDescription(val *string) {
+	p.Description = val
+}
+func (p *Evaluator) SetDraftSubmitted(val *bool) {
+	p.DraftSubmitted = val
+}
+func (p *Evaluator) SetBaseInfo(val *common.BaseInfo) {
+	p.BaseInfo = val
+}
+func (p *Evaluator) SetCurrentVersion(val *EvaluatorVersion) {
+	p.CurrentVersion = val
+}
+func (p *Evaluator) SetLatestVersion(val *string) {
+	p.LatestVersion = val
+}
+func (p *Evaluator) SetBuiltin(val *bool) {
+	p.Builtin = val
+}
+func (p *Evaluator) SetEvaluatorInfo(val *EvaluatorInfo) {
+	p.EvaluatorInfo = val
+}
+func (p *Evaluator) SetBuiltinVisibleVersion(val *string) {
+	p.BuiltinVisibleVersion = val
+}
+func (p *Evaluator) SetBoxType(val *EvaluatorBoxType) {
+	p.BoxType = val
+}
+func (p *Evaluator) SetSourceType(val *EvaluatorSourceType) {
+	p.SourceType = val
+}
+func (p *Evaluator) SetTags(val map[EvaluatorTagLangType]map[EvaluatorTagKey][]string) {
+	p.Tags = val
 }
 
-type Correction struct {
-	Score     *float64 `thrift:"score,1,optional" frugal:"1,optional,double" form:"score" json:"score,omitempty" query:"score"`
-	Explain   *string  `thrift:"explain,2,optional" frugal:"2,optional,string" form:"explain" json:"explain,omitempty" query:"explain"`
-	UpdatedBy *string  `thrift:"updated_by,3,optional" frugal:"3,optional,string" form:"updated_by" json:"updated_by,omitempty" query:"updated_by"`
+var fieldIDToName_Evaluator = map[int16]string{
+	1:   "evaluator_id",
+	2:   "workspace_id",
+	3:   "evaluator_type",
+	4:   "name",
+	5:   "description",
+	6:   "draft_submitted",
+	7:   "base_info",
+	11:  "current_version",
+	12:  "latest_version",
+	20:  "builtin",
+	21:  "evaluator_info",
+	22:  "builtin_visible_version",
+	23:  "box_type",
+	24:  "source_type",
+	100: "tags",
 }
 
-func NewCorrection() *Correction {
-	return &Correction{}
+func (p *Evaluator) IsSetEvaluatorID() bool {
+	return p.EvaluatorID != nil
 }
 
-func (p *Correction) InitDefault() {
+func (p *Evaluator) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
 }
 
-var Correction_Score_DEFAULT float64
+func (p *Evaluator) IsSetEvaluatorType() bool {
+	return p.EvaluatorType != nil
+}
 
-func (p *Correction) GetScore() (v float64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetScore() {
-		return Correction_Score_DEFAULT
-	}
-	return *p.Score
+func (p *Evaluator) IsSetName() bool {
+	return p.Name != nil
 }
 
-var Correction_Explain_DEFAULT string
+func (p *Evaluator) IsSetDescription() bool {
+	return p.Description != nil
+}
 
-func (p *Correction) GetExplain() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetExplain() {
-		return Correction_Explain_DEFAULT
-	}
-	return *p.Explain
+func (p *Evaluator) IsSetDraftSubmitted() bool {
+	return p.DraftSubmitted != nil
 }
 
-var Correction_UpdatedBy_DEFAULT string
+func (p *Evaluator) IsSetBaseInfo() bool {
+	return p.BaseInfo != nil
+}
 
-func (p *Correction) GetUpdatedBy() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetUpdatedBy() {
-		return Correction_UpdatedBy_DEFAULT
-	}
-	return *p.UpdatedBy
+func (p *Evaluator) IsSetCurrentVersion() bool {
+	return p.CurrentVersion != nil
 }
-func (p *Correction) SetScore(val *float64) {
-	p.Score = val
+
+func (p *Evaluator) IsSetLatestVersion() bool {
+	return p.LatestVersion != nil
 }
-func (p *Correction) SetExplain(val *string) {
-	p.Explain = val
+
+func (p *Evaluator) IsSetBuiltin() bool {
+	return p.Builtin != nil
 }
-func (p *Correction) SetUpdatedBy(val *string) {
-	p.UpdatedBy = val
+
+func (p *Evaluator) IsSetEvaluatorInfo() bool {
+	return p.EvaluatorInfo != nil
 }
 
-var fieldIDToName_Correction = map[int16]string{
-	1: "score",
-	2: "explain",
-	3: "updated_by",
+func (p *Evaluator) IsSetBuiltinVisibleVersion() bool {
+	return p.BuiltinVisibleVersion != nil
 }
 
-func (p *Correction) IsSetScore() bool {
-	return p.Score != nil
+func (p *Evaluator) IsSetBoxType() bool {
+	return p.BoxType != nil
 }
 
-func (p *Correction) IsSetExplain() bool {
-	return p.Explain != nil
+func (p *Evaluator) IsSetSourceType() bool {
+	return p.SourceType != nil
 }
 
-func (p *Correction) IsSetUpdatedBy() bool {
-	return p.UpdatedBy != nil
+func (p *Evaluator) IsSetTags() bool {
+	return p.Tags != nil
 }
 
-func (p *Correction) Read(iprot thrift.TProtocol) (err error) {
+func (p *Evaluator) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -9225,7 +8804,7 @@ func (p *Correction) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -9233,7 +8812,7 @@ func (p *Correction) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -9241,517 +8820,8988 @@ func (p *Correction) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Correction[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *Correction) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Score = _field
-	return nil
-}
-func (p *Correction) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 20:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField20(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 21:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField21(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 22:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField22(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 23:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField23(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 24:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField24(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 100:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField100(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Evaluator[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *Evaluator) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
 	} else {
 		_field = &v
 	}
-	p.Explain = _field
+	p.EvaluatorID = _field
 	return nil
 }
-func (p *Correction) ReadField3(iprot thrift.TProtocol) error {
+func (p *Evaluator) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.UpdatedBy = _field
+	p.WorkspaceID = _field
 	return nil
 }
+func (p *Evaluator) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *Correction) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("Correction"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
+	var _field *EvaluatorType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := EvaluatorType(v)
+		_field = &tmp
 	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
+	p.EvaluatorType = _field
+	return nil
+}
+func (p *Evaluator) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	p.Name = _field
+	return nil
+}
+func (p *Evaluator) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.Description = _field
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
+func (p *Evaluator) ReadField6(iprot thrift.TProtocol) error {
 
-func (p *Correction) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetScore() {
-		if err = oprot.WriteFieldBegin("score", thrift.DOUBLE, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteDouble(*p.Score); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.DraftSubmitted = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *Correction) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExplain() {
-		if err = oprot.WriteFieldBegin("explain", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Explain); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *Evaluator) ReadField7(iprot thrift.TProtocol) error {
+	_field := common.NewBaseInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.BaseInfo = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *Correction) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUpdatedBy() {
-		if err = oprot.WriteFieldBegin("updated_by", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.UpdatedBy); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *Evaluator) ReadField11(iprot thrift.TProtocol) error {
+	_field := NewEvaluatorVersion()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.CurrentVersion = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *Evaluator) ReadField12(iprot thrift.TProtocol) error {
 
-func (p *Correction) String() string {
-	if p == nil {
-		return "<nil>"
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	return fmt.Sprintf("Correction(%+v)", *p)
-
+	p.LatestVersion = _field
+	return nil
 }
+func (p *Evaluator) ReadField20(iprot thrift.TProtocol) error {
 
-func (p *Correction) DeepEqual(ano *Correction) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
-	}
-	if !p.Field1DeepEqual(ano.Score) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.Explain) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.UpdatedBy) {
-		return false
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	return true
+	p.Builtin = _field
+	return nil
 }
-
-func (p *Correction) Field1DeepEqual(src *float64) bool {
-
-	if p.Score == src {
-		return true
-	} else if p.Score == nil || src == nil {
-		return false
-	}
-	if *p.Score != *src {
-		return false
+func (p *Evaluator) ReadField21(iprot thrift.TProtocol) error {
+	_field := NewEvaluatorInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	return true
+	p.EvaluatorInfo = _field
+	return nil
 }
-func (p *Correction) Field2DeepEqual(src *string) bool {
+func (p *Evaluator) ReadField22(iprot thrift.TProtocol) error {
 
-	if p.Explain == src {
-		return true
-	} else if p.Explain == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.Explain, *src) != 0 {
-		return false
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	return true
+	p.BuiltinVisibleVersion = _field
+	return nil
 }
-func (p *Correction) Field3DeepEqual(src *string) bool {
+func (p *Evaluator) ReadField23(iprot thrift.TProtocol) error {
 
-	if p.UpdatedBy == src {
-		return true
-	} else if p.UpdatedBy == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.UpdatedBy, *src) != 0 {
-		return false
+	var _field *EvaluatorBoxType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	return true
+	p.BoxType = _field
+	return nil
 }
+func (p *Evaluator) ReadField24(iprot thrift.TProtocol) error {
 
-type EvaluatorRecord struct {
-	ID                  *int64               `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id" form:"id" query:"id"`
-	ExperimentID        *int64               `thrift:"experiment_id,2,optional" frugal:"2,optional,i64" json:"experiment_id" form:"experiment_id" query:"experiment_id"`
-	ExperimentRunID     *int64               `thrift:"experiment_run_id,3,optional" frugal:"3,optional,i64" json:"experiment_run_id" form:"experiment_run_id" query:"experiment_run_id"`
-	ItemID              *int64               `thrift:"item_id,4,optional" frugal:"4,optional,i64" json:"item_id" form:"item_id" query:"item_id"`
-	TurnID              *int64               `thrift:"turn_id,5,optional" frugal:"5,optional,i64" json:"turn_id" form:"turn_id" query:"turn_id"`
-	EvaluatorVersionID  *int64               `thrift:"evaluator_version_id,6,optional" frugal:"6,optional,i64" json:"evaluator_version_id" form:"evaluator_version_id" query:"evaluator_version_id"`
-	TraceID             *string              `thrift:"trace_id,7,optional" frugal:"7,optional,string" form:"trace_id" json:"trace_id,omitempty" query:"trace_id"`
-	LogID               *string              `thrift:"log_id,8,optional" frugal:"8,optional,string" form:"log_id" json:"log_id,omitempty" query:"log_id"`
-	EvaluatorInputData  *EvaluatorInputData  `thrift:"evaluator_input_data,9,optional" frugal:"9,optional,EvaluatorInputData" form:"evaluator_input_data" json:"evaluator_input_data,omitempty" query:"evaluator_input_data"`
-	EvaluatorOutputData *EvaluatorOutputData `thrift:"evaluator_output_data,10,optional" frugal:"10,optional,EvaluatorOutputData" form:"evaluator_output_data" json:"evaluator_output_data,omitempty" query:"evaluator_output_data"`
-	Status              *EvaluatorRunStatus  `thrift:"status,11,optional" frugal:"11,optional,EvaluatorRunStatus" form:"status" json:"status,omitempty" query:"status"`
-	BaseInfo            *common.BaseInfo     `thrift:"base_info,12,optional" frugal:"12,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
-	Ext                 map[string]string    `thrift:"ext,20,optional" frugal:"20,optional,map<string:string>" form:"ext" json:"ext,omitempty" query:"ext"`
+	var _field *EvaluatorSourceType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SourceType = _field
+	return nil
 }
+func (p *Evaluator) ReadField100(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[EvaluatorTagLangType]map[EvaluatorTagKey][]string, size)
+	for i := 0; i < size; i++ {
+		var _key EvaluatorTagLangType
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+		_, _, size, err := iprot.ReadMapBegin()
+		if err != nil {
+			return err
+		}
+		_val := make(map[EvaluatorTagKey][]string, size)
+		for i := 0; i < size; i++ {
+			var _key1 EvaluatorTagKey
+			if v, err := iprot.ReadString(); err != nil {
+				return err
+			} else {
+				_key1 = v
+			}
+			_, size, err := iprot.ReadListBegin()
+			if err != nil {
+				return err
+			}
+			_val1 := make([]string, 0, size)
+			for i := 0; i < size; i++ {
 
-func NewEvaluatorRecord() *EvaluatorRecord {
-	return &EvaluatorRecord{}
-}
+				var _elem string
+				if v, err := iprot.ReadString(); err != nil {
+					return err
+				} else {
+					_elem = v
+				}
 
-func (p *EvaluatorRecord) InitDefault() {
-}
+				_val1 = append(_val1, _elem)
+			}
+			if err := iprot.ReadListEnd(); err != nil {
+				return err
+			}
 
-var EvaluatorRecord_ID_DEFAULT int64
+			_val[_key1] = _val1
+		}
+		if err := iprot.ReadMapEnd(); err != nil {
+			return err
+		}
 
-func (p *EvaluatorRecord) GetID() (v int64) {
-	if p == nil {
-		return
+		_field[_key] = _val
 	}
-	if !p.IsSetID() {
-		return EvaluatorRecord_ID_DEFAULT
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
 	}
-	return *p.ID
+	p.Tags = _field
+	return nil
 }
 
-var EvaluatorRecord_ExperimentID_DEFAULT int64
-
-func (p *EvaluatorRecord) GetExperimentID() (v int64) {
-	if p == nil {
-		return
+func (p *Evaluator) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Evaluator"); err != nil {
+		goto WriteStructBeginError
 	}
-	if !p.IsSetExperimentID() {
-		return EvaluatorRecord_ExperimentID_DEFAULT
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField20(oprot); err != nil {
+			fieldId = 20
+			goto WriteFieldError
+		}
+		if err = p.writeField21(oprot); err != nil {
+			fieldId = 21
+			goto WriteFieldError
+		}
+		if err = p.writeField22(oprot); err != nil {
+			fieldId = 22
+			goto WriteFieldError
+		}
+		if err = p.writeField23(oprot); err != nil {
+			fieldId = 23
+			goto WriteFieldError
+		}
+		if err = p.writeField24(oprot); err != nil {
+			fieldId = 24
+			goto WriteFieldError
+		}
+		if err = p.writeField100(oprot); err != nil {
+			fieldId = 100
+			goto WriteFieldError
+		}
 	}
-	return *p.ExperimentID
-}
-
-var EvaluatorRecord_ExperimentRunID_DEFAULT int64
-
-func (p *EvaluatorRecord) GetExperimentRunID() (v int64) {
-	if p == nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if !p.IsSetExperimentRunID() {
-		return EvaluatorRecord_ExperimentRunID_DEFAULT
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Evaluator) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorID() {
+		if err = oprot.WriteFieldBegin("evaluator_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluatorID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Evaluator) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *Evaluator) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorType() {
+		if err = oprot.WriteFieldBegin("evaluator_type", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.EvaluatorType)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *Evaluator) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *Evaluator) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err = oprot.WriteFieldBegin("description", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Description); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *Evaluator) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDraftSubmitted() {
+		if err = oprot.WriteFieldBegin("draft_submitted", thrift.BOOL, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.DraftSubmitted); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *Evaluator) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseInfo() {
+		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *Evaluator) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCurrentVersion() {
+		if err = oprot.WriteFieldBegin("current_version", thrift.STRUCT, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.CurrentVersion.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *Evaluator) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLatestVersion() {
+		if err = oprot.WriteFieldBegin("latest_version", thrift.STRING, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.LatestVersion); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *Evaluator) writeField20(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBuiltin() {
+		if err = oprot.WriteFieldBegin("builtin", thrift.BOOL, 20); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Builtin); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 end error: ", p), err)
+}
+func (p *Evaluator) writeField21(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorInfo() {
+		if err = oprot.WriteFieldBegin("evaluator_info", thrift.STRUCT, 21); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.EvaluatorInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 21 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 21 end error: ", p), err)
+}
+func (p *Evaluator) writeField22(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBuiltinVisibleVersion() {
+		if err = oprot.WriteFieldBegin("builtin_visible_version", thrift.STRING, 22); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.BuiltinVisibleVersion); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 22 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 22 end error: ", p), err)
+}
+func (p *Evaluator) writeField23(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBoxType() {
+		if err = oprot.WriteFieldBegin("box_type", thrift.STRING, 23); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.BoxType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 23 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 23 end error: ", p), err)
+}
+func (p *Evaluator) writeField24(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSourceType() {
+		if err = oprot.WriteFieldBegin("source_type", thrift.STRING, 24); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SourceType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 24 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 24 end error: ", p), err)
+}
+func (p *Evaluator) writeField100(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTags() {
+		if err = oprot.WriteFieldBegin("tags", thrift.MAP, 100); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.MAP, len(p.Tags)); err != nil {
+			return err
+		}
+		for k, v := range p.Tags {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteMapBegin(thrift.STRING, thrift.LIST, len(v)); err != nil {
+				return err
+			}
+			for k, v := range v {
+				if err := oprot.WriteString(k); err != nil {
+					return err
+				}
+				if err := oprot.WriteListBegin(thrift.STRING, len(v)); err != nil {
+					return err
+				}
+				for _, v := range v {
+					if err := oprot.WriteString(v); err != nil {
+						return err
+					}
+				}
+				if err := oprot.WriteListEnd(); err != nil {
+					return err
+				}
+			}
+			if err := oprot.WriteMapEnd(); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
+}
+
+func (p *Evaluator) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Evaluator(%+v)", *p)
+
+}
+
+func (p *Evaluator) DeepEqual(ano *Evaluator) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EvaluatorID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.EvaluatorType) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Description) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.DraftSubmitted) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.BaseInfo) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.CurrentVersion) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.LatestVersion) {
+		return false
+	}
+	if !p.Field20DeepEqual(ano.Builtin) {
+		return false
+	}
+	if !p.Field21DeepEqual(ano.EvaluatorInfo) {
+		return false
+	}
+	if !p.Field22DeepEqual(ano.BuiltinVisibleVersion) {
+		return false
+	}
+	if !p.Field23DeepEqual(ano.BoxType) {
+		return false
+	}
+	if !p.Field24DeepEqual(ano.SourceType) {
+		return false
+	}
+	if !p.Field100DeepEqual(ano.Tags) {
+		return false
+	}
+	return true
+}
+
+func (p *Evaluator) Field1DeepEqual(src *int64) bool {
+
+	if p.EvaluatorID == src {
+		return true
+	} else if p.EvaluatorID == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatorID != *src {
+		return false
+	}
+	return true
+}
+func (p *Evaluator) Field2DeepEqual(src *int64) bool {
+
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
+	}
+	if *p.WorkspaceID != *src {
+		return false
+	}
+	return true
+}
+func (p *Evaluator) Field3DeepEqual(src *EvaluatorType) bool {
+
+	if p.EvaluatorType == src {
+		return true
+	} else if p.EvaluatorType == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatorType != *src {
+		return false
+	}
+	return true
+}
+func (p *Evaluator) Field4DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Evaluator) Field5DeepEqual(src *string) bool {
+
+	if p.Description == src {
+		return true
+	} else if p.Description == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Description, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Evaluator) Field6DeepEqual(src *bool) bool {
+
+	if p.DraftSubmitted == src {
+		return true
+	} else if p.DraftSubmitted == nil || src == nil {
+		return false
+	}
+	if *p.DraftSubmitted != *src {
+		return false
+	}
+	return true
+}
+func (p *Evaluator) Field7DeepEqual(src *common.BaseInfo) bool {
+
+	if !p.BaseInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *Evaluator) Field11DeepEqual(src *EvaluatorVersion) bool {
+
+	if !p.CurrentVersion.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *Evaluator) Field12DeepEqual(src *string) bool {
+
+	if p.LatestVersion == src {
+		return true
+	} else if p.LatestVersion == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.LatestVersion, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Evaluator) Field20DeepEqual(src *bool) bool {
+
+	if p.Builtin == src {
+		return true
+	} else if p.Builtin == nil || src == nil {
+		return false
+	}
+	if *p.Builtin != *src {
+		return false
+	}
+	return true
+}
+func (p *Evaluator) Field21DeepEqual(src *EvaluatorInfo) bool {
+
+	if !p.EvaluatorInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *Evaluator) Field22DeepEqual(src *string) bool {
+
+	if p.BuiltinVisibleVersion == src {
+		return true
+	} else if p.BuiltinVisibleVersion == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.BuiltinVisibleVersion, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Evaluator) Field23DeepEqual(src *EvaluatorBoxType) bool {
+
+	if p.BoxType == src {
+		return true
+	} else if p.BoxType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.BoxType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Evaluator) Field24DeepEqual(src *EvaluatorSourceType) bool {
+
+	if p.SourceType == src {
+		return true
+	} else if p.SourceType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.SourceType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Evaluator) Field100DeepEqual(src map[EvaluatorTagLangType]map[EvaluatorTagKey][]string) bool {
+
+	if len(p.Tags) != len(src) {
+		return false
+	}
+	for k, v := range p.Tags {
+		_src := src[k]
+		if len(v) != len(_src) {
+			return false
+		}
+		for k, v := range v {
+			_src1 := _src[k]
+			if len(v) != len(_src1) {
+				return false
+			}
+			for i, v := range v {
+				_src2 := _src1[i]
+				if strings.Compare(v, _src2) != 0 {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+type EvaluatorTemplate struct {
+	ID            *int64         `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id" form:"id" query:"id"`
+	WorkspaceID   *int64         `thrift:"workspace_id,2,optional" frugal:"2,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	EvaluatorType *EvaluatorType `thrift:"evaluator_type,3,optional" frugal:"3,optional,EvaluatorType" form:"evaluator_type" json:"evaluator_type,omitempty" query:"evaluator_type"`
+	Name          *string        `thrift:"name,4,optional" frugal:"4,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	Description   *string        `thrift:"description,5,optional" frugal:"5,optional,string" form:"description" json:"description,omitempty" query:"description"`
+	// 热度
+	Popularity       *int64                                                `thrift:"popularity,6,optional" frugal:"6,optional,i64" json:"popularity" form:"popularity" query:"popularity"`
+	EvaluatorInfo    *EvaluatorInfo                                        `thrift:"evaluator_info,7,optional" frugal:"7,optional,EvaluatorInfo" json:"evaluator_info" form:"evaluator_info" query:"evaluator_info"`
+	Tags             map[EvaluatorTagLangType]map[EvaluatorTagKey][]string `thrift:"tags,9,optional" frugal:"9,optional,map<string:map<string:list<string>>>" json:"tags" form:"tags" query:"tags"`
+	EvaluatorContent *EvaluatorContent                                     `thrift:"evaluator_content,101,optional" frugal:"101,optional,EvaluatorContent" form:"evaluator_content" json:"evaluator_content,omitempty" query:"evaluator_content"`
+	BaseInfo         *common.BaseInfo                                      `thrift:"base_info,255,optional" frugal:"255,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+}
+
+func NewEvaluatorTemplate() *EvaluatorTemplate {
+	return &EvaluatorTemplate{}
+}
+
+func (p *EvaluatorTemplate) InitDefault() {
+}
+
+var EvaluatorTemplate_ID_DEFAULT int64
+
+func (p *EvaluatorTemplate) GetID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetID() {
+		return EvaluatorTemplate_ID_DEFAULT
+	}
+	return *p.ID
+}
+
+var EvaluatorTemplate_WorkspaceID_DEFAULT int64
+
+func (p *EvaluatorTemplate) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceID() {
+		return EvaluatorTemplate_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
+}
+
+var EvaluatorTemplate_EvaluatorType_DEFAULT EvaluatorType
+
+func (p *EvaluatorTemplate) GetEvaluatorType() (v EvaluatorType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorType() {
+		return EvaluatorTemplate_EvaluatorType_DEFAULT
+	}
+	return *p.EvaluatorType
+}
+
+var EvaluatorTemplate_Name_DEFAULT string
+
+func (p *EvaluatorTemplate) GetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetName() {
+		return EvaluatorTemplate_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var EvaluatorTemplate_Description_DEFAULT string
+
+func (p *EvaluatorTemplate) GetDescription() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDescription() {
+		return EvaluatorTemplate_Description_DEFAULT
+	}
+	return *p.Description
+}
+
+var EvaluatorTemplate_Popularity_DEFAULT int64
+
+func (p *EvaluatorTemplate) GetPopularity() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPopularity() {
+		return EvaluatorTemplate_Popularity_DEFAULT
+	}
+	return *p.Popularity
+}
+
+var EvaluatorTemplate_EvaluatorInfo_DEFAULT *EvaluatorInfo
+
+func (p *EvaluatorTemplate) GetEvaluatorInfo() (v *EvaluatorInfo) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorInfo() {
+		return EvaluatorTemplate_EvaluatorInfo_DEFAULT
+	}
+	return p.EvaluatorInfo
+}
+
+var EvaluatorTemplate_Tags_DEFAULT map[EvaluatorTagLangType]map[EvaluatorTagKey][]string
+
+func (p *EvaluatorTemplate) GetTags() (v map[EvaluatorTagLangType]map[EvaluatorTagKey][]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTags() {
+		return EvaluatorTemplate_Tags_DEFAULT
+	}
+	return p.Tags
+}
+
+var EvaluatorTemplate_EvaluatorContent_DEFAULT *EvaluatorContent
+
+func (p *EvaluatorTemplate) GetEvaluatorContent() (v *EvaluatorContent) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorContent() {
+		return EvaluatorTemplate_EvaluatorContent_DEFAULT
+	}
+	return p.EvaluatorContent
+}
+
+var EvaluatorTemplate_BaseInfo_DEFAULT *common.BaseInfo
+
+func (p *EvaluatorTemplate) GetBaseInfo() (v *common.BaseInfo) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseInfo() {
+		return EvaluatorTemplate_BaseInfo_DEFAULT
+	}
+	return p.BaseInfo
+}
+func (p *EvaluatorTemplate) SetID(val *int64) {
+	p.ID = val
+}
+func (p *EvaluatorTemplate) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *EvaluatorTemplate) SetEvaluatorType(val *EvaluatorType) {
+	p.EvaluatorType = val
+}
+func (p *EvaluatorTemplate) SetName(val *string) {
+	p.Name = val
+}
+func (p *EvaluatorTemplate) SetDescription(val *string) {
+	p.Description = val
+}
+func (p *EvaluatorTemplate) SetPopularity(val *int64) {
+	p.Popularity = val
+}
+func (p *EvaluatorTemplate) SetEvaluatorInfo(val *EvaluatorInfo) {
+	p.EvaluatorInfo = val
+}
+func (p *EvaluatorTemplate) SetTags(val map[EvaluatorTagLangType]map[EvaluatorTagKey][]string) {
+	p.Tags = val
+}
+func (p *EvaluatorTemplate) SetEvaluatorContent(val *EvaluatorContent) {
+	p.EvaluatorContent = val
+}
+func (p *EvaluatorTemplate) SetBaseInfo(val *common.BaseInfo) {
+	p.BaseInfo = val
+}
+
+var fieldIDToName_EvaluatorTemplate = map[int16]string{
+	1:   "id",
+	2:   "workspace_id",
+	3:   "evaluator_type",
+	4:   "name",
+	5:   "description",
+	6:   "popularity",
+	7:   "evaluator_info",
+	9:   "tags",
+	101: "evaluator_content",
+	255: "base_info",
+}
+
+func (p *EvaluatorTemplate) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *EvaluatorTemplate) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
+}
+
+func (p *EvaluatorTemplate) IsSetEvaluatorType() bool {
+	return p.EvaluatorType != nil
+}
+
+func (p *EvaluatorTemplate) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *EvaluatorTemplate) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *EvaluatorTemplate) IsSetPopularity() bool {
+	return p.Popularity != nil
+}
+
+func (p *EvaluatorTemplate) IsSetEvaluatorInfo() bool {
+	return p.EvaluatorInfo != nil
+}
+
+func (p *EvaluatorTemplate) IsSetTags() bool {
+	return p.Tags != nil
+}
+
+func (p *EvaluatorTemplate) IsSetEvaluatorContent() bool {
+	return p.EvaluatorContent != nil
+}
+
+func (p *EvaluatorTemplate) IsSetBaseInfo() bool {
+	return p.BaseInfo != nil
+}
+
+func (p *EvaluatorTemplate) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 101:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField101(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorTemplate[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorTemplate) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *EvaluatorTemplate) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *EvaluatorTemplate) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *EvaluatorType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := EvaluatorType(v)
+		_field = &tmp
+	}
+	p.EvaluatorType = _field
+	return nil
+}
+func (p *EvaluatorTemplate) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *EvaluatorTemplate) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Description = _field
+	return nil
+}
+func (p *EvaluatorTemplate) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Popularity = _field
+	return nil
+}
+func (p *EvaluatorTemplate) ReadField7(iprot thrift.TProtocol) error {
+	_field := NewEvaluatorInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.EvaluatorInfo = _field
+	return nil
+}
+func (p *EvaluatorTemplate) ReadField9(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[EvaluatorTagLangType]map[EvaluatorTagKey][]string, size)
+	for i := 0; i < size; i++ {
+		var _key EvaluatorTagLangType
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+		_, _, size, err := iprot.ReadMapBegin()
+		if err != nil {
+			return err
+		}
+		_val := make(map[EvaluatorTagKey][]string, size)
+		for i := 0; i < size; i++ {
+			var _key1 EvaluatorTagKey
+			if v, err := iprot.ReadString(); err != nil {
+				return err
+			} else {
+				_key1 = v
+			}
+			_, size, err := iprot.ReadListBegin()
+			if err != nil {
+				return err
+			}
+			_val1 := make([]string, 0, size)
+			for i := 0; i < size; i++ {
+
+				var _elem string
+				if v, err := iprot.ReadString(); err != nil {
+					return err
+				} else {
+					_elem = v
+				}
+
+				_val1 = append(_val1, _elem)
+			}
+			if err := iprot.ReadListEnd(); err != nil {
+				return err
+			}
+
+			_val[_key1] = _val1
+		}
+		if err := iprot.ReadMapEnd(); err != nil {
+			return err
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Tags = _field
+	return nil
+}
+func (p *EvaluatorTemplate) ReadField101(iprot thrift.TProtocol) error {
+	_field := NewEvaluatorContent()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.EvaluatorContent = _field
+	return nil
+}
+func (p *EvaluatorTemplate) ReadField255(iprot thrift.TProtocol) error {
+	_field := common.NewBaseInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseInfo = _field
+	return nil
+}
+
+func (p *EvaluatorTemplate) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorTemplate"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField101(oprot); err != nil {
+			fieldId = 101
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorTemplate) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorTemplate) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluatorTemplate) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorType() {
+		if err = oprot.WriteFieldBegin("evaluator_type", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.EvaluatorType)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluatorTemplate) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluatorTemplate) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err = oprot.WriteFieldBegin("description", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Description); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvaluatorTemplate) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPopularity() {
+		if err = oprot.WriteFieldBegin("popularity", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.Popularity); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *EvaluatorTemplate) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorInfo() {
+		if err = oprot.WriteFieldBegin("evaluator_info", thrift.STRUCT, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.EvaluatorInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *EvaluatorTemplate) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTags() {
+		if err = oprot.WriteFieldBegin("tags", thrift.MAP, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.MAP, len(p.Tags)); err != nil {
+			return err
+		}
+		for k, v := range p.Tags {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteMapBegin(thrift.STRING, thrift.LIST, len(v)); err != nil {
+				return err
+			}
+			for k, v := range v {
+				if err := oprot.WriteString(k); err != nil {
+					return err
+				}
+				if err := oprot.WriteListBegin(thrift.STRING, len(v)); err != nil {
+					return err
+				}
+				for _, v := range v {
+					if err := oprot.WriteString(v); err != nil {
+						return err
+					}
+				}
+				if err := oprot.WriteListEnd(); err != nil {
+					return err
+				}
+			}
+			if err := oprot.WriteMapEnd(); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *EvaluatorTemplate) writeField101(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorContent() {
+		if err = oprot.WriteFieldBegin("evaluator_content", thrift.STRUCT, 101); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.EvaluatorContent.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 101 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 101 end error: ", p), err)
+}
+func (p *EvaluatorTemplate) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseInfo() {
+		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *EvaluatorTemplate) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorTemplate(%+v)", *p)
+
+}
+
+func (p *EvaluatorTemplate) DeepEqual(ano *EvaluatorTemplate) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.EvaluatorType) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Description) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Popularity) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.EvaluatorInfo) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.Tags) {
+		return false
+	}
+	if !p.Field101DeepEqual(ano.EvaluatorContent) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseInfo) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorTemplate) Field1DeepEqual(src *int64) bool {
+
+	if p.ID == src {
+		return true
+	} else if p.ID == nil || src == nil {
+		return false
+	}
+	if *p.ID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorTemplate) Field2DeepEqual(src *int64) bool {
+
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
+	}
+	if *p.WorkspaceID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorTemplate) Field3DeepEqual(src *EvaluatorType) bool {
+
+	if p.EvaluatorType == src {
+		return true
+	} else if p.EvaluatorType == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatorType != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorTemplate) Field4DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorTemplate) Field5DeepEqual(src *string) bool {
+
+	if p.Description == src {
+		return true
+	} else if p.Description == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Description, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorTemplate) Field6DeepEqual(src *int64) bool {
+
+	if p.Popularity == src {
+		return true
+	} else if p.Popularity == nil || src == nil {
+		return false
+	}
+	if *p.Popularity != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorTemplate) Field7DeepEqual(src *EvaluatorInfo) bool {
+
+	if !p.EvaluatorInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorTemplate) Field9DeepEqual(src map[EvaluatorTagLangType]map[EvaluatorTagKey][]string) bool {
+
+	if len(p.Tags) != len(src) {
+		return false
+	}
+	for k, v := range p.Tags {
+		_src := src[k]
+		if len(v) != len(_src) {
+			return false
+		}
+		for k, v := range v {
+			_src1 := _src[k]
+			if len(v) != len(_src1) {
+				return false
+			}
+			for i, v := range v {
+				_src2 := _src1[i]
+				if strings.Compare(v, _src2) != 0 {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+func (p *EvaluatorTemplate) Field101DeepEqual(src *EvaluatorContent) bool {
+
+	if !p.EvaluatorContent.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorTemplate) Field255DeepEqual(src *common.BaseInfo) bool {
+
+	if !p.BaseInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+// Evaluator筛选器选项
+type EvaluatorFilterOption struct {
+	// 模糊搜索关键词，在所有tag中搜索
+	SearchKeyword *string `thrift:"search_keyword,1,optional" frugal:"1,optional,string" form:"search_keyword" json:"search_keyword,omitempty" query:"search_keyword"`
+	// 筛选条件
+	Filters *EvaluatorFilters `thrift:"filters,2,optional" frugal:"2,optional,EvaluatorFilters" form:"filters" json:"filters,omitempty" query:"filters"`
+}
+
+func NewEvaluatorFilterOption() *EvaluatorFilterOption {
+	return &EvaluatorFilterOption{}
+}
+
+func (p *EvaluatorFilterOption) InitDefault() {
+}
+
+var EvaluatorFilterOption_SearchKeyword_DEFAULT string
+
+func (p *EvaluatorFilterOption) GetSearchKeyword() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSearchKeyword() {
+		return EvaluatorFilterOption_SearchKeyword_DEFAULT
+	}
+	return *p.SearchKeyword
+}
+
+var EvaluatorFilterOption_Filters_DEFAULT *EvaluatorFilters
+
+func (p *EvaluatorFilterOption) GetFilters() (v *EvaluatorFilters) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFilters() {
+		return EvaluatorFilterOption_Filters_DEFAULT
+	}
+	return p.Filters
+}
+func (p *EvaluatorFilterOption) SetSearchKeyword(val *string) {
+	p.SearchKeyword = val
+}
+func (p *EvaluatorFilterOption) SetFilters(val *EvaluatorFilters) {
+	p.Filters = val
+}
+
+var fieldIDToName_EvaluatorFilterOption = map[int16]string{
+	1: "search_keyword",
+	2: "filters",
+}
+
+func (p *EvaluatorFilterOption) IsSetSearchKeyword() bool {
+	return p.SearchKeyword != nil
+}
+
+func (p *EvaluatorFilterOption) IsSetFilters() bool {
+	return p.Filters != nil
+}
+
+func (p *EvaluatorFilterOption) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFilterOption[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorFilterOption) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SearchKeyword = _field
+	return nil
+}
+func (p *EvaluatorFilterOption) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewEvaluatorFilters()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Filters = _field
+	return nil
+}
+
+func (p *EvaluatorFilterOption) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorFilterOption"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorFilterOption) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSearchKeyword() {
+		if err = oprot.WriteFieldBegin("search_keyword", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SearchKeyword); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorFilterOption) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilters() {
+		if err = oprot.WriteFieldBegin("filters", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Filters.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *EvaluatorFilterOption) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorFilterOption(%+v)", *p)
+
+}
+
+func (p *EvaluatorFilterOption) DeepEqual(ano *EvaluatorFilterOption) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.SearchKeyword) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Filters) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorFilterOption) Field1DeepEqual(src *string) bool {
+
+	if p.SearchKeyword == src {
+		return true
+	} else if p.SearchKeyword == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.SearchKeyword, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorFilterOption) Field2DeepEqual(src *EvaluatorFilters) bool {
+
+	if !p.Filters.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+// Evaluator筛选条件
+type EvaluatorFilters struct {
+	// 筛选条件列表
+	FilterConditions []*EvaluatorFilterCondition `thrift:"filter_conditions,1,optional" frugal:"1,optional,list<EvaluatorFilterCondition>" form:"filter_conditions" json:"filter_conditions,omitempty" query:"filter_conditions"`
+	// 逻辑操作符
+	LogicOp    *EvaluatorFilterLogicOp `thrift:"logic_op,2,optional" frugal:"2,optional,string" form:"logic_op" json:"logic_op,omitempty" query:"logic_op"`
+	SubFilters []*EvaluatorFilters     `thrift:"sub_filters,3,optional" frugal:"3,optional,list<EvaluatorFilters>" form:"sub_filters" json:"sub_filters,omitempty" query:"sub_filters"`
+}
+
+func NewEvaluatorFilters() *EvaluatorFilters {
+	return &EvaluatorFilters{}
+}
+
+func (p *EvaluatorFilters) InitDefault() {
+}
+
+var EvaluatorFilters_FilterConditions_DEFAULT []*EvaluatorFilterCondition
+
+func (p *EvaluatorFilters) GetFilterConditions() (v []*EvaluatorFilterCondition) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFilterConditions() {
+		return EvaluatorFilters_FilterConditions_DEFAULT
+	}
+	return p.FilterConditions
+}
+
+var EvaluatorFilters_LogicOp_DEFAULT EvaluatorFilterLogicOp
+
+func (p *EvaluatorFilters) GetLogicOp() (v EvaluatorFilterLogicOp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLogicOp() {
+		return EvaluatorFilters_LogicOp_DEFAULT
+	}
+	return *p.LogicOp
+}
+
+var EvaluatorFilters_SubFilters_DEFAULT []*EvaluatorFilters
+
+func (p *EvaluatorFilters) GetSubFilters() (v []*EvaluatorFilters) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSubFilters() {
+		return EvaluatorFilters_SubFilters_DEFAULT
+	}
+	return p.SubFilters
+}
+func (p *EvaluatorFilters) SetFilterConditions(val []*EvaluatorFilterCondition) {
+	p.FilterConditions = val
+}
+func (p *EvaluatorFilters) SetLogicOp(val *EvaluatorFilterLogicOp) {
+	p.LogicOp = val
+}
+func (p *EvaluatorFilters) SetSubFilters(val []*EvaluatorFilters) {
+	p.SubFilters = val
+}
+
+var fieldIDToName_EvaluatorFilters = map[int16]string{
+	1: "filter_conditions",
+	2: "logic_op",
+	3: "sub_filters",
+}
+
+func (p *EvaluatorFilters) IsSetFilterConditions() bool {
+	return p.FilterConditions != nil
+}
+
+func (p *EvaluatorFilters) IsSetLogicOp() bool {
+	return p.LogicOp != nil
+}
+
+func (p *EvaluatorFilters) IsSetSubFilters() bool {
+	return p.SubFilters != nil
+}
+
+func (p *EvaluatorFilters) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFilters[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorFilters) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*EvaluatorFilterCondition, 0, size)
+	values := make([]EvaluatorFilterCondition, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FilterConditions = _field
+	return nil
+}
+func (p *EvaluatorFilters) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *EvaluatorFilterLogicOp
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LogicOp = _field
+	return nil
+}
+func (p *EvaluatorFilters) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*EvaluatorFilters, 0, size)
+	values := make([]EvaluatorFilters, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.SubFilters = _field
+	return nil
+}
+
+func (p *EvaluatorFilters) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorFilters"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorFilters) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilterConditions() {
+		if err = oprot.WriteFieldBegin("filter_conditions", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FilterConditions)); err != nil {
+			return err
+		}
+		for _, v := range p.FilterConditions {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorFilters) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLogicOp() {
+		if err = oprot.WriteFieldBegin("logic_op", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.LogicOp); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluatorFilters) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSubFilters() {
+		if err = oprot.WriteFieldBegin("sub_filters", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.SubFilters)); err != nil {
+			return err
+		}
+		for _, v := range p.SubFilters {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *EvaluatorFilters) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorFilters(%+v)", *p)
+
+}
+
+func (p *EvaluatorFilters) DeepEqual(ano *EvaluatorFilters) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.FilterConditions) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.LogicOp) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.SubFilters) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorFilters) Field1DeepEqual(src []*EvaluatorFilterCondition) bool {
+
+	if len(p.FilterConditions) != len(src) {
+		return false
+	}
+	for i, v := range p.FilterConditions {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluatorFilters) Field2DeepEqual(src *EvaluatorFilterLogicOp) bool {
+
+	if p.LogicOp == src {
+		return true
+	} else if p.LogicOp == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.LogicOp, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorFilters) Field3DeepEqual(src []*EvaluatorFilters) bool {
+
+	if len(p.SubFilters) != len(src) {
+		return false
+	}
+	for i, v := range p.SubFilters {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+// Evaluator筛选条件
+type EvaluatorFilterCondition struct {
+	// 筛选字段
+	TagKey EvaluatorTagKey `thrift:"tag_key,1,required" frugal:"1,required,string" form:"tag_key,required" json:"tag_key,required" query:"tag_key,required"`
+	// 操作符
+	Operator EvaluatorFilterOperatorType `thrift:"operator,2,required" frugal:"2,required,string" form:"operator,required" json:"operator,required" query:"operator,required"`
+	// 操作值
+	Value string `thrift:"value,3,required" frugal:"3,required,string" form:"value,required" json:"value,required" query:"value,required"`
+}
+
+func NewEvaluatorFilterCondition() *EvaluatorFilterCondition {
+	return &EvaluatorFilterCondition{}
+}
+
+func (p *EvaluatorFilterCondition) InitDefault() {
+}
+
+func (p *EvaluatorFilterCondition) GetTagKey() (v EvaluatorTagKey) {
+	if p != nil {
+		return p.TagKey
+	}
+	return
+}
+
+func (p *EvaluatorFilterCondition) GetOperator() (v EvaluatorFilterOperatorType) {
+	if p != nil {
+		return p.Operator
+	}
+	return
+}
+
+func (p *EvaluatorFilterCondition) GetValue() (v string) {
+	if p != nil {
+		return p.Value
+	}
+	return
+}
+func (p *EvaluatorFilterCondition) SetTagKey(val EvaluatorTagKey) {
+	p.TagKey = val
+}
+func (p *EvaluatorFilterCondition) SetOperator(val EvaluatorFilterOperatorType) {
+	p.Operator = val
+}
+func (p *EvaluatorFilterCondition) SetValue(val string) {
+	p.Value = val
+}
+
+var fieldIDToName_EvaluatorFilterCondition = map[int16]string{
+	1: "tag_key",
+	2: "operator",
+	3: "value",
+}
+
+func (p *EvaluatorFilterCondition) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetTagKey bool = false
+	var issetOperator bool = false
+	var issetValue bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTagKey = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOperator = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetValue = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetTagKey {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetOperator {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetValue {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFilterCondition[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_EvaluatorFilterCondition[fieldId]))
+}
+
+func (p *EvaluatorFilterCondition) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field EvaluatorTagKey
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TagKey = _field
+	return nil
+}
+func (p *EvaluatorFilterCondition) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field EvaluatorFilterOperatorType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Operator = _field
+	return nil
+}
+func (p *EvaluatorFilterCondition) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Value = _field
+	return nil
+}
+
+func (p *EvaluatorFilterCondition) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorFilterCondition"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorFilterCondition) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tag_key", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TagKey); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorFilterCondition) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("operator", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Operator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluatorFilterCondition) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("value", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Value); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *EvaluatorFilterCondition) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorFilterCondition(%+v)", *p)
+
+}
+
+func (p *EvaluatorFilterCondition) DeepEqual(ano *EvaluatorFilterCondition) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.TagKey) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Operator) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Value) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorFilterCondition) Field1DeepEqual(src EvaluatorTagKey) bool {
+
+	if strings.Compare(p.TagKey, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorFilterCondition) Field2DeepEqual(src EvaluatorFilterOperatorType) bool {
+
+	if strings.Compare(p.Operator, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorFilterCondition) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Value, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type Correction struct {
+	Score     *float64 `thrift:"score,1,optional" frugal:"1,optional,double" form:"score" json:"score,omitempty" query:"score"`
+	Explain   *string  `thrift:"explain,2,optional" frugal:"2,optional,string" form:"explain" json:"explain,omitempty" query:"explain"`
+	UpdatedBy *string  `thrift:"updated_by,3,optional" frugal:"3,optional,string" form:"updated_by" json:"updated_by,omitempty" query:"updated_by"`
+}
+
+func NewCorrection() *Correction {
+	return &Correction{}
+}
+
+func (p *Correction) InitDefault() {
+}
+
+var Correction_Score_DEFAULT float64
+
+func (p *Correction) GetScore() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScore() {
+		return Correction_Score_DEFAULT
+	}
+	return *p.Score
+}
+
+var Correction_Explain_DEFAULT string
+
+func (p *Correction) GetExplain() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExplain() {
+		return Correction_Explain_DEFAULT
+	}
+	return *p.Explain
+}
+
+var Correction_UpdatedBy_DEFAULT string
+
+func (p *Correction) GetUpdatedBy() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUpdatedBy() {
+		return Correction_UpdatedBy_DEFAULT
+	}
+	return *p.UpdatedBy
+}
+func (p *Correction) SetScore(val *float64) {
+	p.Score = val
+}
+func (p *Correction) SetExplain(val *string) {
+	p.Explain = val
+}
+func (p *Correction) SetUpdatedBy(val *string) {
+	p.UpdatedBy = val
+}
+
+var fieldIDToName_Correction = map[int16]string{
+	1: "score",
+	2: "explain",
+	3: "updated_by",
+}
+
+func (p *Correction) IsSetScore() bool {
+	return p.Score != nil
+}
+
+func (p *Correction) IsSetExplain() bool {
+	return p.Explain != nil
+}
+
+func (p *Correction) IsSetUpdatedBy() bool {
+	return p.UpdatedBy != nil
+}
+
+func (p *Correction) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Correction[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *Correction) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Score = _field
+	return nil
+}
+func (p *Correction) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Explain = _field
+	return nil
+}
+func (p *Correction) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UpdatedBy = _field
+	return nil
+}
+
+func (p *Correction) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Correction"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Correction) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScore() {
+		if err = oprot.WriteFieldBegin("score", thrift.DOUBLE, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Score); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Correction) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExplain() {
+		if err = oprot.WriteFieldBegin("explain", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Explain); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *Correction) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUpdatedBy() {
+		if err = oprot.WriteFieldBegin("updated_by", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UpdatedBy); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *Correction) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Correction(%+v)", *p)
+
+}
+
+func (p *Correction) DeepEqual(ano *Correction) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Score) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Explain) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.UpdatedBy) {
+		return false
+	}
+	return true
+}
+
+func (p *Correction) Field1DeepEqual(src *float64) bool {
+
+	if p.Score == src {
+		return true
+	} else if p.Score == nil || src == nil {
+		return false
+	}
+	if *p.Score != *src {
+		return false
+	}
+	return true
+}
+func (p *Correction) Field2DeepEqual(src *string) bool {
+
+	if p.Explain == src {
+		return true
+	} else if p.Explain == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Explain, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Correction) Field3DeepEqual(src *string) bool {
+
+	if p.UpdatedBy == src {
+		return true
+	} else if p.UpdatedBy == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.UpdatedBy, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type EvaluatorRecord struct {
+	ID                  *int64               `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id" form:"id" query:"id"`
+	ExperimentID        *int64               `thrift:"experiment_id,2,optional" frugal:"2,optional,i64" json:"experiment_id" form:"experiment_id" query:"experiment_id"`
+	ExperimentRunID     *int64               `thrift:"experiment_run_id,3,optional" frugal:"3,optional,i64" json:"experiment_run_id" form:"experiment_run_id" query:"experiment_run_id"`
+	ItemID              *int64               `thrift:"item_id,4,optional" frugal:"4,optional,i64" json:"item_id" form:"item_id" query:"item_id"`
+	TurnID              *int64               `thrift:"turn_id,5,optional" frugal:"5,optional,i64" json:"turn_id" form:"turn_id" query:"turn_id"`
+	EvaluatorVersionID  *int64               `thrift:"evaluator_version_id,6,optional" frugal:"6,optional,i64" json:"evaluator_version_id" form:"evaluator_version_id" query:"evaluator_version_id"`
+	TraceID             *string              `thrift:"trace_id,7,optional" frugal:"7,optional,string" form:"trace_id" json:"trace_id,omitempty" query:"trace_id"`
+	LogID               *string              `thrift:"log_id,8,optional" frugal:"8,optional,string" form:"log_id" json:"log_id,omitempty" query:"log_id"`
+	EvaluatorInputData  *EvaluatorInputData  `thrift:"evaluator_input_data,9,optional" frugal:"9,optional,EvaluatorInputData" form:"evaluator_input_data" json:"evaluator_input_data,omitempty" query:"evaluator_input_data"`
+	EvaluatorOutputData *EvaluatorOutputData `thrift:"evaluator_output_data,10,optional" frugal:"10,optional,EvaluatorOutputData" form:"evaluator_output_data" json:"evaluator_output_data,omitempty" query:"evaluator_output_data"`
+	Status              *EvaluatorRunStatus  `thrift:"status,11,optional" frugal:"11,optional,EvaluatorRunStatus" form:"status" json:"status,omitempty" query:"status"`
+	BaseInfo            *common.BaseInfo     `thrift:"base_info,12,optional" frugal:"12,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+	Ext                 map[string]string    `thrift:"ext,20,optional" frugal:"20,optional,map<string:string>" form:"ext" json:"ext,omitempty" query:"ext"`
+}
+
+func NewEvaluatorRecord() *EvaluatorRecord {
+	return &EvaluatorRecord{}
+}
+
+func (p *EvaluatorRecord) InitDefault() {
+}
+
+var EvaluatorRecord_ID_DEFAULT int64
+
+func (p *EvaluatorRecord) GetID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetID() {
+		return EvaluatorRecord_ID_DEFAULT
+	}
+	return *p.ID
+}
+
+var EvaluatorRecord_ExperimentID_DEFAULT int64
+
+func (p *EvaluatorRecord) GetExperimentID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExperimentID() {
+		return EvaluatorRecord_ExperimentID_DEFAULT
+	}
+	return *p.ExperimentID
+}
+
+var EvaluatorRecord_ExperimentRunID_DEFAULT int64
+
+func (p *EvaluatorRecord) GetExperimentRunID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExperimentRunID() {
+		return EvaluatorRecord_ExperimentRunID_DEFAULT
 	}
 	return *p.ExperimentRunID
 }
 
-var EvaluatorRecord_ItemID_DEFAULT int64
+var EvaluatorRecord_ItemID_DEFAULT int64
+
+func (p *EvaluatorRecord) GetItemID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetItemID() {
+		return EvaluatorRecord_ItemID_DEFAULT
+	}
+	return *p.ItemID
+}
+
+var EvaluatorRecord_TurnID_DEFAULT int64
+
+func (p *EvaluatorRecord) GetTurnID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTurnID() {
+		return EvaluatorRecord_TurnID_DEFAULT
+	}
+	return *p.TurnID
+}
+
+var EvaluatorRecord_EvaluatorVersionID_DEFAULT int64
+
+func (p *EvaluatorRecord) GetEvaluatorVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorVersionID() {
+		return EvaluatorRecord_EvaluatorVersionID_DEFAULT
+	}
+	return *p.EvaluatorVersionID
+}
+
+var EvaluatorRecord_TraceID_DEFAULT string
+
+func (p *EvaluatorRecord) GetTraceID() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTraceID() {
+		return EvaluatorRecord_TraceID_DEFAULT
+	}
+	return *p.TraceID
+}
+
+var EvaluatorRecord_LogID_DEFAULT string
+
+func (p *EvaluatorRecord) GetLogID() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLogID() {
+		return EvaluatorRecord_LogID_DEFAULT
+	}
+	return *p.LogID
+}
+
+var EvaluatorRecord_EvaluatorInputData_DEFAULT *EvaluatorInputData
+
+func (p *EvaluatorRecord) GetEvaluatorInputData() (v *EvaluatorInputData) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorInputData() {
+		return EvaluatorRecord_EvaluatorInputData_DEFAULT
+	}
+	return p.EvaluatorInputData
+}
+
+var EvaluatorRecord_EvaluatorOutputData_DEFAULT *EvaluatorOutputData
+
+func (p *EvaluatorRecord) GetEvaluatorOutputData() (v *EvaluatorOutputData) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorOutputData() {
+		return EvaluatorRecord_EvaluatorOutputData_DEFAULT
+	}
+	return p.EvaluatorOutputData
+}
+
+var EvaluatorRecord_Status_DEFAULT EvaluatorRunStatus
+
+func (p *EvaluatorRecord) GetStatus() (v EvaluatorRunStatus) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStatus() {
+		return EvaluatorRecord_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var EvaluatorRecord_BaseInfo_DEFAULT *common.BaseInfo
+
+func (p *EvaluatorRecord) GetBaseInfo() (v *common.BaseInfo) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseInfo() {
+		return EvaluatorRecord_BaseInfo_DEFAULT
+	}
+	return p.BaseInfo
+}
+
+var EvaluatorRecord_Ext_DEFAULT map[string]string
+
+func (p *EvaluatorRecord) GetExt() (v map[string]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExt() {
+		return EvaluatorRecord_Ext_DEFAULT
+	}
+	return p.Ext
+}
+func (p *EvaluatorRecord) SetID(val *int64) {
+	p.ID = val
+}
+func (p *EvaluatorRecord) SetExperimentID(val *int64) {
+	p.ExperimentID = val
+}
+func (p *EvaluatorRecord) SetExperimentRunID(val *int64) {
+	p.ExperimentRunID = val
+}
+func (p *EvaluatorRecord) SetItemID(val *int64) {
+	p.ItemID = val
+}
+func (p *EvaluatorRecord) SetTurnID(val *int64) {
+	p.TurnID = val
+}
+func (p *EvaluatorRecord) SetEvaluatorVersionID(val *int64) {
+	p.EvaluatorVersionID = val
+}
+func (p *EvaluatorRecord) SetTraceID(val *string) {
+	p.TraceID = val
+}
+func (p *EvaluatorRecord) SetLogID(val *string) {
+	p.LogID = val
+}
+func (p *EvaluatorRecord) SetEvaluatorInputData(val *EvaluatorInputData) {
+	p.EvaluatorInputData = val
+}
+func (p *EvaluatorRecord) SetEvaluatorOutputData(val *EvaluatorOutputData) {
+	p.EvaluatorOutputData = val
+}
+func (p *EvaluatorRecord) SetStatus(val *EvaluatorRunStatus) {
+	p.Status = val
+}
+func (p *EvaluatorRecord) SetBaseInfo(val *common.BaseInfo) {
+	p.BaseInfo = val
+}
+func (p *EvaluatorRecord) SetExt(val map[string]string) {
+	p.Ext = val
+}
+
+var fieldIDToName_EvaluatorRecord = map[int16]string{
+	1:  "id",
+	2:  "experiment_id",
+	3:  "experiment_run_id",
+	4:  "item_id",
+	5:  "turn_id",
+	6:  "evaluator_version_id",
+	7:  "trace_id",
+	8:  "log_id",
+	9:  "evaluator_input_data",
+	10: "evaluator_output_data",
+	11: "status",
+	12: "base_info",
+	20: "ext",
+}
+
+func (p *EvaluatorRecord) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *EvaluatorRecord) IsSetExperimentID() bool {
+	return p.ExperimentID != nil
+}
+
+func (p *EvaluatorRecord) IsSetExperimentRunID() bool {
+	return p.ExperimentRunID != nil
+}
+
+func (p *EvaluatorRecord) IsSetItemID() bool {
+	return p.ItemID != nil
+}
+
+func (p *EvaluatorRecord) IsSetTurnID() bool {
+	return p.TurnID != nil
+}
+
+func (p *EvaluatorRecord) IsSetEvaluatorVersionID() bool {
+	return p.EvaluatorVersionID != nil
+}
+
+func (p *EvaluatorRecord) IsSetTraceID() bool {
+	return p.TraceID != nil
+}
+
+func (p *EvaluatorRecord) IsSetLogID() bool {
+	return p.LogID != nil
+}
+
+func (p *EvaluatorRecord) IsSetEvaluatorInputData() bool {
+	return p.EvaluatorInputData != nil
+}
+
+func (p *EvaluatorRecord) IsSetEvaluatorOutputData() bool {
+	return p.EvaluatorOutputData != nil
+}
+
+func (p *EvaluatorRecord) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *EvaluatorRecord) IsSetBaseInfo() bool {
+	return p.BaseInfo != nil
+}
+
+func (p *EvaluatorRecord) IsSetExt() bool {
+	return p.Ext != nil
+}
+
+func (p *EvaluatorRecord) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 20:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField20(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorRecord[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorRecord) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *EvaluatorRecord) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ExperimentID = _field
+	return nil
+}
+func (p *EvaluatorRecord) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ExperimentRunID = _field
+	return nil
+}
+func (p *EvaluatorRecord) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ItemID = _field
+	return nil
+}
+func (p *EvaluatorRecord) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TurnID = _field
+	return nil
+}
+func (p *EvaluatorRecord) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatorVersionID = _field
+	return nil
+}
+func (p *EvaluatorRecord) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TraceID = _field
+	return nil
+}
+func (p *EvaluatorRecord) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LogID = _field
+	return nil
+}
+func (p *EvaluatorRecord) ReadField9(iprot thrift.TProtocol) error {
+	_field := NewEvaluatorInputData()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.EvaluatorInputData = _field
+	return nil
+}
+func (p *EvaluatorRecord) ReadField10(iprot thrift.TProtocol) error {
+	_field := NewEvaluatorOutputData()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.EvaluatorOutputData = _field
+	return nil
+}
+func (p *EvaluatorRecord) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *EvaluatorRunStatus
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := EvaluatorRunStatus(v)
+		_field = &tmp
+	}
+	p.Status = _field
+	return nil
+}
+func (p *EvaluatorRecord) ReadField12(iprot thrift.TProtocol) error {
+	_field := common.NewBaseInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseInfo = _field
+	return nil
+}
+func (p *EvaluatorRecord) ReadField20(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Ext = _field
+	return nil
+}
+
+func (p *EvaluatorRecord) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorRecord"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField20(oprot); err != nil {
+			fieldId = 20
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorRecord) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorRecord) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExperimentID() {
+		if err = oprot.WriteFieldBegin("experiment_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ExperimentID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluatorRecord) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExperimentRunID() {
+		if err = oprot.WriteFieldBegin("experiment_run_id", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ExperimentRunID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluatorRecord) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItemID() {
+		if err = oprot.WriteFieldBegin("item_id", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ItemID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluatorRecord) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTurnID() {
+		if err = oprot.WriteFieldBegin("turn_id", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TurnID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvaluatorRecord) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersionID() {
+		if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluatorVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *EvaluatorRecord) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTraceID() {
+		if err = oprot.WriteFieldBegin("trace_id", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TraceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *EvaluatorRecord) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLogID() {
+		if err = oprot.WriteFieldBegin("log_id", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.LogID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *EvaluatorRecord) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorInputData() {
+		if err = oprot.WriteFieldBegin("evaluator_input_data", thrift.STRUCT, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.EvaluatorInputData.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *EvaluatorRecord) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorOutputData() {
+		if err = oprot.WriteFieldBegin("evaluator_output_data", thrift.STRUCT, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.EvaluatorOutputData.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *EvaluatorRecord) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.I32, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.Status)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *EvaluatorRecord) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseInfo() {
+		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *EvaluatorRecord) writeField20(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExt() {
+		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 20); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Ext)); err != nil {
+			return err
+		}
+		for k, v := range p.Ext {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 end error: ", p), err)
+}
+
+func (p *EvaluatorRecord) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorRecord(%+v)", *p)
+
+}
+
+func (p *EvaluatorRecord) DeepEqual(ano *EvaluatorRecord) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ExperimentID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ExperimentRunID) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ItemID) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.TurnID) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.EvaluatorVersionID) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.TraceID) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.LogID) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.EvaluatorInputData) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.EvaluatorOutputData) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.BaseInfo) {
+		return false
+	}
+	if !p.Field20DeepEqual(ano.Ext) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorRecord) Field1DeepEqual(src *int64) bool {
+
+	if p.ID == src {
+		return true
+	} else if p.ID == nil || src == nil {
+		return false
+	}
+	if *p.ID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorRecord) Field2DeepEqual(src *int64) bool {
+
+	if p.ExperimentID == src {
+		return true
+	} else if p.ExperimentID == nil || src == nil {
+		return false
+	}
+	if *p.ExperimentID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorRecord) Field3DeepEqual(src *int64) bool {
+
+	if p.ExperimentRunID == src {
+		return true
+	} else if p.ExperimentRunID == nil || src == nil {
+		return false
+	}
+	if *p.ExperimentRunID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorRecord) Field4DeepEqual(src *int64) bool {
+
+	if p.ItemID == src {
+		return true
+	} else if p.ItemID == nil || src == nil {
+		return false
+	}
+	if *p.ItemID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorRecord) Field5DeepEqual(src *int64) bool {
+
+	if p.TurnID == src {
+		return true
+	} else if p.TurnID == nil || src == nil {
+		return false
+	}
+	if *p.TurnID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorRecord) Field6DeepEqual(src *int64) bool {
+
+	if p.EvaluatorVersionID == src {
+		return true
+	} else if p.EvaluatorVersionID == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatorVersionID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorRecord) Field7DeepEqual(src *string) bool {
+
+	if p.TraceID == src {
+		return true
+	} else if p.TraceID == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.TraceID, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorRecord) Field8DeepEqual(src *string) bool {
+
+	if p.LogID == src {
+		return true
+	} else if p.LogID == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.LogID, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorRecord) Field9DeepEqual(src *EvaluatorInputData) bool {
+
+	if !p.EvaluatorInputData.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorRecord) Field10DeepEqual(src *EvaluatorOutputData) bool {
+
+	if !p.EvaluatorOutputData.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorRecord) Field11DeepEqual(src *EvaluatorRunStatus) bool {
+
+	if p.Status == src {
+		return true
+	} else if p.Status == nil || src == nil {
+		return false
+	}
+	if *p.Status != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorRecord) Field12DeepEqual(src *common.BaseInfo) bool {
+
+	if !p.BaseInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorRecord) Field20DeepEqual(src map[string]string) bool {
+
+	if len(p.Ext) != len(src) {
+		return false
+	}
+	for k, v := range p.Ext {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+type EvaluatorOutputData struct {
+	EvaluatorResult_  *EvaluatorResult_            `thrift:"evaluator_result,1,optional" frugal:"1,optional,EvaluatorResult_" form:"evaluator_result" json:"evaluator_result,omitempty" query:"evaluator_result"`
+	EvaluatorUsage    *EvaluatorUsage              `thrift:"evaluator_usage,2,optional" frugal:"2,optional,EvaluatorUsage" form:"evaluator_usage" json:"evaluator_usage,omitempty" query:"evaluator_usage"`
+	EvaluatorRunError *EvaluatorRunError           `thrift:"evaluator_run_error,3,optional" frugal:"3,optional,EvaluatorRunError" form:"evaluator_run_error" json:"evaluator_run_error,omitempty" query:"evaluator_run_error"`
+	TimeConsumingMs   *int64                       `thrift:"time_consuming_ms,4,optional" frugal:"4,optional,i64" json:"time_consuming_ms" form:"time_consuming_ms" query:"time_consuming_ms"`
+	Stdout            *string                      `thrift:"stdout,11,optional" frugal:"11,optional,string" form:"stdout" json:"stdout,omitempty" query:"stdout"`
+	ExtraOutput       *EvaluatorExtraOutputContent `thrift:"extra_output,12,optional" frugal:"12,optional,EvaluatorExtraOutputContent" form:"extra_output" json:"extra_output,omitempty" query:"extra_output"`
+}
+
+func NewEvaluatorOutputData() *EvaluatorOutputData {
+	return &EvaluatorOutputData{}
+}
+
+func (p *EvaluatorOutputData) InitDefault() {
+}
+
+var EvaluatorOutputData_EvaluatorResult__DEFAULT *EvaluatorResult_
+
+func (p *EvaluatorOutputData) GetEvaluatorResult_() (v *EvaluatorResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorResult_() {
+		return EvaluatorOutputData_EvaluatorResult__DEFAULT
+	}
+	return p.EvaluatorResult_
+}
+
+var EvaluatorOutputData_EvaluatorUsage_DEFAULT *EvaluatorUsage
+
+func (p *EvaluatorOutputData) GetEvaluatorUsage() (v *EvaluatorUsage) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorUsage() {
+		return EvaluatorOutputData_EvaluatorUsage_DEFAULT
+	}
+	return p.EvaluatorUsage
+}
+
+var EvaluatorOutputData_EvaluatorRunError_DEFAULT *EvaluatorRunError
+
+func (p *EvaluatorOutputData) GetEvaluatorRunError() (v *EvaluatorRunError) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorRunError() {
+		return EvaluatorOutputData_EvaluatorRunError_DEFAULT
+	}
+	return p.EvaluatorRunError
+}
+
+var EvaluatorOutputData_TimeConsumingMs_DEFAULT int64
+
+func (p *EvaluatorOutputData) GetTimeConsumingMs() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTimeConsumingMs() {
+		return EvaluatorOutputData_TimeConsumingMs_DEFAULT
+	}
+	return *p.TimeConsumingMs
+}
+
+var EvaluatorOutputData_Stdout_DEFAULT string
+
+func (p *EvaluatorOutputData) GetStdout() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStdout() {
+		return EvaluatorOutputData_Stdout_DEFAULT
+	}
+	return *p.Stdout
+}
+
+var EvaluatorOutputData_ExtraOutput_DEFAULT *EvaluatorExtraOutputContent
+
+func (p *EvaluatorOutputData) GetExtraOutput() (v *EvaluatorExtraOutputContent) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExtraOutput() {
+		return EvaluatorOutputData_ExtraOutput_DEFAULT
+	}
+	return p.ExtraOutput
+}
+func (p *EvaluatorOutputData) SetEvaluatorResult_(val *EvaluatorResult_) {
+	p.EvaluatorResult_ = val
+}
+func (p *EvaluatorOutputData) SetEvaluatorUsage(val *EvaluatorUsage) {
+	p.EvaluatorUsage = val
+}
+func (p *EvaluatorOutputData) SetEvaluatorRunError(val *EvaluatorRunError) {
+	p.EvaluatorRunError = val
+}
+func (p *EvaluatorOutputData) SetTimeConsumingMs(val *int64) {
+	p.TimeConsumingMs = val
+}
+func (p *EvaluatorOutputData) SetStdout(val *string) {
+	p.Stdout = val
+}
+func (p *EvaluatorOutputData) SetExtraOutput(val *EvaluatorExtraOutputContent) {
+	p.ExtraOutput = val
+}
+
+var fieldIDToName_EvaluatorOutputData = map[int16]string{
+	1:  "evaluator_result",
+	2:  "evaluator_usage",
+	3:  "evaluator_run_error",
+	4:  "time_consuming_ms",
+	11: "stdout",
+	12: "extra_output",
+}
+
+func (p *EvaluatorOutputData) IsSetEvaluatorResult_() bool {
+	return p.EvaluatorResult_ != nil
+}
+
+func (p *EvaluatorOutputData) IsSetEvaluatorUsage() bool {
+	return p.EvaluatorUsage != nil
+}
+
+func (p *EvaluatorOutputData) IsSetEvaluatorRunError() bool {
+	return p.EvaluatorRunError != nil
+}
+
+func (p *EvaluatorOutputData) IsSetTimeConsumingMs() bool {
+	return p.TimeConsumingMs != nil
+}
+
+func (p *EvaluatorOutputData) IsSetStdout() bool {
+	return p.Stdout != nil
+}
+
+func (p *EvaluatorOutputData) IsSetExtraOutput() bool {
+	return p.ExtraOutput != nil
+}
+
+func (p *EvaluatorOutputData) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorOutputData[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorOutputData) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewEvaluatorResult_()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.EvaluatorResult_ = _field
+	return nil
+}
+func (p *EvaluatorOutputData) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewEvaluatorUsage()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.EvaluatorUsage = _field
+	return nil
+}
+func (p *EvaluatorOutputData) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewEvaluatorRunError()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.EvaluatorRunError = _field
+	return nil
+}
+func (p *EvaluatorOutputData) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TimeConsumingMs = _field
+	return nil
+}
+func (p *EvaluatorOutputData) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Stdout = _field
+	return nil
+}
+func (p *EvaluatorOutputData) ReadField12(iprot thrift.TProtocol) error {
+	_field := NewEvaluatorExtraOutputContent()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ExtraOutput = _field
+	return nil
+}
+
+func (p *EvaluatorOutputData) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorOutputData"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorOutputData) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorResult_() {
+		if err = oprot.WriteFieldBegin("evaluator_result", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.EvaluatorResult_.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorOutputData) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorUsage() {
+		if err = oprot.WriteFieldBegin("evaluator_usage", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.EvaluatorUsage.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluatorOutputData) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorRunError() {
+		if err = oprot.WriteFieldBegin("evaluator_run_error", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.EvaluatorRunError.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluatorOutputData) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTimeConsumingMs() {
+		if err = oprot.WriteFieldBegin("time_consuming_ms", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TimeConsumingMs); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluatorOutputData) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStdout() {
+		if err = oprot.WriteFieldBegin("stdout", thrift.STRING, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Stdout); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *EvaluatorOutputData) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExtraOutput() {
+		if err = oprot.WriteFieldBegin("extra_output", thrift.STRUCT, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ExtraOutput.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+
+func (p *EvaluatorOutputData) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorOutputData(%+v)", *p)
+
+}
+
+func (p *EvaluatorOutputData) DeepEqual(ano *EvaluatorOutputData) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EvaluatorResult_) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.EvaluatorUsage) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.EvaluatorRunError) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.TimeConsumingMs) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.Stdout) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.ExtraOutput) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorOutputData) Field1DeepEqual(src *EvaluatorResult_) bool {
+
+	if !p.EvaluatorResult_.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorOutputData) Field2DeepEqual(src *EvaluatorUsage) bool {
+
+	if !p.EvaluatorUsage.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorOutputData) Field3DeepEqual(src *EvaluatorRunError) bool {
+
+	if !p.EvaluatorRunError.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorOutputData) Field4DeepEqual(src *int64) bool {
+
+	if p.TimeConsumingMs == src {
+		return true
+	} else if p.TimeConsumingMs == nil || src == nil {
+		return false
+	}
+	if *p.TimeConsumingMs != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorOutputData) Field11DeepEqual(src *string) bool {
+
+	if p.Stdout == src {
+		return true
+	} else if p.Stdout == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Stdout, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorOutputData) Field12DeepEqual(src *EvaluatorExtraOutputContent) bool {
+
+	if !p.ExtraOutput.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type EvaluatorResult_ struct {
+	Score      *float64    `thrift:"score,1,optional" frugal:"1,optional,double" form:"score" json:"score,omitempty" query:"score"`
+	Correction *Correction `thrift:"correction,2,optional" frugal:"2,optional,Correction" form:"correction" json:"correction,omitempty" query:"correction"`
+	Reasoning  *string     `thrift:"reasoning,3,optional" frugal:"3,optional,string" form:"reasoning" json:"reasoning,omitempty" query:"reasoning"`
+}
+
+func NewEvaluatorResult_() *EvaluatorResult_ {
+	return &EvaluatorResult_{}
+}
+
+func (p *EvaluatorResult_) InitDefault() {
+}
+
+var EvaluatorResult__Score_DEFAULT float64
+
+func (p *EvaluatorResult_) GetScore() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScore() {
+		return EvaluatorResult__Score_DEFAULT
+	}
+	return *p.Score
+}
+
+var EvaluatorResult__Correction_DEFAULT *Correction
+
+func (p *EvaluatorResult_) GetCorrection() (v *Correction) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCorrection() {
+		return EvaluatorResult__Correction_DEFAULT
+	}
+	return p.Correction
+}
+
+var EvaluatorResult__Reasoning_DEFAULT string
+
+func (p *EvaluatorResult_) GetReasoning() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReasoning() {
+		return EvaluatorResult__Reasoning_DEFAULT
+	}
+	return *p.Reasoning
+}
+func (p *EvaluatorResult_) SetScore(val *float64) {
+	p.Score = val
+}
+func (p *EvaluatorResult_) SetCorrection(val *Correction) {
+	p.Correction = val
+}
+func (p *EvaluatorResult_) SetReasoning(val *string) {
+	p.Reasoning = val
+}
+
+var fieldIDToName_EvaluatorResult_ = map[int16]string{
+	1: "score",
+	2: "correction",
+	3: "reasoning",
+}
+
+func (p *EvaluatorResult_) IsSetScore() bool {
+	return p.Score != nil
+}
+
+func (p *EvaluatorResult_) IsSetCorrection() bool {
+	return p.Correction != nil
+}
+
+func (p *EvaluatorResult_) IsSetReasoning() bool {
+	return p.Reasoning != nil
+}
+
+func (p *EvaluatorResult_) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorResult_[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Score = _field
+	return nil
+}
+func (p *EvaluatorResult_) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewCorrection()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Correction = _field
+	return nil
+}
+func (p *EvaluatorResult_) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Reasoning = _field
+	return nil
+}
+
+func (p *EvaluatorResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScore() {
+		if err = oprot.WriteFieldBegin("score", thrift.DOUBLE, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Score); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCorrection() {
+		if err = oprot.WriteFieldBegin("correction", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Correction.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluatorResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReasoning() {
+		if err = oprot.WriteFieldBegin("reasoning", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Reasoning); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *EvaluatorResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorResult_(%+v)", *p)
+
+}
+
+func (p *EvaluatorResult_) DeepEqual(ano *EvaluatorResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Score) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Correction) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Reasoning) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorResult_) Field1DeepEqual(src *float64) bool {
+
+	if p.Score == src {
+		return true
+	} else if p.Score == nil || src == nil {
+		return false
+	}
+	if *p.Score != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorResult_) Field2DeepEqual(src *Correction) bool {
+
+	if !p.Correction.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorResult_) Field3DeepEqual(src *string) bool {
+
+	if p.Reasoning == src {
+		return true
+	} else if p.Reasoning == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Reasoning, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type EvaluatorUsage struct {
+	InputTokens  *int64 `thrift:"input_tokens,1,optional" frugal:"1,optional,i64" json:"input_tokens" form:"input_tokens" query:"input_tokens"`
+	OutputTokens *int64 `thrift:"output_tokens,2,optional" frugal:"2,optional,i64" json:"output_tokens" form:"output_tokens" query:"output_tokens"`
+}
+
+func NewEvaluatorUsage() *EvaluatorUsage {
+	return &EvaluatorUsage{}
+}
+
+func (p *EvaluatorUsage) InitDefault() {
+}
+
+var EvaluatorUsage_InputTokens_DEFAULT int64
+
+func (p *EvaluatorUsage) GetInputTokens() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetInputTokens() {
+		return EvaluatorUsage_InputTokens_DEFAULT
+	}
+	return *p.InputTokens
+}
+
+var EvaluatorUsage_OutputTokens_DEFAULT int64
+
+func (p *EvaluatorUsage) GetOutputTokens() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOutputTokens() {
+		return EvaluatorUsage_OutputTokens_DEFAULT
+	}
+	return *p.OutputTokens
+}
+func (p *EvaluatorUsage) SetInputTokens(val *int64) {
+	p.InputTokens = val
+}
+func (p *EvaluatorUsage) SetOutputTokens(val *int64) {
+	p.OutputTokens = val
+}
+
+var fieldIDToName_EvaluatorUsage = map[int16]string{
+	1: "input_tokens",
+	2: "output_tokens",
+}
+
+func (p *EvaluatorUsage) IsSetInputTokens() bool {
+	return p.InputTokens != nil
+}
+
+func (p *EvaluatorUsage) IsSetOutputTokens() bool {
+	return p.OutputTokens != nil
+}
+
+func (p *EvaluatorUsage) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorUsage[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorUsage) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.InputTokens = _field
+	return nil
+}
+func (p *EvaluatorUsage) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.OutputTokens = _field
+	return nil
+}
+
+func (p *EvaluatorUsage) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorUsage"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorUsage) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetInputTokens() {
+		if err = oprot.WriteFieldBegin("input_tokens", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.InputTokens); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorUsage) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputTokens() {
+		if err = oprot.WriteFieldBegin("output_tokens", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.OutputTokens); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *EvaluatorUsage) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorUsage(%+v)", *p)
+
+}
+
+func (p *EvaluatorUsage) DeepEqual(ano *EvaluatorUsage) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.InputTokens) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.OutputTokens) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorUsage) Field1DeepEqual(src *int64) bool {
+
+	if p.InputTokens == src {
+		return true
+	} else if p.InputTokens == nil || src == nil {
+		return false
+	}
+	if *p.InputTokens != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorUsage) Field2DeepEqual(src *int64) bool {
+
+	if p.OutputTokens == src {
+		return true
+	} else if p.OutputTokens == nil || src == nil {
+		return false
+	}
+	if *p.OutputTokens != *src {
+		return false
+	}
+	return true
+}
+
+type EvaluatorRunError struct {
+	Code    *int32  `thrift:"code,1,optional" frugal:"1,optional,i32" form:"code" json:"code,omitempty" query:"code"`
+	Message *string `thrift:"message,2,optional" frugal:"2,optional,string" form:"message" json:"message,omitempty" query:"message"`
+}
+
+func NewEvaluatorRunError() *EvaluatorRunError {
+	return &EvaluatorRunError{}
+}
+
+func (p *EvaluatorRunError) InitDefault() {
+}
+
+var EvaluatorRunError_Code_DEFAULT int32
+
+func (p *EvaluatorRunError) GetCode() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCode() {
+		return EvaluatorRunError_Code_DEFAULT
+	}
+	return *p.Code
+}
+
+var EvaluatorRunError_Message_DEFAULT string
+
+func (p *EvaluatorRunError) GetMessage() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMessage() {
+		return EvaluatorRunError_Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *EvaluatorRunError) SetCode(val *int32) {
+	p.Code = val
+}
+func (p *EvaluatorRunError) SetMessage(val *string) {
+	p.Message = val
+}
+
+var fieldIDToName_EvaluatorRunError = map[int16]string{
+	1: "code",
+	2: "message",
+}
+
+func (p *EvaluatorRunError) IsSetCode() bool {
+	return p.Code != nil
+}
+
+func (p *EvaluatorRunError) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *EvaluatorRunError) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorRunError[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorRunError) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *EvaluatorRunError) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Message = _field
+	return nil
+}
+
+func (p *EvaluatorRunError) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorRunError"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorRunError) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCode() {
+		if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Code); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorRunError) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMessage() {
+		if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Message); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *EvaluatorRunError) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorRunError(%+v)", *p)
+
+}
+
+func (p *EvaluatorRunError) DeepEqual(ano *EvaluatorRunError) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Code) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Message) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorRunError) Field1DeepEqual(src *int32) bool {
+
+	if p.Code == src {
+		return true
+	} else if p.Code == nil || src == nil {
+		return false
+	}
+	if *p.Code != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorRunError) Field2DeepEqual(src *string) bool {
+
+	if p.Message == src {
+		return true
+	} else if p.Message == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Message, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type EvaluatorExtraOutputContent struct {
+	OutputType *EvaluatorExtraOutputType `thrift:"output_type,1,optional" frugal:"1,optional,string" form:"output_type" json:"output_type,omitempty" query:"output_type"`
+	URI        *string                   `thrift:"uri,2,optional" frugal:"2,optional,string" form:"uri" json:"uri,omitempty" query:"uri"`
+	URL        *string                   `thrift:"url,3,optional" frugal:"3,optional,string" form:"url" json:"url,omitempty" query:"url"`
+}
+
+func NewEvaluatorExtraOutputContent() *EvaluatorExtraOutputContent {
+	return &EvaluatorExtraOutputContent{}
+}
+
+func (p *EvaluatorExtraOutputContent) InitDefault() {
+}
+
+var EvaluatorExtraOutputContent_OutputType_DEFAULT EvaluatorExtraOutputType
+
+func (p *EvaluatorExtraOutputContent) GetOutputType() (v EvaluatorExtraOutputType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOutputType() {
+		return EvaluatorExtraOutputContent_OutputType_DEFAULT
+	}
+	return *p.OutputType
+}
+
+var EvaluatorExtraOutputContent_URI_DEFAULT string
+
+func (p *EvaluatorExtraOutputContent) GetURI() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetURI() {
+		return EvaluatorExtraOutputContent_URI_DEFAULT
+	}
+	return *p.URI
+}
+
+var EvaluatorExtraOutputContent_URL_DEFAULT string
+
+func (p *EvaluatorExtraOutputContent) GetURL() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetURL() {
+		return EvaluatorExtraOutputContent_URL_DEFAULT
+	}
+	return *p.URL
+}
+func (p *EvaluatorExtraOutputContent) SetOutputType(val *EvaluatorExtraOutputType) {
+	p.OutputType = val
+}
+func (p *EvaluatorExtraOutputContent) SetURI(val *string) {
+	p.URI = val
+}
+func (p *EvaluatorExtraOutputContent) SetURL(val *string) {
+	p.URL = val
+}
+
+var fieldIDToName_EvaluatorExtraOutputContent = map[int16]string{
+	1: "output_type",
+	2: "uri",
+	3: "url",
+}
+
+func (p *EvaluatorExtraOutputContent) IsSetOutputType() bool {
+	return p.OutputType != nil
+}
+
+func (p *EvaluatorExtraOutputContent) IsSetURI() bool {
+	return p.URI != nil
+}
+
+func (p *EvaluatorExtraOutputContent) IsSetURL() bool {
+	return p.URL != nil
+}
+
+func (p *EvaluatorExtraOutputContent) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorExtraOutputContent[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorExtraOutputContent) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *EvaluatorExtraOutputType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.OutputType = _field
+	return nil
+}
+func (p *EvaluatorExtraOutputContent) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.URI = _field
+	return nil
+}
+func (p *EvaluatorExtraOutputContent) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.URL = _field
+	return nil
+}
+
+func (p *EvaluatorExtraOutputContent) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorExtraOutputContent"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorExtraOutputContent) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputType() {
+		if err = oprot.WriteFieldBegin("output_type", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.OutputType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorExtraOutputContent) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetURI() {
+		if err = oprot.WriteFieldBegin("uri", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.URI); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluatorExtraOutputContent) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetURL() {
+		if err = oprot.WriteFieldBegin("url", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.URL); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *EvaluatorExtraOutputContent) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorExtraOutputContent(%+v)", *p)
+
+}
+
+func (p *EvaluatorExtraOutputContent) DeepEqual(ano *EvaluatorExtraOutputContent) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.OutputType) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.URI) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.URL) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorExtraOutputContent) Field1DeepEqual(src *EvaluatorExtraOutputType) bool {
+
+	if p.OutputType == src {
+		return true
+	} else if p.OutputType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.OutputType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorExtraOutputContent) Field2DeepEqual(src *string) bool {
+
+	if p.URI == src {
+		return true
+	} else if p.URI == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.URI, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorExtraOutputContent) Field3DeepEqual(src *string) bool {
+
+	if p.URL == src {
+		return true
+	} else if p.URL == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.URL, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type EvaluatorInputData struct {
+	HistoryMessages            []*common.Message          `thrift:"history_messages,1,optional" frugal:"1,optional,list<common.Message>" form:"history_messages" json:"history_messages,omitempty" query:"history_messages"`
+	InputFields                map[string]*common.Content `thrift:"input_fields,2,optional" frugal:"2,optional,map<string:common.Content>" form:"input_fields" json:"input_fields,omitempty" query:"input_fields"`
+	EvaluateDatasetFields      map[string]*common.Content `thrift:"evaluate_dataset_fields,3,optional" frugal:"3,optional,map<string:common.Content>" form:"evaluate_dataset_fields" json:"evaluate_dataset_fields,omitempty" query:"evaluate_dataset_fields"`
+	EvaluateTargetOutputFields map[string]*common.Content `thrift:"evaluate_target_output_fields,4,optional" frugal:"4,optional,map<string:common.Content>" form:"evaluate_target_output_fields" json:"evaluate_target_output_fields,omitempty" query:"evaluate_target_output_fields"`
+	Ext                        map[string]string          `thrift:"ext,100,optional" frugal:"100,optional,map<string:string>" form:"ext" json:"ext,omitempty" query:"ext"`
+}
+
+func NewEvaluatorInputData() *EvaluatorInputData {
+	return &EvaluatorInputData{}
+}
+
+func (p *EvaluatorInputData) InitDefault() {
+}
+
+var EvaluatorInputData_HistoryMessages_DEFAULT []*common.Message
+
+func (p *EvaluatorInputData) GetHistoryMessages() (v []*common.Message) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetHistoryMessages() {
+		return EvaluatorInputData_HistoryMessages_DEFAULT
+	}
+	return p.HistoryMessages
+}
+
+var EvaluatorInputData_InputFields_DEFAULT map[string]*common.Content
+
+func (p *EvaluatorInputData) GetInputFields() (v map[string]*common.Content) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetInputFields() {
+		return EvaluatorInputData_InputFields_DEFAULT
+	}
+	return p.InputFields
+}
+
+var EvaluatorInputData_EvaluateDatasetFields_DEFAULT map[string]*common.Content
+
+func (p *EvaluatorInputData) GetEvaluateDatasetFields() (v map[string]*common.Content) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluateDatasetFields() {
+		return EvaluatorInputData_EvaluateDatasetFields_DEFAULT
+	}
+	return p.EvaluateDatasetFields
+}
+
+var EvaluatorInputData_EvaluateTargetOutputFields_DEFAULT map[string]*common.Content
+
+func (p *EvaluatorInputData) GetEvaluateTargetOutputFields() (v map[string]*common.Content) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluateTargetOutputFields() {
+		return EvaluatorInputData_EvaluateTargetOutputFields_DEFAULT
+	}
+	return p.EvaluateTargetOutputFields
+}
+
+var EvaluatorInputData_Ext_DEFAULT map[string]string
+
+func (p *EvaluatorInputData) GetExt() (v map[string]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExt() {
+		return EvaluatorInputData_Ext_DEFAULT
+	}
+	return p.Ext
+}
+func (p *EvaluatorInputData) SetHistoryMessages(val []*common.Message) {
+	p.HistoryMessages = val
+}
+func (p *EvaluatorInputData) SetInputFields(val map[string]*common.Content) {
+	p.InputFields = val
+}
+func (p *EvaluatorInputData) SetEvaluateDatasetFields(val map[string]*common.Content) {
+	p.EvaluateDatasetFields = val
+}
+func (p *EvaluatorInputData) SetEvaluateTargetOutputFields(val map[string]*common.Content) {
+	p.EvaluateTargetOutputFields = val
+}
+func (p *EvaluatorInputData) SetExt(val map[string]string) {
+	p.Ext = val
+}
+
+var fieldIDToName_EvaluatorInputData = map[int16]string{
+	1:   "history_messages",
+	2:   "input_fields",
+	3:   "evaluate_dataset_fields",
+	4:   "evaluate_target_output_fields",
+	100: "ext",
+}
+
+func (p *EvaluatorInputData) IsSetHistoryMessages() bool {
+	return p.HistoryMessages != nil
+}
+
+func (p *EvaluatorInputData) IsSetInputFields() bool {
+	return p.InputFields != nil
+}
+
+func (p *EvaluatorInputData) IsSetEvaluateDatasetFields() bool {
+	return p.EvaluateDatasetFields != nil
+}
+
+func (p *EvaluatorInputData) IsSetEvaluateTargetOutputFields() bool {
+	return p.EvaluateTargetOutputFields != nil
+}
+
+func (p *EvaluatorInputData) IsSetExt() bool {
+	return p.Ext != nil
+}
+
+func (p *EvaluatorInputData) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 100:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField100(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorInputData[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorInputData) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*common.Message, 0, size)
+	values := make([]common.Message, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.HistoryMessages = _field
+	return nil
+}
+func (p *EvaluatorInputData) ReadField2(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]*common.Content, size)
+	values := make([]common.Content, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
+			return err
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.InputFields = _field
+	return nil
+}
+func (p *EvaluatorInputData) ReadField3(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]*common.Content, size)
+	values := make([]common.Content, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
+			return err
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.EvaluateDatasetFields = _field
+	return nil
+}
+func (p *EvaluatorInputData) ReadField4(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]*common.Content, size)
+	values := make([]common.Content, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
+			return err
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.EvaluateTargetOutputFields = _field
+	return nil
+}
+func (p *EvaluatorInputData) ReadField100(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Ext = _field
+	return nil
+}
+
+func (p *EvaluatorInputData) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorInputData"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField100(oprot); err != nil {
+			fieldId = 100
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorInputData) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHistoryMessages() {
+		if err = oprot.WriteFieldBegin("history_messages", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.HistoryMessages)); err != nil {
+			return err
+		}
+		for _, v := range p.HistoryMessages {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorInputData) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetInputFields() {
+		if err = oprot.WriteFieldBegin("input_fields", thrift.MAP, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.InputFields)); err != nil {
+			return err
+		}
+		for k, v := range p.InputFields {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluatorInputData) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluateDatasetFields() {
+		if err = oprot.WriteFieldBegin("evaluate_dataset_fields", thrift.MAP, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.EvaluateDatasetFields)); err != nil {
+			return err
+		}
+		for k, v := range p.EvaluateDatasetFields {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluatorInputData) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluateTargetOutputFields() {
+		if err = oprot.WriteFieldBegin("evaluate_target_output_fields", thrift.MAP, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.EvaluateTargetOutputFields)); err != nil {
+			return err
+		}
+		for k, v := range p.EvaluateTargetOutputFields {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluatorInputData) writeField100(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExt() {
+		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 100); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Ext)); err != nil {
+			return err
+		}
+		for k, v := range p.Ext {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
+}
+
+func (p *EvaluatorInputData) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorInputData(%+v)", *p)
+
+}
+
+func (p *EvaluatorInputData) DeepEqual(ano *EvaluatorInputData) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.HistoryMessages) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.InputFields) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.EvaluateDatasetFields) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.EvaluateTargetOutputFields) {
+		return false
+	}
+	if !p.Field100DeepEqual(ano.Ext) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorInputData) Field1DeepEqual(src []*common.Message) bool {
+
+	if len(p.HistoryMessages) != len(src) {
+		return false
+	}
+	for i, v := range p.HistoryMessages {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluatorInputData) Field2DeepEqual(src map[string]*common.Content) bool {
+
+	if len(p.InputFields) != len(src) {
+		return false
+	}
+	for k, v := range p.InputFields {
+		_src := src[k]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluatorInputData) Field3DeepEqual(src map[string]*common.Content) bool {
+
+	if len(p.EvaluateDatasetFields) != len(src) {
+		return false
+	}
+	for k, v := range p.EvaluateDatasetFields {
+		_src := src[k]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluatorInputData) Field4DeepEqual(src map[string]*common.Content) bool {
+
+	if len(p.EvaluateTargetOutputFields) != len(src) {
+		return false
+	}
+	for k, v := range p.EvaluateTargetOutputFields {
+		_src := src[k]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluatorInputData) Field100DeepEqual(src map[string]string) bool {
+
+	if len(p.Ext) != len(src) {
+		return false
+	}
+	for k, v := range p.Ext {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+type EvaluatorHTTPInfo struct {
+	Method *EvaluatorHTTPMethod `thrift:"method,1,optional" frugal:"1,optional,string" form:"method" json:"method,omitempty" query:"method"`
+	Path   *string              `thrift:"path,2,optional" frugal:"2,optional,string" form:"path" json:"path,omitempty" query:"path"`
+}
+
+func NewEvaluatorHTTPInfo() *EvaluatorHTTPInfo {
+	return &EvaluatorHTTPInfo{}
+}
+
+func (p *EvaluatorHTTPInfo) InitDefault() {
+}
+
+var EvaluatorHTTPInfo_Method_DEFAULT EvaluatorHTTPMethod
+
+func (p *EvaluatorHTTPInfo) GetMethod() (v EvaluatorHTTPMethod) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMethod() {
+		return EvaluatorHTTPInfo_Method_DEFAULT
+	}
+	return *p.Method
+}
+
+var EvaluatorHTTPInfo_Path_DEFAULT string
+
+func (p *EvaluatorHTTPInfo) GetPath() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPath() {
+		return EvaluatorHTTPInfo_Path_DEFAULT
+	}
+	return *p.Path
+}
+func (p *EvaluatorHTTPInfo) SetMethod(val *EvaluatorHTTPMethod) {
+	p.Method = val
+}
+func (p *EvaluatorHTTPInfo) SetPath(val *string) {
+	p.Path = val
+}
+
+var fieldIDToName_EvaluatorHTTPInfo = map[int16]string{
+	1: "method",
+	2: "path",
+}
+
+func (p *EvaluatorHTTPInfo) IsSetMethod() bool {
+	return p.Method != nil
+}
+
+func (p *EvaluatorHTTPInfo) IsSetPath() bool {
+	return p.Path != nil
+}
+
+func (p *EvaluatorHTTPInfo) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorHTTPInfo[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorHTTPInfo) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *EvaluatorHTTPMethod
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Method = _field
+	return nil
+}
+func (p *EvaluatorHTTPInfo) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Path = _field
+	return nil
+}
+
+func (p *EvaluatorHTTPInfo) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorHTTPInfo"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorHTTPInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMethod() {
+		if err = oprot.WriteFieldBegin("method", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Method); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorHTTPInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPath() {
+		if err = oprot.WriteFieldBegin("path", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Path); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *EvaluatorHTTPInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorHTTPInfo(%+v)", *p)
+
+}
+
+func (p *EvaluatorHTTPInfo) DeepEqual(ano *EvaluatorHTTPInfo) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Method) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Path) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorHTTPInfo) Field1DeepEqual(src *EvaluatorHTTPMethod) bool {
+
+	if p.Method == src {
+		return true
+	} else if p.Method == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Method, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorHTTPInfo) Field2DeepEqual(src *string) bool {
+
+	if p.Path == src {
+		return true
+	} else if p.Path == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Path, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type EvaluatorRunConfig struct {
+	Env                   *string              `thrift:"env,1,optional" frugal:"1,optional,string" form:"env" json:"env,omitempty" query:"env"`
+	EvaluatorRuntimeParam *common.RuntimeParam `thrift:"evaluator_runtime_param,2,optional" frugal:"2,optional,common.RuntimeParam" form:"evaluator_runtime_param" json:"evaluator_runtime_param,omitempty" query:"evaluator_runtime_param"`
+}
+
+func NewEvaluatorRunConfig() *EvaluatorRunConfig {
+	return &EvaluatorRunConfig{}
+}
+
+func (p *EvaluatorRunConfig) InitDefault() {
+}
+
+var EvaluatorRunConfig_Env_DEFAULT string
+
+func (p *EvaluatorRunConfig) GetEnv() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEnv() {
+		return EvaluatorRunConfig_Env_DEFAULT
+	}
+	return *p.Env
+}
+
+var EvaluatorRunConfig_EvaluatorRuntimeParam_DEFAULT *common.RuntimeParam
+
+func (p *EvaluatorRunConfig) GetEvaluatorRuntimeParam() (v *common.RuntimeParam) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorRuntimeParam() {
+		return EvaluatorRunConfig_EvaluatorRuntimeParam_DEFAULT
+	}
+	return p.EvaluatorRuntimeParam
+}
+func (p *EvaluatorRunConfig) SetEnv(val *string) {
+	p.Env = val
+}
+func (p *EvaluatorRunConfig) SetEvaluatorRuntimeParam(val *common.RuntimeParam) {
+	p.EvaluatorRuntimeParam = val
+}
+
+var fieldIDToName_EvaluatorRunConfig = map[int16]string{
+	1: "env",
+	2: "evaluator_runtime_param",
+}
+
+func (p *EvaluatorRunConfig) IsSetEnv() bool {
+	return p.Env != nil
+}
+
+func (p *EvaluatorRunConfig) IsSetEvaluatorRuntimeParam() bool {
+	return p.EvaluatorRuntimeParam != nil
+}
+
+func (p *EvaluatorRunConfig) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorRunConfig[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorRunConfig) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Env = _field
+	return nil
+}
+func (p *EvaluatorRunConfig) ReadField2(iprot thrift.TProtocol) error {
+	_field := common.NewRuntimeParam()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.EvaluatorRuntimeParam = _field
+	return nil
+}
+
+func (p *EvaluatorRunConfig) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorRunConfig"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorRunConfig) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnv() {
+		if err = oprot.WriteFieldBegin("env", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Env); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorRunConfig) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorRuntimeParam() {
+		if err = oprot.WriteFieldBegin("evaluator_runtime_param", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.EvaluatorRuntimeParam.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *EvaluatorRunConfig) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorRunConfig(%+v)", *p)
+
+}
+
+func (p *EvaluatorRunConfig) DeepEqual(ano *EvaluatorRunConfig) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Env) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.EvaluatorRuntimeParam) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorRunConfig) Field1DeepEqual(src *string) bool {
+
+	if p.Env == src {
+		return true
+	} else if p.Env == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Env, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorRunConfig) Field2DeepEqual(src *common.RuntimeParam) bool {
+
+	if !p.EvaluatorRuntimeParam.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type EvaluatorProgressMessage struct {
+	// 如 system, assistant
+	Role *string `thrift:"role,1,optional" frugal:"1,optional,string" form:"role" json:"role,omitempty" query:"role"`
+	// 如 tool_use, tool_result
+	Type *string `thrift:"type,2,optional" frugal:"2,optional,string" form:"type" json:"type,omitempty" query:"type"`
+	// 如 Check current user identity and working directory
+	Message     *string `thrift:"message,3,optional" frugal:"3,optional,string" form:"message" json:"message,omitempty" query:"message"`
+	CreatedAtMs *int64  `thrift:"created_at_ms,4,optional" frugal:"4,optional,i64" form:"created_at_ms" json:"created_at_ms,omitempty" query:"created_at_ms"`
+}
+
+func NewEvaluatorProgressMessage() *EvaluatorProgressMessage {
+	return &EvaluatorProgressMessage{}
+}
+
+func (p *EvaluatorProgressMessage) InitDefault() {
+}
+
+var EvaluatorProgressMessage_Role_DEFAULT string
+
+func (p *EvaluatorProgressMessage) GetRole() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRole() {
+		return EvaluatorProgressMessage_Role_DEFAULT
+	}
+	return *p.Role
+}
+
+var EvaluatorProgressMessage_Type_DEFAULT string
+
+func (p *EvaluatorProgressMessage) GetType() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetType() {
+		return EvaluatorProgressMessage_Type_DEFAULT
+	}
+	return *p.Type
+}
+
+var EvaluatorProgressMessage_Message_DEFAULT string
+
+func (p *EvaluatorProgressMessage) GetMessage() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMessage() {
+		return EvaluatorProgressMessage_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var EvaluatorProgressMessage_CreatedAtMs_DEFAULT int64
+
+func (p *EvaluatorProgressMessage) GetCreatedAtMs() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCreatedAtMs() {
+		return EvaluatorProgressMessage_CreatedAtMs_DEFAULT
+	}
+	return *p.CreatedAtMs
+}
+func (p *EvaluatorProgressMessage) SetRole(val *string) {
+	p.Role = val
+}
+func (p *EvaluatorProgressMessage) SetType(val *string) {
+	p.Type = val
+}
+func (p *EvaluatorProgressMessage) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *EvaluatorProgressMessage) SetCreatedAtMs(val *int64) {
+	p.CreatedAtMs = val
+}
+
+var fieldIDToName_EvaluatorProgressMessage = map[int16]string{
+	1: "role",
+	2: "type",
+	3: "message",
+	4: "created_at_ms",
+}
+
+func (p *EvaluatorProgressMessage) IsSetRole() bool {
+	return p.Role != nil
+}
+
+func (p *EvaluatorProgressMessage) IsSetType() bool {
+	return p.Type != nil
+}
+
+func (p *EvaluatorProgressMessage) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *EvaluatorProgressMessage) IsSetCreatedAtMs() bool {
+	return p.CreatedAtMs != nil
+}
+
+func (p *EvaluatorProgressMessage) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorProgressMessage[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorProgressMessage) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Role = _field
+	return nil
+}
+func (p *EvaluatorProgressMessage) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Type = _field
+	return nil
+}
+func (p *EvaluatorProgressMessage) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Message = _field
+	return nil
+}
+func (p *EvaluatorProgressMessage) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CreatedAtMs = _field
+	return nil
+}
+
+func (p *EvaluatorProgressMessage) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorProgressMessage"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorProgressMessage) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRole() {
+		if err = oprot.WriteFieldBegin("role", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Role); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorProgressMessage) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetType() {
+		if err = oprot.WriteFieldBegin("type", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Type); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluatorProgressMessage) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMessage() {
+		if err = oprot.WriteFieldBegin("message", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Message); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluatorProgressMessage) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCreatedAtMs() {
+		if err = oprot.WriteFieldBegin("created_at_ms", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.CreatedAtMs); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *EvaluatorProgressMessage) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorProgressMessage(%+v)", *p)
+
+}
+
+func (p *EvaluatorProgressMessage) DeepEqual(ano *EvaluatorProgressMessage) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Role) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Type) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Message) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.CreatedAtMs) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorProgressMessage) Field1DeepEqual(src *string) bool {
+
+	if p.Role == src {
+		return true
+	} else if p.Role == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Role, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorProgressMessage) Field2DeepEqual(src *string) bool {
+
+	if p.Type == src {
+		return true
+	} else if p.Type == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Type, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorProgressMessage) Field3DeepEqual(src *string) bool {
+
+	if p.Message == src {
+		return true
+	} else if p.Message == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Message, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorProgressMessage) Field4DeepEqual(src *int64) bool {
+
+	if p.CreatedAtMs == src {
+		return true
+	} else if p.CreatedAtMs == nil || src == nil {
+		return false
+	}
+	if *p.CreatedAtMs != *src {
+		return false
+	}
+	return true
+}
+
+// 字段映射, field_name 为评估器侧字段, from_field 为评测集字段
+type CalibrationFieldConf struct {
+	FieldName *string `thrift:"field_name,1,optional" frugal:"1,optional,string" form:"field_name" json:"field_name,omitempty" query:"field_name"`
+	FromField *string `thrift:"from_field,2,optional" frugal:"2,optional,string" form:"from_field" json:"from_field,omitempty" query:"from_field"`
+}
+
+func NewCalibrationFieldConf() *CalibrationFieldConf {
+	return &CalibrationFieldConf{}
+}
+
+func (p *CalibrationFieldConf) InitDefault() {
+}
+
+var CalibrationFieldConf_FieldName_DEFAULT string
+
+func (p *CalibrationFieldConf) GetFieldName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFieldName() {
+		return CalibrationFieldConf_FieldName_DEFAULT
+	}
+	return *p.FieldName
+}
+
+var CalibrationFieldConf_FromField_DEFAULT string
+
+func (p *CalibrationFieldConf) GetFromField() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFromField() {
+		return CalibrationFieldConf_FromField_DEFAULT
+	}
+	return *p.FromField
+}
+func (p *CalibrationFieldConf) SetFieldName(val *string) {
+	p.FieldName = val
+}
+func (p *CalibrationFieldConf) SetFromField(val *string) {
+	p.FromField = val
+}
+
+var fieldIDToName_CalibrationFieldConf = map[int16]string{
+	1: "field_name",
+	2: "from_field",
+}
+
+func (p *CalibrationFieldConf) IsSetFieldName() bool {
+	return p.FieldName != nil
+}
+
+func (p *CalibrationFieldConf) IsSetFromField() bool {
+	return p.FromField != nil
+}
+
+func (p *CalibrationFieldConf) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CalibrationFieldConf[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CalibrationFieldConf) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FieldName = _field
+	return nil
+}
+func (p *CalibrationFieldConf) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FromField = _field
+	return nil
+}
+
+func (p *CalibrationFieldConf) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CalibrationFieldConf"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CalibrationFieldConf) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldName() {
+		if err = oprot.WriteFieldBegin("field_name", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.FieldName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CalibrationFieldConf) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFromField() {
+		if err = oprot.WriteFieldBegin("from_field", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.FromField); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CalibrationFieldConf) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CalibrationFieldConf(%+v)", *p)
+
+}
+
+func (p *CalibrationFieldConf) DeepEqual(ano *CalibrationFieldConf) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.FieldName) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.FromField) {
+		return false
+	}
+	return true
+}
+
+func (p *CalibrationFieldConf) Field1DeepEqual(src *string) bool {
+
+	if p.FieldName == src {
+		return true
+	} else if p.FieldName == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.FieldName, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CalibrationFieldConf) Field2DeepEqual(src *string) bool {
+
+	if p.FromField == src {
+		return true
+	} else if p.FromField == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.FromField, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type EvaluatorCalibrationConf struct {
+	// 评测集中人工真值分数所在字段名, 内容需可解析为数值
+	GroundTruthField *string `thrift:"ground_truth_field,1,optional" frugal:"1,optional,string" form:"ground_truth_field" json:"ground_truth_field,omitempty" query:"ground_truth_field"`
+	// 为空时按同名透传除真值字段外的全部字段
+	FieldConfs []*CalibrationFieldConf `thrift:"field_confs,2,optional" frugal:"2,optional,list<CalibrationFieldConf>" form:"field_confs" json:"field_confs,omitempty" query:"field_confs"`
+	// 作为评测对象输出提供给评估器的评测集字段
+	TargetOutputFieldConfs []*CalibrationFieldConf `thrift:"target_output_field_confs,3,optional" frugal:"3,optional,list<CalibrationFieldConf>" form:"target_output_field_confs" json:"target_output_field_confs,omitempty" query:"target_output_field_confs"`
+	// 结果中保留的最不一致样本数, 默认 20
+	WorstItemCnt *int32 `thrift:"worst_item_cnt,4,optional" frugal:"4,optional,i32" form:"worst_item_cnt" json:"worst_item_cnt,omitempty" query:"worst_item_cnt"`
+	// 评估器并发数, 默认 5
+	Concurrency *int32 `thrift:"concurrency,5,optional" frugal:"5,optional,i32" form:"concurrency" json:"concurrency,omitempty" query:"concurrency"`
+	// 评估器运行失败样本占比超过该值时校准置为失败, 默认 0.5
+	MaxEvaluatorFailRate *float64 `thrift:"max_evaluator_fail_rate,6,optional" frugal:"6,optional,double" form:"max_evaluator_fail_rate" json:"max_evaluator_fail_rate,omitempty" query:"max_evaluator_fail_rate"`
+}
+
+func NewEvaluatorCalibrationConf() *EvaluatorCalibrationConf {
+	return &EvaluatorCalibrationConf{}
+}
+
+func (p *EvaluatorCalibrationConf) InitDefault() {
+}
+
+var EvaluatorCalibrationConf_GroundTruthField_DEFAULT string
+
+func (p *EvaluatorCalibrationConf) GetGroundTruthField() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGroundTruthField() {
+		return EvaluatorCalibrationConf_GroundTruthField_DEFAULT
+	}
+	return *p.GroundTruthField
+}
+
+var EvaluatorCalibrationConf_FieldConfs_DEFAULT []*CalibrationFieldConf
+
+func (p *EvaluatorCalibrationConf) GetFieldConfs() (v []*CalibrationFieldConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFieldConfs() {
+		return EvaluatorCalibrationConf_FieldConfs_DEFAULT
+	}
+	return p.FieldConfs
+}
+
+var EvaluatorCalibrationConf_TargetOutputFieldConfs_DEFAULT []*CalibrationFieldConf
+
+func (p *EvaluatorCalibrationConf) GetTargetOutputFieldConfs() (v []*CalibrationFieldConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetOutputFieldConfs() {
+		return EvaluatorCalibrationConf_TargetOutputFieldConfs_DEFAULT
+	}
+	return p.TargetOutputFieldConfs
+}
+
+var EvaluatorCalibrationConf_WorstItemCnt_DEFAULT int32
+
+func (p *EvaluatorCalibrationConf) GetWorstItemCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorstItemCnt() {
+		return EvaluatorCalibrationConf_WorstItemCnt_DEFAULT
+	}
+	return *p.WorstItemCnt
+}
+
+var EvaluatorCalibrationConf_Concurrency_DEFAULT int32
+
+func (p *EvaluatorCalibrationConf) GetConcurrency() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetConcurrency() {
+		return EvaluatorCalibrationConf_Concurrency_DEFAULT
+	}
+	return *p.Concurrency
+}
+
+var EvaluatorCalibrationConf_MaxEvaluatorFailRate_DEFAULT float64
+
+func (p *EvaluatorCalibrationConf) GetMaxEvaluatorFailRate() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMaxEvaluatorFailRate() {
+		return EvaluatorCalibrationConf_MaxEvaluatorFailRate_DEFAULT
+	}
+	return *p.MaxEvaluatorFailRate
+}
+func (p *EvaluatorCalibrationConf) SetGroundTruthField(val *string) {
+	p.GroundTruthField = val
+}
+func (p *EvaluatorCalibrationConf) SetFieldConfs(val []*CalibrationFieldConf) {
+	p.FieldConfs = val
+}
+func (p *EvaluatorCalibrationConf) SetTargetOutputFieldConfs(val []*CalibrationFieldConf) {
+	p.TargetOutputFieldConfs = val
+}
+func (p *EvaluatorCalibrationConf) SetWorstItemCnt(val *int32) {
+	p.WorstItemCnt = val
+}
+func (p *EvaluatorCalibrationConf) SetConcurrency(val *int32) {
+	p.Concurrency = val
+}
+func (p *EvaluatorCalibrationConf) SetMaxEvaluatorFailRate(val *float64) {
+	p.MaxEvaluatorFailRate = val
+}
+
+var fieldIDToName_EvaluatorCalibrationConf = map[int16]string{
+	1: "ground_truth_field",
+	2: "field_confs",
+	3: "target_output_field_confs",
+	4: "worst_item_cnt",
+	5: "concurrency",
+	6: "max_evaluator_fail_rate",
+}
+
+func (p *EvaluatorCalibrationConf) IsSetGroundTruthField() bool {
+	return p.GroundTruthField != nil
+}
+
+func (p *EvaluatorCalibrationConf) IsSetFieldConfs() bool {
+	return p.FieldConfs != nil
+}
+
+func (p *EvaluatorCalibrationConf) IsSetTargetOutputFieldConfs() bool {
+	return p.TargetOutputFieldConfs != nil
+}
+
+func (p *EvaluatorCalibrationConf) IsSetWorstItemCnt() bool {
+	return p.WorstItemCnt != nil
+}
+
+func (p *EvaluatorCalibrationConf) IsSetConcurrency() bool {
+	return p.Concurrency != nil
+}
+
+func (p *EvaluatorCalibrationConf) IsSetMaxEvaluatorFailRate() bool {
+	return p.MaxEvaluatorFailRate != nil
+}
+
+func (p *EvaluatorCalibrationConf) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorCalibrationConf[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorCalibrationConf) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.GroundTruthField = _field
+	return nil
+}
+func (p *EvaluatorCalibrationConf) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*CalibrationFieldConf, 0, size)
+	values := make([]CalibrationFieldConf, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FieldConfs = _field
+	return nil
+}
+func (p *EvaluatorCalibrationConf) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*CalibrationFieldConf, 0, size)
+	values := make([]CalibrationFieldConf, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.TargetOutputFieldConfs = _field
+	return nil
+}
+func (p *EvaluatorCalibrationConf) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorstItemCnt = _field
+	return nil
+}
+func (p *EvaluatorCalibrationConf) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Concurrency = _field
+	return nil
+}
+func (p *EvaluatorCalibrationConf) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxEvaluatorFailRate = _field
+	return nil
+}
+
+func (p *EvaluatorCalibrationConf) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorCalibrationConf"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorCalibrationConf) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGroundTruthField() {
+		if err = oprot.WriteFieldBegin("ground_truth_field", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.GroundTruthField); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorCalibrationConf) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldConfs() {
+		if err = oprot.WriteFieldBegin("field_confs", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FieldConfs)); err != nil {
+			return err
+		}
+		for _, v := range p.FieldConfs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluatorCalibrationConf) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetOutputFieldConfs() {
+		if err = oprot.WriteFieldBegin("target_output_field_confs", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TargetOutputFieldConfs)); err != nil {
+			return err
+		}
+		for _, v := range p.TargetOutputFieldConfs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluatorCalibrationConf) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorstItemCnt() {
+		if err = oprot.WriteFieldBegin("worst_item_cnt", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.WorstItemCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluatorCalibrationConf) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConcurrency() {
+		if err = oprot.WriteFieldBegin("concurrency", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Concurrency); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvaluatorCalibrationConf) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxEvaluatorFailRate() {
+		if err = oprot.WriteFieldBegin("max_evaluator_fail_rate", thrift.DOUBLE, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MaxEvaluatorFailRate); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *EvaluatorCalibrationConf) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorCalibrationConf(%+v)", *p)
 
-func (p *EvaluatorRecord) GetItemID() (v int64) {
+}
+
+func (p *EvaluatorCalibrationConf) DeepEqual(ano *EvaluatorCalibrationConf) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.GroundTruthField) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.FieldConfs) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.TargetOutputFieldConfs) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.WorstItemCnt) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Concurrency) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.MaxEvaluatorFailRate) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorCalibrationConf) Field1DeepEqual(src *string) bool {
+
+	if p.GroundTruthField == src {
+		return true
+	} else if p.GroundTruthField == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.GroundTruthField, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCalibrationConf) Field2DeepEqual(src []*CalibrationFieldConf) bool {
+
+	if len(p.FieldConfs) != len(src) {
+		return false
+	}
+	for i, v := range p.FieldConfs {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluatorCalibrationConf) Field3DeepEqual(src []*CalibrationFieldConf) bool {
+
+	if len(p.TargetOutputFieldConfs) != len(src) {
+		return false
+	}
+	for i, v := range p.TargetOutputFieldConfs {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluatorCalibrationConf) Field4DeepEqual(src *int32) bool {
+
+	if p.WorstItemCnt == src {
+		return true
+	} else if p.WorstItemCnt == nil || src == nil {
+		return false
+	}
+	if *p.WorstItemCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCalibrationConf) Field5DeepEqual(src *int32) bool {
+
+	if p.Concurrency == src {
+		return true
+	} else if p.Concurrency == nil || src == nil {
+		return false
+	}
+	if *p.Concurrency != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCalibrationConf) Field6DeepEqual(src *float64) bool {
+
+	if p.MaxEvaluatorFailRate == src {
+		return true
+	} else if p.MaxEvaluatorFailRate == nil || src == nil {
+		return false
+	}
+	if *p.MaxEvaluatorFailRate != *src {
+		return false
+	}
+	return true
+}
+
+// counts[i][j] 为人工真值为 labels[i] 且评估器输出为 labels[j] 的样本数
+type CalibrationConfusionMatrix struct {
+	Labels   []string  `thrift:"labels,1,optional" frugal:"1,optional,list<string>" form:"labels" json:"labels,omitempty" query:"labels"`
+	Counts   [][]int64 `thrift:"counts,2,optional" frugal:"2,optional,list<list<i64>>" form:"counts" json:"counts,omitempty" query:"counts"`
+	Accuracy *float64  `thrift:"accuracy,3,optional" frugal:"3,optional,double" form:"accuracy" json:"accuracy,omitempty" query:"accuracy"`
+}
+
+func NewCalibrationConfusionMatrix() *CalibrationConfusionMatrix {
+	return &CalibrationConfusionMatrix{}
+}
+
+func (p *CalibrationConfusionMatrix) InitDefault() {
+}
+
+var CalibrationConfusionMatrix_Labels_DEFAULT []string
+
+func (p *CalibrationConfusionMatrix) GetLabels() (v []string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetItemID() {
-		return EvaluatorRecord_ItemID_DEFAULT
+	if !p.IsSetLabels() {
+		return CalibrationConfusionMatrix_Labels_DEFAULT
 	}
-	return *p.ItemID
+	return p.Labels
+}
+
+var CalibrationConfusionMatrix_Counts_DEFAULT [][]int64
+
+func (p *CalibrationConfusionMatrix) GetCounts() (v [][]int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCounts() {
+		return CalibrationConfusionMatrix_Counts_DEFAULT
+	}
+	return p.Counts
+}
+
+var CalibrationConfusionMatrix_Accuracy_DEFAULT float64
+
+func (p *CalibrationConfusionMatrix) GetAccuracy() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAccuracy() {
+		return CalibrationConfusionMatrix_Accuracy_DEFAULT
+	}
+	return *p.Accuracy
+}
+func (p *CalibrationConfusionMatrix) SetLabels(val []string) {
+	p.Labels = val
+}
+func (p *CalibrationConfusionMatrix) SetCounts(val [][]int64) {
+	p.Counts = val
+}
+func (p *CalibrationConfusionMatrix) SetAccuracy(val *float64) {
+	p.Accuracy = val
+}
+
+var fieldIDToName_CalibrationConfusionMatrix = map[int16]string{
+	1: "labels",
+	2: "counts",
+	3: "accuracy",
+}
+
+func (p *CalibrationConfusionMatrix) IsSetLabels() bool {
+	return p.Labels != nil
+}
+
+func (p *CalibrationConfusionMatrix) IsSetCounts() bool {
+	return p.Counts != nil
+}
+
+func (p *CalibrationConfusionMatrix) IsSetAccuracy() bool {
+	return p.Accuracy != nil
+}
+
+func (p *CalibrationConfusionMatrix) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CalibrationConfusionMatrix[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-var EvaluatorRecord_TurnID_DEFAULT int64
+func (p *CalibrationConfusionMatrix) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Labels = _field
+	return nil
+}
+func (p *CalibrationConfusionMatrix) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([][]int64, 0, size)
+	for i := 0; i < size; i++ {
+		_, size, err := iprot.ReadListBegin()
+		if err != nil {
+			return err
+		}
+		_elem := make([]int64, 0, size)
+		for i := 0; i < size; i++ {
+
+			var _elem1 int64
+			if v, err := iprot.ReadI64(); err != nil {
+				return err
+			} else {
+				_elem1 = v
+			}
+
+			_elem = append(_elem, _elem1)
+		}
+		if err := iprot.ReadListEnd(); err != nil {
+			return err
+		}
 
-func (p *EvaluatorRecord) GetTurnID() (v int64) {
-	if p == nil {
-		return
+		_field = append(_field, _elem)
 	}
-	if !p.IsSetTurnID() {
-		return EvaluatorRecord_TurnID_DEFAULT
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
 	}
-	return *p.TurnID
+	p.Counts = _field
+	return nil
 }
+func (p *CalibrationConfusionMatrix) ReadField3(iprot thrift.TProtocol) error {
 
-var EvaluatorRecord_EvaluatorVersionID_DEFAULT int64
-
-func (p *EvaluatorRecord) GetEvaluatorVersionID() (v int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetEvaluatorVersionID() {
-		return EvaluatorRecord_EvaluatorVersionID_DEFAULT
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	return *p.EvaluatorVersionID
+	p.Accuracy = _field
+	return nil
 }
 
-var EvaluatorRecord_TraceID_DEFAULT string
-
-func (p *EvaluatorRecord) GetTraceID() (v string) {
-	if p == nil {
-		return
+func (p *CalibrationConfusionMatrix) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CalibrationConfusionMatrix"); err != nil {
+		goto WriteStructBeginError
 	}
-	if !p.IsSetTraceID() {
-		return EvaluatorRecord_TraceID_DEFAULT
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
-	return *p.TraceID
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-var EvaluatorRecord_LogID_DEFAULT string
-
-func (p *EvaluatorRecord) GetLogID() (v string) {
-	if p == nil {
-		return
+func (p *CalibrationConfusionMatrix) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabels() {
+		if err = oprot.WriteFieldBegin("labels", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Labels)); err != nil {
+			return err
+		}
+		for _, v := range p.Labels {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if !p.IsSetLogID() {
-		return EvaluatorRecord_LogID_DEFAULT
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CalibrationConfusionMatrix) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCounts() {
+		if err = oprot.WriteFieldBegin("counts", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.LIST, len(p.Counts)); err != nil {
+			return err
+		}
+		for _, v := range p.Counts {
+			if err := oprot.WriteListBegin(thrift.I64, len(v)); err != nil {
+				return err
+			}
+			for _, v := range v {
+				if err := oprot.WriteI64(v); err != nil {
+					return err
+				}
+			}
+			if err := oprot.WriteListEnd(); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return *p.LogID
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *CalibrationConfusionMatrix) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAccuracy() {
+		if err = oprot.WriteFieldBegin("accuracy", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Accuracy); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-var EvaluatorRecord_EvaluatorInputData_DEFAULT *EvaluatorInputData
-
-func (p *EvaluatorRecord) GetEvaluatorInputData() (v *EvaluatorInputData) {
+func (p *CalibrationConfusionMatrix) String() string {
 	if p == nil {
-		return
-	}
-	if !p.IsSetEvaluatorInputData() {
-		return EvaluatorRecord_EvaluatorInputData_DEFAULT
+		return "<nil>"
 	}
-	return p.EvaluatorInputData
-}
+	return fmt.Sprintf("CalibrationConfusionMatrix(%+v)", *p)
 
-var EvaluatorRecord_EvaluatorOutputData_DEFAULT *EvaluatorOutputData
+}
 
-func (p *EvaluatorRecord) GetEvaluatorOutputData() (v *EvaluatorOutputData) {
-	if p == nil {
-		return
+func (p *CalibrationConfusionMatrix) DeepEqual(ano *CalibrationConfusionMatrix) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if !p.IsSetEvaluatorOutputData() {
-		return EvaluatorRecord_EvaluatorOutputData_DEFAULT
+	if !p.Field1DeepEqual(ano.Labels) {
+		return false
 	}
-	return p.EvaluatorOutputData
+	if !p.Field2DeepEqual(ano.Counts) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Accuracy) {
+		return false
+	}
+	return true
 }
 
-var EvaluatorRecord_Status_DEFAULT EvaluatorRunStatus
+func (p *CalibrationConfusionMatrix) Field1DeepEqual(src []string) bool {
 
-func (p *EvaluatorRecord) GetStatus() (v EvaluatorRunStatus) {
-	if p == nil {
-		return
+	if len(p.Labels) != len(src) {
+		return false
 	}
-	if !p.IsSetStatus() {
-		return EvaluatorRecord_Status_DEFAULT
+	for i, v := range p.Labels {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
 	}
-	return *p.Status
+	return true
 }
+func (p *CalibrationConfusionMatrix) Field2DeepEqual(src [][]int64) bool {
 
-var EvaluatorRecord_BaseInfo_DEFAULT *common.BaseInfo
-
-func (p *EvaluatorRecord) GetBaseInfo() (v *common.BaseInfo) {
-	if p == nil {
-		return
+	if len(p.Counts) != len(src) {
+		return false
 	}
-	if !p.IsSetBaseInfo() {
-		return EvaluatorRecord_BaseInfo_DEFAULT
+	for i, v := range p.Counts {
+		_src := src[i]
+		if len(v) != len(_src) {
+			return false
+		}
+		for i, v := range v {
+			_src1 := _src[i]
+			if v != _src1 {
+				return false
+			}
+		}
 	}
-	return p.BaseInfo
+	return true
 }
+func (p *CalibrationConfusionMatrix) Field3DeepEqual(src *float64) bool {
 
-var EvaluatorRecord_Ext_DEFAULT map[string]string
-
-func (p *EvaluatorRecord) GetExt() (v map[string]string) {
-	if p == nil {
-		return
+	if p.Accuracy == src {
+		return true
+	} else if p.Accuracy == nil || src == nil {
+		return false
 	}
-	if !p.IsSetExt() {
-		return EvaluatorRecord_Ext_DEFAULT
+	if *p.Accuracy != *src {
+		return false
 	}
-	return p.Ext
-}
-func (p *EvaluatorRecord) SetID(val *int64) {
-	p.ID = val
-}
-func (p *EvaluatorRecord) SetExperimentID(val *int64) {
-	p.ExperimentID = val
-}
-func (p *EvaluatorRecord) SetExperimentRunID(val *int64) {
-	p.ExperimentRunID = val
-}
-func (p *EvaluatorRecord) SetItemID(val *int64) {
-	p.ItemID = val
-}
-func (p *EvaluatorRecord) SetTurnID(val *int64) {
-	p.TurnID = val
-}
-func (p *EvaluatorRecord) SetEvaluatorVersionID(val *int64) {
-	p.EvaluatorVersionID = val
-}
-func (p *EvaluatorRecord) SetTraceID(val *string) {
-	p.TraceID = val
-}
-func (p *EvaluatorRecord) SetLogID(val *string) {
-	p.LogID = val
-}
-func (p *EvaluatorRecord) SetEvaluatorInputData(val *EvaluatorInputData) {
-	p.EvaluatorInputData = val
-}
-func (p *EvaluatorRecord) SetEvaluatorOutputData(val *EvaluatorOutputData) {
-	p.EvaluatorOutputData = val
-}
-func (p *EvaluatorRecord) SetStatus(val *EvaluatorRunStatus) {
-	p.Status = val
-}
-func (p *EvaluatorRecord) SetBaseInfo(val *common.BaseInfo) {
-	p.BaseInfo = val
+	return true
 }
-func (p *EvaluatorRecord) SetExt(val map[string]string) {
-	p.Ext = val
+
+type CalibrationItemResult_ struct {
+	ItemID         *int64   `thrift:"item_id,1,optional" frugal:"1,optional,i64" json:"item_id" form:"item_id" query:"item_id"`
+	TurnID         *int64   `thrift:"turn_id,2,optional" frugal:"2,optional,i64" json:"turn_id" form:"turn_id" query:"turn_id"`
+	GroundTruth    *float64 `thrift:"ground_truth,3,optional" frugal:"3,optional,double" form:"ground_truth" json:"ground_truth,omitempty" query:"ground_truth"`
+	EvaluatorScore *float64 `thrift:"evaluator_score,4,optional" frugal:"4,optional,double" form:"evaluator_score" json:"evaluator_score,omitempty" query:"evaluator_score"`
+	AbsError       *float64 `thrift:"abs_error,5,optional" frugal:"5,optional,double" form:"abs_error" json:"abs_error,omitempty" query:"abs_error"`
+	Reasoning      *string  `thrift:"reasoning,6,optional" frugal:"6,optional,string" form:"reasoning" json:"reasoning,omitempty" query:"reasoning"`
 }
 
-var fieldIDToName_EvaluatorRecord = map[int16]string{
-	1:  "id",
-	2:  "experiment_id",
-	3:  "experiment_run_id",
-	4:  "item_id",
-	5:  "turn_id",
-	6:  "evaluator_version_id",
-	7:  "trace_id",
-	8:  "log_id",
-	9:  "evaluator_input_data",
-	10: "evaluator_output_data",
-	11: "status",
-	12: "base_info",
-	20: "ext",
+func NewCalibrationItemResult_() *CalibrationItemResult_ {
+	return &CalibrationItemResult_{}
+}
+
+func (p *CalibrationItemResult_) InitDefault() {
 }
 
-func (p *EvaluatorRecord) IsSetID() bool {
-	return p.ID != nil
+var CalibrationItemResult__ItemID_DEFAULT int64
+
+func (p *CalibrationItemResult_) GetItemID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetItemID() {
+		return CalibrationItemResult__ItemID_DEFAULT
+	}
+	return *p.ItemID
 }
 
-func (p *EvaluatorRecord) IsSetExperimentID() bool {
-	return p.ExperimentID != nil
+var CalibrationItemResult__TurnID_DEFAULT int64
+
+func (p *CalibrationItemResult_) GetTurnID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTurnID() {
+		return CalibrationItemResult__TurnID_DEFAULT
+	}
+	return *p.TurnID
 }
 
-func (p *EvaluatorRecord) IsSetExperimentRunID() bool {
-	return p.ExperimentRunID != nil
+var CalibrationItemResult__GroundTruth_DEFAULT float64
+
+func (p *CalibrationItemResult_) GetGroundTruth() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGroundTruth() {
+		return CalibrationItemResult__GroundTruth_DEFAULT
+	}
+	return *p.GroundTruth
 }
 
-func (p *EvaluatorRecord) IsSetItemID() bool {
-	return p.ItemID != nil
+var CalibrationItemResult__EvaluatorScore_DEFAULT float64
+
+func (p *CalibrationItemResult_) GetEvaluatorScore() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorScore() {
+		return CalibrationItemResult__EvaluatorScore_DEFAULT
+	}
+	return *p.EvaluatorScore
 }
 
-func (p *EvaluatorRecord) IsSetTurnID() bool {
-	return p.TurnID != nil
+var CalibrationItemResult__AbsError_DEFAULT float64
+
+func (p *CalibrationItemResult_) GetAbsError() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAbsError() {
+		return CalibrationItemResult__AbsError_DEFAULT
+	}
+	return *p.AbsError
 }
 
-func (p *EvaluatorRecord) IsSetEvaluatorVersionID() bool {
-	return p.EvaluatorVersionID != nil
+var CalibrationItemResult__Reasoning_DEFAULT string
+
+func (p *CalibrationItemResult_) GetReasoning() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReasoning() {
+		return CalibrationItemResult__Reasoning_DEFAULT
+	}
+	return *p.Reasoning
+}
+func (p *CalibrationItemResult_) SetItemID(val *int64) {
+	p.ItemID = val
+}
+func (p *CalibrationItemResult_) SetTurnID(val *int64) {
+	p.TurnID = val
+}
+func (p *CalibrationItemResult_) SetGroundTruth(val *float64) {
+	p.GroundTruth = val
+}
+func (p *CalibrationItemResult_) SetEvaluatorScore(val *float64) {
+	p.EvaluatorScore = val
+}
+func (p *CalibrationItemResult_) SetAbsError(val *float64) {
+	p.AbsError = val
+}
+func (p *CalibrationItemResult_) SetReasoning(val *string) {
+	p.Reasoning = val
 }
 
-func (p *EvaluatorRecord) IsSetTraceID() bool {
-	return p.TraceID != nil
+var fieldIDToName_CalibrationItemResult_ = map[int16]string{
+	1: "item_id",
+	2: "turn_id",
+	3: "ground_truth",
+	4: "evaluator_score",
+	5: "abs_error",
+	6: "reasoning",
 }
 
-func (p *EvaluatorRecord) IsSetLogID() bool {
-	return p.LogID != nil
+func (p *CalibrationItemResult_) IsSetItemID() bool {
+	return p.ItemID != nil
 }
 
-func (p *EvaluatorRecord) IsSetEvaluatorInputData() bool {
-	return p.EvaluatorInputData != nil
+func (p *CalibrationItemResult_) IsSetTurnID() bool {
+	return p.TurnID != nil
 }
 
-func (p *EvaluatorRecord) IsSetEvaluatorOutputData() bool {
-	return p.EvaluatorOutputData != nil
+func (p *CalibrationItemResult_) IsSetGroundTruth() bool {
+	return p.GroundTruth != nil
 }
 
-func (p *EvaluatorRecord) IsSetStatus() bool {
-	return p.Status != nil
+func (p *CalibrationItemResult_) IsSetEvaluatorScore() bool {
+	return p.EvaluatorScore != nil
 }
 
-func (p *EvaluatorRecord) IsSetBaseInfo() bool {
-	return p.BaseInfo != nil
+func (p *CalibrationItemResult_) IsSetAbsError() bool {
+	return p.AbsError != nil
 }
 
-func (p *EvaluatorRecord) IsSetExt() bool {
-	return p.Ext != nil
+func (p *CalibrationItemResult_) IsSetReasoning() bool {
+	return p.Reasoning != nil
 }
 
-func (p *EvaluatorRecord) Read(iprot thrift.TProtocol) (err error) {
+func (p *CalibrationItemResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -9786,7 +17836,7 @@ func (p *EvaluatorRecord) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -9794,7 +17844,7 @@ func (p *EvaluatorRecord) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -9802,7 +17852,7 @@ func (p *EvaluatorRecord) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -9810,64 +17860,8 @@ func (p *EvaluatorRecord) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 6:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
 			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 11:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField11(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 12:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField12(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 20:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField20(iprot); err != nil {
+				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -9892,7 +17886,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorRecord[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CalibrationItemResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9902,29 +17896,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorRecord) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.ID = _field
-	return nil
-}
-func (p *EvaluatorRecord) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.ExperimentID = _field
-	return nil
-}
-func (p *EvaluatorRecord) ReadField3(iprot thrift.TProtocol) error {
+func (p *CalibrationItemResult_) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -9932,10 +17904,10 @@ func (p *EvaluatorRecord) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.ExperimentRunID = _field
+	p.ItemID = _field
 	return nil
 }
-func (p *EvaluatorRecord) ReadField4(iprot thrift.TProtocol) error {
+func (p *CalibrationItemResult_) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -9943,43 +17915,43 @@ func (p *EvaluatorRecord) ReadField4(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.ItemID = _field
+	p.TurnID = _field
 	return nil
 }
-func (p *EvaluatorRecord) ReadField5(iprot thrift.TProtocol) error {
+func (p *CalibrationItemResult_) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.TurnID = _field
+	p.GroundTruth = _field
 	return nil
 }
-func (p *EvaluatorRecord) ReadField6(iprot thrift.TProtocol) error {
+func (p *CalibrationItemResult_) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.EvaluatorVersionID = _field
+	p.EvaluatorScore = _field
 	return nil
 }
-func (p *EvaluatorRecord) ReadField7(iprot thrift.TProtocol) error {
+func (p *CalibrationItemResult_) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.TraceID = _field
+	p.AbsError = _field
 	return nil
 }
-func (p *EvaluatorRecord) ReadField8(iprot thrift.TProtocol) error {
+func (p *CalibrationItemResult_) ReadField6(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -9987,78 +17959,13 @@ func (p *EvaluatorRecord) ReadField8(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.LogID = _field
-	return nil
-}
-func (p *EvaluatorRecord) ReadField9(iprot thrift.TProtocol) error {
-	_field := NewEvaluatorInputData()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.EvaluatorInputData = _field
-	return nil
-}
-func (p *EvaluatorRecord) ReadField10(iprot thrift.TProtocol) error {
-	_field := NewEvaluatorOutputData()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.EvaluatorOutputData = _field
-	return nil
-}
-func (p *EvaluatorRecord) ReadField11(iprot thrift.TProtocol) error {
-
-	var _field *EvaluatorRunStatus
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		tmp := EvaluatorRunStatus(v)
-		_field = &tmp
-	}
-	p.Status = _field
-	return nil
-}
-func (p *EvaluatorRecord) ReadField12(iprot thrift.TProtocol) error {
-	_field := common.NewBaseInfo()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.BaseInfo = _field
-	return nil
-}
-func (p *EvaluatorRecord) ReadField20(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
-		}
-
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return err
-	}
-	p.Ext = _field
+	p.Reasoning = _field
 	return nil
 }
 
-func (p *EvaluatorRecord) Write(oprot thrift.TProtocol) (err error) {
+func (p *CalibrationItemResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorRecord"); err != nil {
+	if err = oprot.WriteStructBegin("CalibrationItemResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10086,34 +17993,6 @@ func (p *EvaluatorRecord) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 6
 			goto WriteFieldError
 		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
-			goto WriteFieldError
-		}
-		if err = p.writeField12(oprot); err != nil {
-			fieldId = 12
-			goto WriteFieldError
-		}
-		if err = p.writeField20(oprot); err != nil {
-			fieldId = 20
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -10132,12 +18011,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorRecord) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetID() {
-		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *CalibrationItemResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItemID() {
+		if err = oprot.WriteFieldBegin("item_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.ID); err != nil {
+		if err := oprot.WriteI64(*p.ItemID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10150,12 +18029,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorRecord) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExperimentID() {
-		if err = oprot.WriteFieldBegin("experiment_id", thrift.I64, 2); err != nil {
+func (p *CalibrationItemResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTurnID() {
+		if err = oprot.WriteFieldBegin("turn_id", thrift.I64, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.ExperimentID); err != nil {
+		if err := oprot.WriteI64(*p.TurnID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10168,12 +18047,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *EvaluatorRecord) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExperimentRunID() {
-		if err = oprot.WriteFieldBegin("experiment_run_id", thrift.I64, 3); err != nil {
+func (p *CalibrationItemResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGroundTruth() {
+		if err = oprot.WriteFieldBegin("ground_truth", thrift.DOUBLE, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.ExperimentRunID); err != nil {
+		if err := oprot.WriteDouble(*p.GroundTruth); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10186,12 +18065,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *EvaluatorRecord) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetItemID() {
-		if err = oprot.WriteFieldBegin("item_id", thrift.I64, 4); err != nil {
+func (p *CalibrationItemResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorScore() {
+		if err = oprot.WriteFieldBegin("evaluator_score", thrift.DOUBLE, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.ItemID); err != nil {
+		if err := oprot.WriteDouble(*p.EvaluatorScore); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10204,12 +18083,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *EvaluatorRecord) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTurnID() {
-		if err = oprot.WriteFieldBegin("turn_id", thrift.I64, 5); err != nil {
+func (p *CalibrationItemResult_) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAbsError() {
+		if err = oprot.WriteFieldBegin("abs_error", thrift.DOUBLE, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.TurnID); err != nil {
+		if err := oprot.WriteDouble(*p.AbsError); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10222,149 +18101,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *EvaluatorRecord) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorVersionID() {
-		if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.EvaluatorVersionID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-func (p *EvaluatorRecord) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTraceID() {
-		if err = oprot.WriteFieldBegin("trace_id", thrift.STRING, 7); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.TraceID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
-}
-func (p *EvaluatorRecord) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLogID() {
-		if err = oprot.WriteFieldBegin("log_id", thrift.STRING, 8); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.LogID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
-}
-func (p *EvaluatorRecord) writeField9(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorInputData() {
-		if err = oprot.WriteFieldBegin("evaluator_input_data", thrift.STRUCT, 9); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.EvaluatorInputData.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
-}
-func (p *EvaluatorRecord) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorOutputData() {
-		if err = oprot.WriteFieldBegin("evaluator_output_data", thrift.STRUCT, 10); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.EvaluatorOutputData.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
-}
-func (p *EvaluatorRecord) writeField11(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatus() {
-		if err = oprot.WriteFieldBegin("status", thrift.I32, 11); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(int32(*p.Status)); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
-}
-func (p *EvaluatorRecord) writeField12(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBaseInfo() {
-		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 12); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.BaseInfo.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
-}
-func (p *EvaluatorRecord) writeField20(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExt() {
-		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 20); err != nil {
+func (p *CalibrationItemResult_) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReasoning() {
+		if err = oprot.WriteFieldBegin("reasoning", thrift.STRING, 6); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Ext)); err != nil {
-			return err
-		}
-		for k, v := range p.Ext {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteMapEnd(); err != nil {
+		if err := oprot.WriteString(*p.Reasoning); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10372,105 +18114,48 @@ func (p *EvaluatorRecord) writeField20(oprot thrift.TProtocol) (err error) {
 		}
 	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 20 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 20 end error: ", p), err)
-}
-
-func (p *EvaluatorRecord) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("EvaluatorRecord(%+v)", *p)
-
-}
-
-func (p *EvaluatorRecord) DeepEqual(ano *EvaluatorRecord) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
-	}
-	if !p.Field1DeepEqual(ano.ID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.ExperimentID) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.ExperimentRunID) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.ItemID) {
-		return false
-	}
-	if !p.Field5DeepEqual(ano.TurnID) {
-		return false
-	}
-	if !p.Field6DeepEqual(ano.EvaluatorVersionID) {
-		return false
-	}
-	if !p.Field7DeepEqual(ano.TraceID) {
-		return false
-	}
-	if !p.Field8DeepEqual(ano.LogID) {
-		return false
-	}
-	if !p.Field9DeepEqual(ano.EvaluatorInputData) {
-		return false
-	}
-	if !p.Field10DeepEqual(ano.EvaluatorOutputData) {
-		return false
-	}
-	if !p.Field11DeepEqual(ano.Status) {
-		return false
-	}
-	if !p.Field12DeepEqual(ano.BaseInfo) {
-		return false
-	}
-	if !p.Field20DeepEqual(ano.Ext) {
-		return false
-	}
-	return true
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *EvaluatorRecord) Field1DeepEqual(src *int64) bool {
+func (p *CalibrationItemResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CalibrationItemResult_(%+v)", *p)
 
-	if p.ID == src {
+}
+
+func (p *CalibrationItemResult_) DeepEqual(ano *CalibrationItemResult_) bool {
+	if p == ano {
 		return true
-	} else if p.ID == nil || src == nil {
+	} else if p == nil || ano == nil {
 		return false
 	}
-	if *p.ID != *src {
+	if !p.Field1DeepEqual(ano.ItemID) {
 		return false
 	}
-	return true
-}
-func (p *EvaluatorRecord) Field2DeepEqual(src *int64) bool {
-
-	if p.ExperimentID == src {
-		return true
-	} else if p.ExperimentID == nil || src == nil {
+	if !p.Field2DeepEqual(ano.TurnID) {
 		return false
 	}
-	if *p.ExperimentID != *src {
+	if !p.Field3DeepEqual(ano.GroundTruth) {
 		return false
 	}
-	return true
-}
-func (p *EvaluatorRecord) Field3DeepEqual(src *int64) bool {
-
-	if p.ExperimentRunID == src {
-		return true
-	} else if p.ExperimentRunID == nil || src == nil {
+	if !p.Field4DeepEqual(ano.EvaluatorScore) {
 		return false
 	}
-	if *p.ExperimentRunID != *src {
+	if !p.Field5DeepEqual(ano.AbsError) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Reasoning) {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorRecord) Field4DeepEqual(src *int64) bool {
+
+func (p *CalibrationItemResult_) Field1DeepEqual(src *int64) bool {
 
 	if p.ItemID == src {
 		return true
@@ -10482,7 +18167,7 @@ func (p *EvaluatorRecord) Field4DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *EvaluatorRecord) Field5DeepEqual(src *int64) bool {
+func (p *CalibrationItemResult_) Field2DeepEqual(src *int64) bool {
 
 	if p.TurnID == src {
 		return true
@@ -10494,229 +18179,262 @@ func (p *EvaluatorRecord) Field5DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *EvaluatorRecord) Field6DeepEqual(src *int64) bool {
+func (p *CalibrationItemResult_) Field3DeepEqual(src *float64) bool {
 
-	if p.EvaluatorVersionID == src {
+	if p.GroundTruth == src {
 		return true
-	} else if p.EvaluatorVersionID == nil || src == nil {
+	} else if p.GroundTruth == nil || src == nil {
 		return false
 	}
-	if *p.EvaluatorVersionID != *src {
+	if *p.GroundTruth != *src {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorRecord) Field7DeepEqual(src *string) bool {
+func (p *CalibrationItemResult_) Field4DeepEqual(src *float64) bool {
 
-	if p.TraceID == src {
+	if p.EvaluatorScore == src {
 		return true
-	} else if p.TraceID == nil || src == nil {
+	} else if p.EvaluatorScore == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.TraceID, *src) != 0 {
+	if *p.EvaluatorScore != *src {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorRecord) Field8DeepEqual(src *string) bool {
+func (p *CalibrationItemResult_) Field5DeepEqual(src *float64) bool {
 
-	if p.LogID == src {
+	if p.AbsError == src {
 		return true
-	} else if p.LogID == nil || src == nil {
+	} else if p.AbsError == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.LogID, *src) != 0 {
+	if *p.AbsError != *src {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorRecord) Field9DeepEqual(src *EvaluatorInputData) bool {
+func (p *CalibrationItemResult_) Field6DeepEqual(src *string) bool {
 
-	if !p.EvaluatorInputData.DeepEqual(src) {
+	if p.Reasoning == src {
+		return true
+	} else if p.Reasoning == nil || src == nil {
 		return false
 	}
-	return true
-}
-func (p *EvaluatorRecord) Field10DeepEqual(src *EvaluatorOutputData) bool {
-
-	if !p.EvaluatorOutputData.DeepEqual(src) {
+	if strings.Compare(*p.Reasoning, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorRecord) Field11DeepEqual(src *EvaluatorRunStatus) bool {
 
-	if p.Status == src {
-		return true
-	} else if p.Status == nil || src == nil {
-		return false
-	}
-	if *p.Status != *src {
-		return false
-	}
-	return true
+type EvaluatorCalibrationResult_ struct {
+	// 参与校准的 turn 数
+	ItemCnt *int32 `thrift:"item_cnt,1,optional" frugal:"1,optional,i32" form:"item_cnt" json:"item_cnt,omitempty" query:"item_cnt"`
+	// 评估器得分与人工真值均有效的 turn 数, 以下指标均基于这部分样本
+	PairedCnt             *int32   `thrift:"paired_cnt,2,optional" frugal:"2,optional,i32" form:"paired_cnt" json:"paired_cnt,omitempty" query:"paired_cnt"`
+	EvaluatorFailCnt      *int32   `thrift:"evaluator_fail_cnt,3,optional" frugal:"3,optional,i32" form:"evaluator_fail_cnt" json:"evaluator_fail_cnt,omitempty" query:"evaluator_fail_cnt"`
+	InvalidGroundTruthCnt *int32   `thrift:"invalid_ground_truth_cnt,4,optional" frugal:"4,optional,i32" form:"invalid_ground_truth_cnt" json:"invalid_ground_truth_cnt,omitempty" query:"invalid_ground_truth_cnt"`
+	PearsonCorrelation    *float64 `thrift:"pearson_correlation,5,optional" frugal:"5,optional,double" form:"pearson_correlation" json:"pearson_correlation,omitempty" query:"pearson_correlation"`
+	SpearmanCorrelation   *float64 `thrift:"spearman_correlation,6,optional" frugal:"6,optional,double" form:"spearman_correlation" json:"spearman_correlation,omitempty" query:"spearman_correlation"`
+	MeanAbsoluteError     *float64 `thrift:"mean_absolute_error,7,optional" frugal:"7,optional,double" form:"mean_absolute_error" json:"mean_absolute_error,omitempty" query:"mean_absolute_error"`
+	// 仅分类输出时给出
+	ConfusionMatrix *CalibrationConfusionMatrix `thrift:"confusion_matrix,8,optional" frugal:"8,optional,CalibrationConfusionMatrix" form:"confusion_matrix" json:"confusion_matrix,omitempty" query:"confusion_matrix"`
+	// 按绝对误差降序的最不一致样本
+	WorstItems []*CalibrationItemResult_ `thrift:"worst_items,9,optional" frugal:"9,optional,list<CalibrationItemResult_>" form:"worst_items" json:"worst_items,omitempty" query:"worst_items"`
 }
-func (p *EvaluatorRecord) Field12DeepEqual(src *common.BaseInfo) bool {
 
-	if !p.BaseInfo.DeepEqual(src) {
-		return false
-	}
-	return true
+func NewEvaluatorCalibrationResult_() *EvaluatorCalibrationResult_ {
+	return &EvaluatorCalibrationResult_{}
 }
-func (p *EvaluatorRecord) Field20DeepEqual(src map[string]string) bool {
 
-	if len(p.Ext) != len(src) {
-		return false
+func (p *EvaluatorCalibrationResult_) InitDefault() {
+}
+
+var EvaluatorCalibrationResult__ItemCnt_DEFAULT int32
+
+func (p *EvaluatorCalibrationResult_) GetItemCnt() (v int32) {
+	if p == nil {
+		return
 	}
-	for k, v := range p.Ext {
-		_src := src[k]
-		if strings.Compare(v, _src) != 0 {
-			return false
-		}
+	if !p.IsSetItemCnt() {
+		return EvaluatorCalibrationResult__ItemCnt_DEFAULT
 	}
-	return true
+	return *p.ItemCnt
 }
 
-type EvaluatorOutputData struct {
-	EvaluatorResult_  *EvaluatorResult_            `thrift:"evaluator_result,1,optional" frugal:"1,optional,EvaluatorResult_" form:"evaluator_result" json:"evaluator_result,omitempty" query:"evaluator_result"`
-	EvaluatorUsage    *EvaluatorUsage              `thrift:"evaluator_usage,2,optional" frugal:"2,optional,EvaluatorUsage" form:"evaluator_usage" json:"evaluator_usage,omitempty" query:"evaluator_usage"`
-	EvaluatorRunError *EvaluatorRunError           `thrift:"evaluator_run_error,3,optional" frugal:"3,optional,EvaluatorRunError" form:"evaluator_run_error" json:"evaluator_run_error,omitempty" query:"evaluator_run_error"`
-	TimeConsumingMs   *int64                       `thrift:"time_consuming_ms,4,optional" frugal:"4,optional,i64" json:"time_consuming_ms" form:"time_consuming_ms" query:"time_consuming_ms"`
-	Stdout            *string                      `thrift:"stdout,11,optional" frugal:"11,optional,string" form:"stdout" json:"stdout,omitempty" query:"stdout"`
-	ExtraOutput       *EvaluatorExtraOutputContent `thrift:"extra_output,12,optional" frugal:"12,optional,EvaluatorExtraOutputContent" form:"extra_output" json:"extra_output,omitempty" query:"extra_output"`
-}
+var EvaluatorCalibrationResult__PairedCnt_DEFAULT int32
 
-func NewEvaluatorOutputData() *EvaluatorOutputData {
-	return &EvaluatorOutputData{}
+func (p *EvaluatorCalibrationResult_) GetPairedCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPairedCnt() {
+		return EvaluatorCalibrationResult__PairedCnt_DEFAULT
+	}
+	return *p.PairedCnt
 }
 
-func (p *EvaluatorOutputData) InitDefault() {
+var EvaluatorCalibrationResult__EvaluatorFailCnt_DEFAULT int32
+
+func (p *EvaluatorCalibrationResult_) GetEvaluatorFailCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorFailCnt() {
+		return EvaluatorCalibrationResult__EvaluatorFailCnt_DEFAULT
+	}
+	return *p.EvaluatorFailCnt
 }
 
-var EvaluatorOutputData_EvaluatorResult__DEFAULT *EvaluatorResult_
+var EvaluatorCalibrationResult__InvalidGroundTruthCnt_DEFAULT int32
 
-func (p *EvaluatorOutputData) GetEvaluatorResult_() (v *EvaluatorResult_) {
+func (p *EvaluatorCalibrationResult_) GetInvalidGroundTruthCnt() (v int32) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEvaluatorResult_() {
-		return EvaluatorOutputData_EvaluatorResult__DEFAULT
+	if !p.IsSetInvalidGroundTruthCnt() {
+		return EvaluatorCalibrationResult__InvalidGroundTruthCnt_DEFAULT
 	}
-	return p.EvaluatorResult_
+	return *p.InvalidGroundTruthCnt
 }
 
-var EvaluatorOutputData_EvaluatorUsage_DEFAULT *EvaluatorUsage
+var EvaluatorCalibrationResult__PearsonCorrelation_DEFAULT float64
 
-func (p *EvaluatorOutputData) GetEvaluatorUsage() (v *EvaluatorUsage) {
+func (p *EvaluatorCalibrationResult_) GetPearsonCorrelation() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEvaluatorUsage() {
-		return EvaluatorOutputData_EvaluatorUsage_DEFAULT
+	if !p.IsSetPearsonCorrelation() {
+		return EvaluatorCalibrationResult__PearsonCorrelation_DEFAULT
 	}
-	return p.EvaluatorUsage
+	return *p.PearsonCorrelation
 }
 
-var EvaluatorOutputData_EvaluatorRunError_DEFAULT *EvaluatorRunError
+var EvaluatorCalibrationResult__SpearmanCorrelation_DEFAULT float64
 
-func (p *EvaluatorOutputData) GetEvaluatorRunError() (v *EvaluatorRunError) {
+func (p *EvaluatorCalibrationResult_) GetSpearmanCorrelation() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEvaluatorRunError() {
-		return EvaluatorOutputData_EvaluatorRunError_DEFAULT
+	if !p.IsSetSpearmanCorrelation() {
+		return EvaluatorCalibrationResult__SpearmanCorrelation_DEFAULT
 	}
-	return p.EvaluatorRunError
+	return *p.SpearmanCorrelation
 }
 
-var EvaluatorOutputData_TimeConsumingMs_DEFAULT int64
+var EvaluatorCalibrationResult__MeanAbsoluteError_DEFAULT float64
 
-func (p *EvaluatorOutputData) GetTimeConsumingMs() (v int64) {
+func (p *EvaluatorCalibrationResult_) GetMeanAbsoluteError() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTimeConsumingMs() {
-		return EvaluatorOutputData_TimeConsumingMs_DEFAULT
+	if !p.IsSetMeanAbsoluteError() {
+		return EvaluatorCalibrationResult__MeanAbsoluteError_DEFAULT
 	}
-	return *p.TimeConsumingMs
+	return *p.MeanAbsoluteError
 }
 
-var EvaluatorOutputData_Stdout_DEFAULT string
+var EvaluatorCalibrationResult__ConfusionMatrix_DEFAULT *CalibrationConfusionMatrix
 
-func (p *EvaluatorOutputData) GetStdout() (v string) {
+func (p *EvaluatorCalibrationResult_) GetConfusionMatrix() (v *CalibrationConfusionMatrix) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetStdout() {
-		return EvaluatorOutputData_Stdout_DEFAULT
+	if !p.IsSetConfusionMatrix() {
+		return EvaluatorCalibrationResult__ConfusionMatrix_DEFAULT
 	}
-	return *p.Stdout
+	return p.ConfusionMatrix
 }
 
-var EvaluatorOutputData_ExtraOutput_DEFAULT *EvaluatorExtraOutputContent
+var EvaluatorCalibrationResult__WorstItems_DEFAULT []*CalibrationItemResult_
 
-func (p *EvaluatorOutputData) GetExtraOutput() (v *EvaluatorExtraOutputContent) {
+func (p *EvaluatorCalibrationResult_) GetWorstItems() (v []*CalibrationItemResult_) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetExtraOutput() {
-		return EvaluatorOutputData_ExtraOutput_DEFAULT
+	if !p.IsSetWorstItems() {
+		return EvaluatorCalibrationResult__WorstItems_DEFAULT
 	}
-	return p.ExtraOutput
+	return p.WorstItems
 }
-func (p *EvaluatorOutputData) SetEvaluatorResult_(val *EvaluatorResult_) {
-	p.EvaluatorResult_ = val
+func (p *EvaluatorCalibrationResult_) SetItemCnt(val *int32) {
+	p.ItemCnt = val
 }
-func (p *EvaluatorOutputData) SetEvaluatorUsage(val *EvaluatorUsage) {
-	p.EvaluatorUsage = val
+func (p *EvaluatorCalibrationResult_) SetPairedCnt(val *int32) {
+	p.PairedCnt = val
 }
-func (p *EvaluatorOutputData) SetEvaluatorRunError(val *EvaluatorRunError) {
-	p.EvaluatorRunError = val
+func (p *EvaluatorCalibrationResult_) SetEvaluatorFailCnt(val *int32) {
+	p.EvaluatorFailCnt = val
 }
-func (p *EvaluatorOutputData) SetTimeConsumingMs(val *int64) {
-	p.TimeConsumingMs = val
+func (p *EvaluatorCalibrationResult_) SetInvalidGroundTruthCnt(val *int32) {
+	p.InvalidGroundTruthCnt = val
 }
-func (p *EvaluatorOutputData) SetStdout(val *string) {
-	p.Stdout = val
+func (p *EvaluatorCalibrationResult_) SetPearsonCorrelation(val *float64) {
+	p.PearsonCorrelation = val
 }
-func (p *EvaluatorOutputData) SetExtraOutput(val *EvaluatorExtraOutputContent) {
-	p.ExtraOutput = val
+func (p *EvaluatorCalibrationResult_) SetSpearmanCorrelation(val *float64) {
+	p.SpearmanCorrelation = val
+}
+func (p *EvaluatorCalibrationResult_) SetMeanAbsoluteError(val *float64) {
+	p.MeanAbsoluteError = val
+}
+func (p *EvaluatorCalibrationResult_) SetConfusionMatrix(val *CalibrationConfusionMatrix) {
+	p.ConfusionMatrix = val
+}
+func (p *EvaluatorCalibrationResult_) SetWorstItems(val []*CalibrationItemResult_) {
+	p.WorstItems = val
 }
 
-var fieldIDToName_EvaluatorOutputData = map[int16]string{
-	1:  "evaluator_result",
-	2:  "evaluator_usage",
-	3:  "evaluator_run_error",
-	4:  "time_consuming_ms",
-	11: "stdout",
-	12: "extra_output",
+var fieldIDToName_EvaluatorCalibrationResult_ = map[int16]string{
+	1: "item_cnt",
+	2: "paired_cnt",
+	3: "evaluator_fail_cnt",
+	4: "invalid_ground_truth_cnt",
+	5: "pearson_correlation",
+	6: "spearman_correlation",
+	7: "mean_absolute_error",
+	8: "confusion_matrix",
+	9: "worst_items",
 }
 
-func (p *EvaluatorOutputData) IsSetEvaluatorResult_() bool {
-	return p.EvaluatorResult_ != nil
+func (p *EvaluatorCalibrationResult_) IsSetItemCnt() bool {
+	return p.ItemCnt != nil
 }
 
-func (p *EvaluatorOutputData) IsSetEvaluatorUsage() bool {
-	return p.EvaluatorUsage != nil
+func (p *EvaluatorCalibrationResult_) IsSetPairedCnt() bool {
+	return p.PairedCnt != nil
 }
 
-func (p *EvaluatorOutputData) IsSetEvaluatorRunError() bool {
-	return p.EvaluatorRunError != nil
+func (p *EvaluatorCalibrationResult_) IsSetEvaluatorFailCnt() bool {
+	return p.EvaluatorFailCnt != nil
 }
 
-func (p *EvaluatorOutputData) IsSetTimeConsumingMs() bool {
-	return p.TimeConsumingMs != nil
+func (p *EvaluatorCalibrationResult_) IsSetInvalidGroundTruthCnt() bool {
+	return p.InvalidGroundTruthCnt != nil
 }
 
-func (p *EvaluatorOutputData) IsSetStdout() bool {
-	return p.Stdout != nil
+func (p *EvaluatorCalibrationResult_) IsSetPearsonCorrelation() bool {
+	return p.PearsonCorrelation != nil
 }
 
-func (p *EvaluatorOutputData) IsSetExtraOutput() bool {
-	return p.ExtraOutput != nil
+func (p *EvaluatorCalibrationResult_) IsSetSpearmanCorrelation() bool {
+	return p.SpearmanCorrelation != nil
 }
 
-func (p *EvaluatorOutputData) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorCalibrationResult_) IsSetMeanAbsoluteError() bool {
+	return p.MeanAbsoluteError != nil
+}
+
+func (p *EvaluatorCalibrationResult_) IsSetConfusionMatrix() bool {
+	return p.ConfusionMatrix != nil
+}
+
+func (p *EvaluatorCalibrationResult_) IsSetWorstItems() bool {
+	return p.WorstItems != nil
+}
+
+func (p *EvaluatorCalibrationResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -10735,7 +18453,7 @@ func (p *EvaluatorOutputData) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -10743,7 +18461,7 @@ func (p *EvaluatorOutputData) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -10751,7 +18469,7 @@ func (p *EvaluatorOutputData) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -10759,24 +18477,48 @@ func (p *EvaluatorOutputData) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 11:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField11(iprot); err != nil {
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 12:
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
 			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField12(iprot); err != nil {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField9(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -10801,7 +18543,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorOutputData[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorCalibrationResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10811,64 +18553,118 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorOutputData) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewEvaluatorResult_()
-	if err := _field.Read(iprot); err != nil {
+func (p *EvaluatorCalibrationResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.EvaluatorResult_ = _field
+	p.ItemCnt = _field
 	return nil
 }
-func (p *EvaluatorOutputData) ReadField2(iprot thrift.TProtocol) error {
-	_field := NewEvaluatorUsage()
-	if err := _field.Read(iprot); err != nil {
+func (p *EvaluatorCalibrationResult_) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PairedCnt = _field
+	return nil
+}
+func (p *EvaluatorCalibrationResult_) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatorFailCnt = _field
+	return nil
+}
+func (p *EvaluatorCalibrationResult_) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.EvaluatorUsage = _field
+	p.InvalidGroundTruthCnt = _field
 	return nil
 }
-func (p *EvaluatorOutputData) ReadField3(iprot thrift.TProtocol) error {
-	_field := NewEvaluatorRunError()
-	if err := _field.Read(iprot); err != nil {
+func (p *EvaluatorCalibrationResult_) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.EvaluatorRunError = _field
+	p.PearsonCorrelation = _field
 	return nil
 }
-func (p *EvaluatorOutputData) ReadField4(iprot thrift.TProtocol) error {
+func (p *EvaluatorCalibrationResult_) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.TimeConsumingMs = _field
+	p.SpearmanCorrelation = _field
 	return nil
 }
-func (p *EvaluatorOutputData) ReadField11(iprot thrift.TProtocol) error {
+func (p *EvaluatorCalibrationResult_) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Stdout = _field
+	p.MeanAbsoluteError = _field
 	return nil
 }
-func (p *EvaluatorOutputData) ReadField12(iprot thrift.TProtocol) error {
-	_field := NewEvaluatorExtraOutputContent()
+func (p *EvaluatorCalibrationResult_) ReadField8(iprot thrift.TProtocol) error {
+	_field := NewCalibrationConfusionMatrix()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.ExtraOutput = _field
+	p.ConfusionMatrix = _field
+	return nil
+}
+func (p *EvaluatorCalibrationResult_) ReadField9(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*CalibrationItemResult_, 0, size)
+	values := make([]CalibrationItemResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.WorstItems = _field
 	return nil
 }
 
-func (p *EvaluatorOutputData) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorCalibrationResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorOutputData"); err != nil {
+	if err = oprot.WriteStructBegin("EvaluatorCalibrationResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10888,12 +18684,24 @@ func (p *EvaluatorOutputData) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
 			goto WriteFieldError
 		}
-		if err = p.writeField12(oprot); err != nil {
-			fieldId = 12
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
 			goto WriteFieldError
 		}
 	}
@@ -10914,12 +18722,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorOutputData) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorResult_() {
-		if err = oprot.WriteFieldBegin("evaluator_result", thrift.STRUCT, 1); err != nil {
+func (p *EvaluatorCalibrationResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItemCnt() {
+		if err = oprot.WriteFieldBegin("item_cnt", thrift.I32, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.EvaluatorResult_.Write(oprot); err != nil {
+		if err := oprot.WriteI32(*p.ItemCnt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10932,12 +18740,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorOutputData) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorUsage() {
-		if err = oprot.WriteFieldBegin("evaluator_usage", thrift.STRUCT, 2); err != nil {
+func (p *EvaluatorCalibrationResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPairedCnt() {
+		if err = oprot.WriteFieldBegin("paired_cnt", thrift.I32, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.EvaluatorUsage.Write(oprot); err != nil {
+		if err := oprot.WriteI32(*p.PairedCnt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10950,12 +18758,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *EvaluatorOutputData) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorRunError() {
-		if err = oprot.WriteFieldBegin("evaluator_run_error", thrift.STRUCT, 3); err != nil {
+func (p *EvaluatorCalibrationResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorFailCnt() {
+		if err = oprot.WriteFieldBegin("evaluator_fail_cnt", thrift.I32, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.EvaluatorRunError.Write(oprot); err != nil {
+		if err := oprot.WriteI32(*p.EvaluatorFailCnt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10968,12 +18776,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *EvaluatorOutputData) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTimeConsumingMs() {
-		if err = oprot.WriteFieldBegin("time_consuming_ms", thrift.I64, 4); err != nil {
+func (p *EvaluatorCalibrationResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetInvalidGroundTruthCnt() {
+		if err = oprot.WriteFieldBegin("invalid_ground_truth_cnt", thrift.I32, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.TimeConsumingMs); err != nil {
+		if err := oprot.WriteI32(*p.InvalidGroundTruthCnt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10986,12 +18794,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *EvaluatorOutputData) writeField11(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStdout() {
-		if err = oprot.WriteFieldBegin("stdout", thrift.STRING, 11); err != nil {
+func (p *EvaluatorCalibrationResult_) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPearsonCorrelation() {
+		if err = oprot.WriteFieldBegin("pearson_correlation", thrift.DOUBLE, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Stdout); err != nil {
+		if err := oprot.WriteDouble(*p.PearsonCorrelation); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -11000,16 +18808,16 @@ func (p *EvaluatorOutputData) writeField11(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *EvaluatorOutputData) writeField12(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExtraOutput() {
-		if err = oprot.WriteFieldBegin("extra_output", thrift.STRUCT, 12); err != nil {
+func (p *EvaluatorCalibrationResult_) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSpearmanCorrelation() {
+		if err = oprot.WriteFieldBegin("spearman_correlation", thrift.DOUBLE, 6); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.ExtraOutput.Write(oprot); err != nil {
+		if err := oprot.WriteDouble(*p.SpearmanCorrelation); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -11018,176 +18826,490 @@ func (p *EvaluatorOutputData) writeField12(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *EvaluatorCalibrationResult_) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMeanAbsoluteError() {
+		if err = oprot.WriteFieldBegin("mean_absolute_error", thrift.DOUBLE, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MeanAbsoluteError); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *EvaluatorCalibrationResult_) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConfusionMatrix() {
+		if err = oprot.WriteFieldBegin("confusion_matrix", thrift.STRUCT, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ConfusionMatrix.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *EvaluatorCalibrationResult_) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorstItems() {
+		if err = oprot.WriteFieldBegin("worst_items", thrift.LIST, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.WorstItems)); err != nil {
+			return err
+		}
+		for _, v := range p.WorstItems {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *EvaluatorOutputData) String() string {
+func (p *EvaluatorCalibrationResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorOutputData(%+v)", *p)
+	return fmt.Sprintf("EvaluatorCalibrationResult_(%+v)", *p)
 
 }
 
-func (p *EvaluatorOutputData) DeepEqual(ano *EvaluatorOutputData) bool {
+func (p *EvaluatorCalibrationResult_) DeepEqual(ano *EvaluatorCalibrationResult_) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.EvaluatorResult_) {
+	if !p.Field1DeepEqual(ano.ItemCnt) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.EvaluatorUsage) {
+	if !p.Field2DeepEqual(ano.PairedCnt) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.EvaluatorRunError) {
+	if !p.Field3DeepEqual(ano.EvaluatorFailCnt) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.TimeConsumingMs) {
+	if !p.Field4DeepEqual(ano.InvalidGroundTruthCnt) {
 		return false
 	}
-	if !p.Field11DeepEqual(ano.Stdout) {
+	if !p.Field5DeepEqual(ano.PearsonCorrelation) {
 		return false
 	}
-	if !p.Field12DeepEqual(ano.ExtraOutput) {
+	if !p.Field6DeepEqual(ano.SpearmanCorrelation) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.MeanAbsoluteError) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.ConfusionMatrix) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.WorstItems) {
 		return false
 	}
 	return true
 }
 
-func (p *EvaluatorOutputData) Field1DeepEqual(src *EvaluatorResult_) bool {
+func (p *EvaluatorCalibrationResult_) Field1DeepEqual(src *int32) bool {
 
-	if !p.EvaluatorResult_.DeepEqual(src) {
+	if p.ItemCnt == src {
+		return true
+	} else if p.ItemCnt == nil || src == nil {
+		return false
+	}
+	if *p.ItemCnt != *src {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorOutputData) Field2DeepEqual(src *EvaluatorUsage) bool {
+func (p *EvaluatorCalibrationResult_) Field2DeepEqual(src *int32) bool {
 
-	if !p.EvaluatorUsage.DeepEqual(src) {
+	if p.PairedCnt == src {
+		return true
+	} else if p.PairedCnt == nil || src == nil {
+		return false
+	}
+	if *p.PairedCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCalibrationResult_) Field3DeepEqual(src *int32) bool {
+
+	if p.EvaluatorFailCnt == src {
+		return true
+	} else if p.EvaluatorFailCnt == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatorFailCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCalibrationResult_) Field4DeepEqual(src *int32) bool {
+
+	if p.InvalidGroundTruthCnt == src {
+		return true
+	} else if p.InvalidGroundTruthCnt == nil || src == nil {
+		return false
+	}
+	if *p.InvalidGroundTruthCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCalibrationResult_) Field5DeepEqual(src *float64) bool {
+
+	if p.PearsonCorrelation == src {
+		return true
+	} else if p.PearsonCorrelation == nil || src == nil {
+		return false
+	}
+	if *p.PearsonCorrelation != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCalibrationResult_) Field6DeepEqual(src *float64) bool {
+
+	if p.SpearmanCorrelation == src {
+		return true
+	} else if p.SpearmanCorrelation == nil || src == nil {
+		return false
+	}
+	if *p.SpearmanCorrelation != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCalibrationResult_) Field7DeepEqual(src *float64) bool {
+
+	if p.MeanAbsoluteError == src {
+		return true
+	} else if p.MeanAbsoluteError == nil || src == nil {
+		return false
+	}
+	if *p.MeanAbsoluteError != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCalibrationResult_) Field8DeepEqual(src *CalibrationConfusionMatrix) bool {
+
+	if !p.ConfusionMatrix.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCalibrationResult_) Field9DeepEqual(src []*CalibrationItemResult_) bool {
+
+	if len(p.WorstItems) != len(src) {
 		return false
 	}
-	return true
+	for i, v := range p.WorstItems {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+// 评估器版本的一次校准记录
+type EvaluatorCalibrationRecord struct {
+	RecordID           *int64  `thrift:"record_id,1,optional" frugal:"1,optional,i64" json:"record_id" form:"record_id" query:"record_id"`
+	WorkspaceID        *int64  `thrift:"workspace_id,2,optional" frugal:"2,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	EvaluatorID        *int64  `thrift:"evaluator_id,3,optional" frugal:"3,optional,i64" json:"evaluator_id" form:"evaluator_id" query:"evaluator_id"`
+	EvaluatorVersionID *int64  `thrift:"evaluator_version_id,4,optional" frugal:"4,optional,i64" json:"evaluator_version_id" form:"evaluator_version_id" query:"evaluator_version_id"`
+	EvaluatorVersion   *string `thrift:"evaluator_version,5,optional" frugal:"5,optional,string" form:"evaluator_version" json:"evaluator_version,omitempty" query:"evaluator_version"`
+	EvaluationSetID    *int64  `thrift:"evaluation_set_id,6,optional" frugal:"6,optional,i64" json:"evaluation_set_id" form:"evaluation_set_id" query:"evaluation_set_id"`
+	// 0 表示草稿
+	EvaluationSetVersionID *int64                       `thrift:"evaluation_set_version_id,7,optional" frugal:"7,optional,i64" json:"evaluation_set_version_id" form:"evaluation_set_version_id" query:"evaluation_set_version_id"`
+	Status                 *EvaluatorCalibrationStatus  `thrift:"status,8,optional" frugal:"8,optional,string" form:"status" json:"status,omitempty" query:"status"`
+	Conf                   *EvaluatorCalibrationConf    `thrift:"conf,9,optional" frugal:"9,optional,EvaluatorCalibrationConf" form:"conf" json:"conf,omitempty" query:"conf"`
+	Result_                *EvaluatorCalibrationResult_ `thrift:"result,10,optional" frugal:"10,optional,EvaluatorCalibrationResult_" form:"result" json:"result,omitempty" query:"result"`
+	ErrorMsg               *string                      `thrift:"error_msg,11,optional" frugal:"11,optional,string" form:"error_msg" json:"error_msg,omitempty" query:"error_msg"`
+	BaseInfo               *common.BaseInfo             `thrift:"base_info,12,optional" frugal:"12,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+}
+
+func NewEvaluatorCalibrationRecord() *EvaluatorCalibrationRecord {
+	return &EvaluatorCalibrationRecord{}
+}
+
+func (p *EvaluatorCalibrationRecord) InitDefault() {
+}
+
+var EvaluatorCalibrationRecord_RecordID_DEFAULT int64
+
+func (p *EvaluatorCalibrationRecord) GetRecordID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRecordID() {
+		return EvaluatorCalibrationRecord_RecordID_DEFAULT
+	}
+	return *p.RecordID
+}
+
+var EvaluatorCalibrationRecord_WorkspaceID_DEFAULT int64
+
+func (p *EvaluatorCalibrationRecord) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceID() {
+		return EvaluatorCalibrationRecord_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
+}
+
+var EvaluatorCalibrationRecord_EvaluatorID_DEFAULT int64
+
+func (p *EvaluatorCalibrationRecord) GetEvaluatorID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorID() {
+		return EvaluatorCalibrationRecord_EvaluatorID_DEFAULT
+	}
+	return *p.EvaluatorID
 }
-func (p *EvaluatorOutputData) Field3DeepEqual(src *EvaluatorRunError) bool {
 
-	if !p.EvaluatorRunError.DeepEqual(src) {
-		return false
+var EvaluatorCalibrationRecord_EvaluatorVersionID_DEFAULT int64
+
+func (p *EvaluatorCalibrationRecord) GetEvaluatorVersionID() (v int64) {
+	if p == nil {
+		return
 	}
-	return true
+	if !p.IsSetEvaluatorVersionID() {
+		return EvaluatorCalibrationRecord_EvaluatorVersionID_DEFAULT
+	}
+	return *p.EvaluatorVersionID
 }
-func (p *EvaluatorOutputData) Field4DeepEqual(src *int64) bool {
 
-	if p.TimeConsumingMs == src {
-		return true
-	} else if p.TimeConsumingMs == nil || src == nil {
-		return false
+var EvaluatorCalibrationRecord_EvaluatorVersion_DEFAULT string
+
+func (p *EvaluatorCalibrationRecord) GetEvaluatorVersion() (v string) {
+	if p == nil {
+		return
 	}
-	if *p.TimeConsumingMs != *src {
-		return false
+	if !p.IsSetEvaluatorVersion() {
+		return EvaluatorCalibrationRecord_EvaluatorVersion_DEFAULT
 	}
-	return true
+	return *p.EvaluatorVersion
 }
-func (p *EvaluatorOutputData) Field11DeepEqual(src *string) bool {
 
-	if p.Stdout == src {
-		return true
-	} else if p.Stdout == nil || src == nil {
-		return false
+var EvaluatorCalibrationRecord_EvaluationSetID_DEFAULT int64
+
+func (p *EvaluatorCalibrationRecord) GetEvaluationSetID() (v int64) {
+	if p == nil {
+		return
 	}
-	if strings.Compare(*p.Stdout, *src) != 0 {
-		return false
+	if !p.IsSetEvaluationSetID() {
+		return EvaluatorCalibrationRecord_EvaluationSetID_DEFAULT
 	}
-	return true
+	return *p.EvaluationSetID
 }
-func (p *EvaluatorOutputData) Field12DeepEqual(src *EvaluatorExtraOutputContent) bool {
 
-	if !p.ExtraOutput.DeepEqual(src) {
-		return false
+var EvaluatorCalibrationRecord_EvaluationSetVersionID_DEFAULT int64
+
+func (p *EvaluatorCalibrationRecord) GetEvaluationSetVersionID() (v int64) {
+	if p == nil {
+		return
 	}
-	return true
+	if !p.IsSetEvaluationSetVersionID() {
+		return EvaluatorCalibrationRecord_EvaluationSetVersionID_DEFAULT
+	}
+	return *p.EvaluationSetVersionID
 }
 
-type EvaluatorResult_ struct {
-	Score      *float64    `thrift:"score,1,optional" frugal:"1,optional,double" form:"score" json:"score,omitempty" query:"score"`
-	Correction *Correction `thrift:"correction,2,optional" frugal:"2,optional,Correction" form:"correction" json:"correction,omitempty" query:"correction"`
-	Reasoning  *string     `thrift:"reasoning,3,optional" frugal:"3,optional,string" form:"reasoning" json:"reasoning,omitempty" query:"reasoning"`
-}
+var EvaluatorCalibrationRecord_Status_DEFAULT EvaluatorCalibrationStatus
 
-func NewEvaluatorResult_() *EvaluatorResult_ {
-	return &EvaluatorResult_{}
+func (p *EvaluatorCalibrationRecord) GetStatus() (v EvaluatorCalibrationStatus) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStatus() {
+		return EvaluatorCalibrationRecord_Status_DEFAULT
+	}
+	return *p.Status
 }
 
-func (p *EvaluatorResult_) InitDefault() {
+var EvaluatorCalibrationRecord_Conf_DEFAULT *EvaluatorCalibrationConf
+
+func (p *EvaluatorCalibrationRecord) GetConf() (v *EvaluatorCalibrationConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetConf() {
+		return EvaluatorCalibrationRecord_Conf_DEFAULT
+	}
+	return p.Conf
 }
 
-var EvaluatorResult__Score_DEFAULT float64
+var EvaluatorCalibrationRecord_Result__DEFAULT *EvaluatorCalibrationResult_
 
-func (p *EvaluatorResult_) GetScore() (v float64) {
+func (p *EvaluatorCalibrationRecord) GetResult_() (v *EvaluatorCalibrationResult_) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetScore() {
-		return EvaluatorResult__Score_DEFAULT
+	if !p.IsSetResult_() {
+		return EvaluatorCalibrationRecord_Result__DEFAULT
 	}
-	return *p.Score
+	return p.Result_
 }
 
-var EvaluatorResult__Correction_DEFAULT *Correction
+var EvaluatorCalibrationRecord_ErrorMsg_DEFAULT string
 
-func (p *EvaluatorResult_) GetCorrection() (v *Correction) {
+func (p *EvaluatorCalibrationRecord) GetErrorMsg() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetCorrection() {
-		return EvaluatorResult__Correction_DEFAULT
+	if !p.IsSetErrorMsg() {
+		return EvaluatorCalibrationRecord_ErrorMsg_DEFAULT
 	}
-	return p.Correction
+	return *p.ErrorMsg
 }
 
-var EvaluatorResult__Reasoning_DEFAULT string
+var EvaluatorCalibrationRecord_BaseInfo_DEFAULT *common.BaseInfo
 
-func (p *EvaluatorResult_) GetReasoning() (v string) {
+func (p *EvaluatorCalibrationRecord) GetBaseInfo() (v *common.BaseInfo) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetReasoning() {
-		return EvaluatorResult__Reasoning_DEFAULT
+	if !p.IsSetBaseInfo() {
+		return EvaluatorCalibrationRecord_BaseInfo_DEFAULT
 	}
-	return *p.Reasoning
+	return p.BaseInfo
 }
-func (p *EvaluatorResult_) SetScore(val *float64) {
-	p.Score = val
+func (p *EvaluatorCalibrationRecord) SetRecordID(val *int64) {
+	p.RecordID = val
 }
-func (p *EvaluatorResult_) SetCorrection(val *Correction) {
-	p.Correction = val
+func (p *EvaluatorCalibrationRecord) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
 }
-func (p *EvaluatorResult_) SetReasoning(val *string) {
-	p.Reasoning = val
+func (p *EvaluatorCalibrationRecord) SetEvaluatorID(val *int64) {
+	p.EvaluatorID = val
+}
+func (p *EvaluatorCalibrationRecord) SetEvaluatorVersionID(val *int64) {
+	p.EvaluatorVersionID = val
+}
+func (p *EvaluatorCalibrationRecord) SetEvaluatorVersion(val *string) {
+	p.EvaluatorVersion = val
+}
+func (p *EvaluatorCalibrationRecord) SetEvaluationSetID(val *int64) {
+	p.EvaluationSetID = val
+}
+func (p *EvaluatorCalibrationRecord) SetEvaluationSetVersionID(val *int64) {
+	p.EvaluationSetVersionID = val
+}
+func (p *EvaluatorCalibrationRecord) SetStatus(val *EvaluatorCalibrationStatus) {
+	p.Status = val
+}
+func (p *EvaluatorCalibrationRecord) SetConf(val *EvaluatorCalibrationConf) {
+	p.Conf = val
+}
+func (p *EvaluatorCalibrationRecord) SetResult_(val *EvaluatorCalibrationResult_) {
+	p.Result_ = val
+}
+func (p *EvaluatorCalibrationRecord) SetErrorMsg(val *string) {
+	p.ErrorMsg = val
+}
+func (p *EvaluatorCalibrationRecord) SetBaseInfo(val *common.BaseInfo) {
+	p.BaseInfo = val
 }
 
-var fieldIDToName_EvaluatorResult_ = map[int16]string{
-	1: "score",
-	2: "correction",
-	3: "reasoning",
+var fieldIDToName_EvaluatorCalibrationRecord = map[int16]string{
+	1:  "record_id",
+	2:  "workspace_id",
+	3:  "evaluator_id",
+	4:  "evaluator_version_id",
+	5:  "evaluator_version",
+	6:  "evaluation_set_id",
+	7:  "evaluation_set_version_id",
+	8:  "status",
+	9:  "conf",
+	10: "result",
+	11: "error_msg",
+	12: "base_info",
 }
 
-func (p *EvaluatorResult_) IsSetScore() bool {
-	return p.Score != nil
+func (p *EvaluatorCalibrationRecord) IsSetRecordID() bool {
+	return p.RecordID != nil
 }
 
-func (p *EvaluatorResult_) IsSetCorrection() bool {
-	return p.Correction != nil
+func (p *EvaluatorCalibrationRecord) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
 }
 
-func (p *EvaluatorResult_) IsSetReasoning() bool {
-	return p.Reasoning != nil
+func (p *EvaluatorCalibrationRecord) IsSetEvaluatorID() bool {
+	return p.EvaluatorID != nil
 }
 
-func (p *EvaluatorResult_) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorCalibrationRecord) IsSetEvaluatorVersionID() bool {
+	return p.EvaluatorVersionID != nil
+}
+
+func (p *EvaluatorCalibrationRecord) IsSetEvaluatorVersion() bool {
+	return p.EvaluatorVersion != nil
+}
+
+func (p *EvaluatorCalibrationRecord) IsSetEvaluationSetID() bool {
+	return p.EvaluationSetID != nil
+}
+
+func (p *EvaluatorCalibrationRecord) IsSetEvaluationSetVersionID() bool {
+	return p.EvaluationSetVersionID != nil
+}
+
+func (p *EvaluatorCalibrationRecord) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *EvaluatorCalibrationRecord) IsSetConf() bool {
+	return p.Conf != nil
+}
+
+func (p *EvaluatorCalibrationRecord) IsSetResult_() bool {
+	return p.Result_ != nil
+}
+
+func (p *EvaluatorCalibrationRecord) IsSetErrorMsg() bool {
+	return p.ErrorMsg != nil
+}
+
+func (p *EvaluatorCalibrationRecord) IsSetBaseInfo() bool {
+	return p.BaseInfo != nil
+}
+
+func (p *EvaluatorCalibrationRecord) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -11206,7 +19328,7 @@ func (p *EvaluatorResult_) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -11214,7 +19336,7 @@ func (p *EvaluatorResult_) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -11222,13 +19344,85 @@ func (p *EvaluatorResult_) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -11243,41 +19437,126 @@ func (p *EvaluatorResult_) Read(iprot thrift.TProtocol) (err error) {
 	}
 
 	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorResult_[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorCalibrationRecord[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorCalibrationRecord) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RecordID = _field
+	return nil
+}
+func (p *EvaluatorCalibrationRecord) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *EvaluatorCalibrationRecord) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatorID = _field
+	return nil
+}
+func (p *EvaluatorCalibrationRecord) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatorVersionID = _field
+	return nil
+}
+func (p *EvaluatorCalibrationRecord) ReadField5(iprot thrift.TProtocol) error {
 
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatorVersion = _field
+	return nil
 }
+func (p *EvaluatorCalibrationRecord) ReadField6(iprot thrift.TProtocol) error {
 
-func (p *EvaluatorResult_) ReadField1(iprot thrift.TProtocol) error {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluationSetID = _field
+	return nil
+}
+func (p *EvaluatorCalibrationRecord) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field *float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Score = _field
+	p.EvaluationSetVersionID = _field
 	return nil
 }
-func (p *EvaluatorResult_) ReadField2(iprot thrift.TProtocol) error {
-	_field := NewCorrection()
+func (p *EvaluatorCalibrationRecord) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *EvaluatorCalibrationStatus
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *EvaluatorCalibrationRecord) ReadField9(iprot thrift.TProtocol) error {
+	_field := NewEvaluatorCalibrationConf()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Correction = _field
+	p.Conf = _field
 	return nil
 }
-func (p *EvaluatorResult_) ReadField3(iprot thrift.TProtocol) error {
+func (p *EvaluatorCalibrationRecord) ReadField10(iprot thrift.TProtocol) error {
+	_field := NewEvaluatorCalibrationResult_()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Result_ = _field
+	return nil
+}
+func (p *EvaluatorCalibrationRecord) ReadField11(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -11285,13 +19564,21 @@ func (p *EvaluatorResult_) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Reasoning = _field
+	p.ErrorMsg = _field
+	return nil
+}
+func (p *EvaluatorCalibrationRecord) ReadField12(iprot thrift.TProtocol) error {
+	_field := common.NewBaseInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseInfo = _field
 	return nil
 }
 
-func (p *EvaluatorResult_) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorCalibrationRecord) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorResult"); err != nil {
+	if err = oprot.WriteStructBegin("EvaluatorCalibrationRecord"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11307,6 +19594,42 @@ func (p *EvaluatorResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -11325,12 +19648,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetScore() {
-		if err = oprot.WriteFieldBegin("score", thrift.DOUBLE, 1); err != nil {
+func (p *EvaluatorCalibrationRecord) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRecordID() {
+		if err = oprot.WriteFieldBegin("record_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteDouble(*p.Score); err != nil {
+		if err := oprot.WriteI64(*p.RecordID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -11343,12 +19666,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCorrection() {
-		if err = oprot.WriteFieldBegin("correction", thrift.STRUCT, 2); err != nil {
+func (p *EvaluatorCalibrationRecord) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Correction.Write(oprot); err != nil {
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -11361,12 +19684,174 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *EvaluatorResult_) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetReasoning() {
-		if err = oprot.WriteFieldBegin("reasoning", thrift.STRING, 3); err != nil {
+func (p *EvaluatorCalibrationRecord) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorID() {
+		if err = oprot.WriteFieldBegin("evaluator_id", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluatorID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluatorCalibrationRecord) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersionID() {
+		if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluatorVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluatorCalibrationRecord) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersion() {
+		if err = oprot.WriteFieldBegin("evaluator_version", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.EvaluatorVersion); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvaluatorCalibrationRecord) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluationSetID() {
+		if err = oprot.WriteFieldBegin("evaluation_set_id", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluationSetID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *EvaluatorCalibrationRecord) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluationSetVersionID() {
+		if err = oprot.WriteFieldBegin("evaluation_set_version_id", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluationSetVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *EvaluatorCalibrationRecord) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *EvaluatorCalibrationRecord) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConf() {
+		if err = oprot.WriteFieldBegin("conf", thrift.STRUCT, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Conf.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *EvaluatorCalibrationRecord) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResult_() {
+		if err = oprot.WriteFieldBegin("result", thrift.STRUCT, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Result_.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *EvaluatorCalibrationRecord) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrorMsg() {
+		if err = oprot.WriteFieldBegin("error_msg", thrift.STRING, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ErrorMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *EvaluatorCalibrationRecord) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseInfo() {
+		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 12); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Reasoning); err != nil {
+		if err := p.BaseInfo.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -11375,383 +19860,403 @@ func (p *EvaluatorResult_) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
 }
 
-func (p *EvaluatorResult_) String() string {
+func (p *EvaluatorCalibrationRecord) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorResult_(%+v)", *p)
+	return fmt.Sprintf("EvaluatorCalibrationRecord(%+v)", *p)
 
 }
 
-func (p *EvaluatorResult_) DeepEqual(ano *EvaluatorResult_) bool {
+func (p *EvaluatorCalibrationRecord) DeepEqual(ano *EvaluatorCalibrationRecord) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Score) {
+	if !p.Field1DeepEqual(ano.RecordID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Correction) {
+	if !p.Field2DeepEqual(ano.WorkspaceID) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Reasoning) {
+	if !p.Field3DeepEqual(ano.EvaluatorID) {
 		return false
 	}
-	return true
-}
-
-func (p *EvaluatorResult_) Field1DeepEqual(src *float64) bool {
-
-	if p.Score == src {
-		return true
-	} else if p.Score == nil || src == nil {
+	if !p.Field4DeepEqual(ano.EvaluatorVersionID) {
 		return false
 	}
-	if *p.Score != *src {
+	if !p.Field5DeepEqual(ano.EvaluatorVersion) {
 		return false
 	}
-	return true
-}
-func (p *EvaluatorResult_) Field2DeepEqual(src *Correction) bool {
-
-	if !p.Correction.DeepEqual(src) {
+	if !p.Field6DeepEqual(ano.EvaluationSetID) {
 		return false
 	}
-	return true
-}
-func (p *EvaluatorResult_) Field3DeepEqual(src *string) bool {
-
-	if p.Reasoning == src {
-		return true
-	} else if p.Reasoning == nil || src == nil {
+	if !p.Field7DeepEqual(ano.EvaluationSetVersionID) {
 		return false
 	}
-	if strings.Compare(*p.Reasoning, *src) != 0 {
+	if !p.Field8DeepEqual(ano.Status) {
 		return false
 	}
-	return true
-}
-
-type EvaluatorUsage struct {
-	InputTokens  *int64 `thrift:"input_tokens,1,optional" frugal:"1,optional,i64" json:"input_tokens" form:"input_tokens" query:"input_tokens"`
-	OutputTokens *int64 `thrift:"output_tokens,2,optional" frugal:"2,optional,i64" json:"output_tokens" form:"output_tokens" query:"output_tokens"`
-}
-
-func NewEvaluatorUsage() *EvaluatorUsage {
-	return &EvaluatorUsage{}
-}
-
-func (p *EvaluatorUsage) InitDefault() {
-}
-
-var EvaluatorUsage_InputTokens_DEFAULT int64
-
-func (p *EvaluatorUsage) GetInputTokens() (v int64) {
-	if p == nil {
-		return
+	if !p.Field9DeepEqual(ano.Conf) {
+		return false
 	}
-	if !p.IsSetInputTokens() {
-		return EvaluatorUsage_InputTokens_DEFAULT
+	if !p.Field10DeepEqual(ano.Result_) {
+		return false
 	}
-	return *p.InputTokens
-}
-
-var EvaluatorUsage_OutputTokens_DEFAULT int64
-
-func (p *EvaluatorUsage) GetOutputTokens() (v int64) {
-	if p == nil {
-		return
+	if !p.Field11DeepEqual(ano.ErrorMsg) {
+		return false
 	}
-	if !p.IsSetOutputTokens() {
-		return EvaluatorUsage_OutputTokens_DEFAULT
+	if !p.Field12DeepEqual(ano.BaseInfo) {
+		return false
 	}
-	return *p.OutputTokens
-}
-func (p *EvaluatorUsage) SetInputTokens(val *int64) {
-	p.InputTokens = val
-}
-func (p *EvaluatorUsage) SetOutputTokens(val *int64) {
-	p.OutputTokens = val
-}
-
-var fieldIDToName_EvaluatorUsage = map[int16]string{
-	1: "input_tokens",
-	2: "output_tokens",
-}
-
-func (p *EvaluatorUsage) IsSetInputTokens() bool {
-	return p.InputTokens != nil
-}
-
-func (p *EvaluatorUsage) IsSetOutputTokens() bool {
-	return p.OutputTokens != nil
+	return true
 }
 
-func (p *EvaluatorUsage) Read(iprot thrift.TProtocol) (err error) {
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
+func (p *EvaluatorCalibrationRecord) Field1DeepEqual(src *int64) bool {
 
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
+	if p.RecordID == src {
+		return true
+	} else if p.RecordID == nil || src == nil {
+		return false
 	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	if *p.RecordID != *src {
+		return false
 	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorUsage[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	return true
 }
+func (p *EvaluatorCalibrationRecord) Field2DeepEqual(src *int64) bool {
 
-func (p *EvaluatorUsage) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
 	}
-	p.InputTokens = _field
-	return nil
-}
-func (p *EvaluatorUsage) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
+	if *p.WorkspaceID != *src {
+		return false
 	}
-	p.OutputTokens = _field
-	return nil
+	return true
 }
+func (p *EvaluatorCalibrationRecord) Field3DeepEqual(src *int64) bool {
 
-func (p *EvaluatorUsage) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorUsage"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
+	if p.EvaluatorID == src {
+		return true
+	} else if p.EvaluatorID == nil || src == nil {
+		return false
 	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	if *p.EvaluatorID != *src {
+		return false
 	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+	return true
 }
+func (p *EvaluatorCalibrationRecord) Field4DeepEqual(src *int64) bool {
 
-func (p *EvaluatorUsage) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetInputTokens() {
-		if err = oprot.WriteFieldBegin("input_tokens", thrift.I64, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.InputTokens); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if p.EvaluatorVersionID == src {
+		return true
+	} else if p.EvaluatorVersionID == nil || src == nil {
+		return false
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *EvaluatorUsage) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOutputTokens() {
-		if err = oprot.WriteFieldBegin("output_tokens", thrift.I64, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.OutputTokens); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if *p.EvaluatorVersionID != *src {
+		return false
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return true
 }
+func (p *EvaluatorCalibrationRecord) Field5DeepEqual(src *string) bool {
 
-func (p *EvaluatorUsage) String() string {
-	if p == nil {
-		return "<nil>"
+	if p.EvaluatorVersion == src {
+		return true
+	} else if p.EvaluatorVersion == nil || src == nil {
+		return false
 	}
-	return fmt.Sprintf("EvaluatorUsage(%+v)", *p)
-
+	if strings.Compare(*p.EvaluatorVersion, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *EvaluatorCalibrationRecord) Field6DeepEqual(src *int64) bool {
 
-func (p *EvaluatorUsage) DeepEqual(ano *EvaluatorUsage) bool {
-	if p == ano {
+	if p.EvaluationSetID == src {
 		return true
-	} else if p == nil || ano == nil {
+	} else if p.EvaluationSetID == nil || src == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.InputTokens) {
+	if *p.EvaluationSetID != *src {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.OutputTokens) {
+	return true
+}
+func (p *EvaluatorCalibrationRecord) Field7DeepEqual(src *int64) bool {
+
+	if p.EvaluationSetVersionID == src {
+		return true
+	} else if p.EvaluationSetVersionID == nil || src == nil {
+		return false
+	}
+	if *p.EvaluationSetVersionID != *src {
 		return false
 	}
 	return true
 }
+func (p *EvaluatorCalibrationRecord) Field8DeepEqual(src *EvaluatorCalibrationStatus) bool {
 
-func (p *EvaluatorUsage) Field1DeepEqual(src *int64) bool {
-
-	if p.InputTokens == src {
+	if p.Status == src {
 		return true
-	} else if p.InputTokens == nil || src == nil {
+	} else if p.Status == nil || src == nil {
 		return false
 	}
-	if *p.InputTokens != *src {
+	if strings.Compare(*p.Status, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorUsage) Field2DeepEqual(src *int64) bool {
+func (p *EvaluatorCalibrationRecord) Field9DeepEqual(src *EvaluatorCalibrationConf) bool {
 
-	if p.OutputTokens == src {
+	if !p.Conf.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCalibrationRecord) Field10DeepEqual(src *EvaluatorCalibrationResult_) bool {
+
+	if !p.Result_.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorCalibrationRecord) Field11DeepEqual(src *string) bool {
+
+	if p.ErrorMsg == src {
 		return true
-	} else if p.OutputTokens == nil || src == nil {
+	} else if p.ErrorMsg == nil || src == nil {
 		return false
 	}
-	if *p.OutputTokens != *src {
+	if strings.Compare(*p.ErrorMsg, *src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *EvaluatorCalibrationRecord) Field12DeepEqual(src *common.BaseInfo) bool {
 
-type EvaluatorRunError struct {
-	Code    *int32  `thrift:"code,1,optional" frugal:"1,optional,i32" form:"code" json:"code,omitempty" query:"code"`
-	Message *string `thrift:"message,2,optional" frugal:"2,optional,string" form:"message" json:"message,omitempty" query:"message"`
+	if !p.BaseInfo.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func NewEvaluatorRunError() *EvaluatorRunError {
-	return &EvaluatorRunError{}
+// 版本提交前的回归检查配置: 在基线版本最近的历史记录输入上回放草稿并与基线得分比较, 阈值为空表示不检查该项
+type EvaluatorPromotionCheckConf struct {
+	// 基线版本号, 为空取评估器当前最新版本
+	BaseVersion *string `thrift:"base_version,1,optional" frugal:"1,optional,string" form:"base_version" json:"base_version,omitempty" query:"base_version"`
+	// 默认 Block
+	Action *EvaluatorPromotionAction `thrift:"action,2,optional" frugal:"2,optional,string" form:"action" json:"action,omitempty" query:"action"`
+	// 回放的历史记录数, 默认 50, 上限 200
+	SampleSize *int32 `thrift:"sample_size,3,optional" frugal:"3,optional,i32" form:"sample_size" json:"sample_size,omitempty" query:"sample_size"`
+	// 得分不低于该值视为通过, 前后版本通过与否不同即为翻转; 为空时得分有任何变化即视为翻转
+	PassThreshold    *float64 `thrift:"pass_threshold,4,optional" frugal:"4,optional,double" form:"pass_threshold" json:"pass_threshold,omitempty" query:"pass_threshold"`
+	MaxMeanAbsDrift  *float64 `thrift:"max_mean_abs_drift,10,optional" frugal:"10,optional,double" form:"max_mean_abs_drift" json:"max_mean_abs_drift,omitempty" query:"max_mean_abs_drift"`
+	MaxFlipRate      *float64 `thrift:"max_flip_rate,11,optional" frugal:"11,optional,double" form:"max_flip_rate" json:"max_flip_rate,omitempty" query:"max_flip_rate"`
+	MaxDraftFailRate *float64 `thrift:"max_draft_fail_rate,12,optional" frugal:"12,optional,double" form:"max_draft_fail_rate" json:"max_draft_fail_rate,omitempty" query:"max_draft_fail_rate"`
+	// 如 0.5 表示平均耗时最多增加 50%
+	MaxLatencyIncreaseRatio *float64 `thrift:"max_latency_increase_ratio,13,optional" frugal:"13,optional,double" form:"max_latency_increase_ratio" json:"max_latency_increase_ratio,omitempty" query:"max_latency_increase_ratio"`
+	MaxTokenIncreaseRatio   *float64 `thrift:"max_token_increase_ratio,14,optional" frugal:"14,optional,double" form:"max_token_increase_ratio" json:"max_token_increase_ratio,omitempty" query:"max_token_increase_ratio"`
 }
 
-func (p *EvaluatorRunError) InitDefault() {
+func NewEvaluatorPromotionCheckConf() *EvaluatorPromotionCheckConf {
+	return &EvaluatorPromotionCheckConf{}
 }
 
-var EvaluatorRunError_Code_DEFAULT int32
+func (p *EvaluatorPromotionCheckConf) InitDefault() {
+}
 
-func (p *EvaluatorRunError) GetCode() (v int32) {
+var EvaluatorPromotionCheckConf_BaseVersion_DEFAULT string
+
+func (p *EvaluatorPromotionCheckConf) GetBaseVersion() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetCode() {
-		return EvaluatorRunError_Code_DEFAULT
+	if !p.IsSetBaseVersion() {
+		return EvaluatorPromotionCheckConf_BaseVersion_DEFAULT
 	}
-	return *p.Code
+	return *p.BaseVersion
 }
 
-var EvaluatorRunError_Message_DEFAULT string
+var EvaluatorPromotionCheckConf_Action_DEFAULT EvaluatorPromotionAction
 
-func (p *EvaluatorRunError) GetMessage() (v string) {
+func (p *EvaluatorPromotionCheckConf) GetAction() (v EvaluatorPromotionAction) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetMessage() {
-		return EvaluatorRunError_Message_DEFAULT
+	if !p.IsSetAction() {
+		return EvaluatorPromotionCheckConf_Action_DEFAULT
 	}
-	return *p.Message
+	return *p.Action
 }
-func (p *EvaluatorRunError) SetCode(val *int32) {
-	p.Code = val
+
+var EvaluatorPromotionCheckConf_SampleSize_DEFAULT int32
+
+func (p *EvaluatorPromotionCheckConf) GetSampleSize() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSampleSize() {
+		return EvaluatorPromotionCheckConf_SampleSize_DEFAULT
+	}
+	return *p.SampleSize
 }
-func (p *EvaluatorRunError) SetMessage(val *string) {
-	p.Message = val
+
+var EvaluatorPromotionCheckConf_PassThreshold_DEFAULT float64
+
+func (p *EvaluatorPromotionCheckConf) GetPassThreshold() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPassThreshold() {
+		return EvaluatorPromotionCheckConf_PassThreshold_DEFAULT
+	}
+	return *p.PassThreshold
 }
 
-var fieldIDToName_EvaluatorRunError = map[int16]string{
-	1: "code",
-	2: "message",
+var EvaluatorPromotionCheckConf_MaxMeanAbsDrift_DEFAULT float64
+
+func (p *EvaluatorPromotionCheckConf) GetMaxMeanAbsDrift() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMaxMeanAbsDrift() {
+		return EvaluatorPromotionCheckConf_MaxMeanAbsDrift_DEFAULT
+	}
+	return *p.MaxMeanAbsDrift
 }
 
-func (p *EvaluatorRunError) IsSetCode() bool {
-	return p.Code != nil
+var EvaluatorPromotionCheckConf_MaxFlipRate_DEFAULT float64
+
+func (p *EvaluatorPromotionCheckConf) GetMaxFlipRate() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMaxFlipRate() {
+		return EvaluatorPromotionCheckConf_MaxFlipRate_DEFAULT
+	}
+	return *p.MaxFlipRate
 }
 
-func (p *EvaluatorRunError) IsSetMessage() bool {
-	return p.Message != nil
+var EvaluatorPromotionCheckConf_MaxDraftFailRate_DEFAULT float64
+
+func (p *EvaluatorPromotionCheckConf) GetMaxDraftFailRate() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMaxDraftFailRate() {
+		return EvaluatorPromotionCheckConf_MaxDraftFailRate_DEFAULT
+	}
+	return *p.MaxDraftFailRate
 }
 
-func (p *EvaluatorRunError) Read(iprot thrift.TProtocol) (err error) {
+var EvaluatorPromotionCheckConf_MaxLatencyIncreaseRatio_DEFAULT float64
+
+func (p *EvaluatorPromotionCheckConf) GetMaxLatencyIncreaseRatio() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMaxLatencyIncreaseRatio() {
+		return EvaluatorPromotionCheckConf_MaxLatencyIncreaseRatio_DEFAULT
+	}
+	return *p.MaxLatencyIncreaseRatio
+}
+
+var EvaluatorPromotionCheckConf_MaxTokenIncreaseRatio_DEFAULT float64
+
+func (p *EvaluatorPromotionCheckConf) GetMaxTokenIncreaseRatio() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMaxTokenIncreaseRatio() {
+		return EvaluatorPromotionCheckConf_MaxTokenIncreaseRatio_DEFAULT
+	}
+	return *p.MaxTokenIncreaseRatio
+}
+func (p *EvaluatorPromotionCheckConf) SetBaseVersion(val *string) {
+	p.BaseVersion = val
+}
+func (p *EvaluatorPromotionCheckConf) SetAction(val *EvaluatorPromotionAction) {
+	p.Action = val
+}
+func (p *EvaluatorPromotionCheckConf) SetSampleSize(val *int32) {
+	p.SampleSize = val
+}
+func (p *EvaluatorPromotionCheckConf) SetPassThreshold(val *float64) {
+	p.PassThreshold = val
+}
+func (p *EvaluatorPromotionCheckConf) SetMaxMeanAbsDrift(val *float64) {
+	p.MaxMeanAbsDrift = val
+}
+func (p *EvaluatorPromotionCheckConf) SetMaxFlipRate(val *float64) {
+	p.MaxFlipRate = val
+}
+func (p *EvaluatorPromotionCheckConf) SetMaxDraftFailRate(val *float64) {
+	p.MaxDraftFailRate = val
+}
+func (p *EvaluatorPromotionCheckConf) SetMaxLatencyIncreaseRatio(val *float64) {
+	p.MaxLatencyIncreaseRatio = val
+}
+func (p *EvaluatorPromotionCheckConf) SetMaxTokenIncreaseRatio(val *float64) {
+	p.MaxTokenIncreaseRatio = val
+}
+
+var fieldIDToName_EvaluatorPromotionCheckConf = map[int16]string{
+	1:  "base_version",
+	2:  "action",
+	3:  "sample_size",
+	4:  "pass_threshold",
+	10: "max_mean_abs_drift",
+	11: "max_flip_rate",
+	12: "max_draft_fail_rate",
+	13: "max_latency_increase_ratio",
+	14: "max_token_increase_ratio",
+}
+
+func (p *EvaluatorPromotionCheckConf) IsSetBaseVersion() bool {
+	return p.BaseVersion != nil
+}
+
+func (p *EvaluatorPromotionCheckConf) IsSetAction() bool {
+	return p.Action != nil
+}
+
+func (p *EvaluatorPromotionCheckConf) IsSetSampleSize() bool {
+	return p.SampleSize != nil
+}
+
+func (p *EvaluatorPromotionCheckConf) IsSetPassThreshold() bool {
+	return p.PassThreshold != nil
+}
+
+func (p *EvaluatorPromotionCheckConf) IsSetMaxMeanAbsDrift() bool {
+	return p.MaxMeanAbsDrift != nil
+}
+
+func (p *EvaluatorPromotionCheckConf) IsSetMaxFlipRate() bool {
+	return p.MaxFlipRate != nil
+}
+
+func (p *EvaluatorPromotionCheckConf) IsSetMaxDraftFailRate() bool {
+	return p.MaxDraftFailRate != nil
+}
+
+func (p *EvaluatorPromotionCheckConf) IsSetMaxLatencyIncreaseRatio() bool {
+	return p.MaxLatencyIncreaseRatio != nil
+}
+
+func (p *EvaluatorPromotionCheckConf) IsSetMaxTokenIncreaseRatio() bool {
+	return p.MaxTokenIncreaseRatio != nil
+}
+
+func (p *EvaluatorPromotionCheckConf) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -11770,7 +20275,7 @@ func (p *EvaluatorRunError) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -11785,6 +20290,62 @@ func (p *EvaluatorRunError) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -11804,7 +20365,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorRunError[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorPromotionCheckConf[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11814,32 +20375,109 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorRunError) ReadField1(iprot thrift.TProtocol) error {
+func (p *EvaluatorPromotionCheckConf) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaseVersion = _field
+	return nil
+}
+func (p *EvaluatorPromotionCheckConf) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *EvaluatorPromotionAction
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Action = _field
+	return nil
+}
+func (p *EvaluatorPromotionCheckConf) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SampleSize = _field
+	return nil
+}
+func (p *EvaluatorPromotionCheckConf) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PassThreshold = _field
+	return nil
+}
+func (p *EvaluatorPromotionCheckConf) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxMeanAbsDrift = _field
+	return nil
+}
+func (p *EvaluatorPromotionCheckConf) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxFlipRate = _field
+	return nil
+}
+func (p *EvaluatorPromotionCheckConf) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxDraftFailRate = _field
+	return nil
+}
+func (p *EvaluatorPromotionCheckConf) ReadField13(iprot thrift.TProtocol) error {
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Code = _field
+	p.MaxLatencyIncreaseRatio = _field
 	return nil
 }
-func (p *EvaluatorRunError) ReadField2(iprot thrift.TProtocol) error {
+func (p *EvaluatorPromotionCheckConf) ReadField14(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Message = _field
+	p.MaxTokenIncreaseRatio = _field
 	return nil
 }
 
-func (p *EvaluatorRunError) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorPromotionCheckConf) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorRunError"); err != nil {
+	if err = oprot.WriteStructBegin("EvaluatorPromotionCheckConf"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11851,6 +20489,34 @@ func (p *EvaluatorRunError) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -11869,12 +20535,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorRunError) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCode() {
-		if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+func (p *EvaluatorPromotionCheckConf) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseVersion() {
+		if err = oprot.WriteFieldBegin("base_version", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(*p.Code); err != nil {
+		if err := oprot.WriteString(*p.BaseVersion); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -11887,12 +20553,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorRunError) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMessage() {
-		if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+func (p *EvaluatorPromotionCheckConf) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAction() {
+		if err = oprot.WriteFieldBegin("action", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Message); err != nil {
+		if err := oprot.WriteString(*p.Action); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -11905,132 +20571,364 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *EvaluatorPromotionCheckConf) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSampleSize() {
+		if err = oprot.WriteFieldBegin("sample_size", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.SampleSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluatorPromotionCheckConf) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPassThreshold() {
+		if err = oprot.WriteFieldBegin("pass_threshold", thrift.DOUBLE, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.PassThreshold); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluatorPromotionCheckConf) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxMeanAbsDrift() {
+		if err = oprot.WriteFieldBegin("max_mean_abs_drift", thrift.DOUBLE, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MaxMeanAbsDrift); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *EvaluatorPromotionCheckConf) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxFlipRate() {
+		if err = oprot.WriteFieldBegin("max_flip_rate", thrift.DOUBLE, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MaxFlipRate); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *EvaluatorPromotionCheckConf) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxDraftFailRate() {
+		if err = oprot.WriteFieldBegin("max_draft_fail_rate", thrift.DOUBLE, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MaxDraftFailRate); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *EvaluatorPromotionCheckConf) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxLatencyIncreaseRatio() {
+		if err = oprot.WriteFieldBegin("max_latency_increase_ratio", thrift.DOUBLE, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MaxLatencyIncreaseRatio); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+func (p *EvaluatorPromotionCheckConf) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxTokenIncreaseRatio() {
+		if err = oprot.WriteFieldBegin("max_token_increase_ratio", thrift.DOUBLE, 14); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MaxTokenIncreaseRatio); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
 
-func (p *EvaluatorRunError) String() string {
+func (p *EvaluatorPromotionCheckConf) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorRunError(%+v)", *p)
+	return fmt.Sprintf("EvaluatorPromotionCheckConf(%+v)", *p)
 
 }
 
-func (p *EvaluatorRunError) DeepEqual(ano *EvaluatorRunError) bool {
+func (p *EvaluatorPromotionCheckConf) DeepEqual(ano *EvaluatorPromotionCheckConf) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Code) {
+	if !p.Field1DeepEqual(ano.BaseVersion) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Message) {
+	if !p.Field2DeepEqual(ano.Action) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.SampleSize) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.PassThreshold) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.MaxMeanAbsDrift) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.MaxFlipRate) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.MaxDraftFailRate) {
+		return false
+	}
+	if !p.Field13DeepEqual(ano.MaxLatencyIncreaseRatio) {
+		return false
+	}
+	if !p.Field14DeepEqual(ano.MaxTokenIncreaseRatio) {
 		return false
 	}
 	return true
 }
 
-func (p *EvaluatorRunError) Field1DeepEqual(src *int32) bool {
+func (p *EvaluatorPromotionCheckConf) Field1DeepEqual(src *string) bool {
 
-	if p.Code == src {
+	if p.BaseVersion == src {
 		return true
-	} else if p.Code == nil || src == nil {
+	} else if p.BaseVersion == nil || src == nil {
 		return false
 	}
-	if *p.Code != *src {
+	if strings.Compare(*p.BaseVersion, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorRunError) Field2DeepEqual(src *string) bool {
+func (p *EvaluatorPromotionCheckConf) Field2DeepEqual(src *EvaluatorPromotionAction) bool {
 
-	if p.Message == src {
+	if p.Action == src {
 		return true
-	} else if p.Message == nil || src == nil {
+	} else if p.Action == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Message, *src) != 0 {
+	if strings.Compare(*p.Action, *src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *EvaluatorPromotionCheckConf) Field3DeepEqual(src *int32) bool {
 
-type EvaluatorExtraOutputContent struct {
-	OutputType *EvaluatorExtraOutputType `thrift:"output_type,1,optional" frugal:"1,optional,string" form:"output_type" json:"output_type,omitempty" query:"output_type"`
-	URI        *string                   `thrift:"uri,2,optional" frugal:"2,optional,string" form:"uri" json:"uri,omitempty" query:"uri"`
-	URL        *string                   `thrift:"url,3,optional" frugal:"3,optional,string" form:"url" json:"url,omitempty" query:"url"`
+	if p.SampleSize == src {
+		return true
+	} else if p.SampleSize == nil || src == nil {
+		return false
+	}
+	if *p.SampleSize != *src {
+		return false
+	}
+	return true
 }
+func (p *EvaluatorPromotionCheckConf) Field4DeepEqual(src *float64) bool {
 
-func NewEvaluatorExtraOutputContent() *EvaluatorExtraOutputContent {
-	return &EvaluatorExtraOutputContent{}
+	if p.PassThreshold == src {
+		return true
+	} else if p.PassThreshold == nil || src == nil {
+		return false
+	}
+	if *p.PassThreshold != *src {
+		return false
+	}
+	return true
 }
+func (p *EvaluatorPromotionCheckConf) Field10DeepEqual(src *float64) bool {
 
-func (p *EvaluatorExtraOutputContent) InitDefault() {
+	if p.MaxMeanAbsDrift == src {
+		return true
+	} else if p.MaxMeanAbsDrift == nil || src == nil {
+		return false
+	}
+	if *p.MaxMeanAbsDrift != *src {
+		return false
+	}
+	return true
 }
+func (p *EvaluatorPromotionCheckConf) Field11DeepEqual(src *float64) bool {
 
-var EvaluatorExtraOutputContent_OutputType_DEFAULT EvaluatorExtraOutputType
+	if p.MaxFlipRate == src {
+		return true
+	} else if p.MaxFlipRate == nil || src == nil {
+		return false
+	}
+	if *p.MaxFlipRate != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionCheckConf) Field12DeepEqual(src *float64) bool {
 
-func (p *EvaluatorExtraOutputContent) GetOutputType() (v EvaluatorExtraOutputType) {
+	if p.MaxDraftFailRate == src {
+		return true
+	} else if p.MaxDraftFailRate == nil || src == nil {
+		return false
+	}
+	if *p.MaxDraftFailRate != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionCheckConf) Field13DeepEqual(src *float64) bool {
+
+	if p.MaxLatencyIncreaseRatio == src {
+		return true
+	} else if p.MaxLatencyIncreaseRatio == nil || src == nil {
+		return false
+	}
+	if *p.MaxLatencyIncreaseRatio != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionCheckConf) Field14DeepEqual(src *float64) bool {
+
+	if p.MaxTokenIncreaseRatio == src {
+		return true
+	} else if p.MaxTokenIncreaseRatio == nil || src == nil {
+		return false
+	}
+	if *p.MaxTokenIncreaseRatio != *src {
+		return false
+	}
+	return true
+}
+
+type EvaluatorPromotionViolation struct {
+	// mean_abs_drift / flip_rate / draft_fail_rate / latency_increase_ratio / token_increase_ratio
+	Metric    *string  `thrift:"metric,1,optional" frugal:"1,optional,string" form:"metric" json:"metric,omitempty" query:"metric"`
+	Value     *float64 `thrift:"value,2,optional" frugal:"2,optional,double" form:"value" json:"value,omitempty" query:"value"`
+	Threshold *float64 `thrift:"threshold,3,optional" frugal:"3,optional,double" form:"threshold" json:"threshold,omitempty" query:"threshold"`
+}
+
+func NewEvaluatorPromotionViolation() *EvaluatorPromotionViolation {
+	return &EvaluatorPromotionViolation{}
+}
+
+func (p *EvaluatorPromotionViolation) InitDefault() {
+}
+
+var EvaluatorPromotionViolation_Metric_DEFAULT string
+
+func (p *EvaluatorPromotionViolation) GetMetric() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetOutputType() {
-		return EvaluatorExtraOutputContent_OutputType_DEFAULT
+	if !p.IsSetMetric() {
+		return EvaluatorPromotionViolation_Metric_DEFAULT
 	}
-	return *p.OutputType
+	return *p.Metric
 }
 
-var EvaluatorExtraOutputContent_URI_DEFAULT string
+var EvaluatorPromotionViolation_Value_DEFAULT float64
 
-func (p *EvaluatorExtraOutputContent) GetURI() (v string) {
+func (p *EvaluatorPromotionViolation) GetValue() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetURI() {
-		return EvaluatorExtraOutputContent_URI_DEFAULT
+	if !p.IsSetValue() {
+		return EvaluatorPromotionViolation_Value_DEFAULT
 	}
-	return *p.URI
+	return *p.Value
 }
 
-var EvaluatorExtraOutputContent_URL_DEFAULT string
+var EvaluatorPromotionViolation_Threshold_DEFAULT float64
 
-func (p *EvaluatorExtraOutputContent) GetURL() (v string) {
+func (p *EvaluatorPromotionViolation) GetThreshold() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetURL() {
-		return EvaluatorExtraOutputContent_URL_DEFAULT
+	if !p.IsSetThreshold() {
+		return EvaluatorPromotionViolation_Threshold_DEFAULT
 	}
-	return *p.URL
+	return *p.Threshold
 }
-func (p *EvaluatorExtraOutputContent) SetOutputType(val *EvaluatorExtraOutputType) {
-	p.OutputType = val
+func (p *EvaluatorPromotionViolation) SetMetric(val *string) {
+	p.Metric = val
 }
-func (p *EvaluatorExtraOutputContent) SetURI(val *string) {
-	p.URI = val
+func (p *EvaluatorPromotionViolation) SetValue(val *float64) {
+	p.Value = val
 }
-func (p *EvaluatorExtraOutputContent) SetURL(val *string) {
-	p.URL = val
+func (p *EvaluatorPromotionViolation) SetThreshold(val *float64) {
+	p.Threshold = val
 }
 
-var fieldIDToName_EvaluatorExtraOutputContent = map[int16]string{
-	1: "output_type",
-	2: "uri",
-	3: "url",
+var fieldIDToName_EvaluatorPromotionViolation = map[int16]string{
+	1: "metric",
+	2: "value",
+	3: "threshold",
 }
 
-func (p *EvaluatorExtraOutputContent) IsSetOutputType() bool {
-	return p.OutputType != nil
+func (p *EvaluatorPromotionViolation) IsSetMetric() bool {
+	return p.Metric != nil
 }
 
-func (p *EvaluatorExtraOutputContent) IsSetURI() bool {
-	return p.URI != nil
+func (p *EvaluatorPromotionViolation) IsSetValue() bool {
+	return p.Value != nil
 }
 
-func (p *EvaluatorExtraOutputContent) IsSetURL() bool {
-	return p.URL != nil
+func (p *EvaluatorPromotionViolation) IsSetThreshold() bool {
+	return p.Threshold != nil
 }
 
-func (p *EvaluatorExtraOutputContent) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorPromotionViolation) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -12057,7 +20955,7 @@ func (p *EvaluatorExtraOutputContent) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -12065,7 +20963,7 @@ func (p *EvaluatorExtraOutputContent) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -12091,7 +20989,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorExtraOutputContent[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorPromotionViolation[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12101,43 +20999,43 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorExtraOutputContent) ReadField1(iprot thrift.TProtocol) error {
+func (p *EvaluatorPromotionViolation) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *EvaluatorExtraOutputType
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.OutputType = _field
+	p.Metric = _field
 	return nil
 }
-func (p *EvaluatorExtraOutputContent) ReadField2(iprot thrift.TProtocol) error {
+func (p *EvaluatorPromotionViolation) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.URI = _field
+	p.Value = _field
 	return nil
 }
-func (p *EvaluatorExtraOutputContent) ReadField3(iprot thrift.TProtocol) error {
+func (p *EvaluatorPromotionViolation) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.URL = _field
+	p.Threshold = _field
 	return nil
 }
 
-func (p *EvaluatorExtraOutputContent) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorPromotionViolation) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorExtraOutputContent"); err != nil {
+	if err = oprot.WriteStructBegin("EvaluatorPromotionViolation"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12171,12 +21069,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorExtraOutputContent) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOutputType() {
-		if err = oprot.WriteFieldBegin("output_type", thrift.STRING, 1); err != nil {
+func (p *EvaluatorPromotionViolation) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMetric() {
+		if err = oprot.WriteFieldBegin("metric", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.OutputType); err != nil {
+		if err := oprot.WriteString(*p.Metric); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -12189,12 +21087,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorExtraOutputContent) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetURI() {
-		if err = oprot.WriteFieldBegin("uri", thrift.STRING, 2); err != nil {
+func (p *EvaluatorPromotionViolation) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetValue() {
+		if err = oprot.WriteFieldBegin("value", thrift.DOUBLE, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.URI); err != nil {
+		if err := oprot.WriteDouble(*p.Value); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -12207,12 +21105,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *EvaluatorExtraOutputContent) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetURL() {
-		if err = oprot.WriteFieldBegin("url", thrift.STRING, 3); err != nil {
+func (p *EvaluatorPromotionViolation) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetThreshold() {
+		if err = oprot.WriteFieldBegin("threshold", thrift.DOUBLE, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.URL); err != nil {
+		if err := oprot.WriteDouble(*p.Threshold); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -12226,188 +21124,251 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *EvaluatorExtraOutputContent) String() string {
+func (p *EvaluatorPromotionViolation) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorExtraOutputContent(%+v)", *p)
+	return fmt.Sprintf("EvaluatorPromotionViolation(%+v)", *p)
 
 }
 
-func (p *EvaluatorExtraOutputContent) DeepEqual(ano *EvaluatorExtraOutputContent) bool {
+func (p *EvaluatorPromotionViolation) DeepEqual(ano *EvaluatorPromotionViolation) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.OutputType) {
+	if !p.Field1DeepEqual(ano.Metric) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.URI) {
+	if !p.Field2DeepEqual(ano.Value) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.URL) {
+	if !p.Field3DeepEqual(ano.Threshold) {
 		return false
 	}
 	return true
 }
 
-func (p *EvaluatorExtraOutputContent) Field1DeepEqual(src *EvaluatorExtraOutputType) bool {
+func (p *EvaluatorPromotionViolation) Field1DeepEqual(src *string) bool {
 
-	if p.OutputType == src {
+	if p.Metric == src {
 		return true
-	} else if p.OutputType == nil || src == nil {
+	} else if p.Metric == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.OutputType, *src) != 0 {
+	if strings.Compare(*p.Metric, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorExtraOutputContent) Field2DeepEqual(src *string) bool {
+func (p *EvaluatorPromotionViolation) Field2DeepEqual(src *float64) bool {
 
-	if p.URI == src {
+	if p.Value == src {
 		return true
-	} else if p.URI == nil || src == nil {
+	} else if p.Value == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.URI, *src) != 0 {
+	if *p.Value != *src {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorExtraOutputContent) Field3DeepEqual(src *string) bool {
+func (p *EvaluatorPromotionViolation) Field3DeepEqual(src *float64) bool {
 
-	if p.URL == src {
+	if p.Threshold == src {
 		return true
-	} else if p.URL == nil || src == nil {
+	} else if p.Threshold == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.URL, *src) != 0 {
+	if *p.Threshold != *src {
 		return false
 	}
 	return true
 }
 
-type EvaluatorInputData struct {
-	HistoryMessages            []*common.Message          `thrift:"history_messages,1,optional" frugal:"1,optional,list<common.Message>" form:"history_messages" json:"history_messages,omitempty" query:"history_messages"`
-	InputFields                map[string]*common.Content `thrift:"input_fields,2,optional" frugal:"2,optional,map<string:common.Content>" form:"input_fields" json:"input_fields,omitempty" query:"input_fields"`
-	EvaluateDatasetFields      map[string]*common.Content `thrift:"evaluate_dataset_fields,3,optional" frugal:"3,optional,map<string:common.Content>" form:"evaluate_dataset_fields" json:"evaluate_dataset_fields,omitempty" query:"evaluate_dataset_fields"`
-	EvaluateTargetOutputFields map[string]*common.Content `thrift:"evaluate_target_output_fields,4,optional" frugal:"4,optional,map<string:common.Content>" form:"evaluate_target_output_fields" json:"evaluate_target_output_fields,omitempty" query:"evaluate_target_output_fields"`
-	Ext                        map[string]string          `thrift:"ext,100,optional" frugal:"100,optional,map<string:string>" form:"ext" json:"ext,omitempty" query:"ext"`
+type EvaluatorPromotionDriftItem struct {
+	RecordID       *int64   `thrift:"record_id,1,optional" frugal:"1,optional,i64" json:"record_id" form:"record_id" query:"record_id"`
+	ItemID         *int64   `thrift:"item_id,2,optional" frugal:"2,optional,i64" json:"item_id" form:"item_id" query:"item_id"`
+	TurnID         *int64   `thrift:"turn_id,3,optional" frugal:"3,optional,i64" json:"turn_id" form:"turn_id" query:"turn_id"`
+	BaseScore      *float64 `thrift:"base_score,4,optional" frugal:"4,optional,double" form:"base_score" json:"base_score,omitempty" query:"base_score"`
+	DraftScore     *float64 `thrift:"draft_score,5,optional" frugal:"5,optional,double" form:"draft_score" json:"draft_score,omitempty" query:"draft_score"`
+	Drift          *float64 `thrift:"drift,6,optional" frugal:"6,optional,double" form:"drift" json:"drift,omitempty" query:"drift"`
+	Flipped        *bool    `thrift:"flipped,7,optional" frugal:"7,optional,bool" form:"flipped" json:"flipped,omitempty" query:"flipped"`
+	DraftReasoning *string  `thrift:"draft_reasoning,8,optional" frugal:"8,optional,string" form:"draft_reasoning" json:"draft_reasoning,omitempty" query:"draft_reasoning"`
 }
 
-func NewEvaluatorInputData() *EvaluatorInputData {
-	return &EvaluatorInputData{}
+func NewEvaluatorPromotionDriftItem() *EvaluatorPromotionDriftItem {
+	return &EvaluatorPromotionDriftItem{}
 }
 
-func (p *EvaluatorInputData) InitDefault() {
+func (p *EvaluatorPromotionDriftItem) InitDefault() {
 }
 
-var EvaluatorInputData_HistoryMessages_DEFAULT []*common.Message
+var EvaluatorPromotionDriftItem_RecordID_DEFAULT int64
 
-func (p *EvaluatorInputData) GetHistoryMessages() (v []*common.Message) {
+func (p *EvaluatorPromotionDriftItem) GetRecordID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetHistoryMessages() {
-		return EvaluatorInputData_HistoryMessages_DEFAULT
+	if !p.IsSetRecordID() {
+		return EvaluatorPromotionDriftItem_RecordID_DEFAULT
 	}
-	return p.HistoryMessages
+	return *p.RecordID
 }
 
-var EvaluatorInputData_InputFields_DEFAULT map[string]*common.Content
+var EvaluatorPromotionDriftItem_ItemID_DEFAULT int64
 
-func (p *EvaluatorInputData) GetInputFields() (v map[string]*common.Content) {
+func (p *EvaluatorPromotionDriftItem) GetItemID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetInputFields() {
-		return EvaluatorInputData_InputFields_DEFAULT
+	if !p.IsSetItemID() {
+		return EvaluatorPromotionDriftItem_ItemID_DEFAULT
 	}
-	return p.InputFields
+	return *p.ItemID
 }
 
-var EvaluatorInputData_EvaluateDatasetFields_DEFAULT map[string]*common.Content
+var EvaluatorPromotionDriftItem_TurnID_DEFAULT int64
 
-func (p *EvaluatorInputData) GetEvaluateDatasetFields() (v map[string]*common.Content) {
+func (p *EvaluatorPromotionDriftItem) GetTurnID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEvaluateDatasetFields() {
-		return EvaluatorInputData_EvaluateDatasetFields_DEFAULT
+	if !p.IsSetTurnID() {
+		return EvaluatorPromotionDriftItem_TurnID_DEFAULT
 	}
-	return p.EvaluateDatasetFields
+	return *p.TurnID
 }
 
-var EvaluatorInputData_EvaluateTargetOutputFields_DEFAULT map[string]*common.Content
+var EvaluatorPromotionDriftItem_BaseScore_DEFAULT float64
 
-func (p *EvaluatorInputData) GetEvaluateTargetOutputFields() (v map[string]*common.Content) {
+func (p *EvaluatorPromotionDriftItem) GetBaseScore() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEvaluateTargetOutputFields() {
-		return EvaluatorInputData_EvaluateTargetOutputFields_DEFAULT
+	if !p.IsSetBaseScore() {
+		return EvaluatorPromotionDriftItem_BaseScore_DEFAULT
 	}
-	return p.EvaluateTargetOutputFields
+	return *p.BaseScore
 }
 
-var EvaluatorInputData_Ext_DEFAULT map[string]string
+var EvaluatorPromotionDriftItem_DraftScore_DEFAULT float64
 
-func (p *EvaluatorInputData) GetExt() (v map[string]string) {
+func (p *EvaluatorPromotionDriftItem) GetDraftScore() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetExt() {
-		return EvaluatorInputData_Ext_DEFAULT
+	if !p.IsSetDraftScore() {
+		return EvaluatorPromotionDriftItem_DraftScore_DEFAULT
 	}
-	return p.Ext
+	return *p.DraftScore
 }
-func (p *EvaluatorInputData) SetHistoryMessages(val []*common.Message) {
-	p.HistoryMessages = val
+
+var EvaluatorPromotionDriftItem_Drift_DEFAULT float64
+
+func (p *EvaluatorPromotionDriftItem) GetDrift() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDrift() {
+		return EvaluatorPromotionDriftItem_Drift_DEFAULT
+	}
+	return *p.Drift
 }
-func (p *EvaluatorInputData) SetInputFields(val map[string]*common.Content) {
-	p.InputFields = val
+
+var EvaluatorPromotionDriftItem_Flipped_DEFAULT bool
+
+func (p *EvaluatorPromotionDriftItem) GetFlipped() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFlipped() {
+		return EvaluatorPromotionDriftItem_Flipped_DEFAULT
+	}
+	return *p.Flipped
 }
-func (p *EvaluatorInputData) SetEvaluateDatasetFields(val map[string]*common.Content) {
-	p.EvaluateDatasetFields = val
+
+var EvaluatorPromotionDriftItem_DraftReasoning_DEFAULT string
+
+func (p *EvaluatorPromotionDriftItem) GetDraftReasoning() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDraftReasoning() {
+		return EvaluatorPromotionDriftItem_DraftReasoning_DEFAULT
+	}
+	return *p.DraftReasoning
 }
-func (p *EvaluatorInputData) SetEvaluateTargetOutputFields(val map[string]*common.Content) {
-	p.EvaluateTargetOutputFields = val
+func (p *EvaluatorPromotionDriftItem) SetRecordID(val *int64) {
+	p.RecordID = val
 }
-func (p *EvaluatorInputData) SetExt(val map[string]string) {
-	p.Ext = val
+func (p *EvaluatorPromotionDriftItem) SetItemID(val *int64) {
+	p.ItemID = val
+}
+func (p *EvaluatorPromotionDriftItem) SetTurnID(val *int64) {
+	p.TurnID = val
+}
+func (p *EvaluatorPromotionDriftItem) SetBaseScore(val *float64) {
+	p.BaseScore = val
+}
+func (p *EvaluatorPromotionDriftItem) SetDraftScore(val *float64) {
+	p.DraftScore = val
+}
+func (p *EvaluatorPromotionDriftItem) SetDrift(val *float64) {
+	p.Drift = val
+}
+func (p *EvaluatorPromotionDriftItem) SetFlipped(val *bool) {
+	p.Flipped = val
+}
+func (p *EvaluatorPromotionDriftItem) SetDraftReasoning(val *string) {
+	p.DraftReasoning = val
 }
 
-var fieldIDToName_EvaluatorInputData = map[int16]string{
-	1:   "history_messages",
-	2:   "input_fields",
-	3:   "evaluate_dataset_fields",
-	4:   "evaluate_target_output_fields",
-	100: "ext",
+var fieldIDToName_EvaluatorPromotionDriftItem = map[int16]string{
+	1: "record_id",
+	2: "item_id",
+	3: "turn_id",
+	4: "base_score",
+	5: "draft_score",
+	6: "drift",
+	7: "flipped",
+	8: "draft_reasoning",
 }
 
-func (p *EvaluatorInputData) IsSetHistoryMessages() bool {
-	return p.HistoryMessages != nil
+func (p *EvaluatorPromotionDriftItem) IsSetRecordID() bool {
+	return p.RecordID != nil
 }
 
-func (p *EvaluatorInputData) IsSetInputFields() bool {
-	return p.InputFields != nil
+func (p *EvaluatorPromotionDriftItem) IsSetItemID() bool {
+	return p.ItemID != nil
 }
 
-func (p *EvaluatorInputData) IsSetEvaluateDatasetFields() bool {
-	return p.EvaluateDatasetFields != nil
+func (p *EvaluatorPromotionDriftItem) IsSetTurnID() bool {
+	return p.TurnID != nil
 }
 
-func (p *EvaluatorInputData) IsSetEvaluateTargetOutputFields() bool {
-	return p.EvaluateTargetOutputFields != nil
+func (p *EvaluatorPromotionDriftItem) IsSetBaseScore() bool {
+	return p.BaseScore != nil
 }
 
-func (p *EvaluatorInputData) IsSetExt() bool {
-	return p.Ext != nil
+func (p *EvaluatorPromotionDriftItem) IsSetDraftScore() bool {
+	return p.DraftScore != nil
 }
 
-func (p *EvaluatorInputData) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorPromotionDriftItem) IsSetDrift() bool {
+	return p.Drift != nil
+}
+
+func (p *EvaluatorPromotionDriftItem) IsSetFlipped() bool {
+	return p.Flipped != nil
+}
+
+func (p *EvaluatorPromotionDriftItem) IsSetDraftReasoning() bool {
+	return p.DraftReasoning != nil
+}
+
+func (p *EvaluatorPromotionDriftItem) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -12426,7 +21387,7 @@ func (p *EvaluatorInputData) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -12434,7 +21395,7 @@ func (p *EvaluatorInputData) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -12442,7 +21403,7 @@ func (p *EvaluatorInputData) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -12450,16 +21411,40 @@ func (p *EvaluatorInputData) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 100:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField100(iprot); err != nil {
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -12484,159 +21469,108 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorInputData[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorPromotionDriftItem[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *EvaluatorInputData) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*common.Message, 0, size)
-	values := make([]common.Message, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.HistoryMessages = _field
-	return nil
-}
-func (p *EvaluatorInputData) ReadField2(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[string]*common.Content, size)
-	values := make([]common.Content, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
 
-		_val := &values[i]
-		_val.InitDefault()
-		if err := _val.Read(iprot); err != nil {
-			return err
-		}
+func (p *EvaluatorPromotionDriftItem) ReadField1(iprot thrift.TProtocol) error {
 
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.InputFields = _field
+	p.RecordID = _field
 	return nil
 }
-func (p *EvaluatorInputData) ReadField3(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
+func (p *EvaluatorPromotionDriftItem) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	_field := make(map[string]*common.Content, size)
-	values := make([]common.Content, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		_val := &values[i]
-		_val.InitDefault()
-		if err := _val.Read(iprot); err != nil {
-			return err
-		}
+	p.ItemID = _field
+	return nil
+}
+func (p *EvaluatorPromotionDriftItem) ReadField3(iprot thrift.TProtocol) error {
 
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.EvaluateDatasetFields = _field
+	p.TurnID = _field
 	return nil
 }
-func (p *EvaluatorInputData) ReadField4(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
+func (p *EvaluatorPromotionDriftItem) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	_field := make(map[string]*common.Content, size)
-	values := make([]common.Content, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		_val := &values[i]
-		_val.InitDefault()
-		if err := _val.Read(iprot); err != nil {
-			return err
-		}
+	p.BaseScore = _field
+	return nil
+}
+func (p *EvaluatorPromotionDriftItem) ReadField5(iprot thrift.TProtocol) error {
 
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.EvaluateTargetOutputFields = _field
+	p.DraftScore = _field
 	return nil
 }
-func (p *EvaluatorInputData) ReadField100(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
+func (p *EvaluatorPromotionDriftItem) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
-		}
+	p.Drift = _field
+	return nil
+}
+func (p *EvaluatorPromotionDriftItem) ReadField7(iprot thrift.TProtocol) error {
 
-		_field[_key] = _val
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	if err := iprot.ReadMapEnd(); err != nil {
+	p.Flipped = _field
+	return nil
+}
+func (p *EvaluatorPromotionDriftItem) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Ext = _field
+	p.DraftReasoning = _field
 	return nil
 }
 
-func (p *EvaluatorInputData) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorPromotionDriftItem) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorInputData"); err != nil {
+	if err = oprot.WriteStructBegin("EvaluatorPromotionDriftItem"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12656,8 +21590,20 @@ func (p *EvaluatorInputData) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField100(oprot); err != nil {
-			fieldId = 100
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
 			goto WriteFieldError
 		}
 	}
@@ -12678,20 +21624,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorInputData) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetHistoryMessages() {
-		if err = oprot.WriteFieldBegin("history_messages", thrift.LIST, 1); err != nil {
+func (p *EvaluatorPromotionDriftItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRecordID() {
+		if err = oprot.WriteFieldBegin("record_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.HistoryMessages)); err != nil {
-			return err
-		}
-		for _, v := range p.HistoryMessages {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteI64(*p.RecordID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -12704,23 +21642,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorInputData) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetInputFields() {
-		if err = oprot.WriteFieldBegin("input_fields", thrift.MAP, 2); err != nil {
+func (p *EvaluatorPromotionDriftItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItemID() {
+		if err = oprot.WriteFieldBegin("item_id", thrift.I64, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.InputFields)); err != nil {
-			return err
-		}
-		for k, v := range p.InputFields {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteMapEnd(); err != nil {
+		if err := oprot.WriteI64(*p.ItemID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -12733,23 +21660,30 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *EvaluatorInputData) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluateDatasetFields() {
-		if err = oprot.WriteFieldBegin("evaluate_dataset_fields", thrift.MAP, 3); err != nil {
+func (p *EvaluatorPromotionDriftItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTurnID() {
+		if err = oprot.WriteFieldBegin("turn_id", thrift.I64, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.EvaluateDatasetFields)); err != nil {
+		if err := oprot.WriteI64(*p.TurnID); err != nil {
 			return err
 		}
-		for k, v := range p.EvaluateDatasetFields {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err := oprot.WriteMapEnd(); err != nil {
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluatorPromotionDriftItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseScore() {
+		if err = oprot.WriteFieldBegin("base_score", thrift.DOUBLE, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.BaseScore); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -12758,27 +21692,34 @@ func (p *EvaluatorInputData) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *EvaluatorInputData) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluateTargetOutputFields() {
-		if err = oprot.WriteFieldBegin("evaluate_target_output_fields", thrift.MAP, 4); err != nil {
+func (p *EvaluatorPromotionDriftItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDraftScore() {
+		if err = oprot.WriteFieldBegin("draft_score", thrift.DOUBLE, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.EvaluateTargetOutputFields)); err != nil {
+		if err := oprot.WriteDouble(*p.DraftScore); err != nil {
 			return err
 		}
-		for k, v := range p.EvaluateTargetOutputFields {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err := oprot.WriteMapEnd(); err != nil {
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvaluatorPromotionDriftItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDrift() {
+		if err = oprot.WriteFieldBegin("drift", thrift.DOUBLE, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Drift); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -12787,27 +21728,34 @@ func (p *EvaluatorInputData) writeField4(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-func (p *EvaluatorInputData) writeField100(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExt() {
-		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 100); err != nil {
+func (p *EvaluatorPromotionDriftItem) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFlipped() {
+		if err = oprot.WriteFieldBegin("flipped", thrift.BOOL, 7); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Ext)); err != nil {
+		if err := oprot.WriteBool(*p.Flipped); err != nil {
 			return err
 		}
-		for k, v := range p.Ext {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err := oprot.WriteMapEnd(); err != nil {
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *EvaluatorPromotionDriftItem) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDraftReasoning() {
+		if err = oprot.WriteFieldBegin("draft_reasoning", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.DraftReasoning); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -12816,165 +21764,566 @@ func (p *EvaluatorInputData) writeField100(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *EvaluatorInputData) String() string {
+func (p *EvaluatorPromotionDriftItem) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorInputData(%+v)", *p)
+	return fmt.Sprintf("EvaluatorPromotionDriftItem(%+v)", *p)
 
 }
 
-func (p *EvaluatorInputData) DeepEqual(ano *EvaluatorInputData) bool {
+func (p *EvaluatorPromotionDriftItem) DeepEqual(ano *EvaluatorPromotionDriftItem) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.HistoryMessages) {
+	if !p.Field1DeepEqual(ano.RecordID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.InputFields) {
+	if !p.Field2DeepEqual(ano.ItemID) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.EvaluateDatasetFields) {
+	if !p.Field3DeepEqual(ano.TurnID) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.EvaluateTargetOutputFields) {
+	if !p.Field4DeepEqual(ano.BaseScore) {
 		return false
 	}
-	if !p.Field100DeepEqual(ano.Ext) {
+	if !p.Field5DeepEqual(ano.DraftScore) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Drift) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Flipped) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.DraftReasoning) {
 		return false
 	}
 	return true
 }
 
-func (p *EvaluatorInputData) Field1DeepEqual(src []*common.Message) bool {
+func (p *EvaluatorPromotionDriftItem) Field1DeepEqual(src *int64) bool {
 
-	if len(p.HistoryMessages) != len(src) {
+	if p.RecordID == src {
+		return true
+	} else if p.RecordID == nil || src == nil {
 		return false
 	}
-	for i, v := range p.HistoryMessages {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if *p.RecordID != *src {
+		return false
 	}
 	return true
 }
-func (p *EvaluatorInputData) Field2DeepEqual(src map[string]*common.Content) bool {
+func (p *EvaluatorPromotionDriftItem) Field2DeepEqual(src *int64) bool {
 
-	if len(p.InputFields) != len(src) {
+	if p.ItemID == src {
+		return true
+	} else if p.ItemID == nil || src == nil {
 		return false
 	}
-	for k, v := range p.InputFields {
-		_src := src[k]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if *p.ItemID != *src {
+		return false
 	}
 	return true
 }
-func (p *EvaluatorInputData) Field3DeepEqual(src map[string]*common.Content) bool {
+func (p *EvaluatorPromotionDriftItem) Field3DeepEqual(src *int64) bool {
 
-	if len(p.EvaluateDatasetFields) != len(src) {
+	if p.TurnID == src {
+		return true
+	} else if p.TurnID == nil || src == nil {
 		return false
 	}
-	for k, v := range p.EvaluateDatasetFields {
-		_src := src[k]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if *p.TurnID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionDriftItem) Field4DeepEqual(src *float64) bool {
+
+	if p.BaseScore == src {
+		return true
+	} else if p.BaseScore == nil || src == nil {
+		return false
+	}
+	if *p.BaseScore != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionDriftItem) Field5DeepEqual(src *float64) bool {
+
+	if p.DraftScore == src {
+		return true
+	} else if p.DraftScore == nil || src == nil {
+		return false
+	}
+	if *p.DraftScore != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionDriftItem) Field6DeepEqual(src *float64) bool {
+
+	if p.Drift == src {
+		return true
+	} else if p.Drift == nil || src == nil {
+		return false
+	}
+	if *p.Drift != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionDriftItem) Field7DeepEqual(src *bool) bool {
+
+	if p.Flipped == src {
+		return true
+	} else if p.Flipped == nil || src == nil {
+		return false
+	}
+	if *p.Flipped != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionDriftItem) Field8DeepEqual(src *string) bool {
+
+	if p.DraftReasoning == src {
+		return true
+	} else if p.DraftReasoning == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.DraftReasoning, *src) != 0 {
+		return false
 	}
 	return true
 }
-func (p *EvaluatorInputData) Field4DeepEqual(src map[string]*common.Content) bool {
 
-	if len(p.EvaluateTargetOutputFields) != len(src) {
-		return false
-	}
-	for k, v := range p.EvaluateTargetOutputFields {
-		_src := src[k]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
-	return true
+// 草稿相对基线版本的回归检查报告
+type EvaluatorPromotionReport struct {
+	BaseVersionID *int64                     `thrift:"base_version_id,1,optional" frugal:"1,optional,i64" json:"base_version_id" form:"base_version_id" query:"base_version_id"`
+	BaseVersion   *string                    `thrift:"base_version,2,optional" frugal:"2,optional,string" form:"base_version" json:"base_version,omitempty" query:"base_version"`
+	Verdict       *EvaluatorPromotionVerdict `thrift:"verdict,3,optional" frugal:"3,optional,string" form:"verdict" json:"verdict,omitempty" query:"verdict"`
+	SampleCnt     *int32                     `thrift:"sample_cnt,4,optional" frugal:"4,optional,i32" form:"sample_cnt" json:"sample_cnt,omitempty" query:"sample_cnt"`
+	// 草稿回放成功且得分有效的样本数, 得分类指标均基于这部分样本
+	PairedCnt    *int32 `thrift:"paired_cnt,5,optional" frugal:"5,optional,i32" form:"paired_cnt" json:"paired_cnt,omitempty" query:"paired_cnt"`
+	DraftFailCnt *int32 `thrift:"draft_fail_cnt,6,optional" frugal:"6,optional,i32" form:"draft_fail_cnt" json:"draft_fail_cnt,omitempty" query:"draft_fail_cnt"`
+	// 草稿得分减基线得分的均值
+	MeanDrift          *float64                       `thrift:"mean_drift,7,optional" frugal:"7,optional,double" form:"mean_drift" json:"mean_drift,omitempty" query:"mean_drift"`
+	MeanAbsDrift       *float64                       `thrift:"mean_abs_drift,8,optional" frugal:"8,optional,double" form:"mean_abs_drift" json:"mean_abs_drift,omitempty" query:"mean_abs_drift"`
+	MaxAbsDrift        *float64                       `thrift:"max_abs_drift,9,optional" frugal:"9,optional,double" form:"max_abs_drift" json:"max_abs_drift,omitempty" query:"max_abs_drift"`
+	FlipCnt            *int32                         `thrift:"flip_cnt,10,optional" frugal:"10,optional,i32" form:"flip_cnt" json:"flip_cnt,omitempty" query:"flip_cnt"`
+	FlipRate           *float64                       `thrift:"flip_rate,11,optional" frugal:"11,optional,double" form:"flip_rate" json:"flip_rate,omitempty" query:"flip_rate"`
+	BaseAvgLatencyMs   *float64                       `thrift:"base_avg_latency_ms,12,optional" frugal:"12,optional,double" form:"base_avg_latency_ms" json:"base_avg_latency_ms,omitempty" query:"base_avg_latency_ms"`
+	DraftAvgLatencyMs  *float64                       `thrift:"draft_avg_latency_ms,13,optional" frugal:"13,optional,double" form:"draft_avg_latency_ms" json:"draft_avg_latency_ms,omitempty" query:"draft_avg_latency_ms"`
+	BaseAvgTokens      *float64                       `thrift:"base_avg_tokens,14,optional" frugal:"14,optional,double" form:"base_avg_tokens" json:"base_avg_tokens,omitempty" query:"base_avg_tokens"`
+	DraftAvgTokens     *float64                       `thrift:"draft_avg_tokens,15,optional" frugal:"15,optional,double" form:"draft_avg_tokens" json:"draft_avg_tokens,omitempty" query:"draft_avg_tokens"`
+	LatencyChangeRatio *float64                       `thrift:"latency_change_ratio,16,optional" frugal:"16,optional,double" form:"latency_change_ratio" json:"latency_change_ratio,omitempty" query:"latency_change_ratio"`
+	TokenChangeRatio   *float64                       `thrift:"token_change_ratio,17,optional" frugal:"17,optional,double" form:"token_change_ratio" json:"token_change_ratio,omitempty" query:"token_change_ratio"`
+	Violations         []*EvaluatorPromotionViolation `thrift:"violations,18,optional" frugal:"18,optional,list<EvaluatorPromotionViolation>" form:"violations" json:"violations,omitempty" query:"violations"`
+	// 按漂移绝对值降序
+	DriftItems []*EvaluatorPromotionDriftItem `thrift:"drift_items,19,optional" frugal:"19,optional,list<EvaluatorPromotionDriftItem>" form:"drift_items" json:"drift_items,omitempty" query:"drift_items"`
+}
+
+func NewEvaluatorPromotionReport() *EvaluatorPromotionReport {
+	return &EvaluatorPromotionReport{}
+}
+
+func (p *EvaluatorPromotionReport) InitDefault() {
+}
+
+var EvaluatorPromotionReport_BaseVersionID_DEFAULT int64
+
+func (p *EvaluatorPromotionReport) GetBaseVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseVersionID() {
+		return EvaluatorPromotionReport_BaseVersionID_DEFAULT
+	}
+	return *p.BaseVersionID
+}
+
+var EvaluatorPromotionReport_BaseVersion_DEFAULT string
+
+func (p *EvaluatorPromotionReport) GetBaseVersion() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseVersion() {
+		return EvaluatorPromotionReport_BaseVersion_DEFAULT
+	}
+	return *p.BaseVersion
+}
+
+var EvaluatorPromotionReport_Verdict_DEFAULT EvaluatorPromotionVerdict
+
+func (p *EvaluatorPromotionReport) GetVerdict() (v EvaluatorPromotionVerdict) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetVerdict() {
+		return EvaluatorPromotionReport_Verdict_DEFAULT
+	}
+	return *p.Verdict
+}
+
+var EvaluatorPromotionReport_SampleCnt_DEFAULT int32
+
+func (p *EvaluatorPromotionReport) GetSampleCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSampleCnt() {
+		return EvaluatorPromotionReport_SampleCnt_DEFAULT
+	}
+	return *p.SampleCnt
+}
+
+var EvaluatorPromotionReport_PairedCnt_DEFAULT int32
+
+func (p *EvaluatorPromotionReport) GetPairedCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPairedCnt() {
+		return EvaluatorPromotionReport_PairedCnt_DEFAULT
+	}
+	return *p.PairedCnt
+}
+
+var EvaluatorPromotionReport_DraftFailCnt_DEFAULT int32
+
+func (p *EvaluatorPromotionReport) GetDraftFailCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDraftFailCnt() {
+		return EvaluatorPromotionReport_DraftFailCnt_DEFAULT
+	}
+	return *p.DraftFailCnt
+}
+
+var EvaluatorPromotionReport_MeanDrift_DEFAULT float64
+
+func (p *EvaluatorPromotionReport) GetMeanDrift() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMeanDrift() {
+		return EvaluatorPromotionReport_MeanDrift_DEFAULT
+	}
+	return *p.MeanDrift
+}
+
+var EvaluatorPromotionReport_MeanAbsDrift_DEFAULT float64
+
+func (p *EvaluatorPromotionReport) GetMeanAbsDrift() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMeanAbsDrift() {
+		return EvaluatorPromotionReport_MeanAbsDrift_DEFAULT
+	}
+	return *p.MeanAbsDrift
+}
+
+var EvaluatorPromotionReport_MaxAbsDrift_DEFAULT float64
+
+func (p *EvaluatorPromotionReport) GetMaxAbsDrift() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMaxAbsDrift() {
+		return EvaluatorPromotionReport_MaxAbsDrift_DEFAULT
+	}
+	return *p.MaxAbsDrift
+}
+
+var EvaluatorPromotionReport_FlipCnt_DEFAULT int32
+
+func (p *EvaluatorPromotionReport) GetFlipCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFlipCnt() {
+		return EvaluatorPromotionReport_FlipCnt_DEFAULT
+	}
+	return *p.FlipCnt
+}
+
+var EvaluatorPromotionReport_FlipRate_DEFAULT float64
+
+func (p *EvaluatorPromotionReport) GetFlipRate() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFlipRate() {
+		return EvaluatorPromotionReport_FlipRate_DEFAULT
+	}
+	return *p.FlipRate
+}
+
+var EvaluatorPromotionReport_BaseAvgLatencyMs_DEFAULT float64
+
+func (p *EvaluatorPromotionReport) GetBaseAvgLatencyMs() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseAvgLatencyMs() {
+		return EvaluatorPromotionReport_BaseAvgLatencyMs_DEFAULT
+	}
+	return *p.BaseAvgLatencyMs
+}
+
+var EvaluatorPromotionReport_DraftAvgLatencyMs_DEFAULT float64
+
+func (p *EvaluatorPromotionReport) GetDraftAvgLatencyMs() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDraftAvgLatencyMs() {
+		return EvaluatorPromotionReport_DraftAvgLatencyMs_DEFAULT
+	}
+	return *p.DraftAvgLatencyMs
+}
+
+var EvaluatorPromotionReport_BaseAvgTokens_DEFAULT float64
+
+func (p *EvaluatorPromotionReport) GetBaseAvgTokens() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseAvgTokens() {
+		return EvaluatorPromotionReport_BaseAvgTokens_DEFAULT
+	}
+	return *p.BaseAvgTokens
+}
+
+var EvaluatorPromotionReport_DraftAvgTokens_DEFAULT float64
+
+func (p *EvaluatorPromotionReport) GetDraftAvgTokens() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDraftAvgTokens() {
+		return EvaluatorPromotionReport_DraftAvgTokens_DEFAULT
+	}
+	return *p.DraftAvgTokens
+}
+
+var EvaluatorPromotionReport_LatencyChangeRatio_DEFAULT float64
+
+func (p *EvaluatorPromotionReport) GetLatencyChangeRatio() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLatencyChangeRatio() {
+		return EvaluatorPromotionReport_LatencyChangeRatio_DEFAULT
+	}
+	return *p.LatencyChangeRatio
+}
+
+var EvaluatorPromotionReport_TokenChangeRatio_DEFAULT float64
+
+func (p *EvaluatorPromotionReport) GetTokenChangeRatio() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTokenChangeRatio() {
+		return EvaluatorPromotionReport_TokenChangeRatio_DEFAULT
+	}
+	return *p.TokenChangeRatio
+}
+
+var EvaluatorPromotionReport_Violations_DEFAULT []*EvaluatorPromotionViolation
+
+func (p *EvaluatorPromotionReport) GetViolations() (v []*EvaluatorPromotionViolation) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetViolations() {
+		return EvaluatorPromotionReport_Violations_DEFAULT
+	}
+	return p.Violations
+}
+
+var EvaluatorPromotionReport_DriftItems_DEFAULT []*EvaluatorPromotionDriftItem
+
+func (p *EvaluatorPromotionReport) GetDriftItems() (v []*EvaluatorPromotionDriftItem) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDriftItems() {
+		return EvaluatorPromotionReport_DriftItems_DEFAULT
+	}
+	return p.DriftItems
+}
+func (p *EvaluatorPromotionReport) SetBaseVersionID(val *int64) {
+	p.BaseVersionID = val
+}
+func (p *EvaluatorPromotionReport) SetBaseVersion(val *string) {
+	p.BaseVersion = val
+}
+func (p *EvaluatorPromotionReport) SetVerdict(val *EvaluatorPromotionVerdict) {
+	p.Verdict = val
+}
+func (p *EvaluatorPromotionReport) SetSampleCnt(val *int32) {
+	p.SampleCnt = val
+}
+func (p *EvaluatorPromotionReport) SetPairedCnt(val *int32) {
+	p.PairedCnt = val
+}
+func (p *EvaluatorPromotionReport) SetDraftFailCnt(val *int32) {
+	p.DraftFailCnt = val
+}
+func (p *EvaluatorPromotionReport) SetMeanDrift(val *float64) {
+	p.MeanDrift = val
+}
+func (p *EvaluatorPromotionReport) SetMeanAbsDrift(val *float64) {
+	p.MeanAbsDrift = val
+}
+func (p *EvaluatorPromotionReport) SetMaxAbsDrift(val *float64) {
+	p.MaxAbsDrift = val
+}
+func (p *EvaluatorPromotionReport) SetFlipCnt(val *int32) {
+	p.FlipCnt = val
+}
+func (p *EvaluatorPromotionReport) SetFlipRate(val *float64) {
+	p.FlipRate = val
+}
+func (p *EvaluatorPromotionReport) SetBaseAvgLatencyMs(val *float64) {
+	p.BaseAvgLatencyMs = val
+}
+func (p *EvaluatorPromotionReport) SetDraftAvgLatencyMs(val *float64) {
+	p.DraftAvgLatencyMs = val
+}
+func (p *EvaluatorPromotionReport) SetBaseAvgTokens(val *float64) {
+	p.BaseAvgTokens = val
+}
+func (p *EvaluatorPromotionReport) SetDraftAvgTokens(val *float64) {
+	p.DraftAvgTokens = val
+}
+func (p *EvaluatorPromotionReport) SetLatencyChangeRatio(val *float64) {
+	p.LatencyChangeRatio = val
+}
+func (p *EvaluatorPromotionReport) SetTokenChangeRatio(val *float64) {
+	p.TokenChangeRatio = val
+}
+func (p *EvaluatorPromotionReport) SetViolations(val []*EvaluatorPromotionViolation) {
+	p.Violations = val
+}
+func (p *EvaluatorPromotionReport) SetDriftItems(val []*EvaluatorPromotionDriftItem) {
+	p.DriftItems = val
+}
+
+var fieldIDToName_EvaluatorPromotionReport = map[int16]string{
+	1:  "base_version_id",
+	2:  "base_version",
+	3:  "verdict",
+	4:  "sample_cnt",
+	5:  "paired_cnt",
+	6:  "draft_fail_cnt",
+	7:  "mean_drift",
+	8:  "mean_abs_drift",
+	9:  "max_abs_drift",
+	10: "flip_cnt",
+	11: "flip_rate",
+	12: "base_avg_latency_ms",
+	13: "draft_avg_latency_ms",
+	14: "base_avg_tokens",
+	15: "draft_avg_tokens",
+	16: "latency_change_ratio",
+	17: "token_change_ratio",
+	18: "violations",
+	19: "drift_items",
+}
+
+func (p *EvaluatorPromotionReport) IsSetBaseVersionID() bool {
+	return p.BaseVersionID != nil
+}
+
+func (p *EvaluatorPromotionReport) IsSetBaseVersion() bool {
+	return p.BaseVersion != nil
+}
+
+func (p *EvaluatorPromotionReport) IsSetVerdict() bool {
+	return p.Verdict != nil
+}
+
+func (p *EvaluatorPromotionReport) IsSetSampleCnt() bool {
+	return p.SampleCnt != nil
+}
+
+func (p *EvaluatorPromotionReport) IsSetPairedCnt() bool {
+	return p.PairedCnt != nil
+}
+
+func (p *EvaluatorPromotionReport) IsSetDraftFailCnt() bool {
+	return p.DraftFailCnt != nil
 }
-func (p *EvaluatorInputData) Field100DeepEqual(src map[string]string) bool {
 
-	if len(p.Ext) != len(src) {
-		return false
-	}
-	for k, v := range p.Ext {
-		_src := src[k]
-		if strings.Compare(v, _src) != 0 {
-			return false
-		}
-	}
-	return true
+func (p *EvaluatorPromotionReport) IsSetMeanDrift() bool {
+	return p.MeanDrift != nil
 }
 
-type EvaluatorHTTPInfo struct {
-	Method *EvaluatorHTTPMethod `thrift:"method,1,optional" frugal:"1,optional,string" form:"method" json:"method,omitempty" query:"method"`
-	Path   *string              `thrift:"path,2,optional" frugal:"2,optional,string" form:"path" json:"path,omitempty" query:"path"`
+func (p *EvaluatorPromotionReport) IsSetMeanAbsDrift() bool {
+	return p.MeanAbsDrift != nil
 }
 
-func NewEvaluatorHTTPInfo() *EvaluatorHTTPInfo {
-	return &EvaluatorHTTPInfo{}
+func (p *EvaluatorPromotionReport) IsSetMaxAbsDrift() bool {
+	return p.MaxAbsDrift != nil
 }
 
-func (p *EvaluatorHTTPInfo) InitDefault() {
+func (p *EvaluatorPromotionReport) IsSetFlipCnt() bool {
+	return p.FlipCnt != nil
 }
 
-var EvaluatorHTTPInfo_Method_DEFAULT EvaluatorHTTPMethod
+func (p *EvaluatorPromotionReport) IsSetFlipRate() bool {
+	return p.FlipRate != nil
+}
 
-func (p *EvaluatorHTTPInfo) GetMethod() (v EvaluatorHTTPMethod) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetMethod() {
-		return EvaluatorHTTPInfo_Method_DEFAULT
-	}
-	return *p.Method
+func (p *EvaluatorPromotionReport) IsSetBaseAvgLatencyMs() bool {
+	return p.BaseAvgLatencyMs != nil
 }
 
-var EvaluatorHTTPInfo_Path_DEFAULT string
+func (p *EvaluatorPromotionReport) IsSetDraftAvgLatencyMs() bool {
+	return p.DraftAvgLatencyMs != nil
+}
 
-func (p *EvaluatorHTTPInfo) GetPath() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetPath() {
-		return EvaluatorHTTPInfo_Path_DEFAULT
-	}
-	return *p.Path
+func (p *EvaluatorPromotionReport) IsSetBaseAvgTokens() bool {
+	return p.BaseAvgTokens != nil
 }
-func (p *EvaluatorHTTPInfo) SetMethod(val *EvaluatorHTTPMethod) {
-	p.Method = val
+
+func (p *EvaluatorPromotionReport) IsSetDraftAvgTokens() bool {
+	return p.DraftAvgTokens != nil
 }
-func (p *EvaluatorHTTPInfo) SetPath(val *string) {
-	p.Path = val
+
+func (p *EvaluatorPromotionReport) IsSetLatencyChangeRatio() bool {
+	return p.LatencyChangeRatio != nil
 }
 
-var fieldIDToName_EvaluatorHTTPInfo = map[int16]string{
-	1: "method",
-	2: "path",
+func (p *EvaluatorPromotionReport) IsSetTokenChangeRatio() bool {
+	return p.TokenChangeRatio != nil
 }
 
-func (p *EvaluatorHTTPInfo) IsSetMethod() bool {
-	return p.Method != nil
+func (p *EvaluatorPromotionReport) IsSetViolations() bool {
+	return p.Violations != nil
 }
 
-func (p *EvaluatorHTTPInfo) IsSetPath() bool {
-	return p.Path != nil
+func (p *EvaluatorPromotionReport) IsSetDriftItems() bool {
+	return p.DriftItems != nil
 }
 
-func (p *EvaluatorHTTPInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorPromotionReport) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -12993,7 +22342,7 @@ func (p *EvaluatorHTTPInfo) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -13008,6 +22357,142 @@ func (p *EvaluatorHTTPInfo) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 15:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField15(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 16:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField16(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 17:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField17(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 18:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField18(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 19:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField19(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -13027,7 +22512,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorHTTPInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorPromotionReport[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13037,287 +22522,243 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorHTTPInfo) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *EvaluatorHTTPMethod
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Method = _field
-	return nil
-}
-func (p *EvaluatorHTTPInfo) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Path = _field
-	return nil
-}
-
-func (p *EvaluatorHTTPInfo) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorHTTPInfo"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
-}
-
-func (p *EvaluatorHTTPInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMethod() {
-		if err = oprot.WriteFieldBegin("method", thrift.STRING, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Method); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *EvaluatorPromotionReport) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.BaseVersionID = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorHTTPInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPath() {
-		if err = oprot.WriteFieldBegin("path", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Path); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *EvaluatorPromotionReport) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.BaseVersion = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *EvaluatorPromotionReport) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *EvaluatorHTTPInfo) String() string {
-	if p == nil {
-		return "<nil>"
+	var _field *EvaluatorPromotionVerdict
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	return fmt.Sprintf("EvaluatorHTTPInfo(%+v)", *p)
-
+	p.Verdict = _field
+	return nil
 }
+func (p *EvaluatorPromotionReport) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *EvaluatorHTTPInfo) DeepEqual(ano *EvaluatorHTTPInfo) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
-	}
-	if !p.Field1DeepEqual(ano.Method) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.Path) {
-		return false
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	return true
+	p.SampleCnt = _field
+	return nil
 }
+func (p *EvaluatorPromotionReport) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *EvaluatorHTTPInfo) Field1DeepEqual(src *EvaluatorHTTPMethod) bool {
-
-	if p.Method == src {
-		return true
-	} else if p.Method == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.Method, *src) != 0 {
-		return false
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	return true
+	p.PairedCnt = _field
+	return nil
 }
-func (p *EvaluatorHTTPInfo) Field2DeepEqual(src *string) bool {
+func (p *EvaluatorPromotionReport) ReadField6(iprot thrift.TProtocol) error {
 
-	if p.Path == src {
-		return true
-	} else if p.Path == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.Path, *src) != 0 {
-		return false
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	return true
+	p.DraftFailCnt = _field
+	return nil
 }
+func (p *EvaluatorPromotionReport) ReadField7(iprot thrift.TProtocol) error {
 
-type EvaluatorRunConfig struct {
-	Env                   *string              `thrift:"env,1,optional" frugal:"1,optional,string" form:"env" json:"env,omitempty" query:"env"`
-	EvaluatorRuntimeParam *common.RuntimeParam `thrift:"evaluator_runtime_param,2,optional" frugal:"2,optional,common.RuntimeParam" form:"evaluator_runtime_param" json:"evaluator_runtime_param,omitempty" query:"evaluator_runtime_param"`
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MeanDrift = _field
+	return nil
 }
+func (p *EvaluatorPromotionReport) ReadField8(iprot thrift.TProtocol) error {
 
-func NewEvaluatorRunConfig() *EvaluatorRunConfig {
-	return &EvaluatorRunConfig{}
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MeanAbsDrift = _field
+	return nil
 }
+func (p *EvaluatorPromotionReport) ReadField9(iprot thrift.TProtocol) error {
 
-func (p *EvaluatorRunConfig) InitDefault() {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxAbsDrift = _field
+	return nil
 }
+func (p *EvaluatorPromotionReport) ReadField10(iprot thrift.TProtocol) error {
 
-var EvaluatorRunConfig_Env_DEFAULT string
-
-func (p *EvaluatorRunConfig) GetEnv() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetEnv() {
-		return EvaluatorRunConfig_Env_DEFAULT
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	return *p.Env
+	p.FlipCnt = _field
+	return nil
 }
+func (p *EvaluatorPromotionReport) ReadField11(iprot thrift.TProtocol) error {
 
-var EvaluatorRunConfig_EvaluatorRuntimeParam_DEFAULT *common.RuntimeParam
-
-func (p *EvaluatorRunConfig) GetEvaluatorRuntimeParam() (v *common.RuntimeParam) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetEvaluatorRuntimeParam() {
-		return EvaluatorRunConfig_EvaluatorRuntimeParam_DEFAULT
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	return p.EvaluatorRuntimeParam
-}
-func (p *EvaluatorRunConfig) SetEnv(val *string) {
-	p.Env = val
-}
-func (p *EvaluatorRunConfig) SetEvaluatorRuntimeParam(val *common.RuntimeParam) {
-	p.EvaluatorRuntimeParam = val
+	p.FlipRate = _field
+	return nil
 }
+func (p *EvaluatorPromotionReport) ReadField12(iprot thrift.TProtocol) error {
 
-var fieldIDToName_EvaluatorRunConfig = map[int16]string{
-	1: "env",
-	2: "evaluator_runtime_param",
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaseAvgLatencyMs = _field
+	return nil
 }
+func (p *EvaluatorPromotionReport) ReadField13(iprot thrift.TProtocol) error {
 
-func (p *EvaluatorRunConfig) IsSetEnv() bool {
-	return p.Env != nil
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DraftAvgLatencyMs = _field
+	return nil
 }
+func (p *EvaluatorPromotionReport) ReadField14(iprot thrift.TProtocol) error {
 
-func (p *EvaluatorRunConfig) IsSetEvaluatorRuntimeParam() bool {
-	return p.EvaluatorRuntimeParam != nil
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaseAvgTokens = _field
+	return nil
 }
+func (p *EvaluatorPromotionReport) ReadField15(iprot thrift.TProtocol) error {
 
-func (p *EvaluatorRunConfig) Read(iprot thrift.TProtocol) (err error) {
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.DraftAvgTokens = _field
+	return nil
+}
+func (p *EvaluatorPromotionReport) ReadField16(iprot thrift.TProtocol) error {
 
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-
+	p.LatencyChangeRatio = _field
 	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorRunConfig[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+func (p *EvaluatorPromotionReport) ReadField17(iprot thrift.TProtocol) error {
 
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TokenChangeRatio = _field
+	return nil
 }
+func (p *EvaluatorPromotionReport) ReadField18(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*EvaluatorPromotionViolation, 0, size)
+	values := make([]EvaluatorPromotionViolation, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-func (p *EvaluatorRunConfig) ReadField1(iprot thrift.TProtocol) error {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Env = _field
+	p.Violations = _field
 	return nil
 }
-func (p *EvaluatorRunConfig) ReadField2(iprot thrift.TProtocol) error {
-	_field := common.NewRuntimeParam()
-	if err := _field.Read(iprot); err != nil {
+func (p *EvaluatorPromotionReport) ReadField19(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
 	}
-	p.EvaluatorRuntimeParam = _field
+	_field := make([]*EvaluatorPromotionDriftItem, 0, size)
+	values := make([]EvaluatorPromotionDriftItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.DriftItems = _field
 	return nil
 }
 
-func (p *EvaluatorRunConfig) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorPromotionReport) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorRunConfig"); err != nil {
+	if err = oprot.WriteStructBegin("EvaluatorPromotionReport"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13329,6 +22770,74 @@ func (p *EvaluatorRunConfig) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+		if err = p.writeField15(oprot); err != nil {
+			fieldId = 15
+			goto WriteFieldError
+		}
+		if err = p.writeField16(oprot); err != nil {
+			fieldId = 16
+			goto WriteFieldError
+		}
+		if err = p.writeField17(oprot); err != nil {
+			fieldId = 17
+			goto WriteFieldError
+		}
+		if err = p.writeField18(oprot); err != nil {
+			fieldId = 18
+			goto WriteFieldError
+		}
+		if err = p.writeField19(oprot); err != nil {
+			fieldId = 19
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -13347,12 +22856,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorRunConfig) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEnv() {
-		if err = oprot.WriteFieldBegin("env", thrift.STRING, 1); err != nil {
+func (p *EvaluatorPromotionReport) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseVersionID() {
+		if err = oprot.WriteFieldBegin("base_version_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Env); err != nil {
+		if err := oprot.WriteI64(*p.BaseVersionID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -13365,338 +22874,264 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorRunConfig) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorRuntimeParam() {
-		if err = oprot.WriteFieldBegin("evaluator_runtime_param", thrift.STRUCT, 2); err != nil {
+func (p *EvaluatorPromotionReport) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseVersion() {
+		if err = oprot.WriteFieldBegin("base_version", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
-		}
-		if err := p.EvaluatorRuntimeParam.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *EvaluatorRunConfig) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("EvaluatorRunConfig(%+v)", *p)
-
-}
-
-func (p *EvaluatorRunConfig) DeepEqual(ano *EvaluatorRunConfig) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
-	}
-	if !p.Field1DeepEqual(ano.Env) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.EvaluatorRuntimeParam) {
-		return false
-	}
-	return true
-}
-
-func (p *EvaluatorRunConfig) Field1DeepEqual(src *string) bool {
-
-	if p.Env == src {
-		return true
-	} else if p.Env == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.Env, *src) != 0 {
-		return false
-	}
-	return true
-}
-func (p *EvaluatorRunConfig) Field2DeepEqual(src *common.RuntimeParam) bool {
-
-	if !p.EvaluatorRuntimeParam.DeepEqual(src) {
-		return false
-	}
-	return true
-}
-
-type EvaluatorProgressMessage struct {
-	// 如 system, assistant
-	Role *string `thrift:"role,1,optional" frugal:"1,optional,string" form:"role" json:"role,omitempty" query:"role"`
-	// 如 tool_use, tool_result
-	Type *string `thrift:"type,2,optional" frugal:"2,optional,string" form:"type" json:"type,omitempty" query:"type"`
-	// 如 Check current user identity and working directory
-	Message     *string `thrift:"message,3,optional" frugal:"3,optional,string" form:"message" json:"message,omitempty" query:"message"`
-	CreatedAtMs *int64  `thrift:"created_at_ms,4,optional" frugal:"4,optional,i64" form:"created_at_ms" json:"created_at_ms,omitempty" query:"created_at_ms"`
-}
-
-func NewEvaluatorProgressMessage() *EvaluatorProgressMessage {
-	return &EvaluatorProgressMessage{}
-}
-
-func (p *EvaluatorProgressMessage) InitDefault() {
-}
-
-var EvaluatorProgressMessage_Role_DEFAULT string
-
-func (p *EvaluatorProgressMessage) GetRole() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetRole() {
-		return EvaluatorProgressMessage_Role_DEFAULT
-	}
-	return *p.Role
-}
-
-var EvaluatorProgressMessage_Type_DEFAULT string
-
-func (p *EvaluatorProgressMessage) GetType() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetType() {
-		return EvaluatorProgressMessage_Type_DEFAULT
-	}
-	return *p.Type
-}
-
-var EvaluatorProgressMessage_Message_DEFAULT string
-
-func (p *EvaluatorProgressMessage) GetMessage() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetMessage() {
-		return EvaluatorProgressMessage_Message_DEFAULT
-	}
-	return *p.Message
-}
-
-var EvaluatorProgressMessage_CreatedAtMs_DEFAULT int64
-
-func (p *EvaluatorProgressMessage) GetCreatedAtMs() (v int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetCreatedAtMs() {
-		return EvaluatorProgressMessage_CreatedAtMs_DEFAULT
-	}
-	return *p.CreatedAtMs
-}
-func (p *EvaluatorProgressMessage) SetRole(val *string) {
-	p.Role = val
-}
-func (p *EvaluatorProgressMessage) SetType(val *string) {
-	p.Type = val
-}
-func (p *EvaluatorProgressMessage) SetMessage(val *string) {
-	p.Message = val
-}
-func (p *EvaluatorProgressMessage) SetCreatedAtMs(val *int64) {
-	p.CreatedAtMs = val
-}
-
-var fieldIDToName_EvaluatorProgressMessage = map[int16]string{
-	1: "role",
-	2: "type",
-	3: "message",
-	4: "created_at_ms",
-}
-
-func (p *EvaluatorProgressMessage) IsSetRole() bool {
-	return p.Role != nil
-}
-
-func (p *EvaluatorProgressMessage) IsSetType() bool {
-	return p.Type != nil
-}
-
-func (p *EvaluatorProgressMessage) IsSetMessage() bool {
-	return p.Message != nil
+		}
+		if err := oprot.WriteString(*p.BaseVersion); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
-func (p *EvaluatorProgressMessage) IsSetCreatedAtMs() bool {
-	return p.CreatedAtMs != nil
+func (p *EvaluatorPromotionReport) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVerdict() {
+		if err = oprot.WriteFieldBegin("verdict", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Verdict); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-
-func (p *EvaluatorProgressMessage) Read(iprot thrift.TProtocol) (err error) {
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+func (p *EvaluatorPromotionReport) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSampleCnt() {
+		if err = oprot.WriteFieldBegin("sample_cnt", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.SampleCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluatorPromotionReport) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPairedCnt() {
+		if err = oprot.WriteFieldBegin("paired_cnt", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
 		}
-		if fieldTypeId == thrift.STOP {
-			break
+		if err := oprot.WriteI32(*p.PairedCnt); err != nil {
+			return err
 		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvaluatorPromotionReport) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDraftFailCnt() {
+		if err = oprot.WriteFieldBegin("draft_fail_cnt", thrift.I32, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.DraftFailCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
 	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *EvaluatorPromotionReport) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMeanDrift() {
+		if err = oprot.WriteFieldBegin("mean_drift", thrift.DOUBLE, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MeanDrift); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-
 	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorProgressMessage[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
-
-func (p *EvaluatorProgressMessage) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *EvaluatorPromotionReport) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMeanAbsDrift() {
+		if err = oprot.WriteFieldBegin("mean_abs_drift", thrift.DOUBLE, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MeanAbsDrift); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	p.Role = _field
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
-func (p *EvaluatorProgressMessage) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *EvaluatorPromotionReport) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxAbsDrift() {
+		if err = oprot.WriteFieldBegin("max_abs_drift", thrift.DOUBLE, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MaxAbsDrift); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	p.Type = _field
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
-func (p *EvaluatorProgressMessage) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *EvaluatorPromotionReport) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFlipCnt() {
+		if err = oprot.WriteFieldBegin("flip_cnt", thrift.I32, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.FlipCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	p.Message = _field
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
-func (p *EvaluatorProgressMessage) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *EvaluatorPromotionReport) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFlipRate() {
+		if err = oprot.WriteFieldBegin("flip_rate", thrift.DOUBLE, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.FlipRate); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	p.CreatedAtMs = _field
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
-
-func (p *EvaluatorProgressMessage) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorProgressMessage"); err != nil {
-		goto WriteStructBeginError
+func (p *EvaluatorPromotionReport) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseAvgLatencyMs() {
+		if err = oprot.WriteFieldBegin("base_avg_latency_ms", thrift.DOUBLE, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.BaseAvgLatencyMs); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *EvaluatorPromotionReport) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDraftAvgLatencyMs() {
+		if err = oprot.WriteFieldBegin("draft_avg_latency_ms", thrift.DOUBLE, 13); err != nil {
+			goto WriteFieldBeginError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
+		if err := oprot.WriteDouble(*p.DraftAvgLatencyMs); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+func (p *EvaluatorPromotionReport) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseAvgTokens() {
+		if err = oprot.WriteFieldBegin("base_avg_tokens", thrift.DOUBLE, 14); err != nil {
+			goto WriteFieldBeginError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
+		if err := oprot.WriteDouble(*p.BaseAvgTokens); err != nil {
+			return err
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
 	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+func (p *EvaluatorPromotionReport) writeField15(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDraftAvgTokens() {
+		if err = oprot.WriteFieldBegin("draft_avg_tokens", thrift.DOUBLE, 15); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.DraftAvgTokens); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
 }
-
-func (p *EvaluatorProgressMessage) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRole() {
-		if err = oprot.WriteFieldBegin("role", thrift.STRING, 1); err != nil {
+func (p *EvaluatorPromotionReport) writeField16(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLatencyChangeRatio() {
+		if err = oprot.WriteFieldBegin("latency_change_ratio", thrift.DOUBLE, 16); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Role); err != nil {
+		if err := oprot.WriteDouble(*p.LatencyChangeRatio); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -13705,16 +23140,16 @@ func (p *EvaluatorProgressMessage) writeField1(oprot thrift.TProtocol) (err erro
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 end error: ", p), err)
 }
-func (p *EvaluatorProgressMessage) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetType() {
-		if err = oprot.WriteFieldBegin("type", thrift.STRING, 2); err != nil {
+func (p *EvaluatorPromotionReport) writeField17(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTokenChangeRatio() {
+		if err = oprot.WriteFieldBegin("token_change_ratio", thrift.DOUBLE, 17); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Type); err != nil {
+		if err := oprot.WriteDouble(*p.TokenChangeRatio); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -13723,16 +23158,24 @@ func (p *EvaluatorProgressMessage) writeField2(oprot thrift.TProtocol) (err erro
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 end error: ", p), err)
 }
-func (p *EvaluatorProgressMessage) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMessage() {
-		if err = oprot.WriteFieldBegin("message", thrift.STRING, 3); err != nil {
+func (p *EvaluatorPromotionReport) writeField18(oprot thrift.TProtocol) (err error) {
+	if p.IsSetViolations() {
+		if err = oprot.WriteFieldBegin("violations", thrift.LIST, 18); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Message); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Violations)); err != nil {
+			return err
+		}
+		for _, v := range p.Violations {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -13741,16 +23184,24 @@ func (p *EvaluatorProgressMessage) writeField3(oprot thrift.TProtocol) (err erro
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 18 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 18 end error: ", p), err)
 }
-func (p *EvaluatorProgressMessage) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCreatedAtMs() {
-		if err = oprot.WriteFieldBegin("created_at_ms", thrift.I64, 4); err != nil {
+func (p *EvaluatorPromotionReport) writeField19(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDriftItems() {
+		if err = oprot.WriteFieldBegin("drift_items", thrift.LIST, 19); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.CreatedAtMs); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.DriftItems)); err != nil {
+			return err
+		}
+		for _, v := range p.DriftItems {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -13759,85 +23210,312 @@ func (p *EvaluatorProgressMessage) writeField4(oprot thrift.TProtocol) (err erro
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 19 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 19 end error: ", p), err)
 }
 
-func (p *EvaluatorProgressMessage) String() string {
+func (p *EvaluatorPromotionReport) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorProgressMessage(%+v)", *p)
+	return fmt.Sprintf("EvaluatorPromotionReport(%+v)", *p)
 
 }
 
-func (p *EvaluatorProgressMessage) DeepEqual(ano *EvaluatorProgressMessage) bool {
+func (p *EvaluatorPromotionReport) DeepEqual(ano *EvaluatorPromotionReport) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Role) {
+	if !p.Field1DeepEqual(ano.BaseVersionID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Type) {
+	if !p.Field2DeepEqual(ano.BaseVersion) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Message) {
+	if !p.Field3DeepEqual(ano.Verdict) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.CreatedAtMs) {
+	if !p.Field4DeepEqual(ano.SampleCnt) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.PairedCnt) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.DraftFailCnt) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.MeanDrift) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.MeanAbsDrift) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.MaxAbsDrift) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.FlipCnt) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.FlipRate) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.BaseAvgLatencyMs) {
+		return false
+	}
+	if !p.Field13DeepEqual(ano.DraftAvgLatencyMs) {
+		return false
+	}
+	if !p.Field14DeepEqual(ano.BaseAvgTokens) {
+		return false
+	}
+	if !p.Field15DeepEqual(ano.DraftAvgTokens) {
+		return false
+	}
+	if !p.Field16DeepEqual(ano.LatencyChangeRatio) {
+		return false
+	}
+	if !p.Field17DeepEqual(ano.TokenChangeRatio) {
+		return false
+	}
+	if !p.Field18DeepEqual(ano.Violations) {
+		return false
+	}
+	if !p.Field19DeepEqual(ano.DriftItems) {
 		return false
 	}
 	return true
 }
 
-func (p *EvaluatorProgressMessage) Field1DeepEqual(src *string) bool {
+func (p *EvaluatorPromotionReport) Field1DeepEqual(src *int64) bool {
 
-	if p.Role == src {
+	if p.BaseVersionID == src {
 		return true
-	} else if p.Role == nil || src == nil {
+	} else if p.BaseVersionID == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Role, *src) != 0 {
+	if *p.BaseVersionID != *src {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorProgressMessage) Field2DeepEqual(src *string) bool {
+func (p *EvaluatorPromotionReport) Field2DeepEqual(src *string) bool {
 
-	if p.Type == src {
+	if p.BaseVersion == src {
 		return true
-	} else if p.Type == nil || src == nil {
+	} else if p.BaseVersion == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Type, *src) != 0 {
+	if strings.Compare(*p.BaseVersion, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorProgressMessage) Field3DeepEqual(src *string) bool {
+func (p *EvaluatorPromotionReport) Field3DeepEqual(src *EvaluatorPromotionVerdict) bool {
 
-	if p.Message == src {
+	if p.Verdict == src {
 		return true
-	} else if p.Message == nil || src == nil {
+	} else if p.Verdict == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Message, *src) != 0 {
+	if strings.Compare(*p.Verdict, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorProgressMessage) Field4DeepEqual(src *int64) bool {
+func (p *EvaluatorPromotionReport) Field4DeepEqual(src *int32) bool {
 
-	if p.CreatedAtMs == src {
+	if p.SampleCnt == src {
 		return true
-	} else if p.CreatedAtMs == nil || src == nil {
+	} else if p.SampleCnt == nil || src == nil {
 		return false
 	}
-	if *p.CreatedAtMs != *src {
+	if *p.SampleCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionReport) Field5DeepEqual(src *int32) bool {
+
+	if p.PairedCnt == src {
+		return true
+	} else if p.PairedCnt == nil || src == nil {
+		return false
+	}
+	if *p.PairedCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionReport) Field6DeepEqual(src *int32) bool {
+
+	if p.DraftFailCnt == src {
+		return true
+	} else if p.DraftFailCnt == nil || src == nil {
+		return false
+	}
+	if *p.DraftFailCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionReport) Field7DeepEqual(src *float64) bool {
+
+	if p.MeanDrift == src {
+		return true
+	} else if p.MeanDrift == nil || src == nil {
+		return false
+	}
+	if *p.MeanDrift != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionReport) Field8DeepEqual(src *float64) bool {
+
+	if p.MeanAbsDrift == src {
+		return true
+	} else if p.MeanAbsDrift == nil || src == nil {
+		return false
+	}
+	if *p.MeanAbsDrift != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionReport) Field9DeepEqual(src *float64) bool {
+
+	if p.MaxAbsDrift == src {
+		return true
+	} else if p.MaxAbsDrift == nil || src == nil {
+		return false
+	}
+	if *p.MaxAbsDrift != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionReport) Field10DeepEqual(src *int32) bool {
+
+	if p.FlipCnt == src {
+		return true
+	} else if p.FlipCnt == nil || src == nil {
+		return false
+	}
+	if *p.FlipCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionReport) Field11DeepEqual(src *float64) bool {
+
+	if p.FlipRate == src {
+		return true
+	} else if p.FlipRate == nil || src == nil {
+		return false
+	}
+	if *p.FlipRate != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionReport) Field12DeepEqual(src *float64) bool {
+
+	if p.BaseAvgLatencyMs == src {
+		return true
+	} else if p.BaseAvgLatencyMs == nil || src == nil {
+		return false
+	}
+	if *p.BaseAvgLatencyMs != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionReport) Field13DeepEqual(src *float64) bool {
+
+	if p.DraftAvgLatencyMs == src {
+		return true
+	} else if p.DraftAvgLatencyMs == nil || src == nil {
+		return false
+	}
+	if *p.DraftAvgLatencyMs != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionReport) Field14DeepEqual(src *float64) bool {
+
+	if p.BaseAvgTokens == src {
+		return true
+	} else if p.BaseAvgTokens == nil || src == nil {
+		return false
+	}
+	if *p.BaseAvgTokens != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionReport) Field15DeepEqual(src *float64) bool {
+
+	if p.DraftAvgTokens == src {
+		return true
+	} else if p.DraftAvgTokens == nil || src == nil {
+		return false
+	}
+	if *p.DraftAvgTokens != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionReport) Field16DeepEqual(src *float64) bool {
+
+	if p.LatencyChangeRatio == src {
+		return true
+	} else if p.LatencyChangeRatio == nil || src == nil {
+		return false
+	}
+	if *p.LatencyChangeRatio != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionReport) Field17DeepEqual(src *float64) bool {
+
+	if p.TokenChangeRatio == src {
+		return true
+	} else if p.TokenChangeRatio == nil || src == nil {
+		return false
+	}
+	if *p.TokenChangeRatio != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorPromotionReport) Field18DeepEqual(src []*EvaluatorPromotionViolation) bool {
+
+	if len(p.Violations) != len(src) {
+		return false
+	}
+	for i, v := range p.Violations {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvaluatorPromotionReport) Field19DeepEqual(src []*EvaluatorPromotionDriftItem) bool {
+
+	if len(p.DriftItems) != len(src) {
 		return false
 	}
+	for i, v := range p.DriftItems {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
 	return true
 }
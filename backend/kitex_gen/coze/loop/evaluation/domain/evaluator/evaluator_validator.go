@@ -105,6 +105,23 @@ func (p *CustomRPCEvaluator) IsValid() error {
 	}
 	return nil
 }
+func (p *PairwiseEvaluator) IsValid() error {
+	if p.ModelConfig != nil {
+		if err := p.ModelConfig.IsValid(); err != nil {
+			return fmt.Errorf("field ModelConfig not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *RuleEvaluator) IsValid() error {
+	return nil
+}
+func (p *CompositeComponent) IsValid() error {
+	return nil
+}
+func (p *CompositeEvaluator) IsValid() error {
+	return nil
+}
 func (p *EvaluatorVersion) IsValid() error {
 	if p.BaseInfo != nil {
 		if err := p.BaseInfo.IsValid(); err != nil {
@@ -139,6 +156,21 @@ func (p *EvaluatorContent) IsValid() error {
 			return fmt.Errorf("field AgentEvaluator not valid, %w", err)
 		}
 	}
+	if p.PairwiseEvaluator != nil {
+		if err := p.PairwiseEvaluator.IsValid(); err != nil {
+			return fmt.Errorf("field PairwiseEvaluator not valid, %w", err)
+		}
+	}
+	if p.RuleEvaluator != nil {
+		if err := p.RuleEvaluator.IsValid(); err != nil {
+			return fmt.Errorf("field RuleEvaluator not valid, %w", err)
+		}
+	}
+	if p.CompositeEvaluator != nil {
+		if err := p.CompositeEvaluator.IsValid(); err != nil {
+			return fmt.Errorf("field CompositeEvaluator not valid, %w", err)
+		}
+	}
 	return nil
 }
 func (p *EvaluatorIDVersionItem) IsValid() error {
@@ -280,3 +312,53 @@ func (p *EvaluatorRunConfig) IsValid() error {
 func (p *EvaluatorProgressMessage) IsValid() error {
 	return nil
 }
+func (p *CalibrationFieldConf) IsValid() error {
+	return nil
+}
+func (p *EvaluatorCalibrationConf) IsValid() error {
+	return nil
+}
+func (p *CalibrationConfusionMatrix) IsValid() error {
+	return nil
+}
+func (p *CalibrationItemResult_) IsValid() error {
+	return nil
+}
+func (p *EvaluatorCalibrationResult_) IsValid() error {
+	if p.ConfusionMatrix != nil {
+		if err := p.ConfusionMatrix.IsValid(); err != nil {
+			return fmt.Errorf("field ConfusionMatrix not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *EvaluatorCalibrationRecord) IsValid() error {
+	if p.Conf != nil {
+		if err := p.Conf.IsValid(); err != nil {
+			return fmt.Errorf("field Conf not valid, %w", err)
+		}
+	}
+	if p.Result_ != nil {
+		if err := p.Result_.IsValid(); err != nil {
+			return fmt.Errorf("field Result_ not valid, %w", err)
+		}
+	}
+	if p.BaseInfo != nil {
+		if err := p.BaseInfo.IsValid(); err != nil {
+			return fmt.Errorf("field BaseInfo not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *EvaluatorPromotionCheckConf) IsValid() error {
+	return nil
+}
+func (p *EvaluatorPromotionViolation) IsValid() error {
+	return nil
+}
+func (p *EvaluatorPromotionDriftItem) IsValid() error {
+	return nil
+}
+func (p *EvaluatorPromotionReport) IsValid() error {
+	return nil
+}
@@ -2652,7 +2652,7 @@ func (p *CustomRPCEvaluator) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorVersion) FastRead(buf []byte) (int, error) {
+func (p *PairwiseEvaluator) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2670,7 +2670,7 @@ func (p *EvaluatorVersion) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -2683,37 +2683,9 @@ func (p *EvaluatorVersion) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField3(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 5:
+		case 2:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField5(buf[offset:])
+				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -2725,9 +2697,9 @@ func (p *EvaluatorVersion) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 6:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField6(buf[offset:])
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -2752,246 +2724,192 @@ func (p *EvaluatorVersion) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorVersion[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PairwiseEvaluator[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorVersion) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.ID = _field
-	return offset, nil
-}
-
-func (p *EvaluatorVersion) FastReadField3(buf []byte) (int, error) {
+func (p *PairwiseEvaluator) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = &v
 	}
-	p.Version = _field
-	return offset, nil
-}
-
-func (p *EvaluatorVersion) FastReadField4(buf []byte) (int, error) {
-	offset := 0
+	_field := make([]*common.Message, 0, size)
+	values := make([]common.Message, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+		_field = append(_field, _elem)
 	}
-	p.Description = _field
+	p.MessageList = _field
 	return offset, nil
 }
 
-func (p *EvaluatorVersion) FastReadField5(buf []byte) (int, error) {
+func (p *PairwiseEvaluator) FastReadField2(buf []byte) (int, error) {
 	offset := 0
-	_field := common.NewBaseInfo()
+	_field := common.NewModelConfig()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.BaseInfo = _field
+	p.ModelConfig = _field
 	return offset, nil
 }
 
-func (p *EvaluatorVersion) FastReadField6(buf []byte) (int, error) {
+func (p *PairwiseEvaluator) FastReadField3(buf []byte) (int, error) {
 	offset := 0
-	_field := NewEvaluatorContent()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.EvaluatorContent = _field
+	p.DisablePositionSwap = _field
 	return offset, nil
 }
 
-func (p *EvaluatorVersion) FastWrite(buf []byte) int {
+func (p *PairwiseEvaluator) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorVersion) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *PairwiseEvaluator) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvaluatorVersion) BLength() int {
+func (p *PairwiseEvaluator) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
+		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field4Length()
-		l += p.field5Length()
-		l += p.field6Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvaluatorVersion) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.ID)
-	}
-	return offset
-}
-
-func (p *EvaluatorVersion) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetVersion() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Version)
-	}
-	return offset
-}
-
-func (p *EvaluatorVersion) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *PairwiseEvaluator) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetDescription() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
+	if p.IsSetMessageList() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.MessageList {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *EvaluatorVersion) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *PairwiseEvaluator) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetBaseInfo() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 5)
-		offset += p.BaseInfo.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetModelConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+		offset += p.ModelConfig.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *EvaluatorVersion) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+func (p *PairwiseEvaluator) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvaluatorContent() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 6)
-		offset += p.EvaluatorContent.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetDisablePositionSwap() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 3)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.DisablePositionSwap)
 	}
 	return offset
 }
 
-func (p *EvaluatorVersion) field1Length() int {
-	l := 0
-	if p.IsSetID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *EvaluatorVersion) field3Length() int {
-	l := 0
-	if p.IsSetVersion() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Version)
-	}
-	return l
-}
-
-func (p *EvaluatorVersion) field4Length() int {
+func (p *PairwiseEvaluator) field1Length() int {
 	l := 0
-	if p.IsSetDescription() {
+	if p.IsSetMessageList() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Description)
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.MessageList {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *EvaluatorVersion) field5Length() int {
+func (p *PairwiseEvaluator) field2Length() int {
 	l := 0
-	if p.IsSetBaseInfo() {
+	if p.IsSetModelConfig() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.BaseInfo.BLength()
+		l += p.ModelConfig.BLength()
 	}
 	return l
 }
 
-func (p *EvaluatorVersion) field6Length() int {
+func (p *PairwiseEvaluator) field3Length() int {
 	l := 0
-	if p.IsSetEvaluatorContent() {
+	if p.IsSetDisablePositionSwap() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.EvaluatorContent.BLength()
+		l += thrift.Binary.BoolLength()
 	}
 	return l
 }
 
-func (p *EvaluatorVersion) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorVersion)
+func (p *PairwiseEvaluator) DeepCopy(s interface{}) error {
+	src, ok := s.(*PairwiseEvaluator)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.ID != nil {
-		tmp := *src.ID
-		p.ID = &tmp
-	}
-
-	if src.Version != nil {
-		var tmp string
-		if *src.Version != "" {
-			tmp = kutils.StringDeepCopy(*src.Version)
-		}
-		p.Version = &tmp
-	}
+	if src.MessageList != nil {
+		p.MessageList = make([]*common.Message, 0, len(src.MessageList))
+		for _, elem := range src.MessageList {
+			var _elem *common.Message
+			if elem != nil {
+				_elem = &common.Message{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
 
-	if src.Description != nil {
-		var tmp string
-		if *src.Description != "" {
-			tmp = kutils.StringDeepCopy(*src.Description)
+			p.MessageList = append(p.MessageList, _elem)
 		}
-		p.Description = &tmp
 	}
 
-	var _baseInfo *common.BaseInfo
-	if src.BaseInfo != nil {
-		_baseInfo = &common.BaseInfo{}
-		if err := _baseInfo.DeepCopy(src.BaseInfo); err != nil {
+	var _modelConfig *common.ModelConfig
+	if src.ModelConfig != nil {
+		_modelConfig = &common.ModelConfig{}
+		if err := _modelConfig.DeepCopy(src.ModelConfig); err != nil {
 			return err
 		}
 	}
-	p.BaseInfo = _baseInfo
+	p.ModelConfig = _modelConfig
 
-	var _evaluatorContent *EvaluatorContent
-	if src.EvaluatorContent != nil {
-		_evaluatorContent = &EvaluatorContent{}
-		if err := _evaluatorContent.DeepCopy(src.EvaluatorContent); err != nil {
-			return err
-		}
+	if src.DisablePositionSwap != nil {
+		tmp := *src.DisablePositionSwap
+		p.DisablePositionSwap = &tmp
 	}
-	p.EvaluatorContent = _evaluatorContent
 
 	return nil
 }
 
-func (p *EvaluatorContent) FastRead(buf []byte) (int, error) {
+func (p *RuleEvaluator) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3009,7 +2927,7 @@ func (p *EvaluatorContent) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -3023,7 +2941,7 @@ func (p *EvaluatorContent) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -3037,7 +2955,7 @@ func (p *EvaluatorContent) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -3050,9 +2968,9 @@ func (p *EvaluatorContent) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 101:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField101(buf[offset:])
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -3064,9 +2982,9 @@ func (p *EvaluatorContent) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 102:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField102(buf[offset:])
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField5(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -3078,9 +2996,9 @@ func (p *EvaluatorContent) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 103:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField103(buf[offset:])
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField6(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -3092,9 +3010,93 @@ func (p *EvaluatorContent) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 104:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField104(buf[offset:])
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField9(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField10(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField11(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 12:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField12(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 13:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField13(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -3119,12 +3121,54 @@ func (p *EvaluatorContent) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorContent[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleEvaluator[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorContent) FastReadField1(buf []byte) (int, error) {
+func (p *RuleEvaluator) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *RuleType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.RuleType = _field
+	return offset, nil
+}
+
+func (p *RuleEvaluator) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ActualFieldKey = _field
+	return offset, nil
+}
+
+func (p *RuleEvaluator) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ReferenceFieldKey = _field
+	return offset, nil
+}
+
+func (p *RuleEvaluator) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *bool
@@ -3134,36 +3178,53 @@ func (p *EvaluatorContent) FastReadField1(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.ReceiveChatHistory = _field
+	p.IgnoreCase = _field
 	return offset, nil
 }
 
-func (p *EvaluatorContent) FastReadField2(buf []byte) (int, error) {
+func (p *RuleEvaluator) FastReadField5(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*common.ArgsSchema, 0, size)
-	values := make([]common.ArgsSchema, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
+	p.TrimSpace = _field
+	return offset, nil
+}
 
-		_field = append(_field, _elem)
+func (p *RuleEvaluator) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	p.InputSchemas = _field
+	p.Pattern = _field
 	return offset, nil
 }
 
-func (p *EvaluatorContent) FastReadField3(buf []byte) (int, error) {
+func (p *RuleEvaluator) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.JSONSchema = _field
+	return offset, nil
+}
+
+func (p *RuleEvaluator) FastReadField8(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -3171,334 +3232,476 @@ func (p *EvaluatorContent) FastReadField3(buf []byte) (int, error) {
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*common.ArgsSchema, 0, size)
-	values := make([]common.ArgsSchema, size)
+	_field := make([]string, 0, size)
 	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+		var _elem string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
+			_elem = v
 		}
 
 		_field = append(_field, _elem)
 	}
-	p.OutputSchemas = _field
+	p.Keywords = _field
 	return offset, nil
 }
 
-func (p *EvaluatorContent) FastReadField101(buf []byte) (int, error) {
+func (p *RuleEvaluator) FastReadField9(buf []byte) (int, error) {
 	offset := 0
-	_field := NewPromptEvaluator()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.PromptEvaluator = _field
+	p.ContainsAll = _field
 	return offset, nil
 }
 
-func (p *EvaluatorContent) FastReadField102(buf []byte) (int, error) {
+func (p *RuleEvaluator) FastReadField10(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCodeEvaluator()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.CodeEvaluator = _field
+	p.Tolerance = _field
 	return offset, nil
 }
 
-func (p *EvaluatorContent) FastReadField103(buf []byte) (int, error) {
+func (p *RuleEvaluator) FastReadField11(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCustomRPCEvaluator()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.CustomRPCEvaluator = _field
+	p.Relative = _field
 	return offset, nil
 }
 
-func (p *EvaluatorContent) FastReadField104(buf []byte) (int, error) {
+func (p *RuleEvaluator) FastReadField12(buf []byte) (int, error) {
 	offset := 0
-	_field := NewAgentEvaluator()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.AgentEvaluator = _field
+	p.BleuMaxN = _field
 	return offset, nil
 }
 
-func (p *EvaluatorContent) FastWrite(buf []byte) int {
+func (p *RuleEvaluator) FastReadField13(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PassThreshold = _field
+	return offset, nil
+}
+
+func (p *RuleEvaluator) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorContent) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *RuleEvaluator) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
+		offset += p.fastWriteField11(buf[offset:], w)
+		offset += p.fastWriteField12(buf[offset:], w)
+		offset += p.fastWriteField13(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField101(buf[offset:], w)
-		offset += p.fastWriteField102(buf[offset:], w)
-		offset += p.fastWriteField103(buf[offset:], w)
-		offset += p.fastWriteField104(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvaluatorContent) BLength() int {
+func (p *RuleEvaluator) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field101Length()
-		l += p.field102Length()
-		l += p.field103Length()
-		l += p.field104Length()
-	}
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
+		l += p.field9Length()
+		l += p.field10Length()
+		l += p.field11Length()
+		l += p.field12Length()
+		l += p.field13Length()
+	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvaluatorContent) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *RuleEvaluator) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetReceiveChatHistory() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 1)
-		offset += thrift.Binary.WriteBool(buf[offset:], *p.ReceiveChatHistory)
+	if p.IsSetRuleType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.RuleType)
 	}
 	return offset
 }
 
-func (p *EvaluatorContent) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *RuleEvaluator) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetInputSchemas() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.InputSchemas {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetActualFieldKey() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ActualFieldKey)
 	}
 	return offset
 }
 
-func (p *EvaluatorContent) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *RuleEvaluator) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetOutputSchemas() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+	if p.IsSetReferenceFieldKey() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ReferenceFieldKey)
+	}
+	return offset
+}
+
+func (p *RuleEvaluator) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetIgnoreCase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 4)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.IgnoreCase)
+	}
+	return offset
+}
+
+func (p *RuleEvaluator) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTrimSpace() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 5)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.TrimSpace)
+	}
+	return offset
+}
+
+func (p *RuleEvaluator) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPattern() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 6)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Pattern)
+	}
+	return offset
+}
+
+func (p *RuleEvaluator) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetJSONSchema() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 7)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.JSONSchema)
+	}
+	return offset
+}
+
+func (p *RuleEvaluator) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetKeywords() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 8)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.OutputSchemas {
+		for _, v := range p.Keywords {
 			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
 		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
 	}
 	return offset
 }
 
-func (p *EvaluatorContent) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
+func (p *RuleEvaluator) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPromptEvaluator() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 101)
-		offset += p.PromptEvaluator.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetContainsAll() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 9)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.ContainsAll)
 	}
 	return offset
 }
 
-func (p *EvaluatorContent) fastWriteField102(buf []byte, w thrift.NocopyWriter) int {
+func (p *RuleEvaluator) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetCodeEvaluator() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 102)
-		offset += p.CodeEvaluator.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetTolerance() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 10)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Tolerance)
 	}
 	return offset
 }
 
-func (p *EvaluatorContent) fastWriteField103(buf []byte, w thrift.NocopyWriter) int {
+func (p *RuleEvaluator) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetCustomRPCEvaluator() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 103)
-		offset += p.CustomRPCEvaluator.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetRelative() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 11)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.Relative)
 	}
 	return offset
 }
 
-func (p *EvaluatorContent) fastWriteField104(buf []byte, w thrift.NocopyWriter) int {
+func (p *RuleEvaluator) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetAgentEvaluator() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 104)
-		offset += p.AgentEvaluator.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetBleuMaxN() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 12)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.BleuMaxN)
 	}
 	return offset
 }
 
-func (p *EvaluatorContent) field1Length() int {
+func (p *RuleEvaluator) fastWriteField13(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPassThreshold() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 13)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.PassThreshold)
+	}
+	return offset
+}
+
+func (p *RuleEvaluator) field1Length() int {
 	l := 0
-	if p.IsSetReceiveChatHistory() {
+	if p.IsSetRuleType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.RuleType)
+	}
+	return l
+}
+
+func (p *RuleEvaluator) field2Length() int {
+	l := 0
+	if p.IsSetActualFieldKey() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ActualFieldKey)
+	}
+	return l
+}
+
+func (p *RuleEvaluator) field3Length() int {
+	l := 0
+	if p.IsSetReferenceFieldKey() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ReferenceFieldKey)
+	}
+	return l
+}
+
+func (p *RuleEvaluator) field4Length() int {
+	l := 0
+	if p.IsSetIgnoreCase() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.BoolLength()
 	}
 	return l
 }
 
-func (p *EvaluatorContent) field2Length() int {
+func (p *RuleEvaluator) field5Length() int {
 	l := 0
-	if p.IsSetInputSchemas() {
+	if p.IsSetTrimSpace() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.InputSchemas {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.BoolLength()
 	}
 	return l
 }
 
-func (p *EvaluatorContent) field3Length() int {
+func (p *RuleEvaluator) field6Length() int {
 	l := 0
-	if p.IsSetOutputSchemas() {
+	if p.IsSetPattern() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Pattern)
+	}
+	return l
+}
+
+func (p *RuleEvaluator) field7Length() int {
+	l := 0
+	if p.IsSetJSONSchema() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.JSONSchema)
+	}
+	return l
+}
+
+func (p *RuleEvaluator) field8Length() int {
+	l := 0
+	if p.IsSetKeywords() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.OutputSchemas {
+		for _, v := range p.Keywords {
 			_ = v
-			l += v.BLength()
+			l += thrift.Binary.StringLengthNocopy(v)
 		}
 	}
 	return l
 }
 
-func (p *EvaluatorContent) field101Length() int {
+func (p *RuleEvaluator) field9Length() int {
 	l := 0
-	if p.IsSetPromptEvaluator() {
+	if p.IsSetContainsAll() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.PromptEvaluator.BLength()
+		l += thrift.Binary.BoolLength()
 	}
 	return l
 }
 
-func (p *EvaluatorContent) field102Length() int {
+func (p *RuleEvaluator) field10Length() int {
 	l := 0
-	if p.IsSetCodeEvaluator() {
+	if p.IsSetTolerance() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.CodeEvaluator.BLength()
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *EvaluatorContent) field103Length() int {
+func (p *RuleEvaluator) field11Length() int {
 	l := 0
-	if p.IsSetCustomRPCEvaluator() {
+	if p.IsSetRelative() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.CustomRPCEvaluator.BLength()
+		l += thrift.Binary.BoolLength()
 	}
 	return l
 }
 
-func (p *EvaluatorContent) field104Length() int {
+func (p *RuleEvaluator) field12Length() int {
 	l := 0
-	if p.IsSetAgentEvaluator() {
+	if p.IsSetBleuMaxN() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.AgentEvaluator.BLength()
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *EvaluatorContent) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorContent)
+func (p *RuleEvaluator) field13Length() int {
+	l := 0
+	if p.IsSetPassThreshold() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *RuleEvaluator) DeepCopy(s interface{}) error {
+	src, ok := s.(*RuleEvaluator)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.ReceiveChatHistory != nil {
-		tmp := *src.ReceiveChatHistory
-		p.ReceiveChatHistory = &tmp
+	if src.RuleType != nil {
+		tmp := *src.RuleType
+		p.RuleType = &tmp
 	}
 
-	if src.InputSchemas != nil {
-		p.InputSchemas = make([]*common.ArgsSchema, 0, len(src.InputSchemas))
-		for _, elem := range src.InputSchemas {
-			var _elem *common.ArgsSchema
-			if elem != nil {
-				_elem = &common.ArgsSchema{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
+	if src.ActualFieldKey != nil {
+		var tmp string
+		if *src.ActualFieldKey != "" {
+			tmp = kutils.StringDeepCopy(*src.ActualFieldKey)
+		}
+		p.ActualFieldKey = &tmp
+	}
 
-			p.InputSchemas = append(p.InputSchemas, _elem)
+	if src.ReferenceFieldKey != nil {
+		var tmp string
+		if *src.ReferenceFieldKey != "" {
+			tmp = kutils.StringDeepCopy(*src.ReferenceFieldKey)
 		}
+		p.ReferenceFieldKey = &tmp
 	}
 
-	if src.OutputSchemas != nil {
-		p.OutputSchemas = make([]*common.ArgsSchema, 0, len(src.OutputSchemas))
-		for _, elem := range src.OutputSchemas {
-			var _elem *common.ArgsSchema
-			if elem != nil {
-				_elem = &common.ArgsSchema{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
+	if src.IgnoreCase != nil {
+		tmp := *src.IgnoreCase
+		p.IgnoreCase = &tmp
+	}
 
-			p.OutputSchemas = append(p.OutputSchemas, _elem)
-		}
+	if src.TrimSpace != nil {
+		tmp := *src.TrimSpace
+		p.TrimSpace = &tmp
 	}
 
-	var _promptEvaluator *PromptEvaluator
-	if src.PromptEvaluator != nil {
-		_promptEvaluator = &PromptEvaluator{}
-		if err := _promptEvaluator.DeepCopy(src.PromptEvaluator); err != nil {
-			return err
+	if src.Pattern != nil {
+		var tmp string
+		if *src.Pattern != "" {
+			tmp = kutils.StringDeepCopy(*src.Pattern)
 		}
+		p.Pattern = &tmp
 	}
-	p.PromptEvaluator = _promptEvaluator
 
-	var _codeEvaluator *CodeEvaluator
-	if src.CodeEvaluator != nil {
-		_codeEvaluator = &CodeEvaluator{}
-		if err := _codeEvaluator.DeepCopy(src.CodeEvaluator); err != nil {
-			return err
+	if src.JSONSchema != nil {
+		var tmp string
+		if *src.JSONSchema != "" {
+			tmp = kutils.StringDeepCopy(*src.JSONSchema)
 		}
+		p.JSONSchema = &tmp
 	}
-	p.CodeEvaluator = _codeEvaluator
 
-	var _customRPCEvaluator *CustomRPCEvaluator
-	if src.CustomRPCEvaluator != nil {
-		_customRPCEvaluator = &CustomRPCEvaluator{}
-		if err := _customRPCEvaluator.DeepCopy(src.CustomRPCEvaluator); err != nil {
-			return err
+	if src.Keywords != nil {
+		p.Keywords = make([]string, 0, len(src.Keywords))
+		for _, elem := range src.Keywords {
+			var _elem string
+			if elem != "" {
+				_elem = kutils.StringDeepCopy(elem)
+			}
+			p.Keywords = append(p.Keywords, _elem)
 		}
 	}
-	p.CustomRPCEvaluator = _customRPCEvaluator
 
-	var _agentEvaluator *AgentEvaluator
-	if src.AgentEvaluator != nil {
-		_agentEvaluator = &AgentEvaluator{}
-		if err := _agentEvaluator.DeepCopy(src.AgentEvaluator); err != nil {
-			return err
-		}
+	if src.ContainsAll != nil {
+		tmp := *src.ContainsAll
+		p.ContainsAll = &tmp
+	}
+
+	if src.Tolerance != nil {
+		tmp := *src.Tolerance
+		p.Tolerance = &tmp
+	}
+
+	if src.Relative != nil {
+		tmp := *src.Relative
+		p.Relative = &tmp
+	}
+
+	if src.BleuMaxN != nil {
+		tmp := *src.BleuMaxN
+		p.BleuMaxN = &tmp
+	}
+
+	if src.PassThreshold != nil {
+		tmp := *src.PassThreshold
+		p.PassThreshold = &tmp
 	}
-	p.AgentEvaluator = _agentEvaluator
 
 	return nil
 }
 
-func (p *EvaluatorIDVersionItem) FastRead(buf []byte) (int, error) {
+func (p *CompositeComponent) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3530,7 +3733,7 @@ func (p *EvaluatorIDVersionItem) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -3543,53 +3746,11 @@ func (p *EvaluatorIDVersionItem) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField3(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.DOUBLE {
-				l, err = p.FastReadField5(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
 			}
 		}
 	}
@@ -3598,52 +3759,12 @@ func (p *EvaluatorIDVersionItem) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorIDVersionItem[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompositeComponent[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorIDVersionItem) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.EvaluatorID = _field
-	return offset, nil
-}
-
-func (p *EvaluatorIDVersionItem) FastReadField2(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Version = _field
-	return offset, nil
-}
-
-func (p *EvaluatorIDVersionItem) FastReadField3(buf []byte) (int, error) {
-	offset := 0
-	_field := NewEvaluatorRunConfig()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.RunConfig = _field
-	return offset, nil
-}
-
-func (p *EvaluatorIDVersionItem) FastReadField4(buf []byte) (int, error) {
+func (p *CompositeComponent) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int64
@@ -3657,7 +3778,7 @@ func (p *EvaluatorIDVersionItem) FastReadField4(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *EvaluatorIDVersionItem) FastReadField5(buf []byte) (int, error) {
+func (p *CompositeComponent) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *float64
@@ -3667,113 +3788,53 @@ func (p *EvaluatorIDVersionItem) FastReadField5(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.ScoreWeight = _field
+	p.Weight = _field
 	return offset, nil
 }
 
-func (p *EvaluatorIDVersionItem) FastWrite(buf []byte) int {
+func (p *CompositeComponent) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorIDVersionItem) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompositeComponent) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvaluatorIDVersionItem) BLength() int {
+func (p *CompositeComponent) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
-		l += p.field3Length()
-		l += p.field4Length()
-		l += p.field5Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvaluatorIDVersionItem) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetEvaluatorID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.EvaluatorID)
-	}
-	return offset
-}
-
-func (p *EvaluatorIDVersionItem) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetVersion() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Version)
-	}
-	return offset
-}
-
-func (p *EvaluatorIDVersionItem) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetRunConfig() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
-		offset += p.RunConfig.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
-}
-
-func (p *EvaluatorIDVersionItem) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompositeComponent) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetEvaluatorVersionID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 		offset += thrift.Binary.WriteI64(buf[offset:], *p.EvaluatorVersionID)
 	}
 	return offset
 }
 
-func (p *EvaluatorIDVersionItem) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompositeComponent) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetScoreWeight() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 5)
-		offset += thrift.Binary.WriteDouble(buf[offset:], *p.ScoreWeight)
+	if p.IsSetWeight() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 2)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Weight)
 	}
 	return offset
 }
 
-func (p *EvaluatorIDVersionItem) field1Length() int {
-	l := 0
-	if p.IsSetEvaluatorID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *EvaluatorIDVersionItem) field2Length() int {
-	l := 0
-	if p.IsSetVersion() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Version)
-	}
-	return l
-}
-
-func (p *EvaluatorIDVersionItem) field3Length() int {
-	l := 0
-	if p.IsSetRunConfig() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.RunConfig.BLength()
-	}
-	return l
-}
-
-func (p *EvaluatorIDVersionItem) field4Length() int {
+func (p *CompositeComponent) field1Length() int {
 	l := 0
 	if p.IsSetEvaluatorVersionID() {
 		l += thrift.Binary.FieldBeginLength()
@@ -3782,57 +3843,35 @@ func (p *EvaluatorIDVersionItem) field4Length() int {
 	return l
 }
 
-func (p *EvaluatorIDVersionItem) field5Length() int {
+func (p *CompositeComponent) field2Length() int {
 	l := 0
-	if p.IsSetScoreWeight() {
+	if p.IsSetWeight() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *EvaluatorIDVersionItem) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorIDVersionItem)
+func (p *CompositeComponent) DeepCopy(s interface{}) error {
+	src, ok := s.(*CompositeComponent)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.EvaluatorID != nil {
-		tmp := *src.EvaluatorID
-		p.EvaluatorID = &tmp
-	}
-
-	if src.Version != nil {
-		var tmp string
-		if *src.Version != "" {
-			tmp = kutils.StringDeepCopy(*src.Version)
-		}
-		p.Version = &tmp
-	}
-
-	var _runConfig *EvaluatorRunConfig
-	if src.RunConfig != nil {
-		_runConfig = &EvaluatorRunConfig{}
-		if err := _runConfig.DeepCopy(src.RunConfig); err != nil {
-			return err
-		}
-	}
-	p.RunConfig = _runConfig
-
 	if src.EvaluatorVersionID != nil {
 		tmp := *src.EvaluatorVersionID
 		p.EvaluatorVersionID = &tmp
 	}
 
-	if src.ScoreWeight != nil {
-		tmp := *src.ScoreWeight
-		p.ScoreWeight = &tmp
+	if src.Weight != nil {
+		tmp := *src.Weight
+		p.Weight = &tmp
 	}
 
 	return nil
 }
 
-func (p *EvaluatorInfo) FastRead(buf []byte) (int, error) {
+func (p *CompositeEvaluator) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3850,7 +3889,7 @@ func (p *EvaluatorInfo) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -3878,7 +3917,7 @@ func (p *EvaluatorInfo) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -3905,6 +3944,20 @@ func (p *EvaluatorInfo) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -3918,40 +3971,65 @@ func (p *EvaluatorInfo) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorInfo[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompositeEvaluator[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorInfo) FastReadField1(buf []byte) (int, error) {
+func (p *CompositeEvaluator) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*CompositeComponent, 0, size)
+	values := make([]CompositeComponent, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Components = _field
+	return offset, nil
+}
+
+func (p *CompositeEvaluator) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *CompositeStrategy
 	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Benchmark = _field
+	p.Strategy = _field
 	return offset, nil
 }
 
-func (p *EvaluatorInfo) FastReadField2(buf []byte) (int, error) {
+func (p *CompositeEvaluator) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Vendor = _field
+	p.MinSuccessCnt = _field
 	return offset, nil
 }
 
-func (p *EvaluatorInfo) FastReadField3(buf []byte) (int, error) {
+func (p *CompositeEvaluator) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -3961,166 +4039,203 @@ func (p *EvaluatorInfo) FastReadField3(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.VendorURL = _field
+	p.CodeContent = _field
 	return offset, nil
 }
 
-func (p *EvaluatorInfo) FastReadField4(buf []byte) (int, error) {
+func (p *CompositeEvaluator) FastReadField5(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
+	var _field *LanguageType
 	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.UserManualURL = _field
+	p.LanguageType = _field
 	return offset, nil
 }
 
-func (p *EvaluatorInfo) FastWrite(buf []byte) int {
+func (p *CompositeEvaluator) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorInfo) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompositeEvaluator) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvaluatorInfo) BLength() int {
+func (p *CompositeEvaluator) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
+		l += p.field5Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvaluatorInfo) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompositeEvaluator) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetBenchmark() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Benchmark)
+	if p.IsSetComponents() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Components {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *EvaluatorInfo) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompositeEvaluator) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetVendor() {
+	if p.IsSetStrategy() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Vendor)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Strategy)
 	}
 	return offset
 }
 
-func (p *EvaluatorInfo) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompositeEvaluator) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetVendorURL() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.VendorURL)
+	if p.IsSetMinSuccessCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.MinSuccessCnt)
 	}
 	return offset
 }
 
-func (p *EvaluatorInfo) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompositeEvaluator) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetUserManualURL() {
+	if p.IsSetCodeContent() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.UserManualURL)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.CodeContent)
 	}
 	return offset
 }
 
-func (p *EvaluatorInfo) field1Length() int {
-	l := 0
-	if p.IsSetBenchmark() {
+func (p *CompositeEvaluator) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLanguageType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.LanguageType)
+	}
+	return offset
+}
+
+func (p *CompositeEvaluator) field1Length() int {
+	l := 0
+	if p.IsSetComponents() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Benchmark)
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Components {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *EvaluatorInfo) field2Length() int {
+func (p *CompositeEvaluator) field2Length() int {
 	l := 0
-	if p.IsSetVendor() {
+	if p.IsSetStrategy() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Vendor)
+		l += thrift.Binary.StringLengthNocopy(*p.Strategy)
 	}
 	return l
 }
 
-func (p *EvaluatorInfo) field3Length() int {
+func (p *CompositeEvaluator) field3Length() int {
 	l := 0
-	if p.IsSetVendorURL() {
+	if p.IsSetMinSuccessCnt() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.VendorURL)
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *EvaluatorInfo) field4Length() int {
+func (p *CompositeEvaluator) field4Length() int {
 	l := 0
-	if p.IsSetUserManualURL() {
+	if p.IsSetCodeContent() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.UserManualURL)
+		l += thrift.Binary.StringLengthNocopy(*p.CodeContent)
 	}
 	return l
 }
 
-func (p *EvaluatorInfo) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorInfo)
+func (p *CompositeEvaluator) field5Length() int {
+	l := 0
+	if p.IsSetLanguageType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.LanguageType)
+	}
+	return l
+}
+
+func (p *CompositeEvaluator) DeepCopy(s interface{}) error {
+	src, ok := s.(*CompositeEvaluator)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Benchmark != nil {
-		var tmp string
-		if *src.Benchmark != "" {
-			tmp = kutils.StringDeepCopy(*src.Benchmark)
+	if src.Components != nil {
+		p.Components = make([]*CompositeComponent, 0, len(src.Components))
+		for _, elem := range src.Components {
+			var _elem *CompositeComponent
+			if elem != nil {
+				_elem = &CompositeComponent{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Components = append(p.Components, _elem)
 		}
-		p.Benchmark = &tmp
 	}
 
-	if src.Vendor != nil {
-		var tmp string
-		if *src.Vendor != "" {
-			tmp = kutils.StringDeepCopy(*src.Vendor)
-		}
-		p.Vendor = &tmp
+	if src.Strategy != nil {
+		tmp := *src.Strategy
+		p.Strategy = &tmp
 	}
 
-	if src.VendorURL != nil {
-		var tmp string
-		if *src.VendorURL != "" {
-			tmp = kutils.StringDeepCopy(*src.VendorURL)
-		}
-		p.VendorURL = &tmp
+	if src.MinSuccessCnt != nil {
+		tmp := *src.MinSuccessCnt
+		p.MinSuccessCnt = &tmp
 	}
 
-	if src.UserManualURL != nil {
+	if src.CodeContent != nil {
 		var tmp string
-		if *src.UserManualURL != "" {
-			tmp = kutils.StringDeepCopy(*src.UserManualURL)
+		if *src.CodeContent != "" {
+			tmp = kutils.StringDeepCopy(*src.CodeContent)
 		}
-		p.UserManualURL = &tmp
+		p.CodeContent = &tmp
+	}
+
+	if src.LanguageType != nil {
+		tmp := *src.LanguageType
+		p.LanguageType = &tmp
 	}
 
 	return nil
 }
 
-func (p *Evaluator) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorVersion) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4151,22 +4266,8 @@ func (p *Evaluator) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -4194,7 +4295,7 @@ func (p *Evaluator) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField5(buf[offset:])
 				offset += l
 				if err != nil {
@@ -4208,134 +4309,8 @@ func (p *Evaluator) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 6:
-			if fieldTypeId == thrift.BOOL {
-				l, err = p.FastReadField6(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 7:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField7(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 11:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField11(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 12:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField12(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 20:
-			if fieldTypeId == thrift.BOOL {
-				l, err = p.FastReadField20(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 21:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField21(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 22:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField22(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 23:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField23(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 24:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField24(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 100:
-			if fieldTypeId == thrift.MAP {
-				l, err = p.FastReadField100(buf[offset:])
+				l, err = p.FastReadField6(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -4360,12 +4335,12 @@ func (p *Evaluator) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Evaluator[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorVersion[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *Evaluator) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorVersion) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int64
@@ -4375,493 +4350,156 @@ func (p *Evaluator) FastReadField1(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.EvaluatorID = _field
+	p.ID = _field
 	return offset, nil
 }
 
-func (p *Evaluator) FastReadField2(buf []byte) (int, error) {
+func (p *EvaluatorVersion) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.WorkspaceID = _field
+	p.Version = _field
 	return offset, nil
 }
 
-func (p *Evaluator) FastReadField3(buf []byte) (int, error) {
+func (p *EvaluatorVersion) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *EvaluatorType
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-
-		tmp := EvaluatorType(v)
-		_field = &tmp
+		_field = &v
 	}
-	p.EvaluatorType = _field
+	p.Description = _field
 	return offset, nil
 }
 
-func (p *Evaluator) FastReadField4(buf []byte) (int, error) {
+func (p *EvaluatorVersion) FastReadField5(buf []byte) (int, error) {
 	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_field := common.NewBaseInfo()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
 	}
-	p.Name = _field
+	p.BaseInfo = _field
 	return offset, nil
 }
 
-func (p *Evaluator) FastReadField5(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Description = _field
-	return offset, nil
-}
-
-func (p *Evaluator) FastReadField6(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *bool
-	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.DraftSubmitted = _field
-	return offset, nil
-}
-
-func (p *Evaluator) FastReadField7(buf []byte) (int, error) {
-	offset := 0
-	_field := common.NewBaseInfo()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.BaseInfo = _field
-	return offset, nil
-}
-
-func (p *Evaluator) FastReadField11(buf []byte) (int, error) {
-	offset := 0
-	_field := NewEvaluatorVersion()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.CurrentVersion = _field
-	return offset, nil
-}
-
-func (p *Evaluator) FastReadField12(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.LatestVersion = _field
-	return offset, nil
-}
-
-func (p *Evaluator) FastReadField20(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *bool
-	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Builtin = _field
-	return offset, nil
-}
-
-func (p *Evaluator) FastReadField21(buf []byte) (int, error) {
+func (p *EvaluatorVersion) FastReadField6(buf []byte) (int, error) {
 	offset := 0
-	_field := NewEvaluatorInfo()
+	_field := NewEvaluatorContent()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.EvaluatorInfo = _field
-	return offset, nil
-}
-
-func (p *Evaluator) FastReadField22(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.BuiltinVisibleVersion = _field
-	return offset, nil
-}
-
-func (p *Evaluator) FastReadField23(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *EvaluatorBoxType
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.BoxType = _field
-	return offset, nil
-}
-
-func (p *Evaluator) FastReadField24(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *EvaluatorSourceType
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.SourceType = _field
-	return offset, nil
-}
-
-func (p *Evaluator) FastReadField100(buf []byte) (int, error) {
-	offset := 0
-
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make(map[EvaluatorTagLangType]map[EvaluatorTagKey][]string, size)
-	for i := 0; i < size; i++ {
-		var _key EvaluatorTagLangType
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
-
-		_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
-		offset += l
-		if err != nil {
-			return offset, err
-		}
-		_val := make(map[EvaluatorTagKey][]string, size)
-		for i := 0; i < size; i++ {
-			var _key1 EvaluatorTagKey
-			if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-				return offset, err
-			} else {
-				offset += l
-				_key1 = v
-			}
-
-			_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-			offset += l
-			if err != nil {
-				return offset, err
-			}
-			_val1 := make([]string, 0, size)
-			for i := 0; i < size; i++ {
-				var _elem string
-				if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-					return offset, err
-				} else {
-					offset += l
-					_elem = v
-				}
-
-				_val1 = append(_val1, _elem)
-			}
-
-			_val[_key1] = _val1
-		}
-
-		_field[_key] = _val
-	}
-	p.Tags = _field
+	p.EvaluatorContent = _field
 	return offset, nil
 }
 
-func (p *Evaluator) FastWrite(buf []byte) int {
+func (p *EvaluatorVersion) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *Evaluator) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorVersion) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
-		offset += p.fastWriteField20(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField7(buf[offset:], w)
-		offset += p.fastWriteField11(buf[offset:], w)
-		offset += p.fastWriteField12(buf[offset:], w)
-		offset += p.fastWriteField21(buf[offset:], w)
-		offset += p.fastWriteField22(buf[offset:], w)
-		offset += p.fastWriteField23(buf[offset:], w)
-		offset += p.fastWriteField24(buf[offset:], w)
-		offset += p.fastWriteField100(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *Evaluator) BLength() int {
+func (p *EvaluatorVersion) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
 		l += p.field5Length()
 		l += p.field6Length()
-		l += p.field7Length()
-		l += p.field11Length()
-		l += p.field12Length()
-		l += p.field20Length()
-		l += p.field21Length()
-		l += p.field22Length()
-		l += p.field23Length()
-		l += p.field24Length()
-		l += p.field100Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *Evaluator) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorVersion) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvaluatorID() {
+	if p.IsSetID() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.EvaluatorID)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ID)
 	}
 	return offset
 }
 
-func (p *Evaluator) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorVersion) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetWorkspaceID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.WorkspaceID)
+	if p.IsSetVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Version)
 	}
 	return offset
 }
 
-func (p *Evaluator) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorVersion) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvaluatorType() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
-		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.EvaluatorType))
+	if p.IsSetDescription() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
 	}
 	return offset
 }
 
-func (p *Evaluator) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorVersion) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetName() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
+	if p.IsSetBaseInfo() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 5)
+		offset += p.BaseInfo.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *Evaluator) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorVersion) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetDescription() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
+	if p.IsSetEvaluatorContent() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 6)
+		offset += p.EvaluatorContent.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *Evaluator) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetDraftSubmitted() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 6)
-		offset += thrift.Binary.WriteBool(buf[offset:], *p.DraftSubmitted)
-	}
-	return offset
-}
-
-func (p *Evaluator) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetBaseInfo() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 7)
-		offset += p.BaseInfo.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
-}
-
-func (p *Evaluator) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetCurrentVersion() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 11)
-		offset += p.CurrentVersion.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
-}
-
-func (p *Evaluator) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetLatestVersion() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 12)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.LatestVersion)
-	}
-	return offset
-}
-
-func (p *Evaluator) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetBuiltin() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 20)
-		offset += thrift.Binary.WriteBool(buf[offset:], *p.Builtin)
-	}
-	return offset
-}
-
-func (p *Evaluator) fastWriteField21(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetEvaluatorInfo() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 21)
-		offset += p.EvaluatorInfo.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
-}
-
-func (p *Evaluator) fastWriteField22(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetBuiltinVisibleVersion() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 22)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.BuiltinVisibleVersion)
-	}
-	return offset
-}
-
-func (p *Evaluator) fastWriteField23(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetBoxType() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 23)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.BoxType)
-	}
-	return offset
-}
-
-func (p *Evaluator) fastWriteField24(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetSourceType() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 24)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.SourceType)
-	}
-	return offset
-}
-
-func (p *Evaluator) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetTags() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 100)
-		mapBeginOffset := offset
-		offset += thrift.Binary.MapBeginLength()
-		var length int
-		for k, v := range p.Tags {
-			length++
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
-			mapBeginOffset := offset
-			offset += thrift.Binary.MapBeginLength()
-			var length int
-			for k, v := range v {
-				length++
-				offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
-				listBeginOffset := offset
-				offset += thrift.Binary.ListBeginLength()
-				var length int
-				for _, v := range v {
-					length++
-					offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
-				}
-				thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
-			}
-			thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.LIST, length)
-		}
-		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.MAP, length)
-	}
-	return offset
-}
-
-func (p *Evaluator) field1Length() int {
-	l := 0
-	if p.IsSetEvaluatorID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *Evaluator) field2Length() int {
+func (p *EvaluatorVersion) field1Length() int {
 	l := 0
-	if p.IsSetWorkspaceID() {
+	if p.IsSetID() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *Evaluator) field3Length() int {
-	l := 0
-	if p.IsSetEvaluatorType() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
-	}
-	return l
-}
-
-func (p *Evaluator) field4Length() int {
+func (p *EvaluatorVersion) field3Length() int {
 	l := 0
-	if p.IsSetName() {
+	if p.IsSetVersion() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Name)
+		l += thrift.Binary.StringLengthNocopy(*p.Version)
 	}
 	return l
 }
 
-func (p *Evaluator) field5Length() int {
+func (p *EvaluatorVersion) field4Length() int {
 	l := 0
 	if p.IsSetDescription() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4870,16 +4508,7 @@ func (p *Evaluator) field5Length() int {
 	return l
 }
 
-func (p *Evaluator) field6Length() int {
-	l := 0
-	if p.IsSetDraftSubmitted() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.BoolLength()
-	}
-	return l
-}
-
-func (p *Evaluator) field7Length() int {
+func (p *EvaluatorVersion) field5Length() int {
 	l := 0
 	if p.IsSetBaseInfo() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4888,230 +4517,64 @@ func (p *Evaluator) field7Length() int {
 	return l
 }
 
-func (p *Evaluator) field11Length() int {
+func (p *EvaluatorVersion) field6Length() int {
 	l := 0
-	if p.IsSetCurrentVersion() {
+	if p.IsSetEvaluatorContent() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.CurrentVersion.BLength()
+		l += p.EvaluatorContent.BLength()
 	}
 	return l
 }
 
-func (p *Evaluator) field12Length() int {
-	l := 0
-	if p.IsSetLatestVersion() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.LatestVersion)
+func (p *EvaluatorVersion) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorVersion)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
-	return l
-}
 
-func (p *Evaluator) field20Length() int {
-	l := 0
-	if p.IsSetBuiltin() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.BoolLength()
+	if src.ID != nil {
+		tmp := *src.ID
+		p.ID = &tmp
 	}
-	return l
-}
 
-func (p *Evaluator) field21Length() int {
-	l := 0
-	if p.IsSetEvaluatorInfo() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.EvaluatorInfo.BLength()
+	if src.Version != nil {
+		var tmp string
+		if *src.Version != "" {
+			tmp = kutils.StringDeepCopy(*src.Version)
+		}
+		p.Version = &tmp
 	}
-	return l
-}
 
-func (p *Evaluator) field22Length() int {
-	l := 0
-	if p.IsSetBuiltinVisibleVersion() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.BuiltinVisibleVersion)
+	if src.Description != nil {
+		var tmp string
+		if *src.Description != "" {
+			tmp = kutils.StringDeepCopy(*src.Description)
+		}
+		p.Description = &tmp
 	}
-	return l
-}
 
-func (p *Evaluator) field23Length() int {
-	l := 0
-	if p.IsSetBoxType() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.BoxType)
+	var _baseInfo *common.BaseInfo
+	if src.BaseInfo != nil {
+		_baseInfo = &common.BaseInfo{}
+		if err := _baseInfo.DeepCopy(src.BaseInfo); err != nil {
+			return err
+		}
 	}
-	return l
-}
+	p.BaseInfo = _baseInfo
 
-func (p *Evaluator) field24Length() int {
-	l := 0
-	if p.IsSetSourceType() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.SourceType)
+	var _evaluatorContent *EvaluatorContent
+	if src.EvaluatorContent != nil {
+		_evaluatorContent = &EvaluatorContent{}
+		if err := _evaluatorContent.DeepCopy(src.EvaluatorContent); err != nil {
+			return err
+		}
 	}
-	return l
-}
+	p.EvaluatorContent = _evaluatorContent
 
-func (p *Evaluator) field100Length() int {
-	l := 0
-	if p.IsSetTags() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.MapBeginLength()
-		for k, v := range p.Tags {
-			_, _ = k, v
+	return nil
+}
 
-			l += thrift.Binary.StringLengthNocopy(k)
-			l += thrift.Binary.MapBeginLength()
-			for k, v := range v {
-				_, _ = k, v
-
-				l += thrift.Binary.StringLengthNocopy(k)
-				l += thrift.Binary.ListBeginLength()
-				for _, v := range v {
-					_ = v
-					l += thrift.Binary.StringLengthNocopy(v)
-				}
-			}
-		}
-	}
-	return l
-}
-
-func (p *Evaluator) DeepCopy(s interface{}) error {
-	src, ok := s.(*Evaluator)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
-	}
-
-	if src.EvaluatorID != nil {
-		tmp := *src.EvaluatorID
-		p.EvaluatorID = &tmp
-	}
-
-	if src.WorkspaceID != nil {
-		tmp := *src.WorkspaceID
-		p.WorkspaceID = &tmp
-	}
-
-	if src.EvaluatorType != nil {
-		tmp := *src.EvaluatorType
-		p.EvaluatorType = &tmp
-	}
-
-	if src.Name != nil {
-		var tmp string
-		if *src.Name != "" {
-			tmp = kutils.StringDeepCopy(*src.Name)
-		}
-		p.Name = &tmp
-	}
-
-	if src.Description != nil {
-		var tmp string
-		if *src.Description != "" {
-			tmp = kutils.StringDeepCopy(*src.Description)
-		}
-		p.Description = &tmp
-	}
-
-	if src.DraftSubmitted != nil {
-		tmp := *src.DraftSubmitted
-		p.DraftSubmitted = &tmp
-	}
-
-	var _baseInfo *common.BaseInfo
-	if src.BaseInfo != nil {
-		_baseInfo = &common.BaseInfo{}
-		if err := _baseInfo.DeepCopy(src.BaseInfo); err != nil {
-			return err
-		}
-	}
-	p.BaseInfo = _baseInfo
-
-	var _currentVersion *EvaluatorVersion
-	if src.CurrentVersion != nil {
-		_currentVersion = &EvaluatorVersion{}
-		if err := _currentVersion.DeepCopy(src.CurrentVersion); err != nil {
-			return err
-		}
-	}
-	p.CurrentVersion = _currentVersion
-
-	if src.LatestVersion != nil {
-		var tmp string
-		if *src.LatestVersion != "" {
-			tmp = kutils.StringDeepCopy(*src.LatestVersion)
-		}
-		p.LatestVersion = &tmp
-	}
-
-	if src.Builtin != nil {
-		tmp := *src.Builtin
-		p.Builtin = &tmp
-	}
-
-	var _evaluatorInfo *EvaluatorInfo
-	if src.EvaluatorInfo != nil {
-		_evaluatorInfo = &EvaluatorInfo{}
-		if err := _evaluatorInfo.DeepCopy(src.EvaluatorInfo); err != nil {
-			return err
-		}
-	}
-	p.EvaluatorInfo = _evaluatorInfo
-
-	if src.BuiltinVisibleVersion != nil {
-		var tmp string
-		if *src.BuiltinVisibleVersion != "" {
-			tmp = kutils.StringDeepCopy(*src.BuiltinVisibleVersion)
-		}
-		p.BuiltinVisibleVersion = &tmp
-	}
-
-	if src.BoxType != nil {
-		tmp := *src.BoxType
-		p.BoxType = &tmp
-	}
-
-	if src.SourceType != nil {
-		tmp := *src.SourceType
-		p.SourceType = &tmp
-	}
-
-	if src.Tags != nil {
-		p.Tags = make(map[EvaluatorTagLangType]map[EvaluatorTagKey][]string, len(src.Tags))
-		for key, val := range src.Tags {
-			var _key EvaluatorTagLangType
-			_key = key
-			var _val map[EvaluatorTagKey][]string
-			if val != nil {
-				_val = make(map[EvaluatorTagKey][]string, len(val))
-				for key1, val1 := range val {
-					var _key1 EvaluatorTagKey
-					_key1 = key1
-
-					var _val1 []string
-					if val1 != nil {
-						_val1 = make([]string, 0, len(val1))
-						for _, elem := range val1 {
-							var _elem string
-							if elem != "" {
-								_elem = kutils.StringDeepCopy(elem)
-							}
-							_val1 = append(_val1, _elem)
-						}
-					}
-
-					_val[_key1] = _val1
-				}
-			}
-
-			p.Tags[_key] = _val
-		}
-	}
-
-	return nil
-}
-
-func (p *EvaluatorTemplate) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorContent) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5129,7 +4592,7 @@ func (p *EvaluatorTemplate) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.BOOL {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -5143,7 +4606,7 @@ func (p *EvaluatorTemplate) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -5157,7 +4620,7 @@ func (p *EvaluatorTemplate) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -5170,9 +4633,9 @@ func (p *EvaluatorTemplate) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField4(buf[offset:])
+		case 101:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField101(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -5184,9 +4647,9 @@ func (p *EvaluatorTemplate) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField5(buf[offset:])
+		case 102:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField102(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -5198,9 +4661,9 @@ func (p *EvaluatorTemplate) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField6(buf[offset:])
+		case 103:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField103(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -5212,9 +4675,9 @@ func (p *EvaluatorTemplate) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 7:
+		case 104:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField7(buf[offset:])
+				l, err = p.FastReadField104(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -5226,9 +4689,9 @@ func (p *EvaluatorTemplate) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 9:
-			if fieldTypeId == thrift.MAP {
-				l, err = p.FastReadField9(buf[offset:])
+		case 105:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField105(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -5240,9 +4703,9 @@ func (p *EvaluatorTemplate) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 101:
+		case 106:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField101(buf[offset:])
+				l, err = p.FastReadField106(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -5254,9 +4717,9 @@ func (p *EvaluatorTemplate) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 255:
+		case 107:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
+				l, err = p.FastReadField107(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -5281,557 +4744,509 @@ func (p *EvaluatorTemplate) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorTemplate[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorContent[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorTemplate) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorContent) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.ID = _field
+	p.ReceiveChatHistory = _field
 	return offset, nil
 }
 
-func (p *EvaluatorTemplate) FastReadField2(buf []byte) (int, error) {
+func (p *EvaluatorContent) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = &v
 	}
-	p.WorkspaceID = _field
+	_field := make([]*common.ArgsSchema, 0, size)
+	values := make([]common.ArgsSchema, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.InputSchemas = _field
 	return offset, nil
 }
 
-func (p *EvaluatorTemplate) FastReadField3(buf []byte) (int, error) {
+func (p *EvaluatorContent) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *EvaluatorType
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
+	}
+	_field := make([]*common.ArgsSchema, 0, size)
+	values := make([]common.ArgsSchema, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
 
-		tmp := EvaluatorType(v)
-		_field = &tmp
+		_field = append(_field, _elem)
 	}
-	p.EvaluatorType = _field
+	p.OutputSchemas = _field
 	return offset, nil
 }
 
-func (p *EvaluatorTemplate) FastReadField4(buf []byte) (int, error) {
+func (p *EvaluatorContent) FastReadField101(buf []byte) (int, error) {
 	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_field := NewPromptEvaluator()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
 	}
-	p.Name = _field
+	p.PromptEvaluator = _field
 	return offset, nil
 }
 
-func (p *EvaluatorTemplate) FastReadField5(buf []byte) (int, error) {
+func (p *EvaluatorContent) FastReadField102(buf []byte) (int, error) {
 	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_field := NewCodeEvaluator()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
 	}
-	p.Description = _field
+	p.CodeEvaluator = _field
 	return offset, nil
 }
 
-func (p *EvaluatorTemplate) FastReadField6(buf []byte) (int, error) {
+func (p *EvaluatorContent) FastReadField103(buf []byte) (int, error) {
 	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_field := NewCustomRPCEvaluator()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
 	}
-	p.Popularity = _field
+	p.CustomRPCEvaluator = _field
 	return offset, nil
 }
 
-func (p *EvaluatorTemplate) FastReadField7(buf []byte) (int, error) {
+func (p *EvaluatorContent) FastReadField104(buf []byte) (int, error) {
 	offset := 0
-	_field := NewEvaluatorInfo()
+	_field := NewAgentEvaluator()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.EvaluatorInfo = _field
+	p.AgentEvaluator = _field
 	return offset, nil
 }
 
-func (p *EvaluatorTemplate) FastReadField9(buf []byte) (int, error) {
+func (p *EvaluatorContent) FastReadField105(buf []byte) (int, error) {
 	offset := 0
-
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	_field := NewPairwiseEvaluator()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
-	}
-	_field := make(map[EvaluatorTagLangType]map[EvaluatorTagKey][]string, size)
-	for i := 0; i < size; i++ {
-		var _key EvaluatorTagLangType
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
-
-		_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	} else {
 		offset += l
-		if err != nil {
-			return offset, err
-		}
-		_val := make(map[EvaluatorTagKey][]string, size)
-		for i := 0; i < size; i++ {
-			var _key1 EvaluatorTagKey
-			if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-				return offset, err
-			} else {
-				offset += l
-				_key1 = v
-			}
-
-			_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-			offset += l
-			if err != nil {
-				return offset, err
-			}
-			_val1 := make([]string, 0, size)
-			for i := 0; i < size; i++ {
-				var _elem string
-				if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-					return offset, err
-				} else {
-					offset += l
-					_elem = v
-				}
-
-				_val1 = append(_val1, _elem)
-			}
-
-			_val[_key1] = _val1
-		}
-
-		_field[_key] = _val
 	}
-	p.Tags = _field
+	p.PairwiseEvaluator = _field
 	return offset, nil
 }
 
-func (p *EvaluatorTemplate) FastReadField101(buf []byte) (int, error) {
+func (p *EvaluatorContent) FastReadField106(buf []byte) (int, error) {
 	offset := 0
-	_field := NewEvaluatorContent()
+	_field := NewRuleEvaluator()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.EvaluatorContent = _field
+	p.RuleEvaluator = _field
 	return offset, nil
 }
 
-func (p *EvaluatorTemplate) FastReadField255(buf []byte) (int, error) {
+func (p *EvaluatorContent) FastReadField107(buf []byte) (int, error) {
 	offset := 0
-	_field := common.NewBaseInfo()
+	_field := NewCompositeEvaluator()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.BaseInfo = _field
+	p.CompositeEvaluator = _field
 	return offset, nil
 }
 
-func (p *EvaluatorTemplate) FastWrite(buf []byte) int {
+func (p *EvaluatorContent) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorTemplate) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorContent) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField7(buf[offset:], w)
-		offset += p.fastWriteField9(buf[offset:], w)
 		offset += p.fastWriteField101(buf[offset:], w)
-		offset += p.fastWriteField255(buf[offset:], w)
+		offset += p.fastWriteField102(buf[offset:], w)
+		offset += p.fastWriteField103(buf[offset:], w)
+		offset += p.fastWriteField104(buf[offset:], w)
+		offset += p.fastWriteField105(buf[offset:], w)
+		offset += p.fastWriteField106(buf[offset:], w)
+		offset += p.fastWriteField107(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvaluatorTemplate) BLength() int {
+func (p *EvaluatorContent) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field4Length()
-		l += p.field5Length()
-		l += p.field6Length()
-		l += p.field7Length()
-		l += p.field9Length()
 		l += p.field101Length()
-		l += p.field255Length()
+		l += p.field102Length()
+		l += p.field103Length()
+		l += p.field104Length()
+		l += p.field105Length()
+		l += p.field106Length()
+		l += p.field107Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvaluatorTemplate) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorContent) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.ID)
+	if p.IsSetReceiveChatHistory() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 1)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.ReceiveChatHistory)
 	}
 	return offset
 }
 
-func (p *EvaluatorTemplate) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorContent) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetWorkspaceID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.WorkspaceID)
+	if p.IsSetInputSchemas() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.InputSchemas {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *EvaluatorTemplate) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorContent) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvaluatorType() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
-		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.EvaluatorType))
+	if p.IsSetOutputSchemas() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.OutputSchemas {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *EvaluatorTemplate) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorContent) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetName() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
+	if p.IsSetPromptEvaluator() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 101)
+		offset += p.PromptEvaluator.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *EvaluatorTemplate) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorContent) fastWriteField102(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetDescription() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
+	if p.IsSetCodeEvaluator() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 102)
+		offset += p.CodeEvaluator.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *EvaluatorTemplate) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorContent) fastWriteField103(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPopularity() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 6)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.Popularity)
+	if p.IsSetCustomRPCEvaluator() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 103)
+		offset += p.CustomRPCEvaluator.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *EvaluatorTemplate) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorContent) fastWriteField104(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvaluatorInfo() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 7)
-		offset += p.EvaluatorInfo.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetAgentEvaluator() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 104)
+		offset += p.AgentEvaluator.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *EvaluatorTemplate) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorContent) fastWriteField105(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTags() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 9)
-		mapBeginOffset := offset
-		offset += thrift.Binary.MapBeginLength()
-		var length int
-		for k, v := range p.Tags {
-			length++
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
-			mapBeginOffset := offset
-			offset += thrift.Binary.MapBeginLength()
-			var length int
-			for k, v := range v {
-				length++
-				offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
-				listBeginOffset := offset
-				offset += thrift.Binary.ListBeginLength()
-				var length int
-				for _, v := range v {
-					length++
-					offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
-				}
-				thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
-			}
-			thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.LIST, length)
-		}
-		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.MAP, length)
+	if p.IsSetPairwiseEvaluator() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 105)
+		offset += p.PairwiseEvaluator.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *EvaluatorTemplate) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorContent) fastWriteField106(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvaluatorContent() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 101)
-		offset += p.EvaluatorContent.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetRuleEvaluator() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 106)
+		offset += p.RuleEvaluator.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *EvaluatorTemplate) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorContent) fastWriteField107(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetBaseInfo() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-		offset += p.BaseInfo.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetCompositeEvaluator() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 107)
+		offset += p.CompositeEvaluator.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *EvaluatorTemplate) field1Length() int {
+func (p *EvaluatorContent) field1Length() int {
 	l := 0
-	if p.IsSetID() {
+	if p.IsSetReceiveChatHistory() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += thrift.Binary.BoolLength()
 	}
 	return l
 }
 
-func (p *EvaluatorTemplate) field2Length() int {
+func (p *EvaluatorContent) field2Length() int {
 	l := 0
-	if p.IsSetWorkspaceID() {
+	if p.IsSetInputSchemas() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.InputSchemas {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *EvaluatorTemplate) field3Length() int {
+func (p *EvaluatorContent) field3Length() int {
 	l := 0
-	if p.IsSetEvaluatorType() {
+	if p.IsSetOutputSchemas() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.OutputSchemas {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *EvaluatorTemplate) field4Length() int {
+func (p *EvaluatorContent) field101Length() int {
 	l := 0
-	if p.IsSetName() {
+	if p.IsSetPromptEvaluator() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Name)
+		l += p.PromptEvaluator.BLength()
 	}
 	return l
 }
 
-func (p *EvaluatorTemplate) field5Length() int {
+func (p *EvaluatorContent) field102Length() int {
 	l := 0
-	if p.IsSetDescription() {
+	if p.IsSetCodeEvaluator() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Description)
+		l += p.CodeEvaluator.BLength()
 	}
 	return l
 }
 
-func (p *EvaluatorTemplate) field6Length() int {
+func (p *EvaluatorContent) field103Length() int {
 	l := 0
-	if p.IsSetPopularity() {
+	if p.IsSetCustomRPCEvaluator() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += p.CustomRPCEvaluator.BLength()
 	}
 	return l
 }
 
-func (p *EvaluatorTemplate) field7Length() int {
+func (p *EvaluatorContent) field104Length() int {
 	l := 0
-	if p.IsSetEvaluatorInfo() {
+	if p.IsSetAgentEvaluator() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.EvaluatorInfo.BLength()
+		l += p.AgentEvaluator.BLength()
 	}
 	return l
 }
 
-func (p *EvaluatorTemplate) field9Length() int {
+func (p *EvaluatorContent) field105Length() int {
 	l := 0
-	if p.IsSetTags() {
+	if p.IsSetPairwiseEvaluator() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.MapBeginLength()
-		for k, v := range p.Tags {
-			_, _ = k, v
-
-			l += thrift.Binary.StringLengthNocopy(k)
-			l += thrift.Binary.MapBeginLength()
-			for k, v := range v {
-				_, _ = k, v
-
-				l += thrift.Binary.StringLengthNocopy(k)
-				l += thrift.Binary.ListBeginLength()
-				for _, v := range v {
-					_ = v
-					l += thrift.Binary.StringLengthNocopy(v)
-				}
-			}
-		}
+		l += p.PairwiseEvaluator.BLength()
 	}
 	return l
 }
 
-func (p *EvaluatorTemplate) field101Length() int {
+func (p *EvaluatorContent) field106Length() int {
 	l := 0
-	if p.IsSetEvaluatorContent() {
+	if p.IsSetRuleEvaluator() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.EvaluatorContent.BLength()
+		l += p.RuleEvaluator.BLength()
 	}
 	return l
 }
 
-func (p *EvaluatorTemplate) field255Length() int {
+func (p *EvaluatorContent) field107Length() int {
 	l := 0
-	if p.IsSetBaseInfo() {
+	if p.IsSetCompositeEvaluator() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.BaseInfo.BLength()
+		l += p.CompositeEvaluator.BLength()
 	}
 	return l
 }
 
-func (p *EvaluatorTemplate) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorTemplate)
+func (p *EvaluatorContent) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorContent)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.ID != nil {
-		tmp := *src.ID
-		p.ID = &tmp
+	if src.ReceiveChatHistory != nil {
+		tmp := *src.ReceiveChatHistory
+		p.ReceiveChatHistory = &tmp
 	}
 
-	if src.WorkspaceID != nil {
-		tmp := *src.WorkspaceID
-		p.WorkspaceID = &tmp
-	}
+	if src.InputSchemas != nil {
+		p.InputSchemas = make([]*common.ArgsSchema, 0, len(src.InputSchemas))
+		for _, elem := range src.InputSchemas {
+			var _elem *common.ArgsSchema
+			if elem != nil {
+				_elem = &common.ArgsSchema{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
 
-	if src.EvaluatorType != nil {
-		tmp := *src.EvaluatorType
-		p.EvaluatorType = &tmp
+			p.InputSchemas = append(p.InputSchemas, _elem)
+		}
 	}
 
-	if src.Name != nil {
-		var tmp string
-		if *src.Name != "" {
-			tmp = kutils.StringDeepCopy(*src.Name)
+	if src.OutputSchemas != nil {
+		p.OutputSchemas = make([]*common.ArgsSchema, 0, len(src.OutputSchemas))
+		for _, elem := range src.OutputSchemas {
+			var _elem *common.ArgsSchema
+			if elem != nil {
+				_elem = &common.ArgsSchema{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.OutputSchemas = append(p.OutputSchemas, _elem)
 		}
-		p.Name = &tmp
 	}
 
-	if src.Description != nil {
-		var tmp string
-		if *src.Description != "" {
-			tmp = kutils.StringDeepCopy(*src.Description)
+	var _promptEvaluator *PromptEvaluator
+	if src.PromptEvaluator != nil {
+		_promptEvaluator = &PromptEvaluator{}
+		if err := _promptEvaluator.DeepCopy(src.PromptEvaluator); err != nil {
+			return err
 		}
-		p.Description = &tmp
 	}
+	p.PromptEvaluator = _promptEvaluator
 
-	if src.Popularity != nil {
-		tmp := *src.Popularity
-		p.Popularity = &tmp
+	var _codeEvaluator *CodeEvaluator
+	if src.CodeEvaluator != nil {
+		_codeEvaluator = &CodeEvaluator{}
+		if err := _codeEvaluator.DeepCopy(src.CodeEvaluator); err != nil {
+			return err
+		}
 	}
+	p.CodeEvaluator = _codeEvaluator
 
-	var _evaluatorInfo *EvaluatorInfo
-	if src.EvaluatorInfo != nil {
-		_evaluatorInfo = &EvaluatorInfo{}
-		if err := _evaluatorInfo.DeepCopy(src.EvaluatorInfo); err != nil {
+	var _customRPCEvaluator *CustomRPCEvaluator
+	if src.CustomRPCEvaluator != nil {
+		_customRPCEvaluator = &CustomRPCEvaluator{}
+		if err := _customRPCEvaluator.DeepCopy(src.CustomRPCEvaluator); err != nil {
 			return err
 		}
 	}
-	p.EvaluatorInfo = _evaluatorInfo
-
-	if src.Tags != nil {
-		p.Tags = make(map[EvaluatorTagLangType]map[EvaluatorTagKey][]string, len(src.Tags))
-		for key, val := range src.Tags {
-			var _key EvaluatorTagLangType
-			_key = key
-			var _val map[EvaluatorTagKey][]string
-			if val != nil {
-				_val = make(map[EvaluatorTagKey][]string, len(val))
-				for key1, val1 := range val {
-					var _key1 EvaluatorTagKey
-					_key1 = key1
-
-					var _val1 []string
-					if val1 != nil {
-						_val1 = make([]string, 0, len(val1))
-						for _, elem := range val1 {
-							var _elem string
-							if elem != "" {
-								_elem = kutils.StringDeepCopy(elem)
-							}
-							_val1 = append(_val1, _elem)
-						}
-					}
+	p.CustomRPCEvaluator = _customRPCEvaluator
 
-					_val[_key1] = _val1
-				}
-			}
+	var _agentEvaluator *AgentEvaluator
+	if src.AgentEvaluator != nil {
+		_agentEvaluator = &AgentEvaluator{}
+		if err := _agentEvaluator.DeepCopy(src.AgentEvaluator); err != nil {
+			return err
+		}
+	}
+	p.AgentEvaluator = _agentEvaluator
 
-			p.Tags[_key] = _val
+	var _pairwiseEvaluator *PairwiseEvaluator
+	if src.PairwiseEvaluator != nil {
+		_pairwiseEvaluator = &PairwiseEvaluator{}
+		if err := _pairwiseEvaluator.DeepCopy(src.PairwiseEvaluator); err != nil {
+			return err
 		}
 	}
+	p.PairwiseEvaluator = _pairwiseEvaluator
 
-	var _evaluatorContent *EvaluatorContent
-	if src.EvaluatorContent != nil {
-		_evaluatorContent = &EvaluatorContent{}
-		if err := _evaluatorContent.DeepCopy(src.EvaluatorContent); err != nil {
+	var _ruleEvaluator *RuleEvaluator
+	if src.RuleEvaluator != nil {
+		_ruleEvaluator = &RuleEvaluator{}
+		if err := _ruleEvaluator.DeepCopy(src.RuleEvaluator); err != nil {
 			return err
 		}
 	}
-	p.EvaluatorContent = _evaluatorContent
+	p.RuleEvaluator = _ruleEvaluator
 
-	var _baseInfo *common.BaseInfo
-	if src.BaseInfo != nil {
-		_baseInfo = &common.BaseInfo{}
-		if err := _baseInfo.DeepCopy(src.BaseInfo); err != nil {
+	var _compositeEvaluator *CompositeEvaluator
+	if src.CompositeEvaluator != nil {
+		_compositeEvaluator = &CompositeEvaluator{}
+		if err := _compositeEvaluator.DeepCopy(src.CompositeEvaluator); err != nil {
 			return err
 		}
 	}
-	p.BaseInfo = _baseInfo
+	p.CompositeEvaluator = _compositeEvaluator
 
 	return nil
 }
 
-func (p *EvaluatorFilterOption) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorIDVersionItem) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5849,7 +5264,7 @@ func (p *EvaluatorFilterOption) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -5863,7 +5278,7 @@ func (p *EvaluatorFilterOption) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -5876,6 +5291,48 @@ func (p *EvaluatorFilterOption) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -5889,12 +5346,26 @@ func (p *EvaluatorFilterOption) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFilterOption[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorIDVersionItem[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorFilterOption) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorIDVersionItem) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.EvaluatorID = _field
+	return offset, nil
+}
+
+func (p *EvaluatorIDVersionItem) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -5904,109 +5375,212 @@ func (p *EvaluatorFilterOption) FastReadField1(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.SearchKeyword = _field
+	p.Version = _field
 	return offset, nil
 }
 
-func (p *EvaluatorFilterOption) FastReadField2(buf []byte) (int, error) {
+func (p *EvaluatorIDVersionItem) FastReadField3(buf []byte) (int, error) {
 	offset := 0
-	_field := NewEvaluatorFilters()
+	_field := NewEvaluatorRunConfig()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Filters = _field
+	p.RunConfig = _field
 	return offset, nil
 }
 
-func (p *EvaluatorFilterOption) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
-
-func (p *EvaluatorFilterOption) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorIDVersionItem) FastReadField4(buf []byte) (int, error) {
 	offset := 0
-	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
-}
 
-func (p *EvaluatorFilterOption) BLength() int {
-	l := 0
-	if p != nil {
-		l += p.field1Length()
-		l += p.field2Length()
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	l += thrift.Binary.FieldStopLength()
-	return l
+	p.EvaluatorVersionID = _field
+	return offset, nil
 }
 
-func (p *EvaluatorFilterOption) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorIDVersionItem) FastReadField5(buf []byte) (int, error) {
 	offset := 0
-	if p.IsSetSearchKeyword() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.SearchKeyword)
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ScoreWeight = _field
+	return offset, nil
+}
+
+func (p *EvaluatorIDVersionItem) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorIDVersionItem) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
 	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvaluatorFilterOption) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorIDVersionItem) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorIDVersionItem) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFilters() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
-		offset += p.Filters.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetEvaluatorID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.EvaluatorID)
 	}
 	return offset
 }
 
-func (p *EvaluatorFilterOption) field1Length() int {
+func (p *EvaluatorIDVersionItem) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Version)
+	}
+	return offset
+}
+
+func (p *EvaluatorIDVersionItem) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetRunConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+		offset += p.RunConfig.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorIDVersionItem) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorVersionID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.EvaluatorVersionID)
+	}
+	return offset
+}
+
+func (p *EvaluatorIDVersionItem) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetScoreWeight() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 5)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.ScoreWeight)
+	}
+	return offset
+}
+
+func (p *EvaluatorIDVersionItem) field1Length() int {
 	l := 0
-	if p.IsSetSearchKeyword() {
+	if p.IsSetEvaluatorID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.SearchKeyword)
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *EvaluatorFilterOption) field2Length() int {
+func (p *EvaluatorIDVersionItem) field2Length() int {
 	l := 0
-	if p.IsSetFilters() {
+	if p.IsSetVersion() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Filters.BLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Version)
 	}
 	return l
 }
 
-func (p *EvaluatorFilterOption) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorFilterOption)
+func (p *EvaluatorIDVersionItem) field3Length() int {
+	l := 0
+	if p.IsSetRunConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.RunConfig.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorIDVersionItem) field4Length() int {
+	l := 0
+	if p.IsSetEvaluatorVersionID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluatorIDVersionItem) field5Length() int {
+	l := 0
+	if p.IsSetScoreWeight() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *EvaluatorIDVersionItem) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorIDVersionItem)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.SearchKeyword != nil {
+	if src.EvaluatorID != nil {
+		tmp := *src.EvaluatorID
+		p.EvaluatorID = &tmp
+	}
+
+	if src.Version != nil {
 		var tmp string
-		if *src.SearchKeyword != "" {
-			tmp = kutils.StringDeepCopy(*src.SearchKeyword)
+		if *src.Version != "" {
+			tmp = kutils.StringDeepCopy(*src.Version)
 		}
-		p.SearchKeyword = &tmp
+		p.Version = &tmp
 	}
 
-	var _filters *EvaluatorFilters
-	if src.Filters != nil {
-		_filters = &EvaluatorFilters{}
-		if err := _filters.DeepCopy(src.Filters); err != nil {
+	var _runConfig *EvaluatorRunConfig
+	if src.RunConfig != nil {
+		_runConfig = &EvaluatorRunConfig{}
+		if err := _runConfig.DeepCopy(src.RunConfig); err != nil {
 			return err
 		}
 	}
-	p.Filters = _filters
+	p.RunConfig = _runConfig
+
+	if src.EvaluatorVersionID != nil {
+		tmp := *src.EvaluatorVersionID
+		p.EvaluatorVersionID = &tmp
+	}
+
+	if src.ScoreWeight != nil {
+		tmp := *src.ScoreWeight
+		p.ScoreWeight = &tmp
+	}
 
 	return nil
 }
 
-func (p *EvaluatorFilters) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorInfo) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6024,7 +5598,7 @@ func (p *EvaluatorFilters) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -6052,7 +5626,7 @@ func (p *EvaluatorFilters) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -6065,6 +5639,20 @@ func (p *EvaluatorFilters) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -6078,231 +5666,215 @@ func (p *EvaluatorFilters) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFilters[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorInfo[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorFilters) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorInfo) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*EvaluatorFilterCondition, 0, size)
-	values := make([]EvaluatorFilterCondition, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.FilterConditions = _field
+	p.Benchmark = _field
 	return offset, nil
 }
 
-func (p *EvaluatorFilters) FastReadField2(buf []byte) (int, error) {
+func (p *EvaluatorInfo) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *EvaluatorFilterLogicOp
+	var _field *string
 	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.LogicOp = _field
+	p.Vendor = _field
 	return offset, nil
 }
 
-func (p *EvaluatorFilters) FastReadField3(buf []byte) (int, error) {
+func (p *EvaluatorInfo) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*EvaluatorFilters, 0, size)
-	values := make([]EvaluatorFilters, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
+	p.VendorURL = _field
+	return offset, nil
+}
 
-		_field = append(_field, _elem)
+func (p *EvaluatorInfo) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	p.SubFilters = _field
+	p.UserManualURL = _field
 	return offset, nil
 }
 
-func (p *EvaluatorFilters) FastWrite(buf []byte) int {
+func (p *EvaluatorInfo) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorFilters) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorInfo) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvaluatorFilters) BLength() int {
+func (p *EvaluatorInfo) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvaluatorFilters) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorInfo) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFilterConditions() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.FilterConditions {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetBenchmark() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Benchmark)
 	}
 	return offset
 }
 
-func (p *EvaluatorFilters) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorInfo) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetLogicOp() {
+	if p.IsSetVendor() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.LogicOp)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Vendor)
 	}
 	return offset
 }
 
-func (p *EvaluatorFilters) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorInfo) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSubFilters() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.SubFilters {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetVendorURL() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.VendorURL)
 	}
 	return offset
 }
 
-func (p *EvaluatorFilters) field1Length() int {
+func (p *EvaluatorInfo) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetUserManualURL() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.UserManualURL)
+	}
+	return offset
+}
+
+func (p *EvaluatorInfo) field1Length() int {
 	l := 0
-	if p.IsSetFilterConditions() {
+	if p.IsSetBenchmark() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.FilterConditions {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.StringLengthNocopy(*p.Benchmark)
 	}
 	return l
 }
 
-func (p *EvaluatorFilters) field2Length() int {
+func (p *EvaluatorInfo) field2Length() int {
 	l := 0
-	if p.IsSetLogicOp() {
+	if p.IsSetVendor() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.LogicOp)
+		l += thrift.Binary.StringLengthNocopy(*p.Vendor)
 	}
 	return l
 }
 
-func (p *EvaluatorFilters) field3Length() int {
+func (p *EvaluatorInfo) field3Length() int {
 	l := 0
-	if p.IsSetSubFilters() {
+	if p.IsSetVendorURL() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.SubFilters {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.StringLengthNocopy(*p.VendorURL)
 	}
 	return l
 }
 
-func (p *EvaluatorFilters) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorFilters)
+func (p *EvaluatorInfo) field4Length() int {
+	l := 0
+	if p.IsSetUserManualURL() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.UserManualURL)
+	}
+	return l
+}
+
+func (p *EvaluatorInfo) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorInfo)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.FilterConditions != nil {
-		p.FilterConditions = make([]*EvaluatorFilterCondition, 0, len(src.FilterConditions))
-		for _, elem := range src.FilterConditions {
-			var _elem *EvaluatorFilterCondition
-			if elem != nil {
-				_elem = &EvaluatorFilterCondition{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.FilterConditions = append(p.FilterConditions, _elem)
+	if src.Benchmark != nil {
+		var tmp string
+		if *src.Benchmark != "" {
+			tmp = kutils.StringDeepCopy(*src.Benchmark)
 		}
+		p.Benchmark = &tmp
 	}
 
-	if src.LogicOp != nil {
-		tmp := *src.LogicOp
-		p.LogicOp = &tmp
+	if src.Vendor != nil {
+		var tmp string
+		if *src.Vendor != "" {
+			tmp = kutils.StringDeepCopy(*src.Vendor)
+		}
+		p.Vendor = &tmp
 	}
 
-	if src.SubFilters != nil {
-		p.SubFilters = make([]*EvaluatorFilters, 0, len(src.SubFilters))
-		for _, elem := range src.SubFilters {
-			var _elem *EvaluatorFilters
-			if elem != nil {
-				_elem = &EvaluatorFilters{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
+	if src.VendorURL != nil {
+		var tmp string
+		if *src.VendorURL != "" {
+			tmp = kutils.StringDeepCopy(*src.VendorURL)
+		}
+		p.VendorURL = &tmp
+	}
 
-			p.SubFilters = append(p.SubFilters, _elem)
+	if src.UserManualURL != nil {
+		var tmp string
+		if *src.UserManualURL != "" {
+			tmp = kutils.StringDeepCopy(*src.UserManualURL)
 		}
+		p.UserManualURL = &tmp
 	}
 
 	return nil
 }
 
-func (p *EvaluatorFilterCondition) FastRead(buf []byte) (int, error) {
+func (p *Evaluator) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetTagKey bool = false
-	var issetOperator bool = false
-	var issetValue bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -6314,13 +5886,12 @@ func (p *EvaluatorFilterCondition) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetTagKey = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -6329,13 +5900,12 @@ func (p *EvaluatorFilterCondition) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetOperator = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -6344,13 +5914,12 @@ func (p *EvaluatorFilterCondition) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetValue = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -6358,187 +5927,23 @@ func (p *EvaluatorFilterCondition) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
 			}
-		}
-	}
-
-	if !issetTagKey {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetOperator {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetValue {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFilterCondition[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_EvaluatorFilterCondition[fieldId]))
-}
-
-func (p *EvaluatorFilterCondition) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field EvaluatorTagKey
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.TagKey = _field
-	return offset, nil
-}
-
-func (p *EvaluatorFilterCondition) FastReadField2(buf []byte) (int, error) {
-	offset := 0
-
-	var _field EvaluatorFilterOperatorType
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.Operator = _field
-	return offset, nil
-}
-
-func (p *EvaluatorFilterCondition) FastReadField3(buf []byte) (int, error) {
-	offset := 0
-
-	var _field string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.Value = _field
-	return offset, nil
-}
-
-func (p *EvaluatorFilterCondition) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
-
-func (p *EvaluatorFilterCondition) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
-}
-
-func (p *EvaluatorFilterCondition) BLength() int {
-	l := 0
-	if p != nil {
-		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field3Length()
-	}
-	l += thrift.Binary.FieldStopLength()
-	return l
-}
-
-func (p *EvaluatorFilterCondition) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.TagKey)
-	return offset
-}
-
-func (p *EvaluatorFilterCondition) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Operator)
-	return offset
-}
-
-func (p *EvaluatorFilterCondition) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Value)
-	return offset
-}
-
-func (p *EvaluatorFilterCondition) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.TagKey)
-	return l
-}
-
-func (p *EvaluatorFilterCondition) field2Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.Operator)
-	return l
-}
-
-func (p *EvaluatorFilterCondition) field3Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.Value)
-	return l
-}
-
-func (p *EvaluatorFilterCondition) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorFilterCondition)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
-	}
-
-	p.TagKey = src.TagKey
-
-	p.Operator = src.Operator
-
-	if src.Value != "" {
-		p.Value = kutils.StringDeepCopy(src.Value)
-	}
-
-	return nil
-}
-
-func (p *Correction) FastRead(buf []byte) (int, error) {
-
-	var err error
-	var offset int
-	var l int
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	for {
-		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
-		offset += l
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.DOUBLE {
-				l, err = p.FastReadField1(buf[offset:])
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -6550,9 +5955,51 @@ func (p *Correction) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField11(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 12:
 			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField2(buf[offset:])
+				l, err = p.FastReadField12(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -6564,9 +6011,79 @@ func (p *Correction) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 3:
+		case 20:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField20(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 21:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField21(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 22:
 			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField3(buf[offset:])
+				l, err = p.FastReadField22(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 23:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField23(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 24:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField24(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 100:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField100(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -6591,26 +6108,56 @@ func (p *Correction) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Correction[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Evaluator[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *Correction) FastReadField1(buf []byte) (int, error) {
+func (p *Evaluator) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *float64
-	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Score = _field
+	p.EvaluatorID = _field
 	return offset, nil
 }
 
-func (p *Correction) FastReadField2(buf []byte) (int, error) {
+func (p *Evaluator) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *Evaluator) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *EvaluatorType
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		tmp := EvaluatorType(v)
+		_field = &tmp
+	}
+	p.EvaluatorType = _field
+	return offset, nil
+}
+
+func (p *Evaluator) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -6620,11 +6167,11 @@ func (p *Correction) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Explain = _field
+	p.Name = _field
 	return offset, nil
 }
 
-func (p *Correction) FastReadField3(buf []byte) (int, error) {
+func (p *Evaluator) FastReadField5(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -6634,185 +6181,6456 @@ func (p *Correction) FastReadField3(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.UpdatedBy = _field
+	p.Description = _field
 	return offset, nil
 }
 
-func (p *Correction) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
-
-func (p *Correction) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *Evaluator) FastReadField6(buf []byte) (int, error) {
 	offset := 0
-	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
-}
 
-func (p *Correction) BLength() int {
-	l := 0
-	if p != nil {
-		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field3Length()
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	l += thrift.Binary.FieldStopLength()
-	return l
+	p.DraftSubmitted = _field
+	return offset, nil
 }
 
-func (p *Correction) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *Evaluator) FastReadField7(buf []byte) (int, error) {
 	offset := 0
-	if p.IsSetScore() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 1)
-		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Score)
+	_field := common.NewBaseInfo()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
 	}
-	return offset
+	p.BaseInfo = _field
+	return offset, nil
 }
 
-func (p *Correction) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *Evaluator) FastReadField11(buf []byte) (int, error) {
 	offset := 0
-	if p.IsSetExplain() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Explain)
+	_field := NewEvaluatorVersion()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
 	}
-	return offset
+	p.CurrentVersion = _field
+	return offset, nil
 }
 
-func (p *Correction) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *Evaluator) FastReadField12(buf []byte) (int, error) {
 	offset := 0
-	if p.IsSetUpdatedBy() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.UpdatedBy)
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	return offset
+	p.LatestVersion = _field
+	return offset, nil
 }
 
-func (p *Correction) field1Length() int {
-	l := 0
-	if p.IsSetScore() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.DoubleLength()
+func (p *Evaluator) FastReadField20(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	return l
+	p.Builtin = _field
+	return offset, nil
 }
 
-func (p *Correction) field2Length() int {
-	l := 0
-	if p.IsSetExplain() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Explain)
+func (p *Evaluator) FastReadField21(buf []byte) (int, error) {
+	offset := 0
+	_field := NewEvaluatorInfo()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
 	}
-	return l
+	p.EvaluatorInfo = _field
+	return offset, nil
 }
 
-func (p *Correction) field3Length() int {
-	l := 0
-	if p.IsSetUpdatedBy() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.UpdatedBy)
+func (p *Evaluator) FastReadField22(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	return l
+	p.BuiltinVisibleVersion = _field
+	return offset, nil
 }
 
-func (p *Correction) DeepCopy(s interface{}) error {
-	src, ok := s.(*Correction)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
-	}
+func (p *Evaluator) FastReadField23(buf []byte) (int, error) {
+	offset := 0
 
-	if src.Score != nil {
-		tmp := *src.Score
-		p.Score = &tmp
+	var _field *EvaluatorBoxType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
+	p.BoxType = _field
+	return offset, nil
+}
 
-	if src.Explain != nil {
-		var tmp string
-		if *src.Explain != "" {
-			tmp = kutils.StringDeepCopy(*src.Explain)
-		}
-		p.Explain = &tmp
-	}
+func (p *Evaluator) FastReadField24(buf []byte) (int, error) {
+	offset := 0
 
-	if src.UpdatedBy != nil {
-		var tmp string
-		if *src.UpdatedBy != "" {
-			tmp = kutils.StringDeepCopy(*src.UpdatedBy)
-		}
-		p.UpdatedBy = &tmp
+	var _field *EvaluatorSourceType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-
-	return nil
+	p.SourceType = _field
+	return offset, nil
 }
 
-func (p *EvaluatorRecord) FastRead(buf []byte) (int, error) {
+func (p *Evaluator) FastReadField100(buf []byte) (int, error) {
+	offset := 0
 
-	var err error
-	var offset int
-	var l int
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	for {
-		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[EvaluatorTagLangType]map[EvaluatorTagKey][]string, size)
+	for i := 0; i < size; i++ {
+		var _key EvaluatorTagLangType
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
 		offset += l
 		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
+			return offset, err
 		}
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
+		_val := make(map[EvaluatorTagKey][]string, size)
+		for i := 0; i < size; i++ {
+			var _key1 EvaluatorTagKey
+			if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+				return offset, err
 			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
+				_key1 = v
 			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
+
+			_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+			offset += l
+			if err != nil {
+				return offset, err
 			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField3(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
+			_val1 := make([]string, 0, size)
+			for i := 0; i < size; i++ {
+				var _elem string
+				if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+					return offset, err
+				} else {
+					offset += l
+					_elem = v
 				}
+
+				_val1 = append(_val1, _elem)
 			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
+
+			_val[_key1] = _val1
+		}
+
+		_field[_key] = _val
+	}
+	p.Tags = _field
+	return offset, nil
+}
+
+func (p *Evaluator) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *Evaluator) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField20(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField11(buf[offset:], w)
+		offset += p.fastWriteField12(buf[offset:], w)
+		offset += p.fastWriteField21(buf[offset:], w)
+		offset += p.fastWriteField22(buf[offset:], w)
+		offset += p.fastWriteField23(buf[offset:], w)
+		offset += p.fastWriteField24(buf[offset:], w)
+		offset += p.fastWriteField100(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *Evaluator) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field11Length()
+		l += p.field12Length()
+		l += p.field20Length()
+		l += p.field21Length()
+		l += p.field22Length()
+		l += p.field23Length()
+		l += p.field24Length()
+		l += p.field100Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *Evaluator) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.EvaluatorID)
+	}
+	return offset
+}
+
+func (p *Evaluator) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetWorkspaceID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.WorkspaceID)
+	}
+	return offset
+}
+
+func (p *Evaluator) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.EvaluatorType))
+	}
+	return offset
+}
+
+func (p *Evaluator) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
+	}
+	return offset
+}
+
+func (p *Evaluator) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDescription() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
+	}
+	return offset
+}
+
+func (p *Evaluator) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDraftSubmitted() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 6)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.DraftSubmitted)
+	}
+	return offset
+}
+
+func (p *Evaluator) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseInfo() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 7)
+		offset += p.BaseInfo.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *Evaluator) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCurrentVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 11)
+		offset += p.CurrentVersion.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *Evaluator) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLatestVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 12)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.LatestVersion)
+	}
+	return offset
+}
+
+func (p *Evaluator) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBuiltin() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 20)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.Builtin)
+	}
+	return offset
+}
+
+func (p *Evaluator) fastWriteField21(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorInfo() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 21)
+		offset += p.EvaluatorInfo.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *Evaluator) fastWriteField22(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBuiltinVisibleVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 22)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.BuiltinVisibleVersion)
+	}
+	return offset
+}
+
+func (p *Evaluator) fastWriteField23(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBoxType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 23)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.BoxType)
+	}
+	return offset
+}
+
+func (p *Evaluator) fastWriteField24(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSourceType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 24)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.SourceType)
+	}
+	return offset
+}
+
+func (p *Evaluator) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTags() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 100)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.Tags {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+			mapBeginOffset := offset
+			offset += thrift.Binary.MapBeginLength()
+			var length int
+			for k, v := range v {
+				length++
+				offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+				listBeginOffset := offset
+				offset += thrift.Binary.ListBeginLength()
+				var length int
+				for _, v := range v {
+					length++
+					offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+				}
+				thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
+			}
+			thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.LIST, length)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.MAP, length)
+	}
+	return offset
+}
+
+func (p *Evaluator) field1Length() int {
+	l := 0
+	if p.IsSetEvaluatorID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *Evaluator) field2Length() int {
+	l := 0
+	if p.IsSetWorkspaceID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *Evaluator) field3Length() int {
+	l := 0
+	if p.IsSetEvaluatorType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *Evaluator) field4Length() int {
+	l := 0
+	if p.IsSetName() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Name)
+	}
+	return l
+}
+
+func (p *Evaluator) field5Length() int {
+	l := 0
+	if p.IsSetDescription() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Description)
+	}
+	return l
+}
+
+func (p *Evaluator) field6Length() int {
+	l := 0
+	if p.IsSetDraftSubmitted() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *Evaluator) field7Length() int {
+	l := 0
+	if p.IsSetBaseInfo() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseInfo.BLength()
+	}
+	return l
+}
+
+func (p *Evaluator) field11Length() int {
+	l := 0
+	if p.IsSetCurrentVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.CurrentVersion.BLength()
+	}
+	return l
+}
+
+func (p *Evaluator) field12Length() int {
+	l := 0
+	if p.IsSetLatestVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.LatestVersion)
+	}
+	return l
+}
+
+func (p *Evaluator) field20Length() int {
+	l := 0
+	if p.IsSetBuiltin() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *Evaluator) field21Length() int {
+	l := 0
+	if p.IsSetEvaluatorInfo() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.EvaluatorInfo.BLength()
+	}
+	return l
+}
+
+func (p *Evaluator) field22Length() int {
+	l := 0
+	if p.IsSetBuiltinVisibleVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.BuiltinVisibleVersion)
+	}
+	return l
+}
+
+func (p *Evaluator) field23Length() int {
+	l := 0
+	if p.IsSetBoxType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.BoxType)
+	}
+	return l
+}
+
+func (p *Evaluator) field24Length() int {
+	l := 0
+	if p.IsSetSourceType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.SourceType)
+	}
+	return l
+}
+
+func (p *Evaluator) field100Length() int {
+	l := 0
+	if p.IsSetTags() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.Tags {
+			_, _ = k, v
+
+			l += thrift.Binary.StringLengthNocopy(k)
+			l += thrift.Binary.MapBeginLength()
+			for k, v := range v {
+				_, _ = k, v
+
+				l += thrift.Binary.StringLengthNocopy(k)
+				l += thrift.Binary.ListBeginLength()
+				for _, v := range v {
+					_ = v
+					l += thrift.Binary.StringLengthNocopy(v)
+				}
+			}
+		}
+	}
+	return l
+}
+
+func (p *Evaluator) DeepCopy(s interface{}) error {
+	src, ok := s.(*Evaluator)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.EvaluatorID != nil {
+		tmp := *src.EvaluatorID
+		p.EvaluatorID = &tmp
+	}
+
+	if src.WorkspaceID != nil {
+		tmp := *src.WorkspaceID
+		p.WorkspaceID = &tmp
+	}
+
+	if src.EvaluatorType != nil {
+		tmp := *src.EvaluatorType
+		p.EvaluatorType = &tmp
+	}
+
+	if src.Name != nil {
+		var tmp string
+		if *src.Name != "" {
+			tmp = kutils.StringDeepCopy(*src.Name)
+		}
+		p.Name = &tmp
+	}
+
+	if src.Description != nil {
+		var tmp string
+		if *src.Description != "" {
+			tmp = kutils.StringDeepCopy(*src.Description)
+		}
+		p.Description = &tmp
+	}
+
+	if src.DraftSubmitted != nil {
+		tmp := *src.DraftSubmitted
+		p.DraftSubmitted = &tmp
+	}
+
+	var _baseInfo *common.BaseInfo
+	if src.BaseInfo != nil {
+		_baseInfo = &common.BaseInfo{}
+		if err := _baseInfo.DeepCopy(src.BaseInfo); err != nil {
+			return err
+		}
+	}
+	p.BaseInfo = _baseInfo
+
+	var _currentVersion *EvaluatorVersion
+	if src.CurrentVersion != nil {
+		_currentVersion = &EvaluatorVersion{}
+		if err := _currentVersion.DeepCopy(src.CurrentVersion); err != nil {
+			return err
+		}
+	}
+	p.CurrentVersion = _currentVersion
+
+	if src.LatestVersion != nil {
+		var tmp string
+		if *src.LatestVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.LatestVersion)
+		}
+		p.LatestVersion = &tmp
+	}
+
+	if src.Builtin != nil {
+		tmp := *src.Builtin
+		p.Builtin = &tmp
+	}
+
+	var _evaluatorInfo *EvaluatorInfo
+	if src.EvaluatorInfo != nil {
+		_evaluatorInfo = &EvaluatorInfo{}
+		if err := _evaluatorInfo.DeepCopy(src.EvaluatorInfo); err != nil {
+			return err
+		}
+	}
+	p.EvaluatorInfo = _evaluatorInfo
+
+	if src.BuiltinVisibleVersion != nil {
+		var tmp string
+		if *src.BuiltinVisibleVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.BuiltinVisibleVersion)
+		}
+		p.BuiltinVisibleVersion = &tmp
+	}
+
+	if src.BoxType != nil {
+		tmp := *src.BoxType
+		p.BoxType = &tmp
+	}
+
+	if src.SourceType != nil {
+		tmp := *src.SourceType
+		p.SourceType = &tmp
+	}
+
+	if src.Tags != nil {
+		p.Tags = make(map[EvaluatorTagLangType]map[EvaluatorTagKey][]string, len(src.Tags))
+		for key, val := range src.Tags {
+			var _key EvaluatorTagLangType
+			_key = key
+			var _val map[EvaluatorTagKey][]string
+			if val != nil {
+				_val = make(map[EvaluatorTagKey][]string, len(val))
+				for key1, val1 := range val {
+					var _key1 EvaluatorTagKey
+					_key1 = key1
+
+					var _val1 []string
+					if val1 != nil {
+						_val1 = make([]string, 0, len(val1))
+						for _, elem := range val1 {
+							var _elem string
+							if elem != "" {
+								_elem = kutils.StringDeepCopy(elem)
+							}
+							_val1 = append(_val1, _elem)
+						}
+					}
+
+					_val[_key1] = _val1
+				}
+			}
+
+			p.Tags[_key] = _val
+		}
+	}
+
+	return nil
+}
+
+func (p *EvaluatorTemplate) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField9(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 101:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField101(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorTemplate[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorTemplate) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ID = _field
+	return offset, nil
+}
+
+func (p *EvaluatorTemplate) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *EvaluatorTemplate) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *EvaluatorType
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		tmp := EvaluatorType(v)
+		_field = &tmp
+	}
+	p.EvaluatorType = _field
+	return offset, nil
+}
+
+func (p *EvaluatorTemplate) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Name = _field
+	return offset, nil
+}
+
+func (p *EvaluatorTemplate) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Description = _field
+	return offset, nil
+}
+
+func (p *EvaluatorTemplate) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Popularity = _field
+	return offset, nil
+}
+
+func (p *EvaluatorTemplate) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+	_field := NewEvaluatorInfo()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.EvaluatorInfo = _field
+	return offset, nil
+}
+
+func (p *EvaluatorTemplate) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[EvaluatorTagLangType]map[EvaluatorTagKey][]string, size)
+	for i := 0; i < size; i++ {
+		var _key EvaluatorTagLangType
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			return offset, err
+		}
+		_val := make(map[EvaluatorTagKey][]string, size)
+		for i := 0; i < size; i++ {
+			var _key1 EvaluatorTagKey
+			if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+				return offset, err
+			} else {
+				offset += l
+				_key1 = v
+			}
+
+			_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+			offset += l
+			if err != nil {
+				return offset, err
+			}
+			_val1 := make([]string, 0, size)
+			for i := 0; i < size; i++ {
+				var _elem string
+				if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+					return offset, err
+				} else {
+					offset += l
+					_elem = v
+				}
+
+				_val1 = append(_val1, _elem)
+			}
+
+			_val[_key1] = _val1
+		}
+
+		_field[_key] = _val
+	}
+	p.Tags = _field
+	return offset, nil
+}
+
+func (p *EvaluatorTemplate) FastReadField101(buf []byte) (int, error) {
+	offset := 0
+	_field := NewEvaluatorContent()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.EvaluatorContent = _field
+	return offset, nil
+}
+
+func (p *EvaluatorTemplate) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewBaseInfo()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseInfo = _field
+	return offset, nil
+}
+
+func (p *EvaluatorTemplate) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorTemplate) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
+		offset += p.fastWriteField101(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorTemplate) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field9Length()
+		l += p.field101Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorTemplate) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ID)
+	}
+	return offset
+}
+
+func (p *EvaluatorTemplate) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetWorkspaceID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.WorkspaceID)
+	}
+	return offset
+}
+
+func (p *EvaluatorTemplate) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.EvaluatorType))
+	}
+	return offset
+}
+
+func (p *EvaluatorTemplate) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
+	}
+	return offset
+}
+
+func (p *EvaluatorTemplate) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDescription() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
+	}
+	return offset
+}
+
+func (p *EvaluatorTemplate) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPopularity() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 6)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.Popularity)
+	}
+	return offset
+}
+
+func (p *EvaluatorTemplate) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorInfo() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 7)
+		offset += p.EvaluatorInfo.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorTemplate) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTags() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 9)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.Tags {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+			mapBeginOffset := offset
+			offset += thrift.Binary.MapBeginLength()
+			var length int
+			for k, v := range v {
+				length++
+				offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+				listBeginOffset := offset
+				offset += thrift.Binary.ListBeginLength()
+				var length int
+				for _, v := range v {
+					length++
+					offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+				}
+				thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
+			}
+			thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.LIST, length)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.MAP, length)
+	}
+	return offset
+}
+
+func (p *EvaluatorTemplate) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorContent() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 101)
+		offset += p.EvaluatorContent.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorTemplate) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseInfo() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseInfo.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorTemplate) field1Length() int {
+	l := 0
+	if p.IsSetID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluatorTemplate) field2Length() int {
+	l := 0
+	if p.IsSetWorkspaceID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluatorTemplate) field3Length() int {
+	l := 0
+	if p.IsSetEvaluatorType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *EvaluatorTemplate) field4Length() int {
+	l := 0
+	if p.IsSetName() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Name)
+	}
+	return l
+}
+
+func (p *EvaluatorTemplate) field5Length() int {
+	l := 0
+	if p.IsSetDescription() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Description)
+	}
+	return l
+}
+
+func (p *EvaluatorTemplate) field6Length() int {
+	l := 0
+	if p.IsSetPopularity() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluatorTemplate) field7Length() int {
+	l := 0
+	if p.IsSetEvaluatorInfo() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.EvaluatorInfo.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorTemplate) field9Length() int {
+	l := 0
+	if p.IsSetTags() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.Tags {
+			_, _ = k, v
+
+			l += thrift.Binary.StringLengthNocopy(k)
+			l += thrift.Binary.MapBeginLength()
+			for k, v := range v {
+				_, _ = k, v
+
+				l += thrift.Binary.StringLengthNocopy(k)
+				l += thrift.Binary.ListBeginLength()
+				for _, v := range v {
+					_ = v
+					l += thrift.Binary.StringLengthNocopy(v)
+				}
+			}
+		}
+	}
+	return l
+}
+
+func (p *EvaluatorTemplate) field101Length() int {
+	l := 0
+	if p.IsSetEvaluatorContent() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.EvaluatorContent.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorTemplate) field255Length() int {
+	l := 0
+	if p.IsSetBaseInfo() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseInfo.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorTemplate) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorTemplate)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.ID != nil {
+		tmp := *src.ID
+		p.ID = &tmp
+	}
+
+	if src.WorkspaceID != nil {
+		tmp := *src.WorkspaceID
+		p.WorkspaceID = &tmp
+	}
+
+	if src.EvaluatorType != nil {
+		tmp := *src.EvaluatorType
+		p.EvaluatorType = &tmp
+	}
+
+	if src.Name != nil {
+		var tmp string
+		if *src.Name != "" {
+			tmp = kutils.StringDeepCopy(*src.Name)
+		}
+		p.Name = &tmp
+	}
+
+	if src.Description != nil {
+		var tmp string
+		if *src.Description != "" {
+			tmp = kutils.StringDeepCopy(*src.Description)
+		}
+		p.Description = &tmp
+	}
+
+	if src.Popularity != nil {
+		tmp := *src.Popularity
+		p.Popularity = &tmp
+	}
+
+	var _evaluatorInfo *EvaluatorInfo
+	if src.EvaluatorInfo != nil {
+		_evaluatorInfo = &EvaluatorInfo{}
+		if err := _evaluatorInfo.DeepCopy(src.EvaluatorInfo); err != nil {
+			return err
+		}
+	}
+	p.EvaluatorInfo = _evaluatorInfo
+
+	if src.Tags != nil {
+		p.Tags = make(map[EvaluatorTagLangType]map[EvaluatorTagKey][]string, len(src.Tags))
+		for key, val := range src.Tags {
+			var _key EvaluatorTagLangType
+			_key = key
+			var _val map[EvaluatorTagKey][]string
+			if val != nil {
+				_val = make(map[EvaluatorTagKey][]string, len(val))
+				for key1, val1 := range val {
+					var _key1 EvaluatorTagKey
+					_key1 = key1
+
+					var _val1 []string
+					if val1 != nil {
+						_val1 = make([]string, 0, len(val1))
+						for _, elem := range val1 {
+							var _elem string
+							if elem != "" {
+								_elem = kutils.StringDeepCopy(elem)
+							}
+							_val1 = append(_val1, _elem)
+						}
+					}
+
+					_val[_key1] = _val1
+				}
+			}
+
+			p.Tags[_key] = _val
+		}
+	}
+
+	var _evaluatorContent *EvaluatorContent
+	if src.EvaluatorContent != nil {
+		_evaluatorContent = &EvaluatorContent{}
+		if err := _evaluatorContent.DeepCopy(src.EvaluatorContent); err != nil {
+			return err
+		}
+	}
+	p.EvaluatorContent = _evaluatorContent
+
+	var _baseInfo *common.BaseInfo
+	if src.BaseInfo != nil {
+		_baseInfo = &common.BaseInfo{}
+		if err := _baseInfo.DeepCopy(src.BaseInfo); err != nil {
+			return err
+		}
+	}
+	p.BaseInfo = _baseInfo
+
+	return nil
+}
+
+func (p *EvaluatorFilterOption) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFilterOption[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorFilterOption) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.SearchKeyword = _field
+	return offset, nil
+}
+
+func (p *EvaluatorFilterOption) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := NewEvaluatorFilters()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Filters = _field
+	return offset, nil
+}
+
+func (p *EvaluatorFilterOption) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorFilterOption) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorFilterOption) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorFilterOption) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSearchKeyword() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.SearchKeyword)
+	}
+	return offset
+}
+
+func (p *EvaluatorFilterOption) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFilters() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+		offset += p.Filters.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorFilterOption) field1Length() int {
+	l := 0
+	if p.IsSetSearchKeyword() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.SearchKeyword)
+	}
+	return l
+}
+
+func (p *EvaluatorFilterOption) field2Length() int {
+	l := 0
+	if p.IsSetFilters() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Filters.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorFilterOption) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorFilterOption)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.SearchKeyword != nil {
+		var tmp string
+		if *src.SearchKeyword != "" {
+			tmp = kutils.StringDeepCopy(*src.SearchKeyword)
+		}
+		p.SearchKeyword = &tmp
+	}
+
+	var _filters *EvaluatorFilters
+	if src.Filters != nil {
+		_filters = &EvaluatorFilters{}
+		if err := _filters.DeepCopy(src.Filters); err != nil {
+			return err
+		}
+	}
+	p.Filters = _filters
+
+	return nil
+}
+
+func (p *EvaluatorFilters) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFilters[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorFilters) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*EvaluatorFilterCondition, 0, size)
+	values := make([]EvaluatorFilterCondition, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.FilterConditions = _field
+	return offset, nil
+}
+
+func (p *EvaluatorFilters) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *EvaluatorFilterLogicOp
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.LogicOp = _field
+	return offset, nil
+}
+
+func (p *EvaluatorFilters) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*EvaluatorFilters, 0, size)
+	values := make([]EvaluatorFilters, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.SubFilters = _field
+	return offset, nil
+}
+
+func (p *EvaluatorFilters) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorFilters) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorFilters) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorFilters) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFilterConditions() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.FilterConditions {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *EvaluatorFilters) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLogicOp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.LogicOp)
+	}
+	return offset
+}
+
+func (p *EvaluatorFilters) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSubFilters() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.SubFilters {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *EvaluatorFilters) field1Length() int {
+	l := 0
+	if p.IsSetFilterConditions() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.FilterConditions {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvaluatorFilters) field2Length() int {
+	l := 0
+	if p.IsSetLogicOp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.LogicOp)
+	}
+	return l
+}
+
+func (p *EvaluatorFilters) field3Length() int {
+	l := 0
+	if p.IsSetSubFilters() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.SubFilters {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvaluatorFilters) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorFilters)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.FilterConditions != nil {
+		p.FilterConditions = make([]*EvaluatorFilterCondition, 0, len(src.FilterConditions))
+		for _, elem := range src.FilterConditions {
+			var _elem *EvaluatorFilterCondition
+			if elem != nil {
+				_elem = &EvaluatorFilterCondition{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.FilterConditions = append(p.FilterConditions, _elem)
+		}
+	}
+
+	if src.LogicOp != nil {
+		tmp := *src.LogicOp
+		p.LogicOp = &tmp
+	}
+
+	if src.SubFilters != nil {
+		p.SubFilters = make([]*EvaluatorFilters, 0, len(src.SubFilters))
+		for _, elem := range src.SubFilters {
+			var _elem *EvaluatorFilters
+			if elem != nil {
+				_elem = &EvaluatorFilters{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.SubFilters = append(p.SubFilters, _elem)
+		}
+	}
+
+	return nil
+}
+
+func (p *EvaluatorFilterCondition) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetTagKey bool = false
+	var issetOperator bool = false
+	var issetValue bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetTagKey = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetOperator = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetValue = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetTagKey {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetOperator {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetValue {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFilterCondition[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_EvaluatorFilterCondition[fieldId]))
+}
+
+func (p *EvaluatorFilterCondition) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field EvaluatorTagKey
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.TagKey = _field
+	return offset, nil
+}
+
+func (p *EvaluatorFilterCondition) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field EvaluatorFilterOperatorType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Operator = _field
+	return offset, nil
+}
+
+func (p *EvaluatorFilterCondition) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Value = _field
+	return offset, nil
+}
+
+func (p *EvaluatorFilterCondition) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorFilterCondition) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorFilterCondition) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorFilterCondition) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.TagKey)
+	return offset
+}
+
+func (p *EvaluatorFilterCondition) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Operator)
+	return offset
+}
+
+func (p *EvaluatorFilterCondition) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Value)
+	return offset
+}
+
+func (p *EvaluatorFilterCondition) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.TagKey)
+	return l
+}
+
+func (p *EvaluatorFilterCondition) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.Operator)
+	return l
+}
+
+func (p *EvaluatorFilterCondition) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.Value)
+	return l
+}
+
+func (p *EvaluatorFilterCondition) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorFilterCondition)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.TagKey = src.TagKey
+
+	p.Operator = src.Operator
+
+	if src.Value != "" {
+		p.Value = kutils.StringDeepCopy(src.Value)
+	}
+
+	return nil
+}
+
+func (p *Correction) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Correction[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *Correction) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Score = _field
+	return offset, nil
+}
+
+func (p *Correction) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Explain = _field
+	return offset, nil
+}
+
+func (p *Correction) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.UpdatedBy = _field
+	return offset, nil
+}
+
+func (p *Correction) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *Correction) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *Correction) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *Correction) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetScore() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 1)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Score)
+	}
+	return offset
+}
+
+func (p *Correction) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExplain() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Explain)
+	}
+	return offset
+}
+
+func (p *Correction) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetUpdatedBy() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.UpdatedBy)
+	}
+	return offset
+}
+
+func (p *Correction) field1Length() int {
+	l := 0
+	if p.IsSetScore() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *Correction) field2Length() int {
+	l := 0
+	if p.IsSetExplain() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Explain)
+	}
+	return l
+}
+
+func (p *Correction) field3Length() int {
+	l := 0
+	if p.IsSetUpdatedBy() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.UpdatedBy)
+	}
+	return l
+}
+
+func (p *Correction) DeepCopy(s interface{}) error {
+	src, ok := s.(*Correction)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Score != nil {
+		tmp := *src.Score
+		p.Score = &tmp
+	}
+
+	if src.Explain != nil {
+		var tmp string
+		if *src.Explain != "" {
+			tmp = kutils.StringDeepCopy(*src.Explain)
+		}
+		p.Explain = &tmp
+	}
+
+	if src.UpdatedBy != nil {
+		var tmp string
+		if *src.UpdatedBy != "" {
+			tmp = kutils.StringDeepCopy(*src.UpdatedBy)
+		}
+		p.UpdatedBy = &tmp
+	}
+
+	return nil
+}
+
+func (p *EvaluatorRecord) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField9(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField10(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField11(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 12:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField12(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 20:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField20(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorRecord[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorRecord) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ID = _field
+	return offset, nil
+}
+
+func (p *EvaluatorRecord) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ExperimentID = _field
+	return offset, nil
+}
+
+func (p *EvaluatorRecord) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ExperimentRunID = _field
+	return offset, nil
+}
+
+func (p *EvaluatorRecord) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ItemID = _field
+	return offset, nil
+}
+
+func (p *EvaluatorRecord) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TurnID = _field
+	return offset, nil
+}
+
+func (p *EvaluatorRecord) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.EvaluatorVersionID = _field
+	return offset, nil
+}
+
+func (p *EvaluatorRecord) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TraceID = _field
+	return offset, nil
+}
+
+func (p *EvaluatorRecord) FastReadField8(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.LogID = _field
+	return offset, nil
+}
+
+func (p *EvaluatorRecord) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+	_field := NewEvaluatorInputData()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.EvaluatorInputData = _field
+	return offset, nil
+}
+
+func (p *EvaluatorRecord) FastReadField10(buf []byte) (int, error) {
+	offset := 0
+	_field := NewEvaluatorOutputData()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.EvaluatorOutputData = _field
+	return offset, nil
+}
+
+func (p *EvaluatorRecord) FastReadField11(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *EvaluatorRunStatus
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		tmp := EvaluatorRunStatus(v)
+		_field = &tmp
+	}
+	p.Status = _field
+	return offset, nil
+}
+
+func (p *EvaluatorRecord) FastReadField12(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewBaseInfo()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseInfo = _field
+	return offset, nil
+}
+
+func (p *EvaluatorRecord) FastReadField20(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		var _val string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	p.Ext = _field
+	return offset, nil
+}
+
+func (p *EvaluatorRecord) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorRecord) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
+		offset += p.fastWriteField11(buf[offset:], w)
+		offset += p.fastWriteField12(buf[offset:], w)
+		offset += p.fastWriteField20(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorRecord) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
+		l += p.field9Length()
+		l += p.field10Length()
+		l += p.field11Length()
+		l += p.field12Length()
+		l += p.field20Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorRecord) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ID)
+	}
+	return offset
+}
+
+func (p *EvaluatorRecord) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExperimentID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExperimentID)
+	}
+	return offset
+}
+
+func (p *EvaluatorRecord) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExperimentRunID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExperimentRunID)
+	}
+	return offset
+}
+
+func (p *EvaluatorRecord) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ItemID)
+	}
+	return offset
+}
+
+func (p *EvaluatorRecord) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTurnID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 5)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.TurnID)
+	}
+	return offset
+}
+
+func (p *EvaluatorRecord) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorVersionID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 6)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.EvaluatorVersionID)
+	}
+	return offset
+}
+
+func (p *EvaluatorRecord) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTraceID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 7)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.TraceID)
+	}
+	return offset
+}
+
+func (p *EvaluatorRecord) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLogID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 8)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.LogID)
+	}
+	return offset
+}
+
+func (p *EvaluatorRecord) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorInputData() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 9)
+		offset += p.EvaluatorInputData.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorRecord) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorOutputData() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 10)
+		offset += p.EvaluatorOutputData.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorRecord) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetStatus() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 11)
+		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.Status))
+	}
+	return offset
+}
+
+func (p *EvaluatorRecord) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseInfo() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 12)
+		offset += p.BaseInfo.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorRecord) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 20)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.Ext {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRING, length)
+	}
+	return offset
+}
+
+func (p *EvaluatorRecord) field1Length() int {
+	l := 0
+	if p.IsSetID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluatorRecord) field2Length() int {
+	l := 0
+	if p.IsSetExperimentID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluatorRecord) field3Length() int {
+	l := 0
+	if p.IsSetExperimentRunID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluatorRecord) field4Length() int {
+	l := 0
+	if p.IsSetItemID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluatorRecord) field5Length() int {
+	l := 0
+	if p.IsSetTurnID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluatorRecord) field6Length() int {
+	l := 0
+	if p.IsSetEvaluatorVersionID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluatorRecord) field7Length() int {
+	l := 0
+	if p.IsSetTraceID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.TraceID)
+	}
+	return l
+}
+
+func (p *EvaluatorRecord) field8Length() int {
+	l := 0
+	if p.IsSetLogID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.LogID)
+	}
+	return l
+}
+
+func (p *EvaluatorRecord) field9Length() int {
+	l := 0
+	if p.IsSetEvaluatorInputData() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.EvaluatorInputData.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorRecord) field10Length() int {
+	l := 0
+	if p.IsSetEvaluatorOutputData() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.EvaluatorOutputData.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorRecord) field11Length() int {
+	l := 0
+	if p.IsSetStatus() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *EvaluatorRecord) field12Length() int {
+	l := 0
+	if p.IsSetBaseInfo() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseInfo.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorRecord) field20Length() int {
+	l := 0
+	if p.IsSetExt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.Ext {
+			_, _ = k, v
+
+			l += thrift.Binary.StringLengthNocopy(k)
+			l += thrift.Binary.StringLengthNocopy(v)
+		}
+	}
+	return l
+}
+
+func (p *EvaluatorRecord) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorRecord)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.ID != nil {
+		tmp := *src.ID
+		p.ID = &tmp
+	}
+
+	if src.ExperimentID != nil {
+		tmp := *src.ExperimentID
+		p.ExperimentID = &tmp
+	}
+
+	if src.ExperimentRunID != nil {
+		tmp := *src.ExperimentRunID
+		p.ExperimentRunID = &tmp
+	}
+
+	if src.ItemID != nil {
+		tmp := *src.ItemID
+		p.ItemID = &tmp
+	}
+
+	if src.TurnID != nil {
+		tmp := *src.TurnID
+		p.TurnID = &tmp
+	}
+
+	if src.EvaluatorVersionID != nil {
+		tmp := *src.EvaluatorVersionID
+		p.EvaluatorVersionID = &tmp
+	}
+
+	if src.TraceID != nil {
+		var tmp string
+		if *src.TraceID != "" {
+			tmp = kutils.StringDeepCopy(*src.TraceID)
+		}
+		p.TraceID = &tmp
+	}
+
+	if src.LogID != nil {
+		var tmp string
+		if *src.LogID != "" {
+			tmp = kutils.StringDeepCopy(*src.LogID)
+		}
+		p.LogID = &tmp
+	}
+
+	var _evaluatorInputData *EvaluatorInputData
+	if src.EvaluatorInputData != nil {
+		_evaluatorInputData = &EvaluatorInputData{}
+		if err := _evaluatorInputData.DeepCopy(src.EvaluatorInputData); err != nil {
+			return err
+		}
+	}
+	p.EvaluatorInputData = _evaluatorInputData
+
+	var _evaluatorOutputData *EvaluatorOutputData
+	if src.EvaluatorOutputData != nil {
+		_evaluatorOutputData = &EvaluatorOutputData{}
+		if err := _evaluatorOutputData.DeepCopy(src.EvaluatorOutputData); err != nil {
+			return err
+		}
+	}
+	p.EvaluatorOutputData = _evaluatorOutputData
+
+	if src.Status != nil {
+		tmp := *src.Status
+		p.Status = &tmp
+	}
+
+	var _baseInfo *common.BaseInfo
+	if src.BaseInfo != nil {
+		_baseInfo = &common.BaseInfo{}
+		if err := _baseInfo.DeepCopy(src.BaseInfo); err != nil {
+			return err
+		}
+	}
+	p.BaseInfo = _baseInfo
+
+	if src.Ext != nil {
+		p.Ext = make(map[string]string, len(src.Ext))
+		for key, val := range src.Ext {
+			var _key string
+			if key != "" {
+				_key = kutils.StringDeepCopy(key)
+			}
+
+			var _val string
+			if val != "" {
+				_val = kutils.StringDeepCopy(val)
+			}
+
+			p.Ext[_key] = _val
+		}
+	}
+
+	return nil
+}
+
+func (p *EvaluatorOutputData) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField11(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 12:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField12(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorOutputData[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorOutputData) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewEvaluatorResult_()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.EvaluatorResult_ = _field
+	return offset, nil
+}
+
+func (p *EvaluatorOutputData) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := NewEvaluatorUsage()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.EvaluatorUsage = _field
+	return offset, nil
+}
+
+func (p *EvaluatorOutputData) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+	_field := NewEvaluatorRunError()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.EvaluatorRunError = _field
+	return offset, nil
+}
+
+func (p *EvaluatorOutputData) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TimeConsumingMs = _field
+	return offset, nil
+}
+
+func (p *EvaluatorOutputData) FastReadField11(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Stdout = _field
+	return offset, nil
+}
+
+func (p *EvaluatorOutputData) FastReadField12(buf []byte) (int, error) {
+	offset := 0
+	_field := NewEvaluatorExtraOutputContent()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.ExtraOutput = _field
+	return offset, nil
+}
+
+func (p *EvaluatorOutputData) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorOutputData) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField11(buf[offset:], w)
+		offset += p.fastWriteField12(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorOutputData) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field11Length()
+		l += p.field12Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorOutputData) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorResult_() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.EvaluatorResult_.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorOutputData) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorUsage() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+		offset += p.EvaluatorUsage.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorOutputData) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorRunError() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+		offset += p.EvaluatorRunError.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorOutputData) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTimeConsumingMs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.TimeConsumingMs)
+	}
+	return offset
+}
+
+func (p *EvaluatorOutputData) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetStdout() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 11)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Stdout)
+	}
+	return offset
+}
+
+func (p *EvaluatorOutputData) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExtraOutput() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 12)
+		offset += p.ExtraOutput.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorOutputData) field1Length() int {
+	l := 0
+	if p.IsSetEvaluatorResult_() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.EvaluatorResult_.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorOutputData) field2Length() int {
+	l := 0
+	if p.IsSetEvaluatorUsage() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.EvaluatorUsage.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorOutputData) field3Length() int {
+	l := 0
+	if p.IsSetEvaluatorRunError() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.EvaluatorRunError.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorOutputData) field4Length() int {
+	l := 0
+	if p.IsSetTimeConsumingMs() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluatorOutputData) field11Length() int {
+	l := 0
+	if p.IsSetStdout() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Stdout)
+	}
+	return l
+}
+
+func (p *EvaluatorOutputData) field12Length() int {
+	l := 0
+	if p.IsSetExtraOutput() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.ExtraOutput.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorOutputData) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorOutputData)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _evaluatorResult_ *EvaluatorResult_
+	if src.EvaluatorResult_ != nil {
+		_evaluatorResult_ = &EvaluatorResult_{}
+		if err := _evaluatorResult_.DeepCopy(src.EvaluatorResult_); err != nil {
+			return err
+		}
+	}
+	p.EvaluatorResult_ = _evaluatorResult_
+
+	var _evaluatorUsage *EvaluatorUsage
+	if src.EvaluatorUsage != nil {
+		_evaluatorUsage = &EvaluatorUsage{}
+		if err := _evaluatorUsage.DeepCopy(src.EvaluatorUsage); err != nil {
+			return err
+		}
+	}
+	p.EvaluatorUsage = _evaluatorUsage
+
+	var _evaluatorRunError *EvaluatorRunError
+	if src.EvaluatorRunError != nil {
+		_evaluatorRunError = &EvaluatorRunError{}
+		if err := _evaluatorRunError.DeepCopy(src.EvaluatorRunError); err != nil {
+			return err
+		}
+	}
+	p.EvaluatorRunError = _evaluatorRunError
+
+	if src.TimeConsumingMs != nil {
+		tmp := *src.TimeConsumingMs
+		p.TimeConsumingMs = &tmp
+	}
+
+	if src.Stdout != nil {
+		var tmp string
+		if *src.Stdout != "" {
+			tmp = kutils.StringDeepCopy(*src.Stdout)
+		}
+		p.Stdout = &tmp
+	}
+
+	var _extraOutput *EvaluatorExtraOutputContent
+	if src.ExtraOutput != nil {
+		_extraOutput = &EvaluatorExtraOutputContent{}
+		if err := _extraOutput.DeepCopy(src.ExtraOutput); err != nil {
+			return err
+		}
+	}
+	p.ExtraOutput = _extraOutput
+
+	return nil
+}
+
+func (p *EvaluatorResult_) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorResult_[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorResult_) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Score = _field
+	return offset, nil
+}
+
+func (p *EvaluatorResult_) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := NewCorrection()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Correction = _field
+	return offset, nil
+}
+
+func (p *EvaluatorResult_) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Reasoning = _field
+	return offset, nil
+}
+
+func (p *EvaluatorResult_) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorResult_) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetScore() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 1)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Score)
+	}
+	return offset
+}
+
+func (p *EvaluatorResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCorrection() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+		offset += p.Correction.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorResult_) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetReasoning() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Reasoning)
+	}
+	return offset
+}
+
+func (p *EvaluatorResult_) field1Length() int {
+	l := 0
+	if p.IsSetScore() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *EvaluatorResult_) field2Length() int {
+	l := 0
+	if p.IsSetCorrection() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Correction.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorResult_) field3Length() int {
+	l := 0
+	if p.IsSetReasoning() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Reasoning)
+	}
+	return l
+}
+
+func (p *EvaluatorResult_) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorResult_)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Score != nil {
+		tmp := *src.Score
+		p.Score = &tmp
+	}
+
+	var _correction *Correction
+	if src.Correction != nil {
+		_correction = &Correction{}
+		if err := _correction.DeepCopy(src.Correction); err != nil {
+			return err
+		}
+	}
+	p.Correction = _correction
+
+	if src.Reasoning != nil {
+		var tmp string
+		if *src.Reasoning != "" {
+			tmp = kutils.StringDeepCopy(*src.Reasoning)
+		}
+		p.Reasoning = &tmp
+	}
+
+	return nil
+}
+
+func (p *EvaluatorUsage) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorUsage[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorUsage) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.InputTokens = _field
+	return offset, nil
+}
+
+func (p *EvaluatorUsage) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.OutputTokens = _field
+	return offset, nil
+}
+
+func (p *EvaluatorUsage) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorUsage) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorUsage) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorUsage) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetInputTokens() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.InputTokens)
+	}
+	return offset
+}
+
+func (p *EvaluatorUsage) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetOutputTokens() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.OutputTokens)
+	}
+	return offset
+}
+
+func (p *EvaluatorUsage) field1Length() int {
+	l := 0
+	if p.IsSetInputTokens() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluatorUsage) field2Length() int {
+	l := 0
+	if p.IsSetOutputTokens() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluatorUsage) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorUsage)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.InputTokens != nil {
+		tmp := *src.InputTokens
+		p.InputTokens = &tmp
+	}
+
+	if src.OutputTokens != nil {
+		tmp := *src.OutputTokens
+		p.OutputTokens = &tmp
+	}
+
+	return nil
+}
+
+func (p *EvaluatorRunError) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorRunError[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorRunError) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Code = _field
+	return offset, nil
+}
+
+func (p *EvaluatorRunError) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Message = _field
+	return offset, nil
+}
+
+func (p *EvaluatorRunError) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorRunError) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorRunError) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorRunError) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCode() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 1)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.Code)
+	}
+	return offset
+}
+
+func (p *EvaluatorRunError) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMessage() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Message)
+	}
+	return offset
+}
+
+func (p *EvaluatorRunError) field1Length() int {
+	l := 0
+	if p.IsSetCode() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *EvaluatorRunError) field2Length() int {
+	l := 0
+	if p.IsSetMessage() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Message)
+	}
+	return l
+}
+
+func (p *EvaluatorRunError) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorRunError)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Code != nil {
+		tmp := *src.Code
+		p.Code = &tmp
+	}
+
+	if src.Message != nil {
+		var tmp string
+		if *src.Message != "" {
+			tmp = kutils.StringDeepCopy(*src.Message)
+		}
+		p.Message = &tmp
+	}
+
+	return nil
+}
+
+func (p *EvaluatorExtraOutputContent) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorExtraOutputContent[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorExtraOutputContent) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *EvaluatorExtraOutputType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.OutputType = _field
+	return offset, nil
+}
+
+func (p *EvaluatorExtraOutputContent) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.URI = _field
+	return offset, nil
+}
+
+func (p *EvaluatorExtraOutputContent) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.URL = _field
+	return offset, nil
+}
+
+func (p *EvaluatorExtraOutputContent) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorExtraOutputContent) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorExtraOutputContent) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorExtraOutputContent) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetOutputType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.OutputType)
+	}
+	return offset
+}
+
+func (p *EvaluatorExtraOutputContent) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetURI() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.URI)
+	}
+	return offset
+}
+
+func (p *EvaluatorExtraOutputContent) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetURL() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.URL)
+	}
+	return offset
+}
+
+func (p *EvaluatorExtraOutputContent) field1Length() int {
+	l := 0
+	if p.IsSetOutputType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.OutputType)
+	}
+	return l
+}
+
+func (p *EvaluatorExtraOutputContent) field2Length() int {
+	l := 0
+	if p.IsSetURI() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.URI)
+	}
+	return l
+}
+
+func (p *EvaluatorExtraOutputContent) field3Length() int {
+	l := 0
+	if p.IsSetURL() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.URL)
+	}
+	return l
+}
+
+func (p *EvaluatorExtraOutputContent) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorExtraOutputContent)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.OutputType != nil {
+		tmp := *src.OutputType
+		p.OutputType = &tmp
+	}
+
+	if src.URI != nil {
+		var tmp string
+		if *src.URI != "" {
+			tmp = kutils.StringDeepCopy(*src.URI)
+		}
+		p.URI = &tmp
+	}
+
+	if src.URL != nil {
+		var tmp string
+		if *src.URL != "" {
+			tmp = kutils.StringDeepCopy(*src.URL)
+		}
+		p.URL = &tmp
+	}
+
+	return nil
+}
+
+func (p *EvaluatorInputData) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 100:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField100(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorInputData[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorInputData) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*common.Message, 0, size)
+	values := make([]common.Message, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.HistoryMessages = _field
+	return offset, nil
+}
+
+func (p *EvaluatorInputData) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[string]*common.Content, size)
+	values := make([]common.Content, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if l, err := _val.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field[_key] = _val
+	}
+	p.InputFields = _field
+	return offset, nil
+}
+
+func (p *EvaluatorInputData) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[string]*common.Content, size)
+	values := make([]common.Content, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if l, err := _val.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field[_key] = _val
+	}
+	p.EvaluateDatasetFields = _field
+	return offset, nil
+}
+
+func (p *EvaluatorInputData) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[string]*common.Content, size)
+	values := make([]common.Content, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if l, err := _val.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field[_key] = _val
+	}
+	p.EvaluateTargetOutputFields = _field
+	return offset, nil
+}
+
+func (p *EvaluatorInputData) FastReadField100(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		var _val string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	p.Ext = _field
+	return offset, nil
+}
+
+func (p *EvaluatorInputData) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorInputData) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField100(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorInputData) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field100Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorInputData) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetHistoryMessages() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.HistoryMessages {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *EvaluatorInputData) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetInputFields() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 2)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.InputFields {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *EvaluatorInputData) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluateDatasetFields() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 3)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.EvaluateDatasetFields {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *EvaluatorInputData) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluateTargetOutputFields() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 4)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.EvaluateTargetOutputFields {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *EvaluatorInputData) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 100)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.Ext {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRING, length)
+	}
+	return offset
+}
+
+func (p *EvaluatorInputData) field1Length() int {
+	l := 0
+	if p.IsSetHistoryMessages() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.HistoryMessages {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvaluatorInputData) field2Length() int {
+	l := 0
+	if p.IsSetInputFields() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.InputFields {
+			_, _ = k, v
+
+			l += thrift.Binary.StringLengthNocopy(k)
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvaluatorInputData) field3Length() int {
+	l := 0
+	if p.IsSetEvaluateDatasetFields() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.EvaluateDatasetFields {
+			_, _ = k, v
+
+			l += thrift.Binary.StringLengthNocopy(k)
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvaluatorInputData) field4Length() int {
+	l := 0
+	if p.IsSetEvaluateTargetOutputFields() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.EvaluateTargetOutputFields {
+			_, _ = k, v
+
+			l += thrift.Binary.StringLengthNocopy(k)
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvaluatorInputData) field100Length() int {
+	l := 0
+	if p.IsSetExt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.Ext {
+			_, _ = k, v
+
+			l += thrift.Binary.StringLengthNocopy(k)
+			l += thrift.Binary.StringLengthNocopy(v)
+		}
+	}
+	return l
+}
+
+func (p *EvaluatorInputData) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorInputData)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.HistoryMessages != nil {
+		p.HistoryMessages = make([]*common.Message, 0, len(src.HistoryMessages))
+		for _, elem := range src.HistoryMessages {
+			var _elem *common.Message
+			if elem != nil {
+				_elem = &common.Message{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.HistoryMessages = append(p.HistoryMessages, _elem)
+		}
+	}
+
+	if src.InputFields != nil {
+		p.InputFields = make(map[string]*common.Content, len(src.InputFields))
+		for key, val := range src.InputFields {
+			var _key string
+			if key != "" {
+				_key = kutils.StringDeepCopy(key)
+			}
+
+			var _val *common.Content
+			if val != nil {
+				_val = &common.Content{}
+				if err := _val.DeepCopy(val); err != nil {
+					return err
+				}
+			}
+
+			p.InputFields[_key] = _val
+		}
+	}
+
+	if src.EvaluateDatasetFields != nil {
+		p.EvaluateDatasetFields = make(map[string]*common.Content, len(src.EvaluateDatasetFields))
+		for key, val := range src.EvaluateDatasetFields {
+			var _key string
+			if key != "" {
+				_key = kutils.StringDeepCopy(key)
+			}
+
+			var _val *common.Content
+			if val != nil {
+				_val = &common.Content{}
+				if err := _val.DeepCopy(val); err != nil {
+					return err
+				}
+			}
+
+			p.EvaluateDatasetFields[_key] = _val
+		}
+	}
+
+	if src.EvaluateTargetOutputFields != nil {
+		p.EvaluateTargetOutputFields = make(map[string]*common.Content, len(src.EvaluateTargetOutputFields))
+		for key, val := range src.EvaluateTargetOutputFields {
+			var _key string
+			if key != "" {
+				_key = kutils.StringDeepCopy(key)
+			}
+
+			var _val *common.Content
+			if val != nil {
+				_val = &common.Content{}
+				if err := _val.DeepCopy(val); err != nil {
+					return err
+				}
+			}
+
+			p.EvaluateTargetOutputFields[_key] = _val
+		}
+	}
+
+	if src.Ext != nil {
+		p.Ext = make(map[string]string, len(src.Ext))
+		for key, val := range src.Ext {
+			var _key string
+			if key != "" {
+				_key = kutils.StringDeepCopy(key)
+			}
+
+			var _val string
+			if val != "" {
+				_val = kutils.StringDeepCopy(val)
+			}
+
+			p.Ext[_key] = _val
+		}
+	}
+
+	return nil
+}
+
+func (p *EvaluatorHTTPInfo) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorHTTPInfo[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorHTTPInfo) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *EvaluatorHTTPMethod
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Method = _field
+	return offset, nil
+}
+
+func (p *EvaluatorHTTPInfo) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Path = _field
+	return offset, nil
+}
+
+func (p *EvaluatorHTTPInfo) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorHTTPInfo) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorHTTPInfo) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorHTTPInfo) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMethod() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Method)
+	}
+	return offset
+}
+
+func (p *EvaluatorHTTPInfo) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPath() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Path)
+	}
+	return offset
+}
+
+func (p *EvaluatorHTTPInfo) field1Length() int {
+	l := 0
+	if p.IsSetMethod() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Method)
+	}
+	return l
+}
+
+func (p *EvaluatorHTTPInfo) field2Length() int {
+	l := 0
+	if p.IsSetPath() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Path)
+	}
+	return l
+}
+
+func (p *EvaluatorHTTPInfo) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorHTTPInfo)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Method != nil {
+		tmp := *src.Method
+		p.Method = &tmp
+	}
+
+	if src.Path != nil {
+		var tmp string
+		if *src.Path != "" {
+			tmp = kutils.StringDeepCopy(*src.Path)
+		}
+		p.Path = &tmp
+	}
+
+	return nil
+}
+
+func (p *EvaluatorRunConfig) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorRunConfig[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorRunConfig) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Env = _field
+	return offset, nil
+}
+
+func (p *EvaluatorRunConfig) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewRuntimeParam()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.EvaluatorRuntimeParam = _field
+	return offset, nil
+}
+
+func (p *EvaluatorRunConfig) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorRunConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorRunConfig) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorRunConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEnv() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Env)
+	}
+	return offset
+}
+
+func (p *EvaluatorRunConfig) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorRuntimeParam() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+		offset += p.EvaluatorRuntimeParam.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorRunConfig) field1Length() int {
+	l := 0
+	if p.IsSetEnv() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Env)
+	}
+	return l
+}
+
+func (p *EvaluatorRunConfig) field2Length() int {
+	l := 0
+	if p.IsSetEvaluatorRuntimeParam() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.EvaluatorRuntimeParam.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorRunConfig) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorRunConfig)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Env != nil {
+		var tmp string
+		if *src.Env != "" {
+			tmp = kutils.StringDeepCopy(*src.Env)
+		}
+		p.Env = &tmp
+	}
+
+	var _evaluatorRuntimeParam *common.RuntimeParam
+	if src.EvaluatorRuntimeParam != nil {
+		_evaluatorRuntimeParam = &common.RuntimeParam{}
+		if err := _evaluatorRuntimeParam.DeepCopy(src.EvaluatorRuntimeParam); err != nil {
+			return err
+		}
+	}
+	p.EvaluatorRuntimeParam = _evaluatorRuntimeParam
+
+	return nil
+}
+
+func (p *EvaluatorProgressMessage) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorProgressMessage[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorProgressMessage) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Role = _field
+	return offset, nil
+}
+
+func (p *EvaluatorProgressMessage) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Type = _field
+	return offset, nil
+}
+
+func (p *EvaluatorProgressMessage) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Message = _field
+	return offset, nil
+}
+
+func (p *EvaluatorProgressMessage) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.CreatedAtMs = _field
+	return offset, nil
+}
+
+func (p *EvaluatorProgressMessage) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorProgressMessage) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorProgressMessage) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorProgressMessage) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetRole() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Role)
+	}
+	return offset
+}
+
+func (p *EvaluatorProgressMessage) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Type)
+	}
+	return offset
+}
+
+func (p *EvaluatorProgressMessage) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMessage() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Message)
+	}
+	return offset
+}
+
+func (p *EvaluatorProgressMessage) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCreatedAtMs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.CreatedAtMs)
+	}
+	return offset
+}
+
+func (p *EvaluatorProgressMessage) field1Length() int {
+	l := 0
+	if p.IsSetRole() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Role)
+	}
+	return l
+}
+
+func (p *EvaluatorProgressMessage) field2Length() int {
+	l := 0
+	if p.IsSetType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Type)
+	}
+	return l
+}
+
+func (p *EvaluatorProgressMessage) field3Length() int {
+	l := 0
+	if p.IsSetMessage() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Message)
+	}
+	return l
+}
+
+func (p *EvaluatorProgressMessage) field4Length() int {
+	l := 0
+	if p.IsSetCreatedAtMs() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluatorProgressMessage) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorProgressMessage)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Role != nil {
+		var tmp string
+		if *src.Role != "" {
+			tmp = kutils.StringDeepCopy(*src.Role)
+		}
+		p.Role = &tmp
+	}
+
+	if src.Type != nil {
+		var tmp string
+		if *src.Type != "" {
+			tmp = kutils.StringDeepCopy(*src.Type)
+		}
+		p.Type = &tmp
+	}
+
+	if src.Message != nil {
+		var tmp string
+		if *src.Message != "" {
+			tmp = kutils.StringDeepCopy(*src.Message)
+		}
+		p.Message = &tmp
+	}
+
+	if src.CreatedAtMs != nil {
+		tmp := *src.CreatedAtMs
+		p.CreatedAtMs = &tmp
+	}
+
+	return nil
+}
+
+func (p *CalibrationFieldConf) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CalibrationFieldConf[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *CalibrationFieldConf) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.FieldName = _field
+	return offset, nil
+}
+
+func (p *CalibrationFieldConf) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.FromField = _field
+	return offset, nil
+}
+
+func (p *CalibrationFieldConf) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CalibrationFieldConf) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CalibrationFieldConf) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CalibrationFieldConf) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFieldName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.FieldName)
+	}
+	return offset
+}
+
+func (p *CalibrationFieldConf) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFromField() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.FromField)
+	}
+	return offset
+}
+
+func (p *CalibrationFieldConf) field1Length() int {
+	l := 0
+	if p.IsSetFieldName() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.FieldName)
+	}
+	return l
+}
+
+func (p *CalibrationFieldConf) field2Length() int {
+	l := 0
+	if p.IsSetFromField() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.FromField)
+	}
+	return l
+}
+
+func (p *CalibrationFieldConf) DeepCopy(s interface{}) error {
+	src, ok := s.(*CalibrationFieldConf)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.FieldName != nil {
+		var tmp string
+		if *src.FieldName != "" {
+			tmp = kutils.StringDeepCopy(*src.FieldName)
+		}
+		p.FieldName = &tmp
+	}
+
+	if src.FromField != nil {
+		var tmp string
+		if *src.FromField != "" {
+			tmp = kutils.StringDeepCopy(*src.FromField)
+		}
+		p.FromField = &tmp
+	}
+
+	return nil
+}
+
+func (p *EvaluatorCalibrationConf) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorCalibrationConf[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorCalibrationConf) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.GroundTruthField = _field
+	return offset, nil
+}
+
+func (p *EvaluatorCalibrationConf) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*CalibrationFieldConf, 0, size)
+	values := make([]CalibrationFieldConf, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.FieldConfs = _field
+	return offset, nil
+}
+
+func (p *EvaluatorCalibrationConf) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*CalibrationFieldConf, 0, size)
+	values := make([]CalibrationFieldConf, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.TargetOutputFieldConfs = _field
+	return offset, nil
+}
+
+func (p *EvaluatorCalibrationConf) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.WorstItemCnt = _field
+	return offset, nil
+}
+
+func (p *EvaluatorCalibrationConf) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Concurrency = _field
+	return offset, nil
+}
+
+func (p *EvaluatorCalibrationConf) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.MaxEvaluatorFailRate = _field
+	return offset, nil
+}
+
+func (p *EvaluatorCalibrationConf) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorCalibrationConf) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorCalibrationConf) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorCalibrationConf) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetGroundTruthField() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.GroundTruthField)
+	}
+	return offset
+}
+
+func (p *EvaluatorCalibrationConf) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFieldConfs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.FieldConfs {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *EvaluatorCalibrationConf) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTargetOutputFieldConfs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.TargetOutputFieldConfs {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *EvaluatorCalibrationConf) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetWorstItemCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.WorstItemCnt)
+	}
+	return offset
+}
+
+func (p *EvaluatorCalibrationConf) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetConcurrency() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 5)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.Concurrency)
+	}
+	return offset
+}
+
+func (p *EvaluatorCalibrationConf) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMaxEvaluatorFailRate() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 6)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.MaxEvaluatorFailRate)
+	}
+	return offset
+}
+
+func (p *EvaluatorCalibrationConf) field1Length() int {
+	l := 0
+	if p.IsSetGroundTruthField() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.GroundTruthField)
+	}
+	return l
+}
+
+func (p *EvaluatorCalibrationConf) field2Length() int {
+	l := 0
+	if p.IsSetFieldConfs() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.FieldConfs {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvaluatorCalibrationConf) field3Length() int {
+	l := 0
+	if p.IsSetTargetOutputFieldConfs() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.TargetOutputFieldConfs {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvaluatorCalibrationConf) field4Length() int {
+	l := 0
+	if p.IsSetWorstItemCnt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *EvaluatorCalibrationConf) field5Length() int {
+	l := 0
+	if p.IsSetConcurrency() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *EvaluatorCalibrationConf) field6Length() int {
+	l := 0
+	if p.IsSetMaxEvaluatorFailRate() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *EvaluatorCalibrationConf) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorCalibrationConf)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.GroundTruthField != nil {
+		var tmp string
+		if *src.GroundTruthField != "" {
+			tmp = kutils.StringDeepCopy(*src.GroundTruthField)
+		}
+		p.GroundTruthField = &tmp
+	}
+
+	if src.FieldConfs != nil {
+		p.FieldConfs = make([]*CalibrationFieldConf, 0, len(src.FieldConfs))
+		for _, elem := range src.FieldConfs {
+			var _elem *CalibrationFieldConf
+			if elem != nil {
+				_elem = &CalibrationFieldConf{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.FieldConfs = append(p.FieldConfs, _elem)
+		}
+	}
+
+	if src.TargetOutputFieldConfs != nil {
+		p.TargetOutputFieldConfs = make([]*CalibrationFieldConf, 0, len(src.TargetOutputFieldConfs))
+		for _, elem := range src.TargetOutputFieldConfs {
+			var _elem *CalibrationFieldConf
+			if elem != nil {
+				_elem = &CalibrationFieldConf{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.TargetOutputFieldConfs = append(p.TargetOutputFieldConfs, _elem)
+		}
+	}
+
+	if src.WorstItemCnt != nil {
+		tmp := *src.WorstItemCnt
+		p.WorstItemCnt = &tmp
+	}
+
+	if src.Concurrency != nil {
+		tmp := *src.Concurrency
+		p.Concurrency = &tmp
+	}
+
+	if src.MaxEvaluatorFailRate != nil {
+		tmp := *src.MaxEvaluatorFailRate
+		p.MaxEvaluatorFailRate = &tmp
+	}
+
+	return nil
+}
+
+func (p *CalibrationConfusionMatrix) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CalibrationConfusionMatrix[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *CalibrationConfusionMatrix) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Labels = _field
+	return offset, nil
+}
+
+func (p *CalibrationConfusionMatrix) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([][]int64, 0, size)
+	for i := 0; i < size; i++ {
+		_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			return offset, err
+		}
+		_elem := make([]int64, 0, size)
+		for i := 0; i < size; i++ {
+			var _elem1 int64
+			if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+				return offset, err
+			} else {
+				offset += l
+				_elem1 = v
+			}
+
+			_elem = append(_elem, _elem1)
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Counts = _field
+	return offset, nil
+}
+
+func (p *CalibrationConfusionMatrix) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Accuracy = _field
+	return offset, nil
+}
+
+func (p *CalibrationConfusionMatrix) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CalibrationConfusionMatrix) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CalibrationConfusionMatrix) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CalibrationConfusionMatrix) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLabels() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Labels {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
+	}
+	return offset
+}
+
+func (p *CalibrationConfusionMatrix) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCounts() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Counts {
+			length++
+			listBeginOffset := offset
+			offset += thrift.Binary.ListBeginLength()
+			var length int
+			for _, v := range v {
+				length++
+				offset += thrift.Binary.WriteI64(buf[offset:], v)
+			}
+			thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.LIST, length)
+	}
+	return offset
+}
+
+func (p *CalibrationConfusionMatrix) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetAccuracy() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 3)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Accuracy)
+	}
+	return offset
+}
+
+func (p *CalibrationConfusionMatrix) field1Length() int {
+	l := 0
+	if p.IsSetLabels() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Labels {
+			_ = v
+			l += thrift.Binary.StringLengthNocopy(v)
+		}
+	}
+	return l
+}
+
+func (p *CalibrationConfusionMatrix) field2Length() int {
+	l := 0
+	if p.IsSetCounts() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Counts {
+			_ = v
+			l += thrift.Binary.ListBeginLength()
+			l +=
+				thrift.Binary.I64Length() * len(v)
+		}
+	}
+	return l
+}
+
+func (p *CalibrationConfusionMatrix) field3Length() int {
+	l := 0
+	if p.IsSetAccuracy() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *CalibrationConfusionMatrix) DeepCopy(s interface{}) error {
+	src, ok := s.(*CalibrationConfusionMatrix)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Labels != nil {
+		p.Labels = make([]string, 0, len(src.Labels))
+		for _, elem := range src.Labels {
+			var _elem string
+			if elem != "" {
+				_elem = kutils.StringDeepCopy(elem)
+			}
+			p.Labels = append(p.Labels, _elem)
+		}
+	}
+
+	if src.Counts != nil {
+		p.Counts = make([][]int64, 0, len(src.Counts))
+		for _, elem := range src.Counts {
+			var _elem []int64
+			if elem != nil {
+				_elem = make([]int64, 0, len(elem))
+				for _, elem1 := range elem {
+					var _elem1 int64
+					_elem1 = elem1
+					_elem = append(_elem, _elem1)
+				}
+			}
+			p.Counts = append(p.Counts, _elem)
+		}
+	}
+
+	if src.Accuracy != nil {
+		tmp := *src.Accuracy
+		p.Accuracy = &tmp
+	}
+
+	return nil
+}
+
+func (p *CalibrationItemResult_) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
 				}
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
@@ -6821,8 +12639,50 @@ func (p *EvaluatorRecord) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 5:
+		case 2:
 			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
 				l, err = p.FastReadField5(buf[offset:])
 				offset += l
 				if err != nil {
@@ -6835,9 +12695,338 @@ func (p *EvaluatorRecord) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField6(buf[offset:])
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CalibrationItemResult_[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *CalibrationItemResult_) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ItemID = _field
+	return offset, nil
+}
+
+func (p *CalibrationItemResult_) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TurnID = _field
+	return offset, nil
+}
+
+func (p *CalibrationItemResult_) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.GroundTruth = _field
+	return offset, nil
+}
+
+func (p *CalibrationItemResult_) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.EvaluatorScore = _field
+	return offset, nil
+}
+
+func (p *CalibrationItemResult_) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.AbsError = _field
+	return offset, nil
+}
+
+func (p *CalibrationItemResult_) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Reasoning = _field
+	return offset, nil
+}
+
+func (p *CalibrationItemResult_) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CalibrationItemResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CalibrationItemResult_) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CalibrationItemResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ItemID)
+	}
+	return offset
+}
+
+func (p *CalibrationItemResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTurnID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.TurnID)
+	}
+	return offset
+}
+
+func (p *CalibrationItemResult_) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetGroundTruth() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 3)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.GroundTruth)
+	}
+	return offset
+}
+
+func (p *CalibrationItemResult_) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorScore() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 4)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.EvaluatorScore)
+	}
+	return offset
+}
+
+func (p *CalibrationItemResult_) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetAbsError() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 5)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.AbsError)
+	}
+	return offset
+}
+
+func (p *CalibrationItemResult_) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetReasoning() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 6)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Reasoning)
+	}
+	return offset
+}
+
+func (p *CalibrationItemResult_) field1Length() int {
+	l := 0
+	if p.IsSetItemID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *CalibrationItemResult_) field2Length() int {
+	l := 0
+	if p.IsSetTurnID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *CalibrationItemResult_) field3Length() int {
+	l := 0
+	if p.IsSetGroundTruth() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *CalibrationItemResult_) field4Length() int {
+	l := 0
+	if p.IsSetEvaluatorScore() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *CalibrationItemResult_) field5Length() int {
+	l := 0
+	if p.IsSetAbsError() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *CalibrationItemResult_) field6Length() int {
+	l := 0
+	if p.IsSetReasoning() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Reasoning)
+	}
+	return l
+}
+
+func (p *CalibrationItemResult_) DeepCopy(s interface{}) error {
+	src, ok := s.(*CalibrationItemResult_)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.ItemID != nil {
+		tmp := *src.ItemID
+		p.ItemID = &tmp
+	}
+
+	if src.TurnID != nil {
+		tmp := *src.TurnID
+		p.TurnID = &tmp
+	}
+
+	if src.GroundTruth != nil {
+		tmp := *src.GroundTruth
+		p.GroundTruth = &tmp
+	}
+
+	if src.EvaluatorScore != nil {
+		tmp := *src.EvaluatorScore
+		p.EvaluatorScore = &tmp
+	}
+
+	if src.AbsError != nil {
+		tmp := *src.AbsError
+		p.AbsError = &tmp
+	}
+
+	if src.Reasoning != nil {
+		var tmp string
+		if *src.Reasoning != "" {
+			tmp = kutils.StringDeepCopy(*src.Reasoning)
+		}
+		p.Reasoning = &tmp
+	}
+
+	return nil
+}
+
+func (p *EvaluatorCalibrationResult_) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -6849,9 +13038,9 @@ func (p *EvaluatorRecord) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 7:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField7(buf[offset:])
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -6863,9 +13052,9 @@ func (p *EvaluatorRecord) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 8:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField8(buf[offset:])
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -6877,9 +13066,9 @@ func (p *EvaluatorRecord) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 9:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField9(buf[offset:])
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField5(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -6891,9 +13080,9 @@ func (p *EvaluatorRecord) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 10:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField10(buf[offset:])
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField6(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -6905,9 +13094,9 @@ func (p *EvaluatorRecord) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 11:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField11(buf[offset:])
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField7(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -6919,9 +13108,9 @@ func (p *EvaluatorRecord) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 12:
+		case 8:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField12(buf[offset:])
+				l, err = p.FastReadField8(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -6933,9 +13122,9 @@ func (p *EvaluatorRecord) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 20:
-			if fieldTypeId == thrift.MAP {
-				l, err = p.FastReadField20(buf[offset:])
+		case 9:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField9(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -6960,212 +13149,151 @@ func (p *EvaluatorRecord) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorRecord[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorCalibrationResult_[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorRecord) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.ID = _field
-	return offset, nil
-}
-
-func (p *EvaluatorRecord) FastReadField2(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.ExperimentID = _field
-	return offset, nil
-}
-
-func (p *EvaluatorRecord) FastReadField3(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.ExperimentRunID = _field
-	return offset, nil
-}
-
-func (p *EvaluatorRecord) FastReadField4(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.ItemID = _field
-	return offset, nil
-}
-
-func (p *EvaluatorRecord) FastReadField5(buf []byte) (int, error) {
+func (p *EvaluatorCalibrationResult_) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.TurnID = _field
+	p.ItemCnt = _field
 	return offset, nil
 }
 
-func (p *EvaluatorRecord) FastReadField6(buf []byte) (int, error) {
+func (p *EvaluatorCalibrationResult_) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.EvaluatorVersionID = _field
+	p.PairedCnt = _field
 	return offset, nil
 }
 
-func (p *EvaluatorRecord) FastReadField7(buf []byte) (int, error) {
+func (p *EvaluatorCalibrationResult_) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.TraceID = _field
+	p.EvaluatorFailCnt = _field
 	return offset, nil
 }
 
-func (p *EvaluatorRecord) FastReadField8(buf []byte) (int, error) {
+func (p *EvaluatorCalibrationResult_) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.LogID = _field
+	p.InvalidGroundTruthCnt = _field
 	return offset, nil
 }
 
-func (p *EvaluatorRecord) FastReadField9(buf []byte) (int, error) {
+func (p *EvaluatorCalibrationResult_) FastReadField5(buf []byte) (int, error) {
 	offset := 0
-	_field := NewEvaluatorInputData()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.EvaluatorInputData = _field
+	p.PearsonCorrelation = _field
 	return offset, nil
 }
 
-func (p *EvaluatorRecord) FastReadField10(buf []byte) (int, error) {
+func (p *EvaluatorCalibrationResult_) FastReadField6(buf []byte) (int, error) {
 	offset := 0
-	_field := NewEvaluatorOutputData()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.EvaluatorOutputData = _field
+	p.SpearmanCorrelation = _field
 	return offset, nil
 }
 
-func (p *EvaluatorRecord) FastReadField11(buf []byte) (int, error) {
+func (p *EvaluatorCalibrationResult_) FastReadField7(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *EvaluatorRunStatus
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-
-		tmp := EvaluatorRunStatus(v)
-		_field = &tmp
+		_field = &v
 	}
-	p.Status = _field
+	p.MeanAbsoluteError = _field
 	return offset, nil
 }
 
-func (p *EvaluatorRecord) FastReadField12(buf []byte) (int, error) {
+func (p *EvaluatorCalibrationResult_) FastReadField8(buf []byte) (int, error) {
 	offset := 0
-	_field := common.NewBaseInfo()
+	_field := NewCalibrationConfusionMatrix()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.BaseInfo = _field
+	p.ConfusionMatrix = _field
 	return offset, nil
 }
 
-func (p *EvaluatorRecord) FastReadField20(buf []byte) (int, error) {
+func (p *EvaluatorCalibrationResult_) FastReadField9(buf []byte) (int, error) {
 	offset := 0
 
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
 	offset += l
 	if err != nil {
 		return offset, err
 	}
-	_field := make(map[string]string, size)
+	_field := make([]*CalibrationItemResult_, 0, size)
+	values := make([]CalibrationItemResult_, size)
 	for i := 0; i < size; i++ {
-		var _key string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
-
-		var _val string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
-			_val = v
 		}
 
-		_field[_key] = _val
+		_field = append(_field, _elem)
 	}
-	p.Ext = _field
+	p.WorstItems = _field
 	return offset, nil
 }
 
-func (p *EvaluatorRecord) FastWrite(buf []byte) int {
+func (p *EvaluatorCalibrationResult_) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorRecord) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorCalibrationResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -7177,16 +13305,12 @@ func (p *EvaluatorRecord) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int
 		offset += p.fastWriteField7(buf[offset:], w)
 		offset += p.fastWriteField8(buf[offset:], w)
 		offset += p.fastWriteField9(buf[offset:], w)
-		offset += p.fastWriteField10(buf[offset:], w)
-		offset += p.fastWriteField11(buf[offset:], w)
-		offset += p.fastWriteField12(buf[offset:], w)
-		offset += p.fastWriteField20(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvaluatorRecord) BLength() int {
+func (p *EvaluatorCalibrationResult_) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -7198,368 +13322,253 @@ func (p *EvaluatorRecord) BLength() int {
 		l += p.field7Length()
 		l += p.field8Length()
 		l += p.field9Length()
-		l += p.field10Length()
-		l += p.field11Length()
-		l += p.field12Length()
-		l += p.field20Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvaluatorRecord) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.ID)
-	}
-	return offset
-}
-
-func (p *EvaluatorRecord) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetExperimentID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExperimentID)
-	}
-	return offset
-}
-
-func (p *EvaluatorRecord) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetExperimentRunID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExperimentRunID)
-	}
-	return offset
-}
-
-func (p *EvaluatorRecord) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetItemID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.ItemID)
-	}
-	return offset
-}
-
-func (p *EvaluatorRecord) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorCalibrationResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTurnID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 5)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.TurnID)
+	if p.IsSetItemCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 1)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.ItemCnt)
 	}
 	return offset
 }
 
-func (p *EvaluatorRecord) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorCalibrationResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvaluatorVersionID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 6)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.EvaluatorVersionID)
+	if p.IsSetPairedCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PairedCnt)
 	}
 	return offset
 }
 
-func (p *EvaluatorRecord) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorCalibrationResult_) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTraceID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 7)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.TraceID)
+	if p.IsSetEvaluatorFailCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.EvaluatorFailCnt)
 	}
 	return offset
 }
 
-func (p *EvaluatorRecord) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorCalibrationResult_) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetLogID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 8)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.LogID)
+	if p.IsSetInvalidGroundTruthCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.InvalidGroundTruthCnt)
 	}
 	return offset
 }
 
-func (p *EvaluatorRecord) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorCalibrationResult_) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvaluatorInputData() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 9)
-		offset += p.EvaluatorInputData.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetPearsonCorrelation() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 5)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.PearsonCorrelation)
 	}
 	return offset
 }
 
-func (p *EvaluatorRecord) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorCalibrationResult_) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvaluatorOutputData() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 10)
-		offset += p.EvaluatorOutputData.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetSpearmanCorrelation() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 6)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.SpearmanCorrelation)
 	}
 	return offset
 }
 
-func (p *EvaluatorRecord) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorCalibrationResult_) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetStatus() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 11)
-		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.Status))
+	if p.IsSetMeanAbsoluteError() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 7)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.MeanAbsoluteError)
 	}
 	return offset
 }
 
-func (p *EvaluatorRecord) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorCalibrationResult_) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetBaseInfo() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 12)
-		offset += p.BaseInfo.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetConfusionMatrix() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 8)
+		offset += p.ConfusionMatrix.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *EvaluatorRecord) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorCalibrationResult_) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetExt() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 20)
-		mapBeginOffset := offset
-		offset += thrift.Binary.MapBeginLength()
+	if p.IsSetWorstItems() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 9)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for k, v := range p.Ext {
+		for _, v := range p.WorstItems {
 			length++
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
-		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRING, length)
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *EvaluatorRecord) field1Length() int {
-	l := 0
-	if p.IsSetID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *EvaluatorRecord) field2Length() int {
-	l := 0
-	if p.IsSetExperimentID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *EvaluatorRecord) field3Length() int {
-	l := 0
-	if p.IsSetExperimentRunID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *EvaluatorRecord) field4Length() int {
-	l := 0
-	if p.IsSetItemID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *EvaluatorRecord) field5Length() int {
-	l := 0
-	if p.IsSetTurnID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *EvaluatorRecord) field6Length() int {
+func (p *EvaluatorCalibrationResult_) field1Length() int {
 	l := 0
-	if p.IsSetEvaluatorVersionID() {
+	if p.IsSetItemCnt() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *EvaluatorRecord) field7Length() int {
+func (p *EvaluatorCalibrationResult_) field2Length() int {
 	l := 0
-	if p.IsSetTraceID() {
+	if p.IsSetPairedCnt() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.TraceID)
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *EvaluatorRecord) field8Length() int {
+func (p *EvaluatorCalibrationResult_) field3Length() int {
 	l := 0
-	if p.IsSetLogID() {
+	if p.IsSetEvaluatorFailCnt() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.LogID)
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *EvaluatorRecord) field9Length() int {
+func (p *EvaluatorCalibrationResult_) field4Length() int {
 	l := 0
-	if p.IsSetEvaluatorInputData() {
+	if p.IsSetInvalidGroundTruthCnt() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.EvaluatorInputData.BLength()
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *EvaluatorRecord) field10Length() int {
+func (p *EvaluatorCalibrationResult_) field5Length() int {
 	l := 0
-	if p.IsSetEvaluatorOutputData() {
+	if p.IsSetPearsonCorrelation() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.EvaluatorOutputData.BLength()
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *EvaluatorRecord) field11Length() int {
+func (p *EvaluatorCalibrationResult_) field6Length() int {
 	l := 0
-	if p.IsSetStatus() {
+	if p.IsSetSpearmanCorrelation() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *EvaluatorRecord) field12Length() int {
+func (p *EvaluatorCalibrationResult_) field7Length() int {
 	l := 0
-	if p.IsSetBaseInfo() {
+	if p.IsSetMeanAbsoluteError() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.BaseInfo.BLength()
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *EvaluatorRecord) field20Length() int {
+func (p *EvaluatorCalibrationResult_) field8Length() int {
 	l := 0
-	if p.IsSetExt() {
+	if p.IsSetConfusionMatrix() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.MapBeginLength()
-		for k, v := range p.Ext {
-			_, _ = k, v
-
-			l += thrift.Binary.StringLengthNocopy(k)
-			l += thrift.Binary.StringLengthNocopy(v)
-		}
+		l += p.ConfusionMatrix.BLength()
 	}
 	return l
 }
 
-func (p *EvaluatorRecord) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorRecord)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
-	}
-
-	if src.ID != nil {
-		tmp := *src.ID
-		p.ID = &tmp
-	}
-
-	if src.ExperimentID != nil {
-		tmp := *src.ExperimentID
-		p.ExperimentID = &tmp
-	}
-
-	if src.ExperimentRunID != nil {
-		tmp := *src.ExperimentRunID
-		p.ExperimentRunID = &tmp
+func (p *EvaluatorCalibrationResult_) field9Length() int {
+	l := 0
+	if p.IsSetWorstItems() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.WorstItems {
+			_ = v
+			l += v.BLength()
+		}
 	}
+	return l
+}
 
-	if src.ItemID != nil {
-		tmp := *src.ItemID
-		p.ItemID = &tmp
+func (p *EvaluatorCalibrationResult_) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorCalibrationResult_)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.TurnID != nil {
-		tmp := *src.TurnID
-		p.TurnID = &tmp
+	if src.ItemCnt != nil {
+		tmp := *src.ItemCnt
+		p.ItemCnt = &tmp
 	}
 
-	if src.EvaluatorVersionID != nil {
-		tmp := *src.EvaluatorVersionID
-		p.EvaluatorVersionID = &tmp
+	if src.PairedCnt != nil {
+		tmp := *src.PairedCnt
+		p.PairedCnt = &tmp
 	}
 
-	if src.TraceID != nil {
-		var tmp string
-		if *src.TraceID != "" {
-			tmp = kutils.StringDeepCopy(*src.TraceID)
-		}
-		p.TraceID = &tmp
+	if src.EvaluatorFailCnt != nil {
+		tmp := *src.EvaluatorFailCnt
+		p.EvaluatorFailCnt = &tmp
 	}
 
-	if src.LogID != nil {
-		var tmp string
-		if *src.LogID != "" {
-			tmp = kutils.StringDeepCopy(*src.LogID)
-		}
-		p.LogID = &tmp
+	if src.InvalidGroundTruthCnt != nil {
+		tmp := *src.InvalidGroundTruthCnt
+		p.InvalidGroundTruthCnt = &tmp
 	}
 
-	var _evaluatorInputData *EvaluatorInputData
-	if src.EvaluatorInputData != nil {
-		_evaluatorInputData = &EvaluatorInputData{}
-		if err := _evaluatorInputData.DeepCopy(src.EvaluatorInputData); err != nil {
-			return err
-		}
+	if src.PearsonCorrelation != nil {
+		tmp := *src.PearsonCorrelation
+		p.PearsonCorrelation = &tmp
 	}
-	p.EvaluatorInputData = _evaluatorInputData
 
-	var _evaluatorOutputData *EvaluatorOutputData
-	if src.EvaluatorOutputData != nil {
-		_evaluatorOutputData = &EvaluatorOutputData{}
-		if err := _evaluatorOutputData.DeepCopy(src.EvaluatorOutputData); err != nil {
-			return err
-		}
+	if src.SpearmanCorrelation != nil {
+		tmp := *src.SpearmanCorrelation
+		p.SpearmanCorrelation = &tmp
 	}
-	p.EvaluatorOutputData = _evaluatorOutputData
 
-	if src.Status != nil {
-		tmp := *src.Status
-		p.Status = &tmp
+	if src.MeanAbsoluteError != nil {
+		tmp := *src.MeanAbsoluteError
+		p.MeanAbsoluteError = &tmp
 	}
 
-	var _baseInfo *common.BaseInfo
-	if src.BaseInfo != nil {
-		_baseInfo = &common.BaseInfo{}
-		if err := _baseInfo.DeepCopy(src.BaseInfo); err != nil {
+	var _confusionMatrix *CalibrationConfusionMatrix
+	if src.ConfusionMatrix != nil {
+		_confusionMatrix = &CalibrationConfusionMatrix{}
+		if err := _confusionMatrix.DeepCopy(src.ConfusionMatrix); err != nil {
 			return err
 		}
 	}
-	p.BaseInfo = _baseInfo
-
-	if src.Ext != nil {
-		p.Ext = make(map[string]string, len(src.Ext))
-		for key, val := range src.Ext {
-			var _key string
-			if key != "" {
-				_key = kutils.StringDeepCopy(key)
-			}
+	p.ConfusionMatrix = _confusionMatrix
 
-			var _val string
-			if val != "" {
-				_val = kutils.StringDeepCopy(val)
+	if src.WorstItems != nil {
+		p.WorstItems = make([]*CalibrationItemResult_, 0, len(src.WorstItems))
+		for _, elem := range src.WorstItems {
+			var _elem *CalibrationItemResult_
+			if elem != nil {
+				_elem = &CalibrationItemResult_{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
 			}
 
-			p.Ext[_key] = _val
+			p.WorstItems = append(p.WorstItems, _elem)
 		}
 	}
 
 	return nil
 }
 
-func (p *EvaluatorOutputData) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorCalibrationRecord) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -7577,7 +13586,7 @@ func (p *EvaluatorOutputData) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -7591,7 +13600,7 @@ func (p *EvaluatorOutputData) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -7605,7 +13614,7 @@ func (p *EvaluatorOutputData) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -7632,6 +13641,90 @@ func (p *EvaluatorOutputData) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField9(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField10(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 11:
 			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField11(buf[offset:])
@@ -7673,48 +13766,96 @@ func (p *EvaluatorOutputData) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorOutputData[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorCalibrationRecord[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorOutputData) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorCalibrationRecord) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewEvaluatorResult_()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.EvaluatorResult_ = _field
+	p.RecordID = _field
 	return offset, nil
 }
 
-func (p *EvaluatorOutputData) FastReadField2(buf []byte) (int, error) {
+func (p *EvaluatorCalibrationRecord) FastReadField2(buf []byte) (int, error) {
 	offset := 0
-	_field := NewEvaluatorUsage()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.EvaluatorUsage = _field
+	p.WorkspaceID = _field
 	return offset, nil
 }
 
-func (p *EvaluatorOutputData) FastReadField3(buf []byte) (int, error) {
+func (p *EvaluatorCalibrationRecord) FastReadField3(buf []byte) (int, error) {
 	offset := 0
-	_field := NewEvaluatorRunError()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.EvaluatorRunError = _field
+	p.EvaluatorID = _field
 	return offset, nil
 }
 
-func (p *EvaluatorOutputData) FastReadField4(buf []byte) (int, error) {
+func (p *EvaluatorCalibrationRecord) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.EvaluatorVersionID = _field
+	return offset, nil
+}
+
+func (p *EvaluatorCalibrationRecord) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.EvaluatorVersion = _field
+	return offset, nil
+}
+
+func (p *EvaluatorCalibrationRecord) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.EvaluationSetID = _field
+	return offset, nil
+}
+
+func (p *EvaluatorCalibrationRecord) FastReadField7(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int64
@@ -7722,13 +13863,51 @@ func (p *EvaluatorOutputData) FastReadField4(buf []byte) (int, error) {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = &v
+	}
+	p.EvaluationSetVersionID = _field
+	return offset, nil
+}
+
+func (p *EvaluatorCalibrationRecord) FastReadField8(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *EvaluatorCalibrationStatus
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Status = _field
+	return offset, nil
+}
+
+func (p *EvaluatorCalibrationRecord) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+	_field := NewEvaluatorCalibrationConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Conf = _field
+	return offset, nil
+}
+
+func (p *EvaluatorCalibrationRecord) FastReadField10(buf []byte) (int, error) {
+	offset := 0
+	_field := NewEvaluatorCalibrationResult_()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
 	}
-	p.TimeConsumingMs = _field
+	p.Result_ = _field
 	return offset, nil
 }
 
-func (p *EvaluatorOutputData) FastReadField11(buf []byte) (int, error) {
+func (p *EvaluatorCalibrationRecord) FastReadField11(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -7738,33 +13917,39 @@ func (p *EvaluatorOutputData) FastReadField11(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Stdout = _field
+	p.ErrorMsg = _field
 	return offset, nil
 }
 
-func (p *EvaluatorOutputData) FastReadField12(buf []byte) (int, error) {
+func (p *EvaluatorCalibrationRecord) FastReadField12(buf []byte) (int, error) {
 	offset := 0
-	_field := NewEvaluatorExtraOutputContent()
+	_field := common.NewBaseInfo()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.ExtraOutput = _field
+	p.BaseInfo = _field
 	return offset, nil
 }
 
-func (p *EvaluatorOutputData) FastWrite(buf []byte) int {
+func (p *EvaluatorCalibrationRecord) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorOutputData) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorCalibrationRecord) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
 		offset += p.fastWriteField11(buf[offset:], w)
 		offset += p.fastWriteField12(buf[offset:], w)
 	}
@@ -7772,13 +13957,19 @@ func (p *EvaluatorOutputData) FastWriteNocopy(buf []byte, w thrift.NocopyWriter)
 	return offset
 }
 
-func (p *EvaluatorOutputData) BLength() int {
+func (p *EvaluatorCalibrationRecord) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
+		l += p.field9Length()
+		l += p.field10Length()
 		l += p.field11Length()
 		l += p.field12Length()
 	}
@@ -7786,173 +13977,310 @@ func (p *EvaluatorOutputData) BLength() int {
 	return l
 }
 
-func (p *EvaluatorOutputData) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorCalibrationRecord) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvaluatorResult_() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-		offset += p.EvaluatorResult_.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetRecordID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.RecordID)
 	}
 	return offset
 }
 
-func (p *EvaluatorOutputData) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorCalibrationRecord) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvaluatorUsage() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
-		offset += p.EvaluatorUsage.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetWorkspaceID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.WorkspaceID)
 	}
 	return offset
 }
 
-func (p *EvaluatorOutputData) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorCalibrationRecord) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvaluatorRunError() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
-		offset += p.EvaluatorRunError.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetEvaluatorID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.EvaluatorID)
 	}
 	return offset
 }
 
-func (p *EvaluatorOutputData) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorCalibrationRecord) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTimeConsumingMs() {
+	if p.IsSetEvaluatorVersionID() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.TimeConsumingMs)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.EvaluatorVersionID)
 	}
 	return offset
 }
 
-func (p *EvaluatorOutputData) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorCalibrationRecord) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetStdout() {
+	if p.IsSetEvaluatorVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.EvaluatorVersion)
+	}
+	return offset
+}
+
+func (p *EvaluatorCalibrationRecord) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluationSetID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 6)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.EvaluationSetID)
+	}
+	return offset
+}
+
+func (p *EvaluatorCalibrationRecord) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluationSetVersionID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 7)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.EvaluationSetVersionID)
+	}
+	return offset
+}
+
+func (p *EvaluatorCalibrationRecord) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetStatus() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 8)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Status)
+	}
+	return offset
+}
+
+func (p *EvaluatorCalibrationRecord) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 9)
+		offset += p.Conf.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorCalibrationRecord) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetResult_() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 10)
+		offset += p.Result_.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorCalibrationRecord) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetErrorMsg() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 11)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Stdout)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ErrorMsg)
 	}
 	return offset
 }
 
-func (p *EvaluatorOutputData) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorCalibrationRecord) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetExtraOutput() {
+	if p.IsSetBaseInfo() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 12)
-		offset += p.ExtraOutput.FastWriteNocopy(buf[offset:], w)
+		offset += p.BaseInfo.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *EvaluatorOutputData) field1Length() int {
+func (p *EvaluatorCalibrationRecord) field1Length() int {
 	l := 0
-	if p.IsSetEvaluatorResult_() {
+	if p.IsSetRecordID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.EvaluatorResult_.BLength()
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *EvaluatorOutputData) field2Length() int {
+func (p *EvaluatorCalibrationRecord) field2Length() int {
 	l := 0
-	if p.IsSetEvaluatorUsage() {
+	if p.IsSetWorkspaceID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.EvaluatorUsage.BLength()
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *EvaluatorOutputData) field3Length() int {
+func (p *EvaluatorCalibrationRecord) field3Length() int {
 	l := 0
-	if p.IsSetEvaluatorRunError() {
+	if p.IsSetEvaluatorID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.EvaluatorRunError.BLength()
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *EvaluatorOutputData) field4Length() int {
+func (p *EvaluatorCalibrationRecord) field4Length() int {
 	l := 0
-	if p.IsSetTimeConsumingMs() {
+	if p.IsSetEvaluatorVersionID() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *EvaluatorOutputData) field11Length() int {
+func (p *EvaluatorCalibrationRecord) field5Length() int {
 	l := 0
-	if p.IsSetStdout() {
+	if p.IsSetEvaluatorVersion() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Stdout)
+		l += thrift.Binary.StringLengthNocopy(*p.EvaluatorVersion)
 	}
 	return l
 }
 
-func (p *EvaluatorOutputData) field12Length() int {
+func (p *EvaluatorCalibrationRecord) field6Length() int {
 	l := 0
-	if p.IsSetExtraOutput() {
+	if p.IsSetEvaluationSetID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.ExtraOutput.BLength()
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *EvaluatorOutputData) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorOutputData)
+func (p *EvaluatorCalibrationRecord) field7Length() int {
+	l := 0
+	if p.IsSetEvaluationSetVersionID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluatorCalibrationRecord) field8Length() int {
+	l := 0
+	if p.IsSetStatus() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Status)
+	}
+	return l
+}
+
+func (p *EvaluatorCalibrationRecord) field9Length() int {
+	l := 0
+	if p.IsSetConf() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Conf.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorCalibrationRecord) field10Length() int {
+	l := 0
+	if p.IsSetResult_() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Result_.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorCalibrationRecord) field11Length() int {
+	l := 0
+	if p.IsSetErrorMsg() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ErrorMsg)
+	}
+	return l
+}
+
+func (p *EvaluatorCalibrationRecord) field12Length() int {
+	l := 0
+	if p.IsSetBaseInfo() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseInfo.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorCalibrationRecord) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorCalibrationRecord)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _evaluatorResult_ *EvaluatorResult_
-	if src.EvaluatorResult_ != nil {
-		_evaluatorResult_ = &EvaluatorResult_{}
-		if err := _evaluatorResult_.DeepCopy(src.EvaluatorResult_); err != nil {
-			return err
-		}
+	if src.RecordID != nil {
+		tmp := *src.RecordID
+		p.RecordID = &tmp
 	}
-	p.EvaluatorResult_ = _evaluatorResult_
 
-	var _evaluatorUsage *EvaluatorUsage
-	if src.EvaluatorUsage != nil {
-		_evaluatorUsage = &EvaluatorUsage{}
-		if err := _evaluatorUsage.DeepCopy(src.EvaluatorUsage); err != nil {
-			return err
+	if src.WorkspaceID != nil {
+		tmp := *src.WorkspaceID
+		p.WorkspaceID = &tmp
+	}
+
+	if src.EvaluatorID != nil {
+		tmp := *src.EvaluatorID
+		p.EvaluatorID = &tmp
+	}
+
+	if src.EvaluatorVersionID != nil {
+		tmp := *src.EvaluatorVersionID
+		p.EvaluatorVersionID = &tmp
+	}
+
+	if src.EvaluatorVersion != nil {
+		var tmp string
+		if *src.EvaluatorVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.EvaluatorVersion)
 		}
+		p.EvaluatorVersion = &tmp
 	}
-	p.EvaluatorUsage = _evaluatorUsage
 
-	var _evaluatorRunError *EvaluatorRunError
-	if src.EvaluatorRunError != nil {
-		_evaluatorRunError = &EvaluatorRunError{}
-		if err := _evaluatorRunError.DeepCopy(src.EvaluatorRunError); err != nil {
+	if src.EvaluationSetID != nil {
+		tmp := *src.EvaluationSetID
+		p.EvaluationSetID = &tmp
+	}
+
+	if src.EvaluationSetVersionID != nil {
+		tmp := *src.EvaluationSetVersionID
+		p.EvaluationSetVersionID = &tmp
+	}
+
+	if src.Status != nil {
+		tmp := *src.Status
+		p.Status = &tmp
+	}
+
+	var _conf *EvaluatorCalibrationConf
+	if src.Conf != nil {
+		_conf = &EvaluatorCalibrationConf{}
+		if err := _conf.DeepCopy(src.Conf); err != nil {
 			return err
 		}
 	}
-	p.EvaluatorRunError = _evaluatorRunError
+	p.Conf = _conf
 
-	if src.TimeConsumingMs != nil {
-		tmp := *src.TimeConsumingMs
-		p.TimeConsumingMs = &tmp
+	var _result_ *EvaluatorCalibrationResult_
+	if src.Result_ != nil {
+		_result_ = &EvaluatorCalibrationResult_{}
+		if err := _result_.DeepCopy(src.Result_); err != nil {
+			return err
+		}
 	}
+	p.Result_ = _result_
 
-	if src.Stdout != nil {
+	if src.ErrorMsg != nil {
 		var tmp string
-		if *src.Stdout != "" {
-			tmp = kutils.StringDeepCopy(*src.Stdout)
+		if *src.ErrorMsg != "" {
+			tmp = kutils.StringDeepCopy(*src.ErrorMsg)
 		}
-		p.Stdout = &tmp
+		p.ErrorMsg = &tmp
 	}
 
-	var _extraOutput *EvaluatorExtraOutputContent
-	if src.ExtraOutput != nil {
-		_extraOutput = &EvaluatorExtraOutputContent{}
-		if err := _extraOutput.DeepCopy(src.ExtraOutput); err != nil {
+	var _baseInfo *common.BaseInfo
+	if src.BaseInfo != nil {
+		_baseInfo = &common.BaseInfo{}
+		if err := _baseInfo.DeepCopy(src.BaseInfo); err != nil {
 			return err
 		}
 	}
-	p.ExtraOutput = _extraOutput
+	p.BaseInfo = _baseInfo
 
 	return nil
 }
 
-func (p *EvaluatorResult_) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorPromotionCheckConf) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -7970,8 +14298,50 @@ func (p *EvaluatorResult_) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
 			if fieldTypeId == thrift.DOUBLE {
-				l, err = p.FastReadField1(buf[offset:])
+				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -7983,9 +14353,9 @@ func (p *EvaluatorResult_) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField2(buf[offset:])
+		case 10:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField10(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -7997,9 +14367,51 @@ func (p *EvaluatorResult_) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField3(buf[offset:])
+		case 11:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField11(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 12:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField12(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 13:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField13(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 14:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField14(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -8024,260 +14436,151 @@ func (p *EvaluatorResult_) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorResult_[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorPromotionCheckConf[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorResult_) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorPromotionCheckConf) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *float64
-	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Score = _field
+	p.BaseVersion = _field
 	return offset, nil
 }
 
-func (p *EvaluatorResult_) FastReadField2(buf []byte) (int, error) {
+func (p *EvaluatorPromotionCheckConf) FastReadField2(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCorrection()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *EvaluatorPromotionAction
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.Correction = _field
+	p.Action = _field
 	return offset, nil
 }
 
-func (p *EvaluatorResult_) FastReadField3(buf []byte) (int, error) {
+func (p *EvaluatorPromotionCheckConf) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Reasoning = _field
+	p.SampleSize = _field
 	return offset, nil
 }
 
-func (p *EvaluatorResult_) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
-
-func (p *EvaluatorResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionCheckConf) FastReadField4(buf []byte) (int, error) {
 	offset := 0
-	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
-}
 
-func (p *EvaluatorResult_) BLength() int {
-	l := 0
-	if p != nil {
-		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field3Length()
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	l += thrift.Binary.FieldStopLength()
-	return l
+	p.PassThreshold = _field
+	return offset, nil
 }
 
-func (p *EvaluatorResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionCheckConf) FastReadField10(buf []byte) (int, error) {
 	offset := 0
-	if p.IsSetScore() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 1)
-		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Score)
-	}
-	return offset
-}
 
-func (p *EvaluatorResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetCorrection() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
-		offset += p.Correction.FastWriteNocopy(buf[offset:], w)
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	return offset
+	p.MaxMeanAbsDrift = _field
+	return offset, nil
 }
 
-func (p *EvaluatorResult_) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionCheckConf) FastReadField11(buf []byte) (int, error) {
 	offset := 0
-	if p.IsSetReasoning() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Reasoning)
-	}
-	return offset
-}
-
-func (p *EvaluatorResult_) field1Length() int {
-	l := 0
-	if p.IsSetScore() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.DoubleLength()
-	}
-	return l
-}
-
-func (p *EvaluatorResult_) field2Length() int {
-	l := 0
-	if p.IsSetCorrection() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Correction.BLength()
-	}
-	return l
-}
-
-func (p *EvaluatorResult_) field3Length() int {
-	l := 0
-	if p.IsSetReasoning() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Reasoning)
-	}
-	return l
-}
-
-func (p *EvaluatorResult_) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorResult_)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
-	}
-
-	if src.Score != nil {
-		tmp := *src.Score
-		p.Score = &tmp
-	}
-
-	var _correction *Correction
-	if src.Correction != nil {
-		_correction = &Correction{}
-		if err := _correction.DeepCopy(src.Correction); err != nil {
-			return err
-		}
-	}
-	p.Correction = _correction
 
-	if src.Reasoning != nil {
-		var tmp string
-		if *src.Reasoning != "" {
-			tmp = kutils.StringDeepCopy(*src.Reasoning)
-		}
-		p.Reasoning = &tmp
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-
-	return nil
+	p.MaxFlipRate = _field
+	return offset, nil
 }
 
-func (p *EvaluatorUsage) FastRead(buf []byte) (int, error) {
-
-	var err error
-	var offset int
-	var l int
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	for {
-		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
-		offset += l
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
-			}
-		}
-	}
+func (p *EvaluatorPromotionCheckConf) FastReadField12(buf []byte) (int, error) {
+	offset := 0
 
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.MaxDraftFailRate = _field
 	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorUsage[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorUsage) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorPromotionCheckConf) FastReadField13(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.InputTokens = _field
+	p.MaxLatencyIncreaseRatio = _field
 	return offset, nil
 }
 
-func (p *EvaluatorUsage) FastReadField2(buf []byte) (int, error) {
+func (p *EvaluatorPromotionCheckConf) FastReadField14(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.OutputTokens = _field
+	p.MaxTokenIncreaseRatio = _field
 	return offset, nil
 }
 
-func (p *EvaluatorUsage) FastWrite(buf []byte) int {
+func (p *EvaluatorPromotionCheckConf) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorUsage) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionCheckConf) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
+		offset += p.fastWriteField11(buf[offset:], w)
+		offset += p.fastWriteField12(buf[offset:], w)
+		offset += p.fastWriteField13(buf[offset:], w)
+		offset += p.fastWriteField14(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 	}
@@ -8285,245 +14588,243 @@ func (p *EvaluatorUsage) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int
 	return offset
 }
 
-func (p *EvaluatorUsage) BLength() int {
+func (p *EvaluatorPromotionCheckConf) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field10Length()
+		l += p.field11Length()
+		l += p.field12Length()
+		l += p.field13Length()
+		l += p.field14Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvaluatorUsage) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionCheckConf) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetInputTokens() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.InputTokens)
+	if p.IsSetBaseVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.BaseVersion)
 	}
 	return offset
 }
 
-func (p *EvaluatorUsage) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionCheckConf) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetOutputTokens() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.OutputTokens)
+	if p.IsSetAction() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Action)
 	}
 	return offset
 }
 
-func (p *EvaluatorUsage) field1Length() int {
-	l := 0
-	if p.IsSetInputTokens() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+func (p *EvaluatorPromotionCheckConf) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSampleSize() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.SampleSize)
 	}
-	return l
+	return offset
 }
 
-func (p *EvaluatorUsage) field2Length() int {
-	l := 0
-	if p.IsSetOutputTokens() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+func (p *EvaluatorPromotionCheckConf) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPassThreshold() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 4)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.PassThreshold)
 	}
-	return l
+	return offset
 }
 
-func (p *EvaluatorUsage) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorUsage)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
+func (p *EvaluatorPromotionCheckConf) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMaxMeanAbsDrift() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 10)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.MaxMeanAbsDrift)
 	}
+	return offset
+}
 
-	if src.InputTokens != nil {
-		tmp := *src.InputTokens
-		p.InputTokens = &tmp
+func (p *EvaluatorPromotionCheckConf) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMaxFlipRate() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 11)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.MaxFlipRate)
 	}
+	return offset
+}
 
-	if src.OutputTokens != nil {
-		tmp := *src.OutputTokens
-		p.OutputTokens = &tmp
+func (p *EvaluatorPromotionCheckConf) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMaxDraftFailRate() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 12)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.MaxDraftFailRate)
 	}
-
-	return nil
+	return offset
 }
 
-func (p *EvaluatorRunError) FastRead(buf []byte) (int, error) {
-
-	var err error
-	var offset int
-	var l int
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	for {
-		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
-		offset += l
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
-			}
-		}
+func (p *EvaluatorPromotionCheckConf) fastWriteField13(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMaxLatencyIncreaseRatio() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 13)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.MaxLatencyIncreaseRatio)
 	}
-
-	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorRunError[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+	return offset
 }
 
-func (p *EvaluatorRunError) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorPromotionCheckConf) fastWriteField14(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+	if p.IsSetMaxTokenIncreaseRatio() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 14)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.MaxTokenIncreaseRatio)
 	}
-	p.Code = _field
-	return offset, nil
+	return offset
 }
 
-func (p *EvaluatorRunError) FastReadField2(buf []byte) (int, error) {
-	offset := 0
+func (p *EvaluatorPromotionCheckConf) field1Length() int {
+	l := 0
+	if p.IsSetBaseVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.BaseVersion)
+	}
+	return l
+}
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+func (p *EvaluatorPromotionCheckConf) field2Length() int {
+	l := 0
+	if p.IsSetAction() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Action)
 	}
-	p.Message = _field
-	return offset, nil
+	return l
 }
 
-func (p *EvaluatorRunError) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
+func (p *EvaluatorPromotionCheckConf) field3Length() int {
+	l := 0
+	if p.IsSetSampleSize() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
 }
 
-func (p *EvaluatorRunError) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
+func (p *EvaluatorPromotionCheckConf) field4Length() int {
+	l := 0
+	if p.IsSetPassThreshold() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
 	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
+	return l
 }
 
-func (p *EvaluatorRunError) BLength() int {
+func (p *EvaluatorPromotionCheckConf) field10Length() int {
 	l := 0
-	if p != nil {
-		l += p.field1Length()
-		l += p.field2Length()
+	if p.IsSetMaxMeanAbsDrift() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
 	}
-	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvaluatorRunError) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetCode() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 1)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.Code)
+func (p *EvaluatorPromotionCheckConf) field11Length() int {
+	l := 0
+	if p.IsSetMaxFlipRate() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
 	}
-	return offset
+	return l
 }
 
-func (p *EvaluatorRunError) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetMessage() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Message)
+func (p *EvaluatorPromotionCheckConf) field12Length() int {
+	l := 0
+	if p.IsSetMaxDraftFailRate() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
 	}
-	return offset
+	return l
 }
 
-func (p *EvaluatorRunError) field1Length() int {
+func (p *EvaluatorPromotionCheckConf) field13Length() int {
 	l := 0
-	if p.IsSetCode() {
+	if p.IsSetMaxLatencyIncreaseRatio() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *EvaluatorRunError) field2Length() int {
+func (p *EvaluatorPromotionCheckConf) field14Length() int {
 	l := 0
-	if p.IsSetMessage() {
+	if p.IsSetMaxTokenIncreaseRatio() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Message)
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *EvaluatorRunError) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorRunError)
+func (p *EvaluatorPromotionCheckConf) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorPromotionCheckConf)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Code != nil {
-		tmp := *src.Code
-		p.Code = &tmp
-	}
-
-	if src.Message != nil {
+	if src.BaseVersion != nil {
 		var tmp string
-		if *src.Message != "" {
-			tmp = kutils.StringDeepCopy(*src.Message)
+		if *src.BaseVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.BaseVersion)
 		}
-		p.Message = &tmp
+		p.BaseVersion = &tmp
+	}
+
+	if src.Action != nil {
+		tmp := *src.Action
+		p.Action = &tmp
+	}
+
+	if src.SampleSize != nil {
+		tmp := *src.SampleSize
+		p.SampleSize = &tmp
+	}
+
+	if src.PassThreshold != nil {
+		tmp := *src.PassThreshold
+		p.PassThreshold = &tmp
+	}
+
+	if src.MaxMeanAbsDrift != nil {
+		tmp := *src.MaxMeanAbsDrift
+		p.MaxMeanAbsDrift = &tmp
+	}
+
+	if src.MaxFlipRate != nil {
+		tmp := *src.MaxFlipRate
+		p.MaxFlipRate = &tmp
+	}
+
+	if src.MaxDraftFailRate != nil {
+		tmp := *src.MaxDraftFailRate
+		p.MaxDraftFailRate = &tmp
+	}
+
+	if src.MaxLatencyIncreaseRatio != nil {
+		tmp := *src.MaxLatencyIncreaseRatio
+		p.MaxLatencyIncreaseRatio = &tmp
+	}
+
+	if src.MaxTokenIncreaseRatio != nil {
+		tmp := *src.MaxTokenIncreaseRatio
+		p.MaxTokenIncreaseRatio = &tmp
 	}
 
 	return nil
 }
 
-func (p *EvaluatorExtraOutputContent) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorPromotionViolation) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -8555,7 +14856,7 @@ func (p *EvaluatorExtraOutputContent) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -8569,7 +14870,7 @@ func (p *EvaluatorExtraOutputContent) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -8595,69 +14896,69 @@ func (p *EvaluatorExtraOutputContent) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorExtraOutputContent[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorPromotionViolation[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorExtraOutputContent) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorPromotionViolation) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *EvaluatorExtraOutputType
+	var _field *string
 	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.OutputType = _field
+	p.Metric = _field
 	return offset, nil
 }
 
-func (p *EvaluatorExtraOutputContent) FastReadField2(buf []byte) (int, error) {
+func (p *EvaluatorPromotionViolation) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.URI = _field
+	p.Value = _field
 	return offset, nil
 }
 
-func (p *EvaluatorExtraOutputContent) FastReadField3(buf []byte) (int, error) {
+func (p *EvaluatorPromotionViolation) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.URL = _field
+	p.Threshold = _field
 	return offset, nil
 }
 
-func (p *EvaluatorExtraOutputContent) FastWrite(buf []byte) int {
+func (p *EvaluatorPromotionViolation) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorExtraOutputContent) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionViolation) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvaluatorExtraOutputContent) BLength() int {
+func (p *EvaluatorPromotionViolation) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -8668,91 +14969,88 @@ func (p *EvaluatorExtraOutputContent) BLength() int {
 	return l
 }
 
-func (p *EvaluatorExtraOutputContent) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionViolation) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetOutputType() {
+	if p.IsSetMetric() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.OutputType)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Metric)
 	}
 	return offset
 }
 
-func (p *EvaluatorExtraOutputContent) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionViolation) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetURI() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.URI)
+	if p.IsSetValue() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 2)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Value)
 	}
 	return offset
 }
 
-func (p *EvaluatorExtraOutputContent) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionViolation) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetURL() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.URL)
+	if p.IsSetThreshold() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 3)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Threshold)
 	}
 	return offset
 }
 
-func (p *EvaluatorExtraOutputContent) field1Length() int {
+func (p *EvaluatorPromotionViolation) field1Length() int {
 	l := 0
-	if p.IsSetOutputType() {
+	if p.IsSetMetric() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.OutputType)
+		l += thrift.Binary.StringLengthNocopy(*p.Metric)
 	}
 	return l
 }
 
-func (p *EvaluatorExtraOutputContent) field2Length() int {
+func (p *EvaluatorPromotionViolation) field2Length() int {
 	l := 0
-	if p.IsSetURI() {
+	if p.IsSetValue() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.URI)
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *EvaluatorExtraOutputContent) field3Length() int {
+func (p *EvaluatorPromotionViolation) field3Length() int {
 	l := 0
-	if p.IsSetURL() {
+	if p.IsSetThreshold() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.URL)
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *EvaluatorExtraOutputContent) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorExtraOutputContent)
+func (p *EvaluatorPromotionViolation) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorPromotionViolation)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.OutputType != nil {
-		tmp := *src.OutputType
-		p.OutputType = &tmp
-	}
-
-	if src.URI != nil {
+	if src.Metric != nil {
 		var tmp string
-		if *src.URI != "" {
-			tmp = kutils.StringDeepCopy(*src.URI)
+		if *src.Metric != "" {
+			tmp = kutils.StringDeepCopy(*src.Metric)
 		}
-		p.URI = &tmp
+		p.Metric = &tmp
 	}
 
-	if src.URL != nil {
-		var tmp string
-		if *src.URL != "" {
-			tmp = kutils.StringDeepCopy(*src.URL)
-		}
-		p.URL = &tmp
+	if src.Value != nil {
+		tmp := *src.Value
+		p.Value = &tmp
+	}
+
+	if src.Threshold != nil {
+		tmp := *src.Threshold
+		p.Threshold = &tmp
 	}
 
 	return nil
 }
 
-func (p *EvaluatorInputData) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorPromotionDriftItem) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -8770,7 +15068,7 @@ func (p *EvaluatorInputData) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -8784,7 +15082,7 @@ func (p *EvaluatorInputData) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -8798,7 +15096,7 @@ func (p *EvaluatorInputData) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -8812,7 +15110,7 @@ func (p *EvaluatorInputData) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.DOUBLE {
 				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
@@ -8825,9 +15123,51 @@ func (p *EvaluatorInputData) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 100:
-			if fieldTypeId == thrift.MAP {
-				l, err = p.FastReadField100(buf[offset:])
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField8(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -8852,456 +15192,356 @@ func (p *EvaluatorInputData) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorInputData[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorPromotionDriftItem[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorInputData) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorPromotionDriftItem) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*common.Message, 0, size)
-	values := make([]common.Message, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.HistoryMessages = _field
+	p.RecordID = _field
 	return offset, nil
 }
 
-func (p *EvaluatorInputData) FastReadField2(buf []byte) (int, error) {
+func (p *EvaluatorPromotionDriftItem) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make(map[string]*common.Content, size)
-	values := make([]common.Content, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
-
-		_val := &values[i]
-		_val.InitDefault()
-		if l, err := _val.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field[_key] = _val
-	}
-	p.InputFields = _field
+	p.ItemID = _field
 	return offset, nil
 }
 
-func (p *EvaluatorInputData) FastReadField3(buf []byte) (int, error) {
+func (p *EvaluatorPromotionDriftItem) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make(map[string]*common.Content, size)
-	values := make([]common.Content, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
+	p.TurnID = _field
+	return offset, nil
+}
 
-		_val := &values[i]
-		_val.InitDefault()
-		if l, err := _val.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
+func (p *EvaluatorPromotionDriftItem) FastReadField4(buf []byte) (int, error) {
+	offset := 0
 
-		_field[_key] = _val
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	p.EvaluateDatasetFields = _field
+	p.BaseScore = _field
 	return offset, nil
 }
 
-func (p *EvaluatorInputData) FastReadField4(buf []byte) (int, error) {
+func (p *EvaluatorPromotionDriftItem) FastReadField5(buf []byte) (int, error) {
 	offset := 0
 
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make(map[string]*common.Content, size)
-	values := make([]common.Content, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
+	p.DraftScore = _field
+	return offset, nil
+}
 
-		_val := &values[i]
-		_val.InitDefault()
-		if l, err := _val.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
+func (p *EvaluatorPromotionDriftItem) FastReadField6(buf []byte) (int, error) {
+	offset := 0
 
-		_field[_key] = _val
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	p.EvaluateTargetOutputFields = _field
+	p.Drift = _field
 	return offset, nil
 }
 
-func (p *EvaluatorInputData) FastReadField100(buf []byte) (int, error) {
+func (p *EvaluatorPromotionDriftItem) FastReadField7(buf []byte) (int, error) {
 	offset := 0
 
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
+	p.Flipped = _field
+	return offset, nil
+}
 
-		var _val string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_val = v
-		}
+func (p *EvaluatorPromotionDriftItem) FastReadField8(buf []byte) (int, error) {
+	offset := 0
 
-		_field[_key] = _val
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	p.Ext = _field
+	p.DraftReasoning = _field
 	return offset, nil
 }
 
-func (p *EvaluatorInputData) FastWrite(buf []byte) int {
+func (p *EvaluatorPromotionDriftItem) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorInputData) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionDriftItem) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField100(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvaluatorInputData) BLength() int {
+func (p *EvaluatorPromotionDriftItem) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
-		l += p.field100Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvaluatorInputData) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionDriftItem) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetHistoryMessages() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.HistoryMessages {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetRecordID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.RecordID)
 	}
 	return offset
 }
 
-func (p *EvaluatorInputData) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionDriftItem) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetInputFields() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 2)
-		mapBeginOffset := offset
-		offset += thrift.Binary.MapBeginLength()
-		var length int
-		for k, v := range p.InputFields {
-			length++
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRUCT, length)
+	if p.IsSetItemID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ItemID)
 	}
 	return offset
 }
 
-func (p *EvaluatorInputData) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionDriftItem) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvaluateDatasetFields() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 3)
-		mapBeginOffset := offset
-		offset += thrift.Binary.MapBeginLength()
-		var length int
-		for k, v := range p.EvaluateDatasetFields {
-			length++
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRUCT, length)
+	if p.IsSetTurnID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.TurnID)
 	}
 	return offset
 }
 
-func (p *EvaluatorInputData) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionDriftItem) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvaluateTargetOutputFields() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 4)
-		mapBeginOffset := offset
-		offset += thrift.Binary.MapBeginLength()
-		var length int
-		for k, v := range p.EvaluateTargetOutputFields {
-			length++
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRUCT, length)
+	if p.IsSetBaseScore() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 4)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.BaseScore)
 	}
 	return offset
 }
 
-func (p *EvaluatorInputData) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionDriftItem) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetExt() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 100)
-		mapBeginOffset := offset
-		offset += thrift.Binary.MapBeginLength()
-		var length int
-		for k, v := range p.Ext {
-			length++
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
-		}
-		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRING, length)
+	if p.IsSetDraftScore() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 5)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.DraftScore)
 	}
 	return offset
 }
 
-func (p *EvaluatorInputData) field1Length() int {
+func (p *EvaluatorPromotionDriftItem) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDrift() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 6)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Drift)
+	}
+	return offset
+}
+
+func (p *EvaluatorPromotionDriftItem) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFlipped() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 7)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.Flipped)
+	}
+	return offset
+}
+
+func (p *EvaluatorPromotionDriftItem) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDraftReasoning() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 8)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.DraftReasoning)
+	}
+	return offset
+}
+
+func (p *EvaluatorPromotionDriftItem) field1Length() int {
 	l := 0
-	if p.IsSetHistoryMessages() {
+	if p.IsSetRecordID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.HistoryMessages {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *EvaluatorInputData) field2Length() int {
+func (p *EvaluatorPromotionDriftItem) field2Length() int {
 	l := 0
-	if p.IsSetInputFields() {
+	if p.IsSetItemID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.MapBeginLength()
-		for k, v := range p.InputFields {
-			_, _ = k, v
-
-			l += thrift.Binary.StringLengthNocopy(k)
-			l += v.BLength()
-		}
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *EvaluatorInputData) field3Length() int {
+func (p *EvaluatorPromotionDriftItem) field3Length() int {
 	l := 0
-	if p.IsSetEvaluateDatasetFields() {
+	if p.IsSetTurnID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.MapBeginLength()
-		for k, v := range p.EvaluateDatasetFields {
-			_, _ = k, v
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
 
-			l += thrift.Binary.StringLengthNocopy(k)
-			l += v.BLength()
-		}
+func (p *EvaluatorPromotionDriftItem) field4Length() int {
+	l := 0
+	if p.IsSetBaseScore() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *EvaluatorInputData) field4Length() int {
+func (p *EvaluatorPromotionDriftItem) field5Length() int {
 	l := 0
-	if p.IsSetEvaluateTargetOutputFields() {
+	if p.IsSetDraftScore() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.MapBeginLength()
-		for k, v := range p.EvaluateTargetOutputFields {
-			_, _ = k, v
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
 
-			l += thrift.Binary.StringLengthNocopy(k)
-			l += v.BLength()
-		}
+func (p *EvaluatorPromotionDriftItem) field6Length() int {
+	l := 0
+	if p.IsSetDrift() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *EvaluatorInputData) field100Length() int {
+func (p *EvaluatorPromotionDriftItem) field7Length() int {
 	l := 0
-	if p.IsSetExt() {
+	if p.IsSetFlipped() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.MapBeginLength()
-		for k, v := range p.Ext {
-			_, _ = k, v
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
 
-			l += thrift.Binary.StringLengthNocopy(k)
-			l += thrift.Binary.StringLengthNocopy(v)
-		}
+func (p *EvaluatorPromotionDriftItem) field8Length() int {
+	l := 0
+	if p.IsSetDraftReasoning() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.DraftReasoning)
 	}
 	return l
 }
 
-func (p *EvaluatorInputData) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorInputData)
+func (p *EvaluatorPromotionDriftItem) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorPromotionDriftItem)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.HistoryMessages != nil {
-		p.HistoryMessages = make([]*common.Message, 0, len(src.HistoryMessages))
-		for _, elem := range src.HistoryMessages {
-			var _elem *common.Message
-			if elem != nil {
-				_elem = &common.Message{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.HistoryMessages = append(p.HistoryMessages, _elem)
-		}
+	if src.RecordID != nil {
+		tmp := *src.RecordID
+		p.RecordID = &tmp
 	}
 
-	if src.InputFields != nil {
-		p.InputFields = make(map[string]*common.Content, len(src.InputFields))
-		for key, val := range src.InputFields {
-			var _key string
-			if key != "" {
-				_key = kutils.StringDeepCopy(key)
-			}
-
-			var _val *common.Content
-			if val != nil {
-				_val = &common.Content{}
-				if err := _val.DeepCopy(val); err != nil {
-					return err
-				}
-			}
-
-			p.InputFields[_key] = _val
-		}
+	if src.ItemID != nil {
+		tmp := *src.ItemID
+		p.ItemID = &tmp
 	}
 
-	if src.EvaluateDatasetFields != nil {
-		p.EvaluateDatasetFields = make(map[string]*common.Content, len(src.EvaluateDatasetFields))
-		for key, val := range src.EvaluateDatasetFields {
-			var _key string
-			if key != "" {
-				_key = kutils.StringDeepCopy(key)
-			}
-
-			var _val *common.Content
-			if val != nil {
-				_val = &common.Content{}
-				if err := _val.DeepCopy(val); err != nil {
-					return err
-				}
-			}
-
-			p.EvaluateDatasetFields[_key] = _val
-		}
+	if src.TurnID != nil {
+		tmp := *src.TurnID
+		p.TurnID = &tmp
 	}
 
-	if src.EvaluateTargetOutputFields != nil {
-		p.EvaluateTargetOutputFields = make(map[string]*common.Content, len(src.EvaluateTargetOutputFields))
-		for key, val := range src.EvaluateTargetOutputFields {
-			var _key string
-			if key != "" {
-				_key = kutils.StringDeepCopy(key)
-			}
-
-			var _val *common.Content
-			if val != nil {
-				_val = &common.Content{}
-				if err := _val.DeepCopy(val); err != nil {
-					return err
-				}
-			}
+	if src.BaseScore != nil {
+		tmp := *src.BaseScore
+		p.BaseScore = &tmp
+	}
 
-			p.EvaluateTargetOutputFields[_key] = _val
-		}
+	if src.DraftScore != nil {
+		tmp := *src.DraftScore
+		p.DraftScore = &tmp
 	}
 
-	if src.Ext != nil {
-		p.Ext = make(map[string]string, len(src.Ext))
-		for key, val := range src.Ext {
-			var _key string
-			if key != "" {
-				_key = kutils.StringDeepCopy(key)
-			}
+	if src.Drift != nil {
+		tmp := *src.Drift
+		p.Drift = &tmp
+	}
 
-			var _val string
-			if val != "" {
-				_val = kutils.StringDeepCopy(val)
-			}
+	if src.Flipped != nil {
+		tmp := *src.Flipped
+		p.Flipped = &tmp
+	}
 
-			p.Ext[_key] = _val
+	if src.DraftReasoning != nil {
+		var tmp string
+		if *src.DraftReasoning != "" {
+			tmp = kutils.StringDeepCopy(*src.DraftReasoning)
 		}
+		p.DraftReasoning = &tmp
 	}
 
 	return nil
 }
 
-func (p *EvaluatorHTTPInfo) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorPromotionReport) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -9319,7 +15559,7 @@ func (p *EvaluatorHTTPInfo) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -9332,9 +15572,247 @@ func (p *EvaluatorHTTPInfo) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField2(buf[offset:])
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField9(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField10(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField11(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 12:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField12(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 13:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField13(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 14:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField14(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 15:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField15(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 16:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField16(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 17:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField17(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 18:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField18(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 19:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField19(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -9359,26 +15837,26 @@ func (p *EvaluatorHTTPInfo) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorHTTPInfo[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorPromotionReport[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorHTTPInfo) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorPromotionReport) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *EvaluatorHTTPMethod
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Method = _field
+	p.BaseVersionID = _field
 	return offset, nil
 }
 
-func (p *EvaluatorHTTPInfo) FastReadField2(buf []byte) (int, error) {
+func (p *EvaluatorPromotionReport) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -9388,547 +15866,814 @@ func (p *EvaluatorHTTPInfo) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Path = _field
+	p.BaseVersion = _field
 	return offset, nil
 }
 
-func (p *EvaluatorHTTPInfo) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
+func (p *EvaluatorPromotionReport) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *EvaluatorPromotionVerdict
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Verdict = _field
+	return offset, nil
 }
 
-func (p *EvaluatorHTTPInfo) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionReport) FastReadField4(buf []byte) (int, error) {
 	offset := 0
-	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
+	p.SampleCnt = _field
+	return offset, nil
 }
 
-func (p *EvaluatorHTTPInfo) BLength() int {
-	l := 0
-	if p != nil {
-		l += p.field1Length()
-		l += p.field2Length()
+func (p *EvaluatorPromotionReport) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	l += thrift.Binary.FieldStopLength()
-	return l
+	p.PairedCnt = _field
+	return offset, nil
 }
 
-func (p *EvaluatorHTTPInfo) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionReport) FastReadField6(buf []byte) (int, error) {
 	offset := 0
-	if p.IsSetMethod() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Method)
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	return offset
+	p.DraftFailCnt = _field
+	return offset, nil
 }
 
-func (p *EvaluatorHTTPInfo) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionReport) FastReadField7(buf []byte) (int, error) {
 	offset := 0
-	if p.IsSetPath() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Path)
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	return offset
+	p.MeanDrift = _field
+	return offset, nil
 }
 
-func (p *EvaluatorHTTPInfo) field1Length() int {
-	l := 0
-	if p.IsSetMethod() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Method)
+func (p *EvaluatorPromotionReport) FastReadField8(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	return l
+	p.MeanAbsDrift = _field
+	return offset, nil
 }
 
-func (p *EvaluatorHTTPInfo) field2Length() int {
-	l := 0
-	if p.IsSetPath() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Path)
+func (p *EvaluatorPromotionReport) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	return l
+	p.MaxAbsDrift = _field
+	return offset, nil
 }
 
-func (p *EvaluatorHTTPInfo) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorHTTPInfo)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
+func (p *EvaluatorPromotionReport) FastReadField10(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
+	p.FlipCnt = _field
+	return offset, nil
+}
 
-	if src.Method != nil {
-		tmp := *src.Method
-		p.Method = &tmp
+func (p *EvaluatorPromotionReport) FastReadField11(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
+	p.FlipRate = _field
+	return offset, nil
+}
 
-	if src.Path != nil {
-		var tmp string
-		if *src.Path != "" {
-			tmp = kutils.StringDeepCopy(*src.Path)
-		}
-		p.Path = &tmp
+func (p *EvaluatorPromotionReport) FastReadField12(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
+	p.BaseAvgLatencyMs = _field
+	return offset, nil
+}
 
-	return nil
+func (p *EvaluatorPromotionReport) FastReadField13(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.DraftAvgLatencyMs = _field
+	return offset, nil
 }
 
-func (p *EvaluatorRunConfig) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorPromotionReport) FastReadField14(buf []byte) (int, error) {
+	offset := 0
 
-	var err error
-	var offset int
-	var l int
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	for {
-		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
 		offset += l
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
-			}
-		}
+		_field = &v
 	}
+	p.BaseAvgTokens = _field
+	return offset, nil
+}
+
+func (p *EvaluatorPromotionReport) FastReadField15(buf []byte) (int, error) {
+	offset := 0
 
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.DraftAvgTokens = _field
+	return offset, nil
+}
+
+func (p *EvaluatorPromotionReport) FastReadField16(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.LatencyChangeRatio = _field
 	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorRunConfig[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorRunConfig) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorPromotionReport) FastReadField17(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Env = _field
+	p.TokenChangeRatio = _field
 	return offset, nil
 }
 
-func (p *EvaluatorRunConfig) FastReadField2(buf []byte) (int, error) {
+func (p *EvaluatorPromotionReport) FastReadField18(buf []byte) (int, error) {
 	offset := 0
-	_field := common.NewRuntimeParam()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
 	}
-	p.EvaluatorRuntimeParam = _field
+	_field := make([]*EvaluatorPromotionViolation, 0, size)
+	values := make([]EvaluatorPromotionViolation, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Violations = _field
 	return offset, nil
 }
 
-func (p *EvaluatorRunConfig) FastWrite(buf []byte) int {
+func (p *EvaluatorPromotionReport) FastReadField19(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*EvaluatorPromotionDriftItem, 0, size)
+	values := make([]EvaluatorPromotionDriftItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.DriftItems = _field
+	return offset, nil
+}
+
+func (p *EvaluatorPromotionReport) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorRunConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionReport) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
+		offset += p.fastWriteField11(buf[offset:], w)
+		offset += p.fastWriteField12(buf[offset:], w)
+		offset += p.fastWriteField13(buf[offset:], w)
+		offset += p.fastWriteField14(buf[offset:], w)
+		offset += p.fastWriteField15(buf[offset:], w)
+		offset += p.fastWriteField16(buf[offset:], w)
+		offset += p.fastWriteField17(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField18(buf[offset:], w)
+		offset += p.fastWriteField19(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvaluatorRunConfig) BLength() int {
+func (p *EvaluatorPromotionReport) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
+		l += p.field9Length()
+		l += p.field10Length()
+		l += p.field11Length()
+		l += p.field12Length()
+		l += p.field13Length()
+		l += p.field14Length()
+		l += p.field15Length()
+		l += p.field16Length()
+		l += p.field17Length()
+		l += p.field18Length()
+		l += p.field19Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvaluatorRunConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionReport) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEnv() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Env)
+	if p.IsSetBaseVersionID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.BaseVersionID)
 	}
 	return offset
 }
 
-func (p *EvaluatorRunConfig) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorPromotionReport) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvaluatorRuntimeParam() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
-		offset += p.EvaluatorRuntimeParam.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetBaseVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.BaseVersion)
 	}
 	return offset
 }
 
-func (p *EvaluatorRunConfig) field1Length() int {
-	l := 0
-	if p.IsSetEnv() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Env)
+func (p *EvaluatorPromotionReport) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetVerdict() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Verdict)
 	}
-	return l
+	return offset
 }
 
-func (p *EvaluatorRunConfig) field2Length() int {
-	l := 0
-	if p.IsSetEvaluatorRuntimeParam() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.EvaluatorRuntimeParam.BLength()
+func (p *EvaluatorPromotionReport) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSampleCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.SampleCnt)
 	}
-	return l
+	return offset
 }
 
-func (p *EvaluatorRunConfig) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorRunConfig)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
+func (p *EvaluatorPromotionReport) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPairedCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 5)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PairedCnt)
 	}
+	return offset
+}
 
-	if src.Env != nil {
-		var tmp string
-		if *src.Env != "" {
-			tmp = kutils.StringDeepCopy(*src.Env)
-		}
-		p.Env = &tmp
+func (p *EvaluatorPromotionReport) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDraftFailCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 6)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.DraftFailCnt)
 	}
+	return offset
+}
 
-	var _evaluatorRuntimeParam *common.RuntimeParam
-	if src.EvaluatorRuntimeParam != nil {
-		_evaluatorRuntimeParam = &common.RuntimeParam{}
-		if err := _evaluatorRuntimeParam.DeepCopy(src.EvaluatorRuntimeParam); err != nil {
-			return err
-		}
+func (p *EvaluatorPromotionReport) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMeanDrift() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 7)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.MeanDrift)
 	}
-	p.EvaluatorRuntimeParam = _evaluatorRuntimeParam
+	return offset
+}
 
-	return nil
+func (p *EvaluatorPromotionReport) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMeanAbsDrift() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 8)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.MeanAbsDrift)
+	}
+	return offset
 }
 
-func (p *EvaluatorProgressMessage) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorPromotionReport) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMaxAbsDrift() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 9)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.MaxAbsDrift)
+	}
+	return offset
+}
 
-	var err error
-	var offset int
-	var l int
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	for {
-		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
-		offset += l
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField3(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
-			}
+func (p *EvaluatorPromotionReport) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFlipCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 10)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.FlipCnt)
+	}
+	return offset
+}
+
+func (p *EvaluatorPromotionReport) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFlipRate() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 11)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.FlipRate)
+	}
+	return offset
+}
+
+func (p *EvaluatorPromotionReport) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseAvgLatencyMs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 12)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.BaseAvgLatencyMs)
+	}
+	return offset
+}
+
+func (p *EvaluatorPromotionReport) fastWriteField13(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDraftAvgLatencyMs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 13)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.DraftAvgLatencyMs)
+	}
+	return offset
+}
+
+func (p *EvaluatorPromotionReport) fastWriteField14(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseAvgTokens() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 14)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.BaseAvgTokens)
+	}
+	return offset
+}
+
+func (p *EvaluatorPromotionReport) fastWriteField15(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDraftAvgTokens() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 15)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.DraftAvgTokens)
+	}
+	return offset
+}
+
+func (p *EvaluatorPromotionReport) fastWriteField16(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLatencyChangeRatio() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 16)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.LatencyChangeRatio)
+	}
+	return offset
+}
+
+func (p *EvaluatorPromotionReport) fastWriteField17(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTokenChangeRatio() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 17)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.TokenChangeRatio)
+	}
+	return offset
+}
+
+func (p *EvaluatorPromotionReport) fastWriteField18(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetViolations() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 18)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Violations {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
-
-	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorProgressMessage[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+	return offset
 }
 
-func (p *EvaluatorProgressMessage) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorPromotionReport) fastWriteField19(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
+	if p.IsSetDriftItems() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 19)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.DriftItems {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+func (p *EvaluatorPromotionReport) field1Length() int {
+	l := 0
+	if p.IsSetBaseVersionID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
 	}
-	p.Role = _field
-	return offset, nil
+	return l
 }
 
-func (p *EvaluatorProgressMessage) FastReadField2(buf []byte) (int, error) {
-	offset := 0
+func (p *EvaluatorPromotionReport) field2Length() int {
+	l := 0
+	if p.IsSetBaseVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.BaseVersion)
+	}
+	return l
+}
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+func (p *EvaluatorPromotionReport) field3Length() int {
+	l := 0
+	if p.IsSetVerdict() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Verdict)
 	}
-	p.Type = _field
-	return offset, nil
+	return l
 }
 
-func (p *EvaluatorProgressMessage) FastReadField3(buf []byte) (int, error) {
-	offset := 0
+func (p *EvaluatorPromotionReport) field4Length() int {
+	l := 0
+	if p.IsSetSampleCnt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+func (p *EvaluatorPromotionReport) field5Length() int {
+	l := 0
+	if p.IsSetPairedCnt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
 	}
-	p.Message = _field
-	return offset, nil
+	return l
 }
 
-func (p *EvaluatorProgressMessage) FastReadField4(buf []byte) (int, error) {
-	offset := 0
+func (p *EvaluatorPromotionReport) field6Length() int {
+	l := 0
+	if p.IsSetDraftFailCnt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+func (p *EvaluatorPromotionReport) field7Length() int {
+	l := 0
+	if p.IsSetMeanDrift() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
 	}
-	p.CreatedAtMs = _field
-	return offset, nil
+	return l
 }
 
-func (p *EvaluatorProgressMessage) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
+func (p *EvaluatorPromotionReport) field8Length() int {
+	l := 0
+	if p.IsSetMeanAbsDrift() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
 }
 
-func (p *EvaluatorProgressMessage) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p != nil {
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
+func (p *EvaluatorPromotionReport) field9Length() int {
+	l := 0
+	if p.IsSetMaxAbsDrift() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
 	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
+	return l
 }
 
-func (p *EvaluatorProgressMessage) BLength() int {
+func (p *EvaluatorPromotionReport) field10Length() int {
 	l := 0
-	if p != nil {
-		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field3Length()
-		l += p.field4Length()
+	if p.IsSetFlipCnt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
 	}
-	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvaluatorProgressMessage) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetRole() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Role)
+func (p *EvaluatorPromotionReport) field11Length() int {
+	l := 0
+	if p.IsSetFlipRate() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
 	}
-	return offset
+	return l
 }
 
-func (p *EvaluatorProgressMessage) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetType() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Type)
+func (p *EvaluatorPromotionReport) field12Length() int {
+	l := 0
+	if p.IsSetBaseAvgLatencyMs() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
 	}
-	return offset
+	return l
 }
 
-func (p *EvaluatorProgressMessage) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetMessage() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Message)
+func (p *EvaluatorPromotionReport) field13Length() int {
+	l := 0
+	if p.IsSetDraftAvgLatencyMs() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
 	}
-	return offset
+	return l
 }
 
-func (p *EvaluatorProgressMessage) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetCreatedAtMs() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.CreatedAtMs)
+func (p *EvaluatorPromotionReport) field14Length() int {
+	l := 0
+	if p.IsSetBaseAvgTokens() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
 	}
-	return offset
+	return l
 }
 
-func (p *EvaluatorProgressMessage) field1Length() int {
+func (p *EvaluatorPromotionReport) field15Length() int {
 	l := 0
-	if p.IsSetRole() {
+	if p.IsSetDraftAvgTokens() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Role)
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *EvaluatorProgressMessage) field2Length() int {
+func (p *EvaluatorPromotionReport) field16Length() int {
 	l := 0
-	if p.IsSetType() {
+	if p.IsSetLatencyChangeRatio() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Type)
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *EvaluatorProgressMessage) field3Length() int {
+func (p *EvaluatorPromotionReport) field17Length() int {
 	l := 0
-	if p.IsSetMessage() {
+	if p.IsSetTokenChangeRatio() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Message)
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *EvaluatorProgressMessage) field4Length() int {
+func (p *EvaluatorPromotionReport) field18Length() int {
 	l := 0
-	if p.IsSetCreatedAtMs() {
+	if p.IsSetViolations() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Violations {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *EvaluatorProgressMessage) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorProgressMessage)
+func (p *EvaluatorPromotionReport) field19Length() int {
+	l := 0
+	if p.IsSetDriftItems() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.DriftItems {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvaluatorPromotionReport) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorPromotionReport)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Role != nil {
-		var tmp string
-		if *src.Role != "" {
-			tmp = kutils.StringDeepCopy(*src.Role)
-		}
-		p.Role = &tmp
+	if src.BaseVersionID != nil {
+		tmp := *src.BaseVersionID
+		p.BaseVersionID = &tmp
 	}
 
-	if src.Type != nil {
+	if src.BaseVersion != nil {
 		var tmp string
-		if *src.Type != "" {
-			tmp = kutils.StringDeepCopy(*src.Type)
+		if *src.BaseVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.BaseVersion)
 		}
-		p.Type = &tmp
+		p.BaseVersion = &tmp
 	}
 
-	if src.Message != nil {
-		var tmp string
-		if *src.Message != "" {
-			tmp = kutils.StringDeepCopy(*src.Message)
+	if src.Verdict != nil {
+		tmp := *src.Verdict
+		p.Verdict = &tmp
+	}
+
+	if src.SampleCnt != nil {
+		tmp := *src.SampleCnt
+		p.SampleCnt = &tmp
+	}
+
+	if src.PairedCnt != nil {
+		tmp := *src.PairedCnt
+		p.PairedCnt = &tmp
+	}
+
+	if src.DraftFailCnt != nil {
+		tmp := *src.DraftFailCnt
+		p.DraftFailCnt = &tmp
+	}
+
+	if src.MeanDrift != nil {
+		tmp := *src.MeanDrift
+		p.MeanDrift = &tmp
+	}
+
+	if src.MeanAbsDrift != nil {
+		tmp := *src.MeanAbsDrift
+		p.MeanAbsDrift = &tmp
+	}
+
+	if src.MaxAbsDrift != nil {
+		tmp := *src.MaxAbsDrift
+		p.MaxAbsDrift = &tmp
+	}
+
+	if src.FlipCnt != nil {
+		tmp := *src.FlipCnt
+		p.FlipCnt = &tmp
+	}
+
+	if src.FlipRate != nil {
+		tmp := *src.FlipRate
+		p.FlipRate = &tmp
+	}
+
+	if src.BaseAvgLatencyMs != nil {
+		tmp := *src.BaseAvgLatencyMs
+		p.BaseAvgLatencyMs = &tmp
+	}
+
+	if src.DraftAvgLatencyMs != nil {
+		tmp := *src.DraftAvgLatencyMs
+		p.DraftAvgLatencyMs = &tmp
+	}
+
+	if src.BaseAvgTokens != nil {
+		tmp := *src.BaseAvgTokens
+		p.BaseAvgTokens = &tmp
+	}
+
+	if src.DraftAvgTokens != nil {
+		tmp := *src.DraftAvgTokens
+		p.DraftAvgTokens = &tmp
+	}
+
+	if src.LatencyChangeRatio != nil {
+		tmp := *src.LatencyChangeRatio
+		p.LatencyChangeRatio = &tmp
+	}
+
+	if src.TokenChangeRatio != nil {
+		tmp := *src.TokenChangeRatio
+		p.TokenChangeRatio = &tmp
+	}
+
+	if src.Violations != nil {
+		p.Violations = make([]*EvaluatorPromotionViolation, 0, len(src.Violations))
+		for _, elem := range src.Violations {
+			var _elem *EvaluatorPromotionViolation
+			if elem != nil {
+				_elem = &EvaluatorPromotionViolation{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Violations = append(p.Violations, _elem)
 		}
-		p.Message = &tmp
 	}
 
-	if src.CreatedAtMs != nil {
-		tmp := *src.CreatedAtMs
-		p.CreatedAtMs = &tmp
+	if src.DriftItems != nil {
+		p.DriftItems = make([]*EvaluatorPromotionDriftItem, 0, len(src.DriftItems))
+		for _, elem := range src.DriftItems {
+			var _elem *EvaluatorPromotionDriftItem
+			if elem != nil {
+				_elem = &EvaluatorPromotionDriftItem{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.DriftItems = append(p.DriftItems, _elem)
+		}
 	}
 
 	return nil
@@ -99,6 +99,40 @@ const (
 	FeedbackActionTypeUpdateComment = "Update_Comment"
 
 	FeedbackActionTypeDeleteComment = "Delete_Comment"
+
+	ExptPairwiseRankStatusUnknown = "Unknown"
+
+	ExptPairwiseRankStatusRunning = "Running"
+
+	ExptPairwiseRankStatusSuccess = "Success"
+
+	ExptPairwiseRankStatusFailed = "Failed"
+
+	QualityGateMetricEvaluatorAvgScore = "evaluator_avg_score"
+
+	QualityGateMetricWeightedAvgScore = "weighted_avg_score"
+
+	QualityGateMetricFailRate = "fail_rate"
+
+	QualityGateMetricRegressionRate = "regression_rate"
+
+	QualityGateStatusPassed = "passed"
+
+	QualityGateStatusFailed = "failed"
+
+	QualityGateStatusError = "error"
+
+	ExptRegressionChangeTypeImproved = "improved"
+
+	ExptRegressionChangeTypeRegressed = "regressed"
+
+	ExptRegressionChangeTypeMixed = "mixed"
+
+	ExptSamplingModeRandomN = "random_n"
+
+	ExptSamplingModePercentage = "percentage"
+
+	ExptSamplingModeStratified = "stratified"
 )
 
 type ExptStatus int64
@@ -1284,6 +1318,54 @@ func (p *ExptEvalSetSourceType) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
+// ===============================
+// 分组聚合
+// ===============================
+// 分组维度来源
+type ExptGroupDimensionType int64
+
+const (
+	// 评测集字段, key 为评测集 schema 的 field_key
+	ExptGroupDimensionType_EvalSetField ExptGroupDimensionType = 1
+	// 人工标注标签, key 为 tag_key_id
+	ExptGroupDimensionType_AnnotationTag ExptGroupDimensionType = 2
+)
+
+func (p ExptGroupDimensionType) String() string {
+	switch p {
+	case ExptGroupDimensionType_EvalSetField:
+		return "EvalSetField"
+	case ExptGroupDimensionType_AnnotationTag:
+		return "AnnotationTag"
+	}
+	return "<UNSET>"
+}
+
+func ExptGroupDimensionTypeFromString(s string) (ExptGroupDimensionType, error) {
+	switch s {
+	case "EvalSetField":
+		return ExptGroupDimensionType_EvalSetField, nil
+	case "AnnotationTag":
+		return ExptGroupDimensionType_AnnotationTag, nil
+	}
+	return ExptGroupDimensionType(0), fmt.Errorf("not a valid ExptGroupDimensionType string")
+}
+
+func ExptGroupDimensionTypePtr(v ExptGroupDimensionType) *ExptGroupDimensionType { return &v }
+func (p *ExptGroupDimensionType) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = ExptGroupDimensionType(result.Int64)
+	return
+}
+
+func (p *ExptGroupDimensionType) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
 type Visibility = string
 
 type ExptTriggerType = string
@@ -1303,6 +1385,24 @@ type InsightAnalysisReportVoteType = string
 // 反馈动作
 type FeedbackActionType = string
 
+type ExptPairwiseRankStatus = string
+
+// ===============================
+// 质量门禁
+// ===============================
+// 门禁规则度量指标
+type QualityGateMetric = string
+
+// 门禁评估结论
+type QualityGateStatus = string
+
+type ExptRegressionChangeType = string
+
+// ===============================
+// 抽样评测
+// ===============================
+type ExptSamplingMode = string
+
 // RunModeConfig 实验级跑法配置 (对齐 runtime RunModeConfig)。run_mode 是顶层跑法总开关;
 // sua_mode 是 SUA 专属子字段, 仅 run_mode ∈ {sua_multi_turn, goal} 时生效。
 // 仅 SandboxAgent 评测对象 + MultiSetConfig 实验生效。
@@ -2192,6 +2292,20 @@ type Experiment struct {
 	// 实验级多轮/SUA 跑法配置回显: 从 experiment.eval_conf.run_mode_config 反序列化, 与 Create/Submit 入参 run_mode_config 同构。
 	// 仅 SandboxAgent + MultiSetConfig 实验非空。SUA 模型的 api_key/base_url 是运行时从 TCC 解析注入 case-file, 绝不回显。
 	RunModeConfig *RunModeConfig `thrift:"run_mode_config,115,optional" frugal:"115,optional,RunModeConfig" form:"run_mode_config" json:"run_mode_config,omitempty" query:"run_mode_config"`
+	// 质量门禁配置与评估结果; 结果在实验结束后异步评估写入, 评估完成前为空
+	QualityGateConf    *ExptQualityGateConf    `thrift:"quality_gate_conf,116,optional" frugal:"116,optional,ExptQualityGateConf" form:"quality_gate_conf" json:"quality_gate_conf,omitempty" query:"quality_gate_conf"`
+	QualityGateResult_ *ExptQualityGateResult_ `thrift:"quality_gate_result,117,optional" frugal:"117,optional,ExptQualityGateResult_" form:"quality_gate_result" json:"quality_gate_result,omitempty" query:"quality_gate_result"`
+	// 成本核算与花费上限配置
+	CostConf *ExptCostConf `thrift:"cost_conf,118,optional" frugal:"118,optional,ExptCostConf" form:"cost_conf" json:"cost_conf,omitempty" query:"cost_conf"`
+	// 评测对象/评估器结果缓存配置
+	ResultCacheConf *ExptResultCacheConf `thrift:"result_cache_conf,119,optional" frugal:"119,optional,ExptResultCacheConf" form:"result_cache_conf" json:"result_cache_conf,omitempty" query:"result_cache_conf"`
+	// 抽样配置与抽样结果; 抽样结果在实验启动扫描评测集时写入
+	SamplingConf    *ExptSamplingConf    `thrift:"sampling_conf,120,optional" frugal:"120,optional,ExptSamplingConf" form:"sampling_conf" json:"sampling_conf,omitempty" query:"sampling_conf"`
+	SamplingResult_ *ExptSamplingResult_ `thrift:"sampling_result,121,optional" frugal:"121,optional,ExptSamplingResult_" form:"sampling_result" json:"sampling_result,omitempty" query:"sampling_result"`
+	// 重复试验配置
+	TrialConf *ExptTrialConf `thrift:"trial_conf,122,optional" frugal:"122,optional,ExptTrialConf" form:"trial_conf" json:"trial_conf,omitempty" query:"trial_conf"`
+	// 模拟用户配置
+	UserSimulatorConf *ExptUserSimulatorConf `thrift:"user_simulator_conf,123,optional" frugal:"123,optional,ExptUserSimulatorConf" form:"user_simulator_conf" json:"user_simulator_conf,omitempty" query:"user_simulator_conf"`
 }
 
 func NewExperiment() *Experiment {
@@ -2764,6 +2878,102 @@ func (p *Experiment) GetRunModeConfig() (v *RunModeConfig) {
 	}
 	return p.RunModeConfig
 }
+
+var Experiment_QualityGateConf_DEFAULT *ExptQualityGateConf
+
+func (p *Experiment) GetQualityGateConf() (v *ExptQualityGateConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetQualityGateConf() {
+		return Experiment_QualityGateConf_DEFAULT
+	}
+	return p.QualityGateConf
+}
+
+var Experiment_QualityGateResult__DEFAULT *ExptQualityGateResult_
+
+func (p *Experiment) GetQualityGateResult_() (v *ExptQualityGateResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetQualityGateResult_() {
+		return Experiment_QualityGateResult__DEFAULT
+	}
+	return p.QualityGateResult_
+}
+
+var Experiment_CostConf_DEFAULT *ExptCostConf
+
+func (p *Experiment) GetCostConf() (v *ExptCostConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCostConf() {
+		return Experiment_CostConf_DEFAULT
+	}
+	return p.CostConf
+}
+
+var Experiment_ResultCacheConf_DEFAULT *ExptResultCacheConf
+
+func (p *Experiment) GetResultCacheConf() (v *ExptResultCacheConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetResultCacheConf() {
+		return Experiment_ResultCacheConf_DEFAULT
+	}
+	return p.ResultCacheConf
+}
+
+var Experiment_SamplingConf_DEFAULT *ExptSamplingConf
+
+func (p *Experiment) GetSamplingConf() (v *ExptSamplingConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSamplingConf() {
+		return Experiment_SamplingConf_DEFAULT
+	}
+	return p.SamplingConf
+}
+
+var Experiment_SamplingResult__DEFAULT *ExptSamplingResult_
+
+func (p *Experiment) GetSamplingResult_() (v *ExptSamplingResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSamplingResult_() {
+		return Experiment_SamplingResult__DEFAULT
+	}
+	return p.SamplingResult_
+}
+
+var Experiment_TrialConf_DEFAULT *ExptTrialConf
+
+func (p *Experiment) GetTrialConf() (v *ExptTrialConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTrialConf() {
+		return Experiment_TrialConf_DEFAULT
+	}
+	return p.TrialConf
+}
+
+var Experiment_UserSimulatorConf_DEFAULT *ExptUserSimulatorConf
+
+func (p *Experiment) GetUserSimulatorConf() (v *ExptUserSimulatorConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUserSimulatorConf() {
+		return Experiment_UserSimulatorConf_DEFAULT
+	}
+	return p.UserSimulatorConf
+}
 func (p *Experiment) SetID(val *int64) {
 	p.ID = val
 }
@@ -2905,6 +3115,30 @@ func (p *Experiment) SetTotalItemCount(val *int64) {
 func (p *Experiment) SetRunModeConfig(val *RunModeConfig) {
 	p.RunModeConfig = val
 }
+func (p *Experiment) SetQualityGateConf(val *ExptQualityGateConf) {
+	p.QualityGateConf = val
+}
+func (p *Experiment) SetQualityGateResult_(val *ExptQualityGateResult_) {
+	p.QualityGateResult_ = val
+}
+func (p *Experiment) SetCostConf(val *ExptCostConf) {
+	p.CostConf = val
+}
+func (p *Experiment) SetResultCacheConf(val *ExptResultCacheConf) {
+	p.ResultCacheConf = val
+}
+func (p *Experiment) SetSamplingConf(val *ExptSamplingConf) {
+	p.SamplingConf = val
+}
+func (p *Experiment) SetSamplingResult_(val *ExptSamplingResult_) {
+	p.SamplingResult_ = val
+}
+func (p *Experiment) SetTrialConf(val *ExptTrialConf) {
+	p.TrialConf = val
+}
+func (p *Experiment) SetUserSimulatorConf(val *ExptUserSimulatorConf) {
+	p.UserSimulatorConf = val
+}
 
 var fieldIDToName_Experiment = map[int16]string{
 	1:   "id",
@@ -2954,6 +3188,14 @@ var fieldIDToName_Experiment = map[int16]string{
 	113: "evaluators_concur_num",
 	114: "total_item_count",
 	115: "run_mode_config",
+	116: "quality_gate_conf",
+	117: "quality_gate_result",
+	118: "cost_conf",
+	119: "result_cache_conf",
+	120: "sampling_conf",
+	121: "sampling_result",
+	122: "trial_conf",
+	123: "user_simulator_conf",
 }
 
 func (p *Experiment) IsSetID() bool {
@@ -3144,6 +3386,38 @@ func (p *Experiment) IsSetRunModeConfig() bool {
 	return p.RunModeConfig != nil
 }
 
+func (p *Experiment) IsSetQualityGateConf() bool {
+	return p.QualityGateConf != nil
+}
+
+func (p *Experiment) IsSetQualityGateResult_() bool {
+	return p.QualityGateResult_ != nil
+}
+
+func (p *Experiment) IsSetCostConf() bool {
+	return p.CostConf != nil
+}
+
+func (p *Experiment) IsSetResultCacheConf() bool {
+	return p.ResultCacheConf != nil
+}
+
+func (p *Experiment) IsSetSamplingConf() bool {
+	return p.SamplingConf != nil
+}
+
+func (p *Experiment) IsSetSamplingResult_() bool {
+	return p.SamplingResult_ != nil
+}
+
+func (p *Experiment) IsSetTrialConf() bool {
+	return p.TrialConf != nil
+}
+
+func (p *Experiment) IsSetUserSimulatorConf() bool {
+	return p.UserSimulatorConf != nil
+}
+
 func (p *Experiment) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3538,6 +3812,70 @@ func (p *Experiment) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 116:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField116(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 117:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField117(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 118:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField118(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 119:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField119(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 120:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField120(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 121:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField121(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 122:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField122(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 123:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField123(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4146,6 +4484,70 @@ func (p *Experiment) ReadField115(iprot thrift.TProtocol) error {
 	p.RunModeConfig = _field
 	return nil
 }
+func (p *Experiment) ReadField116(iprot thrift.TProtocol) error {
+	_field := NewExptQualityGateConf()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.QualityGateConf = _field
+	return nil
+}
+func (p *Experiment) ReadField117(iprot thrift.TProtocol) error {
+	_field := NewExptQualityGateResult_()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.QualityGateResult_ = _field
+	return nil
+}
+func (p *Experiment) ReadField118(iprot thrift.TProtocol) error {
+	_field := NewExptCostConf()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.CostConf = _field
+	return nil
+}
+func (p *Experiment) ReadField119(iprot thrift.TProtocol) error {
+	_field := NewExptResultCacheConf()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ResultCacheConf = _field
+	return nil
+}
+func (p *Experiment) ReadField120(iprot thrift.TProtocol) error {
+	_field := NewExptSamplingConf()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.SamplingConf = _field
+	return nil
+}
+func (p *Experiment) ReadField121(iprot thrift.TProtocol) error {
+	_field := NewExptSamplingResult_()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.SamplingResult_ = _field
+	return nil
+}
+func (p *Experiment) ReadField122(iprot thrift.TProtocol) error {
+	_field := NewExptTrialConf()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.TrialConf = _field
+	return nil
+}
+func (p *Experiment) ReadField123(iprot thrift.TProtocol) error {
+	_field := NewExptUserSimulatorConf()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.UserSimulatorConf = _field
+	return nil
+}
 
 func (p *Experiment) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -4341,6 +4743,38 @@ func (p *Experiment) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 115
 			goto WriteFieldError
 		}
+		if err = p.writeField116(oprot); err != nil {
+			fieldId = 116
+			goto WriteFieldError
+		}
+		if err = p.writeField117(oprot); err != nil {
+			fieldId = 117
+			goto WriteFieldError
+		}
+		if err = p.writeField118(oprot); err != nil {
+			fieldId = 118
+			goto WriteFieldError
+		}
+		if err = p.writeField119(oprot); err != nil {
+			fieldId = 119
+			goto WriteFieldError
+		}
+		if err = p.writeField120(oprot); err != nil {
+			fieldId = 120
+			goto WriteFieldError
+		}
+		if err = p.writeField121(oprot); err != nil {
+			fieldId = 121
+			goto WriteFieldError
+		}
+		if err = p.writeField122(oprot); err != nil {
+			fieldId = 122
+			goto WriteFieldError
+		}
+		if err = p.writeField123(oprot); err != nil {
+			fieldId = 123
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5264,6 +5698,150 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 115 end error: ", p), err)
 }
+func (p *Experiment) writeField116(oprot thrift.TProtocol) (err error) {
+	if p.IsSetQualityGateConf() {
+		if err = oprot.WriteFieldBegin("quality_gate_conf", thrift.STRUCT, 116); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.QualityGateConf.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 116 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 116 end error: ", p), err)
+}
+func (p *Experiment) writeField117(oprot thrift.TProtocol) (err error) {
+	if p.IsSetQualityGateResult_() {
+		if err = oprot.WriteFieldBegin("quality_gate_result", thrift.STRUCT, 117); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.QualityGateResult_.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 117 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 117 end error: ", p), err)
+}
+func (p *Experiment) writeField118(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCostConf() {
+		if err = oprot.WriteFieldBegin("cost_conf", thrift.STRUCT, 118); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.CostConf.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 118 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 118 end error: ", p), err)
+}
+func (p *Experiment) writeField119(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResultCacheConf() {
+		if err = oprot.WriteFieldBegin("result_cache_conf", thrift.STRUCT, 119); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ResultCacheConf.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 119 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 119 end error: ", p), err)
+}
+func (p *Experiment) writeField120(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSamplingConf() {
+		if err = oprot.WriteFieldBegin("sampling_conf", thrift.STRUCT, 120); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.SamplingConf.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 120 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 120 end error: ", p), err)
+}
+func (p *Experiment) writeField121(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSamplingResult_() {
+		if err = oprot.WriteFieldBegin("sampling_result", thrift.STRUCT, 121); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.SamplingResult_.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 121 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 121 end error: ", p), err)
+}
+func (p *Experiment) writeField122(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTrialConf() {
+		if err = oprot.WriteFieldBegin("trial_conf", thrift.STRUCT, 122); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.TrialConf.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 122 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 122 end error: ", p), err)
+}
+func (p *Experiment) writeField123(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserSimulatorConf() {
+		if err = oprot.WriteFieldBegin("user_simulator_conf", thrift.STRUCT, 123); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.UserSimulatorConf.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 123 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 123 end error: ", p), err)
+}
 
 func (p *Experiment) String() string {
 	if p == nil {
@@ -5420,6 +5998,30 @@ func (p *Experiment) DeepEqual(ano *Experiment) bool {
 	if !p.Field115DeepEqual(ano.RunModeConfig) {
 		return false
 	}
+	if !p.Field116DeepEqual(ano.QualityGateConf) {
+		return false
+	}
+	if !p.Field117DeepEqual(ano.QualityGateResult_) {
+		return false
+	}
+	if !p.Field118DeepEqual(ano.CostConf) {
+		return false
+	}
+	if !p.Field119DeepEqual(ano.ResultCacheConf) {
+		return false
+	}
+	if !p.Field120DeepEqual(ano.SamplingConf) {
+		return false
+	}
+	if !p.Field121DeepEqual(ano.SamplingResult_) {
+		return false
+	}
+	if !p.Field122DeepEqual(ano.TrialConf) {
+		return false
+	}
+	if !p.Field123DeepEqual(ano.UserSimulatorConf) {
+		return false
+	}
 	return true
 }
 
@@ -5939,6 +6541,62 @@ func (p *Experiment) Field115DeepEqual(src *RunModeConfig) bool {
 	}
 	return true
 }
+func (p *Experiment) Field116DeepEqual(src *ExptQualityGateConf) bool {
+
+	if !p.QualityGateConf.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *Experiment) Field117DeepEqual(src *ExptQualityGateResult_) bool {
+
+	if !p.QualityGateResult_.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *Experiment) Field118DeepEqual(src *ExptCostConf) bool {
+
+	if !p.CostConf.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *Experiment) Field119DeepEqual(src *ExptResultCacheConf) bool {
+
+	if !p.ResultCacheConf.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *Experiment) Field120DeepEqual(src *ExptSamplingConf) bool {
+
+	if !p.SamplingConf.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *Experiment) Field121DeepEqual(src *ExptSamplingResult_) bool {
+
+	if !p.SamplingResult_.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *Experiment) Field122DeepEqual(src *ExptTrialConf) bool {
+
+	if !p.TrialConf.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *Experiment) Field123DeepEqual(src *ExptUserSimulatorConf) bool {
+
+	if !p.UserSimulatorConf.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 
 // 实验模板基础信息
 type ExptTemplateMeta struct {
@@ -8051,7 +8709,9 @@ type ExptTemplate struct {
 	EnableExtractTrajectory *bool             `thrift:"enable_extract_trajectory,7,optional" frugal:"7,optional,bool" form:"enable_extract_trajectory" json:"enable_extract_trajectory,omitempty" query:"enable_extract_trajectory"`
 	// 通知配置
 	NotificationConf *ExptNotificationConf `thrift:"notification_conf,10,optional" frugal:"10,optional,ExptNotificationConf" form:"notification_conf" json:"notification_conf,omitempty" query:"notification_conf"`
-	BaseInfo         *common.BaseInfo      `thrift:"base_info,255,optional" frugal:"255,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+	// 质量门禁配置, 基于模板创建实验时带入实验
+	QualityGateConf *ExptQualityGateConf `thrift:"quality_gate_conf,11,optional" frugal:"11,optional,ExptQualityGateConf" form:"quality_gate_conf" json:"quality_gate_conf,omitempty" query:"quality_gate_conf"`
+	BaseInfo        *common.BaseInfo     `thrift:"base_info,255,optional" frugal:"255,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
 }
 
 func NewExptTemplate() *ExptTemplate {
@@ -8157,6 +8817,18 @@ func (p *ExptTemplate) GetNotificationConf() (v *ExptNotificationConf) {
 	return p.NotificationConf
 }
 
+var ExptTemplate_QualityGateConf_DEFAULT *ExptQualityGateConf
+
+func (p *ExptTemplate) GetQualityGateConf() (v *ExptQualityGateConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetQualityGateConf() {
+		return ExptTemplate_QualityGateConf_DEFAULT
+	}
+	return p.QualityGateConf
+}
+
 var ExptTemplate_BaseInfo_DEFAULT *common.BaseInfo
 
 func (p *ExptTemplate) GetBaseInfo() (v *common.BaseInfo) {
@@ -8192,6 +8864,9 @@ func (p *ExptTemplate) SetEnableExtractTrajectory(val *bool) {
 func (p *ExptTemplate) SetNotificationConf(val *ExptNotificationConf) {
 	p.NotificationConf = val
 }
+func (p *ExptTemplate) SetQualityGateConf(val *ExptQualityGateConf) {
+	p.QualityGateConf = val
+}
 func (p *ExptTemplate) SetBaseInfo(val *common.BaseInfo) {
 	p.BaseInfo = val
 }
@@ -8205,6 +8880,7 @@ var fieldIDToName_ExptTemplate = map[int16]string{
 	6:   "expt_source",
 	7:   "enable_extract_trajectory",
 	10:  "notification_conf",
+	11:  "quality_gate_conf",
 	255: "base_info",
 }
 
@@ -8240,6 +8916,10 @@ func (p *ExptTemplate) IsSetNotificationConf() bool {
 	return p.NotificationConf != nil
 }
 
+func (p *ExptTemplate) IsSetQualityGateConf() bool {
+	return p.QualityGateConf != nil
+}
+
 func (p *ExptTemplate) IsSetBaseInfo() bool {
 	return p.BaseInfo != nil
 }
@@ -8326,6 +9006,14 @@ func (p *ExptTemplate) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 11:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -8430,6 +9118,14 @@ func (p *ExptTemplate) ReadField10(iprot thrift.TProtocol) error {
 	p.NotificationConf = _field
 	return nil
 }
+func (p *ExptTemplate) ReadField11(iprot thrift.TProtocol) error {
+	_field := NewExptQualityGateConf()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.QualityGateConf = _field
+	return nil
+}
 func (p *ExptTemplate) ReadField255(iprot thrift.TProtocol) error {
 	_field := common.NewBaseInfo()
 	if err := _field.Read(iprot); err != nil {
@@ -8477,6 +9173,10 @@ func (p *ExptTemplate) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 10
 			goto WriteFieldError
 		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -8643,6 +9343,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
+func (p *ExptTemplate) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetQualityGateConf() {
+		if err = oprot.WriteFieldBegin("quality_gate_conf", thrift.STRUCT, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.QualityGateConf.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
 func (p *ExptTemplate) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBaseInfo() {
 		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 255); err != nil {
@@ -8700,6 +9418,9 @@ func (p *ExptTemplate) DeepEqual(ano *ExptTemplate) bool {
 	if !p.Field10DeepEqual(ano.NotificationConf) {
 		return false
 	}
+	if !p.Field11DeepEqual(ano.QualityGateConf) {
+		return false
+	}
 	if !p.Field255DeepEqual(ano.BaseInfo) {
 		return false
 	}
@@ -8767,6 +9488,13 @@ func (p *ExptTemplate) Field10DeepEqual(src *ExptNotificationConf) bool {
 	}
 	return true
 }
+func (p *ExptTemplate) Field11DeepEqual(src *ExptQualityGateConf) bool {
+
+	if !p.QualityGateConf.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *ExptTemplate) Field255DeepEqual(src *common.BaseInfo) bool {
 
 	if !p.BaseInfo.DeepEqual(src) {
@@ -10903,6 +11631,12 @@ type ExptStatistics struct {
 	FailTurnCnt               *int32                       `thrift:"fail_turn_cnt,6,optional" frugal:"6,optional,i32" form:"fail_turn_cnt" json:"fail_turn_cnt,omitempty" query:"fail_turn_cnt"`
 	TerminatedTurnCnt         *int32                       `thrift:"terminated_turn_cnt,7,optional" frugal:"7,optional,i32" form:"terminated_turn_cnt" json:"terminated_turn_cnt,omitempty" query:"terminated_turn_cnt"`
 	ProcessingTurnCnt         *int32                       `thrift:"processing_turn_cnt,8,optional" frugal:"8,optional,i32" form:"processing_turn_cnt" json:"processing_turn_cnt,omitempty" query:"processing_turn_cnt"`
+	// 按模型价目表核算的花费 (币种同价目表), item 结果落库时累加
+	CostStats *ExptCostStats `thrift:"cost_stats,9,optional" frugal:"9,optional,ExptCostStats" form:"cost_stats" json:"cost_stats,omitempty" query:"cost_stats"`
+	// 调度器当前生效的 item 并发, 开启自适应并发时随后端负载调整
+	EffectiveConcurNum *int32 `thrift:"effective_concur_num,10,optional" frugal:"10,optional,i32" form:"effective_concur_num" json:"effective_concur_num,omitempty" query:"effective_concur_num"`
+	// 最近一轮调度中因后端共享提交预算不足而延后提交的 item 数
+	BudgetDeferredItemCnt *int32 `thrift:"budget_deferred_item_cnt,11,optional" frugal:"11,optional,i32" form:"budget_deferred_item_cnt" json:"budget_deferred_item_cnt,omitempty" query:"budget_deferred_item_cnt"`
 }
 
 func NewExptStatistics() *ExptStatistics {
@@ -11007,6 +11741,42 @@ func (p *ExptStatistics) GetProcessingTurnCnt() (v int32) {
 	}
 	return *p.ProcessingTurnCnt
 }
+
+var ExptStatistics_CostStats_DEFAULT *ExptCostStats
+
+func (p *ExptStatistics) GetCostStats() (v *ExptCostStats) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCostStats() {
+		return ExptStatistics_CostStats_DEFAULT
+	}
+	return p.CostStats
+}
+
+var ExptStatistics_EffectiveConcurNum_DEFAULT int32
+
+func (p *ExptStatistics) GetEffectiveConcurNum() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEffectiveConcurNum() {
+		return ExptStatistics_EffectiveConcurNum_DEFAULT
+	}
+	return *p.EffectiveConcurNum
+}
+
+var ExptStatistics_BudgetDeferredItemCnt_DEFAULT int32
+
+func (p *ExptStatistics) GetBudgetDeferredItemCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBudgetDeferredItemCnt() {
+		return ExptStatistics_BudgetDeferredItemCnt_DEFAULT
+	}
+	return *p.BudgetDeferredItemCnt
+}
 func (p *ExptStatistics) SetEvaluatorAggregateResults(val []*EvaluatorAggregateResult_) {
 	p.EvaluatorAggregateResults = val
 }
@@ -11031,16 +11801,28 @@ func (p *ExptStatistics) SetTerminatedTurnCnt(val *int32) {
 func (p *ExptStatistics) SetProcessingTurnCnt(val *int32) {
 	p.ProcessingTurnCnt = val
 }
+func (p *ExptStatistics) SetCostStats(val *ExptCostStats) {
+	p.CostStats = val
+}
+func (p *ExptStatistics) SetEffectiveConcurNum(val *int32) {
+	p.EffectiveConcurNum = val
+}
+func (p *ExptStatistics) SetBudgetDeferredItemCnt(val *int32) {
+	p.BudgetDeferredItemCnt = val
+}
 
 var fieldIDToName_ExptStatistics = map[int16]string{
-	1: "evaluator_aggregate_results",
-	2: "token_usage",
-	3: "credit_cost",
-	4: "pending_turn_cnt",
-	5: "success_turn_cnt",
-	6: "fail_turn_cnt",
-	7: "terminated_turn_cnt",
-	8: "processing_turn_cnt",
+	1:  "evaluator_aggregate_results",
+	2:  "token_usage",
+	3:  "credit_cost",
+	4:  "pending_turn_cnt",
+	5:  "success_turn_cnt",
+	6:  "fail_turn_cnt",
+	7:  "terminated_turn_cnt",
+	8:  "processing_turn_cnt",
+	9:  "cost_stats",
+	10: "effective_concur_num",
+	11: "budget_deferred_item_cnt",
 }
 
 func (p *ExptStatistics) IsSetEvaluatorAggregateResults() bool {
@@ -11075,6 +11857,18 @@ func (p *ExptStatistics) IsSetProcessingTurnCnt() bool {
 	return p.ProcessingTurnCnt != nil
 }
 
+func (p *ExptStatistics) IsSetCostStats() bool {
+	return p.CostStats != nil
+}
+
+func (p *ExptStatistics) IsSetEffectiveConcurNum() bool {
+	return p.EffectiveConcurNum != nil
+}
+
+func (p *ExptStatistics) IsSetBudgetDeferredItemCnt() bool {
+	return p.BudgetDeferredItemCnt != nil
+}
+
 func (p *ExptStatistics) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11157,6 +11951,30 @@ func (p *ExptStatistics) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 9:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -11283,6 +12101,36 @@ func (p *ExptStatistics) ReadField8(iprot thrift.TProtocol) error {
 	p.ProcessingTurnCnt = _field
 	return nil
 }
+func (p *ExptStatistics) ReadField9(iprot thrift.TProtocol) error {
+	_field := NewExptCostStats()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.CostStats = _field
+	return nil
+}
+func (p *ExptStatistics) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EffectiveConcurNum = _field
+	return nil
+}
+func (p *ExptStatistics) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BudgetDeferredItemCnt = _field
+	return nil
+}
 
 func (p *ExptStatistics) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -11322,6 +12170,18 @@ func (p *ExptStatistics) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 8
 			goto WriteFieldError
 		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -11492,6 +12352,60 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
+func (p *ExptStatistics) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCostStats() {
+		if err = oprot.WriteFieldBegin("cost_stats", thrift.STRUCT, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.CostStats.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *ExptStatistics) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEffectiveConcurNum() {
+		if err = oprot.WriteFieldBegin("effective_concur_num", thrift.I32, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.EffectiveConcurNum); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *ExptStatistics) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBudgetDeferredItemCnt() {
+		if err = oprot.WriteFieldBegin("budget_deferred_item_cnt", thrift.I32, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.BudgetDeferredItemCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
 
 func (p *ExptStatistics) String() string {
 	if p == nil {
@@ -11531,6 +12445,15 @@ func (p *ExptStatistics) DeepEqual(ano *ExptStatistics) bool {
 	if !p.Field8DeepEqual(ano.ProcessingTurnCnt) {
 		return false
 	}
+	if !p.Field9DeepEqual(ano.CostStats) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.EffectiveConcurNum) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.BudgetDeferredItemCnt) {
+		return false
+	}
 	return true
 }
 
@@ -11626,6 +12549,37 @@ func (p *ExptStatistics) Field8DeepEqual(src *int32) bool {
 	}
 	return true
 }
+func (p *ExptStatistics) Field9DeepEqual(src *ExptCostStats) bool {
+
+	if !p.CostStats.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExptStatistics) Field10DeepEqual(src *int32) bool {
+
+	if p.EffectiveConcurNum == src {
+		return true
+	} else if p.EffectiveConcurNum == nil || src == nil {
+		return false
+	}
+	if *p.EffectiveConcurNum != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptStatistics) Field11DeepEqual(src *int32) bool {
+
+	if p.BudgetDeferredItemCnt == src {
+		return true
+	} else if p.BudgetDeferredItemCnt == nil || src == nil {
+		return false
+	}
+	if *p.BudgetDeferredItemCnt != *src {
+		return false
+	}
+	return true
+}
 
 type EvaluatorFmtResult_ struct {
 	Name  *string  `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
@@ -12529,6 +13483,8 @@ type FieldMapping struct {
 	FieldName     *string `thrift:"field_name,1,optional" frugal:"1,optional,string" form:"field_name" json:"field_name,omitempty" query:"field_name"`
 	ConstValue    *string `thrift:"const_value,2,optional" frugal:"2,optional,string" form:"const_value" json:"const_value,omitempty" query:"const_value"`
 	FromFieldName *string `thrift:"from_field_name,3,optional" frugal:"3,optional,string" form:"from_field_name" json:"from_field_name,omitempty" query:"from_field_name"`
+	// 字段映射表达式 (如 template('Q: {{ $.input }}')), 非空时优先于 from_field_name 与 const_value
+	Expr *string `thrift:"expr,4,optional" frugal:"4,optional,string" form:"expr" json:"expr,omitempty" query:"expr"`
 }
 
 func NewFieldMapping() *FieldMapping {
@@ -12573,6 +13529,18 @@ func (p *FieldMapping) GetFromFieldName() (v string) {
 	}
 	return *p.FromFieldName
 }
+
+var FieldMapping_Expr_DEFAULT string
+
+func (p *FieldMapping) GetExpr() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExpr() {
+		return FieldMapping_Expr_DEFAULT
+	}
+	return *p.Expr
+}
 func (p *FieldMapping) SetFieldName(val *string) {
 	p.FieldName = val
 }
@@ -12582,11 +13550,15 @@ func (p *FieldMapping) SetConstValue(val *string) {
 func (p *FieldMapping) SetFromFieldName(val *string) {
 	p.FromFieldName = val
 }
+func (p *FieldMapping) SetExpr(val *string) {
+	p.Expr = val
+}
 
 var fieldIDToName_FieldMapping = map[int16]string{
 	1: "field_name",
 	2: "const_value",
 	3: "from_field_name",
+	4: "expr",
 }
 
 func (p *FieldMapping) IsSetFieldName() bool {
@@ -12601,6 +13573,10 @@ func (p *FieldMapping) IsSetFromFieldName() bool {
 	return p.FromFieldName != nil
 }
 
+func (p *FieldMapping) IsSetExpr() bool {
+	return p.Expr != nil
+}
+
 func (p *FieldMapping) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12643,6 +13619,14 @@ func (p *FieldMapping) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -12705,6 +13689,17 @@ func (p *FieldMapping) ReadField3(iprot thrift.TProtocol) error {
 	p.FromFieldName = _field
 	return nil
 }
+func (p *FieldMapping) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Expr = _field
+	return nil
+}
 
 func (p *FieldMapping) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -12724,6 +13719,10 @@ func (p *FieldMapping) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -12796,6 +13795,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *FieldMapping) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExpr() {
+		if err = oprot.WriteFieldBegin("expr", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Expr); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
 
 func (p *FieldMapping) String() string {
 	if p == nil {
@@ -12820,6 +13837,9 @@ func (p *FieldMapping) DeepEqual(ano *FieldMapping) bool {
 	if !p.Field3DeepEqual(ano.FromFieldName) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.Expr) {
+		return false
+	}
 	return true
 }
 
@@ -12859,6 +13879,18 @@ func (p *FieldMapping) Field3DeepEqual(src *string) bool {
 	}
 	return true
 }
+func (p *FieldMapping) Field4DeepEqual(src *string) bool {
+
+	if p.Expr == src {
+		return true
+	} else if p.Expr == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Expr, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 // ===============================
 // 通知配置相关结构定义
@@ -24282,6 +25314,8 @@ type EvaluatorAggregateResult_ struct {
 	// alias 多实例别名 (default/judge_b 等); 同 version 多实例时区分, 老数据为空串。
 	// 注意: evaluator_results 为 map<i64> 时同 version 多 alias 会撞 key 只保留一个, 要拿全部 alias 走 list 出口。
 	Alias *string `thrift:"alias,5,optional" frugal:"5,optional,string" form:"alias" json:"alias,omitempty" query:"alias"`
+	// 重复试验实验的 pass@k / 一致率指标, 未开启重复试验时为空
+	Trial *EvaluatorTrialAggregate `thrift:"trial,6,optional" frugal:"6,optional,EvaluatorTrialAggregate" form:"trial" json:"trial,omitempty" query:"trial"`
 }
 
 func NewEvaluatorAggregateResult_() *EvaluatorAggregateResult_ {
@@ -24345,6 +25379,18 @@ func (p *EvaluatorAggregateResult_) GetAlias() (v string) {
 	}
 	return *p.Alias
 }
+
+var EvaluatorAggregateResult__Trial_DEFAULT *EvaluatorTrialAggregate
+
+func (p *EvaluatorAggregateResult_) GetTrial() (v *EvaluatorTrialAggregate) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTrial() {
+		return EvaluatorAggregateResult__Trial_DEFAULT
+	}
+	return p.Trial
+}
 func (p *EvaluatorAggregateResult_) SetEvaluatorVersionID(val int64) {
 	p.EvaluatorVersionID = val
 }
@@ -24360,6 +25406,9 @@ func (p *EvaluatorAggregateResult_) SetVersion(val *string) {
 func (p *EvaluatorAggregateResult_) SetAlias(val *string) {
 	p.Alias = val
 }
+func (p *EvaluatorAggregateResult_) SetTrial(val *EvaluatorTrialAggregate) {
+	p.Trial = val
+}
 
 var fieldIDToName_EvaluatorAggregateResult_ = map[int16]string{
 	1: "evaluator_version_id",
@@ -24367,6 +25416,7 @@ var fieldIDToName_EvaluatorAggregateResult_ = map[int16]string{
 	3: "name",
 	4: "version",
 	5: "alias",
+	6: "trial",
 }
 
 func (p *EvaluatorAggregateResult_) IsSetAggregatorResults() bool {
@@ -24385,6 +25435,10 @@ func (p *EvaluatorAggregateResult_) IsSetAlias() bool {
 	return p.Alias != nil
 }
 
+func (p *EvaluatorAggregateResult_) IsSetTrial() bool {
+	return p.Trial != nil
+}
+
 func (p *EvaluatorAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -24445,6 +25499,14 @@ func (p *EvaluatorAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -24547,6 +25609,14 @@ func (p *EvaluatorAggregateResult_) ReadField5(iprot thrift.TProtocol) error {
 	p.Alias = _field
 	return nil
 }
+func (p *EvaluatorAggregateResult_) ReadField6(iprot thrift.TProtocol) error {
+	_field := NewEvaluatorTrialAggregate()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Trial = _field
+	return nil
+}
 
 func (p *EvaluatorAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -24574,6 +25644,10 @@ func (p *EvaluatorAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -24688,6 +25762,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
+func (p *EvaluatorAggregateResult_) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTrial() {
+		if err = oprot.WriteFieldBegin("trial", thrift.STRUCT, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Trial.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
 
 func (p *EvaluatorAggregateResult_) String() string {
 	if p == nil {
@@ -24718,6 +25810,9 @@ func (p *EvaluatorAggregateResult_) DeepEqual(ano *EvaluatorAggregateResult_) bo
 	if !p.Field5DeepEqual(ano.Alias) {
 		return false
 	}
+	if !p.Field6DeepEqual(ano.Trial) {
+		return false
+	}
 	return true
 }
 
@@ -24777,12 +25872,21 @@ func (p *EvaluatorAggregateResult_) Field5DeepEqual(src *string) bool {
 	}
 	return true
 }
+func (p *EvaluatorAggregateResult_) Field6DeepEqual(src *EvaluatorTrialAggregate) bool {
+
+	if !p.Trial.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 
 // 人工标注项粒度聚合结果
 type AnnotationAggregateResult_ struct {
 	TagKeyID          int64                `thrift:"tag_key_id,1,required" frugal:"1,required,i64" json:"tag_key_id" form:"tag_key_id,required" query:"tag_key_id,required"`
 	AggregatorResults []*AggregatorResult_ `thrift:"aggregator_results,2,optional" frugal:"2,optional,list<AggregatorResult_>" form:"aggregator_results" json:"aggregator_results,omitempty" query:"aggregator_results"`
 	Name              *string              `thrift:"name,3,optional" frugal:"3,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	// 多标注者一致性及评估器-人工一致性, 无多人标注且无评估器可对比时为空
+	Agreement *AnnotationAgreement `thrift:"agreement,4,optional" frugal:"4,optional,AnnotationAgreement" form:"agreement" json:"agreement,omitempty" query:"agreement"`
 }
 
 func NewAnnotationAggregateResult_() *AnnotationAggregateResult_ {
@@ -24822,6 +25926,18 @@ func (p *AnnotationAggregateResult_) GetName() (v string) {
 	}
 	return *p.Name
 }
+
+var AnnotationAggregateResult__Agreement_DEFAULT *AnnotationAgreement
+
+func (p *AnnotationAggregateResult_) GetAgreement() (v *AnnotationAgreement) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAgreement() {
+		return AnnotationAggregateResult__Agreement_DEFAULT
+	}
+	return p.Agreement
+}
 func (p *AnnotationAggregateResult_) SetTagKeyID(val int64) {
 	p.TagKeyID = val
 }
@@ -24831,11 +25947,15 @@ func (p *AnnotationAggregateResult_) SetAggregatorResults(val []*AggregatorResul
 func (p *AnnotationAggregateResult_) SetName(val *string) {
 	p.Name = val
 }
+func (p *AnnotationAggregateResult_) SetAgreement(val *AnnotationAgreement) {
+	p.Agreement = val
+}
 
 var fieldIDToName_AnnotationAggregateResult_ = map[int16]string{
 	1: "tag_key_id",
 	2: "aggregator_results",
 	3: "name",
+	4: "agreement",
 }
 
 func (p *AnnotationAggregateResult_) IsSetAggregatorResults() bool {
@@ -24846,6 +25966,10 @@ func (p *AnnotationAggregateResult_) IsSetName() bool {
 	return p.Name != nil
 }
 
+func (p *AnnotationAggregateResult_) IsSetAgreement() bool {
+	return p.Agreement != nil
+}
+
 func (p *AnnotationAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -24890,6 +26014,14 @@ func (p *AnnotationAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -24970,6 +26102,14 @@ func (p *AnnotationAggregateResult_) ReadField3(iprot thrift.TProtocol) error {
 	p.Name = _field
 	return nil
 }
+func (p *AnnotationAggregateResult_) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewAnnotationAgreement()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Agreement = _field
+	return nil
+}
 
 func (p *AnnotationAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -24989,6 +26129,10 @@ func (p *AnnotationAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -25067,6 +26211,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *AnnotationAggregateResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAgreement() {
+		if err = oprot.WriteFieldBegin("agreement", thrift.STRUCT, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Agreement.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
 
 func (p *AnnotationAggregateResult_) String() string {
 	if p == nil {
@@ -25091,6 +26253,9 @@ func (p *AnnotationAggregateResult_) DeepEqual(ano *AnnotationAggregateResult_)
 	if !p.Field3DeepEqual(ano.Name) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.Agreement) {
+		return false
+	}
 	return true
 }
 
@@ -25126,58 +26291,161 @@ func (p *AnnotationAggregateResult_) Field3DeepEqual(src *string) bool {
 	}
 	return true
 }
+func (p *AnnotationAggregateResult_) Field4DeepEqual(src *AnnotationAgreement) bool {
 
-// 一种聚合器类型的聚合结果
-type AggregatorResult_ struct {
-	AggregatorType AggregatorType `thrift:"aggregator_type,1,required" frugal:"1,required,AggregatorType" form:"aggregator_type,required" json:"aggregator_type,required" query:"aggregator_type,required"`
-	Data           *AggregateData `thrift:"data,2,optional" frugal:"2,optional,AggregateData" form:"data" json:"data,omitempty" query:"data"`
+	if !p.Agreement.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func NewAggregatorResult_() *AggregatorResult_ {
-	return &AggregatorResult_{}
+// 人工标注标签的一致性度量, 仅统计被 2 名及以上标注者标注过的 turn
+// 分类/布尔标签按名义尺度计算, 连续数值标签按区间尺度计算 (kappa 仅适用于分类/布尔)
+type AnnotationAgreement struct {
+	AnnotatorCnt *int32 `thrift:"annotator_cnt,1,optional" frugal:"1,optional,i32" form:"annotator_cnt" json:"annotator_cnt,omitempty" query:"annotator_cnt"`
+	// 被 2 名及以上标注者标注过的 turn 数
+	UnitCnt *int32 `thrift:"unit_cnt,2,optional" frugal:"2,optional,i32" form:"unit_cnt" json:"unit_cnt,omitempty" query:"unit_cnt"`
+	// 两两标注者 Cohen's kappa 的均值
+	CohenKappa        *float64 `thrift:"cohen_kappa,3,optional" frugal:"3,optional,double" form:"cohen_kappa" json:"cohen_kappa,omitempty" query:"cohen_kappa"`
+	FleissKappa       *float64 `thrift:"fleiss_kappa,4,optional" frugal:"4,optional,double" form:"fleiss_kappa" json:"fleiss_kappa,omitempty" query:"fleiss_kappa"`
+	KrippendorffAlpha *float64 `thrift:"krippendorff_alpha,5,optional" frugal:"5,optional,double" form:"krippendorff_alpha" json:"krippendorff_alpha,omitempty" query:"krippendorff_alpha"`
+	// 各评估器实例与人工标注的一致性, 仅数值与布尔标签计算
+	JudgeAgreements []*JudgeHumanAgreement `thrift:"judge_agreements,6,optional" frugal:"6,optional,list<JudgeHumanAgreement>" form:"judge_agreements" json:"judge_agreements,omitempty" query:"judge_agreements"`
 }
 
-func (p *AggregatorResult_) InitDefault() {
+func NewAnnotationAgreement() *AnnotationAgreement {
+	return &AnnotationAgreement{}
 }
 
-func (p *AggregatorResult_) GetAggregatorType() (v AggregatorType) {
-	if p != nil {
-		return p.AggregatorType
+func (p *AnnotationAgreement) InitDefault() {
+}
+
+var AnnotationAgreement_AnnotatorCnt_DEFAULT int32
+
+func (p *AnnotationAgreement) GetAnnotatorCnt() (v int32) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetAnnotatorCnt() {
+		return AnnotationAgreement_AnnotatorCnt_DEFAULT
+	}
+	return *p.AnnotatorCnt
 }
 
-var AggregatorResult__Data_DEFAULT *AggregateData
+var AnnotationAgreement_UnitCnt_DEFAULT int32
 
-func (p *AggregatorResult_) GetData() (v *AggregateData) {
+func (p *AnnotationAgreement) GetUnitCnt() (v int32) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetData() {
-		return AggregatorResult__Data_DEFAULT
+	if !p.IsSetUnitCnt() {
+		return AnnotationAgreement_UnitCnt_DEFAULT
 	}
-	return p.Data
+	return *p.UnitCnt
 }
-func (p *AggregatorResult_) SetAggregatorType(val AggregatorType) {
-	p.AggregatorType = val
+
+var AnnotationAgreement_CohenKappa_DEFAULT float64
+
+func (p *AnnotationAgreement) GetCohenKappa() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCohenKappa() {
+		return AnnotationAgreement_CohenKappa_DEFAULT
+	}
+	return *p.CohenKappa
 }
-func (p *AggregatorResult_) SetData(val *AggregateData) {
-	p.Data = val
+
+var AnnotationAgreement_FleissKappa_DEFAULT float64
+
+func (p *AnnotationAgreement) GetFleissKappa() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFleissKappa() {
+		return AnnotationAgreement_FleissKappa_DEFAULT
+	}
+	return *p.FleissKappa
 }
 
-var fieldIDToName_AggregatorResult_ = map[int16]string{
-	1: "aggregator_type",
-	2: "data",
+var AnnotationAgreement_KrippendorffAlpha_DEFAULT float64
+
+func (p *AnnotationAgreement) GetKrippendorffAlpha() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetKrippendorffAlpha() {
+		return AnnotationAgreement_KrippendorffAlpha_DEFAULT
+	}
+	return *p.KrippendorffAlpha
 }
 
-func (p *AggregatorResult_) IsSetData() bool {
-	return p.Data != nil
+var AnnotationAgreement_JudgeAgreements_DEFAULT []*JudgeHumanAgreement
+
+func (p *AnnotationAgreement) GetJudgeAgreements() (v []*JudgeHumanAgreement) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetJudgeAgreements() {
+		return AnnotationAgreement_JudgeAgreements_DEFAULT
+	}
+	return p.JudgeAgreements
+}
+func (p *AnnotationAgreement) SetAnnotatorCnt(val *int32) {
+	p.AnnotatorCnt = val
+}
+func (p *AnnotationAgreement) SetUnitCnt(val *int32) {
+	p.UnitCnt = val
+}
+func (p *AnnotationAgreement) SetCohenKappa(val *float64) {
+	p.CohenKappa = val
+}
+func (p *AnnotationAgreement) SetFleissKappa(val *float64) {
+	p.FleissKappa = val
+}
+func (p *AnnotationAgreement) SetKrippendorffAlpha(val *float64) {
+	p.KrippendorffAlpha = val
+}
+func (p *AnnotationAgreement) SetJudgeAgreements(val []*JudgeHumanAgreement) {
+	p.JudgeAgreements = val
 }
 
-func (p *AggregatorResult_) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_AnnotationAgreement = map[int16]string{
+	1: "annotator_cnt",
+	2: "unit_cnt",
+	3: "cohen_kappa",
+	4: "fleiss_kappa",
+	5: "krippendorff_alpha",
+	6: "judge_agreements",
+}
+
+func (p *AnnotationAgreement) IsSetAnnotatorCnt() bool {
+	return p.AnnotatorCnt != nil
+}
+
+func (p *AnnotationAgreement) IsSetUnitCnt() bool {
+	return p.UnitCnt != nil
+}
+
+func (p *AnnotationAgreement) IsSetCohenKappa() bool {
+	return p.CohenKappa != nil
+}
+
+func (p *AnnotationAgreement) IsSetFleissKappa() bool {
+	return p.FleissKappa != nil
+}
+
+func (p *AnnotationAgreement) IsSetKrippendorffAlpha() bool {
+	return p.KrippendorffAlpha != nil
+}
+
+func (p *AnnotationAgreement) IsSetJudgeAgreements() bool {
+	return p.JudgeAgreements != nil
+}
+
+func (p *AnnotationAgreement) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetAggregatorType bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -25198,18 +26466,49 @@ func (p *AggregatorResult_) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetAggregatorType = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -25223,17 +26522,13 @@ func (p *AggregatorResult_) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetAggregatorType {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AggregatorResult_[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AnnotationAgreement[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -25241,33 +26536,90 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AggregatorResult_[fieldId]))
 }
 
-func (p *AggregatorResult_) ReadField1(iprot thrift.TProtocol) error {
+func (p *AnnotationAgreement) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field AggregatorType
+	var _field *int32
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		_field = AggregatorType(v)
+		_field = &v
 	}
-	p.AggregatorType = _field
+	p.AnnotatorCnt = _field
 	return nil
 }
-func (p *AggregatorResult_) ReadField2(iprot thrift.TProtocol) error {
-	_field := NewAggregateData()
-	if err := _field.Read(iprot); err != nil {
+func (p *AnnotationAgreement) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Data = _field
+	p.UnitCnt = _field
 	return nil
 }
+func (p *AnnotationAgreement) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *AggregatorResult_) Write(oprot thrift.TProtocol) (err error) {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CohenKappa = _field
+	return nil
+}
+func (p *AnnotationAgreement) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FleissKappa = _field
+	return nil
+}
+func (p *AnnotationAgreement) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.KrippendorffAlpha = _field
+	return nil
+}
+func (p *AnnotationAgreement) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*JudgeHumanAgreement, 0, size)
+	values := make([]JudgeHumanAgreement, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.JudgeAgreements = _field
+	return nil
+}
+
+func (p *AnnotationAgreement) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AggregatorResult"); err != nil {
+	if err = oprot.WriteStructBegin("AnnotationAgreement"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -25279,6 +26631,22 @@ func (p *AggregatorResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -25297,15 +26665,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AggregatorResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("aggregator_type", thrift.I32, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(int32(p.AggregatorType)); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *AnnotationAgreement) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAnnotatorCnt() {
+		if err = oprot.WriteFieldBegin("annotator_cnt", thrift.I32, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.AnnotatorCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -25313,12 +26683,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *AggregatorResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetData() {
-		if err = oprot.WriteFieldBegin("data", thrift.STRUCT, 2); err != nil {
+func (p *AnnotationAgreement) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUnitCnt() {
+		if err = oprot.WriteFieldBegin("unit_cnt", thrift.I32, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Data.Write(oprot); err != nil {
+		if err := oprot.WriteI32(*p.UnitCnt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -25331,302 +26701,355 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *AnnotationAgreement) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCohenKappa() {
+		if err = oprot.WriteFieldBegin("cohen_kappa", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.CohenKappa); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *AnnotationAgreement) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFleissKappa() {
+		if err = oprot.WriteFieldBegin("fleiss_kappa", thrift.DOUBLE, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.FleissKappa); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *AnnotationAgreement) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKrippendorffAlpha() {
+		if err = oprot.WriteFieldBegin("krippendorff_alpha", thrift.DOUBLE, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.KrippendorffAlpha); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *AnnotationAgreement) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetJudgeAgreements() {
+		if err = oprot.WriteFieldBegin("judge_agreements", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.JudgeAgreements)); err != nil {
+			return err
+		}
+		for _, v := range p.JudgeAgreements {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
 
-func (p *AggregatorResult_) String() string {
+func (p *AnnotationAgreement) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AggregatorResult_(%+v)", *p)
+	return fmt.Sprintf("AnnotationAgreement(%+v)", *p)
 
 }
 
-func (p *AggregatorResult_) DeepEqual(ano *AggregatorResult_) bool {
+func (p *AnnotationAgreement) DeepEqual(ano *AnnotationAgreement) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.AggregatorType) {
+	if !p.Field1DeepEqual(ano.AnnotatorCnt) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Data) {
+	if !p.Field2DeepEqual(ano.UnitCnt) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.CohenKappa) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.FleissKappa) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.KrippendorffAlpha) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.JudgeAgreements) {
 		return false
 	}
 	return true
 }
 
-func (p *AggregatorResult_) Field1DeepEqual(src AggregatorType) bool {
+func (p *AnnotationAgreement) Field1DeepEqual(src *int32) bool {
 
-	if p.AggregatorType != src {
+	if p.AnnotatorCnt == src {
+		return true
+	} else if p.AnnotatorCnt == nil || src == nil {
+		return false
+	}
+	if *p.AnnotatorCnt != *src {
 		return false
 	}
 	return true
 }
-func (p *AggregatorResult_) Field2DeepEqual(src *AggregateData) bool {
+func (p *AnnotationAgreement) Field2DeepEqual(src *int32) bool {
 
-	if !p.Data.DeepEqual(src) {
+	if p.UnitCnt == src {
+		return true
+	} else if p.UnitCnt == nil || src == nil {
+		return false
+	}
+	if *p.UnitCnt != *src {
 		return false
 	}
 	return true
 }
+func (p *AnnotationAgreement) Field3DeepEqual(src *float64) bool {
 
-type ScoreDistribution struct {
-	ScoreDistributionItems []*ScoreDistributionItem `thrift:"score_distribution_items,1,optional" frugal:"1,optional,list<ScoreDistributionItem>" form:"score_distribution_items" json:"score_distribution_items,omitempty" query:"score_distribution_items"`
+	if p.CohenKappa == src {
+		return true
+	} else if p.CohenKappa == nil || src == nil {
+		return false
+	}
+	if *p.CohenKappa != *src {
+		return false
+	}
+	return true
 }
+func (p *AnnotationAgreement) Field4DeepEqual(src *float64) bool {
 
-func NewScoreDistribution() *ScoreDistribution {
-	return &ScoreDistribution{}
+	if p.FleissKappa == src {
+		return true
+	} else if p.FleissKappa == nil || src == nil {
+		return false
+	}
+	if *p.FleissKappa != *src {
+		return false
+	}
+	return true
 }
+func (p *AnnotationAgreement) Field5DeepEqual(src *float64) bool {
 
-func (p *ScoreDistribution) InitDefault() {
+	if p.KrippendorffAlpha == src {
+		return true
+	} else if p.KrippendorffAlpha == nil || src == nil {
+		return false
+	}
+	if *p.KrippendorffAlpha != *src {
+		return false
+	}
+	return true
 }
+func (p *AnnotationAgreement) Field6DeepEqual(src []*JudgeHumanAgreement) bool {
 
-var ScoreDistribution_ScoreDistributionItems_DEFAULT []*ScoreDistributionItem
-
-func (p *ScoreDistribution) GetScoreDistributionItems() (v []*ScoreDistributionItem) {
-	if p == nil {
-		return
+	if len(p.JudgeAgreements) != len(src) {
+		return false
 	}
-	if !p.IsSetScoreDistributionItems() {
-		return ScoreDistribution_ScoreDistributionItems_DEFAULT
+	for i, v := range p.JudgeAgreements {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
-	return p.ScoreDistributionItems
+	return true
 }
-func (p *ScoreDistribution) SetScoreDistributionItems(val []*ScoreDistributionItem) {
-	p.ScoreDistributionItems = val
+
+// 单个评估器实例得分与人工标注 (多人时取均值/众数) 的一致性
+type JudgeHumanAgreement struct {
+	EvaluatorVersionID int64   `thrift:"evaluator_version_id,1,required" frugal:"1,required,i64" json:"evaluator_version_id" form:"evaluator_version_id,required" query:"evaluator_version_id,required"`
+	Alias              *string `thrift:"alias,2,optional" frugal:"2,optional,string" form:"alias" json:"alias,omitempty" query:"alias"`
+	// 同时具备评估器得分与人工标注的 turn 数
+	PairedCnt          *int32   `thrift:"paired_cnt,3,optional" frugal:"3,optional,i32" form:"paired_cnt" json:"paired_cnt,omitempty" query:"paired_cnt"`
+	PearsonCorrelation *float64 `thrift:"pearson_correlation,4,optional" frugal:"4,optional,double" form:"pearson_correlation" json:"pearson_correlation,omitempty" query:"pearson_correlation"`
+	MeanAbsoluteError  *float64 `thrift:"mean_absolute_error,5,optional" frugal:"5,optional,double" form:"mean_absolute_error" json:"mean_absolute_error,omitempty" query:"mean_absolute_error"`
+	// 将评估器视为一名额外标注者的区间尺度 alpha
+	KrippendorffAlpha *float64 `thrift:"krippendorff_alpha,6,optional" frugal:"6,optional,double" form:"krippendorff_alpha" json:"krippendorff_alpha,omitempty" query:"krippendorff_alpha"`
+	// 仅布尔标签: 评估器得分按 0.5 二值化后的 kappa
+	CohenKappa *float64 `thrift:"cohen_kappa,7,optional" frugal:"7,optional,double" form:"cohen_kappa" json:"cohen_kappa,omitempty" query:"cohen_kappa"`
 }
 
-var fieldIDToName_ScoreDistribution = map[int16]string{
-	1: "score_distribution_items",
+func NewJudgeHumanAgreement() *JudgeHumanAgreement {
+	return &JudgeHumanAgreement{}
 }
 
-func (p *ScoreDistribution) IsSetScoreDistributionItems() bool {
-	return p.ScoreDistributionItems != nil
+func (p *JudgeHumanAgreement) InitDefault() {
 }
 
-func (p *ScoreDistribution) Read(iprot thrift.TProtocol) (err error) {
-	var fieldTypeId thrift.TType
-	var fieldId int16
+func (p *JudgeHumanAgreement) GetEvaluatorVersionID() (v int64) {
+	if p != nil {
+		return p.EvaluatorVersionID
+	}
+	return
+}
 
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+var JudgeHumanAgreement_Alias_DEFAULT string
+
+func (p *JudgeHumanAgreement) GetAlias() (v string) {
+	if p == nil {
+		return
 	}
+	if !p.IsSetAlias() {
+		return JudgeHumanAgreement_Alias_DEFAULT
+	}
+	return *p.Alias
+}
 
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
+var JudgeHumanAgreement_PairedCnt_DEFAULT int32
 
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
+func (p *JudgeHumanAgreement) GetPairedCnt() (v int32) {
+	if p == nil {
+		return
 	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	if !p.IsSetPairedCnt() {
+		return JudgeHumanAgreement_PairedCnt_DEFAULT
 	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ScoreDistribution[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	return *p.PairedCnt
 }
 
-func (p *ScoreDistribution) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*ScoreDistributionItem, 0, size)
-	values := make([]ScoreDistributionItem, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+var JudgeHumanAgreement_PearsonCorrelation_DEFAULT float64
 
-		_field = append(_field, _elem)
+func (p *JudgeHumanAgreement) GetPearsonCorrelation() (v float64) {
+	if p == nil {
+		return
 	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
+	if !p.IsSetPearsonCorrelation() {
+		return JudgeHumanAgreement_PearsonCorrelation_DEFAULT
 	}
-	p.ScoreDistributionItems = _field
-	return nil
+	return *p.PearsonCorrelation
 }
 
-func (p *ScoreDistribution) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("ScoreDistribution"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
+var JudgeHumanAgreement_MeanAbsoluteError_DEFAULT float64
+
+func (p *JudgeHumanAgreement) GetMeanAbsoluteError() (v float64) {
+	if p == nil {
+		return
 	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	if !p.IsSetMeanAbsoluteError() {
+		return JudgeHumanAgreement_MeanAbsoluteError_DEFAULT
 	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+	return *p.MeanAbsoluteError
 }
 
-func (p *ScoreDistribution) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetScoreDistributionItems() {
-		if err = oprot.WriteFieldBegin("score_distribution_items", thrift.LIST, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ScoreDistributionItems)); err != nil {
-			return err
-		}
-		for _, v := range p.ScoreDistributionItems {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
+var JudgeHumanAgreement_KrippendorffAlpha_DEFAULT float64
 
-func (p *ScoreDistribution) String() string {
+func (p *JudgeHumanAgreement) GetKrippendorffAlpha() (v float64) {
 	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("ScoreDistribution(%+v)", *p)
-
-}
-
-func (p *ScoreDistribution) DeepEqual(ano *ScoreDistribution) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
+		return
 	}
-	if !p.Field1DeepEqual(ano.ScoreDistributionItems) {
-		return false
+	if !p.IsSetKrippendorffAlpha() {
+		return JudgeHumanAgreement_KrippendorffAlpha_DEFAULT
 	}
-	return true
+	return *p.KrippendorffAlpha
 }
 
-func (p *ScoreDistribution) Field1DeepEqual(src []*ScoreDistributionItem) bool {
+var JudgeHumanAgreement_CohenKappa_DEFAULT float64
 
-	if len(p.ScoreDistributionItems) != len(src) {
-		return false
+func (p *JudgeHumanAgreement) GetCohenKappa() (v float64) {
+	if p == nil {
+		return
 	}
-	for i, v := range p.ScoreDistributionItems {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if !p.IsSetCohenKappa() {
+		return JudgeHumanAgreement_CohenKappa_DEFAULT
 	}
-	return true
+	return *p.CohenKappa
 }
-
-type ScoreDistributionItem struct {
-	Score      string  `thrift:"score,1,required" frugal:"1,required,string" form:"score,required" json:"score,required" query:"score,required"`
-	Count      int64   `thrift:"count,2,required" frugal:"2,required,i64" json:"count" form:"count,required" query:"count,required"`
-	Percentage float64 `thrift:"percentage,3,required" frugal:"3,required,double" form:"percentage,required" json:"percentage,required" query:"percentage,required"`
+func (p *JudgeHumanAgreement) SetEvaluatorVersionID(val int64) {
+	p.EvaluatorVersionID = val
 }
-
-func NewScoreDistributionItem() *ScoreDistributionItem {
-	return &ScoreDistributionItem{}
+func (p *JudgeHumanAgreement) SetAlias(val *string) {
+	p.Alias = val
 }
-
-func (p *ScoreDistributionItem) InitDefault() {
+func (p *JudgeHumanAgreement) SetPairedCnt(val *int32) {
+	p.PairedCnt = val
+}
+func (p *JudgeHumanAgreement) SetPearsonCorrelation(val *float64) {
+	p.PearsonCorrelation = val
+}
+func (p *JudgeHumanAgreement) SetMeanAbsoluteError(val *float64) {
+	p.MeanAbsoluteError = val
+}
+func (p *JudgeHumanAgreement) SetKrippendorffAlpha(val *float64) {
+	p.KrippendorffAlpha = val
+}
+func (p *JudgeHumanAgreement) SetCohenKappa(val *float64) {
+	p.CohenKappa = val
 }
 
-func (p *ScoreDistributionItem) GetScore() (v string) {
-	if p != nil {
-		return p.Score
-	}
-	return
+var fieldIDToName_JudgeHumanAgreement = map[int16]string{
+	1: "evaluator_version_id",
+	2: "alias",
+	3: "paired_cnt",
+	4: "pearson_correlation",
+	5: "mean_absolute_error",
+	6: "krippendorff_alpha",
+	7: "cohen_kappa",
 }
 
-func (p *ScoreDistributionItem) GetCount() (v int64) {
-	if p != nil {
-		return p.Count
-	}
-	return
+func (p *JudgeHumanAgreement) IsSetAlias() bool {
+	return p.Alias != nil
 }
 
-func (p *ScoreDistributionItem) GetPercentage() (v float64) {
-	if p != nil {
-		return p.Percentage
-	}
-	return
+func (p *JudgeHumanAgreement) IsSetPairedCnt() bool {
+	return p.PairedCnt != nil
 }
-func (p *ScoreDistributionItem) SetScore(val string) {
-	p.Score = val
+
+func (p *JudgeHumanAgreement) IsSetPearsonCorrelation() bool {
+	return p.PearsonCorrelation != nil
 }
-func (p *ScoreDistributionItem) SetCount(val int64) {
-	p.Count = val
+
+func (p *JudgeHumanAgreement) IsSetMeanAbsoluteError() bool {
+	return p.MeanAbsoluteError != nil
 }
-func (p *ScoreDistributionItem) SetPercentage(val float64) {
-	p.Percentage = val
+
+func (p *JudgeHumanAgreement) IsSetKrippendorffAlpha() bool {
+	return p.KrippendorffAlpha != nil
 }
 
-var fieldIDToName_ScoreDistributionItem = map[int16]string{
-	1: "score",
-	2: "count",
-	3: "percentage",
+func (p *JudgeHumanAgreement) IsSetCohenKappa() bool {
+	return p.CohenKappa != nil
 }
 
-func (p *ScoreDistributionItem) Read(iprot thrift.TProtocol) (err error) {
+func (p *JudgeHumanAgreement) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetScore bool = false
-	var issetCount bool = false
-	var issetPercentage bool = false
+	var issetEvaluatorVersionID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -25643,29 +27066,59 @@ func (p *ScoreDistributionItem) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetScore = true
+				issetEvaluatorVersionID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCount = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetPercentage = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -25682,27 +27135,17 @@ func (p *ScoreDistributionItem) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetScore {
+	if !issetEvaluatorVersionID {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetCount {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetPercentage {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ScoreDistributionItem[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JudgeHumanAgreement[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -25711,46 +27154,90 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ScoreDistributionItem[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_JudgeHumanAgreement[fieldId]))
 }
 
-func (p *ScoreDistributionItem) ReadField1(iprot thrift.TProtocol) error {
+func (p *JudgeHumanAgreement) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Score = _field
+	p.EvaluatorVersionID = _field
 	return nil
 }
-func (p *ScoreDistributionItem) ReadField2(iprot thrift.TProtocol) error {
+func (p *JudgeHumanAgreement) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.Count = _field
+	p.Alias = _field
 	return nil
 }
-func (p *ScoreDistributionItem) ReadField3(iprot thrift.TProtocol) error {
+func (p *JudgeHumanAgreement) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field float64
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PairedCnt = _field
+	return nil
+}
+func (p *JudgeHumanAgreement) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *float64
 	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.Percentage = _field
+	p.PearsonCorrelation = _field
 	return nil
 }
+func (p *JudgeHumanAgreement) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *ScoreDistributionItem) Write(oprot thrift.TProtocol) (err error) {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MeanAbsoluteError = _field
+	return nil
+}
+func (p *JudgeHumanAgreement) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.KrippendorffAlpha = _field
+	return nil
+}
+func (p *JudgeHumanAgreement) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CohenKappa = _field
+	return nil
+}
+
+func (p *JudgeHumanAgreement) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ScoreDistributionItem"); err != nil {
+	if err = oprot.WriteStructBegin("JudgeHumanAgreement"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -25766,6 +27253,22 @@ func (p *ScoreDistributionItem) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -25784,11 +27287,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ScoreDistributionItem) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("score", thrift.STRING, 1); err != nil {
+func (p *JudgeHumanAgreement) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Score); err != nil {
+	if err := oprot.WriteI64(p.EvaluatorVersionID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -25800,15 +27303,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ScoreDistributionItem) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("count", thrift.I64, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.Count); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *JudgeHumanAgreement) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAlias() {
+		if err = oprot.WriteFieldBegin("alias", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Alias); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -25816,15 +27321,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ScoreDistributionItem) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("percentage", thrift.DOUBLE, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteDouble(p.Percentage); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *JudgeHumanAgreement) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPairedCnt() {
+		if err = oprot.WriteFieldBegin("paired_cnt", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PairedCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -25832,147 +27339,248 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *JudgeHumanAgreement) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPearsonCorrelation() {
+		if err = oprot.WriteFieldBegin("pearson_correlation", thrift.DOUBLE, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.PearsonCorrelation); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *JudgeHumanAgreement) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMeanAbsoluteError() {
+		if err = oprot.WriteFieldBegin("mean_absolute_error", thrift.DOUBLE, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MeanAbsoluteError); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *JudgeHumanAgreement) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKrippendorffAlpha() {
+		if err = oprot.WriteFieldBegin("krippendorff_alpha", thrift.DOUBLE, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.KrippendorffAlpha); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *JudgeHumanAgreement) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCohenKappa() {
+		if err = oprot.WriteFieldBegin("cohen_kappa", thrift.DOUBLE, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.CohenKappa); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
 
-func (p *ScoreDistributionItem) String() string {
+func (p *JudgeHumanAgreement) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ScoreDistributionItem(%+v)", *p)
+	return fmt.Sprintf("JudgeHumanAgreement(%+v)", *p)
 
 }
 
-func (p *ScoreDistributionItem) DeepEqual(ano *ScoreDistributionItem) bool {
+func (p *JudgeHumanAgreement) DeepEqual(ano *JudgeHumanAgreement) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Score) {
+	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Count) {
+	if !p.Field2DeepEqual(ano.Alias) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Percentage) {
+	if !p.Field3DeepEqual(ano.PairedCnt) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.PearsonCorrelation) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.MeanAbsoluteError) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.KrippendorffAlpha) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.CohenKappa) {
 		return false
 	}
 	return true
 }
 
-func (p *ScoreDistributionItem) Field1DeepEqual(src string) bool {
+func (p *JudgeHumanAgreement) Field1DeepEqual(src int64) bool {
 
-	if strings.Compare(p.Score, src) != 0 {
+	if p.EvaluatorVersionID != src {
 		return false
 	}
 	return true
 }
-func (p *ScoreDistributionItem) Field2DeepEqual(src int64) bool {
+func (p *JudgeHumanAgreement) Field2DeepEqual(src *string) bool {
 
-	if p.Count != src {
+	if p.Alias == src {
+		return true
+	} else if p.Alias == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Alias, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ScoreDistributionItem) Field3DeepEqual(src float64) bool {
+func (p *JudgeHumanAgreement) Field3DeepEqual(src *int32) bool {
 
-	if p.Percentage != src {
+	if p.PairedCnt == src {
+		return true
+	} else if p.PairedCnt == nil || src == nil {
+		return false
+	}
+	if *p.PairedCnt != *src {
 		return false
 	}
 	return true
 }
+func (p *JudgeHumanAgreement) Field4DeepEqual(src *float64) bool {
 
-type AggregateData struct {
-	DataType           DataType            `thrift:"data_type,1,required" frugal:"1,required,DataType" form:"data_type,required" json:"data_type,required" query:"data_type,required"`
-	Value              *float64            `thrift:"value,2,optional" frugal:"2,optional,double" form:"value" json:"value,omitempty" query:"value"`
-	ScoreDistribution  *ScoreDistribution  `thrift:"score_distribution,3,optional" frugal:"3,optional,ScoreDistribution" form:"score_distribution" json:"score_distribution,omitempty" query:"score_distribution"`
-	OptionDistribution *OptionDistribution `thrift:"option_distribution,4,optional" frugal:"4,optional,OptionDistribution" form:"option_distribution" json:"option_distribution,omitempty" query:"option_distribution"`
+	if p.PearsonCorrelation == src {
+		return true
+	} else if p.PearsonCorrelation == nil || src == nil {
+		return false
+	}
+	if *p.PearsonCorrelation != *src {
+		return false
+	}
+	return true
 }
+func (p *JudgeHumanAgreement) Field5DeepEqual(src *float64) bool {
 
-func NewAggregateData() *AggregateData {
-	return &AggregateData{}
+	if p.MeanAbsoluteError == src {
+		return true
+	} else if p.MeanAbsoluteError == nil || src == nil {
+		return false
+	}
+	if *p.MeanAbsoluteError != *src {
+		return false
+	}
+	return true
 }
+func (p *JudgeHumanAgreement) Field6DeepEqual(src *float64) bool {
 
-func (p *AggregateData) InitDefault() {
+	if p.KrippendorffAlpha == src {
+		return true
+	} else if p.KrippendorffAlpha == nil || src == nil {
+		return false
+	}
+	if *p.KrippendorffAlpha != *src {
+		return false
+	}
+	return true
 }
+func (p *JudgeHumanAgreement) Field7DeepEqual(src *float64) bool {
 
-func (p *AggregateData) GetDataType() (v DataType) {
+	if p.CohenKappa == src {
+		return true
+	} else if p.CohenKappa == nil || src == nil {
+		return false
+	}
+	if *p.CohenKappa != *src {
+		return false
+	}
+	return true
+}
+
+// 一种聚合器类型的聚合结果
+type AggregatorResult_ struct {
+	AggregatorType AggregatorType `thrift:"aggregator_type,1,required" frugal:"1,required,AggregatorType" form:"aggregator_type,required" json:"aggregator_type,required" query:"aggregator_type,required"`
+	Data           *AggregateData `thrift:"data,2,optional" frugal:"2,optional,AggregateData" form:"data" json:"data,omitempty" query:"data"`
+}
+
+func NewAggregatorResult_() *AggregatorResult_ {
+	return &AggregatorResult_{}
+}
+
+func (p *AggregatorResult_) InitDefault() {
+}
+
+func (p *AggregatorResult_) GetAggregatorType() (v AggregatorType) {
 	if p != nil {
-		return p.DataType
+		return p.AggregatorType
 	}
 	return
 }
 
-var AggregateData_Value_DEFAULT float64
+var AggregatorResult__Data_DEFAULT *AggregateData
 
-func (p *AggregateData) GetValue() (v float64) {
+func (p *AggregatorResult_) GetData() (v *AggregateData) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetValue() {
-		return AggregateData_Value_DEFAULT
-	}
-	return *p.Value
-}
-
-var AggregateData_ScoreDistribution_DEFAULT *ScoreDistribution
-
-func (p *AggregateData) GetScoreDistribution() (v *ScoreDistribution) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetScoreDistribution() {
-		return AggregateData_ScoreDistribution_DEFAULT
-	}
-	return p.ScoreDistribution
-}
-
-var AggregateData_OptionDistribution_DEFAULT *OptionDistribution
-
-func (p *AggregateData) GetOptionDistribution() (v *OptionDistribution) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetOptionDistribution() {
-		return AggregateData_OptionDistribution_DEFAULT
+	if !p.IsSetData() {
+		return AggregatorResult__Data_DEFAULT
 	}
-	return p.OptionDistribution
-}
-func (p *AggregateData) SetDataType(val DataType) {
-	p.DataType = val
-}
-func (p *AggregateData) SetValue(val *float64) {
-	p.Value = val
-}
-func (p *AggregateData) SetScoreDistribution(val *ScoreDistribution) {
-	p.ScoreDistribution = val
-}
-func (p *AggregateData) SetOptionDistribution(val *OptionDistribution) {
-	p.OptionDistribution = val
+	return p.Data
 }
-
-var fieldIDToName_AggregateData = map[int16]string{
-	1: "data_type",
-	2: "value",
-	3: "score_distribution",
-	4: "option_distribution",
+func (p *AggregatorResult_) SetAggregatorType(val AggregatorType) {
+	p.AggregatorType = val
 }
-
-func (p *AggregateData) IsSetValue() bool {
-	return p.Value != nil
+func (p *AggregatorResult_) SetData(val *AggregateData) {
+	p.Data = val
 }
 
-func (p *AggregateData) IsSetScoreDistribution() bool {
-	return p.ScoreDistribution != nil
+var fieldIDToName_AggregatorResult_ = map[int16]string{
+	1: "aggregator_type",
+	2: "data",
 }
 
-func (p *AggregateData) IsSetOptionDistribution() bool {
-	return p.OptionDistribution != nil
+func (p *AggregatorResult_) IsSetData() bool {
+	return p.Data != nil
 }
 
-func (p *AggregateData) Read(iprot thrift.TProtocol) (err error) {
+func (p *AggregatorResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetDataType bool = false
+	var issetAggregatorType bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -25993,29 +27601,13 @@ func (p *AggregateData) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetDataType = true
+				issetAggregatorType = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
 			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField4(iprot); err != nil {
+				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -26034,7 +27626,7 @@ func (p *AggregateData) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetDataType {
+	if !issetAggregatorType {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
@@ -26044,7 +27636,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AggregateData[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AggregatorResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -26053,51 +27645,32 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AggregateData[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AggregatorResult_[fieldId]))
 }
 
-func (p *AggregateData) ReadField1(iprot thrift.TProtocol) error {
+func (p *AggregatorResult_) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field DataType
+	var _field AggregatorType
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		_field = DataType(v)
-	}
-	p.DataType = _field
-	return nil
-}
-func (p *AggregateData) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Value = _field
-	return nil
-}
-func (p *AggregateData) ReadField3(iprot thrift.TProtocol) error {
-	_field := NewScoreDistribution()
-	if err := _field.Read(iprot); err != nil {
-		return err
+		_field = AggregatorType(v)
 	}
-	p.ScoreDistribution = _field
+	p.AggregatorType = _field
 	return nil
 }
-func (p *AggregateData) ReadField4(iprot thrift.TProtocol) error {
-	_field := NewOptionDistribution()
+func (p *AggregatorResult_) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewAggregateData()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.OptionDistribution = _field
+	p.Data = _field
 	return nil
 }
 
-func (p *AggregateData) Write(oprot thrift.TProtocol) (err error) {
+func (p *AggregatorResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AggregateData"); err != nil {
+	if err = oprot.WriteStructBegin("AggregatorResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -26109,14 +27682,6 @@ func (p *AggregateData) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -26135,11 +27700,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AggregateData) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("data_type", thrift.I32, 1); err != nil {
+func (p *AggregatorResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("aggregator_type", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(int32(p.DataType)); err != nil {
+	if err := oprot.WriteI32(int32(p.AggregatorType)); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -26151,12 +27716,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *AggregateData) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetValue() {
-		if err = oprot.WriteFieldBegin("value", thrift.DOUBLE, 2); err != nil {
+func (p *AggregatorResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetData() {
+		if err = oprot.WriteFieldBegin("data", thrift.STRUCT, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteDouble(*p.Value); err != nil {
+		if err := p.Data.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -26169,141 +27734,80 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *AggregateData) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetScoreDistribution() {
-		if err = oprot.WriteFieldBegin("score_distribution", thrift.STRUCT, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.ScoreDistribution.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *AggregateData) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOptionDistribution() {
-		if err = oprot.WriteFieldBegin("option_distribution", thrift.STRUCT, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.OptionDistribution.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
 
-func (p *AggregateData) String() string {
+func (p *AggregatorResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AggregateData(%+v)", *p)
+	return fmt.Sprintf("AggregatorResult_(%+v)", *p)
 
 }
 
-func (p *AggregateData) DeepEqual(ano *AggregateData) bool {
+func (p *AggregatorResult_) DeepEqual(ano *AggregatorResult_) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.DataType) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.Value) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.ScoreDistribution) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.OptionDistribution) {
+	if !p.Field1DeepEqual(ano.AggregatorType) {
 		return false
 	}
-	return true
-}
-
-func (p *AggregateData) Field1DeepEqual(src DataType) bool {
-
-	if p.DataType != src {
+	if !p.Field2DeepEqual(ano.Data) {
 		return false
 	}
 	return true
 }
-func (p *AggregateData) Field2DeepEqual(src *float64) bool {
 
-	if p.Value == src {
-		return true
-	} else if p.Value == nil || src == nil {
-		return false
-	}
-	if *p.Value != *src {
-		return false
-	}
-	return true
-}
-func (p *AggregateData) Field3DeepEqual(src *ScoreDistribution) bool {
+func (p *AggregatorResult_) Field1DeepEqual(src AggregatorType) bool {
 
-	if !p.ScoreDistribution.DeepEqual(src) {
+	if p.AggregatorType != src {
 		return false
 	}
 	return true
 }
-func (p *AggregateData) Field4DeepEqual(src *OptionDistribution) bool {
+func (p *AggregatorResult_) Field2DeepEqual(src *AggregateData) bool {
 
-	if !p.OptionDistribution.DeepEqual(src) {
+	if !p.Data.DeepEqual(src) {
 		return false
 	}
 	return true
 }
 
-type OptionDistribution struct {
-	OptionDistributionItems []*OptionDistributionItem `thrift:"option_distribution_items,1,optional" frugal:"1,optional,list<OptionDistributionItem>" form:"option_distribution_items" json:"option_distribution_items,omitempty" query:"option_distribution_items"`
+type ScoreDistribution struct {
+	ScoreDistributionItems []*ScoreDistributionItem `thrift:"score_distribution_items,1,optional" frugal:"1,optional,list<ScoreDistributionItem>" form:"score_distribution_items" json:"score_distribution_items,omitempty" query:"score_distribution_items"`
 }
 
-func NewOptionDistribution() *OptionDistribution {
-	return &OptionDistribution{}
+func NewScoreDistribution() *ScoreDistribution {
+	return &ScoreDistribution{}
 }
 
-func (p *OptionDistribution) InitDefault() {
+func (p *ScoreDistribution) InitDefault() {
 }
 
-var OptionDistribution_OptionDistributionItems_DEFAULT []*OptionDistributionItem
+var ScoreDistribution_ScoreDistributionItems_DEFAULT []*ScoreDistributionItem
 
-func (p *OptionDistribution) GetOptionDistributionItems() (v []*OptionDistributionItem) {
+func (p *ScoreDistribution) GetScoreDistributionItems() (v []*ScoreDistributionItem) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetOptionDistributionItems() {
-		return OptionDistribution_OptionDistributionItems_DEFAULT
+	if !p.IsSetScoreDistributionItems() {
+		return ScoreDistribution_ScoreDistributionItems_DEFAULT
 	}
-	return p.OptionDistributionItems
+	return p.ScoreDistributionItems
 }
-func (p *OptionDistribution) SetOptionDistributionItems(val []*OptionDistributionItem) {
-	p.OptionDistributionItems = val
+func (p *ScoreDistribution) SetScoreDistributionItems(val []*ScoreDistributionItem) {
+	p.ScoreDistributionItems = val
 }
 
-var fieldIDToName_OptionDistribution = map[int16]string{
-	1: "option_distribution_items",
+var fieldIDToName_ScoreDistribution = map[int16]string{
+	1: "score_distribution_items",
 }
 
-func (p *OptionDistribution) IsSetOptionDistributionItems() bool {
-	return p.OptionDistributionItems != nil
+func (p *ScoreDistribution) IsSetScoreDistributionItems() bool {
+	return p.ScoreDistributionItems != nil
 }
 
-func (p *OptionDistribution) Read(iprot thrift.TProtocol) (err error) {
+func (p *ScoreDistribution) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -26348,7 +27852,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OptionDistribution[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ScoreDistribution[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -26358,13 +27862,13 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OptionDistribution) ReadField1(iprot thrift.TProtocol) error {
+func (p *ScoreDistribution) ReadField1(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*OptionDistributionItem, 0, size)
-	values := make([]OptionDistributionItem, size)
+	_field := make([]*ScoreDistributionItem, 0, size)
+	values := make([]ScoreDistributionItem, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -26378,13 +27882,13 @@ func (p *OptionDistribution) ReadField1(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.OptionDistributionItems = _field
+	p.ScoreDistributionItems = _field
 	return nil
 }
 
-func (p *OptionDistribution) Write(oprot thrift.TProtocol) (err error) {
+func (p *ScoreDistribution) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("OptionDistribution"); err != nil {
+	if err = oprot.WriteStructBegin("ScoreDistribution"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -26410,15 +27914,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OptionDistribution) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOptionDistributionItems() {
-		if err = oprot.WriteFieldBegin("option_distribution_items", thrift.LIST, 1); err != nil {
+func (p *ScoreDistribution) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScoreDistributionItems() {
+		if err = oprot.WriteFieldBegin("score_distribution_items", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.OptionDistributionItems)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ScoreDistributionItems)); err != nil {
 			return err
 		}
-		for _, v := range p.OptionDistributionItems {
+		for _, v := range p.ScoreDistributionItems {
 			if err := v.Write(oprot); err != nil {
 				return err
 			}
@@ -26437,32 +27941,32 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *OptionDistribution) String() string {
+func (p *ScoreDistribution) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OptionDistribution(%+v)", *p)
+	return fmt.Sprintf("ScoreDistribution(%+v)", *p)
 
 }
 
-func (p *OptionDistribution) DeepEqual(ano *OptionDistribution) bool {
+func (p *ScoreDistribution) DeepEqual(ano *ScoreDistribution) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.OptionDistributionItems) {
+	if !p.Field1DeepEqual(ano.ScoreDistributionItems) {
 		return false
 	}
 	return true
 }
 
-func (p *OptionDistribution) Field1DeepEqual(src []*OptionDistributionItem) bool {
+func (p *ScoreDistribution) Field1DeepEqual(src []*ScoreDistributionItem) bool {
 
-	if len(p.OptionDistributionItems) != len(src) {
+	if len(p.ScoreDistributionItems) != len(src) {
 		return false
 	}
-	for i, v := range p.OptionDistributionItems {
+	for i, v := range p.ScoreDistributionItems {
 		_src := src[i]
 		if !v.DeepEqual(_src) {
 			return false
@@ -26471,60 +27975,59 @@ func (p *OptionDistribution) Field1DeepEqual(src []*OptionDistributionItem) bool
 	return true
 }
 
-type OptionDistributionItem struct {
-	// 值为tag_value_id,或`其他`
-	Option     string  `thrift:"option,1,required" frugal:"1,required,string" form:"option,required" json:"option,required" query:"option,required"`
+type ScoreDistributionItem struct {
+	Score      string  `thrift:"score,1,required" frugal:"1,required,string" form:"score,required" json:"score,required" query:"score,required"`
 	Count      int64   `thrift:"count,2,required" frugal:"2,required,i64" json:"count" form:"count,required" query:"count,required"`
 	Percentage float64 `thrift:"percentage,3,required" frugal:"3,required,double" form:"percentage,required" json:"percentage,required" query:"percentage,required"`
 }
 
-func NewOptionDistributionItem() *OptionDistributionItem {
-	return &OptionDistributionItem{}
+func NewScoreDistributionItem() *ScoreDistributionItem {
+	return &ScoreDistributionItem{}
 }
 
-func (p *OptionDistributionItem) InitDefault() {
+func (p *ScoreDistributionItem) InitDefault() {
 }
 
-func (p *OptionDistributionItem) GetOption() (v string) {
+func (p *ScoreDistributionItem) GetScore() (v string) {
 	if p != nil {
-		return p.Option
+		return p.Score
 	}
 	return
 }
 
-func (p *OptionDistributionItem) GetCount() (v int64) {
+func (p *ScoreDistributionItem) GetCount() (v int64) {
 	if p != nil {
 		return p.Count
 	}
 	return
 }
 
-func (p *OptionDistributionItem) GetPercentage() (v float64) {
+func (p *ScoreDistributionItem) GetPercentage() (v float64) {
 	if p != nil {
 		return p.Percentage
 	}
 	return
 }
-func (p *OptionDistributionItem) SetOption(val string) {
-	p.Option = val
+func (p *ScoreDistributionItem) SetScore(val string) {
+	p.Score = val
 }
-func (p *OptionDistributionItem) SetCount(val int64) {
+func (p *ScoreDistributionItem) SetCount(val int64) {
 	p.Count = val
 }
-func (p *OptionDistributionItem) SetPercentage(val float64) {
+func (p *ScoreDistributionItem) SetPercentage(val float64) {
 	p.Percentage = val
 }
 
-var fieldIDToName_OptionDistributionItem = map[int16]string{
-	1: "option",
+var fieldIDToName_ScoreDistributionItem = map[int16]string{
+	1: "score",
 	2: "count",
 	3: "percentage",
 }
 
-func (p *OptionDistributionItem) Read(iprot thrift.TProtocol) (err error) {
+func (p *ScoreDistributionItem) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetOption bool = false
+	var issetScore bool = false
 	var issetCount bool = false
 	var issetPercentage bool = false
 
@@ -26547,7 +28050,7 @@ func (p *OptionDistributionItem) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetOption = true
+				issetScore = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -26582,7 +28085,7 @@ func (p *OptionDistributionItem) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetOption {
+	if !issetScore {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
@@ -26602,7 +28105,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OptionDistributionItem[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ScoreDistributionItem[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -26611,10 +28114,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_OptionDistributionItem[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ScoreDistributionItem[fieldId]))
 }
 
-func (p *OptionDistributionItem) ReadField1(iprot thrift.TProtocol) error {
+func (p *ScoreDistributionItem) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -26622,10 +28125,10 @@ func (p *OptionDistributionItem) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Option = _field
+	p.Score = _field
 	return nil
 }
-func (p *OptionDistributionItem) ReadField2(iprot thrift.TProtocol) error {
+func (p *ScoreDistributionItem) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -26636,7 +28139,7 @@ func (p *OptionDistributionItem) ReadField2(iprot thrift.TProtocol) error {
 	p.Count = _field
 	return nil
 }
-func (p *OptionDistributionItem) ReadField3(iprot thrift.TProtocol) error {
+func (p *ScoreDistributionItem) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field float64
 	if v, err := iprot.ReadDouble(); err != nil {
@@ -26648,9 +28151,9 @@ func (p *OptionDistributionItem) ReadField3(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *OptionDistributionItem) Write(oprot thrift.TProtocol) (err error) {
+func (p *ScoreDistributionItem) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("OptionDistributionItem"); err != nil {
+	if err = oprot.WriteStructBegin("ScoreDistributionItem"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -26684,11 +28187,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OptionDistributionItem) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("option", thrift.STRING, 1); err != nil {
+func (p *ScoreDistributionItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("score", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Option); err != nil {
+	if err := oprot.WriteString(p.Score); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -26700,7 +28203,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *OptionDistributionItem) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *ScoreDistributionItem) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("count", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -26716,7 +28219,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *OptionDistributionItem) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *ScoreDistributionItem) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("percentage", thrift.DOUBLE, 3); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -26733,21 +28236,21 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *OptionDistributionItem) String() string {
+func (p *ScoreDistributionItem) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OptionDistributionItem(%+v)", *p)
+	return fmt.Sprintf("ScoreDistributionItem(%+v)", *p)
 
 }
 
-func (p *OptionDistributionItem) DeepEqual(ano *OptionDistributionItem) bool {
+func (p *ScoreDistributionItem) DeepEqual(ano *ScoreDistributionItem) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Option) {
+	if !p.Field1DeepEqual(ano.Score) {
 		return false
 	}
 	if !p.Field2DeepEqual(ano.Count) {
@@ -26759,21 +28262,21 @@ func (p *OptionDistributionItem) DeepEqual(ano *OptionDistributionItem) bool {
 	return true
 }
 
-func (p *OptionDistributionItem) Field1DeepEqual(src string) bool {
+func (p *ScoreDistributionItem) Field1DeepEqual(src string) bool {
 
-	if strings.Compare(p.Option, src) != 0 {
+	if strings.Compare(p.Score, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *OptionDistributionItem) Field2DeepEqual(src int64) bool {
+func (p *ScoreDistributionItem) Field2DeepEqual(src int64) bool {
 
 	if p.Count != src {
 		return false
 	}
 	return true
 }
-func (p *OptionDistributionItem) Field3DeepEqual(src float64) bool {
+func (p *ScoreDistributionItem) Field3DeepEqual(src float64) bool {
 
 	if p.Percentage != src {
 		return false
@@ -26781,85 +28284,98 @@ func (p *OptionDistributionItem) Field3DeepEqual(src float64) bool {
 	return true
 }
 
-type ExptStatsInfo struct {
-	ExptID    *int64          `thrift:"expt_id,1,optional" frugal:"1,optional,i64" form:"expt_id" json:"expt_id,omitempty" query:"expt_id"`
-	SourceID  *string         `thrift:"source_id,2,optional" frugal:"2,optional,string" form:"source_id" json:"source_id,omitempty" query:"source_id"`
-	ExptStats *ExptStatistics `thrift:"expt_stats,3,optional" frugal:"3,optional,ExptStatistics" form:"expt_stats" json:"expt_stats,omitempty" query:"expt_stats"`
+type AggregateData struct {
+	DataType           DataType            `thrift:"data_type,1,required" frugal:"1,required,DataType" form:"data_type,required" json:"data_type,required" query:"data_type,required"`
+	Value              *float64            `thrift:"value,2,optional" frugal:"2,optional,double" form:"value" json:"value,omitempty" query:"value"`
+	ScoreDistribution  *ScoreDistribution  `thrift:"score_distribution,3,optional" frugal:"3,optional,ScoreDistribution" form:"score_distribution" json:"score_distribution,omitempty" query:"score_distribution"`
+	OptionDistribution *OptionDistribution `thrift:"option_distribution,4,optional" frugal:"4,optional,OptionDistribution" form:"option_distribution" json:"option_distribution,omitempty" query:"option_distribution"`
 }
 
-func NewExptStatsInfo() *ExptStatsInfo {
-	return &ExptStatsInfo{}
+func NewAggregateData() *AggregateData {
+	return &AggregateData{}
 }
 
-func (p *ExptStatsInfo) InitDefault() {
+func (p *AggregateData) InitDefault() {
 }
 
-var ExptStatsInfo_ExptID_DEFAULT int64
+func (p *AggregateData) GetDataType() (v DataType) {
+	if p != nil {
+		return p.DataType
+	}
+	return
+}
 
-func (p *ExptStatsInfo) GetExptID() (v int64) {
+var AggregateData_Value_DEFAULT float64
+
+func (p *AggregateData) GetValue() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetExptID() {
-		return ExptStatsInfo_ExptID_DEFAULT
+	if !p.IsSetValue() {
+		return AggregateData_Value_DEFAULT
 	}
-	return *p.ExptID
+	return *p.Value
 }
 
-var ExptStatsInfo_SourceID_DEFAULT string
+var AggregateData_ScoreDistribution_DEFAULT *ScoreDistribution
 
-func (p *ExptStatsInfo) GetSourceID() (v string) {
+func (p *AggregateData) GetScoreDistribution() (v *ScoreDistribution) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetSourceID() {
-		return ExptStatsInfo_SourceID_DEFAULT
+	if !p.IsSetScoreDistribution() {
+		return AggregateData_ScoreDistribution_DEFAULT
 	}
-	return *p.SourceID
+	return p.ScoreDistribution
 }
 
-var ExptStatsInfo_ExptStats_DEFAULT *ExptStatistics
+var AggregateData_OptionDistribution_DEFAULT *OptionDistribution
 
-func (p *ExptStatsInfo) GetExptStats() (v *ExptStatistics) {
+func (p *AggregateData) GetOptionDistribution() (v *OptionDistribution) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetExptStats() {
-		return ExptStatsInfo_ExptStats_DEFAULT
+	if !p.IsSetOptionDistribution() {
+		return AggregateData_OptionDistribution_DEFAULT
 	}
-	return p.ExptStats
+	return p.OptionDistribution
 }
-func (p *ExptStatsInfo) SetExptID(val *int64) {
-	p.ExptID = val
+func (p *AggregateData) SetDataType(val DataType) {
+	p.DataType = val
 }
-func (p *ExptStatsInfo) SetSourceID(val *string) {
-	p.SourceID = val
+func (p *AggregateData) SetValue(val *float64) {
+	p.Value = val
 }
-func (p *ExptStatsInfo) SetExptStats(val *ExptStatistics) {
-	p.ExptStats = val
+func (p *AggregateData) SetScoreDistribution(val *ScoreDistribution) {
+	p.ScoreDistribution = val
+}
+func (p *AggregateData) SetOptionDistribution(val *OptionDistribution) {
+	p.OptionDistribution = val
 }
 
-var fieldIDToName_ExptStatsInfo = map[int16]string{
-	1: "expt_id",
-	2: "source_id",
-	3: "expt_stats",
+var fieldIDToName_AggregateData = map[int16]string{
+	1: "data_type",
+	2: "value",
+	3: "score_distribution",
+	4: "option_distribution",
 }
 
-func (p *ExptStatsInfo) IsSetExptID() bool {
-	return p.ExptID != nil
+func (p *AggregateData) IsSetValue() bool {
+	return p.Value != nil
 }
 
-func (p *ExptStatsInfo) IsSetSourceID() bool {
-	return p.SourceID != nil
+func (p *AggregateData) IsSetScoreDistribution() bool {
+	return p.ScoreDistribution != nil
 }
 
-func (p *ExptStatsInfo) IsSetExptStats() bool {
-	return p.ExptStats != nil
+func (p *AggregateData) IsSetOptionDistribution() bool {
+	return p.OptionDistribution != nil
 }
 
-func (p *ExptStatsInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *AggregateData) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetDataType bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -26876,15 +28392,16 @@ func (p *ExptStatsInfo) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetDataType = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -26899,6 +28416,14 @@ func (p *ExptStatsInfo) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -26912,13 +28437,17 @@ func (p *ExptStatsInfo) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetDataType {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptStatsInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AggregateData[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -26926,42 +28455,52 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AggregateData[fieldId]))
 }
 
-func (p *ExptStatsInfo) ReadField1(iprot thrift.TProtocol) error {
+func (p *AggregateData) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field DataType
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = DataType(v)
 	}
-	p.ExptID = _field
+	p.DataType = _field
 	return nil
 }
-func (p *ExptStatsInfo) ReadField2(iprot thrift.TProtocol) error {
+func (p *AggregateData) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.SourceID = _field
+	p.Value = _field
 	return nil
 }
-func (p *ExptStatsInfo) ReadField3(iprot thrift.TProtocol) error {
-	_field := NewExptStatistics()
+func (p *AggregateData) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewScoreDistribution()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.ExptStats = _field
+	p.ScoreDistribution = _field
+	return nil
+}
+func (p *AggregateData) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewOptionDistribution()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.OptionDistribution = _field
 	return nil
 }
 
-func (p *ExptStatsInfo) Write(oprot thrift.TProtocol) (err error) {
+func (p *AggregateData) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptStatsInfo"); err != nil {
+	if err = oprot.WriteStructBegin("AggregateData"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -26977,6 +28516,10 @@ func (p *ExptStatsInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -26995,12 +28538,28 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExptStatsInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExptID() {
-		if err = oprot.WriteFieldBegin("expt_id", thrift.I64, 1); err != nil {
+func (p *AggregateData) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("data_type", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.DataType)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *AggregateData) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetValue() {
+		if err = oprot.WriteFieldBegin("value", thrift.DOUBLE, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.ExptID); err != nil {
+		if err := oprot.WriteDouble(*p.Value); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -27009,16 +28568,16 @@ func (p *ExptStatsInfo) writeField1(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ExptStatsInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSourceID() {
-		if err = oprot.WriteFieldBegin("source_id", thrift.STRING, 2); err != nil {
+func (p *AggregateData) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScoreDistribution() {
+		if err = oprot.WriteFieldBegin("score_distribution", thrift.STRUCT, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.SourceID); err != nil {
+		if err := p.ScoreDistribution.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -27027,16 +28586,16 @@ func (p *ExptStatsInfo) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ExptStatsInfo) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExptStats() {
-		if err = oprot.WriteFieldBegin("expt_stats", thrift.STRUCT, 3); err != nil {
+func (p *AggregateData) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOptionDistribution() {
+		if err = oprot.WriteFieldBegin("option_distribution", thrift.STRUCT, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.ExptStats.Write(oprot); err != nil {
+		if err := p.OptionDistribution.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -27045,119 +28604,111 @@ func (p *ExptStatsInfo) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *ExptStatsInfo) String() string {
+func (p *AggregateData) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptStatsInfo(%+v)", *p)
+	return fmt.Sprintf("AggregateData(%+v)", *p)
 
 }
 
-func (p *ExptStatsInfo) DeepEqual(ano *ExptStatsInfo) bool {
+func (p *AggregateData) DeepEqual(ano *AggregateData) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ExptID) {
+	if !p.Field1DeepEqual(ano.DataType) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.SourceID) {
+	if !p.Field2DeepEqual(ano.Value) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.ExptStats) {
+	if !p.Field3DeepEqual(ano.ScoreDistribution) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.OptionDistribution) {
 		return false
 	}
 	return true
 }
 
-func (p *ExptStatsInfo) Field1DeepEqual(src *int64) bool {
+func (p *AggregateData) Field1DeepEqual(src DataType) bool {
 
-	if p.ExptID == src {
-		return true
-	} else if p.ExptID == nil || src == nil {
-		return false
-	}
-	if *p.ExptID != *src {
+	if p.DataType != src {
 		return false
 	}
 	return true
 }
-func (p *ExptStatsInfo) Field2DeepEqual(src *string) bool {
+func (p *AggregateData) Field2DeepEqual(src *float64) bool {
 
-	if p.SourceID == src {
+	if p.Value == src {
 		return true
-	} else if p.SourceID == nil || src == nil {
+	} else if p.Value == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.SourceID, *src) != 0 {
+	if *p.Value != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptStatsInfo) Field3DeepEqual(src *ExptStatistics) bool {
+func (p *AggregateData) Field3DeepEqual(src *ScoreDistribution) bool {
 
-	if !p.ExptStats.DeepEqual(src) {
+	if !p.ScoreDistribution.DeepEqual(src) {
 		return false
 	}
 	return true
 }
+func (p *AggregateData) Field4DeepEqual(src *OptionDistribution) bool {
 
-type ExptColumnAnnotation struct {
-	ExperimentID      int64               `thrift:"experiment_id,1,required" frugal:"1,required,i64" json:"experiment_id" form:"experiment_id,required" query:"experiment_id,required"`
-	ColumnAnnotations []*ColumnAnnotation `thrift:"column_annotations,2,optional" frugal:"2,optional,list<ColumnAnnotation>" form:"column_annotations" json:"column_annotations,omitempty" query:"column_annotations"`
+	if !p.OptionDistribution.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func NewExptColumnAnnotation() *ExptColumnAnnotation {
-	return &ExptColumnAnnotation{}
+type OptionDistribution struct {
+	OptionDistributionItems []*OptionDistributionItem `thrift:"option_distribution_items,1,optional" frugal:"1,optional,list<OptionDistributionItem>" form:"option_distribution_items" json:"option_distribution_items,omitempty" query:"option_distribution_items"`
 }
 
-func (p *ExptColumnAnnotation) InitDefault() {
+func NewOptionDistribution() *OptionDistribution {
+	return &OptionDistribution{}
 }
 
-func (p *ExptColumnAnnotation) GetExperimentID() (v int64) {
-	if p != nil {
-		return p.ExperimentID
-	}
-	return
+func (p *OptionDistribution) InitDefault() {
 }
 
-var ExptColumnAnnotation_ColumnAnnotations_DEFAULT []*ColumnAnnotation
+var OptionDistribution_OptionDistributionItems_DEFAULT []*OptionDistributionItem
 
-func (p *ExptColumnAnnotation) GetColumnAnnotations() (v []*ColumnAnnotation) {
+func (p *OptionDistribution) GetOptionDistributionItems() (v []*OptionDistributionItem) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetColumnAnnotations() {
-		return ExptColumnAnnotation_ColumnAnnotations_DEFAULT
+	if !p.IsSetOptionDistributionItems() {
+		return OptionDistribution_OptionDistributionItems_DEFAULT
 	}
-	return p.ColumnAnnotations
-}
-func (p *ExptColumnAnnotation) SetExperimentID(val int64) {
-	p.ExperimentID = val
+	return p.OptionDistributionItems
 }
-func (p *ExptColumnAnnotation) SetColumnAnnotations(val []*ColumnAnnotation) {
-	p.ColumnAnnotations = val
+func (p *OptionDistribution) SetOptionDistributionItems(val []*OptionDistributionItem) {
+	p.OptionDistributionItems = val
 }
 
-var fieldIDToName_ExptColumnAnnotation = map[int16]string{
-	1: "experiment_id",
-	2: "column_annotations",
+var fieldIDToName_OptionDistribution = map[int16]string{
+	1: "option_distribution_items",
 }
 
-func (p *ExptColumnAnnotation) IsSetColumnAnnotations() bool {
-	return p.ColumnAnnotations != nil
+func (p *OptionDistribution) IsSetOptionDistributionItems() bool {
+	return p.OptionDistributionItems != nil
 }
 
-func (p *ExptColumnAnnotation) Read(iprot thrift.TProtocol) (err error) {
+func (p *OptionDistribution) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetExperimentID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -27174,17 +28725,8 @@ func (p *ExptColumnAnnotation) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetExperimentID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
 			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField2(iprot); err != nil {
+				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -27203,17 +28745,13 @@ func (p *ExptColumnAnnotation) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetExperimentID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptColumnAnnotation[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OptionDistribution[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -27221,28 +28759,15 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptColumnAnnotation[fieldId]))
 }
 
-func (p *ExptColumnAnnotation) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ExperimentID = _field
-	return nil
-}
-func (p *ExptColumnAnnotation) ReadField2(iprot thrift.TProtocol) error {
+func (p *OptionDistribution) ReadField1(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*ColumnAnnotation, 0, size)
-	values := make([]ColumnAnnotation, size)
+	_field := make([]*OptionDistributionItem, 0, size)
+	values := make([]OptionDistributionItem, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -27256,13 +28781,13 @@ func (p *ExptColumnAnnotation) ReadField2(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.ColumnAnnotations = _field
+	p.OptionDistributionItems = _field
 	return nil
 }
 
-func (p *ExptColumnAnnotation) Write(oprot thrift.TProtocol) (err error) {
+func (p *OptionDistribution) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptColumnAnnotation"); err != nil {
+	if err = oprot.WriteStructBegin("OptionDistribution"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -27270,10 +28795,6 @@ func (p *ExptColumnAnnotation) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -27292,31 +28813,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExptColumnAnnotation) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("experiment_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ExperimentID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *ExptColumnAnnotation) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetColumnAnnotations() {
-		if err = oprot.WriteFieldBegin("column_annotations", thrift.LIST, 2); err != nil {
+func (p *OptionDistribution) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOptionDistributionItems() {
+		if err = oprot.WriteFieldBegin("option_distribution_items", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ColumnAnnotations)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.OptionDistributionItems)); err != nil {
 			return err
 		}
-		for _, v := range p.ColumnAnnotations {
+		for _, v := range p.OptionDistributionItems {
 			if err := v.Write(oprot); err != nil {
 				return err
 			}
@@ -27330,47 +28835,37 @@ func (p *ExptColumnAnnotation) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ExptColumnAnnotation) String() string {
+func (p *OptionDistribution) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptColumnAnnotation(%+v)", *p)
+	return fmt.Sprintf("OptionDistribution(%+v)", *p)
 
 }
 
-func (p *ExptColumnAnnotation) DeepEqual(ano *ExptColumnAnnotation) bool {
+func (p *OptionDistribution) DeepEqual(ano *OptionDistribution) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ExperimentID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.ColumnAnnotations) {
+	if !p.Field1DeepEqual(ano.OptionDistributionItems) {
 		return false
 	}
 	return true
 }
 
-func (p *ExptColumnAnnotation) Field1DeepEqual(src int64) bool {
-
-	if p.ExperimentID != src {
-		return false
-	}
-	return true
-}
-func (p *ExptColumnAnnotation) Field2DeepEqual(src []*ColumnAnnotation) bool {
+func (p *OptionDistribution) Field1DeepEqual(src []*OptionDistributionItem) bool {
 
-	if len(p.ColumnAnnotations) != len(src) {
+	if len(p.OptionDistributionItems) != len(src) {
 		return false
 	}
-	for i, v := range p.ColumnAnnotations {
+	for i, v := range p.OptionDistributionItems {
 		_src := src[i]
 		if !v.DeepEqual(_src) {
 			return false
@@ -27379,175 +28874,62 @@ func (p *ExptColumnAnnotation) Field2DeepEqual(src []*ColumnAnnotation) bool {
 	return true
 }
 
-// 标签信息，沿用数据基座Tag定义
-type ColumnAnnotation struct {
-	TagKeyID *int64 `thrift:"tag_key_id,1,optional" frugal:"1,optional,i64" json:"tag_key_id" form:"tag_key_id" query:"tag_key_id"`
-	// tag key name
-	TagKeyName *string `thrift:"tag_key_name,2,optional" frugal:"2,optional,string" form:"tag_key_name" json:"tag_key_name,omitempty" query:"tag_key_name"`
-	// 描述
-	Description *string        `thrift:"description,3,optional" frugal:"3,optional,string" form:"description" json:"description,omitempty" query:"description"`
-	Status      *tag.TagStatus `thrift:"status,4,optional" frugal:"4,optional,string" form:"status" json:"status,omitempty" query:"status"`
-	// 标签选项值
-	TagValues []*tag.TagValue `thrift:"tag_values,13,optional" frugal:"13,optional,list<tag.TagValue>" form:"tag_values" json:"tag_values,omitempty" query:"tag_values"`
-	// 标签内容类型
-	ContentType *tag.TagContentType `thrift:"content_type,14,optional" frugal:"14,optional,string" form:"content_type" json:"content_type,omitempty" query:"content_type"`
-	// 标签内容限制
-	ContentSpec *tag.TagContentSpec `thrift:"content_spec,15,optional" frugal:"15,optional,tag.TagContentSpec" form:"content_spec" json:"content_spec,omitempty" query:"content_spec"`
-}
-
-func NewColumnAnnotation() *ColumnAnnotation {
-	return &ColumnAnnotation{}
-}
-
-func (p *ColumnAnnotation) InitDefault() {
-}
-
-var ColumnAnnotation_TagKeyID_DEFAULT int64
-
-func (p *ColumnAnnotation) GetTagKeyID() (v int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetTagKeyID() {
-		return ColumnAnnotation_TagKeyID_DEFAULT
-	}
-	return *p.TagKeyID
-}
-
-var ColumnAnnotation_TagKeyName_DEFAULT string
-
-func (p *ColumnAnnotation) GetTagKeyName() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetTagKeyName() {
-		return ColumnAnnotation_TagKeyName_DEFAULT
-	}
-	return *p.TagKeyName
+type OptionDistributionItem struct {
+	// 值为tag_value_id,或`其他`
+	Option     string  `thrift:"option,1,required" frugal:"1,required,string" form:"option,required" json:"option,required" query:"option,required"`
+	Count      int64   `thrift:"count,2,required" frugal:"2,required,i64" json:"count" form:"count,required" query:"count,required"`
+	Percentage float64 `thrift:"percentage,3,required" frugal:"3,required,double" form:"percentage,required" json:"percentage,required" query:"percentage,required"`
 }
 
-var ColumnAnnotation_Description_DEFAULT string
-
-func (p *ColumnAnnotation) GetDescription() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetDescription() {
-		return ColumnAnnotation_Description_DEFAULT
-	}
-	return *p.Description
+func NewOptionDistributionItem() *OptionDistributionItem {
+	return &OptionDistributionItem{}
 }
 
-var ColumnAnnotation_Status_DEFAULT tag.TagStatus
-
-func (p *ColumnAnnotation) GetStatus() (v tag.TagStatus) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetStatus() {
-		return ColumnAnnotation_Status_DEFAULT
-	}
-	return *p.Status
+func (p *OptionDistributionItem) InitDefault() {
 }
 
-var ColumnAnnotation_TagValues_DEFAULT []*tag.TagValue
-
-func (p *ColumnAnnotation) GetTagValues() (v []*tag.TagValue) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetTagValues() {
-		return ColumnAnnotation_TagValues_DEFAULT
+func (p *OptionDistributionItem) GetOption() (v string) {
+	if p != nil {
+		return p.Option
 	}
-	return p.TagValues
+	return
 }
 
-var ColumnAnnotation_ContentType_DEFAULT tag.TagContentType
-
-func (p *ColumnAnnotation) GetContentType() (v tag.TagContentType) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetContentType() {
-		return ColumnAnnotation_ContentType_DEFAULT
+func (p *OptionDistributionItem) GetCount() (v int64) {
+	if p != nil {
+		return p.Count
 	}
-	return *p.ContentType
+	return
 }
 
-var ColumnAnnotation_ContentSpec_DEFAULT *tag.TagContentSpec
-
-func (p *ColumnAnnotation) GetContentSpec() (v *tag.TagContentSpec) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetContentSpec() {
-		return ColumnAnnotation_ContentSpec_DEFAULT
+func (p *OptionDistributionItem) GetPercentage() (v float64) {
+	if p != nil {
+		return p.Percentage
 	}
-	return p.ContentSpec
-}
-func (p *ColumnAnnotation) SetTagKeyID(val *int64) {
-	p.TagKeyID = val
-}
-func (p *ColumnAnnotation) SetTagKeyName(val *string) {
-	p.TagKeyName = val
-}
-func (p *ColumnAnnotation) SetDescription(val *string) {
-	p.Description = val
-}
-func (p *ColumnAnnotation) SetStatus(val *tag.TagStatus) {
-	p.Status = val
-}
-func (p *ColumnAnnotation) SetTagValues(val []*tag.TagValue) {
-	p.TagValues = val
-}
-func (p *ColumnAnnotation) SetContentType(val *tag.TagContentType) {
-	p.ContentType = val
-}
-func (p *ColumnAnnotation) SetContentSpec(val *tag.TagContentSpec) {
-	p.ContentSpec = val
-}
-
-var fieldIDToName_ColumnAnnotation = map[int16]string{
-	1:  "tag_key_id",
-	2:  "tag_key_name",
-	3:  "description",
-	4:  "status",
-	13: "tag_values",
-	14: "content_type",
-	15: "content_spec",
-}
-
-func (p *ColumnAnnotation) IsSetTagKeyID() bool {
-	return p.TagKeyID != nil
-}
-
-func (p *ColumnAnnotation) IsSetTagKeyName() bool {
-	return p.TagKeyName != nil
-}
-
-func (p *ColumnAnnotation) IsSetDescription() bool {
-	return p.Description != nil
+	return
 }
-
-func (p *ColumnAnnotation) IsSetStatus() bool {
-	return p.Status != nil
+func (p *OptionDistributionItem) SetOption(val string) {
+	p.Option = val
 }
-
-func (p *ColumnAnnotation) IsSetTagValues() bool {
-	return p.TagValues != nil
+func (p *OptionDistributionItem) SetCount(val int64) {
+	p.Count = val
 }
-
-func (p *ColumnAnnotation) IsSetContentType() bool {
-	return p.ContentType != nil
+func (p *OptionDistributionItem) SetPercentage(val float64) {
+	p.Percentage = val
 }
 
-func (p *ColumnAnnotation) IsSetContentSpec() bool {
-	return p.ContentSpec != nil
+var fieldIDToName_OptionDistributionItem = map[int16]string{
+	1: "option",
+	2: "count",
+	3: "percentage",
 }
 
-func (p *ColumnAnnotation) Read(iprot thrift.TProtocol) (err error) {
+func (p *OptionDistributionItem) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetOption bool = false
+	var issetCount bool = false
+	var issetPercentage bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -27564,58 +28946,29 @@ func (p *ColumnAnnotation) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetOption = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetCount = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 13:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField13(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 14:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField14(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 15:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField15(iprot); err != nil {
-					goto ReadFieldError
-				}
+				issetPercentage = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -27632,13 +28985,27 @@ func (p *ColumnAnnotation) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetOption {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCount {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPercentage {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ColumnAnnotation[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OptionDistributionItem[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -27646,20 +29013,16216 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_OptionDistributionItem[fieldId]))
 }
 
-func (p *ColumnAnnotation) ReadField1(iprot thrift.TProtocol) error {
+func (p *OptionDistributionItem) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.TagKeyID = _field
+	p.Option = _field
 	return nil
 }
-func (p *ColumnAnnotation) ReadField2(iprot thrift.TProtocol) error {
+func (p *OptionDistributionItem) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
+}
+func (p *OptionDistributionItem) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Percentage = _field
+	return nil
+}
+
+func (p *OptionDistributionItem) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("OptionDistributionItem"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OptionDistributionItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("option", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Option); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *OptionDistributionItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *OptionDistributionItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("percentage", thrift.DOUBLE, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.Percentage); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *OptionDistributionItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OptionDistributionItem(%+v)", *p)
+
+}
+
+func (p *OptionDistributionItem) DeepEqual(ano *OptionDistributionItem) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Option) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Count) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Percentage) {
+		return false
+	}
+	return true
+}
+
+func (p *OptionDistributionItem) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Option, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *OptionDistributionItem) Field2DeepEqual(src int64) bool {
+
+	if p.Count != src {
+		return false
+	}
+	return true
+}
+func (p *OptionDistributionItem) Field3DeepEqual(src float64) bool {
+
+	if p.Percentage != src {
+		return false
+	}
+	return true
+}
+
+type ExptStatsInfo struct {
+	ExptID    *int64          `thrift:"expt_id,1,optional" frugal:"1,optional,i64" form:"expt_id" json:"expt_id,omitempty" query:"expt_id"`
+	SourceID  *string         `thrift:"source_id,2,optional" frugal:"2,optional,string" form:"source_id" json:"source_id,omitempty" query:"source_id"`
+	ExptStats *ExptStatistics `thrift:"expt_stats,3,optional" frugal:"3,optional,ExptStatistics" form:"expt_stats" json:"expt_stats,omitempty" query:"expt_stats"`
+}
+
+func NewExptStatsInfo() *ExptStatsInfo {
+	return &ExptStatsInfo{}
+}
+
+func (p *ExptStatsInfo) InitDefault() {
+}
+
+var ExptStatsInfo_ExptID_DEFAULT int64
+
+func (p *ExptStatsInfo) GetExptID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExptID() {
+		return ExptStatsInfo_ExptID_DEFAULT
+	}
+	return *p.ExptID
+}
+
+var ExptStatsInfo_SourceID_DEFAULT string
+
+func (p *ExptStatsInfo) GetSourceID() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSourceID() {
+		return ExptStatsInfo_SourceID_DEFAULT
+	}
+	return *p.SourceID
+}
+
+var ExptStatsInfo_ExptStats_DEFAULT *ExptStatistics
+
+func (p *ExptStatsInfo) GetExptStats() (v *ExptStatistics) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExptStats() {
+		return ExptStatsInfo_ExptStats_DEFAULT
+	}
+	return p.ExptStats
+}
+func (p *ExptStatsInfo) SetExptID(val *int64) {
+	p.ExptID = val
+}
+func (p *ExptStatsInfo) SetSourceID(val *string) {
+	p.SourceID = val
+}
+func (p *ExptStatsInfo) SetExptStats(val *ExptStatistics) {
+	p.ExptStats = val
+}
+
+var fieldIDToName_ExptStatsInfo = map[int16]string{
+	1: "expt_id",
+	2: "source_id",
+	3: "expt_stats",
+}
+
+func (p *ExptStatsInfo) IsSetExptID() bool {
+	return p.ExptID != nil
+}
+
+func (p *ExptStatsInfo) IsSetSourceID() bool {
+	return p.SourceID != nil
+}
+
+func (p *ExptStatsInfo) IsSetExptStats() bool {
+	return p.ExptStats != nil
+}
+
+func (p *ExptStatsInfo) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptStatsInfo[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptStatsInfo) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ExptID = _field
+	return nil
+}
+func (p *ExptStatsInfo) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SourceID = _field
+	return nil
+}
+func (p *ExptStatsInfo) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewExptStatistics()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ExptStats = _field
+	return nil
+}
+
+func (p *ExptStatsInfo) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptStatsInfo"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptStatsInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExptID() {
+		if err = oprot.WriteFieldBegin("expt_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ExptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptStatsInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSourceID() {
+		if err = oprot.WriteFieldBegin("source_id", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SourceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptStatsInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExptStats() {
+		if err = oprot.WriteFieldBegin("expt_stats", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ExptStats.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ExptStatsInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptStatsInfo(%+v)", *p)
+
+}
+
+func (p *ExptStatsInfo) DeepEqual(ano *ExptStatsInfo) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ExptID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.SourceID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ExptStats) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptStatsInfo) Field1DeepEqual(src *int64) bool {
+
+	if p.ExptID == src {
+		return true
+	} else if p.ExptID == nil || src == nil {
+		return false
+	}
+	if *p.ExptID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptStatsInfo) Field2DeepEqual(src *string) bool {
+
+	if p.SourceID == src {
+		return true
+	} else if p.SourceID == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.SourceID, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptStatsInfo) Field3DeepEqual(src *ExptStatistics) bool {
+
+	if !p.ExptStats.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ExptColumnAnnotation struct {
+	ExperimentID      int64               `thrift:"experiment_id,1,required" frugal:"1,required,i64" json:"experiment_id" form:"experiment_id,required" query:"experiment_id,required"`
+	ColumnAnnotations []*ColumnAnnotation `thrift:"column_annotations,2,optional" frugal:"2,optional,list<ColumnAnnotation>" form:"column_annotations" json:"column_annotations,omitempty" query:"column_annotations"`
+}
+
+func NewExptColumnAnnotation() *ExptColumnAnnotation {
+	return &ExptColumnAnnotation{}
+}
+
+func (p *ExptColumnAnnotation) InitDefault() {
+}
+
+func (p *ExptColumnAnnotation) GetExperimentID() (v int64) {
+	if p != nil {
+		return p.ExperimentID
+	}
+	return
+}
+
+var ExptColumnAnnotation_ColumnAnnotations_DEFAULT []*ColumnAnnotation
+
+func (p *ExptColumnAnnotation) GetColumnAnnotations() (v []*ColumnAnnotation) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetColumnAnnotations() {
+		return ExptColumnAnnotation_ColumnAnnotations_DEFAULT
+	}
+	return p.ColumnAnnotations
+}
+func (p *ExptColumnAnnotation) SetExperimentID(val int64) {
+	p.ExperimentID = val
+}
+func (p *ExptColumnAnnotation) SetColumnAnnotations(val []*ColumnAnnotation) {
+	p.ColumnAnnotations = val
+}
+
+var fieldIDToName_ExptColumnAnnotation = map[int16]string{
+	1: "experiment_id",
+	2: "column_annotations",
+}
+
+func (p *ExptColumnAnnotation) IsSetColumnAnnotations() bool {
+	return p.ColumnAnnotations != nil
+}
+
+func (p *ExptColumnAnnotation) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetExperimentID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetExperimentID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetExperimentID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptColumnAnnotation[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptColumnAnnotation[fieldId]))
+}
+
+func (p *ExptColumnAnnotation) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ExperimentID = _field
+	return nil
+}
+func (p *ExptColumnAnnotation) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ColumnAnnotation, 0, size)
+	values := make([]ColumnAnnotation, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ColumnAnnotations = _field
+	return nil
+}
+
+func (p *ExptColumnAnnotation) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptColumnAnnotation"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptColumnAnnotation) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("experiment_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ExperimentID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptColumnAnnotation) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetColumnAnnotations() {
+		if err = oprot.WriteFieldBegin("column_annotations", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ColumnAnnotations)); err != nil {
+			return err
+		}
+		for _, v := range p.ColumnAnnotations {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ExptColumnAnnotation) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptColumnAnnotation(%+v)", *p)
+
+}
+
+func (p *ExptColumnAnnotation) DeepEqual(ano *ExptColumnAnnotation) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ExperimentID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ColumnAnnotations) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptColumnAnnotation) Field1DeepEqual(src int64) bool {
+
+	if p.ExperimentID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptColumnAnnotation) Field2DeepEqual(src []*ColumnAnnotation) bool {
+
+	if len(p.ColumnAnnotations) != len(src) {
+		return false
+	}
+	for i, v := range p.ColumnAnnotations {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+// 标签信息，沿用数据基座Tag定义
+type ColumnAnnotation struct {
+	TagKeyID *int64 `thrift:"tag_key_id,1,optional" frugal:"1,optional,i64" json:"tag_key_id" form:"tag_key_id" query:"tag_key_id"`
+	// tag key name
+	TagKeyName *string `thrift:"tag_key_name,2,optional" frugal:"2,optional,string" form:"tag_key_name" json:"tag_key_name,omitempty" query:"tag_key_name"`
+	// 描述
+	Description *string        `thrift:"description,3,optional" frugal:"3,optional,string" form:"description" json:"description,omitempty" query:"description"`
+	Status      *tag.TagStatus `thrift:"status,4,optional" frugal:"4,optional,string" form:"status" json:"status,omitempty" query:"status"`
+	// 标签选项值
+	TagValues []*tag.TagValue `thrift:"tag_values,13,optional" frugal:"13,optional,list<tag.TagValue>" form:"tag_values" json:"tag_values,omitempty" query:"tag_values"`
+	// 标签内容类型
+	ContentType *tag.TagContentType `thrift:"content_type,14,optional" frugal:"14,optional,string" form:"content_type" json:"content_type,omitempty" query:"content_type"`
+	// 标签内容限制
+	ContentSpec *tag.TagContentSpec `thrift:"content_spec,15,optional" frugal:"15,optional,tag.TagContentSpec" form:"content_spec" json:"content_spec,omitempty" query:"content_spec"`
+}
+
+func NewColumnAnnotation() *ColumnAnnotation {
+	return &ColumnAnnotation{}
+}
+
+func (p *ColumnAnnotation) InitDefault() {
+}
+
+var ColumnAnnotation_TagKeyID_DEFAULT int64
+
+func (p *ColumnAnnotation) GetTagKeyID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTagKeyID() {
+		return ColumnAnnotation_TagKeyID_DEFAULT
+	}
+	return *p.TagKeyID
+}
+
+var ColumnAnnotation_TagKeyName_DEFAULT string
+
+func (p *ColumnAnnotation) GetTagKeyName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTagKeyName() {
+		return ColumnAnnotation_TagKeyName_DEFAULT
+	}
+	return *p.TagKeyName
+}
+
+var ColumnAnnotation_Description_DEFAULT string
+
+func (p *ColumnAnnotation) GetDescription() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDescription() {
+		return ColumnAnnotation_Description_DEFAULT
+	}
+	return *p.Description
+}
+
+var ColumnAnnotation_Status_DEFAULT tag.TagStatus
+
+func (p *ColumnAnnotation) GetStatus() (v tag.TagStatus) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStatus() {
+		return ColumnAnnotation_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var ColumnAnnotation_TagValues_DEFAULT []*tag.TagValue
+
+func (p *ColumnAnnotation) GetTagValues() (v []*tag.TagValue) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTagValues() {
+		return ColumnAnnotation_TagValues_DEFAULT
+	}
+	return p.TagValues
+}
+
+var ColumnAnnotation_ContentType_DEFAULT tag.TagContentType
+
+func (p *ColumnAnnotation) GetContentType() (v tag.TagContentType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetContentType() {
+		return ColumnAnnotation_ContentType_DEFAULT
+	}
+	return *p.ContentType
+}
+
+var ColumnAnnotation_ContentSpec_DEFAULT *tag.TagContentSpec
+
+func (p *ColumnAnnotation) GetContentSpec() (v *tag.TagContentSpec) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetContentSpec() {
+		return ColumnAnnotation_ContentSpec_DEFAULT
+	}
+	return p.ContentSpec
+}
+func (p *ColumnAnnotation) SetTagKeyID(val *int64) {
+	p.TagKeyID = val
+}
+func (p *ColumnAnnotation) SetTagKeyName(val *string) {
+	p.TagKeyName = val
+}
+func (p *ColumnAnnotation) SetDescription(val *string) {
+	p.Description = val
+}
+func (p *ColumnAnnotation) SetStatus(val *tag.TagStatus) {
+	p.Status = val
+}
+func (p *ColumnAnnotation) SetTagValues(val []*tag.TagValue) {
+	p.TagValues = val
+}
+func (p *ColumnAnnotation) SetContentType(val *tag.TagContentType) {
+	p.ContentType = val
+}
+func (p *ColumnAnnotation) SetContentSpec(val *tag.TagContentSpec) {
+	p.ContentSpec = val
+}
+
+var fieldIDToName_ColumnAnnotation = map[int16]string{
+	1:  "tag_key_id",
+	2:  "tag_key_name",
+	3:  "description",
+	4:  "status",
+	13: "tag_values",
+	14: "content_type",
+	15: "content_spec",
+}
+
+func (p *ColumnAnnotation) IsSetTagKeyID() bool {
+	return p.TagKeyID != nil
+}
+
+func (p *ColumnAnnotation) IsSetTagKeyName() bool {
+	return p.TagKeyName != nil
+}
+
+func (p *ColumnAnnotation) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *ColumnAnnotation) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *ColumnAnnotation) IsSetTagValues() bool {
+	return p.TagValues != nil
+}
+
+func (p *ColumnAnnotation) IsSetContentType() bool {
+	return p.ContentType != nil
+}
+
+func (p *ColumnAnnotation) IsSetContentSpec() bool {
+	return p.ContentSpec != nil
+}
+
+func (p *ColumnAnnotation) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 15:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField15(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ColumnAnnotation[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ColumnAnnotation) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TagKeyID = _field
+	return nil
+}
+func (p *ColumnAnnotation) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TagKeyName = _field
+	return nil
+}
+func (p *ColumnAnnotation) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Description = _field
+	return nil
+}
+func (p *ColumnAnnotation) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *tag.TagStatus
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *ColumnAnnotation) ReadField13(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*tag.TagValue, 0, size)
+	values := make([]tag.TagValue, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.TagValues = _field
+	return nil
+}
+func (p *ColumnAnnotation) ReadField14(iprot thrift.TProtocol) error {
+
+	var _field *tag.TagContentType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ContentType = _field
+	return nil
+}
+func (p *ColumnAnnotation) ReadField15(iprot thrift.TProtocol) error {
+	_field := tag.NewTagContentSpec()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ContentSpec = _field
+	return nil
+}
+
+func (p *ColumnAnnotation) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ColumnAnnotation"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+		if err = p.writeField15(oprot); err != nil {
+			fieldId = 15
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ColumnAnnotation) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTagKeyID() {
+		if err = oprot.WriteFieldBegin("tag_key_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TagKeyID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ColumnAnnotation) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTagKeyName() {
+		if err = oprot.WriteFieldBegin("tag_key_name", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TagKeyName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ColumnAnnotation) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err = oprot.WriteFieldBegin("description", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Description); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ColumnAnnotation) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ColumnAnnotation) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTagValues() {
+		if err = oprot.WriteFieldBegin("tag_values", thrift.LIST, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TagValues)); err != nil {
+			return err
+		}
+		for _, v := range p.TagValues {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+func (p *ColumnAnnotation) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetContentType() {
+		if err = oprot.WriteFieldBegin("content_type", thrift.STRING, 14); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ContentType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+func (p *ColumnAnnotation) writeField15(oprot thrift.TProtocol) (err error) {
+	if p.IsSetContentSpec() {
+		if err = oprot.WriteFieldBegin("content_spec", thrift.STRUCT, 15); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ContentSpec.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+}
+
+func (p *ColumnAnnotation) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ColumnAnnotation(%+v)", *p)
+
+}
+
+func (p *ColumnAnnotation) DeepEqual(ano *ColumnAnnotation) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.TagKeyID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TagKeyName) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Description) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field13DeepEqual(ano.TagValues) {
+		return false
+	}
+	if !p.Field14DeepEqual(ano.ContentType) {
+		return false
+	}
+	if !p.Field15DeepEqual(ano.ContentSpec) {
+		return false
+	}
+	return true
+}
+
+func (p *ColumnAnnotation) Field1DeepEqual(src *int64) bool {
+
+	if p.TagKeyID == src {
+		return true
+	} else if p.TagKeyID == nil || src == nil {
+		return false
+	}
+	if *p.TagKeyID != *src {
+		return false
+	}
+	return true
+}
+func (p *ColumnAnnotation) Field2DeepEqual(src *string) bool {
+
+	if p.TagKeyName == src {
+		return true
+	} else if p.TagKeyName == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.TagKeyName, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ColumnAnnotation) Field3DeepEqual(src *string) bool {
+
+	if p.Description == src {
+		return true
+	} else if p.Description == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Description, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ColumnAnnotation) Field4DeepEqual(src *tag.TagStatus) bool {
+
+	if p.Status == src {
+		return true
+	} else if p.Status == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Status, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ColumnAnnotation) Field13DeepEqual(src []*tag.TagValue) bool {
+
+	if len(p.TagValues) != len(src) {
+		return false
+	}
+	for i, v := range p.TagValues {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ColumnAnnotation) Field14DeepEqual(src *tag.TagContentType) bool {
+
+	if p.ContentType == src {
+		return true
+	} else if p.ContentType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ContentType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ColumnAnnotation) Field15DeepEqual(src *tag.TagContentSpec) bool {
+
+	if !p.ContentSpec.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ExptResultExportRecord struct {
+	ExportID        int64            `thrift:"export_id,1,required" frugal:"1,required,i64" json:"export_id" form:"export_id,required" query:"export_id,required"`
+	WorkspaceID     int64            `thrift:"workspace_id,2,required" frugal:"2,required,i64" json:"workspace_id" form:"workspace_id,required" query:"workspace_id,required"`
+	ExptID          int64            `thrift:"expt_id,3,required" frugal:"3,required,i64" json:"expt_id" form:"expt_id,required" query:"expt_id,required"`
+	CsvExportStatus CSVExportStatus  `thrift:"csv_export_status,4,required" frugal:"4,required,string" form:"csv_export_status,required" json:"csv_export_status,required" query:"csv_export_status,required"`
+	BaseInfo        *common.BaseInfo `thrift:"base_info,5,optional" frugal:"5,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+	StartTime       *int64           `thrift:"start_time,6,optional" frugal:"6,optional,i64" json:"start_time" form:"start_time" query:"start_time"`
+	EndTime         *int64           `thrift:"end_time,7,optional" frugal:"7,optional,i64" json:"end_time" form:"end_time" query:"end_time"`
+	// deprecated, cause not match snake name
+	URL     *string   `thrift:"URL,8,optional" frugal:"8,optional,string" form:"URL" json:"URL,omitempty" query:"URL"`
+	Expired *bool     `thrift:"expired,9,optional" frugal:"9,optional,bool" form:"expired" json:"expired,omitempty" query:"expired"`
+	Error   *RunError `thrift:"error,10,optional" frugal:"10,optional,RunError" form:"error" json:"error,omitempty" query:"error"`
+	URL_    *string   `thrift:"url,11,optional" frugal:"11,optional,string" form:"url" json:"url,omitempty" query:"url"`
+}
+
+func NewExptResultExportRecord() *ExptResultExportRecord {
+	return &ExptResultExportRecord{}
+}
+
+func (p *ExptResultExportRecord) InitDefault() {
+}
+
+func (p *ExptResultExportRecord) GetExportID() (v int64) {
+	if p != nil {
+		return p.ExportID
+	}
+	return
+}
+
+func (p *ExptResultExportRecord) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
+	}
+	return
+}
+
+func (p *ExptResultExportRecord) GetExptID() (v int64) {
+	if p != nil {
+		return p.ExptID
+	}
+	return
+}
+
+func (p *ExptResultExportRecord) GetCsvExportStatus() (v CSVExportStatus) {
+	if p != nil {
+		return p.CsvExportStatus
+	}
+	return
+}
+
+var ExptResultExportRecord_BaseInfo_DEFAULT *common.BaseInfo
+
+func (p *ExptResultExportRecord) GetBaseInfo() (v *common.BaseInfo) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseInfo() {
+		return ExptResultExportRecord_BaseInfo_DEFAULT
+	}
+	return p.BaseInfo
+}
+
+var ExptResultExportRecord_StartTime_DEFAULT int64
+
+func (p *ExptResultExportRecord) GetStartTime() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStartTime() {
+		return ExptResultExportRecord_StartTime_DEFAULT
+	}
+	return *p.StartTime
+}
+
+var ExptResultExportRecord_EndTime_DEFAULT int64
+
+func (p *ExptResultExportRecord) GetEndTime() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEndTime() {
+		return ExptResultExportRecord_EndTime_DEFAULT
+	}
+	return *p.EndTime
+}
+
+var ExptResultExportRecord_URL_DEFAULT string
+
+func (p *ExptResultExportRecord) GetURL() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetURL() {
+		return ExptResultExportRecord_URL_DEFAULT
+	}
+	return *p.URL
+}
+
+var ExptResultExportRecord_Expired_DEFAULT bool
+
+func (p *ExptResultExportRecord) GetExpired() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExpired() {
+		return ExptResultExportRecord_Expired_DEFAULT
+	}
+	return *p.Expired
+}
+
+var ExptResultExportRecord_Error_DEFAULT *RunError
+
+func (p *ExptResultExportRecord) GetError() (v *RunError) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetError() {
+		return ExptResultExportRecord_Error_DEFAULT
+	}
+	return p.Error
+}
+
+var ExptResultExportRecord_URL__DEFAULT string
+
+func (p *ExptResultExportRecord) GetURL_() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetURL_() {
+		return ExptResultExportRecord_URL__DEFAULT
+	}
+	return *p.URL_
+}
+func (p *ExptResultExportRecord) SetExportID(val int64) {
+	p.ExportID = val
+}
+func (p *ExptResultExportRecord) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
+}
+func (p *ExptResultExportRecord) SetExptID(val int64) {
+	p.ExptID = val
+}
+func (p *ExptResultExportRecord) SetCsvExportStatus(val CSVExportStatus) {
+	p.CsvExportStatus = val
+}
+func (p *ExptResultExportRecord) SetBaseInfo(val *common.BaseInfo) {
+	p.BaseInfo = val
+}
+func (p *ExptResultExportRecord) SetStartTime(val *int64) {
+	p.StartTime = val
+}
+func (p *ExptResultExportRecord) SetEndTime(val *int64) {
+	p.EndTime = val
+}
+func (p *ExptResultExportRecord) SetURL(val *string) {
+	p.URL = val
+}
+func (p *ExptResultExportRecord) SetExpired(val *bool) {
+	p.Expired = val
+}
+func (p *ExptResultExportRecord) SetError(val *RunError) {
+	p.Error = val
+}
+func (p *ExptResultExportRecord) SetURL_(val *string) {
+	p.URL_ = val
+}
+
+var fieldIDToName_ExptResultExportRecord = map[int16]string{
+	1:  "export_id",
+	2:  "workspace_id",
+	3:  "expt_id",
+	4:  "csv_export_status",
+	5:  "base_info",
+	6:  "start_time",
+	7:  "end_time",
+	8:  "URL",
+	9:  "expired",
+	10: "error",
+	11: "url",
+}
+
+func (p *ExptResultExportRecord) IsSetBaseInfo() bool {
+	return p.BaseInfo != nil
+}
+
+func (p *ExptResultExportRecord) IsSetStartTime() bool {
+	return p.StartTime != nil
+}
+
+func (p *ExptResultExportRecord) IsSetEndTime() bool {
+	return p.EndTime != nil
+}
+
+func (p *ExptResultExportRecord) IsSetURL() bool {
+	return p.URL != nil
+}
+
+func (p *ExptResultExportRecord) IsSetExpired() bool {
+	return p.Expired != nil
+}
+
+func (p *ExptResultExportRecord) IsSetError() bool {
+	return p.Error != nil
+}
+
+func (p *ExptResultExportRecord) IsSetURL_() bool {
+	return p.URL_ != nil
+}
+
+func (p *ExptResultExportRecord) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetExportID bool = false
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	var issetCsvExportStatus bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetExportID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCsvExportStatus = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetExportID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCsvExportStatus {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptResultExportRecord[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptResultExportRecord[fieldId]))
+}
+
+func (p *ExptResultExportRecord) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ExportID = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ExptID = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field CSVExportStatus
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CsvExportStatus = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField5(iprot thrift.TProtocol) error {
+	_field := common.NewBaseInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseInfo = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StartTime = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EndTime = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.URL = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Expired = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField10(iprot thrift.TProtocol) error {
+	_field := NewRunError()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Error = _field
+	return nil
+}
+func (p *ExptResultExportRecord) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.URL_ = _field
+	return nil
+}
+
+func (p *ExptResultExportRecord) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptResultExportRecord"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptResultExportRecord) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("export_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ExportID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("expt_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ExptID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("csv_export_status", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CsvExportStatus); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseInfo() {
+		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStartTime() {
+		if err = oprot.WriteFieldBegin("start_time", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.StartTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEndTime() {
+		if err = oprot.WriteFieldBegin("end_time", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EndTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetURL() {
+		if err = oprot.WriteFieldBegin("URL", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.URL); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExpired() {
+		if err = oprot.WriteFieldBegin("expired", thrift.BOOL, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Expired); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetError() {
+		if err = oprot.WriteFieldBegin("error", thrift.STRUCT, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Error.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *ExptResultExportRecord) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetURL_() {
+		if err = oprot.WriteFieldBegin("url", thrift.STRING, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.URL_); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
+func (p *ExptResultExportRecord) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptResultExportRecord(%+v)", *p)
+
+}
+
+func (p *ExptResultExportRecord) DeepEqual(ano *ExptResultExportRecord) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ExportID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ExptID) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.CsvExportStatus) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.BaseInfo) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.StartTime) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.EndTime) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.URL) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.Expired) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.Error) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.URL_) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptResultExportRecord) Field1DeepEqual(src int64) bool {
+
+	if p.ExportID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field2DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field3DeepEqual(src int64) bool {
+
+	if p.ExptID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field4DeepEqual(src CSVExportStatus) bool {
+
+	if strings.Compare(p.CsvExportStatus, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field5DeepEqual(src *common.BaseInfo) bool {
+
+	if !p.BaseInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field6DeepEqual(src *int64) bool {
+
+	if p.StartTime == src {
+		return true
+	} else if p.StartTime == nil || src == nil {
+		return false
+	}
+	if *p.StartTime != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field7DeepEqual(src *int64) bool {
+
+	if p.EndTime == src {
+		return true
+	} else if p.EndTime == nil || src == nil {
+		return false
+	}
+	if *p.EndTime != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field8DeepEqual(src *string) bool {
+
+	if p.URL == src {
+		return true
+	} else if p.URL == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.URL, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field9DeepEqual(src *bool) bool {
+
+	if p.Expired == src {
+		return true
+	} else if p.Expired == nil || src == nil {
+		return false
+	}
+	if *p.Expired != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field10DeepEqual(src *RunError) bool {
+
+	if !p.Error.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExptResultExportRecord) Field11DeepEqual(src *string) bool {
+
+	if p.URL_ == src {
+		return true
+	} else if p.URL_ == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.URL_, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+// 洞察分析记录
+type ExptInsightAnalysisRecord struct {
+	RecordID                    int64                        `thrift:"record_id,1,required" frugal:"1,required,i64" json:"record_id" form:"record_id,required" query:"record_id,required"`
+	WorkspaceID                 int64                        `thrift:"workspace_id,2,required" frugal:"2,required,i64" json:"workspace_id" form:"workspace_id,required" query:"workspace_id,required"`
+	ExptID                      int64                        `thrift:"expt_id,3,required" frugal:"3,required,i64" json:"expt_id" form:"expt_id,required" query:"expt_id,required"`
+	AnalysisStatus              InsightAnalysisStatus        `thrift:"analysis_status,4,required" frugal:"4,required,string" form:"analysis_status,required" json:"analysis_status,required" query:"analysis_status,required"`
+	AnalysisReportID            *int64                       `thrift:"analysis_report_id,5,optional" frugal:"5,optional,i64" json:"analysis_report_id" form:"analysis_report_id" query:"analysis_report_id"`
+	AnalysisReportContent       *string                      `thrift:"analysis_report_content,6,optional" frugal:"6,optional,string" form:"analysis_report_content" json:"analysis_report_content,omitempty" query:"analysis_report_content"`
+	ExptInsightAnalysisFeedback *ExptInsightAnalysisFeedback `thrift:"expt_insight_analysis_feedback,7,optional" frugal:"7,optional,ExptInsightAnalysisFeedback" form:"expt_insight_analysis_feedback" json:"expt_insight_analysis_feedback,omitempty" query:"expt_insight_analysis_feedback"`
+	BaseInfo                    *common.BaseInfo             `thrift:"base_info,8,optional" frugal:"8,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+	AnalysisReportIndex         []*ExptInsightAnalysisIndex  `thrift:"analysis_report_index,21,optional" frugal:"21,optional,list<ExptInsightAnalysisIndex>" form:"analysis_report_index" json:"analysis_report_index,omitempty" query:"analysis_report_index"`
+}
+
+func NewExptInsightAnalysisRecord() *ExptInsightAnalysisRecord {
+	return &ExptInsightAnalysisRecord{}
+}
+
+func (p *ExptInsightAnalysisRecord) InitDefault() {
+}
+
+func (p *ExptInsightAnalysisRecord) GetRecordID() (v int64) {
+	if p != nil {
+		return p.RecordID
+	}
+	return
+}
+
+func (p *ExptInsightAnalysisRecord) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
+	}
+	return
+}
+
+func (p *ExptInsightAnalysisRecord) GetExptID() (v int64) {
+	if p != nil {
+		return p.ExptID
+	}
+	return
+}
+
+func (p *ExptInsightAnalysisRecord) GetAnalysisStatus() (v InsightAnalysisStatus) {
+	if p != nil {
+		return p.AnalysisStatus
+	}
+	return
+}
+
+var ExptInsightAnalysisRecord_AnalysisReportID_DEFAULT int64
+
+func (p *ExptInsightAnalysisRecord) GetAnalysisReportID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAnalysisReportID() {
+		return ExptInsightAnalysisRecord_AnalysisReportID_DEFAULT
+	}
+	return *p.AnalysisReportID
+}
+
+var ExptInsightAnalysisRecord_AnalysisReportContent_DEFAULT string
+
+func (p *ExptInsightAnalysisRecord) GetAnalysisReportContent() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAnalysisReportContent() {
+		return ExptInsightAnalysisRecord_AnalysisReportContent_DEFAULT
+	}
+	return *p.AnalysisReportContent
+}
+
+var ExptInsightAnalysisRecord_ExptInsightAnalysisFeedback_DEFAULT *ExptInsightAnalysisFeedback
+
+func (p *ExptInsightAnalysisRecord) GetExptInsightAnalysisFeedback() (v *ExptInsightAnalysisFeedback) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExptInsightAnalysisFeedback() {
+		return ExptInsightAnalysisRecord_ExptInsightAnalysisFeedback_DEFAULT
+	}
+	return p.ExptInsightAnalysisFeedback
+}
+
+var ExptInsightAnalysisRecord_BaseInfo_DEFAULT *common.BaseInfo
+
+func (p *ExptInsightAnalysisRecord) GetBaseInfo() (v *common.BaseInfo) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseInfo() {
+		return ExptInsightAnalysisRecord_BaseInfo_DEFAULT
+	}
+	return p.BaseInfo
+}
+
+var ExptInsightAnalysisRecord_AnalysisReportIndex_DEFAULT []*ExptInsightAnalysisIndex
+
+func (p *ExptInsightAnalysisRecord) GetAnalysisReportIndex() (v []*ExptInsightAnalysisIndex) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAnalysisReportIndex() {
+		return ExptInsightAnalysisRecord_AnalysisReportIndex_DEFAULT
+	}
+	return p.AnalysisReportIndex
+}
+func (p *ExptInsightAnalysisRecord) SetRecordID(val int64) {
+	p.RecordID = val
+}
+func (p *ExptInsightAnalysisRecord) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
+}
+func (p *ExptInsightAnalysisRecord) SetExptID(val int64) {
+	p.ExptID = val
+}
+func (p *ExptInsightAnalysisRecord) SetAnalysisStatus(val InsightAnalysisStatus) {
+	p.AnalysisStatus = val
+}
+func (p *ExptInsightAnalysisRecord) SetAnalysisReportID(val *int64) {
+	p.AnalysisReportID = val
+}
+func (p *ExptInsightAnalysisRecord) SetAnalysisReportContent(val *string) {
+	p.AnalysisReportContent = val
+}
+func (p *ExptInsightAnalysisRecord) SetExptInsightAnalysisFeedback(val *ExptInsightAnalysisFeedback) {
+	p.ExptInsightAnalysisFeedback = val
+}
+func (p *ExptInsightAnalysisRecord) SetBaseInfo(val *common.BaseInfo) {
+	p.BaseInfo = val
+}
+func (p *ExptInsightAnalysisRecord) SetAnalysisReportIndex(val []*ExptInsightAnalysisIndex) {
+	p.AnalysisReportIndex = val
+}
+
+var fieldIDToName_ExptInsightAnalysisRecord = map[int16]string{
+	1:  "record_id",
+	2:  "workspace_id",
+	3:  "expt_id",
+	4:  "analysis_status",
+	5:  "analysis_report_id",
+	6:  "analysis_report_content",
+	7:  "expt_insight_analysis_feedback",
+	8:  "base_info",
+	21: "analysis_report_index",
+}
+
+func (p *ExptInsightAnalysisRecord) IsSetAnalysisReportID() bool {
+	return p.AnalysisReportID != nil
+}
+
+func (p *ExptInsightAnalysisRecord) IsSetAnalysisReportContent() bool {
+	return p.AnalysisReportContent != nil
+}
+
+func (p *ExptInsightAnalysisRecord) IsSetExptInsightAnalysisFeedback() bool {
+	return p.ExptInsightAnalysisFeedback != nil
+}
+
+func (p *ExptInsightAnalysisRecord) IsSetBaseInfo() bool {
+	return p.BaseInfo != nil
+}
+
+func (p *ExptInsightAnalysisRecord) IsSetAnalysisReportIndex() bool {
+	return p.AnalysisReportIndex != nil
+}
+
+func (p *ExptInsightAnalysisRecord) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetRecordID bool = false
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	var issetAnalysisStatus bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRecordID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAnalysisStatus = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 21:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField21(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetRecordID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAnalysisStatus {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptInsightAnalysisRecord[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptInsightAnalysisRecord[fieldId]))
+}
+
+func (p *ExptInsightAnalysisRecord) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RecordID = _field
+	return nil
+}
+func (p *ExptInsightAnalysisRecord) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *ExptInsightAnalysisRecord) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ExptID = _field
+	return nil
+}
+func (p *ExptInsightAnalysisRecord) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field InsightAnalysisStatus
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AnalysisStatus = _field
+	return nil
+}
+func (p *ExptInsightAnalysisRecord) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.AnalysisReportID = _field
+	return nil
+}
+func (p *ExptInsightAnalysisRecord) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.AnalysisReportContent = _field
+	return nil
+}
+func (p *ExptInsightAnalysisRecord) ReadField7(iprot thrift.TProtocol) error {
+	_field := NewExptInsightAnalysisFeedback()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ExptInsightAnalysisFeedback = _field
+	return nil
+}
+func (p *ExptInsightAnalysisRecord) ReadField8(iprot thrift.TProtocol) error {
+	_field := common.NewBaseInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseInfo = _field
+	return nil
+}
+func (p *ExptInsightAnalysisRecord) ReadField21(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExptInsightAnalysisIndex, 0, size)
+	values := make([]ExptInsightAnalysisIndex, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.AnalysisReportIndex = _field
+	return nil
+}
+
+func (p *ExptInsightAnalysisRecord) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptInsightAnalysisRecord"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField21(oprot); err != nil {
+			fieldId = 21
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptInsightAnalysisRecord) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("record_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RecordID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptInsightAnalysisRecord) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptInsightAnalysisRecord) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("expt_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ExptID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptInsightAnalysisRecord) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("analysis_status", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AnalysisStatus); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptInsightAnalysisRecord) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAnalysisReportID() {
+		if err = oprot.WriteFieldBegin("analysis_report_id", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.AnalysisReportID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptInsightAnalysisRecord) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAnalysisReportContent() {
+		if err = oprot.WriteFieldBegin("analysis_report_content", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.AnalysisReportContent); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ExptInsightAnalysisRecord) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExptInsightAnalysisFeedback() {
+		if err = oprot.WriteFieldBegin("expt_insight_analysis_feedback", thrift.STRUCT, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ExptInsightAnalysisFeedback.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ExptInsightAnalysisRecord) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseInfo() {
+		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *ExptInsightAnalysisRecord) writeField21(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAnalysisReportIndex() {
+		if err = oprot.WriteFieldBegin("analysis_report_index", thrift.LIST, 21); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.AnalysisReportIndex)); err != nil {
+			return err
+		}
+		for _, v := range p.AnalysisReportIndex {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 21 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 21 end error: ", p), err)
+}
+
+func (p *ExptInsightAnalysisRecord) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptInsightAnalysisRecord(%+v)", *p)
+
+}
+
+func (p *ExptInsightAnalysisRecord) DeepEqual(ano *ExptInsightAnalysisRecord) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.RecordID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ExptID) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.AnalysisStatus) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.AnalysisReportID) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.AnalysisReportContent) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.ExptInsightAnalysisFeedback) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.BaseInfo) {
+		return false
+	}
+	if !p.Field21DeepEqual(ano.AnalysisReportIndex) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptInsightAnalysisRecord) Field1DeepEqual(src int64) bool {
+
+	if p.RecordID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptInsightAnalysisRecord) Field2DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptInsightAnalysisRecord) Field3DeepEqual(src int64) bool {
+
+	if p.ExptID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptInsightAnalysisRecord) Field4DeepEqual(src InsightAnalysisStatus) bool {
+
+	if strings.Compare(p.AnalysisStatus, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptInsightAnalysisRecord) Field5DeepEqual(src *int64) bool {
+
+	if p.AnalysisReportID == src {
+		return true
+	} else if p.AnalysisReportID == nil || src == nil {
+		return false
+	}
+	if *p.AnalysisReportID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptInsightAnalysisRecord) Field6DeepEqual(src *string) bool {
+
+	if p.AnalysisReportContent == src {
+		return true
+	} else if p.AnalysisReportContent == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.AnalysisReportContent, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptInsightAnalysisRecord) Field7DeepEqual(src *ExptInsightAnalysisFeedback) bool {
+
+	if !p.ExptInsightAnalysisFeedback.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExptInsightAnalysisRecord) Field8DeepEqual(src *common.BaseInfo) bool {
+
+	if !p.BaseInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExptInsightAnalysisRecord) Field21DeepEqual(src []*ExptInsightAnalysisIndex) bool {
+
+	if len(p.AnalysisReportIndex) != len(src) {
+		return false
+	}
+	for i, v := range p.AnalysisReportIndex {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type ExptInsightAnalysisIndex struct {
+	ID    *string `thrift:"id,1,optional" frugal:"1,optional,string" form:"id" json:"id,omitempty" query:"id"`
+	Title *string `thrift:"title,2,optional" frugal:"2,optional,string" form:"title" json:"title,omitempty" query:"title"`
+}
+
+func NewExptInsightAnalysisIndex() *ExptInsightAnalysisIndex {
+	return &ExptInsightAnalysisIndex{}
+}
+
+func (p *ExptInsightAnalysisIndex) InitDefault() {
+}
+
+var ExptInsightAnalysisIndex_ID_DEFAULT string
+
+func (p *ExptInsightAnalysisIndex) GetID() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetID() {
+		return ExptInsightAnalysisIndex_ID_DEFAULT
+	}
+	return *p.ID
+}
+
+var ExptInsightAnalysisIndex_Title_DEFAULT string
+
+func (p *ExptInsightAnalysisIndex) GetTitle() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTitle() {
+		return ExptInsightAnalysisIndex_Title_DEFAULT
+	}
+	return *p.Title
+}
+func (p *ExptInsightAnalysisIndex) SetID(val *string) {
+	p.ID = val
+}
+func (p *ExptInsightAnalysisIndex) SetTitle(val *string) {
+	p.Title = val
+}
+
+var fieldIDToName_ExptInsightAnalysisIndex = map[int16]string{
+	1: "id",
+	2: "title",
+}
+
+func (p *ExptInsightAnalysisIndex) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *ExptInsightAnalysisIndex) IsSetTitle() bool {
+	return p.Title != nil
+}
+
+func (p *ExptInsightAnalysisIndex) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptInsightAnalysisIndex[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptInsightAnalysisIndex) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *ExptInsightAnalysisIndex) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Title = _field
+	return nil
+}
+
+func (p *ExptInsightAnalysisIndex) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptInsightAnalysisIndex"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptInsightAnalysisIndex) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptInsightAnalysisIndex) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTitle() {
+		if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Title); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ExptInsightAnalysisIndex) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptInsightAnalysisIndex(%+v)", *p)
+
+}
+
+func (p *ExptInsightAnalysisIndex) DeepEqual(ano *ExptInsightAnalysisIndex) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Title) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptInsightAnalysisIndex) Field1DeepEqual(src *string) bool {
+
+	if p.ID == src {
+		return true
+	} else if p.ID == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ID, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptInsightAnalysisIndex) Field2DeepEqual(src *string) bool {
+
+	if p.Title == src {
+		return true
+	} else if p.Title == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Title, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+// 洞察分析反馈统计
+type ExptInsightAnalysisFeedback struct {
+	UpvoteCnt   *int32 `thrift:"upvote_cnt,1,optional" frugal:"1,optional,i32" form:"upvote_cnt" json:"upvote_cnt,omitempty" query:"upvote_cnt"`
+	DownvoteCnt *int32 `thrift:"downvote_cnt,2,optional" frugal:"2,optional,i32" form:"downvote_cnt" json:"downvote_cnt,omitempty" query:"downvote_cnt"`
+	// 当前用户点赞状态，用于展示用户是否已点赞点踩
+	CurrentUserVoteType *InsightAnalysisReportVoteType `thrift:"current_user_vote_type,3,optional" frugal:"3,optional,string" form:"current_user_vote_type" json:"current_user_vote_type,omitempty" query:"current_user_vote_type"`
+}
+
+func NewExptInsightAnalysisFeedback() *ExptInsightAnalysisFeedback {
+	return &ExptInsightAnalysisFeedback{}
+}
+
+func (p *ExptInsightAnalysisFeedback) InitDefault() {
+}
+
+var ExptInsightAnalysisFeedback_UpvoteCnt_DEFAULT int32
+
+func (p *ExptInsightAnalysisFeedback) GetUpvoteCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUpvoteCnt() {
+		return ExptInsightAnalysisFeedback_UpvoteCnt_DEFAULT
+	}
+	return *p.UpvoteCnt
+}
+
+var ExptInsightAnalysisFeedback_DownvoteCnt_DEFAULT int32
+
+func (p *ExptInsightAnalysisFeedback) GetDownvoteCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDownvoteCnt() {
+		return ExptInsightAnalysisFeedback_DownvoteCnt_DEFAULT
+	}
+	return *p.DownvoteCnt
+}
+
+var ExptInsightAnalysisFeedback_CurrentUserVoteType_DEFAULT InsightAnalysisReportVoteType
+
+func (p *ExptInsightAnalysisFeedback) GetCurrentUserVoteType() (v InsightAnalysisReportVoteType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCurrentUserVoteType() {
+		return ExptInsightAnalysisFeedback_CurrentUserVoteType_DEFAULT
+	}
+	return *p.CurrentUserVoteType
+}
+func (p *ExptInsightAnalysisFeedback) SetUpvoteCnt(val *int32) {
+	p.UpvoteCnt = val
+}
+func (p *ExptInsightAnalysisFeedback) SetDownvoteCnt(val *int32) {
+	p.DownvoteCnt = val
+}
+func (p *ExptInsightAnalysisFeedback) SetCurrentUserVoteType(val *InsightAnalysisReportVoteType) {
+	p.CurrentUserVoteType = val
+}
+
+var fieldIDToName_ExptInsightAnalysisFeedback = map[int16]string{
+	1: "upvote_cnt",
+	2: "downvote_cnt",
+	3: "current_user_vote_type",
+}
+
+func (p *ExptInsightAnalysisFeedback) IsSetUpvoteCnt() bool {
+	return p.UpvoteCnt != nil
+}
+
+func (p *ExptInsightAnalysisFeedback) IsSetDownvoteCnt() bool {
+	return p.DownvoteCnt != nil
+}
+
+func (p *ExptInsightAnalysisFeedback) IsSetCurrentUserVoteType() bool {
+	return p.CurrentUserVoteType != nil
+}
+
+func (p *ExptInsightAnalysisFeedback) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptInsightAnalysisFeedback[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptInsightAnalysisFeedback) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UpvoteCnt = _field
+	return nil
+}
+func (p *ExptInsightAnalysisFeedback) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DownvoteCnt = _field
+	return nil
+}
+func (p *ExptInsightAnalysisFeedback) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *InsightAnalysisReportVoteType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CurrentUserVoteType = _field
+	return nil
+}
+
+func (p *ExptInsightAnalysisFeedback) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptInsightAnalysisFeedback"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptInsightAnalysisFeedback) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUpvoteCnt() {
+		if err = oprot.WriteFieldBegin("upvote_cnt", thrift.I32, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.UpvoteCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptInsightAnalysisFeedback) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDownvoteCnt() {
+		if err = oprot.WriteFieldBegin("downvote_cnt", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.DownvoteCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptInsightAnalysisFeedback) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCurrentUserVoteType() {
+		if err = oprot.WriteFieldBegin("current_user_vote_type", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.CurrentUserVoteType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ExptInsightAnalysisFeedback) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptInsightAnalysisFeedback(%+v)", *p)
+
+}
+
+func (p *ExptInsightAnalysisFeedback) DeepEqual(ano *ExptInsightAnalysisFeedback) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UpvoteCnt) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.DownvoteCnt) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.CurrentUserVoteType) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptInsightAnalysisFeedback) Field1DeepEqual(src *int32) bool {
+
+	if p.UpvoteCnt == src {
+		return true
+	} else if p.UpvoteCnt == nil || src == nil {
+		return false
+	}
+	if *p.UpvoteCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptInsightAnalysisFeedback) Field2DeepEqual(src *int32) bool {
+
+	if p.DownvoteCnt == src {
+		return true
+	} else if p.DownvoteCnt == nil || src == nil {
+		return false
+	}
+	if *p.DownvoteCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptInsightAnalysisFeedback) Field3DeepEqual(src *InsightAnalysisReportVoteType) bool {
+
+	if p.CurrentUserVoteType == src {
+		return true
+	} else if p.CurrentUserVoteType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.CurrentUserVoteType, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+// 洞察分析反馈评论
+type ExptInsightAnalysisFeedbackComment struct {
+	CommentID   int64            `thrift:"comment_id,1,required" frugal:"1,required,i64" json:"comment_id" form:"comment_id,required" query:"comment_id,required"`
+	WorkspaceID int64            `thrift:"workspace_id,2,required" frugal:"2,required,i64" json:"workspace_id" form:"workspace_id,required" query:"workspace_id,required"`
+	ExptID      int64            `thrift:"expt_id,3,required" frugal:"3,required,i64" json:"expt_id" form:"expt_id,required" query:"expt_id,required"`
+	RecordID    int64            `thrift:"record_id,4,required" frugal:"4,required,i64" json:"record_id" form:"record_id,required" query:"record_id,required"`
+	Content     string           `thrift:"content,5,required" frugal:"5,required,string" form:"content,required" json:"content,required" query:"content,required"`
+	BaseInfo    *common.BaseInfo `thrift:"base_info,6,optional" frugal:"6,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+}
+
+func NewExptInsightAnalysisFeedbackComment() *ExptInsightAnalysisFeedbackComment {
+	return &ExptInsightAnalysisFeedbackComment{}
+}
+
+func (p *ExptInsightAnalysisFeedbackComment) InitDefault() {
+}
+
+func (p *ExptInsightAnalysisFeedbackComment) GetCommentID() (v int64) {
+	if p != nil {
+		return p.CommentID
+	}
+	return
+}
+
+func (p *ExptInsightAnalysisFeedbackComment) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
+	}
+	return
+}
+
+func (p *ExptInsightAnalysisFeedbackComment) GetExptID() (v int64) {
+	if p != nil {
+		return p.ExptID
+	}
+	return
+}
+
+func (p *ExptInsightAnalysisFeedbackComment) GetRecordID() (v int64) {
+	if p != nil {
+		return p.RecordID
+	}
+	return
+}
+
+func (p *ExptInsightAnalysisFeedbackComment) GetContent() (v string) {
+	if p != nil {
+		return p.Content
+	}
+	return
+}
+
+var ExptInsightAnalysisFeedbackComment_BaseInfo_DEFAULT *common.BaseInfo
+
+func (p *ExptInsightAnalysisFeedbackComment) GetBaseInfo() (v *common.BaseInfo) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseInfo() {
+		return ExptInsightAnalysisFeedbackComment_BaseInfo_DEFAULT
+	}
+	return p.BaseInfo
+}
+func (p *ExptInsightAnalysisFeedbackComment) SetCommentID(val int64) {
+	p.CommentID = val
+}
+func (p *ExptInsightAnalysisFeedbackComment) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
+}
+func (p *ExptInsightAnalysisFeedbackComment) SetExptID(val int64) {
+	p.ExptID = val
+}
+func (p *ExptInsightAnalysisFeedbackComment) SetRecordID(val int64) {
+	p.RecordID = val
+}
+func (p *ExptInsightAnalysisFeedbackComment) SetContent(val string) {
+	p.Content = val
+}
+func (p *ExptInsightAnalysisFeedbackComment) SetBaseInfo(val *common.BaseInfo) {
+	p.BaseInfo = val
+}
+
+var fieldIDToName_ExptInsightAnalysisFeedbackComment = map[int16]string{
+	1: "comment_id",
+	2: "workspace_id",
+	3: "expt_id",
+	4: "record_id",
+	5: "content",
+	6: "base_info",
+}
+
+func (p *ExptInsightAnalysisFeedbackComment) IsSetBaseInfo() bool {
+	return p.BaseInfo != nil
+}
+
+func (p *ExptInsightAnalysisFeedbackComment) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetCommentID bool = false
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	var issetRecordID bool = false
+	var issetContent bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCommentID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRecordID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetContent = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetCommentID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRecordID {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetContent {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptInsightAnalysisFeedbackComment[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptInsightAnalysisFeedbackComment[fieldId]))
+}
+
+func (p *ExptInsightAnalysisFeedbackComment) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CommentID = _field
+	return nil
+}
+func (p *ExptInsightAnalysisFeedbackComment) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *ExptInsightAnalysisFeedbackComment) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ExptID = _field
+	return nil
+}
+func (p *ExptInsightAnalysisFeedbackComment) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RecordID = _field
+	return nil
+}
+func (p *ExptInsightAnalysisFeedbackComment) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Content = _field
+	return nil
+}
+func (p *ExptInsightAnalysisFeedbackComment) ReadField6(iprot thrift.TProtocol) error {
+	_field := common.NewBaseInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseInfo = _field
+	return nil
+}
+
+func (p *ExptInsightAnalysisFeedbackComment) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptInsightAnalysisFeedbackComment"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptInsightAnalysisFeedbackComment) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("comment_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CommentID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptInsightAnalysisFeedbackComment) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptInsightAnalysisFeedbackComment) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("expt_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ExptID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptInsightAnalysisFeedbackComment) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("record_id", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RecordID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptInsightAnalysisFeedbackComment) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Content); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptInsightAnalysisFeedbackComment) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseInfo() {
+		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *ExptInsightAnalysisFeedbackComment) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptInsightAnalysisFeedbackComment(%+v)", *p)
+
+}
+
+func (p *ExptInsightAnalysisFeedbackComment) DeepEqual(ano *ExptInsightAnalysisFeedbackComment) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.CommentID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ExptID) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.RecordID) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Content) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.BaseInfo) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptInsightAnalysisFeedbackComment) Field1DeepEqual(src int64) bool {
+
+	if p.CommentID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptInsightAnalysisFeedbackComment) Field2DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptInsightAnalysisFeedbackComment) Field3DeepEqual(src int64) bool {
+
+	if p.ExptID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptInsightAnalysisFeedbackComment) Field4DeepEqual(src int64) bool {
+
+	if p.RecordID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptInsightAnalysisFeedbackComment) Field5DeepEqual(src string) bool {
+
+	if strings.Compare(p.Content, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptInsightAnalysisFeedbackComment) Field6DeepEqual(src *common.BaseInfo) bool {
+
+	if !p.BaseInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ExptInsightAnalysisFeedbackVote struct {
+	ID                 *int64              `thrift:"id,1,optional" frugal:"1,optional,i64" json:"comment_id" form:"id" query:"id"`
+	FeedbackActionType *FeedbackActionType `thrift:"feedback_action_type,2,optional" frugal:"2,optional,string" form:"feedback_action_type" json:"feedback_action_type,omitempty" query:"feedback_action_type"`
+}
+
+func NewExptInsightAnalysisFeedbackVote() *ExptInsightAnalysisFeedbackVote {
+	return &ExptInsightAnalysisFeedbackVote{}
+}
+
+func (p *ExptInsightAnalysisFeedbackVote) InitDefault() {
+}
+
+var ExptInsightAnalysisFeedbackVote_ID_DEFAULT int64
+
+func (p *ExptInsightAnalysisFeedbackVote) GetID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetID() {
+		return ExptInsightAnalysisFeedbackVote_ID_DEFAULT
+	}
+	return *p.ID
+}
+
+var ExptInsightAnalysisFeedbackVote_FeedbackActionType_DEFAULT FeedbackActionType
+
+func (p *ExptInsightAnalysisFeedbackVote) GetFeedbackActionType() (v FeedbackActionType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFeedbackActionType() {
+		return ExptInsightAnalysisFeedbackVote_FeedbackActionType_DEFAULT
+	}
+	return *p.FeedbackActionType
+}
+func (p *ExptInsightAnalysisFeedbackVote) SetID(val *int64) {
+	p.ID = val
+}
+func (p *ExptInsightAnalysisFeedbackVote) SetFeedbackActionType(val *FeedbackActionType) {
+	p.FeedbackActionType = val
+}
+
+var fieldIDToName_ExptInsightAnalysisFeedbackVote = map[int16]string{
+	1: "id",
+	2: "feedback_action_type",
+}
+
+func (p *ExptInsightAnalysisFeedbackVote) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *ExptInsightAnalysisFeedbackVote) IsSetFeedbackActionType() bool {
+	return p.FeedbackActionType != nil
+}
+
+func (p *ExptInsightAnalysisFeedbackVote) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptInsightAnalysisFeedbackVote[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptInsightAnalysisFeedbackVote) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *ExptInsightAnalysisFeedbackVote) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *FeedbackActionType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FeedbackActionType = _field
+	return nil
+}
+
+func (p *ExptInsightAnalysisFeedbackVote) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptInsightAnalysisFeedbackVote"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptInsightAnalysisFeedbackVote) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptInsightAnalysisFeedbackVote) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFeedbackActionType() {
+		if err = oprot.WriteFieldBegin("feedback_action_type", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.FeedbackActionType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ExptInsightAnalysisFeedbackVote) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptInsightAnalysisFeedbackVote(%+v)", *p)
+
+}
+
+func (p *ExptInsightAnalysisFeedbackVote) DeepEqual(ano *ExptInsightAnalysisFeedbackVote) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.FeedbackActionType) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptInsightAnalysisFeedbackVote) Field1DeepEqual(src *int64) bool {
+
+	if p.ID == src {
+		return true
+	} else if p.ID == nil || src == nil {
+		return false
+	}
+	if *p.ID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptInsightAnalysisFeedbackVote) Field2DeepEqual(src *FeedbackActionType) bool {
+
+	if p.FeedbackActionType == src {
+		return true
+	} else if p.FeedbackActionType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.FeedbackActionType, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+// 说明: item 圈选 / evaluator 行级过滤复用 data/domain/data_filter.thrift 的 Filter/FilterField
+// (别名 data_filter, 与 observability filter 区分以便 BAM/thriftgo 无歧义解析)
+// 用法: 全集 = 不传; 点选 = item_id in [...]; 条件圈选 = tag 条件
+// 校验白名单(应用层): query_type ∈ {eq,not_eq,in,not_in}; 单层不嵌套(sub_filter 必空); field_name ∈ {item_id, tag key}; field_type ∈ {long, tag}
+// per-set target 运行配置; 本期 len<=1, alias 恒空 (多 target 实例预留口子)
+type ExptTargetConf struct {
+	TargetID        *int64                      `thrift:"target_id,1,optional" frugal:"1,optional,i64" json:"target_id" form:"target_id" query:"target_id"`
+	TargetVersionID *int64                      `thrift:"target_version_id,2,optional" frugal:"2,optional,i64" json:"target_version_id" form:"target_version_id" query:"target_version_id"`
+	TargetType      *eval_target.EvalTargetType `thrift:"target_type,3,optional" frugal:"3,optional,EvalTargetType" form:"target_type" json:"target_type,omitempty" query:"target_type"`
+	// 本评测集字段 → target 输入
+	FieldMapping *TargetFieldMapping  `thrift:"field_mapping,10,optional" frugal:"10,optional,TargetFieldMapping" form:"field_mapping" json:"field_mapping,omitempty" query:"field_mapping"`
+	RuntimeParam *common.RuntimeParam `thrift:"runtime_param,20,optional" frugal:"20,optional,common.RuntimeParam" form:"runtime_param" json:"runtime_param,omitempty" query:"runtime_param"`
+	// 多实例标识, 本期恒空串
+	Alias *string           `thrift:"alias,30,optional" frugal:"30,optional,string" form:"alias" json:"alias,omitempty" query:"alias"`
+	Ext   map[string]string `thrift:"ext,100,optional" frugal:"100,optional,map<string:string>" form:"ext" json:"ext,omitempty" query:"ext"`
+}
+
+func NewExptTargetConf() *ExptTargetConf {
+	return &ExptTargetConf{}
+}
+
+func (p *ExptTargetConf) InitDefault() {
+}
+
+var ExptTargetConf_TargetID_DEFAULT int64
+
+func (p *ExptTargetConf) GetTargetID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetID() {
+		return ExptTargetConf_TargetID_DEFAULT
+	}
+	return *p.TargetID
+}
+
+var ExptTargetConf_TargetVersionID_DEFAULT int64
+
+func (p *ExptTargetConf) GetTargetVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetVersionID() {
+		return ExptTargetConf_TargetVersionID_DEFAULT
+	}
+	return *p.TargetVersionID
+}
+
+var ExptTargetConf_TargetType_DEFAULT eval_target.EvalTargetType
+
+func (p *ExptTargetConf) GetTargetType() (v eval_target.EvalTargetType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetType() {
+		return ExptTargetConf_TargetType_DEFAULT
+	}
+	return *p.TargetType
+}
+
+var ExptTargetConf_FieldMapping_DEFAULT *TargetFieldMapping
+
+func (p *ExptTargetConf) GetFieldMapping() (v *TargetFieldMapping) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFieldMapping() {
+		return ExptTargetConf_FieldMapping_DEFAULT
+	}
+	return p.FieldMapping
+}
+
+var ExptTargetConf_RuntimeParam_DEFAULT *common.RuntimeParam
+
+func (p *ExptTargetConf) GetRuntimeParam() (v *common.RuntimeParam) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRuntimeParam() {
+		return ExptTargetConf_RuntimeParam_DEFAULT
+	}
+	return p.RuntimeParam
+}
+
+var ExptTargetConf_Alias_DEFAULT string
+
+func (p *ExptTargetConf) GetAlias() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAlias() {
+		return ExptTargetConf_Alias_DEFAULT
+	}
+	return *p.Alias
+}
+
+var ExptTargetConf_Ext_DEFAULT map[string]string
+
+func (p *ExptTargetConf) GetExt() (v map[string]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExt() {
+		return ExptTargetConf_Ext_DEFAULT
+	}
+	return p.Ext
+}
+func (p *ExptTargetConf) SetTargetID(val *int64) {
+	p.TargetID = val
+}
+func (p *ExptTargetConf) SetTargetVersionID(val *int64) {
+	p.TargetVersionID = val
+}
+func (p *ExptTargetConf) SetTargetType(val *eval_target.EvalTargetType) {
+	p.TargetType = val
+}
+func (p *ExptTargetConf) SetFieldMapping(val *TargetFieldMapping) {
+	p.FieldMapping = val
+}
+func (p *ExptTargetConf) SetRuntimeParam(val *common.RuntimeParam) {
+	p.RuntimeParam = val
+}
+func (p *ExptTargetConf) SetAlias(val *string) {
+	p.Alias = val
+}
+func (p *ExptTargetConf) SetExt(val map[string]string) {
+	p.Ext = val
+}
+
+var fieldIDToName_ExptTargetConf = map[int16]string{
+	1:   "target_id",
+	2:   "target_version_id",
+	3:   "target_type",
+	10:  "field_mapping",
+	20:  "runtime_param",
+	30:  "alias",
+	100: "ext",
+}
+
+func (p *ExptTargetConf) IsSetTargetID() bool {
+	return p.TargetID != nil
+}
+
+func (p *ExptTargetConf) IsSetTargetVersionID() bool {
+	return p.TargetVersionID != nil
+}
+
+func (p *ExptTargetConf) IsSetTargetType() bool {
+	return p.TargetType != nil
+}
+
+func (p *ExptTargetConf) IsSetFieldMapping() bool {
+	return p.FieldMapping != nil
+}
+
+func (p *ExptTargetConf) IsSetRuntimeParam() bool {
+	return p.RuntimeParam != nil
+}
+
+func (p *ExptTargetConf) IsSetAlias() bool {
+	return p.Alias != nil
+}
+
+func (p *ExptTargetConf) IsSetExt() bool {
+	return p.Ext != nil
+}
+
+func (p *ExptTargetConf) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 20:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField20(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 30:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField30(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 100:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField100(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptTargetConf[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptTargetConf) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetID = _field
+	return nil
+}
+func (p *ExptTargetConf) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetVersionID = _field
+	return nil
+}
+func (p *ExptTargetConf) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *eval_target.EvalTargetType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := eval_target.EvalTargetType(v)
+		_field = &tmp
+	}
+	p.TargetType = _field
+	return nil
+}
+func (p *ExptTargetConf) ReadField10(iprot thrift.TProtocol) error {
+	_field := NewTargetFieldMapping()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.FieldMapping = _field
+	return nil
+}
+func (p *ExptTargetConf) ReadField20(iprot thrift.TProtocol) error {
+	_field := common.NewRuntimeParam()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.RuntimeParam = _field
+	return nil
+}
+func (p *ExptTargetConf) ReadField30(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Alias = _field
+	return nil
+}
+func (p *ExptTargetConf) ReadField100(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Ext = _field
+	return nil
+}
+
+func (p *ExptTargetConf) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptTargetConf"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField20(oprot); err != nil {
+			fieldId = 20
+			goto WriteFieldError
+		}
+		if err = p.writeField30(oprot); err != nil {
+			fieldId = 30
+			goto WriteFieldError
+		}
+		if err = p.writeField100(oprot); err != nil {
+			fieldId = 100
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptTargetConf) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetID() {
+		if err = oprot.WriteFieldBegin("target_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TargetID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptTargetConf) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetVersionID() {
+		if err = oprot.WriteFieldBegin("target_version_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TargetVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptTargetConf) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetType() {
+		if err = oprot.WriteFieldBegin("target_type", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.TargetType)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptTargetConf) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldMapping() {
+		if err = oprot.WriteFieldBegin("field_mapping", thrift.STRUCT, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.FieldMapping.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *ExptTargetConf) writeField20(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRuntimeParam() {
+		if err = oprot.WriteFieldBegin("runtime_param", thrift.STRUCT, 20); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.RuntimeParam.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 end error: ", p), err)
+}
+func (p *ExptTargetConf) writeField30(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAlias() {
+		if err = oprot.WriteFieldBegin("alias", thrift.STRING, 30); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Alias); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 30 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 30 end error: ", p), err)
+}
+func (p *ExptTargetConf) writeField100(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExt() {
+		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 100); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Ext)); err != nil {
+			return err
+		}
+		for k, v := range p.Ext {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
+}
+
+func (p *ExptTargetConf) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptTargetConf(%+v)", *p)
+
+}
+
+func (p *ExptTargetConf) DeepEqual(ano *ExptTargetConf) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.TargetID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TargetVersionID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.TargetType) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.FieldMapping) {
+		return false
+	}
+	if !p.Field20DeepEqual(ano.RuntimeParam) {
+		return false
+	}
+	if !p.Field30DeepEqual(ano.Alias) {
+		return false
+	}
+	if !p.Field100DeepEqual(ano.Ext) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptTargetConf) Field1DeepEqual(src *int64) bool {
+
+	if p.TargetID == src {
+		return true
+	} else if p.TargetID == nil || src == nil {
+		return false
+	}
+	if *p.TargetID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptTargetConf) Field2DeepEqual(src *int64) bool {
+
+	if p.TargetVersionID == src {
+		return true
+	} else if p.TargetVersionID == nil || src == nil {
+		return false
+	}
+	if *p.TargetVersionID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptTargetConf) Field3DeepEqual(src *eval_target.EvalTargetType) bool {
+
+	if p.TargetType == src {
+		return true
+	} else if p.TargetType == nil || src == nil {
+		return false
+	}
+	if *p.TargetType != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptTargetConf) Field10DeepEqual(src *TargetFieldMapping) bool {
+
+	if !p.FieldMapping.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExptTargetConf) Field20DeepEqual(src *common.RuntimeParam) bool {
+
+	if !p.RuntimeParam.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExptTargetConf) Field30DeepEqual(src *string) bool {
+
+	if p.Alias == src {
+		return true
+	} else if p.Alias == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Alias, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptTargetConf) Field100DeepEqual(src map[string]string) bool {
+
+	if len(p.Ext) != len(src) {
+		return false
+	}
+	for k, v := range p.Ext {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// per-set 的一个 evaluator binding
+type ExptEvaluatorConf struct {
+	EvaluatorID        int64                    `thrift:"evaluator_id,1,required" frugal:"1,required,i64" json:"evaluator_id" form:"evaluator_id,required" query:"evaluator_id,required"`
+	EvaluatorVersionID int64                    `thrift:"evaluator_version_id,2,required" frugal:"2,required,i64" json:"evaluator_version_id" form:"evaluator_version_id,required" query:"evaluator_version_id,required"`
+	EvaluatorType      *evaluator.EvaluatorType `thrift:"evaluator_type,3,optional" frugal:"3,optional,EvaluatorType" form:"evaluator_type" json:"evaluator_type,omitempty" query:"evaluator_type"`
+	// 多实例区分(judge_A/judge_B); 缺省 '' 默认实例
+	Alias *string `thrift:"alias,4,optional" frugal:"4,optional,string" form:"alias" json:"alias,omitempty" query:"alias"`
+	// 评测集字段 → evaluator 输入
+	FromEvalSet []*FieldMapping `thrift:"from_eval_set,10,optional" frugal:"10,optional,list<FieldMapping>" form:"from_eval_set" json:"from_eval_set,omitempty" query:"from_eval_set"`
+	// target 输出 → evaluator 输入
+	FromTarget []*FieldMapping `thrift:"from_target,11,optional" frugal:"11,optional,list<FieldMapping>" form:"from_target" json:"from_target,omitempty" query:"from_target"`
+	// 行级过滤: 命中才执行本 binding (复用 data data_filter.Filter)
+	Filter *filter.Filter `thrift:"filter,20,optional" frugal:"20,optional,filter.Filter" form:"filter" json:"filter,omitempty" query:"filter"`
+	// 0 None / 1 Include / 2 Exclude
+	FilterMode *int32 `thrift:"filter_mode,21,optional" frugal:"21,optional,i32" form:"filter_mode" json:"filter_mode,omitempty" query:"filter_mode"`
+	// alias 多实例核心动机: 同 version 不同参数
+	RuntimeParam *common.RuntimeParam `thrift:"runtime_param,30,optional" frugal:"30,optional,common.RuntimeParam" form:"runtime_param" json:"runtime_param,omitempty" query:"runtime_param"`
+	// enable_weighted_score 开启时参与加权
+	ScoreWeight *float64          `thrift:"score_weight,40,optional" frugal:"40,optional,double" form:"score_weight" json:"score_weight,omitempty" query:"score_weight"`
+	Ext         map[string]string `thrift:"ext,100,optional" frugal:"100,optional,map<string:string>" form:"ext" json:"ext,omitempty" query:"ext"`
+}
+
+func NewExptEvaluatorConf() *ExptEvaluatorConf {
+	return &ExptEvaluatorConf{}
+}
+
+func (p *ExptEvaluatorConf) InitDefault() {
+}
+
+func (p *ExptEvaluatorConf) GetEvaluatorID() (v int64) {
+	if p != nil {
+		return p.EvaluatorID
+	}
+	return
+}
+
+func (p *ExptEvaluatorConf) GetEvaluatorVersionID() (v int64) {
+	if p != nil {
+		return p.EvaluatorVersionID
+	}
+	return
+}
+
+var ExptEvaluatorConf_EvaluatorType_DEFAULT evaluator.EvaluatorType
+
+func (p *ExptEvaluatorConf) GetEvaluatorType() (v evaluator.EvaluatorType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorType() {
+		return ExptEvaluatorConf_EvaluatorType_DEFAULT
+	}
+	return *p.EvaluatorType
+}
+
+var ExptEvaluatorConf_Alias_DEFAULT string
+
+func (p *ExptEvaluatorConf) GetAlias() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAlias() {
+		return ExptEvaluatorConf_Alias_DEFAULT
+	}
+	return *p.Alias
+}
+
+var ExptEvaluatorConf_FromEvalSet_DEFAULT []*FieldMapping
+
+func (p *ExptEvaluatorConf) GetFromEvalSet() (v []*FieldMapping) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFromEvalSet() {
+		return ExptEvaluatorConf_FromEvalSet_DEFAULT
+	}
+	return p.FromEvalSet
+}
+
+var ExptEvaluatorConf_FromTarget_DEFAULT []*FieldMapping
+
+func (p *ExptEvaluatorConf) GetFromTarget() (v []*FieldMapping) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFromTarget() {
+		return ExptEvaluatorConf_FromTarget_DEFAULT
+	}
+	return p.FromTarget
+}
+
+var ExptEvaluatorConf_Filter_DEFAULT *filter.Filter
+
+func (p *ExptEvaluatorConf) GetFilter() (v *filter.Filter) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFilter() {
+		return ExptEvaluatorConf_Filter_DEFAULT
+	}
+	return p.Filter
+}
+
+var ExptEvaluatorConf_FilterMode_DEFAULT int32
+
+func (p *ExptEvaluatorConf) GetFilterMode() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFilterMode() {
+		return ExptEvaluatorConf_FilterMode_DEFAULT
+	}
+	return *p.FilterMode
+}
+
+var ExptEvaluatorConf_RuntimeParam_DEFAULT *common.RuntimeParam
+
+func (p *ExptEvaluatorConf) GetRuntimeParam() (v *common.RuntimeParam) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRuntimeParam() {
+		return ExptEvaluatorConf_RuntimeParam_DEFAULT
+	}
+	return p.RuntimeParam
+}
+
+var ExptEvaluatorConf_ScoreWeight_DEFAULT float64
+
+func (p *ExptEvaluatorConf) GetScoreWeight() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScoreWeight() {
+		return ExptEvaluatorConf_ScoreWeight_DEFAULT
+	}
+	return *p.ScoreWeight
+}
+
+var ExptEvaluatorConf_Ext_DEFAULT map[string]string
+
+func (p *ExptEvaluatorConf) GetExt() (v map[string]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExt() {
+		return ExptEvaluatorConf_Ext_DEFAULT
+	}
+	return p.Ext
+}
+func (p *ExptEvaluatorConf) SetEvaluatorID(val int64) {
+	p.EvaluatorID = val
+}
+func (p *ExptEvaluatorConf) SetEvaluatorVersionID(val int64) {
+	p.EvaluatorVersionID = val
+}
+func (p *ExptEvaluatorConf) SetEvaluatorType(val *evaluator.EvaluatorType) {
+	p.EvaluatorType = val
+}
+func (p *ExptEvaluatorConf) SetAlias(val *string) {
+	p.Alias = val
+}
+func (p *ExptEvaluatorConf) SetFromEvalSet(val []*FieldMapping) {
+	p.FromEvalSet = val
+}
+func (p *ExptEvaluatorConf) SetFromTarget(val []*FieldMapping) {
+	p.FromTarget = val
+}
+func (p *ExptEvaluatorConf) SetFilter(val *filter.Filter) {
+	p.Filter = val
+}
+func (p *ExptEvaluatorConf) SetFilterMode(val *int32) {
+	p.FilterMode = val
+}
+func (p *ExptEvaluatorConf) SetRuntimeParam(val *common.RuntimeParam) {
+	p.RuntimeParam = val
+}
+func (p *ExptEvaluatorConf) SetScoreWeight(val *float64) {
+	p.ScoreWeight = val
+}
+func (p *ExptEvaluatorConf) SetExt(val map[string]string) {
+	p.Ext = val
+}
+
+var fieldIDToName_ExptEvaluatorConf = map[int16]string{
+	1:   "evaluator_id",
+	2:   "evaluator_version_id",
+	3:   "evaluator_type",
+	4:   "alias",
+	10:  "from_eval_set",
+	11:  "from_target",
+	20:  "filter",
+	21:  "filter_mode",
+	30:  "runtime_param",
+	40:  "score_weight",
+	100: "ext",
+}
+
+func (p *ExptEvaluatorConf) IsSetEvaluatorType() bool {
+	return p.EvaluatorType != nil
+}
+
+func (p *ExptEvaluatorConf) IsSetAlias() bool {
+	return p.Alias != nil
+}
+
+func (p *ExptEvaluatorConf) IsSetFromEvalSet() bool {
+	return p.FromEvalSet != nil
+}
+
+func (p *ExptEvaluatorConf) IsSetFromTarget() bool {
+	return p.FromTarget != nil
+}
+
+func (p *ExptEvaluatorConf) IsSetFilter() bool {
+	return p.Filter != nil
+}
+
+func (p *ExptEvaluatorConf) IsSetFilterMode() bool {
+	return p.FilterMode != nil
+}
+
+func (p *ExptEvaluatorConf) IsSetRuntimeParam() bool {
+	return p.RuntimeParam != nil
+}
+
+func (p *ExptEvaluatorConf) IsSetScoreWeight() bool {
+	return p.ScoreWeight != nil
+}
+
+func (p *ExptEvaluatorConf) IsSetExt() bool {
+	return p.Ext != nil
+}
+
+func (p *ExptEvaluatorConf) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetEvaluatorID bool = false
+	var issetEvaluatorVersionID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluatorID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluatorVersionID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 20:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField20(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 21:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField21(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 30:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField30(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 40:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField40(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 100:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField100(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetEvaluatorID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluatorVersionID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptEvaluatorConf[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptEvaluatorConf[fieldId]))
+}
+
+func (p *ExptEvaluatorConf) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EvaluatorID = _field
+	return nil
+}
+func (p *ExptEvaluatorConf) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EvaluatorVersionID = _field
+	return nil
+}
+func (p *ExptEvaluatorConf) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *evaluator.EvaluatorType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := evaluator.EvaluatorType(v)
+		_field = &tmp
+	}
+	p.EvaluatorType = _field
+	return nil
+}
+func (p *ExptEvaluatorConf) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Alias = _field
+	return nil
+}
+func (p *ExptEvaluatorConf) ReadField10(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*FieldMapping, 0, size)
+	values := make([]FieldMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FromEvalSet = _field
+	return nil
+}
+func (p *ExptEvaluatorConf) ReadField11(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*FieldMapping, 0, size)
+	values := make([]FieldMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FromTarget = _field
+	return nil
+}
+func (p *ExptEvaluatorConf) ReadField20(iprot thrift.TProtocol) error {
+	_field := filter.NewFilter()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Filter = _field
+	return nil
+}
+func (p *ExptEvaluatorConf) ReadField21(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FilterMode = _field
+	return nil
+}
+func (p *ExptEvaluatorConf) ReadField30(iprot thrift.TProtocol) error {
+	_field := common.NewRuntimeParam()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.RuntimeParam = _field
+	return nil
+}
+func (p *ExptEvaluatorConf) ReadField40(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ScoreWeight = _field
+	return nil
+}
+func (p *ExptEvaluatorConf) ReadField100(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Ext = _field
+	return nil
+}
+
+func (p *ExptEvaluatorConf) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptEvaluatorConf"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField20(oprot); err != nil {
+			fieldId = 20
+			goto WriteFieldError
+		}
+		if err = p.writeField21(oprot); err != nil {
+			fieldId = 21
+			goto WriteFieldError
+		}
+		if err = p.writeField30(oprot); err != nil {
+			fieldId = 30
+			goto WriteFieldError
+		}
+		if err = p.writeField40(oprot); err != nil {
+			fieldId = 40
+			goto WriteFieldError
+		}
+		if err = p.writeField100(oprot); err != nil {
+			fieldId = 100
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptEvaluatorConf) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvaluatorID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptEvaluatorConf) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvaluatorVersionID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptEvaluatorConf) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorType() {
+		if err = oprot.WriteFieldBegin("evaluator_type", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.EvaluatorType)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptEvaluatorConf) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAlias() {
+		if err = oprot.WriteFieldBegin("alias", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Alias); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptEvaluatorConf) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFromEvalSet() {
+		if err = oprot.WriteFieldBegin("from_eval_set", thrift.LIST, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FromEvalSet)); err != nil {
+			return err
+		}
+		for _, v := range p.FromEvalSet {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *ExptEvaluatorConf) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFromTarget() {
+		if err = oprot.WriteFieldBegin("from_target", thrift.LIST, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FromTarget)); err != nil {
+			return err
+		}
+		for _, v := range p.FromTarget {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *ExptEvaluatorConf) writeField20(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilter() {
+		if err = oprot.WriteFieldBegin("filter", thrift.STRUCT, 20); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Filter.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 end error: ", p), err)
+}
+func (p *ExptEvaluatorConf) writeField21(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilterMode() {
+		if err = oprot.WriteFieldBegin("filter_mode", thrift.I32, 21); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.FilterMode); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 21 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 21 end error: ", p), err)
+}
+func (p *ExptEvaluatorConf) writeField30(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRuntimeParam() {
+		if err = oprot.WriteFieldBegin("runtime_param", thrift.STRUCT, 30); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.RuntimeParam.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 30 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 30 end error: ", p), err)
+}
+func (p *ExptEvaluatorConf) writeField40(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScoreWeight() {
+		if err = oprot.WriteFieldBegin("score_weight", thrift.DOUBLE, 40); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.ScoreWeight); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 40 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 40 end error: ", p), err)
+}
+func (p *ExptEvaluatorConf) writeField100(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExt() {
+		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 100); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Ext)); err != nil {
+			return err
+		}
+		for k, v := range p.Ext {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
+}
+
+func (p *ExptEvaluatorConf) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptEvaluatorConf(%+v)", *p)
+
+}
+
+func (p *ExptEvaluatorConf) DeepEqual(ano *ExptEvaluatorConf) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EvaluatorID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.EvaluatorVersionID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.EvaluatorType) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Alias) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.FromEvalSet) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.FromTarget) {
+		return false
+	}
+	if !p.Field20DeepEqual(ano.Filter) {
+		return false
+	}
+	if !p.Field21DeepEqual(ano.FilterMode) {
+		return false
+	}
+	if !p.Field30DeepEqual(ano.RuntimeParam) {
+		return false
+	}
+	if !p.Field40DeepEqual(ano.ScoreWeight) {
+		return false
+	}
+	if !p.Field100DeepEqual(ano.Ext) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptEvaluatorConf) Field1DeepEqual(src int64) bool {
+
+	if p.EvaluatorID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorConf) Field2DeepEqual(src int64) bool {
+
+	if p.EvaluatorVersionID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorConf) Field3DeepEqual(src *evaluator.EvaluatorType) bool {
+
+	if p.EvaluatorType == src {
+		return true
+	} else if p.EvaluatorType == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatorType != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorConf) Field4DeepEqual(src *string) bool {
+
+	if p.Alias == src {
+		return true
+	} else if p.Alias == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Alias, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorConf) Field10DeepEqual(src []*FieldMapping) bool {
+
+	if len(p.FromEvalSet) != len(src) {
+		return false
+	}
+	for i, v := range p.FromEvalSet {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ExptEvaluatorConf) Field11DeepEqual(src []*FieldMapping) bool {
+
+	if len(p.FromTarget) != len(src) {
+		return false
+	}
+	for i, v := range p.FromTarget {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ExptEvaluatorConf) Field20DeepEqual(src *filter.Filter) bool {
+
+	if !p.Filter.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorConf) Field21DeepEqual(src *int32) bool {
+
+	if p.FilterMode == src {
+		return true
+	} else if p.FilterMode == nil || src == nil {
+		return false
+	}
+	if *p.FilterMode != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorConf) Field30DeepEqual(src *common.RuntimeParam) bool {
+
+	if !p.RuntimeParam.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorConf) Field40DeepEqual(src *float64) bool {
+
+	if p.ScoreWeight == src {
+		return true
+	} else if p.ScoreWeight == nil || src == nil {
+		return false
+	}
+	if *p.ScoreWeight != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorConf) Field100DeepEqual(src map[string]string) bool {
+
+	if len(p.Ext) != len(src) {
+		return false
+	}
+	for k, v := range p.Ext {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// 一个评测集 + 该集的完整配置包
+type EvalSetConfig struct {
+	EvalSetID int64 `thrift:"eval_set_id,1,required" frugal:"1,required,i64" json:"eval_set_id" form:"eval_set_id,required" query:"eval_set_id,required"`
+	// 版本锁定, 不允许滚动 latest
+	EvalSetVersionID int64 `thrift:"eval_set_version_id,2,required" frugal:"2,required,i64" json:"eval_set_version_id" form:"eval_set_version_id,required" query:"eval_set_version_id,required"`
+	// 不传=全集; 点选=item_id in [...]; 条件圈选=tag 条件 (复用 data data_filter.Filter)
+	ItemFilter *filter.Filter `thrift:"item_filter,10,optional" frugal:"10,optional,filter.Filter" form:"item_filter" json:"item_filter,omitempty" query:"item_filter"`
+	// 本期 len<=1; 不传=继承 request 顶层 target
+	TargetConfs []*ExptTargetConf `thrift:"target_confs,20,optional" frugal:"20,optional,list<ExptTargetConf>" form:"target_confs" json:"target_confs,omitempty" query:"target_confs"`
+	// (evaluator_version_id, alias) 在 set 内唯一
+	EvaluatorConfs []*ExptEvaluatorConf `thrift:"evaluator_confs,30,optional" frugal:"30,optional,list<ExptEvaluatorConf>" form:"evaluator_confs" json:"evaluator_confs,omitempty" query:"evaluator_confs"`
+	// 跨空间: 该 set 评测集来源空间; nil/!is_shared=同空间
+	SharedOption *common.SharedResourceOption `thrift:"shared_option,40,optional" frugal:"40,optional,common.SharedResourceOption" form:"shared_option" json:"shared_option,omitempty" query:"shared_option"`
+	// 跨空间: 该 set 评测对象来源空间
+	TargetSharedOption *common.SharedResourceOption `thrift:"target_shared_option,41,optional" frugal:"41,optional,common.SharedResourceOption" form:"target_shared_option" json:"target_shared_option,omitempty" query:"target_shared_option"`
+	Ext                map[string]string            `thrift:"ext,100,optional" frugal:"100,optional,map<string:string>" form:"ext" json:"ext,omitempty" query:"ext"`
+}
+
+func NewEvalSetConfig() *EvalSetConfig {
+	return &EvalSetConfig{}
+}
+
+func (p *EvalSetConfig) InitDefault() {
+}
+
+func (p *EvalSetConfig) GetEvalSetID() (v int64) {
+	if p != nil {
+		return p.EvalSetID
+	}
+	return
+}
+
+func (p *EvalSetConfig) GetEvalSetVersionID() (v int64) {
+	if p != nil {
+		return p.EvalSetVersionID
+	}
+	return
+}
+
+var EvalSetConfig_ItemFilter_DEFAULT *filter.Filter
+
+func (p *EvalSetConfig) GetItemFilter() (v *filter.Filter) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetItemFilter() {
+		return EvalSetConfig_ItemFilter_DEFAULT
+	}
+	return p.ItemFilter
+}
+
+var EvalSetConfig_TargetConfs_DEFAULT []*ExptTargetConf
+
+func (p *EvalSetConfig) GetTargetConfs() (v []*ExptTargetConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetConfs() {
+		return EvalSetConfig_TargetConfs_DEFAULT
+	}
+	return p.TargetConfs
+}
+
+var EvalSetConfig_EvaluatorConfs_DEFAULT []*ExptEvaluatorConf
+
+func (p *EvalSetConfig) GetEvaluatorConfs() (v []*ExptEvaluatorConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorConfs() {
+		return EvalSetConfig_EvaluatorConfs_DEFAULT
+	}
+	return p.EvaluatorConfs
+}
+
+var EvalSetConfig_SharedOption_DEFAULT *common.SharedResourceOption
+
+func (p *EvalSetConfig) GetSharedOption() (v *common.SharedResourceOption) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSharedOption() {
+		return EvalSetConfig_SharedOption_DEFAULT
+	}
+	return p.SharedOption
+}
+
+var EvalSetConfig_TargetSharedOption_DEFAULT *common.SharedResourceOption
+
+func (p *EvalSetConfig) GetTargetSharedOption() (v *common.SharedResourceOption) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetSharedOption() {
+		return EvalSetConfig_TargetSharedOption_DEFAULT
+	}
+	return p.TargetSharedOption
+}
+
+var EvalSetConfig_Ext_DEFAULT map[string]string
+
+func (p *EvalSetConfig) GetExt() (v map[string]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExt() {
+		return EvalSetConfig_Ext_DEFAULT
+	}
+	return p.Ext
+}
+func (p *EvalSetConfig) SetEvalSetID(val int64) {
+	p.EvalSetID = val
+}
+func (p *EvalSetConfig) SetEvalSetVersionID(val int64) {
+	p.EvalSetVersionID = val
+}
+func (p *EvalSetConfig) SetItemFilter(val *filter.Filter) {
+	p.ItemFilter = val
+}
+func (p *EvalSetConfig) SetTargetConfs(val []*ExptTargetConf) {
+	p.TargetConfs = val
+}
+func (p *EvalSetConfig) SetEvaluatorConfs(val []*ExptEvaluatorConf) {
+	p.EvaluatorConfs = val
+}
+func (p *EvalSetConfig) SetSharedOption(val *common.SharedResourceOption) {
+	p.SharedOption = val
+}
+func (p *EvalSetConfig) SetTargetSharedOption(val *common.SharedResourceOption) {
+	p.TargetSharedOption = val
+}
+func (p *EvalSetConfig) SetExt(val map[string]string) {
+	p.Ext = val
+}
+
+var fieldIDToName_EvalSetConfig = map[int16]string{
+	1:   "eval_set_id",
+	2:   "eval_set_version_id",
+	10:  "item_filter",
+	20:  "target_confs",
+	30:  "evaluator_confs",
+	40:  "shared_option",
+	41:  "target_shared_option",
+	100: "ext",
+}
+
+func (p *EvalSetConfig) IsSetItemFilter() bool {
+	return p.ItemFilter != nil
+}
+
+func (p *EvalSetConfig) IsSetTargetConfs() bool {
+	return p.TargetConfs != nil
+}
+
+func (p *EvalSetConfig) IsSetEvaluatorConfs() bool {
+	return p.EvaluatorConfs != nil
+}
+
+func (p *EvalSetConfig) IsSetSharedOption() bool {
+	return p.SharedOption != nil
+}
+
+func (p *EvalSetConfig) IsSetTargetSharedOption() bool {
+	return p.TargetSharedOption != nil
+}
+
+func (p *EvalSetConfig) IsSetExt() bool {
+	return p.Ext != nil
+}
+
+func (p *EvalSetConfig) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetEvalSetID bool = false
+	var issetEvalSetVersionID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEvalSetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEvalSetVersionID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 20:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField20(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 30:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField30(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 40:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField40(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 41:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField41(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 100:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField100(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetEvalSetID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvalSetVersionID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvalSetConfig[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_EvalSetConfig[fieldId]))
+}
+
+func (p *EvalSetConfig) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EvalSetID = _field
+	return nil
+}
+func (p *EvalSetConfig) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EvalSetVersionID = _field
+	return nil
+}
+func (p *EvalSetConfig) ReadField10(iprot thrift.TProtocol) error {
+	_field := filter.NewFilter()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ItemFilter = _field
+	return nil
+}
+func (p *EvalSetConfig) ReadField20(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExptTargetConf, 0, size)
+	values := make([]ExptTargetConf, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.TargetConfs = _field
+	return nil
+}
+func (p *EvalSetConfig) ReadField30(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExptEvaluatorConf, 0, size)
+	values := make([]ExptEvaluatorConf, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.EvaluatorConfs = _field
+	return nil
+}
+func (p *EvalSetConfig) ReadField40(iprot thrift.TProtocol) error {
+	_field := common.NewSharedResourceOption()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.SharedOption = _field
+	return nil
+}
+func (p *EvalSetConfig) ReadField41(iprot thrift.TProtocol) error {
+	_field := common.NewSharedResourceOption()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.TargetSharedOption = _field
+	return nil
+}
+func (p *EvalSetConfig) ReadField100(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Ext = _field
+	return nil
+}
+
+func (p *EvalSetConfig) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvalSetConfig"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField20(oprot); err != nil {
+			fieldId = 20
+			goto WriteFieldError
+		}
+		if err = p.writeField30(oprot); err != nil {
+			fieldId = 30
+			goto WriteFieldError
+		}
+		if err = p.writeField40(oprot); err != nil {
+			fieldId = 40
+			goto WriteFieldError
+		}
+		if err = p.writeField41(oprot); err != nil {
+			fieldId = 41
+			goto WriteFieldError
+		}
+		if err = p.writeField100(oprot); err != nil {
+			fieldId = 100
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvalSetConfig) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("eval_set_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvalSetID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvalSetConfig) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("eval_set_version_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvalSetVersionID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvalSetConfig) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItemFilter() {
+		if err = oprot.WriteFieldBegin("item_filter", thrift.STRUCT, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ItemFilter.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *EvalSetConfig) writeField20(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetConfs() {
+		if err = oprot.WriteFieldBegin("target_confs", thrift.LIST, 20); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TargetConfs)); err != nil {
+			return err
+		}
+		for _, v := range p.TargetConfs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 end error: ", p), err)
+}
+func (p *EvalSetConfig) writeField30(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorConfs() {
+		if err = oprot.WriteFieldBegin("evaluator_confs", thrift.LIST, 30); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.EvaluatorConfs)); err != nil {
+			return err
+		}
+		for _, v := range p.EvaluatorConfs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 30 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 30 end error: ", p), err)
+}
+func (p *EvalSetConfig) writeField40(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSharedOption() {
+		if err = oprot.WriteFieldBegin("shared_option", thrift.STRUCT, 40); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.SharedOption.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 40 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 40 end error: ", p), err)
+}
+func (p *EvalSetConfig) writeField41(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetSharedOption() {
+		if err = oprot.WriteFieldBegin("target_shared_option", thrift.STRUCT, 41); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.TargetSharedOption.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 41 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 41 end error: ", p), err)
+}
+func (p *EvalSetConfig) writeField100(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExt() {
+		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 100); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Ext)); err != nil {
+			return err
+		}
+		for k, v := range p.Ext {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
+}
+
+func (p *EvalSetConfig) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvalSetConfig(%+v)", *p)
+
+}
+
+func (p *EvalSetConfig) DeepEqual(ano *EvalSetConfig) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EvalSetID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.EvalSetVersionID) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.ItemFilter) {
+		return false
+	}
+	if !p.Field20DeepEqual(ano.TargetConfs) {
+		return false
+	}
+	if !p.Field30DeepEqual(ano.EvaluatorConfs) {
+		return false
+	}
+	if !p.Field40DeepEqual(ano.SharedOption) {
+		return false
+	}
+	if !p.Field41DeepEqual(ano.TargetSharedOption) {
+		return false
+	}
+	if !p.Field100DeepEqual(ano.Ext) {
+		return false
+	}
+	return true
+}
+
+func (p *EvalSetConfig) Field1DeepEqual(src int64) bool {
+
+	if p.EvalSetID != src {
+		return false
+	}
+	return true
+}
+func (p *EvalSetConfig) Field2DeepEqual(src int64) bool {
+
+	if p.EvalSetVersionID != src {
+		return false
+	}
+	return true
+}
+func (p *EvalSetConfig) Field10DeepEqual(src *filter.Filter) bool {
+
+	if !p.ItemFilter.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvalSetConfig) Field20DeepEqual(src []*ExptTargetConf) bool {
+
+	if len(p.TargetConfs) != len(src) {
+		return false
+	}
+	for i, v := range p.TargetConfs {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvalSetConfig) Field30DeepEqual(src []*ExptEvaluatorConf) bool {
+
+	if len(p.EvaluatorConfs) != len(src) {
+		return false
+	}
+	for i, v := range p.EvaluatorConfs {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvalSetConfig) Field40DeepEqual(src *common.SharedResourceOption) bool {
+
+	if !p.SharedOption.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvalSetConfig) Field41DeepEqual(src *common.SharedResourceOption) bool {
+
+	if !p.TargetSharedOption.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvalSetConfig) Field100DeepEqual(src map[string]string) bool {
+
+	if len(p.Ext) != len(src) {
+		return false
+	}
+	for k, v := range p.Ext {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// per-set 运行期增量信息 (纯读模型, 不进 Create 入参)
+type ExptEvalSetDetail struct {
+	EvalSetID        *int64 `thrift:"eval_set_id,1,optional" frugal:"1,optional,i64" json:"eval_set_id" form:"eval_set_id" query:"eval_set_id"`
+	EvalSetVersionID *int64 `thrift:"eval_set_version_id,2,optional" frugal:"2,optional,i64" json:"eval_set_version_id" form:"eval_set_version_id" query:"eval_set_version_id"`
+	// 主集(封面), 与 experiment.eval_set_id 列一致
+	IsPrimary *bool `thrift:"is_primary,3,optional" frugal:"3,optional,bool" form:"is_primary" json:"is_primary,omitempty" query:"is_primary"`
+	// 该 set 选入实验的 item 数; 来源 expt_item_ref, 首跑前不填
+	ItemCount *int32 `thrift:"item_count,4,optional" frugal:"4,optional,i32" form:"item_count" json:"item_count,omitempty" query:"item_count"`
+	// Get 填充详情; List 不填
+	EvalSet *eval_set.EvaluationSet `thrift:"eval_set,5,optional" frugal:"5,optional,eval_set.EvaluationSet" form:"eval_set" json:"eval_set,omitempty" query:"eval_set"`
+	// 评测集业务唯一键; 便于 GetExperiment 直接展示/定位
+	DatasetKey *string `thrift:"dataset_key,6,optional" frugal:"6,optional,string" form:"dataset_key" json:"dataset_key,omitempty" query:"dataset_key"`
+}
+
+func NewExptEvalSetDetail() *ExptEvalSetDetail {
+	return &ExptEvalSetDetail{}
+}
+
+func (p *ExptEvalSetDetail) InitDefault() {
+}
+
+var ExptEvalSetDetail_EvalSetID_DEFAULT int64
+
+func (p *ExptEvalSetDetail) GetEvalSetID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvalSetID() {
+		return ExptEvalSetDetail_EvalSetID_DEFAULT
+	}
+	return *p.EvalSetID
+}
+
+var ExptEvalSetDetail_EvalSetVersionID_DEFAULT int64
+
+func (p *ExptEvalSetDetail) GetEvalSetVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvalSetVersionID() {
+		return ExptEvalSetDetail_EvalSetVersionID_DEFAULT
+	}
+	return *p.EvalSetVersionID
+}
+
+var ExptEvalSetDetail_IsPrimary_DEFAULT bool
+
+func (p *ExptEvalSetDetail) GetIsPrimary() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetIsPrimary() {
+		return ExptEvalSetDetail_IsPrimary_DEFAULT
+	}
+	return *p.IsPrimary
+}
+
+var ExptEvalSetDetail_ItemCount_DEFAULT int32
+
+func (p *ExptEvalSetDetail) GetItemCount() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetItemCount() {
+		return ExptEvalSetDetail_ItemCount_DEFAULT
+	}
+	return *p.ItemCount
+}
+
+var ExptEvalSetDetail_EvalSet_DEFAULT *eval_set.EvaluationSet
+
+func (p *ExptEvalSetDetail) GetEvalSet() (v *eval_set.EvaluationSet) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvalSet() {
+		return ExptEvalSetDetail_EvalSet_DEFAULT
+	}
+	return p.EvalSet
+}
+
+var ExptEvalSetDetail_DatasetKey_DEFAULT string
+
+func (p *ExptEvalSetDetail) GetDatasetKey() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDatasetKey() {
+		return ExptEvalSetDetail_DatasetKey_DEFAULT
+	}
+	return *p.DatasetKey
+}
+func (p *ExptEvalSetDetail) SetEvalSetID(val *int64) {
+	p.EvalSetID = val
+}
+func (p *ExptEvalSetDetail) SetEvalSetVersionID(val *int64) {
+	p.EvalSetVersionID = val
+}
+func (p *ExptEvalSetDetail) SetIsPrimary(val *bool) {
+	p.IsPrimary = val
+}
+func (p *ExptEvalSetDetail) SetItemCount(val *int32) {
+	p.ItemCount = val
+}
+func (p *ExptEvalSetDetail) SetEvalSet(val *eval_set.EvaluationSet) {
+	p.EvalSet = val
+}
+func (p *ExptEvalSetDetail) SetDatasetKey(val *string) {
+	p.DatasetKey = val
+}
+
+var fieldIDToName_ExptEvalSetDetail = map[int16]string{
+	1: "eval_set_id",
+	2: "eval_set_version_id",
+	3: "is_primary",
+	4: "item_count",
+	5: "eval_set",
+	6: "dataset_key",
+}
+
+func (p *ExptEvalSetDetail) IsSetEvalSetID() bool {
+	return p.EvalSetID != nil
+}
+
+func (p *ExptEvalSetDetail) IsSetEvalSetVersionID() bool {
+	return p.EvalSetVersionID != nil
+}
+
+func (p *ExptEvalSetDetail) IsSetIsPrimary() bool {
+	return p.IsPrimary != nil
+}
+
+func (p *ExptEvalSetDetail) IsSetItemCount() bool {
+	return p.ItemCount != nil
+}
+
+func (p *ExptEvalSetDetail) IsSetEvalSet() bool {
+	return p.EvalSet != nil
+}
+
+func (p *ExptEvalSetDetail) IsSetDatasetKey() bool {
+	return p.DatasetKey != nil
+}
+
+func (p *ExptEvalSetDetail) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptEvalSetDetail[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptEvalSetDetail) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvalSetID = _field
+	return nil
+}
+func (p *ExptEvalSetDetail) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvalSetVersionID = _field
+	return nil
+}
+func (p *ExptEvalSetDetail) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IsPrimary = _field
+	return nil
+}
+func (p *ExptEvalSetDetail) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ItemCount = _field
+	return nil
+}
+func (p *ExptEvalSetDetail) ReadField5(iprot thrift.TProtocol) error {
+	_field := eval_set.NewEvaluationSet()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.EvalSet = _field
+	return nil
+}
+func (p *ExptEvalSetDetail) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DatasetKey = _field
+	return nil
+}
+
+func (p *ExptEvalSetDetail) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptEvalSetDetail"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptEvalSetDetail) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvalSetID() {
+		if err = oprot.WriteFieldBegin("eval_set_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvalSetID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptEvalSetDetail) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvalSetVersionID() {
+		if err = oprot.WriteFieldBegin("eval_set_version_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvalSetVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptEvalSetDetail) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsPrimary() {
+		if err = oprot.WriteFieldBegin("is_primary", thrift.BOOL, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.IsPrimary); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptEvalSetDetail) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItemCount() {
+		if err = oprot.WriteFieldBegin("item_count", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.ItemCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptEvalSetDetail) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvalSet() {
+		if err = oprot.WriteFieldBegin("eval_set", thrift.STRUCT, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.EvalSet.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptEvalSetDetail) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDatasetKey() {
+		if err = oprot.WriteFieldBegin("dataset_key", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.DatasetKey); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *ExptEvalSetDetail) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptEvalSetDetail(%+v)", *p)
+
+}
+
+func (p *ExptEvalSetDetail) DeepEqual(ano *ExptEvalSetDetail) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EvalSetID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.EvalSetVersionID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.IsPrimary) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ItemCount) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.EvalSet) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.DatasetKey) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptEvalSetDetail) Field1DeepEqual(src *int64) bool {
+
+	if p.EvalSetID == src {
+		return true
+	} else if p.EvalSetID == nil || src == nil {
+		return false
+	}
+	if *p.EvalSetID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvalSetDetail) Field2DeepEqual(src *int64) bool {
+
+	if p.EvalSetVersionID == src {
+		return true
+	} else if p.EvalSetVersionID == nil || src == nil {
+		return false
+	}
+	if *p.EvalSetVersionID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvalSetDetail) Field3DeepEqual(src *bool) bool {
+
+	if p.IsPrimary == src {
+		return true
+	} else if p.IsPrimary == nil || src == nil {
+		return false
+	}
+	if *p.IsPrimary != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvalSetDetail) Field4DeepEqual(src *int32) bool {
+
+	if p.ItemCount == src {
+		return true
+	} else if p.ItemCount == nil || src == nil {
+		return false
+	}
+	if *p.ItemCount != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvalSetDetail) Field5DeepEqual(src *eval_set.EvaluationSet) bool {
+
+	if !p.EvalSet.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExptEvalSetDetail) Field6DeepEqual(src *string) bool {
+
+	if p.DatasetKey == src {
+		return true
+	} else if p.DatasetKey == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.DatasetKey, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+// 一对实验的胜负统计, 以 expt_id_a 视角
+type ExptPairwiseStat struct {
+	ExptIDA *int64 `thrift:"expt_id_a,1,optional" frugal:"1,optional,i64" json:"expt_id_a" form:"expt_id_a" query:"expt_id_a"`
+	ExptIDB *int64 `thrift:"expt_id_b,2,optional" frugal:"2,optional,i64" json:"expt_id_b" form:"expt_id_b" query:"expt_id_b"`
+	AWins   *int64 `thrift:"a_wins,3,optional" frugal:"3,optional,i64" json:"a_wins" form:"a_wins" query:"a_wins"`
+	BWins   *int64 `thrift:"b_wins,4,optional" frugal:"4,optional,i64" json:"b_wins" form:"b_wins" query:"b_wins"`
+	Ties    *int64 `thrift:"ties,5,optional" frugal:"5,optional,i64" json:"ties" form:"ties" query:"ties"`
+	// 评估器执行失败或缺少输出的 turn 数, 不计入胜负
+	Failed *int64 `thrift:"failed,6,optional" frugal:"6,optional,i64" json:"failed" form:"failed" query:"failed"`
+}
+
+func NewExptPairwiseStat() *ExptPairwiseStat {
+	return &ExptPairwiseStat{}
+}
+
+func (p *ExptPairwiseStat) InitDefault() {
+}
+
+var ExptPairwiseStat_ExptIDA_DEFAULT int64
+
+func (p *ExptPairwiseStat) GetExptIDA() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExptIDA() {
+		return ExptPairwiseStat_ExptIDA_DEFAULT
+	}
+	return *p.ExptIDA
+}
+
+var ExptPairwiseStat_ExptIDB_DEFAULT int64
+
+func (p *ExptPairwiseStat) GetExptIDB() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExptIDB() {
+		return ExptPairwiseStat_ExptIDB_DEFAULT
+	}
+	return *p.ExptIDB
+}
+
+var ExptPairwiseStat_AWins_DEFAULT int64
+
+func (p *ExptPairwiseStat) GetAWins() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAWins() {
+		return ExptPairwiseStat_AWins_DEFAULT
+	}
+	return *p.AWins
+}
+
+var ExptPairwiseStat_BWins_DEFAULT int64
+
+func (p *ExptPairwiseStat) GetBWins() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBWins() {
+		return ExptPairwiseStat_BWins_DEFAULT
+	}
+	return *p.BWins
+}
+
+var ExptPairwiseStat_Ties_DEFAULT int64
+
+func (p *ExptPairwiseStat) GetTies() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTies() {
+		return ExptPairwiseStat_Ties_DEFAULT
+	}
+	return *p.Ties
+}
+
+var ExptPairwiseStat_Failed_DEFAULT int64
+
+func (p *ExptPairwiseStat) GetFailed() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFailed() {
+		return ExptPairwiseStat_Failed_DEFAULT
+	}
+	return *p.Failed
+}
+func (p *ExptPairwiseStat) SetExptIDA(val *int64) {
+	p.ExptIDA = val
+}
+func (p *ExptPairwiseStat) SetExptIDB(val *int64) {
+	p.ExptIDB = val
+}
+func (p *ExptPairwiseStat) SetAWins(val *int64) {
+	p.AWins = val
+}
+func (p *ExptPairwiseStat) SetBWins(val *int64) {
+	p.BWins = val
+}
+func (p *ExptPairwiseStat) SetTies(val *int64) {
+	p.Ties = val
+}
+func (p *ExptPairwiseStat) SetFailed(val *int64) {
+	p.Failed = val
+}
+
+var fieldIDToName_ExptPairwiseStat = map[int16]string{
+	1: "expt_id_a",
+	2: "expt_id_b",
+	3: "a_wins",
+	4: "b_wins",
+	5: "ties",
+	6: "failed",
+}
+
+func (p *ExptPairwiseStat) IsSetExptIDA() bool {
+	return p.ExptIDA != nil
+}
+
+func (p *ExptPairwiseStat) IsSetExptIDB() bool {
+	return p.ExptIDB != nil
+}
+
+func (p *ExptPairwiseStat) IsSetAWins() bool {
+	return p.AWins != nil
+}
+
+func (p *ExptPairwiseStat) IsSetBWins() bool {
+	return p.BWins != nil
+}
+
+func (p *ExptPairwiseStat) IsSetTies() bool {
+	return p.Ties != nil
+}
+
+func (p *ExptPairwiseStat) IsSetFailed() bool {
+	return p.Failed != nil
+}
+
+func (p *ExptPairwiseStat) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptPairwiseStat[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptPairwiseStat) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ExptIDA = _field
+	return nil
+}
+func (p *ExptPairwiseStat) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ExptIDB = _field
+	return nil
+}
+func (p *ExptPairwiseStat) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.AWins = _field
+	return nil
+}
+func (p *ExptPairwiseStat) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BWins = _field
+	return nil
+}
+func (p *ExptPairwiseStat) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Ties = _field
+	return nil
+}
+func (p *ExptPairwiseStat) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Failed = _field
+	return nil
+}
+
+func (p *ExptPairwiseStat) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptPairwiseStat"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptPairwiseStat) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExptIDA() {
+		if err = oprot.WriteFieldBegin("expt_id_a", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ExptIDA); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptPairwiseStat) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExptIDB() {
+		if err = oprot.WriteFieldBegin("expt_id_b", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ExptIDB); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptPairwiseStat) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAWins() {
+		if err = oprot.WriteFieldBegin("a_wins", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.AWins); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptPairwiseStat) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBWins() {
+		if err = oprot.WriteFieldBegin("b_wins", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.BWins); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptPairwiseStat) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTies() {
+		if err = oprot.WriteFieldBegin("ties", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.Ties); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptPairwiseStat) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFailed() {
+		if err = oprot.WriteFieldBegin("failed", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.Failed); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *ExptPairwiseStat) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptPairwiseStat(%+v)", *p)
+
+}
+
+func (p *ExptPairwiseStat) DeepEqual(ano *ExptPairwiseStat) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ExptIDA) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ExptIDB) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.AWins) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.BWins) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Ties) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Failed) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptPairwiseStat) Field1DeepEqual(src *int64) bool {
+
+	if p.ExptIDA == src {
+		return true
+	} else if p.ExptIDA == nil || src == nil {
+		return false
+	}
+	if *p.ExptIDA != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptPairwiseStat) Field2DeepEqual(src *int64) bool {
+
+	if p.ExptIDB == src {
+		return true
+	} else if p.ExptIDB == nil || src == nil {
+		return false
+	}
+	if *p.ExptIDB != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptPairwiseStat) Field3DeepEqual(src *int64) bool {
+
+	if p.AWins == src {
+		return true
+	} else if p.AWins == nil || src == nil {
+		return false
+	}
+	if *p.AWins != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptPairwiseStat) Field4DeepEqual(src *int64) bool {
+
+	if p.BWins == src {
+		return true
+	} else if p.BWins == nil || src == nil {
+		return false
+	}
+	if *p.BWins != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptPairwiseStat) Field5DeepEqual(src *int64) bool {
+
+	if p.Ties == src {
+		return true
+	} else if p.Ties == nil || src == nil {
+		return false
+	}
+	if *p.Ties != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptPairwiseStat) Field6DeepEqual(src *int64) bool {
+
+	if p.Failed == src {
+		return true
+	} else if p.Failed == nil || src == nil {
+		return false
+	}
+	if *p.Failed != *src {
+		return false
+	}
+	return true
+}
+
+type ExptPairwiseRanking struct {
+	ExptID  *int64   `thrift:"expt_id,1,optional" frugal:"1,optional,i64" json:"expt_id" form:"expt_id" query:"expt_id"`
+	Rank    *int32   `thrift:"rank,2,optional" frugal:"2,optional,i32" form:"rank" json:"rank,omitempty" query:"rank"`
+	Wins    *int64   `thrift:"wins,3,optional" frugal:"3,optional,i64" json:"wins" form:"wins" query:"wins"`
+	Losses  *int64   `thrift:"losses,4,optional" frugal:"4,optional,i64" json:"losses" form:"losses" query:"losses"`
+	Ties    *int64   `thrift:"ties,5,optional" frugal:"5,optional,i64" json:"ties" form:"ties" query:"ties"`
+	WinRate *float64 `thrift:"win_rate,6,optional" frugal:"6,optional,double" form:"win_rate" json:"win_rate,omitempty" query:"win_rate"`
+	Elo     *float64 `thrift:"elo,7,optional" frugal:"7,optional,double" form:"elo" json:"elo,omitempty" query:"elo"`
+	// Bradley-Terry 强度, 几何平均归一为 1
+	BtScore *float64 `thrift:"bt_score,8,optional" frugal:"8,optional,double" form:"bt_score" json:"bt_score,omitempty" query:"bt_score"`
+}
+
+func NewExptPairwiseRanking() *ExptPairwiseRanking {
+	return &ExptPairwiseRanking{}
+}
+
+func (p *ExptPairwiseRanking) InitDefault() {
+}
+
+var ExptPairwiseRanking_ExptID_DEFAULT int64
+
+func (p *ExptPairwiseRanking) GetExptID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExptID() {
+		return ExptPairwiseRanking_ExptID_DEFAULT
+	}
+	return *p.ExptID
+}
+
+var ExptPairwiseRanking_Rank_DEFAULT int32
+
+func (p *ExptPairwiseRanking) GetRank() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRank() {
+		return ExptPairwiseRanking_Rank_DEFAULT
+	}
+	return *p.Rank
+}
+
+var ExptPairwiseRanking_Wins_DEFAULT int64
+
+func (p *ExptPairwiseRanking) GetWins() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWins() {
+		return ExptPairwiseRanking_Wins_DEFAULT
+	}
+	return *p.Wins
+}
+
+var ExptPairwiseRanking_Losses_DEFAULT int64
+
+func (p *ExptPairwiseRanking) GetLosses() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLosses() {
+		return ExptPairwiseRanking_Losses_DEFAULT
+	}
+	return *p.Losses
+}
+
+var ExptPairwiseRanking_Ties_DEFAULT int64
+
+func (p *ExptPairwiseRanking) GetTies() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTies() {
+		return ExptPairwiseRanking_Ties_DEFAULT
+	}
+	return *p.Ties
+}
+
+var ExptPairwiseRanking_WinRate_DEFAULT float64
+
+func (p *ExptPairwiseRanking) GetWinRate() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWinRate() {
+		return ExptPairwiseRanking_WinRate_DEFAULT
+	}
+	return *p.WinRate
+}
+
+var ExptPairwiseRanking_Elo_DEFAULT float64
+
+func (p *ExptPairwiseRanking) GetElo() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetElo() {
+		return ExptPairwiseRanking_Elo_DEFAULT
+	}
+	return *p.Elo
+}
+
+var ExptPairwiseRanking_BtScore_DEFAULT float64
+
+func (p *ExptPairwiseRanking) GetBtScore() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBtScore() {
+		return ExptPairwiseRanking_BtScore_DEFAULT
+	}
+	return *p.BtScore
+}
+func (p *ExptPairwiseRanking) SetExptID(val *int64) {
+	p.ExptID = val
+}
+func (p *ExptPairwiseRanking) SetRank(val *int32) {
+	p.Rank = val
+}
+func (p *ExptPairwiseRanking) SetWins(val *int64) {
+	p.Wins = val
+}
+func (p *ExptPairwiseRanking) SetLosses(val *int64) {
+	p.Losses = val
+}
+func (p *ExptPairwiseRanking) SetTies(val *int64) {
+	p.Ties = val
+}
+func (p *ExptPairwiseRanking) SetWinRate(val *float64) {
+	p.WinRate = val
+}
+func (p *ExptPairwiseRanking) SetElo(val *float64) {
+	p.Elo = val
+}
+func (p *ExptPairwiseRanking) SetBtScore(val *float64) {
+	p.BtScore = val
+}
+
+var fieldIDToName_ExptPairwiseRanking = map[int16]string{
+	1: "expt_id",
+	2: "rank",
+	3: "wins",
+	4: "losses",
+	5: "ties",
+	6: "win_rate",
+	7: "elo",
+	8: "bt_score",
+}
+
+func (p *ExptPairwiseRanking) IsSetExptID() bool {
+	return p.ExptID != nil
+}
+
+func (p *ExptPairwiseRanking) IsSetRank() bool {
+	return p.Rank != nil
+}
+
+func (p *ExptPairwiseRanking) IsSetWins() bool {
+	return p.Wins != nil
+}
+
+func (p *ExptPairwiseRanking) IsSetLosses() bool {
+	return p.Losses != nil
+}
+
+func (p *ExptPairwiseRanking) IsSetTies() bool {
+	return p.Ties != nil
+}
+
+func (p *ExptPairwiseRanking) IsSetWinRate() bool {
+	return p.WinRate != nil
+}
+
+func (p *ExptPairwiseRanking) IsSetElo() bool {
+	return p.Elo != nil
+}
+
+func (p *ExptPairwiseRanking) IsSetBtScore() bool {
+	return p.BtScore != nil
+}
+
+func (p *ExptPairwiseRanking) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptPairwiseRanking[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptPairwiseRanking) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ExptID = _field
+	return nil
+}
+func (p *ExptPairwiseRanking) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Rank = _field
+	return nil
+}
+func (p *ExptPairwiseRanking) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Wins = _field
+	return nil
+}
+func (p *ExptPairwiseRanking) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Losses = _field
+	return nil
+}
+func (p *ExptPairwiseRanking) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Ties = _field
+	return nil
+}
+func (p *ExptPairwiseRanking) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WinRate = _field
+	return nil
+}
+func (p *ExptPairwiseRanking) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Elo = _field
+	return nil
+}
+func (p *ExptPairwiseRanking) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BtScore = _field
+	return nil
+}
+
+func (p *ExptPairwiseRanking) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptPairwiseRanking"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptPairwiseRanking) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExptID() {
+		if err = oprot.WriteFieldBegin("expt_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ExptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptPairwiseRanking) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRank() {
+		if err = oprot.WriteFieldBegin("rank", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Rank); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptPairwiseRanking) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWins() {
+		if err = oprot.WriteFieldBegin("wins", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.Wins); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptPairwiseRanking) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLosses() {
+		if err = oprot.WriteFieldBegin("losses", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.Losses); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptPairwiseRanking) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTies() {
+		if err = oprot.WriteFieldBegin("ties", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.Ties); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptPairwiseRanking) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWinRate() {
+		if err = oprot.WriteFieldBegin("win_rate", thrift.DOUBLE, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.WinRate); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ExptPairwiseRanking) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetElo() {
+		if err = oprot.WriteFieldBegin("elo", thrift.DOUBLE, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Elo); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ExptPairwiseRanking) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBtScore() {
+		if err = oprot.WriteFieldBegin("bt_score", thrift.DOUBLE, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.BtScore); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *ExptPairwiseRanking) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptPairwiseRanking(%+v)", *p)
+
+}
+
+func (p *ExptPairwiseRanking) DeepEqual(ano *ExptPairwiseRanking) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ExptID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Rank) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Wins) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Losses) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Ties) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.WinRate) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Elo) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.BtScore) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptPairwiseRanking) Field1DeepEqual(src *int64) bool {
+
+	if p.ExptID == src {
+		return true
+	} else if p.ExptID == nil || src == nil {
+		return false
+	}
+	if *p.ExptID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptPairwiseRanking) Field2DeepEqual(src *int32) bool {
+
+	if p.Rank == src {
+		return true
+	} else if p.Rank == nil || src == nil {
+		return false
+	}
+	if *p.Rank != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptPairwiseRanking) Field3DeepEqual(src *int64) bool {
+
+	if p.Wins == src {
+		return true
+	} else if p.Wins == nil || src == nil {
+		return false
+	}
+	if *p.Wins != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptPairwiseRanking) Field4DeepEqual(src *int64) bool {
+
+	if p.Losses == src {
+		return true
+	} else if p.Losses == nil || src == nil {
+		return false
+	}
+	if *p.Losses != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptPairwiseRanking) Field5DeepEqual(src *int64) bool {
+
+	if p.Ties == src {
+		return true
+	} else if p.Ties == nil || src == nil {
+		return false
+	}
+	if *p.Ties != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptPairwiseRanking) Field6DeepEqual(src *float64) bool {
+
+	if p.WinRate == src {
+		return true
+	} else if p.WinRate == nil || src == nil {
+		return false
+	}
+	if *p.WinRate != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptPairwiseRanking) Field7DeepEqual(src *float64) bool {
+
+	if p.Elo == src {
+		return true
+	} else if p.Elo == nil || src == nil {
+		return false
+	}
+	if *p.Elo != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptPairwiseRanking) Field8DeepEqual(src *float64) bool {
+
+	if p.BtScore == src {
+		return true
+	} else if p.BtScore == nil || src == nil {
+		return false
+	}
+	if *p.BtScore != *src {
+		return false
+	}
+	return true
+}
+
+// 成对排名任务
+type ExptPairwiseRankRecord struct {
+	RecordID           int64                  `thrift:"record_id,1,required" frugal:"1,required,i64" json:"record_id" form:"record_id,required" query:"record_id,required"`
+	WorkspaceID        int64                  `thrift:"workspace_id,2,required" frugal:"2,required,i64" json:"workspace_id" form:"workspace_id,required" query:"workspace_id,required"`
+	EvaluatorVersionID int64                  `thrift:"evaluator_version_id,3,required" frugal:"3,required,i64" json:"evaluator_version_id" form:"evaluator_version_id,required" query:"evaluator_version_id,required"`
+	ExptIds            []int64                `thrift:"expt_ids,4,optional" frugal:"4,optional,list<i64>" json:"expt_ids" form:"expt_ids" query:"expt_ids"`
+	Status             ExptPairwiseRankStatus `thrift:"status,5,required" frugal:"5,required,string" form:"status,required" json:"status,required" query:"status,required"`
+	PairResults        []*ExptPairwiseStat    `thrift:"pair_results,6,optional" frugal:"6,optional,list<ExptPairwiseStat>" form:"pair_results" json:"pair_results,omitempty" query:"pair_results"`
+	// 按 Bradley-Terry 强度降序
+	Rankings []*ExptPairwiseRanking `thrift:"rankings,7,optional" frugal:"7,optional,list<ExptPairwiseRanking>" form:"rankings" json:"rankings,omitempty" query:"rankings"`
+	ErrorMsg *string                `thrift:"error_msg,8,optional" frugal:"8,optional,string" form:"error_msg" json:"error_msg,omitempty" query:"error_msg"`
+	BaseInfo *common.BaseInfo       `thrift:"base_info,9,optional" frugal:"9,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+}
+
+func NewExptPairwiseRankRecord() *ExptPairwiseRankRecord {
+	return &ExptPairwiseRankRecord{}
+}
+
+func (p *ExptPairwiseRankRecord) InitDefault() {
+}
+
+func (p *ExptPairwiseRankRecord) GetRecordID() (v int64) {
+	if p != nil {
+		return p.RecordID
+	}
+	return
+}
+
+func (p *ExptPairwiseRankRecord) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
+	}
+	return
+}
+
+func (p *ExptPairwiseRankRecord) GetEvaluatorVersionID() (v int64) {
+	if p != nil {
+		return p.EvaluatorVersionID
+	}
+	return
+}
+
+var ExptPairwiseRankRecord_ExptIds_DEFAULT []int64
+
+func (p *ExptPairwiseRankRecord) GetExptIds() (v []int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExptIds() {
+		return ExptPairwiseRankRecord_ExptIds_DEFAULT
+	}
+	return p.ExptIds
+}
+
+func (p *ExptPairwiseRankRecord) GetStatus() (v ExptPairwiseRankStatus) {
+	if p != nil {
+		return p.Status
+	}
+	return
+}
+
+var ExptPairwiseRankRecord_PairResults_DEFAULT []*ExptPairwiseStat
+
+func (p *ExptPairwiseRankRecord) GetPairResults() (v []*ExptPairwiseStat) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPairResults() {
+		return ExptPairwiseRankRecord_PairResults_DEFAULT
+	}
+	return p.PairResults
+}
+
+var ExptPairwiseRankRecord_Rankings_DEFAULT []*ExptPairwiseRanking
+
+func (p *ExptPairwiseRankRecord) GetRankings() (v []*ExptPairwiseRanking) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRankings() {
+		return ExptPairwiseRankRecord_Rankings_DEFAULT
+	}
+	return p.Rankings
+}
+
+var ExptPairwiseRankRecord_ErrorMsg_DEFAULT string
+
+func (p *ExptPairwiseRankRecord) GetErrorMsg() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetErrorMsg() {
+		return ExptPairwiseRankRecord_ErrorMsg_DEFAULT
+	}
+	return *p.ErrorMsg
+}
+
+var ExptPairwiseRankRecord_BaseInfo_DEFAULT *common.BaseInfo
+
+func (p *ExptPairwiseRankRecord) GetBaseInfo() (v *common.BaseInfo) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseInfo() {
+		return ExptPairwiseRankRecord_BaseInfo_DEFAULT
+	}
+	return p.BaseInfo
+}
+func (p *ExptPairwiseRankRecord) SetRecordID(val int64) {
+	p.RecordID = val
+}
+func (p *ExptPairwiseRankRecord) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
+}
+func (p *ExptPairwiseRankRecord) SetEvaluatorVersionID(val int64) {
+	p.EvaluatorVersionID = val
+}
+func (p *ExptPairwiseRankRecord) SetExptIds(val []int64) {
+	p.ExptIds = val
+}
+func (p *ExptPairwiseRankRecord) SetStatus(val ExptPairwiseRankStatus) {
+	p.Status = val
+}
+func (p *ExptPairwiseRankRecord) SetPairResults(val []*ExptPairwiseStat) {
+	p.PairResults = val
+}
+func (p *ExptPairwiseRankRecord) SetRankings(val []*ExptPairwiseRanking) {
+	p.Rankings = val
+}
+func (p *ExptPairwiseRankRecord) SetErrorMsg(val *string) {
+	p.ErrorMsg = val
+}
+func (p *ExptPairwiseRankRecord) SetBaseInfo(val *common.BaseInfo) {
+	p.BaseInfo = val
+}
+
+var fieldIDToName_ExptPairwiseRankRecord = map[int16]string{
+	1: "record_id",
+	2: "workspace_id",
+	3: "evaluator_version_id",
+	4: "expt_ids",
+	5: "status",
+	6: "pair_results",
+	7: "rankings",
+	8: "error_msg",
+	9: "base_info",
+}
+
+func (p *ExptPairwiseRankRecord) IsSetExptIds() bool {
+	return p.ExptIds != nil
+}
+
+func (p *ExptPairwiseRankRecord) IsSetPairResults() bool {
+	return p.PairResults != nil
+}
+
+func (p *ExptPairwiseRankRecord) IsSetRankings() bool {
+	return p.Rankings != nil
+}
+
+func (p *ExptPairwiseRankRecord) IsSetErrorMsg() bool {
+	return p.ErrorMsg != nil
+}
+
+func (p *ExptPairwiseRankRecord) IsSetBaseInfo() bool {
+	return p.BaseInfo != nil
+}
+
+func (p *ExptPairwiseRankRecord) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetRecordID bool = false
+	var issetWorkspaceID bool = false
+	var issetEvaluatorVersionID bool = false
+	var issetStatus bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRecordID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluatorVersionID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStatus = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetRecordID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluatorVersionID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStatus {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptPairwiseRankRecord[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptPairwiseRankRecord[fieldId]))
+}
+
+func (p *ExptPairwiseRankRecord) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RecordID = _field
+	return nil
+}
+func (p *ExptPairwiseRankRecord) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *ExptPairwiseRankRecord) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EvaluatorVersionID = _field
+	return nil
+}
+func (p *ExptPairwiseRankRecord) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ExptIds = _field
+	return nil
+}
+func (p *ExptPairwiseRankRecord) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field ExptPairwiseRankStatus
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *ExptPairwiseRankRecord) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExptPairwiseStat, 0, size)
+	values := make([]ExptPairwiseStat, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.PairResults = _field
+	return nil
+}
+func (p *ExptPairwiseRankRecord) ReadField7(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExptPairwiseRanking, 0, size)
+	values := make([]ExptPairwiseRanking, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Rankings = _field
+	return nil
+}
+func (p *ExptPairwiseRankRecord) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ErrorMsg = _field
+	return nil
+}
+func (p *ExptPairwiseRankRecord) ReadField9(iprot thrift.TProtocol) error {
+	_field := common.NewBaseInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseInfo = _field
+	return nil
+}
+
+func (p *ExptPairwiseRankRecord) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptPairwiseRankRecord"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptPairwiseRankRecord) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("record_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RecordID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptPairwiseRankRecord) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptPairwiseRankRecord) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvaluatorVersionID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptPairwiseRankRecord) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExptIds() {
+		if err = oprot.WriteFieldBegin("expt_ids", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I64, len(p.ExptIds)); err != nil {
+			return err
+		}
+		for _, v := range p.ExptIds {
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptPairwiseRankRecord) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptPairwiseRankRecord) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPairResults() {
+		if err = oprot.WriteFieldBegin("pair_results", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.PairResults)); err != nil {
+			return err
+		}
+		for _, v := range p.PairResults {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ExptPairwiseRankRecord) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRankings() {
+		if err = oprot.WriteFieldBegin("rankings", thrift.LIST, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Rankings)); err != nil {
+			return err
+		}
+		for _, v := range p.Rankings {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ExptPairwiseRankRecord) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrorMsg() {
+		if err = oprot.WriteFieldBegin("error_msg", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ErrorMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *ExptPairwiseRankRecord) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseInfo() {
+		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *ExptPairwiseRankRecord) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptPairwiseRankRecord(%+v)", *p)
+
+}
+
+func (p *ExptPairwiseRankRecord) DeepEqual(ano *ExptPairwiseRankRecord) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.RecordID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.EvaluatorVersionID) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ExptIds) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.PairResults) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Rankings) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.ErrorMsg) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.BaseInfo) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptPairwiseRankRecord) Field1DeepEqual(src int64) bool {
+
+	if p.RecordID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptPairwiseRankRecord) Field2DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptPairwiseRankRecord) Field3DeepEqual(src int64) bool {
+
+	if p.EvaluatorVersionID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptPairwiseRankRecord) Field4DeepEqual(src []int64) bool {
+
+	if len(p.ExptIds) != len(src) {
+		return false
+	}
+	for i, v := range p.ExptIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+func (p *ExptPairwiseRankRecord) Field5DeepEqual(src ExptPairwiseRankStatus) bool {
+
+	if strings.Compare(p.Status, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptPairwiseRankRecord) Field6DeepEqual(src []*ExptPairwiseStat) bool {
+
+	if len(p.PairResults) != len(src) {
+		return false
+	}
+	for i, v := range p.PairResults {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ExptPairwiseRankRecord) Field7DeepEqual(src []*ExptPairwiseRanking) bool {
+
+	if len(p.Rankings) != len(src) {
+		return false
+	}
+	for i, v := range p.Rankings {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ExptPairwiseRankRecord) Field8DeepEqual(src *string) bool {
+
+	if p.ErrorMsg == src {
+		return true
+	} else if p.ErrorMsg == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ErrorMsg, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptPairwiseRankRecord) Field9DeepEqual(src *common.BaseInfo) bool {
+
+	if !p.BaseInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+// 单个评估器实例在基线与候选实验间的配对显著性统计, 以候选实验视角
+type ExptEvaluatorSignificance struct {
+	EvaluatorVersionID *int64  `thrift:"evaluator_version_id,1,optional" frugal:"1,optional,i64" json:"evaluator_version_id" form:"evaluator_version_id" query:"evaluator_version_id"`
+	Alias              *string `thrift:"alias,2,optional" frugal:"2,optional,string" form:"alias" json:"alias,omitempty" query:"alias"`
+	// 参与配对的 turn 数
+	PairedCount   *int64   `thrift:"paired_count,3,optional" frugal:"3,optional,i64" json:"paired_count" form:"paired_count" query:"paired_count"`
+	BaseMean      *float64 `thrift:"base_mean,4,optional" frugal:"4,optional,double" form:"base_mean" json:"base_mean,omitempty" query:"base_mean"`
+	CandidateMean *float64 `thrift:"candidate_mean,5,optional" frugal:"5,optional,double" form:"candidate_mean" json:"candidate_mean,omitempty" query:"candidate_mean"`
+	// candidate_mean - base_mean
+	MeanDelta *float64 `thrift:"mean_delta,6,optional" frugal:"6,optional,double" form:"mean_delta" json:"mean_delta,omitempty" query:"mean_delta"`
+	// 配对 bootstrap 置信区间下界
+	CiLower *float64 `thrift:"ci_lower,7,optional" frugal:"7,optional,double" form:"ci_lower" json:"ci_lower,omitempty" query:"ci_lower"`
+	// 配对 bootstrap 置信区间上界
+	CiUpper *float64 `thrift:"ci_upper,8,optional" frugal:"8,optional,double" form:"ci_upper" json:"ci_upper,omitempty" query:"ci_upper"`
+	// 配对 t 检验双侧 p 值
+	TTestPValue *float64 `thrift:"t_test_p_value,9,optional" frugal:"9,optional,double" form:"t_test_p_value" json:"t_test_p_value,omitempty" query:"t_test_p_value"`
+	// Wilcoxon 符号秩检验双侧 p 值
+	WilcoxonPValue *float64 `thrift:"wilcoxon_p_value,10,optional" frugal:"10,optional,double" form:"wilcoxon_p_value" json:"wilcoxon_p_value,omitempty" query:"wilcoxon_p_value"`
+	WinCount       *int64   `thrift:"win_count,11,optional" frugal:"11,optional,i64" json:"win_count" form:"win_count" query:"win_count"`
+	TieCount       *int64   `thrift:"tie_count,12,optional" frugal:"12,optional,i64" json:"tie_count" form:"tie_count" query:"tie_count"`
+	LossCount      *int64   `thrift:"loss_count,13,optional" frugal:"13,optional,i64" json:"loss_count" form:"loss_count" query:"loss_count"`
+	// t 检验 p 值小于 1 - confidence 且置信区间不跨 0
+	Significant *bool `thrift:"significant,14,optional" frugal:"14,optional,bool" form:"significant" json:"significant,omitempty" query:"significant"`
+}
+
+func NewExptEvaluatorSignificance() *ExptEvaluatorSignificance {
+	return &ExptEvaluatorSignificance{}
+}
+
+func (p *ExptEvaluatorSignificance) InitDefault() {
+}
+
+var ExptEvaluatorSignificance_EvaluatorVersionID_DEFAULT int64
+
+func (p *ExptEvaluatorSignificance) GetEvaluatorVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorVersionID() {
+		return ExptEvaluatorSignificance_EvaluatorVersionID_DEFAULT
+	}
+	return *p.EvaluatorVersionID
+}
+
+var ExptEvaluatorSignificance_Alias_DEFAULT string
+
+func (p *ExptEvaluatorSignificance) GetAlias() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAlias() {
+		return ExptEvaluatorSignificance_Alias_DEFAULT
+	}
+	return *p.Alias
+}
+
+var ExptEvaluatorSignificance_PairedCount_DEFAULT int64
+
+func (p *ExptEvaluatorSignificance) GetPairedCount() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPairedCount() {
+		return ExptEvaluatorSignificance_PairedCount_DEFAULT
+	}
+	return *p.PairedCount
+}
+
+var ExptEvaluatorSignificance_BaseMean_DEFAULT float64
+
+func (p *ExptEvaluatorSignificance) GetBaseMean() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseMean() {
+		return ExptEvaluatorSignificance_BaseMean_DEFAULT
+	}
+	return *p.BaseMean
+}
+
+var ExptEvaluatorSignificance_CandidateMean_DEFAULT float64
+
+func (p *ExptEvaluatorSignificance) GetCandidateMean() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCandidateMean() {
+		return ExptEvaluatorSignificance_CandidateMean_DEFAULT
+	}
+	return *p.CandidateMean
+}
+
+var ExptEvaluatorSignificance_MeanDelta_DEFAULT float64
+
+func (p *ExptEvaluatorSignificance) GetMeanDelta() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMeanDelta() {
+		return ExptEvaluatorSignificance_MeanDelta_DEFAULT
+	}
+	return *p.MeanDelta
+}
+
+var ExptEvaluatorSignificance_CiLower_DEFAULT float64
+
+func (p *ExptEvaluatorSignificance) GetCiLower() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCiLower() {
+		return ExptEvaluatorSignificance_CiLower_DEFAULT
+	}
+	return *p.CiLower
+}
+
+var ExptEvaluatorSignificance_CiUpper_DEFAULT float64
+
+func (p *ExptEvaluatorSignificance) GetCiUpper() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCiUpper() {
+		return ExptEvaluatorSignificance_CiUpper_DEFAULT
+	}
+	return *p.CiUpper
+}
+
+var ExptEvaluatorSignificance_TTestPValue_DEFAULT float64
+
+func (p *ExptEvaluatorSignificance) GetTTestPValue() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTTestPValue() {
+		return ExptEvaluatorSignificance_TTestPValue_DEFAULT
+	}
+	return *p.TTestPValue
+}
+
+var ExptEvaluatorSignificance_WilcoxonPValue_DEFAULT float64
+
+func (p *ExptEvaluatorSignificance) GetWilcoxonPValue() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWilcoxonPValue() {
+		return ExptEvaluatorSignificance_WilcoxonPValue_DEFAULT
+	}
+	return *p.WilcoxonPValue
+}
+
+var ExptEvaluatorSignificance_WinCount_DEFAULT int64
+
+func (p *ExptEvaluatorSignificance) GetWinCount() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWinCount() {
+		return ExptEvaluatorSignificance_WinCount_DEFAULT
+	}
+	return *p.WinCount
+}
+
+var ExptEvaluatorSignificance_TieCount_DEFAULT int64
+
+func (p *ExptEvaluatorSignificance) GetTieCount() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTieCount() {
+		return ExptEvaluatorSignificance_TieCount_DEFAULT
+	}
+	return *p.TieCount
+}
+
+var ExptEvaluatorSignificance_LossCount_DEFAULT int64
+
+func (p *ExptEvaluatorSignificance) GetLossCount() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLossCount() {
+		return ExptEvaluatorSignificance_LossCount_DEFAULT
+	}
+	return *p.LossCount
+}
+
+var ExptEvaluatorSignificance_Significant_DEFAULT bool
+
+func (p *ExptEvaluatorSignificance) GetSignificant() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSignificant() {
+		return ExptEvaluatorSignificance_Significant_DEFAULT
+	}
+	return *p.Significant
+}
+func (p *ExptEvaluatorSignificance) SetEvaluatorVersionID(val *int64) {
+	p.EvaluatorVersionID = val
+}
+func (p *ExptEvaluatorSignificance) SetAlias(val *string) {
+	p.Alias = val
+}
+func (p *ExptEvaluatorSignificance) SetPairedCount(val *int64) {
+	p.PairedCount = val
+}
+func (p *ExptEvaluatorSignificance) SetBaseMean(val *float64) {
+	p.BaseMean = val
+}
+func (p *ExptEvaluatorSignificance) SetCandidateMean(val *float64) {
+	p.CandidateMean = val
+}
+func (p *ExptEvaluatorSignificance) SetMeanDelta(val *float64) {
+	p.MeanDelta = val
+}
+func (p *ExptEvaluatorSignificance) SetCiLower(val *float64) {
+	p.CiLower = val
+}
+func (p *ExptEvaluatorSignificance) SetCiUpper(val *float64) {
+	p.CiUpper = val
+}
+func (p *ExptEvaluatorSignificance) SetTTestPValue(val *float64) {
+	p.TTestPValue = val
+}
+func (p *ExptEvaluatorSignificance) SetWilcoxonPValue(val *float64) {
+	p.WilcoxonPValue = val
+}
+func (p *ExptEvaluatorSignificance) SetWinCount(val *int64) {
+	p.WinCount = val
+}
+func (p *ExptEvaluatorSignificance) SetTieCount(val *int64) {
+	p.TieCount = val
+}
+func (p *ExptEvaluatorSignificance) SetLossCount(val *int64) {
+	p.LossCount = val
+}
+func (p *ExptEvaluatorSignificance) SetSignificant(val *bool) {
+	p.Significant = val
+}
+
+var fieldIDToName_ExptEvaluatorSignificance = map[int16]string{
+	1:  "evaluator_version_id",
+	2:  "alias",
+	3:  "paired_count",
+	4:  "base_mean",
+	5:  "candidate_mean",
+	6:  "mean_delta",
+	7:  "ci_lower",
+	8:  "ci_upper",
+	9:  "t_test_p_value",
+	10: "wilcoxon_p_value",
+	11: "win_count",
+	12: "tie_count",
+	13: "loss_count",
+	14: "significant",
+}
+
+func (p *ExptEvaluatorSignificance) IsSetEvaluatorVersionID() bool {
+	return p.EvaluatorVersionID != nil
+}
+
+func (p *ExptEvaluatorSignificance) IsSetAlias() bool {
+	return p.Alias != nil
+}
+
+func (p *ExptEvaluatorSignificance) IsSetPairedCount() bool {
+	return p.PairedCount != nil
+}
+
+func (p *ExptEvaluatorSignificance) IsSetBaseMean() bool {
+	return p.BaseMean != nil
+}
+
+func (p *ExptEvaluatorSignificance) IsSetCandidateMean() bool {
+	return p.CandidateMean != nil
+}
+
+func (p *ExptEvaluatorSignificance) IsSetMeanDelta() bool {
+	return p.MeanDelta != nil
+}
+
+func (p *ExptEvaluatorSignificance) IsSetCiLower() bool {
+	return p.CiLower != nil
+}
+
+func (p *ExptEvaluatorSignificance) IsSetCiUpper() bool {
+	return p.CiUpper != nil
+}
+
+func (p *ExptEvaluatorSignificance) IsSetTTestPValue() bool {
+	return p.TTestPValue != nil
+}
+
+func (p *ExptEvaluatorSignificance) IsSetWilcoxonPValue() bool {
+	return p.WilcoxonPValue != nil
+}
+
+func (p *ExptEvaluatorSignificance) IsSetWinCount() bool {
+	return p.WinCount != nil
+}
+
+func (p *ExptEvaluatorSignificance) IsSetTieCount() bool {
+	return p.TieCount != nil
+}
+
+func (p *ExptEvaluatorSignificance) IsSetLossCount() bool {
+	return p.LossCount != nil
+}
+
+func (p *ExptEvaluatorSignificance) IsSetSignificant() bool {
+	return p.Significant != nil
+}
+
+func (p *ExptEvaluatorSignificance) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptEvaluatorSignificance[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptEvaluatorSignificance) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatorVersionID = _field
+	return nil
+}
+func (p *ExptEvaluatorSignificance) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Alias = _field
+	return nil
+}
+func (p *ExptEvaluatorSignificance) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PairedCount = _field
+	return nil
+}
+func (p *ExptEvaluatorSignificance) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaseMean = _field
+	return nil
+}
+func (p *ExptEvaluatorSignificance) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CandidateMean = _field
+	return nil
+}
+func (p *ExptEvaluatorSignificance) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MeanDelta = _field
+	return nil
+}
+func (p *ExptEvaluatorSignificance) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CiLower = _field
+	return nil
+}
+func (p *ExptEvaluatorSignificance) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CiUpper = _field
+	return nil
+}
+func (p *ExptEvaluatorSignificance) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TTestPValue = _field
+	return nil
+}
+func (p *ExptEvaluatorSignificance) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WilcoxonPValue = _field
+	return nil
+}
+func (p *ExptEvaluatorSignificance) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WinCount = _field
+	return nil
+}
+func (p *ExptEvaluatorSignificance) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TieCount = _field
+	return nil
+}
+func (p *ExptEvaluatorSignificance) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LossCount = _field
+	return nil
+}
+func (p *ExptEvaluatorSignificance) ReadField14(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Significant = _field
+	return nil
+}
+
+func (p *ExptEvaluatorSignificance) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptEvaluatorSignificance"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptEvaluatorSignificance) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersionID() {
+		if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluatorVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptEvaluatorSignificance) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAlias() {
+		if err = oprot.WriteFieldBegin("alias", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Alias); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptEvaluatorSignificance) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPairedCount() {
+		if err = oprot.WriteFieldBegin("paired_count", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.PairedCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptEvaluatorSignificance) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseMean() {
+		if err = oprot.WriteFieldBegin("base_mean", thrift.DOUBLE, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.BaseMean); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptEvaluatorSignificance) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCandidateMean() {
+		if err = oprot.WriteFieldBegin("candidate_mean", thrift.DOUBLE, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.CandidateMean); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptEvaluatorSignificance) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMeanDelta() {
+		if err = oprot.WriteFieldBegin("mean_delta", thrift.DOUBLE, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MeanDelta); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ExptEvaluatorSignificance) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCiLower() {
+		if err = oprot.WriteFieldBegin("ci_lower", thrift.DOUBLE, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.CiLower); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ExptEvaluatorSignificance) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCiUpper() {
+		if err = oprot.WriteFieldBegin("ci_upper", thrift.DOUBLE, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.CiUpper); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *ExptEvaluatorSignificance) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTTestPValue() {
+		if err = oprot.WriteFieldBegin("t_test_p_value", thrift.DOUBLE, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.TTestPValue); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *ExptEvaluatorSignificance) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWilcoxonPValue() {
+		if err = oprot.WriteFieldBegin("wilcoxon_p_value", thrift.DOUBLE, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.WilcoxonPValue); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *ExptEvaluatorSignificance) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWinCount() {
+		if err = oprot.WriteFieldBegin("win_count", thrift.I64, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WinCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *ExptEvaluatorSignificance) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTieCount() {
+		if err = oprot.WriteFieldBegin("tie_count", thrift.I64, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TieCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *ExptEvaluatorSignificance) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLossCount() {
+		if err = oprot.WriteFieldBegin("loss_count", thrift.I64, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.LossCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+func (p *ExptEvaluatorSignificance) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSignificant() {
+		if err = oprot.WriteFieldBegin("significant", thrift.BOOL, 14); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Significant); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+
+func (p *ExptEvaluatorSignificance) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptEvaluatorSignificance(%+v)", *p)
+
+}
+
+func (p *ExptEvaluatorSignificance) DeepEqual(ano *ExptEvaluatorSignificance) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Alias) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.PairedCount) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.BaseMean) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.CandidateMean) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.MeanDelta) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.CiLower) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.CiUpper) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.TTestPValue) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.WilcoxonPValue) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.WinCount) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.TieCount) {
+		return false
+	}
+	if !p.Field13DeepEqual(ano.LossCount) {
+		return false
+	}
+	if !p.Field14DeepEqual(ano.Significant) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptEvaluatorSignificance) Field1DeepEqual(src *int64) bool {
+
+	if p.EvaluatorVersionID == src {
+		return true
+	} else if p.EvaluatorVersionID == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatorVersionID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorSignificance) Field2DeepEqual(src *string) bool {
+
+	if p.Alias == src {
+		return true
+	} else if p.Alias == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Alias, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorSignificance) Field3DeepEqual(src *int64) bool {
+
+	if p.PairedCount == src {
+		return true
+	} else if p.PairedCount == nil || src == nil {
+		return false
+	}
+	if *p.PairedCount != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorSignificance) Field4DeepEqual(src *float64) bool {
+
+	if p.BaseMean == src {
+		return true
+	} else if p.BaseMean == nil || src == nil {
+		return false
+	}
+	if *p.BaseMean != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorSignificance) Field5DeepEqual(src *float64) bool {
+
+	if p.CandidateMean == src {
+		return true
+	} else if p.CandidateMean == nil || src == nil {
+		return false
+	}
+	if *p.CandidateMean != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorSignificance) Field6DeepEqual(src *float64) bool {
+
+	if p.MeanDelta == src {
+		return true
+	} else if p.MeanDelta == nil || src == nil {
+		return false
+	}
+	if *p.MeanDelta != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorSignificance) Field7DeepEqual(src *float64) bool {
+
+	if p.CiLower == src {
+		return true
+	} else if p.CiLower == nil || src == nil {
+		return false
+	}
+	if *p.CiLower != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorSignificance) Field8DeepEqual(src *float64) bool {
+
+	if p.CiUpper == src {
+		return true
+	} else if p.CiUpper == nil || src == nil {
+		return false
+	}
+	if *p.CiUpper != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorSignificance) Field9DeepEqual(src *float64) bool {
+
+	if p.TTestPValue == src {
+		return true
+	} else if p.TTestPValue == nil || src == nil {
+		return false
+	}
+	if *p.TTestPValue != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorSignificance) Field10DeepEqual(src *float64) bool {
+
+	if p.WilcoxonPValue == src {
+		return true
+	} else if p.WilcoxonPValue == nil || src == nil {
+		return false
+	}
+	if *p.WilcoxonPValue != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorSignificance) Field11DeepEqual(src *int64) bool {
+
+	if p.WinCount == src {
+		return true
+	} else if p.WinCount == nil || src == nil {
+		return false
+	}
+	if *p.WinCount != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorSignificance) Field12DeepEqual(src *int64) bool {
+
+	if p.TieCount == src {
+		return true
+	} else if p.TieCount == nil || src == nil {
+		return false
+	}
+	if *p.TieCount != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorSignificance) Field13DeepEqual(src *int64) bool {
+
+	if p.LossCount == src {
+		return true
+	} else if p.LossCount == nil || src == nil {
+		return false
+	}
+	if *p.LossCount != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptEvaluatorSignificance) Field14DeepEqual(src *bool) bool {
+
+	if p.Significant == src {
+		return true
+	} else if p.Significant == nil || src == nil {
+		return false
+	}
+	if *p.Significant != *src {
+		return false
+	}
+	return true
+}
+
+// 基线实验与一个候选实验的对比
+type ExptPairSignificance struct {
+	CandidateExptID *int64 `thrift:"candidate_expt_id,1,optional" frugal:"1,optional,i64" json:"candidate_expt_id" form:"candidate_expt_id" query:"candidate_expt_id"`
+	// 仅包含两侧实验都绑定的评估器实例
+	EvaluatorSignificances []*ExptEvaluatorSignificance `thrift:"evaluator_significances,2,optional" frugal:"2,optional,list<ExptEvaluatorSignificance>" form:"evaluator_significances" json:"evaluator_significances,omitempty" query:"evaluator_significances"`
+}
+
+func NewExptPairSignificance() *ExptPairSignificance {
+	return &ExptPairSignificance{}
+}
+
+func (p *ExptPairSignificance) InitDefault() {
+}
+
+var ExptPairSignificance_CandidateExptID_DEFAULT int64
+
+func (p *ExptPairSignificance) GetCandidateExptID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCandidateExptID() {
+		return ExptPairSignificance_CandidateExptID_DEFAULT
+	}
+	return *p.CandidateExptID
+}
+
+var ExptPairSignificance_EvaluatorSignificances_DEFAULT []*ExptEvaluatorSignificance
+
+func (p *ExptPairSignificance) GetEvaluatorSignificances() (v []*ExptEvaluatorSignificance) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorSignificances() {
+		return ExptPairSignificance_EvaluatorSignificances_DEFAULT
+	}
+	return p.EvaluatorSignificances
+}
+func (p *ExptPairSignificance) SetCandidateExptID(val *int64) {
+	p.CandidateExptID = val
+}
+func (p *ExptPairSignificance) SetEvaluatorSignificances(val []*ExptEvaluatorSignificance) {
+	p.EvaluatorSignificances = val
+}
+
+var fieldIDToName_ExptPairSignificance = map[int16]string{
+	1: "candidate_expt_id",
+	2: "evaluator_significances",
+}
+
+func (p *ExptPairSignificance) IsSetCandidateExptID() bool {
+	return p.CandidateExptID != nil
+}
+
+func (p *ExptPairSignificance) IsSetEvaluatorSignificances() bool {
+	return p.EvaluatorSignificances != nil
+}
+
+func (p *ExptPairSignificance) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptPairSignificance[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptPairSignificance) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CandidateExptID = _field
+	return nil
+}
+func (p *ExptPairSignificance) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExptEvaluatorSignificance, 0, size)
+	values := make([]ExptEvaluatorSignificance, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.EvaluatorSignificances = _field
+	return nil
+}
+
+func (p *ExptPairSignificance) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptPairSignificance"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptPairSignificance) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCandidateExptID() {
+		if err = oprot.WriteFieldBegin("candidate_expt_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.CandidateExptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptPairSignificance) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorSignificances() {
+		if err = oprot.WriteFieldBegin("evaluator_significances", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.EvaluatorSignificances)); err != nil {
+			return err
+		}
+		for _, v := range p.EvaluatorSignificances {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ExptPairSignificance) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptPairSignificance(%+v)", *p)
+
+}
+
+func (p *ExptPairSignificance) DeepEqual(ano *ExptPairSignificance) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.CandidateExptID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.EvaluatorSignificances) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptPairSignificance) Field1DeepEqual(src *int64) bool {
+
+	if p.CandidateExptID == src {
+		return true
+	} else if p.CandidateExptID == nil || src == nil {
+		return false
+	}
+	if *p.CandidateExptID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptPairSignificance) Field2DeepEqual(src []*ExptEvaluatorSignificance) bool {
+
+	if len(p.EvaluatorSignificances) != len(src) {
+		return false
+	}
+	for i, v := range p.EvaluatorSignificances {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type ExptQualityGateRule struct {
+	// 规则展示名, 为空时按 metric 生成
+	Name   *string            `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	Metric *QualityGateMetric `thrift:"metric,2,optional" frugal:"2,optional,string" form:"metric" json:"metric,omitempty" query:"metric"`
+	// >= / > / <= / < / ==
+	Operator  *string  `thrift:"operator,3,optional" frugal:"3,optional,string" form:"operator" json:"operator,omitempty" query:"operator"`
+	Threshold *float64 `thrift:"threshold,4,optional" frugal:"4,optional,double" form:"threshold" json:"threshold,omitempty" query:"threshold"`
+	// 仅 evaluator_avg_score 使用
+	EvaluatorVersionID *int64 `thrift:"evaluator_version_id,5,optional" frugal:"5,optional,i64" json:"evaluator_version_id" form:"evaluator_version_id" query:"evaluator_version_id"`
+	// 仅 evaluator_avg_score 使用
+	EvaluatorAlias *string `thrift:"evaluator_alias,6,optional" frugal:"6,optional,string" form:"evaluator_alias" json:"evaluator_alias,omitempty" query:"evaluator_alias"`
+	// 仅 regression_rate 使用
+	BaselineExptID *int64 `thrift:"baseline_expt_id,7,optional" frugal:"7,optional,i64" json:"baseline_expt_id" form:"baseline_expt_id" query:"baseline_expt_id"`
+	// 仅 regression_rate 使用, 单个 turn 得分下降超过该值才视为退化
+	ScoreTolerance *float64 `thrift:"score_tolerance,8,optional" frugal:"8,optional,double" form:"score_tolerance" json:"score_tolerance,omitempty" query:"score_tolerance"`
+}
+
+func NewExptQualityGateRule() *ExptQualityGateRule {
+	return &ExptQualityGateRule{}
+}
+
+func (p *ExptQualityGateRule) InitDefault() {
+}
+
+var ExptQualityGateRule_Name_DEFAULT string
+
+func (p *ExptQualityGateRule) GetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetName() {
+		return ExptQualityGateRule_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var ExptQualityGateRule_Metric_DEFAULT QualityGateMetric
+
+func (p *ExptQualityGateRule) GetMetric() (v QualityGateMetric) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMetric() {
+		return ExptQualityGateRule_Metric_DEFAULT
+	}
+	return *p.Metric
+}
+
+var ExptQualityGateRule_Operator_DEFAULT string
+
+func (p *ExptQualityGateRule) GetOperator() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOperator() {
+		return ExptQualityGateRule_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+
+var ExptQualityGateRule_Threshold_DEFAULT float64
+
+func (p *ExptQualityGateRule) GetThreshold() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetThreshold() {
+		return ExptQualityGateRule_Threshold_DEFAULT
+	}
+	return *p.Threshold
+}
+
+var ExptQualityGateRule_EvaluatorVersionID_DEFAULT int64
+
+func (p *ExptQualityGateRule) GetEvaluatorVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorVersionID() {
+		return ExptQualityGateRule_EvaluatorVersionID_DEFAULT
+	}
+	return *p.EvaluatorVersionID
+}
+
+var ExptQualityGateRule_EvaluatorAlias_DEFAULT string
+
+func (p *ExptQualityGateRule) GetEvaluatorAlias() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorAlias() {
+		return ExptQualityGateRule_EvaluatorAlias_DEFAULT
+	}
+	return *p.EvaluatorAlias
+}
+
+var ExptQualityGateRule_BaselineExptID_DEFAULT int64
+
+func (p *ExptQualityGateRule) GetBaselineExptID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaselineExptID() {
+		return ExptQualityGateRule_BaselineExptID_DEFAULT
+	}
+	return *p.BaselineExptID
+}
+
+var ExptQualityGateRule_ScoreTolerance_DEFAULT float64
+
+func (p *ExptQualityGateRule) GetScoreTolerance() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScoreTolerance() {
+		return ExptQualityGateRule_ScoreTolerance_DEFAULT
+	}
+	return *p.ScoreTolerance
+}
+func (p *ExptQualityGateRule) SetName(val *string) {
+	p.Name = val
+}
+func (p *ExptQualityGateRule) SetMetric(val *QualityGateMetric) {
+	p.Metric = val
+}
+func (p *ExptQualityGateRule) SetOperator(val *string) {
+	p.Operator = val
+}
+func (p *ExptQualityGateRule) SetThreshold(val *float64) {
+	p.Threshold = val
+}
+func (p *ExptQualityGateRule) SetEvaluatorVersionID(val *int64) {
+	p.EvaluatorVersionID = val
+}
+func (p *ExptQualityGateRule) SetEvaluatorAlias(val *string) {
+	p.EvaluatorAlias = val
+}
+func (p *ExptQualityGateRule) SetBaselineExptID(val *int64) {
+	p.BaselineExptID = val
+}
+func (p *ExptQualityGateRule) SetScoreTolerance(val *float64) {
+	p.ScoreTolerance = val
+}
+
+var fieldIDToName_ExptQualityGateRule = map[int16]string{
+	1: "name",
+	2: "metric",
+	3: "operator",
+	4: "threshold",
+	5: "evaluator_version_id",
+	6: "evaluator_alias",
+	7: "baseline_expt_id",
+	8: "score_tolerance",
+}
+
+func (p *ExptQualityGateRule) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *ExptQualityGateRule) IsSetMetric() bool {
+	return p.Metric != nil
+}
+
+func (p *ExptQualityGateRule) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *ExptQualityGateRule) IsSetThreshold() bool {
+	return p.Threshold != nil
+}
+
+func (p *ExptQualityGateRule) IsSetEvaluatorVersionID() bool {
+	return p.EvaluatorVersionID != nil
+}
+
+func (p *ExptQualityGateRule) IsSetEvaluatorAlias() bool {
+	return p.EvaluatorAlias != nil
+}
+
+func (p *ExptQualityGateRule) IsSetBaselineExptID() bool {
+	return p.BaselineExptID != nil
+}
+
+func (p *ExptQualityGateRule) IsSetScoreTolerance() bool {
+	return p.ScoreTolerance != nil
+}
+
+func (p *ExptQualityGateRule) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptQualityGateRule[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptQualityGateRule) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *ExptQualityGateRule) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *QualityGateMetric
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Metric = _field
+	return nil
+}
+func (p *ExptQualityGateRule) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Operator = _field
+	return nil
+}
+func (p *ExptQualityGateRule) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Threshold = _field
+	return nil
+}
+func (p *ExptQualityGateRule) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatorVersionID = _field
+	return nil
+}
+func (p *ExptQualityGateRule) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatorAlias = _field
+	return nil
+}
+func (p *ExptQualityGateRule) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaselineExptID = _field
+	return nil
+}
+func (p *ExptQualityGateRule) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ScoreTolerance = _field
+	return nil
+}
+
+func (p *ExptQualityGateRule) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptQualityGateRule"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptQualityGateRule) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptQualityGateRule) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMetric() {
+		if err = oprot.WriteFieldBegin("metric", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Metric); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptQualityGateRule) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOperator() {
+		if err = oprot.WriteFieldBegin("operator", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Operator); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptQualityGateRule) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetThreshold() {
+		if err = oprot.WriteFieldBegin("threshold", thrift.DOUBLE, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Threshold); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptQualityGateRule) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersionID() {
+		if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluatorVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptQualityGateRule) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorAlias() {
+		if err = oprot.WriteFieldBegin("evaluator_alias", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.EvaluatorAlias); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ExptQualityGateRule) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaselineExptID() {
+		if err = oprot.WriteFieldBegin("baseline_expt_id", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.BaselineExptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ExptQualityGateRule) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScoreTolerance() {
+		if err = oprot.WriteFieldBegin("score_tolerance", thrift.DOUBLE, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.ScoreTolerance); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *ExptQualityGateRule) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptQualityGateRule(%+v)", *p)
+
+}
+
+func (p *ExptQualityGateRule) DeepEqual(ano *ExptQualityGateRule) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Metric) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Operator) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Threshold) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.EvaluatorVersionID) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.EvaluatorAlias) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.BaselineExptID) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.ScoreTolerance) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptQualityGateRule) Field1DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRule) Field2DeepEqual(src *QualityGateMetric) bool {
+
+	if p.Metric == src {
+		return true
+	} else if p.Metric == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Metric, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRule) Field3DeepEqual(src *string) bool {
+
+	if p.Operator == src {
+		return true
+	} else if p.Operator == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Operator, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRule) Field4DeepEqual(src *float64) bool {
+
+	if p.Threshold == src {
+		return true
+	} else if p.Threshold == nil || src == nil {
+		return false
+	}
+	if *p.Threshold != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRule) Field5DeepEqual(src *int64) bool {
+
+	if p.EvaluatorVersionID == src {
+		return true
+	} else if p.EvaluatorVersionID == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatorVersionID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRule) Field6DeepEqual(src *string) bool {
+
+	if p.EvaluatorAlias == src {
+		return true
+	} else if p.EvaluatorAlias == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.EvaluatorAlias, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRule) Field7DeepEqual(src *int64) bool {
+
+	if p.BaselineExptID == src {
+		return true
+	} else if p.BaselineExptID == nil || src == nil {
+		return false
+	}
+	if *p.BaselineExptID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRule) Field8DeepEqual(src *float64) bool {
+
+	if p.ScoreTolerance == src {
+		return true
+	} else if p.ScoreTolerance == nil || src == nil {
+		return false
+	}
+	if *p.ScoreTolerance != *src {
+		return false
+	}
+	return true
+}
+
+// 质量门禁配置, 实验结束时逐条评估, 全部规则通过才算通过
+type ExptQualityGateConf struct {
+	Rules []*ExptQualityGateRule `thrift:"rules,1,optional" frugal:"1,optional,list<ExptQualityGateRule>" form:"rules" json:"rules,omitempty" query:"rules"`
+}
+
+func NewExptQualityGateConf() *ExptQualityGateConf {
+	return &ExptQualityGateConf{}
+}
+
+func (p *ExptQualityGateConf) InitDefault() {
+}
+
+var ExptQualityGateConf_Rules_DEFAULT []*ExptQualityGateRule
+
+func (p *ExptQualityGateConf) GetRules() (v []*ExptQualityGateRule) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRules() {
+		return ExptQualityGateConf_Rules_DEFAULT
+	}
+	return p.Rules
+}
+func (p *ExptQualityGateConf) SetRules(val []*ExptQualityGateRule) {
+	p.Rules = val
+}
+
+var fieldIDToName_ExptQualityGateConf = map[int16]string{
+	1: "rules",
+}
+
+func (p *ExptQualityGateConf) IsSetRules() bool {
+	return p.Rules != nil
+}
+
+func (p *ExptQualityGateConf) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptQualityGateConf[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptQualityGateConf) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExptQualityGateRule, 0, size)
+	values := make([]ExptQualityGateRule, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Rules = _field
+	return nil
+}
+
+func (p *ExptQualityGateConf) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptQualityGateConf"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptQualityGateConf) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRules() {
+		if err = oprot.WriteFieldBegin("rules", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Rules)); err != nil {
+			return err
+		}
+		for _, v := range p.Rules {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ExptQualityGateConf) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptQualityGateConf(%+v)", *p)
+
+}
+
+func (p *ExptQualityGateConf) DeepEqual(ano *ExptQualityGateConf) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Rules) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptQualityGateConf) Field1DeepEqual(src []*ExptQualityGateRule) bool {
+
+	if len(p.Rules) != len(src) {
+		return false
+	}
+	for i, v := range p.Rules {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type ExptQualityGateRuleResult_ struct {
+	Rule *ExptQualityGateRule `thrift:"rule,1,optional" frugal:"1,optional,ExptQualityGateRule" form:"rule" json:"rule,omitempty" query:"rule"`
+	// 实际度量值, 无法计算时为空
+	Actual  *float64           `thrift:"actual,2,optional" frugal:"2,optional,double" form:"actual" json:"actual,omitempty" query:"actual"`
+	Status  *QualityGateStatus `thrift:"status,3,optional" frugal:"3,optional,string" form:"status" json:"status,omitempty" query:"status"`
+	Message *string            `thrift:"message,4,optional" frugal:"4,optional,string" form:"message" json:"message,omitempty" query:"message"`
+}
+
+func NewExptQualityGateRuleResult_() *ExptQualityGateRuleResult_ {
+	return &ExptQualityGateRuleResult_{}
+}
+
+func (p *ExptQualityGateRuleResult_) InitDefault() {
+}
+
+var ExptQualityGateRuleResult__Rule_DEFAULT *ExptQualityGateRule
+
+func (p *ExptQualityGateRuleResult_) GetRule() (v *ExptQualityGateRule) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRule() {
+		return ExptQualityGateRuleResult__Rule_DEFAULT
+	}
+	return p.Rule
+}
+
+var ExptQualityGateRuleResult__Actual_DEFAULT float64
+
+func (p *ExptQualityGateRuleResult_) GetActual() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetActual() {
+		return ExptQualityGateRuleResult__Actual_DEFAULT
+	}
+	return *p.Actual
+}
+
+var ExptQualityGateRuleResult__Status_DEFAULT QualityGateStatus
+
+func (p *ExptQualityGateRuleResult_) GetStatus() (v QualityGateStatus) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStatus() {
+		return ExptQualityGateRuleResult__Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var ExptQualityGateRuleResult__Message_DEFAULT string
+
+func (p *ExptQualityGateRuleResult_) GetMessage() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMessage() {
+		return ExptQualityGateRuleResult__Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *ExptQualityGateRuleResult_) SetRule(val *ExptQualityGateRule) {
+	p.Rule = val
+}
+func (p *ExptQualityGateRuleResult_) SetActual(val *float64) {
+	p.Actual = val
+}
+func (p *ExptQualityGateRuleResult_) SetStatus(val *QualityGateStatus) {
+	p.Status = val
+}
+func (p *ExptQualityGateRuleResult_) SetMessage(val *string) {
+	p.Message = val
+}
+
+var fieldIDToName_ExptQualityGateRuleResult_ = map[int16]string{
+	1: "rule",
+	2: "actual",
+	3: "status",
+	4: "message",
+}
+
+func (p *ExptQualityGateRuleResult_) IsSetRule() bool {
+	return p.Rule != nil
+}
+
+func (p *ExptQualityGateRuleResult_) IsSetActual() bool {
+	return p.Actual != nil
+}
+
+func (p *ExptQualityGateRuleResult_) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *ExptQualityGateRuleResult_) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ExptQualityGateRuleResult_) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptQualityGateRuleResult_[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptQualityGateRuleResult_) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewExptQualityGateRule()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Rule = _field
+	return nil
+}
+func (p *ExptQualityGateRuleResult_) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Actual = _field
+	return nil
+}
+func (p *ExptQualityGateRuleResult_) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *QualityGateStatus
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *ExptQualityGateRuleResult_) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Message = _field
+	return nil
+}
+
+func (p *ExptQualityGateRuleResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptQualityGateRuleResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptQualityGateRuleResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRule() {
+		if err = oprot.WriteFieldBegin("rule", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Rule.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptQualityGateRuleResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetActual() {
+		if err = oprot.WriteFieldBegin("actual", thrift.DOUBLE, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Actual); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptQualityGateRuleResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptQualityGateRuleResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMessage() {
+		if err = oprot.WriteFieldBegin("message", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Message); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ExptQualityGateRuleResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptQualityGateRuleResult_(%+v)", *p)
+
+}
+
+func (p *ExptQualityGateRuleResult_) DeepEqual(ano *ExptQualityGateRuleResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Rule) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Actual) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Message) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptQualityGateRuleResult_) Field1DeepEqual(src *ExptQualityGateRule) bool {
+
+	if !p.Rule.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRuleResult_) Field2DeepEqual(src *float64) bool {
+
+	if p.Actual == src {
+		return true
+	} else if p.Actual == nil || src == nil {
+		return false
+	}
+	if *p.Actual != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRuleResult_) Field3DeepEqual(src *QualityGateStatus) bool {
+
+	if p.Status == src {
+		return true
+	} else if p.Status == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Status, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRuleResult_) Field4DeepEqual(src *string) bool {
+
+	if p.Message == src {
+		return true
+	} else if p.Message == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Message, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+// 质量门禁评估结果, 实验结束后异步写入
+type ExptQualityGateResult_ struct {
+	Status      *QualityGateStatus            `thrift:"status,1,optional" frugal:"1,optional,string" form:"status" json:"status,omitempty" query:"status"`
+	Passed      *bool                         `thrift:"passed,2,optional" frugal:"2,optional,bool" form:"passed" json:"passed,omitempty" query:"passed"`
+	RuleResults []*ExptQualityGateRuleResult_ `thrift:"rule_results,3,optional" frugal:"3,optional,list<ExptQualityGateRuleResult_>" form:"rule_results" json:"rule_results,omitempty" query:"rule_results"`
+	// 毫秒时间戳
+	EvaluatedAt *int64 `thrift:"evaluated_at,4,optional" frugal:"4,optional,i64" json:"evaluated_at" form:"evaluated_at" query:"evaluated_at"`
+}
+
+func NewExptQualityGateResult_() *ExptQualityGateResult_ {
+	return &ExptQualityGateResult_{}
+}
+
+func (p *ExptQualityGateResult_) InitDefault() {
+}
+
+var ExptQualityGateResult__Status_DEFAULT QualityGateStatus
+
+func (p *ExptQualityGateResult_) GetStatus() (v QualityGateStatus) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStatus() {
+		return ExptQualityGateResult__Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var ExptQualityGateResult__Passed_DEFAULT bool
+
+func (p *ExptQualityGateResult_) GetPassed() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPassed() {
+		return ExptQualityGateResult__Passed_DEFAULT
+	}
+	return *p.Passed
+}
+
+var ExptQualityGateResult__RuleResults_DEFAULT []*ExptQualityGateRuleResult_
+
+func (p *ExptQualityGateResult_) GetRuleResults() (v []*ExptQualityGateRuleResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRuleResults() {
+		return ExptQualityGateResult__RuleResults_DEFAULT
+	}
+	return p.RuleResults
+}
+
+var ExptQualityGateResult__EvaluatedAt_DEFAULT int64
+
+func (p *ExptQualityGateResult_) GetEvaluatedAt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatedAt() {
+		return ExptQualityGateResult__EvaluatedAt_DEFAULT
+	}
+	return *p.EvaluatedAt
+}
+func (p *ExptQualityGateResult_) SetStatus(val *QualityGateStatus) {
+	p.Status = val
+}
+func (p *ExptQualityGateResult_) SetPassed(val *bool) {
+	p.Passed = val
+}
+func (p *ExptQualityGateResult_) SetRuleResults(val []*ExptQualityGateRuleResult_) {
+	p.RuleResults = val
+}
+func (p *ExptQualityGateResult_) SetEvaluatedAt(val *int64) {
+	p.EvaluatedAt = val
+}
+
+var fieldIDToName_ExptQualityGateResult_ = map[int16]string{
+	1: "status",
+	2: "passed",
+	3: "rule_results",
+	4: "evaluated_at",
+}
+
+func (p *ExptQualityGateResult_) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *ExptQualityGateResult_) IsSetPassed() bool {
+	return p.Passed != nil
+}
+
+func (p *ExptQualityGateResult_) IsSetRuleResults() bool {
+	return p.RuleResults != nil
+}
+
+func (p *ExptQualityGateResult_) IsSetEvaluatedAt() bool {
+	return p.EvaluatedAt != nil
+}
+
+func (p *ExptQualityGateResult_) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptQualityGateResult_[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptQualityGateResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *QualityGateStatus
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *ExptQualityGateResult_) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Passed = _field
+	return nil
+}
+func (p *ExptQualityGateResult_) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExptQualityGateRuleResult_, 0, size)
+	values := make([]ExptQualityGateRuleResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.RuleResults = _field
+	return nil
+}
+func (p *ExptQualityGateResult_) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatedAt = _field
+	return nil
+}
+
+func (p *ExptQualityGateResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptQualityGateResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptQualityGateResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptQualityGateResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPassed() {
+		if err = oprot.WriteFieldBegin("passed", thrift.BOOL, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Passed); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptQualityGateResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRuleResults() {
+		if err = oprot.WriteFieldBegin("rule_results", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RuleResults)); err != nil {
+			return err
+		}
+		for _, v := range p.RuleResults {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptQualityGateResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatedAt() {
+		if err = oprot.WriteFieldBegin("evaluated_at", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluatedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ExptQualityGateResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptQualityGateResult_(%+v)", *p)
+
+}
+
+func (p *ExptQualityGateResult_) DeepEqual(ano *ExptQualityGateResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Passed) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.RuleResults) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.EvaluatedAt) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptQualityGateResult_) Field1DeepEqual(src *QualityGateStatus) bool {
+
+	if p.Status == src {
+		return true
+	} else if p.Status == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Status, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateResult_) Field2DeepEqual(src *bool) bool {
+
+	if p.Passed == src {
+		return true
+	} else if p.Passed == nil || src == nil {
+		return false
+	}
+	if *p.Passed != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateResult_) Field3DeepEqual(src []*ExptQualityGateRuleResult_) bool {
+
+	if len(p.RuleResults) != len(src) {
+		return false
+	}
+	for i, v := range p.RuleResults {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ExptQualityGateResult_) Field4DeepEqual(src *int64) bool {
+
+	if p.EvaluatedAt == src {
+		return true
+	} else if p.EvaluatedAt == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatedAt != *src {
+		return false
+	}
+	return true
+}
+
+// 候选实验相对基线的变化统计
+type ExptRegressionSummary struct {
+	CandidateExptID *int64 `thrift:"candidate_expt_id,1,optional" frugal:"1,optional,i64" json:"candidate_expt_id" form:"candidate_expt_id" query:"candidate_expt_id"`
+	// 两侧都存在的 turn 数 (经基线筛选后)
+	PairedTurnCnt *int64 `thrift:"paired_turn_cnt,2,optional" frugal:"2,optional,i64" json:"paired_turn_cnt" form:"paired_turn_cnt" query:"paired_turn_cnt"`
+	ImprovedCnt   *int64 `thrift:"improved_cnt,3,optional" frugal:"3,optional,i64" json:"improved_cnt" form:"improved_cnt" query:"improved_cnt"`
+	RegressedCnt  *int64 `thrift:"regressed_cnt,4,optional" frugal:"4,optional,i64" json:"regressed_cnt" form:"regressed_cnt" query:"regressed_cnt"`
+	MixedCnt      *int64 `thrift:"mixed_cnt,5,optional" frugal:"5,optional,i64" json:"mixed_cnt" form:"mixed_cnt" query:"mixed_cnt"`
+	UnchangedCnt  *int64 `thrift:"unchanged_cnt,6,optional" frugal:"6,optional,i64" json:"unchanged_cnt" form:"unchanged_cnt" query:"unchanged_cnt"`
+}
+
+func NewExptRegressionSummary() *ExptRegressionSummary {
+	return &ExptRegressionSummary{}
+}
+
+func (p *ExptRegressionSummary) InitDefault() {
+}
+
+var ExptRegressionSummary_CandidateExptID_DEFAULT int64
+
+func (p *ExptRegressionSummary) GetCandidateExptID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCandidateExptID() {
+		return ExptRegressionSummary_CandidateExptID_DEFAULT
+	}
+	return *p.CandidateExptID
+}
+
+var ExptRegressionSummary_PairedTurnCnt_DEFAULT int64
+
+func (p *ExptRegressionSummary) GetPairedTurnCnt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPairedTurnCnt() {
+		return ExptRegressionSummary_PairedTurnCnt_DEFAULT
+	}
+	return *p.PairedTurnCnt
+}
+
+var ExptRegressionSummary_ImprovedCnt_DEFAULT int64
+
+func (p *ExptRegressionSummary) GetImprovedCnt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetImprovedCnt() {
+		return ExptRegressionSummary_ImprovedCnt_DEFAULT
+	}
+	return *p.ImprovedCnt
+}
+
+var ExptRegressionSummary_RegressedCnt_DEFAULT int64
+
+func (p *ExptRegressionSummary) GetRegressedCnt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRegressedCnt() {
+		return ExptRegressionSummary_RegressedCnt_DEFAULT
+	}
+	return *p.RegressedCnt
+}
+
+var ExptRegressionSummary_MixedCnt_DEFAULT int64
+
+func (p *ExptRegressionSummary) GetMixedCnt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMixedCnt() {
+		return ExptRegressionSummary_MixedCnt_DEFAULT
+	}
+	return *p.MixedCnt
+}
+
+var ExptRegressionSummary_UnchangedCnt_DEFAULT int64
+
+func (p *ExptRegressionSummary) GetUnchangedCnt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUnchangedCnt() {
+		return ExptRegressionSummary_UnchangedCnt_DEFAULT
+	}
+	return *p.UnchangedCnt
+}
+func (p *ExptRegressionSummary) SetCandidateExptID(val *int64) {
+	p.CandidateExptID = val
+}
+func (p *ExptRegressionSummary) SetPairedTurnCnt(val *int64) {
+	p.PairedTurnCnt = val
+}
+func (p *ExptRegressionSummary) SetImprovedCnt(val *int64) {
+	p.ImprovedCnt = val
+}
+func (p *ExptRegressionSummary) SetRegressedCnt(val *int64) {
+	p.RegressedCnt = val
+}
+func (p *ExptRegressionSummary) SetMixedCnt(val *int64) {
+	p.MixedCnt = val
+}
+func (p *ExptRegressionSummary) SetUnchangedCnt(val *int64) {
+	p.UnchangedCnt = val
+}
+
+var fieldIDToName_ExptRegressionSummary = map[int16]string{
+	1: "candidate_expt_id",
+	2: "paired_turn_cnt",
+	3: "improved_cnt",
+	4: "regressed_cnt",
+	5: "mixed_cnt",
+	6: "unchanged_cnt",
+}
+
+func (p *ExptRegressionSummary) IsSetCandidateExptID() bool {
+	return p.CandidateExptID != nil
+}
+
+func (p *ExptRegressionSummary) IsSetPairedTurnCnt() bool {
+	return p.PairedTurnCnt != nil
+}
+
+func (p *ExptRegressionSummary) IsSetImprovedCnt() bool {
+	return p.ImprovedCnt != nil
+}
+
+func (p *ExptRegressionSummary) IsSetRegressedCnt() bool {
+	return p.RegressedCnt != nil
+}
+
+func (p *ExptRegressionSummary) IsSetMixedCnt() bool {
+	return p.MixedCnt != nil
+}
+
+func (p *ExptRegressionSummary) IsSetUnchangedCnt() bool {
+	return p.UnchangedCnt != nil
+}
+
+func (p *ExptRegressionSummary) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptRegressionSummary[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptRegressionSummary) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CandidateExptID = _field
+	return nil
+}
+func (p *ExptRegressionSummary) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PairedTurnCnt = _field
+	return nil
+}
+func (p *ExptRegressionSummary) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ImprovedCnt = _field
+	return nil
+}
+func (p *ExptRegressionSummary) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RegressedCnt = _field
+	return nil
+}
+func (p *ExptRegressionSummary) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MixedCnt = _field
+	return nil
+}
+func (p *ExptRegressionSummary) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UnchangedCnt = _field
+	return nil
+}
+
+func (p *ExptRegressionSummary) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptRegressionSummary"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptRegressionSummary) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCandidateExptID() {
+		if err = oprot.WriteFieldBegin("candidate_expt_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.CandidateExptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptRegressionSummary) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPairedTurnCnt() {
+		if err = oprot.WriteFieldBegin("paired_turn_cnt", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.PairedTurnCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptRegressionSummary) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetImprovedCnt() {
+		if err = oprot.WriteFieldBegin("improved_cnt", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ImprovedCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptRegressionSummary) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRegressedCnt() {
+		if err = oprot.WriteFieldBegin("regressed_cnt", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.RegressedCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptRegressionSummary) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMixedCnt() {
+		if err = oprot.WriteFieldBegin("mixed_cnt", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.MixedCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptRegressionSummary) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUnchangedCnt() {
+		if err = oprot.WriteFieldBegin("unchanged_cnt", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UnchangedCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *ExptRegressionSummary) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptRegressionSummary(%+v)", *p)
+
+}
+
+func (p *ExptRegressionSummary) DeepEqual(ano *ExptRegressionSummary) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.CandidateExptID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.PairedTurnCnt) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ImprovedCnt) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.RegressedCnt) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.MixedCnt) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.UnchangedCnt) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptRegressionSummary) Field1DeepEqual(src *int64) bool {
+
+	if p.CandidateExptID == src {
+		return true
+	} else if p.CandidateExptID == nil || src == nil {
+		return false
+	}
+	if *p.CandidateExptID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionSummary) Field2DeepEqual(src *int64) bool {
+
+	if p.PairedTurnCnt == src {
+		return true
+	} else if p.PairedTurnCnt == nil || src == nil {
+		return false
+	}
+	if *p.PairedTurnCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionSummary) Field3DeepEqual(src *int64) bool {
+
+	if p.ImprovedCnt == src {
+		return true
+	} else if p.ImprovedCnt == nil || src == nil {
+		return false
+	}
+	if *p.ImprovedCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionSummary) Field4DeepEqual(src *int64) bool {
+
+	if p.RegressedCnt == src {
+		return true
+	} else if p.RegressedCnt == nil || src == nil {
+		return false
+	}
+	if *p.RegressedCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionSummary) Field5DeepEqual(src *int64) bool {
+
+	if p.MixedCnt == src {
+		return true
+	} else if p.MixedCnt == nil || src == nil {
+		return false
+	}
+	if *p.MixedCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionSummary) Field6DeepEqual(src *int64) bool {
+
+	if p.UnchangedCnt == src {
+		return true
+	} else if p.UnchangedCnt == nil || src == nil {
+		return false
+	}
+	if *p.UnchangedCnt != *src {
+		return false
+	}
+	return true
+}
+
+// 某实验在该 turn 上的结果
+type ExptRegressionTurnResult_ struct {
+	ExptID        *int64                     `thrift:"expt_id,1,optional" frugal:"1,optional,i64" json:"expt_id" form:"expt_id" query:"expt_id"`
+	TargetOutputs map[string]*common.Content `thrift:"target_outputs,2,optional" frugal:"2,optional,map<string:common.Content>" form:"target_outputs" json:"target_outputs,omitempty" query:"target_outputs"`
+	WeightedScore *float64                   `thrift:"weighted_score,3,optional" frugal:"3,optional,double" form:"weighted_score" json:"weighted_score,omitempty" query:"weighted_score"`
+	// key 为评估器实例 key
+	EvaluatorScores map[string]float64 `thrift:"evaluator_scores,4,optional" frugal:"4,optional,map<string:double>" form:"evaluator_scores" json:"evaluator_scores,omitempty" query:"evaluator_scores"`
+}
+
+func NewExptRegressionTurnResult_() *ExptRegressionTurnResult_ {
+	return &ExptRegressionTurnResult_{}
+}
+
+func (p *ExptRegressionTurnResult_) InitDefault() {
+}
+
+var ExptRegressionTurnResult__ExptID_DEFAULT int64
+
+func (p *ExptRegressionTurnResult_) GetExptID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExptID() {
+		return ExptRegressionTurnResult__ExptID_DEFAULT
+	}
+	return *p.ExptID
+}
+
+var ExptRegressionTurnResult__TargetOutputs_DEFAULT map[string]*common.Content
+
+func (p *ExptRegressionTurnResult_) GetTargetOutputs() (v map[string]*common.Content) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetOutputs() {
+		return ExptRegressionTurnResult__TargetOutputs_DEFAULT
+	}
+	return p.TargetOutputs
+}
+
+var ExptRegressionTurnResult__WeightedScore_DEFAULT float64
+
+func (p *ExptRegressionTurnResult_) GetWeightedScore() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWeightedScore() {
+		return ExptRegressionTurnResult__WeightedScore_DEFAULT
+	}
+	return *p.WeightedScore
+}
+
+var ExptRegressionTurnResult__EvaluatorScores_DEFAULT map[string]float64
+
+func (p *ExptRegressionTurnResult_) GetEvaluatorScores() (v map[string]float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorScores() {
+		return ExptRegressionTurnResult__EvaluatorScores_DEFAULT
+	}
+	return p.EvaluatorScores
+}
+func (p *ExptRegressionTurnResult_) SetExptID(val *int64) {
+	p.ExptID = val
+}
+func (p *ExptRegressionTurnResult_) SetTargetOutputs(val map[string]*common.Content) {
+	p.TargetOutputs = val
+}
+func (p *ExptRegressionTurnResult_) SetWeightedScore(val *float64) {
+	p.WeightedScore = val
+}
+func (p *ExptRegressionTurnResult_) SetEvaluatorScores(val map[string]float64) {
+	p.EvaluatorScores = val
+}
+
+var fieldIDToName_ExptRegressionTurnResult_ = map[int16]string{
+	1: "expt_id",
+	2: "target_outputs",
+	3: "weighted_score",
+	4: "evaluator_scores",
+}
+
+func (p *ExptRegressionTurnResult_) IsSetExptID() bool {
+	return p.ExptID != nil
+}
+
+func (p *ExptRegressionTurnResult_) IsSetTargetOutputs() bool {
+	return p.TargetOutputs != nil
+}
+
+func (p *ExptRegressionTurnResult_) IsSetWeightedScore() bool {
+	return p.WeightedScore != nil
+}
+
+func (p *ExptRegressionTurnResult_) IsSetEvaluatorScores() bool {
+	return p.EvaluatorScores != nil
+}
+
+func (p *ExptRegressionTurnResult_) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptRegressionTurnResult_[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptRegressionTurnResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ExptID = _field
+	return nil
+}
+func (p *ExptRegressionTurnResult_) ReadField2(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]*common.Content, size)
+	values := make([]common.Content, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
+			return err
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.TargetOutputs = _field
+	return nil
+}
+func (p *ExptRegressionTurnResult_) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WeightedScore = _field
+	return nil
+}
+func (p *ExptRegressionTurnResult_) ReadField4(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]float64, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val float64
+		if v, err := iprot.ReadDouble(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.EvaluatorScores = _field
+	return nil
+}
+
+func (p *ExptRegressionTurnResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptRegressionTurnResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptRegressionTurnResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExptID() {
+		if err = oprot.WriteFieldBegin("expt_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ExptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptRegressionTurnResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetOutputs() {
+		if err = oprot.WriteFieldBegin("target_outputs", thrift.MAP, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.TargetOutputs)); err != nil {
+			return err
+		}
+		for k, v := range p.TargetOutputs {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptRegressionTurnResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWeightedScore() {
+		if err = oprot.WriteFieldBegin("weighted_score", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.WeightedScore); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptRegressionTurnResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorScores() {
+		if err = oprot.WriteFieldBegin("evaluator_scores", thrift.MAP, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.DOUBLE, len(p.EvaluatorScores)); err != nil {
+			return err
+		}
+		for k, v := range p.EvaluatorScores {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteDouble(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ExptRegressionTurnResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptRegressionTurnResult_(%+v)", *p)
+
+}
+
+func (p *ExptRegressionTurnResult_) DeepEqual(ano *ExptRegressionTurnResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ExptID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TargetOutputs) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.WeightedScore) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.EvaluatorScores) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptRegressionTurnResult_) Field1DeepEqual(src *int64) bool {
+
+	if p.ExptID == src {
+		return true
+	} else if p.ExptID == nil || src == nil {
+		return false
+	}
+	if *p.ExptID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionTurnResult_) Field2DeepEqual(src map[string]*common.Content) bool {
+
+	if len(p.TargetOutputs) != len(src) {
+		return false
+	}
+	for k, v := range p.TargetOutputs {
+		_src := src[k]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ExptRegressionTurnResult_) Field3DeepEqual(src *float64) bool {
+
+	if p.WeightedScore == src {
+		return true
+	} else if p.WeightedScore == nil || src == nil {
+		return false
+	}
+	if *p.WeightedScore != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionTurnResult_) Field4DeepEqual(src map[string]float64) bool {
+
+	if len(p.EvaluatorScores) != len(src) {
+		return false
+	}
+	for k, v := range p.EvaluatorScores {
+		_src := src[k]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+
+type ExptRegressionEvaluatorDelta struct {
+	EvaluatorVersionID *int64   `thrift:"evaluator_version_id,1,optional" frugal:"1,optional,i64" json:"evaluator_version_id" form:"evaluator_version_id" query:"evaluator_version_id"`
+	Alias              *string  `thrift:"alias,2,optional" frugal:"2,optional,string" form:"alias" json:"alias,omitempty" query:"alias"`
+	BaseScore          *float64 `thrift:"base_score,3,optional" frugal:"3,optional,double" form:"base_score" json:"base_score,omitempty" query:"base_score"`
+	CandidateScore     *float64 `thrift:"candidate_score,4,optional" frugal:"4,optional,double" form:"candidate_score" json:"candidate_score,omitempty" query:"candidate_score"`
+	// candidate_score - base_score
+	Delta *float64 `thrift:"delta,5,optional" frugal:"5,optional,double" form:"delta" json:"delta,omitempty" query:"delta"`
+	// |delta| 超过阈值
+	Changed *bool `thrift:"changed,6,optional" frugal:"6,optional,bool" form:"changed" json:"changed,omitempty" query:"changed"`
+}
+
+func NewExptRegressionEvaluatorDelta() *ExptRegressionEvaluatorDelta {
+	return &ExptRegressionEvaluatorDelta{}
+}
+
+func (p *ExptRegressionEvaluatorDelta) InitDefault() {
+}
+
+var ExptRegressionEvaluatorDelta_EvaluatorVersionID_DEFAULT int64
+
+func (p *ExptRegressionEvaluatorDelta) GetEvaluatorVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorVersionID() {
+		return ExptRegressionEvaluatorDelta_EvaluatorVersionID_DEFAULT
+	}
+	return *p.EvaluatorVersionID
+}
+
+var ExptRegressionEvaluatorDelta_Alias_DEFAULT string
+
+func (p *ExptRegressionEvaluatorDelta) GetAlias() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAlias() {
+		return ExptRegressionEvaluatorDelta_Alias_DEFAULT
+	}
+	return *p.Alias
+}
+
+var ExptRegressionEvaluatorDelta_BaseScore_DEFAULT float64
+
+func (p *ExptRegressionEvaluatorDelta) GetBaseScore() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseScore() {
+		return ExptRegressionEvaluatorDelta_BaseScore_DEFAULT
+	}
+	return *p.BaseScore
+}
+
+var ExptRegressionEvaluatorDelta_CandidateScore_DEFAULT float64
+
+func (p *ExptRegressionEvaluatorDelta) GetCandidateScore() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCandidateScore() {
+		return ExptRegressionEvaluatorDelta_CandidateScore_DEFAULT
+	}
+	return *p.CandidateScore
+}
+
+var ExptRegressionEvaluatorDelta_Delta_DEFAULT float64
+
+func (p *ExptRegressionEvaluatorDelta) GetDelta() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDelta() {
+		return ExptRegressionEvaluatorDelta_Delta_DEFAULT
+	}
+	return *p.Delta
+}
+
+var ExptRegressionEvaluatorDelta_Changed_DEFAULT bool
+
+func (p *ExptRegressionEvaluatorDelta) GetChanged() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetChanged() {
+		return ExptRegressionEvaluatorDelta_Changed_DEFAULT
+	}
+	return *p.Changed
+}
+func (p *ExptRegressionEvaluatorDelta) SetEvaluatorVersionID(val *int64) {
+	p.EvaluatorVersionID = val
+}
+func (p *ExptRegressionEvaluatorDelta) SetAlias(val *string) {
+	p.Alias = val
+}
+func (p *ExptRegressionEvaluatorDelta) SetBaseScore(val *float64) {
+	p.BaseScore = val
+}
+func (p *ExptRegressionEvaluatorDelta) SetCandidateScore(val *float64) {
+	p.CandidateScore = val
+}
+func (p *ExptRegressionEvaluatorDelta) SetDelta(val *float64) {
+	p.Delta = val
+}
+func (p *ExptRegressionEvaluatorDelta) SetChanged(val *bool) {
+	p.Changed = val
+}
+
+var fieldIDToName_ExptRegressionEvaluatorDelta = map[int16]string{
+	1: "evaluator_version_id",
+	2: "alias",
+	3: "base_score",
+	4: "candidate_score",
+	5: "delta",
+	6: "changed",
+}
+
+func (p *ExptRegressionEvaluatorDelta) IsSetEvaluatorVersionID() bool {
+	return p.EvaluatorVersionID != nil
+}
+
+func (p *ExptRegressionEvaluatorDelta) IsSetAlias() bool {
+	return p.Alias != nil
+}
+
+func (p *ExptRegressionEvaluatorDelta) IsSetBaseScore() bool {
+	return p.BaseScore != nil
+}
+
+func (p *ExptRegressionEvaluatorDelta) IsSetCandidateScore() bool {
+	return p.CandidateScore != nil
+}
+
+func (p *ExptRegressionEvaluatorDelta) IsSetDelta() bool {
+	return p.Delta != nil
+}
+
+func (p *ExptRegressionEvaluatorDelta) IsSetChanged() bool {
+	return p.Changed != nil
+}
+
+func (p *ExptRegressionEvaluatorDelta) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptRegressionEvaluatorDelta[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptRegressionEvaluatorDelta) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatorVersionID = _field
+	return nil
+}
+func (p *ExptRegressionEvaluatorDelta) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Alias = _field
+	return nil
+}
+func (p *ExptRegressionEvaluatorDelta) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaseScore = _field
+	return nil
+}
+func (p *ExptRegressionEvaluatorDelta) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CandidateScore = _field
+	return nil
+}
+func (p *ExptRegressionEvaluatorDelta) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Delta = _field
+	return nil
+}
+func (p *ExptRegressionEvaluatorDelta) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Changed = _field
+	return nil
+}
+
+func (p *ExptRegressionEvaluatorDelta) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptRegressionEvaluatorDelta"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptRegressionEvaluatorDelta) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersionID() {
+		if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluatorVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptRegressionEvaluatorDelta) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAlias() {
+		if err = oprot.WriteFieldBegin("alias", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Alias); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptRegressionEvaluatorDelta) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseScore() {
+		if err = oprot.WriteFieldBegin("base_score", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.BaseScore); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptRegressionEvaluatorDelta) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCandidateScore() {
+		if err = oprot.WriteFieldBegin("candidate_score", thrift.DOUBLE, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.CandidateScore); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptRegressionEvaluatorDelta) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDelta() {
+		if err = oprot.WriteFieldBegin("delta", thrift.DOUBLE, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Delta); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptRegressionEvaluatorDelta) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChanged() {
+		if err = oprot.WriteFieldBegin("changed", thrift.BOOL, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Changed); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *ExptRegressionEvaluatorDelta) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptRegressionEvaluatorDelta(%+v)", *p)
+
+}
+
+func (p *ExptRegressionEvaluatorDelta) DeepEqual(ano *ExptRegressionEvaluatorDelta) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Alias) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.BaseScore) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.CandidateScore) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Delta) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Changed) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptRegressionEvaluatorDelta) Field1DeepEqual(src *int64) bool {
+
+	if p.EvaluatorVersionID == src {
+		return true
+	} else if p.EvaluatorVersionID == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatorVersionID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionEvaluatorDelta) Field2DeepEqual(src *string) bool {
+
+	if p.Alias == src {
+		return true
+	} else if p.Alias == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Alias, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionEvaluatorDelta) Field3DeepEqual(src *float64) bool {
+
+	if p.BaseScore == src {
+		return true
+	} else if p.BaseScore == nil || src == nil {
+		return false
+	}
+	if *p.BaseScore != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionEvaluatorDelta) Field4DeepEqual(src *float64) bool {
+
+	if p.CandidateScore == src {
+		return true
+	} else if p.CandidateScore == nil || src == nil {
+		return false
+	}
+	if *p.CandidateScore != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionEvaluatorDelta) Field5DeepEqual(src *float64) bool {
+
+	if p.Delta == src {
+		return true
+	} else if p.Delta == nil || src == nil {
+		return false
+	}
+	if *p.Delta != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionEvaluatorDelta) Field6DeepEqual(src *bool) bool {
+
+	if p.Changed == src {
+		return true
+	} else if p.Changed == nil || src == nil {
+		return false
+	}
+	if *p.Changed != *src {
+		return false
+	}
+	return true
+}
+
+type ExptRegressionCandidateResult_ struct {
+	Result_ *ExptRegressionTurnResult_ `thrift:"result,1,optional" frugal:"1,optional,ExptRegressionTurnResult_" form:"result" json:"result,omitempty" query:"result"`
+	// 为空表示该候选实验在此 turn 上无超过阈值的变化
+	Change             *ExptRegressionChangeType `thrift:"change,2,optional" frugal:"2,optional,string" form:"change" json:"change,omitempty" query:"change"`
+	WeightedScoreDelta *float64                  `thrift:"weighted_score_delta,3,optional" frugal:"3,optional,double" form:"weighted_score_delta" json:"weighted_score_delta,omitempty" query:"weighted_score_delta"`
+	// 仅包含两侧都有得分的评估器实例
+	EvaluatorDeltas []*ExptRegressionEvaluatorDelta `thrift:"evaluator_deltas,4,optional" frugal:"4,optional,list<ExptRegressionEvaluatorDelta>" form:"evaluator_deltas" json:"evaluator_deltas,omitempty" query:"evaluator_deltas"`
+}
+
+func NewExptRegressionCandidateResult_() *ExptRegressionCandidateResult_ {
+	return &ExptRegressionCandidateResult_{}
+}
+
+func (p *ExptRegressionCandidateResult_) InitDefault() {
+}
+
+var ExptRegressionCandidateResult__Result__DEFAULT *ExptRegressionTurnResult_
+
+func (p *ExptRegressionCandidateResult_) GetResult_() (v *ExptRegressionTurnResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetResult_() {
+		return ExptRegressionCandidateResult__Result__DEFAULT
+	}
+	return p.Result_
+}
+
+var ExptRegressionCandidateResult__Change_DEFAULT ExptRegressionChangeType
+
+func (p *ExptRegressionCandidateResult_) GetChange() (v ExptRegressionChangeType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetChange() {
+		return ExptRegressionCandidateResult__Change_DEFAULT
+	}
+	return *p.Change
+}
+
+var ExptRegressionCandidateResult__WeightedScoreDelta_DEFAULT float64
+
+func (p *ExptRegressionCandidateResult_) GetWeightedScoreDelta() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWeightedScoreDelta() {
+		return ExptRegressionCandidateResult__WeightedScoreDelta_DEFAULT
+	}
+	return *p.WeightedScoreDelta
+}
+
+var ExptRegressionCandidateResult__EvaluatorDeltas_DEFAULT []*ExptRegressionEvaluatorDelta
+
+func (p *ExptRegressionCandidateResult_) GetEvaluatorDeltas() (v []*ExptRegressionEvaluatorDelta) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorDeltas() {
+		return ExptRegressionCandidateResult__EvaluatorDeltas_DEFAULT
+	}
+	return p.EvaluatorDeltas
+}
+func (p *ExptRegressionCandidateResult_) SetResult_(val *ExptRegressionTurnResult_) {
+	p.Result_ = val
+}
+func (p *ExptRegressionCandidateResult_) SetChange(val *ExptRegressionChangeType) {
+	p.Change = val
+}
+func (p *ExptRegressionCandidateResult_) SetWeightedScoreDelta(val *float64) {
+	p.WeightedScoreDelta = val
+}
+func (p *ExptRegressionCandidateResult_) SetEvaluatorDeltas(val []*ExptRegressionEvaluatorDelta) {
+	p.EvaluatorDeltas = val
+}
+
+var fieldIDToName_ExptRegressionCandidateResult_ = map[int16]string{
+	1: "result",
+	2: "change",
+	3: "weighted_score_delta",
+	4: "evaluator_deltas",
+}
+
+func (p *ExptRegressionCandidateResult_) IsSetResult_() bool {
+	return p.Result_ != nil
+}
+
+func (p *ExptRegressionCandidateResult_) IsSetChange() bool {
+	return p.Change != nil
+}
+
+func (p *ExptRegressionCandidateResult_) IsSetWeightedScoreDelta() bool {
+	return p.WeightedScoreDelta != nil
+}
+
+func (p *ExptRegressionCandidateResult_) IsSetEvaluatorDeltas() bool {
+	return p.EvaluatorDeltas != nil
+}
+
+func (p *ExptRegressionCandidateResult_) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptRegressionCandidateResult_[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptRegressionCandidateResult_) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewExptRegressionTurnResult_()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Result_ = _field
+	return nil
+}
+func (p *ExptRegressionCandidateResult_) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *ExptRegressionChangeType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Change = _field
+	return nil
+}
+func (p *ExptRegressionCandidateResult_) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WeightedScoreDelta = _field
+	return nil
+}
+func (p *ExptRegressionCandidateResult_) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExptRegressionEvaluatorDelta, 0, size)
+	values := make([]ExptRegressionEvaluatorDelta, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.EvaluatorDeltas = _field
+	return nil
+}
+
+func (p *ExptRegressionCandidateResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptRegressionCandidateResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptRegressionCandidateResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResult_() {
+		if err = oprot.WriteFieldBegin("result", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Result_.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptRegressionCandidateResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChange() {
+		if err = oprot.WriteFieldBegin("change", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Change); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptRegressionCandidateResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWeightedScoreDelta() {
+		if err = oprot.WriteFieldBegin("weighted_score_delta", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.WeightedScoreDelta); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptRegressionCandidateResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorDeltas() {
+		if err = oprot.WriteFieldBegin("evaluator_deltas", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.EvaluatorDeltas)); err != nil {
+			return err
+		}
+		for _, v := range p.EvaluatorDeltas {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ExptRegressionCandidateResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptRegressionCandidateResult_(%+v)", *p)
+
+}
+
+func (p *ExptRegressionCandidateResult_) DeepEqual(ano *ExptRegressionCandidateResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Result_) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Change) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.WeightedScoreDelta) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.EvaluatorDeltas) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptRegressionCandidateResult_) Field1DeepEqual(src *ExptRegressionTurnResult_) bool {
+
+	if !p.Result_.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionCandidateResult_) Field2DeepEqual(src *ExptRegressionChangeType) bool {
+
+	if p.Change == src {
+		return true
+	} else if p.Change == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Change, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionCandidateResult_) Field3DeepEqual(src *float64) bool {
+
+	if p.WeightedScoreDelta == src {
+		return true
+	} else if p.WeightedScoreDelta == nil || src == nil {
+		return false
+	}
+	if *p.WeightedScoreDelta != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionCandidateResult_) Field4DeepEqual(src []*ExptRegressionEvaluatorDelta) bool {
+
+	if len(p.EvaluatorDeltas) != len(src) {
+		return false
+	}
+	for i, v := range p.EvaluatorDeltas {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+// 发生变化的 turn, 基线与各候选实验的结果并排展示
+type ExptRegressionItem struct {
+	ItemID     *int64                            `thrift:"item_id,1,optional" frugal:"1,optional,i64" json:"item_id" form:"item_id" query:"item_id"`
+	TurnID     *int64                            `thrift:"turn_id,2,optional" frugal:"2,optional,i64" json:"turn_id" form:"turn_id" query:"turn_id"`
+	Base       *ExptRegressionTurnResult_        `thrift:"base,3,optional" frugal:"3,optional,ExptRegressionTurnResult_" form:"base" json:"base,omitempty" query:"base"`
+	Candidates []*ExptRegressionCandidateResult_ `thrift:"candidates,4,optional" frugal:"4,optional,list<ExptRegressionCandidateResult_>" form:"candidates" json:"candidates,omitempty" query:"candidates"`
+}
+
+func NewExptRegressionItem() *ExptRegressionItem {
+	return &ExptRegressionItem{}
+}
+
+func (p *ExptRegressionItem) InitDefault() {
+}
+
+var ExptRegressionItem_ItemID_DEFAULT int64
+
+func (p *ExptRegressionItem) GetItemID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetItemID() {
+		return ExptRegressionItem_ItemID_DEFAULT
+	}
+	return *p.ItemID
+}
+
+var ExptRegressionItem_TurnID_DEFAULT int64
+
+func (p *ExptRegressionItem) GetTurnID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTurnID() {
+		return ExptRegressionItem_TurnID_DEFAULT
+	}
+	return *p.TurnID
+}
+
+var ExptRegressionItem_Base_DEFAULT *ExptRegressionTurnResult_
+
+func (p *ExptRegressionItem) GetBase() (v *ExptRegressionTurnResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return ExptRegressionItem_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var ExptRegressionItem_Candidates_DEFAULT []*ExptRegressionCandidateResult_
+
+func (p *ExptRegressionItem) GetCandidates() (v []*ExptRegressionCandidateResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCandidates() {
+		return ExptRegressionItem_Candidates_DEFAULT
+	}
+	return p.Candidates
+}
+func (p *ExptRegressionItem) SetItemID(val *int64) {
+	p.ItemID = val
+}
+func (p *ExptRegressionItem) SetTurnID(val *int64) {
+	p.TurnID = val
+}
+func (p *ExptRegressionItem) SetBase(val *ExptRegressionTurnResult_) {
+	p.Base = val
+}
+func (p *ExptRegressionItem) SetCandidates(val []*ExptRegressionCandidateResult_) {
+	p.Candidates = val
+}
+
+var fieldIDToName_ExptRegressionItem = map[int16]string{
+	1: "item_id",
+	2: "turn_id",
+	3: "base",
+	4: "candidates",
+}
+
+func (p *ExptRegressionItem) IsSetItemID() bool {
+	return p.ItemID != nil
+}
+
+func (p *ExptRegressionItem) IsSetTurnID() bool {
+	return p.TurnID != nil
+}
+
+func (p *ExptRegressionItem) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ExptRegressionItem) IsSetCandidates() bool {
+	return p.Candidates != nil
+}
+
+func (p *ExptRegressionItem) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptRegressionItem[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptRegressionItem) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ItemID = _field
+	return nil
+}
+func (p *ExptRegressionItem) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TurnID = _field
+	return nil
+}
+func (p *ExptRegressionItem) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewExptRegressionTurnResult_()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+func (p *ExptRegressionItem) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExptRegressionCandidateResult_, 0, size)
+	values := make([]ExptRegressionCandidateResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Candidates = _field
+	return nil
+}
+
+func (p *ExptRegressionItem) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptRegressionItem"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptRegressionItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItemID() {
+		if err = oprot.WriteFieldBegin("item_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ItemID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptRegressionItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTurnID() {
+		if err = oprot.WriteFieldBegin("turn_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TurnID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptRegressionItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptRegressionItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCandidates() {
+		if err = oprot.WriteFieldBegin("candidates", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Candidates)); err != nil {
+			return err
+		}
+		for _, v := range p.Candidates {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ExptRegressionItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptRegressionItem(%+v)", *p)
+
+}
+
+func (p *ExptRegressionItem) DeepEqual(ano *ExptRegressionItem) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ItemID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TurnID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Candidates) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptRegressionItem) Field1DeepEqual(src *int64) bool {
+
+	if p.ItemID == src {
+		return true
+	} else if p.ItemID == nil || src == nil {
+		return false
+	}
+	if *p.ItemID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionItem) Field2DeepEqual(src *int64) bool {
+
+	if p.TurnID == src {
+		return true
+	} else if p.TurnID == nil || src == nil {
+		return false
+	}
+	if *p.TurnID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionItem) Field3DeepEqual(src *ExptRegressionTurnResult_) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionItem) Field4DeepEqual(src []*ExptRegressionCandidateResult_) bool {
+
+	if len(p.Candidates) != len(src) {
+		return false
+	}
+	for i, v := range p.Candidates {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+// 跨实验回归报告
+type ExptRegressionReport struct {
+	BaseExptID *int64                   `thrift:"base_expt_id,1,optional" frugal:"1,optional,i64" json:"base_expt_id" form:"base_expt_id" query:"base_expt_id"`
+	Summaries  []*ExptRegressionSummary `thrift:"summaries,2,optional" frugal:"2,optional,list<ExptRegressionSummary>" form:"summaries" json:"summaries,omitempty" query:"summaries"`
+	// 按 (item_id, turn_id) 升序
+	Items []*ExptRegressionItem `thrift:"items,3,optional" frugal:"3,optional,list<ExptRegressionItem>" form:"items" json:"items,omitempty" query:"items"`
+	// 发生变化的 turn 数超过 max_item_cnt, items 已被截断 (summaries 仍为全量统计)
+	Truncated *bool `thrift:"truncated,4,optional" frugal:"4,optional,bool" form:"truncated" json:"truncated,omitempty" query:"truncated"`
+}
+
+func NewExptRegressionReport() *ExptRegressionReport {
+	return &ExptRegressionReport{}
+}
+
+func (p *ExptRegressionReport) InitDefault() {
+}
+
+var ExptRegressionReport_BaseExptID_DEFAULT int64
+
+func (p *ExptRegressionReport) GetBaseExptID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseExptID() {
+		return ExptRegressionReport_BaseExptID_DEFAULT
+	}
+	return *p.BaseExptID
+}
+
+var ExptRegressionReport_Summaries_DEFAULT []*ExptRegressionSummary
+
+func (p *ExptRegressionReport) GetSummaries() (v []*ExptRegressionSummary) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSummaries() {
+		return ExptRegressionReport_Summaries_DEFAULT
+	}
+	return p.Summaries
+}
+
+var ExptRegressionReport_Items_DEFAULT []*ExptRegressionItem
+
+func (p *ExptRegressionReport) GetItems() (v []*ExptRegressionItem) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetItems() {
+		return ExptRegressionReport_Items_DEFAULT
+	}
+	return p.Items
+}
+
+var ExptRegressionReport_Truncated_DEFAULT bool
+
+func (p *ExptRegressionReport) GetTruncated() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTruncated() {
+		return ExptRegressionReport_Truncated_DEFAULT
+	}
+	return *p.Truncated
+}
+func (p *ExptRegressionReport) SetBaseExptID(val *int64) {
+	p.BaseExptID = val
+}
+func (p *ExptRegressionReport) SetSummaries(val []*ExptRegressionSummary) {
+	p.Summaries = val
+}
+func (p *ExptRegressionReport) SetItems(val []*ExptRegressionItem) {
+	p.Items = val
+}
+func (p *ExptRegressionReport) SetTruncated(val *bool) {
+	p.Truncated = val
+}
+
+var fieldIDToName_ExptRegressionReport = map[int16]string{
+	1: "base_expt_id",
+	2: "summaries",
+	3: "items",
+	4: "truncated",
+}
+
+func (p *ExptRegressionReport) IsSetBaseExptID() bool {
+	return p.BaseExptID != nil
+}
+
+func (p *ExptRegressionReport) IsSetSummaries() bool {
+	return p.Summaries != nil
+}
+
+func (p *ExptRegressionReport) IsSetItems() bool {
+	return p.Items != nil
+}
+
+func (p *ExptRegressionReport) IsSetTruncated() bool {
+	return p.Truncated != nil
+}
+
+func (p *ExptRegressionReport) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptRegressionReport[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptRegressionReport) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaseExptID = _field
+	return nil
+}
+func (p *ExptRegressionReport) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExptRegressionSummary, 0, size)
+	values := make([]ExptRegressionSummary, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Summaries = _field
+	return nil
+}
+func (p *ExptRegressionReport) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExptRegressionItem, 0, size)
+	values := make([]ExptRegressionItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Items = _field
+	return nil
+}
+func (p *ExptRegressionReport) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Truncated = _field
+	return nil
+}
+
+func (p *ExptRegressionReport) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptRegressionReport"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptRegressionReport) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseExptID() {
+		if err = oprot.WriteFieldBegin("base_expt_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.BaseExptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptRegressionReport) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSummaries() {
+		if err = oprot.WriteFieldBegin("summaries", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Summaries)); err != nil {
+			return err
+		}
+		for _, v := range p.Summaries {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptRegressionReport) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItems() {
+		if err = oprot.WriteFieldBegin("items", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Items)); err != nil {
+			return err
+		}
+		for _, v := range p.Items {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptRegressionReport) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTruncated() {
+		if err = oprot.WriteFieldBegin("truncated", thrift.BOOL, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Truncated); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ExptRegressionReport) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptRegressionReport(%+v)", *p)
+
+}
+
+func (p *ExptRegressionReport) DeepEqual(ano *ExptRegressionReport) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseExptID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Summaries) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Items) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Truncated) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptRegressionReport) Field1DeepEqual(src *int64) bool {
+
+	if p.BaseExptID == src {
+		return true
+	} else if p.BaseExptID == nil || src == nil {
+		return false
+	}
+	if *p.BaseExptID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptRegressionReport) Field2DeepEqual(src []*ExptRegressionSummary) bool {
+
+	if len(p.Summaries) != len(src) {
+		return false
+	}
+	for i, v := range p.Summaries {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ExptRegressionReport) Field3DeepEqual(src []*ExptRegressionItem) bool {
+
+	if len(p.Items) != len(src) {
+		return false
+	}
+	for i, v := range p.Items {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ExptRegressionReport) Field4DeepEqual(src *bool) bool {
+
+	if p.Truncated == src {
+		return true
+	} else if p.Truncated == nil || src == nil {
+		return false
+	}
+	if *p.Truncated != *src {
+		return false
+	}
+	return true
+}
+
+// ===============================
+// 成本核算
+// ===============================
+// 单个模型的单价, 价格单位为每百万 token
+type ModelPrice struct {
+	ModelID                     *int64   `thrift:"model_id,1,optional" frugal:"1,optional,i64" json:"model_id" form:"model_id" query:"model_id"`
+	InputPricePerMillionTokens  *float64 `thrift:"input_price_per_million_tokens,2,optional" frugal:"2,optional,double" form:"input_price_per_million_tokens" json:"input_price_per_million_tokens,omitempty" query:"input_price_per_million_tokens"`
+	OutputPricePerMillionTokens *float64 `thrift:"output_price_per_million_tokens,3,optional" frugal:"3,optional,double" form:"output_price_per_million_tokens" json:"output_price_per_million_tokens,omitempty" query:"output_price_per_million_tokens"`
+}
+
+func NewModelPrice() *ModelPrice {
+	return &ModelPrice{}
+}
+
+func (p *ModelPrice) InitDefault() {
+}
+
+var ModelPrice_ModelID_DEFAULT int64
+
+func (p *ModelPrice) GetModelID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModelID() {
+		return ModelPrice_ModelID_DEFAULT
+	}
+	return *p.ModelID
+}
+
+var ModelPrice_InputPricePerMillionTokens_DEFAULT float64
+
+func (p *ModelPrice) GetInputPricePerMillionTokens() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetInputPricePerMillionTokens() {
+		return ModelPrice_InputPricePerMillionTokens_DEFAULT
+	}
+	return *p.InputPricePerMillionTokens
+}
+
+var ModelPrice_OutputPricePerMillionTokens_DEFAULT float64
+
+func (p *ModelPrice) GetOutputPricePerMillionTokens() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOutputPricePerMillionTokens() {
+		return ModelPrice_OutputPricePerMillionTokens_DEFAULT
+	}
+	return *p.OutputPricePerMillionTokens
+}
+func (p *ModelPrice) SetModelID(val *int64) {
+	p.ModelID = val
+}
+func (p *ModelPrice) SetInputPricePerMillionTokens(val *float64) {
+	p.InputPricePerMillionTokens = val
+}
+func (p *ModelPrice) SetOutputPricePerMillionTokens(val *float64) {
+	p.OutputPricePerMillionTokens = val
+}
+
+var fieldIDToName_ModelPrice = map[int16]string{
+	1: "model_id",
+	2: "input_price_per_million_tokens",
+	3: "output_price_per_million_tokens",
+}
+
+func (p *ModelPrice) IsSetModelID() bool {
+	return p.ModelID != nil
+}
+
+func (p *ModelPrice) IsSetInputPricePerMillionTokens() bool {
+	return p.InputPricePerMillionTokens != nil
+}
+
+func (p *ModelPrice) IsSetOutputPricePerMillionTokens() bool {
+	return p.OutputPricePerMillionTokens != nil
+}
+
+func (p *ModelPrice) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModelPrice[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ModelPrice) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ModelID = _field
+	return nil
+}
+func (p *ModelPrice) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.InputPricePerMillionTokens = _field
+	return nil
+}
+func (p *ModelPrice) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.OutputPricePerMillionTokens = _field
+	return nil
+}
+
+func (p *ModelPrice) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ModelPrice"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ModelPrice) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelID() {
+		if err = oprot.WriteFieldBegin("model_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ModelID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ModelPrice) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetInputPricePerMillionTokens() {
+		if err = oprot.WriteFieldBegin("input_price_per_million_tokens", thrift.DOUBLE, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.InputPricePerMillionTokens); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ModelPrice) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputPricePerMillionTokens() {
+		if err = oprot.WriteFieldBegin("output_price_per_million_tokens", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.OutputPricePerMillionTokens); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ModelPrice) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ModelPrice(%+v)", *p)
+
+}
+
+func (p *ModelPrice) DeepEqual(ano *ModelPrice) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ModelID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.InputPricePerMillionTokens) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.OutputPricePerMillionTokens) {
+		return false
+	}
+	return true
+}
+
+func (p *ModelPrice) Field1DeepEqual(src *int64) bool {
+
+	if p.ModelID == src {
+		return true
+	} else if p.ModelID == nil || src == nil {
+		return false
+	}
+	if *p.ModelID != *src {
+		return false
+	}
+	return true
+}
+func (p *ModelPrice) Field2DeepEqual(src *float64) bool {
+
+	if p.InputPricePerMillionTokens == src {
+		return true
+	} else if p.InputPricePerMillionTokens == nil || src == nil {
+		return false
+	}
+	if *p.InputPricePerMillionTokens != *src {
+		return false
+	}
+	return true
+}
+func (p *ModelPrice) Field3DeepEqual(src *float64) bool {
+
+	if p.OutputPricePerMillionTokens == src {
+		return true
+	} else if p.OutputPricePerMillionTokens == nil || src == nil {
+		return false
+	}
+	if *p.OutputPricePerMillionTokens != *src {
+		return false
+	}
+	return true
+}
+
+// 模型价目表: models 按 model_id 精确匹配, 未命中时回落 default, default 为空则不计费
+type ModelPriceConf struct {
+	// 价格币种, 仅用于展示
+	Currency     *string       `thrift:"currency,1,optional" frugal:"1,optional,string" form:"currency" json:"currency,omitempty" query:"currency"`
+	DefaultPrice *ModelPrice   `thrift:"default_price,2,optional" frugal:"2,optional,ModelPrice" form:"default_price" json:"default_price,omitempty" query:"default_price"`
+	Models       []*ModelPrice `thrift:"models,3,optional" frugal:"3,optional,list<ModelPrice>" form:"models" json:"models,omitempty" query:"models"`
+}
+
+func NewModelPriceConf() *ModelPriceConf {
+	return &ModelPriceConf{}
+}
+
+func (p *ModelPriceConf) InitDefault() {
+}
+
+var ModelPriceConf_Currency_DEFAULT string
+
+func (p *ModelPriceConf) GetCurrency() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCurrency() {
+		return ModelPriceConf_Currency_DEFAULT
+	}
+	return *p.Currency
+}
+
+var ModelPriceConf_DefaultPrice_DEFAULT *ModelPrice
+
+func (p *ModelPriceConf) GetDefaultPrice() (v *ModelPrice) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDefaultPrice() {
+		return ModelPriceConf_DefaultPrice_DEFAULT
+	}
+	return p.DefaultPrice
+}
+
+var ModelPriceConf_Models_DEFAULT []*ModelPrice
+
+func (p *ModelPriceConf) GetModels() (v []*ModelPrice) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModels() {
+		return ModelPriceConf_Models_DEFAULT
+	}
+	return p.Models
+}
+func (p *ModelPriceConf) SetCurrency(val *string) {
+	p.Currency = val
+}
+func (p *ModelPriceConf) SetDefaultPrice(val *ModelPrice) {
+	p.DefaultPrice = val
+}
+func (p *ModelPriceConf) SetModels(val []*ModelPrice) {
+	p.Models = val
+}
+
+var fieldIDToName_ModelPriceConf = map[int16]string{
+	1: "currency",
+	2: "default_price",
+	3: "models",
+}
+
+func (p *ModelPriceConf) IsSetCurrency() bool {
+	return p.Currency != nil
+}
+
+func (p *ModelPriceConf) IsSetDefaultPrice() bool {
+	return p.DefaultPrice != nil
+}
+
+func (p *ModelPriceConf) IsSetModels() bool {
+	return p.Models != nil
+}
+
+func (p *ModelPriceConf) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModelPriceConf[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ModelPriceConf) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -27667,77 +45230,713 @@ func (p *ColumnAnnotation) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.TagKeyName = _field
+	p.Currency = _field
+	return nil
+}
+func (p *ModelPriceConf) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewModelPrice()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.DefaultPrice = _field
+	return nil
+}
+func (p *ModelPriceConf) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ModelPrice, 0, size)
+	values := make([]ModelPrice, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Models = _field
+	return nil
+}
+
+func (p *ModelPriceConf) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ModelPriceConf"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ModelPriceConf) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCurrency() {
+		if err = oprot.WriteFieldBegin("currency", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Currency); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ModelPriceConf) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDefaultPrice() {
+		if err = oprot.WriteFieldBegin("default_price", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.DefaultPrice.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ModelPriceConf) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModels() {
+		if err = oprot.WriteFieldBegin("models", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Models)); err != nil {
+			return err
+		}
+		for _, v := range p.Models {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ModelPriceConf) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ModelPriceConf(%+v)", *p)
+
+}
+
+func (p *ModelPriceConf) DeepEqual(ano *ModelPriceConf) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Currency) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.DefaultPrice) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Models) {
+		return false
+	}
+	return true
+}
+
+func (p *ModelPriceConf) Field1DeepEqual(src *string) bool {
+
+	if p.Currency == src {
+		return true
+	} else if p.Currency == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Currency, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ModelPriceConf) Field2DeepEqual(src *ModelPrice) bool {
+
+	if !p.DefaultPrice.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ModelPriceConf) Field3DeepEqual(src []*ModelPrice) bool {
+
+	if len(p.Models) != len(src) {
+		return false
+	}
+	for i, v := range p.Models {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type ExptCostConf struct {
+	// 花费上限 (与价目表同币种), 已核算花费达到上限后停止提交新 item 并终止实验; 为空不限制
+	BudgetCap *float64 `thrift:"budget_cap,1,optional" frugal:"1,optional,double" form:"budget_cap" json:"budget_cap,omitempty" query:"budget_cap"`
+	// 评测对象自身不携带模型信息时用于核算评测对象花费
+	TargetModelID *int64 `thrift:"target_model_id,2,optional" frugal:"2,optional,i64" json:"target_model_id" form:"target_model_id" query:"target_model_id"`
+	// 实验级价目表, 为空时使用全局价目表
+	PriceConf *ModelPriceConf `thrift:"price_conf,3,optional" frugal:"3,optional,ModelPriceConf" form:"price_conf" json:"price_conf,omitempty" query:"price_conf"`
+}
+
+func NewExptCostConf() *ExptCostConf {
+	return &ExptCostConf{}
+}
+
+func (p *ExptCostConf) InitDefault() {
+}
+
+var ExptCostConf_BudgetCap_DEFAULT float64
+
+func (p *ExptCostConf) GetBudgetCap() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBudgetCap() {
+		return ExptCostConf_BudgetCap_DEFAULT
+	}
+	return *p.BudgetCap
+}
+
+var ExptCostConf_TargetModelID_DEFAULT int64
+
+func (p *ExptCostConf) GetTargetModelID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetModelID() {
+		return ExptCostConf_TargetModelID_DEFAULT
+	}
+	return *p.TargetModelID
+}
+
+var ExptCostConf_PriceConf_DEFAULT *ModelPriceConf
+
+func (p *ExptCostConf) GetPriceConf() (v *ModelPriceConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPriceConf() {
+		return ExptCostConf_PriceConf_DEFAULT
+	}
+	return p.PriceConf
+}
+func (p *ExptCostConf) SetBudgetCap(val *float64) {
+	p.BudgetCap = val
+}
+func (p *ExptCostConf) SetTargetModelID(val *int64) {
+	p.TargetModelID = val
+}
+func (p *ExptCostConf) SetPriceConf(val *ModelPriceConf) {
+	p.PriceConf = val
+}
+
+var fieldIDToName_ExptCostConf = map[int16]string{
+	1: "budget_cap",
+	2: "target_model_id",
+	3: "price_conf",
+}
+
+func (p *ExptCostConf) IsSetBudgetCap() bool {
+	return p.BudgetCap != nil
+}
+
+func (p *ExptCostConf) IsSetTargetModelID() bool {
+	return p.TargetModelID != nil
+}
+
+func (p *ExptCostConf) IsSetPriceConf() bool {
+	return p.PriceConf != nil
+}
+
+func (p *ExptCostConf) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptCostConf[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptCostConf) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BudgetCap = _field
+	return nil
+}
+func (p *ExptCostConf) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetModelID = _field
+	return nil
+}
+func (p *ExptCostConf) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewModelPriceConf()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.PriceConf = _field
+	return nil
+}
+
+func (p *ExptCostConf) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptCostConf"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptCostConf) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBudgetCap() {
+		if err = oprot.WriteFieldBegin("budget_cap", thrift.DOUBLE, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.BudgetCap); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptCostConf) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetModelID() {
+		if err = oprot.WriteFieldBegin("target_model_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TargetModelID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptCostConf) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPriceConf() {
+		if err = oprot.WriteFieldBegin("price_conf", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.PriceConf.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ColumnAnnotation) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *ExptCostConf) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	p.Description = _field
-	return nil
+	return fmt.Sprintf("ExptCostConf(%+v)", *p)
+
 }
-func (p *ColumnAnnotation) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field *tag.TagStatus
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *ExptCostConf) DeepEqual(ano *ExptCostConf) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	p.Status = _field
-	return nil
+	if !p.Field1DeepEqual(ano.BudgetCap) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TargetModelID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.PriceConf) {
+		return false
+	}
+	return true
 }
-func (p *ColumnAnnotation) ReadField13(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
+
+func (p *ExptCostConf) Field1DeepEqual(src *float64) bool {
+
+	if p.BudgetCap == src {
+		return true
+	} else if p.BudgetCap == nil || src == nil {
+		return false
 	}
-	_field := make([]*tag.TagValue, 0, size)
-	values := make([]tag.TagValue, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
+	if *p.BudgetCap != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptCostConf) Field2DeepEqual(src *int64) bool {
 
-		if err := _elem.Read(iprot); err != nil {
-			return err
+	if p.TargetModelID == src {
+		return true
+	} else if p.TargetModelID == nil || src == nil {
+		return false
+	}
+	if *p.TargetModelID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptCostConf) Field3DeepEqual(src *ModelPriceConf) bool {
+
+	if !p.PriceConf.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ExptCostStats struct {
+	TargetCost    *float64 `thrift:"target_cost,1,optional" frugal:"1,optional,double" form:"target_cost" json:"target_cost,omitempty" query:"target_cost"`
+	EvaluatorCost *float64 `thrift:"evaluator_cost,2,optional" frugal:"2,optional,double" form:"evaluator_cost" json:"evaluator_cost,omitempty" query:"evaluator_cost"`
+	TotalCost     *float64 `thrift:"total_cost,3,optional" frugal:"3,optional,double" form:"total_cost" json:"total_cost,omitempty" query:"total_cost"`
+}
+
+func NewExptCostStats() *ExptCostStats {
+	return &ExptCostStats{}
+}
+
+func (p *ExptCostStats) InitDefault() {
+}
+
+var ExptCostStats_TargetCost_DEFAULT float64
+
+func (p *ExptCostStats) GetTargetCost() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetCost() {
+		return ExptCostStats_TargetCost_DEFAULT
+	}
+	return *p.TargetCost
+}
+
+var ExptCostStats_EvaluatorCost_DEFAULT float64
+
+func (p *ExptCostStats) GetEvaluatorCost() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorCost() {
+		return ExptCostStats_EvaluatorCost_DEFAULT
+	}
+	return *p.EvaluatorCost
+}
+
+var ExptCostStats_TotalCost_DEFAULT float64
+
+func (p *ExptCostStats) GetTotalCost() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTotalCost() {
+		return ExptCostStats_TotalCost_DEFAULT
+	}
+	return *p.TotalCost
+}
+func (p *ExptCostStats) SetTargetCost(val *float64) {
+	p.TargetCost = val
+}
+func (p *ExptCostStats) SetEvaluatorCost(val *float64) {
+	p.EvaluatorCost = val
+}
+func (p *ExptCostStats) SetTotalCost(val *float64) {
+	p.TotalCost = val
+}
+
+var fieldIDToName_ExptCostStats = map[int16]string{
+	1: "target_cost",
+	2: "evaluator_cost",
+	3: "total_cost",
+}
+
+func (p *ExptCostStats) IsSetTargetCost() bool {
+	return p.TargetCost != nil
+}
+
+func (p *ExptCostStats) IsSetEvaluatorCost() bool {
+	return p.EvaluatorCost != nil
+}
+
+func (p *ExptCostStats) IsSetTotalCost() bool {
+	return p.TotalCost != nil
+}
+
+func (p *ExptCostStats) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
 		}
 
-		_field = append(_field, _elem)
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err := iprot.ReadListEnd(); err != nil {
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptCostStats[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptCostStats) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.TagValues = _field
+	p.TargetCost = _field
 	return nil
 }
-func (p *ColumnAnnotation) ReadField14(iprot thrift.TProtocol) error {
+func (p *ExptCostStats) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *tag.TagContentType
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.ContentType = _field
+	p.EvaluatorCost = _field
 	return nil
 }
-func (p *ColumnAnnotation) ReadField15(iprot thrift.TProtocol) error {
-	_field := tag.NewTagContentSpec()
-	if err := _field.Read(iprot); err != nil {
+func (p *ExptCostStats) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.ContentSpec = _field
+	p.TotalCost = _field
 	return nil
 }
 
-func (p *ColumnAnnotation) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExptCostStats) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ColumnAnnotation"); err != nil {
+	if err = oprot.WriteStructBegin("ExptCostStats"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -27753,22 +45952,6 @@ func (p *ColumnAnnotation) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField13(oprot); err != nil {
-			fieldId = 13
-			goto WriteFieldError
-		}
-		if err = p.writeField14(oprot); err != nil {
-			fieldId = 14
-			goto WriteFieldError
-		}
-		if err = p.writeField15(oprot); err != nil {
-			fieldId = 15
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -27787,12 +45970,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ColumnAnnotation) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTagKeyID() {
-		if err = oprot.WriteFieldBegin("tag_key_id", thrift.I64, 1); err != nil {
+func (p *ExptCostStats) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetCost() {
+		if err = oprot.WriteFieldBegin("target_cost", thrift.DOUBLE, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.TagKeyID); err != nil {
+		if err := oprot.WriteDouble(*p.TargetCost); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -27805,12 +45988,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ColumnAnnotation) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTagKeyName() {
-		if err = oprot.WriteFieldBegin("tag_key_name", thrift.STRING, 2); err != nil {
+func (p *ExptCostStats) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorCost() {
+		if err = oprot.WriteFieldBegin("evaluator_cost", thrift.DOUBLE, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.TagKeyName); err != nil {
+		if err := oprot.WriteDouble(*p.EvaluatorCost); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -27823,12 +46006,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ColumnAnnotation) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDescription() {
-		if err = oprot.WriteFieldBegin("description", thrift.STRING, 3); err != nil {
+func (p *ExptCostStats) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTotalCost() {
+		if err = oprot.WriteFieldBegin("total_cost", thrift.DOUBLE, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Description); err != nil {
+		if err := oprot.WriteDouble(*p.TotalCost); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -27841,38 +46024,298 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ColumnAnnotation) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatus() {
-		if err = oprot.WriteFieldBegin("status", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
+
+func (p *ExptCostStats) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptCostStats(%+v)", *p)
+
+}
+
+func (p *ExptCostStats) DeepEqual(ano *ExptCostStats) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.TargetCost) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.EvaluatorCost) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.TotalCost) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptCostStats) Field1DeepEqual(src *float64) bool {
+
+	if p.TargetCost == src {
+		return true
+	} else if p.TargetCost == nil || src == nil {
+		return false
+	}
+	if *p.TargetCost != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptCostStats) Field2DeepEqual(src *float64) bool {
+
+	if p.EvaluatorCost == src {
+		return true
+	} else if p.EvaluatorCost == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatorCost != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptCostStats) Field3DeepEqual(src *float64) bool {
+
+	if p.TotalCost == src {
+		return true
+	} else if p.TotalCost == nil || src == nil {
+		return false
+	}
+	if *p.TotalCost != *src {
+		return false
+	}
+	return true
+}
+
+// ===============================
+// 结果缓存
+// ===============================
+// 实验结果缓存配置 (opt-in): 同空间内评测对象版本 + 输入一致、评估器版本 + 输入一致的调用直接复用历史结果
+type ExptResultCacheConf struct {
+	EnableTargetCache    *bool `thrift:"enable_target_cache,1,optional" frugal:"1,optional,bool" form:"enable_target_cache" json:"enable_target_cache,omitempty" query:"enable_target_cache"`
+	EnableEvaluatorCache *bool `thrift:"enable_evaluator_cache,2,optional" frugal:"2,optional,bool" form:"enable_evaluator_cache" json:"enable_evaluator_cache,omitempty" query:"enable_evaluator_cache"`
+	// 仅复用该时长内写入的缓存结果, 为空时以空间缓存存活时间为准
+	TTLSeconds *int64 `thrift:"ttl_seconds,3,optional" frugal:"3,optional,i64" json:"ttl_seconds" form:"ttl_seconds" query:"ttl_seconds"`
+}
+
+func NewExptResultCacheConf() *ExptResultCacheConf {
+	return &ExptResultCacheConf{}
+}
+
+func (p *ExptResultCacheConf) InitDefault() {
+}
+
+var ExptResultCacheConf_EnableTargetCache_DEFAULT bool
+
+func (p *ExptResultCacheConf) GetEnableTargetCache() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEnableTargetCache() {
+		return ExptResultCacheConf_EnableTargetCache_DEFAULT
+	}
+	return *p.EnableTargetCache
+}
+
+var ExptResultCacheConf_EnableEvaluatorCache_DEFAULT bool
+
+func (p *ExptResultCacheConf) GetEnableEvaluatorCache() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEnableEvaluatorCache() {
+		return ExptResultCacheConf_EnableEvaluatorCache_DEFAULT
+	}
+	return *p.EnableEvaluatorCache
+}
+
+var ExptResultCacheConf_TTLSeconds_DEFAULT int64
+
+func (p *ExptResultCacheConf) GetTTLSeconds() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTTLSeconds() {
+		return ExptResultCacheConf_TTLSeconds_DEFAULT
+	}
+	return *p.TTLSeconds
+}
+func (p *ExptResultCacheConf) SetEnableTargetCache(val *bool) {
+	p.EnableTargetCache = val
+}
+func (p *ExptResultCacheConf) SetEnableEvaluatorCache(val *bool) {
+	p.EnableEvaluatorCache = val
+}
+func (p *ExptResultCacheConf) SetTTLSeconds(val *int64) {
+	p.TTLSeconds = val
+}
+
+var fieldIDToName_ExptResultCacheConf = map[int16]string{
+	1: "enable_target_cache",
+	2: "enable_evaluator_cache",
+	3: "ttl_seconds",
+}
+
+func (p *ExptResultCacheConf) IsSetEnableTargetCache() bool {
+	return p.EnableTargetCache != nil
+}
+
+func (p *ExptResultCacheConf) IsSetEnableEvaluatorCache() bool {
+	return p.EnableEvaluatorCache != nil
+}
+
+func (p *ExptResultCacheConf) IsSetTTLSeconds() bool {
+	return p.TTLSeconds != nil
+}
+
+func (p *ExptResultCacheConf) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Status); err != nil {
-			return err
+		if fieldTypeId == thrift.STOP {
+			break
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
 		}
 	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptResultCacheConf[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *ColumnAnnotation) writeField13(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTagValues() {
-		if err = oprot.WriteFieldBegin("tag_values", thrift.LIST, 13); err != nil {
-			goto WriteFieldBeginError
+
+func (p *ExptResultCacheConf) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EnableTargetCache = _field
+	return nil
+}
+func (p *ExptResultCacheConf) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EnableEvaluatorCache = _field
+	return nil
+}
+func (p *ExptResultCacheConf) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TTLSeconds = _field
+	return nil
+}
+
+func (p *ExptResultCacheConf) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptResultCacheConf"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TagValues)); err != nil {
-			return err
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
 		}
-		for _, v := range p.TagValues {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
 		}
-		if err := oprot.WriteListEnd(); err != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptResultCacheConf) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnableTargetCache() {
+		if err = oprot.WriteFieldBegin("enable_target_cache", thrift.BOOL, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.EnableTargetCache); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -27881,16 +46324,16 @@ func (p *ColumnAnnotation) writeField13(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ColumnAnnotation) writeField14(oprot thrift.TProtocol) (err error) {
-	if p.IsSetContentType() {
-		if err = oprot.WriteFieldBegin("content_type", thrift.STRING, 14); err != nil {
+func (p *ExptResultCacheConf) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnableEvaluatorCache() {
+		if err = oprot.WriteFieldBegin("enable_evaluator_cache", thrift.BOOL, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.ContentType); err != nil {
+		if err := oprot.WriteBool(*p.EnableEvaluatorCache); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -27899,16 +46342,16 @@ func (p *ColumnAnnotation) writeField14(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ColumnAnnotation) writeField15(oprot thrift.TProtocol) (err error) {
-	if p.IsSetContentSpec() {
-		if err = oprot.WriteFieldBegin("content_spec", thrift.STRUCT, 15); err != nil {
+func (p *ExptResultCacheConf) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTTLSeconds() {
+		if err = oprot.WriteFieldBegin("ttl_seconds", thrift.I64, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.ContentSpec.Write(oprot); err != nil {
+		if err := oprot.WriteI64(*p.TTLSeconds); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -27917,346 +46360,200 @@ func (p *ColumnAnnotation) writeField15(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ColumnAnnotation) String() string {
+func (p *ExptResultCacheConf) String() string {
 	if p == nil {
 		return "<nil>"
-	}
-	return fmt.Sprintf("ColumnAnnotation(%+v)", *p)
-
-}
-
-func (p *ColumnAnnotation) DeepEqual(ano *ColumnAnnotation) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
-	}
-	if !p.Field1DeepEqual(ano.TagKeyID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.TagKeyName) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.Description) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.Status) {
-		return false
-	}
-	if !p.Field13DeepEqual(ano.TagValues) {
-		return false
-	}
-	if !p.Field14DeepEqual(ano.ContentType) {
-		return false
-	}
-	if !p.Field15DeepEqual(ano.ContentSpec) {
-		return false
-	}
-	return true
-}
+	}
+	return fmt.Sprintf("ExptResultCacheConf(%+v)", *p)
 
-func (p *ColumnAnnotation) Field1DeepEqual(src *int64) bool {
+}
 
-	if p.TagKeyID == src {
+func (p *ExptResultCacheConf) DeepEqual(ano *ExptResultCacheConf) bool {
+	if p == ano {
 		return true
-	} else if p.TagKeyID == nil || src == nil {
+	} else if p == nil || ano == nil {
 		return false
 	}
-	if *p.TagKeyID != *src {
+	if !p.Field1DeepEqual(ano.EnableTargetCache) {
 		return false
 	}
-	return true
-}
-func (p *ColumnAnnotation) Field2DeepEqual(src *string) bool {
-
-	if p.TagKeyName == src {
-		return true
-	} else if p.TagKeyName == nil || src == nil {
+	if !p.Field2DeepEqual(ano.EnableEvaluatorCache) {
 		return false
 	}
-	if strings.Compare(*p.TagKeyName, *src) != 0 {
+	if !p.Field3DeepEqual(ano.TTLSeconds) {
 		return false
 	}
 	return true
 }
-func (p *ColumnAnnotation) Field3DeepEqual(src *string) bool {
 
-	if p.Description == src {
+func (p *ExptResultCacheConf) Field1DeepEqual(src *bool) bool {
+
+	if p.EnableTargetCache == src {
 		return true
-	} else if p.Description == nil || src == nil {
+	} else if p.EnableTargetCache == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Description, *src) != 0 {
+	if *p.EnableTargetCache != *src {
 		return false
 	}
 	return true
 }
-func (p *ColumnAnnotation) Field4DeepEqual(src *tag.TagStatus) bool {
+func (p *ExptResultCacheConf) Field2DeepEqual(src *bool) bool {
 
-	if p.Status == src {
+	if p.EnableEvaluatorCache == src {
 		return true
-	} else if p.Status == nil || src == nil {
+	} else if p.EnableEvaluatorCache == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Status, *src) != 0 {
-		return false
-	}
-	return true
-}
-func (p *ColumnAnnotation) Field13DeepEqual(src []*tag.TagValue) bool {
-
-	if len(p.TagValues) != len(src) {
+	if *p.EnableEvaluatorCache != *src {
 		return false
 	}
-	for i, v := range p.TagValues {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
 	return true
 }
-func (p *ColumnAnnotation) Field14DeepEqual(src *tag.TagContentType) bool {
+func (p *ExptResultCacheConf) Field3DeepEqual(src *int64) bool {
 
-	if p.ContentType == src {
+	if p.TTLSeconds == src {
 		return true
-	} else if p.ContentType == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.ContentType, *src) != 0 {
+	} else if p.TTLSeconds == nil || src == nil {
 		return false
 	}
-	return true
-}
-func (p *ColumnAnnotation) Field15DeepEqual(src *tag.TagContentSpec) bool {
-
-	if !p.ContentSpec.DeepEqual(src) {
+	if *p.TTLSeconds != *src {
 		return false
 	}
 	return true
 }
 
-type ExptResultExportRecord struct {
-	ExportID        int64            `thrift:"export_id,1,required" frugal:"1,required,i64" json:"export_id" form:"export_id,required" query:"export_id,required"`
-	WorkspaceID     int64            `thrift:"workspace_id,2,required" frugal:"2,required,i64" json:"workspace_id" form:"workspace_id,required" query:"workspace_id,required"`
-	ExptID          int64            `thrift:"expt_id,3,required" frugal:"3,required,i64" json:"expt_id" form:"expt_id,required" query:"expt_id,required"`
-	CsvExportStatus CSVExportStatus  `thrift:"csv_export_status,4,required" frugal:"4,required,string" form:"csv_export_status,required" json:"csv_export_status,required" query:"csv_export_status,required"`
-	BaseInfo        *common.BaseInfo `thrift:"base_info,5,optional" frugal:"5,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
-	StartTime       *int64           `thrift:"start_time,6,optional" frugal:"6,optional,i64" json:"start_time" form:"start_time" query:"start_time"`
-	EndTime         *int64           `thrift:"end_time,7,optional" frugal:"7,optional,i64" json:"end_time" form:"end_time" query:"end_time"`
-	// deprecated, cause not match snake name
-	URL     *string   `thrift:"URL,8,optional" frugal:"8,optional,string" form:"URL" json:"URL,omitempty" query:"URL"`
-	Expired *bool     `thrift:"expired,9,optional" frugal:"9,optional,bool" form:"expired" json:"expired,omitempty" query:"expired"`
-	Error   *RunError `thrift:"error,10,optional" frugal:"10,optional,RunError" form:"error" json:"error,omitempty" query:"error"`
-	URL_    *string   `thrift:"url,11,optional" frugal:"11,optional,string" form:"url" json:"url,omitempty" query:"url"`
-}
-
-func NewExptResultExportRecord() *ExptResultExportRecord {
-	return &ExptResultExportRecord{}
-}
-
-func (p *ExptResultExportRecord) InitDefault() {
-}
-
-func (p *ExptResultExportRecord) GetExportID() (v int64) {
-	if p != nil {
-		return p.ExportID
-	}
-	return
-}
-
-func (p *ExptResultExportRecord) GetWorkspaceID() (v int64) {
-	if p != nil {
-		return p.WorkspaceID
-	}
-	return
-}
-
-func (p *ExptResultExportRecord) GetExptID() (v int64) {
-	if p != nil {
-		return p.ExptID
-	}
-	return
-}
-
-func (p *ExptResultExportRecord) GetCsvExportStatus() (v CSVExportStatus) {
-	if p != nil {
-		return p.CsvExportStatus
-	}
-	return
+// 实验抽样配置, 仅单评测集实验生效; 同一 seed 与评测集版本下抽样结果稳定
+type ExptSamplingConf struct {
+	Mode *ExptSamplingMode `thrift:"mode,1,optional" frugal:"1,optional,string" form:"mode" json:"mode,omitempty" query:"mode"`
+	// random_n 必填; stratified 下与 percentage 二选一
+	SampleNum *int32 `thrift:"sample_num,2,optional" frugal:"2,optional,i32" form:"sample_num" json:"sample_num,omitempty" query:"sample_num"`
+	// 取值 (0, 100]
+	Percentage *float64 `thrift:"percentage,3,optional" frugal:"3,optional,double" form:"percentage" json:"percentage,omitempty" query:"percentage"`
+	// 分层字段名 (评测集列名), stratified 必填
+	StratifyField *string `thrift:"stratify_field,4,optional" frugal:"4,optional,string" form:"stratify_field" json:"stratify_field,omitempty" query:"stratify_field"`
+	// 为空时自动生成并回写
+	Seed *int64 `thrift:"seed,5,optional" frugal:"5,optional,i64" json:"seed" form:"seed" query:"seed"`
 }
 
-var ExptResultExportRecord_BaseInfo_DEFAULT *common.BaseInfo
-
-func (p *ExptResultExportRecord) GetBaseInfo() (v *common.BaseInfo) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetBaseInfo() {
-		return ExptResultExportRecord_BaseInfo_DEFAULT
-	}
-	return p.BaseInfo
+func NewExptSamplingConf() *ExptSamplingConf {
+	return &ExptSamplingConf{}
 }
 
-var ExptResultExportRecord_StartTime_DEFAULT int64
-
-func (p *ExptResultExportRecord) GetStartTime() (v int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetStartTime() {
-		return ExptResultExportRecord_StartTime_DEFAULT
-	}
-	return *p.StartTime
+func (p *ExptSamplingConf) InitDefault() {
 }
 
-var ExptResultExportRecord_EndTime_DEFAULT int64
+var ExptSamplingConf_Mode_DEFAULT ExptSamplingMode
 
-func (p *ExptResultExportRecord) GetEndTime() (v int64) {
+func (p *ExptSamplingConf) GetMode() (v ExptSamplingMode) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEndTime() {
-		return ExptResultExportRecord_EndTime_DEFAULT
+	if !p.IsSetMode() {
+		return ExptSamplingConf_Mode_DEFAULT
 	}
-	return *p.EndTime
+	return *p.Mode
 }
 
-var ExptResultExportRecord_URL_DEFAULT string
+var ExptSamplingConf_SampleNum_DEFAULT int32
 
-func (p *ExptResultExportRecord) GetURL() (v string) {
+func (p *ExptSamplingConf) GetSampleNum() (v int32) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetURL() {
-		return ExptResultExportRecord_URL_DEFAULT
+	if !p.IsSetSampleNum() {
+		return ExptSamplingConf_SampleNum_DEFAULT
 	}
-	return *p.URL
+	return *p.SampleNum
 }
 
-var ExptResultExportRecord_Expired_DEFAULT bool
+var ExptSamplingConf_Percentage_DEFAULT float64
 
-func (p *ExptResultExportRecord) GetExpired() (v bool) {
+func (p *ExptSamplingConf) GetPercentage() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetExpired() {
-		return ExptResultExportRecord_Expired_DEFAULT
+	if !p.IsSetPercentage() {
+		return ExptSamplingConf_Percentage_DEFAULT
 	}
-	return *p.Expired
+	return *p.Percentage
 }
 
-var ExptResultExportRecord_Error_DEFAULT *RunError
+var ExptSamplingConf_StratifyField_DEFAULT string
 
-func (p *ExptResultExportRecord) GetError() (v *RunError) {
+func (p *ExptSamplingConf) GetStratifyField() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetError() {
-		return ExptResultExportRecord_Error_DEFAULT
+	if !p.IsSetStratifyField() {
+		return ExptSamplingConf_StratifyField_DEFAULT
 	}
-	return p.Error
+	return *p.StratifyField
 }
 
-var ExptResultExportRecord_URL__DEFAULT string
+var ExptSamplingConf_Seed_DEFAULT int64
 
-func (p *ExptResultExportRecord) GetURL_() (v string) {
+func (p *ExptSamplingConf) GetSeed() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetURL_() {
-		return ExptResultExportRecord_URL__DEFAULT
+	if !p.IsSetSeed() {
+		return ExptSamplingConf_Seed_DEFAULT
 	}
-	return *p.URL_
-}
-func (p *ExptResultExportRecord) SetExportID(val int64) {
-	p.ExportID = val
-}
-func (p *ExptResultExportRecord) SetWorkspaceID(val int64) {
-	p.WorkspaceID = val
-}
-func (p *ExptResultExportRecord) SetExptID(val int64) {
-	p.ExptID = val
-}
-func (p *ExptResultExportRecord) SetCsvExportStatus(val CSVExportStatus) {
-	p.CsvExportStatus = val
-}
-func (p *ExptResultExportRecord) SetBaseInfo(val *common.BaseInfo) {
-	p.BaseInfo = val
-}
-func (p *ExptResultExportRecord) SetStartTime(val *int64) {
-	p.StartTime = val
+	return *p.Seed
 }
-func (p *ExptResultExportRecord) SetEndTime(val *int64) {
-	p.EndTime = val
-}
-func (p *ExptResultExportRecord) SetURL(val *string) {
-	p.URL = val
-}
-func (p *ExptResultExportRecord) SetExpired(val *bool) {
-	p.Expired = val
+func (p *ExptSamplingConf) SetMode(val *ExptSamplingMode) {
+	p.Mode = val
 }
-func (p *ExptResultExportRecord) SetError(val *RunError) {
-	p.Error = val
+func (p *ExptSamplingConf) SetSampleNum(val *int32) {
+	p.SampleNum = val
 }
-func (p *ExptResultExportRecord) SetURL_(val *string) {
-	p.URL_ = val
+func (p *ExptSamplingConf) SetPercentage(val *float64) {
+	p.Percentage = val
 }
-
-var fieldIDToName_ExptResultExportRecord = map[int16]string{
-	1:  "export_id",
-	2:  "workspace_id",
-	3:  "expt_id",
-	4:  "csv_export_status",
-	5:  "base_info",
-	6:  "start_time",
-	7:  "end_time",
-	8:  "URL",
-	9:  "expired",
-	10: "error",
-	11: "url",
+func (p *ExptSamplingConf) SetStratifyField(val *string) {
+	p.StratifyField = val
 }
-
-func (p *ExptResultExportRecord) IsSetBaseInfo() bool {
-	return p.BaseInfo != nil
+func (p *ExptSamplingConf) SetSeed(val *int64) {
+	p.Seed = val
 }
 
-func (p *ExptResultExportRecord) IsSetStartTime() bool {
-	return p.StartTime != nil
+var fieldIDToName_ExptSamplingConf = map[int16]string{
+	1: "mode",
+	2: "sample_num",
+	3: "percentage",
+	4: "stratify_field",
+	5: "seed",
 }
 
-func (p *ExptResultExportRecord) IsSetEndTime() bool {
-	return p.EndTime != nil
+func (p *ExptSamplingConf) IsSetMode() bool {
+	return p.Mode != nil
 }
 
-func (p *ExptResultExportRecord) IsSetURL() bool {
-	return p.URL != nil
+func (p *ExptSamplingConf) IsSetSampleNum() bool {
+	return p.SampleNum != nil
 }
 
-func (p *ExptResultExportRecord) IsSetExpired() bool {
-	return p.Expired != nil
+func (p *ExptSamplingConf) IsSetPercentage() bool {
+	return p.Percentage != nil
 }
 
-func (p *ExptResultExportRecord) IsSetError() bool {
-	return p.Error != nil
+func (p *ExptSamplingConf) IsSetStratifyField() bool {
+	return p.StratifyField != nil
 }
 
-func (p *ExptResultExportRecord) IsSetURL_() bool {
-	return p.URL_ != nil
+func (p *ExptSamplingConf) IsSetSeed() bool {
+	return p.Seed != nil
 }
 
-func (p *ExptResultExportRecord) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptSamplingConf) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetExportID bool = false
-	var issetWorkspaceID bool = false
-	var issetExptID bool = false
-	var issetCsvExportStatus bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -28273,29 +46570,26 @@ func (p *ExptResultExportRecord) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetExportID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetWorkspaceID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetExptID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -28304,61 +46598,12 @@ func (p *ExptResultExportRecord) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCsvExportStatus = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
 			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 11:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField11(iprot); err != nil {
+				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -28377,32 +46622,13 @@ func (p *ExptResultExportRecord) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetExportID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetWorkspaceID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetExptID {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetCsvExportStatus {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptResultExportRecord[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptSamplingConf[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -28410,85 +46636,42 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptResultExportRecord[fieldId]))
-}
-
-func (p *ExptResultExportRecord) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ExportID = _field
-	return nil
-}
-func (p *ExptResultExportRecord) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.WorkspaceID = _field
-	return nil
 }
-func (p *ExptResultExportRecord) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ExptID = _field
-	return nil
-}
-func (p *ExptResultExportRecord) ReadField4(iprot thrift.TProtocol) error {
+func (p *ExptSamplingConf) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field CSVExportStatus
+	var _field *ExptSamplingMode
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
-	}
-	p.CsvExportStatus = _field
-	return nil
-}
-func (p *ExptResultExportRecord) ReadField5(iprot thrift.TProtocol) error {
-	_field := common.NewBaseInfo()
-	if err := _field.Read(iprot); err != nil {
-		return err
+		_field = &v
 	}
-	p.BaseInfo = _field
+	p.Mode = _field
 	return nil
 }
-func (p *ExptResultExportRecord) ReadField6(iprot thrift.TProtocol) error {
+func (p *ExptSamplingConf) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.StartTime = _field
+	p.SampleNum = _field
 	return nil
 }
-func (p *ExptResultExportRecord) ReadField7(iprot thrift.TProtocol) error {
+func (p *ExptSamplingConf) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.EndTime = _field
+	p.Percentage = _field
 	return nil
 }
-func (p *ExptResultExportRecord) ReadField8(iprot thrift.TProtocol) error {
+func (p *ExptSamplingConf) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -28496,43 +46679,24 @@ func (p *ExptResultExportRecord) ReadField8(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.URL = _field
-	return nil
-}
-func (p *ExptResultExportRecord) ReadField9(iprot thrift.TProtocol) error {
-
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Expired = _field
-	return nil
-}
-func (p *ExptResultExportRecord) ReadField10(iprot thrift.TProtocol) error {
-	_field := NewRunError()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.Error = _field
+	p.StratifyField = _field
 	return nil
 }
-func (p *ExptResultExportRecord) ReadField11(iprot thrift.TProtocol) error {
+func (p *ExptSamplingConf) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.URL_ = _field
+	p.Seed = _field
 	return nil
 }
 
-func (p *ExptResultExportRecord) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExptSamplingConf) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptResultExportRecord"); err != nil {
+	if err = oprot.WriteStructBegin("ExptSamplingConf"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -28556,30 +46720,6 @@ func (p *ExptResultExportRecord) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -28598,112 +46738,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExptResultExportRecord) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("export_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ExportID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *ExptResultExportRecord) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-func (p *ExptResultExportRecord) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("expt_id", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ExptID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *ExptResultExportRecord) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("csv_export_status", thrift.STRING, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.CsvExportStatus); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *ExptResultExportRecord) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBaseInfo() {
-		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.BaseInfo.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-func (p *ExptResultExportRecord) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStartTime() {
-		if err = oprot.WriteFieldBegin("start_time", thrift.I64, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.StartTime); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-func (p *ExptResultExportRecord) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEndTime() {
-		if err = oprot.WriteFieldBegin("end_time", thrift.I64, 7); err != nil {
+func (p *ExptSamplingConf) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMode() {
+		if err = oprot.WriteFieldBegin("mode", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.EndTime); err != nil {
+		if err := oprot.WriteString(*p.Mode); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -28712,16 +46752,16 @@ func (p *ExptResultExportRecord) writeField7(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
-}
-func (p *ExptResultExportRecord) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetURL() {
-		if err = oprot.WriteFieldBegin("URL", thrift.STRING, 8); err != nil {
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptSamplingConf) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSampleNum() {
+		if err = oprot.WriteFieldBegin("sample_num", thrift.I32, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.URL); err != nil {
+		if err := oprot.WriteI32(*p.SampleNum); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -28730,16 +46770,16 @@ func (p *ExptResultExportRecord) writeField8(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ExptResultExportRecord) writeField9(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExpired() {
-		if err = oprot.WriteFieldBegin("expired", thrift.BOOL, 9); err != nil {
+func (p *ExptSamplingConf) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPercentage() {
+		if err = oprot.WriteFieldBegin("percentage", thrift.DOUBLE, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.Expired); err != nil {
+		if err := oprot.WriteDouble(*p.Percentage); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -28748,16 +46788,16 @@ func (p *ExptResultExportRecord) writeField9(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ExptResultExportRecord) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetError() {
-		if err = oprot.WriteFieldBegin("error", thrift.STRUCT, 10); err != nil {
+func (p *ExptSamplingConf) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStratifyField() {
+		if err = oprot.WriteFieldBegin("stratify_field", thrift.STRING, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Error.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.StratifyField); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -28766,16 +46806,16 @@ func (p *ExptResultExportRecord) writeField10(oprot thrift.TProtocol) (err error
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *ExptResultExportRecord) writeField11(oprot thrift.TProtocol) (err error) {
-	if p.IsSetURL_() {
-		if err = oprot.WriteFieldBegin("url", thrift.STRING, 11); err != nil {
+func (p *ExptSamplingConf) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSeed() {
+		if err = oprot.WriteFieldBegin("seed", thrift.I64, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.URL_); err != nil {
+		if err := oprot.WriteI64(*p.Seed); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -28784,338 +46824,205 @@ func (p *ExptResultExportRecord) writeField11(oprot thrift.TProtocol) (err error
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *ExptResultExportRecord) String() string {
+func (p *ExptSamplingConf) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptResultExportRecord(%+v)", *p)
+	return fmt.Sprintf("ExptSamplingConf(%+v)", *p)
 
 }
 
-func (p *ExptResultExportRecord) DeepEqual(ano *ExptResultExportRecord) bool {
+func (p *ExptSamplingConf) DeepEqual(ano *ExptSamplingConf) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ExportID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.WorkspaceID) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.ExptID) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.CsvExportStatus) {
-		return false
-	}
-	if !p.Field5DeepEqual(ano.BaseInfo) {
-		return false
-	}
-	if !p.Field6DeepEqual(ano.StartTime) {
-		return false
-	}
-	if !p.Field7DeepEqual(ano.EndTime) {
-		return false
-	}
-	if !p.Field8DeepEqual(ano.URL) {
-		return false
-	}
-	if !p.Field9DeepEqual(ano.Expired) {
-		return false
-	}
-	if !p.Field10DeepEqual(ano.Error) {
-		return false
-	}
-	if !p.Field11DeepEqual(ano.URL_) {
+	if !p.Field1DeepEqual(ano.Mode) {
 		return false
 	}
-	return true
-}
-
-func (p *ExptResultExportRecord) Field1DeepEqual(src int64) bool {
-
-	if p.ExportID != src {
+	if !p.Field2DeepEqual(ano.SampleNum) {
 		return false
 	}
-	return true
-}
-func (p *ExptResultExportRecord) Field2DeepEqual(src int64) bool {
-
-	if p.WorkspaceID != src {
+	if !p.Field3DeepEqual(ano.Percentage) {
 		return false
 	}
-	return true
-}
-func (p *ExptResultExportRecord) Field3DeepEqual(src int64) bool {
-
-	if p.ExptID != src {
+	if !p.Field4DeepEqual(ano.StratifyField) {
 		return false
 	}
-	return true
-}
-func (p *ExptResultExportRecord) Field4DeepEqual(src CSVExportStatus) bool {
-
-	if strings.Compare(p.CsvExportStatus, src) != 0 {
+	if !p.Field5DeepEqual(ano.Seed) {
 		return false
 	}
 	return true
 }
-func (p *ExptResultExportRecord) Field5DeepEqual(src *common.BaseInfo) bool {
 
-	if !p.BaseInfo.DeepEqual(src) {
-		return false
-	}
-	return true
-}
-func (p *ExptResultExportRecord) Field6DeepEqual(src *int64) bool {
+func (p *ExptSamplingConf) Field1DeepEqual(src *ExptSamplingMode) bool {
 
-	if p.StartTime == src {
+	if p.Mode == src {
 		return true
-	} else if p.StartTime == nil || src == nil {
+	} else if p.Mode == nil || src == nil {
 		return false
 	}
-	if *p.StartTime != *src {
+	if strings.Compare(*p.Mode, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExptResultExportRecord) Field7DeepEqual(src *int64) bool {
+func (p *ExptSamplingConf) Field2DeepEqual(src *int32) bool {
 
-	if p.EndTime == src {
+	if p.SampleNum == src {
 		return true
-	} else if p.EndTime == nil || src == nil {
+	} else if p.SampleNum == nil || src == nil {
 		return false
 	}
-	if *p.EndTime != *src {
+	if *p.SampleNum != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptResultExportRecord) Field8DeepEqual(src *string) bool {
+func (p *ExptSamplingConf) Field3DeepEqual(src *float64) bool {
 
-	if p.URL == src {
+	if p.Percentage == src {
 		return true
-	} else if p.URL == nil || src == nil {
+	} else if p.Percentage == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.URL, *src) != 0 {
+	if *p.Percentage != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptResultExportRecord) Field9DeepEqual(src *bool) bool {
+func (p *ExptSamplingConf) Field4DeepEqual(src *string) bool {
 
-	if p.Expired == src {
+	if p.StratifyField == src {
 		return true
-	} else if p.Expired == nil || src == nil {
+	} else if p.StratifyField == nil || src == nil {
 		return false
 	}
-	if *p.Expired != *src {
-		return false
-	}
-	return true
-}
-func (p *ExptResultExportRecord) Field10DeepEqual(src *RunError) bool {
-
-	if !p.Error.DeepEqual(src) {
+	if strings.Compare(*p.StratifyField, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExptResultExportRecord) Field11DeepEqual(src *string) bool {
+func (p *ExptSamplingConf) Field5DeepEqual(src *int64) bool {
 
-	if p.URL_ == src {
+	if p.Seed == src {
 		return true
-	} else if p.URL_ == nil || src == nil {
+	} else if p.Seed == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.URL_, *src) != 0 {
+	if *p.Seed != *src {
 		return false
 	}
 	return true
 }
 
-// 洞察分析记录
-type ExptInsightAnalysisRecord struct {
-	RecordID                    int64                        `thrift:"record_id,1,required" frugal:"1,required,i64" json:"record_id" form:"record_id,required" query:"record_id,required"`
-	WorkspaceID                 int64                        `thrift:"workspace_id,2,required" frugal:"2,required,i64" json:"workspace_id" form:"workspace_id,required" query:"workspace_id,required"`
-	ExptID                      int64                        `thrift:"expt_id,3,required" frugal:"3,required,i64" json:"expt_id" form:"expt_id,required" query:"expt_id,required"`
-	AnalysisStatus              InsightAnalysisStatus        `thrift:"analysis_status,4,required" frugal:"4,required,string" form:"analysis_status,required" json:"analysis_status,required" query:"analysis_status,required"`
-	AnalysisReportID            *int64                       `thrift:"analysis_report_id,5,optional" frugal:"5,optional,i64" json:"analysis_report_id" form:"analysis_report_id" query:"analysis_report_id"`
-	AnalysisReportContent       *string                      `thrift:"analysis_report_content,6,optional" frugal:"6,optional,string" form:"analysis_report_content" json:"analysis_report_content,omitempty" query:"analysis_report_content"`
-	ExptInsightAnalysisFeedback *ExptInsightAnalysisFeedback `thrift:"expt_insight_analysis_feedback,7,optional" frugal:"7,optional,ExptInsightAnalysisFeedback" form:"expt_insight_analysis_feedback" json:"expt_insight_analysis_feedback,omitempty" query:"expt_insight_analysis_feedback"`
-	BaseInfo                    *common.BaseInfo             `thrift:"base_info,8,optional" frugal:"8,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
-	AnalysisReportIndex         []*ExptInsightAnalysisIndex  `thrift:"analysis_report_index,21,optional" frugal:"21,optional,list<ExptInsightAnalysisIndex>" form:"analysis_report_index" json:"analysis_report_index,omitempty" query:"analysis_report_index"`
-}
-
-func NewExptInsightAnalysisRecord() *ExptInsightAnalysisRecord {
-	return &ExptInsightAnalysisRecord{}
-}
-
-func (p *ExptInsightAnalysisRecord) InitDefault() {
-}
-
-func (p *ExptInsightAnalysisRecord) GetRecordID() (v int64) {
-	if p != nil {
-		return p.RecordID
-	}
-	return
-}
-
-func (p *ExptInsightAnalysisRecord) GetWorkspaceID() (v int64) {
-	if p != nil {
-		return p.WorkspaceID
-	}
-	return
-}
-
-func (p *ExptInsightAnalysisRecord) GetExptID() (v int64) {
-	if p != nil {
-		return p.ExptID
-	}
-	return
+type ExptSamplingStratum struct {
+	Value         *string `thrift:"value,1,optional" frugal:"1,optional,string" form:"value" json:"value,omitempty" query:"value"`
+	PopulationCnt *int64  `thrift:"population_cnt,2,optional" frugal:"2,optional,i64" json:"population_cnt" form:"population_cnt" query:"population_cnt"`
+	SampleCnt     *int64  `thrift:"sample_cnt,3,optional" frugal:"3,optional,i64" json:"sample_cnt" form:"sample_cnt" query:"sample_cnt"`
+	// 每条样本代表的总体 item 数
+	Weight *float64 `thrift:"weight,4,optional" frugal:"4,optional,double" form:"weight" json:"weight,omitempty" query:"weight"`
 }
 
-func (p *ExptInsightAnalysisRecord) GetAnalysisStatus() (v InsightAnalysisStatus) {
-	if p != nil {
-		return p.AnalysisStatus
-	}
-	return
+func NewExptSamplingStratum() *ExptSamplingStratum {
+	return &ExptSamplingStratum{}
 }
 
-var ExptInsightAnalysisRecord_AnalysisReportID_DEFAULT int64
-
-func (p *ExptInsightAnalysisRecord) GetAnalysisReportID() (v int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetAnalysisReportID() {
-		return ExptInsightAnalysisRecord_AnalysisReportID_DEFAULT
-	}
-	return *p.AnalysisReportID
+func (p *ExptSamplingStratum) InitDefault() {
 }
 
-var ExptInsightAnalysisRecord_AnalysisReportContent_DEFAULT string
+var ExptSamplingStratum_Value_DEFAULT string
 
-func (p *ExptInsightAnalysisRecord) GetAnalysisReportContent() (v string) {
+func (p *ExptSamplingStratum) GetValue() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetAnalysisReportContent() {
-		return ExptInsightAnalysisRecord_AnalysisReportContent_DEFAULT
+	if !p.IsSetValue() {
+		return ExptSamplingStratum_Value_DEFAULT
 	}
-	return *p.AnalysisReportContent
+	return *p.Value
 }
 
-var ExptInsightAnalysisRecord_ExptInsightAnalysisFeedback_DEFAULT *ExptInsightAnalysisFeedback
+var ExptSamplingStratum_PopulationCnt_DEFAULT int64
 
-func (p *ExptInsightAnalysisRecord) GetExptInsightAnalysisFeedback() (v *ExptInsightAnalysisFeedback) {
+func (p *ExptSamplingStratum) GetPopulationCnt() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetExptInsightAnalysisFeedback() {
-		return ExptInsightAnalysisRecord_ExptInsightAnalysisFeedback_DEFAULT
+	if !p.IsSetPopulationCnt() {
+		return ExptSamplingStratum_PopulationCnt_DEFAULT
 	}
-	return p.ExptInsightAnalysisFeedback
+	return *p.PopulationCnt
 }
 
-var ExptInsightAnalysisRecord_BaseInfo_DEFAULT *common.BaseInfo
+var ExptSamplingStratum_SampleCnt_DEFAULT int64
 
-func (p *ExptInsightAnalysisRecord) GetBaseInfo() (v *common.BaseInfo) {
+func (p *ExptSamplingStratum) GetSampleCnt() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetBaseInfo() {
-		return ExptInsightAnalysisRecord_BaseInfo_DEFAULT
+	if !p.IsSetSampleCnt() {
+		return ExptSamplingStratum_SampleCnt_DEFAULT
 	}
-	return p.BaseInfo
+	return *p.SampleCnt
 }
 
-var ExptInsightAnalysisRecord_AnalysisReportIndex_DEFAULT []*ExptInsightAnalysisIndex
+var ExptSamplingStratum_Weight_DEFAULT float64
 
-func (p *ExptInsightAnalysisRecord) GetAnalysisReportIndex() (v []*ExptInsightAnalysisIndex) {
+func (p *ExptSamplingStratum) GetWeight() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetAnalysisReportIndex() {
-		return ExptInsightAnalysisRecord_AnalysisReportIndex_DEFAULT
+	if !p.IsSetWeight() {
+		return ExptSamplingStratum_Weight_DEFAULT
 	}
-	return p.AnalysisReportIndex
-}
-func (p *ExptInsightAnalysisRecord) SetRecordID(val int64) {
-	p.RecordID = val
-}
-func (p *ExptInsightAnalysisRecord) SetWorkspaceID(val int64) {
-	p.WorkspaceID = val
-}
-func (p *ExptInsightAnalysisRecord) SetExptID(val int64) {
-	p.ExptID = val
-}
-func (p *ExptInsightAnalysisRecord) SetAnalysisStatus(val InsightAnalysisStatus) {
-	p.AnalysisStatus = val
+	return *p.Weight
 }
-func (p *ExptInsightAnalysisRecord) SetAnalysisReportID(val *int64) {
-	p.AnalysisReportID = val
-}
-func (p *ExptInsightAnalysisRecord) SetAnalysisReportContent(val *string) {
-	p.AnalysisReportContent = val
-}
-func (p *ExptInsightAnalysisRecord) SetExptInsightAnalysisFeedback(val *ExptInsightAnalysisFeedback) {
-	p.ExptInsightAnalysisFeedback = val
+func (p *ExptSamplingStratum) SetValue(val *string) {
+	p.Value = val
 }
-func (p *ExptInsightAnalysisRecord) SetBaseInfo(val *common.BaseInfo) {
-	p.BaseInfo = val
+func (p *ExptSamplingStratum) SetPopulationCnt(val *int64) {
+	p.PopulationCnt = val
 }
-func (p *ExptInsightAnalysisRecord) SetAnalysisReportIndex(val []*ExptInsightAnalysisIndex) {
-	p.AnalysisReportIndex = val
+func (p *ExptSamplingStratum) SetSampleCnt(val *int64) {
+	p.SampleCnt = val
 }
-
-var fieldIDToName_ExptInsightAnalysisRecord = map[int16]string{
-	1:  "record_id",
-	2:  "workspace_id",
-	3:  "expt_id",
-	4:  "analysis_status",
-	5:  "analysis_report_id",
-	6:  "analysis_report_content",
-	7:  "expt_insight_analysis_feedback",
-	8:  "base_info",
-	21: "analysis_report_index",
+func (p *ExptSamplingStratum) SetWeight(val *float64) {
+	p.Weight = val
 }
 
-func (p *ExptInsightAnalysisRecord) IsSetAnalysisReportID() bool {
-	return p.AnalysisReportID != nil
+var fieldIDToName_ExptSamplingStratum = map[int16]string{
+	1: "value",
+	2: "population_cnt",
+	3: "sample_cnt",
+	4: "weight",
 }
 
-func (p *ExptInsightAnalysisRecord) IsSetAnalysisReportContent() bool {
-	return p.AnalysisReportContent != nil
+func (p *ExptSamplingStratum) IsSetValue() bool {
+	return p.Value != nil
 }
 
-func (p *ExptInsightAnalysisRecord) IsSetExptInsightAnalysisFeedback() bool {
-	return p.ExptInsightAnalysisFeedback != nil
+func (p *ExptSamplingStratum) IsSetPopulationCnt() bool {
+	return p.PopulationCnt != nil
 }
 
-func (p *ExptInsightAnalysisRecord) IsSetBaseInfo() bool {
-	return p.BaseInfo != nil
+func (p *ExptSamplingStratum) IsSetSampleCnt() bool {
+	return p.SampleCnt != nil
 }
 
-func (p *ExptInsightAnalysisRecord) IsSetAnalysisReportIndex() bool {
-	return p.AnalysisReportIndex != nil
+func (p *ExptSamplingStratum) IsSetWeight() bool {
+	return p.Weight != nil
 }
 
-func (p *ExptInsightAnalysisRecord) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptSamplingStratum) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetRecordID bool = false
-	var issetWorkspaceID bool = false
-	var issetExptID bool = false
-	var issetAnalysisStatus bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -29132,11 +47039,10 @@ func (p *ExptInsightAnalysisRecord) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetRecordID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -29145,7 +47051,6 @@ func (p *ExptInsightAnalysisRecord) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetWorkspaceID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -29154,56 +47059,14 @@ func (p *ExptInsightAnalysisRecord) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetExptID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetAnalysisStatus = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 21:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField21(iprot); err != nil {
-					goto ReadFieldError
-				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -29220,32 +47083,13 @@ func (p *ExptInsightAnalysisRecord) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetRecordID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetWorkspaceID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetExptID {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetAnalysisStatus {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptInsightAnalysisRecord[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptSamplingStratum[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -29253,55 +47097,20 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptInsightAnalysisRecord[fieldId]))
-}
-
-func (p *ExptInsightAnalysisRecord) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.RecordID = _field
-	return nil
-}
-func (p *ExptInsightAnalysisRecord) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.WorkspaceID = _field
-	return nil
 }
-func (p *ExptInsightAnalysisRecord) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ExptID = _field
-	return nil
-}
-func (p *ExptInsightAnalysisRecord) ReadField4(iprot thrift.TProtocol) error {
+func (p *ExptSamplingStratum) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field InsightAnalysisStatus
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.AnalysisStatus = _field
+	p.Value = _field
 	return nil
 }
-func (p *ExptInsightAnalysisRecord) ReadField5(iprot thrift.TProtocol) error {
+func (p *ExptSamplingStratum) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -29309,208 +47118,78 @@ func (p *ExptInsightAnalysisRecord) ReadField5(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.AnalysisReportID = _field
-	return nil
-}
-func (p *ExptInsightAnalysisRecord) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.AnalysisReportContent = _field
-	return nil
-}
-func (p *ExptInsightAnalysisRecord) ReadField7(iprot thrift.TProtocol) error {
-	_field := NewExptInsightAnalysisFeedback()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.ExptInsightAnalysisFeedback = _field
-	return nil
-}
-func (p *ExptInsightAnalysisRecord) ReadField8(iprot thrift.TProtocol) error {
-	_field := common.NewBaseInfo()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.BaseInfo = _field
-	return nil
-}
-func (p *ExptInsightAnalysisRecord) ReadField21(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*ExptInsightAnalysisIndex, 0, size)
-	values := make([]ExptInsightAnalysisIndex, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.AnalysisReportIndex = _field
-	return nil
-}
-
-func (p *ExptInsightAnalysisRecord) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptInsightAnalysisRecord"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField21(oprot); err != nil {
-			fieldId = 21
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
+	p.PopulationCnt = _field
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
+func (p *ExptSamplingStratum) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *ExptInsightAnalysisRecord) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("record_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.RecordID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *ExptInsightAnalysisRecord) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-func (p *ExptInsightAnalysisRecord) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("expt_id", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ExptID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.SampleCnt = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ExptInsightAnalysisRecord) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("analysis_status", thrift.STRING, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.AnalysisStatus); err != nil {
+func (p *ExptSamplingStratum) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.Weight = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *ExptInsightAnalysisRecord) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAnalysisReportID() {
-		if err = oprot.WriteFieldBegin("analysis_report_id", thrift.I64, 5); err != nil {
-			goto WriteFieldBeginError
+
+func (p *ExptSamplingStratum) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptSamplingStratum"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
 		}
-		if err := oprot.WriteI64(*p.AnalysisReportID); err != nil {
-			return err
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
 		}
 	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *ExptInsightAnalysisRecord) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAnalysisReportContent() {
-		if err = oprot.WriteFieldBegin("analysis_report_content", thrift.STRING, 6); err != nil {
+
+func (p *ExptSamplingStratum) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetValue() {
+		if err = oprot.WriteFieldBegin("value", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.AnalysisReportContent); err != nil {
+		if err := oprot.WriteString(*p.Value); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -29519,16 +47198,16 @@ func (p *ExptInsightAnalysisRecord) writeField6(oprot thrift.TProtocol) (err err
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ExptInsightAnalysisRecord) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExptInsightAnalysisFeedback() {
-		if err = oprot.WriteFieldBegin("expt_insight_analysis_feedback", thrift.STRUCT, 7); err != nil {
+func (p *ExptSamplingStratum) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPopulationCnt() {
+		if err = oprot.WriteFieldBegin("population_cnt", thrift.I64, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.ExptInsightAnalysisFeedback.Write(oprot); err != nil {
+		if err := oprot.WriteI64(*p.PopulationCnt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -29537,16 +47216,16 @@ func (p *ExptInsightAnalysisRecord) writeField7(oprot thrift.TProtocol) (err err
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ExptInsightAnalysisRecord) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBaseInfo() {
-		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 8); err != nil {
+func (p *ExptSamplingStratum) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSampleCnt() {
+		if err = oprot.WriteFieldBegin("sample_cnt", thrift.I64, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.BaseInfo.Write(oprot); err != nil {
+		if err := oprot.WriteI64(*p.SampleCnt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -29555,24 +47234,16 @@ func (p *ExptInsightAnalysisRecord) writeField8(oprot thrift.TProtocol) (err err
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ExptInsightAnalysisRecord) writeField21(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAnalysisReportIndex() {
-		if err = oprot.WriteFieldBegin("analysis_report_index", thrift.LIST, 21); err != nil {
+func (p *ExptSamplingStratum) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWeight() {
+		if err = oprot.WriteFieldBegin("weight", thrift.DOUBLE, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.AnalysisReportIndex)); err != nil {
-			return err
-		}
-		for _, v := range p.AnalysisReportIndex {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteDouble(*p.Weight); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -29581,191 +47252,229 @@ func (p *ExptInsightAnalysisRecord) writeField21(oprot thrift.TProtocol) (err er
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 21 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 21 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *ExptInsightAnalysisRecord) String() string {
+func (p *ExptSamplingStratum) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptInsightAnalysisRecord(%+v)", *p)
+	return fmt.Sprintf("ExptSamplingStratum(%+v)", *p)
 
 }
 
-func (p *ExptInsightAnalysisRecord) DeepEqual(ano *ExptInsightAnalysisRecord) bool {
+func (p *ExptSamplingStratum) DeepEqual(ano *ExptSamplingStratum) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.RecordID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.WorkspaceID) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.ExptID) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.AnalysisStatus) {
-		return false
-	}
-	if !p.Field5DeepEqual(ano.AnalysisReportID) {
-		return false
-	}
-	if !p.Field6DeepEqual(ano.AnalysisReportContent) {
+	if !p.Field1DeepEqual(ano.Value) {
 		return false
 	}
-	if !p.Field7DeepEqual(ano.ExptInsightAnalysisFeedback) {
+	if !p.Field2DeepEqual(ano.PopulationCnt) {
 		return false
 	}
-	if !p.Field8DeepEqual(ano.BaseInfo) {
+	if !p.Field3DeepEqual(ano.SampleCnt) {
 		return false
 	}
-	if !p.Field21DeepEqual(ano.AnalysisReportIndex) {
+	if !p.Field4DeepEqual(ano.Weight) {
 		return false
 	}
 	return true
 }
 
-func (p *ExptInsightAnalysisRecord) Field1DeepEqual(src int64) bool {
+func (p *ExptSamplingStratum) Field1DeepEqual(src *string) bool {
 
-	if p.RecordID != src {
+	if p.Value == src {
+		return true
+	} else if p.Value == nil || src == nil {
 		return false
 	}
-	return true
-}
-func (p *ExptInsightAnalysisRecord) Field2DeepEqual(src int64) bool {
-
-	if p.WorkspaceID != src {
+	if strings.Compare(*p.Value, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExptInsightAnalysisRecord) Field3DeepEqual(src int64) bool {
+func (p *ExptSamplingStratum) Field2DeepEqual(src *int64) bool {
 
-	if p.ExptID != src {
+	if p.PopulationCnt == src {
+		return true
+	} else if p.PopulationCnt == nil || src == nil {
 		return false
 	}
-	return true
-}
-func (p *ExptInsightAnalysisRecord) Field4DeepEqual(src InsightAnalysisStatus) bool {
-
-	if strings.Compare(p.AnalysisStatus, src) != 0 {
+	if *p.PopulationCnt != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptInsightAnalysisRecord) Field5DeepEqual(src *int64) bool {
+func (p *ExptSamplingStratum) Field3DeepEqual(src *int64) bool {
 
-	if p.AnalysisReportID == src {
+	if p.SampleCnt == src {
 		return true
-	} else if p.AnalysisReportID == nil || src == nil {
+	} else if p.SampleCnt == nil || src == nil {
 		return false
 	}
-	if *p.AnalysisReportID != *src {
+	if *p.SampleCnt != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptInsightAnalysisRecord) Field6DeepEqual(src *string) bool {
+func (p *ExptSamplingStratum) Field4DeepEqual(src *float64) bool {
 
-	if p.AnalysisReportContent == src {
+	if p.Weight == src {
 		return true
-	} else if p.AnalysisReportContent == nil || src == nil {
+	} else if p.Weight == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.AnalysisReportContent, *src) != 0 {
+	if *p.Weight != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptInsightAnalysisRecord) Field7DeepEqual(src *ExptInsightAnalysisFeedback) bool {
 
-	if !p.ExptInsightAnalysisFeedback.DeepEqual(src) {
-		return false
-	}
-	return true
+type ExptSamplingResult_ struct {
+	Mode          *ExptSamplingMode      `thrift:"mode,1,optional" frugal:"1,optional,string" form:"mode" json:"mode,omitempty" query:"mode"`
+	Seed          *int64                 `thrift:"seed,2,optional" frugal:"2,optional,i64" json:"seed" form:"seed" query:"seed"`
+	StratifyField *string                `thrift:"stratify_field,3,optional" frugal:"3,optional,string" form:"stratify_field" json:"stratify_field,omitempty" query:"stratify_field"`
+	PopulationCnt *int64                 `thrift:"population_cnt,4,optional" frugal:"4,optional,i64" json:"population_cnt" form:"population_cnt" query:"population_cnt"`
+	SampleCnt     *int64                 `thrift:"sample_cnt,5,optional" frugal:"5,optional,i64" json:"sample_cnt" form:"sample_cnt" query:"sample_cnt"`
+	Strata        []*ExptSamplingStratum `thrift:"strata,6,optional" frugal:"6,optional,list<ExptSamplingStratum>" form:"strata" json:"strata,omitempty" query:"strata"`
 }
-func (p *ExptInsightAnalysisRecord) Field8DeepEqual(src *common.BaseInfo) bool {
 
-	if !p.BaseInfo.DeepEqual(src) {
-		return false
-	}
-	return true
+func NewExptSamplingResult_() *ExptSamplingResult_ {
+	return &ExptSamplingResult_{}
 }
-func (p *ExptInsightAnalysisRecord) Field21DeepEqual(src []*ExptInsightAnalysisIndex) bool {
 
-	if len(p.AnalysisReportIndex) != len(src) {
-		return false
+func (p *ExptSamplingResult_) InitDefault() {
+}
+
+var ExptSamplingResult__Mode_DEFAULT ExptSamplingMode
+
+func (p *ExptSamplingResult_) GetMode() (v ExptSamplingMode) {
+	if p == nil {
+		return
 	}
-	for i, v := range p.AnalysisReportIndex {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if !p.IsSetMode() {
+		return ExptSamplingResult__Mode_DEFAULT
 	}
-	return true
+	return *p.Mode
 }
 
-type ExptInsightAnalysisIndex struct {
-	ID    *string `thrift:"id,1,optional" frugal:"1,optional,string" form:"id" json:"id,omitempty" query:"id"`
-	Title *string `thrift:"title,2,optional" frugal:"2,optional,string" form:"title" json:"title,omitempty" query:"title"`
+var ExptSamplingResult__Seed_DEFAULT int64
+
+func (p *ExptSamplingResult_) GetSeed() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSeed() {
+		return ExptSamplingResult__Seed_DEFAULT
+	}
+	return *p.Seed
 }
 
-func NewExptInsightAnalysisIndex() *ExptInsightAnalysisIndex {
-	return &ExptInsightAnalysisIndex{}
+var ExptSamplingResult__StratifyField_DEFAULT string
+
+func (p *ExptSamplingResult_) GetStratifyField() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStratifyField() {
+		return ExptSamplingResult__StratifyField_DEFAULT
+	}
+	return *p.StratifyField
 }
 
-func (p *ExptInsightAnalysisIndex) InitDefault() {
+var ExptSamplingResult__PopulationCnt_DEFAULT int64
+
+func (p *ExptSamplingResult_) GetPopulationCnt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPopulationCnt() {
+		return ExptSamplingResult__PopulationCnt_DEFAULT
+	}
+	return *p.PopulationCnt
 }
 
-var ExptInsightAnalysisIndex_ID_DEFAULT string
+var ExptSamplingResult__SampleCnt_DEFAULT int64
 
-func (p *ExptInsightAnalysisIndex) GetID() (v string) {
+func (p *ExptSamplingResult_) GetSampleCnt() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetID() {
-		return ExptInsightAnalysisIndex_ID_DEFAULT
+	if !p.IsSetSampleCnt() {
+		return ExptSamplingResult__SampleCnt_DEFAULT
 	}
-	return *p.ID
+	return *p.SampleCnt
 }
 
-var ExptInsightAnalysisIndex_Title_DEFAULT string
+var ExptSamplingResult__Strata_DEFAULT []*ExptSamplingStratum
 
-func (p *ExptInsightAnalysisIndex) GetTitle() (v string) {
+func (p *ExptSamplingResult_) GetStrata() (v []*ExptSamplingStratum) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTitle() {
-		return ExptInsightAnalysisIndex_Title_DEFAULT
+	if !p.IsSetStrata() {
+		return ExptSamplingResult__Strata_DEFAULT
 	}
-	return *p.Title
+	return p.Strata
 }
-func (p *ExptInsightAnalysisIndex) SetID(val *string) {
-	p.ID = val
+func (p *ExptSamplingResult_) SetMode(val *ExptSamplingMode) {
+	p.Mode = val
 }
-func (p *ExptInsightAnalysisIndex) SetTitle(val *string) {
-	p.Title = val
+func (p *ExptSamplingResult_) SetSeed(val *int64) {
+	p.Seed = val
+}
+func (p *ExptSamplingResult_) SetStratifyField(val *string) {
+	p.StratifyField = val
+}
+func (p *ExptSamplingResult_) SetPopulationCnt(val *int64) {
+	p.PopulationCnt = val
+}
+func (p *ExptSamplingResult_) SetSampleCnt(val *int64) {
+	p.SampleCnt = val
+}
+func (p *ExptSamplingResult_) SetStrata(val []*ExptSamplingStratum) {
+	p.Strata = val
 }
 
-var fieldIDToName_ExptInsightAnalysisIndex = map[int16]string{
-	1: "id",
-	2: "title",
+var fieldIDToName_ExptSamplingResult_ = map[int16]string{
+	1: "mode",
+	2: "seed",
+	3: "stratify_field",
+	4: "population_cnt",
+	5: "sample_cnt",
+	6: "strata",
 }
 
-func (p *ExptInsightAnalysisIndex) IsSetID() bool {
-	return p.ID != nil
+func (p *ExptSamplingResult_) IsSetMode() bool {
+	return p.Mode != nil
 }
 
-func (p *ExptInsightAnalysisIndex) IsSetTitle() bool {
-	return p.Title != nil
+func (p *ExptSamplingResult_) IsSetSeed() bool {
+	return p.Seed != nil
 }
 
-func (p *ExptInsightAnalysisIndex) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptSamplingResult_) IsSetStratifyField() bool {
+	return p.StratifyField != nil
+}
+
+func (p *ExptSamplingResult_) IsSetPopulationCnt() bool {
+	return p.PopulationCnt != nil
+}
+
+func (p *ExptSamplingResult_) IsSetSampleCnt() bool {
+	return p.SampleCnt != nil
+}
+
+func (p *ExptSamplingResult_) IsSetStrata() bool {
+	return p.Strata != nil
+}
+
+func (p *ExptSamplingResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -29792,13 +47501,45 @@ func (p *ExptInsightAnalysisIndex) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -29818,7 +47559,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptInsightAnalysisIndex[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptSamplingResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -29828,18 +47569,29 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExptInsightAnalysisIndex) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExptSamplingResult_) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *string
+	var _field *ExptSamplingMode
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.ID = _field
+	p.Mode = _field
 	return nil
 }
-func (p *ExptInsightAnalysisIndex) ReadField2(iprot thrift.TProtocol) error {
+func (p *ExptSamplingResult_) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Seed = _field
+	return nil
+}
+func (p *ExptSamplingResult_) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -29847,13 +47599,58 @@ func (p *ExptInsightAnalysisIndex) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Title = _field
+	p.StratifyField = _field
 	return nil
 }
+func (p *ExptSamplingResult_) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *ExptInsightAnalysisIndex) Write(oprot thrift.TProtocol) (err error) {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PopulationCnt = _field
+	return nil
+}
+func (p *ExptSamplingResult_) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SampleCnt = _field
+	return nil
+}
+func (p *ExptSamplingResult_) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExptSamplingStratum, 0, size)
+	values := make([]ExptSamplingStratum, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Strata = _field
+	return nil
+}
+
+func (p *ExptSamplingResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptInsightAnalysisIndex"); err != nil {
+	if err = oprot.WriteStructBegin("ExptSamplingResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -29865,6 +47662,22 @@ func (p *ExptInsightAnalysisIndex) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -29883,12 +47696,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExptInsightAnalysisIndex) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetID() {
-		if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *ExptSamplingResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMode() {
+		if err = oprot.WriteFieldBegin("mode", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.ID); err != nil {
+		if err := oprot.WriteString(*p.Mode); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -29901,12 +47714,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ExptInsightAnalysisIndex) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTitle() {
-		if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+func (p *ExptSamplingResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSeed() {
+		if err = oprot.WriteFieldBegin("seed", thrift.I64, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Title); err != nil {
+		if err := oprot.WriteI64(*p.Seed); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -29919,134 +47732,383 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *ExptSamplingResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStratifyField() {
+		if err = oprot.WriteFieldBegin("stratify_field", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.StratifyField); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptSamplingResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPopulationCnt() {
+		if err = oprot.WriteFieldBegin("population_cnt", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.PopulationCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptSamplingResult_) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSampleCnt() {
+		if err = oprot.WriteFieldBegin("sample_cnt", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.SampleCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptSamplingResult_) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStrata() {
+		if err = oprot.WriteFieldBegin("strata", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Strata)); err != nil {
+			return err
+		}
+		for _, v := range p.Strata {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
 
-func (p *ExptInsightAnalysisIndex) String() string {
+func (p *ExptSamplingResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptInsightAnalysisIndex(%+v)", *p)
+	return fmt.Sprintf("ExptSamplingResult_(%+v)", *p)
 
 }
 
-func (p *ExptInsightAnalysisIndex) DeepEqual(ano *ExptInsightAnalysisIndex) bool {
+func (p *ExptSamplingResult_) DeepEqual(ano *ExptSamplingResult_) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ID) {
+	if !p.Field1DeepEqual(ano.Mode) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Title) {
+	if !p.Field2DeepEqual(ano.Seed) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.StratifyField) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.PopulationCnt) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.SampleCnt) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Strata) {
 		return false
 	}
 	return true
 }
 
-func (p *ExptInsightAnalysisIndex) Field1DeepEqual(src *string) bool {
+func (p *ExptSamplingResult_) Field1DeepEqual(src *ExptSamplingMode) bool {
 
-	if p.ID == src {
+	if p.Mode == src {
 		return true
-	} else if p.ID == nil || src == nil {
+	} else if p.Mode == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.ID, *src) != 0 {
+	if strings.Compare(*p.Mode, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExptInsightAnalysisIndex) Field2DeepEqual(src *string) bool {
+func (p *ExptSamplingResult_) Field2DeepEqual(src *int64) bool {
 
-	if p.Title == src {
+	if p.Seed == src {
 		return true
-	} else if p.Title == nil || src == nil {
+	} else if p.Seed == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Title, *src) != 0 {
+	if *p.Seed != *src {
 		return false
 	}
 	return true
 }
+func (p *ExptSamplingResult_) Field3DeepEqual(src *string) bool {
 
-// 洞察分析反馈统计
-type ExptInsightAnalysisFeedback struct {
-	UpvoteCnt   *int32 `thrift:"upvote_cnt,1,optional" frugal:"1,optional,i32" form:"upvote_cnt" json:"upvote_cnt,omitempty" query:"upvote_cnt"`
-	DownvoteCnt *int32 `thrift:"downvote_cnt,2,optional" frugal:"2,optional,i32" form:"downvote_cnt" json:"downvote_cnt,omitempty" query:"downvote_cnt"`
-	// 当前用户点赞状态，用于展示用户是否已点赞点踩
-	CurrentUserVoteType *InsightAnalysisReportVoteType `thrift:"current_user_vote_type,3,optional" frugal:"3,optional,string" form:"current_user_vote_type" json:"current_user_vote_type,omitempty" query:"current_user_vote_type"`
+	if p.StratifyField == src {
+		return true
+	} else if p.StratifyField == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.StratifyField, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *ExptSamplingResult_) Field4DeepEqual(src *int64) bool {
 
-func NewExptInsightAnalysisFeedback() *ExptInsightAnalysisFeedback {
-	return &ExptInsightAnalysisFeedback{}
+	if p.PopulationCnt == src {
+		return true
+	} else if p.PopulationCnt == nil || src == nil {
+		return false
+	}
+	if *p.PopulationCnt != *src {
+		return false
+	}
+	return true
 }
+func (p *ExptSamplingResult_) Field5DeepEqual(src *int64) bool {
 
-func (p *ExptInsightAnalysisFeedback) InitDefault() {
+	if p.SampleCnt == src {
+		return true
+	} else if p.SampleCnt == nil || src == nil {
+		return false
+	}
+	if *p.SampleCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptSamplingResult_) Field6DeepEqual(src []*ExptSamplingStratum) bool {
+
+	if len(p.Strata) != len(src) {
+		return false
+	}
+	for i, v := range p.Strata {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+// 单个评估器实例按抽样权重估计的总体均值及置信区间
+type ExptSampledEvaluatorAggr struct {
+	EvaluatorVersionID *int64  `thrift:"evaluator_version_id,1,optional" frugal:"1,optional,i64" json:"evaluator_version_id" form:"evaluator_version_id" query:"evaluator_version_id"`
+	Alias              *string `thrift:"alias,2,optional" frugal:"2,optional,string" form:"alias" json:"alias,omitempty" query:"alias"`
+	// 参与估计的有成功得分的样本 item 数
+	ScoredCnt *int64 `thrift:"scored_cnt,3,optional" frugal:"3,optional,i64" json:"scored_cnt" form:"scored_cnt" query:"scored_cnt"`
+	// 样本算术平均
+	UnweightedMean *float64 `thrift:"unweighted_mean,4,optional" frugal:"4,optional,double" form:"unweighted_mean" json:"unweighted_mean,omitempty" query:"unweighted_mean"`
+	// 按分层权重估计的总体均值
+	Mean *float64 `thrift:"mean,5,optional" frugal:"5,optional,double" form:"mean" json:"mean,omitempty" query:"mean"`
+	// 含有限总体校正
+	StdErr  *float64 `thrift:"std_err,6,optional" frugal:"6,optional,double" form:"std_err" json:"std_err,omitempty" query:"std_err"`
+	CiLower *float64 `thrift:"ci_lower,7,optional" frugal:"7,optional,double" form:"ci_lower" json:"ci_lower,omitempty" query:"ci_lower"`
+	CiUpper *float64 `thrift:"ci_upper,8,optional" frugal:"8,optional,double" form:"ci_upper" json:"ci_upper,omitempty" query:"ci_upper"`
+}
+
+func NewExptSampledEvaluatorAggr() *ExptSampledEvaluatorAggr {
+	return &ExptSampledEvaluatorAggr{}
+}
+
+func (p *ExptSampledEvaluatorAggr) InitDefault() {
+}
+
+var ExptSampledEvaluatorAggr_EvaluatorVersionID_DEFAULT int64
+
+func (p *ExptSampledEvaluatorAggr) GetEvaluatorVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorVersionID() {
+		return ExptSampledEvaluatorAggr_EvaluatorVersionID_DEFAULT
+	}
+	return *p.EvaluatorVersionID
+}
+
+var ExptSampledEvaluatorAggr_Alias_DEFAULT string
+
+func (p *ExptSampledEvaluatorAggr) GetAlias() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAlias() {
+		return ExptSampledEvaluatorAggr_Alias_DEFAULT
+	}
+	return *p.Alias
+}
+
+var ExptSampledEvaluatorAggr_ScoredCnt_DEFAULT int64
+
+func (p *ExptSampledEvaluatorAggr) GetScoredCnt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScoredCnt() {
+		return ExptSampledEvaluatorAggr_ScoredCnt_DEFAULT
+	}
+	return *p.ScoredCnt
+}
+
+var ExptSampledEvaluatorAggr_UnweightedMean_DEFAULT float64
+
+func (p *ExptSampledEvaluatorAggr) GetUnweightedMean() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUnweightedMean() {
+		return ExptSampledEvaluatorAggr_UnweightedMean_DEFAULT
+	}
+	return *p.UnweightedMean
+}
+
+var ExptSampledEvaluatorAggr_Mean_DEFAULT float64
+
+func (p *ExptSampledEvaluatorAggr) GetMean() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMean() {
+		return ExptSampledEvaluatorAggr_Mean_DEFAULT
+	}
+	return *p.Mean
 }
 
-var ExptInsightAnalysisFeedback_UpvoteCnt_DEFAULT int32
+var ExptSampledEvaluatorAggr_StdErr_DEFAULT float64
 
-func (p *ExptInsightAnalysisFeedback) GetUpvoteCnt() (v int32) {
+func (p *ExptSampledEvaluatorAggr) GetStdErr() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetUpvoteCnt() {
-		return ExptInsightAnalysisFeedback_UpvoteCnt_DEFAULT
+	if !p.IsSetStdErr() {
+		return ExptSampledEvaluatorAggr_StdErr_DEFAULT
 	}
-	return *p.UpvoteCnt
+	return *p.StdErr
 }
 
-var ExptInsightAnalysisFeedback_DownvoteCnt_DEFAULT int32
+var ExptSampledEvaluatorAggr_CiLower_DEFAULT float64
 
-func (p *ExptInsightAnalysisFeedback) GetDownvoteCnt() (v int32) {
+func (p *ExptSampledEvaluatorAggr) GetCiLower() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDownvoteCnt() {
-		return ExptInsightAnalysisFeedback_DownvoteCnt_DEFAULT
+	if !p.IsSetCiLower() {
+		return ExptSampledEvaluatorAggr_CiLower_DEFAULT
 	}
-	return *p.DownvoteCnt
+	return *p.CiLower
 }
 
-var ExptInsightAnalysisFeedback_CurrentUserVoteType_DEFAULT InsightAnalysisReportVoteType
+var ExptSampledEvaluatorAggr_CiUpper_DEFAULT float64
 
-func (p *ExptInsightAnalysisFeedback) GetCurrentUserVoteType() (v InsightAnalysisReportVoteType) {
+func (p *ExptSampledEvaluatorAggr) GetCiUpper() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetCurrentUserVoteType() {
-		return ExptInsightAnalysisFeedback_CurrentUserVoteType_DEFAULT
+	if !p.IsSetCiUpper() {
+		return ExptSampledEvaluatorAggr_CiUpper_DEFAULT
 	}
-	return *p.CurrentUserVoteType
+	return *p.CiUpper
 }
-func (p *ExptInsightAnalysisFeedback) SetUpvoteCnt(val *int32) {
-	p.UpvoteCnt = val
+func (p *ExptSampledEvaluatorAggr) SetEvaluatorVersionID(val *int64) {
+	p.EvaluatorVersionID = val
 }
-func (p *ExptInsightAnalysisFeedback) SetDownvoteCnt(val *int32) {
-	p.DownvoteCnt = val
+func (p *ExptSampledEvaluatorAggr) SetAlias(val *string) {
+	p.Alias = val
 }
-func (p *ExptInsightAnalysisFeedback) SetCurrentUserVoteType(val *InsightAnalysisReportVoteType) {
-	p.CurrentUserVoteType = val
+func (p *ExptSampledEvaluatorAggr) SetScoredCnt(val *int64) {
+	p.ScoredCnt = val
+}
+func (p *ExptSampledEvaluatorAggr) SetUnweightedMean(val *float64) {
+	p.UnweightedMean = val
+}
+func (p *ExptSampledEvaluatorAggr) SetMean(val *float64) {
+	p.Mean = val
+}
+func (p *ExptSampledEvaluatorAggr) SetStdErr(val *float64) {
+	p.StdErr = val
+}
+func (p *ExptSampledEvaluatorAggr) SetCiLower(val *float64) {
+	p.CiLower = val
+}
+func (p *ExptSampledEvaluatorAggr) SetCiUpper(val *float64) {
+	p.CiUpper = val
 }
 
-var fieldIDToName_ExptInsightAnalysisFeedback = map[int16]string{
-	1: "upvote_cnt",
-	2: "downvote_cnt",
-	3: "current_user_vote_type",
+var fieldIDToName_ExptSampledEvaluatorAggr = map[int16]string{
+	1: "evaluator_version_id",
+	2: "alias",
+	3: "scored_cnt",
+	4: "unweighted_mean",
+	5: "mean",
+	6: "std_err",
+	7: "ci_lower",
+	8: "ci_upper",
 }
 
-func (p *ExptInsightAnalysisFeedback) IsSetUpvoteCnt() bool {
-	return p.UpvoteCnt != nil
+func (p *ExptSampledEvaluatorAggr) IsSetEvaluatorVersionID() bool {
+	return p.EvaluatorVersionID != nil
 }
 
-func (p *ExptInsightAnalysisFeedback) IsSetDownvoteCnt() bool {
-	return p.DownvoteCnt != nil
+func (p *ExptSampledEvaluatorAggr) IsSetAlias() bool {
+	return p.Alias != nil
 }
 
-func (p *ExptInsightAnalysisFeedback) IsSetCurrentUserVoteType() bool {
-	return p.CurrentUserVoteType != nil
+func (p *ExptSampledEvaluatorAggr) IsSetScoredCnt() bool {
+	return p.ScoredCnt != nil
 }
 
-func (p *ExptInsightAnalysisFeedback) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptSampledEvaluatorAggr) IsSetUnweightedMean() bool {
+	return p.UnweightedMean != nil
+}
+
+func (p *ExptSampledEvaluatorAggr) IsSetMean() bool {
+	return p.Mean != nil
+}
+
+func (p *ExptSampledEvaluatorAggr) IsSetStdErr() bool {
+	return p.StdErr != nil
+}
+
+func (p *ExptSampledEvaluatorAggr) IsSetCiLower() bool {
+	return p.CiLower != nil
+}
+
+func (p *ExptSampledEvaluatorAggr) IsSetCiUpper() bool {
+	return p.CiUpper != nil
+}
+
+func (p *ExptSampledEvaluatorAggr) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -30065,7 +48127,7 @@ func (p *ExptInsightAnalysisFeedback) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -30073,7 +48135,7 @@ func (p *ExptInsightAnalysisFeedback) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -30081,13 +48143,53 @@ func (p *ExptInsightAnalysisFeedback) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -30107,7 +48209,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptInsightAnalysisFeedback[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptSampledEvaluatorAggr[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -30117,43 +48219,98 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExptInsightAnalysisFeedback) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExptSampledEvaluatorAggr) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.UpvoteCnt = _field
+	p.EvaluatorVersionID = _field
 	return nil
 }
-func (p *ExptInsightAnalysisFeedback) ReadField2(iprot thrift.TProtocol) error {
+func (p *ExptSampledEvaluatorAggr) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.DownvoteCnt = _field
+	p.Alias = _field
 	return nil
 }
-func (p *ExptInsightAnalysisFeedback) ReadField3(iprot thrift.TProtocol) error {
+func (p *ExptSampledEvaluatorAggr) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *InsightAnalysisReportVoteType
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.CurrentUserVoteType = _field
+	p.ScoredCnt = _field
 	return nil
 }
+func (p *ExptSampledEvaluatorAggr) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *ExptInsightAnalysisFeedback) Write(oprot thrift.TProtocol) (err error) {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UnweightedMean = _field
+	return nil
+}
+func (p *ExptSampledEvaluatorAggr) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Mean = _field
+	return nil
+}
+func (p *ExptSampledEvaluatorAggr) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StdErr = _field
+	return nil
+}
+func (p *ExptSampledEvaluatorAggr) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CiLower = _field
+	return nil
+}
+func (p *ExptSampledEvaluatorAggr) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CiUpper = _field
+	return nil
+}
+
+func (p *ExptSampledEvaluatorAggr) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptInsightAnalysisFeedback"); err != nil {
+	if err = oprot.WriteStructBegin("ExptSampledEvaluatorAggr"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -30169,6 +48326,26 @@ func (p *ExptInsightAnalysisFeedback) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -30187,12 +48364,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExptInsightAnalysisFeedback) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUpvoteCnt() {
-		if err = oprot.WriteFieldBegin("upvote_cnt", thrift.I32, 1); err != nil {
+func (p *ExptSampledEvaluatorAggr) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersionID() {
+		if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(*p.UpvoteCnt); err != nil {
+		if err := oprot.WriteI64(*p.EvaluatorVersionID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -30205,12 +48382,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ExptInsightAnalysisFeedback) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDownvoteCnt() {
-		if err = oprot.WriteFieldBegin("downvote_cnt", thrift.I32, 2); err != nil {
+func (p *ExptSampledEvaluatorAggr) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAlias() {
+		if err = oprot.WriteFieldBegin("alias", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(*p.DownvoteCnt); err != nil {
+		if err := oprot.WriteString(*p.Alias); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -30223,12 +48400,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ExptInsightAnalysisFeedback) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCurrentUserVoteType() {
-		if err = oprot.WriteFieldBegin("current_user_vote_type", thrift.STRING, 3); err != nil {
+func (p *ExptSampledEvaluatorAggr) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScoredCnt() {
+		if err = oprot.WriteFieldBegin("scored_cnt", thrift.I64, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.CurrentUserVoteType); err != nil {
+		if err := oprot.WriteI64(*p.ScoredCnt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -30241,173 +48418,335 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *ExptSampledEvaluatorAggr) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUnweightedMean() {
+		if err = oprot.WriteFieldBegin("unweighted_mean", thrift.DOUBLE, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.UnweightedMean); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptSampledEvaluatorAggr) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMean() {
+		if err = oprot.WriteFieldBegin("mean", thrift.DOUBLE, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Mean); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptSampledEvaluatorAggr) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStdErr() {
+		if err = oprot.WriteFieldBegin("std_err", thrift.DOUBLE, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.StdErr); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ExptSampledEvaluatorAggr) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCiLower() {
+		if err = oprot.WriteFieldBegin("ci_lower", thrift.DOUBLE, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.CiLower); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ExptSampledEvaluatorAggr) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCiUpper() {
+		if err = oprot.WriteFieldBegin("ci_upper", thrift.DOUBLE, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.CiUpper); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
 
-func (p *ExptInsightAnalysisFeedback) String() string {
+func (p *ExptSampledEvaluatorAggr) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptInsightAnalysisFeedback(%+v)", *p)
+	return fmt.Sprintf("ExptSampledEvaluatorAggr(%+v)", *p)
 
 }
 
-func (p *ExptInsightAnalysisFeedback) DeepEqual(ano *ExptInsightAnalysisFeedback) bool {
+func (p *ExptSampledEvaluatorAggr) DeepEqual(ano *ExptSampledEvaluatorAggr) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.UpvoteCnt) {
+	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.DownvoteCnt) {
+	if !p.Field2DeepEqual(ano.Alias) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.CurrentUserVoteType) {
+	if !p.Field3DeepEqual(ano.ScoredCnt) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.UnweightedMean) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Mean) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.StdErr) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.CiLower) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.CiUpper) {
 		return false
 	}
 	return true
 }
 
-func (p *ExptInsightAnalysisFeedback) Field1DeepEqual(src *int32) bool {
+func (p *ExptSampledEvaluatorAggr) Field1DeepEqual(src *int64) bool {
 
-	if p.UpvoteCnt == src {
+	if p.EvaluatorVersionID == src {
 		return true
-	} else if p.UpvoteCnt == nil || src == nil {
+	} else if p.EvaluatorVersionID == nil || src == nil {
 		return false
 	}
-	if *p.UpvoteCnt != *src {
+	if *p.EvaluatorVersionID != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptInsightAnalysisFeedback) Field2DeepEqual(src *int32) bool {
+func (p *ExptSampledEvaluatorAggr) Field2DeepEqual(src *string) bool {
 
-	if p.DownvoteCnt == src {
+	if p.Alias == src {
 		return true
-	} else if p.DownvoteCnt == nil || src == nil {
+	} else if p.Alias == nil || src == nil {
 		return false
 	}
-	if *p.DownvoteCnt != *src {
+	if strings.Compare(*p.Alias, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExptInsightAnalysisFeedback) Field3DeepEqual(src *InsightAnalysisReportVoteType) bool {
+func (p *ExptSampledEvaluatorAggr) Field3DeepEqual(src *int64) bool {
 
-	if p.CurrentUserVoteType == src {
+	if p.ScoredCnt == src {
 		return true
-	} else if p.CurrentUserVoteType == nil || src == nil {
+	} else if p.ScoredCnt == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.CurrentUserVoteType, *src) != 0 {
+	if *p.ScoredCnt != *src {
 		return false
 	}
 	return true
 }
+func (p *ExptSampledEvaluatorAggr) Field4DeepEqual(src *float64) bool {
 
-// 洞察分析反馈评论
-type ExptInsightAnalysisFeedbackComment struct {
-	CommentID   int64            `thrift:"comment_id,1,required" frugal:"1,required,i64" json:"comment_id" form:"comment_id,required" query:"comment_id,required"`
-	WorkspaceID int64            `thrift:"workspace_id,2,required" frugal:"2,required,i64" json:"workspace_id" form:"workspace_id,required" query:"workspace_id,required"`
-	ExptID      int64            `thrift:"expt_id,3,required" frugal:"3,required,i64" json:"expt_id" form:"expt_id,required" query:"expt_id,required"`
-	RecordID    int64            `thrift:"record_id,4,required" frugal:"4,required,i64" json:"record_id" form:"record_id,required" query:"record_id,required"`
-	Content     string           `thrift:"content,5,required" frugal:"5,required,string" form:"content,required" json:"content,required" query:"content,required"`
-	BaseInfo    *common.BaseInfo `thrift:"base_info,6,optional" frugal:"6,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+	if p.UnweightedMean == src {
+		return true
+	} else if p.UnweightedMean == nil || src == nil {
+		return false
+	}
+	if *p.UnweightedMean != *src {
+		return false
+	}
+	return true
 }
+func (p *ExptSampledEvaluatorAggr) Field5DeepEqual(src *float64) bool {
 
-func NewExptInsightAnalysisFeedbackComment() *ExptInsightAnalysisFeedbackComment {
-	return &ExptInsightAnalysisFeedbackComment{}
+	if p.Mean == src {
+		return true
+	} else if p.Mean == nil || src == nil {
+		return false
+	}
+	if *p.Mean != *src {
+		return false
+	}
+	return true
 }
+func (p *ExptSampledEvaluatorAggr) Field6DeepEqual(src *float64) bool {
 
-func (p *ExptInsightAnalysisFeedbackComment) InitDefault() {
+	if p.StdErr == src {
+		return true
+	} else if p.StdErr == nil || src == nil {
+		return false
+	}
+	if *p.StdErr != *src {
+		return false
+	}
+	return true
 }
+func (p *ExptSampledEvaluatorAggr) Field7DeepEqual(src *float64) bool {
 
-func (p *ExptInsightAnalysisFeedbackComment) GetCommentID() (v int64) {
-	if p != nil {
-		return p.CommentID
+	if p.CiLower == src {
+		return true
+	} else if p.CiLower == nil || src == nil {
+		return false
 	}
-	return
+	if *p.CiLower != *src {
+		return false
+	}
+	return true
 }
+func (p *ExptSampledEvaluatorAggr) Field8DeepEqual(src *float64) bool {
 
-func (p *ExptInsightAnalysisFeedbackComment) GetWorkspaceID() (v int64) {
-	if p != nil {
-		return p.WorkspaceID
+	if p.CiUpper == src {
+		return true
+	} else if p.CiUpper == nil || src == nil {
+		return false
 	}
-	return
+	if *p.CiUpper != *src {
+		return false
+	}
+	return true
 }
 
-func (p *ExptInsightAnalysisFeedbackComment) GetExptID() (v int64) {
-	if p != nil {
-		return p.ExptID
+type ExptSampledAggrResult_ struct {
+	ExptID           *int64                      `thrift:"expt_id,1,optional" frugal:"1,optional,i64" json:"expt_id" form:"expt_id" query:"expt_id"`
+	Sampling         *ExptSamplingResult_        `thrift:"sampling,2,optional" frugal:"2,optional,ExptSamplingResult_" form:"sampling" json:"sampling,omitempty" query:"sampling"`
+	Confidence       *float64                    `thrift:"confidence,3,optional" frugal:"3,optional,double" form:"confidence" json:"confidence,omitempty" query:"confidence"`
+	EvaluatorResults []*ExptSampledEvaluatorAggr `thrift:"evaluator_results,4,optional" frugal:"4,optional,list<ExptSampledEvaluatorAggr>" form:"evaluator_results" json:"evaluator_results,omitempty" query:"evaluator_results"`
+}
+
+func NewExptSampledAggrResult_() *ExptSampledAggrResult_ {
+	return &ExptSampledAggrResult_{}
+}
+
+func (p *ExptSampledAggrResult_) InitDefault() {
+}
+
+var ExptSampledAggrResult__ExptID_DEFAULT int64
+
+func (p *ExptSampledAggrResult_) GetExptID() (v int64) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetExptID() {
+		return ExptSampledAggrResult__ExptID_DEFAULT
+	}
+	return *p.ExptID
 }
 
-func (p *ExptInsightAnalysisFeedbackComment) GetRecordID() (v int64) {
-	if p != nil {
-		return p.RecordID
+var ExptSampledAggrResult__Sampling_DEFAULT *ExptSamplingResult_
+
+func (p *ExptSampledAggrResult_) GetSampling() (v *ExptSamplingResult_) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetSampling() {
+		return ExptSampledAggrResult__Sampling_DEFAULT
+	}
+	return p.Sampling
 }
 
-func (p *ExptInsightAnalysisFeedbackComment) GetContent() (v string) {
-	if p != nil {
-		return p.Content
+var ExptSampledAggrResult__Confidence_DEFAULT float64
+
+func (p *ExptSampledAggrResult_) GetConfidence() (v float64) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetConfidence() {
+		return ExptSampledAggrResult__Confidence_DEFAULT
+	}
+	return *p.Confidence
 }
 
-var ExptInsightAnalysisFeedbackComment_BaseInfo_DEFAULT *common.BaseInfo
+var ExptSampledAggrResult__EvaluatorResults_DEFAULT []*ExptSampledEvaluatorAggr
 
-func (p *ExptInsightAnalysisFeedbackComment) GetBaseInfo() (v *common.BaseInfo) {
+func (p *ExptSampledAggrResult_) GetEvaluatorResults() (v []*ExptSampledEvaluatorAggr) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetBaseInfo() {
-		return ExptInsightAnalysisFeedbackComment_BaseInfo_DEFAULT
+	if !p.IsSetEvaluatorResults() {
+		return ExptSampledAggrResult__EvaluatorResults_DEFAULT
 	}
-	return p.BaseInfo
+	return p.EvaluatorResults
 }
-func (p *ExptInsightAnalysisFeedbackComment) SetCommentID(val int64) {
-	p.CommentID = val
+func (p *ExptSampledAggrResult_) SetExptID(val *int64) {
+	p.ExptID = val
 }
-func (p *ExptInsightAnalysisFeedbackComment) SetWorkspaceID(val int64) {
-	p.WorkspaceID = val
+func (p *ExptSampledAggrResult_) SetSampling(val *ExptSamplingResult_) {
+	p.Sampling = val
 }
-func (p *ExptInsightAnalysisFeedbackComment) SetExptID(val int64) {
-	p.ExptID = val
+func (p *ExptSampledAggrResult_) SetConfidence(val *float64) {
+	p.Confidence = val
 }
-func (p *ExptInsightAnalysisFeedbackComment) SetRecordID(val int64) {
-	p.RecordID = val
+func (p *ExptSampledAggrResult_) SetEvaluatorResults(val []*ExptSampledEvaluatorAggr) {
+	p.EvaluatorResults = val
 }
-func (p *ExptInsightAnalysisFeedbackComment) SetContent(val string) {
-	p.Content = val
+
+var fieldIDToName_ExptSampledAggrResult_ = map[int16]string{
+	1: "expt_id",
+	2: "sampling",
+	3: "confidence",
+	4: "evaluator_results",
 }
-func (p *ExptInsightAnalysisFeedbackComment) SetBaseInfo(val *common.BaseInfo) {
-	p.BaseInfo = val
+
+func (p *ExptSampledAggrResult_) IsSetExptID() bool {
+	return p.ExptID != nil
 }
 
-var fieldIDToName_ExptInsightAnalysisFeedbackComment = map[int16]string{
-	1: "comment_id",
-	2: "workspace_id",
-	3: "expt_id",
-	4: "record_id",
-	5: "content",
-	6: "base_info",
+func (p *ExptSampledAggrResult_) IsSetSampling() bool {
+	return p.Sampling != nil
 }
 
-func (p *ExptInsightAnalysisFeedbackComment) IsSetBaseInfo() bool {
-	return p.BaseInfo != nil
+func (p *ExptSampledAggrResult_) IsSetConfidence() bool {
+	return p.Confidence != nil
 }
 
-func (p *ExptInsightAnalysisFeedbackComment) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptSampledAggrResult_) IsSetEvaluatorResults() bool {
+	return p.EvaluatorResults != nil
+}
+
+func (p *ExptSampledAggrResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetCommentID bool = false
-	var issetWorkspaceID bool = false
-	var issetExptID bool = false
-	var issetRecordID bool = false
-	var issetContent bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -30428,51 +48767,30 @@ func (p *ExptInsightAnalysisFeedbackComment) Read(iprot thrift.TProtocol) (err e
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCommentID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetWorkspaceID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetExptID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetRecordID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetContent = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -30489,37 +48807,13 @@ func (p *ExptInsightAnalysisFeedbackComment) Read(iprot thrift.TProtocol) (err e
 		goto ReadStructEndError
 	}
 
-	if !issetCommentID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetWorkspaceID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetExptID {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetRecordID {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetContent {
-		fieldId = 5
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptInsightAnalysisFeedbackComment[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptSampledAggrResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -30527,77 +48821,65 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptInsightAnalysisFeedbackComment[fieldId]))
-}
-
-func (p *ExptInsightAnalysisFeedbackComment) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.CommentID = _field
-	return nil
 }
-func (p *ExptInsightAnalysisFeedbackComment) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.WorkspaceID = _field
-	return nil
-}
-func (p *ExptInsightAnalysisFeedbackComment) ReadField3(iprot thrift.TProtocol) error {
+func (p *ExptSampledAggrResult_) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
+	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
 	p.ExptID = _field
-	return nil
-}
-func (p *ExptInsightAnalysisFeedbackComment) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	return nil
+}
+func (p *ExptSampledAggrResult_) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewExptSamplingResult_()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.RecordID = _field
+	p.Sampling = _field
 	return nil
 }
-func (p *ExptInsightAnalysisFeedbackComment) ReadField5(iprot thrift.TProtocol) error {
+func (p *ExptSampledAggrResult_) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.Content = _field
+	p.Confidence = _field
 	return nil
 }
-func (p *ExptInsightAnalysisFeedbackComment) ReadField6(iprot thrift.TProtocol) error {
-	_field := common.NewBaseInfo()
-	if err := _field.Read(iprot); err != nil {
+func (p *ExptSampledAggrResult_) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
 	}
-	p.BaseInfo = _field
+	_field := make([]*ExptSampledEvaluatorAggr, 0, size)
+	values := make([]ExptSampledEvaluatorAggr, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.EvaluatorResults = _field
 	return nil
 }
 
-func (p *ExptInsightAnalysisFeedbackComment) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExptSampledAggrResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptInsightAnalysisFeedbackComment"); err != nil {
+	if err = oprot.WriteStructBegin("ExptSampledAggrResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -30617,14 +48899,6 @@ func (p *ExptInsightAnalysisFeedbackComment) Write(oprot thrift.TProtocol) (err
 			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -30643,15 +48917,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExptInsightAnalysisFeedbackComment) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("comment_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.CommentID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ExptSampledAggrResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExptID() {
+		if err = oprot.WriteFieldBegin("expt_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ExptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -30659,15 +48935,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ExptInsightAnalysisFeedbackComment) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ExptSampledAggrResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSampling() {
+		if err = oprot.WriteFieldBegin("sampling", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Sampling.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -30675,15 +48953,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ExptInsightAnalysisFeedbackComment) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("expt_id", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ExptID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ExptSampledAggrResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConfidence() {
+		if err = oprot.WriteFieldBegin("confidence", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Confidence); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -30691,44 +48971,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ExptInsightAnalysisFeedbackComment) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("record_id", thrift.I64, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.RecordID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *ExptInsightAnalysisFeedbackComment) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("content", thrift.STRING, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Content); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-func (p *ExptInsightAnalysisFeedbackComment) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBaseInfo() {
-		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 6); err != nil {
+func (p *ExptSampledAggrResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorResults() {
+		if err = oprot.WriteFieldBegin("evaluator_results", thrift.LIST, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.BaseInfo.Write(oprot); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.EvaluatorResults)); err != nil {
+			return err
+		}
+		for _, v := range p.EvaluatorResults {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -30737,145 +48993,147 @@ func (p *ExptInsightAnalysisFeedbackComment) writeField6(oprot thrift.TProtocol)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *ExptInsightAnalysisFeedbackComment) String() string {
+func (p *ExptSampledAggrResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptInsightAnalysisFeedbackComment(%+v)", *p)
+	return fmt.Sprintf("ExptSampledAggrResult_(%+v)", *p)
 
 }
 
-func (p *ExptInsightAnalysisFeedbackComment) DeepEqual(ano *ExptInsightAnalysisFeedbackComment) bool {
+func (p *ExptSampledAggrResult_) DeepEqual(ano *ExptSampledAggrResult_) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.CommentID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.WorkspaceID) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.ExptID) {
+	if !p.Field1DeepEqual(ano.ExptID) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.RecordID) {
+	if !p.Field2DeepEqual(ano.Sampling) {
 		return false
 	}
-	if !p.Field5DeepEqual(ano.Content) {
+	if !p.Field3DeepEqual(ano.Confidence) {
 		return false
 	}
-	if !p.Field6DeepEqual(ano.BaseInfo) {
+	if !p.Field4DeepEqual(ano.EvaluatorResults) {
 		return false
 	}
 	return true
 }
 
-func (p *ExptInsightAnalysisFeedbackComment) Field1DeepEqual(src int64) bool {
+func (p *ExptSampledAggrResult_) Field1DeepEqual(src *int64) bool {
 
-	if p.CommentID != src {
+	if p.ExptID == src {
+		return true
+	} else if p.ExptID == nil || src == nil {
 		return false
 	}
-	return true
-}
-func (p *ExptInsightAnalysisFeedbackComment) Field2DeepEqual(src int64) bool {
-
-	if p.WorkspaceID != src {
+	if *p.ExptID != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptInsightAnalysisFeedbackComment) Field3DeepEqual(src int64) bool {
+func (p *ExptSampledAggrResult_) Field2DeepEqual(src *ExptSamplingResult_) bool {
 
-	if p.ExptID != src {
+	if !p.Sampling.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *ExptInsightAnalysisFeedbackComment) Field4DeepEqual(src int64) bool {
+func (p *ExptSampledAggrResult_) Field3DeepEqual(src *float64) bool {
 
-	if p.RecordID != src {
+	if p.Confidence == src {
+		return true
+	} else if p.Confidence == nil || src == nil {
 		return false
 	}
-	return true
-}
-func (p *ExptInsightAnalysisFeedbackComment) Field5DeepEqual(src string) bool {
-
-	if strings.Compare(p.Content, src) != 0 {
+	if *p.Confidence != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptInsightAnalysisFeedbackComment) Field6DeepEqual(src *common.BaseInfo) bool {
+func (p *ExptSampledAggrResult_) Field4DeepEqual(src []*ExptSampledEvaluatorAggr) bool {
 
-	if !p.BaseInfo.DeepEqual(src) {
+	if len(p.EvaluatorResults) != len(src) {
 		return false
 	}
+	for i, v := range p.EvaluatorResults {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
 	return true
 }
 
-type ExptInsightAnalysisFeedbackVote struct {
-	ID                 *int64              `thrift:"id,1,optional" frugal:"1,optional,i64" json:"comment_id" form:"id" query:"id"`
-	FeedbackActionType *FeedbackActionType `thrift:"feedback_action_type,2,optional" frugal:"2,optional,string" form:"feedback_action_type" json:"feedback_action_type,omitempty" query:"feedback_action_type"`
+// ===============================
+// 重复试验
+// ===============================
+// 实验重复试验配置, 开启后每个 turn 执行 trial_num 次评测对象调用与评估
+type ExptTrialConf struct {
+	// 每个 turn 的试验次数 (含第 0 次), 大于 1 时生效, 上限 10
+	TrialNum *int32 `thrift:"trial_num,1,optional" frugal:"1,optional,i32" form:"trial_num" json:"trial_num,omitempty" query:"trial_num"`
+	// 单次试验判定通过的得分阈值, 默认 1.0
+	PassThreshold *float64 `thrift:"pass_threshold,2,optional" frugal:"2,optional,double" form:"pass_threshold" json:"pass_threshold,omitempty" query:"pass_threshold"`
 }
 
-func NewExptInsightAnalysisFeedbackVote() *ExptInsightAnalysisFeedbackVote {
-	return &ExptInsightAnalysisFeedbackVote{}
+func NewExptTrialConf() *ExptTrialConf {
+	return &ExptTrialConf{}
 }
 
-func (p *ExptInsightAnalysisFeedbackVote) InitDefault() {
+func (p *ExptTrialConf) InitDefault() {
 }
 
-var ExptInsightAnalysisFeedbackVote_ID_DEFAULT int64
+var ExptTrialConf_TrialNum_DEFAULT int32
 
-func (p *ExptInsightAnalysisFeedbackVote) GetID() (v int64) {
+func (p *ExptTrialConf) GetTrialNum() (v int32) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetID() {
-		return ExptInsightAnalysisFeedbackVote_ID_DEFAULT
+	if !p.IsSetTrialNum() {
+		return ExptTrialConf_TrialNum_DEFAULT
 	}
-	return *p.ID
+	return *p.TrialNum
 }
 
-var ExptInsightAnalysisFeedbackVote_FeedbackActionType_DEFAULT FeedbackActionType
+var ExptTrialConf_PassThreshold_DEFAULT float64
 
-func (p *ExptInsightAnalysisFeedbackVote) GetFeedbackActionType() (v FeedbackActionType) {
+func (p *ExptTrialConf) GetPassThreshold() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFeedbackActionType() {
-		return ExptInsightAnalysisFeedbackVote_FeedbackActionType_DEFAULT
+	if !p.IsSetPassThreshold() {
+		return ExptTrialConf_PassThreshold_DEFAULT
 	}
-	return *p.FeedbackActionType
+	return *p.PassThreshold
 }
-func (p *ExptInsightAnalysisFeedbackVote) SetID(val *int64) {
-	p.ID = val
+func (p *ExptTrialConf) SetTrialNum(val *int32) {
+	p.TrialNum = val
 }
-func (p *ExptInsightAnalysisFeedbackVote) SetFeedbackActionType(val *FeedbackActionType) {
-	p.FeedbackActionType = val
+func (p *ExptTrialConf) SetPassThreshold(val *float64) {
+	p.PassThreshold = val
 }
 
-var fieldIDToName_ExptInsightAnalysisFeedbackVote = map[int16]string{
-	1: "id",
-	2: "feedback_action_type",
+var fieldIDToName_ExptTrialConf = map[int16]string{
+	1: "trial_num",
+	2: "pass_threshold",
 }
 
-func (p *ExptInsightAnalysisFeedbackVote) IsSetID() bool {
-	return p.ID != nil
+func (p *ExptTrialConf) IsSetTrialNum() bool {
+	return p.TrialNum != nil
 }
 
-func (p *ExptInsightAnalysisFeedbackVote) IsSetFeedbackActionType() bool {
-	return p.FeedbackActionType != nil
+func (p *ExptTrialConf) IsSetPassThreshold() bool {
+	return p.PassThreshold != nil
 }
 
-func (p *ExptInsightAnalysisFeedbackVote) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptTrialConf) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -30894,7 +49152,7 @@ func (p *ExptInsightAnalysisFeedbackVote) Read(iprot thrift.TProtocol) (err erro
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -30902,7 +49160,7 @@ func (p *ExptInsightAnalysisFeedbackVote) Read(iprot thrift.TProtocol) (err erro
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -30928,7 +49186,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptInsightAnalysisFeedbackVote[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptTrialConf[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -30938,32 +49196,32 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExptInsightAnalysisFeedbackVote) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExptTrialConf) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.ID = _field
+	p.TrialNum = _field
 	return nil
 }
-func (p *ExptInsightAnalysisFeedbackVote) ReadField2(iprot thrift.TProtocol) error {
+func (p *ExptTrialConf) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *FeedbackActionType
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.FeedbackActionType = _field
+	p.PassThreshold = _field
 	return nil
 }
 
-func (p *ExptInsightAnalysisFeedbackVote) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExptTrialConf) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptInsightAnalysisFeedbackVote"); err != nil {
+	if err = oprot.WriteStructBegin("ExptTrialConf"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -30993,12 +49251,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExptInsightAnalysisFeedbackVote) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetID() {
-		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *ExptTrialConf) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTrialNum() {
+		if err = oprot.WriteFieldBegin("trial_num", thrift.I32, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.ID); err != nil {
+		if err := oprot.WriteI32(*p.TrialNum); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -31011,12 +49269,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ExptInsightAnalysisFeedbackVote) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFeedbackActionType() {
-		if err = oprot.WriteFieldBegin("feedback_action_type", thrift.STRING, 2); err != nil {
+func (p *ExptTrialConf) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPassThreshold() {
+		if err = oprot.WriteFieldBegin("pass_threshold", thrift.DOUBLE, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.FeedbackActionType); err != nil {
+		if err := oprot.WriteDouble(*p.PassThreshold); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -31030,222 +49288,263 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ExptInsightAnalysisFeedbackVote) String() string {
+func (p *ExptTrialConf) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptInsightAnalysisFeedbackVote(%+v)", *p)
+	return fmt.Sprintf("ExptTrialConf(%+v)", *p)
 
 }
 
-func (p *ExptInsightAnalysisFeedbackVote) DeepEqual(ano *ExptInsightAnalysisFeedbackVote) bool {
+func (p *ExptTrialConf) DeepEqual(ano *ExptTrialConf) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ID) {
+	if !p.Field1DeepEqual(ano.TrialNum) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.FeedbackActionType) {
+	if !p.Field2DeepEqual(ano.PassThreshold) {
 		return false
 	}
 	return true
 }
 
-func (p *ExptInsightAnalysisFeedbackVote) Field1DeepEqual(src *int64) bool {
+func (p *ExptTrialConf) Field1DeepEqual(src *int32) bool {
 
-	if p.ID == src {
+	if p.TrialNum == src {
 		return true
-	} else if p.ID == nil || src == nil {
+	} else if p.TrialNum == nil || src == nil {
 		return false
 	}
-	if *p.ID != *src {
+	if *p.TrialNum != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptInsightAnalysisFeedbackVote) Field2DeepEqual(src *FeedbackActionType) bool {
+func (p *ExptTrialConf) Field2DeepEqual(src *float64) bool {
 
-	if p.FeedbackActionType == src {
+	if p.PassThreshold == src {
 		return true
-	} else if p.FeedbackActionType == nil || src == nil {
+	} else if p.PassThreshold == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.FeedbackActionType, *src) != 0 {
+	if *p.PassThreshold != *src {
 		return false
 	}
 	return true
 }
 
-// 说明: item 圈选 / evaluator 行级过滤复用 data/domain/data_filter.thrift 的 Filter/FilterField
-// (别名 data_filter, 与 observability filter 区分以便 BAM/thriftgo 无歧义解析)
-// 用法: 全集 = 不传; 点选 = item_id in [...]; 条件圈选 = tag 条件
-// 校验白名单(应用层): query_type ∈ {eq,not_eq,in,not_in}; 单层不嵌套(sub_filter 必空); field_name ∈ {item_id, tag key}; field_type ∈ {long, tag}
-// per-set target 运行配置; 本期 len<=1, alias 恒空 (多 target 实例预留口子)
-type ExptTargetConf struct {
-	TargetID        *int64                      `thrift:"target_id,1,optional" frugal:"1,optional,i64" json:"target_id" form:"target_id" query:"target_id"`
-	TargetVersionID *int64                      `thrift:"target_version_id,2,optional" frugal:"2,optional,i64" json:"target_version_id" form:"target_version_id" query:"target_version_id"`
-	TargetType      *eval_target.EvalTargetType `thrift:"target_type,3,optional" frugal:"3,optional,EvalTargetType" form:"target_type" json:"target_type,omitempty" query:"target_type"`
-	// 本评测集字段 → target 输入
-	FieldMapping *TargetFieldMapping  `thrift:"field_mapping,10,optional" frugal:"10,optional,TargetFieldMapping" form:"field_mapping" json:"field_mapping,omitempty" query:"field_mapping"`
-	RuntimeParam *common.RuntimeParam `thrift:"runtime_param,20,optional" frugal:"20,optional,common.RuntimeParam" form:"runtime_param" json:"runtime_param,omitempty" query:"runtime_param"`
-	// 多实例标识, 本期恒空串
-	Alias *string           `thrift:"alias,30,optional" frugal:"30,optional,string" form:"alias" json:"alias,omitempty" query:"alias"`
-	Ext   map[string]string `thrift:"ext,100,optional" frugal:"100,optional,map<string:string>" form:"ext" json:"ext,omitempty" query:"ext"`
+// 单个评估器实例在重复试验实验中的聚合指标, unit 为 (item, turn)
+type EvaluatorTrialAggregate struct {
+	TrialNum      *int32   `thrift:"trial_num,1,optional" frugal:"1,optional,i32" form:"trial_num" json:"trial_num,omitempty" query:"trial_num"`
+	PassThreshold *float64 `thrift:"pass_threshold,2,optional" frugal:"2,optional,double" form:"pass_threshold" json:"pass_threshold,omitempty" query:"pass_threshold"`
+	// 所有试验均有得分的 unit 数
+	UnitCnt   *int64   `thrift:"unit_cnt,3,optional" frugal:"3,optional,i64" json:"unit_cnt" form:"unit_cnt" query:"unit_cnt"`
+	MeanScore *float64 `thrift:"mean_score,4,optional" frugal:"4,optional,double" form:"mean_score" json:"mean_score,omitempty" query:"mean_score"`
+	// unit 内试验得分标准差的均值
+	MeanStd *float64 `thrift:"mean_std,5,optional" frugal:"5,optional,double" form:"mean_std" json:"mean_std,omitempty" query:"mean_std"`
+	// 所有试验通过判定一致的 unit 占比
+	ConsistencyRate *float64 `thrift:"consistency_rate,6,optional" frugal:"6,optional,double" form:"consistency_rate" json:"consistency_rate,omitempty" query:"consistency_rate"`
+	PassAt1         *float64 `thrift:"pass_at_1,7,optional" frugal:"7,optional,double" form:"pass_at_1" json:"pass_at_1,omitempty" query:"pass_at_1"`
+	// 至少一次通过的概率估计
+	PassAtK *float64 `thrift:"pass_at_k,8,optional" frugal:"8,optional,double" form:"pass_at_k" json:"pass_at_k,omitempty" query:"pass_at_k"`
+	// k 次全部通过的概率估计
+	PassPowK *float64 `thrift:"pass_pow_k,9,optional" frugal:"9,optional,double" form:"pass_pow_k" json:"pass_pow_k,omitempty" query:"pass_pow_k"`
 }
 
-func NewExptTargetConf() *ExptTargetConf {
-	return &ExptTargetConf{}
+func NewEvaluatorTrialAggregate() *EvaluatorTrialAggregate {
+	return &EvaluatorTrialAggregate{}
 }
 
-func (p *ExptTargetConf) InitDefault() {
+func (p *EvaluatorTrialAggregate) InitDefault() {
 }
 
-var ExptTargetConf_TargetID_DEFAULT int64
+var EvaluatorTrialAggregate_TrialNum_DEFAULT int32
 
-func (p *ExptTargetConf) GetTargetID() (v int64) {
+func (p *EvaluatorTrialAggregate) GetTrialNum() (v int32) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTargetID() {
-		return ExptTargetConf_TargetID_DEFAULT
+	if !p.IsSetTrialNum() {
+		return EvaluatorTrialAggregate_TrialNum_DEFAULT
 	}
-	return *p.TargetID
+	return *p.TrialNum
 }
 
-var ExptTargetConf_TargetVersionID_DEFAULT int64
+var EvaluatorTrialAggregate_PassThreshold_DEFAULT float64
 
-func (p *ExptTargetConf) GetTargetVersionID() (v int64) {
+func (p *EvaluatorTrialAggregate) GetPassThreshold() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTargetVersionID() {
-		return ExptTargetConf_TargetVersionID_DEFAULT
+	if !p.IsSetPassThreshold() {
+		return EvaluatorTrialAggregate_PassThreshold_DEFAULT
 	}
-	return *p.TargetVersionID
+	return *p.PassThreshold
 }
 
-var ExptTargetConf_TargetType_DEFAULT eval_target.EvalTargetType
+var EvaluatorTrialAggregate_UnitCnt_DEFAULT int64
 
-func (p *ExptTargetConf) GetTargetType() (v eval_target.EvalTargetType) {
+func (p *EvaluatorTrialAggregate) GetUnitCnt() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTargetType() {
-		return ExptTargetConf_TargetType_DEFAULT
+	if !p.IsSetUnitCnt() {
+		return EvaluatorTrialAggregate_UnitCnt_DEFAULT
 	}
-	return *p.TargetType
+	return *p.UnitCnt
 }
 
-var ExptTargetConf_FieldMapping_DEFAULT *TargetFieldMapping
+var EvaluatorTrialAggregate_MeanScore_DEFAULT float64
 
-func (p *ExptTargetConf) GetFieldMapping() (v *TargetFieldMapping) {
+func (p *EvaluatorTrialAggregate) GetMeanScore() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFieldMapping() {
-		return ExptTargetConf_FieldMapping_DEFAULT
+	if !p.IsSetMeanScore() {
+		return EvaluatorTrialAggregate_MeanScore_DEFAULT
 	}
-	return p.FieldMapping
+	return *p.MeanScore
 }
 
-var ExptTargetConf_RuntimeParam_DEFAULT *common.RuntimeParam
+var EvaluatorTrialAggregate_MeanStd_DEFAULT float64
 
-func (p *ExptTargetConf) GetRuntimeParam() (v *common.RuntimeParam) {
+func (p *EvaluatorTrialAggregate) GetMeanStd() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetRuntimeParam() {
-		return ExptTargetConf_RuntimeParam_DEFAULT
+	if !p.IsSetMeanStd() {
+		return EvaluatorTrialAggregate_MeanStd_DEFAULT
 	}
-	return p.RuntimeParam
+	return *p.MeanStd
 }
 
-var ExptTargetConf_Alias_DEFAULT string
+var EvaluatorTrialAggregate_ConsistencyRate_DEFAULT float64
 
-func (p *ExptTargetConf) GetAlias() (v string) {
+func (p *EvaluatorTrialAggregate) GetConsistencyRate() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetAlias() {
-		return ExptTargetConf_Alias_DEFAULT
+	if !p.IsSetConsistencyRate() {
+		return EvaluatorTrialAggregate_ConsistencyRate_DEFAULT
 	}
-	return *p.Alias
+	return *p.ConsistencyRate
 }
 
-var ExptTargetConf_Ext_DEFAULT map[string]string
+var EvaluatorTrialAggregate_PassAt1_DEFAULT float64
 
-func (p *ExptTargetConf) GetExt() (v map[string]string) {
+func (p *EvaluatorTrialAggregate) GetPassAt1() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetExt() {
-		return ExptTargetConf_Ext_DEFAULT
+	if !p.IsSetPassAt1() {
+		return EvaluatorTrialAggregate_PassAt1_DEFAULT
 	}
-	return p.Ext
+	return *p.PassAt1
 }
-func (p *ExptTargetConf) SetTargetID(val *int64) {
-	p.TargetID = val
+
+var EvaluatorTrialAggregate_PassAtK_DEFAULT float64
+
+func (p *EvaluatorTrialAggregate) GetPassAtK() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPassAtK() {
+		return EvaluatorTrialAggregate_PassAtK_DEFAULT
+	}
+	return *p.PassAtK
 }
-func (p *ExptTargetConf) SetTargetVersionID(val *int64) {
-	p.TargetVersionID = val
+
+var EvaluatorTrialAggregate_PassPowK_DEFAULT float64
+
+func (p *EvaluatorTrialAggregate) GetPassPowK() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPassPowK() {
+		return EvaluatorTrialAggregate_PassPowK_DEFAULT
+	}
+	return *p.PassPowK
 }
-func (p *ExptTargetConf) SetTargetType(val *eval_target.EvalTargetType) {
-	p.TargetType = val
+func (p *EvaluatorTrialAggregate) SetTrialNum(val *int32) {
+	p.TrialNum = val
 }
-func (p *ExptTargetConf) SetFieldMapping(val *TargetFieldMapping) {
-	p.FieldMapping = val
+func (p *EvaluatorTrialAggregate) SetPassThreshold(val *float64) {
+	p.PassThreshold = val
 }
-func (p *ExptTargetConf) SetRuntimeParam(val *common.RuntimeParam) {
-	p.RuntimeParam = val
+func (p *EvaluatorTrialAggregate) SetUnitCnt(val *int64) {
+	p.UnitCnt = val
 }
-func (p *ExptTargetConf) SetAlias(val *string) {
-	p.Alias = val
+func (p *EvaluatorTrialAggregate) SetMeanScore(val *float64) {
+	p.MeanScore = val
 }
-func (p *ExptTargetConf) SetExt(val map[string]string) {
-	p.Ext = val
+func (p *EvaluatorTrialAggregate) SetMeanStd(val *float64) {
+	p.MeanStd = val
+}
+func (p *EvaluatorTrialAggregate) SetConsistencyRate(val *float64) {
+	p.ConsistencyRate = val
+}
+func (p *EvaluatorTrialAggregate) SetPassAt1(val *float64) {
+	p.PassAt1 = val
+}
+func (p *EvaluatorTrialAggregate) SetPassAtK(val *float64) {
+	p.PassAtK = val
+}
+func (p *EvaluatorTrialAggregate) SetPassPowK(val *float64) {
+	p.PassPowK = val
 }
 
-var fieldIDToName_ExptTargetConf = map[int16]string{
-	1:   "target_id",
-	2:   "target_version_id",
-	3:   "target_type",
-	10:  "field_mapping",
-	20:  "runtime_param",
-	30:  "alias",
-	100: "ext",
+var fieldIDToName_EvaluatorTrialAggregate = map[int16]string{
+	1: "trial_num",
+	2: "pass_threshold",
+	3: "unit_cnt",
+	4: "mean_score",
+	5: "mean_std",
+	6: "consistency_rate",
+	7: "pass_at_1",
+	8: "pass_at_k",
+	9: "pass_pow_k",
 }
 
-func (p *ExptTargetConf) IsSetTargetID() bool {
-	return p.TargetID != nil
+func (p *EvaluatorTrialAggregate) IsSetTrialNum() bool {
+	return p.TrialNum != nil
 }
 
-func (p *ExptTargetConf) IsSetTargetVersionID() bool {
-	return p.TargetVersionID != nil
+func (p *EvaluatorTrialAggregate) IsSetPassThreshold() bool {
+	return p.PassThreshold != nil
 }
 
-func (p *ExptTargetConf) IsSetTargetType() bool {
-	return p.TargetType != nil
+func (p *EvaluatorTrialAggregate) IsSetUnitCnt() bool {
+	return p.UnitCnt != nil
 }
 
-func (p *ExptTargetConf) IsSetFieldMapping() bool {
-	return p.FieldMapping != nil
+func (p *EvaluatorTrialAggregate) IsSetMeanScore() bool {
+	return p.MeanScore != nil
 }
 
-func (p *ExptTargetConf) IsSetRuntimeParam() bool {
-	return p.RuntimeParam != nil
+func (p *EvaluatorTrialAggregate) IsSetMeanStd() bool {
+	return p.MeanStd != nil
 }
 
-func (p *ExptTargetConf) IsSetAlias() bool {
-	return p.Alias != nil
+func (p *EvaluatorTrialAggregate) IsSetConsistencyRate() bool {
+	return p.ConsistencyRate != nil
 }
 
-func (p *ExptTargetConf) IsSetExt() bool {
-	return p.Ext != nil
+func (p *EvaluatorTrialAggregate) IsSetPassAt1() bool {
+	return p.PassAt1 != nil
 }
 
-func (p *ExptTargetConf) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorTrialAggregate) IsSetPassAtK() bool {
+	return p.PassAtK != nil
+}
+
+func (p *EvaluatorTrialAggregate) IsSetPassPowK() bool {
+	return p.PassPowK != nil
+}
+
+func (p *EvaluatorTrialAggregate) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -31264,7 +49563,7 @@ func (p *ExptTargetConf) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -31272,7 +49571,7 @@ func (p *ExptTargetConf) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -31280,40 +49579,56 @@ func (p *ExptTargetConf) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 10:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField10(iprot); err != nil {
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 20:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField20(iprot); err != nil {
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 30:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField30(iprot); err != nil {
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 100:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField100(iprot); err != nil {
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField9(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -31338,7 +49653,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptTargetConf[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorTrialAggregate[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -31348,18 +49663,29 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExptTargetConf) ReadField1(iprot thrift.TProtocol) error {
+func (p *EvaluatorTrialAggregate) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.TargetID = _field
+	p.TrialNum = _field
 	return nil
 }
-func (p *ExptTargetConf) ReadField2(iprot thrift.TProtocol) error {
+func (p *EvaluatorTrialAggregate) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PassThreshold = _field
+	return nil
+}
+func (p *EvaluatorTrialAggregate) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -31367,81 +49693,79 @@ func (p *ExptTargetConf) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.TargetVersionID = _field
+	p.UnitCnt = _field
 	return nil
 }
-func (p *ExptTargetConf) ReadField3(iprot thrift.TProtocol) error {
+func (p *EvaluatorTrialAggregate) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field *eval_target.EvalTargetType
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
-		tmp := eval_target.EvalTargetType(v)
-		_field = &tmp
+		_field = &v
 	}
-	p.TargetType = _field
+	p.MeanScore = _field
 	return nil
 }
-func (p *ExptTargetConf) ReadField10(iprot thrift.TProtocol) error {
-	_field := NewTargetFieldMapping()
-	if err := _field.Read(iprot); err != nil {
+func (p *EvaluatorTrialAggregate) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.FieldMapping = _field
+	p.MeanStd = _field
 	return nil
 }
-func (p *ExptTargetConf) ReadField20(iprot thrift.TProtocol) error {
-	_field := common.NewRuntimeParam()
-	if err := _field.Read(iprot); err != nil {
+func (p *EvaluatorTrialAggregate) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.RuntimeParam = _field
+	p.ConsistencyRate = _field
 	return nil
 }
-func (p *ExptTargetConf) ReadField30(iprot thrift.TProtocol) error {
+func (p *EvaluatorTrialAggregate) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Alias = _field
+	p.PassAt1 = _field
 	return nil
 }
-func (p *ExptTargetConf) ReadField100(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
+func (p *EvaluatorTrialAggregate) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
-		}
+	p.PassAtK = _field
+	return nil
+}
+func (p *EvaluatorTrialAggregate) ReadField9(iprot thrift.TProtocol) error {
 
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Ext = _field
+	p.PassPowK = _field
 	return nil
 }
 
-func (p *ExptTargetConf) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorTrialAggregate) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptTargetConf"); err != nil {
+	if err = oprot.WriteStructBegin("EvaluatorTrialAggregate"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -31457,20 +49781,28 @@ func (p *ExptTargetConf) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField20(oprot); err != nil {
-			fieldId = 20
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
 			goto WriteFieldError
 		}
-		if err = p.writeField30(oprot); err != nil {
-			fieldId = 30
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
 			goto WriteFieldError
 		}
-		if err = p.writeField100(oprot); err != nil {
-			fieldId = 100
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
 			goto WriteFieldError
 		}
 	}
@@ -31491,12 +49823,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExptTargetConf) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTargetID() {
-		if err = oprot.WriteFieldBegin("target_id", thrift.I64, 1); err != nil {
+func (p *EvaluatorTrialAggregate) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTrialNum() {
+		if err = oprot.WriteFieldBegin("trial_num", thrift.I32, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.TargetID); err != nil {
+		if err := oprot.WriteI32(*p.TrialNum); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -31509,12 +49841,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ExptTargetConf) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTargetVersionID() {
-		if err = oprot.WriteFieldBegin("target_version_id", thrift.I64, 2); err != nil {
+func (p *EvaluatorTrialAggregate) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPassThreshold() {
+		if err = oprot.WriteFieldBegin("pass_threshold", thrift.DOUBLE, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.TargetVersionID); err != nil {
+		if err := oprot.WriteDouble(*p.PassThreshold); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -31527,12 +49859,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ExptTargetConf) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTargetType() {
-		if err = oprot.WriteFieldBegin("target_type", thrift.I32, 3); err != nil {
+func (p *EvaluatorTrialAggregate) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUnitCnt() {
+		if err = oprot.WriteFieldBegin("unit_cnt", thrift.I64, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(int32(*p.TargetType)); err != nil {
+		if err := oprot.WriteI64(*p.UnitCnt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -31545,12 +49877,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ExptTargetConf) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFieldMapping() {
-		if err = oprot.WriteFieldBegin("field_mapping", thrift.STRUCT, 10); err != nil {
+func (p *EvaluatorTrialAggregate) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMeanScore() {
+		if err = oprot.WriteFieldBegin("mean_score", thrift.DOUBLE, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.FieldMapping.Write(oprot); err != nil {
+		if err := oprot.WriteDouble(*p.MeanScore); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -31559,16 +49891,16 @@ func (p *ExptTargetConf) writeField10(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *ExptTargetConf) writeField20(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRuntimeParam() {
-		if err = oprot.WriteFieldBegin("runtime_param", thrift.STRUCT, 20); err != nil {
+func (p *EvaluatorTrialAggregate) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMeanStd() {
+		if err = oprot.WriteFieldBegin("mean_std", thrift.DOUBLE, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.RuntimeParam.Write(oprot); err != nil {
+		if err := oprot.WriteDouble(*p.MeanStd); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -31577,16 +49909,16 @@ func (p *ExptTargetConf) writeField20(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 20 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 20 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *ExptTargetConf) writeField30(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAlias() {
-		if err = oprot.WriteFieldBegin("alias", thrift.STRING, 30); err != nil {
+func (p *EvaluatorTrialAggregate) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConsistencyRate() {
+		if err = oprot.WriteFieldBegin("consistency_rate", thrift.DOUBLE, 6); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Alias); err != nil {
+		if err := oprot.WriteDouble(*p.ConsistencyRate); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -31595,27 +49927,34 @@ func (p *ExptTargetConf) writeField30(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 30 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 30 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-func (p *ExptTargetConf) writeField100(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExt() {
-		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 100); err != nil {
+func (p *EvaluatorTrialAggregate) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPassAt1() {
+		if err = oprot.WriteFieldBegin("pass_at_1", thrift.DOUBLE, 7); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Ext)); err != nil {
+		if err := oprot.WriteDouble(*p.PassAt1); err != nil {
 			return err
 		}
-		for k, v := range p.Ext {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err := oprot.WriteMapEnd(); err != nil {
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *EvaluatorTrialAggregate) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPassAtK() {
+		if err = oprot.WriteFieldBegin("pass_at_k", thrift.DOUBLE, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.PassAtK); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -31624,364 +49963,464 @@ func (p *ExptTargetConf) writeField100(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *EvaluatorTrialAggregate) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPassPowK() {
+		if err = oprot.WriteFieldBegin("pass_pow_k", thrift.DOUBLE, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.PassPowK); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *ExptTargetConf) String() string {
+func (p *EvaluatorTrialAggregate) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptTargetConf(%+v)", *p)
+	return fmt.Sprintf("EvaluatorTrialAggregate(%+v)", *p)
 
 }
 
-func (p *ExptTargetConf) DeepEqual(ano *ExptTargetConf) bool {
+func (p *EvaluatorTrialAggregate) DeepEqual(ano *EvaluatorTrialAggregate) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.TargetID) {
+	if !p.Field1DeepEqual(ano.TrialNum) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.TargetVersionID) {
+	if !p.Field2DeepEqual(ano.PassThreshold) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.TargetType) {
+	if !p.Field3DeepEqual(ano.UnitCnt) {
 		return false
 	}
-	if !p.Field10DeepEqual(ano.FieldMapping) {
+	if !p.Field4DeepEqual(ano.MeanScore) {
 		return false
 	}
-	if !p.Field20DeepEqual(ano.RuntimeParam) {
+	if !p.Field5DeepEqual(ano.MeanStd) {
 		return false
 	}
-	if !p.Field30DeepEqual(ano.Alias) {
+	if !p.Field6DeepEqual(ano.ConsistencyRate) {
 		return false
 	}
-	if !p.Field100DeepEqual(ano.Ext) {
+	if !p.Field7DeepEqual(ano.PassAt1) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.PassAtK) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.PassPowK) {
 		return false
 	}
 	return true
 }
 
-func (p *ExptTargetConf) Field1DeepEqual(src *int64) bool {
+func (p *EvaluatorTrialAggregate) Field1DeepEqual(src *int32) bool {
 
-	if p.TargetID == src {
+	if p.TrialNum == src {
 		return true
-	} else if p.TargetID == nil || src == nil {
+	} else if p.TrialNum == nil || src == nil {
 		return false
 	}
-	if *p.TargetID != *src {
+	if *p.TrialNum != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptTargetConf) Field2DeepEqual(src *int64) bool {
+func (p *EvaluatorTrialAggregate) Field2DeepEqual(src *float64) bool {
 
-	if p.TargetVersionID == src {
+	if p.PassThreshold == src {
 		return true
-	} else if p.TargetVersionID == nil || src == nil {
+	} else if p.PassThreshold == nil || src == nil {
 		return false
 	}
-	if *p.TargetVersionID != *src {
+	if *p.PassThreshold != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptTargetConf) Field3DeepEqual(src *eval_target.EvalTargetType) bool {
+func (p *EvaluatorTrialAggregate) Field3DeepEqual(src *int64) bool {
 
-	if p.TargetType == src {
+	if p.UnitCnt == src {
 		return true
-	} else if p.TargetType == nil || src == nil {
+	} else if p.UnitCnt == nil || src == nil {
 		return false
 	}
-	if *p.TargetType != *src {
+	if *p.UnitCnt != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptTargetConf) Field10DeepEqual(src *TargetFieldMapping) bool {
+func (p *EvaluatorTrialAggregate) Field4DeepEqual(src *float64) bool {
 
-	if !p.FieldMapping.DeepEqual(src) {
+	if p.MeanScore == src {
+		return true
+	} else if p.MeanScore == nil || src == nil {
+		return false
+	}
+	if *p.MeanScore != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptTargetConf) Field20DeepEqual(src *common.RuntimeParam) bool {
+func (p *EvaluatorTrialAggregate) Field5DeepEqual(src *float64) bool {
 
-	if !p.RuntimeParam.DeepEqual(src) {
+	if p.MeanStd == src {
+		return true
+	} else if p.MeanStd == nil || src == nil {
+		return false
+	}
+	if *p.MeanStd != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptTargetConf) Field30DeepEqual(src *string) bool {
+func (p *EvaluatorTrialAggregate) Field6DeepEqual(src *float64) bool {
 
-	if p.Alias == src {
+	if p.ConsistencyRate == src {
 		return true
-	} else if p.Alias == nil || src == nil {
+	} else if p.ConsistencyRate == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Alias, *src) != 0 {
+	if *p.ConsistencyRate != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptTargetConf) Field100DeepEqual(src map[string]string) bool {
+func (p *EvaluatorTrialAggregate) Field7DeepEqual(src *float64) bool {
 
-	if len(p.Ext) != len(src) {
+	if p.PassAt1 == src {
+		return true
+	} else if p.PassAt1 == nil || src == nil {
 		return false
 	}
-	for k, v := range p.Ext {
-		_src := src[k]
-		if strings.Compare(v, _src) != 0 {
-			return false
-		}
+	if *p.PassAt1 != *src {
+		return false
 	}
 	return true
 }
+func (p *EvaluatorTrialAggregate) Field8DeepEqual(src *float64) bool {
 
-// per-set 的一个 evaluator binding
-type ExptEvaluatorConf struct {
-	EvaluatorID        int64                    `thrift:"evaluator_id,1,required" frugal:"1,required,i64" json:"evaluator_id" form:"evaluator_id,required" query:"evaluator_id,required"`
-	EvaluatorVersionID int64                    `thrift:"evaluator_version_id,2,required" frugal:"2,required,i64" json:"evaluator_version_id" form:"evaluator_version_id,required" query:"evaluator_version_id,required"`
-	EvaluatorType      *evaluator.EvaluatorType `thrift:"evaluator_type,3,optional" frugal:"3,optional,EvaluatorType" form:"evaluator_type" json:"evaluator_type,omitempty" query:"evaluator_type"`
-	// 多实例区分(judge_A/judge_B); 缺省 '' 默认实例
-	Alias *string `thrift:"alias,4,optional" frugal:"4,optional,string" form:"alias" json:"alias,omitempty" query:"alias"`
-	// 评测集字段 → evaluator 输入
-	FromEvalSet []*FieldMapping `thrift:"from_eval_set,10,optional" frugal:"10,optional,list<FieldMapping>" form:"from_eval_set" json:"from_eval_set,omitempty" query:"from_eval_set"`
-	// target 输出 → evaluator 输入
-	FromTarget []*FieldMapping `thrift:"from_target,11,optional" frugal:"11,optional,list<FieldMapping>" form:"from_target" json:"from_target,omitempty" query:"from_target"`
-	// 行级过滤: 命中才执行本 binding (复用 data data_filter.Filter)
-	Filter *filter.Filter `thrift:"filter,20,optional" frugal:"20,optional,filter.Filter" form:"filter" json:"filter,omitempty" query:"filter"`
-	// 0 None / 1 Include / 2 Exclude
-	FilterMode *int32 `thrift:"filter_mode,21,optional" frugal:"21,optional,i32" form:"filter_mode" json:"filter_mode,omitempty" query:"filter_mode"`
-	// alias 多实例核心动机: 同 version 不同参数
-	RuntimeParam *common.RuntimeParam `thrift:"runtime_param,30,optional" frugal:"30,optional,common.RuntimeParam" form:"runtime_param" json:"runtime_param,omitempty" query:"runtime_param"`
-	// enable_weighted_score 开启时参与加权
-	ScoreWeight *float64          `thrift:"score_weight,40,optional" frugal:"40,optional,double" form:"score_weight" json:"score_weight,omitempty" query:"score_weight"`
-	Ext         map[string]string `thrift:"ext,100,optional" frugal:"100,optional,map<string:string>" form:"ext" json:"ext,omitempty" query:"ext"`
+	if p.PassAtK == src {
+		return true
+	} else if p.PassAtK == nil || src == nil {
+		return false
+	}
+	if *p.PassAtK != *src {
+		return false
+	}
+	return true
 }
+func (p *EvaluatorTrialAggregate) Field9DeepEqual(src *float64) bool {
 
-func NewExptEvaluatorConf() *ExptEvaluatorConf {
-	return &ExptEvaluatorConf{}
+	if p.PassPowK == src {
+		return true
+	} else if p.PassPowK == nil || src == nil {
+		return false
+	}
+	if *p.PassPowK != *src {
+		return false
+	}
+	return true
 }
 
-func (p *ExptEvaluatorConf) InitDefault() {
+// ===============================
+// 模拟用户
+// ===============================
+// 模拟用户配置: 每个 item 以首个 turn 为种子, 由模拟用户逐轮生成用户消息并调用评测对象,
+// 直至目标达成、模拟用户主动结束或达到最大轮数
+type ExptUserSimulatorConf struct {
+	// 模拟用户使用的模型, 必填
+	ModelConfig *common.ModelConfig `thrift:"model_config,1,optional" frugal:"1,optional,common.ModelConfig" form:"model_config" json:"model_config,omitempty" query:"model_config"`
+	// 支持 {{goal}} / {{persona}} / {{constraints}} 占位符, 为空使用内置模板
+	SystemPrompt *string `thrift:"system_prompt,2,optional" frugal:"2,optional,string" form:"system_prompt" json:"system_prompt,omitempty" query:"system_prompt"`
+	// 实验级默认的目标/人设/行为约束
+	Goal                  *string `thrift:"goal,3,optional" frugal:"3,optional,string" form:"goal" json:"goal,omitempty" query:"goal"`
+	Persona               *string `thrift:"persona,4,optional" frugal:"4,optional,string" form:"persona" json:"persona,omitempty" query:"persona"`
+	BehavioralConstraints *string `thrift:"behavioral_constraints,5,optional" frugal:"5,optional,string" form:"behavioral_constraints" json:"behavioral_constraints,omitempty" query:"behavioral_constraints"`
+	// 从评测集字段读取题目级目标/人设, 优先于实验级默认值
+	GoalFieldName    *string `thrift:"goal_field_name,6,optional" frugal:"6,optional,string" form:"goal_field_name" json:"goal_field_name,omitempty" query:"goal_field_name"`
+	PersonaFieldName *string `thrift:"persona_field_name,7,optional" frugal:"7,optional,string" form:"persona_field_name" json:"persona_field_name,omitempty" query:"persona_field_name"`
+	// 评测集中承载用户消息的字段, 必填
+	QueryFieldName *string `thrift:"query_field_name,8,optional" frugal:"8,optional,string" form:"query_field_name" json:"query_field_name,omitempty" query:"query_field_name"`
+	// 评测对象输出中承载回复的字段, 为空取 actual_output
+	ResponseFieldName *string `thrift:"response_field_name,9,optional" frugal:"9,optional,string" form:"response_field_name" json:"response_field_name,omitempty" query:"response_field_name"`
+	// 最大轮数, 为空取 5, 上限 30
+	MaxTurns *int32 `thrift:"max_turns,10,optional" frugal:"10,optional,i32" form:"max_turns" json:"max_turns,omitempty" query:"max_turns"`
+	// 目标判定评估器版本, 为空不判定
+	GoalEvaluatorVersionID *int64 `thrift:"goal_evaluator_version_id,11,optional" frugal:"11,optional,i64" json:"goal_evaluator_version_id" form:"goal_evaluator_version_id" query:"goal_evaluator_version_id"`
+	// 目标判定得分阈值, 默认 1.0
+	GoalScoreThreshold *float64 `thrift:"goal_score_threshold,12,optional" frugal:"12,optional,double" form:"goal_score_threshold" json:"goal_score_threshold,omitempty" query:"goal_score_threshold"`
 }
 
-func (p *ExptEvaluatorConf) GetEvaluatorID() (v int64) {
-	if p != nil {
-		return p.EvaluatorID
+func NewExptUserSimulatorConf() *ExptUserSimulatorConf {
+	return &ExptUserSimulatorConf{}
+}
+
+func (p *ExptUserSimulatorConf) InitDefault() {
+}
+
+var ExptUserSimulatorConf_ModelConfig_DEFAULT *common.ModelConfig
+
+func (p *ExptUserSimulatorConf) GetModelConfig() (v *common.ModelConfig) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetModelConfig() {
+		return ExptUserSimulatorConf_ModelConfig_DEFAULT
+	}
+	return p.ModelConfig
 }
 
-func (p *ExptEvaluatorConf) GetEvaluatorVersionID() (v int64) {
-	if p != nil {
-		return p.EvaluatorVersionID
+var ExptUserSimulatorConf_SystemPrompt_DEFAULT string
+
+func (p *ExptUserSimulatorConf) GetSystemPrompt() (v string) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetSystemPrompt() {
+		return ExptUserSimulatorConf_SystemPrompt_DEFAULT
+	}
+	return *p.SystemPrompt
 }
 
-var ExptEvaluatorConf_EvaluatorType_DEFAULT evaluator.EvaluatorType
+var ExptUserSimulatorConf_Goal_DEFAULT string
 
-func (p *ExptEvaluatorConf) GetEvaluatorType() (v evaluator.EvaluatorType) {
+func (p *ExptUserSimulatorConf) GetGoal() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEvaluatorType() {
-		return ExptEvaluatorConf_EvaluatorType_DEFAULT
+	if !p.IsSetGoal() {
+		return ExptUserSimulatorConf_Goal_DEFAULT
 	}
-	return *p.EvaluatorType
+	return *p.Goal
 }
 
-var ExptEvaluatorConf_Alias_DEFAULT string
+var ExptUserSimulatorConf_Persona_DEFAULT string
 
-func (p *ExptEvaluatorConf) GetAlias() (v string) {
+func (p *ExptUserSimulatorConf) GetPersona() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetAlias() {
-		return ExptEvaluatorConf_Alias_DEFAULT
+	if !p.IsSetPersona() {
+		return ExptUserSimulatorConf_Persona_DEFAULT
 	}
-	return *p.Alias
+	return *p.Persona
 }
 
-var ExptEvaluatorConf_FromEvalSet_DEFAULT []*FieldMapping
+var ExptUserSimulatorConf_BehavioralConstraints_DEFAULT string
 
-func (p *ExptEvaluatorConf) GetFromEvalSet() (v []*FieldMapping) {
+func (p *ExptUserSimulatorConf) GetBehavioralConstraints() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFromEvalSet() {
-		return ExptEvaluatorConf_FromEvalSet_DEFAULT
+	if !p.IsSetBehavioralConstraints() {
+		return ExptUserSimulatorConf_BehavioralConstraints_DEFAULT
 	}
-	return p.FromEvalSet
+	return *p.BehavioralConstraints
 }
 
-var ExptEvaluatorConf_FromTarget_DEFAULT []*FieldMapping
+var ExptUserSimulatorConf_GoalFieldName_DEFAULT string
 
-func (p *ExptEvaluatorConf) GetFromTarget() (v []*FieldMapping) {
+func (p *ExptUserSimulatorConf) GetGoalFieldName() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFromTarget() {
-		return ExptEvaluatorConf_FromTarget_DEFAULT
+	if !p.IsSetGoalFieldName() {
+		return ExptUserSimulatorConf_GoalFieldName_DEFAULT
 	}
-	return p.FromTarget
+	return *p.GoalFieldName
 }
 
-var ExptEvaluatorConf_Filter_DEFAULT *filter.Filter
+var ExptUserSimulatorConf_PersonaFieldName_DEFAULT string
 
-func (p *ExptEvaluatorConf) GetFilter() (v *filter.Filter) {
+func (p *ExptUserSimulatorConf) GetPersonaFieldName() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFilter() {
-		return ExptEvaluatorConf_Filter_DEFAULT
+	if !p.IsSetPersonaFieldName() {
+		return ExptUserSimulatorConf_PersonaFieldName_DEFAULT
 	}
-	return p.Filter
+	return *p.PersonaFieldName
 }
 
-var ExptEvaluatorConf_FilterMode_DEFAULT int32
+var ExptUserSimulatorConf_QueryFieldName_DEFAULT string
 
-func (p *ExptEvaluatorConf) GetFilterMode() (v int32) {
+func (p *ExptUserSimulatorConf) GetQueryFieldName() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFilterMode() {
-		return ExptEvaluatorConf_FilterMode_DEFAULT
+	if !p.IsSetQueryFieldName() {
+		return ExptUserSimulatorConf_QueryFieldName_DEFAULT
 	}
-	return *p.FilterMode
+	return *p.QueryFieldName
 }
 
-var ExptEvaluatorConf_RuntimeParam_DEFAULT *common.RuntimeParam
+var ExptUserSimulatorConf_ResponseFieldName_DEFAULT string
 
-func (p *ExptEvaluatorConf) GetRuntimeParam() (v *common.RuntimeParam) {
+func (p *ExptUserSimulatorConf) GetResponseFieldName() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetRuntimeParam() {
-		return ExptEvaluatorConf_RuntimeParam_DEFAULT
+	if !p.IsSetResponseFieldName() {
+		return ExptUserSimulatorConf_ResponseFieldName_DEFAULT
 	}
-	return p.RuntimeParam
+	return *p.ResponseFieldName
 }
 
-var ExptEvaluatorConf_ScoreWeight_DEFAULT float64
+var ExptUserSimulatorConf_MaxTurns_DEFAULT int32
 
-func (p *ExptEvaluatorConf) GetScoreWeight() (v float64) {
+func (p *ExptUserSimulatorConf) GetMaxTurns() (v int32) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetScoreWeight() {
-		return ExptEvaluatorConf_ScoreWeight_DEFAULT
+	if !p.IsSetMaxTurns() {
+		return ExptUserSimulatorConf_MaxTurns_DEFAULT
 	}
-	return *p.ScoreWeight
+	return *p.MaxTurns
 }
 
-var ExptEvaluatorConf_Ext_DEFAULT map[string]string
+var ExptUserSimulatorConf_GoalEvaluatorVersionID_DEFAULT int64
 
-func (p *ExptEvaluatorConf) GetExt() (v map[string]string) {
+func (p *ExptUserSimulatorConf) GetGoalEvaluatorVersionID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetExt() {
-		return ExptEvaluatorConf_Ext_DEFAULT
+	if !p.IsSetGoalEvaluatorVersionID() {
+		return ExptUserSimulatorConf_GoalEvaluatorVersionID_DEFAULT
 	}
-	return p.Ext
+	return *p.GoalEvaluatorVersionID
 }
-func (p *ExptEvaluatorConf) SetEvaluatorID(val int64) {
-	p.EvaluatorID = val
+
+var ExptUserSimulatorConf_GoalScoreThreshold_DEFAULT float64
+
+func (p *ExptUserSimulatorConf) GetGoalScoreThreshold() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGoalScoreThreshold() {
+		return ExptUserSimulatorConf_GoalScoreThreshold_DEFAULT
+	}
+	return *p.GoalScoreThreshold
 }
-func (p *ExptEvaluatorConf) SetEvaluatorVersionID(val int64) {
-	p.EvaluatorVersionID = val
+func (p *ExptUserSimulatorConf) SetModelConfig(val *common.ModelConfig) {
+	p.ModelConfig = val
 }
-func (p *ExptEvaluatorConf) SetEvaluatorType(val *evaluator.EvaluatorType) {
-	p.EvaluatorType = val
+func (p *ExptUserSimulatorConf) SetSystemPrompt(val *string) {
+	p.SystemPrompt = val
 }
-func (p *ExptEvaluatorConf) SetAlias(val *string) {
-	p.Alias = val
+func (p *ExptUserSimulatorConf) SetGoal(val *string) {
+	p.Goal = val
 }
-func (p *ExptEvaluatorConf) SetFromEvalSet(val []*FieldMapping) {
-	p.FromEvalSet = val
+func (p *ExptUserSimulatorConf) SetPersona(val *string) {
+	p.Persona = val
 }
-func (p *ExptEvaluatorConf) SetFromTarget(val []*FieldMapping) {
-	p.FromTarget = val
+func (p *ExptUserSimulatorConf) SetBehavioralConstraints(val *string) {
+	p.BehavioralConstraints = val
 }
-func (p *ExptEvaluatorConf) SetFilter(val *filter.Filter) {
-	p.Filter = val
+func (p *ExptUserSimulatorConf) SetGoalFieldName(val *string) {
+	p.GoalFieldName = val
 }
-func (p *ExptEvaluatorConf) SetFilterMode(val *int32) {
-	p.FilterMode = val
+func (p *ExptUserSimulatorConf) SetPersonaFieldName(val *string) {
+	p.PersonaFieldName = val
 }
-func (p *ExptEvaluatorConf) SetRuntimeParam(val *common.RuntimeParam) {
-	p.RuntimeParam = val
+func (p *ExptUserSimulatorConf) SetQueryFieldName(val *string) {
+	p.QueryFieldName = val
 }
-func (p *ExptEvaluatorConf) SetScoreWeight(val *float64) {
-	p.ScoreWeight = val
+func (p *ExptUserSimulatorConf) SetResponseFieldName(val *string) {
+	p.ResponseFieldName = val
 }
-func (p *ExptEvaluatorConf) SetExt(val map[string]string) {
-	p.Ext = val
+func (p *ExptUserSimulatorConf) SetMaxTurns(val *int32) {
+	p.MaxTurns = val
+}
+func (p *ExptUserSimulatorConf) SetGoalEvaluatorVersionID(val *int64) {
+	p.GoalEvaluatorVersionID = val
+}
+func (p *ExptUserSimulatorConf) SetGoalScoreThreshold(val *float64) {
+	p.GoalScoreThreshold = val
 }
 
-var fieldIDToName_ExptEvaluatorConf = map[int16]string{
-	1:   "evaluator_id",
-	2:   "evaluator_version_id",
-	3:   "evaluator_type",
-	4:   "alias",
-	10:  "from_eval_set",
-	11:  "from_target",
-	20:  "filter",
-	21:  "filter_mode",
-	30:  "runtime_param",
-	40:  "score_weight",
-	100: "ext",
+var fieldIDToName_ExptUserSimulatorConf = map[int16]string{
+	1:  "model_config",
+	2:  "system_prompt",
+	3:  "goal",
+	4:  "persona",
+	5:  "behavioral_constraints",
+	6:  "goal_field_name",
+	7:  "persona_field_name",
+	8:  "query_field_name",
+	9:  "response_field_name",
+	10: "max_turns",
+	11: "goal_evaluator_version_id",
+	12: "goal_score_threshold",
 }
 
-func (p *ExptEvaluatorConf) IsSetEvaluatorType() bool {
-	return p.EvaluatorType != nil
+func (p *ExptUserSimulatorConf) IsSetModelConfig() bool {
+	return p.ModelConfig != nil
 }
 
-func (p *ExptEvaluatorConf) IsSetAlias() bool {
-	return p.Alias != nil
+func (p *ExptUserSimulatorConf) IsSetSystemPrompt() bool {
+	return p.SystemPrompt != nil
 }
 
-func (p *ExptEvaluatorConf) IsSetFromEvalSet() bool {
-	return p.FromEvalSet != nil
+func (p *ExptUserSimulatorConf) IsSetGoal() bool {
+	return p.Goal != nil
 }
 
-func (p *ExptEvaluatorConf) IsSetFromTarget() bool {
-	return p.FromTarget != nil
+func (p *ExptUserSimulatorConf) IsSetPersona() bool {
+	return p.Persona != nil
 }
 
-func (p *ExptEvaluatorConf) IsSetFilter() bool {
-	return p.Filter != nil
+func (p *ExptUserSimulatorConf) IsSetBehavioralConstraints() bool {
+	return p.BehavioralConstraints != nil
 }
 
-func (p *ExptEvaluatorConf) IsSetFilterMode() bool {
-	return p.FilterMode != nil
+func (p *ExptUserSimulatorConf) IsSetGoalFieldName() bool {
+	return p.GoalFieldName != nil
 }
 
-func (p *ExptEvaluatorConf) IsSetRuntimeParam() bool {
-	return p.RuntimeParam != nil
+func (p *ExptUserSimulatorConf) IsSetPersonaFieldName() bool {
+	return p.PersonaFieldName != nil
 }
 
-func (p *ExptEvaluatorConf) IsSetScoreWeight() bool {
-	return p.ScoreWeight != nil
+func (p *ExptUserSimulatorConf) IsSetQueryFieldName() bool {
+	return p.QueryFieldName != nil
 }
 
-func (p *ExptEvaluatorConf) IsSetExt() bool {
-	return p.Ext != nil
+func (p *ExptUserSimulatorConf) IsSetResponseFieldName() bool {
+	return p.ResponseFieldName != nil
 }
 
-func (p *ExptEvaluatorConf) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptUserSimulatorConf) IsSetMaxTurns() bool {
+	return p.MaxTurns != nil
+}
+
+func (p *ExptUserSimulatorConf) IsSetGoalEvaluatorVersionID() bool {
+	return p.GoalEvaluatorVersionID != nil
+}
+
+func (p *ExptUserSimulatorConf) IsSetGoalScoreThreshold() bool {
+	return p.GoalScoreThreshold != nil
+}
+
+func (p *ExptUserSimulatorConf) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetEvaluatorID bool = false
-	var issetEvaluatorVersionID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -31998,25 +50437,23 @@ func (p *ExptEvaluatorConf) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetEvaluatorID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetEvaluatorVersionID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -32031,57 +50468,65 @@ func (p *ExptEvaluatorConf) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 10:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField10(iprot); err != nil {
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 11:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField11(iprot); err != nil {
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 20:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField20(iprot); err != nil {
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 21:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField21(iprot); err != nil {
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 30:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField30(iprot); err != nil {
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 40:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField40(iprot); err != nil {
+		case 10:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField10(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 100:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField100(iprot); err != nil {
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField12(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -32100,22 +50545,13 @@ func (p *ExptEvaluatorConf) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetEvaluatorID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetEvaluatorVersionID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptEvaluatorConf[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptUserSimulatorConf[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -32123,45 +50559,39 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptEvaluatorConf[fieldId]))
 }
 
-func (p *ExptEvaluatorConf) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *ExptUserSimulatorConf) ReadField1(iprot thrift.TProtocol) error {
+	_field := common.NewModelConfig()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.EvaluatorID = _field
+	p.ModelConfig = _field
 	return nil
 }
-func (p *ExptEvaluatorConf) ReadField2(iprot thrift.TProtocol) error {
+func (p *ExptUserSimulatorConf) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.EvaluatorVersionID = _field
+	p.SystemPrompt = _field
 	return nil
 }
-func (p *ExptEvaluatorConf) ReadField3(iprot thrift.TProtocol) error {
+func (p *ExptUserSimulatorConf) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *evaluator.EvaluatorType
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		tmp := evaluator.EvaluatorType(v)
-		_field = &tmp
+		_field = &v
 	}
-	p.EvaluatorType = _field
+	p.Goal = _field
 	return nil
 }
-func (p *ExptEvaluatorConf) ReadField4(iprot thrift.TProtocol) error {
+func (p *ExptUserSimulatorConf) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -32169,64 +50599,65 @@ func (p *ExptEvaluatorConf) ReadField4(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Alias = _field
+	p.Persona = _field
 	return nil
 }
-func (p *ExptEvaluatorConf) ReadField10(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *ExptUserSimulatorConf) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	_field := make([]*FieldMapping, 0, size)
-	values := make([]FieldMapping, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	p.BehavioralConstraints = _field
+	return nil
+}
+func (p *ExptUserSimulatorConf) ReadField6(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.FromEvalSet = _field
+	p.GoalFieldName = _field
 	return nil
 }
-func (p *ExptEvaluatorConf) ReadField11(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *ExptUserSimulatorConf) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	_field := make([]*FieldMapping, 0, size)
-	values := make([]FieldMapping, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	p.PersonaFieldName = _field
+	return nil
+}
+func (p *ExptUserSimulatorConf) ReadField8(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.FromTarget = _field
+	p.QueryFieldName = _field
 	return nil
 }
-func (p *ExptEvaluatorConf) ReadField20(iprot thrift.TProtocol) error {
-	_field := filter.NewFilter()
-	if err := _field.Read(iprot); err != nil {
+func (p *ExptUserSimulatorConf) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Filter = _field
+	p.ResponseFieldName = _field
 	return nil
 }
-func (p *ExptEvaluatorConf) ReadField21(iprot thrift.TProtocol) error {
+func (p *ExptUserSimulatorConf) ReadField10(iprot thrift.TProtocol) error {
 
 	var _field *int32
 	if v, err := iprot.ReadI32(); err != nil {
@@ -32234,18 +50665,21 @@ func (p *ExptEvaluatorConf) ReadField21(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.FilterMode = _field
+	p.MaxTurns = _field
 	return nil
 }
-func (p *ExptEvaluatorConf) ReadField30(iprot thrift.TProtocol) error {
-	_field := common.NewRuntimeParam()
-	if err := _field.Read(iprot); err != nil {
+func (p *ExptUserSimulatorConf) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.RuntimeParam = _field
+	p.GoalEvaluatorVersionID = _field
 	return nil
 }
-func (p *ExptEvaluatorConf) ReadField40(iprot thrift.TProtocol) error {
+func (p *ExptUserSimulatorConf) ReadField12(iprot thrift.TProtocol) error {
 
 	var _field *float64
 	if v, err := iprot.ReadDouble(); err != nil {
@@ -32253,42 +50687,13 @@ func (p *ExptEvaluatorConf) ReadField40(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.ScoreWeight = _field
-	return nil
-}
-func (p *ExptEvaluatorConf) ReadField100(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
-		}
-
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return err
-	}
-	p.Ext = _field
+	p.GoalScoreThreshold = _field
 	return nil
 }
 
-func (p *ExptEvaluatorConf) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExptUserSimulatorConf) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptEvaluatorConf"); err != nil {
+	if err = oprot.WriteStructBegin("ExptUserSimulatorConf"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -32308,32 +50713,36 @@ func (p *ExptEvaluatorConf) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
 			goto WriteFieldError
 		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
 			goto WriteFieldError
 		}
-		if err = p.writeField20(oprot); err != nil {
-			fieldId = 20
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
 			goto WriteFieldError
 		}
-		if err = p.writeField21(oprot); err != nil {
-			fieldId = 21
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
 			goto WriteFieldError
 		}
-		if err = p.writeField30(oprot); err != nil {
-			fieldId = 30
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
 			goto WriteFieldError
 		}
-		if err = p.writeField40(oprot); err != nil {
-			fieldId = 40
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
 			goto WriteFieldError
 		}
-		if err = p.writeField100(oprot); err != nil {
-			fieldId = 100
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
 			goto WriteFieldError
 		}
 	}
@@ -32354,15 +50763,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExptEvaluatorConf) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("evaluator_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.EvaluatorID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ExptUserSimulatorConf) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelConfig() {
+		if err = oprot.WriteFieldBegin("model_config", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ModelConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -32370,15 +50781,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ExptEvaluatorConf) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.EvaluatorVersionID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ExptUserSimulatorConf) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSystemPrompt() {
+		if err = oprot.WriteFieldBegin("system_prompt", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SystemPrompt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -32386,12 +50799,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ExptEvaluatorConf) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorType() {
-		if err = oprot.WriteFieldBegin("evaluator_type", thrift.I32, 3); err != nil {
+func (p *ExptUserSimulatorConf) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGoal() {
+		if err = oprot.WriteFieldBegin("goal", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(int32(*p.EvaluatorType)); err != nil {
+		if err := oprot.WriteString(*p.Goal); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -32404,12 +50817,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ExptEvaluatorConf) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAlias() {
-		if err = oprot.WriteFieldBegin("alias", thrift.STRING, 4); err != nil {
+func (p *ExptUserSimulatorConf) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPersona() {
+		if err = oprot.WriteFieldBegin("persona", thrift.STRING, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Alias); err != nil {
+		if err := oprot.WriteString(*p.Persona); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -32422,20 +50835,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *ExptEvaluatorConf) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFromEvalSet() {
-		if err = oprot.WriteFieldBegin("from_eval_set", thrift.LIST, 10); err != nil {
+func (p *ExptUserSimulatorConf) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBehavioralConstraints() {
+		if err = oprot.WriteFieldBegin("behavioral_constraints", thrift.STRING, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FromEvalSet)); err != nil {
-			return err
-		}
-		for _, v := range p.FromEvalSet {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteString(*p.BehavioralConstraints); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -32444,24 +50849,16 @@ func (p *ExptEvaluatorConf) writeField10(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *ExptEvaluatorConf) writeField11(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFromTarget() {
-		if err = oprot.WriteFieldBegin("from_target", thrift.LIST, 11); err != nil {
+func (p *ExptUserSimulatorConf) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGoalFieldName() {
+		if err = oprot.WriteFieldBegin("goal_field_name", thrift.STRING, 6); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FromTarget)); err != nil {
-			return err
-		}
-		for _, v := range p.FromTarget {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteString(*p.GoalFieldName); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -32470,16 +50867,16 @@ func (p *ExptEvaluatorConf) writeField11(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-func (p *ExptEvaluatorConf) writeField20(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFilter() {
-		if err = oprot.WriteFieldBegin("filter", thrift.STRUCT, 20); err != nil {
+func (p *ExptUserSimulatorConf) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPersonaFieldName() {
+		if err = oprot.WriteFieldBegin("persona_field_name", thrift.STRING, 7); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Filter.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.PersonaFieldName); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -32488,16 +50885,16 @@ func (p *ExptEvaluatorConf) writeField20(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 20 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 20 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
-func (p *ExptEvaluatorConf) writeField21(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFilterMode() {
-		if err = oprot.WriteFieldBegin("filter_mode", thrift.I32, 21); err != nil {
+func (p *ExptUserSimulatorConf) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetQueryFieldName() {
+		if err = oprot.WriteFieldBegin("query_field_name", thrift.STRING, 8); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(*p.FilterMode); err != nil {
+		if err := oprot.WriteString(*p.QueryFieldName); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -32506,16 +50903,16 @@ func (p *ExptEvaluatorConf) writeField21(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 21 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 21 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
-func (p *ExptEvaluatorConf) writeField30(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRuntimeParam() {
-		if err = oprot.WriteFieldBegin("runtime_param", thrift.STRUCT, 30); err != nil {
+func (p *ExptUserSimulatorConf) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResponseFieldName() {
+		if err = oprot.WriteFieldBegin("response_field_name", thrift.STRING, 9); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.RuntimeParam.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.ResponseFieldName); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -32524,16 +50921,16 @@ func (p *ExptEvaluatorConf) writeField30(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 30 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 30 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
-func (p *ExptEvaluatorConf) writeField40(oprot thrift.TProtocol) (err error) {
-	if p.IsSetScoreWeight() {
-		if err = oprot.WriteFieldBegin("score_weight", thrift.DOUBLE, 40); err != nil {
+func (p *ExptUserSimulatorConf) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxTurns() {
+		if err = oprot.WriteFieldBegin("max_turns", thrift.I32, 10); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteDouble(*p.ScoreWeight); err != nil {
+		if err := oprot.WriteI32(*p.MaxTurns); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -32542,27 +50939,34 @@ func (p *ExptEvaluatorConf) writeField40(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 40 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 40 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
-func (p *ExptEvaluatorConf) writeField100(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExt() {
-		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 100); err != nil {
+func (p *ExptUserSimulatorConf) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGoalEvaluatorVersionID() {
+		if err = oprot.WriteFieldBegin("goal_evaluator_version_id", thrift.I64, 11); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Ext)); err != nil {
+		if err := oprot.WriteI64(*p.GoalEvaluatorVersionID); err != nil {
 			return err
 		}
-		for k, v := range p.Ext {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err := oprot.WriteMapEnd(); err != nil {
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *ExptUserSimulatorConf) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGoalScoreThreshold() {
+		if err = oprot.WriteFieldBegin("goal_score_threshold", thrift.DOUBLE, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.GoalScoreThreshold); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -32571,352 +50975,565 @@ func (p *ExptEvaluatorConf) writeField100(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
 }
 
-func (p *ExptEvaluatorConf) String() string {
+func (p *ExptUserSimulatorConf) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptEvaluatorConf(%+v)", *p)
+	return fmt.Sprintf("ExptUserSimulatorConf(%+v)", *p)
 
 }
 
-func (p *ExptEvaluatorConf) DeepEqual(ano *ExptEvaluatorConf) bool {
+func (p *ExptUserSimulatorConf) DeepEqual(ano *ExptUserSimulatorConf) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.EvaluatorID) {
+	if !p.Field1DeepEqual(ano.ModelConfig) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.EvaluatorVersionID) {
+	if !p.Field2DeepEqual(ano.SystemPrompt) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.EvaluatorType) {
+	if !p.Field3DeepEqual(ano.Goal) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.Alias) {
+	if !p.Field4DeepEqual(ano.Persona) {
 		return false
 	}
-	if !p.Field10DeepEqual(ano.FromEvalSet) {
+	if !p.Field5DeepEqual(ano.BehavioralConstraints) {
 		return false
 	}
-	if !p.Field11DeepEqual(ano.FromTarget) {
+	if !p.Field6DeepEqual(ano.GoalFieldName) {
 		return false
 	}
-	if !p.Field20DeepEqual(ano.Filter) {
+	if !p.Field7DeepEqual(ano.PersonaFieldName) {
 		return false
 	}
-	if !p.Field21DeepEqual(ano.FilterMode) {
+	if !p.Field8DeepEqual(ano.QueryFieldName) {
 		return false
 	}
-	if !p.Field30DeepEqual(ano.RuntimeParam) {
+	if !p.Field9DeepEqual(ano.ResponseFieldName) {
 		return false
 	}
-	if !p.Field40DeepEqual(ano.ScoreWeight) {
+	if !p.Field10DeepEqual(ano.MaxTurns) {
 		return false
 	}
-	if !p.Field100DeepEqual(ano.Ext) {
+	if !p.Field11DeepEqual(ano.GoalEvaluatorVersionID) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.GoalScoreThreshold) {
 		return false
 	}
 	return true
 }
 
-func (p *ExptEvaluatorConf) Field1DeepEqual(src int64) bool {
+func (p *ExptUserSimulatorConf) Field1DeepEqual(src *common.ModelConfig) bool {
 
-	if p.EvaluatorID != src {
+	if !p.ModelConfig.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *ExptEvaluatorConf) Field2DeepEqual(src int64) bool {
+func (p *ExptUserSimulatorConf) Field2DeepEqual(src *string) bool {
 
-	if p.EvaluatorVersionID != src {
+	if p.SystemPrompt == src {
+		return true
+	} else if p.SystemPrompt == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.SystemPrompt, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExptEvaluatorConf) Field3DeepEqual(src *evaluator.EvaluatorType) bool {
+func (p *ExptUserSimulatorConf) Field3DeepEqual(src *string) bool {
 
-	if p.EvaluatorType == src {
+	if p.Goal == src {
 		return true
-	} else if p.EvaluatorType == nil || src == nil {
+	} else if p.Goal == nil || src == nil {
 		return false
 	}
-	if *p.EvaluatorType != *src {
+	if strings.Compare(*p.Goal, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExptEvaluatorConf) Field4DeepEqual(src *string) bool {
+func (p *ExptUserSimulatorConf) Field4DeepEqual(src *string) bool {
 
-	if p.Alias == src {
+	if p.Persona == src {
 		return true
-	} else if p.Alias == nil || src == nil {
+	} else if p.Persona == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Alias, *src) != 0 {
+	if strings.Compare(*p.Persona, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExptEvaluatorConf) Field10DeepEqual(src []*FieldMapping) bool {
+func (p *ExptUserSimulatorConf) Field5DeepEqual(src *string) bool {
 
-	if len(p.FromEvalSet) != len(src) {
+	if p.BehavioralConstraints == src {
+		return true
+	} else if p.BehavioralConstraints == nil || src == nil {
 		return false
 	}
-	for i, v := range p.FromEvalSet {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if strings.Compare(*p.BehavioralConstraints, *src) != 0 {
+		return false
 	}
 	return true
 }
-func (p *ExptEvaluatorConf) Field11DeepEqual(src []*FieldMapping) bool {
+func (p *ExptUserSimulatorConf) Field6DeepEqual(src *string) bool {
 
-	if len(p.FromTarget) != len(src) {
+	if p.GoalFieldName == src {
+		return true
+	} else if p.GoalFieldName == nil || src == nil {
 		return false
 	}
-	for i, v := range p.FromTarget {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if strings.Compare(*p.GoalFieldName, *src) != 0 {
+		return false
 	}
 	return true
 }
-func (p *ExptEvaluatorConf) Field20DeepEqual(src *filter.Filter) bool {
+func (p *ExptUserSimulatorConf) Field7DeepEqual(src *string) bool {
 
-	if !p.Filter.DeepEqual(src) {
+	if p.PersonaFieldName == src {
+		return true
+	} else if p.PersonaFieldName == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.PersonaFieldName, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExptEvaluatorConf) Field21DeepEqual(src *int32) bool {
+func (p *ExptUserSimulatorConf) Field8DeepEqual(src *string) bool {
 
-	if p.FilterMode == src {
+	if p.QueryFieldName == src {
 		return true
-	} else if p.FilterMode == nil || src == nil {
+	} else if p.QueryFieldName == nil || src == nil {
 		return false
 	}
-	if *p.FilterMode != *src {
+	if strings.Compare(*p.QueryFieldName, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExptEvaluatorConf) Field30DeepEqual(src *common.RuntimeParam) bool {
+func (p *ExptUserSimulatorConf) Field9DeepEqual(src *string) bool {
 
-	if !p.RuntimeParam.DeepEqual(src) {
+	if p.ResponseFieldName == src {
+		return true
+	} else if p.ResponseFieldName == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ResponseFieldName, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExptEvaluatorConf) Field40DeepEqual(src *float64) bool {
+func (p *ExptUserSimulatorConf) Field10DeepEqual(src *int32) bool {
 
-	if p.ScoreWeight == src {
+	if p.MaxTurns == src {
 		return true
-	} else if p.ScoreWeight == nil || src == nil {
+	} else if p.MaxTurns == nil || src == nil {
 		return false
 	}
-	if *p.ScoreWeight != *src {
+	if *p.MaxTurns != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptEvaluatorConf) Field100DeepEqual(src map[string]string) bool {
+func (p *ExptUserSimulatorConf) Field11DeepEqual(src *int64) bool {
 
-	if len(p.Ext) != len(src) {
+	if p.GoalEvaluatorVersionID == src {
+		return true
+	} else if p.GoalEvaluatorVersionID == nil || src == nil {
 		return false
 	}
-	for k, v := range p.Ext {
-		_src := src[k]
-		if strings.Compare(v, _src) != 0 {
-			return false
-		}
+	if *p.GoalEvaluatorVersionID != *src {
+		return false
 	}
 	return true
 }
+func (p *ExptUserSimulatorConf) Field12DeepEqual(src *float64) bool {
 
-// 一个评测集 + 该集的完整配置包
-type EvalSetConfig struct {
-	EvalSetID int64 `thrift:"eval_set_id,1,required" frugal:"1,required,i64" json:"eval_set_id" form:"eval_set_id,required" query:"eval_set_id,required"`
-	// 版本锁定, 不允许滚动 latest
-	EvalSetVersionID int64 `thrift:"eval_set_version_id,2,required" frugal:"2,required,i64" json:"eval_set_version_id" form:"eval_set_version_id,required" query:"eval_set_version_id,required"`
-	// 不传=全集; 点选=item_id in [...]; 条件圈选=tag 条件 (复用 data data_filter.Filter)
-	ItemFilter *filter.Filter `thrift:"item_filter,10,optional" frugal:"10,optional,filter.Filter" form:"item_filter" json:"item_filter,omitempty" query:"item_filter"`
-	// 本期 len<=1; 不传=继承 request 顶层 target
-	TargetConfs []*ExptTargetConf `thrift:"target_confs,20,optional" frugal:"20,optional,list<ExptTargetConf>" form:"target_confs" json:"target_confs,omitempty" query:"target_confs"`
-	// (evaluator_version_id, alias) 在 set 内唯一
-	EvaluatorConfs []*ExptEvaluatorConf `thrift:"evaluator_confs,30,optional" frugal:"30,optional,list<ExptEvaluatorConf>" form:"evaluator_confs" json:"evaluator_confs,omitempty" query:"evaluator_confs"`
-	// 跨空间: 该 set 评测集来源空间; nil/!is_shared=同空间
-	SharedOption *common.SharedResourceOption `thrift:"shared_option,40,optional" frugal:"40,optional,common.SharedResourceOption" form:"shared_option" json:"shared_option,omitempty" query:"shared_option"`
-	// 跨空间: 该 set 评测对象来源空间
-	TargetSharedOption *common.SharedResourceOption `thrift:"target_shared_option,41,optional" frugal:"41,optional,common.SharedResourceOption" form:"target_shared_option" json:"target_shared_option,omitempty" query:"target_shared_option"`
-	Ext                map[string]string            `thrift:"ext,100,optional" frugal:"100,optional,map<string:string>" form:"ext" json:"ext,omitempty" query:"ext"`
-}
-
-func NewEvalSetConfig() *EvalSetConfig {
-	return &EvalSetConfig{}
+	if p.GoalScoreThreshold == src {
+		return true
+	} else if p.GoalScoreThreshold == nil || src == nil {
+		return false
+	}
+	if *p.GoalScoreThreshold != *src {
+		return false
+	}
+	return true
 }
 
-func (p *EvalSetConfig) InitDefault() {
+type ExptGroupDimension struct {
+	Type *ExptGroupDimensionType `thrift:"type,1,optional" frugal:"1,optional,ExptGroupDimensionType" form:"type" json:"type,omitempty" query:"type"`
+	Key  *string                 `thrift:"key,2,optional" frugal:"2,optional,string" form:"key" json:"key,omitempty" query:"key"`
 }
 
-func (p *EvalSetConfig) GetEvalSetID() (v int64) {
-	if p != nil {
-		return p.EvalSetID
-	}
-	return
+func NewExptGroupDimension() *ExptGroupDimension {
+	return &ExptGroupDimension{}
 }
 
-func (p *EvalSetConfig) GetEvalSetVersionID() (v int64) {
-	if p != nil {
-		return p.EvalSetVersionID
-	}
-	return
+func (p *ExptGroupDimension) InitDefault() {
 }
 
-var EvalSetConfig_ItemFilter_DEFAULT *filter.Filter
+var ExptGroupDimension_Type_DEFAULT ExptGroupDimensionType
 
-func (p *EvalSetConfig) GetItemFilter() (v *filter.Filter) {
+func (p *ExptGroupDimension) GetType() (v ExptGroupDimensionType) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetItemFilter() {
-		return EvalSetConfig_ItemFilter_DEFAULT
+	if !p.IsSetType() {
+		return ExptGroupDimension_Type_DEFAULT
 	}
-	return p.ItemFilter
+	return *p.Type
 }
 
-var EvalSetConfig_TargetConfs_DEFAULT []*ExptTargetConf
+var ExptGroupDimension_Key_DEFAULT string
 
-func (p *EvalSetConfig) GetTargetConfs() (v []*ExptTargetConf) {
+func (p *ExptGroupDimension) GetKey() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTargetConfs() {
-		return EvalSetConfig_TargetConfs_DEFAULT
+	if !p.IsSetKey() {
+		return ExptGroupDimension_Key_DEFAULT
 	}
-	return p.TargetConfs
+	return *p.Key
+}
+func (p *ExptGroupDimension) SetType(val *ExptGroupDimensionType) {
+	p.Type = val
+}
+func (p *ExptGroupDimension) SetKey(val *string) {
+	p.Key = val
 }
 
-var EvalSetConfig_EvaluatorConfs_DEFAULT []*ExptEvaluatorConf
+var fieldIDToName_ExptGroupDimension = map[int16]string{
+	1: "type",
+	2: "key",
+}
 
-func (p *EvalSetConfig) GetEvaluatorConfs() (v []*ExptEvaluatorConf) {
-	if p == nil {
-		return
+func (p *ExptGroupDimension) IsSetType() bool {
+	return p.Type != nil
+}
+
+func (p *ExptGroupDimension) IsSetKey() bool {
+	return p.Key != nil
+}
+
+func (p *ExptGroupDimension) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if !p.IsSetEvaluatorConfs() {
-		return EvalSetConfig_EvaluatorConfs_DEFAULT
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	return p.EvaluatorConfs
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptGroupDimension[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-var EvalSetConfig_SharedOption_DEFAULT *common.SharedResourceOption
+func (p *ExptGroupDimension) ReadField1(iprot thrift.TProtocol) error {
 
-func (p *EvalSetConfig) GetSharedOption() (v *common.SharedResourceOption) {
-	if p == nil {
-		return
+	var _field *ExptGroupDimensionType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := ExptGroupDimensionType(v)
+		_field = &tmp
 	}
-	if !p.IsSetSharedOption() {
-		return EvalSetConfig_SharedOption_DEFAULT
+	p.Type = _field
+	return nil
+}
+func (p *ExptGroupDimension) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	return p.SharedOption
+	p.Key = _field
+	return nil
 }
 
-var EvalSetConfig_TargetSharedOption_DEFAULT *common.SharedResourceOption
+func (p *ExptGroupDimension) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptGroupDimension"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
 
-func (p *EvalSetConfig) GetTargetSharedOption() (v *common.SharedResourceOption) {
+func (p *ExptGroupDimension) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetType() {
+		if err = oprot.WriteFieldBegin("type", thrift.I32, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.Type)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptGroupDimension) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKey() {
+		if err = oprot.WriteFieldBegin("key", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Key); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ExptGroupDimension) String() string {
 	if p == nil {
-		return
+		return "<nil>"
 	}
-	if !p.IsSetTargetSharedOption() {
-		return EvalSetConfig_TargetSharedOption_DEFAULT
+	return fmt.Sprintf("ExptGroupDimension(%+v)", *p)
+
+}
+
+func (p *ExptGroupDimension) DeepEqual(ano *ExptGroupDimension) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	return p.TargetSharedOption
+	if !p.Field1DeepEqual(ano.Type) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Key) {
+		return false
+	}
+	return true
 }
 
-var EvalSetConfig_Ext_DEFAULT map[string]string
+func (p *ExptGroupDimension) Field1DeepEqual(src *ExptGroupDimensionType) bool {
 
-func (p *EvalSetConfig) GetExt() (v map[string]string) {
-	if p == nil {
-		return
+	if p.Type == src {
+		return true
+	} else if p.Type == nil || src == nil {
+		return false
 	}
-	if !p.IsSetExt() {
-		return EvalSetConfig_Ext_DEFAULT
+	if *p.Type != *src {
+		return false
 	}
-	return p.Ext
+	return true
 }
-func (p *EvalSetConfig) SetEvalSetID(val int64) {
-	p.EvalSetID = val
+func (p *ExptGroupDimension) Field2DeepEqual(src *string) bool {
+
+	if p.Key == src {
+		return true
+	} else if p.Key == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Key, *src) != 0 {
+		return false
+	}
+	return true
 }
-func (p *EvalSetConfig) SetEvalSetVersionID(val int64) {
-	p.EvalSetVersionID = val
+
+type ExptGroupEvaluatorAggr struct {
+	EvaluatorVersionID *int64  `thrift:"evaluator_version_id,1,optional" frugal:"1,optional,i64" json:"evaluator_version_id" form:"evaluator_version_id" query:"evaluator_version_id"`
+	Alias              *string `thrift:"alias,2,optional" frugal:"2,optional,string" form:"alias" json:"alias,omitempty" query:"alias"`
+	// 分组内产出该评估器得分的 turn 数
+	Count *int64 `thrift:"count,3,optional" frugal:"3,optional,i64" json:"count" form:"count" query:"count"`
+	// Average / Max / Min
+	AggregatorResults []*AggregatorResult_ `thrift:"aggregator_results,4,optional" frugal:"4,optional,list<AggregatorResult_>" form:"aggregator_results" json:"aggregator_results,omitempty" query:"aggregator_results"`
 }
-func (p *EvalSetConfig) SetItemFilter(val *filter.Filter) {
-	p.ItemFilter = val
+
+func NewExptGroupEvaluatorAggr() *ExptGroupEvaluatorAggr {
+	return &ExptGroupEvaluatorAggr{}
 }
-func (p *EvalSetConfig) SetTargetConfs(val []*ExptTargetConf) {
-	p.TargetConfs = val
+
+func (p *ExptGroupEvaluatorAggr) InitDefault() {
+}
+
+var ExptGroupEvaluatorAggr_EvaluatorVersionID_DEFAULT int64
+
+func (p *ExptGroupEvaluatorAggr) GetEvaluatorVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorVersionID() {
+		return ExptGroupEvaluatorAggr_EvaluatorVersionID_DEFAULT
+	}
+	return *p.EvaluatorVersionID
+}
+
+var ExptGroupEvaluatorAggr_Alias_DEFAULT string
+
+func (p *ExptGroupEvaluatorAggr) GetAlias() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAlias() {
+		return ExptGroupEvaluatorAggr_Alias_DEFAULT
+	}
+	return *p.Alias
 }
-func (p *EvalSetConfig) SetEvaluatorConfs(val []*ExptEvaluatorConf) {
-	p.EvaluatorConfs = val
+
+var ExptGroupEvaluatorAggr_Count_DEFAULT int64
+
+func (p *ExptGroupEvaluatorAggr) GetCount() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCount() {
+		return ExptGroupEvaluatorAggr_Count_DEFAULT
+	}
+	return *p.Count
 }
-func (p *EvalSetConfig) SetSharedOption(val *common.SharedResourceOption) {
-	p.SharedOption = val
+
+var ExptGroupEvaluatorAggr_AggregatorResults_DEFAULT []*AggregatorResult_
+
+func (p *ExptGroupEvaluatorAggr) GetAggregatorResults() (v []*AggregatorResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAggregatorResults() {
+		return ExptGroupEvaluatorAggr_AggregatorResults_DEFAULT
+	}
+	return p.AggregatorResults
 }
-func (p *EvalSetConfig) SetTargetSharedOption(val *common.SharedResourceOption) {
-	p.TargetSharedOption = val
+func (p *ExptGroupEvaluatorAggr) SetEvaluatorVersionID(val *int64) {
+	p.EvaluatorVersionID = val
 }
-func (p *EvalSetConfig) SetExt(val map[string]string) {
-	p.Ext = val
+func (p *ExptGroupEvaluatorAggr) SetAlias(val *string) {
+	p.Alias = val
 }
-
-var fieldIDToName_EvalSetConfig = map[int16]string{
-	1:   "eval_set_id",
-	2:   "eval_set_version_id",
-	10:  "item_filter",
-	20:  "target_confs",
-	30:  "evaluator_confs",
-	40:  "shared_option",
-	41:  "target_shared_option",
-	100: "ext",
+func (p *ExptGroupEvaluatorAggr) SetCount(val *int64) {
+	p.Count = val
 }
-
-func (p *EvalSetConfig) IsSetItemFilter() bool {
-	return p.ItemFilter != nil
+func (p *ExptGroupEvaluatorAggr) SetAggregatorResults(val []*AggregatorResult_) {
+	p.AggregatorResults = val
 }
 
-func (p *EvalSetConfig) IsSetTargetConfs() bool {
-	return p.TargetConfs != nil
+var fieldIDToName_ExptGroupEvaluatorAggr = map[int16]string{
+	1: "evaluator_version_id",
+	2: "alias",
+	3: "count",
+	4: "aggregator_results",
 }
 
-func (p *EvalSetConfig) IsSetEvaluatorConfs() bool {
-	return p.EvaluatorConfs != nil
+func (p *ExptGroupEvaluatorAggr) IsSetEvaluatorVersionID() bool {
+	return p.EvaluatorVersionID != nil
 }
 
-func (p *EvalSetConfig) IsSetSharedOption() bool {
-	return p.SharedOption != nil
+func (p *ExptGroupEvaluatorAggr) IsSetAlias() bool {
+	return p.Alias != nil
 }
 
-func (p *EvalSetConfig) IsSetTargetSharedOption() bool {
-	return p.TargetSharedOption != nil
+func (p *ExptGroupEvaluatorAggr) IsSetCount() bool {
+	return p.Count != nil
 }
 
-func (p *EvalSetConfig) IsSetExt() bool {
-	return p.Ext != nil
+func (p *ExptGroupEvaluatorAggr) IsSetAggregatorResults() bool {
+	return p.AggregatorResults != nil
 }
 
-func (p *EvalSetConfig) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptGroupEvaluatorAggr) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetEvalSetID bool = false
-	var issetEvalSetVersionID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -32937,62 +51554,28 @@ func (p *EvalSetConfig) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetEvalSetID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetEvalSetVersionID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 20:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField20(iprot); err != nil {
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 30:
+		case 4:
 			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField30(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 40:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField40(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 41:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField41(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 100:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField100(iprot); err != nil {
+				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -33011,22 +51594,13 @@ func (p *EvalSetConfig) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetEvalSetID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetEvalSetVersionID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvalSetConfig[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptGroupEvaluatorAggr[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -33034,70 +51608,48 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_EvalSetConfig[fieldId]))
 }
 
-func (p *EvalSetConfig) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExptGroupEvaluatorAggr) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
+	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.EvalSetID = _field
+	p.EvaluatorVersionID = _field
 	return nil
 }
-func (p *EvalSetConfig) ReadField2(iprot thrift.TProtocol) error {
+func (p *ExptGroupEvaluatorAggr) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
-	}
-	p.EvalSetVersionID = _field
-	return nil
-}
-func (p *EvalSetConfig) ReadField10(iprot thrift.TProtocol) error {
-	_field := filter.NewFilter()
-	if err := _field.Read(iprot); err != nil {
-		return err
+		_field = &v
 	}
-	p.ItemFilter = _field
+	p.Alias = _field
 	return nil
 }
-func (p *EvalSetConfig) ReadField20(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*ExptTargetConf, 0, size)
-	values := make([]ExptTargetConf, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *ExptGroupEvaluatorAggr) ReadField3(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.TargetConfs = _field
+	p.Count = _field
 	return nil
 }
-func (p *EvalSetConfig) ReadField30(iprot thrift.TProtocol) error {
+func (p *ExptGroupEvaluatorAggr) ReadField4(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*ExptEvaluatorConf, 0, size)
-	values := make([]ExptEvaluatorConf, size)
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -33111,58 +51663,13 @@ func (p *EvalSetConfig) ReadField30(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.EvaluatorConfs = _field
-	return nil
-}
-func (p *EvalSetConfig) ReadField40(iprot thrift.TProtocol) error {
-	_field := common.NewSharedResourceOption()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.SharedOption = _field
-	return nil
-}
-func (p *EvalSetConfig) ReadField41(iprot thrift.TProtocol) error {
-	_field := common.NewSharedResourceOption()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.TargetSharedOption = _field
-	return nil
-}
-func (p *EvalSetConfig) ReadField100(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
-		}
-
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return err
-	}
-	p.Ext = _field
+	p.AggregatorResults = _field
 	return nil
 }
 
-func (p *EvalSetConfig) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExptGroupEvaluatorAggr) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvalSetConfig"); err != nil {
+	if err = oprot.WriteStructBegin("ExptGroupEvaluatorAggr"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -33174,28 +51681,12 @@ func (p *EvalSetConfig) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
-		if err = p.writeField20(oprot); err != nil {
-			fieldId = 20
-			goto WriteFieldError
-		}
-		if err = p.writeField30(oprot); err != nil {
-			fieldId = 30
-			goto WriteFieldError
-		}
-		if err = p.writeField40(oprot); err != nil {
-			fieldId = 40
-			goto WriteFieldError
-		}
-		if err = p.writeField41(oprot); err != nil {
-			fieldId = 41
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField100(oprot); err != nil {
-			fieldId = 100
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
 			goto WriteFieldError
 		}
 	}
@@ -33216,15 +51707,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvalSetConfig) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("eval_set_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.EvalSetID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ExptGroupEvaluatorAggr) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersionID() {
+		if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluatorVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -33232,15 +51725,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvalSetConfig) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("eval_set_version_id", thrift.I64, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.EvalSetVersionID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ExptGroupEvaluatorAggr) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAlias() {
+		if err = oprot.WriteFieldBegin("alias", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Alias); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -33248,12 +51743,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *EvalSetConfig) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetItemFilter() {
-		if err = oprot.WriteFieldBegin("item_filter", thrift.STRUCT, 10); err != nil {
+func (p *ExptGroupEvaluatorAggr) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCount() {
+		if err = oprot.WriteFieldBegin("count", thrift.I64, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.ItemFilter.Write(oprot); err != nil {
+		if err := oprot.WriteI64(*p.Count); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -33262,19 +51757,19 @@ func (p *EvalSetConfig) writeField10(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *EvalSetConfig) writeField20(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTargetConfs() {
-		if err = oprot.WriteFieldBegin("target_confs", thrift.LIST, 20); err != nil {
+func (p *ExptGroupEvaluatorAggr) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAggregatorResults() {
+		if err = oprot.WriteFieldBegin("aggregator_results", thrift.LIST, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TargetConfs)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.AggregatorResults)); err != nil {
 			return err
 		}
-		for _, v := range p.TargetConfs {
+		for _, v := range p.AggregatorResults {
 			if err := v.Write(oprot); err != nil {
 				return err
 			}
@@ -33287,43 +51782,327 @@ func (p *EvalSetConfig) writeField20(oprot thrift.TProtocol) (err error) {
 		}
 	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 20 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 20 end error: ", p), err)
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ExptGroupEvaluatorAggr) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptGroupEvaluatorAggr(%+v)", *p)
+
+}
+
+func (p *ExptGroupEvaluatorAggr) DeepEqual(ano *ExptGroupEvaluatorAggr) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Alias) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Count) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.AggregatorResults) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptGroupEvaluatorAggr) Field1DeepEqual(src *int64) bool {
+
+	if p.EvaluatorVersionID == src {
+		return true
+	} else if p.EvaluatorVersionID == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatorVersionID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptGroupEvaluatorAggr) Field2DeepEqual(src *string) bool {
+
+	if p.Alias == src {
+		return true
+	} else if p.Alias == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Alias, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptGroupEvaluatorAggr) Field3DeepEqual(src *int64) bool {
+
+	if p.Count == src {
+		return true
+	} else if p.Count == nil || src == nil {
+		return false
+	}
+	if *p.Count != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptGroupEvaluatorAggr) Field4DeepEqual(src []*AggregatorResult_) bool {
+
+	if len(p.AggregatorResults) != len(src) {
+		return false
+	}
+	for i, v := range p.AggregatorResults {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type ExptAggrGroup struct {
+	// 分组取值; 字段缺失时为空串, 分类/布尔标签为 tag_value_id
+	Value            *string                   `thrift:"value,1,optional" frugal:"1,optional,string" form:"value" json:"value,omitempty" query:"value"`
+	TurnCount        *int64                    `thrift:"turn_count,2,optional" frugal:"2,optional,i64" json:"turn_count" form:"turn_count" query:"turn_count"`
+	EvaluatorResults []*ExptGroupEvaluatorAggr `thrift:"evaluator_results,3,optional" frugal:"3,optional,list<ExptGroupEvaluatorAggr>" form:"evaluator_results" json:"evaluator_results,omitempty" query:"evaluator_results"`
+}
+
+func NewExptAggrGroup() *ExptAggrGroup {
+	return &ExptAggrGroup{}
+}
+
+func (p *ExptAggrGroup) InitDefault() {
+}
+
+var ExptAggrGroup_Value_DEFAULT string
+
+func (p *ExptAggrGroup) GetValue() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetValue() {
+		return ExptAggrGroup_Value_DEFAULT
+	}
+	return *p.Value
+}
+
+var ExptAggrGroup_TurnCount_DEFAULT int64
+
+func (p *ExptAggrGroup) GetTurnCount() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTurnCount() {
+		return ExptAggrGroup_TurnCount_DEFAULT
+	}
+	return *p.TurnCount
+}
+
+var ExptAggrGroup_EvaluatorResults_DEFAULT []*ExptGroupEvaluatorAggr
+
+func (p *ExptAggrGroup) GetEvaluatorResults() (v []*ExptGroupEvaluatorAggr) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorResults() {
+		return ExptAggrGroup_EvaluatorResults_DEFAULT
+	}
+	return p.EvaluatorResults
+}
+func (p *ExptAggrGroup) SetValue(val *string) {
+	p.Value = val
+}
+func (p *ExptAggrGroup) SetTurnCount(val *int64) {
+	p.TurnCount = val
+}
+func (p *ExptAggrGroup) SetEvaluatorResults(val []*ExptGroupEvaluatorAggr) {
+	p.EvaluatorResults = val
+}
+
+var fieldIDToName_ExptAggrGroup = map[int16]string{
+	1: "value",
+	2: "turn_count",
+	3: "evaluator_results",
+}
+
+func (p *ExptAggrGroup) IsSetValue() bool {
+	return p.Value != nil
+}
+
+func (p *ExptAggrGroup) IsSetTurnCount() bool {
+	return p.TurnCount != nil
+}
+
+func (p *ExptAggrGroup) IsSetEvaluatorResults() bool {
+	return p.EvaluatorResults != nil
+}
+
+func (p *ExptAggrGroup) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptAggrGroup[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptAggrGroup) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Value = _field
+	return nil
+}
+func (p *ExptAggrGroup) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TurnCount = _field
+	return nil
+}
+func (p *ExptAggrGroup) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExptGroupEvaluatorAggr, 0, size)
+	values := make([]ExptGroupEvaluatorAggr, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.EvaluatorResults = _field
+	return nil
 }
-func (p *EvalSetConfig) writeField30(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorConfs() {
-		if err = oprot.WriteFieldBegin("evaluator_confs", thrift.LIST, 30); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.EvaluatorConfs)); err != nil {
-			return err
-		}
-		for _, v := range p.EvaluatorConfs {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
+
+func (p *ExptAggrGroup) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptAggrGroup"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
 		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
 		}
 	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 30 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 30 end error: ", p), err)
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *EvalSetConfig) writeField40(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSharedOption() {
-		if err = oprot.WriteFieldBegin("shared_option", thrift.STRUCT, 40); err != nil {
+
+func (p *ExptAggrGroup) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetValue() {
+		if err = oprot.WriteFieldBegin("value", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.SharedOption.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.Value); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -33332,16 +52111,16 @@ func (p *EvalSetConfig) writeField40(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 40 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 40 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvalSetConfig) writeField41(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTargetSharedOption() {
-		if err = oprot.WriteFieldBegin("target_shared_option", thrift.STRUCT, 41); err != nil {
+func (p *ExptAggrGroup) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTurnCount() {
+		if err = oprot.WriteFieldBegin("turn_count", thrift.I64, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.TargetSharedOption.Write(oprot); err != nil {
+		if err := oprot.WriteI64(*p.TurnCount); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -33350,27 +52129,24 @@ func (p *EvalSetConfig) writeField41(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 41 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 41 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *EvalSetConfig) writeField100(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExt() {
-		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 100); err != nil {
+func (p *ExptAggrGroup) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorResults() {
+		if err = oprot.WriteFieldBegin("evaluator_results", thrift.LIST, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Ext)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.EvaluatorResults)); err != nil {
 			return err
 		}
-		for k, v := range p.Ext {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteString(v); err != nil {
+		for _, v := range p.EvaluatorResults {
+			if err := v.Write(oprot); err != nil {
 				return err
 			}
 		}
-		if err := oprot.WriteMapEnd(); err != nil {
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -33379,92 +52155,67 @@ func (p *EvalSetConfig) writeField100(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *EvalSetConfig) String() string {
+func (p *ExptAggrGroup) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvalSetConfig(%+v)", *p)
+	return fmt.Sprintf("ExptAggrGroup(%+v)", *p)
 
 }
 
-func (p *EvalSetConfig) DeepEqual(ano *EvalSetConfig) bool {
+func (p *ExptAggrGroup) DeepEqual(ano *ExptAggrGroup) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.EvalSetID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.EvalSetVersionID) {
-		return false
-	}
-	if !p.Field10DeepEqual(ano.ItemFilter) {
-		return false
-	}
-	if !p.Field20DeepEqual(ano.TargetConfs) {
-		return false
-	}
-	if !p.Field30DeepEqual(ano.EvaluatorConfs) {
-		return false
-	}
-	if !p.Field40DeepEqual(ano.SharedOption) {
+	if !p.Field1DeepEqual(ano.Value) {
 		return false
 	}
-	if !p.Field41DeepEqual(ano.TargetSharedOption) {
+	if !p.Field2DeepEqual(ano.TurnCount) {
 		return false
 	}
-	if !p.Field100DeepEqual(ano.Ext) {
+	if !p.Field3DeepEqual(ano.EvaluatorResults) {
 		return false
 	}
 	return true
 }
 
-func (p *EvalSetConfig) Field1DeepEqual(src int64) bool {
+func (p *ExptAggrGroup) Field1DeepEqual(src *string) bool {
 
-	if p.EvalSetID != src {
+	if p.Value == src {
+		return true
+	} else if p.Value == nil || src == nil {
 		return false
 	}
-	return true
-}
-func (p *EvalSetConfig) Field2DeepEqual(src int64) bool {
-
-	if p.EvalSetVersionID != src {
+	if strings.Compare(*p.Value, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *EvalSetConfig) Field10DeepEqual(src *filter.Filter) bool {
+func (p *ExptAggrGroup) Field2DeepEqual(src *int64) bool {
 
-	if !p.ItemFilter.DeepEqual(src) {
+	if p.TurnCount == src {
+		return true
+	} else if p.TurnCount == nil || src == nil {
 		return false
 	}
-	return true
-}
-func (p *EvalSetConfig) Field20DeepEqual(src []*ExptTargetConf) bool {
-
-	if len(p.TargetConfs) != len(src) {
+	if *p.TurnCount != *src {
 		return false
 	}
-	for i, v := range p.TargetConfs {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
 	return true
 }
-func (p *EvalSetConfig) Field30DeepEqual(src []*ExptEvaluatorConf) bool {
+func (p *ExptAggrGroup) Field3DeepEqual(src []*ExptGroupEvaluatorAggr) bool {
 
-	if len(p.EvaluatorConfs) != len(src) {
+	if len(p.EvaluatorResults) != len(src) {
 		return false
 	}
-	for i, v := range p.EvaluatorConfs {
+	for i, v := range p.EvaluatorResults {
 		_src := src[i]
 		if !v.DeepEqual(_src) {
 			return false
@@ -33472,179 +52223,86 @@ func (p *EvalSetConfig) Field30DeepEqual(src []*ExptEvaluatorConf) bool {
 	}
 	return true
 }
-func (p *EvalSetConfig) Field40DeepEqual(src *common.SharedResourceOption) bool {
-
-	if !p.SharedOption.DeepEqual(src) {
-		return false
-	}
-	return true
-}
-func (p *EvalSetConfig) Field41DeepEqual(src *common.SharedResourceOption) bool {
-
-	if !p.TargetSharedOption.DeepEqual(src) {
-		return false
-	}
-	return true
-}
-func (p *EvalSetConfig) Field100DeepEqual(src map[string]string) bool {
-
-	if len(p.Ext) != len(src) {
-		return false
-	}
-	for k, v := range p.Ext {
-		_src := src[k]
-		if strings.Compare(v, _src) != 0 {
-			return false
-		}
-	}
-	return true
-}
-
-// per-set 运行期增量信息 (纯读模型, 不进 Create 入参)
-type ExptEvalSetDetail struct {
-	EvalSetID        *int64 `thrift:"eval_set_id,1,optional" frugal:"1,optional,i64" json:"eval_set_id" form:"eval_set_id" query:"eval_set_id"`
-	EvalSetVersionID *int64 `thrift:"eval_set_version_id,2,optional" frugal:"2,optional,i64" json:"eval_set_version_id" form:"eval_set_version_id" query:"eval_set_version_id"`
-	// 主集(封面), 与 experiment.eval_set_id 列一致
-	IsPrimary *bool `thrift:"is_primary,3,optional" frugal:"3,optional,bool" form:"is_primary" json:"is_primary,omitempty" query:"is_primary"`
-	// 该 set 选入实验的 item 数; 来源 expt_item_ref, 首跑前不填
-	ItemCount *int32 `thrift:"item_count,4,optional" frugal:"4,optional,i32" form:"item_count" json:"item_count,omitempty" query:"item_count"`
-	// Get 填充详情; List 不填
-	EvalSet *eval_set.EvaluationSet `thrift:"eval_set,5,optional" frugal:"5,optional,eval_set.EvaluationSet" form:"eval_set" json:"eval_set,omitempty" query:"eval_set"`
-	// 评测集业务唯一键; 便于 GetExperiment 直接展示/定位
-	DatasetKey *string `thrift:"dataset_key,6,optional" frugal:"6,optional,string" form:"dataset_key" json:"dataset_key,omitempty" query:"dataset_key"`
-}
-
-func NewExptEvalSetDetail() *ExptEvalSetDetail {
-	return &ExptEvalSetDetail{}
-}
-
-func (p *ExptEvalSetDetail) InitDefault() {
-}
-
-var ExptEvalSetDetail_EvalSetID_DEFAULT int64
 
-func (p *ExptEvalSetDetail) GetEvalSetID() (v int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetEvalSetID() {
-		return ExptEvalSetDetail_EvalSetID_DEFAULT
-	}
-	return *p.EvalSetID
+// 单个实验的分组聚合结果
+type ExptGroupedAggr struct {
+	ExptID *int64           `thrift:"expt_id,1,optional" frugal:"1,optional,i64" json:"expt_id" form:"expt_id" query:"expt_id"`
+	Groups []*ExptAggrGroup `thrift:"groups,2,optional" frugal:"2,optional,list<ExptAggrGroup>" form:"groups" json:"groups,omitempty" query:"groups"`
+	// 分组数超过 max_groups, 仅返回 turn 数最多的前 max_groups 组
+	Truncated *bool `thrift:"truncated,3,optional" frugal:"3,optional,bool" form:"truncated" json:"truncated,omitempty" query:"truncated"`
 }
 
-var ExptEvalSetDetail_EvalSetVersionID_DEFAULT int64
-
-func (p *ExptEvalSetDetail) GetEvalSetVersionID() (v int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetEvalSetVersionID() {
-		return ExptEvalSetDetail_EvalSetVersionID_DEFAULT
-	}
-	return *p.EvalSetVersionID
+func NewExptGroupedAggr() *ExptGroupedAggr {
+	return &ExptGroupedAggr{}
 }
 
-var ExptEvalSetDetail_IsPrimary_DEFAULT bool
-
-func (p *ExptEvalSetDetail) GetIsPrimary() (v bool) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetIsPrimary() {
-		return ExptEvalSetDetail_IsPrimary_DEFAULT
-	}
-	return *p.IsPrimary
+func (p *ExptGroupedAggr) InitDefault() {
 }
 
-var ExptEvalSetDetail_ItemCount_DEFAULT int32
+var ExptGroupedAggr_ExptID_DEFAULT int64
 
-func (p *ExptEvalSetDetail) GetItemCount() (v int32) {
+func (p *ExptGroupedAggr) GetExptID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetItemCount() {
-		return ExptEvalSetDetail_ItemCount_DEFAULT
+	if !p.IsSetExptID() {
+		return ExptGroupedAggr_ExptID_DEFAULT
 	}
-	return *p.ItemCount
+	return *p.ExptID
 }
 
-var ExptEvalSetDetail_EvalSet_DEFAULT *eval_set.EvaluationSet
+var ExptGroupedAggr_Groups_DEFAULT []*ExptAggrGroup
 
-func (p *ExptEvalSetDetail) GetEvalSet() (v *eval_set.EvaluationSet) {
+func (p *ExptGroupedAggr) GetGroups() (v []*ExptAggrGroup) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEvalSet() {
-		return ExptEvalSetDetail_EvalSet_DEFAULT
+	if !p.IsSetGroups() {
+		return ExptGroupedAggr_Groups_DEFAULT
 	}
-	return p.EvalSet
+	return p.Groups
 }
 
-var ExptEvalSetDetail_DatasetKey_DEFAULT string
+var ExptGroupedAggr_Truncated_DEFAULT bool
 
-func (p *ExptEvalSetDetail) GetDatasetKey() (v string) {
+func (p *ExptGroupedAggr) GetTruncated() (v bool) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDatasetKey() {
-		return ExptEvalSetDetail_DatasetKey_DEFAULT
+	if !p.IsSetTruncated() {
+		return ExptGroupedAggr_Truncated_DEFAULT
 	}
-	return *p.DatasetKey
-}
-func (p *ExptEvalSetDetail) SetEvalSetID(val *int64) {
-	p.EvalSetID = val
-}
-func (p *ExptEvalSetDetail) SetEvalSetVersionID(val *int64) {
-	p.EvalSetVersionID = val
-}
-func (p *ExptEvalSetDetail) SetIsPrimary(val *bool) {
-	p.IsPrimary = val
-}
-func (p *ExptEvalSetDetail) SetItemCount(val *int32) {
-	p.ItemCount = val
-}
-func (p *ExptEvalSetDetail) SetEvalSet(val *eval_set.EvaluationSet) {
-	p.EvalSet = val
-}
-func (p *ExptEvalSetDetail) SetDatasetKey(val *string) {
-	p.DatasetKey = val
+	return *p.Truncated
 }
-
-var fieldIDToName_ExptEvalSetDetail = map[int16]string{
-	1: "eval_set_id",
-	2: "eval_set_version_id",
-	3: "is_primary",
-	4: "item_count",
-	5: "eval_set",
-	6: "dataset_key",
+func (p *ExptGroupedAggr) SetExptID(val *int64) {
+	p.ExptID = val
 }
-
-func (p *ExptEvalSetDetail) IsSetEvalSetID() bool {
-	return p.EvalSetID != nil
+func (p *ExptGroupedAggr) SetGroups(val []*ExptAggrGroup) {
+	p.Groups = val
 }
-
-func (p *ExptEvalSetDetail) IsSetEvalSetVersionID() bool {
-	return p.EvalSetVersionID != nil
+func (p *ExptGroupedAggr) SetTruncated(val *bool) {
+	p.Truncated = val
 }
 
-func (p *ExptEvalSetDetail) IsSetIsPrimary() bool {
-	return p.IsPrimary != nil
+var fieldIDToName_ExptGroupedAggr = map[int16]string{
+	1: "expt_id",
+	2: "groups",
+	3: "truncated",
 }
 
-func (p *ExptEvalSetDetail) IsSetItemCount() bool {
-	return p.ItemCount != nil
+func (p *ExptGroupedAggr) IsSetExptID() bool {
+	return p.ExptID != nil
 }
 
-func (p *ExptEvalSetDetail) IsSetEvalSet() bool {
-	return p.EvalSet != nil
+func (p *ExptGroupedAggr) IsSetGroups() bool {
+	return p.Groups != nil
 }
 
-func (p *ExptEvalSetDetail) IsSetDatasetKey() bool {
-	return p.DatasetKey != nil
+func (p *ExptGroupedAggr) IsSetTruncated() bool {
+	return p.Truncated != nil
 }
 
-func (p *ExptEvalSetDetail) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptGroupedAggr) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -33671,7 +52329,7 @@ func (p *ExptEvalSetDetail) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -33686,30 +52344,6 @@ func (p *ExptEvalSetDetail) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -33729,7 +52363,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptEvalSetDetail[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptGroupedAggr[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -33739,7 +52373,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExptEvalSetDetail) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExptGroupedAggr) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -33747,65 +52381,47 @@ func (p *ExptEvalSetDetail) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.EvalSetID = _field
+	p.ExptID = _field
 	return nil
 }
-func (p *ExptEvalSetDetail) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *ExptGroupedAggr) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.EvalSetVersionID = _field
-	return nil
-}
-func (p *ExptEvalSetDetail) ReadField3(iprot thrift.TProtocol) error {
+	_field := make([]*ExptAggrGroup, 0, size)
+	values := make([]ExptAggrGroup, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.IsPrimary = _field
-	return nil
-}
-func (p *ExptEvalSetDetail) ReadField4(iprot thrift.TProtocol) error {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
+		_field = append(_field, _elem)
 	}
-	p.ItemCount = _field
-	return nil
-}
-func (p *ExptEvalSetDetail) ReadField5(iprot thrift.TProtocol) error {
-	_field := eval_set.NewEvaluationSet()
-	if err := _field.Read(iprot); err != nil {
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.EvalSet = _field
+	p.Groups = _field
 	return nil
 }
-func (p *ExptEvalSetDetail) ReadField6(iprot thrift.TProtocol) error {
+func (p *ExptGroupedAggr) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.DatasetKey = _field
+	p.Truncated = _field
 	return nil
 }
 
-func (p *ExptEvalSetDetail) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExptGroupedAggr) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptEvalSetDetail"); err != nil {
+	if err = oprot.WriteStructBegin("ExptGroupedAggr"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -33821,18 +52437,6 @@ func (p *ExptEvalSetDetail) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -33851,12 +52455,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExptEvalSetDetail) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvalSetID() {
-		if err = oprot.WriteFieldBegin("eval_set_id", thrift.I64, 1); err != nil {
+func (p *ExptGroupedAggr) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExptID() {
+		if err = oprot.WriteFieldBegin("expt_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.EvalSetID); err != nil {
+		if err := oprot.WriteI64(*p.ExptID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -33869,12 +52473,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ExptEvalSetDetail) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvalSetVersionID() {
-		if err = oprot.WriteFieldBegin("eval_set_version_id", thrift.I64, 2); err != nil {
+func (p *ExptGroupedAggr) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGroups() {
+		if err = oprot.WriteFieldBegin("groups", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.EvalSetVersionID); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Groups)); err != nil {
+			return err
+		}
+		for _, v := range p.Groups {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -33887,12 +52499,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ExptEvalSetDetail) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIsPrimary() {
-		if err = oprot.WriteFieldBegin("is_primary", thrift.BOOL, 3); err != nil {
+func (p *ExptGroupedAggr) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTruncated() {
+		if err = oprot.WriteFieldBegin("truncated", thrift.BOOL, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.IsPrimary); err != nil {
+		if err := oprot.WriteBool(*p.Truncated); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -33905,159 +52517,66 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ExptEvalSetDetail) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetItemCount() {
-		if err = oprot.WriteFieldBegin("item_count", thrift.I32, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.ItemCount); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *ExptEvalSetDetail) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvalSet() {
-		if err = oprot.WriteFieldBegin("eval_set", thrift.STRUCT, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.EvalSet.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-func (p *ExptEvalSetDetail) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDatasetKey() {
-		if err = oprot.WriteFieldBegin("dataset_key", thrift.STRING, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.DatasetKey); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
 
-func (p *ExptEvalSetDetail) String() string {
+func (p *ExptGroupedAggr) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptEvalSetDetail(%+v)", *p)
+	return fmt.Sprintf("ExptGroupedAggr(%+v)", *p)
 
 }
 
-func (p *ExptEvalSetDetail) DeepEqual(ano *ExptEvalSetDetail) bool {
+func (p *ExptGroupedAggr) DeepEqual(ano *ExptGroupedAggr) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.EvalSetID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.EvalSetVersionID) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.IsPrimary) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.ItemCount) {
-		return false
-	}
-	if !p.Field5DeepEqual(ano.EvalSet) {
-		return false
-	}
-	if !p.Field6DeepEqual(ano.DatasetKey) {
+	if !p.Field1DeepEqual(ano.ExptID) {
 		return false
 	}
-	return true
-}
-
-func (p *ExptEvalSetDetail) Field1DeepEqual(src *int64) bool {
-
-	if p.EvalSetID == src {
-		return true
-	} else if p.EvalSetID == nil || src == nil {
+	if !p.Field2DeepEqual(ano.Groups) {
 		return false
 	}
-	if *p.EvalSetID != *src {
+	if !p.Field3DeepEqual(ano.Truncated) {
 		return false
 	}
 	return true
 }
-func (p *ExptEvalSetDetail) Field2DeepEqual(src *int64) bool {
 
-	if p.EvalSetVersionID == src {
-		return true
-	} else if p.EvalSetVersionID == nil || src == nil {
-		return false
-	}
-	if *p.EvalSetVersionID != *src {
-		return false
-	}
-	return true
-}
-func (p *ExptEvalSetDetail) Field3DeepEqual(src *bool) bool {
+func (p *ExptGroupedAggr) Field1DeepEqual(src *int64) bool {
 
-	if p.IsPrimary == src {
+	if p.ExptID == src {
 		return true
-	} else if p.IsPrimary == nil || src == nil {
+	} else if p.ExptID == nil || src == nil {
 		return false
 	}
-	if *p.IsPrimary != *src {
+	if *p.ExptID != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptEvalSetDetail) Field4DeepEqual(src *int32) bool {
+func (p *ExptGroupedAggr) Field2DeepEqual(src []*ExptAggrGroup) bool {
 
-	if p.ItemCount == src {
-		return true
-	} else if p.ItemCount == nil || src == nil {
-		return false
-	}
-	if *p.ItemCount != *src {
+	if len(p.Groups) != len(src) {
 		return false
 	}
-	return true
-}
-func (p *ExptEvalSetDetail) Field5DeepEqual(src *eval_set.EvaluationSet) bool {
-
-	if !p.EvalSet.DeepEqual(src) {
-		return false
+	for i, v := range p.Groups {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
 	return true
 }
-func (p *ExptEvalSetDetail) Field6DeepEqual(src *string) bool {
+func (p *ExptGroupedAggr) Field3DeepEqual(src *bool) bool {
 
-	if p.DatasetKey == src {
+	if p.Truncated == src {
 		return true
-	} else if p.DatasetKey == nil || src == nil {
+	} else if p.Truncated == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.DatasetKey, *src) != 0 {
+	if *p.Truncated != *src {
 		return false
 	}
 	return true
@@ -80,6 +80,46 @@ func (p *Experiment) IsValid() error {
 			return fmt.Errorf("field RunModeConfig not valid, %w", err)
 		}
 	}
+	if p.QualityGateConf != nil {
+		if err := p.QualityGateConf.IsValid(); err != nil {
+			return fmt.Errorf("field QualityGateConf not valid, %w", err)
+		}
+	}
+	if p.QualityGateResult_ != nil {
+		if err := p.QualityGateResult_.IsValid(); err != nil {
+			return fmt.Errorf("field QualityGateResult_ not valid, %w", err)
+		}
+	}
+	if p.CostConf != nil {
+		if err := p.CostConf.IsValid(); err != nil {
+			return fmt.Errorf("field CostConf not valid, %w", err)
+		}
+	}
+	if p.ResultCacheConf != nil {
+		if err := p.ResultCacheConf.IsValid(); err != nil {
+			return fmt.Errorf("field ResultCacheConf not valid, %w", err)
+		}
+	}
+	if p.SamplingConf != nil {
+		if err := p.SamplingConf.IsValid(); err != nil {
+			return fmt.Errorf("field SamplingConf not valid, %w", err)
+		}
+	}
+	if p.SamplingResult_ != nil {
+		if err := p.SamplingResult_.IsValid(); err != nil {
+			return fmt.Errorf("field SamplingResult_ not valid, %w", err)
+		}
+	}
+	if p.TrialConf != nil {
+		if err := p.TrialConf.IsValid(); err != nil {
+			return fmt.Errorf("field TrialConf not valid, %w", err)
+		}
+	}
+	if p.UserSimulatorConf != nil {
+		if err := p.UserSimulatorConf.IsValid(); err != nil {
+			return fmt.Errorf("field UserSimulatorConf not valid, %w", err)
+		}
+	}
 	return nil
 }
 func (p *ExptTemplateMeta) IsValid() error {
@@ -150,6 +190,11 @@ func (p *ExptTemplate) IsValid() error {
 			return fmt.Errorf("field NotificationConf not valid, %w", err)
 		}
 	}
+	if p.QualityGateConf != nil {
+		if err := p.QualityGateConf.IsValid(); err != nil {
+			return fmt.Errorf("field QualityGateConf not valid, %w", err)
+		}
+	}
 	if p.BaseInfo != nil {
 		if err := p.BaseInfo.IsValid(); err != nil {
 			return fmt.Errorf("field BaseInfo not valid, %w", err)
@@ -198,6 +243,11 @@ func (p *ExptStatistics) IsValid() error {
 			return fmt.Errorf("field TokenUsage not valid, %w", err)
 		}
 	}
+	if p.CostStats != nil {
+		if err := p.CostStats.IsValid(); err != nil {
+			return fmt.Errorf("field CostStats not valid, %w", err)
+		}
+	}
 	return nil
 }
 func (p *EvaluatorFmtResult_) IsValid() error {
@@ -426,9 +476,25 @@ func (p *EvalTargetAggregateResult_) IsValid() error {
 	return nil
 }
 func (p *EvaluatorAggregateResult_) IsValid() error {
+	if p.Trial != nil {
+		if err := p.Trial.IsValid(); err != nil {
+			return fmt.Errorf("field Trial not valid, %w", err)
+		}
+	}
 	return nil
 }
 func (p *AnnotationAggregateResult_) IsValid() error {
+	if p.Agreement != nil {
+		if err := p.Agreement.IsValid(); err != nil {
+			return fmt.Errorf("field Agreement not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *AnnotationAgreement) IsValid() error {
+	return nil
+}
+func (p *JudgeHumanAgreement) IsValid() error {
 	return nil
 }
 func (p *AggregatorResult_) IsValid() error {
@@ -578,3 +644,139 @@ func (p *ExptEvalSetDetail) IsValid() error {
 	}
 	return nil
 }
+func (p *ExptPairwiseStat) IsValid() error {
+	return nil
+}
+func (p *ExptPairwiseRanking) IsValid() error {
+	return nil
+}
+func (p *ExptPairwiseRankRecord) IsValid() error {
+	if p.BaseInfo != nil {
+		if err := p.BaseInfo.IsValid(); err != nil {
+			return fmt.Errorf("field BaseInfo not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExptEvaluatorSignificance) IsValid() error {
+	return nil
+}
+func (p *ExptPairSignificance) IsValid() error {
+	return nil
+}
+func (p *ExptQualityGateRule) IsValid() error {
+	return nil
+}
+func (p *ExptQualityGateConf) IsValid() error {
+	return nil
+}
+func (p *ExptQualityGateRuleResult_) IsValid() error {
+	if p.Rule != nil {
+		if err := p.Rule.IsValid(); err != nil {
+			return fmt.Errorf("field Rule not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExptQualityGateResult_) IsValid() error {
+	return nil
+}
+func (p *ExptRegressionSummary) IsValid() error {
+	return nil
+}
+func (p *ExptRegressionTurnResult_) IsValid() error {
+	return nil
+}
+func (p *ExptRegressionEvaluatorDelta) IsValid() error {
+	return nil
+}
+func (p *ExptRegressionCandidateResult_) IsValid() error {
+	if p.Result_ != nil {
+		if err := p.Result_.IsValid(); err != nil {
+			return fmt.Errorf("field Result_ not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExptRegressionItem) IsValid() error {
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExptRegressionReport) IsValid() error {
+	return nil
+}
+func (p *ModelPrice) IsValid() error {
+	return nil
+}
+func (p *ModelPriceConf) IsValid() error {
+	if p.DefaultPrice != nil {
+		if err := p.DefaultPrice.IsValid(); err != nil {
+			return fmt.Errorf("field DefaultPrice not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExptCostConf) IsValid() error {
+	if p.PriceConf != nil {
+		if err := p.PriceConf.IsValid(); err != nil {
+			return fmt.Errorf("field PriceConf not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExptCostStats) IsValid() error {
+	return nil
+}
+func (p *ExptResultCacheConf) IsValid() error {
+	return nil
+}
+func (p *ExptSamplingConf) IsValid() error {
+	return nil
+}
+func (p *ExptSamplingStratum) IsValid() error {
+	return nil
+}
+func (p *ExptSamplingResult_) IsValid() error {
+	return nil
+}
+func (p *ExptSampledEvaluatorAggr) IsValid() error {
+	return nil
+}
+func (p *ExptSampledAggrResult_) IsValid() error {
+	if p.Sampling != nil {
+		if err := p.Sampling.IsValid(); err != nil {
+			return fmt.Errorf("field Sampling not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExptTrialConf) IsValid() error {
+	return nil
+}
+func (p *EvaluatorTrialAggregate) IsValid() error {
+	return nil
+}
+func (p *ExptUserSimulatorConf) IsValid() error {
+	if p.ModelConfig != nil {
+		if err := p.ModelConfig.IsValid(); err != nil {
+			return fmt.Errorf("field ModelConfig not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExptGroupDimension) IsValid() error {
+	return nil
+}
+func (p *ExptGroupEvaluatorAggr) IsValid() error {
+	return nil
+}
+func (p *ExptAggrGroup) IsValid() error {
+	return nil
+}
+func (p *ExptGroupedAggr) IsValid() error {
+	return nil
+}
@@ -18,6 +18,12 @@ const (
 
 	EvaluatorTypeAgent = "agent"
 
+	EvaluatorTypePairwise = "pairwise"
+
+	EvaluatorTypeRule = "rule"
+
+	EvaluatorTypeComposite = "composite"
+
 	LanguageTypePython = "python"
 
 	LanguageTypeJS = "javascript"
@@ -2731,169 +2737,84 @@ func (p *AgentEvaluator) Field4DeepEqual(src *AgentEvaluatorPromptConfig) bool {
 	return true
 }
 
-// 评估器内容
-type EvaluatorContent struct {
-	IsReceiveChatHistory *bool                `thrift:"is_receive_chat_history,1,optional" frugal:"1,optional,bool" form:"is_receive_chat_history" json:"is_receive_chat_history,omitempty" query:"is_receive_chat_history"`
-	InputSchemas         []*common.ArgsSchema `thrift:"input_schemas,2,optional" frugal:"2,optional,list<common.ArgsSchema>" form:"input_schemas" json:"input_schemas,omitempty" query:"input_schemas"`
-	OutputSchemas        []*common.ArgsSchema `thrift:"output_schemas,3,optional" frugal:"3,optional,list<common.ArgsSchema>" form:"output_schemas" json:"output_schemas,omitempty" query:"output_schemas"`
-	// 101-200 Evaluator类型
-	PromptEvaluator    *PromptEvaluator    `thrift:"prompt_evaluator,101,optional" frugal:"101,optional,PromptEvaluator" form:"prompt_evaluator" json:"prompt_evaluator,omitempty" query:"prompt_evaluator"`
-	CodeEvaluator      *CodeEvaluator      `thrift:"code_evaluator,102,optional" frugal:"102,optional,CodeEvaluator" form:"code_evaluator" json:"code_evaluator,omitempty" query:"code_evaluator"`
-	CustomRPCEvaluator *CustomRPCEvaluator `thrift:"custom_rpc_evaluator,103,optional" frugal:"103,optional,CustomRPCEvaluator" form:"custom_rpc_evaluator" json:"custom_rpc_evaluator,omitempty" query:"custom_rpc_evaluator"`
-	AgentEvaluator     *AgentEvaluator     `thrift:"agent_evaluator,104,optional" frugal:"104,optional,AgentEvaluator" form:"agent_evaluator" json:"agent_evaluator,omitempty" query:"agent_evaluator"`
-}
-
-func NewEvaluatorContent() *EvaluatorContent {
-	return &EvaluatorContent{}
-}
-
-func (p *EvaluatorContent) InitDefault() {
-}
-
-var EvaluatorContent_IsReceiveChatHistory_DEFAULT bool
-
-func (p *EvaluatorContent) GetIsReceiveChatHistory() (v bool) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetIsReceiveChatHistory() {
-		return EvaluatorContent_IsReceiveChatHistory_DEFAULT
-	}
-	return *p.IsReceiveChatHistory
-}
-
-var EvaluatorContent_InputSchemas_DEFAULT []*common.ArgsSchema
-
-func (p *EvaluatorContent) GetInputSchemas() (v []*common.ArgsSchema) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetInputSchemas() {
-		return EvaluatorContent_InputSchemas_DEFAULT
-	}
-	return p.InputSchemas
+// 成对评估器, 与 domain/evaluator 对齐
+type PairwiseEvaluator struct {
+	Messages            []*common.Message   `thrift:"messages,1,optional" frugal:"1,optional,list<common.Message>" form:"messages" json:"messages,omitempty" query:"messages"`
+	ModelConfig         *common.ModelConfig `thrift:"model_config,2,optional" frugal:"2,optional,common.ModelConfig" form:"model_config" json:"model_config,omitempty" query:"model_config"`
+	DisablePositionSwap *bool               `thrift:"disable_position_swap,3,optional" frugal:"3,optional,bool" form:"disable_position_swap" json:"disable_position_swap,omitempty" query:"disable_position_swap"`
 }
 
-var EvaluatorContent_OutputSchemas_DEFAULT []*common.ArgsSchema
-
-func (p *EvaluatorContent) GetOutputSchemas() (v []*common.ArgsSchema) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetOutputSchemas() {
-		return EvaluatorContent_OutputSchemas_DEFAULT
-	}
-	return p.OutputSchemas
+func NewPairwiseEvaluator() *PairwiseEvaluator {
+	return &PairwiseEvaluator{}
 }
 
-var EvaluatorContent_PromptEvaluator_DEFAULT *PromptEvaluator
-
-func (p *EvaluatorContent) GetPromptEvaluator() (v *PromptEvaluator) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetPromptEvaluator() {
-		return EvaluatorContent_PromptEvaluator_DEFAULT
-	}
-	return p.PromptEvaluator
+func (p *PairwiseEvaluator) InitDefault() {
 }
 
-var EvaluatorContent_CodeEvaluator_DEFAULT *CodeEvaluator
+var PairwiseEvaluator_Messages_DEFAULT []*common.Message
 
-func (p *EvaluatorContent) GetCodeEvaluator() (v *CodeEvaluator) {
+func (p *PairwiseEvaluator) GetMessages() (v []*common.Message) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetCodeEvaluator() {
-		return EvaluatorContent_CodeEvaluator_DEFAULT
+	if !p.IsSetMessages() {
+		return PairwiseEvaluator_Messages_DEFAULT
 	}
-	return p.CodeEvaluator
+	return p.Messages
 }
 
-var EvaluatorContent_CustomRPCEvaluator_DEFAULT *CustomRPCEvaluator
+var PairwiseEvaluator_ModelConfig_DEFAULT *common.ModelConfig
 
-func (p *EvaluatorContent) GetCustomRPCEvaluator() (v *CustomRPCEvaluator) {
+func (p *PairwiseEvaluator) GetModelConfig() (v *common.ModelConfig) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetCustomRPCEvaluator() {
-		return EvaluatorContent_CustomRPCEvaluator_DEFAULT
+	if !p.IsSetModelConfig() {
+		return PairwiseEvaluator_ModelConfig_DEFAULT
 	}
-	return p.CustomRPCEvaluator
+	return p.ModelConfig
 }
 
-var EvaluatorContent_AgentEvaluator_DEFAULT *AgentEvaluator
+var PairwiseEvaluator_DisablePositionSwap_DEFAULT bool
 
-func (p *EvaluatorContent) GetAgentEvaluator() (v *AgentEvaluator) {
+func (p *PairwiseEvaluator) GetDisablePositionSwap() (v bool) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetAgentEvaluator() {
-		return EvaluatorContent_AgentEvaluator_DEFAULT
+	if !p.IsSetDisablePositionSwap() {
+		return PairwiseEvaluator_DisablePositionSwap_DEFAULT
 	}
-	return p.AgentEvaluator
-}
-func (p *EvaluatorContent) SetIsReceiveChatHistory(val *bool) {
-	p.IsReceiveChatHistory = val
-}
-func (p *EvaluatorContent) SetInputSchemas(val []*common.ArgsSchema) {
-	p.InputSchemas = val
-}
-func (p *EvaluatorContent) SetOutputSchemas(val []*common.ArgsSchema) {
-	p.OutputSchemas = val
-}
-func (p *EvaluatorContent) SetPromptEvaluator(val *PromptEvaluator) {
-	p.PromptEvaluator = val
-}
-func (p *EvaluatorContent) SetCodeEvaluator(val *CodeEvaluator) {
-	p.CodeEvaluator = val
-}
-func (p *EvaluatorContent) SetCustomRPCEvaluator(val *CustomRPCEvaluator) {
-	p.CustomRPCEvaluator = val
-}
-func (p *EvaluatorContent) SetAgentEvaluator(val *AgentEvaluator) {
-	p.AgentEvaluator = val
-}
-
-var fieldIDToName_EvaluatorContent = map[int16]string{
-	1:   "is_receive_chat_history",
-	2:   "input_schemas",
-	3:   "output_schemas",
-	101: "prompt_evaluator",
-	102: "code_evaluator",
-	103: "custom_rpc_evaluator",
-	104: "agent_evaluator",
+	return *p.DisablePositionSwap
 }
-
-func (p *EvaluatorContent) IsSetIsReceiveChatHistory() bool {
-	return p.IsReceiveChatHistory != nil
+func (p *PairwiseEvaluator) SetMessages(val []*common.Message) {
+	p.Messages = val
 }
-
-func (p *EvaluatorContent) IsSetInputSchemas() bool {
-	return p.InputSchemas != nil
+func (p *PairwiseEvaluator) SetModelConfig(val *common.ModelConfig) {
+	p.ModelConfig = val
 }
-
-func (p *EvaluatorContent) IsSetOutputSchemas() bool {
-	return p.OutputSchemas != nil
+func (p *PairwiseEvaluator) SetDisablePositionSwap(val *bool) {
+	p.DisablePositionSwap = val
 }
 
-func (p *EvaluatorContent) IsSetPromptEvaluator() bool {
-	return p.PromptEvaluator != nil
+var fieldIDToName_PairwiseEvaluator = map[int16]string{
+	1: "messages",
+	2: "model_config",
+	3: "disable_position_swap",
 }
 
-func (p *EvaluatorContent) IsSetCodeEvaluator() bool {
-	return p.CodeEvaluator != nil
+func (p *PairwiseEvaluator) IsSetMessages() bool {
+	return p.Messages != nil
 }
 
-func (p *EvaluatorContent) IsSetCustomRPCEvaluator() bool {
-	return p.CustomRPCEvaluator != nil
+func (p *PairwiseEvaluator) IsSetModelConfig() bool {
+	return p.ModelConfig != nil
 }
 
-func (p *EvaluatorContent) IsSetAgentEvaluator() bool {
-	return p.AgentEvaluator != nil
+func (p *PairwiseEvaluator) IsSetDisablePositionSwap() bool {
+	return p.DisablePositionSwap != nil
 }
 
-func (p *EvaluatorContent) Read(iprot thrift.TProtocol) (err error) {
+func (p *PairwiseEvaluator) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2912,7 +2833,7 @@ func (p *EvaluatorContent) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2920,7 +2841,7 @@ func (p *EvaluatorContent) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2928,45 +2849,13 @@ func (p *EvaluatorContent) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 101:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField101(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 102:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField102(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 103:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField103(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 104:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField104(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2986,7 +2875,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorContent[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PairwiseEvaluator[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2996,47 +2885,13 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorContent) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.IsReceiveChatHistory = _field
-	return nil
-}
-func (p *EvaluatorContent) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*common.ArgsSchema, 0, size)
-	values := make([]common.ArgsSchema, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.InputSchemas = _field
-	return nil
-}
-func (p *EvaluatorContent) ReadField3(iprot thrift.TProtocol) error {
+func (p *PairwiseEvaluator) ReadField1(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*common.ArgsSchema, 0, size)
-	values := make([]common.ArgsSchema, size)
+	_field := make([]*common.Message, 0, size)
+	values := make([]common.Message, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -3050,45 +2905,32 @@ func (p *EvaluatorContent) ReadField3(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.OutputSchemas = _field
-	return nil
-}
-func (p *EvaluatorContent) ReadField101(iprot thrift.TProtocol) error {
-	_field := NewPromptEvaluator()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.PromptEvaluator = _field
-	return nil
-}
-func (p *EvaluatorContent) ReadField102(iprot thrift.TProtocol) error {
-	_field := NewCodeEvaluator()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.CodeEvaluator = _field
+	p.Messages = _field
 	return nil
 }
-func (p *EvaluatorContent) ReadField103(iprot thrift.TProtocol) error {
-	_field := NewCustomRPCEvaluator()
+func (p *PairwiseEvaluator) ReadField2(iprot thrift.TProtocol) error {
+	_field := common.NewModelConfig()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.CustomRPCEvaluator = _field
+	p.ModelConfig = _field
 	return nil
 }
-func (p *EvaluatorContent) ReadField104(iprot thrift.TProtocol) error {
-	_field := NewAgentEvaluator()
-	if err := _field.Read(iprot); err != nil {
+func (p *PairwiseEvaluator) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.AgentEvaluator = _field
+	p.DisablePositionSwap = _field
 	return nil
 }
 
-func (p *EvaluatorContent) Write(oprot thrift.TProtocol) (err error) {
+func (p *PairwiseEvaluator) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorContent"); err != nil {
+	if err = oprot.WriteStructBegin("PairwiseEvaluator"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3104,12 +2946,2533 @@ func (p *EvaluatorContent) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField101(oprot); err != nil {
-			fieldId = 101
-			goto WriteFieldError
-		}
-		if err = p.writeField102(oprot); err != nil {
-			fieldId = 102
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PairwiseEvaluator) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMessages() {
+		if err = oprot.WriteFieldBegin("messages", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Messages)); err != nil {
+			return err
+		}
+		for _, v := range p.Messages {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *PairwiseEvaluator) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelConfig() {
+		if err = oprot.WriteFieldBegin("model_config", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ModelConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *PairwiseEvaluator) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDisablePositionSwap() {
+		if err = oprot.WriteFieldBegin("disable_position_swap", thrift.BOOL, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.DisablePositionSwap); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *PairwiseEvaluator) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PairwiseEvaluator(%+v)", *p)
+
+}
+
+func (p *PairwiseEvaluator) DeepEqual(ano *PairwiseEvaluator) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Messages) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ModelConfig) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.DisablePositionSwap) {
+		return false
+	}
+	return true
+}
+
+func (p *PairwiseEvaluator) Field1DeepEqual(src []*common.Message) bool {
+
+	if len(p.Messages) != len(src) {
+		return false
+	}
+	for i, v := range p.Messages {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *PairwiseEvaluator) Field2DeepEqual(src *common.ModelConfig) bool {
+
+	if !p.ModelConfig.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *PairwiseEvaluator) Field3DeepEqual(src *bool) bool {
+
+	if p.DisablePositionSwap == src {
+		return true
+	} else if p.DisablePositionSwap == nil || src == nil {
+		return false
+	}
+	if *p.DisablePositionSwap != *src {
+		return false
+	}
+	return true
+}
+
+// 规则评估器, 与 domain/evaluator 对齐
+type RuleEvaluator struct {
+	RuleType          *string  `thrift:"rule_type,1,optional" frugal:"1,optional,string" form:"rule_type" json:"rule_type,omitempty" query:"rule_type"`
+	ActualFieldKey    *string  `thrift:"actual_field_key,2,optional" frugal:"2,optional,string" form:"actual_field_key" json:"actual_field_key,omitempty" query:"actual_field_key"`
+	ReferenceFieldKey *string  `thrift:"reference_field_key,3,optional" frugal:"3,optional,string" form:"reference_field_key" json:"reference_field_key,omitempty" query:"reference_field_key"`
+	IgnoreCase        *bool    `thrift:"ignore_case,4,optional" frugal:"4,optional,bool" form:"ignore_case" json:"ignore_case,omitempty" query:"ignore_case"`
+	TrimSpace         *bool    `thrift:"trim_space,5,optional" frugal:"5,optional,bool" form:"trim_space" json:"trim_space,omitempty" query:"trim_space"`
+	Pattern           *string  `thrift:"pattern,6,optional" frugal:"6,optional,string" form:"pattern" json:"pattern,omitempty" query:"pattern"`
+	JSONSchema        *string  `thrift:"json_schema,7,optional" frugal:"7,optional,string" form:"json_schema" json:"json_schema,omitempty" query:"json_schema"`
+	Keywords          []string `thrift:"keywords,8,optional" frugal:"8,optional,list<string>" form:"keywords" json:"keywords,omitempty" query:"keywords"`
+	ContainsAll       *bool    `thrift:"contains_all,9,optional" frugal:"9,optional,bool" form:"contains_all" json:"contains_all,omitempty" query:"contains_all"`
+	Tolerance         *float64 `thrift:"tolerance,10,optional" frugal:"10,optional,double" form:"tolerance" json:"tolerance,omitempty" query:"tolerance"`
+	Relative          *bool    `thrift:"relative,11,optional" frugal:"11,optional,bool" form:"relative" json:"relative,omitempty" query:"relative"`
+	BleuMaxN          *int32   `thrift:"bleu_max_n,12,optional" frugal:"12,optional,i32" form:"bleu_max_n" json:"bleu_max_n,omitempty" query:"bleu_max_n"`
+	PassThreshold     *float64 `thrift:"pass_threshold,13,optional" frugal:"13,optional,double" form:"pass_threshold" json:"pass_threshold,omitempty" query:"pass_threshold"`
+}
+
+func NewRuleEvaluator() *RuleEvaluator {
+	return &RuleEvaluator{}
+}
+
+func (p *RuleEvaluator) InitDefault() {
+}
+
+var RuleEvaluator_RuleType_DEFAULT string
+
+func (p *RuleEvaluator) GetRuleType() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRuleType() {
+		return RuleEvaluator_RuleType_DEFAULT
+	}
+	return *p.RuleType
+}
+
+var RuleEvaluator_ActualFieldKey_DEFAULT string
+
+func (p *RuleEvaluator) GetActualFieldKey() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetActualFieldKey() {
+		return RuleEvaluator_ActualFieldKey_DEFAULT
+	}
+	return *p.ActualFieldKey
+}
+
+var RuleEvaluator_ReferenceFieldKey_DEFAULT string
+
+func (p *RuleEvaluator) GetReferenceFieldKey() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReferenceFieldKey() {
+		return RuleEvaluator_ReferenceFieldKey_DEFAULT
+	}
+	return *p.ReferenceFieldKey
+}
+
+var RuleEvaluator_IgnoreCase_DEFAULT bool
+
+func (p *RuleEvaluator) GetIgnoreCase() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetIgnoreCase() {
+		return RuleEvaluator_IgnoreCase_DEFAULT
+	}
+	return *p.IgnoreCase
+}
+
+var RuleEvaluator_TrimSpace_DEFAULT bool
+
+func (p *RuleEvaluator) GetTrimSpace() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTrimSpace() {
+		return RuleEvaluator_TrimSpace_DEFAULT
+	}
+	return *p.TrimSpace
+}
+
+var RuleEvaluator_Pattern_DEFAULT string
+
+func (p *RuleEvaluator) GetPattern() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPattern() {
+		return RuleEvaluator_Pattern_DEFAULT
+	}
+	return *p.Pattern
+}
+
+var RuleEvaluator_JSONSchema_DEFAULT string
+
+func (p *RuleEvaluator) GetJSONSchema() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetJSONSchema() {
+		return RuleEvaluator_JSONSchema_DEFAULT
+	}
+	return *p.JSONSchema
+}
+
+var RuleEvaluator_Keywords_DEFAULT []string
+
+func (p *RuleEvaluator) GetKeywords() (v []string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetKeywords() {
+		return RuleEvaluator_Keywords_DEFAULT
+	}
+	return p.Keywords
+}
+
+var RuleEvaluator_ContainsAll_DEFAULT bool
+
+func (p *RuleEvaluator) GetContainsAll() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetContainsAll() {
+		return RuleEvaluator_ContainsAll_DEFAULT
+	}
+	return *p.ContainsAll
+}
+
+var RuleEvaluator_Tolerance_DEFAULT float64
+
+func (p *RuleEvaluator) GetTolerance() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTolerance() {
+		return RuleEvaluator_Tolerance_DEFAULT
+	}
+	return *p.Tolerance
+}
+
+var RuleEvaluator_Relative_DEFAULT bool
+
+func (p *RuleEvaluator) GetRelative() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRelative() {
+		return RuleEvaluator_Relative_DEFAULT
+	}
+	return *p.Relative
+}
+
+var RuleEvaluator_BleuMaxN_DEFAULT int32
+
+func (p *RuleEvaluator) GetBleuMaxN() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBleuMaxN() {
+		return RuleEvaluator_BleuMaxN_DEFAULT
+	}
+	return *p.BleuMaxN
+}
+
+var RuleEvaluator_PassThreshold_DEFAULT float64
+
+func (p *RuleEvaluator) GetPassThreshold() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPassThreshold() {
+		return RuleEvaluator_PassThreshold_DEFAULT
+	}
+	return *p.PassThreshold
+}
+func (p *RuleEvaluator) SetRuleType(val *string) {
+	p.RuleType = val
+}
+func (p *RuleEvaluator) SetActualFieldKey(val *string) {
+	p.ActualFieldKey = val
+}
+func (p *RuleEvaluator) SetReferenceFieldKey(val *string) {
+	p.ReferenceFieldKey = val
+}
+func (p *RuleEvaluator) SetIgnoreCase(val *bool) {
+	p.IgnoreCase = val
+}
+func (p *RuleEvaluator) SetTrimSpace(val *bool) {
+	p.TrimSpace = val
+}
+func (p *RuleEvaluator) SetPattern(val *string) {
+	p.Pattern = val
+}
+func (p *RuleEvaluator) SetJSONSchema(val *string) {
+	p.JSONSchema = val
+}
+func (p *RuleEvaluator) SetKeywords(val []string) {
+	p.Keywords = val
+}
+func (p *RuleEvaluator) SetContainsAll(val *bool) {
+	p.ContainsAll = val
+}
+func (p *RuleEvaluator) SetTolerance(val *float64) {
+	p.Tolerance = val
+}
+func (p *RuleEvaluator) SetRelative(val *bool) {
+	p.Relative = val
+}
+func (p *RuleEvaluator) SetBleuMaxN(val *int32) {
+	p.BleuMaxN = val
+}
+func (p *RuleEvaluator) SetPassThreshold(val *float64) {
+	p.PassThreshold = val
+}
+
+var fieldIDToName_RuleEvaluator = map[int16]string{
+	1:  "rule_type",
+	2:  "actual_field_key",
+	3:  "reference_field_key",
+	4:  "ignore_case",
+	5:  "trim_space",
+	6:  "pattern",
+	7:  "json_schema",
+	8:  "keywords",
+	9:  "contains_all",
+	10: "tolerance",
+	11: "relative",
+	12: "bleu_max_n",
+	13: "pass_threshold",
+}
+
+func (p *RuleEvaluator) IsSetRuleType() bool {
+	return p.RuleType != nil
+}
+
+func (p *RuleEvaluator) IsSetActualFieldKey() bool {
+	return p.ActualFieldKey != nil
+}
+
+func (p *RuleEvaluator) IsSetReferenceFieldKey() bool {
+	return p.ReferenceFieldKey != nil
+}
+
+func (p *RuleEvaluator) IsSetIgnoreCase() bool {
+	return p.IgnoreCase != nil
+}
+
+func (p *RuleEvaluator) IsSetTrimSpace() bool {
+	return p.TrimSpace != nil
+}
+
+func (p *RuleEvaluator) IsSetPattern() bool {
+	return p.Pattern != nil
+}
+
+func (p *RuleEvaluator) IsSetJSONSchema() bool {
+	return p.JSONSchema != nil
+}
+
+func (p *RuleEvaluator) IsSetKeywords() bool {
+	return p.Keywords != nil
+}
+
+func (p *RuleEvaluator) IsSetContainsAll() bool {
+	return p.ContainsAll != nil
+}
+
+func (p *RuleEvaluator) IsSetTolerance() bool {
+	return p.Tolerance != nil
+}
+
+func (p *RuleEvaluator) IsSetRelative() bool {
+	return p.Relative != nil
+}
+
+func (p *RuleEvaluator) IsSetBleuMaxN() bool {
+	return p.BleuMaxN != nil
+}
+
+func (p *RuleEvaluator) IsSetPassThreshold() bool {
+	return p.PassThreshold != nil
+}
+
+func (p *RuleEvaluator) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleEvaluator[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RuleEvaluator) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RuleType = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ActualFieldKey = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ReferenceFieldKey = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IgnoreCase = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TrimSpace = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Pattern = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.JSONSchema = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Keywords = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ContainsAll = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Tolerance = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Relative = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BleuMaxN = _field
+	return nil
+}
+func (p *RuleEvaluator) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PassThreshold = _field
+	return nil
+}
+
+func (p *RuleEvaluator) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RuleEvaluator"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RuleEvaluator) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRuleType() {
+		if err = oprot.WriteFieldBegin("rule_type", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RuleType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetActualFieldKey() {
+		if err = oprot.WriteFieldBegin("actual_field_key", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ActualFieldKey); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReferenceFieldKey() {
+		if err = oprot.WriteFieldBegin("reference_field_key", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ReferenceFieldKey); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIgnoreCase() {
+		if err = oprot.WriteFieldBegin("ignore_case", thrift.BOOL, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.IgnoreCase); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTrimSpace() {
+		if err = oprot.WriteFieldBegin("trim_space", thrift.BOOL, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.TrimSpace); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPattern() {
+		if err = oprot.WriteFieldBegin("pattern", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Pattern); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetJSONSchema() {
+		if err = oprot.WriteFieldBegin("json_schema", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.JSONSchema); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeywords() {
+		if err = oprot.WriteFieldBegin("keywords", thrift.LIST, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Keywords)); err != nil {
+			return err
+		}
+		for _, v := range p.Keywords {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetContainsAll() {
+		if err = oprot.WriteFieldBegin("contains_all", thrift.BOOL, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.ContainsAll); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTolerance() {
+		if err = oprot.WriteFieldBegin("tolerance", thrift.DOUBLE, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Tolerance); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRelative() {
+		if err = oprot.WriteFieldBegin("relative", thrift.BOOL, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Relative); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBleuMaxN() {
+		if err = oprot.WriteFieldBegin("bleu_max_n", thrift.I32, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.BleuMaxN); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *RuleEvaluator) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPassThreshold() {
+		if err = oprot.WriteFieldBegin("pass_threshold", thrift.DOUBLE, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.PassThreshold); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+
+func (p *RuleEvaluator) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RuleEvaluator(%+v)", *p)
+
+}
+
+func (p *RuleEvaluator) DeepEqual(ano *RuleEvaluator) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.RuleType) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ActualFieldKey) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ReferenceFieldKey) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.IgnoreCase) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.TrimSpace) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Pattern) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.JSONSchema) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.Keywords) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.ContainsAll) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.Tolerance) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.Relative) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.BleuMaxN) {
+		return false
+	}
+	if !p.Field13DeepEqual(ano.PassThreshold) {
+		return false
+	}
+	return true
+}
+
+func (p *RuleEvaluator) Field1DeepEqual(src *string) bool {
+
+	if p.RuleType == src {
+		return true
+	} else if p.RuleType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.RuleType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RuleEvaluator) Field2DeepEqual(src *string) bool {
+
+	if p.ActualFieldKey == src {
+		return true
+	} else if p.ActualFieldKey == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ActualFieldKey, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RuleEvaluator) Field3DeepEqual(src *string) bool {
+
+	if p.ReferenceFieldKey == src {
+		return true
+	} else if p.ReferenceFieldKey == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ReferenceFieldKey, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RuleEvaluator) Field4DeepEqual(src *bool) bool {
+
+	if p.IgnoreCase == src {
+		return true
+	} else if p.IgnoreCase == nil || src == nil {
+		return false
+	}
+	if *p.IgnoreCase != *src {
+		return false
+	}
+	return true
+}
+func (p *RuleEvaluator) Field5DeepEqual(src *bool) bool {
+
+	if p.TrimSpace == src {
+		return true
+	} else if p.TrimSpace == nil || src == nil {
+		return false
+	}
+	if *p.TrimSpace != *src {
+		return false
+	}
+	return true
+}
+func (p *RuleEvaluator) Field6DeepEqual(src *string) bool {
+
+	if p.Pattern == src {
+		return true
+	} else if p.Pattern == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Pattern, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RuleEvaluator) Field7DeepEqual(src *string) bool {
+
+	if p.JSONSchema == src {
+		return true
+	} else if p.JSONSchema == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.JSONSchema, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RuleEvaluator) Field8DeepEqual(src []string) bool {
+
+	if len(p.Keywords) != len(src) {
+		return false
+	}
+	for i, v := range p.Keywords {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *RuleEvaluator) Field9DeepEqual(src *bool) bool {
+
+	if p.ContainsAll == src {
+		return true
+	} else if p.ContainsAll == nil || src == nil {
+		return false
+	}
+	if *p.ContainsAll != *src {
+		return false
+	}
+	return true
+}
+func (p *RuleEvaluator) Field10DeepEqual(src *float64) bool {
+
+	if p.Tolerance == src {
+		return true
+	} else if p.Tolerance == nil || src == nil {
+		return false
+	}
+	if *p.Tolerance != *src {
+		return false
+	}
+	return true
+}
+func (p *RuleEvaluator) Field11DeepEqual(src *bool) bool {
+
+	if p.Relative == src {
+		return true
+	} else if p.Relative == nil || src == nil {
+		return false
+	}
+	if *p.Relative != *src {
+		return false
+	}
+	return true
+}
+func (p *RuleEvaluator) Field12DeepEqual(src *int32) bool {
+
+	if p.BleuMaxN == src {
+		return true
+	} else if p.BleuMaxN == nil || src == nil {
+		return false
+	}
+	if *p.BleuMaxN != *src {
+		return false
+	}
+	return true
+}
+func (p *RuleEvaluator) Field13DeepEqual(src *float64) bool {
+
+	if p.PassThreshold == src {
+		return true
+	} else if p.PassThreshold == nil || src == nil {
+		return false
+	}
+	if *p.PassThreshold != *src {
+		return false
+	}
+	return true
+}
+
+// 组合评估器组件
+type CompositeComponent struct {
+	EvaluatorVersionID *int64   `thrift:"evaluator_version_id,1,optional" frugal:"1,optional,i64" json:"evaluator_version_id" form:"evaluator_version_id" query:"evaluator_version_id"`
+	Weight             *float64 `thrift:"weight,2,optional" frugal:"2,optional,double" form:"weight" json:"weight,omitempty" query:"weight"`
+}
+
+func NewCompositeComponent() *CompositeComponent {
+	return &CompositeComponent{}
+}
+
+func (p *CompositeComponent) InitDefault() {
+}
+
+var CompositeComponent_EvaluatorVersionID_DEFAULT int64
+
+func (p *CompositeComponent) GetEvaluatorVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorVersionID() {
+		return CompositeComponent_EvaluatorVersionID_DEFAULT
+	}
+	return *p.EvaluatorVersionID
+}
+
+var CompositeComponent_Weight_DEFAULT float64
+
+func (p *CompositeComponent) GetWeight() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWeight() {
+		return CompositeComponent_Weight_DEFAULT
+	}
+	return *p.Weight
+}
+func (p *CompositeComponent) SetEvaluatorVersionID(val *int64) {
+	p.EvaluatorVersionID = val
+}
+func (p *CompositeComponent) SetWeight(val *float64) {
+	p.Weight = val
+}
+
+var fieldIDToName_CompositeComponent = map[int16]string{
+	1: "evaluator_version_id",
+	2: "weight",
+}
+
+func (p *CompositeComponent) IsSetEvaluatorVersionID() bool {
+	return p.EvaluatorVersionID != nil
+}
+
+func (p *CompositeComponent) IsSetWeight() bool {
+	return p.Weight != nil
+}
+
+func (p *CompositeComponent) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompositeComponent[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CompositeComponent) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatorVersionID = _field
+	return nil
+}
+func (p *CompositeComponent) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Weight = _field
+	return nil
+}
+
+func (p *CompositeComponent) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CompositeComponent"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CompositeComponent) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersionID() {
+		if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluatorVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CompositeComponent) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWeight() {
+		if err = oprot.WriteFieldBegin("weight", thrift.DOUBLE, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Weight); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CompositeComponent) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CompositeComponent(%+v)", *p)
+
+}
+
+func (p *CompositeComponent) DeepEqual(ano *CompositeComponent) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Weight) {
+		return false
+	}
+	return true
+}
+
+func (p *CompositeComponent) Field1DeepEqual(src *int64) bool {
+
+	if p.EvaluatorVersionID == src {
+		return true
+	} else if p.EvaluatorVersionID == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatorVersionID != *src {
+		return false
+	}
+	return true
+}
+func (p *CompositeComponent) Field2DeepEqual(src *float64) bool {
+
+	if p.Weight == src {
+		return true
+	} else if p.Weight == nil || src == nil {
+		return false
+	}
+	if *p.Weight != *src {
+		return false
+	}
+	return true
+}
+
+// 组合评估器, 与 domain/evaluator 对齐
+type CompositeEvaluator struct {
+	Components    []*CompositeComponent `thrift:"components,1,optional" frugal:"1,optional,list<CompositeComponent>" form:"components" json:"components,omitempty" query:"components"`
+	Strategy      *string               `thrift:"strategy,2,optional" frugal:"2,optional,string" form:"strategy" json:"strategy,omitempty" query:"strategy"`
+	MinSuccessCnt *int32                `thrift:"min_success_cnt,3,optional" frugal:"3,optional,i32" form:"min_success_cnt" json:"min_success_cnt,omitempty" query:"min_success_cnt"`
+	CodeContent   *string               `thrift:"code_content,4,optional" frugal:"4,optional,string" form:"code_content" json:"code_content,omitempty" query:"code_content"`
+	LanguageType  *LanguageType         `thrift:"language_type,5,optional" frugal:"5,optional,string" form:"language_type" json:"language_type,omitempty" query:"language_type"`
+}
+
+func NewCompositeEvaluator() *CompositeEvaluator {
+	return &CompositeEvaluator{}
+}
+
+func (p *CompositeEvaluator) InitDefault() {
+}
+
+var CompositeEvaluator_Components_DEFAULT []*CompositeComponent
+
+func (p *CompositeEvaluator) GetComponents() (v []*CompositeComponent) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetComponents() {
+		return CompositeEvaluator_Components_DEFAULT
+	}
+	return p.Components
+}
+
+var CompositeEvaluator_Strategy_DEFAULT string
+
+func (p *CompositeEvaluator) GetStrategy() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStrategy() {
+		return CompositeEvaluator_Strategy_DEFAULT
+	}
+	return *p.Strategy
+}
+
+var CompositeEvaluator_MinSuccessCnt_DEFAULT int32
+
+func (p *CompositeEvaluator) GetMinSuccessCnt() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMinSuccessCnt() {
+		return CompositeEvaluator_MinSuccessCnt_DEFAULT
+	}
+	return *p.MinSuccessCnt
+}
+
+var CompositeEvaluator_CodeContent_DEFAULT string
+
+func (p *CompositeEvaluator) GetCodeContent() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCodeContent() {
+		return CompositeEvaluator_CodeContent_DEFAULT
+	}
+	return *p.CodeContent
+}
+
+var CompositeEvaluator_LanguageType_DEFAULT LanguageType
+
+func (p *CompositeEvaluator) GetLanguageType() (v LanguageType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLanguageType() {
+		return CompositeEvaluator_LanguageType_DEFAULT
+	}
+	return *p.LanguageType
+}
+func (p *CompositeEvaluator) SetComponents(val []*CompositeComponent) {
+	p.Components = val
+}
+func (p *CompositeEvaluator) SetStrategy(val *string) {
+	p.Strategy = val
+}
+func (p *CompositeEvaluator) SetMinSuccessCnt(val *int32) {
+	p.MinSuccessCnt = val
+}
+func (p *CompositeEvaluator) SetCodeContent(val *string) {
+	p.CodeContent = val
+}
+func (p *CompositeEvaluator) SetLanguageType(val *LanguageType) {
+	p.LanguageType = val
+}
+
+var fieldIDToName_CompositeEvaluator = map[int16]string{
+	1: "components",
+	2: "strategy",
+	3: "min_success_cnt",
+	4: "code_content",
+	5: "language_type",
+}
+
+func (p *CompositeEvaluator) IsSetComponents() bool {
+	return p.Components != nil
+}
+
+func (p *CompositeEvaluator) IsSetStrategy() bool {
+	return p.Strategy != nil
+}
+
+func (p *CompositeEvaluator) IsSetMinSuccessCnt() bool {
+	return p.MinSuccessCnt != nil
+}
+
+func (p *CompositeEvaluator) IsSetCodeContent() bool {
+	return p.CodeContent != nil
+}
+
+func (p *CompositeEvaluator) IsSetLanguageType() bool {
+	return p.LanguageType != nil
+}
+
+func (p *CompositeEvaluator) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompositeEvaluator[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CompositeEvaluator) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*CompositeComponent, 0, size)
+	values := make([]CompositeComponent, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Components = _field
+	return nil
+}
+func (p *CompositeEvaluator) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Strategy = _field
+	return nil
+}
+func (p *CompositeEvaluator) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MinSuccessCnt = _field
+	return nil
+}
+func (p *CompositeEvaluator) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CodeContent = _field
+	return nil
+}
+func (p *CompositeEvaluator) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *LanguageType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LanguageType = _field
+	return nil
+}
+
+func (p *CompositeEvaluator) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CompositeEvaluator"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CompositeEvaluator) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetComponents() {
+		if err = oprot.WriteFieldBegin("components", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Components)); err != nil {
+			return err
+		}
+		for _, v := range p.Components {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CompositeEvaluator) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStrategy() {
+		if err = oprot.WriteFieldBegin("strategy", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Strategy); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *CompositeEvaluator) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMinSuccessCnt() {
+		if err = oprot.WriteFieldBegin("min_success_cnt", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.MinSuccessCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *CompositeEvaluator) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCodeContent() {
+		if err = oprot.WriteFieldBegin("code_content", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.CodeContent); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *CompositeEvaluator) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLanguageType() {
+		if err = oprot.WriteFieldBegin("language_type", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.LanguageType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *CompositeEvaluator) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CompositeEvaluator(%+v)", *p)
+
+}
+
+func (p *CompositeEvaluator) DeepEqual(ano *CompositeEvaluator) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Components) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Strategy) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.MinSuccessCnt) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.CodeContent) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.LanguageType) {
+		return false
+	}
+	return true
+}
+
+func (p *CompositeEvaluator) Field1DeepEqual(src []*CompositeComponent) bool {
+
+	if len(p.Components) != len(src) {
+		return false
+	}
+	for i, v := range p.Components {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *CompositeEvaluator) Field2DeepEqual(src *string) bool {
+
+	if p.Strategy == src {
+		return true
+	} else if p.Strategy == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Strategy, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CompositeEvaluator) Field3DeepEqual(src *int32) bool {
+
+	if p.MinSuccessCnt == src {
+		return true
+	} else if p.MinSuccessCnt == nil || src == nil {
+		return false
+	}
+	if *p.MinSuccessCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *CompositeEvaluator) Field4DeepEqual(src *string) bool {
+
+	if p.CodeContent == src {
+		return true
+	} else if p.CodeContent == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.CodeContent, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CompositeEvaluator) Field5DeepEqual(src *LanguageType) bool {
+
+	if p.LanguageType == src {
+		return true
+	} else if p.LanguageType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.LanguageType, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+// 评估器内容
+type EvaluatorContent struct {
+	IsReceiveChatHistory *bool                `thrift:"is_receive_chat_history,1,optional" frugal:"1,optional,bool" form:"is_receive_chat_history" json:"is_receive_chat_history,omitempty" query:"is_receive_chat_history"`
+	InputSchemas         []*common.ArgsSchema `thrift:"input_schemas,2,optional" frugal:"2,optional,list<common.ArgsSchema>" form:"input_schemas" json:"input_schemas,omitempty" query:"input_schemas"`
+	OutputSchemas        []*common.ArgsSchema `thrift:"output_schemas,3,optional" frugal:"3,optional,list<common.ArgsSchema>" form:"output_schemas" json:"output_schemas,omitempty" query:"output_schemas"`
+	// 101-200 Evaluator类型
+	PromptEvaluator    *PromptEvaluator    `thrift:"prompt_evaluator,101,optional" frugal:"101,optional,PromptEvaluator" form:"prompt_evaluator" json:"prompt_evaluator,omitempty" query:"prompt_evaluator"`
+	CodeEvaluator      *CodeEvaluator      `thrift:"code_evaluator,102,optional" frugal:"102,optional,CodeEvaluator" form:"code_evaluator" json:"code_evaluator,omitempty" query:"code_evaluator"`
+	CustomRPCEvaluator *CustomRPCEvaluator `thrift:"custom_rpc_evaluator,103,optional" frugal:"103,optional,CustomRPCEvaluator" form:"custom_rpc_evaluator" json:"custom_rpc_evaluator,omitempty" query:"custom_rpc_evaluator"`
+	AgentEvaluator     *AgentEvaluator     `thrift:"agent_evaluator,104,optional" frugal:"104,optional,AgentEvaluator" form:"agent_evaluator" json:"agent_evaluator,omitempty" query:"agent_evaluator"`
+	PairwiseEvaluator  *PairwiseEvaluator  `thrift:"pairwise_evaluator,105,optional" frugal:"105,optional,PairwiseEvaluator" form:"pairwise_evaluator" json:"pairwise_evaluator,omitempty" query:"pairwise_evaluator"`
+	RuleEvaluator      *RuleEvaluator      `thrift:"rule_evaluator,106,optional" frugal:"106,optional,RuleEvaluator" form:"rule_evaluator" json:"rule_evaluator,omitempty" query:"rule_evaluator"`
+	CompositeEvaluator *CompositeEvaluator `thrift:"composite_evaluator,107,optional" frugal:"107,optional,CompositeEvaluator" form:"composite_evaluator" json:"composite_evaluator,omitempty" query:"composite_evaluator"`
+}
+
+func NewEvaluatorContent() *EvaluatorContent {
+	return &EvaluatorContent{}
+}
+
+func (p *EvaluatorContent) InitDefault() {
+}
+
+var EvaluatorContent_IsReceiveChatHistory_DEFAULT bool
+
+func (p *EvaluatorContent) GetIsReceiveChatHistory() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetIsReceiveChatHistory() {
+		return EvaluatorContent_IsReceiveChatHistory_DEFAULT
+	}
+	return *p.IsReceiveChatHistory
+}
+
+var EvaluatorContent_InputSchemas_DEFAULT []*common.ArgsSchema
+
+func (p *EvaluatorContent) GetInputSchemas() (v []*common.ArgsSchema) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetInputSchemas() {
+		return EvaluatorContent_InputSchemas_DEFAULT
+	}
+	return p.InputSchemas
+}
+
+var EvaluatorContent_OutputSchemas_DEFAULT []*common.ArgsSchema
+
+func (p *EvaluatorContent) GetOutputSchemas() (v []*common.ArgsSchema) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOutputSchemas() {
+		return EvaluatorContent_OutputSchemas_DEFAULT
+	}
+	return p.OutputSchemas
+}
+
+var EvaluatorContent_PromptEvaluator_DEFAULT *PromptEvaluator
+
+func (p *EvaluatorContent) GetPromptEvaluator() (v *PromptEvaluator) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPromptEvaluator() {
+		return EvaluatorContent_PromptEvaluator_DEFAULT
+	}
+	return p.PromptEvaluator
+}
+
+var EvaluatorContent_CodeEvaluator_DEFAULT *CodeEvaluator
+
+func (p *EvaluatorContent) GetCodeEvaluator() (v *CodeEvaluator) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCodeEvaluator() {
+		return EvaluatorContent_CodeEvaluator_DEFAULT
+	}
+	return p.CodeEvaluator
+}
+
+var EvaluatorContent_CustomRPCEvaluator_DEFAULT *CustomRPCEvaluator
+
+func (p *EvaluatorContent) GetCustomRPCEvaluator() (v *CustomRPCEvaluator) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCustomRPCEvaluator() {
+		return EvaluatorContent_CustomRPCEvaluator_DEFAULT
+	}
+	return p.CustomRPCEvaluator
+}
+
+var EvaluatorContent_AgentEvaluator_DEFAULT *AgentEvaluator
+
+func (p *EvaluatorContent) GetAgentEvaluator() (v *AgentEvaluator) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAgentEvaluator() {
+		return EvaluatorContent_AgentEvaluator_DEFAULT
+	}
+	return p.AgentEvaluator
+}
+
+var EvaluatorContent_PairwiseEvaluator_DEFAULT *PairwiseEvaluator
+
+func (p *EvaluatorContent) GetPairwiseEvaluator() (v *PairwiseEvaluator) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPairwiseEvaluator() {
+		return EvaluatorContent_PairwiseEvaluator_DEFAULT
+	}
+	return p.PairwiseEvaluator
+}
+
+var EvaluatorContent_RuleEvaluator_DEFAULT *RuleEvaluator
+
+func (p *EvaluatorContent) GetRuleEvaluator() (v *RuleEvaluator) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRuleEvaluator() {
+		return EvaluatorContent_RuleEvaluator_DEFAULT
+	}
+	return p.RuleEvaluator
+}
+
+var EvaluatorContent_CompositeEvaluator_DEFAULT *CompositeEvaluator
+
+func (p *EvaluatorContent) GetCompositeEvaluator() (v *CompositeEvaluator) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCompositeEvaluator() {
+		return EvaluatorContent_CompositeEvaluator_DEFAULT
+	}
+	return p.CompositeEvaluator
+}
+func (p *EvaluatorContent) SetIsReceiveChatHistory(val *bool) {
+	p.IsReceiveChatHistory = val
+}
+func (p *EvaluatorContent) SetInputSchemas(val []*common.ArgsSchema) {
+	p.InputSchemas = val
+}
+func (p *EvaluatorContent) SetOutputSchemas(val []*common.ArgsSchema) {
+	p.OutputSchemas = val
+}
+func (p *EvaluatorContent) SetPromptEvaluator(val *PromptEvaluator) {
+	p.PromptEvaluator = val
+}
+func (p *EvaluatorContent) SetCodeEvaluator(val *CodeEvaluator) {
+	p.CodeEvaluator = val
+}
+func (p *EvaluatorContent) SetCustomRPCEvaluator(val *CustomRPCEvaluator) {
+	p.CustomRPCEvaluator = val
+}
+func (p *EvaluatorContent) SetAgentEvaluator(val *AgentEvaluator) {
+	p.AgentEvaluator = val
+}
+func (p *EvaluatorContent) SetPairwiseEvaluator(val *PairwiseEvaluator) {
+	p.PairwiseEvaluator = val
+}
+func (p *EvaluatorContent) SetRuleEvaluator(val *RuleEvaluator) {
+	p.RuleEvaluator = val
+}
+func (p *EvaluatorContent) SetCompositeEvaluator(val *CompositeEvaluator) {
+	p.CompositeEvaluator = val
+}
+
+var fieldIDToName_EvaluatorContent = map[int16]string{
+	1:   "is_receive_chat_history",
+	2:   "input_schemas",
+	3:   "output_schemas",
+	101: "prompt_evaluator",
+	102: "code_evaluator",
+	103: "custom_rpc_evaluator",
+	104: "agent_evaluator",
+	105: "pairwise_evaluator",
+	106: "rule_evaluator",
+	107: "composite_evaluator",
+}
+
+func (p *EvaluatorContent) IsSetIsReceiveChatHistory() bool {
+	return p.IsReceiveChatHistory != nil
+}
+
+func (p *EvaluatorContent) IsSetInputSchemas() bool {
+	return p.InputSchemas != nil
+}
+
+func (p *EvaluatorContent) IsSetOutputSchemas() bool {
+	return p.OutputSchemas != nil
+}
+
+func (p *EvaluatorContent) IsSetPromptEvaluator() bool {
+	return p.PromptEvaluator != nil
+}
+
+func (p *EvaluatorContent) IsSetCodeEvaluator() bool {
+	return p.CodeEvaluator != nil
+}
+
+func (p *EvaluatorContent) IsSetCustomRPCEvaluator() bool {
+	return p.CustomRPCEvaluator != nil
+}
+
+func (p *EvaluatorContent) IsSetAgentEvaluator() bool {
+	return p.AgentEvaluator != nil
+}
+
+func (p *EvaluatorContent) IsSetPairwiseEvaluator() bool {
+	return p.PairwiseEvaluator != nil
+}
+
+func (p *EvaluatorContent) IsSetRuleEvaluator() bool {
+	return p.RuleEvaluator != nil
+}
+
+func (p *EvaluatorContent) IsSetCompositeEvaluator() bool {
+	return p.CompositeEvaluator != nil
+}
+
+func (p *EvaluatorContent) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 101:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField101(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 102:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField102(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 103:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField103(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 104:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField104(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 105:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField105(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 106:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField106(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 107:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField107(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorContent[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorContent) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IsReceiveChatHistory = _field
+	return nil
+}
+func (p *EvaluatorContent) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*common.ArgsSchema, 0, size)
+	values := make([]common.ArgsSchema, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.InputSchemas = _field
+	return nil
+}
+func (p *EvaluatorContent) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*common.ArgsSchema, 0, size)
+	values := make([]common.ArgsSchema, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.OutputSchemas = _field
+	return nil
+}
+func (p *EvaluatorContent) ReadField101(iprot thrift.TProtocol) error {
+	_field := NewPromptEvaluator()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.PromptEvaluator = _field
+	return nil
+}
+func (p *EvaluatorContent) ReadField102(iprot thrift.TProtocol) error {
+	_field := NewCodeEvaluator()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.CodeEvaluator = _field
+	return nil
+}
+func (p *EvaluatorContent) ReadField103(iprot thrift.TProtocol) error {
+	_field := NewCustomRPCEvaluator()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.CustomRPCEvaluator = _field
+	return nil
+}
+func (p *EvaluatorContent) ReadField104(iprot thrift.TProtocol) error {
+	_field := NewAgentEvaluator()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.AgentEvaluator = _field
+	return nil
+}
+func (p *EvaluatorContent) ReadField105(iprot thrift.TProtocol) error {
+	_field := NewPairwiseEvaluator()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.PairwiseEvaluator = _field
+	return nil
+}
+func (p *EvaluatorContent) ReadField106(iprot thrift.TProtocol) error {
+	_field := NewRuleEvaluator()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.RuleEvaluator = _field
+	return nil
+}
+func (p *EvaluatorContent) ReadField107(iprot thrift.TProtocol) error {
+	_field := NewCompositeEvaluator()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.CompositeEvaluator = _field
+	return nil
+}
+
+func (p *EvaluatorContent) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorContent"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField101(oprot); err != nil {
+			fieldId = 101
+			goto WriteFieldError
+		}
+		if err = p.writeField102(oprot); err != nil {
+			fieldId = 102
 			goto WriteFieldError
 		}
 		if err = p.writeField103(oprot); err != nil {
@@ -3120,6 +5483,18 @@ func (p *EvaluatorContent) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 104
 			goto WriteFieldError
 		}
+		if err = p.writeField105(oprot); err != nil {
+			fieldId = 105
+			goto WriteFieldError
+		}
+		if err = p.writeField106(oprot); err != nil {
+			fieldId = 106
+			goto WriteFieldError
+		}
+		if err = p.writeField107(oprot); err != nil {
+			fieldId = 107
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3280,6 +5655,60 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 104 end error: ", p), err)
 }
+func (p *EvaluatorContent) writeField105(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPairwiseEvaluator() {
+		if err = oprot.WriteFieldBegin("pairwise_evaluator", thrift.STRUCT, 105); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.PairwiseEvaluator.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 105 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 105 end error: ", p), err)
+}
+func (p *EvaluatorContent) writeField106(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRuleEvaluator() {
+		if err = oprot.WriteFieldBegin("rule_evaluator", thrift.STRUCT, 106); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.RuleEvaluator.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 106 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 106 end error: ", p), err)
+}
+func (p *EvaluatorContent) writeField107(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCompositeEvaluator() {
+		if err = oprot.WriteFieldBegin("composite_evaluator", thrift.STRUCT, 107); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.CompositeEvaluator.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 107 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 107 end error: ", p), err)
+}
 
 func (p *EvaluatorContent) String() string {
 	if p == nil {
@@ -3316,6 +5745,15 @@ func (p *EvaluatorContent) DeepEqual(ano *EvaluatorContent) bool {
 	if !p.Field104DeepEqual(ano.AgentEvaluator) {
 		return false
 	}
+	if !p.Field105DeepEqual(ano.PairwiseEvaluator) {
+		return false
+	}
+	if !p.Field106DeepEqual(ano.RuleEvaluator) {
+		return false
+	}
+	if !p.Field107DeepEqual(ano.CompositeEvaluator) {
+		return false
+	}
 	return true
 }
 
@@ -3385,6 +5823,27 @@ func (p *EvaluatorContent) Field104DeepEqual(src *AgentEvaluator) bool {
 	}
 	return true
 }
+func (p *EvaluatorContent) Field105DeepEqual(src *PairwiseEvaluator) bool {
+
+	if !p.PairwiseEvaluator.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorContent) Field106DeepEqual(src *RuleEvaluator) bool {
+
+	if !p.RuleEvaluator.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorContent) Field107DeepEqual(src *CompositeEvaluator) bool {
+
+	if !p.CompositeEvaluator.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 
 // 评估器版本
 type EvaluatorVersion struct {
@@ -97,6 +97,23 @@ func (p *AgentEvaluator) IsValid() error {
 	}
 	return nil
 }
+func (p *PairwiseEvaluator) IsValid() error {
+	if p.ModelConfig != nil {
+		if err := p.ModelConfig.IsValid(); err != nil {
+			return fmt.Errorf("field ModelConfig not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *RuleEvaluator) IsValid() error {
+	return nil
+}
+func (p *CompositeComponent) IsValid() error {
+	return nil
+}
+func (p *CompositeEvaluator) IsValid() error {
+	return nil
+}
 func (p *EvaluatorContent) IsValid() error {
 	if p.PromptEvaluator != nil {
 		if err := p.PromptEvaluator.IsValid(); err != nil {
@@ -118,6 +135,21 @@ func (p *EvaluatorContent) IsValid() error {
 			return fmt.Errorf("field AgentEvaluator not valid, %w", err)
 		}
 	}
+	if p.PairwiseEvaluator != nil {
+		if err := p.PairwiseEvaluator.IsValid(); err != nil {
+			return fmt.Errorf("field PairwiseEvaluator not valid, %w", err)
+		}
+	}
+	if p.RuleEvaluator != nil {
+		if err := p.RuleEvaluator.IsValid(); err != nil {
+			return fmt.Errorf("field RuleEvaluator not valid, %w", err)
+		}
+	}
+	if p.CompositeEvaluator != nil {
+		if err := p.CompositeEvaluator.IsValid(); err != nil {
+			return fmt.Errorf("field CompositeEvaluator not valid, %w", err)
+		}
+	}
 	return nil
 }
 func (p *EvaluatorVersion) IsValid() error {
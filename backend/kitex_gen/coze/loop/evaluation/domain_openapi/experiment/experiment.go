@@ -227,6 +227,20 @@ const (
 	CSVExportStatusSuccess = "Success"
 
 	CSVExportStatusFailed = "Failed"
+
+	QualityGateMetricEvaluatorAvgScore = "evaluator_avg_score"
+
+	QualityGateMetricWeightedAvgScore = "weighted_avg_score"
+
+	QualityGateMetricFailRate = "fail_rate"
+
+	QualityGateMetricRegressionRate = "regression_rate"
+
+	QualityGateStatusPassed = "passed"
+
+	QualityGateStatusFailed = "failed"
+
+	QualityGateStatusError = "error"
 )
 
 // 实验状态
@@ -285,6 +299,15 @@ type ExptResultExportType = string
 // CSV 导出任务状态
 type CSVExportStatus = string
 
+// ===============================
+// 质量门禁
+// ===============================
+// 门禁规则度量指标
+type QualityGateMetric = string
+
+// 门禁评估结论
+type QualityGateStatus = string
+
 // 字段映射
 type FieldMapping struct {
 	FieldName     *string `thrift:"field_name,1,optional" frugal:"1,optional,string" form:"field_name" json:"field_name,omitempty" query:"field_name"`
@@ -7568,8 +7591,11 @@ type Experiment struct {
 	// 跑法配置回显。字段号 115 与 domain/expt.thrift 的 struct Experiment 刻意对齐, 便于两套
 	// 读模型对照 —— 它们是同一个概念的两种表示 (本文件用字符串枚举, domain 用整数枚举)。
 	// 用本文件已有的 RunModeConfig (字符串枚举), 不要 include domain/expt.thrift —— 会符号冲突。
-	RunModeConfig *RunModeConfig   `thrift:"run_mode_config,115,optional" frugal:"115,optional,RunModeConfig" form:"run_mode_config" json:"run_mode_config,omitempty" query:"run_mode_config"`
-	BaseInfo      *common.BaseInfo `thrift:"base_info,100,optional" frugal:"100,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+	RunModeConfig *RunModeConfig `thrift:"run_mode_config,115,optional" frugal:"115,optional,RunModeConfig" form:"run_mode_config" json:"run_mode_config,omitempty" query:"run_mode_config"`
+	// 质量门禁配置与评估结果 (与 domain Experiment 116~117 同义); 结果在实验结束后异步写入, 评估完成前为空
+	QualityGateConf    *ExptQualityGateConf    `thrift:"quality_gate_conf,116,optional" frugal:"116,optional,ExptQualityGateConf" form:"quality_gate_conf" json:"quality_gate_conf,omitempty" query:"quality_gate_conf"`
+	QualityGateResult_ *ExptQualityGateResult_ `thrift:"quality_gate_result,117,optional" frugal:"117,optional,ExptQualityGateResult_" form:"quality_gate_result" json:"quality_gate_result,omitempty" query:"quality_gate_result"`
+	BaseInfo           *common.BaseInfo        `thrift:"base_info,100,optional" frugal:"100,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
 }
 
 func NewExperiment() *Experiment {
@@ -7891,6 +7917,30 @@ func (p *Experiment) GetRunModeConfig() (v *RunModeConfig) {
 	return p.RunModeConfig
 }
 
+var Experiment_QualityGateConf_DEFAULT *ExptQualityGateConf
+
+func (p *Experiment) GetQualityGateConf() (v *ExptQualityGateConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetQualityGateConf() {
+		return Experiment_QualityGateConf_DEFAULT
+	}
+	return p.QualityGateConf
+}
+
+var Experiment_QualityGateResult__DEFAULT *ExptQualityGateResult_
+
+func (p *Experiment) GetQualityGateResult_() (v *ExptQualityGateResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetQualityGateResult_() {
+		return Experiment_QualityGateResult__DEFAULT
+	}
+	return p.QualityGateResult_
+}
+
 var Experiment_BaseInfo_DEFAULT *common.BaseInfo
 
 func (p *Experiment) GetBaseInfo() (v *common.BaseInfo) {
@@ -7980,6 +8030,12 @@ func (p *Experiment) SetTotalItemCount(val *int64) {
 func (p *Experiment) SetRunModeConfig(val *RunModeConfig) {
 	p.RunModeConfig = val
 }
+func (p *Experiment) SetQualityGateConf(val *ExptQualityGateConf) {
+	p.QualityGateConf = val
+}
+func (p *Experiment) SetQualityGateResult_(val *ExptQualityGateResult_) {
+	p.QualityGateResult_ = val
+}
 func (p *Experiment) SetBaseInfo(val *common.BaseInfo) {
 	p.BaseInfo = val
 }
@@ -8011,6 +8067,8 @@ var fieldIDToName_Experiment = map[int16]string{
 	113: "evaluators_concur_num",
 	114: "total_item_count",
 	115: "run_mode_config",
+	116: "quality_gate_conf",
+	117: "quality_gate_result",
 	100: "base_info",
 }
 
@@ -8118,6 +8176,14 @@ func (p *Experiment) IsSetRunModeConfig() bool {
 	return p.RunModeConfig != nil
 }
 
+func (p *Experiment) IsSetQualityGateConf() bool {
+	return p.QualityGateConf != nil
+}
+
+func (p *Experiment) IsSetQualityGateResult_() bool {
+	return p.QualityGateResult_ != nil
+}
+
 func (p *Experiment) IsSetBaseInfo() bool {
 	return p.BaseInfo != nil
 }
@@ -8348,6 +8414,22 @@ func (p *Experiment) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 116:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField116(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 117:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField117(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 100:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField100(iprot); err != nil {
@@ -8695,6 +8777,22 @@ func (p *Experiment) ReadField115(iprot thrift.TProtocol) error {
 	p.RunModeConfig = _field
 	return nil
 }
+func (p *Experiment) ReadField116(iprot thrift.TProtocol) error {
+	_field := NewExptQualityGateConf()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.QualityGateConf = _field
+	return nil
+}
+func (p *Experiment) ReadField117(iprot thrift.TProtocol) error {
+	_field := NewExptQualityGateResult_()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.QualityGateResult_ = _field
+	return nil
+}
 func (p *Experiment) ReadField100(iprot thrift.TProtocol) error {
 	_field := common.NewBaseInfo()
 	if err := _field.Read(iprot); err != nil {
@@ -8814,6 +8912,14 @@ func (p *Experiment) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 115
 			goto WriteFieldError
 		}
+		if err = p.writeField116(oprot); err != nil {
+			fieldId = 116
+			goto WriteFieldError
+		}
+		if err = p.writeField117(oprot); err != nil {
+			fieldId = 117
+			goto WriteFieldError
+		}
 		if err = p.writeField100(oprot); err != nil {
 			fieldId = 100
 			goto WriteFieldError
@@ -9336,6 +9442,42 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 115 end error: ", p), err)
 }
+func (p *Experiment) writeField116(oprot thrift.TProtocol) (err error) {
+	if p.IsSetQualityGateConf() {
+		if err = oprot.WriteFieldBegin("quality_gate_conf", thrift.STRUCT, 116); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.QualityGateConf.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 116 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 116 end error: ", p), err)
+}
+func (p *Experiment) writeField117(oprot thrift.TProtocol) (err error) {
+	if p.IsSetQualityGateResult_() {
+		if err = oprot.WriteFieldBegin("quality_gate_result", thrift.STRUCT, 117); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.QualityGateResult_.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 117 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 117 end error: ", p), err)
+}
 func (p *Experiment) writeField100(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBaseInfo() {
 		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 100); err != nil {
@@ -9447,6 +9589,12 @@ func (p *Experiment) DeepEqual(ano *Experiment) bool {
 	if !p.Field115DeepEqual(ano.RunModeConfig) {
 		return false
 	}
+	if !p.Field116DeepEqual(ano.QualityGateConf) {
+		return false
+	}
+	if !p.Field117DeepEqual(ano.QualityGateResult_) {
+		return false
+	}
 	if !p.Field100DeepEqual(ano.BaseInfo) {
 		return false
 	}
@@ -9729,6 +9877,20 @@ func (p *Experiment) Field115DeepEqual(src *RunModeConfig) bool {
 	}
 	return true
 }
+func (p *Experiment) Field116DeepEqual(src *ExptQualityGateConf) bool {
+
+	if !p.QualityGateConf.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *Experiment) Field117DeepEqual(src *ExptQualityGateResult_) bool {
+
+	if !p.QualityGateResult_.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *Experiment) Field100DeepEqual(src *common.BaseInfo) bool {
 
 	if !p.BaseInfo.DeepEqual(src) {
@@ -15654,7 +15816,9 @@ type ExptTemplate struct {
 	EnableExtractTrajectory *bool             `thrift:"enable_extract_trajectory,5,optional" frugal:"5,optional,bool" form:"enable_extract_trajectory" json:"enable_extract_trajectory,omitempty" query:"enable_extract_trajectory"`
 	// 通知配置
 	NotificationConf *ExptNotificationConf `thrift:"notification_conf,10,optional" frugal:"10,optional,ExptNotificationConf" form:"notification_conf" json:"notification_conf,omitempty" query:"notification_conf"`
-	BaseInfo         *common.BaseInfo      `thrift:"base_info,100,optional" frugal:"100,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+	// 质量门禁配置
+	QualityGateConf *ExptQualityGateConf `thrift:"quality_gate_conf,11,optional" frugal:"11,optional,ExptQualityGateConf" form:"quality_gate_conf" json:"quality_gate_conf,omitempty" query:"quality_gate_conf"`
+	BaseInfo        *common.BaseInfo     `thrift:"base_info,100,optional" frugal:"100,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
 }
 
 func NewExptTemplate() *ExptTemplate {
@@ -15736,6 +15900,18 @@ func (p *ExptTemplate) GetNotificationConf() (v *ExptNotificationConf) {
 	return p.NotificationConf
 }
 
+var ExptTemplate_QualityGateConf_DEFAULT *ExptQualityGateConf
+
+func (p *ExptTemplate) GetQualityGateConf() (v *ExptQualityGateConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetQualityGateConf() {
+		return ExptTemplate_QualityGateConf_DEFAULT
+	}
+	return p.QualityGateConf
+}
+
 var ExptTemplate_BaseInfo_DEFAULT *common.BaseInfo
 
 func (p *ExptTemplate) GetBaseInfo() (v *common.BaseInfo) {
@@ -15765,6 +15941,9 @@ func (p *ExptTemplate) SetEnableExtractTrajectory(val *bool) {
 func (p *ExptTemplate) SetNotificationConf(val *ExptNotificationConf) {
 	p.NotificationConf = val
 }
+func (p *ExptTemplate) SetQualityGateConf(val *ExptQualityGateConf) {
+	p.QualityGateConf = val
+}
 func (p *ExptTemplate) SetBaseInfo(val *common.BaseInfo) {
 	p.BaseInfo = val
 }
@@ -15776,6 +15955,7 @@ var fieldIDToName_ExptTemplate = map[int16]string{
 	4:   "score_weight_config",
 	5:   "enable_extract_trajectory",
 	10:  "notification_conf",
+	11:  "quality_gate_conf",
 	100: "base_info",
 }
 
@@ -15803,6 +15983,10 @@ func (p *ExptTemplate) IsSetNotificationConf() bool {
 	return p.NotificationConf != nil
 }
 
+func (p *ExptTemplate) IsSetQualityGateConf() bool {
+	return p.QualityGateConf != nil
+}
+
 func (p *ExptTemplate) IsSetBaseInfo() bool {
 	return p.BaseInfo != nil
 }
@@ -15873,6 +16057,14 @@ func (p *ExptTemplate) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 11:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 100:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField100(iprot); err != nil {
@@ -15961,6 +16153,14 @@ func (p *ExptTemplate) ReadField10(iprot thrift.TProtocol) error {
 	p.NotificationConf = _field
 	return nil
 }
+func (p *ExptTemplate) ReadField11(iprot thrift.TProtocol) error {
+	_field := NewExptQualityGateConf()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.QualityGateConf = _field
+	return nil
+}
 func (p *ExptTemplate) ReadField100(iprot thrift.TProtocol) error {
 	_field := common.NewBaseInfo()
 	if err := _field.Read(iprot); err != nil {
@@ -16000,6 +16200,10 @@ func (p *ExptTemplate) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 10
 			goto WriteFieldError
 		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
 		if err = p.writeField100(oprot); err != nil {
 			fieldId = 100
 			goto WriteFieldError
@@ -16130,6 +16334,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
+func (p *ExptTemplate) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetQualityGateConf() {
+		if err = oprot.WriteFieldBegin("quality_gate_conf", thrift.STRUCT, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.QualityGateConf.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
 func (p *ExptTemplate) writeField100(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBaseInfo() {
 		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 100); err != nil {
@@ -16181,6 +16403,9 @@ func (p *ExptTemplate) DeepEqual(ano *ExptTemplate) bool {
 	if !p.Field10DeepEqual(ano.NotificationConf) {
 		return false
 	}
+	if !p.Field11DeepEqual(ano.QualityGateConf) {
+		return false
+	}
 	if !p.Field100DeepEqual(ano.BaseInfo) {
 		return false
 	}
@@ -16234,6 +16459,13 @@ func (p *ExptTemplate) Field10DeepEqual(src *ExptNotificationConf) bool {
 	}
 	return true
 }
+func (p *ExptTemplate) Field11DeepEqual(src *ExptQualityGateConf) bool {
+
+	if !p.QualityGateConf.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *ExptTemplate) Field100DeepEqual(src *common.BaseInfo) bool {
 
 	if !p.BaseInfo.DeepEqual(src) {
@@ -21284,3 +21516,3234 @@ func (p *FeishuNotificationConf) Field2DeepEqual(src *string) bool {
 	}
 	return true
 }
+
+// 单个评估器实例在基线与候选实验间的配对显著性统计, 以候选实验视角
+type ExperimentEvaluatorSignificance struct {
+	EvaluatorVersionID *int64   `thrift:"evaluator_version_id,1,optional" frugal:"1,optional,i64" json:"evaluator_version_id" form:"evaluator_version_id" query:"evaluator_version_id"`
+	Alias              *string  `thrift:"alias,2,optional" frugal:"2,optional,string" form:"alias" json:"alias,omitempty" query:"alias"`
+	PairedCount        *int64   `thrift:"paired_count,3,optional" frugal:"3,optional,i64" json:"paired_count" form:"paired_count" query:"paired_count"`
+	BaseMean           *float64 `thrift:"base_mean,4,optional" frugal:"4,optional,double" form:"base_mean" json:"base_mean,omitempty" query:"base_mean"`
+	CandidateMean      *float64 `thrift:"candidate_mean,5,optional" frugal:"5,optional,double" form:"candidate_mean" json:"candidate_mean,omitempty" query:"candidate_mean"`
+	MeanDelta          *float64 `thrift:"mean_delta,6,optional" frugal:"6,optional,double" form:"mean_delta" json:"mean_delta,omitempty" query:"mean_delta"`
+	CiLower            *float64 `thrift:"ci_lower,7,optional" frugal:"7,optional,double" form:"ci_lower" json:"ci_lower,omitempty" query:"ci_lower"`
+	CiUpper            *float64 `thrift:"ci_upper,8,optional" frugal:"8,optional,double" form:"ci_upper" json:"ci_upper,omitempty" query:"ci_upper"`
+	TTestPValue        *float64 `thrift:"t_test_p_value,9,optional" frugal:"9,optional,double" form:"t_test_p_value" json:"t_test_p_value,omitempty" query:"t_test_p_value"`
+	WilcoxonPValue     *float64 `thrift:"wilcoxon_p_value,10,optional" frugal:"10,optional,double" form:"wilcoxon_p_value" json:"wilcoxon_p_value,omitempty" query:"wilcoxon_p_value"`
+	WinCount           *int64   `thrift:"win_count,11,optional" frugal:"11,optional,i64" json:"win_count" form:"win_count" query:"win_count"`
+	TieCount           *int64   `thrift:"tie_count,12,optional" frugal:"12,optional,i64" json:"tie_count" form:"tie_count" query:"tie_count"`
+	LossCount          *int64   `thrift:"loss_count,13,optional" frugal:"13,optional,i64" json:"loss_count" form:"loss_count" query:"loss_count"`
+	Significant        *bool    `thrift:"significant,14,optional" frugal:"14,optional,bool" form:"significant" json:"significant,omitempty" query:"significant"`
+}
+
+func NewExperimentEvaluatorSignificance() *ExperimentEvaluatorSignificance {
+	return &ExperimentEvaluatorSignificance{}
+}
+
+func (p *ExperimentEvaluatorSignificance) InitDefault() {
+}
+
+var ExperimentEvaluatorSignificance_EvaluatorVersionID_DEFAULT int64
+
+func (p *ExperimentEvaluatorSignificance) GetEvaluatorVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorVersionID() {
+		return ExperimentEvaluatorSignificance_EvaluatorVersionID_DEFAULT
+	}
+	return *p.EvaluatorVersionID
+}
+
+var ExperimentEvaluatorSignificance_Alias_DEFAULT string
+
+func (p *ExperimentEvaluatorSignificance) GetAlias() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAlias() {
+		return ExperimentEvaluatorSignificance_Alias_DEFAULT
+	}
+	return *p.Alias
+}
+
+var ExperimentEvaluatorSignificance_PairedCount_DEFAULT int64
+
+func (p *ExperimentEvaluatorSignificance) GetPairedCount() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPairedCount() {
+		return ExperimentEvaluatorSignificance_PairedCount_DEFAULT
+	}
+	return *p.PairedCount
+}
+
+var ExperimentEvaluatorSignificance_BaseMean_DEFAULT float64
+
+func (p *ExperimentEvaluatorSignificance) GetBaseMean() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseMean() {
+		return ExperimentEvaluatorSignificance_BaseMean_DEFAULT
+	}
+	return *p.BaseMean
+}
+
+var ExperimentEvaluatorSignificance_CandidateMean_DEFAULT float64
+
+func (p *ExperimentEvaluatorSignificance) GetCandidateMean() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCandidateMean() {
+		return ExperimentEvaluatorSignificance_CandidateMean_DEFAULT
+	}
+	return *p.CandidateMean
+}
+
+var ExperimentEvaluatorSignificance_MeanDelta_DEFAULT float64
+
+func (p *ExperimentEvaluatorSignificance) GetMeanDelta() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMeanDelta() {
+		return ExperimentEvaluatorSignificance_MeanDelta_DEFAULT
+	}
+	return *p.MeanDelta
+}
+
+var ExperimentEvaluatorSignificance_CiLower_DEFAULT float64
+
+func (p *ExperimentEvaluatorSignificance) GetCiLower() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCiLower() {
+		return ExperimentEvaluatorSignificance_CiLower_DEFAULT
+	}
+	return *p.CiLower
+}
+
+var ExperimentEvaluatorSignificance_CiUpper_DEFAULT float64
+
+func (p *ExperimentEvaluatorSignificance) GetCiUpper() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCiUpper() {
+		return ExperimentEvaluatorSignificance_CiUpper_DEFAULT
+	}
+	return *p.CiUpper
+}
+
+var ExperimentEvaluatorSignificance_TTestPValue_DEFAULT float64
+
+func (p *ExperimentEvaluatorSignificance) GetTTestPValue() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTTestPValue() {
+		return ExperimentEvaluatorSignificance_TTestPValue_DEFAULT
+	}
+	return *p.TTestPValue
+}
+
+var ExperimentEvaluatorSignificance_WilcoxonPValue_DEFAULT float64
+
+func (p *ExperimentEvaluatorSignificance) GetWilcoxonPValue() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWilcoxonPValue() {
+		return ExperimentEvaluatorSignificance_WilcoxonPValue_DEFAULT
+	}
+	return *p.WilcoxonPValue
+}
+
+var ExperimentEvaluatorSignificance_WinCount_DEFAULT int64
+
+func (p *ExperimentEvaluatorSignificance) GetWinCount() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWinCount() {
+		return ExperimentEvaluatorSignificance_WinCount_DEFAULT
+	}
+	return *p.WinCount
+}
+
+var ExperimentEvaluatorSignificance_TieCount_DEFAULT int64
+
+func (p *ExperimentEvaluatorSignificance) GetTieCount() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTieCount() {
+		return ExperimentEvaluatorSignificance_TieCount_DEFAULT
+	}
+	return *p.TieCount
+}
+
+var ExperimentEvaluatorSignificance_LossCount_DEFAULT int64
+
+func (p *ExperimentEvaluatorSignificance) GetLossCount() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLossCount() {
+		return ExperimentEvaluatorSignificance_LossCount_DEFAULT
+	}
+	return *p.LossCount
+}
+
+var ExperimentEvaluatorSignificance_Significant_DEFAULT bool
+
+func (p *ExperimentEvaluatorSignificance) GetSignificant() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSignificant() {
+		return ExperimentEvaluatorSignificance_Significant_DEFAULT
+	}
+	return *p.Significant
+}
+func (p *ExperimentEvaluatorSignificance) SetEvaluatorVersionID(val *int64) {
+	p.EvaluatorVersionID = val
+}
+func (p *ExperimentEvaluatorSignificance) SetAlias(val *string) {
+	p.Alias = val
+}
+func (p *ExperimentEvaluatorSignificance) SetPairedCount(val *int64) {
+	p.PairedCount = val
+}
+func (p *ExperimentEvaluatorSignificance) SetBaseMean(val *float64) {
+	p.BaseMean = val
+}
+func (p *ExperimentEvaluatorSignificance) SetCandidateMean(val *float64) {
+	p.CandidateMean = val
+}
+func (p *ExperimentEvaluatorSignificance) SetMeanDelta(val *float64) {
+	p.MeanDelta = val
+}
+func (p *ExperimentEvaluatorSignificance) SetCiLower(val *float64) {
+	p.CiLower = val
+}
+func (p *ExperimentEvaluatorSignificance) SetCiUpper(val *float64) {
+	p.CiUpper = val
+}
+func (p *ExperimentEvaluatorSignificance) SetTTestPValue(val *float64) {
+	p.TTestPValue = val
+}
+func (p *ExperimentEvaluatorSignificance) SetWilcoxonPValue(val *float64) {
+	p.WilcoxonPValue = val
+}
+func (p *ExperimentEvaluatorSignificance) SetWinCount(val *int64) {
+	p.WinCount = val
+}
+func (p *ExperimentEvaluatorSignificance) SetTieCount(val *int64) {
+	p.TieCount = val
+}
+func (p *ExperimentEvaluatorSignificance) SetLossCount(val *int64) {
+	p.LossCount = val
+}
+func (p *ExperimentEvaluatorSignificance) SetSignificant(val *bool) {
+	p.Significant = val
+}
+
+var fieldIDToName_ExperimentEvaluatorSignificance = map[int16]string{
+	1:  "evaluator_version_id",
+	2:  "alias",
+	3:  "paired_count",
+	4:  "base_mean",
+	5:  "candidate_mean",
+	6:  "mean_delta",
+	7:  "ci_lower",
+	8:  "ci_upper",
+	9:  "t_test_p_value",
+	10: "wilcoxon_p_value",
+	11: "win_count",
+	12: "tie_count",
+	13: "loss_count",
+	14: "significant",
+}
+
+func (p *ExperimentEvaluatorSignificance) IsSetEvaluatorVersionID() bool {
+	return p.EvaluatorVersionID != nil
+}
+
+func (p *ExperimentEvaluatorSignificance) IsSetAlias() bool {
+	return p.Alias != nil
+}
+
+func (p *ExperimentEvaluatorSignificance) IsSetPairedCount() bool {
+	return p.PairedCount != nil
+}
+
+func (p *ExperimentEvaluatorSignificance) IsSetBaseMean() bool {
+	return p.BaseMean != nil
+}
+
+func (p *ExperimentEvaluatorSignificance) IsSetCandidateMean() bool {
+	return p.CandidateMean != nil
+}
+
+func (p *ExperimentEvaluatorSignificance) IsSetMeanDelta() bool {
+	return p.MeanDelta != nil
+}
+
+func (p *ExperimentEvaluatorSignificance) IsSetCiLower() bool {
+	return p.CiLower != nil
+}
+
+func (p *ExperimentEvaluatorSignificance) IsSetCiUpper() bool {
+	return p.CiUpper != nil
+}
+
+func (p *ExperimentEvaluatorSignificance) IsSetTTestPValue() bool {
+	return p.TTestPValue != nil
+}
+
+func (p *ExperimentEvaluatorSignificance) IsSetWilcoxonPValue() bool {
+	return p.WilcoxonPValue != nil
+}
+
+func (p *ExperimentEvaluatorSignificance) IsSetWinCount() bool {
+	return p.WinCount != nil
+}
+
+func (p *ExperimentEvaluatorSignificance) IsSetTieCount() bool {
+	return p.TieCount != nil
+}
+
+func (p *ExperimentEvaluatorSignificance) IsSetLossCount() bool {
+	return p.LossCount != nil
+}
+
+func (p *ExperimentEvaluatorSignificance) IsSetSignificant() bool {
+	return p.Significant != nil
+}
+
+func (p *ExperimentEvaluatorSignificance) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentEvaluatorSignificance[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExperimentEvaluatorSignificance) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatorVersionID = _field
+	return nil
+}
+func (p *ExperimentEvaluatorSignificance) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Alias = _field
+	return nil
+}
+func (p *ExperimentEvaluatorSignificance) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PairedCount = _field
+	return nil
+}
+func (p *ExperimentEvaluatorSignificance) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaseMean = _field
+	return nil
+}
+func (p *ExperimentEvaluatorSignificance) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CandidateMean = _field
+	return nil
+}
+func (p *ExperimentEvaluatorSignificance) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MeanDelta = _field
+	return nil
+}
+func (p *ExperimentEvaluatorSignificance) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CiLower = _field
+	return nil
+}
+func (p *ExperimentEvaluatorSignificance) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CiUpper = _field
+	return nil
+}
+func (p *ExperimentEvaluatorSignificance) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TTestPValue = _field
+	return nil
+}
+func (p *ExperimentEvaluatorSignificance) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WilcoxonPValue = _field
+	return nil
+}
+func (p *ExperimentEvaluatorSignificance) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WinCount = _field
+	return nil
+}
+func (p *ExperimentEvaluatorSignificance) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TieCount = _field
+	return nil
+}
+func (p *ExperimentEvaluatorSignificance) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LossCount = _field
+	return nil
+}
+func (p *ExperimentEvaluatorSignificance) ReadField14(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Significant = _field
+	return nil
+}
+
+func (p *ExperimentEvaluatorSignificance) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExperimentEvaluatorSignificance"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExperimentEvaluatorSignificance) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersionID() {
+		if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluatorVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExperimentEvaluatorSignificance) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAlias() {
+		if err = oprot.WriteFieldBegin("alias", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Alias); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExperimentEvaluatorSignificance) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPairedCount() {
+		if err = oprot.WriteFieldBegin("paired_count", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.PairedCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExperimentEvaluatorSignificance) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseMean() {
+		if err = oprot.WriteFieldBegin("base_mean", thrift.DOUBLE, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.BaseMean); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExperimentEvaluatorSignificance) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCandidateMean() {
+		if err = oprot.WriteFieldBegin("candidate_mean", thrift.DOUBLE, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.CandidateMean); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExperimentEvaluatorSignificance) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMeanDelta() {
+		if err = oprot.WriteFieldBegin("mean_delta", thrift.DOUBLE, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MeanDelta); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ExperimentEvaluatorSignificance) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCiLower() {
+		if err = oprot.WriteFieldBegin("ci_lower", thrift.DOUBLE, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.CiLower); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ExperimentEvaluatorSignificance) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCiUpper() {
+		if err = oprot.WriteFieldBegin("ci_upper", thrift.DOUBLE, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.CiUpper); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *ExperimentEvaluatorSignificance) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTTestPValue() {
+		if err = oprot.WriteFieldBegin("t_test_p_value", thrift.DOUBLE, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.TTestPValue); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *ExperimentEvaluatorSignificance) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWilcoxonPValue() {
+		if err = oprot.WriteFieldBegin("wilcoxon_p_value", thrift.DOUBLE, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.WilcoxonPValue); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *ExperimentEvaluatorSignificance) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWinCount() {
+		if err = oprot.WriteFieldBegin("win_count", thrift.I64, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WinCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *ExperimentEvaluatorSignificance) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTieCount() {
+		if err = oprot.WriteFieldBegin("tie_count", thrift.I64, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TieCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *ExperimentEvaluatorSignificance) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLossCount() {
+		if err = oprot.WriteFieldBegin("loss_count", thrift.I64, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.LossCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+func (p *ExperimentEvaluatorSignificance) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSignificant() {
+		if err = oprot.WriteFieldBegin("significant", thrift.BOOL, 14); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Significant); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+
+func (p *ExperimentEvaluatorSignificance) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExperimentEvaluatorSignificance(%+v)", *p)
+
+}
+
+func (p *ExperimentEvaluatorSignificance) DeepEqual(ano *ExperimentEvaluatorSignificance) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Alias) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.PairedCount) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.BaseMean) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.CandidateMean) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.MeanDelta) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.CiLower) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.CiUpper) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.TTestPValue) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.WilcoxonPValue) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.WinCount) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.TieCount) {
+		return false
+	}
+	if !p.Field13DeepEqual(ano.LossCount) {
+		return false
+	}
+	if !p.Field14DeepEqual(ano.Significant) {
+		return false
+	}
+	return true
+}
+
+func (p *ExperimentEvaluatorSignificance) Field1DeepEqual(src *int64) bool {
+
+	if p.EvaluatorVersionID == src {
+		return true
+	} else if p.EvaluatorVersionID == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatorVersionID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExperimentEvaluatorSignificance) Field2DeepEqual(src *string) bool {
+
+	if p.Alias == src {
+		return true
+	} else if p.Alias == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Alias, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExperimentEvaluatorSignificance) Field3DeepEqual(src *int64) bool {
+
+	if p.PairedCount == src {
+		return true
+	} else if p.PairedCount == nil || src == nil {
+		return false
+	}
+	if *p.PairedCount != *src {
+		return false
+	}
+	return true
+}
+func (p *ExperimentEvaluatorSignificance) Field4DeepEqual(src *float64) bool {
+
+	if p.BaseMean == src {
+		return true
+	} else if p.BaseMean == nil || src == nil {
+		return false
+	}
+	if *p.BaseMean != *src {
+		return false
+	}
+	return true
+}
+func (p *ExperimentEvaluatorSignificance) Field5DeepEqual(src *float64) bool {
+
+	if p.CandidateMean == src {
+		return true
+	} else if p.CandidateMean == nil || src == nil {
+		return false
+	}
+	if *p.CandidateMean != *src {
+		return false
+	}
+	return true
+}
+func (p *ExperimentEvaluatorSignificance) Field6DeepEqual(src *float64) bool {
+
+	if p.MeanDelta == src {
+		return true
+	} else if p.MeanDelta == nil || src == nil {
+		return false
+	}
+	if *p.MeanDelta != *src {
+		return false
+	}
+	return true
+}
+func (p *ExperimentEvaluatorSignificance) Field7DeepEqual(src *float64) bool {
+
+	if p.CiLower == src {
+		return true
+	} else if p.CiLower == nil || src == nil {
+		return false
+	}
+	if *p.CiLower != *src {
+		return false
+	}
+	return true
+}
+func (p *ExperimentEvaluatorSignificance) Field8DeepEqual(src *float64) bool {
+
+	if p.CiUpper == src {
+		return true
+	} else if p.CiUpper == nil || src == nil {
+		return false
+	}
+	if *p.CiUpper != *src {
+		return false
+	}
+	return true
+}
+func (p *ExperimentEvaluatorSignificance) Field9DeepEqual(src *float64) bool {
+
+	if p.TTestPValue == src {
+		return true
+	} else if p.TTestPValue == nil || src == nil {
+		return false
+	}
+	if *p.TTestPValue != *src {
+		return false
+	}
+	return true
+}
+func (p *ExperimentEvaluatorSignificance) Field10DeepEqual(src *float64) bool {
+
+	if p.WilcoxonPValue == src {
+		return true
+	} else if p.WilcoxonPValue == nil || src == nil {
+		return false
+	}
+	if *p.WilcoxonPValue != *src {
+		return false
+	}
+	return true
+}
+func (p *ExperimentEvaluatorSignificance) Field11DeepEqual(src *int64) bool {
+
+	if p.WinCount == src {
+		return true
+	} else if p.WinCount == nil || src == nil {
+		return false
+	}
+	if *p.WinCount != *src {
+		return false
+	}
+	return true
+}
+func (p *ExperimentEvaluatorSignificance) Field12DeepEqual(src *int64) bool {
+
+	if p.TieCount == src {
+		return true
+	} else if p.TieCount == nil || src == nil {
+		return false
+	}
+	if *p.TieCount != *src {
+		return false
+	}
+	return true
+}
+func (p *ExperimentEvaluatorSignificance) Field13DeepEqual(src *int64) bool {
+
+	if p.LossCount == src {
+		return true
+	} else if p.LossCount == nil || src == nil {
+		return false
+	}
+	if *p.LossCount != *src {
+		return false
+	}
+	return true
+}
+func (p *ExperimentEvaluatorSignificance) Field14DeepEqual(src *bool) bool {
+
+	if p.Significant == src {
+		return true
+	} else if p.Significant == nil || src == nil {
+		return false
+	}
+	if *p.Significant != *src {
+		return false
+	}
+	return true
+}
+
+type ExperimentPairSignificance struct {
+	CandidateExperimentID  *int64                             `thrift:"candidate_experiment_id,1,optional" frugal:"1,optional,i64" json:"candidate_experiment_id" form:"candidate_experiment_id" query:"candidate_experiment_id"`
+	EvaluatorSignificances []*ExperimentEvaluatorSignificance `thrift:"evaluator_significances,2,optional" frugal:"2,optional,list<ExperimentEvaluatorSignificance>" form:"evaluator_significances" json:"evaluator_significances,omitempty" query:"evaluator_significances"`
+}
+
+func NewExperimentPairSignificance() *ExperimentPairSignificance {
+	return &ExperimentPairSignificance{}
+}
+
+func (p *ExperimentPairSignificance) InitDefault() {
+}
+
+var ExperimentPairSignificance_CandidateExperimentID_DEFAULT int64
+
+func (p *ExperimentPairSignificance) GetCandidateExperimentID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCandidateExperimentID() {
+		return ExperimentPairSignificance_CandidateExperimentID_DEFAULT
+	}
+	return *p.CandidateExperimentID
+}
+
+var ExperimentPairSignificance_EvaluatorSignificances_DEFAULT []*ExperimentEvaluatorSignificance
+
+func (p *ExperimentPairSignificance) GetEvaluatorSignificances() (v []*ExperimentEvaluatorSignificance) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorSignificances() {
+		return ExperimentPairSignificance_EvaluatorSignificances_DEFAULT
+	}
+	return p.EvaluatorSignificances
+}
+func (p *ExperimentPairSignificance) SetCandidateExperimentID(val *int64) {
+	p.CandidateExperimentID = val
+}
+func (p *ExperimentPairSignificance) SetEvaluatorSignificances(val []*ExperimentEvaluatorSignificance) {
+	p.EvaluatorSignificances = val
+}
+
+var fieldIDToName_ExperimentPairSignificance = map[int16]string{
+	1: "candidate_experiment_id",
+	2: "evaluator_significances",
+}
+
+func (p *ExperimentPairSignificance) IsSetCandidateExperimentID() bool {
+	return p.CandidateExperimentID != nil
+}
+
+func (p *ExperimentPairSignificance) IsSetEvaluatorSignificances() bool {
+	return p.EvaluatorSignificances != nil
+}
+
+func (p *ExperimentPairSignificance) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentPairSignificance[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExperimentPairSignificance) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CandidateExperimentID = _field
+	return nil
+}
+func (p *ExperimentPairSignificance) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExperimentEvaluatorSignificance, 0, size)
+	values := make([]ExperimentEvaluatorSignificance, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.EvaluatorSignificances = _field
+	return nil
+}
+
+func (p *ExperimentPairSignificance) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExperimentPairSignificance"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExperimentPairSignificance) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCandidateExperimentID() {
+		if err = oprot.WriteFieldBegin("candidate_experiment_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.CandidateExperimentID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExperimentPairSignificance) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorSignificances() {
+		if err = oprot.WriteFieldBegin("evaluator_significances", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.EvaluatorSignificances)); err != nil {
+			return err
+		}
+		for _, v := range p.EvaluatorSignificances {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ExperimentPairSignificance) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExperimentPairSignificance(%+v)", *p)
+
+}
+
+func (p *ExperimentPairSignificance) DeepEqual(ano *ExperimentPairSignificance) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.CandidateExperimentID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.EvaluatorSignificances) {
+		return false
+	}
+	return true
+}
+
+func (p *ExperimentPairSignificance) Field1DeepEqual(src *int64) bool {
+
+	if p.CandidateExperimentID == src {
+		return true
+	} else if p.CandidateExperimentID == nil || src == nil {
+		return false
+	}
+	if *p.CandidateExperimentID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExperimentPairSignificance) Field2DeepEqual(src []*ExperimentEvaluatorSignificance) bool {
+
+	if len(p.EvaluatorSignificances) != len(src) {
+		return false
+	}
+	for i, v := range p.EvaluatorSignificances {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type ExptQualityGateRule struct {
+	// 规则展示名, 为空时按 metric 生成
+	Name   *string            `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	Metric *QualityGateMetric `thrift:"metric,2,optional" frugal:"2,optional,string" form:"metric" json:"metric,omitempty" query:"metric"`
+	// >= / > / <= / < / ==
+	Operator  *string  `thrift:"operator,3,optional" frugal:"3,optional,string" form:"operator" json:"operator,omitempty" query:"operator"`
+	Threshold *float64 `thrift:"threshold,4,optional" frugal:"4,optional,double" form:"threshold" json:"threshold,omitempty" query:"threshold"`
+	// 仅 evaluator_avg_score 使用
+	EvaluatorVersionID *int64 `thrift:"evaluator_version_id,5,optional" frugal:"5,optional,i64" json:"evaluator_version_id" form:"evaluator_version_id" query:"evaluator_version_id"`
+	// 仅 evaluator_avg_score 使用
+	EvaluatorAlias *string `thrift:"evaluator_alias,6,optional" frugal:"6,optional,string" form:"evaluator_alias" json:"evaluator_alias,omitempty" query:"evaluator_alias"`
+	// 仅 regression_rate 使用
+	BaselineExptID *int64 `thrift:"baseline_expt_id,7,optional" frugal:"7,optional,i64" json:"baseline_expt_id" form:"baseline_expt_id" query:"baseline_expt_id"`
+	// 仅 regression_rate 使用, 单个 turn 得分下降超过该值才视为退化
+	ScoreTolerance *float64 `thrift:"score_tolerance,8,optional" frugal:"8,optional,double" form:"score_tolerance" json:"score_tolerance,omitempty" query:"score_tolerance"`
+}
+
+func NewExptQualityGateRule() *ExptQualityGateRule {
+	return &ExptQualityGateRule{}
+}
+
+func (p *ExptQualityGateRule) InitDefault() {
+}
+
+var ExptQualityGateRule_Name_DEFAULT string
+
+func (p *ExptQualityGateRule) GetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetName() {
+		return ExptQualityGateRule_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var ExptQualityGateRule_Metric_DEFAULT QualityGateMetric
+
+func (p *ExptQualityGateRule) GetMetric() (v QualityGateMetric) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMetric() {
+		return ExptQualityGateRule_Metric_DEFAULT
+	}
+	return *p.Metric
+}
+
+var ExptQualityGateRule_Operator_DEFAULT string
+
+func (p *ExptQualityGateRule) GetOperator() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOperator() {
+		return ExptQualityGateRule_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+
+var ExptQualityGateRule_Threshold_DEFAULT float64
+
+func (p *ExptQualityGateRule) GetThreshold() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetThreshold() {
+		return ExptQualityGateRule_Threshold_DEFAULT
+	}
+	return *p.Threshold
+}
+
+var ExptQualityGateRule_EvaluatorVersionID_DEFAULT int64
+
+func (p *ExptQualityGateRule) GetEvaluatorVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorVersionID() {
+		return ExptQualityGateRule_EvaluatorVersionID_DEFAULT
+	}
+	return *p.EvaluatorVersionID
+}
+
+var ExptQualityGateRule_EvaluatorAlias_DEFAULT string
+
+func (p *ExptQualityGateRule) GetEvaluatorAlias() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorAlias() {
+		return ExptQualityGateRule_EvaluatorAlias_DEFAULT
+	}
+	return *p.EvaluatorAlias
+}
+
+var ExptQualityGateRule_BaselineExptID_DEFAULT int64
+
+func (p *ExptQualityGateRule) GetBaselineExptID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaselineExptID() {
+		return ExptQualityGateRule_BaselineExptID_DEFAULT
+	}
+	return *p.BaselineExptID
+}
+
+var ExptQualityGateRule_ScoreTolerance_DEFAULT float64
+
+func (p *ExptQualityGateRule) GetScoreTolerance() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScoreTolerance() {
+		return ExptQualityGateRule_ScoreTolerance_DEFAULT
+	}
+	return *p.ScoreTolerance
+}
+func (p *ExptQualityGateRule) SetName(val *string) {
+	p.Name = val
+}
+func (p *ExptQualityGateRule) SetMetric(val *QualityGateMetric) {
+	p.Metric = val
+}
+func (p *ExptQualityGateRule) SetOperator(val *string) {
+	p.Operator = val
+}
+func (p *ExptQualityGateRule) SetThreshold(val *float64) {
+	p.Threshold = val
+}
+func (p *ExptQualityGateRule) SetEvaluatorVersionID(val *int64) {
+	p.EvaluatorVersionID = val
+}
+func (p *ExptQualityGateRule) SetEvaluatorAlias(val *string) {
+	p.EvaluatorAlias = val
+}
+func (p *ExptQualityGateRule) SetBaselineExptID(val *int64) {
+	p.BaselineExptID = val
+}
+func (p *ExptQualityGateRule) SetScoreTolerance(val *float64) {
+	p.ScoreTolerance = val
+}
+
+var fieldIDToName_ExptQualityGateRule = map[int16]string{
+	1: "name",
+	2: "metric",
+	3: "operator",
+	4: "threshold",
+	5: "evaluator_version_id",
+	6: "evaluator_alias",
+	7: "baseline_expt_id",
+	8: "score_tolerance",
+}
+
+func (p *ExptQualityGateRule) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *ExptQualityGateRule) IsSetMetric() bool {
+	return p.Metric != nil
+}
+
+func (p *ExptQualityGateRule) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *ExptQualityGateRule) IsSetThreshold() bool {
+	return p.Threshold != nil
+}
+
+func (p *ExptQualityGateRule) IsSetEvaluatorVersionID() bool {
+	return p.EvaluatorVersionID != nil
+}
+
+func (p *ExptQualityGateRule) IsSetEvaluatorAlias() bool {
+	return p.EvaluatorAlias != nil
+}
+
+func (p *ExptQualityGateRule) IsSetBaselineExptID() bool {
+	return p.BaselineExptID != nil
+}
+
+func (p *ExptQualityGateRule) IsSetScoreTolerance() bool {
+	return p.ScoreTolerance != nil
+}
+
+func (p *ExptQualityGateRule) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptQualityGateRule[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptQualityGateRule) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *ExptQualityGateRule) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *QualityGateMetric
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Metric = _field
+	return nil
+}
+func (p *ExptQualityGateRule) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Operator = _field
+	return nil
+}
+func (p *ExptQualityGateRule) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Threshold = _field
+	return nil
+}
+func (p *ExptQualityGateRule) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatorVersionID = _field
+	return nil
+}
+func (p *ExptQualityGateRule) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatorAlias = _field
+	return nil
+}
+func (p *ExptQualityGateRule) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaselineExptID = _field
+	return nil
+}
+func (p *ExptQualityGateRule) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ScoreTolerance = _field
+	return nil
+}
+
+func (p *ExptQualityGateRule) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptQualityGateRule"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptQualityGateRule) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptQualityGateRule) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMetric() {
+		if err = oprot.WriteFieldBegin("metric", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Metric); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptQualityGateRule) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOperator() {
+		if err = oprot.WriteFieldBegin("operator", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Operator); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptQualityGateRule) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetThreshold() {
+		if err = oprot.WriteFieldBegin("threshold", thrift.DOUBLE, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Threshold); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptQualityGateRule) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersionID() {
+		if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluatorVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptQualityGateRule) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorAlias() {
+		if err = oprot.WriteFieldBegin("evaluator_alias", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.EvaluatorAlias); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ExptQualityGateRule) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaselineExptID() {
+		if err = oprot.WriteFieldBegin("baseline_expt_id", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.BaselineExptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ExptQualityGateRule) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScoreTolerance() {
+		if err = oprot.WriteFieldBegin("score_tolerance", thrift.DOUBLE, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.ScoreTolerance); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *ExptQualityGateRule) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptQualityGateRule(%+v)", *p)
+
+}
+
+func (p *ExptQualityGateRule) DeepEqual(ano *ExptQualityGateRule) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Metric) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Operator) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Threshold) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.EvaluatorVersionID) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.EvaluatorAlias) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.BaselineExptID) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.ScoreTolerance) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptQualityGateRule) Field1DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRule) Field2DeepEqual(src *QualityGateMetric) bool {
+
+	if p.Metric == src {
+		return true
+	} else if p.Metric == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Metric, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRule) Field3DeepEqual(src *string) bool {
+
+	if p.Operator == src {
+		return true
+	} else if p.Operator == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Operator, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRule) Field4DeepEqual(src *float64) bool {
+
+	if p.Threshold == src {
+		return true
+	} else if p.Threshold == nil || src == nil {
+		return false
+	}
+	if *p.Threshold != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRule) Field5DeepEqual(src *int64) bool {
+
+	if p.EvaluatorVersionID == src {
+		return true
+	} else if p.EvaluatorVersionID == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatorVersionID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRule) Field6DeepEqual(src *string) bool {
+
+	if p.EvaluatorAlias == src {
+		return true
+	} else if p.EvaluatorAlias == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.EvaluatorAlias, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRule) Field7DeepEqual(src *int64) bool {
+
+	if p.BaselineExptID == src {
+		return true
+	} else if p.BaselineExptID == nil || src == nil {
+		return false
+	}
+	if *p.BaselineExptID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRule) Field8DeepEqual(src *float64) bool {
+
+	if p.ScoreTolerance == src {
+		return true
+	} else if p.ScoreTolerance == nil || src == nil {
+		return false
+	}
+	if *p.ScoreTolerance != *src {
+		return false
+	}
+	return true
+}
+
+// 质量门禁配置, 实验结束时逐条评估, 全部规则通过才算通过
+type ExptQualityGateConf struct {
+	Rules []*ExptQualityGateRule `thrift:"rules,1,optional" frugal:"1,optional,list<ExptQualityGateRule>" form:"rules" json:"rules,omitempty" query:"rules"`
+}
+
+func NewExptQualityGateConf() *ExptQualityGateConf {
+	return &ExptQualityGateConf{}
+}
+
+func (p *ExptQualityGateConf) InitDefault() {
+}
+
+var ExptQualityGateConf_Rules_DEFAULT []*ExptQualityGateRule
+
+func (p *ExptQualityGateConf) GetRules() (v []*ExptQualityGateRule) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRules() {
+		return ExptQualityGateConf_Rules_DEFAULT
+	}
+	return p.Rules
+}
+func (p *ExptQualityGateConf) SetRules(val []*ExptQualityGateRule) {
+	p.Rules = val
+}
+
+var fieldIDToName_ExptQualityGateConf = map[int16]string{
+	1: "rules",
+}
+
+func (p *ExptQualityGateConf) IsSetRules() bool {
+	return p.Rules != nil
+}
+
+func (p *ExptQualityGateConf) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptQualityGateConf[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptQualityGateConf) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExptQualityGateRule, 0, size)
+	values := make([]ExptQualityGateRule, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Rules = _field
+	return nil
+}
+
+func (p *ExptQualityGateConf) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptQualityGateConf"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptQualityGateConf) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRules() {
+		if err = oprot.WriteFieldBegin("rules", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Rules)); err != nil {
+			return err
+		}
+		for _, v := range p.Rules {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ExptQualityGateConf) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptQualityGateConf(%+v)", *p)
+
+}
+
+func (p *ExptQualityGateConf) DeepEqual(ano *ExptQualityGateConf) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Rules) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptQualityGateConf) Field1DeepEqual(src []*ExptQualityGateRule) bool {
+
+	if len(p.Rules) != len(src) {
+		return false
+	}
+	for i, v := range p.Rules {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type ExptQualityGateRuleResult_ struct {
+	Rule *ExptQualityGateRule `thrift:"rule,1,optional" frugal:"1,optional,ExptQualityGateRule" form:"rule" json:"rule,omitempty" query:"rule"`
+	// 实际度量值, 无法计算时为空
+	Actual  *float64           `thrift:"actual,2,optional" frugal:"2,optional,double" form:"actual" json:"actual,omitempty" query:"actual"`
+	Status  *QualityGateStatus `thrift:"status,3,optional" frugal:"3,optional,string" form:"status" json:"status,omitempty" query:"status"`
+	Message *string            `thrift:"message,4,optional" frugal:"4,optional,string" form:"message" json:"message,omitempty" query:"message"`
+}
+
+func NewExptQualityGateRuleResult_() *ExptQualityGateRuleResult_ {
+	return &ExptQualityGateRuleResult_{}
+}
+
+func (p *ExptQualityGateRuleResult_) InitDefault() {
+}
+
+var ExptQualityGateRuleResult__Rule_DEFAULT *ExptQualityGateRule
+
+func (p *ExptQualityGateRuleResult_) GetRule() (v *ExptQualityGateRule) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRule() {
+		return ExptQualityGateRuleResult__Rule_DEFAULT
+	}
+	return p.Rule
+}
+
+var ExptQualityGateRuleResult__Actual_DEFAULT float64
+
+func (p *ExptQualityGateRuleResult_) GetActual() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetActual() {
+		return ExptQualityGateRuleResult__Actual_DEFAULT
+	}
+	return *p.Actual
+}
+
+var ExptQualityGateRuleResult__Status_DEFAULT QualityGateStatus
+
+func (p *ExptQualityGateRuleResult_) GetStatus() (v QualityGateStatus) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStatus() {
+		return ExptQualityGateRuleResult__Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var ExptQualityGateRuleResult__Message_DEFAULT string
+
+func (p *ExptQualityGateRuleResult_) GetMessage() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMessage() {
+		return ExptQualityGateRuleResult__Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *ExptQualityGateRuleResult_) SetRule(val *ExptQualityGateRule) {
+	p.Rule = val
+}
+func (p *ExptQualityGateRuleResult_) SetActual(val *float64) {
+	p.Actual = val
+}
+func (p *ExptQualityGateRuleResult_) SetStatus(val *QualityGateStatus) {
+	p.Status = val
+}
+func (p *ExptQualityGateRuleResult_) SetMessage(val *string) {
+	p.Message = val
+}
+
+var fieldIDToName_ExptQualityGateRuleResult_ = map[int16]string{
+	1: "rule",
+	2: "actual",
+	3: "status",
+	4: "message",
+}
+
+func (p *ExptQualityGateRuleResult_) IsSetRule() bool {
+	return p.Rule != nil
+}
+
+func (p *ExptQualityGateRuleResult_) IsSetActual() bool {
+	return p.Actual != nil
+}
+
+func (p *ExptQualityGateRuleResult_) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *ExptQualityGateRuleResult_) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ExptQualityGateRuleResult_) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptQualityGateRuleResult_[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptQualityGateRuleResult_) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewExptQualityGateRule()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Rule = _field
+	return nil
+}
+func (p *ExptQualityGateRuleResult_) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Actual = _field
+	return nil
+}
+func (p *ExptQualityGateRuleResult_) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *QualityGateStatus
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *ExptQualityGateRuleResult_) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Message = _field
+	return nil
+}
+
+func (p *ExptQualityGateRuleResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptQualityGateRuleResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptQualityGateRuleResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRule() {
+		if err = oprot.WriteFieldBegin("rule", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Rule.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptQualityGateRuleResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetActual() {
+		if err = oprot.WriteFieldBegin("actual", thrift.DOUBLE, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Actual); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptQualityGateRuleResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptQualityGateRuleResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMessage() {
+		if err = oprot.WriteFieldBegin("message", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Message); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ExptQualityGateRuleResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptQualityGateRuleResult_(%+v)", *p)
+
+}
+
+func (p *ExptQualityGateRuleResult_) DeepEqual(ano *ExptQualityGateRuleResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Rule) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Actual) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Message) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptQualityGateRuleResult_) Field1DeepEqual(src *ExptQualityGateRule) bool {
+
+	if !p.Rule.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRuleResult_) Field2DeepEqual(src *float64) bool {
+
+	if p.Actual == src {
+		return true
+	} else if p.Actual == nil || src == nil {
+		return false
+	}
+	if *p.Actual != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRuleResult_) Field3DeepEqual(src *QualityGateStatus) bool {
+
+	if p.Status == src {
+		return true
+	} else if p.Status == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Status, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateRuleResult_) Field4DeepEqual(src *string) bool {
+
+	if p.Message == src {
+		return true
+	} else if p.Message == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Message, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+// 质量门禁评估结果, 实验结束后异步写入, 与 domain/expt.thrift 同构
+type ExptQualityGateResult_ struct {
+	Status      *QualityGateStatus            `thrift:"status,1,optional" frugal:"1,optional,string" form:"status" json:"status,omitempty" query:"status"`
+	Passed      *bool                         `thrift:"passed,2,optional" frugal:"2,optional,bool" form:"passed" json:"passed,omitempty" query:"passed"`
+	RuleResults []*ExptQualityGateRuleResult_ `thrift:"rule_results,3,optional" frugal:"3,optional,list<ExptQualityGateRuleResult_>" form:"rule_results" json:"rule_results,omitempty" query:"rule_results"`
+	// 毫秒时间戳
+	EvaluatedAt *int64 `thrift:"evaluated_at,4,optional" frugal:"4,optional,i64" json:"evaluated_at" form:"evaluated_at" query:"evaluated_at"`
+}
+
+func NewExptQualityGateResult_() *ExptQualityGateResult_ {
+	return &ExptQualityGateResult_{}
+}
+
+func (p *ExptQualityGateResult_) InitDefault() {
+}
+
+var ExptQualityGateResult__Status_DEFAULT QualityGateStatus
+
+func (p *ExptQualityGateResult_) GetStatus() (v QualityGateStatus) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStatus() {
+		return ExptQualityGateResult__Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var ExptQualityGateResult__Passed_DEFAULT bool
+
+func (p *ExptQualityGateResult_) GetPassed() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPassed() {
+		return ExptQualityGateResult__Passed_DEFAULT
+	}
+	return *p.Passed
+}
+
+var ExptQualityGateResult__RuleResults_DEFAULT []*ExptQualityGateRuleResult_
+
+func (p *ExptQualityGateResult_) GetRuleResults() (v []*ExptQualityGateRuleResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRuleResults() {
+		return ExptQualityGateResult__RuleResults_DEFAULT
+	}
+	return p.RuleResults
+}
+
+var ExptQualityGateResult__EvaluatedAt_DEFAULT int64
+
+func (p *ExptQualityGateResult_) GetEvaluatedAt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatedAt() {
+		return ExptQualityGateResult__EvaluatedAt_DEFAULT
+	}
+	return *p.EvaluatedAt
+}
+func (p *ExptQualityGateResult_) SetStatus(val *QualityGateStatus) {
+	p.Status = val
+}
+func (p *ExptQualityGateResult_) SetPassed(val *bool) {
+	p.Passed = val
+}
+func (p *ExptQualityGateResult_) SetRuleResults(val []*ExptQualityGateRuleResult_) {
+	p.RuleResults = val
+}
+func (p *ExptQualityGateResult_) SetEvaluatedAt(val *int64) {
+	p.EvaluatedAt = val
+}
+
+var fieldIDToName_ExptQualityGateResult_ = map[int16]string{
+	1: "status",
+	2: "passed",
+	3: "rule_results",
+	4: "evaluated_at",
+}
+
+func (p *ExptQualityGateResult_) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *ExptQualityGateResult_) IsSetPassed() bool {
+	return p.Passed != nil
+}
+
+func (p *ExptQualityGateResult_) IsSetRuleResults() bool {
+	return p.RuleResults != nil
+}
+
+func (p *ExptQualityGateResult_) IsSetEvaluatedAt() bool {
+	return p.EvaluatedAt != nil
+}
+
+func (p *ExptQualityGateResult_) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptQualityGateResult_[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptQualityGateResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *QualityGateStatus
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *ExptQualityGateResult_) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Passed = _field
+	return nil
+}
+func (p *ExptQualityGateResult_) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExptQualityGateRuleResult_, 0, size)
+	values := make([]ExptQualityGateRuleResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.RuleResults = _field
+	return nil
+}
+func (p *ExptQualityGateResult_) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatedAt = _field
+	return nil
+}
+
+func (p *ExptQualityGateResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptQualityGateResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptQualityGateResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptQualityGateResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPassed() {
+		if err = oprot.WriteFieldBegin("passed", thrift.BOOL, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Passed); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptQualityGateResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRuleResults() {
+		if err = oprot.WriteFieldBegin("rule_results", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RuleResults)); err != nil {
+			return err
+		}
+		for _, v := range p.RuleResults {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptQualityGateResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatedAt() {
+		if err = oprot.WriteFieldBegin("evaluated_at", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluatedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ExptQualityGateResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptQualityGateResult_(%+v)", *p)
+
+}
+
+func (p *ExptQualityGateResult_) DeepEqual(ano *ExptQualityGateResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Passed) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.RuleResults) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.EvaluatedAt) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptQualityGateResult_) Field1DeepEqual(src *QualityGateStatus) bool {
+
+	if p.Status == src {
+		return true
+	} else if p.Status == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Status, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateResult_) Field2DeepEqual(src *bool) bool {
+
+	if p.Passed == src {
+		return true
+	} else if p.Passed == nil || src == nil {
+		return false
+	}
+	if *p.Passed != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptQualityGateResult_) Field3DeepEqual(src []*ExptQualityGateRuleResult_) bool {
+
+	if len(p.RuleResults) != len(src) {
+		return false
+	}
+	for i, v := range p.RuleResults {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ExptQualityGateResult_) Field4DeepEqual(src *int64) bool {
+
+	if p.EvaluatedAt == src {
+		return true
+	} else if p.EvaluatedAt == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatedAt != *src {
+		return false
+	}
+	return true
+}
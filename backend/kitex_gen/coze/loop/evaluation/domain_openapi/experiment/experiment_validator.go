@@ -160,6 +160,16 @@ func (p *Experiment) IsValid() error {
 			return fmt.Errorf("field RunModeConfig not valid, %w", err)
 		}
 	}
+	if p.QualityGateConf != nil {
+		if err := p.QualityGateConf.IsValid(); err != nil {
+			return fmt.Errorf("field QualityGateConf not valid, %w", err)
+		}
+	}
+	if p.QualityGateResult_ != nil {
+		if err := p.QualityGateResult_.IsValid(); err != nil {
+			return fmt.Errorf("field QualityGateResult_ not valid, %w", err)
+		}
+	}
 	if p.BaseInfo != nil {
 		if err := p.BaseInfo.IsValid(); err != nil {
 			return fmt.Errorf("field BaseInfo not valid, %w", err)
@@ -292,6 +302,11 @@ func (p *ExptTemplate) IsValid() error {
 			return fmt.Errorf("field NotificationConf not valid, %w", err)
 		}
 	}
+	if p.QualityGateConf != nil {
+		if err := p.QualityGateConf.IsValid(); err != nil {
+			return fmt.Errorf("field QualityGateConf not valid, %w", err)
+		}
+	}
 	if p.BaseInfo != nil {
 		if err := p.BaseInfo.IsValid(); err != nil {
 			return fmt.Errorf("field BaseInfo not valid, %w", err)
@@ -401,3 +416,26 @@ func (p *WebhookNotificationConf) IsValid() error {
 func (p *FeishuNotificationConf) IsValid() error {
 	return nil
 }
+func (p *ExperimentEvaluatorSignificance) IsValid() error {
+	return nil
+}
+func (p *ExperimentPairSignificance) IsValid() error {
+	return nil
+}
+func (p *ExptQualityGateRule) IsValid() error {
+	return nil
+}
+func (p *ExptQualityGateConf) IsValid() error {
+	return nil
+}
+func (p *ExptQualityGateRuleResult_) IsValid() error {
+	if p.Rule != nil {
+		if err := p.Rule.IsValid(); err != nil {
+			return fmt.Errorf("field Rule not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExptQualityGateResult_) IsValid() error {
+	return nil
+}
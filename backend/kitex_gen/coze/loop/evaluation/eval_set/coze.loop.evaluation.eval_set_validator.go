@@ -454,6 +454,46 @@ func (p *ListEvaluationSetVersionsResponse) IsValid() error {
 	}
 	return nil
 }
+func (p *DiffEvaluationSetVersionsRequest) IsValid() error {
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *DiffEvaluationSetVersionsResponse) IsValid() error {
+	if p.Diff != nil {
+		if err := p.Diff.IsValid(); err != nil {
+			return fmt.Errorf("field Diff not valid, %w", err)
+		}
+	}
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *MergeEvaluationSetVersionRequest) IsValid() error {
+	if len(p.ItemIds) > int(1000) {
+		return fmt.Errorf("field ItemIds MaxLen rule failed, current value: %v", p.ItemIds)
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *MergeEvaluationSetVersionResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
 func (p *UpdateEvaluationSetSchemaRequest) IsValid() error {
 	if p.Base != nil {
 		if err := p.Base.IsValid(); err != nil {
@@ -579,6 +619,149 @@ func (p *BatchDeleteEvaluationSetItemsResponse) IsValid() error {
 	}
 	return nil
 }
+func (p *StartEvaluationSetDedupJobRequest) IsValid() error {
+	if p.Conf != nil {
+		if err := p.Conf.IsValid(); err != nil {
+			return fmt.Errorf("field Conf not valid, %w", err)
+		}
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *StartEvaluationSetDedupJobResponse) IsValid() error {
+	if p.Job != nil {
+		if err := p.Job.IsValid(); err != nil {
+			return fmt.Errorf("field Job not valid, %w", err)
+		}
+	}
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GetEvaluationSetDedupJobRequest) IsValid() error {
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GetEvaluationSetDedupJobResponse) IsValid() error {
+	if p.Job != nil {
+		if err := p.Job.IsValid(); err != nil {
+			return fmt.Errorf("field Job not valid, %w", err)
+		}
+	}
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ArchiveEvaluationSetDuplicatesRequest) IsValid() error {
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ArchiveEvaluationSetDuplicatesResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GenerateEvaluationSetItemsRequest) IsValid() error {
+	if p.Conf != nil {
+		if err := p.Conf.IsValid(); err != nil {
+			return fmt.Errorf("field Conf not valid, %w", err)
+		}
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GenerateEvaluationSetItemsResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ListGeneratedEvaluationSetItemsRequest) IsValid() error {
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ListGeneratedEvaluationSetItemsResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ReviewGeneratedEvaluationSetItemsRequest) IsValid() error {
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ReviewGeneratedEvaluationSetItemsResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *EvaluationSetItemUsage) IsValid() error {
+	return nil
+}
+func (p *EvaluationSetItemTurnScore) IsValid() error {
+	return nil
+}
+func (p *GetEvaluationSetItemLineageRequest) IsValid() error {
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GetEvaluationSetItemLineageResponse) IsValid() error {
+	if p.Provenance != nil {
+		if err := p.Provenance.IsValid(); err != nil {
+			return fmt.Errorf("field Provenance not valid, %w", err)
+		}
+	}
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
 func (p *ListEvaluationSetItemsRequest) IsValid() error {
 	if p.SharedOption != nil {
 		if err := p.SharedOption.IsValid(); err != nil {
@@ -595,6 +778,11 @@ func (p *ListEvaluationSetItemsRequest) IsValid() error {
 			return fmt.Errorf("field TagFilter not valid, %w", err)
 		}
 	}
+	if p.ProvenanceFilter != nil {
+		if err := p.ProvenanceFilter.IsValid(); err != nil {
+			return fmt.Errorf("field ProvenanceFilter not valid, %w", err)
+		}
+	}
 	if p.Base != nil {
 		if err := p.Base.IsValid(); err != nil {
 			return fmt.Errorf("field Base not valid, %w", err)
@@ -16,6 +16,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/data/domain/dataset_job"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/common"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/eval_set"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/stone/fornax/ml_flow/domain/filter"
 )
 
@@ -25,6 +26,7 @@ var (
 	_ = dataset_job.KitexUnusedProtection
 	_ = common.KitexUnusedProtection
 	_ = eval_set.KitexUnusedProtection
+	_ = expt.KitexUnusedProtection
 	_ = filter.KitexUnusedProtection
 )
 
@@ -8710,7 +8712,7 @@ func (p *ListEvaluationSetVersionsResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *UpdateEvaluationSetSchemaRequest) FastRead(buf []byte) (int, error) {
+func (p *DiffEvaluationSetVersionsRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -8759,9 +8761,23 @@ func (p *UpdateEvaluationSetSchemaRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 10:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField10(buf[offset:])
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -8809,14 +8825,14 @@ func (p *UpdateEvaluationSetSchemaRequest) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateEvaluationSetSchemaRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DiffEvaluationSetVersionsRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_UpdateEvaluationSetSchemaRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_DiffEvaluationSetVersionsRequest[fieldId]))
 }
 
-func (p *UpdateEvaluationSetSchemaRequest) FastReadField1(buf []byte) (int, error) {
+func (p *DiffEvaluationSetVersionsRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -8830,7 +8846,7 @@ func (p *UpdateEvaluationSetSchemaRequest) FastReadField1(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *UpdateEvaluationSetSchemaRequest) FastReadField2(buf []byte) (int, error) {
+func (p *DiffEvaluationSetVersionsRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -8844,32 +8860,35 @@ func (p *UpdateEvaluationSetSchemaRequest) FastReadField2(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *UpdateEvaluationSetSchemaRequest) FastReadField10(buf []byte) (int, error) {
+func (p *DiffEvaluationSetVersionsRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*eval_set.FieldSchema, 0, size)
-	values := make([]eval_set.FieldSchema, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
+	p.BaseVersionID = _field
+	return offset, nil
+}
 
-		_field = append(_field, _elem)
+func (p *DiffEvaluationSetVersionsRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	p.Fields = _field
+	p.TargetVersionID = _field
 	return offset, nil
 }
 
-func (p *UpdateEvaluationSetSchemaRequest) FastReadField255(buf []byte) (int, error) {
+func (p *DiffEvaluationSetVersionsRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -8881,65 +8900,69 @@ func (p *UpdateEvaluationSetSchemaRequest) FastReadField255(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *UpdateEvaluationSetSchemaRequest) FastWrite(buf []byte) int {
+func (p *DiffEvaluationSetVersionsRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UpdateEvaluationSetSchemaRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *DiffEvaluationSetVersionsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField10(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *UpdateEvaluationSetSchemaRequest) BLength() int {
+func (p *DiffEvaluationSetVersionsRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
-		l += p.field10Length()
+		l += p.field3Length()
+		l += p.field4Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *UpdateEvaluationSetSchemaRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *DiffEvaluationSetVersionsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *UpdateEvaluationSetSchemaRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *DiffEvaluationSetVersionsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
 	return offset
 }
 
-func (p *UpdateEvaluationSetSchemaRequest) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+func (p *DiffEvaluationSetVersionsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFields() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 10)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.Fields {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetBaseVersionID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.BaseVersionID)
 	}
 	return offset
 }
 
-func (p *UpdateEvaluationSetSchemaRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *DiffEvaluationSetVersionsRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTargetVersionID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.TargetVersionID)
+	}
+	return offset
+}
+
+func (p *DiffEvaluationSetVersionsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -8948,34 +8971,39 @@ func (p *UpdateEvaluationSetSchemaRequest) fastWriteField255(buf []byte, w thrif
 	return offset
 }
 
-func (p *UpdateEvaluationSetSchemaRequest) field1Length() int {
+func (p *DiffEvaluationSetVersionsRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *UpdateEvaluationSetSchemaRequest) field2Length() int {
+func (p *DiffEvaluationSetVersionsRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *UpdateEvaluationSetSchemaRequest) field10Length() int {
+func (p *DiffEvaluationSetVersionsRequest) field3Length() int {
 	l := 0
-	if p.IsSetFields() {
+	if p.IsSetBaseVersionID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.Fields {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *UpdateEvaluationSetSchemaRequest) field255Length() int {
+func (p *DiffEvaluationSetVersionsRequest) field4Length() int {
+	l := 0
+	if p.IsSetTargetVersionID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *DiffEvaluationSetVersionsRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -8984,8 +9012,8 @@ func (p *UpdateEvaluationSetSchemaRequest) field255Length() int {
 	return l
 }
 
-func (p *UpdateEvaluationSetSchemaRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*UpdateEvaluationSetSchemaRequest)
+func (p *DiffEvaluationSetVersionsRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*DiffEvaluationSetVersionsRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
@@ -8994,19 +9022,14 @@ func (p *UpdateEvaluationSetSchemaRequest) DeepCopy(s interface{}) error {
 
 	p.EvaluationSetID = src.EvaluationSetID
 
-	if src.Fields != nil {
-		p.Fields = make([]*eval_set.FieldSchema, 0, len(src.Fields))
-		for _, elem := range src.Fields {
-			var _elem *eval_set.FieldSchema
-			if elem != nil {
-				_elem = &eval_set.FieldSchema{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
+	if src.BaseVersionID != nil {
+		tmp := *src.BaseVersionID
+		p.BaseVersionID = &tmp
+	}
 
-			p.Fields = append(p.Fields, _elem)
-		}
+	if src.TargetVersionID != nil {
+		tmp := *src.TargetVersionID
+		p.TargetVersionID = &tmp
 	}
 
 	var _base *base.Base
@@ -9021,7 +9044,7 @@ func (p *UpdateEvaluationSetSchemaRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *UpdateEvaluationSetSchemaResponse) FastRead(buf []byte) (int, error) {
+func (p *DiffEvaluationSetVersionsResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -9038,6 +9061,20 @@ func (p *UpdateEvaluationSetSchemaResponse) FastRead(buf []byte) (int, error) {
 			break
 		}
 		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -9065,12 +9102,24 @@ func (p *UpdateEvaluationSetSchemaResponse) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateEvaluationSetSchemaResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DiffEvaluationSetVersionsResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UpdateEvaluationSetSchemaResponse) FastReadField255(buf []byte) (int, error) {
+func (p *DiffEvaluationSetVersionsResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := eval_set.NewEvaluationSetVersionDiff()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Diff = _field
+	return offset, nil
+}
+
+func (p *DiffEvaluationSetVersionsResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -9082,48 +9131,77 @@ func (p *UpdateEvaluationSetSchemaResponse) FastReadField255(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *UpdateEvaluationSetSchemaResponse) FastWrite(buf []byte) int {
+func (p *DiffEvaluationSetVersionsResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UpdateEvaluationSetSchemaResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *DiffEvaluationSetVersionsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *UpdateEvaluationSetSchemaResponse) BLength() int {
+func (p *DiffEvaluationSetVersionsResponse) BLength() int {
 	l := 0
 	if p != nil {
+		l += p.field1Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *UpdateEvaluationSetSchemaResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *DiffEvaluationSetVersionsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDiff() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Diff.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *DiffEvaluationSetVersionsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UpdateEvaluationSetSchemaResponse) field255Length() int {
+func (p *DiffEvaluationSetVersionsResponse) field1Length() int {
+	l := 0
+	if p.IsSetDiff() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Diff.BLength()
+	}
+	return l
+}
+
+func (p *DiffEvaluationSetVersionsResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *UpdateEvaluationSetSchemaResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*UpdateEvaluationSetSchemaResponse)
+func (p *DiffEvaluationSetVersionsResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*DiffEvaluationSetVersionsResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
+	var _diff *eval_set.EvaluationSetVersionDiff
+	if src.Diff != nil {
+		_diff = &eval_set.EvaluationSetVersionDiff{}
+		if err := _diff.DeepCopy(src.Diff); err != nil {
+			return err
+		}
+	}
+	p.Diff = _diff
+
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
 		_baseResp = &base.BaseResp{}
@@ -9136,7 +9214,7 @@ func (p *UpdateEvaluationSetSchemaResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
+func (p *MergeEvaluationSetVersionRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -9145,6 +9223,7 @@ func (p *BatchCreateEvaluationSetItemsRequest) FastRead(buf []byte) (int, error)
 	var fieldId int16
 	var issetWorkspaceID bool = false
 	var issetEvaluationSetID bool = false
+	var issetVersionID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -9186,12 +9265,13 @@ func (p *BatchCreateEvaluationSetItemsRequest) FastRead(buf []byte) (int, error)
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetVersionID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -9199,9 +9279,9 @@ func (p *BatchCreateEvaluationSetItemsRequest) FastRead(buf []byte) (int, error)
 					goto SkipFieldError
 				}
 			}
-		case 10:
-			if fieldTypeId == thrift.BOOL {
-				l, err = p.FastReadField10(buf[offset:])
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -9213,9 +9293,9 @@ func (p *BatchCreateEvaluationSetItemsRequest) FastRead(buf []byte) (int, error)
 					goto SkipFieldError
 				}
 			}
-		case 11:
+		case 5:
 			if fieldTypeId == thrift.BOOL {
-				l, err = p.FastReadField11(buf[offset:])
+				l, err = p.FastReadField5(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -9227,9 +9307,9 @@ func (p *BatchCreateEvaluationSetItemsRequest) FastRead(buf []byte) (int, error)
 					goto SkipFieldError
 				}
 			}
-		case 12:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField12(buf[offset:])
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField6(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -9273,18 +9353,23 @@ func (p *BatchCreateEvaluationSetItemsRequest) FastRead(buf []byte) (int, error)
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetVersionID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchCreateEvaluationSetItemsRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MergeEvaluationSetVersionRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_BatchCreateEvaluationSetItemsRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_MergeEvaluationSetVersionRequest[fieldId]))
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) FastReadField1(buf []byte) (int, error) {
+func (p *MergeEvaluationSetVersionRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -9298,7 +9383,7 @@ func (p *BatchCreateEvaluationSetItemsRequest) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) FastReadField2(buf []byte) (int, error) {
+func (p *MergeEvaluationSetVersionRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -9312,7 +9397,21 @@ func (p *BatchCreateEvaluationSetItemsRequest) FastReadField2(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) FastReadField3(buf []byte) (int, error) {
+func (p *MergeEvaluationSetVersionRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.VersionID = _field
+	return offset, nil
+}
+
+func (p *MergeEvaluationSetVersionRequest) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -9320,24 +9419,23 @@ func (p *BatchCreateEvaluationSetItemsRequest) FastReadField3(buf []byte) (int,
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*eval_set.EvaluationSetItem, 0, size)
-	values := make([]eval_set.EvaluationSetItem, size)
+	_field := make([]int64, 0, size)
 	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
+			_elem = v
 		}
 
 		_field = append(_field, _elem)
 	}
-	p.Items = _field
+	p.ItemIds = _field
 	return offset, nil
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) FastReadField10(buf []byte) (int, error) {
+func (p *MergeEvaluationSetVersionRequest) FastReadField5(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *bool
@@ -9347,11 +9445,11 @@ func (p *BatchCreateEvaluationSetItemsRequest) FastReadField10(buf []byte) (int,
 		offset += l
 		_field = &v
 	}
-	p.SkipInvalidItems = _field
+	p.MergeAllItems = _field
 	return offset, nil
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) FastReadField11(buf []byte) (int, error) {
+func (p *MergeEvaluationSetVersionRequest) FastReadField6(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *bool
@@ -9361,36 +9459,11 @@ func (p *BatchCreateEvaluationSetItemsRequest) FastReadField11(buf []byte) (int,
 		offset += l
 		_field = &v
 	}
-	p.AllowPartialAdd = _field
-	return offset, nil
-}
-
-func (p *BatchCreateEvaluationSetItemsRequest) FastReadField12(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]*dataset.FieldWriteOption, 0, size)
-	values := make([]dataset.FieldWriteOption, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.FieldWriteOptions = _field
+	p.MergeSchema = _field
 	return offset, nil
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) FastReadField255(buf []byte) (int, error) {
+func (p *MergeEvaluationSetVersionRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -9402,105 +9475,96 @@ func (p *BatchCreateEvaluationSetItemsRequest) FastReadField255(buf []byte) (int
 	return offset, nil
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) FastWrite(buf []byte) int {
+func (p *MergeEvaluationSetVersionRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MergeEvaluationSetVersionRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField10(buf[offset:], w)
-		offset += p.fastWriteField11(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField12(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) BLength() int {
+func (p *MergeEvaluationSetVersionRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field10Length()
-		l += p.field11Length()
-		l += p.field12Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *MergeEvaluationSetVersionRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *MergeEvaluationSetVersionRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
 	return offset
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *MergeEvaluationSetVersionRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetItems() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.VersionID)
+	return offset
+}
+
+func (p *MergeEvaluationSetVersionRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemIds() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.Items {
+		for _, v := range p.ItemIds {
 			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
 		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *BatchCreateEvaluationSetItemsRequest) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetSkipInvalidItems() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 10)
-		offset += thrift.Binary.WriteBool(buf[offset:], *p.SkipInvalidItems)
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
 	}
 	return offset
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+func (p *MergeEvaluationSetVersionRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetAllowPartialAdd() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 11)
-		offset += thrift.Binary.WriteBool(buf[offset:], *p.AllowPartialAdd)
+	if p.IsSetMergeAllItems() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 5)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.MergeAllItems)
 	}
 	return offset
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
+func (p *MergeEvaluationSetVersionRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFieldWriteOptions() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 12)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.FieldWriteOptions {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetMergeSchema() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 6)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.MergeSchema)
 	}
 	return offset
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *MergeEvaluationSetVersionRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -9509,65 +9573,57 @@ func (p *BatchCreateEvaluationSetItemsRequest) fastWriteField255(buf []byte, w t
 	return offset
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) field1Length() int {
+func (p *MergeEvaluationSetVersionRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) field2Length() int {
+func (p *MergeEvaluationSetVersionRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) field3Length() int {
+func (p *MergeEvaluationSetVersionRequest) field3Length() int {
 	l := 0
-	if p.IsSetItems() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.Items {
-			_ = v
-			l += v.BLength()
-		}
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) field10Length() int {
+func (p *MergeEvaluationSetVersionRequest) field4Length() int {
 	l := 0
-	if p.IsSetSkipInvalidItems() {
+	if p.IsSetItemIds() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.BoolLength()
+		l += thrift.Binary.ListBeginLength()
+		l +=
+			thrift.Binary.I64Length() * len(p.ItemIds)
 	}
 	return l
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) field11Length() int {
+func (p *MergeEvaluationSetVersionRequest) field5Length() int {
 	l := 0
-	if p.IsSetAllowPartialAdd() {
+	if p.IsSetMergeAllItems() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.BoolLength()
 	}
 	return l
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) field12Length() int {
+func (p *MergeEvaluationSetVersionRequest) field6Length() int {
 	l := 0
-	if p.IsSetFieldWriteOptions() {
+	if p.IsSetMergeSchema() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.FieldWriteOptions {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.BoolLength()
 	}
 	return l
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) field255Length() int {
+func (p *MergeEvaluationSetVersionRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -9576,8 +9632,8 @@ func (p *BatchCreateEvaluationSetItemsRequest) field255Length() int {
 	return l
 }
 
-func (p *BatchCreateEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*BatchCreateEvaluationSetItemsRequest)
+func (p *MergeEvaluationSetVersionRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*MergeEvaluationSetVersionRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
@@ -9586,44 +9642,25 @@ func (p *BatchCreateEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
 
 	p.EvaluationSetID = src.EvaluationSetID
 
-	if src.Items != nil {
-		p.Items = make([]*eval_set.EvaluationSetItem, 0, len(src.Items))
-		for _, elem := range src.Items {
-			var _elem *eval_set.EvaluationSetItem
-			if elem != nil {
-				_elem = &eval_set.EvaluationSetItem{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
+	p.VersionID = src.VersionID
 
-			p.Items = append(p.Items, _elem)
+	if src.ItemIds != nil {
+		p.ItemIds = make([]int64, 0, len(src.ItemIds))
+		for _, elem := range src.ItemIds {
+			var _elem int64
+			_elem = elem
+			p.ItemIds = append(p.ItemIds, _elem)
 		}
 	}
 
-	if src.SkipInvalidItems != nil {
-		tmp := *src.SkipInvalidItems
-		p.SkipInvalidItems = &tmp
-	}
-
-	if src.AllowPartialAdd != nil {
-		tmp := *src.AllowPartialAdd
-		p.AllowPartialAdd = &tmp
+	if src.MergeAllItems != nil {
+		tmp := *src.MergeAllItems
+		p.MergeAllItems = &tmp
 	}
 
-	if src.FieldWriteOptions != nil {
-		p.FieldWriteOptions = make([]*dataset.FieldWriteOption, 0, len(src.FieldWriteOptions))
-		for _, elem := range src.FieldWriteOptions {
-			var _elem *dataset.FieldWriteOption
-			if elem != nil {
-				_elem = &dataset.FieldWriteOption{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.FieldWriteOptions = append(p.FieldWriteOptions, _elem)
-		}
+	if src.MergeSchema != nil {
+		tmp := *src.MergeSchema
+		p.MergeSchema = &tmp
 	}
 
 	var _base *base.Base
@@ -9638,7 +9675,7 @@ func (p *BatchCreateEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *BatchCreateEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
+func (p *MergeEvaluationSetVersionResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -9697,6 +9734,34 @@ func (p *BatchCreateEvaluationSetItemsResponse) FastRead(buf []byte) (int, error
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -9724,12 +9789,12 @@ func (p *BatchCreateEvaluationSetItemsResponse) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchCreateEvaluationSetItemsResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MergeEvaluationSetVersionResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *BatchCreateEvaluationSetItemsResponse) FastReadField1(buf []byte) (int, error) {
+func (p *MergeEvaluationSetVersionResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
@@ -9757,11 +9822,11 @@ func (p *BatchCreateEvaluationSetItemsResponse) FastReadField1(buf []byte) (int,
 
 		_field[_key] = _val
 	}
-	p.AddedItems = _field
+	p.AddedItemIds = _field
 	return offset, nil
 }
 
-func (p *BatchCreateEvaluationSetItemsResponse) FastReadField2(buf []byte) (int, error) {
+func (p *MergeEvaluationSetVersionResponse) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -9769,24 +9834,23 @@ func (p *BatchCreateEvaluationSetItemsResponse) FastReadField2(buf []byte) (int,
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*dataset.ItemErrorGroup, 0, size)
-	values := make([]dataset.ItemErrorGroup, size)
+	_field := make([]int64, 0, size)
 	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
+			_elem = v
 		}
 
 		_field = append(_field, _elem)
 	}
-	p.Errors = _field
+	p.UpdatedItemIds = _field
 	return offset, nil
 }
 
-func (p *BatchCreateEvaluationSetItemsResponse) FastReadField3(buf []byte) (int, error) {
+func (p *MergeEvaluationSetVersionResponse) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -9794,8 +9858,46 @@ func (p *BatchCreateEvaluationSetItemsResponse) FastReadField3(buf []byte) (int,
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*dataset.CreateDatasetItemOutput, 0, size)
-	values := make([]dataset.CreateDatasetItemOutput, size)
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.RemovedItemIds = _field
+	return offset, nil
+}
+
+func (p *MergeEvaluationSetVersionResponse) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.SchemaMerged = _field
+	return offset, nil
+}
+
+func (p *MergeEvaluationSetVersionResponse) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*dataset.ItemErrorGroup, 0, size)
+	values := make([]dataset.ItemErrorGroup, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -9807,11 +9909,11 @@ func (p *BatchCreateEvaluationSetItemsResponse) FastReadField3(buf []byte) (int,
 
 		_field = append(_field, _elem)
 	}
-	p.ItemOutputs = _field
+	p.Errors = _field
 	return offset, nil
 }
 
-func (p *BatchCreateEvaluationSetItemsResponse) FastReadField255(buf []byte) (int, error) {
+func (p *MergeEvaluationSetVersionResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -9823,42 +9925,46 @@ func (p *BatchCreateEvaluationSetItemsResponse) FastReadField255(buf []byte) (in
 	return offset, nil
 }
 
-func (p *BatchCreateEvaluationSetItemsResponse) FastWrite(buf []byte) int {
+func (p *MergeEvaluationSetVersionResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *BatchCreateEvaluationSetItemsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MergeEvaluationSetVersionResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *BatchCreateEvaluationSetItemsResponse) BLength() int {
+func (p *MergeEvaluationSetVersionResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *BatchCreateEvaluationSetItemsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *MergeEvaluationSetVersionResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetAddedItems() {
+	if p.IsSetAddedItemIds() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 1)
 		mapBeginOffset := offset
 		offset += thrift.Binary.MapBeginLength()
 		var length int
-		for k, v := range p.AddedItems {
+		for k, v := range p.AddedItemIds {
 			length++
 			offset += thrift.Binary.WriteI64(buf[offset:], k)
 			offset += thrift.Binary.WriteI64(buf[offset:], v)
@@ -9868,75 +9974,118 @@ func (p *BatchCreateEvaluationSetItemsResponse) fastWriteField1(buf []byte, w th
 	return offset
 }
 
-func (p *BatchCreateEvaluationSetItemsResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *MergeEvaluationSetVersionResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetErrors() {
+	if p.IsSetUpdatedItemIds() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.Errors {
+		for _, v := range p.UpdatedItemIds {
 			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
 		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
 	}
 	return offset
 }
 
-func (p *BatchCreateEvaluationSetItemsResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *MergeEvaluationSetVersionResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetItemOutputs() {
+	if p.IsSetRemovedItemIds() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.ItemOutputs {
+		for _, v := range p.RemovedItemIds {
 			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
 		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
 	}
 	return offset
 }
 
-func (p *BatchCreateEvaluationSetItemsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *MergeEvaluationSetVersionResponse) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetSchemaMerged() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 4)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.SchemaMerged)
+	}
 	return offset
 }
 
-func (p *BatchCreateEvaluationSetItemsResponse) field1Length() int {
-	l := 0
-	if p.IsSetAddedItems() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.MapBeginLength()
-		l += (thrift.Binary.I64Length() +
-			thrift.Binary.I64Length()) * len(p.AddedItems)
-	}
-	return l
-}
+func (p *MergeEvaluationSetVersionResponse) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetErrors() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 5)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Errors {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
 
-func (p *BatchCreateEvaluationSetItemsResponse) field2Length() int {
+func (p *MergeEvaluationSetVersionResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *MergeEvaluationSetVersionResponse) field1Length() int {
 	l := 0
-	if p.IsSetErrors() {
+	if p.IsSetAddedItemIds() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		l += (thrift.Binary.I64Length() +
+			thrift.Binary.I64Length()) * len(p.AddedItemIds)
+	}
+	return l
+}
+
+func (p *MergeEvaluationSetVersionResponse) field2Length() int {
+	l := 0
+	if p.IsSetUpdatedItemIds() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.Errors {
-			_ = v
-			l += v.BLength()
-		}
+		l +=
+			thrift.Binary.I64Length() * len(p.UpdatedItemIds)
 	}
 	return l
 }
 
-func (p *BatchCreateEvaluationSetItemsResponse) field3Length() int {
+func (p *MergeEvaluationSetVersionResponse) field3Length() int {
 	l := 0
-	if p.IsSetItemOutputs() {
+	if p.IsSetRemovedItemIds() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.ItemOutputs {
+		l +=
+			thrift.Binary.I64Length() * len(p.RemovedItemIds)
+	}
+	return l
+}
+
+func (p *MergeEvaluationSetVersionResponse) field4Length() int {
+	l := 0
+	if p.IsSetSchemaMerged() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *MergeEvaluationSetVersionResponse) field5Length() int {
+	l := 0
+	if p.IsSetErrors() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Errors {
 			_ = v
 			l += v.BLength()
 		}
@@ -9944,32 +10093,55 @@ func (p *BatchCreateEvaluationSetItemsResponse) field3Length() int {
 	return l
 }
 
-func (p *BatchCreateEvaluationSetItemsResponse) field255Length() int {
+func (p *MergeEvaluationSetVersionResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *BatchCreateEvaluationSetItemsResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*BatchCreateEvaluationSetItemsResponse)
+func (p *MergeEvaluationSetVersionResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*MergeEvaluationSetVersionResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.AddedItems != nil {
-		p.AddedItems = make(map[int64]int64, len(src.AddedItems))
-		for key, val := range src.AddedItems {
+	if src.AddedItemIds != nil {
+		p.AddedItemIds = make(map[int64]int64, len(src.AddedItemIds))
+		for key, val := range src.AddedItemIds {
 			var _key int64
 			_key = key
 
 			var _val int64
 			_val = val
 
-			p.AddedItems[_key] = _val
+			p.AddedItemIds[_key] = _val
+		}
+	}
+
+	if src.UpdatedItemIds != nil {
+		p.UpdatedItemIds = make([]int64, 0, len(src.UpdatedItemIds))
+		for _, elem := range src.UpdatedItemIds {
+			var _elem int64
+			_elem = elem
+			p.UpdatedItemIds = append(p.UpdatedItemIds, _elem)
+		}
+	}
+
+	if src.RemovedItemIds != nil {
+		p.RemovedItemIds = make([]int64, 0, len(src.RemovedItemIds))
+		for _, elem := range src.RemovedItemIds {
+			var _elem int64
+			_elem = elem
+			p.RemovedItemIds = append(p.RemovedItemIds, _elem)
 		}
 	}
 
+	if src.SchemaMerged != nil {
+		tmp := *src.SchemaMerged
+		p.SchemaMerged = &tmp
+	}
+
 	if src.Errors != nil {
 		p.Errors = make([]*dataset.ItemErrorGroup, 0, len(src.Errors))
 		for _, elem := range src.Errors {
@@ -9985,21 +10157,6 @@ func (p *BatchCreateEvaluationSetItemsResponse) DeepCopy(s interface{}) error {
 		}
 	}
 
-	if src.ItemOutputs != nil {
-		p.ItemOutputs = make([]*dataset.CreateDatasetItemOutput, 0, len(src.ItemOutputs))
-		for _, elem := range src.ItemOutputs {
-			var _elem *dataset.CreateDatasetItemOutput
-			if elem != nil {
-				_elem = &dataset.CreateDatasetItemOutput{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.ItemOutputs = append(p.ItemOutputs, _elem)
-		}
-	}
-
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
 		_baseResp = &base.BaseResp{}
@@ -10012,7 +10169,7 @@ func (p *BatchCreateEvaluationSetItemsResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *UpdateEvaluationSetItemRequest) FastRead(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetSchemaRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -10021,7 +10178,6 @@ func (p *UpdateEvaluationSetItemRequest) FastRead(buf []byte) (int, error) {
 	var fieldId int16
 	var issetWorkspaceID bool = false
 	var issetEvaluationSetID bool = false
-	var issetItemID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -10062,49 +10218,6 @@ func (p *UpdateEvaluationSetItemRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField3(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetItemID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField5(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField6(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		case 10:
 			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField10(buf[offset:])
@@ -10119,48 +10232,6 @@ func (p *UpdateEvaluationSetItemRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 20:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField20(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 21:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField21(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 22:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField22(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -10193,23 +10264,18 @@ func (p *UpdateEvaluationSetItemRequest) FastRead(buf []byte) (int, error) {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetItemID {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateEvaluationSetItemRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateEvaluationSetSchemaRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_UpdateEvaluationSetItemRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_UpdateEvaluationSetSchemaRequest[fieldId]))
 }
 
-func (p *UpdateEvaluationSetItemRequest) FastReadField1(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetSchemaRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -10223,7 +10289,7 @@ func (p *UpdateEvaluationSetItemRequest) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *UpdateEvaluationSetItemRequest) FastReadField2(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetSchemaRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -10237,21 +10303,7 @@ func (p *UpdateEvaluationSetItemRequest) FastReadField2(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *UpdateEvaluationSetItemRequest) FastReadField3(buf []byte) (int, error) {
-	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.ItemID = _field
-	return offset, nil
-}
-
-func (p *UpdateEvaluationSetItemRequest) FastReadField5(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetSchemaRequest) FastReadField10(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -10259,8 +10311,8 @@ func (p *UpdateEvaluationSetItemRequest) FastReadField5(buf []byte) (int, error)
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*eval_set.Turn, 0, size)
-	values := make([]eval_set.Turn, size)
+	_field := make([]*eval_set.FieldSchema, 0, size)
+	values := make([]eval_set.FieldSchema, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -10272,215 +10324,72 @@ func (p *UpdateEvaluationSetItemRequest) FastReadField5(buf []byte) (int, error)
 
 		_field = append(_field, _elem)
 	}
-	p.Turns = _field
+	p.Fields = _field
 	return offset, nil
 }
 
-func (p *UpdateEvaluationSetItemRequest) FastReadField6(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetSchemaRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
 	}
-	_field := make([]*eval_set.ResourceTagRef, 0, size)
-	values := make([]eval_set.ResourceTagRef, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.Tags = _field
+	p.Base = _field
 	return offset, nil
 }
 
-func (p *UpdateEvaluationSetItemRequest) FastReadField10(buf []byte) (int, error) {
-	offset := 0
+func (p *UpdateEvaluationSetSchemaRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]*dataset.FieldWriteOption, 0, size)
-	values := make([]dataset.FieldWriteOption, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.FieldWriteOptions = _field
-	return offset, nil
-}
-
-func (p *UpdateEvaluationSetItemRequest) FastReadField20(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.ItemVersion = _field
-	return offset, nil
-}
-
-func (p *UpdateEvaluationSetItemRequest) FastReadField21(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.ItemVersionDescription = _field
-	return offset, nil
-}
-
-func (p *UpdateEvaluationSetItemRequest) FastReadField22(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.ItemVersionStatus = _field
-	return offset, nil
-}
-
-func (p *UpdateEvaluationSetItemRequest) FastReadField255(buf []byte) (int, error) {
-	offset := 0
-	_field := base.NewBase()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.Base = _field
-	return offset, nil
-}
-
-func (p *UpdateEvaluationSetItemRequest) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
-
-func (p *UpdateEvaluationSetItemRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
-		offset += p.fastWriteField10(buf[offset:], w)
-		offset += p.fastWriteField20(buf[offset:], w)
-		offset += p.fastWriteField21(buf[offset:], w)
-		offset += p.fastWriteField22(buf[offset:], w)
-		offset += p.fastWriteField255(buf[offset:], w)
+func (p *UpdateEvaluationSetSchemaRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *UpdateEvaluationSetItemRequest) BLength() int {
+func (p *UpdateEvaluationSetSchemaRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
-		l += p.field3Length()
-		l += p.field5Length()
-		l += p.field6Length()
 		l += p.field10Length()
-		l += p.field20Length()
-		l += p.field21Length()
-		l += p.field22Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *UpdateEvaluationSetItemRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateEvaluationSetSchemaRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *UpdateEvaluationSetItemRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateEvaluationSetSchemaRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
 	return offset
 }
 
-func (p *UpdateEvaluationSetItemRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
-	return offset
-}
-
-func (p *UpdateEvaluationSetItemRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetTurns() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 5)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.Turns {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *UpdateEvaluationSetItemRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetTags() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 6)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.Tags {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *UpdateEvaluationSetItemRequest) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateEvaluationSetSchemaRequest) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFieldWriteOptions() {
+	if p.IsSetFields() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 10)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.FieldWriteOptions {
+		for _, v := range p.Fields {
 			length++
 			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
@@ -10489,34 +10398,7 @@ func (p *UpdateEvaluationSetItemRequest) fastWriteField10(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *UpdateEvaluationSetItemRequest) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetItemVersion() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 20)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ItemVersion)
-	}
-	return offset
-}
-
-func (p *UpdateEvaluationSetItemRequest) fastWriteField21(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetItemVersionDescription() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 21)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ItemVersionDescription)
-	}
-	return offset
-}
-
-func (p *UpdateEvaluationSetItemRequest) fastWriteField22(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetItemVersionStatus() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 22)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ItemVersionStatus)
-	}
-	return offset
-}
-
-func (p *UpdateEvaluationSetItemRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateEvaluationSetSchemaRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -10525,59 +10407,26 @@ func (p *UpdateEvaluationSetItemRequest) fastWriteField255(buf []byte, w thrift.
 	return offset
 }
 
-func (p *UpdateEvaluationSetItemRequest) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
-
-func (p *UpdateEvaluationSetItemRequest) field2Length() int {
+func (p *UpdateEvaluationSetSchemaRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *UpdateEvaluationSetItemRequest) field3Length() int {
+func (p *UpdateEvaluationSetSchemaRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *UpdateEvaluationSetItemRequest) field5Length() int {
-	l := 0
-	if p.IsSetTurns() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.Turns {
-			_ = v
-			l += v.BLength()
-		}
-	}
-	return l
-}
-
-func (p *UpdateEvaluationSetItemRequest) field6Length() int {
-	l := 0
-	if p.IsSetTags() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.Tags {
-			_ = v
-			l += v.BLength()
-		}
-	}
-	return l
-}
-
-func (p *UpdateEvaluationSetItemRequest) field10Length() int {
+func (p *UpdateEvaluationSetSchemaRequest) field10Length() int {
 	l := 0
-	if p.IsSetFieldWriteOptions() {
+	if p.IsSetFields() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.FieldWriteOptions {
+		for _, v := range p.Fields {
 			_ = v
 			l += v.BLength()
 		}
@@ -10585,34 +10434,7 @@ func (p *UpdateEvaluationSetItemRequest) field10Length() int {
 	return l
 }
 
-func (p *UpdateEvaluationSetItemRequest) field20Length() int {
-	l := 0
-	if p.IsSetItemVersion() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.ItemVersion)
-	}
-	return l
-}
-
-func (p *UpdateEvaluationSetItemRequest) field21Length() int {
-	l := 0
-	if p.IsSetItemVersionDescription() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.ItemVersionDescription)
-	}
-	return l
-}
-
-func (p *UpdateEvaluationSetItemRequest) field22Length() int {
-	l := 0
-	if p.IsSetItemVersionStatus() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.ItemVersionStatus)
-	}
-	return l
-}
-
-func (p *UpdateEvaluationSetItemRequest) field255Length() int {
+func (p *UpdateEvaluationSetSchemaRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -10621,8 +10443,8 @@ func (p *UpdateEvaluationSetItemRequest) field255Length() int {
 	return l
 }
 
-func (p *UpdateEvaluationSetItemRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*UpdateEvaluationSetItemRequest)
+func (p *UpdateEvaluationSetSchemaRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*UpdateEvaluationSetSchemaRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
@@ -10631,75 +10453,19 @@ func (p *UpdateEvaluationSetItemRequest) DeepCopy(s interface{}) error {
 
 	p.EvaluationSetID = src.EvaluationSetID
 
-	p.ItemID = src.ItemID
-
-	if src.Turns != nil {
-		p.Turns = make([]*eval_set.Turn, 0, len(src.Turns))
-		for _, elem := range src.Turns {
-			var _elem *eval_set.Turn
-			if elem != nil {
-				_elem = &eval_set.Turn{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.Turns = append(p.Turns, _elem)
-		}
-	}
-
-	if src.Tags != nil {
-		p.Tags = make([]*eval_set.ResourceTagRef, 0, len(src.Tags))
-		for _, elem := range src.Tags {
-			var _elem *eval_set.ResourceTagRef
-			if elem != nil {
-				_elem = &eval_set.ResourceTagRef{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.Tags = append(p.Tags, _elem)
-		}
-	}
-
-	if src.FieldWriteOptions != nil {
-		p.FieldWriteOptions = make([]*dataset.FieldWriteOption, 0, len(src.FieldWriteOptions))
-		for _, elem := range src.FieldWriteOptions {
-			var _elem *dataset.FieldWriteOption
+	if src.Fields != nil {
+		p.Fields = make([]*eval_set.FieldSchema, 0, len(src.Fields))
+		for _, elem := range src.Fields {
+			var _elem *eval_set.FieldSchema
 			if elem != nil {
-				_elem = &dataset.FieldWriteOption{}
+				_elem = &eval_set.FieldSchema{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
 			}
 
-			p.FieldWriteOptions = append(p.FieldWriteOptions, _elem)
-		}
-	}
-
-	if src.ItemVersion != nil {
-		var tmp string
-		if *src.ItemVersion != "" {
-			tmp = kutils.StringDeepCopy(*src.ItemVersion)
-		}
-		p.ItemVersion = &tmp
-	}
-
-	if src.ItemVersionDescription != nil {
-		var tmp string
-		if *src.ItemVersionDescription != "" {
-			tmp = kutils.StringDeepCopy(*src.ItemVersionDescription)
-		}
-		p.ItemVersionDescription = &tmp
-	}
-
-	if src.ItemVersionStatus != nil {
-		var tmp string
-		if *src.ItemVersionStatus != "" {
-			tmp = kutils.StringDeepCopy(*src.ItemVersionStatus)
+			p.Fields = append(p.Fields, _elem)
 		}
-		p.ItemVersionStatus = &tmp
 	}
 
 	var _base *base.Base
@@ -10714,7 +10480,7 @@ func (p *UpdateEvaluationSetItemRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *UpdateEvaluationSetItemResponse) FastRead(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetSchemaResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -10758,12 +10524,12 @@ func (p *UpdateEvaluationSetItemResponse) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateEvaluationSetItemResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateEvaluationSetSchemaResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UpdateEvaluationSetItemResponse) FastReadField255(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetSchemaResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -10775,11 +10541,11 @@ func (p *UpdateEvaluationSetItemResponse) FastReadField255(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *UpdateEvaluationSetItemResponse) FastWrite(buf []byte) int {
+func (p *UpdateEvaluationSetSchemaResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UpdateEvaluationSetItemResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateEvaluationSetSchemaResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField255(buf[offset:], w)
@@ -10788,7 +10554,7 @@ func (p *UpdateEvaluationSetItemResponse) FastWriteNocopy(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *UpdateEvaluationSetItemResponse) BLength() int {
+func (p *UpdateEvaluationSetSchemaResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field255Length()
@@ -10797,22 +10563,22 @@ func (p *UpdateEvaluationSetItemResponse) BLength() int {
 	return l
 }
 
-func (p *UpdateEvaluationSetItemResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateEvaluationSetSchemaResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UpdateEvaluationSetItemResponse) field255Length() int {
+func (p *UpdateEvaluationSetSchemaResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *UpdateEvaluationSetItemResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*UpdateEvaluationSetItemResponse)
+func (p *UpdateEvaluationSetSchemaResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*UpdateEvaluationSetSchemaResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
@@ -10829,7 +10595,7 @@ func (p *UpdateEvaluationSetItemResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *DeleteEvaluationSetItemRequest) FastRead(buf []byte) (int, error) {
+func (p *BatchCreateEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -10838,7 +10604,6 @@ func (p *DeleteEvaluationSetItemRequest) FastRead(buf []byte) (int, error) {
 	var fieldId int16
 	var issetWorkspaceID bool = false
 	var issetEvaluationSetID bool = false
-	var issetItemID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -10880,13 +10645,54 @@ func (p *DeleteEvaluationSetItemRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetItemID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField10(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField11(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 12:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField12(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -10926,23 +10732,18 @@ func (p *DeleteEvaluationSetItemRequest) FastRead(buf []byte) (int, error) {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetItemID {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteEvaluationSetItemRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchCreateEvaluationSetItemsRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_DeleteEvaluationSetItemRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_BatchCreateEvaluationSetItemsRequest[fieldId]))
 }
 
-func (p *DeleteEvaluationSetItemRequest) FastReadField1(buf []byte) (int, error) {
+func (p *BatchCreateEvaluationSetItemsRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -10956,7 +10757,7 @@ func (p *DeleteEvaluationSetItemRequest) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *DeleteEvaluationSetItemRequest) FastReadField2(buf []byte) (int, error) {
+func (p *BatchCreateEvaluationSetItemsRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -10970,21 +10771,85 @@ func (p *DeleteEvaluationSetItemRequest) FastReadField2(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *DeleteEvaluationSetItemRequest) FastReadField3(buf []byte) (int, error) {
+func (p *BatchCreateEvaluationSetItemsRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*eval_set.EvaluationSetItem, 0, size)
+	values := make([]eval_set.EvaluationSetItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Items = _field
+	return offset, nil
+}
+
+func (p *BatchCreateEvaluationSetItemsRequest) FastReadField10(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.ItemID = _field
+	p.SkipInvalidItems = _field
 	return offset, nil
 }
 
-func (p *DeleteEvaluationSetItemRequest) FastReadField255(buf []byte) (int, error) {
+func (p *BatchCreateEvaluationSetItemsRequest) FastReadField11(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.AllowPartialAdd = _field
+	return offset, nil
+}
+
+func (p *BatchCreateEvaluationSetItemsRequest) FastReadField12(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*dataset.FieldWriteOption, 0, size)
+	values := make([]dataset.FieldWriteOption, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.FieldWriteOptions = _field
+	return offset, nil
+}
+
+func (p *BatchCreateEvaluationSetItemsRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -10996,86 +10861,172 @@ func (p *DeleteEvaluationSetItemRequest) FastReadField255(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *DeleteEvaluationSetItemRequest) FastWrite(buf []byte) int {
+func (p *BatchCreateEvaluationSetItemsRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *DeleteEvaluationSetItemRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchCreateEvaluationSetItemsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
+		offset += p.fastWriteField11(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField12(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *DeleteEvaluationSetItemRequest) BLength() int {
+func (p *BatchCreateEvaluationSetItemsRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field10Length()
+		l += p.field11Length()
+		l += p.field12Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *DeleteEvaluationSetItemRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchCreateEvaluationSetItemsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *DeleteEvaluationSetItemRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchCreateEvaluationSetItemsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
 	return offset
 }
 
-func (p *DeleteEvaluationSetItemRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchCreateEvaluationSetItemsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
+	if p.IsSetItems() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Items {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
 	return offset
 }
 
-func (p *DeleteEvaluationSetItemRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchCreateEvaluationSetItemsRequest) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetBase() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetSkipInvalidItems() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 10)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.SkipInvalidItems)
 	}
 	return offset
 }
 
-func (p *DeleteEvaluationSetItemRequest) field1Length() int {
+func (p *BatchCreateEvaluationSetItemsRequest) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetAllowPartialAdd() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 11)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.AllowPartialAdd)
+	}
+	return offset
+}
+
+func (p *BatchCreateEvaluationSetItemsRequest) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFieldWriteOptions() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 12)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.FieldWriteOptions {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *BatchCreateEvaluationSetItemsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *BatchCreateEvaluationSetItemsRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *DeleteEvaluationSetItemRequest) field2Length() int {
+func (p *BatchCreateEvaluationSetItemsRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *DeleteEvaluationSetItemRequest) field3Length() int {
+func (p *BatchCreateEvaluationSetItemsRequest) field3Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetItems() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Items {
+			_ = v
+			l += v.BLength()
+		}
+	}
 	return l
 }
 
-func (p *DeleteEvaluationSetItemRequest) field255Length() int {
+func (p *BatchCreateEvaluationSetItemsRequest) field10Length() int {
+	l := 0
+	if p.IsSetSkipInvalidItems() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *BatchCreateEvaluationSetItemsRequest) field11Length() int {
+	l := 0
+	if p.IsSetAllowPartialAdd() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *BatchCreateEvaluationSetItemsRequest) field12Length() int {
+	l := 0
+	if p.IsSetFieldWriteOptions() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.FieldWriteOptions {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *BatchCreateEvaluationSetItemsRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -11084,8 +11035,8 @@ func (p *DeleteEvaluationSetItemRequest) field255Length() int {
 	return l
 }
 
-func (p *DeleteEvaluationSetItemRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*DeleteEvaluationSetItemRequest)
+func (p *BatchCreateEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*BatchCreateEvaluationSetItemsRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
@@ -11094,144 +11045,65 @@ func (p *DeleteEvaluationSetItemRequest) DeepCopy(s interface{}) error {
 
 	p.EvaluationSetID = src.EvaluationSetID
 
-	p.ItemID = src.ItemID
-
-	var _base *base.Base
-	if src.Base != nil {
-		_base = &base.Base{}
-		if err := _base.DeepCopy(src.Base); err != nil {
-			return err
-		}
-	}
-	p.Base = _base
-
-	return nil
-}
-
-func (p *DeleteEvaluationSetItemResponse) FastRead(buf []byte) (int, error) {
-
-	var err error
-	var offset int
-	var l int
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	for {
-		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
-		offset += l
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 255:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
+	if src.Items != nil {
+		p.Items = make([]*eval_set.EvaluationSetItem, 0, len(src.Items))
+		for _, elem := range src.Items {
+			var _elem *eval_set.EvaluationSetItem
+			if elem != nil {
+				_elem = &eval_set.EvaluationSetItem{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
 				}
 			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
-			}
-		}
-	}
-
-	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteEvaluationSetItemResponse[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-}
 
-func (p *DeleteEvaluationSetItemResponse) FastReadField255(buf []byte) (int, error) {
-	offset := 0
-	_field := base.NewBaseResp()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
+			p.Items = append(p.Items, _elem)
+		}
 	}
-	p.BaseResp = _field
-	return offset, nil
-}
-
-func (p *DeleteEvaluationSetItemResponse) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
 
-func (p *DeleteEvaluationSetItemResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p != nil {
-		offset += p.fastWriteField255(buf[offset:], w)
+	if src.SkipInvalidItems != nil {
+		tmp := *src.SkipInvalidItems
+		p.SkipInvalidItems = &tmp
 	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
-}
 
-func (p *DeleteEvaluationSetItemResponse) BLength() int {
-	l := 0
-	if p != nil {
-		l += p.field255Length()
+	if src.AllowPartialAdd != nil {
+		tmp := *src.AllowPartialAdd
+		p.AllowPartialAdd = &tmp
 	}
-	l += thrift.Binary.FieldStopLength()
-	return l
-}
-
-func (p *DeleteEvaluationSetItemResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
-	return offset
-}
 
-func (p *DeleteEvaluationSetItemResponse) field255Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.BaseResp.BLength()
-	return l
-}
+	if src.FieldWriteOptions != nil {
+		p.FieldWriteOptions = make([]*dataset.FieldWriteOption, 0, len(src.FieldWriteOptions))
+		for _, elem := range src.FieldWriteOptions {
+			var _elem *dataset.FieldWriteOption
+			if elem != nil {
+				_elem = &dataset.FieldWriteOption{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
 
-func (p *DeleteEvaluationSetItemResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*DeleteEvaluationSetItemResponse)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
+			p.FieldWriteOptions = append(p.FieldWriteOptions, _elem)
+		}
 	}
 
-	var _baseResp *base.BaseResp
-	if src.BaseResp != nil {
-		_baseResp = &base.BaseResp{}
-		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
 			return err
 		}
 	}
-	p.BaseResp = _baseResp
+	p.Base = _base
 
 	return nil
 }
 
-func (p *BatchDeleteEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
+func (p *BatchCreateEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetWorkspaceID bool = false
-	var issetEvaluationSetID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -11243,13 +11115,12 @@ func (p *BatchDeleteEvaluationSetItemsRequest) FastRead(buf []byte) (int, error)
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.MAP {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetWorkspaceID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -11258,13 +11129,12 @@ func (p *BatchDeleteEvaluationSetItemsRequest) FastRead(buf []byte) (int, error)
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetEvaluationSetID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -11309,55 +11179,73 @@ func (p *BatchDeleteEvaluationSetItemsRequest) FastRead(buf []byte) (int, error)
 		}
 	}
 
-	if !issetWorkspaceID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetEvaluationSetID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchDeleteEvaluationSetItemsRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchCreateEvaluationSetItemsResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_BatchDeleteEvaluationSetItemsRequest[fieldId]))
 }
 
-func (p *BatchDeleteEvaluationSetItemsRequest) FastReadField1(buf []byte) (int, error) {
+func (p *BatchCreateEvaluationSetItemsResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = v
 	}
-	p.WorkspaceID = _field
+	_field := make(map[int64]int64, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		var _val int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	p.AddedItems = _field
 	return offset, nil
 }
 
-func (p *BatchDeleteEvaluationSetItemsRequest) FastReadField2(buf []byte) (int, error) {
+func (p *BatchCreateEvaluationSetItemsResponse) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = v
 	}
-	p.EvaluationSetID = _field
+	_field := make([]*dataset.ItemErrorGroup, 0, size)
+	values := make([]dataset.ItemErrorGroup, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Errors = _field
 	return offset, nil
 }
 
-func (p *BatchDeleteEvaluationSetItemsRequest) FastReadField3(buf []byte) (int, error) {
+func (p *BatchCreateEvaluationSetItemsResponse) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -11365,39 +11253,40 @@ func (p *BatchDeleteEvaluationSetItemsRequest) FastReadField3(buf []byte) (int,
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]int64, 0, size)
+	_field := make([]*dataset.CreateDatasetItemOutput, 0, size)
+	values := make([]dataset.CreateDatasetItemOutput, size)
 	for i := 0; i < size; i++ {
-		var _elem int64
-		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
-			_elem = v
 		}
 
 		_field = append(_field, _elem)
 	}
-	p.ItemIds = _field
+	p.ItemOutputs = _field
 	return offset, nil
 }
 
-func (p *BatchDeleteEvaluationSetItemsRequest) FastReadField255(buf []byte) (int, error) {
+func (p *BatchCreateEvaluationSetItemsResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBase()
+	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Base = _field
+	p.BaseResp = _field
 	return offset, nil
 }
 
-func (p *BatchDeleteEvaluationSetItemsRequest) FastWrite(buf []byte) int {
+func (p *BatchCreateEvaluationSetItemsResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *BatchDeleteEvaluationSetItemsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchCreateEvaluationSetItemsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -11409,7 +11298,7 @@ func (p *BatchDeleteEvaluationSetItemsRequest) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *BatchDeleteEvaluationSetItemsRequest) BLength() int {
+func (p *BatchCreateEvaluationSetItemsResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -11421,117 +11310,177 @@ func (p *BatchDeleteEvaluationSetItemsRequest) BLength() int {
 	return l
 }
 
-func (p *BatchDeleteEvaluationSetItemsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchCreateEvaluationSetItemsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	if p.IsSetAddedItems() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 1)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.AddedItems {
+			length++
+			offset += thrift.Binary.WriteI64(buf[offset:], k)
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.I64, thrift.I64, length)
+	}
 	return offset
 }
 
-func (p *BatchDeleteEvaluationSetItemsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchCreateEvaluationSetItemsResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
+	if p.IsSetErrors() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Errors {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
 	return offset
 }
 
-func (p *BatchDeleteEvaluationSetItemsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchCreateEvaluationSetItemsResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetItemIds() {
+	if p.IsSetItemOutputs() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.ItemIds {
+		for _, v := range p.ItemOutputs {
 			length++
-			offset += thrift.Binary.WriteI64(buf[offset:], v)
+			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *BatchDeleteEvaluationSetItemsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchCreateEvaluationSetItemsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetBase() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-		offset += p.Base.FastWriteNocopy(buf[offset:], w)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *BatchDeleteEvaluationSetItemsRequest) field1Length() int {
+func (p *BatchCreateEvaluationSetItemsResponse) field1Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetAddedItems() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		l += (thrift.Binary.I64Length() +
+			thrift.Binary.I64Length()) * len(p.AddedItems)
+	}
 	return l
 }
 
-func (p *BatchDeleteEvaluationSetItemsRequest) field2Length() int {
+func (p *BatchCreateEvaluationSetItemsResponse) field2Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetErrors() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Errors {
+			_ = v
+			l += v.BLength()
+		}
+	}
 	return l
 }
 
-func (p *BatchDeleteEvaluationSetItemsRequest) field3Length() int {
+func (p *BatchCreateEvaluationSetItemsResponse) field3Length() int {
 	l := 0
-	if p.IsSetItemIds() {
+	if p.IsSetItemOutputs() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		l +=
-			thrift.Binary.I64Length() * len(p.ItemIds)
+		for _, v := range p.ItemOutputs {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *BatchDeleteEvaluationSetItemsRequest) field255Length() int {
+func (p *BatchCreateEvaluationSetItemsResponse) field255Length() int {
 	l := 0
-	if p.IsSetBase() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Base.BLength()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *BatchDeleteEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*BatchDeleteEvaluationSetItemsRequest)
+func (p *BatchCreateEvaluationSetItemsResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*BatchCreateEvaluationSetItemsResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.WorkspaceID = src.WorkspaceID
+	if src.AddedItems != nil {
+		p.AddedItems = make(map[int64]int64, len(src.AddedItems))
+		for key, val := range src.AddedItems {
+			var _key int64
+			_key = key
 
-	p.EvaluationSetID = src.EvaluationSetID
+			var _val int64
+			_val = val
 
-	if src.ItemIds != nil {
-		p.ItemIds = make([]int64, 0, len(src.ItemIds))
-		for _, elem := range src.ItemIds {
-			var _elem int64
-			_elem = elem
-			p.ItemIds = append(p.ItemIds, _elem)
+			p.AddedItems[_key] = _val
 		}
 	}
 
-	var _base *base.Base
-	if src.Base != nil {
-		_base = &base.Base{}
-		if err := _base.DeepCopy(src.Base); err != nil {
+	if src.Errors != nil {
+		p.Errors = make([]*dataset.ItemErrorGroup, 0, len(src.Errors))
+		for _, elem := range src.Errors {
+			var _elem *dataset.ItemErrorGroup
+			if elem != nil {
+				_elem = &dataset.ItemErrorGroup{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Errors = append(p.Errors, _elem)
+		}
+	}
+
+	if src.ItemOutputs != nil {
+		p.ItemOutputs = make([]*dataset.CreateDatasetItemOutput, 0, len(src.ItemOutputs))
+		for _, elem := range src.ItemOutputs {
+			var _elem *dataset.CreateDatasetItemOutput
+			if elem != nil {
+				_elem = &dataset.CreateDatasetItemOutput{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ItemOutputs = append(p.ItemOutputs, _elem)
+		}
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
 			return err
 		}
 	}
-	p.Base = _base
+	p.BaseResp = _baseResp
 
 	return nil
 }
 
-func (p *BatchDeleteEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetItemRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluationSetID bool = false
+	var issetItemID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -11542,131 +11491,14 @@ func (p *BatchDeleteEvaluationSetItemsResponse) FastRead(buf []byte) (int, error
 			break
 		}
 		switch fieldId {
-		case 255:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
-			}
-		}
-	}
-
-	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchDeleteEvaluationSetItemsResponse[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-}
-
-func (p *BatchDeleteEvaluationSetItemsResponse) FastReadField255(buf []byte) (int, error) {
-	offset := 0
-	_field := base.NewBaseResp()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.BaseResp = _field
-	return offset, nil
-}
-
-func (p *BatchDeleteEvaluationSetItemsResponse) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
-
-func (p *BatchDeleteEvaluationSetItemsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p != nil {
-		offset += p.fastWriteField255(buf[offset:], w)
-	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
-}
-
-func (p *BatchDeleteEvaluationSetItemsResponse) BLength() int {
-	l := 0
-	if p != nil {
-		l += p.field255Length()
-	}
-	l += thrift.Binary.FieldStopLength()
-	return l
-}
-
-func (p *BatchDeleteEvaluationSetItemsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
-	return offset
-}
-
-func (p *BatchDeleteEvaluationSetItemsResponse) field255Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.BaseResp.BLength()
-	return l
-}
-
-func (p *BatchDeleteEvaluationSetItemsResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*BatchDeleteEvaluationSetItemsResponse)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
-	}
-
-	var _baseResp *base.BaseResp
-	if src.BaseResp != nil {
-		_baseResp = &base.BaseResp{}
-		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
-			return err
-		}
-	}
-	p.BaseResp = _baseResp
-
-	return nil
-}
-
-func (p *ListEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
-
-	var err error
-	var offset int
-	var l int
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	var issetWorkspaceID bool = false
-	var issetEvaluationSetID bool = false
-	for {
-		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
-		offset += l
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetWorkspaceID = true
+				issetWorkspaceID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -11696,6 +11528,7 @@ func (p *ListEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetItemID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -11703,37 +11536,9 @@ func (p *ListEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 4:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 100:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField100(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 101:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField101(buf[offset:])
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField5(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -11745,9 +11550,9 @@ func (p *ListEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 102:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField102(buf[offset:])
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField6(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -11759,9 +11564,9 @@ func (p *ListEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 103:
+		case 10:
 			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField103(buf[offset:])
+				l, err = p.FastReadField10(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -11773,9 +11578,9 @@ func (p *ListEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 200:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField200(buf[offset:])
+		case 20:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField20(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -11787,9 +11592,9 @@ func (p *ListEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 201:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField201(buf[offset:])
+		case 21:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField21(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -11801,9 +11606,9 @@ func (p *ListEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 212:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField212(buf[offset:])
+		case 22:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField22(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -11847,18 +11652,23 @@ func (p *ListEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetItemID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListEvaluationSetItemsRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateEvaluationSetItemRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListEvaluationSetItemsRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_UpdateEvaluationSetItemRequest[fieldId]))
 }
 
-func (p *ListEvaluationSetItemsRequest) FastReadField1(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetItemRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -11872,7 +11682,7 @@ func (p *ListEvaluationSetItemsRequest) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemsRequest) FastReadField2(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetItemRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -11886,75 +11696,46 @@ func (p *ListEvaluationSetItemsRequest) FastReadField2(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemsRequest) FastReadField3(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetItemRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
+	var _field int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
-	}
-	p.VersionID = _field
-	return offset, nil
-}
-
-func (p *ListEvaluationSetItemsRequest) FastReadField4(buf []byte) (int, error) {
-	offset := 0
-	_field := common.NewSharedResourceOption()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.SharedOption = _field
-	return offset, nil
-}
-
-func (p *ListEvaluationSetItemsRequest) FastReadField100(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+		_field = v
 	}
-	p.PageNumber = _field
+	p.ItemID = _field
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemsRequest) FastReadField101(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetItemRequest) FastReadField5(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = &v
 	}
-	p.PageSize = _field
-	return offset, nil
-}
-
-func (p *ListEvaluationSetItemsRequest) FastReadField102(buf []byte) (int, error) {
-	offset := 0
+	_field := make([]*eval_set.Turn, 0, size)
+	values := make([]eval_set.Turn, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+		_field = append(_field, _elem)
 	}
-	p.PageToken = _field
+	p.Turns = _field
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemsRequest) FastReadField103(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetItemRequest) FastReadField6(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -11962,8 +11743,8 @@ func (p *ListEvaluationSetItemsRequest) FastReadField103(buf []byte) (int, error
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*common.OrderBy, 0, size)
-	values := make([]common.OrderBy, size)
+	_field := make([]*eval_set.ResourceTagRef, 0, size)
+	values := make([]eval_set.ResourceTagRef, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -11975,11 +11756,11 @@ func (p *ListEvaluationSetItemsRequest) FastReadField103(buf []byte) (int, error
 
 		_field = append(_field, _elem)
 	}
-	p.OrderBys = _field
+	p.Tags = _field
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemsRequest) FastReadField200(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetItemRequest) FastReadField10(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -11987,47 +11768,66 @@ func (p *ListEvaluationSetItemsRequest) FastReadField200(buf []byte) (int, error
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]int64, 0, size)
+	_field := make([]*dataset.FieldWriteOption, 0, size)
+	values := make([]dataset.FieldWriteOption, size)
 	for i := 0; i < size; i++ {
-		var _elem int64
-		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
-			_elem = v
 		}
 
 		_field = append(_field, _elem)
 	}
-	p.ItemIDNotIn = _field
+	p.FieldWriteOptions = _field
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemsRequest) FastReadField201(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetItemRequest) FastReadField20(buf []byte) (int, error) {
 	offset := 0
-	_field := filter.NewFilter()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.Filter = _field
+	p.ItemVersion = _field
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemsRequest) FastReadField212(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetItemRequest) FastReadField21(buf []byte) (int, error) {
 	offset := 0
-	_field := eval_set.NewTagFilter()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.TagFilter = _field
+	p.ItemVersionDescription = _field
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemsRequest) FastReadField255(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetItemRequest) FastReadField22(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ItemVersionStatus = _field
+	return offset, nil
+}
+
+func (p *UpdateEvaluationSetItemRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -12039,117 +11839,91 @@ func (p *ListEvaluationSetItemsRequest) FastReadField255(buf []byte) (int, error
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemsRequest) FastWrite(buf []byte) int {
+func (p *UpdateEvaluationSetItemRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ListEvaluationSetItemsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateEvaluationSetItemRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField100(buf[offset:], w)
-		offset += p.fastWriteField101(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField102(buf[offset:], w)
-		offset += p.fastWriteField103(buf[offset:], w)
-		offset += p.fastWriteField200(buf[offset:], w)
-		offset += p.fastWriteField201(buf[offset:], w)
-		offset += p.fastWriteField212(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
+		offset += p.fastWriteField20(buf[offset:], w)
+		offset += p.fastWriteField21(buf[offset:], w)
+		offset += p.fastWriteField22(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ListEvaluationSetItemsRequest) BLength() int {
+func (p *UpdateEvaluationSetItemRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field4Length()
-		l += p.field100Length()
-		l += p.field101Length()
-		l += p.field102Length()
-		l += p.field103Length()
-		l += p.field200Length()
-		l += p.field201Length()
-		l += p.field212Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field10Length()
+		l += p.field20Length()
+		l += p.field21Length()
+		l += p.field22Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ListEvaluationSetItemsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateEvaluationSetItemRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *ListEvaluationSetItemsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateEvaluationSetItemRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
 	return offset
 }
 
-func (p *ListEvaluationSetItemsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetVersionID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.VersionID)
-	}
-	return offset
-}
-
-func (p *ListEvaluationSetItemsRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetSharedOption() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
-		offset += p.SharedOption.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
-}
-
-func (p *ListEvaluationSetItemsRequest) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetPageNumber() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 100)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageNumber)
-	}
-	return offset
-}
-
-func (p *ListEvaluationSetItemsRequest) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateEvaluationSetItemRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPageSize() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 101)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
 	return offset
 }
 
-func (p *ListEvaluationSetItemsRequest) fastWriteField102(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateEvaluationSetItemRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPageToken() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 102)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PageToken)
+	if p.IsSetTurns() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 5)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Turns {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *ListEvaluationSetItemsRequest) fastWriteField103(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateEvaluationSetItemRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetOrderBys() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 103)
+	if p.IsSetTags() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 6)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.OrderBys {
+		for _, v := range p.Tags {
 			length++
 			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
@@ -12158,41 +11932,50 @@ func (p *ListEvaluationSetItemsRequest) fastWriteField103(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *ListEvaluationSetItemsRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateEvaluationSetItemRequest) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetItemIDNotIn() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 200)
+	if p.IsSetFieldWriteOptions() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 10)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.ItemIDNotIn {
+		for _, v := range p.FieldWriteOptions {
 			length++
-			offset += thrift.Binary.WriteI64(buf[offset:], v)
+			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *ListEvaluationSetItemsRequest) fastWriteField201(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateEvaluationSetItemRequest) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFilter() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 201)
-		offset += p.Filter.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetItemVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 20)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ItemVersion)
 	}
 	return offset
 }
 
-func (p *ListEvaluationSetItemsRequest) fastWriteField212(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateEvaluationSetItemRequest) fastWriteField21(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTagFilter() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 212)
-		offset += p.TagFilter.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetItemVersionDescription() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 21)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ItemVersionDescription)
 	}
 	return offset
 }
 
-func (p *ListEvaluationSetItemsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *UpdateEvaluationSetItemRequest) fastWriteField22(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemVersionStatus() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 22)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ItemVersionStatus)
+	}
+	return offset
+}
+
+func (p *UpdateEvaluationSetItemRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -12201,118 +11984,104 @@ func (p *ListEvaluationSetItemsRequest) fastWriteField255(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *ListEvaluationSetItemsRequest) field1Length() int {
+func (p *UpdateEvaluationSetItemRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ListEvaluationSetItemsRequest) field2Length() int {
+func (p *UpdateEvaluationSetItemRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ListEvaluationSetItemsRequest) field3Length() int {
+func (p *UpdateEvaluationSetItemRequest) field3Length() int {
 	l := 0
-	if p.IsSetVersionID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ListEvaluationSetItemsRequest) field4Length() int {
+func (p *UpdateEvaluationSetItemRequest) field5Length() int {
 	l := 0
-	if p.IsSetSharedOption() {
+	if p.IsSetTurns() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.SharedOption.BLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Turns {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *ListEvaluationSetItemsRequest) field100Length() int {
+func (p *UpdateEvaluationSetItemRequest) field6Length() int {
 	l := 0
-	if p.IsSetPageNumber() {
+	if p.IsSetTags() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Tags {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *ListEvaluationSetItemsRequest) field101Length() int {
+func (p *UpdateEvaluationSetItemRequest) field10Length() int {
 	l := 0
-	if p.IsSetPageSize() {
+	if p.IsSetFieldWriteOptions() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.FieldWriteOptions {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *ListEvaluationSetItemsRequest) field102Length() int {
+func (p *UpdateEvaluationSetItemRequest) field20Length() int {
 	l := 0
-	if p.IsSetPageToken() {
+	if p.IsSetItemVersion() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.PageToken)
+		l += thrift.Binary.StringLengthNocopy(*p.ItemVersion)
 	}
 	return l
 }
 
-func (p *ListEvaluationSetItemsRequest) field103Length() int {
+func (p *UpdateEvaluationSetItemRequest) field21Length() int {
 	l := 0
-	if p.IsSetOrderBys() {
+	if p.IsSetItemVersionDescription() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.OrderBys {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.StringLengthNocopy(*p.ItemVersionDescription)
 	}
 	return l
 }
 
-func (p *ListEvaluationSetItemsRequest) field200Length() int {
+func (p *UpdateEvaluationSetItemRequest) field22Length() int {
 	l := 0
-	if p.IsSetItemIDNotIn() {
+	if p.IsSetItemVersionStatus() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		l +=
-			thrift.Binary.I64Length() * len(p.ItemIDNotIn)
+		l += thrift.Binary.StringLengthNocopy(*p.ItemVersionStatus)
 	}
 	return l
 }
 
-func (p *ListEvaluationSetItemsRequest) field201Length() int {
+func (p *UpdateEvaluationSetItemRequest) field255Length() int {
 	l := 0
-	if p.IsSetFilter() {
+	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Filter.BLength()
+		l += p.Base.BLength()
 	}
 	return l
 }
 
-func (p *ListEvaluationSetItemsRequest) field212Length() int {
-	l := 0
-	if p.IsSetTagFilter() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.TagFilter.BLength()
-	}
-	return l
-}
-
-func (p *ListEvaluationSetItemsRequest) field255Length() int {
-	l := 0
-	if p.IsSetBase() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Base.BLength()
-	}
-	return l
-}
-
-func (p *ListEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*ListEvaluationSetItemsRequest)
+func (p *UpdateEvaluationSetItemRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*UpdateEvaluationSetItemRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
@@ -12321,79 +12090,76 @@ func (p *ListEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
 
 	p.EvaluationSetID = src.EvaluationSetID
 
-	if src.VersionID != nil {
-		tmp := *src.VersionID
-		p.VersionID = &tmp
-	}
+	p.ItemID = src.ItemID
 
-	var _sharedOption *common.SharedResourceOption
-	if src.SharedOption != nil {
-		_sharedOption = &common.SharedResourceOption{}
-		if err := _sharedOption.DeepCopy(src.SharedOption); err != nil {
-			return err
-		}
-	}
-	p.SharedOption = _sharedOption
+	if src.Turns != nil {
+		p.Turns = make([]*eval_set.Turn, 0, len(src.Turns))
+		for _, elem := range src.Turns {
+			var _elem *eval_set.Turn
+			if elem != nil {
+				_elem = &eval_set.Turn{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
 
-	if src.PageNumber != nil {
-		tmp := *src.PageNumber
-		p.PageNumber = &tmp
+			p.Turns = append(p.Turns, _elem)
+		}
 	}
 
-	if src.PageSize != nil {
-		tmp := *src.PageSize
-		p.PageSize = &tmp
-	}
+	if src.Tags != nil {
+		p.Tags = make([]*eval_set.ResourceTagRef, 0, len(src.Tags))
+		for _, elem := range src.Tags {
+			var _elem *eval_set.ResourceTagRef
+			if elem != nil {
+				_elem = &eval_set.ResourceTagRef{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
 
-	if src.PageToken != nil {
-		var tmp string
-		if *src.PageToken != "" {
-			tmp = kutils.StringDeepCopy(*src.PageToken)
+			p.Tags = append(p.Tags, _elem)
 		}
-		p.PageToken = &tmp
 	}
 
-	if src.OrderBys != nil {
-		p.OrderBys = make([]*common.OrderBy, 0, len(src.OrderBys))
-		for _, elem := range src.OrderBys {
-			var _elem *common.OrderBy
+	if src.FieldWriteOptions != nil {
+		p.FieldWriteOptions = make([]*dataset.FieldWriteOption, 0, len(src.FieldWriteOptions))
+		for _, elem := range src.FieldWriteOptions {
+			var _elem *dataset.FieldWriteOption
 			if elem != nil {
-				_elem = &common.OrderBy{}
+				_elem = &dataset.FieldWriteOption{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
 			}
 
-			p.OrderBys = append(p.OrderBys, _elem)
+			p.FieldWriteOptions = append(p.FieldWriteOptions, _elem)
 		}
 	}
 
-	if src.ItemIDNotIn != nil {
-		p.ItemIDNotIn = make([]int64, 0, len(src.ItemIDNotIn))
-		for _, elem := range src.ItemIDNotIn {
-			var _elem int64
-			_elem = elem
-			p.ItemIDNotIn = append(p.ItemIDNotIn, _elem)
+	if src.ItemVersion != nil {
+		var tmp string
+		if *src.ItemVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.ItemVersion)
 		}
+		p.ItemVersion = &tmp
 	}
 
-	var _filter *filter.Filter
-	if src.Filter != nil {
-		_filter = &filter.Filter{}
-		if err := _filter.DeepCopy(src.Filter); err != nil {
-			return err
+	if src.ItemVersionDescription != nil {
+		var tmp string
+		if *src.ItemVersionDescription != "" {
+			tmp = kutils.StringDeepCopy(*src.ItemVersionDescription)
 		}
+		p.ItemVersionDescription = &tmp
 	}
-	p.Filter = _filter
 
-	var _tagFilter *eval_set.TagFilter
-	if src.TagFilter != nil {
-		_tagFilter = &eval_set.TagFilter{}
-		if err := _tagFilter.DeepCopy(src.TagFilter); err != nil {
-			return err
+	if src.ItemVersionStatus != nil {
+		var tmp string
+		if *src.ItemVersionStatus != "" {
+			tmp = kutils.StringDeepCopy(*src.ItemVersionStatus)
 		}
+		p.ItemVersionStatus = &tmp
 	}
-	p.TagFilter = _tagFilter
 
 	var _base *base.Base
 	if src.Base != nil {
@@ -12407,7 +12173,7 @@ func (p *ListEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ListEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
+func (p *UpdateEvaluationSetItemResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12424,9 +12190,9 @@ func (p *ListEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
 			break
 		}
 		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField1(buf[offset:])
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -12438,13 +12204,118 @@ func (p *ListEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 100:
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateEvaluationSetItemResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UpdateEvaluationSetItemResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *UpdateEvaluationSetItemResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UpdateEvaluationSetItemResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UpdateEvaluationSetItemResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UpdateEvaluationSetItemResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *UpdateEvaluationSetItemResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *UpdateEvaluationSetItemResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*UpdateEvaluationSetItemResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *DeleteEvaluationSetItemRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluationSetID bool = false
+	var issetItemID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
 			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField100(buf[offset:])
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetWorkspaceID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -12452,13 +12323,14 @@ func (p *ListEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 101:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField101(buf[offset:])
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetEvaluationSetID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -12466,13 +12338,14 @@ func (p *ListEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 102:
+		case 3:
 			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField102(buf[offset:])
+				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetItemID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -12503,282 +12376,204 @@ func (p *ListEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListEvaluationSetItemsResponse[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-}
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 
-func (p *ListEvaluationSetItemsResponse) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
+	if !issetEvaluationSetID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
 	}
-	_field := make([]*eval_set.EvaluationSetItem, 0, size)
-	values := make([]eval_set.EvaluationSetItem, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
 
-		_field = append(_field, _elem)
+	if !issetItemID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
 	}
-	p.Items = _field
 	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteEvaluationSetItemRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_DeleteEvaluationSetItemRequest[fieldId]))
 }
 
-func (p *ListEvaluationSetItemsResponse) FastReadField100(buf []byte) (int, error) {
+func (p *DeleteEvaluationSetItemRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
+	var _field int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
-	p.Total = _field
+	p.WorkspaceID = _field
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemsResponse) FastReadField101(buf []byte) (int, error) {
+func (p *DeleteEvaluationSetItemRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
-	p.NextPageToken = _field
+	p.EvaluationSetID = _field
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemsResponse) FastReadField102(buf []byte) (int, error) {
+func (p *DeleteEvaluationSetItemRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
+	var _field int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
-	p.FilterTotal = _field
+	p.ItemID = _field
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemsResponse) FastReadField255(buf []byte) (int, error) {
+func (p *DeleteEvaluationSetItemRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBaseResp()
+	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.BaseResp = _field
+	p.Base = _field
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemsResponse) FastWrite(buf []byte) int {
+func (p *DeleteEvaluationSetItemRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ListEvaluationSetItemsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *DeleteEvaluationSetItemRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField100(buf[offset:], w)
-		offset += p.fastWriteField102(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField101(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ListEvaluationSetItemsResponse) BLength() int {
+func (p *DeleteEvaluationSetItemRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field100Length()
-		l += p.field101Length()
-		l += p.field102Length()
+		l += p.field2Length()
+		l += p.field3Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ListEvaluationSetItemsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *DeleteEvaluationSetItemRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetItems() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.Items {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *ListEvaluationSetItemsResponse) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
+func (p *DeleteEvaluationSetItemRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTotal() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 100)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.Total)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
 	return offset
 }
 
-func (p *ListEvaluationSetItemsResponse) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
+func (p *DeleteEvaluationSetItemRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetNextPageToken() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 101)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.NextPageToken)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
 	return offset
 }
 
-func (p *ListEvaluationSetItemsResponse) fastWriteField102(buf []byte, w thrift.NocopyWriter) int {
+func (p *DeleteEvaluationSetItemRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFilterTotal() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 102)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.FilterTotal)
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *ListEvaluationSetItemsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
-	return offset
-}
-
-func (p *ListEvaluationSetItemsResponse) field1Length() int {
+func (p *DeleteEvaluationSetItemRequest) field1Length() int {
 	l := 0
-	if p.IsSetItems() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.Items {
-			_ = v
-			l += v.BLength()
-		}
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ListEvaluationSetItemsResponse) field100Length() int {
+func (p *DeleteEvaluationSetItemRequest) field2Length() int {
 	l := 0
-	if p.IsSetTotal() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ListEvaluationSetItemsResponse) field101Length() int {
+func (p *DeleteEvaluationSetItemRequest) field3Length() int {
 	l := 0
-	if p.IsSetNextPageToken() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.NextPageToken)
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ListEvaluationSetItemsResponse) field102Length() int {
+func (p *DeleteEvaluationSetItemRequest) field255Length() int {
 	l := 0
-	if p.IsSetFilterTotal() {
+	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += p.Base.BLength()
 	}
 	return l
 }
 
-func (p *ListEvaluationSetItemsResponse) field255Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.BaseResp.BLength()
-	return l
-}
-
-func (p *ListEvaluationSetItemsResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*ListEvaluationSetItemsResponse)
+func (p *DeleteEvaluationSetItemRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*DeleteEvaluationSetItemRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Items != nil {
-		p.Items = make([]*eval_set.EvaluationSetItem, 0, len(src.Items))
-		for _, elem := range src.Items {
-			var _elem *eval_set.EvaluationSetItem
-			if elem != nil {
-				_elem = &eval_set.EvaluationSetItem{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.Items = append(p.Items, _elem)
-		}
-	}
-
-	if src.Total != nil {
-		tmp := *src.Total
-		p.Total = &tmp
-	}
+	p.WorkspaceID = src.WorkspaceID
 
-	if src.NextPageToken != nil {
-		var tmp string
-		if *src.NextPageToken != "" {
-			tmp = kutils.StringDeepCopy(*src.NextPageToken)
-		}
-		p.NextPageToken = &tmp
-	}
+	p.EvaluationSetID = src.EvaluationSetID
 
-	if src.FilterTotal != nil {
-		tmp := *src.FilterTotal
-		p.FilterTotal = &tmp
-	}
+	p.ItemID = src.ItemID
 
-	var _baseResp *base.BaseResp
-	if src.BaseResp != nil {
-		_baseResp = &base.BaseResp{}
-		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
 			return err
 		}
 	}
-	p.BaseResp = _baseResp
+	p.Base = _base
 
 	return nil
 }
 
-func (p *GetEvaluationSetItemRequest) FastRead(buf []byte) (int, error) {
+func (p *DeleteEvaluationSetItemResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetWorkspaceID bool = false
-	var issetEvaluationSetID bool = false
-	var issetItemID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -12789,14 +12584,13 @@ func (p *GetEvaluationSetItemRequest) FastRead(buf []byte) (int, error) {
 			break
 		}
 		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetWorkspaceID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -12804,32 +12598,149 @@ func (p *GetEvaluationSetItemRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetEvaluationSetID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField3(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetItemID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteEvaluationSetItemResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *DeleteEvaluationSetItemResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *DeleteEvaluationSetItemResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *DeleteEvaluationSetItemResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *DeleteEvaluationSetItemResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *DeleteEvaluationSetItemResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *DeleteEvaluationSetItemResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *DeleteEvaluationSetItemResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*DeleteEvaluationSetItemResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *BatchDeleteEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluationSetID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluationSetID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
 				if err != nil {
 					goto SkipFieldError
 				}
@@ -12866,23 +12777,18 @@ func (p *GetEvaluationSetItemRequest) FastRead(buf []byte) (int, error) {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetItemID {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchDeleteEvaluationSetItemsRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetEvaluationSetItemRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_BatchDeleteEvaluationSetItemsRequest[fieldId]))
 }
 
-func (p *GetEvaluationSetItemRequest) FastReadField1(buf []byte) (int, error) {
+func (p *BatchDeleteEvaluationSetItemsRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -12896,7 +12802,7 @@ func (p *GetEvaluationSetItemRequest) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemRequest) FastReadField2(buf []byte) (int, error) {
+func (p *BatchDeleteEvaluationSetItemsRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -12910,21 +12816,31 @@ func (p *GetEvaluationSetItemRequest) FastReadField2(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemRequest) FastReadField3(buf []byte) (int, error) {
+func (p *BatchDeleteEvaluationSetItemsRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = v
 	}
-	p.ItemID = _field
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ItemIds = _field
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemRequest) FastReadField255(buf []byte) (int, error) {
+func (p *BatchDeleteEvaluationSetItemsRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -12936,11 +12852,11 @@ func (p *GetEvaluationSetItemRequest) FastReadField255(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemRequest) FastWrite(buf []byte) int {
+func (p *BatchDeleteEvaluationSetItemsRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *GetEvaluationSetItemRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchDeleteEvaluationSetItemsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -12952,7 +12868,7 @@ func (p *GetEvaluationSetItemRequest) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *GetEvaluationSetItemRequest) BLength() int {
+func (p *BatchDeleteEvaluationSetItemsRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -12964,28 +12880,37 @@ func (p *GetEvaluationSetItemRequest) BLength() int {
 	return l
 }
 
-func (p *GetEvaluationSetItemRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchDeleteEvaluationSetItemsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *GetEvaluationSetItemRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchDeleteEvaluationSetItemsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
 	return offset
 }
 
-func (p *GetEvaluationSetItemRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchDeleteEvaluationSetItemsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
+	if p.IsSetItemIds() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ItemIds {
+			length++
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+	}
 	return offset
 }
 
-func (p *GetEvaluationSetItemRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchDeleteEvaluationSetItemsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -12994,28 +12919,32 @@ func (p *GetEvaluationSetItemRequest) fastWriteField255(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *GetEvaluationSetItemRequest) field1Length() int {
+func (p *BatchDeleteEvaluationSetItemsRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *GetEvaluationSetItemRequest) field2Length() int {
+func (p *BatchDeleteEvaluationSetItemsRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *GetEvaluationSetItemRequest) field3Length() int {
+func (p *BatchDeleteEvaluationSetItemsRequest) field3Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetItemIds() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		l +=
+			thrift.Binary.I64Length() * len(p.ItemIds)
+	}
 	return l
 }
 
-func (p *GetEvaluationSetItemRequest) field255Length() int {
+func (p *BatchDeleteEvaluationSetItemsRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -13024,8 +12953,8 @@ func (p *GetEvaluationSetItemRequest) field255Length() int {
 	return l
 }
 
-func (p *GetEvaluationSetItemRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*GetEvaluationSetItemRequest)
+func (p *BatchDeleteEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*BatchDeleteEvaluationSetItemsRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
@@ -13034,7 +12963,14 @@ func (p *GetEvaluationSetItemRequest) DeepCopy(s interface{}) error {
 
 	p.EvaluationSetID = src.EvaluationSetID
 
-	p.ItemID = src.ItemID
+	if src.ItemIds != nil {
+		p.ItemIds = make([]int64, 0, len(src.ItemIds))
+		for _, elem := range src.ItemIds {
+			var _elem int64
+			_elem = elem
+			p.ItemIds = append(p.ItemIds, _elem)
+		}
+	}
 
 	var _base *base.Base
 	if src.Base != nil {
@@ -13048,7 +12984,7 @@ func (p *GetEvaluationSetItemRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *GetEvaluationSetItemResponse) FastRead(buf []byte) (int, error) {
+func (p *BatchDeleteEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13065,20 +13001,6 @@ func (p *GetEvaluationSetItemResponse) FastRead(buf []byte) (int, error) {
 			break
 		}
 		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -13106,106 +13028,65 @@ func (p *GetEvaluationSetItemResponse) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchDeleteEvaluationSetItemsResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *GetEvaluationSetItemResponse) FastReadField1(buf []byte) (int, error) {
+func (p *BatchDeleteEvaluationSetItemsResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
-	_field := eval_set.NewEvaluationSetItem()
+	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Item = _field
+	p.BaseResp = _field
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemResponse) FastReadField255(buf []byte) (int, error) {
-	offset := 0
-	_field := base.NewBaseResp()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.BaseResp = _field
-	return offset, nil
-}
-
-func (p *GetEvaluationSetItemResponse) FastWrite(buf []byte) int {
+func (p *BatchDeleteEvaluationSetItemsResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *GetEvaluationSetItemResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchDeleteEvaluationSetItemsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *GetEvaluationSetItemResponse) BLength() int {
+func (p *BatchDeleteEvaluationSetItemsResponse) BLength() int {
 	l := 0
 	if p != nil {
-		l += p.field1Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *GetEvaluationSetItemResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetItem() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-		offset += p.Item.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
-}
-
-func (p *GetEvaluationSetItemResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *BatchDeleteEvaluationSetItemsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *GetEvaluationSetItemResponse) field1Length() int {
-	l := 0
-	if p.IsSetItem() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Item.BLength()
-	}
-	return l
-}
-
-func (p *GetEvaluationSetItemResponse) field255Length() int {
+func (p *BatchDeleteEvaluationSetItemsResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *GetEvaluationSetItemResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*GetEvaluationSetItemResponse)
+func (p *BatchDeleteEvaluationSetItemsResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*BatchDeleteEvaluationSetItemsResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _item *eval_set.EvaluationSetItem
-	if src.Item != nil {
-		_item = &eval_set.EvaluationSetItem{}
-		if err := _item.DeepCopy(src.Item); err != nil {
-			return err
-		}
-	}
-	p.Item = _item
-
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
 		_baseResp = &base.BaseResp{}
@@ -13218,7 +13099,7 @@ func (p *GetEvaluationSetItemResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
+func (p *StartEvaluationSetDedupJobRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13282,50 +13163,8 @@ func (p *BatchGetEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 20:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField20(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 201:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField201(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 212:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField212(buf[offset:])
+				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -13373,14 +13212,14 @@ func (p *BatchGetEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetEvaluationSetItemsRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_StartEvaluationSetDedupJobRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_BatchGetEvaluationSetItemsRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_StartEvaluationSetDedupJobRequest[fieldId]))
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) FastReadField1(buf []byte) (int, error) {
+func (p *StartEvaluationSetDedupJobRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -13394,7 +13233,7 @@ func (p *BatchGetEvaluationSetItemsRequest) FastReadField1(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) FastReadField2(buf []byte) (int, error) {
+func (p *StartEvaluationSetDedupJobRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -13408,7 +13247,7 @@ func (p *BatchGetEvaluationSetItemsRequest) FastReadField2(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) FastReadField3(buf []byte) (int, error) {
+func (p *StartEvaluationSetDedupJobRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int64
@@ -13422,80 +13261,19 @@ func (p *BatchGetEvaluationSetItemsRequest) FastReadField3(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) FastReadField4(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]int64, 0, size)
-	for i := 0; i < size; i++ {
-		var _elem int64
-		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_elem = v
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.ItemIds = _field
-	return offset, nil
-}
-
-func (p *BatchGetEvaluationSetItemsRequest) FastReadField20(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]*EvaluationItemVersionRef, 0, size)
-	values := make([]EvaluationItemVersionRef, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.ItemVersionQueries = _field
-	return offset, nil
-}
-
-func (p *BatchGetEvaluationSetItemsRequest) FastReadField201(buf []byte) (int, error) {
-	offset := 0
-	_field := filter.NewFilter()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.Filter = _field
-	return offset, nil
-}
-
-func (p *BatchGetEvaluationSetItemsRequest) FastReadField212(buf []byte) (int, error) {
+func (p *StartEvaluationSetDedupJobRequest) FastReadField4(buf []byte) (int, error) {
 	offset := 0
-	_field := eval_set.NewTagFilter()
+	_field := eval_set.NewEvaluationSetDedupConf()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.TagFilter = _field
+	p.Conf = _field
 	return offset, nil
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) FastReadField255(buf []byte) (int, error) {
+func (p *StartEvaluationSetDedupJobRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -13507,57 +13285,51 @@ func (p *BatchGetEvaluationSetItemsRequest) FastReadField255(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) FastWrite(buf []byte) int {
+func (p *StartEvaluationSetDedupJobRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *StartEvaluationSetDedupJobRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField20(buf[offset:], w)
-		offset += p.fastWriteField201(buf[offset:], w)
-		offset += p.fastWriteField212(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) BLength() int {
+func (p *StartEvaluationSetDedupJobRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
-		l += p.field20Length()
-		l += p.field201Length()
-		l += p.field212Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *StartEvaluationSetDedupJobRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *StartEvaluationSetDedupJobRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
 	return offset
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *StartEvaluationSetDedupJobRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetVersionID() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
@@ -13566,57 +13338,16 @@ func (p *BatchGetEvaluationSetItemsRequest) fastWriteField3(buf []byte, w thrift
 	return offset
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetItemIds() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.ItemIds {
-			length++
-			offset += thrift.Binary.WriteI64(buf[offset:], v)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
-	}
-	return offset
-}
-
-func (p *BatchGetEvaluationSetItemsRequest) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetItemVersionQueries() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 20)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.ItemVersionQueries {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *BatchGetEvaluationSetItemsRequest) fastWriteField201(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetFilter() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 201)
-		offset += p.Filter.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
-}
-
-func (p *BatchGetEvaluationSetItemsRequest) fastWriteField212(buf []byte, w thrift.NocopyWriter) int {
+func (p *StartEvaluationSetDedupJobRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTagFilter() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 212)
-		offset += p.TagFilter.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
+		offset += p.Conf.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *StartEvaluationSetDedupJobRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -13625,21 +13356,21 @@ func (p *BatchGetEvaluationSetItemsRequest) fastWriteField255(buf []byte, w thri
 	return offset
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) field1Length() int {
+func (p *StartEvaluationSetDedupJobRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) field2Length() int {
+func (p *StartEvaluationSetDedupJobRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) field3Length() int {
+func (p *StartEvaluationSetDedupJobRequest) field3Length() int {
 	l := 0
 	if p.IsSetVersionID() {
 		l += thrift.Binary.FieldBeginLength()
@@ -13648,61 +13379,28 @@ func (p *BatchGetEvaluationSetItemsRequest) field3Length() int {
 	return l
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) field4Length() int {
+func (p *StartEvaluationSetDedupJobRequest) field4Length() int {
 	l := 0
-	if p.IsSetItemIds() {
+	if p.IsSetConf() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		l +=
-			thrift.Binary.I64Length() * len(p.ItemIds)
+		l += p.Conf.BLength()
 	}
 	return l
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) field20Length() int {
+func (p *StartEvaluationSetDedupJobRequest) field255Length() int {
 	l := 0
-	if p.IsSetItemVersionQueries() {
+	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.ItemVersionQueries {
-			_ = v
-			l += v.BLength()
-		}
+		l += p.Base.BLength()
 	}
 	return l
 }
 
-func (p *BatchGetEvaluationSetItemsRequest) field201Length() int {
-	l := 0
-	if p.IsSetFilter() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Filter.BLength()
-	}
-	return l
-}
-
-func (p *BatchGetEvaluationSetItemsRequest) field212Length() int {
-	l := 0
-	if p.IsSetTagFilter() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.TagFilter.BLength()
-	}
-	return l
-}
-
-func (p *BatchGetEvaluationSetItemsRequest) field255Length() int {
-	l := 0
-	if p.IsSetBase() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Base.BLength()
-	}
-	return l
-}
-
-func (p *BatchGetEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*BatchGetEvaluationSetItemsRequest)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
+func (p *StartEvaluationSetDedupJobRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*StartEvaluationSetDedupJobRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
 	p.WorkspaceID = src.WorkspaceID
@@ -13714,47 +13412,14 @@ func (p *BatchGetEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
 		p.VersionID = &tmp
 	}
 
-	if src.ItemIds != nil {
-		p.ItemIds = make([]int64, 0, len(src.ItemIds))
-		for _, elem := range src.ItemIds {
-			var _elem int64
-			_elem = elem
-			p.ItemIds = append(p.ItemIds, _elem)
-		}
-	}
-
-	if src.ItemVersionQueries != nil {
-		p.ItemVersionQueries = make([]*EvaluationItemVersionRef, 0, len(src.ItemVersionQueries))
-		for _, elem := range src.ItemVersionQueries {
-			var _elem *EvaluationItemVersionRef
-			if elem != nil {
-				_elem = &EvaluationItemVersionRef{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.ItemVersionQueries = append(p.ItemVersionQueries, _elem)
-		}
-	}
-
-	var _filter *filter.Filter
-	if src.Filter != nil {
-		_filter = &filter.Filter{}
-		if err := _filter.DeepCopy(src.Filter); err != nil {
-			return err
-		}
-	}
-	p.Filter = _filter
-
-	var _tagFilter *eval_set.TagFilter
-	if src.TagFilter != nil {
-		_tagFilter = &eval_set.TagFilter{}
-		if err := _tagFilter.DeepCopy(src.TagFilter); err != nil {
+	var _conf *eval_set.EvaluationSetDedupConf
+	if src.Conf != nil {
+		_conf = &eval_set.EvaluationSetDedupConf{}
+		if err := _conf.DeepCopy(src.Conf); err != nil {
 			return err
 		}
 	}
-	p.TagFilter = _tagFilter
+	p.Conf = _conf
 
 	var _base *base.Base
 	if src.Base != nil {
@@ -13768,7 +13433,7 @@ func (p *BatchGetEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *BatchGetEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
+func (p *StartEvaluationSetDedupJobResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13786,7 +13451,7 @@ func (p *BatchGetEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -13826,37 +13491,24 @@ func (p *BatchGetEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetEvaluationSetItemsResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_StartEvaluationSetDedupJobResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *BatchGetEvaluationSetItemsResponse) FastReadField1(buf []byte) (int, error) {
+func (p *StartEvaluationSetDedupJobResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	_field := eval_set.NewEvaluationSetDedupJob()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
 	}
-	_field := make([]*eval_set.EvaluationSetItem, 0, size)
-	values := make([]eval_set.EvaluationSetItem, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.Items = _field
+	p.Job = _field
 	return offset, nil
 }
 
-func (p *BatchGetEvaluationSetItemsResponse) FastReadField255(buf []byte) (int, error) {
+func (p *StartEvaluationSetDedupJobResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -13868,11 +13520,11 @@ func (p *BatchGetEvaluationSetItemsResponse) FastReadField255(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *BatchGetEvaluationSetItemsResponse) FastWrite(buf []byte) int {
+func (p *StartEvaluationSetDedupJobResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *BatchGetEvaluationSetItemsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *StartEvaluationSetDedupJobResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -13882,7 +13534,7 @@ func (p *BatchGetEvaluationSetItemsResponse) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *BatchGetEvaluationSetItemsResponse) BLength() int {
+func (p *StartEvaluationSetDedupJobResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -13892,69 +13544,52 @@ func (p *BatchGetEvaluationSetItemsResponse) BLength() int {
 	return l
 }
 
-func (p *BatchGetEvaluationSetItemsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *StartEvaluationSetDedupJobResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetItems() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.Items {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetJob() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Job.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *BatchGetEvaluationSetItemsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *StartEvaluationSetDedupJobResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *BatchGetEvaluationSetItemsResponse) field1Length() int {
+func (p *StartEvaluationSetDedupJobResponse) field1Length() int {
 	l := 0
-	if p.IsSetItems() {
+	if p.IsSetJob() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.Items {
-			_ = v
-			l += v.BLength()
-		}
+		l += p.Job.BLength()
 	}
 	return l
 }
 
-func (p *BatchGetEvaluationSetItemsResponse) field255Length() int {
+func (p *StartEvaluationSetDedupJobResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *BatchGetEvaluationSetItemsResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*BatchGetEvaluationSetItemsResponse)
+func (p *StartEvaluationSetDedupJobResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*StartEvaluationSetDedupJobResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Items != nil {
-		p.Items = make([]*eval_set.EvaluationSetItem, 0, len(src.Items))
-		for _, elem := range src.Items {
-			var _elem *eval_set.EvaluationSetItem
-			if elem != nil {
-				_elem = &eval_set.EvaluationSetItem{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.Items = append(p.Items, _elem)
+	var _job *eval_set.EvaluationSetDedupJob
+	if src.Job != nil {
+		_job = &eval_set.EvaluationSetDedupJob{}
+		if err := _job.DeepCopy(src.Job); err != nil {
+			return err
 		}
 	}
+	p.Job = _job
 
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
@@ -13968,7 +13603,7 @@ func (p *BatchGetEvaluationSetItemsResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *BatchAddExistEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
+func (p *GetEvaluationSetDedupJobRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13977,7 +13612,7 @@ func (p *BatchAddExistEvaluationSetItemsRequest) FastRead(buf []byte) (int, erro
 	var fieldId int16
 	var issetWorkspaceID bool = false
 	var issetEvaluationSetID bool = false
-	var issetItems bool = false
+	var issetJobID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -14019,27 +13654,13 @@ func (p *BatchAddExistEvaluationSetItemsRequest) FastRead(buf []byte) (int, erro
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetItems = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 10:
-			if fieldTypeId == thrift.BOOL {
-				l, err = p.FastReadField10(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
+				issetJobID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -14080,7 +13701,7 @@ func (p *BatchAddExistEvaluationSetItemsRequest) FastRead(buf []byte) (int, erro
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetItems {
+	if !issetJobID {
 		fieldId = 3
 		goto RequiredFieldNotSetError
 	}
@@ -14088,14 +13709,14 @@ func (p *BatchAddExistEvaluationSetItemsRequest) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchAddExistEvaluationSetItemsRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetDedupJobRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_BatchAddExistEvaluationSetItemsRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetEvaluationSetDedupJobRequest[fieldId]))
 }
 
-func (p *BatchAddExistEvaluationSetItemsRequest) FastReadField1(buf []byte) (int, error) {
+func (p *GetEvaluationSetDedupJobRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -14109,7 +13730,7 @@ func (p *BatchAddExistEvaluationSetItemsRequest) FastReadField1(buf []byte) (int
 	return offset, nil
 }
 
-func (p *BatchAddExistEvaluationSetItemsRequest) FastReadField2(buf []byte) (int, error) {
+func (p *GetEvaluationSetDedupJobRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -14123,46 +13744,21 @@ func (p *BatchAddExistEvaluationSetItemsRequest) FastReadField2(buf []byte) (int
 	return offset, nil
 }
 
-func (p *BatchAddExistEvaluationSetItemsRequest) FastReadField3(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]*EvaluationItemVersionRef, 0, size)
-	values := make([]EvaluationItemVersionRef, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.Items = _field
-	return offset, nil
-}
-
-func (p *BatchAddExistEvaluationSetItemsRequest) FastReadField10(buf []byte) (int, error) {
+func (p *GetEvaluationSetDedupJobRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *bool
-	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
-	p.AllowPartialAdd = _field
+	p.JobID = _field
 	return offset, nil
 }
 
-func (p *BatchAddExistEvaluationSetItemsRequest) FastReadField255(buf []byte) (int, error) {
+func (p *GetEvaluationSetDedupJobRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -14174,16 +13770,15 @@ func (p *BatchAddExistEvaluationSetItemsRequest) FastReadField255(buf []byte) (i
 	return offset, nil
 }
 
-func (p *BatchAddExistEvaluationSetItemsRequest) FastWrite(buf []byte) int {
+func (p *GetEvaluationSetDedupJobRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *BatchAddExistEvaluationSetItemsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetDedupJobRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField10(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
@@ -14191,57 +13786,40 @@ func (p *BatchAddExistEvaluationSetItemsRequest) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *BatchAddExistEvaluationSetItemsRequest) BLength() int {
+func (p *GetEvaluationSetDedupJobRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field10Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *BatchAddExistEvaluationSetItemsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetDedupJobRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *BatchAddExistEvaluationSetItemsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetDedupJobRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
 	return offset
 }
 
-func (p *BatchAddExistEvaluationSetItemsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
-	listBeginOffset := offset
-	offset += thrift.Binary.ListBeginLength()
-	var length int
-	for _, v := range p.Items {
-		length++
-		offset += v.FastWriteNocopy(buf[offset:], w)
-	}
-	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	return offset
-}
-
-func (p *BatchAddExistEvaluationSetItemsRequest) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetDedupJobRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetAllowPartialAdd() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 10)
-		offset += thrift.Binary.WriteBool(buf[offset:], *p.AllowPartialAdd)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.JobID)
 	return offset
 }
 
-func (p *BatchAddExistEvaluationSetItemsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetDedupJobRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -14250,41 +13828,28 @@ func (p *BatchAddExistEvaluationSetItemsRequest) fastWriteField255(buf []byte, w
 	return offset
 }
 
-func (p *BatchAddExistEvaluationSetItemsRequest) field1Length() int {
+func (p *GetEvaluationSetDedupJobRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *BatchAddExistEvaluationSetItemsRequest) field2Length() int {
+func (p *GetEvaluationSetDedupJobRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *BatchAddExistEvaluationSetItemsRequest) field3Length() int {
+func (p *GetEvaluationSetDedupJobRequest) field3Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.ListBeginLength()
-	for _, v := range p.Items {
-		_ = v
-		l += v.BLength()
-	}
-	return l
-}
-
-func (p *BatchAddExistEvaluationSetItemsRequest) field10Length() int {
-	l := 0
-	if p.IsSetAllowPartialAdd() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.BoolLength()
-	}
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *BatchAddExistEvaluationSetItemsRequest) field255Length() int {
+func (p *GetEvaluationSetDedupJobRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -14293,8 +13858,8 @@ func (p *BatchAddExistEvaluationSetItemsRequest) field255Length() int {
 	return l
 }
 
-func (p *BatchAddExistEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*BatchAddExistEvaluationSetItemsRequest)
+func (p *GetEvaluationSetDedupJobRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetEvaluationSetDedupJobRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
@@ -14303,25 +13868,7 @@ func (p *BatchAddExistEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
 
 	p.EvaluationSetID = src.EvaluationSetID
 
-	if src.Items != nil {
-		p.Items = make([]*EvaluationItemVersionRef, 0, len(src.Items))
-		for _, elem := range src.Items {
-			var _elem *EvaluationItemVersionRef
-			if elem != nil {
-				_elem = &EvaluationItemVersionRef{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.Items = append(p.Items, _elem)
-		}
-	}
-
-	if src.AllowPartialAdd != nil {
-		tmp := *src.AllowPartialAdd
-		p.AllowPartialAdd = &tmp
-	}
+	p.JobID = src.JobID
 
 	var _base *base.Base
 	if src.Base != nil {
@@ -14335,14 +13882,13 @@ func (p *BatchAddExistEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluationItemVersionRef) FastRead(buf []byte) (int, error) {
+func (p *GetEvaluationSetDedupJobResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetItemID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -14354,27 +13900,12 @@ func (p *EvaluationItemVersionRef) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetItemID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -14382,9 +13913,9 @@ func (p *EvaluationItemVersionRef) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField3(buf[offset:])
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -14405,170 +13936,132 @@ func (p *EvaluationItemVersionRef) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetItemID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationItemVersionRef[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetDedupJobResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_EvaluationItemVersionRef[fieldId]))
-}
-
-func (p *EvaluationItemVersionRef) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.ItemID = _field
-	return offset, nil
 }
 
-func (p *EvaluationItemVersionRef) FastReadField2(buf []byte) (int, error) {
+func (p *GetEvaluationSetDedupJobResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_field := eval_set.NewEvaluationSetDedupJob()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
 	}
-	p.ItemVersionID = _field
+	p.Job = _field
 	return offset, nil
 }
 
-func (p *EvaluationItemVersionRef) FastReadField3(buf []byte) (int, error) {
+func (p *GetEvaluationSetDedupJobResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
 	}
-	p.ItemVersion = _field
+	p.BaseResp = _field
 	return offset, nil
 }
 
-func (p *EvaluationItemVersionRef) FastWrite(buf []byte) int {
+func (p *GetEvaluationSetDedupJobResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationItemVersionRef) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetDedupJobResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvaluationItemVersionRef) BLength() int {
+func (p *GetEvaluationSetDedupJobResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field3Length()
+		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvaluationItemVersionRef) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
-	return offset
-}
-
-func (p *EvaluationItemVersionRef) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetDedupJobResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetItemVersionID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.ItemVersionID)
+	if p.IsSetJob() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Job.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *EvaluationItemVersionRef) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetDedupJobResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetItemVersion() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ItemVersion)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluationItemVersionRef) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
-
-func (p *EvaluationItemVersionRef) field2Length() int {
+func (p *GetEvaluationSetDedupJobResponse) field1Length() int {
 	l := 0
-	if p.IsSetItemVersionID() {
+	if p.IsSetJob() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += p.Job.BLength()
 	}
 	return l
 }
 
-func (p *EvaluationItemVersionRef) field3Length() int {
+func (p *GetEvaluationSetDedupJobResponse) field255Length() int {
 	l := 0
-	if p.IsSetItemVersion() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.ItemVersion)
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *EvaluationItemVersionRef) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationItemVersionRef)
+func (p *GetEvaluationSetDedupJobResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetEvaluationSetDedupJobResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.ItemID = src.ItemID
-
-	if src.ItemVersionID != nil {
-		tmp := *src.ItemVersionID
-		p.ItemVersionID = &tmp
+	var _job *eval_set.EvaluationSetDedupJob
+	if src.Job != nil {
+		_job = &eval_set.EvaluationSetDedupJob{}
+		if err := _job.DeepCopy(src.Job); err != nil {
+			return err
+		}
 	}
+	p.Job = _job
 
-	if src.ItemVersion != nil {
-		var tmp string
-		if *src.ItemVersion != "" {
-			tmp = kutils.StringDeepCopy(*src.ItemVersion)
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
 		}
-		p.ItemVersion = &tmp
 	}
+	p.BaseResp = _baseResp
 
 	return nil
 }
 
-func (p *BatchAddExistEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
+func (p *ArchiveEvaluationSetDuplicatesRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluationSetID bool = false
+	var issetJobID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -14580,12 +14073,13 @@ func (p *BatchAddExistEvaluationSetItemsResponse) FastRead(buf []byte) (int, err
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetWorkspaceID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -14594,12 +14088,13 @@ func (p *BatchAddExistEvaluationSetItemsResponse) FastRead(buf []byte) (int, err
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetEvaluationSetID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -14608,12 +14103,27 @@ func (p *BatchAddExistEvaluationSetItemsResponse) FastRead(buf []byte) (int, err
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetJobID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -14644,44 +14154,74 @@ func (p *BatchAddExistEvaluationSetItemsResponse) FastRead(buf []byte) (int, err
 		}
 	}
 
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluationSetID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetJobID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchAddExistEvaluationSetItemsResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArchiveEvaluationSetDuplicatesRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ArchiveEvaluationSetDuplicatesRequest[fieldId]))
 }
 
-func (p *BatchAddExistEvaluationSetItemsResponse) FastReadField1(buf []byte) (int, error) {
+func (p *ArchiveEvaluationSetDuplicatesRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
-	p.SuccessCount = _field
+	p.WorkspaceID = _field
 	return offset, nil
 }
 
-func (p *BatchAddExistEvaluationSetItemsResponse) FastReadField2(buf []byte) (int, error) {
+func (p *ArchiveEvaluationSetDuplicatesRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
-	p.FailedCount = _field
+	p.EvaluationSetID = _field
 	return offset, nil
 }
 
-func (p *BatchAddExistEvaluationSetItemsResponse) FastReadField3(buf []byte) (int, error) {
+func (p *ArchiveEvaluationSetDuplicatesRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.JobID = _field
+	return offset, nil
+}
+
+func (p *ArchiveEvaluationSetDuplicatesRequest) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -14689,170 +14229,360 @@ func (p *BatchAddExistEvaluationSetItemsResponse) FastReadField3(buf []byte) (in
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*EvaluationItemVersionRef, 0, size)
-	values := make([]EvaluationItemVersionRef, size)
+	_field := make([]int64, 0, size)
 	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
+			_elem = v
 		}
 
 		_field = append(_field, _elem)
 	}
-	p.FailedItems = _field
+	p.ItemIds = _field
 	return offset, nil
 }
 
-func (p *BatchAddExistEvaluationSetItemsResponse) FastReadField255(buf []byte) (int, error) {
+func (p *ArchiveEvaluationSetDuplicatesRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBaseResp()
+	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.BaseResp = _field
+	p.Base = _field
 	return offset, nil
 }
 
-func (p *BatchAddExistEvaluationSetItemsResponse) FastWrite(buf []byte) int {
+func (p *ArchiveEvaluationSetDuplicatesRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *BatchAddExistEvaluationSetItemsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ArchiveEvaluationSetDuplicatesRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *BatchAddExistEvaluationSetItemsResponse) BLength() int {
+func (p *ArchiveEvaluationSetDuplicatesRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *BatchAddExistEvaluationSetItemsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ArchiveEvaluationSetDuplicatesRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSuccessCount() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 1)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.SuccessCount)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *BatchAddExistEvaluationSetItemsResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ArchiveEvaluationSetDuplicatesRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFailedCount() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.FailedCount)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
 	return offset
 }
 
-func (p *BatchAddExistEvaluationSetItemsResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *ArchiveEvaluationSetDuplicatesRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFailedItems() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.JobID)
+	return offset
+}
+
+func (p *ArchiveEvaluationSetDuplicatesRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemIds() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.FailedItems {
+		for _, v := range p.ItemIds {
 			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
 		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
 	}
 	return offset
 }
 
-func (p *BatchAddExistEvaluationSetItemsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ArchiveEvaluationSetDuplicatesRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
 	return offset
 }
 
-func (p *BatchAddExistEvaluationSetItemsResponse) field1Length() int {
+func (p *ArchiveEvaluationSetDuplicatesRequest) field1Length() int {
 	l := 0
-	if p.IsSetSuccessCount() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *BatchAddExistEvaluationSetItemsResponse) field2Length() int {
+func (p *ArchiveEvaluationSetDuplicatesRequest) field2Length() int {
 	l := 0
-	if p.IsSetFailedCount() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *BatchAddExistEvaluationSetItemsResponse) field3Length() int {
+func (p *ArchiveEvaluationSetDuplicatesRequest) field3Length() int {
 	l := 0
-	if p.IsSetFailedItems() {
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ArchiveEvaluationSetDuplicatesRequest) field4Length() int {
+	l := 0
+	if p.IsSetItemIds() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.FailedItems {
-			_ = v
-			l += v.BLength()
-		}
+		l +=
+			thrift.Binary.I64Length() * len(p.ItemIds)
 	}
 	return l
 }
 
-func (p *BatchAddExistEvaluationSetItemsResponse) field255Length() int {
+func (p *ArchiveEvaluationSetDuplicatesRequest) field255Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.BaseResp.BLength()
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
 	return l
 }
 
-func (p *BatchAddExistEvaluationSetItemsResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*BatchAddExistEvaluationSetItemsResponse)
+func (p *ArchiveEvaluationSetDuplicatesRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ArchiveEvaluationSetDuplicatesRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.SuccessCount != nil {
-		tmp := *src.SuccessCount
-		p.SuccessCount = &tmp
+	p.WorkspaceID = src.WorkspaceID
+
+	p.EvaluationSetID = src.EvaluationSetID
+
+	p.JobID = src.JobID
+
+	if src.ItemIds != nil {
+		p.ItemIds = make([]int64, 0, len(src.ItemIds))
+		for _, elem := range src.ItemIds {
+			var _elem int64
+			_elem = elem
+			p.ItemIds = append(p.ItemIds, _elem)
+		}
 	}
 
-	if src.FailedCount != nil {
-		tmp := *src.FailedCount
-		p.FailedCount = &tmp
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
 	}
+	p.Base = _base
 
-	if src.FailedItems != nil {
-		p.FailedItems = make([]*EvaluationItemVersionRef, 0, len(src.FailedItems))
-		for _, elem := range src.FailedItems {
-			var _elem *EvaluationItemVersionRef
-			if elem != nil {
-				_elem = &EvaluationItemVersionRef{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
+	return nil
+}
+
+func (p *ArchiveEvaluationSetDuplicatesResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
 				}
 			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
 
-			p.FailedItems = append(p.FailedItems, _elem)
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArchiveEvaluationSetDuplicatesResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ArchiveEvaluationSetDuplicatesResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ArchivedItemIds = _field
+	return offset, nil
+}
+
+func (p *ArchiveEvaluationSetDuplicatesResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ArchiveEvaluationSetDuplicatesResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ArchiveEvaluationSetDuplicatesResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ArchiveEvaluationSetDuplicatesResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ArchiveEvaluationSetDuplicatesResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetArchivedItemIds() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ArchivedItemIds {
+			length++
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+	}
+	return offset
+}
+
+func (p *ArchiveEvaluationSetDuplicatesResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ArchiveEvaluationSetDuplicatesResponse) field1Length() int {
+	l := 0
+	if p.IsSetArchivedItemIds() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		l +=
+			thrift.Binary.I64Length() * len(p.ArchivedItemIds)
+	}
+	return l
+}
+
+func (p *ArchiveEvaluationSetDuplicatesResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *ArchiveEvaluationSetDuplicatesResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ArchiveEvaluationSetDuplicatesResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.ArchivedItemIds != nil {
+		p.ArchivedItemIds = make([]int64, 0, len(src.ArchivedItemIds))
+		for _, elem := range src.ArchivedItemIds {
+			var _elem int64
+			_elem = elem
+			p.ArchivedItemIds = append(p.ArchivedItemIds, _elem)
 		}
 	}
 
@@ -14868,7 +14598,7 @@ func (p *BatchAddExistEvaluationSetItemsResponse) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *GetEvaluationSetItemDefRequest) FastRead(buf []byte) (int, error) {
+func (p *GenerateEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -14877,7 +14607,6 @@ func (p *GetEvaluationSetItemDefRequest) FastRead(buf []byte) (int, error) {
 	var fieldId int16
 	var issetWorkspaceID bool = false
 	var issetEvaluationSetID bool = false
-	var issetItemID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -14919,13 +14648,26 @@ func (p *GetEvaluationSetItemDefRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetItemID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -14965,23 +14707,18 @@ func (p *GetEvaluationSetItemDefRequest) FastRead(buf []byte) (int, error) {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetItemID {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemDefRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GenerateEvaluationSetItemsRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetEvaluationSetItemDefRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GenerateEvaluationSetItemsRequest[fieldId]))
 }
 
-func (p *GetEvaluationSetItemDefRequest) FastReadField1(buf []byte) (int, error) {
+func (p *GenerateEvaluationSetItemsRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -14995,7 +14732,7 @@ func (p *GetEvaluationSetItemDefRequest) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemDefRequest) FastReadField2(buf []byte) (int, error) {
+func (p *GenerateEvaluationSetItemsRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -15009,21 +14746,43 @@ func (p *GetEvaluationSetItemDefRequest) FastReadField2(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemDefRequest) FastReadField3(buf []byte) (int, error) {
+func (p *GenerateEvaluationSetItemsRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.SeedItemIds = _field
+	return offset, nil
+}
+
+func (p *GenerateEvaluationSetItemsRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+	_field := eval_set.NewEvaluationSetSynthesisConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
 	}
-	p.ItemID = _field
+	p.Conf = _field
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemDefRequest) FastReadField255(buf []byte) (int, error) {
+func (p *GenerateEvaluationSetItemsRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -15035,56 +14794,76 @@ func (p *GetEvaluationSetItemDefRequest) FastReadField255(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemDefRequest) FastWrite(buf []byte) int {
+func (p *GenerateEvaluationSetItemsRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *GetEvaluationSetItemDefRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *GenerateEvaluationSetItemsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *GetEvaluationSetItemDefRequest) BLength() int {
+func (p *GenerateEvaluationSetItemsRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *GetEvaluationSetItemDefRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *GenerateEvaluationSetItemsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *GetEvaluationSetItemDefRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *GenerateEvaluationSetItemsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
 	return offset
 }
 
-func (p *GetEvaluationSetItemDefRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *GenerateEvaluationSetItemsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
+	if p.IsSetSeedItemIds() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.SeedItemIds {
+			length++
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+	}
 	return offset
 }
 
-func (p *GetEvaluationSetItemDefRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *GenerateEvaluationSetItemsRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
+		offset += p.Conf.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GenerateEvaluationSetItemsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -15093,28 +14872,41 @@ func (p *GetEvaluationSetItemDefRequest) fastWriteField255(buf []byte, w thrift.
 	return offset
 }
 
-func (p *GetEvaluationSetItemDefRequest) field1Length() int {
+func (p *GenerateEvaluationSetItemsRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *GetEvaluationSetItemDefRequest) field2Length() int {
+func (p *GenerateEvaluationSetItemsRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *GetEvaluationSetItemDefRequest) field3Length() int {
+func (p *GenerateEvaluationSetItemsRequest) field3Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetSeedItemIds() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		l +=
+			thrift.Binary.I64Length() * len(p.SeedItemIds)
+	}
 	return l
 }
 
-func (p *GetEvaluationSetItemDefRequest) field255Length() int {
+func (p *GenerateEvaluationSetItemsRequest) field4Length() int {
+	l := 0
+	if p.IsSetConf() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Conf.BLength()
+	}
+	return l
+}
+
+func (p *GenerateEvaluationSetItemsRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -15123,8 +14915,8 @@ func (p *GetEvaluationSetItemDefRequest) field255Length() int {
 	return l
 }
 
-func (p *GetEvaluationSetItemDefRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*GetEvaluationSetItemDefRequest)
+func (p *GenerateEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GenerateEvaluationSetItemsRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
@@ -15133,7 +14925,23 @@ func (p *GetEvaluationSetItemDefRequest) DeepCopy(s interface{}) error {
 
 	p.EvaluationSetID = src.EvaluationSetID
 
-	p.ItemID = src.ItemID
+	if src.SeedItemIds != nil {
+		p.SeedItemIds = make([]int64, 0, len(src.SeedItemIds))
+		for _, elem := range src.SeedItemIds {
+			var _elem int64
+			_elem = elem
+			p.SeedItemIds = append(p.SeedItemIds, _elem)
+		}
+	}
+
+	var _conf *eval_set.EvaluationSetSynthesisConf
+	if src.Conf != nil {
+		_conf = &eval_set.EvaluationSetSynthesisConf{}
+		if err := _conf.DeepCopy(src.Conf); err != nil {
+			return err
+		}
+	}
+	p.Conf = _conf
 
 	var _base *base.Base
 	if src.Base != nil {
@@ -15147,7 +14955,7 @@ func (p *GetEvaluationSetItemDefRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *GetEvaluationSetItemDefResponse) FastRead(buf []byte) (int, error) {
+func (p *GenerateEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -15165,7 +14973,7 @@ func (p *GetEvaluationSetItemDefResponse) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -15178,6 +14986,76 @@ func (p *GetEvaluationSetItemDefResponse) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -15205,24 +15083,117 @@ func (p *GetEvaluationSetItemDefResponse) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemDefResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GenerateEvaluationSetItemsResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *GetEvaluationSetItemDefResponse) FastReadField1(buf []byte) (int, error) {
+func (p *GenerateEvaluationSetItemsResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := eval_set.NewEvaluationItemDef()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ItemIds = _field
+	return offset, nil
+}
+
+func (p *GenerateEvaluationSetItemsResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.ItemDef = _field
+	p.GeneratedCnt = _field
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemDefResponse) FastReadField255(buf []byte) (int, error) {
+func (p *GenerateEvaluationSetItemsResponse) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.DuplicateCnt = _field
+	return offset, nil
+}
+
+func (p *GenerateEvaluationSetItemsResponse) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.InvalidCnt = _field
+	return offset, nil
+}
+
+func (p *GenerateEvaluationSetItemsResponse) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.FailedBatchCnt = _field
+	return offset, nil
+}
+
+func (p *GenerateEvaluationSetItemsResponse) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*dataset.ItemErrorGroup, 0, size)
+	values := make([]dataset.ItemErrorGroup, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Errors = _field
+	return offset, nil
+}
+
+func (p *GenerateEvaluationSetItemsResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -15234,76 +15205,231 @@ func (p *GetEvaluationSetItemDefResponse) FastReadField255(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemDefResponse) FastWrite(buf []byte) int {
+func (p *GenerateEvaluationSetItemsResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *GetEvaluationSetItemDefResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *GenerateEvaluationSetItemsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *GetEvaluationSetItemDefResponse) BLength() int {
+func (p *GenerateEvaluationSetItemsResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field255Length()
-	}
-	l += thrift.Binary.FieldStopLength()
-	return l
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
 }
 
-func (p *GetEvaluationSetItemDefResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *GenerateEvaluationSetItemsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetItemDef() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-		offset += p.ItemDef.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetItemIds() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ItemIds {
+			length++
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
 	}
 	return offset
 }
 
-func (p *GetEvaluationSetItemDefResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *GenerateEvaluationSetItemsResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetGeneratedCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.GeneratedCnt)
+	}
+	return offset
+}
+
+func (p *GenerateEvaluationSetItemsResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDuplicateCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.DuplicateCnt)
+	}
+	return offset
+}
+
+func (p *GenerateEvaluationSetItemsResponse) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetInvalidCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.InvalidCnt)
+	}
+	return offset
+}
+
+func (p *GenerateEvaluationSetItemsResponse) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFailedBatchCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 5)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.FailedBatchCnt)
+	}
+	return offset
+}
+
+func (p *GenerateEvaluationSetItemsResponse) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetErrors() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 6)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Errors {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *GenerateEvaluationSetItemsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *GetEvaluationSetItemDefResponse) field1Length() int {
+func (p *GenerateEvaluationSetItemsResponse) field1Length() int {
 	l := 0
-	if p.IsSetItemDef() {
+	if p.IsSetItemIds() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.ItemDef.BLength()
+		l += thrift.Binary.ListBeginLength()
+		l +=
+			thrift.Binary.I64Length() * len(p.ItemIds)
 	}
 	return l
 }
 
-func (p *GetEvaluationSetItemDefResponse) field255Length() int {
+func (p *GenerateEvaluationSetItemsResponse) field2Length() int {
+	l := 0
+	if p.IsSetGeneratedCnt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *GenerateEvaluationSetItemsResponse) field3Length() int {
+	l := 0
+	if p.IsSetDuplicateCnt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *GenerateEvaluationSetItemsResponse) field4Length() int {
+	l := 0
+	if p.IsSetInvalidCnt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *GenerateEvaluationSetItemsResponse) field5Length() int {
+	l := 0
+	if p.IsSetFailedBatchCnt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *GenerateEvaluationSetItemsResponse) field6Length() int {
+	l := 0
+	if p.IsSetErrors() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Errors {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *GenerateEvaluationSetItemsResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *GetEvaluationSetItemDefResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*GetEvaluationSetItemDefResponse)
+func (p *GenerateEvaluationSetItemsResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GenerateEvaluationSetItemsResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _itemDef *eval_set.EvaluationItemDef
-	if src.ItemDef != nil {
-		_itemDef = &eval_set.EvaluationItemDef{}
-		if err := _itemDef.DeepCopy(src.ItemDef); err != nil {
-			return err
+	if src.ItemIds != nil {
+		p.ItemIds = make([]int64, 0, len(src.ItemIds))
+		for _, elem := range src.ItemIds {
+			var _elem int64
+			_elem = elem
+			p.ItemIds = append(p.ItemIds, _elem)
+		}
+	}
+
+	if src.GeneratedCnt != nil {
+		tmp := *src.GeneratedCnt
+		p.GeneratedCnt = &tmp
+	}
+
+	if src.DuplicateCnt != nil {
+		tmp := *src.DuplicateCnt
+		p.DuplicateCnt = &tmp
+	}
+
+	if src.InvalidCnt != nil {
+		tmp := *src.InvalidCnt
+		p.InvalidCnt = &tmp
+	}
+
+	if src.FailedBatchCnt != nil {
+		tmp := *src.FailedBatchCnt
+		p.FailedBatchCnt = &tmp
+	}
+
+	if src.Errors != nil {
+		p.Errors = make([]*dataset.ItemErrorGroup, 0, len(src.Errors))
+		for _, elem := range src.Errors {
+			var _elem *dataset.ItemErrorGroup
+			if elem != nil {
+				_elem = &dataset.ItemErrorGroup{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Errors = append(p.Errors, _elem)
 		}
 	}
-	p.ItemDef = _itemDef
 
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
@@ -15317,7 +15443,7 @@ func (p *GetEvaluationSetItemDefResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ListEvaluationSetItemDefsRequest) FastRead(buf []byte) (int, error) {
+func (p *ListGeneratedEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -15408,20 +15534,6 @@ func (p *ListEvaluationSetItemDefsRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 103:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField103(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -15458,14 +15570,14 @@ func (p *ListEvaluationSetItemDefsRequest) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListEvaluationSetItemDefsRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListGeneratedEvaluationSetItemsRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListEvaluationSetItemDefsRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListGeneratedEvaluationSetItemsRequest[fieldId]))
 }
 
-func (p *ListEvaluationSetItemDefsRequest) FastReadField1(buf []byte) (int, error) {
+func (p *ListGeneratedEvaluationSetItemsRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -15479,7 +15591,7 @@ func (p *ListEvaluationSetItemDefsRequest) FastReadField1(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemDefsRequest) FastReadField2(buf []byte) (int, error) {
+func (p *ListGeneratedEvaluationSetItemsRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -15493,7 +15605,7 @@ func (p *ListEvaluationSetItemDefsRequest) FastReadField2(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemDefsRequest) FastReadField100(buf []byte) (int, error) {
+func (p *ListGeneratedEvaluationSetItemsRequest) FastReadField100(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int32
@@ -15507,7 +15619,7 @@ func (p *ListEvaluationSetItemDefsRequest) FastReadField100(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemDefsRequest) FastReadField101(buf []byte) (int, error) {
+func (p *ListGeneratedEvaluationSetItemsRequest) FastReadField101(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int32
@@ -15521,7 +15633,7 @@ func (p *ListEvaluationSetItemDefsRequest) FastReadField101(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemDefsRequest) FastReadField102(buf []byte) (int, error) {
+func (p *ListGeneratedEvaluationSetItemsRequest) FastReadField102(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -15535,32 +15647,7 @@ func (p *ListEvaluationSetItemDefsRequest) FastReadField102(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemDefsRequest) FastReadField103(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]*common.OrderBy, 0, size)
-	values := make([]common.OrderBy, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.OrderBys = _field
-	return offset, nil
-}
-
-func (p *ListEvaluationSetItemDefsRequest) FastReadField255(buf []byte) (int, error) {
+func (p *ListGeneratedEvaluationSetItemsRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -15572,11 +15659,11 @@ func (p *ListEvaluationSetItemDefsRequest) FastReadField255(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemDefsRequest) FastWrite(buf []byte) int {
+func (p *ListGeneratedEvaluationSetItemsRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ListEvaluationSetItemDefsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListGeneratedEvaluationSetItemsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -15584,14 +15671,13 @@ func (p *ListEvaluationSetItemDefsRequest) FastWriteNocopy(buf []byte, w thrift.
 		offset += p.fastWriteField100(buf[offset:], w)
 		offset += p.fastWriteField101(buf[offset:], w)
 		offset += p.fastWriteField102(buf[offset:], w)
-		offset += p.fastWriteField103(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ListEvaluationSetItemDefsRequest) BLength() int {
+func (p *ListGeneratedEvaluationSetItemsRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -15599,28 +15685,27 @@ func (p *ListEvaluationSetItemDefsRequest) BLength() int {
 		l += p.field100Length()
 		l += p.field101Length()
 		l += p.field102Length()
-		l += p.field103Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ListEvaluationSetItemDefsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListGeneratedEvaluationSetItemsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *ListEvaluationSetItemDefsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListGeneratedEvaluationSetItemsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
 	return offset
 }
 
-func (p *ListEvaluationSetItemDefsRequest) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListGeneratedEvaluationSetItemsRequest) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetPageNumber() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 100)
@@ -15629,7 +15714,7 @@ func (p *ListEvaluationSetItemDefsRequest) fastWriteField100(buf []byte, w thrif
 	return offset
 }
 
-func (p *ListEvaluationSetItemDefsRequest) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListGeneratedEvaluationSetItemsRequest) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetPageSize() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 101)
@@ -15638,7 +15723,7 @@ func (p *ListEvaluationSetItemDefsRequest) fastWriteField101(buf []byte, w thrif
 	return offset
 }
 
-func (p *ListEvaluationSetItemDefsRequest) fastWriteField102(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListGeneratedEvaluationSetItemsRequest) fastWriteField102(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetPageToken() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 102)
@@ -15647,23 +15732,7 @@ func (p *ListEvaluationSetItemDefsRequest) fastWriteField102(buf []byte, w thrif
 	return offset
 }
 
-func (p *ListEvaluationSetItemDefsRequest) fastWriteField103(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetOrderBys() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 103)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.OrderBys {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *ListEvaluationSetItemDefsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListGeneratedEvaluationSetItemsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -15672,21 +15741,21 @@ func (p *ListEvaluationSetItemDefsRequest) fastWriteField255(buf []byte, w thrif
 	return offset
 }
 
-func (p *ListEvaluationSetItemDefsRequest) field1Length() int {
+func (p *ListGeneratedEvaluationSetItemsRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ListEvaluationSetItemDefsRequest) field2Length() int {
+func (p *ListGeneratedEvaluationSetItemsRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ListEvaluationSetItemDefsRequest) field100Length() int {
+func (p *ListGeneratedEvaluationSetItemsRequest) field100Length() int {
 	l := 0
 	if p.IsSetPageNumber() {
 		l += thrift.Binary.FieldBeginLength()
@@ -15695,7 +15764,7 @@ func (p *ListEvaluationSetItemDefsRequest) field100Length() int {
 	return l
 }
 
-func (p *ListEvaluationSetItemDefsRequest) field101Length() int {
+func (p *ListGeneratedEvaluationSetItemsRequest) field101Length() int {
 	l := 0
 	if p.IsSetPageSize() {
 		l += thrift.Binary.FieldBeginLength()
@@ -15704,7 +15773,7 @@ func (p *ListEvaluationSetItemDefsRequest) field101Length() int {
 	return l
 }
 
-func (p *ListEvaluationSetItemDefsRequest) field102Length() int {
+func (p *ListGeneratedEvaluationSetItemsRequest) field102Length() int {
 	l := 0
 	if p.IsSetPageToken() {
 		l += thrift.Binary.FieldBeginLength()
@@ -15713,20 +15782,7 @@ func (p *ListEvaluationSetItemDefsRequest) field102Length() int {
 	return l
 }
 
-func (p *ListEvaluationSetItemDefsRequest) field103Length() int {
-	l := 0
-	if p.IsSetOrderBys() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.OrderBys {
-			_ = v
-			l += v.BLength()
-		}
-	}
-	return l
-}
-
-func (p *ListEvaluationSetItemDefsRequest) field255Length() int {
+func (p *ListGeneratedEvaluationSetItemsRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -15735,8 +15791,8 @@ func (p *ListEvaluationSetItemDefsRequest) field255Length() int {
 	return l
 }
 
-func (p *ListEvaluationSetItemDefsRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*ListEvaluationSetItemDefsRequest)
+func (p *ListGeneratedEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListGeneratedEvaluationSetItemsRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
@@ -15763,21 +15819,6 @@ func (p *ListEvaluationSetItemDefsRequest) DeepCopy(s interface{}) error {
 		p.PageToken = &tmp
 	}
 
-	if src.OrderBys != nil {
-		p.OrderBys = make([]*common.OrderBy, 0, len(src.OrderBys))
-		for _, elem := range src.OrderBys {
-			var _elem *common.OrderBy
-			if elem != nil {
-				_elem = &common.OrderBy{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.OrderBys = append(p.OrderBys, _elem)
-		}
-	}
-
 	var _base *base.Base
 	if src.Base != nil {
 		_base = &base.Base{}
@@ -15790,7 +15831,7 @@ func (p *ListEvaluationSetItemDefsRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ListEvaluationSetItemDefsResponse) FastRead(buf []byte) (int, error) {
+func (p *ListGeneratedEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -15876,12 +15917,12 @@ func (p *ListEvaluationSetItemDefsResponse) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListEvaluationSetItemDefsResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListGeneratedEvaluationSetItemsResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ListEvaluationSetItemDefsResponse) FastReadField1(buf []byte) (int, error) {
+func (p *ListGeneratedEvaluationSetItemsResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -15889,8 +15930,8 @@ func (p *ListEvaluationSetItemDefsResponse) FastReadField1(buf []byte) (int, err
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*eval_set.EvaluationItemDef, 0, size)
-	values := make([]eval_set.EvaluationItemDef, size)
+	_field := make([]*eval_set.GeneratedEvaluationSetItem, 0, size)
+	values := make([]eval_set.GeneratedEvaluationSetItem, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -15902,11 +15943,11 @@ func (p *ListEvaluationSetItemDefsResponse) FastReadField1(buf []byte) (int, err
 
 		_field = append(_field, _elem)
 	}
-	p.ItemDefs = _field
+	p.Items = _field
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemDefsResponse) FastReadField100(buf []byte) (int, error) {
+func (p *ListGeneratedEvaluationSetItemsResponse) FastReadField100(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int64
@@ -15920,7 +15961,7 @@ func (p *ListEvaluationSetItemDefsResponse) FastReadField100(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemDefsResponse) FastReadField101(buf []byte) (int, error) {
+func (p *ListGeneratedEvaluationSetItemsResponse) FastReadField101(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -15934,7 +15975,7 @@ func (p *ListEvaluationSetItemDefsResponse) FastReadField101(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemDefsResponse) FastReadField255(buf []byte) (int, error) {
+func (p *ListGeneratedEvaluationSetItemsResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -15946,11 +15987,11 @@ func (p *ListEvaluationSetItemDefsResponse) FastReadField255(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemDefsResponse) FastWrite(buf []byte) int {
+func (p *ListGeneratedEvaluationSetItemsResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ListEvaluationSetItemDefsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListGeneratedEvaluationSetItemsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField100(buf[offset:], w)
@@ -15962,7 +16003,7 @@ func (p *ListEvaluationSetItemDefsResponse) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *ListEvaluationSetItemDefsResponse) BLength() int {
+func (p *ListGeneratedEvaluationSetItemsResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -15974,14 +16015,14 @@ func (p *ListEvaluationSetItemDefsResponse) BLength() int {
 	return l
 }
 
-func (p *ListEvaluationSetItemDefsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListGeneratedEvaluationSetItemsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetItemDefs() {
+	if p.IsSetItems() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.ItemDefs {
+		for _, v := range p.Items {
 			length++
 			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
@@ -15990,7 +16031,7 @@ func (p *ListEvaluationSetItemDefsResponse) fastWriteField1(buf []byte, w thrift
 	return offset
 }
 
-func (p *ListEvaluationSetItemDefsResponse) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListGeneratedEvaluationSetItemsResponse) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetTotal() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 100)
@@ -15999,7 +16040,7 @@ func (p *ListEvaluationSetItemDefsResponse) fastWriteField100(buf []byte, w thri
 	return offset
 }
 
-func (p *ListEvaluationSetItemDefsResponse) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListGeneratedEvaluationSetItemsResponse) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetNextPageToken() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 101)
@@ -16008,19 +16049,19 @@ func (p *ListEvaluationSetItemDefsResponse) fastWriteField101(buf []byte, w thri
 	return offset
 }
 
-func (p *ListEvaluationSetItemDefsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListGeneratedEvaluationSetItemsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ListEvaluationSetItemDefsResponse) field1Length() int {
+func (p *ListGeneratedEvaluationSetItemsResponse) field1Length() int {
 	l := 0
-	if p.IsSetItemDefs() {
+	if p.IsSetItems() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.ItemDefs {
+		for _, v := range p.Items {
 			_ = v
 			l += v.BLength()
 		}
@@ -16028,7 +16069,7 @@ func (p *ListEvaluationSetItemDefsResponse) field1Length() int {
 	return l
 }
 
-func (p *ListEvaluationSetItemDefsResponse) field100Length() int {
+func (p *ListGeneratedEvaluationSetItemsResponse) field100Length() int {
 	l := 0
 	if p.IsSetTotal() {
 		l += thrift.Binary.FieldBeginLength()
@@ -16037,7 +16078,7 @@ func (p *ListEvaluationSetItemDefsResponse) field100Length() int {
 	return l
 }
 
-func (p *ListEvaluationSetItemDefsResponse) field101Length() int {
+func (p *ListGeneratedEvaluationSetItemsResponse) field101Length() int {
 	l := 0
 	if p.IsSetNextPageToken() {
 		l += thrift.Binary.FieldBeginLength()
@@ -16046,31 +16087,31 @@ func (p *ListEvaluationSetItemDefsResponse) field101Length() int {
 	return l
 }
 
-func (p *ListEvaluationSetItemDefsResponse) field255Length() int {
+func (p *ListGeneratedEvaluationSetItemsResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *ListEvaluationSetItemDefsResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*ListEvaluationSetItemDefsResponse)
+func (p *ListGeneratedEvaluationSetItemsResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListGeneratedEvaluationSetItemsResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.ItemDefs != nil {
-		p.ItemDefs = make([]*eval_set.EvaluationItemDef, 0, len(src.ItemDefs))
-		for _, elem := range src.ItemDefs {
-			var _elem *eval_set.EvaluationItemDef
+	if src.Items != nil {
+		p.Items = make([]*eval_set.GeneratedEvaluationSetItem, 0, len(src.Items))
+		for _, elem := range src.Items {
+			var _elem *eval_set.GeneratedEvaluationSetItem
 			if elem != nil {
-				_elem = &eval_set.EvaluationItemDef{}
+				_elem = &eval_set.GeneratedEvaluationSetItem{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
 			}
 
-			p.ItemDefs = append(p.ItemDefs, _elem)
+			p.Items = append(p.Items, _elem)
 		}
 	}
 
@@ -16099,7 +16140,7 @@ func (p *ListEvaluationSetItemDefsResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) FastRead(buf []byte) (int, error) {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16108,7 +16149,6 @@ func (p *ListEvaluationSetItemVersionsRequest) FastRead(buf []byte) (int, error)
 	var fieldId int16
 	var issetWorkspaceID bool = false
 	var issetEvaluationSetID bool = false
-	var issetItemID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -16150,55 +16190,12 @@ func (p *ListEvaluationSetItemVersionsRequest) FastRead(buf []byte) (int, error)
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetItemID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 100:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField100(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 101:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField101(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 102:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField102(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -16206,9 +16203,9 @@ func (p *ListEvaluationSetItemVersionsRequest) FastRead(buf []byte) (int, error)
 					goto SkipFieldError
 				}
 			}
-		case 103:
+		case 4:
 			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField103(buf[offset:])
+				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -16252,23 +16249,18 @@ func (p *ListEvaluationSetItemVersionsRequest) FastRead(buf []byte) (int, error)
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetItemID {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListEvaluationSetItemVersionsRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewGeneratedEvaluationSetItemsRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListEvaluationSetItemVersionsRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ReviewGeneratedEvaluationSetItemsRequest[fieldId]))
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) FastReadField1(buf []byte) (int, error) {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -16282,7 +16274,7 @@ func (p *ListEvaluationSetItemVersionsRequest) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) FastReadField2(buf []byte) (int, error) {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -16296,63 +16288,31 @@ func (p *ListEvaluationSetItemVersionsRequest) FastReadField2(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) FastReadField3(buf []byte) (int, error) {
-	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.ItemID = _field
-	return offset, nil
-}
-
-func (p *ListEvaluationSetItemVersionsRequest) FastReadField100(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.PageNumber = _field
-	return offset, nil
-}
-
-func (p *ListEvaluationSetItemVersionsRequest) FastReadField101(buf []byte) (int, error) {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = &v
 	}
-	p.PageSize = _field
-	return offset, nil
-}
-
-func (p *ListEvaluationSetItemVersionsRequest) FastReadField102(buf []byte) (int, error) {
-	offset := 0
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+		_field = append(_field, _elem)
 	}
-	p.PageToken = _field
+	p.ApprovedItemIds = _field
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) FastReadField103(buf []byte) (int, error) {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -16360,24 +16320,23 @@ func (p *ListEvaluationSetItemVersionsRequest) FastReadField103(buf []byte) (int
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*common.OrderBy, 0, size)
-	values := make([]common.OrderBy, size)
+	_field := make([]int64, 0, size)
 	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
+			_elem = v
 		}
 
 		_field = append(_field, _elem)
 	}
-	p.OrderBys = _field
+	p.RejectedItemIds = _field
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) FastReadField255(buf []byte) (int, error) {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -16389,107 +16348,83 @@ func (p *ListEvaluationSetItemVersionsRequest) FastReadField255(buf []byte) (int
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) FastWrite(buf []byte) int {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField100(buf[offset:], w)
-		offset += p.fastWriteField101(buf[offset:], w)
-		offset += p.fastWriteField102(buf[offset:], w)
-		offset += p.fastWriteField103(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) BLength() int {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field100Length()
-		l += p.field101Length()
-		l += p.field102Length()
-		l += p.field103Length()
+		l += p.field4Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
 	return offset
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
-	return offset
-}
-
-func (p *ListEvaluationSetItemVersionsRequest) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetPageNumber() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 100)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageNumber)
-	}
-	return offset
-}
-
-func (p *ListEvaluationSetItemVersionsRequest) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetPageSize() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 101)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
-	}
-	return offset
-}
-
-func (p *ListEvaluationSetItemVersionsRequest) fastWriteField102(buf []byte, w thrift.NocopyWriter) int {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPageToken() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 102)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PageToken)
+	if p.IsSetApprovedItemIds() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ApprovedItemIds {
+			length++
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
 	}
 	return offset
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) fastWriteField103(buf []byte, w thrift.NocopyWriter) int {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetOrderBys() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 103)
+	if p.IsSetRejectedItemIds() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.OrderBys {
+		for _, v := range p.RejectedItemIds {
 			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
 		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
 	}
 	return offset
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -16498,78 +16433,53 @@ func (p *ListEvaluationSetItemVersionsRequest) fastWriteField255(buf []byte, w t
 	return offset
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) field1Length() int {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) field2Length() int {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) field3Length() int {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) field3Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetApprovedItemIds() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		l +=
+			thrift.Binary.I64Length() * len(p.ApprovedItemIds)
+	}
 	return l
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) field100Length() int {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) field4Length() int {
 	l := 0
-	if p.IsSetPageNumber() {
+	if p.IsSetRejectedItemIds() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+		l += thrift.Binary.ListBeginLength()
+		l +=
+			thrift.Binary.I64Length() * len(p.RejectedItemIds)
 	}
 	return l
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) field101Length() int {
+func (p *ReviewGeneratedEvaluationSetItemsRequest) field255Length() int {
 	l := 0
-	if p.IsSetPageSize() {
+	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+		l += p.Base.BLength()
 	}
 	return l
 }
 
-func (p *ListEvaluationSetItemVersionsRequest) field102Length() int {
-	l := 0
-	if p.IsSetPageToken() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.PageToken)
-	}
-	return l
-}
-
-func (p *ListEvaluationSetItemVersionsRequest) field103Length() int {
-	l := 0
-	if p.IsSetOrderBys() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.OrderBys {
-			_ = v
-			l += v.BLength()
-		}
-	}
-	return l
-}
-
-func (p *ListEvaluationSetItemVersionsRequest) field255Length() int {
-	l := 0
-	if p.IsSetBase() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Base.BLength()
-	}
-	return l
-}
-
-func (p *ListEvaluationSetItemVersionsRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*ListEvaluationSetItemVersionsRequest)
+func (p *ReviewGeneratedEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ReviewGeneratedEvaluationSetItemsRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
@@ -16578,38 +16488,21 @@ func (p *ListEvaluationSetItemVersionsRequest) DeepCopy(s interface{}) error {
 
 	p.EvaluationSetID = src.EvaluationSetID
 
-	p.ItemID = src.ItemID
-
-	if src.PageNumber != nil {
-		tmp := *src.PageNumber
-		p.PageNumber = &tmp
-	}
-
-	if src.PageSize != nil {
-		tmp := *src.PageSize
-		p.PageSize = &tmp
-	}
-
-	if src.PageToken != nil {
-		var tmp string
-		if *src.PageToken != "" {
-			tmp = kutils.StringDeepCopy(*src.PageToken)
+	if src.ApprovedItemIds != nil {
+		p.ApprovedItemIds = make([]int64, 0, len(src.ApprovedItemIds))
+		for _, elem := range src.ApprovedItemIds {
+			var _elem int64
+			_elem = elem
+			p.ApprovedItemIds = append(p.ApprovedItemIds, _elem)
 		}
-		p.PageToken = &tmp
 	}
 
-	if src.OrderBys != nil {
-		p.OrderBys = make([]*common.OrderBy, 0, len(src.OrderBys))
-		for _, elem := range src.OrderBys {
-			var _elem *common.OrderBy
-			if elem != nil {
-				_elem = &common.OrderBy{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.OrderBys = append(p.OrderBys, _elem)
+	if src.RejectedItemIds != nil {
+		p.RejectedItemIds = make([]int64, 0, len(src.RejectedItemIds))
+		for _, elem := range src.RejectedItemIds {
+			var _elem int64
+			_elem = elem
+			p.RejectedItemIds = append(p.RejectedItemIds, _elem)
 		}
 	}
 
@@ -16625,7 +16518,7 @@ func (p *ListEvaluationSetItemVersionsRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ListEvaluationSetItemVersionsResponse) FastRead(buf []byte) (int, error) {
+func (p *ReviewGeneratedEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16642,48 +16535,6 @@ func (p *ListEvaluationSetItemVersionsResponse) FastRead(buf []byte) (int, error
 			break
 		}
 		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 100:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField100(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 101:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField101(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -16711,65 +16562,12 @@ func (p *ListEvaluationSetItemVersionsResponse) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListEvaluationSetItemVersionsResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewGeneratedEvaluationSetItemsResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ListEvaluationSetItemVersionsResponse) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]*eval_set.EvaluationItemVersion, 0, size)
-	values := make([]eval_set.EvaluationItemVersion, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.Versions = _field
-	return offset, nil
-}
-
-func (p *ListEvaluationSetItemVersionsResponse) FastReadField100(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Total = _field
-	return offset, nil
-}
-
-func (p *ListEvaluationSetItemVersionsResponse) FastReadField101(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.NextPageToken = _field
-	return offset, nil
-}
-
-func (p *ListEvaluationSetItemVersionsResponse) FastReadField255(buf []byte) (int, error) {
+func (p *ReviewGeneratedEvaluationSetItemsResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -16781,152 +16579,53 @@ func (p *ListEvaluationSetItemVersionsResponse) FastReadField255(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ListEvaluationSetItemVersionsResponse) FastWrite(buf []byte) int {
+func (p *ReviewGeneratedEvaluationSetItemsResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ListEvaluationSetItemVersionsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ReviewGeneratedEvaluationSetItemsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField100(buf[offset:], w)
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField101(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ListEvaluationSetItemVersionsResponse) BLength() int {
+func (p *ReviewGeneratedEvaluationSetItemsResponse) BLength() int {
 	l := 0
 	if p != nil {
-		l += p.field1Length()
-		l += p.field100Length()
-		l += p.field101Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ListEvaluationSetItemVersionsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetVersions() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.Versions {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *ListEvaluationSetItemVersionsResponse) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetTotal() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 100)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.Total)
-	}
-	return offset
-}
-
-func (p *ListEvaluationSetItemVersionsResponse) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetNextPageToken() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 101)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.NextPageToken)
-	}
-	return offset
-}
-
-func (p *ListEvaluationSetItemVersionsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ReviewGeneratedEvaluationSetItemsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ListEvaluationSetItemVersionsResponse) field1Length() int {
-	l := 0
-	if p.IsSetVersions() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.Versions {
-			_ = v
-			l += v.BLength()
-		}
-	}
-	return l
-}
-
-func (p *ListEvaluationSetItemVersionsResponse) field100Length() int {
-	l := 0
-	if p.IsSetTotal() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *ListEvaluationSetItemVersionsResponse) field101Length() int {
-	l := 0
-	if p.IsSetNextPageToken() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.NextPageToken)
-	}
-	return l
-}
-
-func (p *ListEvaluationSetItemVersionsResponse) field255Length() int {
+func (p *ReviewGeneratedEvaluationSetItemsResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *ListEvaluationSetItemVersionsResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*ListEvaluationSetItemVersionsResponse)
+func (p *ReviewGeneratedEvaluationSetItemsResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ReviewGeneratedEvaluationSetItemsResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Versions != nil {
-		p.Versions = make([]*eval_set.EvaluationItemVersion, 0, len(src.Versions))
-		for _, elem := range src.Versions {
-			var _elem *eval_set.EvaluationItemVersion
-			if elem != nil {
-				_elem = &eval_set.EvaluationItemVersion{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.Versions = append(p.Versions, _elem)
-		}
-	}
-
-	if src.Total != nil {
-		tmp := *src.Total
-		p.Total = &tmp
-	}
-
-	if src.NextPageToken != nil {
-		var tmp string
-		if *src.NextPageToken != "" {
-			tmp = kutils.StringDeepCopy(*src.NextPageToken)
-		}
-		p.NextPageToken = &tmp
-	}
-
-	var _baseResp *base.BaseResp
-	if src.BaseResp != nil {
-		_baseResp = &base.BaseResp{}
-		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
-			return err
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
 		}
 	}
 	p.BaseResp = _baseResp
@@ -16934,16 +16633,13 @@ func (p *ListEvaluationSetItemVersionsResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *GetEvaluationSetItemVersionRequest) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetItemUsage) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetWorkspaceID bool = false
-	var issetEvaluationSetID bool = false
-	var issetItemID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -16961,7 +16657,6 @@ func (p *GetEvaluationSetItemVersionRequest) FastRead(buf []byte) (int, error) {
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetWorkspaceID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -16970,13 +16665,12 @@ func (p *GetEvaluationSetItemVersionRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetEvaluationSetID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -16985,13 +16679,12 @@ func (p *GetEvaluationSetItemVersionRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.I32 {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetItemID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -17014,7 +16707,7 @@ func (p *GetEvaluationSetItemVersionRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField5(buf[offset:])
 				offset += l
 				if err != nil {
@@ -17027,9 +16720,51 @@ func (p *GetEvaluationSetItemVersionRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 255:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField9(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -17050,74 +16785,74 @@ func (p *GetEvaluationSetItemVersionRequest) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetWorkspaceID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetEvaluationSetID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetItemID {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemVersionRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetItemUsage[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetEvaluationSetItemVersionRequest[fieldId]))
 }
 
-func (p *GetEvaluationSetItemVersionRequest) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetItemUsage) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
+	var _field *int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.WorkspaceID = _field
+	p.ExptID = _field
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemVersionRequest) FastReadField2(buf []byte) (int, error) {
+func (p *EvaluationSetItemUsage) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.EvaluationSetID = _field
+	p.ExptName = _field
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemVersionRequest) FastReadField3(buf []byte) (int, error) {
+func (p *EvaluationSetItemUsage) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
+	var _field *expt.ExptStatus
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		tmp := expt.ExptStatus(v)
+		_field = &tmp
+	}
+	p.ExptStatus = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetItemUsage) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.ItemID = _field
+	p.EvalSetVersionID = _field
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemVersionRequest) FastReadField4(buf []byte) (int, error) {
+func (p *EvaluationSetItemUsage) FastReadField5(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int64
@@ -17131,51 +16866,97 @@ func (p *GetEvaluationSetItemVersionRequest) FastReadField4(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemVersionRequest) FastReadField5(buf []byte) (int, error) {
+func (p *EvaluationSetItemUsage) FastReadField6(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *expt.ItemRunState
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		tmp := expt.ItemRunState(v)
+		_field = &tmp
+	}
+	p.ItemStatus = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetItemUsage) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.ItemVersion = _field
+	p.StartAt = _field
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemVersionRequest) FastReadField255(buf []byte) (int, error) {
+func (p *EvaluationSetItemUsage) FastReadField8(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBase()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.Base = _field
+	p.FinishedAt = _field
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemVersionRequest) FastWrite(buf []byte) int {
+func (p *EvaluationSetItemUsage) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*EvaluationSetItemTurnScore, 0, size)
+	values := make([]EvaluationSetItemTurnScore, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.TurnScores = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetItemUsage) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *GetEvaluationSetItemVersionRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetItemUsage) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField255(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *GetEvaluationSetItemVersionRequest) BLength() int {
+func (p *EvaluationSetItemUsage) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -17183,82 +16964,140 @@ func (p *GetEvaluationSetItemVersionRequest) BLength() int {
 		l += p.field3Length()
 		l += p.field4Length()
 		l += p.field5Length()
-		l += p.field255Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
+		l += p.field9Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *GetEvaluationSetItemVersionRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetItemUsage) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	if p.IsSetExptID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExptID)
+	}
 	return offset
 }
 
-func (p *GetEvaluationSetItemVersionRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetItemUsage) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
+	if p.IsSetExptName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ExptName)
+	}
 	return offset
 }
 
-func (p *GetEvaluationSetItemVersionRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetItemUsage) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
+	if p.IsSetExptStatus() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.ExptStatus))
+	}
 	return offset
 }
 
-func (p *GetEvaluationSetItemVersionRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetItemUsage) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetItemVersionID() {
+	if p.IsSetEvalSetVersionID() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.EvalSetVersionID)
+	}
+	return offset
+}
+
+func (p *EvaluationSetItemUsage) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemVersionID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 5)
 		offset += thrift.Binary.WriteI64(buf[offset:], *p.ItemVersionID)
 	}
 	return offset
 }
 
-func (p *GetEvaluationSetItemVersionRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetItemUsage) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetItemVersion() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ItemVersion)
+	if p.IsSetItemStatus() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 6)
+		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.ItemStatus))
 	}
 	return offset
 }
 
-func (p *GetEvaluationSetItemVersionRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetItemUsage) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetBase() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetStartAt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 7)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.StartAt)
 	}
 	return offset
 }
 
-func (p *GetEvaluationSetItemVersionRequest) field1Length() int {
+func (p *EvaluationSetItemUsage) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFinishedAt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 8)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.FinishedAt)
+	}
+	return offset
+}
+
+func (p *EvaluationSetItemUsage) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTurnScores() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 9)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.TurnScores {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *EvaluationSetItemUsage) field1Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetExptID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
 	return l
 }
 
-func (p *GetEvaluationSetItemVersionRequest) field2Length() int {
+func (p *EvaluationSetItemUsage) field2Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetExptName() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ExptName)
+	}
 	return l
 }
 
-func (p *GetEvaluationSetItemVersionRequest) field3Length() int {
+func (p *EvaluationSetItemUsage) field3Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetExptStatus() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
 	return l
 }
 
-func (p *GetEvaluationSetItemVersionRequest) field4Length() int {
+func (p *EvaluationSetItemUsage) field4Length() int {
+	l := 0
+	if p.IsSetEvalSetVersionID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluationSetItemUsage) field5Length() int {
 	l := 0
 	if p.IsSetItemVersionID() {
 		l += thrift.Binary.FieldBeginLength()
@@ -17267,62 +17106,114 @@ func (p *GetEvaluationSetItemVersionRequest) field4Length() int {
 	return l
 }
 
-func (p *GetEvaluationSetItemVersionRequest) field5Length() int {
+func (p *EvaluationSetItemUsage) field6Length() int {
 	l := 0
-	if p.IsSetItemVersion() {
+	if p.IsSetItemStatus() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.ItemVersion)
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *GetEvaluationSetItemVersionRequest) field255Length() int {
+func (p *EvaluationSetItemUsage) field7Length() int {
 	l := 0
-	if p.IsSetBase() {
+	if p.IsSetStartAt() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Base.BLength()
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *GetEvaluationSetItemVersionRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*GetEvaluationSetItemVersionRequest)
+func (p *EvaluationSetItemUsage) field8Length() int {
+	l := 0
+	if p.IsSetFinishedAt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluationSetItemUsage) field9Length() int {
+	l := 0
+	if p.IsSetTurnScores() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.TurnScores {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvaluationSetItemUsage) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetItemUsage)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.WorkspaceID = src.WorkspaceID
+	if src.ExptID != nil {
+		tmp := *src.ExptID
+		p.ExptID = &tmp
+	}
 
-	p.EvaluationSetID = src.EvaluationSetID
+	if src.ExptName != nil {
+		var tmp string
+		if *src.ExptName != "" {
+			tmp = kutils.StringDeepCopy(*src.ExptName)
+		}
+		p.ExptName = &tmp
+	}
 
-	p.ItemID = src.ItemID
+	if src.ExptStatus != nil {
+		tmp := *src.ExptStatus
+		p.ExptStatus = &tmp
+	}
+
+	if src.EvalSetVersionID != nil {
+		tmp := *src.EvalSetVersionID
+		p.EvalSetVersionID = &tmp
+	}
 
 	if src.ItemVersionID != nil {
 		tmp := *src.ItemVersionID
 		p.ItemVersionID = &tmp
 	}
 
-	if src.ItemVersion != nil {
-		var tmp string
-		if *src.ItemVersion != "" {
-			tmp = kutils.StringDeepCopy(*src.ItemVersion)
-		}
-		p.ItemVersion = &tmp
+	if src.ItemStatus != nil {
+		tmp := *src.ItemStatus
+		p.ItemStatus = &tmp
 	}
 
-	var _base *base.Base
-	if src.Base != nil {
-		_base = &base.Base{}
-		if err := _base.DeepCopy(src.Base); err != nil {
-			return err
+	if src.StartAt != nil {
+		tmp := *src.StartAt
+		p.StartAt = &tmp
+	}
+
+	if src.FinishedAt != nil {
+		tmp := *src.FinishedAt
+		p.FinishedAt = &tmp
+	}
+
+	if src.TurnScores != nil {
+		p.TurnScores = make([]*EvaluationSetItemTurnScore, 0, len(src.TurnScores))
+		for _, elem := range src.TurnScores {
+			var _elem *EvaluationSetItemTurnScore
+			if elem != nil {
+				_elem = &EvaluationSetItemTurnScore{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.TurnScores = append(p.TurnScores, _elem)
 		}
 	}
-	p.Base = _base
 
 	return nil
 }
 
-func (p *GetEvaluationSetItemVersionResponse) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetItemTurnScore) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17340,7 +17231,7 @@ func (p *GetEvaluationSetItemVersionResponse) FastRead(buf []byte) (int, error)
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -17353,9 +17244,23 @@ func (p *GetEvaluationSetItemVersionResponse) FastRead(buf []byte) (int, error)
 					goto SkipFieldError
 				}
 			}
-		case 255:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
+		case 2:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -17380,119 +17285,200 @@ func (p *GetEvaluationSetItemVersionResponse) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemVersionResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetItemTurnScore[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *GetEvaluationSetItemVersionResponse) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetItemTurnScore) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := eval_set.NewEvaluationItemVersion()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.Version = _field
+	p.TurnID = _field
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemVersionResponse) FastReadField255(buf []byte) (int, error) {
+func (p *EvaluationSetItemTurnScore) FastReadField2(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBaseResp()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.BaseResp = _field
+	p.WeightedScore = _field
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemVersionResponse) FastWrite(buf []byte) int {
+func (p *EvaluationSetItemTurnScore) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[string]float64, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		var _val float64
+		if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	p.EvaluatorScores = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetItemTurnScore) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *GetEvaluationSetItemVersionResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetItemTurnScore) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField255(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *GetEvaluationSetItemVersionResponse) BLength() int {
+func (p *EvaluationSetItemTurnScore) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field255Length()
+		l += p.field2Length()
+		l += p.field3Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *GetEvaluationSetItemVersionResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetItemTurnScore) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetVersion() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-		offset += p.Version.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetTurnID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.TurnID)
 	}
 	return offset
 }
 
-func (p *GetEvaluationSetItemVersionResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetItemTurnScore) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetWeightedScore() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 2)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.WeightedScore)
+	}
 	return offset
 }
 
-func (p *GetEvaluationSetItemVersionResponse) field1Length() int {
+func (p *EvaluationSetItemTurnScore) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorScores() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 3)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.EvaluatorScores {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+			offset += thrift.Binary.WriteDouble(buf[offset:], v)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.DOUBLE, length)
+	}
+	return offset
+}
+
+func (p *EvaluationSetItemTurnScore) field1Length() int {
 	l := 0
-	if p.IsSetVersion() {
+	if p.IsSetTurnID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Version.BLength()
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *GetEvaluationSetItemVersionResponse) field255Length() int {
+func (p *EvaluationSetItemTurnScore) field2Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.BaseResp.BLength()
+	if p.IsSetWeightedScore() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
 	return l
 }
 
-func (p *GetEvaluationSetItemVersionResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*GetEvaluationSetItemVersionResponse)
+func (p *EvaluationSetItemTurnScore) field3Length() int {
+	l := 0
+	if p.IsSetEvaluatorScores() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.EvaluatorScores {
+			_, _ = k, v
+
+			l += thrift.Binary.StringLengthNocopy(k)
+			l += thrift.Binary.DoubleLength()
+		}
+	}
+	return l
+}
+
+func (p *EvaluationSetItemTurnScore) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetItemTurnScore)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _version *eval_set.EvaluationItemVersion
-	if src.Version != nil {
-		_version = &eval_set.EvaluationItemVersion{}
-		if err := _version.DeepCopy(src.Version); err != nil {
-			return err
-		}
+	if src.TurnID != nil {
+		tmp := *src.TurnID
+		p.TurnID = &tmp
 	}
-	p.Version = _version
 
-	var _baseResp *base.BaseResp
-	if src.BaseResp != nil {
-		_baseResp = &base.BaseResp{}
-		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
-			return err
+	if src.WeightedScore != nil {
+		tmp := *src.WeightedScore
+		p.WeightedScore = &tmp
+	}
+
+	if src.EvaluatorScores != nil {
+		p.EvaluatorScores = make(map[string]float64, len(src.EvaluatorScores))
+		for key, val := range src.EvaluatorScores {
+			var _key string
+			if key != "" {
+				_key = kutils.StringDeepCopy(key)
+			}
+
+			var _val float64
+			_val = val
+
+			p.EvaluatorScores[_key] = _val
 		}
 	}
-	p.BaseResp = _baseResp
 
 	return nil
 }
 
-func (p *UpdateEvaluationSetItemVersionRequest) FastRead(buf []byte) (int, error) {
+func (p *GetEvaluationSetItemLineageRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17557,23 +17543,9 @@ func (p *UpdateEvaluationSetItemVersionRequest) FastRead(buf []byte) (int, error
 					goto SkipFieldError
 				}
 			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField5(buf[offset:])
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -17585,53 +17557,11 @@ func (p *UpdateEvaluationSetItemVersionRequest) FastRead(buf []byte) (int, error
 					goto SkipFieldError
 				}
 			}
-		case 6:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField6(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 7:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField7(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 255:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
 			}
 		}
 	}
@@ -17654,14 +17584,14 @@ func (p *UpdateEvaluationSetItemVersionRequest) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateEvaluationSetItemVersionRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemLineageRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_UpdateEvaluationSetItemVersionRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetEvaluationSetItemLineageRequest[fieldId]))
 }
 
-func (p *UpdateEvaluationSetItemVersionRequest) FastReadField1(buf []byte) (int, error) {
+func (p *GetEvaluationSetItemLineageRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -17675,7 +17605,7 @@ func (p *UpdateEvaluationSetItemVersionRequest) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *UpdateEvaluationSetItemVersionRequest) FastReadField2(buf []byte) (int, error) {
+func (p *GetEvaluationSetItemLineageRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -17689,7 +17619,7 @@ func (p *UpdateEvaluationSetItemVersionRequest) FastReadField2(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *UpdateEvaluationSetItemVersionRequest) FastReadField3(buf []byte) (int, error) {
+func (p *GetEvaluationSetItemLineageRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -17703,63 +17633,7 @@ func (p *UpdateEvaluationSetItemVersionRequest) FastReadField3(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *UpdateEvaluationSetItemVersionRequest) FastReadField4(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.ItemVersionID = _field
-	return offset, nil
-}
-
-func (p *UpdateEvaluationSetItemVersionRequest) FastReadField5(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Status = _field
-	return offset, nil
-}
-
-func (p *UpdateEvaluationSetItemVersionRequest) FastReadField6(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Description = _field
-	return offset, nil
-}
-
-func (p *UpdateEvaluationSetItemVersionRequest) FastReadField7(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.ItemVersion = _field
-	return offset, nil
-}
-
-func (p *UpdateEvaluationSetItemVersionRequest) FastReadField255(buf []byte) (int, error) {
+func (p *GetEvaluationSetItemLineageRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -17771,100 +17645,56 @@ func (p *UpdateEvaluationSetItemVersionRequest) FastReadField255(buf []byte) (in
 	return offset, nil
 }
 
-func (p *UpdateEvaluationSetItemVersionRequest) FastWrite(buf []byte) int {
+func (p *GetEvaluationSetItemLineageRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UpdateEvaluationSetItemVersionRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetItemLineageRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
-		offset += p.fastWriteField7(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *UpdateEvaluationSetItemVersionRequest) BLength() int {
+func (p *GetEvaluationSetItemLineageRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field4Length()
-		l += p.field5Length()
-		l += p.field6Length()
-		l += p.field7Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *UpdateEvaluationSetItemVersionRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetItemLineageRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *UpdateEvaluationSetItemVersionRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetItemLineageRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
 	return offset
 }
 
-func (p *UpdateEvaluationSetItemVersionRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetItemLineageRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
 	return offset
 }
 
-func (p *UpdateEvaluationSetItemVersionRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetItemVersionID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.ItemVersionID)
-	}
-	return offset
-}
-
-func (p *UpdateEvaluationSetItemVersionRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetStatus() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Status)
-	}
-	return offset
-}
-
-func (p *UpdateEvaluationSetItemVersionRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetDescription() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 6)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
-	}
-	return offset
-}
-
-func (p *UpdateEvaluationSetItemVersionRequest) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetItemVersion() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 7)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ItemVersion)
-	}
-	return offset
-}
-
-func (p *UpdateEvaluationSetItemVersionRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetItemLineageRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -17873,64 +17703,28 @@ func (p *UpdateEvaluationSetItemVersionRequest) fastWriteField255(buf []byte, w
 	return offset
 }
 
-func (p *UpdateEvaluationSetItemVersionRequest) field1Length() int {
+func (p *GetEvaluationSetItemLineageRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *UpdateEvaluationSetItemVersionRequest) field2Length() int {
+func (p *GetEvaluationSetItemLineageRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *UpdateEvaluationSetItemVersionRequest) field3Length() int {
+func (p *GetEvaluationSetItemLineageRequest) field3Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *UpdateEvaluationSetItemVersionRequest) field4Length() int {
-	l := 0
-	if p.IsSetItemVersionID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *UpdateEvaluationSetItemVersionRequest) field5Length() int {
-	l := 0
-	if p.IsSetStatus() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Status)
-	}
-	return l
-}
-
-func (p *UpdateEvaluationSetItemVersionRequest) field6Length() int {
-	l := 0
-	if p.IsSetDescription() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Description)
-	}
-	return l
-}
-
-func (p *UpdateEvaluationSetItemVersionRequest) field7Length() int {
-	l := 0
-	if p.IsSetItemVersion() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.ItemVersion)
-	}
-	return l
-}
-
-func (p *UpdateEvaluationSetItemVersionRequest) field255Length() int {
+func (p *GetEvaluationSetItemLineageRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -17939,8 +17733,8 @@ func (p *UpdateEvaluationSetItemVersionRequest) field255Length() int {
 	return l
 }
 
-func (p *UpdateEvaluationSetItemVersionRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*UpdateEvaluationSetItemVersionRequest)
+func (p *GetEvaluationSetItemLineageRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetEvaluationSetItemLineageRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
@@ -17951,35 +17745,6 @@ func (p *UpdateEvaluationSetItemVersionRequest) DeepCopy(s interface{}) error {
 
 	p.ItemID = src.ItemID
 
-	if src.ItemVersionID != nil {
-		tmp := *src.ItemVersionID
-		p.ItemVersionID = &tmp
-	}
-
-	if src.Status != nil {
-		var tmp string
-		if *src.Status != "" {
-			tmp = kutils.StringDeepCopy(*src.Status)
-		}
-		p.Status = &tmp
-	}
-
-	if src.Description != nil {
-		var tmp string
-		if *src.Description != "" {
-			tmp = kutils.StringDeepCopy(*src.Description)
-		}
-		p.Description = &tmp
-	}
-
-	if src.ItemVersion != nil {
-		var tmp string
-		if *src.ItemVersion != "" {
-			tmp = kutils.StringDeepCopy(*src.ItemVersion)
-		}
-		p.ItemVersion = &tmp
-	}
-
 	var _base *base.Base
 	if src.Base != nil {
 		_base = &base.Base{}
@@ -17992,7 +17757,7 @@ func (p *UpdateEvaluationSetItemVersionRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *UpdateEvaluationSetItemVersionResponse) FastRead(buf []byte) (int, error) {
+func (p *GetEvaluationSetItemLineageResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18009,9 +17774,9 @@ func (p *UpdateEvaluationSetItemVersionResponse) FastRead(buf []byte) (int, erro
 			break
 		}
 		switch fieldId {
-		case 255:
+		case 1:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -18023,132 +17788,27 @@ func (p *UpdateEvaluationSetItemVersionResponse) FastRead(buf []byte) (int, erro
 					goto SkipFieldError
 				}
 			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
 			}
-		}
-	}
-
-	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateEvaluationSetItemVersionResponse[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-}
-
-func (p *UpdateEvaluationSetItemVersionResponse) FastReadField255(buf []byte) (int, error) {
-	offset := 0
-	_field := base.NewBaseResp()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.BaseResp = _field
-	return offset, nil
-}
-
-func (p *UpdateEvaluationSetItemVersionResponse) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
-
-func (p *UpdateEvaluationSetItemVersionResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p != nil {
-		offset += p.fastWriteField255(buf[offset:], w)
-	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
-}
-
-func (p *UpdateEvaluationSetItemVersionResponse) BLength() int {
-	l := 0
-	if p != nil {
-		l += p.field255Length()
-	}
-	l += thrift.Binary.FieldStopLength()
-	return l
-}
-
-func (p *UpdateEvaluationSetItemVersionResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
-	return offset
-}
-
-func (p *UpdateEvaluationSetItemVersionResponse) field255Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.BaseResp.BLength()
-	return l
-}
-
-func (p *UpdateEvaluationSetItemVersionResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*UpdateEvaluationSetItemVersionResponse)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
-	}
-
-	var _baseResp *base.BaseResp
-	if src.BaseResp != nil {
-		_baseResp = &base.BaseResp{}
-		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
-			return err
-		}
-	}
-	p.BaseResp = _baseResp
-
-	return nil
-}
-
-func (p *ClearEvaluationSetDraftItemRequest) FastRead(buf []byte) (int, error) {
-
-	var err error
-	var offset int
-	var l int
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	var issetWorkspaceID bool = false
-	var issetEvaluationSetID bool = false
-	for {
-		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
-		offset += l
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetWorkspaceID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField2(buf[offset:])
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetEvaluationSetID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -18179,73 +17839,86 @@ func (p *ClearEvaluationSetDraftItemRequest) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetWorkspaceID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetEvaluationSetID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ClearEvaluationSetDraftItemRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemLineageResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ClearEvaluationSetDraftItemRequest[fieldId]))
 }
 
-func (p *ClearEvaluationSetDraftItemRequest) FastReadField1(buf []byte) (int, error) {
+func (p *GetEvaluationSetItemLineageResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_field := eval_set.NewEvaluationSetItemProvenance()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
 	}
-	p.WorkspaceID = _field
+	p.Provenance = _field
 	return offset, nil
 }
 
-func (p *ClearEvaluationSetDraftItemRequest) FastReadField2(buf []byte) (int, error) {
+func (p *GetEvaluationSetItemLineageResponse) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*EvaluationSetItemUsage, 0, size)
+	values := make([]EvaluationSetItemUsage, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Usages = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemLineageResponse) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.EvaluationSetID = _field
+	p.Truncated = _field
 	return offset, nil
 }
 
-func (p *ClearEvaluationSetDraftItemRequest) FastReadField255(buf []byte) (int, error) {
+func (p *GetEvaluationSetItemLineageResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBase()
+	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Base = _field
+	p.BaseResp = _field
 	return offset, nil
 }
 
-func (p *ClearEvaluationSetDraftItemRequest) FastWrite(buf []byte) int {
+func (p *GetEvaluationSetItemLineageResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ClearEvaluationSetDraftItemRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetItemLineageResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
@@ -18254,92 +17927,153 @@ func (p *ClearEvaluationSetDraftItemRequest) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *ClearEvaluationSetDraftItemRequest) BLength() int {
+func (p *GetEvaluationSetItemLineageResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ClearEvaluationSetDraftItemRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetItemLineageResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	if p.IsSetProvenance() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Provenance.FastWriteNocopy(buf[offset:], w)
+	}
 	return offset
 }
 
-func (p *ClearEvaluationSetDraftItemRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetItemLineageResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
+	if p.IsSetUsages() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Usages {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
 	return offset
 }
 
-func (p *ClearEvaluationSetDraftItemRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetItemLineageResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetBase() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetTruncated() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 3)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.Truncated)
 	}
 	return offset
 }
 
-func (p *ClearEvaluationSetDraftItemRequest) field1Length() int {
+func (p *GetEvaluationSetItemLineageResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *GetEvaluationSetItemLineageResponse) field1Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetProvenance() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Provenance.BLength()
+	}
 	return l
 }
 
-func (p *ClearEvaluationSetDraftItemRequest) field2Length() int {
+func (p *GetEvaluationSetItemLineageResponse) field2Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetUsages() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Usages {
+			_ = v
+			l += v.BLength()
+		}
+	}
 	return l
 }
 
-func (p *ClearEvaluationSetDraftItemRequest) field255Length() int {
+func (p *GetEvaluationSetItemLineageResponse) field3Length() int {
 	l := 0
-	if p.IsSetBase() {
+	if p.IsSetTruncated() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Base.BLength()
+		l += thrift.Binary.BoolLength()
 	}
 	return l
 }
 
-func (p *ClearEvaluationSetDraftItemRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*ClearEvaluationSetDraftItemRequest)
+func (p *GetEvaluationSetItemLineageResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *GetEvaluationSetItemLineageResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetEvaluationSetItemLineageResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.WorkspaceID = src.WorkspaceID
+	var _provenance *eval_set.EvaluationSetItemProvenance
+	if src.Provenance != nil {
+		_provenance = &eval_set.EvaluationSetItemProvenance{}
+		if err := _provenance.DeepCopy(src.Provenance); err != nil {
+			return err
+		}
+	}
+	p.Provenance = _provenance
 
-	p.EvaluationSetID = src.EvaluationSetID
+	if src.Usages != nil {
+		p.Usages = make([]*EvaluationSetItemUsage, 0, len(src.Usages))
+		for _, elem := range src.Usages {
+			var _elem *EvaluationSetItemUsage
+			if elem != nil {
+				_elem = &EvaluationSetItemUsage{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
 
-	var _base *base.Base
-	if src.Base != nil {
-		_base = &base.Base{}
-		if err := _base.DeepCopy(src.Base); err != nil {
+			p.Usages = append(p.Usages, _elem)
+		}
+	}
+
+	if src.Truncated != nil {
+		tmp := *src.Truncated
+		p.Truncated = &tmp
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
 			return err
 		}
 	}
-	p.Base = _base
+	p.BaseResp = _baseResp
 
 	return nil
 }
 
-func (p *ClearEvaluationSetDraftItemResponse) FastRead(buf []byte) (int, error) {
+func (p *ListEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluationSetID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -18350,13 +18084,14 @@ func (p *ClearEvaluationSetDraftItemResponse) FastRead(buf []byte) (int, error)
 			break
 		}
 		switch fieldId {
-		case 255:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetWorkspaceID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -18364,119 +18099,14 @@ func (p *ClearEvaluationSetDraftItemResponse) FastRead(buf []byte) (int, error)
 					goto SkipFieldError
 				}
 			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
-			}
-		}
-	}
-
-	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ClearEvaluationSetDraftItemResponse[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-}
-
-func (p *ClearEvaluationSetDraftItemResponse) FastReadField255(buf []byte) (int, error) {
-	offset := 0
-	_field := base.NewBaseResp()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.BaseResp = _field
-	return offset, nil
-}
-
-func (p *ClearEvaluationSetDraftItemResponse) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
-
-func (p *ClearEvaluationSetDraftItemResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p != nil {
-		offset += p.fastWriteField255(buf[offset:], w)
-	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
-}
-
-func (p *ClearEvaluationSetDraftItemResponse) BLength() int {
-	l := 0
-	if p != nil {
-		l += p.field255Length()
-	}
-	l += thrift.Binary.FieldStopLength()
-	return l
-}
-
-func (p *ClearEvaluationSetDraftItemResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
-	return offset
-}
-
-func (p *ClearEvaluationSetDraftItemResponse) field255Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.BaseResp.BLength()
-	return l
-}
-
-func (p *ClearEvaluationSetDraftItemResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*ClearEvaluationSetDraftItemResponse)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
-	}
-
-	var _baseResp *base.BaseResp
-	if src.BaseResp != nil {
-		_baseResp = &base.BaseResp{}
-		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
-			return err
-		}
-	}
-	p.BaseResp = _baseResp
-
-	return nil
-}
-
-func (p *GetEvaluationSetItemFieldRequest) FastRead(buf []byte) (int, error) {
-
-	var err error
-	var offset int
-	var l int
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	var issetWorkspaceID bool = false
-	var issetEvaluationSetID bool = false
-	var issetItemPk bool = false
-	var issetFieldName bool = false
-	for {
-		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
-		offset += l
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
+		case 2:
 			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
+				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetWorkspaceID = true
+				issetEvaluationSetID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -18484,14 +18114,13 @@ func (p *GetEvaluationSetItemFieldRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
+		case 3:
 			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField2(buf[offset:])
+				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetEvaluationSetID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -18499,14 +18128,13 @@ func (p *GetEvaluationSetItemFieldRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField3(buf[offset:])
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetItemPk = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -18514,14 +18142,13 @@ func (p *GetEvaluationSetItemFieldRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField5(buf[offset:])
+		case 100:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField100(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetFieldName = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -18529,9 +18156,9 @@ func (p *GetEvaluationSetItemFieldRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField6(buf[offset:])
+		case 101:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField101(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -18543,9 +18170,79 @@ func (p *GetEvaluationSetItemFieldRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 7:
+		case 102:
 			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField7(buf[offset:])
+				l, err = p.FastReadField102(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 103:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField103(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 200:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField200(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 201:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField201(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 212:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField212(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 213:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField213(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -18589,28 +18286,18 @@ func (p *GetEvaluationSetItemFieldRequest) FastRead(buf []byte) (int, error) {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetItemPk {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetFieldName {
-		fieldId = 5
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemFieldRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListEvaluationSetItemsRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetEvaluationSetItemFieldRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListEvaluationSetItemsRequest[fieldId]))
 }
 
-func (p *GetEvaluationSetItemFieldRequest) FastReadField1(buf []byte) (int, error) {
+func (p *ListEvaluationSetItemsRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -18624,7 +18311,7 @@ func (p *GetEvaluationSetItemFieldRequest) FastReadField1(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemFieldRequest) FastReadField2(buf []byte) (int, error) {
+func (p *ListEvaluationSetItemsRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -18638,63 +18325,160 @@ func (p *GetEvaluationSetItemFieldRequest) FastReadField2(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemFieldRequest) FastReadField3(buf []byte) (int, error) {
+func (p *ListEvaluationSetItemsRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
+	var _field *int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.ItemPk = _field
+	p.VersionID = _field
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemFieldRequest) FastReadField5(buf []byte) (int, error) {
+func (p *ListEvaluationSetItemsRequest) FastReadField4(buf []byte) (int, error) {
 	offset := 0
-
-	var _field string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_field := common.NewSharedResourceOption()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
 	}
-	p.FieldName = _field
+	p.SharedOption = _field
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemFieldRequest) FastReadField6(buf []byte) (int, error) {
+func (p *ListEvaluationSetItemsRequest) FastReadField100(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.TurnID = _field
+	p.PageNumber = _field
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemFieldRequest) FastReadField7(buf []byte) (int, error) {
+func (p *ListEvaluationSetItemsRequest) FastReadField101(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.FieldKey = _field
+	p.PageSize = _field
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemFieldRequest) FastReadField255(buf []byte) (int, error) {
+func (p *ListEvaluationSetItemsRequest) FastReadField102(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageToken = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemsRequest) FastReadField103(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*common.OrderBy, 0, size)
+	values := make([]common.OrderBy, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.OrderBys = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemsRequest) FastReadField200(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ItemIDNotIn = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemsRequest) FastReadField201(buf []byte) (int, error) {
+	offset := 0
+	_field := filter.NewFilter()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Filter = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemsRequest) FastReadField212(buf []byte) (int, error) {
+	offset := 0
+	_field := eval_set.NewTagFilter()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.TagFilter = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemsRequest) FastReadField213(buf []byte) (int, error) {
+	offset := 0
+	_field := eval_set.NewEvaluationSetItemProvenanceFilter()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.ProvenanceFilter = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemsRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -18706,87 +18490,171 @@ func (p *GetEvaluationSetItemFieldRequest) FastReadField255(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemFieldRequest) FastWrite(buf []byte) int {
+func (p *ListEvaluationSetItemsRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *GetEvaluationSetItemFieldRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListEvaluationSetItemsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField100(buf[offset:], w)
+		offset += p.fastWriteField101(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField102(buf[offset:], w)
+		offset += p.fastWriteField103(buf[offset:], w)
+		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField201(buf[offset:], w)
+		offset += p.fastWriteField212(buf[offset:], w)
+		offset += p.fastWriteField213(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *GetEvaluationSetItemFieldRequest) BLength() int {
+func (p *ListEvaluationSetItemsRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field5Length()
-		l += p.field6Length()
-		l += p.field7Length()
+		l += p.field4Length()
+		l += p.field100Length()
+		l += p.field101Length()
+		l += p.field102Length()
+		l += p.field103Length()
+		l += p.field200Length()
+		l += p.field201Length()
+		l += p.field212Length()
+		l += p.field213Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *GetEvaluationSetItemFieldRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListEvaluationSetItemsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *GetEvaluationSetItemFieldRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListEvaluationSetItemsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
 	return offset
 }
 
-func (p *GetEvaluationSetItemFieldRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListEvaluationSetItemsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemPk)
+	if p.IsSetVersionID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.VersionID)
+	}
 	return offset
 }
 
-func (p *GetEvaluationSetItemFieldRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListEvaluationSetItemsRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.FieldName)
+	if p.IsSetSharedOption() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
+		offset += p.SharedOption.FastWriteNocopy(buf[offset:], w)
+	}
 	return offset
 }
 
-func (p *GetEvaluationSetItemFieldRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListEvaluationSetItemsRequest) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTurnID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 6)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.TurnID)
+	if p.IsSetPageNumber() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 100)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageNumber)
 	}
 	return offset
 }
 
-func (p *GetEvaluationSetItemFieldRequest) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListEvaluationSetItemsRequest) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFieldKey() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 7)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.FieldKey)
+	if p.IsSetPageSize() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 101)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
 	}
 	return offset
 }
 
-func (p *GetEvaluationSetItemFieldRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListEvaluationSetItemsRequest) fastWriteField102(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageToken() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 102)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PageToken)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemsRequest) fastWriteField103(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetOrderBys() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 103)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.OrderBys {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemsRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemIDNotIn() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 200)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ItemIDNotIn {
+			length++
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemsRequest) fastWriteField201(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFilter() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 201)
+		offset += p.Filter.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemsRequest) fastWriteField212(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTagFilter() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 212)
+		offset += p.TagFilter.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemsRequest) fastWriteField213(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetProvenanceFilter() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 213)
+		offset += p.ProvenanceFilter.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -18795,53 +18663,117 @@ func (p *GetEvaluationSetItemFieldRequest) fastWriteField255(buf []byte, w thrif
 	return offset
 }
 
-func (p *GetEvaluationSetItemFieldRequest) field1Length() int {
+func (p *ListEvaluationSetItemsRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *GetEvaluationSetItemFieldRequest) field2Length() int {
+func (p *ListEvaluationSetItemsRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *GetEvaluationSetItemFieldRequest) field3Length() int {
+func (p *ListEvaluationSetItemsRequest) field3Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetVersionID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
 	return l
 }
 
-func (p *GetEvaluationSetItemFieldRequest) field5Length() int {
+func (p *ListEvaluationSetItemsRequest) field4Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.FieldName)
+	if p.IsSetSharedOption() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.SharedOption.BLength()
+	}
 	return l
 }
 
-func (p *GetEvaluationSetItemFieldRequest) field6Length() int {
+func (p *ListEvaluationSetItemsRequest) field100Length() int {
 	l := 0
-	if p.IsSetTurnID() {
+	if p.IsSetPageNumber() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *GetEvaluationSetItemFieldRequest) field7Length() int {
+func (p *ListEvaluationSetItemsRequest) field101Length() int {
 	l := 0
-	if p.IsSetFieldKey() {
+	if p.IsSetPageSize() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.FieldKey)
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *GetEvaluationSetItemFieldRequest) field255Length() int {
+func (p *ListEvaluationSetItemsRequest) field102Length() int {
+	l := 0
+	if p.IsSetPageToken() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.PageToken)
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemsRequest) field103Length() int {
+	l := 0
+	if p.IsSetOrderBys() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.OrderBys {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemsRequest) field200Length() int {
+	l := 0
+	if p.IsSetItemIDNotIn() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		l +=
+			thrift.Binary.I64Length() * len(p.ItemIDNotIn)
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemsRequest) field201Length() int {
+	l := 0
+	if p.IsSetFilter() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Filter.BLength()
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemsRequest) field212Length() int {
+	l := 0
+	if p.IsSetTagFilter() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.TagFilter.BLength()
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemsRequest) field213Length() int {
+	l := 0
+	if p.IsSetProvenanceFilter() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.ProvenanceFilter.BLength()
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemsRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18850,8 +18782,8 @@ func (p *GetEvaluationSetItemFieldRequest) field255Length() int {
 	return l
 }
 
-func (p *GetEvaluationSetItemFieldRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*GetEvaluationSetItemFieldRequest)
+func (p *ListEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListEvaluationSetItemsRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
@@ -18860,24 +18792,88 @@ func (p *GetEvaluationSetItemFieldRequest) DeepCopy(s interface{}) error {
 
 	p.EvaluationSetID = src.EvaluationSetID
 
-	p.ItemPk = src.ItemPk
+	if src.VersionID != nil {
+		tmp := *src.VersionID
+		p.VersionID = &tmp
+	}
 
-	if src.FieldName != "" {
-		p.FieldName = kutils.StringDeepCopy(src.FieldName)
+	var _sharedOption *common.SharedResourceOption
+	if src.SharedOption != nil {
+		_sharedOption = &common.SharedResourceOption{}
+		if err := _sharedOption.DeepCopy(src.SharedOption); err != nil {
+			return err
+		}
+	}
+	p.SharedOption = _sharedOption
+
+	if src.PageNumber != nil {
+		tmp := *src.PageNumber
+		p.PageNumber = &tmp
 	}
 
-	if src.TurnID != nil {
-		tmp := *src.TurnID
-		p.TurnID = &tmp
+	if src.PageSize != nil {
+		tmp := *src.PageSize
+		p.PageSize = &tmp
 	}
 
-	if src.FieldKey != nil {
+	if src.PageToken != nil {
 		var tmp string
-		if *src.FieldKey != "" {
-			tmp = kutils.StringDeepCopy(*src.FieldKey)
+		if *src.PageToken != "" {
+			tmp = kutils.StringDeepCopy(*src.PageToken)
+		}
+		p.PageToken = &tmp
+	}
+
+	if src.OrderBys != nil {
+		p.OrderBys = make([]*common.OrderBy, 0, len(src.OrderBys))
+		for _, elem := range src.OrderBys {
+			var _elem *common.OrderBy
+			if elem != nil {
+				_elem = &common.OrderBy{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.OrderBys = append(p.OrderBys, _elem)
+		}
+	}
+
+	if src.ItemIDNotIn != nil {
+		p.ItemIDNotIn = make([]int64, 0, len(src.ItemIDNotIn))
+		for _, elem := range src.ItemIDNotIn {
+			var _elem int64
+			_elem = elem
+			p.ItemIDNotIn = append(p.ItemIDNotIn, _elem)
+		}
+	}
+
+	var _filter *filter.Filter
+	if src.Filter != nil {
+		_filter = &filter.Filter{}
+		if err := _filter.DeepCopy(src.Filter); err != nil {
+			return err
+		}
+	}
+	p.Filter = _filter
+
+	var _tagFilter *eval_set.TagFilter
+	if src.TagFilter != nil {
+		_tagFilter = &eval_set.TagFilter{}
+		if err := _tagFilter.DeepCopy(src.TagFilter); err != nil {
+			return err
+		}
+	}
+	p.TagFilter = _tagFilter
+
+	var _provenanceFilter *eval_set.EvaluationSetItemProvenanceFilter
+	if src.ProvenanceFilter != nil {
+		_provenanceFilter = &eval_set.EvaluationSetItemProvenanceFilter{}
+		if err := _provenanceFilter.DeepCopy(src.ProvenanceFilter); err != nil {
+			return err
 		}
-		p.FieldKey = &tmp
 	}
+	p.ProvenanceFilter = _provenanceFilter
 
 	var _base *base.Base
 	if src.Base != nil {
@@ -18891,7 +18887,7 @@ func (p *GetEvaluationSetItemFieldRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *GetEvaluationSetItemFieldResponse) FastRead(buf []byte) (int, error) {
+func (p *ListEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18909,7 +18905,7 @@ func (p *GetEvaluationSetItemFieldResponse) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -18922,6 +18918,48 @@ func (p *GetEvaluationSetItemFieldResponse) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 100:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField100(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 101:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField101(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 102:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField102(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -18949,109 +18987,255 @@ func (p *GetEvaluationSetItemFieldResponse) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemFieldResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListEvaluationSetItemsResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *GetEvaluationSetItemFieldResponse) FastReadField1(buf []byte) (int, error) {
+func (p *ListEvaluationSetItemsResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := eval_set.NewFieldData()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
 	}
-	p.FieldData = _field
+	_field := make([]*eval_set.EvaluationSetItem, 0, size)
+	values := make([]eval_set.EvaluationSetItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Items = _field
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemFieldResponse) FastReadField255(buf []byte) (int, error) {
+func (p *ListEvaluationSetItemsResponse) FastReadField100(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBaseResp()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.BaseResp = _field
+	p.Total = _field
 	return offset, nil
 }
 
-func (p *GetEvaluationSetItemFieldResponse) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
-
-func (p *GetEvaluationSetItemFieldResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListEvaluationSetItemsResponse) FastReadField101(buf []byte) (int, error) {
 	offset := 0
-	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField255(buf[offset:], w)
-	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
-}
 
-func (p *GetEvaluationSetItemFieldResponse) BLength() int {
-	l := 0
-	if p != nil {
-		l += p.field1Length()
-		l += p.field255Length()
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	l += thrift.Binary.FieldStopLength()
-	return l
+	p.NextPageToken = _field
+	return offset, nil
 }
 
-func (p *GetEvaluationSetItemFieldResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListEvaluationSetItemsResponse) FastReadField102(buf []byte) (int, error) {
 	offset := 0
-	if p.IsSetFieldData() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-		offset += p.FieldData.FastWriteNocopy(buf[offset:], w)
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.FilterTotal = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemsResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemsResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListEvaluationSetItemsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField100(buf[offset:], w)
+		offset += p.fastWriteField102(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField101(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
 	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *GetEvaluationSetItemFieldResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ListEvaluationSetItemsResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field100Length()
+		l += p.field101Length()
+		l += p.field102Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListEvaluationSetItemsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetBaseResp() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetItems() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Items {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *GetEvaluationSetItemFieldResponse) field1Length() int {
+func (p *ListEvaluationSetItemsResponse) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTotal() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 100)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.Total)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemsResponse) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetNextPageToken() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 101)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.NextPageToken)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemsResponse) fastWriteField102(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFilterTotal() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 102)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.FilterTotal)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ListEvaluationSetItemsResponse) field1Length() int {
 	l := 0
-	if p.IsSetFieldData() {
+	if p.IsSetItems() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.FieldData.BLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Items {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *GetEvaluationSetItemFieldResponse) field255Length() int {
+func (p *ListEvaluationSetItemsResponse) field100Length() int {
 	l := 0
-	if p.IsSetBaseResp() {
+	if p.IsSetTotal() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.BaseResp.BLength()
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *GetEvaluationSetItemFieldResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*GetEvaluationSetItemFieldResponse)
+func (p *ListEvaluationSetItemsResponse) field101Length() int {
+	l := 0
+	if p.IsSetNextPageToken() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.NextPageToken)
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemsResponse) field102Length() int {
+	l := 0
+	if p.IsSetFilterTotal() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemsResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *ListEvaluationSetItemsResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListEvaluationSetItemsResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _fieldData *eval_set.FieldData
-	if src.FieldData != nil {
-		_fieldData = &eval_set.FieldData{}
-		if err := _fieldData.DeepCopy(src.FieldData); err != nil {
-			return err
+	if src.Items != nil {
+		p.Items = make([]*eval_set.EvaluationSetItem, 0, len(src.Items))
+		for _, elem := range src.Items {
+			var _elem *eval_set.EvaluationSetItem
+			if elem != nil {
+				_elem = &eval_set.EvaluationSetItem{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Items = append(p.Items, _elem)
 		}
 	}
-	p.FieldData = _fieldData
+
+	if src.Total != nil {
+		tmp := *src.Total
+		p.Total = &tmp
+	}
+
+	if src.NextPageToken != nil {
+		var tmp string
+		if *src.NextPageToken != "" {
+			tmp = kutils.StringDeepCopy(*src.NextPageToken)
+		}
+		p.NextPageToken = &tmp
+	}
+
+	if src.FilterTotal != nil {
+		tmp := *src.FilterTotal
+		p.FilterTotal = &tmp
+	}
 
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
@@ -19065,13 +19249,16 @@ func (p *GetEvaluationSetItemFieldResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *UploadAttachmentDetail) FastRead(buf []byte) (int, error) {
+func (p *GetEvaluationSetItemRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluationSetID bool = false
+	var issetItemID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -19083,12 +19270,13 @@ func (p *UploadAttachmentDetail) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetWorkspaceID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -19097,12 +19285,13 @@ func (p *UploadAttachmentDetail) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetEvaluationSetID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -19110,41 +19299,14 @@ func (p *UploadAttachmentDetail) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 20:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField20(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 21:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField21(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 22:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField22(buf[offset:])
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetItemID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -19152,9 +19314,9 @@ func (p *UploadAttachmentDetail) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 23:
+		case 255:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField23(buf[offset:])
+				l, err = p.FastReadField255(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -19166,529 +19328,213 @@ func (p *UploadAttachmentDetail) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 24:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField24(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 25:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField25(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 101:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField101(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 102:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField102(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
 			}
 		}
 	}
 
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluationSetID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetItemID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadAttachmentDetail[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetEvaluationSetItemRequest[fieldId]))
 }
 
-func (p *UploadAttachmentDetail) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *dataset.ContentType
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-
-		tmp := dataset.ContentType(v)
-		_field = &tmp
-	}
-	p.ContentType = _field
-	return offset, nil
-}
-
-func (p *UploadAttachmentDetail) FastReadField2(buf []byte) (int, error) {
+func (p *GetEvaluationSetItemRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.ImagexServiceID = _field
-	return offset, nil
-}
-
-func (p *UploadAttachmentDetail) FastReadField20(buf []byte) (int, error) {
-	offset := 0
-	_field := common.NewImage()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = v
 	}
-	p.OriginImage = _field
+	p.WorkspaceID = _field
 	return offset, nil
 }
 
-func (p *UploadAttachmentDetail) FastReadField21(buf []byte) (int, error) {
+func (p *GetEvaluationSetItemRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
-	_field := common.NewImage()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.Image = _field
-	return offset, nil
-}
 
-func (p *UploadAttachmentDetail) FastReadField22(buf []byte) (int, error) {
-	offset := 0
-	_field := common.NewAudio()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = v
 	}
-	p.OriginAudio = _field
+	p.EvaluationSetID = _field
 	return offset, nil
 }
 
-func (p *UploadAttachmentDetail) FastReadField23(buf []byte) (int, error) {
+func (p *GetEvaluationSetItemRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
-	_field := common.NewAudio()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.Audio = _field
-	return offset, nil
-}
 
-func (p *UploadAttachmentDetail) FastReadField24(buf []byte) (int, error) {
-	offset := 0
-	_field := common.NewVideo()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = v
 	}
-	p.OriginVideo = _field
+	p.ItemID = _field
 	return offset, nil
 }
 
-func (p *UploadAttachmentDetail) FastReadField25(buf []byte) (int, error) {
+func (p *GetEvaluationSetItemRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
-	_field := common.NewVideo()
+	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Video = _field
-	return offset, nil
-}
-
-func (p *UploadAttachmentDetail) FastReadField101(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *dataset.ItemErrorType
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-
-		tmp := dataset.ItemErrorType(v)
-		_field = &tmp
-	}
-	p.ErrorType = _field
-	return offset, nil
-}
-
-func (p *UploadAttachmentDetail) FastReadField102(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.ErrMsg = _field
+	p.Base = _field
 	return offset, nil
 }
 
-func (p *UploadAttachmentDetail) FastWrite(buf []byte) int {
+func (p *GetEvaluationSetItemRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UploadAttachmentDetail) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetItemRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField20(buf[offset:], w)
-		offset += p.fastWriteField21(buf[offset:], w)
-		offset += p.fastWriteField22(buf[offset:], w)
-		offset += p.fastWriteField23(buf[offset:], w)
-		offset += p.fastWriteField24(buf[offset:], w)
-		offset += p.fastWriteField25(buf[offset:], w)
-		offset += p.fastWriteField101(buf[offset:], w)
-		offset += p.fastWriteField102(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *UploadAttachmentDetail) BLength() int {
+func (p *GetEvaluationSetItemRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
-		l += p.field20Length()
-		l += p.field21Length()
-		l += p.field22Length()
-		l += p.field23Length()
-		l += p.field24Length()
-		l += p.field25Length()
-		l += p.field101Length()
-		l += p.field102Length()
+		l += p.field3Length()
+		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *UploadAttachmentDetail) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetContentType() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 1)
-		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.ContentType))
-	}
-	return offset
-}
-
-func (p *UploadAttachmentDetail) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetItemRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetImagexServiceID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ImagexServiceID)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *UploadAttachmentDetail) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetItemRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetOriginImage() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 20)
-		offset += p.OriginImage.FastWriteNocopy(buf[offset:], w)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
 	return offset
 }
 
-func (p *UploadAttachmentDetail) fastWriteField21(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetItemRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetImage() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 21)
-		offset += p.Image.FastWriteNocopy(buf[offset:], w)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
 	return offset
 }
 
-func (p *UploadAttachmentDetail) fastWriteField22(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetEvaluationSetItemRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetOriginAudio() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 22)
-		offset += p.OriginAudio.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *UploadAttachmentDetail) fastWriteField23(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetAudio() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 23)
-		offset += p.Audio.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
+func (p *GetEvaluationSetItemRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
 }
 
-func (p *UploadAttachmentDetail) fastWriteField24(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetOriginVideo() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 24)
-		offset += p.OriginVideo.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
-}
-
-func (p *UploadAttachmentDetail) fastWriteField25(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetVideo() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 25)
-		offset += p.Video.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
-}
-
-func (p *UploadAttachmentDetail) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetErrorType() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 101)
-		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.ErrorType))
-	}
-	return offset
-}
-
-func (p *UploadAttachmentDetail) fastWriteField102(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetErrMsg() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 102)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ErrMsg)
-	}
-	return offset
-}
-
-func (p *UploadAttachmentDetail) field1Length() int {
-	l := 0
-	if p.IsSetContentType() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
-	}
-	return l
-}
-
-func (p *UploadAttachmentDetail) field2Length() int {
-	l := 0
-	if p.IsSetImagexServiceID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.ImagexServiceID)
-	}
-	return l
-}
-
-func (p *UploadAttachmentDetail) field20Length() int {
-	l := 0
-	if p.IsSetOriginImage() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.OriginImage.BLength()
-	}
-	return l
-}
-
-func (p *UploadAttachmentDetail) field21Length() int {
-	l := 0
-	if p.IsSetImage() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Image.BLength()
-	}
-	return l
-}
-
-func (p *UploadAttachmentDetail) field22Length() int {
-	l := 0
-	if p.IsSetOriginAudio() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.OriginAudio.BLength()
-	}
-	return l
-}
-
-func (p *UploadAttachmentDetail) field23Length() int {
-	l := 0
-	if p.IsSetAudio() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Audio.BLength()
-	}
-	return l
-}
-
-func (p *UploadAttachmentDetail) field24Length() int {
-	l := 0
-	if p.IsSetOriginVideo() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.OriginVideo.BLength()
-	}
-	return l
-}
-
-func (p *UploadAttachmentDetail) field25Length() int {
+func (p *GetEvaluationSetItemRequest) field2Length() int {
 	l := 0
-	if p.IsSetVideo() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Video.BLength()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *UploadAttachmentDetail) field101Length() int {
+func (p *GetEvaluationSetItemRequest) field3Length() int {
 	l := 0
-	if p.IsSetErrorType() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *UploadAttachmentDetail) field102Length() int {
+func (p *GetEvaluationSetItemRequest) field255Length() int {
 	l := 0
-	if p.IsSetErrMsg() {
+	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.ErrMsg)
+		l += p.Base.BLength()
 	}
 	return l
 }
 
-func (p *UploadAttachmentDetail) DeepCopy(s interface{}) error {
-	src, ok := s.(*UploadAttachmentDetail)
+func (p *GetEvaluationSetItemRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetEvaluationSetItemRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.ContentType != nil {
-		tmp := *src.ContentType
-		p.ContentType = &tmp
-	}
-
-	if src.ImagexServiceID != nil {
-		var tmp string
-		if *src.ImagexServiceID != "" {
-			tmp = kutils.StringDeepCopy(*src.ImagexServiceID)
-		}
-		p.ImagexServiceID = &tmp
-	}
-
-	var _originImage *common.Image
-	if src.OriginImage != nil {
-		_originImage = &common.Image{}
-		if err := _originImage.DeepCopy(src.OriginImage); err != nil {
-			return err
-		}
-	}
-	p.OriginImage = _originImage
-
-	var _image *common.Image
-	if src.Image != nil {
-		_image = &common.Image{}
-		if err := _image.DeepCopy(src.Image); err != nil {
-			return err
-		}
-	}
-	p.Image = _image
-
-	var _originAudio *common.Audio
-	if src.OriginAudio != nil {
-		_originAudio = &common.Audio{}
-		if err := _originAudio.DeepCopy(src.OriginAudio); err != nil {
-			return err
-		}
-	}
-	p.OriginAudio = _originAudio
+	p.WorkspaceID = src.WorkspaceID
 
-	var _audio *common.Audio
-	if src.Audio != nil {
-		_audio = &common.Audio{}
-		if err := _audio.DeepCopy(src.Audio); err != nil {
-			return err
-		}
-	}
-	p.Audio = _audio
+	p.EvaluationSetID = src.EvaluationSetID
 
-	var _originVideo *common.Video
-	if src.OriginVideo != nil {
-		_originVideo = &common.Video{}
-		if err := _originVideo.DeepCopy(src.OriginVideo); err != nil {
-			return err
-		}
-	}
-	p.OriginVideo = _originVideo
+	p.ItemID = src.ItemID
 
-	var _video *common.Video
-	if src.Video != nil {
-		_video = &common.Video{}
-		if err := _video.DeepCopy(src.Video); err != nil {
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
 			return err
 		}
 	}
-	p.Video = _video
-
-	if src.ErrorType != nil {
-		tmp := *src.ErrorType
-		p.ErrorType = &tmp
-	}
-
-	if src.ErrMsg != nil {
-		var tmp string
-		if *src.ErrMsg != "" {
-			tmp = kutils.StringDeepCopy(*src.ErrMsg)
-		}
-		p.ErrMsg = &tmp
-	}
+	p.Base = _base
 
 	return nil
 }
 
-func (p *ValidateEvaluationSetMultiPartDataRequest) FastRead(buf []byte) (int, error) {
+func (p *GetEvaluationSetItemResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetSpaceID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -19700,37 +19546,8 @@ func (p *ValidateEvaluationSetMultiPartDataRequest) FastRead(buf []byte) (int, e
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetSpaceID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 3:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField3(buf[offset:])
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -19765,231 +19582,8970 @@ func (p *ValidateEvaluationSetMultiPartDataRequest) FastRead(buf []byte) (int, e
 		}
 	}
 
-	if !issetSpaceID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ValidateEvaluationSetMultiPartDataRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ValidateEvaluationSetMultiPartDataRequest[fieldId]))
 }
 
-func (p *ValidateEvaluationSetMultiPartDataRequest) FastReadField1(buf []byte) (int, error) {
+func (p *GetEvaluationSetItemResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_field := eval_set.NewEvaluationSetItem()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+	}
+	p.Item = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetEvaluationSetItemResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetEvaluationSetItemResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetEvaluationSetItemResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItem() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Item.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetEvaluationSetItemResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *GetEvaluationSetItemResponse) field1Length() int {
+	l := 0
+	if p.IsSetItem() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Item.BLength()
+	}
+	return l
+}
+
+func (p *GetEvaluationSetItemResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *GetEvaluationSetItemResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetEvaluationSetItemResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _item *eval_set.EvaluationSetItem
+	if src.Item != nil {
+		_item = &eval_set.EvaluationSetItem{}
+		if err := _item.DeepCopy(src.Item); err != nil {
+			return err
+		}
+	}
+	p.Item = _item
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluationSetID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluationSetID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 20:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField20(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 201:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField201(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 212:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField212(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluationSetID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetEvaluationSetItemsRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_BatchGetEvaluationSetItemsRequest[fieldId]))
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EvaluationSetID = _field
+	return offset, nil
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.VersionID = _field
+	return offset, nil
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ItemIds = _field
+	return offset, nil
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) FastReadField20(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*EvaluationItemVersionRef, 0, size)
+	values := make([]EvaluationItemVersionRef, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ItemVersionQueries = _field
+	return offset, nil
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) FastReadField201(buf []byte) (int, error) {
+	offset := 0
+	_field := filter.NewFilter()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Filter = _field
+	return offset, nil
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) FastReadField212(buf []byte) (int, error) {
+	offset := 0
+	_field := eval_set.NewTagFilter()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.TagFilter = _field
+	return offset, nil
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField20(buf[offset:], w)
+		offset += p.fastWriteField201(buf[offset:], w)
+		offset += p.fastWriteField212(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field20Length()
+		l += p.field201Length()
+		l += p.field212Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
+	return offset
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetVersionID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.VersionID)
+	}
+	return offset
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemIds() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ItemIds {
+			length++
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+	}
+	return offset
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemVersionQueries() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 20)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ItemVersionQueries {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) fastWriteField201(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFilter() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 201)
+		offset += p.Filter.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) fastWriteField212(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTagFilter() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 212)
+		offset += p.TagFilter.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) field3Length() int {
+	l := 0
+	if p.IsSetVersionID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) field4Length() int {
+	l := 0
+	if p.IsSetItemIds() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		l +=
+			thrift.Binary.I64Length() * len(p.ItemIds)
+	}
+	return l
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) field20Length() int {
+	l := 0
+	if p.IsSetItemVersionQueries() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ItemVersionQueries {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) field201Length() int {
+	l := 0
+	if p.IsSetFilter() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Filter.BLength()
+	}
+	return l
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) field212Length() int {
+	l := 0
+	if p.IsSetTagFilter() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.TagFilter.BLength()
+	}
+	return l
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *BatchGetEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*BatchGetEvaluationSetItemsRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.EvaluationSetID = src.EvaluationSetID
+
+	if src.VersionID != nil {
+		tmp := *src.VersionID
+		p.VersionID = &tmp
+	}
+
+	if src.ItemIds != nil {
+		p.ItemIds = make([]int64, 0, len(src.ItemIds))
+		for _, elem := range src.ItemIds {
+			var _elem int64
+			_elem = elem
+			p.ItemIds = append(p.ItemIds, _elem)
+		}
+	}
+
+	if src.ItemVersionQueries != nil {
+		p.ItemVersionQueries = make([]*EvaluationItemVersionRef, 0, len(src.ItemVersionQueries))
+		for _, elem := range src.ItemVersionQueries {
+			var _elem *EvaluationItemVersionRef
+			if elem != nil {
+				_elem = &EvaluationItemVersionRef{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ItemVersionQueries = append(p.ItemVersionQueries, _elem)
+		}
+	}
+
+	var _filter *filter.Filter
+	if src.Filter != nil {
+		_filter = &filter.Filter{}
+		if err := _filter.DeepCopy(src.Filter); err != nil {
+			return err
+		}
+	}
+	p.Filter = _filter
+
+	var _tagFilter *eval_set.TagFilter
+	if src.TagFilter != nil {
+		_tagFilter = &eval_set.TagFilter{}
+		if err := _tagFilter.DeepCopy(src.TagFilter); err != nil {
+			return err
+		}
+	}
+	p.TagFilter = _tagFilter
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *BatchGetEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetEvaluationSetItemsResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *BatchGetEvaluationSetItemsResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*eval_set.EvaluationSetItem, 0, size)
+	values := make([]eval_set.EvaluationSetItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Items = _field
+	return offset, nil
+}
+
+func (p *BatchGetEvaluationSetItemsResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *BatchGetEvaluationSetItemsResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *BatchGetEvaluationSetItemsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *BatchGetEvaluationSetItemsResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *BatchGetEvaluationSetItemsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItems() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Items {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *BatchGetEvaluationSetItemsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *BatchGetEvaluationSetItemsResponse) field1Length() int {
+	l := 0
+	if p.IsSetItems() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Items {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *BatchGetEvaluationSetItemsResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *BatchGetEvaluationSetItemsResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*BatchGetEvaluationSetItemsResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Items != nil {
+		p.Items = make([]*eval_set.EvaluationSetItem, 0, len(src.Items))
+		for _, elem := range src.Items {
+			var _elem *eval_set.EvaluationSetItem
+			if elem != nil {
+				_elem = &eval_set.EvaluationSetItem{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Items = append(p.Items, _elem)
+		}
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluationSetID bool = false
+	var issetItems bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluationSetID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetItems = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField10(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluationSetID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetItems {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchAddExistEvaluationSetItemsRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_BatchAddExistEvaluationSetItemsRequest[fieldId]))
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EvaluationSetID = _field
+	return offset, nil
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*EvaluationItemVersionRef, 0, size)
+	values := make([]EvaluationItemVersionRef, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Items = _field
+	return offset, nil
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) FastReadField10(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.AllowPartialAdd = _field
+	return offset, nil
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field10Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
+	return offset
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.Items {
+		length++
+		offset += v.FastWriteNocopy(buf[offset:], w)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	return offset
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetAllowPartialAdd() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 10)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.AllowPartialAdd)
+	}
+	return offset
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.Items {
+		_ = v
+		l += v.BLength()
+	}
+	return l
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) field10Length() int {
+	l := 0
+	if p.IsSetAllowPartialAdd() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *BatchAddExistEvaluationSetItemsRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*BatchAddExistEvaluationSetItemsRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.EvaluationSetID = src.EvaluationSetID
+
+	if src.Items != nil {
+		p.Items = make([]*EvaluationItemVersionRef, 0, len(src.Items))
+		for _, elem := range src.Items {
+			var _elem *EvaluationItemVersionRef
+			if elem != nil {
+				_elem = &EvaluationItemVersionRef{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Items = append(p.Items, _elem)
+		}
+	}
+
+	if src.AllowPartialAdd != nil {
+		tmp := *src.AllowPartialAdd
+		p.AllowPartialAdd = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *EvaluationItemVersionRef) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetItemID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetItemID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetItemID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationItemVersionRef[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_EvaluationItemVersionRef[fieldId]))
+}
+
+func (p *EvaluationItemVersionRef) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ItemID = _field
+	return offset, nil
+}
+
+func (p *EvaluationItemVersionRef) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ItemVersionID = _field
+	return offset, nil
+}
+
+func (p *EvaluationItemVersionRef) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ItemVersion = _field
+	return offset, nil
+}
+
+func (p *EvaluationItemVersionRef) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluationItemVersionRef) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluationItemVersionRef) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluationItemVersionRef) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
+	return offset
+}
+
+func (p *EvaluationItemVersionRef) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemVersionID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ItemVersionID)
+	}
+	return offset
+}
+
+func (p *EvaluationItemVersionRef) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ItemVersion)
+	}
+	return offset
+}
+
+func (p *EvaluationItemVersionRef) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *EvaluationItemVersionRef) field2Length() int {
+	l := 0
+	if p.IsSetItemVersionID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvaluationItemVersionRef) field3Length() int {
+	l := 0
+	if p.IsSetItemVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ItemVersion)
+	}
+	return l
+}
+
+func (p *EvaluationItemVersionRef) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationItemVersionRef)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.ItemID = src.ItemID
+
+	if src.ItemVersionID != nil {
+		tmp := *src.ItemVersionID
+		p.ItemVersionID = &tmp
+	}
+
+	if src.ItemVersion != nil {
+		var tmp string
+		if *src.ItemVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.ItemVersion)
+		}
+		p.ItemVersion = &tmp
+	}
+
+	return nil
+}
+
+func (p *BatchAddExistEvaluationSetItemsResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchAddExistEvaluationSetItemsResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *BatchAddExistEvaluationSetItemsResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.SuccessCount = _field
+	return offset, nil
+}
+
+func (p *BatchAddExistEvaluationSetItemsResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.FailedCount = _field
+	return offset, nil
+}
+
+func (p *BatchAddExistEvaluationSetItemsResponse) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*EvaluationItemVersionRef, 0, size)
+	values := make([]EvaluationItemVersionRef, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.FailedItems = _field
+	return offset, nil
+}
+
+func (p *BatchAddExistEvaluationSetItemsResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *BatchAddExistEvaluationSetItemsResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *BatchAddExistEvaluationSetItemsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *BatchAddExistEvaluationSetItemsResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *BatchAddExistEvaluationSetItemsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccessCount() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 1)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.SuccessCount)
+	}
+	return offset
+}
+
+func (p *BatchAddExistEvaluationSetItemsResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFailedCount() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.FailedCount)
+	}
+	return offset
+}
+
+func (p *BatchAddExistEvaluationSetItemsResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFailedItems() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.FailedItems {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *BatchAddExistEvaluationSetItemsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *BatchAddExistEvaluationSetItemsResponse) field1Length() int {
+	l := 0
+	if p.IsSetSuccessCount() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *BatchAddExistEvaluationSetItemsResponse) field2Length() int {
+	l := 0
+	if p.IsSetFailedCount() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *BatchAddExistEvaluationSetItemsResponse) field3Length() int {
+	l := 0
+	if p.IsSetFailedItems() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.FailedItems {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *BatchAddExistEvaluationSetItemsResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *BatchAddExistEvaluationSetItemsResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*BatchAddExistEvaluationSetItemsResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.SuccessCount != nil {
+		tmp := *src.SuccessCount
+		p.SuccessCount = &tmp
+	}
+
+	if src.FailedCount != nil {
+		tmp := *src.FailedCount
+		p.FailedCount = &tmp
+	}
+
+	if src.FailedItems != nil {
+		p.FailedItems = make([]*EvaluationItemVersionRef, 0, len(src.FailedItems))
+		for _, elem := range src.FailedItems {
+			var _elem *EvaluationItemVersionRef
+			if elem != nil {
+				_elem = &EvaluationItemVersionRef{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.FailedItems = append(p.FailedItems, _elem)
+		}
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *GetEvaluationSetItemDefRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluationSetID bool = false
+	var issetItemID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluationSetID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetItemID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluationSetID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetItemID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemDefRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetEvaluationSetItemDefRequest[fieldId]))
+}
+
+func (p *GetEvaluationSetItemDefRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemDefRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EvaluationSetID = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemDefRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ItemID = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemDefRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemDefRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetEvaluationSetItemDefRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetEvaluationSetItemDefRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetEvaluationSetItemDefRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *GetEvaluationSetItemDefRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
+	return offset
+}
+
+func (p *GetEvaluationSetItemDefRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
+	return offset
+}
+
+func (p *GetEvaluationSetItemDefRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetEvaluationSetItemDefRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetEvaluationSetItemDefRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetEvaluationSetItemDefRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetEvaluationSetItemDefRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *GetEvaluationSetItemDefRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetEvaluationSetItemDefRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.EvaluationSetID = src.EvaluationSetID
+
+	p.ItemID = src.ItemID
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *GetEvaluationSetItemDefResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemDefResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *GetEvaluationSetItemDefResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := eval_set.NewEvaluationItemDef()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.ItemDef = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemDefResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemDefResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetEvaluationSetItemDefResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetEvaluationSetItemDefResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetEvaluationSetItemDefResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemDef() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.ItemDef.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetEvaluationSetItemDefResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *GetEvaluationSetItemDefResponse) field1Length() int {
+	l := 0
+	if p.IsSetItemDef() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.ItemDef.BLength()
+	}
+	return l
+}
+
+func (p *GetEvaluationSetItemDefResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *GetEvaluationSetItemDefResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetEvaluationSetItemDefResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _itemDef *eval_set.EvaluationItemDef
+	if src.ItemDef != nil {
+		_itemDef = &eval_set.EvaluationItemDef{}
+		if err := _itemDef.DeepCopy(src.ItemDef); err != nil {
+			return err
+		}
+	}
+	p.ItemDef = _itemDef
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *ListEvaluationSetItemDefsRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluationSetID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluationSetID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 100:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField100(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 101:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField101(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 102:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField102(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 103:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField103(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluationSetID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListEvaluationSetItemDefsRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListEvaluationSetItemDefsRequest[fieldId]))
+}
+
+func (p *ListEvaluationSetItemDefsRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemDefsRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EvaluationSetID = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemDefsRequest) FastReadField100(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageNumber = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemDefsRequest) FastReadField101(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageSize = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemDefsRequest) FastReadField102(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageToken = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemDefsRequest) FastReadField103(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*common.OrderBy, 0, size)
+	values := make([]common.OrderBy, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.OrderBys = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemDefsRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemDefsRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListEvaluationSetItemDefsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField100(buf[offset:], w)
+		offset += p.fastWriteField101(buf[offset:], w)
+		offset += p.fastWriteField102(buf[offset:], w)
+		offset += p.fastWriteField103(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListEvaluationSetItemDefsRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field100Length()
+		l += p.field101Length()
+		l += p.field102Length()
+		l += p.field103Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListEvaluationSetItemDefsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *ListEvaluationSetItemDefsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
+	return offset
+}
+
+func (p *ListEvaluationSetItemDefsRequest) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageNumber() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 100)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageNumber)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemDefsRequest) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageSize() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 101)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemDefsRequest) fastWriteField102(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageToken() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 102)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PageToken)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemDefsRequest) fastWriteField103(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetOrderBys() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 103)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.OrderBys {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemDefsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemDefsRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ListEvaluationSetItemDefsRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ListEvaluationSetItemDefsRequest) field100Length() int {
+	l := 0
+	if p.IsSetPageNumber() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemDefsRequest) field101Length() int {
+	l := 0
+	if p.IsSetPageSize() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemDefsRequest) field102Length() int {
+	l := 0
+	if p.IsSetPageToken() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.PageToken)
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemDefsRequest) field103Length() int {
+	l := 0
+	if p.IsSetOrderBys() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.OrderBys {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemDefsRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemDefsRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListEvaluationSetItemDefsRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.EvaluationSetID = src.EvaluationSetID
+
+	if src.PageNumber != nil {
+		tmp := *src.PageNumber
+		p.PageNumber = &tmp
+	}
+
+	if src.PageSize != nil {
+		tmp := *src.PageSize
+		p.PageSize = &tmp
+	}
+
+	if src.PageToken != nil {
+		var tmp string
+		if *src.PageToken != "" {
+			tmp = kutils.StringDeepCopy(*src.PageToken)
+		}
+		p.PageToken = &tmp
+	}
+
+	if src.OrderBys != nil {
+		p.OrderBys = make([]*common.OrderBy, 0, len(src.OrderBys))
+		for _, elem := range src.OrderBys {
+			var _elem *common.OrderBy
+			if elem != nil {
+				_elem = &common.OrderBy{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.OrderBys = append(p.OrderBys, _elem)
+		}
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *ListEvaluationSetItemDefsResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 100:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField100(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 101:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField101(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListEvaluationSetItemDefsResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ListEvaluationSetItemDefsResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*eval_set.EvaluationItemDef, 0, size)
+	values := make([]eval_set.EvaluationItemDef, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ItemDefs = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemDefsResponse) FastReadField100(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Total = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemDefsResponse) FastReadField101(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.NextPageToken = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemDefsResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemDefsResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListEvaluationSetItemDefsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField100(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField101(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListEvaluationSetItemDefsResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field100Length()
+		l += p.field101Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListEvaluationSetItemDefsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemDefs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ItemDefs {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemDefsResponse) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTotal() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 100)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.Total)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemDefsResponse) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetNextPageToken() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 101)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.NextPageToken)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemDefsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ListEvaluationSetItemDefsResponse) field1Length() int {
+	l := 0
+	if p.IsSetItemDefs() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ItemDefs {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemDefsResponse) field100Length() int {
+	l := 0
+	if p.IsSetTotal() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemDefsResponse) field101Length() int {
+	l := 0
+	if p.IsSetNextPageToken() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.NextPageToken)
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemDefsResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *ListEvaluationSetItemDefsResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListEvaluationSetItemDefsResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.ItemDefs != nil {
+		p.ItemDefs = make([]*eval_set.EvaluationItemDef, 0, len(src.ItemDefs))
+		for _, elem := range src.ItemDefs {
+			var _elem *eval_set.EvaluationItemDef
+			if elem != nil {
+				_elem = &eval_set.EvaluationItemDef{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ItemDefs = append(p.ItemDefs, _elem)
+		}
+	}
+
+	if src.Total != nil {
+		tmp := *src.Total
+		p.Total = &tmp
+	}
+
+	if src.NextPageToken != nil {
+		var tmp string
+		if *src.NextPageToken != "" {
+			tmp = kutils.StringDeepCopy(*src.NextPageToken)
+		}
+		p.NextPageToken = &tmp
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluationSetID bool = false
+	var issetItemID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluationSetID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetItemID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 100:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField100(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 101:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField101(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 102:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField102(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 103:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField103(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluationSetID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetItemID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListEvaluationSetItemVersionsRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListEvaluationSetItemVersionsRequest[fieldId]))
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EvaluationSetID = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ItemID = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) FastReadField100(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageNumber = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) FastReadField101(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageSize = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) FastReadField102(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageToken = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) FastReadField103(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*common.OrderBy, 0, size)
+	values := make([]common.OrderBy, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.OrderBys = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField100(buf[offset:], w)
+		offset += p.fastWriteField101(buf[offset:], w)
+		offset += p.fastWriteField102(buf[offset:], w)
+		offset += p.fastWriteField103(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field100Length()
+		l += p.field101Length()
+		l += p.field102Length()
+		l += p.field103Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
+	return offset
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
+	return offset
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageNumber() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 100)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageNumber)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageSize() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 101)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) fastWriteField102(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageToken() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 102)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PageToken)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) fastWriteField103(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetOrderBys() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 103)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.OrderBys {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) field100Length() int {
+	l := 0
+	if p.IsSetPageNumber() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) field101Length() int {
+	l := 0
+	if p.IsSetPageSize() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) field102Length() int {
+	l := 0
+	if p.IsSetPageToken() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.PageToken)
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) field103Length() int {
+	l := 0
+	if p.IsSetOrderBys() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.OrderBys {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemVersionsRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListEvaluationSetItemVersionsRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.EvaluationSetID = src.EvaluationSetID
+
+	p.ItemID = src.ItemID
+
+	if src.PageNumber != nil {
+		tmp := *src.PageNumber
+		p.PageNumber = &tmp
+	}
+
+	if src.PageSize != nil {
+		tmp := *src.PageSize
+		p.PageSize = &tmp
+	}
+
+	if src.PageToken != nil {
+		var tmp string
+		if *src.PageToken != "" {
+			tmp = kutils.StringDeepCopy(*src.PageToken)
+		}
+		p.PageToken = &tmp
+	}
+
+	if src.OrderBys != nil {
+		p.OrderBys = make([]*common.OrderBy, 0, len(src.OrderBys))
+		for _, elem := range src.OrderBys {
+			var _elem *common.OrderBy
+			if elem != nil {
+				_elem = &common.OrderBy{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.OrderBys = append(p.OrderBys, _elem)
+		}
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *ListEvaluationSetItemVersionsResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 100:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField100(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 101:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField101(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListEvaluationSetItemVersionsResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ListEvaluationSetItemVersionsResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*eval_set.EvaluationItemVersion, 0, size)
+	values := make([]eval_set.EvaluationItemVersion, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Versions = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemVersionsResponse) FastReadField100(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Total = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemVersionsResponse) FastReadField101(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.NextPageToken = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemVersionsResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ListEvaluationSetItemVersionsResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListEvaluationSetItemVersionsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField100(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField101(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListEvaluationSetItemVersionsResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field100Length()
+		l += p.field101Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListEvaluationSetItemVersionsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetVersions() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Versions {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemVersionsResponse) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTotal() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 100)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.Total)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemVersionsResponse) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetNextPageToken() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 101)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.NextPageToken)
+	}
+	return offset
+}
+
+func (p *ListEvaluationSetItemVersionsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ListEvaluationSetItemVersionsResponse) field1Length() int {
+	l := 0
+	if p.IsSetVersions() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Versions {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemVersionsResponse) field100Length() int {
+	l := 0
+	if p.IsSetTotal() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemVersionsResponse) field101Length() int {
+	l := 0
+	if p.IsSetNextPageToken() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.NextPageToken)
+	}
+	return l
+}
+
+func (p *ListEvaluationSetItemVersionsResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *ListEvaluationSetItemVersionsResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListEvaluationSetItemVersionsResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Versions != nil {
+		p.Versions = make([]*eval_set.EvaluationItemVersion, 0, len(src.Versions))
+		for _, elem := range src.Versions {
+			var _elem *eval_set.EvaluationItemVersion
+			if elem != nil {
+				_elem = &eval_set.EvaluationItemVersion{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Versions = append(p.Versions, _elem)
+		}
+	}
+
+	if src.Total != nil {
+		tmp := *src.Total
+		p.Total = &tmp
+	}
+
+	if src.NextPageToken != nil {
+		var tmp string
+		if *src.NextPageToken != "" {
+			tmp = kutils.StringDeepCopy(*src.NextPageToken)
+		}
+		p.NextPageToken = &tmp
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *GetEvaluationSetItemVersionRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluationSetID bool = false
+	var issetItemID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluationSetID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetItemID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluationSetID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetItemID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemVersionRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetEvaluationSetItemVersionRequest[fieldId]))
+}
+
+func (p *GetEvaluationSetItemVersionRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemVersionRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EvaluationSetID = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemVersionRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ItemID = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemVersionRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ItemVersionID = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemVersionRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ItemVersion = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemVersionRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemVersionRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetEvaluationSetItemVersionRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetEvaluationSetItemVersionRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetEvaluationSetItemVersionRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *GetEvaluationSetItemVersionRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
+	return offset
+}
+
+func (p *GetEvaluationSetItemVersionRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
+	return offset
+}
+
+func (p *GetEvaluationSetItemVersionRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemVersionID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ItemVersionID)
+	}
+	return offset
+}
+
+func (p *GetEvaluationSetItemVersionRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ItemVersion)
+	}
+	return offset
+}
+
+func (p *GetEvaluationSetItemVersionRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetEvaluationSetItemVersionRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetEvaluationSetItemVersionRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetEvaluationSetItemVersionRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetEvaluationSetItemVersionRequest) field4Length() int {
+	l := 0
+	if p.IsSetItemVersionID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *GetEvaluationSetItemVersionRequest) field5Length() int {
+	l := 0
+	if p.IsSetItemVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ItemVersion)
+	}
+	return l
+}
+
+func (p *GetEvaluationSetItemVersionRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *GetEvaluationSetItemVersionRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetEvaluationSetItemVersionRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.EvaluationSetID = src.EvaluationSetID
+
+	p.ItemID = src.ItemID
+
+	if src.ItemVersionID != nil {
+		tmp := *src.ItemVersionID
+		p.ItemVersionID = &tmp
+	}
+
+	if src.ItemVersion != nil {
+		var tmp string
+		if *src.ItemVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.ItemVersion)
+		}
+		p.ItemVersion = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *GetEvaluationSetItemVersionResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemVersionResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *GetEvaluationSetItemVersionResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := eval_set.NewEvaluationItemVersion()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Version = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemVersionResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemVersionResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetEvaluationSetItemVersionResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetEvaluationSetItemVersionResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetEvaluationSetItemVersionResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Version.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetEvaluationSetItemVersionResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *GetEvaluationSetItemVersionResponse) field1Length() int {
+	l := 0
+	if p.IsSetVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Version.BLength()
+	}
+	return l
+}
+
+func (p *GetEvaluationSetItemVersionResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *GetEvaluationSetItemVersionResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetEvaluationSetItemVersionResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _version *eval_set.EvaluationItemVersion
+	if src.Version != nil {
+		_version = &eval_set.EvaluationItemVersion{}
+		if err := _version.DeepCopy(src.Version); err != nil {
+			return err
+		}
+	}
+	p.Version = _version
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluationSetID bool = false
+	var issetItemID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluationSetID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetItemID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluationSetID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetItemID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateEvaluationSetItemVersionRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_UpdateEvaluationSetItemVersionRequest[fieldId]))
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EvaluationSetID = _field
+	return offset, nil
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ItemID = _field
+	return offset, nil
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ItemVersionID = _field
+	return offset, nil
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Status = _field
+	return offset, nil
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Description = _field
+	return offset, nil
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ItemVersion = _field
+	return offset, nil
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
+	return offset
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
+	return offset
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemVersionID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ItemVersionID)
+	}
+	return offset
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetStatus() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Status)
+	}
+	return offset
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDescription() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 6)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
+	}
+	return offset
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 7)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ItemVersion)
+	}
+	return offset
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) field4Length() int {
+	l := 0
+	if p.IsSetItemVersionID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) field5Length() int {
+	l := 0
+	if p.IsSetStatus() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Status)
+	}
+	return l
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) field6Length() int {
+	l := 0
+	if p.IsSetDescription() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Description)
+	}
+	return l
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) field7Length() int {
+	l := 0
+	if p.IsSetItemVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ItemVersion)
+	}
+	return l
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *UpdateEvaluationSetItemVersionRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*UpdateEvaluationSetItemVersionRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.EvaluationSetID = src.EvaluationSetID
+
+	p.ItemID = src.ItemID
+
+	if src.ItemVersionID != nil {
+		tmp := *src.ItemVersionID
+		p.ItemVersionID = &tmp
+	}
+
+	if src.Status != nil {
+		var tmp string
+		if *src.Status != "" {
+			tmp = kutils.StringDeepCopy(*src.Status)
+		}
+		p.Status = &tmp
+	}
+
+	if src.Description != nil {
+		var tmp string
+		if *src.Description != "" {
+			tmp = kutils.StringDeepCopy(*src.Description)
+		}
+		p.Description = &tmp
+	}
+
+	if src.ItemVersion != nil {
+		var tmp string
+		if *src.ItemVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.ItemVersion)
+		}
+		p.ItemVersion = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *UpdateEvaluationSetItemVersionResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateEvaluationSetItemVersionResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UpdateEvaluationSetItemVersionResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *UpdateEvaluationSetItemVersionResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UpdateEvaluationSetItemVersionResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UpdateEvaluationSetItemVersionResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UpdateEvaluationSetItemVersionResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *UpdateEvaluationSetItemVersionResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *UpdateEvaluationSetItemVersionResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*UpdateEvaluationSetItemVersionResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *ClearEvaluationSetDraftItemRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluationSetID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluationSetID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluationSetID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ClearEvaluationSetDraftItemRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ClearEvaluationSetDraftItemRequest[fieldId]))
+}
+
+func (p *ClearEvaluationSetDraftItemRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *ClearEvaluationSetDraftItemRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EvaluationSetID = _field
+	return offset, nil
+}
+
+func (p *ClearEvaluationSetDraftItemRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ClearEvaluationSetDraftItemRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ClearEvaluationSetDraftItemRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ClearEvaluationSetDraftItemRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ClearEvaluationSetDraftItemRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *ClearEvaluationSetDraftItemRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
+	return offset
+}
+
+func (p *ClearEvaluationSetDraftItemRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ClearEvaluationSetDraftItemRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ClearEvaluationSetDraftItemRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ClearEvaluationSetDraftItemRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *ClearEvaluationSetDraftItemRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ClearEvaluationSetDraftItemRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.EvaluationSetID = src.EvaluationSetID
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *ClearEvaluationSetDraftItemResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ClearEvaluationSetDraftItemResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ClearEvaluationSetDraftItemResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ClearEvaluationSetDraftItemResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ClearEvaluationSetDraftItemResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ClearEvaluationSetDraftItemResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ClearEvaluationSetDraftItemResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ClearEvaluationSetDraftItemResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *ClearEvaluationSetDraftItemResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ClearEvaluationSetDraftItemResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *GetEvaluationSetItemFieldRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluationSetID bool = false
+	var issetItemPk bool = false
+	var issetFieldName bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluationSetID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetItemPk = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetFieldName = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluationSetID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetItemPk {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetFieldName {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemFieldRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetEvaluationSetItemFieldRequest[fieldId]))
+}
+
+func (p *GetEvaluationSetItemFieldRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemFieldRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EvaluationSetID = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemFieldRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ItemPk = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemFieldRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.FieldName = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemFieldRequest) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TurnID = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemFieldRequest) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.FieldKey = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemFieldRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemFieldRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetEvaluationSetItemFieldRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetEvaluationSetItemFieldRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetEvaluationSetItemFieldRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *GetEvaluationSetItemFieldRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
+	return offset
+}
+
+func (p *GetEvaluationSetItemFieldRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemPk)
+	return offset
+}
+
+func (p *GetEvaluationSetItemFieldRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.FieldName)
+	return offset
+}
+
+func (p *GetEvaluationSetItemFieldRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTurnID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 6)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.TurnID)
+	}
+	return offset
+}
+
+func (p *GetEvaluationSetItemFieldRequest) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFieldKey() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 7)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.FieldKey)
+	}
+	return offset
+}
+
+func (p *GetEvaluationSetItemFieldRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetEvaluationSetItemFieldRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetEvaluationSetItemFieldRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetEvaluationSetItemFieldRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetEvaluationSetItemFieldRequest) field5Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.FieldName)
+	return l
+}
+
+func (p *GetEvaluationSetItemFieldRequest) field6Length() int {
+	l := 0
+	if p.IsSetTurnID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *GetEvaluationSetItemFieldRequest) field7Length() int {
+	l := 0
+	if p.IsSetFieldKey() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.FieldKey)
+	}
+	return l
+}
+
+func (p *GetEvaluationSetItemFieldRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *GetEvaluationSetItemFieldRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetEvaluationSetItemFieldRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.EvaluationSetID = src.EvaluationSetID
+
+	p.ItemPk = src.ItemPk
+
+	if src.FieldName != "" {
+		p.FieldName = kutils.StringDeepCopy(src.FieldName)
+	}
+
+	if src.TurnID != nil {
+		tmp := *src.TurnID
+		p.TurnID = &tmp
+	}
+
+	if src.FieldKey != nil {
+		var tmp string
+		if *src.FieldKey != "" {
+			tmp = kutils.StringDeepCopy(*src.FieldKey)
+		}
+		p.FieldKey = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *GetEvaluationSetItemFieldResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluationSetItemFieldResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *GetEvaluationSetItemFieldResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := eval_set.NewFieldData()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.FieldData = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemFieldResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *GetEvaluationSetItemFieldResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetEvaluationSetItemFieldResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetEvaluationSetItemFieldResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetEvaluationSetItemFieldResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFieldData() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.FieldData.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetEvaluationSetItemFieldResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetEvaluationSetItemFieldResponse) field1Length() int {
+	l := 0
+	if p.IsSetFieldData() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.FieldData.BLength()
+	}
+	return l
+}
+
+func (p *GetEvaluationSetItemFieldResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *GetEvaluationSetItemFieldResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetEvaluationSetItemFieldResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _fieldData *eval_set.FieldData
+	if src.FieldData != nil {
+		_fieldData = &eval_set.FieldData{}
+		if err := _fieldData.DeepCopy(src.FieldData); err != nil {
+			return err
+		}
+	}
+	p.FieldData = _fieldData
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *UploadAttachmentDetail) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 20:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField20(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 21:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField21(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 22:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField22(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 23:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField23(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 24:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField24(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 25:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField25(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 101:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField101(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 102:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField102(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadAttachmentDetail[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UploadAttachmentDetail) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *dataset.ContentType
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		tmp := dataset.ContentType(v)
+		_field = &tmp
+	}
+	p.ContentType = _field
+	return offset, nil
+}
+
+func (p *UploadAttachmentDetail) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ImagexServiceID = _field
+	return offset, nil
+}
+
+func (p *UploadAttachmentDetail) FastReadField20(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewImage()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.OriginImage = _field
+	return offset, nil
+}
+
+func (p *UploadAttachmentDetail) FastReadField21(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewImage()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Image = _field
+	return offset, nil
+}
+
+func (p *UploadAttachmentDetail) FastReadField22(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewAudio()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.OriginAudio = _field
+	return offset, nil
+}
+
+func (p *UploadAttachmentDetail) FastReadField23(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewAudio()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Audio = _field
+	return offset, nil
+}
+
+func (p *UploadAttachmentDetail) FastReadField24(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewVideo()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.OriginVideo = _field
+	return offset, nil
+}
+
+func (p *UploadAttachmentDetail) FastReadField25(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewVideo()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Video = _field
+	return offset, nil
+}
+
+func (p *UploadAttachmentDetail) FastReadField101(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *dataset.ItemErrorType
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		tmp := dataset.ItemErrorType(v)
+		_field = &tmp
+	}
+	p.ErrorType = _field
+	return offset, nil
+}
+
+func (p *UploadAttachmentDetail) FastReadField102(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ErrMsg = _field
+	return offset, nil
+}
+
+func (p *UploadAttachmentDetail) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UploadAttachmentDetail) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField20(buf[offset:], w)
+		offset += p.fastWriteField21(buf[offset:], w)
+		offset += p.fastWriteField22(buf[offset:], w)
+		offset += p.fastWriteField23(buf[offset:], w)
+		offset += p.fastWriteField24(buf[offset:], w)
+		offset += p.fastWriteField25(buf[offset:], w)
+		offset += p.fastWriteField101(buf[offset:], w)
+		offset += p.fastWriteField102(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UploadAttachmentDetail) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field20Length()
+		l += p.field21Length()
+		l += p.field22Length()
+		l += p.field23Length()
+		l += p.field24Length()
+		l += p.field25Length()
+		l += p.field101Length()
+		l += p.field102Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UploadAttachmentDetail) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetContentType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 1)
+		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.ContentType))
+	}
+	return offset
+}
+
+func (p *UploadAttachmentDetail) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetImagexServiceID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ImagexServiceID)
+	}
+	return offset
+}
+
+func (p *UploadAttachmentDetail) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetOriginImage() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 20)
+		offset += p.OriginImage.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UploadAttachmentDetail) fastWriteField21(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetImage() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 21)
+		offset += p.Image.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UploadAttachmentDetail) fastWriteField22(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetOriginAudio() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 22)
+		offset += p.OriginAudio.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UploadAttachmentDetail) fastWriteField23(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetAudio() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 23)
+		offset += p.Audio.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UploadAttachmentDetail) fastWriteField24(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetOriginVideo() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 24)
+		offset += p.OriginVideo.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UploadAttachmentDetail) fastWriteField25(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetVideo() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 25)
+		offset += p.Video.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UploadAttachmentDetail) fastWriteField101(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetErrorType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 101)
+		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.ErrorType))
+	}
+	return offset
+}
+
+func (p *UploadAttachmentDetail) fastWriteField102(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetErrMsg() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 102)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ErrMsg)
+	}
+	return offset
+}
+
+func (p *UploadAttachmentDetail) field1Length() int {
+	l := 0
+	if p.IsSetContentType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *UploadAttachmentDetail) field2Length() int {
+	l := 0
+	if p.IsSetImagexServiceID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ImagexServiceID)
+	}
+	return l
+}
+
+func (p *UploadAttachmentDetail) field20Length() int {
+	l := 0
+	if p.IsSetOriginImage() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.OriginImage.BLength()
+	}
+	return l
+}
+
+func (p *UploadAttachmentDetail) field21Length() int {
+	l := 0
+	if p.IsSetImage() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Image.BLength()
+	}
+	return l
+}
+
+func (p *UploadAttachmentDetail) field22Length() int {
+	l := 0
+	if p.IsSetOriginAudio() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.OriginAudio.BLength()
+	}
+	return l
+}
+
+func (p *UploadAttachmentDetail) field23Length() int {
+	l := 0
+	if p.IsSetAudio() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Audio.BLength()
+	}
+	return l
+}
+
+func (p *UploadAttachmentDetail) field24Length() int {
+	l := 0
+	if p.IsSetOriginVideo() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.OriginVideo.BLength()
+	}
+	return l
+}
+
+func (p *UploadAttachmentDetail) field25Length() int {
+	l := 0
+	if p.IsSetVideo() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Video.BLength()
+	}
+	return l
+}
+
+func (p *UploadAttachmentDetail) field101Length() int {
+	l := 0
+	if p.IsSetErrorType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *UploadAttachmentDetail) field102Length() int {
+	l := 0
+	if p.IsSetErrMsg() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ErrMsg)
+	}
+	return l
+}
+
+func (p *UploadAttachmentDetail) DeepCopy(s interface{}) error {
+	src, ok := s.(*UploadAttachmentDetail)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.ContentType != nil {
+		tmp := *src.ContentType
+		p.ContentType = &tmp
+	}
+
+	if src.ImagexServiceID != nil {
+		var tmp string
+		if *src.ImagexServiceID != "" {
+			tmp = kutils.StringDeepCopy(*src.ImagexServiceID)
+		}
+		p.ImagexServiceID = &tmp
+	}
+
+	var _originImage *common.Image
+	if src.OriginImage != nil {
+		_originImage = &common.Image{}
+		if err := _originImage.DeepCopy(src.OriginImage); err != nil {
+			return err
+		}
+	}
+	p.OriginImage = _originImage
+
+	var _image *common.Image
+	if src.Image != nil {
+		_image = &common.Image{}
+		if err := _image.DeepCopy(src.Image); err != nil {
+			return err
+		}
+	}
+	p.Image = _image
+
+	var _originAudio *common.Audio
+	if src.OriginAudio != nil {
+		_originAudio = &common.Audio{}
+		if err := _originAudio.DeepCopy(src.OriginAudio); err != nil {
+			return err
+		}
+	}
+	p.OriginAudio = _originAudio
+
+	var _audio *common.Audio
+	if src.Audio != nil {
+		_audio = &common.Audio{}
+		if err := _audio.DeepCopy(src.Audio); err != nil {
+			return err
+		}
+	}
+	p.Audio = _audio
+
+	var _originVideo *common.Video
+	if src.OriginVideo != nil {
+		_originVideo = &common.Video{}
+		if err := _originVideo.DeepCopy(src.OriginVideo); err != nil {
+			return err
+		}
+	}
+	p.OriginVideo = _originVideo
+
+	var _video *common.Video
+	if src.Video != nil {
+		_video = &common.Video{}
+		if err := _video.DeepCopy(src.Video); err != nil {
+			return err
+		}
+	}
+	p.Video = _video
+
+	if src.ErrorType != nil {
+		tmp := *src.ErrorType
+		p.ErrorType = &tmp
+	}
+
+	if src.ErrMsg != nil {
+		var tmp string
+		if *src.ErrMsg != "" {
+			tmp = kutils.StringDeepCopy(*src.ErrMsg)
+		}
+		p.ErrMsg = &tmp
+	}
+
+	return nil
+}
+
+func (p *ValidateEvaluationSetMultiPartDataRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSpaceID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetSpaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetSpaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ValidateEvaluationSetMultiPartDataRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ValidateEvaluationSetMultiPartDataRequest[fieldId]))
+}
+
+func (p *ValidateEvaluationSetMultiPartDataRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
 	}
 	p.SpaceID = _field
 	return offset, nil
 }
 
-func (p *ValidateEvaluationSetMultiPartDataRequest) FastReadField2(buf []byte) (int, error) {
+func (p *ValidateEvaluationSetMultiPartDataRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.PreviewData = _field
+	return offset, nil
+}
+
+func (p *ValidateEvaluationSetMultiPartDataRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+	_field := dataset.NewMultiModalStoreOption()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.StoreOption = _field
+	return offset, nil
+}
+
+func (p *ValidateEvaluationSetMultiPartDataRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ValidateEvaluationSetMultiPartDataRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ValidateEvaluationSetMultiPartDataRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ValidateEvaluationSetMultiPartDataRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ValidateEvaluationSetMultiPartDataRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.SpaceID)
+	return offset
+}
+
+func (p *ValidateEvaluationSetMultiPartDataRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPreviewData() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.PreviewData {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
+	}
+	return offset
+}
+
+func (p *ValidateEvaluationSetMultiPartDataRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetStoreOption() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+		offset += p.StoreOption.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ValidateEvaluationSetMultiPartDataRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ValidateEvaluationSetMultiPartDataRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ValidateEvaluationSetMultiPartDataRequest) field2Length() int {
+	l := 0
+	if p.IsSetPreviewData() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.PreviewData {
+			_ = v
+			l += thrift.Binary.StringLengthNocopy(v)
+		}
+	}
+	return l
+}
+
+func (p *ValidateEvaluationSetMultiPartDataRequest) field3Length() int {
+	l := 0
+	if p.IsSetStoreOption() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.StoreOption.BLength()
+	}
+	return l
+}
+
+func (p *ValidateEvaluationSetMultiPartDataRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *ValidateEvaluationSetMultiPartDataRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ValidateEvaluationSetMultiPartDataRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.SpaceID = src.SpaceID
+
+	if src.PreviewData != nil {
+		p.PreviewData = make([]string, 0, len(src.PreviewData))
+		for _, elem := range src.PreviewData {
+			var _elem string
+			if elem != "" {
+				_elem = kutils.StringDeepCopy(elem)
+			}
+			p.PreviewData = append(p.PreviewData, _elem)
+		}
+	}
+
+	var _storeOption *dataset.MultiModalStoreOption
+	if src.StoreOption != nil {
+		_storeOption = &dataset.MultiModalStoreOption{}
+		if err := _storeOption.DeepCopy(src.StoreOption); err != nil {
+			return err
+		}
+	}
+	p.StoreOption = _storeOption
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *ValidateEvaluationSetMultiPartDataResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ValidateEvaluationSetMultiPartDataResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ValidateEvaluationSetMultiPartDataResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*UploadAttachmentDetail, 0, size)
+	values := make([]UploadAttachmentDetail, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.AttachmentUrlsCheckDetail = _field
+	return offset, nil
+}
+
+func (p *ValidateEvaluationSetMultiPartDataResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ValidateEvaluationSetMultiPartDataResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ValidateEvaluationSetMultiPartDataResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ValidateEvaluationSetMultiPartDataResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ValidateEvaluationSetMultiPartDataResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetAttachmentUrlsCheckDetail() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.AttachmentUrlsCheckDetail {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ValidateEvaluationSetMultiPartDataResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ValidateEvaluationSetMultiPartDataResponse) field1Length() int {
+	l := 0
+	if p.IsSetAttachmentUrlsCheckDetail() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.AttachmentUrlsCheckDetail {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ValidateEvaluationSetMultiPartDataResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *ValidateEvaluationSetMultiPartDataResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ValidateEvaluationSetMultiPartDataResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.AttachmentUrlsCheckDetail != nil {
+		p.AttachmentUrlsCheckDetail = make([]*UploadAttachmentDetail, 0, len(src.AttachmentUrlsCheckDetail))
+		for _, elem := range src.AttachmentUrlsCheckDetail {
+			var _elem *UploadAttachmentDetail
+			if elem != nil {
+				_elem = &UploadAttachmentDetail{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.AttachmentUrlsCheckDetail = append(p.AttachmentUrlsCheckDetail, _elem)
+		}
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceCreateEvaluationSetArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewCreateEvaluationSetRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceCreateEvaluationSetArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *CreateEvaluationSetRequest
+	if src.Req != nil {
+		_req = &CreateEvaluationSetRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceCreateEvaluationSetResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewCreateEvaluationSetResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceCreateEvaluationSetResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *CreateEvaluationSetResponse
+	if src.Success != nil {
+		_success = &CreateEvaluationSetResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceListEvaluationSetTemplatesArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewListEvaluationSetTemplatesRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceListEvaluationSetTemplatesArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *ListEvaluationSetTemplatesRequest
+	if src.Req != nil {
+		_req = &ListEvaluationSetTemplatesRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceListEvaluationSetTemplatesResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewListEvaluationSetTemplatesResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceListEvaluationSetTemplatesResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *ListEvaluationSetTemplatesResponse
+	if src.Success != nil {
+		_success = &ListEvaluationSetTemplatesResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *EvaluationSetServiceUpdateEvaluationSetArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceUpdateEvaluationSetArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluationSetServiceUpdateEvaluationSetArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewUpdateEvaluationSetRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetServiceUpdateEvaluationSetArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluationSetServiceUpdateEvaluationSetArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluationSetServiceUpdateEvaluationSetArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluationSetServiceUpdateEvaluationSetArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *EvaluationSetServiceUpdateEvaluationSetArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *EvaluationSetServiceUpdateEvaluationSetArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceUpdateEvaluationSetArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *UpdateEvaluationSetRequest
+	if src.Req != nil {
+		_req = &UpdateEvaluationSetRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *EvaluationSetServiceUpdateEvaluationSetResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceUpdateEvaluationSetResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluationSetServiceUpdateEvaluationSetResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewUpdateEvaluationSetResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetServiceUpdateEvaluationSetResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluationSetServiceUpdateEvaluationSetResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluationSetServiceUpdateEvaluationSetResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluationSetServiceUpdateEvaluationSetResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluationSetServiceUpdateEvaluationSetResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *EvaluationSetServiceUpdateEvaluationSetResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceUpdateEvaluationSetResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *UpdateEvaluationSetResponse
+	if src.Success != nil {
+		_success = &UpdateEvaluationSetResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *EvaluationSetServiceDeleteEvaluationSetArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceDeleteEvaluationSetArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluationSetServiceDeleteEvaluationSetArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewDeleteEvaluationSetRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetServiceDeleteEvaluationSetArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluationSetServiceDeleteEvaluationSetArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluationSetServiceDeleteEvaluationSetArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluationSetServiceDeleteEvaluationSetArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *EvaluationSetServiceDeleteEvaluationSetArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *EvaluationSetServiceDeleteEvaluationSetArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceDeleteEvaluationSetArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *DeleteEvaluationSetRequest
+	if src.Req != nil {
+		_req = &DeleteEvaluationSetRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *EvaluationSetServiceDeleteEvaluationSetResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceDeleteEvaluationSetResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluationSetServiceDeleteEvaluationSetResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewDeleteEvaluationSetResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetServiceDeleteEvaluationSetResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluationSetServiceDeleteEvaluationSetResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluationSetServiceDeleteEvaluationSetResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluationSetServiceDeleteEvaluationSetResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluationSetServiceDeleteEvaluationSetResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *EvaluationSetServiceDeleteEvaluationSetResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceDeleteEvaluationSetResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *DeleteEvaluationSetResponse
+	if src.Success != nil {
+		_success = &DeleteEvaluationSetResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceGetEvaluationSetArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetEvaluationSetRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceGetEvaluationSetArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *GetEvaluationSetRequest
+	if src.Req != nil {
+		_req = &GetEvaluationSetRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceGetEvaluationSetResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetEvaluationSetResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceGetEvaluationSetResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *GetEvaluationSetResponse
+	if src.Success != nil {
+		_success = &GetEvaluationSetResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *EvaluationSetServiceListEvaluationSetsArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceListEvaluationSetsArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluationSetServiceListEvaluationSetsArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewListEvaluationSetsRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetServiceListEvaluationSetsArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluationSetServiceListEvaluationSetsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluationSetServiceListEvaluationSetsArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluationSetServiceListEvaluationSetsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *EvaluationSetServiceListEvaluationSetsArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *EvaluationSetServiceListEvaluationSetsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceListEvaluationSetsArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *ListEvaluationSetsRequest
+	if src.Req != nil {
+		_req = &ListEvaluationSetsRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *EvaluationSetServiceListEvaluationSetsResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceListEvaluationSetsResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluationSetServiceListEvaluationSetsResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewListEvaluationSetsResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetServiceListEvaluationSetsResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluationSetServiceListEvaluationSetsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluationSetServiceListEvaluationSetsResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluationSetServiceListEvaluationSetsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluationSetServiceListEvaluationSetsResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *EvaluationSetServiceListEvaluationSetsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceListEvaluationSetsResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *ListEvaluationSetsResponse
+	if src.Success != nil {
+		_success = &ListEvaluationSetsResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceCreateEvaluationSetWithImportArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewCreateEvaluationSetWithImportRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceCreateEvaluationSetWithImportArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *CreateEvaluationSetWithImportRequest
+	if src.Req != nil {
+		_req = &CreateEvaluationSetWithImportRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceCreateEvaluationSetWithImportResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	_field := NewCreateEvaluationSetWithImportResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
 	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
-		var _elem string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_elem = v
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceCreateEvaluationSetWithImportResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *CreateEvaluationSetWithImportResponse
+	if src.Success != nil {
+		_success = &CreateEvaluationSetWithImportResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
 		}
+	}
+	p.Success = _success
 
-		_field = append(_field, _elem)
+	return nil
+}
+
+func (p *EvaluationSetServiceParseImportSourceFileArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
 	}
-	p.PreviewData = _field
+
 	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceParseImportSourceFileArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ValidateEvaluationSetMultiPartDataRequest) FastReadField3(buf []byte) (int, error) {
+func (p *EvaluationSetServiceParseImportSourceFileArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := dataset.NewMultiModalStoreOption()
+	_field := NewParseImportSourceFileRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.StoreOption = _field
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetServiceParseImportSourceFileArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluationSetServiceParseImportSourceFileArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluationSetServiceParseImportSourceFileArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluationSetServiceParseImportSourceFileArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *EvaluationSetServiceParseImportSourceFileArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *EvaluationSetServiceParseImportSourceFileArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceParseImportSourceFileArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *ParseImportSourceFileRequest
+	if src.Req != nil {
+		_req = &ParseImportSourceFileRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *EvaluationSetServiceParseImportSourceFileResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
 	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceParseImportSourceFileResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ValidateEvaluationSetMultiPartDataRequest) FastReadField255(buf []byte) (int, error) {
+func (p *EvaluationSetServiceParseImportSourceFileResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBase()
+	_field := NewParseImportSourceFileResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Base = _field
+	p.Success = _field
 	return offset, nil
 }
 
-func (p *ValidateEvaluationSetMultiPartDataRequest) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceParseImportSourceFileResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ValidateEvaluationSetMultiPartDataRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceParseImportSourceFileResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField255(buf[offset:], w)
+		offset += p.fastWriteField0(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ValidateEvaluationSetMultiPartDataRequest) BLength() int {
+func (p *EvaluationSetServiceParseImportSourceFileResult) BLength() int {
 	l := 0
 	if p != nil {
-		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field3Length()
-		l += p.field255Length()
+		l += p.field0Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ValidateEvaluationSetMultiPartDataRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.SpaceID)
-	return offset
-}
-
-func (p *ValidateEvaluationSetMultiPartDataRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetPreviewData() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.PreviewData {
-			length++
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
-	}
-	return offset
-}
-
-func (p *ValidateEvaluationSetMultiPartDataRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetStoreOption() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
-		offset += p.StoreOption.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
-}
-
-func (p *ValidateEvaluationSetMultiPartDataRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceParseImportSourceFileResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetBase() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *ValidateEvaluationSetMultiPartDataRequest) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
-
-func (p *ValidateEvaluationSetMultiPartDataRequest) field2Length() int {
-	l := 0
-	if p.IsSetPreviewData() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.PreviewData {
-			_ = v
-			l += thrift.Binary.StringLengthNocopy(v)
-		}
-	}
-	return l
-}
-
-func (p *ValidateEvaluationSetMultiPartDataRequest) field3Length() int {
-	l := 0
-	if p.IsSetStoreOption() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.StoreOption.BLength()
-	}
-	return l
-}
-
-func (p *ValidateEvaluationSetMultiPartDataRequest) field255Length() int {
+func (p *EvaluationSetServiceParseImportSourceFileResult) field0Length() int {
 	l := 0
-	if p.IsSetBase() {
+	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Base.BLength()
+		l += p.Success.BLength()
 	}
 	return l
 }
 
-func (p *ValidateEvaluationSetMultiPartDataRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*ValidateEvaluationSetMultiPartDataRequest)
+func (p *EvaluationSetServiceParseImportSourceFileResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceParseImportSourceFileResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.SpaceID = src.SpaceID
-
-	if src.PreviewData != nil {
-		p.PreviewData = make([]string, 0, len(src.PreviewData))
-		for _, elem := range src.PreviewData {
-			var _elem string
-			if elem != "" {
-				_elem = kutils.StringDeepCopy(elem)
-			}
-			p.PreviewData = append(p.PreviewData, _elem)
-		}
-	}
-
-	var _storeOption *dataset.MultiModalStoreOption
-	if src.StoreOption != nil {
-		_storeOption = &dataset.MultiModalStoreOption{}
-		if err := _storeOption.DeepCopy(src.StoreOption); err != nil {
-			return err
-		}
-	}
-	p.StoreOption = _storeOption
-
-	var _base *base.Base
-	if src.Base != nil {
-		_base = &base.Base{}
-		if err := _base.DeepCopy(src.Base); err != nil {
+	var _success *ParseImportSourceFileResponse
+	if src.Success != nil {
+		_success = &ParseImportSourceFileResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
 	}
-	p.Base = _base
+	p.Success = _success
 
 	return nil
 }
 
-func (p *ValidateEvaluationSetMultiPartDataResponse) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20007,22 +28563,8 @@ func (p *ValidateEvaluationSetMultiPartDataResponse) FastRead(buf []byte) (int,
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField1(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 255:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField255(buf[offset:])
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -20047,153 +28589,197 @@ func (p *ValidateEvaluationSetMultiPartDataResponse) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ValidateEvaluationSetMultiPartDataResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceCreateEvaluationSetVersionArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ValidateEvaluationSetMultiPartDataResponse) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	_field := NewCreateEvaluationSetVersionRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
 	}
-	_field := make([]*UploadAttachmentDetail, 0, size)
-	values := make([]UploadAttachmentDetail, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceCreateEvaluationSetVersionArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *CreateEvaluationSetVersionRequest
+	if src.Req != nil {
+		_req = &CreateEvaluationSetVersionRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
 		}
-
-		_field = append(_field, _elem)
 	}
-	p.AttachmentUrlsCheckDetail = _field
+
 	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceCreateEvaluationSetVersionResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ValidateEvaluationSetMultiPartDataResponse) FastReadField255(buf []byte) (int, error) {
+func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBaseResp()
+	_field := NewCreateEvaluationSetVersionResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.BaseResp = _field
+	p.Success = _field
 	return offset, nil
 }
 
-func (p *ValidateEvaluationSetMultiPartDataResponse) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ValidateEvaluationSetMultiPartDataResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField255(buf[offset:], w)
+		offset += p.fastWriteField0(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ValidateEvaluationSetMultiPartDataResponse) BLength() int {
+func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) BLength() int {
 	l := 0
 	if p != nil {
-		l += p.field1Length()
-		l += p.field255Length()
+		l += p.field0Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ValidateEvaluationSetMultiPartDataResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetAttachmentUrlsCheckDetail() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.AttachmentUrlsCheckDetail {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *ValidateEvaluationSetMultiPartDataResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetBaseResp() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *ValidateEvaluationSetMultiPartDataResponse) field1Length() int {
-	l := 0
-	if p.IsSetAttachmentUrlsCheckDetail() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.AttachmentUrlsCheckDetail {
-			_ = v
-			l += v.BLength()
-		}
-	}
-	return l
-}
-
-func (p *ValidateEvaluationSetMultiPartDataResponse) field255Length() int {
+func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) field0Length() int {
 	l := 0
-	if p.IsSetBaseResp() {
+	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.BaseResp.BLength()
+		l += p.Success.BLength()
 	}
 	return l
 }
 
-func (p *ValidateEvaluationSetMultiPartDataResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*ValidateEvaluationSetMultiPartDataResponse)
+func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceCreateEvaluationSetVersionResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.AttachmentUrlsCheckDetail != nil {
-		p.AttachmentUrlsCheckDetail = make([]*UploadAttachmentDetail, 0, len(src.AttachmentUrlsCheckDetail))
-		for _, elem := range src.AttachmentUrlsCheckDetail {
-			var _elem *UploadAttachmentDetail
-			if elem != nil {
-				_elem = &UploadAttachmentDetail{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.AttachmentUrlsCheckDetail = append(p.AttachmentUrlsCheckDetail, _elem)
-		}
-	}
-
-	var _baseResp *base.BaseResp
-	if src.BaseResp != nil {
-		_baseResp = &base.BaseResp{}
-		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+	var _success *CreateEvaluationSetVersionResponse
+	if src.Success != nil {
+		_success = &CreateEvaluationSetVersionResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
 	}
-	p.BaseResp = _baseResp
+	p.Success = _success
 
 	return nil
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20237,14 +28823,14 @@ func (p *EvaluationSetServiceCreateEvaluationSetArgs) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceCreateEvaluationSetArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceGetEvaluationSetVersionArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateEvaluationSetRequest()
+	_field := NewGetEvaluationSetVersionRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20254,11 +28840,11 @@ func (p *EvaluationSetServiceCreateEvaluationSetArgs) FastReadField1(buf []byte)
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetArgs) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20267,7 +28853,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetArgs) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetArgs) BLength() int {
+func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20276,29 +28862,29 @@ func (p *EvaluationSetServiceCreateEvaluationSetArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetArgs) field1Length() int {
+func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceCreateEvaluationSetArgs)
+func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceGetEvaluationSetVersionArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CreateEvaluationSetRequest
+	var _req *GetEvaluationSetVersionRequest
 	if src.Req != nil {
-		_req = &CreateEvaluationSetRequest{}
+		_req = &GetEvaluationSetVersionRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -20308,7 +28894,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetArgs) DeepCopy(s interface{}) er
 	return nil
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceGetEvaluationSetVersionResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20352,14 +28938,14 @@ func (p *EvaluationSetServiceCreateEvaluationSetResult) FastRead(buf []byte) (in
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceCreateEvaluationSetResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceGetEvaluationSetVersionResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluationSetServiceGetEvaluationSetVersionResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateEvaluationSetResponse()
+	_field := NewGetEvaluationSetVersionResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20369,11 +28955,11 @@ func (p *EvaluationSetServiceCreateEvaluationSetResult) FastReadField0(buf []byt
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetResult) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceGetEvaluationSetVersionResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceGetEvaluationSetVersionResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20382,7 +28968,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetResult) FastWriteNocopy(buf []by
 	return offset
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetResult) BLength() int {
+func (p *EvaluationSetServiceGetEvaluationSetVersionResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20391,7 +28977,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetResult) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceGetEvaluationSetVersionResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20400,7 +28986,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetResult) fastWriteField0(buf []by
 	return offset
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetResult) field0Length() int {
+func (p *EvaluationSetServiceGetEvaluationSetVersionResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20409,15 +28995,15 @@ func (p *EvaluationSetServiceCreateEvaluationSetResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceCreateEvaluationSetResult)
+func (p *EvaluationSetServiceGetEvaluationSetVersionResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceGetEvaluationSetVersionResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CreateEvaluationSetResponse
+	var _success *GetEvaluationSetVersionResponse
 	if src.Success != nil {
-		_success = &CreateEvaluationSetResponse{}
+		_success = &GetEvaluationSetVersionResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -20427,7 +29013,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetResult) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20471,14 +29057,14 @@ func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) FastRead(buf []byte
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceListEvaluationSetTemplatesArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceListEvaluationSetVersionsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListEvaluationSetTemplatesRequest()
+	_field := NewListEvaluationSetVersionsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20488,11 +29074,11 @@ func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) FastReadField1(buf
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20501,7 +29087,7 @@ func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) BLength() int {
+func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20510,29 +29096,29 @@ func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) field1Length() int {
+func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceListEvaluationSetTemplatesArgs)
+func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceListEvaluationSetVersionsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListEvaluationSetTemplatesRequest
+	var _req *ListEvaluationSetVersionsRequest
 	if src.Req != nil {
-		_req = &ListEvaluationSetTemplatesRequest{}
+		_req = &ListEvaluationSetVersionsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -20542,7 +29128,7 @@ func (p *EvaluationSetServiceListEvaluationSetTemplatesArgs) DeepCopy(s interfac
 	return nil
 }
 
-func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceListEvaluationSetVersionsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20586,14 +29172,14 @@ func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) FastRead(buf []by
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceListEvaluationSetTemplatesResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceListEvaluationSetVersionsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluationSetServiceListEvaluationSetVersionsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListEvaluationSetTemplatesResponse()
+	_field := NewListEvaluationSetVersionsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20603,11 +29189,11 @@ func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) FastReadField0(bu
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceListEvaluationSetVersionsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceListEvaluationSetVersionsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20616,7 +29202,7 @@ func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) FastWriteNocopy(b
 	return offset
 }
 
-func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) BLength() int {
+func (p *EvaluationSetServiceListEvaluationSetVersionsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20625,7 +29211,7 @@ func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceListEvaluationSetVersionsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20634,7 +29220,7 @@ func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) fastWriteField0(b
 	return offset
 }
 
-func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) field0Length() int {
+func (p *EvaluationSetServiceListEvaluationSetVersionsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20643,15 +29229,15 @@ func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) field0Length() in
 	return l
 }
 
-func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceListEvaluationSetTemplatesResult)
+func (p *EvaluationSetServiceListEvaluationSetVersionsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceListEvaluationSetVersionsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListEvaluationSetTemplatesResponse
+	var _success *ListEvaluationSetVersionsResponse
 	if src.Success != nil {
-		_success = &ListEvaluationSetTemplatesResponse{}
+		_success = &ListEvaluationSetVersionsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -20661,7 +29247,7 @@ func (p *EvaluationSetServiceListEvaluationSetTemplatesResult) DeepCopy(s interf
 	return nil
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20705,14 +29291,14 @@ func (p *EvaluationSetServiceUpdateEvaluationSetArgs) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceUpdateEvaluationSetArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceBatchGetEvaluationSetVersionsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateEvaluationSetRequest()
+	_field := NewBatchGetEvaluationSetVersionsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20722,11 +29308,11 @@ func (p *EvaluationSetServiceUpdateEvaluationSetArgs) FastReadField1(buf []byte)
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetArgs) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20735,7 +29321,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetArgs) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetArgs) BLength() int {
+func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20744,29 +29330,29 @@ func (p *EvaluationSetServiceUpdateEvaluationSetArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetArgs) field1Length() int {
+func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceUpdateEvaluationSetArgs)
+func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceBatchGetEvaluationSetVersionsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *UpdateEvaluationSetRequest
+	var _req *BatchGetEvaluationSetVersionsRequest
 	if src.Req != nil {
-		_req = &UpdateEvaluationSetRequest{}
+		_req = &BatchGetEvaluationSetVersionsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -20776,7 +29362,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetArgs) DeepCopy(s interface{}) er
 	return nil
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20820,14 +29406,14 @@ func (p *EvaluationSetServiceUpdateEvaluationSetResult) FastRead(buf []byte) (in
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceUpdateEvaluationSetResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceBatchGetEvaluationSetVersionsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateEvaluationSetResponse()
+	_field := NewBatchGetEvaluationSetVersionsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20837,11 +29423,11 @@ func (p *EvaluationSetServiceUpdateEvaluationSetResult) FastReadField0(buf []byt
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetResult) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20850,7 +29436,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetResult) FastWriteNocopy(buf []by
 	return offset
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetResult) BLength() int {
+func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20859,7 +29445,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetResult) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20868,7 +29454,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetResult) fastWriteField0(buf []by
 	return offset
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetResult) field0Length() int {
+func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20877,15 +29463,15 @@ func (p *EvaluationSetServiceUpdateEvaluationSetResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceUpdateEvaluationSetResult)
+func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceBatchGetEvaluationSetVersionsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpdateEvaluationSetResponse
+	var _success *BatchGetEvaluationSetVersionsResponse
 	if src.Success != nil {
-		_success = &UpdateEvaluationSetResponse{}
+		_success = &BatchGetEvaluationSetVersionsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -20895,7 +29481,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetResult) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *EvaluationSetServiceDeleteEvaluationSetArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20939,14 +29525,14 @@ func (p *EvaluationSetServiceDeleteEvaluationSetArgs) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceDeleteEvaluationSetArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceDiffEvaluationSetVersionsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceDeleteEvaluationSetArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteEvaluationSetRequest()
+	_field := NewDiffEvaluationSetVersionsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20956,11 +29542,11 @@ func (p *EvaluationSetServiceDeleteEvaluationSetArgs) FastReadField1(buf []byte)
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceDeleteEvaluationSetArgs) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceDeleteEvaluationSetArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20969,7 +29555,7 @@ func (p *EvaluationSetServiceDeleteEvaluationSetArgs) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *EvaluationSetServiceDeleteEvaluationSetArgs) BLength() int {
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20978,29 +29564,29 @@ func (p *EvaluationSetServiceDeleteEvaluationSetArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceDeleteEvaluationSetArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluationSetServiceDeleteEvaluationSetArgs) field1Length() int {
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluationSetServiceDeleteEvaluationSetArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceDeleteEvaluationSetArgs)
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceDiffEvaluationSetVersionsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *DeleteEvaluationSetRequest
+	var _req *DiffEvaluationSetVersionsRequest
 	if src.Req != nil {
-		_req = &DeleteEvaluationSetRequest{}
+		_req = &DiffEvaluationSetVersionsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -21010,7 +29596,7 @@ func (p *EvaluationSetServiceDeleteEvaluationSetArgs) DeepCopy(s interface{}) er
 	return nil
 }
 
-func (p *EvaluationSetServiceDeleteEvaluationSetResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21054,14 +29640,14 @@ func (p *EvaluationSetServiceDeleteEvaluationSetResult) FastRead(buf []byte) (in
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceDeleteEvaluationSetResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceDiffEvaluationSetVersionsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceDeleteEvaluationSetResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteEvaluationSetResponse()
+	_field := NewDiffEvaluationSetVersionsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21071,11 +29657,11 @@ func (p *EvaluationSetServiceDeleteEvaluationSetResult) FastReadField0(buf []byt
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceDeleteEvaluationSetResult) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceDeleteEvaluationSetResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -21084,7 +29670,7 @@ func (p *EvaluationSetServiceDeleteEvaluationSetResult) FastWriteNocopy(buf []by
 	return offset
 }
 
-func (p *EvaluationSetServiceDeleteEvaluationSetResult) BLength() int {
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -21093,7 +29679,7 @@ func (p *EvaluationSetServiceDeleteEvaluationSetResult) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceDeleteEvaluationSetResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -21102,7 +29688,7 @@ func (p *EvaluationSetServiceDeleteEvaluationSetResult) fastWriteField0(buf []by
 	return offset
 }
 
-func (p *EvaluationSetServiceDeleteEvaluationSetResult) field0Length() int {
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -21111,15 +29697,15 @@ func (p *EvaluationSetServiceDeleteEvaluationSetResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluationSetServiceDeleteEvaluationSetResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceDeleteEvaluationSetResult)
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceDiffEvaluationSetVersionsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *DeleteEvaluationSetResponse
+	var _success *DiffEvaluationSetVersionsResponse
 	if src.Success != nil {
-		_success = &DeleteEvaluationSetResponse{}
+		_success = &DiffEvaluationSetVersionsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -21129,7 +29715,7 @@ func (p *EvaluationSetServiceDeleteEvaluationSetResult) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceMergeEvaluationSetVersionArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21173,14 +29759,14 @@ func (p *EvaluationSetServiceGetEvaluationSetArgs) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceGetEvaluationSetArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceMergeEvaluationSetVersionArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetServiceMergeEvaluationSetVersionArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetEvaluationSetRequest()
+	_field := NewMergeEvaluationSetVersionRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21190,11 +29776,11 @@ func (p *EvaluationSetServiceGetEvaluationSetArgs) FastReadField1(buf []byte) (i
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetArgs) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceMergeEvaluationSetVersionArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceMergeEvaluationSetVersionArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -21203,7 +29789,7 @@ func (p *EvaluationSetServiceGetEvaluationSetArgs) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetArgs) BLength() int {
+func (p *EvaluationSetServiceMergeEvaluationSetVersionArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -21212,29 +29798,29 @@ func (p *EvaluationSetServiceGetEvaluationSetArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceMergeEvaluationSetVersionArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetArgs) field1Length() int {
+func (p *EvaluationSetServiceMergeEvaluationSetVersionArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceGetEvaluationSetArgs)
+func (p *EvaluationSetServiceMergeEvaluationSetVersionArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceMergeEvaluationSetVersionArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *GetEvaluationSetRequest
+	var _req *MergeEvaluationSetVersionRequest
 	if src.Req != nil {
-		_req = &GetEvaluationSetRequest{}
+		_req = &MergeEvaluationSetVersionRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -21244,7 +29830,7 @@ func (p *EvaluationSetServiceGetEvaluationSetArgs) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceMergeEvaluationSetVersionResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21288,14 +29874,14 @@ func (p *EvaluationSetServiceGetEvaluationSetResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceGetEvaluationSetResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceMergeEvaluationSetVersionResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluationSetServiceMergeEvaluationSetVersionResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetEvaluationSetResponse()
+	_field := NewMergeEvaluationSetVersionResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21305,11 +29891,11 @@ func (p *EvaluationSetServiceGetEvaluationSetResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetResult) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceMergeEvaluationSetVersionResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceMergeEvaluationSetVersionResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -21318,7 +29904,7 @@ func (p *EvaluationSetServiceGetEvaluationSetResult) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetResult) BLength() int {
+func (p *EvaluationSetServiceMergeEvaluationSetVersionResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -21327,7 +29913,7 @@ func (p *EvaluationSetServiceGetEvaluationSetResult) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceMergeEvaluationSetVersionResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -21336,7 +29922,7 @@ func (p *EvaluationSetServiceGetEvaluationSetResult) fastWriteField0(buf []byte,
 	return offset
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetResult) field0Length() int {
+func (p *EvaluationSetServiceMergeEvaluationSetVersionResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -21345,15 +29931,15 @@ func (p *EvaluationSetServiceGetEvaluationSetResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceGetEvaluationSetResult)
+func (p *EvaluationSetServiceMergeEvaluationSetVersionResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceMergeEvaluationSetVersionResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetEvaluationSetResponse
+	var _success *MergeEvaluationSetVersionResponse
 	if src.Success != nil {
-		_success = &GetEvaluationSetResponse{}
+		_success = &MergeEvaluationSetVersionResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -21363,7 +29949,7 @@ func (p *EvaluationSetServiceGetEvaluationSetResult) DeepCopy(s interface{}) err
 	return nil
 }
 
-func (p *EvaluationSetServiceListEvaluationSetsArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21407,14 +29993,14 @@ func (p *EvaluationSetServiceListEvaluationSetsArgs) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceListEvaluationSetsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceUpdateEvaluationSetSchemaArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceListEvaluationSetsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListEvaluationSetsRequest()
+	_field := NewUpdateEvaluationSetSchemaRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21424,11 +30010,11 @@ func (p *EvaluationSetServiceListEvaluationSetsArgs) FastReadField1(buf []byte)
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceListEvaluationSetsArgs) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceListEvaluationSetsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -21437,7 +30023,7 @@ func (p *EvaluationSetServiceListEvaluationSetsArgs) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *EvaluationSetServiceListEvaluationSetsArgs) BLength() int {
+func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -21446,29 +30032,29 @@ func (p *EvaluationSetServiceListEvaluationSetsArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceListEvaluationSetsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluationSetServiceListEvaluationSetsArgs) field1Length() int {
+func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluationSetServiceListEvaluationSetsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceListEvaluationSetsArgs)
+func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceUpdateEvaluationSetSchemaArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListEvaluationSetsRequest
+	var _req *UpdateEvaluationSetSchemaRequest
 	if src.Req != nil {
-		_req = &ListEvaluationSetsRequest{}
+		_req = &UpdateEvaluationSetSchemaRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -21478,7 +30064,7 @@ func (p *EvaluationSetServiceListEvaluationSetsArgs) DeepCopy(s interface{}) err
 	return nil
 }
 
-func (p *EvaluationSetServiceListEvaluationSetsResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21522,14 +30108,14 @@ func (p *EvaluationSetServiceListEvaluationSetsResult) FastRead(buf []byte) (int
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceListEvaluationSetsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceUpdateEvaluationSetSchemaResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceListEvaluationSetsResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListEvaluationSetsResponse()
+	_field := NewUpdateEvaluationSetSchemaResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21539,11 +30125,11 @@ func (p *EvaluationSetServiceListEvaluationSetsResult) FastReadField0(buf []byte
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceListEvaluationSetsResult) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceListEvaluationSetsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -21552,7 +30138,7 @@ func (p *EvaluationSetServiceListEvaluationSetsResult) FastWriteNocopy(buf []byt
 	return offset
 }
 
-func (p *EvaluationSetServiceListEvaluationSetsResult) BLength() int {
+func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -21561,7 +30147,7 @@ func (p *EvaluationSetServiceListEvaluationSetsResult) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceListEvaluationSetsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -21570,7 +30156,7 @@ func (p *EvaluationSetServiceListEvaluationSetsResult) fastWriteField0(buf []byt
 	return offset
 }
 
-func (p *EvaluationSetServiceListEvaluationSetsResult) field0Length() int {
+func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -21579,15 +30165,15 @@ func (p *EvaluationSetServiceListEvaluationSetsResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluationSetServiceListEvaluationSetsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceListEvaluationSetsResult)
+func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceUpdateEvaluationSetSchemaResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListEvaluationSetsResponse
+	var _success *UpdateEvaluationSetSchemaResponse
 	if src.Success != nil {
-		_success = &ListEvaluationSetsResponse{}
+		_success = &UpdateEvaluationSetSchemaResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -21597,7 +30183,7 @@ func (p *EvaluationSetServiceListEvaluationSetsResult) DeepCopy(s interface{}) e
 	return nil
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21641,14 +30227,14 @@ func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) FastRead(buf []b
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceCreateEvaluationSetWithImportArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceBatchCreateEvaluationSetItemsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateEvaluationSetWithImportRequest()
+	_field := NewBatchCreateEvaluationSetItemsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21658,11 +30244,11 @@ func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) FastReadField1(b
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -21671,7 +30257,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) FastWriteNocopy(
 	return offset
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) BLength() int {
+func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -21680,29 +30266,29 @@ func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) field1Length() int {
+func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceCreateEvaluationSetWithImportArgs)
+func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceBatchCreateEvaluationSetItemsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CreateEvaluationSetWithImportRequest
+	var _req *BatchCreateEvaluationSetItemsRequest
 	if src.Req != nil {
-		_req = &CreateEvaluationSetWithImportRequest{}
+		_req = &BatchCreateEvaluationSetItemsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -21712,7 +30298,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetWithImportArgs) DeepCopy(s inter
 	return nil
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21756,14 +30342,14 @@ func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) FastRead(buf [
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceCreateEvaluationSetWithImportResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceBatchCreateEvaluationSetItemsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateEvaluationSetWithImportResponse()
+	_field := NewBatchCreateEvaluationSetItemsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21773,11 +30359,11 @@ func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) FastReadField0
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -21786,7 +30372,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) FastWriteNocop
 	return offset
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) BLength() int {
+func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -21795,7 +30381,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) BLength() int
 	return l
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -21804,7 +30390,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) fastWriteField
 	return offset
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) field0Length() int {
+func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -21813,15 +30399,15 @@ func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) field0Length()
 	return l
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceCreateEvaluationSetWithImportResult)
+func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceBatchCreateEvaluationSetItemsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CreateEvaluationSetWithImportResponse
+	var _success *BatchCreateEvaluationSetItemsResponse
 	if src.Success != nil {
-		_success = &CreateEvaluationSetWithImportResponse{}
+		_success = &BatchCreateEvaluationSetItemsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -21831,7 +30417,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetWithImportResult) DeepCopy(s int
 	return nil
 }
 
-func (p *EvaluationSetServiceParseImportSourceFileArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21875,14 +30461,14 @@ func (p *EvaluationSetServiceParseImportSourceFileArgs) FastRead(buf []byte) (in
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceParseImportSourceFileArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceUpdateEvaluationSetItemArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceParseImportSourceFileArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewParseImportSourceFileRequest()
+	_field := NewUpdateEvaluationSetItemRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21892,11 +30478,11 @@ func (p *EvaluationSetServiceParseImportSourceFileArgs) FastReadField1(buf []byt
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceParseImportSourceFileArgs) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceParseImportSourceFileArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -21905,7 +30491,7 @@ func (p *EvaluationSetServiceParseImportSourceFileArgs) FastWriteNocopy(buf []by
 	return offset
 }
 
-func (p *EvaluationSetServiceParseImportSourceFileArgs) BLength() int {
+func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -21914,29 +30500,29 @@ func (p *EvaluationSetServiceParseImportSourceFileArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceParseImportSourceFileArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluationSetServiceParseImportSourceFileArgs) field1Length() int {
+func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluationSetServiceParseImportSourceFileArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceParseImportSourceFileArgs)
+func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceUpdateEvaluationSetItemArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ParseImportSourceFileRequest
+	var _req *UpdateEvaluationSetItemRequest
 	if src.Req != nil {
-		_req = &ParseImportSourceFileRequest{}
+		_req = &UpdateEvaluationSetItemRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -21946,7 +30532,7 @@ func (p *EvaluationSetServiceParseImportSourceFileArgs) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *EvaluationSetServiceParseImportSourceFileResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21990,14 +30576,14 @@ func (p *EvaluationSetServiceParseImportSourceFileResult) FastRead(buf []byte) (
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceParseImportSourceFileResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceUpdateEvaluationSetItemResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceParseImportSourceFileResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewParseImportSourceFileResponse()
+	_field := NewUpdateEvaluationSetItemResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22007,11 +30593,11 @@ func (p *EvaluationSetServiceParseImportSourceFileResult) FastReadField0(buf []b
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceParseImportSourceFileResult) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceParseImportSourceFileResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -22020,7 +30606,7 @@ func (p *EvaluationSetServiceParseImportSourceFileResult) FastWriteNocopy(buf []
 	return offset
 }
 
-func (p *EvaluationSetServiceParseImportSourceFileResult) BLength() int {
+func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -22029,7 +30615,7 @@ func (p *EvaluationSetServiceParseImportSourceFileResult) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceParseImportSourceFileResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -22038,7 +30624,7 @@ func (p *EvaluationSetServiceParseImportSourceFileResult) fastWriteField0(buf []
 	return offset
 }
 
-func (p *EvaluationSetServiceParseImportSourceFileResult) field0Length() int {
+func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -22047,15 +30633,15 @@ func (p *EvaluationSetServiceParseImportSourceFileResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluationSetServiceParseImportSourceFileResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceParseImportSourceFileResult)
+func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceUpdateEvaluationSetItemResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ParseImportSourceFileResponse
+	var _success *UpdateEvaluationSetItemResponse
 	if src.Success != nil {
-		_success = &ParseImportSourceFileResponse{}
+		_success = &UpdateEvaluationSetItemResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -22065,7 +30651,7 @@ func (p *EvaluationSetServiceParseImportSourceFileResult) DeepCopy(s interface{}
 	return nil
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22109,14 +30695,14 @@ func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) FastRead(buf []byte
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceCreateEvaluationSetVersionArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateEvaluationSetVersionRequest()
+	_field := NewBatchDeleteEvaluationSetItemsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22126,11 +30712,11 @@ func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) FastReadField1(buf
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -22139,7 +30725,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) BLength() int {
+func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -22148,29 +30734,29 @@ func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) field1Length() int {
+func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceCreateEvaluationSetVersionArgs)
+func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CreateEvaluationSetVersionRequest
+	var _req *BatchDeleteEvaluationSetItemsRequest
 	if src.Req != nil {
-		_req = &CreateEvaluationSetVersionRequest{}
+		_req = &BatchDeleteEvaluationSetItemsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -22180,7 +30766,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetVersionArgs) DeepCopy(s interfac
 	return nil
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22224,14 +30810,14 @@ func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) FastRead(buf []by
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceCreateEvaluationSetVersionResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceBatchDeleteEvaluationSetItemsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateEvaluationSetVersionResponse()
+	_field := NewBatchDeleteEvaluationSetItemsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22241,11 +30827,11 @@ func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) FastReadField0(bu
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -22254,7 +30840,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) FastWriteNocopy(b
 	return offset
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) BLength() int {
+func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -22263,7 +30849,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -22272,7 +30858,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) fastWriteField0(b
 	return offset
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) field0Length() int {
+func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -22281,15 +30867,15 @@ func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) field0Length() in
 	return l
 }
 
-func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceCreateEvaluationSetVersionResult)
+func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceBatchDeleteEvaluationSetItemsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CreateEvaluationSetVersionResponse
+	var _success *BatchDeleteEvaluationSetItemsResponse
 	if src.Success != nil {
-		_success = &CreateEvaluationSetVersionResponse{}
+		_success = &BatchDeleteEvaluationSetItemsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -22299,7 +30885,7 @@ func (p *EvaluationSetServiceCreateEvaluationSetVersionResult) DeepCopy(s interf
 	return nil
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22343,14 +30929,14 @@ func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) FastRead(buf []byte) (
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceGetEvaluationSetVersionArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceStartEvaluationSetDedupJobArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetEvaluationSetVersionRequest()
+	_field := NewStartEvaluationSetDedupJobRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22360,11 +30946,11 @@ func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) FastReadField1(buf []b
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -22373,7 +30959,7 @@ func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) FastWriteNocopy(buf []
 	return offset
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) BLength() int {
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -22382,29 +30968,29 @@ func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) field1Length() int {
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceGetEvaluationSetVersionArgs)
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceStartEvaluationSetDedupJobArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *GetEvaluationSetVersionRequest
+	var _req *StartEvaluationSetDedupJobRequest
 	if src.Req != nil {
-		_req = &GetEvaluationSetVersionRequest{}
+		_req = &StartEvaluationSetDedupJobRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -22414,7 +31000,7 @@ func (p *EvaluationSetServiceGetEvaluationSetVersionArgs) DeepCopy(s interface{}
 	return nil
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetVersionResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22458,14 +31044,14 @@ func (p *EvaluationSetServiceGetEvaluationSetVersionResult) FastRead(buf []byte)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceGetEvaluationSetVersionResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceStartEvaluationSetDedupJobResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetVersionResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetEvaluationSetVersionResponse()
+	_field := NewStartEvaluationSetDedupJobResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22475,11 +31061,11 @@ func (p *EvaluationSetServiceGetEvaluationSetVersionResult) FastReadField0(buf [
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetVersionResult) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetVersionResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -22488,7 +31074,7 @@ func (p *EvaluationSetServiceGetEvaluationSetVersionResult) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetVersionResult) BLength() int {
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -22497,7 +31083,7 @@ func (p *EvaluationSetServiceGetEvaluationSetVersionResult) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetVersionResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -22506,7 +31092,7 @@ func (p *EvaluationSetServiceGetEvaluationSetVersionResult) fastWriteField0(buf
 	return offset
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetVersionResult) field0Length() int {
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -22515,15 +31101,15 @@ func (p *EvaluationSetServiceGetEvaluationSetVersionResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluationSetServiceGetEvaluationSetVersionResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceGetEvaluationSetVersionResult)
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceStartEvaluationSetDedupJobResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetEvaluationSetVersionResponse
+	var _success *StartEvaluationSetDedupJobResponse
 	if src.Success != nil {
-		_success = &GetEvaluationSetVersionResponse{}
+		_success = &StartEvaluationSetDedupJobResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -22533,7 +31119,7 @@ func (p *EvaluationSetServiceGetEvaluationSetVersionResult) DeepCopy(s interface
 	return nil
 }
 
-func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22577,14 +31163,14 @@ func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) FastRead(buf []byte)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceListEvaluationSetVersionsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceGetEvaluationSetDedupJobArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListEvaluationSetVersionsRequest()
+	_field := NewGetEvaluationSetDedupJobRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22594,11 +31180,11 @@ func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) FastReadField1(buf [
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -22607,7 +31193,7 @@ func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) BLength() int {
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -22616,29 +31202,29 @@ func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) field1Length() int {
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceListEvaluationSetVersionsArgs)
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceGetEvaluationSetDedupJobArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListEvaluationSetVersionsRequest
+	var _req *GetEvaluationSetDedupJobRequest
 	if src.Req != nil {
-		_req = &ListEvaluationSetVersionsRequest{}
+		_req = &GetEvaluationSetDedupJobRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -22648,7 +31234,7 @@ func (p *EvaluationSetServiceListEvaluationSetVersionsArgs) DeepCopy(s interface
 	return nil
 }
 
-func (p *EvaluationSetServiceListEvaluationSetVersionsResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22692,14 +31278,14 @@ func (p *EvaluationSetServiceListEvaluationSetVersionsResult) FastRead(buf []byt
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceListEvaluationSetVersionsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceGetEvaluationSetDedupJobResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceListEvaluationSetVersionsResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListEvaluationSetVersionsResponse()
+	_field := NewGetEvaluationSetDedupJobResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22709,11 +31295,11 @@ func (p *EvaluationSetServiceListEvaluationSetVersionsResult) FastReadField0(buf
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceListEvaluationSetVersionsResult) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceListEvaluationSetVersionsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -22722,7 +31308,7 @@ func (p *EvaluationSetServiceListEvaluationSetVersionsResult) FastWriteNocopy(bu
 	return offset
 }
 
-func (p *EvaluationSetServiceListEvaluationSetVersionsResult) BLength() int {
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -22731,7 +31317,7 @@ func (p *EvaluationSetServiceListEvaluationSetVersionsResult) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceListEvaluationSetVersionsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -22740,7 +31326,7 @@ func (p *EvaluationSetServiceListEvaluationSetVersionsResult) fastWriteField0(bu
 	return offset
 }
 
-func (p *EvaluationSetServiceListEvaluationSetVersionsResult) field0Length() int {
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -22749,15 +31335,15 @@ func (p *EvaluationSetServiceListEvaluationSetVersionsResult) field0Length() int
 	return l
 }
 
-func (p *EvaluationSetServiceListEvaluationSetVersionsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceListEvaluationSetVersionsResult)
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceGetEvaluationSetDedupJobResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListEvaluationSetVersionsResponse
+	var _success *GetEvaluationSetDedupJobResponse
 	if src.Success != nil {
-		_success = &ListEvaluationSetVersionsResponse{}
+		_success = &GetEvaluationSetDedupJobResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -22767,7 +31353,7 @@ func (p *EvaluationSetServiceListEvaluationSetVersionsResult) DeepCopy(s interfa
 	return nil
 }
 
-func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22811,14 +31397,14 @@ func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) FastRead(buf []b
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceBatchGetEvaluationSetVersionsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceArchiveEvaluationSetDuplicatesArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetEvaluationSetVersionsRequest()
+	_field := NewArchiveEvaluationSetDuplicatesRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22828,11 +31414,11 @@ func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) FastReadField1(b
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -22841,7 +31427,7 @@ func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) FastWriteNocopy(
 	return offset
 }
 
-func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) BLength() int {
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -22850,29 +31436,29 @@ func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) field1Length() int {
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceBatchGetEvaluationSetVersionsArgs)
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceArchiveEvaluationSetDuplicatesArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchGetEvaluationSetVersionsRequest
+	var _req *ArchiveEvaluationSetDuplicatesRequest
 	if src.Req != nil {
-		_req = &BatchGetEvaluationSetVersionsRequest{}
+		_req = &ArchiveEvaluationSetDuplicatesRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -22882,7 +31468,7 @@ func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsArgs) DeepCopy(s inter
 	return nil
 }
 
-func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22926,14 +31512,14 @@ func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) FastRead(buf [
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceBatchGetEvaluationSetVersionsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceArchiveEvaluationSetDuplicatesResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetEvaluationSetVersionsResponse()
+	_field := NewArchiveEvaluationSetDuplicatesResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22943,11 +31529,11 @@ func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) FastReadField0
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -22956,7 +31542,7 @@ func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) FastWriteNocop
 	return offset
 }
 
-func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) BLength() int {
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -22965,7 +31551,7 @@ func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) BLength() int
 	return l
 }
 
-func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -22974,7 +31560,7 @@ func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) fastWriteField
 	return offset
 }
 
-func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) field0Length() int {
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -22983,15 +31569,15 @@ func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) field0Length()
 	return l
 }
 
-func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceBatchGetEvaluationSetVersionsResult)
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceArchiveEvaluationSetDuplicatesResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchGetEvaluationSetVersionsResponse
+	var _success *ArchiveEvaluationSetDuplicatesResponse
 	if src.Success != nil {
-		_success = &BatchGetEvaluationSetVersionsResponse{}
+		_success = &ArchiveEvaluationSetDuplicatesResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -23001,7 +31587,7 @@ func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) DeepCopy(s int
 	return nil
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -23045,14 +31631,14 @@ func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) FastRead(buf []byte)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceUpdateEvaluationSetSchemaArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceGenerateEvaluationSetItemsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateEvaluationSetSchemaRequest()
+	_field := NewGenerateEvaluationSetItemsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -23062,11 +31648,11 @@ func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) FastReadField1(buf [
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -23075,7 +31661,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) BLength() int {
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -23084,29 +31670,29 @@ func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) field1Length() int {
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceUpdateEvaluationSetSchemaArgs)
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceGenerateEvaluationSetItemsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *UpdateEvaluationSetSchemaRequest
+	var _req *GenerateEvaluationSetItemsRequest
 	if src.Req != nil {
-		_req = &UpdateEvaluationSetSchemaRequest{}
+		_req = &GenerateEvaluationSetItemsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -23116,7 +31702,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) DeepCopy(s interface
 	return nil
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -23160,14 +31746,14 @@ func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) FastRead(buf []byt
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceUpdateEvaluationSetSchemaResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceGenerateEvaluationSetItemsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateEvaluationSetSchemaResponse()
+	_field := NewGenerateEvaluationSetItemsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -23177,11 +31763,11 @@ func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) FastReadField0(buf
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -23190,7 +31776,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) FastWriteNocopy(bu
 	return offset
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) BLength() int {
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -23199,7 +31785,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -23208,7 +31794,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) fastWriteField0(bu
 	return offset
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) field0Length() int {
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -23217,15 +31803,15 @@ func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) field0Length() int
 	return l
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceUpdateEvaluationSetSchemaResult)
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceGenerateEvaluationSetItemsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpdateEvaluationSetSchemaResponse
+	var _success *GenerateEvaluationSetItemsResponse
 	if src.Success != nil {
-		_success = &UpdateEvaluationSetSchemaResponse{}
+		_success = &GenerateEvaluationSetItemsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -23235,7 +31821,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetSchemaResult) DeepCopy(s interfa
 	return nil
 }
 
-func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -23279,14 +31865,14 @@ func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) FastRead(buf []b
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceBatchCreateEvaluationSetItemsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceListGeneratedEvaluationSetItemsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchCreateEvaluationSetItemsRequest()
+	_field := NewListGeneratedEvaluationSetItemsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -23296,11 +31882,11 @@ func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) FastReadField1(b
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -23309,7 +31895,7 @@ func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) FastWriteNocopy(
 	return offset
 }
 
-func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) BLength() int {
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -23318,29 +31904,29 @@ func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) field1Length() int {
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceBatchCreateEvaluationSetItemsArgs)
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceListGeneratedEvaluationSetItemsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchCreateEvaluationSetItemsRequest
+	var _req *ListGeneratedEvaluationSetItemsRequest
 	if src.Req != nil {
-		_req = &BatchCreateEvaluationSetItemsRequest{}
+		_req = &ListGeneratedEvaluationSetItemsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -23350,7 +31936,7 @@ func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsArgs) DeepCopy(s inter
 	return nil
 }
 
-func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -23394,14 +31980,14 @@ func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) FastRead(buf [
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceBatchCreateEvaluationSetItemsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceListGeneratedEvaluationSetItemsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchCreateEvaluationSetItemsResponse()
+	_field := NewListGeneratedEvaluationSetItemsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -23411,11 +31997,11 @@ func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) FastReadField0
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -23424,7 +32010,7 @@ func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) FastWriteNocop
 	return offset
 }
 
-func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) BLength() int {
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -23433,7 +32019,7 @@ func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) BLength() int
 	return l
 }
 
-func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -23442,7 +32028,7 @@ func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) fastWriteField
 	return offset
 }
 
-func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) field0Length() int {
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -23451,15 +32037,15 @@ func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) field0Length()
 	return l
 }
 
-func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceBatchCreateEvaluationSetItemsResult)
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceListGeneratedEvaluationSetItemsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchCreateEvaluationSetItemsResponse
+	var _success *ListGeneratedEvaluationSetItemsResponse
 	if src.Success != nil {
-		_success = &BatchCreateEvaluationSetItemsResponse{}
+		_success = &ListGeneratedEvaluationSetItemsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -23469,7 +32055,7 @@ func (p *EvaluationSetServiceBatchCreateEvaluationSetItemsResult) DeepCopy(s int
 	return nil
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -23513,14 +32099,14 @@ func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) FastRead(buf []byte) (
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceUpdateEvaluationSetItemArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateEvaluationSetItemRequest()
+	_field := NewReviewGeneratedEvaluationSetItemsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -23530,11 +32116,11 @@ func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) FastReadField1(buf []b
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -23543,7 +32129,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) FastWriteNocopy(buf []
 	return offset
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) BLength() int {
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -23552,29 +32138,29 @@ func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) field1Length() int {
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceUpdateEvaluationSetItemArgs)
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *UpdateEvaluationSetItemRequest
+	var _req *ReviewGeneratedEvaluationSetItemsRequest
 	if src.Req != nil {
-		_req = &UpdateEvaluationSetItemRequest{}
+		_req = &ReviewGeneratedEvaluationSetItemsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -23584,7 +32170,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetItemArgs) DeepCopy(s interface{}
 	return nil
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -23628,14 +32214,14 @@ func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) FastRead(buf []byte)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceUpdateEvaluationSetItemResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceReviewGeneratedEvaluationSetItemsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateEvaluationSetItemResponse()
+	_field := NewReviewGeneratedEvaluationSetItemsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -23645,11 +32231,11 @@ func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) FastReadField0(buf [
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -23658,7 +32244,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) BLength() int {
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -23667,7 +32253,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -23676,7 +32262,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) fastWriteField0(buf
 	return offset
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) field0Length() int {
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -23685,15 +32271,15 @@ func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceUpdateEvaluationSetItemResult)
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceReviewGeneratedEvaluationSetItemsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpdateEvaluationSetItemResponse
+	var _success *ReviewGeneratedEvaluationSetItemsResponse
 	if src.Success != nil {
-		_success = &UpdateEvaluationSetItemResponse{}
+		_success = &ReviewGeneratedEvaluationSetItemsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -23703,7 +32289,7 @@ func (p *EvaluationSetServiceUpdateEvaluationSetItemResult) DeepCopy(s interface
 	return nil
 }
 
-func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -23747,14 +32333,14 @@ func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) FastRead(buf []b
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceGetEvaluationSetItemLineageArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchDeleteEvaluationSetItemsRequest()
+	_field := NewGetEvaluationSetItemLineageRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -23764,11 +32350,11 @@ func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) FastReadField1(b
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -23777,7 +32363,7 @@ func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) FastWriteNocopy(
 	return offset
 }
 
-func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) BLength() int {
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -23786,29 +32372,29 @@ func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) field1Length() int {
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs)
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceGetEvaluationSetItemLineageArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchDeleteEvaluationSetItemsRequest
+	var _req *GetEvaluationSetItemLineageRequest
 	if src.Req != nil {
-		_req = &BatchDeleteEvaluationSetItemsRequest{}
+		_req = &GetEvaluationSetItemLineageRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -23818,7 +32404,7 @@ func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsArgs) DeepCopy(s inter
 	return nil
 }
 
-func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -23862,14 +32448,14 @@ func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) FastRead(buf [
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceBatchDeleteEvaluationSetItemsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationSetServiceGetEvaluationSetItemLineageResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchDeleteEvaluationSetItemsResponse()
+	_field := NewGetEvaluationSetItemLineageResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -23879,11 +32465,11 @@ func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) FastReadField0
 	return offset, nil
 }
 
-func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) FastWrite(buf []byte) int {
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -23892,7 +32478,7 @@ func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) FastWriteNocop
 	return offset
 }
 
-func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) BLength() int {
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -23901,7 +32487,7 @@ func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) BLength() int
 	return l
 }
 
-func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -23910,7 +32496,7 @@ func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) fastWriteField
 	return offset
 }
 
-func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) field0Length() int {
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -23919,15 +32505,15 @@ func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) field0Length()
 	return l
 }
 
-func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluationSetServiceBatchDeleteEvaluationSetItemsResult)
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluationSetServiceGetEvaluationSetItemLineageResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchDeleteEvaluationSetItemsResponse
+	var _success *GetEvaluationSetItemLineageResponse
 	if src.Success != nil {
-		_success = &BatchDeleteEvaluationSetItemsResponse{}
+		_success = &GetEvaluationSetItemLineageResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -26607,6 +35193,22 @@ func (p *EvaluationSetServiceBatchGetEvaluationSetVersionsResult) GetResult() in
 	return p.Success
 }
 
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *EvaluationSetServiceDiffEvaluationSetVersionsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *EvaluationSetServiceMergeEvaluationSetVersionArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *EvaluationSetServiceMergeEvaluationSetVersionResult) GetResult() interface{} {
+	return p.Success
+}
+
 func (p *EvaluationSetServiceUpdateEvaluationSetSchemaArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
@@ -26639,6 +35241,62 @@ func (p *EvaluationSetServiceBatchDeleteEvaluationSetItemsResult) GetResult() in
 	return p.Success
 }
 
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *EvaluationSetServiceStartEvaluationSetDedupJobResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetDedupJobResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *EvaluationSetServiceArchiveEvaluationSetDuplicatesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *EvaluationSetServiceGenerateEvaluationSetItemsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *EvaluationSetServiceListGeneratedEvaluationSetItemsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *EvaluationSetServiceReviewGeneratedEvaluationSetItemsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *EvaluationSetServiceGetEvaluationSetItemLineageResult) GetResult() interface{} {
+	return p.Success
+}
+
 func (p *EvaluationSetServiceListEvaluationSetItemsArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
@@ -23,10 +23,19 @@ type Client interface {
 	GetEvaluationSetVersion(ctx context.Context, req *eval_set.GetEvaluationSetVersionRequest, callOptions ...callopt.Option) (r *eval_set.GetEvaluationSetVersionResponse, err error)
 	ListEvaluationSetVersions(ctx context.Context, req *eval_set.ListEvaluationSetVersionsRequest, callOptions ...callopt.Option) (r *eval_set.ListEvaluationSetVersionsResponse, err error)
 	BatchGetEvaluationSetVersions(ctx context.Context, req *eval_set.BatchGetEvaluationSetVersionsRequest, callOptions ...callopt.Option) (r *eval_set.BatchGetEvaluationSetVersionsResponse, err error)
+	DiffEvaluationSetVersions(ctx context.Context, req *eval_set.DiffEvaluationSetVersionsRequest, callOptions ...callopt.Option) (r *eval_set.DiffEvaluationSetVersionsResponse, err error)
+	MergeEvaluationSetVersion(ctx context.Context, req *eval_set.MergeEvaluationSetVersionRequest, callOptions ...callopt.Option) (r *eval_set.MergeEvaluationSetVersionResponse, err error)
 	UpdateEvaluationSetSchema(ctx context.Context, req *eval_set.UpdateEvaluationSetSchemaRequest, callOptions ...callopt.Option) (r *eval_set.UpdateEvaluationSetSchemaResponse, err error)
 	BatchCreateEvaluationSetItems(ctx context.Context, req *eval_set.BatchCreateEvaluationSetItemsRequest, callOptions ...callopt.Option) (r *eval_set.BatchCreateEvaluationSetItemsResponse, err error)
 	UpdateEvaluationSetItem(ctx context.Context, req *eval_set.UpdateEvaluationSetItemRequest, callOptions ...callopt.Option) (r *eval_set.UpdateEvaluationSetItemResponse, err error)
 	BatchDeleteEvaluationSetItems(ctx context.Context, req *eval_set.BatchDeleteEvaluationSetItemsRequest, callOptions ...callopt.Option) (r *eval_set.BatchDeleteEvaluationSetItemsResponse, err error)
+	StartEvaluationSetDedupJob(ctx context.Context, req *eval_set.StartEvaluationSetDedupJobRequest, callOptions ...callopt.Option) (r *eval_set.StartEvaluationSetDedupJobResponse, err error)
+	GetEvaluationSetDedupJob(ctx context.Context, req *eval_set.GetEvaluationSetDedupJobRequest, callOptions ...callopt.Option) (r *eval_set.GetEvaluationSetDedupJobResponse, err error)
+	ArchiveEvaluationSetDuplicates(ctx context.Context, req *eval_set.ArchiveEvaluationSetDuplicatesRequest, callOptions ...callopt.Option) (r *eval_set.ArchiveEvaluationSetDuplicatesResponse, err error)
+	GenerateEvaluationSetItems(ctx context.Context, req *eval_set.GenerateEvaluationSetItemsRequest, callOptions ...callopt.Option) (r *eval_set.GenerateEvaluationSetItemsResponse, err error)
+	ListGeneratedEvaluationSetItems(ctx context.Context, req *eval_set.ListGeneratedEvaluationSetItemsRequest, callOptions ...callopt.Option) (r *eval_set.ListGeneratedEvaluationSetItemsResponse, err error)
+	ReviewGeneratedEvaluationSetItems(ctx context.Context, req *eval_set.ReviewGeneratedEvaluationSetItemsRequest, callOptions ...callopt.Option) (r *eval_set.ReviewGeneratedEvaluationSetItemsResponse, err error)
+	GetEvaluationSetItemLineage(ctx context.Context, req *eval_set.GetEvaluationSetItemLineageRequest, callOptions ...callopt.Option) (r *eval_set.GetEvaluationSetItemLineageResponse, err error)
 	ListEvaluationSetItems(ctx context.Context, req *eval_set.ListEvaluationSetItemsRequest, callOptions ...callopt.Option) (r *eval_set.ListEvaluationSetItemsResponse, err error)
 	BatchGetEvaluationSetItems(ctx context.Context, req *eval_set.BatchGetEvaluationSetItemsRequest, callOptions ...callopt.Option) (r *eval_set.BatchGetEvaluationSetItemsResponse, err error)
 	BatchAddExistEvaluationSetItems(ctx context.Context, req *eval_set.BatchAddExistEvaluationSetItemsRequest, callOptions ...callopt.Option) (r *eval_set.BatchAddExistEvaluationSetItemsResponse, err error)
@@ -129,6 +138,16 @@ func (p *kEvaluationSetServiceClient) BatchGetEvaluationSetVersions(ctx context.
 	return p.kClient.BatchGetEvaluationSetVersions(ctx, req)
 }
 
+func (p *kEvaluationSetServiceClient) DiffEvaluationSetVersions(ctx context.Context, req *eval_set.DiffEvaluationSetVersionsRequest, callOptions ...callopt.Option) (r *eval_set.DiffEvaluationSetVersionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DiffEvaluationSetVersions(ctx, req)
+}
+
+func (p *kEvaluationSetServiceClient) MergeEvaluationSetVersion(ctx context.Context, req *eval_set.MergeEvaluationSetVersionRequest, callOptions ...callopt.Option) (r *eval_set.MergeEvaluationSetVersionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MergeEvaluationSetVersion(ctx, req)
+}
+
 func (p *kEvaluationSetServiceClient) UpdateEvaluationSetSchema(ctx context.Context, req *eval_set.UpdateEvaluationSetSchemaRequest, callOptions ...callopt.Option) (r *eval_set.UpdateEvaluationSetSchemaResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.UpdateEvaluationSetSchema(ctx, req)
@@ -149,6 +168,41 @@ func (p *kEvaluationSetServiceClient) BatchDeleteEvaluationSetItems(ctx context.
 	return p.kClient.BatchDeleteEvaluationSetItems(ctx, req)
 }
 
+func (p *kEvaluationSetServiceClient) StartEvaluationSetDedupJob(ctx context.Context, req *eval_set.StartEvaluationSetDedupJobRequest, callOptions ...callopt.Option) (r *eval_set.StartEvaluationSetDedupJobResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.StartEvaluationSetDedupJob(ctx, req)
+}
+
+func (p *kEvaluationSetServiceClient) GetEvaluationSetDedupJob(ctx context.Context, req *eval_set.GetEvaluationSetDedupJobRequest, callOptions ...callopt.Option) (r *eval_set.GetEvaluationSetDedupJobResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetEvaluationSetDedupJob(ctx, req)
+}
+
+func (p *kEvaluationSetServiceClient) ArchiveEvaluationSetDuplicates(ctx context.Context, req *eval_set.ArchiveEvaluationSetDuplicatesRequest, callOptions ...callopt.Option) (r *eval_set.ArchiveEvaluationSetDuplicatesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ArchiveEvaluationSetDuplicates(ctx, req)
+}
+
+func (p *kEvaluationSetServiceClient) GenerateEvaluationSetItems(ctx context.Context, req *eval_set.GenerateEvaluationSetItemsRequest, callOptions ...callopt.Option) (r *eval_set.GenerateEvaluationSetItemsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GenerateEvaluationSetItems(ctx, req)
+}
+
+func (p *kEvaluationSetServiceClient) ListGeneratedEvaluationSetItems(ctx context.Context, req *eval_set.ListGeneratedEvaluationSetItemsRequest, callOptions ...callopt.Option) (r *eval_set.ListGeneratedEvaluationSetItemsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListGeneratedEvaluationSetItems(ctx, req)
+}
+
+func (p *kEvaluationSetServiceClient) ReviewGeneratedEvaluationSetItems(ctx context.Context, req *eval_set.ReviewGeneratedEvaluationSetItemsRequest, callOptions ...callopt.Option) (r *eval_set.ReviewGeneratedEvaluationSetItemsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReviewGeneratedEvaluationSetItems(ctx, req)
+}
+
+func (p *kEvaluationSetServiceClient) GetEvaluationSetItemLineage(ctx context.Context, req *eval_set.GetEvaluationSetItemLineageRequest, callOptions ...callopt.Option) (r *eval_set.GetEvaluationSetItemLineageResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetEvaluationSetItemLineage(ctx, req)
+}
+
 func (p *kEvaluationSetServiceClient) ListEvaluationSetItems(ctx context.Context, req *eval_set.ListEvaluationSetItemsRequest, callOptions ...callopt.Option) (r *eval_set.ListEvaluationSetItemsResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ListEvaluationSetItems(ctx, req)
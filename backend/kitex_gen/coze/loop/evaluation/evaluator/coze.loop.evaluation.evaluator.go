@@ -8845,12 +8845,14 @@ func (p *BatchGetEvaluatorVersionIDsResponse) Field255DeepEqual(src *base.BaseRe
 }
 
 type SubmitEvaluatorVersionRequest struct {
-	WorkspaceID int64      `thrift:"workspace_id,1,required" frugal:"1,required,i64" json:"workspace_id" form:"workspace_id,required" `
-	EvaluatorID int64      `thrift:"evaluator_id,2,required" frugal:"2,required,i64" json:"evaluator_id" path:"evaluator_id,required" `
-	Version     string     `thrift:"version,3,required" frugal:"3,required,string" form:"version,required" json:"version,required"`
-	Description *string    `thrift:"description,4,optional" frugal:"4,optional,string" form:"description" json:"description,omitempty"`
-	Cid         *string    `thrift:"cid,100,optional" frugal:"100,optional,string" form:"cid" json:"cid,omitempty"`
-	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+	WorkspaceID int64   `thrift:"workspace_id,1,required" frugal:"1,required,i64" json:"workspace_id" form:"workspace_id,required" `
+	EvaluatorID int64   `thrift:"evaluator_id,2,required" frugal:"2,required,i64" json:"evaluator_id" path:"evaluator_id,required" `
+	Version     string  `thrift:"version,3,required" frugal:"3,required,string" form:"version,required" json:"version,required"`
+	Description *string `thrift:"description,4,optional" frugal:"4,optional,string" form:"description" json:"description,omitempty"`
+	// 提交前的回归检查, 为空时按评估器已配置的晋级策略检查
+	PromotionCheck *evaluator.EvaluatorPromotionCheckConf `thrift:"promotion_check,5,optional" frugal:"5,optional,evaluator.EvaluatorPromotionCheckConf" form:"promotion_check" json:"promotion_check,omitempty"`
+	Cid            *string                                `thrift:"cid,100,optional" frugal:"100,optional,string" form:"cid" json:"cid,omitempty"`
+	Base           *base.Base                             `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
 func NewSubmitEvaluatorVersionRequest() *SubmitEvaluatorVersionRequest {
@@ -8893,6 +8895,18 @@ func (p *SubmitEvaluatorVersionRequest) GetDescription() (v string) {
 	return *p.Description
 }
 
+var SubmitEvaluatorVersionRequest_PromotionCheck_DEFAULT *evaluator.EvaluatorPromotionCheckConf
+
+func (p *SubmitEvaluatorVersionRequest) GetPromotionCheck() (v *evaluator.EvaluatorPromotionCheckConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPromotionCheck() {
+		return SubmitEvaluatorVersionRequest_PromotionCheck_DEFAULT
+	}
+	return p.PromotionCheck
+}
+
 var SubmitEvaluatorVersionRequest_Cid_DEFAULT string
 
 func (p *SubmitEvaluatorVersionRequest) GetCid() (v string) {
@@ -8928,6 +8942,9 @@ func (p *SubmitEvaluatorVersionRequest) SetVersion(val string) {
 func (p *SubmitEvaluatorVersionRequest) SetDescription(val *string) {
 	p.Description = val
 }
+func (p *SubmitEvaluatorVersionRequest) SetPromotionCheck(val *evaluator.EvaluatorPromotionCheckConf) {
+	p.PromotionCheck = val
+}
 func (p *SubmitEvaluatorVersionRequest) SetCid(val *string) {
 	p.Cid = val
 }
@@ -8940,6 +8957,7 @@ var fieldIDToName_SubmitEvaluatorVersionRequest = map[int16]string{
 	2:   "evaluator_id",
 	3:   "version",
 	4:   "description",
+	5:   "promotion_check",
 	100: "cid",
 	255: "Base",
 }
@@ -8948,6 +8966,10 @@ func (p *SubmitEvaluatorVersionRequest) IsSetDescription() bool {
 	return p.Description != nil
 }
 
+func (p *SubmitEvaluatorVersionRequest) IsSetPromotionCheck() bool {
+	return p.PromotionCheck != nil
+}
+
 func (p *SubmitEvaluatorVersionRequest) IsSetCid() bool {
 	return p.Cid != nil
 }
@@ -9012,6 +9034,14 @@ func (p *SubmitEvaluatorVersionRequest) Read(iprot thrift.TProtocol) (err error)
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 100:
 			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField100(iprot); err != nil {
@@ -9117,6 +9147,14 @@ func (p *SubmitEvaluatorVersionRequest) ReadField4(iprot thrift.TProtocol) error
 	p.Description = _field
 	return nil
 }
+func (p *SubmitEvaluatorVersionRequest) ReadField5(iprot thrift.TProtocol) error {
+	_field := evaluator.NewEvaluatorPromotionCheckConf()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.PromotionCheck = _field
+	return nil
+}
 func (p *SubmitEvaluatorVersionRequest) ReadField100(iprot thrift.TProtocol) error {
 
 	var _field *string
@@ -9159,6 +9197,10 @@ func (p *SubmitEvaluatorVersionRequest) Write(oprot thrift.TProtocol) (err error
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 		if err = p.writeField100(oprot); err != nil {
 			fieldId = 100
 			goto WriteFieldError
@@ -9251,6 +9293,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
+func (p *SubmitEvaluatorVersionRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPromotionCheck() {
+		if err = oprot.WriteFieldBegin("promotion_check", thrift.STRUCT, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.PromotionCheck.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
 func (p *SubmitEvaluatorVersionRequest) writeField100(oprot thrift.TProtocol) (err error) {
 	if p.IsSetCid() {
 		if err = oprot.WriteFieldBegin("cid", thrift.STRING, 100); err != nil {
@@ -9314,6 +9374,9 @@ func (p *SubmitEvaluatorVersionRequest) DeepEqual(ano *SubmitEvaluatorVersionReq
 	if !p.Field4DeepEqual(ano.Description) {
 		return false
 	}
+	if !p.Field5DeepEqual(ano.PromotionCheck) {
+		return false
+	}
 	if !p.Field100DeepEqual(ano.Cid) {
 		return false
 	}
@@ -9356,6 +9419,13 @@ func (p *SubmitEvaluatorVersionRequest) Field4DeepEqual(src *string) bool {
 	}
 	return true
 }
+func (p *SubmitEvaluatorVersionRequest) Field5DeepEqual(src *evaluator.EvaluatorPromotionCheckConf) bool {
+
+	if !p.PromotionCheck.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *SubmitEvaluatorVersionRequest) Field100DeepEqual(src *string) bool {
 
 	if p.Cid == src {
@@ -9378,7 +9448,9 @@ func (p *SubmitEvaluatorVersionRequest) Field255DeepEqual(src *base.Base) bool {
 
 type SubmitEvaluatorVersionResponse struct {
 	Evaluator *evaluator.Evaluator `thrift:"evaluator,1,optional" frugal:"1,optional,evaluator.Evaluator" form:"evaluator" json:"evaluator,omitempty"`
-	BaseResp  *base.BaseResp       `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+	// 回归检查报告, 未执行检查时为空; 检查结论为阻断时提交失败并返回错误
+	PromotionReport *evaluator.EvaluatorPromotionReport `thrift:"promotion_report,2,optional" frugal:"2,optional,evaluator.EvaluatorPromotionReport" form:"promotion_report" json:"promotion_report,omitempty"`
+	BaseResp        *base.BaseResp                      `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
 }
 
 func NewSubmitEvaluatorVersionResponse() *SubmitEvaluatorVersionResponse {
@@ -9400,6 +9472,18 @@ func (p *SubmitEvaluatorVersionResponse) GetEvaluator() (v *evaluator.Evaluator)
 	return p.Evaluator
 }
 
+var SubmitEvaluatorVersionResponse_PromotionReport_DEFAULT *evaluator.EvaluatorPromotionReport
+
+func (p *SubmitEvaluatorVersionResponse) GetPromotionReport() (v *evaluator.EvaluatorPromotionReport) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPromotionReport() {
+		return SubmitEvaluatorVersionResponse_PromotionReport_DEFAULT
+	}
+	return p.PromotionReport
+}
+
 var SubmitEvaluatorVersionResponse_BaseResp_DEFAULT *base.BaseResp
 
 func (p *SubmitEvaluatorVersionResponse) GetBaseResp() (v *base.BaseResp) {
@@ -9414,12 +9498,16 @@ func (p *SubmitEvaluatorVersionResponse) GetBaseResp() (v *base.BaseResp) {
 func (p *SubmitEvaluatorVersionResponse) SetEvaluator(val *evaluator.Evaluator) {
 	p.Evaluator = val
 }
+func (p *SubmitEvaluatorVersionResponse) SetPromotionReport(val *evaluator.EvaluatorPromotionReport) {
+	p.PromotionReport = val
+}
 func (p *SubmitEvaluatorVersionResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
 var fieldIDToName_SubmitEvaluatorVersionResponse = map[int16]string{
 	1:   "evaluator",
+	2:   "promotion_report",
 	255: "BaseResp",
 }
 
@@ -9427,6 +9515,10 @@ func (p *SubmitEvaluatorVersionResponse) IsSetEvaluator() bool {
 	return p.Evaluator != nil
 }
 
+func (p *SubmitEvaluatorVersionResponse) IsSetPromotionReport() bool {
+	return p.PromotionReport != nil
+}
+
 func (p *SubmitEvaluatorVersionResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
@@ -9457,6 +9549,14 @@ func (p *SubmitEvaluatorVersionResponse) Read(iprot thrift.TProtocol) (err error
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -9502,6 +9602,14 @@ func (p *SubmitEvaluatorVersionResponse) ReadField1(iprot thrift.TProtocol) erro
 	p.Evaluator = _field
 	return nil
 }
+func (p *SubmitEvaluatorVersionResponse) ReadField2(iprot thrift.TProtocol) error {
+	_field := evaluator.NewEvaluatorPromotionReport()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.PromotionReport = _field
+	return nil
+}
 func (p *SubmitEvaluatorVersionResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
@@ -9521,6 +9629,10 @@ func (p *SubmitEvaluatorVersionResponse) Write(oprot thrift.TProtocol) (err erro
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -9561,6 +9673,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *SubmitEvaluatorVersionResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPromotionReport() {
+		if err = oprot.WriteFieldBegin("promotion_report", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.PromotionReport.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
 func (p *SubmitEvaluatorVersionResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
@@ -9595,6 +9725,9 @@ func (p *SubmitEvaluatorVersionResponse) DeepEqual(ano *SubmitEvaluatorVersionRe
 	if !p.Field1DeepEqual(ano.Evaluator) {
 		return false
 	}
+	if !p.Field2DeepEqual(ano.PromotionReport) {
+		return false
+	}
 	if !p.Field255DeepEqual(ano.BaseResp) {
 		return false
 	}
@@ -9608,6 +9741,13 @@ func (p *SubmitEvaluatorVersionResponse) Field1DeepEqual(src *evaluator.Evaluato
 	}
 	return true
 }
+func (p *SubmitEvaluatorVersionResponse) Field2DeepEqual(src *evaluator.EvaluatorPromotionReport) bool {
+
+	if !p.PromotionReport.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *SubmitEvaluatorVersionResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
@@ -25433,482 +25573,3574 @@ func (p *ListEvaluatorTagsResponse) Field255DeepEqual(src *base.BaseResp) bool {
 	return true
 }
 
-type EvaluatorService interface {
-	// 评估器
-	ListEvaluators(ctx context.Context, request *ListEvaluatorsRequest) (r *ListEvaluatorsResponse, err error)
-
-	BatchGetEvaluators(ctx context.Context, request *BatchGetEvaluatorsRequest) (r *BatchGetEvaluatorsResponse, err error)
-
-	GetEvaluator(ctx context.Context, request *GetEvaluatorRequest) (r *GetEvaluatorResponse, err error)
-
-	CreateEvaluator(ctx context.Context, request *CreateEvaluatorRequest) (r *CreateEvaluatorResponse, err error)
-
-	UpdateEvaluator(ctx context.Context, request *UpdateEvaluatorRequest) (r *UpdateEvaluatorResponse, err error)
-
-	UpdateEvaluatorDraft(ctx context.Context, request *UpdateEvaluatorDraftRequest) (r *UpdateEvaluatorDraftResponse, err error)
-
-	DeleteEvaluator(ctx context.Context, request *DeleteEvaluatorRequest) (r *DeleteEvaluatorResponse, err error)
-
-	CheckEvaluatorName(ctx context.Context, request *CheckEvaluatorNameRequest) (r *CheckEvaluatorNameResponse, err error)
-	// 评估器版本
-	ListEvaluatorVersions(ctx context.Context, request *ListEvaluatorVersionsRequest) (r *ListEvaluatorVersionsResponse, err error)
-
-	GetEvaluatorVersion(ctx context.Context, request *GetEvaluatorVersionRequest) (r *GetEvaluatorVersionResponse, err error)
-
-	BatchGetEvaluatorVersions(ctx context.Context, request *BatchGetEvaluatorVersionsRequest) (r *BatchGetEvaluatorVersionsResponse, err error)
-	// 按 evaluator_id + version 对批量查询 evaluator_version_id（仅 RPC）
-	BatchGetEvaluatorVersionIDs(ctx context.Context, request *BatchGetEvaluatorVersionIDsRequest) (r *BatchGetEvaluatorVersionIDsResponse, err error)
-
-	SubmitEvaluatorVersion(ctx context.Context, request *SubmitEvaluatorVersionRequest) (r *SubmitEvaluatorVersionResponse, err error)
-	// 评估器预置模版
-	ListTemplates(ctx context.Context, request *ListTemplatesRequest) (r *ListTemplatesResponse, err error)
-
-	GetTemplateInfo(ctx context.Context, request *GetTemplateInfoRequest) (r *GetTemplateInfoResponse, err error)
-
-	GetDefaultPromptEvaluatorTools(ctx context.Context, req *GetDefaultPromptEvaluatorToolsRequest) (r *GetDefaultPromptEvaluatorToolsResponse, err error)
-	// 评估器执行
-	RunEvaluator(ctx context.Context, req *RunEvaluatorRequest) (r *RunEvaluatorResponse, err error)
-
-	DebugEvaluator(ctx context.Context, req *DebugEvaluatorRequest) (r *DebugEvaluatorResponse, err error)
-
-	BatchDebugEvaluator(ctx context.Context, req *BatchDebugEvaluatorRequest) (r *BatchDebugEvaluatorResponse, err error)
-
-	AsyncRunEvaluator(ctx context.Context, req *AsyncRunEvaluatorRequest) (r *AsyncRunEvaluatorResponse, err error)
-
-	AsyncDebugEvaluator(ctx context.Context, req *AsyncDebugEvaluatorRequest) (r *AsyncDebugEvaluatorResponse, err error)
-	// 评估器执行结果
-	UpdateEvaluatorRecord(ctx context.Context, req *UpdateEvaluatorRecordRequest) (r *UpdateEvaluatorRecordResponse, err error)
-
-	GetEvaluatorRecord(ctx context.Context, req *GetEvaluatorRecordRequest) (r *GetEvaluatorRecordResponse, err error)
-
-	BatchGetEvaluatorRecords(ctx context.Context, req *BatchGetEvaluatorRecordsRequest) (r *BatchGetEvaluatorRecordsResponse, err error)
-	// 评估器验证
-	ValidateEvaluator(ctx context.Context, request *ValidateEvaluatorRequest) (r *ValidateEvaluatorResponse, err error)
-	// 查询评估器模板
-	ListTemplatesV2(ctx context.Context, request *ListTemplatesV2Request) (r *ListTemplatesV2Response, err error)
+type RunEvaluatorCalibrationRequest struct {
+	WorkspaceID        int64 `thrift:"workspace_id,1,required" frugal:"1,required,i64" json:"workspace_id" form:"workspace_id,required" `
+	EvaluatorVersionID int64 `thrift:"evaluator_version_id,2,required" frugal:"2,required,i64" json:"evaluator_version_id" path:"evaluator_version_id,required" `
+	// 带人工真值字段的评测集
+	EvaluationSetID int64 `thrift:"evaluation_set_id,3,required" frugal:"3,required,i64" json:"evaluation_set_id" form:"evaluation_set_id,required" `
+	// 为空时使用草稿
+	EvaluationSetVersionID *int64                              `thrift:"evaluation_set_version_id,4,optional" frugal:"4,optional,i64" json:"evaluation_set_version_id" form:"evaluation_set_version_id" `
+	Conf                   *evaluator.EvaluatorCalibrationConf `thrift:"conf,5,required" frugal:"5,required,evaluator.EvaluatorCalibrationConf" form:"conf,required" json:"conf,required"`
+	Base                   *base.Base                          `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
 
-	GetTemplateV2(ctx context.Context, request *GetTemplateV2Request) (r *GetTemplateV2Response, err error)
-	// 创建评估器模板
-	CreateEvaluatorTemplate(ctx context.Context, request *CreateEvaluatorTemplateRequest) (r *CreateEvaluatorTemplateResponse, err error)
-	// 更新评估器模板
-	UpdateEvaluatorTemplate(ctx context.Context, request *UpdateEvaluatorTemplateRequest) (r *UpdateEvaluatorTemplateResponse, err error)
-	// 删除
-	DeleteEvaluatorTemplate(ctx context.Context, request *DeleteEvaluatorTemplateRequest) (r *DeleteEvaluatorTemplateResponse, err error)
-	// 调试预置评估器
-	DebugBuiltinEvaluator(ctx context.Context, req *DebugBuiltinEvaluatorRequest) (r *DebugBuiltinEvaluatorResponse, err error)
-	// 更新预置评估器tag
-	UpdateBuiltinEvaluatorTags(ctx context.Context, req *UpdateBuiltinEvaluatorTagsRequest) (r *UpdateBuiltinEvaluatorTagsResponse, err error)
-	// 查询Tag
-	ListEvaluatorTags(ctx context.Context, req *ListEvaluatorTagsRequest) (r *ListEvaluatorTagsResponse, err error)
+func NewRunEvaluatorCalibrationRequest() *RunEvaluatorCalibrationRequest {
+	return &RunEvaluatorCalibrationRequest{}
 }
 
-type EvaluatorServiceClient struct {
-	c thrift.TClient
+func (p *RunEvaluatorCalibrationRequest) InitDefault() {
 }
 
-func NewEvaluatorServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *EvaluatorServiceClient {
-	return &EvaluatorServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+func (p *RunEvaluatorCalibrationRequest) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
 	}
+	return
 }
 
-func NewEvaluatorServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *EvaluatorServiceClient {
-	return &EvaluatorServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+func (p *RunEvaluatorCalibrationRequest) GetEvaluatorVersionID() (v int64) {
+	if p != nil {
+		return p.EvaluatorVersionID
 	}
+	return
 }
 
-func NewEvaluatorServiceClient(c thrift.TClient) *EvaluatorServiceClient {
-	return &EvaluatorServiceClient{
-		c: c,
+func (p *RunEvaluatorCalibrationRequest) GetEvaluationSetID() (v int64) {
+	if p != nil {
+		return p.EvaluationSetID
 	}
+	return
 }
 
-func (p *EvaluatorServiceClient) Client_() thrift.TClient {
-	return p.c
-}
+var RunEvaluatorCalibrationRequest_EvaluationSetVersionID_DEFAULT int64
 
-func (p *EvaluatorServiceClient) ListEvaluators(ctx context.Context, request *ListEvaluatorsRequest) (r *ListEvaluatorsResponse, err error) {
-	var _args EvaluatorServiceListEvaluatorsArgs
-	_args.Request = request
-	var _result EvaluatorServiceListEvaluatorsResult
-	if err = p.Client_().Call(ctx, "ListEvaluators", &_args, &_result); err != nil {
+func (p *RunEvaluatorCalibrationRequest) GetEvaluationSetVersionID() (v int64) {
+	if p == nil {
 		return
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *EvaluatorServiceClient) BatchGetEvaluators(ctx context.Context, request *BatchGetEvaluatorsRequest) (r *BatchGetEvaluatorsResponse, err error) {
-	var _args EvaluatorServiceBatchGetEvaluatorsArgs
-	_args.Request = request
-	var _result EvaluatorServiceBatchGetEvaluatorsResult
-	if err = p.Client_().Call(ctx, "BatchGetEvaluators", &_args, &_result); err != nil {
-		return
+	if !p.IsSetEvaluationSetVersionID() {
+		return RunEvaluatorCalibrationRequest_EvaluationSetVersionID_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return *p.EvaluationSetVersionID
 }
-func (p *EvaluatorServiceClient) GetEvaluator(ctx context.Context, request *GetEvaluatorRequest) (r *GetEvaluatorResponse, err error) {
-	var _args EvaluatorServiceGetEvaluatorArgs
-	_args.Request = request
-	var _result EvaluatorServiceGetEvaluatorResult
-	if err = p.Client_().Call(ctx, "GetEvaluator", &_args, &_result); err != nil {
+
+var RunEvaluatorCalibrationRequest_Conf_DEFAULT *evaluator.EvaluatorCalibrationConf
+
+func (p *RunEvaluatorCalibrationRequest) GetConf() (v *evaluator.EvaluatorCalibrationConf) {
+	if p == nil {
 		return
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *EvaluatorServiceClient) CreateEvaluator(ctx context.Context, request *CreateEvaluatorRequest) (r *CreateEvaluatorResponse, err error) {
-	var _args EvaluatorServiceCreateEvaluatorArgs
-	_args.Request = request
-	var _result EvaluatorServiceCreateEvaluatorResult
-	if err = p.Client_().Call(ctx, "CreateEvaluator", &_args, &_result); err != nil {
-		return
+	if !p.IsSetConf() {
+		return RunEvaluatorCalibrationRequest_Conf_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return p.Conf
 }
-func (p *EvaluatorServiceClient) UpdateEvaluator(ctx context.Context, request *UpdateEvaluatorRequest) (r *UpdateEvaluatorResponse, err error) {
-	var _args EvaluatorServiceUpdateEvaluatorArgs
-	_args.Request = request
-	var _result EvaluatorServiceUpdateEvaluatorResult
-	if err = p.Client_().Call(ctx, "UpdateEvaluator", &_args, &_result); err != nil {
+
+var RunEvaluatorCalibrationRequest_Base_DEFAULT *base.Base
+
+func (p *RunEvaluatorCalibrationRequest) GetBase() (v *base.Base) {
+	if p == nil {
 		return
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *EvaluatorServiceClient) UpdateEvaluatorDraft(ctx context.Context, request *UpdateEvaluatorDraftRequest) (r *UpdateEvaluatorDraftResponse, err error) {
-	var _args EvaluatorServiceUpdateEvaluatorDraftArgs
-	_args.Request = request
-	var _result EvaluatorServiceUpdateEvaluatorDraftResult
-	if err = p.Client_().Call(ctx, "UpdateEvaluatorDraft", &_args, &_result); err != nil {
-		return
+	if !p.IsSetBase() {
+		return RunEvaluatorCalibrationRequest_Base_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return p.Base
 }
-func (p *EvaluatorServiceClient) DeleteEvaluator(ctx context.Context, request *DeleteEvaluatorRequest) (r *DeleteEvaluatorResponse, err error) {
-	var _args EvaluatorServiceDeleteEvaluatorArgs
-	_args.Request = request
-	var _result EvaluatorServiceDeleteEvaluatorResult
-	if err = p.Client_().Call(ctx, "DeleteEvaluator", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *RunEvaluatorCalibrationRequest) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
 }
-func (p *EvaluatorServiceClient) CheckEvaluatorName(ctx context.Context, request *CheckEvaluatorNameRequest) (r *CheckEvaluatorNameResponse, err error) {
-	var _args EvaluatorServiceCheckEvaluatorNameArgs
-	_args.Request = request
-	var _result EvaluatorServiceCheckEvaluatorNameResult
-	if err = p.Client_().Call(ctx, "CheckEvaluatorName", &_args, &_result); err != nil {
-		return
+func (p *RunEvaluatorCalibrationRequest) SetEvaluatorVersionID(val int64) {
+	p.EvaluatorVersionID = val
+}
+func (p *RunEvaluatorCalibrationRequest) SetEvaluationSetID(val int64) {
+	p.EvaluationSetID = val
+}
+func (p *RunEvaluatorCalibrationRequest) SetEvaluationSetVersionID(val *int64) {
+	p.EvaluationSetVersionID = val
+}
+func (p *RunEvaluatorCalibrationRequest) SetConf(val *evaluator.EvaluatorCalibrationConf) {
+	p.Conf = val
+}
+func (p *RunEvaluatorCalibrationRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_RunEvaluatorCalibrationRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "evaluator_version_id",
+	3:   "evaluation_set_id",
+	4:   "evaluation_set_version_id",
+	5:   "conf",
+	255: "Base",
+}
+
+func (p *RunEvaluatorCalibrationRequest) IsSetEvaluationSetVersionID() bool {
+	return p.EvaluationSetVersionID != nil
+}
+
+func (p *RunEvaluatorCalibrationRequest) IsSetConf() bool {
+	return p.Conf != nil
+}
+
+func (p *RunEvaluatorCalibrationRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RunEvaluatorCalibrationRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluatorVersionID bool = false
+	var issetEvaluationSetID bool = false
+	var issetConf bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluatorVersionID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluationSetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetConf = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluatorVersionID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluationSetID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetConf {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RunEvaluatorCalibrationRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RunEvaluatorCalibrationRequest[fieldId]))
+}
+
+func (p *RunEvaluatorCalibrationRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *RunEvaluatorCalibrationRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EvaluatorVersionID = _field
+	return nil
+}
+func (p *RunEvaluatorCalibrationRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EvaluationSetID = _field
+	return nil
+}
+func (p *RunEvaluatorCalibrationRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluationSetVersionID = _field
+	return nil
+}
+func (p *RunEvaluatorCalibrationRequest) ReadField5(iprot thrift.TProtocol) error {
+	_field := evaluator.NewEvaluatorCalibrationConf()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Conf = _field
+	return nil
+}
+func (p *RunEvaluatorCalibrationRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *RunEvaluatorCalibrationRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RunEvaluatorCalibrationRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RunEvaluatorCalibrationRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RunEvaluatorCalibrationRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvaluatorVersionID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *RunEvaluatorCalibrationRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluation_set_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvaluationSetID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *RunEvaluatorCalibrationRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluationSetVersionID() {
+		if err = oprot.WriteFieldBegin("evaluation_set_version_id", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluationSetVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *RunEvaluatorCalibrationRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("conf", thrift.STRUCT, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Conf.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *RunEvaluatorCalibrationRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *RunEvaluatorCalibrationRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RunEvaluatorCalibrationRequest(%+v)", *p)
+
+}
+
+func (p *RunEvaluatorCalibrationRequest) DeepEqual(ano *RunEvaluatorCalibrationRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.EvaluatorVersionID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.EvaluationSetID) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.EvaluationSetVersionID) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Conf) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *RunEvaluatorCalibrationRequest) Field1DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
+	}
+	return true
+}
+func (p *RunEvaluatorCalibrationRequest) Field2DeepEqual(src int64) bool {
+
+	if p.EvaluatorVersionID != src {
+		return false
+	}
+	return true
+}
+func (p *RunEvaluatorCalibrationRequest) Field3DeepEqual(src int64) bool {
+
+	if p.EvaluationSetID != src {
+		return false
+	}
+	return true
+}
+func (p *RunEvaluatorCalibrationRequest) Field4DeepEqual(src *int64) bool {
+
+	if p.EvaluationSetVersionID == src {
+		return true
+	} else if p.EvaluationSetVersionID == nil || src == nil {
+		return false
+	}
+	if *p.EvaluationSetVersionID != *src {
+		return false
+	}
+	return true
+}
+func (p *RunEvaluatorCalibrationRequest) Field5DeepEqual(src *evaluator.EvaluatorCalibrationConf) bool {
+
+	if !p.Conf.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *RunEvaluatorCalibrationRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type RunEvaluatorCalibrationResponse struct {
+	// 运行中的校准记录, 结果通过 GetEvaluatorCalibrationRecord 轮询
+	Record   *evaluator.EvaluatorCalibrationRecord `thrift:"record,1,optional" frugal:"1,optional,evaluator.EvaluatorCalibrationRecord" form:"record" json:"record,omitempty"`
+	BaseResp *base.BaseResp                        `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewRunEvaluatorCalibrationResponse() *RunEvaluatorCalibrationResponse {
+	return &RunEvaluatorCalibrationResponse{}
+}
+
+func (p *RunEvaluatorCalibrationResponse) InitDefault() {
+}
+
+var RunEvaluatorCalibrationResponse_Record_DEFAULT *evaluator.EvaluatorCalibrationRecord
+
+func (p *RunEvaluatorCalibrationResponse) GetRecord() (v *evaluator.EvaluatorCalibrationRecord) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRecord() {
+		return RunEvaluatorCalibrationResponse_Record_DEFAULT
+	}
+	return p.Record
+}
+
+var RunEvaluatorCalibrationResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *RunEvaluatorCalibrationResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return RunEvaluatorCalibrationResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *RunEvaluatorCalibrationResponse) SetRecord(val *evaluator.EvaluatorCalibrationRecord) {
+	p.Record = val
+}
+func (p *RunEvaluatorCalibrationResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_RunEvaluatorCalibrationResponse = map[int16]string{
+	1:   "record",
+	255: "BaseResp",
+}
+
+func (p *RunEvaluatorCalibrationResponse) IsSetRecord() bool {
+	return p.Record != nil
+}
+
+func (p *RunEvaluatorCalibrationResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RunEvaluatorCalibrationResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RunEvaluatorCalibrationResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RunEvaluatorCalibrationResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := evaluator.NewEvaluatorCalibrationRecord()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Record = _field
+	return nil
+}
+func (p *RunEvaluatorCalibrationResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *RunEvaluatorCalibrationResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RunEvaluatorCalibrationResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RunEvaluatorCalibrationResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRecord() {
+		if err = oprot.WriteFieldBegin("record", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Record.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RunEvaluatorCalibrationResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *RunEvaluatorCalibrationResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RunEvaluatorCalibrationResponse(%+v)", *p)
+
+}
+
+func (p *RunEvaluatorCalibrationResponse) DeepEqual(ano *RunEvaluatorCalibrationResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Record) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *RunEvaluatorCalibrationResponse) Field1DeepEqual(src *evaluator.EvaluatorCalibrationRecord) bool {
+
+	if !p.Record.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *RunEvaluatorCalibrationResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type GetEvaluatorCalibrationRecordRequest struct {
+	WorkspaceID int64      `thrift:"workspace_id,1,required" frugal:"1,required,i64" json:"workspace_id" query:"workspace_id,required" `
+	RecordID    int64      `thrift:"record_id,2,required" frugal:"2,required,i64" json:"record_id" path:"record_id,required" `
+	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewGetEvaluatorCalibrationRecordRequest() *GetEvaluatorCalibrationRecordRequest {
+	return &GetEvaluatorCalibrationRecordRequest{}
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) InitDefault() {
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
+	}
+	return
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) GetRecordID() (v int64) {
+	if p != nil {
+		return p.RecordID
+	}
+	return
+}
+
+var GetEvaluatorCalibrationRecordRequest_Base_DEFAULT *base.Base
+
+func (p *GetEvaluatorCalibrationRecordRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return GetEvaluatorCalibrationRecordRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *GetEvaluatorCalibrationRecordRequest) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
+}
+func (p *GetEvaluatorCalibrationRecordRequest) SetRecordID(val int64) {
+	p.RecordID = val
+}
+func (p *GetEvaluatorCalibrationRecordRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_GetEvaluatorCalibrationRecordRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "record_id",
+	255: "Base",
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetRecordID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRecordID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRecordID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluatorCalibrationRecordRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetEvaluatorCalibrationRecordRequest[fieldId]))
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *GetEvaluatorCalibrationRecordRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RecordID = _field
+	return nil
+}
+func (p *GetEvaluatorCalibrationRecordRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetEvaluatorCalibrationRecordRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetEvaluatorCalibrationRecordRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("record_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RecordID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *GetEvaluatorCalibrationRecordRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetEvaluatorCalibrationRecordRequest(%+v)", *p)
+
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) DeepEqual(ano *GetEvaluatorCalibrationRecordRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.RecordID) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) Field1DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
+	}
+	return true
+}
+func (p *GetEvaluatorCalibrationRecordRequest) Field2DeepEqual(src int64) bool {
+
+	if p.RecordID != src {
+		return false
+	}
+	return true
+}
+func (p *GetEvaluatorCalibrationRecordRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type GetEvaluatorCalibrationRecordResponse struct {
+	Record   *evaluator.EvaluatorCalibrationRecord `thrift:"record,1,optional" frugal:"1,optional,evaluator.EvaluatorCalibrationRecord" form:"record" json:"record,omitempty"`
+	BaseResp *base.BaseResp                        `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewGetEvaluatorCalibrationRecordResponse() *GetEvaluatorCalibrationRecordResponse {
+	return &GetEvaluatorCalibrationRecordResponse{}
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) InitDefault() {
+}
+
+var GetEvaluatorCalibrationRecordResponse_Record_DEFAULT *evaluator.EvaluatorCalibrationRecord
+
+func (p *GetEvaluatorCalibrationRecordResponse) GetRecord() (v *evaluator.EvaluatorCalibrationRecord) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRecord() {
+		return GetEvaluatorCalibrationRecordResponse_Record_DEFAULT
+	}
+	return p.Record
+}
+
+var GetEvaluatorCalibrationRecordResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *GetEvaluatorCalibrationRecordResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return GetEvaluatorCalibrationRecordResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *GetEvaluatorCalibrationRecordResponse) SetRecord(val *evaluator.EvaluatorCalibrationRecord) {
+	p.Record = val
+}
+func (p *GetEvaluatorCalibrationRecordResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_GetEvaluatorCalibrationRecordResponse = map[int16]string{
+	1:   "record",
+	255: "BaseResp",
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) IsSetRecord() bool {
+	return p.Record != nil
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluatorCalibrationRecordResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := evaluator.NewEvaluatorCalibrationRecord()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Record = _field
+	return nil
+}
+func (p *GetEvaluatorCalibrationRecordResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetEvaluatorCalibrationRecordResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRecord() {
+		if err = oprot.WriteFieldBegin("record", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Record.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetEvaluatorCalibrationRecordResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetEvaluatorCalibrationRecordResponse(%+v)", *p)
+
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) DeepEqual(ano *GetEvaluatorCalibrationRecordResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Record) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) Field1DeepEqual(src *evaluator.EvaluatorCalibrationRecord) bool {
+
+	if !p.Record.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *GetEvaluatorCalibrationRecordResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ListEvaluatorCalibrationRecordsRequest struct {
+	WorkspaceID int64 `thrift:"workspace_id,1,required" frugal:"1,required,i64" json:"workspace_id" form:"workspace_id,required" `
+	EvaluatorID int64 `thrift:"evaluator_id,2,required" frugal:"2,required,i64" json:"evaluator_id" form:"evaluator_id,required" `
+	// 为空时返回全部版本
+	EvaluatorVersionIds []int64    `thrift:"evaluator_version_ids,3,optional" frugal:"3,optional,list<i64>" json:"evaluator_version_ids" form:"evaluator_version_ids" `
+	Base                *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewListEvaluatorCalibrationRecordsRequest() *ListEvaluatorCalibrationRecordsRequest {
+	return &ListEvaluatorCalibrationRecordsRequest{}
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) InitDefault() {
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
+	}
+	return
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) GetEvaluatorID() (v int64) {
+	if p != nil {
+		return p.EvaluatorID
+	}
+	return
+}
+
+var ListEvaluatorCalibrationRecordsRequest_EvaluatorVersionIds_DEFAULT []int64
+
+func (p *ListEvaluatorCalibrationRecordsRequest) GetEvaluatorVersionIds() (v []int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorVersionIds() {
+		return ListEvaluatorCalibrationRecordsRequest_EvaluatorVersionIds_DEFAULT
+	}
+	return p.EvaluatorVersionIds
+}
+
+var ListEvaluatorCalibrationRecordsRequest_Base_DEFAULT *base.Base
+
+func (p *ListEvaluatorCalibrationRecordsRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return ListEvaluatorCalibrationRecordsRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *ListEvaluatorCalibrationRecordsRequest) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
+}
+func (p *ListEvaluatorCalibrationRecordsRequest) SetEvaluatorID(val int64) {
+	p.EvaluatorID = val
+}
+func (p *ListEvaluatorCalibrationRecordsRequest) SetEvaluatorVersionIds(val []int64) {
+	p.EvaluatorVersionIds = val
+}
+func (p *ListEvaluatorCalibrationRecordsRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_ListEvaluatorCalibrationRecordsRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "evaluator_id",
+	3:   "evaluator_version_ids",
+	255: "Base",
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) IsSetEvaluatorVersionIds() bool {
+	return p.EvaluatorVersionIds != nil
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluatorID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluatorID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluatorID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListEvaluatorCalibrationRecordsRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListEvaluatorCalibrationRecordsRequest[fieldId]))
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *ListEvaluatorCalibrationRecordsRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EvaluatorID = _field
+	return nil
+}
+func (p *ListEvaluatorCalibrationRecordsRequest) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.EvaluatorVersionIds = _field
+	return nil
+}
+func (p *ListEvaluatorCalibrationRecordsRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListEvaluatorCalibrationRecordsRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ListEvaluatorCalibrationRecordsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvaluatorID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ListEvaluatorCalibrationRecordsRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersionIds() {
+		if err = oprot.WriteFieldBegin("evaluator_version_ids", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I64, len(p.EvaluatorVersionIds)); err != nil {
+			return err
+		}
+		for _, v := range p.EvaluatorVersionIds {
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ListEvaluatorCalibrationRecordsRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListEvaluatorCalibrationRecordsRequest(%+v)", *p)
+
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) DeepEqual(ano *ListEvaluatorCalibrationRecordsRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.EvaluatorID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.EvaluatorVersionIds) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) Field1DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
+	}
+	return true
+}
+func (p *ListEvaluatorCalibrationRecordsRequest) Field2DeepEqual(src int64) bool {
+
+	if p.EvaluatorID != src {
+		return false
+	}
+	return true
+}
+func (p *ListEvaluatorCalibrationRecordsRequest) Field3DeepEqual(src []int64) bool {
+
+	if len(p.EvaluatorVersionIds) != len(src) {
+		return false
+	}
+	for i, v := range p.EvaluatorVersionIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListEvaluatorCalibrationRecordsRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ListEvaluatorCalibrationRecordsResponse struct {
+	Records  []*evaluator.EvaluatorCalibrationRecord `thrift:"records,1,optional" frugal:"1,optional,list<evaluator.EvaluatorCalibrationRecord>" form:"records" json:"records,omitempty"`
+	BaseResp *base.BaseResp                          `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewListEvaluatorCalibrationRecordsResponse() *ListEvaluatorCalibrationRecordsResponse {
+	return &ListEvaluatorCalibrationRecordsResponse{}
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) InitDefault() {
+}
+
+var ListEvaluatorCalibrationRecordsResponse_Records_DEFAULT []*evaluator.EvaluatorCalibrationRecord
+
+func (p *ListEvaluatorCalibrationRecordsResponse) GetRecords() (v []*evaluator.EvaluatorCalibrationRecord) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRecords() {
+		return ListEvaluatorCalibrationRecordsResponse_Records_DEFAULT
+	}
+	return p.Records
+}
+
+var ListEvaluatorCalibrationRecordsResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *ListEvaluatorCalibrationRecordsResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return ListEvaluatorCalibrationRecordsResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ListEvaluatorCalibrationRecordsResponse) SetRecords(val []*evaluator.EvaluatorCalibrationRecord) {
+	p.Records = val
+}
+func (p *ListEvaluatorCalibrationRecordsResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_ListEvaluatorCalibrationRecordsResponse = map[int16]string{
+	1:   "records",
+	255: "BaseResp",
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) IsSetRecords() bool {
+	return p.Records != nil
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListEvaluatorCalibrationRecordsResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*evaluator.EvaluatorCalibrationRecord, 0, size)
+	values := make([]evaluator.EvaluatorCalibrationRecord, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Records = _field
+	return nil
+}
+func (p *ListEvaluatorCalibrationRecordsResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListEvaluatorCalibrationRecordsResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRecords() {
+		if err = oprot.WriteFieldBegin("records", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Records)); err != nil {
+			return err
+		}
+		for _, v := range p.Records {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ListEvaluatorCalibrationRecordsResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListEvaluatorCalibrationRecordsResponse(%+v)", *p)
+
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) DeepEqual(ano *ListEvaluatorCalibrationRecordsResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Records) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) Field1DeepEqual(src []*evaluator.EvaluatorCalibrationRecord) bool {
+
+	if len(p.Records) != len(src) {
+		return false
+	}
+	for i, v := range p.Records {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListEvaluatorCalibrationRecordsResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type EvaluatorService interface {
+	// 评估器
+	ListEvaluators(ctx context.Context, request *ListEvaluatorsRequest) (r *ListEvaluatorsResponse, err error)
+
+	BatchGetEvaluators(ctx context.Context, request *BatchGetEvaluatorsRequest) (r *BatchGetEvaluatorsResponse, err error)
+
+	GetEvaluator(ctx context.Context, request *GetEvaluatorRequest) (r *GetEvaluatorResponse, err error)
+
+	CreateEvaluator(ctx context.Context, request *CreateEvaluatorRequest) (r *CreateEvaluatorResponse, err error)
+
+	UpdateEvaluator(ctx context.Context, request *UpdateEvaluatorRequest) (r *UpdateEvaluatorResponse, err error)
+
+	UpdateEvaluatorDraft(ctx context.Context, request *UpdateEvaluatorDraftRequest) (r *UpdateEvaluatorDraftResponse, err error)
+
+	DeleteEvaluator(ctx context.Context, request *DeleteEvaluatorRequest) (r *DeleteEvaluatorResponse, err error)
+
+	CheckEvaluatorName(ctx context.Context, request *CheckEvaluatorNameRequest) (r *CheckEvaluatorNameResponse, err error)
+	// 评估器版本
+	ListEvaluatorVersions(ctx context.Context, request *ListEvaluatorVersionsRequest) (r *ListEvaluatorVersionsResponse, err error)
+
+	GetEvaluatorVersion(ctx context.Context, request *GetEvaluatorVersionRequest) (r *GetEvaluatorVersionResponse, err error)
+
+	BatchGetEvaluatorVersions(ctx context.Context, request *BatchGetEvaluatorVersionsRequest) (r *BatchGetEvaluatorVersionsResponse, err error)
+	// 按 evaluator_id + version 对批量查询 evaluator_version_id（仅 RPC）
+	BatchGetEvaluatorVersionIDs(ctx context.Context, request *BatchGetEvaluatorVersionIDsRequest) (r *BatchGetEvaluatorVersionIDsResponse, err error)
+
+	SubmitEvaluatorVersion(ctx context.Context, request *SubmitEvaluatorVersionRequest) (r *SubmitEvaluatorVersionResponse, err error)
+	// 评估器预置模版
+	ListTemplates(ctx context.Context, request *ListTemplatesRequest) (r *ListTemplatesResponse, err error)
+
+	GetTemplateInfo(ctx context.Context, request *GetTemplateInfoRequest) (r *GetTemplateInfoResponse, err error)
+
+	GetDefaultPromptEvaluatorTools(ctx context.Context, req *GetDefaultPromptEvaluatorToolsRequest) (r *GetDefaultPromptEvaluatorToolsResponse, err error)
+	// 评估器执行
+	RunEvaluator(ctx context.Context, req *RunEvaluatorRequest) (r *RunEvaluatorResponse, err error)
+
+	DebugEvaluator(ctx context.Context, req *DebugEvaluatorRequest) (r *DebugEvaluatorResponse, err error)
+
+	BatchDebugEvaluator(ctx context.Context, req *BatchDebugEvaluatorRequest) (r *BatchDebugEvaluatorResponse, err error)
+
+	AsyncRunEvaluator(ctx context.Context, req *AsyncRunEvaluatorRequest) (r *AsyncRunEvaluatorResponse, err error)
+
+	AsyncDebugEvaluator(ctx context.Context, req *AsyncDebugEvaluatorRequest) (r *AsyncDebugEvaluatorResponse, err error)
+	// 评估器执行结果
+	UpdateEvaluatorRecord(ctx context.Context, req *UpdateEvaluatorRecordRequest) (r *UpdateEvaluatorRecordResponse, err error)
+
+	GetEvaluatorRecord(ctx context.Context, req *GetEvaluatorRecordRequest) (r *GetEvaluatorRecordResponse, err error)
+
+	BatchGetEvaluatorRecords(ctx context.Context, req *BatchGetEvaluatorRecordsRequest) (r *BatchGetEvaluatorRecordsResponse, err error)
+	// 评估器校准
+	RunEvaluatorCalibration(ctx context.Context, req *RunEvaluatorCalibrationRequest) (r *RunEvaluatorCalibrationResponse, err error)
+
+	GetEvaluatorCalibrationRecord(ctx context.Context, req *GetEvaluatorCalibrationRecordRequest) (r *GetEvaluatorCalibrationRecordResponse, err error)
+
+	ListEvaluatorCalibrationRecords(ctx context.Context, req *ListEvaluatorCalibrationRecordsRequest) (r *ListEvaluatorCalibrationRecordsResponse, err error)
+	// 评估器验证
+	ValidateEvaluator(ctx context.Context, request *ValidateEvaluatorRequest) (r *ValidateEvaluatorResponse, err error)
+	// 查询评估器模板
+	ListTemplatesV2(ctx context.Context, request *ListTemplatesV2Request) (r *ListTemplatesV2Response, err error)
+
+	GetTemplateV2(ctx context.Context, request *GetTemplateV2Request) (r *GetTemplateV2Response, err error)
+	// 创建评估器模板
+	CreateEvaluatorTemplate(ctx context.Context, request *CreateEvaluatorTemplateRequest) (r *CreateEvaluatorTemplateResponse, err error)
+	// 更新评估器模板
+	UpdateEvaluatorTemplate(ctx context.Context, request *UpdateEvaluatorTemplateRequest) (r *UpdateEvaluatorTemplateResponse, err error)
+	// 删除
+	DeleteEvaluatorTemplate(ctx context.Context, request *DeleteEvaluatorTemplateRequest) (r *DeleteEvaluatorTemplateResponse, err error)
+	// 调试预置评估器
+	DebugBuiltinEvaluator(ctx context.Context, req *DebugBuiltinEvaluatorRequest) (r *DebugBuiltinEvaluatorResponse, err error)
+	// 更新预置评估器tag
+	UpdateBuiltinEvaluatorTags(ctx context.Context, req *UpdateBuiltinEvaluatorTagsRequest) (r *UpdateBuiltinEvaluatorTagsResponse, err error)
+	// 查询Tag
+	ListEvaluatorTags(ctx context.Context, req *ListEvaluatorTagsRequest) (r *ListEvaluatorTagsResponse, err error)
+}
+
+type EvaluatorServiceClient struct {
+	c thrift.TClient
+}
+
+func NewEvaluatorServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *EvaluatorServiceClient {
+	return &EvaluatorServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewEvaluatorServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *EvaluatorServiceClient {
+	return &EvaluatorServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewEvaluatorServiceClient(c thrift.TClient) *EvaluatorServiceClient {
+	return &EvaluatorServiceClient{
+		c: c,
+	}
+}
+
+func (p *EvaluatorServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *EvaluatorServiceClient) ListEvaluators(ctx context.Context, request *ListEvaluatorsRequest) (r *ListEvaluatorsResponse, err error) {
+	var _args EvaluatorServiceListEvaluatorsArgs
+	_args.Request = request
+	var _result EvaluatorServiceListEvaluatorsResult
+	if err = p.Client_().Call(ctx, "ListEvaluators", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) BatchGetEvaluators(ctx context.Context, request *BatchGetEvaluatorsRequest) (r *BatchGetEvaluatorsResponse, err error) {
+	var _args EvaluatorServiceBatchGetEvaluatorsArgs
+	_args.Request = request
+	var _result EvaluatorServiceBatchGetEvaluatorsResult
+	if err = p.Client_().Call(ctx, "BatchGetEvaluators", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) GetEvaluator(ctx context.Context, request *GetEvaluatorRequest) (r *GetEvaluatorResponse, err error) {
+	var _args EvaluatorServiceGetEvaluatorArgs
+	_args.Request = request
+	var _result EvaluatorServiceGetEvaluatorResult
+	if err = p.Client_().Call(ctx, "GetEvaluator", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) CreateEvaluator(ctx context.Context, request *CreateEvaluatorRequest) (r *CreateEvaluatorResponse, err error) {
+	var _args EvaluatorServiceCreateEvaluatorArgs
+	_args.Request = request
+	var _result EvaluatorServiceCreateEvaluatorResult
+	if err = p.Client_().Call(ctx, "CreateEvaluator", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) UpdateEvaluator(ctx context.Context, request *UpdateEvaluatorRequest) (r *UpdateEvaluatorResponse, err error) {
+	var _args EvaluatorServiceUpdateEvaluatorArgs
+	_args.Request = request
+	var _result EvaluatorServiceUpdateEvaluatorResult
+	if err = p.Client_().Call(ctx, "UpdateEvaluator", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) UpdateEvaluatorDraft(ctx context.Context, request *UpdateEvaluatorDraftRequest) (r *UpdateEvaluatorDraftResponse, err error) {
+	var _args EvaluatorServiceUpdateEvaluatorDraftArgs
+	_args.Request = request
+	var _result EvaluatorServiceUpdateEvaluatorDraftResult
+	if err = p.Client_().Call(ctx, "UpdateEvaluatorDraft", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) DeleteEvaluator(ctx context.Context, request *DeleteEvaluatorRequest) (r *DeleteEvaluatorResponse, err error) {
+	var _args EvaluatorServiceDeleteEvaluatorArgs
+	_args.Request = request
+	var _result EvaluatorServiceDeleteEvaluatorResult
+	if err = p.Client_().Call(ctx, "DeleteEvaluator", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) CheckEvaluatorName(ctx context.Context, request *CheckEvaluatorNameRequest) (r *CheckEvaluatorNameResponse, err error) {
+	var _args EvaluatorServiceCheckEvaluatorNameArgs
+	_args.Request = request
+	var _result EvaluatorServiceCheckEvaluatorNameResult
+	if err = p.Client_().Call(ctx, "CheckEvaluatorName", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) ListEvaluatorVersions(ctx context.Context, request *ListEvaluatorVersionsRequest) (r *ListEvaluatorVersionsResponse, err error) {
+	var _args EvaluatorServiceListEvaluatorVersionsArgs
+	_args.Request = request
+	var _result EvaluatorServiceListEvaluatorVersionsResult
+	if err = p.Client_().Call(ctx, "ListEvaluatorVersions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) GetEvaluatorVersion(ctx context.Context, request *GetEvaluatorVersionRequest) (r *GetEvaluatorVersionResponse, err error) {
+	var _args EvaluatorServiceGetEvaluatorVersionArgs
+	_args.Request = request
+	var _result EvaluatorServiceGetEvaluatorVersionResult
+	if err = p.Client_().Call(ctx, "GetEvaluatorVersion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) BatchGetEvaluatorVersions(ctx context.Context, request *BatchGetEvaluatorVersionsRequest) (r *BatchGetEvaluatorVersionsResponse, err error) {
+	var _args EvaluatorServiceBatchGetEvaluatorVersionsArgs
+	_args.Request = request
+	var _result EvaluatorServiceBatchGetEvaluatorVersionsResult
+	if err = p.Client_().Call(ctx, "BatchGetEvaluatorVersions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) BatchGetEvaluatorVersionIDs(ctx context.Context, request *BatchGetEvaluatorVersionIDsRequest) (r *BatchGetEvaluatorVersionIDsResponse, err error) {
+	var _args EvaluatorServiceBatchGetEvaluatorVersionIDsArgs
+	_args.Request = request
+	var _result EvaluatorServiceBatchGetEvaluatorVersionIDsResult
+	if err = p.Client_().Call(ctx, "BatchGetEvaluatorVersionIDs", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) SubmitEvaluatorVersion(ctx context.Context, request *SubmitEvaluatorVersionRequest) (r *SubmitEvaluatorVersionResponse, err error) {
+	var _args EvaluatorServiceSubmitEvaluatorVersionArgs
+	_args.Request = request
+	var _result EvaluatorServiceSubmitEvaluatorVersionResult
+	if err = p.Client_().Call(ctx, "SubmitEvaluatorVersion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) ListTemplates(ctx context.Context, request *ListTemplatesRequest) (r *ListTemplatesResponse, err error) {
+	var _args EvaluatorServiceListTemplatesArgs
+	_args.Request = request
+	var _result EvaluatorServiceListTemplatesResult
+	if err = p.Client_().Call(ctx, "ListTemplates", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) GetTemplateInfo(ctx context.Context, request *GetTemplateInfoRequest) (r *GetTemplateInfoResponse, err error) {
+	var _args EvaluatorServiceGetTemplateInfoArgs
+	_args.Request = request
+	var _result EvaluatorServiceGetTemplateInfoResult
+	if err = p.Client_().Call(ctx, "GetTemplateInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) GetDefaultPromptEvaluatorTools(ctx context.Context, req *GetDefaultPromptEvaluatorToolsRequest) (r *GetDefaultPromptEvaluatorToolsResponse, err error) {
+	var _args EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs
+	_args.Req = req
+	var _result EvaluatorServiceGetDefaultPromptEvaluatorToolsResult
+	if err = p.Client_().Call(ctx, "GetDefaultPromptEvaluatorTools", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) RunEvaluator(ctx context.Context, req *RunEvaluatorRequest) (r *RunEvaluatorResponse, err error) {
+	var _args EvaluatorServiceRunEvaluatorArgs
+	_args.Req = req
+	var _result EvaluatorServiceRunEvaluatorResult
+	if err = p.Client_().Call(ctx, "RunEvaluator", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) DebugEvaluator(ctx context.Context, req *DebugEvaluatorRequest) (r *DebugEvaluatorResponse, err error) {
+	var _args EvaluatorServiceDebugEvaluatorArgs
+	_args.Req = req
+	var _result EvaluatorServiceDebugEvaluatorResult
+	if err = p.Client_().Call(ctx, "DebugEvaluator", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) BatchDebugEvaluator(ctx context.Context, req *BatchDebugEvaluatorRequest) (r *BatchDebugEvaluatorResponse, err error) {
+	var _args EvaluatorServiceBatchDebugEvaluatorArgs
+	_args.Req = req
+	var _result EvaluatorServiceBatchDebugEvaluatorResult
+	if err = p.Client_().Call(ctx, "BatchDebugEvaluator", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) AsyncRunEvaluator(ctx context.Context, req *AsyncRunEvaluatorRequest) (r *AsyncRunEvaluatorResponse, err error) {
+	var _args EvaluatorServiceAsyncRunEvaluatorArgs
+	_args.Req = req
+	var _result EvaluatorServiceAsyncRunEvaluatorResult
+	if err = p.Client_().Call(ctx, "AsyncRunEvaluator", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) AsyncDebugEvaluator(ctx context.Context, req *AsyncDebugEvaluatorRequest) (r *AsyncDebugEvaluatorResponse, err error) {
+	var _args EvaluatorServiceAsyncDebugEvaluatorArgs
+	_args.Req = req
+	var _result EvaluatorServiceAsyncDebugEvaluatorResult
+	if err = p.Client_().Call(ctx, "AsyncDebugEvaluator", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) UpdateEvaluatorRecord(ctx context.Context, req *UpdateEvaluatorRecordRequest) (r *UpdateEvaluatorRecordResponse, err error) {
+	var _args EvaluatorServiceUpdateEvaluatorRecordArgs
+	_args.Req = req
+	var _result EvaluatorServiceUpdateEvaluatorRecordResult
+	if err = p.Client_().Call(ctx, "UpdateEvaluatorRecord", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) GetEvaluatorRecord(ctx context.Context, req *GetEvaluatorRecordRequest) (r *GetEvaluatorRecordResponse, err error) {
+	var _args EvaluatorServiceGetEvaluatorRecordArgs
+	_args.Req = req
+	var _result EvaluatorServiceGetEvaluatorRecordResult
+	if err = p.Client_().Call(ctx, "GetEvaluatorRecord", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) BatchGetEvaluatorRecords(ctx context.Context, req *BatchGetEvaluatorRecordsRequest) (r *BatchGetEvaluatorRecordsResponse, err error) {
+	var _args EvaluatorServiceBatchGetEvaluatorRecordsArgs
+	_args.Req = req
+	var _result EvaluatorServiceBatchGetEvaluatorRecordsResult
+	if err = p.Client_().Call(ctx, "BatchGetEvaluatorRecords", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) RunEvaluatorCalibration(ctx context.Context, req *RunEvaluatorCalibrationRequest) (r *RunEvaluatorCalibrationResponse, err error) {
+	var _args EvaluatorServiceRunEvaluatorCalibrationArgs
+	_args.Req = req
+	var _result EvaluatorServiceRunEvaluatorCalibrationResult
+	if err = p.Client_().Call(ctx, "RunEvaluatorCalibration", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) GetEvaluatorCalibrationRecord(ctx context.Context, req *GetEvaluatorCalibrationRecordRequest) (r *GetEvaluatorCalibrationRecordResponse, err error) {
+	var _args EvaluatorServiceGetEvaluatorCalibrationRecordArgs
+	_args.Req = req
+	var _result EvaluatorServiceGetEvaluatorCalibrationRecordResult
+	if err = p.Client_().Call(ctx, "GetEvaluatorCalibrationRecord", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) ListEvaluatorCalibrationRecords(ctx context.Context, req *ListEvaluatorCalibrationRecordsRequest) (r *ListEvaluatorCalibrationRecordsResponse, err error) {
+	var _args EvaluatorServiceListEvaluatorCalibrationRecordsArgs
+	_args.Req = req
+	var _result EvaluatorServiceListEvaluatorCalibrationRecordsResult
+	if err = p.Client_().Call(ctx, "ListEvaluatorCalibrationRecords", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) ValidateEvaluator(ctx context.Context, request *ValidateEvaluatorRequest) (r *ValidateEvaluatorResponse, err error) {
+	var _args EvaluatorServiceValidateEvaluatorArgs
+	_args.Request = request
+	var _result EvaluatorServiceValidateEvaluatorResult
+	if err = p.Client_().Call(ctx, "ValidateEvaluator", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) ListTemplatesV2(ctx context.Context, request *ListTemplatesV2Request) (r *ListTemplatesV2Response, err error) {
+	var _args EvaluatorServiceListTemplatesV2Args
+	_args.Request = request
+	var _result EvaluatorServiceListTemplatesV2Result
+	if err = p.Client_().Call(ctx, "ListTemplatesV2", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) GetTemplateV2(ctx context.Context, request *GetTemplateV2Request) (r *GetTemplateV2Response, err error) {
+	var _args EvaluatorServiceGetTemplateV2Args
+	_args.Request = request
+	var _result EvaluatorServiceGetTemplateV2Result
+	if err = p.Client_().Call(ctx, "GetTemplateV2", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) CreateEvaluatorTemplate(ctx context.Context, request *CreateEvaluatorTemplateRequest) (r *CreateEvaluatorTemplateResponse, err error) {
+	var _args EvaluatorServiceCreateEvaluatorTemplateArgs
+	_args.Request = request
+	var _result EvaluatorServiceCreateEvaluatorTemplateResult
+	if err = p.Client_().Call(ctx, "CreateEvaluatorTemplate", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) UpdateEvaluatorTemplate(ctx context.Context, request *UpdateEvaluatorTemplateRequest) (r *UpdateEvaluatorTemplateResponse, err error) {
+	var _args EvaluatorServiceUpdateEvaluatorTemplateArgs
+	_args.Request = request
+	var _result EvaluatorServiceUpdateEvaluatorTemplateResult
+	if err = p.Client_().Call(ctx, "UpdateEvaluatorTemplate", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) DeleteEvaluatorTemplate(ctx context.Context, request *DeleteEvaluatorTemplateRequest) (r *DeleteEvaluatorTemplateResponse, err error) {
+	var _args EvaluatorServiceDeleteEvaluatorTemplateArgs
+	_args.Request = request
+	var _result EvaluatorServiceDeleteEvaluatorTemplateResult
+	if err = p.Client_().Call(ctx, "DeleteEvaluatorTemplate", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) DebugBuiltinEvaluator(ctx context.Context, req *DebugBuiltinEvaluatorRequest) (r *DebugBuiltinEvaluatorResponse, err error) {
+	var _args EvaluatorServiceDebugBuiltinEvaluatorArgs
+	_args.Req = req
+	var _result EvaluatorServiceDebugBuiltinEvaluatorResult
+	if err = p.Client_().Call(ctx, "DebugBuiltinEvaluator", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) UpdateBuiltinEvaluatorTags(ctx context.Context, req *UpdateBuiltinEvaluatorTagsRequest) (r *UpdateBuiltinEvaluatorTagsResponse, err error) {
+	var _args EvaluatorServiceUpdateBuiltinEvaluatorTagsArgs
+	_args.Req = req
+	var _result EvaluatorServiceUpdateBuiltinEvaluatorTagsResult
+	if err = p.Client_().Call(ctx, "UpdateBuiltinEvaluatorTags", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *EvaluatorServiceClient) ListEvaluatorTags(ctx context.Context, req *ListEvaluatorTagsRequest) (r *ListEvaluatorTagsResponse, err error) {
+	var _args EvaluatorServiceListEvaluatorTagsArgs
+	_args.Req = req
+	var _result EvaluatorServiceListEvaluatorTagsResult
+	if err = p.Client_().Call(ctx, "ListEvaluatorTags", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type EvaluatorServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      EvaluatorService
+}
+
+func (p *EvaluatorServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *EvaluatorServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *EvaluatorServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewEvaluatorServiceProcessor(handler EvaluatorService) *EvaluatorServiceProcessor {
+	self := &EvaluatorServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("ListEvaluators", &evaluatorServiceProcessorListEvaluators{handler: handler})
+	self.AddToProcessorMap("BatchGetEvaluators", &evaluatorServiceProcessorBatchGetEvaluators{handler: handler})
+	self.AddToProcessorMap("GetEvaluator", &evaluatorServiceProcessorGetEvaluator{handler: handler})
+	self.AddToProcessorMap("CreateEvaluator", &evaluatorServiceProcessorCreateEvaluator{handler: handler})
+	self.AddToProcessorMap("UpdateEvaluator", &evaluatorServiceProcessorUpdateEvaluator{handler: handler})
+	self.AddToProcessorMap("UpdateEvaluatorDraft", &evaluatorServiceProcessorUpdateEvaluatorDraft{handler: handler})
+	self.AddToProcessorMap("DeleteEvaluator", &evaluatorServiceProcessorDeleteEvaluator{handler: handler})
+	self.AddToProcessorMap("CheckEvaluatorName", &evaluatorServiceProcessorCheckEvaluatorName{handler: handler})
+	self.AddToProcessorMap("ListEvaluatorVersions", &evaluatorServiceProcessorListEvaluatorVersions{handler: handler})
+	self.AddToProcessorMap("GetEvaluatorVersion", &evaluatorServiceProcessorGetEvaluatorVersion{handler: handler})
+	self.AddToProcessorMap("BatchGetEvaluatorVersions", &evaluatorServiceProcessorBatchGetEvaluatorVersions{handler: handler})
+	self.AddToProcessorMap("BatchGetEvaluatorVersionIDs", &evaluatorServiceProcessorBatchGetEvaluatorVersionIDs{handler: handler})
+	self.AddToProcessorMap("SubmitEvaluatorVersion", &evaluatorServiceProcessorSubmitEvaluatorVersion{handler: handler})
+	self.AddToProcessorMap("ListTemplates", &evaluatorServiceProcessorListTemplates{handler: handler})
+	self.AddToProcessorMap("GetTemplateInfo", &evaluatorServiceProcessorGetTemplateInfo{handler: handler})
+	self.AddToProcessorMap("GetDefaultPromptEvaluatorTools", &evaluatorServiceProcessorGetDefaultPromptEvaluatorTools{handler: handler})
+	self.AddToProcessorMap("RunEvaluator", &evaluatorServiceProcessorRunEvaluator{handler: handler})
+	self.AddToProcessorMap("DebugEvaluator", &evaluatorServiceProcessorDebugEvaluator{handler: handler})
+	self.AddToProcessorMap("BatchDebugEvaluator", &evaluatorServiceProcessorBatchDebugEvaluator{handler: handler})
+	self.AddToProcessorMap("AsyncRunEvaluator", &evaluatorServiceProcessorAsyncRunEvaluator{handler: handler})
+	self.AddToProcessorMap("AsyncDebugEvaluator", &evaluatorServiceProcessorAsyncDebugEvaluator{handler: handler})
+	self.AddToProcessorMap("UpdateEvaluatorRecord", &evaluatorServiceProcessorUpdateEvaluatorRecord{handler: handler})
+	self.AddToProcessorMap("GetEvaluatorRecord", &evaluatorServiceProcessorGetEvaluatorRecord{handler: handler})
+	self.AddToProcessorMap("BatchGetEvaluatorRecords", &evaluatorServiceProcessorBatchGetEvaluatorRecords{handler: handler})
+	self.AddToProcessorMap("RunEvaluatorCalibration", &evaluatorServiceProcessorRunEvaluatorCalibration{handler: handler})
+	self.AddToProcessorMap("GetEvaluatorCalibrationRecord", &evaluatorServiceProcessorGetEvaluatorCalibrationRecord{handler: handler})
+	self.AddToProcessorMap("ListEvaluatorCalibrationRecords", &evaluatorServiceProcessorListEvaluatorCalibrationRecords{handler: handler})
+	self.AddToProcessorMap("ValidateEvaluator", &evaluatorServiceProcessorValidateEvaluator{handler: handler})
+	self.AddToProcessorMap("ListTemplatesV2", &evaluatorServiceProcessorListTemplatesV2{handler: handler})
+	self.AddToProcessorMap("GetTemplateV2", &evaluatorServiceProcessorGetTemplateV2{handler: handler})
+	self.AddToProcessorMap("CreateEvaluatorTemplate", &evaluatorServiceProcessorCreateEvaluatorTemplate{handler: handler})
+	self.AddToProcessorMap("UpdateEvaluatorTemplate", &evaluatorServiceProcessorUpdateEvaluatorTemplate{handler: handler})
+	self.AddToProcessorMap("DeleteEvaluatorTemplate", &evaluatorServiceProcessorDeleteEvaluatorTemplate{handler: handler})
+	self.AddToProcessorMap("DebugBuiltinEvaluator", &evaluatorServiceProcessorDebugBuiltinEvaluator{handler: handler})
+	self.AddToProcessorMap("UpdateBuiltinEvaluatorTags", &evaluatorServiceProcessorUpdateBuiltinEvaluatorTags{handler: handler})
+	self.AddToProcessorMap("ListEvaluatorTags", &evaluatorServiceProcessorListEvaluatorTags{handler: handler})
+	return self
+}
+func (p *EvaluatorServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type evaluatorServiceProcessorListEvaluators struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorListEvaluators) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceListEvaluatorsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListEvaluators", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceListEvaluatorsResult{}
+	var retval *ListEvaluatorsResponse
+	if retval, err2 = p.handler.ListEvaluators(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListEvaluators: "+err2.Error())
+		oprot.WriteMessageBegin("ListEvaluators", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListEvaluators", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type evaluatorServiceProcessorBatchGetEvaluators struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorBatchGetEvaluators) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceBatchGetEvaluatorsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchGetEvaluators", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceBatchGetEvaluatorsResult{}
+	var retval *BatchGetEvaluatorsResponse
+	if retval, err2 = p.handler.BatchGetEvaluators(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchGetEvaluators: "+err2.Error())
+		oprot.WriteMessageBegin("BatchGetEvaluators", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchGetEvaluators", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type evaluatorServiceProcessorGetEvaluator struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorGetEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceGetEvaluatorArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceGetEvaluatorResult{}
+	var retval *GetEvaluatorResponse
+	if retval, err2 = p.handler.GetEvaluator(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetEvaluator: "+err2.Error())
+		oprot.WriteMessageBegin("GetEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetEvaluator", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type evaluatorServiceProcessorCreateEvaluator struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorCreateEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceCreateEvaluatorArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceCreateEvaluatorResult{}
+	var retval *CreateEvaluatorResponse
+	if retval, err2 = p.handler.CreateEvaluator(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateEvaluator: "+err2.Error())
+		oprot.WriteMessageBegin("CreateEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateEvaluator", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type evaluatorServiceProcessorUpdateEvaluator struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorUpdateEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceUpdateEvaluatorArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceUpdateEvaluatorResult{}
+	var retval *UpdateEvaluatorResponse
+	if retval, err2 = p.handler.UpdateEvaluator(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateEvaluator: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateEvaluator", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type evaluatorServiceProcessorUpdateEvaluatorDraft struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorUpdateEvaluatorDraft) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceUpdateEvaluatorDraftArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateEvaluatorDraft", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceUpdateEvaluatorDraftResult{}
+	var retval *UpdateEvaluatorDraftResponse
+	if retval, err2 = p.handler.UpdateEvaluatorDraft(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateEvaluatorDraft: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateEvaluatorDraft", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateEvaluatorDraft", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type evaluatorServiceProcessorDeleteEvaluator struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorDeleteEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceDeleteEvaluatorArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceDeleteEvaluatorResult{}
+	var retval *DeleteEvaluatorResponse
+	if retval, err2 = p.handler.DeleteEvaluator(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteEvaluator: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteEvaluator", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
-func (p *EvaluatorServiceClient) ListEvaluatorVersions(ctx context.Context, request *ListEvaluatorVersionsRequest) (r *ListEvaluatorVersionsResponse, err error) {
-	var _args EvaluatorServiceListEvaluatorVersionsArgs
-	_args.Request = request
-	var _result EvaluatorServiceListEvaluatorVersionsResult
-	if err = p.Client_().Call(ctx, "ListEvaluatorVersions", &_args, &_result); err != nil {
+
+type evaluatorServiceProcessorCheckEvaluatorName struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorCheckEvaluatorName) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceCheckEvaluatorNameArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CheckEvaluatorName", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceCheckEvaluatorNameResult{}
+	var retval *CheckEvaluatorNameResponse
+	if retval, err2 = p.handler.CheckEvaluatorName(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CheckEvaluatorName: "+err2.Error())
+		oprot.WriteMessageBegin("CheckEvaluatorName", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CheckEvaluatorName", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
-func (p *EvaluatorServiceClient) GetEvaluatorVersion(ctx context.Context, request *GetEvaluatorVersionRequest) (r *GetEvaluatorVersionResponse, err error) {
-	var _args EvaluatorServiceGetEvaluatorVersionArgs
-	_args.Request = request
-	var _result EvaluatorServiceGetEvaluatorVersionResult
-	if err = p.Client_().Call(ctx, "GetEvaluatorVersion", &_args, &_result); err != nil {
+
+type evaluatorServiceProcessorListEvaluatorVersions struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorListEvaluatorVersions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceListEvaluatorVersionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListEvaluatorVersions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceListEvaluatorVersionsResult{}
+	var retval *ListEvaluatorVersionsResponse
+	if retval, err2 = p.handler.ListEvaluatorVersions(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListEvaluatorVersions: "+err2.Error())
+		oprot.WriteMessageBegin("ListEvaluatorVersions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListEvaluatorVersions", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type evaluatorServiceProcessorGetEvaluatorVersion struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorGetEvaluatorVersion) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceGetEvaluatorVersionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetEvaluatorVersion", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceGetEvaluatorVersionResult{}
+	var retval *GetEvaluatorVersionResponse
+	if retval, err2 = p.handler.GetEvaluatorVersion(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetEvaluatorVersion: "+err2.Error())
+		oprot.WriteMessageBegin("GetEvaluatorVersion", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetEvaluatorVersion", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type evaluatorServiceProcessorBatchGetEvaluatorVersions struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorBatchGetEvaluatorVersions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceBatchGetEvaluatorVersionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchGetEvaluatorVersions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceBatchGetEvaluatorVersionsResult{}
+	var retval *BatchGetEvaluatorVersionsResponse
+	if retval, err2 = p.handler.BatchGetEvaluatorVersions(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchGetEvaluatorVersions: "+err2.Error())
+		oprot.WriteMessageBegin("BatchGetEvaluatorVersions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchGetEvaluatorVersions", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type evaluatorServiceProcessorBatchGetEvaluatorVersionIDs struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorBatchGetEvaluatorVersionIDs) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceBatchGetEvaluatorVersionIDsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchGetEvaluatorVersionIDs", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceBatchGetEvaluatorVersionIDsResult{}
+	var retval *BatchGetEvaluatorVersionIDsResponse
+	if retval, err2 = p.handler.BatchGetEvaluatorVersionIDs(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchGetEvaluatorVersionIDs: "+err2.Error())
+		oprot.WriteMessageBegin("BatchGetEvaluatorVersionIDs", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchGetEvaluatorVersionIDs", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
-func (p *EvaluatorServiceClient) BatchGetEvaluatorVersions(ctx context.Context, request *BatchGetEvaluatorVersionsRequest) (r *BatchGetEvaluatorVersionsResponse, err error) {
-	var _args EvaluatorServiceBatchGetEvaluatorVersionsArgs
-	_args.Request = request
-	var _result EvaluatorServiceBatchGetEvaluatorVersionsResult
-	if err = p.Client_().Call(ctx, "BatchGetEvaluatorVersions", &_args, &_result); err != nil {
+
+type evaluatorServiceProcessorSubmitEvaluatorVersion struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorSubmitEvaluatorVersion) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceSubmitEvaluatorVersionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SubmitEvaluatorVersion", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceSubmitEvaluatorVersionResult{}
+	var retval *SubmitEvaluatorVersionResponse
+	if retval, err2 = p.handler.SubmitEvaluatorVersion(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SubmitEvaluatorVersion: "+err2.Error())
+		oprot.WriteMessageBegin("SubmitEvaluatorVersion", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SubmitEvaluatorVersion", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
-func (p *EvaluatorServiceClient) BatchGetEvaluatorVersionIDs(ctx context.Context, request *BatchGetEvaluatorVersionIDsRequest) (r *BatchGetEvaluatorVersionIDsResponse, err error) {
-	var _args EvaluatorServiceBatchGetEvaluatorVersionIDsArgs
-	_args.Request = request
-	var _result EvaluatorServiceBatchGetEvaluatorVersionIDsResult
-	if err = p.Client_().Call(ctx, "BatchGetEvaluatorVersionIDs", &_args, &_result); err != nil {
+
+type evaluatorServiceProcessorListTemplates struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorListTemplates) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceListTemplatesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListTemplates", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceListTemplatesResult{}
+	var retval *ListTemplatesResponse
+	if retval, err2 = p.handler.ListTemplates(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListTemplates: "+err2.Error())
+		oprot.WriteMessageBegin("ListTemplates", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListTemplates", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
-func (p *EvaluatorServiceClient) SubmitEvaluatorVersion(ctx context.Context, request *SubmitEvaluatorVersionRequest) (r *SubmitEvaluatorVersionResponse, err error) {
-	var _args EvaluatorServiceSubmitEvaluatorVersionArgs
-	_args.Request = request
-	var _result EvaluatorServiceSubmitEvaluatorVersionResult
-	if err = p.Client_().Call(ctx, "SubmitEvaluatorVersion", &_args, &_result); err != nil {
+
+type evaluatorServiceProcessorGetTemplateInfo struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorGetTemplateInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceGetTemplateInfoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetTemplateInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceGetTemplateInfoResult{}
+	var retval *GetTemplateInfoResponse
+	if retval, err2 = p.handler.GetTemplateInfo(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTemplateInfo: "+err2.Error())
+		oprot.WriteMessageBegin("GetTemplateInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetTemplateInfo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
-func (p *EvaluatorServiceClient) ListTemplates(ctx context.Context, request *ListTemplatesRequest) (r *ListTemplatesResponse, err error) {
-	var _args EvaluatorServiceListTemplatesArgs
-	_args.Request = request
-	var _result EvaluatorServiceListTemplatesResult
-	if err = p.Client_().Call(ctx, "ListTemplates", &_args, &_result); err != nil {
+
+type evaluatorServiceProcessorGetDefaultPromptEvaluatorTools struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorGetDefaultPromptEvaluatorTools) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetDefaultPromptEvaluatorTools", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceGetDefaultPromptEvaluatorToolsResult{}
+	var retval *GetDefaultPromptEvaluatorToolsResponse
+	if retval, err2 = p.handler.GetDefaultPromptEvaluatorTools(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetDefaultPromptEvaluatorTools: "+err2.Error())
+		oprot.WriteMessageBegin("GetDefaultPromptEvaluatorTools", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetDefaultPromptEvaluatorTools", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
-func (p *EvaluatorServiceClient) GetTemplateInfo(ctx context.Context, request *GetTemplateInfoRequest) (r *GetTemplateInfoResponse, err error) {
-	var _args EvaluatorServiceGetTemplateInfoArgs
-	_args.Request = request
-	var _result EvaluatorServiceGetTemplateInfoResult
-	if err = p.Client_().Call(ctx, "GetTemplateInfo", &_args, &_result); err != nil {
-		return
+
+type evaluatorServiceProcessorRunEvaluator struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorRunEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceRunEvaluatorArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RunEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceRunEvaluatorResult{}
+	var retval *RunEvaluatorResponse
+	if retval, err2 = p.handler.RunEvaluator(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RunEvaluator: "+err2.Error())
+		oprot.WriteMessageBegin("RunEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RunEvaluator", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *EvaluatorServiceClient) GetDefaultPromptEvaluatorTools(ctx context.Context, req *GetDefaultPromptEvaluatorToolsRequest) (r *GetDefaultPromptEvaluatorToolsResponse, err error) {
-	var _args EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs
-	_args.Req = req
-	var _result EvaluatorServiceGetDefaultPromptEvaluatorToolsResult
-	if err = p.Client_().Call(ctx, "GetDefaultPromptEvaluatorTools", &_args, &_result); err != nil {
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
-func (p *EvaluatorServiceClient) RunEvaluator(ctx context.Context, req *RunEvaluatorRequest) (r *RunEvaluatorResponse, err error) {
-	var _args EvaluatorServiceRunEvaluatorArgs
-	_args.Req = req
-	var _result EvaluatorServiceRunEvaluatorResult
-	if err = p.Client_().Call(ctx, "RunEvaluator", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+type evaluatorServiceProcessorDebugEvaluator struct {
+	handler EvaluatorService
 }
-func (p *EvaluatorServiceClient) DebugEvaluator(ctx context.Context, req *DebugEvaluatorRequest) (r *DebugEvaluatorResponse, err error) {
-	var _args EvaluatorServiceDebugEvaluatorArgs
-	_args.Req = req
-	var _result EvaluatorServiceDebugEvaluatorResult
-	if err = p.Client_().Call(ctx, "DebugEvaluator", &_args, &_result); err != nil {
-		return
+
+func (p *evaluatorServiceProcessorDebugEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceDebugEvaluatorArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DebugEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *EvaluatorServiceClient) BatchDebugEvaluator(ctx context.Context, req *BatchDebugEvaluatorRequest) (r *BatchDebugEvaluatorResponse, err error) {
-	var _args EvaluatorServiceBatchDebugEvaluatorArgs
-	_args.Req = req
-	var _result EvaluatorServiceBatchDebugEvaluatorResult
-	if err = p.Client_().Call(ctx, "BatchDebugEvaluator", &_args, &_result); err != nil {
-		return
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceDebugEvaluatorResult{}
+	var retval *DebugEvaluatorResponse
+	if retval, err2 = p.handler.DebugEvaluator(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DebugEvaluator: "+err2.Error())
+		oprot.WriteMessageBegin("DebugEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *EvaluatorServiceClient) AsyncRunEvaluator(ctx context.Context, req *AsyncRunEvaluatorRequest) (r *AsyncRunEvaluatorResponse, err error) {
-	var _args EvaluatorServiceAsyncRunEvaluatorArgs
-	_args.Req = req
-	var _result EvaluatorServiceAsyncRunEvaluatorResult
-	if err = p.Client_().Call(ctx, "AsyncRunEvaluator", &_args, &_result); err != nil {
-		return
+	if err2 = oprot.WriteMessageBegin("DebugEvaluator", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *EvaluatorServiceClient) AsyncDebugEvaluator(ctx context.Context, req *AsyncDebugEvaluatorRequest) (r *AsyncDebugEvaluatorResponse, err error) {
-	var _args EvaluatorServiceAsyncDebugEvaluatorArgs
-	_args.Req = req
-	var _result EvaluatorServiceAsyncDebugEvaluatorResult
-	if err = p.Client_().Call(ctx, "AsyncDebugEvaluator", &_args, &_result); err != nil {
-		return
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *EvaluatorServiceClient) UpdateEvaluatorRecord(ctx context.Context, req *UpdateEvaluatorRecordRequest) (r *UpdateEvaluatorRecordResponse, err error) {
-	var _args EvaluatorServiceUpdateEvaluatorRecordArgs
-	_args.Req = req
-	var _result EvaluatorServiceUpdateEvaluatorRecordResult
-	if err = p.Client_().Call(ctx, "UpdateEvaluatorRecord", &_args, &_result); err != nil {
-		return
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *EvaluatorServiceClient) GetEvaluatorRecord(ctx context.Context, req *GetEvaluatorRecordRequest) (r *GetEvaluatorRecordResponse, err error) {
-	var _args EvaluatorServiceGetEvaluatorRecordArgs
-	_args.Req = req
-	var _result EvaluatorServiceGetEvaluatorRecordResult
-	if err = p.Client_().Call(ctx, "GetEvaluatorRecord", &_args, &_result); err != nil {
-		return
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *EvaluatorServiceClient) BatchGetEvaluatorRecords(ctx context.Context, req *BatchGetEvaluatorRecordsRequest) (r *BatchGetEvaluatorRecordsResponse, err error) {
-	var _args EvaluatorServiceBatchGetEvaluatorRecordsArgs
-	_args.Req = req
-	var _result EvaluatorServiceBatchGetEvaluatorRecordsResult
-	if err = p.Client_().Call(ctx, "BatchGetEvaluatorRecords", &_args, &_result); err != nil {
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
-func (p *EvaluatorServiceClient) ValidateEvaluator(ctx context.Context, request *ValidateEvaluatorRequest) (r *ValidateEvaluatorResponse, err error) {
-	var _args EvaluatorServiceValidateEvaluatorArgs
-	_args.Request = request
-	var _result EvaluatorServiceValidateEvaluatorResult
-	if err = p.Client_().Call(ctx, "ValidateEvaluator", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+type evaluatorServiceProcessorBatchDebugEvaluator struct {
+	handler EvaluatorService
 }
-func (p *EvaluatorServiceClient) ListTemplatesV2(ctx context.Context, request *ListTemplatesV2Request) (r *ListTemplatesV2Response, err error) {
-	var _args EvaluatorServiceListTemplatesV2Args
-	_args.Request = request
-	var _result EvaluatorServiceListTemplatesV2Result
-	if err = p.Client_().Call(ctx, "ListTemplatesV2", &_args, &_result); err != nil {
-		return
+
+func (p *evaluatorServiceProcessorBatchDebugEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceBatchDebugEvaluatorArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchDebugEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *EvaluatorServiceClient) GetTemplateV2(ctx context.Context, request *GetTemplateV2Request) (r *GetTemplateV2Response, err error) {
-	var _args EvaluatorServiceGetTemplateV2Args
-	_args.Request = request
-	var _result EvaluatorServiceGetTemplateV2Result
-	if err = p.Client_().Call(ctx, "GetTemplateV2", &_args, &_result); err != nil {
-		return
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceBatchDebugEvaluatorResult{}
+	var retval *BatchDebugEvaluatorResponse
+	if retval, err2 = p.handler.BatchDebugEvaluator(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchDebugEvaluator: "+err2.Error())
+		oprot.WriteMessageBegin("BatchDebugEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *EvaluatorServiceClient) CreateEvaluatorTemplate(ctx context.Context, request *CreateEvaluatorTemplateRequest) (r *CreateEvaluatorTemplateResponse, err error) {
-	var _args EvaluatorServiceCreateEvaluatorTemplateArgs
-	_args.Request = request
-	var _result EvaluatorServiceCreateEvaluatorTemplateResult
-	if err = p.Client_().Call(ctx, "CreateEvaluatorTemplate", &_args, &_result); err != nil {
-		return
+	if err2 = oprot.WriteMessageBegin("BatchDebugEvaluator", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *EvaluatorServiceClient) UpdateEvaluatorTemplate(ctx context.Context, request *UpdateEvaluatorTemplateRequest) (r *UpdateEvaluatorTemplateResponse, err error) {
-	var _args EvaluatorServiceUpdateEvaluatorTemplateArgs
-	_args.Request = request
-	var _result EvaluatorServiceUpdateEvaluatorTemplateResult
-	if err = p.Client_().Call(ctx, "UpdateEvaluatorTemplate", &_args, &_result); err != nil {
-		return
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *EvaluatorServiceClient) DeleteEvaluatorTemplate(ctx context.Context, request *DeleteEvaluatorTemplateRequest) (r *DeleteEvaluatorTemplateResponse, err error) {
-	var _args EvaluatorServiceDeleteEvaluatorTemplateArgs
-	_args.Request = request
-	var _result EvaluatorServiceDeleteEvaluatorTemplateResult
-	if err = p.Client_().Call(ctx, "DeleteEvaluatorTemplate", &_args, &_result); err != nil {
-		return
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *EvaluatorServiceClient) DebugBuiltinEvaluator(ctx context.Context, req *DebugBuiltinEvaluatorRequest) (r *DebugBuiltinEvaluatorResponse, err error) {
-	var _args EvaluatorServiceDebugBuiltinEvaluatorArgs
-	_args.Req = req
-	var _result EvaluatorServiceDebugBuiltinEvaluatorResult
-	if err = p.Client_().Call(ctx, "DebugBuiltinEvaluator", &_args, &_result); err != nil {
-		return
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *EvaluatorServiceClient) UpdateBuiltinEvaluatorTags(ctx context.Context, req *UpdateBuiltinEvaluatorTagsRequest) (r *UpdateBuiltinEvaluatorTagsResponse, err error) {
-	var _args EvaluatorServiceUpdateBuiltinEvaluatorTagsArgs
-	_args.Req = req
-	var _result EvaluatorServiceUpdateBuiltinEvaluatorTagsResult
-	if err = p.Client_().Call(ctx, "UpdateBuiltinEvaluatorTags", &_args, &_result); err != nil {
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
-func (p *EvaluatorServiceClient) ListEvaluatorTags(ctx context.Context, req *ListEvaluatorTagsRequest) (r *ListEvaluatorTagsResponse, err error) {
-	var _args EvaluatorServiceListEvaluatorTagsArgs
-	_args.Req = req
-	var _result EvaluatorServiceListEvaluatorTagsResult
-	if err = p.Client_().Call(ctx, "ListEvaluatorTags", &_args, &_result); err != nil {
+
+type evaluatorServiceProcessorAsyncRunEvaluator struct {
+	handler EvaluatorService
+}
+
+func (p *evaluatorServiceProcessorAsyncRunEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceAsyncRunEvaluatorArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AsyncRunEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceAsyncRunEvaluatorResult{}
+	var retval *AsyncRunEvaluatorResponse
+	if retval, err2 = p.handler.AsyncRunEvaluator(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AsyncRunEvaluator: "+err2.Error())
+		oprot.WriteMessageBegin("AsyncRunEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AsyncRunEvaluator", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
 
-type EvaluatorServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      EvaluatorService
+type evaluatorServiceProcessorAsyncDebugEvaluator struct {
+	handler EvaluatorService
 }
 
-func (p *EvaluatorServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
-}
+func (p *evaluatorServiceProcessorAsyncDebugEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceAsyncDebugEvaluatorArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AsyncDebugEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
 
-func (p *EvaluatorServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluatorServiceAsyncDebugEvaluatorResult{}
+	var retval *AsyncDebugEvaluatorResponse
+	if retval, err2 = p.handler.AsyncDebugEvaluator(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AsyncDebugEvaluator: "+err2.Error())
+		oprot.WriteMessageBegin("AsyncDebugEvaluator", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AsyncDebugEvaluator", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-func (p *EvaluatorServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+type evaluatorServiceProcessorUpdateEvaluatorRecord struct {
+	handler EvaluatorService
 }
 
-func NewEvaluatorServiceProcessor(handler EvaluatorService) *EvaluatorServiceProcessor {
-	self := &EvaluatorServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("ListEvaluators", &evaluatorServiceProcessorListEvaluators{handler: handler})
-	self.AddToProcessorMap("BatchGetEvaluators", &evaluatorServiceProcessorBatchGetEvaluators{handler: handler})
-	self.AddToProcessorMap("GetEvaluator", &evaluatorServiceProcessorGetEvaluator{handler: handler})
-	self.AddToProcessorMap("CreateEvaluator", &evaluatorServiceProcessorCreateEvaluator{handler: handler})
-	self.AddToProcessorMap("UpdateEvaluator", &evaluatorServiceProcessorUpdateEvaluator{handler: handler})
-	self.AddToProcessorMap("UpdateEvaluatorDraft", &evaluatorServiceProcessorUpdateEvaluatorDraft{handler: handler})
-	self.AddToProcessorMap("DeleteEvaluator", &evaluatorServiceProcessorDeleteEvaluator{handler: handler})
-	self.AddToProcessorMap("CheckEvaluatorName", &evaluatorServiceProcessorCheckEvaluatorName{handler: handler})
-	self.AddToProcessorMap("ListEvaluatorVersions", &evaluatorServiceProcessorListEvaluatorVersions{handler: handler})
-	self.AddToProcessorMap("GetEvaluatorVersion", &evaluatorServiceProcessorGetEvaluatorVersion{handler: handler})
-	self.AddToProcessorMap("BatchGetEvaluatorVersions", &evaluatorServiceProcessorBatchGetEvaluatorVersions{handler: handler})
-	self.AddToProcessorMap("BatchGetEvaluatorVersionIDs", &evaluatorServiceProcessorBatchGetEvaluatorVersionIDs{handler: handler})
-	self.AddToProcessorMap("SubmitEvaluatorVersion", &evaluatorServiceProcessorSubmitEvaluatorVersion{handler: handler})
-	self.AddToProcessorMap("ListTemplates", &evaluatorServiceProcessorListTemplates{handler: handler})
-	self.AddToProcessorMap("GetTemplateInfo", &evaluatorServiceProcessorGetTemplateInfo{handler: handler})
-	self.AddToProcessorMap("GetDefaultPromptEvaluatorTools", &evaluatorServiceProcessorGetDefaultPromptEvaluatorTools{handler: handler})
-	self.AddToProcessorMap("RunEvaluator", &evaluatorServiceProcessorRunEvaluator{handler: handler})
-	self.AddToProcessorMap("DebugEvaluator", &evaluatorServiceProcessorDebugEvaluator{handler: handler})
-	self.AddToProcessorMap("BatchDebugEvaluator", &evaluatorServiceProcessorBatchDebugEvaluator{handler: handler})
-	self.AddToProcessorMap("AsyncRunEvaluator", &evaluatorServiceProcessorAsyncRunEvaluator{handler: handler})
-	self.AddToProcessorMap("AsyncDebugEvaluator", &evaluatorServiceProcessorAsyncDebugEvaluator{handler: handler})
-	self.AddToProcessorMap("UpdateEvaluatorRecord", &evaluatorServiceProcessorUpdateEvaluatorRecord{handler: handler})
-	self.AddToProcessorMap("GetEvaluatorRecord", &evaluatorServiceProcessorGetEvaluatorRecord{handler: handler})
-	self.AddToProcessorMap("BatchGetEvaluatorRecords", &evaluatorServiceProcessorBatchGetEvaluatorRecords{handler: handler})
-	self.AddToProcessorMap("ValidateEvaluator", &evaluatorServiceProcessorValidateEvaluator{handler: handler})
-	self.AddToProcessorMap("ListTemplatesV2", &evaluatorServiceProcessorListTemplatesV2{handler: handler})
-	self.AddToProcessorMap("GetTemplateV2", &evaluatorServiceProcessorGetTemplateV2{handler: handler})
-	self.AddToProcessorMap("CreateEvaluatorTemplate", &evaluatorServiceProcessorCreateEvaluatorTemplate{handler: handler})
-	self.AddToProcessorMap("UpdateEvaluatorTemplate", &evaluatorServiceProcessorUpdateEvaluatorTemplate{handler: handler})
-	self.AddToProcessorMap("DeleteEvaluatorTemplate", &evaluatorServiceProcessorDeleteEvaluatorTemplate{handler: handler})
-	self.AddToProcessorMap("DebugBuiltinEvaluator", &evaluatorServiceProcessorDebugBuiltinEvaluator{handler: handler})
-	self.AddToProcessorMap("UpdateBuiltinEvaluatorTags", &evaluatorServiceProcessorUpdateBuiltinEvaluatorTags{handler: handler})
-	self.AddToProcessorMap("ListEvaluatorTags", &evaluatorServiceProcessorListEvaluatorTags{handler: handler})
-	return self
-}
-func (p *EvaluatorServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
+func (p *evaluatorServiceProcessorUpdateEvaluatorRecord) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceUpdateEvaluatorRecordArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateEvaluatorRecord", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
 		return false, err
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
-	}
-	iprot.Skip(thrift.STRUCT)
+
 	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	var err2 error
+	result := EvaluatorServiceUpdateEvaluatorRecordResult{}
+	var retval *UpdateEvaluatorRecordResponse
+	if retval, err2 = p.handler.UpdateEvaluatorRecord(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateEvaluatorRecord: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateEvaluatorRecord", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateEvaluatorRecord", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-type evaluatorServiceProcessorListEvaluators struct {
+type evaluatorServiceProcessorGetEvaluatorRecord struct {
 	handler EvaluatorService
 }
 
-func (p *evaluatorServiceProcessorListEvaluators) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceListEvaluatorsArgs{}
+func (p *evaluatorServiceProcessorGetEvaluatorRecord) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceGetEvaluatorRecordArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListEvaluators", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("GetEvaluatorRecord", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -25917,11 +29149,11 @@ func (p *evaluatorServiceProcessorListEvaluators) Process(ctx context.Context, s
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := EvaluatorServiceListEvaluatorsResult{}
-	var retval *ListEvaluatorsResponse
-	if retval, err2 = p.handler.ListEvaluators(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListEvaluators: "+err2.Error())
-		oprot.WriteMessageBegin("ListEvaluators", thrift.EXCEPTION, seqId)
+	result := EvaluatorServiceGetEvaluatorRecordResult{}
+	var retval *GetEvaluatorRecordResponse
+	if retval, err2 = p.handler.GetEvaluatorRecord(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetEvaluatorRecord: "+err2.Error())
+		oprot.WriteMessageBegin("GetEvaluatorRecord", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -25929,7 +29161,7 @@ func (p *evaluatorServiceProcessorListEvaluators) Process(ctx context.Context, s
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("ListEvaluators", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("GetEvaluatorRecord", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -25947,16 +29179,16 @@ func (p *evaluatorServiceProcessorListEvaluators) Process(ctx context.Context, s
 	return true, err
 }
 
-type evaluatorServiceProcessorBatchGetEvaluators struct {
+type evaluatorServiceProcessorBatchGetEvaluatorRecords struct {
 	handler EvaluatorService
 }
 
-func (p *evaluatorServiceProcessorBatchGetEvaluators) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceBatchGetEvaluatorsArgs{}
+func (p *evaluatorServiceProcessorBatchGetEvaluatorRecords) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceBatchGetEvaluatorRecordsArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("BatchGetEvaluators", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("BatchGetEvaluatorRecords", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -25965,11 +29197,11 @@ func (p *evaluatorServiceProcessorBatchGetEvaluators) Process(ctx context.Contex
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := EvaluatorServiceBatchGetEvaluatorsResult{}
-	var retval *BatchGetEvaluatorsResponse
-	if retval, err2 = p.handler.BatchGetEvaluators(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchGetEvaluators: "+err2.Error())
-		oprot.WriteMessageBegin("BatchGetEvaluators", thrift.EXCEPTION, seqId)
+	result := EvaluatorServiceBatchGetEvaluatorRecordsResult{}
+	var retval *BatchGetEvaluatorRecordsResponse
+	if retval, err2 = p.handler.BatchGetEvaluatorRecords(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchGetEvaluatorRecords: "+err2.Error())
+		oprot.WriteMessageBegin("BatchGetEvaluatorRecords", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -25977,7 +29209,7 @@ func (p *evaluatorServiceProcessorBatchGetEvaluators) Process(ctx context.Contex
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("BatchGetEvaluators", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("BatchGetEvaluatorRecords", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -25995,16 +29227,16 @@ func (p *evaluatorServiceProcessorBatchGetEvaluators) Process(ctx context.Contex
 	return true, err
 }
 
-type evaluatorServiceProcessorGetEvaluator struct {
+type evaluatorServiceProcessorRunEvaluatorCalibration struct {
 	handler EvaluatorService
 }
 
-func (p *evaluatorServiceProcessorGetEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceGetEvaluatorArgs{}
+func (p *evaluatorServiceProcessorRunEvaluatorCalibration) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceRunEvaluatorCalibrationArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetEvaluator", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("RunEvaluatorCalibration", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26013,11 +29245,11 @@ func (p *evaluatorServiceProcessorGetEvaluator) Process(ctx context.Context, seq
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := EvaluatorServiceGetEvaluatorResult{}
-	var retval *GetEvaluatorResponse
-	if retval, err2 = p.handler.GetEvaluator(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetEvaluator: "+err2.Error())
-		oprot.WriteMessageBegin("GetEvaluator", thrift.EXCEPTION, seqId)
+	result := EvaluatorServiceRunEvaluatorCalibrationResult{}
+	var retval *RunEvaluatorCalibrationResponse
+	if retval, err2 = p.handler.RunEvaluatorCalibration(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RunEvaluatorCalibration: "+err2.Error())
+		oprot.WriteMessageBegin("RunEvaluatorCalibration", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26025,7 +29257,7 @@ func (p *evaluatorServiceProcessorGetEvaluator) Process(ctx context.Context, seq
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("GetEvaluator", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("RunEvaluatorCalibration", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -26043,16 +29275,16 @@ func (p *evaluatorServiceProcessorGetEvaluator) Process(ctx context.Context, seq
 	return true, err
 }
 
-type evaluatorServiceProcessorCreateEvaluator struct {
+type evaluatorServiceProcessorGetEvaluatorCalibrationRecord struct {
 	handler EvaluatorService
 }
 
-func (p *evaluatorServiceProcessorCreateEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceCreateEvaluatorArgs{}
+func (p *evaluatorServiceProcessorGetEvaluatorCalibrationRecord) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceGetEvaluatorCalibrationRecordArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreateEvaluator", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("GetEvaluatorCalibrationRecord", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26061,11 +29293,11 @@ func (p *evaluatorServiceProcessorCreateEvaluator) Process(ctx context.Context,
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := EvaluatorServiceCreateEvaluatorResult{}
-	var retval *CreateEvaluatorResponse
-	if retval, err2 = p.handler.CreateEvaluator(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateEvaluator: "+err2.Error())
-		oprot.WriteMessageBegin("CreateEvaluator", thrift.EXCEPTION, seqId)
+	result := EvaluatorServiceGetEvaluatorCalibrationRecordResult{}
+	var retval *GetEvaluatorCalibrationRecordResponse
+	if retval, err2 = p.handler.GetEvaluatorCalibrationRecord(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetEvaluatorCalibrationRecord: "+err2.Error())
+		oprot.WriteMessageBegin("GetEvaluatorCalibrationRecord", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26073,7 +29305,7 @@ func (p *evaluatorServiceProcessorCreateEvaluator) Process(ctx context.Context,
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("CreateEvaluator", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("GetEvaluatorCalibrationRecord", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -26091,16 +29323,16 @@ func (p *evaluatorServiceProcessorCreateEvaluator) Process(ctx context.Context,
 	return true, err
 }
 
-type evaluatorServiceProcessorUpdateEvaluator struct {
+type evaluatorServiceProcessorListEvaluatorCalibrationRecords struct {
 	handler EvaluatorService
 }
 
-func (p *evaluatorServiceProcessorUpdateEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceUpdateEvaluatorArgs{}
+func (p *evaluatorServiceProcessorListEvaluatorCalibrationRecords) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceListEvaluatorCalibrationRecordsArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateEvaluator", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("ListEvaluatorCalibrationRecords", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26109,11 +29341,11 @@ func (p *evaluatorServiceProcessorUpdateEvaluator) Process(ctx context.Context,
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := EvaluatorServiceUpdateEvaluatorResult{}
-	var retval *UpdateEvaluatorResponse
-	if retval, err2 = p.handler.UpdateEvaluator(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateEvaluator: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateEvaluator", thrift.EXCEPTION, seqId)
+	result := EvaluatorServiceListEvaluatorCalibrationRecordsResult{}
+	var retval *ListEvaluatorCalibrationRecordsResponse
+	if retval, err2 = p.handler.ListEvaluatorCalibrationRecords(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListEvaluatorCalibrationRecords: "+err2.Error())
+		oprot.WriteMessageBegin("ListEvaluatorCalibrationRecords", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26121,7 +29353,7 @@ func (p *evaluatorServiceProcessorUpdateEvaluator) Process(ctx context.Context,
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("UpdateEvaluator", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("ListEvaluatorCalibrationRecords", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -26139,16 +29371,16 @@ func (p *evaluatorServiceProcessorUpdateEvaluator) Process(ctx context.Context,
 	return true, err
 }
 
-type evaluatorServiceProcessorUpdateEvaluatorDraft struct {
+type evaluatorServiceProcessorValidateEvaluator struct {
 	handler EvaluatorService
 }
 
-func (p *evaluatorServiceProcessorUpdateEvaluatorDraft) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceUpdateEvaluatorDraftArgs{}
+func (p *evaluatorServiceProcessorValidateEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceValidateEvaluatorArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateEvaluatorDraft", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("ValidateEvaluator", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26157,11 +29389,11 @@ func (p *evaluatorServiceProcessorUpdateEvaluatorDraft) Process(ctx context.Cont
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := EvaluatorServiceUpdateEvaluatorDraftResult{}
-	var retval *UpdateEvaluatorDraftResponse
-	if retval, err2 = p.handler.UpdateEvaluatorDraft(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateEvaluatorDraft: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateEvaluatorDraft", thrift.EXCEPTION, seqId)
+	result := EvaluatorServiceValidateEvaluatorResult{}
+	var retval *ValidateEvaluatorResponse
+	if retval, err2 = p.handler.ValidateEvaluator(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ValidateEvaluator: "+err2.Error())
+		oprot.WriteMessageBegin("ValidateEvaluator", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26169,7 +29401,7 @@ func (p *evaluatorServiceProcessorUpdateEvaluatorDraft) Process(ctx context.Cont
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("UpdateEvaluatorDraft", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("ValidateEvaluator", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -26187,16 +29419,16 @@ func (p *evaluatorServiceProcessorUpdateEvaluatorDraft) Process(ctx context.Cont
 	return true, err
 }
 
-type evaluatorServiceProcessorDeleteEvaluator struct {
+type evaluatorServiceProcessorListTemplatesV2 struct {
 	handler EvaluatorService
 }
 
-func (p *evaluatorServiceProcessorDeleteEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceDeleteEvaluatorArgs{}
+func (p *evaluatorServiceProcessorListTemplatesV2) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceListTemplatesV2Args{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeleteEvaluator", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("ListTemplatesV2", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26205,11 +29437,11 @@ func (p *evaluatorServiceProcessorDeleteEvaluator) Process(ctx context.Context,
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := EvaluatorServiceDeleteEvaluatorResult{}
-	var retval *DeleteEvaluatorResponse
-	if retval, err2 = p.handler.DeleteEvaluator(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteEvaluator: "+err2.Error())
-		oprot.WriteMessageBegin("DeleteEvaluator", thrift.EXCEPTION, seqId)
+	result := EvaluatorServiceListTemplatesV2Result{}
+	var retval *ListTemplatesV2Response
+	if retval, err2 = p.handler.ListTemplatesV2(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListTemplatesV2: "+err2.Error())
+		oprot.WriteMessageBegin("ListTemplatesV2", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26217,7 +29449,7 @@ func (p *evaluatorServiceProcessorDeleteEvaluator) Process(ctx context.Context,
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("DeleteEvaluator", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("ListTemplatesV2", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -26235,16 +29467,16 @@ func (p *evaluatorServiceProcessorDeleteEvaluator) Process(ctx context.Context,
 	return true, err
 }
 
-type evaluatorServiceProcessorCheckEvaluatorName struct {
+type evaluatorServiceProcessorGetTemplateV2 struct {
 	handler EvaluatorService
 }
 
-func (p *evaluatorServiceProcessorCheckEvaluatorName) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceCheckEvaluatorNameArgs{}
+func (p *evaluatorServiceProcessorGetTemplateV2) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceGetTemplateV2Args{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CheckEvaluatorName", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("GetTemplateV2", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26253,11 +29485,11 @@ func (p *evaluatorServiceProcessorCheckEvaluatorName) Process(ctx context.Contex
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := EvaluatorServiceCheckEvaluatorNameResult{}
-	var retval *CheckEvaluatorNameResponse
-	if retval, err2 = p.handler.CheckEvaluatorName(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CheckEvaluatorName: "+err2.Error())
-		oprot.WriteMessageBegin("CheckEvaluatorName", thrift.EXCEPTION, seqId)
+	result := EvaluatorServiceGetTemplateV2Result{}
+	var retval *GetTemplateV2Response
+	if retval, err2 = p.handler.GetTemplateV2(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTemplateV2: "+err2.Error())
+		oprot.WriteMessageBegin("GetTemplateV2", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26265,7 +29497,7 @@ func (p *evaluatorServiceProcessorCheckEvaluatorName) Process(ctx context.Contex
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("CheckEvaluatorName", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("GetTemplateV2", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -26283,16 +29515,16 @@ func (p *evaluatorServiceProcessorCheckEvaluatorName) Process(ctx context.Contex
 	return true, err
 }
 
-type evaluatorServiceProcessorListEvaluatorVersions struct {
+type evaluatorServiceProcessorCreateEvaluatorTemplate struct {
 	handler EvaluatorService
 }
 
-func (p *evaluatorServiceProcessorListEvaluatorVersions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceListEvaluatorVersionsArgs{}
+func (p *evaluatorServiceProcessorCreateEvaluatorTemplate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceCreateEvaluatorTemplateArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListEvaluatorVersions", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("CreateEvaluatorTemplate", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26301,11 +29533,11 @@ func (p *evaluatorServiceProcessorListEvaluatorVersions) Process(ctx context.Con
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := EvaluatorServiceListEvaluatorVersionsResult{}
-	var retval *ListEvaluatorVersionsResponse
-	if retval, err2 = p.handler.ListEvaluatorVersions(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListEvaluatorVersions: "+err2.Error())
-		oprot.WriteMessageBegin("ListEvaluatorVersions", thrift.EXCEPTION, seqId)
+	result := EvaluatorServiceCreateEvaluatorTemplateResult{}
+	var retval *CreateEvaluatorTemplateResponse
+	if retval, err2 = p.handler.CreateEvaluatorTemplate(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateEvaluatorTemplate: "+err2.Error())
+		oprot.WriteMessageBegin("CreateEvaluatorTemplate", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26313,7 +29545,7 @@ func (p *evaluatorServiceProcessorListEvaluatorVersions) Process(ctx context.Con
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("ListEvaluatorVersions", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("CreateEvaluatorTemplate", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -26331,16 +29563,16 @@ func (p *evaluatorServiceProcessorListEvaluatorVersions) Process(ctx context.Con
 	return true, err
 }
 
-type evaluatorServiceProcessorGetEvaluatorVersion struct {
+type evaluatorServiceProcessorUpdateEvaluatorTemplate struct {
 	handler EvaluatorService
 }
 
-func (p *evaluatorServiceProcessorGetEvaluatorVersion) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceGetEvaluatorVersionArgs{}
+func (p *evaluatorServiceProcessorUpdateEvaluatorTemplate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceUpdateEvaluatorTemplateArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetEvaluatorVersion", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("UpdateEvaluatorTemplate", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26349,11 +29581,11 @@ func (p *evaluatorServiceProcessorGetEvaluatorVersion) Process(ctx context.Conte
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := EvaluatorServiceGetEvaluatorVersionResult{}
-	var retval *GetEvaluatorVersionResponse
-	if retval, err2 = p.handler.GetEvaluatorVersion(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetEvaluatorVersion: "+err2.Error())
-		oprot.WriteMessageBegin("GetEvaluatorVersion", thrift.EXCEPTION, seqId)
+	result := EvaluatorServiceUpdateEvaluatorTemplateResult{}
+	var retval *UpdateEvaluatorTemplateResponse
+	if retval, err2 = p.handler.UpdateEvaluatorTemplate(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateEvaluatorTemplate: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateEvaluatorTemplate", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26361,7 +29593,7 @@ func (p *evaluatorServiceProcessorGetEvaluatorVersion) Process(ctx context.Conte
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("GetEvaluatorVersion", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("UpdateEvaluatorTemplate", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -26379,16 +29611,16 @@ func (p *evaluatorServiceProcessorGetEvaluatorVersion) Process(ctx context.Conte
 	return true, err
 }
 
-type evaluatorServiceProcessorBatchGetEvaluatorVersions struct {
+type evaluatorServiceProcessorDeleteEvaluatorTemplate struct {
 	handler EvaluatorService
 }
 
-func (p *evaluatorServiceProcessorBatchGetEvaluatorVersions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceBatchGetEvaluatorVersionsArgs{}
+func (p *evaluatorServiceProcessorDeleteEvaluatorTemplate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceDeleteEvaluatorTemplateArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("BatchGetEvaluatorVersions", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("DeleteEvaluatorTemplate", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26397,11 +29629,11 @@ func (p *evaluatorServiceProcessorBatchGetEvaluatorVersions) Process(ctx context
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := EvaluatorServiceBatchGetEvaluatorVersionsResult{}
-	var retval *BatchGetEvaluatorVersionsResponse
-	if retval, err2 = p.handler.BatchGetEvaluatorVersions(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchGetEvaluatorVersions: "+err2.Error())
-		oprot.WriteMessageBegin("BatchGetEvaluatorVersions", thrift.EXCEPTION, seqId)
+	result := EvaluatorServiceDeleteEvaluatorTemplateResult{}
+	var retval *DeleteEvaluatorTemplateResponse
+	if retval, err2 = p.handler.DeleteEvaluatorTemplate(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteEvaluatorTemplate: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteEvaluatorTemplate", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26409,7 +29641,7 @@ func (p *evaluatorServiceProcessorBatchGetEvaluatorVersions) Process(ctx context
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("BatchGetEvaluatorVersions", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("DeleteEvaluatorTemplate", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -26427,16 +29659,16 @@ func (p *evaluatorServiceProcessorBatchGetEvaluatorVersions) Process(ctx context
 	return true, err
 }
 
-type evaluatorServiceProcessorBatchGetEvaluatorVersionIDs struct {
+type evaluatorServiceProcessorDebugBuiltinEvaluator struct {
 	handler EvaluatorService
 }
 
-func (p *evaluatorServiceProcessorBatchGetEvaluatorVersionIDs) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceBatchGetEvaluatorVersionIDsArgs{}
+func (p *evaluatorServiceProcessorDebugBuiltinEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceDebugBuiltinEvaluatorArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("BatchGetEvaluatorVersionIDs", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("DebugBuiltinEvaluator", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26445,11 +29677,11 @@ func (p *evaluatorServiceProcessorBatchGetEvaluatorVersionIDs) Process(ctx conte
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := EvaluatorServiceBatchGetEvaluatorVersionIDsResult{}
-	var retval *BatchGetEvaluatorVersionIDsResponse
-	if retval, err2 = p.handler.BatchGetEvaluatorVersionIDs(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchGetEvaluatorVersionIDs: "+err2.Error())
-		oprot.WriteMessageBegin("BatchGetEvaluatorVersionIDs", thrift.EXCEPTION, seqId)
+	result := EvaluatorServiceDebugBuiltinEvaluatorResult{}
+	var retval *DebugBuiltinEvaluatorResponse
+	if retval, err2 = p.handler.DebugBuiltinEvaluator(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DebugBuiltinEvaluator: "+err2.Error())
+		oprot.WriteMessageBegin("DebugBuiltinEvaluator", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26457,7 +29689,7 @@ func (p *evaluatorServiceProcessorBatchGetEvaluatorVersionIDs) Process(ctx conte
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("BatchGetEvaluatorVersionIDs", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("DebugBuiltinEvaluator", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -26475,16 +29707,16 @@ func (p *evaluatorServiceProcessorBatchGetEvaluatorVersionIDs) Process(ctx conte
 	return true, err
 }
 
-type evaluatorServiceProcessorSubmitEvaluatorVersion struct {
+type evaluatorServiceProcessorUpdateBuiltinEvaluatorTags struct {
 	handler EvaluatorService
 }
 
-func (p *evaluatorServiceProcessorSubmitEvaluatorVersion) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceSubmitEvaluatorVersionArgs{}
+func (p *evaluatorServiceProcessorUpdateBuiltinEvaluatorTags) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceUpdateBuiltinEvaluatorTagsArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SubmitEvaluatorVersion", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("UpdateBuiltinEvaluatorTags", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26493,11 +29725,11 @@ func (p *evaluatorServiceProcessorSubmitEvaluatorVersion) Process(ctx context.Co
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := EvaluatorServiceSubmitEvaluatorVersionResult{}
-	var retval *SubmitEvaluatorVersionResponse
-	if retval, err2 = p.handler.SubmitEvaluatorVersion(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SubmitEvaluatorVersion: "+err2.Error())
-		oprot.WriteMessageBegin("SubmitEvaluatorVersion", thrift.EXCEPTION, seqId)
+	result := EvaluatorServiceUpdateBuiltinEvaluatorTagsResult{}
+	var retval *UpdateBuiltinEvaluatorTagsResponse
+	if retval, err2 = p.handler.UpdateBuiltinEvaluatorTags(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateBuiltinEvaluatorTags: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateBuiltinEvaluatorTags", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26505,7 +29737,7 @@ func (p *evaluatorServiceProcessorSubmitEvaluatorVersion) Process(ctx context.Co
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("SubmitEvaluatorVersion", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("UpdateBuiltinEvaluatorTags", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -26523,16 +29755,16 @@ func (p *evaluatorServiceProcessorSubmitEvaluatorVersion) Process(ctx context.Co
 	return true, err
 }
 
-type evaluatorServiceProcessorListTemplates struct {
+type evaluatorServiceProcessorListEvaluatorTags struct {
 	handler EvaluatorService
 }
 
-func (p *evaluatorServiceProcessorListTemplates) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceListTemplatesArgs{}
+func (p *evaluatorServiceProcessorListEvaluatorTags) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluatorServiceListEvaluatorTagsArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListTemplates", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("ListEvaluatorTags", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26541,11 +29773,11 @@ func (p *evaluatorServiceProcessorListTemplates) Process(ctx context.Context, se
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := EvaluatorServiceListTemplatesResult{}
-	var retval *ListTemplatesResponse
-	if retval, err2 = p.handler.ListTemplates(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListTemplates: "+err2.Error())
-		oprot.WriteMessageBegin("ListTemplates", thrift.EXCEPTION, seqId)
+	result := EvaluatorServiceListEvaluatorTagsResult{}
+	var retval *ListEvaluatorTagsResponse
+	if retval, err2 = p.handler.ListEvaluatorTags(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListEvaluatorTags: "+err2.Error())
+		oprot.WriteMessageBegin("ListEvaluatorTags", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -26553,7 +29785,7 @@ func (p *evaluatorServiceProcessorListTemplates) Process(ctx context.Context, se
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("ListTemplates", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("ListEvaluatorTags", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -26571,953 +29803,1073 @@ func (p *evaluatorServiceProcessorListTemplates) Process(ctx context.Context, se
 	return true, err
 }
 
-type evaluatorServiceProcessorGetTemplateInfo struct {
-	handler EvaluatorService
+type EvaluatorServiceListEvaluatorsArgs struct {
+	Request *ListEvaluatorsRequest `thrift:"request,1" frugal:"1,default,ListEvaluatorsRequest"`
 }
 
-func (p *evaluatorServiceProcessorGetTemplateInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceGetTemplateInfoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetTemplateInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func NewEvaluatorServiceListEvaluatorsArgs() *EvaluatorServiceListEvaluatorsArgs {
+	return &EvaluatorServiceListEvaluatorsArgs{}
+}
+
+func (p *EvaluatorServiceListEvaluatorsArgs) InitDefault() {
+}
+
+var EvaluatorServiceListEvaluatorsArgs_Request_DEFAULT *ListEvaluatorsRequest
+
+func (p *EvaluatorServiceListEvaluatorsArgs) GetRequest() (v *ListEvaluatorsRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRequest() {
+		return EvaluatorServiceListEvaluatorsArgs_Request_DEFAULT
 	}
+	return p.Request
+}
+func (p *EvaluatorServiceListEvaluatorsArgs) SetRequest(val *ListEvaluatorsRequest) {
+	p.Request = val
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceGetTemplateInfoResult{}
-	var retval *GetTemplateInfoResponse
-	if retval, err2 = p.handler.GetTemplateInfo(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTemplateInfo: "+err2.Error())
-		oprot.WriteMessageBegin("GetTemplateInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+var fieldIDToName_EvaluatorServiceListEvaluatorsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *EvaluatorServiceListEvaluatorsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *EvaluatorServiceListEvaluatorsArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("GetTemplateInfo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorServiceListEvaluatorsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListEvaluatorsRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	p.Request = _field
+	return nil
+}
+
+func (p *EvaluatorServiceListEvaluatorsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListEvaluators_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
-}
-
-type evaluatorServiceProcessorGetDefaultPromptEvaluatorTools struct {
-	handler EvaluatorService
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *evaluatorServiceProcessorGetDefaultPromptEvaluatorTools) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetDefaultPromptEvaluatorTools", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *EvaluatorServiceListEvaluatorsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceGetDefaultPromptEvaluatorToolsResult{}
-	var retval *GetDefaultPromptEvaluatorToolsResponse
-	if retval, err2 = p.handler.GetDefaultPromptEvaluatorTools(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetDefaultPromptEvaluatorTools: "+err2.Error())
-		oprot.WriteMessageBegin("GetDefaultPromptEvaluatorTools", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if err := p.Request.Write(oprot); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageBegin("GetDefaultPromptEvaluatorTools", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *EvaluatorServiceListEvaluatorsArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("EvaluatorServiceListEvaluatorsArgs(%+v)", *p)
+
+}
+
+func (p *EvaluatorServiceListEvaluatorsArgs) DeepEqual(ano *EvaluatorServiceListEvaluatorsArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field1DeepEqual(ano.Request) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *EvaluatorServiceListEvaluatorsArgs) Field1DeepEqual(src *ListEvaluatorsRequest) bool {
+
+	if !p.Request.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type evaluatorServiceProcessorRunEvaluator struct {
-	handler EvaluatorService
+type EvaluatorServiceListEvaluatorsResult struct {
+	Success *ListEvaluatorsResponse `thrift:"success,0,optional" frugal:"0,optional,ListEvaluatorsResponse"`
 }
 
-func (p *evaluatorServiceProcessorRunEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceRunEvaluatorArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("RunEvaluator", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewEvaluatorServiceListEvaluatorsResult() *EvaluatorServiceListEvaluatorsResult {
+	return &EvaluatorServiceListEvaluatorsResult{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceRunEvaluatorResult{}
-	var retval *RunEvaluatorResponse
-	if retval, err2 = p.handler.RunEvaluator(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RunEvaluator: "+err2.Error())
-		oprot.WriteMessageBegin("RunEvaluator", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("RunEvaluator", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
+func (p *EvaluatorServiceListEvaluatorsResult) InitDefault() {
+}
+
+var EvaluatorServiceListEvaluatorsResult_Success_DEFAULT *ListEvaluatorsResponse
+
+func (p *EvaluatorServiceListEvaluatorsResult) GetSuccess() (v *ListEvaluatorsResponse) {
+	if p == nil {
 		return
 	}
-	return true, err
+	if !p.IsSetSuccess() {
+		return EvaluatorServiceListEvaluatorsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *EvaluatorServiceListEvaluatorsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListEvaluatorsResponse)
 }
 
-type evaluatorServiceProcessorDebugEvaluator struct {
-	handler EvaluatorService
+var fieldIDToName_EvaluatorServiceListEvaluatorsResult = map[int16]string{
+	0: "success",
 }
 
-func (p *evaluatorServiceProcessorDebugEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceDebugEvaluatorArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DebugEvaluator", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *EvaluatorServiceListEvaluatorsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *EvaluatorServiceListEvaluatorsResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceDebugEvaluatorResult{}
-	var retval *DebugEvaluatorResponse
-	if retval, err2 = p.handler.DebugEvaluator(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DebugEvaluator: "+err2.Error())
-		oprot.WriteMessageBegin("DebugEvaluator", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("DebugEvaluator", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorServiceListEvaluatorsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListEvaluatorsResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	p.Success = _field
+	return nil
+}
+
+func (p *EvaluatorServiceListEvaluatorsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListEvaluators_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type evaluatorServiceProcessorBatchDebugEvaluator struct {
-	handler EvaluatorService
+func (p *EvaluatorServiceListEvaluatorsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *evaluatorServiceProcessorBatchDebugEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceBatchDebugEvaluatorArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("BatchDebugEvaluator", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *EvaluatorServiceListEvaluatorsResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("EvaluatorServiceListEvaluatorsResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceBatchDebugEvaluatorResult{}
-	var retval *BatchDebugEvaluatorResponse
-	if retval, err2 = p.handler.BatchDebugEvaluator(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchDebugEvaluator: "+err2.Error())
-		oprot.WriteMessageBegin("BatchDebugEvaluator", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("BatchDebugEvaluator", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+}
+
+func (p *EvaluatorServiceListEvaluatorsResult) DeepEqual(ano *EvaluatorServiceListEvaluatorsResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *EvaluatorServiceListEvaluatorsResult) Field0DeepEqual(src *ListEvaluatorsResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type evaluatorServiceProcessorAsyncRunEvaluator struct {
-	handler EvaluatorService
+type EvaluatorServiceBatchGetEvaluatorsArgs struct {
+	Request *BatchGetEvaluatorsRequest `thrift:"request,1" frugal:"1,default,BatchGetEvaluatorsRequest"`
 }
 
-func (p *evaluatorServiceProcessorAsyncRunEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceAsyncRunEvaluatorArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AsyncRunEvaluator", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewEvaluatorServiceBatchGetEvaluatorsArgs() *EvaluatorServiceBatchGetEvaluatorsArgs {
+	return &EvaluatorServiceBatchGetEvaluatorsArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceAsyncRunEvaluatorResult{}
-	var retval *AsyncRunEvaluatorResponse
-	if retval, err2 = p.handler.AsyncRunEvaluator(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AsyncRunEvaluator: "+err2.Error())
-		oprot.WriteMessageBegin("AsyncRunEvaluator", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("AsyncRunEvaluator", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) InitDefault() {
+}
+
+var EvaluatorServiceBatchGetEvaluatorsArgs_Request_DEFAULT *BatchGetEvaluatorsRequest
+
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) GetRequest() (v *BatchGetEvaluatorsRequest) {
+	if p == nil {
 		return
 	}
-	return true, err
+	if !p.IsSetRequest() {
+		return EvaluatorServiceBatchGetEvaluatorsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) SetRequest(val *BatchGetEvaluatorsRequest) {
+	p.Request = val
 }
 
-type evaluatorServiceProcessorAsyncDebugEvaluator struct {
-	handler EvaluatorService
+var fieldIDToName_EvaluatorServiceBatchGetEvaluatorsArgs = map[int16]string{
+	1: "request",
 }
 
-func (p *evaluatorServiceProcessorAsyncDebugEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceAsyncDebugEvaluatorArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AsyncDebugEvaluator", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceAsyncDebugEvaluatorResult{}
-	var retval *AsyncDebugEvaluatorResponse
-	if retval, err2 = p.handler.AsyncDebugEvaluator(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AsyncDebugEvaluator: "+err2.Error())
-		oprot.WriteMessageBegin("AsyncDebugEvaluator", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("AsyncDebugEvaluator", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type evaluatorServiceProcessorUpdateEvaluatorRecord struct {
-	handler EvaluatorService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *evaluatorServiceProcessorUpdateEvaluatorRecord) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceUpdateEvaluatorRecordArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateEvaluatorRecord", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchGetEvaluatorsRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Request = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceUpdateEvaluatorRecordResult{}
-	var retval *UpdateEvaluatorRecordResponse
-	if retval, err2 = p.handler.UpdateEvaluatorRecord(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateEvaluatorRecord: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateEvaluatorRecord", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UpdateEvaluatorRecord", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchGetEvaluators_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
-}
-
-type evaluatorServiceProcessorGetEvaluatorRecord struct {
-	handler EvaluatorService
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *evaluatorServiceProcessorGetEvaluatorRecord) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceGetEvaluatorRecordArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetEvaluatorRecord", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceGetEvaluatorRecordResult{}
-	var retval *GetEvaluatorRecordResponse
-	if retval, err2 = p.handler.GetEvaluatorRecord(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetEvaluatorRecord: "+err2.Error())
-		oprot.WriteMessageBegin("GetEvaluatorRecord", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if err := p.Request.Write(oprot); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageBegin("GetEvaluatorRecord", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("EvaluatorServiceBatchGetEvaluatorsArgs(%+v)", *p)
+
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) DeepEqual(ano *EvaluatorServiceBatchGetEvaluatorsArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field1DeepEqual(ano.Request) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) Field1DeepEqual(src *BatchGetEvaluatorsRequest) bool {
+
+	if !p.Request.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type evaluatorServiceProcessorBatchGetEvaluatorRecords struct {
-	handler EvaluatorService
+type EvaluatorServiceBatchGetEvaluatorsResult struct {
+	Success *BatchGetEvaluatorsResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetEvaluatorsResponse"`
 }
 
-func (p *evaluatorServiceProcessorBatchGetEvaluatorRecords) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceBatchGetEvaluatorRecordsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("BatchGetEvaluatorRecords", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewEvaluatorServiceBatchGetEvaluatorsResult() *EvaluatorServiceBatchGetEvaluatorsResult {
+	return &EvaluatorServiceBatchGetEvaluatorsResult{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceBatchGetEvaluatorRecordsResult{}
-	var retval *BatchGetEvaluatorRecordsResponse
-	if retval, err2 = p.handler.BatchGetEvaluatorRecords(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchGetEvaluatorRecords: "+err2.Error())
-		oprot.WriteMessageBegin("BatchGetEvaluatorRecords", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("BatchGetEvaluatorRecords", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) InitDefault() {
+}
+
+var EvaluatorServiceBatchGetEvaluatorsResult_Success_DEFAULT *BatchGetEvaluatorsResponse
+
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) GetSuccess() (v *BatchGetEvaluatorsResponse) {
+	if p == nil {
+		return
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if !p.IsSetSuccess() {
+		return EvaluatorServiceBatchGetEvaluatorsResult_Success_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Success
+}
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchGetEvaluatorsResponse)
+}
+
+var fieldIDToName_EvaluatorServiceBatchGetEvaluatorsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type evaluatorServiceProcessorValidateEvaluator struct {
-	handler EvaluatorService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *evaluatorServiceProcessorValidateEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceValidateEvaluatorArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ValidateEvaluator", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchGetEvaluatorsResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceValidateEvaluatorResult{}
-	var retval *ValidateEvaluatorResponse
-	if retval, err2 = p.handler.ValidateEvaluator(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ValidateEvaluator: "+err2.Error())
-		oprot.WriteMessageBegin("ValidateEvaluator", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ValidateEvaluator", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchGetEvaluators_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type evaluatorServiceProcessorListTemplatesV2 struct {
-	handler EvaluatorService
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *evaluatorServiceProcessorListTemplatesV2) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceListTemplatesV2Args{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListTemplatesV2", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("EvaluatorServiceBatchGetEvaluatorsResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceListTemplatesV2Result{}
-	var retval *ListTemplatesV2Response
-	if retval, err2 = p.handler.ListTemplatesV2(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListTemplatesV2: "+err2.Error())
-		oprot.WriteMessageBegin("ListTemplatesV2", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ListTemplatesV2", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) DeepEqual(ano *EvaluatorServiceBatchGetEvaluatorsResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) Field0DeepEqual(src *BatchGetEvaluatorsResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type evaluatorServiceProcessorGetTemplateV2 struct {
-	handler EvaluatorService
+type EvaluatorServiceGetEvaluatorArgs struct {
+	Request *GetEvaluatorRequest `thrift:"request,1" frugal:"1,default,GetEvaluatorRequest"`
 }
 
-func (p *evaluatorServiceProcessorGetTemplateV2) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceGetTemplateV2Args{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetTemplateV2", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewEvaluatorServiceGetEvaluatorArgs() *EvaluatorServiceGetEvaluatorArgs {
+	return &EvaluatorServiceGetEvaluatorArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceGetTemplateV2Result{}
-	var retval *GetTemplateV2Response
-	if retval, err2 = p.handler.GetTemplateV2(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTemplateV2: "+err2.Error())
-		oprot.WriteMessageBegin("GetTemplateV2", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetTemplateV2", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+func (p *EvaluatorServiceGetEvaluatorArgs) InitDefault() {
+}
+
+var EvaluatorServiceGetEvaluatorArgs_Request_DEFAULT *GetEvaluatorRequest
+
+func (p *EvaluatorServiceGetEvaluatorArgs) GetRequest() (v *GetEvaluatorRequest) {
+	if p == nil {
+		return
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if !p.IsSetRequest() {
+		return EvaluatorServiceGetEvaluatorArgs_Request_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Request
+}
+func (p *EvaluatorServiceGetEvaluatorArgs) SetRequest(val *GetEvaluatorRequest) {
+	p.Request = val
+}
+
+var fieldIDToName_EvaluatorServiceGetEvaluatorArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *EvaluatorServiceGetEvaluatorArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *EvaluatorServiceGetEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type evaluatorServiceProcessorCreateEvaluatorTemplate struct {
-	handler EvaluatorService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *evaluatorServiceProcessorCreateEvaluatorTemplate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceCreateEvaluatorTemplateArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreateEvaluatorTemplate", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *EvaluatorServiceGetEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetEvaluatorRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Request = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceCreateEvaluatorTemplateResult{}
-	var retval *CreateEvaluatorTemplateResponse
-	if retval, err2 = p.handler.CreateEvaluatorTemplate(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateEvaluatorTemplate: "+err2.Error())
-		oprot.WriteMessageBegin("CreateEvaluatorTemplate", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("CreateEvaluatorTemplate", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *EvaluatorServiceGetEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetEvaluator_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
-}
-
-type evaluatorServiceProcessorUpdateEvaluatorTemplate struct {
-	handler EvaluatorService
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *evaluatorServiceProcessorUpdateEvaluatorTemplate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceUpdateEvaluatorTemplateArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateEvaluatorTemplate", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceUpdateEvaluatorTemplateResult{}
-	var retval *UpdateEvaluatorTemplateResponse
-	if retval, err2 = p.handler.UpdateEvaluatorTemplate(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateEvaluatorTemplate: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateEvaluatorTemplate", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *EvaluatorServiceGetEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("UpdateEvaluatorTemplate", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err := p.Request.Write(oprot); err != nil {
+		return err
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *EvaluatorServiceGetEvaluatorArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("EvaluatorServiceGetEvaluatorArgs(%+v)", *p)
+
+}
+
+func (p *EvaluatorServiceGetEvaluatorArgs) DeepEqual(ano *EvaluatorServiceGetEvaluatorArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err != nil {
-		return
+	if !p.Field1DeepEqual(ano.Request) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type evaluatorServiceProcessorDeleteEvaluatorTemplate struct {
-	handler EvaluatorService
-}
+func (p *EvaluatorServiceGetEvaluatorArgs) Field1DeepEqual(src *GetEvaluatorRequest) bool {
 
-func (p *evaluatorServiceProcessorDeleteEvaluatorTemplate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceDeleteEvaluatorTemplateArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeleteEvaluatorTemplate", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+	if !p.Request.DeepEqual(src) {
+		return false
 	}
+	return true
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceDeleteEvaluatorTemplateResult{}
-	var retval *DeleteEvaluatorTemplateResponse
-	if retval, err2 = p.handler.DeleteEvaluatorTemplate(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteEvaluatorTemplate: "+err2.Error())
-		oprot.WriteMessageBegin("DeleteEvaluatorTemplate", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("DeleteEvaluatorTemplate", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+type EvaluatorServiceGetEvaluatorResult struct {
+	Success *GetEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvaluatorResponse"`
 }
 
-type evaluatorServiceProcessorDebugBuiltinEvaluator struct {
-	handler EvaluatorService
+func NewEvaluatorServiceGetEvaluatorResult() *EvaluatorServiceGetEvaluatorResult {
+	return &EvaluatorServiceGetEvaluatorResult{}
 }
 
-func (p *evaluatorServiceProcessorDebugBuiltinEvaluator) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceDebugBuiltinEvaluatorArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DebugBuiltinEvaluator", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *EvaluatorServiceGetEvaluatorResult) InitDefault() {
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceDebugBuiltinEvaluatorResult{}
-	var retval *DebugBuiltinEvaluatorResponse
-	if retval, err2 = p.handler.DebugBuiltinEvaluator(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DebugBuiltinEvaluator: "+err2.Error())
-		oprot.WriteMessageBegin("DebugBuiltinEvaluator", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("DebugBuiltinEvaluator", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
+var EvaluatorServiceGetEvaluatorResult_Success_DEFAULT *GetEvaluatorResponse
+
+func (p *EvaluatorServiceGetEvaluatorResult) GetSuccess() (v *GetEvaluatorResponse) {
+	if p == nil {
 		return
 	}
-	return true, err
+	if !p.IsSetSuccess() {
+		return EvaluatorServiceGetEvaluatorResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *EvaluatorServiceGetEvaluatorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetEvaluatorResponse)
 }
 
-type evaluatorServiceProcessorUpdateBuiltinEvaluatorTags struct {
-	handler EvaluatorService
+var fieldIDToName_EvaluatorServiceGetEvaluatorResult = map[int16]string{
+	0: "success",
 }
 
-func (p *evaluatorServiceProcessorUpdateBuiltinEvaluatorTags) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceUpdateBuiltinEvaluatorTagsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateBuiltinEvaluatorTags", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *EvaluatorServiceGetEvaluatorResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *EvaluatorServiceGetEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceUpdateBuiltinEvaluatorTagsResult{}
-	var retval *UpdateBuiltinEvaluatorTagsResponse
-	if retval, err2 = p.handler.UpdateBuiltinEvaluatorTags(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateBuiltinEvaluatorTags: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateBuiltinEvaluatorTags", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("UpdateBuiltinEvaluatorTags", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorServiceGetEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetEvaluatorResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	p.Success = _field
+	return nil
+}
+
+func (p *EvaluatorServiceGetEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetEvaluator_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return true, err
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type evaluatorServiceProcessorListEvaluatorTags struct {
-	handler EvaluatorService
+func (p *EvaluatorServiceGetEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *evaluatorServiceProcessorListEvaluatorTags) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := EvaluatorServiceListEvaluatorTagsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListEvaluatorTags", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *EvaluatorServiceGetEvaluatorResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("EvaluatorServiceGetEvaluatorResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := EvaluatorServiceListEvaluatorTagsResult{}
-	var retval *ListEvaluatorTagsResponse
-	if retval, err2 = p.handler.ListEvaluatorTags(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListEvaluatorTags: "+err2.Error())
-		oprot.WriteMessageBegin("ListEvaluatorTags", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ListEvaluatorTags", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+}
+
+func (p *EvaluatorServiceGetEvaluatorResult) DeepEqual(ano *EvaluatorServiceGetEvaluatorResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *EvaluatorServiceGetEvaluatorResult) Field0DeepEqual(src *GetEvaluatorResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type EvaluatorServiceListEvaluatorsArgs struct {
-	Request *ListEvaluatorsRequest `thrift:"request,1" frugal:"1,default,ListEvaluatorsRequest"`
+type EvaluatorServiceCreateEvaluatorArgs struct {
+	Request *CreateEvaluatorRequest `thrift:"request,1" frugal:"1,default,CreateEvaluatorRequest"`
 }
 
-func NewEvaluatorServiceListEvaluatorsArgs() *EvaluatorServiceListEvaluatorsArgs {
-	return &EvaluatorServiceListEvaluatorsArgs{}
+func NewEvaluatorServiceCreateEvaluatorArgs() *EvaluatorServiceCreateEvaluatorArgs {
+	return &EvaluatorServiceCreateEvaluatorArgs{}
 }
 
-func (p *EvaluatorServiceListEvaluatorsArgs) InitDefault() {
+func (p *EvaluatorServiceCreateEvaluatorArgs) InitDefault() {
 }
 
-var EvaluatorServiceListEvaluatorsArgs_Request_DEFAULT *ListEvaluatorsRequest
+var EvaluatorServiceCreateEvaluatorArgs_Request_DEFAULT *CreateEvaluatorRequest
 
-func (p *EvaluatorServiceListEvaluatorsArgs) GetRequest() (v *ListEvaluatorsRequest) {
+func (p *EvaluatorServiceCreateEvaluatorArgs) GetRequest() (v *CreateEvaluatorRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return EvaluatorServiceListEvaluatorsArgs_Request_DEFAULT
+		return EvaluatorServiceCreateEvaluatorArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *EvaluatorServiceListEvaluatorsArgs) SetRequest(val *ListEvaluatorsRequest) {
+func (p *EvaluatorServiceCreateEvaluatorArgs) SetRequest(val *CreateEvaluatorRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_EvaluatorServiceListEvaluatorsArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceCreateEvaluatorArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *EvaluatorServiceListEvaluatorsArgs) IsSetRequest() bool {
+func (p *EvaluatorServiceCreateEvaluatorArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *EvaluatorServiceListEvaluatorsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceCreateEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -27562,7 +30914,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceCreateEvaluatorArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -27572,8 +30924,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListEvaluatorsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListEvaluatorsRequest()
+func (p *EvaluatorServiceCreateEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateEvaluatorRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -27581,9 +30933,9 @@ func (p *EvaluatorServiceListEvaluatorsArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *EvaluatorServiceListEvaluatorsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceCreateEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListEvaluators_args"); err != nil {
+	if err = oprot.WriteStructBegin("CreateEvaluator_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -27609,7 +30961,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListEvaluatorsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceCreateEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -27626,15 +30978,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListEvaluatorsArgs) String() string {
+func (p *EvaluatorServiceCreateEvaluatorArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceListEvaluatorsArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceCreateEvaluatorArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceListEvaluatorsArgs) DeepEqual(ano *EvaluatorServiceListEvaluatorsArgs) bool {
+func (p *EvaluatorServiceCreateEvaluatorArgs) DeepEqual(ano *EvaluatorServiceCreateEvaluatorArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -27646,7 +30998,7 @@ func (p *EvaluatorServiceListEvaluatorsArgs) DeepEqual(ano *EvaluatorServiceList
 	return true
 }
 
-func (p *EvaluatorServiceListEvaluatorsArgs) Field1DeepEqual(src *ListEvaluatorsRequest) bool {
+func (p *EvaluatorServiceCreateEvaluatorArgs) Field1DeepEqual(src *CreateEvaluatorRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -27654,41 +31006,41 @@ func (p *EvaluatorServiceListEvaluatorsArgs) Field1DeepEqual(src *ListEvaluators
 	return true
 }
 
-type EvaluatorServiceListEvaluatorsResult struct {
-	Success *ListEvaluatorsResponse `thrift:"success,0,optional" frugal:"0,optional,ListEvaluatorsResponse"`
+type EvaluatorServiceCreateEvaluatorResult struct {
+	Success *CreateEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,CreateEvaluatorResponse"`
 }
 
-func NewEvaluatorServiceListEvaluatorsResult() *EvaluatorServiceListEvaluatorsResult {
-	return &EvaluatorServiceListEvaluatorsResult{}
+func NewEvaluatorServiceCreateEvaluatorResult() *EvaluatorServiceCreateEvaluatorResult {
+	return &EvaluatorServiceCreateEvaluatorResult{}
 }
 
-func (p *EvaluatorServiceListEvaluatorsResult) InitDefault() {
+func (p *EvaluatorServiceCreateEvaluatorResult) InitDefault() {
 }
 
-var EvaluatorServiceListEvaluatorsResult_Success_DEFAULT *ListEvaluatorsResponse
+var EvaluatorServiceCreateEvaluatorResult_Success_DEFAULT *CreateEvaluatorResponse
 
-func (p *EvaluatorServiceListEvaluatorsResult) GetSuccess() (v *ListEvaluatorsResponse) {
+func (p *EvaluatorServiceCreateEvaluatorResult) GetSuccess() (v *CreateEvaluatorResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceListEvaluatorsResult_Success_DEFAULT
+		return EvaluatorServiceCreateEvaluatorResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceListEvaluatorsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListEvaluatorsResponse)
+func (p *EvaluatorServiceCreateEvaluatorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateEvaluatorResponse)
 }
 
-var fieldIDToName_EvaluatorServiceListEvaluatorsResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceCreateEvaluatorResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceListEvaluatorsResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceCreateEvaluatorResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceListEvaluatorsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceCreateEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -27733,7 +31085,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceCreateEvaluatorResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -27743,8 +31095,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListEvaluatorsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListEvaluatorsResponse()
+func (p *EvaluatorServiceCreateEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateEvaluatorResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -27752,9 +31104,9 @@ func (p *EvaluatorServiceListEvaluatorsResult) ReadField0(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *EvaluatorServiceListEvaluatorsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceCreateEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListEvaluators_result"); err != nil {
+	if err = oprot.WriteStructBegin("CreateEvaluator_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -27780,7 +31132,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListEvaluatorsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceCreateEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -27799,15 +31151,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListEvaluatorsResult) String() string {
+func (p *EvaluatorServiceCreateEvaluatorResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceListEvaluatorsResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceCreateEvaluatorResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceListEvaluatorsResult) DeepEqual(ano *EvaluatorServiceListEvaluatorsResult) bool {
+func (p *EvaluatorServiceCreateEvaluatorResult) DeepEqual(ano *EvaluatorServiceCreateEvaluatorResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -27819,7 +31171,7 @@ func (p *EvaluatorServiceListEvaluatorsResult) DeepEqual(ano *EvaluatorServiceLi
 	return true
 }
 
-func (p *EvaluatorServiceListEvaluatorsResult) Field0DeepEqual(src *ListEvaluatorsResponse) bool {
+func (p *EvaluatorServiceCreateEvaluatorResult) Field0DeepEqual(src *CreateEvaluatorResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -27827,41 +31179,41 @@ func (p *EvaluatorServiceListEvaluatorsResult) Field0DeepEqual(src *ListEvaluato
 	return true
 }
 
-type EvaluatorServiceBatchGetEvaluatorsArgs struct {
-	Request *BatchGetEvaluatorsRequest `thrift:"request,1" frugal:"1,default,BatchGetEvaluatorsRequest"`
+type EvaluatorServiceUpdateEvaluatorArgs struct {
+	Request *UpdateEvaluatorRequest `thrift:"request,1" frugal:"1,default,UpdateEvaluatorRequest"`
 }
 
-func NewEvaluatorServiceBatchGetEvaluatorsArgs() *EvaluatorServiceBatchGetEvaluatorsArgs {
-	return &EvaluatorServiceBatchGetEvaluatorsArgs{}
+func NewEvaluatorServiceUpdateEvaluatorArgs() *EvaluatorServiceUpdateEvaluatorArgs {
+	return &EvaluatorServiceUpdateEvaluatorArgs{}
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) InitDefault() {
+func (p *EvaluatorServiceUpdateEvaluatorArgs) InitDefault() {
 }
 
-var EvaluatorServiceBatchGetEvaluatorsArgs_Request_DEFAULT *BatchGetEvaluatorsRequest
+var EvaluatorServiceUpdateEvaluatorArgs_Request_DEFAULT *UpdateEvaluatorRequest
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) GetRequest() (v *BatchGetEvaluatorsRequest) {
+func (p *EvaluatorServiceUpdateEvaluatorArgs) GetRequest() (v *UpdateEvaluatorRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return EvaluatorServiceBatchGetEvaluatorsArgs_Request_DEFAULT
+		return EvaluatorServiceUpdateEvaluatorArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) SetRequest(val *BatchGetEvaluatorsRequest) {
+func (p *EvaluatorServiceUpdateEvaluatorArgs) SetRequest(val *UpdateEvaluatorRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_EvaluatorServiceBatchGetEvaluatorsArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceUpdateEvaluatorArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) IsSetRequest() bool {
+func (p *EvaluatorServiceUpdateEvaluatorArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -27906,7 +31258,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -27916,8 +31268,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewBatchGetEvaluatorsRequest()
+func (p *EvaluatorServiceUpdateEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateEvaluatorRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -27925,9 +31277,9 @@ func (p *EvaluatorServiceBatchGetEvaluatorsArgs) ReadField1(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetEvaluators_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateEvaluator_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -27953,7 +31305,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -27970,15 +31322,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) String() string {
+func (p *EvaluatorServiceUpdateEvaluatorArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceBatchGetEvaluatorsArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceUpdateEvaluatorArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) DeepEqual(ano *EvaluatorServiceBatchGetEvaluatorsArgs) bool {
+func (p *EvaluatorServiceUpdateEvaluatorArgs) DeepEqual(ano *EvaluatorServiceUpdateEvaluatorArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -27990,7 +31342,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorsArgs) DeepEqual(ano *EvaluatorService
 	return true
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) Field1DeepEqual(src *BatchGetEvaluatorsRequest) bool {
+func (p *EvaluatorServiceUpdateEvaluatorArgs) Field1DeepEqual(src *UpdateEvaluatorRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -27998,41 +31350,41 @@ func (p *EvaluatorServiceBatchGetEvaluatorsArgs) Field1DeepEqual(src *BatchGetEv
 	return true
 }
 
-type EvaluatorServiceBatchGetEvaluatorsResult struct {
-	Success *BatchGetEvaluatorsResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetEvaluatorsResponse"`
+type EvaluatorServiceUpdateEvaluatorResult struct {
+	Success *UpdateEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateEvaluatorResponse"`
 }
 
-func NewEvaluatorServiceBatchGetEvaluatorsResult() *EvaluatorServiceBatchGetEvaluatorsResult {
-	return &EvaluatorServiceBatchGetEvaluatorsResult{}
+func NewEvaluatorServiceUpdateEvaluatorResult() *EvaluatorServiceUpdateEvaluatorResult {
+	return &EvaluatorServiceUpdateEvaluatorResult{}
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) InitDefault() {
+func (p *EvaluatorServiceUpdateEvaluatorResult) InitDefault() {
 }
 
-var EvaluatorServiceBatchGetEvaluatorsResult_Success_DEFAULT *BatchGetEvaluatorsResponse
+var EvaluatorServiceUpdateEvaluatorResult_Success_DEFAULT *UpdateEvaluatorResponse
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) GetSuccess() (v *BatchGetEvaluatorsResponse) {
+func (p *EvaluatorServiceUpdateEvaluatorResult) GetSuccess() (v *UpdateEvaluatorResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceBatchGetEvaluatorsResult_Success_DEFAULT
+		return EvaluatorServiceUpdateEvaluatorResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchGetEvaluatorsResponse)
+func (p *EvaluatorServiceUpdateEvaluatorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateEvaluatorResponse)
 }
 
-var fieldIDToName_EvaluatorServiceBatchGetEvaluatorsResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceUpdateEvaluatorResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceUpdateEvaluatorResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -28077,7 +31429,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -28087,8 +31439,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewBatchGetEvaluatorsResponse()
+func (p *EvaluatorServiceUpdateEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateEvaluatorResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -28096,9 +31448,9 @@ func (p *EvaluatorServiceBatchGetEvaluatorsResult) ReadField0(iprot thrift.TProt
 	return nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetEvaluators_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateEvaluator_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -28124,7 +31476,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -28143,15 +31495,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) String() string {
+func (p *EvaluatorServiceUpdateEvaluatorResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceBatchGetEvaluatorsResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceUpdateEvaluatorResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) DeepEqual(ano *EvaluatorServiceBatchGetEvaluatorsResult) bool {
+func (p *EvaluatorServiceUpdateEvaluatorResult) DeepEqual(ano *EvaluatorServiceUpdateEvaluatorResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -28163,7 +31515,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorsResult) DeepEqual(ano *EvaluatorServi
 	return true
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) Field0DeepEqual(src *BatchGetEvaluatorsResponse) bool {
+func (p *EvaluatorServiceUpdateEvaluatorResult) Field0DeepEqual(src *UpdateEvaluatorResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -28171,41 +31523,41 @@ func (p *EvaluatorServiceBatchGetEvaluatorsResult) Field0DeepEqual(src *BatchGet
 	return true
 }
 
-type EvaluatorServiceGetEvaluatorArgs struct {
-	Request *GetEvaluatorRequest `thrift:"request,1" frugal:"1,default,GetEvaluatorRequest"`
+type EvaluatorServiceUpdateEvaluatorDraftArgs struct {
+	Request *UpdateEvaluatorDraftRequest `thrift:"request,1" frugal:"1,default,UpdateEvaluatorDraftRequest"`
 }
 
-func NewEvaluatorServiceGetEvaluatorArgs() *EvaluatorServiceGetEvaluatorArgs {
-	return &EvaluatorServiceGetEvaluatorArgs{}
+func NewEvaluatorServiceUpdateEvaluatorDraftArgs() *EvaluatorServiceUpdateEvaluatorDraftArgs {
+	return &EvaluatorServiceUpdateEvaluatorDraftArgs{}
 }
 
-func (p *EvaluatorServiceGetEvaluatorArgs) InitDefault() {
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) InitDefault() {
 }
 
-var EvaluatorServiceGetEvaluatorArgs_Request_DEFAULT *GetEvaluatorRequest
+var EvaluatorServiceUpdateEvaluatorDraftArgs_Request_DEFAULT *UpdateEvaluatorDraftRequest
 
-func (p *EvaluatorServiceGetEvaluatorArgs) GetRequest() (v *GetEvaluatorRequest) {
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) GetRequest() (v *UpdateEvaluatorDraftRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return EvaluatorServiceGetEvaluatorArgs_Request_DEFAULT
+		return EvaluatorServiceUpdateEvaluatorDraftArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *EvaluatorServiceGetEvaluatorArgs) SetRequest(val *GetEvaluatorRequest) {
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) SetRequest(val *UpdateEvaluatorDraftRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_EvaluatorServiceGetEvaluatorArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceUpdateEvaluatorDraftArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *EvaluatorServiceGetEvaluatorArgs) IsSetRequest() bool {
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -28250,7 +31602,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorDraftArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -28260,8 +31612,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetEvaluatorRequest()
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateEvaluatorDraftRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -28269,9 +31621,9 @@ func (p *EvaluatorServiceGetEvaluatorArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvaluator_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateEvaluatorDraft_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -28297,7 +31649,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -28314,15 +31666,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorArgs) String() string {
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceGetEvaluatorArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceUpdateEvaluatorDraftArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceGetEvaluatorArgs) DeepEqual(ano *EvaluatorServiceGetEvaluatorArgs) bool {
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) DeepEqual(ano *EvaluatorServiceUpdateEvaluatorDraftArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -28334,7 +31686,7 @@ func (p *EvaluatorServiceGetEvaluatorArgs) DeepEqual(ano *EvaluatorServiceGetEva
 	return true
 }
 
-func (p *EvaluatorServiceGetEvaluatorArgs) Field1DeepEqual(src *GetEvaluatorRequest) bool {
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) Field1DeepEqual(src *UpdateEvaluatorDraftRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -28342,41 +31694,41 @@ func (p *EvaluatorServiceGetEvaluatorArgs) Field1DeepEqual(src *GetEvaluatorRequ
 	return true
 }
 
-type EvaluatorServiceGetEvaluatorResult struct {
-	Success *GetEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvaluatorResponse"`
+type EvaluatorServiceUpdateEvaluatorDraftResult struct {
+	Success *UpdateEvaluatorDraftResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateEvaluatorDraftResponse"`
 }
 
-func NewEvaluatorServiceGetEvaluatorResult() *EvaluatorServiceGetEvaluatorResult {
-	return &EvaluatorServiceGetEvaluatorResult{}
+func NewEvaluatorServiceUpdateEvaluatorDraftResult() *EvaluatorServiceUpdateEvaluatorDraftResult {
+	return &EvaluatorServiceUpdateEvaluatorDraftResult{}
 }
 
-func (p *EvaluatorServiceGetEvaluatorResult) InitDefault() {
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) InitDefault() {
 }
 
-var EvaluatorServiceGetEvaluatorResult_Success_DEFAULT *GetEvaluatorResponse
+var EvaluatorServiceUpdateEvaluatorDraftResult_Success_DEFAULT *UpdateEvaluatorDraftResponse
 
-func (p *EvaluatorServiceGetEvaluatorResult) GetSuccess() (v *GetEvaluatorResponse) {
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) GetSuccess() (v *UpdateEvaluatorDraftResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceGetEvaluatorResult_Success_DEFAULT
+		return EvaluatorServiceUpdateEvaluatorDraftResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceGetEvaluatorResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetEvaluatorResponse)
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateEvaluatorDraftResponse)
 }
 
-var fieldIDToName_EvaluatorServiceGetEvaluatorResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceUpdateEvaluatorDraftResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceGetEvaluatorResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -28421,7 +31773,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorDraftResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -28431,8 +31783,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetEvaluatorResponse()
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateEvaluatorDraftResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -28440,9 +31792,9 @@ func (p *EvaluatorServiceGetEvaluatorResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvaluator_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateEvaluatorDraft_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -28468,7 +31820,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -28487,15 +31839,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorResult) String() string {
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceGetEvaluatorResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceUpdateEvaluatorDraftResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceGetEvaluatorResult) DeepEqual(ano *EvaluatorServiceGetEvaluatorResult) bool {
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) DeepEqual(ano *EvaluatorServiceUpdateEvaluatorDraftResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -28507,7 +31859,7 @@ func (p *EvaluatorServiceGetEvaluatorResult) DeepEqual(ano *EvaluatorServiceGetE
 	return true
 }
 
-func (p *EvaluatorServiceGetEvaluatorResult) Field0DeepEqual(src *GetEvaluatorResponse) bool {
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) Field0DeepEqual(src *UpdateEvaluatorDraftResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -28515,41 +31867,41 @@ func (p *EvaluatorServiceGetEvaluatorResult) Field0DeepEqual(src *GetEvaluatorRe
 	return true
 }
 
-type EvaluatorServiceCreateEvaluatorArgs struct {
-	Request *CreateEvaluatorRequest `thrift:"request,1" frugal:"1,default,CreateEvaluatorRequest"`
+type EvaluatorServiceDeleteEvaluatorArgs struct {
+	Request *DeleteEvaluatorRequest `thrift:"request,1" frugal:"1,default,DeleteEvaluatorRequest"`
 }
 
-func NewEvaluatorServiceCreateEvaluatorArgs() *EvaluatorServiceCreateEvaluatorArgs {
-	return &EvaluatorServiceCreateEvaluatorArgs{}
+func NewEvaluatorServiceDeleteEvaluatorArgs() *EvaluatorServiceDeleteEvaluatorArgs {
+	return &EvaluatorServiceDeleteEvaluatorArgs{}
 }
 
-func (p *EvaluatorServiceCreateEvaluatorArgs) InitDefault() {
+func (p *EvaluatorServiceDeleteEvaluatorArgs) InitDefault() {
 }
 
-var EvaluatorServiceCreateEvaluatorArgs_Request_DEFAULT *CreateEvaluatorRequest
+var EvaluatorServiceDeleteEvaluatorArgs_Request_DEFAULT *DeleteEvaluatorRequest
 
-func (p *EvaluatorServiceCreateEvaluatorArgs) GetRequest() (v *CreateEvaluatorRequest) {
+func (p *EvaluatorServiceDeleteEvaluatorArgs) GetRequest() (v *DeleteEvaluatorRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return EvaluatorServiceCreateEvaluatorArgs_Request_DEFAULT
+		return EvaluatorServiceDeleteEvaluatorArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *EvaluatorServiceCreateEvaluatorArgs) SetRequest(val *CreateEvaluatorRequest) {
+func (p *EvaluatorServiceDeleteEvaluatorArgs) SetRequest(val *DeleteEvaluatorRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_EvaluatorServiceCreateEvaluatorArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceDeleteEvaluatorArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *EvaluatorServiceCreateEvaluatorArgs) IsSetRequest() bool {
+func (p *EvaluatorServiceDeleteEvaluatorArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *EvaluatorServiceCreateEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceDeleteEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -28594,7 +31946,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceCreateEvaluatorArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceDeleteEvaluatorArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -28603,9 +31955,9 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-
-func (p *EvaluatorServiceCreateEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCreateEvaluatorRequest()
+
+func (p *EvaluatorServiceDeleteEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteEvaluatorRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -28613,9 +31965,9 @@ func (p *EvaluatorServiceCreateEvaluatorArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *EvaluatorServiceCreateEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceDeleteEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateEvaluator_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteEvaluator_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -28641,7 +31993,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceCreateEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceDeleteEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -28658,15 +32010,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceCreateEvaluatorArgs) String() string {
+func (p *EvaluatorServiceDeleteEvaluatorArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceCreateEvaluatorArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceDeleteEvaluatorArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceCreateEvaluatorArgs) DeepEqual(ano *EvaluatorServiceCreateEvaluatorArgs) bool {
+func (p *EvaluatorServiceDeleteEvaluatorArgs) DeepEqual(ano *EvaluatorServiceDeleteEvaluatorArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -28678,7 +32030,7 @@ func (p *EvaluatorServiceCreateEvaluatorArgs) DeepEqual(ano *EvaluatorServiceCre
 	return true
 }
 
-func (p *EvaluatorServiceCreateEvaluatorArgs) Field1DeepEqual(src *CreateEvaluatorRequest) bool {
+func (p *EvaluatorServiceDeleteEvaluatorArgs) Field1DeepEqual(src *DeleteEvaluatorRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -28686,41 +32038,41 @@ func (p *EvaluatorServiceCreateEvaluatorArgs) Field1DeepEqual(src *CreateEvaluat
 	return true
 }
 
-type EvaluatorServiceCreateEvaluatorResult struct {
-	Success *CreateEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,CreateEvaluatorResponse"`
+type EvaluatorServiceDeleteEvaluatorResult struct {
+	Success *DeleteEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteEvaluatorResponse"`
 }
 
-func NewEvaluatorServiceCreateEvaluatorResult() *EvaluatorServiceCreateEvaluatorResult {
-	return &EvaluatorServiceCreateEvaluatorResult{}
+func NewEvaluatorServiceDeleteEvaluatorResult() *EvaluatorServiceDeleteEvaluatorResult {
+	return &EvaluatorServiceDeleteEvaluatorResult{}
 }
 
-func (p *EvaluatorServiceCreateEvaluatorResult) InitDefault() {
+func (p *EvaluatorServiceDeleteEvaluatorResult) InitDefault() {
 }
 
-var EvaluatorServiceCreateEvaluatorResult_Success_DEFAULT *CreateEvaluatorResponse
+var EvaluatorServiceDeleteEvaluatorResult_Success_DEFAULT *DeleteEvaluatorResponse
 
-func (p *EvaluatorServiceCreateEvaluatorResult) GetSuccess() (v *CreateEvaluatorResponse) {
+func (p *EvaluatorServiceDeleteEvaluatorResult) GetSuccess() (v *DeleteEvaluatorResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceCreateEvaluatorResult_Success_DEFAULT
+		return EvaluatorServiceDeleteEvaluatorResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceCreateEvaluatorResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CreateEvaluatorResponse)
+func (p *EvaluatorServiceDeleteEvaluatorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteEvaluatorResponse)
 }
 
-var fieldIDToName_EvaluatorServiceCreateEvaluatorResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceDeleteEvaluatorResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceCreateEvaluatorResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceDeleteEvaluatorResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceCreateEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceDeleteEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -28765,7 +32117,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceCreateEvaluatorResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceDeleteEvaluatorResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -28775,8 +32127,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceCreateEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCreateEvaluatorResponse()
+func (p *EvaluatorServiceDeleteEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteEvaluatorResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -28784,9 +32136,9 @@ func (p *EvaluatorServiceCreateEvaluatorResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *EvaluatorServiceCreateEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceDeleteEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateEvaluator_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteEvaluator_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -28812,7 +32164,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceCreateEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceDeleteEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -28831,15 +32183,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceCreateEvaluatorResult) String() string {
+func (p *EvaluatorServiceDeleteEvaluatorResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceCreateEvaluatorResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceDeleteEvaluatorResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceCreateEvaluatorResult) DeepEqual(ano *EvaluatorServiceCreateEvaluatorResult) bool {
+func (p *EvaluatorServiceDeleteEvaluatorResult) DeepEqual(ano *EvaluatorServiceDeleteEvaluatorResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -28851,7 +32203,7 @@ func (p *EvaluatorServiceCreateEvaluatorResult) DeepEqual(ano *EvaluatorServiceC
 	return true
 }
 
-func (p *EvaluatorServiceCreateEvaluatorResult) Field0DeepEqual(src *CreateEvaluatorResponse) bool {
+func (p *EvaluatorServiceDeleteEvaluatorResult) Field0DeepEqual(src *DeleteEvaluatorResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -28859,41 +32211,41 @@ func (p *EvaluatorServiceCreateEvaluatorResult) Field0DeepEqual(src *CreateEvalu
 	return true
 }
 
-type EvaluatorServiceUpdateEvaluatorArgs struct {
-	Request *UpdateEvaluatorRequest `thrift:"request,1" frugal:"1,default,UpdateEvaluatorRequest"`
+type EvaluatorServiceCheckEvaluatorNameArgs struct {
+	Request *CheckEvaluatorNameRequest `thrift:"request,1" frugal:"1,default,CheckEvaluatorNameRequest"`
 }
 
-func NewEvaluatorServiceUpdateEvaluatorArgs() *EvaluatorServiceUpdateEvaluatorArgs {
-	return &EvaluatorServiceUpdateEvaluatorArgs{}
+func NewEvaluatorServiceCheckEvaluatorNameArgs() *EvaluatorServiceCheckEvaluatorNameArgs {
+	return &EvaluatorServiceCheckEvaluatorNameArgs{}
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) InitDefault() {
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) InitDefault() {
 }
 
-var EvaluatorServiceUpdateEvaluatorArgs_Request_DEFAULT *UpdateEvaluatorRequest
+var EvaluatorServiceCheckEvaluatorNameArgs_Request_DEFAULT *CheckEvaluatorNameRequest
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) GetRequest() (v *UpdateEvaluatorRequest) {
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) GetRequest() (v *CheckEvaluatorNameRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return EvaluatorServiceUpdateEvaluatorArgs_Request_DEFAULT
+		return EvaluatorServiceCheckEvaluatorNameArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *EvaluatorServiceUpdateEvaluatorArgs) SetRequest(val *UpdateEvaluatorRequest) {
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) SetRequest(val *CheckEvaluatorNameRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_EvaluatorServiceUpdateEvaluatorArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceCheckEvaluatorNameArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) IsSetRequest() bool {
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -28938,7 +32290,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceCheckEvaluatorNameArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -28948,8 +32300,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateEvaluatorRequest()
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCheckEvaluatorNameRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -28957,9 +32309,9 @@ func (p *EvaluatorServiceUpdateEvaluatorArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateEvaluator_args"); err != nil {
+	if err = oprot.WriteStructBegin("CheckEvaluatorName_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -28985,7 +32337,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -29002,15 +32354,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) String() string {
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceUpdateEvaluatorArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceCheckEvaluatorNameArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) DeepEqual(ano *EvaluatorServiceUpdateEvaluatorArgs) bool {
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) DeepEqual(ano *EvaluatorServiceCheckEvaluatorNameArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -29022,7 +32374,7 @@ func (p *EvaluatorServiceUpdateEvaluatorArgs) DeepEqual(ano *EvaluatorServiceUpd
 	return true
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) Field1DeepEqual(src *UpdateEvaluatorRequest) bool {
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) Field1DeepEqual(src *CheckEvaluatorNameRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -29030,41 +32382,41 @@ func (p *EvaluatorServiceUpdateEvaluatorArgs) Field1DeepEqual(src *UpdateEvaluat
 	return true
 }
 
-type EvaluatorServiceUpdateEvaluatorResult struct {
-	Success *UpdateEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateEvaluatorResponse"`
+type EvaluatorServiceCheckEvaluatorNameResult struct {
+	Success *CheckEvaluatorNameResponse `thrift:"success,0,optional" frugal:"0,optional,CheckEvaluatorNameResponse"`
 }
 
-func NewEvaluatorServiceUpdateEvaluatorResult() *EvaluatorServiceUpdateEvaluatorResult {
-	return &EvaluatorServiceUpdateEvaluatorResult{}
+func NewEvaluatorServiceCheckEvaluatorNameResult() *EvaluatorServiceCheckEvaluatorNameResult {
+	return &EvaluatorServiceCheckEvaluatorNameResult{}
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) InitDefault() {
+func (p *EvaluatorServiceCheckEvaluatorNameResult) InitDefault() {
 }
 
-var EvaluatorServiceUpdateEvaluatorResult_Success_DEFAULT *UpdateEvaluatorResponse
+var EvaluatorServiceCheckEvaluatorNameResult_Success_DEFAULT *CheckEvaluatorNameResponse
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) GetSuccess() (v *UpdateEvaluatorResponse) {
+func (p *EvaluatorServiceCheckEvaluatorNameResult) GetSuccess() (v *CheckEvaluatorNameResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceUpdateEvaluatorResult_Success_DEFAULT
+		return EvaluatorServiceCheckEvaluatorNameResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceUpdateEvaluatorResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UpdateEvaluatorResponse)
+func (p *EvaluatorServiceCheckEvaluatorNameResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CheckEvaluatorNameResponse)
 }
 
-var fieldIDToName_EvaluatorServiceUpdateEvaluatorResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceCheckEvaluatorNameResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceCheckEvaluatorNameResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceCheckEvaluatorNameResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -29109,7 +32461,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceCheckEvaluatorNameResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -29119,8 +32471,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateEvaluatorResponse()
+func (p *EvaluatorServiceCheckEvaluatorNameResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCheckEvaluatorNameResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -29128,9 +32480,9 @@ func (p *EvaluatorServiceUpdateEvaluatorResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceCheckEvaluatorNameResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateEvaluator_result"); err != nil {
+	if err = oprot.WriteStructBegin("CheckEvaluatorName_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -29156,7 +32508,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceCheckEvaluatorNameResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -29175,15 +32527,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) String() string {
+func (p *EvaluatorServiceCheckEvaluatorNameResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceUpdateEvaluatorResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceCheckEvaluatorNameResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) DeepEqual(ano *EvaluatorServiceUpdateEvaluatorResult) bool {
+func (p *EvaluatorServiceCheckEvaluatorNameResult) DeepEqual(ano *EvaluatorServiceCheckEvaluatorNameResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -29195,7 +32547,7 @@ func (p *EvaluatorServiceUpdateEvaluatorResult) DeepEqual(ano *EvaluatorServiceU
 	return true
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) Field0DeepEqual(src *UpdateEvaluatorResponse) bool {
+func (p *EvaluatorServiceCheckEvaluatorNameResult) Field0DeepEqual(src *CheckEvaluatorNameResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -29203,41 +32555,41 @@ func (p *EvaluatorServiceUpdateEvaluatorResult) Field0DeepEqual(src *UpdateEvalu
 	return true
 }
 
-type EvaluatorServiceUpdateEvaluatorDraftArgs struct {
-	Request *UpdateEvaluatorDraftRequest `thrift:"request,1" frugal:"1,default,UpdateEvaluatorDraftRequest"`
+type EvaluatorServiceListEvaluatorVersionsArgs struct {
+	Request *ListEvaluatorVersionsRequest `thrift:"request,1" frugal:"1,default,ListEvaluatorVersionsRequest"`
 }
 
-func NewEvaluatorServiceUpdateEvaluatorDraftArgs() *EvaluatorServiceUpdateEvaluatorDraftArgs {
-	return &EvaluatorServiceUpdateEvaluatorDraftArgs{}
+func NewEvaluatorServiceListEvaluatorVersionsArgs() *EvaluatorServiceListEvaluatorVersionsArgs {
+	return &EvaluatorServiceListEvaluatorVersionsArgs{}
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) InitDefault() {
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) InitDefault() {
 }
 
-var EvaluatorServiceUpdateEvaluatorDraftArgs_Request_DEFAULT *UpdateEvaluatorDraftRequest
+var EvaluatorServiceListEvaluatorVersionsArgs_Request_DEFAULT *ListEvaluatorVersionsRequest
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) GetRequest() (v *UpdateEvaluatorDraftRequest) {
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) GetRequest() (v *ListEvaluatorVersionsRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return EvaluatorServiceUpdateEvaluatorDraftArgs_Request_DEFAULT
+		return EvaluatorServiceListEvaluatorVersionsArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) SetRequest(val *UpdateEvaluatorDraftRequest) {
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) SetRequest(val *ListEvaluatorVersionsRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_EvaluatorServiceUpdateEvaluatorDraftArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceListEvaluatorVersionsArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) IsSetRequest() bool {
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -29282,7 +32634,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorDraftArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorVersionsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -29292,8 +32644,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateEvaluatorDraftRequest()
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListEvaluatorVersionsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -29301,9 +32653,9 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) ReadField1(iprot thrift.TProt
 	return nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateEvaluatorDraft_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListEvaluatorVersions_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -29329,7 +32681,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -29346,15 +32698,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) String() string {
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceUpdateEvaluatorDraftArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceListEvaluatorVersionsArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) DeepEqual(ano *EvaluatorServiceUpdateEvaluatorDraftArgs) bool {
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) DeepEqual(ano *EvaluatorServiceListEvaluatorVersionsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -29366,7 +32718,7 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) DeepEqual(ano *EvaluatorServi
 	return true
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) Field1DeepEqual(src *UpdateEvaluatorDraftRequest) bool {
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) Field1DeepEqual(src *ListEvaluatorVersionsRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -29374,41 +32726,41 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) Field1DeepEqual(src *UpdateEv
 	return true
 }
 
-type EvaluatorServiceUpdateEvaluatorDraftResult struct {
-	Success *UpdateEvaluatorDraftResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateEvaluatorDraftResponse"`
+type EvaluatorServiceListEvaluatorVersionsResult struct {
+	Success *ListEvaluatorVersionsResponse `thrift:"success,0,optional" frugal:"0,optional,ListEvaluatorVersionsResponse"`
 }
 
-func NewEvaluatorServiceUpdateEvaluatorDraftResult() *EvaluatorServiceUpdateEvaluatorDraftResult {
-	return &EvaluatorServiceUpdateEvaluatorDraftResult{}
+func NewEvaluatorServiceListEvaluatorVersionsResult() *EvaluatorServiceListEvaluatorVersionsResult {
+	return &EvaluatorServiceListEvaluatorVersionsResult{}
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) InitDefault() {
+func (p *EvaluatorServiceListEvaluatorVersionsResult) InitDefault() {
 }
 
-var EvaluatorServiceUpdateEvaluatorDraftResult_Success_DEFAULT *UpdateEvaluatorDraftResponse
+var EvaluatorServiceListEvaluatorVersionsResult_Success_DEFAULT *ListEvaluatorVersionsResponse
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) GetSuccess() (v *UpdateEvaluatorDraftResponse) {
+func (p *EvaluatorServiceListEvaluatorVersionsResult) GetSuccess() (v *ListEvaluatorVersionsResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceUpdateEvaluatorDraftResult_Success_DEFAULT
+		return EvaluatorServiceListEvaluatorVersionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UpdateEvaluatorDraftResponse)
+func (p *EvaluatorServiceListEvaluatorVersionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListEvaluatorVersionsResponse)
 }
 
-var fieldIDToName_EvaluatorServiceUpdateEvaluatorDraftResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceListEvaluatorVersionsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceListEvaluatorVersionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListEvaluatorVersionsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -29453,7 +32805,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorDraftResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorVersionsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -29463,8 +32815,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateEvaluatorDraftResponse()
+func (p *EvaluatorServiceListEvaluatorVersionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListEvaluatorVersionsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -29472,9 +32824,9 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftResult) ReadField0(iprot thrift.TPr
 	return nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListEvaluatorVersionsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateEvaluatorDraft_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListEvaluatorVersions_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -29500,7 +32852,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListEvaluatorVersionsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -29519,15 +32871,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) String() string {
+func (p *EvaluatorServiceListEvaluatorVersionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceUpdateEvaluatorDraftResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceListEvaluatorVersionsResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) DeepEqual(ano *EvaluatorServiceUpdateEvaluatorDraftResult) bool {
+func (p *EvaluatorServiceListEvaluatorVersionsResult) DeepEqual(ano *EvaluatorServiceListEvaluatorVersionsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -29539,7 +32891,7 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftResult) DeepEqual(ano *EvaluatorSer
 	return true
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) Field0DeepEqual(src *UpdateEvaluatorDraftResponse) bool {
+func (p *EvaluatorServiceListEvaluatorVersionsResult) Field0DeepEqual(src *ListEvaluatorVersionsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -29547,41 +32899,41 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftResult) Field0DeepEqual(src *Update
 	return true
 }
 
-type EvaluatorServiceDeleteEvaluatorArgs struct {
-	Request *DeleteEvaluatorRequest `thrift:"request,1" frugal:"1,default,DeleteEvaluatorRequest"`
+type EvaluatorServiceGetEvaluatorVersionArgs struct {
+	Request *GetEvaluatorVersionRequest `thrift:"request,1" frugal:"1,default,GetEvaluatorVersionRequest"`
 }
 
-func NewEvaluatorServiceDeleteEvaluatorArgs() *EvaluatorServiceDeleteEvaluatorArgs {
-	return &EvaluatorServiceDeleteEvaluatorArgs{}
+func NewEvaluatorServiceGetEvaluatorVersionArgs() *EvaluatorServiceGetEvaluatorVersionArgs {
+	return &EvaluatorServiceGetEvaluatorVersionArgs{}
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) InitDefault() {
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) InitDefault() {
 }
 
-var EvaluatorServiceDeleteEvaluatorArgs_Request_DEFAULT *DeleteEvaluatorRequest
+var EvaluatorServiceGetEvaluatorVersionArgs_Request_DEFAULT *GetEvaluatorVersionRequest
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) GetRequest() (v *DeleteEvaluatorRequest) {
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) GetRequest() (v *GetEvaluatorVersionRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return EvaluatorServiceDeleteEvaluatorArgs_Request_DEFAULT
+		return EvaluatorServiceGetEvaluatorVersionArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *EvaluatorServiceDeleteEvaluatorArgs) SetRequest(val *DeleteEvaluatorRequest) {
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) SetRequest(val *GetEvaluatorVersionRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_EvaluatorServiceDeleteEvaluatorArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceGetEvaluatorVersionArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) IsSetRequest() bool {
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -29626,7 +32978,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceDeleteEvaluatorArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorVersionArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -29636,8 +32988,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteEvaluatorRequest()
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetEvaluatorVersionRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -29645,9 +32997,9 @@ func (p *EvaluatorServiceDeleteEvaluatorArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteEvaluator_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvaluatorVersion_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -29673,7 +33025,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -29690,15 +33042,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) String() string {
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceDeleteEvaluatorArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceGetEvaluatorVersionArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) DeepEqual(ano *EvaluatorServiceDeleteEvaluatorArgs) bool {
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) DeepEqual(ano *EvaluatorServiceGetEvaluatorVersionArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -29710,7 +33062,7 @@ func (p *EvaluatorServiceDeleteEvaluatorArgs) DeepEqual(ano *EvaluatorServiceDel
 	return true
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) Field1DeepEqual(src *DeleteEvaluatorRequest) bool {
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) Field1DeepEqual(src *GetEvaluatorVersionRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -29718,41 +33070,41 @@ func (p *EvaluatorServiceDeleteEvaluatorArgs) Field1DeepEqual(src *DeleteEvaluat
 	return true
 }
 
-type EvaluatorServiceDeleteEvaluatorResult struct {
-	Success *DeleteEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteEvaluatorResponse"`
+type EvaluatorServiceGetEvaluatorVersionResult struct {
+	Success *GetEvaluatorVersionResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvaluatorVersionResponse"`
 }
 
-func NewEvaluatorServiceDeleteEvaluatorResult() *EvaluatorServiceDeleteEvaluatorResult {
-	return &EvaluatorServiceDeleteEvaluatorResult{}
+func NewEvaluatorServiceGetEvaluatorVersionResult() *EvaluatorServiceGetEvaluatorVersionResult {
+	return &EvaluatorServiceGetEvaluatorVersionResult{}
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) InitDefault() {
+func (p *EvaluatorServiceGetEvaluatorVersionResult) InitDefault() {
 }
 
-var EvaluatorServiceDeleteEvaluatorResult_Success_DEFAULT *DeleteEvaluatorResponse
+var EvaluatorServiceGetEvaluatorVersionResult_Success_DEFAULT *GetEvaluatorVersionResponse
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) GetSuccess() (v *DeleteEvaluatorResponse) {
+func (p *EvaluatorServiceGetEvaluatorVersionResult) GetSuccess() (v *GetEvaluatorVersionResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceDeleteEvaluatorResult_Success_DEFAULT
+		return EvaluatorServiceGetEvaluatorVersionResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceDeleteEvaluatorResult) SetSuccess(x interface{}) {
-	p.Success = x.(*DeleteEvaluatorResponse)
+func (p *EvaluatorServiceGetEvaluatorVersionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetEvaluatorVersionResponse)
 }
 
-var fieldIDToName_EvaluatorServiceDeleteEvaluatorResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceGetEvaluatorVersionResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceGetEvaluatorVersionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorVersionResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -29797,7 +33149,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceDeleteEvaluatorResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorVersionResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -29807,8 +33159,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteEvaluatorResponse()
+func (p *EvaluatorServiceGetEvaluatorVersionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetEvaluatorVersionResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -29816,9 +33168,9 @@ func (p *EvaluatorServiceDeleteEvaluatorResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorVersionResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteEvaluator_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvaluatorVersion_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -29844,7 +33196,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorVersionResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -29863,15 +33215,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) String() string {
+func (p *EvaluatorServiceGetEvaluatorVersionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceDeleteEvaluatorResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceGetEvaluatorVersionResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) DeepEqual(ano *EvaluatorServiceDeleteEvaluatorResult) bool {
+func (p *EvaluatorServiceGetEvaluatorVersionResult) DeepEqual(ano *EvaluatorServiceGetEvaluatorVersionResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -29883,7 +33235,7 @@ func (p *EvaluatorServiceDeleteEvaluatorResult) DeepEqual(ano *EvaluatorServiceD
 	return true
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) Field0DeepEqual(src *DeleteEvaluatorResponse) bool {
+func (p *EvaluatorServiceGetEvaluatorVersionResult) Field0DeepEqual(src *GetEvaluatorVersionResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -29891,41 +33243,41 @@ func (p *EvaluatorServiceDeleteEvaluatorResult) Field0DeepEqual(src *DeleteEvalu
 	return true
 }
 
-type EvaluatorServiceCheckEvaluatorNameArgs struct {
-	Request *CheckEvaluatorNameRequest `thrift:"request,1" frugal:"1,default,CheckEvaluatorNameRequest"`
+type EvaluatorServiceBatchGetEvaluatorVersionsArgs struct {
+	Request *BatchGetEvaluatorVersionsRequest `thrift:"request,1" frugal:"1,default,BatchGetEvaluatorVersionsRequest"`
 }
 
-func NewEvaluatorServiceCheckEvaluatorNameArgs() *EvaluatorServiceCheckEvaluatorNameArgs {
-	return &EvaluatorServiceCheckEvaluatorNameArgs{}
+func NewEvaluatorServiceBatchGetEvaluatorVersionsArgs() *EvaluatorServiceBatchGetEvaluatorVersionsArgs {
+	return &EvaluatorServiceBatchGetEvaluatorVersionsArgs{}
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) InitDefault() {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) InitDefault() {
 }
 
-var EvaluatorServiceCheckEvaluatorNameArgs_Request_DEFAULT *CheckEvaluatorNameRequest
+var EvaluatorServiceBatchGetEvaluatorVersionsArgs_Request_DEFAULT *BatchGetEvaluatorVersionsRequest
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) GetRequest() (v *CheckEvaluatorNameRequest) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) GetRequest() (v *BatchGetEvaluatorVersionsRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return EvaluatorServiceCheckEvaluatorNameArgs_Request_DEFAULT
+		return EvaluatorServiceBatchGetEvaluatorVersionsArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) SetRequest(val *CheckEvaluatorNameRequest) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) SetRequest(val *BatchGetEvaluatorVersionsRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_EvaluatorServiceCheckEvaluatorNameArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionsArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) IsSetRequest() bool {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -29970,7 +33322,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceCheckEvaluatorNameArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -29980,8 +33332,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCheckEvaluatorNameRequest()
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchGetEvaluatorVersionsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -29989,9 +33341,9 @@ func (p *EvaluatorServiceCheckEvaluatorNameArgs) ReadField1(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CheckEvaluatorName_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetEvaluatorVersions_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -30017,7 +33369,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -30034,15 +33386,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) String() string {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceCheckEvaluatorNameArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceBatchGetEvaluatorVersionsArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) DeepEqual(ano *EvaluatorServiceCheckEvaluatorNameArgs) bool {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) DeepEqual(ano *EvaluatorServiceBatchGetEvaluatorVersionsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -30054,7 +33406,7 @@ func (p *EvaluatorServiceCheckEvaluatorNameArgs) DeepEqual(ano *EvaluatorService
 	return true
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) Field1DeepEqual(src *CheckEvaluatorNameRequest) bool {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) Field1DeepEqual(src *BatchGetEvaluatorVersionsRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -30062,41 +33414,41 @@ func (p *EvaluatorServiceCheckEvaluatorNameArgs) Field1DeepEqual(src *CheckEvalu
 	return true
 }
 
-type EvaluatorServiceCheckEvaluatorNameResult struct {
-	Success *CheckEvaluatorNameResponse `thrift:"success,0,optional" frugal:"0,optional,CheckEvaluatorNameResponse"`
+type EvaluatorServiceBatchGetEvaluatorVersionsResult struct {
+	Success *BatchGetEvaluatorVersionsResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetEvaluatorVersionsResponse"`
 }
 
-func NewEvaluatorServiceCheckEvaluatorNameResult() *EvaluatorServiceCheckEvaluatorNameResult {
-	return &EvaluatorServiceCheckEvaluatorNameResult{}
+func NewEvaluatorServiceBatchGetEvaluatorVersionsResult() *EvaluatorServiceBatchGetEvaluatorVersionsResult {
+	return &EvaluatorServiceBatchGetEvaluatorVersionsResult{}
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) InitDefault() {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) InitDefault() {
 }
 
-var EvaluatorServiceCheckEvaluatorNameResult_Success_DEFAULT *CheckEvaluatorNameResponse
+var EvaluatorServiceBatchGetEvaluatorVersionsResult_Success_DEFAULT *BatchGetEvaluatorVersionsResponse
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) GetSuccess() (v *CheckEvaluatorNameResponse) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) GetSuccess() (v *BatchGetEvaluatorVersionsResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceCheckEvaluatorNameResult_Success_DEFAULT
+		return EvaluatorServiceBatchGetEvaluatorVersionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceCheckEvaluatorNameResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CheckEvaluatorNameResponse)
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchGetEvaluatorVersionsResponse)
 }
 
-var fieldIDToName_EvaluatorServiceCheckEvaluatorNameResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -30141,7 +33493,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceCheckEvaluatorNameResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -30151,8 +33503,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCheckEvaluatorNameResponse()
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchGetEvaluatorVersionsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -30160,9 +33512,9 @@ func (p *EvaluatorServiceCheckEvaluatorNameResult) ReadField0(iprot thrift.TProt
 	return nil
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CheckEvaluatorName_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetEvaluatorVersions_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -30188,7 +33540,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -30207,15 +33559,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) String() string {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceCheckEvaluatorNameResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceBatchGetEvaluatorVersionsResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) DeepEqual(ano *EvaluatorServiceCheckEvaluatorNameResult) bool {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) DeepEqual(ano *EvaluatorServiceBatchGetEvaluatorVersionsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -30227,7 +33579,7 @@ func (p *EvaluatorServiceCheckEvaluatorNameResult) DeepEqual(ano *EvaluatorServi
 	return true
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) Field0DeepEqual(src *CheckEvaluatorNameResponse) bool {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) Field0DeepEqual(src *BatchGetEvaluatorVersionsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -30235,41 +33587,41 @@ func (p *EvaluatorServiceCheckEvaluatorNameResult) Field0DeepEqual(src *CheckEva
 	return true
 }
 
-type EvaluatorServiceListEvaluatorVersionsArgs struct {
-	Request *ListEvaluatorVersionsRequest `thrift:"request,1" frugal:"1,default,ListEvaluatorVersionsRequest"`
+type EvaluatorServiceBatchGetEvaluatorVersionIDsArgs struct {
+	Request *BatchGetEvaluatorVersionIDsRequest `thrift:"request,1" frugal:"1,default,BatchGetEvaluatorVersionIDsRequest"`
 }
 
-func NewEvaluatorServiceListEvaluatorVersionsArgs() *EvaluatorServiceListEvaluatorVersionsArgs {
-	return &EvaluatorServiceListEvaluatorVersionsArgs{}
+func NewEvaluatorServiceBatchGetEvaluatorVersionIDsArgs() *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs {
+	return &EvaluatorServiceBatchGetEvaluatorVersionIDsArgs{}
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) InitDefault() {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) InitDefault() {
 }
 
-var EvaluatorServiceListEvaluatorVersionsArgs_Request_DEFAULT *ListEvaluatorVersionsRequest
+var EvaluatorServiceBatchGetEvaluatorVersionIDsArgs_Request_DEFAULT *BatchGetEvaluatorVersionIDsRequest
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) GetRequest() (v *ListEvaluatorVersionsRequest) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) GetRequest() (v *BatchGetEvaluatorVersionIDsRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return EvaluatorServiceListEvaluatorVersionsArgs_Request_DEFAULT
+		return EvaluatorServiceBatchGetEvaluatorVersionIDsArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) SetRequest(val *ListEvaluatorVersionsRequest) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) SetRequest(val *BatchGetEvaluatorVersionIDsRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_EvaluatorServiceListEvaluatorVersionsArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionIDsArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) IsSetRequest() bool {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -30314,7 +33666,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorVersionsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionIDsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -30324,8 +33676,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListEvaluatorVersionsRequest()
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchGetEvaluatorVersionIDsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -30333,9 +33685,9 @@ func (p *EvaluatorServiceListEvaluatorVersionsArgs) ReadField1(iprot thrift.TPro
 	return nil
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListEvaluatorVersions_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetEvaluatorVersionIDs_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -30361,7 +33713,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -30378,15 +33730,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) String() string {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceListEvaluatorVersionsArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceBatchGetEvaluatorVersionIDsArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) DeepEqual(ano *EvaluatorServiceListEvaluatorVersionsArgs) bool {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) DeepEqual(ano *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -30398,7 +33750,7 @@ func (p *EvaluatorServiceListEvaluatorVersionsArgs) DeepEqual(ano *EvaluatorServ
 	return true
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) Field1DeepEqual(src *ListEvaluatorVersionsRequest) bool {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) Field1DeepEqual(src *BatchGetEvaluatorVersionIDsRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -30406,41 +33758,41 @@ func (p *EvaluatorServiceListEvaluatorVersionsArgs) Field1DeepEqual(src *ListEva
 	return true
 }
 
-type EvaluatorServiceListEvaluatorVersionsResult struct {
-	Success *ListEvaluatorVersionsResponse `thrift:"success,0,optional" frugal:"0,optional,ListEvaluatorVersionsResponse"`
+type EvaluatorServiceBatchGetEvaluatorVersionIDsResult struct {
+	Success *BatchGetEvaluatorVersionIDsResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetEvaluatorVersionIDsResponse"`
 }
 
-func NewEvaluatorServiceListEvaluatorVersionsResult() *EvaluatorServiceListEvaluatorVersionsResult {
-	return &EvaluatorServiceListEvaluatorVersionsResult{}
+func NewEvaluatorServiceBatchGetEvaluatorVersionIDsResult() *EvaluatorServiceBatchGetEvaluatorVersionIDsResult {
+	return &EvaluatorServiceBatchGetEvaluatorVersionIDsResult{}
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) InitDefault() {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) InitDefault() {
 }
 
-var EvaluatorServiceListEvaluatorVersionsResult_Success_DEFAULT *ListEvaluatorVersionsResponse
+var EvaluatorServiceBatchGetEvaluatorVersionIDsResult_Success_DEFAULT *BatchGetEvaluatorVersionIDsResponse
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) GetSuccess() (v *ListEvaluatorVersionsResponse) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) GetSuccess() (v *BatchGetEvaluatorVersionIDsResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceListEvaluatorVersionsResult_Success_DEFAULT
+		return EvaluatorServiceBatchGetEvaluatorVersionIDsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceListEvaluatorVersionsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListEvaluatorVersionsResponse)
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchGetEvaluatorVersionIDsResponse)
 }
 
-var fieldIDToName_EvaluatorServiceListEvaluatorVersionsResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionIDsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -30485,7 +33837,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorVersionsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionIDsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -30495,8 +33847,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListEvaluatorVersionsResponse()
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchGetEvaluatorVersionIDsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -30504,9 +33856,9 @@ func (p *EvaluatorServiceListEvaluatorVersionsResult) ReadField0(iprot thrift.TP
 	return nil
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListEvaluatorVersions_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetEvaluatorVersionIDs_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -30532,7 +33884,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -30551,15 +33903,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) String() string {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceListEvaluatorVersionsResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceBatchGetEvaluatorVersionIDsResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) DeepEqual(ano *EvaluatorServiceListEvaluatorVersionsResult) bool {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) DeepEqual(ano *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -30571,7 +33923,7 @@ func (p *EvaluatorServiceListEvaluatorVersionsResult) DeepEqual(ano *EvaluatorSe
 	return true
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) Field0DeepEqual(src *ListEvaluatorVersionsResponse) bool {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) Field0DeepEqual(src *BatchGetEvaluatorVersionIDsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -30579,41 +33931,41 @@ func (p *EvaluatorServiceListEvaluatorVersionsResult) Field0DeepEqual(src *ListE
 	return true
 }
 
-type EvaluatorServiceGetEvaluatorVersionArgs struct {
-	Request *GetEvaluatorVersionRequest `thrift:"request,1" frugal:"1,default,GetEvaluatorVersionRequest"`
+type EvaluatorServiceSubmitEvaluatorVersionArgs struct {
+	Request *SubmitEvaluatorVersionRequest `thrift:"request,1" frugal:"1,default,SubmitEvaluatorVersionRequest"`
 }
 
-func NewEvaluatorServiceGetEvaluatorVersionArgs() *EvaluatorServiceGetEvaluatorVersionArgs {
-	return &EvaluatorServiceGetEvaluatorVersionArgs{}
+func NewEvaluatorServiceSubmitEvaluatorVersionArgs() *EvaluatorServiceSubmitEvaluatorVersionArgs {
+	return &EvaluatorServiceSubmitEvaluatorVersionArgs{}
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) InitDefault() {
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) InitDefault() {
 }
 
-var EvaluatorServiceGetEvaluatorVersionArgs_Request_DEFAULT *GetEvaluatorVersionRequest
+var EvaluatorServiceSubmitEvaluatorVersionArgs_Request_DEFAULT *SubmitEvaluatorVersionRequest
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) GetRequest() (v *GetEvaluatorVersionRequest) {
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) GetRequest() (v *SubmitEvaluatorVersionRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return EvaluatorServiceGetEvaluatorVersionArgs_Request_DEFAULT
+		return EvaluatorServiceSubmitEvaluatorVersionArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) SetRequest(val *GetEvaluatorVersionRequest) {
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) SetRequest(val *SubmitEvaluatorVersionRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_EvaluatorServiceGetEvaluatorVersionArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceSubmitEvaluatorVersionArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) IsSetRequest() bool {
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -30658,7 +34010,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorVersionArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceSubmitEvaluatorVersionArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -30668,8 +34020,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetEvaluatorVersionRequest()
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSubmitEvaluatorVersionRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -30677,9 +34029,9 @@ func (p *EvaluatorServiceGetEvaluatorVersionArgs) ReadField1(iprot thrift.TProto
 	return nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvaluatorVersion_args"); err != nil {
+	if err = oprot.WriteStructBegin("SubmitEvaluatorVersion_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -30705,7 +34057,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -30722,15 +34074,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) String() string {
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceGetEvaluatorVersionArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceSubmitEvaluatorVersionArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) DeepEqual(ano *EvaluatorServiceGetEvaluatorVersionArgs) bool {
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) DeepEqual(ano *EvaluatorServiceSubmitEvaluatorVersionArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -30742,7 +34094,7 @@ func (p *EvaluatorServiceGetEvaluatorVersionArgs) DeepEqual(ano *EvaluatorServic
 	return true
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) Field1DeepEqual(src *GetEvaluatorVersionRequest) bool {
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) Field1DeepEqual(src *SubmitEvaluatorVersionRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -30750,41 +34102,41 @@ func (p *EvaluatorServiceGetEvaluatorVersionArgs) Field1DeepEqual(src *GetEvalua
 	return true
 }
 
-type EvaluatorServiceGetEvaluatorVersionResult struct {
-	Success *GetEvaluatorVersionResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvaluatorVersionResponse"`
+type EvaluatorServiceSubmitEvaluatorVersionResult struct {
+	Success *SubmitEvaluatorVersionResponse `thrift:"success,0,optional" frugal:"0,optional,SubmitEvaluatorVersionResponse"`
 }
 
-func NewEvaluatorServiceGetEvaluatorVersionResult() *EvaluatorServiceGetEvaluatorVersionResult {
-	return &EvaluatorServiceGetEvaluatorVersionResult{}
+func NewEvaluatorServiceSubmitEvaluatorVersionResult() *EvaluatorServiceSubmitEvaluatorVersionResult {
+	return &EvaluatorServiceSubmitEvaluatorVersionResult{}
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) InitDefault() {
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) InitDefault() {
 }
 
-var EvaluatorServiceGetEvaluatorVersionResult_Success_DEFAULT *GetEvaluatorVersionResponse
+var EvaluatorServiceSubmitEvaluatorVersionResult_Success_DEFAULT *SubmitEvaluatorVersionResponse
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) GetSuccess() (v *GetEvaluatorVersionResponse) {
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) GetSuccess() (v *SubmitEvaluatorVersionResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceGetEvaluatorVersionResult_Success_DEFAULT
+		return EvaluatorServiceSubmitEvaluatorVersionResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceGetEvaluatorVersionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetEvaluatorVersionResponse)
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SubmitEvaluatorVersionResponse)
 }
 
-var fieldIDToName_EvaluatorServiceGetEvaluatorVersionResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceSubmitEvaluatorVersionResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -30829,7 +34181,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorVersionResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceSubmitEvaluatorVersionResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -30839,8 +34191,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetEvaluatorVersionResponse()
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSubmitEvaluatorVersionResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -30848,9 +34200,9 @@ func (p *EvaluatorServiceGetEvaluatorVersionResult) ReadField0(iprot thrift.TPro
 	return nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvaluatorVersion_result"); err != nil {
+	if err = oprot.WriteStructBegin("SubmitEvaluatorVersion_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -30876,7 +34228,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -30895,15 +34247,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) String() string {
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceGetEvaluatorVersionResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceSubmitEvaluatorVersionResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) DeepEqual(ano *EvaluatorServiceGetEvaluatorVersionResult) bool {
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) DeepEqual(ano *EvaluatorServiceSubmitEvaluatorVersionResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -30915,7 +34267,7 @@ func (p *EvaluatorServiceGetEvaluatorVersionResult) DeepEqual(ano *EvaluatorServ
 	return true
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) Field0DeepEqual(src *GetEvaluatorVersionResponse) bool {
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) Field0DeepEqual(src *SubmitEvaluatorVersionResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -30923,41 +34275,41 @@ func (p *EvaluatorServiceGetEvaluatorVersionResult) Field0DeepEqual(src *GetEval
 	return true
 }
 
-type EvaluatorServiceBatchGetEvaluatorVersionsArgs struct {
-	Request *BatchGetEvaluatorVersionsRequest `thrift:"request,1" frugal:"1,default,BatchGetEvaluatorVersionsRequest"`
+type EvaluatorServiceListTemplatesArgs struct {
+	Request *ListTemplatesRequest `thrift:"request,1" frugal:"1,default,ListTemplatesRequest"`
 }
 
-func NewEvaluatorServiceBatchGetEvaluatorVersionsArgs() *EvaluatorServiceBatchGetEvaluatorVersionsArgs {
-	return &EvaluatorServiceBatchGetEvaluatorVersionsArgs{}
+func NewEvaluatorServiceListTemplatesArgs() *EvaluatorServiceListTemplatesArgs {
+	return &EvaluatorServiceListTemplatesArgs{}
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) InitDefault() {
+func (p *EvaluatorServiceListTemplatesArgs) InitDefault() {
 }
 
-var EvaluatorServiceBatchGetEvaluatorVersionsArgs_Request_DEFAULT *BatchGetEvaluatorVersionsRequest
+var EvaluatorServiceListTemplatesArgs_Request_DEFAULT *ListTemplatesRequest
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) GetRequest() (v *BatchGetEvaluatorVersionsRequest) {
+func (p *EvaluatorServiceListTemplatesArgs) GetRequest() (v *ListTemplatesRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return EvaluatorServiceBatchGetEvaluatorVersionsArgs_Request_DEFAULT
+		return EvaluatorServiceListTemplatesArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) SetRequest(val *BatchGetEvaluatorVersionsRequest) {
+func (p *EvaluatorServiceListTemplatesArgs) SetRequest(val *ListTemplatesRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionsArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceListTemplatesArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) IsSetRequest() bool {
+func (p *EvaluatorServiceListTemplatesArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListTemplatesArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -31002,7 +34354,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListTemplatesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -31012,8 +34364,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewBatchGetEvaluatorVersionsRequest()
+func (p *EvaluatorServiceListTemplatesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListTemplatesRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -31021,9 +34373,9 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) ReadField1(iprot thrift.
 	return nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListTemplatesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetEvaluatorVersions_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListTemplates_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -31049,7 +34401,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListTemplatesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -31066,15 +34418,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) String() string {
+func (p *EvaluatorServiceListTemplatesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceBatchGetEvaluatorVersionsArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceListTemplatesArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) DeepEqual(ano *EvaluatorServiceBatchGetEvaluatorVersionsArgs) bool {
+func (p *EvaluatorServiceListTemplatesArgs) DeepEqual(ano *EvaluatorServiceListTemplatesArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -31086,7 +34438,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) DeepEqual(ano *Evaluator
 	return true
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) Field1DeepEqual(src *BatchGetEvaluatorVersionsRequest) bool {
+func (p *EvaluatorServiceListTemplatesArgs) Field1DeepEqual(src *ListTemplatesRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -31094,41 +34446,41 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) Field1DeepEqual(src *Bat
 	return true
 }
 
-type EvaluatorServiceBatchGetEvaluatorVersionsResult struct {
-	Success *BatchGetEvaluatorVersionsResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetEvaluatorVersionsResponse"`
+type EvaluatorServiceListTemplatesResult struct {
+	Success *ListTemplatesResponse `thrift:"success,0,optional" frugal:"0,optional,ListTemplatesResponse"`
 }
 
-func NewEvaluatorServiceBatchGetEvaluatorVersionsResult() *EvaluatorServiceBatchGetEvaluatorVersionsResult {
-	return &EvaluatorServiceBatchGetEvaluatorVersionsResult{}
+func NewEvaluatorServiceListTemplatesResult() *EvaluatorServiceListTemplatesResult {
+	return &EvaluatorServiceListTemplatesResult{}
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) InitDefault() {
+func (p *EvaluatorServiceListTemplatesResult) InitDefault() {
 }
 
-var EvaluatorServiceBatchGetEvaluatorVersionsResult_Success_DEFAULT *BatchGetEvaluatorVersionsResponse
+var EvaluatorServiceListTemplatesResult_Success_DEFAULT *ListTemplatesResponse
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) GetSuccess() (v *BatchGetEvaluatorVersionsResponse) {
+func (p *EvaluatorServiceListTemplatesResult) GetSuccess() (v *ListTemplatesResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceBatchGetEvaluatorVersionsResult_Success_DEFAULT
+		return EvaluatorServiceListTemplatesResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchGetEvaluatorVersionsResponse)
+func (p *EvaluatorServiceListTemplatesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListTemplatesResponse)
 }
 
-var fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionsResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceListTemplatesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceListTemplatesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListTemplatesResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -31173,7 +34525,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListTemplatesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -31183,8 +34535,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewBatchGetEvaluatorVersionsResponse()
+func (p *EvaluatorServiceListTemplatesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListTemplatesResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -31192,9 +34544,9 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) ReadField0(iprot thrif
 	return nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListTemplatesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetEvaluatorVersions_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListTemplates_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -31220,7 +34572,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListTemplatesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -31239,15 +34591,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) String() string {
+func (p *EvaluatorServiceListTemplatesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceBatchGetEvaluatorVersionsResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceListTemplatesResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) DeepEqual(ano *EvaluatorServiceBatchGetEvaluatorVersionsResult) bool {
+func (p *EvaluatorServiceListTemplatesResult) DeepEqual(ano *EvaluatorServiceListTemplatesResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -31259,7 +34611,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) DeepEqual(ano *Evaluat
 	return true
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) Field0DeepEqual(src *BatchGetEvaluatorVersionsResponse) bool {
+func (p *EvaluatorServiceListTemplatesResult) Field0DeepEqual(src *ListTemplatesResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -31267,41 +34619,41 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) Field0DeepEqual(src *B
 	return true
 }
 
-type EvaluatorServiceBatchGetEvaluatorVersionIDsArgs struct {
-	Request *BatchGetEvaluatorVersionIDsRequest `thrift:"request,1" frugal:"1,default,BatchGetEvaluatorVersionIDsRequest"`
+type EvaluatorServiceGetTemplateInfoArgs struct {
+	Request *GetTemplateInfoRequest `thrift:"request,1" frugal:"1,default,GetTemplateInfoRequest"`
 }
 
-func NewEvaluatorServiceBatchGetEvaluatorVersionIDsArgs() *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs {
-	return &EvaluatorServiceBatchGetEvaluatorVersionIDsArgs{}
+func NewEvaluatorServiceGetTemplateInfoArgs() *EvaluatorServiceGetTemplateInfoArgs {
+	return &EvaluatorServiceGetTemplateInfoArgs{}
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) InitDefault() {
+func (p *EvaluatorServiceGetTemplateInfoArgs) InitDefault() {
 }
 
-var EvaluatorServiceBatchGetEvaluatorVersionIDsArgs_Request_DEFAULT *BatchGetEvaluatorVersionIDsRequest
+var EvaluatorServiceGetTemplateInfoArgs_Request_DEFAULT *GetTemplateInfoRequest
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) GetRequest() (v *BatchGetEvaluatorVersionIDsRequest) {
+func (p *EvaluatorServiceGetTemplateInfoArgs) GetRequest() (v *GetTemplateInfoRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return EvaluatorServiceBatchGetEvaluatorVersionIDsArgs_Request_DEFAULT
+		return EvaluatorServiceGetTemplateInfoArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) SetRequest(val *BatchGetEvaluatorVersionIDsRequest) {
+func (p *EvaluatorServiceGetTemplateInfoArgs) SetRequest(val *GetTemplateInfoRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionIDsArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceGetTemplateInfoArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) IsSetRequest() bool {
+func (p *EvaluatorServiceGetTemplateInfoArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetTemplateInfoArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -31346,7 +34698,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionIDsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetTemplateInfoArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -31356,8 +34708,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewBatchGetEvaluatorVersionIDsRequest()
+func (p *EvaluatorServiceGetTemplateInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetTemplateInfoRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -31365,9 +34717,9 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) ReadField1(iprot thrif
 	return nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetTemplateInfoArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetEvaluatorVersionIDs_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetTemplateInfo_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -31393,7 +34745,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetTemplateInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -31410,15 +34762,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) String() string {
+func (p *EvaluatorServiceGetTemplateInfoArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceBatchGetEvaluatorVersionIDsArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceGetTemplateInfoArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) DeepEqual(ano *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) bool {
+func (p *EvaluatorServiceGetTemplateInfoArgs) DeepEqual(ano *EvaluatorServiceGetTemplateInfoArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -31430,7 +34782,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) DeepEqual(ano *Evaluat
 	return true
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) Field1DeepEqual(src *BatchGetEvaluatorVersionIDsRequest) bool {
+func (p *EvaluatorServiceGetTemplateInfoArgs) Field1DeepEqual(src *GetTemplateInfoRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -31438,41 +34790,41 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) Field1DeepEqual(src *B
 	return true
 }
 
-type EvaluatorServiceBatchGetEvaluatorVersionIDsResult struct {
-	Success *BatchGetEvaluatorVersionIDsResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetEvaluatorVersionIDsResponse"`
+type EvaluatorServiceGetTemplateInfoResult struct {
+	Success *GetTemplateInfoResponse `thrift:"success,0,optional" frugal:"0,optional,GetTemplateInfoResponse"`
 }
 
-func NewEvaluatorServiceBatchGetEvaluatorVersionIDsResult() *EvaluatorServiceBatchGetEvaluatorVersionIDsResult {
-	return &EvaluatorServiceBatchGetEvaluatorVersionIDsResult{}
+func NewEvaluatorServiceGetTemplateInfoResult() *EvaluatorServiceGetTemplateInfoResult {
+	return &EvaluatorServiceGetTemplateInfoResult{}
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) InitDefault() {
+func (p *EvaluatorServiceGetTemplateInfoResult) InitDefault() {
 }
 
-var EvaluatorServiceBatchGetEvaluatorVersionIDsResult_Success_DEFAULT *BatchGetEvaluatorVersionIDsResponse
+var EvaluatorServiceGetTemplateInfoResult_Success_DEFAULT *GetTemplateInfoResponse
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) GetSuccess() (v *BatchGetEvaluatorVersionIDsResponse) {
+func (p *EvaluatorServiceGetTemplateInfoResult) GetSuccess() (v *GetTemplateInfoResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceBatchGetEvaluatorVersionIDsResult_Success_DEFAULT
+		return EvaluatorServiceGetTemplateInfoResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchGetEvaluatorVersionIDsResponse)
+func (p *EvaluatorServiceGetTemplateInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetTemplateInfoResponse)
 }
 
-var fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionIDsResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceGetTemplateInfoResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceGetTemplateInfoResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetTemplateInfoResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -31517,7 +34869,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionIDsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetTemplateInfoResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -31526,9 +34878,9 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewBatchGetEvaluatorVersionIDsResponse()
+
+func (p *EvaluatorServiceGetTemplateInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetTemplateInfoResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -31536,9 +34888,9 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) ReadField0(iprot thr
 	return nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetTemplateInfoResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetEvaluatorVersionIDs_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetTemplateInfo_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -31564,7 +34916,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetTemplateInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -31583,15 +34935,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) String() string {
+func (p *EvaluatorServiceGetTemplateInfoResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceBatchGetEvaluatorVersionIDsResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceGetTemplateInfoResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) DeepEqual(ano *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) bool {
+func (p *EvaluatorServiceGetTemplateInfoResult) DeepEqual(ano *EvaluatorServiceGetTemplateInfoResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -31603,7 +34955,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) DeepEqual(ano *Evalu
 	return true
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) Field0DeepEqual(src *BatchGetEvaluatorVersionIDsResponse) bool {
+func (p *EvaluatorServiceGetTemplateInfoResult) Field0DeepEqual(src *GetTemplateInfoResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -31611,41 +34963,41 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) Field0DeepEqual(src
 	return true
 }
 
-type EvaluatorServiceSubmitEvaluatorVersionArgs struct {
-	Request *SubmitEvaluatorVersionRequest `thrift:"request,1" frugal:"1,default,SubmitEvaluatorVersionRequest"`
+type EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs struct {
+	Req *GetDefaultPromptEvaluatorToolsRequest `thrift:"req,1" frugal:"1,default,GetDefaultPromptEvaluatorToolsRequest"`
 }
 
-func NewEvaluatorServiceSubmitEvaluatorVersionArgs() *EvaluatorServiceSubmitEvaluatorVersionArgs {
-	return &EvaluatorServiceSubmitEvaluatorVersionArgs{}
+func NewEvaluatorServiceGetDefaultPromptEvaluatorToolsArgs() *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs {
+	return &EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs{}
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) InitDefault() {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) InitDefault() {
 }
 
-var EvaluatorServiceSubmitEvaluatorVersionArgs_Request_DEFAULT *SubmitEvaluatorVersionRequest
+var EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs_Req_DEFAULT *GetDefaultPromptEvaluatorToolsRequest
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) GetRequest() (v *SubmitEvaluatorVersionRequest) {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) GetReq() (v *GetDefaultPromptEvaluatorToolsRequest) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetRequest() {
-		return EvaluatorServiceSubmitEvaluatorVersionArgs_Request_DEFAULT
+	if !p.IsSetReq() {
+		return EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs_Req_DEFAULT
 	}
-	return p.Request
+	return p.Req
 }
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) SetRequest(val *SubmitEvaluatorVersionRequest) {
-	p.Request = val
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) SetReq(val *GetDefaultPromptEvaluatorToolsRequest) {
+	p.Req = val
 }
 
-var fieldIDToName_EvaluatorServiceSubmitEvaluatorVersionArgs = map[int16]string{
-	1: "request",
+var fieldIDToName_EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) IsSetRequest() bool {
-	return p.Request != nil
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -31690,7 +35042,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceSubmitEvaluatorVersionArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -31700,18 +35052,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSubmitEvaluatorVersionRequest()
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetDefaultPromptEvaluatorToolsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Request = _field
+	p.Req = _field
 	return nil
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SubmitEvaluatorVersion_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetDefaultPromptEvaluatorTools_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -31737,11 +35089,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Request.Write(oprot); err != nil {
+	if err := p.Req.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -31754,69 +35106,69 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) String() string {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceSubmitEvaluatorVersionArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) DeepEqual(ano *EvaluatorServiceSubmitEvaluatorVersionArgs) bool {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) DeepEqual(ano *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Request) {
+	if !p.Field1DeepEqual(ano.Req) {
 		return false
 	}
 	return true
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) Field1DeepEqual(src *SubmitEvaluatorVersionRequest) bool {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) Field1DeepEqual(src *GetDefaultPromptEvaluatorToolsRequest) bool {
 
-	if !p.Request.DeepEqual(src) {
+	if !p.Req.DeepEqual(src) {
 		return false
 	}
 	return true
 }
 
-type EvaluatorServiceSubmitEvaluatorVersionResult struct {
-	Success *SubmitEvaluatorVersionResponse `thrift:"success,0,optional" frugal:"0,optional,SubmitEvaluatorVersionResponse"`
+type EvaluatorServiceGetDefaultPromptEvaluatorToolsResult struct {
+	Success *GetDefaultPromptEvaluatorToolsResponse `thrift:"success,0,optional" frugal:"0,optional,GetDefaultPromptEvaluatorToolsResponse"`
 }
 
-func NewEvaluatorServiceSubmitEvaluatorVersionResult() *EvaluatorServiceSubmitEvaluatorVersionResult {
-	return &EvaluatorServiceSubmitEvaluatorVersionResult{}
+func NewEvaluatorServiceGetDefaultPromptEvaluatorToolsResult() *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult {
+	return &EvaluatorServiceGetDefaultPromptEvaluatorToolsResult{}
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) InitDefault() {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) InitDefault() {
 }
 
-var EvaluatorServiceSubmitEvaluatorVersionResult_Success_DEFAULT *SubmitEvaluatorVersionResponse
+var EvaluatorServiceGetDefaultPromptEvaluatorToolsResult_Success_DEFAULT *GetDefaultPromptEvaluatorToolsResponse
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) GetSuccess() (v *SubmitEvaluatorVersionResponse) {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) GetSuccess() (v *GetDefaultPromptEvaluatorToolsResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceSubmitEvaluatorVersionResult_Success_DEFAULT
+		return EvaluatorServiceGetDefaultPromptEvaluatorToolsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*SubmitEvaluatorVersionResponse)
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetDefaultPromptEvaluatorToolsResponse)
 }
 
-var fieldIDToName_EvaluatorServiceSubmitEvaluatorVersionResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceGetDefaultPromptEvaluatorToolsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -31861,7 +35213,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceSubmitEvaluatorVersionResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetDefaultPromptEvaluatorToolsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -31871,8 +35223,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSubmitEvaluatorVersionResponse()
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetDefaultPromptEvaluatorToolsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -31880,9 +35232,9 @@ func (p *EvaluatorServiceSubmitEvaluatorVersionResult) ReadField0(iprot thrift.T
 	return nil
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SubmitEvaluatorVersion_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetDefaultPromptEvaluatorTools_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -31908,7 +35260,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -31927,15 +35279,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) String() string {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceSubmitEvaluatorVersionResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceGetDefaultPromptEvaluatorToolsResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) DeepEqual(ano *EvaluatorServiceSubmitEvaluatorVersionResult) bool {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) DeepEqual(ano *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -31947,7 +35299,7 @@ func (p *EvaluatorServiceSubmitEvaluatorVersionResult) DeepEqual(ano *EvaluatorS
 	return true
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) Field0DeepEqual(src *SubmitEvaluatorVersionResponse) bool {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) Field0DeepEqual(src *GetDefaultPromptEvaluatorToolsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -31955,41 +35307,41 @@ func (p *EvaluatorServiceSubmitEvaluatorVersionResult) Field0DeepEqual(src *Subm
 	return true
 }
 
-type EvaluatorServiceListTemplatesArgs struct {
-	Request *ListTemplatesRequest `thrift:"request,1" frugal:"1,default,ListTemplatesRequest"`
+type EvaluatorServiceRunEvaluatorArgs struct {
+	Req *RunEvaluatorRequest `thrift:"req,1" frugal:"1,default,RunEvaluatorRequest"`
 }
 
-func NewEvaluatorServiceListTemplatesArgs() *EvaluatorServiceListTemplatesArgs {
-	return &EvaluatorServiceListTemplatesArgs{}
+func NewEvaluatorServiceRunEvaluatorArgs() *EvaluatorServiceRunEvaluatorArgs {
+	return &EvaluatorServiceRunEvaluatorArgs{}
 }
 
-func (p *EvaluatorServiceListTemplatesArgs) InitDefault() {
+func (p *EvaluatorServiceRunEvaluatorArgs) InitDefault() {
 }
 
-var EvaluatorServiceListTemplatesArgs_Request_DEFAULT *ListTemplatesRequest
+var EvaluatorServiceRunEvaluatorArgs_Req_DEFAULT *RunEvaluatorRequest
 
-func (p *EvaluatorServiceListTemplatesArgs) GetRequest() (v *ListTemplatesRequest) {
+func (p *EvaluatorServiceRunEvaluatorArgs) GetReq() (v *RunEvaluatorRequest) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetRequest() {
-		return EvaluatorServiceListTemplatesArgs_Request_DEFAULT
+	if !p.IsSetReq() {
+		return EvaluatorServiceRunEvaluatorArgs_Req_DEFAULT
 	}
-	return p.Request
+	return p.Req
 }
-func (p *EvaluatorServiceListTemplatesArgs) SetRequest(val *ListTemplatesRequest) {
-	p.Request = val
+func (p *EvaluatorServiceRunEvaluatorArgs) SetReq(val *RunEvaluatorRequest) {
+	p.Req = val
 }
 
-var fieldIDToName_EvaluatorServiceListTemplatesArgs = map[int16]string{
-	1: "request",
+var fieldIDToName_EvaluatorServiceRunEvaluatorArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *EvaluatorServiceListTemplatesArgs) IsSetRequest() bool {
-	return p.Request != nil
+func (p *EvaluatorServiceRunEvaluatorArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *EvaluatorServiceListTemplatesArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceRunEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -32034,7 +35386,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListTemplatesArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceRunEvaluatorArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -32044,18 +35396,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListTemplatesArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListTemplatesRequest()
+func (p *EvaluatorServiceRunEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRunEvaluatorRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Request = _field
+	p.Req = _field
 	return nil
 }
 
-func (p *EvaluatorServiceListTemplatesArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceRunEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListTemplates_args"); err != nil {
+	if err = oprot.WriteStructBegin("RunEvaluator_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -32081,11 +35433,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListTemplatesArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+func (p *EvaluatorServiceRunEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Request.Write(oprot); err != nil {
+	if err := p.Req.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -32098,69 +35450,69 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListTemplatesArgs) String() string {
+func (p *EvaluatorServiceRunEvaluatorArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceListTemplatesArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceRunEvaluatorArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceListTemplatesArgs) DeepEqual(ano *EvaluatorServiceListTemplatesArgs) bool {
+func (p *EvaluatorServiceRunEvaluatorArgs) DeepEqual(ano *EvaluatorServiceRunEvaluatorArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Request) {
+	if !p.Field1DeepEqual(ano.Req) {
 		return false
 	}
 	return true
 }
 
-func (p *EvaluatorServiceListTemplatesArgs) Field1DeepEqual(src *ListTemplatesRequest) bool {
+func (p *EvaluatorServiceRunEvaluatorArgs) Field1DeepEqual(src *RunEvaluatorRequest) bool {
 
-	if !p.Request.DeepEqual(src) {
+	if !p.Req.DeepEqual(src) {
 		return false
 	}
 	return true
 }
 
-type EvaluatorServiceListTemplatesResult struct {
-	Success *ListTemplatesResponse `thrift:"success,0,optional" frugal:"0,optional,ListTemplatesResponse"`
+type EvaluatorServiceRunEvaluatorResult struct {
+	Success *RunEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,RunEvaluatorResponse"`
 }
 
-func NewEvaluatorServiceListTemplatesResult() *EvaluatorServiceListTemplatesResult {
-	return &EvaluatorServiceListTemplatesResult{}
+func NewEvaluatorServiceRunEvaluatorResult() *EvaluatorServiceRunEvaluatorResult {
+	return &EvaluatorServiceRunEvaluatorResult{}
 }
 
-func (p *EvaluatorServiceListTemplatesResult) InitDefault() {
+func (p *EvaluatorServiceRunEvaluatorResult) InitDefault() {
 }
 
-var EvaluatorServiceListTemplatesResult_Success_DEFAULT *ListTemplatesResponse
+var EvaluatorServiceRunEvaluatorResult_Success_DEFAULT *RunEvaluatorResponse
 
-func (p *EvaluatorServiceListTemplatesResult) GetSuccess() (v *ListTemplatesResponse) {
+func (p *EvaluatorServiceRunEvaluatorResult) GetSuccess() (v *RunEvaluatorResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceListTemplatesResult_Success_DEFAULT
+		return EvaluatorServiceRunEvaluatorResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceListTemplatesResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListTemplatesResponse)
+func (p *EvaluatorServiceRunEvaluatorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RunEvaluatorResponse)
 }
 
-var fieldIDToName_EvaluatorServiceListTemplatesResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceRunEvaluatorResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceListTemplatesResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceRunEvaluatorResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceListTemplatesResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceRunEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -32205,7 +35557,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListTemplatesResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceRunEvaluatorResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -32215,8 +35567,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListTemplatesResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListTemplatesResponse()
+func (p *EvaluatorServiceRunEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRunEvaluatorResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -32224,9 +35576,9 @@ func (p *EvaluatorServiceListTemplatesResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *EvaluatorServiceListTemplatesResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceRunEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListTemplates_result"); err != nil {
+	if err = oprot.WriteStructBegin("RunEvaluator_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -32252,7 +35604,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListTemplatesResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceRunEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -32271,15 +35623,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceListTemplatesResult) String() string {
+func (p *EvaluatorServiceRunEvaluatorResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceListTemplatesResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceRunEvaluatorResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceListTemplatesResult) DeepEqual(ano *EvaluatorServiceListTemplatesResult) bool {
+func (p *EvaluatorServiceRunEvaluatorResult) DeepEqual(ano *EvaluatorServiceRunEvaluatorResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -32291,7 +35643,7 @@ func (p *EvaluatorServiceListTemplatesResult) DeepEqual(ano *EvaluatorServiceLis
 	return true
 }
 
-func (p *EvaluatorServiceListTemplatesResult) Field0DeepEqual(src *ListTemplatesResponse) bool {
+func (p *EvaluatorServiceRunEvaluatorResult) Field0DeepEqual(src *RunEvaluatorResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -32299,41 +35651,41 @@ func (p *EvaluatorServiceListTemplatesResult) Field0DeepEqual(src *ListTemplates
 	return true
 }
 
-type EvaluatorServiceGetTemplateInfoArgs struct {
-	Request *GetTemplateInfoRequest `thrift:"request,1" frugal:"1,default,GetTemplateInfoRequest"`
+type EvaluatorServiceDebugEvaluatorArgs struct {
+	Req *DebugEvaluatorRequest `thrift:"req,1" frugal:"1,default,DebugEvaluatorRequest"`
 }
 
-func NewEvaluatorServiceGetTemplateInfoArgs() *EvaluatorServiceGetTemplateInfoArgs {
-	return &EvaluatorServiceGetTemplateInfoArgs{}
+func NewEvaluatorServiceDebugEvaluatorArgs() *EvaluatorServiceDebugEvaluatorArgs {
+	return &EvaluatorServiceDebugEvaluatorArgs{}
 }
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) InitDefault() {
+func (p *EvaluatorServiceDebugEvaluatorArgs) InitDefault() {
 }
 
-var EvaluatorServiceGetTemplateInfoArgs_Request_DEFAULT *GetTemplateInfoRequest
+var EvaluatorServiceDebugEvaluatorArgs_Req_DEFAULT *DebugEvaluatorRequest
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) GetRequest() (v *GetTemplateInfoRequest) {
+func (p *EvaluatorServiceDebugEvaluatorArgs) GetReq() (v *DebugEvaluatorRequest) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetRequest() {
-		return EvaluatorServiceGetTemplateInfoArgs_Request_DEFAULT
+	if !p.IsSetReq() {
+		return EvaluatorServiceDebugEvaluatorArgs_Req_DEFAULT
 	}
-	return p.Request
+	return p.Req
 }
-func (p *EvaluatorServiceGetTemplateInfoArgs) SetRequest(val *GetTemplateInfoRequest) {
-	p.Request = val
+func (p *EvaluatorServiceDebugEvaluatorArgs) SetReq(val *DebugEvaluatorRequest) {
+	p.Req = val
 }
 
-var fieldIDToName_EvaluatorServiceGetTemplateInfoArgs = map[int16]string{
-	1: "request",
+var fieldIDToName_EvaluatorServiceDebugEvaluatorArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) IsSetRequest() bool {
-	return p.Request != nil
+func (p *EvaluatorServiceDebugEvaluatorArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceDebugEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -32378,7 +35730,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetTemplateInfoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceDebugEvaluatorArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -32388,18 +35740,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetTemplateInfoRequest()
+func (p *EvaluatorServiceDebugEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDebugEvaluatorRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Request = _field
+	p.Req = _field
 	return nil
 }
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceDebugEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTemplateInfo_args"); err != nil {
+	if err = oprot.WriteStructBegin("DebugEvaluator_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -32425,11 +35777,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+func (p *EvaluatorServiceDebugEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Request.Write(oprot); err != nil {
+	if err := p.Req.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -32442,69 +35794,69 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) String() string {
+func (p *EvaluatorServiceDebugEvaluatorArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceGetTemplateInfoArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceDebugEvaluatorArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) DeepEqual(ano *EvaluatorServiceGetTemplateInfoArgs) bool {
+func (p *EvaluatorServiceDebugEvaluatorArgs) DeepEqual(ano *EvaluatorServiceDebugEvaluatorArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Request) {
+	if !p.Field1DeepEqual(ano.Req) {
 		return false
 	}
 	return true
 }
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) Field1DeepEqual(src *GetTemplateInfoRequest) bool {
+func (p *EvaluatorServiceDebugEvaluatorArgs) Field1DeepEqual(src *DebugEvaluatorRequest) bool {
 
-	if !p.Request.DeepEqual(src) {
+	if !p.Req.DeepEqual(src) {
 		return false
 	}
 	return true
 }
 
-type EvaluatorServiceGetTemplateInfoResult struct {
-	Success *GetTemplateInfoResponse `thrift:"success,0,optional" frugal:"0,optional,GetTemplateInfoResponse"`
+type EvaluatorServiceDebugEvaluatorResult struct {
+	Success *DebugEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,DebugEvaluatorResponse"`
 }
 
-func NewEvaluatorServiceGetTemplateInfoResult() *EvaluatorServiceGetTemplateInfoResult {
-	return &EvaluatorServiceGetTemplateInfoResult{}
+func NewEvaluatorServiceDebugEvaluatorResult() *EvaluatorServiceDebugEvaluatorResult {
+	return &EvaluatorServiceDebugEvaluatorResult{}
 }
 
-func (p *EvaluatorServiceGetTemplateInfoResult) InitDefault() {
+func (p *EvaluatorServiceDebugEvaluatorResult) InitDefault() {
 }
 
-var EvaluatorServiceGetTemplateInfoResult_Success_DEFAULT *GetTemplateInfoResponse
+var EvaluatorServiceDebugEvaluatorResult_Success_DEFAULT *DebugEvaluatorResponse
 
-func (p *EvaluatorServiceGetTemplateInfoResult) GetSuccess() (v *GetTemplateInfoResponse) {
+func (p *EvaluatorServiceDebugEvaluatorResult) GetSuccess() (v *DebugEvaluatorResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceGetTemplateInfoResult_Success_DEFAULT
+		return EvaluatorServiceDebugEvaluatorResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceGetTemplateInfoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetTemplateInfoResponse)
+func (p *EvaluatorServiceDebugEvaluatorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DebugEvaluatorResponse)
 }
 
-var fieldIDToName_EvaluatorServiceGetTemplateInfoResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceDebugEvaluatorResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceGetTemplateInfoResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceDebugEvaluatorResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceGetTemplateInfoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceDebugEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -32549,7 +35901,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetTemplateInfoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceDebugEvaluatorResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -32559,8 +35911,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetTemplateInfoResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetTemplateInfoResponse()
+func (p *EvaluatorServiceDebugEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDebugEvaluatorResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -32568,9 +35920,9 @@ func (p *EvaluatorServiceGetTemplateInfoResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *EvaluatorServiceGetTemplateInfoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceDebugEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTemplateInfo_result"); err != nil {
+	if err = oprot.WriteStructBegin("DebugEvaluator_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -32596,7 +35948,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetTemplateInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceDebugEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -32615,15 +35967,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetTemplateInfoResult) String() string {
+func (p *EvaluatorServiceDebugEvaluatorResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceGetTemplateInfoResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceDebugEvaluatorResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceGetTemplateInfoResult) DeepEqual(ano *EvaluatorServiceGetTemplateInfoResult) bool {
+func (p *EvaluatorServiceDebugEvaluatorResult) DeepEqual(ano *EvaluatorServiceDebugEvaluatorResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -32635,7 +35987,7 @@ func (p *EvaluatorServiceGetTemplateInfoResult) DeepEqual(ano *EvaluatorServiceG
 	return true
 }
 
-func (p *EvaluatorServiceGetTemplateInfoResult) Field0DeepEqual(src *GetTemplateInfoResponse) bool {
+func (p *EvaluatorServiceDebugEvaluatorResult) Field0DeepEqual(src *DebugEvaluatorResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -32643,41 +35995,41 @@ func (p *EvaluatorServiceGetTemplateInfoResult) Field0DeepEqual(src *GetTemplate
 	return true
 }
 
-type EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs struct {
-	Req *GetDefaultPromptEvaluatorToolsRequest `thrift:"req,1" frugal:"1,default,GetDefaultPromptEvaluatorToolsRequest"`
+type EvaluatorServiceBatchDebugEvaluatorArgs struct {
+	Req *BatchDebugEvaluatorRequest `thrift:"req,1" frugal:"1,default,BatchDebugEvaluatorRequest"`
 }
 
-func NewEvaluatorServiceGetDefaultPromptEvaluatorToolsArgs() *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs {
-	return &EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs{}
+func NewEvaluatorServiceBatchDebugEvaluatorArgs() *EvaluatorServiceBatchDebugEvaluatorArgs {
+	return &EvaluatorServiceBatchDebugEvaluatorArgs{}
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) InitDefault() {
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) InitDefault() {
 }
 
-var EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs_Req_DEFAULT *GetDefaultPromptEvaluatorToolsRequest
+var EvaluatorServiceBatchDebugEvaluatorArgs_Req_DEFAULT *BatchDebugEvaluatorRequest
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) GetReq() (v *GetDefaultPromptEvaluatorToolsRequest) {
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) GetReq() (v *BatchDebugEvaluatorRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs_Req_DEFAULT
+		return EvaluatorServiceBatchDebugEvaluatorArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) SetReq(val *GetDefaultPromptEvaluatorToolsRequest) {
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) SetReq(val *BatchDebugEvaluatorRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceBatchDebugEvaluatorArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) IsSetReq() bool {
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -32722,7 +36074,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchDebugEvaluatorArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -32732,8 +36084,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetDefaultPromptEvaluatorToolsRequest()
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchDebugEvaluatorRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -32741,9 +36093,9 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) ReadField1(iprot th
 	return nil
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetDefaultPromptEvaluatorTools_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchDebugEvaluator_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -32769,7 +36121,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -32786,15 +36138,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) String() string {
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceBatchDebugEvaluatorArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) DeepEqual(ano *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) bool {
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) DeepEqual(ano *EvaluatorServiceBatchDebugEvaluatorArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -32806,7 +36158,7 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) DeepEqual(ano *Eval
 	return true
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) Field1DeepEqual(src *GetDefaultPromptEvaluatorToolsRequest) bool {
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) Field1DeepEqual(src *BatchDebugEvaluatorRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -32814,41 +36166,41 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) Field1DeepEqual(src
 	return true
 }
 
-type EvaluatorServiceGetDefaultPromptEvaluatorToolsResult struct {
-	Success *GetDefaultPromptEvaluatorToolsResponse `thrift:"success,0,optional" frugal:"0,optional,GetDefaultPromptEvaluatorToolsResponse"`
+type EvaluatorServiceBatchDebugEvaluatorResult struct {
+	Success *BatchDebugEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,BatchDebugEvaluatorResponse"`
 }
 
-func NewEvaluatorServiceGetDefaultPromptEvaluatorToolsResult() *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult {
-	return &EvaluatorServiceGetDefaultPromptEvaluatorToolsResult{}
+func NewEvaluatorServiceBatchDebugEvaluatorResult() *EvaluatorServiceBatchDebugEvaluatorResult {
+	return &EvaluatorServiceBatchDebugEvaluatorResult{}
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) InitDefault() {
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) InitDefault() {
 }
 
-var EvaluatorServiceGetDefaultPromptEvaluatorToolsResult_Success_DEFAULT *GetDefaultPromptEvaluatorToolsResponse
+var EvaluatorServiceBatchDebugEvaluatorResult_Success_DEFAULT *BatchDebugEvaluatorResponse
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) GetSuccess() (v *GetDefaultPromptEvaluatorToolsResponse) {
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) GetSuccess() (v *BatchDebugEvaluatorResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceGetDefaultPromptEvaluatorToolsResult_Success_DEFAULT
+		return EvaluatorServiceBatchDebugEvaluatorResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetDefaultPromptEvaluatorToolsResponse)
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchDebugEvaluatorResponse)
 }
 
-var fieldIDToName_EvaluatorServiceGetDefaultPromptEvaluatorToolsResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceBatchDebugEvaluatorResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -32893,7 +36245,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetDefaultPromptEvaluatorToolsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchDebugEvaluatorResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -32903,8 +36255,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetDefaultPromptEvaluatorToolsResponse()
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchDebugEvaluatorResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -32912,9 +36264,9 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) ReadField0(iprot
 	return nil
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetDefaultPromptEvaluatorTools_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchDebugEvaluator_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -32940,7 +36292,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -32959,15 +36311,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) String() string {
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceGetDefaultPromptEvaluatorToolsResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceBatchDebugEvaluatorResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) DeepEqual(ano *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) bool {
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) DeepEqual(ano *EvaluatorServiceBatchDebugEvaluatorResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -32979,7 +36331,7 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) DeepEqual(ano *Ev
 	return true
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) Field0DeepEqual(src *GetDefaultPromptEvaluatorToolsResponse) bool {
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) Field0DeepEqual(src *BatchDebugEvaluatorResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -32987,41 +36339,41 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) Field0DeepEqual(s
 	return true
 }
 
-type EvaluatorServiceRunEvaluatorArgs struct {
-	Req *RunEvaluatorRequest `thrift:"req,1" frugal:"1,default,RunEvaluatorRequest"`
+type EvaluatorServiceAsyncRunEvaluatorArgs struct {
+	Req *AsyncRunEvaluatorRequest `thrift:"req,1" frugal:"1,default,AsyncRunEvaluatorRequest"`
 }
 
-func NewEvaluatorServiceRunEvaluatorArgs() *EvaluatorServiceRunEvaluatorArgs {
-	return &EvaluatorServiceRunEvaluatorArgs{}
+func NewEvaluatorServiceAsyncRunEvaluatorArgs() *EvaluatorServiceAsyncRunEvaluatorArgs {
+	return &EvaluatorServiceAsyncRunEvaluatorArgs{}
 }
 
-func (p *EvaluatorServiceRunEvaluatorArgs) InitDefault() {
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) InitDefault() {
 }
 
-var EvaluatorServiceRunEvaluatorArgs_Req_DEFAULT *RunEvaluatorRequest
+var EvaluatorServiceAsyncRunEvaluatorArgs_Req_DEFAULT *AsyncRunEvaluatorRequest
 
-func (p *EvaluatorServiceRunEvaluatorArgs) GetReq() (v *RunEvaluatorRequest) {
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) GetReq() (v *AsyncRunEvaluatorRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluatorServiceRunEvaluatorArgs_Req_DEFAULT
+		return EvaluatorServiceAsyncRunEvaluatorArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluatorServiceRunEvaluatorArgs) SetReq(val *RunEvaluatorRequest) {
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) SetReq(val *AsyncRunEvaluatorRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluatorServiceRunEvaluatorArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceAsyncRunEvaluatorArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluatorServiceRunEvaluatorArgs) IsSetReq() bool {
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluatorServiceRunEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -33066,7 +36418,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceRunEvaluatorArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceAsyncRunEvaluatorArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -33076,8 +36428,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceRunEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewRunEvaluatorRequest()
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAsyncRunEvaluatorRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -33085,9 +36437,9 @@ func (p *EvaluatorServiceRunEvaluatorArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *EvaluatorServiceRunEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RunEvaluator_args"); err != nil {
+	if err = oprot.WriteStructBegin("AsyncRunEvaluator_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -33113,7 +36465,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceRunEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -33130,15 +36482,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceRunEvaluatorArgs) String() string {
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceRunEvaluatorArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceAsyncRunEvaluatorArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceRunEvaluatorArgs) DeepEqual(ano *EvaluatorServiceRunEvaluatorArgs) bool {
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) DeepEqual(ano *EvaluatorServiceAsyncRunEvaluatorArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -33150,7 +36502,7 @@ func (p *EvaluatorServiceRunEvaluatorArgs) DeepEqual(ano *EvaluatorServiceRunEva
 	return true
 }
 
-func (p *EvaluatorServiceRunEvaluatorArgs) Field1DeepEqual(src *RunEvaluatorRequest) bool {
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) Field1DeepEqual(src *AsyncRunEvaluatorRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -33158,41 +36510,41 @@ func (p *EvaluatorServiceRunEvaluatorArgs) Field1DeepEqual(src *RunEvaluatorRequ
 	return true
 }
 
-type EvaluatorServiceRunEvaluatorResult struct {
-	Success *RunEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,RunEvaluatorResponse"`
+type EvaluatorServiceAsyncRunEvaluatorResult struct {
+	Success *AsyncRunEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,AsyncRunEvaluatorResponse"`
 }
 
-func NewEvaluatorServiceRunEvaluatorResult() *EvaluatorServiceRunEvaluatorResult {
-	return &EvaluatorServiceRunEvaluatorResult{}
+func NewEvaluatorServiceAsyncRunEvaluatorResult() *EvaluatorServiceAsyncRunEvaluatorResult {
+	return &EvaluatorServiceAsyncRunEvaluatorResult{}
 }
 
-func (p *EvaluatorServiceRunEvaluatorResult) InitDefault() {
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) InitDefault() {
 }
 
-var EvaluatorServiceRunEvaluatorResult_Success_DEFAULT *RunEvaluatorResponse
+var EvaluatorServiceAsyncRunEvaluatorResult_Success_DEFAULT *AsyncRunEvaluatorResponse
 
-func (p *EvaluatorServiceRunEvaluatorResult) GetSuccess() (v *RunEvaluatorResponse) {
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) GetSuccess() (v *AsyncRunEvaluatorResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceRunEvaluatorResult_Success_DEFAULT
+		return EvaluatorServiceAsyncRunEvaluatorResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceRunEvaluatorResult) SetSuccess(x interface{}) {
-	p.Success = x.(*RunEvaluatorResponse)
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AsyncRunEvaluatorResponse)
 }
 
-var fieldIDToName_EvaluatorServiceRunEvaluatorResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceAsyncRunEvaluatorResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceRunEvaluatorResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceRunEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -33237,7 +36589,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceRunEvaluatorResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceAsyncRunEvaluatorResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -33246,9 +36598,9 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-
-func (p *EvaluatorServiceRunEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewRunEvaluatorResponse()
+
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAsyncRunEvaluatorResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -33256,9 +36608,9 @@ func (p *EvaluatorServiceRunEvaluatorResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *EvaluatorServiceRunEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RunEvaluator_result"); err != nil {
+	if err = oprot.WriteStructBegin("AsyncRunEvaluator_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -33284,7 +36636,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceRunEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -33303,15 +36655,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceRunEvaluatorResult) String() string {
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceRunEvaluatorResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceAsyncRunEvaluatorResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceRunEvaluatorResult) DeepEqual(ano *EvaluatorServiceRunEvaluatorResult) bool {
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) DeepEqual(ano *EvaluatorServiceAsyncRunEvaluatorResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -33323,7 +36675,7 @@ func (p *EvaluatorServiceRunEvaluatorResult) DeepEqual(ano *EvaluatorServiceRunE
 	return true
 }
 
-func (p *EvaluatorServiceRunEvaluatorResult) Field0DeepEqual(src *RunEvaluatorResponse) bool {
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) Field0DeepEqual(src *AsyncRunEvaluatorResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -33331,41 +36683,41 @@ func (p *EvaluatorServiceRunEvaluatorResult) Field0DeepEqual(src *RunEvaluatorRe
 	return true
 }
 
-type EvaluatorServiceDebugEvaluatorArgs struct {
-	Req *DebugEvaluatorRequest `thrift:"req,1" frugal:"1,default,DebugEvaluatorRequest"`
+type EvaluatorServiceAsyncDebugEvaluatorArgs struct {
+	Req *AsyncDebugEvaluatorRequest `thrift:"req,1" frugal:"1,default,AsyncDebugEvaluatorRequest"`
 }
 
-func NewEvaluatorServiceDebugEvaluatorArgs() *EvaluatorServiceDebugEvaluatorArgs {
-	return &EvaluatorServiceDebugEvaluatorArgs{}
+func NewEvaluatorServiceAsyncDebugEvaluatorArgs() *EvaluatorServiceAsyncDebugEvaluatorArgs {
+	return &EvaluatorServiceAsyncDebugEvaluatorArgs{}
 }
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) InitDefault() {
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) InitDefault() {
 }
 
-var EvaluatorServiceDebugEvaluatorArgs_Req_DEFAULT *DebugEvaluatorRequest
+var EvaluatorServiceAsyncDebugEvaluatorArgs_Req_DEFAULT *AsyncDebugEvaluatorRequest
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) GetReq() (v *DebugEvaluatorRequest) {
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) GetReq() (v *AsyncDebugEvaluatorRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluatorServiceDebugEvaluatorArgs_Req_DEFAULT
+		return EvaluatorServiceAsyncDebugEvaluatorArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluatorServiceDebugEvaluatorArgs) SetReq(val *DebugEvaluatorRequest) {
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) SetReq(val *AsyncDebugEvaluatorRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluatorServiceDebugEvaluatorArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceAsyncDebugEvaluatorArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) IsSetReq() bool {
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -33410,7 +36762,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceDebugEvaluatorArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceAsyncDebugEvaluatorArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -33420,8 +36772,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDebugEvaluatorRequest()
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAsyncDebugEvaluatorRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -33429,9 +36781,9 @@ func (p *EvaluatorServiceDebugEvaluatorArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DebugEvaluator_args"); err != nil {
+	if err = oprot.WriteStructBegin("AsyncDebugEvaluator_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -33457,7 +36809,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -33474,15 +36826,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) String() string {
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceDebugEvaluatorArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceAsyncDebugEvaluatorArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) DeepEqual(ano *EvaluatorServiceDebugEvaluatorArgs) bool {
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) DeepEqual(ano *EvaluatorServiceAsyncDebugEvaluatorArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -33494,7 +36846,7 @@ func (p *EvaluatorServiceDebugEvaluatorArgs) DeepEqual(ano *EvaluatorServiceDebu
 	return true
 }
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) Field1DeepEqual(src *DebugEvaluatorRequest) bool {
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) Field1DeepEqual(src *AsyncDebugEvaluatorRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -33502,41 +36854,41 @@ func (p *EvaluatorServiceDebugEvaluatorArgs) Field1DeepEqual(src *DebugEvaluator
 	return true
 }
 
-type EvaluatorServiceDebugEvaluatorResult struct {
-	Success *DebugEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,DebugEvaluatorResponse"`
+type EvaluatorServiceAsyncDebugEvaluatorResult struct {
+	Success *AsyncDebugEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,AsyncDebugEvaluatorResponse"`
 }
 
-func NewEvaluatorServiceDebugEvaluatorResult() *EvaluatorServiceDebugEvaluatorResult {
-	return &EvaluatorServiceDebugEvaluatorResult{}
+func NewEvaluatorServiceAsyncDebugEvaluatorResult() *EvaluatorServiceAsyncDebugEvaluatorResult {
+	return &EvaluatorServiceAsyncDebugEvaluatorResult{}
 }
 
-func (p *EvaluatorServiceDebugEvaluatorResult) InitDefault() {
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) InitDefault() {
 }
 
-var EvaluatorServiceDebugEvaluatorResult_Success_DEFAULT *DebugEvaluatorResponse
+var EvaluatorServiceAsyncDebugEvaluatorResult_Success_DEFAULT *AsyncDebugEvaluatorResponse
 
-func (p *EvaluatorServiceDebugEvaluatorResult) GetSuccess() (v *DebugEvaluatorResponse) {
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) GetSuccess() (v *AsyncDebugEvaluatorResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceDebugEvaluatorResult_Success_DEFAULT
+		return EvaluatorServiceAsyncDebugEvaluatorResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceDebugEvaluatorResult) SetSuccess(x interface{}) {
-	p.Success = x.(*DebugEvaluatorResponse)
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AsyncDebugEvaluatorResponse)
 }
 
-var fieldIDToName_EvaluatorServiceDebugEvaluatorResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceAsyncDebugEvaluatorResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceDebugEvaluatorResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceDebugEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -33581,7 +36933,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceDebugEvaluatorResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceAsyncDebugEvaluatorResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -33591,8 +36943,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceDebugEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDebugEvaluatorResponse()
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAsyncDebugEvaluatorResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -33600,9 +36952,9 @@ func (p *EvaluatorServiceDebugEvaluatorResult) ReadField0(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *EvaluatorServiceDebugEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DebugEvaluator_result"); err != nil {
+	if err = oprot.WriteStructBegin("AsyncDebugEvaluator_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -33628,7 +36980,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceDebugEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -33647,15 +36999,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceDebugEvaluatorResult) String() string {
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceDebugEvaluatorResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceAsyncDebugEvaluatorResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceDebugEvaluatorResult) DeepEqual(ano *EvaluatorServiceDebugEvaluatorResult) bool {
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) DeepEqual(ano *EvaluatorServiceAsyncDebugEvaluatorResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -33667,7 +37019,7 @@ func (p *EvaluatorServiceDebugEvaluatorResult) DeepEqual(ano *EvaluatorServiceDe
 	return true
 }
 
-func (p *EvaluatorServiceDebugEvaluatorResult) Field0DeepEqual(src *DebugEvaluatorResponse) bool {
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) Field0DeepEqual(src *AsyncDebugEvaluatorResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -33675,41 +37027,41 @@ func (p *EvaluatorServiceDebugEvaluatorResult) Field0DeepEqual(src *DebugEvaluat
 	return true
 }
 
-type EvaluatorServiceBatchDebugEvaluatorArgs struct {
-	Req *BatchDebugEvaluatorRequest `thrift:"req,1" frugal:"1,default,BatchDebugEvaluatorRequest"`
+type EvaluatorServiceUpdateEvaluatorRecordArgs struct {
+	Req *UpdateEvaluatorRecordRequest `thrift:"req,1" frugal:"1,default,UpdateEvaluatorRecordRequest"`
 }
 
-func NewEvaluatorServiceBatchDebugEvaluatorArgs() *EvaluatorServiceBatchDebugEvaluatorArgs {
-	return &EvaluatorServiceBatchDebugEvaluatorArgs{}
+func NewEvaluatorServiceUpdateEvaluatorRecordArgs() *EvaluatorServiceUpdateEvaluatorRecordArgs {
+	return &EvaluatorServiceUpdateEvaluatorRecordArgs{}
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) InitDefault() {
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) InitDefault() {
 }
 
-var EvaluatorServiceBatchDebugEvaluatorArgs_Req_DEFAULT *BatchDebugEvaluatorRequest
+var EvaluatorServiceUpdateEvaluatorRecordArgs_Req_DEFAULT *UpdateEvaluatorRecordRequest
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) GetReq() (v *BatchDebugEvaluatorRequest) {
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) GetReq() (v *UpdateEvaluatorRecordRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluatorServiceBatchDebugEvaluatorArgs_Req_DEFAULT
+		return EvaluatorServiceUpdateEvaluatorRecordArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) SetReq(val *BatchDebugEvaluatorRequest) {
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) SetReq(val *UpdateEvaluatorRecordRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluatorServiceBatchDebugEvaluatorArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceUpdateEvaluatorRecordArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) IsSetReq() bool {
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -33754,7 +37106,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchDebugEvaluatorArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorRecordArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -33764,8 +37116,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewBatchDebugEvaluatorRequest()
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateEvaluatorRecordRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -33773,9 +37125,9 @@ func (p *EvaluatorServiceBatchDebugEvaluatorArgs) ReadField1(iprot thrift.TProto
 	return nil
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchDebugEvaluator_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateEvaluatorRecord_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -33801,7 +37153,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -33818,15 +37170,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) String() string {
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceBatchDebugEvaluatorArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceUpdateEvaluatorRecordArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) DeepEqual(ano *EvaluatorServiceBatchDebugEvaluatorArgs) bool {
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) DeepEqual(ano *EvaluatorServiceUpdateEvaluatorRecordArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -33838,7 +37190,7 @@ func (p *EvaluatorServiceBatchDebugEvaluatorArgs) DeepEqual(ano *EvaluatorServic
 	return true
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) Field1DeepEqual(src *BatchDebugEvaluatorRequest) bool {
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) Field1DeepEqual(src *UpdateEvaluatorRecordRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -33846,41 +37198,41 @@ func (p *EvaluatorServiceBatchDebugEvaluatorArgs) Field1DeepEqual(src *BatchDebu
 	return true
 }
 
-type EvaluatorServiceBatchDebugEvaluatorResult struct {
-	Success *BatchDebugEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,BatchDebugEvaluatorResponse"`
+type EvaluatorServiceUpdateEvaluatorRecordResult struct {
+	Success *UpdateEvaluatorRecordResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateEvaluatorRecordResponse"`
 }
 
-func NewEvaluatorServiceBatchDebugEvaluatorResult() *EvaluatorServiceBatchDebugEvaluatorResult {
-	return &EvaluatorServiceBatchDebugEvaluatorResult{}
+func NewEvaluatorServiceUpdateEvaluatorRecordResult() *EvaluatorServiceUpdateEvaluatorRecordResult {
+	return &EvaluatorServiceUpdateEvaluatorRecordResult{}
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) InitDefault() {
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) InitDefault() {
 }
 
-var EvaluatorServiceBatchDebugEvaluatorResult_Success_DEFAULT *BatchDebugEvaluatorResponse
+var EvaluatorServiceUpdateEvaluatorRecordResult_Success_DEFAULT *UpdateEvaluatorRecordResponse
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) GetSuccess() (v *BatchDebugEvaluatorResponse) {
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) GetSuccess() (v *UpdateEvaluatorRecordResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceBatchDebugEvaluatorResult_Success_DEFAULT
+		return EvaluatorServiceUpdateEvaluatorRecordResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchDebugEvaluatorResponse)
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateEvaluatorRecordResponse)
 }
 
-var fieldIDToName_EvaluatorServiceBatchDebugEvaluatorResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceUpdateEvaluatorRecordResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -33925,7 +37277,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchDebugEvaluatorResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorRecordResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -33935,8 +37287,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewBatchDebugEvaluatorResponse()
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateEvaluatorRecordResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -33944,9 +37296,9 @@ func (p *EvaluatorServiceBatchDebugEvaluatorResult) ReadField0(iprot thrift.TPro
 	return nil
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchDebugEvaluator_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateEvaluatorRecord_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -33972,7 +37324,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -33991,15 +37343,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) String() string {
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceBatchDebugEvaluatorResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceUpdateEvaluatorRecordResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) DeepEqual(ano *EvaluatorServiceBatchDebugEvaluatorResult) bool {
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) DeepEqual(ano *EvaluatorServiceUpdateEvaluatorRecordResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -34011,7 +37363,7 @@ func (p *EvaluatorServiceBatchDebugEvaluatorResult) DeepEqual(ano *EvaluatorServ
 	return true
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) Field0DeepEqual(src *BatchDebugEvaluatorResponse) bool {
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) Field0DeepEqual(src *UpdateEvaluatorRecordResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -34019,41 +37371,41 @@ func (p *EvaluatorServiceBatchDebugEvaluatorResult) Field0DeepEqual(src *BatchDe
 	return true
 }
 
-type EvaluatorServiceAsyncRunEvaluatorArgs struct {
-	Req *AsyncRunEvaluatorRequest `thrift:"req,1" frugal:"1,default,AsyncRunEvaluatorRequest"`
+type EvaluatorServiceGetEvaluatorRecordArgs struct {
+	Req *GetEvaluatorRecordRequest `thrift:"req,1" frugal:"1,default,GetEvaluatorRecordRequest"`
 }
 
-func NewEvaluatorServiceAsyncRunEvaluatorArgs() *EvaluatorServiceAsyncRunEvaluatorArgs {
-	return &EvaluatorServiceAsyncRunEvaluatorArgs{}
+func NewEvaluatorServiceGetEvaluatorRecordArgs() *EvaluatorServiceGetEvaluatorRecordArgs {
+	return &EvaluatorServiceGetEvaluatorRecordArgs{}
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) InitDefault() {
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) InitDefault() {
 }
 
-var EvaluatorServiceAsyncRunEvaluatorArgs_Req_DEFAULT *AsyncRunEvaluatorRequest
+var EvaluatorServiceGetEvaluatorRecordArgs_Req_DEFAULT *GetEvaluatorRecordRequest
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) GetReq() (v *AsyncRunEvaluatorRequest) {
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) GetReq() (v *GetEvaluatorRecordRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluatorServiceAsyncRunEvaluatorArgs_Req_DEFAULT
+		return EvaluatorServiceGetEvaluatorRecordArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) SetReq(val *AsyncRunEvaluatorRequest) {
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) SetReq(val *GetEvaluatorRecordRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluatorServiceAsyncRunEvaluatorArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceGetEvaluatorRecordArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) IsSetReq() bool {
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -34098,7 +37450,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceAsyncRunEvaluatorArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorRecordArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -34108,8 +37460,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAsyncRunEvaluatorRequest()
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetEvaluatorRecordRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -34117,9 +37469,9 @@ func (p *EvaluatorServiceAsyncRunEvaluatorArgs) ReadField1(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AsyncRunEvaluator_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvaluatorRecord_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -34145,7 +37497,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -34162,15 +37514,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) String() string {
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceAsyncRunEvaluatorArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceGetEvaluatorRecordArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) DeepEqual(ano *EvaluatorServiceAsyncRunEvaluatorArgs) bool {
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) DeepEqual(ano *EvaluatorServiceGetEvaluatorRecordArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -34182,7 +37534,7 @@ func (p *EvaluatorServiceAsyncRunEvaluatorArgs) DeepEqual(ano *EvaluatorServiceA
 	return true
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) Field1DeepEqual(src *AsyncRunEvaluatorRequest) bool {
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) Field1DeepEqual(src *GetEvaluatorRecordRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -34190,41 +37542,41 @@ func (p *EvaluatorServiceAsyncRunEvaluatorArgs) Field1DeepEqual(src *AsyncRunEva
 	return true
 }
 
-type EvaluatorServiceAsyncRunEvaluatorResult struct {
-	Success *AsyncRunEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,AsyncRunEvaluatorResponse"`
+type EvaluatorServiceGetEvaluatorRecordResult struct {
+	Success *GetEvaluatorRecordResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvaluatorRecordResponse"`
 }
 
-func NewEvaluatorServiceAsyncRunEvaluatorResult() *EvaluatorServiceAsyncRunEvaluatorResult {
-	return &EvaluatorServiceAsyncRunEvaluatorResult{}
+func NewEvaluatorServiceGetEvaluatorRecordResult() *EvaluatorServiceGetEvaluatorRecordResult {
+	return &EvaluatorServiceGetEvaluatorRecordResult{}
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) InitDefault() {
+func (p *EvaluatorServiceGetEvaluatorRecordResult) InitDefault() {
 }
 
-var EvaluatorServiceAsyncRunEvaluatorResult_Success_DEFAULT *AsyncRunEvaluatorResponse
+var EvaluatorServiceGetEvaluatorRecordResult_Success_DEFAULT *GetEvaluatorRecordResponse
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) GetSuccess() (v *AsyncRunEvaluatorResponse) {
+func (p *EvaluatorServiceGetEvaluatorRecordResult) GetSuccess() (v *GetEvaluatorRecordResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceAsyncRunEvaluatorResult_Success_DEFAULT
+		return EvaluatorServiceGetEvaluatorRecordResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) SetSuccess(x interface{}) {
-	p.Success = x.(*AsyncRunEvaluatorResponse)
+func (p *EvaluatorServiceGetEvaluatorRecordResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetEvaluatorRecordResponse)
 }
 
-var fieldIDToName_EvaluatorServiceAsyncRunEvaluatorResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceGetEvaluatorRecordResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceGetEvaluatorRecordResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorRecordResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -34269,7 +37621,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceAsyncRunEvaluatorResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorRecordResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -34279,8 +37631,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAsyncRunEvaluatorResponse()
+func (p *EvaluatorServiceGetEvaluatorRecordResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetEvaluatorRecordResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -34288,9 +37640,9 @@ func (p *EvaluatorServiceAsyncRunEvaluatorResult) ReadField0(iprot thrift.TProto
 	return nil
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorRecordResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AsyncRunEvaluator_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvaluatorRecord_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -34316,7 +37668,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorRecordResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -34335,15 +37687,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) String() string {
+func (p *EvaluatorServiceGetEvaluatorRecordResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceAsyncRunEvaluatorResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceGetEvaluatorRecordResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) DeepEqual(ano *EvaluatorServiceAsyncRunEvaluatorResult) bool {
+func (p *EvaluatorServiceGetEvaluatorRecordResult) DeepEqual(ano *EvaluatorServiceGetEvaluatorRecordResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -34355,7 +37707,7 @@ func (p *EvaluatorServiceAsyncRunEvaluatorResult) DeepEqual(ano *EvaluatorServic
 	return true
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) Field0DeepEqual(src *AsyncRunEvaluatorResponse) bool {
+func (p *EvaluatorServiceGetEvaluatorRecordResult) Field0DeepEqual(src *GetEvaluatorRecordResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -34363,41 +37715,41 @@ func (p *EvaluatorServiceAsyncRunEvaluatorResult) Field0DeepEqual(src *AsyncRunE
 	return true
 }
 
-type EvaluatorServiceAsyncDebugEvaluatorArgs struct {
-	Req *AsyncDebugEvaluatorRequest `thrift:"req,1" frugal:"1,default,AsyncDebugEvaluatorRequest"`
+type EvaluatorServiceBatchGetEvaluatorRecordsArgs struct {
+	Req *BatchGetEvaluatorRecordsRequest `thrift:"req,1" frugal:"1,default,BatchGetEvaluatorRecordsRequest"`
 }
 
-func NewEvaluatorServiceAsyncDebugEvaluatorArgs() *EvaluatorServiceAsyncDebugEvaluatorArgs {
-	return &EvaluatorServiceAsyncDebugEvaluatorArgs{}
+func NewEvaluatorServiceBatchGetEvaluatorRecordsArgs() *EvaluatorServiceBatchGetEvaluatorRecordsArgs {
+	return &EvaluatorServiceBatchGetEvaluatorRecordsArgs{}
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) InitDefault() {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) InitDefault() {
 }
 
-var EvaluatorServiceAsyncDebugEvaluatorArgs_Req_DEFAULT *AsyncDebugEvaluatorRequest
+var EvaluatorServiceBatchGetEvaluatorRecordsArgs_Req_DEFAULT *BatchGetEvaluatorRecordsRequest
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) GetReq() (v *AsyncDebugEvaluatorRequest) {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) GetReq() (v *BatchGetEvaluatorRecordsRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluatorServiceAsyncDebugEvaluatorArgs_Req_DEFAULT
+		return EvaluatorServiceBatchGetEvaluatorRecordsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) SetReq(val *AsyncDebugEvaluatorRequest) {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) SetReq(val *BatchGetEvaluatorRecordsRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluatorServiceAsyncDebugEvaluatorArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceBatchGetEvaluatorRecordsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) IsSetReq() bool {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -34442,7 +37794,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceAsyncDebugEvaluatorArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorRecordsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -34452,8 +37804,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAsyncDebugEvaluatorRequest()
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchGetEvaluatorRecordsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -34461,9 +37813,9 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) ReadField1(iprot thrift.TProto
 	return nil
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AsyncDebugEvaluator_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetEvaluatorRecords_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -34489,7 +37841,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -34506,15 +37858,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) String() string {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceAsyncDebugEvaluatorArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceBatchGetEvaluatorRecordsArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) DeepEqual(ano *EvaluatorServiceAsyncDebugEvaluatorArgs) bool {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) DeepEqual(ano *EvaluatorServiceBatchGetEvaluatorRecordsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -34526,7 +37878,7 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) DeepEqual(ano *EvaluatorServic
 	return true
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) Field1DeepEqual(src *AsyncDebugEvaluatorRequest) bool {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) Field1DeepEqual(src *BatchGetEvaluatorRecordsRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -34534,41 +37886,41 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) Field1DeepEqual(src *AsyncDebu
 	return true
 }
 
-type EvaluatorServiceAsyncDebugEvaluatorResult struct {
-	Success *AsyncDebugEvaluatorResponse `thrift:"success,0,optional" frugal:"0,optional,AsyncDebugEvaluatorResponse"`
+type EvaluatorServiceBatchGetEvaluatorRecordsResult struct {
+	Success *BatchGetEvaluatorRecordsResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetEvaluatorRecordsResponse"`
 }
 
-func NewEvaluatorServiceAsyncDebugEvaluatorResult() *EvaluatorServiceAsyncDebugEvaluatorResult {
-	return &EvaluatorServiceAsyncDebugEvaluatorResult{}
+func NewEvaluatorServiceBatchGetEvaluatorRecordsResult() *EvaluatorServiceBatchGetEvaluatorRecordsResult {
+	return &EvaluatorServiceBatchGetEvaluatorRecordsResult{}
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) InitDefault() {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) InitDefault() {
 }
 
-var EvaluatorServiceAsyncDebugEvaluatorResult_Success_DEFAULT *AsyncDebugEvaluatorResponse
+var EvaluatorServiceBatchGetEvaluatorRecordsResult_Success_DEFAULT *BatchGetEvaluatorRecordsResponse
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) GetSuccess() (v *AsyncDebugEvaluatorResponse) {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) GetSuccess() (v *BatchGetEvaluatorRecordsResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceAsyncDebugEvaluatorResult_Success_DEFAULT
+		return EvaluatorServiceBatchGetEvaluatorRecordsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) SetSuccess(x interface{}) {
-	p.Success = x.(*AsyncDebugEvaluatorResponse)
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchGetEvaluatorRecordsResponse)
 }
 
-var fieldIDToName_EvaluatorServiceAsyncDebugEvaluatorResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceBatchGetEvaluatorRecordsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -34613,7 +37965,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceAsyncDebugEvaluatorResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorRecordsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -34623,8 +37975,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAsyncDebugEvaluatorResponse()
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchGetEvaluatorRecordsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -34632,9 +37984,9 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorResult) ReadField0(iprot thrift.TPro
 	return nil
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AsyncDebugEvaluator_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetEvaluatorRecords_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -34660,7 +38012,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -34679,15 +38031,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) String() string {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceAsyncDebugEvaluatorResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceBatchGetEvaluatorRecordsResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) DeepEqual(ano *EvaluatorServiceAsyncDebugEvaluatorResult) bool {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) DeepEqual(ano *EvaluatorServiceBatchGetEvaluatorRecordsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -34699,7 +38051,7 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorResult) DeepEqual(ano *EvaluatorServ
 	return true
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) Field0DeepEqual(src *AsyncDebugEvaluatorResponse) bool {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) Field0DeepEqual(src *BatchGetEvaluatorRecordsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -34707,41 +38059,41 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorResult) Field0DeepEqual(src *AsyncDe
 	return true
 }
 
-type EvaluatorServiceUpdateEvaluatorRecordArgs struct {
-	Req *UpdateEvaluatorRecordRequest `thrift:"req,1" frugal:"1,default,UpdateEvaluatorRecordRequest"`
+type EvaluatorServiceRunEvaluatorCalibrationArgs struct {
+	Req *RunEvaluatorCalibrationRequest `thrift:"req,1" frugal:"1,default,RunEvaluatorCalibrationRequest"`
 }
 
-func NewEvaluatorServiceUpdateEvaluatorRecordArgs() *EvaluatorServiceUpdateEvaluatorRecordArgs {
-	return &EvaluatorServiceUpdateEvaluatorRecordArgs{}
+func NewEvaluatorServiceRunEvaluatorCalibrationArgs() *EvaluatorServiceRunEvaluatorCalibrationArgs {
+	return &EvaluatorServiceRunEvaluatorCalibrationArgs{}
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) InitDefault() {
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) InitDefault() {
 }
 
-var EvaluatorServiceUpdateEvaluatorRecordArgs_Req_DEFAULT *UpdateEvaluatorRecordRequest
+var EvaluatorServiceRunEvaluatorCalibrationArgs_Req_DEFAULT *RunEvaluatorCalibrationRequest
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) GetReq() (v *UpdateEvaluatorRecordRequest) {
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) GetReq() (v *RunEvaluatorCalibrationRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluatorServiceUpdateEvaluatorRecordArgs_Req_DEFAULT
+		return EvaluatorServiceRunEvaluatorCalibrationArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) SetReq(val *UpdateEvaluatorRecordRequest) {
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) SetReq(val *RunEvaluatorCalibrationRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluatorServiceUpdateEvaluatorRecordArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceRunEvaluatorCalibrationArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) IsSetReq() bool {
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -34786,7 +38138,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorRecordArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceRunEvaluatorCalibrationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -34796,8 +38148,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateEvaluatorRecordRequest()
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRunEvaluatorCalibrationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -34805,9 +38157,9 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) ReadField1(iprot thrift.TPro
 	return nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateEvaluatorRecord_args"); err != nil {
+	if err = oprot.WriteStructBegin("RunEvaluatorCalibration_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -34833,7 +38185,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -34850,15 +38202,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) String() string {
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceUpdateEvaluatorRecordArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceRunEvaluatorCalibrationArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) DeepEqual(ano *EvaluatorServiceUpdateEvaluatorRecordArgs) bool {
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) DeepEqual(ano *EvaluatorServiceRunEvaluatorCalibrationArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -34870,7 +38222,7 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) DeepEqual(ano *EvaluatorServ
 	return true
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) Field1DeepEqual(src *UpdateEvaluatorRecordRequest) bool {
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) Field1DeepEqual(src *RunEvaluatorCalibrationRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -34878,41 +38230,41 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) Field1DeepEqual(src *UpdateE
 	return true
 }
 
-type EvaluatorServiceUpdateEvaluatorRecordResult struct {
-	Success *UpdateEvaluatorRecordResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateEvaluatorRecordResponse"`
+type EvaluatorServiceRunEvaluatorCalibrationResult struct {
+	Success *RunEvaluatorCalibrationResponse `thrift:"success,0,optional" frugal:"0,optional,RunEvaluatorCalibrationResponse"`
 }
 
-func NewEvaluatorServiceUpdateEvaluatorRecordResult() *EvaluatorServiceUpdateEvaluatorRecordResult {
-	return &EvaluatorServiceUpdateEvaluatorRecordResult{}
+func NewEvaluatorServiceRunEvaluatorCalibrationResult() *EvaluatorServiceRunEvaluatorCalibrationResult {
+	return &EvaluatorServiceRunEvaluatorCalibrationResult{}
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) InitDefault() {
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) InitDefault() {
 }
 
-var EvaluatorServiceUpdateEvaluatorRecordResult_Success_DEFAULT *UpdateEvaluatorRecordResponse
+var EvaluatorServiceRunEvaluatorCalibrationResult_Success_DEFAULT *RunEvaluatorCalibrationResponse
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) GetSuccess() (v *UpdateEvaluatorRecordResponse) {
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) GetSuccess() (v *RunEvaluatorCalibrationResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceUpdateEvaluatorRecordResult_Success_DEFAULT
+		return EvaluatorServiceRunEvaluatorCalibrationResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UpdateEvaluatorRecordResponse)
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RunEvaluatorCalibrationResponse)
 }
 
-var fieldIDToName_EvaluatorServiceUpdateEvaluatorRecordResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceRunEvaluatorCalibrationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -34957,7 +38309,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorRecordResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceRunEvaluatorCalibrationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -34967,8 +38319,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateEvaluatorRecordResponse()
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRunEvaluatorCalibrationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -34976,9 +38328,9 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordResult) ReadField0(iprot thrift.TP
 	return nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateEvaluatorRecord_result"); err != nil {
+	if err = oprot.WriteStructBegin("RunEvaluatorCalibration_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -35004,7 +38356,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -35023,15 +38375,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) String() string {
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceUpdateEvaluatorRecordResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceRunEvaluatorCalibrationResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) DeepEqual(ano *EvaluatorServiceUpdateEvaluatorRecordResult) bool {
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) DeepEqual(ano *EvaluatorServiceRunEvaluatorCalibrationResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -35043,7 +38395,7 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordResult) DeepEqual(ano *EvaluatorSe
 	return true
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) Field0DeepEqual(src *UpdateEvaluatorRecordResponse) bool {
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) Field0DeepEqual(src *RunEvaluatorCalibrationResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -35051,41 +38403,41 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordResult) Field0DeepEqual(src *Updat
 	return true
 }
 
-type EvaluatorServiceGetEvaluatorRecordArgs struct {
-	Req *GetEvaluatorRecordRequest `thrift:"req,1" frugal:"1,default,GetEvaluatorRecordRequest"`
+type EvaluatorServiceGetEvaluatorCalibrationRecordArgs struct {
+	Req *GetEvaluatorCalibrationRecordRequest `thrift:"req,1" frugal:"1,default,GetEvaluatorCalibrationRecordRequest"`
 }
 
-func NewEvaluatorServiceGetEvaluatorRecordArgs() *EvaluatorServiceGetEvaluatorRecordArgs {
-	return &EvaluatorServiceGetEvaluatorRecordArgs{}
+func NewEvaluatorServiceGetEvaluatorCalibrationRecordArgs() *EvaluatorServiceGetEvaluatorCalibrationRecordArgs {
+	return &EvaluatorServiceGetEvaluatorCalibrationRecordArgs{}
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) InitDefault() {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) InitDefault() {
 }
 
-var EvaluatorServiceGetEvaluatorRecordArgs_Req_DEFAULT *GetEvaluatorRecordRequest
+var EvaluatorServiceGetEvaluatorCalibrationRecordArgs_Req_DEFAULT *GetEvaluatorCalibrationRecordRequest
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) GetReq() (v *GetEvaluatorRecordRequest) {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) GetReq() (v *GetEvaluatorCalibrationRecordRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluatorServiceGetEvaluatorRecordArgs_Req_DEFAULT
+		return EvaluatorServiceGetEvaluatorCalibrationRecordArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) SetReq(val *GetEvaluatorRecordRequest) {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) SetReq(val *GetEvaluatorCalibrationRecordRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluatorServiceGetEvaluatorRecordArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceGetEvaluatorCalibrationRecordArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) IsSetReq() bool {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -35130,7 +38482,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorRecordArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorCalibrationRecordArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -35140,8 +38492,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetEvaluatorRecordRequest()
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetEvaluatorCalibrationRecordRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -35149,9 +38501,9 @@ func (p *EvaluatorServiceGetEvaluatorRecordArgs) ReadField1(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvaluatorRecord_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvaluatorCalibrationRecord_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -35177,7 +38529,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -35194,15 +38546,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) String() string {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceGetEvaluatorRecordArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceGetEvaluatorCalibrationRecordArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) DeepEqual(ano *EvaluatorServiceGetEvaluatorRecordArgs) bool {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) DeepEqual(ano *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -35214,7 +38566,7 @@ func (p *EvaluatorServiceGetEvaluatorRecordArgs) DeepEqual(ano *EvaluatorService
 	return true
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) Field1DeepEqual(src *GetEvaluatorRecordRequest) bool {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) Field1DeepEqual(src *GetEvaluatorCalibrationRecordRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -35222,41 +38574,41 @@ func (p *EvaluatorServiceGetEvaluatorRecordArgs) Field1DeepEqual(src *GetEvaluat
 	return true
 }
 
-type EvaluatorServiceGetEvaluatorRecordResult struct {
-	Success *GetEvaluatorRecordResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvaluatorRecordResponse"`
+type EvaluatorServiceGetEvaluatorCalibrationRecordResult struct {
+	Success *GetEvaluatorCalibrationRecordResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvaluatorCalibrationRecordResponse"`
 }
 
-func NewEvaluatorServiceGetEvaluatorRecordResult() *EvaluatorServiceGetEvaluatorRecordResult {
-	return &EvaluatorServiceGetEvaluatorRecordResult{}
+func NewEvaluatorServiceGetEvaluatorCalibrationRecordResult() *EvaluatorServiceGetEvaluatorCalibrationRecordResult {
+	return &EvaluatorServiceGetEvaluatorCalibrationRecordResult{}
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) InitDefault() {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) InitDefault() {
 }
 
-var EvaluatorServiceGetEvaluatorRecordResult_Success_DEFAULT *GetEvaluatorRecordResponse
+var EvaluatorServiceGetEvaluatorCalibrationRecordResult_Success_DEFAULT *GetEvaluatorCalibrationRecordResponse
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) GetSuccess() (v *GetEvaluatorRecordResponse) {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) GetSuccess() (v *GetEvaluatorCalibrationRecordResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceGetEvaluatorRecordResult_Success_DEFAULT
+		return EvaluatorServiceGetEvaluatorCalibrationRecordResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceGetEvaluatorRecordResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetEvaluatorRecordResponse)
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetEvaluatorCalibrationRecordResponse)
 }
 
-var fieldIDToName_EvaluatorServiceGetEvaluatorRecordResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceGetEvaluatorCalibrationRecordResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -35301,7 +38653,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorRecordResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorCalibrationRecordResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -35311,8 +38663,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetEvaluatorRecordResponse()
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetEvaluatorCalibrationRecordResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -35320,9 +38672,9 @@ func (p *EvaluatorServiceGetEvaluatorRecordResult) ReadField0(iprot thrift.TProt
 	return nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvaluatorRecord_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvaluatorCalibrationRecord_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -35348,7 +38700,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -35367,15 +38719,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) String() string {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceGetEvaluatorRecordResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceGetEvaluatorCalibrationRecordResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) DeepEqual(ano *EvaluatorServiceGetEvaluatorRecordResult) bool {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) DeepEqual(ano *EvaluatorServiceGetEvaluatorCalibrationRecordResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -35387,7 +38739,7 @@ func (p *EvaluatorServiceGetEvaluatorRecordResult) DeepEqual(ano *EvaluatorServi
 	return true
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) Field0DeepEqual(src *GetEvaluatorRecordResponse) bool {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) Field0DeepEqual(src *GetEvaluatorCalibrationRecordResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -35395,41 +38747,41 @@ func (p *EvaluatorServiceGetEvaluatorRecordResult) Field0DeepEqual(src *GetEvalu
 	return true
 }
 
-type EvaluatorServiceBatchGetEvaluatorRecordsArgs struct {
-	Req *BatchGetEvaluatorRecordsRequest `thrift:"req,1" frugal:"1,default,BatchGetEvaluatorRecordsRequest"`
+type EvaluatorServiceListEvaluatorCalibrationRecordsArgs struct {
+	Req *ListEvaluatorCalibrationRecordsRequest `thrift:"req,1" frugal:"1,default,ListEvaluatorCalibrationRecordsRequest"`
 }
 
-func NewEvaluatorServiceBatchGetEvaluatorRecordsArgs() *EvaluatorServiceBatchGetEvaluatorRecordsArgs {
-	return &EvaluatorServiceBatchGetEvaluatorRecordsArgs{}
+func NewEvaluatorServiceListEvaluatorCalibrationRecordsArgs() *EvaluatorServiceListEvaluatorCalibrationRecordsArgs {
+	return &EvaluatorServiceListEvaluatorCalibrationRecordsArgs{}
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) InitDefault() {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) InitDefault() {
 }
 
-var EvaluatorServiceBatchGetEvaluatorRecordsArgs_Req_DEFAULT *BatchGetEvaluatorRecordsRequest
+var EvaluatorServiceListEvaluatorCalibrationRecordsArgs_Req_DEFAULT *ListEvaluatorCalibrationRecordsRequest
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) GetReq() (v *BatchGetEvaluatorRecordsRequest) {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) GetReq() (v *ListEvaluatorCalibrationRecordsRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluatorServiceBatchGetEvaluatorRecordsArgs_Req_DEFAULT
+		return EvaluatorServiceListEvaluatorCalibrationRecordsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) SetReq(val *BatchGetEvaluatorRecordsRequest) {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) SetReq(val *ListEvaluatorCalibrationRecordsRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluatorServiceBatchGetEvaluatorRecordsArgs = map[int16]string{
+var fieldIDToName_EvaluatorServiceListEvaluatorCalibrationRecordsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) IsSetReq() bool {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -35474,7 +38826,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorRecordsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorCalibrationRecordsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -35484,8 +38836,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewBatchGetEvaluatorRecordsRequest()
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListEvaluatorCalibrationRecordsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -35493,9 +38845,9 @@ func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) ReadField1(iprot thrift.T
 	return nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetEvaluatorRecords_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListEvaluatorCalibrationRecords_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -35521,7 +38873,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -35538,15 +38890,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) String() string {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceBatchGetEvaluatorRecordsArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceListEvaluatorCalibrationRecordsArgs(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) DeepEqual(ano *EvaluatorServiceBatchGetEvaluatorRecordsArgs) bool {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) DeepEqual(ano *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -35558,7 +38910,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) DeepEqual(ano *EvaluatorS
 	return true
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) Field1DeepEqual(src *BatchGetEvaluatorRecordsRequest) bool {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) Field1DeepEqual(src *ListEvaluatorCalibrationRecordsRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -35566,41 +38918,41 @@ func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) Field1DeepEqual(src *Batc
 	return true
 }
 
-type EvaluatorServiceBatchGetEvaluatorRecordsResult struct {
-	Success *BatchGetEvaluatorRecordsResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetEvaluatorRecordsResponse"`
+type EvaluatorServiceListEvaluatorCalibrationRecordsResult struct {
+	Success *ListEvaluatorCalibrationRecordsResponse `thrift:"success,0,optional" frugal:"0,optional,ListEvaluatorCalibrationRecordsResponse"`
 }
 
-func NewEvaluatorServiceBatchGetEvaluatorRecordsResult() *EvaluatorServiceBatchGetEvaluatorRecordsResult {
-	return &EvaluatorServiceBatchGetEvaluatorRecordsResult{}
+func NewEvaluatorServiceListEvaluatorCalibrationRecordsResult() *EvaluatorServiceListEvaluatorCalibrationRecordsResult {
+	return &EvaluatorServiceListEvaluatorCalibrationRecordsResult{}
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) InitDefault() {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) InitDefault() {
 }
 
-var EvaluatorServiceBatchGetEvaluatorRecordsResult_Success_DEFAULT *BatchGetEvaluatorRecordsResponse
+var EvaluatorServiceListEvaluatorCalibrationRecordsResult_Success_DEFAULT *ListEvaluatorCalibrationRecordsResponse
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) GetSuccess() (v *BatchGetEvaluatorRecordsResponse) {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) GetSuccess() (v *ListEvaluatorCalibrationRecordsResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluatorServiceBatchGetEvaluatorRecordsResult_Success_DEFAULT
+		return EvaluatorServiceListEvaluatorCalibrationRecordsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchGetEvaluatorRecordsResponse)
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListEvaluatorCalibrationRecordsResponse)
 }
 
-var fieldIDToName_EvaluatorServiceBatchGetEvaluatorRecordsResult = map[int16]string{
+var fieldIDToName_EvaluatorServiceListEvaluatorCalibrationRecordsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) IsSetSuccess() bool {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -35645,7 +38997,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorRecordsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorCalibrationRecordsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -35655,8 +39007,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewBatchGetEvaluatorRecordsResponse()
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListEvaluatorCalibrationRecordsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -35664,9 +39016,9 @@ func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) ReadField0(iprot thrift
 	return nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetEvaluatorRecords_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListEvaluatorCalibrationRecords_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -35692,7 +39044,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -35711,15 +39063,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) String() string {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorServiceBatchGetEvaluatorRecordsResult(%+v)", *p)
+	return fmt.Sprintf("EvaluatorServiceListEvaluatorCalibrationRecordsResult(%+v)", *p)
 
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) DeepEqual(ano *EvaluatorServiceBatchGetEvaluatorRecordsResult) bool {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) DeepEqual(ano *EvaluatorServiceListEvaluatorCalibrationRecordsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -35731,7 +39083,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) DeepEqual(ano *Evaluato
 	return true
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) Field0DeepEqual(src *BatchGetEvaluatorRecordsResponse) bool {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) Field0DeepEqual(src *ListEvaluatorCalibrationRecordsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
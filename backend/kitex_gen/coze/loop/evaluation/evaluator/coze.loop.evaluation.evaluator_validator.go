@@ -256,6 +256,11 @@ func (p *BatchGetEvaluatorVersionIDsResponse) IsValid() error {
 	return nil
 }
 func (p *SubmitEvaluatorVersionRequest) IsValid() error {
+	if p.PromotionCheck != nil {
+		if err := p.PromotionCheck.IsValid(); err != nil {
+			return fmt.Errorf("field PromotionCheck not valid, %w", err)
+		}
+	}
 	if p.Base != nil {
 		if err := p.Base.IsValid(); err != nil {
 			return fmt.Errorf("field Base not valid, %w", err)
@@ -269,6 +274,11 @@ func (p *SubmitEvaluatorVersionResponse) IsValid() error {
 			return fmt.Errorf("field Evaluator not valid, %w", err)
 		}
 	}
+	if p.PromotionReport != nil {
+		if err := p.PromotionReport.IsValid(); err != nil {
+			return fmt.Errorf("field PromotionReport not valid, %w", err)
+		}
+	}
 	if p.BaseResp != nil {
 		if err := p.BaseResp.IsValid(); err != nil {
 			return fmt.Errorf("field BaseResp not valid, %w", err)
@@ -812,3 +822,66 @@ func (p *ListEvaluatorTagsResponse) IsValid() error {
 	}
 	return nil
 }
+func (p *RunEvaluatorCalibrationRequest) IsValid() error {
+	if p.Conf != nil {
+		if err := p.Conf.IsValid(); err != nil {
+			return fmt.Errorf("field Conf not valid, %w", err)
+		}
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *RunEvaluatorCalibrationResponse) IsValid() error {
+	if p.Record != nil {
+		if err := p.Record.IsValid(); err != nil {
+			return fmt.Errorf("field Record not valid, %w", err)
+		}
+	}
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GetEvaluatorCalibrationRecordRequest) IsValid() error {
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GetEvaluatorCalibrationRecordResponse) IsValid() error {
+	if p.Record != nil {
+		if err := p.Record.IsValid(); err != nil {
+			return fmt.Errorf("field Record not valid, %w", err)
+		}
+	}
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ListEvaluatorCalibrationRecordsRequest) IsValid() error {
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ListEvaluatorCalibrationRecordsResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
@@ -6516,6 +6516,20 @@ func (p *SubmitEvaluatorVersionRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 100:
 			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField100(buf[offset:])
@@ -6634,6 +6648,18 @@ func (p *SubmitEvaluatorVersionRequest) FastReadField4(buf []byte) (int, error)
 	return offset, nil
 }
 
+func (p *SubmitEvaluatorVersionRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+	_field := evaluator.NewEvaluatorPromotionCheckConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.PromotionCheck = _field
+	return offset, nil
+}
+
 func (p *SubmitEvaluatorVersionRequest) FastReadField100(buf []byte) (int, error) {
 	offset := 0
 
@@ -6671,6 +6697,7 @@ func (p *SubmitEvaluatorVersionRequest) FastWriteNocopy(buf []byte, w thrift.Noc
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 		offset += p.fastWriteField100(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
@@ -6685,6 +6712,7 @@ func (p *SubmitEvaluatorVersionRequest) BLength() int {
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
+		l += p.field5Length()
 		l += p.field100Length()
 		l += p.field255Length()
 	}
@@ -6722,6 +6750,15 @@ func (p *SubmitEvaluatorVersionRequest) fastWriteField4(buf []byte, w thrift.Noc
 	return offset
 }
 
+func (p *SubmitEvaluatorVersionRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPromotionCheck() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 5)
+		offset += p.PromotionCheck.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *SubmitEvaluatorVersionRequest) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetCid() {
@@ -6770,6 +6807,15 @@ func (p *SubmitEvaluatorVersionRequest) field4Length() int {
 	return l
 }
 
+func (p *SubmitEvaluatorVersionRequest) field5Length() int {
+	l := 0
+	if p.IsSetPromotionCheck() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.PromotionCheck.BLength()
+	}
+	return l
+}
+
 func (p *SubmitEvaluatorVersionRequest) field100Length() int {
 	l := 0
 	if p.IsSetCid() {
@@ -6810,6 +6856,15 @@ func (p *SubmitEvaluatorVersionRequest) DeepCopy(s interface{}) error {
 		p.Description = &tmp
 	}
 
+	var _promotionCheck *evaluator.EvaluatorPromotionCheckConf
+	if src.PromotionCheck != nil {
+		_promotionCheck = &evaluator.EvaluatorPromotionCheckConf{}
+		if err := _promotionCheck.DeepCopy(src.PromotionCheck); err != nil {
+			return err
+		}
+	}
+	p.PromotionCheck = _promotionCheck
+
 	if src.Cid != nil {
 		var tmp string
 		if *src.Cid != "" {
@@ -6861,6 +6916,20 @@ func (p *SubmitEvaluatorVersionResponse) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -6905,6 +6974,18 @@ func (p *SubmitEvaluatorVersionResponse) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
+func (p *SubmitEvaluatorVersionResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := evaluator.NewEvaluatorPromotionReport()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.PromotionReport = _field
+	return offset, nil
+}
+
 func (p *SubmitEvaluatorVersionResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
@@ -6925,6 +7006,7 @@ func (p *SubmitEvaluatorVersionResponse) FastWriteNocopy(buf []byte, w thrift.No
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
@@ -6935,6 +7017,7 @@ func (p *SubmitEvaluatorVersionResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
+		l += p.field2Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
@@ -6950,6 +7033,15 @@ func (p *SubmitEvaluatorVersionResponse) fastWriteField1(buf []byte, w thrift.No
 	return offset
 }
 
+func (p *SubmitEvaluatorVersionResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPromotionReport() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+		offset += p.PromotionReport.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *SubmitEvaluatorVersionResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -6966,6 +7058,15 @@ func (p *SubmitEvaluatorVersionResponse) field1Length() int {
 	return l
 }
 
+func (p *SubmitEvaluatorVersionResponse) field2Length() int {
+	l := 0
+	if p.IsSetPromotionReport() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.PromotionReport.BLength()
+	}
+	return l
+}
+
 func (p *SubmitEvaluatorVersionResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
@@ -6988,6 +7089,15 @@ func (p *SubmitEvaluatorVersionResponse) DeepCopy(s interface{}) error {
 	}
 	p.Evaluator = _evaluator
 
+	var _promotionReport *evaluator.EvaluatorPromotionReport
+	if src.PromotionReport != nil {
+		_promotionReport = &evaluator.EvaluatorPromotionReport{}
+		if err := _promotionReport.DeepCopy(src.PromotionReport); err != nil {
+			return err
+		}
+	}
+	p.PromotionReport = _promotionReport
+
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
 		_baseResp = &base.BaseResp{}
@@ -18537,13 +18647,17 @@ func (p *ListEvaluatorTagsResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceListEvaluatorsArgs) FastRead(buf []byte) (int, error) {
+func (p *RunEvaluatorCalibrationRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluatorVersionID bool = false
+	var issetEvaluationSetID bool = false
+	var issetConf bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -18555,12 +18669,86 @@ func (p *EvaluatorServiceListEvaluatorsArgs) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluatorVersionID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluationSetID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetConf = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -18577,72 +18765,2154 @@ func (p *EvaluatorServiceListEvaluatorsArgs) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluatorVersionID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluationSetID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetConf {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RunEvaluatorCalibrationRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_RunEvaluatorCalibrationRequest[fieldId]))
 }
 
-func (p *EvaluatorServiceListEvaluatorsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *RunEvaluatorCalibrationRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListEvaluatorsRequest()
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *RunEvaluatorCalibrationRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EvaluatorVersionID = _field
+	return offset, nil
+}
+
+func (p *RunEvaluatorCalibrationRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EvaluationSetID = _field
+	return offset, nil
+}
+
+func (p *RunEvaluatorCalibrationRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.EvaluationSetVersionID = _field
+	return offset, nil
+}
+
+func (p *RunEvaluatorCalibrationRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+	_field := evaluator.NewEvaluatorCalibrationConf()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Request = _field
+	p.Conf = _field
 	return offset, nil
 }
 
-func (p *EvaluatorServiceListEvaluatorsArgs) FastWrite(buf []byte) int {
+func (p *RunEvaluatorCalibrationRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *RunEvaluatorCalibrationRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceListEvaluatorsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *RunEvaluatorCalibrationRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvaluatorServiceListEvaluatorsArgs) BLength() int {
+func (p *RunEvaluatorCalibrationRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvaluatorServiceListEvaluatorsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *RunEvaluatorCalibrationRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-	offset += p.Request.FastWriteNocopy(buf[offset:], w)
-	return offset
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *RunEvaluatorCalibrationRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorVersionID)
+	return offset
+}
+
+func (p *RunEvaluatorCalibrationRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluationSetID)
+	return offset
+}
+
+func (p *RunEvaluatorCalibrationRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluationSetVersionID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.EvaluationSetVersionID)
+	}
+	return offset
+}
+
+func (p *RunEvaluatorCalibrationRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 5)
+	offset += p.Conf.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *RunEvaluatorCalibrationRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *RunEvaluatorCalibrationRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *RunEvaluatorCalibrationRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *RunEvaluatorCalibrationRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *RunEvaluatorCalibrationRequest) field4Length() int {
+	l := 0
+	if p.IsSetEvaluationSetVersionID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *RunEvaluatorCalibrationRequest) field5Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Conf.BLength()
+	return l
+}
+
+func (p *RunEvaluatorCalibrationRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *RunEvaluatorCalibrationRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*RunEvaluatorCalibrationRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.EvaluatorVersionID = src.EvaluatorVersionID
+
+	p.EvaluationSetID = src.EvaluationSetID
+
+	if src.EvaluationSetVersionID != nil {
+		tmp := *src.EvaluationSetVersionID
+		p.EvaluationSetVersionID = &tmp
+	}
+
+	var _conf *evaluator.EvaluatorCalibrationConf
+	if src.Conf != nil {
+		_conf = &evaluator.EvaluatorCalibrationConf{}
+		if err := _conf.DeepCopy(src.Conf); err != nil {
+			return err
+		}
+	}
+	p.Conf = _conf
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *RunEvaluatorCalibrationResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RunEvaluatorCalibrationResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *RunEvaluatorCalibrationResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := evaluator.NewEvaluatorCalibrationRecord()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Record = _field
+	return offset, nil
+}
+
+func (p *RunEvaluatorCalibrationResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *RunEvaluatorCalibrationResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *RunEvaluatorCalibrationResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *RunEvaluatorCalibrationResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *RunEvaluatorCalibrationResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetRecord() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Record.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *RunEvaluatorCalibrationResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *RunEvaluatorCalibrationResponse) field1Length() int {
+	l := 0
+	if p.IsSetRecord() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Record.BLength()
+	}
+	return l
+}
+
+func (p *RunEvaluatorCalibrationResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *RunEvaluatorCalibrationResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*RunEvaluatorCalibrationResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _record *evaluator.EvaluatorCalibrationRecord
+	if src.Record != nil {
+		_record = &evaluator.EvaluatorCalibrationRecord{}
+		if err := _record.DeepCopy(src.Record); err != nil {
+			return err
+		}
+	}
+	p.Record = _record
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetRecordID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetRecordID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRecordID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluatorCalibrationRecordRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetEvaluatorCalibrationRecordRequest[fieldId]))
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.RecordID = _field
+	return offset, nil
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.RecordID)
+	return offset
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *GetEvaluatorCalibrationRecordRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetEvaluatorCalibrationRecordRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.RecordID = src.RecordID
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetEvaluatorCalibrationRecordResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := evaluator.NewEvaluatorCalibrationRecord()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Record = _field
+	return offset, nil
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetRecord() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Record.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) field1Length() int {
+	l := 0
+	if p.IsSetRecord() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Record.BLength()
+	}
+	return l
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *GetEvaluatorCalibrationRecordResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetEvaluatorCalibrationRecordResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _record *evaluator.EvaluatorCalibrationRecord
+	if src.Record != nil {
+		_record = &evaluator.EvaluatorCalibrationRecord{}
+		if err := _record.DeepCopy(src.Record); err != nil {
+			return err
+		}
+	}
+	p.Record = _record
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetEvaluatorID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluatorID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluatorID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListEvaluatorCalibrationRecordsRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListEvaluatorCalibrationRecordsRequest[fieldId]))
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EvaluatorID = _field
+	return offset, nil
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.EvaluatorVersionIds = _field
+	return offset, nil
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorID)
+	return offset
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorVersionIds() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.EvaluatorVersionIds {
+			length++
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+	}
+	return offset
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) field3Length() int {
+	l := 0
+	if p.IsSetEvaluatorVersionIds() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		l +=
+			thrift.Binary.I64Length() * len(p.EvaluatorVersionIds)
+	}
+	return l
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *ListEvaluatorCalibrationRecordsRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListEvaluatorCalibrationRecordsRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.EvaluatorID = src.EvaluatorID
+
+	if src.EvaluatorVersionIds != nil {
+		p.EvaluatorVersionIds = make([]int64, 0, len(src.EvaluatorVersionIds))
+		for _, elem := range src.EvaluatorVersionIds {
+			var _elem int64
+			_elem = elem
+			p.EvaluatorVersionIds = append(p.EvaluatorVersionIds, _elem)
+		}
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListEvaluatorCalibrationRecordsResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*evaluator.EvaluatorCalibrationRecord, 0, size)
+	values := make([]evaluator.EvaluatorCalibrationRecord, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Records = _field
+	return offset, nil
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetRecords() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Records {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) field1Length() int {
+	l := 0
+	if p.IsSetRecords() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Records {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *ListEvaluatorCalibrationRecordsResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListEvaluatorCalibrationRecordsResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Records != nil {
+		p.Records = make([]*evaluator.EvaluatorCalibrationRecord, 0, len(src.Records))
+		for _, elem := range src.Records {
+			var _elem *evaluator.EvaluatorCalibrationRecord
+			if elem != nil {
+				_elem = &evaluator.EvaluatorCalibrationRecord{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Records = append(p.Records, _elem)
+		}
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *EvaluatorServiceListEvaluatorsArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorsArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorServiceListEvaluatorsArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewListEvaluatorsRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Request = _field
+	return offset, nil
+}
+
+func (p *EvaluatorServiceListEvaluatorsArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorServiceListEvaluatorsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorServiceListEvaluatorsArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorServiceListEvaluatorsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Request.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *EvaluatorServiceListEvaluatorsArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Request.BLength()
+	return l
+}
+
+func (p *EvaluatorServiceListEvaluatorsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceListEvaluatorsArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _request *ListEvaluatorsRequest
+	if src.Request != nil {
+		_request = &ListEvaluatorsRequest{}
+		if err := _request.DeepCopy(src.Request); err != nil {
+			return err
+		}
+	}
+	p.Request = _request
+
+	return nil
+}
+
+func (p *EvaluatorServiceListEvaluatorsResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorsResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorServiceListEvaluatorsResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewListEvaluatorsResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *EvaluatorServiceListEvaluatorsResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorServiceListEvaluatorsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorServiceListEvaluatorsResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorServiceListEvaluatorsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorServiceListEvaluatorsResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorServiceListEvaluatorsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceListEvaluatorsResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *ListEvaluatorsResponse
+	if src.Success != nil {
+		_success = &ListEvaluatorsResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorsArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewBatchGetEvaluatorsRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Request = _field
+	return offset, nil
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Request.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Request.BLength()
+	return l
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceBatchGetEvaluatorsArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _request *BatchGetEvaluatorsRequest
+	if src.Request != nil {
+		_request = &BatchGetEvaluatorsRequest{}
+		if err := _request.DeepCopy(src.Request); err != nil {
+			return err
+		}
+	}
+	p.Request = _request
+
+	return nil
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorsResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewBatchGetEvaluatorsResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorServiceBatchGetEvaluatorsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceBatchGetEvaluatorsResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *BatchGetEvaluatorsResponse
+	if src.Success != nil {
+		_success = &BatchGetEvaluatorsResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *EvaluatorServiceGetEvaluatorArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorServiceGetEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetEvaluatorRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Request = _field
+	return offset, nil
+}
+
+func (p *EvaluatorServiceGetEvaluatorArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorServiceGetEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorServiceGetEvaluatorArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorServiceGetEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Request.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *EvaluatorServiceGetEvaluatorArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Request.BLength()
+	return l
+}
+
+func (p *EvaluatorServiceGetEvaluatorArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceGetEvaluatorArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _request *GetEvaluatorRequest
+	if src.Request != nil {
+		_request = &GetEvaluatorRequest{}
+		if err := _request.DeepCopy(src.Request); err != nil {
+			return err
+		}
+	}
+	p.Request = _request
+
+	return nil
+}
+
+func (p *EvaluatorServiceGetEvaluatorResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvaluatorServiceGetEvaluatorResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetEvaluatorResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *EvaluatorServiceGetEvaluatorResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorServiceGetEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorServiceGetEvaluatorResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorServiceGetEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *EvaluatorServiceGetEvaluatorResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorServiceGetEvaluatorResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceGetEvaluatorResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *GetEvaluatorResponse
+	if src.Success != nil {
+		_success = &GetEvaluatorResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *EvaluatorServiceCreateEvaluatorArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceCreateEvaluatorArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceListEvaluatorsArgs) field1Length() int {
+func (p *EvaluatorServiceCreateEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewCreateEvaluatorRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Request = _field
+	return offset, nil
+}
+
+func (p *EvaluatorServiceCreateEvaluatorArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvaluatorServiceCreateEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvaluatorServiceCreateEvaluatorArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvaluatorServiceCreateEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Request.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *EvaluatorServiceCreateEvaluatorArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceListEvaluatorsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceListEvaluatorsArgs)
+func (p *EvaluatorServiceCreateEvaluatorArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceCreateEvaluatorArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *ListEvaluatorsRequest
+	var _request *CreateEvaluatorRequest
 	if src.Request != nil {
-		_request = &ListEvaluatorsRequest{}
+		_request = &CreateEvaluatorRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -18652,7 +20922,7 @@ func (p *EvaluatorServiceListEvaluatorsArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceListEvaluatorsResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceCreateEvaluatorResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18696,14 +20966,14 @@ func (p *EvaluatorServiceListEvaluatorsResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceCreateEvaluatorResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceListEvaluatorsResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceCreateEvaluatorResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListEvaluatorsResponse()
+	_field := NewCreateEvaluatorResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18713,11 +20983,11 @@ func (p *EvaluatorServiceListEvaluatorsResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *EvaluatorServiceListEvaluatorsResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceCreateEvaluatorResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceListEvaluatorsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceCreateEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -18726,7 +20996,7 @@ func (p *EvaluatorServiceListEvaluatorsResult) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *EvaluatorServiceListEvaluatorsResult) BLength() int {
+func (p *EvaluatorServiceCreateEvaluatorResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -18735,7 +21005,7 @@ func (p *EvaluatorServiceListEvaluatorsResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceListEvaluatorsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceCreateEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -18744,7 +21014,7 @@ func (p *EvaluatorServiceListEvaluatorsResult) fastWriteField0(buf []byte, w thr
 	return offset
 }
 
-func (p *EvaluatorServiceListEvaluatorsResult) field0Length() int {
+func (p *EvaluatorServiceCreateEvaluatorResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18753,15 +21023,15 @@ func (p *EvaluatorServiceListEvaluatorsResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceListEvaluatorsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceListEvaluatorsResult)
+func (p *EvaluatorServiceCreateEvaluatorResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceCreateEvaluatorResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListEvaluatorsResponse
+	var _success *CreateEvaluatorResponse
 	if src.Success != nil {
-		_success = &ListEvaluatorsResponse{}
+		_success = &CreateEvaluatorResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -18771,7 +21041,7 @@ func (p *EvaluatorServiceListEvaluatorsResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceUpdateEvaluatorArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18815,14 +21085,14 @@ func (p *EvaluatorServiceBatchGetEvaluatorsArgs) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceUpdateEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetEvaluatorsRequest()
+	_field := NewUpdateEvaluatorRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18832,11 +21102,11 @@ func (p *EvaluatorServiceBatchGetEvaluatorsArgs) FastReadField1(buf []byte) (int
 	return offset, nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceUpdateEvaluatorArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceUpdateEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -18845,7 +21115,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorsArgs) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) BLength() int {
+func (p *EvaluatorServiceUpdateEvaluatorArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -18854,29 +21124,29 @@ func (p *EvaluatorServiceBatchGetEvaluatorsArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceUpdateEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) field1Length() int {
+func (p *EvaluatorServiceUpdateEvaluatorArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceBatchGetEvaluatorsArgs)
+func (p *EvaluatorServiceUpdateEvaluatorArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceUpdateEvaluatorArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *BatchGetEvaluatorsRequest
+	var _request *UpdateEvaluatorRequest
 	if src.Request != nil {
-		_request = &BatchGetEvaluatorsRequest{}
+		_request = &UpdateEvaluatorRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -18886,7 +21156,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorsArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceUpdateEvaluatorResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18930,14 +21200,14 @@ func (p *EvaluatorServiceBatchGetEvaluatorsResult) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceUpdateEvaluatorResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetEvaluatorsResponse()
+	_field := NewUpdateEvaluatorResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18947,11 +21217,11 @@ func (p *EvaluatorServiceBatchGetEvaluatorsResult) FastReadField0(buf []byte) (i
 	return offset, nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceUpdateEvaluatorResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceUpdateEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -18960,7 +21230,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorsResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) BLength() int {
+func (p *EvaluatorServiceUpdateEvaluatorResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -18969,7 +21239,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorsResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceUpdateEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -18978,7 +21248,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorsResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) field0Length() int {
+func (p *EvaluatorServiceUpdateEvaluatorResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18987,15 +21257,15 @@ func (p *EvaluatorServiceBatchGetEvaluatorsResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceBatchGetEvaluatorsResult)
+func (p *EvaluatorServiceUpdateEvaluatorResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceUpdateEvaluatorResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchGetEvaluatorsResponse
+	var _success *UpdateEvaluatorResponse
 	if src.Success != nil {
-		_success = &BatchGetEvaluatorsResponse{}
+		_success = &UpdateEvaluatorResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -19005,7 +21275,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorsResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19049,14 +21319,14 @@ func (p *EvaluatorServiceGetEvaluatorArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorDraftArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetEvaluatorRequest()
+	_field := NewUpdateEvaluatorDraftRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19066,11 +21336,11 @@ func (p *EvaluatorServiceGetEvaluatorArgs) FastReadField1(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceGetEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -19079,7 +21349,7 @@ func (p *EvaluatorServiceGetEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *EvaluatorServiceGetEvaluatorArgs) BLength() int {
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -19088,29 +21358,29 @@ func (p *EvaluatorServiceGetEvaluatorArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceGetEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceGetEvaluatorArgs) field1Length() int {
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceGetEvaluatorArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceGetEvaluatorArgs)
+func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceUpdateEvaluatorDraftArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *GetEvaluatorRequest
+	var _request *UpdateEvaluatorDraftRequest
 	if src.Request != nil {
-		_request = &GetEvaluatorRequest{}
+		_request = &UpdateEvaluatorDraftRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -19120,7 +21390,7 @@ func (p *EvaluatorServiceGetEvaluatorArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19164,14 +21434,14 @@ func (p *EvaluatorServiceGetEvaluatorResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorDraftResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetEvaluatorResponse()
+	_field := NewUpdateEvaluatorDraftResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19181,11 +21451,11 @@ func (p *EvaluatorServiceGetEvaluatorResult) FastReadField0(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceGetEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19194,7 +21464,7 @@ func (p *EvaluatorServiceGetEvaluatorResult) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *EvaluatorServiceGetEvaluatorResult) BLength() int {
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19203,7 +21473,7 @@ func (p *EvaluatorServiceGetEvaluatorResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceGetEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19212,7 +21482,7 @@ func (p *EvaluatorServiceGetEvaluatorResult) fastWriteField0(buf []byte, w thrif
 	return offset
 }
 
-func (p *EvaluatorServiceGetEvaluatorResult) field0Length() int {
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19221,15 +21491,15 @@ func (p *EvaluatorServiceGetEvaluatorResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceGetEvaluatorResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceGetEvaluatorResult)
+func (p *EvaluatorServiceUpdateEvaluatorDraftResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceUpdateEvaluatorDraftResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetEvaluatorResponse
+	var _success *UpdateEvaluatorDraftResponse
 	if src.Success != nil {
-		_success = &GetEvaluatorResponse{}
+		_success = &UpdateEvaluatorDraftResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -19239,7 +21509,7 @@ func (p *EvaluatorServiceGetEvaluatorResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceCreateEvaluatorArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceDeleteEvaluatorArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19283,14 +21553,14 @@ func (p *EvaluatorServiceCreateEvaluatorArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceCreateEvaluatorArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceDeleteEvaluatorArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceCreateEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceDeleteEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateEvaluatorRequest()
+	_field := NewDeleteEvaluatorRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19300,11 +21570,11 @@ func (p *EvaluatorServiceCreateEvaluatorArgs) FastReadField1(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *EvaluatorServiceCreateEvaluatorArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceDeleteEvaluatorArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceCreateEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceDeleteEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -19313,7 +21583,7 @@ func (p *EvaluatorServiceCreateEvaluatorArgs) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *EvaluatorServiceCreateEvaluatorArgs) BLength() int {
+func (p *EvaluatorServiceDeleteEvaluatorArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -19322,29 +21592,29 @@ func (p *EvaluatorServiceCreateEvaluatorArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceCreateEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceDeleteEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceCreateEvaluatorArgs) field1Length() int {
+func (p *EvaluatorServiceDeleteEvaluatorArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceCreateEvaluatorArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceCreateEvaluatorArgs)
+func (p *EvaluatorServiceDeleteEvaluatorArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceDeleteEvaluatorArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *CreateEvaluatorRequest
+	var _request *DeleteEvaluatorRequest
 	if src.Request != nil {
-		_request = &CreateEvaluatorRequest{}
+		_request = &DeleteEvaluatorRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -19354,7 +21624,7 @@ func (p *EvaluatorServiceCreateEvaluatorArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceCreateEvaluatorResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceDeleteEvaluatorResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19398,14 +21668,14 @@ func (p *EvaluatorServiceCreateEvaluatorResult) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceCreateEvaluatorResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceDeleteEvaluatorResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceCreateEvaluatorResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceDeleteEvaluatorResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateEvaluatorResponse()
+	_field := NewDeleteEvaluatorResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19415,11 +21685,11 @@ func (p *EvaluatorServiceCreateEvaluatorResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *EvaluatorServiceCreateEvaluatorResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceDeleteEvaluatorResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceCreateEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceDeleteEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19428,7 +21698,7 @@ func (p *EvaluatorServiceCreateEvaluatorResult) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *EvaluatorServiceCreateEvaluatorResult) BLength() int {
+func (p *EvaluatorServiceDeleteEvaluatorResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19437,7 +21707,7 @@ func (p *EvaluatorServiceCreateEvaluatorResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceCreateEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceDeleteEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19446,7 +21716,7 @@ func (p *EvaluatorServiceCreateEvaluatorResult) fastWriteField0(buf []byte, w th
 	return offset
 }
 
-func (p *EvaluatorServiceCreateEvaluatorResult) field0Length() int {
+func (p *EvaluatorServiceDeleteEvaluatorResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19455,15 +21725,15 @@ func (p *EvaluatorServiceCreateEvaluatorResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceCreateEvaluatorResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceCreateEvaluatorResult)
+func (p *EvaluatorServiceDeleteEvaluatorResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceDeleteEvaluatorResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CreateEvaluatorResponse
+	var _success *DeleteEvaluatorResponse
 	if src.Success != nil {
-		_success = &CreateEvaluatorResponse{}
+		_success = &DeleteEvaluatorResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -19473,7 +21743,7 @@ func (p *EvaluatorServiceCreateEvaluatorResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19517,14 +21787,14 @@ func (p *EvaluatorServiceUpdateEvaluatorArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceCheckEvaluatorNameArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateEvaluatorRequest()
+	_field := NewCheckEvaluatorNameRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19534,11 +21804,11 @@ func (p *EvaluatorServiceUpdateEvaluatorArgs) FastReadField1(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -19547,7 +21817,7 @@ func (p *EvaluatorServiceUpdateEvaluatorArgs) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) BLength() int {
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -19556,29 +21826,29 @@ func (p *EvaluatorServiceUpdateEvaluatorArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) field1Length() int {
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceUpdateEvaluatorArgs)
+func (p *EvaluatorServiceCheckEvaluatorNameArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceCheckEvaluatorNameArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *UpdateEvaluatorRequest
+	var _request *CheckEvaluatorNameRequest
 	if src.Request != nil {
-		_request = &UpdateEvaluatorRequest{}
+		_request = &CheckEvaluatorNameRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -19588,7 +21858,7 @@ func (p *EvaluatorServiceUpdateEvaluatorArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceCheckEvaluatorNameResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19632,14 +21902,14 @@ func (p *EvaluatorServiceUpdateEvaluatorResult) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceCheckEvaluatorNameResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceCheckEvaluatorNameResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateEvaluatorResponse()
+	_field := NewCheckEvaluatorNameResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19649,11 +21919,11 @@ func (p *EvaluatorServiceUpdateEvaluatorResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceCheckEvaluatorNameResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceCheckEvaluatorNameResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19662,7 +21932,7 @@ func (p *EvaluatorServiceUpdateEvaluatorResult) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) BLength() int {
+func (p *EvaluatorServiceCheckEvaluatorNameResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19671,7 +21941,7 @@ func (p *EvaluatorServiceUpdateEvaluatorResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceCheckEvaluatorNameResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19680,7 +21950,7 @@ func (p *EvaluatorServiceUpdateEvaluatorResult) fastWriteField0(buf []byte, w th
 	return offset
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) field0Length() int {
+func (p *EvaluatorServiceCheckEvaluatorNameResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19689,15 +21959,15 @@ func (p *EvaluatorServiceUpdateEvaluatorResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceUpdateEvaluatorResult)
+func (p *EvaluatorServiceCheckEvaluatorNameResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceCheckEvaluatorNameResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpdateEvaluatorResponse
+	var _success *CheckEvaluatorNameResponse
 	if src.Success != nil {
-		_success = &UpdateEvaluatorResponse{}
+		_success = &CheckEvaluatorNameResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -19707,7 +21977,7 @@ func (p *EvaluatorServiceUpdateEvaluatorResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19751,14 +22021,14 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorDraftArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorVersionsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateEvaluatorDraftRequest()
+	_field := NewListEvaluatorVersionsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19768,11 +22038,11 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) FastReadField1(buf []byte) (i
 	return offset, nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -19781,7 +22051,7 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) BLength() int {
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -19790,29 +22060,29 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) field1Length() int {
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceUpdateEvaluatorDraftArgs)
+func (p *EvaluatorServiceListEvaluatorVersionsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceListEvaluatorVersionsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *UpdateEvaluatorDraftRequest
+	var _request *ListEvaluatorVersionsRequest
 	if src.Request != nil {
-		_request = &UpdateEvaluatorDraftRequest{}
+		_request = &ListEvaluatorVersionsRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -19822,7 +22092,7 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftArgs) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceListEvaluatorVersionsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19866,14 +22136,14 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorDraftResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorVersionsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceListEvaluatorVersionsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateEvaluatorDraftResponse()
+	_field := NewListEvaluatorVersionsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19883,11 +22153,11 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceListEvaluatorVersionsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceListEvaluatorVersionsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19896,7 +22166,7 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftResult) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) BLength() int {
+func (p *EvaluatorServiceListEvaluatorVersionsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19905,7 +22175,7 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceListEvaluatorVersionsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19914,7 +22184,7 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftResult) fastWriteField0(buf []byte,
 	return offset
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) field0Length() int {
+func (p *EvaluatorServiceListEvaluatorVersionsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19923,15 +22193,15 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorDraftResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceUpdateEvaluatorDraftResult)
+func (p *EvaluatorServiceListEvaluatorVersionsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceListEvaluatorVersionsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpdateEvaluatorDraftResponse
+	var _success *ListEvaluatorVersionsResponse
 	if src.Success != nil {
-		_success = &UpdateEvaluatorDraftResponse{}
+		_success = &ListEvaluatorVersionsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -19941,7 +22211,7 @@ func (p *EvaluatorServiceUpdateEvaluatorDraftResult) DeepCopy(s interface{}) err
 	return nil
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19985,14 +22255,14 @@ func (p *EvaluatorServiceDeleteEvaluatorArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceDeleteEvaluatorArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorVersionArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteEvaluatorRequest()
+	_field := NewGetEvaluatorVersionRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20002,11 +22272,11 @@ func (p *EvaluatorServiceDeleteEvaluatorArgs) FastReadField1(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20015,7 +22285,7 @@ func (p *EvaluatorServiceDeleteEvaluatorArgs) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) BLength() int {
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20024,29 +22294,29 @@ func (p *EvaluatorServiceDeleteEvaluatorArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) field1Length() int {
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceDeleteEvaluatorArgs)
+func (p *EvaluatorServiceGetEvaluatorVersionArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceGetEvaluatorVersionArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *DeleteEvaluatorRequest
+	var _request *GetEvaluatorVersionRequest
 	if src.Request != nil {
-		_request = &DeleteEvaluatorRequest{}
+		_request = &GetEvaluatorVersionRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -20056,7 +22326,7 @@ func (p *EvaluatorServiceDeleteEvaluatorArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetEvaluatorVersionResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20100,14 +22370,14 @@ func (p *EvaluatorServiceDeleteEvaluatorResult) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceDeleteEvaluatorResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorVersionResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetEvaluatorVersionResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteEvaluatorResponse()
+	_field := NewGetEvaluatorVersionResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20117,11 +22387,11 @@ func (p *EvaluatorServiceDeleteEvaluatorResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceGetEvaluatorVersionResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetEvaluatorVersionResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20130,7 +22400,7 @@ func (p *EvaluatorServiceDeleteEvaluatorResult) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) BLength() int {
+func (p *EvaluatorServiceGetEvaluatorVersionResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20139,7 +22409,7 @@ func (p *EvaluatorServiceDeleteEvaluatorResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetEvaluatorVersionResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20148,7 +22418,7 @@ func (p *EvaluatorServiceDeleteEvaluatorResult) fastWriteField0(buf []byte, w th
 	return offset
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) field0Length() int {
+func (p *EvaluatorServiceGetEvaluatorVersionResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20157,15 +22427,15 @@ func (p *EvaluatorServiceDeleteEvaluatorResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceDeleteEvaluatorResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceDeleteEvaluatorResult)
+func (p *EvaluatorServiceGetEvaluatorVersionResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceGetEvaluatorVersionResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *DeleteEvaluatorResponse
+	var _success *GetEvaluatorVersionResponse
 	if src.Success != nil {
-		_success = &DeleteEvaluatorResponse{}
+		_success = &GetEvaluatorVersionResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -20175,7 +22445,7 @@ func (p *EvaluatorServiceDeleteEvaluatorResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20219,14 +22489,14 @@ func (p *EvaluatorServiceCheckEvaluatorNameArgs) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceCheckEvaluatorNameArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCheckEvaluatorNameRequest()
+	_field := NewBatchGetEvaluatorVersionsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20236,11 +22506,11 @@ func (p *EvaluatorServiceCheckEvaluatorNameArgs) FastReadField1(buf []byte) (int
 	return offset, nil
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20249,7 +22519,7 @@ func (p *EvaluatorServiceCheckEvaluatorNameArgs) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) BLength() int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20258,29 +22528,29 @@ func (p *EvaluatorServiceCheckEvaluatorNameArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) field1Length() int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceCheckEvaluatorNameArgs)
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceBatchGetEvaluatorVersionsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *CheckEvaluatorNameRequest
+	var _request *BatchGetEvaluatorVersionsRequest
 	if src.Request != nil {
-		_request = &CheckEvaluatorNameRequest{}
+		_request = &BatchGetEvaluatorVersionsRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -20290,7 +22560,7 @@ func (p *EvaluatorServiceCheckEvaluatorNameArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20334,14 +22604,14 @@ func (p *EvaluatorServiceCheckEvaluatorNameResult) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceCheckEvaluatorNameResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCheckEvaluatorNameResponse()
+	_field := NewBatchGetEvaluatorVersionsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20351,11 +22621,11 @@ func (p *EvaluatorServiceCheckEvaluatorNameResult) FastReadField0(buf []byte) (i
 	return offset, nil
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20364,7 +22634,7 @@ func (p *EvaluatorServiceCheckEvaluatorNameResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) BLength() int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20373,7 +22643,7 @@ func (p *EvaluatorServiceCheckEvaluatorNameResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20382,7 +22652,7 @@ func (p *EvaluatorServiceCheckEvaluatorNameResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) field0Length() int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20391,15 +22661,15 @@ func (p *EvaluatorServiceCheckEvaluatorNameResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceCheckEvaluatorNameResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceCheckEvaluatorNameResult)
+func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceBatchGetEvaluatorVersionsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CheckEvaluatorNameResponse
+	var _success *BatchGetEvaluatorVersionsResponse
 	if src.Success != nil {
-		_success = &CheckEvaluatorNameResponse{}
+		_success = &BatchGetEvaluatorVersionsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -20409,7 +22679,7 @@ func (p *EvaluatorServiceCheckEvaluatorNameResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20453,14 +22723,14 @@ func (p *EvaluatorServiceListEvaluatorVersionsArgs) FastRead(buf []byte) (int, e
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorVersionsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionIDsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListEvaluatorVersionsRequest()
+	_field := NewBatchGetEvaluatorVersionIDsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20470,11 +22740,11 @@ func (p *EvaluatorServiceListEvaluatorVersionsArgs) FastReadField1(buf []byte) (
 	return offset, nil
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20483,7 +22753,7 @@ func (p *EvaluatorServiceListEvaluatorVersionsArgs) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) BLength() int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20492,29 +22762,29 @@ func (p *EvaluatorServiceListEvaluatorVersionsArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) field1Length() int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceListEvaluatorVersionsArgs)
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceBatchGetEvaluatorVersionIDsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *ListEvaluatorVersionsRequest
+	var _request *BatchGetEvaluatorVersionIDsRequest
 	if src.Request != nil {
-		_request = &ListEvaluatorVersionsRequest{}
+		_request = &BatchGetEvaluatorVersionIDsRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -20524,7 +22794,7 @@ func (p *EvaluatorServiceListEvaluatorVersionsArgs) DeepCopy(s interface{}) erro
 	return nil
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20568,14 +22838,14 @@ func (p *EvaluatorServiceListEvaluatorVersionsResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorVersionsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionIDsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListEvaluatorVersionsResponse()
+	_field := NewBatchGetEvaluatorVersionIDsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20585,11 +22855,11 @@ func (p *EvaluatorServiceListEvaluatorVersionsResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20598,7 +22868,7 @@ func (p *EvaluatorServiceListEvaluatorVersionsResult) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) BLength() int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20607,7 +22877,7 @@ func (p *EvaluatorServiceListEvaluatorVersionsResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20616,7 +22886,7 @@ func (p *EvaluatorServiceListEvaluatorVersionsResult) fastWriteField0(buf []byte
 	return offset
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) field0Length() int {
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20625,15 +22895,15 @@ func (p *EvaluatorServiceListEvaluatorVersionsResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceListEvaluatorVersionsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceListEvaluatorVersionsResult)
+func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceBatchGetEvaluatorVersionIDsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListEvaluatorVersionsResponse
+	var _success *BatchGetEvaluatorVersionIDsResponse
 	if src.Success != nil {
-		_success = &ListEvaluatorVersionsResponse{}
+		_success = &BatchGetEvaluatorVersionIDsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -20643,7 +22913,7 @@ func (p *EvaluatorServiceListEvaluatorVersionsResult) DeepCopy(s interface{}) er
 	return nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20687,14 +22957,14 @@ func (p *EvaluatorServiceGetEvaluatorVersionArgs) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorVersionArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceSubmitEvaluatorVersionArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetEvaluatorVersionRequest()
+	_field := NewSubmitEvaluatorVersionRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20704,11 +22974,11 @@ func (p *EvaluatorServiceGetEvaluatorVersionArgs) FastReadField1(buf []byte) (in
 	return offset, nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20717,7 +22987,7 @@ func (p *EvaluatorServiceGetEvaluatorVersionArgs) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) BLength() int {
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20726,29 +22996,29 @@ func (p *EvaluatorServiceGetEvaluatorVersionArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) field1Length() int {
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceGetEvaluatorVersionArgs)
+func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceSubmitEvaluatorVersionArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *GetEvaluatorVersionRequest
+	var _request *SubmitEvaluatorVersionRequest
 	if src.Request != nil {
-		_request = &GetEvaluatorVersionRequest{}
+		_request = &SubmitEvaluatorVersionRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -20758,7 +23028,7 @@ func (p *EvaluatorServiceGetEvaluatorVersionArgs) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20802,14 +23072,14 @@ func (p *EvaluatorServiceGetEvaluatorVersionResult) FastRead(buf []byte) (int, e
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorVersionResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceSubmitEvaluatorVersionResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetEvaluatorVersionResponse()
+	_field := NewSubmitEvaluatorVersionResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20819,11 +23089,11 @@ func (p *EvaluatorServiceGetEvaluatorVersionResult) FastReadField0(buf []byte) (
 	return offset, nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20832,7 +23102,7 @@ func (p *EvaluatorServiceGetEvaluatorVersionResult) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) BLength() int {
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20841,7 +23111,7 @@ func (p *EvaluatorServiceGetEvaluatorVersionResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20850,7 +23120,7 @@ func (p *EvaluatorServiceGetEvaluatorVersionResult) fastWriteField0(buf []byte,
 	return offset
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) field0Length() int {
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20859,15 +23129,15 @@ func (p *EvaluatorServiceGetEvaluatorVersionResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceGetEvaluatorVersionResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceGetEvaluatorVersionResult)
+func (p *EvaluatorServiceSubmitEvaluatorVersionResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceSubmitEvaluatorVersionResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetEvaluatorVersionResponse
+	var _success *SubmitEvaluatorVersionResponse
 	if src.Success != nil {
-		_success = &GetEvaluatorVersionResponse{}
+		_success = &SubmitEvaluatorVersionResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -20877,7 +23147,7 @@ func (p *EvaluatorServiceGetEvaluatorVersionResult) DeepCopy(s interface{}) erro
 	return nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceListTemplatesArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20921,14 +23191,14 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) FastRead(buf []byte) (in
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListTemplatesArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceListTemplatesArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetEvaluatorVersionsRequest()
+	_field := NewListTemplatesRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20938,11 +23208,11 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) FastReadField1(buf []byt
 	return offset, nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceListTemplatesArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceListTemplatesArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20951,7 +23221,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) FastWriteNocopy(buf []by
 	return offset
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) BLength() int {
+func (p *EvaluatorServiceListTemplatesArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20960,29 +23230,29 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceListTemplatesArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) field1Length() int {
+func (p *EvaluatorServiceListTemplatesArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceBatchGetEvaluatorVersionsArgs)
+func (p *EvaluatorServiceListTemplatesArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceListTemplatesArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *BatchGetEvaluatorVersionsRequest
+	var _request *ListTemplatesRequest
 	if src.Request != nil {
-		_request = &BatchGetEvaluatorVersionsRequest{}
+		_request = &ListTemplatesRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -20992,7 +23262,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsArgs) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceListTemplatesResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21036,14 +23306,14 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) FastRead(buf []byte) (
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListTemplatesResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceListTemplatesResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetEvaluatorVersionsResponse()
+	_field := NewListTemplatesResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21053,11 +23323,11 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) FastReadField0(buf []b
 	return offset, nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceListTemplatesResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceListTemplatesResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -21066,7 +23336,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) FastWriteNocopy(buf []
 	return offset
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) BLength() int {
+func (p *EvaluatorServiceListTemplatesResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -21075,7 +23345,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceListTemplatesResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -21084,7 +23354,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) fastWriteField0(buf []
 	return offset
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) field0Length() int {
+func (p *EvaluatorServiceListTemplatesResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -21093,15 +23363,15 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceBatchGetEvaluatorVersionsResult)
+func (p *EvaluatorServiceListTemplatesResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceListTemplatesResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchGetEvaluatorVersionsResponse
+	var _success *ListTemplatesResponse
 	if src.Success != nil {
-		_success = &BatchGetEvaluatorVersionsResponse{}
+		_success = &ListTemplatesResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -21111,7 +23381,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionsResult) DeepCopy(s interface{}
 	return nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetTemplateInfoArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21155,14 +23425,14 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) FastRead(buf []byte) (
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionIDsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetTemplateInfoArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetTemplateInfoArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetEvaluatorVersionIDsRequest()
+	_field := NewGetTemplateInfoRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21172,11 +23442,11 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) FastReadField1(buf []b
 	return offset, nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceGetTemplateInfoArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetTemplateInfoArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -21185,7 +23455,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) FastWriteNocopy(buf []
 	return offset
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) BLength() int {
+func (p *EvaluatorServiceGetTemplateInfoArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -21194,29 +23464,29 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetTemplateInfoArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Request.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) field1Length() int {
+func (p *EvaluatorServiceGetTemplateInfoArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Request.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceBatchGetEvaluatorVersionIDsArgs)
+func (p *EvaluatorServiceGetTemplateInfoArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceGetTemplateInfoArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *BatchGetEvaluatorVersionIDsRequest
+	var _request *GetTemplateInfoRequest
 	if src.Request != nil {
-		_request = &BatchGetEvaluatorVersionIDsRequest{}
+		_request = &GetTemplateInfoRequest{}
 		if err := _request.DeepCopy(src.Request); err != nil {
 			return err
 		}
@@ -21226,7 +23496,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsArgs) DeepCopy(s interface{}
 	return nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetTemplateInfoResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21270,14 +23540,14 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) FastRead(buf []byte)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorVersionIDsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetTemplateInfoResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetTemplateInfoResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetEvaluatorVersionIDsResponse()
+	_field := NewGetTemplateInfoResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21287,11 +23557,11 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) FastReadField0(buf [
 	return offset, nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceGetTemplateInfoResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetTemplateInfoResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -21300,7 +23570,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) BLength() int {
+func (p *EvaluatorServiceGetTemplateInfoResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -21309,7 +23579,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetTemplateInfoResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -21318,7 +23588,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) fastWriteField0(buf
 	return offset
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) field0Length() int {
+func (p *EvaluatorServiceGetTemplateInfoResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -21327,15 +23597,15 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceBatchGetEvaluatorVersionIDsResult)
+func (p *EvaluatorServiceGetTemplateInfoResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceGetTemplateInfoResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchGetEvaluatorVersionIDsResponse
+	var _success *GetTemplateInfoResponse
 	if src.Success != nil {
-		_success = &BatchGetEvaluatorVersionIDsResponse{}
+		_success = &GetTemplateInfoResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -21345,7 +23615,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorVersionIDsResult) DeepCopy(s interface
 	return nil
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21389,28 +23659,28 @@ func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceSubmitEvaluatorVersionArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSubmitEvaluatorVersionRequest()
+	_field := NewGetDefaultPromptEvaluatorToolsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Request = _field
+	p.Req = _field
 	return offset, nil
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -21419,7 +23689,7 @@ func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) BLength() int {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -21428,39 +23698,39 @@ func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-	offset += p.Request.FastWriteNocopy(buf[offset:], w)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) field1Length() int {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += p.Request.BLength()
+	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceSubmitEvaluatorVersionArgs)
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *SubmitEvaluatorVersionRequest
-	if src.Request != nil {
-		_request = &SubmitEvaluatorVersionRequest{}
-		if err := _request.DeepCopy(src.Request); err != nil {
+	var _req *GetDefaultPromptEvaluatorToolsRequest
+	if src.Req != nil {
+		_req = &GetDefaultPromptEvaluatorToolsRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
 	}
-	p.Request = _request
+	p.Req = _req
 
 	return nil
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21504,14 +23774,14 @@ func (p *EvaluatorServiceSubmitEvaluatorVersionResult) FastRead(buf []byte) (int
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceSubmitEvaluatorVersionResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetDefaultPromptEvaluatorToolsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSubmitEvaluatorVersionResponse()
+	_field := NewGetDefaultPromptEvaluatorToolsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21521,11 +23791,11 @@ func (p *EvaluatorServiceSubmitEvaluatorVersionResult) FastReadField0(buf []byte
 	return offset, nil
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -21534,7 +23804,7 @@ func (p *EvaluatorServiceSubmitEvaluatorVersionResult) FastWriteNocopy(buf []byt
 	return offset
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) BLength() int {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -21543,7 +23813,7 @@ func (p *EvaluatorServiceSubmitEvaluatorVersionResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -21552,7 +23822,7 @@ func (p *EvaluatorServiceSubmitEvaluatorVersionResult) fastWriteField0(buf []byt
 	return offset
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) field0Length() int {
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -21561,15 +23831,15 @@ func (p *EvaluatorServiceSubmitEvaluatorVersionResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceSubmitEvaluatorVersionResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceSubmitEvaluatorVersionResult)
+func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceGetDefaultPromptEvaluatorToolsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *SubmitEvaluatorVersionResponse
+	var _success *GetDefaultPromptEvaluatorToolsResponse
 	if src.Success != nil {
-		_success = &SubmitEvaluatorVersionResponse{}
+		_success = &GetDefaultPromptEvaluatorToolsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -21579,7 +23849,7 @@ func (p *EvaluatorServiceSubmitEvaluatorVersionResult) DeepCopy(s interface{}) e
 	return nil
 }
 
-func (p *EvaluatorServiceListTemplatesArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceRunEvaluatorArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21623,28 +23893,28 @@ func (p *EvaluatorServiceListTemplatesArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListTemplatesArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceRunEvaluatorArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceListTemplatesArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceRunEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListTemplatesRequest()
+	_field := NewRunEvaluatorRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Request = _field
+	p.Req = _field
 	return offset, nil
 }
 
-func (p *EvaluatorServiceListTemplatesArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceRunEvaluatorArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceListTemplatesArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceRunEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -21653,7 +23923,7 @@ func (p *EvaluatorServiceListTemplatesArgs) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *EvaluatorServiceListTemplatesArgs) BLength() int {
+func (p *EvaluatorServiceRunEvaluatorArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -21662,39 +23932,39 @@ func (p *EvaluatorServiceListTemplatesArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceListTemplatesArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceRunEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-	offset += p.Request.FastWriteNocopy(buf[offset:], w)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceListTemplatesArgs) field1Length() int {
+func (p *EvaluatorServiceRunEvaluatorArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += p.Request.BLength()
+	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceListTemplatesArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceListTemplatesArgs)
+func (p *EvaluatorServiceRunEvaluatorArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceRunEvaluatorArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *ListTemplatesRequest
-	if src.Request != nil {
-		_request = &ListTemplatesRequest{}
-		if err := _request.DeepCopy(src.Request); err != nil {
+	var _req *RunEvaluatorRequest
+	if src.Req != nil {
+		_req = &RunEvaluatorRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
 	}
-	p.Request = _request
+	p.Req = _req
 
 	return nil
 }
 
-func (p *EvaluatorServiceListTemplatesResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceRunEvaluatorResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21738,14 +24008,14 @@ func (p *EvaluatorServiceListTemplatesResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListTemplatesResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceRunEvaluatorResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceListTemplatesResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceRunEvaluatorResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListTemplatesResponse()
+	_field := NewRunEvaluatorResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21755,11 +24025,11 @@ func (p *EvaluatorServiceListTemplatesResult) FastReadField0(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *EvaluatorServiceListTemplatesResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceRunEvaluatorResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceListTemplatesResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceRunEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -21768,7 +24038,7 @@ func (p *EvaluatorServiceListTemplatesResult) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *EvaluatorServiceListTemplatesResult) BLength() int {
+func (p *EvaluatorServiceRunEvaluatorResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -21777,7 +24047,7 @@ func (p *EvaluatorServiceListTemplatesResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceListTemplatesResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceRunEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -21786,7 +24056,7 @@ func (p *EvaluatorServiceListTemplatesResult) fastWriteField0(buf []byte, w thri
 	return offset
 }
 
-func (p *EvaluatorServiceListTemplatesResult) field0Length() int {
+func (p *EvaluatorServiceRunEvaluatorResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -21795,15 +24065,15 @@ func (p *EvaluatorServiceListTemplatesResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceListTemplatesResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceListTemplatesResult)
+func (p *EvaluatorServiceRunEvaluatorResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceRunEvaluatorResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListTemplatesResponse
+	var _success *RunEvaluatorResponse
 	if src.Success != nil {
-		_success = &ListTemplatesResponse{}
+		_success = &RunEvaluatorResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -21813,7 +24083,7 @@ func (p *EvaluatorServiceListTemplatesResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceDebugEvaluatorArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21857,28 +24127,28 @@ func (p *EvaluatorServiceGetTemplateInfoArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetTemplateInfoArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceDebugEvaluatorArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceDebugEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetTemplateInfoRequest()
+	_field := NewDebugEvaluatorRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Request = _field
+	p.Req = _field
 	return offset, nil
 }
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceDebugEvaluatorArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceDebugEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -21887,7 +24157,7 @@ func (p *EvaluatorServiceGetTemplateInfoArgs) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) BLength() int {
+func (p *EvaluatorServiceDebugEvaluatorArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -21896,39 +24166,39 @@ func (p *EvaluatorServiceGetTemplateInfoArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceDebugEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-	offset += p.Request.FastWriteNocopy(buf[offset:], w)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) field1Length() int {
+func (p *EvaluatorServiceDebugEvaluatorArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += p.Request.BLength()
+	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceGetTemplateInfoArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceGetTemplateInfoArgs)
+func (p *EvaluatorServiceDebugEvaluatorArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceDebugEvaluatorArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _request *GetTemplateInfoRequest
-	if src.Request != nil {
-		_request = &GetTemplateInfoRequest{}
-		if err := _request.DeepCopy(src.Request); err != nil {
+	var _req *DebugEvaluatorRequest
+	if src.Req != nil {
+		_req = &DebugEvaluatorRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
 	}
-	p.Request = _request
+	p.Req = _req
 
 	return nil
 }
 
-func (p *EvaluatorServiceGetTemplateInfoResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceDebugEvaluatorResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21972,14 +24242,14 @@ func (p *EvaluatorServiceGetTemplateInfoResult) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetTemplateInfoResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceDebugEvaluatorResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceGetTemplateInfoResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceDebugEvaluatorResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetTemplateInfoResponse()
+	_field := NewDebugEvaluatorResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21989,11 +24259,11 @@ func (p *EvaluatorServiceGetTemplateInfoResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *EvaluatorServiceGetTemplateInfoResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceDebugEvaluatorResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceGetTemplateInfoResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceDebugEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -22002,7 +24272,7 @@ func (p *EvaluatorServiceGetTemplateInfoResult) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *EvaluatorServiceGetTemplateInfoResult) BLength() int {
+func (p *EvaluatorServiceDebugEvaluatorResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -22011,7 +24281,7 @@ func (p *EvaluatorServiceGetTemplateInfoResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceGetTemplateInfoResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceDebugEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -22020,7 +24290,7 @@ func (p *EvaluatorServiceGetTemplateInfoResult) fastWriteField0(buf []byte, w th
 	return offset
 }
 
-func (p *EvaluatorServiceGetTemplateInfoResult) field0Length() int {
+func (p *EvaluatorServiceDebugEvaluatorResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -22029,15 +24299,15 @@ func (p *EvaluatorServiceGetTemplateInfoResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceGetTemplateInfoResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceGetTemplateInfoResult)
+func (p *EvaluatorServiceDebugEvaluatorResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceDebugEvaluatorResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetTemplateInfoResponse
+	var _success *DebugEvaluatorResponse
 	if src.Success != nil {
-		_success = &GetTemplateInfoResponse{}
+		_success = &DebugEvaluatorResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -22047,7 +24317,7 @@ func (p *EvaluatorServiceGetTemplateInfoResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22091,14 +24361,14 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) FastRead(buf []byte
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchDebugEvaluatorArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetDefaultPromptEvaluatorToolsRequest()
+	_field := NewBatchDebugEvaluatorRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22108,11 +24378,11 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) FastReadField1(buf
 	return offset, nil
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -22121,7 +24391,7 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) BLength() int {
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -22130,29 +24400,29 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) field1Length() int {
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs)
+func (p *EvaluatorServiceBatchDebugEvaluatorArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceBatchDebugEvaluatorArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *GetDefaultPromptEvaluatorToolsRequest
+	var _req *BatchDebugEvaluatorRequest
 	if src.Req != nil {
-		_req = &GetDefaultPromptEvaluatorToolsRequest{}
+		_req = &BatchDebugEvaluatorRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -22162,7 +24432,7 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsArgs) DeepCopy(s interfac
 	return nil
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22206,14 +24476,14 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) FastRead(buf []by
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetDefaultPromptEvaluatorToolsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchDebugEvaluatorResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetDefaultPromptEvaluatorToolsResponse()
+	_field := NewBatchDebugEvaluatorResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22223,11 +24493,11 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) FastReadField0(bu
 	return offset, nil
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -22236,7 +24506,7 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) FastWriteNocopy(b
 	return offset
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) BLength() int {
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -22245,7 +24515,7 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -22254,7 +24524,7 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) fastWriteField0(b
 	return offset
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) field0Length() int {
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -22263,15 +24533,15 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) field0Length() in
 	return l
 }
 
-func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceGetDefaultPromptEvaluatorToolsResult)
+func (p *EvaluatorServiceBatchDebugEvaluatorResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceBatchDebugEvaluatorResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetDefaultPromptEvaluatorToolsResponse
+	var _success *BatchDebugEvaluatorResponse
 	if src.Success != nil {
-		_success = &GetDefaultPromptEvaluatorToolsResponse{}
+		_success = &BatchDebugEvaluatorResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -22281,7 +24551,7 @@ func (p *EvaluatorServiceGetDefaultPromptEvaluatorToolsResult) DeepCopy(s interf
 	return nil
 }
 
-func (p *EvaluatorServiceRunEvaluatorArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22325,14 +24595,14 @@ func (p *EvaluatorServiceRunEvaluatorArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceRunEvaluatorArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceAsyncRunEvaluatorArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceRunEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRunEvaluatorRequest()
+	_field := NewAsyncRunEvaluatorRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22342,11 +24612,11 @@ func (p *EvaluatorServiceRunEvaluatorArgs) FastReadField1(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *EvaluatorServiceRunEvaluatorArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceRunEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -22355,7 +24625,7 @@ func (p *EvaluatorServiceRunEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *EvaluatorServiceRunEvaluatorArgs) BLength() int {
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -22364,29 +24634,29 @@ func (p *EvaluatorServiceRunEvaluatorArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceRunEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceRunEvaluatorArgs) field1Length() int {
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceRunEvaluatorArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceRunEvaluatorArgs)
+func (p *EvaluatorServiceAsyncRunEvaluatorArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceAsyncRunEvaluatorArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *RunEvaluatorRequest
+	var _req *AsyncRunEvaluatorRequest
 	if src.Req != nil {
-		_req = &RunEvaluatorRequest{}
+		_req = &AsyncRunEvaluatorRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -22396,7 +24666,7 @@ func (p *EvaluatorServiceRunEvaluatorArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceRunEvaluatorResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22440,14 +24710,14 @@ func (p *EvaluatorServiceRunEvaluatorResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceRunEvaluatorResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceAsyncRunEvaluatorResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceRunEvaluatorResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRunEvaluatorResponse()
+	_field := NewAsyncRunEvaluatorResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22457,11 +24727,11 @@ func (p *EvaluatorServiceRunEvaluatorResult) FastReadField0(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *EvaluatorServiceRunEvaluatorResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceRunEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -22470,7 +24740,7 @@ func (p *EvaluatorServiceRunEvaluatorResult) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *EvaluatorServiceRunEvaluatorResult) BLength() int {
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -22479,7 +24749,7 @@ func (p *EvaluatorServiceRunEvaluatorResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceRunEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -22488,7 +24758,7 @@ func (p *EvaluatorServiceRunEvaluatorResult) fastWriteField0(buf []byte, w thrif
 	return offset
 }
 
-func (p *EvaluatorServiceRunEvaluatorResult) field0Length() int {
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -22497,15 +24767,15 @@ func (p *EvaluatorServiceRunEvaluatorResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceRunEvaluatorResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceRunEvaluatorResult)
+func (p *EvaluatorServiceAsyncRunEvaluatorResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceAsyncRunEvaluatorResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *RunEvaluatorResponse
+	var _success *AsyncRunEvaluatorResponse
 	if src.Success != nil {
-		_success = &RunEvaluatorResponse{}
+		_success = &AsyncRunEvaluatorResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -22515,7 +24785,7 @@ func (p *EvaluatorServiceRunEvaluatorResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22559,14 +24829,14 @@ func (p *EvaluatorServiceDebugEvaluatorArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceDebugEvaluatorArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceAsyncDebugEvaluatorArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDebugEvaluatorRequest()
+	_field := NewAsyncDebugEvaluatorRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22576,11 +24846,11 @@ func (p *EvaluatorServiceDebugEvaluatorArgs) FastReadField1(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -22589,7 +24859,7 @@ func (p *EvaluatorServiceDebugEvaluatorArgs) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) BLength() int {
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -22598,29 +24868,29 @@ func (p *EvaluatorServiceDebugEvaluatorArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) field1Length() int {
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceDebugEvaluatorArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceDebugEvaluatorArgs)
+func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceAsyncDebugEvaluatorArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *DebugEvaluatorRequest
+	var _req *AsyncDebugEvaluatorRequest
 	if src.Req != nil {
-		_req = &DebugEvaluatorRequest{}
+		_req = &AsyncDebugEvaluatorRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -22630,7 +24900,7 @@ func (p *EvaluatorServiceDebugEvaluatorArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceDebugEvaluatorResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22674,14 +24944,14 @@ func (p *EvaluatorServiceDebugEvaluatorResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceDebugEvaluatorResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceAsyncDebugEvaluatorResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceDebugEvaluatorResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDebugEvaluatorResponse()
+	_field := NewAsyncDebugEvaluatorResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22691,11 +24961,11 @@ func (p *EvaluatorServiceDebugEvaluatorResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *EvaluatorServiceDebugEvaluatorResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceDebugEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -22704,7 +24974,7 @@ func (p *EvaluatorServiceDebugEvaluatorResult) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *EvaluatorServiceDebugEvaluatorResult) BLength() int {
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -22713,7 +24983,7 @@ func (p *EvaluatorServiceDebugEvaluatorResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceDebugEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -22722,7 +24992,7 @@ func (p *EvaluatorServiceDebugEvaluatorResult) fastWriteField0(buf []byte, w thr
 	return offset
 }
 
-func (p *EvaluatorServiceDebugEvaluatorResult) field0Length() int {
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -22731,15 +25001,15 @@ func (p *EvaluatorServiceDebugEvaluatorResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceDebugEvaluatorResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceDebugEvaluatorResult)
+func (p *EvaluatorServiceAsyncDebugEvaluatorResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceAsyncDebugEvaluatorResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *DebugEvaluatorResponse
+	var _success *AsyncDebugEvaluatorResponse
 	if src.Success != nil {
-		_success = &DebugEvaluatorResponse{}
+		_success = &AsyncDebugEvaluatorResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -22749,7 +25019,7 @@ func (p *EvaluatorServiceDebugEvaluatorResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22793,14 +25063,14 @@ func (p *EvaluatorServiceBatchDebugEvaluatorArgs) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchDebugEvaluatorArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorRecordArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchDebugEvaluatorRequest()
+	_field := NewUpdateEvaluatorRecordRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22810,11 +25080,11 @@ func (p *EvaluatorServiceBatchDebugEvaluatorArgs) FastReadField1(buf []byte) (in
 	return offset, nil
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -22823,7 +25093,7 @@ func (p *EvaluatorServiceBatchDebugEvaluatorArgs) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) BLength() int {
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -22832,29 +25102,29 @@ func (p *EvaluatorServiceBatchDebugEvaluatorArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) field1Length() int {
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceBatchDebugEvaluatorArgs)
+func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceUpdateEvaluatorRecordArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchDebugEvaluatorRequest
+	var _req *UpdateEvaluatorRecordRequest
 	if src.Req != nil {
-		_req = &BatchDebugEvaluatorRequest{}
+		_req = &UpdateEvaluatorRecordRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -22864,7 +25134,7 @@ func (p *EvaluatorServiceBatchDebugEvaluatorArgs) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22908,14 +25178,14 @@ func (p *EvaluatorServiceBatchDebugEvaluatorResult) FastRead(buf []byte) (int, e
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchDebugEvaluatorResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorRecordResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchDebugEvaluatorResponse()
+	_field := NewUpdateEvaluatorRecordResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22925,11 +25195,11 @@ func (p *EvaluatorServiceBatchDebugEvaluatorResult) FastReadField0(buf []byte) (
 	return offset, nil
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -22938,7 +25208,7 @@ func (p *EvaluatorServiceBatchDebugEvaluatorResult) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) BLength() int {
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -22947,7 +25217,7 @@ func (p *EvaluatorServiceBatchDebugEvaluatorResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -22956,7 +25226,7 @@ func (p *EvaluatorServiceBatchDebugEvaluatorResult) fastWriteField0(buf []byte,
 	return offset
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) field0Length() int {
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -22965,15 +25235,15 @@ func (p *EvaluatorServiceBatchDebugEvaluatorResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceBatchDebugEvaluatorResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceBatchDebugEvaluatorResult)
+func (p *EvaluatorServiceUpdateEvaluatorRecordResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceUpdateEvaluatorRecordResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchDebugEvaluatorResponse
+	var _success *UpdateEvaluatorRecordResponse
 	if src.Success != nil {
-		_success = &BatchDebugEvaluatorResponse{}
+		_success = &UpdateEvaluatorRecordResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -22983,7 +25253,7 @@ func (p *EvaluatorServiceBatchDebugEvaluatorResult) DeepCopy(s interface{}) erro
 	return nil
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -23027,14 +25297,14 @@ func (p *EvaluatorServiceAsyncRunEvaluatorArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceAsyncRunEvaluatorArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorRecordArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewAsyncRunEvaluatorRequest()
+	_field := NewGetEvaluatorRecordRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -23044,11 +25314,11 @@ func (p *EvaluatorServiceAsyncRunEvaluatorArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -23057,7 +25327,7 @@ func (p *EvaluatorServiceAsyncRunEvaluatorArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) BLength() int {
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -23066,29 +25336,29 @@ func (p *EvaluatorServiceAsyncRunEvaluatorArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) field1Length() int {
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceAsyncRunEvaluatorArgs)
+func (p *EvaluatorServiceGetEvaluatorRecordArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceGetEvaluatorRecordArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *AsyncRunEvaluatorRequest
+	var _req *GetEvaluatorRecordRequest
 	if src.Req != nil {
-		_req = &AsyncRunEvaluatorRequest{}
+		_req = &GetEvaluatorRecordRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -23098,7 +25368,7 @@ func (p *EvaluatorServiceAsyncRunEvaluatorArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetEvaluatorRecordResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -23142,14 +25412,14 @@ func (p *EvaluatorServiceAsyncRunEvaluatorResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceAsyncRunEvaluatorResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorRecordResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetEvaluatorRecordResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewAsyncRunEvaluatorResponse()
+	_field := NewGetEvaluatorRecordResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -23159,11 +25429,11 @@ func (p *EvaluatorServiceAsyncRunEvaluatorResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceGetEvaluatorRecordResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetEvaluatorRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -23172,7 +25442,7 @@ func (p *EvaluatorServiceAsyncRunEvaluatorResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) BLength() int {
+func (p *EvaluatorServiceGetEvaluatorRecordResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -23181,7 +25451,7 @@ func (p *EvaluatorServiceAsyncRunEvaluatorResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetEvaluatorRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -23190,7 +25460,7 @@ func (p *EvaluatorServiceAsyncRunEvaluatorResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) field0Length() int {
+func (p *EvaluatorServiceGetEvaluatorRecordResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -23199,15 +25469,15 @@ func (p *EvaluatorServiceAsyncRunEvaluatorResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceAsyncRunEvaluatorResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceAsyncRunEvaluatorResult)
+func (p *EvaluatorServiceGetEvaluatorRecordResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceGetEvaluatorRecordResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *AsyncRunEvaluatorResponse
+	var _success *GetEvaluatorRecordResponse
 	if src.Success != nil {
-		_success = &AsyncRunEvaluatorResponse{}
+		_success = &GetEvaluatorRecordResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -23217,7 +25487,7 @@ func (p *EvaluatorServiceAsyncRunEvaluatorResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -23261,14 +25531,14 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceAsyncDebugEvaluatorArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorRecordsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewAsyncDebugEvaluatorRequest()
+	_field := NewBatchGetEvaluatorRecordsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -23278,11 +25548,11 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) FastReadField1(buf []byte) (in
 	return offset, nil
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -23291,7 +25561,7 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) BLength() int {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -23300,29 +25570,29 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) field1Length() int {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceAsyncDebugEvaluatorArgs)
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceBatchGetEvaluatorRecordsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *AsyncDebugEvaluatorRequest
+	var _req *BatchGetEvaluatorRecordsRequest
 	if src.Req != nil {
-		_req = &AsyncDebugEvaluatorRequest{}
+		_req = &BatchGetEvaluatorRecordsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -23332,7 +25602,7 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorArgs) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -23376,14 +25646,14 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorResult) FastRead(buf []byte) (int, e
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceAsyncDebugEvaluatorResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorRecordsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewAsyncDebugEvaluatorResponse()
+	_field := NewBatchGetEvaluatorRecordsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -23393,11 +25663,11 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorResult) FastReadField0(buf []byte) (
 	return offset, nil
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -23406,7 +25676,7 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorResult) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) BLength() int {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -23415,7 +25685,7 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -23424,7 +25694,7 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorResult) fastWriteField0(buf []byte,
 	return offset
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) field0Length() int {
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -23433,15 +25703,15 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceAsyncDebugEvaluatorResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceAsyncDebugEvaluatorResult)
+func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceBatchGetEvaluatorRecordsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *AsyncDebugEvaluatorResponse
+	var _success *BatchGetEvaluatorRecordsResponse
 	if src.Success != nil {
-		_success = &AsyncDebugEvaluatorResponse{}
+		_success = &BatchGetEvaluatorRecordsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -23451,7 +25721,7 @@ func (p *EvaluatorServiceAsyncDebugEvaluatorResult) DeepCopy(s interface{}) erro
 	return nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -23495,14 +25765,14 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) FastRead(buf []byte) (int, e
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorRecordArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceRunEvaluatorCalibrationArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateEvaluatorRecordRequest()
+	_field := NewRunEvaluatorCalibrationRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -23512,11 +25782,11 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) FastReadField1(buf []byte) (
 	return offset, nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -23525,7 +25795,7 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) BLength() int {
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -23534,29 +25804,29 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) field1Length() int {
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceUpdateEvaluatorRecordArgs)
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceRunEvaluatorCalibrationArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *UpdateEvaluatorRecordRequest
+	var _req *RunEvaluatorCalibrationRequest
 	if src.Req != nil {
-		_req = &UpdateEvaluatorRecordRequest{}
+		_req = &RunEvaluatorCalibrationRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -23566,7 +25836,7 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordArgs) DeepCopy(s interface{}) erro
 	return nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -23610,14 +25880,14 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceUpdateEvaluatorRecordResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceRunEvaluatorCalibrationResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateEvaluatorRecordResponse()
+	_field := NewRunEvaluatorCalibrationResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -23627,11 +25897,11 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -23640,7 +25910,7 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordResult) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) BLength() int {
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -23649,7 +25919,7 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -23658,7 +25928,7 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordResult) fastWriteField0(buf []byte
 	return offset
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) field0Length() int {
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -23667,15 +25937,15 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceUpdateEvaluatorRecordResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceUpdateEvaluatorRecordResult)
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceRunEvaluatorCalibrationResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpdateEvaluatorRecordResponse
+	var _success *RunEvaluatorCalibrationResponse
 	if src.Success != nil {
-		_success = &UpdateEvaluatorRecordResponse{}
+		_success = &RunEvaluatorCalibrationResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -23685,7 +25955,7 @@ func (p *EvaluatorServiceUpdateEvaluatorRecordResult) DeepCopy(s interface{}) er
 	return nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -23729,14 +25999,14 @@ func (p *EvaluatorServiceGetEvaluatorRecordArgs) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorRecordArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorCalibrationRecordArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetEvaluatorRecordRequest()
+	_field := NewGetEvaluatorCalibrationRecordRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -23746,11 +26016,11 @@ func (p *EvaluatorServiceGetEvaluatorRecordArgs) FastReadField1(buf []byte) (int
 	return offset, nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -23759,7 +26029,7 @@ func (p *EvaluatorServiceGetEvaluatorRecordArgs) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) BLength() int {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -23768,29 +26038,29 @@ func (p *EvaluatorServiceGetEvaluatorRecordArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) field1Length() int {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceGetEvaluatorRecordArgs)
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceGetEvaluatorCalibrationRecordArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *GetEvaluatorRecordRequest
+	var _req *GetEvaluatorCalibrationRecordRequest
 	if src.Req != nil {
-		_req = &GetEvaluatorRecordRequest{}
+		_req = &GetEvaluatorCalibrationRecordRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -23800,7 +26070,7 @@ func (p *EvaluatorServiceGetEvaluatorRecordArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -23844,14 +26114,14 @@ func (p *EvaluatorServiceGetEvaluatorRecordResult) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorRecordResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceGetEvaluatorCalibrationRecordResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetEvaluatorRecordResponse()
+	_field := NewGetEvaluatorCalibrationRecordResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -23861,11 +26131,11 @@ func (p *EvaluatorServiceGetEvaluatorRecordResult) FastReadField0(buf []byte) (i
 	return offset, nil
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -23874,7 +26144,7 @@ func (p *EvaluatorServiceGetEvaluatorRecordResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) BLength() int {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -23883,7 +26153,7 @@ func (p *EvaluatorServiceGetEvaluatorRecordResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -23892,7 +26162,7 @@ func (p *EvaluatorServiceGetEvaluatorRecordResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) field0Length() int {
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -23901,15 +26171,15 @@ func (p *EvaluatorServiceGetEvaluatorRecordResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceGetEvaluatorRecordResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceGetEvaluatorRecordResult)
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceGetEvaluatorCalibrationRecordResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetEvaluatorRecordResponse
+	var _success *GetEvaluatorCalibrationRecordResponse
 	if src.Success != nil {
-		_success = &GetEvaluatorRecordResponse{}
+		_success = &GetEvaluatorCalibrationRecordResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -23919,7 +26189,7 @@ func (p *EvaluatorServiceGetEvaluatorRecordResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -23963,14 +26233,14 @@ func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) FastRead(buf []byte) (int
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorRecordsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorCalibrationRecordsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetEvaluatorRecordsRequest()
+	_field := NewListEvaluatorCalibrationRecordsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -23980,11 +26250,11 @@ func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) FastReadField1(buf []byte
 	return offset, nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -23993,7 +26263,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) FastWriteNocopy(buf []byt
 	return offset
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) BLength() int {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -24002,29 +26272,29 @@ func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) field1Length() int {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceBatchGetEvaluatorRecordsArgs)
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceListEvaluatorCalibrationRecordsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchGetEvaluatorRecordsRequest
+	var _req *ListEvaluatorCalibrationRecordsRequest
 	if src.Req != nil {
-		_req = &BatchGetEvaluatorRecordsRequest{}
+		_req = &ListEvaluatorCalibrationRecordsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -24034,7 +26304,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorRecordsArgs) DeepCopy(s interface{}) e
 	return nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -24078,14 +26348,14 @@ func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) FastRead(buf []byte) (i
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceBatchGetEvaluatorRecordsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorServiceListEvaluatorCalibrationRecordsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) FastReadField0(buf []byte) (int, error) {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetEvaluatorRecordsResponse()
+	_field := NewListEvaluatorCalibrationRecordsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -24095,11 +26365,11 @@ func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) FastReadField0(buf []by
 	return offset, nil
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) FastWrite(buf []byte) int {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -24108,7 +26378,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) FastWriteNocopy(buf []b
 	return offset
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) BLength() int {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -24117,7 +26387,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) BLength() int {
 	return l
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -24126,7 +26396,7 @@ func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) fastWriteField0(buf []b
 	return offset
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) field0Length() int {
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -24135,15 +26405,15 @@ func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) field0Length() int {
 	return l
 }
 
-func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorServiceBatchGetEvaluatorRecordsResult)
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorServiceListEvaluatorCalibrationRecordsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchGetEvaluatorRecordsResponse
+	var _success *ListEvaluatorCalibrationRecordsResponse
 	if src.Success != nil {
-		_success = &BatchGetEvaluatorRecordsResponse{}
+		_success = &ListEvaluatorCalibrationRecordsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -26451,6 +28721,30 @@ func (p *EvaluatorServiceBatchGetEvaluatorRecordsResult) GetResult() interface{}
 	return p.Success
 }
 
+func (p *EvaluatorServiceRunEvaluatorCalibrationArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *EvaluatorServiceRunEvaluatorCalibrationResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *EvaluatorServiceGetEvaluatorCalibrationRecordResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *EvaluatorServiceListEvaluatorCalibrationRecordsResult) GetResult() interface{} {
+	return p.Success
+}
+
 func (p *EvaluatorServiceValidateEvaluatorArgs) GetFirstArgument() interface{} {
 	return p.Request
 }
@@ -182,6 +182,27 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"RunEvaluatorCalibration": kitex.NewMethodInfo(
+		runEvaluatorCalibrationHandler,
+		newEvaluatorServiceRunEvaluatorCalibrationArgs,
+		newEvaluatorServiceRunEvaluatorCalibrationResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetEvaluatorCalibrationRecord": kitex.NewMethodInfo(
+		getEvaluatorCalibrationRecordHandler,
+		newEvaluatorServiceGetEvaluatorCalibrationRecordArgs,
+		newEvaluatorServiceGetEvaluatorCalibrationRecordResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListEvaluatorCalibrationRecords": kitex.NewMethodInfo(
+		listEvaluatorCalibrationRecordsHandler,
+		newEvaluatorServiceListEvaluatorCalibrationRecordsArgs,
+		newEvaluatorServiceListEvaluatorCalibrationRecordsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"ValidateEvaluator": kitex.NewMethodInfo(
 		validateEvaluatorHandler,
 		newEvaluatorServiceValidateEvaluatorArgs,
@@ -734,6 +755,63 @@ func newEvaluatorServiceBatchGetEvaluatorRecordsResult() interface{} {
 	return evaluator.NewEvaluatorServiceBatchGetEvaluatorRecordsResult()
 }
 
+func runEvaluatorCalibrationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*evaluator.EvaluatorServiceRunEvaluatorCalibrationArgs)
+	realResult := result.(*evaluator.EvaluatorServiceRunEvaluatorCalibrationResult)
+	success, err := handler.(evaluator.EvaluatorService).RunEvaluatorCalibration(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluatorServiceRunEvaluatorCalibrationArgs() interface{} {
+	return evaluator.NewEvaluatorServiceRunEvaluatorCalibrationArgs()
+}
+
+func newEvaluatorServiceRunEvaluatorCalibrationResult() interface{} {
+	return evaluator.NewEvaluatorServiceRunEvaluatorCalibrationResult()
+}
+
+func getEvaluatorCalibrationRecordHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*evaluator.EvaluatorServiceGetEvaluatorCalibrationRecordArgs)
+	realResult := result.(*evaluator.EvaluatorServiceGetEvaluatorCalibrationRecordResult)
+	success, err := handler.(evaluator.EvaluatorService).GetEvaluatorCalibrationRecord(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluatorServiceGetEvaluatorCalibrationRecordArgs() interface{} {
+	return evaluator.NewEvaluatorServiceGetEvaluatorCalibrationRecordArgs()
+}
+
+func newEvaluatorServiceGetEvaluatorCalibrationRecordResult() interface{} {
+	return evaluator.NewEvaluatorServiceGetEvaluatorCalibrationRecordResult()
+}
+
+func listEvaluatorCalibrationRecordsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*evaluator.EvaluatorServiceListEvaluatorCalibrationRecordsArgs)
+	realResult := result.(*evaluator.EvaluatorServiceListEvaluatorCalibrationRecordsResult)
+	success, err := handler.(evaluator.EvaluatorService).ListEvaluatorCalibrationRecords(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluatorServiceListEvaluatorCalibrationRecordsArgs() interface{} {
+	return evaluator.NewEvaluatorServiceListEvaluatorCalibrationRecordsArgs()
+}
+
+func newEvaluatorServiceListEvaluatorCalibrationRecordsResult() interface{} {
+	return evaluator.NewEvaluatorServiceListEvaluatorCalibrationRecordsResult()
+}
+
 func validateEvaluatorHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*evaluator.EvaluatorServiceValidateEvaluatorArgs)
 	realResult := result.(*evaluator.EvaluatorServiceValidateEvaluatorResult)
@@ -1157,6 +1235,36 @@ func (p *kClient) BatchGetEvaluatorRecords(ctx context.Context, req *evaluator.B
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) RunEvaluatorCalibration(ctx context.Context, req *evaluator.RunEvaluatorCalibrationRequest) (r *evaluator.RunEvaluatorCalibrationResponse, err error) {
+	var _args evaluator.EvaluatorServiceRunEvaluatorCalibrationArgs
+	_args.Req = req
+	var _result evaluator.EvaluatorServiceRunEvaluatorCalibrationResult
+	if err = p.c.Call(ctx, "RunEvaluatorCalibration", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetEvaluatorCalibrationRecord(ctx context.Context, req *evaluator.GetEvaluatorCalibrationRecordRequest) (r *evaluator.GetEvaluatorCalibrationRecordResponse, err error) {
+	var _args evaluator.EvaluatorServiceGetEvaluatorCalibrationRecordArgs
+	_args.Req = req
+	var _result evaluator.EvaluatorServiceGetEvaluatorCalibrationRecordResult
+	if err = p.c.Call(ctx, "GetEvaluatorCalibrationRecord", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListEvaluatorCalibrationRecords(ctx context.Context, req *evaluator.ListEvaluatorCalibrationRecordsRequest) (r *evaluator.ListEvaluatorCalibrationRecordsResponse, err error) {
+	var _args evaluator.EvaluatorServiceListEvaluatorCalibrationRecordsArgs
+	_args.Req = req
+	var _result evaluator.EvaluatorServiceListEvaluatorCalibrationRecordsResult
+	if err = p.c.Call(ctx, "ListEvaluatorCalibrationRecords", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) ValidateEvaluator(ctx context.Context, request *evaluator.ValidateEvaluatorRequest) (r *evaluator.ValidateEvaluatorResponse, err error) {
 	var _args evaluator.EvaluatorServiceValidateEvaluatorArgs
 	_args.Request = request
@@ -57,6 +57,36 @@ func (p *CreateExperimentRequest) IsValid() error {
 			return fmt.Errorf("field NotificationConf not valid, %w", err)
 		}
 	}
+	if p.QualityGateConf != nil {
+		if err := p.QualityGateConf.IsValid(); err != nil {
+			return fmt.Errorf("field QualityGateConf not valid, %w", err)
+		}
+	}
+	if p.CostConf != nil {
+		if err := p.CostConf.IsValid(); err != nil {
+			return fmt.Errorf("field CostConf not valid, %w", err)
+		}
+	}
+	if p.ResultCacheConf != nil {
+		if err := p.ResultCacheConf.IsValid(); err != nil {
+			return fmt.Errorf("field ResultCacheConf not valid, %w", err)
+		}
+	}
+	if p.SamplingConf != nil {
+		if err := p.SamplingConf.IsValid(); err != nil {
+			return fmt.Errorf("field SamplingConf not valid, %w", err)
+		}
+	}
+	if p.TrialConf != nil {
+		if err := p.TrialConf.IsValid(); err != nil {
+			return fmt.Errorf("field TrialConf not valid, %w", err)
+		}
+	}
+	if p.UserSimulatorConf != nil {
+		if err := p.UserSimulatorConf.IsValid(); err != nil {
+			return fmt.Errorf("field UserSimulatorConf not valid, %w", err)
+		}
+	}
 	if p.Session != nil {
 		if err := p.Session.IsValid(); err != nil {
 			return fmt.Errorf("field Session not valid, %w", err)
@@ -123,6 +153,36 @@ func (p *SubmitExperimentRequest) IsValid() error {
 			return fmt.Errorf("field NotificationConf not valid, %w", err)
 		}
 	}
+	if p.QualityGateConf != nil {
+		if err := p.QualityGateConf.IsValid(); err != nil {
+			return fmt.Errorf("field QualityGateConf not valid, %w", err)
+		}
+	}
+	if p.CostConf != nil {
+		if err := p.CostConf.IsValid(); err != nil {
+			return fmt.Errorf("field CostConf not valid, %w", err)
+		}
+	}
+	if p.ResultCacheConf != nil {
+		if err := p.ResultCacheConf.IsValid(); err != nil {
+			return fmt.Errorf("field ResultCacheConf not valid, %w", err)
+		}
+	}
+	if p.SamplingConf != nil {
+		if err := p.SamplingConf.IsValid(); err != nil {
+			return fmt.Errorf("field SamplingConf not valid, %w", err)
+		}
+	}
+	if p.TrialConf != nil {
+		if err := p.TrialConf.IsValid(); err != nil {
+			return fmt.Errorf("field TrialConf not valid, %w", err)
+		}
+	}
+	if p.UserSimulatorConf != nil {
+		if err := p.UserSimulatorConf.IsValid(); err != nil {
+			return fmt.Errorf("field UserSimulatorConf not valid, %w", err)
+		}
+	}
 	if p.Session != nil {
 		if err := p.Session.IsValid(); err != nil {
 			return fmt.Errorf("field Session not valid, %w", err)
@@ -605,6 +665,11 @@ func (p *CreateExperimentTemplateRequest) IsValid() error {
 			return fmt.Errorf("field NotificationConf not valid, %w", err)
 		}
 	}
+	if p.QualityGateConf != nil {
+		if err := p.QualityGateConf.IsValid(); err != nil {
+			return fmt.Errorf("field QualityGateConf not valid, %w", err)
+		}
+	}
 	if p.Session != nil {
 		if err := p.Session.IsValid(); err != nil {
 			return fmt.Errorf("field Session not valid, %w", err)
@@ -708,6 +773,11 @@ func (p *UpdateExperimentTemplateRequest) IsValid() error {
 			return fmt.Errorf("field NotificationConf not valid, %w", err)
 		}
 	}
+	if p.QualityGateConf != nil {
+		if err := p.QualityGateConf.IsValid(); err != nil {
+			return fmt.Errorf("field QualityGateConf not valid, %w", err)
+		}
+	}
 	if p.Base != nil {
 		if err := p.Base.IsValid(); err != nil {
 			return fmt.Errorf("field Base not valid, %w", err)
@@ -787,6 +857,11 @@ func (p *SubmitExptFromTemplateRequest) IsValid() error {
 			return fmt.Errorf("field NotificationConf not valid, %w", err)
 		}
 	}
+	if p.QualityGateConf != nil {
+		if err := p.QualityGateConf.IsValid(); err != nil {
+			return fmt.Errorf("field QualityGateConf not valid, %w", err)
+		}
+	}
 	if p.Session != nil {
 		if err := p.Session.IsValid(); err != nil {
 			return fmt.Errorf("field Session not valid, %w", err)
@@ -993,6 +1068,179 @@ func (p *GetExptResultExportRecordResponse) IsValid() error {
 	}
 	return nil
 }
+func (p *SubmitExptPairwiseRankRequest) IsValid() error {
+	if p.Session != nil {
+		if err := p.Session.IsValid(); err != nil {
+			return fmt.Errorf("field Session not valid, %w", err)
+		}
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *SubmitExptPairwiseRankResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GetExptPairwiseRankRequest) IsValid() error {
+	if p.Session != nil {
+		if err := p.Session.IsValid(); err != nil {
+			return fmt.Errorf("field Session not valid, %w", err)
+		}
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GetExptPairwiseRankResponse) IsValid() error {
+	if p.Record != nil {
+		if err := p.Record.IsValid(); err != nil {
+			return fmt.Errorf("field Record not valid, %w", err)
+		}
+	}
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *CompareExperimentsRequest) IsValid() error {
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *CompareExperimentsResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GetExptRegressionReportRequest) IsValid() error {
+	if p.Filter != nil {
+		if err := p.Filter.IsValid(); err != nil {
+			return fmt.Errorf("field Filter not valid, %w", err)
+		}
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GetExptRegressionReportResponse) IsValid() error {
+	if p.Report != nil {
+		if err := p.Report.IsValid(); err != nil {
+			return fmt.Errorf("field Report not valid, %w", err)
+		}
+	}
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExportExptRegressionReportRequest) IsValid() error {
+	if p.Filter != nil {
+		if err := p.Filter.IsValid(); err != nil {
+			return fmt.Errorf("field Filter not valid, %w", err)
+		}
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExportExptRegressionReportResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GetExptGroupedAggrResultRequest) IsValid() error {
+	if p.Dimension != nil {
+		if err := p.Dimension.IsValid(); err != nil {
+			return fmt.Errorf("field Dimension not valid, %w", err)
+		}
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GetExptGroupedAggrResultResponse) IsValid() error {
+	if p.Dimension != nil {
+		if err := p.Dimension.IsValid(); err != nil {
+			return fmt.Errorf("field Dimension not valid, %w", err)
+		}
+	}
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GetExptSampledAggrResultRequest) IsValid() error {
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *GetExptSampledAggrResultResponse) IsValid() error {
+	if p.Result_ != nil {
+		if err := p.Result_.IsValid(); err != nil {
+			return fmt.Errorf("field Result_ not valid, %w", err)
+		}
+	}
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *InvalidateExptResultCacheRequest) IsValid() error {
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *InvalidateExptResultCacheResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
 func (p *GetExptInsightAnalysisRecordRequest) IsValid() error {
 	if p.Session != nil {
 		if err := p.Session.IsValid(); err != nil {
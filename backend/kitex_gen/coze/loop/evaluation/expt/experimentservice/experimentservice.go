@@ -272,6 +272,62 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"CompareExperiments": kitex.NewMethodInfo(
+		compareExperimentsHandler,
+		newExperimentServiceCompareExperimentsArgs,
+		newExperimentServiceCompareExperimentsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetExptRegressionReport": kitex.NewMethodInfo(
+		getExptRegressionReportHandler,
+		newExperimentServiceGetExptRegressionReportArgs,
+		newExperimentServiceGetExptRegressionReportResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ExportExptRegressionReport": kitex.NewMethodInfo(
+		exportExptRegressionReportHandler,
+		newExperimentServiceExportExptRegressionReportArgs,
+		newExperimentServiceExportExptRegressionReportResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetExptGroupedAggrResult": kitex.NewMethodInfo(
+		getExptGroupedAggrResult_Handler,
+		newExperimentServiceGetExptGroupedAggrResultArgs,
+		newExperimentServiceGetExptGroupedAggrResultResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetExptSampledAggrResult": kitex.NewMethodInfo(
+		getExptSampledAggrResult_Handler,
+		newExperimentServiceGetExptSampledAggrResultArgs,
+		newExperimentServiceGetExptSampledAggrResultResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"InvalidateExptResultCache": kitex.NewMethodInfo(
+		invalidateExptResultCacheHandler,
+		newExperimentServiceInvalidateExptResultCacheArgs,
+		newExperimentServiceInvalidateExptResultCacheResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SubmitExptPairwiseRank": kitex.NewMethodInfo(
+		submitExptPairwiseRankHandler,
+		newExperimentServiceSubmitExptPairwiseRankArgs,
+		newExperimentServiceSubmitExptPairwiseRankResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetExptPairwiseRank": kitex.NewMethodInfo(
+		getExptPairwiseRankHandler,
+		newExperimentServiceGetExptPairwiseRankArgs,
+		newExperimentServiceGetExptPairwiseRankResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"CreateExperimentTemplate": kitex.NewMethodInfo(
 		createExperimentTemplateHandler,
 		newExperimentServiceCreateExperimentTemplateArgs,
@@ -1064,6 +1120,158 @@ func newExperimentServiceGetAnalysisRecordFeedbackVoteResult() interface{} {
 	return expt.NewExperimentServiceGetAnalysisRecordFeedbackVoteResult()
 }
 
+func compareExperimentsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*expt.ExperimentServiceCompareExperimentsArgs)
+	realResult := result.(*expt.ExperimentServiceCompareExperimentsResult)
+	success, err := handler.(expt.ExperimentService).CompareExperiments(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newExperimentServiceCompareExperimentsArgs() interface{} {
+	return expt.NewExperimentServiceCompareExperimentsArgs()
+}
+
+func newExperimentServiceCompareExperimentsResult() interface{} {
+	return expt.NewExperimentServiceCompareExperimentsResult()
+}
+
+func getExptRegressionReportHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*expt.ExperimentServiceGetExptRegressionReportArgs)
+	realResult := result.(*expt.ExperimentServiceGetExptRegressionReportResult)
+	success, err := handler.(expt.ExperimentService).GetExptRegressionReport(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newExperimentServiceGetExptRegressionReportArgs() interface{} {
+	return expt.NewExperimentServiceGetExptRegressionReportArgs()
+}
+
+func newExperimentServiceGetExptRegressionReportResult() interface{} {
+	return expt.NewExperimentServiceGetExptRegressionReportResult()
+}
+
+func exportExptRegressionReportHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*expt.ExperimentServiceExportExptRegressionReportArgs)
+	realResult := result.(*expt.ExperimentServiceExportExptRegressionReportResult)
+	success, err := handler.(expt.ExperimentService).ExportExptRegressionReport(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newExperimentServiceExportExptRegressionReportArgs() interface{} {
+	return expt.NewExperimentServiceExportExptRegressionReportArgs()
+}
+
+func newExperimentServiceExportExptRegressionReportResult() interface{} {
+	return expt.NewExperimentServiceExportExptRegressionReportResult()
+}
+
+func getExptGroupedAggrResult_Handler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*expt.ExperimentServiceGetExptGroupedAggrResultArgs)
+	realResult := result.(*expt.ExperimentServiceGetExptGroupedAggrResultResult)
+	success, err := handler.(expt.ExperimentService).GetExptGroupedAggrResult_(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newExperimentServiceGetExptGroupedAggrResultArgs() interface{} {
+	return expt.NewExperimentServiceGetExptGroupedAggrResultArgs()
+}
+
+func newExperimentServiceGetExptGroupedAggrResultResult() interface{} {
+	return expt.NewExperimentServiceGetExptGroupedAggrResultResult()
+}
+
+func getExptSampledAggrResult_Handler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*expt.ExperimentServiceGetExptSampledAggrResultArgs)
+	realResult := result.(*expt.ExperimentServiceGetExptSampledAggrResultResult)
+	success, err := handler.(expt.ExperimentService).GetExptSampledAggrResult_(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newExperimentServiceGetExptSampledAggrResultArgs() interface{} {
+	return expt.NewExperimentServiceGetExptSampledAggrResultArgs()
+}
+
+func newExperimentServiceGetExptSampledAggrResultResult() interface{} {
+	return expt.NewExperimentServiceGetExptSampledAggrResultResult()
+}
+
+func invalidateExptResultCacheHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*expt.ExperimentServiceInvalidateExptResultCacheArgs)
+	realResult := result.(*expt.ExperimentServiceInvalidateExptResultCacheResult)
+	success, err := handler.(expt.ExperimentService).InvalidateExptResultCache(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newExperimentServiceInvalidateExptResultCacheArgs() interface{} {
+	return expt.NewExperimentServiceInvalidateExptResultCacheArgs()
+}
+
+func newExperimentServiceInvalidateExptResultCacheResult() interface{} {
+	return expt.NewExperimentServiceInvalidateExptResultCacheResult()
+}
+
+func submitExptPairwiseRankHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*expt.ExperimentServiceSubmitExptPairwiseRankArgs)
+	realResult := result.(*expt.ExperimentServiceSubmitExptPairwiseRankResult)
+	success, err := handler.(expt.ExperimentService).SubmitExptPairwiseRank(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newExperimentServiceSubmitExptPairwiseRankArgs() interface{} {
+	return expt.NewExperimentServiceSubmitExptPairwiseRankArgs()
+}
+
+func newExperimentServiceSubmitExptPairwiseRankResult() interface{} {
+	return expt.NewExperimentServiceSubmitExptPairwiseRankResult()
+}
+
+func getExptPairwiseRankHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*expt.ExperimentServiceGetExptPairwiseRankArgs)
+	realResult := result.(*expt.ExperimentServiceGetExptPairwiseRankResult)
+	success, err := handler.(expt.ExperimentService).GetExptPairwiseRank(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newExperimentServiceGetExptPairwiseRankArgs() interface{} {
+	return expt.NewExperimentServiceGetExptPairwiseRankArgs()
+}
+
+func newExperimentServiceGetExptPairwiseRankResult() interface{} {
+	return expt.NewExperimentServiceGetExptPairwiseRankResult()
+}
+
 func createExperimentTemplateHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*expt.ExperimentServiceCreateExperimentTemplateArgs)
 	realResult := result.(*expt.ExperimentServiceCreateExperimentTemplateResult)
@@ -1598,6 +1806,86 @@ func (p *kClient) GetAnalysisRecordFeedbackVote(ctx context.Context, req *expt.G
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) CompareExperiments(ctx context.Context, req *expt.CompareExperimentsRequest) (r *expt.CompareExperimentsResponse, err error) {
+	var _args expt.ExperimentServiceCompareExperimentsArgs
+	_args.Req = req
+	var _result expt.ExperimentServiceCompareExperimentsResult
+	if err = p.c.Call(ctx, "CompareExperiments", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetExptRegressionReport(ctx context.Context, req *expt.GetExptRegressionReportRequest) (r *expt.GetExptRegressionReportResponse, err error) {
+	var _args expt.ExperimentServiceGetExptRegressionReportArgs
+	_args.Req = req
+	var _result expt.ExperimentServiceGetExptRegressionReportResult
+	if err = p.c.Call(ctx, "GetExptRegressionReport", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ExportExptRegressionReport(ctx context.Context, req *expt.ExportExptRegressionReportRequest) (r *expt.ExportExptRegressionReportResponse, err error) {
+	var _args expt.ExperimentServiceExportExptRegressionReportArgs
+	_args.Req = req
+	var _result expt.ExperimentServiceExportExptRegressionReportResult
+	if err = p.c.Call(ctx, "ExportExptRegressionReport", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetExptGroupedAggrResult_(ctx context.Context, req *expt.GetExptGroupedAggrResultRequest) (r *expt.GetExptGroupedAggrResultResponse, err error) {
+	var _args expt.ExperimentServiceGetExptGroupedAggrResultArgs
+	_args.Req = req
+	var _result expt.ExperimentServiceGetExptGroupedAggrResultResult
+	if err = p.c.Call(ctx, "GetExptGroupedAggrResult", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetExptSampledAggrResult_(ctx context.Context, req *expt.GetExptSampledAggrResultRequest) (r *expt.GetExptSampledAggrResultResponse, err error) {
+	var _args expt.ExperimentServiceGetExptSampledAggrResultArgs
+	_args.Req = req
+	var _result expt.ExperimentServiceGetExptSampledAggrResultResult
+	if err = p.c.Call(ctx, "GetExptSampledAggrResult", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) InvalidateExptResultCache(ctx context.Context, req *expt.InvalidateExptResultCacheRequest) (r *expt.InvalidateExptResultCacheResponse, err error) {
+	var _args expt.ExperimentServiceInvalidateExptResultCacheArgs
+	_args.Req = req
+	var _result expt.ExperimentServiceInvalidateExptResultCacheResult
+	if err = p.c.Call(ctx, "InvalidateExptResultCache", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SubmitExptPairwiseRank(ctx context.Context, req *expt.SubmitExptPairwiseRankRequest) (r *expt.SubmitExptPairwiseRankResponse, err error) {
+	var _args expt.ExperimentServiceSubmitExptPairwiseRankArgs
+	_args.Req = req
+	var _result expt.ExperimentServiceSubmitExptPairwiseRankResult
+	if err = p.c.Call(ctx, "SubmitExptPairwiseRank", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetExptPairwiseRank(ctx context.Context, req *expt.GetExptPairwiseRankRequest) (r *expt.GetExptPairwiseRankResponse, err error) {
+	var _args expt.ExperimentServiceGetExptPairwiseRankArgs
+	_args.Req = req
+	var _result expt.ExperimentServiceGetExptPairwiseRankResult
+	if err = p.c.Call(ctx, "GetExptPairwiseRank", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) CreateExperimentTemplate(ctx context.Context, req *expt.CreateExperimentTemplateRequest) (r *expt.CreateExperimentTemplateResponse, err error) {
 	var _args expt.ExperimentServiceCreateExperimentTemplateArgs
 	_args.Req = req
@@ -548,6 +548,90 @@ func (p *CreateExperimentRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 111:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField111(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 112:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField112(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 113:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField113(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 114:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField114(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 115:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField115(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 116:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField116(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 100:
 			if fieldTypeId == thrift.MAP {
 				l, err = p.FastReadField100(buf[offset:])
@@ -1157,6 +1241,78 @@ func (p *CreateExperimentRequest) FastReadField110(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *CreateExperimentRequest) FastReadField111(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptQualityGateConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.QualityGateConf = _field
+	return offset, nil
+}
+
+func (p *CreateExperimentRequest) FastReadField112(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptCostConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.CostConf = _field
+	return offset, nil
+}
+
+func (p *CreateExperimentRequest) FastReadField113(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptResultCacheConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.ResultCacheConf = _field
+	return offset, nil
+}
+
+func (p *CreateExperimentRequest) FastReadField114(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptSamplingConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.SamplingConf = _field
+	return offset, nil
+}
+
+func (p *CreateExperimentRequest) FastReadField115(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptTrialConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.TrialConf = _field
+	return offset, nil
+}
+
+func (p *CreateExperimentRequest) FastReadField116(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptUserSimulatorConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.UserSimulatorConf = _field
+	return offset, nil
+}
+
 func (p *CreateExperimentRequest) FastReadField100(buf []byte) (int, error) {
 	offset := 0
 
@@ -1255,6 +1411,12 @@ func (p *CreateExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWri
 		offset += p.fastWriteField80(buf[offset:], w)
 		offset += p.fastWriteField81(buf[offset:], w)
 		offset += p.fastWriteField110(buf[offset:], w)
+		offset += p.fastWriteField111(buf[offset:], w)
+		offset += p.fastWriteField112(buf[offset:], w)
+		offset += p.fastWriteField113(buf[offset:], w)
+		offset += p.fastWriteField114(buf[offset:], w)
+		offset += p.fastWriteField115(buf[offset:], w)
+		offset += p.fastWriteField116(buf[offset:], w)
 		offset += p.fastWriteField100(buf[offset:], w)
 		offset += p.fastWriteField200(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
@@ -1301,6 +1463,12 @@ func (p *CreateExperimentRequest) BLength() int {
 		l += p.field81Length()
 		l += p.field91Length()
 		l += p.field110Length()
+		l += p.field111Length()
+		l += p.field112Length()
+		l += p.field113Length()
+		l += p.field114Length()
+		l += p.field115Length()
+		l += p.field116Length()
 		l += p.field100Length()
 		l += p.field200Length()
 		l += p.field255Length()
@@ -1658,6 +1826,60 @@ func (p *CreateExperimentRequest) fastWriteField110(buf []byte, w thrift.NocopyW
 	return offset
 }
 
+func (p *CreateExperimentRequest) fastWriteField111(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetQualityGateConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 111)
+		offset += p.QualityGateConf.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CreateExperimentRequest) fastWriteField112(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCostConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 112)
+		offset += p.CostConf.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CreateExperimentRequest) fastWriteField113(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetResultCacheConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 113)
+		offset += p.ResultCacheConf.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CreateExperimentRequest) fastWriteField114(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSamplingConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 114)
+		offset += p.SamplingConf.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CreateExperimentRequest) fastWriteField115(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTrialConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 115)
+		offset += p.TrialConf.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CreateExperimentRequest) fastWriteField116(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetUserSimulatorConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 116)
+		offset += p.UserSimulatorConf.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *CreateExperimentRequest) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetExt() {
@@ -2022,6 +2244,60 @@ func (p *CreateExperimentRequest) field110Length() int {
 	return l
 }
 
+func (p *CreateExperimentRequest) field111Length() int {
+	l := 0
+	if p.IsSetQualityGateConf() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.QualityGateConf.BLength()
+	}
+	return l
+}
+
+func (p *CreateExperimentRequest) field112Length() int {
+	l := 0
+	if p.IsSetCostConf() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.CostConf.BLength()
+	}
+	return l
+}
+
+func (p *CreateExperimentRequest) field113Length() int {
+	l := 0
+	if p.IsSetResultCacheConf() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.ResultCacheConf.BLength()
+	}
+	return l
+}
+
+func (p *CreateExperimentRequest) field114Length() int {
+	l := 0
+	if p.IsSetSamplingConf() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.SamplingConf.BLength()
+	}
+	return l
+}
+
+func (p *CreateExperimentRequest) field115Length() int {
+	l := 0
+	if p.IsSetTrialConf() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.TrialConf.BLength()
+	}
+	return l
+}
+
+func (p *CreateExperimentRequest) field116Length() int {
+	l := 0
+	if p.IsSetUserSimulatorConf() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.UserSimulatorConf.BLength()
+	}
+	return l
+}
+
 func (p *CreateExperimentRequest) field100Length() int {
 	l := 0
 	if p.IsSetExt() {
@@ -2315,6 +2591,60 @@ func (p *CreateExperimentRequest) DeepCopy(s interface{}) error {
 	}
 	p.NotificationConf = _notificationConf
 
+	var _qualityGateConf *expt.ExptQualityGateConf
+	if src.QualityGateConf != nil {
+		_qualityGateConf = &expt.ExptQualityGateConf{}
+		if err := _qualityGateConf.DeepCopy(src.QualityGateConf); err != nil {
+			return err
+		}
+	}
+	p.QualityGateConf = _qualityGateConf
+
+	var _costConf *expt.ExptCostConf
+	if src.CostConf != nil {
+		_costConf = &expt.ExptCostConf{}
+		if err := _costConf.DeepCopy(src.CostConf); err != nil {
+			return err
+		}
+	}
+	p.CostConf = _costConf
+
+	var _resultCacheConf *expt.ExptResultCacheConf
+	if src.ResultCacheConf != nil {
+		_resultCacheConf = &expt.ExptResultCacheConf{}
+		if err := _resultCacheConf.DeepCopy(src.ResultCacheConf); err != nil {
+			return err
+		}
+	}
+	p.ResultCacheConf = _resultCacheConf
+
+	var _samplingConf *expt.ExptSamplingConf
+	if src.SamplingConf != nil {
+		_samplingConf = &expt.ExptSamplingConf{}
+		if err := _samplingConf.DeepCopy(src.SamplingConf); err != nil {
+			return err
+		}
+	}
+	p.SamplingConf = _samplingConf
+
+	var _trialConf *expt.ExptTrialConf
+	if src.TrialConf != nil {
+		_trialConf = &expt.ExptTrialConf{}
+		if err := _trialConf.DeepCopy(src.TrialConf); err != nil {
+			return err
+		}
+	}
+	p.TrialConf = _trialConf
+
+	var _userSimulatorConf *expt.ExptUserSimulatorConf
+	if src.UserSimulatorConf != nil {
+		_userSimulatorConf = &expt.ExptUserSimulatorConf{}
+		if err := _userSimulatorConf.DeepCopy(src.UserSimulatorConf); err != nil {
+			return err
+		}
+	}
+	p.UserSimulatorConf = _userSimulatorConf
+
 	if src.Ext != nil {
 		p.Ext = make(map[string]string, len(src.Ext))
 		for key, val := range src.Ext {
@@ -3074,6 +3404,90 @@ func (p *SubmitExperimentRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 111:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField111(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 112:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField112(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 113:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField113(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 114:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField114(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 115:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField115(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 116:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField116(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 200:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField200(buf[offset:])
@@ -3719,6 +4133,78 @@ func (p *SubmitExperimentRequest) FastReadField110(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *SubmitExperimentRequest) FastReadField111(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptQualityGateConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.QualityGateConf = _field
+	return offset, nil
+}
+
+func (p *SubmitExperimentRequest) FastReadField112(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptCostConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.CostConf = _field
+	return offset, nil
+}
+
+func (p *SubmitExperimentRequest) FastReadField113(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptResultCacheConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.ResultCacheConf = _field
+	return offset, nil
+}
+
+func (p *SubmitExperimentRequest) FastReadField114(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptSamplingConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.SamplingConf = _field
+	return offset, nil
+}
+
+func (p *SubmitExperimentRequest) FastReadField115(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptTrialConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.TrialConf = _field
+	return offset, nil
+}
+
+func (p *SubmitExperimentRequest) FastReadField116(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptUserSimulatorConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.UserSimulatorConf = _field
+	return offset, nil
+}
+
 func (p *SubmitExperimentRequest) FastReadField200(buf []byte) (int, error) {
 	offset := 0
 	_field := common.NewSession()
@@ -3788,6 +4274,12 @@ func (p *SubmitExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWri
 		offset += p.fastWriteField81(buf[offset:], w)
 		offset += p.fastWriteField100(buf[offset:], w)
 		offset += p.fastWriteField110(buf[offset:], w)
+		offset += p.fastWriteField111(buf[offset:], w)
+		offset += p.fastWriteField112(buf[offset:], w)
+		offset += p.fastWriteField113(buf[offset:], w)
+		offset += p.fastWriteField114(buf[offset:], w)
+		offset += p.fastWriteField115(buf[offset:], w)
+		offset += p.fastWriteField116(buf[offset:], w)
 		offset += p.fastWriteField200(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
@@ -3836,6 +4328,12 @@ func (p *SubmitExperimentRequest) BLength() int {
 		l += p.field100Length()
 		l += p.field91Length()
 		l += p.field110Length()
+		l += p.field111Length()
+		l += p.field112Length()
+		l += p.field113Length()
+		l += p.field114Length()
+		l += p.field115Length()
+		l += p.field116Length()
 		l += p.field200Length()
 		l += p.field255Length()
 	}
@@ -4226,6 +4724,60 @@ func (p *SubmitExperimentRequest) fastWriteField110(buf []byte, w thrift.NocopyW
 	return offset
 }
 
+func (p *SubmitExperimentRequest) fastWriteField111(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetQualityGateConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 111)
+		offset += p.QualityGateConf.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *SubmitExperimentRequest) fastWriteField112(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCostConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 112)
+		offset += p.CostConf.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *SubmitExperimentRequest) fastWriteField113(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetResultCacheConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 113)
+		offset += p.ResultCacheConf.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *SubmitExperimentRequest) fastWriteField114(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSamplingConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 114)
+		offset += p.SamplingConf.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *SubmitExperimentRequest) fastWriteField115(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTrialConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 115)
+		offset += p.TrialConf.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *SubmitExperimentRequest) fastWriteField116(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetUserSimulatorConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 116)
+		offset += p.UserSimulatorConf.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *SubmitExperimentRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSession() {
@@ -4606,6 +5158,60 @@ func (p *SubmitExperimentRequest) field110Length() int {
 	return l
 }
 
+func (p *SubmitExperimentRequest) field111Length() int {
+	l := 0
+	if p.IsSetQualityGateConf() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.QualityGateConf.BLength()
+	}
+	return l
+}
+
+func (p *SubmitExperimentRequest) field112Length() int {
+	l := 0
+	if p.IsSetCostConf() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.CostConf.BLength()
+	}
+	return l
+}
+
+func (p *SubmitExperimentRequest) field113Length() int {
+	l := 0
+	if p.IsSetResultCacheConf() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.ResultCacheConf.BLength()
+	}
+	return l
+}
+
+func (p *SubmitExperimentRequest) field114Length() int {
+	l := 0
+	if p.IsSetSamplingConf() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.SamplingConf.BLength()
+	}
+	return l
+}
+
+func (p *SubmitExperimentRequest) field115Length() int {
+	l := 0
+	if p.IsSetTrialConf() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.TrialConf.BLength()
+	}
+	return l
+}
+
+func (p *SubmitExperimentRequest) field116Length() int {
+	l := 0
+	if p.IsSetUserSimulatorConf() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.UserSimulatorConf.BLength()
+	}
+	return l
+}
+
 func (p *SubmitExperimentRequest) field200Length() int {
 	l := 0
 	if p.IsSetSession() {
@@ -4914,6 +5520,60 @@ func (p *SubmitExperimentRequest) DeepCopy(s interface{}) error {
 	}
 	p.NotificationConf = _notificationConf
 
+	var _qualityGateConf *expt.ExptQualityGateConf
+	if src.QualityGateConf != nil {
+		_qualityGateConf = &expt.ExptQualityGateConf{}
+		if err := _qualityGateConf.DeepCopy(src.QualityGateConf); err != nil {
+			return err
+		}
+	}
+	p.QualityGateConf = _qualityGateConf
+
+	var _costConf *expt.ExptCostConf
+	if src.CostConf != nil {
+		_costConf = &expt.ExptCostConf{}
+		if err := _costConf.DeepCopy(src.CostConf); err != nil {
+			return err
+		}
+	}
+	p.CostConf = _costConf
+
+	var _resultCacheConf *expt.ExptResultCacheConf
+	if src.ResultCacheConf != nil {
+		_resultCacheConf = &expt.ExptResultCacheConf{}
+		if err := _resultCacheConf.DeepCopy(src.ResultCacheConf); err != nil {
+			return err
+		}
+	}
+	p.ResultCacheConf = _resultCacheConf
+
+	var _samplingConf *expt.ExptSamplingConf
+	if src.SamplingConf != nil {
+		_samplingConf = &expt.ExptSamplingConf{}
+		if err := _samplingConf.DeepCopy(src.SamplingConf); err != nil {
+			return err
+		}
+	}
+	p.SamplingConf = _samplingConf
+
+	var _trialConf *expt.ExptTrialConf
+	if src.TrialConf != nil {
+		_trialConf = &expt.ExptTrialConf{}
+		if err := _trialConf.DeepCopy(src.TrialConf); err != nil {
+			return err
+		}
+	}
+	p.TrialConf = _trialConf
+
+	var _userSimulatorConf *expt.ExptUserSimulatorConf
+	if src.UserSimulatorConf != nil {
+		_userSimulatorConf = &expt.ExptUserSimulatorConf{}
+		if err := _userSimulatorConf.DeepCopy(src.UserSimulatorConf); err != nil {
+			return err
+		}
+	}
+	p.UserSimulatorConf = _userSimulatorConf
+
 	var _session *common.Session
 	if src.Session != nil {
 		_session = &common.Session{}
@@ -18849,6 +19509,20 @@ func (p *CreateExperimentTemplateRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 41:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField41(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 200:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField200(buf[offset:])
@@ -19041,6 +19715,18 @@ func (p *CreateExperimentTemplateRequest) FastReadField40(buf []byte) (int, erro
 	return offset, nil
 }
 
+func (p *CreateExperimentTemplateRequest) FastReadField41(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptQualityGateConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.QualityGateConf = _field
+	return offset, nil
+}
+
 func (p *CreateExperimentTemplateRequest) FastReadField200(buf []byte) (int, error) {
 	offset := 0
 	_field := common.NewSession()
@@ -19083,6 +19769,7 @@ func (p *CreateExperimentTemplateRequest) FastWriteNocopy(buf []byte, w thrift.N
 		offset += p.fastWriteField23(buf[offset:], w)
 		offset += p.fastWriteField30(buf[offset:], w)
 		offset += p.fastWriteField40(buf[offset:], w)
+		offset += p.fastWriteField41(buf[offset:], w)
 		offset += p.fastWriteField200(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
@@ -19104,6 +19791,7 @@ func (p *CreateExperimentTemplateRequest) BLength() int {
 		l += p.field24Length()
 		l += p.field30Length()
 		l += p.field40Length()
+		l += p.field41Length()
 		l += p.field200Length()
 		l += p.field255Length()
 	}
@@ -19208,6 +19896,15 @@ func (p *CreateExperimentTemplateRequest) fastWriteField40(buf []byte, w thrift.
 	return offset
 }
 
+func (p *CreateExperimentTemplateRequest) fastWriteField41(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetQualityGateConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 41)
+		offset += p.QualityGateConf.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *CreateExperimentTemplateRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSession() {
@@ -19323,6 +20020,15 @@ func (p *CreateExperimentTemplateRequest) field40Length() int {
 	return l
 }
 
+func (p *CreateExperimentTemplateRequest) field41Length() int {
+	l := 0
+	if p.IsSetQualityGateConf() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.QualityGateConf.BLength()
+	}
+	return l
+}
+
 func (p *CreateExperimentTemplateRequest) field200Length() int {
 	l := 0
 	if p.IsSetSession() {
@@ -19430,6 +20136,15 @@ func (p *CreateExperimentTemplateRequest) DeepCopy(s interface{}) error {
 	}
 	p.NotificationConf = _notificationConf
 
+	var _qualityGateConf *expt.ExptQualityGateConf
+	if src.QualityGateConf != nil {
+		_qualityGateConf = &expt.ExptQualityGateConf{}
+		if err := _qualityGateConf.DeepCopy(src.QualityGateConf); err != nil {
+			return err
+		}
+	}
+	p.QualityGateConf = _qualityGateConf
+
 	var _session *common.Session
 	if src.Session != nil {
 		_session = &common.Session{}
@@ -20713,6 +21428,20 @@ func (p *UpdateExperimentTemplateRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 41:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField41(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -20910,6 +21639,18 @@ func (p *UpdateExperimentTemplateRequest) FastReadField40(buf []byte) (int, erro
 	return offset, nil
 }
 
+func (p *UpdateExperimentTemplateRequest) FastReadField41(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptQualityGateConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.QualityGateConf = _field
+	return offset, nil
+}
+
 func (p *UpdateExperimentTemplateRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
@@ -20941,6 +21682,7 @@ func (p *UpdateExperimentTemplateRequest) FastWriteNocopy(buf []byte, w thrift.N
 		offset += p.fastWriteField23(buf[offset:], w)
 		offset += p.fastWriteField30(buf[offset:], w)
 		offset += p.fastWriteField40(buf[offset:], w)
+		offset += p.fastWriteField41(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
@@ -20962,6 +21704,7 @@ func (p *UpdateExperimentTemplateRequest) BLength() int {
 		l += p.field24Length()
 		l += p.field30Length()
 		l += p.field40Length()
+		l += p.field41Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
@@ -21072,6 +21815,15 @@ func (p *UpdateExperimentTemplateRequest) fastWriteField40(buf []byte, w thrift.
 	return offset
 }
 
+func (p *UpdateExperimentTemplateRequest) fastWriteField41(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetQualityGateConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 41)
+		offset += p.QualityGateConf.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *UpdateExperimentTemplateRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
@@ -21185,6 +21937,15 @@ func (p *UpdateExperimentTemplateRequest) field40Length() int {
 	return l
 }
 
+func (p *UpdateExperimentTemplateRequest) field41Length() int {
+	l := 0
+	if p.IsSetQualityGateConf() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.QualityGateConf.BLength()
+	}
+	return l
+}
+
 func (p *UpdateExperimentTemplateRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
@@ -21285,6 +22046,15 @@ func (p *UpdateExperimentTemplateRequest) DeepCopy(s interface{}) error {
 	}
 	p.NotificationConf = _notificationConf
 
+	var _qualityGateConf *expt.ExptQualityGateConf
+	if src.QualityGateConf != nil {
+		_qualityGateConf = &expt.ExptQualityGateConf{}
+		if err := _qualityGateConf.DeepCopy(src.QualityGateConf); err != nil {
+			return err
+		}
+	}
+	p.QualityGateConf = _qualityGateConf
+
 	var _base *base.Base
 	if src.Base != nil {
 		_base = &base.Base{}
@@ -23061,6 +23831,20 @@ func (p *SubmitExptFromTemplateRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 11:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField11(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 200:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField200(buf[offset:])
@@ -23172,6 +23956,18 @@ func (p *SubmitExptFromTemplateRequest) FastReadField10(buf []byte) (int, error)
 	return offset, nil
 }
 
+func (p *SubmitExptFromTemplateRequest) FastReadField11(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptQualityGateConf()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.QualityGateConf = _field
+	return offset, nil
+}
+
 func (p *SubmitExptFromTemplateRequest) FastReadField200(buf []byte) (int, error) {
 	offset := 0
 	_field := common.NewSession()
@@ -23207,6 +24003,7 @@ func (p *SubmitExptFromTemplateRequest) FastWriteNocopy(buf []byte, w thrift.Noc
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField10(buf[offset:], w)
+		offset += p.fastWriteField11(buf[offset:], w)
 		offset += p.fastWriteField200(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
@@ -23221,6 +24018,7 @@ func (p *SubmitExptFromTemplateRequest) BLength() int {
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field10Length()
+		l += p.field11Length()
 		l += p.field200Length()
 		l += p.field255Length()
 	}
@@ -23260,6 +24058,15 @@ func (p *SubmitExptFromTemplateRequest) fastWriteField10(buf []byte, w thrift.No
 	return offset
 }
 
+func (p *SubmitExptFromTemplateRequest) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetQualityGateConf() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 11)
+		offset += p.QualityGateConf.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *SubmitExptFromTemplateRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSession() {
@@ -23310,6 +24117,15 @@ func (p *SubmitExptFromTemplateRequest) field10Length() int {
 	return l
 }
 
+func (p *SubmitExptFromTemplateRequest) field11Length() int {
+	l := 0
+	if p.IsSetQualityGateConf() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.QualityGateConf.BLength()
+	}
+	return l
+}
+
 func (p *SubmitExptFromTemplateRequest) field200Length() int {
 	l := 0
 	if p.IsSetSession() {
@@ -23355,6 +24171,15 @@ func (p *SubmitExptFromTemplateRequest) DeepCopy(s interface{}) error {
 	}
 	p.NotificationConf = _notificationConf
 
+	var _qualityGateConf *expt.ExptQualityGateConf
+	if src.QualityGateConf != nil {
+		_qualityGateConf = &expt.ExptQualityGateConf{}
+		if err := _qualityGateConf.DeepCopy(src.QualityGateConf); err != nil {
+			return err
+		}
+	}
+	p.QualityGateConf = _qualityGateConf
+
 	var _session *common.Session
 	if src.Session != nil {
 		_session = &common.Session{}
@@ -28414,7 +29239,7 @@ func (p *GetExptResultExportRecordResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, error) {
+func (p *SubmitExptPairwiseRankRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -28422,8 +29247,8 @@ func (p *GetExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, error)
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetWorkspaceID bool = false
-	var issetExptID bool = false
-	var issetInsightAnalysisRecordID bool = false
+	var issetExptIds bool = false
+	var issetEvaluatorVersionID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -28450,13 +29275,13 @@ func (p *GetExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, error)
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetExptID = true
+				issetExptIds = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -28471,7 +29296,63 @@ func (p *GetExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, error)
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetInsightAnalysisRecordID = true
+				issetEvaluatorVersionID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -28521,12 +29402,12 @@ func (p *GetExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, error)
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetExptID {
+	if !issetExptIds {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetInsightAnalysisRecordID {
+	if !issetEvaluatorVersionID {
 		fieldId = 3
 		goto RequiredFieldNotSetError
 	}
@@ -28534,14 +29415,14 @@ func (p *GetExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetExptInsightAnalysisRecordRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SubmitExptPairwiseRankRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetExptInsightAnalysisRecordRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_SubmitExptPairwiseRankRequest[fieldId]))
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) FastReadField1(buf []byte) (int, error) {
+func (p *SubmitExptPairwiseRankRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -28555,7 +29436,31 @@ func (p *GetExptInsightAnalysisRecordRequest) FastReadField1(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) FastReadField2(buf []byte) (int, error) {
+func (p *SubmitExptPairwiseRankRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ExptIds = _field
+	return offset, nil
+}
+
+func (p *SubmitExptPairwiseRankRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -28565,25 +29470,67 @@ func (p *GetExptInsightAnalysisRecordRequest) FastReadField2(buf []byte) (int, e
 		offset += l
 		_field = v
 	}
-	p.ExptID = _field
+	p.EvaluatorVersionID = _field
 	return offset, nil
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) FastReadField3(buf []byte) (int, error) {
+func (p *SubmitExptPairwiseRankRequest) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.InsightAnalysisRecordID = _field
+	p.OutputFieldKey = _field
 	return offset, nil
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) FastReadField200(buf []byte) (int, error) {
+func (p *SubmitExptPairwiseRankRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.MaxTurnCnt = _field
+	return offset, nil
+}
+
+func (p *SubmitExptPairwiseRankRequest) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.EloK = _field
+	return offset, nil
+}
+
+func (p *SubmitExptPairwiseRankRequest) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Concurrency = _field
+	return offset, nil
+}
+
+func (p *SubmitExptPairwiseRankRequest) FastReadField200(buf []byte) (int, error) {
 	offset := 0
 	_field := common.NewSession()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -28595,7 +29542,7 @@ func (p *GetExptInsightAnalysisRecordRequest) FastReadField200(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) FastReadField255(buf []byte) (int, error) {
+func (p *SubmitExptPairwiseRankRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -28607,16 +29554,20 @@ func (p *GetExptInsightAnalysisRecordRequest) FastReadField255(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) FastWrite(buf []byte) int {
+func (p *SubmitExptPairwiseRankRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *SubmitExptPairwiseRankRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField200(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
@@ -28624,12 +29575,16 @@ func (p *GetExptInsightAnalysisRecordRequest) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) BLength() int {
+func (p *SubmitExptPairwiseRankRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
 		l += p.field200Length()
 		l += p.field255Length()
 	}
@@ -28637,28 +29592,71 @@ func (p *GetExptInsightAnalysisRecordRequest) BLength() int {
 	return l
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *SubmitExptPairwiseRankRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *SubmitExptPairwiseRankRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.ExptIds {
+		length++
+		offset += thrift.Binary.WriteI64(buf[offset:], v)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
 	return offset
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *SubmitExptPairwiseRankRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.InsightAnalysisRecordID)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorVersionID)
 	return offset
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+func (p *SubmitExptPairwiseRankRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetOutputFieldKey() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.OutputFieldKey)
+	}
+	return offset
+}
+
+func (p *SubmitExptPairwiseRankRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMaxTurnCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 5)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.MaxTurnCnt)
+	}
+	return offset
+}
+
+func (p *SubmitExptPairwiseRankRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEloK() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 6)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.EloK)
+	}
+	return offset
+}
+
+func (p *SubmitExptPairwiseRankRequest) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetConcurrency() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 7)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.Concurrency)
+	}
+	return offset
+}
+
+func (p *SubmitExptPairwiseRankRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSession() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
@@ -28667,7 +29665,7 @@ func (p *GetExptInsightAnalysisRecordRequest) fastWriteField200(buf []byte, w th
 	return offset
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *SubmitExptPairwiseRankRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -28676,28 +29674,66 @@ func (p *GetExptInsightAnalysisRecordRequest) fastWriteField255(buf []byte, w th
 	return offset
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) field1Length() int {
+func (p *SubmitExptPairwiseRankRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) field2Length() int {
+func (p *SubmitExptPairwiseRankRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	l += thrift.Binary.ListBeginLength()
+	l +=
+		thrift.Binary.I64Length() * len(p.ExptIds)
 	return l
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) field3Length() int {
+func (p *SubmitExptPairwiseRankRequest) field3Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) field200Length() int {
+func (p *SubmitExptPairwiseRankRequest) field4Length() int {
+	l := 0
+	if p.IsSetOutputFieldKey() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.OutputFieldKey)
+	}
+	return l
+}
+
+func (p *SubmitExptPairwiseRankRequest) field5Length() int {
+	l := 0
+	if p.IsSetMaxTurnCnt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *SubmitExptPairwiseRankRequest) field6Length() int {
+	l := 0
+	if p.IsSetEloK() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *SubmitExptPairwiseRankRequest) field7Length() int {
+	l := 0
+	if p.IsSetConcurrency() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *SubmitExptPairwiseRankRequest) field200Length() int {
 	l := 0
 	if p.IsSetSession() {
 		l += thrift.Binary.FieldBeginLength()
@@ -28706,7 +29742,7 @@ func (p *GetExptInsightAnalysisRecordRequest) field200Length() int {
 	return l
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) field255Length() int {
+func (p *SubmitExptPairwiseRankRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -28715,17 +29751,47 @@ func (p *GetExptInsightAnalysisRecordRequest) field255Length() int {
 	return l
 }
 
-func (p *GetExptInsightAnalysisRecordRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*GetExptInsightAnalysisRecordRequest)
+func (p *SubmitExptPairwiseRankRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*SubmitExptPairwiseRankRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
 	p.WorkspaceID = src.WorkspaceID
 
-	p.ExptID = src.ExptID
+	if src.ExptIds != nil {
+		p.ExptIds = make([]int64, 0, len(src.ExptIds))
+		for _, elem := range src.ExptIds {
+			var _elem int64
+			_elem = elem
+			p.ExptIds = append(p.ExptIds, _elem)
+		}
+	}
 
-	p.InsightAnalysisRecordID = src.InsightAnalysisRecordID
+	p.EvaluatorVersionID = src.EvaluatorVersionID
+
+	if src.OutputFieldKey != nil {
+		var tmp string
+		if *src.OutputFieldKey != "" {
+			tmp = kutils.StringDeepCopy(*src.OutputFieldKey)
+		}
+		p.OutputFieldKey = &tmp
+	}
+
+	if src.MaxTurnCnt != nil {
+		tmp := *src.MaxTurnCnt
+		p.MaxTurnCnt = &tmp
+	}
+
+	if src.EloK != nil {
+		tmp := *src.EloK
+		p.EloK = &tmp
+	}
+
+	if src.Concurrency != nil {
+		tmp := *src.Concurrency
+		p.Concurrency = &tmp
+	}
 
 	var _session *common.Session
 	if src.Session != nil {
@@ -28748,13 +29814,14 @@ func (p *GetExptInsightAnalysisRecordRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *GetExptInsightAnalysisRecordResponse) FastRead(buf []byte) (int, error) {
+func (p *SubmitExptPairwiseRankResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetRecordID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -28766,12 +29833,13 @@ func (p *GetExptInsightAnalysisRecordResponse) FastRead(buf []byte) (int, error)
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetRecordID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -28802,28 +29870,36 @@ func (p *GetExptInsightAnalysisRecordResponse) FastRead(buf []byte) (int, error)
 		}
 	}
 
+	if !issetRecordID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetExptInsightAnalysisRecordResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SubmitExptPairwiseRankResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_SubmitExptPairwiseRankResponse[fieldId]))
 }
 
-func (p *GetExptInsightAnalysisRecordResponse) FastReadField1(buf []byte) (int, error) {
+func (p *SubmitExptPairwiseRankResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := expt.NewExptInsightAnalysisRecord()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = v
 	}
-	p.ExptInsightAnalysisRecord = _field
+	p.RecordID = _field
 	return offset, nil
 }
 
-func (p *GetExptInsightAnalysisRecordResponse) FastReadField255(buf []byte) (int, error) {
+func (p *SubmitExptPairwiseRankResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -28835,11 +29911,11 @@ func (p *GetExptInsightAnalysisRecordResponse) FastReadField255(buf []byte) (int
 	return offset, nil
 }
 
-func (p *GetExptInsightAnalysisRecordResponse) FastWrite(buf []byte) int {
+func (p *SubmitExptPairwiseRankResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *GetExptInsightAnalysisRecordResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *SubmitExptPairwiseRankResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -28849,7 +29925,7 @@ func (p *GetExptInsightAnalysisRecordResponse) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *GetExptInsightAnalysisRecordResponse) BLength() int {
+func (p *SubmitExptPairwiseRankResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -28859,52 +29935,41 @@ func (p *GetExptInsightAnalysisRecordResponse) BLength() int {
 	return l
 }
 
-func (p *GetExptInsightAnalysisRecordResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *SubmitExptPairwiseRankResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetExptInsightAnalysisRecord() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-		offset += p.ExptInsightAnalysisRecord.FastWriteNocopy(buf[offset:], w)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.RecordID)
 	return offset
 }
 
-func (p *GetExptInsightAnalysisRecordResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *SubmitExptPairwiseRankResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *GetExptInsightAnalysisRecordResponse) field1Length() int {
+func (p *SubmitExptPairwiseRankResponse) field1Length() int {
 	l := 0
-	if p.IsSetExptInsightAnalysisRecord() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.ExptInsightAnalysisRecord.BLength()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *GetExptInsightAnalysisRecordResponse) field255Length() int {
+func (p *SubmitExptPairwiseRankResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *GetExptInsightAnalysisRecordResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*GetExptInsightAnalysisRecordResponse)
+func (p *SubmitExptPairwiseRankResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*SubmitExptPairwiseRankResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _exptInsightAnalysisRecord *expt.ExptInsightAnalysisRecord
-	if src.ExptInsightAnalysisRecord != nil {
-		_exptInsightAnalysisRecord = &expt.ExptInsightAnalysisRecord{}
-		if err := _exptInsightAnalysisRecord.DeepCopy(src.ExptInsightAnalysisRecord); err != nil {
-			return err
-		}
-	}
-	p.ExptInsightAnalysisRecord = _exptInsightAnalysisRecord
+	p.RecordID = src.RecordID
 
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
@@ -28918,7 +29983,7 @@ func (p *GetExptInsightAnalysisRecordResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *InsightAnalysisExperimentRequest) FastRead(buf []byte) (int, error) {
+func (p *GetExptPairwiseRankRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -28926,7 +29991,7 @@ func (p *InsightAnalysisExperimentRequest) FastRead(buf []byte) (int, error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetWorkspaceID bool = false
-	var issetExptID bool = false
+	var issetRecordID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -28959,7 +30024,7 @@ func (p *InsightAnalysisExperimentRequest) FastRead(buf []byte) (int, error) {
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetExptID = true
+				issetRecordID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -29009,7 +30074,7 @@ func (p *InsightAnalysisExperimentRequest) FastRead(buf []byte) (int, error) {
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetExptID {
+	if !issetRecordID {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
@@ -29017,14 +30082,14 @@ func (p *InsightAnalysisExperimentRequest) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InsightAnalysisExperimentRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetExptPairwiseRankRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_InsightAnalysisExperimentRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetExptPairwiseRankRequest[fieldId]))
 }
 
-func (p *InsightAnalysisExperimentRequest) FastReadField1(buf []byte) (int, error) {
+func (p *GetExptPairwiseRankRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -29038,7 +30103,7 @@ func (p *InsightAnalysisExperimentRequest) FastReadField1(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *InsightAnalysisExperimentRequest) FastReadField2(buf []byte) (int, error) {
+func (p *GetExptPairwiseRankRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -29048,11 +30113,11 @@ func (p *InsightAnalysisExperimentRequest) FastReadField2(buf []byte) (int, erro
 		offset += l
 		_field = v
 	}
-	p.ExptID = _field
+	p.RecordID = _field
 	return offset, nil
 }
 
-func (p *InsightAnalysisExperimentRequest) FastReadField200(buf []byte) (int, error) {
+func (p *GetExptPairwiseRankRequest) FastReadField200(buf []byte) (int, error) {
 	offset := 0
 	_field := common.NewSession()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -29064,7 +30129,7 @@ func (p *InsightAnalysisExperimentRequest) FastReadField200(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *InsightAnalysisExperimentRequest) FastReadField255(buf []byte) (int, error) {
+func (p *GetExptPairwiseRankRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -29076,11 +30141,11 @@ func (p *InsightAnalysisExperimentRequest) FastReadField255(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *InsightAnalysisExperimentRequest) FastWrite(buf []byte) int {
+func (p *GetExptPairwiseRankRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *InsightAnalysisExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptPairwiseRankRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -29092,7 +30157,7 @@ func (p *InsightAnalysisExperimentRequest) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *InsightAnalysisExperimentRequest) BLength() int {
+func (p *GetExptPairwiseRankRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -29104,21 +30169,21 @@ func (p *InsightAnalysisExperimentRequest) BLength() int {
 	return l
 }
 
-func (p *InsightAnalysisExperimentRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptPairwiseRankRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *InsightAnalysisExperimentRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptPairwiseRankRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.RecordID)
 	return offset
 }
 
-func (p *InsightAnalysisExperimentRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptPairwiseRankRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSession() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
@@ -29127,7 +30192,7 @@ func (p *InsightAnalysisExperimentRequest) fastWriteField200(buf []byte, w thrif
 	return offset
 }
 
-func (p *InsightAnalysisExperimentRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptPairwiseRankRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -29136,21 +30201,21 @@ func (p *InsightAnalysisExperimentRequest) fastWriteField255(buf []byte, w thrif
 	return offset
 }
 
-func (p *InsightAnalysisExperimentRequest) field1Length() int {
+func (p *GetExptPairwiseRankRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *InsightAnalysisExperimentRequest) field2Length() int {
+func (p *GetExptPairwiseRankRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *InsightAnalysisExperimentRequest) field200Length() int {
+func (p *GetExptPairwiseRankRequest) field200Length() int {
 	l := 0
 	if p.IsSetSession() {
 		l += thrift.Binary.FieldBeginLength()
@@ -29159,7 +30224,7 @@ func (p *InsightAnalysisExperimentRequest) field200Length() int {
 	return l
 }
 
-func (p *InsightAnalysisExperimentRequest) field255Length() int {
+func (p *GetExptPairwiseRankRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -29168,15 +30233,15 @@ func (p *InsightAnalysisExperimentRequest) field255Length() int {
 	return l
 }
 
-func (p *InsightAnalysisExperimentRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*InsightAnalysisExperimentRequest)
+func (p *GetExptPairwiseRankRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetExptPairwiseRankRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
 	p.WorkspaceID = src.WorkspaceID
 
-	p.ExptID = src.ExptID
+	p.RecordID = src.RecordID
 
 	var _session *common.Session
 	if src.Session != nil {
@@ -29199,14 +30264,13 @@ func (p *InsightAnalysisExperimentRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *InsightAnalysisExperimentResponse) FastRead(buf []byte) (int, error) {
+func (p *GetExptPairwiseRankResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetInsightAnalysisRecordID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -29218,13 +30282,12 @@ func (p *InsightAnalysisExperimentResponse) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetInsightAnalysisRecordID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -29255,36 +30318,28 @@ func (p *InsightAnalysisExperimentResponse) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetInsightAnalysisRecordID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InsightAnalysisExperimentResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetExptPairwiseRankResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_InsightAnalysisExperimentResponse[fieldId]))
 }
 
-func (p *InsightAnalysisExperimentResponse) FastReadField1(buf []byte) (int, error) {
+func (p *GetExptPairwiseRankResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_field := expt.NewExptPairwiseRankRecord()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
 	}
-	p.InsightAnalysisRecordID = _field
+	p.Record = _field
 	return offset, nil
 }
 
-func (p *InsightAnalysisExperimentResponse) FastReadField255(buf []byte) (int, error) {
+func (p *GetExptPairwiseRankResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -29296,11 +30351,11 @@ func (p *InsightAnalysisExperimentResponse) FastReadField255(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *InsightAnalysisExperimentResponse) FastWrite(buf []byte) int {
+func (p *GetExptPairwiseRankResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *InsightAnalysisExperimentResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptPairwiseRankResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -29310,7 +30365,7 @@ func (p *InsightAnalysisExperimentResponse) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *InsightAnalysisExperimentResponse) BLength() int {
+func (p *GetExptPairwiseRankResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -29320,41 +30375,52 @@ func (p *InsightAnalysisExperimentResponse) BLength() int {
 	return l
 }
 
-func (p *InsightAnalysisExperimentResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptPairwiseRankResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.InsightAnalysisRecordID)
+	if p.IsSetRecord() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Record.FastWriteNocopy(buf[offset:], w)
+	}
 	return offset
 }
 
-func (p *InsightAnalysisExperimentResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptPairwiseRankResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *InsightAnalysisExperimentResponse) field1Length() int {
+func (p *GetExptPairwiseRankResponse) field1Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetRecord() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Record.BLength()
+	}
 	return l
 }
 
-func (p *InsightAnalysisExperimentResponse) field255Length() int {
+func (p *GetExptPairwiseRankResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *InsightAnalysisExperimentResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*InsightAnalysisExperimentResponse)
+func (p *GetExptPairwiseRankResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetExptPairwiseRankResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.InsightAnalysisRecordID = src.InsightAnalysisRecordID
+	var _record *expt.ExptPairwiseRankRecord
+	if src.Record != nil {
+		_record = &expt.ExptPairwiseRankRecord{}
+		if err := _record.DeepCopy(src.Record); err != nil {
+			return err
+		}
+	}
+	p.Record = _record
 
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
@@ -29368,7 +30434,7 @@ func (p *InsightAnalysisExperimentResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, error) {
+func (p *CompareExperimentsRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -29376,7 +30442,8 @@ func (p *ListExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, error)
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetWorkspaceID bool = false
-	var issetExptID bool = false
+	var issetBaseExptID bool = false
+	var issetCandidateExptIds bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -29409,7 +30476,7 @@ func (p *ListExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, error)
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetExptID = true
+				issetBaseExptID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -29418,12 +30485,13 @@ func (p *ListExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, error)
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetCandidateExptIds = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -29432,7 +30500,7 @@ func (p *ListExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, error)
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.DOUBLE {
 				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
@@ -29445,9 +30513,37 @@ func (p *ListExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, error)
 					goto SkipFieldError
 				}
 			}
-		case 200:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField200(buf[offset:])
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField7(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -29487,22 +30583,27 @@ func (p *ListExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, error)
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetExptID {
+	if !issetBaseExptID {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetCandidateExptIds {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExptInsightAnalysisRecordRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompareExperimentsRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListExptInsightAnalysisRecordRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_CompareExperimentsRequest[fieldId]))
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) FastReadField1(buf []byte) (int, error) {
+func (p *CompareExperimentsRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -29516,7 +30617,7 @@ func (p *ListExptInsightAnalysisRecordRequest) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) FastReadField2(buf []byte) (int, error) {
+func (p *CompareExperimentsRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -29526,25 +30627,49 @@ func (p *ListExptInsightAnalysisRecordRequest) FastReadField2(buf []byte) (int,
 		offset += l
 		_field = v
 	}
-	p.ExptID = _field
+	p.BaseExptID = _field
 	return offset, nil
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) FastReadField3(buf []byte) (int, error) {
+func (p *CompareExperimentsRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.CandidateExptIds = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentsRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.PageNumber = _field
+	p.Confidence = _field
 	return offset, nil
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) FastReadField4(buf []byte) (int, error) {
+func (p *CompareExperimentsRequest) FastReadField5(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int32
@@ -29554,23 +30679,39 @@ func (p *ListExptInsightAnalysisRecordRequest) FastReadField4(buf []byte) (int,
 		offset += l
 		_field = &v
 	}
-	p.PageSize = _field
+	p.BootstrapIterations = _field
 	return offset, nil
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) FastReadField200(buf []byte) (int, error) {
+func (p *CompareExperimentsRequest) FastReadField6(buf []byte) (int, error) {
 	offset := 0
-	_field := common.NewSession()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.Session = _field
+	p.Seed = _field
 	return offset, nil
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) FastReadField255(buf []byte) (int, error) {
+func (p *CompareExperimentsRequest) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TieThreshold = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentsRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -29582,80 +30723,107 @@ func (p *ListExptInsightAnalysisRecordRequest) FastReadField255(buf []byte) (int
 	return offset, nil
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) FastWrite(buf []byte) int {
+func (p *CompareExperimentsRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompareExperimentsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) BLength() int {
+func (p *CompareExperimentsRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
-		l += p.field200Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompareExperimentsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompareExperimentsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.BaseExptID)
 	return offset
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompareExperimentsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPageNumber() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageNumber)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.CandidateExptIds {
+		length++
+		offset += thrift.Binary.WriteI64(buf[offset:], v)
 	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
 	return offset
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompareExperimentsRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPageSize() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
+	if p.IsSetConfidence() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 4)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Confidence)
 	}
 	return offset
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompareExperimentsRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSession() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
-		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetBootstrapIterations() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 5)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.BootstrapIterations)
 	}
 	return offset
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompareExperimentsRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSeed() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 6)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.Seed)
+	}
+	return offset
+}
+
+func (p *CompareExperimentsRequest) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTieThreshold() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 7)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.TieThreshold)
+	}
+	return offset
+}
+
+func (p *CompareExperimentsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -29664,48 +30832,66 @@ func (p *ListExptInsightAnalysisRecordRequest) fastWriteField255(buf []byte, w t
 	return offset
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) field1Length() int {
+func (p *CompareExperimentsRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) field2Length() int {
+func (p *CompareExperimentsRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) field3Length() int {
+func (p *CompareExperimentsRequest) field3Length() int {
 	l := 0
-	if p.IsSetPageNumber() {
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	l +=
+		thrift.Binary.I64Length() * len(p.CandidateExptIds)
+	return l
+}
+
+func (p *CompareExperimentsRequest) field4Length() int {
+	l := 0
+	if p.IsSetConfidence() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) field4Length() int {
+func (p *CompareExperimentsRequest) field5Length() int {
 	l := 0
-	if p.IsSetPageSize() {
+	if p.IsSetBootstrapIterations() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) field200Length() int {
+func (p *CompareExperimentsRequest) field6Length() int {
 	l := 0
-	if p.IsSetSession() {
+	if p.IsSetSeed() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Session.BLength()
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) field255Length() int {
+func (p *CompareExperimentsRequest) field7Length() int {
+	l := 0
+	if p.IsSetTieThreshold() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *CompareExperimentsRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -29714,34 +30900,44 @@ func (p *ListExptInsightAnalysisRecordRequest) field255Length() int {
 	return l
 }
 
-func (p *ListExptInsightAnalysisRecordRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*ListExptInsightAnalysisRecordRequest)
+func (p *CompareExperimentsRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*CompareExperimentsRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
 	p.WorkspaceID = src.WorkspaceID
 
-	p.ExptID = src.ExptID
+	p.BaseExptID = src.BaseExptID
 
-	if src.PageNumber != nil {
-		tmp := *src.PageNumber
-		p.PageNumber = &tmp
+	if src.CandidateExptIds != nil {
+		p.CandidateExptIds = make([]int64, 0, len(src.CandidateExptIds))
+		for _, elem := range src.CandidateExptIds {
+			var _elem int64
+			_elem = elem
+			p.CandidateExptIds = append(p.CandidateExptIds, _elem)
+		}
 	}
 
-	if src.PageSize != nil {
-		tmp := *src.PageSize
-		p.PageSize = &tmp
+	if src.Confidence != nil {
+		tmp := *src.Confidence
+		p.Confidence = &tmp
 	}
 
-	var _session *common.Session
-	if src.Session != nil {
-		_session = &common.Session{}
-		if err := _session.DeepCopy(src.Session); err != nil {
-			return err
-		}
+	if src.BootstrapIterations != nil {
+		tmp := *src.BootstrapIterations
+		p.BootstrapIterations = &tmp
+	}
+
+	if src.Seed != nil {
+		tmp := *src.Seed
+		p.Seed = &tmp
+	}
+
+	if src.TieThreshold != nil {
+		tmp := *src.TieThreshold
+		p.TieThreshold = &tmp
 	}
-	p.Session = _session
 
 	var _base *base.Base
 	if src.Base != nil {
@@ -29755,14 +30951,13 @@ func (p *ListExptInsightAnalysisRecordRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ListExptInsightAnalysisRecordResponse) FastRead(buf []byte) (int, error) {
+func (p *CompareExperimentsResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetExptInsightAnalysisRecords bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -29774,13 +30969,12 @@ func (p *ListExptInsightAnalysisRecordResponse) FastRead(buf []byte) (int, error
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetExptInsightAnalysisRecords = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -29788,9 +30982,9 @@ func (p *ListExptInsightAnalysisRecordResponse) FastRead(buf []byte) (int, error
 					goto SkipFieldError
 				}
 			}
-		case 20:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField20(buf[offset:])
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -29825,22 +31019,30 @@ func (p *ListExptInsightAnalysisRecordResponse) FastRead(buf []byte) (int, error
 		}
 	}
 
-	if !issetExptInsightAnalysisRecords {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExptInsightAnalysisRecordResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompareExperimentsResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListExptInsightAnalysisRecordResponse[fieldId]))
 }
 
-func (p *ListExptInsightAnalysisRecordResponse) FastReadField1(buf []byte) (int, error) {
+func (p *CompareExperimentsResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.BaseExptID = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentsResponse) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -29848,8 +31050,8 @@ func (p *ListExptInsightAnalysisRecordResponse) FastReadField1(buf []byte) (int,
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*expt.ExptInsightAnalysisRecord, 0, size)
-	values := make([]expt.ExptInsightAnalysisRecord, size)
+	_field := make([]*expt.ExptPairSignificance, 0, size)
+	values := make([]expt.ExptPairSignificance, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -29861,25 +31063,11 @@ func (p *ListExptInsightAnalysisRecordResponse) FastReadField1(buf []byte) (int,
 
 		_field = append(_field, _elem)
 	}
-	p.ExptInsightAnalysisRecords = _field
-	return offset, nil
-}
-
-func (p *ListExptInsightAnalysisRecordResponse) FastReadField20(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Total = _field
+	p.Comparisons = _field
 	return offset, nil
 }
 
-func (p *ListExptInsightAnalysisRecordResponse) FastReadField255(buf []byte) (int, error) {
+func (p *CompareExperimentsResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -29891,115 +31079,119 @@ func (p *ListExptInsightAnalysisRecordResponse) FastReadField255(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ListExptInsightAnalysisRecordResponse) FastWrite(buf []byte) int {
+func (p *CompareExperimentsResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ListExptInsightAnalysisRecordResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompareExperimentsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField20(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ListExptInsightAnalysisRecordResponse) BLength() int {
+func (p *CompareExperimentsResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field20Length()
+		l += p.field2Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ListExptInsightAnalysisRecordResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompareExperimentsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
-	listBeginOffset := offset
-	offset += thrift.Binary.ListBeginLength()
-	var length int
-	for _, v := range p.ExptInsightAnalysisRecords {
-		length++
-		offset += v.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetBaseExptID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.BaseExptID)
 	}
-	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	return offset
 }
 
-func (p *ListExptInsightAnalysisRecordResponse) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompareExperimentsResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTotal() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 20)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.Total)
+	if p.IsSetComparisons() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Comparisons {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *ListExptInsightAnalysisRecordResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompareExperimentsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ListExptInsightAnalysisRecordResponse) field1Length() int {
+func (p *CompareExperimentsResponse) field1Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.ListBeginLength()
-	for _, v := range p.ExptInsightAnalysisRecords {
-		_ = v
-		l += v.BLength()
+	if p.IsSetBaseExptID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *ListExptInsightAnalysisRecordResponse) field20Length() int {
+func (p *CompareExperimentsResponse) field2Length() int {
 	l := 0
-	if p.IsSetTotal() {
+	if p.IsSetComparisons() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Comparisons {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *ListExptInsightAnalysisRecordResponse) field255Length() int {
+func (p *CompareExperimentsResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *ListExptInsightAnalysisRecordResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*ListExptInsightAnalysisRecordResponse)
+func (p *CompareExperimentsResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*CompareExperimentsResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.ExptInsightAnalysisRecords != nil {
-		p.ExptInsightAnalysisRecords = make([]*expt.ExptInsightAnalysisRecord, 0, len(src.ExptInsightAnalysisRecords))
-		for _, elem := range src.ExptInsightAnalysisRecords {
-			var _elem *expt.ExptInsightAnalysisRecord
+	if src.BaseExptID != nil {
+		tmp := *src.BaseExptID
+		p.BaseExptID = &tmp
+	}
+
+	if src.Comparisons != nil {
+		p.Comparisons = make([]*expt.ExptPairSignificance, 0, len(src.Comparisons))
+		for _, elem := range src.Comparisons {
+			var _elem *expt.ExptPairSignificance
 			if elem != nil {
-				_elem = &expt.ExptInsightAnalysisRecord{}
+				_elem = &expt.ExptPairSignificance{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
 			}
 
-			p.ExptInsightAnalysisRecords = append(p.ExptInsightAnalysisRecords, _elem)
+			p.Comparisons = append(p.Comparisons, _elem)
 		}
 	}
 
-	if src.Total != nil {
-		tmp := *src.Total
-		p.Total = &tmp
-	}
-
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
 		_baseResp = &base.BaseResp{}
@@ -30012,7 +31204,7 @@ func (p *ListExptInsightAnalysisRecordResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, error) {
+func (p *GetExptRegressionReportRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -30020,8 +31212,8 @@ func (p *DeleteExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, erro
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetWorkspaceID bool = false
-	var issetExptID bool = false
-	var issetInsightAnalysisRecordID bool = false
+	var issetBaseExptID bool = false
+	var issetCandidateExptIds bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -30054,7 +31246,7 @@ func (p *DeleteExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, erro
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetExptID = true
+				issetBaseExptID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -30063,13 +31255,13 @@ func (p *DeleteExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, erro
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetInsightAnalysisRecordID = true
+				issetCandidateExptIds = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -30077,9 +31269,79 @@ func (p *DeleteExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, erro
 					goto SkipFieldError
 				}
 			}
-		case 200:
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
 			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField200(buf[offset:])
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField9(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -30119,12 +31381,12 @@ func (p *DeleteExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, erro
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetExptID {
+	if !issetBaseExptID {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetInsightAnalysisRecordID {
+	if !issetCandidateExptIds {
 		fieldId = 3
 		goto RequiredFieldNotSetError
 	}
@@ -30132,14 +31394,14 @@ func (p *DeleteExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteExptInsightAnalysisRecordRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetExptRegressionReportRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_DeleteExptInsightAnalysisRecordRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetExptRegressionReportRequest[fieldId]))
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) FastReadField1(buf []byte) (int, error) {
+func (p *GetExptRegressionReportRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -30153,7 +31415,7 @@ func (p *DeleteExptInsightAnalysisRecordRequest) FastReadField1(buf []byte) (int
 	return offset, nil
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) FastReadField2(buf []byte) (int, error) {
+func (p *GetExptRegressionReportRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -30163,37 +31425,137 @@ func (p *DeleteExptInsightAnalysisRecordRequest) FastReadField2(buf []byte) (int
 		offset += l
 		_field = v
 	}
-	p.ExptID = _field
+	p.BaseExptID = _field
 	return offset, nil
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) FastReadField3(buf []byte) (int, error) {
+func (p *GetExptRegressionReportRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.CandidateExptIds = _field
+	return offset, nil
+}
+
+func (p *GetExptRegressionReportRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.InsightAnalysisRecordID = _field
+	p.Threshold = _field
 	return offset, nil
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) FastReadField200(buf []byte) (int, error) {
+func (p *GetExptRegressionReportRequest) FastReadField5(buf []byte) (int, error) {
 	offset := 0
-	_field := common.NewSession()
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]expt.ExptRegressionChangeType, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem expt.ExptRegressionChangeType
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ChangeTypes = _field
+	return offset, nil
+}
+
+func (p *GetExptRegressionReportRequest) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExperimentFilter()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Session = _field
+	p.Filter = _field
 	return offset, nil
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) FastReadField255(buf []byte) (int, error) {
+func (p *GetExptRegressionReportRequest) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.UseAccelerator = _field
+	return offset, nil
+}
+
+func (p *GetExptRegressionReportRequest) FastReadField8(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.OutputFieldKeys = _field
+	return offset, nil
+}
+
+func (p *GetExptRegressionReportRequest) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.MaxItemCnt = _field
+	return offset, nil
+}
+
+func (p *GetExptRegressionReportRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -30205,67 +31567,143 @@ func (p *DeleteExptInsightAnalysisRecordRequest) FastReadField255(buf []byte) (i
 	return offset, nil
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) FastWrite(buf []byte) int {
+func (p *GetExptRegressionReportRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptRegressionReportRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) BLength() int {
+func (p *GetExptRegressionReportRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field200Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
+		l += p.field9Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptRegressionReportRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptRegressionReportRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.BaseExptID)
 	return offset
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptRegressionReportRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.InsightAnalysisRecordID)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.CandidateExptIds {
+		length++
+		offset += thrift.Binary.WriteI64(buf[offset:], v)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
 	return offset
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptRegressionReportRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSession() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
-		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetThreshold() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 4)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Threshold)
 	}
 	return offset
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptRegressionReportRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetChangeTypes() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 5)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ChangeTypes {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
+	}
+	return offset
+}
+
+func (p *GetExptRegressionReportRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFilter() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 6)
+		offset += p.Filter.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetExptRegressionReportRequest) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetUseAccelerator() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 7)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.UseAccelerator)
+	}
+	return offset
+}
+
+func (p *GetExptRegressionReportRequest) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetOutputFieldKeys() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 8)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.OutputFieldKeys {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
+	}
+	return offset
+}
+
+func (p *GetExptRegressionReportRequest) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMaxItemCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 9)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.MaxItemCnt)
+	}
+	return offset
+}
+
+func (p *GetExptRegressionReportRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -30274,37 +31712,92 @@ func (p *DeleteExptInsightAnalysisRecordRequest) fastWriteField255(buf []byte, w
 	return offset
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) field1Length() int {
+func (p *GetExptRegressionReportRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) field2Length() int {
+func (p *GetExptRegressionReportRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) field3Length() int {
+func (p *GetExptRegressionReportRequest) field3Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	l += thrift.Binary.ListBeginLength()
+	l +=
+		thrift.Binary.I64Length() * len(p.CandidateExptIds)
 	return l
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) field200Length() int {
+func (p *GetExptRegressionReportRequest) field4Length() int {
 	l := 0
-	if p.IsSetSession() {
+	if p.IsSetThreshold() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Session.BLength()
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) field255Length() int {
+func (p *GetExptRegressionReportRequest) field5Length() int {
+	l := 0
+	if p.IsSetChangeTypes() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ChangeTypes {
+			_ = v
+			l += thrift.Binary.StringLengthNocopy(v)
+		}
+	}
+	return l
+}
+
+func (p *GetExptRegressionReportRequest) field6Length() int {
+	l := 0
+	if p.IsSetFilter() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Filter.BLength()
+	}
+	return l
+}
+
+func (p *GetExptRegressionReportRequest) field7Length() int {
+	l := 0
+	if p.IsSetUseAccelerator() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *GetExptRegressionReportRequest) field8Length() int {
+	l := 0
+	if p.IsSetOutputFieldKeys() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.OutputFieldKeys {
+			_ = v
+			l += thrift.Binary.StringLengthNocopy(v)
+		}
+	}
+	return l
+}
+
+func (p *GetExptRegressionReportRequest) field9Length() int {
+	l := 0
+	if p.IsSetMaxItemCnt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *GetExptRegressionReportRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -30313,26 +31806,68 @@ func (p *DeleteExptInsightAnalysisRecordRequest) field255Length() int {
 	return l
 }
 
-func (p *DeleteExptInsightAnalysisRecordRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*DeleteExptInsightAnalysisRecordRequest)
+func (p *GetExptRegressionReportRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetExptRegressionReportRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
 	p.WorkspaceID = src.WorkspaceID
 
-	p.ExptID = src.ExptID
+	p.BaseExptID = src.BaseExptID
 
-	p.InsightAnalysisRecordID = src.InsightAnalysisRecordID
+	if src.CandidateExptIds != nil {
+		p.CandidateExptIds = make([]int64, 0, len(src.CandidateExptIds))
+		for _, elem := range src.CandidateExptIds {
+			var _elem int64
+			_elem = elem
+			p.CandidateExptIds = append(p.CandidateExptIds, _elem)
+		}
+	}
 
-	var _session *common.Session
-	if src.Session != nil {
-		_session = &common.Session{}
-		if err := _session.DeepCopy(src.Session); err != nil {
+	if src.Threshold != nil {
+		tmp := *src.Threshold
+		p.Threshold = &tmp
+	}
+
+	if src.ChangeTypes != nil {
+		p.ChangeTypes = make([]expt.ExptRegressionChangeType, 0, len(src.ChangeTypes))
+		for _, elem := range src.ChangeTypes {
+			var _elem expt.ExptRegressionChangeType
+			_elem = elem
+			p.ChangeTypes = append(p.ChangeTypes, _elem)
+		}
+	}
+
+	var _filter *expt.ExperimentFilter
+	if src.Filter != nil {
+		_filter = &expt.ExperimentFilter{}
+		if err := _filter.DeepCopy(src.Filter); err != nil {
 			return err
 		}
 	}
-	p.Session = _session
+	p.Filter = _filter
+
+	if src.UseAccelerator != nil {
+		tmp := *src.UseAccelerator
+		p.UseAccelerator = &tmp
+	}
+
+	if src.OutputFieldKeys != nil {
+		p.OutputFieldKeys = make([]string, 0, len(src.OutputFieldKeys))
+		for _, elem := range src.OutputFieldKeys {
+			var _elem string
+			if elem != "" {
+				_elem = kutils.StringDeepCopy(elem)
+			}
+			p.OutputFieldKeys = append(p.OutputFieldKeys, _elem)
+		}
+	}
+
+	if src.MaxItemCnt != nil {
+		tmp := *src.MaxItemCnt
+		p.MaxItemCnt = &tmp
+	}
 
 	var _base *base.Base
 	if src.Base != nil {
@@ -30346,7 +31881,7 @@ func (p *DeleteExptInsightAnalysisRecordRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *DeleteExptInsightAnalysisRecordResponse) FastRead(buf []byte) (int, error) {
+func (p *GetExptRegressionReportResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -30363,6 +31898,20 @@ func (p *DeleteExptInsightAnalysisRecordResponse) FastRead(buf []byte) (int, err
 			break
 		}
 		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -30390,12 +31939,24 @@ func (p *DeleteExptInsightAnalysisRecordResponse) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteExptInsightAnalysisRecordResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetExptRegressionReportResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *DeleteExptInsightAnalysisRecordResponse) FastReadField255(buf []byte) (int, error) {
+func (p *GetExptRegressionReportResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptRegressionReport()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Report = _field
+	return offset, nil
+}
+
+func (p *GetExptRegressionReportResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -30407,48 +31968,77 @@ func (p *DeleteExptInsightAnalysisRecordResponse) FastReadField255(buf []byte) (
 	return offset, nil
 }
 
-func (p *DeleteExptInsightAnalysisRecordResponse) FastWrite(buf []byte) int {
+func (p *GetExptRegressionReportResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *DeleteExptInsightAnalysisRecordResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptRegressionReportResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *DeleteExptInsightAnalysisRecordResponse) BLength() int {
+func (p *GetExptRegressionReportResponse) BLength() int {
 	l := 0
 	if p != nil {
+		l += p.field1Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *DeleteExptInsightAnalysisRecordResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptRegressionReportResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetReport() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Report.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetExptRegressionReportResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *DeleteExptInsightAnalysisRecordResponse) field255Length() int {
+func (p *GetExptRegressionReportResponse) field1Length() int {
+	l := 0
+	if p.IsSetReport() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Report.BLength()
+	}
+	return l
+}
+
+func (p *GetExptRegressionReportResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *DeleteExptInsightAnalysisRecordResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*DeleteExptInsightAnalysisRecordResponse)
+func (p *GetExptRegressionReportResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetExptRegressionReportResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
+	var _report *expt.ExptRegressionReport
+	if src.Report != nil {
+		_report = &expt.ExptRegressionReport{}
+		if err := _report.DeepCopy(src.Report); err != nil {
+			return err
+		}
+	}
+	p.Report = _report
+
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
 		_baseResp = &base.BaseResp{}
@@ -30461,7 +32051,7 @@ func (p *DeleteExptInsightAnalysisRecordResponse) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) FastRead(buf []byte) (int, error) {
+func (p *ExportExptRegressionReportRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -30469,9 +32059,8 @@ func (p *FeedbackExptInsightAnalysisReportRequest) FastRead(buf []byte) (int, er
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetWorkspaceID bool = false
-	var issetExptID bool = false
-	var issetInsightAnalysisRecordID bool = false
-	var issetFeedbackActionType bool = false
+	var issetBaseExptID bool = false
+	var issetCandidateExptIds bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -30504,7 +32093,7 @@ func (p *FeedbackExptInsightAnalysisReportRequest) FastRead(buf []byte) (int, er
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetExptID = true
+				issetBaseExptID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -30513,13 +32102,13 @@ func (p *FeedbackExptInsightAnalysisReportRequest) FastRead(buf []byte) (int, er
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetInsightAnalysisRecordID = true
+				issetCandidateExptIds = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -30528,13 +32117,12 @@ func (p *FeedbackExptInsightAnalysisReportRequest) FastRead(buf []byte) (int, er
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetFeedbackActionType = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -30543,7 +32131,7 @@ func (p *FeedbackExptInsightAnalysisReportRequest) FastRead(buf []byte) (int, er
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField5(buf[offset:])
 				offset += l
 				if err != nil {
@@ -30557,7 +32145,7 @@ func (p *FeedbackExptInsightAnalysisReportRequest) FastRead(buf []byte) (int, er
 				}
 			}
 		case 6:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField6(buf[offset:])
 				offset += l
 				if err != nil {
@@ -30570,9 +32158,51 @@ func (p *FeedbackExptInsightAnalysisReportRequest) FastRead(buf []byte) (int, er
 					goto SkipFieldError
 				}
 			}
-		case 200:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField200(buf[offset:])
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField9(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField10(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -30612,32 +32242,27 @@ func (p *FeedbackExptInsightAnalysisReportRequest) FastRead(buf []byte) (int, er
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetExptID {
+	if !issetBaseExptID {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetInsightAnalysisRecordID {
+	if !issetCandidateExptIds {
 		fieldId = 3
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetFeedbackActionType {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FeedbackExptInsightAnalysisReportRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportExptRegressionReportRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_FeedbackExptInsightAnalysisReportRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExportExptRegressionReportRequest[fieldId]))
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField1(buf []byte) (int, error) {
+func (p *ExportExptRegressionReportRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -30651,7 +32276,7 @@ func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField1(buf []byte) (i
 	return offset, nil
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField2(buf []byte) (int, error) {
+func (p *ExportExptRegressionReportRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -30661,79 +32286,151 @@ func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField2(buf []byte) (i
 		offset += l
 		_field = v
 	}
-	p.ExptID = _field
+	p.BaseExptID = _field
 	return offset, nil
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField3(buf []byte) (int, error) {
+func (p *ExportExptRegressionReportRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.CandidateExptIds = _field
+	return offset, nil
+}
+
+func (p *ExportExptRegressionReportRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.InsightAnalysisRecordID = _field
+	p.Threshold = _field
 	return offset, nil
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField4(buf []byte) (int, error) {
+func (p *ExportExptRegressionReportRequest) FastReadField5(buf []byte) (int, error) {
 	offset := 0
 
-	var _field expt.FeedbackActionType
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]expt.ExptRegressionChangeType, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem expt.ExptRegressionChangeType
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ChangeTypes = _field
+	return offset, nil
+}
+
+func (p *ExportExptRegressionReportRequest) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExperimentFilter()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
 	}
-	p.FeedbackActionType = _field
+	p.Filter = _field
 	return offset, nil
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField5(buf []byte) (int, error) {
+func (p *ExportExptRegressionReportRequest) FastReadField7(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Comment = _field
+	p.UseAccelerator = _field
 	return offset, nil
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField6(buf []byte) (int, error) {
+func (p *ExportExptRegressionReportRequest) FastReadField8(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.OutputFieldKeys = _field
+	return offset, nil
+}
+
+func (p *ExportExptRegressionReportRequest) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.CommentID = _field
+	p.MaxItemCnt = _field
 	return offset, nil
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField200(buf []byte) (int, error) {
+func (p *ExportExptRegressionReportRequest) FastReadField10(buf []byte) (int, error) {
 	offset := 0
-	_field := common.NewSession()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *expt.ExptResultExportType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.Session = _field
+	p.ExportType = _field
 	return offset, nil
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField255(buf []byte) (int, error) {
+func (p *ExportExptRegressionReportRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -30745,27 +32442,30 @@ func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField255(buf []byte)
 	return offset, nil
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) FastWrite(buf []byte) int {
+func (p *ExportExptRegressionReportRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExportExptRegressionReportRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) BLength() int {
+func (p *ExportExptRegressionReportRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -30774,69 +32474,122 @@ func (p *FeedbackExptInsightAnalysisReportRequest) BLength() int {
 		l += p.field4Length()
 		l += p.field5Length()
 		l += p.field6Length()
-		l += p.field200Length()
+		l += p.field7Length()
+		l += p.field8Length()
+		l += p.field9Length()
+		l += p.field10Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExportExptRegressionReportRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExportExptRegressionReportRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.BaseExptID)
 	return offset
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExportExptRegressionReportRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.InsightAnalysisRecordID)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.CandidateExptIds {
+		length++
+		offset += thrift.Binary.WriteI64(buf[offset:], v)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
 	return offset
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExportExptRegressionReportRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.FeedbackActionType)
+	if p.IsSetThreshold() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 4)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Threshold)
+	}
 	return offset
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExportExptRegressionReportRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetComment() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Comment)
+	if p.IsSetChangeTypes() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 5)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ChangeTypes {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
 	}
 	return offset
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExportExptRegressionReportRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetCommentID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 6)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.CommentID)
+	if p.IsSetFilter() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 6)
+		offset += p.Filter.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExportExptRegressionReportRequest) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSession() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
-		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetUseAccelerator() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 7)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.UseAccelerator)
 	}
 	return offset
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExportExptRegressionReportRequest) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetOutputFieldKeys() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 8)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.OutputFieldKeys {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
+	}
+	return offset
+}
+
+func (p *ExportExptRegressionReportRequest) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMaxItemCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 9)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.MaxItemCnt)
+	}
+	return offset
+}
+
+func (p *ExportExptRegressionReportRequest) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExportType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 10)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ExportType)
+	}
+	return offset
+}
+
+func (p *ExportExptRegressionReportRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -30845,62 +32598,101 @@ func (p *FeedbackExptInsightAnalysisReportRequest) fastWriteField255(buf []byte,
 	return offset
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) field1Length() int {
+func (p *ExportExptRegressionReportRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) field2Length() int {
+func (p *ExportExptRegressionReportRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) field3Length() int {
+func (p *ExportExptRegressionReportRequest) field3Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	l += thrift.Binary.ListBeginLength()
+	l +=
+		thrift.Binary.I64Length() * len(p.CandidateExptIds)
 	return l
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) field4Length() int {
+func (p *ExportExptRegressionReportRequest) field4Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.FeedbackActionType)
+	if p.IsSetThreshold() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
 	return l
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) field5Length() int {
+func (p *ExportExptRegressionReportRequest) field5Length() int {
 	l := 0
-	if p.IsSetComment() {
+	if p.IsSetChangeTypes() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Comment)
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ChangeTypes {
+			_ = v
+			l += thrift.Binary.StringLengthNocopy(v)
+		}
 	}
 	return l
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) field6Length() int {
+func (p *ExportExptRegressionReportRequest) field6Length() int {
 	l := 0
-	if p.IsSetCommentID() {
+	if p.IsSetFilter() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += p.Filter.BLength()
 	}
 	return l
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) field200Length() int {
+func (p *ExportExptRegressionReportRequest) field7Length() int {
 	l := 0
-	if p.IsSetSession() {
+	if p.IsSetUseAccelerator() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Session.BLength()
+		l += thrift.Binary.BoolLength()
 	}
 	return l
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) field255Length() int {
+func (p *ExportExptRegressionReportRequest) field8Length() int {
+	l := 0
+	if p.IsSetOutputFieldKeys() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.OutputFieldKeys {
+			_ = v
+			l += thrift.Binary.StringLengthNocopy(v)
+		}
+	}
+	return l
+}
+
+func (p *ExportExptRegressionReportRequest) field9Length() int {
+	l := 0
+	if p.IsSetMaxItemCnt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ExportExptRegressionReportRequest) field10Length() int {
+	l := 0
+	if p.IsSetExportType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ExportType)
+	}
+	return l
+}
+
+func (p *ExportExptRegressionReportRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -30909,41 +32701,73 @@ func (p *FeedbackExptInsightAnalysisReportRequest) field255Length() int {
 	return l
 }
 
-func (p *FeedbackExptInsightAnalysisReportRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*FeedbackExptInsightAnalysisReportRequest)
+func (p *ExportExptRegressionReportRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExportExptRegressionReportRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
 	p.WorkspaceID = src.WorkspaceID
 
-	p.ExptID = src.ExptID
+	p.BaseExptID = src.BaseExptID
 
-	p.InsightAnalysisRecordID = src.InsightAnalysisRecordID
+	if src.CandidateExptIds != nil {
+		p.CandidateExptIds = make([]int64, 0, len(src.CandidateExptIds))
+		for _, elem := range src.CandidateExptIds {
+			var _elem int64
+			_elem = elem
+			p.CandidateExptIds = append(p.CandidateExptIds, _elem)
+		}
+	}
 
-	p.FeedbackActionType = src.FeedbackActionType
+	if src.Threshold != nil {
+		tmp := *src.Threshold
+		p.Threshold = &tmp
+	}
 
-	if src.Comment != nil {
-		var tmp string
-		if *src.Comment != "" {
-			tmp = kutils.StringDeepCopy(*src.Comment)
+	if src.ChangeTypes != nil {
+		p.ChangeTypes = make([]expt.ExptRegressionChangeType, 0, len(src.ChangeTypes))
+		for _, elem := range src.ChangeTypes {
+			var _elem expt.ExptRegressionChangeType
+			_elem = elem
+			p.ChangeTypes = append(p.ChangeTypes, _elem)
 		}
-		p.Comment = &tmp
 	}
 
-	if src.CommentID != nil {
-		tmp := *src.CommentID
-		p.CommentID = &tmp
+	var _filter *expt.ExperimentFilter
+	if src.Filter != nil {
+		_filter = &expt.ExperimentFilter{}
+		if err := _filter.DeepCopy(src.Filter); err != nil {
+			return err
+		}
 	}
+	p.Filter = _filter
 
-	var _session *common.Session
-	if src.Session != nil {
-		_session = &common.Session{}
-		if err := _session.DeepCopy(src.Session); err != nil {
-			return err
+	if src.UseAccelerator != nil {
+		tmp := *src.UseAccelerator
+		p.UseAccelerator = &tmp
+	}
+
+	if src.OutputFieldKeys != nil {
+		p.OutputFieldKeys = make([]string, 0, len(src.OutputFieldKeys))
+		for _, elem := range src.OutputFieldKeys {
+			var _elem string
+			if elem != "" {
+				_elem = kutils.StringDeepCopy(elem)
+			}
+			p.OutputFieldKeys = append(p.OutputFieldKeys, _elem)
 		}
 	}
-	p.Session = _session
+
+	if src.MaxItemCnt != nil {
+		tmp := *src.MaxItemCnt
+		p.MaxItemCnt = &tmp
+	}
+
+	if src.ExportType != nil {
+		tmp := *src.ExportType
+		p.ExportType = &tmp
+	}
 
 	var _base *base.Base
 	if src.Base != nil {
@@ -30957,7 +32781,7 @@ func (p *FeedbackExptInsightAnalysisReportRequest) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *FeedbackExptInsightAnalysisReportResponse) FastRead(buf []byte) (int, error) {
+func (p *ExportExptRegressionReportResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -30974,6 +32798,20 @@ func (p *FeedbackExptInsightAnalysisReportResponse) FastRead(buf []byte) (int, e
 			break
 		}
 		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -31001,12 +32839,26 @@ func (p *FeedbackExptInsightAnalysisReportResponse) FastRead(buf []byte) (int, e
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FeedbackExptInsightAnalysisReportResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportExptRegressionReportResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *FeedbackExptInsightAnalysisReportResponse) FastReadField255(buf []byte) (int, error) {
+func (p *ExportExptRegressionReportResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.URL = _field
+	return offset, nil
+}
+
+func (p *ExportExptRegressionReportResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -31018,48 +32870,76 @@ func (p *FeedbackExptInsightAnalysisReportResponse) FastReadField255(buf []byte)
 	return offset, nil
 }
 
-func (p *FeedbackExptInsightAnalysisReportResponse) FastWrite(buf []byte) int {
+func (p *ExportExptRegressionReportResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *FeedbackExptInsightAnalysisReportResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExportExptRegressionReportResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *FeedbackExptInsightAnalysisReportResponse) BLength() int {
+func (p *ExportExptRegressionReportResponse) BLength() int {
 	l := 0
 	if p != nil {
+		l += p.field1Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *FeedbackExptInsightAnalysisReportResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExportExptRegressionReportResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetURL() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.URL)
+	}
+	return offset
+}
+
+func (p *ExportExptRegressionReportResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *FeedbackExptInsightAnalysisReportResponse) field255Length() int {
+func (p *ExportExptRegressionReportResponse) field1Length() int {
+	l := 0
+	if p.IsSetURL() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.URL)
+	}
+	return l
+}
+
+func (p *ExportExptRegressionReportResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *FeedbackExptInsightAnalysisReportResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*FeedbackExptInsightAnalysisReportResponse)
+func (p *ExportExptRegressionReportResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExportExptRegressionReportResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
+	if src.URL != nil {
+		var tmp string
+		if *src.URL != "" {
+			tmp = kutils.StringDeepCopy(*src.URL)
+		}
+		p.URL = &tmp
+	}
+
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
 		_baseResp = &base.BaseResp{}
@@ -31072,7 +32952,7 @@ func (p *FeedbackExptInsightAnalysisReportResponse) DeepCopy(s interface{}) erro
 	return nil
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) FastRead(buf []byte) (int, error) {
+func (p *GetExptGroupedAggrResultRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -31080,8 +32960,8 @@ func (p *ListExptInsightAnalysisCommentRequest) FastRead(buf []byte) (int, error
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetWorkspaceID bool = false
-	var issetExptID bool = false
-	var issetInsightAnalysisRecordID bool = false
+	var issetExptIds bool = false
+	var issetDimension bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -31108,13 +32988,13 @@ func (p *ListExptInsightAnalysisCommentRequest) FastRead(buf []byte) (int, error
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetExptID = true
+				issetExptIds = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -31123,13 +33003,13 @@ func (p *ListExptInsightAnalysisCommentRequest) FastRead(buf []byte) (int, error
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetInsightAnalysisRecordID = true
+				issetDimension = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -31151,34 +33031,6 @@ func (p *ListExptInsightAnalysisCommentRequest) FastRead(buf []byte) (int, error
 					goto SkipFieldError
 				}
 			}
-		case 5:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField5(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 200:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField200(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -31207,12 +33059,12 @@ func (p *ListExptInsightAnalysisCommentRequest) FastRead(buf []byte) (int, error
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetExptID {
+	if !issetExptIds {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetInsightAnalysisRecordID {
+	if !issetDimension {
 		fieldId = 3
 		goto RequiredFieldNotSetError
 	}
@@ -31220,14 +33072,14 @@ func (p *ListExptInsightAnalysisCommentRequest) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExptInsightAnalysisCommentRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetExptGroupedAggrResultRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListExptInsightAnalysisCommentRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetExptGroupedAggrResultRequest[fieldId]))
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) FastReadField1(buf []byte) (int, error) {
+func (p *GetExptGroupedAggrResultRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -31241,49 +33093,43 @@ func (p *ListExptInsightAnalysisCommentRequest) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) FastReadField2(buf []byte) (int, error) {
+func (p *GetExptGroupedAggrResultRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = v
 	}
-	p.ExptID = _field
-	return offset, nil
-}
-
-func (p *ListExptInsightAnalysisCommentRequest) FastReadField3(buf []byte) (int, error) {
-	offset := 0
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
+		_field = append(_field, _elem)
 	}
-	p.InsightAnalysisRecordID = _field
+	p.ExptIds = _field
 	return offset, nil
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) FastReadField4(buf []byte) (int, error) {
+func (p *GetExptGroupedAggrResultRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
-
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	_field := expt.NewExptGroupDimension()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
 	}
-	p.PageNumber = _field
+	p.Dimension = _field
 	return offset, nil
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) FastReadField5(buf []byte) (int, error) {
+func (p *GetExptGroupedAggrResultRequest) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int32
@@ -31293,23 +33139,11 @@ func (p *ListExptInsightAnalysisCommentRequest) FastReadField5(buf []byte) (int,
 		offset += l
 		_field = &v
 	}
-	p.PageSize = _field
-	return offset, nil
-}
-
-func (p *ListExptInsightAnalysisCommentRequest) FastReadField200(buf []byte) (int, error) {
-	offset := 0
-	_field := common.NewSession()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.Session = _field
+	p.MaxGroups = _field
 	return offset, nil
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) FastReadField255(buf []byte) (int, error) {
+func (p *GetExptGroupedAggrResultRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -31321,89 +33155,74 @@ func (p *ListExptInsightAnalysisCommentRequest) FastReadField255(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) FastWrite(buf []byte) int {
+func (p *GetExptGroupedAggrResultRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptGroupedAggrResultRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField200(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) BLength() int {
+func (p *GetExptGroupedAggrResultRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
-		l += p.field5Length()
-		l += p.field200Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptGroupedAggrResultRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptGroupedAggrResultRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
-	return offset
-}
-
-func (p *ListExptInsightAnalysisCommentRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.InsightAnalysisRecordID)
-	return offset
-}
-
-func (p *ListExptInsightAnalysisCommentRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetPageNumber() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageNumber)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.ExptIds {
+		length++
+		offset += thrift.Binary.WriteI64(buf[offset:], v)
 	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
 	return offset
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptGroupedAggrResultRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPageSize() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 5)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+	offset += p.Dimension.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptGroupedAggrResultRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSession() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
-		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetMaxGroups() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.MaxGroups)
 	}
 	return offset
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptGroupedAggrResultRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -31412,55 +33231,39 @@ func (p *ListExptInsightAnalysisCommentRequest) fastWriteField255(buf []byte, w
 	return offset
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) field1Length() int {
+func (p *GetExptGroupedAggrResultRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) field2Length() int {
+func (p *GetExptGroupedAggrResultRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	l += thrift.Binary.ListBeginLength()
+	l +=
+		thrift.Binary.I64Length() * len(p.ExptIds)
 	return l
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) field3Length() int {
+func (p *GetExptGroupedAggrResultRequest) field3Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
-
-func (p *ListExptInsightAnalysisCommentRequest) field4Length() int {
-	l := 0
-	if p.IsSetPageNumber() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
-	}
+	l += p.Dimension.BLength()
 	return l
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) field5Length() int {
+func (p *GetExptGroupedAggrResultRequest) field4Length() int {
 	l := 0
-	if p.IsSetPageSize() {
+	if p.IsSetMaxGroups() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) field200Length() int {
-	l := 0
-	if p.IsSetSession() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.Session.BLength()
-	}
-	return l
-}
-
-func (p *ListExptInsightAnalysisCommentRequest) field255Length() int {
+func (p *GetExptGroupedAggrResultRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -31469,36 +33272,36 @@ func (p *ListExptInsightAnalysisCommentRequest) field255Length() int {
 	return l
 }
 
-func (p *ListExptInsightAnalysisCommentRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*ListExptInsightAnalysisCommentRequest)
+func (p *GetExptGroupedAggrResultRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetExptGroupedAggrResultRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
 	p.WorkspaceID = src.WorkspaceID
 
-	p.ExptID = src.ExptID
-
-	p.InsightAnalysisRecordID = src.InsightAnalysisRecordID
-
-	if src.PageNumber != nil {
-		tmp := *src.PageNumber
-		p.PageNumber = &tmp
-	}
-
-	if src.PageSize != nil {
-		tmp := *src.PageSize
-		p.PageSize = &tmp
+	if src.ExptIds != nil {
+		p.ExptIds = make([]int64, 0, len(src.ExptIds))
+		for _, elem := range src.ExptIds {
+			var _elem int64
+			_elem = elem
+			p.ExptIds = append(p.ExptIds, _elem)
+		}
 	}
 
-	var _session *common.Session
-	if src.Session != nil {
-		_session = &common.Session{}
-		if err := _session.DeepCopy(src.Session); err != nil {
+	var _dimension *expt.ExptGroupDimension
+	if src.Dimension != nil {
+		_dimension = &expt.ExptGroupDimension{}
+		if err := _dimension.DeepCopy(src.Dimension); err != nil {
 			return err
 		}
 	}
-	p.Session = _session
+	p.Dimension = _dimension
+
+	if src.MaxGroups != nil {
+		tmp := *src.MaxGroups
+		p.MaxGroups = &tmp
+	}
 
 	var _base *base.Base
 	if src.Base != nil {
@@ -31512,14 +33315,13 @@ func (p *ListExptInsightAnalysisCommentRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ListExptInsightAnalysisCommentResponse) FastRead(buf []byte) (int, error) {
+func (p *GetExptGroupedAggrResultResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetExptInsightAnalysisFeedbackComments bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -31531,13 +33333,12 @@ func (p *ListExptInsightAnalysisCommentResponse) FastRead(buf []byte) (int, erro
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetExptInsightAnalysisFeedbackComments = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -31545,9 +33346,9 @@ func (p *ListExptInsightAnalysisCommentResponse) FastRead(buf []byte) (int, erro
 					goto SkipFieldError
 				}
 			}
-		case 20:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField20(buf[offset:])
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -31582,22 +33383,28 @@ func (p *ListExptInsightAnalysisCommentResponse) FastRead(buf []byte) (int, erro
 		}
 	}
 
-	if !issetExptInsightAnalysisFeedbackComments {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExptInsightAnalysisCommentResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetExptGroupedAggrResultResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListExptInsightAnalysisCommentResponse[fieldId]))
 }
 
-func (p *ListExptInsightAnalysisCommentResponse) FastReadField1(buf []byte) (int, error) {
+func (p *GetExptGroupedAggrResultResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptGroupDimension()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Dimension = _field
+	return offset, nil
+}
+
+func (p *GetExptGroupedAggrResultResponse) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -31605,8 +33412,8 @@ func (p *ListExptInsightAnalysisCommentResponse) FastReadField1(buf []byte) (int
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*expt.ExptInsightAnalysisFeedbackComment, 0, size)
-	values := make([]expt.ExptInsightAnalysisFeedbackComment, size)
+	_field := make([]*expt.ExptGroupedAggr, 0, size)
+	values := make([]expt.ExptGroupedAggr, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -31618,25 +33425,11 @@ func (p *ListExptInsightAnalysisCommentResponse) FastReadField1(buf []byte) (int
 
 		_field = append(_field, _elem)
 	}
-	p.ExptInsightAnalysisFeedbackComments = _field
-	return offset, nil
-}
-
-func (p *ListExptInsightAnalysisCommentResponse) FastReadField20(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Total = _field
+	p.ExptResults = _field
 	return offset, nil
 }
 
-func (p *ListExptInsightAnalysisCommentResponse) FastReadField255(buf []byte) (int, error) {
+func (p *GetExptGroupedAggrResultResponse) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBaseResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -31648,115 +33441,123 @@ func (p *ListExptInsightAnalysisCommentResponse) FastReadField255(buf []byte) (i
 	return offset, nil
 }
 
-func (p *ListExptInsightAnalysisCommentResponse) FastWrite(buf []byte) int {
+func (p *GetExptGroupedAggrResultResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ListExptInsightAnalysisCommentResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptGroupedAggrResultResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField20(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ListExptInsightAnalysisCommentResponse) BLength() int {
+func (p *GetExptGroupedAggrResultResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field20Length()
+		l += p.field2Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ListExptInsightAnalysisCommentResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptGroupedAggrResultResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
-	listBeginOffset := offset
-	offset += thrift.Binary.ListBeginLength()
-	var length int
-	for _, v := range p.ExptInsightAnalysisFeedbackComments {
-		length++
-		offset += v.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetDimension() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Dimension.FastWriteNocopy(buf[offset:], w)
 	}
-	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	return offset
 }
 
-func (p *ListExptInsightAnalysisCommentResponse) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptGroupedAggrResultResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTotal() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 20)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.Total)
+	if p.IsSetExptResults() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ExptResults {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *ListExptInsightAnalysisCommentResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptGroupedAggrResultResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
 	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ListExptInsightAnalysisCommentResponse) field1Length() int {
+func (p *GetExptGroupedAggrResultResponse) field1Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.ListBeginLength()
-	for _, v := range p.ExptInsightAnalysisFeedbackComments {
-		_ = v
-		l += v.BLength()
+	if p.IsSetDimension() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Dimension.BLength()
 	}
 	return l
 }
 
-func (p *ListExptInsightAnalysisCommentResponse) field20Length() int {
+func (p *GetExptGroupedAggrResultResponse) field2Length() int {
 	l := 0
-	if p.IsSetTotal() {
+	if p.IsSetExptResults() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ExptResults {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *ListExptInsightAnalysisCommentResponse) field255Length() int {
+func (p *GetExptGroupedAggrResultResponse) field255Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.BaseResp.BLength()
 	return l
 }
 
-func (p *ListExptInsightAnalysisCommentResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*ListExptInsightAnalysisCommentResponse)
+func (p *GetExptGroupedAggrResultResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetExptGroupedAggrResultResponse)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.ExptInsightAnalysisFeedbackComments != nil {
-		p.ExptInsightAnalysisFeedbackComments = make([]*expt.ExptInsightAnalysisFeedbackComment, 0, len(src.ExptInsightAnalysisFeedbackComments))
-		for _, elem := range src.ExptInsightAnalysisFeedbackComments {
-			var _elem *expt.ExptInsightAnalysisFeedbackComment
+	var _dimension *expt.ExptGroupDimension
+	if src.Dimension != nil {
+		_dimension = &expt.ExptGroupDimension{}
+		if err := _dimension.DeepCopy(src.Dimension); err != nil {
+			return err
+		}
+	}
+	p.Dimension = _dimension
+
+	if src.ExptResults != nil {
+		p.ExptResults = make([]*expt.ExptGroupedAggr, 0, len(src.ExptResults))
+		for _, elem := range src.ExptResults {
+			var _elem *expt.ExptGroupedAggr
 			if elem != nil {
-				_elem = &expt.ExptInsightAnalysisFeedbackComment{}
+				_elem = &expt.ExptGroupedAggr{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
 			}
 
-			p.ExptInsightAnalysisFeedbackComments = append(p.ExptInsightAnalysisFeedbackComments, _elem)
+			p.ExptResults = append(p.ExptResults, _elem)
 		}
 	}
 
-	if src.Total != nil {
-		tmp := *src.Total
-		p.Total = &tmp
-	}
-
 	var _baseResp *base.BaseResp
 	if src.BaseResp != nil {
 		_baseResp = &base.BaseResp{}
@@ -31769,13 +33570,15 @@ func (p *ListExptInsightAnalysisCommentResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *GetAnalysisRecordFeedbackVoteRequest) FastRead(buf []byte) (int, error) {
+func (p *GetExptSampledAggrResultRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -31793,6 +33596,7 @@ func (p *GetAnalysisRecordFeedbackVoteRequest) FastRead(buf []byte) (int, error)
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetWorkspaceID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -31807,6 +33611,7 @@ func (p *GetAnalysisRecordFeedbackVoteRequest) FastRead(buf []byte) (int, error)
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetExptID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -31815,7 +33620,7 @@ func (p *GetAnalysisRecordFeedbackVoteRequest) FastRead(buf []byte) (int, error)
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.DOUBLE {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -31828,20 +33633,6 @@ func (p *GetAnalysisRecordFeedbackVoteRequest) FastRead(buf []byte) (int, error)
 					goto SkipFieldError
 				}
 			}
-		case 200:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField200(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -31865,70 +33656,69 @@ func (p *GetAnalysisRecordFeedbackVoteRequest) FastRead(buf []byte) (int, error)
 		}
 	}
 
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAnalysisRecordFeedbackVoteRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetExptSampledAggrResultRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetExptSampledAggrResultRequest[fieldId]))
 }
 
-func (p *GetAnalysisRecordFeedbackVoteRequest) FastReadField1(buf []byte) (int, error) {
+func (p *GetExptSampledAggrResultRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
+	var _field int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
 	p.WorkspaceID = _field
 	return offset, nil
 }
 
-func (p *GetAnalysisRecordFeedbackVoteRequest) FastReadField2(buf []byte) (int, error) {
+func (p *GetExptSampledAggrResultRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
+	var _field int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
 	p.ExptID = _field
 	return offset, nil
 }
 
-func (p *GetAnalysisRecordFeedbackVoteRequest) FastReadField3(buf []byte) (int, error) {
+func (p *GetExptSampledAggrResultRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.InsightAnalysisRecordID = _field
-	return offset, nil
-}
-
-func (p *GetAnalysisRecordFeedbackVoteRequest) FastReadField200(buf []byte) (int, error) {
-	offset := 0
-	_field := common.NewSession()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.Session = _field
+	p.Confidence = _field
 	return offset, nil
 }
 
-func (p *GetAnalysisRecordFeedbackVoteRequest) FastReadField255(buf []byte) (int, error) {
+func (p *GetExptSampledAggrResultRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -31940,73 +33730,58 @@ func (p *GetAnalysisRecordFeedbackVoteRequest) FastReadField255(buf []byte) (int
 	return offset, nil
 }
 
-func (p *GetAnalysisRecordFeedbackVoteRequest) FastWrite(buf []byte) int {
+func (p *GetExptSampledAggrResultRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *GetAnalysisRecordFeedbackVoteRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptSampledAggrResultRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField200(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *GetAnalysisRecordFeedbackVoteRequest) BLength() int {
+func (p *GetExptSampledAggrResultRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field200Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *GetAnalysisRecordFeedbackVoteRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetWorkspaceID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.WorkspaceID)
-	}
-	return offset
-}
-
-func (p *GetAnalysisRecordFeedbackVoteRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptSampledAggrResultRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetExptID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExptID)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *GetAnalysisRecordFeedbackVoteRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptSampledAggrResultRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetInsightAnalysisRecordID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.InsightAnalysisRecordID)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
 	return offset
 }
 
-func (p *GetAnalysisRecordFeedbackVoteRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptSampledAggrResultRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSession() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
-		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetConfidence() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 3)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Confidence)
 	}
 	return offset
 }
 
-func (p *GetAnalysisRecordFeedbackVoteRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetExptSampledAggrResultRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -32015,43 +33790,30 @@ func (p *GetAnalysisRecordFeedbackVoteRequest) fastWriteField255(buf []byte, w t
 	return offset
 }
 
-func (p *GetAnalysisRecordFeedbackVoteRequest) field1Length() int {
-	l := 0
-	if p.IsSetWorkspaceID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *GetAnalysisRecordFeedbackVoteRequest) field2Length() int {
+func (p *GetExptSampledAggrResultRequest) field1Length() int {
 	l := 0
-	if p.IsSetExptID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *GetAnalysisRecordFeedbackVoteRequest) field3Length() int {
+func (p *GetExptSampledAggrResultRequest) field2Length() int {
 	l := 0
-	if p.IsSetInsightAnalysisRecordID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *GetAnalysisRecordFeedbackVoteRequest) field200Length() int {
+func (p *GetExptSampledAggrResultRequest) field3Length() int {
 	l := 0
-	if p.IsSetSession() {
+	if p.IsSetConfidence() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Session.BLength()
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *GetAnalysisRecordFeedbackVoteRequest) field255Length() int {
+func (p *GetExptSampledAggrResultRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -32060,35 +33822,20 @@ func (p *GetAnalysisRecordFeedbackVoteRequest) field255Length() int {
 	return l
 }
 
-func (p *GetAnalysisRecordFeedbackVoteRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*GetAnalysisRecordFeedbackVoteRequest)
+func (p *GetExptSampledAggrResultRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetExptSampledAggrResultRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.WorkspaceID != nil {
-		tmp := *src.WorkspaceID
-		p.WorkspaceID = &tmp
-	}
-
-	if src.ExptID != nil {
-		tmp := *src.ExptID
-		p.ExptID = &tmp
-	}
+	p.WorkspaceID = src.WorkspaceID
 
-	if src.InsightAnalysisRecordID != nil {
-		tmp := *src.InsightAnalysisRecordID
-		p.InsightAnalysisRecordID = &tmp
-	}
+	p.ExptID = src.ExptID
 
-	var _session *common.Session
-	if src.Session != nil {
-		_session = &common.Session{}
-		if err := _session.DeepCopy(src.Session); err != nil {
-			return err
-		}
+	if src.Confidence != nil {
+		tmp := *src.Confidence
+		p.Confidence = &tmp
 	}
-	p.Session = _session
 
 	var _base *base.Base
 	if src.Base != nil {
@@ -32102,7 +33849,7 @@ func (p *GetAnalysisRecordFeedbackVoteRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *GetAnalysisRecordFeedbackVoteResponse) FastRead(buf []byte) (int, error) {
+func (p *GetExptSampledAggrResultResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -32160,119 +33907,6137 @@ func (p *GetAnalysisRecordFeedbackVoteResponse) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAnalysisRecordFeedbackVoteResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetExptSampledAggrResultResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *GetExptSampledAggrResultResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptSampledAggrResult_()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Result_ = _field
+	return offset, nil
+}
+
+func (p *GetExptSampledAggrResultResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *GetExptSampledAggrResultResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetExptSampledAggrResultResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetExptSampledAggrResultResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetExptSampledAggrResultResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetResult_() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Result_.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetExptSampledAggrResultResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *GetExptSampledAggrResultResponse) field1Length() int {
+	l := 0
+	if p.IsSetResult_() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Result_.BLength()
+	}
+	return l
+}
+
+func (p *GetExptSampledAggrResultResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *GetExptSampledAggrResultResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetExptSampledAggrResultResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _result_ *expt.ExptSampledAggrResult_
+	if src.Result_ != nil {
+		_result_ = &expt.ExptSampledAggrResult_{}
+		if err := _result_.DeepCopy(src.Result_); err != nil {
+			return err
+		}
+	}
+	p.Result_ = _result_
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *InvalidateExptResultCacheRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InvalidateExptResultCacheRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_InvalidateExptResultCacheRequest[fieldId]))
+}
+
+func (p *InvalidateExptResultCacheRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *InvalidateExptResultCacheRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *InvalidateExptResultCacheRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *InvalidateExptResultCacheRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *InvalidateExptResultCacheRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *InvalidateExptResultCacheRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *InvalidateExptResultCacheRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *InvalidateExptResultCacheRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *InvalidateExptResultCacheRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *InvalidateExptResultCacheRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*InvalidateExptResultCacheRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *InvalidateExptResultCacheResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InvalidateExptResultCacheResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *InvalidateExptResultCacheResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *InvalidateExptResultCacheResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *InvalidateExptResultCacheResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *InvalidateExptResultCacheResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *InvalidateExptResultCacheResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *InvalidateExptResultCacheResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *InvalidateExptResultCacheResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*InvalidateExptResultCacheResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	var issetInsightAnalysisRecordID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetInsightAnalysisRecordID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 200:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField200(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetInsightAnalysisRecordID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetExptInsightAnalysisRecordRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetExptInsightAnalysisRecordRequest[fieldId]))
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ExptID = _field
+	return offset, nil
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.InsightAnalysisRecordID = _field
+	return offset, nil
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) FastReadField200(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewSession()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Session = _field
+	return offset, nil
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field200Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	return offset
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.InsightAnalysisRecordID)
+	return offset
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSession() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
+		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) field200Length() int {
+	l := 0
+	if p.IsSetSession() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Session.BLength()
+	}
+	return l
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *GetExptInsightAnalysisRecordRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetExptInsightAnalysisRecordRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.ExptID = src.ExptID
+
+	p.InsightAnalysisRecordID = src.InsightAnalysisRecordID
+
+	var _session *common.Session
+	if src.Session != nil {
+		_session = &common.Session{}
+		if err := _session.DeepCopy(src.Session); err != nil {
+			return err
+		}
+	}
+	p.Session = _session
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *GetExptInsightAnalysisRecordResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetExptInsightAnalysisRecordResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *GetExptInsightAnalysisRecordResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptInsightAnalysisRecord()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.ExptInsightAnalysisRecord = _field
+	return offset, nil
+}
+
+func (p *GetExptInsightAnalysisRecordResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *GetExptInsightAnalysisRecordResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetExptInsightAnalysisRecordResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetExptInsightAnalysisRecordResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetExptInsightAnalysisRecordResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExptInsightAnalysisRecord() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.ExptInsightAnalysisRecord.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetExptInsightAnalysisRecordResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *GetExptInsightAnalysisRecordResponse) field1Length() int {
+	l := 0
+	if p.IsSetExptInsightAnalysisRecord() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.ExptInsightAnalysisRecord.BLength()
+	}
+	return l
+}
+
+func (p *GetExptInsightAnalysisRecordResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *GetExptInsightAnalysisRecordResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetExptInsightAnalysisRecordResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _exptInsightAnalysisRecord *expt.ExptInsightAnalysisRecord
+	if src.ExptInsightAnalysisRecord != nil {
+		_exptInsightAnalysisRecord = &expt.ExptInsightAnalysisRecord{}
+		if err := _exptInsightAnalysisRecord.DeepCopy(src.ExptInsightAnalysisRecord); err != nil {
+			return err
+		}
+	}
+	p.ExptInsightAnalysisRecord = _exptInsightAnalysisRecord
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *InsightAnalysisExperimentRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 200:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField200(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InsightAnalysisExperimentRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_InsightAnalysisExperimentRequest[fieldId]))
+}
+
+func (p *InsightAnalysisExperimentRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *InsightAnalysisExperimentRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ExptID = _field
+	return offset, nil
+}
+
+func (p *InsightAnalysisExperimentRequest) FastReadField200(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewSession()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Session = _field
+	return offset, nil
+}
+
+func (p *InsightAnalysisExperimentRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *InsightAnalysisExperimentRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *InsightAnalysisExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *InsightAnalysisExperimentRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field200Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *InsightAnalysisExperimentRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *InsightAnalysisExperimentRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	return offset
+}
+
+func (p *InsightAnalysisExperimentRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSession() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
+		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *InsightAnalysisExperimentRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *InsightAnalysisExperimentRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *InsightAnalysisExperimentRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *InsightAnalysisExperimentRequest) field200Length() int {
+	l := 0
+	if p.IsSetSession() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Session.BLength()
+	}
+	return l
+}
+
+func (p *InsightAnalysisExperimentRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *InsightAnalysisExperimentRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*InsightAnalysisExperimentRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.ExptID = src.ExptID
+
+	var _session *common.Session
+	if src.Session != nil {
+		_session = &common.Session{}
+		if err := _session.DeepCopy(src.Session); err != nil {
+			return err
+		}
+	}
+	p.Session = _session
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *InsightAnalysisExperimentResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetInsightAnalysisRecordID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetInsightAnalysisRecordID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetInsightAnalysisRecordID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InsightAnalysisExperimentResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_InsightAnalysisExperimentResponse[fieldId]))
+}
+
+func (p *InsightAnalysisExperimentResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.InsightAnalysisRecordID = _field
+	return offset, nil
+}
+
+func (p *InsightAnalysisExperimentResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *InsightAnalysisExperimentResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *InsightAnalysisExperimentResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *InsightAnalysisExperimentResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *InsightAnalysisExperimentResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.InsightAnalysisRecordID)
+	return offset
+}
+
+func (p *InsightAnalysisExperimentResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *InsightAnalysisExperimentResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *InsightAnalysisExperimentResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *InsightAnalysisExperimentResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*InsightAnalysisExperimentResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.InsightAnalysisRecordID = src.InsightAnalysisRecordID
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 200:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField200(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExptInsightAnalysisRecordRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListExptInsightAnalysisRecordRequest[fieldId]))
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ExptID = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageNumber = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageSize = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) FastReadField200(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewSession()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Session = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field200Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	return offset
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageNumber() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageNumber)
+	}
+	return offset
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageSize() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
+	}
+	return offset
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSession() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
+		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) field3Length() int {
+	l := 0
+	if p.IsSetPageNumber() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) field4Length() int {
+	l := 0
+	if p.IsSetPageSize() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) field200Length() int {
+	l := 0
+	if p.IsSetSession() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Session.BLength()
+	}
+	return l
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *ListExptInsightAnalysisRecordRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListExptInsightAnalysisRecordRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.ExptID = src.ExptID
+
+	if src.PageNumber != nil {
+		tmp := *src.PageNumber
+		p.PageNumber = &tmp
+	}
+
+	if src.PageSize != nil {
+		tmp := *src.PageSize
+		p.PageSize = &tmp
+	}
+
+	var _session *common.Session
+	if src.Session != nil {
+		_session = &common.Session{}
+		if err := _session.DeepCopy(src.Session); err != nil {
+			return err
+		}
+	}
+	p.Session = _session
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *ListExptInsightAnalysisRecordResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetExptInsightAnalysisRecords bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExptInsightAnalysisRecords = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 20:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField20(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetExptInsightAnalysisRecords {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExptInsightAnalysisRecordResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListExptInsightAnalysisRecordResponse[fieldId]))
+}
+
+func (p *ListExptInsightAnalysisRecordResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*expt.ExptInsightAnalysisRecord, 0, size)
+	values := make([]expt.ExptInsightAnalysisRecord, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ExptInsightAnalysisRecords = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisRecordResponse) FastReadField20(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Total = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisRecordResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisRecordResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListExptInsightAnalysisRecordResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField20(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListExptInsightAnalysisRecordResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field20Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListExptInsightAnalysisRecordResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.ExptInsightAnalysisRecords {
+		length++
+		offset += v.FastWriteNocopy(buf[offset:], w)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	return offset
+}
+
+func (p *ListExptInsightAnalysisRecordResponse) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTotal() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 20)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.Total)
+	}
+	return offset
+}
+
+func (p *ListExptInsightAnalysisRecordResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ListExptInsightAnalysisRecordResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.ExptInsightAnalysisRecords {
+		_ = v
+		l += v.BLength()
+	}
+	return l
+}
+
+func (p *ListExptInsightAnalysisRecordResponse) field20Length() int {
+	l := 0
+	if p.IsSetTotal() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *ListExptInsightAnalysisRecordResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *ListExptInsightAnalysisRecordResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListExptInsightAnalysisRecordResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.ExptInsightAnalysisRecords != nil {
+		p.ExptInsightAnalysisRecords = make([]*expt.ExptInsightAnalysisRecord, 0, len(src.ExptInsightAnalysisRecords))
+		for _, elem := range src.ExptInsightAnalysisRecords {
+			var _elem *expt.ExptInsightAnalysisRecord
+			if elem != nil {
+				_elem = &expt.ExptInsightAnalysisRecord{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ExptInsightAnalysisRecords = append(p.ExptInsightAnalysisRecords, _elem)
+		}
+	}
+
+	if src.Total != nil {
+		tmp := *src.Total
+		p.Total = &tmp
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	var issetInsightAnalysisRecordID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetInsightAnalysisRecordID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 200:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField200(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetInsightAnalysisRecordID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteExptInsightAnalysisRecordRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_DeleteExptInsightAnalysisRecordRequest[fieldId]))
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ExptID = _field
+	return offset, nil
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.InsightAnalysisRecordID = _field
+	return offset, nil
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) FastReadField200(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewSession()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Session = _field
+	return offset, nil
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field200Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	return offset
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.InsightAnalysisRecordID)
+	return offset
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSession() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
+		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) field200Length() int {
+	l := 0
+	if p.IsSetSession() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Session.BLength()
+	}
+	return l
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *DeleteExptInsightAnalysisRecordRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*DeleteExptInsightAnalysisRecordRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.ExptID = src.ExptID
+
+	p.InsightAnalysisRecordID = src.InsightAnalysisRecordID
+
+	var _session *common.Session
+	if src.Session != nil {
+		_session = &common.Session{}
+		if err := _session.DeepCopy(src.Session); err != nil {
+			return err
+		}
+	}
+	p.Session = _session
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *DeleteExptInsightAnalysisRecordResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteExptInsightAnalysisRecordResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *DeleteExptInsightAnalysisRecordResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *DeleteExptInsightAnalysisRecordResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *DeleteExptInsightAnalysisRecordResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *DeleteExptInsightAnalysisRecordResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *DeleteExptInsightAnalysisRecordResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *DeleteExptInsightAnalysisRecordResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *DeleteExptInsightAnalysisRecordResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*DeleteExptInsightAnalysisRecordResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	var issetInsightAnalysisRecordID bool = false
+	var issetFeedbackActionType bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetInsightAnalysisRecordID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetFeedbackActionType = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 200:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField200(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetInsightAnalysisRecordID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetFeedbackActionType {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FeedbackExptInsightAnalysisReportRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_FeedbackExptInsightAnalysisReportRequest[fieldId]))
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ExptID = _field
+	return offset, nil
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.InsightAnalysisRecordID = _field
+	return offset, nil
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field expt.FeedbackActionType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.FeedbackActionType = _field
+	return offset, nil
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Comment = _field
+	return offset, nil
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.CommentID = _field
+	return offset, nil
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField200(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewSession()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Session = _field
+	return offset, nil
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field200Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	return offset
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.InsightAnalysisRecordID)
+	return offset
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.FeedbackActionType)
+	return offset
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetComment() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Comment)
+	}
+	return offset
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCommentID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 6)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.CommentID)
+	}
+	return offset
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSession() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
+		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) field4Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.FeedbackActionType)
+	return l
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) field5Length() int {
+	l := 0
+	if p.IsSetComment() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Comment)
+	}
+	return l
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) field6Length() int {
+	l := 0
+	if p.IsSetCommentID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) field200Length() int {
+	l := 0
+	if p.IsSetSession() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Session.BLength()
+	}
+	return l
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *FeedbackExptInsightAnalysisReportRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*FeedbackExptInsightAnalysisReportRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.ExptID = src.ExptID
+
+	p.InsightAnalysisRecordID = src.InsightAnalysisRecordID
+
+	p.FeedbackActionType = src.FeedbackActionType
+
+	if src.Comment != nil {
+		var tmp string
+		if *src.Comment != "" {
+			tmp = kutils.StringDeepCopy(*src.Comment)
+		}
+		p.Comment = &tmp
+	}
+
+	if src.CommentID != nil {
+		tmp := *src.CommentID
+		p.CommentID = &tmp
+	}
+
+	var _session *common.Session
+	if src.Session != nil {
+		_session = &common.Session{}
+		if err := _session.DeepCopy(src.Session); err != nil {
+			return err
+		}
+	}
+	p.Session = _session
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *FeedbackExptInsightAnalysisReportResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FeedbackExptInsightAnalysisReportResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *FeedbackExptInsightAnalysisReportResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *FeedbackExptInsightAnalysisReportResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *FeedbackExptInsightAnalysisReportResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *FeedbackExptInsightAnalysisReportResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *FeedbackExptInsightAnalysisReportResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *FeedbackExptInsightAnalysisReportResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *FeedbackExptInsightAnalysisReportResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*FeedbackExptInsightAnalysisReportResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	var issetInsightAnalysisRecordID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetInsightAnalysisRecordID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 200:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField200(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetInsightAnalysisRecordID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExptInsightAnalysisCommentRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListExptInsightAnalysisCommentRequest[fieldId]))
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ExptID = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.InsightAnalysisRecordID = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageNumber = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageSize = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) FastReadField200(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewSession()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Session = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field200Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	return offset
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.InsightAnalysisRecordID)
+	return offset
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageNumber() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageNumber)
+	}
+	return offset
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageSize() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 5)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
+	}
+	return offset
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSession() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
+		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) field4Length() int {
+	l := 0
+	if p.IsSetPageNumber() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) field5Length() int {
+	l := 0
+	if p.IsSetPageSize() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) field200Length() int {
+	l := 0
+	if p.IsSetSession() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Session.BLength()
+	}
+	return l
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *ListExptInsightAnalysisCommentRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListExptInsightAnalysisCommentRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.ExptID = src.ExptID
+
+	p.InsightAnalysisRecordID = src.InsightAnalysisRecordID
+
+	if src.PageNumber != nil {
+		tmp := *src.PageNumber
+		p.PageNumber = &tmp
+	}
+
+	if src.PageSize != nil {
+		tmp := *src.PageSize
+		p.PageSize = &tmp
+	}
+
+	var _session *common.Session
+	if src.Session != nil {
+		_session = &common.Session{}
+		if err := _session.DeepCopy(src.Session); err != nil {
+			return err
+		}
+	}
+	p.Session = _session
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *ListExptInsightAnalysisCommentResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetExptInsightAnalysisFeedbackComments bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExptInsightAnalysisFeedbackComments = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 20:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField20(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetExptInsightAnalysisFeedbackComments {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListExptInsightAnalysisCommentResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListExptInsightAnalysisCommentResponse[fieldId]))
+}
+
+func (p *ListExptInsightAnalysisCommentResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*expt.ExptInsightAnalysisFeedbackComment, 0, size)
+	values := make([]expt.ExptInsightAnalysisFeedbackComment, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ExptInsightAnalysisFeedbackComments = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisCommentResponse) FastReadField20(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Total = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisCommentResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ListExptInsightAnalysisCommentResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListExptInsightAnalysisCommentResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField20(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListExptInsightAnalysisCommentResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field20Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListExptInsightAnalysisCommentResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.ExptInsightAnalysisFeedbackComments {
+		length++
+		offset += v.FastWriteNocopy(buf[offset:], w)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	return offset
+}
+
+func (p *ListExptInsightAnalysisCommentResponse) fastWriteField20(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTotal() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 20)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.Total)
+	}
+	return offset
+}
+
+func (p *ListExptInsightAnalysisCommentResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ListExptInsightAnalysisCommentResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.ExptInsightAnalysisFeedbackComments {
+		_ = v
+		l += v.BLength()
+	}
+	return l
+}
+
+func (p *ListExptInsightAnalysisCommentResponse) field20Length() int {
+	l := 0
+	if p.IsSetTotal() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *ListExptInsightAnalysisCommentResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *ListExptInsightAnalysisCommentResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListExptInsightAnalysisCommentResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.ExptInsightAnalysisFeedbackComments != nil {
+		p.ExptInsightAnalysisFeedbackComments = make([]*expt.ExptInsightAnalysisFeedbackComment, 0, len(src.ExptInsightAnalysisFeedbackComments))
+		for _, elem := range src.ExptInsightAnalysisFeedbackComments {
+			var _elem *expt.ExptInsightAnalysisFeedbackComment
+			if elem != nil {
+				_elem = &expt.ExptInsightAnalysisFeedbackComment{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ExptInsightAnalysisFeedbackComments = append(p.ExptInsightAnalysisFeedbackComments, _elem)
+		}
+	}
+
+	if src.Total != nil {
+		tmp := *src.Total
+		p.Total = &tmp
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 200:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField200(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAnalysisRecordFeedbackVoteRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ExptID = _field
+	return offset, nil
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.InsightAnalysisRecordID = _field
+	return offset, nil
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) FastReadField200(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewSession()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Session = _field
+	return offset, nil
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField200(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field200Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetWorkspaceID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.WorkspaceID)
+	}
+	return offset
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExptID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExptID)
+	}
+	return offset
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetInsightAnalysisRecordID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.InsightAnalysisRecordID)
+	}
+	return offset
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSession() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 200)
+		offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) field1Length() int {
+	l := 0
+	if p.IsSetWorkspaceID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) field2Length() int {
+	l := 0
+	if p.IsSetExptID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) field3Length() int {
+	l := 0
+	if p.IsSetInsightAnalysisRecordID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) field200Length() int {
+	l := 0
+	if p.IsSetSession() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Session.BLength()
+	}
+	return l
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *GetAnalysisRecordFeedbackVoteRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetAnalysisRecordFeedbackVoteRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.WorkspaceID != nil {
+		tmp := *src.WorkspaceID
+		p.WorkspaceID = &tmp
+	}
+
+	if src.ExptID != nil {
+		tmp := *src.ExptID
+		p.ExptID = &tmp
+	}
+
+	if src.InsightAnalysisRecordID != nil {
+		tmp := *src.InsightAnalysisRecordID
+		p.InsightAnalysisRecordID = &tmp
+	}
+
+	var _session *common.Session
+	if src.Session != nil {
+		_session = &common.Session{}
+		if err := _session.DeepCopy(src.Session); err != nil {
+			return err
+		}
+	}
+	p.Session = _session
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *GetAnalysisRecordFeedbackVoteResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAnalysisRecordFeedbackVoteResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *GetAnalysisRecordFeedbackVoteResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptInsightAnalysisFeedbackVote()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Vote = _field
+	return offset, nil
+}
+
+func (p *GetAnalysisRecordFeedbackVoteResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *GetAnalysisRecordFeedbackVoteResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetAnalysisRecordFeedbackVoteResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetAnalysisRecordFeedbackVoteResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetAnalysisRecordFeedbackVoteResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetVote() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Vote.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetAnalysisRecordFeedbackVoteResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *GetAnalysisRecordFeedbackVoteResponse) field1Length() int {
+	l := 0
+	if p.IsSetVote() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Vote.BLength()
+	}
+	return l
+}
+
+func (p *GetAnalysisRecordFeedbackVoteResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *GetAnalysisRecordFeedbackVoteResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetAnalysisRecordFeedbackVoteResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _vote *expt.ExptInsightAnalysisFeedbackVote
+	if src.Vote != nil {
+		_vote = &expt.ExptInsightAnalysisFeedbackVote{}
+		if err := _vote.DeepCopy(src.Vote); err != nil {
+			return err
+		}
+	}
+	p.Vote = _vote
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *ExperimentServiceCheckExperimentNameArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCheckExperimentNameArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceCheckExperimentNameArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewCheckExperimentNameRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *ExperimentServiceCheckExperimentNameArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceCheckExperimentNameArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentServiceCheckExperimentNameArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentServiceCheckExperimentNameArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ExperimentServiceCheckExperimentNameArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *ExperimentServiceCheckExperimentNameArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCheckExperimentNameArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *CheckExperimentNameRequest
+	if src.Req != nil {
+		_req = &CheckExperimentNameRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *ExperimentServiceCheckExperimentNameResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCheckExperimentNameResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceCheckExperimentNameResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewCheckExperimentNameResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *ExperimentServiceCheckExperimentNameResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceCheckExperimentNameResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentServiceCheckExperimentNameResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentServiceCheckExperimentNameResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExperimentServiceCheckExperimentNameResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *ExperimentServiceCheckExperimentNameResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCheckExperimentNameResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *CheckExperimentNameResponse
+	if src.Success != nil {
+		_success = &CheckExperimentNameResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *ExperimentServiceCreateExperimentArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateExperimentArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceCreateExperimentArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewCreateExperimentRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *ExperimentServiceCreateExperimentArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceCreateExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentServiceCreateExperimentArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentServiceCreateExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ExperimentServiceCreateExperimentArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *ExperimentServiceCreateExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCreateExperimentArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *CreateExperimentRequest
+	if src.Req != nil {
+		_req = &CreateExperimentRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *ExperimentServiceCreateExperimentResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateExperimentResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceCreateExperimentResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewCreateExperimentResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *ExperimentServiceCreateExperimentResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceCreateExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentServiceCreateExperimentResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentServiceCreateExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExperimentServiceCreateExperimentResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *ExperimentServiceCreateExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCreateExperimentResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *CreateExperimentResponse
+	if src.Success != nil {
+		_success = &CreateExperimentResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *ExperimentServiceSubmitExperimentArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceSubmitExperimentArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceSubmitExperimentArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewSubmitExperimentRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *ExperimentServiceSubmitExperimentArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceSubmitExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentServiceSubmitExperimentArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentServiceSubmitExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ExperimentServiceSubmitExperimentArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *ExperimentServiceSubmitExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceSubmitExperimentArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *SubmitExperimentRequest
+	if src.Req != nil {
+		_req = &SubmitExperimentRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceSubmitExperimentResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewSubmitExperimentResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceSubmitExperimentResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *SubmitExperimentResponse
+	if src.Success != nil {
+		_success = &SubmitExperimentResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *ExperimentServiceBatchGetExperimentsArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentsArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceBatchGetExperimentsArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewBatchGetExperimentsRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *ExperimentServiceBatchGetExperimentsArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceBatchGetExperimentsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentServiceBatchGetExperimentsArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentServiceBatchGetExperimentsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ExperimentServiceBatchGetExperimentsArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *ExperimentServiceBatchGetExperimentsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchGetExperimentsArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *BatchGetExperimentsRequest
+	if src.Req != nil {
+		_req = &BatchGetExperimentsRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *ExperimentServiceBatchGetExperimentsResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentsResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceBatchGetExperimentsResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewBatchGetExperimentsResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *ExperimentServiceBatchGetExperimentsResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceBatchGetExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentServiceBatchGetExperimentsResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentServiceBatchGetExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExperimentServiceBatchGetExperimentsResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *ExperimentServiceBatchGetExperimentsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchGetExperimentsResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *BatchGetExperimentsResponse
+	if src.Success != nil {
+		_success = &BatchGetExperimentsResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *ExperimentServiceGetExperimentIDsByGroupArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExperimentIDsByGroupArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceGetExperimentIDsByGroupArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetExperimentIDsByGroupRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *ExperimentServiceGetExperimentIDsByGroupArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceGetExperimentIDsByGroupArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentServiceGetExperimentIDsByGroupArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentServiceGetExperimentIDsByGroupArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ExperimentServiceGetExperimentIDsByGroupArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *ExperimentServiceGetExperimentIDsByGroupArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetExperimentIDsByGroupArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *GetExperimentIDsByGroupRequest
+	if src.Req != nil {
+		_req = &GetExperimentIDsByGroupRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *ExperimentServiceGetExperimentIDsByGroupResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExperimentIDsByGroupResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceGetExperimentIDsByGroupResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetExperimentIDsByGroupResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *ExperimentServiceGetExperimentIDsByGroupResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceGetExperimentIDsByGroupResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentServiceGetExperimentIDsByGroupResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentServiceGetExperimentIDsByGroupResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExperimentServiceGetExperimentIDsByGroupResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *ExperimentServiceGetExperimentIDsByGroupResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetExperimentIDsByGroupResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *GetExperimentIDsByGroupResponse
+	if src.Success != nil {
+		_success = &GetExperimentIDsByGroupResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *ExperimentServiceListExperimentsArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentsArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceListExperimentsArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewListExperimentsRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *ExperimentServiceListExperimentsArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceListExperimentsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentServiceListExperimentsArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentServiceListExperimentsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ExperimentServiceListExperimentsArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *ExperimentServiceListExperimentsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExperimentsArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *ListExperimentsRequest
+	if src.Req != nil {
+		_req = &ListExperimentsRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *ExperimentServiceListExperimentsResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentsResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceListExperimentsResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewListExperimentsResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *ExperimentServiceListExperimentsResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceListExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentServiceListExperimentsResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentServiceListExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExperimentServiceListExperimentsResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *ExperimentServiceListExperimentsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExperimentsResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *ListExperimentsResponse
+	if src.Success != nil {
+		_success = &ListExperimentsResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *ExperimentServiceUpdateExperimentArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExperimentArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceUpdateExperimentArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewUpdateExperimentRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *ExperimentServiceUpdateExperimentArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceUpdateExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentServiceUpdateExperimentArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentServiceUpdateExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ExperimentServiceUpdateExperimentArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *ExperimentServiceUpdateExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpdateExperimentArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *UpdateExperimentRequest
+	if src.Req != nil {
+		_req = &UpdateExperimentRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *ExperimentServiceUpdateExperimentResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExperimentResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceUpdateExperimentResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewUpdateExperimentResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *ExperimentServiceUpdateExperimentResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceUpdateExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentServiceUpdateExperimentResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentServiceUpdateExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExperimentServiceUpdateExperimentResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *ExperimentServiceUpdateExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpdateExperimentResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *UpdateExperimentResponse
+	if src.Success != nil {
+		_success = &UpdateExperimentResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *ExperimentServiceUpdateExptRunConfArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExptRunConfArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceUpdateExptRunConfArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewUpdateExptRunConfRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *ExperimentServiceUpdateExptRunConfArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceUpdateExptRunConfArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentServiceUpdateExptRunConfArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentServiceUpdateExptRunConfArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ExperimentServiceUpdateExptRunConfArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *ExperimentServiceUpdateExptRunConfArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpdateExptRunConfArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *UpdateExptRunConfRequest
+	if src.Req != nil {
+		_req = &UpdateExptRunConfRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *ExperimentServiceUpdateExptRunConfResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExptRunConfResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *GetAnalysisRecordFeedbackVoteResponse) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-	_field := expt.NewExptInsightAnalysisFeedbackVote()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.Vote = _field
-	return offset, nil
-}
-
-func (p *GetAnalysisRecordFeedbackVoteResponse) FastReadField255(buf []byte) (int, error) {
+func (p *ExperimentServiceUpdateExptRunConfResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewBaseResp()
+	_field := NewUpdateExptRunConfResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.BaseResp = _field
+	p.Success = _field
 	return offset, nil
 }
 
-func (p *GetAnalysisRecordFeedbackVoteResponse) FastWrite(buf []byte) int {
+func (p *ExperimentServiceUpdateExptRunConfResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *GetAnalysisRecordFeedbackVoteResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateExptRunConfResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField255(buf[offset:], w)
+		offset += p.fastWriteField0(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *GetAnalysisRecordFeedbackVoteResponse) BLength() int {
+func (p *ExperimentServiceUpdateExptRunConfResult) BLength() int {
 	l := 0
 	if p != nil {
-		l += p.field1Length()
-		l += p.field255Length()
+		l += p.field0Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *GetAnalysisRecordFeedbackVoteResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateExptRunConfResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetVote() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-		offset += p.Vote.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *GetAnalysisRecordFeedbackVoteResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
-	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
-	return offset
-}
-
-func (p *GetAnalysisRecordFeedbackVoteResponse) field1Length() int {
+func (p *ExperimentServiceUpdateExptRunConfResult) field0Length() int {
 	l := 0
-	if p.IsSetVote() {
+	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Vote.BLength()
+		l += p.Success.BLength()
 	}
 	return l
 }
 
-func (p *GetAnalysisRecordFeedbackVoteResponse) field255Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.BaseResp.BLength()
-	return l
-}
-
-func (p *GetAnalysisRecordFeedbackVoteResponse) DeepCopy(s interface{}) error {
-	src, ok := s.(*GetAnalysisRecordFeedbackVoteResponse)
+func (p *ExperimentServiceUpdateExptRunConfResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpdateExptRunConfResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _vote *expt.ExptInsightAnalysisFeedbackVote
-	if src.Vote != nil {
-		_vote = &expt.ExptInsightAnalysisFeedbackVote{}
-		if err := _vote.DeepCopy(src.Vote); err != nil {
-			return err
-		}
-	}
-	p.Vote = _vote
-
-	var _baseResp *base.BaseResp
-	if src.BaseResp != nil {
-		_baseResp = &base.BaseResp{}
-		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+	var _success *UpdateExptRunConfResponse
+	if src.Success != nil {
+		_success = &UpdateExptRunConfResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
 	}
-	p.BaseResp = _baseResp
+	p.Success = _success
 
 	return nil
 }
 
-func (p *ExperimentServiceCheckExperimentNameArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -32316,14 +40081,14 @@ func (p *ExperimentServiceCheckExperimentNameArgs) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCheckExperimentNameArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCheckExperimentNameArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCheckExperimentNameRequest()
+	_field := NewDeleteExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -32333,11 +40098,11 @@ func (p *ExperimentServiceCheckExperimentNameArgs) FastReadField1(buf []byte) (i
 	return offset, nil
 }
 
-func (p *ExperimentServiceCheckExperimentNameArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceDeleteExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCheckExperimentNameArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -32346,7 +40111,7 @@ func (p *ExperimentServiceCheckExperimentNameArgs) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceCheckExperimentNameArgs) BLength() int {
+func (p *ExperimentServiceDeleteExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -32355,29 +40120,29 @@ func (p *ExperimentServiceCheckExperimentNameArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCheckExperimentNameArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceCheckExperimentNameArgs) field1Length() int {
+func (p *ExperimentServiceDeleteExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceCheckExperimentNameArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCheckExperimentNameArgs)
+func (p *ExperimentServiceDeleteExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceDeleteExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CheckExperimentNameRequest
+	var _req *DeleteExperimentRequest
 	if src.Req != nil {
-		_req = &CheckExperimentNameRequest{}
+		_req = &DeleteExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -32387,7 +40152,7 @@ func (p *ExperimentServiceCheckExperimentNameArgs) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceCheckExperimentNameResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -32431,14 +40196,14 @@ func (p *ExperimentServiceCheckExperimentNameResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCheckExperimentNameResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCheckExperimentNameResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCheckExperimentNameResponse()
+	_field := NewDeleteExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -32448,11 +40213,11 @@ func (p *ExperimentServiceCheckExperimentNameResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *ExperimentServiceCheckExperimentNameResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceDeleteExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCheckExperimentNameResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -32461,7 +40226,7 @@ func (p *ExperimentServiceCheckExperimentNameResult) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceCheckExperimentNameResult) BLength() int {
+func (p *ExperimentServiceDeleteExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -32470,7 +40235,7 @@ func (p *ExperimentServiceCheckExperimentNameResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCheckExperimentNameResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -32479,7 +40244,7 @@ func (p *ExperimentServiceCheckExperimentNameResult) fastWriteField0(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceCheckExperimentNameResult) field0Length() int {
+func (p *ExperimentServiceDeleteExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -32488,15 +40253,15 @@ func (p *ExperimentServiceCheckExperimentNameResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceCheckExperimentNameResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCheckExperimentNameResult)
+func (p *ExperimentServiceDeleteExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceDeleteExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CheckExperimentNameResponse
+	var _success *DeleteExperimentResponse
 	if src.Success != nil {
-		_success = &CheckExperimentNameResponse{}
+		_success = &DeleteExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -32506,7 +40271,7 @@ func (p *ExperimentServiceCheckExperimentNameResult) DeepCopy(s interface{}) err
 	return nil
 }
 
-func (p *ExperimentServiceCreateExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -32550,14 +40315,14 @@ func (p *ExperimentServiceCreateExperimentArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchDeleteExperimentsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCreateExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateExperimentRequest()
+	_field := NewBatchDeleteExperimentsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -32567,11 +40332,11 @@ func (p *ExperimentServiceCreateExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceCreateExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCreateExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -32580,7 +40345,7 @@ func (p *ExperimentServiceCreateExperimentArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceCreateExperimentArgs) BLength() int {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -32589,29 +40354,29 @@ func (p *ExperimentServiceCreateExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCreateExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceCreateExperimentArgs) field1Length() int {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceCreateExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCreateExperimentArgs)
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchDeleteExperimentsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CreateExperimentRequest
+	var _req *BatchDeleteExperimentsRequest
 	if src.Req != nil {
-		_req = &CreateExperimentRequest{}
+		_req = &BatchDeleteExperimentsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -32621,7 +40386,7 @@ func (p *ExperimentServiceCreateExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceCreateExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -32665,14 +40430,14 @@ func (p *ExperimentServiceCreateExperimentResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchDeleteExperimentsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCreateExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateExperimentResponse()
+	_field := NewBatchDeleteExperimentsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -32682,11 +40447,11 @@ func (p *ExperimentServiceCreateExperimentResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ExperimentServiceCreateExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCreateExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -32695,7 +40460,7 @@ func (p *ExperimentServiceCreateExperimentResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceCreateExperimentResult) BLength() int {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -32704,7 +40469,7 @@ func (p *ExperimentServiceCreateExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCreateExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -32713,7 +40478,7 @@ func (p *ExperimentServiceCreateExperimentResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceCreateExperimentResult) field0Length() int {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -32722,15 +40487,15 @@ func (p *ExperimentServiceCreateExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceCreateExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCreateExperimentResult)
+func (p *ExperimentServiceBatchDeleteExperimentsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchDeleteExperimentsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CreateExperimentResponse
+	var _success *BatchDeleteExperimentsResponse
 	if src.Success != nil {
-		_success = &CreateExperimentResponse{}
+		_success = &BatchDeleteExperimentsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -32740,7 +40505,7 @@ func (p *ExperimentServiceCreateExperimentResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCloneExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -32784,14 +40549,14 @@ func (p *ExperimentServiceSubmitExperimentArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceSubmitExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCloneExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceCloneExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSubmitExperimentRequest()
+	_field := NewCloneExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -32801,11 +40566,11 @@ func (p *ExperimentServiceSubmitExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCloneExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCloneExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -32814,7 +40579,7 @@ func (p *ExperimentServiceSubmitExperimentArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) BLength() int {
+func (p *ExperimentServiceCloneExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -32823,29 +40588,29 @@ func (p *ExperimentServiceSubmitExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCloneExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) field1Length() int {
+func (p *ExperimentServiceCloneExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceSubmitExperimentArgs)
+func (p *ExperimentServiceCloneExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCloneExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *SubmitExperimentRequest
+	var _req *CloneExperimentRequest
 	if src.Req != nil {
-		_req = &SubmitExperimentRequest{}
+		_req = &CloneExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -32855,7 +40620,7 @@ func (p *ExperimentServiceSubmitExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCloneExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -32899,14 +40664,14 @@ func (p *ExperimentServiceSubmitExperimentResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceSubmitExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCloneExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceCloneExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSubmitExperimentResponse()
+	_field := NewCloneExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -32916,11 +40681,11 @@ func (p *ExperimentServiceSubmitExperimentResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCloneExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCloneExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -32929,7 +40694,7 @@ func (p *ExperimentServiceSubmitExperimentResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) BLength() int {
+func (p *ExperimentServiceCloneExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -32938,7 +40703,7 @@ func (p *ExperimentServiceSubmitExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCloneExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -32947,7 +40712,7 @@ func (p *ExperimentServiceSubmitExperimentResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) field0Length() int {
+func (p *ExperimentServiceCloneExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -32956,15 +40721,15 @@ func (p *ExperimentServiceSubmitExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceSubmitExperimentResult)
+func (p *ExperimentServiceCloneExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCloneExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *SubmitExperimentResponse
+	var _success *CloneExperimentResponse
 	if src.Success != nil {
-		_success = &SubmitExperimentResponse{}
+		_success = &CloneExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -32974,7 +40739,7 @@ func (p *ExperimentServiceSubmitExperimentResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceRunExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -33018,14 +40783,14 @@ func (p *ExperimentServiceBatchGetExperimentsArgs) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRunExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceRunExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetExperimentsRequest()
+	_field := NewRunExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -33035,11 +40800,11 @@ func (p *ExperimentServiceBatchGetExperimentsArgs) FastReadField1(buf []byte) (i
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceRunExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRunExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -33048,7 +40813,7 @@ func (p *ExperimentServiceBatchGetExperimentsArgs) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) BLength() int {
+func (p *ExperimentServiceRunExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -33057,29 +40822,29 @@ func (p *ExperimentServiceBatchGetExperimentsArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRunExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) field1Length() int {
+func (p *ExperimentServiceRunExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchGetExperimentsArgs)
+func (p *ExperimentServiceRunExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceRunExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchGetExperimentsRequest
+	var _req *RunExperimentRequest
 	if src.Req != nil {
-		_req = &BatchGetExperimentsRequest{}
+		_req = &RunExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -33089,7 +40854,7 @@ func (p *ExperimentServiceBatchGetExperimentsArgs) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceRunExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -33133,14 +40898,14 @@ func (p *ExperimentServiceBatchGetExperimentsResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRunExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceRunExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetExperimentsResponse()
+	_field := NewRunExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -33150,11 +40915,11 @@ func (p *ExperimentServiceBatchGetExperimentsResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceRunExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRunExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -33163,7 +40928,7 @@ func (p *ExperimentServiceBatchGetExperimentsResult) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) BLength() int {
+func (p *ExperimentServiceRunExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -33172,7 +40937,7 @@ func (p *ExperimentServiceBatchGetExperimentsResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRunExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -33181,7 +40946,7 @@ func (p *ExperimentServiceBatchGetExperimentsResult) fastWriteField0(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) field0Length() int {
+func (p *ExperimentServiceRunExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -33190,15 +40955,15 @@ func (p *ExperimentServiceBatchGetExperimentsResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchGetExperimentsResult)
+func (p *ExperimentServiceRunExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceRunExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchGetExperimentsResponse
+	var _success *RunExperimentResponse
 	if src.Success != nil {
-		_success = &BatchGetExperimentsResponse{}
+		_success = &RunExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -33208,7 +40973,7 @@ func (p *ExperimentServiceBatchGetExperimentsResult) DeepCopy(s interface{}) err
 	return nil
 }
 
-func (p *ExperimentServiceGetExperimentIDsByGroupArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceRetryExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -33252,14 +41017,14 @@ func (p *ExperimentServiceGetExperimentIDsByGroupArgs) FastRead(buf []byte) (int
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExperimentIDsByGroupArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRetryExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceGetExperimentIDsByGroupArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceRetryExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetExperimentIDsByGroupRequest()
+	_field := NewRetryExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -33269,11 +41034,11 @@ func (p *ExperimentServiceGetExperimentIDsByGroupArgs) FastReadField1(buf []byte
 	return offset, nil
 }
 
-func (p *ExperimentServiceGetExperimentIDsByGroupArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceRetryExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceGetExperimentIDsByGroupArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRetryExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -33282,7 +41047,7 @@ func (p *ExperimentServiceGetExperimentIDsByGroupArgs) FastWriteNocopy(buf []byt
 	return offset
 }
 
-func (p *ExperimentServiceGetExperimentIDsByGroupArgs) BLength() int {
+func (p *ExperimentServiceRetryExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -33291,29 +41056,29 @@ func (p *ExperimentServiceGetExperimentIDsByGroupArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceGetExperimentIDsByGroupArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRetryExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceGetExperimentIDsByGroupArgs) field1Length() int {
+func (p *ExperimentServiceRetryExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceGetExperimentIDsByGroupArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceGetExperimentIDsByGroupArgs)
+func (p *ExperimentServiceRetryExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceRetryExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *GetExperimentIDsByGroupRequest
+	var _req *RetryExperimentRequest
 	if src.Req != nil {
-		_req = &GetExperimentIDsByGroupRequest{}
+		_req = &RetryExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -33323,7 +41088,7 @@ func (p *ExperimentServiceGetExperimentIDsByGroupArgs) DeepCopy(s interface{}) e
 	return nil
 }
 
-func (p *ExperimentServiceGetExperimentIDsByGroupResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceRetryExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -33367,14 +41132,14 @@ func (p *ExperimentServiceGetExperimentIDsByGroupResult) FastRead(buf []byte) (i
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExperimentIDsByGroupResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRetryExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceGetExperimentIDsByGroupResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceRetryExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetExperimentIDsByGroupResponse()
+	_field := NewRetryExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -33384,11 +41149,11 @@ func (p *ExperimentServiceGetExperimentIDsByGroupResult) FastReadField0(buf []by
 	return offset, nil
 }
 
-func (p *ExperimentServiceGetExperimentIDsByGroupResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceRetryExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceGetExperimentIDsByGroupResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRetryExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -33397,7 +41162,7 @@ func (p *ExperimentServiceGetExperimentIDsByGroupResult) FastWriteNocopy(buf []b
 	return offset
 }
 
-func (p *ExperimentServiceGetExperimentIDsByGroupResult) BLength() int {
+func (p *ExperimentServiceRetryExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -33406,7 +41171,7 @@ func (p *ExperimentServiceGetExperimentIDsByGroupResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceGetExperimentIDsByGroupResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRetryExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -33415,7 +41180,7 @@ func (p *ExperimentServiceGetExperimentIDsByGroupResult) fastWriteField0(buf []b
 	return offset
 }
 
-func (p *ExperimentServiceGetExperimentIDsByGroupResult) field0Length() int {
+func (p *ExperimentServiceRetryExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -33424,15 +41189,15 @@ func (p *ExperimentServiceGetExperimentIDsByGroupResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceGetExperimentIDsByGroupResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceGetExperimentIDsByGroupResult)
+func (p *ExperimentServiceRetryExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceRetryExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetExperimentIDsByGroupResponse
+	var _success *RetryExperimentResponse
 	if src.Success != nil {
-		_success = &GetExperimentIDsByGroupResponse{}
+		_success = &RetryExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -33442,7 +41207,7 @@ func (p *ExperimentServiceGetExperimentIDsByGroupResult) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *ExperimentServiceListExperimentsArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceKillExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -33486,14 +41251,14 @@ func (p *ExperimentServiceListExperimentsArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceKillExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExperimentsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceKillExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExperimentsRequest()
+	_field := NewKillExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -33503,11 +41268,11 @@ func (p *ExperimentServiceListExperimentsArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExperimentsArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceKillExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExperimentsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceKillExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -33516,7 +41281,7 @@ func (p *ExperimentServiceListExperimentsArgs) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentsArgs) BLength() int {
+func (p *ExperimentServiceKillExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -33525,29 +41290,29 @@ func (p *ExperimentServiceListExperimentsArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExperimentsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceKillExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentsArgs) field1Length() int {
+func (p *ExperimentServiceKillExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceListExperimentsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExperimentsArgs)
+func (p *ExperimentServiceKillExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceKillExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListExperimentsRequest
+	var _req *KillExperimentRequest
 	if src.Req != nil {
-		_req = &ListExperimentsRequest{}
+		_req = &KillExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -33557,7 +41322,7 @@ func (p *ExperimentServiceListExperimentsArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceListExperimentsResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceKillExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -33601,14 +41366,14 @@ func (p *ExperimentServiceListExperimentsResult) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceKillExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExperimentsResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceKillExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExperimentsResponse()
+	_field := NewKillExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -33618,11 +41383,11 @@ func (p *ExperimentServiceListExperimentsResult) FastReadField0(buf []byte) (int
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExperimentsResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceKillExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceKillExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -33631,7 +41396,7 @@ func (p *ExperimentServiceListExperimentsResult) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentsResult) BLength() int {
+func (p *ExperimentServiceKillExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -33640,7 +41405,7 @@ func (p *ExperimentServiceListExperimentsResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceKillExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -33649,7 +41414,7 @@ func (p *ExperimentServiceListExperimentsResult) fastWriteField0(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentsResult) field0Length() int {
+func (p *ExperimentServiceKillExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -33658,15 +41423,15 @@ func (p *ExperimentServiceListExperimentsResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceListExperimentsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExperimentsResult)
+func (p *ExperimentServiceKillExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceKillExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListExperimentsResponse
+	var _success *KillExperimentResponse
 	if src.Success != nil {
-		_success = &ListExperimentsResponse{}
+		_success = &KillExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -33676,7 +41441,7 @@ func (p *ExperimentServiceListExperimentsResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -33720,14 +41485,14 @@ func (p *ExperimentServiceUpdateExperimentArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentResultArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateExperimentRequest()
+	_field := NewBatchGetExperimentResultRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -33737,11 +41502,11 @@ func (p *ExperimentServiceUpdateExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -33750,7 +41515,7 @@ func (p *ExperimentServiceUpdateExperimentArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) BLength() int {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -33759,29 +41524,29 @@ func (p *ExperimentServiceUpdateExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) field1Length() int {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceUpdateExperimentArgs)
+func (p *ExperimentServiceBatchGetExperimentResultArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchGetExperimentResultArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *UpdateExperimentRequest
+	var _req *BatchGetExperimentResultRequest
 	if src.Req != nil {
-		_req = &UpdateExperimentRequest{}
+		_req = &BatchGetExperimentResultRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -33791,7 +41556,7 @@ func (p *ExperimentServiceUpdateExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentResultResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -33835,14 +41600,14 @@ func (p *ExperimentServiceUpdateExperimentResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentResultResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentResultResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateExperimentResponse()
+	_field := NewBatchGetExperimentResultResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -33852,11 +41617,11 @@ func (p *ExperimentServiceUpdateExperimentResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchGetExperimentResultResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -33865,7 +41630,7 @@ func (p *ExperimentServiceUpdateExperimentResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) BLength() int {
+func (p *ExperimentServiceBatchGetExperimentResultResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -33874,7 +41639,7 @@ func (p *ExperimentServiceUpdateExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -33883,7 +41648,7 @@ func (p *ExperimentServiceUpdateExperimentResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) field0Length() int {
+func (p *ExperimentServiceBatchGetExperimentResultResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -33892,15 +41657,15 @@ func (p *ExperimentServiceUpdateExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceUpdateExperimentResult)
+func (p *ExperimentServiceBatchGetExperimentResultResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchGetExperimentResultResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpdateExperimentResponse
+	var _success *BatchGetExperimentResultResponse
 	if src.Success != nil {
-		_success = &UpdateExperimentResponse{}
+		_success = &BatchGetExperimentResultResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -33910,7 +41675,7 @@ func (p *ExperimentServiceUpdateExperimentResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceUpdateExptRunConfArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -33954,14 +41719,14 @@ func (p *ExperimentServiceUpdateExptRunConfArgs) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExptRunConfArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceMGetExperimentStandardEvalOutputsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceUpdateExptRunConfArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateExptRunConfRequest()
+	_field := NewMGetExperimentStandardEvalOutputsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -33971,11 +41736,11 @@ func (p *ExperimentServiceUpdateExptRunConfArgs) FastReadField1(buf []byte) (int
 	return offset, nil
 }
 
-func (p *ExperimentServiceUpdateExptRunConfArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceUpdateExptRunConfArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -33984,7 +41749,7 @@ func (p *ExperimentServiceUpdateExptRunConfArgs) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceUpdateExptRunConfArgs) BLength() int {
+func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -33993,29 +41758,29 @@ func (p *ExperimentServiceUpdateExptRunConfArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateExptRunConfArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceUpdateExptRunConfArgs) field1Length() int {
+func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceUpdateExptRunConfArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceUpdateExptRunConfArgs)
+func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceMGetExperimentStandardEvalOutputsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *UpdateExptRunConfRequest
+	var _req *MGetExperimentStandardEvalOutputsRequest
 	if src.Req != nil {
-		_req = &UpdateExptRunConfRequest{}
+		_req = &MGetExperimentStandardEvalOutputsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -34025,7 +41790,7 @@ func (p *ExperimentServiceUpdateExptRunConfArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceUpdateExptRunConfResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -34069,14 +41834,14 @@ func (p *ExperimentServiceUpdateExptRunConfResult) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExptRunConfResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceMGetExperimentStandardEvalOutputsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceUpdateExptRunConfResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateExptRunConfResponse()
+	_field := NewMGetExperimentStandardEvalOutputsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -34086,11 +41851,11 @@ func (p *ExperimentServiceUpdateExptRunConfResult) FastReadField0(buf []byte) (i
 	return offset, nil
 }
 
-func (p *ExperimentServiceUpdateExptRunConfResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceUpdateExptRunConfResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -34099,7 +41864,7 @@ func (p *ExperimentServiceUpdateExptRunConfResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceUpdateExptRunConfResult) BLength() int {
+func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -34108,7 +41873,7 @@ func (p *ExperimentServiceUpdateExptRunConfResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateExptRunConfResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -34117,7 +41882,7 @@ func (p *ExperimentServiceUpdateExptRunConfResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceUpdateExptRunConfResult) field0Length() int {
+func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -34126,15 +41891,15 @@ func (p *ExperimentServiceUpdateExptRunConfResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateExptRunConfResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceUpdateExptRunConfResult)
+func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceMGetExperimentStandardEvalOutputsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpdateExptRunConfResponse
+	var _success *MGetExperimentStandardEvalOutputsResponse
 	if src.Success != nil {
-		_success = &UpdateExptRunConfResponse{}
+		_success = &MGetExperimentStandardEvalOutputsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -34144,7 +41909,7 @@ func (p *ExperimentServiceUpdateExptRunConfResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -34188,14 +41953,14 @@ func (p *ExperimentServiceDeleteExperimentArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentStandardEvalOutputsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteExperimentRequest()
+	_field := NewListExperimentStandardEvalOutputsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -34205,11 +41970,11 @@ func (p *ExperimentServiceDeleteExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -34218,7 +41983,7 @@ func (p *ExperimentServiceDeleteExperimentArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) BLength() int {
+func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -34227,29 +41992,29 @@ func (p *ExperimentServiceDeleteExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) field1Length() int {
+func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceDeleteExperimentArgs)
+func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExperimentStandardEvalOutputsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *DeleteExperimentRequest
+	var _req *ListExperimentStandardEvalOutputsRequest
 	if src.Req != nil {
-		_req = &DeleteExperimentRequest{}
+		_req = &ListExperimentStandardEvalOutputsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -34259,7 +42024,7 @@ func (p *ExperimentServiceDeleteExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -34303,14 +42068,14 @@ func (p *ExperimentServiceDeleteExperimentResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentStandardEvalOutputsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteExperimentResponse()
+	_field := NewListExperimentStandardEvalOutputsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -34320,11 +42085,11 @@ func (p *ExperimentServiceDeleteExperimentResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -34333,7 +42098,7 @@ func (p *ExperimentServiceDeleteExperimentResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) BLength() int {
+func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -34342,7 +42107,7 @@ func (p *ExperimentServiceDeleteExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -34351,7 +42116,7 @@ func (p *ExperimentServiceDeleteExperimentResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) field0Length() int {
+func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -34360,15 +42125,15 @@ func (p *ExperimentServiceDeleteExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceDeleteExperimentResult)
+func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExperimentStandardEvalOutputsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *DeleteExperimentResponse
+	var _success *ListExperimentStandardEvalOutputsResponse
 	if src.Success != nil {
-		_success = &DeleteExperimentResponse{}
+		_success = &ListExperimentStandardEvalOutputsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -34378,7 +42143,7 @@ func (p *ExperimentServiceDeleteExperimentResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -34422,14 +42187,14 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchDeleteExperimentsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCalculateExperimentAggrResultArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchDeleteExperimentsRequest()
+	_field := NewCalculateExperimentAggrResultRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -34439,11 +42204,11 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastReadField1(buf []byte)
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -34452,7 +42217,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) BLength() int {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -34461,29 +42226,29 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) field1Length() int {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchDeleteExperimentsArgs)
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCalculateExperimentAggrResultArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchDeleteExperimentsRequest
+	var _req *CalculateExperimentAggrResultRequest
 	if src.Req != nil {
-		_req = &BatchDeleteExperimentsRequest{}
+		_req = &CalculateExperimentAggrResultRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -34493,7 +42258,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) DeepCopy(s interface{}) er
 	return nil
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -34537,14 +42302,14 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) FastRead(buf []byte) (in
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchDeleteExperimentsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCalculateExperimentAggrResultResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchDeleteExperimentsResponse()
+	_field := NewCalculateExperimentAggrResultResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -34554,11 +42319,11 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) FastReadField0(buf []byt
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -34567,7 +42332,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) FastWriteNocopy(buf []by
 	return offset
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) BLength() int {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -34576,7 +42341,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -34585,7 +42350,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) fastWriteField0(buf []by
 	return offset
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) field0Length() int {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -34594,15 +42359,15 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchDeleteExperimentsResult)
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCalculateExperimentAggrResultResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchDeleteExperimentsResponse
+	var _success *CalculateExperimentAggrResultResponse
 	if src.Success != nil {
-		_success = &BatchDeleteExperimentsResponse{}
+		_success = &CalculateExperimentAggrResultResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -34612,7 +42377,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -34656,14 +42421,14 @@ func (p *ExperimentServiceCloneExperimentArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCloneExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCloneExperimentRequest()
+	_field := NewBatchGetExperimentAggrResultRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -34673,11 +42438,11 @@ func (p *ExperimentServiceCloneExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -34686,7 +42451,7 @@ func (p *ExperimentServiceCloneExperimentArgs) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) BLength() int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -34695,29 +42460,29 @@ func (p *ExperimentServiceCloneExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) field1Length() int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCloneExperimentArgs)
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchGetExperimentAggrResultArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CloneExperimentRequest
+	var _req *BatchGetExperimentAggrResultRequest
 	if src.Req != nil {
-		_req = &CloneExperimentRequest{}
+		_req = &BatchGetExperimentAggrResultRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -34727,7 +42492,7 @@ func (p *ExperimentServiceCloneExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceCloneExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -34771,14 +42536,14 @@ func (p *ExperimentServiceCloneExperimentResult) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCloneExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCloneExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCloneExperimentResponse()
+	_field := NewBatchGetExperimentAggrResultResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -34788,11 +42553,11 @@ func (p *ExperimentServiceCloneExperimentResult) FastReadField0(buf []byte) (int
 	return offset, nil
 }
 
-func (p *ExperimentServiceCloneExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCloneExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -34801,7 +42566,7 @@ func (p *ExperimentServiceCloneExperimentResult) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceCloneExperimentResult) BLength() int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -34810,7 +42575,7 @@ func (p *ExperimentServiceCloneExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCloneExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -34819,7 +42584,7 @@ func (p *ExperimentServiceCloneExperimentResult) fastWriteField0(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceCloneExperimentResult) field0Length() int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -34828,15 +42593,15 @@ func (p *ExperimentServiceCloneExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceCloneExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCloneExperimentResult)
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchGetExperimentAggrResultResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CloneExperimentResponse
+	var _success *BatchGetExperimentAggrResultResponse
 	if src.Success != nil {
-		_success = &CloneExperimentResponse{}
+		_success = &BatchGetExperimentAggrResultResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -34846,7 +42611,7 @@ func (p *ExperimentServiceCloneExperimentResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceRunExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceInvokeExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -34890,14 +42655,14 @@ func (p *ExperimentServiceRunExperimentArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRunExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceInvokeExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceRunExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceInvokeExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRunExperimentRequest()
+	_field := NewInvokeExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -34907,11 +42672,11 @@ func (p *ExperimentServiceRunExperimentArgs) FastReadField1(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *ExperimentServiceRunExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceInvokeExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceRunExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceInvokeExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -34920,7 +42685,7 @@ func (p *ExperimentServiceRunExperimentArgs) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *ExperimentServiceRunExperimentArgs) BLength() int {
+func (p *ExperimentServiceInvokeExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -34929,29 +42694,29 @@ func (p *ExperimentServiceRunExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceRunExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceInvokeExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceRunExperimentArgs) field1Length() int {
+func (p *ExperimentServiceInvokeExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceRunExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceRunExperimentArgs)
+func (p *ExperimentServiceInvokeExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceInvokeExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *RunExperimentRequest
+	var _req *InvokeExperimentRequest
 	if src.Req != nil {
-		_req = &RunExperimentRequest{}
+		_req = &InvokeExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -34961,7 +42726,7 @@ func (p *ExperimentServiceRunExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceRunExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceInvokeExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -35005,14 +42770,14 @@ func (p *ExperimentServiceRunExperimentResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRunExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceInvokeExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceRunExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceInvokeExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRunExperimentResponse()
+	_field := NewInvokeExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -35022,11 +42787,11 @@ func (p *ExperimentServiceRunExperimentResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceRunExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceInvokeExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceRunExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceInvokeExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -35035,7 +42800,7 @@ func (p *ExperimentServiceRunExperimentResult) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *ExperimentServiceRunExperimentResult) BLength() int {
+func (p *ExperimentServiceInvokeExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -35044,7 +42809,7 @@ func (p *ExperimentServiceRunExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceRunExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceInvokeExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -35053,7 +42818,7 @@ func (p *ExperimentServiceRunExperimentResult) fastWriteField0(buf []byte, w thr
 	return offset
 }
 
-func (p *ExperimentServiceRunExperimentResult) field0Length() int {
+func (p *ExperimentServiceInvokeExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -35062,15 +42827,15 @@ func (p *ExperimentServiceRunExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceRunExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceRunExperimentResult)
+func (p *ExperimentServiceInvokeExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceInvokeExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *RunExperimentResponse
+	var _success *InvokeExperimentResponse
 	if src.Success != nil {
-		_success = &RunExperimentResponse{}
+		_success = &InvokeExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -35080,7 +42845,7 @@ func (p *ExperimentServiceRunExperimentResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceFinishExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -35124,14 +42889,14 @@ func (p *ExperimentServiceRetryExperimentArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRetryExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceFinishExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceFinishExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRetryExperimentRequest()
+	_field := NewFinishExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -35141,11 +42906,11 @@ func (p *ExperimentServiceRetryExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceFinishExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceFinishExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -35154,7 +42919,7 @@ func (p *ExperimentServiceRetryExperimentArgs) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) BLength() int {
+func (p *ExperimentServiceFinishExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -35163,29 +42928,29 @@ func (p *ExperimentServiceRetryExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceFinishExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) field1Length() int {
+func (p *ExperimentServiceFinishExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceRetryExperimentArgs)
+func (p *ExperimentServiceFinishExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceFinishExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *RetryExperimentRequest
+	var _req *FinishExperimentRequest
 	if src.Req != nil {
-		_req = &RetryExperimentRequest{}
+		_req = &FinishExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -35195,7 +42960,7 @@ func (p *ExperimentServiceRetryExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceRetryExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceFinishExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -35239,14 +43004,14 @@ func (p *ExperimentServiceRetryExperimentResult) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRetryExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceFinishExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceRetryExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceFinishExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRetryExperimentResponse()
+	_field := NewFinishExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -35256,11 +43021,11 @@ func (p *ExperimentServiceRetryExperimentResult) FastReadField0(buf []byte) (int
 	return offset, nil
 }
 
-func (p *ExperimentServiceRetryExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceFinishExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceRetryExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceFinishExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -35269,7 +43034,7 @@ func (p *ExperimentServiceRetryExperimentResult) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceRetryExperimentResult) BLength() int {
+func (p *ExperimentServiceFinishExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -35278,7 +43043,7 @@ func (p *ExperimentServiceRetryExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceRetryExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceFinishExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -35287,7 +43052,7 @@ func (p *ExperimentServiceRetryExperimentResult) fastWriteField0(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceRetryExperimentResult) field0Length() int {
+func (p *ExperimentServiceFinishExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -35296,15 +43061,15 @@ func (p *ExperimentServiceRetryExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceRetryExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceRetryExperimentResult)
+func (p *ExperimentServiceFinishExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceFinishExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *RetryExperimentResponse
+	var _success *FinishExperimentResponse
 	if src.Success != nil {
-		_success = &RetryExperimentResponse{}
+		_success = &FinishExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -35314,7 +43079,7 @@ func (p *ExperimentServiceRetryExperimentResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceKillExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentStatsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -35358,14 +43123,14 @@ func (p *ExperimentServiceKillExperimentArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceKillExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentStatsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceKillExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentStatsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewKillExperimentRequest()
+	_field := NewListExperimentStatsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -35375,11 +43140,11 @@ func (p *ExperimentServiceKillExperimentArgs) FastReadField1(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *ExperimentServiceKillExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExperimentStatsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceKillExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExperimentStatsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -35388,7 +43153,7 @@ func (p *ExperimentServiceKillExperimentArgs) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *ExperimentServiceKillExperimentArgs) BLength() int {
+func (p *ExperimentServiceListExperimentStatsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -35397,29 +43162,29 @@ func (p *ExperimentServiceKillExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceKillExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExperimentStatsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceKillExperimentArgs) field1Length() int {
+func (p *ExperimentServiceListExperimentStatsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceKillExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceKillExperimentArgs)
+func (p *ExperimentServiceListExperimentStatsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExperimentStatsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *KillExperimentRequest
+	var _req *ListExperimentStatsRequest
 	if src.Req != nil {
-		_req = &KillExperimentRequest{}
+		_req = &ListExperimentStatsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -35429,7 +43194,7 @@ func (p *ExperimentServiceKillExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceKillExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentStatsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -35473,14 +43238,14 @@ func (p *ExperimentServiceKillExperimentResult) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceKillExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentStatsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceKillExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceListExperimentStatsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewKillExperimentResponse()
+	_field := NewListExperimentStatsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -35490,11 +43255,11 @@ func (p *ExperimentServiceKillExperimentResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceKillExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExperimentStatsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceKillExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExperimentStatsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -35503,7 +43268,7 @@ func (p *ExperimentServiceKillExperimentResult) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceKillExperimentResult) BLength() int {
+func (p *ExperimentServiceListExperimentStatsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -35512,7 +43277,7 @@ func (p *ExperimentServiceKillExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceKillExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExperimentStatsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -35521,7 +43286,7 @@ func (p *ExperimentServiceKillExperimentResult) fastWriteField0(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceKillExperimentResult) field0Length() int {
+func (p *ExperimentServiceListExperimentStatsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -35530,15 +43295,15 @@ func (p *ExperimentServiceKillExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceKillExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceKillExperimentResult)
+func (p *ExperimentServiceListExperimentStatsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExperimentStatsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *KillExperimentResponse
+	var _success *ListExperimentStatsResponse
 	if src.Success != nil {
-		_success = &KillExperimentResponse{}
+		_success = &ListExperimentStatsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -35548,7 +43313,7 @@ func (p *ExperimentServiceKillExperimentResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -35592,14 +43357,14 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) FastRead(buf []byte) (in
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentResultArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpsertExptTurnResultFilterArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetExperimentResultRequest()
+	_field := NewUpsertExptTurnResultFilterRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -35609,11 +43374,11 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) FastReadField1(buf []byt
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -35622,7 +43387,7 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) FastWriteNocopy(buf []by
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) BLength() int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -35631,29 +43396,29 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) field1Length() int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchGetExperimentResultArgs)
+func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpsertExptTurnResultFilterArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchGetExperimentResultRequest
+	var _req *UpsertExptTurnResultFilterRequest
 	if src.Req != nil {
-		_req = &BatchGetExperimentResultRequest{}
+		_req = &UpsertExptTurnResultFilterRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -35663,7 +43428,7 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -35707,14 +43472,14 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) FastRead(buf []byte) (
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentResultResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpsertExptTurnResultFilterResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetExperimentResultResponse()
+	_field := NewUpsertExptTurnResultFilterResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -35724,11 +43489,11 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) FastReadField0(buf []b
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -35737,7 +43502,7 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) FastWriteNocopy(buf []
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) BLength() int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -35746,7 +43511,7 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -35755,7 +43520,7 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) fastWriteField0(buf []
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) field0Length() int {
+func (p *ExperimentServiceUpsertExptTurnResultFilterResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -35764,15 +43529,15 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchGetExperimentResultResult)
+func (p *ExperimentServiceUpsertExptTurnResultFilterResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpsertExptTurnResultFilterResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchGetExperimentResultResponse
+	var _success *UpsertExptTurnResultFilterResponse
 	if src.Success != nil {
-		_success = &BatchGetExperimentResultResponse{}
+		_success = &UpsertExptTurnResultFilterResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -35782,7 +43547,7 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) DeepCopy(s interface{}
 	return nil
 }
 
-func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceAssociateAnnotationTagArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -35826,14 +43591,14 @@ func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) FastRead(buf []
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceMGetExperimentStandardEvalOutputsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceAssociateAnnotationTagArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceAssociateAnnotationTagArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewMGetExperimentStandardEvalOutputsRequest()
+	_field := NewAssociateAnnotationTagReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -35843,11 +43608,11 @@ func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) FastReadField1(
 	return offset, nil
 }
 
-func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceAssociateAnnotationTagArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceAssociateAnnotationTagArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -35856,7 +43621,7 @@ func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) FastWriteNocopy
 	return offset
 }
 
-func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) BLength() int {
+func (p *ExperimentServiceAssociateAnnotationTagArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -35865,29 +43630,29 @@ func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceAssociateAnnotationTagArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) field1Length() int {
+func (p *ExperimentServiceAssociateAnnotationTagArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceMGetExperimentStandardEvalOutputsArgs)
+func (p *ExperimentServiceAssociateAnnotationTagArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceAssociateAnnotationTagArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *MGetExperimentStandardEvalOutputsRequest
+	var _req *AssociateAnnotationTagReq
 	if src.Req != nil {
-		_req = &MGetExperimentStandardEvalOutputsRequest{}
+		_req = &AssociateAnnotationTagReq{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -35897,7 +43662,7 @@ func (p *ExperimentServiceMGetExperimentStandardEvalOutputsArgs) DeepCopy(s inte
 	return nil
 }
 
-func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceAssociateAnnotationTagResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -35941,14 +43706,14 @@ func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) FastRead(buf
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceMGetExperimentStandardEvalOutputsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceAssociateAnnotationTagResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceAssociateAnnotationTagResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewMGetExperimentStandardEvalOutputsResponse()
+	_field := NewAssociateAnnotationTagResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -35958,11 +43723,11 @@ func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) FastReadField
 	return offset, nil
 }
 
-func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceAssociateAnnotationTagResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceAssociateAnnotationTagResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -35971,7 +43736,7 @@ func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) FastWriteNoco
 	return offset
 }
 
-func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) BLength() int {
+func (p *ExperimentServiceAssociateAnnotationTagResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -35980,7 +43745,7 @@ func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) BLength() int
 	return l
 }
 
-func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceAssociateAnnotationTagResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -35989,7 +43754,7 @@ func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) fastWriteFiel
 	return offset
 }
 
-func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) field0Length() int {
+func (p *ExperimentServiceAssociateAnnotationTagResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -35998,15 +43763,15 @@ func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) field0Length(
 	return l
 }
 
-func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceMGetExperimentStandardEvalOutputsResult)
+func (p *ExperimentServiceAssociateAnnotationTagResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceAssociateAnnotationTagResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *MGetExperimentStandardEvalOutputsResponse
+	var _success *AssociateAnnotationTagResp
 	if src.Success != nil {
-		_success = &MGetExperimentStandardEvalOutputsResponse{}
+		_success = &AssociateAnnotationTagResp{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -36016,7 +43781,7 @@ func (p *ExperimentServiceMGetExperimentStandardEvalOutputsResult) DeepCopy(s in
 	return nil
 }
 
-func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteAnnotationTagArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -36060,14 +43825,14 @@ func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) FastRead(buf []
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentStandardEvalOutputsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteAnnotationTagArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteAnnotationTagArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExperimentStandardEvalOutputsRequest()
+	_field := NewDeleteAnnotationTagReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -36077,11 +43842,11 @@ func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) FastReadField1(
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceDeleteAnnotationTagArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteAnnotationTagArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -36090,7 +43855,7 @@ func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) FastWriteNocopy
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) BLength() int {
+func (p *ExperimentServiceDeleteAnnotationTagArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -36099,29 +43864,29 @@ func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteAnnotationTagArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) field1Length() int {
+func (p *ExperimentServiceDeleteAnnotationTagArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExperimentStandardEvalOutputsArgs)
+func (p *ExperimentServiceDeleteAnnotationTagArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceDeleteAnnotationTagArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListExperimentStandardEvalOutputsRequest
+	var _req *DeleteAnnotationTagReq
 	if src.Req != nil {
-		_req = &ListExperimentStandardEvalOutputsRequest{}
+		_req = &DeleteAnnotationTagReq{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -36131,7 +43896,7 @@ func (p *ExperimentServiceListExperimentStandardEvalOutputsArgs) DeepCopy(s inte
 	return nil
 }
 
-func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteAnnotationTagResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -36175,14 +43940,14 @@ func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) FastRead(buf
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentStandardEvalOutputsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteAnnotationTagResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteAnnotationTagResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExperimentStandardEvalOutputsResponse()
+	_field := NewDeleteAnnotationTagResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -36192,11 +43957,11 @@ func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) FastReadField
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceDeleteAnnotationTagResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteAnnotationTagResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -36205,7 +43970,7 @@ func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) FastWriteNoco
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) BLength() int {
+func (p *ExperimentServiceDeleteAnnotationTagResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -36214,7 +43979,7 @@ func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) BLength() int
 	return l
 }
 
-func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteAnnotationTagResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -36223,7 +43988,7 @@ func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) fastWriteFiel
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) field0Length() int {
+func (p *ExperimentServiceDeleteAnnotationTagResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -36232,15 +43997,15 @@ func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) field0Length(
 	return l
 }
 
-func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExperimentStandardEvalOutputsResult)
+func (p *ExperimentServiceDeleteAnnotationTagResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceDeleteAnnotationTagResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListExperimentStandardEvalOutputsResponse
+	var _success *DeleteAnnotationTagResp
 	if src.Success != nil {
-		_success = &ListExperimentStandardEvalOutputsResponse{}
+		_success = &DeleteAnnotationTagResp{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -36250,7 +44015,7 @@ func (p *ExperimentServiceListExperimentStandardEvalOutputsResult) DeepCopy(s in
 	return nil
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCreateAnnotateRecordArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -36294,14 +44059,14 @@ func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastRead(buf []byte
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCalculateExperimentAggrResultArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateAnnotateRecordArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceCreateAnnotateRecordArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCalculateExperimentAggrResultRequest()
+	_field := NewCreateAnnotateRecordReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -36311,11 +44076,11 @@ func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastReadField1(buf
 	return offset, nil
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCreateAnnotateRecordArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCreateAnnotateRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -36324,7 +44089,7 @@ func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) BLength() int {
+func (p *ExperimentServiceCreateAnnotateRecordArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -36333,29 +44098,29 @@ func (p *ExperimentServiceCalculateExperimentAggrResultArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCreateAnnotateRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) field1Length() int {
+func (p *ExperimentServiceCreateAnnotateRecordArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCalculateExperimentAggrResultArgs)
+func (p *ExperimentServiceCreateAnnotateRecordArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCreateAnnotateRecordArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CalculateExperimentAggrResultRequest
+	var _req *CreateAnnotateRecordReq
 	if src.Req != nil {
-		_req = &CalculateExperimentAggrResultRequest{}
+		_req = &CreateAnnotateRecordReq{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -36365,7 +44130,7 @@ func (p *ExperimentServiceCalculateExperimentAggrResultArgs) DeepCopy(s interfac
 	return nil
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCreateAnnotateRecordResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -36409,14 +44174,14 @@ func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastRead(buf []by
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCalculateExperimentAggrResultResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateAnnotateRecordResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceCreateAnnotateRecordResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCalculateExperimentAggrResultResponse()
+	_field := NewCreateAnnotateRecordResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -36426,11 +44191,11 @@ func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastReadField0(bu
 	return offset, nil
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCreateAnnotateRecordResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCreateAnnotateRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -36439,7 +44204,7 @@ func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastWriteNocopy(b
 	return offset
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) BLength() int {
+func (p *ExperimentServiceCreateAnnotateRecordResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -36448,7 +44213,7 @@ func (p *ExperimentServiceCalculateExperimentAggrResultResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCreateAnnotateRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -36457,7 +44222,7 @@ func (p *ExperimentServiceCalculateExperimentAggrResultResult) fastWriteField0(b
 	return offset
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) field0Length() int {
+func (p *ExperimentServiceCreateAnnotateRecordResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -36466,15 +44231,15 @@ func (p *ExperimentServiceCalculateExperimentAggrResultResult) field0Length() in
 	return l
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCalculateExperimentAggrResultResult)
+func (p *ExperimentServiceCreateAnnotateRecordResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCreateAnnotateRecordResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CalculateExperimentAggrResultResponse
+	var _success *CreateAnnotateRecordResp
 	if src.Success != nil {
-		_success = &CalculateExperimentAggrResultResponse{}
+		_success = &CreateAnnotateRecordResp{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -36484,7 +44249,7 @@ func (p *ExperimentServiceCalculateExperimentAggrResultResult) DeepCopy(s interf
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -36528,14 +44293,14 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastRead(buf []byte)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateAnnotateRecordArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetExperimentAggrResultRequest()
+	_field := NewUpdateAnnotateRecordReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -36545,11 +44310,11 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastReadField1(buf [
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -36558,7 +44323,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) BLength() int {
+func (p *ExperimentServiceUpdateAnnotateRecordArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -36567,29 +44332,29 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateAnnotateRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) field1Length() int {
+func (p *ExperimentServiceUpdateAnnotateRecordArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchGetExperimentAggrResultArgs)
+func (p *ExperimentServiceUpdateAnnotateRecordArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpdateAnnotateRecordArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchGetExperimentAggrResultRequest
+	var _req *UpdateAnnotateRecordReq
 	if src.Req != nil {
-		_req = &BatchGetExperimentAggrResultRequest{}
+		_req = &UpdateAnnotateRecordReq{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -36599,7 +44364,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) DeepCopy(s interface
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceUpdateAnnotateRecordResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -36643,14 +44408,14 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastRead(buf []byt
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateAnnotateRecordResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceUpdateAnnotateRecordResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetExperimentAggrResultResponse()
+	_field := NewUpdateAnnotateRecordResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -36660,11 +44425,11 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastReadField0(buf
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceUpdateAnnotateRecordResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateAnnotateRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -36673,7 +44438,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastWriteNocopy(bu
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) BLength() int {
+func (p *ExperimentServiceUpdateAnnotateRecordResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -36682,7 +44447,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateAnnotateRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -36691,7 +44456,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) fastWriteField0(bu
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) field0Length() int {
+func (p *ExperimentServiceUpdateAnnotateRecordResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -36700,15 +44465,15 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) field0Length() int
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchGetExperimentAggrResultResult)
+func (p *ExperimentServiceUpdateAnnotateRecordResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpdateAnnotateRecordResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchGetExperimentAggrResultResponse
+	var _success *UpdateAnnotateRecordResp
 	if src.Success != nil {
-		_success = &BatchGetExperimentAggrResultResponse{}
+		_success = &UpdateAnnotateRecordResp{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -36718,7 +44483,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) DeepCopy(s interfa
 	return nil
 }
 
-func (p *ExperimentServiceInvokeExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceExportExptResultArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -36762,14 +44527,14 @@ func (p *ExperimentServiceInvokeExperimentArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceInvokeExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceExportExptResultArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceInvokeExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceExportExptResultArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewInvokeExperimentRequest()
+	_field := NewExportExptResultRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -36779,11 +44544,11 @@ func (p *ExperimentServiceInvokeExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceInvokeExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceExportExptResultArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceInvokeExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceExportExptResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -36792,7 +44557,7 @@ func (p *ExperimentServiceInvokeExperimentArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceInvokeExperimentArgs) BLength() int {
+func (p *ExperimentServiceExportExptResultArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -36801,29 +44566,29 @@ func (p *ExperimentServiceInvokeExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceInvokeExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceExportExptResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceInvokeExperimentArgs) field1Length() int {
+func (p *ExperimentServiceExportExptResultArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceInvokeExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceInvokeExperimentArgs)
+func (p *ExperimentServiceExportExptResultArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceExportExptResultArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *InvokeExperimentRequest
+	var _req *ExportExptResultRequest
 	if src.Req != nil {
-		_req = &InvokeExperimentRequest{}
+		_req = &ExportExptResultRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -36833,7 +44598,7 @@ func (p *ExperimentServiceInvokeExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceInvokeExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceExportExptResultResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -36877,14 +44642,14 @@ func (p *ExperimentServiceInvokeExperimentResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceInvokeExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceExportExptResultResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceInvokeExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceExportExptResultResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewInvokeExperimentResponse()
+	_field := NewExportExptResultResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -36894,11 +44659,11 @@ func (p *ExperimentServiceInvokeExperimentResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ExperimentServiceInvokeExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceExportExptResultResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceInvokeExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceExportExptResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -36907,7 +44672,7 @@ func (p *ExperimentServiceInvokeExperimentResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceInvokeExperimentResult) BLength() int {
+func (p *ExperimentServiceExportExptResultResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -36916,7 +44681,7 @@ func (p *ExperimentServiceInvokeExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceInvokeExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceExportExptResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -36925,7 +44690,7 @@ func (p *ExperimentServiceInvokeExperimentResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceInvokeExperimentResult) field0Length() int {
+func (p *ExperimentServiceExportExptResultResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -36934,15 +44699,15 @@ func (p *ExperimentServiceInvokeExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceInvokeExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceInvokeExperimentResult)
+func (p *ExperimentServiceExportExptResultResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceExportExptResultResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *InvokeExperimentResponse
+	var _success *ExportExptResultResponse
 	if src.Success != nil {
-		_success = &InvokeExperimentResponse{}
+		_success = &ExportExptResultResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -36952,7 +44717,7 @@ func (p *ExperimentServiceInvokeExperimentResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceFinishExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptResultExportRecordArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -36996,14 +44761,14 @@ func (p *ExperimentServiceFinishExperimentArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceFinishExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptResultExportRecordArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceFinishExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptResultExportRecordArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewFinishExperimentRequest()
+	_field := NewListExptResultExportRecordRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -37013,11 +44778,11 @@ func (p *ExperimentServiceFinishExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceFinishExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExptResultExportRecordArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceFinishExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptResultExportRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -37026,7 +44791,7 @@ func (p *ExperimentServiceFinishExperimentArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceFinishExperimentArgs) BLength() int {
+func (p *ExperimentServiceListExptResultExportRecordArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -37035,29 +44800,29 @@ func (p *ExperimentServiceFinishExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceFinishExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptResultExportRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceFinishExperimentArgs) field1Length() int {
+func (p *ExperimentServiceListExptResultExportRecordArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceFinishExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceFinishExperimentArgs)
+func (p *ExperimentServiceListExptResultExportRecordArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExptResultExportRecordArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *FinishExperimentRequest
+	var _req *ListExptResultExportRecordRequest
 	if src.Req != nil {
-		_req = &FinishExperimentRequest{}
+		_req = &ListExptResultExportRecordRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -37067,7 +44832,7 @@ func (p *ExperimentServiceFinishExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceFinishExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptResultExportRecordResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -37111,14 +44876,14 @@ func (p *ExperimentServiceFinishExperimentResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceFinishExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptResultExportRecordResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceFinishExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptResultExportRecordResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewFinishExperimentResponse()
+	_field := NewListExptResultExportRecordResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -37128,11 +44893,11 @@ func (p *ExperimentServiceFinishExperimentResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ExperimentServiceFinishExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExptResultExportRecordResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceFinishExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptResultExportRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -37141,7 +44906,7 @@ func (p *ExperimentServiceFinishExperimentResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceFinishExperimentResult) BLength() int {
+func (p *ExperimentServiceListExptResultExportRecordResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -37150,7 +44915,7 @@ func (p *ExperimentServiceFinishExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceFinishExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptResultExportRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -37159,7 +44924,7 @@ func (p *ExperimentServiceFinishExperimentResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceFinishExperimentResult) field0Length() int {
+func (p *ExperimentServiceListExptResultExportRecordResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -37168,15 +44933,15 @@ func (p *ExperimentServiceFinishExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceFinishExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceFinishExperimentResult)
+func (p *ExperimentServiceListExptResultExportRecordResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExptResultExportRecordResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *FinishExperimentResponse
+	var _success *ListExptResultExportRecordResponse
 	if src.Success != nil {
-		_success = &FinishExperimentResponse{}
+		_success = &ListExptResultExportRecordResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -37186,7 +44951,7 @@ func (p *ExperimentServiceFinishExperimentResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceListExperimentStatsArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptResultExportRecordArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -37230,14 +44995,14 @@ func (p *ExperimentServiceListExperimentStatsArgs) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentStatsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptResultExportRecordArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExperimentStatsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptResultExportRecordArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExperimentStatsRequest()
+	_field := NewGetExptResultExportRecordRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -37247,11 +45012,11 @@ func (p *ExperimentServiceListExperimentStatsArgs) FastReadField1(buf []byte) (i
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExperimentStatsArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceGetExptResultExportRecordArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExperimentStatsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptResultExportRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -37260,7 +45025,7 @@ func (p *ExperimentServiceListExperimentStatsArgs) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentStatsArgs) BLength() int {
+func (p *ExperimentServiceGetExptResultExportRecordArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -37269,29 +45034,29 @@ func (p *ExperimentServiceListExperimentStatsArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExperimentStatsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptResultExportRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentStatsArgs) field1Length() int {
+func (p *ExperimentServiceGetExptResultExportRecordArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceListExperimentStatsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExperimentStatsArgs)
+func (p *ExperimentServiceGetExptResultExportRecordArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetExptResultExportRecordArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListExperimentStatsRequest
+	var _req *GetExptResultExportRecordRequest
 	if src.Req != nil {
-		_req = &ListExperimentStatsRequest{}
+		_req = &GetExptResultExportRecordRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -37301,7 +45066,7 @@ func (p *ExperimentServiceListExperimentStatsArgs) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceListExperimentStatsResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptResultExportRecordResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -37345,14 +45110,14 @@ func (p *ExperimentServiceListExperimentStatsResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentStatsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptResultExportRecordResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExperimentStatsResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptResultExportRecordResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExperimentStatsResponse()
+	_field := NewGetExptResultExportRecordResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -37362,11 +45127,11 @@ func (p *ExperimentServiceListExperimentStatsResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExperimentStatsResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceGetExptResultExportRecordResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExperimentStatsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptResultExportRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -37375,7 +45140,7 @@ func (p *ExperimentServiceListExperimentStatsResult) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentStatsResult) BLength() int {
+func (p *ExperimentServiceGetExptResultExportRecordResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -37384,7 +45149,7 @@ func (p *ExperimentServiceListExperimentStatsResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExperimentStatsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptResultExportRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -37393,7 +45158,7 @@ func (p *ExperimentServiceListExperimentStatsResult) fastWriteField0(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentStatsResult) field0Length() int {
+func (p *ExperimentServiceGetExptResultExportRecordResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -37402,15 +45167,15 @@ func (p *ExperimentServiceListExperimentStatsResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceListExperimentStatsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExperimentStatsResult)
+func (p *ExperimentServiceGetExptResultExportRecordResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetExptResultExportRecordResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListExperimentStatsResponse
+	var _success *GetExptResultExportRecordResponse
 	if src.Success != nil {
-		_success = &ListExperimentStatsResponse{}
+		_success = &GetExptResultExportRecordResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -37420,7 +45185,7 @@ func (p *ExperimentServiceListExperimentStatsResult) DeepCopy(s interface{}) err
 	return nil
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceInsightAnalysisExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -37464,14 +45229,14 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastRead(buf []byte) (
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpsertExptTurnResultFilterArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceInsightAnalysisExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceInsightAnalysisExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpsertExptTurnResultFilterRequest()
+	_field := NewInsightAnalysisExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -37481,11 +45246,11 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastReadField1(buf []b
 	return offset, nil
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceInsightAnalysisExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceInsightAnalysisExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -37494,7 +45259,7 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) FastWriteNocopy(buf []
 	return offset
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) BLength() int {
+func (p *ExperimentServiceInsightAnalysisExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -37503,29 +45268,29 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceInsightAnalysisExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) field1Length() int {
+func (p *ExperimentServiceInsightAnalysisExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceUpsertExptTurnResultFilterArgs)
+func (p *ExperimentServiceInsightAnalysisExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceInsightAnalysisExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *UpsertExptTurnResultFilterRequest
+	var _req *InsightAnalysisExperimentRequest
 	if src.Req != nil {
-		_req = &UpsertExptTurnResultFilterRequest{}
+		_req = &InsightAnalysisExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -37535,7 +45300,7 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterArgs) DeepCopy(s interface{}
 	return nil
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceInsightAnalysisExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -37579,14 +45344,14 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastRead(buf []byte)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpsertExptTurnResultFilterResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceInsightAnalysisExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceInsightAnalysisExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpsertExptTurnResultFilterResponse()
+	_field := NewInsightAnalysisExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -37596,11 +45361,11 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastReadField0(buf [
 	return offset, nil
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceInsightAnalysisExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceInsightAnalysisExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -37609,7 +45374,7 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterResult) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterResult) BLength() int {
+func (p *ExperimentServiceInsightAnalysisExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -37618,7 +45383,7 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceInsightAnalysisExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -37627,7 +45392,7 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterResult) fastWriteField0(buf
 	return offset
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterResult) field0Length() int {
+func (p *ExperimentServiceInsightAnalysisExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -37636,15 +45401,15 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceUpsertExptTurnResultFilterResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceUpsertExptTurnResultFilterResult)
+func (p *ExperimentServiceInsightAnalysisExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceInsightAnalysisExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpsertExptTurnResultFilterResponse
+	var _success *InsightAnalysisExperimentResponse
 	if src.Success != nil {
-		_success = &UpsertExptTurnResultFilterResponse{}
+		_success = &InsightAnalysisExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -37654,7 +45419,7 @@ func (p *ExperimentServiceUpsertExptTurnResultFilterResult) DeepCopy(s interface
 	return nil
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -37698,14 +45463,14 @@ func (p *ExperimentServiceAssociateAnnotationTagArgs) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceAssociateAnnotationTagArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptInsightAnalysisRecordArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewAssociateAnnotationTagReq()
+	_field := NewListExptInsightAnalysisRecordRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -37715,11 +45480,11 @@ func (p *ExperimentServiceAssociateAnnotationTagArgs) FastReadField1(buf []byte)
 	return offset, nil
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -37728,7 +45493,7 @@ func (p *ExperimentServiceAssociateAnnotationTagArgs) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagArgs) BLength() int {
+func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -37737,29 +45502,29 @@ func (p *ExperimentServiceAssociateAnnotationTagArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagArgs) field1Length() int {
+func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceAssociateAnnotationTagArgs)
+func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExptInsightAnalysisRecordArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *AssociateAnnotationTagReq
+	var _req *ListExptInsightAnalysisRecordRequest
 	if src.Req != nil {
-		_req = &AssociateAnnotationTagReq{}
+		_req = &ListExptInsightAnalysisRecordRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -37769,7 +45534,7 @@ func (p *ExperimentServiceAssociateAnnotationTagArgs) DeepCopy(s interface{}) er
 	return nil
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptInsightAnalysisRecordResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -37813,14 +45578,14 @@ func (p *ExperimentServiceAssociateAnnotationTagResult) FastRead(buf []byte) (in
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceAssociateAnnotationTagResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptInsightAnalysisRecordResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptInsightAnalysisRecordResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewAssociateAnnotationTagResp()
+	_field := NewListExptInsightAnalysisRecordResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -37830,11 +45595,11 @@ func (p *ExperimentServiceAssociateAnnotationTagResult) FastReadField0(buf []byt
 	return offset, nil
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExptInsightAnalysisRecordResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptInsightAnalysisRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -37843,7 +45608,7 @@ func (p *ExperimentServiceAssociateAnnotationTagResult) FastWriteNocopy(buf []by
 	return offset
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagResult) BLength() int {
+func (p *ExperimentServiceListExptInsightAnalysisRecordResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -37852,7 +45617,7 @@ func (p *ExperimentServiceAssociateAnnotationTagResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptInsightAnalysisRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -37861,7 +45626,7 @@ func (p *ExperimentServiceAssociateAnnotationTagResult) fastWriteField0(buf []by
 	return offset
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagResult) field0Length() int {
+func (p *ExperimentServiceListExptInsightAnalysisRecordResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -37870,15 +45635,15 @@ func (p *ExperimentServiceAssociateAnnotationTagResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceAssociateAnnotationTagResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceAssociateAnnotationTagResult)
+func (p *ExperimentServiceListExptInsightAnalysisRecordResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExptInsightAnalysisRecordResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *AssociateAnnotationTagResp
+	var _success *ListExptInsightAnalysisRecordResponse
 	if src.Success != nil {
-		_success = &AssociateAnnotationTagResp{}
+		_success = &ListExptInsightAnalysisRecordResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -37888,7 +45653,7 @@ func (p *ExperimentServiceAssociateAnnotationTagResult) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -37932,14 +45697,14 @@ func (p *ExperimentServiceDeleteAnnotationTagArgs) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteAnnotationTagArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExptInsightAnalysisRecordArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteAnnotationTagReq()
+	_field := NewDeleteExptInsightAnalysisRecordRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -37949,11 +45714,11 @@ func (p *ExperimentServiceDeleteAnnotationTagArgs) FastReadField1(buf []byte) (i
 	return offset, nil
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -37962,7 +45727,7 @@ func (p *ExperimentServiceDeleteAnnotationTagArgs) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagArgs) BLength() int {
+func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -37971,29 +45736,29 @@ func (p *ExperimentServiceDeleteAnnotationTagArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagArgs) field1Length() int {
+func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceDeleteAnnotationTagArgs)
+func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceDeleteExptInsightAnalysisRecordArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *DeleteAnnotationTagReq
+	var _req *DeleteExptInsightAnalysisRecordRequest
 	if src.Req != nil {
-		_req = &DeleteAnnotationTagReq{}
+		_req = &DeleteExptInsightAnalysisRecordRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -38003,7 +45768,7 @@ func (p *ExperimentServiceDeleteAnnotationTagArgs) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -38047,14 +45812,14 @@ func (p *ExperimentServiceDeleteAnnotationTagResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteAnnotationTagResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExptInsightAnalysisRecordResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteAnnotationTagResp()
+	_field := NewDeleteExptInsightAnalysisRecordResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -38064,11 +45829,11 @@ func (p *ExperimentServiceDeleteAnnotationTagResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -38077,7 +45842,7 @@ func (p *ExperimentServiceDeleteAnnotationTagResult) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagResult) BLength() int {
+func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -38086,7 +45851,7 @@ func (p *ExperimentServiceDeleteAnnotationTagResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -38095,7 +45860,7 @@ func (p *ExperimentServiceDeleteAnnotationTagResult) fastWriteField0(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagResult) field0Length() int {
+func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -38104,15 +45869,15 @@ func (p *ExperimentServiceDeleteAnnotationTagResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceDeleteAnnotationTagResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceDeleteAnnotationTagResult)
+func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceDeleteExptInsightAnalysisRecordResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *DeleteAnnotationTagResp
+	var _success *DeleteExptInsightAnalysisRecordResponse
 	if src.Success != nil {
-		_success = &DeleteAnnotationTagResp{}
+		_success = &DeleteExptInsightAnalysisRecordResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -38122,7 +45887,7 @@ func (p *ExperimentServiceDeleteAnnotationTagResult) DeepCopy(s interface{}) err
 	return nil
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -38166,14 +45931,14 @@ func (p *ExperimentServiceCreateAnnotateRecordArgs) FastRead(buf []byte) (int, e
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateAnnotateRecordArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptInsightAnalysisRecordArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateAnnotateRecordReq()
+	_field := NewGetExptInsightAnalysisRecordRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -38183,11 +45948,11 @@ func (p *ExperimentServiceCreateAnnotateRecordArgs) FastReadField1(buf []byte) (
 	return offset, nil
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -38196,7 +45961,7 @@ func (p *ExperimentServiceCreateAnnotateRecordArgs) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordArgs) BLength() int {
+func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -38205,29 +45970,29 @@ func (p *ExperimentServiceCreateAnnotateRecordArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordArgs) field1Length() int {
+func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCreateAnnotateRecordArgs)
+func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetExptInsightAnalysisRecordArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CreateAnnotateRecordReq
+	var _req *GetExptInsightAnalysisRecordRequest
 	if src.Req != nil {
-		_req = &CreateAnnotateRecordReq{}
+		_req = &GetExptInsightAnalysisRecordRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -38237,7 +46002,7 @@ func (p *ExperimentServiceCreateAnnotateRecordArgs) DeepCopy(s interface{}) erro
 	return nil
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -38281,14 +46046,14 @@ func (p *ExperimentServiceCreateAnnotateRecordResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCreateAnnotateRecordResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptInsightAnalysisRecordResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateAnnotateRecordResp()
+	_field := NewGetExptInsightAnalysisRecordResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -38298,11 +46063,11 @@ func (p *ExperimentServiceCreateAnnotateRecordResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -38311,7 +46076,7 @@ func (p *ExperimentServiceCreateAnnotateRecordResult) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordResult) BLength() int {
+func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -38320,7 +46085,7 @@ func (p *ExperimentServiceCreateAnnotateRecordResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -38329,7 +46094,7 @@ func (p *ExperimentServiceCreateAnnotateRecordResult) fastWriteField0(buf []byte
 	return offset
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordResult) field0Length() int {
+func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -38338,15 +46103,15 @@ func (p *ExperimentServiceCreateAnnotateRecordResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceCreateAnnotateRecordResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCreateAnnotateRecordResult)
+func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetExptInsightAnalysisRecordResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CreateAnnotateRecordResp
+	var _success *GetExptInsightAnalysisRecordResponse
 	if src.Success != nil {
-		_success = &CreateAnnotateRecordResp{}
+		_success = &GetExptInsightAnalysisRecordResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -38356,7 +46121,7 @@ func (p *ExperimentServiceCreateAnnotateRecordResult) DeepCopy(s interface{}) er
 	return nil
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -38400,14 +46165,14 @@ func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastRead(buf []byte) (int, e
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateAnnotateRecordArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceFeedbackExptInsightAnalysisReportArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateAnnotateRecordReq()
+	_field := NewFeedbackExptInsightAnalysisReportRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -38417,11 +46182,11 @@ func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastReadField1(buf []byte) (
 	return offset, nil
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -38430,7 +46195,7 @@ func (p *ExperimentServiceUpdateAnnotateRecordArgs) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordArgs) BLength() int {
+func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -38439,29 +46204,29 @@ func (p *ExperimentServiceUpdateAnnotateRecordArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordArgs) field1Length() int {
+func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceUpdateAnnotateRecordArgs)
+func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceFeedbackExptInsightAnalysisReportArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *UpdateAnnotateRecordReq
+	var _req *FeedbackExptInsightAnalysisReportRequest
 	if src.Req != nil {
-		_req = &UpdateAnnotateRecordReq{}
+		_req = &FeedbackExptInsightAnalysisReportRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -38471,7 +46236,7 @@ func (p *ExperimentServiceUpdateAnnotateRecordArgs) DeepCopy(s interface{}) erro
 	return nil
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -38515,14 +46280,14 @@ func (p *ExperimentServiceUpdateAnnotateRecordResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateAnnotateRecordResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceFeedbackExptInsightAnalysisReportResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateAnnotateRecordResp()
+	_field := NewFeedbackExptInsightAnalysisReportResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -38532,11 +46297,11 @@ func (p *ExperimentServiceUpdateAnnotateRecordResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -38545,7 +46310,7 @@ func (p *ExperimentServiceUpdateAnnotateRecordResult) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordResult) BLength() int {
+func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -38554,7 +46319,7 @@ func (p *ExperimentServiceUpdateAnnotateRecordResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -38563,7 +46328,7 @@ func (p *ExperimentServiceUpdateAnnotateRecordResult) fastWriteField0(buf []byte
 	return offset
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordResult) field0Length() int {
+func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -38572,15 +46337,15 @@ func (p *ExperimentServiceUpdateAnnotateRecordResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateAnnotateRecordResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceUpdateAnnotateRecordResult)
+func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceFeedbackExptInsightAnalysisReportResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpdateAnnotateRecordResp
+	var _success *FeedbackExptInsightAnalysisReportResponse
 	if src.Success != nil {
-		_success = &UpdateAnnotateRecordResp{}
+		_success = &FeedbackExptInsightAnalysisReportResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -38590,7 +46355,7 @@ func (p *ExperimentServiceUpdateAnnotateRecordResult) DeepCopy(s interface{}) er
 	return nil
 }
 
-func (p *ExperimentServiceExportExptResultArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -38634,14 +46399,14 @@ func (p *ExperimentServiceExportExptResultArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceExportExptResultArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptInsightAnalysisCommentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceExportExptResultArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewExportExptResultRequest()
+	_field := NewListExptInsightAnalysisCommentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -38651,11 +46416,11 @@ func (p *ExperimentServiceExportExptResultArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceExportExptResultArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceExportExptResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -38664,7 +46429,7 @@ func (p *ExperimentServiceExportExptResultArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceExportExptResultArgs) BLength() int {
+func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -38673,29 +46438,29 @@ func (p *ExperimentServiceExportExptResultArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceExportExptResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceExportExptResultArgs) field1Length() int {
+func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceExportExptResultArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceExportExptResultArgs)
+func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExptInsightAnalysisCommentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ExportExptResultRequest
+	var _req *ListExptInsightAnalysisCommentRequest
 	if src.Req != nil {
-		_req = &ExportExptResultRequest{}
+		_req = &ListExptInsightAnalysisCommentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -38705,7 +46470,7 @@ func (p *ExperimentServiceExportExptResultArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceExportExptResultResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptInsightAnalysisCommentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -38749,14 +46514,14 @@ func (p *ExperimentServiceExportExptResultResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceExportExptResultResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptInsightAnalysisCommentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceExportExptResultResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceListExptInsightAnalysisCommentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewExportExptResultResponse()
+	_field := NewListExptInsightAnalysisCommentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -38766,11 +46531,11 @@ func (p *ExperimentServiceExportExptResultResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ExperimentServiceExportExptResultResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceListExptInsightAnalysisCommentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceExportExptResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptInsightAnalysisCommentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -38779,7 +46544,7 @@ func (p *ExperimentServiceExportExptResultResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceExportExptResultResult) BLength() int {
+func (p *ExperimentServiceListExptInsightAnalysisCommentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -38788,7 +46553,7 @@ func (p *ExperimentServiceExportExptResultResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceExportExptResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceListExptInsightAnalysisCommentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -38797,7 +46562,7 @@ func (p *ExperimentServiceExportExptResultResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceExportExptResultResult) field0Length() int {
+func (p *ExperimentServiceListExptInsightAnalysisCommentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -38806,15 +46571,15 @@ func (p *ExperimentServiceExportExptResultResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceExportExptResultResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceExportExptResultResult)
+func (p *ExperimentServiceListExptInsightAnalysisCommentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExptInsightAnalysisCommentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ExportExptResultResponse
+	var _success *ListExptInsightAnalysisCommentResponse
 	if src.Success != nil {
-		_success = &ExportExptResultResponse{}
+		_success = &ListExptInsightAnalysisCommentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -38824,7 +46589,7 @@ func (p *ExperimentServiceExportExptResultResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceListExptResultExportRecordArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -38868,14 +46633,14 @@ func (p *ExperimentServiceListExptResultExportRecordArgs) FastRead(buf []byte) (
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptResultExportRecordArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetAnalysisRecordFeedbackVoteArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExptResultExportRecordArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExptResultExportRecordRequest()
+	_field := NewGetAnalysisRecordFeedbackVoteRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -38885,11 +46650,11 @@ func (p *ExperimentServiceListExptResultExportRecordArgs) FastReadField1(buf []b
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExptResultExportRecordArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExptResultExportRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -38898,7 +46663,7 @@ func (p *ExperimentServiceListExptResultExportRecordArgs) FastWriteNocopy(buf []
 	return offset
 }
 
-func (p *ExperimentServiceListExptResultExportRecordArgs) BLength() int {
+func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -38907,29 +46672,29 @@ func (p *ExperimentServiceListExptResultExportRecordArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExptResultExportRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceListExptResultExportRecordArgs) field1Length() int {
+func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceListExptResultExportRecordArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExptResultExportRecordArgs)
+func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetAnalysisRecordFeedbackVoteArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListExptResultExportRecordRequest
+	var _req *GetAnalysisRecordFeedbackVoteRequest
 	if src.Req != nil {
-		_req = &ListExptResultExportRecordRequest{}
+		_req = &GetAnalysisRecordFeedbackVoteRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -38939,7 +46704,7 @@ func (p *ExperimentServiceListExptResultExportRecordArgs) DeepCopy(s interface{}
 	return nil
 }
 
-func (p *ExperimentServiceListExptResultExportRecordResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -38983,14 +46748,14 @@ func (p *ExperimentServiceListExptResultExportRecordResult) FastRead(buf []byte)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptResultExportRecordResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetAnalysisRecordFeedbackVoteResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExptResultExportRecordResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExptResultExportRecordResponse()
+	_field := NewGetAnalysisRecordFeedbackVoteResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -39000,11 +46765,11 @@ func (p *ExperimentServiceListExptResultExportRecordResult) FastReadField0(buf [
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExptResultExportRecordResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExptResultExportRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -39013,7 +46778,7 @@ func (p *ExperimentServiceListExptResultExportRecordResult) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *ExperimentServiceListExptResultExportRecordResult) BLength() int {
+func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -39022,7 +46787,7 @@ func (p *ExperimentServiceListExptResultExportRecordResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExptResultExportRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -39031,7 +46796,7 @@ func (p *ExperimentServiceListExptResultExportRecordResult) fastWriteField0(buf
 	return offset
 }
 
-func (p *ExperimentServiceListExptResultExportRecordResult) field0Length() int {
+func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -39040,15 +46805,15 @@ func (p *ExperimentServiceListExptResultExportRecordResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceListExptResultExportRecordResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExptResultExportRecordResult)
+func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetAnalysisRecordFeedbackVoteResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListExptResultExportRecordResponse
+	var _success *GetAnalysisRecordFeedbackVoteResponse
 	if src.Success != nil {
-		_success = &ListExptResultExportRecordResponse{}
+		_success = &GetAnalysisRecordFeedbackVoteResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -39058,7 +46823,7 @@ func (p *ExperimentServiceListExptResultExportRecordResult) DeepCopy(s interface
 	return nil
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCompareExperimentsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -39102,14 +46867,14 @@ func (p *ExperimentServiceGetExptResultExportRecordArgs) FastRead(buf []byte) (i
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptResultExportRecordArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCompareExperimentsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceCompareExperimentsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetExptResultExportRecordRequest()
+	_field := NewCompareExperimentsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -39119,11 +46884,11 @@ func (p *ExperimentServiceGetExptResultExportRecordArgs) FastReadField1(buf []by
 	return offset, nil
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCompareExperimentsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCompareExperimentsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -39132,7 +46897,7 @@ func (p *ExperimentServiceGetExptResultExportRecordArgs) FastWriteNocopy(buf []b
 	return offset
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordArgs) BLength() int {
+func (p *ExperimentServiceCompareExperimentsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -39141,29 +46906,29 @@ func (p *ExperimentServiceGetExptResultExportRecordArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCompareExperimentsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordArgs) field1Length() int {
+func (p *ExperimentServiceCompareExperimentsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceGetExptResultExportRecordArgs)
+func (p *ExperimentServiceCompareExperimentsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCompareExperimentsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *GetExptResultExportRecordRequest
+	var _req *CompareExperimentsRequest
 	if src.Req != nil {
-		_req = &GetExptResultExportRecordRequest{}
+		_req = &CompareExperimentsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -39173,7 +46938,7 @@ func (p *ExperimentServiceGetExptResultExportRecordArgs) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCompareExperimentsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -39217,14 +46982,14 @@ func (p *ExperimentServiceGetExptResultExportRecordResult) FastRead(buf []byte)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptResultExportRecordResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCompareExperimentsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceCompareExperimentsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetExptResultExportRecordResponse()
+	_field := NewCompareExperimentsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -39234,11 +46999,11 @@ func (p *ExperimentServiceGetExptResultExportRecordResult) FastReadField0(buf []
 	return offset, nil
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCompareExperimentsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCompareExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -39247,7 +47012,7 @@ func (p *ExperimentServiceGetExptResultExportRecordResult) FastWriteNocopy(buf [
 	return offset
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordResult) BLength() int {
+func (p *ExperimentServiceCompareExperimentsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -39256,7 +47021,7 @@ func (p *ExperimentServiceGetExptResultExportRecordResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCompareExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -39265,7 +47030,7 @@ func (p *ExperimentServiceGetExptResultExportRecordResult) fastWriteField0(buf [
 	return offset
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordResult) field0Length() int {
+func (p *ExperimentServiceCompareExperimentsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -39274,15 +47039,15 @@ func (p *ExperimentServiceGetExptResultExportRecordResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceGetExptResultExportRecordResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceGetExptResultExportRecordResult)
+func (p *ExperimentServiceCompareExperimentsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCompareExperimentsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetExptResultExportRecordResponse
+	var _success *CompareExperimentsResponse
 	if src.Success != nil {
-		_success = &GetExptResultExportRecordResponse{}
+		_success = &CompareExperimentsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -39292,7 +47057,7 @@ func (p *ExperimentServiceGetExptResultExportRecordResult) DeepCopy(s interface{
 	return nil
 }
 
-func (p *ExperimentServiceInsightAnalysisExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptRegressionReportArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -39336,14 +47101,14 @@ func (p *ExperimentServiceInsightAnalysisExperimentArgs) FastRead(buf []byte) (i
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceInsightAnalysisExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptRegressionReportArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceInsightAnalysisExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptRegressionReportArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewInsightAnalysisExperimentRequest()
+	_field := NewGetExptRegressionReportRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -39353,11 +47118,11 @@ func (p *ExperimentServiceInsightAnalysisExperimentArgs) FastReadField1(buf []by
 	return offset, nil
 }
 
-func (p *ExperimentServiceInsightAnalysisExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceGetExptRegressionReportArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceInsightAnalysisExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptRegressionReportArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -39366,7 +47131,7 @@ func (p *ExperimentServiceInsightAnalysisExperimentArgs) FastWriteNocopy(buf []b
 	return offset
 }
 
-func (p *ExperimentServiceInsightAnalysisExperimentArgs) BLength() int {
+func (p *ExperimentServiceGetExptRegressionReportArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -39375,29 +47140,29 @@ func (p *ExperimentServiceInsightAnalysisExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceInsightAnalysisExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptRegressionReportArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceInsightAnalysisExperimentArgs) field1Length() int {
+func (p *ExperimentServiceGetExptRegressionReportArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceInsightAnalysisExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceInsightAnalysisExperimentArgs)
+func (p *ExperimentServiceGetExptRegressionReportArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetExptRegressionReportArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *InsightAnalysisExperimentRequest
+	var _req *GetExptRegressionReportRequest
 	if src.Req != nil {
-		_req = &InsightAnalysisExperimentRequest{}
+		_req = &GetExptRegressionReportRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -39407,7 +47172,7 @@ func (p *ExperimentServiceInsightAnalysisExperimentArgs) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *ExperimentServiceInsightAnalysisExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptRegressionReportResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -39451,14 +47216,14 @@ func (p *ExperimentServiceInsightAnalysisExperimentResult) FastRead(buf []byte)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceInsightAnalysisExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptRegressionReportResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceInsightAnalysisExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptRegressionReportResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewInsightAnalysisExperimentResponse()
+	_field := NewGetExptRegressionReportResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -39468,11 +47233,11 @@ func (p *ExperimentServiceInsightAnalysisExperimentResult) FastReadField0(buf []
 	return offset, nil
 }
 
-func (p *ExperimentServiceInsightAnalysisExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceGetExptRegressionReportResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceInsightAnalysisExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptRegressionReportResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -39481,7 +47246,7 @@ func (p *ExperimentServiceInsightAnalysisExperimentResult) FastWriteNocopy(buf [
 	return offset
 }
 
-func (p *ExperimentServiceInsightAnalysisExperimentResult) BLength() int {
+func (p *ExperimentServiceGetExptRegressionReportResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -39490,7 +47255,7 @@ func (p *ExperimentServiceInsightAnalysisExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceInsightAnalysisExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptRegressionReportResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -39499,7 +47264,7 @@ func (p *ExperimentServiceInsightAnalysisExperimentResult) fastWriteField0(buf [
 	return offset
 }
 
-func (p *ExperimentServiceInsightAnalysisExperimentResult) field0Length() int {
+func (p *ExperimentServiceGetExptRegressionReportResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -39508,15 +47273,15 @@ func (p *ExperimentServiceInsightAnalysisExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceInsightAnalysisExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceInsightAnalysisExperimentResult)
+func (p *ExperimentServiceGetExptRegressionReportResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetExptRegressionReportResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *InsightAnalysisExperimentResponse
+	var _success *GetExptRegressionReportResponse
 	if src.Success != nil {
-		_success = &InsightAnalysisExperimentResponse{}
+		_success = &GetExptRegressionReportResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -39526,7 +47291,7 @@ func (p *ExperimentServiceInsightAnalysisExperimentResult) DeepCopy(s interface{
 	return nil
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceExportExptRegressionReportArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -39570,14 +47335,14 @@ func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) FastRead(buf []byte
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptInsightAnalysisRecordArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceExportExptRegressionReportArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceExportExptRegressionReportArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExptInsightAnalysisRecordRequest()
+	_field := NewExportExptRegressionReportRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -39587,11 +47352,11 @@ func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) FastReadField1(buf
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceExportExptRegressionReportArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceExportExptRegressionReportArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -39600,7 +47365,7 @@ func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) BLength() int {
+func (p *ExperimentServiceExportExptRegressionReportArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -39609,29 +47374,29 @@ func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceExportExptRegressionReportArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) field1Length() int {
+func (p *ExperimentServiceExportExptRegressionReportArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExptInsightAnalysisRecordArgs)
+func (p *ExperimentServiceExportExptRegressionReportArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceExportExptRegressionReportArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListExptInsightAnalysisRecordRequest
+	var _req *ExportExptRegressionReportRequest
 	if src.Req != nil {
-		_req = &ListExptInsightAnalysisRecordRequest{}
+		_req = &ExportExptRegressionReportRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -39641,7 +47406,7 @@ func (p *ExperimentServiceListExptInsightAnalysisRecordArgs) DeepCopy(s interfac
 	return nil
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisRecordResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceExportExptRegressionReportResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -39685,14 +47450,14 @@ func (p *ExperimentServiceListExptInsightAnalysisRecordResult) FastRead(buf []by
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptInsightAnalysisRecordResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceExportExptRegressionReportResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisRecordResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceExportExptRegressionReportResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExptInsightAnalysisRecordResponse()
+	_field := NewExportExptRegressionReportResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -39702,11 +47467,11 @@ func (p *ExperimentServiceListExptInsightAnalysisRecordResult) FastReadField0(bu
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisRecordResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceExportExptRegressionReportResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceExportExptRegressionReportResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -39715,7 +47480,7 @@ func (p *ExperimentServiceListExptInsightAnalysisRecordResult) FastWriteNocopy(b
 	return offset
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisRecordResult) BLength() int {
+func (p *ExperimentServiceExportExptRegressionReportResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -39724,7 +47489,7 @@ func (p *ExperimentServiceListExptInsightAnalysisRecordResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceExportExptRegressionReportResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -39733,7 +47498,7 @@ func (p *ExperimentServiceListExptInsightAnalysisRecordResult) fastWriteField0(b
 	return offset
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisRecordResult) field0Length() int {
+func (p *ExperimentServiceExportExptRegressionReportResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -39742,15 +47507,15 @@ func (p *ExperimentServiceListExptInsightAnalysisRecordResult) field0Length() in
 	return l
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisRecordResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExptInsightAnalysisRecordResult)
+func (p *ExperimentServiceExportExptRegressionReportResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceExportExptRegressionReportResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListExptInsightAnalysisRecordResponse
+	var _success *ExportExptRegressionReportResponse
 	if src.Success != nil {
-		_success = &ListExptInsightAnalysisRecordResponse{}
+		_success = &ExportExptRegressionReportResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -39760,7 +47525,7 @@ func (p *ExperimentServiceListExptInsightAnalysisRecordResult) DeepCopy(s interf
 	return nil
 }
 
-func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptGroupedAggrResultArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -39804,14 +47569,14 @@ func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) FastRead(buf []by
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExptInsightAnalysisRecordArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptGroupedAggrResultArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptGroupedAggrResultArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteExptInsightAnalysisRecordRequest()
+	_field := NewGetExptGroupedAggrResultRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -39821,11 +47586,11 @@ func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) FastReadField1(bu
 	return offset, nil
 }
 
-func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceGetExptGroupedAggrResultArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptGroupedAggrResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -39834,7 +47599,7 @@ func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) FastWriteNocopy(b
 	return offset
 }
 
-func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) BLength() int {
+func (p *ExperimentServiceGetExptGroupedAggrResultArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -39843,29 +47608,29 @@ func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptGroupedAggrResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) field1Length() int {
+func (p *ExperimentServiceGetExptGroupedAggrResultArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceDeleteExptInsightAnalysisRecordArgs)
+func (p *ExperimentServiceGetExptGroupedAggrResultArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetExptGroupedAggrResultArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *DeleteExptInsightAnalysisRecordRequest
+	var _req *GetExptGroupedAggrResultRequest
 	if src.Req != nil {
-		_req = &DeleteExptInsightAnalysisRecordRequest{}
+		_req = &GetExptGroupedAggrResultRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -39875,7 +47640,7 @@ func (p *ExperimentServiceDeleteExptInsightAnalysisRecordArgs) DeepCopy(s interf
 	return nil
 }
 
-func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptGroupedAggrResultResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -39919,14 +47684,14 @@ func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) FastRead(buf []
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExptInsightAnalysisRecordResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptGroupedAggrResultResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptGroupedAggrResultResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteExptInsightAnalysisRecordResponse()
+	_field := NewGetExptGroupedAggrResultResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -39936,11 +47701,11 @@ func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) FastReadField0(
 	return offset, nil
 }
 
-func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceGetExptGroupedAggrResultResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptGroupedAggrResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -39949,7 +47714,7 @@ func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) FastWriteNocopy
 	return offset
 }
 
-func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) BLength() int {
+func (p *ExperimentServiceGetExptGroupedAggrResultResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -39958,7 +47723,7 @@ func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptGroupedAggrResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -39967,7 +47732,7 @@ func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) fastWriteField0
 	return offset
 }
 
-func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) field0Length() int {
+func (p *ExperimentServiceGetExptGroupedAggrResultResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -39976,15 +47741,15 @@ func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) field0Length()
 	return l
 }
 
-func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceDeleteExptInsightAnalysisRecordResult)
+func (p *ExperimentServiceGetExptGroupedAggrResultResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetExptGroupedAggrResultResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *DeleteExptInsightAnalysisRecordResponse
+	var _success *GetExptGroupedAggrResultResponse
 	if src.Success != nil {
-		_success = &DeleteExptInsightAnalysisRecordResponse{}
+		_success = &GetExptGroupedAggrResultResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -39994,7 +47759,7 @@ func (p *ExperimentServiceDeleteExptInsightAnalysisRecordResult) DeepCopy(s inte
 	return nil
 }
 
-func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptSampledAggrResultArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -40038,14 +47803,14 @@ func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) FastRead(buf []byte)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptInsightAnalysisRecordArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptSampledAggrResultArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptSampledAggrResultArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetExptInsightAnalysisRecordRequest()
+	_field := NewGetExptSampledAggrResultRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -40055,11 +47820,11 @@ func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) FastReadField1(buf [
 	return offset, nil
 }
 
-func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceGetExptSampledAggrResultArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptSampledAggrResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -40068,7 +47833,7 @@ func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) BLength() int {
+func (p *ExperimentServiceGetExptSampledAggrResultArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -40077,29 +47842,29 @@ func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptSampledAggrResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) field1Length() int {
+func (p *ExperimentServiceGetExptSampledAggrResultArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceGetExptInsightAnalysisRecordArgs)
+func (p *ExperimentServiceGetExptSampledAggrResultArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetExptSampledAggrResultArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *GetExptInsightAnalysisRecordRequest
+	var _req *GetExptSampledAggrResultRequest
 	if src.Req != nil {
-		_req = &GetExptInsightAnalysisRecordRequest{}
+		_req = &GetExptSampledAggrResultRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -40109,7 +47874,7 @@ func (p *ExperimentServiceGetExptInsightAnalysisRecordArgs) DeepCopy(s interface
 	return nil
 }
 
-func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptSampledAggrResultResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -40153,14 +47918,14 @@ func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) FastRead(buf []byt
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptInsightAnalysisRecordResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptSampledAggrResultResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptSampledAggrResultResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetExptInsightAnalysisRecordResponse()
+	_field := NewGetExptSampledAggrResultResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -40170,11 +47935,11 @@ func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) FastReadField0(buf
 	return offset, nil
 }
 
-func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceGetExptSampledAggrResultResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptSampledAggrResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -40183,7 +47948,7 @@ func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) FastWriteNocopy(bu
 	return offset
 }
 
-func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) BLength() int {
+func (p *ExperimentServiceGetExptSampledAggrResultResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -40192,7 +47957,7 @@ func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptSampledAggrResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -40201,7 +47966,7 @@ func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) fastWriteField0(bu
 	return offset
 }
 
-func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) field0Length() int {
+func (p *ExperimentServiceGetExptSampledAggrResultResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -40210,15 +47975,15 @@ func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) field0Length() int
 	return l
 }
 
-func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceGetExptInsightAnalysisRecordResult)
+func (p *ExperimentServiceGetExptSampledAggrResultResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetExptSampledAggrResultResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetExptInsightAnalysisRecordResponse
+	var _success *GetExptSampledAggrResultResponse
 	if src.Success != nil {
-		_success = &GetExptInsightAnalysisRecordResponse{}
+		_success = &GetExptSampledAggrResultResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -40228,7 +47993,7 @@ func (p *ExperimentServiceGetExptInsightAnalysisRecordResult) DeepCopy(s interfa
 	return nil
 }
 
-func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceInvalidateExptResultCacheArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -40272,14 +48037,14 @@ func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) FastRead(buf []
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceFeedbackExptInsightAnalysisReportArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceInvalidateExptResultCacheArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceInvalidateExptResultCacheArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewFeedbackExptInsightAnalysisReportRequest()
+	_field := NewInvalidateExptResultCacheRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -40289,11 +48054,11 @@ func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) FastReadField1(
 	return offset, nil
 }
 
-func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceInvalidateExptResultCacheArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceInvalidateExptResultCacheArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -40302,7 +48067,7 @@ func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) FastWriteNocopy
 	return offset
 }
 
-func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) BLength() int {
+func (p *ExperimentServiceInvalidateExptResultCacheArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -40311,29 +48076,29 @@ func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceInvalidateExptResultCacheArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) field1Length() int {
+func (p *ExperimentServiceInvalidateExptResultCacheArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceFeedbackExptInsightAnalysisReportArgs)
+func (p *ExperimentServiceInvalidateExptResultCacheArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceInvalidateExptResultCacheArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *FeedbackExptInsightAnalysisReportRequest
+	var _req *InvalidateExptResultCacheRequest
 	if src.Req != nil {
-		_req = &FeedbackExptInsightAnalysisReportRequest{}
+		_req = &InvalidateExptResultCacheRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -40343,7 +48108,7 @@ func (p *ExperimentServiceFeedbackExptInsightAnalysisReportArgs) DeepCopy(s inte
 	return nil
 }
 
-func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceInvalidateExptResultCacheResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -40387,14 +48152,14 @@ func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) FastRead(buf
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceFeedbackExptInsightAnalysisReportResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceInvalidateExptResultCacheResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceInvalidateExptResultCacheResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewFeedbackExptInsightAnalysisReportResponse()
+	_field := NewInvalidateExptResultCacheResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -40404,11 +48169,11 @@ func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) FastReadField
 	return offset, nil
 }
 
-func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceInvalidateExptResultCacheResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceInvalidateExptResultCacheResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -40417,7 +48182,7 @@ func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) FastWriteNoco
 	return offset
 }
 
-func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) BLength() int {
+func (p *ExperimentServiceInvalidateExptResultCacheResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -40426,7 +48191,7 @@ func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) BLength() int
 	return l
 }
 
-func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceInvalidateExptResultCacheResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -40435,7 +48200,7 @@ func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) fastWriteFiel
 	return offset
 }
 
-func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) field0Length() int {
+func (p *ExperimentServiceInvalidateExptResultCacheResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -40444,15 +48209,15 @@ func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) field0Length(
 	return l
 }
 
-func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceFeedbackExptInsightAnalysisReportResult)
+func (p *ExperimentServiceInvalidateExptResultCacheResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceInvalidateExptResultCacheResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *FeedbackExptInsightAnalysisReportResponse
+	var _success *InvalidateExptResultCacheResponse
 	if src.Success != nil {
-		_success = &FeedbackExptInsightAnalysisReportResponse{}
+		_success = &InvalidateExptResultCacheResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -40462,7 +48227,7 @@ func (p *ExperimentServiceFeedbackExptInsightAnalysisReportResult) DeepCopy(s in
 	return nil
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceSubmitExptPairwiseRankArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -40506,14 +48271,14 @@ func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) FastRead(buf []byt
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptInsightAnalysisCommentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceSubmitExptPairwiseRankArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceSubmitExptPairwiseRankArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExptInsightAnalysisCommentRequest()
+	_field := NewSubmitExptPairwiseRankRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -40523,11 +48288,11 @@ func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) FastReadField1(buf
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceSubmitExptPairwiseRankArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceSubmitExptPairwiseRankArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -40536,7 +48301,7 @@ func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) FastWriteNocopy(bu
 	return offset
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) BLength() int {
+func (p *ExperimentServiceSubmitExptPairwiseRankArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -40545,29 +48310,29 @@ func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceSubmitExptPairwiseRankArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) field1Length() int {
+func (p *ExperimentServiceSubmitExptPairwiseRankArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExptInsightAnalysisCommentArgs)
+func (p *ExperimentServiceSubmitExptPairwiseRankArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceSubmitExptPairwiseRankArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListExptInsightAnalysisCommentRequest
+	var _req *SubmitExptPairwiseRankRequest
 	if src.Req != nil {
-		_req = &ListExptInsightAnalysisCommentRequest{}
+		_req = &SubmitExptPairwiseRankRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -40577,7 +48342,7 @@ func (p *ExperimentServiceListExptInsightAnalysisCommentArgs) DeepCopy(s interfa
 	return nil
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisCommentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceSubmitExptPairwiseRankResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -40621,14 +48386,14 @@ func (p *ExperimentServiceListExptInsightAnalysisCommentResult) FastRead(buf []b
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExptInsightAnalysisCommentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceSubmitExptPairwiseRankResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisCommentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceSubmitExptPairwiseRankResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExptInsightAnalysisCommentResponse()
+	_field := NewSubmitExptPairwiseRankResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -40638,11 +48403,11 @@ func (p *ExperimentServiceListExptInsightAnalysisCommentResult) FastReadField0(b
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisCommentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceSubmitExptPairwiseRankResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisCommentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceSubmitExptPairwiseRankResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -40651,7 +48416,7 @@ func (p *ExperimentServiceListExptInsightAnalysisCommentResult) FastWriteNocopy(
 	return offset
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisCommentResult) BLength() int {
+func (p *ExperimentServiceSubmitExptPairwiseRankResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -40660,7 +48425,7 @@ func (p *ExperimentServiceListExptInsightAnalysisCommentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisCommentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceSubmitExptPairwiseRankResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -40669,7 +48434,7 @@ func (p *ExperimentServiceListExptInsightAnalysisCommentResult) fastWriteField0(
 	return offset
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisCommentResult) field0Length() int {
+func (p *ExperimentServiceSubmitExptPairwiseRankResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -40678,15 +48443,15 @@ func (p *ExperimentServiceListExptInsightAnalysisCommentResult) field0Length() i
 	return l
 }
 
-func (p *ExperimentServiceListExptInsightAnalysisCommentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExptInsightAnalysisCommentResult)
+func (p *ExperimentServiceSubmitExptPairwiseRankResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceSubmitExptPairwiseRankResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListExptInsightAnalysisCommentResponse
+	var _success *SubmitExptPairwiseRankResponse
 	if src.Success != nil {
-		_success = &ListExptInsightAnalysisCommentResponse{}
+		_success = &SubmitExptPairwiseRankResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -40696,7 +48461,7 @@ func (p *ExperimentServiceListExptInsightAnalysisCommentResult) DeepCopy(s inter
 	return nil
 }
 
-func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptPairwiseRankArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -40740,14 +48505,14 @@ func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) FastRead(buf []byte
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetAnalysisRecordFeedbackVoteArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptPairwiseRankArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptPairwiseRankArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetAnalysisRecordFeedbackVoteRequest()
+	_field := NewGetExptPairwiseRankRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -40757,11 +48522,11 @@ func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) FastReadField1(buf
 	return offset, nil
 }
 
-func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceGetExptPairwiseRankArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptPairwiseRankArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -40770,7 +48535,7 @@ func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) BLength() int {
+func (p *ExperimentServiceGetExptPairwiseRankArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -40779,29 +48544,29 @@ func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptPairwiseRankArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) field1Length() int {
+func (p *ExperimentServiceGetExptPairwiseRankArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceGetAnalysisRecordFeedbackVoteArgs)
+func (p *ExperimentServiceGetExptPairwiseRankArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetExptPairwiseRankArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *GetAnalysisRecordFeedbackVoteRequest
+	var _req *GetExptPairwiseRankRequest
 	if src.Req != nil {
-		_req = &GetAnalysisRecordFeedbackVoteRequest{}
+		_req = &GetExptPairwiseRankRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -40811,7 +48576,7 @@ func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteArgs) DeepCopy(s interfac
 	return nil
 }
 
-func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptPairwiseRankResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -40855,14 +48620,14 @@ func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) FastRead(buf []by
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetAnalysisRecordFeedbackVoteResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceGetExptPairwiseRankResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceGetExptPairwiseRankResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetAnalysisRecordFeedbackVoteResponse()
+	_field := NewGetExptPairwiseRankResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -40872,11 +48637,11 @@ func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) FastReadField0(bu
 	return offset, nil
 }
 
-func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceGetExptPairwiseRankResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptPairwiseRankResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -40885,7 +48650,7 @@ func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) FastWriteNocopy(b
 	return offset
 }
 
-func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) BLength() int {
+func (p *ExperimentServiceGetExptPairwiseRankResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -40894,7 +48659,7 @@ func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceGetExptPairwiseRankResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -40903,7 +48668,7 @@ func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) fastWriteField0(b
 	return offset
 }
 
-func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) field0Length() int {
+func (p *ExperimentServiceGetExptPairwiseRankResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -40912,15 +48677,15 @@ func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) field0Length() in
 	return l
 }
 
-func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceGetAnalysisRecordFeedbackVoteResult)
+func (p *ExperimentServiceGetExptPairwiseRankResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceGetExptPairwiseRankResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetAnalysisRecordFeedbackVoteResponse
+	var _success *GetExptPairwiseRankResponse
 	if src.Success != nil {
-		_success = &GetAnalysisRecordFeedbackVoteResponse{}
+		_success = &GetExptPairwiseRankResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -43098,6 +50863,70 @@ func (p *ExperimentServiceGetAnalysisRecordFeedbackVoteResult) GetResult() inter
 	return p.Success
 }
 
+func (p *ExperimentServiceCompareExperimentsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *ExperimentServiceCompareExperimentsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *ExperimentServiceGetExptRegressionReportArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *ExperimentServiceGetExptRegressionReportResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *ExperimentServiceExportExptRegressionReportArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *ExperimentServiceExportExptRegressionReportResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *ExperimentServiceGetExptGroupedAggrResultArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *ExperimentServiceGetExptGroupedAggrResultResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *ExperimentServiceGetExptSampledAggrResultArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *ExperimentServiceGetExptSampledAggrResultResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *ExperimentServiceInvalidateExptResultCacheArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *ExperimentServiceInvalidateExptResultCacheResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *ExperimentServiceSubmitExptPairwiseRankArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *ExperimentServiceSubmitExptPairwiseRankResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *ExperimentServiceGetExptPairwiseRankArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *ExperimentServiceGetExptPairwiseRankResult) GetResult() interface{} {
+	return p.Success
+}
+
 func (p *ExperimentServiceCreateExperimentTemplateArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
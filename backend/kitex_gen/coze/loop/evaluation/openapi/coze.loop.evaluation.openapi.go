@@ -29121,7 +29121,9 @@ type SubmitExperimentOApiRequest struct {
 	RunModeConfig *experiment.RunModeConfig `thrift:"run_mode_config,47,optional" frugal:"47,optional,experiment.RunModeConfig" form:"run_mode_config" json:"run_mode_config,omitempty"`
 	// 通知配置
 	NotificationConf *experiment.ExptNotificationConf `thrift:"notification_conf,50,optional" frugal:"50,optional,experiment.ExptNotificationConf" form:"notification_conf" json:"notification_conf,omitempty"`
-	Ext              map[string]string                `thrift:"ext,100,optional" frugal:"100,optional,map<string:string>" form:"ext" json:"ext,omitempty"`
+	// 质量门禁配置, 实验结束后异步评估, 结果通过 GetExperimentsOApi 与 lifecycle webhook 下发
+	QualityGateConf *experiment.ExptQualityGateConf `thrift:"quality_gate_conf,51,optional" frugal:"51,optional,experiment.ExptQualityGateConf" form:"quality_gate_conf" json:"quality_gate_conf,omitempty"`
+	Ext             map[string]string               `thrift:"ext,100,optional" frugal:"100,optional,map<string:string>" form:"ext" json:"ext,omitempty"`
 	// 实验分组 key 默认以实验 ID 兜底；填写 ref_group_experiment_id 时复用该引用实验的 group key（归入同一分组）。
 	// 引用分组实验 id: 填写时校验其为当前空间内的实验 id。
 	RefGroupExperimentID *int64       `thrift:"ref_group_experiment_id,102,optional" frugal:"102,optional,i64" json:"ref_group_experiment_id" form:"ref_group_experiment_id" `
@@ -29328,6 +29330,18 @@ func (p *SubmitExperimentOApiRequest) GetNotificationConf() (v *experiment.ExptN
 	return p.NotificationConf
 }
 
+var SubmitExperimentOApiRequest_QualityGateConf_DEFAULT *experiment.ExptQualityGateConf
+
+func (p *SubmitExperimentOApiRequest) GetQualityGateConf() (v *experiment.ExptQualityGateConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetQualityGateConf() {
+		return SubmitExperimentOApiRequest_QualityGateConf_DEFAULT
+	}
+	return p.QualityGateConf
+}
+
 var SubmitExperimentOApiRequest_Ext_DEFAULT map[string]string
 
 func (p *SubmitExperimentOApiRequest) GetExt() (v map[string]string) {
@@ -29423,6 +29437,9 @@ func (p *SubmitExperimentOApiRequest) SetRunModeConfig(val *experiment.RunModeCo
 func (p *SubmitExperimentOApiRequest) SetNotificationConf(val *experiment.ExptNotificationConf) {
 	p.NotificationConf = val
 }
+func (p *SubmitExperimentOApiRequest) SetQualityGateConf(val *experiment.ExptQualityGateConf) {
+	p.QualityGateConf = val
+}
 func (p *SubmitExperimentOApiRequest) SetExt(val map[string]string) {
 	p.Ext = val
 }
@@ -29453,6 +29470,7 @@ var fieldIDToName_SubmitExperimentOApiRequest = map[int16]string{
 	46:  "enable_extract_trajectory",
 	47:  "run_mode_config",
 	50:  "notification_conf",
+	51:  "quality_gate_conf",
 	100: "ext",
 	102: "ref_group_experiment_id",
 	254: "extra",
@@ -29523,6 +29541,10 @@ func (p *SubmitExperimentOApiRequest) IsSetNotificationConf() bool {
 	return p.NotificationConf != nil
 }
 
+func (p *SubmitExperimentOApiRequest) IsSetQualityGateConf() bool {
+	return p.QualityGateConf != nil
+}
+
 func (p *SubmitExperimentOApiRequest) IsSetExt() bool {
 	return p.Ext != nil
 }
@@ -29685,6 +29707,14 @@ func (p *SubmitExperimentOApiRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 51:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField51(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 100:
 			if fieldTypeId == thrift.MAP {
 				if err = p.ReadField100(iprot); err != nil {
@@ -29940,6 +29970,14 @@ func (p *SubmitExperimentOApiRequest) ReadField50(iprot thrift.TProtocol) error
 	p.NotificationConf = _field
 	return nil
 }
+func (p *SubmitExperimentOApiRequest) ReadField51(iprot thrift.TProtocol) error {
+	_field := experiment.NewExptQualityGateConf()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.QualityGateConf = _field
+	return nil
+}
 func (p *SubmitExperimentOApiRequest) ReadField100(iprot thrift.TProtocol) error {
 	_, _, size, err := iprot.ReadMapBegin()
 	if err != nil {
@@ -30067,6 +30105,10 @@ func (p *SubmitExperimentOApiRequest) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 50
 			goto WriteFieldError
 		}
+		if err = p.writeField51(oprot); err != nil {
+			fieldId = 51
+			goto WriteFieldError
+		}
 		if err = p.writeField100(oprot); err != nil {
 			fieldId = 100
 			goto WriteFieldError
@@ -30413,6 +30455,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 50 end error: ", p), err)
 }
+func (p *SubmitExperimentOApiRequest) writeField51(oprot thrift.TProtocol) (err error) {
+	if p.IsSetQualityGateConf() {
+		if err = oprot.WriteFieldBegin("quality_gate_conf", thrift.STRUCT, 51); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.QualityGateConf.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 51 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 51 end error: ", p), err)
+}
 func (p *SubmitExperimentOApiRequest) writeField100(oprot thrift.TProtocol) (err error) {
 	if p.IsSetExt() {
 		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 100); err != nil {
@@ -30559,6 +30619,9 @@ func (p *SubmitExperimentOApiRequest) DeepEqual(ano *SubmitExperimentOApiRequest
 	if !p.Field50DeepEqual(ano.NotificationConf) {
 		return false
 	}
+	if !p.Field51DeepEqual(ano.QualityGateConf) {
+		return false
+	}
 	if !p.Field100DeepEqual(ano.Ext) {
 		return false
 	}
@@ -30739,6 +30802,13 @@ func (p *SubmitExperimentOApiRequest) Field50DeepEqual(src *experiment.ExptNotif
 	}
 	return true
 }
+func (p *SubmitExperimentOApiRequest) Field51DeepEqual(src *experiment.ExptQualityGateConf) bool {
+
+	if !p.QualityGateConf.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *SubmitExperimentOApiRequest) Field100DeepEqual(src map[string]string) bool {
 
 	if len(p.Ext) != len(src) {
@@ -40203,146 +40273,209 @@ func (p *GetExperimentAggrResultOpenAPIData) Field2DeepEqual(src *experiment.Eva
 	return true
 }
 
-type RetryExperimentOApiRequest struct {
-	WorkspaceID  *int64                    `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" `
-	ExperimentID *int64                    `thrift:"experiment_id,2,optional" frugal:"2,optional,i64" json:"experiment_id" path:"experiment_id" `
-	RetryMode    *experiment.ExptRetryMode `thrift:"retry_mode,3,optional" frugal:"3,optional,string" form:"retry_mode" json:"retry_mode,omitempty"`
-	ItemIds      []int64                   `thrift:"item_ids,4,optional" frugal:"4,optional,list<i64>" json:"item_ids" form:"item_ids" `
-	Ext          map[string]string         `thrift:"ext,100,optional" frugal:"100,optional,map<string:string>" form:"ext" json:"ext,omitempty"`
-	Base         *base.Base                `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+type CompareExperimentsOApiRequest struct {
+	WorkspaceID            *int64       `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" `
+	BaseExperimentID       *int64       `thrift:"base_experiment_id,2,optional" frugal:"2,optional,i64" json:"base_experiment_id" form:"base_experiment_id" `
+	CandidateExperimentIds []int64      `thrift:"candidate_experiment_ids,3,optional" frugal:"3,optional,list<i64>" json:"candidate_experiment_ids" form:"candidate_experiment_ids" `
+	Confidence             *float64     `thrift:"confidence,4,optional" frugal:"4,optional,double" form:"confidence" json:"confidence,omitempty"`
+	BootstrapIterations    *int32       `thrift:"bootstrap_iterations,5,optional" frugal:"5,optional,i32" form:"bootstrap_iterations" json:"bootstrap_iterations,omitempty"`
+	Seed                   *int64       `thrift:"seed,6,optional" frugal:"6,optional,i64" json:"seed" form:"seed" `
+	TieThreshold           *float64     `thrift:"tie_threshold,7,optional" frugal:"7,optional,double" form:"tie_threshold" json:"tie_threshold,omitempty"`
+	Extra                  *extra.Extra `thrift:"extra,254,optional" frugal:"254,optional,extra.Extra" form:"extra" json:"extra,omitempty" query:"extra"`
+	Base                   *base.Base   `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func NewRetryExperimentOApiRequest() *RetryExperimentOApiRequest {
-	return &RetryExperimentOApiRequest{}
+func NewCompareExperimentsOApiRequest() *CompareExperimentsOApiRequest {
+	return &CompareExperimentsOApiRequest{}
 }
 
-func (p *RetryExperimentOApiRequest) InitDefault() {
+func (p *CompareExperimentsOApiRequest) InitDefault() {
 }
 
-var RetryExperimentOApiRequest_WorkspaceID_DEFAULT int64
+var CompareExperimentsOApiRequest_WorkspaceID_DEFAULT int64
 
-func (p *RetryExperimentOApiRequest) GetWorkspaceID() (v int64) {
+func (p *CompareExperimentsOApiRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return RetryExperimentOApiRequest_WorkspaceID_DEFAULT
+		return CompareExperimentsOApiRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-var RetryExperimentOApiRequest_ExperimentID_DEFAULT int64
+var CompareExperimentsOApiRequest_BaseExperimentID_DEFAULT int64
 
-func (p *RetryExperimentOApiRequest) GetExperimentID() (v int64) {
+func (p *CompareExperimentsOApiRequest) GetBaseExperimentID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetExperimentID() {
-		return RetryExperimentOApiRequest_ExperimentID_DEFAULT
+	if !p.IsSetBaseExperimentID() {
+		return CompareExperimentsOApiRequest_BaseExperimentID_DEFAULT
 	}
-	return *p.ExperimentID
+	return *p.BaseExperimentID
 }
 
-var RetryExperimentOApiRequest_RetryMode_DEFAULT experiment.ExptRetryMode
+var CompareExperimentsOApiRequest_CandidateExperimentIds_DEFAULT []int64
 
-func (p *RetryExperimentOApiRequest) GetRetryMode() (v experiment.ExptRetryMode) {
+func (p *CompareExperimentsOApiRequest) GetCandidateExperimentIds() (v []int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetRetryMode() {
-		return RetryExperimentOApiRequest_RetryMode_DEFAULT
+	if !p.IsSetCandidateExperimentIds() {
+		return CompareExperimentsOApiRequest_CandidateExperimentIds_DEFAULT
 	}
-	return *p.RetryMode
+	return p.CandidateExperimentIds
 }
 
-var RetryExperimentOApiRequest_ItemIds_DEFAULT []int64
+var CompareExperimentsOApiRequest_Confidence_DEFAULT float64
 
-func (p *RetryExperimentOApiRequest) GetItemIds() (v []int64) {
+func (p *CompareExperimentsOApiRequest) GetConfidence() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetItemIds() {
-		return RetryExperimentOApiRequest_ItemIds_DEFAULT
+	if !p.IsSetConfidence() {
+		return CompareExperimentsOApiRequest_Confidence_DEFAULT
 	}
-	return p.ItemIds
+	return *p.Confidence
 }
 
-var RetryExperimentOApiRequest_Ext_DEFAULT map[string]string
+var CompareExperimentsOApiRequest_BootstrapIterations_DEFAULT int32
 
-func (p *RetryExperimentOApiRequest) GetExt() (v map[string]string) {
+func (p *CompareExperimentsOApiRequest) GetBootstrapIterations() (v int32) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetExt() {
-		return RetryExperimentOApiRequest_Ext_DEFAULT
+	if !p.IsSetBootstrapIterations() {
+		return CompareExperimentsOApiRequest_BootstrapIterations_DEFAULT
 	}
-	return p.Ext
+	return *p.BootstrapIterations
 }
 
-var RetryExperimentOApiRequest_Base_DEFAULT *base.Base
+var CompareExperimentsOApiRequest_Seed_DEFAULT int64
 
-func (p *RetryExperimentOApiRequest) GetBase() (v *base.Base) {
+func (p *CompareExperimentsOApiRequest) GetSeed() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSeed() {
+		return CompareExperimentsOApiRequest_Seed_DEFAULT
+	}
+	return *p.Seed
+}
+
+var CompareExperimentsOApiRequest_TieThreshold_DEFAULT float64
+
+func (p *CompareExperimentsOApiRequest) GetTieThreshold() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTieThreshold() {
+		return CompareExperimentsOApiRequest_TieThreshold_DEFAULT
+	}
+	return *p.TieThreshold
+}
+
+var CompareExperimentsOApiRequest_Extra_DEFAULT *extra.Extra
+
+func (p *CompareExperimentsOApiRequest) GetExtra() (v *extra.Extra) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExtra() {
+		return CompareExperimentsOApiRequest_Extra_DEFAULT
+	}
+	return p.Extra
+}
+
+var CompareExperimentsOApiRequest_Base_DEFAULT *base.Base
+
+func (p *CompareExperimentsOApiRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return RetryExperimentOApiRequest_Base_DEFAULT
+		return CompareExperimentsOApiRequest_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *RetryExperimentOApiRequest) SetWorkspaceID(val *int64) {
+func (p *CompareExperimentsOApiRequest) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *RetryExperimentOApiRequest) SetExperimentID(val *int64) {
-	p.ExperimentID = val
+func (p *CompareExperimentsOApiRequest) SetBaseExperimentID(val *int64) {
+	p.BaseExperimentID = val
 }
-func (p *RetryExperimentOApiRequest) SetRetryMode(val *experiment.ExptRetryMode) {
-	p.RetryMode = val
+func (p *CompareExperimentsOApiRequest) SetCandidateExperimentIds(val []int64) {
+	p.CandidateExperimentIds = val
 }
-func (p *RetryExperimentOApiRequest) SetItemIds(val []int64) {
-	p.ItemIds = val
+func (p *CompareExperimentsOApiRequest) SetConfidence(val *float64) {
+	p.Confidence = val
 }
-func (p *RetryExperimentOApiRequest) SetExt(val map[string]string) {
-	p.Ext = val
+func (p *CompareExperimentsOApiRequest) SetBootstrapIterations(val *int32) {
+	p.BootstrapIterations = val
 }
-func (p *RetryExperimentOApiRequest) SetBase(val *base.Base) {
+func (p *CompareExperimentsOApiRequest) SetSeed(val *int64) {
+	p.Seed = val
+}
+func (p *CompareExperimentsOApiRequest) SetTieThreshold(val *float64) {
+	p.TieThreshold = val
+}
+func (p *CompareExperimentsOApiRequest) SetExtra(val *extra.Extra) {
+	p.Extra = val
+}
+func (p *CompareExperimentsOApiRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_RetryExperimentOApiRequest = map[int16]string{
+var fieldIDToName_CompareExperimentsOApiRequest = map[int16]string{
 	1:   "workspace_id",
-	2:   "experiment_id",
-	3:   "retry_mode",
-	4:   "item_ids",
-	100: "ext",
+	2:   "base_experiment_id",
+	3:   "candidate_experiment_ids",
+	4:   "confidence",
+	5:   "bootstrap_iterations",
+	6:   "seed",
+	7:   "tie_threshold",
+	254: "extra",
 	255: "Base",
 }
 
-func (p *RetryExperimentOApiRequest) IsSetWorkspaceID() bool {
+func (p *CompareExperimentsOApiRequest) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *RetryExperimentOApiRequest) IsSetExperimentID() bool {
-	return p.ExperimentID != nil
+func (p *CompareExperimentsOApiRequest) IsSetBaseExperimentID() bool {
+	return p.BaseExperimentID != nil
 }
 
-func (p *RetryExperimentOApiRequest) IsSetRetryMode() bool {
-	return p.RetryMode != nil
+func (p *CompareExperimentsOApiRequest) IsSetCandidateExperimentIds() bool {
+	return p.CandidateExperimentIds != nil
 }
 
-func (p *RetryExperimentOApiRequest) IsSetItemIds() bool {
-	return p.ItemIds != nil
+func (p *CompareExperimentsOApiRequest) IsSetConfidence() bool {
+	return p.Confidence != nil
 }
 
-func (p *RetryExperimentOApiRequest) IsSetExt() bool {
-	return p.Ext != nil
+func (p *CompareExperimentsOApiRequest) IsSetBootstrapIterations() bool {
+	return p.BootstrapIterations != nil
 }
 
-func (p *RetryExperimentOApiRequest) IsSetBase() bool {
+func (p *CompareExperimentsOApiRequest) IsSetSeed() bool {
+	return p.Seed != nil
+}
+
+func (p *CompareExperimentsOApiRequest) IsSetTieThreshold() bool {
+	return p.TieThreshold != nil
+}
+
+func (p *CompareExperimentsOApiRequest) IsSetExtra() bool {
+	return p.Extra != nil
+}
+
+func (p *CompareExperimentsOApiRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *RetryExperimentOApiRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *CompareExperimentsOApiRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -40377,7 +40510,7 @@ func (p *RetryExperimentOApiRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -40385,16 +40518,40 @@ func (p *RetryExperimentOApiRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 100:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField100(iprot); err != nil {
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 254:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField254(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -40427,7 +40584,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RetryExperimentOApiRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompareExperimentsOApiRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -40437,7 +40594,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RetryExperimentOApiRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *CompareExperimentsOApiRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -40448,7 +40605,7 @@ func (p *RetryExperimentOApiRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *RetryExperimentOApiRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *CompareExperimentsOApiRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -40456,21 +40613,10 @@ func (p *RetryExperimentOApiRequest) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.ExperimentID = _field
+	p.BaseExperimentID = _field
 	return nil
 }
-func (p *RetryExperimentOApiRequest) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *experiment.ExptRetryMode
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.RetryMode = _field
-	return nil
-}
-func (p *RetryExperimentOApiRequest) ReadField4(iprot thrift.TProtocol) error {
+func (p *CompareExperimentsOApiRequest) ReadField3(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
@@ -40490,39 +40636,62 @@ func (p *RetryExperimentOApiRequest) ReadField4(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.ItemIds = _field
+	p.CandidateExperimentIds = _field
 	return nil
 }
-func (p *RetryExperimentOApiRequest) ReadField100(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
+func (p *CompareExperimentsOApiRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
+	p.Confidence = _field
+	return nil
+}
+func (p *CompareExperimentsOApiRequest) ReadField5(iprot thrift.TProtocol) error {
 
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
-		}
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BootstrapIterations = _field
+	return nil
+}
+func (p *CompareExperimentsOApiRequest) ReadField6(iprot thrift.TProtocol) error {
 
-		_field[_key] = _val
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	if err := iprot.ReadMapEnd(); err != nil {
+	p.Seed = _field
+	return nil
+}
+func (p *CompareExperimentsOApiRequest) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Ext = _field
+	p.TieThreshold = _field
 	return nil
 }
-func (p *RetryExperimentOApiRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *CompareExperimentsOApiRequest) ReadField254(iprot thrift.TProtocol) error {
+	_field := extra.NewExtra()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Extra = _field
+	return nil
+}
+func (p *CompareExperimentsOApiRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -40531,9 +40700,9 @@ func (p *RetryExperimentOApiRequest) ReadField255(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *RetryExperimentOApiRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *CompareExperimentsOApiRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RetryExperimentOApiRequest"); err != nil {
+	if err = oprot.WriteStructBegin("CompareExperimentsOApiRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -40553,8 +40722,20 @@ func (p *RetryExperimentOApiRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField100(oprot); err != nil {
-			fieldId = 100
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField254(oprot); err != nil {
+			fieldId = 254
 			goto WriteFieldError
 		}
 		if err = p.writeField255(oprot); err != nil {
@@ -40579,7 +40760,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RetryExperimentOApiRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CompareExperimentsOApiRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -40597,12 +40778,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *RetryExperimentOApiRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExperimentID() {
-		if err = oprot.WriteFieldBegin("experiment_id", thrift.I64, 2); err != nil {
+func (p *CompareExperimentsOApiRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseExperimentID() {
+		if err = oprot.WriteFieldBegin("base_experiment_id", thrift.I64, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.ExperimentID); err != nil {
+		if err := oprot.WriteI64(*p.BaseExperimentID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -40615,12 +40796,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *RetryExperimentOApiRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRetryMode() {
-		if err = oprot.WriteFieldBegin("retry_mode", thrift.STRING, 3); err != nil {
+func (p *CompareExperimentsOApiRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCandidateExperimentIds() {
+		if err = oprot.WriteFieldBegin("candidate_experiment_ids", thrift.LIST, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.RetryMode); err != nil {
+		if err := oprot.WriteListBegin(thrift.I64, len(p.CandidateExperimentIds)); err != nil {
+			return err
+		}
+		for _, v := range p.CandidateExperimentIds {
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -40633,20 +40822,30 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *RetryExperimentOApiRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetItemIds() {
-		if err = oprot.WriteFieldBegin("item_ids", thrift.LIST, 4); err != nil {
+func (p *CompareExperimentsOApiRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConfidence() {
+		if err = oprot.WriteFieldBegin("confidence", thrift.DOUBLE, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.I64, len(p.ItemIds)); err != nil {
+		if err := oprot.WriteDouble(*p.Confidence); err != nil {
 			return err
 		}
-		for _, v := range p.ItemIds {
-			if err := oprot.WriteI64(v); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err := oprot.WriteListEnd(); err != nil {
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *CompareExperimentsOApiRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBootstrapIterations() {
+		if err = oprot.WriteFieldBegin("bootstrap_iterations", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.BootstrapIterations); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -40655,27 +40854,34 @@ func (p *RetryExperimentOApiRequest) writeField4(oprot thrift.TProtocol) (err er
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *RetryExperimentOApiRequest) writeField100(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExt() {
-		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 100); err != nil {
+func (p *CompareExperimentsOApiRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSeed() {
+		if err = oprot.WriteFieldBegin("seed", thrift.I64, 6); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Ext)); err != nil {
+		if err := oprot.WriteI64(*p.Seed); err != nil {
 			return err
 		}
-		for k, v := range p.Ext {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err := oprot.WriteMapEnd(); err != nil {
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *CompareExperimentsOApiRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTieThreshold() {
+		if err = oprot.WriteFieldBegin("tie_threshold", thrift.DOUBLE, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.TieThreshold); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -40684,11 +40890,29 @@ func (p *RetryExperimentOApiRequest) writeField100(oprot thrift.TProtocol) (err
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
-func (p *RetryExperimentOApiRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *CompareExperimentsOApiRequest) writeField254(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExtra() {
+		if err = oprot.WriteFieldBegin("extra", thrift.STRUCT, 254); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Extra.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 254 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 254 end error: ", p), err)
+}
+func (p *CompareExperimentsOApiRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
@@ -40707,15 +40931,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *RetryExperimentOApiRequest) String() string {
+func (p *CompareExperimentsOApiRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RetryExperimentOApiRequest(%+v)", *p)
+	return fmt.Sprintf("CompareExperimentsOApiRequest(%+v)", *p)
 
 }
 
-func (p *RetryExperimentOApiRequest) DeepEqual(ano *RetryExperimentOApiRequest) bool {
+func (p *CompareExperimentsOApiRequest) DeepEqual(ano *CompareExperimentsOApiRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -40724,16 +40948,25 @@ func (p *RetryExperimentOApiRequest) DeepEqual(ano *RetryExperimentOApiRequest)
 	if !p.Field1DeepEqual(ano.WorkspaceID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.ExperimentID) {
+	if !p.Field2DeepEqual(ano.BaseExperimentID) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.RetryMode) {
+	if !p.Field3DeepEqual(ano.CandidateExperimentIds) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.ItemIds) {
+	if !p.Field4DeepEqual(ano.Confidence) {
 		return false
 	}
-	if !p.Field100DeepEqual(ano.Ext) {
+	if !p.Field5DeepEqual(ano.BootstrapIterations) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Seed) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.TieThreshold) {
+		return false
+	}
+	if !p.Field254DeepEqual(ano.Extra) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.Base) {
@@ -40742,7 +40975,7 @@ func (p *RetryExperimentOApiRequest) DeepEqual(ano *RetryExperimentOApiRequest)
 	return true
 }
 
-func (p *RetryExperimentOApiRequest) Field1DeepEqual(src *int64) bool {
+func (p *CompareExperimentsOApiRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -40754,57 +40987,87 @@ func (p *RetryExperimentOApiRequest) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *RetryExperimentOApiRequest) Field2DeepEqual(src *int64) bool {
+func (p *CompareExperimentsOApiRequest) Field2DeepEqual(src *int64) bool {
 
-	if p.ExperimentID == src {
+	if p.BaseExperimentID == src {
 		return true
-	} else if p.ExperimentID == nil || src == nil {
+	} else if p.BaseExperimentID == nil || src == nil {
 		return false
 	}
-	if *p.ExperimentID != *src {
+	if *p.BaseExperimentID != *src {
 		return false
 	}
 	return true
 }
-func (p *RetryExperimentOApiRequest) Field3DeepEqual(src *experiment.ExptRetryMode) bool {
+func (p *CompareExperimentsOApiRequest) Field3DeepEqual(src []int64) bool {
 
-	if p.RetryMode == src {
+	if len(p.CandidateExperimentIds) != len(src) {
+		return false
+	}
+	for i, v := range p.CandidateExperimentIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+func (p *CompareExperimentsOApiRequest) Field4DeepEqual(src *float64) bool {
+
+	if p.Confidence == src {
 		return true
-	} else if p.RetryMode == nil || src == nil {
+	} else if p.Confidence == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.RetryMode, *src) != 0 {
+	if *p.Confidence != *src {
 		return false
 	}
 	return true
 }
-func (p *RetryExperimentOApiRequest) Field4DeepEqual(src []int64) bool {
+func (p *CompareExperimentsOApiRequest) Field5DeepEqual(src *int32) bool {
 
-	if len(p.ItemIds) != len(src) {
+	if p.BootstrapIterations == src {
+		return true
+	} else if p.BootstrapIterations == nil || src == nil {
 		return false
 	}
-	for i, v := range p.ItemIds {
-		_src := src[i]
-		if v != _src {
-			return false
-		}
+	if *p.BootstrapIterations != *src {
+		return false
 	}
 	return true
 }
-func (p *RetryExperimentOApiRequest) Field100DeepEqual(src map[string]string) bool {
+func (p *CompareExperimentsOApiRequest) Field6DeepEqual(src *int64) bool {
 
-	if len(p.Ext) != len(src) {
+	if p.Seed == src {
+		return true
+	} else if p.Seed == nil || src == nil {
 		return false
 	}
-	for k, v := range p.Ext {
-		_src := src[k]
-		if strings.Compare(v, _src) != 0 {
-			return false
-		}
+	if *p.Seed != *src {
+		return false
 	}
 	return true
 }
-func (p *RetryExperimentOApiRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *CompareExperimentsOApiRequest) Field7DeepEqual(src *float64) bool {
+
+	if p.TieThreshold == src {
+		return true
+	} else if p.TieThreshold == nil || src == nil {
+		return false
+	}
+	if *p.TieThreshold != *src {
+		return false
+	}
+	return true
+}
+func (p *CompareExperimentsOApiRequest) Field254DeepEqual(src *extra.Extra) bool {
+
+	if !p.Extra.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *CompareExperimentsOApiRequest) Field255DeepEqual(src *base.Base) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
@@ -40812,104 +41075,104 @@ func (p *RetryExperimentOApiRequest) Field255DeepEqual(src *base.Base) bool {
 	return true
 }
 
-type RetryExperimentOApiResponse struct {
-	Code     *int32                      `thrift:"code,1,optional" frugal:"1,optional,i32" form:"code" json:"code,omitempty" query:"code"`
-	Msg      *string                     `thrift:"msg,2,optional" frugal:"2,optional,string" form:"msg" json:"msg,omitempty" query:"msg"`
-	Data     *RetryExperimentOpenAPIData `thrift:"data,3,optional" frugal:"3,optional,RetryExperimentOpenAPIData" form:"data" json:"data,omitempty" query:"data"`
-	BaseResp *base.BaseResp              `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+type CompareExperimentsOApiResponse struct {
+	Code     *int32                         `thrift:"code,1,optional" frugal:"1,optional,i32" form:"code" json:"code,omitempty" query:"code"`
+	Msg      *string                        `thrift:"msg,2,optional" frugal:"2,optional,string" form:"msg" json:"msg,omitempty" query:"msg"`
+	Data     *CompareExperimentsOpenAPIData `thrift:"data,3,optional" frugal:"3,optional,CompareExperimentsOpenAPIData" form:"data" json:"data,omitempty" query:"data"`
+	BaseResp *base.BaseResp                 `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
 }
 
-func NewRetryExperimentOApiResponse() *RetryExperimentOApiResponse {
-	return &RetryExperimentOApiResponse{}
+func NewCompareExperimentsOApiResponse() *CompareExperimentsOApiResponse {
+	return &CompareExperimentsOApiResponse{}
 }
 
-func (p *RetryExperimentOApiResponse) InitDefault() {
+func (p *CompareExperimentsOApiResponse) InitDefault() {
 }
 
-var RetryExperimentOApiResponse_Code_DEFAULT int32
+var CompareExperimentsOApiResponse_Code_DEFAULT int32
 
-func (p *RetryExperimentOApiResponse) GetCode() (v int32) {
+func (p *CompareExperimentsOApiResponse) GetCode() (v int32) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetCode() {
-		return RetryExperimentOApiResponse_Code_DEFAULT
+		return CompareExperimentsOApiResponse_Code_DEFAULT
 	}
 	return *p.Code
 }
 
-var RetryExperimentOApiResponse_Msg_DEFAULT string
+var CompareExperimentsOApiResponse_Msg_DEFAULT string
 
-func (p *RetryExperimentOApiResponse) GetMsg() (v string) {
+func (p *CompareExperimentsOApiResponse) GetMsg() (v string) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetMsg() {
-		return RetryExperimentOApiResponse_Msg_DEFAULT
+		return CompareExperimentsOApiResponse_Msg_DEFAULT
 	}
 	return *p.Msg
 }
 
-var RetryExperimentOApiResponse_Data_DEFAULT *RetryExperimentOpenAPIData
+var CompareExperimentsOApiResponse_Data_DEFAULT *CompareExperimentsOpenAPIData
 
-func (p *RetryExperimentOApiResponse) GetData() (v *RetryExperimentOpenAPIData) {
+func (p *CompareExperimentsOApiResponse) GetData() (v *CompareExperimentsOpenAPIData) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetData() {
-		return RetryExperimentOApiResponse_Data_DEFAULT
+		return CompareExperimentsOApiResponse_Data_DEFAULT
 	}
 	return p.Data
 }
 
-var RetryExperimentOApiResponse_BaseResp_DEFAULT *base.BaseResp
+var CompareExperimentsOApiResponse_BaseResp_DEFAULT *base.BaseResp
 
-func (p *RetryExperimentOApiResponse) GetBaseResp() (v *base.BaseResp) {
+func (p *CompareExperimentsOApiResponse) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return RetryExperimentOApiResponse_BaseResp_DEFAULT
+		return CompareExperimentsOApiResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *RetryExperimentOApiResponse) SetCode(val *int32) {
+func (p *CompareExperimentsOApiResponse) SetCode(val *int32) {
 	p.Code = val
 }
-func (p *RetryExperimentOApiResponse) SetMsg(val *string) {
+func (p *CompareExperimentsOApiResponse) SetMsg(val *string) {
 	p.Msg = val
 }
-func (p *RetryExperimentOApiResponse) SetData(val *RetryExperimentOpenAPIData) {
+func (p *CompareExperimentsOApiResponse) SetData(val *CompareExperimentsOpenAPIData) {
 	p.Data = val
 }
-func (p *RetryExperimentOApiResponse) SetBaseResp(val *base.BaseResp) {
+func (p *CompareExperimentsOApiResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_RetryExperimentOApiResponse = map[int16]string{
+var fieldIDToName_CompareExperimentsOApiResponse = map[int16]string{
 	1:   "code",
 	2:   "msg",
 	3:   "data",
 	255: "BaseResp",
 }
 
-func (p *RetryExperimentOApiResponse) IsSetCode() bool {
+func (p *CompareExperimentsOApiResponse) IsSetCode() bool {
 	return p.Code != nil
 }
 
-func (p *RetryExperimentOApiResponse) IsSetMsg() bool {
+func (p *CompareExperimentsOApiResponse) IsSetMsg() bool {
 	return p.Msg != nil
 }
 
-func (p *RetryExperimentOApiResponse) IsSetData() bool {
+func (p *CompareExperimentsOApiResponse) IsSetData() bool {
 	return p.Data != nil
 }
 
-func (p *RetryExperimentOApiResponse) IsSetBaseResp() bool {
+func (p *CompareExperimentsOApiResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *RetryExperimentOApiResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *CompareExperimentsOApiResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -40978,7 +41241,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RetryExperimentOApiResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompareExperimentsOApiResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -40988,7 +41251,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RetryExperimentOApiResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *CompareExperimentsOApiResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int32
 	if v, err := iprot.ReadI32(); err != nil {
@@ -40999,7 +41262,7 @@ func (p *RetryExperimentOApiResponse) ReadField1(iprot thrift.TProtocol) error {
 	p.Code = _field
 	return nil
 }
-func (p *RetryExperimentOApiResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *CompareExperimentsOApiResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -41010,15 +41273,15 @@ func (p *RetryExperimentOApiResponse) ReadField2(iprot thrift.TProtocol) error {
 	p.Msg = _field
 	return nil
 }
-func (p *RetryExperimentOApiResponse) ReadField3(iprot thrift.TProtocol) error {
-	_field := NewRetryExperimentOpenAPIData()
+func (p *CompareExperimentsOApiResponse) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewCompareExperimentsOpenAPIData()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
 	p.Data = _field
 	return nil
 }
-func (p *RetryExperimentOApiResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *CompareExperimentsOApiResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -41027,9 +41290,9 @@ func (p *RetryExperimentOApiResponse) ReadField255(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *RetryExperimentOApiResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *CompareExperimentsOApiResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RetryExperimentOApiResponse"); err != nil {
+	if err = oprot.WriteStructBegin("CompareExperimentsOApiResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -41067,7 +41330,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RetryExperimentOApiResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CompareExperimentsOApiResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetCode() {
 		if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
 			goto WriteFieldBeginError
@@ -41085,7 +41348,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *RetryExperimentOApiResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *CompareExperimentsOApiResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if p.IsSetMsg() {
 		if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
@@ -41103,7 +41366,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *RetryExperimentOApiResponse) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *CompareExperimentsOApiResponse) writeField3(oprot thrift.TProtocol) (err error) {
 	if p.IsSetData() {
 		if err = oprot.WriteFieldBegin("data", thrift.STRUCT, 3); err != nil {
 			goto WriteFieldBeginError
@@ -41121,7 +41384,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *RetryExperimentOApiResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *CompareExperimentsOApiResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -41138,15 +41401,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *RetryExperimentOApiResponse) String() string {
+func (p *CompareExperimentsOApiResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RetryExperimentOApiResponse(%+v)", *p)
+	return fmt.Sprintf("CompareExperimentsOApiResponse(%+v)", *p)
 
 }
 
-func (p *RetryExperimentOApiResponse) DeepEqual(ano *RetryExperimentOApiResponse) bool {
+func (p *CompareExperimentsOApiResponse) DeepEqual(ano *CompareExperimentsOApiResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -41167,7 +41430,7 @@ func (p *RetryExperimentOApiResponse) DeepEqual(ano *RetryExperimentOApiResponse
 	return true
 }
 
-func (p *RetryExperimentOApiResponse) Field1DeepEqual(src *int32) bool {
+func (p *CompareExperimentsOApiResponse) Field1DeepEqual(src *int32) bool {
 
 	if p.Code == src {
 		return true
@@ -41179,7 +41442,7 @@ func (p *RetryExperimentOApiResponse) Field1DeepEqual(src *int32) bool {
 	}
 	return true
 }
-func (p *RetryExperimentOApiResponse) Field2DeepEqual(src *string) bool {
+func (p *CompareExperimentsOApiResponse) Field2DeepEqual(src *string) bool {
 
 	if p.Msg == src {
 		return true
@@ -41191,14 +41454,14 @@ func (p *RetryExperimentOApiResponse) Field2DeepEqual(src *string) bool {
 	}
 	return true
 }
-func (p *RetryExperimentOApiResponse) Field3DeepEqual(src *RetryExperimentOpenAPIData) bool {
+func (p *CompareExperimentsOApiResponse) Field3DeepEqual(src *CompareExperimentsOpenAPIData) bool {
 
 	if !p.Data.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *RetryExperimentOApiResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *CompareExperimentsOApiResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -41206,41 +41469,62 @@ func (p *RetryExperimentOApiResponse) Field255DeepEqual(src *base.BaseResp) bool
 	return true
 }
 
-type RetryExperimentOpenAPIData struct {
-	RunID *int64 `thrift:"run_id,3,optional" frugal:"3,optional,i64" form:"run_id" json:"run_id,omitempty" query:"run_id"`
+type CompareExperimentsOpenAPIData struct {
+	BaseExperimentID *int64                                   `thrift:"base_experiment_id,1,optional" frugal:"1,optional,i64" json:"base_experiment_id" form:"base_experiment_id" query:"base_experiment_id"`
+	Comparisons      []*experiment.ExperimentPairSignificance `thrift:"comparisons,2,optional" frugal:"2,optional,list<experiment.ExperimentPairSignificance>" form:"comparisons" json:"comparisons,omitempty" query:"comparisons"`
 }
 
-func NewRetryExperimentOpenAPIData() *RetryExperimentOpenAPIData {
-	return &RetryExperimentOpenAPIData{}
+func NewCompareExperimentsOpenAPIData() *CompareExperimentsOpenAPIData {
+	return &CompareExperimentsOpenAPIData{}
 }
 
-func (p *RetryExperimentOpenAPIData) InitDefault() {
+func (p *CompareExperimentsOpenAPIData) InitDefault() {
 }
 
-var RetryExperimentOpenAPIData_RunID_DEFAULT int64
+var CompareExperimentsOpenAPIData_BaseExperimentID_DEFAULT int64
 
-func (p *RetryExperimentOpenAPIData) GetRunID() (v int64) {
+func (p *CompareExperimentsOpenAPIData) GetBaseExperimentID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetRunID() {
-		return RetryExperimentOpenAPIData_RunID_DEFAULT
+	if !p.IsSetBaseExperimentID() {
+		return CompareExperimentsOpenAPIData_BaseExperimentID_DEFAULT
 	}
-	return *p.RunID
+	return *p.BaseExperimentID
 }
-func (p *RetryExperimentOpenAPIData) SetRunID(val *int64) {
-	p.RunID = val
+
+var CompareExperimentsOpenAPIData_Comparisons_DEFAULT []*experiment.ExperimentPairSignificance
+
+func (p *CompareExperimentsOpenAPIData) GetComparisons() (v []*experiment.ExperimentPairSignificance) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetComparisons() {
+		return CompareExperimentsOpenAPIData_Comparisons_DEFAULT
+	}
+	return p.Comparisons
+}
+func (p *CompareExperimentsOpenAPIData) SetBaseExperimentID(val *int64) {
+	p.BaseExperimentID = val
+}
+func (p *CompareExperimentsOpenAPIData) SetComparisons(val []*experiment.ExperimentPairSignificance) {
+	p.Comparisons = val
 }
 
-var fieldIDToName_RetryExperimentOpenAPIData = map[int16]string{
-	3: "run_id",
+var fieldIDToName_CompareExperimentsOpenAPIData = map[int16]string{
+	1: "base_experiment_id",
+	2: "comparisons",
 }
 
-func (p *RetryExperimentOpenAPIData) IsSetRunID() bool {
-	return p.RunID != nil
+func (p *CompareExperimentsOpenAPIData) IsSetBaseExperimentID() bool {
+	return p.BaseExperimentID != nil
 }
 
-func (p *RetryExperimentOpenAPIData) Read(iprot thrift.TProtocol) (err error) {
+func (p *CompareExperimentsOpenAPIData) IsSetComparisons() bool {
+	return p.Comparisons != nil
+}
+
+func (p *CompareExperimentsOpenAPIData) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -41258,9 +41542,17 @@ func (p *RetryExperimentOpenAPIData) Read(iprot thrift.TProtocol) (err error) {
 		}
 
 		switch fieldId {
-		case 3:
+		case 1:
 			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField3(iprot); err != nil {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -41285,7 +41577,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RetryExperimentOpenAPIData[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompareExperimentsOpenAPIData[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -41295,7 +41587,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RetryExperimentOpenAPIData) ReadField3(iprot thrift.TProtocol) error {
+func (p *CompareExperimentsOpenAPIData) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -41303,18 +41595,45 @@ func (p *RetryExperimentOpenAPIData) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.RunID = _field
+	p.BaseExperimentID = _field
 	return nil
 }
+func (p *CompareExperimentsOpenAPIData) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*experiment.ExperimentPairSignificance, 0, size)
+	values := make([]experiment.ExperimentPairSignificance, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-func (p *RetryExperimentOpenAPIData) Write(oprot thrift.TProtocol) (err error) {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Comparisons = _field
+	return nil
+}
+
+func (p *CompareExperimentsOpenAPIData) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RetryExperimentOpenAPIData"); err != nil {
+	if err = oprot.WriteStructBegin("CompareExperimentsOpenAPIData"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
 			goto WriteFieldError
 		}
 	}
@@ -41335,12 +41654,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RetryExperimentOpenAPIData) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRunID() {
-		if err = oprot.WriteFieldBegin("run_id", thrift.I64, 3); err != nil {
+func (p *CompareExperimentsOpenAPIData) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseExperimentID() {
+		if err = oprot.WriteFieldBegin("base_experiment_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.RunID); err != nil {
+		if err := oprot.WriteI64(*p.BaseExperimentID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -41349,123 +41668,1349 @@ func (p *RetryExperimentOpenAPIData) writeField3(oprot thrift.TProtocol) (err er
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CompareExperimentsOpenAPIData) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetComparisons() {
+		if err = oprot.WriteFieldBegin("comparisons", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Comparisons)); err != nil {
+			return err
+		}
+		for _, v := range p.Comparisons {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *RetryExperimentOpenAPIData) String() string {
+func (p *CompareExperimentsOpenAPIData) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RetryExperimentOpenAPIData(%+v)", *p)
+	return fmt.Sprintf("CompareExperimentsOpenAPIData(%+v)", *p)
 
 }
 
-func (p *RetryExperimentOpenAPIData) DeepEqual(ano *RetryExperimentOpenAPIData) bool {
+func (p *CompareExperimentsOpenAPIData) DeepEqual(ano *CompareExperimentsOpenAPIData) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.RunID) {
+	if !p.Field1DeepEqual(ano.BaseExperimentID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Comparisons) {
 		return false
 	}
 	return true
 }
 
-func (p *RetryExperimentOpenAPIData) Field3DeepEqual(src *int64) bool {
+func (p *CompareExperimentsOpenAPIData) Field1DeepEqual(src *int64) bool {
 
-	if p.RunID == src {
+	if p.BaseExperimentID == src {
 		return true
-	} else if p.RunID == nil || src == nil {
+	} else if p.BaseExperimentID == nil || src == nil {
 		return false
 	}
-	if *p.RunID != *src {
+	if *p.BaseExperimentID != *src {
 		return false
 	}
 	return true
 }
+func (p *CompareExperimentsOpenAPIData) Field2DeepEqual(src []*experiment.ExperimentPairSignificance) bool {
 
-// KillExperimentOApiRequest 通过 OpenAPI 终止一个进行中的实验。
-// 仅对处于 Processing 状态的实验生效，成功后实验会被置为 Terminated。
-type KillExperimentOApiRequest struct {
-	WorkspaceID  *int64     `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" `
-	ExperimentID *int64     `thrift:"experiment_id,2,optional" frugal:"2,optional,i64" json:"experiment_id" path:"experiment_id" `
-	Base         *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+	if len(p.Comparisons) != len(src) {
+		return false
+	}
+	for i, v := range p.Comparisons {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
 }
 
-func NewKillExperimentOApiRequest() *KillExperimentOApiRequest {
-	return &KillExperimentOApiRequest{}
+type RetryExperimentOApiRequest struct {
+	WorkspaceID  *int64                    `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" `
+	ExperimentID *int64                    `thrift:"experiment_id,2,optional" frugal:"2,optional,i64" json:"experiment_id" path:"experiment_id" `
+	RetryMode    *experiment.ExptRetryMode `thrift:"retry_mode,3,optional" frugal:"3,optional,string" form:"retry_mode" json:"retry_mode,omitempty"`
+	ItemIds      []int64                   `thrift:"item_ids,4,optional" frugal:"4,optional,list<i64>" json:"item_ids" form:"item_ids" `
+	Ext          map[string]string         `thrift:"ext,100,optional" frugal:"100,optional,map<string:string>" form:"ext" json:"ext,omitempty"`
+	Base         *base.Base                `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func (p *KillExperimentOApiRequest) InitDefault() {
+func NewRetryExperimentOApiRequest() *RetryExperimentOApiRequest {
+	return &RetryExperimentOApiRequest{}
 }
 
-var KillExperimentOApiRequest_WorkspaceID_DEFAULT int64
+func (p *RetryExperimentOApiRequest) InitDefault() {
+}
 
-func (p *KillExperimentOApiRequest) GetWorkspaceID() (v int64) {
+var RetryExperimentOApiRequest_WorkspaceID_DEFAULT int64
+
+func (p *RetryExperimentOApiRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return KillExperimentOApiRequest_WorkspaceID_DEFAULT
+		return RetryExperimentOApiRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-var KillExperimentOApiRequest_ExperimentID_DEFAULT int64
+var RetryExperimentOApiRequest_ExperimentID_DEFAULT int64
 
-func (p *KillExperimentOApiRequest) GetExperimentID() (v int64) {
+func (p *RetryExperimentOApiRequest) GetExperimentID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetExperimentID() {
-		return KillExperimentOApiRequest_ExperimentID_DEFAULT
+		return RetryExperimentOApiRequest_ExperimentID_DEFAULT
 	}
 	return *p.ExperimentID
 }
 
-var KillExperimentOApiRequest_Base_DEFAULT *base.Base
+var RetryExperimentOApiRequest_RetryMode_DEFAULT experiment.ExptRetryMode
 
-func (p *KillExperimentOApiRequest) GetBase() (v *base.Base) {
+func (p *RetryExperimentOApiRequest) GetRetryMode() (v experiment.ExptRetryMode) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRetryMode() {
+		return RetryExperimentOApiRequest_RetryMode_DEFAULT
+	}
+	return *p.RetryMode
+}
+
+var RetryExperimentOApiRequest_ItemIds_DEFAULT []int64
+
+func (p *RetryExperimentOApiRequest) GetItemIds() (v []int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetItemIds() {
+		return RetryExperimentOApiRequest_ItemIds_DEFAULT
+	}
+	return p.ItemIds
+}
+
+var RetryExperimentOApiRequest_Ext_DEFAULT map[string]string
+
+func (p *RetryExperimentOApiRequest) GetExt() (v map[string]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExt() {
+		return RetryExperimentOApiRequest_Ext_DEFAULT
+	}
+	return p.Ext
+}
+
+var RetryExperimentOApiRequest_Base_DEFAULT *base.Base
+
+func (p *RetryExperimentOApiRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return KillExperimentOApiRequest_Base_DEFAULT
+		return RetryExperimentOApiRequest_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *KillExperimentOApiRequest) SetWorkspaceID(val *int64) {
+func (p *RetryExperimentOApiRequest) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *KillExperimentOApiRequest) SetExperimentID(val *int64) {
+func (p *RetryExperimentOApiRequest) SetExperimentID(val *int64) {
 	p.ExperimentID = val
 }
-func (p *KillExperimentOApiRequest) SetBase(val *base.Base) {
+func (p *RetryExperimentOApiRequest) SetRetryMode(val *experiment.ExptRetryMode) {
+	p.RetryMode = val
+}
+func (p *RetryExperimentOApiRequest) SetItemIds(val []int64) {
+	p.ItemIds = val
+}
+func (p *RetryExperimentOApiRequest) SetExt(val map[string]string) {
+	p.Ext = val
+}
+func (p *RetryExperimentOApiRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_KillExperimentOApiRequest = map[int16]string{
+var fieldIDToName_RetryExperimentOApiRequest = map[int16]string{
 	1:   "workspace_id",
 	2:   "experiment_id",
+	3:   "retry_mode",
+	4:   "item_ids",
+	100: "ext",
 	255: "Base",
 }
 
-func (p *KillExperimentOApiRequest) IsSetWorkspaceID() bool {
+func (p *RetryExperimentOApiRequest) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *KillExperimentOApiRequest) IsSetExperimentID() bool {
+func (p *RetryExperimentOApiRequest) IsSetExperimentID() bool {
 	return p.ExperimentID != nil
 }
 
-func (p *KillExperimentOApiRequest) IsSetBase() bool {
+func (p *RetryExperimentOApiRequest) IsSetRetryMode() bool {
+	return p.RetryMode != nil
+}
+
+func (p *RetryExperimentOApiRequest) IsSetItemIds() bool {
+	return p.ItemIds != nil
+}
+
+func (p *RetryExperimentOApiRequest) IsSetExt() bool {
+	return p.Ext != nil
+}
+
+func (p *RetryExperimentOApiRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *KillExperimentOApiRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *RetryExperimentOApiRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 100:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField100(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RetryExperimentOApiRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RetryExperimentOApiRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *RetryExperimentOApiRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ExperimentID = _field
+	return nil
+}
+func (p *RetryExperimentOApiRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *experiment.ExptRetryMode
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RetryMode = _field
+	return nil
+}
+func (p *RetryExperimentOApiRequest) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ItemIds = _field
+	return nil
+}
+func (p *RetryExperimentOApiRequest) ReadField100(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Ext = _field
+	return nil
+}
+func (p *RetryExperimentOApiRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *RetryExperimentOApiRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RetryExperimentOApiRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField100(oprot); err != nil {
+			fieldId = 100
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RetryExperimentOApiRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RetryExperimentOApiRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExperimentID() {
+		if err = oprot.WriteFieldBegin("experiment_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ExperimentID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *RetryExperimentOApiRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRetryMode() {
+		if err = oprot.WriteFieldBegin("retry_mode", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RetryMode); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *RetryExperimentOApiRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItemIds() {
+		if err = oprot.WriteFieldBegin("item_ids", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I64, len(p.ItemIds)); err != nil {
+			return err
+		}
+		for _, v := range p.ItemIds {
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *RetryExperimentOApiRequest) writeField100(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExt() {
+		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 100); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Ext)); err != nil {
+			return err
+		}
+		for k, v := range p.Ext {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
+}
+func (p *RetryExperimentOApiRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *RetryExperimentOApiRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RetryExperimentOApiRequest(%+v)", *p)
+
+}
+
+func (p *RetryExperimentOApiRequest) DeepEqual(ano *RetryExperimentOApiRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ExperimentID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.RetryMode) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ItemIds) {
+		return false
+	}
+	if !p.Field100DeepEqual(ano.Ext) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *RetryExperimentOApiRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
+	}
+	if *p.WorkspaceID != *src {
+		return false
+	}
+	return true
+}
+func (p *RetryExperimentOApiRequest) Field2DeepEqual(src *int64) bool {
+
+	if p.ExperimentID == src {
+		return true
+	} else if p.ExperimentID == nil || src == nil {
+		return false
+	}
+	if *p.ExperimentID != *src {
+		return false
+	}
+	return true
+}
+func (p *RetryExperimentOApiRequest) Field3DeepEqual(src *experiment.ExptRetryMode) bool {
+
+	if p.RetryMode == src {
+		return true
+	} else if p.RetryMode == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.RetryMode, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RetryExperimentOApiRequest) Field4DeepEqual(src []int64) bool {
+
+	if len(p.ItemIds) != len(src) {
+		return false
+	}
+	for i, v := range p.ItemIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+func (p *RetryExperimentOApiRequest) Field100DeepEqual(src map[string]string) bool {
+
+	if len(p.Ext) != len(src) {
+		return false
+	}
+	for k, v := range p.Ext {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *RetryExperimentOApiRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type RetryExperimentOApiResponse struct {
+	Code     *int32                      `thrift:"code,1,optional" frugal:"1,optional,i32" form:"code" json:"code,omitempty" query:"code"`
+	Msg      *string                     `thrift:"msg,2,optional" frugal:"2,optional,string" form:"msg" json:"msg,omitempty" query:"msg"`
+	Data     *RetryExperimentOpenAPIData `thrift:"data,3,optional" frugal:"3,optional,RetryExperimentOpenAPIData" form:"data" json:"data,omitempty" query:"data"`
+	BaseResp *base.BaseResp              `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewRetryExperimentOApiResponse() *RetryExperimentOApiResponse {
+	return &RetryExperimentOApiResponse{}
+}
+
+func (p *RetryExperimentOApiResponse) InitDefault() {
+}
+
+var RetryExperimentOApiResponse_Code_DEFAULT int32
+
+func (p *RetryExperimentOApiResponse) GetCode() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCode() {
+		return RetryExperimentOApiResponse_Code_DEFAULT
+	}
+	return *p.Code
+}
+
+var RetryExperimentOApiResponse_Msg_DEFAULT string
+
+func (p *RetryExperimentOApiResponse) GetMsg() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMsg() {
+		return RetryExperimentOApiResponse_Msg_DEFAULT
+	}
+	return *p.Msg
+}
+
+var RetryExperimentOApiResponse_Data_DEFAULT *RetryExperimentOpenAPIData
+
+func (p *RetryExperimentOApiResponse) GetData() (v *RetryExperimentOpenAPIData) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetData() {
+		return RetryExperimentOApiResponse_Data_DEFAULT
+	}
+	return p.Data
+}
+
+var RetryExperimentOApiResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *RetryExperimentOApiResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return RetryExperimentOApiResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *RetryExperimentOApiResponse) SetCode(val *int32) {
+	p.Code = val
+}
+func (p *RetryExperimentOApiResponse) SetMsg(val *string) {
+	p.Msg = val
+}
+func (p *RetryExperimentOApiResponse) SetData(val *RetryExperimentOpenAPIData) {
+	p.Data = val
+}
+func (p *RetryExperimentOApiResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_RetryExperimentOApiResponse = map[int16]string{
+	1:   "code",
+	2:   "msg",
+	3:   "data",
+	255: "BaseResp",
+}
+
+func (p *RetryExperimentOApiResponse) IsSetCode() bool {
+	return p.Code != nil
+}
+
+func (p *RetryExperimentOApiResponse) IsSetMsg() bool {
+	return p.Msg != nil
+}
+
+func (p *RetryExperimentOApiResponse) IsSetData() bool {
+	return p.Data != nil
+}
+
+func (p *RetryExperimentOApiResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RetryExperimentOApiResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RetryExperimentOApiResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RetryExperimentOApiResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *RetryExperimentOApiResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *RetryExperimentOApiResponse) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewRetryExperimentOpenAPIData()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Data = _field
+	return nil
+}
+func (p *RetryExperimentOApiResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *RetryExperimentOApiResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RetryExperimentOApiResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RetryExperimentOApiResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCode() {
+		if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Code); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RetryExperimentOApiResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMsg() {
+		if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Msg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *RetryExperimentOApiResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetData() {
+		if err = oprot.WriteFieldBegin("data", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Data.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *RetryExperimentOApiResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *RetryExperimentOApiResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RetryExperimentOApiResponse(%+v)", *p)
+
+}
+
+func (p *RetryExperimentOApiResponse) DeepEqual(ano *RetryExperimentOApiResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Code) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Msg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Data) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *RetryExperimentOApiResponse) Field1DeepEqual(src *int32) bool {
+
+	if p.Code == src {
+		return true
+	} else if p.Code == nil || src == nil {
+		return false
+	}
+	if *p.Code != *src {
+		return false
+	}
+	return true
+}
+func (p *RetryExperimentOApiResponse) Field2DeepEqual(src *string) bool {
+
+	if p.Msg == src {
+		return true
+	} else if p.Msg == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Msg, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RetryExperimentOApiResponse) Field3DeepEqual(src *RetryExperimentOpenAPIData) bool {
+
+	if !p.Data.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *RetryExperimentOApiResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type RetryExperimentOpenAPIData struct {
+	RunID *int64 `thrift:"run_id,3,optional" frugal:"3,optional,i64" form:"run_id" json:"run_id,omitempty" query:"run_id"`
+}
+
+func NewRetryExperimentOpenAPIData() *RetryExperimentOpenAPIData {
+	return &RetryExperimentOpenAPIData{}
+}
+
+func (p *RetryExperimentOpenAPIData) InitDefault() {
+}
+
+var RetryExperimentOpenAPIData_RunID_DEFAULT int64
+
+func (p *RetryExperimentOpenAPIData) GetRunID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRunID() {
+		return RetryExperimentOpenAPIData_RunID_DEFAULT
+	}
+	return *p.RunID
+}
+func (p *RetryExperimentOpenAPIData) SetRunID(val *int64) {
+	p.RunID = val
+}
+
+var fieldIDToName_RetryExperimentOpenAPIData = map[int16]string{
+	3: "run_id",
+}
+
+func (p *RetryExperimentOpenAPIData) IsSetRunID() bool {
+	return p.RunID != nil
+}
+
+func (p *RetryExperimentOpenAPIData) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RetryExperimentOpenAPIData[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RetryExperimentOpenAPIData) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RunID = _field
+	return nil
+}
+
+func (p *RetryExperimentOpenAPIData) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RetryExperimentOpenAPIData"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RetryExperimentOpenAPIData) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRunID() {
+		if err = oprot.WriteFieldBegin("run_id", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.RunID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *RetryExperimentOpenAPIData) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RetryExperimentOpenAPIData(%+v)", *p)
+
+}
+
+func (p *RetryExperimentOpenAPIData) DeepEqual(ano *RetryExperimentOpenAPIData) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.RunID) {
+		return false
+	}
+	return true
+}
+
+func (p *RetryExperimentOpenAPIData) Field3DeepEqual(src *int64) bool {
+
+	if p.RunID == src {
+		return true
+	} else if p.RunID == nil || src == nil {
+		return false
+	}
+	if *p.RunID != *src {
+		return false
+	}
+	return true
+}
+
+// KillExperimentOApiRequest 通过 OpenAPI 终止一个进行中的实验。
+// 仅对处于 Processing 状态的实验生效，成功后实验会被置为 Terminated。
+type KillExperimentOApiRequest struct {
+	WorkspaceID  *int64     `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" `
+	ExperimentID *int64     `thrift:"experiment_id,2,optional" frugal:"2,optional,i64" json:"experiment_id" path:"experiment_id" `
+	Base         *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewKillExperimentOApiRequest() *KillExperimentOApiRequest {
+	return &KillExperimentOApiRequest{}
+}
+
+func (p *KillExperimentOApiRequest) InitDefault() {
+}
+
+var KillExperimentOApiRequest_WorkspaceID_DEFAULT int64
+
+func (p *KillExperimentOApiRequest) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceID() {
+		return KillExperimentOApiRequest_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
+}
+
+var KillExperimentOApiRequest_ExperimentID_DEFAULT int64
+
+func (p *KillExperimentOApiRequest) GetExperimentID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExperimentID() {
+		return KillExperimentOApiRequest_ExperimentID_DEFAULT
+	}
+	return *p.ExperimentID
+}
+
+var KillExperimentOApiRequest_Base_DEFAULT *base.Base
+
+func (p *KillExperimentOApiRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return KillExperimentOApiRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *KillExperimentOApiRequest) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *KillExperimentOApiRequest) SetExperimentID(val *int64) {
+	p.ExperimentID = val
+}
+func (p *KillExperimentOApiRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_KillExperimentOApiRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "experiment_id",
+	255: "Base",
+}
+
+func (p *KillExperimentOApiRequest) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
+}
+
+func (p *KillExperimentOApiRequest) IsSetExperimentID() bool {
+	return p.ExperimentID != nil
+}
+
+func (p *KillExperimentOApiRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *KillExperimentOApiRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -61448,8 +62993,10 @@ type CreateExptTemplateOApiRequest struct {
 	EnableExtractTrajectory    *bool                            `thrift:"enable_extract_trajectory,22,optional" frugal:"22,optional,bool" json:"enable_extract_trajectory" form:"enable_extract_trajectory" `
 	// 通知配置
 	NotificationConf *experiment.ExptNotificationConf `thrift:"notification_conf,30,optional" frugal:"30,optional,experiment.ExptNotificationConf" form:"notification_conf" json:"notification_conf,omitempty"`
-	Extra            *extra.Extra                     `thrift:"extra,254,optional" frugal:"254,optional,extra.Extra" form:"extra" json:"extra,omitempty" query:"extra"`
-	Base             *base.Base                       `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+	// 质量门禁配置
+	QualityGateConf *experiment.ExptQualityGateConf `thrift:"quality_gate_conf,31,optional" frugal:"31,optional,experiment.ExptQualityGateConf" form:"quality_gate_conf" json:"quality_gate_conf,omitempty"`
+	Extra           *extra.Extra                    `thrift:"extra,254,optional" frugal:"254,optional,extra.Extra" form:"extra" json:"extra,omitempty" query:"extra"`
+	Base            *base.Base                      `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
 func NewCreateExptTemplateOApiRequest() *CreateExptTemplateOApiRequest {
@@ -61555,6 +63102,18 @@ func (p *CreateExptTemplateOApiRequest) GetNotificationConf() (v *experiment.Exp
 	return p.NotificationConf
 }
 
+var CreateExptTemplateOApiRequest_QualityGateConf_DEFAULT *experiment.ExptQualityGateConf
+
+func (p *CreateExptTemplateOApiRequest) GetQualityGateConf() (v *experiment.ExptQualityGateConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetQualityGateConf() {
+		return CreateExptTemplateOApiRequest_QualityGateConf_DEFAULT
+	}
+	return p.QualityGateConf
+}
+
 var CreateExptTemplateOApiRequest_Extra_DEFAULT *extra.Extra
 
 func (p *CreateExptTemplateOApiRequest) GetExtra() (v *extra.Extra) {
@@ -61602,6 +63161,9 @@ func (p *CreateExptTemplateOApiRequest) SetEnableExtractTrajectory(val *bool) {
 func (p *CreateExptTemplateOApiRequest) SetNotificationConf(val *experiment.ExptNotificationConf) {
 	p.NotificationConf = val
 }
+func (p *CreateExptTemplateOApiRequest) SetQualityGateConf(val *experiment.ExptQualityGateConf) {
+	p.QualityGateConf = val
+}
 func (p *CreateExptTemplateOApiRequest) SetExtra(val *extra.Extra) {
 	p.Extra = val
 }
@@ -61618,6 +63180,7 @@ var fieldIDToName_CreateExptTemplateOApiRequest = map[int16]string{
 	21:  "default_evaluators_concur_num",
 	22:  "enable_extract_trajectory",
 	30:  "notification_conf",
+	31:  "quality_gate_conf",
 	254: "extra",
 	255: "Base",
 }
@@ -61654,6 +63217,10 @@ func (p *CreateExptTemplateOApiRequest) IsSetNotificationConf() bool {
 	return p.NotificationConf != nil
 }
 
+func (p *CreateExptTemplateOApiRequest) IsSetQualityGateConf() bool {
+	return p.QualityGateConf != nil
+}
+
 func (p *CreateExptTemplateOApiRequest) IsSetExtra() bool {
 	return p.Extra != nil
 }
@@ -61744,6 +63311,14 @@ func (p *CreateExptTemplateOApiRequest) Read(iprot thrift.TProtocol) (err error)
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 31:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField31(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 254:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField254(iprot); err != nil {
@@ -61862,6 +63437,14 @@ func (p *CreateExptTemplateOApiRequest) ReadField30(iprot thrift.TProtocol) erro
 	p.NotificationConf = _field
 	return nil
 }
+func (p *CreateExptTemplateOApiRequest) ReadField31(iprot thrift.TProtocol) error {
+	_field := experiment.NewExptQualityGateConf()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.QualityGateConf = _field
+	return nil
+}
 func (p *CreateExptTemplateOApiRequest) ReadField254(iprot thrift.TProtocol) error {
 	_field := extra.NewExtra()
 	if err := _field.Read(iprot); err != nil {
@@ -61917,6 +63500,10 @@ func (p *CreateExptTemplateOApiRequest) Write(oprot thrift.TProtocol) (err error
 			fieldId = 30
 			goto WriteFieldError
 		}
+		if err = p.writeField31(oprot); err != nil {
+			fieldId = 31
+			goto WriteFieldError
+		}
 		if err = p.writeField254(oprot); err != nil {
 			fieldId = 254
 			goto WriteFieldError
@@ -62087,6 +63674,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 30 end error: ", p), err)
 }
+func (p *CreateExptTemplateOApiRequest) writeField31(oprot thrift.TProtocol) (err error) {
+	if p.IsSetQualityGateConf() {
+		if err = oprot.WriteFieldBegin("quality_gate_conf", thrift.STRUCT, 31); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.QualityGateConf.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 31 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 31 end error: ", p), err)
+}
 func (p *CreateExptTemplateOApiRequest) writeField254(oprot thrift.TProtocol) (err error) {
 	if p.IsSetExtra() {
 		if err = oprot.WriteFieldBegin("extra", thrift.STRUCT, 254); err != nil {
@@ -62162,6 +63767,9 @@ func (p *CreateExptTemplateOApiRequest) DeepEqual(ano *CreateExptTemplateOApiReq
 	if !p.Field30DeepEqual(ano.NotificationConf) {
 		return false
 	}
+	if !p.Field31DeepEqual(ano.QualityGateConf) {
+		return false
+	}
 	if !p.Field254DeepEqual(ano.Extra) {
 		return false
 	}
@@ -62242,6 +63850,13 @@ func (p *CreateExptTemplateOApiRequest) Field30DeepEqual(src *experiment.ExptNot
 	}
 	return true
 }
+func (p *CreateExptTemplateOApiRequest) Field31DeepEqual(src *experiment.ExptQualityGateConf) bool {
+
+	if !p.QualityGateConf.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *CreateExptTemplateOApiRequest) Field254DeepEqual(src *extra.Extra) bool {
 
 	if !p.Extra.DeepEqual(src) {
@@ -64883,8 +66498,10 @@ type UpdateExptTemplateOApiRequest struct {
 	EnableExtractTrajectory    *bool                            `thrift:"enable_extract_trajectory,22,optional" frugal:"22,optional,bool" json:"enable_extract_trajectory" form:"enable_extract_trajectory" `
 	// 通知配置
 	NotificationConf *experiment.ExptNotificationConf `thrift:"notification_conf,30,optional" frugal:"30,optional,experiment.ExptNotificationConf" form:"notification_conf" json:"notification_conf,omitempty"`
-	Extra            *extra.Extra                     `thrift:"extra,254,optional" frugal:"254,optional,extra.Extra" form:"extra" json:"extra,omitempty" query:"extra"`
-	Base             *base.Base                       `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+	// 质量门禁配置
+	QualityGateConf *experiment.ExptQualityGateConf `thrift:"quality_gate_conf,31,optional" frugal:"31,optional,experiment.ExptQualityGateConf" form:"quality_gate_conf" json:"quality_gate_conf,omitempty"`
+	Extra           *extra.Extra                    `thrift:"extra,254,optional" frugal:"254,optional,extra.Extra" form:"extra" json:"extra,omitempty" query:"extra"`
+	Base            *base.Base                      `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
 func NewUpdateExptTemplateOApiRequest() *UpdateExptTemplateOApiRequest {
@@ -65002,6 +66619,18 @@ func (p *UpdateExptTemplateOApiRequest) GetNotificationConf() (v *experiment.Exp
 	return p.NotificationConf
 }
 
+var UpdateExptTemplateOApiRequest_QualityGateConf_DEFAULT *experiment.ExptQualityGateConf
+
+func (p *UpdateExptTemplateOApiRequest) GetQualityGateConf() (v *experiment.ExptQualityGateConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetQualityGateConf() {
+		return UpdateExptTemplateOApiRequest_QualityGateConf_DEFAULT
+	}
+	return p.QualityGateConf
+}
+
 var UpdateExptTemplateOApiRequest_Extra_DEFAULT *extra.Extra
 
 func (p *UpdateExptTemplateOApiRequest) GetExtra() (v *extra.Extra) {
@@ -65052,6 +66681,9 @@ func (p *UpdateExptTemplateOApiRequest) SetEnableExtractTrajectory(val *bool) {
 func (p *UpdateExptTemplateOApiRequest) SetNotificationConf(val *experiment.ExptNotificationConf) {
 	p.NotificationConf = val
 }
+func (p *UpdateExptTemplateOApiRequest) SetQualityGateConf(val *experiment.ExptQualityGateConf) {
+	p.QualityGateConf = val
+}
 func (p *UpdateExptTemplateOApiRequest) SetExtra(val *extra.Extra) {
 	p.Extra = val
 }
@@ -65069,6 +66701,7 @@ var fieldIDToName_UpdateExptTemplateOApiRequest = map[int16]string{
 	21:  "default_evaluators_concur_num",
 	22:  "enable_extract_trajectory",
 	30:  "notification_conf",
+	31:  "quality_gate_conf",
 	254: "extra",
 	255: "Base",
 }
@@ -65109,6 +66742,10 @@ func (p *UpdateExptTemplateOApiRequest) IsSetNotificationConf() bool {
 	return p.NotificationConf != nil
 }
 
+func (p *UpdateExptTemplateOApiRequest) IsSetQualityGateConf() bool {
+	return p.QualityGateConf != nil
+}
+
 func (p *UpdateExptTemplateOApiRequest) IsSetExtra() bool {
 	return p.Extra != nil
 }
@@ -65207,6 +66844,14 @@ func (p *UpdateExptTemplateOApiRequest) Read(iprot thrift.TProtocol) (err error)
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 31:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField31(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 254:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField254(iprot); err != nil {
@@ -65336,6 +66981,14 @@ func (p *UpdateExptTemplateOApiRequest) ReadField30(iprot thrift.TProtocol) erro
 	p.NotificationConf = _field
 	return nil
 }
+func (p *UpdateExptTemplateOApiRequest) ReadField31(iprot thrift.TProtocol) error {
+	_field := experiment.NewExptQualityGateConf()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.QualityGateConf = _field
+	return nil
+}
 func (p *UpdateExptTemplateOApiRequest) ReadField254(iprot thrift.TProtocol) error {
 	_field := extra.NewExtra()
 	if err := _field.Read(iprot); err != nil {
@@ -65395,6 +67048,10 @@ func (p *UpdateExptTemplateOApiRequest) Write(oprot thrift.TProtocol) (err error
 			fieldId = 30
 			goto WriteFieldError
 		}
+		if err = p.writeField31(oprot); err != nil {
+			fieldId = 31
+			goto WriteFieldError
+		}
 		if err = p.writeField254(oprot); err != nil {
 			fieldId = 254
 			goto WriteFieldError
@@ -65583,6 +67240,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 30 end error: ", p), err)
 }
+func (p *UpdateExptTemplateOApiRequest) writeField31(oprot thrift.TProtocol) (err error) {
+	if p.IsSetQualityGateConf() {
+		if err = oprot.WriteFieldBegin("quality_gate_conf", thrift.STRUCT, 31); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.QualityGateConf.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 31 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 31 end error: ", p), err)
+}
 func (p *UpdateExptTemplateOApiRequest) writeField254(oprot thrift.TProtocol) (err error) {
 	if p.IsSetExtra() {
 		if err = oprot.WriteFieldBegin("extra", thrift.STRUCT, 254); err != nil {
@@ -65661,6 +67336,9 @@ func (p *UpdateExptTemplateOApiRequest) DeepEqual(ano *UpdateExptTemplateOApiReq
 	if !p.Field30DeepEqual(ano.NotificationConf) {
 		return false
 	}
+	if !p.Field31DeepEqual(ano.QualityGateConf) {
+		return false
+	}
 	if !p.Field254DeepEqual(ano.Extra) {
 		return false
 	}
@@ -65753,6 +67431,13 @@ func (p *UpdateExptTemplateOApiRequest) Field30DeepEqual(src *experiment.ExptNot
 	}
 	return true
 }
+func (p *UpdateExptTemplateOApiRequest) Field31DeepEqual(src *experiment.ExptQualityGateConf) bool {
+
+	if !p.QualityGateConf.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *UpdateExptTemplateOApiRequest) Field254DeepEqual(src *extra.Extra) bool {
 
 	if !p.Extra.DeepEqual(src) {
@@ -68538,6 +70223,8 @@ type SubmitExptFromTemplateOApiRequest struct {
 	Name        *string `thrift:"name,3,optional" frugal:"3,optional,string" form:"name" json:"name,omitempty"`
 	// 通知配置（可选覆盖模板配置）
 	NotificationConf *experiment.ExptNotificationConf `thrift:"notification_conf,10,optional" frugal:"10,optional,experiment.ExptNotificationConf" form:"notification_conf" json:"notification_conf,omitempty"`
+	// 质量门禁配置（可选覆盖模板配置）
+	QualityGateConf *experiment.ExptQualityGateConf `thrift:"quality_gate_conf,11,optional" frugal:"11,optional,experiment.ExptQualityGateConf" form:"quality_gate_conf" json:"quality_gate_conf,omitempty"`
 	// 创建实验时，判断不为空则替换模板上的信息
 	TargetRuntimeParam *common.RuntimeParam `thrift:"target_runtime_param,20,optional" frugal:"20,optional,common.RuntimeParam" form:"target_runtime_param" json:"target_runtime_param,omitempty"`
 	Extra              *extra.Extra         `thrift:"extra,254,optional" frugal:"254,optional,extra.Extra" form:"extra" json:"extra,omitempty" query:"extra"`
@@ -68599,6 +70286,18 @@ func (p *SubmitExptFromTemplateOApiRequest) GetNotificationConf() (v *experiment
 	return p.NotificationConf
 }
 
+var SubmitExptFromTemplateOApiRequest_QualityGateConf_DEFAULT *experiment.ExptQualityGateConf
+
+func (p *SubmitExptFromTemplateOApiRequest) GetQualityGateConf() (v *experiment.ExptQualityGateConf) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetQualityGateConf() {
+		return SubmitExptFromTemplateOApiRequest_QualityGateConf_DEFAULT
+	}
+	return p.QualityGateConf
+}
+
 var SubmitExptFromTemplateOApiRequest_TargetRuntimeParam_DEFAULT *common.RuntimeParam
 
 func (p *SubmitExptFromTemplateOApiRequest) GetTargetRuntimeParam() (v *common.RuntimeParam) {
@@ -68646,6 +70345,9 @@ func (p *SubmitExptFromTemplateOApiRequest) SetName(val *string) {
 func (p *SubmitExptFromTemplateOApiRequest) SetNotificationConf(val *experiment.ExptNotificationConf) {
 	p.NotificationConf = val
 }
+func (p *SubmitExptFromTemplateOApiRequest) SetQualityGateConf(val *experiment.ExptQualityGateConf) {
+	p.QualityGateConf = val
+}
 func (p *SubmitExptFromTemplateOApiRequest) SetTargetRuntimeParam(val *common.RuntimeParam) {
 	p.TargetRuntimeParam = val
 }
@@ -68661,6 +70363,7 @@ var fieldIDToName_SubmitExptFromTemplateOApiRequest = map[int16]string{
 	2:   "template_id",
 	3:   "name",
 	10:  "notification_conf",
+	11:  "quality_gate_conf",
 	20:  "target_runtime_param",
 	254: "extra",
 	255: "Base",
@@ -68682,6 +70385,10 @@ func (p *SubmitExptFromTemplateOApiRequest) IsSetNotificationConf() bool {
 	return p.NotificationConf != nil
 }
 
+func (p *SubmitExptFromTemplateOApiRequest) IsSetQualityGateConf() bool {
+	return p.QualityGateConf != nil
+}
+
 func (p *SubmitExptFromTemplateOApiRequest) IsSetTargetRuntimeParam() bool {
 	return p.TargetRuntimeParam != nil
 }
@@ -68744,6 +70451,14 @@ func (p *SubmitExptFromTemplateOApiRequest) Read(iprot thrift.TProtocol) (err er
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 11:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 20:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField20(iprot); err != nil {
@@ -68838,6 +70553,14 @@ func (p *SubmitExptFromTemplateOApiRequest) ReadField10(iprot thrift.TProtocol)
 	p.NotificationConf = _field
 	return nil
 }
+func (p *SubmitExptFromTemplateOApiRequest) ReadField11(iprot thrift.TProtocol) error {
+	_field := experiment.NewExptQualityGateConf()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.QualityGateConf = _field
+	return nil
+}
 func (p *SubmitExptFromTemplateOApiRequest) ReadField20(iprot thrift.TProtocol) error {
 	_field := common.NewRuntimeParam()
 	if err := _field.Read(iprot); err != nil {
@@ -68885,6 +70608,10 @@ func (p *SubmitExptFromTemplateOApiRequest) Write(oprot thrift.TProtocol) (err e
 			fieldId = 10
 			goto WriteFieldError
 		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
 		if err = p.writeField20(oprot); err != nil {
 			fieldId = 20
 			goto WriteFieldError
@@ -68987,6 +70714,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
+func (p *SubmitExptFromTemplateOApiRequest) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetQualityGateConf() {
+		if err = oprot.WriteFieldBegin("quality_gate_conf", thrift.STRUCT, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.QualityGateConf.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
 func (p *SubmitExptFromTemplateOApiRequest) writeField20(oprot thrift.TProtocol) (err error) {
 	if p.IsSetTargetRuntimeParam() {
 		if err = oprot.WriteFieldBegin("target_runtime_param", thrift.STRUCT, 20); err != nil {
@@ -69068,6 +70813,9 @@ func (p *SubmitExptFromTemplateOApiRequest) DeepEqual(ano *SubmitExptFromTemplat
 	if !p.Field10DeepEqual(ano.NotificationConf) {
 		return false
 	}
+	if !p.Field11DeepEqual(ano.QualityGateConf) {
+		return false
+	}
 	if !p.Field20DeepEqual(ano.TargetRuntimeParam) {
 		return false
 	}
@@ -69123,6 +70871,13 @@ func (p *SubmitExptFromTemplateOApiRequest) Field10DeepEqual(src *experiment.Exp
 	}
 	return true
 }
+func (p *SubmitExptFromTemplateOApiRequest) Field11DeepEqual(src *experiment.ExptQualityGateConf) bool {
+
+	if !p.QualityGateConf.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *SubmitExptFromTemplateOApiRequest) Field20DeepEqual(src *common.RuntimeParam) bool {
 
 	if !p.TargetRuntimeParam.DeepEqual(src) {
@@ -70495,6 +72250,8 @@ type EvaluationOpenAPIService interface {
 	ListExperimentResultOApi(ctx context.Context, req *ListExperimentResultOApiRequest) (r *ListExperimentResultOApiResponse, err error)
 	// 获取聚合结果
 	GetExperimentAggrResultOApi(ctx context.Context, req *GetExperimentAggrResultOApiRequest) (r *GetExperimentAggrResultOApiResponse, err error)
+	// 实验显著性对比
+	CompareExperimentsOApi(ctx context.Context, req *CompareExperimentsOApiRequest) (r *CompareExperimentsOApiResponse, err error)
 	// 重试实验
 	RetryExperimentOApi(ctx context.Context, req *RetryExperimentOApiRequest) (r *RetryExperimentOApiResponse, err error)
 	// 终止实验
@@ -70857,6 +72614,15 @@ func (p *EvaluationOpenAPIServiceClient) GetExperimentAggrResultOApi(ctx context
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *EvaluationOpenAPIServiceClient) CompareExperimentsOApi(ctx context.Context, req *CompareExperimentsOApiRequest) (r *CompareExperimentsOApiResponse, err error) {
+	var _args EvaluationOpenAPIServiceCompareExperimentsOApiArgs
+	_args.Req = req
+	var _result EvaluationOpenAPIServiceCompareExperimentsOApiResult
+	if err = p.Client_().Call(ctx, "CompareExperimentsOApi", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *EvaluationOpenAPIServiceClient) RetryExperimentOApi(ctx context.Context, req *RetryExperimentOApiRequest) (r *RetryExperimentOApiResponse, err error) {
 	var _args EvaluationOpenAPIServiceRetryExperimentOApiArgs
 	_args.Req = req
@@ -71143,6 +72909,7 @@ func NewEvaluationOpenAPIServiceProcessor(handler EvaluationOpenAPIService) *Eva
 	self.AddToProcessorMap("GetExperimentIDsByGroupOApi", &evaluationOpenAPIServiceProcessorGetExperimentIDsByGroupOApi{handler: handler})
 	self.AddToProcessorMap("ListExperimentResultOApi", &evaluationOpenAPIServiceProcessorListExperimentResultOApi{handler: handler})
 	self.AddToProcessorMap("GetExperimentAggrResultOApi", &evaluationOpenAPIServiceProcessorGetExperimentAggrResultOApi{handler: handler})
+	self.AddToProcessorMap("CompareExperimentsOApi", &evaluationOpenAPIServiceProcessorCompareExperimentsOApi{handler: handler})
 	self.AddToProcessorMap("RetryExperimentOApi", &evaluationOpenAPIServiceProcessorRetryExperimentOApi{handler: handler})
 	self.AddToProcessorMap("KillExperimentOApi", &evaluationOpenAPIServiceProcessorKillExperimentOApi{handler: handler})
 	self.AddToProcessorMap("ExportExperimentResultOApi", &evaluationOpenAPIServiceProcessorExportExperimentResultOApi{handler: handler})
@@ -72677,6 +74444,54 @@ func (p *evaluationOpenAPIServiceProcessorGetExperimentAggrResultOApi) Process(c
 	return true, err
 }
 
+type evaluationOpenAPIServiceProcessorCompareExperimentsOApi struct {
+	handler EvaluationOpenAPIService
+}
+
+func (p *evaluationOpenAPIServiceProcessorCompareExperimentsOApi) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := EvaluationOpenAPIServiceCompareExperimentsOApiArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CompareExperimentsOApi", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := EvaluationOpenAPIServiceCompareExperimentsOApiResult{}
+	var retval *CompareExperimentsOApiResponse
+	if retval, err2 = p.handler.CompareExperimentsOApi(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CompareExperimentsOApi: "+err2.Error())
+		oprot.WriteMessageBegin("CompareExperimentsOApi", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CompareExperimentsOApi", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
 type evaluationOpenAPIServiceProcessorRetryExperimentOApi struct {
 	handler EvaluationOpenAPIService
 }
@@ -74051,7 +75866,351 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluationOpenAPIServiceCreateEvaluationSetOApiArgs(%+v)", *p)
+
+}
+
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceCreateEvaluationSetOApiArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiArgs) Field1DeepEqual(src *CreateEvaluationSetOApiRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type EvaluationOpenAPIServiceCreateEvaluationSetOApiResult struct {
+	Success *CreateEvaluationSetOApiResponse `thrift:"success,0,optional" frugal:"0,optional,CreateEvaluationSetOApiResponse"`
+}
+
+func NewEvaluationOpenAPIServiceCreateEvaluationSetOApiResult() *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult {
+	return &EvaluationOpenAPIServiceCreateEvaluationSetOApiResult{}
+}
+
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) InitDefault() {
+}
+
+var EvaluationOpenAPIServiceCreateEvaluationSetOApiResult_Success_DEFAULT *CreateEvaluationSetOApiResponse
+
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) GetSuccess() (v *CreateEvaluationSetOApiResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return EvaluationOpenAPIServiceCreateEvaluationSetOApiResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateEvaluationSetOApiResponse)
+}
+
+var fieldIDToName_EvaluationOpenAPIServiceCreateEvaluationSetOApiResult = map[int16]string{
+	0: "success",
+}
+
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceCreateEvaluationSetOApiResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateEvaluationSetOApiResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateEvaluationSetOApi_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluationOpenAPIServiceCreateEvaluationSetOApiResult(%+v)", *p)
+
+}
+
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) Field0DeepEqual(src *CreateEvaluationSetOApiResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs struct {
+	Req *ListEvaluationSetTemplatesOApiRequest `thrift:"req,1" frugal:"1,default,ListEvaluationSetTemplatesOApiRequest"`
+}
+
+func NewEvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs() *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs {
+	return &EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs{}
+}
+
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) InitDefault() {
+}
+
+var EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs_Req_DEFAULT *ListEvaluationSetTemplatesOApiRequest
+
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) GetReq() (v *ListEvaluationSetTemplatesOApiRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) SetReq(val *ListEvaluationSetTemplatesOApiRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListEvaluationSetTemplatesOApiRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListEvaluationSetTemplatesOApi_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -74068,15 +76227,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceCreateEvaluationSetOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceCreateEvaluationSetOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -74088,7 +76247,7 @@ func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiArgs) DeepEqual(ano *Eva
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiArgs) Field1DeepEqual(src *CreateEvaluationSetOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) Field1DeepEqual(src *ListEvaluationSetTemplatesOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -74096,41 +76255,41 @@ func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiArgs) Field1DeepEqual(sr
 	return true
 }
 
-type EvaluationOpenAPIServiceCreateEvaluationSetOApiResult struct {
-	Success *CreateEvaluationSetOApiResponse `thrift:"success,0,optional" frugal:"0,optional,CreateEvaluationSetOApiResponse"`
+type EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult struct {
+	Success *ListEvaluationSetTemplatesOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ListEvaluationSetTemplatesOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceCreateEvaluationSetOApiResult() *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult {
-	return &EvaluationOpenAPIServiceCreateEvaluationSetOApiResult{}
+func NewEvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult() *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult {
+	return &EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceCreateEvaluationSetOApiResult_Success_DEFAULT *CreateEvaluationSetOApiResponse
+var EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult_Success_DEFAULT *ListEvaluationSetTemplatesOApiResponse
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) GetSuccess() (v *CreateEvaluationSetOApiResponse) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) GetSuccess() (v *ListEvaluationSetTemplatesOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceCreateEvaluationSetOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CreateEvaluationSetOApiResponse)
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListEvaluationSetTemplatesOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceCreateEvaluationSetOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -74175,7 +76334,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceCreateEvaluationSetOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -74185,8 +76344,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCreateEvaluationSetOApiResponse()
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListEvaluationSetTemplatesOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -74194,9 +76353,9 @@ func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) ReadField0(iprot
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateEvaluationSetOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListEvaluationSetTemplatesOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -74222,7 +76381,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -74241,15 +76400,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceCreateEvaluationSetOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -74261,7 +76420,7 @@ func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) DeepEqual(ano *E
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) Field0DeepEqual(src *CreateEvaluationSetOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) Field0DeepEqual(src *ListEvaluationSetTemplatesOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -74269,41 +76428,41 @@ func (p *EvaluationOpenAPIServiceCreateEvaluationSetOApiResult) Field0DeepEqual(
 	return true
 }
 
-type EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs struct {
-	Req *ListEvaluationSetTemplatesOApiRequest `thrift:"req,1" frugal:"1,default,ListEvaluationSetTemplatesOApiRequest"`
+type EvaluationOpenAPIServiceGetEvaluationSetOApiArgs struct {
+	Req *GetEvaluationSetOApiRequest `thrift:"req,1" frugal:"1,default,GetEvaluationSetOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs() *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs {
-	return &EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs{}
+func NewEvaluationOpenAPIServiceGetEvaluationSetOApiArgs() *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs {
+	return &EvaluationOpenAPIServiceGetEvaluationSetOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs_Req_DEFAULT *ListEvaluationSetTemplatesOApiRequest
+var EvaluationOpenAPIServiceGetEvaluationSetOApiArgs_Req_DEFAULT *GetEvaluationSetOApiRequest
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) GetReq() (v *ListEvaluationSetTemplatesOApiRequest) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) GetReq() (v *GetEvaluationSetOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceGetEvaluationSetOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) SetReq(val *ListEvaluationSetTemplatesOApiRequest) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) SetReq(val *GetEvaluationSetOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -74348,7 +76507,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -74358,8 +76517,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListEvaluationSetTemplatesOApiRequest()
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetEvaluationSetOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -74367,9 +76526,9 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) ReadField1(
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListEvaluationSetTemplatesOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvaluationSetOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -74395,7 +76554,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -74412,15 +76571,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvaluationSetOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -74432,7 +76591,7 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) DeepEqual(a
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) Field1DeepEqual(src *ListEvaluationSetTemplatesOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) Field1DeepEqual(src *GetEvaluationSetOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -74440,41 +76599,41 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiArgs) Field1DeepE
 	return true
 }
 
-type EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult struct {
-	Success *ListEvaluationSetTemplatesOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ListEvaluationSetTemplatesOApiResponse"`
+type EvaluationOpenAPIServiceGetEvaluationSetOApiResult struct {
+	Success *GetEvaluationSetOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvaluationSetOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult() *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult {
-	return &EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult{}
+func NewEvaluationOpenAPIServiceGetEvaluationSetOApiResult() *EvaluationOpenAPIServiceGetEvaluationSetOApiResult {
+	return &EvaluationOpenAPIServiceGetEvaluationSetOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult_Success_DEFAULT *ListEvaluationSetTemplatesOApiResponse
+var EvaluationOpenAPIServiceGetEvaluationSetOApiResult_Success_DEFAULT *GetEvaluationSetOApiResponse
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) GetSuccess() (v *ListEvaluationSetTemplatesOApiResponse) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) GetSuccess() (v *GetEvaluationSetOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceGetEvaluationSetOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListEvaluationSetTemplatesOApiResponse)
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetEvaluationSetOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -74519,7 +76678,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -74529,8 +76688,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListEvaluationSetTemplatesOApiResponse()
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetEvaluationSetOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -74538,9 +76697,9 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) ReadField
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListEvaluationSetTemplatesOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvaluationSetOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -74566,7 +76725,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -74585,15 +76744,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvaluationSetOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -74605,7 +76764,7 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) DeepEqual
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) Field0DeepEqual(src *ListEvaluationSetTemplatesOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) Field0DeepEqual(src *GetEvaluationSetOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -74613,41 +76772,41 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetTemplatesOApiResult) Field0Dee
 	return true
 }
 
-type EvaluationOpenAPIServiceGetEvaluationSetOApiArgs struct {
-	Req *GetEvaluationSetOApiRequest `thrift:"req,1" frugal:"1,default,GetEvaluationSetOApiRequest"`
+type EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs struct {
+	Req *UpdateEvaluationSetOApiRequest `thrift:"req,1" frugal:"1,default,UpdateEvaluationSetOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceGetEvaluationSetOApiArgs() *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs {
-	return &EvaluationOpenAPIServiceGetEvaluationSetOApiArgs{}
+func NewEvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs() *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs {
+	return &EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetEvaluationSetOApiArgs_Req_DEFAULT *GetEvaluationSetOApiRequest
+var EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs_Req_DEFAULT *UpdateEvaluationSetOApiRequest
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) GetReq() (v *GetEvaluationSetOApiRequest) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) GetReq() (v *UpdateEvaluationSetOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceGetEvaluationSetOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) SetReq(val *GetEvaluationSetOApiRequest) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) SetReq(val *UpdateEvaluationSetOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -74692,7 +76851,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -74702,8 +76861,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetEvaluationSetOApiRequest()
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateEvaluationSetOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -74711,9 +76870,9 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) ReadField1(iprot thri
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvaluationSetOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateEvaluationSetOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -74739,7 +76898,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -74756,15 +76915,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvaluationSetOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -74776,7 +76935,7 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) DeepEqual(ano *Evalua
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) Field1DeepEqual(src *GetEvaluationSetOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) Field1DeepEqual(src *UpdateEvaluationSetOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -74784,41 +76943,41 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiArgs) Field1DeepEqual(src *
 	return true
 }
 
-type EvaluationOpenAPIServiceGetEvaluationSetOApiResult struct {
-	Success *GetEvaluationSetOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvaluationSetOApiResponse"`
+type EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult struct {
+	Success *UpdateEvaluationSetOApiResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateEvaluationSetOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceGetEvaluationSetOApiResult() *EvaluationOpenAPIServiceGetEvaluationSetOApiResult {
-	return &EvaluationOpenAPIServiceGetEvaluationSetOApiResult{}
+func NewEvaluationOpenAPIServiceUpdateEvaluationSetOApiResult() *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult {
+	return &EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetEvaluationSetOApiResult_Success_DEFAULT *GetEvaluationSetOApiResponse
+var EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult_Success_DEFAULT *UpdateEvaluationSetOApiResponse
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) GetSuccess() (v *GetEvaluationSetOApiResponse) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) GetSuccess() (v *UpdateEvaluationSetOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceGetEvaluationSetOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetEvaluationSetOApiResponse)
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateEvaluationSetOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -74863,7 +77022,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -74873,8 +77032,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetEvaluationSetOApiResponse()
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateEvaluationSetOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -74882,9 +77041,9 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) ReadField0(iprot th
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvaluationSetOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateEvaluationSetOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -74910,7 +77069,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -74929,15 +77088,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvaluationSetOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) bool {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -74949,7 +77108,7 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) DeepEqual(ano *Eval
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) Field0DeepEqual(src *GetEvaluationSetOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) Field0DeepEqual(src *UpdateEvaluationSetOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -74957,41 +77116,41 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetOApiResult) Field0DeepEqual(src
 	return true
 }
 
-type EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs struct {
-	Req *UpdateEvaluationSetOApiRequest `thrift:"req,1" frugal:"1,default,UpdateEvaluationSetOApiRequest"`
+type EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs struct {
+	Req *DeleteEvaluationSetOApiRequest `thrift:"req,1" frugal:"1,default,DeleteEvaluationSetOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs() *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs {
-	return &EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs{}
+func NewEvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs() *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs {
+	return &EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs_Req_DEFAULT *UpdateEvaluationSetOApiRequest
+var EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs_Req_DEFAULT *DeleteEvaluationSetOApiRequest
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) GetReq() (v *UpdateEvaluationSetOApiRequest) {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) GetReq() (v *DeleteEvaluationSetOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) SetReq(val *UpdateEvaluationSetOApiRequest) {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) SetReq(val *DeleteEvaluationSetOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -75036,7 +77195,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -75046,8 +77205,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateEvaluationSetOApiRequest()
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteEvaluationSetOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -75055,9 +77214,9 @@ func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) ReadField1(iprot t
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateEvaluationSetOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteEvaluationSetOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -75083,7 +77242,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -75100,15 +77259,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -75120,7 +77279,7 @@ func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) DeepEqual(ano *Eva
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) Field1DeepEqual(src *UpdateEvaluationSetOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) Field1DeepEqual(src *DeleteEvaluationSetOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -75128,41 +77287,41 @@ func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiArgs) Field1DeepEqual(sr
 	return true
 }
 
-type EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult struct {
-	Success *UpdateEvaluationSetOApiResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateEvaluationSetOApiResponse"`
+type EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult struct {
+	Success *DeleteEvaluationSetOApiResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteEvaluationSetOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceUpdateEvaluationSetOApiResult() *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult {
-	return &EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult{}
+func NewEvaluationOpenAPIServiceDeleteEvaluationSetOApiResult() *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult {
+	return &EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult_Success_DEFAULT *UpdateEvaluationSetOApiResponse
+var EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult_Success_DEFAULT *DeleteEvaluationSetOApiResponse
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) GetSuccess() (v *UpdateEvaluationSetOApiResponse) {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) GetSuccess() (v *DeleteEvaluationSetOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UpdateEvaluationSetOApiResponse)
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteEvaluationSetOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -75207,7 +77366,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -75217,8 +77376,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateEvaluationSetOApiResponse()
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteEvaluationSetOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -75226,9 +77385,9 @@ func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) ReadField0(iprot
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateEvaluationSetOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteEvaluationSetOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -75254,7 +77413,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -75273,15 +77432,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) bool {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -75293,7 +77452,7 @@ func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) DeepEqual(ano *E
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) Field0DeepEqual(src *UpdateEvaluationSetOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) Field0DeepEqual(src *DeleteEvaluationSetOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -75301,41 +77460,41 @@ func (p *EvaluationOpenAPIServiceUpdateEvaluationSetOApiResult) Field0DeepEqual(
 	return true
 }
 
-type EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs struct {
-	Req *DeleteEvaluationSetOApiRequest `thrift:"req,1" frugal:"1,default,DeleteEvaluationSetOApiRequest"`
+type EvaluationOpenAPIServiceListEvaluationSetsOApiArgs struct {
+	Req *ListEvaluationSetsOApiRequest `thrift:"req,1" frugal:"1,default,ListEvaluationSetsOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs() *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs {
-	return &EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs{}
+func NewEvaluationOpenAPIServiceListEvaluationSetsOApiArgs() *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs {
+	return &EvaluationOpenAPIServiceListEvaluationSetsOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs_Req_DEFAULT *DeleteEvaluationSetOApiRequest
+var EvaluationOpenAPIServiceListEvaluationSetsOApiArgs_Req_DEFAULT *ListEvaluationSetsOApiRequest
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) GetReq() (v *DeleteEvaluationSetOApiRequest) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) GetReq() (v *ListEvaluationSetsOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceListEvaluationSetsOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) SetReq(val *DeleteEvaluationSetOApiRequest) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) SetReq(val *ListEvaluationSetsOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetsOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -75380,7 +77539,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetsOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -75390,8 +77549,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteEvaluationSetOApiRequest()
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListEvaluationSetsOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -75399,9 +77558,9 @@ func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) ReadField1(iprot t
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteEvaluationSetOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListEvaluationSetsOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -75427,7 +77586,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -75444,15 +77603,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetsOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -75464,7 +77623,7 @@ func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) DeepEqual(ano *Eva
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) Field1DeepEqual(src *DeleteEvaluationSetOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) Field1DeepEqual(src *ListEvaluationSetsOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -75472,41 +77631,41 @@ func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiArgs) Field1DeepEqual(sr
 	return true
 }
 
-type EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult struct {
-	Success *DeleteEvaluationSetOApiResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteEvaluationSetOApiResponse"`
+type EvaluationOpenAPIServiceListEvaluationSetsOApiResult struct {
+	Success *ListEvaluationSetsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ListEvaluationSetsOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceDeleteEvaluationSetOApiResult() *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult {
-	return &EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult{}
+func NewEvaluationOpenAPIServiceListEvaluationSetsOApiResult() *EvaluationOpenAPIServiceListEvaluationSetsOApiResult {
+	return &EvaluationOpenAPIServiceListEvaluationSetsOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult_Success_DEFAULT *DeleteEvaluationSetOApiResponse
+var EvaluationOpenAPIServiceListEvaluationSetsOApiResult_Success_DEFAULT *ListEvaluationSetsOApiResponse
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) GetSuccess() (v *DeleteEvaluationSetOApiResponse) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) GetSuccess() (v *ListEvaluationSetsOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceListEvaluationSetsOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*DeleteEvaluationSetOApiResponse)
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListEvaluationSetsOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetsOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -75551,7 +77710,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetsOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -75561,8 +77720,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteEvaluationSetOApiResponse()
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListEvaluationSetsOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -75570,9 +77729,9 @@ func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) ReadField0(iprot
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteEvaluationSetOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListEvaluationSetsOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -75598,7 +77757,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -75617,15 +77776,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetsOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -75637,7 +77796,7 @@ func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) DeepEqual(ano *E
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) Field0DeepEqual(src *DeleteEvaluationSetOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) Field0DeepEqual(src *ListEvaluationSetsOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -75645,41 +77804,41 @@ func (p *EvaluationOpenAPIServiceDeleteEvaluationSetOApiResult) Field0DeepEqual(
 	return true
 }
 
-type EvaluationOpenAPIServiceListEvaluationSetsOApiArgs struct {
-	Req *ListEvaluationSetsOApiRequest `thrift:"req,1" frugal:"1,default,ListEvaluationSetsOApiRequest"`
+type EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs struct {
+	Req *CreateEvaluationSetVersionOApiRequest `thrift:"req,1" frugal:"1,default,CreateEvaluationSetVersionOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceListEvaluationSetsOApiArgs() *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs {
-	return &EvaluationOpenAPIServiceListEvaluationSetsOApiArgs{}
+func NewEvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs() *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs {
+	return &EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceListEvaluationSetsOApiArgs_Req_DEFAULT *ListEvaluationSetsOApiRequest
+var EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs_Req_DEFAULT *CreateEvaluationSetVersionOApiRequest
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) GetReq() (v *ListEvaluationSetsOApiRequest) {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) GetReq() (v *CreateEvaluationSetVersionOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceListEvaluationSetsOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) SetReq(val *ListEvaluationSetsOApiRequest) {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) SetReq(val *CreateEvaluationSetVersionOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetsOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -75724,7 +77883,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetsOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -75734,8 +77893,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListEvaluationSetsOApiRequest()
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateEvaluationSetVersionOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -75743,9 +77902,9 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) ReadField1(iprot th
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListEvaluationSetsOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("CreateEvaluationSetVersionOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -75771,7 +77930,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -75788,15 +77947,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetsOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -75808,7 +77967,7 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) DeepEqual(ano *Eval
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) Field1DeepEqual(src *ListEvaluationSetsOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) Field1DeepEqual(src *CreateEvaluationSetVersionOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -75816,41 +77975,41 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiArgs) Field1DeepEqual(src
 	return true
 }
 
-type EvaluationOpenAPIServiceListEvaluationSetsOApiResult struct {
-	Success *ListEvaluationSetsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ListEvaluationSetsOApiResponse"`
+type EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult struct {
+	Success *CreateEvaluationSetVersionOApiResponse `thrift:"success,0,optional" frugal:"0,optional,CreateEvaluationSetVersionOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceListEvaluationSetsOApiResult() *EvaluationOpenAPIServiceListEvaluationSetsOApiResult {
-	return &EvaluationOpenAPIServiceListEvaluationSetsOApiResult{}
+func NewEvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult() *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult {
+	return &EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceListEvaluationSetsOApiResult_Success_DEFAULT *ListEvaluationSetsOApiResponse
+var EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult_Success_DEFAULT *CreateEvaluationSetVersionOApiResponse
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) GetSuccess() (v *ListEvaluationSetsOApiResponse) {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) GetSuccess() (v *CreateEvaluationSetVersionOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceListEvaluationSetsOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListEvaluationSetsOApiResponse)
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateEvaluationSetVersionOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetsOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -75895,7 +78054,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetsOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -75905,8 +78064,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListEvaluationSetsOApiResponse()
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateEvaluationSetVersionOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -75914,9 +78073,9 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) ReadField0(iprot
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListEvaluationSetsOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("CreateEvaluationSetVersionOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -75942,7 +78101,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -75961,15 +78120,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetsOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) bool {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -75981,7 +78140,7 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) DeepEqual(ano *Ev
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) Field0DeepEqual(src *ListEvaluationSetsOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) Field0DeepEqual(src *CreateEvaluationSetVersionOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -75989,41 +78148,41 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetsOApiResult) Field0DeepEqual(s
 	return true
 }
 
-type EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs struct {
-	Req *CreateEvaluationSetVersionOApiRequest `thrift:"req,1" frugal:"1,default,CreateEvaluationSetVersionOApiRequest"`
+type EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs struct {
+	Req *ListEvaluationSetVersionsOApiRequest `thrift:"req,1" frugal:"1,default,ListEvaluationSetVersionsOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs() *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs {
-	return &EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs{}
+func NewEvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs() *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs {
+	return &EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs_Req_DEFAULT *CreateEvaluationSetVersionOApiRequest
+var EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs_Req_DEFAULT *ListEvaluationSetVersionsOApiRequest
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) GetReq() (v *CreateEvaluationSetVersionOApiRequest) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) GetReq() (v *ListEvaluationSetVersionsOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) SetReq(val *CreateEvaluationSetVersionOApiRequest) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) SetReq(val *ListEvaluationSetVersionsOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -76068,7 +78227,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -76078,8 +78237,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCreateEvaluationSetVersionOApiRequest()
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListEvaluationSetVersionsOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -76087,9 +78246,9 @@ func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) ReadField1(
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateEvaluationSetVersionOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListEvaluationSetVersionsOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -76115,7 +78274,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -76132,15 +78291,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -76152,7 +78311,7 @@ func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) DeepEqual(a
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) Field1DeepEqual(src *CreateEvaluationSetVersionOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) Field1DeepEqual(src *ListEvaluationSetVersionsOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -76160,41 +78319,41 @@ func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiArgs) Field1DeepE
 	return true
 }
 
-type EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult struct {
-	Success *CreateEvaluationSetVersionOApiResponse `thrift:"success,0,optional" frugal:"0,optional,CreateEvaluationSetVersionOApiResponse"`
+type EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult struct {
+	Success *ListEvaluationSetVersionsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ListEvaluationSetVersionsOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult() *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult {
-	return &EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult{}
+func NewEvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult() *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult {
+	return &EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult_Success_DEFAULT *CreateEvaluationSetVersionOApiResponse
+var EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult_Success_DEFAULT *ListEvaluationSetVersionsOApiResponse
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) GetSuccess() (v *CreateEvaluationSetVersionOApiResponse) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) GetSuccess() (v *ListEvaluationSetVersionsOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CreateEvaluationSetVersionOApiResponse)
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListEvaluationSetVersionsOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -76239,7 +78398,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -76249,8 +78408,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCreateEvaluationSetVersionOApiResponse()
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListEvaluationSetVersionsOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -76258,9 +78417,9 @@ func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) ReadField
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateEvaluationSetVersionOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListEvaluationSetVersionsOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -76286,7 +78445,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -76305,15 +78464,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -76325,7 +78484,7 @@ func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) DeepEqual
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) Field0DeepEqual(src *CreateEvaluationSetVersionOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) Field0DeepEqual(src *ListEvaluationSetVersionsOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -76333,41 +78492,41 @@ func (p *EvaluationOpenAPIServiceCreateEvaluationSetVersionOApiResult) Field0Dee
 	return true
 }
 
-type EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs struct {
-	Req *ListEvaluationSetVersionsOApiRequest `thrift:"req,1" frugal:"1,default,ListEvaluationSetVersionsOApiRequest"`
+type EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs struct {
+	Req *BatchCreateEvaluationSetItemsOApiRequest `thrift:"req,1" frugal:"1,default,BatchCreateEvaluationSetItemsOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs() *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs {
-	return &EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs{}
+func NewEvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs() *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs {
+	return &EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs_Req_DEFAULT *ListEvaluationSetVersionsOApiRequest
+var EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs_Req_DEFAULT *BatchCreateEvaluationSetItemsOApiRequest
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) GetReq() (v *ListEvaluationSetVersionsOApiRequest) {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) GetReq() (v *BatchCreateEvaluationSetItemsOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) SetReq(val *ListEvaluationSetVersionsOApiRequest) {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) SetReq(val *BatchCreateEvaluationSetItemsOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -76412,7 +78571,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -76422,8 +78581,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListEvaluationSetVersionsOApiRequest()
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchCreateEvaluationSetItemsOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -76431,9 +78590,9 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) ReadField1(i
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListEvaluationSetVersionsOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchCreateEvaluationSetItemsOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -76459,7 +78618,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -76476,15 +78635,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -76496,7 +78655,7 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) DeepEqual(an
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) Field1DeepEqual(src *ListEvaluationSetVersionsOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) Field1DeepEqual(src *BatchCreateEvaluationSetItemsOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -76504,41 +78663,41 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiArgs) Field1DeepEq
 	return true
 }
 
-type EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult struct {
-	Success *ListEvaluationSetVersionsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ListEvaluationSetVersionsOApiResponse"`
+type EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult struct {
+	Success *BatchCreateEvaluationSetItemsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,BatchCreateEvaluationSetItemsOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult() *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult {
-	return &EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult{}
+func NewEvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult() *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult {
+	return &EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult_Success_DEFAULT *ListEvaluationSetVersionsOApiResponse
+var EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult_Success_DEFAULT *BatchCreateEvaluationSetItemsOApiResponse
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) GetSuccess() (v *ListEvaluationSetVersionsOApiResponse) {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) GetSuccess() (v *BatchCreateEvaluationSetItemsOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListEvaluationSetVersionsOApiResponse)
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchCreateEvaluationSetItemsOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -76583,7 +78742,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -76593,8 +78752,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListEvaluationSetVersionsOApiResponse()
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchCreateEvaluationSetItemsOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -76602,9 +78761,9 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) ReadField0
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListEvaluationSetVersionsOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchCreateEvaluationSetItemsOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -76630,7 +78789,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -76649,15 +78808,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) bool {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -76669,7 +78828,7 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) DeepEqual(
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) Field0DeepEqual(src *ListEvaluationSetVersionsOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) Field0DeepEqual(src *BatchCreateEvaluationSetItemsOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -76677,41 +78836,41 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetVersionsOApiResult) Field0Deep
 	return true
 }
 
-type EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs struct {
-	Req *BatchCreateEvaluationSetItemsOApiRequest `thrift:"req,1" frugal:"1,default,BatchCreateEvaluationSetItemsOApiRequest"`
+type EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs struct {
+	Req *BatchUpdateEvaluationSetItemsOApiRequest `thrift:"req,1" frugal:"1,default,BatchUpdateEvaluationSetItemsOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs() *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs {
-	return &EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs{}
+func NewEvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs() *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs {
+	return &EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs_Req_DEFAULT *BatchCreateEvaluationSetItemsOApiRequest
+var EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs_Req_DEFAULT *BatchUpdateEvaluationSetItemsOApiRequest
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) GetReq() (v *BatchCreateEvaluationSetItemsOApiRequest) {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) GetReq() (v *BatchUpdateEvaluationSetItemsOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) SetReq(val *BatchCreateEvaluationSetItemsOApiRequest) {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) SetReq(val *BatchUpdateEvaluationSetItemsOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -76756,7 +78915,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -76766,8 +78925,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewBatchCreateEvaluationSetItemsOApiRequest()
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchUpdateEvaluationSetItemsOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -76775,9 +78934,9 @@ func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) ReadFiel
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchCreateEvaluationSetItemsOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchUpdateEvaluationSetItemsOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -76803,7 +78962,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -76820,15 +78979,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -76840,7 +78999,7 @@ func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) DeepEqua
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) Field1DeepEqual(src *BatchCreateEvaluationSetItemsOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) Field1DeepEqual(src *BatchUpdateEvaluationSetItemsOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -76848,41 +79007,41 @@ func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiArgs) Field1De
 	return true
 }
 
-type EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult struct {
-	Success *BatchCreateEvaluationSetItemsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,BatchCreateEvaluationSetItemsOApiResponse"`
+type EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult struct {
+	Success *BatchUpdateEvaluationSetItemsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,BatchUpdateEvaluationSetItemsOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult() *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult {
-	return &EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult{}
+func NewEvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult() *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult {
+	return &EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult_Success_DEFAULT *BatchCreateEvaluationSetItemsOApiResponse
+var EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult_Success_DEFAULT *BatchUpdateEvaluationSetItemsOApiResponse
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) GetSuccess() (v *BatchCreateEvaluationSetItemsOApiResponse) {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) GetSuccess() (v *BatchUpdateEvaluationSetItemsOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchCreateEvaluationSetItemsOApiResponse)
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchUpdateEvaluationSetItemsOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -76927,7 +79086,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -76937,8 +79096,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewBatchCreateEvaluationSetItemsOApiResponse()
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchUpdateEvaluationSetItemsOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -76946,9 +79105,9 @@ func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) ReadFi
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchCreateEvaluationSetItemsOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchUpdateEvaluationSetItemsOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -76974,7 +79133,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -76993,15 +79152,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) bool {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -77013,7 +79172,7 @@ func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) DeepEq
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) Field0DeepEqual(src *BatchCreateEvaluationSetItemsOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) Field0DeepEqual(src *BatchUpdateEvaluationSetItemsOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -77021,41 +79180,41 @@ func (p *EvaluationOpenAPIServiceBatchCreateEvaluationSetItemsOApiResult) Field0
 	return true
 }
 
-type EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs struct {
-	Req *BatchUpdateEvaluationSetItemsOApiRequest `thrift:"req,1" frugal:"1,default,BatchUpdateEvaluationSetItemsOApiRequest"`
+type EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs struct {
+	Req *BatchDeleteEvaluationSetItemsOApiRequest `thrift:"req,1" frugal:"1,default,BatchDeleteEvaluationSetItemsOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs() *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs {
-	return &EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs{}
+func NewEvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs() *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs {
+	return &EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs_Req_DEFAULT *BatchUpdateEvaluationSetItemsOApiRequest
+var EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs_Req_DEFAULT *BatchDeleteEvaluationSetItemsOApiRequest
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) GetReq() (v *BatchUpdateEvaluationSetItemsOApiRequest) {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) GetReq() (v *BatchDeleteEvaluationSetItemsOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) SetReq(val *BatchUpdateEvaluationSetItemsOApiRequest) {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) SetReq(val *BatchDeleteEvaluationSetItemsOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -77100,7 +79259,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -77110,8 +79269,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewBatchUpdateEvaluationSetItemsOApiRequest()
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchDeleteEvaluationSetItemsOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -77119,9 +79278,9 @@ func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) ReadFiel
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchUpdateEvaluationSetItemsOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchDeleteEvaluationSetItemsOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -77147,7 +79306,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -77164,15 +79323,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -77184,7 +79343,7 @@ func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) DeepEqua
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) Field1DeepEqual(src *BatchUpdateEvaluationSetItemsOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) Field1DeepEqual(src *BatchDeleteEvaluationSetItemsOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -77192,41 +79351,41 @@ func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiArgs) Field1De
 	return true
 }
 
-type EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult struct {
-	Success *BatchUpdateEvaluationSetItemsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,BatchUpdateEvaluationSetItemsOApiResponse"`
+type EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult struct {
+	Success *BatchDeleteEvaluationSetItemsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,BatchDeleteEvaluationSetItemsOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult() *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult {
-	return &EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult{}
+func NewEvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult() *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult {
+	return &EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult_Success_DEFAULT *BatchUpdateEvaluationSetItemsOApiResponse
+var EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult_Success_DEFAULT *BatchDeleteEvaluationSetItemsOApiResponse
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) GetSuccess() (v *BatchUpdateEvaluationSetItemsOApiResponse) {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) GetSuccess() (v *BatchDeleteEvaluationSetItemsOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchUpdateEvaluationSetItemsOApiResponse)
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchDeleteEvaluationSetItemsOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -77271,7 +79430,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -77281,8 +79440,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewBatchUpdateEvaluationSetItemsOApiResponse()
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchDeleteEvaluationSetItemsOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -77290,9 +79449,9 @@ func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) ReadFi
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchUpdateEvaluationSetItemsOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchDeleteEvaluationSetItemsOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -77318,7 +79477,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -77337,15 +79496,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) bool {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -77357,7 +79516,7 @@ func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) DeepEq
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) Field0DeepEqual(src *BatchUpdateEvaluationSetItemsOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) Field0DeepEqual(src *BatchDeleteEvaluationSetItemsOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -77365,41 +79524,41 @@ func (p *EvaluationOpenAPIServiceBatchUpdateEvaluationSetItemsOApiResult) Field0
 	return true
 }
 
-type EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs struct {
-	Req *BatchDeleteEvaluationSetItemsOApiRequest `thrift:"req,1" frugal:"1,default,BatchDeleteEvaluationSetItemsOApiRequest"`
+type EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs struct {
+	Req *ListEvaluationSetVersionItemsOApiRequest `thrift:"req,1" frugal:"1,default,ListEvaluationSetVersionItemsOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs() *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs {
-	return &EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs{}
+func NewEvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs() *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs {
+	return &EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs_Req_DEFAULT *BatchDeleteEvaluationSetItemsOApiRequest
+var EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs_Req_DEFAULT *ListEvaluationSetVersionItemsOApiRequest
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) GetReq() (v *BatchDeleteEvaluationSetItemsOApiRequest) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) GetReq() (v *ListEvaluationSetVersionItemsOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) SetReq(val *BatchDeleteEvaluationSetItemsOApiRequest) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) SetReq(val *ListEvaluationSetVersionItemsOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -77444,7 +79603,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -77454,8 +79613,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewBatchDeleteEvaluationSetItemsOApiRequest()
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListEvaluationSetVersionItemsOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -77463,9 +79622,9 @@ func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) ReadFiel
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchDeleteEvaluationSetItemsOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListEvaluationSetVersionItemsOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -77491,7 +79650,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -77508,15 +79667,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -77528,7 +79687,7 @@ func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) DeepEqua
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) Field1DeepEqual(src *BatchDeleteEvaluationSetItemsOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) Field1DeepEqual(src *ListEvaluationSetVersionItemsOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -77536,41 +79695,41 @@ func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiArgs) Field1De
 	return true
 }
 
-type EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult struct {
-	Success *BatchDeleteEvaluationSetItemsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,BatchDeleteEvaluationSetItemsOApiResponse"`
+type EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult struct {
+	Success *ListEvaluationSetVersionItemsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ListEvaluationSetVersionItemsOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult() *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult {
-	return &EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult{}
+func NewEvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult() *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult {
+	return &EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult_Success_DEFAULT *BatchDeleteEvaluationSetItemsOApiResponse
+var EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult_Success_DEFAULT *ListEvaluationSetVersionItemsOApiResponse
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) GetSuccess() (v *BatchDeleteEvaluationSetItemsOApiResponse) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) GetSuccess() (v *ListEvaluationSetVersionItemsOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchDeleteEvaluationSetItemsOApiResponse)
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListEvaluationSetVersionItemsOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -77615,7 +79774,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -77625,8 +79784,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewBatchDeleteEvaluationSetItemsOApiResponse()
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListEvaluationSetVersionItemsOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -77634,9 +79793,9 @@ func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) ReadFi
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchDeleteEvaluationSetItemsOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListEvaluationSetVersionItemsOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -77662,7 +79821,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -77681,15 +79840,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -77701,7 +79860,7 @@ func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) DeepEq
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) Field0DeepEqual(src *BatchDeleteEvaluationSetItemsOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) Field0DeepEqual(src *ListEvaluationSetVersionItemsOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -77709,41 +79868,41 @@ func (p *EvaluationOpenAPIServiceBatchDeleteEvaluationSetItemsOApiResult) Field0
 	return true
 }
 
-type EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs struct {
-	Req *ListEvaluationSetVersionItemsOApiRequest `thrift:"req,1" frugal:"1,default,ListEvaluationSetVersionItemsOApiRequest"`
+type EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs struct {
+	Req *ListEvaluationSetItemVersionsOApiRequest `thrift:"req,1" frugal:"1,default,ListEvaluationSetItemVersionsOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs() *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs {
-	return &EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs{}
+func NewEvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs() *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs {
+	return &EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs_Req_DEFAULT *ListEvaluationSetVersionItemsOApiRequest
+var EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs_Req_DEFAULT *ListEvaluationSetItemVersionsOApiRequest
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) GetReq() (v *ListEvaluationSetVersionItemsOApiRequest) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) GetReq() (v *ListEvaluationSetItemVersionsOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) SetReq(val *ListEvaluationSetVersionItemsOApiRequest) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) SetReq(val *ListEvaluationSetItemVersionsOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -77788,7 +79947,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -77798,8 +79957,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListEvaluationSetVersionItemsOApiRequest()
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListEvaluationSetItemVersionsOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -77807,9 +79966,9 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) ReadFiel
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListEvaluationSetVersionItemsOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListEvaluationSetItemVersionsOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -77835,7 +79994,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -77852,15 +80011,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -77872,7 +80031,7 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) DeepEqua
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) Field1DeepEqual(src *ListEvaluationSetVersionItemsOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) Field1DeepEqual(src *ListEvaluationSetItemVersionsOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -77880,41 +80039,41 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiArgs) Field1De
 	return true
 }
 
-type EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult struct {
-	Success *ListEvaluationSetVersionItemsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ListEvaluationSetVersionItemsOApiResponse"`
+type EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult struct {
+	Success *ListEvaluationSetItemVersionsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ListEvaluationSetItemVersionsOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult() *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult {
-	return &EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult{}
+func NewEvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult() *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult {
+	return &EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult_Success_DEFAULT *ListEvaluationSetVersionItemsOApiResponse
+var EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult_Success_DEFAULT *ListEvaluationSetItemVersionsOApiResponse
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) GetSuccess() (v *ListEvaluationSetVersionItemsOApiResponse) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) GetSuccess() (v *ListEvaluationSetItemVersionsOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListEvaluationSetVersionItemsOApiResponse)
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListEvaluationSetItemVersionsOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -77959,7 +80118,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -77969,8 +80128,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListEvaluationSetVersionItemsOApiResponse()
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListEvaluationSetItemVersionsOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -77978,9 +80137,9 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) ReadFi
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListEvaluationSetVersionItemsOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListEvaluationSetItemVersionsOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -78006,7 +80165,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -78025,15 +80184,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -78045,7 +80204,7 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) DeepEq
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) Field0DeepEqual(src *ListEvaluationSetVersionItemsOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) Field0DeepEqual(src *ListEvaluationSetItemVersionsOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -78053,41 +80212,41 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetVersionItemsOApiResult) Field0
 	return true
 }
 
-type EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs struct {
-	Req *ListEvaluationSetItemVersionsOApiRequest `thrift:"req,1" frugal:"1,default,ListEvaluationSetItemVersionsOApiRequest"`
+type EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs struct {
+	Req *GetEvaluationSetItemVersionOApiRequest `thrift:"req,1" frugal:"1,default,GetEvaluationSetItemVersionOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs() *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs {
-	return &EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs{}
+func NewEvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs() *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs {
+	return &EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs_Req_DEFAULT *ListEvaluationSetItemVersionsOApiRequest
+var EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs_Req_DEFAULT *GetEvaluationSetItemVersionOApiRequest
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) GetReq() (v *ListEvaluationSetItemVersionsOApiRequest) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) GetReq() (v *GetEvaluationSetItemVersionOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) SetReq(val *ListEvaluationSetItemVersionsOApiRequest) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) SetReq(val *GetEvaluationSetItemVersionOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -78132,7 +80291,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -78142,8 +80301,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListEvaluationSetItemVersionsOApiRequest()
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetEvaluationSetItemVersionOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -78151,9 +80310,9 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) ReadFiel
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListEvaluationSetItemVersionsOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvaluationSetItemVersionOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -78179,7 +80338,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -78196,15 +80355,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -78216,7 +80375,7 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) DeepEqua
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) Field1DeepEqual(src *ListEvaluationSetItemVersionsOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) Field1DeepEqual(src *GetEvaluationSetItemVersionOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -78224,41 +80383,41 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiArgs) Field1De
 	return true
 }
 
-type EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult struct {
-	Success *ListEvaluationSetItemVersionsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ListEvaluationSetItemVersionsOApiResponse"`
+type EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult struct {
+	Success *GetEvaluationSetItemVersionOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvaluationSetItemVersionOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult() *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult {
-	return &EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult{}
+func NewEvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult() *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult {
+	return &EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult_Success_DEFAULT *ListEvaluationSetItemVersionsOApiResponse
+var EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult_Success_DEFAULT *GetEvaluationSetItemVersionOApiResponse
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) GetSuccess() (v *ListEvaluationSetItemVersionsOApiResponse) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) GetSuccess() (v *GetEvaluationSetItemVersionOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListEvaluationSetItemVersionsOApiResponse)
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetEvaluationSetItemVersionOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -78303,7 +80462,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -78313,8 +80472,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListEvaluationSetItemVersionsOApiResponse()
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetEvaluationSetItemVersionOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -78322,9 +80481,9 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) ReadFi
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListEvaluationSetItemVersionsOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvaluationSetItemVersionOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -78350,7 +80509,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -78369,15 +80528,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -78389,7 +80548,7 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) DeepEq
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) Field0DeepEqual(src *ListEvaluationSetItemVersionsOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) Field0DeepEqual(src *GetEvaluationSetItemVersionOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -78397,41 +80556,41 @@ func (p *EvaluationOpenAPIServiceListEvaluationSetItemVersionsOApiResult) Field0
 	return true
 }
 
-type EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs struct {
-	Req *GetEvaluationSetItemVersionOApiRequest `thrift:"req,1" frugal:"1,default,GetEvaluationSetItemVersionOApiRequest"`
+type EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs struct {
+	Req *GetEvaluationItemFieldOApiRequest `thrift:"req,1" frugal:"1,default,GetEvaluationItemFieldOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs() *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs {
-	return &EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs{}
+func NewEvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs() *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs {
+	return &EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs_Req_DEFAULT *GetEvaluationSetItemVersionOApiRequest
+var EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs_Req_DEFAULT *GetEvaluationItemFieldOApiRequest
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) GetReq() (v *GetEvaluationSetItemVersionOApiRequest) {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) GetReq() (v *GetEvaluationItemFieldOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) SetReq(val *GetEvaluationSetItemVersionOApiRequest) {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) SetReq(val *GetEvaluationItemFieldOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -78476,7 +80635,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -78486,8 +80645,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetEvaluationSetItemVersionOApiRequest()
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetEvaluationItemFieldOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -78495,9 +80654,9 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) ReadField1
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvaluationSetItemVersionOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvaluationItemFieldOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -78523,7 +80682,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -78540,15 +80699,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -78560,7 +80719,7 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) DeepEqual(
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) Field1DeepEqual(src *GetEvaluationSetItemVersionOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) Field1DeepEqual(src *GetEvaluationItemFieldOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -78568,41 +80727,41 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiArgs) Field1Deep
 	return true
 }
 
-type EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult struct {
-	Success *GetEvaluationSetItemVersionOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvaluationSetItemVersionOApiResponse"`
+type EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult struct {
+	Success *GetEvaluationItemFieldOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvaluationItemFieldOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult() *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult {
-	return &EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult{}
+func NewEvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult() *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult {
+	return &EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult_Success_DEFAULT *GetEvaluationSetItemVersionOApiResponse
+var EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult_Success_DEFAULT *GetEvaluationItemFieldOApiResponse
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) GetSuccess() (v *GetEvaluationSetItemVersionOApiResponse) {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) GetSuccess() (v *GetEvaluationItemFieldOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetEvaluationSetItemVersionOApiResponse)
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetEvaluationItemFieldOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -78647,7 +80806,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -78657,8 +80816,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetEvaluationSetItemVersionOApiResponse()
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetEvaluationItemFieldOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -78666,9 +80825,9 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) ReadFiel
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvaluationSetItemVersionOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvaluationItemFieldOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -78694,7 +80853,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -78713,15 +80872,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -78733,7 +80892,7 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) DeepEqua
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) Field0DeepEqual(src *GetEvaluationSetItemVersionOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) Field0DeepEqual(src *GetEvaluationItemFieldOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -78741,41 +80900,41 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetItemVersionOApiResult) Field0De
 	return true
 }
 
-type EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs struct {
-	Req *GetEvaluationItemFieldOApiRequest `thrift:"req,1" frugal:"1,default,GetEvaluationItemFieldOApiRequest"`
+type EvaluationOpenAPIServiceImportEvaluationSetOApiArgs struct {
+	Req *ImportEvaluationSetOApiRequest `thrift:"req,1" frugal:"1,default,ImportEvaluationSetOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs() *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs {
-	return &EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs{}
+func NewEvaluationOpenAPIServiceImportEvaluationSetOApiArgs() *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs {
+	return &EvaluationOpenAPIServiceImportEvaluationSetOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs_Req_DEFAULT *GetEvaluationItemFieldOApiRequest
+var EvaluationOpenAPIServiceImportEvaluationSetOApiArgs_Req_DEFAULT *ImportEvaluationSetOApiRequest
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) GetReq() (v *GetEvaluationItemFieldOApiRequest) {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) GetReq() (v *ImportEvaluationSetOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceImportEvaluationSetOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) SetReq(val *GetEvaluationItemFieldOApiRequest) {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) SetReq(val *ImportEvaluationSetOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceImportEvaluationSetOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -78820,7 +80979,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceImportEvaluationSetOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -78830,8 +80989,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetEvaluationItemFieldOApiRequest()
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewImportEvaluationSetOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -78839,9 +80998,9 @@ func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) ReadField1(ipro
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvaluationItemFieldOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("ImportEvaluationSetOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -78867,7 +81026,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -78884,15 +81043,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceImportEvaluationSetOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -78904,7 +81063,7 @@ func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) DeepEqual(ano *
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) Field1DeepEqual(src *GetEvaluationItemFieldOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) Field1DeepEqual(src *ImportEvaluationSetOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -78912,41 +81071,41 @@ func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiArgs) Field1DeepEqual
 	return true
 }
 
-type EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult struct {
-	Success *GetEvaluationItemFieldOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvaluationItemFieldOApiResponse"`
+type EvaluationOpenAPIServiceImportEvaluationSetOApiResult struct {
+	Success *ImportEvaluationSetOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ImportEvaluationSetOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult() *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult {
-	return &EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult{}
+func NewEvaluationOpenAPIServiceImportEvaluationSetOApiResult() *EvaluationOpenAPIServiceImportEvaluationSetOApiResult {
+	return &EvaluationOpenAPIServiceImportEvaluationSetOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult_Success_DEFAULT *GetEvaluationItemFieldOApiResponse
+var EvaluationOpenAPIServiceImportEvaluationSetOApiResult_Success_DEFAULT *ImportEvaluationSetOApiResponse
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) GetSuccess() (v *GetEvaluationItemFieldOApiResponse) {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) GetSuccess() (v *ImportEvaluationSetOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceImportEvaluationSetOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetEvaluationItemFieldOApiResponse)
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ImportEvaluationSetOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceImportEvaluationSetOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -78991,7 +81150,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceImportEvaluationSetOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -79001,8 +81160,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetEvaluationItemFieldOApiResponse()
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewImportEvaluationSetOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -79010,9 +81169,9 @@ func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) ReadField0(ip
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvaluationItemFieldOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("ImportEvaluationSetOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -79038,7 +81197,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -79057,15 +81216,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceImportEvaluationSetOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) bool {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -79077,7 +81236,7 @@ func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) DeepEqual(ano
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) Field0DeepEqual(src *GetEvaluationItemFieldOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) Field0DeepEqual(src *ImportEvaluationSetOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -79085,41 +81244,41 @@ func (p *EvaluationOpenAPIServiceGetEvaluationItemFieldOApiResult) Field0DeepEqu
 	return true
 }
 
-type EvaluationOpenAPIServiceImportEvaluationSetOApiArgs struct {
-	Req *ImportEvaluationSetOApiRequest `thrift:"req,1" frugal:"1,default,ImportEvaluationSetOApiRequest"`
+type EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs struct {
+	Req *GetEvaluationSetIOJobOApiRequest `thrift:"req,1" frugal:"1,default,GetEvaluationSetIOJobOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceImportEvaluationSetOApiArgs() *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs {
-	return &EvaluationOpenAPIServiceImportEvaluationSetOApiArgs{}
+func NewEvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs() *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs {
+	return &EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceImportEvaluationSetOApiArgs_Req_DEFAULT *ImportEvaluationSetOApiRequest
+var EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs_Req_DEFAULT *GetEvaluationSetIOJobOApiRequest
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) GetReq() (v *ImportEvaluationSetOApiRequest) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) GetReq() (v *GetEvaluationSetIOJobOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceImportEvaluationSetOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) SetReq(val *ImportEvaluationSetOApiRequest) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) SetReq(val *GetEvaluationSetIOJobOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceImportEvaluationSetOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -79164,7 +81323,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceImportEvaluationSetOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -79174,8 +81333,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewImportEvaluationSetOApiRequest()
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetEvaluationSetIOJobOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -79183,9 +81342,9 @@ func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) ReadField1(iprot t
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ImportEvaluationSetOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvaluationSetJobOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -79211,7 +81370,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -79228,15 +81387,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceImportEvaluationSetOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -79248,7 +81407,7 @@ func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) DeepEqual(ano *Eva
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) Field1DeepEqual(src *ImportEvaluationSetOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) Field1DeepEqual(src *GetEvaluationSetIOJobOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -79256,41 +81415,41 @@ func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiArgs) Field1DeepEqual(sr
 	return true
 }
 
-type EvaluationOpenAPIServiceImportEvaluationSetOApiResult struct {
-	Success *ImportEvaluationSetOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ImportEvaluationSetOApiResponse"`
+type EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult struct {
+	Success *GetEvaluationSetIOJobOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvaluationSetIOJobOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceImportEvaluationSetOApiResult() *EvaluationOpenAPIServiceImportEvaluationSetOApiResult {
-	return &EvaluationOpenAPIServiceImportEvaluationSetOApiResult{}
+func NewEvaluationOpenAPIServiceGetEvaluationSetJobOApiResult() *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult {
+	return &EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceImportEvaluationSetOApiResult_Success_DEFAULT *ImportEvaluationSetOApiResponse
+var EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult_Success_DEFAULT *GetEvaluationSetIOJobOApiResponse
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) GetSuccess() (v *ImportEvaluationSetOApiResponse) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) GetSuccess() (v *GetEvaluationSetIOJobOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceImportEvaluationSetOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ImportEvaluationSetOApiResponse)
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetEvaluationSetIOJobOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceImportEvaluationSetOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -79335,7 +81494,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceImportEvaluationSetOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -79345,8 +81504,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewImportEvaluationSetOApiResponse()
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetEvaluationSetIOJobOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -79354,9 +81513,9 @@ func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) ReadField0(iprot
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ImportEvaluationSetOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvaluationSetJobOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -79382,7 +81541,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -79401,15 +81560,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceImportEvaluationSetOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -79421,7 +81580,7 @@ func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) DeepEqual(ano *E
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) Field0DeepEqual(src *ImportEvaluationSetOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) Field0DeepEqual(src *GetEvaluationSetIOJobOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -79429,41 +81588,41 @@ func (p *EvaluationOpenAPIServiceImportEvaluationSetOApiResult) Field0DeepEqual(
 	return true
 }
 
-type EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs struct {
-	Req *GetEvaluationSetIOJobOApiRequest `thrift:"req,1" frugal:"1,default,GetEvaluationSetIOJobOApiRequest"`
+type EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs struct {
+	Req *UpdateEvaluationSetSchemaOApiRequest `thrift:"req,1" frugal:"1,default,UpdateEvaluationSetSchemaOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs() *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs {
-	return &EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs{}
+func NewEvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs() *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs {
+	return &EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs_Req_DEFAULT *GetEvaluationSetIOJobOApiRequest
+var EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs_Req_DEFAULT *UpdateEvaluationSetSchemaOApiRequest
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) GetReq() (v *GetEvaluationSetIOJobOApiRequest) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) GetReq() (v *UpdateEvaluationSetSchemaOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) SetReq(val *GetEvaluationSetIOJobOApiRequest) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) SetReq(val *UpdateEvaluationSetSchemaOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -79508,7 +81667,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -79518,8 +81677,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetEvaluationSetIOJobOApiRequest()
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateEvaluationSetSchemaOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -79527,9 +81686,9 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) ReadField1(iprot t
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvaluationSetJobOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateEvaluationSetSchemaOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -79555,7 +81714,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -79572,15 +81731,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -79592,7 +81751,7 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) DeepEqual(ano *Eva
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) Field1DeepEqual(src *GetEvaluationSetIOJobOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) Field1DeepEqual(src *UpdateEvaluationSetSchemaOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -79600,41 +81759,41 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiArgs) Field1DeepEqual(sr
 	return true
 }
 
-type EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult struct {
-	Success *GetEvaluationSetIOJobOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvaluationSetIOJobOApiResponse"`
+type EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult struct {
+	Success *UpdateEvaluationSetSchemaOApiResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateEvaluationSetSchemaOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceGetEvaluationSetJobOApiResult() *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult {
-	return &EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult{}
+func NewEvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult() *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult {
+	return &EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult_Success_DEFAULT *GetEvaluationSetIOJobOApiResponse
+var EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult_Success_DEFAULT *UpdateEvaluationSetSchemaOApiResponse
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) GetSuccess() (v *GetEvaluationSetIOJobOApiResponse) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) GetSuccess() (v *UpdateEvaluationSetSchemaOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetEvaluationSetIOJobOApiResponse)
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateEvaluationSetSchemaOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -79679,7 +81838,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -79689,8 +81848,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetEvaluationSetIOJobOApiResponse()
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateEvaluationSetSchemaOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -79698,9 +81857,9 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) ReadField0(iprot
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvaluationSetJobOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateEvaluationSetSchemaOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -79726,7 +81885,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -79745,15 +81904,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) bool {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -79765,7 +81924,7 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) DeepEqual(ano *E
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) Field0DeepEqual(src *GetEvaluationSetIOJobOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) Field0DeepEqual(src *UpdateEvaluationSetSchemaOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -79773,41 +81932,41 @@ func (p *EvaluationOpenAPIServiceGetEvaluationSetJobOApiResult) Field0DeepEqual(
 	return true
 }
 
-type EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs struct {
-	Req *UpdateEvaluationSetSchemaOApiRequest `thrift:"req,1" frugal:"1,default,UpdateEvaluationSetSchemaOApiRequest"`
+type EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs struct {
+	Req *ReportEvalTargetInvokeResultRequest `thrift:"req,1" frugal:"1,default,ReportEvalTargetInvokeResultRequest"`
 }
 
-func NewEvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs() *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs {
-	return &EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs{}
+func NewEvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs() *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs {
+	return &EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs_Req_DEFAULT *UpdateEvaluationSetSchemaOApiRequest
+var EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs_Req_DEFAULT *ReportEvalTargetInvokeResultRequest
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) GetReq() (v *UpdateEvaluationSetSchemaOApiRequest) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) GetReq() (v *ReportEvalTargetInvokeResultRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) SetReq(val *UpdateEvaluationSetSchemaOApiRequest) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) SetReq(val *ReportEvalTargetInvokeResultRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -79852,7 +82011,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -79862,8 +82021,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateEvaluationSetSchemaOApiRequest()
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewReportEvalTargetInvokeResultRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -79871,9 +82030,9 @@ func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) ReadField1(i
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateEvaluationSetSchemaOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("ReportEvalTargetInvokeResult_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -79899,7 +82058,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -79916,15 +82075,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) DeepEqual(ano *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -79936,7 +82095,7 @@ func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) DeepEqual(an
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) Field1DeepEqual(src *UpdateEvaluationSetSchemaOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) Field1DeepEqual(src *ReportEvalTargetInvokeResultRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -79944,41 +82103,41 @@ func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiArgs) Field1DeepEq
 	return true
 }
 
-type EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult struct {
-	Success *UpdateEvaluationSetSchemaOApiResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateEvaluationSetSchemaOApiResponse"`
+type EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult struct {
+	Success *ReportEvalTargetInvokeResultResponse `thrift:"success,0,optional" frugal:"0,optional,ReportEvalTargetInvokeResultResponse"`
 }
 
-func NewEvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult() *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult {
-	return &EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult{}
+func NewEvaluationOpenAPIServiceReportEvalTargetInvokeResultResult() *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult {
+	return &EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult{}
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult_Success_DEFAULT *UpdateEvaluationSetSchemaOApiResponse
+var EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult_Success_DEFAULT *ReportEvalTargetInvokeResultResponse
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) GetSuccess() (v *UpdateEvaluationSetSchemaOApiResponse) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) GetSuccess() (v *ReportEvalTargetInvokeResultResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UpdateEvaluationSetSchemaOApiResponse)
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReportEvalTargetInvokeResultResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -80023,7 +82182,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -80033,8 +82192,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateEvaluationSetSchemaOApiResponse()
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewReportEvalTargetInvokeResultResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -80042,9 +82201,9 @@ func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) ReadField0
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateEvaluationSetSchemaOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("ReportEvalTargetInvokeResult_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -80070,7 +82229,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -80089,15 +82248,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) bool {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) DeepEqual(ano *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -80109,7 +82268,7 @@ func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) DeepEqual(
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) Field0DeepEqual(src *UpdateEvaluationSetSchemaOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) Field0DeepEqual(src *ReportEvalTargetInvokeResultResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -80117,41 +82276,41 @@ func (p *EvaluationOpenAPIServiceUpdateEvaluationSetSchemaOApiResult) Field0Deep
 	return true
 }
 
-type EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs struct {
-	Req *ReportEvalTargetInvokeResultRequest `thrift:"req,1" frugal:"1,default,ReportEvalTargetInvokeResultRequest"`
+type EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs struct {
+	Req *ReportEvalTargetStepMetricRequest `thrift:"req,1" frugal:"1,default,ReportEvalTargetStepMetricRequest"`
 }
 
-func NewEvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs() *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs {
-	return &EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs{}
+func NewEvaluationOpenAPIServiceReportEvalTargetStepMetricArgs() *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs {
+	return &EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs_Req_DEFAULT *ReportEvalTargetInvokeResultRequest
+var EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs_Req_DEFAULT *ReportEvalTargetStepMetricRequest
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) GetReq() (v *ReportEvalTargetInvokeResultRequest) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) GetReq() (v *ReportEvalTargetStepMetricRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) SetReq(val *ReportEvalTargetInvokeResultRequest) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) SetReq(val *ReportEvalTargetStepMetricRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -80196,7 +82355,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -80206,8 +82365,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewReportEvalTargetInvokeResultRequest()
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewReportEvalTargetStepMetricRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -80215,9 +82374,9 @@ func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) ReadField1(ip
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ReportEvalTargetInvokeResult_args"); err != nil {
+	if err = oprot.WriteStructBegin("ReportEvalTargetStepMetric_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -80243,7 +82402,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -80260,15 +82419,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) String() string {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) DeepEqual(ano *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) bool {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) DeepEqual(ano *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -80280,7 +82439,7 @@ func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) DeepEqual(ano
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) Field1DeepEqual(src *ReportEvalTargetInvokeResultRequest) bool {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) Field1DeepEqual(src *ReportEvalTargetStepMetricRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -80288,41 +82447,41 @@ func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultArgs) Field1DeepEqu
 	return true
 }
 
-type EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult struct {
-	Success *ReportEvalTargetInvokeResultResponse `thrift:"success,0,optional" frugal:"0,optional,ReportEvalTargetInvokeResultResponse"`
+type EvaluationOpenAPIServiceReportEvalTargetStepMetricResult struct {
+	Success *ReportEvalTargetStepMetricResponse `thrift:"success,0,optional" frugal:"0,optional,ReportEvalTargetStepMetricResponse"`
 }
 
-func NewEvaluationOpenAPIServiceReportEvalTargetInvokeResultResult() *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult {
-	return &EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult{}
+func NewEvaluationOpenAPIServiceReportEvalTargetStepMetricResult() *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult {
+	return &EvaluationOpenAPIServiceReportEvalTargetStepMetricResult{}
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult_Success_DEFAULT *ReportEvalTargetInvokeResultResponse
+var EvaluationOpenAPIServiceReportEvalTargetStepMetricResult_Success_DEFAULT *ReportEvalTargetStepMetricResponse
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) GetSuccess() (v *ReportEvalTargetInvokeResultResponse) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) GetSuccess() (v *ReportEvalTargetStepMetricResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceReportEvalTargetStepMetricResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ReportEvalTargetInvokeResultResponse)
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReportEvalTargetStepMetricResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceReportEvalTargetStepMetricResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -80367,7 +82526,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceReportEvalTargetStepMetricResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -80377,8 +82536,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewReportEvalTargetInvokeResultResponse()
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewReportEvalTargetStepMetricResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -80386,9 +82545,9 @@ func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) ReadField0(
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ReportEvalTargetInvokeResult_result"); err != nil {
+	if err = oprot.WriteStructBegin("ReportEvalTargetStepMetric_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -80414,7 +82573,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -80433,15 +82592,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) String() string {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceReportEvalTargetStepMetricResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) DeepEqual(ano *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) bool {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) DeepEqual(ano *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -80453,7 +82612,7 @@ func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) DeepEqual(a
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) Field0DeepEqual(src *ReportEvalTargetInvokeResultResponse) bool {
+func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) Field0DeepEqual(src *ReportEvalTargetStepMetricResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -80461,41 +82620,41 @@ func (p *EvaluationOpenAPIServiceReportEvalTargetInvokeResultResult) Field0DeepE
 	return true
 }
 
-type EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs struct {
-	Req *ReportEvalTargetStepMetricRequest `thrift:"req,1" frugal:"1,default,ReportEvalTargetStepMetricRequest"`
+type EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs struct {
+	Req *GetEvalTargetOutputFieldContentOApiRequest `thrift:"req,1" frugal:"1,default,GetEvalTargetOutputFieldContentOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceReportEvalTargetStepMetricArgs() *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs {
-	return &EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs{}
+func NewEvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs() *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs {
+	return &EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs_Req_DEFAULT *ReportEvalTargetStepMetricRequest
+var EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs_Req_DEFAULT *GetEvalTargetOutputFieldContentOApiRequest
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) GetReq() (v *ReportEvalTargetStepMetricRequest) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) GetReq() (v *GetEvalTargetOutputFieldContentOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) SetReq(val *ReportEvalTargetStepMetricRequest) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) SetReq(val *GetEvalTargetOutputFieldContentOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -80540,7 +82699,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -80550,8 +82709,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewReportEvalTargetStepMetricRequest()
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetEvalTargetOutputFieldContentOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -80559,9 +82718,9 @@ func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) ReadField1(ipro
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ReportEvalTargetStepMetric_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvalTargetOutputFieldContentOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -80587,7 +82746,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -80604,15 +82763,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) String() string {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) DeepEqual(ano *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) bool {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -80624,7 +82783,7 @@ func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) DeepEqual(ano *
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) Field1DeepEqual(src *ReportEvalTargetStepMetricRequest) bool {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) Field1DeepEqual(src *GetEvalTargetOutputFieldContentOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -80632,41 +82791,41 @@ func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricArgs) Field1DeepEqual
 	return true
 }
 
-type EvaluationOpenAPIServiceReportEvalTargetStepMetricResult struct {
-	Success *ReportEvalTargetStepMetricResponse `thrift:"success,0,optional" frugal:"0,optional,ReportEvalTargetStepMetricResponse"`
+type EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult struct {
+	Success *GetEvalTargetOutputFieldContentOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvalTargetOutputFieldContentOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceReportEvalTargetStepMetricResult() *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult {
-	return &EvaluationOpenAPIServiceReportEvalTargetStepMetricResult{}
+func NewEvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult() *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult {
+	return &EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceReportEvalTargetStepMetricResult_Success_DEFAULT *ReportEvalTargetStepMetricResponse
+var EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult_Success_DEFAULT *GetEvalTargetOutputFieldContentOApiResponse
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) GetSuccess() (v *ReportEvalTargetStepMetricResponse) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) GetSuccess() (v *GetEvalTargetOutputFieldContentOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceReportEvalTargetStepMetricResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ReportEvalTargetStepMetricResponse)
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetEvalTargetOutputFieldContentOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceReportEvalTargetStepMetricResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -80711,7 +82870,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceReportEvalTargetStepMetricResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -80721,8 +82880,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewReportEvalTargetStepMetricResponse()
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetEvalTargetOutputFieldContentOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -80730,9 +82889,9 @@ func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) ReadField0(ip
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ReportEvalTargetStepMetric_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvalTargetOutputFieldContentOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -80758,7 +82917,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -80777,15 +82936,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) String() string {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceReportEvalTargetStepMetricResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) DeepEqual(ano *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) bool {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -80797,7 +82956,7 @@ func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) DeepEqual(ano
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) Field0DeepEqual(src *ReportEvalTargetStepMetricResponse) bool {
+func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) Field0DeepEqual(src *GetEvalTargetOutputFieldContentOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -80805,41 +82964,41 @@ func (p *EvaluationOpenAPIServiceReportEvalTargetStepMetricResult) Field0DeepEqu
 	return true
 }
 
-type EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs struct {
-	Req *GetEvalTargetOutputFieldContentOApiRequest `thrift:"req,1" frugal:"1,default,GetEvalTargetOutputFieldContentOApiRequest"`
+type EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs struct {
+	Req *AsyncDebugEvalTargetOApiRequest `thrift:"req,1" frugal:"1,default,AsyncDebugEvalTargetOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs() *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs {
-	return &EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs{}
+func NewEvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs() *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs {
+	return &EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs_Req_DEFAULT *GetEvalTargetOutputFieldContentOApiRequest
+var EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs_Req_DEFAULT *AsyncDebugEvalTargetOApiRequest
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) GetReq() (v *GetEvalTargetOutputFieldContentOApiRequest) {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) GetReq() (v *AsyncDebugEvalTargetOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) SetReq(val *GetEvalTargetOutputFieldContentOApiRequest) {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) SetReq(val *AsyncDebugEvalTargetOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -80884,7 +83043,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -80894,8 +83053,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetEvalTargetOutputFieldContentOApiRequest()
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAsyncDebugEvalTargetOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -80903,9 +83062,9 @@ func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) ReadFi
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvalTargetOutputFieldContentOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("AsyncDebugEvalTargetOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -80931,7 +83090,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -80948,15 +83107,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -80968,7 +83127,7 @@ func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) DeepEq
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) Field1DeepEqual(src *GetEvalTargetOutputFieldContentOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) Field1DeepEqual(src *AsyncDebugEvalTargetOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -80976,41 +83135,41 @@ func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiArgs) Field1
 	return true
 }
 
-type EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult struct {
-	Success *GetEvalTargetOutputFieldContentOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvalTargetOutputFieldContentOApiResponse"`
+type EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult struct {
+	Success *AsyncDebugEvalTargetOApiResponse `thrift:"success,0,optional" frugal:"0,optional,AsyncDebugEvalTargetOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult() *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult {
-	return &EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult{}
+func NewEvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult() *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult {
+	return &EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult_Success_DEFAULT *GetEvalTargetOutputFieldContentOApiResponse
+var EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult_Success_DEFAULT *AsyncDebugEvalTargetOApiResponse
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) GetSuccess() (v *GetEvalTargetOutputFieldContentOApiResponse) {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) GetSuccess() (v *AsyncDebugEvalTargetOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetEvalTargetOutputFieldContentOApiResponse)
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AsyncDebugEvalTargetOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -81055,7 +83214,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -81065,8 +83224,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetEvalTargetOutputFieldContentOApiResponse()
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAsyncDebugEvalTargetOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -81074,9 +83233,9 @@ func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) Read
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvalTargetOutputFieldContentOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("AsyncDebugEvalTargetOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -81102,7 +83261,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -81121,15 +83280,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) bool {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -81141,7 +83300,7 @@ func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) Deep
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) Field0DeepEqual(src *GetEvalTargetOutputFieldContentOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) Field0DeepEqual(src *AsyncDebugEvalTargetOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -81149,41 +83308,41 @@ func (p *EvaluationOpenAPIServiceGetEvalTargetOutputFieldContentOApiResult) Fiel
 	return true
 }
 
-type EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs struct {
-	Req *AsyncDebugEvalTargetOApiRequest `thrift:"req,1" frugal:"1,default,AsyncDebugEvalTargetOApiRequest"`
+type EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs struct {
+	Req *GetEvalTargetRecordOApiRequest `thrift:"req,1" frugal:"1,default,GetEvalTargetRecordOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs() *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs {
-	return &EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs{}
+func NewEvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs() *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs {
+	return &EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs_Req_DEFAULT *AsyncDebugEvalTargetOApiRequest
+var EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs_Req_DEFAULT *GetEvalTargetRecordOApiRequest
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) GetReq() (v *AsyncDebugEvalTargetOApiRequest) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) GetReq() (v *GetEvalTargetRecordOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) SetReq(val *AsyncDebugEvalTargetOApiRequest) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) SetReq(val *GetEvalTargetRecordOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -81228,7 +83387,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -81238,8 +83397,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAsyncDebugEvalTargetOApiRequest()
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetEvalTargetRecordOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -81247,9 +83406,9 @@ func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) ReadField1(iprot
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AsyncDebugEvalTargetOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvalTargetRecordOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -81275,7 +83434,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -81292,15 +83451,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -81312,7 +83471,7 @@ func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) DeepEqual(ano *Ev
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) Field1DeepEqual(src *AsyncDebugEvalTargetOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) Field1DeepEqual(src *GetEvalTargetRecordOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -81320,41 +83479,41 @@ func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiArgs) Field1DeepEqual(s
 	return true
 }
 
-type EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult struct {
-	Success *AsyncDebugEvalTargetOApiResponse `thrift:"success,0,optional" frugal:"0,optional,AsyncDebugEvalTargetOApiResponse"`
+type EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult struct {
+	Success *GetEvalTargetRecordOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvalTargetRecordOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult() *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult {
-	return &EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult{}
+func NewEvaluationOpenAPIServiceGetEvalTargetRecordOApiResult() *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult {
+	return &EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult_Success_DEFAULT *AsyncDebugEvalTargetOApiResponse
+var EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult_Success_DEFAULT *GetEvalTargetRecordOApiResponse
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) GetSuccess() (v *AsyncDebugEvalTargetOApiResponse) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) GetSuccess() (v *GetEvalTargetRecordOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*AsyncDebugEvalTargetOApiResponse)
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetEvalTargetRecordOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -81399,7 +83558,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -81409,8 +83568,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAsyncDebugEvalTargetOApiResponse()
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetEvalTargetRecordOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -81418,9 +83577,9 @@ func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) ReadField0(ipro
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AsyncDebugEvalTargetOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetEvalTargetRecordOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -81446,7 +83605,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -81465,15 +83624,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) bool {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -81485,7 +83644,7 @@ func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) DeepEqual(ano *
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) Field0DeepEqual(src *AsyncDebugEvalTargetOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) Field0DeepEqual(src *GetEvalTargetRecordOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -81493,41 +83652,41 @@ func (p *EvaluationOpenAPIServiceAsyncDebugEvalTargetOApiResult) Field0DeepEqual
 	return true
 }
 
-type EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs struct {
-	Req *GetEvalTargetRecordOApiRequest `thrift:"req,1" frugal:"1,default,GetEvalTargetRecordOApiRequest"`
+type EvaluationOpenAPIServiceListEvalTargetsOApiArgs struct {
+	Req *ListEvalTargetsOApiRequest `thrift:"req,1" frugal:"1,default,ListEvalTargetsOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs() *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs {
-	return &EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs{}
+func NewEvaluationOpenAPIServiceListEvalTargetsOApiArgs() *EvaluationOpenAPIServiceListEvalTargetsOApiArgs {
+	return &EvaluationOpenAPIServiceListEvalTargetsOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs_Req_DEFAULT *GetEvalTargetRecordOApiRequest
+var EvaluationOpenAPIServiceListEvalTargetsOApiArgs_Req_DEFAULT *ListEvalTargetsOApiRequest
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) GetReq() (v *GetEvalTargetRecordOApiRequest) {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) GetReq() (v *ListEvalTargetsOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceListEvalTargetsOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) SetReq(val *GetEvalTargetRecordOApiRequest) {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) SetReq(val *ListEvalTargetsOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceListEvalTargetsOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -81572,7 +83731,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvalTargetsOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -81582,8 +83741,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetEvalTargetRecordOApiRequest()
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListEvalTargetsOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -81591,9 +83750,9 @@ func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) ReadField1(iprot t
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvalTargetRecordOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListEvalTargetsOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -81619,7 +83778,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -81636,15 +83795,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceListEvalTargetsOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -81656,7 +83815,7 @@ func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) DeepEqual(ano *Eva
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) Field1DeepEqual(src *GetEvalTargetRecordOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) Field1DeepEqual(src *ListEvalTargetsOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -81664,41 +83823,41 @@ func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiArgs) Field1DeepEqual(sr
 	return true
 }
 
-type EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult struct {
-	Success *GetEvalTargetRecordOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetEvalTargetRecordOApiResponse"`
+type EvaluationOpenAPIServiceListEvalTargetsOApiResult struct {
+	Success *ListEvalTargetsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ListEvalTargetsOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceGetEvalTargetRecordOApiResult() *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult {
-	return &EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult{}
+func NewEvaluationOpenAPIServiceListEvalTargetsOApiResult() *EvaluationOpenAPIServiceListEvalTargetsOApiResult {
+	return &EvaluationOpenAPIServiceListEvalTargetsOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult_Success_DEFAULT *GetEvalTargetRecordOApiResponse
+var EvaluationOpenAPIServiceListEvalTargetsOApiResult_Success_DEFAULT *ListEvalTargetsOApiResponse
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) GetSuccess() (v *GetEvalTargetRecordOApiResponse) {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) GetSuccess() (v *ListEvalTargetsOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceListEvalTargetsOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetEvalTargetRecordOApiResponse)
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListEvalTargetsOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceListEvalTargetsOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -81743,7 +83902,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvalTargetsOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -81753,8 +83912,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetEvalTargetRecordOApiResponse()
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListEvalTargetsOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -81762,9 +83921,9 @@ func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) ReadField0(iprot
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetEvalTargetRecordOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListEvalTargetsOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -81790,7 +83949,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -81809,15 +83968,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceListEvalTargetsOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) bool {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceListEvalTargetsOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -81829,7 +83988,7 @@ func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) DeepEqual(ano *E
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) Field0DeepEqual(src *GetEvalTargetRecordOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) Field0DeepEqual(src *ListEvalTargetsOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -81837,41 +83996,41 @@ func (p *EvaluationOpenAPIServiceGetEvalTargetRecordOApiResult) Field0DeepEqual(
 	return true
 }
 
-type EvaluationOpenAPIServiceListEvalTargetsOApiArgs struct {
-	Req *ListEvalTargetsOApiRequest `thrift:"req,1" frugal:"1,default,ListEvalTargetsOApiRequest"`
+type EvaluationOpenAPIServiceSubmitExperimentOApiArgs struct {
+	Req *SubmitExperimentOApiRequest `thrift:"req,1" frugal:"1,default,SubmitExperimentOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceListEvalTargetsOApiArgs() *EvaluationOpenAPIServiceListEvalTargetsOApiArgs {
-	return &EvaluationOpenAPIServiceListEvalTargetsOApiArgs{}
+func NewEvaluationOpenAPIServiceSubmitExperimentOApiArgs() *EvaluationOpenAPIServiceSubmitExperimentOApiArgs {
+	return &EvaluationOpenAPIServiceSubmitExperimentOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceListEvalTargetsOApiArgs_Req_DEFAULT *ListEvalTargetsOApiRequest
+var EvaluationOpenAPIServiceSubmitExperimentOApiArgs_Req_DEFAULT *SubmitExperimentOApiRequest
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) GetReq() (v *ListEvalTargetsOApiRequest) {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) GetReq() (v *SubmitExperimentOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceListEvalTargetsOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceSubmitExperimentOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) SetReq(val *ListEvalTargetsOApiRequest) {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) SetReq(val *SubmitExperimentOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceListEvalTargetsOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceSubmitExperimentOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -81916,7 +84075,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvalTargetsOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceSubmitExperimentOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -81926,8 +84085,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListEvalTargetsOApiRequest()
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSubmitExperimentOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -81935,9 +84094,9 @@ func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) ReadField1(iprot thrif
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListEvalTargetsOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("SubmitExperimentOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -81963,7 +84122,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -81980,15 +84139,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceListEvalTargetsOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceSubmitExperimentOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -82000,7 +84159,7 @@ func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) DeepEqual(ano *Evaluat
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) Field1DeepEqual(src *ListEvalTargetsOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) Field1DeepEqual(src *SubmitExperimentOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -82008,41 +84167,41 @@ func (p *EvaluationOpenAPIServiceListEvalTargetsOApiArgs) Field1DeepEqual(src *L
 	return true
 }
 
-type EvaluationOpenAPIServiceListEvalTargetsOApiResult struct {
-	Success *ListEvalTargetsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ListEvalTargetsOApiResponse"`
+type EvaluationOpenAPIServiceSubmitExperimentOApiResult struct {
+	Success *SubmitExperimentOApiResponse `thrift:"success,0,optional" frugal:"0,optional,SubmitExperimentOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceListEvalTargetsOApiResult() *EvaluationOpenAPIServiceListEvalTargetsOApiResult {
-	return &EvaluationOpenAPIServiceListEvalTargetsOApiResult{}
+func NewEvaluationOpenAPIServiceSubmitExperimentOApiResult() *EvaluationOpenAPIServiceSubmitExperimentOApiResult {
+	return &EvaluationOpenAPIServiceSubmitExperimentOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceListEvalTargetsOApiResult_Success_DEFAULT *ListEvalTargetsOApiResponse
+var EvaluationOpenAPIServiceSubmitExperimentOApiResult_Success_DEFAULT *SubmitExperimentOApiResponse
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) GetSuccess() (v *ListEvalTargetsOApiResponse) {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) GetSuccess() (v *SubmitExperimentOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceListEvalTargetsOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceSubmitExperimentOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListEvalTargetsOApiResponse)
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SubmitExperimentOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceListEvalTargetsOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceSubmitExperimentOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -82087,7 +84246,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListEvalTargetsOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceSubmitExperimentOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -82097,8 +84256,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListEvalTargetsOApiResponse()
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSubmitExperimentOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -82106,9 +84265,9 @@ func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) ReadField0(iprot thr
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListEvalTargetsOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("SubmitExperimentOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -82134,7 +84293,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -82153,15 +84312,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceListEvalTargetsOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceSubmitExperimentOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceListEvalTargetsOApiResult) bool {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceSubmitExperimentOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -82173,7 +84332,7 @@ func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) DeepEqual(ano *Evalu
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) Field0DeepEqual(src *ListEvalTargetsOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) Field0DeepEqual(src *SubmitExperimentOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -82181,41 +84340,41 @@ func (p *EvaluationOpenAPIServiceListEvalTargetsOApiResult) Field0DeepEqual(src
 	return true
 }
 
-type EvaluationOpenAPIServiceSubmitExperimentOApiArgs struct {
-	Req *SubmitExperimentOApiRequest `thrift:"req,1" frugal:"1,default,SubmitExperimentOApiRequest"`
+type EvaluationOpenAPIServiceGetExperimentsOApiArgs struct {
+	Req *GetExperimentsOApiRequest `thrift:"req,1" frugal:"1,default,GetExperimentsOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceSubmitExperimentOApiArgs() *EvaluationOpenAPIServiceSubmitExperimentOApiArgs {
-	return &EvaluationOpenAPIServiceSubmitExperimentOApiArgs{}
+func NewEvaluationOpenAPIServiceGetExperimentsOApiArgs() *EvaluationOpenAPIServiceGetExperimentsOApiArgs {
+	return &EvaluationOpenAPIServiceGetExperimentsOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceSubmitExperimentOApiArgs_Req_DEFAULT *SubmitExperimentOApiRequest
+var EvaluationOpenAPIServiceGetExperimentsOApiArgs_Req_DEFAULT *GetExperimentsOApiRequest
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) GetReq() (v *SubmitExperimentOApiRequest) {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) GetReq() (v *GetExperimentsOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceSubmitExperimentOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceGetExperimentsOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) SetReq(val *SubmitExperimentOApiRequest) {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) SetReq(val *GetExperimentsOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceSubmitExperimentOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetExperimentsOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -82260,7 +84419,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceSubmitExperimentOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetExperimentsOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -82270,8 +84429,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSubmitExperimentOApiRequest()
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetExperimentsOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -82279,9 +84438,9 @@ func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) ReadField1(iprot thri
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SubmitExperimentOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetExperimentsOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -82307,7 +84466,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -82324,15 +84483,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceSubmitExperimentOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetExperimentsOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetExperimentsOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -82344,7 +84503,7 @@ func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) DeepEqual(ano *Evalua
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) Field1DeepEqual(src *SubmitExperimentOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) Field1DeepEqual(src *GetExperimentsOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -82352,41 +84511,41 @@ func (p *EvaluationOpenAPIServiceSubmitExperimentOApiArgs) Field1DeepEqual(src *
 	return true
 }
 
-type EvaluationOpenAPIServiceSubmitExperimentOApiResult struct {
-	Success *SubmitExperimentOApiResponse `thrift:"success,0,optional" frugal:"0,optional,SubmitExperimentOApiResponse"`
+type EvaluationOpenAPIServiceGetExperimentsOApiResult struct {
+	Success *GetExperimentsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetExperimentsOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceSubmitExperimentOApiResult() *EvaluationOpenAPIServiceSubmitExperimentOApiResult {
-	return &EvaluationOpenAPIServiceSubmitExperimentOApiResult{}
+func NewEvaluationOpenAPIServiceGetExperimentsOApiResult() *EvaluationOpenAPIServiceGetExperimentsOApiResult {
+	return &EvaluationOpenAPIServiceGetExperimentsOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceSubmitExperimentOApiResult_Success_DEFAULT *SubmitExperimentOApiResponse
+var EvaluationOpenAPIServiceGetExperimentsOApiResult_Success_DEFAULT *GetExperimentsOApiResponse
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) GetSuccess() (v *SubmitExperimentOApiResponse) {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) GetSuccess() (v *GetExperimentsOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceSubmitExperimentOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceGetExperimentsOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*SubmitExperimentOApiResponse)
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetExperimentsOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceSubmitExperimentOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetExperimentsOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -82431,7 +84590,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceSubmitExperimentOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetExperimentsOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -82441,8 +84600,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSubmitExperimentOApiResponse()
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetExperimentsOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -82450,9 +84609,9 @@ func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) ReadField0(iprot th
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SubmitExperimentOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetExperimentsOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -82478,7 +84637,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -82497,15 +84656,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceSubmitExperimentOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetExperimentsOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceSubmitExperimentOApiResult) bool {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetExperimentsOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -82517,7 +84676,7 @@ func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) DeepEqual(ano *Eval
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) Field0DeepEqual(src *SubmitExperimentOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) Field0DeepEqual(src *GetExperimentsOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -82525,41 +84684,41 @@ func (p *EvaluationOpenAPIServiceSubmitExperimentOApiResult) Field0DeepEqual(src
 	return true
 }
 
-type EvaluationOpenAPIServiceGetExperimentsOApiArgs struct {
-	Req *GetExperimentsOApiRequest `thrift:"req,1" frugal:"1,default,GetExperimentsOApiRequest"`
+type EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs struct {
+	Req *UpdateExptRunConfOApiRequest `thrift:"req,1" frugal:"1,default,UpdateExptRunConfOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceGetExperimentsOApiArgs() *EvaluationOpenAPIServiceGetExperimentsOApiArgs {
-	return &EvaluationOpenAPIServiceGetExperimentsOApiArgs{}
+func NewEvaluationOpenAPIServiceUpdateExptRunConfOApiArgs() *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs {
+	return &EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetExperimentsOApiArgs_Req_DEFAULT *GetExperimentsOApiRequest
+var EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs_Req_DEFAULT *UpdateExptRunConfOApiRequest
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) GetReq() (v *GetExperimentsOApiRequest) {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) GetReq() (v *UpdateExptRunConfOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceGetExperimentsOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) SetReq(val *GetExperimentsOApiRequest) {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) SetReq(val *UpdateExptRunConfOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetExperimentsOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -82604,7 +84763,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetExperimentsOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -82614,8 +84773,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetExperimentsOApiRequest()
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateExptRunConfOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -82623,9 +84782,9 @@ func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) ReadField1(iprot thrift
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetExperimentsOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateExptRunConfOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -82651,7 +84810,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -82668,15 +84827,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetExperimentsOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetExperimentsOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -82688,7 +84847,7 @@ func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) DeepEqual(ano *Evaluati
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) Field1DeepEqual(src *GetExperimentsOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) Field1DeepEqual(src *UpdateExptRunConfOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -82696,41 +84855,41 @@ func (p *EvaluationOpenAPIServiceGetExperimentsOApiArgs) Field1DeepEqual(src *Ge
 	return true
 }
 
-type EvaluationOpenAPIServiceGetExperimentsOApiResult struct {
-	Success *GetExperimentsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetExperimentsOApiResponse"`
+type EvaluationOpenAPIServiceUpdateExptRunConfOApiResult struct {
+	Success *UpdateExptRunConfOApiResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateExptRunConfOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceGetExperimentsOApiResult() *EvaluationOpenAPIServiceGetExperimentsOApiResult {
-	return &EvaluationOpenAPIServiceGetExperimentsOApiResult{}
+func NewEvaluationOpenAPIServiceUpdateExptRunConfOApiResult() *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult {
+	return &EvaluationOpenAPIServiceUpdateExptRunConfOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetExperimentsOApiResult_Success_DEFAULT *GetExperimentsOApiResponse
+var EvaluationOpenAPIServiceUpdateExptRunConfOApiResult_Success_DEFAULT *UpdateExptRunConfOApiResponse
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) GetSuccess() (v *GetExperimentsOApiResponse) {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) GetSuccess() (v *UpdateExptRunConfOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceGetExperimentsOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceUpdateExptRunConfOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetExperimentsOApiResponse)
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateExptRunConfOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetExperimentsOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceUpdateExptRunConfOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -82775,7 +84934,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetExperimentsOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceUpdateExptRunConfOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -82785,8 +84944,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetExperimentsOApiResponse()
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateExptRunConfOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -82794,9 +84953,9 @@ func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) ReadField0(iprot thri
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetExperimentsOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateExptRunConfOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -82822,7 +84981,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -82841,15 +85000,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetExperimentsOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceUpdateExptRunConfOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetExperimentsOApiResult) bool {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -82861,7 +85020,7 @@ func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) DeepEqual(ano *Evalua
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) Field0DeepEqual(src *GetExperimentsOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) Field0DeepEqual(src *UpdateExptRunConfOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -82869,41 +85028,41 @@ func (p *EvaluationOpenAPIServiceGetExperimentsOApiResult) Field0DeepEqual(src *
 	return true
 }
 
-type EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs struct {
-	Req *UpdateExptRunConfOApiRequest `thrift:"req,1" frugal:"1,default,UpdateExptRunConfOApiRequest"`
+type EvaluationOpenAPIServiceListExperimentsOApiArgs struct {
+	Req *ListExperimentsOApiRequest `thrift:"req,1" frugal:"1,default,ListExperimentsOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceUpdateExptRunConfOApiArgs() *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs {
-	return &EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs{}
+func NewEvaluationOpenAPIServiceListExperimentsOApiArgs() *EvaluationOpenAPIServiceListExperimentsOApiArgs {
+	return &EvaluationOpenAPIServiceListExperimentsOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs_Req_DEFAULT *UpdateExptRunConfOApiRequest
+var EvaluationOpenAPIServiceListExperimentsOApiArgs_Req_DEFAULT *ListExperimentsOApiRequest
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) GetReq() (v *UpdateExptRunConfOApiRequest) {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) GetReq() (v *ListExperimentsOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceListExperimentsOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) SetReq(val *UpdateExptRunConfOApiRequest) {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) SetReq(val *ListExperimentsOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceListExperimentsOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -82948,7 +85107,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListExperimentsOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -82958,8 +85117,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateExptRunConfOApiRequest()
+func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListExperimentsOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -82967,9 +85126,9 @@ func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) ReadField1(iprot thr
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateExptRunConfOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListExperimentsOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -82995,7 +85154,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -83012,15 +85171,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceListExperimentsOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceListExperimentsOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -83032,7 +85191,7 @@ func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) DeepEqual(ano *Evalu
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) Field1DeepEqual(src *UpdateExptRunConfOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) Field1DeepEqual(src *ListExperimentsOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -83040,41 +85199,41 @@ func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiArgs) Field1DeepEqual(src
 	return true
 }
 
-type EvaluationOpenAPIServiceUpdateExptRunConfOApiResult struct {
-	Success *UpdateExptRunConfOApiResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateExptRunConfOApiResponse"`
+type EvaluationOpenAPIServiceListExperimentsOApiResult struct {
+	Success *ListExperimentsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ListExperimentsOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceUpdateExptRunConfOApiResult() *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult {
-	return &EvaluationOpenAPIServiceUpdateExptRunConfOApiResult{}
+func NewEvaluationOpenAPIServiceListExperimentsOApiResult() *EvaluationOpenAPIServiceListExperimentsOApiResult {
+	return &EvaluationOpenAPIServiceListExperimentsOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceUpdateExptRunConfOApiResult_Success_DEFAULT *UpdateExptRunConfOApiResponse
+var EvaluationOpenAPIServiceListExperimentsOApiResult_Success_DEFAULT *ListExperimentsOApiResponse
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) GetSuccess() (v *UpdateExptRunConfOApiResponse) {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) GetSuccess() (v *ListExperimentsOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceUpdateExptRunConfOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceListExperimentsOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UpdateExptRunConfOApiResponse)
+func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListExperimentsOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceUpdateExptRunConfOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceListExperimentsOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -83119,7 +85278,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceUpdateExptRunConfOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListExperimentsOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -83129,8 +85288,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateExptRunConfOApiResponse()
+func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListExperimentsOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -83138,9 +85297,9 @@ func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) ReadField0(iprot t
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateExptRunConfOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListExperimentsOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -83166,7 +85325,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -83185,15 +85344,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceUpdateExptRunConfOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceListExperimentsOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) bool {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceListExperimentsOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -83205,7 +85364,7 @@ func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) DeepEqual(ano *Eva
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) Field0DeepEqual(src *UpdateExptRunConfOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) Field0DeepEqual(src *ListExperimentsOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -83213,41 +85372,41 @@ func (p *EvaluationOpenAPIServiceUpdateExptRunConfOApiResult) Field0DeepEqual(sr
 	return true
 }
 
-type EvaluationOpenAPIServiceListExperimentsOApiArgs struct {
-	Req *ListExperimentsOApiRequest `thrift:"req,1" frugal:"1,default,ListExperimentsOApiRequest"`
+type EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs struct {
+	Req *GetExperimentIDsByGroupOApiRequest `thrift:"req,1" frugal:"1,default,GetExperimentIDsByGroupOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceListExperimentsOApiArgs() *EvaluationOpenAPIServiceListExperimentsOApiArgs {
-	return &EvaluationOpenAPIServiceListExperimentsOApiArgs{}
+func NewEvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs() *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs {
+	return &EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceListExperimentsOApiArgs_Req_DEFAULT *ListExperimentsOApiRequest
+var EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs_Req_DEFAULT *GetExperimentIDsByGroupOApiRequest
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) GetReq() (v *ListExperimentsOApiRequest) {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) GetReq() (v *GetExperimentIDsByGroupOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceListExperimentsOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) SetReq(val *ListExperimentsOApiRequest) {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) SetReq(val *GetExperimentIDsByGroupOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceListExperimentsOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -83292,7 +85451,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListExperimentsOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -83302,8 +85461,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListExperimentsOApiRequest()
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetExperimentIDsByGroupOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -83311,9 +85470,9 @@ func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) ReadField1(iprot thrif
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListExperimentsOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetExperimentIDsByGroupOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -83339,7 +85498,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -83356,15 +85515,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceListExperimentsOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceListExperimentsOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -83376,7 +85535,7 @@ func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) DeepEqual(ano *Evaluat
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) Field1DeepEqual(src *ListExperimentsOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) Field1DeepEqual(src *GetExperimentIDsByGroupOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -83384,41 +85543,41 @@ func (p *EvaluationOpenAPIServiceListExperimentsOApiArgs) Field1DeepEqual(src *L
 	return true
 }
 
-type EvaluationOpenAPIServiceListExperimentsOApiResult struct {
-	Success *ListExperimentsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ListExperimentsOApiResponse"`
+type EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult struct {
+	Success *GetExperimentIDsByGroupOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetExperimentIDsByGroupOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceListExperimentsOApiResult() *EvaluationOpenAPIServiceListExperimentsOApiResult {
-	return &EvaluationOpenAPIServiceListExperimentsOApiResult{}
+func NewEvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult() *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult {
+	return &EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceListExperimentsOApiResult_Success_DEFAULT *ListExperimentsOApiResponse
+var EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult_Success_DEFAULT *GetExperimentIDsByGroupOApiResponse
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) GetSuccess() (v *ListExperimentsOApiResponse) {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) GetSuccess() (v *GetExperimentIDsByGroupOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceListExperimentsOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListExperimentsOApiResponse)
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetExperimentIDsByGroupOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceListExperimentsOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -83463,7 +85622,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListExperimentsOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -83473,8 +85632,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListExperimentsOApiResponse()
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetExperimentIDsByGroupOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -83482,9 +85641,9 @@ func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) ReadField0(iprot thr
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListExperimentsOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetExperimentIDsByGroupOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -83510,7 +85669,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -83529,15 +85688,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceListExperimentsOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceListExperimentsOApiResult) bool {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -83549,7 +85708,7 @@ func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) DeepEqual(ano *Evalu
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) Field0DeepEqual(src *ListExperimentsOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) Field0DeepEqual(src *GetExperimentIDsByGroupOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -83557,41 +85716,41 @@ func (p *EvaluationOpenAPIServiceListExperimentsOApiResult) Field0DeepEqual(src
 	return true
 }
 
-type EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs struct {
-	Req *GetExperimentIDsByGroupOApiRequest `thrift:"req,1" frugal:"1,default,GetExperimentIDsByGroupOApiRequest"`
+type EvaluationOpenAPIServiceListExperimentResultOApiArgs struct {
+	Req *ListExperimentResultOApiRequest `thrift:"req,1" frugal:"1,default,ListExperimentResultOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs() *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs {
-	return &EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs{}
+func NewEvaluationOpenAPIServiceListExperimentResultOApiArgs() *EvaluationOpenAPIServiceListExperimentResultOApiArgs {
+	return &EvaluationOpenAPIServiceListExperimentResultOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs_Req_DEFAULT *GetExperimentIDsByGroupOApiRequest
+var EvaluationOpenAPIServiceListExperimentResultOApiArgs_Req_DEFAULT *ListExperimentResultOApiRequest
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) GetReq() (v *GetExperimentIDsByGroupOApiRequest) {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) GetReq() (v *ListExperimentResultOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceListExperimentResultOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) SetReq(val *GetExperimentIDsByGroupOApiRequest) {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) SetReq(val *ListExperimentResultOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceListExperimentResultOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -83636,7 +85795,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListExperimentResultOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -83646,8 +85805,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetExperimentIDsByGroupOApiRequest()
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListExperimentResultOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -83655,9 +85814,9 @@ func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) ReadField1(ipr
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetExperimentIDsByGroupOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListExperimentResultOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -83683,7 +85842,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -83700,15 +85859,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceListExperimentResultOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceListExperimentResultOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -83720,7 +85879,7 @@ func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) DeepEqual(ano
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) Field1DeepEqual(src *GetExperimentIDsByGroupOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) Field1DeepEqual(src *ListExperimentResultOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -83728,41 +85887,41 @@ func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiArgs) Field1DeepEqua
 	return true
 }
 
-type EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult struct {
-	Success *GetExperimentIDsByGroupOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetExperimentIDsByGroupOApiResponse"`
+type EvaluationOpenAPIServiceListExperimentResultOApiResult struct {
+	Success *ListExperimentResultOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ListExperimentResultOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult() *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult {
-	return &EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult{}
+func NewEvaluationOpenAPIServiceListExperimentResultOApiResult() *EvaluationOpenAPIServiceListExperimentResultOApiResult {
+	return &EvaluationOpenAPIServiceListExperimentResultOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult_Success_DEFAULT *GetExperimentIDsByGroupOApiResponse
+var EvaluationOpenAPIServiceListExperimentResultOApiResult_Success_DEFAULT *ListExperimentResultOApiResponse
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) GetSuccess() (v *GetExperimentIDsByGroupOApiResponse) {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) GetSuccess() (v *ListExperimentResultOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceListExperimentResultOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetExperimentIDsByGroupOApiResponse)
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListExperimentResultOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceListExperimentResultOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -83807,7 +85966,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListExperimentResultOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -83817,8 +85976,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetExperimentIDsByGroupOApiResponse()
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListExperimentResultOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -83826,9 +85985,9 @@ func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) ReadField0(i
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetExperimentIDsByGroupOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListExperimentResultOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -83854,7 +86013,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -83873,15 +86032,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceListExperimentResultOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) bool {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceListExperimentResultOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -83893,7 +86052,7 @@ func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) DeepEqual(an
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) Field0DeepEqual(src *GetExperimentIDsByGroupOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) Field0DeepEqual(src *ListExperimentResultOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -83901,41 +86060,41 @@ func (p *EvaluationOpenAPIServiceGetExperimentIDsByGroupOApiResult) Field0DeepEq
 	return true
 }
 
-type EvaluationOpenAPIServiceListExperimentResultOApiArgs struct {
-	Req *ListExperimentResultOApiRequest `thrift:"req,1" frugal:"1,default,ListExperimentResultOApiRequest"`
+type EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs struct {
+	Req *GetExperimentAggrResultOApiRequest `thrift:"req,1" frugal:"1,default,GetExperimentAggrResultOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceListExperimentResultOApiArgs() *EvaluationOpenAPIServiceListExperimentResultOApiArgs {
-	return &EvaluationOpenAPIServiceListExperimentResultOApiArgs{}
+func NewEvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs() *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs {
+	return &EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceListExperimentResultOApiArgs_Req_DEFAULT *ListExperimentResultOApiRequest
+var EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs_Req_DEFAULT *GetExperimentAggrResultOApiRequest
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) GetReq() (v *ListExperimentResultOApiRequest) {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) GetReq() (v *GetExperimentAggrResultOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceListExperimentResultOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) SetReq(val *ListExperimentResultOApiRequest) {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) SetReq(val *GetExperimentAggrResultOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceListExperimentResultOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -83980,7 +86139,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListExperimentResultOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -83990,8 +86149,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListExperimentResultOApiRequest()
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetExperimentAggrResultOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -83999,9 +86158,9 @@ func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) ReadField1(iprot
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListExperimentResultOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetExperimentAggrResultOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -84027,7 +86186,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -84044,15 +86203,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceListExperimentResultOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceListExperimentResultOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -84064,7 +86223,7 @@ func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) DeepEqual(ano *Ev
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) Field1DeepEqual(src *ListExperimentResultOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) Field1DeepEqual(src *GetExperimentAggrResultOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -84072,41 +86231,41 @@ func (p *EvaluationOpenAPIServiceListExperimentResultOApiArgs) Field1DeepEqual(s
 	return true
 }
 
-type EvaluationOpenAPIServiceListExperimentResultOApiResult struct {
-	Success *ListExperimentResultOApiResponse `thrift:"success,0,optional" frugal:"0,optional,ListExperimentResultOApiResponse"`
+type EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult struct {
+	Success *GetExperimentAggrResultOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetExperimentAggrResultOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceListExperimentResultOApiResult() *EvaluationOpenAPIServiceListExperimentResultOApiResult {
-	return &EvaluationOpenAPIServiceListExperimentResultOApiResult{}
+func NewEvaluationOpenAPIServiceGetExperimentAggrResultOApiResult() *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult {
+	return &EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceListExperimentResultOApiResult_Success_DEFAULT *ListExperimentResultOApiResponse
+var EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult_Success_DEFAULT *GetExperimentAggrResultOApiResponse
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) GetSuccess() (v *ListExperimentResultOApiResponse) {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) GetSuccess() (v *GetExperimentAggrResultOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceListExperimentResultOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListExperimentResultOApiResponse)
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetExperimentAggrResultOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceListExperimentResultOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -84151,7 +86310,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceListExperimentResultOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -84161,8 +86320,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListExperimentResultOApiResponse()
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetExperimentAggrResultOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -84170,9 +86329,9 @@ func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) ReadField0(ipro
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListExperimentResultOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetExperimentAggrResultOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -84198,7 +86357,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -84217,15 +86376,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceListExperimentResultOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceListExperimentResultOApiResult) bool {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -84237,7 +86396,7 @@ func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) DeepEqual(ano *
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) Field0DeepEqual(src *ListExperimentResultOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) Field0DeepEqual(src *GetExperimentAggrResultOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -84245,41 +86404,41 @@ func (p *EvaluationOpenAPIServiceListExperimentResultOApiResult) Field0DeepEqual
 	return true
 }
 
-type EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs struct {
-	Req *GetExperimentAggrResultOApiRequest `thrift:"req,1" frugal:"1,default,GetExperimentAggrResultOApiRequest"`
+type EvaluationOpenAPIServiceCompareExperimentsOApiArgs struct {
+	Req *CompareExperimentsOApiRequest `thrift:"req,1" frugal:"1,default,CompareExperimentsOApiRequest"`
 }
 
-func NewEvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs() *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs {
-	return &EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs{}
+func NewEvaluationOpenAPIServiceCompareExperimentsOApiArgs() *EvaluationOpenAPIServiceCompareExperimentsOApiArgs {
+	return &EvaluationOpenAPIServiceCompareExperimentsOApiArgs{}
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) InitDefault() {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiArgs) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs_Req_DEFAULT *GetExperimentAggrResultOApiRequest
+var EvaluationOpenAPIServiceCompareExperimentsOApiArgs_Req_DEFAULT *CompareExperimentsOApiRequest
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) GetReq() (v *GetExperimentAggrResultOApiRequest) {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiArgs) GetReq() (v *CompareExperimentsOApiRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs_Req_DEFAULT
+		return EvaluationOpenAPIServiceCompareExperimentsOApiArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) SetReq(val *GetExperimentAggrResultOApiRequest) {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiArgs) SetReq(val *CompareExperimentsOApiRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceCompareExperimentsOApiArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) IsSetReq() bool {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -84324,7 +86483,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceCompareExperimentsOApiArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -84334,8 +86493,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetExperimentAggrResultOApiRequest()
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCompareExperimentsOApiRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -84343,9 +86502,9 @@ func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) ReadField1(ipr
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetExperimentAggrResultOApi_args"); err != nil {
+	if err = oprot.WriteStructBegin("CompareExperimentsOApi_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -84371,7 +86530,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -84388,15 +86547,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) String() string {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceCompareExperimentsOApiArgs(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) bool {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiArgs) DeepEqual(ano *EvaluationOpenAPIServiceCompareExperimentsOApiArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -84408,7 +86567,7 @@ func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) DeepEqual(ano
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) Field1DeepEqual(src *GetExperimentAggrResultOApiRequest) bool {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiArgs) Field1DeepEqual(src *CompareExperimentsOApiRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -84416,41 +86575,41 @@ func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiArgs) Field1DeepEqua
 	return true
 }
 
-type EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult struct {
-	Success *GetExperimentAggrResultOApiResponse `thrift:"success,0,optional" frugal:"0,optional,GetExperimentAggrResultOApiResponse"`
+type EvaluationOpenAPIServiceCompareExperimentsOApiResult struct {
+	Success *CompareExperimentsOApiResponse `thrift:"success,0,optional" frugal:"0,optional,CompareExperimentsOApiResponse"`
 }
 
-func NewEvaluationOpenAPIServiceGetExperimentAggrResultOApiResult() *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult {
-	return &EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult{}
+func NewEvaluationOpenAPIServiceCompareExperimentsOApiResult() *EvaluationOpenAPIServiceCompareExperimentsOApiResult {
+	return &EvaluationOpenAPIServiceCompareExperimentsOApiResult{}
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) InitDefault() {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiResult) InitDefault() {
 }
 
-var EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult_Success_DEFAULT *GetExperimentAggrResultOApiResponse
+var EvaluationOpenAPIServiceCompareExperimentsOApiResult_Success_DEFAULT *CompareExperimentsOApiResponse
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) GetSuccess() (v *GetExperimentAggrResultOApiResponse) {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiResult) GetSuccess() (v *CompareExperimentsOApiResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult_Success_DEFAULT
+		return EvaluationOpenAPIServiceCompareExperimentsOApiResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetExperimentAggrResultOApiResponse)
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CompareExperimentsOApiResponse)
 }
 
-var fieldIDToName_EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult = map[int16]string{
+var fieldIDToName_EvaluationOpenAPIServiceCompareExperimentsOApiResult = map[int16]string{
 	0: "success",
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) IsSetSuccess() bool {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -84495,7 +86654,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluationOpenAPIServiceCompareExperimentsOApiResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -84505,8 +86664,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetExperimentAggrResultOApiResponse()
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCompareExperimentsOApiResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -84514,9 +86673,9 @@ func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) ReadField0(i
 	return nil
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetExperimentAggrResultOApi_result"); err != nil {
+	if err = oprot.WriteStructBegin("CompareExperimentsOApi_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -84542,7 +86701,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -84561,15 +86720,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) String() string {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult(%+v)", *p)
+	return fmt.Sprintf("EvaluationOpenAPIServiceCompareExperimentsOApiResult(%+v)", *p)
 
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) bool {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiResult) DeepEqual(ano *EvaluationOpenAPIServiceCompareExperimentsOApiResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -84581,7 +86740,7 @@ func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) DeepEqual(an
 	return true
 }
 
-func (p *EvaluationOpenAPIServiceGetExperimentAggrResultOApiResult) Field0DeepEqual(src *GetExperimentAggrResultOApiResponse) bool {
+func (p *EvaluationOpenAPIServiceCompareExperimentsOApiResult) Field0DeepEqual(src *CompareExperimentsOApiResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -938,6 +938,11 @@ func (p *SubmitExperimentOApiRequest) IsValid() error {
 			return fmt.Errorf("field NotificationConf not valid, %w", err)
 		}
 	}
+	if p.QualityGateConf != nil {
+		if err := p.QualityGateConf.IsValid(); err != nil {
+			return fmt.Errorf("field QualityGateConf not valid, %w", err)
+		}
+	}
 	if p.Extra != nil {
 		if err := p.Extra.IsValid(); err != nil {
 			return fmt.Errorf("field Extra not valid, %w", err)
@@ -1201,6 +1206,35 @@ func (p *GetExperimentAggrResultOpenAPIData) IsValid() error {
 	}
 	return nil
 }
+func (p *CompareExperimentsOApiRequest) IsValid() error {
+	if p.Extra != nil {
+		if err := p.Extra.IsValid(); err != nil {
+			return fmt.Errorf("field Extra not valid, %w", err)
+		}
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *CompareExperimentsOApiResponse) IsValid() error {
+	if p.Data != nil {
+		if err := p.Data.IsValid(); err != nil {
+			return fmt.Errorf("field Data not valid, %w", err)
+		}
+	}
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *CompareExperimentsOpenAPIData) IsValid() error {
+	return nil
+}
 func (p *RetryExperimentOApiRequest) IsValid() error {
 	if p.Base != nil {
 		if err := p.Base.IsValid(); err != nil {
@@ -1847,6 +1881,11 @@ func (p *CreateExptTemplateOApiRequest) IsValid() error {
 			return fmt.Errorf("field NotificationConf not valid, %w", err)
 		}
 	}
+	if p.QualityGateConf != nil {
+		if err := p.QualityGateConf.IsValid(); err != nil {
+			return fmt.Errorf("field QualityGateConf not valid, %w", err)
+		}
+	}
 	if p.Extra != nil {
 		if err := p.Extra.IsValid(); err != nil {
 			return fmt.Errorf("field Extra not valid, %w", err)
@@ -1974,6 +2013,11 @@ func (p *UpdateExptTemplateOApiRequest) IsValid() error {
 			return fmt.Errorf("field NotificationConf not valid, %w", err)
 		}
 	}
+	if p.QualityGateConf != nil {
+		if err := p.QualityGateConf.IsValid(); err != nil {
+			return fmt.Errorf("field QualityGateConf not valid, %w", err)
+		}
+	}
 	if p.Extra != nil {
 		if err := p.Extra.IsValid(); err != nil {
 			return fmt.Errorf("field Extra not valid, %w", err)
@@ -2076,6 +2120,11 @@ func (p *SubmitExptFromTemplateOApiRequest) IsValid() error {
 			return fmt.Errorf("field NotificationConf not valid, %w", err)
 		}
 	}
+	if p.QualityGateConf != nil {
+		if err := p.QualityGateConf.IsValid(); err != nil {
+			return fmt.Errorf("field QualityGateConf not valid, %w", err)
+		}
+	}
 	if p.TargetRuntimeParam != nil {
 		if err := p.TargetRuntimeParam.IsValid(); err != nil {
 			return fmt.Errorf("field TargetRuntimeParam not valid, %w", err)
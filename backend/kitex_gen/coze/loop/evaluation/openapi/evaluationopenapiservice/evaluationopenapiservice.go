@@ -230,6 +230,13 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"CompareExperimentsOApi": kitex.NewMethodInfo(
+		compareExperimentsOApiHandler,
+		newEvaluationOpenAPIServiceCompareExperimentsOApiArgs,
+		newEvaluationOpenAPIServiceCompareExperimentsOApiResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"RetryExperimentOApi": kitex.NewMethodInfo(
 		retryExperimentOApiHandler,
 		newEvaluationOpenAPIServiceRetryExperimentOApiArgs,
@@ -1034,6 +1041,25 @@ func newEvaluationOpenAPIServiceGetExperimentAggrResultOApiResult() interface{}
 	return openapi.NewEvaluationOpenAPIServiceGetExperimentAggrResultOApiResult()
 }
 
+func compareExperimentsOApiHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*openapi.EvaluationOpenAPIServiceCompareExperimentsOApiArgs)
+	realResult := result.(*openapi.EvaluationOpenAPIServiceCompareExperimentsOApiResult)
+	success, err := handler.(openapi.EvaluationOpenAPIService).CompareExperimentsOApi(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newEvaluationOpenAPIServiceCompareExperimentsOApiArgs() interface{} {
+	return openapi.NewEvaluationOpenAPIServiceCompareExperimentsOApiArgs()
+}
+
+func newEvaluationOpenAPIServiceCompareExperimentsOApiResult() interface{} {
+	return openapi.NewEvaluationOpenAPIServiceCompareExperimentsOApiResult()
+}
+
 func retryExperimentOApiHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*openapi.EvaluationOpenAPIServiceRetryExperimentOApiArgs)
 	realResult := result.(*openapi.EvaluationOpenAPIServiceRetryExperimentOApiResult)
@@ -1850,6 +1876,16 @@ func (p *kClient) GetExperimentAggrResultOApi(ctx context.Context, req *openapi.
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) CompareExperimentsOApi(ctx context.Context, req *openapi.CompareExperimentsOApiRequest) (r *openapi.CompareExperimentsOApiResponse, err error) {
+	var _args openapi.EvaluationOpenAPIServiceCompareExperimentsOApiArgs
+	_args.Req = req
+	var _result openapi.EvaluationOpenAPIServiceCompareExperimentsOApiResult
+	if err = p.c.Call(ctx, "CompareExperimentsOApi", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) RetryExperimentOApi(ctx context.Context, req *openapi.RetryExperimentOApiRequest) (r *openapi.RetryExperimentOApiResponse, err error) {
 	var _args openapi.EvaluationOpenAPIServiceRetryExperimentOApiArgs
 	_args.Req = req
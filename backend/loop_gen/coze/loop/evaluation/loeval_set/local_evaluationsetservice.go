@@ -278,6 +278,48 @@ func (l *LocalEvaluationSetService) BatchGetEvaluationSetVersions(ctx context.Co
 	return result.GetSuccess(), nil
 }
 
+func (l *LocalEvaluationSetService) DiffEvaluationSetVersions(ctx context.Context, req *eval_set.DiffEvaluationSetVersionsRequest, callOptions ...callopt.Option) (*eval_set.DiffEvaluationSetVersionsResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*eval_set.EvaluationSetServiceDiffEvaluationSetVersionsArgs)
+		result := out.(*eval_set.EvaluationSetServiceDiffEvaluationSetVersionsResult)
+		resp, err := l.impl.DiffEvaluationSetVersions(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &eval_set.EvaluationSetServiceDiffEvaluationSetVersionsArgs{Req: req}
+	result := &eval_set.EvaluationSetServiceDiffEvaluationSetVersionsResult{}
+	ctx = l.injectRPCInfo(ctx, "DiffEvaluationSetVersions")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalEvaluationSetService) MergeEvaluationSetVersion(ctx context.Context, req *eval_set.MergeEvaluationSetVersionRequest, callOptions ...callopt.Option) (*eval_set.MergeEvaluationSetVersionResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*eval_set.EvaluationSetServiceMergeEvaluationSetVersionArgs)
+		result := out.(*eval_set.EvaluationSetServiceMergeEvaluationSetVersionResult)
+		resp, err := l.impl.MergeEvaluationSetVersion(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &eval_set.EvaluationSetServiceMergeEvaluationSetVersionArgs{Req: req}
+	result := &eval_set.EvaluationSetServiceMergeEvaluationSetVersionResult{}
+	ctx = l.injectRPCInfo(ctx, "MergeEvaluationSetVersion")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 // UpdateEvaluationSetSchema
 // 字段管理
 func (l *LocalEvaluationSetService) UpdateEvaluationSetSchema(ctx context.Context, req *eval_set.UpdateEvaluationSetSchemaRequest, callOptions ...callopt.Option) (*eval_set.UpdateEvaluationSetSchemaResponse, error) {
@@ -366,6 +408,153 @@ func (l *LocalEvaluationSetService) BatchDeleteEvaluationSetItems(ctx context.Co
 	return result.GetSuccess(), nil
 }
 
+func (l *LocalEvaluationSetService) StartEvaluationSetDedupJob(ctx context.Context, req *eval_set.StartEvaluationSetDedupJobRequest, callOptions ...callopt.Option) (*eval_set.StartEvaluationSetDedupJobResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*eval_set.EvaluationSetServiceStartEvaluationSetDedupJobArgs)
+		result := out.(*eval_set.EvaluationSetServiceStartEvaluationSetDedupJobResult)
+		resp, err := l.impl.StartEvaluationSetDedupJob(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &eval_set.EvaluationSetServiceStartEvaluationSetDedupJobArgs{Req: req}
+	result := &eval_set.EvaluationSetServiceStartEvaluationSetDedupJobResult{}
+	ctx = l.injectRPCInfo(ctx, "StartEvaluationSetDedupJob")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalEvaluationSetService) GetEvaluationSetDedupJob(ctx context.Context, req *eval_set.GetEvaluationSetDedupJobRequest, callOptions ...callopt.Option) (*eval_set.GetEvaluationSetDedupJobResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*eval_set.EvaluationSetServiceGetEvaluationSetDedupJobArgs)
+		result := out.(*eval_set.EvaluationSetServiceGetEvaluationSetDedupJobResult)
+		resp, err := l.impl.GetEvaluationSetDedupJob(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &eval_set.EvaluationSetServiceGetEvaluationSetDedupJobArgs{Req: req}
+	result := &eval_set.EvaluationSetServiceGetEvaluationSetDedupJobResult{}
+	ctx = l.injectRPCInfo(ctx, "GetEvaluationSetDedupJob")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalEvaluationSetService) ArchiveEvaluationSetDuplicates(ctx context.Context, req *eval_set.ArchiveEvaluationSetDuplicatesRequest, callOptions ...callopt.Option) (*eval_set.ArchiveEvaluationSetDuplicatesResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*eval_set.EvaluationSetServiceArchiveEvaluationSetDuplicatesArgs)
+		result := out.(*eval_set.EvaluationSetServiceArchiveEvaluationSetDuplicatesResult)
+		resp, err := l.impl.ArchiveEvaluationSetDuplicates(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &eval_set.EvaluationSetServiceArchiveEvaluationSetDuplicatesArgs{Req: req}
+	result := &eval_set.EvaluationSetServiceArchiveEvaluationSetDuplicatesResult{}
+	ctx = l.injectRPCInfo(ctx, "ArchiveEvaluationSetDuplicates")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalEvaluationSetService) GenerateEvaluationSetItems(ctx context.Context, req *eval_set.GenerateEvaluationSetItemsRequest, callOptions ...callopt.Option) (*eval_set.GenerateEvaluationSetItemsResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*eval_set.EvaluationSetServiceGenerateEvaluationSetItemsArgs)
+		result := out.(*eval_set.EvaluationSetServiceGenerateEvaluationSetItemsResult)
+		resp, err := l.impl.GenerateEvaluationSetItems(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &eval_set.EvaluationSetServiceGenerateEvaluationSetItemsArgs{Req: req}
+	result := &eval_set.EvaluationSetServiceGenerateEvaluationSetItemsResult{}
+	ctx = l.injectRPCInfo(ctx, "GenerateEvaluationSetItems")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalEvaluationSetService) ListGeneratedEvaluationSetItems(ctx context.Context, req *eval_set.ListGeneratedEvaluationSetItemsRequest, callOptions ...callopt.Option) (*eval_set.ListGeneratedEvaluationSetItemsResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*eval_set.EvaluationSetServiceListGeneratedEvaluationSetItemsArgs)
+		result := out.(*eval_set.EvaluationSetServiceListGeneratedEvaluationSetItemsResult)
+		resp, err := l.impl.ListGeneratedEvaluationSetItems(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &eval_set.EvaluationSetServiceListGeneratedEvaluationSetItemsArgs{Req: req}
+	result := &eval_set.EvaluationSetServiceListGeneratedEvaluationSetItemsResult{}
+	ctx = l.injectRPCInfo(ctx, "ListGeneratedEvaluationSetItems")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalEvaluationSetService) ReviewGeneratedEvaluationSetItems(ctx context.Context, req *eval_set.ReviewGeneratedEvaluationSetItemsRequest, callOptions ...callopt.Option) (*eval_set.ReviewGeneratedEvaluationSetItemsResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*eval_set.EvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs)
+		result := out.(*eval_set.EvaluationSetServiceReviewGeneratedEvaluationSetItemsResult)
+		resp, err := l.impl.ReviewGeneratedEvaluationSetItems(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &eval_set.EvaluationSetServiceReviewGeneratedEvaluationSetItemsArgs{Req: req}
+	result := &eval_set.EvaluationSetServiceReviewGeneratedEvaluationSetItemsResult{}
+	ctx = l.injectRPCInfo(ctx, "ReviewGeneratedEvaluationSetItems")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalEvaluationSetService) GetEvaluationSetItemLineage(ctx context.Context, req *eval_set.GetEvaluationSetItemLineageRequest, callOptions ...callopt.Option) (*eval_set.GetEvaluationSetItemLineageResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*eval_set.EvaluationSetServiceGetEvaluationSetItemLineageArgs)
+		result := out.(*eval_set.EvaluationSetServiceGetEvaluationSetItemLineageResult)
+		resp, err := l.impl.GetEvaluationSetItemLineage(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &eval_set.EvaluationSetServiceGetEvaluationSetItemLineageArgs{Req: req}
+	result := &eval_set.EvaluationSetServiceGetEvaluationSetItemLineageResult{}
+	ctx = l.injectRPCInfo(ctx, "GetEvaluationSetItemLineage")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 func (l *LocalEvaluationSetService) ListEvaluationSetItems(ctx context.Context, req *eval_set.ListEvaluationSetItemsRequest, callOptions ...callopt.Option) (*eval_set.ListEvaluationSetItemsResponse, error) {
 	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
 		arg := in.(*eval_set.EvaluationSetServiceListEvaluationSetItemsArgs)
@@ -538,6 +538,71 @@ func (l *LocalEvaluatorService) BatchGetEvaluatorRecords(ctx context.Context, re
 	return result.GetSuccess(), nil
 }
 
+// RunEvaluatorCalibration
+// 评估器校准
+func (l *LocalEvaluatorService) RunEvaluatorCalibration(ctx context.Context, req *evaluator.RunEvaluatorCalibrationRequest, callOptions ...callopt.Option) (*evaluator.RunEvaluatorCalibrationResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*evaluator.EvaluatorServiceRunEvaluatorCalibrationArgs)
+		result := out.(*evaluator.EvaluatorServiceRunEvaluatorCalibrationResult)
+		resp, err := l.impl.RunEvaluatorCalibration(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &evaluator.EvaluatorServiceRunEvaluatorCalibrationArgs{Req: req}
+	result := &evaluator.EvaluatorServiceRunEvaluatorCalibrationResult{}
+	ctx = l.injectRPCInfo(ctx, "RunEvaluatorCalibration")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalEvaluatorService) GetEvaluatorCalibrationRecord(ctx context.Context, req *evaluator.GetEvaluatorCalibrationRecordRequest, callOptions ...callopt.Option) (*evaluator.GetEvaluatorCalibrationRecordResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*evaluator.EvaluatorServiceGetEvaluatorCalibrationRecordArgs)
+		result := out.(*evaluator.EvaluatorServiceGetEvaluatorCalibrationRecordResult)
+		resp, err := l.impl.GetEvaluatorCalibrationRecord(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &evaluator.EvaluatorServiceGetEvaluatorCalibrationRecordArgs{Req: req}
+	result := &evaluator.EvaluatorServiceGetEvaluatorCalibrationRecordResult{}
+	ctx = l.injectRPCInfo(ctx, "GetEvaluatorCalibrationRecord")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalEvaluatorService) ListEvaluatorCalibrationRecords(ctx context.Context, req *evaluator.ListEvaluatorCalibrationRecordsRequest, callOptions ...callopt.Option) (*evaluator.ListEvaluatorCalibrationRecordsResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*evaluator.EvaluatorServiceListEvaluatorCalibrationRecordsArgs)
+		result := out.(*evaluator.EvaluatorServiceListEvaluatorCalibrationRecordsResult)
+		resp, err := l.impl.ListEvaluatorCalibrationRecords(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &evaluator.EvaluatorServiceListEvaluatorCalibrationRecordsArgs{Req: req}
+	result := &evaluator.EvaluatorServiceListEvaluatorCalibrationRecordsResult{}
+	ctx = l.injectRPCInfo(ctx, "ListEvaluatorCalibrationRecords")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 // ValidateEvaluator
 // 评估器验证
 func (l *LocalEvaluatorService) ValidateEvaluator(ctx context.Context, request *evaluator.ValidateEvaluatorRequest, callOptions ...callopt.Option) (*evaluator.ValidateEvaluatorResponse, error) {
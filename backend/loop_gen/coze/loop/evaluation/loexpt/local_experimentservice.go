@@ -819,6 +819,186 @@ func (l *LocalExperimentService) GetAnalysisRecordFeedbackVote(ctx context.Conte
 	return result.GetSuccess(), nil
 }
 
+// CompareExperiments
+// 显著性对比
+func (l *LocalExperimentService) CompareExperiments(ctx context.Context, req *expt.CompareExperimentsRequest, callOptions ...callopt.Option) (*expt.CompareExperimentsResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*expt.ExperimentServiceCompareExperimentsArgs)
+		result := out.(*expt.ExperimentServiceCompareExperimentsResult)
+		resp, err := l.impl.CompareExperiments(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &expt.ExperimentServiceCompareExperimentsArgs{Req: req}
+	result := &expt.ExperimentServiceCompareExperimentsResult{}
+	ctx = l.injectRPCInfo(ctx, "CompareExperiments")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+// GetExptRegressionReport
+// 跨实验回归报告
+func (l *LocalExperimentService) GetExptRegressionReport(ctx context.Context, req *expt.GetExptRegressionReportRequest, callOptions ...callopt.Option) (*expt.GetExptRegressionReportResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*expt.ExperimentServiceGetExptRegressionReportArgs)
+		result := out.(*expt.ExperimentServiceGetExptRegressionReportResult)
+		resp, err := l.impl.GetExptRegressionReport(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &expt.ExperimentServiceGetExptRegressionReportArgs{Req: req}
+	result := &expt.ExperimentServiceGetExptRegressionReportResult{}
+	ctx = l.injectRPCInfo(ctx, "GetExptRegressionReport")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalExperimentService) ExportExptRegressionReport(ctx context.Context, req *expt.ExportExptRegressionReportRequest, callOptions ...callopt.Option) (*expt.ExportExptRegressionReportResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*expt.ExperimentServiceExportExptRegressionReportArgs)
+		result := out.(*expt.ExperimentServiceExportExptRegressionReportResult)
+		resp, err := l.impl.ExportExptRegressionReport(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &expt.ExperimentServiceExportExptRegressionReportArgs{Req: req}
+	result := &expt.ExperimentServiceExportExptRegressionReportResult{}
+	ctx = l.injectRPCInfo(ctx, "ExportExptRegressionReport")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+// GetExptGroupedAggrResult_
+// 分组聚合: 按评测集字段或人工标注取值切分一个或多个实验的评估器得分
+func (l *LocalExperimentService) GetExptGroupedAggrResult_(ctx context.Context, req *expt.GetExptGroupedAggrResultRequest, callOptions ...callopt.Option) (*expt.GetExptGroupedAggrResultResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*expt.ExperimentServiceGetExptGroupedAggrResultArgs)
+		result := out.(*expt.ExperimentServiceGetExptGroupedAggrResultResult)
+		resp, err := l.impl.GetExptGroupedAggrResult_(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &expt.ExperimentServiceGetExptGroupedAggrResultArgs{Req: req}
+	result := &expt.ExperimentServiceGetExptGroupedAggrResultResult{}
+	ctx = l.injectRPCInfo(ctx, "GetExptGroupedAggrResult_")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+// GetExptSampledAggrResult_
+// 抽样实验: 按分层权重估计全量评测集上的评估器得分及置信区间
+func (l *LocalExperimentService) GetExptSampledAggrResult_(ctx context.Context, req *expt.GetExptSampledAggrResultRequest, callOptions ...callopt.Option) (*expt.GetExptSampledAggrResultResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*expt.ExperimentServiceGetExptSampledAggrResultArgs)
+		result := out.(*expt.ExperimentServiceGetExptSampledAggrResultResult)
+		resp, err := l.impl.GetExptSampledAggrResult_(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &expt.ExperimentServiceGetExptSampledAggrResultArgs{Req: req}
+	result := &expt.ExperimentServiceGetExptSampledAggrResultResult{}
+	ctx = l.injectRPCInfo(ctx, "GetExptSampledAggrResult_")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+// InvalidateExptResultCache
+// 结果缓存: 使空间内已有的评测对象/评估器结果缓存全部失效
+func (l *LocalExperimentService) InvalidateExptResultCache(ctx context.Context, req *expt.InvalidateExptResultCacheRequest, callOptions ...callopt.Option) (*expt.InvalidateExptResultCacheResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*expt.ExperimentServiceInvalidateExptResultCacheArgs)
+		result := out.(*expt.ExperimentServiceInvalidateExptResultCacheResult)
+		resp, err := l.impl.InvalidateExptResultCache(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &expt.ExperimentServiceInvalidateExptResultCacheArgs{Req: req}
+	result := &expt.ExperimentServiceInvalidateExptResultCacheResult{}
+	ctx = l.injectRPCInfo(ctx, "InvalidateExptResultCache")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+// SubmitExptPairwiseRank
+// 成对排名
+func (l *LocalExperimentService) SubmitExptPairwiseRank(ctx context.Context, req *expt.SubmitExptPairwiseRankRequest, callOptions ...callopt.Option) (*expt.SubmitExptPairwiseRankResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*expt.ExperimentServiceSubmitExptPairwiseRankArgs)
+		result := out.(*expt.ExperimentServiceSubmitExptPairwiseRankResult)
+		resp, err := l.impl.SubmitExptPairwiseRank(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &expt.ExperimentServiceSubmitExptPairwiseRankArgs{Req: req}
+	result := &expt.ExperimentServiceSubmitExptPairwiseRankResult{}
+	ctx = l.injectRPCInfo(ctx, "SubmitExptPairwiseRank")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalExperimentService) GetExptPairwiseRank(ctx context.Context, req *expt.GetExptPairwiseRankRequest, callOptions ...callopt.Option) (*expt.GetExptPairwiseRankResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*expt.ExperimentServiceGetExptPairwiseRankArgs)
+		result := out.(*expt.ExperimentServiceGetExptPairwiseRankResult)
+		resp, err := l.impl.GetExptPairwiseRank(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &expt.ExperimentServiceGetExptPairwiseRankArgs{Req: req}
+	result := &expt.ExperimentServiceGetExptPairwiseRankResult{}
+	ctx = l.injectRPCInfo(ctx, "GetExptPairwiseRank")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 // CreateExperimentTemplate
 // 实验模板
 func (l *LocalExperimentService) CreateExperimentTemplate(ctx context.Context, req *expt.CreateExperimentTemplateRequest, callOptions ...callopt.Option) (*expt.CreateExperimentTemplateResponse, error) {
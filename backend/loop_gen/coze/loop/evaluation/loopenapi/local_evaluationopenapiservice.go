@@ -737,6 +737,29 @@ func (l *LocalEvaluationOpenAPIService) GetExperimentAggrResultOApi(ctx context.
 	return result.GetSuccess(), nil
 }
 
+// CompareExperimentsOApi
+// 实验显著性对比
+func (l *LocalEvaluationOpenAPIService) CompareExperimentsOApi(ctx context.Context, req *openapi.CompareExperimentsOApiRequest, callOptions ...callopt.Option) (*openapi.CompareExperimentsOApiResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*openapi.EvaluationOpenAPIServiceCompareExperimentsOApiArgs)
+		result := out.(*openapi.EvaluationOpenAPIServiceCompareExperimentsOApiResult)
+		resp, err := l.impl.CompareExperimentsOApi(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &openapi.EvaluationOpenAPIServiceCompareExperimentsOApiArgs{Req: req}
+	result := &openapi.EvaluationOpenAPIServiceCompareExperimentsOApiResult{}
+	ctx = l.injectRPCInfo(ctx, "CompareExperimentsOApi")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 // RetryExperimentOApi
 // 重试实验
 func (l *LocalEvaluationOpenAPIService) RetryExperimentOApi(ctx context.Context, req *openapi.RetryExperimentOApiRequest, callOptions ...callopt.Option) (*openapi.RetryExperimentOApiResponse, error) {
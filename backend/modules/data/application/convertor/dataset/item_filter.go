@@ -0,0 +1,118 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataset
+
+import (
+	"strings"
+
+	"github.com/bytedance/gg/gslice"
+
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/stone/fornax/ml_flow/domain/filter"
+	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/data/pkg/errno"
+)
+
+var itemFilterQueryTypes = map[filter.QueryType]entity.ItemFilterOp{
+	filter.QueryTypeMatch:    entity.ItemFilterOpMatch,
+	filter.QueryTypeNotMatch: entity.ItemFilterOpNotMatch,
+	filter.QueryTypeEq:       entity.ItemFilterOpEq,
+	filter.QueryTypeNotEq:    entity.ItemFilterOpNotEq,
+	filter.QueryTypeIn:       entity.ItemFilterOpIn,
+	filter.QueryTypeNotIn:    entity.ItemFilterOpNotIn,
+	filter.QueryTypeGt:       entity.ItemFilterOpGt,
+	filter.QueryTypeGte:      entity.ItemFilterOpGte,
+	filter.QueryTypeLt:       entity.ItemFilterOpLt,
+	filter.QueryTypeLte:      entity.ItemFilterOpLte,
+	filter.QueryTypeExist:    entity.ItemFilterOpExist,
+	filter.QueryTypeNotExist: entity.ItemFilterOpNotExist,
+	filter.QueryTypeIsNull:   entity.ItemFilterOpNotExist,
+	filter.QueryTypeNotNull:  entity.ItemFilterOpExist,
+}
+
+// ItemFilterDTO2DO 将条目筛选条件转为按内容筛选.
+// field_name 可为字段 key 或展示名称, 写作 len(<field>) 时比较内容字符数; field_type 为数值类型时按数值比较;
+// query_type 缺省为 eq; match/not_match 有多个值时按字段上的 query_and_or 组合, 缺省时 match 为或, not_match 为且.
+func ItemFilterDTO2DO(f *filter.Filter, schema *entity.DatasetSchema) (*entity.ItemFilter, error) {
+	if f == nil {
+		return nil, nil
+	}
+	do := &entity.ItemFilter{Or: f.GetQueryAndOr() == filter.QueryRelationOr}
+	for _, field := range f.GetFilterFields() {
+		if field == nil {
+			continue
+		}
+		if field.SubFilter != nil {
+			sub, err := ItemFilterDTO2DO(field.SubFilter, schema)
+			if err != nil {
+				return nil, err
+			}
+			do.SubFilters = append(do.SubFilters, sub)
+		}
+		if field.GetFieldName() == "" {
+			continue
+		}
+		conds, err := itemFilterFieldDTO2DO(field, schema)
+		if err != nil {
+			return nil, err
+		}
+		if len(conds) > 1 {
+			or := conds[0].Op == entity.ItemFilterOpMatch
+			if field.QueryAndOr != nil {
+				or = field.GetQueryAndOr() == filter.QueryRelationOr
+			}
+			do.SubFilters = append(do.SubFilters, &entity.ItemFilter{Or: or, Conditions: conds})
+			continue
+		}
+		do.Conditions = append(do.Conditions, conds...)
+	}
+	return do, nil
+}
+
+func itemFilterFieldDTO2DO(field *filter.FilterField, schema *entity.DatasetSchema) ([]*entity.ItemFilterCondition, error) {
+	name, operand := strings.TrimSpace(field.GetFieldName()), entity.ItemFilterOperandContent
+	if strings.HasPrefix(name, "len(") && strings.HasSuffix(name, ")") {
+		name, operand = strings.TrimSpace(name[len("len("):len(name)-1]), entity.ItemFilterOperandLength
+	}
+	key, err := itemFilterFieldKey(name, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	switch field.GetFieldType() {
+	case filter.FieldTypeLong, filter.FieldTypeInteger, filter.FieldTypeDouble, filter.FieldTypeFloat:
+		if operand == entity.ItemFilterOperandContent {
+			operand = entity.ItemFilterOperandNumber
+		}
+	case filter.FieldTypeTag:
+		return nil, errno.InvalidParamErrorf("filter on field %s: tag is not supported, use tag_filter instead", name)
+	}
+
+	op := entity.ItemFilterOpEq
+	if field.QueryType != nil {
+		var ok bool
+		if op, ok = itemFilterQueryTypes[field.GetQueryType()]; !ok {
+			return nil, errno.InvalidParamErrorf("filter on field %s: unsupported query_type %s", name, field.GetQueryType())
+		}
+	}
+	if (op == entity.ItemFilterOpMatch || op == entity.ItemFilterOpNotMatch) && len(field.Values) > 1 {
+		return gslice.Map(field.Values, func(v string) *entity.ItemFilterCondition {
+			return &entity.ItemFilterCondition{FieldKey: key, Op: op, Operand: operand, Values: []string{v}}
+		}), nil
+	}
+	return []*entity.ItemFilterCondition{{FieldKey: key, Op: op, Operand: operand, Values: field.Values}}, nil
+}
+
+func itemFilterFieldKey(name string, schema *entity.DatasetSchema) (string, error) {
+	if schema == nil {
+		return name, nil
+	}
+	fields := schema.AvailableFields()
+	if f, ok := gslice.Find(fields, func(f *entity.FieldSchema) bool { return f.Key == name }).Get(); ok {
+		return f.Key, nil
+	}
+	if f, ok := gslice.Find(fields, func(f *entity.FieldSchema) bool { return f.Name == name }).Get(); ok {
+		return f.Key, nil
+	}
+	return "", errno.InvalidParamErrorf("filter field %s is not found in dataset schema", name)
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataset
+
+import (
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/stone/fornax/ml_flow/domain/filter"
+	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/entity"
+)
+
+func TestItemFilterDTO2DO(t *testing.T) {
+	schema := &entity.DatasetSchema{Fields: []*entity.FieldSchema{
+		{Key: "key_input", Name: "input"},
+		{Key: "key_output", Name: "output"},
+		{Key: "key_label", Name: "label"},
+		{Key: "key_deleted", Name: "deleted", Status: entity.FieldStatusDeleted},
+	}}
+	tests := []struct {
+		name    string
+		filter  *filter.Filter
+		want    *entity.ItemFilter
+		wantErr bool
+	}{
+		{
+			name:   "nil",
+			filter: nil,
+			want:   nil,
+		},
+		{
+			name: "name, key and length",
+			filter: &filter.Filter{FilterFields: []*filter.FilterField{
+				{FieldName: "input", FieldType: filter.FieldTypeString, Values: []string{"refund"}, QueryType: gptr.Of(filter.QueryTypeMatch)},
+				{FieldName: "key_label", FieldType: filter.FieldTypeString, Values: []string{"refund"}},
+				{FieldName: "len(output)", FieldType: filter.FieldTypeLong, Values: []string{"2000"}, QueryType: gptr.Of(filter.QueryTypeGt)},
+			}},
+			want: &entity.ItemFilter{Conditions: []*entity.ItemFilterCondition{
+				{FieldKey: "key_input", Op: entity.ItemFilterOpMatch, Values: []string{"refund"}},
+				{FieldKey: "key_label", Op: entity.ItemFilterOpEq, Values: []string{"refund"}},
+				{FieldKey: "key_output", Op: entity.ItemFilterOpGt, Operand: entity.ItemFilterOperandLength, Values: []string{"2000"}},
+			}},
+		},
+		{
+			name: "numeric, multi values and sub filter",
+			filter: &filter.Filter{QueryAndOr: gptr.Of(filter.QueryRelationOr), FilterFields: []*filter.FilterField{
+				{FieldName: "output", FieldType: filter.FieldTypeDouble, Values: []string{"0.5"}, QueryType: gptr.Of(filter.QueryTypeGte)},
+				{FieldName: "input", FieldType: filter.FieldTypeString, Values: []string{"a", "b"}, QueryType: gptr.Of(filter.QueryTypeNotMatch)},
+				{SubFilter: &filter.Filter{FilterFields: []*filter.FilterField{
+					{FieldName: "label", FieldType: filter.FieldTypeString, QueryType: gptr.Of(filter.QueryTypeIsNull)},
+				}}},
+			}},
+			want: &entity.ItemFilter{
+				Or: true,
+				Conditions: []*entity.ItemFilterCondition{
+					{FieldKey: "key_output", Op: entity.ItemFilterOpGte, Operand: entity.ItemFilterOperandNumber, Values: []string{"0.5"}},
+				},
+				SubFilters: []*entity.ItemFilter{
+					{Conditions: []*entity.ItemFilterCondition{
+						{FieldKey: "key_input", Op: entity.ItemFilterOpNotMatch, Values: []string{"a"}},
+						{FieldKey: "key_input", Op: entity.ItemFilterOpNotMatch, Values: []string{"b"}},
+					}},
+					{Conditions: []*entity.ItemFilterCondition{{FieldKey: "key_label", Op: entity.ItemFilterOpNotExist}}},
+				},
+			},
+		},
+		{
+			name: "unknown field",
+			filter: &filter.Filter{FilterFields: []*filter.FilterField{
+				{FieldName: "deleted", FieldType: filter.FieldTypeString, Values: []string{"x"}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "unsupported query type",
+			filter: &filter.Filter{FilterFields: []*filter.FilterField{
+				{FieldName: "input", FieldType: filter.FieldTypeString, Values: []string{"x"}, QueryType: gptr.Of("regex")},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "tag",
+			filter: &filter.Filter{FilterFields: []*filter.FilterField{
+				{FieldName: "input", FieldType: filter.FieldTypeTag, Values: []string{"x"}},
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ItemFilterDTO2DO(tt.filter, schema)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
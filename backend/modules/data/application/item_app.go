@@ -157,6 +157,21 @@ func (h *DatasetApplicationImpl) ListDatasetItems(ctx context.Context, req *data
 		return nil, err
 	}
 	defer func() { h.fixTotal(req, resp) }()
+	itemFilter, err := convertor.ItemFilterDTO2DO(req.GetFilter(), ds.Schema)
+	if err != nil {
+		return nil, err
+	}
+	if !itemFilter.IsEmpty() {
+		offloaded, err := h.repo.CountItems(ctx, repo.NewListItemsParamsOfDataset(req.GetWorkspaceID(), req.GetDatasetID(), func(p *repo.ListItemsParams) {
+			p.Offloaded = true
+		}))
+		if err != nil {
+			return nil, err
+		}
+		if err := checkFilterOnOffloadedItems(offloaded); err != nil {
+			return nil, err
+		}
+	}
 	orderBy := &service.OrderBy{}
 	if len(req.GetOrderBys()) != 0 {
 		orderBy = &service.OrderBy{
@@ -171,6 +186,7 @@ func (h *DatasetApplicationImpl) ListDatasetItems(ctx context.Context, req *data
 			pagination.WithOrderByAsc(gptr.Indirect(orderBy.IsAsc)),
 			pagination.WithPrePage(req.PageNumber, req.PageSize, req.PageToken),
 		)
+		p.Filter = itemFilter
 	})
 	items, pr, err := h.repo.ListItems(ctx, query)
 	if err != nil {
@@ -180,10 +196,22 @@ func (h *DatasetApplicationImpl) ListDatasetItems(ctx context.Context, req *data
 		return nil, err
 	}
 
+	var filterTotal *int64
+	if !itemFilter.IsEmpty() {
+		count, err := h.repo.CountItems(ctx, repo.NewListItemsParamsOfDataset(req.GetWorkspaceID(), req.GetDatasetID(), func(p *repo.ListItemsParams) {
+			p.Filter = itemFilter
+		}))
+		if err != nil {
+			return nil, err
+		}
+		filterTotal = gptr.Of(count)
+	}
+
 	service.SanitizeOutputItem(ds.Schema, items)
 	return &dataset.ListDatasetItemsResponse{
 		Items:         gslice.Map(items, convertor.ItemDO2DTO),
 		Total:         gptr.Of(total),
+		FilterTotal:   filterTotal,
 		NextPageToken: gptr.Of(pr.Cursor),
 	}, nil
 }
@@ -199,17 +227,30 @@ func (h *DatasetApplicationImpl) ListDatasetItemsByVersion(ctx context.Context,
 		return nil, errors.WithMessage(err, "repo.GetVersion")
 	}
 
-	items, pr, err := h.listItemsByVersion(ctx, req, version)
+	schema, err := h.repo.GetSchema(ctx, req.GetWorkspaceID(), version.SchemaID)
 	if err != nil {
-		return nil, errors.WithMessage(err, "repo.ListItems")
+		return nil, err
 	}
-	if err := h.svc.LoadItemData(ctx, items...); err != nil {
-		return nil, errors.WithMessage(err, "svc.LoadItemData")
+	itemFilter, err := convertor.ItemFilterDTO2DO(req.GetFilter(), schema)
+	if err != nil {
+		return nil, err
+	}
+	if !itemFilter.IsEmpty() {
+		offloaded, err := h.countItemsByVersion(ctx, req, version, nil, true)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkFilterOnOffloadedItems(offloaded); err != nil {
+			return nil, err
+		}
 	}
 
-	schema, err := h.repo.GetSchema(ctx, req.GetWorkspaceID(), version.SchemaID)
+	items, pr, err := h.listItemsByVersion(ctx, req, version, itemFilter)
 	if err != nil {
-		return nil, err
+		return nil, errors.WithMessage(err, "repo.ListItems")
+	}
+	if err := h.svc.LoadItemData(ctx, items...); err != nil {
+		return nil, errors.WithMessage(err, "svc.LoadItemData")
 	}
 	service.SanitizeOutputItem(schema, items)
 
@@ -217,9 +258,18 @@ func (h *DatasetApplicationImpl) ListDatasetItemsByVersion(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	var filterTotal *int64
+	if !itemFilter.IsEmpty() {
+		count, err := h.countItemsByVersion(ctx, req, version, itemFilter, false)
+		if err != nil {
+			return nil, err
+		}
+		filterTotal = gptr.Of(count)
+	}
 	return &dataset.ListDatasetItemsByVersionResponse{
 		Items:         gslice.Map(items, convertor.ItemDO2DTO),
 		Total:         gptr.Of(total),
+		FilterTotal:   filterTotal,
 		NextPageToken: gptr.Of(pr.Cursor),
 	}, nil
 }
@@ -337,10 +387,10 @@ func (h *DatasetApplicationImpl) fixTotal(req *dataset.ListDatasetItemsRequest,
 	}
 }
 
-func (h *DatasetApplicationImpl) listItemsByVersion(ctx context.Context, req *dataset.ListDatasetItemsByVersionRequest, version *entity.DatasetVersion) ([]*entity.Item, *pagination.PageResult, error) {
+func (h *DatasetApplicationImpl) listItemsByVersion(ctx context.Context, req *dataset.ListDatasetItemsByVersionRequest, version *entity.DatasetVersion, itemFilter *entity.ItemFilter) ([]*entity.Item, *pagination.PageResult, error) {
 	if version.SnapshotStatus == entity.SnapshotStatusCompleted {
 		// list items from snapshot
-		items, pr, err := h.listSnapshotsByVersion(ctx, req)
+		items, pr, err := h.listSnapshotsByVersion(ctx, req, itemFilter)
 		if err == nil {
 			return items, pr, nil
 		}
@@ -349,6 +399,7 @@ func (h *DatasetApplicationImpl) listItemsByVersion(ctx context.Context, req *da
 
 	query := repo.NewListItemsParamsFromVersion(version, func(q *repo.ListItemsParams) {
 		q.Paginator = pagination.New(pagination.WithPrePage(req.PageNumber, req.PageSize, req.PageToken))
+		q.Filter = itemFilter
 	})
 	items, pr, err := h.repo.ListItems(ctx, query)
 	if err != nil {
@@ -357,7 +408,40 @@ func (h *DatasetApplicationImpl) listItemsByVersion(ctx context.Context, req *da
 	return items, pr, nil
 }
 
-func (h *DatasetApplicationImpl) listSnapshotsByVersion(ctx context.Context, req *dataset.ListDatasetItemsByVersionRequest) ([]*entity.Item, *pagination.PageResult, error) {
+// checkFilterOnOffloadedItems 内容筛选只作用于内联存储的 data, 范围内有内容转存至外部存储的条目时拒绝筛选, 避免静默漏掉这些条目
+func checkFilterOnOffloadedItems(offloaded int64) error {
+	if offloaded > 0 {
+		return errno.InvalidParamErrorf("item filter is not supported on datasets with items stored outside the database, %d such items found", offloaded)
+	}
+	return nil
+}
+
+// countItemsByVersion 统计版本下命中筛选的条目数, offloaded 为 true 时只统计内容转存至外部存储的条目, 与 listItemsByVersion 一致优先使用快照
+func (h *DatasetApplicationImpl) countItemsByVersion(ctx context.Context, req *dataset.ListDatasetItemsByVersionRequest, version *entity.DatasetVersion, itemFilter *entity.ItemFilter, offloaded bool) (int64, error) {
+	if version.SnapshotStatus == entity.SnapshotStatusCompleted {
+		count, err := h.repo.CountItemSnapshots(ctx, &repo.ListItemSnapshotsParams{
+			SpaceID:   req.GetWorkspaceID(),
+			VersionID: req.VersionID,
+			Filter:    itemFilter,
+			Offloaded: offloaded,
+		})
+		if err == nil {
+			return count, nil
+		}
+		logs.CtxError(ctx, "count items from snapshot failed, query from item table instead. version_id=%d, err=%v", version.ID, err)
+	}
+
+	count, err := h.repo.CountItems(ctx, repo.NewListItemsParamsFromVersion(version, func(q *repo.ListItemsParams) {
+		q.Filter = itemFilter
+		q.Offloaded = offloaded
+	}))
+	if err != nil {
+		return 0, errors.WithMessage(err, "repo.CountItems")
+	}
+	return count, nil
+}
+
+func (h *DatasetApplicationImpl) listSnapshotsByVersion(ctx context.Context, req *dataset.ListDatasetItemsByVersionRequest, itemFilter *entity.ItemFilter) ([]*entity.Item, *pagination.PageResult, error) {
 	orderBy := &service.OrderBy{}
 	if len(req.GetOrderBys()) != 0 {
 		orderBy = &service.OrderBy{
@@ -374,6 +458,7 @@ func (h *DatasetApplicationImpl) listSnapshotsByVersion(ctx context.Context, req
 		SpaceID:   req.GetWorkspaceID(),
 		VersionID: req.VersionID,
 		Paginator: pg,
+		Filter:    itemFilter,
 	})
 	if err != nil {
 		return nil, nil, err
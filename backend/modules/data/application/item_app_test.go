@@ -16,8 +16,10 @@ import (
 	mock_audit "github.com/coze-dev/coze-loop/backend/infra/external/audit/mocks"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/data/dataset"
 	domain_dataset "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/data/domain/dataset"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/stone/fornax/ml_flow/domain/filter"
 	mock_auth "github.com/coze-dev/coze-loop/backend/modules/data/domain/component/rpc/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/repo"
 	mock_repo "github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/repo/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/service"
 	mock_dataset "github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/service/mocks"
@@ -488,6 +490,167 @@ func TestDatasetApplicationImpl_ListDatasetItemsByVersion(t *testing.T) {
 	}
 }
 
+func TestDatasetApplicationImpl_ListDatasetItems_Filter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mock_auth.NewMockIAuthProvider(ctrl)
+	mockRepo := mock_repo.NewMockIDatasetAPI(ctrl)
+	mockDatasetService := mock_dataset.NewMockIDatasetAPI(ctrl)
+
+	app := &DatasetApplicationImpl{
+		auth: mockAuth,
+		repo: mockRepo,
+		svc:  mockDatasetService,
+	}
+	schema := &entity.DatasetSchema{Fields: []*entity.FieldSchema{{Key: "key_label", Name: "label"}}}
+	labelFilter := &filter.Filter{FilterFields: []*filter.FilterField{
+		{FieldName: "label", FieldType: filter.FieldTypeString, Values: []string{"refund"}},
+	}}
+	wantFilter := &entity.ItemFilter{Conditions: []*entity.ItemFilterCondition{
+		{FieldKey: "key_label", Op: entity.ItemFilterOpEq, Values: []string{"refund"}},
+	}}
+	mockAuthOK := func() {
+		mockRepo.EXPECT().GetDataset(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&entity.Dataset{}, nil)
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).Return(nil)
+	}
+
+	t.Run("草稿按内容筛选", func(t *testing.T) {
+		mockAuthOK()
+		mockDatasetService.EXPECT().GetDataset(gomock.Any(), gomock.Any(), gomock.Any()).Return(&service.DatasetWithSchema{Dataset: &entity.Dataset{}, Schema: schema}, nil)
+		mockRepo.EXPECT().GetItemCount(gomock.Any(), gomock.Any()).Return(int64(10), nil)
+		mockRepo.EXPECT().CountItems(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, params *repo.ListItemsParams, _ ...repo.Option) (int64, error) {
+			assert.True(t, params.Offloaded)
+			assert.Nil(t, params.Filter)
+			return 0, nil
+		})
+		mockRepo.EXPECT().ListItems(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, params *repo.ListItemsParams, _ ...repo.Option) ([]*entity.Item, *pagination.PageResult, error) {
+			assert.Equal(t, wantFilter, params.Filter)
+			return []*entity.Item{{}}, &pagination.PageResult{}, nil
+		})
+		mockDatasetService.EXPECT().LoadItemData(gomock.Any(), gomock.Any()).Return(nil)
+		mockRepo.EXPECT().CountItems(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, params *repo.ListItemsParams, _ ...repo.Option) (int64, error) {
+			assert.Equal(t, wantFilter, params.Filter)
+			assert.False(t, params.Offloaded)
+			assert.Nil(t, params.Paginator)
+			return 3, nil
+		})
+
+		resp, err := app.ListDatasetItems(context.Background(), &dataset.ListDatasetItemsRequest{
+			WorkspaceID: gptr.Of(int64(1)),
+			DatasetID:   1,
+			Filter:      labelFilter,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), resp.GetTotal())
+		assert.Equal(t, int64(3), resp.GetFilterTotal())
+	})
+
+	t.Run("草稿筛选字段不存在", func(t *testing.T) {
+		mockAuthOK()
+		mockDatasetService.EXPECT().GetDataset(gomock.Any(), gomock.Any(), gomock.Any()).Return(&service.DatasetWithSchema{Dataset: &entity.Dataset{}, Schema: schema}, nil)
+		mockRepo.EXPECT().GetItemCount(gomock.Any(), gomock.Any()).Return(int64(10), nil)
+
+		_, err := app.ListDatasetItems(context.Background(), &dataset.ListDatasetItemsRequest{
+			WorkspaceID: gptr.Of(int64(1)),
+			DatasetID:   1,
+			Filter: &filter.Filter{FilterFields: []*filter.FilterField{
+				{FieldName: "unknown", FieldType: filter.FieldTypeString, Values: []string{"x"}},
+			}},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("草稿含外部存储条目时拒绝筛选", func(t *testing.T) {
+		mockAuthOK()
+		mockDatasetService.EXPECT().GetDataset(gomock.Any(), gomock.Any(), gomock.Any()).Return(&service.DatasetWithSchema{Dataset: &entity.Dataset{}, Schema: schema}, nil)
+		mockRepo.EXPECT().GetItemCount(gomock.Any(), gomock.Any()).Return(int64(10), nil)
+		mockRepo.EXPECT().CountItems(gomock.Any(), gomock.Any()).Return(int64(2), nil)
+
+		_, err := app.ListDatasetItems(context.Background(), &dataset.ListDatasetItemsRequest{
+			WorkspaceID: gptr.Of(int64(1)),
+			DatasetID:   1,
+			Filter:      labelFilter,
+		})
+		assert.ErrorContains(t, err, "stored outside the database")
+	})
+
+	t.Run("版本快照按内容筛选", func(t *testing.T) {
+		mockAuthOK()
+		mockRepo.EXPECT().GetVersion(gomock.Any(), gomock.Any(), gomock.Any()).Return(&entity.DatasetVersion{ID: 1, SchemaID: 100, SnapshotStatus: entity.SnapshotStatusCompleted}, nil)
+		mockRepo.EXPECT().GetSchema(gomock.Any(), gomock.Any(), gomock.Any()).Return(schema, nil)
+		mockRepo.EXPECT().CountItemSnapshots(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, params *repo.ListItemSnapshotsParams, _ ...repo.Option) (int64, error) {
+			assert.True(t, params.Offloaded)
+			return 0, nil
+		})
+		mockRepo.EXPECT().ListItemSnapshots(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, params *repo.ListItemSnapshotsParams, _ ...repo.Option) ([]*entity.ItemSnapshot, *pagination.PageResult, error) {
+			assert.Equal(t, wantFilter, params.Filter)
+			return []*entity.ItemSnapshot{{Snapshot: &entity.Item{}}}, &pagination.PageResult{}, nil
+		})
+		mockDatasetService.EXPECT().LoadItemData(gomock.Any(), gomock.Any()).Return(nil)
+		mockDatasetService.EXPECT().GetOrSetItemCountOfVersion(gomock.Any(), gomock.Any()).Return(int64(10), nil)
+		mockRepo.EXPECT().CountItemSnapshots(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, params *repo.ListItemSnapshotsParams, _ ...repo.Option) (int64, error) {
+			assert.Equal(t, wantFilter, params.Filter)
+			return 2, nil
+		})
+
+		resp, err := app.ListDatasetItemsByVersion(context.Background(), &dataset.ListDatasetItemsByVersionRequest{
+			WorkspaceID: gptr.Of(int64(1)),
+			DatasetID:   1,
+			VersionID:   1,
+			Filter:      labelFilter,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), resp.GetTotal())
+		assert.Equal(t, int64(2), resp.GetFilterTotal())
+	})
+
+	t.Run("版本快照统计失败回退条目表", func(t *testing.T) {
+		mockAuthOK()
+		mockRepo.EXPECT().GetVersion(gomock.Any(), gomock.Any(), gomock.Any()).Return(&entity.DatasetVersion{ID: 1, SchemaID: 100, VersionNum: 2, SnapshotStatus: entity.SnapshotStatusCompleted}, nil)
+		mockRepo.EXPECT().GetSchema(gomock.Any(), gomock.Any(), gomock.Any()).Return(schema, nil)
+		mockRepo.EXPECT().CountItemSnapshots(gomock.Any(), gomock.Any()).Return(int64(0), errors.New("snapshot error")).Times(2)
+		mockRepo.EXPECT().CountItems(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, params *repo.ListItemsParams, _ ...repo.Option) (int64, error) {
+			assert.True(t, params.Offloaded)
+			assert.Equal(t, int64(2), params.AddVNLte)
+			return 0, nil
+		})
+		mockRepo.EXPECT().ListItemSnapshots(gomock.Any(), gomock.Any()).Return(nil, nil, errors.New("snapshot error"))
+		mockRepo.EXPECT().ListItems(gomock.Any(), gomock.Any()).Return([]*entity.Item{{}}, &pagination.PageResult{}, nil)
+		mockDatasetService.EXPECT().LoadItemData(gomock.Any(), gomock.Any()).Return(nil)
+		mockDatasetService.EXPECT().GetOrSetItemCountOfVersion(gomock.Any(), gomock.Any()).Return(int64(10), nil)
+		mockRepo.EXPECT().CountItems(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, params *repo.ListItemsParams, _ ...repo.Option) (int64, error) {
+			assert.Equal(t, wantFilter, params.Filter)
+			assert.Equal(t, int64(2), params.AddVNLte)
+			return 4, nil
+		})
+
+		resp, err := app.ListDatasetItemsByVersion(context.Background(), &dataset.ListDatasetItemsByVersionRequest{
+			WorkspaceID: gptr.Of(int64(1)),
+			DatasetID:   1,
+			VersionID:   1,
+			Filter:      labelFilter,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), resp.GetFilterTotal())
+	})
+
+	t.Run("版本含外部存储条目时拒绝筛选", func(t *testing.T) {
+		mockAuthOK()
+		mockRepo.EXPECT().GetVersion(gomock.Any(), gomock.Any(), gomock.Any()).Return(&entity.DatasetVersion{ID: 1, SchemaID: 100, SnapshotStatus: entity.SnapshotStatusCompleted}, nil)
+		mockRepo.EXPECT().GetSchema(gomock.Any(), gomock.Any(), gomock.Any()).Return(schema, nil)
+		mockRepo.EXPECT().CountItemSnapshots(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+		_, err := app.ListDatasetItemsByVersion(context.Background(), &dataset.ListDatasetItemsByVersionRequest{
+			WorkspaceID: gptr.Of(int64(1)),
+			DatasetID:   1,
+			VersionID:   1,
+			Filter:      labelFilter,
+		})
+		assert.ErrorContains(t, err, "stored outside the database")
+	})
+}
+
 // ... existing code ...
 
 func TestDatasetApplicationImpl_BatchGetDatasetItemsByVersion(t *testing.T) {
@@ -0,0 +1,61 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+// ItemFilterOp 条目内容筛选的比较方式
+type ItemFilterOp string
+
+const (
+	ItemFilterOpMatch    ItemFilterOp = "match"     // 内容包含
+	ItemFilterOpNotMatch ItemFilterOp = "not_match" // 内容不包含, 字段不存在也视为命中
+	ItemFilterOpEq       ItemFilterOp = "eq"
+	ItemFilterOpNotEq    ItemFilterOp = "not_eq" // 字段不存在也视为命中
+	ItemFilterOpIn       ItemFilterOp = "in"
+	ItemFilterOpNotIn    ItemFilterOp = "not_in" // 字段不存在也视为命中
+	ItemFilterOpGt       ItemFilterOp = "gt"
+	ItemFilterOpGte      ItemFilterOp = "gte"
+	ItemFilterOpLt       ItemFilterOp = "lt"
+	ItemFilterOpLte      ItemFilterOp = "lte"
+	ItemFilterOpExist    ItemFilterOp = "exist"
+	ItemFilterOpNotExist ItemFilterOp = "not_exist"
+)
+
+// ItemFilterOperand 比较对象
+type ItemFilterOperand string
+
+const (
+	ItemFilterOperandContent ItemFilterOperand = ""       // 按字符串比较内容
+	ItemFilterOperandNumber  ItemFilterOperand = "number" // 将内容转为数值后比较
+	ItemFilterOperandLength  ItemFilterOperand = "length" // 比较内容的字符数
+)
+
+// ItemFilterCondition 对单个字段内容的筛选条件
+type ItemFilterCondition struct {
+	FieldKey string // 字段 key, 与 FieldData.Key 对应
+	Op       ItemFilterOp
+	Operand  ItemFilterOperand
+	Values   []string // in/not_in 取全部, exist/not_exist 不取, 其余取第一个
+}
+
+// ItemFilter 条目内容筛选, Conditions 与 SubFilters 之间按 Or 决定且/或
+type ItemFilter struct {
+	Or         bool
+	Conditions []*ItemFilterCondition
+	SubFilters []*ItemFilter
+}
+
+func (f *ItemFilter) IsEmpty() bool {
+	if f == nil {
+		return true
+	}
+	if len(f.Conditions) > 0 {
+		return false
+	}
+	for _, sub := range f.SubFilters {
+		if !sub.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
@@ -34,6 +34,10 @@ type ListItemsParams struct {
 	AddVNLte  int64
 	DelVNGt   int64
 	ItemIDGt  int64
+	// Filter 按条目内容筛选, 只作用于内联存储的 data, 内容转存至外部存储的条目不会命中
+	Filter *entity.ItemFilter
+	// Offloaded 只取内容转存至外部存储的条目
+	Offloaded bool
 }
 
 func NewListItemsParamsFromVersion(version *entity.DatasetVersion, taps ...func(*ListItemsParams)) *ListItemsParams {
@@ -75,6 +79,8 @@ type ListItemSnapshotsParams struct {
 	Paginator *pagination.Paginator
 	SpaceID   int64 `validate:"required,gt=0"`
 	VersionID int64 `validate:"required,gt=0"` // index, must set
+	Filter    *entity.ItemFilter
+	Offloaded bool // 只取内容转存至外部存储的条目
 }
 
 type DeltaDatasetIOJob struct {
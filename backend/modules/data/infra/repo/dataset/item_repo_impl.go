@@ -30,6 +30,8 @@ func (d *DatasetRepo) CountItems(ctx context.Context, params *repo.ListItemsPara
 		AddVNLte:  params.AddVNLte,
 		DelVNGt:   params.DelVNGt,
 		ItemIDGt:  params.ItemIDGt,
+		Filter:    params.Filter,
+		Offloaded: params.Offloaded,
 	}
 	return d.itemDAO.CountItems(ctx, daoParam, Opt2DBOpt(opt...)...)
 }
@@ -71,6 +73,8 @@ func (d *DatasetRepo) ListItems(ctx context.Context, params *repo.ListItemsParam
 		AddVNLte:  params.AddVNLte,
 		DelVNGt:   params.DelVNGt,
 		ItemIDGt:  params.ItemIDGt,
+		Filter:    params.Filter,
+		Offloaded: params.Offloaded,
 	}
 	items, p, err := d.itemDAO.ListItems(ctx, daoParam, Opt2DBOpt(opt...)...)
 	if err != nil {
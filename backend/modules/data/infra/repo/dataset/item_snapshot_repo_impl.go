@@ -29,6 +29,8 @@ func (d *DatasetRepo) ListItemSnapshots(ctx context.Context, params *repo.ListIt
 		Paginator: params.Paginator,
 		SpaceID:   params.SpaceID,
 		VersionID: params.VersionID,
+		Filter:    params.Filter,
+		Offloaded: params.Offloaded,
 	}
 	pos, p, err := d.itemSnapshotDAO.ListItemSnapshots(ctx, daoParam, Opt2DBOpt(opt...)...)
 	if err != nil {
@@ -46,6 +48,8 @@ func (d *DatasetRepo) CountItemSnapshots(ctx context.Context, params *repo.ListI
 		Paginator: params.Paginator,
 		SpaceID:   params.SpaceID,
 		VersionID: params.VersionID,
+		Filter:    params.Filter,
+		Offloaded: params.Offloaded,
 	}
 	return d.itemSnapshotDAO.CountItemSnapshots(ctx, daoParam, Opt2DBOpt(opt...)...)
 }
@@ -62,6 +62,8 @@ type ListItemsParams struct {
 	AddVNLte  int64
 	DelVNGt   int64
 	ItemIDGt  int64
+	Filter    *entity.ItemFilter
+	Offloaded bool
 }
 
 func (p *ListItemsParams) toWhere() (*clause.Where, error) {
@@ -80,6 +82,16 @@ func (p *ListItemsParams) toWhere() (*clause.Where, error) {
 	db.MaybeAddLteToWhere(b, p.AddVNLte, `add_vn`)
 	db.MaybeAddGtToWhere(b, p.DelVNGt, `del_vn`)
 	db.MaybeAddGtToWhere(b, p.ItemIDGt, `item_id`)
+	if !p.Filter.IsEmpty() {
+		expr, err := buildItemFilterExpr(p.Filter)
+		if err != nil {
+			return nil, err
+		}
+		b.AddWhere(expr)
+	}
+	if p.Offloaded {
+		b.AddWhere(clause.Expr{SQL: itemOffloadedCond})
+	}
 
 	return b.Build()
 }
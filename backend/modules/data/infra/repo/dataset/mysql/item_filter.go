@@ -0,0 +1,167 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/data/pkg/errno"
+)
+
+// itemFieldPath 定位 data 列 ([]FieldData 的 JSON 数组) 中 key 等于参数的元素, 返回形如 $[0] 的路径, 字段不存在时为 NULL
+const itemFieldPath = "SUBSTRING_INDEX(JSON_UNQUOTE(JSON_SEARCH(`data`, 'one', ?, NULL, '$[*].key')), '.', 1)"
+
+// itemFieldContent 取对应元素的 content, 字段不存在时为 NULL
+const itemFieldContent = "JSON_UNQUOTE(JSON_EXTRACT(`data`, CONCAT(" + itemFieldPath + ", '.content')))"
+
+// itemOffloadedCond 内容转存至外部存储的条目, data 列为空, 内容筛选无法命中
+const itemOffloadedCond = "JSON_EXTRACT(`data_properties`, '$.storage_key') IS NOT NULL"
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// buildItemFilterExpr 将内容筛选翻译为 dataset_item/dataset_item_snapshot 的 data 列上的 where 条件,
+// 只能筛选内联存储的内容, 调用方需拒绝对含外部存储条目的范围做筛选
+func buildItemFilterExpr(f *entity.ItemFilter) (clause.Expression, error) {
+	sql, vars, err := buildItemFilterSQL(f)
+	if err != nil {
+		return nil, err
+	}
+	return clause.Expr{SQL: sql, Vars: vars}, nil
+}
+
+func buildItemFilterSQL(f *entity.ItemFilter) (string, []any, error) {
+	var (
+		parts []string
+		vars  []any
+	)
+	for _, cond := range f.Conditions {
+		sql, condVars, err := buildItemFilterConditionSQL(cond)
+		if err != nil {
+			return "", nil, err
+		}
+		parts = append(parts, sql)
+		vars = append(vars, condVars...)
+	}
+	for _, sub := range f.SubFilters {
+		if sub.IsEmpty() {
+			continue
+		}
+		sql, subVars, err := buildItemFilterSQL(sub)
+		if err != nil {
+			return "", nil, err
+		}
+		parts = append(parts, sql)
+		vars = append(vars, subVars...)
+	}
+	sep := " AND "
+	if f.Or {
+		sep = " OR "
+	}
+	return "(" + strings.Join(parts, sep) + ")", vars, nil
+}
+
+func buildItemFilterConditionSQL(cond *entity.ItemFilterCondition) (string, []any, error) {
+	if cond == nil || cond.FieldKey == "" {
+		return "", nil, errno.InvalidParamErrorf("item filter field key is required")
+	}
+	// JSON_SEARCH 的搜索串支持通配符, key 需转义后精确匹配
+	key := likeEscaper.Replace(cond.FieldKey)
+
+	switch cond.Op {
+	case entity.ItemFilterOpExist:
+		return itemFieldPath + " IS NOT NULL", []any{key}, nil
+	case entity.ItemFilterOpNotExist:
+		return itemFieldPath + " IS NULL", []any{key}, nil
+	}
+	if len(cond.Values) == 0 {
+		return "", nil, errno.InvalidParamErrorf("item filter on field %s requires values", cond.FieldKey)
+	}
+
+	column, err := itemFilterOperandColumn(cond.Operand)
+	if err != nil {
+		return "", nil, err
+	}
+	values := make([]any, 0, len(cond.Values))
+	for _, v := range cond.Values {
+		pv, err := parseItemFilterValue(cond.Operand, v)
+		if err != nil {
+			return "", nil, errno.InvalidParamErrorf("item filter on field %s: %v", cond.FieldKey, err)
+		}
+		values = append(values, pv)
+	}
+	// 取反的条件对字段不存在的条目同样命中
+	negate := func(predicate string, value any) (string, []any) {
+		return fmt.Sprintf("(%s IS NULL OR %s)", itemFieldContent, predicate), []any{key, key, value}
+	}
+
+	switch cond.Op {
+	case entity.ItemFilterOpMatch, entity.ItemFilterOpNotMatch:
+		if cond.Operand != entity.ItemFilterOperandContent {
+			return "", nil, errno.InvalidParamErrorf("item filter on field %s: %s only applies to content", cond.FieldKey, cond.Op)
+		}
+		pattern := "%" + likeEscaper.Replace(cond.Values[0]) + "%"
+		if cond.Op == entity.ItemFilterOpNotMatch {
+			sql, vars := negate(column+" NOT LIKE ? ESCAPE '\\\\'", pattern)
+			return sql, vars, nil
+		}
+		return column + " LIKE ? ESCAPE '\\\\'", []any{key, pattern}, nil
+	case entity.ItemFilterOpEq:
+		return column + " = ?", []any{key, values[0]}, nil
+	case entity.ItemFilterOpNotEq:
+		sql, vars := negate(column+" <> ?", values[0])
+		return sql, vars, nil
+	case entity.ItemFilterOpIn:
+		return column + " IN ?", []any{key, values}, nil
+	case entity.ItemFilterOpNotIn:
+		sql, vars := negate(column+" NOT IN ?", values)
+		return sql, vars, nil
+	case entity.ItemFilterOpGt:
+		return column + " > ?", []any{key, values[0]}, nil
+	case entity.ItemFilterOpGte:
+		return column + " >= ?", []any{key, values[0]}, nil
+	case entity.ItemFilterOpLt:
+		return column + " < ?", []any{key, values[0]}, nil
+	case entity.ItemFilterOpLte:
+		return column + " <= ?", []any{key, values[0]}, nil
+	default:
+		return "", nil, errno.InvalidParamErrorf("item filter on field %s: unsupported op %s", cond.FieldKey, cond.Op)
+	}
+}
+
+func itemFilterOperandColumn(operand entity.ItemFilterOperand) (string, error) {
+	switch operand {
+	case entity.ItemFilterOperandContent:
+		return itemFieldContent, nil
+	case entity.ItemFilterOperandNumber:
+		return "CAST(" + itemFieldContent + " AS DECIMAL(65,10))", nil
+	case entity.ItemFilterOperandLength:
+		return "CHAR_LENGTH(" + itemFieldContent + ")", nil
+	default:
+		return "", errno.InvalidParamErrorf("unsupported item filter operand %s", operand)
+	}
+}
+
+func parseItemFilterValue(operand entity.ItemFilterOperand, v string) (any, error) {
+	switch operand {
+	case entity.ItemFilterOperandNumber:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return nil, fmt.Errorf("value %q is not a number", v)
+		}
+		return f, nil
+	case entity.ItemFilterOperandLength:
+		n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value %q is not an integer", v)
+		}
+		return n, nil
+	default:
+		return v, nil
+	}
+}
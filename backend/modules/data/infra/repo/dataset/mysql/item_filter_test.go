@@ -0,0 +1,134 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/clause"
+
+	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/entity"
+)
+
+func TestBuildItemFilterExpr(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   *entity.ItemFilter
+		wantSQL  []string
+		wantVars []any
+		wantErr  bool
+	}{
+		{
+			name:     "match escapes wildcard",
+			filter:   &entity.ItemFilter{Conditions: []*entity.ItemFilterCondition{{FieldKey: "input", Op: entity.ItemFilterOpMatch, Values: []string{"50%_off"}}}},
+			wantSQL:  []string{itemFieldContent + " LIKE ?"},
+			wantVars: []any{"input", `%50\%\_off%`},
+		},
+		{
+			name:     "not eq hits missing field",
+			filter:   &entity.ItemFilter{Conditions: []*entity.ItemFilterCondition{{FieldKey: "label", Op: entity.ItemFilterOpNotEq, Values: []string{"refund"}}}},
+			wantSQL:  []string{"(" + itemFieldContent + " IS NULL OR " + itemFieldContent + " <> ?)"},
+			wantVars: []any{"label", "label", "refund"},
+		},
+		{
+			name:     "length",
+			filter:   &entity.ItemFilter{Conditions: []*entity.ItemFilterCondition{{FieldKey: "output", Op: entity.ItemFilterOpGt, Operand: entity.ItemFilterOperandLength, Values: []string{"2000"}}}},
+			wantSQL:  []string{"CHAR_LENGTH(" + itemFieldContent + ") > ?"},
+			wantVars: []any{"output", int64(2000)},
+		},
+		{
+			name:     "number",
+			filter:   &entity.ItemFilter{Conditions: []*entity.ItemFilterCondition{{FieldKey: "score", Op: entity.ItemFilterOpLte, Operand: entity.ItemFilterOperandNumber, Values: []string{"0.5"}}}},
+			wantSQL:  []string{"CAST(" + itemFieldContent + " AS DECIMAL(65,10)) <= ?"},
+			wantVars: []any{"score", 0.5},
+		},
+		{
+			name: "or with sub filter",
+			filter: &entity.ItemFilter{
+				Or:         true,
+				Conditions: []*entity.ItemFilterCondition{{FieldKey: "label", Op: entity.ItemFilterOpIn, Values: []string{"refund", "return"}}},
+				SubFilters: []*entity.ItemFilter{
+					{Conditions: []*entity.ItemFilterCondition{{FieldKey: "label_v2", Op: entity.ItemFilterOpNotExist}}},
+					{},
+				},
+			},
+			wantSQL:  []string{" IN ? OR (" + itemFieldPath + " IS NULL))"},
+			wantVars: []any{"label", []any{"refund", "return"}, `label\_v2`},
+		},
+		{
+			name:    "length requires integer",
+			filter:  &entity.ItemFilter{Conditions: []*entity.ItemFilterCondition{{FieldKey: "output", Op: entity.ItemFilterOpGt, Operand: entity.ItemFilterOperandLength, Values: []string{"long"}}}},
+			wantErr: true,
+		},
+		{
+			name:    "match on length",
+			filter:  &entity.ItemFilter{Conditions: []*entity.ItemFilterCondition{{FieldKey: "output", Op: entity.ItemFilterOpMatch, Operand: entity.ItemFilterOperandLength, Values: []string{"1"}}}},
+			wantErr: true,
+		},
+		{
+			name:    "missing values",
+			filter:  &entity.ItemFilter{Conditions: []*entity.ItemFilterCondition{{FieldKey: "output", Op: entity.ItemFilterOpEq}}},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported op",
+			filter:  &entity.ItemFilter{Conditions: []*entity.ItemFilterCondition{{FieldKey: "output", Op: "is_null", Values: []string{"1"}}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildItemFilterExpr(tt.filter)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			expr, ok := got.(clause.Expr)
+			require.True(t, ok)
+			for _, s := range tt.wantSQL {
+				assert.Contains(t, expr.SQL, s)
+			}
+			assert.Equal(t, tt.wantVars, expr.Vars)
+			assert.Equal(t, len(expr.Vars), strings.Count(expr.SQL, "?"))
+		})
+	}
+}
+
+func TestListItemsParams_toWhere_Filter(t *testing.T) {
+	p := &ListItemsParams{
+		SpaceID:   1,
+		DatasetID: 2,
+		Filter:    &entity.ItemFilter{Conditions: []*entity.ItemFilterCondition{{FieldKey: "label", Op: entity.ItemFilterOpEq, Values: []string{"refund"}}}},
+	}
+	where, err := p.toWhere()
+	require.NoError(t, err)
+	assert.Len(t, where.Exprs, 3)
+
+	p.Filter = &entity.ItemFilter{Conditions: []*entity.ItemFilterCondition{{Op: entity.ItemFilterOpEq, Values: []string{"refund"}}}}
+	_, err = p.toWhere()
+	assert.Error(t, err)
+
+	snapshotParams := &ListItemSnapshotsParams{SpaceID: 1, VersionID: 2, Filter: &entity.ItemFilter{}}
+	where, err = snapshotParams.toWhere()
+	require.NoError(t, err)
+	assert.Len(t, where.Exprs, 2)
+}
+
+func TestListItemsParams_toWhere_Offloaded(t *testing.T) {
+	p := &ListItemsParams{SpaceID: 1, DatasetID: 2, Offloaded: true}
+	where, err := p.toWhere()
+	require.NoError(t, err)
+	require.Len(t, where.Exprs, 3)
+	assert.Equal(t, clause.Expr{SQL: itemOffloadedCond}, where.Exprs[2])
+
+	snapshotParams := &ListItemSnapshotsParams{SpaceID: 1, VersionID: 2, Offloaded: true}
+	where, err = snapshotParams.toWhere()
+	require.NoError(t, err)
+	require.Len(t, where.Exprs, 3)
+	assert.Equal(t, clause.Expr{SQL: itemOffloadedCond}, where.Exprs[2])
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/infra/db"
 	"github.com/coze-dev/coze-loop/backend/infra/platestwrite"
 	"github.com/coze-dev/coze-loop/backend/infra/redis"
+	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/entity"
 	"github.com/coze-dev/coze-loop/backend/modules/data/infra/repo/dataset/mysql/gorm_gen/model"
 	"github.com/coze-dev/coze-loop/backend/modules/data/pkg/errno"
 	"github.com/coze-dev/coze-loop/backend/modules/data/pkg/pagination"
@@ -40,6 +41,8 @@ type ListItemSnapshotsParams struct {
 	Paginator *pagination.Paginator
 	SpaceID   int64 `validate:"required,gt=0"`
 	VersionID int64 `validate:"required,gt=0"` // index, must set
+	Filter    *entity.ItemFilter
+	Offloaded bool
 }
 
 func (p *ListItemSnapshotsParams) toWhere() (*clause.Where, error) {
@@ -51,6 +54,16 @@ func (p *ListItemSnapshotsParams) toWhere() (*clause.Where, error) {
 
 	db.MaybeAddEqToWhere(b, p.SpaceID, `space_id`)
 	db.MaybeAddEqToWhere(b, p.VersionID, `version_id`, db.WhereWithIndex)
+	if !p.Filter.IsEmpty() {
+		expr, err := buildItemFilterExpr(p.Filter)
+		if err != nil {
+			return nil, err
+		}
+		b.AddWhere(expr)
+	}
+	if p.Offloaded {
+		b.AddWhere(clause.Expr{SQL: itemOffloadedCond})
+	}
 
 	return b.Build()
 }
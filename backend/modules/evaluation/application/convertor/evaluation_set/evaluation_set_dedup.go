@@ -0,0 +1,108 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package evaluation_set
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	domain_common "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/common"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/eval_set"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func DedupConfDTO2DO(dto *eval_set.EvaluationSetDedupConf) *entity.EvaluationSetDedupConf {
+	if dto == nil {
+		return nil
+	}
+	return &entity.EvaluationSetDedupConf{
+		FieldNames:  dto.FieldNames,
+		Method:      entity.EvaluationSetDedupMethod(dto.GetMethod()),
+		Threshold:   dto.GetThreshold(),
+		ShingleSize: int(dto.GetShingleSize()),
+		ExactOnly:   dto.GetExactOnly(),
+	}
+}
+
+func DedupConfDO2DTO(do *entity.EvaluationSetDedupConf) *eval_set.EvaluationSetDedupConf {
+	if do == nil {
+		return nil
+	}
+	dto := &eval_set.EvaluationSetDedupConf{
+		FieldNames: do.FieldNames,
+		Method:     gptr.Of(string(do.GetMethod())),
+		Threshold:  gptr.Of(do.GetThreshold()),
+		ExactOnly:  gptr.Of(do.ExactOnly),
+	}
+	if do.GetMethod() == entity.EvaluationSetDedupMethod_MinHash {
+		dto.ShingleSize = gptr.Of(int32(do.GetShingleSize()))
+	}
+	return dto
+}
+
+func DedupReportDO2DTO(do *entity.EvaluationSetDedupReport) *eval_set.EvaluationSetDedupReport {
+	if do == nil {
+		return nil
+	}
+	clusters := make([]*eval_set.EvaluationSetDuplicateCluster, 0, len(do.Clusters))
+	for _, c := range do.Clusters {
+		if c == nil {
+			continue
+		}
+		clusters = append(clusters, &eval_set.EvaluationSetDuplicateCluster{
+			Kind:             gptr.Of(string(c.Kind)),
+			KeepItemID:       gptr.Of(c.KeepItemID),
+			DuplicateItemIds: c.DuplicateItemIDs,
+			Similarity:       gptr.Of(c.Similarity),
+		})
+	}
+	return &eval_set.EvaluationSetDedupReport{
+		ScannedItemCnt:    gptr.Of(int32(do.ScannedItemCnt)),
+		SkippedItemCnt:    gptr.Of(int32(do.SkippedItemCnt)),
+		Truncated:         gptr.Of(do.Truncated),
+		ExactDuplicateCnt: gptr.Of(int32(do.ExactDuplicateCnt)),
+		NearDuplicateCnt:  gptr.Of(int32(do.NearDuplicateCnt)),
+		Clusters:          clusters,
+	}
+}
+
+func DedupJobDO2DTO(do *entity.EvaluationSetDedupJob) *eval_set.EvaluationSetDedupJob {
+	if do == nil {
+		return nil
+	}
+	dto := &eval_set.EvaluationSetDedupJob{
+		JobID:           do.ID,
+		WorkspaceID:     do.SpaceID,
+		EvaluationSetID: do.EvaluationSetID,
+		Status:          DedupJobStatusDO2DTO(do.Status),
+		Conf:            DedupConfDO2DTO(do.Conf),
+		Report:          DedupReportDO2DTO(do.Report),
+		BaseInfo: &domain_common.BaseInfo{
+			CreatedBy: &domain_common.UserInfo{
+				UserID: gptr.Of(do.CreatedBy),
+			},
+			CreatedAt: gptr.Of(do.CreatedAt.Unix()),
+			UpdatedAt: gptr.Of(do.UpdatedAt.Unix()),
+		},
+	}
+	if do.VersionID > 0 {
+		dto.VersionID = gptr.Of(do.VersionID)
+	}
+	if len(do.ErrMsg) > 0 {
+		dto.ErrorMsg = gptr.Of(do.ErrMsg)
+	}
+	return dto
+}
+
+func DedupJobStatusDO2DTO(status entity.EvaluationSetDedupJobStatus) eval_set.EvaluationSetDedupJobStatus {
+	switch status {
+	case entity.EvaluationSetDedupJobStatus_Running:
+		return eval_set.EvaluationSetDedupJobStatusRunning
+	case entity.EvaluationSetDedupJobStatus_Success:
+		return eval_set.EvaluationSetDedupJobStatusSuccess
+	case entity.EvaluationSetDedupJobStatus_Failed:
+		return eval_set.EvaluationSetDedupJobStatusFailed
+	default:
+		return eval_set.EvaluationSetDedupJobStatusUnknown
+	}
+}
@@ -114,6 +114,7 @@ func ItemDO2DTO(do *entity.EvaluationSetItem) *eval_set.EvaluationSetItem {
 		Turns:           TurnDO2DTOs(do.Turns),
 		BaseInfo:        common.ConvertBaseInfoDO2DTO(do.BaseInfo),
 		Tags:            ResourceTagDO2DTOs(do.Tags),
+		Provenance:      ProvenanceDO2DTO(do.Provenance),
 	}
 }
 
@@ -0,0 +1,104 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package evaluation_set
+
+import (
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/eval_set"
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	eval_set_svc "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/eval_set"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func ProvenanceFilterDTO2DO(dto *eval_set.EvaluationSetItemProvenanceFilter) *entity.EvaluationSetItemProvenanceFilter {
+	if dto == nil {
+		return nil
+	}
+	sourceTypes := make([]entity.EvaluationSetItemSourceType, 0, len(dto.SourceTypes))
+	for _, t := range dto.SourceTypes {
+		sourceTypes = append(sourceTypes, entity.EvaluationSetItemSourceType(t))
+	}
+	return &entity.EvaluationSetItemProvenanceFilter{
+		SourceTypes:   sourceTypes,
+		TraceIDs:      dto.TraceIds,
+		ImportJobIDs:  dto.ImportJobIds,
+		SourceExptIDs: dto.SourceExptIds,
+		Authors:       dto.Authors,
+	}
+}
+
+func ProvenanceDO2DTO(do *entity.EvaluationSetItemProvenance) *eval_set.EvaluationSetItemProvenance {
+	if do == nil {
+		return nil
+	}
+	dto := &eval_set.EvaluationSetItemProvenance{
+		ItemID:     gptr.Of(do.ItemID),
+		SourceType: gptr.Of(string(do.SourceType)),
+		Author:     gptr.Of(do.Author),
+		UpdatedBy:  gptr.Of(do.UpdatedBy),
+		CreatedAt:  unixPtr(do.CreatedAt),
+		UpdatedAt:  unixPtr(do.UpdatedAt),
+	}
+	if len(do.TraceID) > 0 {
+		dto.TraceID = gptr.Of(do.TraceID)
+		dto.SpanID = gptr.Of(do.SpanID)
+	}
+	if do.ImportJobID > 0 {
+		dto.ImportJobID = gptr.Of(do.ImportJobID)
+	}
+	if do.SourceExptID > 0 {
+		dto.SourceExptID = gptr.Of(do.SourceExptID)
+	}
+	if do.SourceVersionID > 0 {
+		dto.SourceVersionID = gptr.Of(do.SourceVersionID)
+	}
+	return dto
+}
+
+func ItemUsageDO2DTOs(dos []*entity.EvaluationSetItemUsage) []*eval_set_svc.EvaluationSetItemUsage {
+	if dos == nil {
+		return nil
+	}
+	dtos := make([]*eval_set_svc.EvaluationSetItemUsage, 0, len(dos))
+	for _, do := range dos {
+		if do == nil {
+			continue
+		}
+		dto := &eval_set_svc.EvaluationSetItemUsage{
+			ExptID:           gptr.Of(do.ExptID),
+			ExptName:         gptr.Of(do.ExptName),
+			ExptStatus:       gptr.Of(domain_expt.ExptStatus(do.ExptStatus)),
+			EvalSetVersionID: gptr.Of(do.EvalSetVersionID),
+			ItemStatus:       gptr.Of(domain_expt.ItemRunState(do.ItemStatus)),
+			StartAt:          unixPtr(do.StartAt),
+			FinishedAt:       unixPtr(do.FinishedAt),
+			TurnScores:       make([]*eval_set_svc.EvaluationSetItemTurnScore, 0, len(do.TurnScores)),
+		}
+		if do.ItemVersionID > 0 {
+			dto.ItemVersionID = gptr.Of(do.ItemVersionID)
+		}
+		for _, score := range do.TurnScores {
+			if score == nil {
+				continue
+			}
+			dto.TurnScores = append(dto.TurnScores, &eval_set_svc.EvaluationSetItemTurnScore{
+				TurnID:          gptr.Of(score.TurnID),
+				WeightedScore:   score.WeightedScore,
+				EvaluatorScores: score.EvaluatorScores,
+			})
+		}
+		dtos = append(dtos, dto)
+	}
+	return dtos
+}
+
+func unixPtr(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	return gptr.Of(t.Unix())
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package evaluation_set
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/eval_set"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/application/convertor/common"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func SynthesisConfDTO2DO(dto *eval_set.EvaluationSetSynthesisConf) *entity.EvaluationSetSynthesisConf {
+	if dto == nil {
+		return nil
+	}
+	strategies := make([]entity.EvaluationSetSynthesisStrategy, 0, len(dto.Strategies))
+	for _, s := range dto.Strategies {
+		strategies = append(strategies, entity.EvaluationSetSynthesisStrategy(s))
+	}
+	return &entity.EvaluationSetSynthesisConf{
+		ModelConfig: common.ConvertModelConfigDTO2DO(dto.ModelConfig),
+		Count:       int(dto.GetCount()),
+		Strategies:  strategies,
+		FieldNames:  dto.FieldNames,
+		Instruction: dto.GetInstruction(),
+		BatchSize:   int(dto.GetBatchSize()),
+	}
+}
+
+func GeneratedItemDO2DTOs(dos []*entity.GeneratedEvaluationSetItem) []*eval_set.GeneratedEvaluationSetItem {
+	if dos == nil {
+		return nil
+	}
+	dtos := make([]*eval_set.GeneratedEvaluationSetItem, 0, len(dos))
+	for _, do := range dos {
+		if do == nil {
+			continue
+		}
+		dto := &eval_set.GeneratedEvaluationSetItem{Item: ItemDO2DTO(do.Item)}
+		if len(do.ReviewStatus) > 0 {
+			dto.ReviewStatus = gptr.Of(do.ReviewStatus)
+		}
+		dtos = append(dtos, dto)
+	}
+	return dtos
+}
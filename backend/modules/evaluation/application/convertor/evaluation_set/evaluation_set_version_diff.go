@@ -0,0 +1,84 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package evaluation_set
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/eval_set"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/application/convertor/common"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func VersionDiffDO2DTO(do *entity.EvaluationSetVersionDiff) *eval_set.EvaluationSetVersionDiff {
+	if do == nil {
+		return nil
+	}
+	schemaDiffs := make([]*eval_set.EvaluationSetSchemaFieldDiff, 0, len(do.SchemaDiffs))
+	for _, d := range do.SchemaDiffs {
+		schemaDiffs = append(schemaDiffs, SchemaFieldDiffDO2DTO(d))
+	}
+	itemDiffs := make([]*eval_set.EvaluationSetItemDiff, 0, len(do.ItemDiffs))
+	for _, d := range do.ItemDiffs {
+		itemDiffs = append(itemDiffs, ItemDiffDO2DTO(d))
+	}
+	return &eval_set.EvaluationSetVersionDiff{
+		EvaluationSetID:  gptr.Of(do.EvaluationSetID),
+		BaseVersionID:    do.BaseVersionID,
+		TargetVersionID:  do.TargetVersionID,
+		SchemaDiffs:      schemaDiffs,
+		ItemDiffs:        itemDiffs,
+		AddedItemCnt:     gptr.Of(int32(do.AddedItemCnt)),
+		RemovedItemCnt:   gptr.Of(int32(do.RemovedItemCnt)),
+		ModifiedItemCnt:  gptr.Of(int32(do.ModifiedItemCnt)),
+		UnchangedItemCnt: gptr.Of(int32(do.UnchangedItemCnt)),
+		Truncated:        gptr.Of(do.Truncated),
+	}
+}
+
+func SchemaFieldDiffDO2DTO(do *entity.EvaluationSetSchemaFieldDiff) *eval_set.EvaluationSetSchemaFieldDiff {
+	if do == nil {
+		return nil
+	}
+	return &eval_set.EvaluationSetSchemaFieldDiff{
+		Key:          gptr.Of(do.Key),
+		Name:         gptr.Of(do.Name),
+		ChangeType:   gptr.Of(eval_set.EvaluationSetChangeType(do.ChangeType)),
+		Base:         FieldSchemaDO2DTO(do.Base),
+		Target:       FieldSchemaDO2DTO(do.Target),
+		ChangedAttrs: do.ChangedAttrs,
+	}
+}
+
+func ItemDiffDO2DTO(do *entity.EvaluationSetItemDiff) *eval_set.EvaluationSetItemDiff {
+	if do == nil {
+		return nil
+	}
+	fieldDiffs := make([]*eval_set.EvaluationSetFieldDiff, 0, len(do.FieldDiffs))
+	for _, d := range do.FieldDiffs {
+		fieldDiffs = append(fieldDiffs, FieldDiffDO2DTO(d))
+	}
+	return &eval_set.EvaluationSetItemDiff{
+		ItemID:     gptr.Of(do.ItemID),
+		ItemKey:    gptr.Of(do.ItemKey),
+		ChangeType: gptr.Of(eval_set.EvaluationSetChangeType(do.ChangeType)),
+		BaseItem:   ItemDO2DTO(do.BaseItem),
+		TargetItem: ItemDO2DTO(do.TargetItem),
+		FieldDiffs: fieldDiffs,
+	}
+}
+
+func FieldDiffDO2DTO(do *entity.EvaluationSetFieldDiff) *eval_set.EvaluationSetFieldDiff {
+	if do == nil {
+		return nil
+	}
+	return &eval_set.EvaluationSetFieldDiff{
+		TurnIdx:    gptr.Of(int32(do.TurnIdx)),
+		FieldKey:   gptr.Of(do.FieldKey),
+		FieldName:  gptr.Of(do.FieldName),
+		ChangeType: gptr.Of(eval_set.EvaluationSetChangeType(do.ChangeType)),
+		Base:       common.ConvertContentDO2DTO(do.Base),
+		Target:     common.ConvertContentDO2DTO(do.Target),
+	}
+}
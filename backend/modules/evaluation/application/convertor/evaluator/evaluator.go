@@ -58,6 +58,12 @@ func ConvertEvaluatorDTO2DO(evaluatorDTO *evaluatordto.Evaluator) (*evaluatordo.
 			evaluatorDO.CustomRPCEvaluatorVersion = customRPCEvaluatorVersion
 		case evaluatordto.EvaluatorType_Agent:
 			evaluatorDO.AgentEvaluatorVersion = ConvertAgentEvaluatorVersionDTO2DO(evaluatorDO.ID, evaluatorDO.SpaceID, evaluatorDTO.GetCurrentVersion())
+		case evaluatordto.EvaluatorType_Pairwise:
+			evaluatorDO.PairwiseEvaluatorVersion = ConvertPairwiseEvaluatorVersionDTO2DO(evaluatorDO.ID, evaluatorDO.SpaceID, evaluatorDTO.GetCurrentVersion())
+		case evaluatordto.EvaluatorType_Rule:
+			evaluatorDO.RuleEvaluatorVersion = ConvertRuleEvaluatorVersionDTO2DO(evaluatorDO.ID, evaluatorDO.SpaceID, evaluatorDTO.GetCurrentVersion())
+		case evaluatordto.EvaluatorType_Composite:
+			evaluatorDO.CompositeEvaluatorVersion = ConvertCompositeEvaluatorVersionDTO2DO(evaluatorDO.ID, evaluatorDO.SpaceID, evaluatorDTO.GetCurrentVersion())
 		}
 	}
 	return evaluatorDO, nil
@@ -128,6 +134,18 @@ func ConvertEvaluatorDO2DTO(do *evaluatordo.Evaluator) *evaluatordto.Evaluator {
 			versionDTO := ConvertAgentEvaluatorVersionDO2DTO(do.AgentEvaluatorVersion)
 			dto.CurrentVersion = versionDTO
 		}
+	case evaluatordo.EvaluatorTypePairwise:
+		if do.PairwiseEvaluatorVersion != nil {
+			dto.CurrentVersion = ConvertPairwiseEvaluatorVersionDO2DTO(do.PairwiseEvaluatorVersion)
+		}
+	case evaluatordo.EvaluatorTypeRule:
+		if do.RuleEvaluatorVersion != nil {
+			dto.CurrentVersion = ConvertRuleEvaluatorVersionDO2DTO(do.RuleEvaluatorVersion)
+		}
+	case evaluatordo.EvaluatorTypeComposite:
+		if do.CompositeEvaluatorVersion != nil {
+			dto.CurrentVersion = ConvertCompositeEvaluatorVersionDO2DTO(do.CompositeEvaluatorVersion)
+		}
 	}
 	return dto
 }
@@ -387,6 +405,24 @@ func ConvertEvaluatorContent2DO(content *evaluatordto.EvaluatorContent, evaluato
 
 		evaluator.AgentEvaluatorVersion = agentVersion
 
+	case evaluatordto.EvaluatorType_Pairwise:
+		if content.PairwiseEvaluator == nil {
+			return nil, errorx.NewByCode(errno.InvalidInputDataCode, errorx.WithExtraMsg("pairwise evaluator content is nil"))
+		}
+		evaluator.PairwiseEvaluatorVersion = ConvertPairwiseEvaluatorVersionDTO2DO(0, 0, &evaluatordto.EvaluatorVersion{EvaluatorContent: content})
+
+	case evaluatordto.EvaluatorType_Rule:
+		if content.RuleEvaluator == nil {
+			return nil, errorx.NewByCode(errno.InvalidInputDataCode, errorx.WithExtraMsg("rule evaluator content is nil"))
+		}
+		evaluator.RuleEvaluatorVersion = ConvertRuleEvaluatorVersionDTO2DO(0, 0, &evaluatordto.EvaluatorVersion{EvaluatorContent: content})
+
+	case evaluatordto.EvaluatorType_Composite:
+		if content.CompositeEvaluator == nil {
+			return nil, errorx.NewByCode(errno.InvalidInputDataCode, errorx.WithExtraMsg("composite evaluator content is nil"))
+		}
+		evaluator.CompositeEvaluatorVersion = ConvertCompositeEvaluatorVersionDTO2DO(0, 0, &evaluatordto.EvaluatorVersion{EvaluatorContent: content})
+
 	default:
 		return nil, errorx.NewByCode(errno.InvalidEvaluatorTypeCode, errorx.WithExtraMsg("unsupported evaluator type"))
 	}
@@ -778,3 +814,215 @@ func ConvertAgentEvaluatorPromptConfigDO2DTO(do *evaluatordo.AgentEvaluatorPromp
 	}
 	return dto
 }
+
+func ConvertPairwiseEvaluatorVersionDTO2DO(evaluatorID, spaceID int64, dto *evaluatordto.EvaluatorVersion) *evaluatordo.PairwiseEvaluatorVersion {
+	if dto == nil || dto.EvaluatorContent == nil || dto.EvaluatorContent.PairwiseEvaluator == nil {
+		return nil
+	}
+	pairwiseEvaluator := dto.EvaluatorContent.PairwiseEvaluator
+	pairwiseEvaluatorVersion := &evaluatordo.PairwiseEvaluatorVersion{
+		ID:                  dto.GetID(),
+		SpaceID:             spaceID,
+		EvaluatorType:       evaluatordo.EvaluatorTypePairwise,
+		EvaluatorID:         evaluatorID,
+		Description:         dto.GetDescription(),
+		Version:             dto.GetVersion(),
+		BaseInfo:            commonconvertor.ConvertBaseInfoDTO2DO(dto.GetBaseInfo()),
+		InputSchemas:        commonconvertor.ConvertArgsSchemaListDTO2DO(dto.EvaluatorContent.InputSchemas),
+		ModelConfig:         commonconvertor.ConvertModelConfigDTO2DO(pairwiseEvaluator.ModelConfig),
+		DisablePositionSwap: pairwiseEvaluator.GetDisablePositionSwap(),
+	}
+	if len(pairwiseEvaluator.MessageList) > 0 {
+		pairwiseEvaluatorVersion.MessageList = make([]*evaluatordo.Message, 0, len(pairwiseEvaluator.MessageList))
+		for _, msg := range pairwiseEvaluator.MessageList {
+			pairwiseEvaluatorVersion.MessageList = append(pairwiseEvaluatorVersion.MessageList, commonconvertor.ConvertMessageDTO2DO(msg))
+		}
+	}
+	return pairwiseEvaluatorVersion
+}
+
+func ConvertPairwiseEvaluatorVersionDO2DTO(do *evaluatordo.PairwiseEvaluatorVersion) *evaluatordto.EvaluatorVersion {
+	if do == nil {
+		return nil
+	}
+	dto := &evaluatordto.EvaluatorVersion{
+		ID:          gptr.Of(do.ID),
+		Version:     gptr.Of(do.Version),
+		Description: gptr.Of(do.Description),
+		BaseInfo:    commonconvertor.ConvertBaseInfoDO2DTO(do.BaseInfo),
+		EvaluatorContent: &evaluatordto.EvaluatorContent{
+			InputSchemas: commonconvertor.ConvertArgsSchemaListDO2DTO(do.InputSchemas),
+			PairwiseEvaluator: &evaluatordto.PairwiseEvaluator{
+				ModelConfig:         commonconvertor.ConvertModelConfigDO2DTO(do.ModelConfig),
+				DisablePositionSwap: gptr.Of(do.DisablePositionSwap),
+			},
+		},
+	}
+	if len(do.MessageList) > 0 {
+		dto.EvaluatorContent.PairwiseEvaluator.MessageList = make([]*commondto.Message, 0, len(do.MessageList))
+		for _, msg := range do.MessageList {
+			dto.EvaluatorContent.PairwiseEvaluator.MessageList = append(dto.EvaluatorContent.PairwiseEvaluator.MessageList, commonconvertor.ConvertMessageDO2DTO(msg))
+		}
+	}
+	return dto
+}
+
+func ConvertRuleEvaluatorVersionDTO2DO(evaluatorID, spaceID int64, dto *evaluatordto.EvaluatorVersion) *evaluatordo.RuleEvaluatorVersion {
+	if dto == nil || dto.EvaluatorContent == nil || dto.EvaluatorContent.RuleEvaluator == nil {
+		return nil
+	}
+	return &evaluatordo.RuleEvaluatorVersion{
+		ID:            dto.GetID(),
+		SpaceID:       spaceID,
+		EvaluatorType: evaluatordo.EvaluatorTypeRule,
+		EvaluatorID:   evaluatorID,
+		Description:   dto.GetDescription(),
+		Version:       dto.GetVersion(),
+		BaseInfo:      commonconvertor.ConvertBaseInfoDTO2DO(dto.GetBaseInfo()),
+		InputSchemas:  commonconvertor.ConvertArgsSchemaListDTO2DO(dto.EvaluatorContent.InputSchemas),
+		RuleConfig:    ConvertRuleConfigDTO2DO(dto.EvaluatorContent.RuleEvaluator),
+	}
+}
+
+func ConvertRuleEvaluatorVersionDO2DTO(do *evaluatordo.RuleEvaluatorVersion) *evaluatordto.EvaluatorVersion {
+	if do == nil {
+		return nil
+	}
+	return &evaluatordto.EvaluatorVersion{
+		ID:          gptr.Of(do.ID),
+		Version:     gptr.Of(do.Version),
+		Description: gptr.Of(do.Description),
+		BaseInfo:    commonconvertor.ConvertBaseInfoDO2DTO(do.BaseInfo),
+		EvaluatorContent: &evaluatordto.EvaluatorContent{
+			InputSchemas:  commonconvertor.ConvertArgsSchemaListDO2DTO(do.InputSchemas),
+			RuleEvaluator: ConvertRuleConfigDO2DTO(do.RuleConfig),
+		},
+	}
+}
+
+func ConvertRuleConfigDTO2DO(dto *evaluatordto.RuleEvaluator) *evaluatordo.RuleConfig {
+	if dto == nil {
+		return nil
+	}
+	return &evaluatordo.RuleConfig{
+		RuleType:          evaluatordo.RuleType(dto.GetRuleType()),
+		ActualFieldKey:    dto.GetActualFieldKey(),
+		ReferenceFieldKey: dto.GetReferenceFieldKey(),
+		IgnoreCase:        dto.GetIgnoreCase(),
+		TrimSpace:         dto.GetTrimSpace(),
+		Pattern:           dto.GetPattern(),
+		JSONSchema:        dto.GetJSONSchema(),
+		Keywords:          dto.GetKeywords(),
+		ContainsAll:       dto.GetContainsAll(),
+		Tolerance:         dto.GetTolerance(),
+		Relative:          dto.GetRelative(),
+		BLEUMaxN:          int(dto.GetBleuMaxN()),
+		PassThreshold:     dto.PassThreshold,
+	}
+}
+
+func ConvertRuleConfigDO2DTO(do *evaluatordo.RuleConfig) *evaluatordto.RuleEvaluator {
+	if do == nil {
+		return nil
+	}
+	return &evaluatordto.RuleEvaluator{
+		RuleType:          gptr.Of(evaluatordto.RuleType(do.RuleType)),
+		ActualFieldKey:    gptr.Of(do.ActualFieldKey),
+		ReferenceFieldKey: gptr.Of(do.ReferenceFieldKey),
+		IgnoreCase:        gptr.Of(do.IgnoreCase),
+		TrimSpace:         gptr.Of(do.TrimSpace),
+		Pattern:           gptr.Of(do.Pattern),
+		JSONSchema:        gptr.Of(do.JSONSchema),
+		Keywords:          do.Keywords,
+		ContainsAll:       gptr.Of(do.ContainsAll),
+		Tolerance:         gptr.Of(do.Tolerance),
+		Relative:          gptr.Of(do.Relative),
+		BleuMaxN:          gptr.Of(int32(do.BLEUMaxN)),
+		PassThreshold:     do.PassThreshold,
+	}
+}
+
+func ConvertCompositeEvaluatorVersionDTO2DO(evaluatorID, spaceID int64, dto *evaluatordto.EvaluatorVersion) *evaluatordo.CompositeEvaluatorVersion {
+	if dto == nil || dto.EvaluatorContent == nil || dto.EvaluatorContent.CompositeEvaluator == nil {
+		return nil
+	}
+	return &evaluatordo.CompositeEvaluatorVersion{
+		ID:              dto.GetID(),
+		SpaceID:         spaceID,
+		EvaluatorType:   evaluatordo.EvaluatorTypeComposite,
+		EvaluatorID:     evaluatorID,
+		Description:     dto.GetDescription(),
+		Version:         dto.GetVersion(),
+		BaseInfo:        commonconvertor.ConvertBaseInfoDTO2DO(dto.GetBaseInfo()),
+		InputSchemas:    commonconvertor.ConvertArgsSchemaListDTO2DO(dto.EvaluatorContent.InputSchemas),
+		CompositeConfig: ConvertCompositeConfigDTO2DO(dto.EvaluatorContent.CompositeEvaluator),
+	}
+}
+
+func ConvertCompositeEvaluatorVersionDO2DTO(do *evaluatordo.CompositeEvaluatorVersion) *evaluatordto.EvaluatorVersion {
+	if do == nil {
+		return nil
+	}
+	return &evaluatordto.EvaluatorVersion{
+		ID:          gptr.Of(do.ID),
+		Version:     gptr.Of(do.Version),
+		Description: gptr.Of(do.Description),
+		BaseInfo:    commonconvertor.ConvertBaseInfoDO2DTO(do.BaseInfo),
+		EvaluatorContent: &evaluatordto.EvaluatorContent{
+			InputSchemas:       commonconvertor.ConvertArgsSchemaListDO2DTO(do.InputSchemas),
+			CompositeEvaluator: ConvertCompositeConfigDO2DTO(do.CompositeConfig),
+		},
+	}
+}
+
+func ConvertCompositeConfigDTO2DO(dto *evaluatordto.CompositeEvaluator) *evaluatordo.CompositeConfig {
+	if dto == nil {
+		return nil
+	}
+	do := &evaluatordo.CompositeConfig{
+		Strategy:      evaluatordo.CompositeStrategy(dto.GetStrategy()),
+		MinSuccessCnt: int(dto.GetMinSuccessCnt()),
+		CodeContent:   dto.GetCodeContent(),
+		LanguageType:  normalizeLanguageType(evaluatordo.LanguageType(dto.GetLanguageType())),
+	}
+	if len(dto.Components) > 0 {
+		do.Components = make([]*evaluatordo.CompositeComponent, 0, len(dto.Components))
+		for _, component := range dto.Components {
+			if component == nil {
+				continue
+			}
+			do.Components = append(do.Components, &evaluatordo.CompositeComponent{
+				EvaluatorVersionID: component.GetEvaluatorVersionID(),
+				Weight:             component.GetWeight(),
+			})
+		}
+	}
+	return do
+}
+
+func ConvertCompositeConfigDO2DTO(do *evaluatordo.CompositeConfig) *evaluatordto.CompositeEvaluator {
+	if do == nil {
+		return nil
+	}
+	dto := &evaluatordto.CompositeEvaluator{
+		Strategy:      gptr.Of(evaluatordto.CompositeStrategy(do.Strategy)),
+		MinSuccessCnt: gptr.Of(int32(do.MinSuccessCnt)),
+	}
+	if do.Strategy == evaluatordo.CompositeStrategyCode {
+		dto.CodeContent = gptr.Of(do.CodeContent)
+		dto.LanguageType = gptr.Of(convertLanguageTypeDO2DTO(do.LanguageType))
+	}
+	if len(do.Components) > 0 {
+		dto.Components = make([]*evaluatordto.CompositeComponent, 0, len(do.Components))
+		for _, component := range do.Components {
+			if component == nil {
+				continue
+			}
+			dto.Components = append(dto.Components, &evaluatordto.CompositeComponent{
+				EvaluatorVersionID: gptr.Of(component.EvaluatorVersionID),
+				Weight:             gptr.Of(component.Weight),
+			})
+		}
+	}
+	return dto
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package evaluator
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	evaluatordto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/evaluator"
+	commonconvertor "github.com/coze-dev/coze-loop/backend/modules/evaluation/application/convertor/common"
+	evaluatordo "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func ConvertEvaluatorCalibrationConfDTO2DO(dto *evaluatordto.EvaluatorCalibrationConf) *evaluatordo.EvaluatorCalibrationConf {
+	if dto == nil {
+		return nil
+	}
+	return &evaluatordo.EvaluatorCalibrationConf{
+		GroundTruthField:       dto.GetGroundTruthField(),
+		FieldConfs:             convertCalibrationFieldConfsDTO2DO(dto.GetFieldConfs()),
+		TargetOutputFieldConfs: convertCalibrationFieldConfsDTO2DO(dto.GetTargetOutputFieldConfs()),
+		WorstItemCnt:           int(dto.GetWorstItemCnt()),
+		Concurrency:            int(dto.GetConcurrency()),
+		MaxEvaluatorFailRate:   dto.GetMaxEvaluatorFailRate(),
+	}
+}
+
+func convertCalibrationFieldConfsDTO2DO(dtos []*evaluatordto.CalibrationFieldConf) []*evaluatordo.CalibrationFieldConf {
+	if len(dtos) == 0 {
+		return nil
+	}
+	dos := make([]*evaluatordo.CalibrationFieldConf, 0, len(dtos))
+	for _, dto := range dtos {
+		if dto == nil {
+			continue
+		}
+		dos = append(dos, &evaluatordo.CalibrationFieldConf{FieldName: dto.GetFieldName(), FromField: dto.GetFromField()})
+	}
+	return dos
+}
+
+func ConvertEvaluatorCalibrationConfDO2DTO(do *evaluatordo.EvaluatorCalibrationConf) *evaluatordto.EvaluatorCalibrationConf {
+	if do == nil {
+		return nil
+	}
+	dto := &evaluatordto.EvaluatorCalibrationConf{
+		GroundTruthField:       gptr.Of(do.GroundTruthField),
+		FieldConfs:             convertCalibrationFieldConfsDO2DTO(do.FieldConfs),
+		TargetOutputFieldConfs: convertCalibrationFieldConfsDO2DTO(do.TargetOutputFieldConfs),
+		WorstItemCnt:           gptr.Of(int32(do.GetWorstItemCnt())),
+		Concurrency:            gptr.Of(int32(do.GetConcurrency())),
+		MaxEvaluatorFailRate:   gptr.Of(do.GetMaxEvaluatorFailRate()),
+	}
+	return dto
+}
+
+func convertCalibrationFieldConfsDO2DTO(dos []*evaluatordo.CalibrationFieldConf) []*evaluatordto.CalibrationFieldConf {
+	if len(dos) == 0 {
+		return nil
+	}
+	dtos := make([]*evaluatordto.CalibrationFieldConf, 0, len(dos))
+	for _, do := range dos {
+		if do == nil {
+			continue
+		}
+		dtos = append(dtos, &evaluatordto.CalibrationFieldConf{FieldName: gptr.Of(do.FieldName), FromField: gptr.Of(do.FromField)})
+	}
+	return dtos
+}
+
+func ConvertEvaluatorCalibrationRecordDO2DTO(do *evaluatordo.EvaluatorCalibrationRecord) *evaluatordto.EvaluatorCalibrationRecord {
+	if do == nil {
+		return nil
+	}
+	dto := &evaluatordto.EvaluatorCalibrationRecord{
+		RecordID:               gptr.Of(do.ID),
+		WorkspaceID:            gptr.Of(do.SpaceID),
+		EvaluatorID:            gptr.Of(do.EvaluatorID),
+		EvaluatorVersionID:     gptr.Of(do.EvaluatorVersionID),
+		EvaluatorVersion:       gptr.Of(do.EvaluatorVersion),
+		EvaluationSetID:        gptr.Of(do.EvaluationSetID),
+		EvaluationSetVersionID: gptr.Of(do.EvaluationSetVersionID),
+		Status:                 gptr.Of(convertEvaluatorCalibrationStatusDO2DTO(do.Status)),
+		Conf:                   ConvertEvaluatorCalibrationConfDO2DTO(do.Conf),
+		Result_:                convertEvaluatorCalibrationResultDO2DTO(do.Result),
+		BaseInfo:               commonconvertor.ConvertBaseInfoDO2DTO(do.BaseInfo),
+	}
+	if len(do.ErrMsg) > 0 {
+		dto.ErrorMsg = gptr.Of(do.ErrMsg)
+	}
+	return dto
+}
+
+func ConvertEvaluatorCalibrationRecordDOs2DTOs(dos []*evaluatordo.EvaluatorCalibrationRecord) []*evaluatordto.EvaluatorCalibrationRecord {
+	dtos := make([]*evaluatordto.EvaluatorCalibrationRecord, 0, len(dos))
+	for _, do := range dos {
+		if do == nil {
+			continue
+		}
+		dtos = append(dtos, ConvertEvaluatorCalibrationRecordDO2DTO(do))
+	}
+	return dtos
+}
+
+func convertEvaluatorCalibrationStatusDO2DTO(status evaluatordo.EvaluatorCalibrationStatus) evaluatordto.EvaluatorCalibrationStatus {
+	switch status {
+	case evaluatordo.EvaluatorCalibrationStatus_Running:
+		return evaluatordto.EvaluatorCalibrationStatusRunning
+	case evaluatordo.EvaluatorCalibrationStatus_Success:
+		return evaluatordto.EvaluatorCalibrationStatusSuccess
+	case evaluatordo.EvaluatorCalibrationStatus_Failed:
+		return evaluatordto.EvaluatorCalibrationStatusFailed
+	default:
+		return evaluatordto.EvaluatorCalibrationStatusUnknown
+	}
+}
+
+func convertEvaluatorCalibrationResultDO2DTO(do *evaluatordo.EvaluatorCalibrationResult) *evaluatordto.EvaluatorCalibrationResult_ {
+	if do == nil {
+		return nil
+	}
+	dto := &evaluatordto.EvaluatorCalibrationResult_{
+		ItemCnt:               gptr.Of(int32(do.ItemCnt)),
+		PairedCnt:             gptr.Of(int32(do.PairedCnt)),
+		EvaluatorFailCnt:      gptr.Of(int32(do.EvaluatorFailCnt)),
+		InvalidGroundTruthCnt: gptr.Of(int32(do.InvalidGroundTruthCnt)),
+		PearsonCorrelation:    do.PearsonCorrelation,
+		SpearmanCorrelation:   do.SpearmanCorrelation,
+		MeanAbsoluteError:     do.MeanAbsoluteError,
+	}
+	if do.ConfusionMatrix != nil {
+		dto.ConfusionMatrix = &evaluatordto.CalibrationConfusionMatrix{
+			Labels:   do.ConfusionMatrix.Labels,
+			Counts:   do.ConfusionMatrix.Counts,
+			Accuracy: gptr.Of(do.ConfusionMatrix.Accuracy),
+		}
+	}
+	for _, item := range do.WorstItems {
+		if item == nil {
+			continue
+		}
+		dto.WorstItems = append(dto.WorstItems, &evaluatordto.CalibrationItemResult_{
+			ItemID:         gptr.Of(item.ItemID),
+			TurnID:         gptr.Of(item.TurnID),
+			GroundTruth:    gptr.Of(item.GroundTruth),
+			EvaluatorScore: gptr.Of(item.EvaluatorScore),
+			AbsError:       gptr.Of(item.AbsError),
+			Reasoning:      gptr.Of(item.Reasoning),
+		})
+	}
+	return dto
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package evaluator
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	evaluatordto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/evaluator"
+	evaluatordo "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+var promotionVerdictDO2DTO = map[evaluatordo.EvaluatorPromotionVerdict]evaluatordto.EvaluatorPromotionVerdict{
+	evaluatordo.EvaluatorPromotionVerdict_Pass:    evaluatordto.EvaluatorPromotionVerdictPass,
+	evaluatordo.EvaluatorPromotionVerdict_Warn:    evaluatordto.EvaluatorPromotionVerdictWarn,
+	evaluatordo.EvaluatorPromotionVerdict_Block:   evaluatordto.EvaluatorPromotionVerdictBlock,
+	evaluatordo.EvaluatorPromotionVerdict_Skipped: evaluatordto.EvaluatorPromotionVerdictSkipped,
+}
+
+// ConvertEvaluatorPromotionCheckConfDTO2DO 请求中显式传入的检查配置视为开启, action 缺省为阻断; 提交时只能收紧已保存的策略
+func ConvertEvaluatorPromotionCheckConfDTO2DO(dto *evaluatordto.EvaluatorPromotionCheckConf) *evaluatordo.EvaluatorPromotionConf {
+	if dto == nil {
+		return nil
+	}
+	action := evaluatordo.EvaluatorPromotionAction_Block
+	switch dto.GetAction() {
+	case "":
+	case evaluatordto.EvaluatorPromotionActionWarn:
+		action = evaluatordo.EvaluatorPromotionAction_Warn
+	case evaluatordto.EvaluatorPromotionActionBlock:
+		action = evaluatordo.EvaluatorPromotionAction_Block
+	default:
+		action = evaluatordo.EvaluatorPromotionAction_Unknown
+	}
+	return &evaluatordo.EvaluatorPromotionConf{
+		Enabled:                 true,
+		Action:                  action,
+		BaseVersion:             dto.GetBaseVersion(),
+		SampleSize:              int(dto.GetSampleSize()),
+		PassThreshold:           dto.PassThreshold,
+		MaxMeanAbsDrift:         dto.MaxMeanAbsDrift,
+		MaxFlipRate:             dto.MaxFlipRate,
+		MaxDraftFailRate:        dto.MaxDraftFailRate,
+		MaxLatencyIncreaseRatio: dto.MaxLatencyIncreaseRatio,
+		MaxTokenIncreaseRatio:   dto.MaxTokenIncreaseRatio,
+	}
+}
+
+func ConvertEvaluatorPromotionReportDO2DTO(do *evaluatordo.EvaluatorPromotionReport) *evaluatordto.EvaluatorPromotionReport {
+	if do == nil {
+		return nil
+	}
+	dto := &evaluatordto.EvaluatorPromotionReport{
+		BaseVersionID:      gptr.Of(do.BaseVersionID),
+		BaseVersion:        gptr.Of(do.BaseVersion),
+		SampleCnt:          gptr.Of(int32(do.SampleCnt)),
+		PairedCnt:          gptr.Of(int32(do.PairedCnt)),
+		DraftFailCnt:       gptr.Of(int32(do.DraftFailCnt)),
+		MeanDrift:          gptr.Of(do.MeanDrift),
+		MeanAbsDrift:       gptr.Of(do.MeanAbsDrift),
+		MaxAbsDrift:        gptr.Of(do.MaxAbsDrift),
+		FlipCnt:            gptr.Of(int32(do.FlipCnt)),
+		FlipRate:           gptr.Of(do.FlipRate),
+		BaseAvgLatencyMs:   gptr.Of(do.BaseAvgLatencyMS),
+		DraftAvgLatencyMs:  gptr.Of(do.DraftAvgLatencyMS),
+		BaseAvgTokens:      gptr.Of(do.BaseAvgTokens),
+		DraftAvgTokens:     gptr.Of(do.DraftAvgTokens),
+		LatencyChangeRatio: do.LatencyChangeRatio,
+		TokenChangeRatio:   do.TokenChangeRatio,
+		Violations:         make([]*evaluatordto.EvaluatorPromotionViolation, 0, len(do.Violations)),
+		DriftItems:         make([]*evaluatordto.EvaluatorPromotionDriftItem, 0, len(do.DriftItems)),
+	}
+	if verdict, ok := promotionVerdictDO2DTO[do.Verdict]; ok {
+		dto.Verdict = gptr.Of(verdict)
+	}
+	for _, v := range do.Violations {
+		if v == nil {
+			continue
+		}
+		dto.Violations = append(dto.Violations, &evaluatordto.EvaluatorPromotionViolation{
+			Metric:    gptr.Of(v.Metric),
+			Value:     gptr.Of(v.Value),
+			Threshold: gptr.Of(v.Threshold),
+		})
+	}
+	for _, item := range do.DriftItems {
+		if item == nil {
+			continue
+		}
+		dto.DriftItems = append(dto.DriftItems, &evaluatordto.EvaluatorPromotionDriftItem{
+			RecordID:       gptr.Of(item.RecordID),
+			ItemID:         gptr.Of(item.ItemID),
+			TurnID:         gptr.Of(item.TurnID),
+			BaseScore:      gptr.Of(item.BaseScore),
+			DraftScore:     gptr.Of(item.DraftScore),
+			Drift:          gptr.Of(item.Drift),
+			Flipped:        gptr.Of(item.Flipped),
+			DraftReasoning: gptr.Of(item.DraftReasoning),
+		})
+	}
+	return dto
+}
@@ -60,6 +60,12 @@ func OpenAPIEvaluatorTypeDO2DTO(doType entity.EvaluatorType) *openapiEvaluator.E
 		openapiType = openapiEvaluator.EvaluatorTypeCustomRPC
 	case entity.EvaluatorTypeAgent:
 		openapiType = openapiEvaluator.EvaluatorTypeAgent
+	case entity.EvaluatorTypePairwise:
+		openapiType = openapiEvaluator.EvaluatorTypePairwise
+	case entity.EvaluatorTypeRule:
+		openapiType = openapiEvaluator.EvaluatorTypeRule
+	case entity.EvaluatorTypeComposite:
+		openapiType = openapiEvaluator.EvaluatorTypeComposite
 	default:
 		return nil
 	}
@@ -104,6 +110,27 @@ func OpenAPIEvaluatorVersionDO2DTO(do *entity.Evaluator) *openapiEvaluator.Evalu
 			description = do.AgentEvaluatorVersion.Description
 			baseInfo = do.AgentEvaluatorVersion.BaseInfo
 		}
+	case entity.EvaluatorTypePairwise:
+		if do.PairwiseEvaluatorVersion != nil {
+			id = do.PairwiseEvaluatorVersion.ID
+			version = do.PairwiseEvaluatorVersion.Version
+			description = do.PairwiseEvaluatorVersion.Description
+			baseInfo = do.PairwiseEvaluatorVersion.BaseInfo
+		}
+	case entity.EvaluatorTypeRule:
+		if do.RuleEvaluatorVersion != nil {
+			id = do.RuleEvaluatorVersion.ID
+			version = do.RuleEvaluatorVersion.Version
+			description = do.RuleEvaluatorVersion.Description
+			baseInfo = do.RuleEvaluatorVersion.BaseInfo
+		}
+	case entity.EvaluatorTypeComposite:
+		if do.CompositeEvaluatorVersion != nil {
+			id = do.CompositeEvaluatorVersion.ID
+			version = do.CompositeEvaluatorVersion.Version
+			description = do.CompositeEvaluatorVersion.Description
+			baseInfo = do.CompositeEvaluatorVersion.BaseInfo
+		}
 	}
 
 	if id == 0 && version == "" {
@@ -188,6 +215,25 @@ func OpenAPIEvaluatorContentDO2DTO(do *entity.Evaluator) *openapiEvaluator.Evalu
 				PromptConfig: OpenAPIAgentEvaluatorPromptConfigDO2DTO(v.PromptConfig),
 			}
 		}
+	case entity.EvaluatorTypePairwise:
+		if v := do.PairwiseEvaluatorVersion; v != nil {
+			dto.InputSchemas = common_convertor.OpenAPIArgsSchemaDO2DTOs(v.InputSchemas)
+			dto.PairwiseEvaluator = &openapiEvaluator.PairwiseEvaluator{
+				Messages:            common_convertor.OpenAPIMessageDO2DTOs(v.MessageList),
+				ModelConfig:         common_convertor.OpenAPIModelConfigDO2DTO(v.ModelConfig),
+				DisablePositionSwap: gptr.Of(v.DisablePositionSwap),
+			}
+		}
+	case entity.EvaluatorTypeRule:
+		if v := do.RuleEvaluatorVersion; v != nil {
+			dto.InputSchemas = common_convertor.OpenAPIArgsSchemaDO2DTOs(v.InputSchemas)
+			dto.RuleEvaluator = OpenAPIRuleConfigDO2DTO(v.RuleConfig)
+		}
+	case entity.EvaluatorTypeComposite:
+		if v := do.CompositeEvaluatorVersion; v != nil {
+			dto.InputSchemas = common_convertor.OpenAPIArgsSchemaDO2DTOs(v.InputSchemas)
+			dto.CompositeEvaluator = OpenAPICompositeConfigDO2DTO(v.CompositeConfig)
+		}
 	}
 
 	return dto
@@ -559,6 +605,25 @@ func OpenAPIEvaluatorContentDTO2DO(dto *openapiEvaluator.EvaluatorContent, evalT
 			res.AgentEvaluatorVersion.SkillConfigs = OpenAPISkillConfigsDTO2DOs(a.SkillConfigs)
 			res.AgentEvaluatorVersion.PromptConfig = OpenAPIAgentEvaluatorPromptConfigDTO2DO(a.PromptConfig)
 		}
+	case entity.EvaluatorTypePairwise:
+		res.PairwiseEvaluatorVersion = &entity.PairwiseEvaluatorVersion{
+			InputSchemas: common_convertor.OpenAPIArgsSchemaDTO2DOs(dto.InputSchemas),
+		}
+		if dto.PairwiseEvaluator != nil {
+			res.PairwiseEvaluatorVersion.MessageList = common_convertor.OpenAPIMessageDTO2DOs(dto.PairwiseEvaluator.Messages)
+			res.PairwiseEvaluatorVersion.ModelConfig = common_convertor.OpenAPIModelConfigDTO2DO(dto.PairwiseEvaluator.ModelConfig)
+			res.PairwiseEvaluatorVersion.DisablePositionSwap = dto.PairwiseEvaluator.GetDisablePositionSwap()
+		}
+	case entity.EvaluatorTypeRule:
+		res.RuleEvaluatorVersion = &entity.RuleEvaluatorVersion{
+			InputSchemas: common_convertor.OpenAPIArgsSchemaDTO2DOs(dto.InputSchemas),
+			RuleConfig:   OpenAPIRuleConfigDTO2DO(dto.RuleEvaluator),
+		}
+	case entity.EvaluatorTypeComposite:
+		res.CompositeEvaluatorVersion = &entity.CompositeEvaluatorVersion{
+			InputSchemas:    common_convertor.OpenAPIArgsSchemaDTO2DOs(dto.InputSchemas),
+			CompositeConfig: OpenAPICompositeConfigDTO2DO(dto.CompositeEvaluator),
+		}
 	}
 	return res, nil
 }
@@ -625,6 +690,12 @@ func OpenAPIEvaluatorTypeDTO2DO(dto *openapiEvaluator.EvaluatorType) entity.Eval
 		return entity.EvaluatorTypeCustomRPC
 	case openapiEvaluator.EvaluatorTypeAgent:
 		return entity.EvaluatorTypeAgent
+	case openapiEvaluator.EvaluatorTypePairwise:
+		return entity.EvaluatorTypePairwise
+	case openapiEvaluator.EvaluatorTypeRule:
+		return entity.EvaluatorTypeRule
+	case openapiEvaluator.EvaluatorTypeComposite:
+		return entity.EvaluatorTypeComposite
 	default:
 		return entity.EvaluatorTypePrompt
 	}
@@ -713,3 +784,93 @@ func OpenAPIAgentEvaluatorPromptConfigDTO2DO(dto *openapiEvaluator.AgentEvaluato
 	}
 	return do
 }
+
+func OpenAPIRuleConfigDTO2DO(dto *openapiEvaluator.RuleEvaluator) *entity.RuleConfig {
+	if dto == nil {
+		return nil
+	}
+	return &entity.RuleConfig{
+		RuleType:          entity.RuleType(dto.GetRuleType()),
+		ActualFieldKey:    dto.GetActualFieldKey(),
+		ReferenceFieldKey: dto.GetReferenceFieldKey(),
+		IgnoreCase:        dto.GetIgnoreCase(),
+		TrimSpace:         dto.GetTrimSpace(),
+		Pattern:           dto.GetPattern(),
+		JSONSchema:        dto.GetJSONSchema(),
+		Keywords:          dto.GetKeywords(),
+		ContainsAll:       dto.GetContainsAll(),
+		Tolerance:         dto.GetTolerance(),
+		Relative:          dto.GetRelative(),
+		BLEUMaxN:          int(dto.GetBleuMaxN()),
+		PassThreshold:     dto.PassThreshold,
+	}
+}
+
+func OpenAPIRuleConfigDO2DTO(do *entity.RuleConfig) *openapiEvaluator.RuleEvaluator {
+	if do == nil {
+		return nil
+	}
+	return &openapiEvaluator.RuleEvaluator{
+		RuleType:          gptr.Of(string(do.RuleType)),
+		ActualFieldKey:    gptr.Of(do.ActualFieldKey),
+		ReferenceFieldKey: gptr.Of(do.ReferenceFieldKey),
+		IgnoreCase:        gptr.Of(do.IgnoreCase),
+		TrimSpace:         gptr.Of(do.TrimSpace),
+		Pattern:           gptr.Of(do.Pattern),
+		JSONSchema:        gptr.Of(do.JSONSchema),
+		Keywords:          do.Keywords,
+		ContainsAll:       gptr.Of(do.ContainsAll),
+		Tolerance:         gptr.Of(do.Tolerance),
+		Relative:          gptr.Of(do.Relative),
+		BleuMaxN:          gptr.Of(int32(do.BLEUMaxN)),
+		PassThreshold:     do.PassThreshold,
+	}
+}
+
+func OpenAPICompositeConfigDTO2DO(dto *openapiEvaluator.CompositeEvaluator) *entity.CompositeConfig {
+	if dto == nil {
+		return nil
+	}
+	do := &entity.CompositeConfig{
+		Strategy:      entity.CompositeStrategy(dto.GetStrategy()),
+		MinSuccessCnt: int(dto.GetMinSuccessCnt()),
+		CodeContent:   dto.GetCodeContent(),
+	}
+	if dto.LanguageType != nil {
+		do.LanguageType = OpenAPILanguageTypeDTO2DO(dto.LanguageType)
+	}
+	for _, component := range dto.Components {
+		if component == nil {
+			continue
+		}
+		do.Components = append(do.Components, &entity.CompositeComponent{
+			EvaluatorVersionID: component.GetEvaluatorVersionID(),
+			Weight:             component.GetWeight(),
+		})
+	}
+	return do
+}
+
+func OpenAPICompositeConfigDO2DTO(do *entity.CompositeConfig) *openapiEvaluator.CompositeEvaluator {
+	if do == nil {
+		return nil
+	}
+	dto := &openapiEvaluator.CompositeEvaluator{
+		Strategy:      gptr.Of(string(do.Strategy)),
+		MinSuccessCnt: gptr.Of(int32(do.MinSuccessCnt)),
+	}
+	if do.Strategy == entity.CompositeStrategyCode {
+		dto.CodeContent = gptr.Of(do.CodeContent)
+		dto.LanguageType = OpenAPILanguageTypeDO2DTO(do.LanguageType)
+	}
+	for _, component := range do.Components {
+		if component == nil {
+			continue
+		}
+		dto.Components = append(dto.Components, &openapiEvaluator.CompositeComponent{
+			EvaluatorVersionID: gptr.Of(component.EvaluatorVersionID),
+			Weight:             gptr.Of(component.Weight),
+		})
+	}
+	return dto
+}
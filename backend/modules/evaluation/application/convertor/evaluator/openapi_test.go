@@ -60,6 +60,9 @@ func TestOpenAPIEvaluatorTypeDO2DTO(t *testing.T) {
 	assert.Equal(t, openapiEvaluator.EvaluatorTypeCode, *OpenAPIEvaluatorTypeDO2DTO(entity.EvaluatorTypeCode))
 	assert.Equal(t, openapiEvaluator.EvaluatorTypeCustomRPC, *OpenAPIEvaluatorTypeDO2DTO(entity.EvaluatorTypeCustomRPC))
 	assert.Equal(t, openapiEvaluator.EvaluatorTypeAgent, *OpenAPIEvaluatorTypeDO2DTO(entity.EvaluatorTypeAgent))
+	assert.Equal(t, openapiEvaluator.EvaluatorTypePairwise, *OpenAPIEvaluatorTypeDO2DTO(entity.EvaluatorTypePairwise))
+	assert.Equal(t, openapiEvaluator.EvaluatorTypeRule, *OpenAPIEvaluatorTypeDO2DTO(entity.EvaluatorTypeRule))
+	assert.Equal(t, openapiEvaluator.EvaluatorTypeComposite, *OpenAPIEvaluatorTypeDO2DTO(entity.EvaluatorTypeComposite))
 	assert.Nil(t, OpenAPIEvaluatorTypeDO2DTO(entity.EvaluatorType(999)))
 }
 
@@ -611,6 +614,9 @@ func TestOpenAPIEvaluatorTypeDTO2DO(t *testing.T) {
 	assert.Equal(t, entity.EvaluatorTypeCode, OpenAPIEvaluatorTypeDTO2DO(gptr.Of(openapiEvaluator.EvaluatorTypeCode)))
 	assert.Equal(t, entity.EvaluatorTypeCustomRPC, OpenAPIEvaluatorTypeDTO2DO(gptr.Of(openapiEvaluator.EvaluatorTypeCustomRPC)))
 	assert.Equal(t, entity.EvaluatorTypeAgent, OpenAPIEvaluatorTypeDTO2DO(gptr.Of(openapiEvaluator.EvaluatorTypeAgent)))
+	assert.Equal(t, entity.EvaluatorTypePairwise, OpenAPIEvaluatorTypeDTO2DO(gptr.Of(openapiEvaluator.EvaluatorTypePairwise)))
+	assert.Equal(t, entity.EvaluatorTypeRule, OpenAPIEvaluatorTypeDTO2DO(gptr.Of(openapiEvaluator.EvaluatorTypeRule)))
+	assert.Equal(t, entity.EvaluatorTypeComposite, OpenAPIEvaluatorTypeDTO2DO(gptr.Of(openapiEvaluator.EvaluatorTypeComposite)))
 	assert.Equal(t, entity.EvaluatorTypePrompt, OpenAPIEvaluatorTypeDTO2DO(nil))
 	assert.Equal(t, entity.EvaluatorTypePrompt, OpenAPIEvaluatorTypeDTO2DO(gptr.Of(openapiEvaluator.EvaluatorType("999"))))
 }
@@ -1040,3 +1046,108 @@ func TestOpenAPIEvaluatorRunConfigDO2DTO(t *testing.T) {
 		assert.Equal(t, "production", *dto.Env)
 	})
 }
+
+func TestOpenAPIRuleConfigRoundTrip(t *testing.T) {
+	assert.Nil(t, OpenAPIRuleConfigDO2DTO(nil))
+	assert.Nil(t, OpenAPIRuleConfigDTO2DO(nil))
+
+	do := &entity.RuleConfig{
+		RuleType:      entity.RuleTypeContains,
+		Keywords:      []string{"a", "b"},
+		ContainsAll:   true,
+		IgnoreCase:    true,
+		BLEUMaxN:      2,
+		PassThreshold: gptr.Of(0.5),
+	}
+	dto := OpenAPIRuleConfigDO2DTO(do)
+	assert.Equal(t, "contains", dto.GetRuleType())
+	assert.Equal(t, int32(2), dto.GetBleuMaxN())
+	assert.Equal(t, do, OpenAPIRuleConfigDTO2DO(dto))
+}
+
+func TestOpenAPICompositeConfigRoundTrip(t *testing.T) {
+	assert.Nil(t, OpenAPICompositeConfigDO2DTO(nil))
+	assert.Nil(t, OpenAPICompositeConfigDTO2DO(nil))
+
+	t.Run("weighted", func(t *testing.T) {
+		do := &entity.CompositeConfig{
+			Components: []*entity.CompositeComponent{
+				{EvaluatorVersionID: 1, Weight: 2},
+				{EvaluatorVersionID: 2, Weight: 1},
+			},
+			Strategy:      entity.CompositeStrategyWeighted,
+			MinSuccessCnt: 1,
+			CodeContent:   "ignored",
+		}
+		dto := OpenAPICompositeConfigDO2DTO(do)
+		assert.Nil(t, dto.CodeContent)
+		assert.Len(t, dto.Components, 2)
+		got := OpenAPICompositeConfigDTO2DO(dto)
+		assert.Equal(t, do.Components, got.Components)
+		assert.Equal(t, entity.CompositeStrategyWeighted, got.Strategy)
+		assert.Equal(t, 1, got.MinSuccessCnt)
+	})
+
+	t.Run("code", func(t *testing.T) {
+		do := &entity.CompositeConfig{
+			Components:   []*entity.CompositeComponent{{EvaluatorVersionID: 1}},
+			Strategy:     entity.CompositeStrategyCode,
+			CodeContent:  "def exec_evaluation(turn): pass",
+			LanguageType: entity.LanguageTypePython,
+		}
+		got := OpenAPICompositeConfigDTO2DO(OpenAPICompositeConfigDO2DTO(do))
+		assert.Equal(t, do.CodeContent, got.CodeContent)
+		assert.Equal(t, entity.LanguageTypePython, got.LanguageType)
+	})
+}
+
+func TestOpenAPIEvaluatorContentNewTypes(t *testing.T) {
+	t.Run("pairwise", func(t *testing.T) {
+		do := &entity.Evaluator{
+			EvaluatorType: entity.EvaluatorTypePairwise,
+			PairwiseEvaluatorVersion: &entity.PairwiseEvaluatorVersion{
+				DisablePositionSwap: true,
+			},
+		}
+		dto := OpenAPIEvaluatorContentDO2DTO(do)
+		assert.NotNil(t, dto.PairwiseEvaluator)
+		assert.True(t, dto.PairwiseEvaluator.GetDisablePositionSwap())
+
+		got, err := OpenAPIEvaluatorContentDTO2DO(dto, entity.EvaluatorTypePairwise)
+		assert.NoError(t, err)
+		assert.True(t, got.PairwiseEvaluatorVersion.DisablePositionSwap)
+	})
+
+	t.Run("rule", func(t *testing.T) {
+		do := &entity.Evaluator{
+			EvaluatorType: entity.EvaluatorTypeRule,
+			RuleEvaluatorVersion: &entity.RuleEvaluatorVersion{
+				RuleConfig: &entity.RuleConfig{RuleType: entity.RuleTypeExactMatch},
+			},
+		}
+		dto := OpenAPIEvaluatorContentDO2DTO(do)
+		assert.Equal(t, "exact_match", dto.RuleEvaluator.GetRuleType())
+
+		got, err := OpenAPIEvaluatorContentDTO2DO(dto, entity.EvaluatorTypeRule)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.RuleTypeExactMatch, got.RuleEvaluatorVersion.RuleConfig.RuleType)
+	})
+
+	t.Run("composite", func(t *testing.T) {
+		do := &entity.Evaluator{
+			EvaluatorType: entity.EvaluatorTypeComposite,
+			CompositeEvaluatorVersion: &entity.CompositeEvaluatorVersion{
+				CompositeConfig: &entity.CompositeConfig{
+					Components: []*entity.CompositeComponent{{EvaluatorVersionID: 3}},
+					Strategy:   entity.CompositeStrategyMean,
+				},
+			},
+		}
+		dto := OpenAPIEvaluatorContentDO2DTO(do)
+		assert.Len(t, dto.CompositeEvaluator.Components, 1)
+
+		got, err := OpenAPIEvaluatorContentDTO2DO(dto, entity.EvaluatorTypeComposite)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{3}, got.CompositeEvaluatorVersion.CompositeConfig.GetComponentVersionIDs())
+	})
+}
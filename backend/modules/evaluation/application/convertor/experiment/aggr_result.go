@@ -59,6 +59,7 @@ func EvaluatorResultsDOToDTO(result *entity.EvaluatorAggregateResult) *domain_ex
 		Name:               result.Name,
 		Version:            result.Version,
 		Alias:              gptr.Of(result.Alias),
+		Trial:              EvaluatorTrialAggregateDO2DTO(result.Trial),
 	}
 }
 
@@ -71,9 +72,38 @@ func AnnotationResultDOToDTO(result *entity.AnnotationAggregateResult) *domain_e
 		TagKeyID:          result.TagKeyID,
 		AggregatorResults: AggregatorResultDOsToDTOs(result.AggregatorResults),
 		Name:              result.Name,
+		Agreement:         AnnotationAgreementDOToDTO(result.Agreement),
 	}
 }
 
+func AnnotationAgreementDOToDTO(agreement *entity.AnnotationAgreement) *domain_expt.AnnotationAgreement {
+	if agreement == nil {
+		return nil
+	}
+	dto := &domain_expt.AnnotationAgreement{
+		AnnotatorCnt:      gptr.Of(int32(agreement.AnnotatorCnt)),
+		UnitCnt:           gptr.Of(int32(agreement.UnitCnt)),
+		CohenKappa:        agreement.CohenKappa,
+		FleissKappa:       agreement.FleissKappa,
+		KrippendorffAlpha: agreement.KrippendorffAlpha,
+	}
+	for _, judge := range agreement.JudgeAgreements {
+		if judge == nil {
+			continue
+		}
+		dto.JudgeAgreements = append(dto.JudgeAgreements, &domain_expt.JudgeHumanAgreement{
+			EvaluatorVersionID: judge.EvaluatorVersionID,
+			Alias:              gptr.Of(judge.Alias),
+			PairedCnt:          gptr.Of(int32(judge.PairedCnt)),
+			PearsonCorrelation: judge.PearsonCorrelation,
+			MeanAbsoluteError:  judge.MeanAbsoluteError,
+			KrippendorffAlpha:  judge.KrippendorffAlpha,
+			CohenKappa:         judge.CohenKappa,
+		})
+	}
+	return dto
+}
+
 func AggregatorResultDOsToDTOs(result []*entity.AggregatorResult) []*domain_expt.AggregatorResult_ {
 	if len(result) == 0 {
 		return nil
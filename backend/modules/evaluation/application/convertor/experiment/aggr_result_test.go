@@ -216,6 +216,33 @@ func TestAnnotationResultDOToDTO(t *testing.T) {
 		assert.Equal(t, int64(7), got.TagKeyID)
 		assert.Equal(t, "quality", *got.Name)
 		assert.Len(t, got.AggregatorResults, 1)
+		assert.Nil(t, got.Agreement)
+	}
+
+	got = AnnotationResultDOToDTO(&entity.AnnotationAggregateResult{
+		TagKeyID: 7,
+		Agreement: &entity.AnnotationAgreement{
+			AnnotatorCnt: 3,
+			UnitCnt:      10,
+			FleissKappa:  gptr.Of(0.6),
+			JudgeAgreements: []*entity.JudgeHumanAgreement{
+				{EvaluatorVersionID: 11, Alias: "judge_b", PairedCnt: 8, PearsonCorrelation: gptr.Of(0.9)},
+			},
+		},
+	})
+	if assert.NotNil(t, got.Agreement) {
+		assert.Equal(t, int32(3), got.Agreement.GetAnnotatorCnt())
+		assert.Equal(t, int32(10), got.Agreement.GetUnitCnt())
+		assert.Equal(t, 0.6, got.Agreement.GetFleissKappa())
+		assert.False(t, got.Agreement.IsSetCohenKappa())
+		if assert.Len(t, got.Agreement.GetJudgeAgreements(), 1) {
+			judge := got.Agreement.GetJudgeAgreements()[0]
+			assert.Equal(t, int64(11), judge.GetEvaluatorVersionID())
+			assert.Equal(t, "judge_b", judge.GetAlias())
+			assert.Equal(t, int32(8), judge.GetPairedCnt())
+			assert.Equal(t, 0.9, judge.GetPearsonCorrelation())
+			assert.False(t, judge.IsSetMeanAbsoluteError())
+		}
 	}
 }
 
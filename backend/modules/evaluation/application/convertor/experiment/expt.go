@@ -81,6 +81,7 @@ func toTargetFieldMappingDO(mapping *domain_expt.TargetFieldMapping, rtp *common
 				FieldName: fm.GetFieldName(),
 				FromField: fm.GetFromFieldName(),
 				Value:     fm.GetConstValue(),
+				Expr:      fm.GetExpr(),
 			})
 		}
 		tic.EvalSetAdapter.FieldConfs = fc
@@ -112,6 +113,7 @@ func toEvaluatorConfDO(mapping []*domain_expt.EvaluatorFieldMapping, runConfigMa
 				FieldName: fes.GetFieldName(),
 				FromField: fes.GetFromFieldName(),
 				Value:     fes.GetConstValue(),
+				Expr:      fes.GetExpr(),
 			})
 		}
 		tf := make([]*entity.FieldConf, 0, len(fm.GetFromTarget()))
@@ -120,6 +122,7 @@ func toEvaluatorConfDO(mapping []*domain_expt.EvaluatorFieldMapping, runConfigMa
 				FieldName: ft.GetFieldName(),
 				FromField: ft.GetFromFieldName(),
 				Value:     ft.GetConstValue(),
+				Expr:      ft.GetExpr(),
 			})
 		}
 
@@ -202,6 +205,7 @@ func (e *EvalConfConvert) ConvertEntityToDTO(ec *entity.EvaluationConfiguration)
 						FieldName:     gptr.Of(fc.FieldName),
 						FromFieldName: gptr.Of(fc.FromField),
 						ConstValue:    gptr.Of(fc.Value),
+						Expr:          gptr.Of(fc.Expr),
 					})
 				}
 			}
@@ -211,6 +215,7 @@ func (e *EvalConfConvert) ConvertEntityToDTO(ec *entity.EvaluationConfiguration)
 						FieldName:     gptr.Of(fc.FieldName),
 						FromFieldName: gptr.Of(fc.FromField),
 						ConstValue:    gptr.Of(fc.Value),
+						Expr:          gptr.Of(fc.Expr),
 					})
 				}
 			}
@@ -231,6 +236,7 @@ func (e *EvalConfConvert) ConvertEntityToDTO(ec *entity.EvaluationConfiguration)
 					FieldName:     gptr.Of(fc.FieldName),
 					FromFieldName: gptr.Of(fc.FromField),
 					ConstValue:    gptr.Of(fc.Value),
+					Expr:          gptr.Of(fc.Expr),
 				})
 			}
 		}
@@ -435,6 +441,16 @@ func ToExptDTO(experiment *entity.Experiment) *domain_expt.Experiment {
 	}
 
 	res.NotificationConf = notificationConfDO2DTO(experiment.NotificationConf)
+	if experiment.EvalConf != nil {
+		res.QualityGateConf = QualityGateConfDO2DTO(experiment.EvalConf.QualityGateConf)
+		res.CostConf = CostConfDO2DTO(experiment.EvalConf.CostConf)
+		res.ResultCacheConf = ResultCacheConfDO2DTO(experiment.EvalConf.ResultCacheConf)
+		res.SamplingConf = SamplingConfDO2DTO(experiment.EvalConf.SamplingConf)
+		res.TrialConf = TrialConfDO2DTO(experiment.EvalConf.TrialConf)
+		res.UserSimulatorConf = UserSimulatorConfDO2DTO(experiment.EvalConf.UserSimulatorConf)
+	}
+	res.QualityGateResult_ = QualityGateResultDO2DTO(experiment.QualityGateResult)
+	res.SamplingResult_ = SamplingResultDO2DTO(experiment.SamplingResult)
 	res.Evaluators = make([]*evaluatordto.Evaluator, 0, len(experiment.Evaluators))
 	for _, evaluatorDO := range experiment.Evaluators {
 		res.Evaluators = append(res.Evaluators, evaluator.ConvertEvaluatorDO2DTO(evaluatorDO))
@@ -719,6 +735,7 @@ func fieldConfsToFieldMappingDTO(fcs []*entity.FieldConf) []*domain_expt.FieldMa
 		out = append(out, &domain_expt.FieldMapping{
 			FieldName:     gptr.Of(fc.FieldName),
 			FromFieldName: gptr.Of(fc.FromField),
+			Expr:          gptr.Of(fc.Expr),
 		})
 	}
 	return out
@@ -777,6 +794,9 @@ func ToExptStatsDTO(stats *entity.ExptStats, aggrResult *entity.ExptAggregateRes
 			InputTokens:  gptr.Of(stats.InputTokenCost),
 			OutputTokens: gptr.Of(stats.OutputTokenCost),
 		},
+		CostStats:             costStatsDO2DTO(stats),
+		EffectiveConcurNum:    gptr.Of(stats.EffectiveConcurNum),
+		BudgetDeferredItemCnt: gptr.Of(stats.BudgetDeferredCnt),
 	}
 
 	if aggrResult != nil {
@@ -894,6 +914,42 @@ func ConvertCreateReq(cer *expt.CreateExperimentRequest, evaluatorVersionRunConf
 		}
 		param.ExptConf = evaluationConfiguration
 	}
+	if cer.QualityGateConf != nil {
+		if param.ExptConf == nil {
+			param.ExptConf = &entity.EvaluationConfiguration{}
+		}
+		param.ExptConf.QualityGateConf = QualityGateConfDTO2DO(cer.QualityGateConf)
+	}
+	if cer.CostConf != nil {
+		if param.ExptConf == nil {
+			param.ExptConf = &entity.EvaluationConfiguration{}
+		}
+		param.ExptConf.CostConf = CostConfDTO2DO(cer.CostConf)
+	}
+	if cer.ResultCacheConf != nil {
+		if param.ExptConf == nil {
+			param.ExptConf = &entity.EvaluationConfiguration{}
+		}
+		param.ExptConf.ResultCacheConf = ResultCacheConfDTO2DO(cer.ResultCacheConf)
+	}
+	if cer.SamplingConf != nil {
+		if param.ExptConf == nil {
+			param.ExptConf = &entity.EvaluationConfiguration{}
+		}
+		param.ExptConf.SamplingConf = SamplingConfDTO2DO(cer.SamplingConf)
+	}
+	if cer.TrialConf != nil {
+		if param.ExptConf == nil {
+			param.ExptConf = &entity.EvaluationConfiguration{}
+		}
+		param.ExptConf.TrialConf = TrialConfDTO2DO(cer.TrialConf)
+	}
+	if cer.UserSimulatorConf != nil {
+		if param.ExptConf == nil {
+			param.ExptConf = &entity.EvaluationConfiguration{}
+		}
+		param.ExptConf.UserSimulatorConf = UserSimulatorConfDTO2DO(cer.UserSimulatorConf)
+	}
 
 	if cer.IsSetExptTemplateID() {
 		param.ExptTemplateID = cer.GetExptTemplateID()
@@ -0,0 +1,100 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+// CostConfDTO2DO 取值合法性由 domain 层 ExptCostConf.Validate 统一校验
+func CostConfDTO2DO(conf *domain_expt.ExptCostConf) *entity.ExptCostConf {
+	if conf == nil {
+		return nil
+	}
+	return &entity.ExptCostConf{
+		BudgetCap:     conf.BudgetCap,
+		TargetModelID: conf.TargetModelID,
+		PriceConf:     modelPriceConfDTO2DO(conf.PriceConf),
+	}
+}
+
+func CostConfDO2DTO(conf *entity.ExptCostConf) *domain_expt.ExptCostConf {
+	if conf == nil {
+		return nil
+	}
+	return &domain_expt.ExptCostConf{
+		BudgetCap:     conf.BudgetCap,
+		TargetModelID: conf.TargetModelID,
+		PriceConf:     modelPriceConfDO2DTO(conf.PriceConf),
+	}
+}
+
+func modelPriceConfDTO2DO(conf *domain_expt.ModelPriceConf) *entity.ModelPriceConf {
+	if conf == nil {
+		return nil
+	}
+	res := &entity.ModelPriceConf{
+		Currency: conf.GetCurrency(),
+		Default:  modelPriceDTO2DO(conf.DefaultPrice),
+		Models:   make([]*entity.ModelPrice, 0, len(conf.GetModels())),
+	}
+	for _, p := range conf.GetModels() {
+		if p == nil {
+			continue
+		}
+		res.Models = append(res.Models, modelPriceDTO2DO(p))
+	}
+	return res
+}
+
+func modelPriceConfDO2DTO(conf *entity.ModelPriceConf) *domain_expt.ModelPriceConf {
+	if conf == nil {
+		return nil
+	}
+	res := &domain_expt.ModelPriceConf{
+		Currency:     gptr.Of(conf.Currency),
+		DefaultPrice: modelPriceDO2DTO(conf.Default),
+		Models:       make([]*domain_expt.ModelPrice, 0, len(conf.Models)),
+	}
+	for _, p := range conf.Models {
+		if p == nil {
+			continue
+		}
+		res.Models = append(res.Models, modelPriceDO2DTO(p))
+	}
+	return res
+}
+
+func modelPriceDTO2DO(price *domain_expt.ModelPrice) *entity.ModelPrice {
+	if price == nil {
+		return nil
+	}
+	return &entity.ModelPrice{
+		ModelID:                     price.GetModelID(),
+		InputPricePerMillionTokens:  price.GetInputPricePerMillionTokens(),
+		OutputPricePerMillionTokens: price.GetOutputPricePerMillionTokens(),
+	}
+}
+
+func modelPriceDO2DTO(price *entity.ModelPrice) *domain_expt.ModelPrice {
+	if price == nil {
+		return nil
+	}
+	return &domain_expt.ModelPrice{
+		ModelID:                     gptr.Of(price.ModelID),
+		InputPricePerMillionTokens:  gptr.Of(price.InputPricePerMillionTokens),
+		OutputPricePerMillionTokens: gptr.Of(price.OutputPricePerMillionTokens),
+	}
+}
+
+func costStatsDO2DTO(stats *entity.ExptStats) *domain_expt.ExptCostStats {
+	return &domain_expt.ExptCostStats{
+		TargetCost:    gptr.Of(stats.TargetCost),
+		EvaluatorCost: gptr.Of(stats.EvaluatorCost),
+		TotalCost:     gptr.Of(stats.GetTotalCost()),
+	}
+}
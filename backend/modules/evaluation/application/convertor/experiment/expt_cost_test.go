@@ -0,0 +1,67 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+
+	domainExpt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	exptpb "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func TestCostConfConvert(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, CostConfDTO2DO(nil))
+	assert.Nil(t, CostConfDO2DTO(nil))
+
+	dto := &domainExpt.ExptCostConf{
+		BudgetCap:     gptr.Of(12.5),
+		TargetModelID: gptr.Of(int64(7)),
+		PriceConf: &domainExpt.ModelPriceConf{
+			Currency:     gptr.Of("USD"),
+			DefaultPrice: &domainExpt.ModelPrice{InputPricePerMillionTokens: gptr.Of(1.0), OutputPricePerMillionTokens: gptr.Of(2.0)},
+			Models:       []*domainExpt.ModelPrice{{ModelID: gptr.Of(int64(7)), InputPricePerMillionTokens: gptr.Of(10.0)}, nil},
+		},
+	}
+	do := CostConfDTO2DO(dto)
+	assert.Equal(t, 12.5, gptr.Indirect(do.BudgetCap))
+	assert.Equal(t, int64(7), gptr.Indirect(do.TargetModelID))
+	assert.Equal(t, "USD", do.PriceConf.Currency)
+	assert.Equal(t, 2.0, do.PriceConf.Default.OutputPricePerMillionTokens)
+	if assert.Len(t, do.PriceConf.Models, 1) {
+		assert.Equal(t, 10.0, do.PriceConf.GetPrice(7).InputPricePerMillionTokens)
+	}
+
+	back := CostConfDO2DTO(do)
+	assert.Equal(t, dto.GetBudgetCap(), back.GetBudgetCap())
+	assert.Equal(t, dto.GetPriceConf().GetCurrency(), back.GetPriceConf().GetCurrency())
+	assert.Len(t, back.GetPriceConf().GetModels(), 1)
+}
+
+func TestCostConf_CreateReqAndStats(t *testing.T) {
+	t.Parallel()
+
+	param, err := ConvertCreateReq(&exptpb.CreateExperimentRequest{
+		WorkspaceID: 1,
+		CostConf:    &domainExpt.ExptCostConf{BudgetCap: gptr.Of(3.0)},
+	}, nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, param.ExptConf) && assert.NotNil(t, param.ExptConf.CostConf) {
+		assert.Equal(t, 3.0, gptr.Indirect(param.ExptConf.CostConf.BudgetCap))
+	}
+
+	dto := ToExptDTO(&entity.Experiment{
+		ID:       1,
+		EvalConf: &entity.EvaluationConfiguration{CostConf: &entity.ExptCostConf{BudgetCap: gptr.Of(3.0)}},
+		Stats:    &entity.ExptStats{TargetCost: 1.5, EvaluatorCost: 0.25},
+	})
+	assert.Equal(t, 3.0, dto.GetCostConf().GetBudgetCap())
+	assert.Equal(t, 1.75, dto.GetExptStats().GetCostStats().GetTotalCost())
+	assert.Equal(t, 0.25, dto.GetExptStats().GetCostStats().GetEvaluatorCost())
+}
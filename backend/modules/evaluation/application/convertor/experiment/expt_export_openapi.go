@@ -11,6 +11,7 @@ import (
 	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
 	openapiExperiment "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain_openapi/experiment"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
 )
 
 // OpenAPIExportColumnSpecDTO2Inner 将 OpenAPI 的 ExptResultExportColumnSpec 转为内部 expt 包版本，
@@ -51,14 +52,13 @@ func OpenAPIExportColumnSpecDTO2Inner(from *openapiExperiment.ExptResultExportCo
 	return to
 }
 
-// OpenAPIExportTypeDTO2Inner OpenAPI 导出类型 -> 内部 domain/expt 导出类型。空值默认 CSV。
+// OpenAPIExportTypeDTO2Inner OpenAPI 导出类型 -> 内部 domain/expt 导出类型。空值与未知值默认 CSV。
 func OpenAPIExportTypeDTO2Inner(from openapiExperiment.ExptResultExportType) domain_expt.ExptResultExportType {
-	switch from {
-	case openapiExperiment.ExptResultExportTypeCSV, "":
-		return domain_expt.ExptResultExportTypeCSV
-	default:
+	format, err := entity.ParseExptResultExportFormat(from)
+	if err != nil {
 		return domain_expt.ExptResultExportTypeCSV
 	}
+	return string(format)
 }
 
 // InnerExportRecordDTO2OpenAPI 将内部 domain_expt.ExptResultExportRecord（experimentApp.GetExptResultExportRecord
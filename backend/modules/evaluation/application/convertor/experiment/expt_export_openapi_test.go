@@ -160,6 +160,9 @@ func TestOpenAPIExportTypeDTO2Inner(t *testing.T) {
 		{name: "csv", in: openapiExperiment.ExptResultExportTypeCSV, want: domain_expt.ExptResultExportTypeCSV},
 		{name: "empty defaults to csv", in: "", want: domain_expt.ExptResultExportTypeCSV},
 		{name: "unknown defaults to csv", in: openapiExperiment.ExptResultExportType("unknown"), want: domain_expt.ExptResultExportTypeCSV},
+		{name: "xlsx", in: openapiExperiment.ExptResultExportType("XLSX"), want: domain_expt.ExptResultExportType("XLSX")},
+		{name: "jsonl case insensitive", in: openapiExperiment.ExptResultExportType("jsonl"), want: domain_expt.ExptResultExportType("JSONL")},
+		{name: "parquet", in: openapiExperiment.ExptResultExportType("Parquet"), want: domain_expt.ExptResultExportType("PARQUET")},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,84 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+// ExptGroupedAggrParamDTO2DO 参数合法性由 domain 层 ExptGroupedAggrService 统一校验
+func ExptGroupedAggrParamDTO2DO(req *expt.GetExptGroupedAggrResultRequest) *entity.ExptGroupedAggrParam {
+	return &entity.ExptGroupedAggrParam{
+		SpaceID:   req.GetWorkspaceID(),
+		ExptIDs:   req.GetExptIds(),
+		Dimension: ExptGroupDimensionDTO2DO(req.GetDimension()),
+		MaxGroups: int(req.GetMaxGroups()),
+	}
+}
+
+func ExptGroupDimensionDTO2DO(dim *domain_expt.ExptGroupDimension) *entity.ExptGroupDimension {
+	if dim == nil {
+		return nil
+	}
+	return &entity.ExptGroupDimension{
+		Type: entity.ExptGroupDimensionType(dim.GetType()),
+		Key:  dim.GetKey(),
+	}
+}
+
+func ExptGroupDimensionDO2DTO(dim *entity.ExptGroupDimension) *domain_expt.ExptGroupDimension {
+	if dim == nil {
+		return nil
+	}
+	return &domain_expt.ExptGroupDimension{
+		Type: gptr.Of(domain_expt.ExptGroupDimensionType(dim.Type)),
+		Key:  gptr.Of(dim.Key),
+	}
+}
+
+func ExptGroupedAggrDOs2DTOs(results []*entity.ExptGroupedAggr) []*domain_expt.ExptGroupedAggr {
+	res := make([]*domain_expt.ExptGroupedAggr, 0, len(results))
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+		groups := make([]*domain_expt.ExptAggrGroup, 0, len(r.Groups))
+		for _, g := range r.Groups {
+			if g == nil {
+				continue
+			}
+			groups = append(groups, exptAggrGroupDO2DTO(g))
+		}
+		res = append(res, &domain_expt.ExptGroupedAggr{
+			ExptID:    gptr.Of(r.ExptID),
+			Groups:    groups,
+			Truncated: gptr.Of(r.Truncated),
+		})
+	}
+	return res
+}
+
+func exptAggrGroupDO2DTO(group *entity.ExptAggrGroup) *domain_expt.ExptAggrGroup {
+	evaluatorResults := make([]*domain_expt.ExptGroupEvaluatorAggr, 0, len(group.EvaluatorResults))
+	for _, er := range group.EvaluatorResults {
+		if er == nil {
+			continue
+		}
+		evaluatorResults = append(evaluatorResults, &domain_expt.ExptGroupEvaluatorAggr{
+			EvaluatorVersionID: gptr.Of(er.EvaluatorVersionID),
+			Alias:              gptr.Of(er.Alias),
+			Count:              gptr.Of(er.Count),
+			AggregatorResults:  AggregatorResultDOsToDTOs(er.AggregatorResults),
+		})
+	}
+	return &domain_expt.ExptAggrGroup{
+		Value:            gptr.Of(group.Value),
+		TurnCount:        gptr.Of(group.TurnCount),
+		EvaluatorResults: evaluatorResults,
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	domain_common "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/common"
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func ExptPairwiseRankParamDTO2DO(req *expt.SubmitExptPairwiseRankRequest) *entity.ExptPairwiseRankParam {
+	return &entity.ExptPairwiseRankParam{
+		SpaceID:            req.GetWorkspaceID(),
+		ExptIDs:            req.GetExptIds(),
+		EvaluatorVersionID: req.GetEvaluatorVersionID(),
+		OutputFieldKey:     req.GetOutputFieldKey(),
+		MaxTurnCnt:         int(req.GetMaxTurnCnt()),
+		EloK:               req.GetEloK(),
+		Concurrency:        int(req.GetConcurrency()),
+	}
+}
+
+func ExptPairwiseRankRecordDO2DTO(do *entity.ExptPairwiseRankRecord) *domain_expt.ExptPairwiseRankRecord {
+	if do == nil {
+		return nil
+	}
+	dto := &domain_expt.ExptPairwiseRankRecord{
+		RecordID:           do.ID,
+		WorkspaceID:        do.SpaceID,
+		EvaluatorVersionID: do.EvaluatorVersionID,
+		Status:             ExptPairwiseRankStatus2DTO(do.Status),
+		BaseInfo: &domain_common.BaseInfo{
+			CreatedBy: &domain_common.UserInfo{
+				UserID: ptr.Of(do.CreatedBy),
+			},
+			CreatedAt: ptr.Of(do.CreatedAt.Unix()),
+			UpdatedAt: ptr.Of(do.UpdatedAt.Unix()),
+		},
+	}
+	if do.Param != nil {
+		dto.ExptIds = do.Param.ExptIDs
+	}
+	if len(do.ErrMsg) > 0 {
+		dto.ErrorMsg = ptr.Of(do.ErrMsg)
+	}
+	if do.Result == nil {
+		return dto
+	}
+	for _, pr := range do.Result.PairResults {
+		dto.PairResults = append(dto.PairResults, &domain_expt.ExptPairwiseStat{
+			ExptIDA: ptr.Of(pr.ExptAID),
+			ExptIDB: ptr.Of(pr.ExptBID),
+			AWins:   ptr.Of(pr.AWins),
+			BWins:   ptr.Of(pr.BWins),
+			Ties:    ptr.Of(pr.Ties),
+			Failed:  ptr.Of(pr.Failed),
+		})
+	}
+	for _, r := range do.Result.Rankings {
+		dto.Rankings = append(dto.Rankings, &domain_expt.ExptPairwiseRanking{
+			ExptID:  ptr.Of(r.ExptID),
+			Rank:    ptr.Of(int32(r.Rank)),
+			Wins:    ptr.Of(r.Wins),
+			Losses:  ptr.Of(r.Losses),
+			Ties:    ptr.Of(r.Ties),
+			WinRate: ptr.Of(r.WinRate),
+			Elo:     ptr.Of(r.Elo),
+			BtScore: ptr.Of(r.BTScore),
+		})
+	}
+	return dto
+}
+
+func ExptPairwiseRankStatus2DTO(status entity.ExptPairwiseRankStatus) domain_expt.ExptPairwiseRankStatus {
+	switch status {
+	case entity.ExptPairwiseRankStatus_Running:
+		return domain_expt.ExptPairwiseRankStatusRunning
+	case entity.ExptPairwiseRankStatus_Success:
+		return domain_expt.ExptPairwiseRankStatusSuccess
+	case entity.ExptPairwiseRankStatus_Failed:
+		return domain_expt.ExptPairwiseRankStatusFailed
+	default:
+		return domain_expt.ExptPairwiseRankStatusUnknown
+	}
+}
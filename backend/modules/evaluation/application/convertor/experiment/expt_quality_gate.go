@@ -0,0 +1,171 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	openapi_experiment "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain_openapi/experiment"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+// QualityGateConfDTO2DO 规则合法性由 domain 层 ExptQualityGateConf.Validate 统一校验
+func QualityGateConfDTO2DO(conf *domain_expt.ExptQualityGateConf) *entity.ExptQualityGateConf {
+	if conf == nil {
+		return nil
+	}
+	res := &entity.ExptQualityGateConf{Rules: make([]*entity.ExptQualityGateRule, 0, len(conf.GetRules()))}
+	for _, rule := range conf.GetRules() {
+		if rule == nil {
+			continue
+		}
+		res.Rules = append(res.Rules, &entity.ExptQualityGateRule{
+			Name:               rule.GetName(),
+			Metric:             entity.QualityGateMetric(rule.GetMetric()),
+			Operator:           entity.QualityGateOperator(rule.GetOperator()),
+			Threshold:          rule.GetThreshold(),
+			EvaluatorVersionID: rule.GetEvaluatorVersionID(),
+			EvaluatorAlias:     rule.GetEvaluatorAlias(),
+			BaselineExptID:     rule.GetBaselineExptID(),
+			ScoreTolerance:     rule.GetScoreTolerance(),
+		})
+	}
+	return res
+}
+
+func QualityGateConfDO2DTO(conf *entity.ExptQualityGateConf) *domain_expt.ExptQualityGateConf {
+	if conf == nil {
+		return nil
+	}
+	res := &domain_expt.ExptQualityGateConf{Rules: make([]*domain_expt.ExptQualityGateRule, 0, len(conf.Rules))}
+	for _, rule := range conf.Rules {
+		res.Rules = append(res.Rules, qualityGateRuleDO2DTO(rule))
+	}
+	return res
+}
+
+func qualityGateRuleDO2DTO(rule *entity.ExptQualityGateRule) *domain_expt.ExptQualityGateRule {
+	if rule == nil {
+		return nil
+	}
+	dto := &domain_expt.ExptQualityGateRule{
+		Metric:    gptr.Of(string(rule.Metric)),
+		Operator:  gptr.Of(string(rule.Operator)),
+		Threshold: gptr.Of(rule.Threshold),
+	}
+	if rule.Name != "" {
+		dto.Name = gptr.Of(rule.Name)
+	}
+	if rule.EvaluatorVersionID > 0 {
+		dto.EvaluatorVersionID = gptr.Of(rule.EvaluatorVersionID)
+	}
+	if rule.EvaluatorAlias != "" {
+		dto.EvaluatorAlias = gptr.Of(rule.EvaluatorAlias)
+	}
+	if rule.BaselineExptID > 0 {
+		dto.BaselineExptID = gptr.Of(rule.BaselineExptID)
+		dto.ScoreTolerance = gptr.Of(rule.ScoreTolerance)
+	}
+	return dto
+}
+
+func QualityGateResultDO2DTO(result *entity.ExptQualityGateResult) *domain_expt.ExptQualityGateResult_ {
+	if result == nil {
+		return nil
+	}
+	dto := &domain_expt.ExptQualityGateResult_{
+		Status:      gptr.Of(string(result.Status)),
+		Passed:      gptr.Of(result.Passed),
+		RuleResults: make([]*domain_expt.ExptQualityGateRuleResult_, 0, len(result.RuleResults)),
+		EvaluatedAt: gptr.Of(result.EvaluatedAt),
+	}
+	for _, rr := range result.RuleResults {
+		if rr == nil {
+			continue
+		}
+		ruleDTO := &domain_expt.ExptQualityGateRuleResult_{
+			Rule:   qualityGateRuleDO2DTO(rr.Rule),
+			Actual: rr.Actual,
+			Status: gptr.Of(string(rr.Status)),
+		}
+		if rr.Message != "" {
+			ruleDTO.Message = gptr.Of(rr.Message)
+		}
+		dto.RuleResults = append(dto.RuleResults, ruleDTO)
+	}
+	return dto
+}
+
+// OpenAPIQualityGateConfDTO2Domain OpenAPI 与 domain 门禁结构同构, 逐字段拷贝
+func OpenAPIQualityGateConfDTO2Domain(conf *openapi_experiment.ExptQualityGateConf) *domain_expt.ExptQualityGateConf {
+	if conf == nil {
+		return nil
+	}
+	res := &domain_expt.ExptQualityGateConf{Rules: make([]*domain_expt.ExptQualityGateRule, 0, len(conf.GetRules()))}
+	for _, rule := range conf.GetRules() {
+		if rule == nil {
+			continue
+		}
+		res.Rules = append(res.Rules, &domain_expt.ExptQualityGateRule{
+			Name:               rule.Name,
+			Metric:             rule.Metric,
+			Operator:           rule.Operator,
+			Threshold:          rule.Threshold,
+			EvaluatorVersionID: rule.EvaluatorVersionID,
+			EvaluatorAlias:     rule.EvaluatorAlias,
+			BaselineExptID:     rule.BaselineExptID,
+			ScoreTolerance:     rule.ScoreTolerance,
+		})
+	}
+	return res
+}
+
+func domainQualityGateConfToOpenAPI(conf *domain_expt.ExptQualityGateConf) *openapi_experiment.ExptQualityGateConf {
+	if conf == nil {
+		return nil
+	}
+	res := &openapi_experiment.ExptQualityGateConf{Rules: make([]*openapi_experiment.ExptQualityGateRule, 0, len(conf.GetRules()))}
+	for _, rule := range conf.GetRules() {
+		res.Rules = append(res.Rules, domainQualityGateRuleToOpenAPI(rule))
+	}
+	return res
+}
+
+func domainQualityGateRuleToOpenAPI(rule *domain_expt.ExptQualityGateRule) *openapi_experiment.ExptQualityGateRule {
+	if rule == nil {
+		return nil
+	}
+	return &openapi_experiment.ExptQualityGateRule{
+		Name:               rule.Name,
+		Metric:             rule.Metric,
+		Operator:           rule.Operator,
+		Threshold:          rule.Threshold,
+		EvaluatorVersionID: rule.EvaluatorVersionID,
+		EvaluatorAlias:     rule.EvaluatorAlias,
+		BaselineExptID:     rule.BaselineExptID,
+		ScoreTolerance:     rule.ScoreTolerance,
+	}
+}
+
+func domainQualityGateResultToOpenAPI(result *domain_expt.ExptQualityGateResult_) *openapi_experiment.ExptQualityGateResult_ {
+	if result == nil {
+		return nil
+	}
+	res := &openapi_experiment.ExptQualityGateResult_{
+		Status:      result.Status,
+		Passed:      result.Passed,
+		RuleResults: make([]*openapi_experiment.ExptQualityGateRuleResult_, 0, len(result.GetRuleResults())),
+		EvaluatedAt: result.EvaluatedAt,
+	}
+	for _, rr := range result.GetRuleResults() {
+		res.RuleResults = append(res.RuleResults, &openapi_experiment.ExptQualityGateRuleResult_{
+			Rule:    domainQualityGateRuleToOpenAPI(rr.GetRule()),
+			Actual:  rr.Actual,
+			Status:  rr.Status,
+			Message: rr.Message,
+		})
+	}
+	return res
+}
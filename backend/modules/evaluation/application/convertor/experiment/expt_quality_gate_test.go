@@ -0,0 +1,98 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+
+	domainExpt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	openapiExperiment "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain_openapi/experiment"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func TestQualityGateConfConvert(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, QualityGateConfDTO2DO(nil))
+	assert.Nil(t, QualityGateConfDO2DTO(nil))
+	assert.Nil(t, OpenAPIQualityGateConfDTO2Domain(nil))
+
+	oapi := &openapiExperiment.ExptQualityGateConf{Rules: []*openapiExperiment.ExptQualityGateRule{
+		{
+			Metric:             gptr.Of(domainExpt.QualityGateMetricEvaluatorAvgScore),
+			Operator:           gptr.Of(">="),
+			Threshold:          gptr.Of(0.8),
+			EvaluatorVersionID: gptr.Of(int64(11)),
+		},
+		{
+			Name:           gptr.Of("no regression"),
+			Metric:         gptr.Of(domainExpt.QualityGateMetricRegressionRate),
+			Operator:       gptr.Of("<="),
+			Threshold:      gptr.Of(0.0),
+			BaselineExptID: gptr.Of(int64(22)),
+			ScoreTolerance: gptr.Of(0.05),
+		},
+		nil,
+	}}
+
+	do := QualityGateConfDTO2DO(OpenAPIQualityGateConfDTO2Domain(oapi))
+	if assert.Len(t, do.Rules, 2) {
+		assert.Equal(t, entity.QualityGateMetricEvaluatorAvgScore, do.Rules[0].Metric)
+		assert.Equal(t, entity.QualityGateOperatorGE, do.Rules[0].Operator)
+		assert.Equal(t, int64(11), do.Rules[0].EvaluatorVersionID)
+		assert.Equal(t, int64(22), do.Rules[1].BaselineExptID)
+		assert.Equal(t, 0.05, do.Rules[1].ScoreTolerance)
+	}
+	assert.NoError(t, do.Validate())
+
+	back := domainQualityGateConfToOpenAPI(QualityGateConfDO2DTO(do))
+	assert.Equal(t, oapi.Rules[:2], back.Rules)
+}
+
+func TestQualityGateResultConvert(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, QualityGateResultDO2DTO(nil))
+	assert.Nil(t, domainQualityGateResultToOpenAPI(nil))
+
+	rule := &entity.ExptQualityGateRule{Metric: entity.QualityGateMetricFailRate, Operator: entity.QualityGateOperatorLT, Threshold: 0.02}
+	result := entity.NewExptQualityGateResult([]*entity.ExptQualityGateRuleResult{
+		{Rule: rule, Actual: gptr.Of(0.1), Status: entity.QualityGateStatusFailed, Message: "fail_rate 0.1 not < 0.02"},
+	}, 1700000000000)
+
+	got := domainQualityGateResultToOpenAPI(QualityGateResultDO2DTO(result))
+	assert.Equal(t, domainExpt.QualityGateStatusFailed, got.GetStatus())
+	assert.False(t, got.GetPassed())
+	assert.Equal(t, int64(1700000000000), got.GetEvaluatedAt())
+	if assert.Len(t, got.RuleResults, 1) {
+		assert.Equal(t, 0.1, got.RuleResults[0].GetActual())
+		assert.Equal(t, "fail_rate 0.1 not < 0.02", got.RuleResults[0].GetMessage())
+		assert.Equal(t, domainExpt.QualityGateMetricFailRate, got.RuleResults[0].GetRule().GetMetric())
+	}
+}
+
+func TestToExptDTO_QualityGate(t *testing.T) {
+	t.Parallel()
+
+	conf := &entity.ExptQualityGateConf{Rules: []*entity.ExptQualityGateRule{
+		{Metric: entity.QualityGateMetricWeightedAvgScore, Operator: entity.QualityGateOperatorGE, Threshold: 0.6},
+	}}
+	expt := &entity.Experiment{
+		ID:                1,
+		EvalConf:          &entity.EvaluationConfiguration{QualityGateConf: conf},
+		QualityGateResult: entity.NewExptQualityGateResult(nil, 1),
+	}
+	dto := ToExptDTO(expt)
+	if assert.NotNil(t, dto.QualityGateConf) {
+		assert.Len(t, dto.QualityGateConf.Rules, 1)
+	}
+	assert.True(t, dto.GetQualityGateResult_().GetPassed())
+
+	oapi := OpenAPIExptDO2DTO(expt)
+	assert.NotNil(t, oapi.QualityGateConf)
+	assert.Equal(t, domainExpt.QualityGateStatusPassed, oapi.GetQualityGateResult_().GetStatus())
+}
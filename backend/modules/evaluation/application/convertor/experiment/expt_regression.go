@@ -0,0 +1,146 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	commondto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/common"
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/application/convertor/common"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func ExptRegressionReportParamDTO2DO(req *expt.GetExptRegressionReportRequest) (*entity.ExptRegressionReportParam, error) {
+	param := &entity.ExptRegressionReportParam{
+		SpaceID:          req.GetWorkspaceID(),
+		BaseExptID:       req.GetBaseExptID(),
+		CandidateExptIDs: req.GetCandidateExptIds(),
+		Threshold:        req.GetThreshold(),
+		OutputFieldKeys:  req.GetOutputFieldKeys(),
+		MaxItemCnt:       int(req.GetMaxItemCnt()),
+		UseAccelerator:   req.GetUseAccelerator(),
+	}
+	for _, ct := range req.GetChangeTypes() {
+		param.ChangeTypes = append(param.ChangeTypes, entity.ExptRegressionChangeType(ct))
+	}
+	if req.Filter == nil {
+		return param, nil
+	}
+	if param.UseAccelerator {
+		filter, err := ConvertExptTurnResultFilterAccelerator(req.GetFilter())
+		if err != nil {
+			return nil, err
+		}
+		param.FilterAccelerator = filter
+	} else {
+		filter, err := ConvertExptTurnResultFilter(req.GetFilter().GetFilters())
+		if err != nil {
+			return nil, err
+		}
+		param.Filter = filter
+	}
+	return param, nil
+}
+
+// ExportExptRegressionReportReq2ReportReq 导出与查询共用同一组报告参数
+func ExportExptRegressionReportReq2ReportReq(req *expt.ExportExptRegressionReportRequest) *expt.GetExptRegressionReportRequest {
+	return &expt.GetExptRegressionReportRequest{
+		WorkspaceID:      req.GetWorkspaceID(),
+		BaseExptID:       req.GetBaseExptID(),
+		CandidateExptIds: req.GetCandidateExptIds(),
+		Threshold:        req.Threshold,
+		ChangeTypes:      req.GetChangeTypes(),
+		Filter:           req.Filter,
+		UseAccelerator:   req.UseAccelerator,
+		OutputFieldKeys:  req.GetOutputFieldKeys(),
+		MaxItemCnt:       req.MaxItemCnt,
+		Base:             req.Base,
+	}
+}
+
+func ExptRegressionReportDO2DTO(do *entity.ExptRegressionReport) *domain_expt.ExptRegressionReport {
+	if do == nil {
+		return nil
+	}
+	dto := &domain_expt.ExptRegressionReport{
+		BaseExptID: ptr.Of(do.BaseExptID),
+		Summaries:  make([]*domain_expt.ExptRegressionSummary, 0, len(do.Summaries)),
+		Items:      make([]*domain_expt.ExptRegressionItem, 0, len(do.Items)),
+		Truncated:  ptr.Of(do.Truncated),
+	}
+	for _, s := range do.Summaries {
+		if s == nil {
+			continue
+		}
+		dto.Summaries = append(dto.Summaries, &domain_expt.ExptRegressionSummary{
+			CandidateExptID: ptr.Of(s.CandidateExptID),
+			PairedTurnCnt:   ptr.Of(s.PairedTurnCnt),
+			ImprovedCnt:     ptr.Of(s.ImprovedCnt),
+			RegressedCnt:    ptr.Of(s.RegressedCnt),
+			MixedCnt:        ptr.Of(s.MixedCnt),
+			UnchangedCnt:    ptr.Of(s.UnchangedCnt),
+		})
+	}
+	for _, item := range do.Items {
+		if item == nil {
+			continue
+		}
+		itemDTO := &domain_expt.ExptRegressionItem{
+			ItemID:     ptr.Of(item.ItemID),
+			TurnID:     ptr.Of(item.TurnID),
+			Base:       exptRegressionTurnResultDO2DTO(item.Base),
+			Candidates: make([]*domain_expt.ExptRegressionCandidateResult_, 0, len(item.Candidates)),
+		}
+		for _, c := range item.Candidates {
+			if c == nil {
+				continue
+			}
+			itemDTO.Candidates = append(itemDTO.Candidates, exptRegressionCandidateResultDO2DTO(c))
+		}
+		dto.Items = append(dto.Items, itemDTO)
+	}
+	return dto
+}
+
+func exptRegressionTurnResultDO2DTO(do *entity.ExptRegressionTurnResult) *domain_expt.ExptRegressionTurnResult_ {
+	if do == nil {
+		return nil
+	}
+	outputs := make(map[string]*commondto.Content, len(do.TargetOutputs))
+	for k, v := range do.TargetOutputs {
+		outputs[k] = common.ConvertContentDO2DTO(v)
+	}
+	return &domain_expt.ExptRegressionTurnResult_{
+		ExptID:          ptr.Of(do.ExptID),
+		TargetOutputs:   outputs,
+		WeightedScore:   do.WeightedScore,
+		EvaluatorScores: do.EvaluatorScores,
+	}
+}
+
+func exptRegressionCandidateResultDO2DTO(do *entity.ExptRegressionCandidateResult) *domain_expt.ExptRegressionCandidateResult_ {
+	dto := &domain_expt.ExptRegressionCandidateResult_{
+		Result_:            exptRegressionTurnResultDO2DTO(do.ExptRegressionTurnResult),
+		WeightedScoreDelta: do.WeightedScoreDelta,
+		EvaluatorDeltas:    make([]*domain_expt.ExptRegressionEvaluatorDelta, 0, len(do.EvaluatorDeltas)),
+	}
+	if do.Change != "" {
+		dto.Change = ptr.Of(string(do.Change))
+	}
+	for _, d := range do.EvaluatorDeltas {
+		if d == nil {
+			continue
+		}
+		dto.EvaluatorDeltas = append(dto.EvaluatorDeltas, &domain_expt.ExptRegressionEvaluatorDelta{
+			EvaluatorVersionID: ptr.Of(d.EvaluatorVersionID),
+			Alias:              ptr.Of(d.Alias),
+			BaseScore:          ptr.Of(d.BaseScore),
+			CandidateScore:     ptr.Of(d.CandidateScore),
+			Delta:              ptr.Of(d.Delta),
+			Changed:            ptr.Of(d.Changed),
+		})
+	}
+	return dto
+}
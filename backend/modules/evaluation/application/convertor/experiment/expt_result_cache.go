@@ -0,0 +1,36 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func ResultCacheConfDTO2DO(conf *domain_expt.ExptResultCacheConf) *entity.ExptResultCacheConf {
+	if conf == nil {
+		return nil
+	}
+	return &entity.ExptResultCacheConf{
+		EnableTargetCache:    conf.GetEnableTargetCache(),
+		EnableEvaluatorCache: conf.GetEnableEvaluatorCache(),
+		TTLSeconds:           conf.GetTTLSeconds(),
+	}
+}
+
+func ResultCacheConfDO2DTO(conf *entity.ExptResultCacheConf) *domain_expt.ExptResultCacheConf {
+	if conf == nil {
+		return nil
+	}
+	res := &domain_expt.ExptResultCacheConf{
+		EnableTargetCache:    gptr.Of(conf.EnableTargetCache),
+		EnableEvaluatorCache: gptr.Of(conf.EnableEvaluatorCache),
+	}
+	if conf.TTLSeconds > 0 {
+		res.TTLSeconds = gptr.Of(conf.TTLSeconds)
+	}
+	return res
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+
+	domainExpt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	exptpb "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func TestResultCacheConfConvert(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, ResultCacheConfDTO2DO(nil))
+	assert.Nil(t, ResultCacheConfDO2DTO(nil))
+
+	param, err := ConvertCreateReq(&exptpb.CreateExperimentRequest{
+		WorkspaceID: 1,
+		ResultCacheConf: &domainExpt.ExptResultCacheConf{
+			EnableTargetCache: gptr.Of(true),
+			TTLSeconds:        gptr.Of(int64(3600)),
+		},
+	}, nil)
+	assert.NoError(t, err)
+	conf := param.ExptConf.ResultCacheConf
+	assert.True(t, conf.TargetCacheEnabled())
+	assert.False(t, conf.EvaluatorCacheEnabled())
+	assert.Equal(t, time.Hour, conf.MaxAge())
+
+	dto := ToExptDTO(&entity.Experiment{ID: 1, EvalConf: &entity.EvaluationConfiguration{ResultCacheConf: conf}})
+	assert.True(t, dto.GetResultCacheConf().GetEnableTargetCache())
+	assert.Equal(t, int64(3600), dto.GetResultCacheConf().GetTTLSeconds())
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+// SamplingConfDTO2DO 取值合法性由 domain 层 ExptSamplingConf.Validate 统一校验
+func SamplingConfDTO2DO(conf *domain_expt.ExptSamplingConf) *entity.ExptSamplingConf {
+	if conf == nil {
+		return nil
+	}
+	return &entity.ExptSamplingConf{
+		Mode:          entity.ExptSamplingMode(conf.GetMode()),
+		SampleNum:     int(conf.GetSampleNum()),
+		Percentage:    conf.GetPercentage(),
+		StratifyField: conf.GetStratifyField(),
+		Seed:          conf.GetSeed(),
+	}
+}
+
+func SamplingConfDO2DTO(conf *entity.ExptSamplingConf) *domain_expt.ExptSamplingConf {
+	if conf == nil {
+		return nil
+	}
+	res := &domain_expt.ExptSamplingConf{
+		Mode: gptr.Of(domain_expt.ExptSamplingMode(conf.Mode)),
+		Seed: gptr.Of(conf.Seed),
+	}
+	if conf.SampleNum > 0 {
+		res.SampleNum = gptr.Of(int32(conf.SampleNum))
+	}
+	if conf.Percentage > 0 {
+		res.Percentage = gptr.Of(conf.Percentage)
+	}
+	if conf.StratifyField != "" {
+		res.StratifyField = gptr.Of(conf.StratifyField)
+	}
+	return res
+}
+
+func SamplingResultDO2DTO(result *entity.ExptSamplingResult) *domain_expt.ExptSamplingResult_ {
+	if result == nil {
+		return nil
+	}
+	res := &domain_expt.ExptSamplingResult_{
+		Mode:          gptr.Of(domain_expt.ExptSamplingMode(result.Mode)),
+		Seed:          gptr.Of(result.Seed),
+		StratifyField: gptr.Of(result.StratifyField),
+		PopulationCnt: gptr.Of(result.PopulationCnt),
+		SampleCnt:     gptr.Of(result.SampleCnt),
+		Strata:        make([]*domain_expt.ExptSamplingStratum, 0, len(result.Strata)),
+	}
+	for _, s := range result.Strata {
+		if s == nil {
+			continue
+		}
+		res.Strata = append(res.Strata, &domain_expt.ExptSamplingStratum{
+			Value:         gptr.Of(s.Value),
+			PopulationCnt: gptr.Of(s.PopulationCnt),
+			SampleCnt:     gptr.Of(s.SampleCnt),
+			Weight:        gptr.Of(s.Weight),
+		})
+	}
+	return res
+}
+
+func SampledAggrResultDO2DTO(result *entity.ExptSampledAggrResult) *domain_expt.ExptSampledAggrResult_ {
+	if result == nil {
+		return nil
+	}
+	res := &domain_expt.ExptSampledAggrResult_{
+		ExptID:           gptr.Of(result.ExptID),
+		Sampling:         SamplingResultDO2DTO(result.Sampling),
+		Confidence:       gptr.Of(result.Confidence),
+		EvaluatorResults: make([]*domain_expt.ExptSampledEvaluatorAggr, 0, len(result.EvaluatorResults)),
+	}
+	for _, r := range result.EvaluatorResults {
+		if r == nil {
+			continue
+		}
+		res.EvaluatorResults = append(res.EvaluatorResults, &domain_expt.ExptSampledEvaluatorAggr{
+			EvaluatorVersionID: gptr.Of(r.EvaluatorVersionID),
+			Alias:              gptr.Of(r.Alias),
+			ScoredCnt:          gptr.Of(r.ScoredCnt),
+			UnweightedMean:     gptr.Of(r.UnweightedMean),
+			Mean:               gptr.Of(r.Mean),
+			StdErr:             gptr.Of(r.StdErr),
+			CiLower:            gptr.Of(r.CILower),
+			CiUpper:            gptr.Of(r.CIUpper),
+		})
+	}
+	return res
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+
+	domainExpt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	exptpb "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func TestSamplingConfConvert(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, SamplingConfDTO2DO(nil))
+	assert.Nil(t, SamplingConfDO2DTO(nil))
+	assert.Nil(t, SamplingResultDO2DTO(nil))
+	assert.Nil(t, SampledAggrResultDO2DTO(nil))
+
+	dto := &domainExpt.ExptSamplingConf{
+		Mode:          gptr.Of(domainExpt.ExptSamplingModeStratified),
+		Percentage:    gptr.Of(20.0),
+		StratifyField: gptr.Of("category"),
+		Seed:          gptr.Of(int64(42)),
+	}
+	do := SamplingConfDTO2DO(dto)
+	assert.Equal(t, entity.ExptSamplingModeStratified, do.Mode)
+	assert.Equal(t, 20.0, do.Percentage)
+	assert.Equal(t, "category", do.StratifyField)
+	assert.Equal(t, int64(42), do.Seed)
+	assert.NoError(t, do.Validate())
+
+	back := SamplingConfDO2DTO(do)
+	assert.Equal(t, dto.GetMode(), back.GetMode())
+	assert.False(t, back.IsSetSampleNum())
+	assert.Equal(t, dto.GetPercentage(), back.GetPercentage())
+	assert.Equal(t, dto.GetSeed(), back.GetSeed())
+}
+
+func TestSamplingConf_CreateReqAndExptDTO(t *testing.T) {
+	t.Parallel()
+
+	param, err := ConvertCreateReq(&exptpb.CreateExperimentRequest{
+		WorkspaceID:  1,
+		SamplingConf: &domainExpt.ExptSamplingConf{Mode: gptr.Of(domainExpt.ExptSamplingModeRandomN), SampleNum: gptr.Of(int32(50))},
+	}, nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, param.ExptConf) && assert.NotNil(t, param.ExptConf.SamplingConf) {
+		assert.Equal(t, 50, param.ExptConf.SamplingConf.SampleNum)
+	}
+
+	dto := ToExptDTO(&entity.Experiment{
+		ID:       1,
+		EvalConf: &entity.EvaluationConfiguration{SamplingConf: &entity.ExptSamplingConf{Mode: entity.ExptSamplingModeRandomN, SampleNum: 50, Seed: 7}},
+		SamplingResult: &entity.ExptSamplingResult{
+			Mode: entity.ExptSamplingModeRandomN, Seed: 7, PopulationCnt: 200, SampleCnt: 50,
+			Strata: []*entity.ExptSamplingStratum{{PopulationCnt: 200, SampleCnt: 50, Weight: 4}, nil},
+		},
+	})
+	assert.Equal(t, int32(50), dto.GetSamplingConf().GetSampleNum())
+	assert.Equal(t, int64(7), dto.GetSamplingConf().GetSeed())
+	assert.Equal(t, int64(200), dto.GetSamplingResult_().GetPopulationCnt())
+	if assert.Len(t, dto.GetSamplingResult_().GetStrata(), 1) {
+		assert.Equal(t, 4.0, dto.GetSamplingResult_().GetStrata()[0].GetWeight())
+	}
+}
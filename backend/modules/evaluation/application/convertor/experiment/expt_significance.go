@@ -0,0 +1,97 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	openapi_experiment "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain_openapi/experiment"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/openapi"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func ExptSignificanceParamDTO2DO(req *expt.CompareExperimentsRequest) *entity.ExptSignificanceParam {
+	return &entity.ExptSignificanceParam{
+		SpaceID:             req.GetWorkspaceID(),
+		BaseExptID:          req.GetBaseExptID(),
+		CandidateExptIDs:    req.GetCandidateExptIds(),
+		Confidence:          req.GetConfidence(),
+		BootstrapIterations: int(req.GetBootstrapIterations()),
+		Seed:                req.GetSeed(),
+		TieThreshold:        req.GetTieThreshold(),
+	}
+}
+
+func ExptPairSignificancesDO2DTOs(dos []*entity.ExptPairSignificance) []*domain_expt.ExptPairSignificance {
+	dtos := make([]*domain_expt.ExptPairSignificance, 0, len(dos))
+	for _, do := range dos {
+		dto := &domain_expt.ExptPairSignificance{
+			CandidateExptID:        ptr.Of(do.CandidateExptID),
+			EvaluatorSignificances: make([]*domain_expt.ExptEvaluatorSignificance, 0, len(do.EvaluatorResults)),
+		}
+		for _, r := range do.EvaluatorResults {
+			dto.EvaluatorSignificances = append(dto.EvaluatorSignificances, &domain_expt.ExptEvaluatorSignificance{
+				EvaluatorVersionID: ptr.Of(r.EvaluatorVersionID),
+				Alias:              ptr.Of(r.Alias),
+				PairedCount:        ptr.Of(r.PairedCount),
+				BaseMean:           ptr.Of(r.BaseMean),
+				CandidateMean:      ptr.Of(r.CandidateMean),
+				MeanDelta:          ptr.Of(r.MeanDelta),
+				CiLower:            ptr.Of(r.CILower),
+				CiUpper:            ptr.Of(r.CIUpper),
+				TTestPValue:        ptr.Of(r.TTestPValue),
+				WilcoxonPValue:     ptr.Of(r.WilcoxonPValue),
+				WinCount:           ptr.Of(r.WinCount),
+				TieCount:           ptr.Of(r.TieCount),
+				LossCount:          ptr.Of(r.LossCount),
+				Significant:        ptr.Of(r.Significant),
+			})
+		}
+		dtos = append(dtos, dto)
+	}
+	return dtos
+}
+
+func OpenAPICompareExperimentsReq2Inner(req *openapi.CompareExperimentsOApiRequest) *expt.CompareExperimentsRequest {
+	return &expt.CompareExperimentsRequest{
+		WorkspaceID:         req.GetWorkspaceID(),
+		BaseExptID:          req.GetBaseExperimentID(),
+		CandidateExptIds:    req.GetCandidateExperimentIds(),
+		Confidence:          req.Confidence,
+		BootstrapIterations: req.BootstrapIterations,
+		Seed:                req.Seed,
+		TieThreshold:        req.TieThreshold,
+	}
+}
+
+func OpenAPIExptPairSignificancesDTO2OpenAPI(dtos []*domain_expt.ExptPairSignificance) []*openapi_experiment.ExperimentPairSignificance {
+	res := make([]*openapi_experiment.ExperimentPairSignificance, 0, len(dtos))
+	for _, dto := range dtos {
+		pair := &openapi_experiment.ExperimentPairSignificance{
+			CandidateExperimentID:  dto.CandidateExptID,
+			EvaluatorSignificances: make([]*openapi_experiment.ExperimentEvaluatorSignificance, 0, len(dto.EvaluatorSignificances)),
+		}
+		for _, r := range dto.EvaluatorSignificances {
+			pair.EvaluatorSignificances = append(pair.EvaluatorSignificances, &openapi_experiment.ExperimentEvaluatorSignificance{
+				EvaluatorVersionID: r.EvaluatorVersionID,
+				Alias:              r.Alias,
+				PairedCount:        r.PairedCount,
+				BaseMean:           r.BaseMean,
+				CandidateMean:      r.CandidateMean,
+				MeanDelta:          r.MeanDelta,
+				CiLower:            r.CiLower,
+				CiUpper:            r.CiUpper,
+				TTestPValue:        r.TTestPValue,
+				WilcoxonPValue:     r.WilcoxonPValue,
+				WinCount:           r.WinCount,
+				TieCount:           r.TieCount,
+				LossCount:          r.LossCount,
+				Significant:        r.Significant,
+			})
+		}
+		res = append(res, pair)
+	}
+	return res
+}
@@ -58,6 +58,7 @@ func ConvertCreateExptTemplateReq(req *expt.CreateExperimentTemplateRequest) (*e
 		}
 		param.NotificationConf = notifConf
 	}
+	param.QualityGateConf = QualityGateConfDTO2DO(req.QualityGateConf)
 
 	return param, nil
 }
@@ -308,6 +309,7 @@ func toTargetFieldMappingDOForTemplate(mapping *domain_expt.TargetFieldMapping,
 				FieldName: fm.GetFieldName(),
 				FromField: fm.GetFromFieldName(),
 				Value:     fm.GetConstValue(),
+				Expr:      fm.GetExpr(),
 			})
 		}
 		tic.EvalSetAdapter.FieldConfs = fc
@@ -342,6 +344,7 @@ func toEvaluatorFieldMappingDoForTemplate(mapping []*domain_expt.EvaluatorFieldM
 				FieldName: fes.GetFieldName(),
 				FromField: fes.GetFromFieldName(),
 				Value:     fes.GetConstValue(),
+				Expr:      fes.GetExpr(),
 			})
 		}
 		tf := make([]*entity.FieldConf, 0, len(fm.GetFromTarget()))
@@ -350,6 +353,7 @@ func toEvaluatorFieldMappingDoForTemplate(mapping []*domain_expt.EvaluatorFieldM
 				FieldName: ft.GetFieldName(),
 				FromField: ft.GetFromFieldName(),
 				Value:     ft.GetConstValue(),
+				Expr:      ft.GetExpr(),
 			})
 		}
 		// 从 EvaluatorIDVersionItem 中提取信息，如果不存在则使用 EvaluatorVersionID
@@ -492,6 +496,9 @@ func ToExptTemplateDTO(template *entity.ExptTemplate) *domain_expt.ExptTemplate
 	}
 
 	dto.NotificationConf = notificationConfDO2DTO(template.NotificationConf)
+	if template.TemplateConf != nil {
+		dto.QualityGateConf = QualityGateConfDO2DTO(template.TemplateConf.QualityGateConf)
+	}
 
 	return dto
 }
@@ -940,6 +947,7 @@ func buildTemplateFieldMappingDTO(template *entity.ExptTemplate) *domain_expt.Ex
 				FieldName:     gptr.Of(fm.FieldName),
 				FromFieldName: gptr.Of(fm.FromFieldName),
 				ConstValue:    gptr.Of(fm.ConstValue),
+				Expr:          gptr.Of(fm.Expr),
 			})
 		}
 		fieldMapping.TargetFieldMapping = targetMapping
@@ -1000,6 +1008,7 @@ func buildTemplateFieldMappingDTO(template *entity.ExptTemplate) *domain_expt.Ex
 					FieldName:     gptr.Of(fm.FieldName),
 					FromFieldName: gptr.Of(fm.FromFieldName),
 					ConstValue:    gptr.Of(fm.ConstValue),
+					Expr:          gptr.Of(fm.Expr),
 				})
 			}
 			for _, fm := range em.FromTarget {
@@ -1007,6 +1016,7 @@ func buildTemplateFieldMappingDTO(template *entity.ExptTemplate) *domain_expt.Ex
 					FieldName:     gptr.Of(fm.FieldName),
 					FromFieldName: gptr.Of(fm.FromFieldName),
 					ConstValue:    gptr.Of(fm.ConstValue),
+					Expr:          gptr.Of(fm.Expr),
 				})
 			}
 			evaluatorMappings = append(evaluatorMappings, m)
@@ -1182,6 +1192,7 @@ func convertTemplateConfToDTO(conf *entity.ExptTemplateConfiguration) (*domain_e
 					FieldName:     gptr.Of(fc.FieldName),
 					FromFieldName: gptr.Of(fc.FromField),
 					ConstValue:    gptr.Of(fc.Value),
+					Expr:          gptr.Of(fc.Expr),
 				})
 			}
 		}
@@ -1243,6 +1254,7 @@ func convertTemplateConfToDTO(conf *entity.ExptTemplateConfiguration) (*domain_e
 						FieldName:     gptr.Of(fc.FieldName),
 						FromFieldName: gptr.Of(fc.FromField),
 						ConstValue:    gptr.Of(fc.Value),
+						Expr:          gptr.Of(fc.Expr),
 					})
 				}
 			}
@@ -1252,6 +1264,7 @@ func convertTemplateConfToDTO(conf *entity.ExptTemplateConfiguration) (*domain_e
 						FieldName:     gptr.Of(fc.FieldName),
 						FromFieldName: gptr.Of(fc.FromField),
 						ConstValue:    gptr.Of(fc.Value),
+						Expr:          gptr.Of(fc.Expr),
 					})
 				}
 			}
@@ -1430,6 +1443,7 @@ func ConvertUpdateExptTemplateReq(req *expt.UpdateExperimentTemplateRequest) (*e
 		}
 		param.NotificationConf = notifConf
 	}
+	param.QualityGateConf = QualityGateConfDTO2DO(req.QualityGateConf)
 
 	return param, nil
 }
@@ -2686,3 +2686,19 @@ func TestToExptDTO_EvalSetByNilCheck(t *testing.T) {
 		assert.Nil(t, result.EvalSet)
 	})
 }
+
+func TestToExptStatsDTO_ConcurrencyState(t *testing.T) {
+	dto := ToExptStatsDTO(&entity.ExptStats{EffectiveConcurNum: 6, BudgetDeferredCnt: 2}, nil)
+	assert.Equal(t, int32(6), dto.GetEffectiveConcurNum())
+	assert.Equal(t, int32(2), dto.GetBudgetDeferredItemCnt())
+}
+
+func TestToTargetFieldMappingDO_Expr(t *testing.T) {
+	tic := toTargetFieldMappingDO(&domain_expt.TargetFieldMapping{
+		FromEvalSet: []*domain_expt.FieldMapping{{FieldName: gptr.Of("input"), FromFieldName: gptr.Of("=q"), Expr: gptr.Of("upper($.q)")}},
+	}, nil)
+	if assert.Len(t, tic.EvalSetAdapter.FieldConfs, 1) {
+		assert.Equal(t, "=q", tic.EvalSetAdapter.FieldConfs[0].FromField)
+		assert.Equal(t, "upper($.q)", tic.EvalSetAdapter.FieldConfs[0].Expr)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+// TrialConfDTO2DO 取值合法性由 domain 层 ExptTrialConf.Validate 统一校验
+func TrialConfDTO2DO(conf *domain_expt.ExptTrialConf) *entity.ExptTrialConf {
+	if conf == nil {
+		return nil
+	}
+	return &entity.ExptTrialConf{
+		TrialNum:      int(conf.GetTrialNum()),
+		PassThreshold: conf.PassThreshold,
+	}
+}
+
+func TrialConfDO2DTO(conf *entity.ExptTrialConf) *domain_expt.ExptTrialConf {
+	if conf == nil {
+		return nil
+	}
+	return &domain_expt.ExptTrialConf{
+		TrialNum:      gptr.Of(int32(conf.TrialNum)),
+		PassThreshold: conf.PassThreshold,
+	}
+}
+
+func EvaluatorTrialAggregateDO2DTO(aggr *entity.EvaluatorTrialAggregate) *domain_expt.EvaluatorTrialAggregate {
+	if aggr == nil {
+		return nil
+	}
+	return &domain_expt.EvaluatorTrialAggregate{
+		TrialNum:        gptr.Of(int32(aggr.TrialNum)),
+		PassThreshold:   gptr.Of(aggr.PassThreshold),
+		UnitCnt:         gptr.Of(aggr.UnitCnt),
+		MeanScore:       gptr.Of(aggr.MeanScore),
+		MeanStd:         gptr.Of(aggr.MeanStd),
+		ConsistencyRate: gptr.Of(aggr.ConsistencyRate),
+		PassAt1:         gptr.Of(aggr.PassAt1),
+		PassAtK:         gptr.Of(aggr.PassAtK),
+		PassPowK:        gptr.Of(aggr.PassPowK),
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+
+	domainExpt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	exptpb "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func TestTrialConf_CreateReqAndExptDTO(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, TrialConfDTO2DO(nil))
+	assert.Nil(t, TrialConfDO2DTO(nil))
+
+	param, err := ConvertCreateReq(&exptpb.CreateExperimentRequest{
+		WorkspaceID: 1,
+		TrialConf:   &domainExpt.ExptTrialConf{TrialNum: gptr.Of(int32(3)), PassThreshold: gptr.Of(0.5)},
+	}, nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, param.ExptConf) && assert.NotNil(t, param.ExptConf.TrialConf) {
+		assert.Equal(t, 3, param.ExptConf.TrialConf.GetTrialNum())
+		assert.Equal(t, 0.5, param.ExptConf.TrialConf.GetPassThreshold())
+	}
+
+	dto := ToExptDTO(&entity.Experiment{
+		ID:       1,
+		EvalConf: &entity.EvaluationConfiguration{TrialConf: &entity.ExptTrialConf{TrialNum: 3}},
+	})
+	assert.Equal(t, int32(3), dto.GetTrialConf().GetTrialNum())
+	assert.False(t, dto.GetTrialConf().IsSetPassThreshold())
+}
+
+func TestEvaluatorTrialAggregateDO2DTO(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, EvaluatorTrialAggregateDO2DTO(nil))
+
+	got := EvaluatorResultsDOToDTO(&entity.EvaluatorAggregateResult{
+		EvaluatorVersionID: 11,
+		Trial: &entity.EvaluatorTrialAggregate{
+			TrialNum: 3, PassThreshold: 1, UnitCnt: 20,
+			ConsistencyRate: 0.75, PassAt1: 0.5, PassAtK: 0.9, PassPowK: 0.2,
+		},
+	})
+	assert.Equal(t, int32(3), got.GetTrial().GetTrialNum())
+	assert.Equal(t, int64(20), got.GetTrial().GetUnitCnt())
+	assert.Equal(t, 0.75, got.GetTrial().GetConsistencyRate())
+	assert.Equal(t, 0.9, got.GetTrial().GetPassAtK())
+	assert.Equal(t, 0.2, got.GetTrial().GetPassPowK())
+
+	assert.Nil(t, EvaluatorResultsDOToDTO(&entity.EvaluatorAggregateResult{EvaluatorVersionID: 11}).GetTrial())
+}
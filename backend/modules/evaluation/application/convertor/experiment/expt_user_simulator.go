@@ -0,0 +1,56 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/application/convertor/common"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+// UserSimulatorConfDTO2DO 取值合法性由 domain 层 ExptUserSimulatorConf.Validate 统一校验
+func UserSimulatorConfDTO2DO(conf *domain_expt.ExptUserSimulatorConf) *entity.ExptUserSimulatorConf {
+	if conf == nil {
+		return nil
+	}
+	return &entity.ExptUserSimulatorConf{
+		ModelConfig:            common.ConvertModelConfigDTO2DO(conf.ModelConfig),
+		SystemPrompt:           conf.GetSystemPrompt(),
+		Goal:                   conf.GetGoal(),
+		Persona:                conf.GetPersona(),
+		BehavioralConstraints:  conf.GetBehavioralConstraints(),
+		GoalFieldName:          conf.GetGoalFieldName(),
+		PersonaFieldName:       conf.GetPersonaFieldName(),
+		QueryFieldName:         conf.GetQueryFieldName(),
+		ResponseFieldName:      conf.GetResponseFieldName(),
+		MaxTurns:               int(conf.GetMaxTurns()),
+		GoalEvaluatorVersionID: conf.GetGoalEvaluatorVersionID(),
+		GoalScoreThreshold:     conf.GoalScoreThreshold,
+	}
+}
+
+func UserSimulatorConfDO2DTO(conf *entity.ExptUserSimulatorConf) *domain_expt.ExptUserSimulatorConf {
+	if conf == nil {
+		return nil
+	}
+	res := &domain_expt.ExptUserSimulatorConf{
+		ModelConfig:           common.ConvertModelConfigDO2DTO(conf.ModelConfig),
+		SystemPrompt:          gptr.Of(conf.SystemPrompt),
+		Goal:                  gptr.Of(conf.Goal),
+		Persona:               gptr.Of(conf.Persona),
+		BehavioralConstraints: gptr.Of(conf.BehavioralConstraints),
+		GoalFieldName:         gptr.Of(conf.GoalFieldName),
+		PersonaFieldName:      gptr.Of(conf.PersonaFieldName),
+		QueryFieldName:        gptr.Of(conf.QueryFieldName),
+		ResponseFieldName:     gptr.Of(conf.ResponseFieldName),
+		MaxTurns:              gptr.Of(int32(conf.GetMaxTurns())),
+		GoalScoreThreshold:    conf.GoalScoreThreshold,
+	}
+	if conf.GoalEvaluatorVersionID > 0 {
+		res.GoalEvaluatorVersionID = gptr.Of(conf.GoalEvaluatorVersionID)
+	}
+	return res
+}
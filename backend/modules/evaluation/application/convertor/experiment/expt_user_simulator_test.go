@@ -0,0 +1,58 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+
+	domainCommon "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/common"
+	domainExpt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	exptpb "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func TestUserSimulatorConf_CreateReqAndExptDTO(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, UserSimulatorConfDTO2DO(nil))
+	assert.Nil(t, UserSimulatorConfDO2DTO(nil))
+
+	param, err := ConvertCreateReq(&exptpb.CreateExperimentRequest{
+		WorkspaceID: 1,
+		UserSimulatorConf: &domainExpt.ExptUserSimulatorConf{
+			ModelConfig:            &domainCommon.ModelConfig{ModelID: gptr.Of(int64(9))},
+			Goal:                   gptr.Of("book a flight"),
+			QueryFieldName:         gptr.Of("input"),
+			MaxTurns:               gptr.Of(int32(8)),
+			GoalEvaluatorVersionID: gptr.Of(int64(21)),
+			GoalScoreThreshold:     gptr.Of(0.8),
+		},
+	}, nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, param.ExptConf) && assert.NotNil(t, param.ExptConf.UserSimulatorConf) {
+		conf := param.ExptConf.UserSimulatorConf
+		assert.True(t, conf.IsEnabled())
+		assert.NoError(t, conf.Validate())
+		assert.Equal(t, int64(9), gptr.Indirect(conf.ModelConfig.ModelID))
+		assert.Equal(t, "book a flight", conf.Goal)
+		assert.Equal(t, 8, conf.GetMaxTurns())
+		assert.Equal(t, int64(21), conf.GoalEvaluatorVersionID)
+		assert.True(t, conf.IsGoalAchieved(0.8))
+	}
+
+	dto := ToExptDTO(&entity.Experiment{
+		ID: 1,
+		EvalConf: &entity.EvaluationConfiguration{UserSimulatorConf: &entity.ExptUserSimulatorConf{
+			ModelConfig:    &entity.ModelConfig{ModelID: gptr.Of(int64(9))},
+			QueryFieldName: "input",
+		}},
+	})
+	assert.Equal(t, int64(9), dto.GetUserSimulatorConf().GetModelConfig().GetModelID())
+	assert.Equal(t, "input", dto.GetUserSimulatorConf().GetQueryFieldName())
+	assert.Equal(t, int32(entity.DefaultUserSimulatorMaxTurns), dto.GetUserSimulatorConf().GetMaxTurns())
+	assert.False(t, dto.GetUserSimulatorConf().IsSetGoalEvaluatorVersionID())
+}
@@ -406,6 +406,8 @@ func DomainExperimentDTO2OpenAPI(dto *domainExpt.Experiment) *openapiExperiment.
 	result.ExptTemplateMeta = DomainExptTemplateMetaDTO2OpenAPI(dto.ExptTemplateMeta)
 	result.OfflineExptAnalysisStatus = mapOfflineExptAnalysisStatusDTO2OpenAPI(dto.OfflineExptAnalysisStatus)
 	result.NotificationConf = domainNotificationConfToOpenAPI(dto.NotificationConf)
+	result.QualityGateConf = domainQualityGateConfToOpenAPI(dto.QualityGateConf)
+	result.QualityGateResult_ = domainQualityGateResultToOpenAPI(dto.QualityGateResult_)
 
 	// ★ 多评测集读视图 (与 domain 110~114 对应)。
 	// 注: eval_set_details[].EvalSet 详情与 version-string 风格的 eval_set_configs 全量回显本期不在 OpenAPI 侧映射
@@ -770,6 +772,10 @@ func OpenAPIExptDO2DTO(experiment *entity.Experiment) *openapiExperiment.Experim
 	}
 
 	result.NotificationConf = entityNotificationConfToOpenAPI(experiment.NotificationConf)
+	if experiment.EvalConf != nil {
+		result.QualityGateConf = domainQualityGateConfToOpenAPI(QualityGateConfDO2DTO(experiment.EvalConf.QualityGateConf))
+	}
+	result.QualityGateResult_ = domainQualityGateResultToOpenAPI(QualityGateResultDO2DTO(experiment.QualityGateResult))
 
 	// item-centric 多评测集字段回填 (110/111/112/113/114): 与 DomainExperimentDTO2OpenAPI / ToExptDTO 等价, 但走 entity 直转,
 	// 供 GetExperimentsOApi 单实验 Get 使用 (其上游 manager.GetDetail 已 enrichEvalSetDetails 填好 EvalSetDetails/TotalItemCount)。
@@ -1287,6 +1293,12 @@ func convertEntityEvaluatorTypeToOpenAPI(typ entity.EvaluatorType) *openapiEvalu
 		openapiType = openapiEvaluator.EvaluatorTypeCustomRPC
 	case entity.EvaluatorTypeAgent:
 		openapiType = openapiEvaluator.EvaluatorTypeAgent
+	case entity.EvaluatorTypePairwise:
+		openapiType = openapiEvaluator.EvaluatorTypePairwise
+	case entity.EvaluatorTypeRule:
+		openapiType = openapiEvaluator.EvaluatorTypeRule
+	case entity.EvaluatorTypeComposite:
+		openapiType = openapiEvaluator.EvaluatorTypeComposite
 	default:
 		return nil
 	}
@@ -2335,6 +2347,9 @@ func OpenAPIExptTemplateDO2DTO(template *entity.ExptTemplate) *openapiExperiment
 	}
 
 	dto.NotificationConf = entityNotificationConfToOpenAPI(template.NotificationConf)
+	if template.TemplateConf != nil {
+		dto.QualityGateConf = domainQualityGateConfToOpenAPI(QualityGateConfDO2DTO(template.TemplateConf.QualityGateConf))
+	}
 
 	return dto
 }
@@ -2608,6 +2623,7 @@ func OpenAPICreateExptTemplateReq2Domain(req *openapi.CreateExptTemplateOApiRequ
 		}
 		param.NotificationConf = entityConf
 	}
+	param.QualityGateConf = QualityGateConfDTO2DO(OpenAPIQualityGateConfDTO2Domain(req.QualityGateConf))
 
 	return param, nil
 }
@@ -2725,6 +2741,7 @@ func OpenAPIUpdateExptTemplateReq2Domain(req *openapi.UpdateExptTemplateOApiRequ
 		}
 		param.NotificationConf = entityConf
 	}
+	param.QualityGateConf = QualityGateConfDTO2DO(OpenAPIQualityGateConfDTO2Domain(req.QualityGateConf))
 
 	return param, nil
 }
@@ -89,6 +89,8 @@ type EvalOpenAPIApplication struct {
 	callbackDispatcher       service.IEvaluatorCallbackDispatcher
 	resourceAccessAuthorizer service.ResourceAccessAuthorizer
 	sourceEvalTargetLister   SourceEvalTargetLister
+	promotionService         service.EvaluatorPromotionService
+	provenanceService        service.EvaluationSetItemProvenanceService
 }
 
 func NewEvalOpenAPIApplication(asyncRepo repo.IEvalAsyncRepo, publisher events.ExptEventPublisher,
@@ -114,6 +116,8 @@ func NewEvalOpenAPIApplication(asyncRepo repo.IEvalAsyncRepo, publisher events.E
 	fileProvider rpc.IFileProvider,
 	callbackDispatcher service.IEvaluatorCallbackDispatcher,
 	resourceAccessAuthorizer service.ResourceAccessAuthorizer,
+	promotionService service.EvaluatorPromotionService,
+	provenanceService service.EvaluationSetItemProvenanceService,
 ) IEvalOpenAPIApplication {
 	return &EvalOpenAPIApplication{
 		asyncRepo:                   asyncRepo,
@@ -140,6 +144,8 @@ func NewEvalOpenAPIApplication(asyncRepo repo.IEvalAsyncRepo, publisher events.E
 		fileProvider:                fileProvider,
 		callbackDispatcher:          callbackDispatcher,
 		resourceAccessAuthorizer:    resourceAccessAuthorizer,
+		promotionService:            promotionService,
+		provenanceService:           provenanceService,
 	}
 }
 
@@ -949,6 +955,29 @@ func (e *EvalOpenAPIApplication) BatchCreateEvaluationSetItemsOApi(ctx context.C
 	if err != nil {
 		return nil, err
 	}
+	// 行已写入, 来源登记失败只记日志, 缺失的来源在读取时补录; 按 item_key 命中已有行的视为编辑
+	var newIDs, editedIDs []int64
+	for _, output := range itemOutputs {
+		if output == nil || output.ItemID == nil {
+			continue
+		}
+		if gptr.Indirect(output.IsNewItem) {
+			newIDs = append(newIDs, *output.ItemID)
+		} else {
+			editedIDs = append(editedIDs, *output.ItemID)
+		}
+	}
+	if len(newIDs) > 0 {
+		if err := e.provenanceService.RecordProvenance(ctx, &entity.RecordEvaluationSetItemProvenanceParam{
+			SpaceID:         req.GetWorkspaceID(),
+			EvaluationSetID: req.GetEvaluationSetID(),
+			ItemIDs:         newIDs,
+			Template:        &entity.EvaluationSetItemProvenance{SourceType: entity.EvaluationSetItemSourceType_OpenAPI},
+		}); err != nil {
+			logs.CtxError(ctx, "record openapi item provenance fail, evaluation_set_id: %v, err: %v", req.GetEvaluationSetID(), err)
+		}
+	}
+	e.markItemsEdited(ctx, req.GetWorkspaceID(), req.GetEvaluationSetID(), editedIDs)
 
 	// 构建响应
 	return &openapi.BatchCreateEvaluationSetItemsOApiResponse{
@@ -1006,6 +1035,13 @@ func (e *EvalOpenAPIApplication) BatchUpdateEvaluationSetItemsOApi(ctx context.C
 	if err != nil {
 		return nil, err
 	}
+	editedIDs := make([]int64, 0, len(itemOutputs))
+	for _, output := range itemOutputs {
+		if output != nil && output.ItemID != nil {
+			editedIDs = append(editedIDs, *output.ItemID)
+		}
+	}
+	e.markItemsEdited(ctx, req.GetWorkspaceID(), req.GetEvaluationSetID(), editedIDs)
 
 	// 构建响应
 	return &openapi.BatchUpdateEvaluationSetItemsOApiResponse{
@@ -1016,6 +1052,16 @@ func (e *EvalOpenAPIApplication) BatchUpdateEvaluationSetItemsOApi(ctx context.C
 	}, nil
 }
 
+// markItemsEdited 记录行的最后编辑者, 失败只记日志
+func (e *EvalOpenAPIApplication) markItemsEdited(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64) {
+	if len(itemIDs) == 0 {
+		return
+	}
+	if err := e.provenanceService.MarkEdited(ctx, spaceID, evaluationSetID, itemIDs); err != nil {
+		logs.CtxError(ctx, "mark openapi items edited fail, evaluation_set_id: %v, err: %v", evaluationSetID, err)
+	}
+}
+
 func (e *EvalOpenAPIApplication) BatchDeleteEvaluationSetItemsOApi(ctx context.Context, req *openapi.BatchDeleteEvaluationSetItemsOApiRequest) (r *openapi.BatchDeleteEvaluationSetItemsOApiResponse, err error) {
 	startTime := time.Now().UnixNano() / int64(time.Millisecond)
 	defer func() {
@@ -1868,6 +1914,7 @@ func (e *EvalOpenAPIApplication) SubmitExperimentOApi(ctx context.Context, req *
 	}
 	createReq.CreateEvalTargetParam = createEvalTargetParam
 	createReq.NotificationConf = notificationConf
+	createReq.QualityGateConf = experiment_convertor.OpenAPIQualityGateConfDTO2Domain(req.QualityGateConf)
 	// ★ 跨空间共享 (单评测集): 评测对象来源空间选项 (SubmitExperimentEvalTargetParam.shared_option)
 	if req.EvalTargetParam != nil {
 		createReq.TargetSharedOption = openapiSharedOptionDTO2Domain(req.EvalTargetParam.GetSharedOption())
@@ -2252,6 +2299,35 @@ func (e *EvalOpenAPIApplication) GetExperimentAggrResultOApi(ctx context.Context
 	}, nil
 }
 
+// CompareExperimentsOApi 以基线实验为参照对候选实验做配对显著性检验。
+func (e *EvalOpenAPIApplication) CompareExperimentsOApi(ctx context.Context, req *openapi.CompareExperimentsOApiRequest) (r *openapi.CompareExperimentsOApiResponse, err error) {
+	startTime := time.Now().UnixNano() / int64(time.Millisecond)
+	defer func() {
+		e.metric.EmitOpenAPIMetric(ctx, req.GetWorkspaceID(), 0, kitexutil.GetTOMethod(ctx), startTime, err)
+	}()
+
+	if req == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("req is nil"))
+	}
+	if req.GetWorkspaceID() <= 0 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("workspace_id is required"))
+	}
+	if req.GetBaseExperimentID() <= 0 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("base_experiment_id is required"))
+	}
+
+	resp, err := e.experimentApp.CompareExperiments(ctx, experiment_convertor.OpenAPICompareExperimentsReq2Inner(req))
+	if err != nil {
+		return nil, err
+	}
+	return &openapi.CompareExperimentsOApiResponse{
+		Data: &openapi.CompareExperimentsOpenAPIData{
+			BaseExperimentID: resp.BaseExptID,
+			Comparisons:      experiment_convertor.OpenAPIExptPairSignificancesDTO2OpenAPI(resp.GetComparisons()),
+		},
+	}, nil
+}
+
 func (e *EvalOpenAPIApplication) RetryExperimentOApi(ctx context.Context, req *openapi.RetryExperimentOApiRequest) (r *openapi.RetryExperimentOApiResponse, err error) {
 	startTime := time.Now().UnixNano() / int64(time.Millisecond)
 	defer func() {
@@ -2925,6 +3001,10 @@ func (e *EvalOpenAPIApplication) SubmitEvaluatorVersionOApi(ctx context.Context,
 		return nil, err
 	}
 
+	// 按评估器晋级策略执行提交前回归检查, 结论为阻断时拒绝提交
+	if _, err = e.promotionService.GuardSubmit(ctx, evaluator, nil); err != nil {
+		return nil, err
+	}
 	res, err := e.evaluatorService.SubmitEvaluatorVersion(ctx, evaluator, req.GetVersion(), req.GetDescription(), "")
 	if err != nil {
 		return nil, err
@@ -3375,6 +3455,10 @@ func (e *EvalOpenAPIApplication) SubmitExptFromTemplateOApi(ctx context.Context,
 		}
 		submitReq.NotificationConf = domainConf
 	}
+	// 质量门禁覆盖：未传时由 CreateExpt 按模板 ID 沿用模板门禁
+	if req.QualityGateConf != nil {
+		submitReq.QualityGateConf = experiment_convertor.OpenAPIQualityGateConfDTO2Domain(req.QualityGateConf)
+	}
 
 	cresp, err := e.experimentApp.SubmitExperiment(ctx, submitReq)
 	if err != nil {
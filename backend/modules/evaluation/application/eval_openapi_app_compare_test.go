@@ -0,0 +1,142 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+
+	domainexpt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	exptpb "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/openapi"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+func TestEvalOpenAPIApplication_CompareExperimentsOApi(t *testing.T) {
+	t.Parallel()
+
+	workspaceID := int64(92001)
+	baseID := int64(92002)
+	candidateID := int64(92003)
+
+	buildBaseReq := func() *openapi.CompareExperimentsOApiRequest {
+		return &openapi.CompareExperimentsOApiRequest{
+			WorkspaceID:            gptr.Of(workspaceID),
+			BaseExperimentID:       gptr.Of(baseID),
+			CandidateExperimentIds: []int64{candidateID},
+			Confidence:             gptr.Of(0.9),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		buildReq    func() *openapi.CompareExperimentsOApiRequest
+		setup       func(fakeApp *fakeExperimentApp)
+		wantErr     int32
+		wantNoInner bool
+	}{
+		{
+			name:        "nil request",
+			buildReq:    func() *openapi.CompareExperimentsOApiRequest { return nil },
+			wantErr:     errno.CommonInvalidParamCode,
+			wantNoInner: true,
+		},
+		{
+			name: "invalid workspace_id",
+			buildReq: func() *openapi.CompareExperimentsOApiRequest {
+				req := buildBaseReq()
+				req.WorkspaceID = gptr.Of(int64(0))
+				return req
+			},
+			wantErr:     errno.CommonInvalidParamCode,
+			wantNoInner: true,
+		},
+		{
+			name: "invalid base_experiment_id",
+			buildReq: func() *openapi.CompareExperimentsOApiRequest {
+				req := buildBaseReq()
+				req.BaseExperimentID = nil
+				return req
+			},
+			wantErr:     errno.CommonInvalidParamCode,
+			wantNoInner: true,
+		},
+		{
+			name:     "inner compare error",
+			buildReq: buildBaseReq,
+			setup: func(fakeApp *fakeExperimentApp) {
+				fakeApp.compareErr = errors.New("oops")
+			},
+			wantErr: -1,
+		},
+		{
+			name:     "success",
+			buildReq: buildBaseReq,
+			setup: func(fakeApp *fakeExperimentApp) {
+				fakeApp.compareResp = &exptpb.CompareExperimentsResponse{
+					BaseExptID: gptr.Of(baseID),
+					Comparisons: []*domainexpt.ExptPairSignificance{{
+						CandidateExptID: gptr.Of(candidateID),
+						EvaluatorSignificances: []*domainexpt.ExptEvaluatorSignificance{{
+							EvaluatorVersionID: gptr.Of(int64(7)),
+							TTestPValue:        gptr.Of(0.01),
+							Significant:        gptr.Of(true),
+						}},
+					}},
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			fakeApp := &fakeExperimentApp{}
+			app := &EvalOpenAPIApplication{
+				experimentApp: fakeApp,
+				metric:        &fakeOpenAPIMetric{},
+			}
+			if tc.setup != nil {
+				tc.setup(fakeApp)
+			}
+
+			resp, err := app.CompareExperimentsOApi(context.Background(), tc.buildReq())
+			if tc.wantErr != 0 {
+				assert.Error(t, err)
+				if tc.wantErr > 0 {
+					statusErr, ok := errorx.FromStatusError(err)
+					assert.True(t, ok)
+					assert.Equal(t, tc.wantErr, statusErr.Code())
+				}
+				assert.Nil(t, resp)
+				if tc.wantNoInner {
+					assert.Nil(t, fakeApp.lastCompareReq)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			if assert.NotNil(t, fakeApp.lastCompareReq) {
+				assert.Equal(t, workspaceID, fakeApp.lastCompareReq.GetWorkspaceID())
+				assert.Equal(t, baseID, fakeApp.lastCompareReq.GetBaseExptID())
+				assert.Equal(t, []int64{candidateID}, fakeApp.lastCompareReq.GetCandidateExptIds())
+				assert.Equal(t, 0.9, fakeApp.lastCompareReq.GetConfidence())
+			}
+			if assert.NotNil(t, resp) && assert.NotNil(t, resp.Data) && assert.Len(t, resp.Data.Comparisons, 1) {
+				assert.Equal(t, baseID, resp.Data.GetBaseExperimentID())
+				pair := resp.Data.Comparisons[0]
+				assert.Equal(t, candidateID, pair.GetCandidateExperimentID())
+				assert.Equal(t, int64(7), pair.EvaluatorSignificances[0].GetEvaluatorVersionID())
+				assert.True(t, pair.EvaluatorSignificances[0].GetSignificant())
+			}
+		})
+	}
+}
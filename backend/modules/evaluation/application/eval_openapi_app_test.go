@@ -1350,7 +1350,9 @@ func TestEvalOpenAPIApplication_BatchCreateEvaluationSetItemsOApi(t *testing.T)
 		buildReq func() *openapi.BatchCreateEvaluationSetItemsOApiRequest
 		setup    func(auth *rpcmocks.MockIAuthProvider, evalSetSvc *servicemocks.MockIEvaluationSetService, itemSvc *servicemocks.MockEvaluationSetItemService)
 		wantErr  int32
-		wantLen  int
+		// provenanceSetup 写入成功后的来源登记预期
+		provenanceSetup func(provenanceSvc *servicemocks.MockEvaluationSetItemProvenanceService)
+		wantLen         int
 	}{
 		{
 			name: "empty items",
@@ -1424,14 +1426,22 @@ func TestEvalOpenAPIApplication_BatchCreateEvaluationSetItemsOApi(t *testing.T)
 				itemID := gptr.Of(int64(10))
 				isNew := gptr.Of(true)
 				idx := gptr.Of(int32(0))
-				outputs := []*entity.DatasetItemOutput{{ItemKey: itemKey, ItemID: itemID, IsNewItem: isNew, ItemIndex: idx}}
+				outputs := []*entity.DatasetItemOutput{{ItemKey: itemKey, ItemID: itemID, IsNewItem: isNew, ItemIndex: idx}, {ItemID: gptr.Of(int64(11)), IsNewItem: gptr.Of(false)}}
 				itemSvc.EXPECT().BatchCreateEvaluationSetItems(gomock.Any(), gomock.AssignableToTypeOf(&entity.BatchCreateEvaluationSetItemsParam{})).DoAndReturn(func(_ context.Context, param *entity.BatchCreateEvaluationSetItemsParam) (map[int64]int64, []*entity.ItemErrorGroup, []*entity.DatasetItemOutput, error) {
 					require.Len(t, param.Items, 1)
 					assert.Equal(t, []*entity.ResourceTag{{TagName: "tag-a", TagKeyID: 10, ContentType: "option", Status: "active"}}, param.Items[0].Tags)
 					return nil, errorsList, outputs, nil
 				})
 			},
-			wantLen: 1,
+			provenanceSetup: func(provenanceSvc *servicemocks.MockEvaluationSetItemProvenanceService) {
+				provenanceSvc.EXPECT().RecordProvenance(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, param *entity.RecordEvaluationSetItemProvenanceParam) error {
+					assert.Equal(t, []int64{10}, param.ItemIDs)
+					assert.Equal(t, entity.EvaluationSetItemSourceType_OpenAPI, param.Template.SourceType)
+					return nil
+				})
+				provenanceSvc.EXPECT().MarkEdited(gomock.Any(), workspaceID, evaluationSetID, []int64{11}).Return(errors.New("db error"))
+			},
+			wantLen: 2,
 		},
 	}
 
@@ -1446,6 +1456,7 @@ func TestEvalOpenAPIApplication_BatchCreateEvaluationSetItemsOApi(t *testing.T)
 			auth := rpcmocks.NewMockIAuthProvider(ctrl)
 			evalSetSvc := servicemocks.NewMockIEvaluationSetService(ctrl)
 			itemSvc := servicemocks.NewMockEvaluationSetItemService(ctrl)
+			provenanceSvc := servicemocks.NewMockEvaluationSetItemProvenanceService(ctrl)
 			metric := &fakeOpenAPIMetric{}
 
 			app := &EvalOpenAPIApplication{
@@ -1453,6 +1464,7 @@ func TestEvalOpenAPIApplication_BatchCreateEvaluationSetItemsOApi(t *testing.T)
 				evaluationSetService:     evalSetSvc,
 				evaluationSetItemService: itemSvc,
 				metric:                   metric,
+				provenanceService:        provenanceSvc,
 			}
 
 			req := tc.buildReq()
@@ -1463,6 +1475,9 @@ func TestEvalOpenAPIApplication_BatchCreateEvaluationSetItemsOApi(t *testing.T)
 			} else {
 				tc.setup(auth, evalSetSvc, itemSvc)
 			}
+			if tc.provenanceSetup != nil {
+				tc.provenanceSetup(provenanceSvc)
+			}
 
 			resp, err := app.BatchCreateEvaluationSetItemsOApi(context.Background(), req)
 
@@ -1501,6 +1516,8 @@ func TestEvalOpenAPIApplication_BatchUpdateEvaluationSetItemsOApi(t *testing.T)
 		buildReq func() *openapi.BatchUpdateEvaluationSetItemsOApiRequest
 		setup    func(auth *rpcmocks.MockIAuthProvider, evalSetSvc *servicemocks.MockIEvaluationSetService, itemSvc *servicemocks.MockEvaluationSetItemService)
 		wantErr  int32
+		// provenanceSetup 写入成功后的来源登记预期
+		provenanceSetup func(provenanceSvc *servicemocks.MockEvaluationSetItemProvenanceService)
 	}{
 		{
 			name: "empty items",
@@ -1565,9 +1582,12 @@ func TestEvalOpenAPIApplication_BatchUpdateEvaluationSetItemsOApi(t *testing.T)
 				itemSvc.EXPECT().BatchUpdateEvaluationSetItems(gomock.Any(), gomock.AssignableToTypeOf(&entity.BatchUpdateEvaluationSetItemsParam{})).DoAndReturn(func(_ context.Context, param *entity.BatchUpdateEvaluationSetItemsParam) ([]*entity.ItemErrorGroup, []*entity.DatasetItemOutput, error) {
 					require.Len(t, param.Items, 1)
 					assert.Equal(t, []*entity.ResourceTag{{TagName: "tag-b", TagKeyID: 20, ContentType: "option", Status: "active"}}, param.Items[0].Tags)
-					return nil, nil, nil
+					return nil, []*entity.DatasetItemOutput{{ItemID: gptr.Of(int64(12))}}, nil
 				})
 			},
+			provenanceSetup: func(provenanceSvc *servicemocks.MockEvaluationSetItemProvenanceService) {
+				provenanceSvc.EXPECT().MarkEdited(gomock.Any(), workspaceID, evaluationSetID, []int64{12}).Return(nil)
+			},
 		},
 		{
 			name: "get set error",
@@ -1593,6 +1613,7 @@ func TestEvalOpenAPIApplication_BatchUpdateEvaluationSetItemsOApi(t *testing.T)
 			auth := rpcmocks.NewMockIAuthProvider(ctrl)
 			evalSetSvc := servicemocks.NewMockIEvaluationSetService(ctrl)
 			itemSvc := servicemocks.NewMockEvaluationSetItemService(ctrl)
+			provenanceSvc := servicemocks.NewMockEvaluationSetItemProvenanceService(ctrl)
 			metric := &fakeOpenAPIMetric{}
 
 			app := &EvalOpenAPIApplication{
@@ -1600,6 +1621,7 @@ func TestEvalOpenAPIApplication_BatchUpdateEvaluationSetItemsOApi(t *testing.T)
 				evaluationSetService:     evalSetSvc,
 				evaluationSetItemService: itemSvc,
 				metric:                   metric,
+				provenanceService:        provenanceSvc,
 			}
 
 			req := tc.buildReq()
@@ -1610,6 +1632,9 @@ func TestEvalOpenAPIApplication_BatchUpdateEvaluationSetItemsOApi(t *testing.T)
 			} else {
 				tc.setup(auth, evalSetSvc, itemSvc)
 			}
+			if tc.provenanceSetup != nil {
+				tc.provenanceSetup(provenanceSvc)
+			}
 
 			resp, err := app.BatchUpdateEvaluationSetItemsOApi(context.Background(), req)
 
@@ -3339,6 +3364,9 @@ type fakeExperimentApp struct {
 	service.IExptResultExportService
 	service.IExptInsightAnalysisService
 	service.ExptLifecycleEventHandler
+	service.ExptPairwiseRankService
+	service.EvaluatorCalibrationService
+	service.EvaluationSetDedupService
 
 	submitResp *exptpb.SubmitExperimentResponse
 	submitErr  error
@@ -3366,6 +3394,10 @@ type fakeExperimentApp struct {
 	groupIDsResp    *exptpb.GetExperimentIDsByGroupResponse
 	groupIDsErr     error
 	lastGroupIDsReq *exptpb.GetExperimentIDsByGroupRequest
+
+	compareResp    *exptpb.CompareExperimentsResponse
+	compareErr     error
+	lastCompareReq *exptpb.CompareExperimentsRequest
 }
 
 func (f *fakeExperimentApp) SubmitExperiment(ctx context.Context, req *exptpb.SubmitExperimentRequest) (*exptpb.SubmitExperimentResponse, error) {
@@ -3430,6 +3462,17 @@ func (f *fakeExperimentApp) GetExperimentIDsByGroup(_ context.Context, req *expt
 	return &exptpb.GetExperimentIDsByGroupResponse{}, nil
 }
 
+func (f *fakeExperimentApp) CompareExperiments(_ context.Context, req *exptpb.CompareExperimentsRequest) (*exptpb.CompareExperimentsResponse, error) {
+	f.lastCompareReq = req
+	if f.compareErr != nil {
+		return nil, f.compareErr
+	}
+	if f.compareResp != nil {
+		return f.compareResp, nil
+	}
+	return &exptpb.CompareExperimentsResponse{}, nil
+}
+
 var _ IExperimentApplication = (*fakeExperimentApp)(nil)
 
 func newSuccessInvokeResultReq(workspaceID, invokeID int64) *openapi.ReportEvalTargetInvokeResultRequest {
@@ -4316,13 +4359,17 @@ func TestEvalOpenAPIApplication_SubmitEvaluatorVersionOApi(t *testing.T) {
 
 			auth := rpcmocks.NewMockIAuthProvider(ctrl)
 			evaluatorSvc := servicemocks.NewMockEvaluatorService(ctrl)
+			promotionSvc := servicemocks.NewMockEvaluatorPromotionService(ctrl)
 			metric := &fakeOpenAPIMetric{}
 
 			app := &EvalOpenAPIApplication{
 				auth:             auth,
 				evaluatorService: evaluatorSvc,
 				metric:           metric,
+				promotionService: promotionSvc,
 			}
+			// 晋级策略未配置, 回归检查不阻断提交
+			promotionSvc.EXPECT().GuardSubmit(gomock.Any(), gomock.Any(), gomock.Nil()).Return(nil, nil).AnyTimes()
 
 			if tc.name == "nil request" {
 				auth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).Times(0)
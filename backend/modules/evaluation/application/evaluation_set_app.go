@@ -9,6 +9,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/bytedance/gg/gmap"
 	"github.com/bytedance/gg/gptr"
 
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/base"
@@ -40,20 +41,27 @@ func NewEvaluationSetApplicationImpl(auth rpc.IAuthProvider,
 	evaluationSetSchemaService service.EvaluationSetSchemaService,
 	evaluationSetVersionService service.EvaluationSetVersionService,
 	evaluationSetItemService service.EvaluationSetItemService,
+	evaluationSetVersionDiffService service.EvaluationSetVersionDiffService,
+	evaluationSetDedupService service.EvaluationSetDedupService,
+	evaluationSetSynthesisService service.EvaluationSetSynthesisService,
+	evaluationSetItemProvenanceService service.EvaluationSetItemProvenanceService,
 	metric metrics.EvaluationSetMetrics,
 	userInfoService userinfo.UserInfoService,
 	resourceAccessAuthorizer service.ResourceAccessAuthorizer,
 ) evaluation.EvaluationSetService {
 	evaluationSetApplicationOnce.Do(func() {
 		evaluationSetApplication = &EvaluationSetApplicationImpl{
-			auth:                        auth,
-			evaluationSetService:        evaluationSetService,
-			evaluationSetSchemaService:  evaluationSetSchemaService,
-			evaluationSetVersionService: evaluationSetVersionService,
-			evaluationSetItemService:    evaluationSetItemService,
-			metric:                      metric,
-			userInfoService:             userInfoService,
-			resourceAccessAuthorizer:    resourceAccessAuthorizer,
+			auth:                            auth,
+			evaluationSetService:            evaluationSetService,
+			evaluationSetSchemaService:      evaluationSetSchemaService,
+			evaluationSetVersionService:     evaluationSetVersionService,
+			evaluationSetItemService:        evaluationSetItemService,
+			evaluationSetVersionDiffService: evaluationSetVersionDiffService,
+			evaluationSetDedupService:       evaluationSetDedupService,
+			evaluationSetSynthesisService:   evaluationSetSynthesisService,
+			metric:                          metric,
+			userInfoService:                 userInfoService,
+			resourceAccessAuthorizer:        resourceAccessAuthorizer,
 		}
 	})
 
@@ -61,14 +69,18 @@ func NewEvaluationSetApplicationImpl(auth rpc.IAuthProvider,
 }
 
 type EvaluationSetApplicationImpl struct {
-	auth                        rpc.IAuthProvider
-	metric                      metrics.EvaluationSetMetrics
-	evaluationSetService        service.IEvaluationSetService
-	evaluationSetSchemaService  service.EvaluationSetSchemaService
-	evaluationSetVersionService service.EvaluationSetVersionService
-	evaluationSetItemService    service.EvaluationSetItemService
-	userInfoService             userinfo.UserInfoService
-	resourceAccessAuthorizer    service.ResourceAccessAuthorizer
+	auth                               rpc.IAuthProvider
+	metric                             metrics.EvaluationSetMetrics
+	evaluationSetService               service.IEvaluationSetService
+	evaluationSetSchemaService         service.EvaluationSetSchemaService
+	evaluationSetVersionService        service.EvaluationSetVersionService
+	evaluationSetItemService           service.EvaluationSetItemService
+	userInfoService                    userinfo.UserInfoService
+	resourceAccessAuthorizer           service.ResourceAccessAuthorizer
+	evaluationSetVersionDiffService    service.EvaluationSetVersionDiffService
+	evaluationSetDedupService          service.EvaluationSetDedupService
+	evaluationSetSynthesisService      service.EvaluationSetSynthesisService
+	evaluationSetItemProvenanceService service.EvaluationSetItemProvenanceService
 }
 
 func sharedOptionDTO2DO(option *common_domain.SharedResourceOption) *entity.SharedResourceOption {
@@ -592,6 +604,7 @@ func (e *EvaluationSetApplicationImpl) BatchCreateEvaluationSetItems(ctx context
 	if err != nil {
 		return nil, err
 	}
+	e.recordCreatedItemsProvenance(ctx, req.WorkspaceID, req.EvaluationSetID, req.Items, idMap, itemOutputs)
 	// 返回结果构建、错误处理
 	return &eval_set.BatchCreateEvaluationSetItemsResponse{
 		AddedItems:  idMap,
@@ -600,6 +613,49 @@ func (e *EvaluationSetApplicationImpl) BatchCreateEvaluationSetItems(ctx context
 	}, nil
 }
 
+// recordCreatedItemsProvenance 为新写入的行登记来源: 请求声明为 trace 导出的行记录其 trace / span, 其余记为手动创建;
+// 按 item_key 命中已有行的视为编辑。行已写入, 登记失败只记日志, 缺失的来源在读取时补录
+func (e *EvaluationSetApplicationImpl) recordCreatedItemsProvenance(ctx context.Context, spaceID, evaluationSetID int64, items []*domain_eval_set.EvaluationSetItem, idMap map[int64]int64, itemOutputs []*entity.DatasetItemOutput) {
+	existing := make(map[int64]bool)
+	for _, output := range itemOutputs {
+		if output != nil && output.ItemID != nil && output.IsNewItem != nil && !*output.IsNewItem {
+			existing[*output.ItemID] = true
+		}
+	}
+	var manualIDs, traceIDs []int64
+	traceSpans := make(map[int64]*entity.EvaluationSetItemTraceSpan)
+	for idx, itemID := range idMap {
+		if idx < 0 || idx >= int64(len(items)) || existing[itemID] {
+			continue
+		}
+		p := items[idx].GetProvenance()
+		if p.GetSourceType() != domain_eval_set.EvaluationSetItemSourceTypeTraceExport {
+			manualIDs = append(manualIDs, itemID)
+			continue
+		}
+		traceIDs = append(traceIDs, itemID)
+		traceSpans[itemID] = &entity.EvaluationSetItemTraceSpan{TraceID: p.GetTraceID(), SpanID: p.GetSpanID()}
+	}
+	params := []*entity.RecordEvaluationSetItemProvenanceParam{
+		{ItemIDs: manualIDs, Template: &entity.EvaluationSetItemProvenance{SourceType: entity.EvaluationSetItemSourceType_Manual}},
+		{ItemIDs: traceIDs, Template: &entity.EvaluationSetItemProvenance{SourceType: entity.EvaluationSetItemSourceType_TraceExport}, TraceSpans: traceSpans},
+	}
+	for _, param := range params {
+		if len(param.ItemIDs) == 0 {
+			continue
+		}
+		param.SpaceID, param.EvaluationSetID = spaceID, evaluationSetID
+		if err := e.evaluationSetItemProvenanceService.RecordProvenance(ctx, param); err != nil {
+			logs.CtxError(ctx, "record evaluation set item provenance fail, evaluation_set_id: %v, source_type: %v, err: %v", evaluationSetID, param.Template.SourceType, err)
+		}
+	}
+	if len(existing) > 0 {
+		if err := e.evaluationSetItemProvenanceService.MarkEdited(ctx, spaceID, evaluationSetID, gmap.Keys(existing)); err != nil {
+			logs.CtxError(ctx, "mark evaluation set item edited fail, evaluation_set_id: %v, err: %v", evaluationSetID, err)
+		}
+	}
+}
+
 // UpsertEvaluationSetItem implements the EvaluationSetServiceImpl interface.
 func (e *EvaluationSetApplicationImpl) UpdateEvaluationSetItem(ctx context.Context, req *eval_set.UpdateEvaluationSetItemRequest) (resp *eval_set.UpdateEvaluationSetItemResponse, err error) {
 	// 参数校验
@@ -633,6 +689,9 @@ func (e *EvaluationSetApplicationImpl) UpdateEvaluationSetItem(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
+	if err := e.evaluationSetItemProvenanceService.MarkEdited(ctx, req.WorkspaceID, req.EvaluationSetID, []int64{req.ItemID}); err != nil {
+		logs.CtxError(ctx, "mark evaluation set item edited fail, evaluation_set_id: %v, item_id: %v, err: %v", req.EvaluationSetID, req.ItemID, err)
+	}
 	// 返回结果构建、错误处理
 	return &eval_set.UpdateEvaluationSetItemResponse{}, nil
 }
@@ -744,16 +803,28 @@ func (e *EvaluationSetApplicationImpl) ListEvaluationSetItems(ctx context.Contex
 		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
 	}
 	// domain调用：共享时用来源空间查询 item
-	items, total, filterTotal, nextCursor, err := e.evaluationSetItemService.ListEvaluationSetItems(ctx, &entity.ListEvaluationSetItemsParam{
-		SpaceID:         querySpaceID,
-		EvaluationSetID: req.EvaluationSetID,
-		VersionID:       req.VersionID,
-		PageNumber:      req.PageNumber,
-		PageSize:        req.PageSize,
-		OrderBys:        common.ConvertOrderByDTO2DOs(req.OrderBys),
-		Filter:          req.Filter,
-		TagFilter:       tagFilter,
-	})
+	param := &entity.ListEvaluationSetItemsParam{
+		SpaceID:          querySpaceID,
+		EvaluationSetID:  req.EvaluationSetID,
+		VersionID:        req.VersionID,
+		PageNumber:       req.PageNumber,
+		PageSize:         req.PageSize,
+		OrderBys:         common.ConvertOrderByDTO2DOs(req.OrderBys),
+		Filter:           req.Filter,
+		TagFilter:        tagFilter,
+		ProvenanceFilter: evaluation_set.ProvenanceFilterDTO2DO(req.ProvenanceFilter),
+	}
+	var (
+		items              []*entity.EvaluationSetItem
+		total, filterTotal *int64
+		nextCursor         *string
+	)
+	if param.ProvenanceFilter.IsEmpty() {
+		items, total, filterTotal, nextCursor, err = e.evaluationSetItemService.ListEvaluationSetItems(ctx, param)
+	} else {
+		// 按来源筛选由来源服务处理, 返回的行附带来源
+		items, total, filterTotal, nextCursor, err = e.evaluationSetItemProvenanceService.ListEvaluationSetItems(ctx, param)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1172,6 +1243,281 @@ func (e *EvaluationSetApplicationImpl) BatchGetEvaluationSetVersions(ctx context
 	}, nil
 }
 
+func (e *EvaluationSetApplicationImpl) DiffEvaluationSetVersions(ctx context.Context, req *eval_set.DiffEvaluationSetVersionsRequest) (resp *eval_set.DiffEvaluationSetVersionsResponse, err error) {
+	// 参数校验
+	if req == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("req is nil"))
+	}
+	// 鉴权
+	if err = e.authorizeEvaluationSet(ctx, req.WorkspaceID, req.EvaluationSetID, consts.Read); err != nil {
+		return nil, err
+	}
+	// domain调用
+	diff, err := e.evaluationSetVersionDiffService.DiffVersions(ctx, &entity.DiffEvaluationSetVersionsParam{
+		SpaceID:         req.WorkspaceID,
+		EvaluationSetID: req.EvaluationSetID,
+		BaseVersionID:   req.BaseVersionID,
+		TargetVersionID: req.TargetVersionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 返回结果构建、错误处理
+	return &eval_set.DiffEvaluationSetVersionsResponse{
+		Diff: evaluation_set.VersionDiffDO2DTO(diff),
+	}, nil
+}
+
+func (e *EvaluationSetApplicationImpl) MergeEvaluationSetVersion(ctx context.Context, req *eval_set.MergeEvaluationSetVersionRequest) (resp *eval_set.MergeEvaluationSetVersionResponse, err error) {
+	// 参数校验
+	if req == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("req is nil"))
+	}
+	// 鉴权
+	if err = e.authorizeEvaluationSet(ctx, req.WorkspaceID, req.EvaluationSetID, consts.Edit); err != nil {
+		return nil, err
+	}
+	// domain调用
+	res, err := e.evaluationSetVersionDiffService.MergeVersion(ctx, &entity.MergeEvaluationSetVersionParam{
+		SpaceID:         req.WorkspaceID,
+		EvaluationSetID: req.EvaluationSetID,
+		SourceVersionID: req.VersionID,
+		ItemIDs:         req.ItemIds,
+		MergeAllItems:   req.GetMergeAllItems(),
+		MergeSchema:     req.GetMergeSchema(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 返回结果构建、错误处理
+	return &eval_set.MergeEvaluationSetVersionResponse{
+		AddedItemIds:   res.AddedItemIDs,
+		UpdatedItemIds: res.UpdatedItemIDs,
+		RemovedItemIds: res.RemovedItemIDs,
+		SchemaMerged:   gptr.Of(res.SchemaMerged),
+		Errors:         evaluation_set.ItemErrorGroupDO2DTOs(res.Errors),
+	}, nil
+}
+
+func (e *EvaluationSetApplicationImpl) StartEvaluationSetDedupJob(ctx context.Context, req *eval_set.StartEvaluationSetDedupJobRequest) (resp *eval_set.StartEvaluationSetDedupJobResponse, err error) {
+	// 参数校验
+	if req == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("req is nil"))
+	}
+	// 鉴权
+	if err = e.authorizeEvaluationSet(ctx, req.WorkspaceID, req.EvaluationSetID, consts.Read); err != nil {
+		return nil, err
+	}
+	// domain调用
+	job, err := e.evaluationSetDedupService.SubmitDedupJob(ctx, &entity.EvaluationSetDedupParam{
+		SpaceID:         req.WorkspaceID,
+		EvaluationSetID: req.EvaluationSetID,
+		VersionID:       req.VersionID,
+		Conf:            evaluation_set.DedupConfDTO2DO(req.Conf),
+	}, entity.NewSession(ctx))
+	if err != nil {
+		return nil, err
+	}
+	// 返回结果构建、错误处理
+	return &eval_set.StartEvaluationSetDedupJobResponse{
+		Job: evaluation_set.DedupJobDO2DTO(job),
+	}, nil
+}
+
+func (e *EvaluationSetApplicationImpl) GetEvaluationSetDedupJob(ctx context.Context, req *eval_set.GetEvaluationSetDedupJobRequest) (resp *eval_set.GetEvaluationSetDedupJobResponse, err error) {
+	// 参数校验
+	if req == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("req is nil"))
+	}
+	// 鉴权
+	if err = e.authorizeEvaluationSet(ctx, req.WorkspaceID, req.EvaluationSetID, consts.Read); err != nil {
+		return nil, err
+	}
+	// domain调用
+	job, err := e.getDedupJob(ctx, req.WorkspaceID, req.EvaluationSetID, req.JobID)
+	if err != nil {
+		return nil, err
+	}
+	// 返回结果构建、错误处理
+	return &eval_set.GetEvaluationSetDedupJobResponse{
+		Job: evaluation_set.DedupJobDO2DTO(job),
+	}, nil
+}
+
+func (e *EvaluationSetApplicationImpl) ArchiveEvaluationSetDuplicates(ctx context.Context, req *eval_set.ArchiveEvaluationSetDuplicatesRequest) (resp *eval_set.ArchiveEvaluationSetDuplicatesResponse, err error) {
+	// 参数校验
+	if req == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("req is nil"))
+	}
+	// 鉴权
+	if err = e.authorizeEvaluationSet(ctx, req.WorkspaceID, req.EvaluationSetID, consts.Edit); err != nil {
+		return nil, err
+	}
+	// domain调用
+	job, err := e.getDedupJob(ctx, req.WorkspaceID, req.EvaluationSetID, req.JobID)
+	if err != nil {
+		return nil, err
+	}
+	// 归档通过删除草稿行实现, 仅针对草稿检测出的重复行
+	if job.Status != entity.EvaluationSetDedupJobStatus_Success || job.Report == nil || job.VersionID > 0 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("only finished dedup jobs on draft can be archived"))
+	}
+	archived, err := e.evaluationSetDedupService.ArchiveDuplicates(ctx, &entity.ArchiveEvaluationSetDuplicatesParam{
+		SpaceID:         req.WorkspaceID,
+		EvaluationSetID: req.EvaluationSetID,
+		Clusters:        job.Report.Clusters,
+		ItemIDs:         req.ItemIds,
+		Conf:            job.Conf,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 返回结果构建、错误处理
+	return &eval_set.ArchiveEvaluationSetDuplicatesResponse{
+		ArchivedItemIds: archived,
+	}, nil
+}
+
+// getDedupJob 查询去重任务并校验其属于请求中的评测集
+func (e *EvaluationSetApplicationImpl) getDedupJob(ctx context.Context, spaceID, evaluationSetID, jobID int64) (*entity.EvaluationSetDedupJob, error) {
+	job, err := e.evaluationSetDedupService.GetDedupJob(ctx, spaceID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job.EvaluationSetID != evaluationSetID {
+		return nil, errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg("dedup job not found in evaluation set"))
+	}
+	return job, nil
+}
+
+func (e *EvaluationSetApplicationImpl) GenerateEvaluationSetItems(ctx context.Context, req *eval_set.GenerateEvaluationSetItemsRequest) (resp *eval_set.GenerateEvaluationSetItemsResponse, err error) {
+	// 参数校验
+	if req == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("req is nil"))
+	}
+	// 鉴权
+	if err = e.authorizeEvaluationSet(ctx, req.WorkspaceID, req.EvaluationSetID, consts.Edit); err != nil {
+		return nil, err
+	}
+	// domain调用
+	res, err := e.evaluationSetSynthesisService.GenerateItems(ctx, &entity.GenerateEvaluationSetItemsParam{
+		SpaceID:         req.WorkspaceID,
+		EvaluationSetID: req.EvaluationSetID,
+		SeedItemIDs:     req.SeedItemIds,
+		Conf:            evaluation_set.SynthesisConfDTO2DO(req.Conf),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 返回结果构建、错误处理
+	return &eval_set.GenerateEvaluationSetItemsResponse{
+		ItemIds:        res.ItemIDs,
+		GeneratedCnt:   gptr.Of(int32(res.GeneratedCnt)),
+		DuplicateCnt:   gptr.Of(int32(res.DuplicateCnt)),
+		InvalidCnt:     gptr.Of(int32(res.InvalidCnt)),
+		FailedBatchCnt: gptr.Of(int32(res.FailedBatchCnt)),
+		Errors:         evaluation_set.ItemErrorGroupDO2DTOs(res.Errors),
+	}, nil
+}
+
+func (e *EvaluationSetApplicationImpl) ListGeneratedEvaluationSetItems(ctx context.Context, req *eval_set.ListGeneratedEvaluationSetItemsRequest) (resp *eval_set.ListGeneratedEvaluationSetItemsResponse, err error) {
+	// 参数校验
+	if req == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("req is nil"))
+	}
+	// 鉴权
+	if err = e.authorizeEvaluationSet(ctx, req.WorkspaceID, req.EvaluationSetID, consts.Read); err != nil {
+		return nil, err
+	}
+	// domain调用
+	items, total, nextPageToken, err := e.evaluationSetSynthesisService.ListGeneratedItems(ctx, &entity.ListGeneratedEvaluationSetItemsParam{
+		SpaceID:         req.WorkspaceID,
+		EvaluationSetID: req.EvaluationSetID,
+		PageNumber:      req.PageNumber,
+		PageSize:        req.PageSize,
+		PageToken:       req.PageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 返回结果构建、错误处理
+	return &eval_set.ListGeneratedEvaluationSetItemsResponse{
+		Items:         evaluation_set.GeneratedItemDO2DTOs(items),
+		Total:         total,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (e *EvaluationSetApplicationImpl) ReviewGeneratedEvaluationSetItems(ctx context.Context, req *eval_set.ReviewGeneratedEvaluationSetItemsRequest) (resp *eval_set.ReviewGeneratedEvaluationSetItemsResponse, err error) {
+	// 参数校验
+	if req == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("req is nil"))
+	}
+	// 鉴权
+	if err = e.authorizeEvaluationSet(ctx, req.WorkspaceID, req.EvaluationSetID, consts.Edit); err != nil {
+		return nil, err
+	}
+	// domain调用
+	if err = e.evaluationSetSynthesisService.ReviewGeneratedItems(ctx, &entity.ReviewGeneratedEvaluationSetItemsParam{
+		SpaceID:         req.WorkspaceID,
+		EvaluationSetID: req.EvaluationSetID,
+		ApprovedItemIDs: req.ApprovedItemIds,
+		RejectedItemIDs: req.RejectedItemIds,
+	}); err != nil {
+		return nil, err
+	}
+	// 返回结果构建、错误处理
+	return &eval_set.ReviewGeneratedEvaluationSetItemsResponse{}, nil
+}
+
+func (e *EvaluationSetApplicationImpl) GetEvaluationSetItemLineage(ctx context.Context, req *eval_set.GetEvaluationSetItemLineageRequest) (resp *eval_set.GetEvaluationSetItemLineageResponse, err error) {
+	// 参数校验
+	if req == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("req is nil"))
+	}
+	// 鉴权
+	if err = e.authorizeEvaluationSet(ctx, req.WorkspaceID, req.EvaluationSetID, consts.Read); err != nil {
+		return nil, err
+	}
+	// domain调用
+	lineage, err := e.evaluationSetItemProvenanceService.GetItemLineage(ctx, &entity.GetEvaluationSetItemLineageParam{
+		SpaceID:         req.WorkspaceID,
+		EvaluationSetID: req.EvaluationSetID,
+		ItemID:          req.ItemID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 返回结果构建、错误处理
+	return &eval_set.GetEvaluationSetItemLineageResponse{
+		Provenance: evaluation_set.ProvenanceDO2DTO(lineage.Provenance),
+		Usages:     evaluation_set.ItemUsageDO2DTOs(lineage.Usages),
+		Truncated:  gptr.Of(lineage.Truncated),
+	}, nil
+}
+
+// authorizeEvaluationSet 加载评测集并按 owner 鉴权
+func (e *EvaluationSetApplicationImpl) authorizeEvaluationSet(ctx context.Context, spaceID, evaluationSetID int64, action string) error {
+	set, err := e.evaluationSetService.GetEvaluationSet(ctx, &spaceID, evaluationSetID, nil, nil)
+	if err != nil {
+		return err
+	}
+	if set == nil {
+		return errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg("errno set not found"))
+	}
+	var ownerID *string
+	if set.BaseInfo != nil && set.BaseInfo.CreatedBy != nil {
+		ownerID = set.BaseInfo.CreatedBy.UserID
+	}
+	return e.auth.AuthorizationWithoutSPI(ctx, &rpc.AuthorizationWithoutSPIParam{
+		ObjectID:        strconv.FormatInt(set.ID, 10),
+		SpaceID:         spaceID,
+		ActionObjects:   []*rpc.ActionObject{{Action: gptr.Of(action), EntityType: gptr.Of(rpc.AuthEntityType_EvaluationSet)}},
+		OwnerID:         ownerID,
+		ResourceSpaceID: set.SpaceID,
+	})
+}
+
 func (e *EvaluationSetApplicationImpl) ClearEvaluationSetDraftItem(ctx context.Context, req *eval_set.ClearEvaluationSetDraftItemRequest) (r *eval_set.ClearEvaluationSetDraftItemResponse, err error) {
 	// 鉴权
 	err = e.auth.Authorization(ctx, &rpc.AuthorizationParam{
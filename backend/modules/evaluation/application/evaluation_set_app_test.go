@@ -8,6 +8,7 @@ import (
 	"errors"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/bytedance/gg/gptr"
 	"github.com/stretchr/testify/assert"
@@ -17,6 +18,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/data/domain/dataset_job"
 	domain_common "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/common"
 	domain_eval_set "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/eval_set"
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/eval_set"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
 	metricsmock "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics/mocks"
@@ -922,3 +924,469 @@ func TestEvaluationSetApplicationImpl_SharedExecuteVersionSchemaRedaction(t *tes
 		assert.NotNil(t, version.EvaluationSetSchema)
 	})
 }
+
+func TestEvaluationSetApplicationImpl_DiffEvaluationSetVersions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockEvalSetSvc := servicemocks.NewMockIEvaluationSetService(ctrl)
+	mockDiffSvc := servicemocks.NewMockEvaluationSetVersionDiffService(ctrl)
+
+	app := &EvaluationSetApplicationImpl{
+		auth:                            mockAuth,
+		evaluationSetService:            mockEvalSetSvc,
+		evaluationSetVersionDiffService: mockDiffSvc,
+	}
+
+	workspaceID := int64(3003)
+	evalSetID := int64(4004)
+	validSet := &entity.EvaluationSet{ID: evalSetID, SpaceID: workspaceID, BaseInfo: &entity.BaseInfo{CreatedBy: &entity.UserInfo{UserID: gptr.Of("owner")}}}
+	req := &eval_set.DiffEvaluationSetVersionsRequest{
+		WorkspaceID:     workspaceID,
+		EvaluationSetID: evalSetID,
+		BaseVersionID:   gptr.Of(int64(70)),
+	}
+
+	t.Run("nil req", func(t *testing.T) {
+		_, err := app.DiffEvaluationSetVersions(context.Background(), nil)
+		statusErr, ok := errorx.FromStatusError(err)
+		require.True(t, ok)
+		assert.Equal(t, int32(errno.CommonInvalidParamCode), statusErr.Code())
+	})
+
+	t.Run("set not found", func(t *testing.T) {
+		mockEvalSetSvc.EXPECT().GetEvaluationSet(gomock.Any(), gptr.Of(workspaceID), evalSetID, gomock.Nil(), gomock.Nil()).Return(nil, nil)
+		_, err := app.DiffEvaluationSetVersions(context.Background(), req)
+		statusErr, ok := errorx.FromStatusError(err)
+		require.True(t, ok)
+		assert.Equal(t, int32(errno.ResourceNotFoundCode), statusErr.Code())
+	})
+
+	t.Run("成功", func(t *testing.T) {
+		mockEvalSetSvc.EXPECT().GetEvaluationSet(gomock.Any(), gptr.Of(workspaceID), evalSetID, gomock.Nil(), gomock.Nil()).Return(validSet, nil)
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, p *rpc.AuthorizationWithoutSPIParam) error {
+			assert.Equal(t, consts.Read, gptr.Indirect(p.ActionObjects[0].Action))
+			assert.Equal(t, "owner", gptr.Indirect(p.OwnerID))
+			return nil
+		})
+		mockDiffSvc.EXPECT().DiffVersions(gomock.Any(), &entity.DiffEvaluationSetVersionsParam{
+			SpaceID: workspaceID, EvaluationSetID: evalSetID, BaseVersionID: gptr.Of(int64(70)),
+		}).Return(&entity.EvaluationSetVersionDiff{
+			EvaluationSetID: evalSetID,
+			BaseVersionID:   gptr.Of(int64(70)),
+			ItemDiffs: []*entity.EvaluationSetItemDiff{{
+				ItemID:     2,
+				ChangeType: entity.EvaluationSetChangeType_Modified,
+				FieldDiffs: []*entity.EvaluationSetFieldDiff{{TurnIdx: 0, FieldKey: "input", ChangeType: entity.EvaluationSetChangeType_Modified}},
+			}},
+			ModifiedItemCnt: 1,
+		}, nil)
+
+		resp, err := app.DiffEvaluationSetVersions(context.Background(), req)
+		require.NoError(t, err)
+		diff := resp.GetDiff()
+		assert.Equal(t, int64(70), diff.GetBaseVersionID())
+		assert.False(t, diff.IsSetTargetVersionID())
+		assert.Equal(t, int32(1), diff.GetModifiedItemCnt())
+		require.Len(t, diff.ItemDiffs, 1)
+		assert.Equal(t, domain_eval_set.EvaluationSetChangeTypeModified, diff.ItemDiffs[0].GetChangeType())
+		assert.Equal(t, "input", diff.ItemDiffs[0].FieldDiffs[0].GetFieldKey())
+	})
+}
+
+func TestEvaluationSetApplicationImpl_MergeEvaluationSetVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockEvalSetSvc := servicemocks.NewMockIEvaluationSetService(ctrl)
+	mockDiffSvc := servicemocks.NewMockEvaluationSetVersionDiffService(ctrl)
+
+	app := &EvaluationSetApplicationImpl{
+		auth:                            mockAuth,
+		evaluationSetService:            mockEvalSetSvc,
+		evaluationSetVersionDiffService: mockDiffSvc,
+	}
+
+	workspaceID := int64(3003)
+	evalSetID := int64(4004)
+	validSet := &entity.EvaluationSet{ID: evalSetID, SpaceID: workspaceID}
+	req := &eval_set.MergeEvaluationSetVersionRequest{
+		WorkspaceID:     workspaceID,
+		EvaluationSetID: evalSetID,
+		VersionID:       70,
+		ItemIds:         []int64{4},
+		MergeAllItems:   gptr.Of(true),
+	}
+
+	t.Run("auth failed", func(t *testing.T) {
+		mockEvalSetSvc.EXPECT().GetEvaluationSet(gomock.Any(), gptr.Of(workspaceID), evalSetID, gomock.Nil(), gomock.Nil()).Return(validSet, nil)
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).Return(errorx.NewByCode(errno.CommonNoPermissionCode))
+		_, err := app.MergeEvaluationSetVersion(context.Background(), req)
+		statusErr, ok := errorx.FromStatusError(err)
+		require.True(t, ok)
+		assert.Equal(t, int32(errno.CommonNoPermissionCode), statusErr.Code())
+	})
+
+	t.Run("成功", func(t *testing.T) {
+		mockEvalSetSvc.EXPECT().GetEvaluationSet(gomock.Any(), gptr.Of(workspaceID), evalSetID, gomock.Nil(), gomock.Nil()).Return(validSet, nil)
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, p *rpc.AuthorizationWithoutSPIParam) error {
+			assert.Equal(t, consts.Edit, gptr.Indirect(p.ActionObjects[0].Action))
+			return nil
+		})
+		mockDiffSvc.EXPECT().MergeVersion(gomock.Any(), &entity.MergeEvaluationSetVersionParam{
+			SpaceID: workspaceID, EvaluationSetID: evalSetID, SourceVersionID: 70, ItemIDs: []int64{4}, MergeAllItems: true,
+		}).Return(&entity.MergeEvaluationSetVersionResult{
+			AddedItemIDs:   map[int64]int64{3: 300},
+			UpdatedItemIDs: []int64{2},
+			RemovedItemIDs: []int64{4},
+		}, nil)
+
+		resp, err := app.MergeEvaluationSetVersion(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]int64{3: 300}, resp.AddedItemIds)
+		assert.Equal(t, []int64{2}, resp.UpdatedItemIds)
+		assert.Equal(t, []int64{4}, resp.RemovedItemIds)
+		assert.False(t, resp.GetSchemaMerged())
+	})
+}
+
+func TestEvaluationSetApplicationImpl_EvaluationSetDedupJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockEvalSetSvc := servicemocks.NewMockIEvaluationSetService(ctrl)
+	mockDedupSvc := servicemocks.NewMockEvaluationSetDedupService(ctrl)
+
+	app := &EvaluationSetApplicationImpl{
+		auth:                      mockAuth,
+		evaluationSetService:      mockEvalSetSvc,
+		evaluationSetDedupService: mockDedupSvc,
+	}
+
+	workspaceID := int64(3003)
+	evalSetID := int64(4004)
+	validSet := &entity.EvaluationSet{ID: evalSetID, SpaceID: workspaceID}
+	expectAuth := func(action string) {
+		mockEvalSetSvc.EXPECT().GetEvaluationSet(gomock.Any(), gptr.Of(workspaceID), evalSetID, gomock.Nil(), gomock.Nil()).Return(validSet, nil)
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, p *rpc.AuthorizationWithoutSPIParam) error {
+			assert.Equal(t, action, gptr.Indirect(p.ActionObjects[0].Action))
+			return nil
+		})
+	}
+	report := &entity.EvaluationSetDedupReport{
+		ScannedItemCnt:    5,
+		ExactDuplicateCnt: 1,
+		NearDuplicateCnt:  2,
+		Clusters: []*entity.EvaluationSetDuplicateCluster{
+			{Kind: entity.EvaluationSetDuplicateKind_Exact, KeepItemID: 1, DuplicateItemIDs: []int64{2}, Similarity: 1},
+			{Kind: entity.EvaluationSetDuplicateKind_Near, KeepItemID: 3, DuplicateItemIDs: []int64{4, 5}, Similarity: 0.9},
+		},
+	}
+
+	t.Run("start", func(t *testing.T) {
+		expectAuth(consts.Read)
+		mockDedupSvc.EXPECT().SubmitDedupJob(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.EvaluationSetDedupParam, _ *entity.Session) (*entity.EvaluationSetDedupJob, error) {
+				assert.Equal(t, evalSetID, param.EvaluationSetID)
+				assert.Equal(t, entity.EvaluationSetDedupMethod_SimHash, param.Conf.GetMethod())
+				assert.Equal(t, 0.9, param.Conf.GetThreshold())
+				return &entity.EvaluationSetDedupJob{ID: 77, SpaceID: workspaceID, EvaluationSetID: evalSetID, Status: entity.EvaluationSetDedupJobStatus_Running, Conf: param.Conf}, nil
+			})
+
+		resp, err := app.StartEvaluationSetDedupJob(context.Background(), &eval_set.StartEvaluationSetDedupJobRequest{
+			WorkspaceID:     workspaceID,
+			EvaluationSetID: evalSetID,
+			Conf:            &domain_eval_set.EvaluationSetDedupConf{Method: gptr.Of(domain_eval_set.EvaluationSetDedupMethodSimHash), Threshold: gptr.Of(0.9)},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(77), resp.GetJob().GetJobID())
+		assert.Equal(t, domain_eval_set.EvaluationSetDedupJobStatusRunning, resp.GetJob().GetStatus())
+		assert.False(t, resp.GetJob().IsSetVersionID())
+	})
+
+	t.Run("get job of another evaluation set", func(t *testing.T) {
+		expectAuth(consts.Read)
+		mockDedupSvc.EXPECT().GetDedupJob(gomock.Any(), workspaceID, int64(77)).Return(&entity.EvaluationSetDedupJob{ID: 77, EvaluationSetID: evalSetID + 1}, nil)
+		_, err := app.GetEvaluationSetDedupJob(context.Background(), &eval_set.GetEvaluationSetDedupJobRequest{WorkspaceID: workspaceID, EvaluationSetID: evalSetID, JobID: 77})
+		statusErr, ok := errorx.FromStatusError(err)
+		require.True(t, ok)
+		assert.Equal(t, int32(errno.ResourceNotFoundCode), statusErr.Code())
+	})
+
+	t.Run("get", func(t *testing.T) {
+		expectAuth(consts.Read)
+		mockDedupSvc.EXPECT().GetDedupJob(gomock.Any(), workspaceID, int64(77)).Return(&entity.EvaluationSetDedupJob{
+			ID: 77, SpaceID: workspaceID, EvaluationSetID: evalSetID, VersionID: 9, Status: entity.EvaluationSetDedupJobStatus_Success, Report: report,
+		}, nil)
+		resp, err := app.GetEvaluationSetDedupJob(context.Background(), &eval_set.GetEvaluationSetDedupJobRequest{WorkspaceID: workspaceID, EvaluationSetID: evalSetID, JobID: 77})
+		require.NoError(t, err)
+		job := resp.GetJob()
+		assert.Equal(t, int64(9), job.GetVersionID())
+		assert.Equal(t, domain_eval_set.EvaluationSetDedupJobStatusSuccess, job.GetStatus())
+		assert.Equal(t, int32(2), job.GetReport().GetNearDuplicateCnt())
+		require.Len(t, job.GetReport().Clusters, 2)
+		assert.Equal(t, []int64{4, 5}, job.GetReport().Clusters[1].DuplicateItemIds)
+	})
+
+	t.Run("archive rejects version job", func(t *testing.T) {
+		expectAuth(consts.Edit)
+		mockDedupSvc.EXPECT().GetDedupJob(gomock.Any(), workspaceID, int64(77)).Return(&entity.EvaluationSetDedupJob{
+			ID: 77, EvaluationSetID: evalSetID, VersionID: 9, Status: entity.EvaluationSetDedupJobStatus_Success, Report: report,
+		}, nil)
+		_, err := app.ArchiveEvaluationSetDuplicates(context.Background(), &eval_set.ArchiveEvaluationSetDuplicatesRequest{WorkspaceID: workspaceID, EvaluationSetID: evalSetID, JobID: 77})
+		statusErr, ok := errorx.FromStatusError(err)
+		require.True(t, ok)
+		assert.Equal(t, int32(errno.CommonInvalidParamCode), statusErr.Code())
+	})
+
+	t.Run("archive selected duplicates", func(t *testing.T) {
+		expectAuth(consts.Edit)
+		conf := &entity.EvaluationSetDedupConf{Threshold: 0.9}
+		mockDedupSvc.EXPECT().GetDedupJob(gomock.Any(), workspaceID, int64(77)).Return(&entity.EvaluationSetDedupJob{
+			ID: 77, EvaluationSetID: evalSetID, Status: entity.EvaluationSetDedupJobStatus_Success, Report: report, Conf: conf,
+		}, nil)
+		mockDedupSvc.EXPECT().ArchiveDuplicates(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.ArchiveEvaluationSetDuplicatesParam) ([]int64, error) {
+				assert.Equal(t, report.Clusters, param.Clusters)
+				assert.Equal(t, []int64{5}, param.ItemIDs)
+				assert.Equal(t, conf, param.Conf)
+				return []int64{5}, nil
+			})
+		resp, err := app.ArchiveEvaluationSetDuplicates(context.Background(), &eval_set.ArchiveEvaluationSetDuplicatesRequest{
+			WorkspaceID: workspaceID, EvaluationSetID: evalSetID, JobID: 77, ItemIds: []int64{5},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{5}, resp.ArchivedItemIds)
+	})
+}
+
+func TestEvaluationSetApplicationImpl_GeneratedEvaluationSetItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockEvalSetSvc := servicemocks.NewMockIEvaluationSetService(ctrl)
+	mockSynthesisSvc := servicemocks.NewMockEvaluationSetSynthesisService(ctrl)
+
+	app := &EvaluationSetApplicationImpl{
+		auth:                          mockAuth,
+		evaluationSetService:          mockEvalSetSvc,
+		evaluationSetSynthesisService: mockSynthesisSvc,
+	}
+
+	workspaceID := int64(3003)
+	evalSetID := int64(4004)
+	validSet := &entity.EvaluationSet{ID: evalSetID, SpaceID: workspaceID}
+	expectAuth := func(action string) {
+		mockEvalSetSvc.EXPECT().GetEvaluationSet(gomock.Any(), gptr.Of(workspaceID), evalSetID, gomock.Nil(), gomock.Nil()).Return(validSet, nil)
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, p *rpc.AuthorizationWithoutSPIParam) error {
+			assert.Equal(t, action, gptr.Indirect(p.ActionObjects[0].Action))
+			return nil
+		})
+	}
+
+	t.Run("generate", func(t *testing.T) {
+		expectAuth(consts.Edit)
+		mockSynthesisSvc.EXPECT().GenerateItems(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.GenerateEvaluationSetItemsParam) (*entity.GenerateEvaluationSetItemsResult, error) {
+				assert.Equal(t, []int64{1, 2}, param.SeedItemIDs)
+				assert.Equal(t, 3, param.Conf.Count)
+				assert.Equal(t, []entity.EvaluationSetSynthesisStrategy{entity.EvaluationSetSynthesisStrategy_Adversarial}, param.Conf.Strategies)
+				assert.NotNil(t, param.Conf.ModelConfig)
+				return &entity.GenerateEvaluationSetItemsResult{ItemIDs: []int64{10, 11}, GeneratedCnt: 3, DuplicateCnt: 1}, nil
+			})
+
+		resp, err := app.GenerateEvaluationSetItems(context.Background(), &eval_set.GenerateEvaluationSetItemsRequest{
+			WorkspaceID:     workspaceID,
+			EvaluationSetID: evalSetID,
+			SeedItemIds:     []int64{1, 2},
+			Conf: &domain_eval_set.EvaluationSetSynthesisConf{
+				ModelConfig: &domain_common.ModelConfig{ModelID: gptr.Of(int64(1))},
+				Count:       gptr.Of(int32(3)),
+				Strategies:  []string{domain_eval_set.EvaluationSetSynthesisStrategyAdversarial},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{10, 11}, resp.GetItemIds())
+		assert.Equal(t, int32(3), resp.GetGeneratedCnt())
+		assert.Equal(t, int32(1), resp.GetDuplicateCnt())
+	})
+
+	t.Run("list", func(t *testing.T) {
+		expectAuth(consts.Read)
+		mockSynthesisSvc.EXPECT().ListGeneratedItems(gomock.Any(), gomock.Any()).Return([]*entity.GeneratedEvaluationSetItem{
+			{Item: &entity.EvaluationSetItem{ItemID: 10}, ReviewStatus: entity.EvaluationSetItemReviewStatus_PendingReview},
+			{Item: &entity.EvaluationSetItem{ItemID: 11}},
+		}, gptr.Of(int64(2)), gptr.Of("next"), nil)
+
+		resp, err := app.ListGeneratedEvaluationSetItems(context.Background(), &eval_set.ListGeneratedEvaluationSetItemsRequest{WorkspaceID: workspaceID, EvaluationSetID: evalSetID})
+		require.NoError(t, err)
+		require.Len(t, resp.GetItems(), 2)
+		assert.Equal(t, domain_eval_set.GeneratedItemReviewStatusPendingReview, resp.GetItems()[0].GetReviewStatus())
+		assert.False(t, resp.GetItems()[1].IsSetReviewStatus())
+		assert.Equal(t, int64(2), resp.GetTotal())
+		assert.Equal(t, "next", resp.GetNextPageToken())
+	})
+
+	t.Run("review", func(t *testing.T) {
+		expectAuth(consts.Edit)
+		mockSynthesisSvc.EXPECT().ReviewGeneratedItems(gomock.Any(), &entity.ReviewGeneratedEvaluationSetItemsParam{
+			SpaceID: workspaceID, EvaluationSetID: evalSetID, ApprovedItemIDs: []int64{10}, RejectedItemIDs: []int64{11},
+		}).Return(nil)
+
+		_, err := app.ReviewGeneratedEvaluationSetItems(context.Background(), &eval_set.ReviewGeneratedEvaluationSetItemsRequest{
+			WorkspaceID: workspaceID, EvaluationSetID: evalSetID, ApprovedItemIds: []int64{10}, RejectedItemIds: []int64{11},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("nil req", func(t *testing.T) {
+		_, err := app.GenerateEvaluationSetItems(context.Background(), nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestEvaluationSetApplicationImpl_EvaluationSetItemProvenance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockEvalSetSvc := servicemocks.NewMockIEvaluationSetService(ctrl)
+	mockItemSvc := servicemocks.NewMockEvaluationSetItemService(ctrl)
+	mockProvenanceSvc := servicemocks.NewMockEvaluationSetItemProvenanceService(ctrl)
+
+	app := &EvaluationSetApplicationImpl{
+		auth:                               mockAuth,
+		evaluationSetService:               mockEvalSetSvc,
+		evaluationSetItemService:           mockItemSvc,
+		evaluationSetItemProvenanceService: mockProvenanceSvc,
+	}
+
+	workspaceID := int64(3003)
+	evalSetID := int64(4004)
+	validSet := &entity.EvaluationSet{ID: evalSetID, SpaceID: workspaceID}
+	createdAt := time.Unix(1700000000, 0)
+
+	t.Run("list with provenance filter", func(t *testing.T) {
+		mockEvalSetSvc.EXPECT().GetEvaluationSet(gomock.Any(), gptr.Of(workspaceID), evalSetID, gomock.Any(), gomock.Nil()).Return(validSet, nil)
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).Return(nil)
+		mockProvenanceSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.ListEvaluationSetItemsParam) ([]*entity.EvaluationSetItem, *int64, *int64, *string, error) {
+				assert.Equal(t, []entity.EvaluationSetItemSourceType{entity.EvaluationSetItemSourceType_TraceExport}, param.ProvenanceFilter.SourceTypes)
+				assert.Equal(t, []string{"trace-1"}, param.ProvenanceFilter.TraceIDs)
+				return []*entity.EvaluationSetItem{{ItemID: 10, Provenance: &entity.EvaluationSetItemProvenance{
+					ItemID: 10, SourceType: entity.EvaluationSetItemSourceType_TraceExport, TraceID: "trace-1", SpanID: "span-1", CreatedAt: &createdAt,
+				}}}, gptr.Of(int64(1)), gptr.Of(int64(1)), nil, nil
+			})
+
+		resp, err := app.ListEvaluationSetItems(context.Background(), &eval_set.ListEvaluationSetItemsRequest{
+			WorkspaceID:     workspaceID,
+			EvaluationSetID: evalSetID,
+			ProvenanceFilter: &domain_eval_set.EvaluationSetItemProvenanceFilter{
+				SourceTypes: []string{domain_eval_set.EvaluationSetItemSourceTypeTraceExport},
+				TraceIds:    []string{"trace-1"},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetItems(), 1)
+		provenance := resp.GetItems()[0].GetProvenance()
+		assert.Equal(t, domain_eval_set.EvaluationSetItemSourceTypeTraceExport, provenance.GetSourceType())
+		assert.Equal(t, "span-1", provenance.GetSpanID())
+		assert.Equal(t, createdAt.Unix(), provenance.GetCreatedAt())
+		assert.False(t, provenance.IsSetImportJobID())
+		assert.Equal(t, int64(1), resp.GetTotal())
+	})
+
+	t.Run("list without provenance filter", func(t *testing.T) {
+		mockEvalSetSvc.EXPECT().GetEvaluationSet(gomock.Any(), gptr.Of(workspaceID), evalSetID, gomock.Any(), gomock.Nil()).Return(validSet, nil)
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).Return(nil)
+		mockItemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).Return([]*entity.EvaluationSetItem{{ItemID: 10}}, gptr.Of(int64(1)), nil, nil, nil)
+
+		resp, err := app.ListEvaluationSetItems(context.Background(), &eval_set.ListEvaluationSetItemsRequest{
+			WorkspaceID:      workspaceID,
+			EvaluationSetID:  evalSetID,
+			ProvenanceFilter: &domain_eval_set.EvaluationSetItemProvenanceFilter{},
+		})
+		require.NoError(t, err)
+		assert.False(t, resp.GetItems()[0].IsSetProvenance())
+	})
+
+	t.Run("lineage", func(t *testing.T) {
+		mockEvalSetSvc.EXPECT().GetEvaluationSet(gomock.Any(), gptr.Of(workspaceID), evalSetID, gomock.Nil(), gomock.Nil()).Return(validSet, nil)
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, p *rpc.AuthorizationWithoutSPIParam) error {
+			assert.Equal(t, consts.Read, gptr.Indirect(p.ActionObjects[0].Action))
+			return nil
+		})
+		mockProvenanceSvc.EXPECT().GetItemLineage(gomock.Any(), &entity.GetEvaluationSetItemLineageParam{SpaceID: workspaceID, EvaluationSetID: evalSetID, ItemID: 10}).
+			Return(&entity.EvaluationSetItemLineage{
+				ItemID:     10,
+				Provenance: &entity.EvaluationSetItemProvenance{ItemID: 10, SourceType: entity.EvaluationSetItemSourceType_Manual, Author: "u1"},
+				Usages: []*entity.EvaluationSetItemUsage{{
+					ExptID:     1,
+					ExptName:   "expt",
+					ExptStatus: entity.ExptStatus_Success,
+					ItemStatus: entity.ItemRunState_Success,
+					StartAt:    &createdAt,
+					TurnScores: []*entity.EvaluationSetItemTurnScore{{TurnID: 5, WeightedScore: gptr.Of(0.8), EvaluatorScores: map[string]float64{"1_2": 0.8}}},
+				}},
+				Truncated: true,
+			}, nil)
+
+		resp, err := app.GetEvaluationSetItemLineage(context.Background(), &eval_set.GetEvaluationSetItemLineageRequest{WorkspaceID: workspaceID, EvaluationSetID: evalSetID, ItemID: 10})
+		require.NoError(t, err)
+		assert.Equal(t, "u1", resp.GetProvenance().GetAuthor())
+		assert.True(t, resp.GetTruncated())
+		require.Len(t, resp.GetUsages(), 1)
+		usage := resp.GetUsages()[0]
+		assert.Equal(t, domain_expt.ExptStatus_Success, usage.GetExptStatus())
+		assert.Equal(t, domain_expt.ItemRunState_Success, usage.GetItemStatus())
+		assert.Equal(t, createdAt.Unix(), usage.GetStartAt())
+		assert.False(t, usage.IsSetFinishedAt())
+		assert.Equal(t, 0.8, usage.GetTurnScores()[0].GetEvaluatorScores()["1_2"])
+	})
+	t.Run("create records provenance per item", func(t *testing.T) {
+		mockEvalSetSvc.EXPECT().GetEvaluationSet(gomock.Any(), gptr.Of(workspaceID), evalSetID, gomock.Nil(), gomock.Nil()).Return(validSet, nil)
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).Return(nil)
+		mockItemSvc.EXPECT().BatchCreateEvaluationSetItems(gomock.Any(), gomock.Any()).Return(
+			map[int64]int64{0: 10, 1: 11, 2: 12}, nil,
+			[]*entity.DatasetItemOutput{{ItemID: gptr.Of(int64(10)), IsNewItem: gptr.Of(true)}, {ItemID: gptr.Of(int64(11)), IsNewItem: gptr.Of(true)}, {ItemID: gptr.Of(int64(12)), IsNewItem: gptr.Of(false)}}, nil)
+		mockProvenanceSvc.EXPECT().RecordProvenance(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.RecordEvaluationSetItemProvenanceParam) error {
+				assert.Equal(t, []int64{11}, param.ItemIDs)
+				assert.Equal(t, entity.EvaluationSetItemSourceType_Manual, param.Template.SourceType)
+				return nil
+			})
+		mockProvenanceSvc.EXPECT().RecordProvenance(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.RecordEvaluationSetItemProvenanceParam) error {
+				assert.Equal(t, []int64{10}, param.ItemIDs)
+				assert.Equal(t, entity.EvaluationSetItemSourceType_TraceExport, param.Template.SourceType)
+				assert.Equal(t, &entity.EvaluationSetItemTraceSpan{TraceID: "trace-1", SpanID: "span-1"}, param.TraceSpans[10])
+				return errors.New("db error")
+			})
+		mockProvenanceSvc.EXPECT().MarkEdited(gomock.Any(), workspaceID, evalSetID, []int64{12}).Return(nil)
+
+		traceItem := &domain_eval_set.EvaluationSetItem{Provenance: &domain_eval_set.EvaluationSetItemProvenance{
+			SourceType: gptr.Of(domain_eval_set.EvaluationSetItemSourceTypeTraceExport), TraceID: gptr.Of("trace-1"), SpanID: gptr.Of("span-1"),
+		}}
+		resp, err := app.BatchCreateEvaluationSetItems(context.Background(), &eval_set.BatchCreateEvaluationSetItemsRequest{
+			WorkspaceID:     workspaceID,
+			EvaluationSetID: evalSetID,
+			Items:           []*domain_eval_set.EvaluationSetItem{traceItem, {}, {}},
+		})
+		require.NoError(t, err)
+		assert.Len(t, resp.GetAddedItems(), 3)
+	})
+
+	t.Run("update marks item edited", func(t *testing.T) {
+		mockEvalSetSvc.EXPECT().GetEvaluationSet(gomock.Any(), gptr.Of(workspaceID), evalSetID, gomock.Nil(), gomock.Nil()).Return(validSet, nil)
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).Return(nil)
+		mockItemSvc.EXPECT().UpdateEvaluationSetItem(gomock.Any(), workspaceID, evalSetID, int64(10), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mockProvenanceSvc.EXPECT().MarkEdited(gomock.Any(), workspaceID, evalSetID, []int64{10}).Return(nil)
+
+		_, err := app.UpdateEvaluationSetItem(context.Background(), &eval_set.UpdateEvaluationSetItemRequest{WorkspaceID: workspaceID, EvaluationSetID: evalSetID, ItemID: 10})
+		require.NoError(t, err)
+	})
+}
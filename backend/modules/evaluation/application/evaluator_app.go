@@ -60,6 +60,8 @@ func NewEvaluatorHandlerImpl(idgen idgen.IIDGenerator,
 	evaluatorSourceServices map[entity.EvaluatorType]service.EvaluatorSourceService,
 	exptResultService service.ExptResultService,
 	evalAsyncRepo repo.IEvalAsyncRepo,
+	calibrationService service.EvaluatorCalibrationService,
+	promotionService service.EvaluatorPromotionService,
 ) evaluation.EvaluatorService {
 	handler := &EvaluatorHandlerImpl{
 		idgen:                    idgen,
@@ -76,6 +78,8 @@ func NewEvaluatorHandlerImpl(idgen idgen.IIDGenerator,
 		evaluatorSourceServices:  evaluatorSourceServices,
 		exptResultService:        exptResultService,
 		evalAsyncRepo:            evalAsyncRepo,
+		calibrationService:       calibrationService,
+		promotionService:         promotionService,
 	}
 	return handler
 }
@@ -96,6 +100,8 @@ type EvaluatorHandlerImpl struct {
 	evaluatorSourceServices  map[entity.EvaluatorType]service.EvaluatorSourceService
 	exptResultService        service.ExptResultService
 	evalAsyncRepo            repo.IEvalAsyncRepo
+	calibrationService       service.EvaluatorCalibrationService
+	promotionService         service.EvaluatorPromotionService
 }
 
 // ListEvaluators 按查询条件查询 evaluator
@@ -875,13 +881,19 @@ func (e *EvaluatorHandlerImpl) SubmitEvaluatorVersion(ctx context.Context, reque
 	if err != nil {
 		return nil, err
 	}
+	// 提交前回归检查: 请求中的检查配置优先, 否则按评估器晋级策略; 结论为阻断时拒绝提交
+	promotionReport, err := e.promotionService.GuardSubmit(ctx, evaluatorDO, evaluatorconvertor.ConvertEvaluatorPromotionCheckConfDTO2DO(request.GetPromotionCheck()))
+	if err != nil {
+		return nil, err
+	}
 	evaluatorDO, err = e.evaluatorService.SubmitEvaluatorVersion(ctx, evaluatorDO, request.GetVersion(), request.GetDescription(), request.GetCid())
 	if err != nil {
 		return nil, err
 	}
 
 	return &evaluatorservice.SubmitEvaluatorVersionResponse{
-		Evaluator: evaluatorconvertor.ConvertEvaluatorDO2DTO(evaluatorDO),
+		Evaluator:       evaluatorconvertor.ConvertEvaluatorDO2DTO(evaluatorDO),
+		PromotionReport: evaluatorconvertor.ConvertEvaluatorPromotionReportDO2DTO(promotionReport),
 	}, nil
 }
 
@@ -1136,6 +1148,80 @@ func buildRunEvaluatorRequest(evaluatorName string, request *evaluatorservice.Ru
 	return srvReq
 }
 
+// RunEvaluatorCalibration 提交评估器校准任务, 在带人工真值的评测集上异步运行评估器版本并统计与人工打分的一致性
+func (e *EvaluatorHandlerImpl) RunEvaluatorCalibration(ctx context.Context, request *evaluatorservice.RunEvaluatorCalibrationRequest) (resp *evaluatorservice.RunEvaluatorCalibrationResponse, err error) {
+	evaluatorDO, err := e.evaluatorService.GetEvaluatorVersion(ctx, gptr.Of(request.GetWorkspaceID()), request.GetEvaluatorVersionID(), false, false)
+	if err != nil {
+		return nil, err
+	}
+	if evaluatorDO == nil {
+		return nil, errorx.NewByCode(errno.EvaluatorNotExistCode)
+	}
+	// 鉴权
+	err = e.auth.Authorization(ctx, &rpc.AuthorizationParam{
+		ObjectID:      strconv.FormatInt(evaluatorDO.ID, 10),
+		SpaceID:       request.GetWorkspaceID(),
+		ActionObjects: []*rpc.ActionObject{{Action: gptr.Of(consts.Run), EntityType: gptr.Of(rpc.AuthEntityType_Evaluator)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	record, err := e.calibrationService.Calibrate(ctx, &entity.EvaluatorCalibrationParam{
+		SpaceID:                request.GetWorkspaceID(),
+		EvaluatorVersionID:     request.GetEvaluatorVersionID(),
+		EvaluationSetID:        request.GetEvaluationSetID(),
+		EvaluationSetVersionID: request.EvaluationSetVersionID,
+		Conf:                   evaluatorconvertor.ConvertEvaluatorCalibrationConfDTO2DO(request.GetConf()),
+		CreatedBy:              session.UserIDInCtxOrEmpty(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &evaluatorservice.RunEvaluatorCalibrationResponse{
+		Record: evaluatorconvertor.ConvertEvaluatorCalibrationRecordDO2DTO(record),
+	}, nil
+}
+
+// GetEvaluatorCalibrationRecord 获取评估器校准记录
+func (e *EvaluatorHandlerImpl) GetEvaluatorCalibrationRecord(ctx context.Context, request *evaluatorservice.GetEvaluatorCalibrationRecordRequest) (resp *evaluatorservice.GetEvaluatorCalibrationRecordResponse, err error) {
+	// 鉴权
+	err = e.auth.Authorization(ctx, &rpc.AuthorizationParam{
+		ObjectID:      strconv.FormatInt(request.GetWorkspaceID(), 10),
+		SpaceID:       request.GetWorkspaceID(),
+		ActionObjects: []*rpc.ActionObject{{Action: gptr.Of("listLoopEvaluator"), EntityType: gptr.Of(rpc.AuthEntityType_Space)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	record, err := e.calibrationService.GetCalibrationRecord(ctx, request.GetWorkspaceID(), request.GetRecordID())
+	if err != nil {
+		return nil, err
+	}
+	return &evaluatorservice.GetEvaluatorCalibrationRecordResponse{
+		Record: evaluatorconvertor.ConvertEvaluatorCalibrationRecordDO2DTO(record),
+	}, nil
+}
+
+// ListEvaluatorCalibrationRecords 查询评估器各版本的校准记录
+func (e *EvaluatorHandlerImpl) ListEvaluatorCalibrationRecords(ctx context.Context, request *evaluatorservice.ListEvaluatorCalibrationRecordsRequest) (resp *evaluatorservice.ListEvaluatorCalibrationRecordsResponse, err error) {
+	// 鉴权
+	err = e.auth.Authorization(ctx, &rpc.AuthorizationParam{
+		ObjectID:      strconv.FormatInt(request.GetWorkspaceID(), 10),
+		SpaceID:       request.GetWorkspaceID(),
+		ActionObjects: []*rpc.ActionObject{{Action: gptr.Of("listLoopEvaluator"), EntityType: gptr.Of(rpc.AuthEntityType_Space)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	records, err := e.calibrationService.ListCalibrationRecords(ctx, request.GetWorkspaceID(), request.GetEvaluatorID(), request.GetEvaluatorVersionIds())
+	if err != nil {
+		return nil, err
+	}
+	return &evaluatorservice.ListEvaluatorCalibrationRecordsResponse{
+		Records: evaluatorconvertor.ConvertEvaluatorCalibrationRecordDOs2DTOs(records),
+	}, nil
+}
+
 // DebugEvaluator 调试 evaluator_version
 func (e *EvaluatorHandlerImpl) DebugEvaluator(ctx context.Context, request *evaluatorservice.DebugEvaluatorRequest) (resp *evaluatorservice.DebugEvaluatorResponse, err error) {
 	// 鉴权
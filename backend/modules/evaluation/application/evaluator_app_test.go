@@ -1498,11 +1498,13 @@ func TestEvaluatorHandlerImpl_SubmitEvaluatorVersion(t *testing.T) {
 	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
 	mockEvaluatorService := mocks.NewMockEvaluatorService(ctrl)
 	mockAuditClient := auditmocks.NewMockIAuditService(ctrl)
+	mockPromotionService := mocks.NewMockEvaluatorPromotionService(ctrl)
 
 	app := &EvaluatorHandlerImpl{
 		auth:             mockAuth,
 		evaluatorService: mockEvaluatorService,
 		auditClient:      mockAuditClient,
+		promotionService: mockPromotionService,
 	}
 
 	workspaceID := int64(100)
@@ -1533,11 +1535,61 @@ func TestEvaluatorHandlerImpl_SubmitEvaluatorVersion(t *testing.T) {
 				mockEvaluatorService.EXPECT().GetEvaluator(gomock.Any(), workspaceID, evaluatorID, false).
 					Return(evaluatorDO, nil)
 				mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+				mockPromotionService.EXPECT().GuardSubmit(gomock.Any(), evaluatorDO, gomock.Nil()).Return(nil, nil)
 				mockEvaluatorService.EXPECT().SubmitEvaluatorVersion(gomock.Any(), evaluatorDO, version, gomock.Any(), gomock.Any()).
 					Return(evaluatorDO, nil)
 			},
 			wantErr: false,
 		},
+		{
+			name: "promotion_check_with_request_conf",
+			req: &evaluatorservice.SubmitEvaluatorVersionRequest{
+				WorkspaceID: workspaceID,
+				EvaluatorID: evaluatorID,
+				Version:     version,
+				PromotionCheck: &evaluatordto.EvaluatorPromotionCheckConf{
+					BaseVersion: gptr.Of("0.9.0"),
+					Action:      gptr.Of(evaluatordto.EvaluatorPromotionActionWarn),
+					MaxFlipRate: gptr.Of(0.1),
+				},
+			},
+			mockSetup: func() {
+				mockAuditClient.EXPECT().Audit(gomock.Any(), gomock.Any()).
+					Return(audit.AuditRecord{AuditStatus: audit.AuditStatus_Approved}, nil)
+				mockEvaluatorService.EXPECT().GetEvaluator(gomock.Any(), workspaceID, evaluatorID, false).
+					Return(evaluatorDO, nil)
+				mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+				mockPromotionService.EXPECT().GuardSubmit(gomock.Any(), evaluatorDO, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ *entity.Evaluator, conf *entity.EvaluatorPromotionConf) (*entity.EvaluatorPromotionReport, error) {
+						assert.True(t, conf.Enabled)
+						assert.Equal(t, "0.9.0", conf.BaseVersion)
+						assert.Equal(t, entity.EvaluatorPromotionAction_Warn, conf.Action)
+						return &entity.EvaluatorPromotionReport{BaseVersion: "0.9.0", Verdict: entity.EvaluatorPromotionVerdict_Warn}, nil
+					})
+				mockEvaluatorService.EXPECT().SubmitEvaluatorVersion(gomock.Any(), evaluatorDO, version, gomock.Any(), gomock.Any()).
+					Return(evaluatorDO, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "promotion_check_blocked",
+			req: &evaluatorservice.SubmitEvaluatorVersionRequest{
+				WorkspaceID: workspaceID,
+				EvaluatorID: evaluatorID,
+				Version:     version,
+			},
+			mockSetup: func() {
+				mockAuditClient.EXPECT().Audit(gomock.Any(), gomock.Any()).
+					Return(audit.AuditRecord{AuditStatus: audit.AuditStatus_Approved}, nil)
+				mockEvaluatorService.EXPECT().GetEvaluator(gomock.Any(), workspaceID, evaluatorID, false).
+					Return(evaluatorDO, nil)
+				mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+				mockPromotionService.EXPECT().GuardSubmit(gomock.Any(), evaluatorDO, gomock.Nil()).
+					Return(&entity.EvaluatorPromotionReport{Verdict: entity.EvaluatorPromotionVerdict_Block}, errorx.NewByCode(errno.EvaluatorPromotionBlockedCode))
+			},
+			wantErr:     true,
+			wantErrCode: errno.EvaluatorPromotionBlockedCode,
+		},
 		{
 			name: "invalid_version",
 			req: &evaluatorservice.SubmitEvaluatorVersionRequest{
@@ -2029,6 +2081,115 @@ func TestEvaluatorHandlerImpl_GetEvaluatorRecord(t *testing.T) {
 	}
 }
 
+func TestEvaluatorHandlerImpl_RunEvaluatorCalibration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockEvaluatorService := mocks.NewMockEvaluatorService(ctrl)
+	mockCalibrationService := mocks.NewMockEvaluatorCalibrationService(ctrl)
+
+	app := &EvaluatorHandlerImpl{
+		auth:               mockAuth,
+		evaluatorService:   mockEvaluatorService,
+		calibrationService: mockCalibrationService,
+	}
+
+	spaceID := int64(100)
+	versionID := int64(20)
+	req := &evaluatorservice.RunEvaluatorCalibrationRequest{
+		WorkspaceID:        spaceID,
+		EvaluatorVersionID: versionID,
+		EvaluationSetID:    30,
+		Conf: &evaluatordto.EvaluatorCalibrationConf{
+			GroundTruthField:     gptr.Of("human_score"),
+			MaxEvaluatorFailRate: gptr.Of(0.2),
+		},
+	}
+	tests := []struct {
+		name      string
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			mockSetup: func() {
+				mockEvaluatorService.EXPECT().GetEvaluatorVersion(gomock.Any(), gptr.Of(spaceID), versionID, false, false).
+					Return(&entity.Evaluator{ID: 10, SpaceID: spaceID}, nil)
+				mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+				mockCalibrationService.EXPECT().Calibrate(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, param *entity.EvaluatorCalibrationParam) (*entity.EvaluatorCalibrationRecord, error) {
+						assert.Equal(t, int64(30), param.EvaluationSetID)
+						assert.Equal(t, "human_score", param.Conf.GroundTruthField)
+						assert.Equal(t, 0.2, param.Conf.MaxEvaluatorFailRate)
+						return &entity.EvaluatorCalibrationRecord{ID: 1, SpaceID: spaceID, Status: entity.EvaluatorCalibrationStatus_Running}, nil
+					})
+			},
+		},
+		{
+			name: "evaluator_not_found",
+			mockSetup: func() {
+				mockEvaluatorService.EXPECT().GetEvaluatorVersion(gomock.Any(), gptr.Of(spaceID), versionID, false, false).
+					Return(nil, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "auth_failed",
+			mockSetup: func() {
+				mockEvaluatorService.EXPECT().GetEvaluatorVersion(gomock.Any(), gptr.Of(spaceID), versionID, false, false).
+					Return(&entity.Evaluator{ID: 10, SpaceID: spaceID}, nil)
+				mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(errors.New("auth failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			resp, err := app.RunEvaluatorCalibration(context.Background(), req)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, evaluatordto.EvaluatorCalibrationStatusRunning, resp.GetRecord().GetStatus())
+		})
+	}
+}
+
+func TestEvaluatorHandlerImpl_GetEvaluatorCalibrationRecord(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockCalibrationService := mocks.NewMockEvaluatorCalibrationService(ctrl)
+
+	app := &EvaluatorHandlerImpl{
+		auth:               mockAuth,
+		calibrationService: mockCalibrationService,
+	}
+
+	mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+	mockCalibrationService.EXPECT().GetCalibrationRecord(gomock.Any(), int64(100), int64(1)).
+		Return(&entity.EvaluatorCalibrationRecord{
+			ID:     1,
+			Status: entity.EvaluatorCalibrationStatus_Failed,
+			ErrMsg: "evaluator failed on too many items",
+			Result: &entity.EvaluatorCalibrationResult{ItemCnt: 10, EvaluatorFailCnt: 8},
+		}, nil)
+
+	resp, err := app.GetEvaluatorCalibrationRecord(context.Background(), &evaluatorservice.GetEvaluatorCalibrationRecordRequest{
+		WorkspaceID: 100,
+		RecordID:    1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, evaluatordto.EvaluatorCalibrationStatusFailed, resp.GetRecord().GetStatus())
+	assert.Equal(t, "evaluator failed on too many items", resp.GetRecord().GetErrorMsg())
+	assert.Equal(t, int32(8), resp.GetRecord().GetResult_().GetEvaluatorFailCnt())
+}
+
 func TestEvaluatorHandlerImpl_GetEvaluatorRecord_ExtraOutputURIToURL(t *testing.T) {
 	recordID := int64(10)
 	spaceID := int64(100)
@@ -2440,6 +2601,8 @@ func TestEvaluatorHandlerImpl_ComplexBusinessScenarios(t *testing.T) {
 					make(map[entity.EvaluatorType]service.EvaluatorSourceService),
 					mockExptResultService,
 					mockEvalAsyncRepo,
+					nil, // calibrationService
+					nil, // promotionService
 				)
 
 				// 测试复杂的调试场景，涉及多个服务交互
@@ -2990,6 +3153,8 @@ func TestEvaluatorHandlerImpl_ComplexBusinessScenarios(t *testing.T) {
 					make(map[entity.EvaluatorType]service.EvaluatorSourceService),
 					mockExptResultService,
 					mockEvalAsyncRepo,
+					nil, // calibrationService
+					nil, // promotionService
 				)
 
 				// 模拟完整的评估器生命周期：创建 -> 更新 -> 提交版本 -> 运行 -> 删除
@@ -51,6 +51,9 @@ type IExperimentApplication interface {
 	service.IExptResultExportService
 	service.IExptInsightAnalysisService
 	service.ExptLifecycleEventHandler
+	service.ExptPairwiseRankService
+	service.EvaluatorCalibrationService
+	service.EvaluationSetDedupService
 }
 
 type experimentApplication struct {
@@ -69,6 +72,16 @@ type experimentApplication struct {
 	userInfoService userinfo.UserInfoService
 	service.IExptInsightAnalysisService
 	service.ExptLifecycleEventHandler
+	service.ExptPairwiseRankService
+	service.ExptSignificanceService
+	regressionService  service.ExptRegressionService
+	resultCacheService service.IExptResultCacheService
+	samplingService    service.ExptSamplingService
+	groupedAggrService service.ExptGroupedAggrService
+	// EvaluatorCalibrationService 供导出事件消费者异步执行评估器校准
+	service.EvaluatorCalibrationService
+	// EvaluationSetDedupService 供导出事件消费者异步执行评测集去重检测
+	service.EvaluationSetDedupService
 
 	evalTargetService        service.IEvalTargetService
 	evaluationSetItemService service.EvaluationSetItemService
@@ -124,6 +137,14 @@ func NewExperimentApplication(
 	lifecycleEventHandler service.ExptLifecycleEventHandler,
 	sandboxSchedulerAdapter rpc.ISandboxSchedulerAdapter,
 	sandboxAgentMetrics metricscomp.SandboxAgentMetrics,
+	pairwiseRankService service.ExptPairwiseRankService,
+	significanceService service.ExptSignificanceService,
+	calibrationService service.EvaluatorCalibrationService,
+	regressionService service.ExptRegressionService,
+	resultCacheService service.IExptResultCacheService,
+	samplingService service.ExptSamplingService,
+	groupedAggrService service.ExptGroupedAggrService,
+	dedupService service.EvaluationSetDedupService,
 ) IExperimentApplication {
 	return &experimentApplication{
 		resultSvc:                   resultSvc,
@@ -147,6 +168,14 @@ func NewExperimentApplication(
 		fileProvider:                fileProvider,
 		sandboxSchedulerAdapter:     sandboxSchedulerAdapter,
 		sandboxAgentMetrics:         sandboxAgentMetrics,
+		ExptPairwiseRankService:     pairwiseRankService,
+		ExptSignificanceService:     significanceService,
+		EvaluatorCalibrationService: calibrationService,
+		regressionService:           regressionService,
+		resultCacheService:          resultCacheService,
+		samplingService:             samplingService,
+		groupedAggrService:          groupedAggrService,
+		EvaluationSetDedupService:   dedupService,
 	}
 }
 
@@ -606,6 +635,12 @@ func (e *experimentApplication) SubmitExperiment(ctx context.Context, req *expt.
 		EvalSetSourceType:    req.EvalSetSourceType,
 		RefGroupExperimentID: req.RefGroupExperimentID,
 		NotificationConf:     req.NotificationConf,
+		QualityGateConf:      req.QualityGateConf,
+		CostConf:             req.CostConf,
+		ResultCacheConf:      req.ResultCacheConf,
+		SamplingConf:         req.SamplingConf,
+		TrialConf:            req.TrialConf,
+		UserSimulatorConf:    req.UserSimulatorConf,
 		// ★ wiring fix: 透传 run_mode_config 到 CreateExperimentRequest，否则落不进 eval_conf，
 		// operator 读不到 → 走默认 sua_multi_turn 兜底，用户选的 single_turn 被静默忽略。nil 安全。
 		RunModeConfig: req.RunModeConfig,
@@ -1106,6 +1141,10 @@ func (e *experimentApplication) SubmitExptFromTemplate(ctx context.Context, req
 	}
 	// ByteScheduler 模板周期回调经本接口提交，与 trigger_type=schedule 对齐
 	submitReq.TriggerType = gptr.Of(domain_expt.Schedule)
+	// 显式传入的质量门禁覆盖模板配置；未传时由 CreateExpt 按模板 ID 沿用模板门禁
+	if req.QualityGateConf != nil {
+		submitReq.QualityGateConf = req.QualityGateConf
+	}
 	submitReq.Session = &common.Session{}
 	if session.UserID != "" {
 		if userID, parseErr := strconv.ParseInt(session.UserID, 10, 64); parseErr == nil {
@@ -2245,6 +2284,7 @@ func (e *experimentApplication) CreateAnnotateRecord(ctx context.Context, req *e
 			Option:         record.CategoricalOption,
 			TagContentType: entity.TagContentType(record.GetTagContentType()),
 		},
+		BaseInfo: &entity.BaseInfo{CreatedBy: &entity.UserInfo{UserID: gptr.Of(session.UserID)}},
 	}
 
 	if record.Score != nil {
@@ -2260,8 +2300,9 @@ func (e *experimentApplication) CreateAnnotateRecord(ctx context.Context, req *e
 	if err != nil {
 		return nil, err
 	}
+	// 同一标注者重复提交时覆盖其已有记录, 返回实际生效的记录 id
 	return &expt.CreateAnnotateRecordResp{
-		AnnotateRecordID: id,
+		AnnotateRecordID: recordDO.ID,
 		BaseResp:         base.NewBaseResp(),
 	}, nil
 }
@@ -2355,10 +2396,14 @@ func (e *experimentApplication) ExportExptResult_(ctx context.Context, req *expt
 		return nil, err
 	}
 
-	// MultiSetConfig 实验关联多个评测集、列不一致，导出单个 CSV 会混乱，产品决定不支持。
-	// 在入口处按类型直接拒绝，不再发起导出 MQ 事件。
-	if got.EvalSetSourceType == entity.ExptEvalSetSourceType_MultiSetConfig {
-		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("该类型实验暂不支持导出（多评测集列不一致）"))
+	exportFormat, err := entity.ParseExptResultExportFormat(req.GetExportType())
+	if err != nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
+	}
+	// MultiSetConfig 实验关联多个评测集、列不一致，导出单个 CSV/Parquet 会混乱，产品决定不支持；
+	// XLSX 按评测集分 sheet、JSONL 逐行自描述，可以导出。在入口处按类型直接拒绝，不再发起导出 MQ 事件。
+	if got.EvalSetSourceType == entity.ExptEvalSetSourceType_MultiSetConfig && !exportFormat.SupportMultiEvalSet() {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("该类型实验暂不支持导出（多评测集列不一致），请使用 XLSX 或 JSONL 格式"))
 	}
 
 	if !e.configer.GetExptExportWhiteList(ctx).IsUserIDInWhiteList(session.UserID) {
@@ -2378,7 +2423,7 @@ func (e *experimentApplication) ExportExptResult_(ctx context.Context, req *expt
 	if req.IsSetExportColumns() {
 		exportColSpec = experiment.ExportColumnSpecThrift2Entity(req.GetExportColumns())
 	}
-	exportID, err := e.ExportCSV(ctx, req.GetWorkspaceID(), req.GetExptID(), session, exportColSpec)
+	exportID, err := e.ExportCSV(ctx, req.GetWorkspaceID(), req.GetExptID(), session, exportColSpec, exportFormat)
 	if err != nil {
 		return nil, err
 	}
@@ -2594,6 +2639,178 @@ func (e *experimentApplication) GetExptInsightAnalysisRecord(ctx context.Context
 	}, nil
 }
 
+func (e *experimentApplication) SubmitExptPairwiseRank(ctx context.Context, req *expt.SubmitExptPairwiseRankRequest) (r *expt.SubmitExptPairwiseRankResponse, err error) {
+	session := entity.NewSession(ctx)
+	if req.Session != nil && req.Session.UserID != nil {
+		session = &entity.Session{
+			UserID: strconv.FormatInt(gptr.Indirect(req.Session.UserID), 10),
+		}
+	}
+	// 排名会按实验结果批量调用评估器, 按创建实验鉴权
+	err = e.auth.Authorization(ctx, &rpc.AuthorizationParam{
+		ObjectID:      strconv.FormatInt(req.GetWorkspaceID(), 10),
+		SpaceID:       req.GetWorkspaceID(),
+		ActionObjects: []*rpc.ActionObject{{Action: gptr.Of(consts.ActionCreateExpt), EntityType: gptr.Of(rpc.AuthEntityType_Space)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := e.SubmitRankTask(ctx, experiment.ExptPairwiseRankParamDTO2DO(req), session)
+	if err != nil {
+		return nil, err
+	}
+	return &expt.SubmitExptPairwiseRankResponse{
+		RecordID: record.ID,
+		BaseResp: base.NewBaseResp(),
+	}, nil
+}
+
+func (e *experimentApplication) GetExptPairwiseRank(ctx context.Context, req *expt.GetExptPairwiseRankRequest) (r *expt.GetExptPairwiseRankResponse, err error) {
+	err = e.auth.Authorization(ctx, &rpc.AuthorizationParam{
+		ObjectID:      strconv.FormatInt(req.GetWorkspaceID(), 10),
+		SpaceID:       req.GetWorkspaceID(),
+		ActionObjects: []*rpc.ActionObject{{Action: gptr.Of(consts.ActionReadExpt), EntityType: gptr.Of(rpc.AuthEntityType_Space)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := e.GetRankRecord(ctx, req.GetWorkspaceID(), req.GetRecordID())
+	if err != nil {
+		return nil, err
+	}
+	return &expt.GetExptPairwiseRankResponse{
+		Record:   experiment.ExptPairwiseRankRecordDO2DTO(record),
+		BaseResp: base.NewBaseResp(),
+	}, nil
+}
+
+func (e *experimentApplication) CompareExperiments(ctx context.Context, req *expt.CompareExperimentsRequest) (r *expt.CompareExperimentsResponse, err error) {
+	err = e.auth.Authorization(ctx, &rpc.AuthorizationParam{
+		ObjectID:      strconv.FormatInt(req.GetWorkspaceID(), 10),
+		SpaceID:       req.GetWorkspaceID(),
+		ActionObjects: []*rpc.ActionObject{{Action: gptr.Of(consts.ActionReadExpt), EntityType: gptr.Of(rpc.AuthEntityType_Space)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := e.ExptSignificanceService.CompareExperiments(ctx, experiment.ExptSignificanceParamDTO2DO(req))
+	if err != nil {
+		return nil, err
+	}
+	return &expt.CompareExperimentsResponse{
+		BaseExptID:  gptr.Of(result.BaseExptID),
+		Comparisons: experiment.ExptPairSignificancesDO2DTOs(result.Comparisons),
+		BaseResp:    base.NewBaseResp(),
+	}, nil
+}
+
+func (e *experimentApplication) GetExptRegressionReport(ctx context.Context, req *expt.GetExptRegressionReportRequest) (r *expt.GetExptRegressionReportResponse, err error) {
+	report, err := e.buildRegressionReport(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &expt.GetExptRegressionReportResponse{
+		Report:   experiment.ExptRegressionReportDO2DTO(report),
+		BaseResp: base.NewBaseResp(),
+	}, nil
+}
+
+func (e *experimentApplication) ExportExptRegressionReport(ctx context.Context, req *expt.ExportExptRegressionReportRequest) (r *expt.ExportExptRegressionReportResponse, err error) {
+	exportFormat, err := entity.ParseExptResultExportFormat(req.GetExportType())
+	if err != nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
+	}
+	report, err := e.buildRegressionReport(ctx, experiment.ExportExptRegressionReportReq2ReportReq(req))
+	if err != nil {
+		return nil, err
+	}
+	url, err := e.UploadRegressionReport(ctx, report, exportFormat)
+	if err != nil {
+		return nil, err
+	}
+	return &expt.ExportExptRegressionReportResponse{
+		URL:      gptr.Of(url),
+		BaseResp: base.NewBaseResp(),
+	}, nil
+}
+
+func (e *experimentApplication) InvalidateExptResultCache(ctx context.Context, req *expt.InvalidateExptResultCacheRequest) (r *expt.InvalidateExptResultCacheResponse, err error) {
+	err = e.auth.Authorization(ctx, &rpc.AuthorizationParam{
+		ObjectID:      strconv.FormatInt(req.GetWorkspaceID(), 10),
+		SpaceID:       req.GetWorkspaceID(),
+		ActionObjects: []*rpc.ActionObject{{Action: gptr.Of(consts.ActionCreateExpt), EntityType: gptr.Of(rpc.AuthEntityType_Space)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = e.resultCacheService.Invalidate(ctx, req.GetWorkspaceID()); err != nil {
+		return nil, err
+	}
+	return &expt.InvalidateExptResultCacheResponse{BaseResp: base.NewBaseResp()}, nil
+}
+
+func (e *experimentApplication) GetExptSampledAggrResult_(ctx context.Context, req *expt.GetExptSampledAggrResultRequest) (r *expt.GetExptSampledAggrResultResponse, err error) {
+	err = e.auth.Authorization(ctx, &rpc.AuthorizationParam{
+		ObjectID:      strconv.FormatInt(req.GetWorkspaceID(), 10),
+		SpaceID:       req.GetWorkspaceID(),
+		ActionObjects: []*rpc.ActionObject{{Action: gptr.Of(consts.ActionReadExpt), EntityType: gptr.Of(rpc.AuthEntityType_Space)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := e.samplingService.GetSampledAggrResult(ctx, req.GetWorkspaceID(), req.GetExptID(), req.GetConfidence())
+	if err != nil {
+		return nil, err
+	}
+	return &expt.GetExptSampledAggrResultResponse{
+		Result_:  experiment.SampledAggrResultDO2DTO(res),
+		BaseResp: base.NewBaseResp(),
+	}, nil
+}
+
+func (e *experimentApplication) GetExptGroupedAggrResult_(ctx context.Context, req *expt.GetExptGroupedAggrResultRequest) (r *expt.GetExptGroupedAggrResultResponse, err error) {
+	err = e.auth.Authorization(ctx, &rpc.AuthorizationParam{
+		ObjectID:      strconv.FormatInt(req.GetWorkspaceID(), 10),
+		SpaceID:       req.GetWorkspaceID(),
+		ActionObjects: []*rpc.ActionObject{{Action: gptr.Of(consts.ActionReadExpt), EntityType: gptr.Of(rpc.AuthEntityType_Space)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := e.groupedAggrService.GetGroupedAggrResult(ctx, experiment.ExptGroupedAggrParamDTO2DO(req))
+	if err != nil {
+		return nil, err
+	}
+	return &expt.GetExptGroupedAggrResultResponse{
+		Dimension:   experiment.ExptGroupDimensionDO2DTO(res.Dimension),
+		ExptResults: experiment.ExptGroupedAggrDOs2DTOs(res.ExptResults),
+		BaseResp:    base.NewBaseResp(),
+	}, nil
+}
+
+func (e *experimentApplication) buildRegressionReport(ctx context.Context, req *expt.GetExptRegressionReportRequest) (*entity.ExptRegressionReport, error) {
+	err := e.auth.Authorization(ctx, &rpc.AuthorizationParam{
+		ObjectID:      strconv.FormatInt(req.GetWorkspaceID(), 10),
+		SpaceID:       req.GetWorkspaceID(),
+		ActionObjects: []*rpc.ActionObject{{Action: gptr.Of(consts.ActionReadExpt), EntityType: gptr.Of(rpc.AuthEntityType_Space)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	param, err := experiment.ExptRegressionReportParamDTO2DO(req)
+	if err != nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
+	}
+	return e.regressionService.BuildRegressionReport(ctx, param)
+}
+
 func (e *experimentApplication) FeedbackExptInsightAnalysisReport(ctx context.Context, req *expt.FeedbackExptInsightAnalysisReportRequest) (r *expt.FeedbackExptInsightAnalysisReportResponse, err error) {
 	session := entity.NewSession(ctx)
 	if req.Session != nil && req.Session.UserID != nil {
@@ -3155,6 +3155,14 @@ func TestExperimentApplication_RetryExperiment(t *testing.T) {
 				nil, // lifecycleEventHandler
 				nil, // sandboxSchedulerAdapter
 				nil, // sandboxAgentMetrics
+				nil, // pairwiseRankService
+				nil, // significanceService
+				nil, // calibrationService
+				nil, // regressionService
+				nil, // resultCacheService
+				nil, // samplingService
+				nil, // groupedAggrService
+				nil, // dedupService
 			)
 
 			// 执行测试
@@ -3408,6 +3416,14 @@ func TestExperimentApplication_KillExperiment(t *testing.T) {
 				nil, // lifecycleEventHandler
 				nil, // sandboxSchedulerAdapter
 				nil, // sandboxAgentMetrics
+				nil, // pairwiseRankService
+				nil, // significanceService
+				nil, // calibrationService
+				nil, // regressionService
+				nil, // resultCacheService
+				nil, // samplingService
+				nil, // groupedAggrService
+				nil, // dedupService
 			)
 
 			// 设置 context 中的 UserID，这样 entity.NewSession 才能获取到 UserID
@@ -3513,6 +3529,14 @@ func TestExperimentApplication_CreateExperimentTemplate(t *testing.T) {
 		nil,                 // lifecycleEventHandler
 		nil,                 // sandboxSchedulerAdapter
 		nil,                 // sandboxAgentMetrics
+		nil,                 // pairwiseRankService
+		nil,                 // significanceService
+		nil,                 // calibrationService
+		nil,                 // regressionService
+		nil,                 // resultCacheService
+		nil,                 // samplingService
+		nil,                 // groupedAggrService
+		nil,                 // dedupService
 	)
 
 	resp, err := app.CreateExperimentTemplate(context.Background(), req)
@@ -3617,6 +3641,14 @@ func TestExperimentApplication_BatchGetExperimentTemplate(t *testing.T) {
 				nil,                 // lifecycleEventHandler
 				nil,                 // sandboxSchedulerAdapter
 				nil,                 // sandboxAgentMetrics
+				nil,                 // pairwiseRankService
+				nil,                 // significanceService
+				nil,                 // calibrationService
+				nil,                 // regressionService
+				nil,                 // resultCacheService
+				nil,                 // samplingService
+				nil,                 // groupedAggrService
+				nil,                 // dedupService
 			)
 			resp, err := app.BatchGetExperimentTemplate(context.Background(), tt.req)
 			if tt.wantErr {
@@ -3663,6 +3695,14 @@ func TestExperimentApplication_UpdateExperimentTemplate(t *testing.T) {
 			nil,                 // lifecycleEventHandler
 			nil,                 // sandboxSchedulerAdapter
 			nil,                 // sandboxAgentMetrics
+			nil,                 // pairwiseRankService
+			nil,                 // significanceService
+			nil,                 // calibrationService
+			nil,                 // regressionService
+			nil,                 // resultCacheService
+			nil,                 // samplingService
+			nil,                 // groupedAggrService
+			nil,                 // dedupService
 		)
 		_, err := app.UpdateExperimentTemplate(context.Background(), &exptpb.UpdateExperimentTemplateRequest{})
 		assert.Error(t, err)
@@ -3744,6 +3784,14 @@ func TestExperimentApplication_UpdateExperimentTemplate(t *testing.T) {
 			nil,                 // lifecycleEventHandler
 			nil,                 // sandboxSchedulerAdapter
 			nil,                 // sandboxAgentMetrics
+			nil,                 // pairwiseRankService
+			nil,                 // significanceService
+			nil,                 // calibrationService
+			nil,                 // regressionService
+			nil,                 // resultCacheService
+			nil,                 // samplingService
+			nil,                 // groupedAggrService
+			nil,                 // dedupService
 		)
 		resp, err := app.UpdateExperimentTemplate(context.Background(), req)
 		assert.NoError(t, err)
@@ -3804,6 +3852,14 @@ func TestExperimentApplication_UpdateExperimentTemplate(t *testing.T) {
 			nil,                 // lifecycleEventHandler
 			nil,                 // sandboxSchedulerAdapter
 			nil,                 // sandboxAgentMetrics
+			nil,                 // pairwiseRankService
+			nil,                 // significanceService
+			nil,                 // calibrationService
+			nil,                 // regressionService
+			nil,                 // resultCacheService
+			nil,                 // samplingService
+			nil,                 // groupedAggrService
+			nil,                 // dedupService
 		)
 		_, err := app.UpdateExperimentTemplate(context.Background(), req)
 		assert.Error(t, err)
@@ -3843,6 +3899,14 @@ func TestExperimentApplication_UpdateExperimentTemplateMeta(t *testing.T) {
 			nil,                 // lifecycleEventHandler
 			nil,                 // sandboxSchedulerAdapter
 			nil,                 // sandboxAgentMetrics
+			nil,                 // pairwiseRankService
+			nil,                 // significanceService
+			nil,                 // calibrationService
+			nil,                 // regressionService
+			nil,                 // resultCacheService
+			nil,                 // samplingService
+			nil,                 // groupedAggrService
+			nil,                 // dedupService
 		)
 		_, err := app.UpdateExperimentTemplateMeta(context.Background(), &exptpb.UpdateExperimentTemplateMetaRequest{})
 		assert.Error(t, err)
@@ -3912,6 +3976,14 @@ func TestExperimentApplication_UpdateExperimentTemplateMeta(t *testing.T) {
 			nil,                 // lifecycleEventHandler
 			nil,                 // sandboxSchedulerAdapter
 			nil,                 // sandboxAgentMetrics
+			nil,                 // pairwiseRankService
+			nil,                 // significanceService
+			nil,                 // calibrationService
+			nil,                 // regressionService
+			nil,                 // resultCacheService
+			nil,                 // samplingService
+			nil,                 // groupedAggrService
+			nil,                 // dedupService
 		)
 		resp, err := app.UpdateExperimentTemplateMeta(context.Background(), req)
 		assert.NoError(t, err)
@@ -3964,6 +4036,14 @@ func TestExperimentApplication_DeleteExperimentTemplate(t *testing.T) {
 		nil,                 // lifecycleEventHandler
 		nil,                 // sandboxSchedulerAdapter
 		nil,                 // sandboxAgentMetrics
+		nil,                 // pairwiseRankService
+		nil,                 // significanceService
+		nil,                 // calibrationService
+		nil,                 // regressionService
+		nil,                 // resultCacheService
+		nil,                 // samplingService
+		nil,                 // groupedAggrService
+		nil,                 // dedupService
 	)
 	resp, err := app.DeleteExperimentTemplate(context.Background(), req)
 	assert.NoError(t, err)
@@ -4038,6 +4118,14 @@ func TestExperimentApplication_ListExperimentTemplates(t *testing.T) {
 		nil,                 // lifecycleEventHandler
 		nil,                 // sandboxSchedulerAdapter
 		nil,                 // sandboxAgentMetrics
+		nil,                 // pairwiseRankService
+		nil,                 // significanceService
+		nil,                 // calibrationService
+		nil,                 // regressionService
+		nil,                 // resultCacheService
+		nil,                 // samplingService
+		nil,                 // groupedAggrService
+		nil,                 // dedupService
 	)
 	resp, err := app.ListExperimentTemplates(context.Background(), req)
 	assert.NoError(t, err)
@@ -4083,6 +4171,14 @@ func TestExperimentApplication_ListExperimentTemplates_FilterOptionAndDefaultSor
 			nil, // lifecycleEventHandler
 			nil, // sandboxSchedulerAdapter
 			nil, // sandboxAgentMetrics
+			nil, // pairwiseRankService
+			nil, // significanceService
+			nil, // calibrationService
+			nil, // regressionService
+			nil, // resultCacheService
+			nil, // samplingService
+			nil, // groupedAggrService
+			nil, // dedupService
 		)
 		_, err := app.ListExperimentTemplates(context.Background(), req)
 		assert.NoError(t, err)
@@ -4113,6 +4209,14 @@ func TestExperimentApplication_ListExperimentTemplates_FilterOptionAndDefaultSor
 			nil, // lifecycleEventHandler
 			nil, // sandboxSchedulerAdapter
 			nil, // sandboxAgentMetrics
+			nil, // pairwiseRankService
+			nil, // significanceService
+			nil, // calibrationService
+			nil, // regressionService
+			nil, // resultCacheService
+			nil, // samplingService
+			nil, // groupedAggrService
+			nil, // dedupService
 		)
 		_, err := app.ListExperimentTemplates(context.Background(), req)
 		assert.NoError(t, err)
@@ -4146,6 +4250,14 @@ func TestExperimentApplication_ListExperimentTemplates_FilterOptionAndDefaultSor
 			nil, // lifecycleEventHandler
 			nil, // sandboxSchedulerAdapter
 			nil, // sandboxAgentMetrics
+			nil, // pairwiseRankService
+			nil, // significanceService
+			nil, // calibrationService
+			nil, // regressionService
+			nil, // resultCacheService
+			nil, // samplingService
+			nil, // groupedAggrService
+			nil, // dedupService
 		)
 		_, err := app.ListExperimentTemplates(context.Background(), req)
 		assert.NoError(t, err)
@@ -4181,6 +4293,14 @@ func TestExperimentApplication_ListExperimentTemplates_FilterOptionAndDefaultSor
 			nil, // lifecycleEventHandler
 			nil, // sandboxSchedulerAdapter
 			nil, // sandboxAgentMetrics
+			nil, // pairwiseRankService
+			nil, // significanceService
+			nil, // calibrationService
+			nil, // regressionService
+			nil, // resultCacheService
+			nil, // samplingService
+			nil, // groupedAggrService
+			nil, // dedupService
 		)
 		// 这个测试主要验证 FilterOption 不为 nil 时会调用 Convert
 		// 具体的转换逻辑在 filter convertor 的测试中覆盖
@@ -5423,7 +5543,7 @@ func TestExperimentApplication_ExportExptResult_(t *testing.T) {
 
 			// 模拟导出实验结果
 			mockExptResultExportService.EXPECT().
-				ExportCSV(gomock.Any(), validWorkspaceID, validExptID, gomock.Any(), gomock.Any()).
+				ExportCSV(gomock.Any(), validWorkspaceID, validExptID, gomock.Any(), gomock.Any(), entity.ExptResultExportFormatCSV).
 				Return(validExportID, nil)
 			mockManager.EXPECT().
 				Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
@@ -5456,6 +5576,56 @@ func TestExperimentApplication_ExportExptResult_(t *testing.T) {
 		wantResp: nil,
 		wantErr:  true,
 		wantCode: errno.CommonNoPermissionCode,
+	}, {
+		name: "多评测集实验导出XLSX",
+		req: &exptpb.ExportExptResultRequest{
+			WorkspaceID: validWorkspaceID,
+			ExptID:      validExptID,
+			ExportType:  ptr.Of("xlsx"),
+		},
+		mockSetup: func() {
+			mockAuth.EXPECT().
+				AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).
+				Return(nil)
+			mockExptResultExportService.EXPECT().
+				ExportCSV(gomock.Any(), validWorkspaceID, validExptID, gomock.Any(), gomock.Any(), entity.ExptResultExportFormatXLSX).
+				Return(validExportID, nil)
+			mockManager.EXPECT().
+				Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&entity.Experiment{EvalSetSourceType: entity.ExptEvalSetSourceType_MultiSetConfig}, nil)
+		},
+		wantResp: &exptpb.ExportExptResultResponse{
+			ExportID: validExportID,
+			BaseResp: base.NewBaseResp(),
+		},
+		wantErr: false,
+	}, {
+		name: "多评测集实验不支持CSV",
+		req: &exptpb.ExportExptResultRequest{
+			WorkspaceID: validWorkspaceID,
+			ExptID:      validExptID,
+		},
+		mockSetup: func() {
+			mockManager.EXPECT().
+				Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&entity.Experiment{EvalSetSourceType: entity.ExptEvalSetSourceType_MultiSetConfig}, nil)
+		},
+		wantErr:  true,
+		wantCode: errno.CommonInvalidParamCode,
+	}, {
+		name: "不支持的导出格式",
+		req: &exptpb.ExportExptResultRequest{
+			WorkspaceID: validWorkspaceID,
+			ExptID:      validExptID,
+			ExportType:  ptr.Of("pdf"),
+		},
+		mockSetup: func() {
+			mockManager.EXPECT().
+				Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&entity.Experiment{}, nil)
+		},
+		wantErr:  true,
+		wantCode: errno.CommonInvalidParamCode,
 	}}
 
 	for _, tt := range tests {
@@ -7340,6 +7510,14 @@ func TestExperimentApplication_RetryExperiment_Branches(t *testing.T) {
 		nil, mockEvalTargetSvc, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 		mockSandboxScheduler,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
 	t.Run("auth fails", func(t *testing.T) {
@@ -7574,6 +7752,14 @@ func TestExperimentApplication_ListExperimentTemplates_MoreBranches(t *testing.T
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
 	t.Run("auth error", func(t *testing.T) {
@@ -8503,3 +8689,282 @@ func TestExperimentApplication_emitSandboxAgentExperimentStarted(t *testing.T) {
 		app.emitSandboxAgentExperimentStarted(context.Background(), exptDTO)
 	})
 }
+
+func TestExperimentApplication_CompareExperiments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockSignificance := servicemocks.NewMockExptSignificanceService(ctrl)
+	app := &experimentApplication{auth: mockAuth, ExptSignificanceService: mockSignificance}
+
+	req := &exptpb.CompareExperimentsRequest{
+		WorkspaceID:         100,
+		BaseExptID:          1,
+		CandidateExptIds:    []int64{2},
+		BootstrapIterations: gptr.Of(int32(500)),
+	}
+
+	t.Run("auth error", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(errors.New("forbidden"))
+		_, err := app.CompareExperiments(context.Background(), req)
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+		mockSignificance.EXPECT().CompareExperiments(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, param *entity.ExptSignificanceParam) (*entity.ExptSignificanceResult, error) {
+				assert.Equal(t, int64(100), param.SpaceID)
+				assert.Equal(t, 500, param.BootstrapIterations)
+				return &entity.ExptSignificanceResult{
+					BaseExptID: 1,
+					Comparisons: []*entity.ExptPairSignificance{{
+						CandidateExptID:  2,
+						EvaluatorResults: []*entity.EvaluatorSignificance{{EvaluatorVersionID: 10, MeanDelta: 0.2, Significant: true}},
+					}},
+				}, nil
+			})
+		resp, err := app.CompareExperiments(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), resp.GetBaseExptID())
+		assert.Len(t, resp.GetComparisons(), 1)
+		assert.Equal(t, int64(2), resp.GetComparisons()[0].GetCandidateExptID())
+		assert.True(t, resp.GetComparisons()[0].GetEvaluatorSignificances()[0].GetSignificant())
+	})
+}
+
+func TestExperimentApplication_GetExptRegressionReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockRegression := servicemocks.NewMockExptRegressionService(ctrl)
+	app := &experimentApplication{auth: mockAuth, regressionService: mockRegression}
+
+	req := &exptpb.GetExptRegressionReportRequest{
+		WorkspaceID:      100,
+		BaseExptID:       1,
+		CandidateExptIds: []int64{2},
+		Threshold:        gptr.Of(0.1),
+		ChangeTypes:      []string{expt.ExptRegressionChangeTypeRegressed},
+	}
+
+	t.Run("auth error", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(errors.New("forbidden"))
+		_, err := app.GetExptRegressionReport(context.Background(), req)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+		_, err := app.GetExptRegressionReport(context.Background(), &exptpb.GetExptRegressionReportRequest{
+			WorkspaceID:      100,
+			BaseExptID:       1,
+			CandidateExptIds: []int64{2},
+			Filter: &expt.ExperimentFilter{Filters: &expt.Filters{
+				LogicOp:          gptr.Of(expt.FilterLogicOp_Or),
+				FilterConditions: []*expt.FilterCondition{{}},
+			}},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+		mockRegression.EXPECT().BuildRegressionReport(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, param *entity.ExptRegressionReportParam) (*entity.ExptRegressionReport, error) {
+				assert.Equal(t, int64(100), param.SpaceID)
+				assert.Equal(t, 0.1, param.Threshold)
+				assert.Equal(t, []entity.ExptRegressionChangeType{entity.ExptRegressionChangeRegressed}, param.ChangeTypes)
+				return &entity.ExptRegressionReport{
+					BaseExptID: 1,
+					Summaries:  []*entity.ExptRegressionSummary{{CandidateExptID: 2, PairedTurnCnt: 1, RegressedCnt: 1}},
+					Items: []*entity.ExptRegressionItem{{
+						ItemID: 10,
+						TurnID: 20,
+						Base:   &entity.ExptRegressionTurnResult{ExptID: 1, WeightedScore: gptr.Of(0.9)},
+						Candidates: []*entity.ExptRegressionCandidateResult{{
+							ExptRegressionTurnResult: &entity.ExptRegressionTurnResult{ExptID: 2, WeightedScore: gptr.Of(0.5)},
+							Change:                   entity.ExptRegressionChangeRegressed,
+							WeightedScoreDelta:       gptr.Of(-0.4),
+						}},
+					}},
+				}, nil
+			})
+		resp, err := app.GetExptRegressionReport(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), resp.GetReport().GetBaseExptID())
+		assert.Equal(t, int64(1), resp.GetReport().GetSummaries()[0].GetRegressedCnt())
+		candidate := resp.GetReport().GetItems()[0].GetCandidates()[0]
+		assert.Equal(t, expt.ExptRegressionChangeTypeRegressed, candidate.GetChange())
+		assert.Equal(t, int64(2), candidate.GetResult_().GetExptID())
+	})
+}
+
+func TestExperimentApplication_ExportExptRegressionReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockRegression := servicemocks.NewMockExptRegressionService(ctrl)
+	mockExport := servicemocks.NewMockIExptResultExportService(ctrl)
+	app := &experimentApplication{auth: mockAuth, regressionService: mockRegression, IExptResultExportService: mockExport}
+
+	req := &exptpb.ExportExptRegressionReportRequest{
+		WorkspaceID:      100,
+		BaseExptID:       1,
+		CandidateExptIds: []int64{2},
+		ExportType:       gptr.Of("XLSX"),
+	}
+
+	t.Run("invalid export type", func(t *testing.T) {
+		_, err := app.ExportExptRegressionReport(context.Background(), &exptpb.ExportExptRegressionReportRequest{
+			WorkspaceID:      100,
+			BaseExptID:       1,
+			CandidateExptIds: []int64{2},
+			ExportType:       gptr.Of("PDF"),
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("auth error", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(errors.New("forbidden"))
+		_, err := app.ExportExptRegressionReport(context.Background(), req)
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		report := &entity.ExptRegressionReport{BaseExptID: 1}
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+		mockRegression.EXPECT().BuildRegressionReport(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, param *entity.ExptRegressionReportParam) (*entity.ExptRegressionReport, error) {
+				assert.Equal(t, []int64{2}, param.CandidateExptIDs)
+				return report, nil
+			})
+		mockExport.EXPECT().UploadRegressionReport(gomock.Any(), report, entity.ExptResultExportFormatXLSX).Return("https://download", nil)
+		resp, err := app.ExportExptRegressionReport(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://download", resp.GetURL())
+	})
+}
+
+func TestExperimentApplication_InvalidateExptResultCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockCache := servicemocks.NewMockIExptResultCacheService(ctrl)
+	app := &experimentApplication{auth: mockAuth, resultCacheService: mockCache}
+	req := &exptpb.InvalidateExptResultCacheRequest{WorkspaceID: 100}
+
+	t.Run("auth error", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(errors.New("forbidden"))
+		_, err := app.InvalidateExptResultCache(context.Background(), req)
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+		mockCache.EXPECT().Invalidate(gomock.Any(), int64(100)).Return(nil)
+		resp, err := app.InvalidateExptResultCache(context.Background(), req)
+		assert.NoError(t, err)
+		assert.NotNil(t, resp.GetBaseResp())
+	})
+}
+
+func TestExperimentApplication_GetExptSampledAggrResult(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockSampling := servicemocks.NewMockExptSamplingService(ctrl)
+	app := &experimentApplication{auth: mockAuth, samplingService: mockSampling}
+	req := &exptpb.GetExptSampledAggrResultRequest{WorkspaceID: 100, ExptID: 1, Confidence: gptr.Of(0.9)}
+
+	t.Run("auth error", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(errors.New("forbidden"))
+		_, err := app.GetExptSampledAggrResult_(context.Background(), req)
+		assert.Error(t, err)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+		mockSampling.EXPECT().GetSampledAggrResult(gomock.Any(), int64(100), int64(1), 0.9).Return(nil, errors.New("no sampling result"))
+		_, err := app.GetExptSampledAggrResult_(context.Background(), req)
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+		mockSampling.EXPECT().GetSampledAggrResult(gomock.Any(), int64(100), int64(1), 0.9).Return(&entity.ExptSampledAggrResult{
+			ExptID:           1,
+			Confidence:       0.9,
+			Sampling:         &entity.ExptSamplingResult{Mode: entity.ExptSamplingModeRandomN, PopulationCnt: 100, SampleCnt: 10},
+			EvaluatorResults: []*entity.ExptSampledEvaluatorAggr{{EvaluatorVersionID: 11, Mean: 0.8, CILower: 0.7, CIUpper: 0.9}},
+		}, nil)
+		resp, err := app.GetExptSampledAggrResult_(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), resp.GetResult_().GetSampling().GetSampleCnt())
+		if assert.Len(t, resp.GetResult_().GetEvaluatorResults(), 1) {
+			assert.Equal(t, 0.7, resp.GetResult_().GetEvaluatorResults()[0].GetCiLower())
+		}
+	})
+}
+
+func TestExperimentApplication_GetExptGroupedAggrResult(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := rpcmocks.NewMockIAuthProvider(ctrl)
+	mockGrouped := servicemocks.NewMockExptGroupedAggrService(ctrl)
+	app := &experimentApplication{auth: mockAuth, groupedAggrService: mockGrouped}
+	req := &exptpb.GetExptGroupedAggrResultRequest{
+		WorkspaceID: 100,
+		ExptIds:     []int64{1, 2},
+		Dimension:   &expt.ExptGroupDimension{Type: gptr.Of(expt.ExptGroupDimensionType_EvalSetField), Key: gptr.Of("category")},
+		MaxGroups:   gptr.Of(int32(5)),
+	}
+
+	t.Run("auth error", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(errors.New("forbidden"))
+		_, err := app.GetExptGroupedAggrResult_(context.Background(), req)
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockAuth.EXPECT().Authorization(gomock.Any(), gomock.Any()).Return(nil)
+		mockGrouped.EXPECT().GetGroupedAggrResult(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.ExptGroupedAggrParam) (*entity.ExptGroupedAggrResult, error) {
+				assert.Equal(t, int64(100), param.SpaceID)
+				assert.Equal(t, []int64{1, 2}, param.ExptIDs)
+				assert.Equal(t, entity.ExptGroupDimensionEvalSetField, param.Dimension.Type)
+				assert.Equal(t, 5, param.MaxGroups)
+				return &entity.ExptGroupedAggrResult{
+					Dimension: param.Dimension,
+					ExptResults: []*entity.ExptGroupedAggr{
+						{ExptID: 1, Groups: []*entity.ExptAggrGroup{{
+							Value:     "math",
+							TurnCount: 3,
+							EvaluatorResults: []*entity.ExptGroupEvaluatorAggr{{
+								EvaluatorVersionID: 11,
+								Count:              3,
+								AggregatorResults:  []*entity.AggregatorResult{{AggregatorType: entity.Average, Data: &entity.AggregateData{Value: gptr.Of(0.5)}}},
+							}},
+						}}},
+						{ExptID: 2, Truncated: true},
+					},
+				}, nil
+			})
+		resp, err := app.GetExptGroupedAggrResult_(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, "category", resp.GetDimension().GetKey())
+		if assert.Len(t, resp.GetExptResults(), 2) {
+			group := resp.GetExptResults()[0].GetGroups()[0]
+			assert.Equal(t, "math", group.GetValue())
+			assert.Equal(t, int64(11), group.GetEvaluatorResults()[0].GetEvaluatorVersionID())
+			assert.Len(t, group.GetEvaluatorResults()[0].GetAggregatorResults(), 1)
+			assert.True(t, resp.GetExptResults()[1].GetTruncated())
+		}
+	})
+}
@@ -44,9 +44,12 @@ import (
 	experimentmetrics "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/metrics/experiment"
 	openapimetrics "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/metrics/openapi"
 	sandboxagentmetrics "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/metrics/sandbox_agent"
+	rmqproducer "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/mq/rocket/producer"
+	evaluatorrepo "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/evaluator"
 	experimentrepo "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment"
 	agentrpc "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/agent"
 	foundationrpc "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/foundation"
+	llmrpc "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/llm"
 	notifyrpc "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/notify"
 	tagrpc "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/tag"
 	trajectoryrpc "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/trajectory"
@@ -115,10 +118,22 @@ var (
 		domainservice.NewResourceAccessAuthorizer,
 		// Domain Service Sets
 		domainservice.EvaluationSetDomainServiceSet,
+		// 模型生成评测集数据
+		llmrpc.LLMRPCSet,
+		// 版本合入登记行来源, 来源追溯依赖实验与评估器记录
+		domainservice.NewEvaluatorRecordServiceImpl,
+		domainservice.NewEvaluatorScoreCalculator,
 		// Infrastructure Sets
 		evaluationsetmetrics.EvaluationSetMetricsSet,
 		foundationrpc.FoundationRPCSet,
 		userinfo.NewUserInfoServiceImpl,
+		experimentrepo.ExperimentRepoSet,
+		evaluatorrepo.EvaluatorRepoSet,
+		rmqproducer.MQProducerSet,
+		infrahttp.NewHTTPClient,
+		evalconf.NewConfiger,
+		flagSet,
+		storage.StorageSet,
 	)
 
 	evalTargetSet = wire.NewSet(
@@ -217,11 +232,17 @@ func InitEvaluatorApplication(
 	return nil, nil
 }
 
-func InitEvaluationSetApplication(client datasetservice.Client,
+func InitEvaluationSetApplication(ctx context.Context,
+	idgen idgen.IIDGenerator,
+	db db.Provider,
+	rmqFactory mq.IFactory,
+	client datasetservice.Client,
 	authClient authservice.Client,
 	meter metrics.Meter,
 	userClient userservice.Client,
 	configFactory conf.IConfigLoaderFactory,
+	batchObjectStorage fileserver.BatchObjectStorage,
+	llmClient llmruntimeservice.Client,
 ) (evaluation.EvaluationSetService, error) {
 	wire.Build(
 		evaluationSetSet,
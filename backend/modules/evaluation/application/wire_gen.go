@@ -106,7 +106,7 @@ func InitExperimentApplication(ctx context.Context, idgen2 idgen.IIDGenerator, d
 	iRuntimeFactory := runtime.NewRuntimeFactory(logger, sandboxConfig)
 	iRuntimeManager := runtime.NewRuntimeManagerFromFactory(iRuntimeFactory, logger)
 	codeBuilderFactory := service.NewCodeBuilderFactory()
-	v := service.NewEvaluatorSourceServices(illmProvider, evaluatorExecMetrics, iConfiger, iRuntimeManager, codeBuilderFactory)
+	v := service.NewEvaluatorSourceServices(illmProvider, evaluatorExecMetrics, iConfiger, iRuntimeManager, codeBuilderFactory, iEvaluatorRepo)
 	iPlainRateLimiter := evaluator.NewPlainRateLimiterImpl(plainLimiterFactory)
 	iEvalAsyncDAO := dao.NewEvalAsyncDAO(cmdable, componentIConfiger)
 	iEvalAsyncRepo := experiment.NewEvalAsyncRepo(iEvalAsyncDAO)
@@ -151,12 +151,15 @@ func InitExperimentApplication(ctx context.Context, idgen2 idgen.IIDGenerator, d
 	iExptTurnResultFilterKeyMappingDAO := mysql.NewExptTurnResultFilterKeyMappingDAO(db2)
 	iExptTurnResultFilterRepo := experiment.NewExptTurnResultFilterRepo(iExptTurnResultFilterDAO, iExptTurnResultFilterKeyMappingDAO)
 	iDatasetRPCAdapter := data.NewDatasetRPCAdapter(sds)
-	evaluationSetVersionService := service.NewEvaluationSetVersionServiceImpl(iDatasetRPCAdapter)
+	evaluationSetItemProvenanceDAO := mysql.NewEvaluationSetItemProvenanceDAO(db2)
+	iEvaluationSetItemProvenanceRepo := experiment.NewEvaluationSetItemProvenanceRepo(evaluationSetItemProvenanceDAO, idgen2)
+	evaluationSetVersionService := service.NewEvaluationSetVersionServiceImpl(iDatasetRPCAdapter, iEvaluationSetItemProvenanceRepo)
 	iEvaluationSetService := service.NewEvaluationSetServiceImpl(iDatasetRPCAdapter)
-	evaluationSetItemService := service.NewEvaluationSetItemServiceImpl(iDatasetRPCAdapter)
+	evaluationSetItemService := service.NewEvaluationSetItemServiceImpl(iDatasetRPCAdapter, iEvaluationSetItemProvenanceRepo)
 	iEvaluationAnalysisService := service.NewEvaluationAnalysisService()
 	iFileProvider := foundation.NewFileRPCProvider(fileClient)
-	exptResultService := service.NewExptResultService(iExptItemResultRepo, iExptTurnResultRepo, iExptAnnotateRepo, iExptStatsRepo, iExperimentRepo, exptMetric, iLatestWriteTracker, idgen2, iExptTurnResultFilterRepo, serviceEvaluatorService, iEvalTargetService, evaluationSetVersionService, iEvaluationSetService, evaluatorRecordService, evaluationSetItemService, exptEventPublisher, iTagRPCAdapter, iEvaluationAnalysisService, iFileProvider, iEvaluatorScoreCalculator)
+	iExptCostCalculator := service.NewExptCostCalculator(componentIConfiger, serviceEvaluatorService)
+	exptResultService := service.NewExptResultService(iExptItemResultRepo, iExptTurnResultRepo, iExptAnnotateRepo, iExptStatsRepo, iExperimentRepo, exptMetric, iLatestWriteTracker, idgen2, iExptTurnResultFilterRepo, serviceEvaluatorService, iEvalTargetService, evaluationSetVersionService, iEvaluationSetService, evaluatorRecordService, evaluationSetItemService, exptEventPublisher, iTagRPCAdapter, iEvaluationAnalysisService, iFileProvider, iEvaluatorScoreCalculator, iExptCostCalculator)
 	iExptItemRefDAO := mysql.NewExptItemRefDAO(db2)
 	iExptItemRefRepo := experiment.NewExptItemRefRepo(iExptItemRefDAO)
 	iQuotaDAO := dao.NewQuotaDAO(cmdable)
@@ -178,13 +181,21 @@ func InitExperimentApplication(ctx context.Context, idgen2 idgen.IIDGenerator, d
 	v3 := service.ProvideNoSandboxAgentNotifiers()
 	schedulerModeFactory := service.NewSchedulerModeFactory(iExptManager, iExptItemResultRepo, iExptStatsRepo, iExptTurnResultRepo, idgen2, evaluationSetItemService, iExperimentRepo, iExptItemRefRepo, idempotentService, componentIConfiger, exptEventPublisher, evaluatorRecordService, exptResultService, iExptTemplateManager, iExptRunLogRepo, iLocker, v3...)
 	iItemCompletePublisher := service.ProvideNilItemCompletePublisher()
-	exptSchedulerEvent := service.NewExptSchedulerSvc(iExptManager, iExperimentRepo, iExptItemResultRepo, iExptTurnResultRepo, iEvaluatorRecordRepo, iExptStatsRepo, iExptRunLogRepo, idempotentService, componentIConfiger, quotaRepo, iLocker, exptEventPublisher, auditClient, exptMetric, exptResultService, idgen2, evaluationSetItemService, schedulerModeFactory, iEvalTargetService, iItemCompletePublisher, iExptItemRefRepo, sandboxAgentMetrics, v3...)
-	exptItemEvalEvent := service.NewExptRecordEvalService(iExptManager, componentIConfiger, exptEventPublisher, iExptItemResultRepo, iExptTurnResultRepo, iExptStatsRepo, iExperimentRepo, iExptItemRefRepo, quotaRepo, iLocker, idempotentService, auditClient, exptMetric, exptResultService, iEvalTargetService, evaluationSetItemService, evaluatorRecordService, serviceEvaluatorService, idgen2, benefitSvc, iEvalAsyncRepo, iItemCompletePublisher, v3...)
+	iConcurrencyDAO := dao.NewConcurrencyDAO(cmdable)
+	iExptConcurrencyRepo := experiment.NewExptConcurrencyRepo(iConcurrencyDAO)
+	iExptBackendBudgetLimiter := experiment.NewExptBackendBudgetLimiter(plainLimiterFactory)
+	iExptConcurrencyController := service.NewExptConcurrencyController(iExptConcurrencyRepo, iExptBackendBudgetLimiter, componentIConfiger)
+	exptSchedulerEvent := service.NewExptSchedulerSvc(iExptManager, iExperimentRepo, iExptItemResultRepo, iExptTurnResultRepo, iEvaluatorRecordRepo, iExptStatsRepo, iExptRunLogRepo, idempotentService, componentIConfiger, quotaRepo, iLocker, exptEventPublisher, auditClient, exptMetric, exptResultService, idgen2, evaluationSetItemService, schedulerModeFactory, iEvalTargetService, iItemCompletePublisher, iExptItemRefRepo, sandboxAgentMetrics, iExptConcurrencyController, v3...)
+	iResultCacheDAO := dao.NewResultCacheDAO(cmdable)
+	iExptResultCacheRepo := experiment.NewExptResultCacheRepo(iResultCacheDAO)
+	iExptResultCacheService := service.NewExptResultCacheService(iExptResultCacheRepo, iEvalTargetService, evaluatorRecordService, iEvaluatorRecordRepo, idgen2)
+	exptUserSimulator := service.NewExptUserSimulator(illmProvider, serviceEvaluatorService)
+	exptItemEvalEvent := service.NewExptRecordEvalService(iExptManager, componentIConfiger, exptEventPublisher, iExptItemResultRepo, iExptTurnResultRepo, iExptStatsRepo, iExperimentRepo, iExptItemRefRepo, quotaRepo, iLocker, idempotentService, auditClient, exptMetric, exptResultService, iEvalTargetService, evaluationSetItemService, evaluatorRecordService, serviceEvaluatorService, idgen2, benefitSvc, iEvalAsyncRepo, iExptResultCacheService, iExptConcurrencyController, iItemCompletePublisher, exptUserSimulator, v3...)
 	iExptAnnotateService := service.NewExptAnnotateService(db2, iExptAnnotateRepo, iExptTurnResultRepo, exptEventPublisher, evaluationSetItemService, iExperimentRepo, exptResultService, iExptTurnResultFilterRepo, iExptAggrResultRepo)
 	exptResultExportRecordDAO := mysql.NewExptResultExportRecordDAO(db2)
 	iExptResultExportRecordRepo := experiment.NewExptResultExportRecordRepo(exptResultExportRecordDAO, idgen2)
 	iurlProcessor := service.NewDefaultURLProcessor()
-	iExptResultExportService := service.NewExptResultExportService(db2, iExptResultExportRecordRepo, iExperimentRepo, iExptTurnResultRepo, exptEventPublisher, exptResultService, objectStorage, componentIConfiger, benefitSvc, iurlProcessor, evaluationSetItemService)
+	iExptResultExportService := service.NewExptResultExportService(db2, iExptResultExportRecordRepo, iExperimentRepo, iExptTurnResultRepo, exptEventPublisher, exptResultService, objectStorage, componentIConfiger, benefitSvc, iurlProcessor, evaluationSetItemService, iExptCostCalculator)
 	iExptInsightAnalysisRecordDAO := mysql.NewExptInsightAnalysisRecordDAO(db2)
 	iExptInsightAnalysisFeedbackCommentDAO := mysql.NewExptInsightAnalysisFeedbackCommentDAO(db2)
 	iExptInsightAnalysisFeedbackVoteDAO := mysql.NewExptInsightAnalysisFeedbackVoteDAO(db2)
@@ -193,8 +204,22 @@ func InitExperimentApplication(ctx context.Context, idgen2 idgen.IIDGenerator, d
 	iExptInsightAnalysisService := service.NewInsightAnalysisService(iExptInsightAnalysisRecordRepo, exptEventPublisher, objectStorage, iAgentAdapter, iExptResultExportService, iNotifyRPCAdapter, iUserProvider, iExperimentRepo, iEvalTargetRepo)
 	noopWebhookSecretProvider := service.NewNoopWebhookSecretProvider()
 	webhookDispatcher := service.NewWebhookDispatcher(exptEventPublisher, noopWebhookSecretProvider, iExptStatsRepo)
-	exptLifecycleEventHandler := service.NewExptLifecycleEventHandler(iExperimentRepo, iNotifyRPCAdapter, iUserProvider, webhookDispatcher)
-	iExperimentApplication := NewExperimentApplication(exptAggrResultService, exptResultService, iExptManager, exptSchedulerEvent, exptItemEvalEvent, idgen2, componentIConfiger, iAuthProvider, userInfoService, iEvalTargetService, evaluationSetItemService, iExptAnnotateService, iTagRPCAdapter, iExptResultExportService, iExptInsightAnalysisService, serviceEvaluatorService, iExptTemplateManager, iFileProvider, exptLifecycleEventHandler, sandboxSchedulerAdapter, sandboxAgentMetrics)
+	exptRegressionService := service.NewExptRegressionService(iExperimentRepo, iExptTurnResultRepo, exptResultService, evaluatorRecordService, iEvalTargetService, iEvaluatorScoreCalculator)
+	exptQualityGateService := service.NewExptQualityGateService(iExperimentRepo, iExptStatsRepo, iExptTurnResultRepo, evaluatorRecordService, iEvaluatorScoreCalculator, exptRegressionService)
+	exptLifecycleEventHandler := service.NewExptLifecycleEventHandler(iExperimentRepo, iNotifyRPCAdapter, iUserProvider, webhookDispatcher, exptQualityGateService, iExptRunLogRepo)
+	exptPairwiseRankRecordDAO := mysql.NewExptPairwiseRankRecordDAO(db2)
+	iExptPairwiseRankRecordRepo := experiment.NewExptPairwiseRankRecordRepo(exptPairwiseRankRecordDAO)
+	exptPairwiseRankService := service.NewExptPairwiseRankService(idgen2, iExperimentRepo, iExptTurnResultRepo, iExptPairwiseRankRecordRepo, iEvalTargetService, serviceEvaluatorService, exptEventPublisher)
+	exptSignificanceService := service.NewExptSignificanceService(iExperimentRepo, iExptTurnResultRepo, evaluatorRecordService)
+	evaluatorCalibrationRecordDAO := mysql2.NewEvaluatorCalibrationRecordDAO(db2)
+	iEvaluatorCalibrationRepo := evaluator.NewEvaluatorCalibrationRepo(evaluatorCalibrationRecordDAO)
+	evaluatorCalibrationService := service.NewEvaluatorCalibrationService(idgen2, serviceEvaluatorService, evaluationSetItemService, iEvaluatorCalibrationRepo, exptEventPublisher)
+	exptSamplingService := service.NewExptSamplingService(iExperimentRepo, iExptItemResultRepo, iExptTurnResultRepo, evaluatorRecordService)
+	exptGroupedAggrService := service.NewExptGroupedAggrService(iExperimentRepo, iExptTurnResultFilterRepo, iEvaluationSetService)
+	evaluationSetDedupJobDAO := mysql.NewEvaluationSetDedupJobDAO(db2)
+	iEvaluationSetDedupJobRepo := experiment.NewEvaluationSetDedupJobRepo(evaluationSetDedupJobDAO)
+	evaluationSetDedupService := service.NewEvaluationSetDedupService(idgen2, evaluationSetItemService, iEvaluationSetDedupJobRepo, exptEventPublisher)
+	iExperimentApplication := NewExperimentApplication(exptAggrResultService, exptResultService, iExptManager, exptSchedulerEvent, exptItemEvalEvent, idgen2, componentIConfiger, iAuthProvider, userInfoService, iEvalTargetService, evaluationSetItemService, iExptAnnotateService, iTagRPCAdapter, iExptResultExportService, iExptInsightAnalysisService, serviceEvaluatorService, iExptTemplateManager, iFileProvider, exptLifecycleEventHandler, sandboxSchedulerAdapter, sandboxAgentMetrics, exptPairwiseRankService, exptSignificanceService, evaluatorCalibrationService, exptRegressionService, iExptResultCacheService, exptSamplingService, exptGroupedAggrService, evaluationSetDedupService)
 	return iExperimentApplication, nil
 }
 
@@ -224,7 +249,7 @@ func InitEvaluatorApplication(ctx context.Context, idgen2 idgen.IIDGenerator, au
 	iRuntimeFactory := runtime.NewRuntimeFactory(logger, sandboxConfig)
 	iRuntimeManager := runtime.NewRuntimeManagerFromFactory(iRuntimeFactory, logger)
 	codeBuilderFactory := service.NewCodeBuilderFactory()
-	v := service.NewEvaluatorSourceServices(illmProvider, evaluatorExecMetrics, iConfiger, iRuntimeManager, codeBuilderFactory)
+	v := service.NewEvaluatorSourceServices(illmProvider, evaluatorExecMetrics, iConfiger, iRuntimeManager, codeBuilderFactory, iEvaluatorRepo)
 	iPlainRateLimiter := evaluator.NewPlainRateLimiterImpl(plainLimiterFactory)
 	iEvalAsyncDAO := dao.NewEvalAsyncDAO(cmdable, componentIConfiger)
 	iEvalAsyncRepo := experiment.NewEvalAsyncRepo(iEvalAsyncDAO)
@@ -277,32 +302,76 @@ func InitEvaluatorApplication(ctx context.Context, idgen2 idgen.IIDGenerator, au
 	iExptRunLogRepo := experiment.NewExptRunLogRepo(iExptRunLogDAO)
 	iEvalTargetService := service.NewEvalTargetServiceImpl(iEvalTargetRepo, idgen2, evalTargetMetrics, v2, iTrajectoryAdapter, componentIConfiger, sandboxSchedulerAdapter, iExptRunLogRepo)
 	iDatasetRPCAdapter := data.NewDatasetRPCAdapter(dataClient)
-	evaluationSetVersionService := service.NewEvaluationSetVersionServiceImpl(iDatasetRPCAdapter)
+	evaluationSetItemProvenanceDAO := mysql.NewEvaluationSetItemProvenanceDAO(db2)
+	iEvaluationSetItemProvenanceRepo := experiment.NewEvaluationSetItemProvenanceRepo(evaluationSetItemProvenanceDAO, idgen2)
+	evaluationSetVersionService := service.NewEvaluationSetVersionServiceImpl(iDatasetRPCAdapter, iEvaluationSetItemProvenanceRepo)
 	iEvaluationSetService := service.NewEvaluationSetServiceImpl(iDatasetRPCAdapter)
-	evaluationSetItemService := service.NewEvaluationSetItemServiceImpl(iDatasetRPCAdapter)
+	evaluationSetItemService := service.NewEvaluationSetItemServiceImpl(iDatasetRPCAdapter, iEvaluationSetItemProvenanceRepo)
 	iTagRPCAdapter := tag.NewTagRPCProvider(tagClient)
 	iEvaluationAnalysisService := service.NewEvaluationAnalysisService()
-	exptResultService := service.NewExptResultService(iExptItemResultRepo, iExptTurnResultRepo, iExptAnnotateRepo, iExptStatsRepo, iExperimentRepo, exptMetric, iLatestWriteTracker, idgen2, iExptTurnResultFilterRepo, evaluatorService, iEvalTargetService, evaluationSetVersionService, iEvaluationSetService, evaluatorRecordService, evaluationSetItemService, exptEventPublisher, iTagRPCAdapter, iEvaluationAnalysisService, iFileProvider, iEvaluatorScoreCalculator)
-	evaluationEvaluatorService := NewEvaluatorHandlerImpl(idgen2, iConfiger, iAuthProvider, evaluatorService, evaluatorRecordService, evaluatorTemplateService, evaluatorExecMetrics, userInfoService, auditClient, benefitSvc, iFileProvider, v, exptResultService, iEvalAsyncRepo)
+	iExptCostCalculator := service.NewExptCostCalculator(componentIConfiger, evaluatorService)
+	exptResultService := service.NewExptResultService(iExptItemResultRepo, iExptTurnResultRepo, iExptAnnotateRepo, iExptStatsRepo, iExperimentRepo, exptMetric, iLatestWriteTracker, idgen2, iExptTurnResultFilterRepo, evaluatorService, iEvalTargetService, evaluationSetVersionService, iEvaluationSetService, evaluatorRecordService, evaluationSetItemService, exptEventPublisher, iTagRPCAdapter, iEvaluationAnalysisService, iFileProvider, iEvaluatorScoreCalculator, iExptCostCalculator)
+	evaluatorCalibrationRecordDAO := mysql2.NewEvaluatorCalibrationRecordDAO(db2)
+	iEvaluatorCalibrationRepo := evaluator.NewEvaluatorCalibrationRepo(evaluatorCalibrationRecordDAO)
+	evaluatorCalibrationService := service.NewEvaluatorCalibrationService(idgen2, evaluatorService, evaluationSetItemService, iEvaluatorCalibrationRepo, exptEventPublisher)
+	evaluatorPromotionPolicyDAO := mysql2.NewEvaluatorPromotionPolicyDAO(db2)
+	iEvaluatorPromotionPolicyRepo := evaluator.NewEvaluatorPromotionPolicyRepo(evaluatorPromotionPolicyDAO)
+	evaluatorPromotionService := service.NewEvaluatorPromotionService(idgen2, iEvaluatorRepo, iEvaluatorRecordRepo, iEvaluatorPromotionPolicyRepo, v)
+	evaluationEvaluatorService := NewEvaluatorHandlerImpl(idgen2, iConfiger, iAuthProvider, evaluatorService, evaluatorRecordService, evaluatorTemplateService, evaluatorExecMetrics, userInfoService, auditClient, benefitSvc, iFileProvider, v, exptResultService, iEvalAsyncRepo, evaluatorCalibrationService, evaluatorPromotionService)
 	return evaluationEvaluatorService, nil
 }
 
-func InitEvaluationSetApplication(client datasetservice.Client, authClient authservice.Client, meter metrics.Meter, userClient userservice.Client, configFactory conf.IConfigLoaderFactory) (evaluation.EvaluationSetService, error) {
+func InitEvaluationSetApplication(ctx context.Context, idgen2 idgen.IIDGenerator, db2 db.Provider, rmqFactory mq.IFactory, client datasetservice.Client, authClient authservice.Client, meter metrics.Meter, userClient userservice.Client, configFactory conf.IConfigLoaderFactory, batchObjectStorage fileserver.BatchObjectStorage, llmClient llmruntimeservice.Client) (evaluation.EvaluationSetService, error) {
 	iAuthProvider := foundation.NewAuthRPCProvider(authClient)
 	iDatasetRPCAdapter := data.NewDatasetRPCAdapter(client)
 	iEvaluationSetService := service.NewEvaluationSetServiceImpl(iDatasetRPCAdapter)
 	evaluationSetSchemaService := service.NewEvaluationSetSchemaServiceImpl(iDatasetRPCAdapter)
-	evaluationSetVersionService := service.NewEvaluationSetVersionServiceImpl(iDatasetRPCAdapter)
-	evaluationSetItemService := service.NewEvaluationSetItemServiceImpl(iDatasetRPCAdapter)
-	evaluationSetMetrics := metrics4.NewEvaluationSetMetrics(meter)
+	evaluationSetItemProvenanceDAO := mysql.NewEvaluationSetItemProvenanceDAO(db2)
+	iEvaluationSetItemProvenanceRepo := experiment.NewEvaluationSetItemProvenanceRepo(evaluationSetItemProvenanceDAO, idgen2)
+	evaluationSetVersionService := service.NewEvaluationSetVersionServiceImpl(iDatasetRPCAdapter, iEvaluationSetItemProvenanceRepo)
+	evaluationSetItemService := service.NewEvaluationSetItemServiceImpl(iDatasetRPCAdapter, iEvaluationSetItemProvenanceRepo)
+	iExptDAO := mysql.NewExptDAO(db2)
+	iExptEvaluatorRefDAO := mysql.NewExptEvaluatorRefDAO(db2)
+	iExperimentRepo := experiment.NewExptRepo(iExptDAO, iExptEvaluatorRefDAO, idgen2)
+	iExptItemResultDAO := mysql.NewExptItemResultDAO(db2)
+	iExptItemResultRepo := experiment.NewExptItemResultRepo(iExptItemResultDAO)
+	exptTurnResultDAO := mysql.NewExptTurnResultDAO(db2)
+	iExptTurnEvaluatorResultRefDAO := mysql.NewExptTurnEvaluatorResultRefDAO(db2)
+	iExptTurnResultRepo := experiment.NewExptTurnResultRepo(idgen2, exptTurnResultDAO, iExptTurnEvaluatorResultRefDAO)
+	evaluatorRecordDAO := mysql2.NewEvaluatorRecordDAO(db2)
+	iConfiger, err := conf2.NewConfiger(configFactory)
+	if err != nil {
+		return nil, err
+	}
+	recordDataStorage := storage.NewRecordDataStorage(batchObjectStorage, iConfiger)
+	iEvaluatorRecordRepo := evaluator.NewEvaluatorRecordRepo(idgen2, db2, evaluatorRecordDAO, recordDataStorage)
+	exptEventPublisher, err := producer.NewExptEventPublisher(ctx, configFactory, rmqFactory)
+	if err != nil {
+		return nil, err
+	}
+	evaluatorEventPublisher, err := producer.NewEvaluatorEventPublisher(ctx, configFactory, rmqFactory)
+	if err != nil {
+		return nil, err
+	}
 	iUserProvider := foundation.NewUserRPCProvider(userClient)
 	userInfoService := userinfo.NewUserInfoServiceImpl(iUserProvider)
+	iClient := http.NewHTTPClient()
+	iEvaluatorScoreCalculator := service.NewEvaluatorScoreCalculator(iConfiger, iClient)
+	evaluatorRecordService := service.NewEvaluatorRecordServiceImpl(idgen2, iEvaluatorRecordRepo, exptEventPublisher, evaluatorEventPublisher, userInfoService, iExperimentRepo, iExptTurnResultRepo, iEvaluatorScoreCalculator)
+	evaluationSetItemProvenanceService := service.NewEvaluationSetItemProvenanceService(iEvaluationSetItemProvenanceRepo, evaluationSetItemService, iExperimentRepo, iExptItemResultRepo, iExptTurnResultRepo, evaluatorRecordService, iDatasetRPCAdapter)
+	evaluationSetVersionDiffService := service.NewEvaluationSetVersionDiffService(iEvaluationSetService, evaluationSetVersionService, evaluationSetItemService, evaluationSetSchemaService, evaluationSetItemProvenanceService)
+	evaluationSetDedupJobDAO := mysql.NewEvaluationSetDedupJobDAO(db2)
+	iEvaluationSetDedupJobRepo := experiment.NewEvaluationSetDedupJobRepo(evaluationSetDedupJobDAO)
+	evaluationSetDedupService := service.NewEvaluationSetDedupService(idgen2, evaluationSetItemService, iEvaluationSetDedupJobRepo, exptEventPublisher)
+	illmProvider := llm.NewLLMRPCProvider(llmClient)
+	evaluationSetSynthesisService := service.NewEvaluationSetSynthesisService(illmProvider, iEvaluationSetService, evaluationSetItemService, evaluationSetItemProvenanceService)
+	evaluationSetMetrics := metrics4.NewEvaluationSetMetrics(meter)
 	sharedResourceConfigProvider, err := conf2.NewSharedResourceConfigProvider(configFactory)
 	if err != nil {
 		return nil, err
 	}
 	resourceAccessAuthorizer := service.NewResourceAccessAuthorizer(iAuthProvider, sharedResourceConfigProvider)
-	evaluationSetService := NewEvaluationSetApplicationImpl(iAuthProvider, iEvaluationSetService, evaluationSetSchemaService, evaluationSetVersionService, evaluationSetItemService, evaluationSetMetrics, userInfoService, resourceAccessAuthorizer)
+	evaluationSetService := NewEvaluationSetApplicationImpl(iAuthProvider, iEvaluationSetService, evaluationSetSchemaService, evaluationSetVersionService, evaluationSetItemService, evaluationSetVersionDiffService, evaluationSetDedupService, evaluationSetSynthesisService, evaluationSetItemProvenanceService, evaluationSetMetrics, userInfoService, resourceAccessAuthorizer)
 	return evaluationSetService, nil
 }
 
@@ -365,8 +434,10 @@ func InitEvalOpenAPIApplication(ctx context.Context, configFactory conf.IConfigL
 	iAuthProvider := foundation.NewAuthRPCProvider(authClient)
 	iDatasetRPCAdapter := data.NewDatasetRPCAdapter(dataClient)
 	iEvaluationSetService := service.NewEvaluationSetServiceImpl(iDatasetRPCAdapter)
-	evaluationSetVersionService := service.NewEvaluationSetVersionServiceImpl(iDatasetRPCAdapter)
-	evaluationSetItemService := service.NewEvaluationSetItemServiceImpl(iDatasetRPCAdapter)
+	evaluationSetItemProvenanceDAO := mysql.NewEvaluationSetItemProvenanceDAO(db2)
+	iEvaluationSetItemProvenanceRepo := experiment.NewEvaluationSetItemProvenanceRepo(evaluationSetItemProvenanceDAO, idgen2)
+	evaluationSetVersionService := service.NewEvaluationSetVersionServiceImpl(iDatasetRPCAdapter, iEvaluationSetItemProvenanceRepo)
+	evaluationSetItemService := service.NewEvaluationSetItemServiceImpl(iDatasetRPCAdapter, iEvaluationSetItemProvenanceRepo)
 	evaluationSetSchemaService := service.NewEvaluationSetSchemaServiceImpl(iDatasetRPCAdapter)
 	openAPIEvaluationMetrics := openapi.NewEvaluationOApiMetrics(meter)
 	iUserProvider := foundation.NewUserRPCProvider(userClient)
@@ -398,7 +469,7 @@ func InitEvalOpenAPIApplication(ctx context.Context, configFactory conf.IConfigL
 	iRuntimeFactory := runtime.NewRuntimeFactory(logger, sandboxConfig)
 	iRuntimeManager := runtime.NewRuntimeManagerFromFactory(iRuntimeFactory, logger)
 	codeBuilderFactory := service.NewCodeBuilderFactory()
-	v2 := service.NewEvaluatorSourceServices(illmProvider, evaluatorExecMetrics, confIConfiger, iRuntimeManager, codeBuilderFactory)
+	v2 := service.NewEvaluatorSourceServices(illmProvider, evaluatorExecMetrics, confIConfiger, iRuntimeManager, codeBuilderFactory, iEvaluatorRepo)
 	iPlainRateLimiter := evaluator.NewPlainRateLimiterImpl(plainLimiterFactory)
 	evaluatorService := service.NewEvaluatorServiceImpl(idgen2, rateLimiter, rmqFactory, iEvaluatorRepo, iEvaluatorRecordRepo, idempotentService, confIConfiger, v2, iPlainRateLimiter, iConfiger, iEvalAsyncRepo, exptEventPublisher)
 	evaluatorEventPublisher, err := producer.NewEvaluatorEventPublisher(ctx, configFactory, rmqFactory)
@@ -424,7 +495,8 @@ func InitEvalOpenAPIApplication(ctx context.Context, configFactory conf.IConfigL
 	iExptTurnResultFilterRepo := experiment.NewExptTurnResultFilterRepo(iExptTurnResultFilterDAO, iExptTurnResultFilterKeyMappingDAO)
 	iEvaluationAnalysisService := service.NewEvaluationAnalysisService()
 	iFileProvider := foundation.NewFileRPCProvider(fileClient)
-	exptResultService := service.NewExptResultService(iExptItemResultRepo, iExptTurnResultRepo, iExptAnnotateRepo, iExptStatsRepo, iExperimentRepo, exptMetric, iLatestWriteTracker, idgen2, iExptTurnResultFilterRepo, evaluatorService, iEvalTargetService, evaluationSetVersionService, iEvaluationSetService, evaluatorRecordService, evaluationSetItemService, exptEventPublisher, iTagRPCAdapter, iEvaluationAnalysisService, iFileProvider, iEvaluatorScoreCalculator)
+	iExptCostCalculator := service.NewExptCostCalculator(iConfiger, evaluatorService)
+	exptResultService := service.NewExptResultService(iExptItemResultRepo, iExptTurnResultRepo, iExptAnnotateRepo, iExptStatsRepo, iExperimentRepo, exptMetric, iLatestWriteTracker, idgen2, iExptTurnResultFilterRepo, evaluatorService, iEvalTargetService, evaluationSetVersionService, iEvaluationSetService, evaluatorRecordService, evaluationSetItemService, exptEventPublisher, iTagRPCAdapter, iEvaluationAnalysisService, iFileProvider, iEvaluatorScoreCalculator, iExptCostCalculator)
 	iExptItemRefDAO := mysql.NewExptItemRefDAO(db2)
 	iExptItemRefRepo := experiment.NewExptItemRefRepo(iExptItemRefDAO)
 	iQuotaDAO := dao.NewQuotaDAO(cmdable)
@@ -445,13 +517,21 @@ func InitEvalOpenAPIApplication(ctx context.Context, configFactory conf.IConfigL
 	v3 := service.ProvideNoSandboxAgentNotifiers()
 	schedulerModeFactory := service.NewSchedulerModeFactory(iExptManager, iExptItemResultRepo, iExptStatsRepo, iExptTurnResultRepo, idgen2, evaluationSetItemService, iExperimentRepo, iExptItemRefRepo, idempotentService, iConfiger, exptEventPublisher, evaluatorRecordService, exptResultService, iExptTemplateManager, iExptRunLogRepo, iLocker, v3...)
 	iItemCompletePublisher := service.ProvideNilItemCompletePublisher()
-	exptSchedulerEvent := service.NewExptSchedulerSvc(iExptManager, iExperimentRepo, iExptItemResultRepo, iExptTurnResultRepo, iEvaluatorRecordRepo, iExptStatsRepo, iExptRunLogRepo, idempotentService, iConfiger, quotaRepo, iLocker, exptEventPublisher, auditClient, exptMetric, exptResultService, idgen2, evaluationSetItemService, schedulerModeFactory, iEvalTargetService, iItemCompletePublisher, iExptItemRefRepo, sandboxAgentMetrics, v3...)
-	exptItemEvalEvent := service.NewExptRecordEvalService(iExptManager, iConfiger, exptEventPublisher, iExptItemResultRepo, iExptTurnResultRepo, iExptStatsRepo, iExperimentRepo, iExptItemRefRepo, quotaRepo, iLocker, idempotentService, auditClient, exptMetric, exptResultService, iEvalTargetService, evaluationSetItemService, evaluatorRecordService, evaluatorService, idgen2, benefitService, iEvalAsyncRepo, iItemCompletePublisher, v3...)
+	iConcurrencyDAO := dao.NewConcurrencyDAO(cmdable)
+	iExptConcurrencyRepo := experiment.NewExptConcurrencyRepo(iConcurrencyDAO)
+	iExptBackendBudgetLimiter := experiment.NewExptBackendBudgetLimiter(plainLimiterFactory)
+	iExptConcurrencyController := service.NewExptConcurrencyController(iExptConcurrencyRepo, iExptBackendBudgetLimiter, iConfiger)
+	exptSchedulerEvent := service.NewExptSchedulerSvc(iExptManager, iExperimentRepo, iExptItemResultRepo, iExptTurnResultRepo, iEvaluatorRecordRepo, iExptStatsRepo, iExptRunLogRepo, idempotentService, iConfiger, quotaRepo, iLocker, exptEventPublisher, auditClient, exptMetric, exptResultService, idgen2, evaluationSetItemService, schedulerModeFactory, iEvalTargetService, iItemCompletePublisher, iExptItemRefRepo, sandboxAgentMetrics, iExptConcurrencyController, v3...)
+	iResultCacheDAO := dao.NewResultCacheDAO(cmdable)
+	iExptResultCacheRepo := experiment.NewExptResultCacheRepo(iResultCacheDAO)
+	iExptResultCacheService := service.NewExptResultCacheService(iExptResultCacheRepo, iEvalTargetService, evaluatorRecordService, iEvaluatorRecordRepo, idgen2)
+	exptUserSimulator := service.NewExptUserSimulator(illmProvider, evaluatorService)
+	exptItemEvalEvent := service.NewExptRecordEvalService(iExptManager, iConfiger, exptEventPublisher, iExptItemResultRepo, iExptTurnResultRepo, iExptStatsRepo, iExperimentRepo, iExptItemRefRepo, quotaRepo, iLocker, idempotentService, auditClient, exptMetric, exptResultService, iEvalTargetService, evaluationSetItemService, evaluatorRecordService, evaluatorService, idgen2, benefitService, iEvalAsyncRepo, iExptResultCacheService, iExptConcurrencyController, iItemCompletePublisher, exptUserSimulator, v3...)
 	iExptAnnotateService := service.NewExptAnnotateService(db2, iExptAnnotateRepo, iExptTurnResultRepo, exptEventPublisher, evaluationSetItemService, iExperimentRepo, exptResultService, iExptTurnResultFilterRepo, iExptAggrResultRepo)
 	exptResultExportRecordDAO := mysql.NewExptResultExportRecordDAO(db2)
 	iExptResultExportRecordRepo := experiment.NewExptResultExportRecordRepo(exptResultExportRecordDAO, idgen2)
 	iurlProcessor := service.NewDefaultURLProcessor()
-	iExptResultExportService := service.NewExptResultExportService(db2, iExptResultExportRecordRepo, iExperimentRepo, iExptTurnResultRepo, exptEventPublisher, exptResultService, objectStorage, iConfiger, benefitService, iurlProcessor, evaluationSetItemService)
+	iExptResultExportService := service.NewExptResultExportService(db2, iExptResultExportRecordRepo, iExperimentRepo, iExptTurnResultRepo, exptEventPublisher, exptResultService, objectStorage, iConfiger, benefitService, iurlProcessor, evaluationSetItemService, iExptCostCalculator)
 	iExptInsightAnalysisRecordDAO := mysql.NewExptInsightAnalysisRecordDAO(db2)
 	iExptInsightAnalysisFeedbackCommentDAO := mysql.NewExptInsightAnalysisFeedbackCommentDAO(db2)
 	iExptInsightAnalysisFeedbackVoteDAO := mysql.NewExptInsightAnalysisFeedbackVoteDAO(db2)
@@ -460,10 +540,28 @@ func InitEvalOpenAPIApplication(ctx context.Context, configFactory conf.IConfigL
 	iExptInsightAnalysisService := service.NewInsightAnalysisService(iExptInsightAnalysisRecordRepo, exptEventPublisher, objectStorage, iAgentAdapter, iExptResultExportService, iNotifyRPCAdapter, iUserProvider, iExperimentRepo, iEvalTargetRepo)
 	noopWebhookSecretProvider := service.NewNoopWebhookSecretProvider()
 	webhookDispatcher := service.NewWebhookDispatcher(exptEventPublisher, noopWebhookSecretProvider, iExptStatsRepo)
-	exptLifecycleEventHandler := service.NewExptLifecycleEventHandler(iExperimentRepo, iNotifyRPCAdapter, iUserProvider, webhookDispatcher)
-	iExperimentApplication := NewExperimentApplication(exptAggrResultService, exptResultService, iExptManager, exptSchedulerEvent, exptItemEvalEvent, idgen2, iConfiger, iAuthProvider, userInfoService, iEvalTargetService, evaluationSetItemService, iExptAnnotateService, iTagRPCAdapter, iExptResultExportService, iExptInsightAnalysisService, evaluatorService, iExptTemplateManager, iFileProvider, exptLifecycleEventHandler, sandboxSchedulerAdapter, sandboxAgentMetrics)
+	exptRegressionService := service.NewExptRegressionService(iExperimentRepo, iExptTurnResultRepo, exptResultService, evaluatorRecordService, iEvalTargetService, iEvaluatorScoreCalculator)
+	exptQualityGateService := service.NewExptQualityGateService(iExperimentRepo, iExptStatsRepo, iExptTurnResultRepo, evaluatorRecordService, iEvaluatorScoreCalculator, exptRegressionService)
+	exptLifecycleEventHandler := service.NewExptLifecycleEventHandler(iExperimentRepo, iNotifyRPCAdapter, iUserProvider, webhookDispatcher, exptQualityGateService, iExptRunLogRepo)
+	exptPairwiseRankRecordDAO := mysql.NewExptPairwiseRankRecordDAO(db2)
+	iExptPairwiseRankRecordRepo := experiment.NewExptPairwiseRankRecordRepo(exptPairwiseRankRecordDAO)
+	exptPairwiseRankService := service.NewExptPairwiseRankService(idgen2, iExperimentRepo, iExptTurnResultRepo, iExptPairwiseRankRecordRepo, iEvalTargetService, evaluatorService, exptEventPublisher)
+	exptSignificanceService := service.NewExptSignificanceService(iExperimentRepo, iExptTurnResultRepo, evaluatorRecordService)
+	evaluatorCalibrationRecordDAO := mysql2.NewEvaluatorCalibrationRecordDAO(db2)
+	iEvaluatorCalibrationRepo := evaluator.NewEvaluatorCalibrationRepo(evaluatorCalibrationRecordDAO)
+	evaluatorCalibrationService := service.NewEvaluatorCalibrationService(idgen2, evaluatorService, evaluationSetItemService, iEvaluatorCalibrationRepo, exptEventPublisher)
+	exptSamplingService := service.NewExptSamplingService(iExperimentRepo, iExptItemResultRepo, iExptTurnResultRepo, evaluatorRecordService)
+	exptGroupedAggrService := service.NewExptGroupedAggrService(iExperimentRepo, iExptTurnResultFilterRepo, iEvaluationSetService)
+	evaluationSetDedupJobDAO := mysql.NewEvaluationSetDedupJobDAO(db2)
+	iEvaluationSetDedupJobRepo := experiment.NewEvaluationSetDedupJobRepo(evaluationSetDedupJobDAO)
+	evaluationSetDedupService := service.NewEvaluationSetDedupService(idgen2, evaluationSetItemService, iEvaluationSetDedupJobRepo, exptEventPublisher)
+	iExperimentApplication := NewExperimentApplication(exptAggrResultService, exptResultService, iExptManager, exptSchedulerEvent, exptItemEvalEvent, idgen2, iConfiger, iAuthProvider, userInfoService, iEvalTargetService, evaluationSetItemService, iExptAnnotateService, iTagRPCAdapter, iExptResultExportService, iExptInsightAnalysisService, evaluatorService, iExptTemplateManager, iFileProvider, exptLifecycleEventHandler, sandboxSchedulerAdapter, sandboxAgentMetrics, exptPairwiseRankService, exptSignificanceService, evaluatorCalibrationService, exptRegressionService, iExptResultCacheService, exptSamplingService, exptGroupedAggrService, evaluationSetDedupService)
 	evaluatorCallbackDispatcher := service.NewEvaluatorCallbackDispatcher(noopWebhookSecretProvider)
-	v4 := NewEvalOpenAPIApplication(iEvalAsyncRepo, exptEventPublisher, iEvalTargetService, iEvalTargetRepo, iAuthProvider, iEvaluationSetService, evaluationSetVersionService, evaluationSetItemService, evaluationSetSchemaService, openAPIEvaluationMetrics, sandboxAgentMetrics, userInfoService, iExperimentApplication, iExptManager, exptResultService, exptAggrResultService, evaluatorService, evaluatorRecordService, iExptTemplateManager, iConfiger, sandboxSchedulerAdapter, iFileProvider, evaluatorCallbackDispatcher, resourceAccessAuthorizer)
+	evaluatorPromotionPolicyDAO := mysql2.NewEvaluatorPromotionPolicyDAO(db2)
+	iEvaluatorPromotionPolicyRepo := evaluator.NewEvaluatorPromotionPolicyRepo(evaluatorPromotionPolicyDAO)
+	evaluatorPromotionService := service.NewEvaluatorPromotionService(idgen2, iEvaluatorRepo, iEvaluatorRecordRepo, iEvaluatorPromotionPolicyRepo, v2)
+	evaluationSetItemProvenanceService := service.NewEvaluationSetItemProvenanceService(iEvaluationSetItemProvenanceRepo, evaluationSetItemService, iExperimentRepo, iExptItemResultRepo, iExptTurnResultRepo, evaluatorRecordService, iDatasetRPCAdapter)
+	v4 := NewEvalOpenAPIApplication(iEvalAsyncRepo, exptEventPublisher, iEvalTargetService, iEvalTargetRepo, iAuthProvider, iEvaluationSetService, evaluationSetVersionService, evaluationSetItemService, evaluationSetSchemaService, openAPIEvaluationMetrics, sandboxAgentMetrics, userInfoService, iExperimentApplication, iExptManager, exptResultService, exptAggrResultService, evaluatorService, evaluatorRecordService, iExptTemplateManager, iConfiger, sandboxSchedulerAdapter, iFileProvider, evaluatorCallbackDispatcher, resourceAccessAuthorizer, evaluatorPromotionService, evaluationSetItemProvenanceService)
 	return v4, nil
 }
 
@@ -482,7 +580,7 @@ var (
 	)
 
 	evaluationSetSet = wire.NewSet(
-		NewEvaluationSetApplicationImpl, conf2.NewSharedResourceConfigProvider, service.NewResourceAccessAuthorizer, service.EvaluationSetDomainServiceSet, metrics4.EvaluationSetMetricsSet, foundation.FoundationRPCSet, userinfo.NewUserInfoServiceImpl,
+		NewEvaluationSetApplicationImpl, conf2.NewSharedResourceConfigProvider, service.NewResourceAccessAuthorizer, service.EvaluationSetDomainServiceSet, llm.LLMRPCSet, service.NewEvaluatorRecordServiceImpl, service.NewEvaluatorScoreCalculator, metrics4.EvaluationSetMetricsSet, foundation.FoundationRPCSet, userinfo.NewUserInfoServiceImpl, experiment.ExperimentRepoSet, evaluator.EvaluatorRepoSet, producer.MQProducerSet, http.NewHTTPClient, conf2.NewConfiger, flagSet, storage.StorageSet,
 	)
 
 	evalTargetSet = wire.NewSet(
@@ -44,6 +44,8 @@ type IConfiger interface {
 	// GetSandboxAgentNotifyConf 沙箱 agent 通知配置（进度卡间隔等）。返回 nil 表示读取失败，
 	// 上层应回落到 entity.DefaultSandboxAgentNotifyConf。
 	GetSandboxAgentNotifyConf(ctx context.Context) *entity.SandboxAgentNotifyConf
+	// GetModelPriceConf 模型价目表, 用于核算实验花费。未配置时返回空价目表 (不计费)。
+	GetModelPriceConf(ctx context.Context) *entity.ModelPriceConf
 	// BuildEvalExt 构造评测记录（EvaluatorRecord/EvalTargetRecord/ExptTurnResultRunLog）落库时的 ext 扩展字段。
 	// turn 为评测集中的轮次数据（部分调用点不可用时为 nil），spaceID 为空间 id。默认空实现返回 nil。
 	BuildEvalExt(ctx context.Context, spaceID int64, turn *entity.Turn) map[string]string
@@ -240,6 +240,20 @@ func (mr *MockIConfigerMockRecorder) GetMaintainerUserIDs(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintainerUserIDs", reflect.TypeOf((*MockIConfiger)(nil).GetMaintainerUserIDs), ctx)
 }
 
+// GetModelPriceConf mocks base method.
+func (m *MockIConfiger) GetModelPriceConf(ctx context.Context) *entity.ModelPriceConf {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelPriceConf", ctx)
+	ret0, _ := ret[0].(*entity.ModelPriceConf)
+	return ret0
+}
+
+// GetModelPriceConf indicates an expected call of GetModelPriceConf.
+func (mr *MockIConfigerMockRecorder) GetModelPriceConf(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelPriceConf", reflect.TypeOf((*MockIConfiger)(nil).GetModelPriceConf), ctx)
+}
+
 // GetSandboxAgentNotifyConf mocks base method.
 func (m *MockIConfiger) GetSandboxAgentNotifyConf(ctx context.Context) *entity.SandboxAgentNotifyConf {
 	m.ctrl.T.Helper()
@@ -15,6 +15,8 @@ type IDatasetRPCAdapter interface {
 	CreateDatasetWithImport(ctx context.Context, param *CreateDatasetWithImportParam) (id, jobID int64, err error)
 	ImportDataset(ctx context.Context, param *ImportDatasetParam) (jobID int64, err error)
 	GetDatasetIOJob(ctx context.Context, spaceID, jobID int64) (job *entity.DatasetIOJob, err error)
+	// ListDatasetIOJobs 列出数据集的导入导出任务, types 为空时不按类型过滤
+	ListDatasetIOJobs(ctx context.Context, spaceID, datasetID int64, types []entity.JobType) (jobs []*entity.DatasetIOJob, err error)
 	ParseImportSourceFile(ctx context.Context, param *entity.ParseImportSourceFileParam) (*entity.ParseImportSourceFileResult, error)
 	ValidateMultiPartData(ctx context.Context, spaceID int64, previewData []string, storeOption *entity.MultiModalStoreOption) ([]*entity.UploadAttachmentDetail, error)
 	UpdateDataset(ctx context.Context, spaceID, evaluationSetID int64, name, desc *string, tags []*entity.ResourceTagRef) (err error)
@@ -34,6 +36,7 @@ type IDatasetRPCAdapter interface {
 	BatchUpdateDatasetItems(ctx context.Context, param *BatchUpdateDatasetItemsParam) (errorGroup []*entity.ItemErrorGroup, itemOutputs []*entity.DatasetItemOutput, err error)
 	UpdateDatasetItem(ctx context.Context, spaceID, evaluationSetID, itemID int64, turns []*entity.Turn, fieldWriteOptions []*entity.FieldWriteOption, tags []*entity.ResourceTagRef) (err error)
 	BatchDeleteDatasetItems(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64) (err error)
+	ValidateDatasetItems(ctx context.Context, param *ValidateDatasetItemsParam) (validIndices []int32, errorGroup []*entity.ItemErrorGroup, err error)
 	ListDatasetItems(ctx context.Context, param *ListDatasetItemsParam) (items []*entity.EvaluationSetItem, total, filterTotal *int64, nextPageToken *string, err error)
 	ListDatasetItemsByVersion(ctx context.Context, param *ListDatasetItemsParam) (items []*entity.EvaluationSetItem, total, filterTotal *int64, nextPageToken *string, err error)
 	BatchGetDatasetItems(ctx context.Context, param *BatchGetDatasetItemsParam) (items []*entity.EvaluationSetItem, err error)
@@ -157,6 +160,16 @@ type BatchUpdateDatasetItemsParam struct {
 	FieldWriteOptions []*entity.FieldWriteOption
 }
 
+type ValidateDatasetItemsParam struct {
+	SpaceID         int64
+	EvaluationSetID int64
+	Items           []*entity.EvaluationSetItem
+	// 非空时覆盖数据集已有 schema 用于校验
+	FieldSchemas []*entity.FieldSchema
+	// 容量校验时不考虑数据集现有条数，仅考虑 items 数量是否超限
+	IgnoreCurrentItemCount *bool
+}
+
 type BatchGetVersionedDatasetsResult struct {
 	Version       *entity.EvaluationSetVersion
 	EvaluationSet *entity.EvaluationSet
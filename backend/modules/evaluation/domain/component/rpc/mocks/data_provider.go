@@ -338,6 +338,21 @@ func (mr *MockIDatasetRPCAdapterMockRecorder) ImportDataset(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportDataset", reflect.TypeOf((*MockIDatasetRPCAdapter)(nil).ImportDataset), arg0, arg1)
 }
 
+// ListDatasetIOJobs mocks base method.
+func (m *MockIDatasetRPCAdapter) ListDatasetIOJobs(arg0 context.Context, arg1, arg2 int64, arg3 []entity.JobType) ([]*entity.DatasetIOJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDatasetIOJobs", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*entity.DatasetIOJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDatasetIOJobs indicates an expected call of ListDatasetIOJobs.
+func (mr *MockIDatasetRPCAdapterMockRecorder) ListDatasetIOJobs(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDatasetIOJobs", reflect.TypeOf((*MockIDatasetRPCAdapter)(nil).ListDatasetIOJobs), arg0, arg1, arg2, arg3)
+}
+
 // ListDatasetItemDefs mocks base method.
 func (m *MockIDatasetRPCAdapter) ListDatasetItemDefs(arg0 context.Context, arg1 *rpc.ListDatasetItemDefsParam) ([]*entity.EvaluationSetItemDef, *int64, *string, error) {
 	m.ctrl.T.Helper()
@@ -529,6 +544,22 @@ func (mr *MockIDatasetRPCAdapterMockRecorder) UpdateDatasetSchema(arg0, arg1, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDatasetSchema", reflect.TypeOf((*MockIDatasetRPCAdapter)(nil).UpdateDatasetSchema), arg0, arg1, arg2, arg3)
 }
 
+// ValidateDatasetItems mocks base method.
+func (m *MockIDatasetRPCAdapter) ValidateDatasetItems(arg0 context.Context, arg1 *rpc.ValidateDatasetItemsParam) ([]int32, []*entity.ItemErrorGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateDatasetItems", arg0, arg1)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].([]*entity.ItemErrorGroup)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ValidateDatasetItems indicates an expected call of ValidateDatasetItems.
+func (mr *MockIDatasetRPCAdapterMockRecorder) ValidateDatasetItems(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateDatasetItems", reflect.TypeOf((*MockIDatasetRPCAdapter)(nil).ValidateDatasetItems), arg0, arg1)
+}
+
 // ValidateMultiPartData mocks base method.
 func (m *MockIDatasetRPCAdapter) ValidateMultiPartData(arg0 context.Context, arg1 int64, arg2 []string, arg3 *entity.MultiModalStoreOption) ([]*entity.UploadAttachmentDetail, error) {
 	m.ctrl.T.Helper()
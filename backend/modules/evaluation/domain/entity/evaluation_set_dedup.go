@@ -0,0 +1,188 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultEvaluationSetDedupThreshold 近似重复默认相似度阈值
+	DefaultEvaluationSetDedupThreshold = 0.85
+	// DefaultEvaluationSetDedupShingleSize MinHash 默认片段长度 (token 数)
+	DefaultEvaluationSetDedupShingleSize = 3
+	// MaxEvaluationSetDedupItemCnt 单次去重扫描的最大行数, 超出部分不参与检测, 报告中标记 Truncated
+	MaxEvaluationSetDedupItemCnt = 50000
+	// EvaluationSetDedupArchiveBatchSize 归档重复行时单次删除的行数, 与下游批量删除上限一致
+	EvaluationSetDedupArchiveBatchSize = 100
+	// EvaluationSetDedupRunningTimeout 运行中的去重任务超过该时长仍未结束视为失败
+	EvaluationSetDedupRunningTimeout = 2 * time.Hour
+)
+
+type EvaluationSetDedupMethod string
+
+const (
+	// EvaluationSetDedupMethod_MinHash 按 token 片段集合的 Jaccard 相似度判定, 适合改写、增删少量语句的近似重复
+	EvaluationSetDedupMethod_MinHash EvaluationSetDedupMethod = "minhash"
+	// EvaluationSetDedupMethod_SimHash 按词频指纹的汉明距离判定, 适合高阈值下的大规模扫描
+	EvaluationSetDedupMethod_SimHash EvaluationSetDedupMethod = "simhash"
+)
+
+type EvaluationSetDuplicateKind string
+
+const (
+	// EvaluationSetDuplicateKind_Exact 规范化文本完全相同
+	EvaluationSetDuplicateKind_Exact EvaluationSetDuplicateKind = "exact"
+	// EvaluationSetDuplicateKind_Near 相似度不低于阈值的近似重复
+	EvaluationSetDuplicateKind_Near EvaluationSetDuplicateKind = "near"
+)
+
+// EvaluationSetDedupParam 评测集去重检测参数
+type EvaluationSetDedupParam struct {
+	SpaceID         int64
+	EvaluationSetID int64
+	// VersionID 为空时扫描草稿
+	VersionID *int64
+	Conf      *EvaluationSetDedupConf
+}
+
+type EvaluationSetDedupConf struct {
+	// FieldNames 参与去重的字段, 为空时使用各 turn 的全部文本字段
+	FieldNames []string `json:"field_names,omitempty"`
+	// Method 近似重复算法, 为空使用 MinHash
+	Method EvaluationSetDedupMethod `json:"method,omitempty"`
+	// Threshold 近似重复相似度阈值, 取值 (0, 1], 为 0 时取默认值
+	Threshold float64 `json:"threshold,omitempty"`
+	// ShingleSize MinHash 片段长度, <=0 时取默认值
+	ShingleSize int `json:"shingle_size,omitempty"`
+	// ExactOnly 仅检测精确重复
+	ExactOnly bool `json:"exact_only,omitempty"`
+}
+
+func (c *EvaluationSetDedupConf) GetMethod() EvaluationSetDedupMethod {
+	if c == nil || len(c.Method) == 0 {
+		return EvaluationSetDedupMethod_MinHash
+	}
+	return c.Method
+}
+
+func (c *EvaluationSetDedupConf) GetThreshold() float64 {
+	if c == nil || c.Threshold <= 0 {
+		return DefaultEvaluationSetDedupThreshold
+	}
+	return c.Threshold
+}
+
+func (c *EvaluationSetDedupConf) GetShingleSize() int {
+	if c == nil || c.ShingleSize <= 0 {
+		return DefaultEvaluationSetDedupShingleSize
+	}
+	return c.ShingleSize
+}
+
+func (c *EvaluationSetDedupConf) IsExactOnly() bool {
+	return c != nil && c.ExactOnly
+}
+
+func (c *EvaluationSetDedupConf) Validate() error {
+	if c == nil {
+		return nil
+	}
+	switch c.GetMethod() {
+	case EvaluationSetDedupMethod_MinHash, EvaluationSetDedupMethod_SimHash:
+	default:
+		return fmt.Errorf("unsupported dedup method %s", c.Method)
+	}
+	if c.Threshold < 0 || c.Threshold > 1 {
+		return fmt.Errorf("dedup threshold must be in (0, 1], got %v", c.Threshold)
+	}
+	return nil
+}
+
+// EvaluationSetDuplicateCluster 一组互为重复的行, 保留 KeepItemID (item_id 最小, 即最早写入的行), 其余为待归档的重复行
+type EvaluationSetDuplicateCluster struct {
+	Kind             EvaluationSetDuplicateKind `json:"kind"`
+	KeepItemID       int64                      `json:"keep_item_id"`
+	DuplicateItemIDs []int64                    `json:"duplicate_item_ids"`
+	// Similarity 簇内已校验的最低相似度, 精确重复为 1
+	Similarity float64 `json:"similarity"`
+}
+
+// EvaluationSetDedupReport 评测集去重检测报告
+type EvaluationSetDedupReport struct {
+	EvaluationSetID int64    `json:"evaluation_set_id"`
+	VersionID       *int64   `json:"version_id,omitempty"`
+	Method          string   `json:"method"`
+	Threshold       float64  `json:"threshold"`
+	FieldNames      []string `json:"field_names,omitempty"`
+
+	ScannedItemCnt int `json:"scanned_item_cnt"`
+	// SkippedItemCnt 所选字段无文本内容、未参与检测的行数
+	SkippedItemCnt int `json:"skipped_item_cnt"`
+	// Truncated 评测集行数超过 MaxEvaluationSetDedupItemCnt, 仅检测了前 MaxEvaluationSetDedupItemCnt 行
+	Truncated bool `json:"truncated,omitempty"`
+
+	ExactDuplicateCnt int                              `json:"exact_duplicate_cnt"`
+	NearDuplicateCnt  int                              `json:"near_duplicate_cnt"`
+	Clusters          []*EvaluationSetDuplicateCluster `json:"clusters,omitempty"`
+}
+
+type EvaluationSetDedupJobStatus int32
+
+const (
+	EvaluationSetDedupJobStatus_Unknown EvaluationSetDedupJobStatus = 0
+
+	EvaluationSetDedupJobStatus_Running EvaluationSetDedupJobStatus = 1
+	EvaluationSetDedupJobStatus_Success EvaluationSetDedupJobStatus = 2
+	EvaluationSetDedupJobStatus_Failed  EvaluationSetDedupJobStatus = 3
+)
+
+// EvaluationSetDedupJob 一次异步去重检测任务, 提交后由 MQ 消费执行并回写报告
+type EvaluationSetDedupJob struct {
+	ID              int64
+	SpaceID         int64
+	EvaluationSetID int64
+	// VersionID 0 表示草稿
+	VersionID int64
+	Status    EvaluationSetDedupJobStatus
+	Conf      *EvaluationSetDedupConf
+	Report    *EvaluationSetDedupReport
+	ErrMsg    string
+	CreatedBy string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsStale 运行中且已超过 EvaluationSetDedupRunningTimeout, 通常是执行进程中途退出
+func (j *EvaluationSetDedupJob) IsStale(now time.Time) bool {
+	return j != nil && j.Status == EvaluationSetDedupJobStatus_Running && !j.CreatedAt.IsZero() &&
+		j.CreatedAt.Add(EvaluationSetDedupRunningTimeout).Before(now)
+}
+
+// Param 还原提交时的检测参数, 供异步执行使用
+func (j *EvaluationSetDedupJob) Param() *EvaluationSetDedupParam {
+	param := &EvaluationSetDedupParam{
+		SpaceID:         j.SpaceID,
+		EvaluationSetID: j.EvaluationSetID,
+		Conf:            j.Conf,
+	}
+	if j.VersionID > 0 {
+		param.VersionID = &j.VersionID
+	}
+	return param
+}
+
+// ArchiveEvaluationSetDuplicatesParam 归档评测集草稿中的重复行
+type ArchiveEvaluationSetDuplicatesParam struct {
+	SpaceID         int64
+	EvaluationSetID int64
+	// Clusters 去重检测报告中的簇, 归档各簇的 DuplicateItemIDs, 保留 KeepItemID;
+	// 归档前按草稿当前内容重新校验, 保留行或成员已变化的簇整体跳过
+	Clusters []*EvaluationSetDuplicateCluster
+	// ItemIDs 只归档选中的重复行, 为空时归档全部
+	ItemIDs []int64
+	// Conf 检测时使用的去重配置, 重新校验沿用同一配置
+	Conf *EvaluationSetDedupConf
+}
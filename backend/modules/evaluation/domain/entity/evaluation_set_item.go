@@ -20,6 +20,8 @@ type EvaluationSetItem struct {
 	Turns           []*Turn        `json:"turns,omitempty"`
 	BaseInfo        *BaseInfo      `json:"base_info,omitempty"`
 	Tags            []*ResourceTag `json:"tags,omitempty"`
+	// Provenance 行来源, 仅经 EvaluationSetItemProvenanceService 查询时填充
+	Provenance *EvaluationSetItemProvenance `json:"provenance,omitempty"`
 }
 
 type Turn struct {
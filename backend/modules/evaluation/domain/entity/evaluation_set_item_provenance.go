@@ -0,0 +1,143 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"time"
+)
+
+// EvaluationSetItemSourceType 评测集行的来源
+type EvaluationSetItemSourceType string
+
+const (
+	// EvaluationSetItemSourceType_Manual 页面手动新增或编辑
+	EvaluationSetItemSourceType_Manual EvaluationSetItemSourceType = "manual"
+	// EvaluationSetItemSourceType_FileImport 文件导入
+	EvaluationSetItemSourceType_FileImport EvaluationSetItemSourceType = "file_import"
+	// EvaluationSetItemSourceType_TraceExport 由 trace 导出 (ExportTracesToDataset)
+	EvaluationSetItemSourceType_TraceExport EvaluationSetItemSourceType = "trace_export"
+	// EvaluationSetItemSourceType_OpenAPI OpenAPI 写入
+	EvaluationSetItemSourceType_OpenAPI EvaluationSetItemSourceType = "openapi"
+	// EvaluationSetItemSourceType_Synthetic 模型生成
+	EvaluationSetItemSourceType_Synthetic EvaluationSetItemSourceType = "synthetic"
+	// EvaluationSetItemSourceType_VersionMerge 由历史版本合并回草稿
+	EvaluationSetItemSourceType_VersionMerge EvaluationSetItemSourceType = "version_merge"
+	// EvaluationSetItemSourceType_ExptResult 由实验结果回流
+	EvaluationSetItemSourceType_ExptResult EvaluationSetItemSourceType = "expt_result"
+	// EvaluationSetItemSourceType_Unknown 来源登记上线前写入、且无法按导入任务归因的历史行, 仅由读时回填产生
+	EvaluationSetItemSourceType_Unknown EvaluationSetItemSourceType = "unknown"
+)
+
+var validEvaluationSetItemSourceTypes = map[EvaluationSetItemSourceType]bool{
+	EvaluationSetItemSourceType_Manual:       true,
+	EvaluationSetItemSourceType_FileImport:   true,
+	EvaluationSetItemSourceType_TraceExport:  true,
+	EvaluationSetItemSourceType_OpenAPI:      true,
+	EvaluationSetItemSourceType_Synthetic:    true,
+	EvaluationSetItemSourceType_VersionMerge: true,
+	EvaluationSetItemSourceType_ExptResult:   true,
+	EvaluationSetItemSourceType_Unknown:      true,
+}
+
+func (t EvaluationSetItemSourceType) IsValid() bool {
+	return validEvaluationSetItemSourceTypes[t]
+}
+
+const (
+	// MaxEvaluationSetItemLineageExptCnt 行血缘最多回溯的实验数, 按实验创建时间倒序截取
+	MaxEvaluationSetItemLineageExptCnt = 100
+	// EvaluationSetItemProvenanceBatchSize 来源信息单批读写行数
+	EvaluationSetItemProvenanceBatchSize = 100
+)
+
+// EvaluationSetItemProvenance 评测集行的来源信息, 以 (evaluation_set_id, item_id) 唯一; item_id 跨版本稳定, 来源对草稿与各版本通用
+type EvaluationSetItemProvenance struct {
+	ID              int64                       `json:"id,omitempty"`
+	SpaceID         int64                       `json:"space_id,omitempty"`
+	EvaluationSetID int64                       `json:"evaluation_set_id,omitempty"`
+	ItemID          int64                       `json:"item_id,omitempty"`
+	SourceType      EvaluationSetItemSourceType `json:"source_type,omitempty"`
+	// TraceID, SpanID trace 导出时的来源 span
+	TraceID string `json:"trace_id,omitempty"`
+	SpanID  string `json:"span_id,omitempty"`
+	// ImportJobID 文件导入任务 id
+	ImportJobID int64 `json:"import_job_id,omitempty"`
+	// SourceExptID 由实验结果回流时的来源实验
+	SourceExptID int64 `json:"source_expt_id,omitempty"`
+	// SourceVersionID 由版本合并写入时的来源版本
+	SourceVersionID int64 `json:"source_version_id,omitempty"`
+	// ReviewStatus 生成行的审核状态, 非生成行为空
+	ReviewStatus string `json:"review_status,omitempty"`
+	// Author 创建者; UpdatedBy 最后编辑者, 编辑不改变来源
+	Author    string     `json:"author,omitempty"`
+	UpdatedBy string     `json:"updated_by,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// EvaluationSetItemProvenanceFilter 按来源筛选评测集行, 各条件之间为且, 条件内多值为或
+type EvaluationSetItemProvenanceFilter struct {
+	SourceTypes    []EvaluationSetItemSourceType
+	TraceIDs       []string
+	ImportJobIDs   []int64
+	SourceExptIDs  []int64
+	Authors        []string
+	ReviewStatuses []string
+}
+
+func (f *EvaluationSetItemProvenanceFilter) IsEmpty() bool {
+	return f == nil || (len(f.SourceTypes) == 0 && len(f.TraceIDs) == 0 && len(f.ImportJobIDs) == 0 &&
+		len(f.SourceExptIDs) == 0 && len(f.Authors) == 0 && len(f.ReviewStatuses) == 0)
+}
+
+// RecordEvaluationSetItemProvenanceParam 为新写入的行登记来源, Template 中的来源字段对 ItemIDs 全部生效
+type RecordEvaluationSetItemProvenanceParam struct {
+	SpaceID         int64
+	EvaluationSetID int64
+	ItemIDs         []int64
+	Template        *EvaluationSetItemProvenance
+	// TraceSpans trace 导出时各行的来源 span, key 为 item_id, 覆盖 Template 中的 TraceID / SpanID
+	TraceSpans map[int64]*EvaluationSetItemTraceSpan
+}
+
+type EvaluationSetItemTraceSpan struct {
+	TraceID string
+	SpanID  string
+}
+
+type GetEvaluationSetItemLineageParam struct {
+	SpaceID         int64
+	EvaluationSetID int64
+	ItemID          int64
+}
+
+// EvaluationSetItemLineage 行血缘: 来源与使用过该行的实验及其得分, 按实验开始时间升序
+type EvaluationSetItemLineage struct {
+	ItemID     int64                        `json:"item_id"`
+	Provenance *EvaluationSetItemProvenance `json:"provenance,omitempty"`
+	Usages     []*EvaluationSetItemUsage    `json:"usages,omitempty"`
+	// Truncated 使用过评测集的实验超过 MaxEvaluationSetItemLineageExptCnt, 仅回溯了最近的实验
+	Truncated bool `json:"truncated"`
+}
+
+// EvaluationSetItemUsage 行在一次实验中的运行情况
+type EvaluationSetItemUsage struct {
+	ExptID           int64        `json:"expt_id"`
+	ExptName         string       `json:"expt_name"`
+	ExptStatus       ExptStatus   `json:"expt_status"`
+	EvalSetVersionID int64        `json:"eval_set_version_id"`
+	ItemVersionID    int64        `json:"item_version_id,omitempty"`
+	ItemStatus       ItemRunState `json:"item_status"`
+	StartAt          *time.Time   `json:"start_at,omitempty"`
+	// FinishedAt item 到终态的时间
+	FinishedAt *time.Time                    `json:"finished_at,omitempty"`
+	TurnScores []*EvaluationSetItemTurnScore `json:"turn_scores,omitempty"`
+}
+
+// EvaluationSetItemTurnScore 单个 turn 的加权得分与各评估器实例得分, EvaluatorScores 的 key 为 EncodeEvaluatorInstanceKey
+type EvaluationSetItemTurnScore struct {
+	TurnID          int64              `json:"turn_id"`
+	WeightedScore   *float64           `json:"weighted_score,omitempty"`
+	EvaluatorScores map[string]float64 `json:"evaluator_scores,omitempty"`
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// MaxEvaluationSetSynthesisItemCnt 单次生成的最大行数
+	MaxEvaluationSetSynthesisItemCnt = 200
+	// MaxEvaluationSetSynthesisSeedCnt 作为示例放入提示的最大种子行数
+	MaxEvaluationSetSynthesisSeedCnt = 20
+	// DefaultEvaluationSetSynthesisBatchSize 单次模型调用生成的行数
+	DefaultEvaluationSetSynthesisBatchSize = 10
+	// EvaluationSetSynthesisWriteBatchSize 写入草稿时单批行数, 与下游批量写入上限一致
+	EvaluationSetSynthesisWriteBatchSize = 100
+)
+
+// 生成行的审核状态, 与来源在同一次写入中登记; 待审核的行不进入评测集版本与草稿实验
+const (
+	EvaluationSetItemReviewStatus_PendingReview = "pending_review"
+	EvaluationSetItemReviewStatus_Approved      = "approved"
+)
+
+type EvaluationSetSynthesisStrategy string
+
+const (
+	// EvaluationSetSynthesisStrategy_Paraphrase 改写种子数据, 语义与期望结果保持一致
+	EvaluationSetSynthesisStrategy_Paraphrase EvaluationSetSynthesisStrategy = "paraphrase"
+	// EvaluationSetSynthesisStrategy_Adversarial 构造误导、诱导、含干扰信息的对抗样本
+	EvaluationSetSynthesisStrategy_Adversarial EvaluationSetSynthesisStrategy = "adversarial"
+	// EvaluationSetSynthesisStrategy_EdgeCase 构造边界输入, 如空值、超长、罕见格式、多语言混杂
+	EvaluationSetSynthesisStrategy_EdgeCase EvaluationSetSynthesisStrategy = "edge_case"
+)
+
+var evaluationSetSynthesisStrategyInstructions = map[EvaluationSetSynthesisStrategy]string{
+	EvaluationSetSynthesisStrategy_Paraphrase:  "Paraphrase the examples: vary wording, tone, length and structure while keeping the meaning and the expected answer consistent.",
+	EvaluationSetSynthesisStrategy_Adversarial: "Create adversarial variants: add misleading premises, distracting details, ambiguous phrasing or attempts to make the model fail, and set the expected answer to what a careful model should respond.",
+	EvaluationSetSynthesisStrategy_EdgeCase:    "Create edge cases: unusual or extreme inputs such as boundary values, very short or very long inputs, rare formats, typos or mixed languages, with correct expected answers.",
+}
+
+// EvaluationSetSynthesisSystemPrompt 生成数据的系统提示模板
+const EvaluationSetSynthesisSystemPrompt = `You generate new rows for an evaluation dataset.
+Each row is a JSON object whose keys are exactly the field names below; every value is a string.
+Fields:
+{{fields}}
+Rules:
+- Output only a JSON array of {{count}} objects, with no explanation and no code fences.
+- Every row must be new and meaningfully different from the examples and from each other.
+- Keep the language, domain and difficulty consistent with the examples unless the task says otherwise.
+Task: {{strategy}}`
+
+// GenerateEvaluationSetItemsParam 以种子行为示例, 由模型生成新行写入评测集草稿
+type GenerateEvaluationSetItemsParam struct {
+	SpaceID         int64
+	EvaluationSetID int64
+	// SeedItemIDs 种子行, 取自草稿; 为空时取草稿前 MaxEvaluationSetSynthesisSeedCnt 行
+	SeedItemIDs []int64
+	Conf        *EvaluationSetSynthesisConf
+}
+
+type EvaluationSetSynthesisConf struct {
+	// ModelConfig 生成使用的模型
+	ModelConfig *ModelConfig `json:"model_config,omitempty"`
+	// Count 生成行数, 取值 (0, MaxEvaluationSetSynthesisItemCnt]
+	Count int `json:"count"`
+	// Strategies 生成策略, 各批次轮流使用; 为空时仅改写
+	Strategies []EvaluationSetSynthesisStrategy `json:"strategies,omitempty"`
+	// FieldNames 需要生成的字段, 为空时生成 schema 中全部文本字段
+	FieldNames []string `json:"field_names,omitempty"`
+	// Instruction 附加的生成要求, 如领域、语言、难度
+	Instruction string `json:"instruction,omitempty"`
+	// BatchSize 单次模型调用生成的行数, <=0 时取默认值
+	BatchSize int `json:"batch_size,omitempty"`
+}
+
+func (c *EvaluationSetSynthesisConf) GetStrategies() []EvaluationSetSynthesisStrategy {
+	if c == nil || len(c.Strategies) == 0 {
+		return []EvaluationSetSynthesisStrategy{EvaluationSetSynthesisStrategy_Paraphrase}
+	}
+	return c.Strategies
+}
+
+func (c *EvaluationSetSynthesisConf) GetBatchSize() int {
+	if c == nil || c.BatchSize <= 0 {
+		return DefaultEvaluationSetSynthesisBatchSize
+	}
+	return c.BatchSize
+}
+
+func (c *EvaluationSetSynthesisConf) Validate() error {
+	if c == nil || c.ModelConfig == nil {
+		return fmt.Errorf("synthesis model config is required")
+	}
+	if c.Count <= 0 || c.Count > MaxEvaluationSetSynthesisItemCnt {
+		return fmt.Errorf("synthesis count must be in (0, %d], got %d", MaxEvaluationSetSynthesisItemCnt, c.Count)
+	}
+	for _, s := range c.Strategies {
+		if _, ok := evaluationSetSynthesisStrategyInstructions[s]; !ok {
+			return fmt.Errorf("unsupported synthesis strategy %s", s)
+		}
+	}
+	return nil
+}
+
+// RenderSystemPrompt 渲染指定策略下单批生成 count 行的系统提示
+func (c *EvaluationSetSynthesisConf) RenderSystemPrompt(fields []*FieldSchema, strategy EvaluationSetSynthesisStrategy, count int) string {
+	var sb strings.Builder
+	for _, f := range fields {
+		sb.WriteString("- ")
+		sb.WriteString(f.Name)
+		if len(f.Description) > 0 {
+			sb.WriteString(": ")
+			sb.WriteString(f.Description)
+		}
+		sb.WriteString("\n")
+	}
+	task := evaluationSetSynthesisStrategyInstructions[strategy]
+	if c != nil && len(c.Instruction) > 0 {
+		task += "\nAdditional requirements: " + c.Instruction
+	}
+	return strings.NewReplacer(
+		"{{fields}}", strings.TrimSuffix(sb.String(), "\n"),
+		"{{count}}", fmt.Sprint(count),
+		"{{strategy}}", task,
+	).Replace(EvaluationSetSynthesisSystemPrompt)
+}
+
+// GenerateEvaluationSetItemsResult 生成结果; 写入的行在草稿中标记为待审核
+type GenerateEvaluationSetItemsResult struct {
+	// ItemIDs 写入草稿的行
+	ItemIDs []int64 `json:"item_ids,omitempty"`
+	// GeneratedCnt 模型返回的行数
+	GeneratedCnt int `json:"generated_cnt"`
+	// DuplicateCnt 与种子或其他生成行文本相同而丢弃的行数
+	DuplicateCnt int `json:"duplicate_cnt"`
+	// InvalidCnt 未通过 schema 校验而丢弃的行数
+	InvalidCnt int `json:"invalid_cnt"`
+	// FailedBatchCnt 模型调用或解析失败的批次数
+	FailedBatchCnt int               `json:"failed_batch_cnt"`
+	Errors         []*ItemErrorGroup `json:"errors,omitempty"`
+}
+
+// ReviewGeneratedEvaluationSetItemsParam 审核生成的行: 通过的行标记为已通过, 驳回的行从草稿删除
+type ReviewGeneratedEvaluationSetItemsParam struct {
+	SpaceID         int64
+	EvaluationSetID int64
+	ApprovedItemIDs []int64
+	RejectedItemIDs []int64
+}
+
+// ListGeneratedEvaluationSetItemsParam 分页列出草稿中由模型生成的行
+type ListGeneratedEvaluationSetItemsParam struct {
+	SpaceID         int64
+	EvaluationSetID int64
+	PageNumber      *int32
+	PageSize        *int32
+	PageToken       *string
+}
+
+// GeneratedEvaluationSetItem 生成的行及其审核状态; ReviewStatus 取自来源记录, 未登记时为空
+type GeneratedEvaluationSetItem struct {
+	Item         *EvaluationSetItem `json:"item,omitempty"`
+	ReviewStatus string             `json:"review_status,omitempty"`
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"reflect"
+
+	"github.com/bytedance/gg/gptr"
+)
+
+const (
+	// MaxEvaluationSetVersionDiffItemCnt 版本对比时单侧最多加载的行数, 超出时对比结果标记 Truncated 且不允许合入
+	MaxEvaluationSetVersionDiffItemCnt = 50000
+	// EvaluationSetMergeBatchSize 合入时批量写入/删除的行数, 与下游批量接口上限一致
+	EvaluationSetMergeBatchSize = 100
+)
+
+type EvaluationSetChangeType string
+
+const (
+	EvaluationSetChangeType_Added    EvaluationSetChangeType = "added"
+	EvaluationSetChangeType_Removed  EvaluationSetChangeType = "removed"
+	EvaluationSetChangeType_Modified EvaluationSetChangeType = "modified"
+)
+
+// DiffEvaluationSetVersionsParam 对比同一评测集的两个版本, 版本 ID 为空表示草稿
+type DiffEvaluationSetVersionsParam struct {
+	SpaceID         int64
+	EvaluationSetID int64
+	BaseVersionID   *int64
+	TargetVersionID *int64
+}
+
+// EvaluationSetVersionDiff 从 Base 到 Target 的变更
+type EvaluationSetVersionDiff struct {
+	EvaluationSetID int64  `json:"evaluation_set_id"`
+	BaseVersionID   *int64 `json:"base_version_id,omitempty"`
+	TargetVersionID *int64 `json:"target_version_id,omitempty"`
+
+	SchemaDiffs []*EvaluationSetSchemaFieldDiff `json:"schema_diffs,omitempty"`
+	ItemDiffs   []*EvaluationSetItemDiff        `json:"item_diffs,omitempty"`
+
+	AddedItemCnt     int `json:"added_item_cnt"`
+	RemovedItemCnt   int `json:"removed_item_cnt"`
+	ModifiedItemCnt  int `json:"modified_item_cnt"`
+	UnchangedItemCnt int `json:"unchanged_item_cnt"`
+	// Truncated 任一侧行数超过 MaxEvaluationSetVersionDiffItemCnt, 行级对比不完整
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// EvaluationSetSchemaFieldDiff 字段 schema 的变更, 按字段 key 匹配
+type EvaluationSetSchemaFieldDiff struct {
+	Key        string                  `json:"key"`
+	Name       string                  `json:"name"`
+	ChangeType EvaluationSetChangeType `json:"change_type"`
+	Base       *FieldSchema            `json:"base,omitempty"`
+	Target     *FieldSchema            `json:"target,omitempty"`
+	// ChangedAttrs 发生变化的属性, 仅 Modified 时有值
+	ChangedAttrs []string `json:"changed_attrs,omitempty"`
+}
+
+// EvaluationSetItemDiff 行级变更, 按 item_id 匹配; 行在版本间 item_id 不变, 编辑不会产生新的 item_id
+type EvaluationSetItemDiff struct {
+	ItemID     int64                   `json:"item_id"`
+	ItemKey    string                  `json:"item_key,omitempty"`
+	ChangeType EvaluationSetChangeType `json:"change_type"`
+	// BaseItem / TargetItem 对应侧的行, 新增时 BaseItem 为空, 删除时 TargetItem 为空
+	BaseItem   *EvaluationSetItem `json:"base_item,omitempty"`
+	TargetItem *EvaluationSetItem `json:"target_item,omitempty"`
+	// FieldDiffs 字段级变更, 仅 Modified 时有值
+	FieldDiffs []*EvaluationSetFieldDiff `json:"field_diffs,omitempty"`
+}
+
+// EvaluationSetFieldDiff 单个 turn 中单个字段内容的变更, turn 按序号匹配, 字段按 key 匹配
+type EvaluationSetFieldDiff struct {
+	TurnIdx    int                     `json:"turn_idx"`
+	FieldKey   string                  `json:"field_key"`
+	FieldName  string                  `json:"field_name"`
+	ChangeType EvaluationSetChangeType `json:"change_type"`
+	Base       *Content                `json:"base,omitempty"`
+	Target     *Content                `json:"target,omitempty"`
+}
+
+// MergeEvaluationSetVersionParam 把来源版本相对草稿的变更选择性合入草稿。
+// 版本为不可变快照, 合入目标固定为草稿, 合入后可再提交为新版本。
+type MergeEvaluationSetVersionParam struct {
+	SpaceID         int64
+	EvaluationSetID int64
+	SourceVersionID int64
+	// ItemIDs 选中合入的变更行 (item_id), 草稿独有的行 (Removed) 只有在此显式选中时才会从草稿删除
+	ItemIDs []int64
+	// MergeAllItems 合入全部新增与修改的行, 不删除草稿中的行
+	MergeAllItems bool
+	// MergeSchema 合入 schema 变更: 来源版本新增与修改的字段写入草稿; 草稿独有的字段保留, 避免丢失草稿数据
+	MergeSchema bool
+}
+
+// MergeEvaluationSetVersionResult 合入结果; 来源版本新增的行在草稿中重新创建, 会分配新的 item_id
+type MergeEvaluationSetVersionResult struct {
+	// AddedItemIDs 来源 item_id -> 草稿中新建的 item_id
+	AddedItemIDs   map[int64]int64   `json:"added_item_ids,omitempty"`
+	UpdatedItemIDs []int64           `json:"updated_item_ids,omitempty"`
+	RemovedItemIDs []int64           `json:"removed_item_ids,omitempty"`
+	SchemaMerged   bool              `json:"schema_merged,omitempty"`
+	Errors         []*ItemErrorGroup `json:"errors,omitempty"`
+}
+
+// ContentEqual 比较两个内容是否相同。多媒体与被裁剪的超长内容按存储 URI 比较, 不比较每次签发都会变化的访问 URL
+func ContentEqual(a, b *Content) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if gptr.Indirect(a.ContentType) != gptr.Indirect(b.ContentType) {
+		return false
+	}
+	if a.IsContentOmitted() || b.IsContentOmitted() {
+		if a.IsContentOmitted() != b.IsContentOmitted() || gptr.Indirect(a.FullContentBytes) != gptr.Indirect(b.FullContentBytes) {
+			return false
+		}
+		var uriA, uriB string
+		if a.FullContent != nil {
+			uriA = gptr.Indirect(a.FullContent.URI)
+		}
+		if b.FullContent != nil {
+			uriB = gptr.Indirect(b.FullContent.URI)
+		}
+		if uriA != uriB {
+			return false
+		}
+	}
+	if a.GetText() != b.GetText() {
+		return false
+	}
+	if a.Image != nil || b.Image != nil {
+		if a.Image == nil || b.Image == nil || !mediaRefEqual(a.Image.URI, a.Image.URL, b.Image.URI, b.Image.URL) {
+			return false
+		}
+	}
+	if a.Audio != nil || b.Audio != nil {
+		if a.Audio == nil || b.Audio == nil || !mediaRefEqual(a.Audio.URI, a.Audio.URL, b.Audio.URI, b.Audio.URL) {
+			return false
+		}
+	}
+	if a.Video != nil || b.Video != nil {
+		if a.Video == nil || b.Video == nil || !mediaRefEqual(a.Video.URI, a.Video.URL, b.Video.URI, b.Video.URL) {
+			return false
+		}
+	}
+	if len(a.MultiPart) != len(b.MultiPart) {
+		return false
+	}
+	for i := range a.MultiPart {
+		if !ContentEqual(a.MultiPart[i], b.MultiPart[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// mediaRefEqual 有存储 URI 时按 URI 比较, 否则按外链 URL 比较
+func mediaRefEqual(uriA, urlA, uriB, urlB *string) bool {
+	if len(gptr.Indirect(uriA)) > 0 || len(gptr.Indirect(uriB)) > 0 {
+		return gptr.Indirect(uriA) == gptr.Indirect(uriB)
+	}
+	return gptr.Indirect(urlA) == gptr.Indirect(urlB)
+}
+
+// DiffFieldSchema 返回两个字段 schema 间发生变化的属性名
+func DiffFieldSchema(base, target *FieldSchema) []string {
+	if base == nil || target == nil {
+		return nil
+	}
+	var changed []string
+	check := func(attr string, eq bool) {
+		if !eq {
+			changed = append(changed, attr)
+		}
+	}
+	check("name", base.Name == target.Name)
+	check("description", base.Description == target.Description)
+	check("content_type", base.ContentType == target.ContentType)
+	check("default_display_format", base.DefaultDisplayFormat == target.DefaultDisplayFormat)
+	check("status", base.Status == target.Status)
+	check("schema_key", gptr.Indirect(base.SchemaKey) == gptr.Indirect(target.SchemaKey))
+	check("text_schema", base.TextSchema == target.TextSchema)
+	check("multi_model_spec", reflect.DeepEqual(base.MultiModelSpec, target.MultiModelSpec))
+	check("hidden", base.Hidden == target.Hidden)
+	check("is_required", base.IsRequired == target.IsRequired)
+	check("default_transformations", reflect.DeepEqual(base.DefaultTransformations, target.DefaultTransformations))
+	check("locked", base.Locked == target.Locked)
+	return changed
+}
@@ -24,6 +24,9 @@ type Evaluator struct {
 	CodeEvaluatorVersion      *CodeEvaluatorVersion
 	CustomRPCEvaluatorVersion *CustomRPCEvaluatorVersion
 	AgentEvaluatorVersion     *AgentEvaluatorVersion
+	PairwiseEvaluatorVersion  *PairwiseEvaluatorVersion
+	RuleEvaluatorVersion      *RuleEvaluatorVersion
+	CompositeEvaluatorVersion *CompositeEvaluatorVersion
 }
 
 type EvaluatorInfo struct {
@@ -55,6 +58,12 @@ const (
 	EvaluatorTypeCode      EvaluatorType = 2
 	EvaluatorTypeCustomRPC EvaluatorType = 3
 	EvaluatorTypeAgent     EvaluatorType = 4
+	// EvaluatorTypePairwise 成对 (A-vs-B) LLM 裁判评估器, 同时接收两个实验的输出并给出偏好
+	EvaluatorTypePairwise EvaluatorType = 5
+	// EvaluatorTypeRule 规则评估器, 按声明式规则在服务进程内直接计算, 不依赖 FaaS 运行时
+	EvaluatorTypeRule EvaluatorType = 6
+	// EvaluatorTypeComposite 组合评估器, 并行运行多个组件评估器版本并合并为一个得分
+	EvaluatorTypeComposite EvaluatorType = 7
 )
 
 var EvaluatorTypeSet = map[EvaluatorType]struct{}{
@@ -62,6 +71,9 @@ var EvaluatorTypeSet = map[EvaluatorType]struct{}{
 	EvaluatorTypeCode:      {},
 	EvaluatorTypeCustomRPC: {},
 	EvaluatorTypeAgent:     {},
+	EvaluatorTypePairwise:  {},
+	EvaluatorTypeRule:      {},
+	EvaluatorTypeComposite: {},
 }
 
 func (e *Evaluator) IsAsync() bool {
@@ -110,6 +122,18 @@ func (e *Evaluator) GetEvaluatorVersionID() int64 {
 		if e.AgentEvaluatorVersion != nil {
 			return e.AgentEvaluatorVersion.GetID()
 		}
+	case EvaluatorTypePairwise:
+		if e.PairwiseEvaluatorVersion != nil {
+			return e.PairwiseEvaluatorVersion.GetID()
+		}
+	case EvaluatorTypeRule:
+		if e.RuleEvaluatorVersion != nil {
+			return e.RuleEvaluatorVersion.GetID()
+		}
+	case EvaluatorTypeComposite:
+		if e.CompositeEvaluatorVersion != nil {
+			return e.CompositeEvaluatorVersion.GetID()
+		}
 	default:
 		return 0
 	}
@@ -135,6 +159,18 @@ func (e *Evaluator) GetVersion() string {
 		if e.AgentEvaluatorVersion != nil {
 			return e.AgentEvaluatorVersion.GetVersion()
 		}
+	case EvaluatorTypePairwise:
+		if e.PairwiseEvaluatorVersion != nil {
+			return e.PairwiseEvaluatorVersion.GetVersion()
+		}
+	case EvaluatorTypeRule:
+		if e.RuleEvaluatorVersion != nil {
+			return e.RuleEvaluatorVersion.GetVersion()
+		}
+	case EvaluatorTypeComposite:
+		if e.CompositeEvaluatorVersion != nil {
+			return e.CompositeEvaluatorVersion.GetVersion()
+		}
 	default:
 		return ""
 	}
@@ -160,6 +196,18 @@ func (e *Evaluator) GetEvaluatorID() int64 {
 		if e.AgentEvaluatorVersion != nil {
 			return e.AgentEvaluatorVersion.GetEvaluatorID()
 		}
+	case EvaluatorTypePairwise:
+		if e.PairwiseEvaluatorVersion != nil {
+			return e.PairwiseEvaluatorVersion.GetEvaluatorID()
+		}
+	case EvaluatorTypeRule:
+		if e.RuleEvaluatorVersion != nil {
+			return e.RuleEvaluatorVersion.GetEvaluatorID()
+		}
+	case EvaluatorTypeComposite:
+		if e.CompositeEvaluatorVersion != nil {
+			return e.CompositeEvaluatorVersion.GetEvaluatorID()
+		}
 	default:
 		return 0
 	}
@@ -185,6 +233,18 @@ func (e *Evaluator) GetSpaceID() int64 {
 		if e.AgentEvaluatorVersion != nil {
 			return e.AgentEvaluatorVersion.GetSpaceID()
 		}
+	case EvaluatorTypePairwise:
+		if e.PairwiseEvaluatorVersion != nil {
+			return e.PairwiseEvaluatorVersion.GetSpaceID()
+		}
+	case EvaluatorTypeRule:
+		if e.RuleEvaluatorVersion != nil {
+			return e.RuleEvaluatorVersion.GetSpaceID()
+		}
+	case EvaluatorTypeComposite:
+		if e.CompositeEvaluatorVersion != nil {
+			return e.CompositeEvaluatorVersion.GetSpaceID()
+		}
 	default:
 		return 0
 	}
@@ -215,6 +275,18 @@ func (e *Evaluator) GetEvaluatorVersionDescription() string {
 		if e.AgentEvaluatorVersion != nil {
 			return e.AgentEvaluatorVersion.GetDescription()
 		}
+	case EvaluatorTypePairwise:
+		if e.PairwiseEvaluatorVersion != nil {
+			return e.PairwiseEvaluatorVersion.GetDescription()
+		}
+	case EvaluatorTypeRule:
+		if e.RuleEvaluatorVersion != nil {
+			return e.RuleEvaluatorVersion.GetDescription()
+		}
+	case EvaluatorTypeComposite:
+		if e.CompositeEvaluatorVersion != nil {
+			return e.CompositeEvaluatorVersion.GetDescription()
+		}
 	default:
 		return ""
 	}
@@ -240,6 +312,18 @@ func (e *Evaluator) GetBaseInfo() *BaseInfo {
 		if e.AgentEvaluatorVersion != nil {
 			return e.AgentEvaluatorVersion.GetBaseInfo()
 		}
+	case EvaluatorTypePairwise:
+		if e.PairwiseEvaluatorVersion != nil {
+			return e.PairwiseEvaluatorVersion.GetBaseInfo()
+		}
+	case EvaluatorTypeRule:
+		if e.RuleEvaluatorVersion != nil {
+			return e.RuleEvaluatorVersion.GetBaseInfo()
+		}
+	case EvaluatorTypeComposite:
+		if e.CompositeEvaluatorVersion != nil {
+			return e.CompositeEvaluatorVersion.GetBaseInfo()
+		}
 	default:
 		return nil
 	}
@@ -266,6 +350,10 @@ func (e *Evaluator) GetModelConfig() *ModelConfig {
 		if e.PromptEvaluatorVersion != nil {
 			return e.PromptEvaluatorVersion.GetModelConfig()
 		}
+	case EvaluatorTypePairwise:
+		if e.PairwiseEvaluatorVersion != nil {
+			return e.PairwiseEvaluatorVersion.GetModelConfig()
+		}
 	default:
 		return nil
 	}
@@ -291,6 +379,18 @@ func (e *Evaluator) ValidateInput(input *EvaluatorInputData) error {
 		if e.AgentEvaluatorVersion != nil {
 			return e.AgentEvaluatorVersion.ValidateInput(input)
 		}
+	case EvaluatorTypePairwise:
+		if e.PairwiseEvaluatorVersion != nil {
+			return e.PairwiseEvaluatorVersion.ValidateInput(input)
+		}
+	case EvaluatorTypeRule:
+		if e.RuleEvaluatorVersion != nil {
+			return e.RuleEvaluatorVersion.ValidateInput(input)
+		}
+	case EvaluatorTypeComposite:
+		if e.CompositeEvaluatorVersion != nil {
+			return e.CompositeEvaluatorVersion.ValidateInput(input)
+		}
 	default:
 		return nil
 	}
@@ -316,6 +416,18 @@ func (e *Evaluator) ValidateBaseInfo() error {
 		if e.AgentEvaluatorVersion != nil {
 			return e.AgentEvaluatorVersion.ValidateBaseInfo()
 		}
+	case EvaluatorTypePairwise:
+		if e.PairwiseEvaluatorVersion != nil {
+			return e.PairwiseEvaluatorVersion.ValidateBaseInfo()
+		}
+	case EvaluatorTypeRule:
+		if e.RuleEvaluatorVersion != nil {
+			return e.RuleEvaluatorVersion.ValidateBaseInfo()
+		}
+	case EvaluatorTypeComposite:
+		if e.CompositeEvaluatorVersion != nil {
+			return e.CompositeEvaluatorVersion.ValidateBaseInfo()
+		}
 	default:
 		return nil
 	}
@@ -341,6 +453,18 @@ func (e *Evaluator) SetEvaluatorVersionID(id int64) {
 		if e.AgentEvaluatorVersion != nil {
 			e.AgentEvaluatorVersion.SetID(id)
 		}
+	case EvaluatorTypePairwise:
+		if e.PairwiseEvaluatorVersion != nil {
+			e.PairwiseEvaluatorVersion.SetID(id)
+		}
+	case EvaluatorTypeRule:
+		if e.RuleEvaluatorVersion != nil {
+			e.RuleEvaluatorVersion.SetID(id)
+		}
+	case EvaluatorTypeComposite:
+		if e.CompositeEvaluatorVersion != nil {
+			e.CompositeEvaluatorVersion.SetID(id)
+		}
 	default:
 		return
 	}
@@ -365,6 +489,18 @@ func (e *Evaluator) SetVersion(version string) {
 		if e.AgentEvaluatorVersion != nil {
 			e.AgentEvaluatorVersion.SetVersion(version)
 		}
+	case EvaluatorTypePairwise:
+		if e.PairwiseEvaluatorVersion != nil {
+			e.PairwiseEvaluatorVersion.SetVersion(version)
+		}
+	case EvaluatorTypeRule:
+		if e.RuleEvaluatorVersion != nil {
+			e.RuleEvaluatorVersion.SetVersion(version)
+		}
+	case EvaluatorTypeComposite:
+		if e.CompositeEvaluatorVersion != nil {
+			e.CompositeEvaluatorVersion.SetVersion(version)
+		}
 	default:
 		return
 	}
@@ -394,6 +530,18 @@ func (e *Evaluator) SetEvaluatorVersionDescription(description string) {
 		if e.AgentEvaluatorVersion != nil {
 			e.AgentEvaluatorVersion.SetDescription(description)
 		}
+	case EvaluatorTypePairwise:
+		if e.PairwiseEvaluatorVersion != nil {
+			e.PairwiseEvaluatorVersion.SetDescription(description)
+		}
+	case EvaluatorTypeRule:
+		if e.RuleEvaluatorVersion != nil {
+			e.RuleEvaluatorVersion.SetDescription(description)
+		}
+	case EvaluatorTypeComposite:
+		if e.CompositeEvaluatorVersion != nil {
+			e.CompositeEvaluatorVersion.SetDescription(description)
+		}
 	default:
 		return
 	}
@@ -418,6 +566,18 @@ func (e *Evaluator) SetBaseInfo(baseInfo *BaseInfo) {
 		if e.AgentEvaluatorVersion != nil {
 			e.AgentEvaluatorVersion.SetBaseInfo(baseInfo)
 		}
+	case EvaluatorTypePairwise:
+		if e.PairwiseEvaluatorVersion != nil {
+			e.PairwiseEvaluatorVersion.SetBaseInfo(baseInfo)
+		}
+	case EvaluatorTypeRule:
+		if e.RuleEvaluatorVersion != nil {
+			e.RuleEvaluatorVersion.SetBaseInfo(baseInfo)
+		}
+	case EvaluatorTypeComposite:
+		if e.CompositeEvaluatorVersion != nil {
+			e.CompositeEvaluatorVersion.SetBaseInfo(baseInfo)
+		}
 	default:
 		return
 	}
@@ -478,6 +638,18 @@ func (e *Evaluator) SetEvaluatorID(evaluatorID int64) {
 		if e.AgentEvaluatorVersion != nil {
 			e.AgentEvaluatorVersion.SetEvaluatorID(evaluatorID)
 		}
+	case EvaluatorTypePairwise:
+		if e.PairwiseEvaluatorVersion != nil {
+			e.PairwiseEvaluatorVersion.SetEvaluatorID(evaluatorID)
+		}
+	case EvaluatorTypeRule:
+		if e.RuleEvaluatorVersion != nil {
+			e.RuleEvaluatorVersion.SetEvaluatorID(evaluatorID)
+		}
+	case EvaluatorTypeComposite:
+		if e.CompositeEvaluatorVersion != nil {
+			e.CompositeEvaluatorVersion.SetEvaluatorID(evaluatorID)
+		}
 	default:
 		return
 	}
@@ -502,6 +674,18 @@ func (e *Evaluator) SetSpaceID(spaceID int64) {
 		if e.AgentEvaluatorVersion != nil {
 			e.AgentEvaluatorVersion.SetSpaceID(spaceID)
 		}
+	case EvaluatorTypePairwise:
+		if e.PairwiseEvaluatorVersion != nil {
+			e.PairwiseEvaluatorVersion.SetSpaceID(spaceID)
+		}
+	case EvaluatorTypeRule:
+		if e.RuleEvaluatorVersion != nil {
+			e.RuleEvaluatorVersion.SetSpaceID(spaceID)
+		}
+	case EvaluatorTypeComposite:
+		if e.CompositeEvaluatorVersion != nil {
+			e.CompositeEvaluatorVersion.SetSpaceID(spaceID)
+		}
 	default:
 		return
 	}
@@ -520,6 +704,12 @@ func (e *Evaluator) SetEvaluatorVersion(version *Evaluator) {
 		e.CustomRPCEvaluatorVersion = version.CustomRPCEvaluatorVersion
 	case EvaluatorTypeAgent:
 		e.AgentEvaluatorVersion = version.AgentEvaluatorVersion
+	case EvaluatorTypePairwise:
+		e.PairwiseEvaluatorVersion = version.PairwiseEvaluatorVersion
+	case EvaluatorTypeRule:
+		e.RuleEvaluatorVersion = version.RuleEvaluatorVersion
+	case EvaluatorTypeComposite:
+		e.CompositeEvaluatorVersion = version.CompositeEvaluatorVersion
 	default:
 		return
 	}
@@ -551,6 +741,18 @@ func (e *Evaluator) GetInputSchemas() []*ArgsSchema {
 		if e.AgentEvaluatorVersion != nil {
 			return e.AgentEvaluatorVersion.InputSchemas
 		}
+	case EvaluatorTypePairwise:
+		if e.PairwiseEvaluatorVersion != nil {
+			return e.PairwiseEvaluatorVersion.InputSchemas
+		}
+	case EvaluatorTypeRule:
+		if e.RuleEvaluatorVersion != nil {
+			return e.RuleEvaluatorVersion.InputSchemas
+		}
+	case EvaluatorTypeComposite:
+		if e.CompositeEvaluatorVersion != nil {
+			return e.CompositeEvaluatorVersion.InputSchemas
+		}
 	}
 	return nil
 }
@@ -0,0 +1,157 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import "time"
+
+const (
+	// DefaultCalibrationWorstItemCnt 校准结果默认保留的最不一致样本数
+	DefaultCalibrationWorstItemCnt = 20
+	// DefaultCalibrationConcurrency 校准时评估器默认并发数
+	DefaultCalibrationConcurrency = 5
+	// CalibrationConfusionMaxLabels 评估器得分与人工标注的取值并集不超过该数量时视为分类输出, 计算混淆矩阵
+	CalibrationConfusionMaxLabels = 10
+	// DefaultCalibrationMaxEvaluatorFailRate 评估器运行失败样本占有效样本的比例超过该值时校准置为失败
+	DefaultCalibrationMaxEvaluatorFailRate = 0.5
+	// EvaluatorCalibrationRunningTimeout 运行中的校准任务超过该时长仍未结束视为失败
+	EvaluatorCalibrationRunningTimeout = 2 * time.Hour
+)
+
+type EvaluatorCalibrationStatus int32
+
+const (
+	EvaluatorCalibrationStatus_Unknown EvaluatorCalibrationStatus = 0
+
+	EvaluatorCalibrationStatus_Running EvaluatorCalibrationStatus = 1
+	EvaluatorCalibrationStatus_Success EvaluatorCalibrationStatus = 2
+	EvaluatorCalibrationStatus_Failed  EvaluatorCalibrationStatus = 3
+)
+
+// EvaluatorCalibrationParam 评估器校准参数: 在带人工真值字段的评测集 (golden set) 上运行指定评估器版本
+type EvaluatorCalibrationParam struct {
+	SpaceID            int64
+	EvaluatorVersionID int64
+	EvaluationSetID    int64
+	// EvaluationSetVersionID 为空时使用评测集草稿
+	EvaluationSetVersionID *int64
+	Conf                   *EvaluatorCalibrationConf
+	CreatedBy              string
+}
+
+type EvaluatorCalibrationConf struct {
+	// GroundTruthField 评测集中人工真值分数所在字段名, 内容需可解析为数值
+	GroundTruthField string `json:"ground_truth_field"`
+	// FieldConfs 评估器输入字段到评测集字段的映射, 为空时按同名透传除真值字段外的全部字段
+	FieldConfs []*CalibrationFieldConf `json:"field_confs,omitempty"`
+	// TargetOutputFieldConfs 作为评测对象输出提供给评估器的评测集字段 (如 golden set 中待评判的回答)
+	TargetOutputFieldConfs []*CalibrationFieldConf `json:"target_output_field_confs,omitempty"`
+	// WorstItemCnt 结果中保留的最不一致样本数, <=0 时取默认值
+	WorstItemCnt int `json:"worst_item_cnt,omitempty"`
+	// Concurrency 评估器并发数, <=0 时取默认值
+	Concurrency int `json:"concurrency,omitempty"`
+	// MaxEvaluatorFailRate 允许的评估器运行失败比例, 取值 (0, 1], <=0 时取默认值
+	MaxEvaluatorFailRate float64 `json:"max_evaluator_fail_rate,omitempty"`
+}
+
+// CalibrationFieldConf 字段映射, FieldName 为评估器侧字段, FromField 为评测集字段
+type CalibrationFieldConf struct {
+	FieldName string `json:"field_name"`
+	FromField string `json:"from_field"`
+}
+
+func (c *EvaluatorCalibrationConf) GetWorstItemCnt() int {
+	if c == nil || c.WorstItemCnt <= 0 {
+		return DefaultCalibrationWorstItemCnt
+	}
+	return c.WorstItemCnt
+}
+
+func (c *EvaluatorCalibrationConf) GetConcurrency() int {
+	if c == nil || c.Concurrency <= 0 {
+		return DefaultCalibrationConcurrency
+	}
+	return c.Concurrency
+}
+
+func (c *EvaluatorCalibrationConf) GetMaxEvaluatorFailRate() float64 {
+	if c == nil || c.MaxEvaluatorFailRate <= 0 {
+		return DefaultCalibrationMaxEvaluatorFailRate
+	}
+	return c.MaxEvaluatorFailRate
+}
+
+// EvaluatorCalibrationRecord 评估器版本的一次校准记录, 同一评估器的多个版本可按记录横向对比
+type EvaluatorCalibrationRecord struct {
+	ID                     int64
+	SpaceID                int64
+	EvaluatorID            int64
+	EvaluatorVersionID     int64
+	EvaluatorVersion       string
+	EvaluationSetID        int64
+	EvaluationSetVersionID int64
+	Status                 EvaluatorCalibrationStatus
+	Conf                   *EvaluatorCalibrationConf
+	Result                 *EvaluatorCalibrationResult
+	ErrMsg                 string
+
+	BaseInfo *BaseInfo
+}
+
+// IsStale 运行中且创建时间已超过 EvaluatorCalibrationRunningTimeout, 通常是执行进程中途退出
+func (r *EvaluatorCalibrationRecord) IsStale(now time.Time) bool {
+	if r == nil || r.Status != EvaluatorCalibrationStatus_Running || r.BaseInfo == nil || r.BaseInfo.CreatedAt == nil {
+		return false
+	}
+	return time.UnixMilli(*r.BaseInfo.CreatedAt).Add(EvaluatorCalibrationRunningTimeout).Before(now)
+}
+
+// Param 还原提交时的校准参数, 供异步执行使用
+func (r *EvaluatorCalibrationRecord) Param() *EvaluatorCalibrationParam {
+	param := &EvaluatorCalibrationParam{
+		SpaceID:            r.SpaceID,
+		EvaluatorVersionID: r.EvaluatorVersionID,
+		EvaluationSetID:    r.EvaluationSetID,
+		Conf:               r.Conf,
+	}
+	if r.EvaluationSetVersionID > 0 {
+		param.EvaluationSetVersionID = &r.EvaluationSetVersionID
+	}
+	if r.BaseInfo != nil && r.BaseInfo.CreatedBy != nil && r.BaseInfo.CreatedBy.UserID != nil {
+		param.CreatedBy = *r.BaseInfo.CreatedBy.UserID
+	}
+	return param
+}
+
+type EvaluatorCalibrationResult struct {
+	// ItemCnt 参与校准的 turn 数
+	ItemCnt int `json:"item_cnt"`
+	// PairedCnt 评估器得分与人工真值均有效的 turn 数, 以下指标均基于这部分样本
+	PairedCnt             int `json:"paired_cnt"`
+	EvaluatorFailCnt      int `json:"evaluator_fail_cnt"`
+	InvalidGroundTruthCnt int `json:"invalid_ground_truth_cnt"`
+
+	PearsonCorrelation  *float64 `json:"pearson_correlation,omitempty"`
+	SpearmanCorrelation *float64 `json:"spearman_correlation,omitempty"`
+	MeanAbsoluteError   *float64 `json:"mean_absolute_error,omitempty"`
+	// ConfusionMatrix 仅在分类输出 (取值数不超过 CalibrationConfusionMaxLabels) 时给出
+	ConfusionMatrix *CalibrationConfusionMatrix `json:"confusion_matrix,omitempty"`
+	// WorstItems 按绝对误差降序的最不一致样本
+	WorstItems []*CalibrationItemResult `json:"worst_items,omitempty"`
+}
+
+// CalibrationConfusionMatrix Counts[i][j] 为人工真值为 Labels[i] 且评估器输出为 Labels[j] 的样本数
+type CalibrationConfusionMatrix struct {
+	Labels   []string  `json:"labels"`
+	Counts   [][]int64 `json:"counts"`
+	Accuracy float64   `json:"accuracy"`
+}
+
+type CalibrationItemResult struct {
+	ItemID         int64   `json:"item_id"`
+	TurnID         int64   `json:"turn_id"`
+	GroundTruth    float64 `json:"ground_truth"`
+	EvaluatorScore float64 `json:"evaluator_score"`
+	AbsError       float64 `json:"abs_error"`
+	Reasoning      string  `json:"reasoning,omitempty"`
+}
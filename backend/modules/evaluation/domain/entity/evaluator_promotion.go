@@ -0,0 +1,242 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+const (
+	// DefaultPromotionSampleSize 回归检查默认回放的历史记录数
+	DefaultPromotionSampleSize = 50
+	// MaxPromotionSampleSize 回归检查单次回放的历史记录数上限
+	MaxPromotionSampleSize = 200
+	// DefaultPromotionConcurrency 回放草稿时评估器默认并发数
+	DefaultPromotionConcurrency = 5
+	// DefaultPromotionDriftItemCnt 报告中默认保留的漂移最大样本数
+	DefaultPromotionDriftItemCnt = 20
+)
+
+// EvaluatorPromotionAction 回归检查未通过时对提交的处理方式
+type EvaluatorPromotionAction int32
+
+const (
+	EvaluatorPromotionAction_Unknown EvaluatorPromotionAction = 0
+	// EvaluatorPromotionAction_Warn 仅告警, 提交照常进行
+	EvaluatorPromotionAction_Warn EvaluatorPromotionAction = 1
+	// EvaluatorPromotionAction_Block 阻断提交
+	EvaluatorPromotionAction_Block EvaluatorPromotionAction = 2
+)
+
+// EvaluatorPromotionVerdict 回归检查结论
+type EvaluatorPromotionVerdict int32
+
+const (
+	EvaluatorPromotionVerdict_Unknown EvaluatorPromotionVerdict = 0
+	EvaluatorPromotionVerdict_Pass    EvaluatorPromotionVerdict = 1
+	EvaluatorPromotionVerdict_Warn    EvaluatorPromotionVerdict = 2
+	EvaluatorPromotionVerdict_Block   EvaluatorPromotionVerdict = 3
+	// EvaluatorPromotionVerdict_Skipped 无上一版本或无可回放的历史记录, 未做检查
+	EvaluatorPromotionVerdict_Skipped EvaluatorPromotionVerdict = 4
+)
+
+// 回归检查指标名, 用于 EvaluatorPromotionViolation.Metric
+const (
+	PromotionMetricMeanAbsDrift    = "mean_abs_drift"
+	PromotionMetricFlipRate        = "flip_rate"
+	PromotionMetricDraftFailRate   = "draft_fail_rate"
+	PromotionMetricLatencyIncrease = "latency_increase_ratio"
+	PromotionMetricTokenIncrease   = "token_increase_ratio"
+)
+
+// promotionFlipScoreDiffTolerance 未配置通过阈值时, 得分差超过该值视为翻转
+const promotionFlipScoreDiffTolerance = 1e-6
+
+// EvaluatorPromotionPolicy 评估器的版本晋级策略, 每个评估器至多一条
+type EvaluatorPromotionPolicy struct {
+	ID          int64
+	SpaceID     int64
+	EvaluatorID int64
+	Conf        *EvaluatorPromotionConf
+
+	BaseInfo *BaseInfo
+}
+
+// EvaluatorPromotionConf 晋级策略配置, 阈值为空表示不检查该项
+type EvaluatorPromotionConf struct {
+	// Enabled 为 true 时提交版本前执行回归检查
+	Enabled bool                     `json:"enabled"`
+	Action  EvaluatorPromotionAction `json:"action"`
+	// BaseVersion 作为基线的版本号, 为空取评估器当前最新版本
+	BaseVersion string `json:"base_version,omitempty"`
+	// SampleSize 回放的上一版本历史记录数, <=0 时取默认值, 不超过 MaxPromotionSampleSize
+	SampleSize int `json:"sample_size,omitempty"`
+	// Concurrency 回放并发数, <=0 时取默认值
+	Concurrency int `json:"concurrency,omitempty"`
+	// PassThreshold 判定通过的得分阈值 (score >= PassThreshold 视为通过), 前后版本通过与否不同即为翻转;
+	// 为空时得分有任何变化即视为翻转, 适用于离散打分
+	PassThreshold *float64 `json:"pass_threshold,omitempty"`
+
+	MaxMeanAbsDrift *float64 `json:"max_mean_abs_drift,omitempty"`
+	MaxFlipRate     *float64 `json:"max_flip_rate,omitempty"`
+	// MaxDraftFailRate 草稿回放失败的样本占比上限
+	MaxDraftFailRate *float64 `json:"max_draft_fail_rate,omitempty"`
+	// MaxLatencyIncreaseRatio / MaxTokenIncreaseRatio 平均耗时/token 相对上一版本的增幅上限, 如 0.5 表示最多增加 50%
+	MaxLatencyIncreaseRatio *float64 `json:"max_latency_increase_ratio,omitempty"`
+	MaxTokenIncreaseRatio   *float64 `json:"max_token_increase_ratio,omitempty"`
+}
+
+func (c *EvaluatorPromotionConf) GetSampleSize() int {
+	if c == nil || c.SampleSize <= 0 {
+		return DefaultPromotionSampleSize
+	}
+	if c.SampleSize > MaxPromotionSampleSize {
+		return MaxPromotionSampleSize
+	}
+	return c.SampleSize
+}
+
+func (c *EvaluatorPromotionConf) GetConcurrency() int {
+	if c == nil || c.Concurrency <= 0 {
+		return DefaultPromotionConcurrency
+	}
+	return c.Concurrency
+}
+
+func (c *EvaluatorPromotionConf) Validate() error {
+	if c == nil {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("promotion conf is nil"))
+	}
+	switch c.Action {
+	case EvaluatorPromotionAction_Warn, EvaluatorPromotionAction_Block:
+	default:
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("invalid promotion action %d", c.Action)))
+	}
+	for name, threshold := range map[string]*float64{
+		PromotionMetricMeanAbsDrift:    c.MaxMeanAbsDrift,
+		PromotionMetricFlipRate:        c.MaxFlipRate,
+		PromotionMetricDraftFailRate:   c.MaxDraftFailRate,
+		PromotionMetricLatencyIncrease: c.MaxLatencyIncreaseRatio,
+		PromotionMetricTokenIncrease:   c.MaxTokenIncreaseRatio,
+	} {
+		if threshold != nil && *threshold < 0 {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("threshold of %s must not be negative", name)))
+		}
+	}
+	return nil
+}
+
+// Tighten 在已开启的策略 c 上合并请求级配置 req, 只允许收紧: warn 可升级为 block, 各项阈值取更严者, 回放样本数取较大者;
+// 基线版本、通过阈值与并发沿用策略。请求级配置视为开启检查, 无策略时直接生效
+func (c *EvaluatorPromotionConf) Tighten(req *EvaluatorPromotionConf) *EvaluatorPromotionConf {
+	if req == nil {
+		return c
+	}
+	if c == nil {
+		merged := *req
+		merged.Enabled = true
+		return &merged
+	}
+	merged := *c
+	merged.Enabled = true
+	if req.Action == EvaluatorPromotionAction_Block {
+		merged.Action = EvaluatorPromotionAction_Block
+	}
+	if req.SampleSize > 0 && req.GetSampleSize() > c.GetSampleSize() {
+		merged.SampleSize = req.GetSampleSize()
+	}
+	merged.MaxMeanAbsDrift = stricterPromotionThreshold(c.MaxMeanAbsDrift, req.MaxMeanAbsDrift)
+	merged.MaxFlipRate = stricterPromotionThreshold(c.MaxFlipRate, req.MaxFlipRate)
+	merged.MaxDraftFailRate = stricterPromotionThreshold(c.MaxDraftFailRate, req.MaxDraftFailRate)
+	merged.MaxLatencyIncreaseRatio = stricterPromotionThreshold(c.MaxLatencyIncreaseRatio, req.MaxLatencyIncreaseRatio)
+	merged.MaxTokenIncreaseRatio = stricterPromotionThreshold(c.MaxTokenIncreaseRatio, req.MaxTokenIncreaseRatio)
+	return &merged
+}
+
+// stricterPromotionThreshold 上限类阈值取较小者, 为空表示不检查
+func stricterPromotionThreshold(a, b *float64) *float64 {
+	switch {
+	case a == nil:
+		return b
+	case b == nil || *a <= *b:
+		return a
+	default:
+		return b
+	}
+}
+
+// IsFlipped 判断同一输入前后两个版本的得分是否翻转
+func (c *EvaluatorPromotionConf) IsFlipped(baseScore, draftScore float64) bool {
+	if c == nil || c.PassThreshold == nil {
+		diff := baseScore - draftScore
+		return diff > promotionFlipScoreDiffTolerance || diff < -promotionFlipScoreDiffTolerance
+	}
+	return (baseScore >= *c.PassThreshold) != (draftScore >= *c.PassThreshold)
+}
+
+// EvaluatorPromotionReport 草稿相对上一版本的回归检查报告
+type EvaluatorPromotionReport struct {
+	EvaluatorID int64 `json:"evaluator_id"`
+	// BaseVersionID / BaseVersion 作为基线的版本, 未指定时为评估器当前最新版本
+	BaseVersionID int64                     `json:"base_version_id"`
+	BaseVersion   string                    `json:"base_version"`
+	Verdict       EvaluatorPromotionVerdict `json:"verdict"`
+
+	// SampleCnt 回放的历史记录数, PairedCnt 草稿回放成功且得分有效的样本数, 得分类指标均基于后者
+	SampleCnt    int `json:"sample_cnt"`
+	PairedCnt    int `json:"paired_cnt"`
+	DraftFailCnt int `json:"draft_fail_cnt"`
+
+	// MeanDrift 草稿得分减上一版本得分的均值, 正数表示整体打分变高
+	MeanDrift    float64 `json:"mean_drift"`
+	MeanAbsDrift float64 `json:"mean_abs_drift"`
+	MaxAbsDrift  float64 `json:"max_abs_drift"`
+	FlipCnt      int     `json:"flip_cnt"`
+	FlipRate     float64 `json:"flip_rate"`
+
+	BaseAvgLatencyMS  float64 `json:"base_avg_latency_ms"`
+	DraftAvgLatencyMS float64 `json:"draft_avg_latency_ms"`
+	BaseAvgTokens     float64 `json:"base_avg_tokens"`
+	DraftAvgTokens    float64 `json:"draft_avg_tokens"`
+	// LatencyChangeRatio / TokenChangeRatio 相对上一版本的变化比例, 上一版本无数据时为空
+	LatencyChangeRatio *float64 `json:"latency_change_ratio,omitempty"`
+	TokenChangeRatio   *float64 `json:"token_change_ratio,omitempty"`
+
+	Violations []*EvaluatorPromotionViolation `json:"violations,omitempty"`
+	// DriftItems 按漂移绝对值降序的样本
+	DriftItems []*EvaluatorPromotionItemResult `json:"drift_items,omitempty"`
+}
+
+// EvaluatorPromotionViolation 超出策略阈值的指标
+type EvaluatorPromotionViolation struct {
+	Metric    string  `json:"metric"`
+	Value     float64 `json:"value"`
+	Threshold float64 `json:"threshold"`
+}
+
+type EvaluatorPromotionItemResult struct {
+	RecordID       int64   `json:"record_id"`
+	ItemID         int64   `json:"item_id"`
+	TurnID         int64   `json:"turn_id"`
+	BaseScore      float64 `json:"base_score"`
+	DraftScore     float64 `json:"draft_score"`
+	Drift          float64 `json:"drift"`
+	Flipped        bool    `json:"flipped"`
+	DraftReasoning string  `json:"draft_reasoning,omitempty"`
+}
+
+// Summary 报告摘要, 用于阻断提交时的错误信息
+func (r *EvaluatorPromotionReport) Summary() string {
+	if r == nil {
+		return ""
+	}
+	s := fmt.Sprintf("regression check against version %s on %d samples:", r.BaseVersion, r.SampleCnt)
+	for _, v := range r.Violations {
+		s += fmt.Sprintf(" %s=%.4f (max %.4f);", v.Metric, v.Value, v.Threshold)
+	}
+	return s
+}
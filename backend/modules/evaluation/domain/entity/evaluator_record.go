@@ -30,11 +30,15 @@ type EvaluatorRecord struct {
 type EvaluatorRecordSourceType int32
 
 const (
-	EvaluatorRecordSourceTypeUnknown EvaluatorRecordSourceType = 0 // 旧数据, 语义同 Builtin
-	EvaluatorRecordSourceTypeBuiltin EvaluatorRecordSourceType = 1 // 注册评估器 (含别名实例)
-	EvaluatorRecordSourceTypeInline  EvaluatorRecordSourceType = 2 // target output 内嵌评分
+	EvaluatorRecordSourceTypeUnknown            EvaluatorRecordSourceType = 0 // 旧数据, 语义同 Builtin
+	EvaluatorRecordSourceTypeBuiltin            EvaluatorRecordSourceType = 1 // 注册评估器 (含别名实例)
+	EvaluatorRecordSourceTypeInline             EvaluatorRecordSourceType = 2 // target output 内嵌评分
+	EvaluatorRecordSourceTypeCompositeComponent EvaluatorRecordSourceType = 3 // 组合评估器的组件运行记录, 不挂到 turn 结果上
 )
 
+// EvaluatorRecordExtKeyCompositeRecordID 组件运行记录的 Ext 中回指组合评估器记录 ID 的 key
+const EvaluatorRecordExtKeyCompositeRecordID = "composite_record_id"
+
 type EvaluatorInputData struct {
 	HistoryMessages            []*Message          `json:"history_messages,omitempty"`
 	InputFields                map[string]*Content `json:"input_fields,omitempty"`
@@ -51,6 +55,8 @@ type EvaluatorOutputData struct {
 	Stdout            string                       `json:"stdout,omitempty"`
 	ExtraOutput       *EvaluatorExtraOutputContent `json:"extra_output,omitempty"`
 	Ext               map[string]string            `json:"ext,omitempty"`
+	// CompositeResult 仅组合评估器输出, 记录各组件得分与组件记录 ID
+	CompositeResult *CompositeEvaluatorResult `json:"composite_result,omitempty"`
 }
 
 type EvaluatorExtraOutputType string
@@ -0,0 +1,218 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+// CompositeStrategy 组合评估器合并组件得分的策略
+type CompositeStrategy string
+
+const (
+	// CompositeStrategyMean 组件得分算术平均
+	CompositeStrategyMean CompositeStrategy = "mean"
+	// CompositeStrategyMedian 组件得分中位数, 偶数个时取中间两数均值
+	CompositeStrategyMedian CompositeStrategy = "median"
+	// CompositeStrategyMajority 多数投票, 取出现次数最多的得分, 票数相同时取较低分
+	CompositeStrategyMajority CompositeStrategy = "majority"
+	// CompositeStrategyMin 组件得分最小值, 任一裁判不通过即不通过
+	CompositeStrategyMin CompositeStrategy = "min"
+	// CompositeStrategyWeighted 按 CompositeComponent.Weight 加权平均
+	CompositeStrategyWeighted CompositeStrategy = "weighted"
+	// CompositeStrategyCode 自定义代码合并, 组件结果以 JSON 文本注入代码输入字段 CompositeCodeInputFieldKey
+	CompositeStrategyCode CompositeStrategy = "code"
+)
+
+const (
+	// MaxCompositeComponentCnt 单个组合评估器引用的组件数上限
+	MaxCompositeComponentCnt = 10
+	// CompositeCodeInputFieldKey 自定义代码策略下组件结果所在的输入字段
+	CompositeCodeInputFieldKey = "component_results"
+)
+
+// CompositeComponent 组合评估器引用的组件评估器版本
+type CompositeComponent struct {
+	EvaluatorVersionID int64 `json:"evaluator_version_id"`
+	// Weight 仅 weighted 策略使用, 须大于 0
+	Weight float64 `json:"weight,omitempty"`
+}
+
+// CompositeConfig 组合评估器配置
+type CompositeConfig struct {
+	Components []*CompositeComponent `json:"components"`
+	Strategy   CompositeStrategy     `json:"strategy"`
+	// MinSuccessCnt 参与合并所需的最少成功组件数, 不足时组合评估失败; 为 0 时要求全部组件成功
+	MinSuccessCnt int `json:"min_success_cnt,omitempty"`
+
+	// CodeContent / LanguageType code 策略使用的合并代码, 写法同 Code 评估器的 exec_evaluation
+	CodeContent  string       `json:"code_content,omitempty"`
+	LanguageType LanguageType `json:"language_type,omitempty"`
+}
+
+// GetMinSuccessCnt 实际生效的最少成功组件数
+func (c *CompositeConfig) GetMinSuccessCnt() int {
+	if c == nil {
+		return 0
+	}
+	if c.MinSuccessCnt <= 0 || c.MinSuccessCnt > len(c.Components) {
+		return len(c.Components)
+	}
+	return c.MinSuccessCnt
+}
+
+// GetComponentVersionIDs 组件评估器版本 ID, 与 Components 顺序一致
+func (c *CompositeConfig) GetComponentVersionIDs() []int64 {
+	if c == nil {
+		return nil
+	}
+	ids := make([]int64, 0, len(c.Components))
+	for _, component := range c.Components {
+		ids = append(ids, component.EvaluatorVersionID)
+	}
+	return ids
+}
+
+func (c *CompositeConfig) Validate() error {
+	if c == nil {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("composite config is nil"))
+	}
+	if len(c.Components) == 0 || len(c.Components) > MaxCompositeComponentCnt {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("composite component count must be in [1, %d]", MaxCompositeComponentCnt)))
+	}
+	seen := make(map[int64]bool, len(c.Components))
+	for _, component := range c.Components {
+		if component == nil || component.EvaluatorVersionID <= 0 {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("composite component evaluator version id is required"))
+		}
+		if seen[component.EvaluatorVersionID] {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("duplicated composite component %d", component.EvaluatorVersionID)))
+		}
+		seen[component.EvaluatorVersionID] = true
+		if c.Strategy == CompositeStrategyWeighted && component.Weight <= 0 {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("weight of composite component %d must be positive", component.EvaluatorVersionID)))
+		}
+	}
+	if c.MinSuccessCnt < 0 {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("min success count must not be negative"))
+	}
+	switch c.Strategy {
+	case CompositeStrategyMean, CompositeStrategyMedian, CompositeStrategyMajority, CompositeStrategyMin, CompositeStrategyWeighted:
+	case CompositeStrategyCode:
+		if c.CodeContent == "" {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("code content is required for code strategy"))
+		}
+		// 同 CodeEvaluatorVersion.ValidateBaseInfo, 标准化后写回
+		langType := normalizeLanguageType(c.LanguageType)
+		if _, ok := LanguageTypeSet[langType]; !ok {
+			return errorx.NewByCode(errno.InvalidLanguageTypeCode, errorx.WithExtraMsg(fmt.Sprintf("invalid language type: %s", c.LanguageType)))
+		}
+		c.LanguageType = langType
+	default:
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("unsupported composite strategy %q", c.Strategy)))
+	}
+	return nil
+}
+
+// CompositeEvaluatorVersion 组合评估器版本, 并行运行多个组件评估器版本并按 Strategy 合并为一个得分
+type CompositeEvaluatorVersion struct {
+	ID            int64         `json:"id"`
+	SpaceID       int64         `json:"space_id"`
+	EvaluatorType EvaluatorType `json:"evaluator_type"`
+	EvaluatorID   int64         `json:"evaluator_id"`
+	Description   string        `json:"description"`
+	Version       string        `json:"version"`
+	BaseInfo      *BaseInfo     `json:"base_info"`
+
+	// InputSchemas 组合评估器的输入原样透传给每个组件, 应覆盖各组件所需字段
+	InputSchemas    []*ArgsSchema    `json:"input_schemas"`
+	CompositeConfig *CompositeConfig `json:"composite_config"`
+}
+
+func (do *CompositeEvaluatorVersion) SetID(id int64) {
+	do.ID = id
+}
+
+func (do *CompositeEvaluatorVersion) GetID() int64 {
+	return do.ID
+}
+
+func (do *CompositeEvaluatorVersion) SetEvaluatorID(evaluatorID int64) {
+	do.EvaluatorID = evaluatorID
+}
+
+func (do *CompositeEvaluatorVersion) GetEvaluatorID() int64 {
+	return do.EvaluatorID
+}
+
+func (do *CompositeEvaluatorVersion) SetSpaceID(spaceID int64) {
+	do.SpaceID = spaceID
+}
+
+func (do *CompositeEvaluatorVersion) GetSpaceID() int64 {
+	return do.SpaceID
+}
+
+func (do *CompositeEvaluatorVersion) GetVersion() string {
+	return do.Version
+}
+
+func (do *CompositeEvaluatorVersion) SetVersion(version string) {
+	do.Version = version
+}
+
+func (do *CompositeEvaluatorVersion) SetDescription(description string) {
+	do.Description = description
+}
+
+func (do *CompositeEvaluatorVersion) GetDescription() string {
+	return do.Description
+}
+
+func (do *CompositeEvaluatorVersion) SetBaseInfo(baseInfo *BaseInfo) {
+	do.BaseInfo = baseInfo
+}
+
+func (do *CompositeEvaluatorVersion) GetBaseInfo() *BaseInfo {
+	return do.BaseInfo
+}
+
+// ValidateInput 输入由各组件自行校验, 此处只校验非空
+func (do *CompositeEvaluatorVersion) ValidateInput(input *EvaluatorInputData) error {
+	if input == nil {
+		return errorx.NewByCode(errno.InvalidInputDataCode, errorx.WithExtraMsg("input data is nil"))
+	}
+	return nil
+}
+
+// ValidateBaseInfo 校验评估器基本信息
+func (do *CompositeEvaluatorVersion) ValidateBaseInfo() error {
+	if do == nil {
+		return errorx.NewByCode(errno.EvaluatorNotExistCode, errorx.WithExtraMsg("evaluator_version is nil"))
+	}
+	return do.CompositeConfig.Validate()
+}
+
+// CompositeEvaluatorResult 组合评估器的合并明细, 随组合评估器的 EvaluatorOutputData 一起落库
+type CompositeEvaluatorResult struct {
+	Strategy   CompositeStrategy           `json:"strategy"`
+	Components []*CompositeComponentResult `json:"components"`
+}
+
+// CompositeComponentResult 单个组件的运行结果
+type CompositeComponentResult struct {
+	EvaluatorVersionID int64              `json:"evaluator_version_id"`
+	Weight             float64            `json:"weight,omitempty"`
+	Status             EvaluatorRunStatus `json:"status"`
+	Score              *float64           `json:"score,omitempty"`
+	// RecordID 组件 EvaluatorRecord ID, 仅 RunEvaluator 落库组件记录后回填
+	RecordID int64 `json:"record_id,omitempty"`
+
+	// Output / TraceID 组件完整输出, 供落库组件记录使用, 不随组合评估器的输出序列化
+	Output  *EvaluatorOutputData `json:"-"`
+	TraceID string               `json:"-"`
+}
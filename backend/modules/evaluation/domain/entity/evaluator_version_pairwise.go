@@ -0,0 +1,185 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/bytedance/gg/gslice"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+// 成对评估器的固定输入变量, judge prompt 中以 {{output_a}} / {{output_b}} 引用两侧的评测对象输出
+const (
+	PairwiseInputFieldKeyOutputA = "output_a"
+	PairwiseInputFieldKeyOutputB = "output_b"
+)
+
+// 成对评估器结果在 EvaluatorOutputData.Ext 中的 key
+const (
+	// PairwiseExtKeyPreference 位置交换合并后的最终偏好
+	PairwiseExtKeyPreference = "pairwise_preference"
+	// PairwiseExtKeyPreferenceAB 按 (A, B) 顺序提问的偏好
+	PairwiseExtKeyPreferenceAB = "pairwise_preference_ab"
+	// PairwiseExtKeyPreferenceBA 按 (B, A) 顺序提问、并已映射回原始 A/B 标签的偏好
+	PairwiseExtKeyPreferenceBA = "pairwise_preference_ba"
+)
+
+// PairwisePreference 成对比较偏好
+type PairwisePreference string
+
+const (
+	PairwisePreferenceA   PairwisePreference = "A"
+	PairwisePreferenceB   PairwisePreference = "B"
+	PairwisePreferenceTie PairwisePreference = "tie"
+)
+
+// Swap 交换 A/B 标签, 用于把位置交换后的判定映射回原始顺序
+func (p PairwisePreference) Swap() PairwisePreference {
+	switch p {
+	case PairwisePreferenceA:
+		return PairwisePreferenceB
+	case PairwisePreferenceB:
+		return PairwisePreferenceA
+	default:
+		return p
+	}
+}
+
+// Score 偏好对应的分数: A 胜=1, 平局=0.5, B 胜=0
+func (p PairwisePreference) Score() float64 {
+	switch p {
+	case PairwisePreferenceA:
+		return 1
+	case PairwisePreferenceB:
+		return 0
+	default:
+		return 0.5
+	}
+}
+
+// PairwiseEvaluatorVersion 成对 (A-vs-B) LLM 裁判评估器版本。
+// 同一评测集 turn 下两个实验的评测对象输出分别填入 output_a / output_b, 由 judge prompt 判定哪一侧更好。
+type PairwiseEvaluatorVersion struct {
+	ID            int64         `json:"id"`
+	SpaceID       int64         `json:"space_id"`
+	EvaluatorType EvaluatorType `json:"evaluator_type"`
+	EvaluatorID   int64         `json:"evaluator_id"`
+	Description   string        `json:"description"`
+	Version       string        `json:"version"`
+	BaseInfo      *BaseInfo     `json:"base_info"`
+
+	InputSchemas []*ArgsSchema `json:"input_schemas"`
+	// MessageList judge prompt, 要求模型输出 {"preference": "A"|"B"|"tie", "reason": "..."}
+	MessageList []*Message   `json:"message_list"`
+	ModelConfig *ModelConfig `json:"model_config"`
+	// DisablePositionSwap 默认会交换 A/B 位置再问一次以抵消位置偏差, 两次判定不一致时记为平局; 置 true 只问一次
+	DisablePositionSwap bool `json:"disable_position_swap,omitempty"`
+}
+
+func (do *PairwiseEvaluatorVersion) SetID(id int64) {
+	do.ID = id
+}
+
+func (do *PairwiseEvaluatorVersion) GetID() int64 {
+	return do.ID
+}
+
+func (do *PairwiseEvaluatorVersion) SetEvaluatorID(evaluatorID int64) {
+	do.EvaluatorID = evaluatorID
+}
+
+func (do *PairwiseEvaluatorVersion) GetEvaluatorID() int64 {
+	return do.EvaluatorID
+}
+
+func (do *PairwiseEvaluatorVersion) SetSpaceID(spaceID int64) {
+	do.SpaceID = spaceID
+}
+
+func (do *PairwiseEvaluatorVersion) GetSpaceID() int64 {
+	return do.SpaceID
+}
+
+func (do *PairwiseEvaluatorVersion) GetVersion() string {
+	return do.Version
+}
+
+func (do *PairwiseEvaluatorVersion) SetVersion(version string) {
+	do.Version = version
+}
+
+func (do *PairwiseEvaluatorVersion) SetDescription(description string) {
+	do.Description = description
+}
+
+func (do *PairwiseEvaluatorVersion) GetDescription() string {
+	return do.Description
+}
+
+func (do *PairwiseEvaluatorVersion) SetBaseInfo(baseInfo *BaseInfo) {
+	do.BaseInfo = baseInfo
+}
+
+func (do *PairwiseEvaluatorVersion) GetBaseInfo() *BaseInfo {
+	return do.BaseInfo
+}
+
+func (do *PairwiseEvaluatorVersion) GetModelConfig() *ModelConfig {
+	return do.ModelConfig
+}
+
+// ValidateInput 校验输入数据, output_a / output_b 必填, 其余字段按 InputSchemas 校验
+func (do *PairwiseEvaluatorVersion) ValidateInput(input *EvaluatorInputData) error {
+	if input == nil {
+		return errorx.NewByCode(errno.InvalidInputDataCode, errorx.WithExtraMsg("input data is nil"))
+	}
+	for _, key := range []string{PairwiseInputFieldKeyOutputA, PairwiseInputFieldKeyOutputB} {
+		if input.InputFields[key] == nil {
+			return errorx.NewByCode(errno.InvalidInputDataCode, errorx.WithExtraMsg(fmt.Sprintf("pairwise input field %s is required", key)))
+		}
+	}
+	inputSchemaMap := make(map[string]*ArgsSchema)
+	for _, argsSchema := range do.InputSchemas {
+		inputSchemaMap[gptr.Indirect(argsSchema.Key)] = argsSchema
+	}
+	for fieldKey, content := range input.InputFields {
+		if content == nil {
+			continue
+		}
+		if argsSchema, ok := inputSchemaMap[fieldKey]; ok {
+			if !gslice.Contains(argsSchema.SupportContentTypes, gptr.Indirect(content.ContentType)) {
+				return errorx.NewByCode(errno.ContentTypeNotSupportedCode, errorx.WithExtraMsg(fmt.Sprintf("content type %v not supported", gptr.Indirect(content.ContentType))))
+			}
+			if gptr.Indirect(content.ContentType) == ContentTypeText {
+				valid, err := json.ValidateJSONSchema(gptr.Indirect(argsSchema.JsonSchema), gptr.Indirect(content.Text))
+				if err != nil || !valid {
+					return errorx.NewByCode(errno.ContentSchemaInvalidCode, errorx.WithExtraMsg(fmt.Sprintf("content %v does not validate with expected schema: %v", gptr.Indirect(content.Text), gptr.Indirect(argsSchema.JsonSchema))))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateBaseInfo 校验评估器基本信息
+func (do *PairwiseEvaluatorVersion) ValidateBaseInfo() error {
+	if do == nil {
+		return errorx.NewByCode(errno.EvaluatorNotExistCode, errorx.WithExtraMsg("evaluator_version is nil"))
+	}
+	if len(do.MessageList) == 0 {
+		return errorx.NewByCode(errno.InvalidMessageListCode, errorx.WithExtraMsg("message list is empty"))
+	}
+	if do.ModelConfig == nil {
+		return errorx.NewByCode(errno.InvalidModelConfigCode, errorx.WithExtraMsg("model config is nil"))
+	}
+	if do.ModelConfig.ModelID == nil && do.ModelConfig.ProviderModelID == nil {
+		return errorx.NewByCode(errno.InvalidModelConfigCode, errorx.WithExtraMsg("model id is empty"))
+	}
+	return nil
+}
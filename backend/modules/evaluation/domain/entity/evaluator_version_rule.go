@@ -0,0 +1,221 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+// RuleType 规则评估器支持的规则类型
+type RuleType string
+
+const (
+	// RuleTypeExactMatch 与参考答案完全一致得 1 分, 否则 0 分
+	RuleTypeExactMatch RuleType = "exact_match"
+	// RuleTypeRegexMatch 匹配正则 Pattern 得 1 分
+	RuleTypeRegexMatch RuleType = "regex_match"
+	// RuleTypeJSONSchema 输出为合法 JSON 且满足 JSONSchema 得 1 分; JSONSchema 为空时只校验 JSON 合法性
+	RuleTypeJSONSchema RuleType = "json_schema"
+	// RuleTypeContains 关键词包含, 见 RuleConfig.ContainsAll
+	RuleTypeContains RuleType = "contains"
+	// RuleTypeNumericTolerance 数值与参考答案之差在容差内得 1 分
+	RuleTypeNumericTolerance RuleType = "numeric_tolerance"
+	// RuleTypeLevenshtein 归一化编辑相似度, 取值 [0, 1]
+	RuleTypeLevenshtein RuleType = "levenshtein"
+	// RuleTypeRougeL ROUGE-L F1, 取值 [0, 1]
+	RuleTypeRougeL RuleType = "rouge_l"
+	// RuleTypeBLEU 句子级 BLEU, 取值 [0, 1]
+	RuleTypeBLEU RuleType = "bleu"
+)
+
+const (
+	DefaultRuleActualFieldKey    = "actual_output"
+	DefaultRuleReferenceFieldKey = "reference_output"
+	// MaxRuleRegexPatternLen regex_match 的 Pattern 长度上限 (字节)
+	MaxRuleRegexPatternLen = 1024
+)
+
+// ruleTypesNeedReference 需要参考答案的规则类型
+var ruleTypesNeedReference = map[RuleType]bool{
+	RuleTypeExactMatch:       true,
+	RuleTypeNumericTolerance: true,
+	RuleTypeLevenshtein:      true,
+	RuleTypeRougeL:           true,
+	RuleTypeBLEU:             true,
+}
+
+// RuleConfig 规则评估器的声明式配置
+type RuleConfig struct {
+	RuleType RuleType `json:"rule_type"`
+	// ActualFieldKey 待评估内容所在的输入字段, 默认 actual_output
+	ActualFieldKey string `json:"actual_field_key,omitempty"`
+	// ReferenceFieldKey 参考答案所在的输入字段, 默认 reference_output
+	ReferenceFieldKey string `json:"reference_field_key,omitempty"`
+	// IgnoreCase / TrimSpace 文本比较前的归一化, 对 exact_match / contains / levenshtein 生效
+	IgnoreCase bool `json:"ignore_case,omitempty"`
+	TrimSpace  bool `json:"trim_space,omitempty"`
+
+	// Pattern regex_match 使用的正则
+	Pattern string `json:"pattern,omitempty"`
+	// JSONSchema json_schema 使用的 schema
+	JSONSchema string `json:"json_schema,omitempty"`
+	// Keywords contains 使用的关键词
+	Keywords []string `json:"keywords,omitempty"`
+	// ContainsAll 为 true 时得分为命中关键词占比, 为 false 时命中任一关键词即得 1 分
+	ContainsAll bool `json:"contains_all,omitempty"`
+	// Tolerance numeric_tolerance 的容差; Relative 为 true 时按 |reference| * Tolerance 计算
+	Tolerance float64 `json:"tolerance,omitempty"`
+	Relative  bool    `json:"relative,omitempty"`
+	// BLEUMaxN bleu 的最大 n-gram 阶数, 默认 4
+	BLEUMaxN int `json:"bleu_max_n,omitempty"`
+	// PassThreshold 非空时将相似度类得分二值化: 得分 >= PassThreshold 为 1, 否则为 0
+	PassThreshold *float64 `json:"pass_threshold,omitempty"`
+}
+
+func (c *RuleConfig) GetActualFieldKey() string {
+	if c == nil || c.ActualFieldKey == "" {
+		return DefaultRuleActualFieldKey
+	}
+	return c.ActualFieldKey
+}
+
+func (c *RuleConfig) GetReferenceFieldKey() string {
+	if c == nil || c.ReferenceFieldKey == "" {
+		return DefaultRuleReferenceFieldKey
+	}
+	return c.ReferenceFieldKey
+}
+
+// NeedReference 规则是否需要参考答案
+func (c *RuleConfig) NeedReference() bool {
+	return c != nil && ruleTypesNeedReference[c.RuleType]
+}
+
+func (c *RuleConfig) Validate() error {
+	if c == nil {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("rule config is nil"))
+	}
+	switch c.RuleType {
+	case RuleTypeExactMatch, RuleTypeJSONSchema, RuleTypeLevenshtein, RuleTypeRougeL:
+	case RuleTypeRegexMatch:
+		if c.Pattern == "" {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("regex pattern is required"))
+		}
+		if len(c.Pattern) > MaxRuleRegexPatternLen {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("regex pattern exceeds %d bytes", MaxRuleRegexPatternLen)))
+		}
+		if _, err := regexp.Compile(c.Pattern); err != nil {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("invalid regex pattern: %v", err)))
+		}
+	case RuleTypeContains:
+		if len(c.Keywords) == 0 {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("keywords are required"))
+		}
+	case RuleTypeNumericTolerance:
+		if c.Tolerance < 0 {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("tolerance must not be negative"))
+		}
+	case RuleTypeBLEU:
+		if c.BLEUMaxN < 0 {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("bleu max n must not be negative"))
+		}
+	default:
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("unsupported rule type %q", c.RuleType)))
+	}
+	if c.PassThreshold != nil && (*c.PassThreshold < 0 || *c.PassThreshold > 1) {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("pass threshold must be in [0, 1]"))
+	}
+	return nil
+}
+
+// RuleEvaluatorVersion 规则评估器版本, 在评测服务进程内按 RuleConfig 直接计算得分
+type RuleEvaluatorVersion struct {
+	ID            int64         `json:"id"`
+	SpaceID       int64         `json:"space_id"`
+	EvaluatorType EvaluatorType `json:"evaluator_type"`
+	EvaluatorID   int64         `json:"evaluator_id"`
+	Description   string        `json:"description"`
+	Version       string        `json:"version"`
+	BaseInfo      *BaseInfo     `json:"base_info"`
+
+	InputSchemas []*ArgsSchema `json:"input_schemas"`
+	RuleConfig   *RuleConfig   `json:"rule_config"`
+}
+
+func (do *RuleEvaluatorVersion) SetID(id int64) {
+	do.ID = id
+}
+
+func (do *RuleEvaluatorVersion) GetID() int64 {
+	return do.ID
+}
+
+func (do *RuleEvaluatorVersion) SetEvaluatorID(evaluatorID int64) {
+	do.EvaluatorID = evaluatorID
+}
+
+func (do *RuleEvaluatorVersion) GetEvaluatorID() int64 {
+	return do.EvaluatorID
+}
+
+func (do *RuleEvaluatorVersion) SetSpaceID(spaceID int64) {
+	do.SpaceID = spaceID
+}
+
+func (do *RuleEvaluatorVersion) GetSpaceID() int64 {
+	return do.SpaceID
+}
+
+func (do *RuleEvaluatorVersion) GetVersion() string {
+	return do.Version
+}
+
+func (do *RuleEvaluatorVersion) SetVersion(version string) {
+	do.Version = version
+}
+
+func (do *RuleEvaluatorVersion) SetDescription(description string) {
+	do.Description = description
+}
+
+func (do *RuleEvaluatorVersion) GetDescription() string {
+	return do.Description
+}
+
+func (do *RuleEvaluatorVersion) SetBaseInfo(baseInfo *BaseInfo) {
+	do.BaseInfo = baseInfo
+}
+
+func (do *RuleEvaluatorVersion) GetBaseInfo() *BaseInfo {
+	return do.BaseInfo
+}
+
+// ValidateInput 校验规则所需的输入字段是否存在
+func (do *RuleEvaluatorVersion) ValidateInput(input *EvaluatorInputData) error {
+	if input == nil {
+		return errorx.NewByCode(errno.InvalidInputDataCode, errorx.WithExtraMsg("input data is nil"))
+	}
+	keys := []string{do.RuleConfig.GetActualFieldKey()}
+	if do.RuleConfig.NeedReference() {
+		keys = append(keys, do.RuleConfig.GetReferenceFieldKey())
+	}
+	for _, key := range keys {
+		if input.InputFields[key] == nil {
+			return errorx.NewByCode(errno.InvalidInputDataCode, errorx.WithExtraMsg(fmt.Sprintf("rule input field %s is required", key)))
+		}
+	}
+	return nil
+}
+
+// ValidateBaseInfo 校验评估器基本信息
+func (do *RuleEvaluatorVersion) ValidateBaseInfo() error {
+	if do == nil {
+		return errorx.NewByCode(errno.EvaluatorNotExistCode, errorx.WithExtraMsg("evaluator_version is nil"))
+	}
+	return do.RuleConfig.Validate()
+}
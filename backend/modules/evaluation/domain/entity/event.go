@@ -38,6 +38,10 @@ type ExptItemEvalEvent struct {
 	EvalSetItemID               int64
 	AsyncReportTrigger          bool
 	AsyncEvaluatorReportTrigger bool
+	// ResumeFromCheckpoint 调度器按检查点续跑僵尸 item 投递的事件, 复用 turn run log 中已有的结果
+	ResumeFromCheckpoint bool
+	// Attempt 投递时 item run log 的执行轮次, 被续跑接替的旧轮次事件及其异步回调不再写入结果
+	Attempt int32
 
 	CreateAt      int64
 	RetryTimes    int
@@ -76,6 +80,9 @@ func (e *ExptItemEvalEvent) IgnoreExistedEvaluatorResult(ctx context.Context) bo
 }
 
 func (e *ExptItemEvalEvent) ignoreExistedResult() bool {
+	if e.ResumeFromCheckpoint {
+		return false
+	}
 	return (e.ExptRunMode == EvaluationModeRetryItems || e.ExptRunMode == EvaluationModeRetryAll) && e.RetryTimes == 0
 }
 
@@ -194,6 +201,8 @@ type ExportCSVEvent struct {
 	CreatedAt   int64
 	// ExportColumns 与 ExportExptResultRequest.export_columns 一致；nil 表示全量列；非 nil 为白名单（子字段 nil/[] 均不导出该组）
 	ExportColumns *ExptResultExportColumnSpec `json:"export_columns,omitempty"`
+	// ExportFormat 导出文件格式；空值（历史事件）按 CSV 处理
+	ExportFormat ExptResultExportFormat `json:"export_format,omitempty"`
 }
 
 type ExptLifecycleEvent struct {
@@ -225,4 +234,10 @@ type ExportScene int
 const (
 	ExportSceneDefault         ExportScene = 0
 	ExportSceneInsightAnalysis ExportScene = 1
+	// ExportScenePairwiseRank 复用导出事件异步执行成对排名, ExportID 为排名记录 ID
+	ExportScenePairwiseRank ExportScene = 2
+	// ExportSceneEvaluatorCalibration 复用导出事件异步执行评估器校准, ExportID 为校准记录 ID
+	ExportSceneEvaluatorCalibration ExportScene = 3
+	// ExportSceneEvaluationSetDedup 复用导出事件异步执行评测集去重检测, ExportID 为去重任务 ID
+	ExportSceneEvaluationSetDedup ExportScene = 4
 )
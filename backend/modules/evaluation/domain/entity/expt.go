@@ -216,6 +216,9 @@ type Experiment struct {
 	Visibility       Visibility            // 实验模板可见性，默认为空，可见
 	ThreadID         *string               // 关联的智能评测会话ID
 	NotificationConf *ExptNotificationConf // 通知配置（JSON序列化存储）
+
+	QualityGateResult *ExptQualityGateResult // 质量门禁评估结果（消费实验终态事件时异步写入，JSON序列化存储）
+	SamplingResult    *ExptSamplingResult    // 抽样结果（抽样实验启动时写入，JSON序列化存储）
 }
 
 func (e *Experiment) ToEvaluatorRefDO() []*ExptEvaluatorRef {
@@ -348,6 +351,20 @@ type EvaluationConfiguration struct {
 	// RunModeConfig 实验级跑法配置 (仅 SandboxAgent 评测对象 + MultiSetConfig 实验生效)。
 	// 序列化进 experiment.eval_conf; 提交时展开到各 item 的 ItemTargetConf.RunConf 兜底默认值。
 	RunModeConfig *RunModeConfig `json:"run_mode_config,omitempty"`
+
+	// QualityGateConf 质量门禁规则, 实验结束时评估, 结果写入 Experiment.QualityGateResult
+	QualityGateConf *ExptQualityGateConf `json:"quality_gate_conf,omitempty"`
+
+	// CostConf 成本核算与花费上限配置
+	CostConf *ExptCostConf `json:"cost_conf,omitempty"`
+	// ResultCacheConf 评测对象/评估器结果缓存配置, 为空不启用
+	ResultCacheConf *ExptResultCacheConf `json:"result_cache_conf,omitempty"`
+	// SamplingConf 抽样配置, 为空运行全量评测集, 抽样结果写入 Experiment.SamplingResult
+	SamplingConf *ExptSamplingConf `json:"sampling_conf,omitempty"`
+	// TrialConf 重复试验配置, 为空每个 turn 仅执行一次
+	TrialConf *ExptTrialConf `json:"trial_conf,omitempty"`
+	// UserSimulatorConf 模拟用户配置, 为空按评测集 turn 执行; 开启后由模拟用户驱动多轮对话
+	UserSimulatorConf *ExptUserSimulatorConf `json:"user_simulator_conf,omitempty"`
 }
 
 // RunMode 实验级评测模式 (跑法)。与 runtime domain RunMode / IDL ExptRunMode 对齐。
@@ -717,6 +734,20 @@ type FieldConf struct {
 	FieldName string
 	FromField string
 	Value     string
+	// Expr 字段映射表达式 (语法见 pkg/fieldexpr), 如 "template('Q: {{ $.input }}')"; 非空时优先于 FromField 与 Value
+	Expr string
+}
+
+// IsExpr 是否按字段映射表达式取值
+func (f *FieldConf) IsExpr() bool {
+	return f != nil && f.Expr != ""
+}
+
+func (f *FieldConf) GetExpr() string {
+	if f == nil {
+		return ""
+	}
+	return f.Expr
 }
 
 type ExptUpdateFields struct {
@@ -747,6 +778,8 @@ type ItemTurnID struct {
 
 type StatsCntArithOp struct {
 	OpStatusCnt map[ItemRunState]int
+	// CostDelta 本次落库 item 的 token 用量与花费增量
+	CostDelta *ExptTurnCost
 }
 
 type TupleExpt struct {
@@ -29,6 +29,16 @@ type AnnotateRecord struct {
 	AnnotateData *AnnotateData
 	BaseInfo     *BaseInfo
 	TagValueID   int64
+	// ExptTurnResultID 标注所属的 turn 结果; 同一 turn/tag 支持多名标注者, 首条记录挂 ref, 其余记录按此字段归属
+	ExptTurnResultID int64
+}
+
+// Annotator 标注者 ID, 取自 BaseInfo.CreatedBy
+func (r *AnnotateRecord) Annotator() string {
+	if r == nil || r.BaseInfo == nil || r.BaseInfo.CreatedBy == nil || r.BaseInfo.CreatedBy.UserID == nil {
+		return ""
+	}
+	return *r.BaseInfo.CreatedBy.UserID
 }
 
 type AnnotateData struct {
@@ -85,3 +95,31 @@ const (
 	TagStatusInactive   = "inactive"
 	TagStatusDeprecated = "deprecated"
 )
+
+// AnnotationAgreement 人工标注标签的一致性度量, 仅统计被 2 名及以上标注者标注过的 turn。
+// 分类/布尔标签按选项做名义尺度计算, 连续数值标签按区间尺度计算 (Cohen/Fleiss kappa 仅适用于分类/布尔)。
+type AnnotationAgreement struct {
+	AnnotatorCnt int `json:"annotator_cnt"`
+	// UnitCnt 被 2 名及以上标注者标注过的 turn 数
+	UnitCnt int `json:"unit_cnt"`
+	// CohenKappa 两两标注者 Cohen's kappa 的均值 (标注者多于 2 名时即 Light's kappa)
+	CohenKappa        *float64 `json:"cohen_kappa,omitempty"`
+	FleissKappa       *float64 `json:"fleiss_kappa,omitempty"`
+	KrippendorffAlpha *float64 `json:"krippendorff_alpha,omitempty"`
+	// JudgeAgreements 实验中各评估器实例与人工标注 (多人时取均值/众数) 的一致性, 仅数值与布尔标签计算
+	JudgeAgreements []*JudgeHumanAgreement `json:"judge_agreements,omitempty"`
+}
+
+// JudgeHumanAgreement 单个评估器实例得分与人工标注的一致性
+type JudgeHumanAgreement struct {
+	EvaluatorVersionID int64  `json:"evaluator_version_id"`
+	Alias              string `json:"alias,omitempty"`
+	// PairedCnt 同时具备评估器得分与人工标注的 turn 数
+	PairedCnt          int      `json:"paired_cnt"`
+	PearsonCorrelation *float64 `json:"pearson_correlation,omitempty"`
+	MeanAbsoluteError  *float64 `json:"mean_absolute_error,omitempty"`
+	// KrippendorffAlpha 将评估器视为一名额外标注者的区间尺度 alpha
+	KrippendorffAlpha *float64 `json:"krippendorff_alpha,omitempty"`
+	// CohenKappa 仅布尔标签: 评估器得分按 0.5 二值化后与人工标注的 kappa
+	CohenKappa *float64 `json:"cohen_kappa,omitempty"`
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+)
+
+const defaultExptMaxResumeTimes = 1
+
+// ExptCheckpointConf 实验执行检查点配置 (configer ExptExecConf.checkpoint_conf)。
+// 开启后 turn 执行过程中每完成一步即把结果引用写入 turn run log, 服务重启导致 item 僵尸时,
+// 调度器按检查点重新投递 item 续跑, 已完成的评测对象/评估器结果直接复用, 异步调用重新挂接回调。
+type ExptCheckpointConf struct {
+	Enable bool `json:"enable" mapstructure:"enable"`
+	// MaxResumeTimes 单 item 在一次运行中最多续跑次数, 超过后按原僵尸逻辑置为失败, 默认 1
+	MaxResumeTimes int `json:"max_resume_times" mapstructure:"max_resume_times"`
+}
+
+func (c *ExptCheckpointConf) IsEnabled() bool {
+	return c != nil && c.Enable
+}
+
+func (c *ExptCheckpointConf) GetMaxResumeTimes() int {
+	if c != nil && c.MaxResumeTimes > 0 {
+		return c.MaxResumeTimes
+	}
+	return defaultExptMaxResumeTimes
+}
+
+type ExptTurnCheckpointStage string
+
+const (
+	// ExptTurnCheckpointStageTargetDone 评测对象已完成
+	ExptTurnCheckpointStageTargetDone ExptTurnCheckpointStage = "target_done"
+	// ExptTurnCheckpointStageTargetAsyncPending 异步评测对象已发起, 等待回调
+	ExptTurnCheckpointStageTargetAsyncPending ExptTurnCheckpointStage = "target_async_pending"
+	// ExptTurnCheckpointStageEvaluating 部分评估器已完成
+	ExptTurnCheckpointStageEvaluating ExptTurnCheckpointStage = "evaluating"
+	// ExptTurnCheckpointStageEvaluatorAsyncPending 异步评估器已发起, 等待回调
+	ExptTurnCheckpointStageEvaluatorAsyncPending ExptTurnCheckpointStage = "evaluator_async_pending"
+)
+
+// ExptTurnCheckpoint turn 执行检查点, 序列化存储在 expt_turn_result_run_log.checkpoint。
+// 已完成步骤的结果引用写在 run log 的 target_result_id / evaluator_result_ids 中, 检查点只记录阶段与待回调的 invoke_id。
+type ExptTurnCheckpoint struct {
+	Stage ExptTurnCheckpointStage `json:"stage,omitempty"`
+	// PendingInvokeIDs 等待异步回调的 invoke_id, 即异步评测对象/评估器的 record id
+	PendingInvokeIDs []int64 `json:"pending_invoke_ids,omitempty"`
+	// ResumeTimes 本次运行中 item 按检查点续跑的次数
+	ResumeTimes int   `json:"resume_times,omitempty"`
+	UpdatedAtMS int64 `json:"updated_at_ms"`
+}
+
+func (c *ExptTurnCheckpoint) GetResumeTimes() int {
+	if c == nil {
+		return 0
+	}
+	return c.ResumeTimes
+}
+
+// BuildExptTurnCheckpoint 按 turn 当前已有的评测对象与评估器结果推导检查点, 续跑次数沿用 prev
+func BuildExptTurnCheckpoint(prev *ExptTurnCheckpoint, target *EvalTargetRecord, evaluatorRecords []*EvaluatorRecord) *ExptTurnCheckpoint {
+	cp := &ExptTurnCheckpoint{
+		ResumeTimes: prev.GetResumeTimes(),
+		UpdatedAtMS: time.Now().UnixMilli(),
+	}
+	if target != nil && target.ID > 0 {
+		if gptr.Indirect(target.Status) == EvalTargetRunStatusAsyncInvoking {
+			cp.Stage = ExptTurnCheckpointStageTargetAsyncPending
+			cp.PendingInvokeIDs = append(cp.PendingInvokeIDs, target.ID)
+			return cp
+		}
+		cp.Stage = ExptTurnCheckpointStageTargetDone
+	}
+	for _, r := range evaluatorRecords {
+		if r == nil || r.ID <= 0 {
+			continue
+		}
+		if r.Status == EvaluatorRunStatusAsyncInvoking {
+			cp.PendingInvokeIDs = append(cp.PendingInvokeIDs, r.ID)
+		}
+		cp.Stage = ExptTurnCheckpointStageEvaluating
+	}
+	if len(cp.PendingInvokeIDs) > 0 {
+		cp.Stage = ExptTurnCheckpointStageEvaluatorAsyncPending
+	}
+	return cp
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExptCheckpointConf(t *testing.T) {
+	var nilConf *ExptCheckpointConf
+	assert.False(t, nilConf.IsEnabled())
+	assert.Equal(t, defaultExptMaxResumeTimes, nilConf.GetMaxResumeTimes())
+
+	conf := &ExptCheckpointConf{Enable: true, MaxResumeTimes: 3}
+	assert.True(t, conf.IsEnabled())
+	assert.Equal(t, 3, conf.GetMaxResumeTimes())
+
+	var nilExecConf *ExptExecConf
+	assert.Nil(t, nilExecConf.GetCheckpointConf())
+}
+
+func TestBuildExptTurnCheckpoint(t *testing.T) {
+	prev := &ExptTurnCheckpoint{ResumeTimes: 1}
+
+	cp := BuildExptTurnCheckpoint(nil, nil, nil)
+	assert.Equal(t, ExptTurnCheckpointStage(""), cp.Stage)
+	assert.Equal(t, 0, cp.ResumeTimes)
+	assert.Positive(t, cp.UpdatedAtMS)
+
+	cp = BuildExptTurnCheckpoint(prev, &EvalTargetRecord{ID: 1, Status: gptr.Of(EvalTargetRunStatusAsyncInvoking)}, nil)
+	assert.Equal(t, ExptTurnCheckpointStageTargetAsyncPending, cp.Stage)
+	assert.Equal(t, []int64{1}, cp.PendingInvokeIDs)
+	assert.Equal(t, 1, cp.ResumeTimes)
+
+	target := &EvalTargetRecord{ID: 1, Status: gptr.Of(EvalTargetRunStatusSuccess)}
+	cp = BuildExptTurnCheckpoint(prev, target, nil)
+	assert.Equal(t, ExptTurnCheckpointStageTargetDone, cp.Stage)
+	assert.Empty(t, cp.PendingInvokeIDs)
+
+	cp = BuildExptTurnCheckpoint(prev, target, []*EvaluatorRecord{{ID: 2, Status: EvaluatorRunStatusSuccess}, nil})
+	assert.Equal(t, ExptTurnCheckpointStageEvaluating, cp.Stage)
+
+	cp = BuildExptTurnCheckpoint(prev, target, []*EvaluatorRecord{
+		{ID: 2, Status: EvaluatorRunStatusSuccess},
+		{ID: 3, Status: EvaluatorRunStatusAsyncInvoking},
+	})
+	assert.Equal(t, ExptTurnCheckpointStageEvaluatorAsyncPending, cp.Stage)
+	assert.Equal(t, []int64{3}, cp.PendingInvokeIDs)
+}
+
+func TestExptItemEvalEvent_ResumeFromCheckpoint(t *testing.T) {
+	event := &ExptItemEvalEvent{ExptRunMode: EvaluationModeRetryAll}
+	assert.True(t, event.IgnoreExistedTargetResult())
+
+	event.ResumeFromCheckpoint = true
+	assert.False(t, event.IgnoreExistedTargetResult())
+}
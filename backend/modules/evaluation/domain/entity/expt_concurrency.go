@@ -0,0 +1,244 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	defaultAdaptiveMinConcurNum       = 1
+	defaultAdaptiveAdditiveStep       = 1
+	defaultAdaptiveDecreaseFactor     = 0.5
+	defaultAdaptiveErrorRateThreshold = 0.2
+	defaultAdaptiveMinSamples         = 3
+)
+
+// ExptAdaptiveConcurConf 实验 item 并发自适应 (AIMD) 配置, 挂在 ExptExecConf 下, 可按空间覆盖。
+// 调度器每个 tick 消费上一窗口内各后端 (评测对象/评估器所用模型) 的执行观测:
+// 出现限流或错误率、平均耗时超过阈值时并发按 DecreaseFactor 乘性减少, 否则加性增加 AdditiveStep。
+// 实验的有效并发取各后端并发的最小值, 且不超过实验配置的 item 并发上限。
+type ExptAdaptiveConcurConf struct {
+	Enable bool `json:"enable" mapstructure:"enable"`
+	// MinConcurNum 并发下限, 默认 1
+	MinConcurNum int `json:"min_concur_num" mapstructure:"min_concur_num"`
+	// MaxConcurNum 实验未指定 item 并发时的并发上限, 为空取 ExptItemEvalConf.MaxItemConcurNum
+	MaxConcurNum int `json:"max_concur_num" mapstructure:"max_concur_num"`
+	// AdditiveStep 每个无异常窗口的并发增量, 默认 1
+	AdditiveStep int `json:"additive_step" mapstructure:"additive_step"`
+	// DecreaseFactor 异常窗口的并发乘数, 取值 (0, 1), 默认 0.5
+	DecreaseFactor float64 `json:"decrease_factor" mapstructure:"decrease_factor"`
+	// ErrorRateThreshold 窗口错误率超过该值视为异常, 默认 0.2
+	ErrorRateThreshold float64 `json:"error_rate_threshold" mapstructure:"error_rate_threshold"`
+	// LatencyThresholdMS 窗口平均耗时超过该值视为异常, 为 0 不按耗时调整
+	LatencyThresholdMS int64 `json:"latency_threshold_ms" mapstructure:"latency_threshold_ms"`
+	// MinSamples 窗口样本数不足时不调整并发, 避免少量样本引起抖动; 出现限流时不受此限制
+	MinSamples int `json:"min_samples" mapstructure:"min_samples"`
+
+	// BackendBudget 同一后端在所有运行中的实验间共享的 item 提交预算, 为空不限制
+	BackendBudget *ExptBackendBudget `json:"backend_budget" mapstructure:"backend_budget"`
+	// BackendBudgets 按后端 key (如 model:123) 覆盖 BackendBudget
+	BackendBudgets map[string]*ExptBackendBudget `json:"backend_budgets" mapstructure:"backend_budgets"`
+}
+
+func (c *ExptAdaptiveConcurConf) IsEnabled() bool {
+	return c != nil && c.Enable
+}
+
+func (c *ExptAdaptiveConcurConf) GetMinConcurNum() int {
+	if c != nil && c.MinConcurNum > 0 {
+		return c.MinConcurNum
+	}
+	return defaultAdaptiveMinConcurNum
+}
+
+// GetMaxConcurNum 未配置时返回 fallback
+func (c *ExptAdaptiveConcurConf) GetMaxConcurNum(fallback int) int {
+	if c != nil && c.MaxConcurNum > 0 {
+		return c.MaxConcurNum
+	}
+	return fallback
+}
+
+func (c *ExptAdaptiveConcurConf) getAdditiveStep() int {
+	if c != nil && c.AdditiveStep > 0 {
+		return c.AdditiveStep
+	}
+	return defaultAdaptiveAdditiveStep
+}
+
+func (c *ExptAdaptiveConcurConf) getDecreaseFactor() float64 {
+	if c != nil && c.DecreaseFactor > 0 && c.DecreaseFactor < 1 {
+		return c.DecreaseFactor
+	}
+	return defaultAdaptiveDecreaseFactor
+}
+
+func (c *ExptAdaptiveConcurConf) getErrorRateThreshold() float64 {
+	if c != nil && c.ErrorRateThreshold > 0 {
+		return c.ErrorRateThreshold
+	}
+	return defaultAdaptiveErrorRateThreshold
+}
+
+func (c *ExptAdaptiveConcurConf) getMinSamples() int64 {
+	if c != nil && c.MinSamples > 0 {
+		return int64(c.MinSamples)
+	}
+	return defaultAdaptiveMinSamples
+}
+
+// GetBackendBudget 返回后端的共享提交预算, 未配置或配置不合法返回 nil
+func (c *ExptAdaptiveConcurConf) GetBackendBudget(backend string) *ExptBackendBudget {
+	if c == nil {
+		return nil
+	}
+	budget := c.BackendBudget
+	if b, ok := c.BackendBudgets[backend]; ok {
+		budget = b
+	}
+	if !budget.isValid() {
+		return nil
+	}
+	return budget
+}
+
+// NextConcurNum 依据窗口观测计算后端的下一个并发值, 结果限定在 [lower, upper]
+func (c *ExptAdaptiveConcurConf) NextConcurNum(cur, lower, upper int, obs *ExptBackendObservation) int {
+	if upper < lower {
+		upper = lower
+	}
+	next := cur
+	switch {
+	case obs.GetThrottled() > 0 || (obs.GetTotal() >= c.getMinSamples() && c.isOverloaded(obs)):
+		next = int(float64(cur) * c.getDecreaseFactor())
+	case obs.GetTotal() >= c.getMinSamples():
+		next = cur + c.getAdditiveStep()
+	}
+	if next < lower {
+		next = lower
+	}
+	if next > upper {
+		next = upper
+	}
+	return next
+}
+
+func (c *ExptAdaptiveConcurConf) isOverloaded(obs *ExptBackendObservation) bool {
+	if obs.ErrorRate() > c.getErrorRateThreshold() {
+		return true
+	}
+	return c != nil && c.LatencyThresholdMS > 0 && obs.AvgLatencyMS() > c.LatencyThresholdMS
+}
+
+// ExptBackendBudget 后端共享提交预算, 每 PeriodSecond 秒最多提交 Rate 个 item
+type ExptBackendBudget struct {
+	Rate         int `json:"rate" mapstructure:"rate"`
+	Burst        int `json:"burst" mapstructure:"burst"`
+	PeriodSecond int `json:"period_second" mapstructure:"period_second"`
+}
+
+func (b *ExptBackendBudget) isValid() bool {
+	return b != nil && b.Rate > 0 && b.PeriodSecond > 0
+}
+
+func (b *ExptBackendBudget) GetBurst() int {
+	if b.Burst > 0 {
+		return b.Burst
+	}
+	return b.Rate
+}
+
+func (b *ExptBackendBudget) GetPeriod() time.Duration {
+	return time.Duration(b.PeriodSecond) * time.Second
+}
+
+// ExptBackendObservation 一个调度窗口内某后端的执行观测, 缓存命中与异步执行的结果不计入
+type ExptBackendObservation struct {
+	Total     int64 `json:"total"`
+	Failed    int64 `json:"failed"`
+	Throttled int64 `json:"throttled"`
+	// LatencyMS 窗口内耗时总和
+	LatencyMS int64 `json:"latency_ms"`
+}
+
+func (o *ExptBackendObservation) GetTotal() int64 {
+	if o == nil {
+		return 0
+	}
+	return o.Total
+}
+
+func (o *ExptBackendObservation) GetThrottled() int64 {
+	if o == nil {
+		return 0
+	}
+	return o.Throttled
+}
+
+func (o *ExptBackendObservation) ErrorRate() float64 {
+	if o.GetTotal() == 0 {
+		return 0
+	}
+	return float64(o.Failed) / float64(o.Total)
+}
+
+func (o *ExptBackendObservation) AvgLatencyMS() int64 {
+	if o.GetTotal() == 0 {
+		return 0
+	}
+	return o.LatencyMS / o.Total
+}
+
+// Record 记录一次执行
+func (o *ExptBackendObservation) Record(failed, throttled bool, latencyMS int64) {
+	o.Total++
+	if failed {
+		o.Failed++
+	}
+	if throttled {
+		o.Throttled++
+	}
+	if latencyMS > 0 {
+		o.LatencyMS += latencyMS
+	}
+}
+
+// TargetBackendKey 评测对象后端, 已知模型时按模型归并, 使调用同一模型的实验共享预算
+func TargetBackendKey(targetID, targetVersionID, modelID int64) string {
+	if modelID > 0 {
+		return modelBackendKey(modelID)
+	}
+	return fmt.Sprintf("target:%d:%d", targetID, targetVersionID)
+}
+
+// EvaluatorBackendKey 评估器后端, 已知模型时按模型归并
+func EvaluatorBackendKey(evaluatorVersionID, modelID int64) string {
+	if modelID > 0 {
+		return modelBackendKey(modelID)
+	}
+	return fmt.Sprintf("evaluator:%d", evaluatorVersionID)
+}
+
+func modelBackendKey(modelID int64) string {
+	return fmt.Sprintf("model:%d", modelID)
+}
+
+var throttledErrKeywords = []string{"429", "rate limit", "ratelimit", "too many requests", "throttl", "限流"}
+
+// IsThrottledErr 判断后端返回的错误是否为限流
+func IsThrottledErr(code int32, msg string) bool {
+	if code == 429 {
+		return true
+	}
+	msg = strings.ToLower(msg)
+	for _, kw := range throttledErrKeywords {
+		if strings.Contains(msg, kw) {
+			return true
+		}
+	}
+	return false
+}
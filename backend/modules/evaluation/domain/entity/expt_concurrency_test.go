@@ -0,0 +1,69 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExptAdaptiveConcurConf_NextConcurNum(t *testing.T) {
+	conf := &ExptAdaptiveConcurConf{Enable: true, LatencyThresholdMS: 1000}
+
+	tests := []struct {
+		name string
+		cur  int
+		obs  *ExptBackendObservation
+		want int
+	}{
+		{name: "无观测保持不变", cur: 5, obs: nil, want: 5},
+		{name: "样本不足保持不变", cur: 5, obs: &ExptBackendObservation{Total: 2, Failed: 2}, want: 5},
+		{name: "健康窗口加性增加", cur: 5, obs: &ExptBackendObservation{Total: 10, Failed: 1, LatencyMS: 5000}, want: 6},
+		{name: "错误率超阈值乘性减少", cur: 10, obs: &ExptBackendObservation{Total: 10, Failed: 3}, want: 5},
+		{name: "平均耗时超阈值乘性减少", cur: 10, obs: &ExptBackendObservation{Total: 4, LatencyMS: 8000}, want: 5},
+		{name: "限流立即减少且不受样本数限制", cur: 8, obs: &ExptBackendObservation{Total: 1, Failed: 1, Throttled: 1}, want: 4},
+		{name: "不低于下限", cur: 2, obs: &ExptBackendObservation{Total: 1, Throttled: 1}, want: 2},
+		{name: "不超过上限", cur: 20, obs: &ExptBackendObservation{Total: 10}, want: 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, conf.NextConcurNum(tt.cur, 2, 20, tt.obs))
+		})
+	}
+}
+
+func TestExptAdaptiveConcurConf_GetBackendBudget(t *testing.T) {
+	var nilConf *ExptAdaptiveConcurConf
+	assert.Nil(t, nilConf.GetBackendBudget("model:1"))
+	assert.False(t, nilConf.IsEnabled())
+
+	defaultBudget := &ExptBackendBudget{Rate: 10, PeriodSecond: 60}
+	conf := &ExptAdaptiveConcurConf{
+		BackendBudget: defaultBudget,
+		BackendBudgets: map[string]*ExptBackendBudget{
+			"model:1": {Rate: 5, Burst: 8, PeriodSecond: 1},
+			"model:2": {Rate: 0},
+		},
+	}
+	assert.Equal(t, defaultBudget, conf.GetBackendBudget("model:3"))
+	assert.Equal(t, 10, conf.GetBackendBudget("model:3").GetBurst())
+	assert.Equal(t, 8, conf.GetBackendBudget("model:1").GetBurst())
+	// 覆盖配置不合法时视为不限制
+	assert.Nil(t, conf.GetBackendBudget("model:2"))
+}
+
+func TestBackendKey(t *testing.T) {
+	assert.Equal(t, "model:7", TargetBackendKey(1, 2, 7))
+	assert.Equal(t, "target:1:2", TargetBackendKey(1, 2, 0))
+	assert.Equal(t, "model:7", EvaluatorBackendKey(3, 7))
+	assert.Equal(t, "evaluator:3", EvaluatorBackendKey(3, 0))
+}
+
+func TestIsThrottledErr(t *testing.T) {
+	assert.True(t, IsThrottledErr(429, ""))
+	assert.True(t, IsThrottledErr(0, "Rate limit reached for requests"))
+	assert.True(t, IsThrottledErr(0, "HTTP 429 Too Many Requests"))
+	assert.False(t, IsThrottledErr(500, "internal error"))
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import "fmt"
+
+// ModelPrice 单个模型的单价, ModelID 对应 llm 模块 Model.ID, 价格单位为每百万 token
+type ModelPrice struct {
+	ModelID                     int64   `json:"model_id" mapstructure:"model_id"`
+	InputPricePerMillionTokens  float64 `json:"input_price_per_million_tokens" mapstructure:"input_price_per_million_tokens"`
+	OutputPricePerMillionTokens float64 `json:"output_price_per_million_tokens" mapstructure:"output_price_per_million_tokens"`
+}
+
+// Cost 按输入/输出 token 数核算花费
+func (p *ModelPrice) Cost(inputTokens, outputTokens int64) float64 {
+	if p == nil {
+		return 0
+	}
+	return (float64(inputTokens)*p.InputPricePerMillionTokens + float64(outputTokens)*p.OutputPricePerMillionTokens) / 1e6
+}
+
+// ModelPriceConf 模型价目表。
+// Models 按 ModelID 精确匹配; 未命中 (或调用方拿不到模型 ID) 时回落 Default, Default 为空则不计费。
+type ModelPriceConf struct {
+	// Currency 价格币种, 仅用于展示
+	Currency string        `json:"currency" mapstructure:"currency"`
+	Default  *ModelPrice   `json:"default,omitempty" mapstructure:"default"`
+	Models   []*ModelPrice `json:"models,omitempty" mapstructure:"models"`
+}
+
+func (c *ModelPriceConf) GetPrice(modelID int64) *ModelPrice {
+	if c == nil {
+		return nil
+	}
+	if modelID > 0 {
+		for _, p := range c.Models {
+			if p != nil && p.ModelID == modelID {
+				return p
+			}
+		}
+	}
+	return c.Default
+}
+
+func DefaultModelPriceConf() *ModelPriceConf {
+	return &ModelPriceConf{}
+}
+
+// ExptCostConf 实验成本配置, 序列化进 experiment.eval_conf
+type ExptCostConf struct {
+	// BudgetCap 花费上限 (与价目表同币种), 已核算花费达到上限后调度器停止提交新 item 并终止实验; 为空不限制
+	BudgetCap *float64 `json:"budget_cap,omitempty"`
+	// TargetModelID 评测对象所用模型 (llm Model.ID), 评测对象自身不携带模型信息时用于核算评测对象花费
+	TargetModelID *int64 `json:"target_model_id,omitempty"`
+	// PriceConf 实验级价目表, 非空时替代全局价目表核算本实验花费
+	PriceConf *ModelPriceConf `json:"price_conf,omitempty"`
+}
+
+func (c *ExptCostConf) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.BudgetCap != nil && *c.BudgetCap <= 0 {
+		return fmt.Errorf("budget_cap must be positive, got %v", *c.BudgetCap)
+	}
+	if c.TargetModelID != nil && *c.TargetModelID <= 0 {
+		return fmt.Errorf("invalid target_model_id %v", *c.TargetModelID)
+	}
+	return c.PriceConf.Validate()
+}
+
+func (c *ModelPriceConf) Validate() error {
+	if c == nil {
+		return nil
+	}
+	for _, p := range append([]*ModelPrice{c.Default}, c.Models...) {
+		if p == nil {
+			continue
+		}
+		if p.InputPricePerMillionTokens < 0 || p.OutputPricePerMillionTokens < 0 {
+			return fmt.Errorf("model price must not be negative, model_id %v", p.ModelID)
+		}
+	}
+	return nil
+}
+
+// GetPriceConf 实验级价目表优先, 未配置时回落全局价目表
+func (c *ExptCostConf) GetPriceConf(global *ModelPriceConf) *ModelPriceConf {
+	if c == nil || c.PriceConf == nil {
+		return global
+	}
+	return c.PriceConf
+}
+
+func (c *ExptCostConf) GetBudgetCap() (float64, bool) {
+	if c == nil || c.BudgetCap == nil || *c.BudgetCap <= 0 {
+		return 0, false
+	}
+	return *c.BudgetCap, true
+}
+
+// IsBudgetExceeded 实验已核算花费是否达到花费上限
+func (c *ExptCostConf) IsBudgetExceeded(stats *ExptStats) bool {
+	budget, ok := c.GetBudgetCap()
+	if !ok || stats == nil {
+		return false
+	}
+	return stats.GetTotalCost() >= budget
+}
+
+// ExptTurnCost 单个 turn 的 token 用量与花费
+type ExptTurnCost struct {
+	TargetInputTokens     int64
+	TargetOutputTokens    int64
+	EvaluatorInputTokens  int64
+	EvaluatorOutputTokens int64
+
+	TargetCost    float64
+	EvaluatorCost float64
+}
+
+func (c *ExptTurnCost) Add(o *ExptTurnCost) {
+	if c == nil || o == nil {
+		return
+	}
+	c.TargetInputTokens += o.TargetInputTokens
+	c.TargetOutputTokens += o.TargetOutputTokens
+	c.EvaluatorInputTokens += o.EvaluatorInputTokens
+	c.EvaluatorOutputTokens += o.EvaluatorOutputTokens
+	c.TargetCost += o.TargetCost
+	c.EvaluatorCost += o.EvaluatorCost
+}
+
+func (c *ExptTurnCost) GetInputTokens() int64 {
+	if c == nil {
+		return 0
+	}
+	return c.TargetInputTokens + c.EvaluatorInputTokens
+}
+
+func (c *ExptTurnCost) GetOutputTokens() int64 {
+	if c == nil {
+		return 0
+	}
+	return c.TargetOutputTokens + c.EvaluatorOutputTokens
+}
+
+func (c *ExptTurnCost) GetTotalCost() float64 {
+	if c == nil {
+		return 0
+	}
+	return c.TargetCost + c.EvaluatorCost
+}
+
+func (c *ExptTurnCost) IsZero() bool {
+	return c == nil || (c.GetInputTokens() == 0 && c.GetOutputTokens() == 0 && c.GetTotalCost() == 0)
+}
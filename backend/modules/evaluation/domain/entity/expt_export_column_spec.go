@@ -7,7 +7,7 @@ package entity
 // 请求未带 export_columns（指针为 nil）：导出全量（含标注列等）。一旦携带 export_columns（含空对象 {}）：按白名单导出，
 // 仅 item_id、status 等必填列 + 各分组非空列表中的列；子字段 nil 与 [] 对该组均表示不导出。
 // 非空列表：仅导出列表内且报告中存在的列名/token。
-// WeightedScore 为 true 时导出加权总分列。
+// WeightedScore 为 true 时导出加权总分列；Cost 为 true 时导出按模型价目表核算的评测对象/评估器花费列。
 type ExptResultExportColumnSpec struct {
 	// 四个切片不可使用 json omitempty：[] 必须能经 JSON 与 nil 区分；nil 与 [] 在白名单模式下对该组等价（均不导出）。
 	EvalSetFields     []string `json:"eval_set_fields"`
@@ -19,4 +19,6 @@ type ExptResultExportColumnSpec struct {
 	TagKeyIds []string `json:"tag_key_ids"`
 	// WeightedScore 仅在为 true 时生效；nil/false 表示不因此单独增加加权分列。
 	WeightedScore *bool `json:"weighted_score,omitempty"`
+	// Cost 仅在为 true 时生效；nil/false 表示白名单模式下不导出花费列。
+	Cost *bool `json:"cost,omitempty"`
 }
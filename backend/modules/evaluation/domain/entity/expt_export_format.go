@@ -0,0 +1,69 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ExptResultExportFormat 实验报告导出文件格式，取值与 ExportExptResultRequest.export_type 对齐（大写）。
+type ExptResultExportFormat string
+
+const (
+	// ExptResultExportFormatCSV 默认格式，分数保留两位小数，多模态内容展开为文本
+	ExptResultExportFormatCSV ExptResultExportFormat = "CSV"
+	// ExptResultExportFormatXLSX 分数保留完整精度；多评测集实验每个评测集一个 sheet
+	ExptResultExportFormatXLSX ExptResultExportFormat = "XLSX"
+	// ExptResultExportFormatJSONL 每行一个 turn，保留完整 Content 结构与评估器原因
+	ExptResultExportFormatJSONL ExptResultExportFormat = "JSONL"
+	// ExptResultExportFormatParquet 列式格式，数值列保留类型，便于导入数仓
+	ExptResultExportFormatParquet ExptResultExportFormat = "PARQUET"
+)
+
+// ParseExptResultExportFormat 解析导出格式，大小写不敏感；空值默认 CSV。
+func ParseExptResultExportFormat(s string) (ExptResultExportFormat, error) {
+	f := ExptResultExportFormat(strings.ToUpper(strings.TrimSpace(s)))
+	switch f {
+	case "":
+		return ExptResultExportFormatCSV, nil
+	case ExptResultExportFormatCSV, ExptResultExportFormatXLSX, ExptResultExportFormatJSONL, ExptResultExportFormatParquet:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unsupported export format %q", s)
+	}
+}
+
+// OrDefault 空值按 CSV 处理，兼容未携带格式的历史导出事件
+func (f ExptResultExportFormat) OrDefault() ExptResultExportFormat {
+	if f == "" {
+		return ExptResultExportFormatCSV
+	}
+	return f
+}
+
+// FileExt 导出文件扩展名（不含点）
+func (f ExptResultExportFormat) FileExt() string {
+	switch f.OrDefault() {
+	case ExptResultExportFormatXLSX:
+		return "xlsx"
+	case ExptResultExportFormatJSONL:
+		return "jsonl"
+	case ExptResultExportFormatParquet:
+		return "parquet"
+	default:
+		return "csv"
+	}
+}
+
+// SupportMultiEvalSet 是否支持多评测集（MultiSetConfig）实验：各评测集列不一致，
+// 仅 XLSX（按评测集分 sheet）与 JSONL（逐行自描述）能无歧义地承载。
+func (f ExptResultExportFormat) SupportMultiEvalSet() bool {
+	switch f {
+	case ExptResultExportFormatXLSX, ExptResultExportFormatJSONL:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseExptResultExportFormat(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    ExptResultExportFormat
+		wantExt string
+		wantErr bool
+	}{
+		{in: "", want: ExptResultExportFormatCSV, wantExt: "csv"},
+		{in: "CSV", want: ExptResultExportFormatCSV, wantExt: "csv"},
+		{in: "xlsx", want: ExptResultExportFormatXLSX, wantExt: "xlsx"},
+		{in: " JsonL ", want: ExptResultExportFormatJSONL, wantExt: "jsonl"},
+		{in: "parquet", want: ExptResultExportFormatParquet, wantExt: "parquet"},
+		{in: "pdf", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseExptResultExportFormat(tt.in)
+		if tt.wantErr {
+			assert.Error(t, err, tt.in)
+			continue
+		}
+		assert.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, got)
+		assert.Equal(t, tt.wantExt, got.FileExt())
+	}
+
+	assert.Equal(t, ExptResultExportFormatCSV, ExptResultExportFormat("").OrDefault())
+	assert.True(t, ExptResultExportFormatXLSX.SupportMultiEvalSet())
+	assert.True(t, ExptResultExportFormatJSONL.SupportMultiEvalSet())
+	assert.False(t, ExptResultExportFormatCSV.SupportMultiEvalSet())
+	assert.False(t, ExptResultExportFormatParquet.SupportMultiEvalSet())
+}
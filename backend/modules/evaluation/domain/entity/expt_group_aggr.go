@@ -0,0 +1,90 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import "time"
+
+const (
+	// MaxGroupedAggrExptCnt 单次分组聚合查询的实验数上限
+	MaxGroupedAggrExptCnt = 10
+	// DefaultGroupedAggrMaxGroups 每个实验默认返回的分组数上限
+	DefaultGroupedAggrMaxGroups = 50
+)
+
+// ExptGroupDimensionType 分组维度来源
+type ExptGroupDimensionType int32
+
+const (
+	// ExptGroupDimensionEvalSetField 评测集字段, Key 为评测集 schema 的 field_key
+	ExptGroupDimensionEvalSetField ExptGroupDimensionType = 1
+	// ExptGroupDimensionAnnotationTag 人工标注标签, Key 为 tag_key_id
+	ExptGroupDimensionAnnotationTag ExptGroupDimensionType = 2
+)
+
+type ExptGroupDimension struct {
+	Type ExptGroupDimensionType
+	Key  string
+}
+
+// ExptGroupedAggrParam 按评测集字段或人工标注取值切分实验的评估器得分聚合, 可一次查询多个实验用于对比
+type ExptGroupedAggrParam struct {
+	SpaceID   int64
+	ExptIDs   []int64
+	Dimension *ExptGroupDimension
+	// MaxGroups 每个实验最多返回的分组数, 按 turn 数降序截断, 默认 DefaultGroupedAggrMaxGroups
+	MaxGroups int
+}
+
+type ExptGroupedAggrResult struct {
+	Dimension   *ExptGroupDimension
+	ExptResults []*ExptGroupedAggr
+}
+
+// ExptGroupedAggr 单个实验的分组聚合结果, 与请求中的 ExptIDs 顺序一致
+type ExptGroupedAggr struct {
+	ExptID int64
+	Groups []*ExptAggrGroup
+	// Truncated 分组数超过 MaxGroups, 仅返回 turn 数最多的前 MaxGroups 组
+	Truncated bool
+}
+
+type ExptAggrGroup struct {
+	// Value 分组取值; 字段缺失时为空串, 分类/布尔标签为 tag_value_id
+	Value     string
+	TurnCount int64
+	// EvaluatorResults 分组内各评估器得分的 Average / Max / Min, 按评估器实例 key 排序
+	EvaluatorResults []*ExptGroupEvaluatorAggr
+}
+
+type ExptGroupEvaluatorAggr struct {
+	EvaluatorVersionID int64
+	Alias              string
+	// Count 分组内产出该评估器得分的 turn 数
+	Count             int64
+	AggregatorResults []*AggregatorResult
+}
+
+// ExptTurnResultGroupAggrQuery 单个实验在 expt_turn_result_filter 上的分组聚合查询
+type ExptTurnResultGroupAggrQuery struct {
+	SpaceID     int64
+	ExptID      int64
+	CreatedDate *time.Time
+	// GroupFromItemSnapshot 分组取值来自评测集 item 快照 (string_map 等), 否则来自 turn 结果的人工标注 map
+	GroupFromItemSnapshot bool
+	// GroupMapColumns 分组取值所在的 map 列, 多个时取第一个包含 GroupMapKey 的列
+	GroupMapColumns []string
+	GroupMapKey     string
+	IsOnlineExpt    bool
+}
+
+// ExptTurnResultGroupAggrRow 分组聚合的一行, ScoreKey 为 ck 侧评估器得分 key (key1 ~ key10);
+// ScoreKey 为空的行仅 Count 有效, 表示该分组的 turn 总数
+type ExptTurnResultGroupAggrRow struct {
+	GroupValue string
+	ScoreKey   string
+	Count      int64
+	Avg        float64
+	Min        float64
+	Max        float64
+}
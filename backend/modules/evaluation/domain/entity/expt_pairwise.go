@@ -0,0 +1,123 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import "time"
+
+const (
+	MaxPairwiseRankExptCnt        = 10
+	DefaultPairwiseRankMaxTurnCnt = 200
+	MaxPairwiseRankMaxTurnCnt     = 2000
+	// DefaultPairwiseRankConcurrency / MaxPairwiseRankConcurrency 成对评估器调用并发数
+	DefaultPairwiseRankConcurrency = 5
+	MaxPairwiseRankConcurrency     = 20
+	// PairwiseRankRunningTimeout 运行中的排名任务超过该时长仍未结束视为失败
+	PairwiseRankRunningTimeout = 2 * time.Hour
+)
+
+// ExptPairwiseRankParam 使用成对评估器对多个实验做两两对比并排名。
+// 每对实验在共同的 (item_id, turn_id) 上, 将两侧评测对象输出填入 output_a / output_b 调用评估器。
+type ExptPairwiseRankParam struct {
+	SpaceID int64 `json:"space_id"`
+	// ExptIDs 参与排名的实验, 至少 2 个且须属于同一评测集
+	ExptIDs []int64 `json:"expt_ids"`
+	// EvaluatorVersionID 成对评估器版本
+	EvaluatorVersionID int64 `json:"evaluator_version_id"`
+	// OutputFieldKey 评测对象输出字段, 默认 actual_output
+	OutputFieldKey string `json:"output_field_key,omitempty"`
+	// MaxTurnCnt 每对实验最多比较的 turn 数, 默认 200, 控制模型调用成本
+	MaxTurnCnt int `json:"max_turn_cnt,omitempty"`
+	// EloK Elo 更新系数, 默认 32
+	EloK float64 `json:"elo_k,omitempty"`
+	// Concurrency 成对评估器调用并发数, 默认 5, 上限 20
+	Concurrency int `json:"concurrency,omitempty"`
+}
+
+func (p *ExptPairwiseRankParam) GetOutputFieldKey() string {
+	if p == nil || p.OutputFieldKey == "" {
+		return "actual_output"
+	}
+	return p.OutputFieldKey
+}
+
+func (p *ExptPairwiseRankParam) GetMaxTurnCnt() int {
+	if p == nil || p.MaxTurnCnt <= 0 {
+		return DefaultPairwiseRankMaxTurnCnt
+	}
+	if p.MaxTurnCnt > MaxPairwiseRankMaxTurnCnt {
+		return MaxPairwiseRankMaxTurnCnt
+	}
+	return p.MaxTurnCnt
+}
+
+func (p *ExptPairwiseRankParam) GetConcurrency() int {
+	if p == nil || p.Concurrency <= 0 {
+		return DefaultPairwiseRankConcurrency
+	}
+	if p.Concurrency > MaxPairwiseRankConcurrency {
+		return MaxPairwiseRankConcurrency
+	}
+	return p.Concurrency
+}
+
+type ExptPairwiseRankStatus int32
+
+const (
+	ExptPairwiseRankStatus_Unknown ExptPairwiseRankStatus = 0
+
+	ExptPairwiseRankStatus_Running ExptPairwiseRankStatus = 1
+	ExptPairwiseRankStatus_Success ExptPairwiseRankStatus = 2
+	ExptPairwiseRankStatus_Failed  ExptPairwiseRankStatus = 3
+)
+
+// ExptPairwiseRankRecord 一次异步排名任务, 提交后由 MQ 消费执行并回写结果
+type ExptPairwiseRankRecord struct {
+	ID                 int64
+	SpaceID            int64
+	EvaluatorVersionID int64
+	Status             ExptPairwiseRankStatus
+	Param              *ExptPairwiseRankParam
+	Result             *ExptPairwiseRankResult
+	ErrMsg             string
+	CreatedBy          string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// IsStale 运行中且已超过 PairwiseRankRunningTimeout
+func (r *ExptPairwiseRankRecord) IsStale(now time.Time) bool {
+	return r != nil && r.Status == ExptPairwiseRankStatus_Running && !r.CreatedAt.IsZero() &&
+		r.CreatedAt.Add(PairwiseRankRunningTimeout).Before(now)
+}
+
+type ExptPairwiseRankResult struct {
+	EvaluatorVersionID int64 `json:"evaluator_version_id"`
+	// PairResults 每对实验的胜负统计, 以 ExptAID 视角
+	PairResults []*ExptPairwiseResult `json:"pair_results"`
+	// Rankings 按 Bradley-Terry 强度降序排列
+	Rankings []*ExptPairwiseRanking `json:"rankings"`
+}
+
+type ExptPairwiseResult struct {
+	ExptAID int64 `json:"expt_a_id"`
+	ExptBID int64 `json:"expt_b_id"`
+	AWins   int64 `json:"a_wins"`
+	BWins   int64 `json:"b_wins"`
+	Ties    int64 `json:"ties"`
+	// Failed 评估器执行失败或缺少输出的 turn 数, 不计入胜负
+	Failed int64 `json:"failed"`
+}
+
+type ExptPairwiseRanking struct {
+	ExptID int64 `json:"expt_id"`
+	Wins   int64 `json:"wins"`
+	Losses int64 `json:"losses"`
+	Ties   int64 `json:"ties"`
+	// WinRate (Wins + 0.5 * Ties) / 比较总数
+	WinRate float64 `json:"win_rate"`
+	Elo     float64 `json:"elo"`
+	// BTScore Bradley-Terry 强度, 几何平均归一为 1
+	BTScore float64 `json:"bt_score"`
+	Rank    int     `json:"rank"`
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"math"
+)
+
+const MaxQualityGateRuleCnt = 20
+
+// QualityGateMetric 质量门禁规则的度量指标
+type QualityGateMetric string
+
+const (
+	// QualityGateMetricEvaluatorAvgScore 指定评估器实例在全部 turn 上的平均得分
+	QualityGateMetricEvaluatorAvgScore QualityGateMetric = "evaluator_avg_score"
+	// QualityGateMetricWeightedAvgScore 加权得分在全部 turn 上的平均值
+	QualityGateMetricWeightedAvgScore QualityGateMetric = "weighted_avg_score"
+	// QualityGateMetricFailRate 失败 item 占全部 item 的比例, 取值 [0, 1]
+	QualityGateMetricFailRate QualityGateMetric = "fail_rate"
+	// QualityGateMetricRegressionRate 相对基线实验退化 (含 mixed) 的 turn 占已对齐 turn 的比例, 取值 [0, 1]
+	QualityGateMetricRegressionRate QualityGateMetric = "regression_rate"
+)
+
+// QualityGateOperator 度量值与阈值的比较方式
+type QualityGateOperator string
+
+const (
+	QualityGateOperatorGE QualityGateOperator = ">="
+	QualityGateOperatorGT QualityGateOperator = ">"
+	QualityGateOperatorLE QualityGateOperator = "<="
+	QualityGateOperatorLT QualityGateOperator = "<"
+	QualityGateOperatorEQ QualityGateOperator = "=="
+)
+
+// Compare 判断 actual <op> threshold 是否成立
+func (o QualityGateOperator) Compare(actual, threshold float64) bool {
+	switch o {
+	case QualityGateOperatorGE:
+		return actual >= threshold
+	case QualityGateOperatorGT:
+		return actual > threshold
+	case QualityGateOperatorLE:
+		return actual <= threshold
+	case QualityGateOperatorLT:
+		return actual < threshold
+	case QualityGateOperatorEQ:
+		return math.Abs(actual-threshold) < 1e-9
+	default:
+		return false
+	}
+}
+
+func (o QualityGateOperator) IsValid() bool {
+	switch o {
+	case QualityGateOperatorGE, QualityGateOperatorGT, QualityGateOperatorLE, QualityGateOperatorLT, QualityGateOperatorEQ:
+		return true
+	default:
+		return false
+	}
+}
+
+// ExptQualityGateConf 实验质量门禁配置, 随 eval_conf / template_conf blob 序列化 (无 DDL)。
+// 实验结束时逐条评估, 全部规则通过才算通过, 供 CI 流水线按单一 pass/fail 卡点。
+type ExptQualityGateConf struct {
+	Rules []*ExptQualityGateRule `json:"rules,omitempty"`
+}
+
+func (c *ExptQualityGateConf) IsEmpty() bool {
+	return c == nil || len(c.Rules) == 0
+}
+
+func (c *ExptQualityGateConf) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if len(c.Rules) > MaxQualityGateRuleCnt {
+		return fmt.Errorf("quality gate rules exceed limit %d", MaxQualityGateRuleCnt)
+	}
+	for i, rule := range c.Rules {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("quality gate rule[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ExptQualityGateRule 单条门禁规则, 例如:
+//
+//	{"metric":"evaluator_avg_score","evaluator_version_id":123,"operator":">=","threshold":0.8}
+//	{"metric":"fail_rate","operator":"<","threshold":0.02}
+//	{"metric":"regression_rate","baseline_expt_id":456,"operator":"<=","threshold":0}
+type ExptQualityGateRule struct {
+	// Name 规则展示名, 为空时按 metric 生成
+	Name     string              `json:"name,omitempty"`
+	Metric   QualityGateMetric   `json:"metric"`
+	Operator QualityGateOperator `json:"operator"`
+	// Threshold 比较阈值; 比例类指标取值 [0, 1]
+	Threshold float64 `json:"threshold"`
+
+	// EvaluatorVersionID / EvaluatorAlias 仅 evaluator_avg_score 使用, 定位评估器实例
+	EvaluatorVersionID int64  `json:"evaluator_version_id,omitempty"`
+	EvaluatorAlias     string `json:"evaluator_alias,omitempty"`
+
+	// BaselineExptID 仅 regression_rate 使用, 固定的基线实验
+	BaselineExptID int64 `json:"baseline_expt_id,omitempty"`
+	// ScoreTolerance 仅 regression_rate 使用, 单个 turn 得分下降超过该值才视为退化, 默认 0
+	ScoreTolerance float64 `json:"score_tolerance,omitempty"`
+}
+
+func (r *ExptQualityGateRule) Validate() error {
+	if r == nil {
+		return fmt.Errorf("rule is nil")
+	}
+	if !r.Operator.IsValid() {
+		return fmt.Errorf("invalid operator %q", r.Operator)
+	}
+	switch r.Metric {
+	case QualityGateMetricEvaluatorAvgScore:
+		if r.EvaluatorVersionID <= 0 {
+			return fmt.Errorf("evaluator_version_id is required for metric %s", r.Metric)
+		}
+	case QualityGateMetricWeightedAvgScore:
+	case QualityGateMetricFailRate:
+		if r.Threshold < 0 || r.Threshold > 1 {
+			return fmt.Errorf("threshold of metric %s must be in [0, 1]", r.Metric)
+		}
+	case QualityGateMetricRegressionRate:
+		if r.BaselineExptID <= 0 {
+			return fmt.Errorf("baseline_expt_id is required for metric %s", r.Metric)
+		}
+		if r.Threshold < 0 || r.Threshold > 1 {
+			return fmt.Errorf("threshold of metric %s must be in [0, 1]", r.Metric)
+		}
+		if r.ScoreTolerance < 0 {
+			return fmt.Errorf("score_tolerance must be non-negative")
+		}
+	default:
+		return fmt.Errorf("unknown metric %q", r.Metric)
+	}
+	return nil
+}
+
+// DisplayName 规则展示名
+func (r *ExptQualityGateRule) DisplayName() string {
+	if r.Name != "" {
+		return r.Name
+	}
+	switch r.Metric {
+	case QualityGateMetricEvaluatorAvgScore:
+		return fmt.Sprintf("%s(%s) %s %v", r.Metric, EncodeEvaluatorInstanceKey(r.EvaluatorVersionID, r.EvaluatorAlias), r.Operator, r.Threshold)
+	case QualityGateMetricRegressionRate:
+		return fmt.Sprintf("%s(baseline=%d) %s %v", r.Metric, r.BaselineExptID, r.Operator, r.Threshold)
+	default:
+		return fmt.Sprintf("%s %s %v", r.Metric, r.Operator, r.Threshold)
+	}
+}
+
+// QualityGateStatus 门禁评估结论
+type QualityGateStatus string
+
+const (
+	QualityGateStatusPassed QualityGateStatus = "passed"
+	QualityGateStatusFailed QualityGateStatus = "failed"
+	// QualityGateStatusError 存在无法计算的规则 (如基线实验不存在、无评估器得分), 对 CI 而言等同未通过
+	QualityGateStatusError QualityGateStatus = "error"
+)
+
+// ExptQualityGateResult 实验质量门禁评估结果, 消费实验终态事件时异步写入 experiment.quality_gate_result
+type ExptQualityGateResult struct {
+	Status QualityGateStatus `json:"status"`
+	// Passed Status == passed, 冗余一份便于流水线直接判断
+	Passed      bool                         `json:"passed"`
+	RuleResults []*ExptQualityGateRuleResult `json:"rule_results,omitempty"`
+	// EvaluatedAt 毫秒时间戳
+	EvaluatedAt int64 `json:"evaluated_at"`
+	// Message 整体评估失败时的说明
+	Message string `json:"message,omitempty"`
+}
+
+// IsEvaluatedAfter 结论是否在 runStartedAt (毫秒) 之后评估, 即属于该次运行; runStartedAt 未知时视为过期
+func (r *ExptQualityGateResult) IsEvaluatedAfter(runStartedAt int64) bool {
+	return r != nil && runStartedAt > 0 && r.EvaluatedAt > runStartedAt
+}
+
+type ExptQualityGateRuleResult struct {
+	Rule *ExptQualityGateRule `json:"rule"`
+	// Actual 实际度量值, 无法计算时为空
+	Actual *float64          `json:"actual,omitempty"`
+	Status QualityGateStatus `json:"status"`
+	// Message 无法计算或未通过时的说明
+	Message string `json:"message,omitempty"`
+}
+
+// NewExptQualityGateResult 按各规则结论汇总: 任一 error 则 error, 否则任一 failed 则 failed
+func NewExptQualityGateResult(ruleResults []*ExptQualityGateRuleResult, evaluatedAt int64) *ExptQualityGateResult {
+	status := QualityGateStatusPassed
+	for _, rr := range ruleResults {
+		switch rr.Status {
+		case QualityGateStatusError:
+			status = QualityGateStatusError
+		case QualityGateStatusFailed:
+			if status != QualityGateStatusError {
+				status = QualityGateStatusFailed
+			}
+		}
+	}
+	return &ExptQualityGateResult{
+		Status:      status,
+		Passed:      status == QualityGateStatusPassed,
+		RuleResults: ruleResults,
+		EvaluatedAt: evaluatedAt,
+	}
+}
+
+// NewExptQualityGateErrorResult 整体评估失败时的 error 结论, 覆盖旧结论, 对 CI 而言等同未通过
+func NewExptQualityGateErrorResult(msg string, evaluatedAt int64) *ExptQualityGateResult {
+	return &ExptQualityGateResult{
+		Status:      QualityGateStatusError,
+		Passed:      false,
+		EvaluatedAt: evaluatedAt,
+		Message:     msg,
+	}
+}
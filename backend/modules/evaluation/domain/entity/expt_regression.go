@@ -0,0 +1,134 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+const (
+	MaxRegressionCandidateExptCnt = 10
+	DefaultRegressionMaxItemCnt   = 1000
+	MaxRegressionMaxItemCnt       = 10000
+)
+
+// ExptRegressionChangeType 候选实验相对基线在某个 turn 上的变化方向
+type ExptRegressionChangeType string
+
+const (
+	ExptRegressionChangeImproved  ExptRegressionChangeType = "improved"
+	ExptRegressionChangeRegressed ExptRegressionChangeType = "regressed"
+	// ExptRegressionChangeMixed 部分得分上升、部分得分下降
+	ExptRegressionChangeMixed ExptRegressionChangeType = "mixed"
+)
+
+// ExptRegressionReportParam 跨实验回归报告参数: 以 BaseExptID 为基线, 按 (item_id, turn_id) 对齐候选实验,
+// 列出加权得分或任一评估器得分变化超过 Threshold 的 turn。
+type ExptRegressionReportParam struct {
+	SpaceID          int64
+	BaseExptID       int64
+	CandidateExptIDs []int64
+	// Threshold |candidate - base| > Threshold 视为发生变化, 默认 0 (任意变化)
+	Threshold float64
+	// ChangeTypes 非空时仅返回指定变化方向的 turn (以任一候选实验命中为准)
+	ChangeTypes []ExptRegressionChangeType
+
+	// Filter / FilterAccelerator 作用于基线实验, 与实验详情页筛选口径一致 (复用 ExptResultService.ListTurnResult);
+	// UseAccelerator 为 true 时使用 FilterAccelerator 走 CK expt_turn_result_filter 查询。
+	Filter            *ExptTurnResultFilter
+	FilterAccelerator *ExptTurnResultFilterAccelerator
+	UseAccelerator    bool
+
+	// OutputFieldKeys 并排展示的评测对象输出字段, 空表示全部输出字段
+	OutputFieldKeys []string
+	// MaxItemCnt 返回的 turn 数上限, 默认 1000
+	MaxItemCnt int
+}
+
+func (p *ExptRegressionReportParam) HasFilter() bool {
+	if p == nil {
+		return false
+	}
+	if p.UseAccelerator {
+		return p.FilterAccelerator != nil && p.FilterAccelerator.HasFilters()
+	}
+	return p.Filter != nil && (len(p.Filter.TrunRunStateFilters) > 0 || len(p.Filter.ScoreFilters) > 0)
+}
+
+func (p *ExptRegressionReportParam) GetMaxItemCnt() int {
+	if p == nil || p.MaxItemCnt <= 0 {
+		return DefaultRegressionMaxItemCnt
+	}
+	if p.MaxItemCnt > MaxRegressionMaxItemCnt {
+		return MaxRegressionMaxItemCnt
+	}
+	return p.MaxItemCnt
+}
+
+type ExptRegressionReport struct {
+	BaseExptID int64
+	Summaries  []*ExptRegressionSummary
+	// Items 按 (item_id, turn_id) 升序排列
+	Items []*ExptRegressionItem
+	// Truncated 发生变化的 turn 数超过 MaxItemCnt, Items 已被截断 (Summaries 仍为全量统计)
+	Truncated bool
+}
+
+// ExptRegressionSummary 某个候选实验相对基线的变化统计
+type ExptRegressionSummary struct {
+	CandidateExptID int64
+	// PairedTurnCnt 两侧都存在的 turn 数 (经基线筛选后)
+	PairedTurnCnt int64
+	ImprovedCnt   int64
+	RegressedCnt  int64
+	MixedCnt      int64
+	UnchangedCnt  int64
+}
+
+// ExptRegressionItem 发生变化的 turn, 基线与各候选实验的结果并排展示
+type ExptRegressionItem struct {
+	ItemID     int64
+	TurnID     int64
+	Base       *ExptRegressionTurnResult
+	Candidates []*ExptRegressionCandidateResult
+}
+
+// AllResults 基线在前, 候选实验按参数顺序在后
+func (i *ExptRegressionItem) AllResults() []*ExptRegressionTurnResult {
+	res := make([]*ExptRegressionTurnResult, 0, len(i.Candidates)+1)
+	if i.Base != nil {
+		res = append(res, i.Base)
+	}
+	for _, c := range i.Candidates {
+		if c != nil && c.ExptRegressionTurnResult != nil {
+			res = append(res, c.ExptRegressionTurnResult)
+		}
+	}
+	return res
+}
+
+// ExptRegressionTurnResult 某实验在该 turn 上的结果
+type ExptRegressionTurnResult struct {
+	ExptID        int64
+	TargetOutputs map[string]*Content
+	WeightedScore *float64
+	// EvaluatorScores key 为评估器实例 key (EncodeEvaluatorInstanceKey)
+	EvaluatorScores map[string]float64
+}
+
+type ExptRegressionCandidateResult struct {
+	*ExptRegressionTurnResult
+	// Change 为空表示该候选实验在此 turn 上无超过阈值的变化
+	Change             ExptRegressionChangeType
+	WeightedScoreDelta *float64
+	// EvaluatorDeltas 仅包含两侧都有得分的评估器实例, 按实例 key 排序
+	EvaluatorDeltas []*ExptRegressionEvaluatorDelta
+}
+
+type ExptRegressionEvaluatorDelta struct {
+	EvaluatorVersionID int64
+	Alias              string
+	BaseScore          float64
+	CandidateScore     float64
+	// Delta = CandidateScore - BaseScore
+	Delta float64
+	// Changed |Delta| > Threshold
+	Changed bool
+}
@@ -41,6 +41,9 @@ const (
 	// 加权得分, FieldKey为expt_id
 	FieldType_WeightedScore FieldType = 24
 
+	// 重复试验指标 (pass@k / 一致率), FieldKey为评估器实例 key
+	FieldType_EvaluatorTrial FieldType = 25
+
 	FieldType_TargetLatency      FieldType = 50
 	FieldType_TargetInputTokens  FieldType = 51
 	FieldType_TargetOutputTokens FieldType = 52
@@ -131,6 +134,10 @@ type AggregatorResult struct {
 // expt_aggr_result 表 aggr_result 字段blob结构
 type AggregateResult struct {
 	AggregatorResults []*AggregatorResult
+	// Agreement 仅人工标注项: 多标注者一致性度量
+	Agreement *AnnotationAgreement `json:",omitempty"`
+	// Trial 仅重复试验指标: 评估器实例的 pass@k / 一致率
+	Trial *EvaluatorTrialAggregate `json:",omitempty"`
 }
 
 func (a AggregatorResult) GetScore() float64 {
@@ -202,6 +209,8 @@ type EvaluatorAggregateResult struct {
 	Version            *string
 	// Alias 评估器多实例别名; 同 versionID 多实例时区分 (default/judge_b 等), 老数据为空串。
 	Alias string
+	// Trial 重复试验实验的 pass@k / 一致率指标, 未开启重复试验时为空
+	Trial *EvaluatorTrialAggregate
 }
 
 // 人工标注项粒度聚合结果
@@ -209,6 +218,8 @@ type AnnotationAggregateResult struct {
 	TagKeyID          int64
 	AggregatorResults []*AggregatorResult
 	Name              *string
+	// Agreement 多标注者一致性及评估器-人工一致性, 无多人标注且无评估器可对比时为空
+	Agreement *AnnotationAgreement
 }
 
 type EvalTargetMtrAggrResult struct {
@@ -248,7 +259,9 @@ type ExptItemResultRunLog struct {
 	ErrMsg        []byte
 	LogID         string
 	ResultState   int32
-	UpdatedAt     *time.Time
+	// Attempt 执行轮次, 僵尸续跑认领时 +1; 事件轮次与之不一致的执行已被接替, 其写入被拒绝
+	Attempt   int32
+	UpdatedAt *time.Time
 }
 
 type ExptItemEvalResult struct {
@@ -268,6 +281,7 @@ type ExptEvalItem struct {
 	ItemID           int64
 	State            ItemRunState
 	UpdatedAt        *time.Time
+	Attempt          int32
 }
 
 func (e *ExptEvalItem) SetState(state ItemRunState) *ExptEvalItem {
@@ -294,10 +308,24 @@ type ExptStats struct {
 	CreditCost        float64
 	InputTokenCost    int64
 	OutputTokenCost   int64
+	// TargetCost / EvaluatorCost 按模型价目表核算的评测对象、评估器花费, item 结果落库时累加
+	TargetCost    float64
+	EvaluatorCost float64
+	// EffectiveConcurNum 调度器当前生效的 item 并发, 开启并发自适应时随后端观测变化
+	EffectiveConcurNum int32
+	// BudgetDeferredCnt 最近一轮调度中, 因后端共享提交预算不足而延后提交的 item 数
+	BudgetDeferredCnt int32
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
 }
 
+func (s *ExptStats) GetTotalCost() float64 {
+	if s == nil {
+		return 0
+	}
+	return s.TargetCost + s.EvaluatorCost
+}
+
 type ExptTurnResult struct {
 	ID               int64
 	SpaceID          int64
@@ -315,6 +343,9 @@ type ExptTurnResult struct {
 	TurnIdx          int32
 
 	WeightedScore *float64 // 使用指针类型，nil 表示未计算，非 nil 表示已计算（可能为 0）
+	CacheHit      ResultCacheHitFlag
+	// TrialResults 重复试验实验中第 1~K-1 次试验的结果引用
+	TrialResults []*ExptTurnTrialResult
 }
 
 func (tr *ExptTurnResult) ToRunLogDO() *ExptTurnResultRunLog {
@@ -411,9 +442,11 @@ type MGetExperimentResultParam struct {
 }
 
 type MGetExperimentReportResult struct {
-	ColumnEvaluators      []*ColumnEvaluator
-	ExptColumnEvaluators  []*ExptColumnEvaluator
-	ColumnEvalSetFields   []*ColumnEvalSetField
+	ColumnEvaluators     []*ColumnEvaluator
+	ExptColumnEvaluators []*ExptColumnEvaluator
+	ColumnEvalSetFields  []*ColumnEvalSetField
+	// EvalSetIDsByFieldKey 多评测集实验中各评测集字段列 (按 Key) 所属的评测集 ID, 单评测集实验为 nil
+	EvalSetIDsByFieldKey  map[string][]int64
 	ExptColumnAnnotations []*ExptColumnAnnotation
 	ItemResults           []*ItemResult
 	ExptColumnsEvalTarget []*ExptColumnEvalTarget
@@ -438,6 +471,9 @@ type ExptTurnResultRunLog struct {
 	ErrMsg             string
 	UpdatedAt          time.Time
 	Ext                map[string]string
+	CacheHit           ResultCacheHitFlag
+	TrialResults       []*ExptTurnTrialResult
+	Checkpoint         *ExptTurnCheckpoint
 }
 
 type ExptTurnEvaluatorResultRef struct {
@@ -775,6 +811,14 @@ type TurnEvaluatorOutput struct {
 	WeightedScore    *float64 // 加权汇总得分
 }
 
+// TurnTrialOutput 重复试验实验中单次额外试验的评测对象与评估器结果
+type TurnTrialOutput struct {
+	TrialIdx        int32
+	TargetOutput    *TurnTargetOutput
+	EvaluatorOutput *TurnEvaluatorOutput
+	ErrMsg          string
+}
+
 type TurnAnnotateResult struct {
 	AnnotateRecords map[int64]*AnnotateRecord
 }
@@ -830,6 +874,8 @@ type ExperimentTurnPayload struct {
 	AnnotateResult *TurnAnnotateResult
 	// 分析结果
 	AnalysisRecord *AnalysisRecord
+	// 重复试验第 1~K-1 次试验结果, 按 TrialIdx 升序, 未开启重复试验时为空
+	TrialOutputs []*TurnTrialOutput
 }
 
 type ExperimentResult struct {
@@ -0,0 +1,173 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+)
+
+// ExptResultCacheConf 实验结果缓存配置 (opt-in), 序列化进 experiment.eval_conf。
+// 开启后同空间内评测对象版本 + 输入 + 运行时参数完全一致的调用直接复用历史 EvalTargetRecord,
+// 评估器版本 + 评估器输入一致的调用直接复用历史 EvaluatorRecord, 不再重复执行。
+type ExptResultCacheConf struct {
+	EnableTargetCache    bool `json:"enable_target_cache,omitempty"`
+	EnableEvaluatorCache bool `json:"enable_evaluator_cache,omitempty"`
+	// TTLSeconds 仅复用该时长内写入的缓存结果, 为 0 时以空间缓存存活时间为准
+	TTLSeconds int64 `json:"ttl_seconds,omitempty"`
+}
+
+func (c *ExptResultCacheConf) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.TTLSeconds < 0 {
+		return fmt.Errorf("invalid result cache ttl_seconds %v", c.TTLSeconds)
+	}
+	return nil
+}
+
+// MaxAge 可复用缓存条目的最大存活时间, 0 表示不限制
+func (c *ExptResultCacheConf) MaxAge() time.Duration {
+	if c == nil || c.TTLSeconds <= 0 {
+		return 0
+	}
+	return time.Duration(c.TTLSeconds) * time.Second
+}
+
+func (c *ExptResultCacheConf) TargetCacheEnabled() bool {
+	return c != nil && c.EnableTargetCache
+}
+
+func (c *ExptResultCacheConf) EvaluatorCacheEnabled() bool {
+	return c != nil && c.EnableEvaluatorCache
+}
+
+type ResultCacheKind string
+
+const (
+	ResultCacheKindTarget    ResultCacheKind = "target"
+	ResultCacheKindEvaluator ResultCacheKind = "evaluator"
+)
+
+// ResultCacheHitExtKey 复用产生的 record 在 Ext 中记录被复用的源 record id
+const ResultCacheHitExtKey = "result_cache_hit_record_id"
+
+// ResultCacheEntry 缓存条目, 指向可复用的源 record
+type ResultCacheEntry struct {
+	// RecordSpaceID 源 record 所在空间 (跨空间共享时评测对象 record 落在来源空间)
+	RecordSpaceID int64 `json:"record_space_id"`
+	RecordID      int64 `json:"record_id"`
+	CreatedAt     int64 `json:"created_at"`
+}
+
+// ResultCacheSpaceConf 空间级缓存配置。
+// Generation 每次失效递增, 作为缓存 key 的一部分, 旧 generation 下的条目随 TTL 自然过期。
+type ResultCacheSpaceConf struct {
+	SpaceID    int64
+	TTL        time.Duration
+	Generation int64
+}
+
+// ResultCacheHitFlag turn 结果的缓存命中标记, 按位组合
+type ResultCacheHitFlag int32
+
+const (
+	ResultCacheHitFlagTarget    ResultCacheHitFlag = 1 << 0
+	ResultCacheHitFlagEvaluator ResultCacheHitFlag = 1 << 1
+)
+
+func (f ResultCacheHitFlag) TargetHit() bool {
+	return f&ResultCacheHitFlagTarget != 0
+}
+
+func (f ResultCacheHitFlag) EvaluatorHit() bool {
+	return f&ResultCacheHitFlagEvaluator != 0
+}
+
+// resultCacheExtKeys 参与缓存 key 计算的 Ext key。其余 Ext (trace/span/run_id/start_time 等) 每次执行都不同, 不参与。
+var resultCacheExtKeys = []string{
+	consts.TargetExecuteExtRuntimeParamKey,
+	consts.TargetExecuteExtRunConfKey,
+	consts.TargetExecuteExtRunModeConfigKey,
+	consts.FieldAdapterBuiltinFieldNameSkillTOSKeys,
+}
+
+func pickResultCacheExt(ext map[string]string) map[string]string {
+	picked := make(map[string]string)
+	for _, k := range resultCacheExtKeys {
+		if v, ok := ext[k]; ok {
+			picked[k] = v
+		}
+	}
+	return picked
+}
+
+// BuildTargetResultCacheKey 按 (评测对象版本, 解析后的输入, 运行时参数) 计算内容寻址 key。
+// 输入中存在被裁剪的大字段时无法保证内容一致, 返回 false 表示不可缓存。
+func BuildTargetResultCacheKey(targetVersionID int64, input *EvalTargetInputData) (string, bool) {
+	if targetVersionID <= 0 || input == nil || hasOmittedContent(input.InputFields) {
+		return "", false
+	}
+	return hashResultCacheKey(struct {
+		TargetVersionID int64               `json:"target_version_id"`
+		History         []*Message          `json:"history,omitempty"`
+		InputFields     map[string]*Content `json:"input_fields,omitempty"`
+		Ext             map[string]string   `json:"ext,omitempty"`
+	}{
+		TargetVersionID: targetVersionID,
+		History:         input.HistoryMessages,
+		InputFields:     input.InputFields,
+		Ext:             pickResultCacheExt(input.Ext),
+	})
+}
+
+// BuildEvaluatorResultCacheKey 按 (评估器版本, 评估器输入, 运行配置) 计算内容寻址 key。
+func BuildEvaluatorResultCacheKey(evaluatorVersionID int64, input *EvaluatorInputData, runConf *EvaluatorRunConfig) (string, bool) {
+	if evaluatorVersionID <= 0 || input == nil ||
+		hasOmittedContent(input.InputFields) || hasOmittedContent(input.EvaluateDatasetFields) || hasOmittedContent(input.EvaluateTargetOutputFields) {
+		return "", false
+	}
+	return hashResultCacheKey(struct {
+		EvaluatorVersionID int64               `json:"evaluator_version_id"`
+		History            []*Message          `json:"history,omitempty"`
+		InputFields        map[string]*Content `json:"input_fields,omitempty"`
+		DatasetFields      map[string]*Content `json:"dataset_fields,omitempty"`
+		TargetOutputFields map[string]*Content `json:"target_output_fields,omitempty"`
+		Ext                map[string]string   `json:"ext,omitempty"`
+		RunConf            *EvaluatorRunConfig `json:"run_conf,omitempty"`
+	}{
+		EvaluatorVersionID: evaluatorVersionID,
+		History:            input.HistoryMessages,
+		InputFields:        input.InputFields,
+		DatasetFields:      input.EvaluateDatasetFields,
+		TargetOutputFields: input.EvaluateTargetOutputFields,
+		Ext:                pickResultCacheExt(input.Ext),
+		RunConf:            runConf,
+	})
+}
+
+func hasOmittedContent(fields map[string]*Content) bool {
+	for _, c := range fields {
+		if c != nil && c.IsContentOmitted() {
+			return true
+		}
+	}
+	return false
+}
+
+// hashResultCacheKey encoding/json 对 map 按 key 排序输出, 相同内容得到相同摘要
+func hashResultCacheKey(v any) (string, bool) {
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		return "", false
+	}
+	sum := sha256.Sum256(bytes)
+	return hex.EncodeToString(sum[:]), true
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+)
+
+func TestBuildTargetResultCacheKey(t *testing.T) {
+	input := func(text string, ext map[string]string) *EvalTargetInputData {
+		return &EvalTargetInputData{
+			InputFields: map[string]*Content{
+				"b": {ContentType: gptr.Of(ContentTypeText), Text: gptr.Of(text)},
+				"a": {ContentType: gptr.Of(ContentTypeText), Text: gptr.Of("fixed")},
+			},
+			Ext: ext,
+		}
+	}
+
+	key1, ok := BuildTargetResultCacheKey(1, input("q", map[string]string{"span_id": "1", consts.TargetExecuteExtRuntimeParamKey: "{}"}))
+	assert.True(t, ok)
+	// 执行期 trace 类 Ext 不参与 key
+	key2, ok := BuildTargetResultCacheKey(1, input("q", map[string]string{"span_id": "2", consts.TargetExecuteExtRuntimeParamKey: "{}"}))
+	assert.True(t, ok)
+	assert.Equal(t, key1, key2)
+
+	// 运行时参数、输入、评测对象版本任一不同则 key 不同
+	key3, _ := BuildTargetResultCacheKey(1, input("q", map[string]string{consts.TargetExecuteExtRuntimeParamKey: `{"t":1}`}))
+	key4, _ := BuildTargetResultCacheKey(1, input("other", map[string]string{consts.TargetExecuteExtRuntimeParamKey: "{}"}))
+	key5, _ := BuildTargetResultCacheKey(2, input("q", map[string]string{consts.TargetExecuteExtRuntimeParamKey: "{}"}))
+	assert.NotEqual(t, key1, key3)
+	assert.NotEqual(t, key1, key4)
+	assert.NotEqual(t, key1, key5)
+
+	omitted := input("q", nil)
+	omitted.InputFields["b"].ContentOmitted = gptr.Of(true)
+	_, ok = BuildTargetResultCacheKey(1, omitted)
+	assert.False(t, ok)
+	_, ok = BuildTargetResultCacheKey(0, input("q", nil))
+	assert.False(t, ok)
+}
+
+func TestBuildEvaluatorResultCacheKey(t *testing.T) {
+	input := &EvaluatorInputData{
+		InputFields:                map[string]*Content{"input": {ContentType: gptr.Of(ContentTypeText), Text: gptr.Of("q")}},
+		EvaluateTargetOutputFields: map[string]*Content{"actual_output": {ContentType: gptr.Of(ContentTypeText), Text: gptr.Of("a")}},
+		Ext:                        map[string]string{"run_id": "1"},
+	}
+	key1, ok := BuildEvaluatorResultCacheKey(10, input, nil)
+	assert.True(t, ok)
+	key2, _ := BuildEvaluatorResultCacheKey(10, input, &EvaluatorRunConfig{Env: gptr.Of("prod")})
+	key3, _ := BuildEvaluatorResultCacheKey(11, input, nil)
+	assert.NotEqual(t, key1, key2)
+	assert.NotEqual(t, key1, key3)
+
+	_, ok = BuildEvaluatorResultCacheKey(10, nil, nil)
+	assert.False(t, ok)
+}
+
+func TestExptTurnRunResult_GetCacheHitFlag(t *testing.T) {
+	hitExt := map[string]string{ResultCacheHitExtKey: "1"}
+	assert.Equal(t, ResultCacheHitFlag(0), (*ExptTurnRunResult)(nil).GetCacheHitFlag())
+
+	flag := (&ExptTurnRunResult{
+		TargetResult:     &EvalTargetRecord{Ext: hitExt},
+		EvaluatorResults: []*EvaluatorRecord{nil, {}},
+	}).GetCacheHitFlag()
+	assert.True(t, flag.TargetHit())
+	assert.False(t, flag.EvaluatorHit())
+
+	flag = (&ExptTurnRunResult{EvaluatorResults: []*EvaluatorRecord{{}, {Ext: hitExt}}}).GetCacheHitFlag()
+	assert.False(t, flag.TargetHit())
+	assert.True(t, flag.EvaluatorHit())
+}
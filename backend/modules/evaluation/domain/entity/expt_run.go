@@ -221,6 +221,10 @@ type ExptExecConf struct {
 	SpaceExptConcurLimit int `json:"space_expt_concur_limit" mapstructure:"space_expt_concur_limit"`
 
 	ExptItemEvalConf *ExptItemEvalConf `json:"expt_item_eval_conf" mapstructure:"expt_item_eval_conf"`
+	// AdaptiveConcurConf item 并发自适应配置, 为空或未开启时按 ExptItemEvalConf 固定并发调度
+	AdaptiveConcurConf *ExptAdaptiveConcurConf `json:"adaptive_concur_conf" mapstructure:"adaptive_concur_conf"`
+	// CheckpointConf turn 执行检查点与续跑配置, 为空或未开启时僵尸 item 直接置为失败
+	CheckpointConf *ExptCheckpointConf `json:"checkpoint_conf" mapstructure:"checkpoint_conf"`
 }
 
 func (e *ExptExecConf) GetSpaceExptConcurLimit() int {
@@ -251,6 +255,20 @@ func (e *ExptExecConf) GetExptItemEvalConf() *ExptItemEvalConf {
 	return nil
 }
 
+func (e *ExptExecConf) GetAdaptiveConcurConf() *ExptAdaptiveConcurConf {
+	if e != nil {
+		return e.AdaptiveConcurConf
+	}
+	return nil
+}
+
+func (e *ExptExecConf) GetCheckpointConf() *ExptCheckpointConf {
+	if e != nil {
+		return e.CheckpointConf
+	}
+	return nil
+}
+
 type ExptItemEvalConf struct {
 	ConcurNum         int `json:"concur_num" mapstructure:"concur_num"`
 	IntervalSecond    int `json:"interval_second" mapstructure:"interval_second"`
@@ -570,6 +588,8 @@ type ExptTurnRunResult struct {
 	EvaluatorResults []*EvaluatorRecord // slice, not map — supports alias multi-instances of same versionID
 	EvalErr          error
 	AsyncAbort       bool
+	// TrialResults 开启重复试验时第 1~K-1 次试验的结果
+	TrialResults []*ExptTurnTrialRunResult
 }
 
 func (e *ExptTurnRunResult) GetTargetResult() *EvalTargetRecord {
@@ -589,6 +609,24 @@ func (e *ExptTurnRunResult) SetEvaluatorResults(er []*EvaluatorRecord) *ExptTurn
 	return e
 }
 
+// GetCacheHitFlag 按 record Ext 中的复用标记汇总本 turn 的结果缓存命中情况
+func (e *ExptTurnRunResult) GetCacheHitFlag() ResultCacheHitFlag {
+	if e == nil {
+		return 0
+	}
+	var flag ResultCacheHitFlag
+	if e.TargetResult != nil && e.TargetResult.Ext[ResultCacheHitExtKey] != "" {
+		flag |= ResultCacheHitFlagTarget
+	}
+	for _, r := range e.EvaluatorResults {
+		if r != nil && r.Ext[ResultCacheHitExtKey] != "" {
+			flag |= ResultCacheHitFlagEvaluator
+			break
+		}
+	}
+	return flag
+}
+
 func (e *ExptTurnRunResult) SetEvalErr(err error) *ExptTurnRunResult {
 	e.EvalErr = err
 	return e
@@ -0,0 +1,296 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sort"
+)
+
+type ExptSamplingMode string
+
+const (
+	// ExptSamplingModeRandomN 简单随机抽取 SampleNum 条
+	ExptSamplingModeRandomN ExptSamplingMode = "random_n"
+	// ExptSamplingModePercentage 简单随机抽取 Percentage% 条
+	ExptSamplingModePercentage ExptSamplingMode = "percentage"
+	// ExptSamplingModeStratified 按 StratifyField 字段值分层, 样本量 (SampleNum 或 Percentage) 按层大小等比例分配
+	ExptSamplingModeStratified ExptSamplingMode = "stratified"
+)
+
+const (
+	// ExptItemResultExtKeySamplingStratum 抽样实验 item 结果 Ext 中记录 item 所属分层
+	ExptItemResultExtKeySamplingStratum = "sampling_stratum"
+
+	// MaxExptSamplingStrataCnt 分层数上限, 避免按高基数字段分层
+	MaxExptSamplingStrataCnt = 500
+	// DefaultExptSamplingConfidence 抽样聚合结果的默认置信水平
+	DefaultExptSamplingConfidence = 0.95
+)
+
+// ExptSamplingConf 实验抽样配置, 序列化进 experiment.eval_conf, 仅单评测集实验生效。
+// 抽样在实验启动扫描评测集时进行: 以 Seed 对 item_id 做哈希得到伪随机序, 每层取序最小的若干条,
+// 同一 Seed 与评测集版本下抽样结果稳定, 重跑可复现。
+type ExptSamplingConf struct {
+	Mode ExptSamplingMode `json:"mode"`
+	// SampleNum 样本量, random_n 必填; stratified 下与 Percentage 二选一
+	SampleNum int `json:"sample_num,omitempty"`
+	// Percentage 抽样比例, 取值 (0, 100]
+	Percentage float64 `json:"percentage,omitempty"`
+	// StratifyField 分层字段名 (评测集列名), stratified 必填; 取 item 首个 turn 的字段文本值, 缺失归入空值层
+	StratifyField string `json:"stratify_field,omitempty"`
+	// Seed 随机种子, 创建实验时为空则自动生成并回写
+	Seed int64 `json:"seed,omitempty"`
+}
+
+func (c *ExptSamplingConf) IsEnabled() bool {
+	return c != nil && c.Mode != ""
+}
+
+func (c *ExptSamplingConf) Validate() error {
+	if !c.IsEnabled() {
+		return nil
+	}
+	if c.Percentage < 0 || c.Percentage > 100 {
+		return fmt.Errorf("sampling percentage must be in (0, 100]")
+	}
+	if c.SampleNum < 0 {
+		return fmt.Errorf("sampling sample_num must not be negative")
+	}
+	switch c.Mode {
+	case ExptSamplingModeRandomN:
+		if c.SampleNum <= 0 {
+			return fmt.Errorf("sampling mode %s requires sample_num", c.Mode)
+		}
+	case ExptSamplingModePercentage:
+		if c.Percentage <= 0 {
+			return fmt.Errorf("sampling mode %s requires percentage", c.Mode)
+		}
+	case ExptSamplingModeStratified:
+		if c.StratifyField == "" {
+			return fmt.Errorf("sampling mode %s requires stratify_field", c.Mode)
+		}
+		if (c.SampleNum > 0) == (c.Percentage > 0) {
+			return fmt.Errorf("sampling mode %s requires exactly one of sample_num and percentage", c.Mode)
+		}
+	default:
+		return fmt.Errorf("unknown sampling mode %s", c.Mode)
+	}
+	return nil
+}
+
+// GetSampleSize 总体为 population 时的总样本量
+func (c *ExptSamplingConf) GetSampleSize(population int) int {
+	if !c.IsEnabled() || population <= 0 {
+		return population
+	}
+	size := c.SampleNum
+	if size <= 0 {
+		size = int(math.Ceil(float64(population) * c.Percentage / 100))
+	}
+	if size > population {
+		size = population
+	}
+	return size
+}
+
+// ExptSamplingCandidate 抽样候选 item
+type ExptSamplingCandidate struct {
+	ItemID  int64
+	Stratum string
+}
+
+// Sample 对候选 item 抽样, 返回入选 item_id 到分层的映射与抽样结果。
+// 非分层模式下所有 item 属于同一个空值层。
+func (c *ExptSamplingConf) Sample(candidates []*ExptSamplingCandidate) (map[int64]string, *ExptSamplingResult, error) {
+	strata := make(map[string][]*ExptSamplingCandidate)
+	for _, cand := range candidates {
+		stratum := ""
+		if c.Mode == ExptSamplingModeStratified {
+			stratum = cand.Stratum
+		}
+		strata[stratum] = append(strata[stratum], cand)
+	}
+	if len(strata) > MaxExptSamplingStrataCnt {
+		return nil, nil, fmt.Errorf("sampling strata count %d exceeds limit %d", len(strata), MaxExptSamplingStrataCnt)
+	}
+
+	values := make([]string, 0, len(strata))
+	populations := make(map[string]int, len(strata))
+	for value, members := range strata {
+		values = append(values, value)
+		populations[value] = len(members)
+	}
+	sort.Strings(values)
+	alloc := allocateSampleSize(values, populations, c.GetSampleSize(len(candidates)))
+
+	selected := make(map[int64]string)
+	res := &ExptSamplingResult{
+		Mode:          c.Mode,
+		Seed:          c.Seed,
+		StratifyField: c.StratifyField,
+		PopulationCnt: int64(len(candidates)),
+	}
+	for _, value := range values {
+		members := strata[value]
+		sort.Slice(members, func(i, j int) bool {
+			pi, pj := samplingPriority(c.Seed, members[i].ItemID), samplingPriority(c.Seed, members[j].ItemID)
+			if pi != pj {
+				return pi < pj
+			}
+			return members[i].ItemID < members[j].ItemID
+		})
+		n := alloc[value]
+		for _, m := range members[:n] {
+			selected[m.ItemID] = value
+		}
+		res.SampleCnt += int64(n)
+		res.Strata = append(res.Strata, &ExptSamplingStratum{
+			Value:         value,
+			PopulationCnt: int64(len(members)),
+			SampleCnt:     int64(n),
+		})
+	}
+	for _, s := range res.Strata {
+		s.Weight = s.GetWeight()
+	}
+	return selected, res, nil
+}
+
+// allocateSampleSize 按层大小等比例分配样本量 (最大余数法), 样本量不小于层数时每层至少 1 条
+func allocateSampleSize(values []string, populations map[string]int, total int) map[string]int {
+	alloc := make(map[string]int, len(values))
+	population := 0
+	for _, v := range values {
+		population += populations[v]
+	}
+	if population == 0 || total <= 0 {
+		return alloc
+	}
+
+	remainders := make(map[string]float64, len(values))
+	assigned := 0
+	for _, v := range values {
+		exact := float64(total) * float64(populations[v]) / float64(population)
+		n := int(math.Floor(exact))
+		if n == 0 && total >= len(values) {
+			n = 1
+		}
+		if n > populations[v] {
+			n = populations[v]
+		}
+		alloc[v] = n
+		remainders[v] = exact - math.Floor(exact)
+		assigned += n
+	}
+
+	order := append([]string(nil), values...)
+	sort.SliceStable(order, func(i, j int) bool { return remainders[order[i]] > remainders[order[j]] })
+	for assigned < total {
+		progressed := false
+		for _, v := range order {
+			if assigned >= total {
+				break
+			}
+			if alloc[v] < populations[v] {
+				alloc[v]++
+				assigned++
+				progressed = true
+			}
+		}
+		if !progressed {
+			break
+		}
+	}
+	// 每层保底 1 条可能使总量超出, 从样本最多的层回收
+	for assigned > total {
+		maxV := values[0]
+		for _, v := range values[1:] {
+			if alloc[v] > alloc[maxV] {
+				maxV = v
+			}
+		}
+		if alloc[maxV] <= 1 {
+			break
+		}
+		alloc[maxV]--
+		assigned--
+	}
+	return alloc
+}
+
+// samplingPriority 以 seed 对 item_id 哈希得到伪随机序, 与 item 扫描顺序无关
+func samplingPriority(seed, itemID int64) uint64 {
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:8], uint64(seed))
+	binary.BigEndian.PutUint64(buf[8:], uint64(itemID))
+	h := fnv.New64a()
+	_, _ = h.Write(buf[:])
+	return h.Sum64()
+}
+
+// ExptSamplingResult 实验抽样结果, 实验启动时写入 experiment.sampling_result
+type ExptSamplingResult struct {
+	Mode          ExptSamplingMode       `json:"mode"`
+	Seed          int64                  `json:"seed"`
+	StratifyField string                 `json:"stratify_field,omitempty"`
+	PopulationCnt int64                  `json:"population_cnt"`
+	SampleCnt     int64                  `json:"sample_cnt"`
+	Strata        []*ExptSamplingStratum `json:"strata"`
+}
+
+func (r *ExptSamplingResult) GetStratum(value string) *ExptSamplingStratum {
+	if r == nil {
+		return nil
+	}
+	for _, s := range r.Strata {
+		if s.Value == value {
+			return s
+		}
+	}
+	return nil
+}
+
+type ExptSamplingStratum struct {
+	Value         string `json:"value"`
+	PopulationCnt int64  `json:"population_cnt"`
+	SampleCnt     int64  `json:"sample_cnt"`
+	// Weight 抽样权重, 即每条样本代表的总体 item 数 (PopulationCnt / SampleCnt)
+	Weight float64 `json:"weight"`
+}
+
+func (s *ExptSamplingStratum) GetWeight() float64 {
+	if s == nil || s.SampleCnt == 0 {
+		return 0
+	}
+	return float64(s.PopulationCnt) / float64(s.SampleCnt)
+}
+
+// ExptSampledAggrResult 抽样实验按抽样权重估计的总体聚合结果
+type ExptSampledAggrResult struct {
+	ExptID     int64
+	Sampling   *ExptSamplingResult
+	Confidence float64
+	// EvaluatorResults 按评估器实例 (versionID + alias) 展开, 按实例 key 排序
+	EvaluatorResults []*ExptSampledEvaluatorAggr
+}
+
+// ExptSampledEvaluatorAggr 单个评估器实例的加权估计
+type ExptSampledEvaluatorAggr struct {
+	EvaluatorVersionID int64
+	Alias              string
+	// ScoredCnt 参与估计的有成功得分的样本 item 数
+	ScoredCnt int64
+	// UnweightedMean 样本算术平均
+	UnweightedMean float64
+	// Mean 按分层权重估计的总体均值
+	Mean float64
+	// StdErr 总体均值估计的标准误 (含有限总体校正)
+	StdErr  float64
+	CILower float64
+	CIUpper float64
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExptSamplingConf_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		conf    *ExptSamplingConf
+		wantErr bool
+	}{
+		{name: "nil", conf: nil},
+		{name: "random n", conf: &ExptSamplingConf{Mode: ExptSamplingModeRandomN, SampleNum: 10}},
+		{name: "random n without sample num", conf: &ExptSamplingConf{Mode: ExptSamplingModeRandomN}, wantErr: true},
+		{name: "percentage", conf: &ExptSamplingConf{Mode: ExptSamplingModePercentage, Percentage: 5}},
+		{name: "percentage out of range", conf: &ExptSamplingConf{Mode: ExptSamplingModePercentage, Percentage: 120}, wantErr: true},
+		{name: "stratified", conf: &ExptSamplingConf{Mode: ExptSamplingModeStratified, StratifyField: "category", SampleNum: 10}},
+		{name: "stratified without field", conf: &ExptSamplingConf{Mode: ExptSamplingModeStratified, SampleNum: 10}, wantErr: true},
+		{name: "stratified with both sizes", conf: &ExptSamplingConf{Mode: ExptSamplingModeStratified, StratifyField: "category", SampleNum: 10, Percentage: 5}, wantErr: true},
+		{name: "unknown mode", conf: &ExptSamplingConf{Mode: "systematic", SampleNum: 10}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.conf.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func newSamplingCandidates(strata map[string]int) []*ExptSamplingCandidate {
+	var res []*ExptSamplingCandidate
+	id := int64(1)
+	for _, value := range []string{"a", "b", "c", ""} {
+		for i := 0; i < strata[value]; i++ {
+			res = append(res, &ExptSamplingCandidate{ItemID: id, Stratum: value})
+			id++
+		}
+	}
+	return res
+}
+
+func TestExptSamplingConf_Sample(t *testing.T) {
+	t.Run("random n is reproducible with same seed", func(t *testing.T) {
+		candidates := newSamplingCandidates(map[string]int{"a": 100})
+		conf := &ExptSamplingConf{Mode: ExptSamplingModeRandomN, SampleNum: 10, Seed: 42}
+
+		selected, res, err := conf.Sample(candidates)
+		assert.NoError(t, err)
+		assert.Len(t, selected, 10)
+		assert.Equal(t, int64(100), res.PopulationCnt)
+		assert.Equal(t, int64(10), res.SampleCnt)
+		assert.Len(t, res.Strata, 1)
+		assert.InDelta(t, 10.0, res.Strata[0].Weight, 1e-9)
+
+		// 扫描顺序不影响结果
+		reversed := make([]*ExptSamplingCandidate, len(candidates))
+		for i, c := range candidates {
+			reversed[len(candidates)-1-i] = c
+		}
+		again, _, err := conf.Sample(reversed)
+		assert.NoError(t, err)
+		assert.Equal(t, selected, again)
+
+		other, _, err := (&ExptSamplingConf{Mode: ExptSamplingModeRandomN, SampleNum: 10, Seed: 7}).Sample(candidates)
+		assert.NoError(t, err)
+		assert.NotEqual(t, selected, other)
+	})
+
+	t.Run("percentage rounds up and caps at population", func(t *testing.T) {
+		selected, res, err := (&ExptSamplingConf{Mode: ExptSamplingModePercentage, Percentage: 2.5, Seed: 1}).Sample(newSamplingCandidates(map[string]int{"a": 100}))
+		assert.NoError(t, err)
+		assert.Len(t, selected, 3)
+		assert.Equal(t, int64(3), res.SampleCnt)
+
+		selected, _, err = (&ExptSamplingConf{Mode: ExptSamplingModeRandomN, SampleNum: 50, Seed: 1}).Sample(newSamplingCandidates(map[string]int{"a": 5}))
+		assert.NoError(t, err)
+		assert.Len(t, selected, 5)
+	})
+
+	t.Run("stratified allocates proportionally with at least one per stratum", func(t *testing.T) {
+		conf := &ExptSamplingConf{Mode: ExptSamplingModeStratified, StratifyField: "category", SampleNum: 10, Seed: 3}
+		selected, res, err := conf.Sample(newSamplingCandidates(map[string]int{"a": 80, "b": 18, "c": 2}))
+		assert.NoError(t, err)
+		assert.Len(t, selected, 10)
+		assert.Equal(t, int64(10), res.SampleCnt)
+
+		got := map[string]int64{}
+		for _, s := range res.Strata {
+			got[s.Value] = s.SampleCnt
+		}
+		assert.Equal(t, map[string]int64{"a": 8, "b": 1, "c": 1}, got)
+		assert.InDelta(t, 2.0, res.GetStratum("c").Weight, 1e-9)
+		for itemID, stratum := range selected {
+			switch {
+			case itemID <= 80:
+				assert.Equal(t, "a", stratum)
+			case itemID <= 98:
+				assert.Equal(t, "b", stratum)
+			default:
+				assert.Equal(t, "c", stratum)
+			}
+		}
+	})
+
+	t.Run("too many strata", func(t *testing.T) {
+		candidates := make([]*ExptSamplingCandidate, 0, MaxExptSamplingStrataCnt+1)
+		for i := 0; i <= MaxExptSamplingStrataCnt; i++ {
+			candidates = append(candidates, &ExptSamplingCandidate{ItemID: int64(i + 1), Stratum: string(rune('A' + i))})
+		}
+		_, _, err := (&ExptSamplingConf{Mode: ExptSamplingModeStratified, StratifyField: "f", Percentage: 10}).Sample(candidates)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+const (
+	DefaultSignificanceConfidence          = 0.95
+	DefaultSignificanceBootstrapIterations = 1000
+	MaxSignificanceBootstrapIterations     = 10000
+	MaxSignificanceCandidateExptCnt        = 10
+)
+
+// ExptSignificanceParam 多实验显著性对比参数: 以 BaseExptID 为基线, 逐个与 CandidateExptIDs 配对比较。
+// 配对粒度为 (item_id, turn_id), 仅两侧都有成功评估结果的 turn 参与计算。
+type ExptSignificanceParam struct {
+	SpaceID          int64
+	BaseExptID       int64
+	CandidateExptIDs []int64
+	// Confidence 置信水平, 默认 0.95; 同时决定 Significant 判定使用的显著性水平 (1 - Confidence)
+	Confidence float64
+	// BootstrapIterations bootstrap 重采样次数, 默认 1000
+	BootstrapIterations int
+	// Seed bootstrap 随机种子, 固定后结果可复现
+	Seed int64
+	// TieThreshold |candidate - base| <= TieThreshold 视为平局, 默认 0 (严格相等)
+	TieThreshold float64
+}
+
+func (p *ExptSignificanceParam) GetConfidence() float64 {
+	if p == nil || p.Confidence <= 0 || p.Confidence >= 1 {
+		return DefaultSignificanceConfidence
+	}
+	return p.Confidence
+}
+
+func (p *ExptSignificanceParam) GetBootstrapIterations() int {
+	if p == nil || p.BootstrapIterations <= 0 {
+		return DefaultSignificanceBootstrapIterations
+	}
+	if p.BootstrapIterations > MaxSignificanceBootstrapIterations {
+		return MaxSignificanceBootstrapIterations
+	}
+	return p.BootstrapIterations
+}
+
+type ExptSignificanceResult struct {
+	BaseExptID  int64
+	Comparisons []*ExptPairSignificance
+}
+
+// ExptPairSignificance 基线实验与某一个候选实验的对比结果
+type ExptPairSignificance struct {
+	CandidateExptID int64
+	// EvaluatorResults 按评估器实例 (versionID + alias) 展开, 仅包含两侧实验都绑定的评估器实例
+	EvaluatorResults []*EvaluatorSignificance
+}
+
+// EvaluatorSignificance 单个评估器实例在两个实验间的配对统计结果
+type EvaluatorSignificance struct {
+	EvaluatorVersionID int64
+	Alias              string
+	// PairedCount 参与配对的 turn 数
+	PairedCount   int64
+	BaseMean      float64
+	CandidateMean float64
+	// MeanDelta = CandidateMean - BaseMean
+	MeanDelta float64
+	// CILower/CIUpper 配对 bootstrap 得到的 MeanDelta 置信区间
+	CILower float64
+	CIUpper float64
+	// TTestPValue 配对 t 检验双侧 p 值
+	TTestPValue float64
+	// WilcoxonPValue Wilcoxon 符号秩检验双侧 p 值
+	WilcoxonPValue float64
+	// WinCount/TieCount/LossCount 以候选实验视角统计
+	WinCount  int64
+	TieCount  int64
+	LossCount int64
+	// Significant 配对 t 检验 p 值小于 1 - Confidence 且 bootstrap 置信区间不跨 0
+	Significant bool
+}
@@ -175,6 +175,7 @@ type ExptTemplateFieldMapping struct {
 	FieldName     string
 	ConstValue    string
 	FromFieldName string
+	Expr          string
 }
 
 // ExptScoreWeight 实验评估器得分加权配置
@@ -208,6 +209,9 @@ type ExptTemplateConfiguration struct {
 
 	// ExptSource 实验来源信息
 	ExptSource *ExptSource `json:"expt_source,omitempty"`
+
+	// QualityGateConf 质量门禁规则, 基于模板创建实验时带入实验的 EvalConf
+	QualityGateConf *ExptQualityGateConf `json:"quality_gate_conf,omitempty"`
 }
 
 // ToEvaluatorRefDO 转换为评估器引用DO
@@ -428,6 +432,7 @@ type CreateExptTemplateParam struct {
 	CreateEvalTargetParam   *CreateEvalTargetParam
 	ExptSource              *ExptSource // 实验来源信息
 	NotificationConf        *ExptNotificationConf
+	QualityGateConf         *ExptQualityGateConf // 质量门禁配置, 写入 TemplateConf
 }
 
 // UpdateExptTemplateParam 更新实验模板参数
@@ -448,6 +453,8 @@ type UpdateExptTemplateParam struct {
 	ExptSource *ExptSource
 	// NotificationConf 通知配置；nil 表示不修改
 	NotificationConf *ExptNotificationConf
+	// QualityGateConf 质量门禁配置；nil 表示不修改，规则为空表示清除
+	QualityGateConf *ExptQualityGateConf
 }
 
 // UpdateExptTemplateMetaParam 更新实验模板 Meta 参数
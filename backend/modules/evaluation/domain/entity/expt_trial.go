@@ -0,0 +1,117 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+)
+
+const (
+	// MaxExptTrialNum 单 turn 重复试验次数上限
+	MaxExptTrialNum = 10
+	// DefaultExptTrialPassThreshold 默认通过阈值, 评估器得分不低于阈值记为通过
+	DefaultExptTrialPassThreshold = 1.0
+)
+
+// ExptTrialConf 实验重复试验配置, 序列化进 experiment.eval_conf。
+// 开启后每个 turn 执行 TrialNum 次评测对象调用与评估, 第 0 次试验即常规结果, 其余试验记录在 turn 结果的 trial_results 中,
+// 聚合时按评估器实例输出 pass@k / pass^k 与一致率。
+type ExptTrialConf struct {
+	// TrialNum 每个 turn 的试验次数 (含第 0 次), 大于 1 时生效
+	TrialNum int `json:"trial_num"`
+	// PassThreshold 单次试验判定通过的得分阈值, 为空取 DefaultExptTrialPassThreshold
+	PassThreshold *float64 `json:"pass_threshold,omitempty"`
+}
+
+func (c *ExptTrialConf) IsEnabled() bool {
+	return c != nil && c.TrialNum > 1
+}
+
+func (c *ExptTrialConf) GetTrialNum() int {
+	if !c.IsEnabled() {
+		return 1
+	}
+	return c.TrialNum
+}
+
+func (c *ExptTrialConf) GetPassThreshold() float64 {
+	if c == nil || c.PassThreshold == nil {
+		return DefaultExptTrialPassThreshold
+	}
+	return *c.PassThreshold
+}
+
+func (c *ExptTrialConf) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.TrialNum < 0 || c.TrialNum > MaxExptTrialNum {
+		return fmt.Errorf("trial_num must be in [0, %d]", MaxExptTrialNum)
+	}
+	return nil
+}
+
+// ExptTurnTrialResult 单次额外试验的结果引用, 序列化存储在 expt_turn_result(_run_log).trial_results
+type ExptTurnTrialResult struct {
+	// TrialIdx 试验序号, 从 1 开始 (第 0 次试验为 turn 的常规结果)
+	TrialIdx         int32                   `json:"trial_idx"`
+	TargetResultID   int64                   `json:"target_result_id,omitempty"`
+	EvaluatorResults []*RegisteredEvalResult `json:"evaluator_results,omitempty"`
+	ErrMsg           string                  `json:"err_msg,omitempty"`
+}
+
+// ExptTurnTrialRunResult 单次额外试验的运行期结果
+type ExptTurnTrialRunResult struct {
+	TrialIdx         int32
+	TargetResult     *EvalTargetRecord
+	EvaluatorResults []*EvaluatorRecord
+	EvalErr          error
+}
+
+// ToTrialResult 转为持久化的试验结果引用
+func (t *ExptTurnTrialRunResult) ToTrialResult() *ExptTurnTrialResult {
+	if t == nil {
+		return nil
+	}
+	res := &ExptTurnTrialResult{TrialIdx: t.TrialIdx}
+	if t.TargetResult != nil {
+		res.TargetResultID = t.TargetResult.ID
+		if out := t.TargetResult.EvalTargetOutputData; out != nil && out.EvalTargetRunError != nil && out.EvalTargetRunError.Code > 0 {
+			res.ErrMsg = out.EvalTargetRunError.Message
+		}
+	}
+	for _, er := range t.EvaluatorResults {
+		if er == nil {
+			continue
+		}
+		res.EvaluatorResults = append(res.EvaluatorResults, &RegisteredEvalResult{
+			VersionID: er.EvaluatorVersionID,
+			Alias:     er.Alias,
+			RecordID:  er.ID,
+		})
+	}
+	if t.EvalErr != nil {
+		res.ErrMsg = t.EvalErr.Error()
+	}
+	return res
+}
+
+// EvaluatorTrialAggregate 单个评估器实例在重复试验实验中的聚合指标, unit 为 (item, turn)
+type EvaluatorTrialAggregate struct {
+	TrialNum      int     `json:"trial_num"`
+	PassThreshold float64 `json:"pass_threshold"`
+	// UnitCnt 参与统计的 (item, turn) 数, 仅统计所有试验均有得分的 unit
+	UnitCnt int64 `json:"unit_cnt"`
+	// MeanScore 所有试验得分均值
+	MeanScore float64 `json:"mean_score"`
+	// MeanStd unit 内试验得分标准差的均值
+	MeanStd float64 `json:"mean_std"`
+	// ConsistencyRate 所有试验通过判定一致的 unit 占比
+	ConsistencyRate float64 `json:"consistency_rate"`
+	PassAt1         float64 `json:"pass_at_1"`
+	// PassAtK 至少一次通过的概率估计
+	PassAtK float64 `json:"pass_at_k"`
+	// PassPowK k 次全部通过的概率估计
+	PassPowK float64 `json:"pass_pow_k"`
+}
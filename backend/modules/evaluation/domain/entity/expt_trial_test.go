@@ -0,0 +1,67 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExptTrialConf(t *testing.T) {
+	var nilConf *ExptTrialConf
+	assert.False(t, nilConf.IsEnabled())
+	assert.Equal(t, 1, nilConf.GetTrialNum())
+	assert.Equal(t, DefaultExptTrialPassThreshold, nilConf.GetPassThreshold())
+	assert.NoError(t, nilConf.Validate())
+
+	conf := &ExptTrialConf{TrialNum: 1}
+	assert.False(t, conf.IsEnabled())
+	assert.Equal(t, 1, conf.GetTrialNum())
+
+	conf = &ExptTrialConf{TrialNum: 5, PassThreshold: gptr.Of(0.6)}
+	assert.True(t, conf.IsEnabled())
+	assert.Equal(t, 5, conf.GetTrialNum())
+	assert.Equal(t, 0.6, conf.GetPassThreshold())
+	assert.NoError(t, conf.Validate())
+
+	assert.Error(t, (&ExptTrialConf{TrialNum: MaxExptTrialNum + 1}).Validate())
+	assert.Error(t, (&ExptTrialConf{TrialNum: -1}).Validate())
+}
+
+func TestExptTurnTrialRunResult_ToTrialResult(t *testing.T) {
+	var nilRes *ExptTurnTrialRunResult
+	assert.Nil(t, nilRes.ToTrialResult())
+
+	res := (&ExptTurnTrialRunResult{
+		TrialIdx:     2,
+		TargetResult: &EvalTargetRecord{ID: 100},
+		EvaluatorResults: []*EvaluatorRecord{
+			{ID: 200, EvaluatorVersionID: 1},
+			nil,
+			{ID: 201, EvaluatorVersionID: 1, Alias: "judge_b"},
+		},
+	}).ToTrialResult()
+	assert.Equal(t, &ExptTurnTrialResult{
+		TrialIdx:       2,
+		TargetResultID: 100,
+		EvaluatorResults: []*RegisteredEvalResult{
+			{VersionID: 1, RecordID: 200},
+			{VersionID: 1, Alias: "judge_b", RecordID: 201},
+		},
+	}, res)
+
+	res = (&ExptTurnTrialRunResult{
+		TrialIdx: 1,
+		TargetResult: &EvalTargetRecord{ID: 100, EvalTargetOutputData: &EvalTargetOutputData{
+			EvalTargetRunError: &EvalTargetRunError{Code: 1, Message: "target fail"},
+		}},
+	}).ToTrialResult()
+	assert.Equal(t, "target fail", res.ErrMsg)
+
+	res = (&ExptTurnTrialRunResult{TrialIdx: 1, EvalErr: errors.New("evaluator fail")}).ToTrialResult()
+	assert.Equal(t, "evaluator fail", res.ErrMsg)
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bytedance/gg/gptr"
+)
+
+const (
+	// DefaultUserSimulatorMaxTurns 模拟对话默认最大轮数
+	DefaultUserSimulatorMaxTurns = 5
+	// MaxUserSimulatorMaxTurns 模拟对话最大轮数上限
+	MaxUserSimulatorMaxTurns = 30
+	// DefaultUserSimulatorGoalScoreThreshold 目标判定评估器得分不低于该值视为目标达成
+	DefaultUserSimulatorGoalScoreThreshold = 1.0
+	// UserSimulatorFinishToken 模拟用户认为对话应当结束时输出的标记
+	UserSimulatorFinishToken = "[END]"
+)
+
+// 目标判定评估器的输入字段, 评估器需按这些变量名声明输入
+const (
+	UserSimulatorJudgeFieldGoal         = "goal"
+	UserSimulatorJudgeFieldConversation = "conversation"
+	UserSimulatorJudgeFieldLastResponse = "last_response"
+)
+
+// DefaultUserSimulatorSystemPrompt 内置的模拟用户系统提示模板
+const DefaultUserSimulatorSystemPrompt = `You are role-playing a user who is talking to an AI assistant. Stay in character and never reveal that you are simulated.
+Persona: {{persona}}
+Goal: {{goal}}
+Constraints: {{constraints}}
+Reply with only the next message you would send to the assistant. When your goal has been achieved or the conversation cannot make further progress, reply with exactly ` + UserSimulatorFinishToken + `.`
+
+// ExptUserSimulatorConf 模拟用户配置, 序列化进 experiment.eval_conf。
+// 开启后每个 item 以首个 turn 为种子, 由模拟用户逐轮生成用户消息并调用评测对象, 直至目标达成、模拟用户主动结束或达到最大轮数;
+// 每轮对话落一条 turn 结果, 评估器按常规逐轮执行。
+type ExptUserSimulatorConf struct {
+	// ModelConfig 模拟用户使用的模型
+	ModelConfig *ModelConfig `json:"model_config,omitempty"`
+	// SystemPrompt 模拟用户系统提示模板, 支持 {{goal}} / {{persona}} / {{constraints}} 占位符, 为空使用 DefaultUserSimulatorSystemPrompt
+	SystemPrompt string `json:"system_prompt,omitempty"`
+
+	// Goal / Persona / BehavioralConstraints 实验级默认的目标/人设/行为约束
+	Goal                  string `json:"goal,omitempty"`
+	Persona               string `json:"persona,omitempty"`
+	BehavioralConstraints string `json:"behavioral_constraints,omitempty"`
+	// GoalFieldName / PersonaFieldName 从评测集字段读取题目级目标/人设, 优先于实验级默认值
+	GoalFieldName    string `json:"goal_field_name,omitempty"`
+	PersonaFieldName string `json:"persona_field_name,omitempty"`
+
+	// QueryFieldName 评测集中承载用户消息的字段, 每轮以模拟用户消息替换该字段后调用评测对象;
+	// 首轮该字段有值时直接作为开场消息
+	QueryFieldName string `json:"query_field_name"`
+	// ResponseFieldName 评测对象输出中承载回复的字段, 为空取 actual_output
+	ResponseFieldName string `json:"response_field_name,omitempty"`
+
+	// MaxTurns 最大轮数, <=0 时取默认值
+	MaxTurns int `json:"max_turns,omitempty"`
+	// GoalEvaluatorVersionID 每轮结束后判定目标是否达成的评估器版本, 为 0 不判定
+	GoalEvaluatorVersionID int64 `json:"goal_evaluator_version_id,omitempty"`
+	// GoalScoreThreshold 目标判定得分阈值, 为空取 DefaultUserSimulatorGoalScoreThreshold
+	GoalScoreThreshold *float64 `json:"goal_score_threshold,omitempty"`
+}
+
+func (c *ExptUserSimulatorConf) IsEnabled() bool {
+	return c != nil && c.ModelConfig != nil
+}
+
+func (c *ExptUserSimulatorConf) GetMaxTurns() int {
+	if c == nil || c.MaxTurns <= 0 {
+		return DefaultUserSimulatorMaxTurns
+	}
+	return c.MaxTurns
+}
+
+func (c *ExptUserSimulatorConf) GetSystemPrompt() string {
+	if c == nil || len(strings.TrimSpace(c.SystemPrompt)) == 0 {
+		return DefaultUserSimulatorSystemPrompt
+	}
+	return c.SystemPrompt
+}
+
+func (c *ExptUserSimulatorConf) GetResponseFieldName() string {
+	if c == nil || len(c.ResponseFieldName) == 0 {
+		return DefaultRuleActualFieldKey
+	}
+	return c.ResponseFieldName
+}
+
+// IsGoalAchieved 目标判定评估器得分是否达到阈值
+func (c *ExptUserSimulatorConf) IsGoalAchieved(score float64) bool {
+	threshold := DefaultUserSimulatorGoalScoreThreshold
+	if c != nil && c.GoalScoreThreshold != nil {
+		threshold = *c.GoalScoreThreshold
+	}
+	return score >= threshold
+}
+
+func (c *ExptUserSimulatorConf) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.ModelConfig == nil {
+		return fmt.Errorf("user simulator model_config is required")
+	}
+	if len(c.QueryFieldName) == 0 {
+		return fmt.Errorf("user simulator query_field_name is required")
+	}
+	if c.MaxTurns < 0 || c.MaxTurns > MaxUserSimulatorMaxTurns {
+		return fmt.Errorf("user simulator max_turns must be in [0, %d]", MaxUserSimulatorMaxTurns)
+	}
+	if c.GoalEvaluatorVersionID < 0 {
+		return fmt.Errorf("invalid user simulator goal_evaluator_version_id %d", c.GoalEvaluatorVersionID)
+	}
+	return nil
+}
+
+// BuildProfile 解析单个 item 的模拟用户画像: 题目级 RunConf 优先, 其次评测集字段, 最后实验级默认值
+func (c *ExptUserSimulatorConf) BuildProfile(seed *Turn, runConf *ItemRunConf) *UserSimulatorProfile {
+	profile := &UserSimulatorProfile{}
+	if c == nil {
+		return profile
+	}
+	fields := make(map[string]string)
+	if seed != nil {
+		for _, fd := range seed.FieldDataList {
+			if fd != nil && fd.Content != nil {
+				fields[fd.Name] = fd.Content.GetText()
+			}
+		}
+	}
+	pick := func(vals ...string) string {
+		for _, v := range vals {
+			if len(strings.TrimSpace(v)) > 0 {
+				return v
+			}
+		}
+		return ""
+	}
+	var itemGoal, itemPersona, itemConstraints string
+	if runConf != nil {
+		itemGoal, itemPersona, itemConstraints = runConf.SuaGoal, runConf.SuaPersona, runConf.SuaBehavioralConstraints
+	}
+	profile.Goal = pick(itemGoal, fields[c.GoalFieldName], c.Goal)
+	profile.Persona = pick(itemPersona, fields[c.PersonaFieldName], c.Persona)
+	profile.BehavioralConstraints = pick(itemConstraints, c.BehavioralConstraints)
+	return profile
+}
+
+// UserSimulatorProfile 单个 item 的模拟用户画像
+type UserSimulatorProfile struct {
+	Goal                  string `json:"goal,omitempty"`
+	Persona               string `json:"persona,omitempty"`
+	BehavioralConstraints string `json:"behavioral_constraints,omitempty"`
+}
+
+// RenderSystemPrompt 用画像填充模拟用户系统提示模板
+func (p *UserSimulatorProfile) RenderSystemPrompt(tmpl string) string {
+	if p == nil {
+		p = &UserSimulatorProfile{}
+	}
+	return strings.NewReplacer(
+		"{{goal}}", p.Goal,
+		"{{persona}}", p.Persona,
+		"{{constraints}}", p.BehavioralConstraints,
+	).Replace(tmpl)
+}
+
+// UserSimulateParam 生成模拟用户下一轮消息的参数
+type UserSimulateParam struct {
+	SpaceID int64
+	Conf    *ExptUserSimulatorConf
+	Profile *UserSimulatorProfile
+	// History 已有对话, RoleUser 为模拟用户消息, RoleAssistant 为评测对象回复
+	History []*Message
+}
+
+// UserSimulateGoalJudgeParam 目标判定参数
+type UserSimulateGoalJudgeParam struct {
+	SpaceID   int64
+	ExptID    int64
+	ExptRunID int64
+	ItemID    int64
+	TurnID    int64
+	Conf      *ExptUserSimulatorConf
+	Profile   *UserSimulatorProfile
+	History   []*Message
+	Ext       map[string]string
+}
+
+// FormatSimulatedConversation 把对话拼成 "user: ... / assistant: ..." 形式的文本, 供目标判定评估器使用
+func FormatSimulatedConversation(history []*Message) string {
+	var sb strings.Builder
+	for _, msg := range history {
+		if msg == nil {
+			continue
+		}
+		role := "user"
+		if msg.Role == RoleAssistant {
+			role = "assistant"
+		}
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(role)
+		sb.WriteString(": ")
+		sb.WriteString(msg.Content.GetText())
+	}
+	return sb.String()
+}
+
+// NewTextContent 构造纯文本内容
+func NewTextContent(text string) *Content {
+	return &Content{
+		ContentType: gptr.Of(ContentTypeText),
+		Text:        gptr.Of(text),
+	}
+}
+
+// NewTextMessage 构造纯文本消息
+func NewTextMessage(role Role, text string) *Message {
+	return &Message{Role: role, Content: NewTextContent(text)}
+}
@@ -84,8 +84,13 @@ type ListEvaluationSetItemsParam struct {
 	PageToken       *string
 	OrderBys        []*OrderBy
 	ItemIDsNotIn    []int64
-	Filter          *Filter
-	TagFilter       *TagFilter
+	// Filter 按行内容筛选, 由数据集服务下推到 MySQL. field_name 为字段 key 或名称, len(<field>) 比较字符数,
+	// 数值 field_type 按数值比较; 命中数通过 filterTotal 返回. 只能筛选存在 MySQL 中的内容,
+	// 评测集含内容转存至外部存储的行时返回参数错误
+	Filter    *Filter
+	TagFilter *TagFilter
+	// ProvenanceFilter 按行来源筛选, 仅 EvaluationSetItemProvenanceService 支持
+	ProvenanceFilter *EvaluationSetItemProvenanceFilter
 }
 type BatchGetEvaluationSetItemsParam struct {
 	SpaceID            int64
@@ -125,6 +130,16 @@ type BatchCreateEvaluationSetItemsParam struct {
 	FieldWriteOptions []*FieldWriteOption
 }
 
+type ValidateEvaluationSetItemsParam struct {
+	SpaceID         int64
+	EvaluationSetID int64
+	Items           []*EvaluationSetItem
+	// FieldSchemas 非空时覆盖评测集当前 schema 用于校验
+	FieldSchemas []*FieldSchema
+	// IgnoreCurrentItemCount 容量校验时不考虑评测集现有行数, 仅校验 items 数量是否超限
+	IgnoreCurrentItemCount *bool
+}
+
 type BatchUpdateEvaluationSetItemsParam struct {
 	SpaceID         int64
 	EvaluationSetID int64
@@ -0,0 +1,18 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination mocks/evaluation_set_dedup_job_mock.go -package mocks . IEvaluationSetDedupJobRepo
+type IEvaluationSetDedupJobRepo interface {
+	CreateDedupJob(ctx context.Context, job *entity.EvaluationSetDedupJob) error
+	// UpdateDedupJobResult 回写去重任务状态、报告与错误信息
+	UpdateDedupJobResult(ctx context.Context, job *entity.EvaluationSetDedupJob) error
+	GetDedupJob(ctx context.Context, spaceID, jobID int64) (*entity.EvaluationSetDedupJob, error)
+}
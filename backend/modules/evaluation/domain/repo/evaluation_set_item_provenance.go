@@ -0,0 +1,26 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination mocks/evaluation_set_item_provenance_mock.go -package mocks . IEvaluationSetItemProvenanceRepo
+type IEvaluationSetItemProvenanceRepo interface {
+	// BatchUpsert 按 (evaluation_set_id, item_id) 写入来源, 已存在时覆盖来源字段
+	BatchUpsert(ctx context.Context, provenances []*entity.EvaluationSetItemProvenance) error
+	// BatchCreateIfAbsent 仅写入尚无来源记录的行, 用于历史行补录, 不覆盖并发登记的来源
+	BatchCreateIfAbsent(ctx context.Context, provenances []*entity.EvaluationSetItemProvenance) error
+	// UpdateEditor 回写最后编辑者, 不存在来源记录的行忽略
+	UpdateEditor(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64, updatedBy string) error
+	// UpdateReviewStatus 更新生成行的审核状态
+	UpdateReviewStatus(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64, reviewStatus string) error
+	MGet(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64) ([]*entity.EvaluationSetItemProvenance, error)
+	// ListItemIDs 按来源筛选行 id, 按 item_id 升序分页, 返回命中总数
+	ListItemIDs(ctx context.Context, spaceID, evaluationSetID int64, filter *entity.EvaluationSetItemProvenanceFilter, page entity.Page) ([]int64, int64, error)
+	BatchDelete(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64) error
+}
@@ -0,0 +1,20 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination mocks/evaluator_calibration_mock.go -package mocks . IEvaluatorCalibrationRepo
+type IEvaluatorCalibrationRepo interface {
+	CreateCalibrationRecord(ctx context.Context, record *entity.EvaluatorCalibrationRecord) error
+	// UpdateCalibrationResult 回写校准状态、结果与错误信息
+	UpdateCalibrationResult(ctx context.Context, record *entity.EvaluatorCalibrationRecord) error
+	GetCalibrationRecord(ctx context.Context, spaceID, recordID int64) (*entity.EvaluatorCalibrationRecord, error)
+	// ListCalibrationRecords 查询评估器的校准记录, evaluatorVersionIDs 为空时返回全部版本, 按创建先后倒序
+	ListCalibrationRecords(ctx context.Context, spaceID, evaluatorID int64, evaluatorVersionIDs []int64) ([]*entity.EvaluatorCalibrationRecord, error)
+}
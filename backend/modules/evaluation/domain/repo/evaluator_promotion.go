@@ -0,0 +1,18 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination mocks/evaluator_promotion_mock.go -package mocks . IEvaluatorPromotionPolicyRepo
+type IEvaluatorPromotionPolicyRepo interface {
+	// SavePromotionPolicy 写入评估器晋级策略, 已存在时覆盖
+	SavePromotionPolicy(ctx context.Context, policy *entity.EvaluatorPromotionPolicy) error
+	// GetPromotionPolicy 未配置时返回 nil
+	GetPromotionPolicy(ctx context.Context, spaceID, evaluatorID int64) (*entity.EvaluatorPromotionPolicy, error)
+}
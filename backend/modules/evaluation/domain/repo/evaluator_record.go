@@ -24,4 +24,6 @@ type IEvaluatorRecordRepo interface {
 	// It is the terminal-state guard for async callbacks and dispatch compensation.
 	CompareAndSwapEvaluatorRecordResult(ctx context.Context, recordID, spaceID int64, fromStatus, toStatus entity.EvaluatorRunStatus, outputData *entity.EvaluatorOutputData) (bool, error)
 	UpdateEvaluatorRecordAsyncDispatch(ctx context.Context, recordID, spaceID int64, traceID string, outputData *entity.EvaluatorOutputData) error
+	// ListSuccessEvaluatorRecordByVersionID 取评估器版本最近 limit 条运行成功且有得分的记录, 按 id 倒序, 输入从 TOS 加载完整内容
+	ListSuccessEvaluatorRecordByVersionID(ctx context.Context, spaceID, evaluatorVersionID int64, limit int) ([]*entity.EvaluatorRecord, error)
 }
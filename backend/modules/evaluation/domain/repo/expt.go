@@ -36,6 +36,8 @@ type IExptStatsRepo interface {
 	UpdateByExptID(ctx context.Context, exptID, spaceID int64, stats *entity.ExptStats) error
 	ArithOperateCount(ctx context.Context, exptID, spaceID int64, cntArithOp *entity.StatsCntArithOp) error
 	Save(ctx context.Context, stats *entity.ExptStats) error
+	// UpdateConcurrencyState 更新调度器当前生效的 item 并发, 及最近一轮调度因后端共享预算延后提交的 item 数
+	UpdateConcurrencyState(ctx context.Context, exptID, spaceID int64, concurNum, budgetDeferredCnt int32) error
 }
 
 type IExptItemResultRepo interface {
@@ -54,6 +56,10 @@ type IExptItemResultRepo interface {
 	BatchCreateNXRunLogs(ctx context.Context, itemRunLogs []*entity.ExptItemResultRunLog) error
 	ScanItemRunLogs(ctx context.Context, exptID, exptRunID int64, filter *entity.ExptItemRunLogFilter, cursor, limit, spaceID int64) ([]*entity.ExptItemResultRunLog, int64, error)
 	UpdateItemRunLog(ctx context.Context, exptID, exptRunID int64, itemID []int64, ufields map[string]any, spaceID int64) error
+	// UpdateItemRunLogByAttempt 仅在执行轮次仍为 attempt 时更新, 返回 false 表示该轮执行已被续跑接替
+	UpdateItemRunLogByAttempt(ctx context.Context, exptID, exptRunID, itemID int64, attempt int32, ufields map[string]any, spaceID int64) (bool, error)
+	// ClaimItemRunLog 续跑僵尸 item 前认领: 执行轮次仍为 attempt 且仍在执行中时将轮次 +1 并刷新 updated_at, 返回是否认领成功
+	ClaimItemRunLog(ctx context.Context, exptID, exptRunID, itemID int64, attempt int32, spaceID int64) (bool, error)
 	GetItemRunLog(ctx context.Context, exptID, exptRunID, itemID, spaceID int64) (*entity.ExptItemResultRunLog, error)
 	MGetItemRunLog(ctx context.Context, exptID, exptRunID int64, itemIDs []int64, spaceID int64) ([]*entity.ExptItemResultRunLog, error)
 }
@@ -115,6 +121,7 @@ type IExptTurnResultFilterRepo interface {
 	InsertExptTurnResultFilterKeyMappings(ctx context.Context, mappings []*entity.ExptTurnResultFilterKeyMapping) error
 	GetByExptIDItemIDs(ctx context.Context, spaceID, exptID, createdDate string, itemIDs []string) ([]*entity.ExptTurnResultFilterEntity, error)
 	DeleteExptTurnResultFilterKeyMapping(ctx context.Context, mapping *entity.ExptTurnResultFilterKeyMapping, opts ...db.Option) error
+	QueryGroupAggr(ctx context.Context, query *entity.ExptTurnResultGroupAggrQuery) ([]*entity.ExptTurnResultGroupAggrRow, error)
 }
 
 type IExptAnnotateRepo interface {
@@ -136,6 +143,12 @@ type IExptAnnotateRepo interface {
 	UpdateAnnotateRecord(ctx context.Context, record *entity.AnnotateRecord) error
 	GetAnnotateRecordsByIDs(ctx context.Context, spaceID int64, recordIDs []int64) ([]*entity.AnnotateRecord, error)
 	GetAnnotateRecordByID(ctx context.Context, spaceID, recordID int64) (*entity.AnnotateRecord, error)
+	// CreateAnnotateRecord 仅保存标注记录, 不建立 turn ref; 用于同一 turn/tag 的追加标注者
+	CreateAnnotateRecord(ctx context.Context, record *entity.AnnotateRecord, opts ...db.Option) error
+	// GetAnnotateRecordsByTagKeyID 获取实验下某标签的全部标注记录 (含追加标注者)
+	GetAnnotateRecordsByTagKeyID(ctx context.Context, spaceID, exptID, tagKeyID int64) ([]*entity.AnnotateRecord, error)
+	// GetAnnotateRecordsByTurnResultID 获取某 turn 下某标签的标注记录 (含追加标注者)
+	GetAnnotateRecordsByTurnResultID(ctx context.Context, spaceID, exptID, tagKeyID, turnResultID int64) ([]*entity.AnnotateRecord, error)
 }
 
 type IExptResultExportRecordRepo interface {
@@ -0,0 +1,21 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination mocks/expt_concurrency_mock.go -package mocks . IExptConcurrencyRepo
+type IExptConcurrencyRepo interface {
+	// IncrBackendObservation 累加实验在某后端当前窗口的执行观测
+	IncrBackendObservation(ctx context.Context, exptID int64, backend string, obs *entity.ExptBackendObservation) error
+	// PopBackendObservation 取出并清空实验在某后端当前窗口的执行观测, 无观测返回空观测
+	PopBackendObservation(ctx context.Context, exptID int64, backend string) (*entity.ExptBackendObservation, error)
+	// GetBackendConcurNum 未记录时返回 0
+	GetBackendConcurNum(ctx context.Context, exptID int64, backend string) (int, error)
+	SetBackendConcurNum(ctx context.Context, exptID int64, backend string, concurNum int) error
+}
@@ -0,0 +1,18 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination mocks/expt_pairwise_rank_mock.go -package mocks . IExptPairwiseRankRecordRepo
+type IExptPairwiseRankRecordRepo interface {
+	CreatePairwiseRankRecord(ctx context.Context, record *entity.ExptPairwiseRankRecord) error
+	// UpdatePairwiseRankResult 回写排名状态、结果与错误信息
+	UpdatePairwiseRankResult(ctx context.Context, record *entity.ExptPairwiseRankRecord) error
+	GetPairwiseRankRecord(ctx context.Context, spaceID, recordID int64) (*entity.ExptPairwiseRankRecord, error)
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination mocks/expt_result_cache_mock.go -package mocks . IExptResultCacheRepo
+type IExptResultCacheRepo interface {
+	// GetResultCache 未命中返回 nil, nil
+	GetResultCache(ctx context.Context, conf *entity.ResultCacheSpaceConf, kind entity.ResultCacheKind, key string) (*entity.ResultCacheEntry, error)
+	SetResultCache(ctx context.Context, conf *entity.ResultCacheSpaceConf, kind entity.ResultCacheKind, key string, entry *entity.ResultCacheEntry) error
+	// GetSpaceConf 获取空间级 TTL 与 generation, 未设置 TTL 时返回默认 TTL
+	GetSpaceConf(ctx context.Context, spaceID int64) (*entity.ResultCacheSpaceConf, error)
+	SetSpaceTTL(ctx context.Context, spaceID int64, ttl time.Duration) error
+	// IncrSpaceGeneration 递增空间 generation 使该空间已有缓存全部失效, 返回新 generation
+	IncrSpaceGeneration(ctx context.Context, spaceID int64) (int64, error)
+}
@@ -163,3 +163,18 @@ func (mr *MockIEvaluatorRecordRepoMockRecorder) UpdateEvaluatorRecordResult(arg0
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvaluatorRecordResult", reflect.TypeOf((*MockIEvaluatorRecordRepo)(nil).UpdateEvaluatorRecordResult), arg0, arg1, arg2, arg3)
 }
+
+// ListSuccessEvaluatorRecordByVersionID mocks base method.
+func (m *MockIEvaluatorRecordRepo) ListSuccessEvaluatorRecordByVersionID(arg0 context.Context, arg1, arg2 int64, arg3 int) ([]*entity.EvaluatorRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSuccessEvaluatorRecordByVersionID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*entity.EvaluatorRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSuccessEvaluatorRecordByVersionID indicates an expected call of ListSuccessEvaluatorRecordByVersionID.
+func (mr *MockIEvaluatorRecordRepoMockRecorder) ListSuccessEvaluatorRecordByVersionID(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSuccessEvaluatorRecordByVersionID", reflect.TypeOf((*MockIEvaluatorRecordRepo)(nil).ListSuccessEvaluatorRecordByVersionID), arg0, arg1, arg2, arg3)
+}
@@ -343,6 +343,20 @@ func (mr *MockIExptStatsRepoMockRecorder) UpdateByExptID(arg0, arg1, arg2, arg3
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByExptID", reflect.TypeOf((*MockIExptStatsRepo)(nil).UpdateByExptID), arg0, arg1, arg2, arg3)
 }
 
+// UpdateConcurrencyState mocks base method.
+func (m *MockIExptStatsRepo) UpdateConcurrencyState(arg0 context.Context, arg1, arg2 int64, arg3, arg4 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConcurrencyState", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateConcurrencyState indicates an expected call of UpdateConcurrencyState.
+func (mr *MockIExptStatsRepoMockRecorder) UpdateConcurrencyState(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConcurrencyState", reflect.TypeOf((*MockIExptStatsRepo)(nil).UpdateConcurrencyState), arg0, arg1, arg2, arg3, arg4)
+}
+
 // MockIExptItemResultRepo is a mock of IExptItemResultRepo interface.
 type MockIExptItemResultRepo struct {
 	ctrl     *gomock.Controller
@@ -590,6 +604,36 @@ func (mr *MockIExptItemResultRepoMockRecorder) UpdateItemRunLog(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemRunLog", reflect.TypeOf((*MockIExptItemResultRepo)(nil).UpdateItemRunLog), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// UpdateItemRunLogByAttempt mocks base method.
+func (m *MockIExptItemResultRepo) UpdateItemRunLogByAttempt(arg0 context.Context, arg1, arg2, arg3 int64, arg4 int32, arg5 map[string]any, arg6 int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItemRunLogByAttempt", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateItemRunLogByAttempt indicates an expected call of UpdateItemRunLogByAttempt.
+func (mr *MockIExptItemResultRepoMockRecorder) UpdateItemRunLogByAttempt(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemRunLogByAttempt", reflect.TypeOf((*MockIExptItemResultRepo)(nil).UpdateItemRunLogByAttempt), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// ClaimItemRunLog mocks base method.
+func (m *MockIExptItemResultRepo) ClaimItemRunLog(arg0 context.Context, arg1, arg2, arg3 int64, arg4 int32, arg5 int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimItemRunLog", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimItemRunLog indicates an expected call of ClaimItemRunLog.
+func (mr *MockIExptItemResultRepoMockRecorder) ClaimItemRunLog(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimItemRunLog", reflect.TypeOf((*MockIExptItemResultRepo)(nil).ClaimItemRunLog), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // UpdateItemsResult mocks base method.
 func (m *MockIExptItemResultRepo) UpdateItemsResult(arg0 context.Context, arg1, arg2 int64, arg3 []int64, arg4 map[string]any) error {
 	m.ctrl.T.Helper()
@@ -1301,6 +1345,21 @@ func (mr *MockIExptTurnResultFilterRepoMockRecorder) InsertExptTurnResultFilterK
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertExptTurnResultFilterKeyMappings", reflect.TypeOf((*MockIExptTurnResultFilterRepo)(nil).InsertExptTurnResultFilterKeyMappings), arg0, arg1)
 }
 
+// QueryGroupAggr mocks base method.
+func (m *MockIExptTurnResultFilterRepo) QueryGroupAggr(arg0 context.Context, arg1 *entity.ExptTurnResultGroupAggrQuery) ([]*entity.ExptTurnResultGroupAggrRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryGroupAggr", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.ExptTurnResultGroupAggrRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryGroupAggr indicates an expected call of QueryGroupAggr.
+func (mr *MockIExptTurnResultFilterRepoMockRecorder) QueryGroupAggr(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryGroupAggr", reflect.TypeOf((*MockIExptTurnResultFilterRepo)(nil).QueryGroupAggr), arg0, arg1)
+}
+
 // QueryItemIDStates mocks base method.
 func (m *MockIExptTurnResultFilterRepo) QueryItemIDStates(arg0 context.Context, arg1 *entity.ExptTurnResultFilterAccelerator) (map[int64]entity.ItemRunState, int64, error) {
 	m.ctrl.T.Helper()
@@ -1384,6 +1443,25 @@ func (mr *MockIExptAnnotateRepoMockRecorder) BatchGetExptTurnResultTagRefs(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetExptTurnResultTagRefs", reflect.TypeOf((*MockIExptAnnotateRepo)(nil).BatchGetExptTurnResultTagRefs), arg0, arg1, arg2)
 }
 
+// CreateAnnotateRecord mocks base method.
+func (m *MockIExptAnnotateRepo) CreateAnnotateRecord(arg0 context.Context, arg1 *entity.AnnotateRecord, arg2 ...db.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAnnotateRecord", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAnnotateRecord indicates an expected call of CreateAnnotateRecord.
+func (mr *MockIExptAnnotateRepoMockRecorder) CreateAnnotateRecord(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnnotateRecord", reflect.TypeOf((*MockIExptAnnotateRepo)(nil).CreateAnnotateRecord), varargs...)
+}
+
 // CreateExptTurnAnnotateRecordRefs mocks base method.
 func (m *MockIExptAnnotateRepo) CreateExptTurnAnnotateRecordRefs(arg0 context.Context, arg1 *entity.ExptTurnAnnotateRecordRef) error {
 	m.ctrl.T.Helper()
@@ -1480,6 +1558,36 @@ func (mr *MockIExptAnnotateRepoMockRecorder) GetAnnotateRecordsByIDs(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnnotateRecordsByIDs", reflect.TypeOf((*MockIExptAnnotateRepo)(nil).GetAnnotateRecordsByIDs), arg0, arg1, arg2)
 }
 
+// GetAnnotateRecordsByTagKeyID mocks base method.
+func (m *MockIExptAnnotateRepo) GetAnnotateRecordsByTagKeyID(arg0 context.Context, arg1, arg2, arg3 int64) ([]*entity.AnnotateRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnnotateRecordsByTagKeyID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*entity.AnnotateRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnnotateRecordsByTagKeyID indicates an expected call of GetAnnotateRecordsByTagKeyID.
+func (mr *MockIExptAnnotateRepoMockRecorder) GetAnnotateRecordsByTagKeyID(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnnotateRecordsByTagKeyID", reflect.TypeOf((*MockIExptAnnotateRepo)(nil).GetAnnotateRecordsByTagKeyID), arg0, arg1, arg2, arg3)
+}
+
+// GetAnnotateRecordsByTurnResultID mocks base method.
+func (m *MockIExptAnnotateRepo) GetAnnotateRecordsByTurnResultID(arg0 context.Context, arg1, arg2, arg3, arg4 int64) ([]*entity.AnnotateRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnnotateRecordsByTurnResultID", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*entity.AnnotateRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnnotateRecordsByTurnResultID indicates an expected call of GetAnnotateRecordsByTurnResultID.
+func (mr *MockIExptAnnotateRepoMockRecorder) GetAnnotateRecordsByTurnResultID(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnnotateRecordsByTurnResultID", reflect.TypeOf((*MockIExptAnnotateRepo)(nil).GetAnnotateRecordsByTurnResultID), arg0, arg1, arg2, arg3, arg4)
+}
+
 // GetExptTurnAnnotateRecordRefs mocks base method.
 func (m *MockIExptAnnotateRepo) GetExptTurnAnnotateRecordRefs(arg0 context.Context, arg1, arg2 int64) ([]*entity.ExptTurnAnnotateRecordRef, error) {
 	m.ctrl.T.Helper()
@@ -1739,33 +1847,33 @@ func (mr *MockIEvalAsyncRepoMockRecorder) GetEvalAsyncCtx(arg0, arg1 any) *gomoc
 }
 
 // GetEvalAsyncCtxStrong mocks base method.
-func (m *MockIEvalAsyncRepo) GetEvalAsyncCtxStrong(ctx context.Context, invokeID string) (*entity.EvalAsyncCtx, error) {
+func (m *MockIEvalAsyncRepo) GetEvalAsyncCtxStrong(arg0 context.Context, arg1 string) (*entity.EvalAsyncCtx, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEvalAsyncCtxStrong", ctx, invokeID)
+	ret := m.ctrl.Call(m, "GetEvalAsyncCtxStrong", arg0, arg1)
 	ret0, _ := ret[0].(*entity.EvalAsyncCtx)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEvalAsyncCtxStrong indicates an expected call of GetEvalAsyncCtxStrong.
-func (mr *MockIEvalAsyncRepoMockRecorder) GetEvalAsyncCtxStrong(ctx, invokeID any) *gomock.Call {
+func (mr *MockIEvalAsyncRepoMockRecorder) GetEvalAsyncCtxStrong(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvalAsyncCtxStrong", reflect.TypeOf((*MockIEvalAsyncRepo)(nil).GetEvalAsyncCtxStrong), ctx, invokeID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvalAsyncCtxStrong", reflect.TypeOf((*MockIEvalAsyncRepo)(nil).GetEvalAsyncCtxStrong), arg0, arg1)
 }
 
 // MarkEvalAsyncResumeReady mocks base method.
-func (m *MockIEvalAsyncRepo) MarkEvalAsyncResumeReady(ctx context.Context, invokeID string) (*entity.EvalAsyncCtx, error) {
+func (m *MockIEvalAsyncRepo) MarkEvalAsyncResumeReady(arg0 context.Context, arg1 string) (*entity.EvalAsyncCtx, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkEvalAsyncResumeReady", ctx, invokeID)
+	ret := m.ctrl.Call(m, "MarkEvalAsyncResumeReady", arg0, arg1)
 	ret0, _ := ret[0].(*entity.EvalAsyncCtx)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // MarkEvalAsyncResumeReady indicates an expected call of MarkEvalAsyncResumeReady.
-func (mr *MockIEvalAsyncRepoMockRecorder) MarkEvalAsyncResumeReady(ctx, invokeID any) *gomock.Call {
+func (mr *MockIEvalAsyncRepoMockRecorder) MarkEvalAsyncResumeReady(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEvalAsyncResumeReady", reflect.TypeOf((*MockIEvalAsyncRepo)(nil).MarkEvalAsyncResumeReady), ctx, invokeID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEvalAsyncResumeReady", reflect.TypeOf((*MockIEvalAsyncRepo)(nil).MarkEvalAsyncResumeReady), arg0, arg1)
 }
 
 // SetEvalAsyncCtx mocks base method.
@@ -18,3 +18,11 @@ type RateLimiter interface {
 type IPlainRateLimiter interface {
 	AllowInvokeWithKeyLimit(ctx context.Context, key string, limit *commonentity.RateLimit) bool
 }
+
+// IExptBackendBudgetLimiter 同一评测后端在并发运行的实验间共享的 item 提交预算
+//
+//go:generate mockgen -destination mocks/expt_backend_budget_mock.go -package mocks . IExptBackendBudgetLimiter
+type IExptBackendBudgetLimiter interface {
+	// AcquireN 为 backend 申请至多 n 个提交额度, 返回获批数量; 限流器异常时放行
+	AcquireN(ctx context.Context, backend string, n int, budget *commonentity.ExptBackendBudget) int
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination ./mocks/evaluation_set_dedup.go --package mocks . EvaluationSetDedupService
+type EvaluationSetDedupService interface {
+	// SubmitDedupJob 校验参数并创建去重任务, 通过 MQ 异步执行 DetectDuplicates
+	SubmitDedupJob(ctx context.Context, param *entity.EvaluationSetDedupParam, session *entity.Session) (*entity.EvaluationSetDedupJob, error)
+	// HandleDedupEvent 消费去重事件, 执行检测并回写报告; 任务已结束时直接跳过
+	HandleDedupEvent(ctx context.Context, spaceID, jobID int64) error
+	// GetDedupJob 查询去重任务, 超时仍在运行的任务置为失败
+	GetDedupJob(ctx context.Context, spaceID, jobID int64) (*entity.EvaluationSetDedupJob, error)
+	// DetectDuplicates 扫描评测集草稿或指定版本, 按规范化文本哈希找出精确重复, 按 MinHash/SimHash 找出近似重复, 返回重复簇
+	DetectDuplicates(ctx context.Context, param *entity.EvaluationSetDedupParam) (*entity.EvaluationSetDedupReport, error)
+	// ArchiveDuplicates 通过批量删除评测集行归档各簇的重复行, 返回实际归档的 item_id
+	ArchiveDuplicates(ctx context.Context, param *entity.ArchiveEvaluationSetDuplicatesParam) (archivedItemIDs []int64, err error)
+}
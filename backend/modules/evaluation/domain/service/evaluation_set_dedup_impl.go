@@ -0,0 +1,569 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/bytedance/gg/gslice"
+
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/events"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/contexts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/textsim"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const (
+	dedupItemPageSize = int32(100)
+	// dedupSimHashMaxBands SimHash 分桶数上限: 每段不少于 8 位, 避免桶过大退化为两两比较;
+	// 阈值低于 1-8/64 时召回为近似值
+	dedupSimHashMaxBands = 8
+)
+
+type EvaluationSetDedupServiceImpl struct {
+	idgen                    idgen.IIDGenerator
+	evaluationSetItemService EvaluationSetItemService
+	dedupJobRepo             repo.IEvaluationSetDedupJobRepo
+	publisher                events.ExptEventPublisher
+}
+
+func NewEvaluationSetDedupService(
+	idgen idgen.IIDGenerator,
+	evaluationSetItemService EvaluationSetItemService,
+	dedupJobRepo repo.IEvaluationSetDedupJobRepo,
+	publisher events.ExptEventPublisher,
+) EvaluationSetDedupService {
+	return &EvaluationSetDedupServiceImpl{
+		idgen:                    idgen,
+		evaluationSetItemService: evaluationSetItemService,
+		dedupJobRepo:             dedupJobRepo,
+		publisher:                publisher,
+	}
+}
+
+// dedupDoc 单行参与去重的文本
+type dedupDoc struct {
+	itemID    int64
+	exactHash uint64
+	tokens    []string
+}
+
+func (e *EvaluationSetDedupServiceImpl) SubmitDedupJob(ctx context.Context, param *entity.EvaluationSetDedupParam, session *entity.Session) (*entity.EvaluationSetDedupJob, error) {
+	// 提交时即完成参数校验, 避免无效任务入队
+	if err := validateDedupParam(param); err != nil {
+		return nil, err
+	}
+
+	id, err := e.idgen.GenID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	job := &entity.EvaluationSetDedupJob{
+		ID:              id,
+		SpaceID:         param.SpaceID,
+		EvaluationSetID: param.EvaluationSetID,
+		VersionID:       gptr.Indirect(param.VersionID),
+		Status:          entity.EvaluationSetDedupJobStatus_Running,
+		Conf:            param.Conf,
+		CreatedAt:       time.Now(),
+	}
+	if session != nil {
+		job.CreatedBy = session.UserID
+	}
+	if err := e.dedupJobRepo.CreateDedupJob(ctx, job); err != nil {
+		return nil, err
+	}
+
+	if err := e.publisher.PublishExptExportCSVEvent(ctx, &entity.ExportCSVEvent{
+		ExportID:    job.ID,
+		SpaceID:     param.SpaceID,
+		ExportScene: entity.ExportSceneEvaluationSetDedup,
+		CreatedAt:   job.CreatedAt.Unix(),
+		Session:     session,
+	}, gptr.Of(time.Second*3)); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (e *EvaluationSetDedupServiceImpl) HandleDedupEvent(ctx context.Context, spaceID, jobID int64) error {
+	job, err := e.dedupJobRepo.GetDedupJob(contexts.WithCtxWriteDB(ctx), spaceID, jobID)
+	if err != nil {
+		return err
+	}
+	if job == nil {
+		return errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("evaluation set dedup job %d not found", jobID)))
+	}
+	if job.Status != entity.EvaluationSetDedupJobStatus_Running {
+		logs.CtxInfo(ctx, "[HandleDedupEvent] job already finished, skip, job_id: %v, status: %v", jobID, job.Status)
+		return nil
+	}
+
+	report, detectErr := e.DetectDuplicates(ctx, job.Param())
+	if detectErr != nil {
+		logs.CtxError(ctx, "[HandleDedupEvent] detect duplicates fail, job_id: %v, eval_set_id: %v, err: %v", jobID, job.EvaluationSetID, detectErr)
+		job.Status = entity.EvaluationSetDedupJobStatus_Failed
+		job.ErrMsg = detectErr.Error()
+	} else {
+		job.Status = entity.EvaluationSetDedupJobStatus_Success
+		job.Report = report
+	}
+	return e.dedupJobRepo.UpdateDedupJobResult(ctx, job)
+}
+
+func (e *EvaluationSetDedupServiceImpl) GetDedupJob(ctx context.Context, spaceID, jobID int64) (*entity.EvaluationSetDedupJob, error) {
+	job, err := e.dedupJobRepo.GetDedupJob(ctx, spaceID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("evaluation set dedup job %d not found", jobID)))
+	}
+	if job.IsStale(time.Now()) {
+		job.Status = entity.EvaluationSetDedupJobStatus_Failed
+		job.ErrMsg = "evaluation set dedup timeout"
+		if err := e.dedupJobRepo.UpdateDedupJobResult(ctx, job); err != nil {
+			logs.CtxError(ctx, "[GetDedupJob] mark stale job failed fail, job_id: %v, err: %v", jobID, err)
+		}
+	}
+	return job, nil
+}
+
+func validateDedupParam(param *entity.EvaluationSetDedupParam) error {
+	if param == nil || param.SpaceID <= 0 || param.EvaluationSetID <= 0 {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid dedup param"))
+	}
+	if err := param.Conf.Validate(); err != nil {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
+	}
+	return nil
+}
+
+func (e *EvaluationSetDedupServiceImpl) DetectDuplicates(ctx context.Context, param *entity.EvaluationSetDedupParam) (*entity.EvaluationSetDedupReport, error) {
+	if err := validateDedupParam(param); err != nil {
+		return nil, err
+	}
+	conf := param.Conf
+
+	report := &entity.EvaluationSetDedupReport{
+		EvaluationSetID: param.EvaluationSetID,
+		VersionID:       param.VersionID,
+		Method:          string(conf.GetMethod()),
+		Threshold:       conf.GetThreshold(),
+	}
+	if conf != nil {
+		report.FieldNames = conf.FieldNames
+	}
+
+	docs, err := e.loadDedupDocs(ctx, param, report)
+	if err != nil {
+		return nil, err
+	}
+
+	report.Clusters = clusterDedupDocs(docs, conf)
+	for _, c := range report.Clusters {
+		if c.Kind == entity.EvaluationSetDuplicateKind_Exact {
+			report.ExactDuplicateCnt += len(c.DuplicateItemIDs)
+		} else {
+			report.NearDuplicateCnt += len(c.DuplicateItemIDs)
+		}
+	}
+
+	logs.CtxInfo(ctx, "[EvaluationSetDedup] detect done, eval_set_id: %v, version_id: %v, scanned: %v, skipped: %v, truncated: %v, clusters: %v, exact: %v, near: %v",
+		param.EvaluationSetID, gptr.Indirect(param.VersionID), report.ScannedItemCnt, report.SkippedItemCnt, report.Truncated, len(report.Clusters), report.ExactDuplicateCnt, report.NearDuplicateCnt)
+	return report, nil
+}
+
+// loadDedupDocs 按 item_id 升序分页拉取评测集行, 拼接所选字段文本; 被裁剪的大字段回查完整内容
+func (e *EvaluationSetDedupServiceImpl) loadDedupDocs(ctx context.Context, param *entity.EvaluationSetDedupParam, report *entity.EvaluationSetDedupReport) ([]*dedupDoc, error) {
+	var (
+		docs      []*dedupDoc
+		pageToken *string
+		pageSize  = dedupItemPageSize
+	)
+	for {
+		items, _, _, nextPageToken, err := e.evaluationSetItemService.ListEvaluationSetItems(ctx, &entity.ListEvaluationSetItemsParam{
+			SpaceID:         param.SpaceID,
+			EvaluationSetID: param.EvaluationSetID,
+			VersionID:       param.VersionID,
+			PageSize:        &pageSize,
+			PageToken:       pageToken,
+			OrderBys:        []*entity.OrderBy{{Field: gptr.Of("item_id"), IsAsc: gptr.Of(true)}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if report.ScannedItemCnt >= entity.MaxEvaluationSetDedupItemCnt {
+				report.Truncated = true
+				return docs, nil
+			}
+			report.ScannedItemCnt++
+			doc, err := e.buildDedupDoc(ctx, param, item)
+			if err != nil {
+				return nil, err
+			}
+			if doc == nil {
+				report.SkippedItemCnt++
+				continue
+			}
+			docs = append(docs, doc)
+		}
+		if len(items) == 0 || gptr.Indirect(nextPageToken) == "" {
+			return docs, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func (e *EvaluationSetDedupServiceImpl) buildDedupDoc(ctx context.Context, param *entity.EvaluationSetDedupParam, item *entity.EvaluationSetItem) (*dedupDoc, error) {
+	var fieldNames []string
+	if param.Conf != nil {
+		fieldNames = param.Conf.FieldNames
+	}
+
+	var (
+		exact  strings.Builder
+		tokens []string
+	)
+	for turnIdx, turn := range item.Turns {
+		if turn == nil {
+			continue
+		}
+		fields := make(map[string]*entity.FieldData, len(turn.FieldDataList))
+		names := fieldNames
+		for _, fd := range turn.FieldDataList {
+			if fd == nil || fd.Content == nil {
+				continue
+			}
+			fields[fd.Name] = fd
+			if len(fieldNames) == 0 {
+				names = append(names, fd.Name)
+			}
+		}
+		if len(fieldNames) == 0 {
+			sort.Strings(names)
+		}
+		for _, name := range names {
+			fd, ok := fields[name]
+			if !ok {
+				continue
+			}
+			content := fd.Content
+			if content.IsContentOmitted() {
+				full, err := e.evaluationSetItemService.GetEvaluationSetItemField(ctx, &entity.GetEvaluationSetItemFieldParam{
+					SpaceID:         param.SpaceID,
+					EvaluationSetID: param.EvaluationSetID,
+					ItemPK:          item.ItemID,
+					FieldName:       fd.Name,
+					FieldKey:        gptr.Of(fd.Key),
+					TurnID:          gptr.Of(turn.ID),
+				})
+				if err != nil {
+					return nil, err
+				}
+				if full != nil && full.Content != nil {
+					content = full.Content
+				}
+			}
+			fieldTokens := textsim.Tokenize(dedupContentText(content))
+			if len(fieldTokens) == 0 {
+				continue
+			}
+			// 字段名与 turn 序号参与精确哈希, 同一文本出现在不同字段或不同 turn 不视为相同
+			exact.WriteString(name)
+			exact.WriteByte(0)
+			exact.WriteString(strconv.Itoa(turnIdx))
+			exact.WriteByte(0)
+			exact.WriteString(strings.Join(fieldTokens, " "))
+			exact.WriteByte(1)
+			tokens = append(tokens, fieldTokens...)
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	return &dedupDoc{itemID: item.ItemID, exactHash: textsim.Hash64(exact.String()), tokens: tokens}, nil
+}
+
+// dedupContentText 取内容中的文本, 多模态内容拼接其中的文本分片
+func dedupContentText(c *entity.Content) string {
+	if c == nil {
+		return ""
+	}
+	if len(c.MultiPart) == 0 {
+		return c.GetText()
+	}
+	parts := make([]string, 0, len(c.MultiPart)+1)
+	if text := c.GetText(); len(text) > 0 {
+		parts = append(parts, text)
+	}
+	for _, part := range c.MultiPart {
+		if text := dedupContentText(part); len(text) > 0 {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// clusterDedupDocs 先按精确哈希合并, 再对各精确组的代表行做 LSH 分桶, 桶内候选对校验相似度后合并; docs 需按 item_id 升序
+func clusterDedupDocs(docs []*dedupDoc, conf *entity.EvaluationSetDedupConf) []*entity.EvaluationSetDuplicateCluster {
+	uf := newDedupUnionFind(len(docs))
+	exactFirst := make(map[uint64]int, len(docs))
+	reps := make([]int, 0, len(docs))
+	for i, doc := range docs {
+		if first, ok := exactFirst[doc.exactHash]; ok {
+			uf.union(first, i)
+			continue
+		}
+		exactFirst[doc.exactHash] = i
+		reps = append(reps, i)
+	}
+
+	nearSim := make(map[int]float64)
+	if !conf.IsExactOnly() {
+		threshold := conf.GetThreshold()
+		buckets := make(map[uint64][]int)
+		similarity := buildDedupSimilarity(docs, reps, conf, buckets)
+		for _, members := range buckets {
+			for a := 0; a < len(members); a++ {
+				for b := a + 1; b < len(members); b++ {
+					i, j := members[a], members[b]
+					if uf.find(i) == uf.find(j) {
+						continue
+					}
+					sim := similarity(i, j)
+					if sim < threshold {
+						continue
+					}
+					uf.union(i, j)
+					nearSim[i] = minSim(nearSim, i, sim)
+					nearSim[j] = minSim(nearSim, j, sim)
+				}
+			}
+		}
+	}
+
+	groups := make(map[int][]int)
+	for i := range docs {
+		root := uf.find(i)
+		groups[root] = append(groups[root], i)
+	}
+	clusters := make([]*entity.EvaluationSetDuplicateCluster, 0)
+	for _, members := range groups {
+		if len(members) < 2 {
+			continue
+		}
+		sort.Ints(members)
+		cluster := &entity.EvaluationSetDuplicateCluster{
+			Kind:             entity.EvaluationSetDuplicateKind_Exact,
+			KeepItemID:       docs[members[0]].itemID,
+			DuplicateItemIDs: make([]int64, 0, len(members)-1),
+			Similarity:       1,
+		}
+		for k, idx := range members {
+			if k > 0 {
+				cluster.DuplicateItemIDs = append(cluster.DuplicateItemIDs, docs[idx].itemID)
+			}
+			if docs[idx].exactHash != docs[members[0]].exactHash {
+				cluster.Kind = entity.EvaluationSetDuplicateKind_Near
+			}
+			if sim, ok := nearSim[idx]; ok {
+				cluster.Similarity = math.Min(cluster.Similarity, sim)
+			}
+		}
+		clusters = append(clusters, cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i].KeepItemID < clusters[j].KeepItemID })
+	return clusters
+}
+
+// buildDedupSimilarity 为代表行计算指纹并写入分桶, 返回两行间的相似度函数
+func buildDedupSimilarity(docs []*dedupDoc, reps []int, conf *entity.EvaluationSetDedupConf, buckets map[uint64][]int) func(i, j int) float64 {
+	if conf.GetMethod() == entity.EvaluationSetDedupMethod_SimHash {
+		bands := int(64*(1-conf.GetThreshold())) + 1
+		if bands > dedupSimHashMaxBands {
+			bands = dedupSimHashMaxBands
+		}
+		hashes := make(map[int]uint64, len(reps))
+		for _, i := range reps {
+			hashes[i] = textsim.SimHash(docs[i].tokens)
+			for _, key := range textsim.SimHashBands(hashes[i], bands) {
+				buckets[key] = append(buckets[key], i)
+			}
+		}
+		return func(i, j int) float64 { return textsim.SimHashSimilarity(hashes[i], hashes[j]) }
+	}
+
+	sigs := make(map[int][]uint64, len(reps))
+	for _, i := range reps {
+		sigs[i] = textsim.MinHash(textsim.Shingles(docs[i].tokens, conf.GetShingleSize()), textsim.DefaultMinHashNum)
+		for _, key := range textsim.MinHashBands(sigs[i], textsim.DefaultMinHashBands) {
+			buckets[key] = append(buckets[key], i)
+		}
+	}
+	return func(i, j int) float64 { return textsim.MinHashSimilarity(sigs[i], sigs[j]) }
+}
+
+func minSim(m map[int]float64, k int, sim float64) float64 {
+	if cur, ok := m[k]; ok && cur < sim {
+		return cur
+	}
+	return sim
+}
+
+func (e *EvaluationSetDedupServiceImpl) ArchiveDuplicates(ctx context.Context, param *entity.ArchiveEvaluationSetDuplicatesParam) ([]int64, error) {
+	if param == nil || param.SpaceID <= 0 || param.EvaluationSetID <= 0 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid archive duplicates param"))
+	}
+
+	clusters, err := e.revalidateDuplicateClusters(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	var selected map[int64]bool
+	if len(param.ItemIDs) > 0 {
+		selected = gslice.ToMap(param.ItemIDs, func(id int64) (int64, bool) { return id, true })
+	}
+	keep := make(map[int64]bool, len(clusters))
+	for _, c := range clusters {
+		keep[c.KeepItemID] = true
+	}
+	seen := make(map[int64]bool)
+	itemIDs := make([]int64, 0)
+	for _, c := range clusters {
+		for _, id := range c.DuplicateItemIDs {
+			// 每个簇至少保留一行: 被其他簇选为保留行的 item 不归档
+			if id <= 0 || keep[id] || seen[id] || (selected != nil && !selected[id]) {
+				continue
+			}
+			seen[id] = true
+			itemIDs = append(itemIDs, id)
+		}
+	}
+
+	archived := make([]int64, 0, len(itemIDs))
+	for start := 0; start < len(itemIDs); start += entity.EvaluationSetDedupArchiveBatchSize {
+		end := min(start+entity.EvaluationSetDedupArchiveBatchSize, len(itemIDs))
+		if err := e.evaluationSetItemService.BatchDeleteEvaluationSetItems(ctx, param.SpaceID, param.EvaluationSetID, itemIDs[start:end]); err != nil {
+			logs.CtxError(ctx, "[EvaluationSetDedup] archive duplicates fail, eval_set_id: %v, archived: %v, err: %v", param.EvaluationSetID, len(archived), err)
+			return archived, err
+		}
+		archived = append(archived, itemIDs[start:end]...)
+	}
+	logs.CtxInfo(ctx, "[EvaluationSetDedup] archive duplicates done, eval_set_id: %v, archived: %v", param.EvaluationSetID, len(archived))
+	return archived, nil
+}
+
+// revalidateDuplicateClusters 检测完成后草稿可能已被编辑或删除: 按当前内容对各簇成员重新聚类,
+// 仍为同一保留行下的同一组成员才归档, 其余簇跳过
+func (e *EvaluationSetDedupServiceImpl) revalidateDuplicateClusters(ctx context.Context, param *entity.ArchiveEvaluationSetDuplicatesParam) ([]*entity.EvaluationSetDuplicateCluster, error) {
+	memberIDs := make([]int64, 0)
+	for _, c := range param.Clusters {
+		if c != nil {
+			memberIDs = append(memberIDs, c.KeepItemID)
+			memberIDs = append(memberIDs, c.DuplicateItemIDs...)
+		}
+	}
+	if len(memberIDs) == 0 {
+		return nil, nil
+	}
+	items, err := e.evaluationSetItemService.BatchGetEvaluationSetItems(ctx, &entity.BatchGetEvaluationSetItemsParam{
+		SpaceID:         param.SpaceID,
+		EvaluationSetID: param.EvaluationSetID,
+		ItemIDs:         gslice.Uniq(memberIDs),
+	})
+	if err != nil {
+		return nil, err
+	}
+	dedupParam := &entity.EvaluationSetDedupParam{SpaceID: param.SpaceID, EvaluationSetID: param.EvaluationSetID, Conf: param.Conf}
+	docs := make(map[int64]*dedupDoc, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		doc, err := e.buildDedupDoc(ctx, dedupParam, item)
+		if err != nil {
+			return nil, err
+		}
+		if doc != nil {
+			docs[item.ItemID] = doc
+		}
+	}
+
+	res := make([]*entity.EvaluationSetDuplicateCluster, 0, len(param.Clusters))
+	for _, c := range param.Clusters {
+		if c == nil {
+			continue
+		}
+		if !duplicateClusterUnchanged(c, docs, param.Conf) {
+			logs.CtxWarn(ctx, "[EvaluationSetDedup] duplicate cluster changed since detection, skip, eval_set_id: %v, keep_item_id: %v",
+				param.EvaluationSetID, c.KeepItemID)
+			continue
+		}
+		res = append(res, c)
+	}
+	return res, nil
+}
+
+// duplicateClusterUnchanged 成员按当前内容重新聚类后仍恰好构成一个以 KeepItemID 为保留行的簇
+func duplicateClusterUnchanged(c *entity.EvaluationSetDuplicateCluster, docs map[int64]*dedupDoc, conf *entity.EvaluationSetDedupConf) bool {
+	memberIDs := gslice.Uniq(append([]int64{c.KeepItemID}, c.DuplicateItemIDs...))
+	members := make([]*dedupDoc, 0, len(memberIDs))
+	for _, id := range memberIDs {
+		doc, ok := docs[id]
+		if !ok {
+			return false
+		}
+		members = append(members, doc)
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].itemID < members[j].itemID })
+	reclustered := clusterDedupDocs(members, conf)
+	return len(reclustered) == 1 && reclustered[0].KeepItemID == c.KeepItemID && len(reclustered[0].DuplicateItemIDs) == len(memberIDs)-1
+}
+
+type dedupUnionFind struct {
+	parent []int
+}
+
+func newDedupUnionFind(n int) *dedupUnionFind {
+	parent := make([]int, n)
+	for i := range parent {
+		parent[i] = i
+	}
+	return &dedupUnionFind{parent: parent}
+}
+
+func (u *dedupUnionFind) find(x int) int {
+	for u.parent[x] != x {
+		u.parent[x] = u.parent[u.parent[x]]
+		x = u.parent[x]
+	}
+	return x
+}
+
+// union 以较小下标为根, 保证簇的根为 item_id 最小的行
+func (u *dedupUnionFind) union(a, b int) {
+	ra, rb := u.find(a), u.find(b)
+	if ra == rb {
+		return
+	}
+	if ra < rb {
+		u.parent[rb] = ra
+	} else {
+		u.parent[ra] = rb
+	}
+}
@@ -0,0 +1,368 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	idgenmocks "github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	eventsmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/events/mocks"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	servicemocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+func newDedupTestItem(itemID int64, fields map[string]string) *entity.EvaluationSetItem {
+	turn := &entity.Turn{ID: itemID * 10, ItemID: itemID}
+	for name, text := range fields {
+		turn.FieldDataList = append(turn.FieldDataList, &entity.FieldData{Key: name, Name: name, Content: entity.NewTextContent(text)})
+	}
+	return &entity.EvaluationSetItem{ItemID: itemID, Turns: []*entity.Turn{turn}}
+}
+
+func TestEvaluationSetDedupServiceImpl_DetectDuplicates(t *testing.T) {
+	const (
+		question     = "How do I reset my account password if I no longer have access to the recovery email address on file"
+		nearQuestion = "How do I reset my account password if I no longer have access to the recovery email address on record"
+	)
+	page1 := []*entity.EvaluationSetItem{
+		newDedupTestItem(1, map[string]string{"input": question, "reference": "contact support"}),
+		newDedupTestItem(2, map[string]string{"input": "  how do I reset my ACCOUNT password, if I no longer have access to the recovery email address on file?", "reference": "Contact support."}),
+		newDedupTestItem(3, map[string]string{"input": "What is the capital of France", "reference": "Paris"}),
+	}
+	page2 := []*entity.EvaluationSetItem{
+		newDedupTestItem(4, map[string]string{"input": nearQuestion, "reference": "contact support"}),
+		newDedupTestItem(5, map[string]string{"input": "", "reference": ""}),
+		newDedupTestItem(6, map[string]string{"input": "What is the capital of France", "reference": "Lyon"}),
+	}
+
+	mockList := func(ctrl *gomock.Controller) *servicemocks.MockEvaluationSetItemService {
+		itemSvc := servicemocks.NewMockEvaluationSetItemService(ctrl)
+		itemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.ListEvaluationSetItemsParam) ([]*entity.EvaluationSetItem, *int64, *int64, *string, error) {
+				assert.Equal(t, int64(7), param.EvaluationSetID)
+				if param.PageToken == nil {
+					return page1, nil, nil, gptr.Of("next"), nil
+				}
+				return page2, nil, nil, nil, nil
+			}).Times(2)
+		return itemSvc
+	}
+
+	t.Run("MinHash 检测精确与近似重复", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := NewEvaluationSetDedupService(nil, mockList(ctrl), nil, nil)
+		report, err := svc.DetectDuplicates(context.Background(), &entity.EvaluationSetDedupParam{
+			SpaceID:         1,
+			EvaluationSetID: 7,
+			Conf:            &entity.EvaluationSetDedupConf{Threshold: 0.7},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 6, report.ScannedItemCnt)
+		assert.Equal(t, 1, report.SkippedItemCnt)
+		require.Len(t, report.Clusters, 1)
+		cluster := report.Clusters[0]
+		assert.Equal(t, entity.EvaluationSetDuplicateKind_Near, cluster.Kind)
+		assert.Equal(t, int64(1), cluster.KeepItemID)
+		assert.ElementsMatch(t, []int64{2, 4}, cluster.DuplicateItemIDs)
+		assert.True(t, cluster.Similarity >= 0.7 && cluster.Similarity < 1)
+		assert.Equal(t, 0, report.ExactDuplicateCnt)
+		assert.Equal(t, 2, report.NearDuplicateCnt)
+	})
+
+	t.Run("仅精确重复且限定字段", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := NewEvaluationSetDedupService(nil, mockList(ctrl), nil, nil)
+		report, err := svc.DetectDuplicates(context.Background(), &entity.EvaluationSetDedupParam{
+			SpaceID:         1,
+			EvaluationSetID: 7,
+			Conf:            &entity.EvaluationSetDedupConf{FieldNames: []string{"input"}, ExactOnly: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, report.Clusters, 2)
+		assert.Equal(t, &entity.EvaluationSetDuplicateCluster{
+			Kind: entity.EvaluationSetDuplicateKind_Exact, KeepItemID: 1, DuplicateItemIDs: []int64{2}, Similarity: 1,
+		}, report.Clusters[0])
+		assert.Equal(t, &entity.EvaluationSetDuplicateCluster{
+			Kind: entity.EvaluationSetDuplicateKind_Exact, KeepItemID: 3, DuplicateItemIDs: []int64{6}, Similarity: 1,
+		}, report.Clusters[1])
+		assert.Equal(t, 2, report.ExactDuplicateCnt)
+	})
+
+	t.Run("SimHash 检测近似重复", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := NewEvaluationSetDedupService(nil, mockList(ctrl), nil, nil)
+		report, err := svc.DetectDuplicates(context.Background(), &entity.EvaluationSetDedupParam{
+			SpaceID:         1,
+			EvaluationSetID: 7,
+			Conf:            &entity.EvaluationSetDedupConf{FieldNames: []string{"input"}, Method: entity.EvaluationSetDedupMethod_SimHash, Threshold: 0.9},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, report.Clusters)
+		assert.Equal(t, int64(1), report.Clusters[0].KeepItemID)
+		assert.Contains(t, report.Clusters[0].DuplicateItemIDs, int64(2))
+	})
+
+	t.Run("被裁剪的字段回查完整内容", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		omitted := newDedupTestItem(2, map[string]string{"input": "truncated"})
+		omitted.Turns[0].FieldDataList[0].Content.ContentOmitted = gptr.Of(true)
+		itemSvc := servicemocks.NewMockEvaluationSetItemService(ctrl)
+		itemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).Return(
+			[]*entity.EvaluationSetItem{newDedupTestItem(1, map[string]string{"input": "full text"}), omitted}, nil, nil, nil, nil)
+		itemSvc.EXPECT().GetEvaluationSetItemField(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.GetEvaluationSetItemFieldParam) (*entity.FieldData, error) {
+				assert.Equal(t, int64(2), param.ItemPK)
+				assert.Equal(t, int64(20), gptr.Indirect(param.TurnID))
+				return &entity.FieldData{Name: "input", Content: entity.NewTextContent("Full text")}, nil
+			})
+
+		report, err := NewEvaluationSetDedupService(nil, itemSvc, nil, nil).DetectDuplicates(context.Background(), &entity.EvaluationSetDedupParam{SpaceID: 1, EvaluationSetID: 7})
+		require.NoError(t, err)
+		require.Len(t, report.Clusters, 1)
+		assert.Equal(t, entity.EvaluationSetDuplicateKind_Exact, report.Clusters[0].Kind)
+	})
+
+	t.Run("参数非法", func(t *testing.T) {
+		svc := NewEvaluationSetDedupService(nil, nil, nil, nil)
+		_, err := svc.DetectDuplicates(context.Background(), &entity.EvaluationSetDedupParam{SpaceID: 1})
+		assert.Error(t, err)
+		_, err = svc.DetectDuplicates(context.Background(), &entity.EvaluationSetDedupParam{
+			SpaceID: 1, EvaluationSetID: 7, Conf: &entity.EvaluationSetDedupConf{Method: "unknown"},
+		})
+		assert.Error(t, err)
+		_, err = svc.DetectDuplicates(context.Background(), &entity.EvaluationSetDedupParam{
+			SpaceID: 1, EvaluationSetID: 7, Conf: &entity.EvaluationSetDedupConf{Threshold: 1.5},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("拉取评测集失败", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		itemSvc := servicemocks.NewMockEvaluationSetItemService(ctrl)
+		itemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).Return(nil, nil, nil, nil, errors.New("rpc fail"))
+		_, err := NewEvaluationSetDedupService(nil, itemSvc, nil, nil).DetectDuplicates(context.Background(), &entity.EvaluationSetDedupParam{SpaceID: 1, EvaluationSetID: 7})
+		assert.Error(t, err)
+	})
+}
+
+func TestEvaluationSetDedupServiceImpl_ArchiveDuplicates(t *testing.T) {
+	t.Run("分批删除并跳过保留行", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dups := make([]int64, 0, 150)
+		items := []*entity.EvaluationSetItem{
+			newDedupTestItem(1, map[string]string{"input": "how do I reset my password"}),
+			newDedupTestItem(2, map[string]string{"input": "how do I reset my password"}),
+			newDedupTestItem(5, map[string]string{"input": "what is the capital of france"}),
+			newDedupTestItem(6, map[string]string{"input": "what is the capital of france"}),
+		}
+		for i := int64(0); i < 150; i++ {
+			dups = append(dups, 1000+i)
+			items = append(items, newDedupTestItem(1000+i, map[string]string{"input": "how do I reset my password"}))
+		}
+		var deleted [][]int64
+		itemSvc := servicemocks.NewMockEvaluationSetItemService(ctrl)
+		itemSvc.EXPECT().BatchGetEvaluationSetItems(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.BatchGetEvaluationSetItemsParam) ([]*entity.EvaluationSetItem, error) {
+				assert.Nil(t, param.VersionID)
+				assert.Len(t, param.ItemIDs, 154)
+				return items, nil
+			})
+		itemSvc.EXPECT().BatchDeleteEvaluationSetItems(gomock.Any(), int64(1), int64(7), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _, _ int64, itemIDs []int64) error {
+				deleted = append(deleted, itemIDs)
+				return nil
+			}).Times(2)
+
+		archived, err := NewEvaluationSetDedupService(nil, itemSvc, nil, nil).ArchiveDuplicates(context.Background(), &entity.ArchiveEvaluationSetDuplicatesParam{
+			SpaceID:         1,
+			EvaluationSetID: 7,
+			Clusters: []*entity.EvaluationSetDuplicateCluster{
+				{KeepItemID: 1, DuplicateItemIDs: append([]int64{2}, dups...)},
+				{KeepItemID: 5, DuplicateItemIDs: []int64{6}},
+			},
+		})
+		require.NoError(t, err)
+		assert.Len(t, archived, 152)
+		require.Len(t, deleted, 2)
+		assert.Len(t, deleted[0], entity.EvaluationSetDedupArchiveBatchSize)
+		assert.NotContains(t, archived, int64(5))
+		assert.Contains(t, archived, int64(6))
+	})
+
+	t.Run("跳过检测后已变化的簇并只归档选中行", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		itemSvc := servicemocks.NewMockEvaluationSetItemService(ctrl)
+		itemSvc.EXPECT().BatchGetEvaluationSetItems(gomock.Any(), gomock.Any()).Return([]*entity.EvaluationSetItem{
+			newDedupTestItem(1, map[string]string{"input": "hello world"}),
+			newDedupTestItem(2, map[string]string{"input": "Hello, world!"}),
+			newDedupTestItem(3, map[string]string{"input": "hello world"}),
+			// 保留行 5 已被删除
+			newDedupTestItem(6, map[string]string{"input": "good morning"}),
+			// 10 检测后被编辑, 不再与 9 重复
+			newDedupTestItem(9, map[string]string{"input": "what is the capital of france"}),
+			newDedupTestItem(10, map[string]string{"input": "an unrelated question about billing"}),
+		}, nil)
+		itemSvc.EXPECT().BatchDeleteEvaluationSetItems(gomock.Any(), int64(1), int64(7), []int64{3}).Return(nil)
+
+		archived, err := NewEvaluationSetDedupService(nil, itemSvc, nil, nil).ArchiveDuplicates(context.Background(), &entity.ArchiveEvaluationSetDuplicatesParam{
+			SpaceID:         1,
+			EvaluationSetID: 7,
+			Clusters: []*entity.EvaluationSetDuplicateCluster{
+				{KeepItemID: 1, DuplicateItemIDs: []int64{2, 3}},
+				{KeepItemID: 5, DuplicateItemIDs: []int64{6}},
+				{KeepItemID: 9, DuplicateItemIDs: []int64{10}},
+			},
+			ItemIDs: []int64{3, 6, 10},
+			Conf:    &entity.EvaluationSetDedupConf{ExactOnly: true},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3}, archived)
+	})
+
+	t.Run("删除失败返回已归档部分", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		itemSvc := servicemocks.NewMockEvaluationSetItemService(ctrl)
+		itemSvc.EXPECT().BatchGetEvaluationSetItems(gomock.Any(), gomock.Any()).Return([]*entity.EvaluationSetItem{
+			newDedupTestItem(1, map[string]string{"input": "hello world"}),
+			newDedupTestItem(2, map[string]string{"input": "hello world"}),
+		}, nil)
+		itemSvc.EXPECT().BatchDeleteEvaluationSetItems(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("rpc fail"))
+		archived, err := NewEvaluationSetDedupService(nil, itemSvc, nil, nil).ArchiveDuplicates(context.Background(), &entity.ArchiveEvaluationSetDuplicatesParam{
+			SpaceID:         1,
+			EvaluationSetID: 7,
+			Clusters:        []*entity.EvaluationSetDuplicateCluster{{KeepItemID: 1, DuplicateItemIDs: []int64{2}}},
+		})
+		assert.Error(t, err)
+		assert.Empty(t, archived)
+	})
+
+	t.Run("参数非法", func(t *testing.T) {
+		_, err := NewEvaluationSetDedupService(nil, nil, nil, nil).ArchiveDuplicates(context.Background(), &entity.ArchiveEvaluationSetDuplicatesParam{SpaceID: 1})
+		assert.Error(t, err)
+	})
+}
+
+func TestEvaluationSetDedupServiceImpl_SubmitAndHandle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idgen := idgenmocks.NewMockIIDGenerator(ctrl)
+	itemSvc := servicemocks.NewMockEvaluationSetItemService(ctrl)
+	jobRepo := repomocks.NewMockIEvaluationSetDedupJobRepo(ctrl)
+	publisher := eventsmocks.NewMockExptEventPublisher(ctrl)
+	svc := NewEvaluationSetDedupService(idgen, itemSvc, jobRepo, publisher)
+
+	const spaceID = int64(1)
+	conf := &entity.EvaluationSetDedupConf{ExactOnly: true}
+
+	t.Run("提交任务", func(t *testing.T) {
+		idgen.EXPECT().GenID(gomock.Any()).Return(int64(77), nil)
+		jobRepo.EXPECT().CreateDedupJob(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *entity.EvaluationSetDedupJob) error {
+			assert.Equal(t, entity.EvaluationSetDedupJobStatus_Running, job.Status)
+			assert.Equal(t, int64(3), job.VersionID)
+			assert.Equal(t, "u1", job.CreatedBy)
+			return nil
+		})
+		publisher.EXPECT().PublishExptExportCSVEvent(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event *entity.ExportCSVEvent, _ *time.Duration) error {
+			assert.Equal(t, entity.ExportSceneEvaluationSetDedup, event.ExportScene)
+			assert.Equal(t, int64(77), event.ExportID)
+			return nil
+		})
+
+		job, err := svc.SubmitDedupJob(context.Background(), &entity.EvaluationSetDedupParam{
+			SpaceID: spaceID, EvaluationSetID: 7, VersionID: gptr.Of(int64(3)), Conf: conf,
+		}, &entity.Session{UserID: "u1"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(77), job.ID)
+	})
+
+	t.Run("提交时校验参数", func(t *testing.T) {
+		_, err := svc.SubmitDedupJob(context.Background(), &entity.EvaluationSetDedupParam{
+			SpaceID: spaceID, EvaluationSetID: 7, Conf: &entity.EvaluationSetDedupConf{Method: "lsh"},
+		}, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("已结束的任务跳过", func(t *testing.T) {
+		jobRepo.EXPECT().GetDedupJob(gomock.Any(), spaceID, int64(77)).
+			Return(&entity.EvaluationSetDedupJob{ID: 77, SpaceID: spaceID, Status: entity.EvaluationSetDedupJobStatus_Success}, nil)
+		assert.NoError(t, svc.HandleDedupEvent(context.Background(), spaceID, 77))
+	})
+
+	t.Run("执行并回写报告", func(t *testing.T) {
+		jobRepo.EXPECT().GetDedupJob(gomock.Any(), spaceID, int64(77)).Return(&entity.EvaluationSetDedupJob{
+			ID: 77, SpaceID: spaceID, EvaluationSetID: 7, VersionID: 3, Status: entity.EvaluationSetDedupJobStatus_Running, Conf: conf,
+		}, nil)
+		itemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.ListEvaluationSetItemsParam) ([]*entity.EvaluationSetItem, *int64, *int64, *string, error) {
+				assert.Equal(t, int64(3), gptr.Indirect(param.VersionID))
+				return []*entity.EvaluationSetItem{
+					newDedupTestItem(1, map[string]string{"input": "hello world"}),
+					newDedupTestItem(2, map[string]string{"input": "Hello, world!"}),
+				}, nil, nil, nil, nil
+			})
+		jobRepo.EXPECT().UpdateDedupJobResult(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *entity.EvaluationSetDedupJob) error {
+			assert.Equal(t, entity.EvaluationSetDedupJobStatus_Success, job.Status)
+			require.NotNil(t, job.Report)
+			assert.Equal(t, 1, job.Report.ExactDuplicateCnt)
+			return nil
+		})
+		assert.NoError(t, svc.HandleDedupEvent(context.Background(), spaceID, 77))
+	})
+
+	t.Run("检测失败记录错误", func(t *testing.T) {
+		jobRepo.EXPECT().GetDedupJob(gomock.Any(), spaceID, int64(77)).Return(&entity.EvaluationSetDedupJob{
+			ID: 77, SpaceID: spaceID, EvaluationSetID: 7, Status: entity.EvaluationSetDedupJobStatus_Running,
+		}, nil)
+		itemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).Return(nil, nil, nil, nil, errors.New("rpc fail"))
+		jobRepo.EXPECT().UpdateDedupJobResult(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *entity.EvaluationSetDedupJob) error {
+			assert.Equal(t, entity.EvaluationSetDedupJobStatus_Failed, job.Status)
+			assert.Equal(t, "rpc fail", job.ErrMsg)
+			return nil
+		})
+		assert.NoError(t, svc.HandleDedupEvent(context.Background(), spaceID, 77))
+	})
+
+	t.Run("超时任务置为失败", func(t *testing.T) {
+		jobRepo.EXPECT().GetDedupJob(gomock.Any(), spaceID, int64(77)).Return(&entity.EvaluationSetDedupJob{
+			ID: 77, SpaceID: spaceID, Status: entity.EvaluationSetDedupJobStatus_Running, CreatedAt: time.Now().Add(-3 * time.Hour),
+		}, nil)
+		jobRepo.EXPECT().UpdateDedupJobResult(gomock.Any(), gomock.Any()).Return(nil)
+
+		job, err := svc.GetDedupJob(context.Background(), spaceID, 77)
+		require.NoError(t, err)
+		assert.Equal(t, entity.EvaluationSetDedupJobStatus_Failed, job.Status)
+	})
+
+	t.Run("任务不存在", func(t *testing.T) {
+		jobRepo.EXPECT().GetDedupJob(gomock.Any(), spaceID, int64(78)).Return(nil, nil)
+		_, err := svc.GetDedupJob(context.Background(), spaceID, 78)
+		assert.Error(t, err)
+	})
+}
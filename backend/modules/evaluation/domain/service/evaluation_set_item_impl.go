@@ -9,6 +9,7 @@ import (
 
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 )
@@ -20,12 +21,14 @@ var (
 
 type EvaluationSetItemServiceImpl struct {
 	datasetRPCAdapter rpc.IDatasetRPCAdapter
+	provenanceRepo    repo.IEvaluationSetItemProvenanceRepo
 }
 
-func NewEvaluationSetItemServiceImpl(datasetRPCAdapter rpc.IDatasetRPCAdapter) EvaluationSetItemService {
+func NewEvaluationSetItemServiceImpl(datasetRPCAdapter rpc.IDatasetRPCAdapter, provenanceRepo repo.IEvaluationSetItemProvenanceRepo) EvaluationSetItemService {
 	evaluationSetItemServiceOnce.Do(func() {
 		evaluationSetItemServiceImpl = &EvaluationSetItemServiceImpl{
 			datasetRPCAdapter: datasetRPCAdapter,
+			provenanceRepo:    provenanceRepo,
 		}
 	})
 	return evaluationSetItemServiceImpl
@@ -66,6 +69,19 @@ func (d *EvaluationSetItemServiceImpl) BatchDeleteEvaluationSetItems(ctx context
 	return d.datasetRPCAdapter.BatchDeleteDatasetItems(ctx, spaceID, evaluationSetID, itemIDs)
 }
 
+func (d *EvaluationSetItemServiceImpl) ValidateEvaluationSetItems(ctx context.Context, param *entity.ValidateEvaluationSetItemsParam) (validIndices []int32, errors []*entity.ItemErrorGroup, err error) {
+	if param == nil {
+		return nil, nil, errorx.NewByCode(errno.CommonInternalErrorCode)
+	}
+	return d.datasetRPCAdapter.ValidateDatasetItems(ctx, &rpc.ValidateDatasetItemsParam{
+		SpaceID:                param.SpaceID,
+		EvaluationSetID:        param.EvaluationSetID,
+		Items:                  param.Items,
+		FieldSchemas:           param.FieldSchemas,
+		IgnoreCurrentItemCount: param.IgnoreCurrentItemCount,
+	})
+}
+
 func (d *EvaluationSetItemServiceImpl) ListEvaluationSetItems(ctx context.Context, param *entity.ListEvaluationSetItemsParam) (items []*entity.EvaluationSetItem, total, filterTotal *int64, nextPageToken *string, err error) {
 	if param == nil {
 		return nil, nil, nil, nil, errorx.NewByCode(errno.CommonInternalErrorCode)
@@ -237,3 +253,19 @@ func (d *EvaluationSetItemServiceImpl) BatchAddExistEvaluationSetItems(ctx conte
 		AllowPartialAdd: param.AllowPartialAdd,
 	})
 }
+
+func (d *EvaluationSetItemServiceImpl) ListPendingReviewItemIDs(ctx context.Context, spaceID, evaluationSetID int64) ([]int64, error) {
+	const pageSize = 200
+	filter := &entity.EvaluationSetItemProvenanceFilter{ReviewStatuses: []string{entity.EvaluationSetItemReviewStatus_PendingReview}}
+	var itemIDs []int64
+	for pageNum := 1; ; pageNum++ {
+		ids, total, err := d.provenanceRepo.ListItemIDs(ctx, spaceID, evaluationSetID, filter, entity.NewPage(pageNum, pageSize))
+		if err != nil {
+			return nil, err
+		}
+		itemIDs = append(itemIDs, ids...)
+		if len(ids) < pageSize || int64(len(itemIDs)) >= total {
+			return itemIDs, nil
+		}
+	}
+}
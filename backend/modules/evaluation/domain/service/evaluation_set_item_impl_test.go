@@ -15,6 +15,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 )
@@ -88,7 +89,7 @@ func TestEvaluationSetItemServiceImpl_UpdateEvaluationSetItem(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockDatasetRPCAdapter := mocks.NewMockIDatasetRPCAdapter(ctrl)
-	service := NewEvaluationSetItemServiceImpl(mockDatasetRPCAdapter)
+	service := NewEvaluationSetItemServiceImpl(mockDatasetRPCAdapter, nil)
 
 	tests := []struct {
 		name      string
@@ -157,7 +158,7 @@ func TestEvaluationSetItemServiceImpl_BatchDeleteEvaluationSetItems(t *testing.T
 	defer ctrl.Finish()
 	evaluationSetItemServiceOnce = sync.Once{}
 	mockDatasetRPCAdapter := mocks.NewMockIDatasetRPCAdapter(ctrl)
-	service := NewEvaluationSetItemServiceImpl(mockDatasetRPCAdapter)
+	service := NewEvaluationSetItemServiceImpl(mockDatasetRPCAdapter, nil)
 
 	tests := []struct {
 		name      string
@@ -212,7 +213,7 @@ func TestEvaluationSetItemServiceImpl_ListEvaluationSetItems(t *testing.T) {
 	defer ctrl.Finish()
 	evaluationSetItemServiceOnce = sync.Once{}
 	mockDatasetRPCAdapter := mocks.NewMockIDatasetRPCAdapter(ctrl)
-	service := NewEvaluationSetItemServiceImpl(mockDatasetRPCAdapter)
+	service := NewEvaluationSetItemServiceImpl(mockDatasetRPCAdapter, nil)
 
 	tests := []struct {
 		name            string
@@ -298,7 +299,7 @@ func TestEvaluationSetItemServiceImpl_BatchGetEvaluationSetItems(t *testing.T) {
 	defer ctrl.Finish()
 	evaluationSetItemServiceOnce = sync.Once{}
 	mockDatasetRPCAdapter := mocks.NewMockIDatasetRPCAdapter(ctrl)
-	service := NewEvaluationSetItemServiceImpl(mockDatasetRPCAdapter)
+	service := NewEvaluationSetItemServiceImpl(mockDatasetRPCAdapter, nil)
 	tagFilter := &entity.TagFilter{
 		TagNames: []string{"tag-api-verify-a"},
 		Relation: entity.TagFilterRelationOr,
@@ -442,7 +443,7 @@ func TestEvaluationSetItemServiceImpl_BatchUpdateEvaluationSetItems(t *testing.T
 	defer ctrl.Finish()
 	evaluationSetItemServiceOnce = sync.Once{}
 	mockDatasetRPCAdapter := mocks.NewMockIDatasetRPCAdapter(ctrl)
-	service := NewEvaluationSetItemServiceImpl(mockDatasetRPCAdapter)
+	service := NewEvaluationSetItemServiceImpl(mockDatasetRPCAdapter, nil)
 
 	tests := []struct {
 		name            string
@@ -602,7 +603,7 @@ func TestEvaluationSetItemServiceImpl_ClearEvaluationSetDraftItem(t *testing.T)
 	defer ctrl.Finish()
 	evaluationSetItemServiceOnce = sync.Once{}
 	mockDatasetRPCAdapter := mocks.NewMockIDatasetRPCAdapter(ctrl)
-	service := NewEvaluationSetItemServiceImpl(mockDatasetRPCAdapter)
+	service := NewEvaluationSetItemServiceImpl(mockDatasetRPCAdapter, nil)
 
 	tests := []struct {
 		name      string
@@ -681,7 +682,7 @@ func TestEvaluationSetItemServiceImpl_GetEvaluationSetItemField(t *testing.T) {
 	defer ctrl.Finish()
 	evaluationSetItemServiceOnce = sync.Once{}
 	mockAdapter := mocks.NewMockIDatasetRPCAdapter(ctrl)
-	service := NewEvaluationSetItemServiceImpl(mockAdapter)
+	service := NewEvaluationSetItemServiceImpl(mockAdapter, nil)
 
 	ctx := context.Background()
 
@@ -711,3 +712,33 @@ func TestEvaluationSetItemServiceImpl_GetEvaluationSetItemField(t *testing.T) {
 		assert.Equal(t, int32(errno.CommonRPCErrorCode), statusErr.Code())
 	})
 }
+
+func TestEvaluationSetItemServiceImpl_ListPendingReviewItemIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	provenanceRepo := repomocks.NewMockIEvaluationSetItemProvenanceRepo(ctrl)
+	service := &EvaluationSetItemServiceImpl{provenanceRepo: provenanceRepo}
+	filter := &entity.EvaluationSetItemProvenanceFilter{ReviewStatuses: []string{entity.EvaluationSetItemReviewStatus_PendingReview}}
+
+	t.Run("翻页读取全部待审核行", func(t *testing.T) {
+		firstPage := make([]int64, 200)
+		for i := range firstPage {
+			firstPage[i] = int64(i + 1)
+		}
+		provenanceRepo.EXPECT().ListItemIDs(gomock.Any(), int64(1), int64(7), filter, entity.NewPage(1, 200)).Return(firstPage, int64(201), nil)
+		provenanceRepo.EXPECT().ListItemIDs(gomock.Any(), int64(1), int64(7), filter, entity.NewPage(2, 200)).Return([]int64{201}, int64(201), nil)
+
+		itemIDs, err := service.ListPendingReviewItemIDs(context.Background(), 1, 7)
+		assert.NoError(t, err)
+		assert.Len(t, itemIDs, 201)
+		assert.Equal(t, int64(201), itemIDs[200])
+	})
+
+	t.Run("查询失败", func(t *testing.T) {
+		provenanceRepo.EXPECT().ListItemIDs(gomock.Any(), int64(1), int64(7), filter, gomock.Any()).Return(nil, int64(0), errorx.NewByCode(errno.CommonMySqlErrorCode))
+
+		_, err := service.ListPendingReviewItemIDs(context.Background(), 1, 7)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination ./mocks/evaluation_set_item_provenance.go --package mocks . EvaluationSetItemProvenanceService
+type EvaluationSetItemProvenanceService interface {
+	// RecordProvenance 为新写入的行登记来源, 作者缺省取当前用户
+	RecordProvenance(ctx context.Context, param *entity.RecordEvaluationSetItemProvenanceParam) error
+	// MarkEdited 记录行的最后编辑者, 来源保持不变
+	MarkEdited(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64) error
+	// UpdateReviewStatus 更新生成行的审核状态
+	UpdateReviewStatus(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64, reviewStatus string) error
+	// RemoveProvenance 行从草稿删除后清理来源
+	RemoveProvenance(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64) error
+	MGetProvenance(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64) (map[int64]*entity.EvaluationSetItemProvenance, error)
+	// ListEvaluationSetItems 同 EvaluationSetItemService.ListEvaluationSetItems, 额外支持 ProvenanceFilter, 返回的行附带来源
+	ListEvaluationSetItems(ctx context.Context, param *entity.ListEvaluationSetItemsParam) (items []*entity.EvaluationSetItem, total, filterTotal *int64, nextPageToken *string, err error)
+	// GetItemLineage 查询行的来源及使用过该行的实验与各次得分
+	GetItemLineage(ctx context.Context, param *entity.GetEvaluationSetItemLineageParam) (*entity.EvaluationSetItemLineage, error)
+}
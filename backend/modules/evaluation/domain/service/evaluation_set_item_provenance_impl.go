@@ -0,0 +1,434 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/bytedance/gg/gslice"
+
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+type EvaluationSetItemProvenanceServiceImpl struct {
+	provenanceRepo           repo.IEvaluationSetItemProvenanceRepo
+	evaluationSetItemService EvaluationSetItemService
+	exptRepo                 repo.IExperimentRepo
+	itemResultRepo           repo.IExptItemResultRepo
+	turnResultRepo           repo.IExptTurnResultRepo
+	evaluatorRecordService   EvaluatorRecordService
+	// 文件导入由数据集导入任务直接写行, 补录历史行来源时按导入任务归因
+	datasetRPCAdapter rpc.IDatasetRPCAdapter
+}
+
+func NewEvaluationSetItemProvenanceService(
+	provenanceRepo repo.IEvaluationSetItemProvenanceRepo,
+	evaluationSetItemService EvaluationSetItemService,
+	exptRepo repo.IExperimentRepo,
+	itemResultRepo repo.IExptItemResultRepo,
+	turnResultRepo repo.IExptTurnResultRepo,
+	evaluatorRecordService EvaluatorRecordService,
+	datasetRPCAdapter rpc.IDatasetRPCAdapter,
+) EvaluationSetItemProvenanceService {
+	return &EvaluationSetItemProvenanceServiceImpl{
+		provenanceRepo:           provenanceRepo,
+		evaluationSetItemService: evaluationSetItemService,
+		exptRepo:                 exptRepo,
+		itemResultRepo:           itemResultRepo,
+		turnResultRepo:           turnResultRepo,
+		evaluatorRecordService:   evaluatorRecordService,
+		datasetRPCAdapter:        datasetRPCAdapter,
+	}
+}
+
+func (e *EvaluationSetItemProvenanceServiceImpl) RecordProvenance(ctx context.Context, param *entity.RecordEvaluationSetItemProvenanceParam) error {
+	if param == nil || param.SpaceID <= 0 || param.EvaluationSetID <= 0 || param.Template == nil {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid provenance param"))
+	}
+	// unknown 只由读时补录产生, 写入路径必须登记明确来源
+	if !param.Template.SourceType.IsValid() || param.Template.SourceType == entity.EvaluationSetItemSourceType_Unknown {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("unsupported item source type "+string(param.Template.SourceType)))
+	}
+	author := param.Template.Author
+	if len(author) == 0 {
+		author = session.UserIDInCtxOrEmpty(ctx)
+	}
+
+	provenances := make([]*entity.EvaluationSetItemProvenance, 0, len(param.ItemIDs))
+	for _, itemID := range gslice.Uniq(param.ItemIDs) {
+		p := &entity.EvaluationSetItemProvenance{
+			SpaceID:         param.SpaceID,
+			EvaluationSetID: param.EvaluationSetID,
+			ItemID:          itemID,
+			SourceType:      param.Template.SourceType,
+			TraceID:         param.Template.TraceID,
+			SpanID:          param.Template.SpanID,
+			ImportJobID:     param.Template.ImportJobID,
+			SourceExptID:    param.Template.SourceExptID,
+			SourceVersionID: param.Template.SourceVersionID,
+			ReviewStatus:    param.Template.ReviewStatus,
+			Author:          author,
+			UpdatedBy:       author,
+		}
+		if span := param.TraceSpans[itemID]; span != nil {
+			p.TraceID, p.SpanID = span.TraceID, span.SpanID
+		}
+		provenances = append(provenances, p)
+	}
+	for _, chunk := range gslice.Chunk(provenances, entity.EvaluationSetItemProvenanceBatchSize) {
+		if err := e.provenanceRepo.BatchUpsert(ctx, chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *EvaluationSetItemProvenanceServiceImpl) MarkEdited(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64) error {
+	updatedBy := session.UserIDInCtxOrEmpty(ctx)
+	for _, chunk := range gslice.Chunk(gslice.Uniq(itemIDs), entity.EvaluationSetItemProvenanceBatchSize) {
+		if err := e.provenanceRepo.UpdateEditor(ctx, spaceID, evaluationSetID, chunk, updatedBy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *EvaluationSetItemProvenanceServiceImpl) UpdateReviewStatus(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64, reviewStatus string) error {
+	for _, chunk := range gslice.Chunk(gslice.Uniq(itemIDs), entity.EvaluationSetItemProvenanceBatchSize) {
+		if err := e.provenanceRepo.UpdateReviewStatus(ctx, spaceID, evaluationSetID, chunk, reviewStatus); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *EvaluationSetItemProvenanceServiceImpl) RemoveProvenance(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64) error {
+	for _, chunk := range gslice.Chunk(gslice.Uniq(itemIDs), entity.EvaluationSetItemProvenanceBatchSize) {
+		if err := e.provenanceRepo.BatchDelete(ctx, spaceID, evaluationSetID, chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *EvaluationSetItemProvenanceServiceImpl) MGetProvenance(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64) (map[int64]*entity.EvaluationSetItemProvenance, error) {
+	res := make(map[int64]*entity.EvaluationSetItemProvenance, len(itemIDs))
+	for _, chunk := range gslice.Chunk(gslice.Uniq(itemIDs), entity.EvaluationSetItemProvenanceBatchSize) {
+		provenances, err := e.provenanceRepo.MGet(ctx, spaceID, evaluationSetID, chunk)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range provenances {
+			if p != nil {
+				res[p.ItemID] = p
+			}
+		}
+	}
+	return res, nil
+}
+
+// ListEvaluationSetItems 带来源筛选时先在来源表按 item_id 升序分页, 再按 id 取草稿或指定版本中的行;
+// 来源命中但在该版本中不存在的行不返回, 因此单页行数可能少于 PageSize, total 与 filterTotal 为来源命中数
+func (e *EvaluationSetItemProvenanceServiceImpl) ListEvaluationSetItems(ctx context.Context, param *entity.ListEvaluationSetItemsParam) (items []*entity.EvaluationSetItem, total, filterTotal *int64, nextPageToken *string, err error) {
+	if param == nil {
+		return nil, nil, nil, nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid list param"))
+	}
+	if param.ProvenanceFilter.IsEmpty() {
+		items, total, filterTotal, nextPageToken, err = e.evaluationSetItemService.ListEvaluationSetItems(ctx, param)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		if err = e.fillProvenance(ctx, param.SpaceID, param.EvaluationSetID, items); err != nil {
+			return nil, nil, nil, nil, err
+		}
+		return items, total, filterTotal, nextPageToken, nil
+	}
+
+	if param.Filter != nil || param.TagFilter != nil || len(param.ItemIDsNotIn) > 0 || gptr.Indirect(param.PageToken) != "" {
+		return nil, nil, nil, nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("provenance filter cannot be combined with content filter, tag filter, excluded items or page token"))
+	}
+	for _, t := range param.ProvenanceFilter.SourceTypes {
+		if !t.IsValid() {
+			return nil, nil, nil, nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("unsupported item source type "+string(t)))
+		}
+	}
+
+	page := entity.NewPage(int(gptr.Indirect(param.PageNumber)), int(gptr.Indirect(param.PageSize)))
+	itemIDs, cnt, err := e.provenanceRepo.ListItemIDs(ctx, param.SpaceID, param.EvaluationSetID, param.ProvenanceFilter, page)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if len(itemIDs) > 0 {
+		items, err = e.evaluationSetItemService.BatchGetEvaluationSetItems(ctx, &entity.BatchGetEvaluationSetItemsParam{
+			SpaceID:         param.SpaceID,
+			EvaluationSetID: param.EvaluationSetID,
+			ItemIDs:         itemIDs,
+			VersionID:       param.VersionID,
+		})
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		// 按来源表分页顺序返回
+		idx := make(map[int64]int, len(itemIDs))
+		for i, id := range itemIDs {
+			idx[id] = i
+		}
+		items = gslice.Filter(items, func(item *entity.EvaluationSetItem) bool { return item != nil })
+		sort.SliceStable(items, func(i, j int) bool { return idx[items[i].ItemID] < idx[items[j].ItemID] })
+		if err = e.fillProvenance(ctx, param.SpaceID, param.EvaluationSetID, items); err != nil {
+			return nil, nil, nil, nil, err
+		}
+	}
+	return items, gptr.Of(cnt), gptr.Of(cnt), nil, nil
+}
+
+func (e *EvaluationSetItemProvenanceServiceImpl) fillProvenance(ctx context.Context, spaceID, evaluationSetID int64, items []*entity.EvaluationSetItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	itemIDs := make([]int64, 0, len(items))
+	for _, item := range items {
+		if item != nil {
+			itemIDs = append(itemIDs, item.ItemID)
+		}
+	}
+	provenances, err := e.MGetProvenance(ctx, spaceID, evaluationSetID, itemIDs)
+	if err != nil {
+		return err
+	}
+	missing := gslice.Filter(items, func(item *entity.EvaluationSetItem) bool { return item != nil && provenances[item.ItemID] == nil })
+	for itemID, p := range e.backfillProvenance(ctx, spaceID, evaluationSetID, missing) {
+		provenances[itemID] = p
+	}
+	for _, item := range items {
+		if item != nil {
+			item.Provenance = provenances[item.ItemID]
+		}
+	}
+	return nil
+}
+
+// backfillProvenance 为未登记来源的行补录来源并落库。评测集侧的写入路径均会登记来源, 缺失来源的行只可能是
+// 登记上线前写入的历史行或由数据集导入任务直接写入: 创建时间落在同一创建者的文件导入任务执行区间内的归为该任务,
+// 其余记为 unknown。补录只写入尚无记录的行, 不覆盖并发登记的来源; 失败只记日志, 不影响读取
+func (e *EvaluationSetItemProvenanceServiceImpl) backfillProvenance(ctx context.Context, spaceID, evaluationSetID int64, items []*entity.EvaluationSetItem) map[int64]*entity.EvaluationSetItemProvenance {
+	if len(items) == 0 {
+		return nil
+	}
+	var importJobs []*entity.DatasetIOJob
+	if e.datasetRPCAdapter != nil {
+		jobs, err := e.datasetRPCAdapter.ListDatasetIOJobs(ctx, spaceID, evaluationSetID, []entity.JobType{entity.JobType_ImportFromFile})
+		if err != nil {
+			logs.CtxWarn(ctx, "list import jobs for provenance backfill fail, evaluation_set_id: %v, err: %v", evaluationSetID, err)
+		}
+		importJobs = jobs
+	}
+
+	res := make(map[int64]*entity.EvaluationSetItemProvenance, len(items))
+	provenances := make([]*entity.EvaluationSetItemProvenance, 0, len(items))
+	for _, item := range items {
+		var (
+			createdAt int64
+			createdBy string
+		)
+		if item.BaseInfo != nil {
+			createdAt = gptr.Indirect(item.BaseInfo.CreatedAt)
+			if item.BaseInfo.CreatedBy != nil {
+				createdBy = gptr.Indirect(item.BaseInfo.CreatedBy.UserID)
+			}
+		}
+		p := &entity.EvaluationSetItemProvenance{
+			SpaceID:         spaceID,
+			EvaluationSetID: evaluationSetID,
+			ItemID:          item.ItemID,
+			SourceType:      entity.EvaluationSetItemSourceType_Unknown,
+			Author:          createdBy,
+			UpdatedBy:       createdBy,
+		}
+		if job := matchImportJob(importJobs, createdBy, createdAt); job != nil {
+			p.SourceType = entity.EvaluationSetItemSourceType_FileImport
+			p.ImportJobID = job.ID
+		}
+		res[item.ItemID] = p
+		provenances = append(provenances, p)
+	}
+	for _, chunk := range gslice.Chunk(provenances, entity.EvaluationSetItemProvenanceBatchSize) {
+		if err := e.provenanceRepo.BatchCreateIfAbsent(ctx, chunk); err != nil {
+			logs.CtxWarn(ctx, "backfill provenance fail, evaluation_set_id: %v, err: %v", evaluationSetID, err)
+			break
+		}
+	}
+	return res
+}
+
+// matchImportJob 找到创建者相同且执行区间 [started_at, ended_at] 覆盖 createdAt (毫秒) 的导入任务, 未结束的任务区间右端不设限
+func matchImportJob(jobs []*entity.DatasetIOJob, createdBy string, createdAt int64) *entity.DatasetIOJob {
+	if createdAt <= 0 {
+		return nil
+	}
+	for _, job := range jobs {
+		if job == nil || job.StartedAt == nil || gptr.Indirect(job.CreatedBy) != createdBy {
+			continue
+		}
+		if createdAt < *job.StartedAt || (job.EndedAt != nil && createdAt > *job.EndedAt) {
+			continue
+		}
+		return job
+	}
+	return nil
+}
+
+// GetItemLineage 回溯最近 MaxEvaluationSetItemLineageExptCnt 个包含该评测集的实验, 未运行到该行的实验不计入
+func (e *EvaluationSetItemProvenanceServiceImpl) GetItemLineage(ctx context.Context, param *entity.GetEvaluationSetItemLineageParam) (*entity.EvaluationSetItemLineage, error) {
+	if param == nil || param.SpaceID <= 0 || param.EvaluationSetID <= 0 || param.ItemID <= 0 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid lineage param"))
+	}
+	provenances, err := e.MGetProvenance(ctx, param.SpaceID, param.EvaluationSetID, []int64{param.ItemID})
+	if err != nil {
+		return nil, err
+	}
+	if provenances[param.ItemID] == nil {
+		items, err := e.evaluationSetItemService.BatchGetEvaluationSetItems(ctx, &entity.BatchGetEvaluationSetItemsParam{
+			SpaceID:         param.SpaceID,
+			EvaluationSetID: param.EvaluationSetID,
+			ItemIDs:         []int64{param.ItemID},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for itemID, p := range e.backfillProvenance(ctx, param.SpaceID, param.EvaluationSetID, gslice.Filter(items, func(item *entity.EvaluationSetItem) bool { return item != nil })) {
+			provenances[itemID] = p
+		}
+	}
+	lineage := &entity.EvaluationSetItemLineage{
+		ItemID:     param.ItemID,
+		Provenance: provenances[param.ItemID],
+	}
+
+	expts, total, err := e.exptRepo.List(ctx, 1, entity.MaxEvaluationSetItemLineageExptCnt, &entity.ExptListFilter{
+		Includes: &entity.ExptFilterFields{EvalSetIDs: []int64{param.EvaluationSetID}},
+	}, nil, param.SpaceID)
+	if err != nil {
+		return nil, err
+	}
+	lineage.Truncated = total > int64(len(expts))
+
+	for _, expt := range expts {
+		if expt == nil {
+			continue
+		}
+		usage, err := e.loadItemUsage(ctx, param.SpaceID, expt, param.ItemID)
+		if err != nil {
+			return nil, err
+		}
+		if usage != nil {
+			lineage.Usages = append(lineage.Usages, usage)
+		}
+	}
+	sort.SliceStable(lineage.Usages, func(i, j int) bool {
+		ti, tj := lineage.Usages[i].StartAt, lineage.Usages[j].StartAt
+		if ti != nil && tj != nil && !ti.Equal(*tj) {
+			return ti.Before(*tj)
+		}
+		if (ti == nil) != (tj == nil) {
+			return ti == nil
+		}
+		return lineage.Usages[i].ExptID < lineage.Usages[j].ExptID
+	})
+	return lineage, nil
+}
+
+func (e *EvaluationSetItemProvenanceServiceImpl) loadItemUsage(ctx context.Context, spaceID int64, expt *entity.Experiment, itemID int64) (*entity.EvaluationSetItemUsage, error) {
+	itemResults, err := e.itemResultRepo.MGetItemResults(ctx, expt.ID, []int64{itemID}, spaceID)
+	if err != nil {
+		return nil, err
+	}
+	if len(itemResults) == 0 || itemResults[0] == nil {
+		return nil, nil
+	}
+	itemResult := itemResults[0]
+	usage := &entity.EvaluationSetItemUsage{
+		ExptID:           expt.ID,
+		ExptName:         expt.Name,
+		ExptStatus:       expt.Status,
+		EvalSetVersionID: expt.EvalSetVersionID,
+		ItemVersionID:    itemResult.ItemVersionID,
+		ItemStatus:       itemResult.Status,
+		StartAt:          expt.StartAt,
+	}
+	switch itemResult.Status {
+	case entity.ItemRunState_Success, entity.ItemRunState_Fail, entity.ItemRunState_Terminal:
+		usage.FinishedAt = itemResult.UpdatedAt
+	default:
+	}
+
+	turnResults, err := e.turnResultRepo.GetItemTurnResults(ctx, expt.ID, itemID, spaceID)
+	if err != nil {
+		return nil, err
+	}
+	scores, err := e.loadTurnEvaluatorScores(ctx, spaceID, turnResults)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(turnResults, func(i, j int) bool { return turnResults[i].TurnIdx < turnResults[j].TurnIdx })
+	for _, tr := range turnResults {
+		usage.TurnScores = append(usage.TurnScores, &entity.EvaluationSetItemTurnScore{
+			TurnID:          tr.TurnID,
+			WeightedScore:   tr.WeightedScore,
+			EvaluatorScores: scores[tr.ID],
+		})
+	}
+	return usage, nil
+}
+
+// loadTurnEvaluatorScores 加载 turn 结果的 Builtin 评估器成功得分, 口径同 loadExptTurnScoresByTurns; 返回 turn 结果 id -> 评估器实例 key -> 得分
+func (e *EvaluationSetItemProvenanceServiceImpl) loadTurnEvaluatorScores(ctx context.Context, spaceID int64, turnResults []*entity.ExptTurnResult) (map[int64]map[string]float64, error) {
+	turnResults = gslice.Filter(turnResults, func(tr *entity.ExptTurnResult) bool { return tr != nil })
+	if len(turnResults) == 0 {
+		return nil, nil
+	}
+	refs, err := e.turnResultRepo.BatchGetTurnEvaluatorResultRef(ctx, spaceID, gslice.Map(turnResults, func(tr *entity.ExptTurnResult) int64 { return tr.ID }))
+	if err != nil {
+		return nil, err
+	}
+	refs = gslice.Filter(refs, func(ref *entity.ExptTurnEvaluatorResultRef) bool {
+		return ref != nil && ref.SourceType != int32(entity.EvaluatorRecordSourceTypeInline)
+	})
+
+	recordScores := make(map[int64]float64, len(refs))
+	recordIDs := gslice.Map(refs, func(ref *entity.ExptTurnEvaluatorResultRef) int64 { return ref.EvaluatorResultID })
+	for _, chunk := range gslice.Chunk(recordIDs, 200) {
+		records, err := e.evaluatorRecordService.BatchGetEvaluatorRecordForAggr(ctx, chunk)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range records {
+			if record == nil || record.Status != entity.EvaluatorRunStatusSuccess || record.Score == nil {
+				continue
+			}
+			recordScores[record.ID] = *record.Score
+		}
+	}
+
+	scores := make(map[int64]map[string]float64, len(turnResults))
+	for _, ref := range refs {
+		score, ok := recordScores[ref.EvaluatorResultID]
+		if !ok {
+			continue
+		}
+		if scores[ref.ExptTurnResultID] == nil {
+			scores[ref.ExptTurnResultID] = make(map[string]float64)
+		}
+		scores[ref.ExptTurnResultID][entity.EncodeEvaluatorInstanceKey(ref.EvaluatorVersionID, ref.Alias)] = score
+	}
+	return scores, nil
+}
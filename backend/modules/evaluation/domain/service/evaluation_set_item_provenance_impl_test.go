@@ -0,0 +1,318 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	rpcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repoMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+type provenanceTestMocks struct {
+	provenanceRepo *repoMocks.MockIEvaluationSetItemProvenanceRepo
+	itemSvc        *svcMocks.MockEvaluationSetItemService
+	exptRepo       *repoMocks.MockIExperimentRepo
+	itemResultRepo *repoMocks.MockIExptItemResultRepo
+	turnResultRepo *repoMocks.MockIExptTurnResultRepo
+	recordSvc      *svcMocks.MockEvaluatorRecordService
+	datasetAdapter *rpcMocks.MockIDatasetRPCAdapter
+}
+
+func newProvenanceTestService(ctrl *gomock.Controller) (EvaluationSetItemProvenanceService, *provenanceTestMocks) {
+	m := &provenanceTestMocks{
+		provenanceRepo: repoMocks.NewMockIEvaluationSetItemProvenanceRepo(ctrl),
+		itemSvc:        svcMocks.NewMockEvaluationSetItemService(ctrl),
+		exptRepo:       repoMocks.NewMockIExperimentRepo(ctrl),
+		itemResultRepo: repoMocks.NewMockIExptItemResultRepo(ctrl),
+		turnResultRepo: repoMocks.NewMockIExptTurnResultRepo(ctrl),
+		recordSvc:      svcMocks.NewMockEvaluatorRecordService(ctrl),
+		datasetAdapter: rpcMocks.NewMockIDatasetRPCAdapter(ctrl),
+	}
+	return NewEvaluationSetItemProvenanceService(m.provenanceRepo, m.itemSvc, m.exptRepo, m.itemResultRepo, m.turnResultRepo, m.recordSvc, m.datasetAdapter), m
+}
+
+func TestEvaluationSetItemProvenanceServiceImpl_RecordProvenance(t *testing.T) {
+	t.Run("按模板为每行登记来源", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newProvenanceTestService(ctrl)
+		m.provenanceRepo.EXPECT().BatchUpsert(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, provenances []*entity.EvaluationSetItemProvenance) error {
+				require.Len(t, provenances, 2)
+				for _, p := range provenances {
+					assert.Equal(t, int64(7), p.EvaluationSetID)
+					assert.Equal(t, entity.EvaluationSetItemSourceType_TraceExport, p.SourceType)
+					assert.Equal(t, "trace-1", p.TraceID)
+					assert.Equal(t, "u1", p.Author)
+					assert.Equal(t, "u1", p.UpdatedBy)
+				}
+				assert.Equal(t, int64(10), provenances[0].ItemID)
+				assert.Equal(t, int64(11), provenances[1].ItemID)
+				return nil
+			})
+
+		err := svc.RecordProvenance(context.Background(), &entity.RecordEvaluationSetItemProvenanceParam{
+			SpaceID: 1, EvaluationSetID: 7, ItemIDs: []int64{10, 11, 10},
+			Template: &entity.EvaluationSetItemProvenance{SourceType: entity.EvaluationSetItemSourceType_TraceExport, TraceID: "trace-1", Author: "u1"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("trace 导出按行覆盖 span", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newProvenanceTestService(ctrl)
+		m.provenanceRepo.EXPECT().BatchUpsert(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, provenances []*entity.EvaluationSetItemProvenance) error {
+				require.Len(t, provenances, 2)
+				assert.Equal(t, "t10", provenances[0].TraceID)
+				assert.Equal(t, "s10", provenances[0].SpanID)
+				assert.Equal(t, "", provenances[1].TraceID)
+				return nil
+			})
+
+		err := svc.RecordProvenance(context.Background(), &entity.RecordEvaluationSetItemProvenanceParam{
+			SpaceID: 1, EvaluationSetID: 7, ItemIDs: []int64{10, 11},
+			Template:   &entity.EvaluationSetItemProvenance{SourceType: entity.EvaluationSetItemSourceType_TraceExport, Author: "u1"},
+			TraceSpans: map[int64]*entity.EvaluationSetItemTraceSpan{10: {TraceID: "t10", SpanID: "s10"}},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("来源类型非法", func(t *testing.T) {
+		svc := NewEvaluationSetItemProvenanceService(nil, nil, nil, nil, nil, nil, nil)
+		for _, sourceType := range []entity.EvaluationSetItemSourceType{"bogus", entity.EvaluationSetItemSourceType_Unknown} {
+			err := svc.RecordProvenance(context.Background(), &entity.RecordEvaluationSetItemProvenanceParam{
+				SpaceID: 1, EvaluationSetID: 7, ItemIDs: []int64{10},
+				Template: &entity.EvaluationSetItemProvenance{SourceType: sourceType},
+			})
+			assert.Error(t, err)
+		}
+		assert.Error(t, svc.RecordProvenance(context.Background(), &entity.RecordEvaluationSetItemProvenanceParam{SpaceID: 1, EvaluationSetID: 7}))
+	})
+}
+
+func TestEvaluationSetItemProvenanceServiceImpl_ListEvaluationSetItems(t *testing.T) {
+	t.Run("无来源筛选时透传并附带来源", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newProvenanceTestService(ctrl)
+		m.itemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).Return(
+			[]*entity.EvaluationSetItem{{ItemID: 10}, {ItemID: 11}}, gptr.Of(int64(2)), gptr.Of(int64(2)), gptr.Of("next"), nil)
+		m.provenanceRepo.EXPECT().MGet(gomock.Any(), int64(1), int64(7), []int64{10, 11}).Return(
+			[]*entity.EvaluationSetItemProvenance{
+				{ItemID: 10, SourceType: entity.EvaluationSetItemSourceType_Manual},
+				{ItemID: 11, SourceType: entity.EvaluationSetItemSourceType_OpenAPI},
+			}, nil)
+
+		items, total, _, next, err := svc.ListEvaluationSetItems(context.Background(), &entity.ListEvaluationSetItemsParam{SpaceID: 1, EvaluationSetID: 7})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), *total)
+		assert.Equal(t, "next", *next)
+		assert.Equal(t, entity.EvaluationSetItemSourceType_Manual, items[0].Provenance.SourceType)
+		assert.Equal(t, entity.EvaluationSetItemSourceType_OpenAPI, items[1].Provenance.SourceType)
+	})
+
+	t.Run("缺失来源的行按导入任务补录", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newProvenanceTestService(ctrl)
+		baseInfo := func(createdAt int64, userID string) *entity.BaseInfo {
+			return &entity.BaseInfo{CreatedAt: gptr.Of(createdAt), CreatedBy: &entity.UserInfo{UserID: gptr.Of(userID)}}
+		}
+		m.itemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).Return(
+			[]*entity.EvaluationSetItem{
+				{ItemID: 10, BaseInfo: baseInfo(1500, "u1")},
+				{ItemID: 11, BaseInfo: baseInfo(1500, "u2")},
+				{ItemID: 12, BaseInfo: baseInfo(900, "u1")},
+				{ItemID: 13},
+			}, gptr.Of(int64(4)), gptr.Of(int64(4)), nil, nil)
+		m.provenanceRepo.EXPECT().MGet(gomock.Any(), int64(1), int64(7), gomock.Any()).Return(
+			[]*entity.EvaluationSetItemProvenance{{ItemID: 13, SourceType: entity.EvaluationSetItemSourceType_OpenAPI}}, nil)
+		m.datasetAdapter.EXPECT().ListDatasetIOJobs(gomock.Any(), int64(1), int64(7), []entity.JobType{entity.JobType_ImportFromFile}).Return(
+			[]*entity.DatasetIOJob{{ID: 5, CreatedBy: gptr.Of("u1"), StartedAt: gptr.Of(int64(1000)), EndedAt: gptr.Of(int64(2000))}}, nil)
+		m.provenanceRepo.EXPECT().BatchCreateIfAbsent(gomock.Any(), gomock.Len(3)).Return(nil)
+
+		items, _, _, _, err := svc.ListEvaluationSetItems(context.Background(), &entity.ListEvaluationSetItemsParam{SpaceID: 1, EvaluationSetID: 7})
+		require.NoError(t, err)
+		assert.Equal(t, entity.EvaluationSetItemSourceType_FileImport, items[0].Provenance.SourceType)
+		assert.Equal(t, int64(5), items[0].Provenance.ImportJobID)
+		assert.Equal(t, "u1", items[0].Provenance.Author)
+		assert.Equal(t, entity.EvaluationSetItemSourceType_Unknown, items[1].Provenance.SourceType)
+		assert.Equal(t, "u2", items[1].Provenance.Author)
+		assert.Equal(t, entity.EvaluationSetItemSourceType_Unknown, items[2].Provenance.SourceType)
+		assert.Equal(t, entity.EvaluationSetItemSourceType_OpenAPI, items[3].Provenance.SourceType)
+	})
+
+	t.Run("补录失败不影响读取", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newProvenanceTestService(ctrl)
+		m.itemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).Return(
+			[]*entity.EvaluationSetItem{{ItemID: 10}}, gptr.Of(int64(1)), gptr.Of(int64(1)), nil, nil)
+		m.provenanceRepo.EXPECT().MGet(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		m.datasetAdapter.EXPECT().ListDatasetIOJobs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc fail"))
+		m.provenanceRepo.EXPECT().BatchCreateIfAbsent(gomock.Any(), gomock.Any()).Return(errors.New("db fail"))
+
+		items, _, _, _, err := svc.ListEvaluationSetItems(context.Background(), &entity.ListEvaluationSetItemsParam{SpaceID: 1, EvaluationSetID: 7})
+		require.NoError(t, err)
+		assert.Equal(t, entity.EvaluationSetItemSourceType_Unknown, items[0].Provenance.SourceType)
+	})
+
+	t.Run("按来源筛选并保持分页顺序", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newProvenanceTestService(ctrl)
+		filter := &entity.EvaluationSetItemProvenanceFilter{SourceTypes: []entity.EvaluationSetItemSourceType{entity.EvaluationSetItemSourceType_FileImport}, ImportJobIDs: []int64{5}}
+		m.provenanceRepo.EXPECT().ListItemIDs(gomock.Any(), int64(1), int64(7), filter, entity.NewPage(2, 2)).Return([]int64{12, 13}, int64(4), nil)
+		m.itemSvc.EXPECT().BatchGetEvaluationSetItems(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.BatchGetEvaluationSetItemsParam) ([]*entity.EvaluationSetItem, error) {
+				assert.Equal(t, []int64{12, 13}, param.ItemIDs)
+				assert.Equal(t, int64(70), gptr.Indirect(param.VersionID))
+				return []*entity.EvaluationSetItem{{ItemID: 13}, {ItemID: 12}}, nil
+			})
+		m.provenanceRepo.EXPECT().MGet(gomock.Any(), int64(1), int64(7), gomock.Any()).Return(
+			[]*entity.EvaluationSetItemProvenance{
+				{ItemID: 12, SourceType: entity.EvaluationSetItemSourceType_FileImport, ImportJobID: 5},
+				{ItemID: 13, SourceType: entity.EvaluationSetItemSourceType_FileImport, ImportJobID: 5},
+			}, nil)
+
+		items, total, filterTotal, _, err := svc.ListEvaluationSetItems(context.Background(), &entity.ListEvaluationSetItemsParam{
+			SpaceID: 1, EvaluationSetID: 7, VersionID: gptr.Of(int64(70)),
+			PageNumber: gptr.Of(int32(2)), PageSize: gptr.Of(int32(2)), ProvenanceFilter: filter,
+		})
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		assert.Equal(t, int64(12), items[0].ItemID)
+		assert.Equal(t, int64(5), items[0].Provenance.ImportJobID)
+		assert.Equal(t, int64(4), *total)
+		assert.Equal(t, int64(4), *filterTotal)
+	})
+
+	t.Run("来源筛选不能与内容筛选组合", func(t *testing.T) {
+		svc := NewEvaluationSetItemProvenanceService(nil, nil, nil, nil, nil, nil, nil)
+		_, _, _, _, err := svc.ListEvaluationSetItems(context.Background(), &entity.ListEvaluationSetItemsParam{
+			SpaceID: 1, EvaluationSetID: 7, Filter: &entity.Filter{},
+			ProvenanceFilter: &entity.EvaluationSetItemProvenanceFilter{Authors: []string{"u1"}},
+		})
+		assert.Error(t, err)
+		_, _, _, _, err = svc.ListEvaluationSetItems(context.Background(), &entity.ListEvaluationSetItemsParam{
+			SpaceID: 1, EvaluationSetID: 7,
+			ProvenanceFilter: &entity.EvaluationSetItemProvenanceFilter{SourceTypes: []entity.EvaluationSetItemSourceType{"bogus"}},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestEvaluationSetItemProvenanceServiceImpl_GetItemLineage(t *testing.T) {
+	t.Run("按实验开始时间汇总得分", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newProvenanceTestService(ctrl)
+		m.provenanceRepo.EXPECT().MGet(gomock.Any(), int64(1), int64(7), []int64{10}).Return(
+			[]*entity.EvaluationSetItemProvenance{{ItemID: 10, SourceType: entity.EvaluationSetItemSourceType_Manual}}, nil)
+		early, late := time.Unix(1000, 0), time.Unix(2000, 0)
+		m.exptRepo.EXPECT().List(gomock.Any(), int32(1), int32(entity.MaxEvaluationSetItemLineageExptCnt), gomock.Any(), gomock.Any(), int64(1)).DoAndReturn(
+			func(_ context.Context, _, _ int32, filter *entity.ExptListFilter, _ []*entity.OrderBy, _ int64) ([]*entity.Experiment, int64, error) {
+				assert.Equal(t, []int64{7}, filter.Includes.EvalSetIDs)
+				return []*entity.Experiment{
+					{ID: 102, Name: "late", EvalSetVersionID: 71, StartAt: &late, Status: entity.ExptStatus_Success},
+					{ID: 101, Name: "early", EvalSetVersionID: 70, StartAt: &early, Status: entity.ExptStatus_Success},
+					{ID: 103, Name: "without item", StartAt: &late},
+				}, 3, nil
+			})
+		finishedAt := time.Unix(3000, 0)
+		m.itemResultRepo.EXPECT().MGetItemResults(gomock.Any(), int64(102), []int64{10}, int64(1)).Return(
+			[]*entity.ExptItemResult{{ItemID: 10, Status: entity.ItemRunState_Success, UpdatedAt: &finishedAt}}, nil)
+		m.itemResultRepo.EXPECT().MGetItemResults(gomock.Any(), int64(101), []int64{10}, int64(1)).Return(
+			[]*entity.ExptItemResult{{ItemID: 10, Status: entity.ItemRunState_Processing, UpdatedAt: &finishedAt}}, nil)
+		m.itemResultRepo.EXPECT().MGetItemResults(gomock.Any(), int64(103), []int64{10}, int64(1)).Return(nil, nil)
+		m.turnResultRepo.EXPECT().GetItemTurnResults(gomock.Any(), int64(102), int64(10), int64(1)).Return(
+			[]*entity.ExptTurnResult{{ID: 1021, TurnID: 1, WeightedScore: gptr.Of(0.8)}}, nil)
+		m.turnResultRepo.EXPECT().GetItemTurnResults(gomock.Any(), int64(101), int64(10), int64(1)).Return(
+			[]*entity.ExptTurnResult{{ID: 1011, TurnID: 1}}, nil)
+		m.turnResultRepo.EXPECT().BatchGetTurnEvaluatorResultRef(gomock.Any(), int64(1), []int64{1021}).Return(
+			[]*entity.ExptTurnEvaluatorResultRef{
+				{ExptTurnResultID: 1021, EvaluatorVersionID: 9, EvaluatorResultID: 501},
+				{ExptTurnResultID: 1021, EvaluatorVersionID: 9, EvaluatorResultID: 502, Alias: "strict"},
+				{ExptTurnResultID: 1021, EvaluatorResultID: 503, SourceType: int32(entity.EvaluatorRecordSourceTypeInline), InlineKey: "k"},
+			}, nil)
+		m.turnResultRepo.EXPECT().BatchGetTurnEvaluatorResultRef(gomock.Any(), int64(1), []int64{1011}).Return(nil, nil)
+		m.recordSvc.EXPECT().BatchGetEvaluatorRecordForAggr(gomock.Any(), []int64{501, 502}).Return([]*entity.EvaluatorRecordAggr{
+			{ID: 501, Score: gptr.Of(1.0), Status: entity.EvaluatorRunStatusSuccess},
+			{ID: 502, Score: gptr.Of(0.0), Status: entity.EvaluatorRunStatusFail},
+		}, nil)
+
+		lineage, err := svc.GetItemLineage(context.Background(), &entity.GetEvaluationSetItemLineageParam{SpaceID: 1, EvaluationSetID: 7, ItemID: 10})
+		require.NoError(t, err)
+		assert.Equal(t, entity.EvaluationSetItemSourceType_Manual, lineage.Provenance.SourceType)
+		assert.False(t, lineage.Truncated)
+		require.Len(t, lineage.Usages, 2)
+		assert.Equal(t, int64(101), lineage.Usages[0].ExptID)
+		assert.Nil(t, lineage.Usages[0].FinishedAt)
+		assert.Nil(t, lineage.Usages[0].TurnScores[0].EvaluatorScores)
+		latest := lineage.Usages[1]
+		assert.Equal(t, int64(102), latest.ExptID)
+		assert.Equal(t, int64(71), latest.EvalSetVersionID)
+		assert.Equal(t, &finishedAt, latest.FinishedAt)
+		require.Len(t, latest.TurnScores, 1)
+		assert.Equal(t, 0.8, *latest.TurnScores[0].WeightedScore)
+		assert.Equal(t, map[string]float64{entity.EncodeEvaluatorInstanceKey(9, ""): 1.0}, latest.TurnScores[0].EvaluatorScores)
+	})
+
+	t.Run("实验列表失败", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newProvenanceTestService(ctrl)
+		m.provenanceRepo.EXPECT().MGet(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+			[]*entity.EvaluationSetItemProvenance{{ItemID: 10, SourceType: entity.EvaluationSetItemSourceType_Manual}}, nil)
+		m.exptRepo.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, int64(0), errors.New("db fail"))
+
+		_, err := svc.GetItemLineage(context.Background(), &entity.GetEvaluationSetItemLineageParam{SpaceID: 1, EvaluationSetID: 7, ItemID: 10})
+		assert.Error(t, err)
+	})
+
+	t.Run("缺失来源时补录", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newProvenanceTestService(ctrl)
+		m.provenanceRepo.EXPECT().MGet(gomock.Any(), int64(1), int64(7), []int64{10}).Return(nil, nil)
+		m.itemSvc.EXPECT().BatchGetEvaluationSetItems(gomock.Any(), gomock.Any()).Return(
+			[]*entity.EvaluationSetItem{{ItemID: 10, BaseInfo: &entity.BaseInfo{CreatedBy: &entity.UserInfo{UserID: gptr.Of("u1")}}}}, nil)
+		m.datasetAdapter.EXPECT().ListDatasetIOJobs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		m.provenanceRepo.EXPECT().BatchCreateIfAbsent(gomock.Any(), gomock.Len(1)).Return(nil)
+		m.exptRepo.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, int64(0), nil)
+
+		lineage, err := svc.GetItemLineage(context.Background(), &entity.GetEvaluationSetItemLineageParam{SpaceID: 1, EvaluationSetID: 7, ItemID: 10})
+		require.NoError(t, err)
+		assert.Equal(t, entity.EvaluationSetItemSourceType_Unknown, lineage.Provenance.SourceType)
+		assert.Equal(t, "u1", lineage.Provenance.Author)
+	})
+
+	t.Run("参数非法", func(t *testing.T) {
+		svc := NewEvaluationSetItemProvenanceService(nil, nil, nil, nil, nil, nil, nil)
+		_, err := svc.GetItemLineage(context.Background(), &entity.GetEvaluationSetItemLineageParam{SpaceID: 1, EvaluationSetID: 7})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,20 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination ./mocks/evaluation_set_synthesis.go --package mocks . EvaluationSetSynthesisService
+type EvaluationSetSynthesisService interface {
+	// GenerateItems 以种子行为示例调用模型按策略生成新行, 经 schema 校验与去重后写入评测集草稿, 并标记为待审核
+	GenerateItems(ctx context.Context, param *entity.GenerateEvaluationSetItemsParam) (*entity.GenerateEvaluationSetItemsResult, error)
+	// ListGeneratedItems 分页列出草稿中由模型生成的行, 附带审核状态
+	ListGeneratedItems(ctx context.Context, param *entity.ListGeneratedEvaluationSetItemsParam) (items []*entity.GeneratedEvaluationSetItem, total *int64, nextPageToken *string, err error)
+	// ReviewGeneratedItems 审核生成的行: 通过的行标记为已通过, 驳回的行从草稿删除
+	ReviewGeneratedItems(ctx context.Context, param *entity.ReviewGeneratedEvaluationSetItemsParam) error
+}
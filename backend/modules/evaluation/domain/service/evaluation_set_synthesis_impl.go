@@ -0,0 +1,431 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/textsim"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+type EvaluationSetSynthesisServiceImpl struct {
+	llmProvider              rpc.ILLMProvider
+	evaluationSetService     IEvaluationSetService
+	evaluationSetItemService EvaluationSetItemService
+	provenanceService        EvaluationSetItemProvenanceService
+}
+
+func NewEvaluationSetSynthesisService(
+	llmProvider rpc.ILLMProvider,
+	evaluationSetService IEvaluationSetService,
+	evaluationSetItemService EvaluationSetItemService,
+	provenanceService EvaluationSetItemProvenanceService,
+) EvaluationSetSynthesisService {
+	return &EvaluationSetSynthesisServiceImpl{
+		llmProvider:              llmProvider,
+		evaluationSetService:     evaluationSetService,
+		evaluationSetItemService: evaluationSetItemService,
+		provenanceService:        provenanceService,
+	}
+}
+
+func (e *EvaluationSetSynthesisServiceImpl) GenerateItems(ctx context.Context, param *entity.GenerateEvaluationSetItemsParam) (*entity.GenerateEvaluationSetItemsResult, error) {
+	if param == nil || param.SpaceID <= 0 || param.EvaluationSetID <= 0 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid synthesis param"))
+	}
+	if err := param.Conf.Validate(); err != nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
+	}
+
+	set, err := e.evaluationSetService.GetEvaluationSet(ctx, gptr.Of(param.SpaceID), param.EvaluationSetID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if set == nil || set.EvaluationSetVersion == nil || set.EvaluationSetVersion.EvaluationSetSchema == nil {
+		return nil, errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg("evaluation set not found"))
+	}
+	fields, err := synthesisFields(set.EvaluationSetVersion.EvaluationSetSchema.FieldSchemas, param.Conf.FieldNames)
+	if err != nil {
+		return nil, err
+	}
+	seeds, err := e.loadSeeds(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	seedRows := make([]map[string]string, 0, len(seeds))
+	seen := make(map[string]bool, len(seeds)+param.Conf.Count)
+	for _, seed := range seeds {
+		row := synthesisSeedRow(seed, fields)
+		if len(row) == 0 {
+			continue
+		}
+		seedRows = append(seedRows, row)
+		seen[synthesisRowKey(row, fields)] = true
+	}
+	if len(seedRows) == 0 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("seed items have no text content in generated fields"))
+	}
+	examples, err := renderSynthesisExamples(seedRows)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &entity.GenerateEvaluationSetItemsResult{}
+	items := make([]*entity.EvaluationSetItem, 0, param.Conf.Count)
+	strategies := param.Conf.GetStrategies()
+	batchSize := param.Conf.GetBatchSize()
+	batchCnt := 0
+	for remaining := param.Conf.Count; remaining > 0; remaining -= batchSize {
+		n := min(batchSize, remaining)
+		strategy := strategies[batchCnt%len(strategies)]
+		batchCnt++
+
+		rows, err := e.generateBatch(ctx, param, fields, strategy, n, examples)
+		if err != nil {
+			logs.CtxWarn(ctx, "evaluation set synthesis batch fail, evaluation_set_id=%d, strategy=%s, err=%v", param.EvaluationSetID, strategy, err)
+			res.FailedBatchCnt++
+			continue
+		}
+		if len(rows) > n {
+			rows = rows[:n]
+		}
+		res.GeneratedCnt += len(rows)
+		for _, row := range rows {
+			key := synthesisRowKey(row, fields)
+			if len(key) == 0 || seen[key] {
+				res.DuplicateCnt++
+				continue
+			}
+			seen[key] = true
+			items = append(items, synthesisItem(row, fields))
+		}
+	}
+	if res.FailedBatchCnt == batchCnt {
+		return nil, errorx.NewByCode(errno.CommonInternalErrorCode, errorx.WithExtraMsg("all synthesis batches failed"))
+	}
+	if len(items) == 0 {
+		return res, nil
+	}
+
+	validIndices, errGroups, err := e.evaluationSetItemService.ValidateEvaluationSetItems(ctx, &entity.ValidateEvaluationSetItemsParam{
+		SpaceID:         param.SpaceID,
+		EvaluationSetID: param.EvaluationSetID,
+		Items:           items,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Errors = append(res.Errors, errGroups...)
+	validItems := make([]*entity.EvaluationSetItem, 0, len(validIndices))
+	for _, idx := range validIndices {
+		if idx >= 0 && int(idx) < len(items) {
+			validItems = append(validItems, items[idx])
+		}
+	}
+	res.InvalidCnt = len(items) - len(validItems)
+
+	for start := 0; start < len(validItems); start += entity.EvaluationSetSynthesisWriteBatchSize {
+		batch := validItems[start:min(start+entity.EvaluationSetSynthesisWriteBatchSize, len(validItems))]
+		idMap, errGroups, _, err := e.evaluationSetItemService.BatchCreateEvaluationSetItems(ctx, &entity.BatchCreateEvaluationSetItemsParam{
+			SpaceID:          param.SpaceID,
+			EvaluationSetID:  param.EvaluationSetID,
+			Items:            batch,
+			SkipInvalidItems: gptr.Of(true),
+			AllowPartialAdd:  gptr.Of(true),
+		})
+		if err != nil {
+			return res, err
+		}
+		res.Errors = append(res.Errors, errGroups...)
+		itemIDs := make([]int64, 0, len(batch))
+		for i := range batch {
+			if itemID, ok := idMap[int64(i)]; ok {
+				itemIDs = append(itemIDs, itemID)
+			}
+		}
+		if err := e.markPendingReview(ctx, param, itemIDs); err != nil {
+			return res, err
+		}
+		res.ItemIDs = append(res.ItemIDs, itemIDs...)
+	}
+	return res, nil
+}
+
+func (e *EvaluationSetSynthesisServiceImpl) ListGeneratedItems(ctx context.Context, param *entity.ListGeneratedEvaluationSetItemsParam) ([]*entity.GeneratedEvaluationSetItem, *int64, *string, error) {
+	if param == nil || param.SpaceID <= 0 || param.EvaluationSetID <= 0 {
+		return nil, nil, nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid list generated items param"))
+	}
+	items, total, _, nextPageToken, err := e.provenanceService.ListEvaluationSetItems(ctx, &entity.ListEvaluationSetItemsParam{
+		SpaceID:          param.SpaceID,
+		EvaluationSetID:  param.EvaluationSetID,
+		PageNumber:       param.PageNumber,
+		PageSize:         param.PageSize,
+		PageToken:        param.PageToken,
+		OrderBys:         []*entity.OrderBy{{Field: gptr.Of("item_id"), IsAsc: gptr.Of(true)}},
+		ProvenanceFilter: &entity.EvaluationSetItemProvenanceFilter{SourceTypes: []entity.EvaluationSetItemSourceType{entity.EvaluationSetItemSourceType_Synthetic}},
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	res := make([]*entity.GeneratedEvaluationSetItem, 0, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		generated := &entity.GeneratedEvaluationSetItem{Item: item}
+		if item.Provenance != nil {
+			generated.ReviewStatus = item.Provenance.ReviewStatus
+		}
+		res = append(res, generated)
+	}
+	return res, total, nextPageToken, nil
+}
+
+func (e *EvaluationSetSynthesisServiceImpl) ReviewGeneratedItems(ctx context.Context, param *entity.ReviewGeneratedEvaluationSetItemsParam) error {
+	if param == nil || param.SpaceID <= 0 || param.EvaluationSetID <= 0 || len(param.ApprovedItemIDs)+len(param.RejectedItemIDs) == 0 {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid review param"))
+	}
+	rejected := make(map[int64]bool, len(param.RejectedItemIDs))
+	for _, itemID := range param.RejectedItemIDs {
+		rejected[itemID] = true
+	}
+	for _, itemID := range param.ApprovedItemIDs {
+		if rejected[itemID] {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("item %d is both approved and rejected", itemID)))
+		}
+	}
+
+	if len(param.ApprovedItemIDs) > 0 {
+		if err := e.provenanceService.UpdateReviewStatus(ctx, param.SpaceID, param.EvaluationSetID, param.ApprovedItemIDs, entity.EvaluationSetItemReviewStatus_Approved); err != nil {
+			return err
+		}
+	}
+	for start := 0; start < len(param.RejectedItemIDs); start += entity.EvaluationSetSynthesisWriteBatchSize {
+		batch := param.RejectedItemIDs[start:min(start+entity.EvaluationSetSynthesisWriteBatchSize, len(param.RejectedItemIDs))]
+		if err := e.evaluationSetItemService.BatchDeleteEvaluationSetItems(ctx, param.SpaceID, param.EvaluationSetID, batch); err != nil {
+			return err
+		}
+		if err := e.provenanceService.RemoveProvenance(ctx, param.SpaceID, param.EvaluationSetID, batch); err != nil {
+			logs.CtxWarn(ctx, "remove rejected item provenance fail, evaluation_set_id=%d, err=%v", param.EvaluationSetID, err)
+		}
+	}
+	return nil
+}
+
+// markPendingReview 以生成来源登记新写入的行并标记待审核; 标记失败时删除这批行, 避免未标记的生成数据进入版本与实验
+func (e *EvaluationSetSynthesisServiceImpl) markPendingReview(ctx context.Context, param *entity.GenerateEvaluationSetItemsParam, itemIDs []int64) error {
+	if len(itemIDs) == 0 {
+		return nil
+	}
+	err := e.provenanceService.RecordProvenance(ctx, &entity.RecordEvaluationSetItemProvenanceParam{
+		SpaceID:         param.SpaceID,
+		EvaluationSetID: param.EvaluationSetID,
+		ItemIDs:         itemIDs,
+		Template: &entity.EvaluationSetItemProvenance{
+			SourceType:   entity.EvaluationSetItemSourceType_Synthetic,
+			ReviewStatus: entity.EvaluationSetItemReviewStatus_PendingReview,
+		},
+	})
+	if err == nil {
+		return nil
+	}
+	if delErr := e.evaluationSetItemService.BatchDeleteEvaluationSetItems(ctx, param.SpaceID, param.EvaluationSetID, itemIDs); delErr != nil {
+		logs.CtxError(ctx, "delete unmarked generated items fail, evaluation_set_id=%d, item_ids=%v, err=%v", param.EvaluationSetID, itemIDs, delErr)
+	}
+	return err
+}
+
+// loadSeeds 加载种子行, 至多 MaxEvaluationSetSynthesisSeedCnt 行
+func (e *EvaluationSetSynthesisServiceImpl) loadSeeds(ctx context.Context, param *entity.GenerateEvaluationSetItemsParam) ([]*entity.EvaluationSetItem, error) {
+	var (
+		seeds []*entity.EvaluationSetItem
+		err   error
+	)
+	if len(param.SeedItemIDs) > 0 {
+		itemIDs := param.SeedItemIDs
+		if len(itemIDs) > entity.MaxEvaluationSetSynthesisSeedCnt {
+			itemIDs = itemIDs[:entity.MaxEvaluationSetSynthesisSeedCnt]
+		}
+		seeds, err = e.evaluationSetItemService.BatchGetEvaluationSetItems(ctx, &entity.BatchGetEvaluationSetItemsParam{
+			SpaceID:         param.SpaceID,
+			EvaluationSetID: param.EvaluationSetID,
+			ItemIDs:         itemIDs,
+		})
+	} else {
+		seeds, _, _, _, err = e.evaluationSetItemService.ListEvaluationSetItems(ctx, &entity.ListEvaluationSetItemsParam{
+			SpaceID:         param.SpaceID,
+			EvaluationSetID: param.EvaluationSetID,
+			PageSize:        gptr.Of(int32(entity.MaxEvaluationSetSynthesisSeedCnt)),
+			OrderBys:        []*entity.OrderBy{{Field: gptr.Of("item_id"), IsAsc: gptr.Of(true)}},
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(seeds) == 0 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("no seed items"))
+	}
+	return seeds, nil
+}
+
+func (e *EvaluationSetSynthesisServiceImpl) generateBatch(ctx context.Context, param *entity.GenerateEvaluationSetItemsParam, fields []*entity.FieldSchema,
+	strategy entity.EvaluationSetSynthesisStrategy, n int, examples string,
+) ([]map[string]string, error) {
+	reply, err := e.llmProvider.Call(ctx, &entity.LLMCallParam{
+		SpaceID:  param.SpaceID,
+		UserID:   gptr.Of(session.UserIDInCtxOrEmpty(ctx)),
+		Scenario: entity.ScenarioDefault,
+		Messages: []*entity.Message{
+			entity.NewTextMessage(entity.RoleSystem, param.Conf.RenderSystemPrompt(fields, strategy, n)),
+			entity.NewTextMessage(entity.RoleUser, "Examples:\n"+examples),
+		},
+		ModelConfig: param.Conf.ModelConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if reply == nil {
+		return nil, fmt.Errorf("empty llm reply")
+	}
+	return parseSynthesisRows(gptr.Indirect(reply.Content), fields)
+}
+
+// synthesisFields 选出需要生成的字段: 未删除的文本字段, 指定 fieldNames 时按名称筛选
+func synthesisFields(schemas []*entity.FieldSchema, fieldNames []string) ([]*entity.FieldSchema, error) {
+	textFields := make(map[string]*entity.FieldSchema, len(schemas))
+	fields := make([]*entity.FieldSchema, 0, len(schemas))
+	for _, f := range schemas {
+		if f == nil || f.Status == entity.FieldStatus_Deleted {
+			continue
+		}
+		if len(f.ContentType) > 0 && f.ContentType != entity.ContentTypeText {
+			continue
+		}
+		textFields[f.Name] = f
+		fields = append(fields, f)
+	}
+	if len(fieldNames) > 0 {
+		fields = fields[:0]
+		for _, name := range fieldNames {
+			f, ok := textFields[name]
+			if !ok {
+				return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("field %s is not a text field of the evaluation set", name)))
+			}
+			fields = append(fields, f)
+		}
+	}
+	if len(fields) == 0 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("evaluation set has no text field to generate"))
+	}
+	return fields, nil
+}
+
+// synthesisSeedRow 取种子行首个 turn 中待生成字段的文本
+func synthesisSeedRow(item *entity.EvaluationSetItem, fields []*entity.FieldSchema) map[string]string {
+	if item == nil || len(item.Turns) == 0 || item.Turns[0] == nil {
+		return nil
+	}
+	row := make(map[string]string, len(fields))
+	for _, fd := range item.Turns[0].FieldDataList {
+		if fd == nil || fd.Content == nil {
+			continue
+		}
+		for _, f := range fields {
+			if (len(fd.Key) > 0 && fd.Key == f.Key) || fd.Name == f.Name {
+				if text := fd.Content.GetText(); len(text) > 0 {
+					row[f.Name] = text
+				}
+				break
+			}
+		}
+	}
+	return row
+}
+
+func renderSynthesisExamples(rows []map[string]string) (string, error) {
+	var sb strings.Builder
+	for _, row := range rows {
+		b, err := json.Marshal(row)
+		if err != nil {
+			return "", errorx.Wrapf(err, "marshal synthesis example fail")
+		}
+		sb.Write(b)
+		sb.WriteString("\n")
+	}
+	return sb.String(), nil
+}
+
+// synthesisRowKey 规范化各字段文本后拼接, 用于丢弃与种子或已生成行相同的行; 全部字段为空时返回空串
+func synthesisRowKey(row map[string]string, fields []*entity.FieldSchema) string {
+	var sb strings.Builder
+	empty := true
+	for _, f := range fields {
+		text := textsim.NormalizeText(row[f.Name])
+		if len(text) > 0 {
+			empty = false
+		}
+		sb.WriteString(text)
+		sb.WriteString("\x00")
+	}
+	if empty {
+		return ""
+	}
+	return sb.String()
+}
+
+func synthesisItem(row map[string]string, fields []*entity.FieldSchema) *entity.EvaluationSetItem {
+	turn := &entity.Turn{FieldDataList: make([]*entity.FieldData, 0, len(fields))}
+	for _, f := range fields {
+		text, ok := row[f.Name]
+		if !ok {
+			continue
+		}
+		turn.FieldDataList = append(turn.FieldDataList, &entity.FieldData{Key: f.Key, Name: f.Name, Content: entity.NewTextContent(text)})
+	}
+	return &entity.EvaluationSetItem{Turns: []*entity.Turn{turn}}
+}
+
+// parseSynthesisRows 从模型回复中解析 JSON 数组, 容忍代码块包裹与前后说明文字; 非字符串的值按 JSON 文本写入
+func parseSynthesisRows(content string, fields []*entity.FieldSchema) ([]map[string]string, error) {
+	start, end := strings.Index(content, "["), strings.LastIndex(content, "]")
+	if start < 0 || end <= start {
+		return nil, fmt.Errorf("no json array in llm reply")
+	}
+	var raw []map[string]any
+	if err := json.Unmarshal([]byte(content[start:end+1]), &raw); err != nil {
+		return nil, fmt.Errorf("parse llm reply fail: %w", err)
+	}
+	rows := make([]map[string]string, 0, len(raw))
+	for _, obj := range raw {
+		row := make(map[string]string, len(fields))
+		for _, f := range fields {
+			v, ok := obj[f.Name]
+			if !ok || v == nil {
+				continue
+			}
+			if s, ok := v.(string); ok {
+				row[f.Name] = s
+				continue
+			}
+			b, err := json.Marshal(v)
+			if err != nil {
+				continue
+			}
+			row[f.Name] = string(b)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
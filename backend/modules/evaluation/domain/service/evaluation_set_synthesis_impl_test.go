@@ -0,0 +1,276 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	rpcmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	servicemocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+type synthesisTestMocks struct {
+	llm     *rpcmocks.MockILLMProvider
+	setSvc  *servicemocks.MockIEvaluationSetService
+	itemSvc *servicemocks.MockEvaluationSetItemService
+	provSvc *servicemocks.MockEvaluationSetItemProvenanceService
+}
+
+func newSynthesisTestService(ctrl *gomock.Controller) (EvaluationSetSynthesisService, *synthesisTestMocks) {
+	m := &synthesisTestMocks{
+		llm:     rpcmocks.NewMockILLMProvider(ctrl),
+		setSvc:  servicemocks.NewMockIEvaluationSetService(ctrl),
+		itemSvc: servicemocks.NewMockEvaluationSetItemService(ctrl),
+		provSvc: servicemocks.NewMockEvaluationSetItemProvenanceService(ctrl),
+	}
+	m.setSvc.EXPECT().GetEvaluationSet(gomock.Any(), gomock.Any(), int64(7), gomock.Any(), gomock.Any()).Return(&entity.EvaluationSet{
+		ID: 7,
+		EvaluationSetVersion: &entity.EvaluationSetVersion{EvaluationSetSchema: &entity.EvaluationSetSchema{FieldSchemas: []*entity.FieldSchema{
+			{Key: "k_input", Name: "input", ContentType: entity.ContentTypeText},
+			{Key: "k_ref", Name: "reference", ContentType: entity.ContentTypeText, Description: "expected answer"},
+			{Key: "k_img", Name: "image", ContentType: entity.ContentTypeImage},
+		}}},
+	}, nil).AnyTimes()
+	return NewEvaluationSetSynthesisService(m.llm, m.setSvc, m.itemSvc, m.provSvc), m
+}
+
+func newSynthesisSeed(itemID int64, input, reference string) *entity.EvaluationSetItem {
+	return &entity.EvaluationSetItem{ItemID: itemID, Turns: []*entity.Turn{{FieldDataList: []*entity.FieldData{
+		{Key: "k_input", Name: "input", Content: entity.NewTextContent(input)},
+		{Key: "k_ref", Name: "reference", Content: entity.NewTextContent(reference)},
+	}}}}
+}
+
+func TestEvaluationSetSynthesisServiceImpl_GenerateItems(t *testing.T) {
+	conf := &entity.EvaluationSetSynthesisConf{
+		ModelConfig: &entity.ModelConfig{},
+		Count:       4,
+		BatchSize:   2,
+		Strategies:  []entity.EvaluationSetSynthesisStrategy{entity.EvaluationSetSynthesisStrategy_Paraphrase, entity.EvaluationSetSynthesisStrategy_EdgeCase},
+	}
+
+	t.Run("生成、去重、校验并写入草稿", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newSynthesisTestService(ctrl)
+		m.itemSvc.EXPECT().BatchGetEvaluationSetItems(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.BatchGetEvaluationSetItemsParam) ([]*entity.EvaluationSetItem, error) {
+				assert.Equal(t, []int64{1}, param.ItemIDs)
+				return []*entity.EvaluationSetItem{newSynthesisSeed(1, "What is 2+2?", "4")}, nil
+			})
+		replies := []string{
+			// 代码块包裹, 首行与种子相同
+			"```json\n[{\"input\": \"what is 2 + 2\", \"reference\": \"4\"}, {\"input\": \"How much is two plus two?\", \"reference\": 4}]\n```",
+			"Here you go: [{\"input\": \"\", \"reference\": \"\"}, {\"input\": \"What is 2+2 in base 3?\", \"reference\": \"11\"}, {\"input\": \"extra\", \"reference\": \"x\"}]",
+		}
+		call := 0
+		m.llm.EXPECT().Call(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.LLMCallParam) (*entity.ReplyItem, error) {
+				require.Len(t, param.Messages, 2)
+				sys := param.Messages[0].Content.GetText()
+				assert.Contains(t, sys, "- reference: expected answer")
+				assert.NotContains(t, sys, "image")
+				if call == 0 {
+					assert.Contains(t, sys, "Paraphrase")
+				} else {
+					assert.Contains(t, sys, "edge cases")
+				}
+				assert.Contains(t, param.Messages[1].Content.GetText(), `{"input":"What is 2+2?","reference":"4"}`)
+				reply := replies[call]
+				call++
+				return &entity.ReplyItem{Content: gptr.Of(reply)}, nil
+			}).Times(2)
+		m.itemSvc.EXPECT().ValidateEvaluationSetItems(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.ValidateEvaluationSetItemsParam) ([]int32, []*entity.ItemErrorGroup, error) {
+				require.Len(t, param.Items, 2)
+				assert.Equal(t, "4", param.Items[0].Turns[0].FieldDataList[1].Content.GetText())
+				assert.Equal(t, "k_ref", param.Items[0].Turns[0].FieldDataList[1].Key)
+				return []int32{0, 1}, nil, nil
+			})
+		m.itemSvc.EXPECT().BatchCreateEvaluationSetItems(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.BatchCreateEvaluationSetItemsParam) (map[int64]int64, []*entity.ItemErrorGroup, []*entity.DatasetItemOutput, error) {
+				assert.Len(t, param.Items, 2)
+				return map[int64]int64{0: 100, 1: 101}, nil, nil, nil
+			})
+		m.provSvc.EXPECT().RecordProvenance(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.RecordEvaluationSetItemProvenanceParam) error {
+				assert.Equal(t, []int64{100, 101}, param.ItemIDs)
+				assert.Equal(t, entity.EvaluationSetItemSourceType_Synthetic, param.Template.SourceType)
+				assert.Equal(t, entity.EvaluationSetItemReviewStatus_PendingReview, param.Template.ReviewStatus)
+				return nil
+			})
+
+		res, err := svc.GenerateItems(context.Background(), &entity.GenerateEvaluationSetItemsParam{
+			SpaceID: 1, EvaluationSetID: 7, SeedItemIDs: []int64{1}, Conf: conf,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{100, 101}, res.ItemIDs)
+		assert.Equal(t, 4, res.GeneratedCnt)
+		assert.Equal(t, 2, res.DuplicateCnt)
+		assert.Equal(t, 0, res.InvalidCnt)
+		assert.Equal(t, 0, res.FailedBatchCnt)
+	})
+
+	t.Run("部分批次失败且校验丢弃无效行", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newSynthesisTestService(ctrl)
+		m.itemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).Return(
+			[]*entity.EvaluationSetItem{newSynthesisSeed(1, "hello", "hi")}, nil, nil, nil, nil)
+		m.llm.EXPECT().Call(gomock.Any(), gomock.Any()).Return(nil, errors.New("llm fail"))
+		m.llm.EXPECT().Call(gomock.Any(), gomock.Any()).Return(&entity.ReplyItem{Content: gptr.Of(`[{"input":"good morning","reference":"morning"},{"input":"bye"}]`)}, nil)
+		m.itemSvc.EXPECT().ValidateEvaluationSetItems(gomock.Any(), gomock.Any()).Return(
+			[]int32{0}, []*entity.ItemErrorGroup{{Type: gptr.Of(entity.ItemErrorType_MissingRequiredField)}}, nil)
+		m.itemSvc.EXPECT().BatchCreateEvaluationSetItems(gomock.Any(), gomock.Any()).Return(map[int64]int64{0: 200}, nil, nil, nil)
+		m.provSvc.EXPECT().RecordProvenance(gomock.Any(), gomock.Any()).Return(nil)
+
+		res, err := svc.GenerateItems(context.Background(), &entity.GenerateEvaluationSetItemsParam{SpaceID: 1, EvaluationSetID: 7, Conf: conf})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{200}, res.ItemIDs)
+		assert.Equal(t, 1, res.FailedBatchCnt)
+		assert.Equal(t, 1, res.InvalidCnt)
+		assert.Len(t, res.Errors, 1)
+	})
+
+	t.Run("标记待审核失败时删除本批行并返回错误", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newSynthesisTestService(ctrl)
+		m.itemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).Return(
+			[]*entity.EvaluationSetItem{newSynthesisSeed(1, "hello", "hi")}, nil, nil, nil, nil)
+		m.llm.EXPECT().Call(gomock.Any(), gomock.Any()).Return(&entity.ReplyItem{Content: gptr.Of(`[{"input":"good morning","reference":"morning"}]`)}, nil).Times(2)
+		m.itemSvc.EXPECT().ValidateEvaluationSetItems(gomock.Any(), gomock.Any()).Return([]int32{0}, nil, nil)
+		m.itemSvc.EXPECT().BatchCreateEvaluationSetItems(gomock.Any(), gomock.Any()).Return(map[int64]int64{0: 200}, nil, nil, nil)
+		m.provSvc.EXPECT().RecordProvenance(gomock.Any(), gomock.Any()).Return(errors.New("db fail"))
+		m.itemSvc.EXPECT().BatchDeleteEvaluationSetItems(gomock.Any(), int64(1), int64(7), []int64{200}).Return(nil)
+
+		res, err := svc.GenerateItems(context.Background(), &entity.GenerateEvaluationSetItemsParam{SpaceID: 1, EvaluationSetID: 7, Conf: conf})
+		assert.Error(t, err)
+		assert.Empty(t, res.ItemIDs)
+	})
+
+	t.Run("全部批次失败", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newSynthesisTestService(ctrl)
+		m.itemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).Return(
+			[]*entity.EvaluationSetItem{newSynthesisSeed(1, "hello", "hi")}, nil, nil, nil, nil)
+		m.llm.EXPECT().Call(gomock.Any(), gomock.Any()).Return(&entity.ReplyItem{Content: gptr.Of("sorry")}, nil).Times(2)
+
+		_, err := svc.GenerateItems(context.Background(), &entity.GenerateEvaluationSetItemsParam{SpaceID: 1, EvaluationSetID: 7, Conf: conf})
+		assert.Error(t, err)
+	})
+
+	t.Run("字段不是文本字段", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _ := newSynthesisTestService(ctrl)
+		_, err := svc.GenerateItems(context.Background(), &entity.GenerateEvaluationSetItemsParam{
+			SpaceID: 1, EvaluationSetID: 7,
+			Conf: &entity.EvaluationSetSynthesisConf{ModelConfig: &entity.ModelConfig{}, Count: 1, FieldNames: []string{"image"}},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("参数非法", func(t *testing.T) {
+		svc := NewEvaluationSetSynthesisService(nil, nil, nil, nil)
+		_, err := svc.GenerateItems(context.Background(), &entity.GenerateEvaluationSetItemsParam{SpaceID: 1, EvaluationSetID: 7})
+		assert.Error(t, err)
+		_, err = svc.GenerateItems(context.Background(), &entity.GenerateEvaluationSetItemsParam{
+			SpaceID: 1, EvaluationSetID: 7, Conf: &entity.EvaluationSetSynthesisConf{ModelConfig: &entity.ModelConfig{}, Count: entity.MaxEvaluationSetSynthesisItemCnt + 1},
+		})
+		assert.Error(t, err)
+		_, err = svc.GenerateItems(context.Background(), &entity.GenerateEvaluationSetItemsParam{
+			SpaceID: 1, EvaluationSetID: 7, Conf: &entity.EvaluationSetSynthesisConf{ModelConfig: &entity.ModelConfig{}, Count: 1, Strategies: []entity.EvaluationSetSynthesisStrategy{"unknown"}},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestEvaluationSetSynthesisServiceImpl_ListGeneratedItems(t *testing.T) {
+	t.Run("按来源筛选并附带审核状态", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		provSvc := servicemocks.NewMockEvaluationSetItemProvenanceService(ctrl)
+		provSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.ListEvaluationSetItemsParam) ([]*entity.EvaluationSetItem, *int64, *int64, *string, error) {
+				assert.Equal(t, []entity.EvaluationSetItemSourceType{entity.EvaluationSetItemSourceType_Synthetic}, param.ProvenanceFilter.SourceTypes)
+				assert.Equal(t, int32(20), gptr.Indirect(param.PageSize))
+				return []*entity.EvaluationSetItem{
+					{ItemID: 100, Provenance: &entity.EvaluationSetItemProvenance{ReviewStatus: entity.EvaluationSetItemReviewStatus_PendingReview}},
+					{ItemID: 101, Provenance: &entity.EvaluationSetItemProvenance{ReviewStatus: entity.EvaluationSetItemReviewStatus_Approved}},
+				}, gptr.Of(int64(2)), gptr.Of(int64(2)), gptr.Of("next"), nil
+			})
+
+		items, total, next, err := NewEvaluationSetSynthesisService(nil, nil, nil, provSvc).ListGeneratedItems(context.Background(), &entity.ListGeneratedEvaluationSetItemsParam{
+			SpaceID: 1, EvaluationSetID: 7, PageSize: gptr.Of(int32(20)),
+		})
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		assert.Equal(t, entity.EvaluationSetItemReviewStatus_PendingReview, items[0].ReviewStatus)
+		assert.Equal(t, entity.EvaluationSetItemReviewStatus_Approved, items[1].ReviewStatus)
+		assert.Equal(t, int64(2), gptr.Indirect(total))
+		assert.Equal(t, "next", gptr.Indirect(next))
+	})
+
+	t.Run("查询失败", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		provSvc := servicemocks.NewMockEvaluationSetItemProvenanceService(ctrl)
+		provSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).Return(nil, nil, nil, nil, errors.New("rpc fail"))
+
+		_, _, _, err := NewEvaluationSetSynthesisService(nil, nil, nil, provSvc).ListGeneratedItems(context.Background(), &entity.ListGeneratedEvaluationSetItemsParam{SpaceID: 1, EvaluationSetID: 7})
+		assert.Error(t, err)
+	})
+
+	t.Run("参数非法", func(t *testing.T) {
+		_, _, _, err := NewEvaluationSetSynthesisService(nil, nil, nil, nil).ListGeneratedItems(context.Background(), &entity.ListGeneratedEvaluationSetItemsParam{SpaceID: 1})
+		assert.Error(t, err)
+	})
+}
+
+func TestEvaluationSetSynthesisServiceImpl_ReviewGeneratedItems(t *testing.T) {
+	t.Run("通过与驳回", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		itemSvc := servicemocks.NewMockEvaluationSetItemService(ctrl)
+		itemSvc.EXPECT().BatchDeleteEvaluationSetItems(gomock.Any(), int64(1), int64(7), []int64{101, 102}).Return(nil)
+		provSvc := servicemocks.NewMockEvaluationSetItemProvenanceService(ctrl)
+		provSvc.EXPECT().UpdateReviewStatus(gomock.Any(), int64(1), int64(7), []int64{100}, entity.EvaluationSetItemReviewStatus_Approved).Return(nil)
+		provSvc.EXPECT().RemoveProvenance(gomock.Any(), int64(1), int64(7), []int64{101, 102}).Return(nil)
+
+		err := NewEvaluationSetSynthesisService(nil, nil, itemSvc, provSvc).ReviewGeneratedItems(context.Background(), &entity.ReviewGeneratedEvaluationSetItemsParam{
+			SpaceID: 1, EvaluationSetID: 7, ApprovedItemIDs: []int64{100}, RejectedItemIDs: []int64{101, 102},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("同一行既通过又驳回", func(t *testing.T) {
+		err := NewEvaluationSetSynthesisService(nil, nil, nil, nil).ReviewGeneratedItems(context.Background(), &entity.ReviewGeneratedEvaluationSetItemsParam{
+			SpaceID: 1, EvaluationSetID: 7, ApprovedItemIDs: []int64{100}, RejectedItemIDs: []int64{100},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("未选择任何行", func(t *testing.T) {
+		err := NewEvaluationSetSynthesisService(nil, nil, nil, nil).ReviewGeneratedItems(context.Background(), &entity.ReviewGeneratedEvaluationSetItemsParam{SpaceID: 1, EvaluationSetID: 7})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,18 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination ./mocks/evaluation_set_version_diff.go --package mocks . EvaluationSetVersionDiffService
+type EvaluationSetVersionDiffService interface {
+	// DiffVersions 对比同一评测集的两个版本 (或版本与草稿), 返回 schema 字段变更与行级新增、删除、修改 (含字段级内容差异)
+	DiffVersions(ctx context.Context, param *entity.DiffEvaluationSetVersionsParam) (*entity.EvaluationSetVersionDiff, error)
+	// MergeVersion 把来源版本相对草稿的选中变更合入草稿
+	MergeVersion(ctx context.Context, param *entity.MergeEvaluationSetVersionParam) (*entity.MergeEvaluationSetVersionResult, error)
+}
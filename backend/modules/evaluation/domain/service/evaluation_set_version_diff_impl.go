@@ -0,0 +1,474 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const versionDiffItemPageSize = int32(100)
+
+type EvaluationSetVersionDiffServiceImpl struct {
+	evaluationSetService        IEvaluationSetService
+	evaluationSetVersionService EvaluationSetVersionService
+	evaluationSetItemService    EvaluationSetItemService
+	evaluationSetSchemaService  EvaluationSetSchemaService
+	provenanceService           EvaluationSetItemProvenanceService
+}
+
+func NewEvaluationSetVersionDiffService(
+	evaluationSetService IEvaluationSetService,
+	evaluationSetVersionService EvaluationSetVersionService,
+	evaluationSetItemService EvaluationSetItemService,
+	evaluationSetSchemaService EvaluationSetSchemaService,
+	provenanceService EvaluationSetItemProvenanceService,
+) EvaluationSetVersionDiffService {
+	return &EvaluationSetVersionDiffServiceImpl{
+		evaluationSetService:        evaluationSetService,
+		evaluationSetVersionService: evaluationSetVersionService,
+		evaluationSetItemService:    evaluationSetItemService,
+		evaluationSetSchemaService:  evaluationSetSchemaService,
+		provenanceService:           provenanceService,
+	}
+}
+
+// versionSide 对比的一侧: 版本快照或草稿
+type versionSide struct {
+	versionID *int64
+	schema    *entity.EvaluationSetSchema
+	items     []*entity.EvaluationSetItem
+	truncated bool
+}
+
+func (e *EvaluationSetVersionDiffServiceImpl) DiffVersions(ctx context.Context, param *entity.DiffEvaluationSetVersionsParam) (*entity.EvaluationSetVersionDiff, error) {
+	if param == nil || param.SpaceID <= 0 || param.EvaluationSetID <= 0 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid diff param"))
+	}
+	if gptr.Indirect(param.BaseVersionID) == gptr.Indirect(param.TargetVersionID) {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("base and target version must differ"))
+	}
+	base, err := e.loadVersionSide(ctx, param.SpaceID, param.EvaluationSetID, param.BaseVersionID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := e.loadVersionSide(ctx, param.SpaceID, param.EvaluationSetID, param.TargetVersionID)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := diffVersionSides(base, target)
+	diff.EvaluationSetID = param.EvaluationSetID
+	logs.CtxInfo(ctx, "[EvaluationSetVersionDiff] diff done, eval_set_id: %v, base: %v, target: %v, schema_diffs: %v, added: %v, removed: %v, modified: %v, truncated: %v",
+		param.EvaluationSetID, gptr.Indirect(param.BaseVersionID), gptr.Indirect(param.TargetVersionID), len(diff.SchemaDiffs), diff.AddedItemCnt, diff.RemovedItemCnt, diff.ModifiedItemCnt, diff.Truncated)
+	return diff, nil
+}
+
+// loadVersionSide 加载一侧的 schema 与全部行; 版本行读取数据集的 item 快照, 草稿行读取当前数据
+func (e *EvaluationSetVersionDiffServiceImpl) loadVersionSide(ctx context.Context, spaceID, evaluationSetID int64, versionID *int64) (*versionSide, error) {
+	side := &versionSide{versionID: versionID}
+	if versionID == nil {
+		set, err := e.evaluationSetService.GetEvaluationSet(ctx, gptr.Of(spaceID), evaluationSetID, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		if set == nil {
+			return nil, errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("evaluation set %d not found", evaluationSetID)))
+		}
+		if set.EvaluationSetVersion != nil {
+			side.schema = set.EvaluationSetVersion.EvaluationSetSchema
+		}
+	} else {
+		version, _, err := e.evaluationSetVersionService.GetEvaluationSetVersion(ctx, spaceID, *versionID, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		if version == nil {
+			return nil, errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("evaluation set version %d not found", *versionID)))
+		}
+		if version.EvaluationSetID != evaluationSetID {
+			return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("version %d does not belong to evaluation set %d", *versionID, evaluationSetID)))
+		}
+		side.schema = version.EvaluationSetSchema
+	}
+
+	var (
+		pageToken *string
+		pageSize  = versionDiffItemPageSize
+	)
+	for {
+		items, _, _, nextPageToken, err := e.evaluationSetItemService.ListEvaluationSetItems(ctx, &entity.ListEvaluationSetItemsParam{
+			SpaceID:         spaceID,
+			EvaluationSetID: evaluationSetID,
+			VersionID:       versionID,
+			PageSize:        &pageSize,
+			PageToken:       pageToken,
+			OrderBys:        []*entity.OrderBy{{Field: gptr.Of("item_id"), IsAsc: gptr.Of(true)}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if len(side.items) >= entity.MaxEvaluationSetVersionDiffItemCnt {
+				side.truncated = true
+				return side, nil
+			}
+			side.items = append(side.items, item)
+		}
+		if len(items) == 0 || gptr.Indirect(nextPageToken) == "" {
+			return side, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func diffVersionSides(base, target *versionSide) *entity.EvaluationSetVersionDiff {
+	diff := &entity.EvaluationSetVersionDiff{
+		BaseVersionID:   base.versionID,
+		TargetVersionID: target.versionID,
+		SchemaDiffs:     diffSchemas(base.schema, target.schema),
+		Truncated:       base.truncated || target.truncated,
+	}
+
+	baseItems := make(map[int64]*entity.EvaluationSetItem, len(base.items))
+	for _, item := range base.items {
+		baseItems[item.ItemID] = item
+	}
+	targetItems := make(map[int64]*entity.EvaluationSetItem, len(target.items))
+	for _, item := range target.items {
+		targetItems[item.ItemID] = item
+		baseItem, ok := baseItems[item.ItemID]
+		if !ok {
+			diff.ItemDiffs = append(diff.ItemDiffs, &entity.EvaluationSetItemDiff{
+				ItemID:     item.ItemID,
+				ItemKey:    item.ItemKey,
+				ChangeType: entity.EvaluationSetChangeType_Added,
+				TargetItem: item,
+			})
+			diff.AddedItemCnt++
+			continue
+		}
+		fieldDiffs := diffItemTurns(baseItem.Turns, item.Turns)
+		if len(fieldDiffs) == 0 {
+			diff.UnchangedItemCnt++
+			continue
+		}
+		diff.ItemDiffs = append(diff.ItemDiffs, &entity.EvaluationSetItemDiff{
+			ItemID:     item.ItemID,
+			ItemKey:    item.ItemKey,
+			ChangeType: entity.EvaluationSetChangeType_Modified,
+			BaseItem:   baseItem,
+			TargetItem: item,
+			FieldDiffs: fieldDiffs,
+		})
+		diff.ModifiedItemCnt++
+	}
+	for _, item := range base.items {
+		if _, ok := targetItems[item.ItemID]; ok {
+			continue
+		}
+		diff.ItemDiffs = append(diff.ItemDiffs, &entity.EvaluationSetItemDiff{
+			ItemID:     item.ItemID,
+			ItemKey:    item.ItemKey,
+			ChangeType: entity.EvaluationSetChangeType_Removed,
+			BaseItem:   item,
+		})
+		diff.RemovedItemCnt++
+	}
+	sort.SliceStable(diff.ItemDiffs, func(i, j int) bool { return diff.ItemDiffs[i].ItemID < diff.ItemDiffs[j].ItemID })
+	return diff
+}
+
+func diffSchemas(base, target *entity.EvaluationSetSchema) []*entity.EvaluationSetSchemaFieldDiff {
+	var baseFields, targetFields []*entity.FieldSchema
+	if base != nil {
+		baseFields = base.FieldSchemas
+	}
+	if target != nil {
+		targetFields = target.FieldSchemas
+	}
+	baseByKey := make(map[string]*entity.FieldSchema, len(baseFields))
+	for _, f := range baseFields {
+		if f != nil {
+			baseByKey[f.Key] = f
+		}
+	}
+	targetKeys := make(map[string]bool, len(targetFields))
+
+	var diffs []*entity.EvaluationSetSchemaFieldDiff
+	for _, f := range targetFields {
+		if f == nil {
+			continue
+		}
+		targetKeys[f.Key] = true
+		bf, ok := baseByKey[f.Key]
+		if !ok {
+			diffs = append(diffs, &entity.EvaluationSetSchemaFieldDiff{Key: f.Key, Name: f.Name, ChangeType: entity.EvaluationSetChangeType_Added, Target: f})
+			continue
+		}
+		if attrs := entity.DiffFieldSchema(bf, f); len(attrs) > 0 {
+			diffs = append(diffs, &entity.EvaluationSetSchemaFieldDiff{Key: f.Key, Name: f.Name, ChangeType: entity.EvaluationSetChangeType_Modified, Base: bf, Target: f, ChangedAttrs: attrs})
+		}
+	}
+	for _, f := range baseFields {
+		if f == nil || targetKeys[f.Key] {
+			continue
+		}
+		diffs = append(diffs, &entity.EvaluationSetSchemaFieldDiff{Key: f.Key, Name: f.Name, ChangeType: entity.EvaluationSetChangeType_Removed, Base: f})
+	}
+	return diffs
+}
+
+// diffItemTurns turn 按序号匹配, 字段按 key 匹配 (key 为空时按字段名)
+func diffItemTurns(baseTurns, targetTurns []*entity.Turn) []*entity.EvaluationSetFieldDiff {
+	var diffs []*entity.EvaluationSetFieldDiff
+	for idx := 0; idx < max(len(baseTurns), len(targetTurns)); idx++ {
+		var baseFields, targetFields []*entity.FieldData
+		if idx < len(baseTurns) && baseTurns[idx] != nil {
+			baseFields = baseTurns[idx].FieldDataList
+		}
+		if idx < len(targetTurns) && targetTurns[idx] != nil {
+			targetFields = targetTurns[idx].FieldDataList
+		}
+
+		baseByKey := make(map[string]*entity.FieldData, len(baseFields))
+		for _, fd := range baseFields {
+			if fd != nil {
+				baseByKey[fieldDataDiffKey(fd)] = fd
+			}
+		}
+		targetKeys := make(map[string]bool, len(targetFields))
+		for _, fd := range targetFields {
+			if fd == nil {
+				continue
+			}
+			key := fieldDataDiffKey(fd)
+			targetKeys[key] = true
+			bfd, ok := baseByKey[key]
+			switch {
+			case !ok || bfd.Content == nil:
+				if fd.Content == nil {
+					continue
+				}
+				diffs = append(diffs, &entity.EvaluationSetFieldDiff{TurnIdx: idx, FieldKey: fd.Key, FieldName: fd.Name, ChangeType: entity.EvaluationSetChangeType_Added, Target: fd.Content})
+			case fd.Content == nil:
+				diffs = append(diffs, &entity.EvaluationSetFieldDiff{TurnIdx: idx, FieldKey: fd.Key, FieldName: fd.Name, ChangeType: entity.EvaluationSetChangeType_Removed, Base: bfd.Content})
+			case !entity.ContentEqual(bfd.Content, fd.Content):
+				diffs = append(diffs, &entity.EvaluationSetFieldDiff{TurnIdx: idx, FieldKey: fd.Key, FieldName: fd.Name, ChangeType: entity.EvaluationSetChangeType_Modified, Base: bfd.Content, Target: fd.Content})
+			}
+		}
+		for _, fd := range baseFields {
+			if fd == nil || fd.Content == nil || targetKeys[fieldDataDiffKey(fd)] {
+				continue
+			}
+			diffs = append(diffs, &entity.EvaluationSetFieldDiff{TurnIdx: idx, FieldKey: fd.Key, FieldName: fd.Name, ChangeType: entity.EvaluationSetChangeType_Removed, Base: fd.Content})
+		}
+	}
+	return diffs
+}
+
+func fieldDataDiffKey(fd *entity.FieldData) string {
+	if len(fd.Key) > 0 {
+		return fd.Key
+	}
+	return fd.Name
+}
+
+func (e *EvaluationSetVersionDiffServiceImpl) MergeVersion(ctx context.Context, param *entity.MergeEvaluationSetVersionParam) (*entity.MergeEvaluationSetVersionResult, error) {
+	if param == nil || param.SpaceID <= 0 || param.EvaluationSetID <= 0 || param.SourceVersionID <= 0 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid merge param"))
+	}
+	if !param.MergeAllItems && len(param.ItemIDs) == 0 && !param.MergeSchema {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("no change selected to merge"))
+	}
+
+	draft, err := e.loadVersionSide(ctx, param.SpaceID, param.EvaluationSetID, nil)
+	if err != nil {
+		return nil, err
+	}
+	source, err := e.loadVersionSide(ctx, param.SpaceID, param.EvaluationSetID, gptr.Of(param.SourceVersionID))
+	if err != nil {
+		return nil, err
+	}
+	diff := diffVersionSides(draft, source)
+	if diff.Truncated {
+		// 行级对比不完整时无法判断哪些行被删除, 不允许合入
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("evaluation set exceeds %d items, merge is not supported", entity.MaxEvaluationSetVersionDiffItemCnt)))
+	}
+
+	res := &entity.MergeEvaluationSetVersionResult{}
+	if param.MergeSchema && len(diff.SchemaDiffs) > 0 {
+		if err := e.mergeSchema(ctx, param, draft.schema, diff.SchemaDiffs); err != nil {
+			return nil, err
+		}
+		res.SchemaMerged = true
+	}
+
+	selected := make(map[int64]bool, len(param.ItemIDs))
+	for _, id := range param.ItemIDs {
+		selected[id] = true
+	}
+	var added, removed []*entity.EvaluationSetItemDiff
+	for _, d := range diff.ItemDiffs {
+		if !selected[d.ItemID] && (!param.MergeAllItems || d.ChangeType == entity.EvaluationSetChangeType_Removed) {
+			// 版本是草稿的历史快照, 草稿独有的行多为版本之后新增的, MergeAllItems 不删除, 删除须显式选中
+			continue
+		}
+		switch d.ChangeType {
+		case entity.EvaluationSetChangeType_Added:
+			added = append(added, d)
+		case entity.EvaluationSetChangeType_Removed:
+			removed = append(removed, d)
+		case entity.EvaluationSetChangeType_Modified:
+			// 以来源版本的内容覆盖草稿中的同一行, item_id 不变
+			if err := e.evaluationSetItemService.UpdateEvaluationSetItem(ctx, param.SpaceID, param.EvaluationSetID, d.ItemID, d.TargetItem.Turns, nil, nil); err != nil {
+				return res, err
+			}
+			res.UpdatedItemIDs = append(res.UpdatedItemIDs, d.ItemID)
+		}
+	}
+
+	if err := e.mergeAddedItems(ctx, param, added, res); err != nil {
+		return res, err
+	}
+	for start := 0; start < len(removed); start += entity.EvaluationSetMergeBatchSize {
+		batch := removed[start:min(start+entity.EvaluationSetMergeBatchSize, len(removed))]
+		itemIDs := make([]int64, 0, len(batch))
+		for _, d := range batch {
+			itemIDs = append(itemIDs, d.ItemID)
+		}
+		if err := e.evaluationSetItemService.BatchDeleteEvaluationSetItems(ctx, param.SpaceID, param.EvaluationSetID, itemIDs); err != nil {
+			return res, err
+		}
+		res.RemovedItemIDs = append(res.RemovedItemIDs, itemIDs...)
+	}
+	e.recordMergeProvenance(ctx, param, res)
+
+	logs.CtxInfo(ctx, "[EvaluationSetVersionDiff] merge done, eval_set_id: %v, source_version_id: %v, schema_merged: %v, added: %v, updated: %v, removed: %v",
+		param.EvaluationSetID, param.SourceVersionID, res.SchemaMerged, len(res.AddedItemIDs), len(res.UpdatedItemIDs), len(res.RemovedItemIDs))
+	return res, nil
+}
+
+// recordMergeProvenance 登记合入行的来源: 新建的行来源为版本合并, 覆盖的行记录编辑者, 删除的行清理来源。
+// 行已写入草稿, 来源登记失败不影响合入结果
+func (e *EvaluationSetVersionDiffServiceImpl) recordMergeProvenance(ctx context.Context, param *entity.MergeEvaluationSetVersionParam, res *entity.MergeEvaluationSetVersionResult) {
+	if len(res.AddedItemIDs) > 0 {
+		addedItemIDs := make([]int64, 0, len(res.AddedItemIDs))
+		for _, itemID := range res.AddedItemIDs {
+			addedItemIDs = append(addedItemIDs, itemID)
+		}
+		sort.Slice(addedItemIDs, func(i, j int) bool { return addedItemIDs[i] < addedItemIDs[j] })
+		if err := e.provenanceService.RecordProvenance(ctx, &entity.RecordEvaluationSetItemProvenanceParam{
+			SpaceID:         param.SpaceID,
+			EvaluationSetID: param.EvaluationSetID,
+			ItemIDs:         addedItemIDs,
+			Template: &entity.EvaluationSetItemProvenance{
+				SourceType:      entity.EvaluationSetItemSourceType_VersionMerge,
+				SourceVersionID: param.SourceVersionID,
+			},
+		}); err != nil {
+			logs.CtxWarn(ctx, "record merged item provenance fail, evaluation_set_id=%d, err=%v", param.EvaluationSetID, err)
+		}
+	}
+	if len(res.UpdatedItemIDs) > 0 {
+		if err := e.provenanceService.MarkEdited(ctx, param.SpaceID, param.EvaluationSetID, res.UpdatedItemIDs); err != nil {
+			logs.CtxWarn(ctx, "mark merged item edited fail, evaluation_set_id=%d, err=%v", param.EvaluationSetID, err)
+		}
+	}
+	if len(res.RemovedItemIDs) > 0 {
+		if err := e.provenanceService.RemoveProvenance(ctx, param.SpaceID, param.EvaluationSetID, res.RemovedItemIDs); err != nil {
+			logs.CtxWarn(ctx, "remove merged item provenance fail, evaluation_set_id=%d, err=%v", param.EvaluationSetID, err)
+		}
+	}
+}
+
+// mergeSchema 来源版本新增与修改的字段写入草稿 schema, 草稿独有的字段保留
+func (e *EvaluationSetVersionDiffServiceImpl) mergeSchema(ctx context.Context, param *entity.MergeEvaluationSetVersionParam, draftSchema *entity.EvaluationSetSchema, diffs []*entity.EvaluationSetSchemaFieldDiff) error {
+	var current []*entity.FieldSchema
+	if draftSchema != nil {
+		current = draftSchema.FieldSchemas
+	}
+	merged := make([]*entity.FieldSchema, 0, len(current)+len(diffs))
+	replaced := make(map[string]*entity.FieldSchema)
+	for _, d := range diffs {
+		if d.ChangeType == entity.EvaluationSetChangeType_Modified {
+			replaced[d.Key] = d.Target
+		}
+	}
+	for _, f := range current {
+		if f == nil {
+			continue
+		}
+		if r, ok := replaced[f.Key]; ok {
+			merged = append(merged, r)
+			continue
+		}
+		merged = append(merged, f)
+	}
+	for _, d := range diffs {
+		if d.ChangeType == entity.EvaluationSetChangeType_Added {
+			merged = append(merged, d.Target)
+		}
+	}
+
+	if err := e.evaluationSetService.ValidateEvaluationSetSchemaUpdate(ctx, &entity.ValidateEvaluationSetSchemaUpdateParam{
+		SpaceID:             param.SpaceID,
+		EvaluationSetID:     param.EvaluationSetID,
+		CurrentSchema:       draftSchema,
+		UpdatedFieldSchemas: merged,
+	}); err != nil {
+		return err
+	}
+	return e.evaluationSetSchemaService.UpdateEvaluationSetSchema(ctx, param.SpaceID, param.EvaluationSetID, merged)
+}
+
+// mergeAddedItems 来源版本新增的行在草稿中重新创建; 已被草稿删除的行无法恢复原 item_id, 沿用 item_key
+func (e *EvaluationSetVersionDiffServiceImpl) mergeAddedItems(ctx context.Context, param *entity.MergeEvaluationSetVersionParam, added []*entity.EvaluationSetItemDiff, res *entity.MergeEvaluationSetVersionResult) error {
+	for start := 0; start < len(added); start += entity.EvaluationSetMergeBatchSize {
+		batch := added[start:min(start+entity.EvaluationSetMergeBatchSize, len(added))]
+		items := make([]*entity.EvaluationSetItem, 0, len(batch))
+		for _, d := range batch {
+			turns := make([]*entity.Turn, 0, len(d.TargetItem.Turns))
+			for _, turn := range d.TargetItem.Turns {
+				if turn == nil {
+					continue
+				}
+				turns = append(turns, &entity.Turn{FieldDataList: turn.FieldDataList})
+			}
+			items = append(items, &entity.EvaluationSetItem{
+				SpaceID:         param.SpaceID,
+				EvaluationSetID: param.EvaluationSetID,
+				ItemKey:         d.ItemKey,
+				Turns:           turns,
+			})
+		}
+		idMap, errs, _, err := e.evaluationSetItemService.BatchCreateEvaluationSetItems(ctx, &entity.BatchCreateEvaluationSetItemsParam{
+			SpaceID:         param.SpaceID,
+			EvaluationSetID: param.EvaluationSetID,
+			Items:           items,
+		})
+		if err != nil {
+			return err
+		}
+		res.Errors = append(res.Errors, errs...)
+		for idx, itemID := range idMap {
+			if idx < 0 || int(idx) >= len(batch) {
+				continue
+			}
+			if res.AddedItemIDs == nil {
+				res.AddedItemIDs = make(map[int64]int64, len(added))
+			}
+			res.AddedItemIDs[batch[idx].ItemID] = itemID
+		}
+	}
+	return nil
+}
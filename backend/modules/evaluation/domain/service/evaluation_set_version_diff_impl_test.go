@@ -0,0 +1,273 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	servicemocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+func newVersionDiffTestItem(itemID int64, input, reference string) *entity.EvaluationSetItem {
+	return &entity.EvaluationSetItem{
+		ItemID:  itemID,
+		ItemKey: "key",
+		Turns: []*entity.Turn{{ID: itemID * 10, FieldDataList: []*entity.FieldData{
+			{Key: "k_input", Name: "input", Content: entity.NewTextContent(input)},
+			{Key: "k_ref", Name: "reference", Content: entity.NewTextContent(reference)},
+		}}},
+	}
+}
+
+type versionDiffTestMocks struct {
+	setSvc     *servicemocks.MockIEvaluationSetService
+	versionSvc *servicemocks.MockEvaluationSetVersionService
+	itemSvc    *servicemocks.MockEvaluationSetItemService
+	schemaSvc  *servicemocks.MockEvaluationSetSchemaService
+	provSvc    *servicemocks.MockEvaluationSetItemProvenanceService
+}
+
+func newVersionDiffTestService(ctrl *gomock.Controller, draftItems, versionItems []*entity.EvaluationSetItem) (EvaluationSetVersionDiffService, *versionDiffTestMocks) {
+	m := &versionDiffTestMocks{
+		setSvc:     servicemocks.NewMockIEvaluationSetService(ctrl),
+		versionSvc: servicemocks.NewMockEvaluationSetVersionService(ctrl),
+		itemSvc:    servicemocks.NewMockEvaluationSetItemService(ctrl),
+		schemaSvc:  servicemocks.NewMockEvaluationSetSchemaService(ctrl),
+		provSvc:    servicemocks.NewMockEvaluationSetItemProvenanceService(ctrl),
+	}
+	draftSchema := &entity.EvaluationSetSchema{FieldSchemas: []*entity.FieldSchema{
+		{Key: "k_input", Name: "input", ContentType: entity.ContentTypeText},
+		{Key: "k_ref", Name: "reference", ContentType: entity.ContentTypeText},
+		{Key: "k_note", Name: "note", ContentType: entity.ContentTypeText},
+	}}
+	versionSchema := &entity.EvaluationSetSchema{FieldSchemas: []*entity.FieldSchema{
+		{Key: "k_input", Name: "input", ContentType: entity.ContentTypeText},
+		{Key: "k_ref", Name: "reference", ContentType: entity.ContentTypeText, Description: "golden answer"},
+		{Key: "k_tag", Name: "tag", ContentType: entity.ContentTypeText},
+	}}
+	m.setSvc.EXPECT().GetEvaluationSet(gomock.Any(), gomock.Any(), int64(7), gomock.Any(), gomock.Any()).
+		Return(&entity.EvaluationSet{ID: 7, EvaluationSetVersion: &entity.EvaluationSetVersion{EvaluationSetSchema: draftSchema}}, nil).AnyTimes()
+	m.versionSvc.EXPECT().GetEvaluationSetVersion(gomock.Any(), int64(1), int64(70), gomock.Any(), gomock.Any()).
+		Return(&entity.EvaluationSetVersion{ID: 70, EvaluationSetID: 7, EvaluationSetSchema: versionSchema}, nil, nil).AnyTimes()
+	m.itemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, param *entity.ListEvaluationSetItemsParam) ([]*entity.EvaluationSetItem, *int64, *int64, *string, error) {
+			if param.VersionID == nil {
+				return draftItems, nil, nil, nil, nil
+			}
+			return versionItems, nil, nil, nil, nil
+		}).AnyTimes()
+	return NewEvaluationSetVersionDiffService(m.setSvc, m.versionSvc, m.itemSvc, m.schemaSvc, m.provSvc), m
+}
+
+func TestEvaluationSetVersionDiffServiceImpl_DiffVersions(t *testing.T) {
+	draftItems := []*entity.EvaluationSetItem{
+		newVersionDiffTestItem(1, "q1", "a1"),
+		newVersionDiffTestItem(2, "q2", "a2-draft"),
+		newVersionDiffTestItem(4, "q4", "a4"),
+	}
+	versionItems := []*entity.EvaluationSetItem{
+		newVersionDiffTestItem(1, "q1", "a1"),
+		newVersionDiffTestItem(2, "q2", "a2"),
+		newVersionDiffTestItem(3, "q3", "a3"),
+	}
+
+	t.Run("版本对比草稿", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _ := newVersionDiffTestService(ctrl, draftItems, versionItems)
+		diff, err := svc.DiffVersions(context.Background(), &entity.DiffEvaluationSetVersionsParam{
+			SpaceID: 1, EvaluationSetID: 7, BaseVersionID: gptr.Of(int64(70)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, diff.AddedItemCnt)
+		assert.Equal(t, 1, diff.RemovedItemCnt)
+		assert.Equal(t, 1, diff.ModifiedItemCnt)
+		assert.Equal(t, 1, diff.UnchangedItemCnt)
+
+		require.Len(t, diff.ItemDiffs, 3)
+		assert.Equal(t, int64(2), diff.ItemDiffs[0].ItemID)
+		assert.Equal(t, entity.EvaluationSetChangeType_Modified, diff.ItemDiffs[0].ChangeType)
+		require.Len(t, diff.ItemDiffs[0].FieldDiffs, 1)
+		fd := diff.ItemDiffs[0].FieldDiffs[0]
+		assert.Equal(t, "reference", fd.FieldName)
+		assert.Equal(t, "a2", fd.Base.GetText())
+		assert.Equal(t, "a2-draft", fd.Target.GetText())
+		assert.Equal(t, entity.EvaluationSetChangeType_Removed, diff.ItemDiffs[1].ChangeType)
+		assert.Equal(t, int64(3), diff.ItemDiffs[1].ItemID)
+		assert.Equal(t, entity.EvaluationSetChangeType_Added, diff.ItemDiffs[2].ChangeType)
+		assert.Equal(t, int64(4), diff.ItemDiffs[2].ItemID)
+
+		require.Len(t, diff.SchemaDiffs, 3)
+		byKey := make(map[string]*entity.EvaluationSetSchemaFieldDiff)
+		for _, d := range diff.SchemaDiffs {
+			byKey[d.Key] = d
+		}
+		assert.Equal(t, entity.EvaluationSetChangeType_Modified, byKey["k_ref"].ChangeType)
+		assert.Equal(t, []string{"description"}, byKey["k_ref"].ChangedAttrs)
+		assert.Equal(t, entity.EvaluationSetChangeType_Added, byKey["k_note"].ChangeType)
+		assert.Equal(t, entity.EvaluationSetChangeType_Removed, byKey["k_tag"].ChangeType)
+	})
+
+	t.Run("版本不属于评测集", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		versionSvc := servicemocks.NewMockEvaluationSetVersionService(ctrl)
+		versionSvc.EXPECT().GetEvaluationSetVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&entity.EvaluationSetVersion{ID: 80, EvaluationSetID: 8}, nil, nil)
+		svc := NewEvaluationSetVersionDiffService(nil, versionSvc, nil, nil, nil)
+		_, err := svc.DiffVersions(context.Background(), &entity.DiffEvaluationSetVersionsParam{
+			SpaceID: 1, EvaluationSetID: 7, BaseVersionID: gptr.Of(int64(80)),
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("对比同一版本", func(t *testing.T) {
+		svc := NewEvaluationSetVersionDiffService(nil, nil, nil, nil, nil)
+		_, err := svc.DiffVersions(context.Background(), &entity.DiffEvaluationSetVersionsParam{SpaceID: 1, EvaluationSetID: 7})
+		assert.Error(t, err)
+	})
+}
+
+func TestEvaluationSetVersionDiffServiceImpl_MergeVersion(t *testing.T) {
+	draftItems := []*entity.EvaluationSetItem{
+		newVersionDiffTestItem(1, "q1", "a1"),
+		newVersionDiffTestItem(2, "q2", "a2-draft"),
+		newVersionDiffTestItem(4, "q4", "a4"),
+	}
+	versionItems := []*entity.EvaluationSetItem{
+		newVersionDiffTestItem(1, "q1", "a1"),
+		newVersionDiffTestItem(2, "q2", "a2"),
+		newVersionDiffTestItem(3, "q3", "a3"),
+	}
+
+	t.Run("合入全部变更与 schema", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newVersionDiffTestService(ctrl, draftItems, versionItems)
+		m.setSvc.EXPECT().ValidateEvaluationSetSchemaUpdate(gomock.Any(), gomock.Any()).Return(nil)
+		m.schemaSvc.EXPECT().UpdateEvaluationSetSchema(gomock.Any(), int64(1), int64(7), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _, _ int64, fields []*entity.FieldSchema) error {
+				// 草稿独有的 note 字段保留, reference 以来源版本为准, 新增 tag
+				require.Len(t, fields, 4)
+				assert.Equal(t, "golden answer", fields[1].Description)
+				assert.Equal(t, "note", fields[2].Name)
+				assert.Equal(t, "tag", fields[3].Name)
+				return nil
+			})
+		m.itemSvc.EXPECT().UpdateEvaluationSetItem(gomock.Any(), int64(1), int64(7), int64(2), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _, _, _ int64, turns []*entity.Turn, _ []*entity.FieldWriteOption, _ []*entity.ResourceTagRef) error {
+				assert.Equal(t, "a2", turns[0].FieldDataList[1].Content.GetText())
+				return nil
+			})
+		m.itemSvc.EXPECT().BatchCreateEvaluationSetItems(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.BatchCreateEvaluationSetItemsParam) (map[int64]int64, []*entity.ItemErrorGroup, []*entity.DatasetItemOutput, error) {
+				require.Len(t, param.Items, 1)
+				assert.Equal(t, "q3", param.Items[0].Turns[0].FieldDataList[0].Content.GetText())
+				assert.Zero(t, param.Items[0].Turns[0].ID)
+				return map[int64]int64{0: 300}, nil, nil, nil
+			})
+		m.provSvc.EXPECT().RecordProvenance(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.RecordEvaluationSetItemProvenanceParam) error {
+				assert.Equal(t, []int64{300}, param.ItemIDs)
+				assert.Equal(t, entity.EvaluationSetItemSourceType_VersionMerge, param.Template.SourceType)
+				assert.Equal(t, int64(70), param.Template.SourceVersionID)
+				return nil
+			})
+		m.provSvc.EXPECT().MarkEdited(gomock.Any(), int64(1), int64(7), []int64{2}).Return(nil)
+
+		res, err := svc.MergeVersion(context.Background(), &entity.MergeEvaluationSetVersionParam{
+			SpaceID: 1, EvaluationSetID: 7, SourceVersionID: 70, MergeAllItems: true, MergeSchema: true,
+		})
+		require.NoError(t, err)
+		assert.True(t, res.SchemaMerged)
+		assert.Equal(t, map[int64]int64{3: 300}, res.AddedItemIDs)
+		assert.Equal(t, []int64{2}, res.UpdatedItemIDs)
+		// 草稿在版本之后新增的行 4 不被删除
+		assert.Empty(t, res.RemovedItemIDs)
+	})
+
+	t.Run("显式选中的删除才生效", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newVersionDiffTestService(ctrl, draftItems, versionItems)
+		m.itemSvc.EXPECT().UpdateEvaluationSetItem(gomock.Any(), int64(1), int64(7), int64(2), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		m.itemSvc.EXPECT().BatchCreateEvaluationSetItems(gomock.Any(), gomock.Any()).Return(map[int64]int64{0: 300}, nil, nil, nil)
+		m.itemSvc.EXPECT().BatchDeleteEvaluationSetItems(gomock.Any(), int64(1), int64(7), []int64{4}).Return(nil)
+		m.provSvc.EXPECT().RecordProvenance(gomock.Any(), gomock.Any()).Return(nil)
+		m.provSvc.EXPECT().MarkEdited(gomock.Any(), int64(1), int64(7), []int64{2}).Return(nil)
+		m.provSvc.EXPECT().RemoveProvenance(gomock.Any(), int64(1), int64(7), []int64{4}).Return(errors.New("ignored"))
+
+		res, err := svc.MergeVersion(context.Background(), &entity.MergeEvaluationSetVersionParam{
+			SpaceID: 1, EvaluationSetID: 7, SourceVersionID: 70, MergeAllItems: true, ItemIDs: []int64{4},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]int64{3: 300}, res.AddedItemIDs)
+		assert.Equal(t, []int64{4}, res.RemovedItemIDs)
+	})
+
+	t.Run("仅合入选中行", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newVersionDiffTestService(ctrl, draftItems, versionItems)
+		m.itemSvc.EXPECT().UpdateEvaluationSetItem(gomock.Any(), gomock.Any(), gomock.Any(), int64(2), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		m.provSvc.EXPECT().MarkEdited(gomock.Any(), int64(1), int64(7), []int64{2}).Return(nil)
+
+		res, err := svc.MergeVersion(context.Background(), &entity.MergeEvaluationSetVersionParam{
+			SpaceID: 1, EvaluationSetID: 7, SourceVersionID: 70, ItemIDs: []int64{1, 2},
+		})
+		require.NoError(t, err)
+		assert.False(t, res.SchemaMerged)
+		assert.Equal(t, []int64{2}, res.UpdatedItemIDs)
+		assert.Empty(t, res.AddedItemIDs)
+		assert.Empty(t, res.RemovedItemIDs)
+	})
+
+	t.Run("写入失败", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newVersionDiffTestService(ctrl, draftItems, versionItems)
+		m.itemSvc.EXPECT().UpdateEvaluationSetItem(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("rpc fail"))
+		_, err := svc.MergeVersion(context.Background(), &entity.MergeEvaluationSetVersionParam{
+			SpaceID: 1, EvaluationSetID: 7, SourceVersionID: 70, ItemIDs: []int64{2},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("未选择任何变更", func(t *testing.T) {
+		svc := NewEvaluationSetVersionDiffService(nil, nil, nil, nil, nil)
+		_, err := svc.MergeVersion(context.Background(), &entity.MergeEvaluationSetVersionParam{SpaceID: 1, EvaluationSetID: 7, SourceVersionID: 70})
+		assert.Error(t, err)
+	})
+}
+
+func TestContentEqual(t *testing.T) {
+	img := func(uri, url string) *entity.Content {
+		return &entity.Content{ContentType: gptr.Of(entity.ContentTypeImage), Image: &entity.Image{URI: gptr.Of(uri), URL: gptr.Of(url)}}
+	}
+	assert.True(t, entity.ContentEqual(nil, nil))
+	assert.False(t, entity.ContentEqual(entity.NewTextContent("a"), nil))
+	assert.True(t, entity.ContentEqual(entity.NewTextContent("a"), entity.NewTextContent("a")))
+	assert.False(t, entity.ContentEqual(entity.NewTextContent("a"), entity.NewTextContent("b")))
+	// 访问 URL 每次签发不同, 按存储 URI 比较
+	assert.True(t, entity.ContentEqual(img("tos://a", "https://x?sig=1"), img("tos://a", "https://x?sig=2")))
+	assert.False(t, entity.ContentEqual(img("tos://a", ""), img("tos://b", "")))
+	assert.True(t, entity.ContentEqual(
+		&entity.Content{ContentType: gptr.Of(entity.ContentTypeMultipart), MultiPart: []*entity.Content{entity.NewTextContent("a"), img("tos://a", "")}},
+		&entity.Content{ContentType: gptr.Of(entity.ContentTypeMultipart), MultiPart: []*entity.Content{entity.NewTextContent("a"), img("tos://a", "")}},
+	))
+}
@@ -5,10 +5,12 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 )
@@ -20,12 +22,14 @@ var (
 
 type EvaluationSetVersionServiceImpl struct {
 	datasetRPCAdapter rpc.IDatasetRPCAdapter
+	provenanceRepo    repo.IEvaluationSetItemProvenanceRepo
 }
 
-func NewEvaluationSetVersionServiceImpl(datasetRPCAdapter rpc.IDatasetRPCAdapter) EvaluationSetVersionService {
+func NewEvaluationSetVersionServiceImpl(datasetRPCAdapter rpc.IDatasetRPCAdapter, provenanceRepo repo.IEvaluationSetItemProvenanceRepo) EvaluationSetVersionService {
 	evaluationSetVersionServiceOnce.Do(func() {
 		evaluationSetVersionServiceImpl = &EvaluationSetVersionServiceImpl{
 			datasetRPCAdapter: datasetRPCAdapter,
+			provenanceRepo:    provenanceRepo,
 		}
 	})
 	return evaluationSetVersionServiceImpl
@@ -35,6 +39,16 @@ func (d *EvaluationSetVersionServiceImpl) CreateEvaluationSetVersion(ctx context
 	if param == nil {
 		return 0, errorx.NewByCode(errno.CommonInternalErrorCode)
 	}
+	// 版本是草稿的全量快照, 存在待审核的生成行时不允许提交
+	_, pendingCnt, err := d.provenanceRepo.ListItemIDs(ctx, param.SpaceID, param.EvaluationSetID, &entity.EvaluationSetItemProvenanceFilter{
+		ReviewStatuses: []string{entity.EvaluationSetItemReviewStatus_PendingReview},
+	}, entity.NewPage(1, 1))
+	if err != nil {
+		return 0, err
+	}
+	if pendingCnt > 0 {
+		return 0, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("%d generated items are pending review, approve or reject them before creating a version", pendingCnt)))
+	}
 	// 依赖数据集服务
 	return d.datasetRPCAdapter.CreateDatasetVersion(ctx, param.SpaceID, param.EvaluationSetID, param.Version, param.Description)
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 )
@@ -34,7 +35,7 @@ func TestNewEvaluationSetVersionServiceImpl(t *testing.T) {
 				defer ctrl.Finish()
 
 				mockDatasetRPCAdapter := mocks.NewMockIDatasetRPCAdapter(ctrl)
-				service := NewEvaluationSetVersionServiceImpl(mockDatasetRPCAdapter)
+				service := NewEvaluationSetVersionServiceImpl(mockDatasetRPCAdapter, nil)
 
 				// 验证返回的服务实例不为空
 				assert.NotNil(t, service)
@@ -59,7 +60,7 @@ func TestNewEvaluationSetVersionServiceImpl(t *testing.T) {
 				evaluationSetVersionServiceImpl = nil
 
 				// 使用 nil adapter 初始化
-				service := NewEvaluationSetVersionServiceImpl(nil)
+				service := NewEvaluationSetVersionServiceImpl(nil, nil)
 
 				// 验证返回的服务实例不为空
 				assert.NotNil(t, service)
@@ -86,10 +87,13 @@ func TestCreateEvaluationSetVersion(t *testing.T) {
 
 	// 创建模拟的 DatasetRPCAdapter
 	mockAdapter := mocks.NewMockIDatasetRPCAdapter(ctrl)
+	mockProvenanceRepo := repomocks.NewMockIEvaluationSetItemProvenanceRepo(ctrl)
+	pendingFilter := &entity.EvaluationSetItemProvenanceFilter{ReviewStatuses: []string{entity.EvaluationSetItemReviewStatus_PendingReview}}
 
 	// 创建 EvaluationSetVersionServiceImpl 实例
 	service := &EvaluationSetVersionServiceImpl{
 		datasetRPCAdapter: mockAdapter,
+		provenanceRepo:    mockProvenanceRepo,
 	}
 
 	// 定义测试用例
@@ -111,9 +115,23 @@ func TestCreateEvaluationSetVersion(t *testing.T) {
 			expectedID:  123,
 			expectedErr: nil,
 			mockSetup: func() {
+				mockProvenanceRepo.EXPECT().ListItemIDs(gomock.Any(), int64(1), int64(1), pendingFilter, gomock.Any()).Return(nil, int64(0), nil)
 				mockAdapter.EXPECT().CreateDatasetVersion(gomock.Any(), int64(1), int64(1), "1.0", gomock.Any()).Return(int64(123), nil)
 			},
 		},
+		{
+			name: "存在待审核的生成行时拒绝创建",
+			param: &entity.CreateEvaluationSetVersionParam{
+				SpaceID:         1,
+				EvaluationSetID: 2,
+				Version:         "1.0",
+			},
+			expectedID:  0,
+			expectedErr: errorx.NewByCode(errno.CommonInvalidParamCode),
+			mockSetup: func() {
+				mockProvenanceRepo.EXPECT().ListItemIDs(gomock.Any(), int64(1), int64(2), pendingFilter, gomock.Any()).Return([]int64{10}, int64(3), nil)
+			},
+		},
 		{
 			name:        "参数为空",
 			param:       nil,
@@ -0,0 +1,23 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination ./mocks/evaluator_calibration.go --package mocks . EvaluatorCalibrationService
+type EvaluatorCalibrationService interface {
+	// Calibrate 提交校准任务: 校验参数后创建运行中的校准记录并投递异步事件, 由 HandleCalibrationEvent 执行
+	Calibrate(ctx context.Context, param *entity.EvaluatorCalibrationParam) (*entity.EvaluatorCalibrationRecord, error)
+	// HandleCalibrationEvent 在带人工真值字段的评测集上运行评估器版本, 计算与人工真值的相关性、MAE、混淆矩阵及最不一致样本,
+	// 结果回写校准记录。评估器运行失败的样本占比超过阈值时记录置为失败。
+	HandleCalibrationEvent(ctx context.Context, spaceID, recordID int64) error
+	// GetCalibrationRecord 获取校准记录, 超时未结束的运行中记录置为失败
+	GetCalibrationRecord(ctx context.Context, spaceID, recordID int64) (*entity.EvaluatorCalibrationRecord, error)
+	// ListCalibrationRecords 查询评估器各版本的校准记录, 用于版本上线实验前的横向对比
+	ListCalibrationRecords(ctx context.Context, spaceID, evaluatorID int64, evaluatorVersionIDs []int64) ([]*entity.EvaluatorCalibrationRecord, error)
+}
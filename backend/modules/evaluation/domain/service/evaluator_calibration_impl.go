@@ -0,0 +1,469 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/events"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/contexts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/stats"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/goroutine"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const (
+	calibrationItemPageSize = int32(100)
+	calibrationMaxPageLoop  = 10000
+)
+
+type EvaluatorCalibrationServiceImpl struct {
+	idgen                    idgen.IIDGenerator
+	evaluatorService         EvaluatorService
+	evaluationSetItemService EvaluationSetItemService
+	calibrationRepo          repo.IEvaluatorCalibrationRepo
+	publisher                events.ExptEventPublisher
+}
+
+func NewEvaluatorCalibrationService(
+	idgen idgen.IIDGenerator,
+	evaluatorService EvaluatorService,
+	evaluationSetItemService EvaluationSetItemService,
+	calibrationRepo repo.IEvaluatorCalibrationRepo,
+	publisher events.ExptEventPublisher,
+) EvaluatorCalibrationService {
+	return &EvaluatorCalibrationServiceImpl{
+		idgen:                    idgen,
+		evaluatorService:         evaluatorService,
+		evaluationSetItemService: evaluationSetItemService,
+		calibrationRepo:          calibrationRepo,
+		publisher:                publisher,
+	}
+}
+
+// calibrationSample 单个 turn 的校准样本
+type calibrationSample struct {
+	itemID, turnID int64
+	input          *entity.EvaluatorInputData
+
+	groundTruth      float64
+	groundTruthValid bool
+
+	score     float64
+	scoreOK   bool
+	reasoning string
+	errMsg    string
+}
+
+func (e *EvaluatorCalibrationServiceImpl) Calibrate(ctx context.Context, param *entity.EvaluatorCalibrationParam) (*entity.EvaluatorCalibrationRecord, error) {
+	if param == nil || param.SpaceID <= 0 || param.EvaluatorVersionID <= 0 || param.EvaluationSetID <= 0 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid evaluator calibration param"))
+	}
+	if param.Conf == nil || param.Conf.GroundTruthField == "" {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("ground truth field is required"))
+	}
+	if param.Conf.MaxEvaluatorFailRate > 1 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("max evaluator fail rate must be in (0, 1]"))
+	}
+
+	evaluatorDO, err := e.evaluatorService.GetEvaluatorVersion(ctx, gptr.Of(param.SpaceID), param.EvaluatorVersionID, false, false)
+	if err != nil {
+		return nil, err
+	}
+	if evaluatorDO == nil {
+		return nil, errorx.NewByCode(errno.EvaluatorVersionNotFoundCode)
+	}
+
+	id, err := e.idgen.GenID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	record := &entity.EvaluatorCalibrationRecord{
+		ID:                     id,
+		SpaceID:                param.SpaceID,
+		EvaluatorID:            evaluatorDO.GetEvaluatorID(),
+		EvaluatorVersionID:     param.EvaluatorVersionID,
+		EvaluatorVersion:       evaluatorDO.GetVersion(),
+		EvaluationSetID:        param.EvaluationSetID,
+		EvaluationSetVersionID: gptr.Indirect(param.EvaluationSetVersionID),
+		Status:                 entity.EvaluatorCalibrationStatus_Running,
+		Conf:                   param.Conf,
+		BaseInfo: &entity.BaseInfo{
+			CreatedBy: &entity.UserInfo{UserID: gptr.Of(param.CreatedBy)},
+			CreatedAt: gptr.Of(now.UnixMilli()),
+		},
+	}
+	if err := e.calibrationRepo.CreateCalibrationRecord(ctx, record); err != nil {
+		return nil, err
+	}
+
+	if err := e.publisher.PublishExptExportCSVEvent(ctx, &entity.ExportCSVEvent{
+		ExportID:    record.ID,
+		SpaceID:     param.SpaceID,
+		ExportScene: entity.ExportSceneEvaluatorCalibration,
+		CreatedAt:   now.Unix(),
+		Session:     &entity.Session{UserID: param.CreatedBy},
+	}, gptr.Of(time.Second*3)); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (e *EvaluatorCalibrationServiceImpl) HandleCalibrationEvent(ctx context.Context, spaceID, recordID int64) error {
+	record, err := e.calibrationRepo.GetCalibrationRecord(contexts.WithCtxWriteDB(ctx), spaceID, recordID)
+	if err != nil {
+		return err
+	}
+	if record == nil {
+		return errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("evaluator calibration record %d not found", recordID)))
+	}
+	if record.Status != entity.EvaluatorCalibrationStatus_Running {
+		logs.CtxInfo(ctx, "[HandleCalibrationEvent] record already finished, skip, record_id: %v, status: %v", recordID, record.Status)
+		return nil
+	}
+
+	result, calibrateErr := e.calibrate(ctx, record.Param())
+	if calibrateErr != nil {
+		logs.CtxError(ctx, "[HandleCalibrationEvent] calibrate fail, record_id: %v, evaluator_version_id: %v, err: %v", record.ID, record.EvaluatorVersionID, calibrateErr)
+		record.Status = entity.EvaluatorCalibrationStatus_Failed
+		record.ErrMsg = calibrateErr.Error()
+	} else {
+		record.Status = entity.EvaluatorCalibrationStatus_Success
+	}
+	// 失败时也保留已计算出的部分结果, 便于排查失败样本
+	record.Result = result
+	return e.calibrationRepo.UpdateCalibrationResult(ctx, record)
+}
+
+func (e *EvaluatorCalibrationServiceImpl) GetCalibrationRecord(ctx context.Context, spaceID, recordID int64) (*entity.EvaluatorCalibrationRecord, error) {
+	record, err := e.calibrationRepo.GetCalibrationRecord(ctx, spaceID, recordID)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return nil, errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("evaluator calibration record %d not found", recordID)))
+	}
+	e.failStaleRecord(ctx, record, time.Now())
+	return record, nil
+}
+
+func (e *EvaluatorCalibrationServiceImpl) ListCalibrationRecords(ctx context.Context, spaceID, evaluatorID int64, evaluatorVersionIDs []int64) ([]*entity.EvaluatorCalibrationRecord, error) {
+	records, err := e.calibrationRepo.ListCalibrationRecords(ctx, spaceID, evaluatorID, evaluatorVersionIDs)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, record := range records {
+		e.failStaleRecord(ctx, record, now)
+	}
+	return records, nil
+}
+
+// failStaleRecord 执行进程中途退出时记录会一直停留在运行中, 读取时按超时置为失败
+func (e *EvaluatorCalibrationServiceImpl) failStaleRecord(ctx context.Context, record *entity.EvaluatorCalibrationRecord, now time.Time) {
+	if !record.IsStale(now) {
+		return
+	}
+	record.Status = entity.EvaluatorCalibrationStatus_Failed
+	record.ErrMsg = "evaluator calibration timeout"
+	if err := e.calibrationRepo.UpdateCalibrationResult(ctx, record); err != nil {
+		logs.CtxError(ctx, "[failStaleRecord] mark stale calibration record failed fail, record_id: %v, err: %v", record.ID, err)
+	}
+}
+
+func (e *EvaluatorCalibrationServiceImpl) calibrate(ctx context.Context, param *entity.EvaluatorCalibrationParam) (*entity.EvaluatorCalibrationResult, error) {
+	evaluatorDO, err := e.evaluatorService.GetEvaluatorVersion(ctx, gptr.Of(param.SpaceID), param.EvaluatorVersionID, false, false)
+	if err != nil {
+		return nil, err
+	}
+	if evaluatorDO == nil {
+		return nil, errorx.NewByCode(errno.EvaluatorVersionNotFoundCode)
+	}
+
+	samples, err := e.loadCalibrationSamples(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	if len(samples) == 0 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("evaluation set has no item for calibration"))
+	}
+
+	pool, err := goroutine.NewPool(param.Conf.GetConcurrency())
+	if err != nil {
+		return nil, err
+	}
+	defer pool.Release()
+	for _, sample := range samples {
+		if !sample.groundTruthValid {
+			continue
+		}
+		sample := sample
+		pool.Add(func() error {
+			output, err := e.evaluatorService.DebugEvaluator(ctx, evaluatorDO, sample.input, nil, param.SpaceID)
+			switch {
+			case err != nil:
+				logs.CtxWarn(ctx, "evaluator calibration run fail, item_id: %v, turn_id: %v, err: %v", sample.itemID, sample.turnID, err)
+				sample.errMsg = err.Error()
+			case output == nil:
+				sample.errMsg = "evaluator output is empty"
+			case output.EvaluatorRunError != nil:
+				sample.errMsg = output.EvaluatorRunError.Message
+			case output.EvaluatorResult == nil || output.EvaluatorResult.Score == nil:
+				sample.errMsg = "evaluator output has no score"
+			default:
+				sample.score, sample.scoreOK = *output.EvaluatorResult.Score, true
+				sample.reasoning = output.EvaluatorResult.Reasoning
+			}
+			// 单个样本失败不中断整体校准, 由 checkCalibrationFailRate 统一判定
+			return nil
+		})
+	}
+	if err := pool.ExecAll(ctx); err != nil {
+		return nil, err
+	}
+
+	result := calcCalibrationResult(samples, param.Conf.GetWorstItemCnt())
+	return result, checkCalibrationFailRate(samples, result, param.Conf.GetMaxEvaluatorFailRate())
+}
+
+// checkCalibrationFailRate 评估器运行失败的样本占有效真值样本的比例超过阈值, 或没有任何可比较样本时校准失败
+func checkCalibrationFailRate(samples []*calibrationSample, result *entity.EvaluatorCalibrationResult, maxFailRate float64) error {
+	validCnt := result.ItemCnt - result.InvalidGroundTruthCnt
+	if validCnt == 0 {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("no valid ground truth in evaluation set"))
+	}
+	if result.PairedCnt > 0 && float64(result.EvaluatorFailCnt) <= maxFailRate*float64(validCnt) {
+		return nil
+	}
+	var firstErrMsg string
+	for _, s := range samples {
+		if s.groundTruthValid && !s.scoreOK {
+			firstErrMsg = fmt.Sprintf(", first failure item_id: %v, turn_id: %v, err: %v", s.itemID, s.turnID, s.errMsg)
+			break
+		}
+	}
+	return errorx.New("evaluator failed on %d of %d samples, exceeds max fail rate %v%s", result.EvaluatorFailCnt, validCnt, maxFailRate, firstErrMsg)
+}
+
+// loadCalibrationSamples 分页拉取评测集全部行, 每个 turn 组装评估器输入并解析人工真值
+func (e *EvaluatorCalibrationServiceImpl) loadCalibrationSamples(ctx context.Context, param *entity.EvaluatorCalibrationParam) ([]*calibrationSample, error) {
+	var (
+		samples   []*calibrationSample
+		pageToken *string
+		pageSize  = calibrationItemPageSize
+	)
+	for i := 0; i < calibrationMaxPageLoop; i++ {
+		items, _, _, nextPageToken, err := e.evaluationSetItemService.ListEvaluationSetItems(ctx, &entity.ListEvaluationSetItemsParam{
+			SpaceID:         param.SpaceID,
+			EvaluationSetID: param.EvaluationSetID,
+			VersionID:       param.EvaluationSetVersionID,
+			PageSize:        &pageSize,
+			PageToken:       pageToken,
+			OrderBys:        []*entity.OrderBy{{Field: gptr.Of("item_id"), IsAsc: gptr.Of(true)}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			for _, turn := range item.Turns {
+				sample, err := e.buildCalibrationSample(ctx, param, item, turn)
+				if err != nil {
+					return nil, err
+				}
+				samples = append(samples, sample)
+			}
+		}
+		if len(items) == 0 || gptr.Indirect(nextPageToken) == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return samples, nil
+}
+
+func (e *EvaluatorCalibrationServiceImpl) buildCalibrationSample(ctx context.Context, param *entity.EvaluatorCalibrationParam,
+	item *entity.EvaluationSetItem, turn *entity.Turn,
+) (*calibrationSample, error) {
+	conf := param.Conf
+	fields := make(map[string]*entity.Content, len(turn.FieldDataList))
+	for _, fd := range turn.FieldDataList {
+		if fd == nil || fd.Content == nil {
+			continue
+		}
+		content := fd.Content
+		if content.IsContentOmitted() {
+			full, err := e.evaluationSetItemService.GetEvaluationSetItemField(ctx, &entity.GetEvaluationSetItemFieldParam{
+				SpaceID:         param.SpaceID,
+				EvaluationSetID: item.EvaluationSetID,
+				ItemPK:          item.ItemID,
+				FieldName:       fd.Name,
+				FieldKey:        gptr.Of(fd.Key),
+				TurnID:          gptr.Of(turn.ID),
+			})
+			if err != nil {
+				return nil, err
+			}
+			if full != nil && full.Content != nil {
+				content = full.Content
+			}
+		}
+		fields[fd.Name] = content
+	}
+
+	sample := &calibrationSample{itemID: item.ItemID, turnID: turn.ID}
+	if gt, ok := fields[conf.GroundTruthField]; ok {
+		if v, err := strconv.ParseFloat(strings.TrimSpace(gt.GetText()), 64); err == nil && !math.IsNaN(v) && !math.IsInf(v, 0) {
+			sample.groundTruth, sample.groundTruthValid = v, true
+		}
+	}
+
+	// 真值字段不能泄露给评估器
+	datasetFields := make(map[string]*entity.Content, len(fields))
+	for name, content := range fields {
+		if name != conf.GroundTruthField {
+			datasetFields[name] = content
+		}
+	}
+	input := &entity.EvaluatorInputData{
+		InputFields:           make(map[string]*entity.Content),
+		EvaluateDatasetFields: datasetFields,
+	}
+	if len(conf.FieldConfs) == 0 {
+		for name, content := range datasetFields {
+			input.InputFields[name] = content
+		}
+	}
+	for _, fc := range conf.FieldConfs {
+		if content, ok := datasetFields[fc.FromField]; ok {
+			input.InputFields[fc.FieldName] = content
+		}
+	}
+	if len(conf.TargetOutputFieldConfs) > 0 {
+		input.EvaluateTargetOutputFields = make(map[string]*entity.Content, len(conf.TargetOutputFieldConfs))
+		for _, fc := range conf.TargetOutputFieldConfs {
+			if content, ok := datasetFields[fc.FromField]; ok {
+				input.EvaluateTargetOutputFields[fc.FieldName] = content
+			}
+		}
+	}
+	sample.input = input
+	return sample, nil
+}
+
+// calcCalibrationResult 基于评估器得分与人工真值均有效的样本计算校准指标
+func calcCalibrationResult(samples []*calibrationSample, worstItemCnt int) *entity.EvaluatorCalibrationResult {
+	res := &entity.EvaluatorCalibrationResult{ItemCnt: len(samples)}
+	var (
+		paired        []*calibrationSample
+		scores, truth []float64
+		sumAbsError   float64
+	)
+	for _, s := range samples {
+		switch {
+		case !s.groundTruthValid:
+			res.InvalidGroundTruthCnt++
+		case !s.scoreOK:
+			res.EvaluatorFailCnt++
+		default:
+			paired = append(paired, s)
+			scores = append(scores, s.score)
+			truth = append(truth, s.groundTruth)
+			sumAbsError += math.Abs(s.score - s.groundTruth)
+		}
+	}
+	res.PairedCnt = len(paired)
+	if res.PairedCnt == 0 {
+		return res
+	}
+
+	res.MeanAbsoluteError = gptr.Of(sumAbsError / float64(res.PairedCnt))
+	if r, ok := stats.Pearson(scores, truth); ok {
+		res.PearsonCorrelation = gptr.Of(r)
+	}
+	if rho, ok := stats.Spearman(scores, truth); ok {
+		res.SpearmanCorrelation = gptr.Of(rho)
+	}
+	res.ConfusionMatrix = calcCalibrationConfusionMatrix(paired)
+
+	worst := make([]*entity.CalibrationItemResult, 0, len(paired))
+	for _, s := range paired {
+		absError := math.Abs(s.score - s.groundTruth)
+		if absError == 0 {
+			continue
+		}
+		worst = append(worst, &entity.CalibrationItemResult{
+			ItemID:         s.itemID,
+			TurnID:         s.turnID,
+			GroundTruth:    s.groundTruth,
+			EvaluatorScore: s.score,
+			AbsError:       absError,
+			Reasoning:      s.reasoning,
+		})
+	}
+	sort.SliceStable(worst, func(i, j int) bool {
+		if worst[i].AbsError != worst[j].AbsError {
+			return worst[i].AbsError > worst[j].AbsError
+		}
+		if worst[i].ItemID != worst[j].ItemID {
+			return worst[i].ItemID < worst[j].ItemID
+		}
+		return worst[i].TurnID < worst[j].TurnID
+	})
+	if len(worst) > worstItemCnt {
+		worst = worst[:worstItemCnt]
+	}
+	res.WorstItems = worst
+	return res
+}
+
+// calcCalibrationConfusionMatrix 评估器得分与人工真值的取值并集不超过 CalibrationConfusionMaxLabels 时视为分类输出
+func calcCalibrationConfusionMatrix(paired []*calibrationSample) *entity.CalibrationConfusionMatrix {
+	valueSet := make(map[float64]struct{})
+	for _, s := range paired {
+		valueSet[s.score] = struct{}{}
+		valueSet[s.groundTruth] = struct{}{}
+		if len(valueSet) > entity.CalibrationConfusionMaxLabels {
+			return nil
+		}
+	}
+	values := make([]float64, 0, len(valueSet))
+	for v := range valueSet {
+		values = append(values, v)
+	}
+	sort.Float64s(values)
+
+	index := make(map[float64]int, len(values))
+	matrix := &entity.CalibrationConfusionMatrix{
+		Labels: make([]string, len(values)),
+		Counts: make([][]int64, len(values)),
+	}
+	for i, v := range values {
+		index[v] = i
+		matrix.Labels[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		matrix.Counts[i] = make([]int64, len(values))
+	}
+	var correct int
+	for _, s := range paired {
+		matrix.Counts[index[s.groundTruth]][index[s.score]]++
+		if s.groundTruth == s.score {
+			correct++
+		}
+	}
+	matrix.Accuracy = float64(correct) / float64(len(paired))
+	return matrix
+}
@@ -0,0 +1,343 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	idgenmocks "github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	eventsMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/events/mocks"
+	repoMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+type calibrationTestMocks struct {
+	idgen           *idgenmocks.MockIIDGenerator
+	evaluatorSvc    *svcMocks.MockEvaluatorService
+	evalSetItemSvc  *svcMocks.MockEvaluationSetItemService
+	calibrationRepo *repoMocks.MockIEvaluatorCalibrationRepo
+	publisher       *eventsMocks.MockExptEventPublisher
+}
+
+func newCalibrationTestService(ctrl *gomock.Controller) (EvaluatorCalibrationService, *calibrationTestMocks) {
+	m := &calibrationTestMocks{
+		idgen:           idgenmocks.NewMockIIDGenerator(ctrl),
+		evaluatorSvc:    svcMocks.NewMockEvaluatorService(ctrl),
+		evalSetItemSvc:  svcMocks.NewMockEvaluationSetItemService(ctrl),
+		calibrationRepo: repoMocks.NewMockIEvaluatorCalibrationRepo(ctrl),
+		publisher:       eventsMocks.NewMockExptEventPublisher(ctrl),
+	}
+	return NewEvaluatorCalibrationService(m.idgen, m.evaluatorSvc, m.evalSetItemSvc, m.calibrationRepo, m.publisher), m
+}
+
+func newCalibrationItem(itemID int64, input, answer, label string) *entity.EvaluationSetItem {
+	return &entity.EvaluationSetItem{
+		ItemID:          itemID,
+		EvaluationSetID: 3,
+		Turns: []*entity.Turn{{
+			ID: 1,
+			FieldDataList: []*entity.FieldData{
+				{Name: "input", Content: &entity.Content{Text: gptr.Of(input)}},
+				{Name: "answer", Content: &entity.Content{Text: gptr.Of(answer)}},
+				{Name: "human_score", Content: &entity.Content{Text: gptr.Of(label)}},
+			},
+		}},
+	}
+}
+
+func TestEvaluatorCalibrationServiceImpl_Calibrate(t *testing.T) {
+	const spaceID, evaluatorID, evaluatorVersionID, evalSetID = int64(100), int64(10), int64(11), int64(3)
+	evaluatorDO := &entity.Evaluator{
+		ID:                     evaluatorID,
+		EvaluatorType:          entity.EvaluatorTypePrompt,
+		PromptEvaluatorVersion: &entity.PromptEvaluatorVersion{ID: evaluatorVersionID, EvaluatorID: evaluatorID, Version: "0.0.2"},
+	}
+	newParam := func() *entity.EvaluatorCalibrationParam {
+		return &entity.EvaluatorCalibrationParam{
+			SpaceID:            spaceID,
+			EvaluatorVersionID: evaluatorVersionID,
+			EvaluationSetID:    evalSetID,
+			Conf:               &entity.EvaluatorCalibrationConf{GroundTruthField: "human_score"},
+			CreatedBy:          "u1",
+		}
+	}
+
+	t.Run("创建运行中记录并投递异步事件", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		svc, m := newCalibrationTestService(ctrl)
+
+		m.evaluatorSvc.EXPECT().GetEvaluatorVersion(gomock.Any(), gptr.Of(spaceID), evaluatorVersionID, false, false).Return(evaluatorDO, nil)
+		m.idgen.EXPECT().GenID(gomock.Any()).Return(int64(999), nil)
+		m.calibrationRepo.EXPECT().CreateCalibrationRecord(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, record *entity.EvaluatorCalibrationRecord) error {
+				assert.Equal(t, entity.EvaluatorCalibrationStatus_Running, record.Status)
+				assert.Equal(t, evaluatorID, record.EvaluatorID)
+				assert.Equal(t, "0.0.2", record.EvaluatorVersion)
+				return nil
+			})
+		m.publisher.EXPECT().PublishExptExportCSVEvent(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, event *entity.ExportCSVEvent, _ *time.Duration) error {
+				assert.Equal(t, int64(999), event.ExportID)
+				assert.Equal(t, spaceID, event.SpaceID)
+				assert.Equal(t, entity.ExportSceneEvaluatorCalibration, event.ExportScene)
+				assert.Equal(t, "u1", event.Session.UserID)
+				return nil
+			})
+
+		record, err := svc.Calibrate(context.Background(), newParam())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(999), record.ID)
+		assert.Equal(t, entity.EvaluatorCalibrationStatus_Running, record.Status)
+	})
+
+	t.Run("参数校验", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		svc, m := newCalibrationTestService(ctrl)
+
+		_, err := svc.Calibrate(context.Background(), nil)
+		assert.Error(t, err)
+		param := newParam()
+		param.Conf.GroundTruthField = ""
+		_, err = svc.Calibrate(context.Background(), param)
+		assert.Error(t, err)
+		param = newParam()
+		param.Conf.MaxEvaluatorFailRate = 1.5
+		_, err = svc.Calibrate(context.Background(), param)
+		assert.Error(t, err)
+
+		m.evaluatorSvc.EXPECT().GetEvaluatorVersion(gomock.Any(), gomock.Any(), evaluatorVersionID, false, false).Return(nil, nil)
+		_, err = svc.Calibrate(context.Background(), newParam())
+		assert.Error(t, err)
+	})
+}
+
+func TestEvaluatorCalibrationServiceImpl_HandleCalibrationEvent(t *testing.T) {
+	const spaceID, evaluatorID, evaluatorVersionID, evalSetID, recordID = int64(100), int64(10), int64(11), int64(3), int64(999)
+	evaluatorDO := &entity.Evaluator{
+		ID:                     evaluatorID,
+		EvaluatorType:          entity.EvaluatorTypePrompt,
+		PromptEvaluatorVersion: &entity.PromptEvaluatorVersion{ID: evaluatorVersionID, EvaluatorID: evaluatorID, Version: "0.0.2"},
+	}
+	newRecord := func() *entity.EvaluatorCalibrationRecord {
+		return &entity.EvaluatorCalibrationRecord{
+			ID:                 recordID,
+			SpaceID:            spaceID,
+			EvaluatorID:        evaluatorID,
+			EvaluatorVersionID: evaluatorVersionID,
+			EvaluationSetID:    evalSetID,
+			Status:             entity.EvaluatorCalibrationStatus_Running,
+			Conf: &entity.EvaluatorCalibrationConf{
+				GroundTruthField:       "human_score",
+				FieldConfs:             []*entity.CalibrationFieldConf{{FieldName: "question", FromField: "input"}},
+				TargetOutputFieldConfs: []*entity.CalibrationFieldConf{{FieldName: "actual_output", FromField: "answer"}},
+				WorstItemCnt:           2,
+			},
+			BaseInfo: &entity.BaseInfo{CreatedBy: &entity.UserInfo{UserID: gptr.Of("u1")}},
+		}
+	}
+
+	t.Run("成功", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		svc, m := newCalibrationTestService(ctrl)
+
+		m.calibrationRepo.EXPECT().GetCalibrationRecord(gomock.Any(), spaceID, recordID).Return(newRecord(), nil)
+		m.evaluatorSvc.EXPECT().GetEvaluatorVersion(gomock.Any(), gptr.Of(spaceID), evaluatorVersionID, false, false).Return(evaluatorDO, nil)
+		// 两页: item 1~3 与 item 4~5, item 5 真值非法
+		m.evalSetItemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.ListEvaluationSetItemsParam) ([]*entity.EvaluationSetItem, *int64, *int64, *string, error) {
+				assert.Nil(t, param.PageToken)
+				assert.Nil(t, param.VersionID)
+				return []*entity.EvaluationSetItem{
+					newCalibrationItem(1, "q1", "a1", "1"),
+					newCalibrationItem(2, "q2", "a2", "0"),
+					newCalibrationItem(3, "q3", "a3", "1"),
+				}, gptr.Of(int64(5)), nil, gptr.Of("next"), nil
+			})
+		m.evalSetItemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *entity.ListEvaluationSetItemsParam) ([]*entity.EvaluationSetItem, *int64, *int64, *string, error) {
+				assert.Equal(t, "next", *param.PageToken)
+				return []*entity.EvaluationSetItem{
+					newCalibrationItem(4, "q4", "a4", "0"),
+					newCalibrationItem(5, "q5", "a5", "N/A"),
+				}, gptr.Of(int64(5)), nil, nil, nil
+			})
+		// 评估器: q1->1, q2->1, q3->0, q4 运行失败
+		judge := map[string]float64{"q1": 1, "q2": 1, "q3": 0}
+		m.evaluatorSvc.EXPECT().DebugEvaluator(gomock.Any(), evaluatorDO, gomock.Any(), gomock.Nil(), spaceID).DoAndReturn(
+			func(_ context.Context, _ *entity.Evaluator, input *entity.EvaluatorInputData, _ *entity.EvaluatorRunConfig, _ int64) (*entity.EvaluatorOutputData, error) {
+				assert.NotContains(t, input.InputFields, "human_score")
+				assert.NotContains(t, input.EvaluateDatasetFields, "human_score")
+				assert.Contains(t, input.EvaluateTargetOutputFields, "actual_output")
+				q := input.InputFields["question"].GetText()
+				score, ok := judge[q]
+				if !ok {
+					return nil, errors.New("llm timeout")
+				}
+				return &entity.EvaluatorOutputData{EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(score), Reasoning: "because " + q}}, nil
+			}).Times(4)
+		var saved *entity.EvaluatorCalibrationRecord
+		m.calibrationRepo.EXPECT().UpdateCalibrationResult(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, record *entity.EvaluatorCalibrationRecord) error {
+				saved = record
+				return nil
+			})
+
+		assert.NoError(t, svc.HandleCalibrationEvent(context.Background(), spaceID, recordID))
+		assert.Equal(t, entity.EvaluatorCalibrationStatus_Success, saved.Status)
+
+		res := saved.Result
+		assert.Equal(t, 5, res.ItemCnt)
+		assert.Equal(t, 3, res.PairedCnt)
+		assert.Equal(t, 1, res.EvaluatorFailCnt)
+		assert.Equal(t, 1, res.InvalidGroundTruthCnt)
+		assert.InDelta(t, 2.0/3, *res.MeanAbsoluteError, 1e-9)
+		assert.InDelta(t, -0.5, *res.PearsonCorrelation, 1e-9)
+		assert.InDelta(t, -0.5, *res.SpearmanCorrelation, 1e-9)
+		if assert.NotNil(t, res.ConfusionMatrix) {
+			assert.Equal(t, []string{"0", "1"}, res.ConfusionMatrix.Labels)
+			assert.Equal(t, [][]int64{{0, 1}, {1, 1}}, res.ConfusionMatrix.Counts)
+			assert.InDelta(t, 1.0/3, res.ConfusionMatrix.Accuracy, 1e-9)
+		}
+		if assert.Len(t, res.WorstItems, 2) {
+			assert.Equal(t, int64(2), res.WorstItems[0].ItemID)
+			assert.Equal(t, "because q2", res.WorstItems[0].Reasoning)
+			assert.Equal(t, int64(3), res.WorstItems[1].ItemID)
+		}
+	})
+
+	t.Run("评估器全部失败时记录失败并保留结果", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		svc, m := newCalibrationTestService(ctrl)
+
+		m.calibrationRepo.EXPECT().GetCalibrationRecord(gomock.Any(), spaceID, recordID).Return(newRecord(), nil)
+		m.evaluatorSvc.EXPECT().GetEvaluatorVersion(gomock.Any(), gomock.Any(), evaluatorVersionID, false, false).Return(evaluatorDO, nil)
+		m.evalSetItemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).Return([]*entity.EvaluationSetItem{
+			newCalibrationItem(1, "q1", "a1", "1"),
+			newCalibrationItem(2, "q2", "a2", "0"),
+		}, gptr.Of(int64(2)), nil, nil, nil)
+		m.evaluatorSvc.EXPECT().DebugEvaluator(gomock.Any(), evaluatorDO, gomock.Any(), gomock.Nil(), spaceID).
+			Return(&entity.EvaluatorOutputData{EvaluatorRunError: &entity.EvaluatorRunError{Message: "model quota exceeded"}}, nil).Times(2)
+		m.calibrationRepo.EXPECT().UpdateCalibrationResult(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, record *entity.EvaluatorCalibrationRecord) error {
+				assert.Equal(t, entity.EvaluatorCalibrationStatus_Failed, record.Status)
+				assert.Contains(t, record.ErrMsg, "model quota exceeded")
+				if assert.NotNil(t, record.Result) {
+					assert.Equal(t, 2, record.Result.EvaluatorFailCnt)
+				}
+				return nil
+			})
+
+		assert.NoError(t, svc.HandleCalibrationEvent(context.Background(), spaceID, recordID))
+	})
+
+	t.Run("评估集为空时记录失败", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		svc, m := newCalibrationTestService(ctrl)
+
+		m.calibrationRepo.EXPECT().GetCalibrationRecord(gomock.Any(), spaceID, recordID).Return(newRecord(), nil)
+		m.evaluatorSvc.EXPECT().GetEvaluatorVersion(gomock.Any(), gomock.Any(), evaluatorVersionID, false, false).Return(evaluatorDO, nil)
+		m.evalSetItemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).Return(nil, gptr.Of(int64(0)), nil, nil, nil)
+		m.calibrationRepo.EXPECT().UpdateCalibrationResult(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, record *entity.EvaluatorCalibrationRecord) error {
+				assert.Equal(t, entity.EvaluatorCalibrationStatus_Failed, record.Status)
+				assert.NotEmpty(t, record.ErrMsg)
+				return nil
+			})
+
+		assert.NoError(t, svc.HandleCalibrationEvent(context.Background(), spaceID, recordID))
+	})
+
+	t.Run("已结束的记录跳过", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		svc, m := newCalibrationTestService(ctrl)
+
+		record := newRecord()
+		record.Status = entity.EvaluatorCalibrationStatus_Success
+		m.calibrationRepo.EXPECT().GetCalibrationRecord(gomock.Any(), spaceID, recordID).Return(record, nil)
+
+		assert.NoError(t, svc.HandleCalibrationEvent(context.Background(), spaceID, recordID))
+	})
+}
+
+func TestEvaluatorCalibrationServiceImpl_GetCalibrationRecord(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc, m := newCalibrationTestService(ctrl)
+
+	stale := &entity.EvaluatorCalibrationRecord{
+		ID:       1,
+		SpaceID:  100,
+		Status:   entity.EvaluatorCalibrationStatus_Running,
+		BaseInfo: &entity.BaseInfo{CreatedAt: gptr.Of(time.Now().Add(-entity.EvaluatorCalibrationRunningTimeout - time.Minute).UnixMilli())},
+	}
+	m.calibrationRepo.EXPECT().GetCalibrationRecord(gomock.Any(), int64(100), int64(1)).Return(stale, nil)
+	m.calibrationRepo.EXPECT().UpdateCalibrationResult(gomock.Any(), gomock.Any()).Return(nil)
+
+	record, err := svc.GetCalibrationRecord(context.Background(), 100, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.EvaluatorCalibrationStatus_Failed, record.Status)
+
+	m.calibrationRepo.EXPECT().GetCalibrationRecord(gomock.Any(), int64(100), int64(2)).Return(nil, nil)
+	_, err = svc.GetCalibrationRecord(context.Background(), 100, 2)
+	assert.Error(t, err)
+}
+
+func TestCalcCalibrationResult(t *testing.T) {
+	t.Run("连续得分不生成混淆矩阵", func(t *testing.T) {
+		var samples []*calibrationSample
+		for i := 0; i < 12; i++ {
+			samples = append(samples, &calibrationSample{
+				itemID: int64(i + 1), groundTruth: float64(i), groundTruthValid: true,
+				score: float64(i) + 0.5, scoreOK: true,
+			})
+		}
+		res := calcCalibrationResult(samples, 3)
+		assert.Equal(t, 12, res.PairedCnt)
+		assert.Nil(t, res.ConfusionMatrix)
+		assert.InDelta(t, 0.5, *res.MeanAbsoluteError, 1e-9)
+		assert.InDelta(t, 1, *res.PearsonCorrelation, 1e-9)
+		assert.InDelta(t, 1, *res.SpearmanCorrelation, 1e-9)
+		if assert.Len(t, res.WorstItems, 3) {
+			// 误差相同按 item_id 升序
+			for i, w := range res.WorstItems {
+				assert.Equal(t, int64(i+1), w.ItemID)
+			}
+		}
+	})
+
+	t.Run("无有效样本", func(t *testing.T) {
+		res := calcCalibrationResult([]*calibrationSample{{groundTruthValid: true}}, 3)
+		assert.Equal(t, 0, res.PairedCnt)
+		assert.Equal(t, 1, res.EvaluatorFailCnt)
+		assert.Nil(t, res.MeanAbsoluteError)
+	})
+
+	t.Run("失败比例阈值", func(t *testing.T) {
+		samples := []*calibrationSample{
+			{itemID: 1, groundTruthValid: true, scoreOK: true},
+			{itemID: 2, groundTruthValid: true, errMsg: "timeout"},
+			{itemID: 3, groundTruthValid: true, scoreOK: true},
+		}
+		res := calcCalibrationResult(samples, 3)
+		assert.NoError(t, checkCalibrationFailRate(samples, res, 0.5))
+		err := checkCalibrationFailRate(samples, res, 0.2)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "timeout")
+		}
+	})
+}
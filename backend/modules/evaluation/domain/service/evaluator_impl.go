@@ -526,6 +526,18 @@ func (e *EvaluatorServiceImpl) validateCreateEvaluatorRequest(ctx context.Contex
 		if evaluator.AgentEvaluatorVersion == nil {
 			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("agent evaluator version is required"))
 		}
+	case entity.EvaluatorTypePairwise:
+		if evaluator.PairwiseEvaluatorVersion == nil {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("pairwise evaluator version is required"))
+		}
+	case entity.EvaluatorTypeRule:
+		if evaluator.RuleEvaluatorVersion == nil {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("rule evaluator version is required"))
+		}
+	case entity.EvaluatorTypeComposite:
+		if evaluator.CompositeEvaluatorVersion == nil {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("composite evaluator version is required"))
+		}
 	}
 	return nil
 }
@@ -864,6 +876,9 @@ func (e *EvaluatorServiceImpl) RunEvaluator(ctx context.Context, request *entity
 	if err != nil {
 		return nil, err
 	}
+	if outputData != nil && outputData.CompositeResult != nil {
+		e.createCompositeComponentRecords(ctx, request, recordID, outputData.CompositeResult)
+	}
 	userIDInContext := session.UserIDInCtxOrEmpty(ctx)
 	logID := logs.GetLogID(ctx)
 	recordDO := &entity.EvaluatorRecord{
@@ -902,6 +917,46 @@ func (e *EvaluatorServiceImpl) RunEvaluator(ctx context.Context, request *entity
 	return recordDO, nil
 }
 
+// createCompositeComponentRecords 将组合评估器各组件的运行结果落库为独立记录并回填 RecordID;
+// 组件记录不挂到 turn 结果上, 实验结果与聚合中只出现组合评估器这一列。单个组件记录写入失败不影响组合结果
+func (e *EvaluatorServiceImpl) createCompositeComponentRecords(ctx context.Context, request *entity.RunEvaluatorRequest, compositeRecordID int64, compositeResult *entity.CompositeEvaluatorResult) {
+	userIDInContext := session.UserIDInCtxOrEmpty(ctx)
+	for _, component := range compositeResult.Components {
+		if component == nil {
+			continue
+		}
+		recordID, err := e.idgen.GenID(ctx)
+		if err != nil {
+			logs.CtxWarn(ctx, "[RunEvaluator] gen composite component record id fail, evaluatorVersionID: %d, err: %v", component.EvaluatorVersionID, err)
+			continue
+		}
+		componentRecord := &entity.EvaluatorRecord{
+			ID:                  recordID,
+			SpaceID:             request.SpaceID,
+			ExperimentID:        request.ExperimentID,
+			ExperimentRunID:     request.ExperimentRunID,
+			ItemID:              request.ItemID,
+			TurnID:              request.TurnID,
+			EvaluatorVersionID:  component.EvaluatorVersionID,
+			SourceType:          entity.EvaluatorRecordSourceTypeCompositeComponent,
+			TraceID:             component.TraceID,
+			LogID:               logs.GetLogID(ctx),
+			EvaluatorInputData:  request.InputData,
+			EvaluatorOutputData: component.Output,
+			Status:              component.Status,
+			Ext:                 map[string]string{entity.EvaluatorRecordExtKeyCompositeRecordID: strconv.FormatInt(compositeRecordID, 10)},
+			BaseInfo: &entity.BaseInfo{
+				CreatedBy: &entity.UserInfo{UserID: gptr.Of(userIDInContext)},
+			},
+		}
+		if err := e.evaluatorRecordRepo.CreateEvaluatorRecord(ctx, componentRecord); err != nil {
+			logs.CtxWarn(ctx, "[RunEvaluator] create composite component record fail, evaluatorVersionID: %d, err: %v", component.EvaluatorVersionID, err)
+			continue
+		}
+		component.RecordID = recordID
+	}
+}
+
 // CreateEvaluatorRunFailRecord creates a failed evaluator record for an evaluator run attempt that failed
 // before RunEvaluator/AsyncRunEvaluator could persist its normal record. This keeps experiment turn results
 // complete and preserves the original evaluator-level failure reason for users.
@@ -5096,3 +5096,93 @@ func TestEvaluatorServiceImpl_publishEvaluatorResumeEvent(t *testing.T) {
 		require.ErrorIs(t, err, context.Canceled)
 	})
 }
+
+func TestEvaluatorServiceImpl_RunEvaluator_CompositeComponentRecords(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockEvaluatorRepo := repomocks.NewMockIEvaluatorRepo(ctrl)
+	mockLimiter := repomocks.NewMockRateLimiter(ctrl)
+	mockIDGen := idgenmocks.NewMockIIDGenerator(ctrl)
+	mockEvaluatorRecordRepo := repomocks.NewMockIEvaluatorRecordRepo(ctrl)
+	mockEvaluatorSourceService := mocks.NewMockEvaluatorSourceService(ctrl)
+	mockPlainLimiter := repomocks.NewMockIPlainRateLimiter(ctrl)
+
+	req := &entity.RunEvaluatorRequest{
+		SpaceID:            1,
+		ExperimentID:       7,
+		ItemID:             8,
+		TurnID:             9,
+		EvaluatorVersionID: 401,
+		InputData:          &entity.EvaluatorInputData{},
+	}
+	evaluatorDO := &entity.Evaluator{
+		ID:                        400,
+		SpaceID:                   1,
+		EvaluatorType:             entity.EvaluatorTypeComposite,
+		CompositeEvaluatorVersion: &entity.CompositeEvaluatorVersion{ID: 401, EvaluatorID: 400, SpaceID: 1},
+	}
+	componentOutput := &entity.EvaluatorOutputData{EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(1.0)}}
+	outputData := &entity.EvaluatorOutputData{
+		EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(0.5)},
+		CompositeResult: &entity.CompositeEvaluatorResult{
+			Strategy: entity.CompositeStrategyMean,
+			Components: []*entity.CompositeComponentResult{
+				{EvaluatorVersionID: 11, Status: entity.EvaluatorRunStatusSuccess, Score: gptr.Of(1.0), Output: componentOutput, TraceID: "trace-11"},
+				{EvaluatorVersionID: 12, Status: entity.EvaluatorRunStatusSuccess, Score: gptr.Of(0.0), Output: &entity.EvaluatorOutputData{}},
+			},
+		},
+	}
+
+	s := &EvaluatorServiceImpl{
+		evaluatorRepo:       mockEvaluatorRepo,
+		limiter:             mockLimiter,
+		idgen:               mockIDGen,
+		evaluatorRecordRepo: mockEvaluatorRecordRepo,
+		evaluatorSourceServices: map[entity.EvaluatorType]EvaluatorSourceService{
+			entity.EvaluatorTypeComposite: mockEvaluatorSourceService,
+		},
+		plainRateLimiter: mockPlainLimiter,
+	}
+
+	mockEvaluatorRepo.EXPECT().BatchGetEvaluatorByVersionID(gomock.Any(), nil, []int64{401}, false, false).Return([]*entity.Evaluator{evaluatorDO}, nil)
+	mockLimiter.EXPECT().AllowInvoke(gomock.Any(), req.SpaceID).Return(true)
+	mockPlainLimiter.EXPECT().AllowInvokeWithKeyLimit(gomock.Any(), "run_evaluator:400", gomock.Any()).Return(true)
+	mockEvaluatorSourceService.EXPECT().PreHandle(gomock.Any(), evaluatorDO).Return(nil)
+	mockEvaluatorSourceService.EXPECT().Run(gomock.Any(), evaluatorDO, req.InputData, req.EvaluatorRunConf, req.SpaceID, req.DisableTracing).
+		Return(outputData, entity.EvaluatorRunStatusSuccess, "trace-composite")
+	gomock.InOrder(
+		mockIDGen.EXPECT().GenID(gomock.Any()).Return(int64(1000), nil),
+		mockIDGen.EXPECT().GenID(gomock.Any()).Return(int64(1001), nil),
+		mockIDGen.EXPECT().GenID(gomock.Any()).Return(int64(1002), nil),
+	)
+	var created []*entity.EvaluatorRecord
+	mockEvaluatorRecordRepo.EXPECT().CreateEvaluatorRecord(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, record *entity.EvaluatorRecord) error {
+			created = append(created, record)
+			if record.ID == 1002 {
+				return errors.New("db error")
+			}
+			return nil
+		}).Times(3)
+
+	record, err := s.RunEvaluator(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, created, 3)
+
+	component := created[0]
+	assert.Equal(t, int64(1001), component.ID)
+	assert.Equal(t, int64(11), component.EvaluatorVersionID)
+	assert.Equal(t, entity.EvaluatorRecordSourceTypeCompositeComponent, component.SourceType)
+	assert.Equal(t, int64(9), component.TurnID)
+	assert.Equal(t, "trace-11", component.TraceID)
+	assert.Equal(t, componentOutput, component.EvaluatorOutputData)
+	assert.Equal(t, map[string]string{entity.EvaluatorRecordExtKeyCompositeRecordID: "1000"}, component.Ext)
+
+	assert.Equal(t, int64(1000), record.ID)
+	assert.Equal(t, int64(401), record.EvaluatorVersionID)
+	assert.Equal(t, entity.EvaluatorRecordSourceTypeBuiltin, record.SourceType)
+	assert.Equal(t, int64(1001), record.EvaluatorOutputData.CompositeResult.Components[0].RecordID)
+	assert.Zero(t, record.EvaluatorOutputData.CompositeResult.Components[1].RecordID, "组件记录写入失败时不回填")
+}
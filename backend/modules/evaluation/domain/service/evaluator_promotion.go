@@ -0,0 +1,24 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination ./mocks/evaluator_promotion.go --package mocks . EvaluatorPromotionService
+type EvaluatorPromotionService interface {
+	// SavePromotionPolicy 写入评估器的版本晋级策略, 每个评估器一条, 重复写入覆盖
+	SavePromotionPolicy(ctx context.Context, policy *entity.EvaluatorPromotionPolicy) error
+	// GetPromotionPolicy 未配置时返回 nil
+	GetPromotionPolicy(ctx context.Context, spaceID, evaluatorID int64) (*entity.EvaluatorPromotionPolicy, error)
+	// CheckPromotion 在基线版本 (默认评估器最新版本) 最近的历史记录输入上回放草稿, 报告得分漂移、翻转率及耗时/token 变化。
+	// conf 为空时使用评估器已配置的策略, 均未配置时只出报告不判定阈值。草稿本身不会被修改。
+	CheckPromotion(ctx context.Context, draft *entity.Evaluator, conf *entity.EvaluatorPromotionConf) (*entity.EvaluatorPromotionReport, error)
+	// GuardSubmit 提交版本前执行回归检查: conf 非空时按 conf 检查, 否则按评估器策略检查, 策略未配置或未开启时返回 nil;
+	// 结论为阻断时返回 EvaluatorPromotionBlockedCode 错误, 告警时只记日志不影响提交
+	GuardSubmit(ctx context.Context, draft *entity.Evaluator, conf *entity.EvaluatorPromotionConf) (*entity.EvaluatorPromotionReport, error)
+}
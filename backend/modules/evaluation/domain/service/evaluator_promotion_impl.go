@@ -0,0 +1,382 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/goroutine"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// EvaluatorPromotionServiceImpl 直接使用评估器源服务回放草稿, 不经 EvaluatorService, 以便 EvaluatorService 提交版本时调用
+type EvaluatorPromotionServiceImpl struct {
+	idgen                   idgen.IIDGenerator
+	evaluatorRepo           repo.IEvaluatorRepo
+	evaluatorRecordRepo     repo.IEvaluatorRecordRepo
+	promotionPolicyRepo     repo.IEvaluatorPromotionPolicyRepo
+	evaluatorSourceServices map[entity.EvaluatorType]EvaluatorSourceService
+}
+
+func NewEvaluatorPromotionService(
+	idgen idgen.IIDGenerator,
+	evaluatorRepo repo.IEvaluatorRepo,
+	evaluatorRecordRepo repo.IEvaluatorRecordRepo,
+	promotionPolicyRepo repo.IEvaluatorPromotionPolicyRepo,
+	evaluatorSourceServices map[entity.EvaluatorType]EvaluatorSourceService,
+) EvaluatorPromotionService {
+	return &EvaluatorPromotionServiceImpl{
+		idgen:                   idgen,
+		evaluatorRepo:           evaluatorRepo,
+		evaluatorRecordRepo:     evaluatorRecordRepo,
+		promotionPolicyRepo:     promotionPolicyRepo,
+		evaluatorSourceServices: evaluatorSourceServices,
+	}
+}
+
+// promotionSample 单条历史记录的回放样本
+type promotionSample struct {
+	recordID, itemID, turnID int64
+	input                    *entity.EvaluatorInputData
+
+	baseScore     float64
+	baseLatencyMS int64
+	baseTokens    int64
+
+	draftOK        bool
+	draftScore     float64
+	draftLatencyMS int64
+	draftTokens    int64
+	draftReasoning string
+}
+
+func (e *EvaluatorPromotionServiceImpl) SavePromotionPolicy(ctx context.Context, policy *entity.EvaluatorPromotionPolicy) error {
+	if policy == nil || policy.SpaceID <= 0 || policy.EvaluatorID <= 0 {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid evaluator promotion policy"))
+	}
+	if err := policy.Conf.Validate(); err != nil {
+		return err
+	}
+	if policy.ID == 0 {
+		id, err := e.idgen.GenID(ctx)
+		if err != nil {
+			return err
+		}
+		policy.ID = id
+	}
+	userID := session.UserIDInCtxOrEmpty(ctx)
+	policy.BaseInfo = &entity.BaseInfo{
+		CreatedBy: &entity.UserInfo{UserID: gptr.Of(userID)},
+		UpdatedBy: &entity.UserInfo{UserID: gptr.Of(userID)},
+	}
+	return e.promotionPolicyRepo.SavePromotionPolicy(ctx, policy)
+}
+
+func (e *EvaluatorPromotionServiceImpl) GetPromotionPolicy(ctx context.Context, spaceID, evaluatorID int64) (*entity.EvaluatorPromotionPolicy, error) {
+	return e.promotionPolicyRepo.GetPromotionPolicy(ctx, spaceID, evaluatorID)
+}
+
+func (e *EvaluatorPromotionServiceImpl) CheckPromotion(ctx context.Context, draft *entity.Evaluator, conf *entity.EvaluatorPromotionConf) (*entity.EvaluatorPromotionReport, error) {
+	if draft == nil || draft.ID <= 0 {
+		return nil, errorx.NewByCode(errno.EvaluatorNotExistCode)
+	}
+	if conf == nil {
+		policy, err := e.promotionPolicyRepo.GetPromotionPolicy(ctx, draft.SpaceID, draft.ID)
+		if err != nil {
+			return nil, err
+		}
+		if policy != nil {
+			conf = policy.Conf
+		}
+	}
+	return e.checkPromotion(ctx, draft, conf)
+}
+
+func (e *EvaluatorPromotionServiceImpl) GuardSubmit(ctx context.Context, draft *entity.Evaluator, conf *entity.EvaluatorPromotionConf) (*entity.EvaluatorPromotionReport, error) {
+	if draft == nil {
+		return nil, nil
+	}
+	if conf != nil {
+		if err := conf.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	// 已保存的策略始终生效, 请求级配置只能在其基础上收紧
+	policy, err := e.promotionPolicyRepo.GetPromotionPolicy(ctx, draft.SpaceID, draft.ID)
+	if err != nil {
+		return nil, err
+	}
+	var policyConf *entity.EvaluatorPromotionConf
+	if policy != nil && policy.Conf != nil && policy.Conf.Enabled {
+		policyConf = policy.Conf
+	}
+	conf = policyConf.Tighten(conf)
+	if conf == nil || !conf.Enabled {
+		return nil, nil
+	}
+	report, err := e.checkPromotion(ctx, draft, conf)
+	if err != nil {
+		// 仅告警的策略不因检查本身出错而影响提交
+		if conf.Action != entity.EvaluatorPromotionAction_Block {
+			logs.CtxWarn(ctx, "evaluator promotion check fail, evaluator_id: %v, err: %v", draft.ID, err)
+			return nil, nil
+		}
+		return nil, err
+	}
+	switch report.Verdict {
+	case entity.EvaluatorPromotionVerdict_Block:
+		return report, errorx.NewByCode(errno.EvaluatorPromotionBlockedCode, errorx.WithExtraMsg(report.Summary()))
+	case entity.EvaluatorPromotionVerdict_Warn:
+		logs.CtxWarn(ctx, "evaluator promotion check warn, evaluator_id: %v, %s", draft.ID, report.Summary())
+	default:
+	}
+	return report, nil
+}
+
+func (e *EvaluatorPromotionServiceImpl) checkPromotion(ctx context.Context, draft *entity.Evaluator, conf *entity.EvaluatorPromotionConf) (*entity.EvaluatorPromotionReport, error) {
+	report := &entity.EvaluatorPromotionReport{
+		EvaluatorID: draft.ID,
+		Verdict:     entity.EvaluatorPromotionVerdict_Skipped,
+	}
+	baseVersion := draft.LatestVersion
+	if conf != nil && conf.BaseVersion != "" {
+		baseVersion = conf.BaseVersion
+	}
+	// 首个版本无基线; 异步评估器无法同步回放
+	if baseVersion == "" || draft.IsAsync() {
+		return report, nil
+	}
+	sourceService, ok := e.evaluatorSourceServices[draft.EvaluatorType]
+	if !ok {
+		return nil, errorx.NewByCode(errno.InvalidEvaluatorTypeCode)
+	}
+
+	bases, err := e.evaluatorRepo.BatchGetEvaluatorVersionsByEvaluatorIDAndVersions(ctx, [][2]interface{}{{draft.ID, baseVersion}})
+	if err != nil {
+		return nil, err
+	}
+	if len(bases) == 0 {
+		// 显式指定的基线版本不存在视为参数错误, 默认基线缺失时跳过检查
+		if conf != nil && conf.BaseVersion != "" {
+			return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("base version %s not found", conf.BaseVersion)))
+		}
+		return report, nil
+	}
+	report.BaseVersionID = bases[0].GetEvaluatorVersionID()
+	report.BaseVersion = bases[0].GetVersion()
+
+	records, err := e.evaluatorRecordRepo.ListSuccessEvaluatorRecordByVersionID(ctx, draft.SpaceID, report.BaseVersionID, conf.GetSampleSize())
+	if err != nil {
+		return nil, err
+	}
+	samples := buildPromotionSamples(records)
+	if len(samples) == 0 {
+		return report, nil
+	}
+
+	// PreHandle 会注入工具等运行时配置, 在副本上回放, 避免写入提交的版本
+	replayDO, err := copyEvaluatorForReplay(draft)
+	if err != nil {
+		return nil, err
+	}
+	if err := sourceService.PreHandle(ctx, replayDO); err != nil {
+		return nil, err
+	}
+
+	pool, err := goroutine.NewPool(conf.GetConcurrency())
+	if err != nil {
+		return nil, err
+	}
+	defer pool.Release()
+	for _, sample := range samples {
+		sample := sample
+		pool.Add(func() error {
+			startTime := time.Now()
+			output, runStatus, _ := sourceService.Run(ctx, replayDO, deepCopyEvaluatorInputData(sample.input), nil, draft.SpaceID, true)
+			if runStatus != entity.EvaluatorRunStatusSuccess || output == nil || output.EvaluatorResult == nil || output.EvaluatorResult.Score == nil {
+				logs.CtxWarn(ctx, "evaluator promotion replay fail, evaluator_id: %v, record_id: %v, status: %v", draft.ID, sample.recordID, runStatus)
+				return nil
+			}
+			roundEvaluatorOutputScore(output)
+			sample.draftOK = true
+			sample.draftScore = *output.EvaluatorResult.Score
+			sample.draftReasoning = output.EvaluatorResult.Reasoning
+			sample.draftLatencyMS = output.TimeConsumingMS
+			if sample.draftLatencyMS <= 0 {
+				sample.draftLatencyMS = time.Since(startTime).Milliseconds()
+			}
+			sample.draftTokens = evaluatorUsageTokens(output.EvaluatorUsage)
+			return nil
+		})
+	}
+	if err := pool.ExecAll(ctx); err != nil {
+		return nil, err
+	}
+
+	calcPromotionReport(report, samples, conf)
+	return report, nil
+}
+
+// buildPromotionSamples 只保留输入与原始得分都完整的记录, 人工修正分不参与比较
+func buildPromotionSamples(records []*entity.EvaluatorRecord) []*promotionSample {
+	samples := make([]*promotionSample, 0, len(records))
+	for _, record := range records {
+		if record == nil || record.EvaluatorInputData == nil || record.EvaluatorOutputData == nil ||
+			record.EvaluatorOutputData.EvaluatorResult == nil || record.EvaluatorOutputData.EvaluatorResult.Score == nil {
+			continue
+		}
+		samples = append(samples, &promotionSample{
+			recordID:      record.ID,
+			itemID:        record.ItemID,
+			turnID:        record.TurnID,
+			input:         record.EvaluatorInputData,
+			baseScore:     *record.EvaluatorOutputData.EvaluatorResult.Score,
+			baseLatencyMS: record.EvaluatorOutputData.TimeConsumingMS,
+			baseTokens:    evaluatorUsageTokens(record.EvaluatorOutputData.EvaluatorUsage),
+		})
+	}
+	return samples
+}
+
+func evaluatorUsageTokens(usage *entity.EvaluatorUsage) int64 {
+	if usage == nil {
+		return 0
+	}
+	return usage.InputTokens + usage.OutputTokens
+}
+
+func copyEvaluatorForReplay(evaluatorDO *entity.Evaluator) (*entity.Evaluator, error) {
+	b, err := json.Marshal(evaluatorDO)
+	if err != nil {
+		return nil, err
+	}
+	out := &entity.Evaluator{}
+	if err := json.Unmarshal(b, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// calcPromotionReport 得分、耗时与 token 指标均只在草稿回放成功的样本上比较, 耗时只取两侧都有记录的样本
+func calcPromotionReport(report *entity.EvaluatorPromotionReport, samples []*promotionSample, conf *entity.EvaluatorPromotionConf) {
+	report.SampleCnt = len(samples)
+
+	var sumDrift, sumAbsDrift float64
+	var baseLatency, draftLatency, baseTokens, draftTokens int64
+	latencyCnt := 0
+	items := make([]*entity.EvaluatorPromotionItemResult, 0, len(samples))
+	for _, s := range samples {
+		if !s.draftOK {
+			report.DraftFailCnt++
+			continue
+		}
+		report.PairedCnt++
+		drift := s.draftScore - s.baseScore
+		absDrift := math.Abs(drift)
+		sumDrift += drift
+		sumAbsDrift += absDrift
+		report.MaxAbsDrift = math.Max(report.MaxAbsDrift, absDrift)
+		flipped := conf.IsFlipped(s.baseScore, s.draftScore)
+		if flipped {
+			report.FlipCnt++
+		}
+		if s.baseLatencyMS > 0 && s.draftLatencyMS > 0 {
+			latencyCnt++
+			baseLatency += s.baseLatencyMS
+			draftLatency += s.draftLatencyMS
+		}
+		baseTokens += s.baseTokens
+		draftTokens += s.draftTokens
+		items = append(items, &entity.EvaluatorPromotionItemResult{
+			RecordID:       s.recordID,
+			ItemID:         s.itemID,
+			TurnID:         s.turnID,
+			BaseScore:      s.baseScore,
+			DraftScore:     s.draftScore,
+			Drift:          drift,
+			Flipped:        flipped,
+			DraftReasoning: s.draftReasoning,
+		})
+	}
+
+	if report.PairedCnt > 0 {
+		paired := float64(report.PairedCnt)
+		report.MeanDrift = sumDrift / paired
+		report.MeanAbsDrift = sumAbsDrift / paired
+		report.FlipRate = float64(report.FlipCnt) / paired
+		report.BaseAvgTokens = float64(baseTokens) / paired
+		report.DraftAvgTokens = float64(draftTokens) / paired
+		report.TokenChangeRatio = promotionChangeRatio(report.BaseAvgTokens, report.DraftAvgTokens)
+	}
+	if latencyCnt > 0 {
+		report.BaseAvgLatencyMS = float64(baseLatency) / float64(latencyCnt)
+		report.DraftAvgLatencyMS = float64(draftLatency) / float64(latencyCnt)
+		report.LatencyChangeRatio = promotionChangeRatio(report.BaseAvgLatencyMS, report.DraftAvgLatencyMS)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		ai, aj := math.Abs(items[i].Drift), math.Abs(items[j].Drift)
+		if ai != aj {
+			return ai > aj
+		}
+		return items[i].RecordID > items[j].RecordID
+	})
+	if len(items) > entity.DefaultPromotionDriftItemCnt {
+		items = items[:entity.DefaultPromotionDriftItemCnt]
+	}
+	report.DriftItems = items
+
+	report.Violations = checkPromotionViolations(report, conf)
+	switch {
+	case len(report.Violations) == 0:
+		report.Verdict = entity.EvaluatorPromotionVerdict_Pass
+	case conf != nil && conf.Action == entity.EvaluatorPromotionAction_Block:
+		report.Verdict = entity.EvaluatorPromotionVerdict_Block
+	default:
+		report.Verdict = entity.EvaluatorPromotionVerdict_Warn
+	}
+}
+
+func promotionChangeRatio(base, draft float64) *float64 {
+	if base <= 0 {
+		return nil
+	}
+	return gptr.Of((draft - base) / base)
+}
+
+func checkPromotionViolations(report *entity.EvaluatorPromotionReport, conf *entity.EvaluatorPromotionConf) []*entity.EvaluatorPromotionViolation {
+	if conf == nil {
+		return nil
+	}
+	var violations []*entity.EvaluatorPromotionViolation
+	check := func(metric string, value *float64, threshold *float64) {
+		if value == nil || threshold == nil || *value <= *threshold {
+			return
+		}
+		violations = append(violations, &entity.EvaluatorPromotionViolation{Metric: metric, Value: *value, Threshold: *threshold})
+	}
+	if report.SampleCnt > 0 {
+		check(entity.PromotionMetricDraftFailRate, gptr.Of(float64(report.DraftFailCnt)/float64(report.SampleCnt)), conf.MaxDraftFailRate)
+	}
+	if report.PairedCnt > 0 {
+		check(entity.PromotionMetricMeanAbsDrift, gptr.Of(report.MeanAbsDrift), conf.MaxMeanAbsDrift)
+		check(entity.PromotionMetricFlipRate, gptr.Of(report.FlipRate), conf.MaxFlipRate)
+	}
+	check(entity.PromotionMetricLatencyIncrease, report.LatencyChangeRatio, conf.MaxLatencyIncreaseRatio)
+	check(entity.PromotionMetricTokenIncrease, report.TokenChangeRatio, conf.MaxTokenIncreaseRatio)
+	return violations
+}
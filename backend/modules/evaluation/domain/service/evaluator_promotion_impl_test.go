@@ -0,0 +1,325 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	idgenmocks "github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repoMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+type promotionTestMocks struct {
+	idgen         *idgenmocks.MockIIDGenerator
+	evaluatorRepo *repoMocks.MockIEvaluatorRepo
+	recordRepo    *repoMocks.MockIEvaluatorRecordRepo
+	policyRepo    *repoMocks.MockIEvaluatorPromotionPolicyRepo
+	sourceSvc     *svcMocks.MockEvaluatorSourceService
+}
+
+func newPromotionTestService(ctrl *gomock.Controller) (EvaluatorPromotionService, *promotionTestMocks) {
+	m := &promotionTestMocks{
+		idgen:         idgenmocks.NewMockIIDGenerator(ctrl),
+		evaluatorRepo: repoMocks.NewMockIEvaluatorRepo(ctrl),
+		recordRepo:    repoMocks.NewMockIEvaluatorRecordRepo(ctrl),
+		policyRepo:    repoMocks.NewMockIEvaluatorPromotionPolicyRepo(ctrl),
+		sourceSvc:     svcMocks.NewMockEvaluatorSourceService(ctrl),
+	}
+	return NewEvaluatorPromotionService(m.idgen, m.evaluatorRepo, m.recordRepo, m.policyRepo,
+		map[entity.EvaluatorType]EvaluatorSourceService{entity.EvaluatorTypePrompt: m.sourceSvc}), m
+}
+
+func newPromotionDraft() *entity.Evaluator {
+	return &entity.Evaluator{
+		ID:                     10,
+		SpaceID:                100,
+		Name:                   "judge",
+		EvaluatorType:          entity.EvaluatorTypePrompt,
+		LatestVersion:          "0.0.1",
+		PromptEvaluatorVersion: &entity.PromptEvaluatorVersion{},
+	}
+}
+
+func newPromotionRecord(id int64, q string, score float64, latencyMS, tokens int64) *entity.EvaluatorRecord {
+	return &entity.EvaluatorRecord{
+		ID:                 id,
+		SpaceID:            100,
+		EvaluatorVersionID: 11,
+		ItemID:             id * 10,
+		EvaluatorInputData: &entity.EvaluatorInputData{InputFields: map[string]*entity.Content{"q": {Text: gptr.Of(q)}}},
+		EvaluatorOutputData: &entity.EvaluatorOutputData{
+			EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(score)},
+			EvaluatorUsage:  &entity.EvaluatorUsage{InputTokens: tokens},
+			TimeConsumingMS: latencyMS,
+		},
+		Status: entity.EvaluatorRunStatusSuccess,
+	}
+}
+
+// expectPromotionReplay 上一版本 3 条历史记录: q1 1->1, q2 1->0 (翻转), q3 草稿回放失败
+func expectPromotionReplay(m *promotionTestMocks, sampleSize int) {
+	m.evaluatorRepo.EXPECT().BatchGetEvaluatorVersionsByEvaluatorIDAndVersions(gomock.Any(), [][2]interface{}{{int64(10), "0.0.1"}}).
+		Return([]*entity.Evaluator{{ID: 10, EvaluatorType: entity.EvaluatorTypePrompt, PromptEvaluatorVersion: &entity.PromptEvaluatorVersion{ID: 11, Version: "0.0.1"}}}, nil)
+	m.recordRepo.EXPECT().ListSuccessEvaluatorRecordByVersionID(gomock.Any(), int64(100), int64(11), sampleSize).Return([]*entity.EvaluatorRecord{
+		newPromotionRecord(3, "q3", 0, 100, 10),
+		newPromotionRecord(2, "q2", 1, 100, 10),
+		newPromotionRecord(1, "q1", 1, 100, 10),
+		{ID: 9, EvaluatorOutputData: &entity.EvaluatorOutputData{}},
+	}, nil)
+	m.sourceSvc.EXPECT().PreHandle(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, evaluatorDO *entity.Evaluator) error {
+		evaluatorDO.Name = "pre-handled"
+		return nil
+	})
+	m.sourceSvc.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Nil(), int64(100), true).DoAndReturn(
+		func(_ context.Context, evaluatorDO *entity.Evaluator, input *entity.EvaluatorInputData, _ *entity.EvaluatorRunConfig, _ int64, _ bool) (*entity.EvaluatorOutputData, entity.EvaluatorRunStatus, string) {
+			switch gptr.Indirect(input.InputFields["q"].Text) {
+			case "q1":
+				return &entity.EvaluatorOutputData{
+					EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(1.0)},
+					EvaluatorUsage:  &entity.EvaluatorUsage{InputTokens: 20},
+					TimeConsumingMS: 300,
+				}, entity.EvaluatorRunStatusSuccess, ""
+			case "q2":
+				return &entity.EvaluatorOutputData{
+					EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(0.0), Reasoning: "stricter"},
+					EvaluatorUsage:  &entity.EvaluatorUsage{InputTokens: 20},
+					TimeConsumingMS: 300,
+				}, entity.EvaluatorRunStatusSuccess, ""
+			default:
+				return &entity.EvaluatorOutputData{EvaluatorRunError: &entity.EvaluatorRunError{Message: "timeout"}}, entity.EvaluatorRunStatusFail, ""
+			}
+		}).Times(3)
+}
+
+func TestEvaluatorPromotionServiceImpl_CheckPromotion(t *testing.T) {
+	t.Run("回放草稿并按阈值给出阻断结论", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc, m := newPromotionTestService(ctrl)
+		draft := newPromotionDraft()
+		expectPromotionReplay(m, 20)
+
+		report, err := svc.CheckPromotion(context.Background(), draft, &entity.EvaluatorPromotionConf{
+			Action:                  entity.EvaluatorPromotionAction_Block,
+			SampleSize:              20,
+			PassThreshold:           gptr.Of(0.5),
+			MaxFlipRate:             gptr.Of(0.3),
+			MaxMeanAbsDrift:         gptr.Of(0.6),
+			MaxLatencyIncreaseRatio: gptr.Of(1.0),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "judge", draft.Name, "PreHandle 只作用于回放副本")
+
+		assert.Equal(t, int64(11), report.BaseVersionID)
+		assert.Equal(t, "0.0.1", report.BaseVersion)
+		assert.Equal(t, 3, report.SampleCnt)
+		assert.Equal(t, 2, report.PairedCnt)
+		assert.Equal(t, 1, report.DraftFailCnt)
+		assert.Equal(t, -0.5, report.MeanDrift)
+		assert.Equal(t, 0.5, report.MeanAbsDrift)
+		assert.Equal(t, 1, report.FlipCnt)
+		assert.Equal(t, 0.5, report.FlipRate)
+		assert.Equal(t, 2.0, *report.LatencyChangeRatio)
+		assert.Equal(t, 1.0, *report.TokenChangeRatio)
+		assert.Equal(t, []*entity.EvaluatorPromotionViolation{
+			{Metric: entity.PromotionMetricFlipRate, Value: 0.5, Threshold: 0.3},
+			{Metric: entity.PromotionMetricLatencyIncrease, Value: 2, Threshold: 1},
+		}, report.Violations)
+		assert.Equal(t, entity.EvaluatorPromotionVerdict_Block, report.Verdict)
+		if assert.Len(t, report.DriftItems, 2) {
+			assert.Equal(t, int64(2), report.DriftItems[0].RecordID)
+			assert.True(t, report.DriftItems[0].Flipped)
+			assert.Equal(t, "stricter", report.DriftItems[0].DraftReasoning)
+		}
+	})
+
+	t.Run("未传配置时使用已保存策略, 告警策略给出告警结论", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc, m := newPromotionTestService(ctrl)
+		m.policyRepo.EXPECT().GetPromotionPolicy(gomock.Any(), int64(100), int64(10)).Return(&entity.EvaluatorPromotionPolicy{
+			Conf: &entity.EvaluatorPromotionConf{Action: entity.EvaluatorPromotionAction_Warn, MaxDraftFailRate: gptr.Of(0.2)},
+		}, nil)
+		expectPromotionReplay(m, entity.DefaultPromotionSampleSize)
+
+		report, err := svc.CheckPromotion(context.Background(), newPromotionDraft(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.EvaluatorPromotionVerdict_Warn, report.Verdict)
+		if assert.Len(t, report.Violations, 1) {
+			assert.Equal(t, entity.PromotionMetricDraftFailRate, report.Violations[0].Metric)
+		}
+	})
+
+	t.Run("首个版本或异步评估器跳过检查", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc, _ := newPromotionTestService(ctrl)
+		draft := newPromotionDraft()
+		draft.LatestVersion = ""
+		report, err := svc.CheckPromotion(context.Background(), draft, &entity.EvaluatorPromotionConf{})
+		assert.NoError(t, err)
+		assert.Equal(t, entity.EvaluatorPromotionVerdict_Skipped, report.Verdict)
+
+		draft = &entity.Evaluator{ID: 10, SpaceID: 100, EvaluatorType: entity.EvaluatorTypeAgent, LatestVersion: "0.0.1"}
+		report, err = svc.CheckPromotion(context.Background(), draft, &entity.EvaluatorPromotionConf{})
+		assert.NoError(t, err)
+		assert.Equal(t, entity.EvaluatorPromotionVerdict_Skipped, report.Verdict)
+	})
+
+	t.Run("上一版本无历史记录时跳过检查", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc, m := newPromotionTestService(ctrl)
+		m.evaluatorRepo.EXPECT().BatchGetEvaluatorVersionsByEvaluatorIDAndVersions(gomock.Any(), gomock.Any()).
+			Return([]*entity.Evaluator{{ID: 10, EvaluatorType: entity.EvaluatorTypePrompt, PromptEvaluatorVersion: &entity.PromptEvaluatorVersion{ID: 11}}}, nil)
+		m.recordRepo.EXPECT().ListSuccessEvaluatorRecordByVersionID(gomock.Any(), int64(100), int64(11), gomock.Any()).Return(nil, nil)
+		report, err := svc.CheckPromotion(context.Background(), newPromotionDraft(), &entity.EvaluatorPromotionConf{})
+		assert.NoError(t, err)
+		assert.Equal(t, entity.EvaluatorPromotionVerdict_Skipped, report.Verdict)
+	})
+}
+
+func TestEvaluatorPromotionServiceImpl_GuardSubmit(t *testing.T) {
+	t.Run("未配置或未开启策略不检查", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc, m := newPromotionTestService(ctrl)
+		m.policyRepo.EXPECT().GetPromotionPolicy(gomock.Any(), int64(100), int64(10)).Return(nil, nil)
+		m.policyRepo.EXPECT().GetPromotionPolicy(gomock.Any(), int64(100), int64(10)).
+			Return(&entity.EvaluatorPromotionPolicy{Conf: &entity.EvaluatorPromotionConf{Action: entity.EvaluatorPromotionAction_Block}}, nil)
+		for i := 0; i < 2; i++ {
+			report, err := svc.GuardSubmit(context.Background(), newPromotionDraft(), nil)
+			assert.NoError(t, err)
+			assert.Nil(t, report)
+		}
+	})
+
+	t.Run("阻断策略未通过时拒绝提交", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc, m := newPromotionTestService(ctrl)
+		m.policyRepo.EXPECT().GetPromotionPolicy(gomock.Any(), int64(100), int64(10)).Return(&entity.EvaluatorPromotionPolicy{
+			Conf: &entity.EvaluatorPromotionConf{Enabled: true, Action: entity.EvaluatorPromotionAction_Block, MaxFlipRate: gptr.Of(0.1)},
+		}, nil)
+		expectPromotionReplay(m, entity.DefaultPromotionSampleSize)
+
+		report, err := svc.GuardSubmit(context.Background(), newPromotionDraft(), nil)
+		statusErr, ok := errorx.FromStatusError(err)
+		if assert.True(t, ok) {
+			assert.Equal(t, int32(errno.EvaluatorPromotionBlockedCode), statusErr.Code())
+		}
+		assert.ErrorContains(t, err, "flip_rate=0.5000 (max 0.1000)")
+		assert.Equal(t, entity.EvaluatorPromotionVerdict_Block, report.Verdict)
+	})
+
+	t.Run("告警策略检查出错不影响提交, 阻断策略透传错误", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc, m := newPromotionTestService(ctrl)
+		for _, action := range []entity.EvaluatorPromotionAction{entity.EvaluatorPromotionAction_Warn, entity.EvaluatorPromotionAction_Block} {
+			m.policyRepo.EXPECT().GetPromotionPolicy(gomock.Any(), int64(100), int64(10)).Return(&entity.EvaluatorPromotionPolicy{
+				Conf: &entity.EvaluatorPromotionConf{Enabled: true, Action: action},
+			}, nil)
+			m.evaluatorRepo.EXPECT().BatchGetEvaluatorVersionsByEvaluatorIDAndVersions(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+		}
+
+		report, err := svc.GuardSubmit(context.Background(), newPromotionDraft(), nil)
+		assert.NoError(t, err)
+		assert.Nil(t, report)
+		_, err = svc.GuardSubmit(context.Background(), newPromotionDraft(), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("无策略时请求级配置生效并可指定基线版本", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc, m := newPromotionTestService(ctrl)
+		draft := newPromotionDraft()
+		draft.LatestVersion = "0.0.2"
+		m.policyRepo.EXPECT().GetPromotionPolicy(gomock.Any(), int64(100), int64(10)).Return(nil, nil)
+		// 指定基线 0.0.1
+		expectPromotionReplay(m, 10)
+
+		report, err := svc.GuardSubmit(context.Background(), draft, &entity.EvaluatorPromotionConf{
+			Action: entity.EvaluatorPromotionAction_Warn, BaseVersion: "0.0.1", SampleSize: 10, MaxFlipRate: gptr.Of(0.1),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "0.0.1", report.BaseVersion)
+		assert.Equal(t, entity.EvaluatorPromotionVerdict_Warn, report.Verdict)
+	})
+
+	t.Run("请求级配置不能放宽已保存的策略", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc, m := newPromotionTestService(ctrl)
+		m.policyRepo.EXPECT().GetPromotionPolicy(gomock.Any(), int64(100), int64(10)).Return(&entity.EvaluatorPromotionPolicy{
+			Conf: &entity.EvaluatorPromotionConf{Enabled: true, Action: entity.EvaluatorPromotionAction_Block, MaxFlipRate: gptr.Of(0.1)},
+		}, nil)
+		// 请求试图降级为告警、放宽阈值、缩小样本并换基线, 均不生效
+		expectPromotionReplay(m, entity.DefaultPromotionSampleSize)
+
+		report, err := svc.GuardSubmit(context.Background(), newPromotionDraft(), &entity.EvaluatorPromotionConf{
+			Action: entity.EvaluatorPromotionAction_Warn, BaseVersion: "9.9.9", SampleSize: 1, MaxFlipRate: gptr.Of(0.9),
+		})
+		statusErr, ok := errorx.FromStatusError(err)
+		if assert.True(t, ok) {
+			assert.Equal(t, int32(errno.EvaluatorPromotionBlockedCode), statusErr.Code())
+		}
+		assert.Equal(t, entity.EvaluatorPromotionVerdict_Block, report.Verdict)
+		assert.Equal(t, "0.0.1", report.BaseVersion)
+	})
+
+	t.Run("请求级配置可收紧已保存的策略", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc, m := newPromotionTestService(ctrl)
+		m.policyRepo.EXPECT().GetPromotionPolicy(gomock.Any(), int64(100), int64(10)).Return(&entity.EvaluatorPromotionPolicy{
+			Conf: &entity.EvaluatorPromotionConf{Enabled: true, Action: entity.EvaluatorPromotionAction_Warn, MaxFlipRate: gptr.Of(0.9)},
+		}, nil)
+		expectPromotionReplay(m, entity.DefaultPromotionSampleSize)
+
+		report, err := svc.GuardSubmit(context.Background(), newPromotionDraft(), &entity.EvaluatorPromotionConf{
+			Action: entity.EvaluatorPromotionAction_Block, MaxFlipRate: gptr.Of(0.1),
+		})
+		assert.Error(t, err)
+		assert.Equal(t, entity.EvaluatorPromotionVerdict_Block, report.Verdict)
+	})
+
+	t.Run("请求级配置非法或基线版本不存在", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc, m := newPromotionTestService(ctrl)
+		_, err := svc.GuardSubmit(context.Background(), newPromotionDraft(), &entity.EvaluatorPromotionConf{Action: entity.EvaluatorPromotionAction_Unknown})
+		assert.Error(t, err)
+
+		m.policyRepo.EXPECT().GetPromotionPolicy(gomock.Any(), int64(100), int64(10)).Return(nil, nil)
+		m.evaluatorRepo.EXPECT().BatchGetEvaluatorVersionsByEvaluatorIDAndVersions(gomock.Any(), [][2]interface{}{{int64(10), "9.9.9"}}).Return(nil, nil)
+		_, err = svc.GuardSubmit(context.Background(), newPromotionDraft(), &entity.EvaluatorPromotionConf{
+			Action: entity.EvaluatorPromotionAction_Block, BaseVersion: "9.9.9",
+		})
+		statusErr, ok := errorx.FromStatusError(err)
+		if assert.True(t, ok) {
+			assert.Equal(t, int32(errno.CommonInvalidParamCode), statusErr.Code())
+		}
+	})
+}
+
+func TestEvaluatorPromotionServiceImpl_SavePromotionPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	svc, m := newPromotionTestService(ctrl)
+
+	for _, policy := range []*entity.EvaluatorPromotionPolicy{
+		nil,
+		{SpaceID: 100, EvaluatorID: 10},
+		{SpaceID: 100, EvaluatorID: 10, Conf: &entity.EvaluatorPromotionConf{}},
+		{SpaceID: 100, EvaluatorID: 10, Conf: &entity.EvaluatorPromotionConf{Action: entity.EvaluatorPromotionAction_Warn, MaxFlipRate: gptr.Of(-0.1)}},
+	} {
+		assert.Error(t, svc.SavePromotionPolicy(context.Background(), policy))
+	}
+
+	m.idgen.EXPECT().GenID(gomock.Any()).Return(int64(1), nil)
+	m.policyRepo.EXPECT().SavePromotionPolicy(gomock.Any(), gomock.Any()).Return(nil)
+	policy := &entity.EvaluatorPromotionPolicy{SpaceID: 100, EvaluatorID: 10, Conf: &entity.EvaluatorPromotionConf{Enabled: true, Action: entity.EvaluatorPromotionAction_Block}}
+	assert.NoError(t, svc.SavePromotionPolicy(context.Background(), policy))
+	assert.Equal(t, int64(1), policy.ID)
+}
@@ -0,0 +1,388 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/bytedance/gg/gslice"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/goroutine"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const compositeEvaluatorMetricModelName = "composite"
+
+// NewEvaluatorSourceCompositeServiceImpl componentServices 为组件可用的评估器源服务, 不含组合评估器自身, 禁止嵌套
+func NewEvaluatorSourceCompositeServiceImpl(
+	evaluatorRepo repo.IEvaluatorRepo,
+	componentServices map[entity.EvaluatorType]EvaluatorSourceService,
+	metric metrics.EvaluatorExecMetrics,
+) EvaluatorSourceService {
+	return &EvaluatorSourceCompositeServiceImpl{
+		evaluatorRepo:     evaluatorRepo,
+		componentServices: componentServices,
+		metric:            metric,
+	}
+}
+
+// EvaluatorSourceCompositeServiceImpl 组合评估器, 并行运行组件评估器版本后按 CompositeConfig.Strategy 合并得分。
+// 组件结果放在输出的 CompositeResult 中, 由 EvaluatorServiceImpl.RunEvaluator 落库为独立的组件记录。
+type EvaluatorSourceCompositeServiceImpl struct {
+	evaluatorRepo     repo.IEvaluatorRepo
+	componentServices map[entity.EvaluatorType]EvaluatorSourceService
+	metric            metrics.EvaluatorExecMetrics
+}
+
+func (c *EvaluatorSourceCompositeServiceImpl) EvaluatorType() entity.EvaluatorType {
+	return entity.EvaluatorTypeComposite
+}
+
+// ShouldIntercept 组合评估器不劫持评估
+func (c *EvaluatorSourceCompositeServiceImpl) ShouldIntercept(_ context.Context, _ *entity.Evaluator, _ *entity.EvaluatorInputData) (*entity.EvaluatorOutputData, entity.EvaluatorRunStatus, bool) {
+	return nil, entity.EvaluatorRunStatusSuccess, false
+}
+
+func (c *EvaluatorSourceCompositeServiceImpl) Run(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData, evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID int64, disableTracing bool) (output *entity.EvaluatorOutputData, runStatus entity.EvaluatorRunStatus, traceID string) {
+	var err error
+	startTime := time.Now()
+	defer func() {
+		c.metric.EmitRun(exptSpaceID, err, startTime, compositeEvaluatorMetricModelName)
+		if output == nil {
+			output = &entity.EvaluatorOutputData{}
+		}
+		output.TimeConsumingMS = time.Since(startTime).Milliseconds()
+		if err != nil {
+			output.EvaluatorRunError = &entity.EvaluatorRunError{Code: errno.RunEvaluatorFailCode, Message: err.Error()}
+			if statusErr, ok := errorx.FromStatusError(err); ok {
+				output.EvaluatorRunError.Code = statusErr.Code()
+				output.EvaluatorRunError.Message = statusErr.Error()
+			}
+		}
+	}()
+
+	if err = evaluator.ValidateBaseInfo(); err != nil {
+		logs.CtxInfo(ctx, "[RunEvaluator] ValidateBaseInfo fail, err: %v", err)
+		return nil, entity.EvaluatorRunStatusFail, ""
+	}
+	if err = evaluator.ValidateInput(input); err != nil {
+		logs.CtxInfo(ctx, "[RunEvaluator] ValidateInput fail, err: %v", err)
+		return nil, entity.EvaluatorRunStatusFail, ""
+	}
+
+	cfg := evaluator.CompositeEvaluatorVersion.CompositeConfig
+	components, err := c.loadComponents(ctx, evaluator)
+	if err != nil {
+		return nil, entity.EvaluatorRunStatusFail, ""
+	}
+	results, err := c.runComponents(ctx, cfg, components, input, evaluatorRunConf, exptSpaceID, disableTracing)
+	if err != nil {
+		return nil, entity.EvaluatorRunStatusFail, ""
+	}
+
+	output = &entity.EvaluatorOutputData{
+		EvaluatorUsage:  sumComponentUsage(results),
+		CompositeResult: &entity.CompositeEvaluatorResult{Strategy: cfg.Strategy, Components: results},
+	}
+	var evaluatorResult *entity.EvaluatorResult
+	evaluatorResult, traceID, err = c.combine(ctx, evaluator, results, evaluatorRunConf, exptSpaceID, disableTracing)
+	if err != nil {
+		logs.CtxWarn(ctx, "[RunEvaluator] composite combine fail, strategy: %v, err: %v", cfg.Strategy, err)
+		// 组件结果仍需返回, 以便落库组件记录
+		return output, entity.EvaluatorRunStatusFail, traceID
+	}
+	output.EvaluatorResult = evaluatorResult
+	return output, entity.EvaluatorRunStatusSuccess, traceID
+}
+
+// loadComponents 按 Components 顺序加载组件评估器; 组件须为当前空间或预置的同步评估器, 且不能是组合评估器
+func (c *EvaluatorSourceCompositeServiceImpl) loadComponents(ctx context.Context, evaluator *entity.Evaluator) ([]*entity.Evaluator, error) {
+	cfg := evaluator.CompositeEvaluatorVersion.CompositeConfig
+	evaluators, err := c.evaluatorRepo.BatchGetEvaluatorByVersionID(ctx, nil, cfg.GetComponentVersionIDs(), false, false)
+	if err != nil {
+		return nil, err
+	}
+	versionID2Evaluator := gslice.ToMap(evaluators, func(e *entity.Evaluator) (int64, *entity.Evaluator) { return e.GetEvaluatorVersionID(), e })
+
+	res := make([]*entity.Evaluator, 0, len(cfg.Components))
+	for _, component := range cfg.Components {
+		componentDO, ok := versionID2Evaluator[component.EvaluatorVersionID]
+		if !ok || (!componentDO.Builtin && componentDO.SpaceID != evaluator.SpaceID) {
+			return nil, errorx.NewByCode(errno.EvaluatorVersionNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("composite component %d not found", component.EvaluatorVersionID)))
+		}
+		if componentDO.EvaluatorType == entity.EvaluatorTypeComposite || componentDO.IsAsync() {
+			return nil, errorx.NewByCode(errno.InvalidEvaluatorTypeCode,
+				errorx.WithExtraMsg(fmt.Sprintf("composite component %d must be a sync and non-composite evaluator", component.EvaluatorVersionID)))
+		}
+		if _, ok := c.componentServices[componentDO.EvaluatorType]; !ok {
+			return nil, errorx.NewByCode(errno.InvalidEvaluatorTypeCode,
+				errorx.WithExtraMsg(fmt.Sprintf("evaluator type %d of composite component %d is not supported", componentDO.EvaluatorType, component.EvaluatorVersionID)))
+		}
+		res = append(res, componentDO)
+	}
+	return res, nil
+}
+
+// runComponents 并行运行组件, 单个组件失败不影响其他组件, 结果顺序与 Components 一致
+func (c *EvaluatorSourceCompositeServiceImpl) runComponents(ctx context.Context, cfg *entity.CompositeConfig, components []*entity.Evaluator,
+	input *entity.EvaluatorInputData, evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID int64, disableTracing bool,
+) ([]*entity.CompositeComponentResult, error) {
+	results := make([]*entity.CompositeComponentResult, len(components))
+	pool, err := goroutine.NewPool(len(components))
+	if err != nil {
+		return nil, err
+	}
+	defer pool.Release()
+	for i, componentDO := range components {
+		i, componentDO := i, componentDO
+		pool.Add(func() error {
+			results[i] = c.runComponent(ctx, componentDO, cfg.Components[i], input, evaluatorRunConf, exptSpaceID, disableTracing)
+			return nil
+		})
+	}
+	if err := pool.ExecAll(ctx); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (c *EvaluatorSourceCompositeServiceImpl) runComponent(ctx context.Context, componentDO *entity.Evaluator, component *entity.CompositeComponent,
+	input *entity.EvaluatorInputData, evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID int64, disableTracing bool,
+) *entity.CompositeComponentResult {
+	res := &entity.CompositeComponentResult{
+		EvaluatorVersionID: component.EvaluatorVersionID,
+		Weight:             component.Weight,
+		Status:             entity.EvaluatorRunStatusFail,
+	}
+	svc := c.componentServices[componentDO.EvaluatorType]
+	if err := svc.PreHandle(ctx, componentDO); err != nil {
+		logs.CtxWarn(ctx, "[RunEvaluator] composite component %d PreHandle fail, err: %v", component.EvaluatorVersionID, err)
+		res.Output = &entity.EvaluatorOutputData{EvaluatorRunError: &entity.EvaluatorRunError{Code: errno.RunEvaluatorFailCode, Message: err.Error()}}
+		return res
+	}
+	// 组件并发运行, 各自持有输入副本, 避免源服务就地改写输入时互相影响
+	output, runStatus, traceID := svc.Run(ctx, componentDO, deepCopyEvaluatorInputData(input), evaluatorRunConf, exptSpaceID, disableTracing)
+	roundEvaluatorOutputScore(output)
+	res.Output, res.TraceID, res.Status = output, traceID, runStatus
+	if runStatus == entity.EvaluatorRunStatusSuccess && output != nil && output.EvaluatorResult != nil {
+		res.Score = output.EvaluatorResult.Score
+	}
+	return res
+}
+
+// combine 按策略合并成功组件的得分
+func (c *EvaluatorSourceCompositeServiceImpl) combine(ctx context.Context, evaluator *entity.Evaluator, results []*entity.CompositeComponentResult,
+	evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID int64, disableTracing bool,
+) (*entity.EvaluatorResult, string, error) {
+	cfg := evaluator.CompositeEvaluatorVersion.CompositeConfig
+	succeeded := gslice.Filter(results, func(r *entity.CompositeComponentResult) bool { return r.Score != nil })
+	if len(succeeded) < cfg.GetMinSuccessCnt() {
+		return nil, "", errorx.NewByCode(errno.RunEvaluatorFailCode,
+			errorx.WithExtraMsg(fmt.Sprintf("composite components succeeded %d/%d, less than required %d", len(succeeded), len(results), cfg.GetMinSuccessCnt())))
+	}
+	if len(succeeded) == 0 {
+		return nil, "", errorx.NewByCode(errno.RunEvaluatorFailCode, errorx.WithExtraMsg("no composite component succeeded"))
+	}
+
+	if cfg.Strategy == entity.CompositeStrategyCode {
+		return c.combineByCode(ctx, evaluator, results, evaluatorRunConf, exptSpaceID, disableTracing)
+	}
+	score, err := combineCompositeScores(cfg.Strategy, succeeded)
+	if err != nil {
+		return nil, "", err
+	}
+	return &entity.EvaluatorResult{
+		Score:     gptr.Of(score),
+		Reasoning: fmt.Sprintf("%s of %d/%d components: %s", cfg.Strategy, len(succeeded), len(results), formatCompositeComponentScores(results)),
+	}, "", nil
+}
+
+func combineCompositeScores(strategy entity.CompositeStrategy, succeeded []*entity.CompositeComponentResult) (float64, error) {
+	scores := gslice.Map(succeeded, func(r *entity.CompositeComponentResult) float64 { return *r.Score })
+	switch strategy {
+	case entity.CompositeStrategyMean:
+		var sum float64
+		for _, s := range scores {
+			sum += s
+		}
+		return sum / float64(len(scores)), nil
+	case entity.CompositeStrategyMedian:
+		sort.Float64s(scores)
+		mid := len(scores) / 2
+		if len(scores)%2 == 0 {
+			return (scores[mid-1] + scores[mid]) / 2, nil
+		}
+		return scores[mid], nil
+	case entity.CompositeStrategyMajority:
+		votes := make(map[float64]int, len(scores))
+		for _, s := range scores {
+			votes[s]++
+		}
+		sort.Float64s(scores)
+		winner, winnerVotes := scores[0], 0
+		for _, s := range scores {
+			// 升序遍历且只在票数严格更多时替换, 票数相同时保留较低分
+			if votes[s] > winnerVotes {
+				winner, winnerVotes = s, votes[s]
+			}
+		}
+		return winner, nil
+	case entity.CompositeStrategyMin:
+		sort.Float64s(scores)
+		return scores[0], nil
+	case entity.CompositeStrategyWeighted:
+		var sum, weights float64
+		for _, r := range succeeded {
+			sum += *r.Score * r.Weight
+			weights += r.Weight
+		}
+		if weights <= 0 {
+			return 0, errorx.NewByCode(errno.RunEvaluatorFailCode, errorx.WithExtraMsg("sum of composite component weights must be positive"))
+		}
+		return sum / weights, nil
+	default:
+		return 0, errorx.NewByCode(errno.InvalidEvaluatorTypeCode, errorx.WithExtraMsg(fmt.Sprintf("unsupported composite strategy %q", strategy)))
+	}
+}
+
+// compositeCodeComponent 自定义代码策略下注入代码的单个组件结果
+type compositeCodeComponent struct {
+	EvaluatorVersionID int64                     `json:"evaluator_version_id"`
+	Weight             float64                   `json:"weight"`
+	Status             entity.EvaluatorRunStatus `json:"status"`
+	Score              *float64                  `json:"score"`
+	Reasoning          string                    `json:"reasoning"`
+}
+
+// combineByCode 以合成的 Code 评估器运行合并代码, 全部组件结果 (含失败组件) 以 JSON 数组注入输入字段 component_results
+func (c *EvaluatorSourceCompositeServiceImpl) combineByCode(ctx context.Context, evaluator *entity.Evaluator, results []*entity.CompositeComponentResult,
+	evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID int64, disableTracing bool,
+) (*entity.EvaluatorResult, string, error) {
+	codeSvc, ok := c.componentServices[entity.EvaluatorTypeCode]
+	if !ok {
+		return nil, "", errorx.NewByCode(errno.InvalidEvaluatorTypeCode, errorx.WithExtraMsg("code evaluator is not available for composite code strategy"))
+	}
+	payload := make([]*compositeCodeComponent, 0, len(results))
+	for _, r := range results {
+		item := &compositeCodeComponent{EvaluatorVersionID: r.EvaluatorVersionID, Weight: r.Weight, Status: r.Status, Score: r.Score}
+		if r.Output != nil && r.Output.EvaluatorResult != nil {
+			item.Reasoning = r.Output.EvaluatorResult.Reasoning
+		}
+		payload = append(payload, item)
+	}
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, "", err
+	}
+	input := &entity.EvaluatorInputData{
+		InputFields: map[string]*entity.Content{
+			entity.CompositeCodeInputFieldKey: {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of(string(payloadBytes))},
+		},
+	}
+	output, runStatus, traceID := codeSvc.Run(ctx, buildCompositeCodeEvaluator(evaluator), input, evaluatorRunConf, exptSpaceID, disableTracing)
+	if runStatus != entity.EvaluatorRunStatusSuccess || output == nil || output.EvaluatorResult == nil {
+		msg := "composite code strategy returns no result"
+		if output != nil && output.EvaluatorRunError != nil {
+			msg = output.EvaluatorRunError.Message
+		}
+		return nil, traceID, errorx.NewByCode(errno.RunEvaluatorFailCode, errorx.WithExtraMsg(msg))
+	}
+	return output.EvaluatorResult, traceID, nil
+}
+
+// buildCompositeCodeEvaluator 合成运行合并代码用的 Code 评估器, 复用组合评估器的 ID 与名称以便 trace 归属
+func buildCompositeCodeEvaluator(evaluator *entity.Evaluator) *entity.Evaluator {
+	version := evaluator.CompositeEvaluatorVersion
+	return &entity.Evaluator{
+		ID:            evaluator.ID,
+		SpaceID:       evaluator.SpaceID,
+		Name:          evaluator.Name,
+		EvaluatorType: entity.EvaluatorTypeCode,
+		CodeEvaluatorVersion: &entity.CodeEvaluatorVersion{
+			ID:            version.ID,
+			SpaceID:       version.SpaceID,
+			EvaluatorType: entity.EvaluatorTypeCode,
+			EvaluatorID:   version.EvaluatorID,
+			Version:       version.Version,
+			CodeContent:   version.CompositeConfig.CodeContent,
+			LanguageType:  version.CompositeConfig.LanguageType,
+		},
+	}
+}
+
+func formatCompositeComponentScores(results []*entity.CompositeComponentResult) string {
+	parts := make([]string, 0, len(results))
+	for _, r := range results {
+		if r.Score == nil {
+			parts = append(parts, fmt.Sprintf("%d=fail", r.EvaluatorVersionID))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%d=%v", r.EvaluatorVersionID, *r.Score))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func sumComponentUsage(results []*entity.CompositeComponentResult) *entity.EvaluatorUsage {
+	usage := &entity.EvaluatorUsage{}
+	for _, r := range results {
+		if r.Output != nil && r.Output.EvaluatorUsage != nil {
+			usage.InputTokens += r.Output.EvaluatorUsage.InputTokens
+			usage.OutputTokens += r.Output.EvaluatorUsage.OutputTokens
+		}
+	}
+	return usage
+}
+
+func (c *EvaluatorSourceCompositeServiceImpl) AsyncRun(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData, evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID, invokeID int64) (map[string]string, string, error) {
+	return nil, "", errorx.NewByCode(errno.InvalidEvaluatorTypeCode, errorx.WithExtraMsg("composite evaluator does not support async run"))
+}
+
+func (c *EvaluatorSourceCompositeServiceImpl) AsyncDebug(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData, evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID, invokeID int64) (map[string]string, string, error) {
+	return nil, "", errorx.NewByCode(errno.InvalidEvaluatorTypeCode, errorx.WithExtraMsg("composite evaluator does not support async debug"))
+}
+
+// Debug 调试时只返回合并结果与组件得分明细, 不落库组件记录
+func (c *EvaluatorSourceCompositeServiceImpl) Debug(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData, evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID int64) (output *entity.EvaluatorOutputData, err error) {
+	output, _, _ = c.Run(ctx, evaluator, input, evaluatorRunConf, exptSpaceID, true)
+	if output != nil && output.EvaluatorRunError != nil {
+		return nil, errorx.NewByCode(output.EvaluatorRunError.Code, errorx.WithExtraMsg(output.EvaluatorRunError.Message))
+	}
+	return output, nil
+}
+
+func (c *EvaluatorSourceCompositeServiceImpl) PreHandle(ctx context.Context, evaluator *entity.Evaluator) error {
+	return nil
+}
+
+// Validate 创建/提交版本时校验组合配置与组件引用, code 策略额外校验合并代码
+func (c *EvaluatorSourceCompositeServiceImpl) Validate(ctx context.Context, evaluator *entity.Evaluator) error {
+	if evaluator == nil || evaluator.EvaluatorType != entity.EvaluatorTypeComposite || evaluator.CompositeEvaluatorVersion == nil {
+		return errorx.NewByCode(errno.InvalidEvaluatorTypeCode, errorx.WithExtraMsg("invalid evaluator type or composite evaluator version is nil"))
+	}
+	if err := evaluator.CompositeEvaluatorVersion.CompositeConfig.Validate(); err != nil {
+		return err
+	}
+	if _, err := c.loadComponents(ctx, evaluator); err != nil {
+		return err
+	}
+	if evaluator.CompositeEvaluatorVersion.CompositeConfig.Strategy != entity.CompositeStrategyCode {
+		return nil
+	}
+	codeSvc, ok := c.componentServices[entity.EvaluatorTypeCode]
+	if !ok {
+		return errorx.NewByCode(errno.InvalidEvaluatorTypeCode, errorx.WithExtraMsg("code evaluator is not available for composite code strategy"))
+	}
+	return codeSvc.Validate(ctx, buildCompositeCodeEvaluator(evaluator))
+}
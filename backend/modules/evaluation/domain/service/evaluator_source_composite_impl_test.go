@@ -0,0 +1,229 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	metricsmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+func newTestCompositeEvaluator(cfg *entity.CompositeConfig) *entity.Evaluator {
+	return &entity.Evaluator{
+		ID:            400,
+		SpaceID:       1,
+		Name:          "judges",
+		EvaluatorType: entity.EvaluatorTypeComposite,
+		CompositeEvaluatorVersion: &entity.CompositeEvaluatorVersion{
+			ID:              401,
+			SpaceID:         1,
+			EvaluatorID:     400,
+			CompositeConfig: cfg,
+		},
+	}
+}
+
+func newTestPromptComponent(versionID int64) *entity.Evaluator {
+	return &entity.Evaluator{
+		ID:                     versionID * 10,
+		SpaceID:                1,
+		EvaluatorType:          entity.EvaluatorTypePrompt,
+		PromptEvaluatorVersion: &entity.PromptEvaluatorVersion{ID: versionID, SpaceID: 1},
+	}
+}
+
+func Test_combineCompositeScores(t *testing.T) {
+	results := func(scores ...float64) []*entity.CompositeComponentResult {
+		res := make([]*entity.CompositeComponentResult, 0, len(scores))
+		for i, s := range scores {
+			res = append(res, &entity.CompositeComponentResult{EvaluatorVersionID: int64(i + 1), Weight: float64(i + 1), Score: gptr.Of(s)})
+		}
+		return res
+	}
+	tests := []struct {
+		name     string
+		strategy entity.CompositeStrategy
+		scores   []float64
+		want     float64
+	}{
+		{name: "mean", strategy: entity.CompositeStrategyMean, scores: []float64{1, 0, 0.5}, want: 0.5},
+		{name: "median odd", strategy: entity.CompositeStrategyMedian, scores: []float64{0.9, 0.1, 0.4}, want: 0.4},
+		{name: "median even", strategy: entity.CompositeStrategyMedian, scores: []float64{0.9, 0.1, 0.4, 0.6}, want: 0.5},
+		{name: "majority", strategy: entity.CompositeStrategyMajority, scores: []float64{1, 0, 1}, want: 1},
+		{name: "majority tie takes lower", strategy: entity.CompositeStrategyMajority, scores: []float64{1, 0, 1, 0}, want: 0},
+		{name: "min", strategy: entity.CompositeStrategyMin, scores: []float64{1, 0.3, 0.8}, want: 0.3},
+		// 权重依次为 1, 2, 3
+		{name: "weighted", strategy: entity.CompositeStrategyWeighted, scores: []float64{1, 0, 0.5}, want: 2.5 / 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := combineCompositeScores(tt.strategy, results(tt.scores...))
+			assert.NoError(t, err)
+			assert.InDelta(t, tt.want, got, 1e-9)
+		})
+	}
+
+	_, err := combineCompositeScores("unknown", results(1))
+	assert.Error(t, err)
+}
+
+func TestEvaluatorSourceCompositeServiceImpl_Run(t *testing.T) {
+	input := &entity.EvaluatorInputData{InputFields: map[string]*entity.Content{
+		"input": {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("q")},
+	}}
+
+	setup := func(t *testing.T, cfg *entity.CompositeConfig) (EvaluatorSourceService, *mocks.MockEvaluatorSourceService, *mocks.MockEvaluatorSourceService) {
+		ctrl := gomock.NewController(t)
+		mockMetric := metricsmocks.NewMockEvaluatorExecMetrics(ctrl)
+		mockMetric.EXPECT().EmitRun(gomock.Any(), gomock.Any(), gomock.Any(), "composite").AnyTimes()
+		mockRepo := repomocks.NewMockIEvaluatorRepo(ctrl)
+		mockRepo.EXPECT().BatchGetEvaluatorByVersionID(gomock.Any(), nil, cfg.GetComponentVersionIDs(), false, false).
+			Return([]*entity.Evaluator{newTestPromptComponent(12), newTestPromptComponent(11), newTestPromptComponent(13)}, nil).AnyTimes()
+		promptSvc := mocks.NewMockEvaluatorSourceService(ctrl)
+		codeSvc := mocks.NewMockEvaluatorSourceService(ctrl)
+		svc := NewEvaluatorSourceCompositeServiceImpl(mockRepo, map[entity.EvaluatorType]EvaluatorSourceService{
+			entity.EvaluatorTypePrompt: promptSvc,
+			entity.EvaluatorTypeCode:   codeSvc,
+		}, mockMetric)
+		return svc, promptSvc, codeSvc
+	}
+	mockComponentRun := func(promptSvc *mocks.MockEvaluatorSourceService, outputs map[int64]*entity.EvaluatorOutputData) {
+		promptSvc.EXPECT().PreHandle(gomock.Any(), gomock.Any()).Return(nil).Times(len(outputs))
+		promptSvc.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), int64(1), false).DoAndReturn(
+			func(_ context.Context, evaluator *entity.Evaluator, _ *entity.EvaluatorInputData, _ *entity.EvaluatorRunConfig, _ int64, _ bool) (*entity.EvaluatorOutputData, entity.EvaluatorRunStatus, string) {
+				output := outputs[evaluator.GetEvaluatorVersionID()]
+				if output.EvaluatorRunError != nil {
+					return output, entity.EvaluatorRunStatusFail, "trace-component"
+				}
+				return output, entity.EvaluatorRunStatusSuccess, "trace-component"
+			}).Times(len(outputs))
+	}
+	scoreOutput := func(score float64, tokens int64) *entity.EvaluatorOutputData {
+		return &entity.EvaluatorOutputData{
+			EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(score), Reasoning: "ok"},
+			EvaluatorUsage:  &entity.EvaluatorUsage{InputTokens: tokens, OutputTokens: tokens},
+		}
+	}
+	failOutput := &entity.EvaluatorOutputData{EvaluatorRunError: &entity.EvaluatorRunError{Code: 1, Message: "llm timeout"}}
+
+	t.Run("majority", func(t *testing.T) {
+		cfg := &entity.CompositeConfig{
+			Strategy:   entity.CompositeStrategyMajority,
+			Components: []*entity.CompositeComponent{{EvaluatorVersionID: 11}, {EvaluatorVersionID: 12}, {EvaluatorVersionID: 13}},
+		}
+		svc, promptSvc, _ := setup(t, cfg)
+		mockComponentRun(promptSvc, map[int64]*entity.EvaluatorOutputData{11: scoreOutput(1, 10), 12: scoreOutput(0, 20), 13: scoreOutput(1, 30)})
+
+		output, status, _ := svc.Run(context.Background(), newTestCompositeEvaluator(cfg), input, nil, 1, false)
+		assert.Equal(t, entity.EvaluatorRunStatusSuccess, status)
+		assert.Equal(t, 1.0, *output.EvaluatorResult.Score)
+		assert.Equal(t, "majority of 3/3 components: 11=1, 12=0, 13=1", output.EvaluatorResult.Reasoning)
+		assert.Equal(t, &entity.EvaluatorUsage{InputTokens: 60, OutputTokens: 60}, output.EvaluatorUsage)
+		if assert.Len(t, output.CompositeResult.Components, 3) {
+			assert.Equal(t, int64(12), output.CompositeResult.Components[1].EvaluatorVersionID)
+			assert.Equal(t, "trace-component", output.CompositeResult.Components[1].TraceID)
+			assert.NotNil(t, output.CompositeResult.Components[1].Output)
+		}
+	})
+
+	t.Run("成功组件数不足时失败但保留组件结果", func(t *testing.T) {
+		cfg := &entity.CompositeConfig{
+			Strategy:   entity.CompositeStrategyMean,
+			Components: []*entity.CompositeComponent{{EvaluatorVersionID: 11}, {EvaluatorVersionID: 12}},
+		}
+		svc, promptSvc, _ := setup(t, cfg)
+		mockComponentRun(promptSvc, map[int64]*entity.EvaluatorOutputData{11: scoreOutput(1, 0), 12: failOutput})
+
+		output, status, _ := svc.Run(context.Background(), newTestCompositeEvaluator(cfg), input, nil, 1, false)
+		assert.Equal(t, entity.EvaluatorRunStatusFail, status)
+		assert.Contains(t, output.EvaluatorRunError.Message, "composite components succeeded 1/2, less than required 2")
+		if assert.Len(t, output.CompositeResult.Components, 2) {
+			assert.Equal(t, entity.EvaluatorRunStatusFail, output.CompositeResult.Components[1].Status)
+		}
+	})
+
+	t.Run("min_success_cnt 放宽后忽略失败组件", func(t *testing.T) {
+		cfg := &entity.CompositeConfig{
+			Strategy:      entity.CompositeStrategyWeighted,
+			MinSuccessCnt: 1,
+			Components:    []*entity.CompositeComponent{{EvaluatorVersionID: 11, Weight: 1}, {EvaluatorVersionID: 12, Weight: 3}},
+		}
+		svc, promptSvc, _ := setup(t, cfg)
+		mockComponentRun(promptSvc, map[int64]*entity.EvaluatorOutputData{11: scoreOutput(0.4, 0), 12: failOutput})
+
+		output, status, _ := svc.Run(context.Background(), newTestCompositeEvaluator(cfg), input, nil, 1, false)
+		assert.Equal(t, entity.EvaluatorRunStatusSuccess, status)
+		assert.Equal(t, 0.4, *output.EvaluatorResult.Score)
+		assert.Equal(t, "weighted of 1/2 components: 11=0.4, 12=fail", output.EvaluatorResult.Reasoning)
+	})
+
+	t.Run("自定义代码合并", func(t *testing.T) {
+		cfg := &entity.CompositeConfig{
+			Strategy:     entity.CompositeStrategyCode,
+			Components:   []*entity.CompositeComponent{{EvaluatorVersionID: 11}},
+			CodeContent:  "def exec_evaluation(turn): ...",
+			LanguageType: "python",
+		}
+		svc, promptSvc, codeSvc := setup(t, cfg)
+		mockComponentRun(promptSvc, map[int64]*entity.EvaluatorOutputData{11: scoreOutput(0.8, 0)})
+		codeSvc.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), int64(1), false).DoAndReturn(
+			func(_ context.Context, evaluator *entity.Evaluator, codeInput *entity.EvaluatorInputData, _ *entity.EvaluatorRunConfig, _ int64, _ bool) (*entity.EvaluatorOutputData, entity.EvaluatorRunStatus, string) {
+				assert.Equal(t, entity.EvaluatorTypeCode, evaluator.EvaluatorType)
+				assert.Equal(t, entity.LanguageTypePython, evaluator.CodeEvaluatorVersion.LanguageType)
+				var payload []map[string]interface{}
+				assert.NoError(t, json.Unmarshal([]byte(codeInput.InputFields[entity.CompositeCodeInputFieldKey].GetText()), &payload))
+				assert.Equal(t, []map[string]interface{}{{"evaluator_version_id": float64(11), "weight": float64(0), "status": float64(1), "score": 0.8, "reasoning": "ok"}}, payload)
+				return &entity.EvaluatorOutputData{EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(0.9), Reasoning: "custom"}}, entity.EvaluatorRunStatusSuccess, "trace-code"
+			})
+
+		output, status, traceID := svc.Run(context.Background(), newTestCompositeEvaluator(cfg), input, nil, 1, false)
+		assert.Equal(t, entity.EvaluatorRunStatusSuccess, status)
+		assert.Equal(t, "trace-code", traceID)
+		assert.Equal(t, 0.9, *output.EvaluatorResult.Score)
+		assert.Equal(t, "custom", output.EvaluatorResult.Reasoning)
+	})
+}
+
+func TestEvaluatorSourceCompositeServiceImpl_Validate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := repomocks.NewMockIEvaluatorRepo(ctrl)
+	svc := NewEvaluatorSourceCompositeServiceImpl(mockRepo, map[entity.EvaluatorType]EvaluatorSourceService{
+		entity.EvaluatorTypePrompt: mocks.NewMockEvaluatorSourceService(ctrl),
+	}, metricsmocks.NewMockEvaluatorExecMetrics(ctrl))
+	ctx := context.Background()
+	components := []*entity.CompositeComponent{{EvaluatorVersionID: 11}, {EvaluatorVersionID: 12}}
+
+	assert.Error(t, svc.Validate(ctx, newTestCompositeEvaluator(&entity.CompositeConfig{Strategy: entity.CompositeStrategyMean})))
+	assert.Error(t, svc.Validate(ctx, newTestCompositeEvaluator(&entity.CompositeConfig{Strategy: entity.CompositeStrategyWeighted, Components: components})))
+	assert.Error(t, svc.Validate(ctx, newTestCompositeEvaluator(&entity.CompositeConfig{
+		Strategy: entity.CompositeStrategyMean, Components: []*entity.CompositeComponent{{EvaluatorVersionID: 11}, {EvaluatorVersionID: 11}},
+	})))
+
+	nested := newTestCompositeEvaluator(&entity.CompositeConfig{Strategy: entity.CompositeStrategyMean, Components: components})
+	nested.CompositeEvaluatorVersion.ID = 12
+	mockRepo.EXPECT().BatchGetEvaluatorByVersionID(gomock.Any(), nil, []int64{11, 12}, false, false).
+		Return([]*entity.Evaluator{newTestPromptComponent(11), nested}, nil)
+	err := svc.Validate(ctx, newTestCompositeEvaluator(&entity.CompositeConfig{Strategy: entity.CompositeStrategyMean, Components: components}))
+	assert.ErrorContains(t, err, "composite component 12 must be a sync and non-composite evaluator")
+
+	otherSpace := newTestPromptComponent(12)
+	otherSpace.SpaceID = 2
+	mockRepo.EXPECT().BatchGetEvaluatorByVersionID(gomock.Any(), nil, []int64{11, 12}, false, false).
+		Return([]*entity.Evaluator{newTestPromptComponent(11), otherSpace}, nil)
+	err = svc.Validate(ctx, newTestCompositeEvaluator(&entity.CompositeConfig{Strategy: entity.CompositeStrategyMean, Components: components}))
+	assert.ErrorContains(t, err, "composite component 12 not found")
+
+	mockRepo.EXPECT().BatchGetEvaluatorByVersionID(gomock.Any(), nil, []int64{11, 12}, false, false).
+		Return([]*entity.Evaluator{newTestPromptComponent(11), newTestPromptComponent(12)}, nil)
+	assert.NoError(t, svc.Validate(ctx, newTestCompositeEvaluator(&entity.CompositeConfig{Strategy: entity.CompositeStrategyMean, Components: components})))
+}
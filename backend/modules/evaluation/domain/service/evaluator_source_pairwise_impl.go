@@ -0,0 +1,302 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/bytedance/sonic"
+	"github.com/jinzhu/copier"
+	"github.com/kaptinlin/jsonrepair"
+
+	"github.com/coze-dev/coze-loop/backend/infra/looptracer"
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+func NewEvaluatorSourcePairwiseServiceImpl(
+	llmProvider rpc.ILLMProvider,
+	metric metrics.EvaluatorExecMetrics,
+) EvaluatorSourceService {
+	return &EvaluatorSourcePairwiseServiceImpl{
+		llmProvider: llmProvider,
+		metric:      metric,
+	}
+}
+
+// EvaluatorSourcePairwiseServiceImpl 成对 (A-vs-B) LLM 裁判评估器
+type EvaluatorSourcePairwiseServiceImpl struct {
+	llmProvider rpc.ILLMProvider
+	metric      metrics.EvaluatorExecMetrics
+}
+
+func (p *EvaluatorSourcePairwiseServiceImpl) EvaluatorType() entity.EvaluatorType {
+	return entity.EvaluatorTypePairwise
+}
+
+// ShouldIntercept 成对评估器不劫持评估
+func (p *EvaluatorSourcePairwiseServiceImpl) ShouldIntercept(_ context.Context, _ *entity.Evaluator, _ *entity.EvaluatorInputData) (*entity.EvaluatorOutputData, entity.EvaluatorRunStatus, bool) {
+	return nil, entity.EvaluatorRunStatusSuccess, false
+}
+
+// Run 按 (A, B) 顺序判定一次; 未关闭位置交换时再按 (B, A) 顺序判定一次, 两次结论一致才认定胜负, 否则记为平局。
+// 结果分数以 A 视角给出: A 胜=1, 平局=0.5, B 胜=0。
+func (p *EvaluatorSourcePairwiseServiceImpl) Run(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData, evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID int64, disableTracing bool) (output *entity.EvaluatorOutputData, runStatus entity.EvaluatorRunStatus, traceID string) {
+	var err error
+	startTime := time.Now()
+	var rootSpan *evaluatorSpan
+
+	if !disableTracing {
+		rootSpan, ctx = newEvaluatorSpan(ctx, evaluator.Name, "LoopEvaluation", strconv.FormatInt(exptSpaceID, 10), false)
+		traceID = rootSpan.GetTraceID()
+	}
+
+	defer func() {
+		if output == nil {
+			output = &entity.EvaluatorOutputData{
+				EvaluatorRunError: &entity.EvaluatorRunError{},
+			}
+		}
+		var errInfo error
+		if err != nil {
+			if output.EvaluatorRunError == nil {
+				output.EvaluatorRunError = &entity.EvaluatorRunError{}
+			}
+			statusErr, ok := errorx.FromStatusError(err)
+			if ok {
+				output.EvaluatorRunError.Code = statusErr.Code()
+				output.EvaluatorRunError.Message = statusErr.Error()
+				errInfo = statusErr
+			} else {
+				output.EvaluatorRunError.Code = errno.RunEvaluatorFailCode
+				output.EvaluatorRunError.Message = err.Error()
+				errInfo = err
+			}
+		}
+		if !disableTracing && rootSpan != nil {
+			rootSpan.reportRootSpan(ctx, &ReportRootSpanRequest{
+				input:     input,
+				output:    output,
+				runStatus: runStatus,
+				evaluatorVersion: &entity.PromptEvaluatorVersion{
+					EvaluatorID: evaluator.GetEvaluatorID(),
+					Version:     evaluator.GetVersion(),
+				},
+				errInfo: errInfo,
+			})
+		}
+	}()
+
+	err = evaluator.ValidateBaseInfo()
+	if err != nil {
+		logs.CtxInfo(ctx, "[RunEvaluator] ValidateBaseInfo fail, err: %v", err)
+		runStatus = entity.EvaluatorRunStatusFail
+		return nil, runStatus, traceID
+	}
+	err = evaluator.ValidateInput(input)
+	if err != nil {
+		logs.CtxInfo(ctx, "[RunEvaluator] ValidateInput fail, err: %v", err)
+		runStatus = entity.EvaluatorRunStatusFail
+		return nil, runStatus, traceID
+	}
+	version := evaluator.PairwiseEvaluatorVersion
+	defer func() {
+		var modelID string
+		if version.ModelConfig.GetModelID() == 0 {
+			modelID = ptr.From(version.ModelConfig.ProviderModelID)
+		} else {
+			modelID = strconv.FormatInt(version.ModelConfig.GetModelID(), 10)
+		}
+		p.metric.EmitRun(exptSpaceID, err, startTime, modelID)
+	}()
+
+	usage := &entity.EvaluatorUsage{}
+	prefAB, reasonAB, err := p.judge(ctx, version, input, false, usage, exptSpaceID, disableTracing)
+	if err != nil {
+		logs.CtxWarn(ctx, "[RunEvaluator] pairwise judge fail, err: %v", err)
+		runStatus = entity.EvaluatorRunStatusFail
+		return nil, runStatus, traceID
+	}
+	finalPref, reasoning := prefAB, reasonAB
+	ext := map[string]string{entity.PairwiseExtKeyPreferenceAB: string(prefAB)}
+
+	if !version.DisablePositionSwap {
+		var (
+			prefBA   entity.PairwisePreference
+			reasonBA string
+		)
+		prefBA, reasonBA, err = p.judge(ctx, version, input, true, usage, exptSpaceID, disableTracing)
+		if err != nil {
+			logs.CtxWarn(ctx, "[RunEvaluator] pairwise swapped judge fail, err: %v", err)
+			runStatus = entity.EvaluatorRunStatusFail
+			return nil, runStatus, traceID
+		}
+		ext[entity.PairwiseExtKeyPreferenceBA] = string(prefBA)
+		if prefAB != prefBA {
+			finalPref = entity.PairwisePreferenceTie
+		}
+		reasoning = fmt.Sprintf("[A/B] %s\n[B/A] %s", reasonAB, reasonBA)
+	}
+	ext[entity.PairwiseExtKeyPreference] = string(finalPref)
+
+	output = &entity.EvaluatorOutputData{
+		EvaluatorResult: &entity.EvaluatorResult{
+			Score:     gptr.Of(finalPref.Score()),
+			Reasoning: reasoning,
+		},
+		EvaluatorUsage: usage,
+		Ext:            ext,
+	}
+	return output, entity.EvaluatorRunStatusSuccess, traceID
+}
+
+// judge 渲染 judge prompt 并调用模型一次。swapped 为 true 时把 A/B 两侧输出互换后提问, 返回的偏好已映射回原始标签。
+func (p *EvaluatorSourcePairwiseServiceImpl) judge(ctx context.Context, version *entity.PairwiseEvaluatorVersion, input *entity.EvaluatorInputData,
+	swapped bool, usage *entity.EvaluatorUsage, exptSpaceID int64, disableTracing bool,
+) (entity.PairwisePreference, string, error) {
+	// renderTemplate 会原地修改 MessageList, 每次判定使用独立副本
+	promptVersion := &entity.PromptEvaluatorVersion{
+		EvaluatorID: version.EvaluatorID,
+		Version:     version.Version,
+		ModelConfig: version.ModelConfig,
+		ParseType:   entity.ParseTypeContent,
+	}
+	if err := copier.CopyWithOption(&promptVersion.MessageList, version.MessageList, copier.Option{DeepCopy: true}); err != nil {
+		return "", "", err
+	}
+
+	inputFields := make(map[string]*entity.Content, len(input.InputFields))
+	for k, v := range input.InputFields {
+		inputFields[k] = v
+	}
+	if swapped {
+		inputFields[entity.PairwiseInputFieldKeyOutputA] = input.InputFields[entity.PairwiseInputFieldKeyOutputB]
+		inputFields[entity.PairwiseInputFieldKeyOutputB] = input.InputFields[entity.PairwiseInputFieldKeyOutputA]
+	}
+	if err := renderTemplate(ctx, promptVersion, &entity.EvaluatorInputData{InputFields: inputFields}, exptSpaceID, disableTracing); err != nil {
+		return "", "", err
+	}
+
+	resp, err := p.chat(ctx, promptVersion, exptSpaceID, disableTracing)
+	if err != nil {
+		return "", "", err
+	}
+	if resp == nil {
+		return "", "", errorx.NewByCode(errno.LLMOutputEmptyCode, errorx.WithExtraMsg(" resp is nil"))
+	}
+	if resp.TokenUsage != nil {
+		usage.InputTokens += resp.TokenUsage.InputTokens
+		usage.OutputTokens += resp.TokenUsage.OutputTokens
+	}
+
+	pref, reason, err := parsePairwiseOutput(gptr.Indirect(resp.Content))
+	if err != nil {
+		return "", "", err
+	}
+	if swapped {
+		pref = pref.Swap()
+	}
+	return pref, reason, nil
+}
+
+func (p *EvaluatorSourcePairwiseServiceImpl) chat(ctx context.Context, promptVersion *entity.PromptEvaluatorVersion, exptSpaceID int64, disableTracing bool) (resp *entity.ReplyItem, err error) {
+	modelCtx := ctx
+	if !disableTracing {
+		var modelSpan *evaluatorSpan
+		modelSpan, modelCtx = newEvaluatorSpan(ctx, promptVersion.ModelConfig.ModelName, "model", strconv.FormatInt(exptSpaceID, 10), true)
+		defer func() {
+			modelSpan.reportModelSpan(modelCtx, promptVersion, resp, err)
+		}()
+		modelCtx = looptracer.GetTracer().Inject(modelCtx)
+	}
+
+	return p.llmProvider.Call(modelCtx, &entity.LLMCallParam{
+		SpaceID:     exptSpaceID,
+		EvaluatorID: strconv.FormatInt(promptVersion.EvaluatorID, 10),
+		UserID:      gptr.Of(session.UserIDInCtxOrEmpty(ctx)),
+		Scenario:    entity.ScenarioEvaluator,
+		Messages:    promptVersion.MessageList,
+		ModelConfig: promptVersion.ModelConfig,
+	})
+}
+
+type pairwiseOutputMsgFormat struct {
+	Preference string `json:"preference"`
+	Reason     string `json:"reason"`
+}
+
+var pairwisePreferenceRe = regexp.MustCompile(`(?i)"?preference"?\s*[:：]\s*"?\s*(A|B|tie)\b`)
+
+// parsePairwiseOutput 解析 judge 输出, 依次尝试直接解析 JSON、修复后解析 JSON、正则提取 preference
+func parsePairwiseOutput(content string) (entity.PairwisePreference, string, error) {
+	var msg pairwiseOutputMsgFormat
+	if err := sonic.UnmarshalString(content, &msg); err == nil {
+		if pref, ok := normalizePairwisePreference(msg.Preference); ok {
+			return pref, msg.Reason, nil
+		}
+	}
+	if repaired, err := jsonrepair.JSONRepair(content); err == nil {
+		msg = pairwiseOutputMsgFormat{}
+		if err := sonic.UnmarshalString(repaired, &msg); err == nil {
+			if pref, ok := normalizePairwisePreference(msg.Preference); ok {
+				return pref, msg.Reason, nil
+			}
+		}
+	}
+	if matches := pairwisePreferenceRe.FindStringSubmatch(content); len(matches) > 1 {
+		if pref, ok := normalizePairwisePreference(matches[1]); ok {
+			return pref, content, nil
+		}
+	}
+	return "", "", errorx.NewByCode(errno.InvalidOutputFromModelCode, errorx.WithExtraMsg("pairwise preference not found. Original content: "+content))
+}
+
+func normalizePairwisePreference(s string) (entity.PairwisePreference, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "a":
+		return entity.PairwisePreferenceA, true
+	case "b":
+		return entity.PairwisePreferenceB, true
+	case "tie", "draw", "equal":
+		return entity.PairwisePreferenceTie, true
+	default:
+		return "", false
+	}
+}
+
+func (p *EvaluatorSourcePairwiseServiceImpl) AsyncRun(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData, evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID, invokeID int64) (map[string]string, string, error) {
+	return nil, "", errorx.NewByCode(errno.InvalidEvaluatorTypeCode, errorx.WithExtraMsg("pairwise evaluator does not support async run"))
+}
+
+func (p *EvaluatorSourcePairwiseServiceImpl) AsyncDebug(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData, evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID, invokeID int64) (map[string]string, string, error) {
+	return nil, "", errorx.NewByCode(errno.InvalidEvaluatorTypeCode, errorx.WithExtraMsg("pairwise evaluator does not support async debug"))
+}
+
+func (p *EvaluatorSourcePairwiseServiceImpl) Debug(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData, evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID int64) (output *entity.EvaluatorOutputData, err error) {
+	output, _, _ = p.Run(ctx, evaluator, input, evaluatorRunConf, exptSpaceID, false)
+	if output != nil && output.EvaluatorRunError != nil {
+		return nil, errorx.NewByCode(output.EvaluatorRunError.Code, errorx.WithExtraMsg(output.EvaluatorRunError.Message))
+	}
+	return output, nil
+}
+
+func (p *EvaluatorSourcePairwiseServiceImpl) PreHandle(ctx context.Context, evaluator *entity.Evaluator) error {
+	return nil
+}
+
+func (p *EvaluatorSourcePairwiseServiceImpl) Validate(ctx context.Context, evaluator *entity.Evaluator) error {
+	return evaluator.ValidateBaseInfo()
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	metricsmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics/mocks"
+	rpcmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func newTestPairwiseEvaluator(disableSwap bool) *entity.Evaluator {
+	return &entity.Evaluator{
+		ID:            200,
+		SpaceID:       1,
+		Name:          "Pairwise Evaluator",
+		EvaluatorType: entity.EvaluatorTypePairwise,
+		PairwiseEvaluatorVersion: &entity.PairwiseEvaluatorVersion{
+			ID:          201,
+			EvaluatorID: 200,
+			SpaceID:     1,
+			ModelConfig: &entity.ModelConfig{ModelID: gptr.Of(int64(1))},
+			MessageList: []*entity.Message{
+				{
+					Role: entity.RoleSystem,
+					Content: &entity.Content{
+						ContentType: gptr.Of(entity.ContentTypeText),
+						Text:        gptr.Of("A: {{output_a}}\nB: {{output_b}}"),
+					},
+				},
+			},
+			DisablePositionSwap: disableSwap,
+		},
+	}
+}
+
+func newTestPairwiseInput() *entity.EvaluatorInputData {
+	return &entity.EvaluatorInputData{
+		InputFields: map[string]*entity.Content{
+			entity.PairwiseInputFieldKeyOutputA: {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("first")},
+			entity.PairwiseInputFieldKeyOutputB: {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("second")},
+		},
+	}
+}
+
+func TestEvaluatorSourcePairwiseServiceImpl_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLLMProvider := rpcmocks.NewMockILLMProvider(ctrl)
+	mockMetric := metricsmocks.NewMockEvaluatorExecMetrics(ctrl)
+	svc := NewEvaluatorSourcePairwiseServiceImpl(mockLLMProvider, mockMetric)
+	assert.Equal(t, entity.EvaluatorTypePairwise, svc.EvaluatorType())
+
+	reply := func(content string) *entity.ReplyItem {
+		return &entity.ReplyItem{Content: gptr.Of(content), TokenUsage: &entity.TokenUsage{InputTokens: 10, OutputTokens: 5}}
+	}
+
+	t.Run("position swap agrees", func(t *testing.T) {
+		gomock.InOrder(
+			mockLLMProvider.EXPECT().Call(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, param *entity.LLMCallParam) (*entity.ReplyItem, error) {
+				assert.Equal(t, "A: first\nB: second", gptr.Indirect(param.Messages[0].Content.Text))
+				return reply(`{"preference": "A", "reason": "first is better"}`), nil
+			}),
+			mockLLMProvider.EXPECT().Call(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, param *entity.LLMCallParam) (*entity.ReplyItem, error) {
+				assert.Equal(t, "A: second\nB: first", gptr.Indirect(param.Messages[0].Content.Text))
+				return reply(`{"preference": "B", "reason": "B is better"}`), nil
+			}),
+		)
+		mockMetric.EXPECT().EmitRun(int64(1), nil, gomock.Any(), "1")
+
+		evaluator := newTestPairwiseEvaluator(false)
+		output, status, _ := svc.Run(context.Background(), evaluator, newTestPairwiseInput(), nil, 1, false)
+		assert.Equal(t, entity.EvaluatorRunStatusSuccess, status)
+		assert.Equal(t, 1.0, gptr.Indirect(output.EvaluatorResult.Score))
+		assert.Equal(t, "A", output.Ext[entity.PairwiseExtKeyPreference])
+		assert.Equal(t, "A", output.Ext[entity.PairwiseExtKeyPreferenceBA])
+		assert.Equal(t, int64(20), output.EvaluatorUsage.InputTokens)
+		assert.Equal(t, int64(10), output.EvaluatorUsage.OutputTokens)
+		// 原始 prompt 不应被渲染修改
+		assert.Equal(t, "A: {{output_a}}\nB: {{output_b}}", gptr.Indirect(evaluator.PairwiseEvaluatorVersion.MessageList[0].Content.Text))
+	})
+
+	t.Run("position swap disagrees", func(t *testing.T) {
+		mockLLMProvider.EXPECT().Call(gomock.Any(), gomock.Any()).Return(reply(`{"preference": "A", "reason": "first slot"}`), nil).Times(2)
+		mockMetric.EXPECT().EmitRun(int64(1), nil, gomock.Any(), gomock.Any())
+
+		output, status, _ := svc.Run(context.Background(), newTestPairwiseEvaluator(false), newTestPairwiseInput(), nil, 1, true)
+		assert.Equal(t, entity.EvaluatorRunStatusSuccess, status)
+		assert.Equal(t, 0.5, gptr.Indirect(output.EvaluatorResult.Score))
+		assert.Equal(t, "tie", output.Ext[entity.PairwiseExtKeyPreference])
+	})
+
+	t.Run("swap disabled", func(t *testing.T) {
+		mockLLMProvider.EXPECT().Call(gomock.Any(), gomock.Any()).Return(reply("preference: B"), nil)
+		mockMetric.EXPECT().EmitRun(int64(1), nil, gomock.Any(), gomock.Any())
+
+		output, status, _ := svc.Run(context.Background(), newTestPairwiseEvaluator(true), newTestPairwiseInput(), nil, 1, true)
+		assert.Equal(t, entity.EvaluatorRunStatusSuccess, status)
+		assert.Equal(t, 0.0, gptr.Indirect(output.EvaluatorResult.Score))
+		_, ok := output.Ext[entity.PairwiseExtKeyPreferenceBA]
+		assert.False(t, ok)
+	})
+
+	t.Run("invalid model output", func(t *testing.T) {
+		mockLLMProvider.EXPECT().Call(gomock.Any(), gomock.Any()).Return(reply("no idea"), nil)
+		mockMetric.EXPECT().EmitRun(int64(1), gomock.Not(nil), gomock.Any(), gomock.Any())
+
+		output, status, _ := svc.Run(context.Background(), newTestPairwiseEvaluator(false), newTestPairwiseInput(), nil, 1, true)
+		assert.Equal(t, entity.EvaluatorRunStatusFail, status)
+		assert.NotNil(t, output.EvaluatorRunError)
+	})
+
+	t.Run("swapped judge fails", func(t *testing.T) {
+		gomock.InOrder(
+			mockLLMProvider.EXPECT().Call(gomock.Any(), gomock.Any()).Return(reply(`{"preference": "A", "reason": "first slot"}`), nil),
+			mockLLMProvider.EXPECT().Call(gomock.Any(), gomock.Any()).Return(reply("no idea"), nil),
+		)
+		mockMetric.EXPECT().EmitRun(int64(1), gomock.Not(nil), gomock.Any(), gomock.Any())
+
+		output, status, _ := svc.Run(context.Background(), newTestPairwiseEvaluator(false), newTestPairwiseInput(), nil, 1, true)
+		assert.Equal(t, entity.EvaluatorRunStatusFail, status)
+		assert.NotNil(t, output.EvaluatorRunError)
+	})
+
+	t.Run("missing output_b", func(t *testing.T) {
+		input := newTestPairwiseInput()
+		delete(input.InputFields, entity.PairwiseInputFieldKeyOutputB)
+		output, status, _ := svc.Run(context.Background(), newTestPairwiseEvaluator(false), input, nil, 1, true)
+		assert.Equal(t, entity.EvaluatorRunStatusFail, status)
+		assert.NotNil(t, output.EvaluatorRunError)
+	})
+}
+
+func TestParsePairwiseOutput(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		wantPref entity.PairwisePreference
+		wantErr  bool
+	}{
+		{name: "json", content: `{"preference": "b", "reason": "r"}`, wantPref: entity.PairwisePreferenceB},
+		{name: "json with tie", content: `{"reason": "same", "preference": "Tie"}`, wantPref: entity.PairwisePreferenceTie},
+		{name: "repaired json", content: "```json\n{\"preference\": \"A\", \"reason\": \"r\",}\n```", wantPref: entity.PairwisePreferenceA},
+		{name: "regex", content: `I think preference: A because ...`, wantPref: entity.PairwisePreferenceA},
+		{name: "unknown", content: `{"preference": "C"}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pref, _, err := parsePairwiseOutput(tt.content)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPref, pref)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	json2 "encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/textsim"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const (
+	ruleEvaluatorMetricModelName = "rule"
+	// ruleRegexCacheSize 已编译正则的缓存上限 (按评估器版本计), 超出后淘汰最久未使用的版本
+	ruleRegexCacheSize = 1024
+)
+
+func NewEvaluatorSourceRuleServiceImpl(metric metrics.EvaluatorExecMetrics) EvaluatorSourceService {
+	regexCache, _ := lru.New(ruleRegexCacheSize)
+	return &EvaluatorSourceRuleServiceImpl{metric: metric, regexCache: regexCache}
+}
+
+// EvaluatorSourceRuleServiceImpl 规则评估器, 在进程内按 RuleConfig 直接计算, 不经过 FaaS 运行时。
+// 规则计算开销远小于一次 span 上报, 因此不创建 trace, 返回的 traceID 为空。
+type EvaluatorSourceRuleServiceImpl struct {
+	metric metrics.EvaluatorExecMetrics
+	// regexCache 按评估器版本 ID 缓存已编译的正则, 大评测集下同一版本的正则只编译一次
+	regexCache *lru.Cache
+}
+
+// ruleRegexCacheEntry 草稿版本的 Pattern 可能被修改, 命中时需比对 pattern
+type ruleRegexCacheEntry struct {
+	pattern string
+	re      *regexp.Regexp
+}
+
+func (r *EvaluatorSourceRuleServiceImpl) EvaluatorType() entity.EvaluatorType {
+	return entity.EvaluatorTypeRule
+}
+
+// ShouldIntercept 规则评估器不劫持评估
+func (r *EvaluatorSourceRuleServiceImpl) ShouldIntercept(_ context.Context, _ *entity.Evaluator, _ *entity.EvaluatorInputData) (*entity.EvaluatorOutputData, entity.EvaluatorRunStatus, bool) {
+	return nil, entity.EvaluatorRunStatusSuccess, false
+}
+
+func (r *EvaluatorSourceRuleServiceImpl) Run(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData, evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID int64, disableTracing bool) (output *entity.EvaluatorOutputData, runStatus entity.EvaluatorRunStatus, traceID string) {
+	var err error
+	startTime := time.Now()
+	defer func() {
+		r.metric.EmitRun(exptSpaceID, err, startTime, ruleEvaluatorMetricModelName)
+		if output == nil {
+			output = &entity.EvaluatorOutputData{}
+		}
+		output.TimeConsumingMS = time.Since(startTime).Milliseconds()
+		if err != nil {
+			output.EvaluatorRunError = &entity.EvaluatorRunError{Code: errno.RunEvaluatorFailCode, Message: err.Error()}
+			if statusErr, ok := errorx.FromStatusError(err); ok {
+				output.EvaluatorRunError.Code = statusErr.Code()
+				output.EvaluatorRunError.Message = statusErr.Error()
+			}
+		}
+	}()
+
+	if err = evaluator.ValidateBaseInfo(); err != nil {
+		logs.CtxInfo(ctx, "[RunEvaluator] ValidateBaseInfo fail, err: %v", err)
+		return nil, entity.EvaluatorRunStatusFail, ""
+	}
+	if err = evaluator.ValidateInput(input); err != nil {
+		logs.CtxInfo(ctx, "[RunEvaluator] ValidateInput fail, err: %v", err)
+		return nil, entity.EvaluatorRunStatusFail, ""
+	}
+
+	cfg := evaluator.RuleEvaluatorVersion.RuleConfig
+	actual := input.InputFields[cfg.GetActualFieldKey()].GetText()
+	var reference string
+	if cfg.NeedReference() {
+		reference = input.InputFields[cfg.GetReferenceFieldKey()].GetText()
+	}
+
+	score, reasoning, err := r.evaluate(evaluator.GetEvaluatorVersionID(), cfg, actual, reference)
+	if err != nil {
+		logs.CtxWarn(ctx, "[RunEvaluator] rule evaluate fail, rule_type: %v, err: %v", cfg.RuleType, err)
+		return nil, entity.EvaluatorRunStatusFail, ""
+	}
+	if cfg.PassThreshold != nil {
+		passed := score >= *cfg.PassThreshold
+		reasoning = fmt.Sprintf("%s; pass_threshold=%v, passed=%v", reasoning, *cfg.PassThreshold, passed)
+		score = 0
+		if passed {
+			score = 1
+		}
+	}
+
+	return &entity.EvaluatorOutputData{
+		EvaluatorResult: &entity.EvaluatorResult{
+			Score:     gptr.Of(score),
+			Reasoning: reasoning,
+		},
+		EvaluatorUsage: &entity.EvaluatorUsage{},
+	}, entity.EvaluatorRunStatusSuccess, ""
+}
+
+func (r *EvaluatorSourceRuleServiceImpl) evaluate(versionID int64, cfg *entity.RuleConfig, actual, reference string) (float64, string, error) {
+	switch cfg.RuleType {
+	case entity.RuleTypeExactMatch:
+		matched := normalizeRuleText(cfg, actual) == normalizeRuleText(cfg, reference)
+		return boolScore(matched), fmt.Sprintf("exact_match: matched=%v", matched), nil
+	case entity.RuleTypeRegexMatch:
+		re, err := r.compileRegex(versionID, cfg.Pattern)
+		if err != nil {
+			return 0, "", err
+		}
+		matched := re.MatchString(actual)
+		return boolScore(matched), fmt.Sprintf("regex_match: pattern=%s, matched=%v", cfg.Pattern, matched), nil
+	case entity.RuleTypeJSONSchema:
+		if cfg.JSONSchema == "" {
+			valid := json2.Valid([]byte(actual))
+			return boolScore(valid), fmt.Sprintf("json_schema: valid_json=%v", valid), nil
+		}
+		valid, err := json.ValidateJSONSchema(cfg.JSONSchema, actual)
+		if err != nil {
+			return 0, fmt.Sprintf("json_schema: valid=false, err=%v", err), nil
+		}
+		return boolScore(valid), fmt.Sprintf("json_schema: valid=%v", valid), nil
+	case entity.RuleTypeContains:
+		text := normalizeRuleText(cfg, actual)
+		hits := make([]string, 0, len(cfg.Keywords))
+		for _, kw := range cfg.Keywords {
+			if strings.Contains(text, normalizeRuleText(cfg, kw)) {
+				hits = append(hits, kw)
+			}
+		}
+		score := boolScore(len(hits) > 0)
+		if cfg.ContainsAll {
+			score = float64(len(hits)) / float64(len(cfg.Keywords))
+		}
+		return score, fmt.Sprintf("contains: hit %d/%d keywords %v", len(hits), len(cfg.Keywords), hits), nil
+	case entity.RuleTypeNumericTolerance:
+		a, err := strconv.ParseFloat(strings.TrimSpace(actual), 64)
+		if err != nil {
+			return 0, fmt.Sprintf("numeric_tolerance: actual %q is not a number", actual), nil
+		}
+		ref, err := strconv.ParseFloat(strings.TrimSpace(reference), 64)
+		if err != nil {
+			return 0, "", errorx.NewByCode(errno.InvalidInputDataCode, errorx.WithExtraMsg(fmt.Sprintf("reference %q is not a number", reference)))
+		}
+		tolerance := cfg.Tolerance
+		if cfg.Relative {
+			tolerance = math.Abs(ref) * cfg.Tolerance
+		}
+		diff := math.Abs(a - ref)
+		return boolScore(diff <= tolerance), fmt.Sprintf("numeric_tolerance: |%v - %v| = %v, tolerance=%v", a, ref, diff, tolerance), nil
+	case entity.RuleTypeLevenshtein:
+		score := textsim.LevenshteinSimilarity(normalizeRuleText(cfg, actual), normalizeRuleText(cfg, reference))
+		return score, fmt.Sprintf("levenshtein similarity: %.4f", score), nil
+	case entity.RuleTypeRougeL:
+		score := textsim.RougeL(textsim.Tokenize(actual), textsim.Tokenize(reference))
+		return score, fmt.Sprintf("rouge_l f1: %.4f", score), nil
+	case entity.RuleTypeBLEU:
+		score := textsim.BLEU(textsim.Tokenize(actual), textsim.Tokenize(reference), cfg.BLEUMaxN)
+		return score, fmt.Sprintf("bleu: %.4f", score), nil
+	default:
+		return 0, "", errorx.NewByCode(errno.InvalidEvaluatorTypeCode, errorx.WithExtraMsg(fmt.Sprintf("unsupported rule type %q", cfg.RuleType)))
+	}
+}
+
+func (r *EvaluatorSourceRuleServiceImpl) compileRegex(versionID int64, pattern string) (*regexp.Regexp, error) {
+	if v, ok := r.regexCache.Get(versionID); ok {
+		if entry := v.(*ruleRegexCacheEntry); entry.pattern == pattern {
+			return entry.re, nil
+		}
+	}
+	// 存量版本未经长度校验, 运行时同样拒绝超长 pattern
+	if len(pattern) > entity.MaxRuleRegexPatternLen {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("regex pattern exceeds %d bytes", entity.MaxRuleRegexPatternLen)))
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("invalid regex pattern: %v", err)))
+	}
+	r.regexCache.Add(versionID, &ruleRegexCacheEntry{pattern: pattern, re: re})
+	return re, nil
+}
+
+func normalizeRuleText(cfg *entity.RuleConfig, s string) string {
+	if cfg.TrimSpace {
+		s = strings.TrimSpace(s)
+	}
+	if cfg.IgnoreCase {
+		s = strings.ToLower(s)
+	}
+	return s
+}
+
+func boolScore(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func (r *EvaluatorSourceRuleServiceImpl) AsyncRun(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData, evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID, invokeID int64) (map[string]string, string, error) {
+	return nil, "", errorx.NewByCode(errno.InvalidEvaluatorTypeCode, errorx.WithExtraMsg("rule evaluator does not support async run"))
+}
+
+func (r *EvaluatorSourceRuleServiceImpl) AsyncDebug(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData, evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID, invokeID int64) (map[string]string, string, error) {
+	return nil, "", errorx.NewByCode(errno.InvalidEvaluatorTypeCode, errorx.WithExtraMsg("rule evaluator does not support async debug"))
+}
+
+func (r *EvaluatorSourceRuleServiceImpl) Debug(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData, evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID int64) (output *entity.EvaluatorOutputData, err error) {
+	output, _, _ = r.Run(ctx, evaluator, input, evaluatorRunConf, exptSpaceID, true)
+	if output != nil && output.EvaluatorRunError != nil {
+		return nil, errorx.NewByCode(output.EvaluatorRunError.Code, errorx.WithExtraMsg(output.EvaluatorRunError.Message))
+	}
+	return output, nil
+}
+
+func (r *EvaluatorSourceRuleServiceImpl) PreHandle(ctx context.Context, evaluator *entity.Evaluator) error {
+	return nil
+}
+
+// Validate 创建/提交版本时校验规则配置, 提前暴露非法正则等问题
+func (r *EvaluatorSourceRuleServiceImpl) Validate(ctx context.Context, evaluator *entity.Evaluator) error {
+	if evaluator == nil || evaluator.EvaluatorType != entity.EvaluatorTypeRule || evaluator.RuleEvaluatorVersion == nil {
+		return errorx.NewByCode(errno.InvalidEvaluatorTypeCode, errorx.WithExtraMsg("invalid evaluator type or rule evaluator version is nil"))
+	}
+	return evaluator.RuleEvaluatorVersion.RuleConfig.Validate()
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	metricsmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func newTestRuleEvaluator(cfg *entity.RuleConfig) *entity.Evaluator {
+	return &entity.Evaluator{
+		ID:            300,
+		SpaceID:       1,
+		EvaluatorType: entity.EvaluatorTypeRule,
+		RuleEvaluatorVersion: &entity.RuleEvaluatorVersion{
+			ID:          301,
+			EvaluatorID: 300,
+			RuleConfig:  cfg,
+		},
+	}
+}
+
+func newTestRuleInput(actual, reference string) *entity.EvaluatorInputData {
+	return &entity.EvaluatorInputData{
+		InputFields: map[string]*entity.Content{
+			"actual_output":    {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of(actual)},
+			"reference_output": {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of(reference)},
+		},
+	}
+}
+
+func TestEvaluatorSourceRuleServiceImpl_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMetric := metricsmocks.NewMockEvaluatorExecMetrics(ctrl)
+	mockMetric.EXPECT().EmitRun(gomock.Any(), gomock.Any(), gomock.Any(), "rule").AnyTimes()
+	svc := NewEvaluatorSourceRuleServiceImpl(mockMetric)
+	assert.Equal(t, entity.EvaluatorTypeRule, svc.EvaluatorType())
+
+	tests := []struct {
+		name       string
+		cfg        *entity.RuleConfig
+		actual     string
+		reference  string
+		wantScore  float64
+		wantStatus entity.EvaluatorRunStatus
+	}{
+		{name: "exact match normalized", cfg: &entity.RuleConfig{RuleType: entity.RuleTypeExactMatch, IgnoreCase: true, TrimSpace: true}, actual: " Paris ", reference: "paris", wantScore: 1, wantStatus: entity.EvaluatorRunStatusSuccess},
+		{name: "exact mismatch", cfg: &entity.RuleConfig{RuleType: entity.RuleTypeExactMatch}, actual: "Paris", reference: "paris", wantScore: 0, wantStatus: entity.EvaluatorRunStatusSuccess},
+		{name: "regex", cfg: &entity.RuleConfig{RuleType: entity.RuleTypeRegexMatch, Pattern: `^\d{3}-\d{4}$`}, actual: "123-4567", wantScore: 1, wantStatus: entity.EvaluatorRunStatusSuccess},
+		{name: "valid json", cfg: &entity.RuleConfig{RuleType: entity.RuleTypeJSONSchema}, actual: `{"a": 1}`, wantScore: 1, wantStatus: entity.EvaluatorRunStatusSuccess},
+		{
+			name:       "json schema violation",
+			cfg:        &entity.RuleConfig{RuleType: entity.RuleTypeJSONSchema, JSONSchema: `{"type": "object", "required": ["b"]}`},
+			actual:     `{"a": 1}`,
+			wantScore:  0,
+			wantStatus: entity.EvaluatorRunStatusSuccess,
+		},
+		{name: "contains any", cfg: &entity.RuleConfig{RuleType: entity.RuleTypeContains, Keywords: []string{"foo", "bar"}}, actual: "a bar", wantScore: 1, wantStatus: entity.EvaluatorRunStatusSuccess},
+		{name: "contains all ratio", cfg: &entity.RuleConfig{RuleType: entity.RuleTypeContains, Keywords: []string{"foo", "bar"}, ContainsAll: true}, actual: "a bar", wantScore: 0.5, wantStatus: entity.EvaluatorRunStatusSuccess},
+		{name: "numeric within tolerance", cfg: &entity.RuleConfig{RuleType: entity.RuleTypeNumericTolerance, Tolerance: 0.01}, actual: "3.141", reference: "3.14159", wantScore: 1, wantStatus: entity.EvaluatorRunStatusSuccess},
+		{name: "numeric relative tolerance", cfg: &entity.RuleConfig{RuleType: entity.RuleTypeNumericTolerance, Tolerance: 0.1, Relative: true}, actual: "120", reference: "100", wantScore: 0, wantStatus: entity.EvaluatorRunStatusSuccess},
+		{name: "numeric bad reference", cfg: &entity.RuleConfig{RuleType: entity.RuleTypeNumericTolerance}, actual: "1", reference: "x", wantStatus: entity.EvaluatorRunStatusFail},
+		{name: "levenshtein", cfg: &entity.RuleConfig{RuleType: entity.RuleTypeLevenshtein}, actual: "abcd", reference: "abce", wantScore: 0.75, wantStatus: entity.EvaluatorRunStatusSuccess},
+		{name: "rouge with threshold", cfg: &entity.RuleConfig{RuleType: entity.RuleTypeRougeL, PassThreshold: gptr.Of(0.8)}, actual: "the cat sat on the mat", reference: "the cat is on the mat", wantScore: 1, wantStatus: entity.EvaluatorRunStatusSuccess},
+		{name: "bleu identical", cfg: &entity.RuleConfig{RuleType: entity.RuleTypeBLEU}, actual: "a b c d e", reference: "a b c d e", wantScore: 1, wantStatus: entity.EvaluatorRunStatusSuccess},
+		{name: "invalid config", cfg: &entity.RuleConfig{RuleType: entity.RuleTypeRegexMatch, Pattern: "("}, actual: "x", wantStatus: entity.EvaluatorRunStatusFail},
+		{name: "unknown rule", cfg: &entity.RuleConfig{RuleType: "unknown"}, actual: "x", wantStatus: entity.EvaluatorRunStatusFail},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, status, traceID := svc.Run(context.Background(), newTestRuleEvaluator(tt.cfg), newTestRuleInput(tt.actual, tt.reference), nil, 1, false)
+			assert.Equal(t, tt.wantStatus, status)
+			assert.Empty(t, traceID)
+			if tt.wantStatus == entity.EvaluatorRunStatusFail {
+				assert.NotNil(t, output.EvaluatorRunError)
+				return
+			}
+			assert.Nil(t, output.EvaluatorRunError)
+			assert.InDelta(t, tt.wantScore, gptr.Indirect(output.EvaluatorResult.Score), 1e-9)
+			assert.NotEmpty(t, output.EvaluatorResult.Reasoning)
+		})
+	}
+
+	t.Run("missing reference field", func(t *testing.T) {
+		input := newTestRuleInput("a", "")
+		delete(input.InputFields, "reference_output")
+		_, status, _ := svc.Run(context.Background(), newTestRuleEvaluator(&entity.RuleConfig{RuleType: entity.RuleTypeExactMatch}), input, nil, 1, false)
+		assert.Equal(t, entity.EvaluatorRunStatusFail, status)
+	})
+
+	t.Run("custom field keys", func(t *testing.T) {
+		input := &entity.EvaluatorInputData{InputFields: map[string]*entity.Content{"answer": {Text: gptr.Of("42")}}}
+		output, status, _ := svc.Run(context.Background(), newTestRuleEvaluator(&entity.RuleConfig{RuleType: entity.RuleTypeRegexMatch, ActualFieldKey: "answer", Pattern: `^\d+$`}), input, nil, 1, false)
+		assert.Equal(t, entity.EvaluatorRunStatusSuccess, status)
+		assert.Equal(t, 1.0, gptr.Indirect(output.EvaluatorResult.Score))
+	})
+}
+
+func TestEvaluatorSourceRuleServiceImpl_Validate(t *testing.T) {
+	svc := NewEvaluatorSourceRuleServiceImpl(nil)
+	assert.NoError(t, svc.Validate(context.Background(), newTestRuleEvaluator(&entity.RuleConfig{RuleType: entity.RuleTypeBLEU})))
+	assert.Error(t, svc.Validate(context.Background(), newTestRuleEvaluator(&entity.RuleConfig{RuleType: entity.RuleTypeContains})))
+	assert.Error(t, svc.Validate(context.Background(), newTestRuleEvaluator(&entity.RuleConfig{RuleType: entity.RuleTypeLevenshtein, PassThreshold: gptr.Of(2.0)})))
+	assert.Error(t, svc.Validate(context.Background(), &entity.Evaluator{EvaluatorType: entity.EvaluatorTypePrompt}))
+	assert.Error(t, svc.Validate(context.Background(), newTestRuleEvaluator(&entity.RuleConfig{
+		RuleType: entity.RuleTypeRegexMatch,
+		Pattern:  strings.Repeat("a", entity.MaxRuleRegexPatternLen+1),
+	})))
+}
+
+func TestEvaluatorSourceRuleServiceImpl_compileRegex(t *testing.T) {
+	svc := NewEvaluatorSourceRuleServiceImpl(nil).(*EvaluatorSourceRuleServiceImpl)
+
+	re, err := svc.compileRegex(1, `^a+$`)
+	assert.NoError(t, err)
+	cached, err := svc.compileRegex(1, `^a+$`)
+	assert.NoError(t, err)
+	assert.Same(t, re, cached)
+
+	// 草稿版本修改 pattern 后重新编译
+	changed, err := svc.compileRegex(1, `^b+$`)
+	assert.NoError(t, err)
+	assert.True(t, changed.MatchString("bb"))
+
+	for i := int64(0); i <= ruleRegexCacheSize; i++ {
+		_, err = svc.compileRegex(100+i, `^a+$`)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, ruleRegexCacheSize, svc.regexCache.Len())
+
+	_, err = svc.compileRegex(2, strings.Repeat("a", entity.MaxRuleRegexPatternLen+1))
+	assert.Error(t, err)
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/events"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/contexts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
 	"github.com/coze-dev/coze-loop/backend/pkg/logs"
 )
@@ -178,6 +180,17 @@ func (e ExptAnnotateServiceImpl) SaveAnnotateRecord(ctx context.Context, exptID,
 
 	turnResultID := turnResult.ID
 
+	// 该 turn/tag 已有标注时, 视为追加一名标注者, 用于计算标注者间一致性
+	refs, err := e.repo.GetExptTurnAnnotateRecordRefsByTurnResultIDs(ctx, record.SpaceID, []int64{turnResultID})
+	if err != nil {
+		return err
+	}
+	for _, ref := range refs {
+		if ref.TagKeyID == record.TagKeyID {
+			return e.saveAdditionalAnnotateRecord(ctx, exptID, itemID, turnID, turnResultID, ref, record)
+		}
+	}
+
 	err = e.txDB.Transaction(ctx, func(tx *gorm.DB) error {
 		opts := []db.Option{db.WithTransaction(tx)}
 		err = e.repo.SaveAnnotateRecord(ctx, turnResultID, record, opts...)
@@ -239,6 +252,80 @@ func (e ExptAnnotateServiceImpl) SaveAnnotateRecord(ctx context.Context, exptID,
 	return nil
 }
 
+// saveAdditionalAnnotateRecord 保存同一 turn/tag 的追加标注者记录: 不建立 turn ref、不计入完成数,
+// 筛选与分布统计仍以首位标注者为准, 追加记录仅参与一致性度量。
+// 同一标注者再次提交时覆盖其已有记录, 与单标注者时的保存语义一致。
+func (e ExptAnnotateServiceImpl) saveAdditionalAnnotateRecord(ctx context.Context, exptID, itemID, turnID, turnResultID int64, primaryRef *entity.ExptTurnAnnotateRecordRef, record *entity.AnnotateRecord) error {
+	annotator := record.Annotator()
+	if annotator == "" {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("annotator is required when the turn has been annotated"))
+	}
+
+	existing, err := e.findTurnAnnotateRecordByAnnotator(ctx, exptID, turnResultID, primaryRef, record.SpaceID, record.TagKeyID, annotator)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		record.ID = existing.ID
+		record.ExperimentID = exptID
+		record.ExptTurnResultID = turnResultID
+		return e.UpdateAnnotateRecord(ctx, itemID, turnID, record)
+	}
+
+	record.ExperimentID = exptID
+	record.ExptTurnResultID = turnResultID
+	if err := e.repo.CreateAnnotateRecord(ctx, record); err != nil {
+		return err
+	}
+
+	tagRef, err := e.repo.GetTagRefByTagKeyID(contexts.WithCtxWriteDB(ctx), exptID, record.SpaceID, record.TagKeyID)
+	if err != nil {
+		return err
+	}
+	if tagRef.CompleteCnt != tagRef.TotalCnt {
+		return nil
+	}
+	return e.exptPublisher.PublishExptAggrCalculateEvent(ctx, []*entity.AggrCalculateEvent{{
+		SpaceID:       record.SpaceID,
+		ExperimentID:  exptID,
+		CalculateMode: entity.UpdateAnnotationFields,
+		SpecificFieldInfo: &entity.SpecificFieldInfo{
+			FieldKey:  strconv.FormatInt(record.TagKeyID, 10),
+			FieldType: entity.FieldType_Annotation,
+		},
+	}}, gptr.Of(time.Second*3))
+}
+
+// findTurnAnnotateRecordByAnnotator 查找标注者在该 turn/tag 下已有的标注记录, 不存在时返回 nil
+func (e ExptAnnotateServiceImpl) findTurnAnnotateRecordByAnnotator(ctx context.Context, exptID, turnResultID int64, primaryRef *entity.ExptTurnAnnotateRecordRef, spaceID, tagKeyID int64, annotator string) (*entity.AnnotateRecord, error) {
+	records, err := e.repo.GetAnnotateRecordsByTurnResultID(ctx, spaceID, exptID, tagKeyID, turnResultID)
+	if err != nil {
+		return nil, err
+	}
+	primaryLoaded := false
+	for _, r := range records {
+		if r.ID == primaryRef.AnnotateRecordID {
+			primaryLoaded = true
+		}
+		if r.Annotator() == annotator {
+			return r, nil
+		}
+	}
+	if primaryLoaded {
+		return nil, nil
+	}
+
+	// 存量首条记录未写入 expt_turn_result_id, 需按 ref 单独读取
+	primary, err := e.repo.GetAnnotateRecordByID(ctx, spaceID, primaryRef.AnnotateRecordID)
+	if err != nil {
+		return nil, err
+	}
+	if primary != nil && primary.Annotator() == annotator {
+		return primary, nil
+	}
+	return nil, nil
+}
+
 func (e ExptAnnotateServiceImpl) UpdateAnnotateRecord(ctx context.Context, itemID, turnID int64, record *entity.AnnotateRecord) error {
 	tagRef, err := e.repo.GetTagRefByTagKeyID(ctx, record.ExperimentID, record.SpaceID, record.TagKeyID)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -212,6 +213,9 @@ func TestExptAnnotateServiceImpl_SaveAnnotateRecord(t *testing.T) {
 				svc.exptTurnResultRepo.(*repoMocks.MockIExptTurnResultRepo).EXPECT().
 					Get(ctx, int64(1), int64(1), int64(1), int64(1)).
 					Return(turnResult, nil).Times(1)
+				svc.repo.(*repoMocks.MockIExptAnnotateRepo).EXPECT().
+					GetExptTurnAnnotateRecordRefsByTurnResultIDs(ctx, int64(1), []int64{1}).
+					Return([]*entity.ExptTurnAnnotateRecordRef{{TagKeyID: 2, AnnotateRecordID: 9}}, nil).Times(1)
 
 				// svc.txDB.(*dbMocks.MockProvider).EXPECT().
 				//	Transaction(ctx, gomock.Any()).
@@ -237,6 +241,131 @@ func TestExptAnnotateServiceImpl_SaveAnnotateRecord(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:   "已有标注时追加标注者",
+			exptID: 1,
+			itemID: 1,
+			turnID: 1,
+			record: &entity.AnnotateRecord{
+				ID:       12,
+				SpaceID:  1,
+				TagKeyID: 1,
+				BaseInfo: &entity.BaseInfo{CreatedBy: &entity.UserInfo{UserID: ptr.Of("u2")}},
+			},
+			setup: func() {
+				annotateRepo := svc.repo.(*repoMocks.MockIExptAnnotateRepo)
+				svc.exptTurnResultRepo.(*repoMocks.MockIExptTurnResultRepo).EXPECT().
+					Get(ctx, int64(1), int64(1), int64(1), int64(1)).
+					Return(&entity.ExptTurnResult{ID: 5}, nil).Times(1)
+				annotateRepo.EXPECT().GetExptTurnAnnotateRecordRefsByTurnResultIDs(ctx, int64(1), []int64{5}).
+					Return([]*entity.ExptTurnAnnotateRecordRef{{ExptTurnResultID: 5, TagKeyID: 1, AnnotateRecordID: 11}}, nil).Times(1)
+				annotateRepo.EXPECT().GetAnnotateRecordsByTurnResultID(ctx, int64(1), int64(1), int64(1), int64(5)).
+					Return([]*entity.AnnotateRecord{
+						{ID: 11, ExptTurnResultID: 5, BaseInfo: &entity.BaseInfo{CreatedBy: &entity.UserInfo{UserID: ptr.Of("u1")}}},
+					}, nil).Times(1)
+				annotateRepo.EXPECT().CreateAnnotateRecord(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, record *entity.AnnotateRecord, _ ...db.Option) error {
+						assert.Equal(t, int64(5), record.ExptTurnResultID)
+						assert.Equal(t, int64(1), record.ExperimentID)
+						return nil
+					}).Times(1)
+				annotateRepo.EXPECT().GetTagRefByTagKeyID(gomock.Any(), int64(1), int64(1), int64(1)).
+					Return(&entity.ExptTurnResultTagRef{TotalCnt: 10, CompleteCnt: 10}, nil).Times(1)
+				svc.exptPublisher.(*eventsMocks.MockExptEventPublisher).EXPECT().PublishExptAggrCalculateEvent(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, events []*entity.AggrCalculateEvent, _ *time.Duration) error {
+						assert.Equal(t, entity.UpdateAnnotationFields, events[0].CalculateMode)
+						return nil
+					}).Times(1)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "同一标注者重复标注时覆盖已有记录",
+			exptID: 1,
+			itemID: 1,
+			turnID: 1,
+			record: &entity.AnnotateRecord{
+				ID:       12,
+				SpaceID:  1,
+				TagKeyID: 1,
+				BaseInfo: &entity.BaseInfo{CreatedBy: &entity.UserInfo{UserID: ptr.Of("u2")}},
+			},
+			setup: func() {
+				annotateRepo := svc.repo.(*repoMocks.MockIExptAnnotateRepo)
+				svc.exptTurnResultRepo.(*repoMocks.MockIExptTurnResultRepo).EXPECT().
+					Get(ctx, int64(1), int64(1), int64(1), int64(1)).
+					Return(&entity.ExptTurnResult{ID: 5}, nil).Times(1)
+				annotateRepo.EXPECT().GetExptTurnAnnotateRecordRefsByTurnResultIDs(ctx, int64(1), []int64{5}).
+					Return([]*entity.ExptTurnAnnotateRecordRef{{ExptTurnResultID: 5, TagKeyID: 1, AnnotateRecordID: 11}}, nil).Times(1)
+				annotateRepo.EXPECT().GetAnnotateRecordsByTurnResultID(ctx, int64(1), int64(1), int64(1), int64(5)).
+					Return([]*entity.AnnotateRecord{
+						{ID: 11, ExptTurnResultID: 5, BaseInfo: &entity.BaseInfo{CreatedBy: &entity.UserInfo{UserID: ptr.Of("u1")}}},
+						{ID: 20, ExptTurnResultID: 5, BaseInfo: &entity.BaseInfo{CreatedBy: &entity.UserInfo{UserID: ptr.Of("u2")}}},
+					}, nil).Times(1)
+				annotateRepo.EXPECT().GetTagRefByTagKeyID(ctx, int64(1), int64(1), int64(1)).
+					Return(&entity.ExptTurnResultTagRef{TotalCnt: 10, CompleteCnt: 10}, nil).Times(1)
+				annotateRepo.EXPECT().UpdateAnnotateRecord(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, record *entity.AnnotateRecord) error {
+						assert.Equal(t, int64(20), record.ID)
+						assert.Equal(t, int64(5), record.ExptTurnResultID)
+						return nil
+					}).Times(1)
+				svc.exptResultService.(*svcMocks.MockExptResultService).EXPECT().UpsertExptTurnResultFilter(ctx, int64(1), int64(1), []int64{1}).Return(nil).Times(1)
+				svc.exptPublisher.(*eventsMocks.MockExptEventPublisher).EXPECT().PublishExptTurnResultFilterEvent(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				svc.exptPublisher.(*eventsMocks.MockExptEventPublisher).EXPECT().PublishExptAggrCalculateEvent(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "存量首条记录未归属 turn 时按 ref 读取并覆盖",
+			exptID: 1,
+			itemID: 1,
+			turnID: 1,
+			record: &entity.AnnotateRecord{
+				ID:       12,
+				SpaceID:  1,
+				TagKeyID: 1,
+				BaseInfo: &entity.BaseInfo{CreatedBy: &entity.UserInfo{UserID: ptr.Of("u1")}},
+			},
+			setup: func() {
+				annotateRepo := svc.repo.(*repoMocks.MockIExptAnnotateRepo)
+				svc.exptTurnResultRepo.(*repoMocks.MockIExptTurnResultRepo).EXPECT().
+					Get(ctx, int64(1), int64(1), int64(1), int64(1)).
+					Return(&entity.ExptTurnResult{ID: 5}, nil).Times(1)
+				annotateRepo.EXPECT().GetExptTurnAnnotateRecordRefsByTurnResultIDs(ctx, int64(1), []int64{5}).
+					Return([]*entity.ExptTurnAnnotateRecordRef{{ExptTurnResultID: 5, TagKeyID: 1, AnnotateRecordID: 11}}, nil).Times(1)
+				annotateRepo.EXPECT().GetAnnotateRecordsByTurnResultID(ctx, int64(1), int64(1), int64(1), int64(5)).
+					Return(nil, nil).Times(1)
+				annotateRepo.EXPECT().GetAnnotateRecordByID(ctx, int64(1), int64(11)).
+					Return(&entity.AnnotateRecord{ID: 11, BaseInfo: &entity.BaseInfo{CreatedBy: &entity.UserInfo{UserID: ptr.Of("u1")}}}, nil).Times(1)
+				annotateRepo.EXPECT().GetTagRefByTagKeyID(ctx, int64(1), int64(1), int64(1)).
+					Return(&entity.ExptTurnResultTagRef{TotalCnt: 10, CompleteCnt: 9}, nil).Times(1)
+				annotateRepo.EXPECT().UpdateAnnotateRecord(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, record *entity.AnnotateRecord) error {
+						assert.Equal(t, int64(11), record.ID)
+						assert.Equal(t, int64(5), record.ExptTurnResultID)
+						return nil
+					}).Times(1)
+				svc.exptResultService.(*svcMocks.MockExptResultService).EXPECT().UpsertExptTurnResultFilter(ctx, int64(1), int64(1), []int64{1}).Return(nil).Times(1)
+				svc.exptPublisher.(*eventsMocks.MockExptEventPublisher).EXPECT().PublishExptTurnResultFilterEvent(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "追加标注缺少标注者",
+			exptID: 1,
+			itemID: 1,
+			turnID: 1,
+			record: &entity.AnnotateRecord{SpaceID: 1, TagKeyID: 1},
+			setup: func() {
+				svc.exptTurnResultRepo.(*repoMocks.MockIExptTurnResultRepo).EXPECT().
+					Get(ctx, int64(1), int64(1), int64(1), int64(1)).
+					Return(&entity.ExptTurnResult{ID: 5}, nil).Times(1)
+				svc.repo.(*repoMocks.MockIExptAnnotateRepo).EXPECT().GetExptTurnAnnotateRecordRefsByTurnResultIDs(ctx, int64(1), []int64{5}).
+					Return([]*entity.ExptTurnAnnotateRecordRef{{ExptTurnResultID: 5, TagKeyID: 1, AnnotateRecordID: 11}}, nil).Times(1)
+			},
+			wantErr: true,
+		},
 		{
 			name:   "获取轮次结果失败",
 			exptID: 1,
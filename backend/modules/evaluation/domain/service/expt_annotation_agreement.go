@@ -0,0 +1,321 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/stats"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// judgeBoolThreshold 布尔标签计算评估器-人工 kappa 时, 评估器得分的二值化阈值
+const judgeBoolThreshold = 0.5
+
+// annotationUnit 单个 turn 上各标注者的标注记录
+type annotationUnit struct {
+	turnResultID int64
+	// records key 为标注者, 老数据无标注者时以记录 id 代替
+	records map[string]*entity.AnnotateRecord
+}
+
+// calcAnnotationAgreement 计算人工标注标签的多标注者一致性及评估器-人工一致性。
+// 一致性仅为附加信息, 计算失败时打日志并返回 nil, 不影响分布聚合结果的写入。
+func (e *ExptAggrResultServiceImpl) calcAnnotationAgreement(ctx context.Context, spaceID, exptID, tagKeyID int64,
+	contentType entity.TagContentType, refs []*entity.ExptTurnAnnotateRecordRef, primaryRecords []*entity.AnnotateRecord,
+) *entity.AnnotationAgreement {
+	if contentType != entity.TagContentTypeCategorical && contentType != entity.TagContentTypeBoolean && contentType != entity.TagContentTypeContinuousNumber {
+		return nil
+	}
+
+	allRecords, err := e.exptAnnotateRepo.GetAnnotateRecordsByTagKeyID(ctx, spaceID, exptID, tagKeyID)
+	if err != nil {
+		logs.CtxWarn(ctx, "calc annotation agreement load records fail, expt_id: %v, tag_key_id: %v, err: %v", exptID, tagKeyID, err)
+		return nil
+	}
+	units := groupAnnotationUnits(refs, primaryRecords, allRecords)
+
+	agreement := calcInterAnnotatorAgreement(contentType, units)
+	if contentType != entity.TagContentTypeCategorical {
+		judges, err := e.calcJudgeHumanAgreements(ctx, spaceID, exptID, contentType, units)
+		if err != nil {
+			logs.CtxWarn(ctx, "calc judge human agreement fail, expt_id: %v, tag_key_id: %v, err: %v", exptID, tagKeyID, err)
+		}
+		agreement.JudgeAgreements = judges
+	}
+
+	if agreement.UnitCnt == 0 && len(agreement.JudgeAgreements) == 0 {
+		return nil
+	}
+	return agreement
+}
+
+// groupAnnotationUnits 按 turn 归集标注记录: 首位标注者的记录经 ref 归属, 追加标注者的记录经 ExptTurnResultID 归属
+func groupAnnotationUnits(refs []*entity.ExptTurnAnnotateRecordRef, primaryRecords, allRecords []*entity.AnnotateRecord) []*annotationUnit {
+	recordID2TurnResultID := make(map[int64]int64, len(refs))
+	for _, ref := range refs {
+		recordID2TurnResultID[ref.AnnotateRecordID] = ref.ExptTurnResultID
+	}
+
+	recordByID := make(map[int64]*entity.AnnotateRecord, len(allRecords)+len(primaryRecords))
+	for _, r := range primaryRecords {
+		recordByID[r.ID] = r
+	}
+	for _, r := range allRecords {
+		recordByID[r.ID] = r
+	}
+
+	unitMap := make(map[int64]*annotationUnit)
+	for _, r := range recordByID {
+		if r == nil || r.AnnotateData == nil {
+			continue
+		}
+		turnResultID, ok := recordID2TurnResultID[r.ID]
+		if !ok {
+			turnResultID = r.ExptTurnResultID
+		}
+		if turnResultID == 0 {
+			continue
+		}
+		unit, ok := unitMap[turnResultID]
+		if !ok {
+			unit = &annotationUnit{turnResultID: turnResultID, records: make(map[string]*entity.AnnotateRecord)}
+			unitMap[turnResultID] = unit
+		}
+		annotator := r.Annotator()
+		if annotator == "" {
+			annotator = "record:" + strconv.FormatInt(r.ID, 10)
+		}
+		unit.records[annotator] = r
+	}
+
+	units := make([]*annotationUnit, 0, len(unitMap))
+	for _, unit := range unitMap {
+		units = append(units, unit)
+	}
+	sort.Slice(units, func(i, j int) bool { return units[i].turnResultID < units[j].turnResultID })
+	return units
+}
+
+func calcInterAnnotatorAgreement(contentType entity.TagContentType, units []*annotationUnit) *entity.AnnotationAgreement {
+	agreement := &entity.AnnotationAgreement{}
+	annotators := make(map[string]struct{})
+	var (
+		nominalUnits  [][]string
+		intervalUnits [][]float64
+	)
+	for _, unit := range units {
+		for annotator := range unit.records {
+			annotators[annotator] = struct{}{}
+		}
+		if len(unit.records) < 2 {
+			continue
+		}
+		agreement.UnitCnt++
+		if contentType == entity.TagContentTypeContinuousNumber {
+			intervalUnits = append(intervalUnits, unitScores(unit))
+		} else {
+			nominalUnits = append(nominalUnits, unitOptions(unit))
+		}
+	}
+	agreement.AnnotatorCnt = len(annotators)
+	if agreement.UnitCnt == 0 {
+		return agreement
+	}
+
+	if contentType == entity.TagContentTypeContinuousNumber {
+		if alpha, ok := stats.KrippendorffAlphaInterval(intervalUnits); ok {
+			agreement.KrippendorffAlpha = gptr.Of(alpha)
+		}
+		return agreement
+	}
+
+	if alpha, ok := stats.KrippendorffAlphaNominal(nominalUnits); ok {
+		agreement.KrippendorffAlpha = gptr.Of(alpha)
+	}
+	if kappa, ok := stats.FleissKappa(nominalUnits); ok {
+		agreement.FleissKappa = gptr.Of(kappa)
+	}
+	if kappa, ok := pairwiseCohenKappa(units); ok {
+		agreement.CohenKappa = gptr.Of(kappa)
+	}
+	return agreement
+}
+
+// pairwiseCohenKappa 两两标注者在共同标注的 turn 上计算 Cohen's kappa 后取均值
+func pairwiseCohenKappa(units []*annotationUnit) (float64, bool) {
+	annotatorSet := make(map[string]struct{})
+	for _, unit := range units {
+		for annotator := range unit.records {
+			annotatorSet[annotator] = struct{}{}
+		}
+	}
+	annotators := make([]string, 0, len(annotatorSet))
+	for annotator := range annotatorSet {
+		annotators = append(annotators, annotator)
+	}
+	sort.Strings(annotators)
+
+	var (
+		sum float64
+		cnt int
+	)
+	for i := 0; i < len(annotators); i++ {
+		for j := i + 1; j < len(annotators); j++ {
+			var a, b []string
+			for _, unit := range units {
+				ra, oka := unit.records[annotators[i]]
+				rb, okb := unit.records[annotators[j]]
+				if oka && okb {
+					a = append(a, strconv.FormatInt(ra.TagValueID, 10))
+					b = append(b, strconv.FormatInt(rb.TagValueID, 10))
+				}
+			}
+			if kappa, ok := stats.CohenKappa(a, b); ok {
+				sum += kappa
+				cnt++
+			}
+		}
+	}
+	if cnt == 0 {
+		return 0, false
+	}
+	return sum / float64(cnt), true
+}
+
+// calcJudgeHumanAgreements 对实验中每个评估器实例, 与各 turn 人工标注 (多人时取均值, 布尔取多数) 计算一致性
+func (e *ExptAggrResultServiceImpl) calcJudgeHumanAgreements(ctx context.Context, spaceID, exptID int64,
+	contentType entity.TagContentType, units []*annotationUnit,
+) ([]*entity.JudgeHumanAgreement, error) {
+	humanByTurnResultID := make(map[int64][]float64, len(units))
+	for _, unit := range units {
+		var values []float64
+		for _, r := range unit.records {
+			if v, ok := humanNumericValue(contentType, r); ok {
+				values = append(values, v)
+			}
+		}
+		if len(values) > 0 {
+			humanByTurnResultID[unit.turnResultID] = values
+		}
+	}
+	if len(humanByTurnResultID) == 0 {
+		return nil, nil
+	}
+
+	turnResults, err := scanExptTurnResults(ctx, e.exptTurnResultRepo, spaceID, exptID)
+	if err != nil {
+		return nil, err
+	}
+	scores, err := loadExptTurnScoresByTurns(ctx, e.exptTurnResultRepo, e.evaluatorRecordService, spaceID, exptID, turnResults)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceKeys := make([]string, 0, len(scores))
+	for key := range scores {
+		instanceKeys = append(instanceKeys, key)
+	}
+	sort.Strings(instanceKeys)
+
+	res := make([]*entity.JudgeHumanAgreement, 0, len(instanceKeys))
+	for _, key := range instanceKeys {
+		versionID, alias, err := entity.ParseEvaluatorScoreFieldKey(key)
+		if err != nil {
+			continue
+		}
+		var (
+			judge, human     []float64
+			judgeBin, hBin   []string
+			alphaUnits       [][]float64
+			sumAbsoluteError float64
+		)
+		for _, tr := range turnResults {
+			humanValues, ok := humanByTurnResultID[tr.ID]
+			if !ok {
+				continue
+			}
+			score, ok := scores[key][entity.ItemTurnID{ItemID: tr.ItemID, TurnID: tr.TurnID}]
+			if !ok {
+				continue
+			}
+			humanValue := stats.Mean(humanValues)
+			judge = append(judge, score)
+			human = append(human, humanValue)
+			sumAbsoluteError += math.Abs(score - humanValue)
+			alphaUnits = append(alphaUnits, append(append([]float64{}, humanValues...), score))
+			if contentType == entity.TagContentTypeBoolean {
+				judgeBin = append(judgeBin, strconv.FormatBool(score >= judgeBoolThreshold))
+				hBin = append(hBin, strconv.FormatBool(humanValue >= judgeBoolThreshold))
+			}
+		}
+		if len(judge) == 0 {
+			continue
+		}
+
+		agreement := &entity.JudgeHumanAgreement{
+			EvaluatorVersionID: versionID,
+			Alias:              alias,
+			PairedCnt:          len(judge),
+			MeanAbsoluteError:  gptr.Of(sumAbsoluteError / float64(len(judge))),
+		}
+		if r, ok := stats.Pearson(judge, human); ok {
+			agreement.PearsonCorrelation = gptr.Of(r)
+		}
+		if alpha, ok := stats.KrippendorffAlphaInterval(alphaUnits); ok {
+			agreement.KrippendorffAlpha = gptr.Of(alpha)
+		}
+		if kappa, ok := stats.CohenKappa(judgeBin, hBin); ok {
+			agreement.CohenKappa = gptr.Of(kappa)
+		}
+		res = append(res, agreement)
+	}
+	return res, nil
+}
+
+// humanNumericValue 人工标注的数值: 连续数值取分数, 布尔标签按选项值解析为 0/1
+func humanNumericValue(contentType entity.TagContentType, record *entity.AnnotateRecord) (float64, bool) {
+	switch contentType {
+	case entity.TagContentTypeContinuousNumber:
+		if record.AnnotateData.Score == nil {
+			return 0, false
+		}
+		return *record.AnnotateData.Score, true
+	case entity.TagContentTypeBoolean:
+		b, err := strconv.ParseBool(gptr.Indirect(record.AnnotateData.BoolValue))
+		if err != nil {
+			return 0, false
+		}
+		if b {
+			return 1, true
+		}
+		return 0, true
+	default:
+		return 0, false
+	}
+}
+
+func unitScores(unit *annotationUnit) []float64 {
+	values := make([]float64, 0, len(unit.records))
+	for _, r := range unit.records {
+		if r.AnnotateData.Score != nil {
+			values = append(values, *r.AnnotateData.Score)
+		}
+	}
+	return values
+}
+
+func unitOptions(unit *annotationUnit) []string {
+	values := make([]string, 0, len(unit.records))
+	for _, r := range unit.records {
+		values = append(values, strconv.FormatInt(r.TagValueID, 10))
+	}
+	return values
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repoMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+func newAnnotateRecord(id, turnResultID int64, annotator string, data *entity.AnnotateData, tagValueID int64) *entity.AnnotateRecord {
+	return &entity.AnnotateRecord{
+		ID:               id,
+		ExptTurnResultID: turnResultID,
+		AnnotateData:     data,
+		TagValueID:       tagValueID,
+		BaseInfo:         &entity.BaseInfo{CreatedBy: &entity.UserInfo{UserID: gptr.Of(annotator)}},
+	}
+}
+
+func TestExptAggrResultServiceImpl_calcAnnotationAgreement(t *testing.T) {
+	const spaceID, exptID, tagKeyID = int64(100), int64(1), int64(7)
+
+	t.Run("连续数值标签: 多标注者一致性与评估器-人工一致性", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := &regressionTestMocks{
+			turnRepo:  repoMocks.NewMockIExptTurnResultRepo(ctrl),
+			recordSvc: svcMocks.NewMockEvaluatorRecordService(ctrl),
+		}
+		annotateRepo := repoMocks.NewMockIExptAnnotateRepo(ctrl)
+		svc := &ExptAggrResultServiceImpl{exptTurnResultRepo: m.turnRepo, evaluatorRecordService: m.recordSvc, exptAnnotateRepo: annotateRepo}
+
+		score := func(v float64) *entity.AnnotateData {
+			return &entity.AnnotateData{TagContentType: entity.TagContentTypeContinuousNumber, Score: gptr.Of(v)}
+		}
+		// 首位标注者的记录经 ref 归属 (老数据 ExptTurnResultID 为 0), 追加标注者的记录经 ExptTurnResultID 归属
+		refs := []*entity.ExptTurnAnnotateRecordRef{
+			{ExptTurnResultID: 1000, AnnotateRecordID: 1},
+			{ExptTurnResultID: 1001, AnnotateRecordID: 2},
+			{ExptTurnResultID: 1002, AnnotateRecordID: 3},
+		}
+		primary := []*entity.AnnotateRecord{
+			newAnnotateRecord(1, 0, "u1", score(0.2), 0),
+			newAnnotateRecord(2, 0, "u1", score(0.6), 0),
+			newAnnotateRecord(3, 0, "u1", score(1.0), 0),
+		}
+		annotateRepo.EXPECT().GetAnnotateRecordsByTagKeyID(gomock.Any(), spaceID, exptID, tagKeyID).Return(append(primary,
+			newAnnotateRecord(4, 1000, "u2", score(0.2), 0),
+			newAnnotateRecord(5, 1001, "u2", score(0.4), 0),
+		), nil)
+		mockRegressionTurns(m, spaceID, exptID, 11, []float64{0.2, 0.5, 0.9}, nil)
+
+		agreement := svc.calcAnnotationAgreement(context.Background(), spaceID, exptID, tagKeyID, entity.TagContentTypeContinuousNumber, refs, primary)
+		if assert.NotNil(t, agreement) {
+			assert.Equal(t, 2, agreement.AnnotatorCnt)
+			assert.Equal(t, 2, agreement.UnitCnt)
+			assert.NotNil(t, agreement.KrippendorffAlpha)
+			assert.Nil(t, agreement.CohenKappa)
+			assert.Nil(t, agreement.FleissKappa)
+			if assert.Len(t, agreement.JudgeAgreements, 1) {
+				judge := agreement.JudgeAgreements[0]
+				assert.Equal(t, int64(11), judge.EvaluatorVersionID)
+				assert.Equal(t, 3, judge.PairedCnt)
+				assert.InDelta(t, 0.1/3, *judge.MeanAbsoluteError, 1e-9)
+				assert.Greater(t, *judge.PearsonCorrelation, 0.9)
+				assert.Nil(t, judge.CohenKappa)
+			}
+		}
+	})
+
+	t.Run("分类标签: kappa 与 alpha", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		annotateRepo := repoMocks.NewMockIExptAnnotateRepo(ctrl)
+		svc := &ExptAggrResultServiceImpl{exptAnnotateRepo: annotateRepo}
+
+		option := &entity.AnnotateData{TagContentType: entity.TagContentTypeCategorical}
+		refs := []*entity.ExptTurnAnnotateRecordRef{
+			{ExptTurnResultID: 1, AnnotateRecordID: 1},
+			{ExptTurnResultID: 2, AnnotateRecordID: 2},
+			{ExptTurnResultID: 3, AnnotateRecordID: 3},
+		}
+		primary := []*entity.AnnotateRecord{
+			newAnnotateRecord(1, 1, "u1", option, 1),
+			newAnnotateRecord(2, 2, "u1", option, 2),
+			newAnnotateRecord(3, 3, "u1", option, 1),
+		}
+		annotateRepo.EXPECT().GetAnnotateRecordsByTagKeyID(gomock.Any(), spaceID, exptID, tagKeyID).Return(append(primary,
+			newAnnotateRecord(4, 1, "u2", option, 1),
+			newAnnotateRecord(5, 2, "u2", option, 2),
+			newAnnotateRecord(6, 3, "u2", option, 2),
+		), nil)
+
+		agreement := svc.calcAnnotationAgreement(context.Background(), spaceID, exptID, tagKeyID, entity.TagContentTypeCategorical, refs, primary)
+		if assert.NotNil(t, agreement) {
+			assert.Equal(t, 2, agreement.AnnotatorCnt)
+			assert.Equal(t, 3, agreement.UnitCnt)
+			assert.InDelta(t, 0.33333, *agreement.FleissKappa, 1e-4)
+			assert.InDelta(t, 0.4, *agreement.CohenKappa, 1e-4)
+			assert.InDelta(t, 0.44444, *agreement.KrippendorffAlpha, 1e-4)
+			assert.Empty(t, agreement.JudgeAgreements)
+		}
+	})
+
+	t.Run("单标注者且无评估器得分时为空", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		annotateRepo := repoMocks.NewMockIExptAnnotateRepo(ctrl)
+		svc := &ExptAggrResultServiceImpl{exptAnnotateRepo: annotateRepo}
+
+		primary := []*entity.AnnotateRecord{newAnnotateRecord(1, 1, "u1", &entity.AnnotateData{TagContentType: entity.TagContentTypeCategorical}, 1)}
+		annotateRepo.EXPECT().GetAnnotateRecordsByTagKeyID(gomock.Any(), spaceID, exptID, tagKeyID).Return(primary, nil)
+		assert.Nil(t, svc.calcAnnotationAgreement(context.Background(), spaceID, exptID, tagKeyID, entity.TagContentTypeCategorical, nil, primary))
+		assert.Nil(t, svc.calcAnnotationAgreement(context.Background(), spaceID, exptID, tagKeyID, entity.TagContentTypeFreeText, nil, primary))
+	})
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/expt_concurrency.go -package=mocks . IExptConcurrencyController
+
+// IExptConcurrencyController 按评测对象/评估器后端自适应调整实验 item 并发 (AIMD),
+// 并通过分布式限流在调用同一后端的实验间共享提交预算。
+// 后端按模型归并 (见 entity.TargetBackendKey / entity.EvaluatorBackendKey), 未开启 ExptAdaptiveConcurConf 时不生效。
+type IExptConcurrencyController interface {
+	// Observe 记录一个 turn 的执行结果 (失败/限流/耗时) 到各后端的观测窗口, 失败只打日志不影响评测
+	Observe(ctx context.Context, expt *entity.Experiment, result *entity.ExptTurnRunResult)
+	// Adjust 消费上一窗口的观测并按 AIMD 调整各后端并发, 返回实验当前的有效 item 并发;
+	// 未开启自适应时返回实验配置的 item 并发
+	Adjust(ctx context.Context, expt *entity.Experiment) (int, error)
+	// AcquireBudget 从各后端共享预算中为 n 个待提交 item 申请额度, 返回获批数量
+	AcquireBudget(ctx context.Context, expt *entity.Experiment, n int) int
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+type ExptConcurrencyControllerImpl struct {
+	concurRepo    repo.IExptConcurrencyRepo
+	budgetLimiter repo.IExptBackendBudgetLimiter
+	configer      component.IConfiger
+}
+
+func NewExptConcurrencyController(
+	concurRepo repo.IExptConcurrencyRepo,
+	budgetLimiter repo.IExptBackendBudgetLimiter,
+	configer component.IConfiger,
+) IExptConcurrencyController {
+	return &ExptConcurrencyControllerImpl{
+		concurRepo:    concurRepo,
+		budgetLimiter: budgetLimiter,
+		configer:      configer,
+	}
+}
+
+func (e *ExptConcurrencyControllerImpl) Observe(ctx context.Context, expt *entity.Experiment, result *entity.ExptTurnRunResult) {
+	if expt == nil || result == nil || !e.getConf(ctx, expt).IsEnabled() {
+		return
+	}
+
+	observations := make(map[string]*entity.ExptBackendObservation)
+	observe := func(backend string, failed, throttled bool, latencyMS int64) {
+		obs, ok := observations[backend]
+		if !ok {
+			obs = &entity.ExptBackendObservation{}
+			observations[backend] = obs
+		}
+		obs.Record(failed, throttled, latencyMS)
+	}
+
+	if record := result.TargetResult; isObservableTargetRecord(record) {
+		var (
+			failed, throttled bool
+			latencyMS         int64
+		)
+		if output := record.EvalTargetOutputData; output != nil {
+			if runErr := output.EvalTargetRunError; runErr != nil {
+				failed = true
+				throttled = entity.IsThrottledErr(runErr.Code, runErr.Message)
+			}
+			latencyMS = gptr.Indirect(output.TimeConsumingMS)
+		}
+		if gptr.Indirect(record.Status) == entity.EvalTargetRunStatusFail {
+			failed = true
+		}
+		observe(exptTargetBackend(expt), failed, throttled, latencyMS)
+	}
+
+	var evaluatorModelIDs map[int64]int64
+	for _, record := range result.EvaluatorResults {
+		if !isObservableEvaluatorRecord(record) {
+			continue
+		}
+		if evaluatorModelIDs == nil {
+			evaluatorModelIDs = exptEvaluatorModelIDs(expt)
+		}
+		var (
+			failed    = record.Status == entity.EvaluatorRunStatusFail
+			throttled bool
+			latencyMS int64
+		)
+		if output := record.EvaluatorOutputData; output != nil {
+			if runErr := output.EvaluatorRunError; runErr != nil {
+				failed = true
+				throttled = entity.IsThrottledErr(runErr.Code, runErr.Message)
+			}
+			latencyMS = output.TimeConsumingMS
+		}
+		observe(entity.EvaluatorBackendKey(record.EvaluatorVersionID, evaluatorModelIDs[record.EvaluatorVersionID]), failed, throttled, latencyMS)
+	}
+
+	for backend, obs := range observations {
+		if err := e.concurRepo.IncrBackendObservation(ctx, expt.ID, backend, obs); err != nil {
+			logs.CtxWarn(ctx, "[ExptConcurrency] incr backend observation fail, expt_id: %v, backend: %v, err: %v", expt.ID, backend, err)
+		}
+	}
+}
+
+func (e *ExptConcurrencyControllerImpl) Adjust(ctx context.Context, expt *entity.Experiment) (int, error) {
+	execConf := e.configer.GetExptExecConf(ctx, expt.SpaceID)
+	concurNum, explicit := getExptItemConcurNum(ctx, e.configer, expt)
+	conf := execConf.GetAdaptiveConcurConf()
+	if !conf.IsEnabled() {
+		return concurNum, nil
+	}
+	backends := exptBackends(expt)
+	if len(backends) == 0 {
+		return concurNum, nil
+	}
+
+	// 实验显式指定的 item 并发作为上限, 否则可增长到配置的上限
+	upper := concurNum
+	if !explicit {
+		upper = conf.GetMaxConcurNum(execConf.GetExptItemEvalConf().GetMaxItemConcurNum())
+	}
+	lower := min(conf.GetMinConcurNum(), upper)
+
+	effective := upper
+	for _, backend := range backends {
+		cur, err := e.concurRepo.GetBackendConcurNum(ctx, expt.ID, backend)
+		if err != nil {
+			return 0, err
+		}
+		if cur <= 0 {
+			cur = concurNum
+		}
+		obs, err := e.concurRepo.PopBackendObservation(ctx, expt.ID, backend)
+		if err != nil {
+			return 0, err
+		}
+		next := conf.NextConcurNum(cur, lower, upper, obs)
+		if err := e.concurRepo.SetBackendConcurNum(ctx, expt.ID, backend, next); err != nil {
+			return 0, err
+		}
+		if next != cur {
+			logs.CtxInfo(ctx, "[ExptConcurrency] adjust backend concurrency, expt_id: %v, backend: %v, from: %v, to: %v, total: %v, error_rate: %v, throttled: %v, avg_latency_ms: %v",
+				expt.ID, backend, cur, next, obs.GetTotal(), obs.ErrorRate(), obs.GetThrottled(), obs.AvgLatencyMS())
+		}
+		effective = min(effective, next)
+	}
+	return effective, nil
+}
+
+// AcquireBudget 各后端依次申请, 后一个后端的申请量不超过前一个的获批量;
+// 前面后端多批出的额度不退还, 只会让共享预算略偏保守。
+func (e *ExptConcurrencyControllerImpl) AcquireBudget(ctx context.Context, expt *entity.Experiment, n int) int {
+	conf := e.getConf(ctx, expt)
+	if !conf.IsEnabled() || n <= 0 {
+		return n
+	}
+	for _, backend := range exptBackends(expt) {
+		budget := conf.GetBackendBudget(backend)
+		if budget == nil {
+			continue
+		}
+		if n = e.budgetLimiter.AcquireN(ctx, backend, n, budget); n <= 0 {
+			return 0
+		}
+	}
+	return n
+}
+
+func (e *ExptConcurrencyControllerImpl) getConf(ctx context.Context, expt *entity.Experiment) *entity.ExptAdaptiveConcurConf {
+	return e.configer.GetExptExecConf(ctx, expt.SpaceID).GetAdaptiveConcurConf()
+}
+
+// exptBackends 实验涉及的全部后端, 调用同一模型的评测对象与评估器归并为一个后端
+func exptBackends(expt *entity.Experiment) []string {
+	backends := make([]string, 0, len(expt.Evaluators)+1)
+	seen := make(map[string]bool)
+	add := func(backend string) {
+		if !seen[backend] {
+			seen[backend] = true
+			backends = append(backends, backend)
+		}
+	}
+	if expt.TargetVersionID > 0 {
+		add(exptTargetBackend(expt))
+	}
+	modelIDs := exptEvaluatorModelIDs(expt)
+	for _, ev := range expt.Evaluators {
+		if ev == nil {
+			continue
+		}
+		add(entity.EvaluatorBackendKey(ev.GetEvaluatorVersionID(), modelIDs[ev.GetEvaluatorVersionID()]))
+	}
+	return backends
+}
+
+func exptTargetBackend(expt *entity.Experiment) string {
+	return entity.TargetBackendKey(expt.TargetID, expt.TargetVersionID, exptTargetModelID(expt))
+}
+
+// isObservableTargetRecord 缓存命中与异步执行中的结果不反映后端负载, 不计入观测
+func isObservableTargetRecord(record *entity.EvalTargetRecord) bool {
+	if record == nil || record.Ext[entity.ResultCacheHitExtKey] != "" {
+		return false
+	}
+	return gptr.Indirect(record.Status) != entity.EvalTargetRunStatusAsyncInvoking
+}
+
+func isObservableEvaluatorRecord(record *entity.EvaluatorRecord) bool {
+	if record == nil || record.Ext[entity.ResultCacheHitExtKey] != "" {
+		return false
+	}
+	return record.Status == entity.EvaluatorRunStatusSuccess || record.Status == entity.EvaluatorRunStatusFail
+}
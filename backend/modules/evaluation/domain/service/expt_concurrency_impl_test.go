@@ -0,0 +1,180 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	componentMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repoMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+)
+
+func newConcurTestExpt() *entity.Experiment {
+	return &entity.Experiment{
+		ID:              1,
+		SpaceID:         2,
+		TargetID:        10,
+		TargetVersionID: 11,
+		EvalConf:        &entity.EvaluationConfiguration{},
+		Evaluators: []*entity.Evaluator{
+			newCostTestPromptEvaluator(21, 7),
+			{EvaluatorType: entity.EvaluatorTypeCode, CodeEvaluatorVersion: &entity.CodeEvaluatorVersion{ID: 22}},
+		},
+	}
+}
+
+func newConcurTestExecConf(adaptive *entity.ExptAdaptiveConcurConf) *entity.ExptExecConf {
+	return &entity.ExptExecConf{
+		ExptItemEvalConf:   &entity.ExptItemEvalConf{ConcurNum: 4, MaxItemConcurNum: 50},
+		AdaptiveConcurConf: adaptive,
+	}
+}
+
+func TestExptConcurrencyControllerImpl_Observe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	concurRepo := repoMocks.NewMockIExptConcurrencyRepo(ctrl)
+	configer := componentMocks.NewMockIConfiger(ctrl)
+	configer.EXPECT().GetExptExecConf(gomock.Any(), int64(2)).Return(newConcurTestExecConf(&entity.ExptAdaptiveConcurConf{Enable: true})).AnyTimes()
+
+	svc := NewExptConcurrencyController(concurRepo, nil, configer)
+	expt := newConcurTestExpt()
+
+	got := make(map[string]*entity.ExptBackendObservation)
+	concurRepo.EXPECT().IncrBackendObservation(gomock.Any(), int64(1), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int64, backend string, obs *entity.ExptBackendObservation) error {
+			got[backend] = obs
+			return nil
+		}).Times(2)
+
+	svc.Observe(context.Background(), expt, &entity.ExptTurnRunResult{
+		TargetResult: &entity.EvalTargetRecord{
+			Status: gptr.Of(entity.EvalTargetRunStatusFail),
+			EvalTargetOutputData: &entity.EvalTargetOutputData{
+				EvalTargetRunError: &entity.EvalTargetRunError{Code: 500, Message: "429 Too Many Requests"},
+				TimeConsumingMS:    gptr.Of(int64(1200)),
+			},
+		},
+		EvaluatorResults: []*entity.EvaluatorRecord{
+			{EvaluatorVersionID: 21, Status: entity.EvaluatorRunStatusSuccess, EvaluatorOutputData: &entity.EvaluatorOutputData{TimeConsumingMS: 300}},
+			// 缓存命中与异步执行中的结果不计入观测
+			{EvaluatorVersionID: 22, Status: entity.EvaluatorRunStatusSuccess, Ext: map[string]string{entity.ResultCacheHitExtKey: "1"}},
+			{EvaluatorVersionID: 23, Status: entity.EvaluatorRunStatusAsyncInvoking},
+		},
+	})
+
+	assert.Equal(t, &entity.ExptBackendObservation{Total: 1, Failed: 1, Throttled: 1, LatencyMS: 1200}, got["target:10:11"])
+	assert.Equal(t, &entity.ExptBackendObservation{Total: 1, LatencyMS: 300}, got["model:7"])
+
+	t.Run("未开启自适应不记录", func(t *testing.T) {
+		disabledConfiger := componentMocks.NewMockIConfiger(ctrl)
+		disabledConfiger.EXPECT().GetExptExecConf(gomock.Any(), gomock.Any()).Return(newConcurTestExecConf(nil))
+		NewExptConcurrencyController(concurRepo, nil, disabledConfiger).Observe(context.Background(), expt, &entity.ExptTurnRunResult{})
+	})
+}
+
+func TestExptConcurrencyControllerImpl_Adjust(t *testing.T) {
+	adaptive := &entity.ExptAdaptiveConcurConf{Enable: true, MaxConcurNum: 8, MinSamples: 1}
+
+	tests := []struct {
+		name        string
+		adaptive    *entity.ExptAdaptiveConcurConf
+		itemConcur  *int
+		prepareMock func(r *repoMocks.MockIExptConcurrencyRepo)
+		want        int
+		wantErr     bool
+	}{
+		{
+			name:        "未开启自适应返回配置并发",
+			prepareMock: func(r *repoMocks.MockIExptConcurrencyRepo) {},
+			want:        4,
+		},
+		{
+			name:     "首次调整以配置并发为起点, 取各后端最小值",
+			adaptive: adaptive,
+			prepareMock: func(r *repoMocks.MockIExptConcurrencyRepo) {
+				r.EXPECT().GetBackendConcurNum(gomock.Any(), int64(1), gomock.Any()).Return(0, nil).Times(3)
+				r.EXPECT().PopBackendObservation(gomock.Any(), int64(1), "target:10:11").Return(&entity.ExptBackendObservation{Total: 5}, nil)
+				r.EXPECT().PopBackendObservation(gomock.Any(), int64(1), "model:7").Return(&entity.ExptBackendObservation{Total: 2, Failed: 1, Throttled: 1}, nil)
+				r.EXPECT().PopBackendObservation(gomock.Any(), int64(1), "evaluator:22").Return(&entity.ExptBackendObservation{}, nil)
+				r.EXPECT().SetBackendConcurNum(gomock.Any(), int64(1), "target:10:11", 5).Return(nil)
+				r.EXPECT().SetBackendConcurNum(gomock.Any(), int64(1), "model:7", 2).Return(nil)
+				r.EXPECT().SetBackendConcurNum(gomock.Any(), int64(1), "evaluator:22", 4).Return(nil)
+			},
+			want: 2,
+		},
+		{
+			name:     "增长不超过上限",
+			adaptive: adaptive,
+			prepareMock: func(r *repoMocks.MockIExptConcurrencyRepo) {
+				r.EXPECT().GetBackendConcurNum(gomock.Any(), int64(1), gomock.Any()).Return(8, nil).Times(3)
+				r.EXPECT().PopBackendObservation(gomock.Any(), int64(1), gomock.Any()).Return(&entity.ExptBackendObservation{Total: 3}, nil).Times(3)
+				r.EXPECT().SetBackendConcurNum(gomock.Any(), int64(1), gomock.Any(), 8).Return(nil).Times(3)
+			},
+			want: 8,
+		},
+		{
+			name:       "实验显式指定的并发作为上限",
+			adaptive:   adaptive,
+			itemConcur: gptr.Of(3),
+			prepareMock: func(r *repoMocks.MockIExptConcurrencyRepo) {
+				r.EXPECT().GetBackendConcurNum(gomock.Any(), int64(1), gomock.Any()).Return(3, nil).Times(3)
+				r.EXPECT().PopBackendObservation(gomock.Any(), int64(1), gomock.Any()).Return(&entity.ExptBackendObservation{Total: 3}, nil).Times(3)
+				r.EXPECT().SetBackendConcurNum(gomock.Any(), int64(1), gomock.Any(), 3).Return(nil).Times(3)
+			},
+			want: 3,
+		},
+		{
+			name:     "读取状态失败",
+			adaptive: adaptive,
+			prepareMock: func(r *repoMocks.MockIExptConcurrencyRepo) {
+				r.EXPECT().GetBackendConcurNum(gomock.Any(), int64(1), gomock.Any()).Return(0, errors.New("redis error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			concurRepo := repoMocks.NewMockIExptConcurrencyRepo(ctrl)
+			configer := componentMocks.NewMockIConfiger(ctrl)
+			configer.EXPECT().GetExptExecConf(gomock.Any(), int64(2)).Return(newConcurTestExecConf(tt.adaptive)).AnyTimes()
+			tt.prepareMock(concurRepo)
+
+			expt := newConcurTestExpt()
+			expt.EvalConf.ItemConcurNum = tt.itemConcur
+			got, err := NewExptConcurrencyController(concurRepo, nil, configer).Adjust(context.Background(), expt)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExptConcurrencyControllerImpl_AcquireBudget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	budgetLimiter := repoMocks.NewMockIExptBackendBudgetLimiter(ctrl)
+	configer := componentMocks.NewMockIConfiger(ctrl)
+	modelBudget := &entity.ExptBackendBudget{Rate: 10, PeriodSecond: 60}
+	configer.EXPECT().GetExptExecConf(gomock.Any(), int64(2)).Return(newConcurTestExecConf(&entity.ExptAdaptiveConcurConf{
+		Enable:         true,
+		BackendBudgets: map[string]*entity.ExptBackendBudget{"model:7": modelBudget},
+	})).AnyTimes()
+
+	// 只有配置了预算的后端需要申请额度
+	budgetLimiter.EXPECT().AcquireN(gomock.Any(), "model:7", 5, modelBudget).Return(3)
+
+	svc := NewExptConcurrencyController(nil, budgetLimiter, configer)
+	assert.Equal(t, 3, svc.AcquireBudget(context.Background(), newConcurTestExpt(), 5))
+	assert.Equal(t, 0, svc.AcquireBudget(context.Background(), newConcurTestExpt(), 0))
+}
@@ -0,0 +1,22 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/expt_cost.go -package=mocks . IExptCostCalculator
+
+// IExptCostCalculator 按模型价目表核算实验花费
+type IExptCostCalculator interface {
+	// CalcTurnCost 核算单个 turn 的评测对象与评估器 token 用量及花费, record 为空或为结果缓存复用的部分不计入。
+	// 评估器模型取实验评估器版本的 ModelConfig, 评测对象模型依次取 SandboxAgent.ModelID、EvalConf.CostConf.TargetModelID,
+	// 都没有时按价目表默认价格核算; 实验配置了 EvalConf.CostConf.PriceConf 时使用实验级价目表。
+	CalcTurnCost(ctx context.Context, expt *entity.Experiment, targetRecord *entity.EvalTargetRecord, evaluatorRecords []*entity.EvaluatorRecord) *entity.ExptTurnCost
+	// FillExptEvaluators 实验未携带评估器详情 (如直接从 repo 读取) 时按 EvaluatorVersionRef 补齐, 供 CalcTurnCost 解析评估器模型
+	FillExptEvaluators(ctx context.Context, expt *entity.Experiment) error
+}
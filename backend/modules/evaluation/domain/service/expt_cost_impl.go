@@ -0,0 +1,115 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/bytedance/gg/gslice"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+type ExptCostCalculatorImpl struct {
+	configer         component.IConfiger
+	evaluatorService EvaluatorService
+}
+
+func NewExptCostCalculator(configer component.IConfiger, evaluatorService EvaluatorService) IExptCostCalculator {
+	return &ExptCostCalculatorImpl{
+		configer:         configer,
+		evaluatorService: evaluatorService,
+	}
+}
+
+func (e *ExptCostCalculatorImpl) FillExptEvaluators(ctx context.Context, expt *entity.Experiment) error {
+	if expt == nil || len(expt.Evaluators) > 0 || len(expt.EvaluatorVersionRef) == 0 {
+		return nil
+	}
+	versionIDs := make([]int64, 0, len(expt.EvaluatorVersionRef))
+	for _, ref := range expt.EvaluatorVersionRef {
+		if ref != nil && ref.EvaluatorVersionID > 0 {
+			versionIDs = append(versionIDs, ref.EvaluatorVersionID)
+		}
+	}
+	if len(versionIDs) == 0 {
+		return nil
+	}
+	evaluators, err := e.evaluatorService.BatchGetEvaluatorVersion(ctx, nil, gslice.Uniq(versionIDs), true)
+	if err != nil {
+		return err
+	}
+	expt.Evaluators = evaluators
+	return nil
+}
+
+func (e *ExptCostCalculatorImpl) CalcTurnCost(ctx context.Context, expt *entity.Experiment, targetRecord *entity.EvalTargetRecord, evaluatorRecords []*entity.EvaluatorRecord) *entity.ExptTurnCost {
+	priceConf := e.configer.GetModelPriceConf(ctx)
+	if expt != nil && expt.EvalConf != nil {
+		priceConf = expt.EvalConf.CostConf.GetPriceConf(priceConf)
+	}
+	cost := &entity.ExptTurnCost{}
+
+	// 结果缓存复用的 record 沿用源 record 的用量, 源 record 已计费, 此处不再重复计入
+	if targetRecord != nil && !isResultCacheHit(targetRecord.Ext) &&
+		targetRecord.EvalTargetOutputData != nil && targetRecord.EvalTargetOutputData.EvalTargetUsage != nil {
+		usage := targetRecord.EvalTargetOutputData.EvalTargetUsage
+		cost.TargetInputTokens = usage.GetInputTokens()
+		cost.TargetOutputTokens = usage.GetOutputTokens()
+		cost.TargetCost = priceConf.GetPrice(exptTargetModelID(expt)).Cost(cost.TargetInputTokens, cost.TargetOutputTokens)
+	}
+
+	var evaluatorModelIDs map[int64]int64
+	for _, record := range evaluatorRecords {
+		if record == nil || isResultCacheHit(record.Ext) || record.EvaluatorOutputData == nil || record.EvaluatorOutputData.EvaluatorUsage == nil {
+			continue
+		}
+		if evaluatorModelIDs == nil {
+			evaluatorModelIDs = exptEvaluatorModelIDs(expt)
+		}
+		usage := record.EvaluatorOutputData.EvaluatorUsage
+		cost.EvaluatorInputTokens += usage.InputTokens
+		cost.EvaluatorOutputTokens += usage.OutputTokens
+		cost.EvaluatorCost += priceConf.GetPrice(evaluatorModelIDs[record.EvaluatorVersionID]).Cost(usage.InputTokens, usage.OutputTokens)
+	}
+
+	return cost
+}
+
+func isResultCacheHit(ext map[string]string) bool {
+	return ext[entity.ResultCacheHitExtKey] != ""
+}
+
+func exptTargetModelID(expt *entity.Experiment) int64 {
+	if expt == nil {
+		return 0
+	}
+	if expt.Target != nil && expt.Target.EvalTargetVersion != nil && expt.Target.EvalTargetVersion.SandboxAgent != nil &&
+		expt.Target.EvalTargetVersion.SandboxAgent.ModelID > 0 {
+		return expt.Target.EvalTargetVersion.SandboxAgent.ModelID
+	}
+	if expt.EvalConf != nil && expt.EvalConf.CostConf != nil {
+		return gptr.Indirect(expt.EvalConf.CostConf.TargetModelID)
+	}
+	return 0
+}
+
+// exptEvaluatorModelIDs 评估器版本 ID → 模型 ID, 无模型配置的评估器 (如代码评估器) 不在其中
+func exptEvaluatorModelIDs(expt *entity.Experiment) map[int64]int64 {
+	res := make(map[int64]int64)
+	if expt == nil {
+		return res
+	}
+	for _, ev := range expt.Evaluators {
+		if ev == nil {
+			continue
+		}
+		if mc := ev.GetModelConfig(); mc != nil && gptr.Indirect(mc.ModelID) > 0 {
+			res[ev.GetEvaluatorVersionID()] = *mc.ModelID
+		}
+	}
+	return res
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	componentMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	svcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+func newCostTestPromptEvaluator(versionID, modelID int64) *entity.Evaluator {
+	return &entity.Evaluator{
+		EvaluatorType: entity.EvaluatorTypePrompt,
+		PromptEvaluatorVersion: &entity.PromptEvaluatorVersion{
+			ID:          versionID,
+			ModelConfig: &entity.ModelConfig{ModelID: gptr.Of(modelID)},
+		},
+	}
+}
+
+func newCostTestEvaluatorRecord(versionID, in, out int64) *entity.EvaluatorRecord {
+	return &entity.EvaluatorRecord{
+		EvaluatorVersionID: versionID,
+		EvaluatorOutputData: &entity.EvaluatorOutputData{
+			EvaluatorUsage: &entity.EvaluatorUsage{InputTokens: in, OutputTokens: out},
+		},
+	}
+}
+
+func TestExptCostCalculatorImpl_CalcTurnCost(t *testing.T) {
+	priceConf := &entity.ModelPriceConf{
+		Currency: "USD",
+		Default:  &entity.ModelPrice{InputPricePerMillionTokens: 1, OutputPricePerMillionTokens: 2},
+		Models: []*entity.ModelPrice{
+			{ModelID: 7, InputPricePerMillionTokens: 10, OutputPricePerMillionTokens: 20},
+			{ModelID: 8, InputPricePerMillionTokens: 100, OutputPricePerMillionTokens: 200},
+		},
+	}
+	targetRecord := &entity.EvalTargetRecord{
+		EvalTargetOutputData: &entity.EvalTargetOutputData{
+			EvalTargetUsage: &entity.EvalTargetUsage{InputTokens: 1000, OutputTokens: 500},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		expt     *entity.Experiment
+		records  []*entity.EvaluatorRecord
+		wantCost *entity.ExptTurnCost
+	}{
+		{
+			name: "评测对象按 CostConf 模型计价, 评估器按版本模型计价",
+			expt: &entity.Experiment{
+				EvalConf:   &entity.EvaluationConfiguration{CostConf: &entity.ExptCostConf{TargetModelID: gptr.Of(int64(7))}},
+				Evaluators: []*entity.Evaluator{newCostTestPromptEvaluator(11, 8)},
+			},
+			records: []*entity.EvaluatorRecord{newCostTestEvaluatorRecord(11, 2000, 100), nil},
+			wantCost: &entity.ExptTurnCost{
+				TargetInputTokens: 1000, TargetOutputTokens: 500,
+				EvaluatorInputTokens: 2000, EvaluatorOutputTokens: 100,
+				TargetCost:    (1000*10 + 500*20) / 1e6,
+				EvaluatorCost: (2000*100 + 100*200) / 1e6,
+			},
+		},
+		{
+			name: "沙箱 Agent 模型优先, 未知评估器回落默认价",
+			expt: &entity.Experiment{
+				Target:   &entity.EvalTarget{EvalTargetVersion: &entity.EvalTargetVersion{SandboxAgent: &entity.SandboxAgent{ModelID: 8}}},
+				EvalConf: &entity.EvaluationConfiguration{CostConf: &entity.ExptCostConf{TargetModelID: gptr.Of(int64(7))}},
+			},
+			records: []*entity.EvaluatorRecord{newCostTestEvaluatorRecord(99, 1000, 1000)},
+			wantCost: &entity.ExptTurnCost{
+				TargetInputTokens: 1000, TargetOutputTokens: 500,
+				EvaluatorInputTokens: 1000, EvaluatorOutputTokens: 1000,
+				TargetCost:    (1000*100 + 500*200) / 1e6,
+				EvaluatorCost: (1000*1 + 1000*2) / 1e6,
+			},
+		},
+		{
+			name: "实验级价目表替代全局价目表",
+			expt: &entity.Experiment{
+				EvalConf: &entity.EvaluationConfiguration{CostConf: &entity.ExptCostConf{
+					TargetModelID: gptr.Of(int64(7)),
+					PriceConf: &entity.ModelPriceConf{
+						Default: &entity.ModelPrice{InputPricePerMillionTokens: 3, OutputPricePerMillionTokens: 4},
+					},
+				}},
+			},
+			records: []*entity.EvaluatorRecord{newCostTestEvaluatorRecord(11, 1000, 1000)},
+			wantCost: &entity.ExptTurnCost{
+				TargetInputTokens: 1000, TargetOutputTokens: 500,
+				EvaluatorInputTokens: 1000, EvaluatorOutputTokens: 1000,
+				TargetCost:    (1000*3 + 500*4) / 1e6,
+				EvaluatorCost: (1000*3 + 1000*4) / 1e6,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			configer := componentMocks.NewMockIConfiger(ctrl)
+			configer.EXPECT().GetModelPriceConf(gomock.Any()).Return(priceConf)
+
+			got := NewExptCostCalculator(configer, nil).CalcTurnCost(context.Background(), tt.expt, targetRecord, tt.records)
+			assert.Equal(t, tt.wantCost.TargetInputTokens, got.TargetInputTokens)
+			assert.Equal(t, tt.wantCost.EvaluatorOutputTokens, got.EvaluatorOutputTokens)
+			assert.InDelta(t, tt.wantCost.TargetCost, got.TargetCost, 1e-12)
+			assert.InDelta(t, tt.wantCost.EvaluatorCost, got.EvaluatorCost, 1e-12)
+		})
+	}
+
+	t.Run("结果缓存复用的 record 不重复计费", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		configer := componentMocks.NewMockIConfiger(ctrl)
+		configer.EXPECT().GetModelPriceConf(gomock.Any()).Return(priceConf)
+
+		hitExt := map[string]string{entity.ResultCacheHitExtKey: "1"}
+		reusedTarget := &entity.EvalTargetRecord{EvalTargetOutputData: targetRecord.EvalTargetOutputData, Ext: hitExt}
+		reusedEvaluator := newCostTestEvaluatorRecord(11, 2000, 100)
+		reusedEvaluator.Ext = hitExt
+		expt := &entity.Experiment{Evaluators: []*entity.Evaluator{newCostTestPromptEvaluator(11, 8)}}
+
+		got := NewExptCostCalculator(configer, nil).CalcTurnCost(context.Background(), expt, reusedTarget,
+			[]*entity.EvaluatorRecord{reusedEvaluator, newCostTestEvaluatorRecord(11, 1000, 0)})
+		assert.Equal(t, int64(0), got.TargetInputTokens)
+		assert.Equal(t, int64(1000), got.EvaluatorInputTokens)
+		assert.InDelta(t, float64(1000*100)/1e6, got.GetTotalCost(), 1e-12)
+	})
+
+	t.Run("未配置价目表只统计 token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		configer := componentMocks.NewMockIConfiger(ctrl)
+		configer.EXPECT().GetModelPriceConf(gomock.Any()).Return(entity.DefaultModelPriceConf())
+
+		got := NewExptCostCalculator(configer, nil).CalcTurnCost(context.Background(), &entity.Experiment{}, targetRecord, nil)
+		assert.Equal(t, int64(1000), got.GetInputTokens())
+		assert.Equal(t, float64(0), got.GetTotalCost())
+	})
+}
+
+func TestExptCostCalculatorImpl_FillExptEvaluators(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	evaluatorSvc := svcMocks.NewMockEvaluatorService(ctrl)
+	calc := NewExptCostCalculator(nil, evaluatorSvc)
+
+	// 已携带评估器详情时不查询
+	loaded := &entity.Experiment{Evaluators: []*entity.Evaluator{newCostTestPromptEvaluator(1, 1)}}
+	assert.NoError(t, calc.FillExptEvaluators(context.Background(), loaded))
+
+	expt := &entity.Experiment{EvaluatorVersionRef: []*entity.ExptEvaluatorVersionRef{
+		{EvaluatorID: 1, EvaluatorVersionID: 11},
+		{EvaluatorID: 1, EvaluatorVersionID: 11},
+		{EvaluatorID: 2, EvaluatorVersionID: 21},
+	}}
+	evaluators := []*entity.Evaluator{newCostTestPromptEvaluator(11, 7), newCostTestPromptEvaluator(21, 8)}
+	evaluatorSvc.EXPECT().BatchGetEvaluatorVersion(gomock.Any(), nil, []int64{11, 21}, true).Return(evaluators, nil)
+	assert.NoError(t, calc.FillExptEvaluators(context.Background(), expt))
+	assert.Equal(t, evaluators, expt.Evaluators)
+
+	failed := &entity.Experiment{EvaluatorVersionRef: []*entity.ExptEvaluatorVersionRef{{EvaluatorVersionID: 31}}}
+	evaluatorSvc.EXPECT().BatchGetEvaluatorVersion(gomock.Any(), nil, []int64{31}, true).Return(nil, errors.New("rpc error"))
+	assert.Error(t, calc.FillExptEvaluators(context.Background(), failed))
+}
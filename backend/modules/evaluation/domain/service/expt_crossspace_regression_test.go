@@ -89,13 +89,13 @@ func TestCompleteItemRunOnUnretriableErr(t *testing.T) {
 	evalErr := errors.New("resource not found, get dataset_version 123")
 
 	itemRepo.EXPECT().
-		UpdateItemRunLog(gomock.Any(), int64(1001), int64(2002), []int64{3003}, gomock.Any(), int64(7)).
-		DoAndReturn(func(_ context.Context, _, _ int64, _ []int64, ufields map[string]any, _ int64) error {
+		UpdateItemRunLogByAttempt(gomock.Any(), int64(1001), int64(2002), int64(3003), int32(0), gomock.Any(), int64(7)).
+		DoAndReturn(func(_ context.Context, _, _, _ int64, _ int32, ufields map[string]any, _ int64) (bool, error) {
 			// 字段须与 CompleteItemRun 失败分支一致
 			assert.Equal(t, int32(entity.ItemRunState_Fail), ufields["status"])
 			assert.Equal(t, int32(entity.ExptItemResultStateLogged), ufields["result_state"])
 			assert.NotEmpty(t, ufields["err_msg"])
-			return nil
+			return true, nil
 		})
 	// 必须同步补建/更新 turn run log 为 Fail (与僵尸清理路径同款)
 	turnRepo.EXPECT().
@@ -127,10 +127,20 @@ func TestCompleteItemRunOnUnretriableErr_NoopAndTolerant(t *testing.T) {
 	defer ctrl2.Finish()
 	itemRepo2 := repoMocks.NewMockIExptItemResultRepo(ctrl2)
 	turnRepo2 := repoMocks.NewMockIExptTurnResultRepo(ctrl2)
-	itemRepo2.EXPECT().UpdateItemRunLog(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		Return(errors.New("db down"))
+	itemRepo2.EXPECT().UpdateItemRunLogByAttempt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(false, errors.New("db down"))
 	turnRepo2.EXPECT().CreateOrUpdateItemsTurnRunLogStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(errors.New("db down"))
 	svc2 := &ExptItemEventEvalServiceImpl{exptItemResultRepo: itemRepo2, exptTurnResultRepo: turnRepo2}
 	svc2.completeItemRunOnUnretriableErr(context.Background(), event, errors.New("boom"))
+
+	// 该轮执行已被续跑接替时不覆盖新轮次, 也不改 turn run log
+	ctrl3 := gomock.NewController(t)
+	defer ctrl3.Finish()
+	itemRepo3 := repoMocks.NewMockIExptItemResultRepo(ctrl3)
+	turnRepo3 := repoMocks.NewMockIExptTurnResultRepo(ctrl3)
+	itemRepo3.EXPECT().UpdateItemRunLogByAttempt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(false, nil)
+	svc3 := &ExptItemEventEvalServiceImpl{exptItemResultRepo: itemRepo3, exptTurnResultRepo: turnRepo3}
+	svc3.completeItemRunOnUnretriableErr(context.Background(), event, errors.New("boom"))
 }
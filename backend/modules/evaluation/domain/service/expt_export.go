@@ -5,14 +5,21 @@ package service
 
 import (
 	"context"
+	"io"
 
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
 )
 
 //go:generate  mockgen -destination  ./mocks/expt_export.go  --package mocks . IExptResultExportService
 type IExptResultExportService interface {
-	ExportCSV(ctx context.Context, spaceID, exptID int64, session *entity.Session, exportColumnSpec *entity.ExptResultExportColumnSpec) (int64, error)
+	ExportCSV(ctx context.Context, spaceID, exptID int64, session *entity.Session, exportColumnSpec *entity.ExptResultExportColumnSpec, format entity.ExptResultExportFormat) (int64, error)
 	DoExportCSV(ctx context.Context, spaceID, exptID int64, fileName string, withLogID bool, exportColumnSpec *entity.ExptResultExportColumnSpec) error
+	// DoExport 按指定格式导出实验报告到本地文件并上传，DoExportCSV 等价于 format=CSV
+	DoExport(ctx context.Context, spaceID, exptID int64, fileName string, withLogID bool, exportColumnSpec *entity.ExptResultExportColumnSpec, format entity.ExptResultExportFormat) error
+	// ExportRegressionReport 将跨实验回归报告按指定格式写入 w
+	ExportRegressionReport(ctx context.Context, report *entity.ExptRegressionReport, format entity.ExptResultExportFormat, w io.Writer) error
+	// UploadRegressionReport 导出跨实验回归报告并上传，返回下载链接
+	UploadRegressionReport(ctx context.Context, report *entity.ExptRegressionReport, format entity.ExptResultExportFormat) (string, error)
 	HandleExportEvent(ctx context.Context, event *entity.ExportCSVEvent) (err error)
 	UpdateExportRecord(ctx context.Context, exportRecord *entity.ExptResultExportRecord) error
 	ListExportRecord(ctx context.Context, spaceID, exptID int64, page entity.Page) ([]*entity.ExptResultExportRecord, int64, error)
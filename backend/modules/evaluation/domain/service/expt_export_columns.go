@@ -23,6 +23,7 @@ const (
 	exportColPrefixTarget     = "target:"
 	exportColPrefixEvaluator  = "evaluator:"
 	exportColKeyWeightedScore = "weighted_score"
+	exportColKeyCost          = "cost"
 	exportColPrefixAnnotation = "annotation:"
 )
 
@@ -147,6 +148,10 @@ func newExportColumnSelectionFromSpec(
 		keys[exportColKeyWeightedScore] = struct{}{}
 	}
 
+	if spec.Cost != nil && *spec.Cost {
+		keys[exportColKeyCost] = struct{}{}
+	}
+
 	// 人工标注：非空 tag_key_ids 为白名单；每项为 TagKeyID 十进制字符串，与 includeAnnotationTag / exportColPrefixAnnotation 一致
 	if len(spec.TagKeyIds) > 0 {
 		for _, raw := range dedupeStrings(spec.TagKeyIds) {
@@ -284,6 +289,14 @@ func (s *exportColumnSelection) includeWeightedScore() bool {
 	return ok
 }
 
+func (s *exportColumnSelection) includeCost() bool {
+	if s == nil || s.exportAll {
+		return true
+	}
+	_, ok := s.keys[exportColKeyCost]
+	return ok
+}
+
 func (s *exportColumnSelection) includeAnnotationTag(tagKeyID int64) bool {
 	if s == nil || s.exportAll {
 		return true
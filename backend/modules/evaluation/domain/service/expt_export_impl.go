@@ -5,7 +5,6 @@ package service
 
 import (
 	"context"
-	"encoding/csv"
 	"fmt"
 	"io"
 	"os"
@@ -45,6 +44,8 @@ type ExptResultExportService struct {
 	benefitService     benefit.IBenefitService
 	urlProcessor       component.IURLProcessor
 	evalSetItemSvc     EvaluationSetItemService
+	// costCalculator 允许为 nil, 为 nil 时不导出花费列
+	costCalculator IExptCostCalculator
 }
 
 func NewExptResultExportService(
@@ -59,6 +60,7 @@ func NewExptResultExportService(
 	benefitService benefit.IBenefitService,
 	urlProcessor component.IURLProcessor,
 	esis EvaluationSetItemService,
+	costCalculator IExptCostCalculator,
 ) IExptResultExportService {
 	return &ExptResultExportService{
 		repo:               repo,
@@ -72,10 +74,15 @@ func NewExptResultExportService(
 		benefitService:     benefitService,
 		urlProcessor:       urlProcessor,
 		evalSetItemSvc:     esis,
+		costCalculator:     costCalculator,
 	}
 }
 
-func (e ExptResultExportService) ExportCSV(ctx context.Context, spaceID, exptID int64, session *entity.Session, exportColumnSpec *entity.ExptResultExportColumnSpec) (int64, error) {
+func (e ExptResultExportService) ExportCSV(ctx context.Context, spaceID, exptID int64, session *entity.Session, exportColumnSpec *entity.ExptResultExportColumnSpec, format entity.ExptResultExportFormat) (int64, error) {
+	format = format.OrDefault()
+	if _, err := entity.ParseExptResultExportFormat(string(format)); err != nil {
+		return 0, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
+	}
 	// 检查实验是否完成
 	expt, err := e.exptRepo.GetByID(ctx, exptID, spaceID)
 	if err != nil {
@@ -84,6 +91,10 @@ func (e ExptResultExportService) ExportCSV(ctx context.Context, spaceID, exptID
 	if !entity.IsExptFinished(expt.Status) {
 		return 0, errorx.NewByCode(errno.ExperimentUncompleteCode)
 	}
+	// 多评测集实验各集列不一致，只有能按集区分的格式才允许导出
+	if expt.EvalSetSourceType == entity.ExptEvalSetSourceType_MultiSetConfig && !format.SupportMultiEvalSet() {
+		return 0, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("多评测集实验不支持导出 %s 格式，请使用 XLSX 或 JSONL", format)))
+	}
 	// 检查是否存在运行中的导出任务
 	page := entity.NewPage(1, 1)
 	_, total, err := e.repo.List(ctx, spaceID, exptID, page, ptr.Of(int32(entity.CSVExportStatus_Running)))
@@ -129,6 +140,7 @@ func (e ExptResultExportService) ExportCSV(ctx context.Context, spaceID, exptID
 		SpaceID:       spaceID,
 		Session:       session,
 		ExportColumns: cloneExptExportColumnSpec(exportColumnSpec),
+		ExportFormat:  format,
 	}
 	err = e.exptPublisher.PublishExptExportCSVEvent(ctx, exportEvent, nil)
 	if err != nil {
@@ -241,12 +253,13 @@ func (e ExptResultExportService) HandleExportEvent(ctx context.Context, event *e
 	if err != nil {
 		return err
 	}
-	fileName, err = e.getFileName(ctx, expt.Name, exportID)
+	format := event.ExportFormat.OrDefault()
+	fileName, err = e.getFileName(ctx, expt.Name, exportID, format)
 	if err != nil {
 		return err
 	}
 
-	err = e.DoExportCSV(ctx, spaceID, exptID, fileName, false, event.ExportColumns)
+	err = e.DoExport(ctx, spaceID, exptID, fileName, false, event.ExportColumns, format)
 	if err != nil {
 		return err
 	}
@@ -255,6 +268,10 @@ func (e ExptResultExportService) HandleExportEvent(ctx context.Context, event *e
 }
 
 func (e ExptResultExportService) DoExportCSV(ctx context.Context, spaceID, exptID int64, fileName string, withLogID bool, exportColumnSpec *entity.ExptResultExportColumnSpec) (err error) {
+	return e.DoExport(ctx, spaceID, exptID, fileName, withLogID, exportColumnSpec, entity.ExptResultExportFormatCSV)
+}
+
+func (e ExptResultExportService) DoExport(ctx context.Context, spaceID, exptID int64, fileName string, withLogID bool, exportColumnSpec *entity.ExptResultExportColumnSpec, format entity.ExptResultExportFormat) (err error) {
 	const (
 		pageSize   = 20
 		maxBatches = 50000 // 游标分页安全上限，避免异常任务死循环
@@ -266,16 +283,11 @@ func (e ExptResultExportService) DoExportCSV(ctx context.Context, spaceID, exptI
 	}
 	defer func() { _ = file.Close() }()
 
-	if _, err = file.WriteString("\xEF\xBB\xBF"); err != nil {
-		return err
-	}
-	writer := csv.NewWriter(file)
-
 	param := mgetParamForExportSpec(exportColumnSpec)
 	param.SpaceID = spaceID
 	param.ExptIDs = []int64{exptID}
 	param.BaseExptID = ptr.Of(exptID)
-	param.LoadEvaluatorFullContent = gptr.Of(false) // 导出 CSV 仅需 score/reason，不加载 Evaluator input 大对象
+	param.LoadEvaluatorFullContent = gptr.Of(false) // 导出仅需 score/reason，不加载 Evaluator input 大对象
 
 	var helper *exportCSVHelper
 	var sel *exportColumnSelection
@@ -283,13 +295,31 @@ func (e ExptResultExportService) DoExportCSV(ctx context.Context, spaceID, exptI
 
 	// ★ 跨空间共享: 预载实验冻结信息, 构建 evalSetID → 来源空间映射, 供导出补裁剪字段按来源空间读。
 	var evalSetSrcSpaceByID map[int64]int64
+	var sheetEvalSetIDs []int64
+	var costExpt *entity.Experiment
+	trialNum := 1
 	if exptForSpace, exptErr := e.exptRepo.GetByID(ctx, exptID, spaceID); exptErr == nil {
 		evalSetSrcSpaceByID = buildEvalSetSrcSpaceMap(exptForSpace)
+		sheetEvalSetIDs = exportSheetEvalSetIDs(exptForSpace)
+		if exptForSpace.EvalConf != nil {
+			trialNum = exptForSpace.EvalConf.TrialConf.GetTrialNum()
+		}
+		if e.costCalculator != nil {
+			if fillErr := e.costCalculator.FillExptEvaluators(ctx, exptForSpace); fillErr != nil {
+				logs.CtxWarn(ctx, "DoExport fill expt evaluators for cost fail, expt_id=%d, err=%v", exptID, fillErr)
+			}
+			costExpt = exptForSpace
+		}
 	} else {
-		logs.CtxWarn(ctx, "DoExportCSV load expt for src space map fail, expt_id=%d, err=%v", exptID, exptErr)
+		logs.CtxWarn(ctx, "DoExport load expt for src space map fail, expt_id=%d, err=%v", exptID, exptErr)
 		evalSetSrcSpaceByID = map[int64]int64{}
 	}
 
+	writer, err := newExptResultFileWriter(format, file, sheetEvalSetIDs)
+	if err != nil {
+		return err
+	}
+
 	for batch := 0; batch < maxBatches; batch++ {
 		param.Page = entity.NewPage(1, pageSize)
 		param.TurnListCursor = turnCursor
@@ -320,6 +350,7 @@ func (e ExptResultExportService) DoExportCSV(ctx context.Context, spaceID, exptI
 				reportEvaluatorCount: len(result.ColumnEvaluators),
 				colEvaluators:        filterColumnEvaluatorsForExport(result.ColumnEvaluators, sel),
 				colEvalSetFields:     filterColumnEvalSetFieldsForExport(result.ColumnEvalSetFields, sel),
+				evalSetIDsByFieldKey: result.EvalSetIDsByFieldKey,
 				colAnnotations:       filterColumnAnnotationsForExport(colAnnotation, sel),
 				columnsEvalTarget:    columnsEvalTarget,
 				exptRepo:             e.exptRepo,
@@ -329,27 +360,20 @@ func (e ExptResultExportService) DoExportCSV(ctx context.Context, spaceID, exptI
 				fileClient:           e.fileClient,
 				evalSetItemSvc:       e.evalSetItemSvc,
 				evalSetSrcSpaceByID:  evalSetSrcSpaceByID,
+				costCalculator:       e.costCalculator,
+				costExpt:             costExpt,
+				trialNum:             trialNum,
 			}
-			columns, err := helper.buildColumns(ctx)
-			if err != nil {
-				return err
-			}
-			if err = writer.Write(columns); err != nil {
+			if err = writer.writeHeader(helper.buildExportColumns(ctx)); err != nil {
 				return err
 			}
 		}
 
-		rows, err := helper.buildRowsForItems(ctx, result.ItemResults)
+		rows, err := helper.buildExportRowsForItems(ctx, result.ItemResults)
 		if err != nil {
 			return err
 		}
-		for _, row := range rows {
-			if err = writer.Write(row); err != nil {
-				return err
-			}
-		}
-		writer.Flush()
-		if err := writer.Error(); err != nil {
+		if err = writer.writeRows(rows); err != nil {
 			return err
 		}
 
@@ -359,6 +383,9 @@ func (e ExptResultExportService) DoExportCSV(ctx context.Context, spaceID, exptI
 		turnCursor = result.NextTurnListCursor
 	}
 
+	if err = writer.close(); err != nil {
+		return err
+	}
 	if _, err = file.Seek(0, 0); err != nil {
 		return err
 	}
@@ -368,6 +395,20 @@ func (e ExptResultExportService) DoExportCSV(ctx context.Context, spaceID, exptI
 	return os.Remove(fileName)
 }
 
+// exportSheetEvalSetIDs 多评测集实验按配置顺序返回评测集 ID，XLSX 据此每个评测集一个 sheet；单集实验返回 nil
+func exportSheetEvalSetIDs(expt *entity.Experiment) []int64 {
+	if expt == nil || expt.EvalSetSourceType != entity.ExptEvalSetSourceType_MultiSetConfig || expt.EvalConf == nil {
+		return nil
+	}
+	ids := make([]int64, 0, len(expt.EvalConf.EvalSetConfigs))
+	for _, sc := range expt.EvalConf.EvalSetConfigs {
+		if sc != nil && sc.EvalSetID > 0 {
+			ids = append(ids, sc.EvalSetID)
+		}
+	}
+	return ids
+}
+
 type exportCSVHelper struct {
 	spaceID   int64
 	exptID    int64
@@ -376,11 +417,13 @@ type exportCSVHelper struct {
 	colSelection         *exportColumnSelection
 	reportEvaluatorCount int
 
-	colEvaluators     []*entity.ColumnEvaluator
-	colEvalSetFields  []*entity.ColumnEvalSetField
-	colAnnotations    []*entity.ColumnAnnotation
-	allItemResults    []*entity.ItemResult
-	columnsEvalTarget []*entity.ColumnEvalTarget
+	colEvaluators    []*entity.ColumnEvaluator
+	colEvalSetFields []*entity.ColumnEvalSetField
+	// evalSetIDsByFieldKey 多评测集实验中评测集字段列所属的评测集, XLSX 按此为各 sheet 生成表头
+	evalSetIDsByFieldKey map[string][]int64
+	colAnnotations       []*entity.ColumnAnnotation
+	allItemResults       []*entity.ItemResult
+	columnsEvalTarget    []*entity.ColumnEvalTarget
 
 	exptRepo           repo.IExperimentRepo
 	exptTurnResultRepo repo.IExptTurnResultRepo
@@ -392,6 +435,13 @@ type exportCSVHelper struct {
 	// evalSetSrcSpaceByID 跨空间共享: evalSetID → 冻结的评测集来源空间 (0=同调用方空间)。
 	// 导出补裁剪大字段 GetEvaluationSetItemField 时据此切来源空间, 否则跨空间读失败/该列导出为空。
 	evalSetSrcSpaceByID map[int64]int64
+
+	// costCalculator / costExpt 均非空时导出每行的评测对象与评估器花费
+	costCalculator IExptCostCalculator
+	costExpt       *entity.Experiment
+
+	// trialNum 重复试验次数, 大于 1 时为第 1~trialNum-1 次试验各导出一组评测对象与评估器得分列
+	trialNum int
 }
 
 // buildEvalSetSrcSpaceMap 从实验冻结信息构建 evalSetID → 来源空间映射 (单集 EvalSetSpaceID / 多集 EvalSetConfig.SourceSpaceID)。
@@ -419,22 +469,44 @@ const (
 	columnNameLogID         = "logID"
 	columnNameTargetTraceID = "targetTraceID"
 	columnNameWeightedScore = "weightedScore"
+	columnNameTargetCost    = "targetCost"
+	columnNameEvaluatorCost = "evaluatorCost"
 )
 
 func (e exportCSVHelper) buildColumns(ctx context.Context) ([]string, error) {
-	columns := []string{}
+	cols := e.buildExportColumns(ctx)
+	columns := make([]string, 0, len(cols))
+	for _, col := range cols {
+		columns = append(columns, col.name)
+	}
+	return columns, nil
+}
 
-	columns = append(columns, columnNameID, columnNameStatus)
+// buildExportColumns 构建导出列定义，列顺序与 buildExportRowsForItems 的单元格顺序一一对应
+func (e exportCSVHelper) buildExportColumns(ctx context.Context) []*exportColumn {
+	columns := []*exportColumn{
+		{name: columnNameID, group: exportColumnGroupItem, kind: exportValueKindInt},
+		{name: columnNameStatus, group: exportColumnGroupItem, kind: exportValueKindString},
+	}
 	for _, colEvalSetField := range e.colEvalSetFields {
 		if colEvalSetField == nil {
 			continue
 		}
 
-		columns = append(columns, ptr.From(colEvalSetField.Name))
+		columns = append(columns, &exportColumn{
+			name:       ptr.From(colEvalSetField.Name),
+			group:      exportColumnGroupEvalSet,
+			kind:       exportValueKindContent,
+			evalSetIDs: e.evalSetIDsByFieldKey[ptr.From(colEvalSetField.Key)],
+		})
 	}
 
 	for _, col := range e.columnsEvalTarget {
-		columns = append(columns, gcond.If(len(col.DisplayName) > 0, col.DisplayName, col.Name))
+		kind := exportValueKindContent
+		if _, ok := exportColTargetMetricNames[col.Name]; ok {
+			kind = exportValueKindInt
+		}
+		columns = append(columns, &exportColumn{name: gcond.If(len(col.DisplayName) > 0, col.DisplayName, col.Name), group: exportColumnGroupTarget, kind: kind})
 	}
 
 	// colEvaluators
@@ -443,39 +515,67 @@ func (e exportCSVHelper) buildColumns(ctx context.Context) ([]string, error) {
 			continue
 		}
 		name, ver := ptr.From(colEvaluator.Name), ptr.From(colEvaluator.Version)
+		scoreCol := &exportColumn{
+			name:               getColumnNameEvaluator(name, ver),
+			group:              exportColumnGroupEvaluatorScore,
+			kind:               exportValueKindFloat,
+			evaluatorKey:       getColumnNameEvaluator(name, ver),
+			evaluatorVersionID: colEvaluator.EvaluatorVersionID,
+		}
+		reasonCol := &exportColumn{
+			name:               getColumnNameEvaluatorReason(name, ver),
+			group:              exportColumnGroupEvaluatorReason,
+			kind:               exportValueKindString,
+			evaluatorKey:       getColumnNameEvaluator(name, ver),
+			evaluatorVersionID: colEvaluator.EvaluatorVersionID,
+		}
 		if e.colSelection == nil || e.colSelection.exportAll {
-			columns = append(columns, getColumnNameEvaluator(name, ver), getColumnNameEvaluatorReason(name, ver))
+			columns = append(columns, scoreCol, reasonCol)
 			continue
 		}
 		if e.colSelection.includeEvaluatorScore(colEvaluator.EvaluatorVersionID) {
-			columns = append(columns, getColumnNameEvaluator(name, ver))
+			columns = append(columns, scoreCol)
 		}
 		if e.colSelection.includeEvaluatorReason(colEvaluator.EvaluatorVersionID) {
-			columns = append(columns, getColumnNameEvaluatorReason(name, ver))
+			columns = append(columns, reasonCol)
 		}
 	}
 
 	if e.wantWeightedScoreColumn() {
-		columns = append(columns, columnNameWeightedScore)
+		columns = append(columns, &exportColumn{name: columnNameWeightedScore, group: exportColumnGroupWeightedScore, kind: exportValueKindFloat})
+	}
+
+	if e.wantCostColumns() {
+		columns = append(columns,
+			&exportColumn{name: columnNameTargetCost, group: exportColumnGroupCost, kind: exportValueKindFloat},
+			&exportColumn{name: columnNameEvaluatorCost, group: exportColumnGroupCost, kind: exportValueKindFloat},
+		)
 	}
 
+	columns = append(columns, e.buildTrialColumns()...)
+
 	// colAnnotations
 	for _, colAnnotation := range e.colAnnotations {
 		if colAnnotation == nil {
 			continue
 		}
 
-		columns = append(columns, colAnnotation.TagName)
-
+		kind := exportValueKindString
+		if colAnnotation.TagContentType == entity.TagContentTypeContinuousNumber {
+			kind = exportValueKindFloat
+		}
+		columns = append(columns, &exportColumn{name: colAnnotation.TagName, group: exportColumnGroupAnnotation, kind: kind})
 	}
 
 	// logID for analysis report
 	if e.withLogID {
-		columns = append(columns, columnNameLogID)
-		columns = append(columns, columnNameTargetTraceID)
+		columns = append(columns,
+			&exportColumn{name: columnNameLogID, group: exportColumnGroupSystem, kind: exportValueKindString},
+			&exportColumn{name: columnNameTargetTraceID, group: exportColumnGroupSystem, kind: exportValueKindString},
+		)
 	}
 
-	return columns, nil
+	return columns
 }
 
 func getColumnNameEvaluator(evaluatorName, version string) string {
@@ -496,21 +596,144 @@ func (e *exportCSVHelper) wantWeightedScoreColumn() bool {
 	return e.colSelection.includeWeightedScore()
 }
 
+func (e *exportCSVHelper) wantCostColumns() bool {
+	if e.costCalculator == nil || e.costExpt == nil {
+		return false
+	}
+	return e.colSelection.includeCost()
+}
+
+// buildCostCells 按模型价目表核算当前行的评测对象与评估器花费
+func (e *exportCSVHelper) buildCostCells(ctx context.Context, payload *entity.ExperimentTurnPayload) []exportCell {
+	var (
+		targetRecord     *entity.EvalTargetRecord
+		evaluatorRecords []*entity.EvaluatorRecord
+	)
+	if payload.TargetOutput != nil {
+		targetRecord = payload.TargetOutput.EvalTargetRecord
+	}
+	if payload.EvaluatorOutput != nil {
+		for _, record := range payload.EvaluatorOutput.EvaluatorRecords {
+			evaluatorRecords = append(evaluatorRecords, record)
+		}
+	}
+	cost := e.costCalculator.CalcTurnCost(ctx, e.costExpt, targetRecord, evaluatorRecords)
+	return []exportCell{costExportCell(cost.TargetCost), costExportCell(cost.EvaluatorCost)}
+}
+
+func getColumnNameTrial(trialIdx int, name string) string {
+	return fmt.Sprintf("trial%d_%s", trialIdx, name)
+}
+
+// buildTrialColumns 重复试验实验为每次额外试验导出一组列：评测对象列 + 评估器得分列，列选择与第 0 次试验一致
+func (e *exportCSVHelper) buildTrialColumns() []*exportColumn {
+	var columns []*exportColumn
+	for idx := 1; idx < e.trialNum; idx++ {
+		for _, col := range e.columnsEvalTarget {
+			kind := exportValueKindContent
+			if _, ok := exportColTargetMetricNames[col.Name]; ok {
+				kind = exportValueKindInt
+			}
+			name := gcond.If(len(col.DisplayName) > 0, col.DisplayName, col.Name)
+			columns = append(columns, &exportColumn{name: getColumnNameTrial(idx, name), group: exportColumnGroupTrial, kind: kind, trialIdx: int32(idx), trialKey: name})
+		}
+		for _, colEvaluator := range e.trialEvaluatorColumns() {
+			name := getColumnNameEvaluator(ptr.From(colEvaluator.Name), ptr.From(colEvaluator.Version))
+			columns = append(columns, &exportColumn{
+				name:               getColumnNameTrial(idx, name),
+				group:              exportColumnGroupTrial,
+				kind:               exportValueKindFloat,
+				evaluatorKey:       name,
+				evaluatorVersionID: colEvaluator.EvaluatorVersionID,
+				trialIdx:           int32(idx),
+				trialKey:           name,
+			})
+		}
+	}
+	return columns
+}
+
+// trialEvaluatorColumns 重复试验导出得分列的评估器，白名单模式下仅导出选中得分列的评估器
+func (e *exportCSVHelper) trialEvaluatorColumns() []*entity.ColumnEvaluator {
+	cols := make([]*entity.ColumnEvaluator, 0, len(e.colEvaluators))
+	for _, colEvaluator := range e.colEvaluators {
+		if colEvaluator == nil {
+			continue
+		}
+		if e.colSelection != nil && !e.colSelection.exportAll && !e.colSelection.includeEvaluatorScore(colEvaluator.EvaluatorVersionID) {
+			continue
+		}
+		cols = append(cols, colEvaluator)
+	}
+	return cols
+}
+
+// buildTrialCells 按 buildTrialColumns 的列顺序构建额外试验的单元格，缺失的试验补空单元格
+func (e *exportCSVHelper) buildTrialCells(ctx context.Context, payload *entity.ExperimentTurnPayload) ([]exportCell, error) {
+	if e.trialNum <= 1 {
+		return nil, nil
+	}
+	idx2Trial := make(map[int32]*entity.TurnTrialOutput, len(payload.TrialOutputs))
+	for _, trial := range payload.TrialOutputs {
+		if trial != nil {
+			idx2Trial[trial.TrialIdx] = trial
+		}
+	}
+
+	evaluatorCols := e.trialEvaluatorColumns()
+	var cells []exportCell
+	for idx := 1; idx < e.trialNum; idx++ {
+		trial := idx2Trial[int32(idx)]
+		var (
+			targetOutput     *entity.EvalTargetOutputData
+			evaluatorRecords map[int64]*entity.EvaluatorRecord
+		)
+		if trial != nil && trial.TargetOutput != nil && trial.TargetOutput.EvalTargetRecord != nil {
+			targetOutput = trial.TargetOutput.EvalTargetRecord.EvalTargetOutputData
+		}
+		if trial != nil && trial.EvaluatorOutput != nil {
+			evaluatorRecords = trial.EvaluatorOutput.EvaluatorRecords
+		}
+
+		for _, col := range e.columnsEvalTarget {
+			if targetOutput == nil {
+				cells = append(cells, exportCell{})
+				continue
+			}
+			cell, err := e.buildColumnEvalTargetCell(ctx, col.Name, targetOutput)
+			if err != nil {
+				return nil, err
+			}
+			cells = append(cells, cell)
+		}
+		for _, colEvaluator := range evaluatorCols {
+			evaluatorRecord := evaluatorRecords[colEvaluator.EvaluatorVersionID]
+			cells = append(cells, exportCell{text: getEvaluatorScore(evaluatorRecord), value: getEvaluatorScoreValue(evaluatorRecord)})
+		}
+	}
+	return cells, nil
+}
+
 func (e *exportCSVHelper) buildColumnEvalTargetContent(ctx context.Context, columnName string, data *entity.EvalTargetOutputData) (string, error) {
+	cell, err := e.buildColumnEvalTargetCell(ctx, columnName, data)
+	return cell.text, err
+}
+
+func (e *exportCSVHelper) buildColumnEvalTargetCell(ctx context.Context, columnName string, data *entity.EvalTargetOutputData) (exportCell, error) {
 	if data == nil {
-		return "", nil
+		return exportCell{}, nil
 	}
 	switch columnName {
 	case consts.ReportColumnNameEvalTargetTotalLatency:
-		return strconv.FormatInt(gptr.Indirect(data.TimeConsumingMS), 10), nil
+		return intExportCell(gptr.Indirect(data.TimeConsumingMS)), nil
 	case consts.ReportColumnNameEvalTargetInputTokens:
-		return strconv.FormatInt(data.EvalTargetUsage.GetInputTokens(), 10), nil
+		return intExportCell(data.EvalTargetUsage.GetInputTokens()), nil
 	case consts.ReportColumnNameEvalTargetOutputTokens:
-		return strconv.FormatInt(data.EvalTargetUsage.GetOutputTokens(), 10), nil
+		return intExportCell(data.EvalTargetUsage.GetOutputTokens()), nil
 	case consts.ReportColumnNameEvalTargetTotalTokens:
-		return strconv.FormatInt(data.EvalTargetUsage.GetTotalTokens(), 10), nil
+		return intExportCell(data.EvalTargetUsage.GetTotalTokens()), nil
 	default:
-		return e.toContentStr(ctx, data.OutputFields[columnName])
+		return e.contentExportCell(ctx, data.OutputFields[columnName])
 	}
 }
 
@@ -519,7 +742,20 @@ func (e *exportCSVHelper) buildRows(ctx context.Context) ([][]string, error) {
 }
 
 func (e *exportCSVHelper) buildRowsForItems(ctx context.Context, itemResults []*entity.ItemResult) ([][]string, error) {
-	rows := make([][]string, 0)
+	exportRows, err := e.buildExportRowsForItems(ctx, itemResults)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([][]string, 0, len(exportRows))
+	for _, row := range exportRows {
+		rows = append(rows, row.texts())
+	}
+	return rows, nil
+}
+
+// buildExportRowsForItems 按 turn 构建导出行，单元格同时保留 CSV 文本与结构化值
+func (e *exportCSVHelper) buildExportRowsForItems(ctx context.Context, itemResults []*entity.ItemResult) ([]*exportRow, error) {
+	rows := make([]*exportRow, 0)
 	for _, itemResult := range itemResults {
 		if itemResult == nil {
 			logs.CtxWarn(ctx, "itemResult is nil")
@@ -532,13 +768,13 @@ func (e *exportCSVHelper) buildRowsForItems(ctx context.Context, itemResults []*
 				continue
 			}
 
-			rowData := make([]string, 0)
-			rowData = append(rowData, strconv.Itoa(int(itemResult.ItemID)))
+			row := &exportRow{itemID: itemResult.ItemID, turnID: turnResult.TurnID}
+			row.cells = append(row.cells, exportCell{text: strconv.Itoa(int(itemResult.ItemID)), value: itemResult.ItemID})
 			runState := ""
 			if itemResult.SystemInfo != nil {
 				runState = itemRunStateToString(itemResult.SystemInfo.RunState)
 			}
-			rowData = append(rowData, runState)
+			row.cells = append(row.cells, stringExportCell(runState))
 
 			if len(turnResult.ExperimentResults) == 0 || turnResult.ExperimentResults[0] == nil {
 				logs.CtxWarn(ctx, "turnResult.ExperimentResults is nil")
@@ -551,23 +787,24 @@ func (e *exportCSVHelper) buildRowsForItems(ctx context.Context, itemResults []*
 				payload.EvalSet.Turn.FieldDataList == nil {
 				return nil, fmt.Errorf("FieldDataList is nil")
 			}
-			datasetFields, err := e.getDatasetFields(ctx, e.colEvalSetFields, payload.EvalSet)
+			row.evalSetID = payload.EvalSet.EvalSetID
+			datasetCells, err := e.getDatasetFieldCells(ctx, e.colEvalSetFields, payload.EvalSet)
 			if err != nil {
 				return nil, err
 			}
-			rowData = append(rowData, datasetFields...)
+			row.cells = append(row.cells, datasetCells...)
 
 			for _, col := range e.columnsEvalTarget {
 				if payload.TargetOutput != nil &&
 					payload.TargetOutput.EvalTargetRecord != nil &&
 					payload.TargetOutput.EvalTargetRecord.EvalTargetOutputData != nil {
-					cont, err := e.buildColumnEvalTargetContent(ctx, col.Name, payload.TargetOutput.EvalTargetRecord.EvalTargetOutputData)
+					cell, err := e.buildColumnEvalTargetCell(ctx, col.Name, payload.TargetOutput.EvalTargetRecord.EvalTargetOutputData)
 					if err != nil {
 						return nil, err
 					}
-					rowData = append(rowData, cont)
+					row.cells = append(row.cells, cell)
 				} else {
-					rowData = append(rowData, "")
+					row.cells = append(row.cells, exportCell{})
 				}
 			}
 
@@ -584,37 +821,56 @@ func (e *exportCSVHelper) buildRowsForItems(ctx context.Context, itemResults []*
 				}
 
 				evaluatorRecord := evaluatorRecords[colEvaluator.EvaluatorVersionID]
+				scoreCell := exportCell{text: getEvaluatorScore(evaluatorRecord), value: getEvaluatorScoreValue(evaluatorRecord)}
+				reasonCell := stringExportCell(getEvaluatorReason(evaluatorRecord))
 				if e.colSelection == nil || e.colSelection.exportAll {
-					rowData = append(rowData, getEvaluatorScore(evaluatorRecord), getEvaluatorReason(evaluatorRecord))
+					row.cells = append(row.cells, scoreCell, reasonCell)
 					continue
 				}
 				if e.colSelection.includeEvaluatorScore(colEvaluator.EvaluatorVersionID) {
-					rowData = append(rowData, getEvaluatorScore(evaluatorRecord))
+					row.cells = append(row.cells, scoreCell)
 				}
 				if e.colSelection.includeEvaluatorReason(colEvaluator.EvaluatorVersionID) {
-					rowData = append(rowData, getEvaluatorReason(evaluatorRecord))
+					row.cells = append(row.cells, reasonCell)
 				}
 			}
 
 			if e.wantWeightedScoreColumn() {
-				weightedScore := ""
+				cell := exportCell{}
 				if payload.EvaluatorOutput != nil && payload.EvaluatorOutput.WeightedScore != nil {
-					weightedScore = strconv.FormatFloat(*payload.EvaluatorOutput.WeightedScore, 'f', 2, 64)
+					cell = exportCell{
+						text:  strconv.FormatFloat(*payload.EvaluatorOutput.WeightedScore, 'f', 2, 64),
+						value: *payload.EvaluatorOutput.WeightedScore,
+					}
 				}
-				rowData = append(rowData, weightedScore)
+				row.cells = append(row.cells, cell)
 			}
 
-			// 标注结果，按Annotation的顺序排序
-			if payload.AnnotateResult != nil && payload.AnnotateResult.AnnotateRecords != nil {
-				annotateRecords := payload.AnnotateResult.AnnotateRecords
-				for _, colAnnotation := range e.colAnnotations {
-					if colAnnotation == nil {
-						continue
-					}
+			if e.wantCostColumns() {
+				row.cells = append(row.cells, e.buildCostCells(ctx, payload)...)
+			}
 
-					annotateRecord := annotateRecords[colAnnotation.TagKeyID]
-					rowData = append(rowData, getAnnotationData(annotateRecord, colAnnotation))
+			trialCells, err := e.buildTrialCells(ctx, payload)
+			if err != nil {
+				return nil, err
+			}
+			row.cells = append(row.cells, trialCells...)
+
+			// 标注结果，按Annotation的顺序排序；无标注结果时补空单元格，保证后续列不错位
+			var annotateRecords map[int64]*entity.AnnotateRecord
+			if payload.AnnotateResult != nil {
+				annotateRecords = payload.AnnotateResult.AnnotateRecords
+			}
+			for _, colAnnotation := range e.colAnnotations {
+				if colAnnotation == nil {
+					continue
 				}
+
+				annotateRecord := annotateRecords[colAnnotation.TagKeyID]
+				row.cells = append(row.cells, exportCell{
+					text:  getAnnotationData(annotateRecord, colAnnotation),
+					value: getAnnotationValue(annotateRecord, colAnnotation),
+				})
 			}
 
 			// logID
@@ -628,12 +884,10 @@ func (e *exportCSVHelper) buildRowsForItems(ctx context.Context, itemResults []*
 					payload.TargetOutput.EvalTargetRecord != nil {
 					traceID = payload.TargetOutput.EvalTargetRecord.TraceID
 				}
-				rowData = append(rowData, logID)
-				rowData = append(rowData, traceID)
-
+				row.cells = append(row.cells, stringExportCell(logID), stringExportCell(traceID))
 			}
 
-			rows = append(rows, rowData)
+			rows = append(rows, row)
 		}
 	}
 
@@ -661,9 +915,22 @@ func itemRunStateToString(itemRunState entity.ItemRunState) string {
 
 // getDatasetFields 按顺序获取数据集字段
 func (e *exportCSVHelper) getDatasetFields(ctx context.Context, colEvalSetFields []*entity.ColumnEvalSetField, tes *entity.TurnEvalSet) (fields []string, err error) {
+	cells, err := e.getDatasetFieldCells(ctx, colEvalSetFields, tes)
+	if err != nil {
+		return nil, err
+	}
+	fields = make([]string, 0, len(cells))
+	for _, cell := range cells {
+		fields = append(fields, cell.text)
+	}
+	return fields, nil
+}
+
+// getDatasetFieldCells 按顺序获取数据集字段，单元格保留完整 Content
+func (e *exportCSVHelper) getDatasetFieldCells(ctx context.Context, colEvalSetFields []*entity.ColumnEvalSetField, tes *entity.TurnEvalSet) (cells []exportCell, err error) {
 	fdl := tes.Turn.FieldDataList
 	fdm := slices.ToMap(fdl, func(t *entity.FieldData) (string, *entity.FieldData) { return t.Key, t })
-	fields = make([]string, 0, len(colEvalSetFields))
+	cells = make([]exportCell, 0, len(colEvalSetFields))
 
 	for _, colEvalSetField := range colEvalSetFields {
 		if colEvalSetField == nil {
@@ -672,13 +939,13 @@ func (e *exportCSVHelper) getDatasetFields(ctx context.Context, colEvalSetFields
 
 		fieldData, ok := fdm[ptr.From(colEvalSetField.Key)]
 		if !ok {
-			fields = append(fields, "")
+			cells = append(cells, exportCell{})
 			continue
 		}
 
 		if fieldData.Content == nil {
 			// 必须与表头「每列一条」对齐；不能 continue 少一格，否则后续 Target 等列整体错位，表现为空数据且表头与列对不上。
-			fields = append(fields, "")
+			cells = append(cells, exportCell{})
 			continue
 		}
 
@@ -697,15 +964,26 @@ func (e *exportCSVHelper) getDatasetFields(ctx context.Context, colEvalSetFields
 			}
 		}
 
-		data, err := e.toContentStr(ctx, fieldData.Content)
+		cell, err := e.contentExportCell(ctx, fieldData.Content)
 		if err != nil {
 			return nil, err
 		}
 
-		fields = append(fields, data)
+		cells = append(cells, cell)
 	}
 
-	return fields, nil
+	return cells, nil
+}
+
+func (e *exportCSVHelper) contentExportCell(ctx context.Context, data *entity.Content) (exportCell, error) {
+	text, err := e.toContentStr(ctx, data)
+	if err != nil {
+		return exportCell{}, err
+	}
+	if data == nil {
+		return exportCell{}, nil
+	}
+	return exportCell{text: text, value: data}, nil
 }
 
 func (e *exportCSVHelper) toContentStr(ctx context.Context, data *entity.Content) (string, error) {
@@ -794,6 +1072,18 @@ func getEvaluatorReason(record *entity.EvaluatorRecord) string {
 	return record.EvaluatorOutputData.EvaluatorResult.Reasoning
 }
 
+// getEvaluatorScoreValue 评估器得分（人工修正优先），不截断精度
+func getEvaluatorScoreValue(record *entity.EvaluatorRecord) any {
+	if record == nil || record.EvaluatorOutputData == nil || record.EvaluatorOutputData.EvaluatorResult == nil || record.EvaluatorOutputData.EvaluatorResult.Score == nil {
+		return nil
+	}
+	result := record.EvaluatorOutputData.EvaluatorResult
+	if result.Correction != nil && result.Correction.Score != nil {
+		return *result.Correction.Score
+	}
+	return *result.Score
+}
+
 func getAnnotationData(record *entity.AnnotateRecord, columnAnnotation *entity.ColumnAnnotation) string {
 	if record == nil || record.AnnotateData == nil {
 		return ""
@@ -819,10 +1109,27 @@ func getAnnotationData(record *entity.AnnotateRecord, columnAnnotation *entity.C
 	}
 }
 
-func (e *ExptResultExportService) getFileName(ctx context.Context, exptName string, exportID int64) (string, error) {
+// getAnnotationValue 标注结构化值：连续分数为 float64（不截断），其余与 getAnnotationData 一致
+func getAnnotationValue(record *entity.AnnotateRecord, columnAnnotation *entity.ColumnAnnotation) any {
+	if record == nil || record.AnnotateData == nil {
+		return nil
+	}
+	if record.AnnotateData.TagContentType == entity.TagContentTypeContinuousNumber {
+		if record.AnnotateData.Score == nil {
+			return nil
+		}
+		return *record.AnnotateData.Score
+	}
+	if v := getAnnotationData(record, columnAnnotation); v != "" {
+		return v
+	}
+	return nil
+}
+
+func (e *ExptResultExportService) getFileName(ctx context.Context, exptName string, exportID int64, format entity.ExptResultExportFormat) (string, error) {
 	t := time.Now().Format("20060102")
-	// 文件名为：{对应实验名}_实验报告_{导出任务ID}_{下载时间}.csv
-	fileName := fmt.Sprintf("%s_实验报告_%d_%s.csv", exptName, exportID, t)
+	// 文件名为：{对应实验名}_实验报告_{导出任务ID}_{下载时间}.{格式扩展名}
+	fileName := fmt.Sprintf("%s_实验报告_%d_%s.%s", exptName, exportID, t, format.FileExt())
 	return fileName, nil
 }
 
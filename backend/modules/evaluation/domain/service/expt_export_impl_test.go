@@ -376,7 +376,7 @@ func TestExptResultExportService_ExportCSV(t *testing.T) {
 			svc := newTestExptResultExportService(ctrl)
 			tt.setup(svc)
 
-			got, err := svc.ExportCSV(context.Background(), tt.spaceID, tt.exptID, tt.session, (*entity.ExptResultExportColumnSpec)(nil))
+			got, err := svc.ExportCSV(context.Background(), tt.spaceID, tt.exptID, tt.session, (*entity.ExptResultExportColumnSpec)(nil), entity.ExptResultExportFormatCSV)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ExportCSV() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1060,6 +1060,7 @@ func TestNewExptResultExportService(t *testing.T) {
 		mockBenefit,
 		urlProcessor,
 		mockEvalSetItemSvc,
+		nil,
 	)
 
 	impl, ok := svc.(*ExptResultExportService)
@@ -1957,3 +1958,58 @@ func Test_buildColumns_withWhitelistSelection(t *testing.T) {
 		assert.Contains(t, columns, "eval5<v3>_reason")
 	})
 }
+
+func Test_exportCSVHelper_trialColumnsAndCells(t *testing.T) {
+	ctx := context.Background()
+	h := &exportCSVHelper{
+		reportEvaluatorCount: 1,
+		trialNum:             3,
+		columnsEvalTarget:    []*entity.ColumnEvalTarget{{Name: "actual_output"}},
+		colEvaluators: []*entity.ColumnEvaluator{
+			{EvaluatorVersionID: 1, Name: ptr.Of("eval1"), Version: ptr.Of("v1")},
+		},
+	}
+
+	t.Run("每次额外试验导出一组评测对象与评估器得分列", func(t *testing.T) {
+		columns, err := h.buildColumns(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, columns, "trial1_actual_output")
+		assert.Contains(t, columns, "trial1_eval1<v1>")
+		assert.Contains(t, columns, "trial2_actual_output")
+		assert.Contains(t, columns, "trial2_eval1<v1>")
+		assert.NotContains(t, columns, "trial3_eval1<v1>")
+	})
+
+	t.Run("缺失的试验补空单元格", func(t *testing.T) {
+		payload := &entity.ExperimentTurnPayload{
+			TrialOutputs: []*entity.TurnTrialOutput{
+				{
+					TrialIdx: 1,
+					TargetOutput: &entity.TurnTargetOutput{EvalTargetRecord: &entity.EvalTargetRecord{
+						EvalTargetOutputData: &entity.EvalTargetOutputData{
+							OutputFields: map[string]*entity.Content{"actual_output": {ContentType: ptr.Of(entity.ContentTypeText), Text: ptr.Of("hello")}},
+						},
+					}},
+					EvaluatorOutput: &entity.TurnEvaluatorOutput{EvaluatorRecords: map[int64]*entity.EvaluatorRecord{
+						1: {EvaluatorOutputData: &entity.EvaluatorOutputData{EvaluatorResult: &entity.EvaluatorResult{Score: ptr.Of(0.5)}}},
+					}},
+				},
+			},
+		}
+		cells, err := h.buildTrialCells(ctx, payload)
+		require.NoError(t, err)
+		require.Len(t, cells, 4)
+		assert.Equal(t, "hello", cells[0].text)
+		assert.Equal(t, "0.50", cells[1].text)
+		assert.Equal(t, "", cells[2].text)
+		assert.Equal(t, "", cells[3].text)
+	})
+
+	t.Run("未开启重复试验不导出试验列", func(t *testing.T) {
+		h1 := &exportCSVHelper{trialNum: 1, columnsEvalTarget: h.columnsEvalTarget, colEvaluators: h.colEvaluators}
+		assert.Empty(t, h1.buildTrialColumns())
+		cells, err := h1.buildTrialCells(ctx, &entity.ExperimentTurnPayload{})
+		require.NoError(t, err)
+		assert.Empty(t, cells)
+	})
+}
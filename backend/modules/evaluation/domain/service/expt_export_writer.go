@@ -0,0 +1,521 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/xuri/excelize/v2"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+// exportColumnGroup 导出列所属分组，JSONL 按分组组织嵌套结构
+type exportColumnGroup int
+
+const (
+	exportColumnGroupItem exportColumnGroup = iota
+	exportColumnGroupEvalSet
+	exportColumnGroupTarget
+	exportColumnGroupEvaluatorScore
+	exportColumnGroupEvaluatorReason
+	exportColumnGroupWeightedScore
+	exportColumnGroupCost
+	exportColumnGroupTrial
+	exportColumnGroupAnnotation
+	exportColumnGroupSystem
+)
+
+// exportValueKind 导出列的值类型，决定 XLSX 单元格类型与 Parquet 列类型
+type exportValueKind int
+
+const (
+	exportValueKindString exportValueKind = iota
+	exportValueKindInt
+	exportValueKindFloat
+	// exportValueKindContent 值为 *entity.Content；JSONL 保留完整结构，其余格式使用展开后的文本
+	exportValueKindContent
+)
+
+type exportColumn struct {
+	name  string
+	group exportColumnGroup
+	kind  exportValueKind
+	// evaluatorKey / evaluatorVersionID 仅评估器列有效，score 与 reason 列共用同一 evaluatorKey
+	evaluatorKey       string
+	evaluatorVersionID int64
+	// trialIdx / trialKey 仅重复试验列有效，trialKey 为列在所属试验内的名称
+	trialIdx int32
+	trialKey string
+	// evalSetIDs 仅多评测集实验的评测集字段列有效，为列所属的评测集；为空表示属于全部评测集
+	evalSetIDs []int64
+}
+
+// belongsToEvalSet 列是否出现在评测集对应的 XLSX sheet 中
+func (c *exportColumn) belongsToEvalSet(evalSetID int64) bool {
+	return len(c.evalSetIDs) == 0 || slices.Contains(c.evalSetIDs, evalSetID)
+}
+
+// exportCell 单元格：text 为 CSV 文本（沿用分数两位小数、多模态展开等既有语义），value 为结构化值（可为 nil）
+type exportCell struct {
+	text  string
+	value any
+}
+
+func stringExportCell(s string) exportCell {
+	return exportCell{text: s, value: s}
+}
+
+// costExportCell 花费单价常低于 0.01, 文本保留 6 位小数
+func costExportCell(v float64) exportCell {
+	return exportCell{text: strconv.FormatFloat(v, 'f', 6, 64), value: v}
+}
+
+func intExportCell(v int64) exportCell {
+	return exportCell{text: strconv.FormatInt(v, 10), value: v}
+}
+
+type exportRow struct {
+	itemID    int64
+	turnID    int64
+	evalSetID int64
+	cells     []exportCell
+}
+
+func (r *exportRow) texts() []string {
+	texts := make([]string, 0, len(r.cells))
+	for _, cell := range r.cells {
+		texts = append(texts, cell.text)
+	}
+	return texts
+}
+
+// exptResultFileWriter 实验报告文件写入器，按格式实现；writeHeader 在首批数据前调用一次
+type exptResultFileWriter interface {
+	writeHeader(columns []*exportColumn) error
+	writeRows(rows []*exportRow) error
+	close() error
+}
+
+// newExptResultFileWriter sheetEvalSetIDs 非空表示多评测集实验，仅 XLSX 使用
+func newExptResultFileWriter(format entity.ExptResultExportFormat, w io.Writer, sheetEvalSetIDs []int64) (exptResultFileWriter, error) {
+	switch format.OrDefault() {
+	case entity.ExptResultExportFormatCSV:
+		return newCSVExportWriter(w)
+	case entity.ExptResultExportFormatXLSX:
+		return &xlsxExportWriter{w: w, sheetEvalSetIDs: sheetEvalSetIDs}, nil
+	case entity.ExptResultExportFormatJSONL:
+		return &jsonlExportWriter{w: bufio.NewWriter(w)}, nil
+	case entity.ExptResultExportFormatParquet:
+		return &parquetExportWriter{w: w}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+type csvExportWriter struct {
+	w *csv.Writer
+}
+
+func newCSVExportWriter(w io.Writer) (*csvExportWriter, error) {
+	// UTF-8 BOM，保证 Excel 直接打开时中文不乱码
+	if _, err := io.WriteString(w, "\xEF\xBB\xBF"); err != nil {
+		return nil, err
+	}
+	return &csvExportWriter{w: csv.NewWriter(w)}, nil
+}
+
+func (c *csvExportWriter) writeHeader(columns []*exportColumn) error {
+	names := make([]string, 0, len(columns))
+	for _, col := range columns {
+		names = append(names, col.name)
+	}
+	return c.w.Write(names)
+}
+
+func (c *csvExportWriter) writeRows(rows []*exportRow) error {
+	for _, row := range rows {
+		if err := c.w.Write(row.texts()); err != nil {
+			return err
+		}
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvExportWriter) close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+const (
+	xlsxDefaultSheetName = "Sheet1"
+	xlsxReportSheetName  = "实验报告"
+	// xlsxMaxCellChars Excel 单元格最大字符数
+	xlsxMaxCellChars = 32767
+)
+
+// xlsxExportWriter 流式写 XLSX；多评测集实验每个评测集一个 sheet，
+// 各 sheet 只包含该评测集自身的字段列，其余列各 sheet 相同
+type xlsxExportWriter struct {
+	w               io.Writer
+	sheetEvalSetIDs []int64
+
+	file    *excelize.File
+	columns []*exportColumn
+	// sheets key 为评测集 ID，单集实验只有 key=0 的 sheet
+	sheets map[int64]*xlsxExportSheet
+}
+
+type xlsxExportSheet struct {
+	sw      *excelize.StreamWriter
+	nextRow int
+	// colIdxes 该 sheet 输出的列在全量列中的下标
+	colIdxes []int
+}
+
+func (x *xlsxExportWriter) writeHeader(columns []*exportColumn) error {
+	x.file = excelize.NewFile()
+	x.columns = columns
+	x.sheets = make(map[int64]*xlsxExportSheet)
+
+	if len(x.sheetEvalSetIDs) == 0 {
+		if err := x.file.SetSheetName(xlsxDefaultSheetName, xlsxReportSheetName); err != nil {
+			return err
+		}
+		_, err := x.openSheet(0, xlsxReportSheetName, false)
+		return err
+	}
+	for _, evalSetID := range x.sheetEvalSetIDs {
+		if _, err := x.sheet(evalSetID); err != nil {
+			return err
+		}
+	}
+	return x.file.DeleteSheet(xlsxDefaultSheetName)
+}
+
+// sheet 获取评测集对应的 sheet，未在实验配置中出现的评测集按需追加
+func (x *xlsxExportWriter) sheet(evalSetID int64) (*xlsxExportSheet, error) {
+	if len(x.sheetEvalSetIDs) == 0 {
+		evalSetID = 0
+	}
+	if s, ok := x.sheets[evalSetID]; ok {
+		return s, nil
+	}
+	return x.openSheet(evalSetID, fmt.Sprintf("评测集_%d", evalSetID), true)
+}
+
+func (x *xlsxExportWriter) openSheet(key int64, name string, create bool) (*xlsxExportSheet, error) {
+	if create {
+		if _, err := x.file.NewSheet(name); err != nil {
+			return nil, err
+		}
+	}
+	sw, err := x.file.NewStreamWriter(name)
+	if err != nil {
+		return nil, err
+	}
+	colIdxes := make([]int, 0, len(x.columns))
+	header := make([]any, 0, len(x.columns))
+	for i, col := range x.columns {
+		if key == 0 || col.belongsToEvalSet(key) {
+			colIdxes = append(colIdxes, i)
+			header = append(header, col.name)
+		}
+	}
+	if err = sw.SetRow("A1", header); err != nil {
+		return nil, err
+	}
+	s := &xlsxExportSheet{sw: sw, nextRow: 2, colIdxes: colIdxes}
+	x.sheets[key] = s
+	return s, nil
+}
+
+func (x *xlsxExportWriter) writeRows(rows []*exportRow) error {
+	for _, row := range rows {
+		s, err := x.sheet(row.evalSetID)
+		if err != nil {
+			return err
+		}
+		values := make([]any, 0, len(s.colIdxes))
+		for _, i := range s.colIdxes {
+			var cell exportCell
+			if i < len(row.cells) {
+				cell = row.cells[i]
+			}
+			values = append(values, xlsxCellValue(x.columns[i].kind, cell))
+		}
+		axis, err := excelize.CoordinatesToCellName(1, s.nextRow)
+		if err != nil {
+			return err
+		}
+		if err = s.sw.SetRow(axis, values); err != nil {
+			return err
+		}
+		s.nextRow++
+	}
+	return nil
+}
+
+// xlsxCellValue 数值列写入数字单元格（保留完整精度），其余写文本
+func xlsxCellValue(kind exportValueKind, cell exportCell) any {
+	switch kind {
+	case exportValueKindInt, exportValueKindFloat:
+		if cell.value != nil {
+			return cell.value
+		}
+		return nil
+	default:
+		return truncateRunes(cell.text, xlsxMaxCellChars)
+	}
+}
+
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
+
+func (x *xlsxExportWriter) close() error {
+	if x.file == nil {
+		return nil
+	}
+	defer func() { _ = x.file.Close() }()
+	for _, s := range x.sheets {
+		if err := s.sw.Flush(); err != nil {
+			return err
+		}
+	}
+	return x.file.Write(x.w)
+}
+
+// jsonlExportWriter 每个 turn 一行 JSON，评测集字段与 Target 输出保留完整 Content 结构
+type jsonlExportWriter struct {
+	w       *bufio.Writer
+	columns []*exportColumn
+}
+
+type exptResultJSONLRow struct {
+	ItemID            int64                                `json:"item_id"`
+	TurnID            int64                                `json:"turn_id"`
+	EvalSetID         int64                                `json:"eval_set_id,omitempty"`
+	Status            string                               `json:"status"`
+	EvalSetFields     map[string]any                       `json:"eval_set_fields,omitempty"`
+	EvalTargetOutputs map[string]any                       `json:"eval_target_outputs,omitempty"`
+	Evaluators        map[string]*exptResultJSONLEvaluator `json:"evaluators,omitempty"`
+	WeightedScore     any                                  `json:"weighted_score,omitempty"`
+	Cost              map[string]any                       `json:"cost,omitempty"`
+	Trials            map[string]map[string]any            `json:"trials,omitempty"`
+	Annotations       map[string]any                       `json:"annotations,omitempty"`
+	LogID             string                               `json:"log_id,omitempty"`
+	TargetTraceID     string                               `json:"target_trace_id,omitempty"`
+}
+
+type exptResultJSONLEvaluator struct {
+	EvaluatorVersionID int64   `json:"evaluator_version_id"`
+	Score              any     `json:"score,omitempty"`
+	Reasoning          *string `json:"reasoning,omitempty"`
+}
+
+func (j *jsonlExportWriter) writeHeader(columns []*exportColumn) error {
+	j.columns = columns
+	return nil
+}
+
+func (j *jsonlExportWriter) writeRows(rows []*exportRow) error {
+	for _, row := range rows {
+		bytes, err := json.Marshal(j.buildRow(row))
+		if err != nil {
+			return err
+		}
+		if _, err = j.w.Write(bytes); err != nil {
+			return err
+		}
+		if err = j.w.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	return j.w.Flush()
+}
+
+func (j *jsonlExportWriter) buildRow(row *exportRow) *exptResultJSONLRow {
+	out := &exptResultJSONLRow{ItemID: row.itemID, TurnID: row.turnID, EvalSetID: row.evalSetID}
+	putValue := func(m *map[string]any, key string, value any) {
+		if value == nil {
+			return
+		}
+		if *m == nil {
+			*m = make(map[string]any)
+		}
+		(*m)[key] = value
+	}
+	evaluator := func(col *exportColumn) *exptResultJSONLEvaluator {
+		if out.Evaluators == nil {
+			out.Evaluators = make(map[string]*exptResultJSONLEvaluator)
+		}
+		ev, ok := out.Evaluators[col.evaluatorKey]
+		if !ok {
+			ev = &exptResultJSONLEvaluator{EvaluatorVersionID: col.evaluatorVersionID}
+			out.Evaluators[col.evaluatorKey] = ev
+		}
+		return ev
+	}
+
+	for i, col := range j.columns {
+		if i >= len(row.cells) {
+			break
+		}
+		cell := row.cells[i]
+		switch col.group {
+		case exportColumnGroupItem:
+			if col.name == columnNameStatus {
+				out.Status = cell.text
+			}
+		case exportColumnGroupEvalSet:
+			putValue(&out.EvalSetFields, col.name, cell.value)
+		case exportColumnGroupTarget:
+			putValue(&out.EvalTargetOutputs, col.name, cell.value)
+		case exportColumnGroupEvaluatorScore:
+			evaluator(col).Score = cell.value
+		case exportColumnGroupEvaluatorReason:
+			reason := cell.text
+			evaluator(col).Reasoning = &reason
+		case exportColumnGroupWeightedScore:
+			out.WeightedScore = cell.value
+		case exportColumnGroupCost:
+			putValue(&out.Cost, col.name, cell.value)
+		case exportColumnGroupTrial:
+			if cell.value == nil {
+				continue
+			}
+			if out.Trials == nil {
+				out.Trials = make(map[string]map[string]any)
+			}
+			trial := out.Trials[strconv.Itoa(int(col.trialIdx))]
+			putValue(&trial, col.trialKey, cell.value)
+			out.Trials[strconv.Itoa(int(col.trialIdx))] = trial
+		case exportColumnGroupAnnotation:
+			putValue(&out.Annotations, col.name, cell.value)
+		case exportColumnGroupSystem:
+			switch col.name {
+			case columnNameLogID:
+				out.LogID = cell.text
+			case columnNameTargetTraceID:
+				out.TargetTraceID = cell.text
+			}
+		}
+	}
+	return out
+}
+
+func (j *jsonlExportWriter) close() error {
+	return j.w.Flush()
+}
+
+// parquetExportWriter 所有列均为 optional；数值列保留类型，内容列使用展开后的文本
+type parquetExportWriter struct {
+	w io.Writer
+
+	columns []*exportColumn
+	names   []string
+	writer  *parquet.GenericWriter[map[string]any]
+}
+
+func (p *parquetExportWriter) writeHeader(columns []*exportColumn) error {
+	p.columns = columns
+	p.names = uniqueExportColumnNames(columns)
+	group := make(parquet.Group, len(columns))
+	for i, col := range columns {
+		var node parquet.Node
+		switch col.kind {
+		case exportValueKindInt:
+			node = parquet.Leaf(parquet.Int64Type)
+		case exportValueKindFloat:
+			node = parquet.Leaf(parquet.DoubleType)
+		default:
+			node = parquet.String()
+		}
+		group[p.names[i]] = parquet.Optional(node)
+	}
+	p.writer = parquet.NewGenericWriter[map[string]any](p.w, parquet.NewSchema("expt_result", group))
+	return nil
+}
+
+func (p *parquetExportWriter) writeRows(rows []*exportRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	records := make([]map[string]any, 0, len(rows))
+	for _, row := range rows {
+		record := make(map[string]any, len(p.columns))
+		for i, col := range p.columns {
+			var cell exportCell
+			if i < len(row.cells) {
+				cell = row.cells[i]
+			}
+			record[p.names[i]] = parquetCellValue(col.kind, cell)
+		}
+		records = append(records, record)
+	}
+	_, err := p.writer.Write(records)
+	return err
+}
+
+func parquetCellValue(kind exportValueKind, cell exportCell) any {
+	switch kind {
+	case exportValueKindInt, exportValueKindFloat:
+		return cell.value
+	case exportValueKindContent:
+		if cell.value == nil {
+			return nil
+		}
+		return cell.text
+	default:
+		return cell.text
+	}
+}
+
+func (p *parquetExportWriter) close() error {
+	if p.writer == nil {
+		return nil
+	}
+	return p.writer.Close()
+}
+
+// uniqueExportColumnNames Parquet 列名必须唯一，重名列（如评测集字段与 Target 输出同名）追加序号，
+// 追加后仍与已有列名冲突时（如已存在名为 input_2 的列）继续递增序号
+func uniqueExportColumnNames(columns []*exportColumn) []string {
+	taken := make(map[string]bool, len(columns))
+	for _, col := range columns {
+		taken[col.name] = true
+	}
+	names := make([]string, 0, len(columns))
+	used := make(map[string]bool, len(columns))
+	next := make(map[string]int, len(columns))
+	for _, col := range columns {
+		name := col.name
+		if used[name] {
+			n := max(next[col.name], 2)
+			for ; ; n++ {
+				name = fmt.Sprintf("%s_%d", col.name, n)
+				if !used[name] && !taken[name] {
+					break
+				}
+			}
+			next[col.name] = n + 1
+		}
+		used[name] = true
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,280 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/infra/fileserver"
+	fileserverMocks "github.com/coze-dev/coze-loop/backend/infra/fileserver/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repoMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func newTestExportColumnsAndRows() ([]*exportColumn, []*exportRow) {
+	columns := []*exportColumn{
+		{name: columnNameID, group: exportColumnGroupItem, kind: exportValueKindInt},
+		{name: columnNameStatus, group: exportColumnGroupItem, kind: exportValueKindString},
+		{name: "input", group: exportColumnGroupEvalSet, kind: exportValueKindContent},
+		{name: "input", group: exportColumnGroupTarget, kind: exportValueKindContent},
+		{name: "acc<v1>", group: exportColumnGroupEvaluatorScore, kind: exportValueKindFloat, evaluatorKey: "acc<v1>", evaluatorVersionID: 7},
+		{name: "acc<v1>_reason", group: exportColumnGroupEvaluatorReason, kind: exportValueKindString, evaluatorKey: "acc<v1>", evaluatorVersionID: 7},
+		{name: columnNameWeightedScore, group: exportColumnGroupWeightedScore, kind: exportValueKindFloat},
+	}
+	multiPart := &entity.Content{
+		ContentType: ptr.Of(entity.ContentTypeMultipart),
+		MultiPart: []*entity.Content{
+			{ContentType: ptr.Of(entity.ContentTypeText), Text: ptr.Of("look")},
+			{ContentType: ptr.Of(entity.ContentTypeImage), Image: &entity.Image{URL: ptr.Of("http://img")}},
+		},
+	}
+	rows := []*exportRow{
+		{itemID: 1, turnID: 11, evalSetID: 100, cells: []exportCell{
+			intExportCell(1), stringExportCell("success"),
+			{text: "look\n<ref_image_url:http://img>\n", value: multiPart},
+			{text: "answer", value: &entity.Content{ContentType: ptr.Of(entity.ContentTypeText), Text: ptr.Of("answer")}},
+			{text: "0.12", value: 0.123456}, stringExportCell("because"),
+			{text: "0.12", value: 0.123456},
+		}},
+		{itemID: 2, turnID: 21, evalSetID: 200, cells: []exportCell{
+			intExportCell(2), stringExportCell("fail"), {}, {}, {}, stringExportCell(""), {},
+		}},
+	}
+	return columns, rows
+}
+
+func writeTestExportFile(t *testing.T, format entity.ExptResultExportFormat, sheetEvalSetIDs []int64) []byte {
+	t.Helper()
+	columns, rows := newTestExportColumnsAndRows()
+	var buf bytes.Buffer
+	w, err := newExptResultFileWriter(format, &buf, sheetEvalSetIDs)
+	require.NoError(t, err)
+	require.NoError(t, w.writeHeader(columns))
+	require.NoError(t, w.writeRows(rows[:1]))
+	require.NoError(t, w.writeRows(rows[1:]))
+	require.NoError(t, w.close())
+	return buf.Bytes()
+}
+
+func TestExptResultFileWriter_CSV(t *testing.T) {
+	out := string(writeTestExportFile(t, entity.ExptResultExportFormatCSV, nil))
+	assert.True(t, strings.HasPrefix(out, "\xEF\xBB\xBF"))
+	lines := strings.Split(strings.TrimPrefix(out, "\xEF\xBB\xBF"), "\n")
+	assert.Equal(t, "ID,status,input,input,acc<v1>,acc<v1>_reason,weightedScore", lines[0])
+	assert.Contains(t, out, "0.12,because,0.12")
+}
+
+func TestExptResultFileWriter_XLSX(t *testing.T) {
+	t.Run("single eval set", func(t *testing.T) {
+		f, err := excelize.OpenReader(bytes.NewReader(writeTestExportFile(t, entity.ExptResultExportFormatXLSX, nil)))
+		require.NoError(t, err)
+		defer func() { _ = f.Close() }()
+		assert.Equal(t, []string{xlsxReportSheetName}, f.GetSheetList())
+
+		rows, err := f.GetRows(xlsxReportSheetName)
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+		assert.Equal(t, "acc<v1>", rows[0][4])
+		// 分数保留完整精度
+		assert.Equal(t, "0.123456", rows[1][4])
+		cellType, err := f.GetCellType(xlsxReportSheetName, "E2")
+		require.NoError(t, err)
+		assert.NotEqual(t, excelize.CellTypeSharedString, cellType)
+	})
+
+	t.Run("one sheet per eval set", func(t *testing.T) {
+		f, err := excelize.OpenReader(bytes.NewReader(writeTestExportFile(t, entity.ExptResultExportFormatXLSX, []int64{200, 100, 300})))
+		require.NoError(t, err)
+		defer func() { _ = f.Close() }()
+		assert.Equal(t, []string{"评测集_200", "评测集_100", "评测集_300"}, f.GetSheetList())
+
+		rows, err := f.GetRows("评测集_100")
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		assert.Equal(t, "1", rows[1][0])
+		rows, err = f.GetRows("评测集_200")
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		assert.Equal(t, "2", rows[1][0])
+		rows, err = f.GetRows("评测集_300")
+		require.NoError(t, err)
+		assert.Len(t, rows, 1)
+	})
+
+	t.Run("per eval set header", func(t *testing.T) {
+		columns := []*exportColumn{
+			{name: columnNameID, group: exportColumnGroupItem, kind: exportValueKindInt},
+			{name: "question", group: exportColumnGroupEvalSet, kind: exportValueKindContent, evalSetIDs: []int64{100, 200}},
+			{name: "context", group: exportColumnGroupEvalSet, kind: exportValueKindContent, evalSetIDs: []int64{100}},
+			{name: "image", group: exportColumnGroupEvalSet, kind: exportValueKindContent, evalSetIDs: []int64{200}},
+			{name: "acc<v1>", group: exportColumnGroupEvaluatorScore, kind: exportValueKindFloat},
+		}
+		var buf bytes.Buffer
+		w, err := newExptResultFileWriter(entity.ExptResultExportFormatXLSX, &buf, []int64{100, 200})
+		require.NoError(t, err)
+		require.NoError(t, w.writeHeader(columns))
+		require.NoError(t, w.writeRows([]*exportRow{
+			{itemID: 1, evalSetID: 100, cells: []exportCell{intExportCell(1), stringExportCell("q1"), stringExportCell("c1"), {}, {text: "1", value: 1.0}}},
+			{itemID: 2, evalSetID: 200, cells: []exportCell{intExportCell(2), stringExportCell("q2"), {}, stringExportCell("i2"), {text: "0", value: 0.0}}},
+		}))
+		require.NoError(t, w.close())
+
+		f, err := excelize.OpenReader(bytes.NewReader(buf.Bytes()))
+		require.NoError(t, err)
+		defer func() { _ = f.Close() }()
+		rows, err := f.GetRows("评测集_100")
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{columnNameID, "question", "context", "acc<v1>"}, {"1", "q1", "c1", "1"}}, rows)
+		rows, err = f.GetRows("评测集_200")
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{columnNameID, "question", "image", "acc<v1>"}, {"2", "q2", "i2", "0"}}, rows)
+	})
+}
+
+func TestExptResultFileWriter_JSONL(t *testing.T) {
+	lines := strings.Split(strings.TrimSpace(string(writeTestExportFile(t, entity.ExptResultExportFormatJSONL, nil))), "\n")
+	require.Len(t, lines, 2)
+
+	var first exptResultJSONLRow
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	assert.Equal(t, int64(1), first.ItemID)
+	assert.Equal(t, int64(11), first.TurnID)
+	assert.Equal(t, int64(100), first.EvalSetID)
+	assert.Equal(t, "success", first.Status)
+	assert.Equal(t, 0.123456, first.WeightedScore)
+	require.Contains(t, first.Evaluators, "acc<v1>")
+	assert.Equal(t, int64(7), first.Evaluators["acc<v1>"].EvaluatorVersionID)
+	assert.Equal(t, 0.123456, first.Evaluators["acc<v1>"].Score)
+	assert.Equal(t, "because", *first.Evaluators["acc<v1>"].Reasoning)
+
+	// 多模态内容保留完整结构
+	raw, err := json.Marshal(first.EvalSetFields["input"])
+	require.NoError(t, err)
+	var content entity.Content
+	require.NoError(t, json.Unmarshal(raw, &content))
+	require.Len(t, content.MultiPart, 2)
+	assert.Equal(t, "http://img", *content.MultiPart[1].Image.URL)
+
+	var second exptResultJSONLRow
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &second))
+	assert.Empty(t, second.EvalSetFields)
+	assert.Nil(t, second.WeightedScore)
+	assert.Nil(t, second.Evaluators["acc<v1>"].Score)
+}
+
+func TestExptResultFileWriter_Parquet(t *testing.T) {
+	data := writeTestExportFile(t, entity.ExptResultExportFormatParquet, nil)
+	r := parquet.NewReader(bytes.NewReader(data))
+	defer func() { _ = r.Close() }()
+	assert.Equal(t, int64(2), r.NumRows())
+
+	first := map[string]any{}
+	require.NoError(t, r.Read(&first))
+	assert.Equal(t, int64(1), first[columnNameID])
+	assert.Equal(t, "look\n<ref_image_url:http://img>\n", first["input"])
+	assert.Equal(t, "answer", first["input_2"])
+	assert.Equal(t, 0.123456, first["acc<v1>"])
+
+	second := map[string]any{}
+	require.NoError(t, r.Read(&second))
+	assert.Nil(t, second["acc<v1>"])
+	assert.Nil(t, second["input"])
+	assert.Nil(t, second[columnNameWeightedScore])
+}
+
+func TestUniqueExportColumnNames(t *testing.T) {
+	names := func(ns ...string) []*exportColumn {
+		columns := make([]*exportColumn, 0, len(ns))
+		for _, n := range ns {
+			columns = append(columns, &exportColumn{name: n})
+		}
+		return columns
+	}
+	assert.Equal(t, []string{"input", "input_2", "output"}, uniqueExportColumnNames(names("input", "input", "output")))
+	// 追加序号后与已有列名冲突时继续递增
+	assert.Equal(t, []string{"input", "input_3", "input_2"}, uniqueExportColumnNames(names("input", "input", "input_2")))
+	assert.Equal(t, []string{"input", "input_2", "input_3", "input_4"}, uniqueExportColumnNames(names("input", "input_2", "input", "input")))
+}
+
+func TestNewExptResultFileWriter_Unsupported(t *testing.T) {
+	_, err := newExptResultFileWriter(entity.ExptResultExportFormat("PDF"), &bytes.Buffer{}, nil)
+	assert.Error(t, err)
+}
+
+func TestExptResultExportService_DoExport_XLSXMultiEvalSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := newTestExptResultExportService(ctrl)
+	svc.exptRepo.(*repoMocks.MockIExperimentRepo).EXPECT().GetByID(gomock.Any(), int64(123), int64(1)).Return(&entity.Experiment{
+		ID:                123,
+		SpaceID:           1,
+		EvalSetSourceType: entity.ExptEvalSetSourceType_MultiSetConfig,
+		EvalConf:          &entity.EvaluationConfiguration{EvalSetConfigs: []*entity.EvalSetConfig{{EvalSetID: 10}, {EvalSetID: 20}}},
+	}, nil)
+
+	newItem := func(itemID, evalSetID int64, text string, score float64) *entity.ItemResult {
+		return &entity.ItemResult{ItemID: itemID, TurnResults: []*entity.TurnResult{{
+			TurnID: 1,
+			ExperimentResults: []*entity.ExperimentResult{{ExperimentID: 123, Payload: &entity.ExperimentTurnPayload{
+				EvalSet: &entity.TurnEvalSet{EvalSetID: evalSetID, ItemID: itemID, Turn: &entity.Turn{ID: 1, FieldDataList: []*entity.FieldData{
+					{Key: "k", Name: "input", Content: &entity.Content{ContentType: ptr.Of(entity.ContentTypeText), Text: ptr.Of(text)}},
+				}}},
+				TargetOutput: &entity.TurnTargetOutput{EvalTargetRecord: &entity.EvalTargetRecord{EvalTargetOutputData: &entity.EvalTargetOutputData{
+					OutputFields: map[string]*entity.Content{consts.OutputSchemaKey: {ContentType: ptr.Of(entity.ContentTypeText), Text: ptr.Of("out")}},
+				}}},
+				EvaluatorOutput: &entity.TurnEvaluatorOutput{EvaluatorRecords: map[int64]*entity.EvaluatorRecord{
+					1: {EvaluatorVersionID: 1, EvaluatorOutputData: &entity.EvaluatorOutputData{EvaluatorResult: &entity.EvaluatorResult{Score: ptr.Of(score), Reasoning: "r"}}},
+				}},
+			}}},
+		}}}
+	}
+	svc.exptResultService.(*svcMocks.MockExptResultService).EXPECT().MGetExperimentResult(gomock.Any(), gomock.Any()).Return(&entity.MGetExperimentReportResult{
+		ColumnEvaluators:    []*entity.ColumnEvaluator{{EvaluatorVersionID: 1, Name: ptr.Of("acc"), Version: ptr.Of("v1")}},
+		ColumnEvalSetFields: []*entity.ColumnEvalSetField{{Key: ptr.Of("k"), Name: ptr.Of("input")}},
+		ItemResults:         []*entity.ItemResult{newItem(1, 10, "a", 0.3333), newItem(2, 20, "b", 0.6667)},
+	}, nil)
+
+	var uploaded []byte
+	svc.fileClient.(*fileserverMocks.MockObjectStorage).EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, r io.Reader, _ ...fileserver.UploadOpt) error {
+			var buf bytes.Buffer
+			_, err := buf.ReadFrom(r)
+			uploaded = buf.Bytes()
+			return err
+		})
+
+	out := filepath.Join(t.TempDir(), "report.xlsx")
+	err := svc.DoExport(context.Background(), 1, 123, out, false, nil, entity.ExptResultExportFormatXLSX)
+	require.NoError(t, err)
+	_, statErr := os.Stat(out)
+	assert.True(t, os.IsNotExist(statErr))
+
+	f, err := excelize.OpenReader(bytes.NewReader(uploaded))
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+	assert.Equal(t, []string{"评测集_10", "评测集_20"}, f.GetSheetList())
+	rows, err := f.GetRows("评测集_20")
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, []string{"ID", "status", "input", "acc<v1>", "acc<v1>_reason", "weightedScore"}, rows[0])
+	assert.Equal(t, "b", rows[1][2])
+	assert.Equal(t, "0.6667", rows[1][3])
+}
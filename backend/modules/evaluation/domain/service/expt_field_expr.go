@@ -0,0 +1,74 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/fieldexpr"
+)
+
+// evalFieldExpr 以来源字段 (评测集列或评测对象输出) 为上下文对字段映射表达式求值。
+// 文本字段以文本参与运算, 多模态等非文本字段仅支持原样透传; 结果为非文本值时序列化为 JSON 文本。
+func evalFieldExpr(fc *entity.FieldConf, sourceFields map[string]*entity.Content) (*entity.Content, error) {
+	expr, err := fieldexpr.Compile(fc.GetExpr())
+	if err != nil {
+		return nil, fmt.Errorf("field %s: invalid expression %q: %w", fc.FieldName, fc.GetExpr(), err)
+	}
+	v, err := expr.Eval(func(column string) (any, bool) {
+		content := sourceFields[column]
+		if content == nil {
+			return nil, false
+		}
+		if content.ContentType == nil || gptr.Indirect(content.ContentType) == entity.ContentTypeText {
+			return gptr.Indirect(content.Text), true
+		}
+		return content, true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("field %s: eval expression %q fail: %w", fc.FieldName, fc.GetExpr(), err)
+	}
+	switch val := v.(type) {
+	case nil:
+		return nil, nil
+	case *entity.Content:
+		return val, nil
+	default:
+		return &entity.Content{
+			ContentType: gptr.Of(entity.ContentTypeText),
+			Text:        gptr.Of(fieldexpr.ToString(val)),
+		}, nil
+	}
+}
+
+// validateFieldExpr 创建实验时校验字段映射表达式: 语法合法, 引用的列存在于来源 schema,
+// 目标字段存在于接收方输入 schema (schema 为空时不校验目标字段)
+func validateFieldExpr(fc *entity.FieldConf, sourceName string, sourceFields map[string]bool, targetName string, targetFields map[string]bool) error {
+	expr, err := fieldexpr.Compile(fc.GetExpr())
+	if err != nil {
+		return fmt.Errorf("field %s: invalid expression %q: %w", fc.FieldName, fc.GetExpr(), err)
+	}
+	for _, column := range expr.Fields() {
+		if !sourceFields[column] {
+			return fmt.Errorf("field %s: expression %q references missing %s field %s", fc.FieldName, fc.GetExpr(), sourceName, column)
+		}
+	}
+	if len(targetFields) > 0 && !targetFields[fc.FieldName] {
+		return fmt.Errorf("field %s: %s has no input field named %s", fc.FieldName, targetName, fc.FieldName)
+	}
+	return nil
+}
+
+func argsSchemaKeys(schemas []*entity.ArgsSchema) map[string]bool {
+	keys := make(map[string]bool, len(schemas))
+	for _, s := range schemas {
+		if s != nil && s.Key != nil {
+			keys[*s.Key] = true
+		}
+	}
+	return keys
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func TestEvalFieldExpr(t *testing.T) {
+	image := &entity.Content{ContentType: gptr.Of(entity.ContentTypeImage), Image: &entity.Image{URL: gptr.Of("https://a/b.png")}}
+	sourceFields := map[string]*entity.Content{
+		"question": {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("1+1=?")},
+		"meta":     {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of(`{"lang":"zh","tags":["math"]}`)},
+		"image":    image,
+	}
+
+	tests := []struct {
+		name    string
+		expr    string
+		want    *entity.Content
+		wantErr bool
+	}{
+		{
+			name: "模板拼接",
+			expr: `template("[{{ $.meta.lang }}] {{ $.question }}")`,
+			want: &entity.Content{ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("[zh] 1+1=?")},
+		},
+		{
+			name: "条件",
+			expr: `if($.meta.lang == "en", "english", "other")`,
+			want: &entity.Content{ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("other")},
+		},
+		{
+			name: "对象结果序列化为 JSON",
+			expr: `$.meta.tags`,
+			want: &entity.Content{ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of(`["math"]`)},
+		},
+		{
+			name: "非文本字段透传",
+			expr: `coalesce($.missing, $.image)`,
+			want: image,
+		},
+		{
+			name: "缺失字段",
+			expr: `$.missing`,
+		},
+		{
+			name:    "语法错误",
+			expr:    `concat($.question`,
+			wantErr: true,
+		},
+		{
+			name:    "求值错误",
+			expr:    `parse_json($.question)`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&DefaultExptTurnEvaluationImpl{}).getFieldContent(&entity.FieldConf{FieldName: "input", Expr: tt.expr}, sourceFields)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDefaultExptTurnEvaluationImpl_getFieldContent_FromFieldNotExpr(t *testing.T) {
+	// FromField 以 "=" 开头的历史列名仍按列取值, 表达式只读 Expr
+	content := &entity.Content{ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("v")}
+	got, err := (&DefaultExptTurnEvaluationImpl{}).getFieldContent(&entity.FieldConf{FieldName: "input", FromField: "=col"}, map[string]*entity.Content{"=col": content})
+	assert.NoError(t, err)
+	assert.Equal(t, content, got)
+}
+
+func TestExptMangerImpl_checkEvaluatorsConnector_FieldExpr(t *testing.T) {
+	newExpt := func(evalSetFCs, targetFCs []*entity.FieldConf) *entity.Experiment {
+		return &entity.Experiment{
+			EvaluatorVersionRef: []*entity.ExptEvaluatorVersionRef{{EvaluatorVersionID: 123}},
+			Evaluators: []*entity.Evaluator{{
+				EvaluatorType: entity.EvaluatorTypePrompt,
+				PromptEvaluatorVersion: &entity.PromptEvaluatorVersion{
+					ID:           123,
+					InputSchemas: []*entity.ArgsSchema{{Key: gptr.Of("input")}, {Key: gptr.Of("output")}},
+				},
+			}},
+			Target: &entity.EvalTarget{
+				EvalTargetType: entity.EvalTargetTypeLoopPrompt,
+				EvalTargetVersion: &entity.EvalTargetVersion{
+					OutputSchema: []*entity.ArgsSchema{{Key: gptr.Of("actual_output")}},
+				},
+			},
+			EvalSet: &entity.EvaluationSet{
+				EvaluationSetVersion: &entity.EvaluationSetVersion{
+					EvaluationSetSchema: &entity.EvaluationSetSchema{
+						FieldSchemas: []*entity.FieldSchema{{Name: "question"}, {Name: "context"}},
+					},
+				},
+			},
+			EvalConf: &entity.EvaluationConfiguration{
+				ConnectorConf: entity.Connector{
+					EvaluatorsConf: &entity.EvaluatorsConf{
+						EvaluatorConf: []*entity.EvaluatorConf{{
+							EvaluatorVersionID: 123,
+							IngressConf: &entity.EvaluatorIngressConf{
+								EvalSetAdapter: &entity.FieldAdapter{FieldConfs: evalSetFCs},
+								TargetAdapter:  &entity.FieldAdapter{FieldConfs: targetFCs},
+							},
+						}},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		evalSetFCs []*entity.FieldConf
+		targetFCs  []*entity.FieldConf
+		wantErrMsg string
+	}{
+		{
+			name:       "合法表达式",
+			evalSetFCs: []*entity.FieldConf{{FieldName: "input", Expr: `template("{{ $.context.doc }}\n{{ $.question }}")`}},
+			targetFCs:  []*entity.FieldConf{{FieldName: "output", Expr: `trim($.actual_output)`}},
+		},
+		{
+			name:       "语法错误",
+			evalSetFCs: []*entity.FieldConf{{FieldName: "input", Expr: `upper($.question`}},
+			wantErrMsg: "invalid expression",
+		},
+		{
+			name:       "引用不存在的评测集列",
+			evalSetFCs: []*entity.FieldConf{{FieldName: "input", Expr: `$.question + $.answer`}},
+			wantErrMsg: "references missing evalset field answer",
+		},
+		{
+			name:       "引用不存在的评测对象输出",
+			targetFCs:  []*entity.FieldConf{{FieldName: "output", Expr: `$.reasoning`}},
+			wantErrMsg: "references missing target field reasoning",
+		},
+		{
+			name:       "评估器无该输入字段",
+			evalSetFCs: []*entity.FieldConf{{FieldName: "reference", Expr: `$.question`}},
+			wantErrMsg: "evaluator has no input field named reference",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&ExptMangerImpl{}).checkEvaluatorsConnector(context.Background(), newExpt(tt.evalSetFCs, tt.targetFCs), nil)
+			if tt.wantErrMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErrMsg)
+		})
+	}
+}
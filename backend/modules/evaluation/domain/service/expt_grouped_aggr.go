@@ -0,0 +1,17 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination ./mocks/expt_grouped_aggr.go --package mocks . ExptGroupedAggrService
+type ExptGroupedAggrService interface {
+	// GetGroupedAggrResult 按评测集字段或人工标注取值对一个或多个实验的评估器得分分组聚合,
+	// 基于 CK expt_turn_result_filter 计算, 用于定位模型在哪些切片上退化。
+	GetGroupedAggrResult(ctx context.Context, param *entity.ExptGroupedAggrParam) (*entity.ExptGroupedAggrResult, error)
+}
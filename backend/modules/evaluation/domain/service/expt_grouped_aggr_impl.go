@@ -0,0 +1,237 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/bytedance/gg/gslice"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+type ExptGroupedAggrServiceImpl struct {
+	experimentRepo           repo.IExperimentRepo
+	exptTurnResultFilterRepo repo.IExptTurnResultFilterRepo
+	evaluationSetService     IEvaluationSetService
+}
+
+func NewExptGroupedAggrService(
+	experimentRepo repo.IExperimentRepo,
+	exptTurnResultFilterRepo repo.IExptTurnResultFilterRepo,
+	evaluationSetService IEvaluationSetService,
+) ExptGroupedAggrService {
+	return &ExptGroupedAggrServiceImpl{
+		experimentRepo:           experimentRepo,
+		exptTurnResultFilterRepo: exptTurnResultFilterRepo,
+		evaluationSetService:     evaluationSetService,
+	}
+}
+
+// 人工标注在 expt_turn_result_filter 中按标签类型分别写入 annotation_string (分类/布尔/文本) 与 annotation_float (连续数值)
+var annotationGroupMapColumns = []string{"annotation_string", "annotation_float"}
+
+func (e *ExptGroupedAggrServiceImpl) GetGroupedAggrResult(ctx context.Context, param *entity.ExptGroupedAggrParam) (*entity.ExptGroupedAggrResult, error) {
+	if err := e.validateParam(param); err != nil {
+		return nil, err
+	}
+	maxGroups := param.MaxGroups
+	if maxGroups <= 0 {
+		maxGroups = entity.DefaultGroupedAggrMaxGroups
+	}
+
+	expts, err := e.experimentRepo.MGetByID(ctx, param.ExptIDs, param.SpaceID)
+	if err != nil {
+		return nil, err
+	}
+	exptMap := gslice.ToMap(expts, func(expt *entity.Experiment) (int64, *entity.Experiment) { return expt.ID, expt })
+
+	res := &entity.ExptGroupedAggrResult{Dimension: param.Dimension, ExptResults: make([]*entity.ExptGroupedAggr, 0, len(param.ExptIDs))}
+	for _, exptID := range param.ExptIDs {
+		expt, ok := exptMap[exptID]
+		if !ok {
+			return nil, errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("experiment %d not found", exptID)))
+		}
+		aggr, err := e.groupedAggrExpt(ctx, expt, param.Dimension, maxGroups)
+		if err != nil {
+			return nil, err
+		}
+		res.ExptResults = append(res.ExptResults, aggr)
+	}
+	return res, nil
+}
+
+func (e *ExptGroupedAggrServiceImpl) validateParam(param *entity.ExptGroupedAggrParam) error {
+	if param == nil || param.SpaceID <= 0 {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("space_id is required"))
+	}
+	if len(param.ExptIDs) == 0 || len(param.ExptIDs) > entity.MaxGroupedAggrExptCnt {
+		return errorx.NewByCode(errno.CommonInvalidParamCode,
+			errorx.WithExtraMsg(fmt.Sprintf("experiment count must be in [1, %d]", entity.MaxGroupedAggrExptCnt)))
+	}
+	seen := make(map[int64]bool, len(param.ExptIDs))
+	for _, id := range param.ExptIDs {
+		if id <= 0 || seen[id] {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("invalid or duplicated experiment id %d", id)))
+		}
+		seen[id] = true
+	}
+	if param.Dimension == nil || param.Dimension.Key == "" {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("group dimension key is required"))
+	}
+	switch param.Dimension.Type {
+	case entity.ExptGroupDimensionEvalSetField, entity.ExptGroupDimensionAnnotationTag:
+	default:
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("invalid group dimension type %d", param.Dimension.Type)))
+	}
+	return nil
+}
+
+// groupedAggrExpt 将分组维度翻译为该实验在 CK 侧的 map 列与 key 后查询; 不同实验的评估器/标签 key 映射各自独立
+func (e *ExptGroupedAggrServiceImpl) groupedAggrExpt(ctx context.Context, expt *entity.Experiment, dim *entity.ExptGroupDimension, maxGroups int) (*entity.ExptGroupedAggr, error) {
+	keyMappings, err := e.exptTurnResultFilterRepo.GetExptTurnResultFilterKeyMappings(ctx, expt.SpaceID, expt.ID)
+	if err != nil {
+		return nil, err
+	}
+	query := &entity.ExptTurnResultGroupAggrQuery{
+		SpaceID:      expt.SpaceID,
+		ExptID:       expt.ID,
+		CreatedDate:  expt.StartAt,
+		IsOnlineExpt: expt.ExptType == entity.ExptType_Online,
+	}
+	switch dim.Type {
+	case entity.ExptGroupDimensionEvalSetField:
+		mapping, err := e.getItemSnapshotFieldMapping(ctx, expt, dim.Key)
+		if err != nil {
+			return nil, err
+		}
+		query.GroupFromItemSnapshot = true
+		query.GroupMapColumns = []string{mapping.MappingKey}
+		query.GroupMapKey = mapping.MappingSubKey
+	case entity.ExptGroupDimensionAnnotationTag:
+		mapping, ok := gslice.Find(keyMappings, func(m *entity.ExptTurnResultFilterKeyMapping) bool {
+			return m.FieldType == entity.FieldTypeManualAnnotation && m.FromField == dim.Key
+		}).Get()
+		if !ok {
+			// 实验未挂载该标签, 无标注数据可分组
+			logs.CtxInfo(ctx, "annotation tag %s not found in experiment %d, skip grouped aggr", dim.Key, expt.ID)
+			return &entity.ExptGroupedAggr{ExptID: expt.ID, Groups: []*entity.ExptAggrGroup{}}, nil
+		}
+		query.GroupMapColumns = annotationGroupMapColumns
+		query.GroupMapKey = mapping.ToKey
+	}
+
+	rows, err := e.exptTurnResultFilterRepo.QueryGroupAggr(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return buildExptGroupedAggr(ctx, expt.ID, rows, keyMappings, maxGroups), nil
+}
+
+// getItemSnapshotFieldMapping 评测集字段在 CK item 快照表中的存储位置, 口径同 ExptResultServiceImpl.mapItemSnapshotFilter
+func (e *ExptGroupedAggrServiceImpl) getItemSnapshotFieldMapping(ctx context.Context, expt *entity.Experiment, fieldKey string) (*entity.ItemSnapshotFieldMapping, error) {
+	isOnline := expt.ExptType == entity.ExptType_Online
+	versionID := expt.EvalSetVersionID
+	if isOnline {
+		versionID = 0
+	}
+	mappings, _, err := e.evaluationSetService.QueryItemSnapshotMappings(ctx, &rpc.QueryItemSnapshotMappingRequest{
+		SpaceID:        resolveLoadSpaceID(expt.SpaceID, expt.EvalSetSpaceID),
+		DatasetID:      expt.EvalSetID,
+		IsDraftVersion: isOnline,
+		VersionID:      gptr.Of(versionID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	mapping, ok := gslice.Find(mappings, func(m *entity.ItemSnapshotFieldMapping) bool { return m.FieldKey == fieldKey }).Get()
+	if !ok {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode,
+			errorx.WithExtraMsg(fmt.Sprintf("eval set field %s of experiment %d is not available for grouping", fieldKey, expt.ID)))
+	}
+	switch mapping.MappingKey {
+	case "string_map", "float_map", "int_map", "bool_map":
+	default:
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode,
+			errorx.WithExtraMsg(fmt.Sprintf("eval set field %s stored as %s can not be used for grouping", fieldKey, mapping.MappingKey)))
+	}
+	return mapping, nil
+}
+
+// buildExptGroupedAggr 将 CK 聚合行按分组取值归并, ck 侧得分 key 经 key mapping 还原为评估器实例;
+// 分组按 turn 数降序、取值升序排列, 超过 maxGroups 时截断
+func buildExptGroupedAggr(ctx context.Context, exptID int64, rows []*entity.ExptTurnResultGroupAggrRow,
+	keyMappings []*entity.ExptTurnResultFilterKeyMapping, maxGroups int,
+) *entity.ExptGroupedAggr {
+	scoreKey2Instance := make(map[string]string, len(keyMappings))
+	for _, m := range keyMappings {
+		if m.FieldType == entity.FieldTypeEvaluator {
+			scoreKey2Instance[m.ToKey] = m.FromField
+		}
+	}
+
+	groups := make(map[string]*entity.ExptAggrGroup)
+	for _, row := range rows {
+		group, ok := groups[row.GroupValue]
+		if !ok {
+			group = &entity.ExptAggrGroup{Value: row.GroupValue, EvaluatorResults: []*entity.ExptGroupEvaluatorAggr{}}
+			groups[row.GroupValue] = group
+		}
+		if row.ScoreKey == "" {
+			group.TurnCount = row.Count
+			continue
+		}
+		instanceKey, ok := scoreKey2Instance[row.ScoreKey]
+		if !ok {
+			continue
+		}
+		versionID, alias, err := entity.ParseEvaluatorScoreFieldKey(instanceKey)
+		if err != nil {
+			logs.CtxWarn(ctx, "parse evaluator instance key %s of experiment %d fail: %v", instanceKey, exptID, err)
+			continue
+		}
+		avg, maxScore, minScore := row.Avg, row.Max, row.Min
+		group.EvaluatorResults = append(group.EvaluatorResults, &entity.ExptGroupEvaluatorAggr{
+			EvaluatorVersionID: versionID,
+			Alias:              alias,
+			Count:              row.Count,
+			AggregatorResults: []*entity.AggregatorResult{
+				{AggregatorType: entity.Average, Data: &entity.AggregateData{DataType: entity.Double, Value: &avg}},
+				{AggregatorType: entity.Max, Data: &entity.AggregateData{DataType: entity.Double, Value: &maxScore}},
+				{AggregatorType: entity.Min, Data: &entity.AggregateData{DataType: entity.Double, Value: &minScore}},
+			},
+		})
+	}
+
+	res := &entity.ExptGroupedAggr{ExptID: exptID, Groups: make([]*entity.ExptAggrGroup, 0, len(groups))}
+	for _, group := range groups {
+		sort.Slice(group.EvaluatorResults, func(i, j int) bool {
+			l, r := group.EvaluatorResults[i], group.EvaluatorResults[j]
+			if l.EvaluatorVersionID != r.EvaluatorVersionID {
+				return l.EvaluatorVersionID < r.EvaluatorVersionID
+			}
+			return l.Alias < r.Alias
+		})
+		res.Groups = append(res.Groups, group)
+	}
+	sort.Slice(res.Groups, func(i, j int) bool {
+		if res.Groups[i].TurnCount != res.Groups[j].TurnCount {
+			return res.Groups[i].TurnCount > res.Groups[j].TurnCount
+		}
+		return res.Groups[i].Value < res.Groups[j].Value
+	})
+	if len(res.Groups) > maxGroups {
+		res.Groups = res.Groups[:maxGroups]
+		res.Truncated = true
+	}
+	return res
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repoMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+func TestExptGroupedAggrServiceImpl_GetGroupedAggrResult(t *testing.T) {
+	const spaceID = int64(100)
+	startAt := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	keyMappings := func(exptID int64) []*entity.ExptTurnResultFilterKeyMapping {
+		return []*entity.ExptTurnResultFilterKeyMapping{
+			{SpaceID: spaceID, ExptID: exptID, FromField: "11", ToKey: "key1", FieldType: entity.FieldTypeEvaluator},
+			{SpaceID: spaceID, ExptID: exptID, FromField: "12:judge_b", ToKey: "key2", FieldType: entity.FieldTypeEvaluator},
+			{SpaceID: spaceID, ExptID: exptID, FromField: "7001", ToKey: "key1", FieldType: entity.FieldTypeManualAnnotation},
+		}
+	}
+
+	t.Run("按评测集字段分组多实验", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		exptRepo := repoMocks.NewMockIExperimentRepo(ctrl)
+		filterRepo := repoMocks.NewMockIExptTurnResultFilterRepo(ctrl)
+		evalSetSvc := svcMocks.NewMockIEvaluationSetService(ctrl)
+		svc := NewExptGroupedAggrService(exptRepo, filterRepo, evalSetSvc)
+
+		exptRepo.EXPECT().MGetByID(gomock.Any(), []int64{2, 1}, spaceID).Return([]*entity.Experiment{
+			{ID: 1, SpaceID: spaceID, EvalSetID: 9, EvalSetVersionID: 91, StartAt: &startAt},
+			{ID: 2, SpaceID: spaceID, EvalSetID: 9, EvalSetVersionID: 92, EvalSetSpaceID: 300, StartAt: &startAt},
+		}, nil)
+		evalSetSvc.EXPECT().QueryItemSnapshotMappings(gomock.Any(), &rpc.QueryItemSnapshotMappingRequest{
+			SpaceID: 300, DatasetID: 9, VersionID: gptr.Of(int64(92)),
+		}).Return([]*entity.ItemSnapshotFieldMapping{{FieldKey: "lang", MappingKey: "string_map", MappingSubKey: "key3"}}, "2025-06-01", nil)
+		evalSetSvc.EXPECT().QueryItemSnapshotMappings(gomock.Any(), &rpc.QueryItemSnapshotMappingRequest{
+			SpaceID: spaceID, DatasetID: 9, VersionID: gptr.Of(int64(91)),
+		}).Return([]*entity.ItemSnapshotFieldMapping{{FieldKey: "lang", MappingKey: "string_map", MappingSubKey: "key1"}}, "2025-06-01", nil)
+		for _, exptID := range []int64{1, 2} {
+			filterRepo.EXPECT().GetExptTurnResultFilterKeyMappings(gomock.Any(), spaceID, exptID).Return(keyMappings(exptID), nil)
+		}
+		filterRepo.EXPECT().QueryGroupAggr(gomock.Any(), &entity.ExptTurnResultGroupAggrQuery{
+			SpaceID: spaceID, ExptID: 2, CreatedDate: &startAt, GroupFromItemSnapshot: true,
+			GroupMapColumns: []string{"string_map"}, GroupMapKey: "key3",
+		}).Return([]*entity.ExptTurnResultGroupAggrRow{
+			{GroupValue: "en", Count: 3},
+			{GroupValue: "en", ScoreKey: "key1", Count: 3, Avg: 0.5, Min: 0, Max: 1},
+		}, nil)
+		filterRepo.EXPECT().QueryGroupAggr(gomock.Any(), &entity.ExptTurnResultGroupAggrQuery{
+			SpaceID: spaceID, ExptID: 1, CreatedDate: &startAt, GroupFromItemSnapshot: true,
+			GroupMapColumns: []string{"string_map"}, GroupMapKey: "key1",
+		}).Return([]*entity.ExptTurnResultGroupAggrRow{
+			{GroupValue: "zh", Count: 2},
+			{GroupValue: "en", Count: 5},
+			{GroupValue: "", Count: 1},
+			{GroupValue: "zh", ScoreKey: "key2", Count: 2, Avg: 0.8, Min: 0.6, Max: 1},
+			{GroupValue: "zh", ScoreKey: "key1", Count: 1, Avg: 1, Min: 1, Max: 1},
+			{GroupValue: "en", ScoreKey: "key9", Count: 5, Avg: 1, Min: 1, Max: 1},
+		}, nil)
+
+		res, err := svc.GetGroupedAggrResult(context.Background(), &entity.ExptGroupedAggrParam{
+			SpaceID:   spaceID,
+			ExptIDs:   []int64{2, 1},
+			Dimension: &entity.ExptGroupDimension{Type: entity.ExptGroupDimensionEvalSetField, Key: "lang"},
+			MaxGroups: 2,
+		})
+		assert.NoError(t, err)
+		if !assert.Len(t, res.ExptResults, 2) {
+			return
+		}
+		assert.Equal(t, int64(2), res.ExptResults[0].ExptID)
+		assert.False(t, res.ExptResults[0].Truncated)
+
+		expt1 := res.ExptResults[1]
+		assert.Equal(t, int64(1), expt1.ExptID)
+		assert.True(t, expt1.Truncated)
+		if !assert.Len(t, expt1.Groups, 2) {
+			return
+		}
+		en, zh := expt1.Groups[0], expt1.Groups[1]
+		assert.Equal(t, "en", en.Value)
+		assert.Equal(t, int64(5), en.TurnCount)
+		assert.Empty(t, en.EvaluatorResults, "未映射的得分 key 应忽略")
+
+		assert.Equal(t, "zh", zh.Value)
+		assert.Equal(t, int64(2), zh.TurnCount)
+		if assert.Len(t, zh.EvaluatorResults, 2) {
+			assert.Equal(t, int64(11), zh.EvaluatorResults[0].EvaluatorVersionID)
+			assert.Equal(t, int64(12), zh.EvaluatorResults[1].EvaluatorVersionID)
+			assert.Equal(t, "judge_b", zh.EvaluatorResults[1].Alias)
+			assert.Equal(t, int64(2), zh.EvaluatorResults[1].Count)
+			assert.Equal(t, entity.Average, zh.EvaluatorResults[1].AggregatorResults[0].AggregatorType)
+			assert.Equal(t, 0.8, *zh.EvaluatorResults[1].AggregatorResults[0].Data.Value)
+		}
+	})
+
+	t.Run("按人工标注分组, 未挂载标签的实验返回空分组", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		exptRepo := repoMocks.NewMockIExperimentRepo(ctrl)
+		filterRepo := repoMocks.NewMockIExptTurnResultFilterRepo(ctrl)
+		svc := NewExptGroupedAggrService(exptRepo, filterRepo, svcMocks.NewMockIEvaluationSetService(ctrl))
+
+		exptRepo.EXPECT().MGetByID(gomock.Any(), []int64{1, 2}, spaceID).Return([]*entity.Experiment{
+			{ID: 1, SpaceID: spaceID, ExptType: entity.ExptType_Online},
+			{ID: 2, SpaceID: spaceID},
+		}, nil)
+		filterRepo.EXPECT().GetExptTurnResultFilterKeyMappings(gomock.Any(), spaceID, int64(1)).Return(keyMappings(1), nil)
+		filterRepo.EXPECT().GetExptTurnResultFilterKeyMappings(gomock.Any(), spaceID, int64(2)).Return(nil, nil)
+		filterRepo.EXPECT().QueryGroupAggr(gomock.Any(), &entity.ExptTurnResultGroupAggrQuery{
+			SpaceID: spaceID, ExptID: 1, IsOnlineExpt: true,
+			GroupMapColumns: []string{"annotation_string", "annotation_float"}, GroupMapKey: "key1",
+		}).Return([]*entity.ExptTurnResultGroupAggrRow{{GroupValue: "8001", Count: 4}}, nil)
+
+		res, err := svc.GetGroupedAggrResult(context.Background(), &entity.ExptGroupedAggrParam{
+			SpaceID:   spaceID,
+			ExptIDs:   []int64{1, 2},
+			Dimension: &entity.ExptGroupDimension{Type: entity.ExptGroupDimensionAnnotationTag, Key: "7001"},
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, res.ExptResults, 2) {
+			assert.Equal(t, []*entity.ExptAggrGroup{{Value: "8001", TurnCount: 4, EvaluatorResults: []*entity.ExptGroupEvaluatorAggr{}}}, res.ExptResults[0].Groups)
+			assert.Empty(t, res.ExptResults[1].Groups)
+		}
+	})
+
+	t.Run("评测集字段不可分组", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		exptRepo := repoMocks.NewMockIExperimentRepo(ctrl)
+		filterRepo := repoMocks.NewMockIExptTurnResultFilterRepo(ctrl)
+		evalSetSvc := svcMocks.NewMockIEvaluationSetService(ctrl)
+		svc := NewExptGroupedAggrService(exptRepo, filterRepo, evalSetSvc)
+
+		exptRepo.EXPECT().MGetByID(gomock.Any(), gomock.Any(), spaceID).Return([]*entity.Experiment{{ID: 1, SpaceID: spaceID, EvalSetID: 9}}, nil).Times(2)
+		filterRepo.EXPECT().GetExptTurnResultFilterKeyMappings(gomock.Any(), spaceID, int64(1)).Return(nil, nil).Times(2)
+		evalSetSvc.EXPECT().QueryItemSnapshotMappings(gomock.Any(), gomock.Any()).
+			Return([]*entity.ItemSnapshotFieldMapping{{FieldKey: "tags", MappingKey: "tag_array"}}, "", nil).Times(2)
+
+		param := &entity.ExptGroupedAggrParam{SpaceID: spaceID, ExptIDs: []int64{1}, Dimension: &entity.ExptGroupDimension{Type: entity.ExptGroupDimensionEvalSetField, Key: "lang"}}
+		_, err := svc.GetGroupedAggrResult(context.Background(), param)
+		assert.ErrorContains(t, err, "eval set field lang of experiment 1 is not available for grouping")
+
+		param.Dimension.Key = "tags"
+		_, err = svc.GetGroupedAggrResult(context.Background(), param)
+		assert.ErrorContains(t, err, "stored as tag_array can not be used for grouping")
+	})
+
+	t.Run("参数校验", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc := NewExptGroupedAggrService(repoMocks.NewMockIExperimentRepo(ctrl), repoMocks.NewMockIExptTurnResultFilterRepo(ctrl), svcMocks.NewMockIEvaluationSetService(ctrl))
+		dim := &entity.ExptGroupDimension{Type: entity.ExptGroupDimensionEvalSetField, Key: "lang"}
+		for _, param := range []*entity.ExptGroupedAggrParam{
+			nil,
+			{SpaceID: spaceID, Dimension: dim},
+			{SpaceID: spaceID, ExptIDs: []int64{1, 1}, Dimension: dim},
+			{SpaceID: spaceID, ExptIDs: []int64{1}},
+			{SpaceID: spaceID, ExptIDs: []int64{1}, Dimension: &entity.ExptGroupDimension{Type: 9, Key: "lang"}},
+		} {
+			_, err := svc.GetGroupedAggrResult(context.Background(), param)
+			assert.Error(t, err)
+		}
+	})
+}
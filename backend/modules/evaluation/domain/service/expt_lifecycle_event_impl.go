@@ -6,6 +6,7 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
@@ -18,14 +19,20 @@ type ExptLifecycleEventHandlerImpl struct {
 	notifyRPCAdapter  rpc.INotifyRPCAdapter
 	userProvider      rpc.IUserProvider
 	webhookDispatcher IWebhookDispatcher
+	// 实验终态事件消费时评估质量门禁, 结论随 webhook 下发
+	qualityGateService ExptQualityGateService
+	// 读取本次运行的开始时间, 判断门禁结论是否属于本次运行
+	runLogRepo repo.IExptRunLogRepo
 }
 
-func NewExptLifecycleEventHandler(exptRepo repo.IExperimentRepo, notifyRPCAdapter rpc.INotifyRPCAdapter, userProvider rpc.IUserProvider, webhookDispatcher IWebhookDispatcher) ExptLifecycleEventHandler {
+func NewExptLifecycleEventHandler(exptRepo repo.IExperimentRepo, notifyRPCAdapter rpc.INotifyRPCAdapter, userProvider rpc.IUserProvider, webhookDispatcher IWebhookDispatcher, qualityGateService ExptQualityGateService, runLogRepo repo.IExptRunLogRepo) ExptLifecycleEventHandler {
 	return &ExptLifecycleEventHandlerImpl{
-		exptRepo:          exptRepo,
-		notifyRPCAdapter:  notifyRPCAdapter,
-		userProvider:      userProvider,
-		webhookDispatcher: webhookDispatcher,
+		exptRepo:           exptRepo,
+		runLogRepo:         runLogRepo,
+		notifyRPCAdapter:   notifyRPCAdapter,
+		userProvider:       userProvider,
+		webhookDispatcher:  webhookDispatcher,
+		qualityGateService: qualityGateService,
 	}
 }
 
@@ -43,11 +50,15 @@ func (h *ExptLifecycleEventHandlerImpl) HandleLifecycleEvent(ctx context.Context
 	// (domain/service/expt_manage_execution_impl.go) 里, 走同步路径以避免 rocket MQ
 	// consumer group 竞争导致灰度实例采集不到。此处仅保留飞书通知 + Webhook 分发。
 
+	// 质量门禁在异步消费终态事件时评估, 不阻塞 CompleteExpt; 先于通知落库, webhook 即可带出结论
+	runStartedAt := h.runStartedAt(ctx, event, expt)
+	h.evaluateQualityGate(ctx, event, expt, runStartedAt)
+
 	// Feishu notification
 	h.handleFeishuNotification(ctx, event, expt)
 
 	// Webhook dispatch
-	h.dispatchWebhook(ctx, event, expt)
+	h.dispatchWebhook(ctx, event, h.withCurrentRunQualityGate(ctx, expt, runStartedAt))
 
 	return nil
 }
@@ -121,6 +132,62 @@ func (h *ExptLifecycleEventHandlerImpl) handleFeishuNotification(ctx context.Con
 	}
 }
 
+// evaluateQualityGate 实验进入终态时评估质量门禁并落库; 本次运行已评估过 (事件重投) 则跳过。
+// 评估或落库失败时本次通知带出 error 结论, 不回落到上次运行的结论
+func (h *ExptLifecycleEventHandlerImpl) evaluateQualityGate(ctx context.Context, event *entity.ExptLifecycleEvent, expt *entity.Experiment, runStartedAt int64) {
+	if h.qualityGateService == nil || !entity.IsExptFinished(event.ToStatus) {
+		return
+	}
+	if expt.EvalConf == nil || expt.EvalConf.QualityGateConf.IsEmpty() {
+		return
+	}
+	if expt.QualityGateResult.IsEvaluatedAfter(runStartedAt) {
+		logs.CtxInfo(ctx, "quality_gate: already evaluated for current run, expt_id: %d, status: %v", expt.ID, expt.QualityGateResult.Status)
+		return
+	}
+	if _, err := h.qualityGateService.EvaluateAndSave(ctx, expt); err != nil {
+		logs.CtxError(ctx, "quality_gate: evaluate fail, expt_id: %d, err: %v", expt.ID, err)
+		if !expt.QualityGateResult.IsEvaluatedAfter(runStartedAt) {
+			expt.QualityGateResult = entity.NewExptQualityGateErrorResult(err.Error(), time.Now().UnixMilli())
+		}
+	}
+}
+
+// runStartedAt 本次运行的开始时间 (毫秒), 取自事件所属运行的 run log; 未配置门禁且无结论时不查询, 查不到返回 0
+func (h *ExptLifecycleEventHandlerImpl) runStartedAt(ctx context.Context, event *entity.ExptLifecycleEvent, expt *entity.Experiment) int64 {
+	if h.runLogRepo == nil {
+		return 0
+	}
+	if expt.QualityGateResult == nil && (expt.EvalConf == nil || expt.EvalConf.QualityGateConf.IsEmpty()) {
+		return 0
+	}
+	runID := expt.LatestRunID
+	if event.ExptRunID != nil && *event.ExptRunID > 0 {
+		runID = *event.ExptRunID
+	}
+	if runID <= 0 {
+		return 0
+	}
+	rl, err := h.runLogRepo.Get(ctx, expt.ID, runID)
+	if err != nil || rl == nil {
+		logs.CtxWarn(ctx, "quality_gate: get run log fail, expt_id: %d, run_id: %d, err: %v", expt.ID, runID, err)
+		return 0
+	}
+	return rl.CreatedAt.UnixMilli()
+}
+
+// withCurrentRunQualityGate 门禁结论早于本次运行开始 (上次运行残留) 时不随 webhook 下发
+func (h *ExptLifecycleEventHandlerImpl) withCurrentRunQualityGate(ctx context.Context, expt *entity.Experiment, runStartedAt int64) *entity.Experiment {
+	if expt.QualityGateResult == nil || expt.QualityGateResult.IsEvaluatedAfter(runStartedAt) {
+		return expt
+	}
+	logs.CtxWarn(ctx, "quality_gate: drop stale result, expt_id: %d, evaluated_at: %d, run_started_at: %d",
+		expt.ID, expt.QualityGateResult.EvaluatedAt, runStartedAt)
+	cp := *expt
+	cp.QualityGateResult = nil
+	return &cp
+}
+
 func (h *ExptLifecycleEventHandlerImpl) dispatchWebhook(ctx context.Context, event *entity.ExptLifecycleEvent, expt *entity.Experiment) {
 	if h.webhookDispatcher == nil {
 		logs.CtxInfo(ctx, "webhook_dispatcher: not configured, skip dispatch, expt_id: %d", event.ExptID)
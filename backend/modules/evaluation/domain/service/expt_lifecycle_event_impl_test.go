@@ -16,6 +16,7 @@ import (
 	rpcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
 	repoMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
 )
 
 type testLifecycleEventMocks struct {
@@ -50,7 +51,7 @@ func TestNewExptLifecycleEventHandler(t *testing.T) {
 	mockNotifyRPCAdapter := rpcMocks.NewMockINotifyRPCAdapter(ctrl)
 	mockUserProvider := rpcMocks.NewMockIUserProvider(ctrl)
 
-	handler := NewExptLifecycleEventHandler(mockExptRepo, mockNotifyRPCAdapter, mockUserProvider, nil)
+	handler := NewExptLifecycleEventHandler(mockExptRepo, mockNotifyRPCAdapter, mockUserProvider, nil, nil, nil)
 	assert.NotNil(t, handler)
 
 	impl, ok := handler.(*ExptLifecycleEventHandlerImpl)
@@ -229,6 +230,101 @@ func TestHandleLifecycleEvent(t *testing.T) {
 	})
 }
 
+func TestEvaluateQualityGate(t *testing.T) {
+	ctx := context.Background()
+	endAt := time.Now()
+	gateConf := &entity.EvaluationConfiguration{QualityGateConf: &entity.ExptQualityGateConf{
+		Rules: []*entity.ExptQualityGateRule{{Metric: entity.QualityGateMetricFailRate, Operator: entity.QualityGateOperatorLT, Threshold: 0.02}},
+	}}
+
+	t.Run("终态且配置门禁时评估, 失败不影响后续分发", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		handler, mocks := newTestLifecycleEventHandler(ctrl)
+		gateSvc := svcMocks.NewMockExptQualityGateService(ctrl)
+		handler.qualityGateService = gateSvc
+
+		expt := &entity.Experiment{ID: 1, SpaceID: 100, EndAt: &endAt, EvalConf: gateConf, NotificationConf: &entity.ExptNotificationConf{}}
+		mocks.exptRepo.EXPECT().GetByID(ctx, int64(1), int64(100)).Return(expt, nil)
+		gateSvc.EXPECT().EvaluateAndSave(ctx, expt).Return(nil, errors.New("db error"))
+
+		err := handler.HandleLifecycleEvent(ctx, &entity.ExptLifecycleEvent{ExptID: 1, SpaceID: 100, ToStatus: entity.ExptStatus_Success})
+		assert.NoError(t, err)
+	})
+
+	t.Run("本次运行已评估或非终态时跳过", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		handler, _ := newTestLifecycleEventHandler(ctrl)
+		handler.qualityGateService = svcMocks.NewMockExptQualityGateService(ctrl)
+
+		runStartedAt := endAt.Add(-time.Minute).UnixMilli()
+		evaluated := &entity.Experiment{
+			ID: 1, EndAt: &endAt, EvalConf: gateConf,
+			QualityGateResult: &entity.ExptQualityGateResult{Status: entity.QualityGateStatusPassed, EvaluatedAt: endAt.UnixMilli() + 1},
+		}
+		handler.evaluateQualityGate(ctx, &entity.ExptLifecycleEvent{ToStatus: entity.ExptStatus_Success}, evaluated, runStartedAt)
+		handler.evaluateQualityGate(ctx, &entity.ExptLifecycleEvent{ToStatus: entity.ExptStatus_Processing}, &entity.Experiment{ID: 1, EvalConf: gateConf}, runStartedAt)
+		handler.evaluateQualityGate(ctx, &entity.ExptLifecycleEvent{ToStatus: entity.ExptStatus_Success}, &entity.Experiment{ID: 1}, runStartedAt)
+	})
+
+	t.Run("评估失败时下发 error 结论而非上次运行的结论", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		handler, mocks := newTestLifecycleEventHandler(ctrl)
+		gateSvc := svcMocks.NewMockExptQualityGateService(ctrl)
+		runLogRepo := repoMocks.NewMockIExptRunLogRepo(ctrl)
+		dispatcher := &capturingWebhookDispatcher{}
+		handler.qualityGateService = gateSvc
+		handler.runLogRepo = runLogRepo
+		handler.webhookDispatcher = dispatcher
+
+		runStartedAt := endAt.Add(-time.Minute)
+		expt := &entity.Experiment{
+			ID: 1, SpaceID: 100, LatestRunID: 9, EndAt: &endAt, EvalConf: gateConf, NotificationConf: &entity.ExptNotificationConf{},
+			QualityGateResult: &entity.ExptQualityGateResult{Status: entity.QualityGateStatusPassed, Passed: true, EvaluatedAt: runStartedAt.Add(-time.Hour).UnixMilli()},
+		}
+		mocks.exptRepo.EXPECT().GetByID(ctx, int64(1), int64(100)).Return(expt, nil)
+		runLogRepo.EXPECT().Get(ctx, int64(1), int64(9)).Return(&entity.ExptRunLog{CreatedAt: runStartedAt}, nil)
+		gateSvc.EXPECT().EvaluateAndSave(ctx, expt).Return(nil, errors.New("db error"))
+
+		err := handler.HandleLifecycleEvent(ctx, &entity.ExptLifecycleEvent{ExptID: 1, ExptRunID: gptr.Of(int64(9)), SpaceID: 100, ToStatus: entity.ExptStatus_Success})
+		assert.NoError(t, err)
+		assert.NotNil(t, dispatcher.expt.QualityGateResult)
+		assert.Equal(t, entity.QualityGateStatusError, dispatcher.expt.QualityGateResult.Status)
+		assert.False(t, dispatcher.expt.QualityGateResult.Passed)
+	})
+}
+
+// capturingWebhookDispatcher 记录下发时的实验快照
+type capturingWebhookDispatcher struct {
+	expt *entity.Experiment
+}
+
+func (c *capturingWebhookDispatcher) Dispatch(_ context.Context, _ *entity.ExptLifecycleEvent, expt *entity.Experiment) error {
+	c.expt = expt
+	return nil
+}
+
+func TestWithCurrentRunQualityGate(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	handler, _ := newTestLifecycleEventHandler(ctrl)
+
+	runStartedAt := time.Now().Add(-time.Minute).UnixMilli()
+	stale := &entity.Experiment{ID: 1, QualityGateResult: &entity.ExptQualityGateResult{Status: entity.QualityGateStatusPassed, EvaluatedAt: runStartedAt - 1}}
+	got := handler.withCurrentRunQualityGate(ctx, stale, runStartedAt)
+	assert.Nil(t, got.QualityGateResult)
+	assert.NotNil(t, stale.QualityGateResult, "stored experiment is not mutated")
+
+	// 运行开始时间未知时不下发, 宁缺毋滥
+	assert.Nil(t, handler.withCurrentRunQualityGate(ctx, stale, 0).QualityGateResult)
+
+	fresh := &entity.Experiment{ID: 1, QualityGateResult: &entity.ExptQualityGateResult{Status: entity.QualityGateStatusFailed, EvaluatedAt: runStartedAt + 1}}
+	assert.Same(t, fresh, handler.withCurrentRunQualityGate(ctx, fresh, runStartedAt))
+}
+
 func TestSendNotifyCard(t *testing.T) {
 	ctx := context.Background()
 
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/bytedance/gg/gmap"
 	"github.com/bytedance/gg/gptr"
 	"github.com/bytedance/gg/gslice"
 	"github.com/samber/lo"
@@ -152,7 +153,18 @@ func (e *ExptMangerImpl) checkTargetConnector(ctx context.Context, expt *entity.
 	}
 
 	evalSetFieldSchema := gslice.ToMap(expt.EvalSet.EvaluationSetVersion.EvaluationSetSchema.FieldSchemas, func(t *entity.FieldSchema) (string, *entity.FieldSchema) { return t.Name, t })
+	evalSetFields := gmap.Map(evalSetFieldSchema, func(k string, _ *entity.FieldSchema) (string, bool) { return k, true })
+	var targetInputFields map[string]bool
+	if expt.Target.EvalTargetVersion != nil {
+		targetInputFields = argsSchemaKeys(expt.Target.EvalTargetVersion.InputSchema)
+	}
 	for _, fc := range connectorConf.TargetConf.IngressConf.EvalSetAdapter.FieldConfs {
+		if fc.IsExpr() {
+			if err := validateFieldExpr(fc, "evalset", evalSetFields, "target", targetInputFields); err != nil {
+				return errorx.WrapByCode(err, errno.ExperimentValidateFailCode, errorx.WithExtraMsg(fmt.Sprintf("invalid connector: target %v", err)))
+			}
+			continue
+		}
 		firstField, err := json.GetFirstJSONPathField(fc.FromField)
 		if err != nil {
 			return errorx.WrapByCode(err, errno.ExperimentValidateFailCode, errorx.WithExtraMsg(fmt.Sprintf("invalid connector: target is expected to receive the missing evalset %v column, json parse error", fc.FromField)))
@@ -204,6 +216,13 @@ func (e *ExptMangerImpl) checkEvaluatorsConnector(ctx context.Context, expt *ent
 		return nil
 	}
 
+	// 成对评估器需同时读取两个实验的输出, 仅用于实验两两对比排名 (SubmitExptPairwiseRank), 不能挂在实验上逐条运行
+	for _, ev := range expt.Evaluators {
+		if ev != nil && ev.EvaluatorType == entity.EvaluatorTypePairwise {
+			return errorx.NewByCode(errno.ExperimentValidateFailCode, errorx.WithExtraMsg(fmt.Sprintf("pairwise evaluator %d is only supported in pairwise ranking", ev.GetEvaluatorVersionID())))
+		}
+	}
+
 	connectorConf := expt.EvalConf.ConnectorConf
 	if err := connectorConf.EvaluatorsConf.Valid(ctx); err != nil {
 		return errorx.WrapByCode(err, errno.ExperimentValidateFailCode, errorx.WithExtraMsg("invalid evaluator connector"))
@@ -230,8 +249,23 @@ func (e *ExptMangerImpl) checkEvaluatorsConnector(ctx context.Context, expt *ent
 	})
 
 	evalSetFieldSchema := gslice.ToMap(expt.EvalSet.EvaluationSetVersion.EvaluationSetSchema.FieldSchemas, func(t *entity.FieldSchema) (string, *entity.FieldSchema) { return t.Name, t })
+	evalSetFields := gmap.Map(evalSetFieldSchema, func(k string, _ *entity.FieldSchema) (string, bool) { return k, true })
+	targetOutputFields := gmap.Map(targetOutputSchema, func(k string, _ *entity.ArgsSchema) (string, bool) { return k, true })
+	evaluatorInputFields := make(map[int64]map[string]bool, len(expt.Evaluators))
+	for _, ev := range expt.Evaluators {
+		if ev != nil {
+			evaluatorInputFields[ev.GetEvaluatorVersionID()] = argsSchemaKeys(ev.GetInputSchemas())
+		}
+	}
 	for _, evaluatorConf := range connectorConf.EvaluatorsConf.EvaluatorConf {
+		inputFields := evaluatorInputFields[evaluatorConf.EvaluatorVersionID]
 		for _, fc := range evaluatorConf.IngressConf.EvalSetAdapter.FieldConfs {
+			if fc.IsExpr() {
+				if err := validateFieldExpr(fc, "evalset", evalSetFields, "evaluator", inputFields); err != nil {
+					return errorx.WrapByCode(err, errno.ExperimentValidateFailCode, errorx.WithExtraMsg(fmt.Sprintf("invalid connector: evaluator %v %v", evaluatorConf.EvaluatorVersionID, err)))
+				}
+				continue
+			}
 			firstField, err := json.GetFirstJSONPathField(fc.FromField)
 			if err != nil {
 				return errorx.WrapByCode(err, errno.ExperimentValidateFailCode, errorx.WithExtraMsg(fmt.Sprintf("invalid connector: evaluator %v is expected to receive the missing evalset %v column, json parse error", evaluatorConf.EvaluatorVersionID, fc.FromField)))
@@ -243,6 +277,12 @@ func (e *ExptMangerImpl) checkEvaluatorsConnector(ctx context.Context, expt *ent
 		// Trace 和仅记录型不需要 target 输出，跳过 target 字段校验
 		if expt.Target != nil && expt.Target.EvalTargetType != entity.EvalTargetTypeLoopTrace && !expt.Target.EvalTargetType.IsRecordOnlyType() {
 			for _, fc := range evaluatorConf.IngressConf.TargetAdapter.FieldConfs {
+				if fc.IsExpr() {
+					if err := validateFieldExpr(fc, "target", targetOutputFields, "evaluator", inputFields); err != nil {
+						return errorx.WrapByCode(err, errno.ExperimentValidateFailCode, errorx.WithExtraMsg(fmt.Sprintf("invalid connector: evaluator %v %v", evaluatorConf.EvaluatorVersionID, err)))
+					}
+					continue
+				}
 				firstField, err := json.GetFirstJSONPathField(fc.FromField)
 				if err != nil {
 					return errorx.WrapByCode(err, errno.ExperimentValidateFailCode, errorx.WithExtraMsg(fmt.Sprintf("invalid connector: evaluator %v is expected to receive the missing target %v column, json parse error", evaluatorConf.EvaluatorVersionID, fc.FromField)))
@@ -1210,10 +1250,7 @@ func (e *ExptMangerImpl) LogRun(ctx context.Context, exptID, exptRunID int64, mo
 		return err
 	}
 
-	if err := e.exptRepo.Update(ctx, &entity.Experiment{
-		ID:          exptID,
-		LatestRunID: exptRunID,
-	}); err != nil {
+	if err := e.exptRepo.UpdateFields(ctx, exptID, newRunExptFields(exptRunID)); err != nil {
 		return err
 	}
 
@@ -1283,7 +1320,7 @@ func (e *ExptMangerImpl) LogRetryItemsRun(ctx context.Context, exptID int64, mod
 	}
 
 	if !retried {
-		if err := e.exptRepo.Update(ctx, &entity.Experiment{ID: exptID, LatestRunID: runID}); err != nil {
+		if err := e.exptRepo.UpdateFields(ctx, exptID, newRunExptFields(runID)); err != nil {
 			return 0, false, err
 		}
 		e.mtr.EmitExptExecRun(spaceID, int64(mode))
@@ -1292,6 +1329,14 @@ func (e *ExptMangerImpl) LogRetryItemsRun(ctx context.Context, exptID int64, mod
 	return runID, !locked, nil
 }
 
+// newRunExptFields 新一次运行开始时更新 latest_run_id, 并清空上次运行的质量门禁结论, 避免终态通知带出过期结论
+func newRunExptFields(exptRunID int64) map[string]any {
+	return map[string]any{
+		"latest_run_id":       exptRunID,
+		"quality_gate_result": nil,
+	}
+}
+
 func (e *ExptMangerImpl) GetRunLog(ctx context.Context, exptID, exptRunID, spaceID int64, session *entity.Session) (*entity.ExptRunLog, error) {
 	return e.runLogRepo.Get(ctx, exptID, exptRunID)
 }
@@ -579,10 +579,13 @@ func TestExptMangerImpl_LogRun(t *testing.T) {
 
 				mgr.exptRepo.(*repoMocks.MockIExperimentRepo).
 					EXPECT().
-					Update(ctx, gomock.Any()).
-					Do(func(ctx context.Context, expt *entity.Experiment) {
-						assert.Equal(t, int64(123), expt.ID)
-						assert.Equal(t, int64(456), expt.LatestRunID)
+					UpdateFields(ctx, gomock.Any(), gomock.Any()).
+					Do(func(ctx context.Context, exptID int64, ufields map[string]any) {
+						assert.Equal(t, int64(123), exptID)
+						assert.Equal(t, int64(456), ufields["latest_run_id"])
+						v, ok := ufields["quality_gate_result"]
+						assert.True(t, ok)
+						assert.Nil(t, v)
 					}).
 					Return(nil)
 			},
@@ -654,7 +657,7 @@ func TestExptMangerImpl_LogRun(t *testing.T) {
 
 				mgr.exptRepo.(*repoMocks.MockIExperimentRepo).
 					EXPECT().
-					Update(ctx, gomock.Any()).
+					UpdateFields(ctx, gomock.Any(), gomock.Any()).
 					Return(nil)
 			},
 			wantErr: false,
@@ -712,7 +715,7 @@ func TestExptMangerImpl_LogRetryItemsRun(t *testing.T) {
 					}).
 					Return(nil)
 				mgr.exptRepo.(*repoMocks.MockIExperimentRepo).
-					EXPECT().Update(ctx, &entity.Experiment{ID: exptID, LatestRunID: 1001}).Return(nil)
+					EXPECT().UpdateFields(ctx, exptID, map[string]any{"latest_run_id": int64(1001), "quality_gate_result": nil}).Return(nil)
 				mgr.mtr.(*metricsMocks.MockExptMetric).
 					EXPECT().EmitExptExecRun(spaceID, int64(mode))
 			},
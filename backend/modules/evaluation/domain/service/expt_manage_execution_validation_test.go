@@ -450,6 +450,21 @@ func TestExptMangerImpl_checkEvaluatorsConnector_VersionIDValidation(t *testing.
 			expectedErrorCode: "601204001",
 			expectedErrorMsg:  "invalid evaluator connector",
 		},
+		{
+			name: "pairwise_evaluator_should_return_error",
+			expt: &entity.Experiment{
+				EvaluatorVersionRef: []*entity.ExptEvaluatorVersionRef{{EvaluatorVersionID: 123}},
+				Evaluators: []*entity.Evaluator{{
+					EvaluatorType:            entity.EvaluatorTypePairwise,
+					PairwiseEvaluatorVersion: &entity.PairwiseEvaluatorVersion{ID: 123},
+				}},
+				EvalConf: &entity.EvaluationConfiguration{},
+			},
+			setupMocks:        func(mgr *testExptManager) {},
+			expectedError:     true,
+			expectedErrorCode: "601204001",
+			expectedErrorMsg:  "pairwise evaluator 123 is only supported in pairwise ranking",
+		},
 	}
 
 	for _, tt := range tests {
@@ -1427,6 +1427,27 @@ func (e *ExptMangerImpl) CreateExpt(ctx context.Context, req *entity.CreateExptP
 		}
 	}
 
+	if err := e.fillQualityGateConf(ctx, do, req, session); err != nil {
+		return nil, err
+	}
+	if do.EvalConf != nil {
+		if err := do.EvalConf.CostConf.Validate(); err != nil {
+			return nil, errorx.NewByCode(errno.ExperimentValidateFailCode, errorx.WithExtraMsg(err.Error()))
+		}
+		if err := do.EvalConf.ResultCacheConf.Validate(); err != nil {
+			return nil, errorx.NewByCode(errno.ExperimentValidateFailCode, errorx.WithExtraMsg(err.Error()))
+		}
+	}
+	if err := fillSamplingConf(do); err != nil {
+		return nil, err
+	}
+	if err := validateTrialConf(do); err != nil {
+		return nil, err
+	}
+	if err := validateUserSimulatorConf(do); err != nil {
+		return nil, err
+	}
+
 	// 根据 EvaluatorConf.ScoreWeight 设置实验是否启用分数权重（仅正数视为开启）
 	if do.EvalConf != nil && do.EvalConf.ConnectorConf.EvaluatorsConf != nil {
 		do.EvalConf.ConnectorConf.EvaluatorsConf.EnableScoreWeight = false
@@ -1480,6 +1501,78 @@ func (e *ExptMangerImpl) CreateExpt(ctx context.Context, req *entity.CreateExptP
 	return do, nil
 }
 
+// fillQualityGateConf 实验未显式配置质量门禁时沿用模板上的门禁规则, 并校验规则合法性
+func (e *ExptMangerImpl) fillQualityGateConf(ctx context.Context, do *entity.Experiment, req *entity.CreateExptParam, session *entity.Session) error {
+	if (do.EvalConf == nil || do.EvalConf.QualityGateConf.IsEmpty()) && req.ExptTemplateID > 0 && e.templateManager != nil {
+		template, err := e.templateManager.Get(ctx, req.ExptTemplateID, req.WorkspaceID, session)
+		if err != nil {
+			return err
+		}
+		if template != nil && template.TemplateConf != nil && !template.TemplateConf.QualityGateConf.IsEmpty() {
+			if do.EvalConf == nil {
+				do.EvalConf = &entity.EvaluationConfiguration{}
+			}
+			do.EvalConf.QualityGateConf = template.TemplateConf.QualityGateConf
+		}
+	}
+	if do.EvalConf == nil {
+		return nil
+	}
+	if err := do.EvalConf.QualityGateConf.Validate(); err != nil {
+		return errorx.NewByCode(errno.ExperimentValidateFailCode, errorx.WithExtraMsg(err.Error()))
+	}
+	return nil
+}
+
+// fillSamplingConf 校验抽样配置, 未指定随机种子时生成并记入 eval_conf, 保证实验重跑抽样结果一致
+func fillSamplingConf(do *entity.Experiment) error {
+	if do.EvalConf == nil || !do.EvalConf.SamplingConf.IsEnabled() {
+		return nil
+	}
+	conf := do.EvalConf.SamplingConf
+	if err := conf.Validate(); err != nil {
+		return errorx.NewByCode(errno.ExperimentValidateFailCode, errorx.WithExtraMsg(err.Error()))
+	}
+	if do.EvalSetSourceType == entity.ExptEvalSetSourceType_MultiSetConfig {
+		return errorx.NewByCode(errno.ExperimentValidateFailCode, errorx.WithExtraMsg("sampling is not supported for multi eval set experiment"))
+	}
+	if conf.Seed == 0 {
+		conf.Seed = time.Now().UnixNano()
+	}
+	return nil
+}
+
+// validateTrialConf 校验重复试验配置; 结果缓存会让重复试验直接复用首次结果, 两者不可同时开启
+func validateTrialConf(do *entity.Experiment) error {
+	if do.EvalConf == nil || !do.EvalConf.TrialConf.IsEnabled() {
+		return nil
+	}
+	if err := do.EvalConf.TrialConf.Validate(); err != nil {
+		return errorx.NewByCode(errno.ExperimentValidateFailCode, errorx.WithExtraMsg(err.Error()))
+	}
+	if do.EvalConf.ResultCacheConf.TargetCacheEnabled() || do.EvalConf.ResultCacheConf.EvaluatorCacheEnabled() {
+		return errorx.NewByCode(errno.ExperimentValidateFailCode, errorx.WithExtraMsg("trial is not supported with result cache enabled"))
+	}
+	return nil
+}
+
+// validateUserSimulatorConf 校验模拟用户配置; 模拟对话逐轮生成, 不可与重复试验或沙箱侧多轮跑法同时开启
+func validateUserSimulatorConf(do *entity.Experiment) error {
+	if do.EvalConf == nil || do.EvalConf.UserSimulatorConf == nil {
+		return nil
+	}
+	if err := do.EvalConf.UserSimulatorConf.Validate(); err != nil {
+		return errorx.NewByCode(errno.ExperimentValidateFailCode, errorx.WithExtraMsg(err.Error()))
+	}
+	if do.EvalConf.TrialConf.IsEnabled() {
+		return errorx.NewByCode(errno.ExperimentValidateFailCode, errorx.WithExtraMsg("user simulator is not supported with trial enabled"))
+	}
+	if rmc := do.EvalConf.RunModeConfig; entity.IsNewRunModeLink(rmc) && rmc.RunMode != entity.RunModeSingleTurn {
+		return errorx.NewByCode(errno.ExperimentValidateFailCode, errorx.WithExtraMsg(fmt.Sprintf("user simulator is not supported with run_mode %s", rmc.RunMode)))
+	}
+	return nil
+}
+
 func (e *ExptMangerImpl) Create(ctx context.Context, expt *entity.Experiment, session *entity.Session) error {
 	refs := expt.ToEvaluatorRefDO()
 
@@ -248,6 +248,11 @@ func TestExptMangerImpl_CreateExpt(t *testing.T) {
 		}
 	})
 
+	mgr.templateManager.(*svcMocks.MockIExptTemplateManager).
+		EXPECT().
+		Get(ctx, int64(100), int64(1), session).
+		Return(&entity.ExptTemplate{}, nil).AnyTimes()
+
 	t.Run("设置ExptTemplateMeta", func(t *testing.T) {
 		paramWithTemplate := &entity.CreateExptParam{
 			WorkspaceID:         1,
@@ -3001,3 +3006,99 @@ func TestExptMangerImpl_GetIDsByGroupKey(t *testing.T) {
 		assert.Equal(t, gotArgs{spaceID: 9902, groupKey: "gk-err", page: 1, pageSize: 10}, captured[0])
 	})
 }
+
+func TestExptMangerImpl_fillQualityGateConf(t *testing.T) {
+	ctx := context.Background()
+	session := &entity.Session{UserID: "1"}
+	templateGate := &entity.ExptQualityGateConf{Rules: []*entity.ExptQualityGateRule{
+		{Metric: entity.QualityGateMetricFailRate, Operator: entity.QualityGateOperatorLT, Threshold: 0.02},
+	}}
+
+	t.Run("沿用模板门禁", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mgr := newTestExptManager(ctrl)
+		mgr.templateManager.(*svcMocks.MockIExptTemplateManager).EXPECT().Get(ctx, int64(100), int64(1), session).
+			Return(&entity.ExptTemplate{TemplateConf: &entity.ExptTemplateConfiguration{QualityGateConf: templateGate}}, nil)
+
+		do := &entity.Experiment{}
+		err := mgr.fillQualityGateConf(ctx, do, &entity.CreateExptParam{WorkspaceID: 1, ExptTemplateID: 100}, session)
+		assert.NoError(t, err)
+		assert.Equal(t, templateGate, do.EvalConf.QualityGateConf)
+	})
+
+	t.Run("显式配置优先", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mgr := newTestExptManager(ctrl)
+
+		gate := &entity.ExptQualityGateConf{Rules: []*entity.ExptQualityGateRule{
+			{Metric: entity.QualityGateMetricWeightedAvgScore, Operator: entity.QualityGateOperatorGE, Threshold: 0.8},
+		}}
+		do := &entity.Experiment{EvalConf: &entity.EvaluationConfiguration{QualityGateConf: gate}}
+		err := mgr.fillQualityGateConf(ctx, do, &entity.CreateExptParam{WorkspaceID: 1, ExptTemplateID: 100}, session)
+		assert.NoError(t, err)
+		assert.Equal(t, gate, do.EvalConf.QualityGateConf)
+	})
+
+	t.Run("非法规则", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mgr := newTestExptManager(ctrl)
+
+		do := &entity.Experiment{EvalConf: &entity.EvaluationConfiguration{QualityGateConf: &entity.ExptQualityGateConf{Rules: []*entity.ExptQualityGateRule{
+			{Metric: entity.QualityGateMetricRegressionRate, Operator: entity.QualityGateOperatorLE},
+		}}}}
+		err := mgr.fillQualityGateConf(ctx, do, &entity.CreateExptParam{WorkspaceID: 1}, session)
+		assert.Error(t, err)
+	})
+}
+
+func Test_fillSamplingConf(t *testing.T) {
+	assert.NoError(t, fillSamplingConf(&entity.Experiment{}))
+
+	do := &entity.Experiment{EvalConf: &entity.EvaluationConfiguration{SamplingConf: &entity.ExptSamplingConf{Mode: entity.ExptSamplingModeRandomN, SampleNum: 10}}}
+	assert.NoError(t, fillSamplingConf(do))
+	assert.NotZero(t, do.EvalConf.SamplingConf.Seed)
+
+	do = &entity.Experiment{EvalConf: &entity.EvaluationConfiguration{SamplingConf: &entity.ExptSamplingConf{Mode: entity.ExptSamplingModeRandomN, SampleNum: 10, Seed: 5}}}
+	assert.NoError(t, fillSamplingConf(do))
+	assert.Equal(t, int64(5), do.EvalConf.SamplingConf.Seed)
+
+	do = &entity.Experiment{EvalConf: &entity.EvaluationConfiguration{SamplingConf: &entity.ExptSamplingConf{Mode: entity.ExptSamplingModeRandomN}}}
+	assert.Error(t, fillSamplingConf(do))
+
+	do = &entity.Experiment{
+		EvalSetSourceType: entity.ExptEvalSetSourceType_MultiSetConfig,
+		EvalConf:          &entity.EvaluationConfiguration{SamplingConf: &entity.ExptSamplingConf{Mode: entity.ExptSamplingModeRandomN, SampleNum: 10}},
+	}
+	assert.Error(t, fillSamplingConf(do))
+}
+
+func Test_validateTrialConf(t *testing.T) {
+	assert.NoError(t, validateTrialConf(&entity.Experiment{}))
+	assert.NoError(t, validateTrialConf(&entity.Experiment{EvalConf: &entity.EvaluationConfiguration{TrialConf: &entity.ExptTrialConf{TrialNum: 3}}}))
+	assert.Error(t, validateTrialConf(&entity.Experiment{EvalConf: &entity.EvaluationConfiguration{TrialConf: &entity.ExptTrialConf{TrialNum: entity.MaxExptTrialNum + 1}}}))
+	assert.Error(t, validateTrialConf(&entity.Experiment{EvalConf: &entity.EvaluationConfiguration{
+		TrialConf:       &entity.ExptTrialConf{TrialNum: 3},
+		ResultCacheConf: &entity.ExptResultCacheConf{EnableTargetCache: true},
+	}}))
+}
+
+func Test_validateUserSimulatorConf(t *testing.T) {
+	simConf := func() *entity.ExptUserSimulatorConf {
+		return &entity.ExptUserSimulatorConf{ModelConfig: &entity.ModelConfig{}, QueryFieldName: "input"}
+	}
+	assert.NoError(t, validateUserSimulatorConf(&entity.Experiment{}))
+	assert.NoError(t, validateUserSimulatorConf(&entity.Experiment{EvalConf: &entity.EvaluationConfiguration{UserSimulatorConf: simConf()}}))
+	assert.Error(t, validateUserSimulatorConf(&entity.Experiment{EvalConf: &entity.EvaluationConfiguration{
+		UserSimulatorConf: &entity.ExptUserSimulatorConf{ModelConfig: &entity.ModelConfig{}},
+	}}))
+	assert.Error(t, validateUserSimulatorConf(&entity.Experiment{EvalConf: &entity.EvaluationConfiguration{
+		UserSimulatorConf: &entity.ExptUserSimulatorConf{ModelConfig: &entity.ModelConfig{}, QueryFieldName: "input", MaxTurns: entity.MaxUserSimulatorMaxTurns + 1},
+	}}))
+	assert.Error(t, validateUserSimulatorConf(&entity.Experiment{EvalConf: &entity.EvaluationConfiguration{
+		UserSimulatorConf: simConf(),
+		TrialConf:         &entity.ExptTrialConf{TrialNum: 3},
+	}}))
+}
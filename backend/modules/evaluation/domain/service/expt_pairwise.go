@@ -0,0 +1,23 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination ./mocks/expt_pairwise.go --package mocks . ExptPairwiseRankService
+type ExptPairwiseRankService interface {
+	// SubmitRankTask 校验参数并创建排名记录, 通过 MQ 异步执行 RankExperiments
+	SubmitRankTask(ctx context.Context, param *entity.ExptPairwiseRankParam, session *entity.Session) (*entity.ExptPairwiseRankRecord, error)
+	// HandleRankEvent 消费排名事件, 执行排名并回写记录; 记录已结束时直接跳过
+	HandleRankEvent(ctx context.Context, spaceID, recordID int64) error
+	// GetRankRecord 查询排名记录, 超时仍在运行的记录置为失败
+	GetRankRecord(ctx context.Context, spaceID, recordID int64) (*entity.ExptPairwiseRankRecord, error)
+	// RankExperiments 使用成对评估器对同一评测集下的多个实验两两对比,
+	// 输出每对实验的胜平负以及胜率、Elo、Bradley-Terry 排名。
+	RankExperiments(ctx context.Context, param *entity.ExptPairwiseRankParam) (*entity.ExptPairwiseRankResult, error)
+}
@@ -0,0 +1,448 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/bytedance/gg/gslice"
+
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/events"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/contexts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/stats"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/goroutine"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const (
+	pairwiseRunExtKeyExptA = "pairwise_expt_a"
+	pairwiseRunExtKeyExptB = "pairwise_expt_b"
+)
+
+type ExptPairwiseRankServiceImpl struct {
+	idgen              idgen.IIDGenerator
+	experimentRepo     repo.IExperimentRepo
+	exptTurnResultRepo repo.IExptTurnResultRepo
+	rankRecordRepo     repo.IExptPairwiseRankRecordRepo
+	evalTargetService  IEvalTargetService
+	evaluatorService   EvaluatorService
+	publisher          events.ExptEventPublisher
+}
+
+func NewExptPairwiseRankService(
+	idgen idgen.IIDGenerator,
+	experimentRepo repo.IExperimentRepo,
+	exptTurnResultRepo repo.IExptTurnResultRepo,
+	rankRecordRepo repo.IExptPairwiseRankRecordRepo,
+	evalTargetService IEvalTargetService,
+	evaluatorService EvaluatorService,
+	publisher events.ExptEventPublisher,
+) ExptPairwiseRankService {
+	return &ExptPairwiseRankServiceImpl{
+		idgen:              idgen,
+		experimentRepo:     experimentRepo,
+		exptTurnResultRepo: exptTurnResultRepo,
+		rankRecordRepo:     rankRecordRepo,
+		evalTargetService:  evalTargetService,
+		evaluatorService:   evaluatorService,
+		publisher:          publisher,
+	}
+}
+
+func (e *ExptPairwiseRankServiceImpl) SubmitRankTask(ctx context.Context, param *entity.ExptPairwiseRankParam, session *entity.Session) (*entity.ExptPairwiseRankRecord, error) {
+	// 提交时即完成鉴权与参数校验, 避免无效任务入队
+	if _, err := e.prepare(ctx, param); err != nil {
+		return nil, err
+	}
+
+	id, err := e.idgen.GenID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	record := &entity.ExptPairwiseRankRecord{
+		ID:                 id,
+		SpaceID:            param.SpaceID,
+		EvaluatorVersionID: param.EvaluatorVersionID,
+		Status:             entity.ExptPairwiseRankStatus_Running,
+		Param:              param,
+		CreatedAt:          time.Now(),
+	}
+	if session != nil {
+		record.CreatedBy = session.UserID
+	}
+	if err := e.rankRecordRepo.CreatePairwiseRankRecord(ctx, record); err != nil {
+		return nil, err
+	}
+
+	if err := e.publisher.PublishExptExportCSVEvent(ctx, &entity.ExportCSVEvent{
+		ExportID:     record.ID,
+		ExperimentID: param.ExptIDs[0],
+		SpaceID:      param.SpaceID,
+		ExportScene:  entity.ExportScenePairwiseRank,
+		CreatedAt:    record.CreatedAt.Unix(),
+		Session:      session,
+	}, gptr.Of(time.Second*3)); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (e *ExptPairwiseRankServiceImpl) HandleRankEvent(ctx context.Context, spaceID, recordID int64) error {
+	record, err := e.rankRecordRepo.GetPairwiseRankRecord(contexts.WithCtxWriteDB(ctx), spaceID, recordID)
+	if err != nil {
+		return err
+	}
+	if record == nil {
+		return errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("pairwise rank record %d not found", recordID)))
+	}
+	if record.Status != entity.ExptPairwiseRankStatus_Running {
+		logs.CtxInfo(ctx, "[HandleRankEvent] record already finished, skip, record_id: %v, status: %v", recordID, record.Status)
+		return nil
+	}
+
+	result, rankErr := e.RankExperiments(ctx, record.Param)
+	if rankErr != nil {
+		logs.CtxError(ctx, "[HandleRankEvent] rank fail, record_id: %v, err: %v", recordID, rankErr)
+		record.Status = entity.ExptPairwiseRankStatus_Failed
+		record.ErrMsg = rankErr.Error()
+	} else {
+		record.Status = entity.ExptPairwiseRankStatus_Success
+		record.Result = result
+	}
+	return e.rankRecordRepo.UpdatePairwiseRankResult(ctx, record)
+}
+
+func (e *ExptPairwiseRankServiceImpl) GetRankRecord(ctx context.Context, spaceID, recordID int64) (*entity.ExptPairwiseRankRecord, error) {
+	record, err := e.rankRecordRepo.GetPairwiseRankRecord(ctx, spaceID, recordID)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return nil, errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("pairwise rank record %d not found", recordID)))
+	}
+	if record.IsStale(time.Now()) {
+		record.Status = entity.ExptPairwiseRankStatus_Failed
+		record.ErrMsg = "pairwise rank timeout"
+		if err := e.rankRecordRepo.UpdatePairwiseRankResult(ctx, record); err != nil {
+			logs.CtxError(ctx, "[GetRankRecord] mark stale record failed fail, record_id: %v, err: %v", recordID, err)
+		}
+	}
+	return record, nil
+}
+
+func (e *ExptPairwiseRankServiceImpl) RankExperiments(ctx context.Context, param *entity.ExptPairwiseRankParam) (*entity.ExptPairwiseRankResult, error) {
+	exptMap, err := e.prepare(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+
+	targetRecords := make([]map[entity.ItemTurnID]*entity.EvalTargetRecord, len(param.ExptIDs))
+	for i, exptID := range param.ExptIDs {
+		targetRecords[i], err = e.loadExptTargetRecords(ctx, exptMap[exptID], param.SpaceID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var tasks []*pairwiseCompareTask
+	res := &entity.ExptPairwiseRankResult{EvaluatorVersionID: param.EvaluatorVersionID}
+	for i := 0; i < len(param.ExptIDs); i++ {
+		for j := i + 1; j < len(param.ExptIDs); j++ {
+			pairResult := &entity.ExptPairwiseResult{ExptAID: param.ExptIDs[i], ExptBID: param.ExptIDs[j]}
+			res.PairResults = append(res.PairResults, pairResult)
+			tasks = append(tasks, planPairCompare(param, i, j, pairResult, targetRecords[i], targetRecords[j])...)
+		}
+	}
+	if err := e.runCompareTasks(ctx, param, tasks); err != nil {
+		return nil, err
+	}
+
+	// 按计划顺序汇总, 保证 Elo 等顺序敏感的统计结果稳定
+	outcomes := make([]stats.PairOutcome, 0, len(tasks))
+	for _, task := range tasks {
+		if !task.ok {
+			task.pairResult.Failed++
+			continue
+		}
+		switch task.pref {
+		case entity.PairwisePreferenceA:
+			task.pairResult.AWins++
+		case entity.PairwisePreferenceB:
+			task.pairResult.BWins++
+		default:
+			task.pairResult.Ties++
+		}
+		outcomes = append(outcomes, stats.PairOutcome{A: task.idxA, B: task.idxB, Score: task.pref.Score()})
+	}
+	res.Rankings = buildPairwiseRankings(param.ExptIDs, outcomes, param.EloK)
+
+	logs.CtxInfo(ctx, "RankExperiments done, space_id: %v, expt_ids: %v, evaluator_version_id: %v, comparisons: %v",
+		param.SpaceID, param.ExptIDs, param.EvaluatorVersionID, len(outcomes))
+	return res, nil
+}
+
+// prepare 校验参数、评估器归属与类型以及实验归属, 返回实验 ID 到实验的映射
+func (e *ExptPairwiseRankServiceImpl) prepare(ctx context.Context, param *entity.ExptPairwiseRankParam) (map[int64]*entity.Experiment, error) {
+	if err := e.validateParam(param); err != nil {
+		return nil, err
+	}
+
+	evaluator, err := e.evaluatorService.GetEvaluatorVersion(ctx, gptr.Of(param.SpaceID), param.EvaluatorVersionID, false, false)
+	if err != nil {
+		return nil, err
+	}
+	if evaluator == nil || (!evaluator.Builtin && evaluator.SpaceID != param.SpaceID) {
+		return nil, errorx.NewByCode(errno.EvaluatorVersionNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("evaluator version %d not found", param.EvaluatorVersionID)))
+	}
+	if evaluator.EvaluatorType != entity.EvaluatorTypePairwise {
+		return nil, errorx.NewByCode(errno.InvalidEvaluatorTypeCode, errorx.WithExtraMsg("evaluator version is not a pairwise evaluator"))
+	}
+
+	expts, err := e.experimentRepo.MGetByID(ctx, param.ExptIDs, param.SpaceID)
+	if err != nil {
+		return nil, err
+	}
+	exptMap := gslice.ToMap(expts, func(expt *entity.Experiment) (int64, *entity.Experiment) { return expt.ID, expt })
+	var evalSetID int64
+	for _, exptID := range param.ExptIDs {
+		expt, ok := exptMap[exptID]
+		if !ok || expt.SpaceID != param.SpaceID {
+			return nil, errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("experiment %d not found", exptID)))
+		}
+		if evalSetID > 0 && expt.EvalSetID > 0 && expt.EvalSetID != evalSetID {
+			return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("experiments must use the same eval set"))
+		}
+		if expt.EvalSetID > 0 {
+			evalSetID = expt.EvalSetID
+		}
+	}
+	return exptMap, nil
+}
+
+func (e *ExptPairwiseRankServiceImpl) validateParam(param *entity.ExptPairwiseRankParam) error {
+	if param == nil || param.SpaceID <= 0 || param.EvaluatorVersionID <= 0 {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("space_id and evaluator_version_id are required"))
+	}
+	if len(param.ExptIDs) < 2 || len(param.ExptIDs) > entity.MaxPairwiseRankExptCnt {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("experiment count must be in [2, %d]", entity.MaxPairwiseRankExptCnt)))
+	}
+	seen := make(map[int64]bool, len(param.ExptIDs))
+	for _, id := range param.ExptIDs {
+		if id <= 0 || seen[id] {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("invalid or duplicated experiment id %d", id)))
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// loadExptTargetRecords 加载实验各 turn 的评测对象执行记录
+func (e *ExptPairwiseRankServiceImpl) loadExptTargetRecords(ctx context.Context, expt *entity.Experiment, spaceID int64) (map[entity.ItemTurnID]*entity.EvalTargetRecord, error) {
+	turnResults, err := scanExptTurnResults(ctx, e.exptTurnResultRepo, spaceID, expt.ID)
+	if err != nil {
+		return nil, err
+	}
+	return loadTargetRecordsByTurns(ctx, e.evalTargetService, expt, spaceID, turnResults)
+}
+
+// loadTargetRecordsByTurns 按 turn 结果上的 TargetResultID 批量加载评测对象执行记录, 评测对象跨空间时按来源空间读
+func loadTargetRecordsByTurns(ctx context.Context, evalTargetService IEvalTargetService, expt *entity.Experiment, spaceID int64,
+	turnResults []*entity.ExptTurnResult,
+) (map[entity.ItemTurnID]*entity.EvalTargetRecord, error) {
+	recordID2ItemTurn := make(map[int64]entity.ItemTurnID)
+	for _, tr := range turnResults {
+		if tr.TargetResultID > 0 {
+			recordID2ItemTurn[tr.TargetResultID] = entity.ItemTurnID{ItemID: tr.ItemID, TurnID: tr.TurnID}
+		}
+	}
+
+	recordIDs := make([]int64, 0, len(recordID2ItemTurn))
+	for id := range recordID2ItemTurn {
+		recordIDs = append(recordIDs, id)
+	}
+	sort.Slice(recordIDs, func(i, j int) bool { return recordIDs[i] < recordIDs[j] })
+
+	res := make(map[entity.ItemTurnID]*entity.EvalTargetRecord, len(recordIDs))
+	for _, chunk := range gslice.Chunk(recordIDs, 200) {
+		records, err := evalTargetService.BatchGetRecordByIDs(ctx, resolveLoadSpaceID(spaceID, expt.TargetSpaceID), chunk)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range records {
+			if record == nil {
+				continue
+			}
+			if itemTurn, ok := recordID2ItemTurn[record.ID]; ok {
+				res[itemTurn] = record
+			}
+		}
+	}
+	return res, nil
+}
+
+// pairwiseCompareTask 一对实验在单个 turn 上的对比, 执行结果写回 pref / ok
+type pairwiseCompareTask struct {
+	idxA, idxB int
+	itemTurn   entity.ItemTurnID
+	pairResult *entity.ExptPairwiseResult
+	inputData  *entity.EvaluatorInputData
+
+	pref entity.PairwisePreference
+	ok   bool
+}
+
+// planPairCompare 取两实验共同的 turn 生成对比任务, 缺少输出的 turn 不生成任务, 直接计入 Failed
+func planPairCompare(param *entity.ExptPairwiseRankParam, idxA, idxB int, pairResult *entity.ExptPairwiseResult,
+	recordsA, recordsB map[entity.ItemTurnID]*entity.EvalTargetRecord,
+) []*pairwiseCompareTask {
+	itemTurns := make([]entity.ItemTurnID, 0, len(recordsA))
+	for itemTurn := range recordsA {
+		if _, ok := recordsB[itemTurn]; ok {
+			itemTurns = append(itemTurns, itemTurn)
+		}
+	}
+	sort.Slice(itemTurns, func(i, j int) bool {
+		if itemTurns[i].ItemID != itemTurns[j].ItemID {
+			return itemTurns[i].ItemID < itemTurns[j].ItemID
+		}
+		return itemTurns[i].TurnID < itemTurns[j].TurnID
+	})
+	if maxCnt := param.GetMaxTurnCnt(); len(itemTurns) > maxCnt {
+		itemTurns = itemTurns[:maxCnt]
+	}
+
+	outputKey := param.GetOutputFieldKey()
+	tasks := make([]*pairwiseCompareTask, 0, len(itemTurns))
+	for _, itemTurn := range itemTurns {
+		recordA, recordB := recordsA[itemTurn], recordsB[itemTurn]
+		outputA, outputB := targetOutputField(recordA, outputKey), targetOutputField(recordB, outputKey)
+		if outputA == nil || outputB == nil {
+			pairResult.Failed++
+			continue
+		}
+
+		inputFields := make(map[string]*entity.Content)
+		if recordA.EvalTargetInputData != nil {
+			for k, v := range recordA.EvalTargetInputData.InputFields {
+				inputFields[k] = v
+			}
+		}
+		inputFields[entity.PairwiseInputFieldKeyOutputA] = outputA
+		inputFields[entity.PairwiseInputFieldKeyOutputB] = outputB
+		tasks = append(tasks, &pairwiseCompareTask{
+			idxA:       idxA,
+			idxB:       idxB,
+			itemTurn:   itemTurn,
+			pairResult: pairResult,
+			inputData:  &entity.EvaluatorInputData{InputFields: inputFields},
+		})
+	}
+	return tasks
+}
+
+// runCompareTasks 以 param.Concurrency 为上限并发调用成对评估器, 单个任务失败只记为 !ok, 不中断整体排名
+func (e *ExptPairwiseRankServiceImpl) runCompareTasks(ctx context.Context, param *entity.ExptPairwiseRankParam, tasks []*pairwiseCompareTask) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	pool, err := goroutine.NewPool(param.GetConcurrency())
+	if err != nil {
+		return err
+	}
+	defer pool.Release()
+
+	for _, task := range tasks {
+		task := task
+		pool.Add(func() error {
+			exptAID, exptBID := param.ExptIDs[task.idxA], param.ExptIDs[task.idxB]
+			record, err := e.evaluatorService.RunEvaluator(ctx, &entity.RunEvaluatorRequest{
+				SpaceID:            param.SpaceID,
+				EvaluatorVersionID: param.EvaluatorVersionID,
+				InputData:          task.inputData,
+				ItemID:             task.itemTurn.ItemID,
+				TurnID:             task.itemTurn.TurnID,
+				Ext: map[string]string{
+					pairwiseRunExtKeyExptA: strconv.FormatInt(exptAID, 10),
+					pairwiseRunExtKeyExptB: strconv.FormatInt(exptBID, 10),
+				},
+			})
+			pref, ok := pairwisePreferenceFromRecord(record)
+			if err != nil || !ok {
+				logs.CtxWarn(ctx, "[RankExperiments] pairwise evaluate fail, expt_a: %v, expt_b: %v, item_id: %v, turn_id: %v, err: %v",
+					exptAID, exptBID, task.itemTurn.ItemID, task.itemTurn.TurnID, err)
+				return nil
+			}
+			task.pref, task.ok = pref, true
+			return nil
+		})
+	}
+	return pool.ExecAll(ctx)
+}
+
+func targetOutputField(record *entity.EvalTargetRecord, key string) *entity.Content {
+	if record == nil || record.EvalTargetOutputData == nil {
+		return nil
+	}
+	return record.EvalTargetOutputData.OutputFields[key]
+}
+
+func pairwisePreferenceFromRecord(record *entity.EvaluatorRecord) (entity.PairwisePreference, bool) {
+	if record == nil || record.Status != entity.EvaluatorRunStatusSuccess || record.EvaluatorOutputData == nil {
+		return "", false
+	}
+	pref, ok := record.EvaluatorOutputData.Ext[entity.PairwiseExtKeyPreference]
+	if !ok {
+		return "", false
+	}
+	return entity.PairwisePreference(pref), true
+}
+
+func buildPairwiseRankings(exptIDs []int64, outcomes []stats.PairOutcome, eloK float64) []*entity.ExptPairwiseRanking {
+	elo := stats.Elo(len(exptIDs), outcomes, eloK)
+	bt := stats.BradleyTerry(len(exptIDs), outcomes)
+
+	rankings := make([]*entity.ExptPairwiseRanking, len(exptIDs))
+	for i, exptID := range exptIDs {
+		rankings[i] = &entity.ExptPairwiseRanking{ExptID: exptID, Elo: elo[i], BTScore: bt[i]}
+	}
+	for _, o := range outcomes {
+		a, b := rankings[o.A], rankings[o.B]
+		switch o.Score {
+		case 1:
+			a.Wins++
+			b.Losses++
+		case 0:
+			a.Losses++
+			b.Wins++
+		default:
+			a.Ties++
+			b.Ties++
+		}
+	}
+	for _, r := range rankings {
+		if total := r.Wins + r.Losses + r.Ties; total > 0 {
+			r.WinRate = (float64(r.Wins) + 0.5*float64(r.Ties)) / float64(total)
+		}
+	}
+	sort.SliceStable(rankings, func(i, j int) bool {
+		if rankings[i].BTScore != rankings[j].BTScore {
+			return rankings[i].BTScore > rankings[j].BTScore
+		}
+		return rankings[i].WinRate > rankings[j].WinRate
+	})
+	for i, r := range rankings {
+		r.Rank = i + 1
+	}
+	return rankings
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	idgenmocks "github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	eventsMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/events/mocks"
+	repoMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+func mockExptTargetOutputs(turnRepo *repoMocks.MockIExptTurnResultRepo, targetSvc *svcMocks.MockIEvalTargetService,
+	spaceID, exptID int64, outputs []string,
+) {
+	turnResults := make([]*entity.ExptTurnResult, 0, len(outputs))
+	records := make([]*entity.EvalTargetRecord, 0, len(outputs))
+	for i, output := range outputs {
+		recordID := exptID*100 + int64(i)
+		turnResults = append(turnResults, &entity.ExptTurnResult{ID: exptID*10 + int64(i), ExptID: exptID, ItemID: int64(i + 1), TurnID: 1, TargetResultID: recordID})
+		records = append(records, &entity.EvalTargetRecord{
+			ID:                  recordID,
+			EvalTargetInputData: &entity.EvalTargetInputData{InputFields: map[string]*entity.Content{"input": {Text: gptr.Of("q")}}},
+			EvalTargetOutputData: &entity.EvalTargetOutputData{
+				OutputFields: map[string]*entity.Content{"actual_output": {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of(output)}},
+			},
+		})
+	}
+	turnRepo.EXPECT().ScanTurnResults(gomock.Any(), exptID, gomock.Any(), int64(0), int64(500), spaceID).Return(turnResults, int64(len(outputs)), nil)
+	turnRepo.EXPECT().ScanTurnResults(gomock.Any(), exptID, gomock.Any(), int64(len(outputs)), int64(500), spaceID).Return(nil, int64(len(outputs)), nil)
+	targetSvc.EXPECT().BatchGetRecordByIDs(gomock.Any(), spaceID, gomock.Any()).Return(records, nil)
+}
+
+func TestExptPairwiseRankServiceImpl_RankExperiments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	exptRepo := repoMocks.NewMockIExperimentRepo(ctrl)
+	turnRepo := repoMocks.NewMockIExptTurnResultRepo(ctrl)
+	targetSvc := svcMocks.NewMockIEvalTargetService(ctrl)
+	evaluatorSvc := svcMocks.NewMockEvaluatorService(ctrl)
+	svc := NewExptPairwiseRankService(idgenmocks.NewMockIIDGenerator(ctrl), exptRepo, turnRepo, repoMocks.NewMockIExptPairwiseRankRecordRepo(ctrl),
+		targetSvc, evaluatorSvc, eventsMocks.NewMockExptEventPublisher(ctrl))
+
+	const spaceID, evaluatorVersionID = int64(100), int64(9)
+	evaluatorSvc.EXPECT().GetEvaluatorVersion(gomock.Any(), gptr.Of(spaceID), evaluatorVersionID, false, false).
+		Return(&entity.Evaluator{SpaceID: spaceID, EvaluatorType: entity.EvaluatorTypePairwise}, nil)
+	exptRepo.EXPECT().MGetByID(gomock.Any(), []int64{1, 2, 3}, spaceID).Return([]*entity.Experiment{
+		{ID: 1, SpaceID: spaceID, EvalSetID: 5}, {ID: 2, SpaceID: spaceID, EvalSetID: 5}, {ID: 3, SpaceID: spaceID, EvalSetID: 5},
+	}, nil)
+	mockExptTargetOutputs(turnRepo, targetSvc, spaceID, 1, []string{"good", "good"})
+	mockExptTargetOutputs(turnRepo, targetSvc, spaceID, 2, []string{"ok", "ok"})
+	mockExptTargetOutputs(turnRepo, targetSvc, spaceID, 3, []string{"bad", "bad"})
+
+	quality := map[string]int{"good": 3, "ok": 2, "bad": 1}
+	evaluatorSvc.EXPECT().RunEvaluator(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *entity.RunEvaluatorRequest) (*entity.EvaluatorRecord, error) {
+		assert.Equal(t, "q", gptr.Indirect(req.InputData.InputFields["input"].Text))
+		a := quality[gptr.Indirect(req.InputData.InputFields[entity.PairwiseInputFieldKeyOutputA].Text)]
+		b := quality[gptr.Indirect(req.InputData.InputFields[entity.PairwiseInputFieldKeyOutputB].Text)]
+		pref := entity.PairwisePreferenceTie
+		if a > b {
+			pref = entity.PairwisePreferenceA
+		} else if a < b {
+			pref = entity.PairwisePreferenceB
+		}
+		return &entity.EvaluatorRecord{
+			Status:              entity.EvaluatorRunStatusSuccess,
+			EvaluatorOutputData: &entity.EvaluatorOutputData{Ext: map[string]string{entity.PairwiseExtKeyPreference: string(pref)}},
+		}, nil
+	}).Times(6)
+
+	res, err := svc.RankExperiments(context.Background(), &entity.ExptPairwiseRankParam{
+		SpaceID:            spaceID,
+		ExptIDs:            []int64{1, 2, 3},
+		EvaluatorVersionID: evaluatorVersionID,
+		Concurrency:        3,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res.PairResults, 3)
+	assert.Equal(t, int64(2), res.PairResults[0].AWins)
+	assert.Equal(t, []int64{1, 2, 3}, []int64{res.Rankings[0].ExptID, res.Rankings[1].ExptID, res.Rankings[2].ExptID})
+	assert.Equal(t, 1, res.Rankings[0].Rank)
+	assert.Equal(t, 1.0, res.Rankings[0].WinRate)
+	assert.Equal(t, 0.0, res.Rankings[2].WinRate)
+	assert.True(t, res.Rankings[0].Elo > res.Rankings[1].Elo && res.Rankings[1].Elo > res.Rankings[2].Elo)
+}
+
+func TestExptPairwiseRankServiceImpl_RankExperiments_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	exptRepo := repoMocks.NewMockIExperimentRepo(ctrl)
+	evaluatorSvc := svcMocks.NewMockEvaluatorService(ctrl)
+	svc := NewExptPairwiseRankService(idgenmocks.NewMockIIDGenerator(ctrl), exptRepo, repoMocks.NewMockIExptTurnResultRepo(ctrl), repoMocks.NewMockIExptPairwiseRankRecordRepo(ctrl),
+		svcMocks.NewMockIEvalTargetService(ctrl), evaluatorSvc, eventsMocks.NewMockExptEventPublisher(ctrl))
+
+	tests := []struct {
+		name  string
+		param *entity.ExptPairwiseRankParam
+		setup func()
+	}{
+		{name: "nil param", param: nil},
+		{name: "single experiment", param: &entity.ExptPairwiseRankParam{SpaceID: 1, EvaluatorVersionID: 1, ExptIDs: []int64{1}}},
+		{name: "duplicated experiment", param: &entity.ExptPairwiseRankParam{SpaceID: 1, EvaluatorVersionID: 1, ExptIDs: []int64{1, 1}}},
+		{
+			name:  "not pairwise evaluator",
+			param: &entity.ExptPairwiseRankParam{SpaceID: 1, EvaluatorVersionID: 1, ExptIDs: []int64{1, 2}},
+			setup: func() {
+				evaluatorSvc.EXPECT().GetEvaluatorVersion(gomock.Any(), gptr.Of(int64(1)), int64(1), false, false).Return(&entity.Evaluator{SpaceID: 1, EvaluatorType: entity.EvaluatorTypePrompt}, nil)
+			},
+		},
+		{
+			name:  "evaluator not found in space",
+			param: &entity.ExptPairwiseRankParam{SpaceID: 1, EvaluatorVersionID: 1, ExptIDs: []int64{1, 2}},
+			setup: func() {
+				evaluatorSvc.EXPECT().GetEvaluatorVersion(gomock.Any(), gptr.Of(int64(1)), int64(1), false, false).Return(nil, nil)
+			},
+		},
+		{
+			name:  "evaluator of other space",
+			param: &entity.ExptPairwiseRankParam{SpaceID: 1, EvaluatorVersionID: 1, ExptIDs: []int64{1, 2}},
+			setup: func() {
+				evaluatorSvc.EXPECT().GetEvaluatorVersion(gomock.Any(), gptr.Of(int64(1)), int64(1), false, false).Return(&entity.Evaluator{SpaceID: 2, EvaluatorType: entity.EvaluatorTypePairwise}, nil)
+			},
+		},
+		{
+			name:  "different eval set",
+			param: &entity.ExptPairwiseRankParam{SpaceID: 1, EvaluatorVersionID: 1, ExptIDs: []int64{1, 2}},
+			setup: func() {
+				evaluatorSvc.EXPECT().GetEvaluatorVersion(gomock.Any(), gptr.Of(int64(1)), int64(1), false, false).Return(&entity.Evaluator{SpaceID: 1, EvaluatorType: entity.EvaluatorTypePairwise}, nil)
+				exptRepo.EXPECT().MGetByID(gomock.Any(), []int64{1, 2}, int64(1)).Return([]*entity.Experiment{{ID: 1, SpaceID: 1, EvalSetID: 3}, {ID: 2, SpaceID: 1, EvalSetID: 4}}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+			_, err := svc.RankExperiments(context.Background(), tt.param)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestExptPairwiseRankServiceImpl_SubmitAndHandle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idgen := idgenmocks.NewMockIIDGenerator(ctrl)
+	exptRepo := repoMocks.NewMockIExperimentRepo(ctrl)
+	recordRepo := repoMocks.NewMockIExptPairwiseRankRecordRepo(ctrl)
+	evaluatorSvc := svcMocks.NewMockEvaluatorService(ctrl)
+	publisher := eventsMocks.NewMockExptEventPublisher(ctrl)
+	svc := NewExptPairwiseRankService(idgen, exptRepo, repoMocks.NewMockIExptTurnResultRepo(ctrl), recordRepo,
+		svcMocks.NewMockIEvalTargetService(ctrl), evaluatorSvc, publisher)
+
+	const spaceID = int64(100)
+	param := &entity.ExptPairwiseRankParam{SpaceID: spaceID, ExptIDs: []int64{1, 2}, EvaluatorVersionID: 9}
+
+	t.Run("submit", func(t *testing.T) {
+		evaluatorSvc.EXPECT().GetEvaluatorVersion(gomock.Any(), gptr.Of(spaceID), int64(9), false, false).
+			Return(&entity.Evaluator{SpaceID: spaceID, EvaluatorType: entity.EvaluatorTypePairwise}, nil)
+		exptRepo.EXPECT().MGetByID(gomock.Any(), []int64{1, 2}, spaceID).Return([]*entity.Experiment{{ID: 1, SpaceID: spaceID}, {ID: 2, SpaceID: spaceID}}, nil)
+		idgen.EXPECT().GenID(gomock.Any()).Return(int64(77), nil)
+		recordRepo.EXPECT().CreatePairwiseRankRecord(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, record *entity.ExptPairwiseRankRecord) error {
+			assert.Equal(t, entity.ExptPairwiseRankStatus_Running, record.Status)
+			assert.Equal(t, "u1", record.CreatedBy)
+			return nil
+		})
+		publisher.EXPECT().PublishExptExportCSVEvent(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event *entity.ExportCSVEvent, _ *time.Duration) error {
+			assert.Equal(t, entity.ExportScenePairwiseRank, event.ExportScene)
+			assert.Equal(t, int64(77), event.ExportID)
+			return nil
+		})
+
+		record, err := svc.SubmitRankTask(context.Background(), param, &entity.Session{UserID: "u1"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(77), record.ID)
+	})
+
+	t.Run("handle finished record is skipped", func(t *testing.T) {
+		recordRepo.EXPECT().GetPairwiseRankRecord(gomock.Any(), spaceID, int64(77)).
+			Return(&entity.ExptPairwiseRankRecord{ID: 77, SpaceID: spaceID, Status: entity.ExptPairwiseRankStatus_Success}, nil)
+		assert.NoError(t, svc.HandleRankEvent(context.Background(), spaceID, 77))
+	})
+
+	t.Run("handle records rank failure", func(t *testing.T) {
+		recordRepo.EXPECT().GetPairwiseRankRecord(gomock.Any(), spaceID, int64(77)).
+			Return(&entity.ExptPairwiseRankRecord{ID: 77, SpaceID: spaceID, Status: entity.ExptPairwiseRankStatus_Running, Param: param}, nil)
+		evaluatorSvc.EXPECT().GetEvaluatorVersion(gomock.Any(), gptr.Of(spaceID), int64(9), false, false).Return(nil, errors.New("db error"))
+		recordRepo.EXPECT().UpdatePairwiseRankResult(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, record *entity.ExptPairwiseRankRecord) error {
+			assert.Equal(t, entity.ExptPairwiseRankStatus_Failed, record.Status)
+			assert.Equal(t, "db error", record.ErrMsg)
+			return nil
+		})
+		assert.NoError(t, svc.HandleRankEvent(context.Background(), spaceID, 77))
+	})
+
+	t.Run("get stale record", func(t *testing.T) {
+		recordRepo.EXPECT().GetPairwiseRankRecord(gomock.Any(), spaceID, int64(77)).Return(&entity.ExptPairwiseRankRecord{
+			ID: 77, SpaceID: spaceID, Status: entity.ExptPairwiseRankStatus_Running, CreatedAt: time.Now().Add(-3 * time.Hour),
+		}, nil)
+		recordRepo.EXPECT().UpdatePairwiseRankResult(gomock.Any(), gomock.Any()).Return(nil)
+
+		record, err := svc.GetRankRecord(context.Background(), spaceID, 77)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.ExptPairwiseRankStatus_Failed, record.Status)
+	})
+}
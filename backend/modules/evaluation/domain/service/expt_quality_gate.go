@@ -0,0 +1,19 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination ./mocks/expt_quality_gate.go --package mocks . ExptQualityGateService
+type ExptQualityGateService interface {
+	// Evaluate 按实验 EvalConf.QualityGateConf 逐条评估门禁规则; 未配置门禁时返回 nil。
+	// 单条规则无法计算时记为 error 而非返回错误, 保证结论总能落库供流水线读取。
+	Evaluate(ctx context.Context, expt *entity.Experiment) (*entity.ExptQualityGateResult, error)
+	// EvaluateAndSave 评估后写入 experiment.quality_gate_result, 并回填 expt.QualityGateResult
+	EvaluateAndSave(ctx context.Context, expt *entity.Experiment) (*entity.ExptQualityGateResult, error)
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+type ExptQualityGateServiceImpl struct {
+	experimentRepo         repo.IExperimentRepo
+	exptStatsRepo          repo.IExptStatsRepo
+	exptTurnResultRepo     repo.IExptTurnResultRepo
+	evaluatorRecordService EvaluatorRecordService
+	scoreCalculator        IEvaluatorScoreCalculator
+	regressionService      ExptRegressionService
+}
+
+func NewExptQualityGateService(
+	experimentRepo repo.IExperimentRepo,
+	exptStatsRepo repo.IExptStatsRepo,
+	exptTurnResultRepo repo.IExptTurnResultRepo,
+	evaluatorRecordService EvaluatorRecordService,
+	scoreCalculator IEvaluatorScoreCalculator,
+	regressionService ExptRegressionService,
+) ExptQualityGateService {
+	return &ExptQualityGateServiceImpl{
+		experimentRepo:         experimentRepo,
+		exptStatsRepo:          exptStatsRepo,
+		exptTurnResultRepo:     exptTurnResultRepo,
+		evaluatorRecordService: evaluatorRecordService,
+		scoreCalculator:        scoreCalculator,
+		regressionService:      regressionService,
+	}
+}
+
+func (e *ExptQualityGateServiceImpl) Evaluate(ctx context.Context, expt *entity.Experiment) (*entity.ExptQualityGateResult, error) {
+	if expt == nil || expt.EvalConf == nil || expt.EvalConf.QualityGateConf.IsEmpty() {
+		return nil, nil
+	}
+
+	// 评估器均分与加权均分共用一次 turn 得分加载
+	var (
+		side    *regressionExptSide
+		sideErr error
+		loaded  bool
+	)
+	loadSide := func() (*regressionExptSide, error) {
+		if !loaded {
+			side, sideErr = loadRegressionExptSide(ctx, e.exptTurnResultRepo, e.evaluatorRecordService, e.scoreCalculator, expt.SpaceID, expt, nil)
+			loaded = true
+		}
+		return side, sideErr
+	}
+
+	ruleResults := make([]*entity.ExptQualityGateRuleResult, 0, len(expt.EvalConf.QualityGateConf.Rules))
+	for _, rule := range expt.EvalConf.QualityGateConf.Rules {
+		ruleResults = append(ruleResults, e.evaluateRule(ctx, expt, rule, loadSide))
+	}
+	res := entity.NewExptQualityGateResult(ruleResults, time.Now().UnixMilli())
+	logs.CtxInfo(ctx, "quality gate evaluated, expt_id: %v, status: %v", expt.ID, res.Status)
+	return res, nil
+}
+
+func (e *ExptQualityGateServiceImpl) EvaluateAndSave(ctx context.Context, expt *entity.Experiment) (*entity.ExptQualityGateResult, error) {
+	res, evalErr := e.Evaluate(ctx, expt)
+	if evalErr != nil {
+		// 评估失败也落一条 error 结论, 避免残留结论被当作本次运行的结果
+		res = entity.NewExptQualityGateErrorResult(evalErr.Error(), time.Now().UnixMilli())
+	}
+	if res == nil {
+		return nil, nil
+	}
+	bytes, err := json.Marshal(res)
+	if err != nil {
+		return nil, errorx.Wrapf(err, "marshal QualityGateResult fail, expt_id: %v", expt.ID)
+	}
+	// 只写 quality_gate_result 单列, 不与实验状态等字段的更新互相覆盖
+	if err = e.experimentRepo.UpdateFields(ctx, expt.ID, map[string]any{"quality_gate_result": &bytes}); err != nil {
+		return nil, err
+	}
+	expt.QualityGateResult = res
+	return res, evalErr
+}
+
+func (e *ExptQualityGateServiceImpl) evaluateRule(ctx context.Context, expt *entity.Experiment, rule *entity.ExptQualityGateRule,
+	loadSide func() (*regressionExptSide, error),
+) *entity.ExptQualityGateRuleResult {
+	res := &entity.ExptQualityGateRuleResult{Rule: rule}
+	if err := rule.Validate(); err != nil {
+		res.Status, res.Message = entity.QualityGateStatusError, err.Error()
+		return res
+	}
+
+	var (
+		actual float64
+		err    error
+	)
+	switch rule.Metric {
+	case entity.QualityGateMetricEvaluatorAvgScore:
+		actual, err = e.evaluatorAvgScore(rule, loadSide)
+	case entity.QualityGateMetricWeightedAvgScore:
+		actual, err = e.weightedAvgScore(loadSide)
+	case entity.QualityGateMetricFailRate:
+		actual, err = e.failRate(ctx, expt)
+	case entity.QualityGateMetricRegressionRate:
+		actual, err = e.regressionRate(ctx, expt, rule)
+	}
+	if err != nil {
+		logs.CtxWarn(ctx, "quality gate rule evaluate fail, expt_id: %v, rule: %v, err: %v", expt.ID, rule.DisplayName(), err)
+		res.Status, res.Message = entity.QualityGateStatusError, err.Error()
+		return res
+	}
+
+	res.Actual = gptr.Of(actual)
+	if rule.Operator.Compare(actual, rule.Threshold) {
+		res.Status = entity.QualityGateStatusPassed
+	} else {
+		res.Status = entity.QualityGateStatusFailed
+		res.Message = fmt.Sprintf("actual %v does not satisfy %s %v", actual, rule.Operator, rule.Threshold)
+	}
+	return res
+}
+
+// evaluatorAvgScore 未指定 alias 时, 若该评估器版本在实验中仅有一个实例则直接使用该实例
+func (e *ExptQualityGateServiceImpl) evaluatorAvgScore(rule *entity.ExptQualityGateRule, loadSide func() (*regressionExptSide, error)) (float64, error) {
+	side, err := loadSide()
+	if err != nil {
+		return 0, err
+	}
+	turnScores, ok := side.scores[entity.EncodeEvaluatorInstanceKey(rule.EvaluatorVersionID, rule.EvaluatorAlias)]
+	if !ok && rule.EvaluatorAlias == "" {
+		var matched []map[entity.ItemTurnID]float64
+		for key, scores := range side.scores {
+			if versionID, _, err := entity.ParseEvaluatorScoreFieldKey(key); err == nil && versionID == rule.EvaluatorVersionID {
+				matched = append(matched, scores)
+			}
+		}
+		if len(matched) == 1 {
+			turnScores, ok = matched[0], true
+		}
+	}
+	if !ok || len(turnScores) == 0 {
+		return 0, fmt.Errorf("no score found for evaluator %s", entity.EncodeEvaluatorInstanceKey(rule.EvaluatorVersionID, rule.EvaluatorAlias))
+	}
+	var sum float64
+	for _, score := range turnScores {
+		sum += score
+	}
+	return sum / float64(len(turnScores)), nil
+}
+
+func (e *ExptQualityGateServiceImpl) weightedAvgScore(loadSide func() (*regressionExptSide, error)) (float64, error) {
+	side, err := loadSide()
+	if err != nil {
+		return 0, err
+	}
+	var (
+		sum float64
+		cnt int
+	)
+	for _, score := range side.weightedScores {
+		if score == nil {
+			continue
+		}
+		sum += *score
+		cnt++
+	}
+	if cnt == 0 {
+		return 0, fmt.Errorf("no weighted score found")
+	}
+	return sum / float64(cnt), nil
+}
+
+func (e *ExptQualityGateServiceImpl) failRate(ctx context.Context, expt *entity.Experiment) (float64, error) {
+	stats, err := e.exptStatsRepo.Get(ctx, expt.ID, expt.SpaceID)
+	if err != nil {
+		return 0, err
+	}
+	if stats == nil {
+		return 0, fmt.Errorf("experiment stats not found")
+	}
+	total := stats.SuccessItemCnt + stats.FailItemCnt + stats.PendingItemCnt + stats.ProcessingItemCnt + stats.TerminatedItemCnt
+	if total <= 0 {
+		return 0, fmt.Errorf("experiment has no item")
+	}
+	return float64(stats.FailItemCnt) / float64(total), nil
+}
+
+// regressionRate 复用回归报告的对齐与变化判定口径, 仅取汇总统计
+func (e *ExptQualityGateServiceImpl) regressionRate(ctx context.Context, expt *entity.Experiment, rule *entity.ExptQualityGateRule) (float64, error) {
+	report, err := e.regressionService.BuildRegressionReport(ctx, &entity.ExptRegressionReportParam{
+		SpaceID:          expt.SpaceID,
+		BaseExptID:       rule.BaselineExptID,
+		CandidateExptIDs: []int64{expt.ID},
+		Threshold:        rule.ScoreTolerance,
+		ChangeTypes:      []entity.ExptRegressionChangeType{entity.ExptRegressionChangeRegressed, entity.ExptRegressionChangeMixed},
+		MaxItemCnt:       1,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if report == nil || len(report.Summaries) == 0 || report.Summaries[0].PairedTurnCnt == 0 {
+		return 0, fmt.Errorf("no turn paired with baseline experiment %d", rule.BaselineExptID)
+	}
+	summary := report.Summaries[0]
+	return float64(summary.RegressedCnt+summary.MixedCnt) / float64(summary.PairedTurnCnt), nil
+}
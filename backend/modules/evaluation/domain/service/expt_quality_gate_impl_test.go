@@ -0,0 +1,184 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repoMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+type qualityGateTestMocks struct {
+	*regressionTestMocks
+	statsRepo     *repoMocks.MockIExptStatsRepo
+	regressionSvc *svcMocks.MockExptRegressionService
+}
+
+func newQualityGateTestService(ctrl *gomock.Controller) (ExptQualityGateService, *qualityGateTestMocks) {
+	m := &qualityGateTestMocks{
+		regressionTestMocks: &regressionTestMocks{
+			exptRepo:      repoMocks.NewMockIExperimentRepo(ctrl),
+			turnRepo:      repoMocks.NewMockIExptTurnResultRepo(ctrl),
+			recordSvc:     svcMocks.NewMockEvaluatorRecordService(ctrl),
+			calculatorSvc: svcMocks.NewMockIEvaluatorScoreCalculator(ctrl),
+		},
+		statsRepo:     repoMocks.NewMockIExptStatsRepo(ctrl),
+		regressionSvc: svcMocks.NewMockExptRegressionService(ctrl),
+	}
+	return NewExptQualityGateService(m.exptRepo, m.statsRepo, m.turnRepo, m.recordSvc, m.calculatorSvc, m.regressionSvc), m
+}
+
+func newQualityGateExpt(spaceID, exptID int64, rules ...*entity.ExptQualityGateRule) *entity.Experiment {
+	return &entity.Experiment{
+		ID:       exptID,
+		SpaceID:  spaceID,
+		EvalConf: &entity.EvaluationConfiguration{QualityGateConf: &entity.ExptQualityGateConf{Rules: rules}},
+	}
+}
+
+func TestExptQualityGateServiceImpl_EvaluateAndSave(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc, m := newQualityGateTestService(ctrl)
+
+	const spaceID, exptID, baselineID, evaluatorVersionID = int64(100), int64(2), int64(1), int64(11)
+	expt := newQualityGateExpt(spaceID, exptID,
+		&entity.ExptQualityGateRule{Metric: entity.QualityGateMetricEvaluatorAvgScore, EvaluatorVersionID: evaluatorVersionID, Operator: entity.QualityGateOperatorGE, Threshold: 0.6},
+		&entity.ExptQualityGateRule{Metric: entity.QualityGateMetricWeightedAvgScore, Operator: entity.QualityGateOperatorGE, Threshold: 0.7},
+		&entity.ExptQualityGateRule{Metric: entity.QualityGateMetricFailRate, Operator: entity.QualityGateOperatorLT, Threshold: 0.02},
+		&entity.ExptQualityGateRule{Metric: entity.QualityGateMetricRegressionRate, BaselineExptID: baselineID, Operator: entity.QualityGateOperatorLE, Threshold: 0, ScoreTolerance: 0.05},
+	)
+
+	// 评估器均分与加权均分只加载一次 turn 得分
+	mockRegressionTurns(m.regressionTestMocks, spaceID, exptID, evaluatorVersionID, []float64{0.5, 0.6, 0.8}, []*float64{gptr.Of(0.5), gptr.Of(0.6), gptr.Of(0.8)})
+	m.statsRepo.EXPECT().Get(gomock.Any(), exptID, spaceID).Return(&entity.ExptStats{SuccessItemCnt: 3}, nil)
+	m.regressionSvc.EXPECT().BuildRegressionReport(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, param *entity.ExptRegressionReportParam) (*entity.ExptRegressionReport, error) {
+			assert.Equal(t, baselineID, param.BaseExptID)
+			assert.Equal(t, []int64{exptID}, param.CandidateExptIDs)
+			assert.Equal(t, 0.05, param.Threshold)
+			return &entity.ExptRegressionReport{Summaries: []*entity.ExptRegressionSummary{
+				{CandidateExptID: exptID, PairedTurnCnt: 4, RegressedCnt: 1, ImprovedCnt: 2, UnchangedCnt: 1},
+			}}, nil
+		})
+	m.exptRepo.EXPECT().UpdateFields(gomock.Any(), exptID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int64, ufields map[string]any) error {
+			bytes, ok := ufields["quality_gate_result"].(*[]byte)
+			assert.True(t, ok)
+			persisted := new(entity.ExptQualityGateResult)
+			assert.NoError(t, json.Unmarshal(*bytes, persisted))
+			assert.Equal(t, entity.QualityGateStatusFailed, persisted.Status)
+			return nil
+		})
+
+	res, err := svc.EvaluateAndSave(context.Background(), expt)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.QualityGateStatusFailed, res.Status)
+	assert.False(t, res.Passed)
+	assert.Same(t, res, expt.QualityGateResult)
+	if assert.Len(t, res.RuleResults, 4) {
+		assert.Equal(t, entity.QualityGateStatusPassed, res.RuleResults[0].Status)
+		assert.InDelta(t, 0.6333, *res.RuleResults[0].Actual, 1e-3)
+		assert.Equal(t, entity.QualityGateStatusFailed, res.RuleResults[1].Status)
+		assert.Equal(t, entity.QualityGateStatusPassed, res.RuleResults[2].Status)
+		assert.InDelta(t, 0, *res.RuleResults[2].Actual, 1e-9)
+		assert.Equal(t, entity.QualityGateStatusFailed, res.RuleResults[3].Status)
+		assert.InDelta(t, 0.25, *res.RuleResults[3].Actual, 1e-9)
+	}
+}
+
+func TestExptQualityGateServiceImpl_Evaluate(t *testing.T) {
+	const spaceID, exptID = int64(100), int64(2)
+
+	t.Run("未配置门禁", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		svc, _ := newQualityGateTestService(ctrl)
+
+		res, err := svc.Evaluate(context.Background(), &entity.Experiment{ID: exptID, SpaceID: spaceID})
+		assert.NoError(t, err)
+		assert.Nil(t, res)
+		res, err = svc.EvaluateAndSave(context.Background(), newQualityGateExpt(spaceID, exptID))
+		assert.NoError(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("全部通过", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		svc, m := newQualityGateTestService(ctrl)
+
+		m.statsRepo.EXPECT().Get(gomock.Any(), exptID, spaceID).Return(&entity.ExptStats{SuccessItemCnt: 99, FailItemCnt: 1}, nil)
+		res, err := svc.Evaluate(context.Background(), newQualityGateExpt(spaceID, exptID,
+			&entity.ExptQualityGateRule{Metric: entity.QualityGateMetricFailRate, Operator: entity.QualityGateOperatorLT, Threshold: 0.02}))
+		assert.NoError(t, err)
+		assert.Equal(t, entity.QualityGateStatusPassed, res.Status)
+		assert.True(t, res.Passed)
+	})
+
+	t.Run("无法计算的规则记为error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		svc, m := newQualityGateTestService(ctrl)
+
+		m.statsRepo.EXPECT().Get(gomock.Any(), exptID, spaceID).Return(nil, errors.New("db error"))
+		m.regressionSvc.EXPECT().BuildRegressionReport(gomock.Any(), gomock.Any()).Return(&entity.ExptRegressionReport{
+			Summaries: []*entity.ExptRegressionSummary{{CandidateExptID: exptID}},
+		}, nil)
+		res, err := svc.Evaluate(context.Background(), newQualityGateExpt(spaceID, exptID,
+			&entity.ExptQualityGateRule{Metric: entity.QualityGateMetricFailRate, Operator: entity.QualityGateOperatorLT, Threshold: 0.02},
+			&entity.ExptQualityGateRule{Metric: entity.QualityGateMetricRegressionRate, BaselineExptID: 1, Operator: entity.QualityGateOperatorLE},
+			&entity.ExptQualityGateRule{Metric: entity.QualityGateMetricEvaluatorAvgScore, Operator: entity.QualityGateOperatorGE, Threshold: 0.8},
+		))
+		assert.NoError(t, err)
+		assert.Equal(t, entity.QualityGateStatusError, res.Status)
+		assert.False(t, res.Passed)
+		for _, rr := range res.RuleResults {
+			assert.Equal(t, entity.QualityGateStatusError, rr.Status)
+			assert.Nil(t, rr.Actual)
+			assert.NotEmpty(t, rr.Message)
+		}
+	})
+
+	t.Run("未指定alias时匹配唯一实例", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		svc, m := newQualityGateTestService(ctrl)
+
+		m.turnRepo.EXPECT().ScanTurnResults(gomock.Any(), exptID, gomock.Any(), int64(0), int64(500), spaceID).Return([]*entity.ExptTurnResult{
+			{ID: 1, ExptID: exptID, ItemID: 1, TurnID: 1, WeightedScore: gptr.Of(0.9)},
+		}, int64(1), nil)
+		m.turnRepo.EXPECT().ScanTurnResults(gomock.Any(), exptID, gomock.Any(), int64(1), int64(500), spaceID).Return(nil, int64(1), nil)
+		m.turnRepo.EXPECT().GetTurnEvaluatorResultRefByExptID(gomock.Any(), spaceID, exptID).Return([]*entity.ExptTurnEvaluatorResultRef{
+			{ExptTurnResultID: 1, EvaluatorVersionID: 11, Alias: "judge_b", EvaluatorResultID: 101},
+		}, nil)
+		m.recordSvc.EXPECT().BatchGetEvaluatorRecordForAggr(gomock.Any(), []int64{101}).Return([]*entity.EvaluatorRecordAggr{
+			{ID: 101, Status: entity.EvaluatorRunStatusSuccess, Score: gptr.Of(0.85)},
+		}, nil)
+
+		res, err := svc.Evaluate(context.Background(), newQualityGateExpt(spaceID, exptID,
+			&entity.ExptQualityGateRule{Metric: entity.QualityGateMetricEvaluatorAvgScore, EvaluatorVersionID: 11, Operator: entity.QualityGateOperatorGE, Threshold: 0.8}))
+		assert.NoError(t, err)
+		assert.True(t, res.Passed)
+		assert.InDelta(t, 0.85, *res.RuleResults[0].Actual, 1e-9)
+	})
+}
+
+func TestQualityGateOperator_Compare(t *testing.T) {
+	assert.True(t, entity.QualityGateOperatorGE.Compare(0.8, 0.8))
+	assert.False(t, entity.QualityGateOperatorGT.Compare(0.8, 0.8))
+	assert.True(t, entity.QualityGateOperatorLE.Compare(0.01, 0.02))
+	assert.False(t, entity.QualityGateOperatorLT.Compare(0.02, 0.02))
+	assert.True(t, entity.QualityGateOperatorEQ.Compare(0.1+0.2, 0.3))
+	assert.False(t, entity.QualityGateOperator("!=").Compare(1, 2))
+}
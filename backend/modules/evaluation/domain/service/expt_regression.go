@@ -0,0 +1,17 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination ./mocks/expt_regression.go --package mocks . ExptRegressionService
+type ExptRegressionService interface {
+	// BuildRegressionReport 以基线实验为参照, 按 (item_id, turn_id) 对齐候选实验, 返回加权得分或任一评估器得分
+	// 变化超过阈值的 turn, 并附带两侧评测对象输出。基线侧可复用实验详情页的 turn 结果筛选条件。
+	BuildRegressionReport(ctx context.Context, param *entity.ExptRegressionReportParam) (*entity.ExptRegressionReport, error)
+}
@@ -0,0 +1,254 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/infra/fileserver"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// ExportRegressionReport 表格格式（CSV/XLSX/Parquet）每个 (turn, 候选实验) 一行，基线与候选的得分、输出并排成列；
+// JSONL 每个 turn 一行，保留完整的 Content 结构。
+func (e ExptResultExportService) ExportRegressionReport(ctx context.Context, report *entity.ExptRegressionReport, format entity.ExptResultExportFormat, w io.Writer) error {
+	if report == nil {
+		return fmt.Errorf("regression report is nil")
+	}
+	if format.OrDefault() == entity.ExptResultExportFormatJSONL {
+		return writeRegressionReportJSONL(report, w)
+	}
+
+	writer, err := newExptResultFileWriter(format, w, nil)
+	if err != nil {
+		return err
+	}
+	evaluatorKeys, outputKeys := collectRegressionReportKeys(report)
+	if err = writer.writeHeader(buildRegressionExportColumns(evaluatorKeys, outputKeys)); err != nil {
+		return err
+	}
+	rows, err := buildRegressionExportRows(ctx, report, evaluatorKeys, outputKeys)
+	if err != nil {
+		return err
+	}
+	if err = writer.writeRows(rows); err != nil {
+		return err
+	}
+	return writer.close()
+}
+
+// UploadRegressionReport 导出回归报告并上传对象存储，返回 24 小时有效的下载链接。
+// 报告规模受 MaxItemCnt 限制，直接在内存中生成，不走导出记录 + MQ 的异步流程。
+func (e ExptResultExportService) UploadRegressionReport(ctx context.Context, report *entity.ExptRegressionReport, format entity.ExptResultExportFormat) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := e.ExportRegressionReport(ctx, report, format, buf); err != nil {
+		return "", err
+	}
+
+	// 文件名为：回归报告_{基线实验ID}_{导出时间}.{格式扩展名}
+	fileName := fmt.Sprintf("回归报告_%d_%s.%s", report.BaseExptID, time.Now().Format("20060102150405"), format.OrDefault().FileExt())
+	uploadCtx, cancel := context.WithTimeout(ctx, 5*60*time.Second)
+	defer cancel()
+	if err := e.fileClient.Upload(uploadCtx, fileName, buf); err != nil {
+		logs.CtxError(ctx, "upload regression report failed, err: %v", err)
+		return "", err
+	}
+
+	signURL, _, err := e.fileClient.SignDownloadReq(ctx, fileName, fileserver.SignWithTTL(24*time.Hour))
+	if err != nil {
+		return "", err
+	}
+	return e.urlProcessor.ProcessSignURL(ctx, signURL), nil
+}
+
+// collectRegressionReportKeys 报告中出现过的评估器实例 key 与输出字段 key，排序保证列顺序稳定
+func collectRegressionReportKeys(report *entity.ExptRegressionReport) (evaluatorKeys, outputKeys []string) {
+	evaluatorKeySet, outputKeySet := make(map[string]bool), make(map[string]bool)
+	for _, item := range report.Items {
+		for _, result := range item.AllResults() {
+			for key := range result.EvaluatorScores {
+				evaluatorKeySet[key] = true
+			}
+			for key := range result.TargetOutputs {
+				outputKeySet[key] = true
+			}
+		}
+	}
+	for key := range evaluatorKeySet {
+		evaluatorKeys = append(evaluatorKeys, key)
+	}
+	for key := range outputKeySet {
+		outputKeys = append(outputKeys, key)
+	}
+	sort.Strings(evaluatorKeys)
+	sort.Strings(outputKeys)
+	return evaluatorKeys, outputKeys
+}
+
+func buildRegressionExportColumns(evaluatorKeys, outputKeys []string) []*exportColumn {
+	columns := []*exportColumn{
+		{name: "itemID", group: exportColumnGroupItem, kind: exportValueKindInt},
+		{name: "turnID", group: exportColumnGroupItem, kind: exportValueKindInt},
+		{name: "candidateExptID", group: exportColumnGroupItem, kind: exportValueKindInt},
+		{name: "change", group: exportColumnGroupItem, kind: exportValueKindString},
+		{name: "weightedScore.base", group: exportColumnGroupWeightedScore, kind: exportValueKindFloat},
+		{name: "weightedScore.candidate", group: exportColumnGroupWeightedScore, kind: exportValueKindFloat},
+		{name: "weightedScore.delta", group: exportColumnGroupWeightedScore, kind: exportValueKindFloat},
+	}
+	for _, key := range evaluatorKeys {
+		for _, suffix := range []string{"base", "candidate", "delta"} {
+			columns = append(columns, &exportColumn{
+				name:         fmt.Sprintf("evaluator_%s.%s", key, suffix),
+				group:        exportColumnGroupEvaluatorScore,
+				kind:         exportValueKindFloat,
+				evaluatorKey: key,
+			})
+		}
+	}
+	for _, key := range outputKeys {
+		for _, suffix := range []string{"base", "candidate"} {
+			columns = append(columns, &exportColumn{name: fmt.Sprintf("%s.%s", key, suffix), group: exportColumnGroupTarget, kind: exportValueKindContent})
+		}
+	}
+	return columns
+}
+
+func buildRegressionExportRows(ctx context.Context, report *entity.ExptRegressionReport, evaluatorKeys, outputKeys []string) ([]*exportRow, error) {
+	helper := &exportCSVHelper{}
+	rows := make([]*exportRow, 0, len(report.Items))
+	for _, item := range report.Items {
+		for _, candidate := range item.Candidates {
+			if candidate == nil || candidate.ExptRegressionTurnResult == nil {
+				continue
+			}
+			row := &exportRow{itemID: item.ItemID, turnID: item.TurnID}
+			row.cells = append(row.cells,
+				intExportCell(item.ItemID),
+				intExportCell(item.TurnID),
+				intExportCell(candidate.ExptID),
+				stringExportCell(string(candidate.Change)),
+				floatExportCell(item.Base.WeightedScore),
+				floatExportCell(candidate.WeightedScore),
+				floatExportCell(candidate.WeightedScoreDelta),
+			)
+			for _, key := range evaluatorKeys {
+				baseScore, baseOK := item.Base.EvaluatorScores[key]
+				candidateScore, candidateOK := candidate.EvaluatorScores[key]
+				var delta *float64
+				if baseOK && candidateOK {
+					d := candidateScore - baseScore
+					delta = &d
+				}
+				row.cells = append(row.cells,
+					floatExportCell(optionalScore(baseScore, baseOK)),
+					floatExportCell(optionalScore(candidateScore, candidateOK)),
+					floatExportCell(delta),
+				)
+			}
+			for _, key := range outputKeys {
+				for _, content := range []*entity.Content{item.Base.TargetOutputs[key], candidate.TargetOutputs[key]} {
+					cell, err := helper.contentExportCell(ctx, content)
+					if err != nil {
+						return nil, err
+					}
+					row.cells = append(row.cells, cell)
+				}
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func optionalScore(score float64, ok bool) *float64 {
+	if !ok {
+		return nil
+	}
+	return &score
+}
+
+// floatExportCell 文本沿用导出分数两位小数的口径，value 保留完整精度
+func floatExportCell(v *float64) exportCell {
+	if v == nil {
+		return exportCell{}
+	}
+	return exportCell{text: strconv.FormatFloat(*v, 'f', 2, 64), value: *v}
+}
+
+type regressionJSONLRow struct {
+	ItemID     int64                             `json:"item_id"`
+	TurnID     int64                             `json:"turn_id"`
+	Base       *regressionJSONLResult            `json:"base"`
+	Candidates []*regressionJSONLCandidateResult `json:"candidates"`
+}
+
+type regressionJSONLResult struct {
+	ExptID          int64                      `json:"expt_id"`
+	WeightedScore   *float64                   `json:"weighted_score,omitempty"`
+	EvaluatorScores map[string]float64         `json:"evaluator_scores,omitempty"`
+	TargetOutputs   map[string]*entity.Content `json:"target_outputs,omitempty"`
+}
+
+type regressionJSONLCandidateResult struct {
+	*regressionJSONLResult
+	Change             string             `json:"change,omitempty"`
+	WeightedScoreDelta *float64           `json:"weighted_score_delta,omitempty"`
+	EvaluatorDeltas    map[string]float64 `json:"evaluator_deltas,omitempty"`
+}
+
+func newRegressionJSONLResult(result *entity.ExptRegressionTurnResult) *regressionJSONLResult {
+	if result == nil {
+		return nil
+	}
+	return &regressionJSONLResult{
+		ExptID:          result.ExptID,
+		WeightedScore:   result.WeightedScore,
+		EvaluatorScores: result.EvaluatorScores,
+		TargetOutputs:   result.TargetOutputs,
+	}
+}
+
+func writeRegressionReportJSONL(report *entity.ExptRegressionReport, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, item := range report.Items {
+		row := &regressionJSONLRow{ItemID: item.ItemID, TurnID: item.TurnID, Base: newRegressionJSONLResult(item.Base)}
+		for _, candidate := range item.Candidates {
+			if candidate == nil {
+				continue
+			}
+			c := &regressionJSONLCandidateResult{
+				regressionJSONLResult: newRegressionJSONLResult(candidate.ExptRegressionTurnResult),
+				Change:                string(candidate.Change),
+				WeightedScoreDelta:    candidate.WeightedScoreDelta,
+			}
+			for _, delta := range candidate.EvaluatorDeltas {
+				if c.EvaluatorDeltas == nil {
+					c.EvaluatorDeltas = make(map[string]float64, len(candidate.EvaluatorDeltas))
+				}
+				c.EvaluatorDeltas[entity.EncodeEvaluatorInstanceKey(delta.EvaluatorVersionID, delta.Alias)] = delta.Delta
+			}
+			row.Candidates = append(row.Candidates, c)
+		}
+		bytes, err := json.Marshal(row)
+		if err != nil {
+			return err
+		}
+		if _, err = bw.Write(bytes); err != nil {
+			return err
+		}
+		if err = bw.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,494 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/bytedance/gg/gslice"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+type ExptRegressionServiceImpl struct {
+	experimentRepo         repo.IExperimentRepo
+	exptTurnResultRepo     repo.IExptTurnResultRepo
+	exptResultService      ExptResultService
+	evaluatorRecordService EvaluatorRecordService
+	evalTargetService      IEvalTargetService
+	scoreCalculator        IEvaluatorScoreCalculator
+}
+
+func NewExptRegressionService(
+	experimentRepo repo.IExperimentRepo,
+	exptTurnResultRepo repo.IExptTurnResultRepo,
+	exptResultService ExptResultService,
+	evaluatorRecordService EvaluatorRecordService,
+	evalTargetService IEvalTargetService,
+	scoreCalculator IEvaluatorScoreCalculator,
+) ExptRegressionService {
+	return &ExptRegressionServiceImpl{
+		experimentRepo:         experimentRepo,
+		exptTurnResultRepo:     exptTurnResultRepo,
+		exptResultService:      exptResultService,
+		evaluatorRecordService: evaluatorRecordService,
+		evalTargetService:      evalTargetService,
+		scoreCalculator:        scoreCalculator,
+	}
+}
+
+// regressionExptSide 单个实验参与对比所需的 turn 结果与得分
+type regressionExptSide struct {
+	expt           *entity.Experiment
+	turns          map[entity.ItemTurnID]*entity.ExptTurnResult
+	scores         exptTurnScores
+	weightedScores map[entity.ItemTurnID]*float64
+}
+
+func (e *ExptRegressionServiceImpl) BuildRegressionReport(ctx context.Context, param *entity.ExptRegressionReportParam) (*entity.ExptRegressionReport, error) {
+	if err := e.validateParam(param); err != nil {
+		return nil, err
+	}
+
+	exptIDs := append([]int64{param.BaseExptID}, param.CandidateExptIDs...)
+	expts, err := e.experimentRepo.MGetByID(ctx, exptIDs, param.SpaceID)
+	if err != nil {
+		return nil, err
+	}
+	exptMap := gslice.ToMap(expts, func(expt *entity.Experiment) (int64, *entity.Experiment) { return expt.ID, expt })
+	base, ok := exptMap[param.BaseExptID]
+	if !ok {
+		return nil, errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("base experiment %d not found", param.BaseExptID)))
+	}
+	for _, candidateID := range param.CandidateExptIDs {
+		candidate, ok := exptMap[candidateID]
+		if !ok {
+			return nil, errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("candidate experiment %d not found", candidateID)))
+		}
+		if base.EvalSetID > 0 && candidate.EvalSetID > 0 && base.EvalSetID != candidate.EvalSetID {
+			return nil, errorx.NewByCode(errno.CommonInvalidParamCode,
+				errorx.WithExtraMsg(fmt.Sprintf("experiment %d uses eval set %d, different from base eval set %d", candidateID, candidate.EvalSetID, base.EvalSetID)))
+		}
+	}
+
+	var baseItemTurns map[entity.ItemTurnID]bool
+	if param.HasFilter() {
+		if baseItemTurns, err = e.listFilteredItemTurns(ctx, param, base); err != nil {
+			return nil, err
+		}
+	}
+
+	baseSide, err := e.loadExptSide(ctx, param.SpaceID, base, baseItemTurns)
+	if err != nil {
+		return nil, err
+	}
+	candidateSides := make([]*regressionExptSide, 0, len(param.CandidateExptIDs))
+	for _, candidateID := range param.CandidateExptIDs {
+		side, err := e.loadExptSide(ctx, param.SpaceID, exptMap[candidateID], baseItemTurns)
+		if err != nil {
+			return nil, err
+		}
+		candidateSides = append(candidateSides, side)
+	}
+
+	report := buildRegressionReport(baseSide, candidateSides, param)
+	if err = e.fillTargetOutputs(ctx, param, report, append([]*regressionExptSide{baseSide}, candidateSides...)); err != nil {
+		return nil, err
+	}
+
+	logs.CtxInfo(ctx, "BuildRegressionReport done, space_id: %v, base_expt_id: %v, candidate_expt_ids: %v, items: %v, truncated: %v",
+		param.SpaceID, param.BaseExptID, param.CandidateExptIDs, len(report.Items), report.Truncated)
+	return report, nil
+}
+
+func (e *ExptRegressionServiceImpl) validateParam(param *entity.ExptRegressionReportParam) error {
+	if param == nil || param.SpaceID <= 0 || param.BaseExptID <= 0 {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("space_id and base_expt_id are required"))
+	}
+	if len(param.CandidateExptIDs) == 0 || len(param.CandidateExptIDs) > entity.MaxRegressionCandidateExptCnt {
+		return errorx.NewByCode(errno.CommonInvalidParamCode,
+			errorx.WithExtraMsg(fmt.Sprintf("candidate experiment count must be in [1, %d]", entity.MaxRegressionCandidateExptCnt)))
+	}
+	seen := map[int64]bool{param.BaseExptID: true}
+	for _, id := range param.CandidateExptIDs {
+		if id <= 0 || seen[id] {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("invalid or duplicated candidate experiment id %d", id)))
+		}
+		seen[id] = true
+	}
+	if param.Threshold < 0 || math.IsNaN(param.Threshold) {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("threshold must not be negative"))
+	}
+	for _, ct := range param.ChangeTypes {
+		switch ct {
+		case entity.ExptRegressionChangeImproved, entity.ExptRegressionChangeRegressed, entity.ExptRegressionChangeMixed:
+		default:
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("invalid change type %q", ct)))
+		}
+	}
+	return nil
+}
+
+// listFilteredItemTurns 复用实验详情页的 turn 结果筛选 (ExptTurnResultFilter / CK accelerator), 得到基线实验命中的 turn
+func (e *ExptRegressionServiceImpl) listFilteredItemTurns(ctx context.Context, param *entity.ExptRegressionReportParam, base *entity.Experiment) (map[entity.ItemTurnID]bool, error) {
+	const (
+		pageSize = 200
+		maxPages = 500
+	)
+
+	res := make(map[entity.ItemTurnID]bool)
+	for page := 1; page <= maxPages; page++ {
+		mgetParam := &entity.MGetExperimentResultParam{
+			SpaceID:        param.SpaceID,
+			ExptIDs:        []int64{base.ID},
+			BaseExptID:     gptr.Of(base.ID),
+			UseAccelerator: param.UseAccelerator,
+			Page:           entity.NewPage(page, pageSize),
+		}
+		if param.UseAccelerator {
+			// ListTurnResult 会把筛选条件原地翻译为 CK 列名, 每页都需要一份未翻译的副本
+			mgetParam.FilterAccelerators = map[int64]*entity.ExptTurnResultFilterAccelerator{base.ID: cloneFilterAccelerator(param.FilterAccelerator)}
+		} else {
+			mgetParam.Filters = map[int64]*entity.ExptTurnResultFilter{base.ID: param.Filter}
+		}
+
+		turnResults, _, total, err := e.exptResultService.ListTurnResult(ctx, mgetParam, base)
+		if err != nil {
+			return nil, err
+		}
+		for _, tr := range turnResults {
+			res[entity.ItemTurnID{ItemID: tr.ItemID, TurnID: tr.TurnID}] = true
+		}
+		if len(turnResults) == 0 || int64(page*pageSize) >= total {
+			break
+		}
+	}
+	return res, nil
+}
+
+// cloneFilterAccelerator 深拷贝筛选条件: CK 查询会原地改写 FieldFilter.Key (转义 / 翻译列名), 共享元素会被重复改写
+func cloneFilterAccelerator(filter *entity.ExptTurnResultFilterAccelerator) *entity.ExptTurnResultFilterAccelerator {
+	if filter == nil {
+		return nil
+	}
+	cloned := *filter
+	cloned.EvaluatorScoreCorrected = cloneFieldFilter(filter.EvaluatorScoreCorrected)
+	cloned.ItemIDs = cloneFieldFilters(filter.ItemIDs)
+	cloned.ItemRunStatus = cloneFieldFilters(filter.ItemRunStatus)
+	cloned.TurnRunStatus = cloneFieldFilters(filter.TurnRunStatus)
+	if filter.MapCond != nil {
+		cloned.MapCond = &entity.ExptTurnResultFilterMapCond{
+			EvalTargetDataFilters:        cloneFieldFilters(filter.MapCond.EvalTargetDataFilters),
+			EvaluatorScoreFilters:        cloneFieldFilters(filter.MapCond.EvaluatorScoreFilters),
+			EvaluatorWeightedScoreFilter: cloneFieldFilter(filter.MapCond.EvaluatorWeightedScoreFilter),
+			AnnotationFloatFilters:       cloneFieldFilters(filter.MapCond.AnnotationFloatFilters),
+			AnnotationBoolFilters:        cloneFieldFilters(filter.MapCond.AnnotationBoolFilters),
+			AnnotationStringFilters:      cloneFieldFilters(filter.MapCond.AnnotationStringFilters),
+			EvalTargetMetricsFilters:     cloneFieldFilters(filter.MapCond.EvalTargetMetricsFilters),
+		}
+	}
+	cloned.ItemSnapshotCond = cloneItemSnapshotFilter(filter.ItemSnapshotCond)
+	if filter.KeywordSearch != nil {
+		cloned.KeywordSearch = &entity.KeywordFilter{
+			ItemSnapshotFilter:    cloneItemSnapshotFilter(filter.KeywordSearch.ItemSnapshotFilter),
+			EvalTargetDataFilters: cloneFieldFilters(filter.KeywordSearch.EvalTargetDataFilters),
+			Keyword:               filter.KeywordSearch.Keyword,
+		}
+	}
+	return &cloned
+}
+
+func cloneItemSnapshotFilter(filter *entity.ItemSnapshotFilter) *entity.ItemSnapshotFilter {
+	if filter == nil {
+		return nil
+	}
+	return &entity.ItemSnapshotFilter{
+		BoolMapFilters:   cloneFieldFilters(filter.BoolMapFilters),
+		FloatMapFilters:  cloneFieldFilters(filter.FloatMapFilters),
+		IntMapFilters:    cloneFieldFilters(filter.IntMapFilters),
+		StringMapFilters: cloneFieldFilters(filter.StringMapFilters),
+	}
+}
+
+func cloneFieldFilters(filters []*entity.FieldFilter) []*entity.FieldFilter {
+	if filters == nil {
+		return nil
+	}
+	res := make([]*entity.FieldFilter, 0, len(filters))
+	for _, f := range filters {
+		res = append(res, cloneFieldFilter(f))
+	}
+	return res
+}
+
+func cloneFieldFilter(filter *entity.FieldFilter) *entity.FieldFilter {
+	if filter == nil {
+		return nil
+	}
+	return &entity.FieldFilter{
+		Key:    filter.Key,
+		Op:     filter.Op,
+		Values: append([]any(nil), filter.Values...),
+	}
+}
+
+func (e *ExptRegressionServiceImpl) loadExptSide(ctx context.Context, spaceID int64, expt *entity.Experiment, itemTurns map[entity.ItemTurnID]bool) (*regressionExptSide, error) {
+	return loadRegressionExptSide(ctx, e.exptTurnResultRepo, e.evaluatorRecordService, e.scoreCalculator, spaceID, expt, itemTurns)
+}
+
+// loadRegressionExptSide 加载实验的 turn 结果与评估器得分; itemTurns 非空时仅保留其中的 turn。
+// 加权得分优先取落库值, 历史数据未落库时用 IEvaluatorScoreCalculator 按实验权重配置补算。
+func loadRegressionExptSide(ctx context.Context, turnResultRepo repo.IExptTurnResultRepo, recordService EvaluatorRecordService,
+	scoreCalculator IEvaluatorScoreCalculator, spaceID int64, expt *entity.Experiment, itemTurns map[entity.ItemTurnID]bool,
+) (*regressionExptSide, error) {
+	turnResults, err := scanExptTurnResults(ctx, turnResultRepo, spaceID, expt.ID)
+	if err != nil {
+		return nil, err
+	}
+	if itemTurns != nil {
+		turnResults = gslice.Filter(turnResults, func(tr *entity.ExptTurnResult) bool {
+			return itemTurns[entity.ItemTurnID{ItemID: tr.ItemID, TurnID: tr.TurnID}]
+		})
+	}
+	scores, err := loadExptTurnScoresByTurns(ctx, turnResultRepo, recordService, spaceID, expt.ID, turnResults)
+	if err != nil {
+		return nil, err
+	}
+
+	side := &regressionExptSide{
+		expt:           expt,
+		turns:          make(map[entity.ItemTurnID]*entity.ExptTurnResult, len(turnResults)),
+		scores:         scores,
+		weightedScores: make(map[entity.ItemTurnID]*float64, len(turnResults)),
+	}
+	scoreWeights := buildScoreWeights(expt)
+	for _, tr := range turnResults {
+		itemTurn := entity.ItemTurnID{ItemID: tr.ItemID, TurnID: tr.TurnID}
+		side.turns[itemTurn] = tr
+		if tr.WeightedScore != nil {
+			side.weightedScores[itemTurn] = tr.WeightedScore
+			continue
+		}
+		if version2Record := scores.turnRecords(itemTurn); len(version2Record) > 0 {
+			side.weightedScores[itemTurn] = scoreCalculator.CalculateWeightedScore(ctx, expt, version2Record, scoreWeights)
+		}
+	}
+	return side, nil
+}
+
+// turnRecords 以得分构造评估器记录, 仅供加权得分计算使用
+func (s exptTurnScores) turnRecords(itemTurn entity.ItemTurnID) map[string]*entity.EvaluatorRecord {
+	var res map[string]*entity.EvaluatorRecord
+	for key, turns := range s {
+		score, ok := turns[itemTurn]
+		if !ok {
+			continue
+		}
+		versionID, alias, err := entity.ParseEvaluatorScoreFieldKey(key)
+		if err != nil {
+			continue
+		}
+		if res == nil {
+			res = make(map[string]*entity.EvaluatorRecord)
+		}
+		res[key] = &entity.EvaluatorRecord{
+			EvaluatorVersionID: versionID,
+			Alias:              alias,
+			Status:             entity.EvaluatorRunStatusSuccess,
+			EvaluatorOutputData: &entity.EvaluatorOutputData{
+				EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(score)},
+			},
+		}
+	}
+	return res
+}
+
+// buildRegressionReport 逐 turn 对比基线与各候选实验, 汇总变化统计并挑出发生变化的 turn
+func buildRegressionReport(base *regressionExptSide, candidates []*regressionExptSide, param *entity.ExptRegressionReportParam) *entity.ExptRegressionReport {
+	report := &entity.ExptRegressionReport{
+		BaseExptID: base.expt.ID,
+		Summaries:  make([]*entity.ExptRegressionSummary, 0, len(candidates)),
+	}
+	for _, candidate := range candidates {
+		report.Summaries = append(report.Summaries, &entity.ExptRegressionSummary{CandidateExptID: candidate.expt.ID})
+	}
+
+	itemTurns := make([]entity.ItemTurnID, 0, len(base.turns))
+	for itemTurn := range base.turns {
+		itemTurns = append(itemTurns, itemTurn)
+	}
+	sort.Slice(itemTurns, func(i, j int) bool {
+		if itemTurns[i].ItemID != itemTurns[j].ItemID {
+			return itemTurns[i].ItemID < itemTurns[j].ItemID
+		}
+		return itemTurns[i].TurnID < itemTurns[j].TurnID
+	})
+
+	wantChange := make(map[entity.ExptRegressionChangeType]bool, len(param.ChangeTypes))
+	for _, ct := range param.ChangeTypes {
+		wantChange[ct] = true
+	}
+	maxItemCnt := param.GetMaxItemCnt()
+	for _, itemTurn := range itemTurns {
+		item := &entity.ExptRegressionItem{
+			ItemID: itemTurn.ItemID,
+			TurnID: itemTurn.TurnID,
+			Base:   base.turnResult(itemTurn),
+		}
+		hit := false
+		for i, candidate := range candidates {
+			if _, ok := candidate.turns[itemTurn]; !ok {
+				continue
+			}
+			candidateResult := compareRegressionTurn(item.Base, candidate.turnResult(itemTurn), param.Threshold)
+			item.Candidates = append(item.Candidates, candidateResult)
+
+			summary := report.Summaries[i]
+			summary.PairedTurnCnt++
+			switch candidateResult.Change {
+			case entity.ExptRegressionChangeImproved:
+				summary.ImprovedCnt++
+			case entity.ExptRegressionChangeRegressed:
+				summary.RegressedCnt++
+			case entity.ExptRegressionChangeMixed:
+				summary.MixedCnt++
+			default:
+				summary.UnchangedCnt++
+			}
+			if candidateResult.Change != "" && (len(wantChange) == 0 || wantChange[candidateResult.Change]) {
+				hit = true
+			}
+		}
+		if !hit {
+			continue
+		}
+		if len(report.Items) >= maxItemCnt {
+			report.Truncated = true
+			continue
+		}
+		report.Items = append(report.Items, item)
+	}
+	return report
+}
+
+func (s *regressionExptSide) turnResult(itemTurn entity.ItemTurnID) *entity.ExptRegressionTurnResult {
+	res := &entity.ExptRegressionTurnResult{
+		ExptID:          s.expt.ID,
+		WeightedScore:   s.weightedScores[itemTurn],
+		EvaluatorScores: make(map[string]float64),
+	}
+	for key, turns := range s.scores {
+		if score, ok := turns[itemTurn]; ok {
+			res.EvaluatorScores[key] = score
+		}
+	}
+	return res
+}
+
+// compareRegressionTurn 计算候选相对基线的得分差; 加权得分与各评估器得分中, 超过阈值的变化全部同向才判为提升/退化
+func compareRegressionTurn(base, candidate *entity.ExptRegressionTurnResult, threshold float64) *entity.ExptRegressionCandidateResult {
+	res := &entity.ExptRegressionCandidateResult{ExptRegressionTurnResult: candidate}
+	var up, down bool
+	mark := func(delta float64) bool {
+		if math.Abs(delta) <= threshold {
+			return false
+		}
+		if delta > 0 {
+			up = true
+		} else {
+			down = true
+		}
+		return true
+	}
+
+	if base.WeightedScore != nil && candidate.WeightedScore != nil {
+		delta := *candidate.WeightedScore - *base.WeightedScore
+		res.WeightedScoreDelta = gptr.Of(delta)
+		mark(delta)
+	}
+
+	keys := make([]string, 0, len(base.EvaluatorScores))
+	for key := range base.EvaluatorScores {
+		if _, ok := candidate.EvaluatorScores[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		versionID, alias, err := entity.ParseEvaluatorScoreFieldKey(key)
+		if err != nil {
+			continue
+		}
+		baseScore, candidateScore := base.EvaluatorScores[key], candidate.EvaluatorScores[key]
+		delta := candidateScore - baseScore
+		res.EvaluatorDeltas = append(res.EvaluatorDeltas, &entity.ExptRegressionEvaluatorDelta{
+			EvaluatorVersionID: versionID,
+			Alias:              alias,
+			BaseScore:          baseScore,
+			CandidateScore:     candidateScore,
+			Delta:              delta,
+			Changed:            mark(delta),
+		})
+	}
+
+	switch {
+	case up && down:
+		res.Change = entity.ExptRegressionChangeMixed
+	case up:
+		res.Change = entity.ExptRegressionChangeImproved
+	case down:
+		res.Change = entity.ExptRegressionChangeRegressed
+	}
+	return res
+}
+
+// fillTargetOutputs 仅为报告中的 turn 加载评测对象输出, 避免全量拉取执行记录
+func (e *ExptRegressionServiceImpl) fillTargetOutputs(ctx context.Context, param *entity.ExptRegressionReportParam, report *entity.ExptRegressionReport,
+	sides []*regressionExptSide,
+) error {
+	if len(report.Items) == 0 {
+		return nil
+	}
+	outputKeys := gslice.ToMap(param.OutputFieldKeys, func(key string) (string, bool) { return key, true })
+
+	for _, side := range sides {
+		turnResults := make([]*entity.ExptTurnResult, 0, len(report.Items))
+		for _, item := range report.Items {
+			if tr, ok := side.turns[entity.ItemTurnID{ItemID: item.ItemID, TurnID: item.TurnID}]; ok {
+				turnResults = append(turnResults, tr)
+			}
+		}
+		records, err := loadTargetRecordsByTurns(ctx, e.evalTargetService, side.expt, param.SpaceID, turnResults)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range report.Items {
+			record := records[entity.ItemTurnID{ItemID: item.ItemID, TurnID: item.TurnID}]
+			if record == nil || record.EvalTargetOutputData == nil {
+				continue
+			}
+			outputs := make(map[string]*entity.Content, len(record.EvalTargetOutputData.OutputFields))
+			for key, content := range record.EvalTargetOutputData.OutputFields {
+				if len(outputKeys) == 0 || outputKeys[key] {
+					outputs[key] = content
+				}
+			}
+			for _, result := range item.AllResults() {
+				if result.ExptID == side.expt.ID {
+					result.TargetOutputs = outputs
+				}
+			}
+		}
+	}
+	return nil
+}
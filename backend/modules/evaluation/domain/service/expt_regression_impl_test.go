@@ -0,0 +1,340 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repoMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+type regressionTestMocks struct {
+	exptRepo      *repoMocks.MockIExperimentRepo
+	turnRepo      *repoMocks.MockIExptTurnResultRepo
+	resultSvc     *svcMocks.MockExptResultService
+	recordSvc     *svcMocks.MockEvaluatorRecordService
+	targetSvc     *svcMocks.MockIEvalTargetService
+	calculatorSvc *svcMocks.MockIEvaluatorScoreCalculator
+}
+
+func newRegressionTestService(ctrl *gomock.Controller) (ExptRegressionService, *regressionTestMocks) {
+	m := &regressionTestMocks{
+		exptRepo:      repoMocks.NewMockIExperimentRepo(ctrl),
+		turnRepo:      repoMocks.NewMockIExptTurnResultRepo(ctrl),
+		resultSvc:     svcMocks.NewMockExptResultService(ctrl),
+		recordSvc:     svcMocks.NewMockEvaluatorRecordService(ctrl),
+		targetSvc:     svcMocks.NewMockIEvalTargetService(ctrl),
+		calculatorSvc: svcMocks.NewMockIEvaluatorScoreCalculator(ctrl),
+	}
+	return NewExptRegressionService(m.exptRepo, m.turnRepo, m.resultSvc, m.recordSvc, m.targetSvc, m.calculatorSvc), m
+}
+
+// mockRegressionTurns item_id 依次为 1..n, turn_id 均为 1; weighted 为 nil 表示未落库加权分
+func mockRegressionTurns(m *regressionTestMocks, spaceID, exptID, evaluatorVersionID int64, scores []float64, weighted []*float64) {
+	turnResults := make([]*entity.ExptTurnResult, 0, len(scores))
+	refs := make([]*entity.ExptTurnEvaluatorResultRef, 0, len(scores))
+	records := make([]*entity.EvaluatorRecordAggr, 0, len(scores))
+	recordIDs := make([]int64, 0, len(scores))
+	for i, score := range scores {
+		turnResultID := exptID*1000 + int64(i)
+		recordID := exptID*10000 + int64(i)
+		tr := &entity.ExptTurnResult{ID: turnResultID, ExptID: exptID, ItemID: int64(i + 1), TurnID: 1, TargetResultID: exptID*100000 + int64(i+1)}
+		if weighted != nil {
+			tr.WeightedScore = weighted[i]
+		}
+		turnResults = append(turnResults, tr)
+		refs = append(refs, &entity.ExptTurnEvaluatorResultRef{ExptTurnResultID: turnResultID, EvaluatorVersionID: evaluatorVersionID, EvaluatorResultID: recordID})
+		records = append(records, &entity.EvaluatorRecordAggr{ID: recordID, Status: entity.EvaluatorRunStatusSuccess, Score: gptr.Of(score)})
+		recordIDs = append(recordIDs, recordID)
+	}
+	m.turnRepo.EXPECT().ScanTurnResults(gomock.Any(), exptID, gomock.Any(), int64(0), int64(500), spaceID).Return(turnResults, int64(len(scores)), nil)
+	m.turnRepo.EXPECT().ScanTurnResults(gomock.Any(), exptID, gomock.Any(), int64(len(scores)), int64(500), spaceID).Return(nil, int64(len(scores)), nil)
+	m.turnRepo.EXPECT().GetTurnEvaluatorResultRefByExptID(gomock.Any(), spaceID, exptID).Return(refs, nil)
+	m.recordSvc.EXPECT().BatchGetEvaluatorRecordForAggr(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, ids []int64) ([]*entity.EvaluatorRecordAggr, error) {
+			idSet := make(map[int64]bool, len(ids))
+			for _, id := range ids {
+				idSet[id] = true
+			}
+			var res []*entity.EvaluatorRecordAggr
+			for _, r := range records {
+				if idSet[r.ID] {
+					res = append(res, r)
+				}
+			}
+			return res, nil
+		})
+}
+
+func mockRegressionTargetRecords(m *regressionTestMocks, spaceID, exptID int64, prefix string) {
+	m.targetSvc.EXPECT().BatchGetRecordByIDs(gomock.Any(), spaceID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int64, ids []int64) ([]*entity.EvalTargetRecord, error) {
+			res := make([]*entity.EvalTargetRecord, 0, len(ids))
+			for _, id := range ids {
+				res = append(res, &entity.EvalTargetRecord{
+					ID: id,
+					EvalTargetOutputData: &entity.EvalTargetOutputData{OutputFields: map[string]*entity.Content{
+						"actual_output": {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of(prefix + "-" + string(rune('0'+id%100000)))},
+						"reasoning":     {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("r")},
+					}},
+				})
+			}
+			return res, nil
+		})
+}
+
+func TestExptRegressionServiceImpl_BuildRegressionReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc, m := newRegressionTestService(ctrl)
+
+	const spaceID, baseID, candidateID, evaluatorVersionID = int64(100), int64(1), int64(2), int64(11)
+	m.exptRepo.EXPECT().MGetByID(gomock.Any(), []int64{baseID, candidateID}, spaceID).Return([]*entity.Experiment{
+		{ID: baseID, SpaceID: spaceID, EvalSetID: 7},
+		{ID: candidateID, SpaceID: spaceID, EvalSetID: 7},
+	}, nil)
+	mockRegressionTurns(m, spaceID, baseID, evaluatorVersionID, []float64{0.5, 0.6, 0.8}, []*float64{gptr.Of(0.5), gptr.Of(0.6), gptr.Of(0.8)})
+	// 候选实验加权分未落库, 由 IEvaluatorScoreCalculator 补算
+	mockRegressionTurns(m, spaceID, candidateID, evaluatorVersionID, []float64{0.9, 0.65, 0.3}, nil)
+	m.calculatorSvc.EXPECT().CalculateWeightedScore(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *entity.Experiment, version2Record map[string]*entity.EvaluatorRecord, _ map[string]float64) *float64 {
+			return version2Record["11"].EvaluatorOutputData.EvaluatorResult.Score
+		}).Times(3)
+	mockRegressionTargetRecords(m, spaceID, baseID, "base")
+	mockRegressionTargetRecords(m, spaceID, candidateID, "cand")
+
+	report, err := svc.BuildRegressionReport(context.Background(), &entity.ExptRegressionReportParam{
+		SpaceID:          spaceID,
+		BaseExptID:       baseID,
+		CandidateExptIDs: []int64{candidateID},
+		Threshold:        0.1,
+		OutputFieldKeys:  []string{"actual_output"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, baseID, report.BaseExptID)
+	assert.False(t, report.Truncated)
+
+	assert.Len(t, report.Summaries, 1)
+	summary := report.Summaries[0]
+	assert.Equal(t, int64(3), summary.PairedTurnCnt)
+	assert.Equal(t, int64(1), summary.ImprovedCnt)
+	assert.Equal(t, int64(1), summary.RegressedCnt)
+	assert.Equal(t, int64(1), summary.UnchangedCnt)
+
+	assert.Len(t, report.Items, 2)
+	improved, regressed := report.Items[0], report.Items[1]
+	assert.Equal(t, int64(1), improved.ItemID)
+	assert.Equal(t, entity.ExptRegressionChangeImproved, improved.Candidates[0].Change)
+	assert.InDelta(t, 0.4, *improved.Candidates[0].WeightedScoreDelta, 1e-9)
+	assert.Len(t, improved.Candidates[0].EvaluatorDeltas, 1)
+	assert.True(t, improved.Candidates[0].EvaluatorDeltas[0].Changed)
+	assert.Equal(t, "base-1", improved.Base.TargetOutputs["actual_output"].GetText())
+	assert.Equal(t, "cand-1", improved.Candidates[0].TargetOutputs["actual_output"].GetText())
+	assert.NotContains(t, improved.Base.TargetOutputs, "reasoning")
+
+	assert.Equal(t, int64(3), regressed.ItemID)
+	assert.Equal(t, entity.ExptRegressionChangeRegressed, regressed.Candidates[0].Change)
+	assert.InDelta(t, -0.5, regressed.Candidates[0].EvaluatorDeltas[0].Delta, 1e-9)
+}
+
+func TestExptRegressionServiceImpl_BuildRegressionReport_WithFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc, m := newRegressionTestService(ctrl)
+
+	const spaceID, baseID, candidateID, evaluatorVersionID = int64(100), int64(1), int64(2), int64(11)
+	m.exptRepo.EXPECT().MGetByID(gomock.Any(), []int64{baseID, candidateID}, spaceID).Return([]*entity.Experiment{
+		{ID: baseID, SpaceID: spaceID},
+		{ID: candidateID, SpaceID: spaceID},
+	}, nil)
+	filter := &entity.ExptTurnResultFilter{ScoreFilters: []*entity.ScoreFilter{{Score: 0.7, Operator: ">=", EvaluatorVersionID: evaluatorVersionID}}}
+	m.resultSvc.EXPECT().ListTurnResult(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, param *entity.MGetExperimentResultParam, expt *entity.Experiment) ([]*entity.ExptTurnResult, map[int64]entity.ItemRunState, int64, error) {
+			assert.Equal(t, baseID, expt.ID)
+			assert.Equal(t, baseID, *param.BaseExptID)
+			assert.Same(t, filter, param.Filters[baseID])
+			return []*entity.ExptTurnResult{{ItemID: 3, TurnID: 1}}, nil, 1, nil
+		})
+	mockRegressionTurns(m, spaceID, baseID, evaluatorVersionID, []float64{0.5, 0.6, 0.8}, []*float64{gptr.Of(0.5), gptr.Of(0.6), gptr.Of(0.8)})
+	mockRegressionTurns(m, spaceID, candidateID, evaluatorVersionID, []float64{0.9, 0.6, 0.3}, []*float64{gptr.Of(0.9), gptr.Of(0.6), gptr.Of(0.3)})
+	mockRegressionTargetRecords(m, spaceID, baseID, "base")
+	mockRegressionTargetRecords(m, spaceID, candidateID, "cand")
+
+	report, err := svc.BuildRegressionReport(context.Background(), &entity.ExptRegressionReportParam{
+		SpaceID:          spaceID,
+		BaseExptID:       baseID,
+		CandidateExptIDs: []int64{candidateID},
+		Filter:           filter,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), report.Summaries[0].PairedTurnCnt)
+	assert.Len(t, report.Items, 1)
+	assert.Equal(t, int64(3), report.Items[0].ItemID)
+	// 未指定 OutputFieldKeys 时返回全部输出字段
+	assert.Len(t, report.Items[0].Base.TargetOutputs, 2)
+}
+
+func TestExptRegressionServiceImpl_BuildRegressionReport_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc, m := newRegressionTestService(ctrl)
+
+	tests := []struct {
+		name  string
+		param *entity.ExptRegressionReportParam
+		setup func()
+	}{
+		{name: "nil param", param: nil},
+		{name: "no candidate", param: &entity.ExptRegressionReportParam{SpaceID: 1, BaseExptID: 1}},
+		{name: "duplicated candidate", param: &entity.ExptRegressionReportParam{SpaceID: 1, BaseExptID: 1, CandidateExptIDs: []int64{2, 2}}},
+		{name: "negative threshold", param: &entity.ExptRegressionReportParam{SpaceID: 1, BaseExptID: 1, CandidateExptIDs: []int64{2}, Threshold: -0.1}},
+		{
+			name:  "invalid change type",
+			param: &entity.ExptRegressionReportParam{SpaceID: 1, BaseExptID: 1, CandidateExptIDs: []int64{2}, ChangeTypes: []entity.ExptRegressionChangeType{"unknown"}},
+		},
+		{
+			name:  "different eval set",
+			param: &entity.ExptRegressionReportParam{SpaceID: 1, BaseExptID: 1, CandidateExptIDs: []int64{2}},
+			setup: func() {
+				m.exptRepo.EXPECT().MGetByID(gomock.Any(), []int64{1, 2}, int64(1)).Return([]*entity.Experiment{{ID: 1, EvalSetID: 3}, {ID: 2, EvalSetID: 4}}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+			_, err := svc.BuildRegressionReport(context.Background(), tt.param)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCompareRegressionTurn(t *testing.T) {
+	base := &entity.ExptRegressionTurnResult{ExptID: 1, WeightedScore: gptr.Of(0.5), EvaluatorScores: map[string]float64{"11": 0.5, "12": 0.5}}
+
+	mixed := compareRegressionTurn(base, &entity.ExptRegressionTurnResult{ExptID: 2, WeightedScore: gptr.Of(0.5), EvaluatorScores: map[string]float64{"11": 0.9, "12": 0.1}}, 0.1)
+	assert.Equal(t, entity.ExptRegressionChangeMixed, mixed.Change)
+	assert.Len(t, mixed.EvaluatorDeltas, 2)
+	assert.Equal(t, int64(11), mixed.EvaluatorDeltas[0].EvaluatorVersionID)
+
+	withinThreshold := compareRegressionTurn(base, &entity.ExptRegressionTurnResult{ExptID: 2, WeightedScore: gptr.Of(0.55), EvaluatorScores: map[string]float64{"11": 0.55}}, 0.1)
+	assert.Empty(t, withinThreshold.Change)
+	assert.Len(t, withinThreshold.EvaluatorDeltas, 1)
+	assert.False(t, withinThreshold.EvaluatorDeltas[0].Changed)
+
+	noWeighted := compareRegressionTurn(base, &entity.ExptRegressionTurnResult{ExptID: 2, EvaluatorScores: map[string]float64{"12": 0.2}}, 0)
+	assert.Nil(t, noWeighted.WeightedScoreDelta)
+	assert.Equal(t, entity.ExptRegressionChangeRegressed, noWeighted.Change)
+}
+
+func TestCloneFilterAccelerator(t *testing.T) {
+	assert.Nil(t, cloneFilterAccelerator(nil))
+
+	filter := &entity.ExptTurnResultFilterAccelerator{
+		ItemIDs: []*entity.FieldFilter{{Key: "item_id", Op: "IN", Values: []any{int64(1)}}},
+		MapCond: &entity.ExptTurnResultFilterMapCond{
+			EvalTargetDataFilters: []*entity.FieldFilter{{Key: "actual_output", Op: "LIKE", Values: []any{"a_b"}}},
+		},
+		ItemSnapshotCond: &entity.ItemSnapshotFilter{
+			StringMapFilters: []*entity.FieldFilter{{Key: "input", Op: "=", Values: []any{"x"}}},
+		},
+		KeywordSearch: &entity.KeywordFilter{
+			EvalTargetDataFilters: []*entity.FieldFilter{{Key: "actual_output", Op: "LIKE", Values: []any{"k"}}},
+			Keyword:               gptr.Of("k"),
+		},
+	}
+	cloned := cloneFilterAccelerator(filter)
+	assert.Equal(t, filter, cloned)
+
+	// CK 查询原地改写 key / values, 不能影响原始筛选条件
+	cloned.ItemIDs[0].Values[0] = int64(2)
+	cloned.MapCond.EvalTargetDataFilters[0].Key = "eval_target_data_1"
+	cloned.ItemSnapshotCond.StringMapFilters[0].Key = "string_map_1"
+	cloned.KeywordSearch.EvalTargetDataFilters[0].Key = "eval_target_data_2"
+	assert.Equal(t, int64(1), filter.ItemIDs[0].Values[0])
+	assert.Equal(t, "actual_output", filter.MapCond.EvalTargetDataFilters[0].Key)
+	assert.Equal(t, "input", filter.ItemSnapshotCond.StringMapFilters[0].Key)
+	assert.Equal(t, "actual_output", filter.KeywordSearch.EvalTargetDataFilters[0].Key)
+}
+
+func TestExptResultExportService_ExportRegressionReport(t *testing.T) {
+	report := &entity.ExptRegressionReport{
+		BaseExptID: 1,
+		Items: []*entity.ExptRegressionItem{{
+			ItemID: 5,
+			TurnID: 1,
+			Base: &entity.ExptRegressionTurnResult{
+				ExptID:          1,
+				WeightedScore:   gptr.Of(0.5),
+				EvaluatorScores: map[string]float64{"11": 0.5},
+				TargetOutputs:   map[string]*entity.Content{"actual_output": {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("old")}},
+			},
+			Candidates: []*entity.ExptRegressionCandidateResult{{
+				ExptRegressionTurnResult: &entity.ExptRegressionTurnResult{
+					ExptID:          2,
+					WeightedScore:   gptr.Of(0.125),
+					EvaluatorScores: map[string]float64{"11": 0.125},
+					TargetOutputs:   map[string]*entity.Content{"actual_output": {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("new")}},
+				},
+				Change:             entity.ExptRegressionChangeRegressed,
+				WeightedScoreDelta: gptr.Of(-0.375),
+				EvaluatorDeltas:    []*entity.ExptRegressionEvaluatorDelta{{EvaluatorVersionID: 11, BaseScore: 0.5, CandidateScore: 0.125, Delta: -0.375, Changed: true}},
+			}},
+		}},
+	}
+	svc := ExptResultExportService{}
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, svc.ExportRegressionReport(context.Background(), report, entity.ExptResultExportFormatCSV, &buf))
+		records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(buf.String(), "\xEF\xBB\xBF"))).ReadAll()
+		assert.NoError(t, err)
+		assert.Len(t, records, 2)
+		assert.Equal(t, []string{
+			"itemID", "turnID", "candidateExptID", "change",
+			"weightedScore.base", "weightedScore.candidate", "weightedScore.delta",
+			"evaluator_11.base", "evaluator_11.candidate", "evaluator_11.delta",
+			"actual_output.base", "actual_output.candidate",
+		}, records[0])
+		assert.Equal(t, []string{"5", "1", "2", "regressed", "0.50", "0.12", "-0.38", "0.50", "0.12", "-0.38", "old", "new"}, records[1])
+	})
+
+	t.Run("jsonl", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, svc.ExportRegressionReport(context.Background(), report, entity.ExptResultExportFormatJSONL, &buf))
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		assert.Len(t, lines, 1)
+		var row map[string]any
+		assert.NoError(t, json.Unmarshal([]byte(lines[0]), &row))
+		assert.Equal(t, float64(5), row["item_id"])
+		candidate := row["candidates"].([]any)[0].(map[string]any)
+		assert.Equal(t, float64(2), candidate["expt_id"])
+		assert.Equal(t, "regressed", candidate["change"])
+		assert.Equal(t, -0.375, candidate["evaluator_deltas"].(map[string]any)["11"])
+		assert.Equal(t, "new", candidate["target_outputs"].(map[string]any)["actual_output"].(map[string]any)["text"])
+	})
+
+	t.Run("xlsx", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, svc.ExportRegressionReport(context.Background(), report, entity.ExptResultExportFormatXLSX, &buf))
+		assert.True(t, buf.Len() > 0)
+	})
+
+	t.Run("nil report", func(t *testing.T) {
+		assert.Error(t, svc.ExportRegressionReport(context.Background(), nil, entity.ExptResultExportFormatCSV, &bytes.Buffer{}))
+	})
+}
@@ -16,6 +16,9 @@ type ExptResultService interface {
 	// GetItemIDListByExptID 精简查询：仅返回实验下 distinct 的 item_id 列表（单表单列 GROUP BY，不加载轨迹/评测大对象），
 	// 用于标准输出精简读（only_item_ids）等只需枚举 item 的场景。
 	GetItemIDListByExptID(ctx context.Context, exptID, spaceID int64) ([]int64, error)
+	// ListTurnResult 按 param.Filters / FilterAccelerators 中基线实验的筛选条件分页查询基线实验的 turn 结果，
+	// 返回 turn 列表、item 运行状态（仅 accelerator 筛选命中时非空）与总数。
+	ListTurnResult(ctx context.Context, param *entity.MGetExperimentResultParam, expt *entity.Experiment) ([]*entity.ExptTurnResult, map[int64]entity.ItemRunState, int64, error)
 	// RecordItemRunLogs sync results from run_log table to result table.
 	RecordItemRunLogs(ctx context.Context, exptID, exptRunID, itemID, spaceID int64, expt *entity.Experiment) ([]*entity.ExptTurnEvaluatorResultRef, error)
 	GetExptItemTurnResults(ctx context.Context, exptID, itemID, spaceID int64, session *entity.Session) ([]*entity.ExptTurnResult, error)
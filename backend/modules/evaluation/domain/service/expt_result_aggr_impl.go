@@ -21,6 +21,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/events"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/stats"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/utils"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 	"github.com/coze-dev/coze-loop/backend/pkg/json"
@@ -265,6 +266,12 @@ func (e *ExptAggrResultServiceImpl) CreateOrUpdateExptAggrResult(ctx context.Con
 		aggrResults = append(aggrResults, weightedAggr)
 	}
 
+	trialAggrResults, err := e.createTrialAggrResults(ctx, spaceID, experimentID)
+	if err != nil {
+		return err
+	}
+	aggrResults = append(aggrResults, trialAggrResults...)
+
 	targetAggrResults, err := tmag.buildAggrResult(spaceID, experimentID)
 	if err != nil {
 		return err
@@ -383,6 +390,163 @@ func (e *ExptAggrResultServiceImpl) createWeightedScoreAggrResult(ctx context.Co
 	}, nil
 }
 
+// createTrialAggrResults 计算重复试验实验各评估器实例的 pass@k / 一致率指标 (FieldType_EvaluatorTrial)。
+// 统计单元为成功的 (item, turn), 第 0 次试验取 expt_turn_evaluator_result_ref, 其余试验取 turn 结果的 trial_results,
+// 仅所有试验均有成功得分的单元参与统计。未开启重复试验时返回空。
+func (e *ExptAggrResultServiceImpl) createTrialAggrResults(ctx context.Context, spaceID, experimentID int64) ([]*entity.ExptAggrResult, error) {
+	const (
+		limit  = int64(500)
+		maxTry = 10000
+	)
+
+	expt, err := e.experimentRepo.GetByID(ctx, experimentID, spaceID)
+	if err != nil {
+		return nil, err
+	}
+	if expt == nil || expt.EvalConf == nil || !expt.EvalConf.TrialConf.IsEnabled() {
+		return nil, nil
+	}
+	trialConf := expt.EvalConf.TrialConf
+	trialNum := trialConf.GetTrialNum()
+
+	refs, err := e.exptTurnResultRepo.GetTurnEvaluatorResultRefByExptID(ctx, spaceID, experimentID)
+	if err != nil {
+		return nil, err
+	}
+	turnResultID2Records := make(map[int64]map[string]int64)
+	for _, ref := range refs {
+		if turnResultID2Records[ref.ExptTurnResultID] == nil {
+			turnResultID2Records[ref.ExptTurnResultID] = make(map[string]int64)
+		}
+		turnResultID2Records[ref.ExptTurnResultID][entity.EncodeEvaluatorInstanceKey(ref.EvaluatorVersionID, ref.Alias)] = ref.EvaluatorResultID
+	}
+
+	// instanceKey -> 各单元按试验序号排列的 record id, 缺失为 0
+	instanceKey2Units := make(map[string][][]int64)
+	var recordIDs []int64
+	var cursor int64
+	for i := 0; i < maxTry; i++ {
+		turnResults, nextCursor, err := e.exptTurnResultRepo.ScanTurnResults(ctx, experimentID, []int32{int32(entity.TurnRunState_Success)}, cursor, limit, spaceID)
+		if err != nil {
+			return nil, err
+		}
+		if len(turnResults) == 0 {
+			break
+		}
+
+		for _, tr := range turnResults {
+			for instanceKey, recordID := range turnResultID2Records[tr.ID] {
+				unit := make([]int64, trialNum)
+				unit[0] = recordID
+				recordIDs = append(recordIDs, recordID)
+				for _, trial := range tr.TrialResults {
+					if trial == nil || trial.TrialIdx <= 0 || int(trial.TrialIdx) >= trialNum {
+						continue
+					}
+					for _, er := range trial.EvaluatorResults {
+						if er != nil && entity.EncodeEvaluatorInstanceKey(er.VersionID, er.Alias) == instanceKey {
+							unit[trial.TrialIdx] = er.RecordID
+							recordIDs = append(recordIDs, er.RecordID)
+						}
+					}
+				}
+				instanceKey2Units[instanceKey] = append(instanceKey2Units[instanceKey], unit)
+			}
+		}
+
+		if nextCursor == 0 || nextCursor == cursor {
+			break
+		}
+		cursor = nextCursor
+	}
+	if len(instanceKey2Units) == 0 {
+		return nil, nil
+	}
+
+	recordID2Score := make(map[int64]float64, len(recordIDs))
+	for _, ids := range gslice.Chunk(recordIDs, 500) {
+		records, err := e.evaluatorRecordService.BatchGetEvaluatorRecordForAggr(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range records {
+			if record == nil || record.Status != entity.EvaluatorRunStatusSuccess || record.Score == nil {
+				continue
+			}
+			recordID2Score[record.ID] = *record.Score
+		}
+	}
+
+	aggrResults := make([]*entity.ExptAggrResult, 0, len(instanceKey2Units))
+	for instanceKey, units := range instanceKey2Units {
+		trialAggr := calcEvaluatorTrialAggregate(units, recordID2Score, trialConf.GetPassThreshold())
+		if trialAggr.UnitCnt == 0 {
+			continue
+		}
+		aggrBytes, err := json.Marshal(&entity.AggregateResult{Trial: trialAggr})
+		if err != nil {
+			return nil, err
+		}
+		aggrResults = append(aggrResults, &entity.ExptAggrResult{
+			SpaceID:      spaceID,
+			ExperimentID: experimentID,
+			FieldType:    int32(entity.FieldType_EvaluatorTrial),
+			FieldKey:     instanceKey,
+			Score:        utils.RoundScoreToTwoDecimals(trialAggr.PassAtK),
+			AggrResult:   aggrBytes,
+			Version:      0,
+		})
+	}
+	return aggrResults, nil
+}
+
+// calcEvaluatorTrialAggregate 按单元计算 pass@1 / pass@k / pass^k (k 取试验次数) 与一致率后取单元均值
+func calcEvaluatorTrialAggregate(units [][]int64, recordID2Score map[int64]float64, passThreshold float64) *entity.EvaluatorTrialAggregate {
+	res := &entity.EvaluatorTrialAggregate{PassThreshold: passThreshold}
+	var allScores, stds []float64
+	var consistent int64
+	for _, unit := range units {
+		scores := make([]float64, 0, len(unit))
+		for _, recordID := range unit {
+			if score, ok := recordID2Score[recordID]; ok && recordID > 0 {
+				scores = append(scores, score)
+			}
+		}
+		if len(scores) != len(unit) || len(unit) == 0 {
+			continue
+		}
+
+		n, c := len(scores), 0
+		for _, score := range scores {
+			if score >= passThreshold {
+				c++
+			}
+		}
+		res.TrialNum = n
+		res.UnitCnt++
+		allScores = append(allScores, scores...)
+		stds = append(stds, stats.StdDev(scores))
+		if c == 0 || c == n {
+			consistent++
+		}
+		res.PassAt1 += stats.PassAtK(n, c, 1)
+		res.PassAtK += stats.PassAtK(n, c, n)
+		res.PassPowK += stats.PassPowK(n, c, n)
+	}
+	if res.UnitCnt == 0 {
+		return res
+	}
+
+	unitCnt := float64(res.UnitCnt)
+	res.MeanScore = stats.Mean(allScores)
+	res.MeanStd = stats.Mean(stds)
+	res.ConsistencyRate = float64(consistent) / unitCnt
+	res.PassAt1 /= unitCnt
+	res.PassAtK /= unitCnt
+	res.PassPowK /= unitCnt
+	return res
+}
+
 func (e *ExptAggrResultServiceImpl) UpdateExptAggrResult(ctx context.Context, param *entity.UpdateExptAggrResultParam) (err error) {
 	now := time.Now().Unix()
 	defer func() {
@@ -598,6 +762,7 @@ func (e *ExptAggrResultServiceImpl) BatchGetExptAggrResultByExperimentIDs(ctx co
 			TargetVersionID: versionedTargetIDMap[exptID].VersionID,
 		}
 		var weightedResults []*entity.AggregatorResult
+		instanceKey2Trial := make(map[string]*entity.EvaluatorTrialAggregate)
 
 		var latestUpdateAt *time.Time
 		for _, fieldResult := range exptResult {
@@ -625,6 +790,7 @@ func (e *ExptAggrResultServiceImpl) BatchGetExptAggrResultByExperimentIDs(ctx co
 					TagKeyID:          tagKeyID,
 					AggregatorResults: aggregateResultDO.AggregatorResults,
 					Name:              ptr.Of(tagInfo.TagKeyName),
+					Agreement:         aggregateResultDO.Agreement,
 				}
 				annotationResults[tagKeyID] = annotationResult
 			case int32(entity.FieldType_EvaluatorScore):
@@ -660,6 +826,12 @@ func (e *ExptAggrResultServiceImpl) BatchGetExptAggrResultByExperimentIDs(ctx co
 					return nil, fmt.Errorf("json.Unmarshal(%s) failed, err: %v", fieldResult.AggrResult, err)
 				}
 				weightedResults = aggregateResultDO.AggregatorResults
+			case int32(entity.FieldType_EvaluatorTrial):
+				aggregateResultDO := entity.AggregateResult{}
+				if err := json.Unmarshal(fieldResult.AggrResult, &aggregateResultDO); err != nil {
+					return nil, fmt.Errorf("json.Unmarshal(%s) failed, err: %v", fieldResult.AggrResult, err)
+				}
+				instanceKey2Trial[fieldResult.FieldKey] = aggregateResultDO.Trial
 			case int32(entity.FieldType_TargetLatency):
 				ar := entity.AggregateResult{}
 				if err := json.Unmarshal(fieldResult.AggrResult, &ar); err != nil {
@@ -693,6 +865,12 @@ func (e *ExptAggrResultServiceImpl) BatchGetExptAggrResultByExperimentIDs(ctx co
 			}
 		}
 
+		for instanceKey, trial := range instanceKey2Trial {
+			if evaluatorResult, ok := evaluatorResults[instanceKey]; ok {
+				evaluatorResult.Trial = trial
+			}
+		}
+
 		exptAgg := &entity.ExptAggregateResult{
 			ExperimentID:      exptID,
 			EvaluatorResults:  evaluatorResults,
@@ -858,14 +1036,15 @@ func (e *ExptAggrResultServiceImpl) CreateAnnotationAggrResult(ctx context.Conte
 		return nil
 	}
 	tagContentType := annotateRecords[0].AnnotateData.TagContentType
+	agreement := e.calcAnnotationAgreement(ctx, param.SpaceID, param.ExperimentID, tagKeyID, tagContentType, annotateRecordRefs, annotateRecords)
 
 	switch tagContentType {
 	case entity.TagContentTypeContinuousNumber:
-		return e.createContinuousNumberExptAggrResult(ctx, param, annotateRecords)
+		return e.createContinuousNumberExptAggrResult(ctx, param, annotateRecords, agreement)
 	case entity.TagContentTypeBoolean:
-		return e.createBooleanExptAggrResult(ctx, param, annotateRecords)
+		return e.createBooleanExptAggrResult(ctx, param, annotateRecords, agreement)
 	case entity.TagContentTypeCategorical:
-		return e.createCategoricalExptAggrResult(ctx, param, annotateRecords)
+		return e.createCategoricalExptAggrResult(ctx, param, annotateRecords, agreement)
 	case entity.TagContentTypeFreeText:
 		return nil
 	default:
@@ -873,12 +1052,13 @@ func (e *ExptAggrResultServiceImpl) CreateAnnotationAggrResult(ctx context.Conte
 	}
 }
 
-func (e *ExptAggrResultServiceImpl) createCategoricalExptAggrResult(ctx context.Context, param *entity.CreateSpecificFieldAggrResultParam, annotateRecords []*entity.AnnotateRecord) error {
+func (e *ExptAggrResultServiceImpl) createCategoricalExptAggrResult(ctx context.Context, param *entity.CreateSpecificFieldAggrResultParam, annotateRecords []*entity.AnnotateRecord, agreement *entity.AnnotationAgreement) error {
 	categoricalAggregatorGroup := NewCategoricalAggregatorGroup()
 	for _, annotateRecord := range annotateRecords {
 		categoricalAggregatorGroup.Append(strconv.FormatInt(annotateRecord.TagValueID, 10))
 	}
 	aggrResult := categoricalAggregatorGroup.Result()
+	aggrResult.Agreement = agreement
 
 	aggrResultBytes, err := json.Marshal(aggrResult)
 	if err != nil {
@@ -902,7 +1082,7 @@ func (e *ExptAggrResultServiceImpl) createCategoricalExptAggrResult(ctx context.
 	return nil
 }
 
-func (e *ExptAggrResultServiceImpl) createContinuousNumberExptAggrResult(ctx context.Context, param *entity.CreateSpecificFieldAggrResultParam, annotateRecords []*entity.AnnotateRecord) error {
+func (e *ExptAggrResultServiceImpl) createContinuousNumberExptAggrResult(ctx context.Context, param *entity.CreateSpecificFieldAggrResultParam, annotateRecords []*entity.AnnotateRecord, agreement *entity.AnnotationAgreement) error {
 	aggregatorGroup := NewAggregatorGroup(WithScoreDistributionAggregator())
 	for _, annotateRecord := range annotateRecords {
 		if annotateRecord.AnnotateData.Score == nil {
@@ -911,6 +1091,7 @@ func (e *ExptAggrResultServiceImpl) createContinuousNumberExptAggrResult(ctx con
 		aggregatorGroup.Append(gptr.Indirect(annotateRecord.AnnotateData.Score))
 	}
 	aggrResult := aggregatorGroup.Result()
+	aggrResult.Agreement = agreement
 
 	var averageScore float64
 	for _, aggregatorResult := range aggrResult.AggregatorResults {
@@ -943,12 +1124,13 @@ func (e *ExptAggrResultServiceImpl) createContinuousNumberExptAggrResult(ctx con
 	return nil
 }
 
-func (e *ExptAggrResultServiceImpl) createBooleanExptAggrResult(ctx context.Context, param *entity.CreateSpecificFieldAggrResultParam, annotateRecords []*entity.AnnotateRecord) error {
+func (e *ExptAggrResultServiceImpl) createBooleanExptAggrResult(ctx context.Context, param *entity.CreateSpecificFieldAggrResultParam, annotateRecords []*entity.AnnotateRecord, agreement *entity.AnnotationAgreement) error {
 	booleanAggregatorGroup := NewCategoricalAggregatorGroup()
 	for _, annotateRecord := range annotateRecords {
 		booleanAggregatorGroup.Append(strconv.FormatInt(annotateRecord.TagValueID, 10))
 	}
 	aggrResult := booleanAggregatorGroup.Result()
+	aggrResult.Agreement = agreement
 
 	aggrResultBytes, err := json.Marshal(aggrResult)
 	if err != nil {
@@ -1033,14 +1215,15 @@ func (e *ExptAggrResultServiceImpl) UpdateAnnotationAggrResult(ctx context.Conte
 		return nil
 	}
 	tagContentType := annotateRecords[0].AnnotateData.TagContentType
+	agreement := e.calcAnnotationAgreement(ctx, param.SpaceID, param.ExperimentID, tagKeyID, tagContentType, annotateRecordRefs, annotateRecords)
 
 	switch tagContentType {
 	case entity.TagContentTypeContinuousNumber:
-		return e.updateContinuousNumberExptAggrResult(ctx, param, annotateRecords, version)
+		return e.updateContinuousNumberExptAggrResult(ctx, param, annotateRecords, agreement, version)
 	case entity.TagContentTypeBoolean:
-		return e.updateBooleanExptAggrResult(ctx, param, annotateRecords, version)
+		return e.updateBooleanExptAggrResult(ctx, param, annotateRecords, agreement, version)
 	case entity.TagContentTypeCategorical:
-		return e.updateCategoricalExptAggrResult(ctx, param, annotateRecords, version)
+		return e.updateCategoricalExptAggrResult(ctx, param, annotateRecords, agreement, version)
 	case entity.TagContentTypeFreeText:
 		return nil
 	default:
@@ -1048,7 +1231,7 @@ func (e *ExptAggrResultServiceImpl) UpdateAnnotationAggrResult(ctx context.Conte
 	}
 }
 
-func (e *ExptAggrResultServiceImpl) updateContinuousNumberExptAggrResult(ctx context.Context, param *entity.UpdateExptAggrResultParam, annotateRecords []*entity.AnnotateRecord, version int64) error {
+func (e *ExptAggrResultServiceImpl) updateContinuousNumberExptAggrResult(ctx context.Context, param *entity.UpdateExptAggrResultParam, annotateRecords []*entity.AnnotateRecord, agreement *entity.AnnotationAgreement, version int64) error {
 	aggregatorGroup := NewAggregatorGroup(WithScoreDistributionAggregator())
 	for _, annotateRecord := range annotateRecords {
 		if annotateRecord.AnnotateData.Score == nil {
@@ -1057,6 +1240,7 @@ func (e *ExptAggrResultServiceImpl) updateContinuousNumberExptAggrResult(ctx con
 		aggregatorGroup.Append(gptr.Indirect(annotateRecord.AnnotateData.Score))
 	}
 	aggrResult := aggregatorGroup.Result()
+	aggrResult.Agreement = agreement
 
 	var averageScore float64
 	for _, aggregatorResult := range aggrResult.AggregatorResults {
@@ -1088,12 +1272,13 @@ func (e *ExptAggrResultServiceImpl) updateContinuousNumberExptAggrResult(ctx con
 	return nil
 }
 
-func (e *ExptAggrResultServiceImpl) updateCategoricalExptAggrResult(ctx context.Context, param *entity.UpdateExptAggrResultParam, annotateRecords []*entity.AnnotateRecord, version int64) error {
+func (e *ExptAggrResultServiceImpl) updateCategoricalExptAggrResult(ctx context.Context, param *entity.UpdateExptAggrResultParam, annotateRecords []*entity.AnnotateRecord, agreement *entity.AnnotationAgreement, version int64) error {
 	categoricalAggregatorGroup := NewCategoricalAggregatorGroup()
 	for _, annotateRecord := range annotateRecords {
 		categoricalAggregatorGroup.Append(strconv.FormatInt(annotateRecord.TagValueID, 10))
 	}
 	aggrResult := categoricalAggregatorGroup.Result()
+	aggrResult.Agreement = agreement
 
 	aggrResultBytes, err := json.Marshal(aggrResult)
 	if err != nil {
@@ -1117,12 +1302,13 @@ func (e *ExptAggrResultServiceImpl) updateCategoricalExptAggrResult(ctx context.
 	return nil
 }
 
-func (e *ExptAggrResultServiceImpl) updateBooleanExptAggrResult(ctx context.Context, param *entity.UpdateExptAggrResultParam, annotateRecords []*entity.AnnotateRecord, version int64) error {
+func (e *ExptAggrResultServiceImpl) updateBooleanExptAggrResult(ctx context.Context, param *entity.UpdateExptAggrResultParam, annotateRecords []*entity.AnnotateRecord, agreement *entity.AnnotationAgreement, version int64) error {
 	booleanAggregatorGroup := NewCategoricalAggregatorGroup()
 	for _, annotateRecord := range annotateRecords {
 		booleanAggregatorGroup.Append(strconv.FormatInt(annotateRecord.TagValueID, 10))
 	}
 	aggrResult := booleanAggregatorGroup.Result()
+	aggrResult.Agreement = agreement
 
 	aggrResultBytes, err := json.Marshal(aggrResult)
 	if err != nil {
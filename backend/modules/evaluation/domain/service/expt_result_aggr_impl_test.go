@@ -1017,6 +1017,8 @@ func TestExptAggrResultServiceImpl_CreateAnnotationAggrResult(t *testing.T) {
 			}
 
 			tt.setup(mockExptAnnotateRepo, mockExptAggrResultRepo, mockMetric)
+			// 单标注者场景下一致性计算不影响聚合结果
+			mockExptAnnotateRepo.EXPECT().GetAnnotateRecordsByTagKeyID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
 			err := svc.CreateAnnotationAggrResult(context.Background(), tt.param)
 			if tt.wantErr {
@@ -1346,6 +1348,7 @@ func TestExptAggrResultServiceImpl_UpdateAnnotationAggrResult(t *testing.T) {
 			}
 
 			tt.setup(mockExptAggrResultRepo, mockExptAnnotateRepo, mockExperimentRepo, mockMetric)
+			mockExptAnnotateRepo.EXPECT().GetAnnotateRecordsByTagKeyID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
 			err := svc.UpdateAnnotationAggrResult(context.Background(), tt.param)
 			if tt.wantErr {
@@ -3766,3 +3769,98 @@ func TestExptAggrResultServiceImpl_calculateWeightedAggregateResults(t *testing.
 		assert.Len(t, result, 1)
 	})
 }
+
+func Test_calcEvaluatorTrialAggregate(t *testing.T) {
+	recordID2Score := map[int64]float64{
+		// 单元 1: 3 次均通过
+		1: 1, 2: 1, 3: 1,
+		// 单元 2: 3 次中 1 次通过
+		4: 1, 5: 0, 6: 0,
+		// 单元 3: 第 2 次试验无得分, 不参与统计
+		7: 1, 8: 1,
+	}
+	units := [][]int64{{1, 2, 3}, {4, 5, 6}, {7, 0, 8}}
+
+	res := calcEvaluatorTrialAggregate(units, recordID2Score, 1)
+	assert.Equal(t, 3, res.TrialNum)
+	assert.Equal(t, int64(2), res.UnitCnt)
+	assert.InDelta(t, 4.0/6, res.MeanScore, 1e-6)
+	// 单元 1 标准差 0, 单元 2 样本标准差 sqrt(1/3)
+	assert.InDelta(t, math.Sqrt(1.0/3)/2, res.MeanStd, 1e-6)
+	assert.InDelta(t, 0.5, res.ConsistencyRate, 1e-6)
+	assert.InDelta(t, (1+1.0/3)/2, res.PassAt1, 1e-6)
+	assert.InDelta(t, 1.0, res.PassAtK, 1e-6)
+	assert.InDelta(t, 0.5, res.PassPowK, 1e-6)
+
+	empty := calcEvaluatorTrialAggregate([][]int64{{7, 0, 8}}, recordID2Score, 1)
+	assert.Equal(t, int64(0), empty.UnitCnt)
+}
+
+func TestExptAggrResultServiceImpl_createTrialAggrResults(t *testing.T) {
+	ctx := context.Background()
+	spaceID, exptID := int64(100), int64(1)
+
+	t.Run("未开启重复试验不计算", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockExperimentRepo := repoMocks.NewMockIExperimentRepo(ctrl)
+		mockExperimentRepo.EXPECT().GetByID(ctx, exptID, spaceID).Return(&entity.Experiment{EvalConf: &entity.EvaluationConfiguration{}}, nil)
+		svc := &ExptAggrResultServiceImpl{experimentRepo: mockExperimentRepo}
+
+		res, err := svc.createTrialAggrResults(ctx, spaceID, exptID)
+		assert.NoError(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("按评估器实例计算 pass@k", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockExperimentRepo := repoMocks.NewMockIExperimentRepo(ctrl)
+		mockExptTurnResultRepo := repoMocks.NewMockIExptTurnResultRepo(ctrl)
+		mockEvaluatorRecordService := svcMocks.NewMockEvaluatorRecordService(ctrl)
+		svc := &ExptAggrResultServiceImpl{
+			experimentRepo:         mockExperimentRepo,
+			exptTurnResultRepo:     mockExptTurnResultRepo,
+			evaluatorRecordService: mockEvaluatorRecordService,
+		}
+
+		mockExperimentRepo.EXPECT().GetByID(ctx, exptID, spaceID).Return(&entity.Experiment{
+			EvalConf: &entity.EvaluationConfiguration{TrialConf: &entity.ExptTrialConf{TrialNum: 2, PassThreshold: gptr.Of(0.5)}},
+		}, nil)
+		mockExptTurnResultRepo.EXPECT().GetTurnEvaluatorResultRefByExptID(ctx, spaceID, exptID).Return([]*entity.ExptTurnEvaluatorResultRef{
+			{ExptTurnResultID: 11, EvaluatorVersionID: 1, EvaluatorResultID: 101},
+			{ExptTurnResultID: 12, EvaluatorVersionID: 1, EvaluatorResultID: 102},
+		}, nil)
+		mockExptTurnResultRepo.EXPECT().ScanTurnResults(ctx, exptID, []int32{int32(entity.TurnRunState_Success)}, int64(0), int64(500), spaceID).
+			Return([]*entity.ExptTurnResult{
+				{ID: 11, TrialResults: []*entity.ExptTurnTrialResult{{TrialIdx: 1, EvaluatorResults: []*entity.RegisteredEvalResult{{VersionID: 1, RecordID: 201}}}}},
+				{ID: 12, TrialResults: []*entity.ExptTurnTrialResult{{TrialIdx: 1, EvaluatorResults: []*entity.RegisteredEvalResult{{VersionID: 1, RecordID: 202}}}}},
+			}, int64(0), nil)
+		mockEvaluatorRecordService.EXPECT().BatchGetEvaluatorRecordForAggr(ctx, gomock.Any()).Return([]*entity.EvaluatorRecordAggr{
+			{ID: 101, Score: gptr.Of(0.8), Status: entity.EvaluatorRunStatusSuccess},
+			{ID: 201, Score: gptr.Of(0.9), Status: entity.EvaluatorRunStatusSuccess},
+			{ID: 102, Score: gptr.Of(0.2), Status: entity.EvaluatorRunStatusSuccess},
+			{ID: 202, Score: gptr.Of(0.7), Status: entity.EvaluatorRunStatusSuccess},
+		}, nil)
+
+		res, err := svc.createTrialAggrResults(ctx, spaceID, exptID)
+		assert.NoError(t, err)
+		if assert.Len(t, res, 1) {
+			assert.Equal(t, int32(entity.FieldType_EvaluatorTrial), res[0].FieldType)
+			assert.Equal(t, "1", res[0].FieldKey)
+			assert.Equal(t, 1.0, res[0].Score)
+
+			aggr := entity.AggregateResult{}
+			assert.NoError(t, json.Unmarshal(res[0].AggrResult, &aggr))
+			if assert.NotNil(t, aggr.Trial) {
+				assert.Equal(t, int64(2), aggr.Trial.UnitCnt)
+				assert.Equal(t, 0.5, aggr.Trial.PassThreshold)
+				assert.InDelta(t, 0.5, aggr.Trial.ConsistencyRate, 1e-6)
+				assert.InDelta(t, 0.75, aggr.Trial.PassAt1, 1e-6)
+				assert.InDelta(t, 0.5, aggr.Trial.PassPowK, 1e-6)
+			}
+		}
+	})
+}
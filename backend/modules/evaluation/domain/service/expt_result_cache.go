@@ -0,0 +1,35 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/expt_result_cache.go -package=mocks . IExptResultCacheService
+
+// IExptResultCacheService 评测对象/评估器结果的内容寻址缓存。
+// 缓存按空间隔离, 命中时不复用源 record 本身, 而是以源 record 的输出为当前实验落一条新 record,
+// Ext 中记录源 record id, 避免后续对本实验结果的修正 (如人工校准评分) 影响其他实验。
+type IExptResultCacheService interface {
+	// ReuseTargetRecord 按 key 查找可复用的评测对象结果, 命中时落一条新的 EvalTargetRecord 并返回; 未命中返回 nil。
+	// maxAge > 0 时早于该时长写入的缓存视为未命中
+	ReuseTargetRecord(ctx context.Context, cacheSpaceID, recordSpaceID, targetID, targetVersionID int64, key string,
+		param *entity.ExecuteTargetCtx, inputData *entity.EvalTargetInputData, maxAge time.Duration) (*entity.EvalTargetRecord, error)
+	// PutTargetRecord 记录可复用的评测对象结果, 仅成功的 record 会被缓存
+	PutTargetRecord(ctx context.Context, cacheSpaceID int64, key string, record *entity.EvalTargetRecord) error
+	// ReuseEvaluatorRecord 按 key 查找可复用的评估器结果, 命中时按 request 落一条新的 EvaluatorRecord 并返回; 未命中返回 nil
+	ReuseEvaluatorRecord(ctx context.Context, key string, request *entity.RunEvaluatorRequest, maxAge time.Duration) (*entity.EvaluatorRecord, error)
+	// PutEvaluatorRecord 记录可复用的评估器结果, 仅成功的 record 会被缓存
+	PutEvaluatorRecord(ctx context.Context, cacheSpaceID int64, key string, record *entity.EvaluatorRecord) error
+
+	GetSpaceConf(ctx context.Context, spaceID int64) (*entity.ResultCacheSpaceConf, error)
+	// SetSpaceTTL 设置空间缓存存活时间, 仅对之后写入的缓存生效
+	SetSpaceTTL(ctx context.Context, spaceID int64, ttl time.Duration) error
+	// Invalidate 使空间内已有缓存全部失效
+	Invalidate(ctx context.Context, spaceID int64) error
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/bytedance/gg/gmap"
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+type ExptResultCacheServiceImpl struct {
+	cacheRepo              repo.IExptResultCacheRepo
+	evalTargetService      IEvalTargetService
+	evaluatorRecordService EvaluatorRecordService
+	evaluatorRecordRepo    repo.IEvaluatorRecordRepo
+	idgen                  idgen.IIDGenerator
+}
+
+func NewExptResultCacheService(
+	cacheRepo repo.IExptResultCacheRepo,
+	evalTargetService IEvalTargetService,
+	evaluatorRecordService EvaluatorRecordService,
+	evaluatorRecordRepo repo.IEvaluatorRecordRepo,
+	idgen idgen.IIDGenerator,
+) IExptResultCacheService {
+	return &ExptResultCacheServiceImpl{
+		cacheRepo:              cacheRepo,
+		evalTargetService:      evalTargetService,
+		evaluatorRecordService: evaluatorRecordService,
+		evaluatorRecordRepo:    evaluatorRecordRepo,
+		idgen:                  idgen,
+	}
+}
+
+func (e *ExptResultCacheServiceImpl) ReuseTargetRecord(ctx context.Context, cacheSpaceID, recordSpaceID, targetID, targetVersionID int64, key string,
+	param *entity.ExecuteTargetCtx, inputData *entity.EvalTargetInputData, maxAge time.Duration,
+) (*entity.EvalTargetRecord, error) {
+	entry, err := e.getEntry(ctx, cacheSpaceID, entity.ResultCacheKindTarget, key, maxAge)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+
+	cached, err := e.evalTargetService.GetRecordByID(ctx, entry.RecordSpaceID, entry.RecordID)
+	if err != nil {
+		return nil, err
+	}
+	if !isReusableTargetRecord(cached) {
+		logs.CtxInfo(ctx, "[ExptResultCache] cached target record not reusable, record_id: %v", entry.RecordID)
+		return nil, nil
+	}
+	if err := e.evalTargetService.LoadRecordFullData(ctx, cached); err != nil {
+		return nil, err
+	}
+
+	recordID, err := e.idgen.GenID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ext := gmap.Clone(inputData.GetExt())
+	if ext == nil {
+		ext = make(map[string]string)
+	}
+	ext[entity.ResultCacheHitExtKey] = strconv.FormatInt(cached.ID, 10)
+
+	now := time.Now().UnixMilli()
+	record := &entity.EvalTargetRecord{
+		ID:                   recordID,
+		SpaceID:              recordSpaceID,
+		TargetID:             targetID,
+		TargetVersionID:      targetVersionID,
+		ExperimentRunID:      gptr.Indirect(param.ExperimentRunID),
+		ItemID:               param.ItemID,
+		TurnID:               param.TurnID,
+		TraceID:              cached.TraceID,
+		LogID:                logs.GetLogID(ctx),
+		EvalTargetInputData:  inputData,
+		EvalTargetOutputData: cached.EvalTargetOutputData,
+		Status:               cached.Status,
+		Ext:                  ext,
+		BaseInfo: &entity.BaseInfo{
+			CreatedAt: gptr.Of(now),
+			UpdatedAt: gptr.Of(now),
+		},
+	}
+	if err := e.evalTargetService.CreateRecord(ctx, record); err != nil {
+		return nil, err
+	}
+
+	logs.CtxInfo(ctx, "[ExptResultCache] reuse target record, source_record_id: %v, record_id: %v", cached.ID, record.ID)
+	return record, nil
+}
+
+func (e *ExptResultCacheServiceImpl) PutTargetRecord(ctx context.Context, cacheSpaceID int64, key string, record *entity.EvalTargetRecord) error {
+	if !isReusableTargetRecord(record) {
+		return nil
+	}
+	return e.putEntry(ctx, cacheSpaceID, entity.ResultCacheKindTarget, key, record.SpaceID, record.ID)
+}
+
+func (e *ExptResultCacheServiceImpl) ReuseEvaluatorRecord(ctx context.Context, key string, request *entity.RunEvaluatorRequest, maxAge time.Duration) (*entity.EvaluatorRecord, error) {
+	entry, err := e.getEntry(ctx, request.SpaceID, entity.ResultCacheKindEvaluator, key, maxAge)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+
+	records, err := e.evaluatorRecordService.BatchGetEvaluatorRecord(ctx, []int64{entry.RecordID}, false, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 || !isReusableEvaluatorRecord(records[0]) {
+		logs.CtxInfo(ctx, "[ExptResultCache] cached evaluator record not reusable, record_id: %v", entry.RecordID)
+		return nil, nil
+	}
+	cached := records[0]
+
+	recordID, err := e.idgen.GenID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ext := gmap.Clone(request.Ext)
+	if ext == nil {
+		ext = make(map[string]string)
+	}
+	ext[entity.ResultCacheHitExtKey] = strconv.FormatInt(cached.ID, 10)
+
+	record := &entity.EvaluatorRecord{
+		ID:                  recordID,
+		SpaceID:             request.SpaceID,
+		ExperimentID:        request.ExperimentID,
+		ExperimentRunID:     request.ExperimentRunID,
+		ItemID:              request.ItemID,
+		TurnID:              request.TurnID,
+		EvaluatorVersionID:  request.EvaluatorVersionID,
+		SourceType:          request.SourceType,
+		Alias:               request.Alias,
+		TraceID:             cached.TraceID,
+		LogID:               logs.GetLogID(ctx),
+		EvaluatorInputData:  request.InputData,
+		EvaluatorOutputData: cached.EvaluatorOutputData,
+		Status:              cached.Status,
+		Ext:                 ext,
+		BaseInfo:            &entity.BaseInfo{},
+	}
+	if err := e.evaluatorRecordRepo.CreateEvaluatorRecord(ctx, record); err != nil {
+		return nil, err
+	}
+
+	logs.CtxInfo(ctx, "[ExptResultCache] reuse evaluator record, source_record_id: %v, record_id: %v", cached.ID, record.ID)
+	return record, nil
+}
+
+func (e *ExptResultCacheServiceImpl) PutEvaluatorRecord(ctx context.Context, cacheSpaceID int64, key string, record *entity.EvaluatorRecord) error {
+	if !isReusableEvaluatorRecord(record) {
+		return nil
+	}
+	return e.putEntry(ctx, cacheSpaceID, entity.ResultCacheKindEvaluator, key, record.SpaceID, record.ID)
+}
+
+func (e *ExptResultCacheServiceImpl) GetSpaceConf(ctx context.Context, spaceID int64) (*entity.ResultCacheSpaceConf, error) {
+	return e.cacheRepo.GetSpaceConf(ctx, spaceID)
+}
+
+func (e *ExptResultCacheServiceImpl) SetSpaceTTL(ctx context.Context, spaceID int64, ttl time.Duration) error {
+	if spaceID <= 0 || ttl < time.Second {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid result cache ttl"))
+	}
+	return e.cacheRepo.SetSpaceTTL(ctx, spaceID, ttl)
+}
+
+func (e *ExptResultCacheServiceImpl) Invalidate(ctx context.Context, spaceID int64) error {
+	if spaceID <= 0 {
+		return errorx.NewByCode(errno.CommonInvalidParamCode)
+	}
+	generation, err := e.cacheRepo.IncrSpaceGeneration(ctx, spaceID)
+	if err != nil {
+		return err
+	}
+	logs.CtxInfo(ctx, "[ExptResultCache] invalidate space result cache, space_id: %v, generation: %v", spaceID, generation)
+	return nil
+}
+
+func (e *ExptResultCacheServiceImpl) getEntry(ctx context.Context, spaceID int64, kind entity.ResultCacheKind, key string, maxAge time.Duration) (*entity.ResultCacheEntry, error) {
+	conf, err := e.cacheRepo.GetSpaceConf(ctx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := e.cacheRepo.GetResultCache(ctx, conf, kind, key)
+	if err != nil || entry == nil || entry.RecordID <= 0 {
+		return nil, err
+	}
+	if maxAge > 0 && time.Since(time.UnixMilli(entry.CreatedAt)) > maxAge {
+		return nil, nil
+	}
+	return entry, nil
+}
+
+func (e *ExptResultCacheServiceImpl) putEntry(ctx context.Context, spaceID int64, kind entity.ResultCacheKind, key string, recordSpaceID, recordID int64) error {
+	conf, err := e.cacheRepo.GetSpaceConf(ctx, spaceID)
+	if err != nil {
+		return err
+	}
+	return e.cacheRepo.SetResultCache(ctx, conf, kind, key, &entity.ResultCacheEntry{
+		RecordSpaceID: recordSpaceID,
+		RecordID:      recordID,
+		CreatedAt:     time.Now().UnixMilli(),
+	})
+}
+
+func isReusableTargetRecord(record *entity.EvalTargetRecord) bool {
+	if record == nil || record.ID <= 0 || gptr.Indirect(record.Status) != entity.EvalTargetRunStatusSuccess {
+		return false
+	}
+	return record.EvalTargetOutputData == nil || record.EvalTargetOutputData.EvalTargetRunError == nil
+}
+
+func isReusableEvaluatorRecord(record *entity.EvaluatorRecord) bool {
+	if record == nil || record.ID <= 0 || record.Status != entity.EvaluatorRunStatusSuccess {
+		return false
+	}
+	return record.EvaluatorOutputData == nil || record.EvaluatorOutputData.EvaluatorRunError == nil
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	idgenmocks "github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repoMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+type resultCacheTestDeps struct {
+	cacheRepo       *repoMocks.MockIExptResultCacheRepo
+	targetSvc       *svcMocks.MockIEvalTargetService
+	recordSvc       *svcMocks.MockEvaluatorRecordService
+	recordRepo      *repoMocks.MockIEvaluatorRecordRepo
+	idgen           *idgenmocks.MockIIDGenerator
+	svc             IExptResultCacheService
+	spaceConf       *entity.ResultCacheSpaceConf
+	expectSpaceConf func()
+}
+
+func newResultCacheTestDeps(t *testing.T) *resultCacheTestDeps {
+	ctrl := gomock.NewController(t)
+	d := &resultCacheTestDeps{
+		cacheRepo:  repoMocks.NewMockIExptResultCacheRepo(ctrl),
+		targetSvc:  svcMocks.NewMockIEvalTargetService(ctrl),
+		recordSvc:  svcMocks.NewMockEvaluatorRecordService(ctrl),
+		recordRepo: repoMocks.NewMockIEvaluatorRecordRepo(ctrl),
+		idgen:      idgenmocks.NewMockIIDGenerator(ctrl),
+		spaceConf:  &entity.ResultCacheSpaceConf{SpaceID: 1, TTL: time.Hour, Generation: 3},
+	}
+	d.svc = NewExptResultCacheService(d.cacheRepo, d.targetSvc, d.recordSvc, d.recordRepo, d.idgen)
+	d.expectSpaceConf = func() { d.cacheRepo.EXPECT().GetSpaceConf(gomock.Any(), int64(1)).Return(d.spaceConf, nil) }
+	return d
+}
+
+func TestExptResultCacheServiceImpl_ReuseTargetRecord(t *testing.T) {
+	ctx := context.Background()
+	param := &entity.ExecuteTargetCtx{ExperimentRunID: gptr.Of(int64(7)), ItemID: 8, TurnID: 9}
+	input := &entity.EvalTargetInputData{Ext: map[string]string{"k": "v"}}
+	output := &entity.EvalTargetOutputData{OutputFields: map[string]*entity.Content{"actual_output": {Text: gptr.Of("a")}}}
+
+	t.Run("未命中", func(t *testing.T) {
+		d := newResultCacheTestDeps(t)
+		d.expectSpaceConf()
+		d.cacheRepo.EXPECT().GetResultCache(gomock.Any(), d.spaceConf, entity.ResultCacheKindTarget, "key").Return(nil, nil)
+		got, err := d.svc.ReuseTargetRecord(ctx, 1, 2, 3, 4, "key", param, input, 0)
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("超过实验缓存 TTL 视为未命中", func(t *testing.T) {
+		d := newResultCacheTestDeps(t)
+		d.expectSpaceConf()
+		d.cacheRepo.EXPECT().GetResultCache(gomock.Any(), d.spaceConf, entity.ResultCacheKindTarget, "key").
+			Return(&entity.ResultCacheEntry{RecordSpaceID: 2, RecordID: 100, CreatedAt: time.Now().Add(-2 * time.Hour).UnixMilli()}, nil)
+		got, err := d.svc.ReuseTargetRecord(ctx, 1, 2, 3, 4, "key", param, input, time.Hour)
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("源 record 失败不复用", func(t *testing.T) {
+		d := newResultCacheTestDeps(t)
+		d.expectSpaceConf()
+		d.cacheRepo.EXPECT().GetResultCache(gomock.Any(), d.spaceConf, entity.ResultCacheKindTarget, "key").
+			Return(&entity.ResultCacheEntry{RecordSpaceID: 2, RecordID: 100}, nil)
+		d.targetSvc.EXPECT().GetRecordByID(gomock.Any(), int64(2), int64(100)).
+			Return(&entity.EvalTargetRecord{ID: 100, Status: gptr.Of(entity.EvalTargetRunStatusFail)}, nil)
+		got, err := d.svc.ReuseTargetRecord(ctx, 1, 2, 3, 4, "key", param, input, 0)
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("命中后落新 record", func(t *testing.T) {
+		d := newResultCacheTestDeps(t)
+		d.expectSpaceConf()
+		d.cacheRepo.EXPECT().GetResultCache(gomock.Any(), d.spaceConf, entity.ResultCacheKindTarget, "key").
+			Return(&entity.ResultCacheEntry{RecordSpaceID: 2, RecordID: 100}, nil)
+		cached := &entity.EvalTargetRecord{ID: 100, Status: gptr.Of(entity.EvalTargetRunStatusSuccess), EvalTargetOutputData: output}
+		d.targetSvc.EXPECT().GetRecordByID(gomock.Any(), int64(2), int64(100)).Return(cached, nil)
+		d.targetSvc.EXPECT().LoadRecordFullData(gomock.Any(), cached).Return(nil)
+		d.idgen.EXPECT().GenID(gomock.Any()).Return(int64(200), nil)
+		d.targetSvc.EXPECT().CreateRecord(gomock.Any(), gomock.Any()).Return(nil)
+
+		got, err := d.svc.ReuseTargetRecord(ctx, 1, 2, 3, 4, "key", param, input, 0)
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, int64(200), got.ID)
+		assert.Equal(t, int64(2), got.SpaceID)
+		assert.Equal(t, int64(7), got.ExperimentRunID)
+		assert.Equal(t, output, got.EvalTargetOutputData)
+		assert.Equal(t, "100", got.Ext[entity.ResultCacheHitExtKey])
+		assert.Equal(t, "v", got.Ext["k"])
+		// 不污染调用方的输入 Ext
+		assert.NotContains(t, input.Ext, entity.ResultCacheHitExtKey)
+	})
+}
+
+func TestExptResultCacheServiceImpl_PutTargetRecord(t *testing.T) {
+	d := newResultCacheTestDeps(t)
+	ctx := context.Background()
+
+	// 失败 record 不写缓存
+	assert.NoError(t, d.svc.PutTargetRecord(ctx, 1, "key", &entity.EvalTargetRecord{ID: 100, Status: gptr.Of(entity.EvalTargetRunStatusFail)}))
+	assert.NoError(t, d.svc.PutTargetRecord(ctx, 1, "key", nil))
+
+	d.expectSpaceConf()
+	d.cacheRepo.EXPECT().SetResultCache(gomock.Any(), d.spaceConf, entity.ResultCacheKindTarget, "key", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *entity.ResultCacheSpaceConf, _ entity.ResultCacheKind, _ string, entry *entity.ResultCacheEntry) error {
+			assert.Equal(t, int64(2), entry.RecordSpaceID)
+			assert.Equal(t, int64(100), entry.RecordID)
+			return nil
+		})
+	assert.NoError(t, d.svc.PutTargetRecord(ctx, 1, "key", &entity.EvalTargetRecord{ID: 100, SpaceID: 2, Status: gptr.Of(entity.EvalTargetRunStatusSuccess)}))
+}
+
+func TestExptResultCacheServiceImpl_ReuseEvaluatorRecord(t *testing.T) {
+	d := newResultCacheTestDeps(t)
+	ctx := context.Background()
+	req := &entity.RunEvaluatorRequest{
+		SpaceID:            1,
+		EvaluatorVersionID: 10,
+		ExperimentID:       5,
+		ExperimentRunID:    6,
+		ItemID:             7,
+		TurnID:             8,
+		Alias:              "a1",
+		InputData:          &entity.EvaluatorInputData{},
+	}
+	outputData := &entity.EvaluatorOutputData{EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(0.5)}}
+
+	d.expectSpaceConf()
+	d.cacheRepo.EXPECT().GetResultCache(gomock.Any(), d.spaceConf, entity.ResultCacheKindEvaluator, "key").
+		Return(&entity.ResultCacheEntry{RecordSpaceID: 1, RecordID: 100}, nil)
+	d.recordSvc.EXPECT().BatchGetEvaluatorRecord(gomock.Any(), []int64{100}, false, true).
+		Return([]*entity.EvaluatorRecord{{ID: 100, Status: entity.EvaluatorRunStatusSuccess, EvaluatorOutputData: outputData}}, nil)
+	d.idgen.EXPECT().GenID(gomock.Any()).Return(int64(200), nil)
+	d.recordRepo.EXPECT().CreateEvaluatorRecord(gomock.Any(), gomock.Any()).Return(nil)
+
+	got, err := d.svc.ReuseEvaluatorRecord(ctx, "key", req, 0)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, int64(200), got.ID)
+	assert.Equal(t, int64(5), got.ExperimentID)
+	assert.Equal(t, "a1", got.Alias)
+	assert.Equal(t, outputData, got.EvaluatorOutputData)
+	assert.Equal(t, "100", got.Ext[entity.ResultCacheHitExtKey])
+}
+
+func TestExptResultCacheServiceImpl_SpaceConf(t *testing.T) {
+	d := newResultCacheTestDeps(t)
+	ctx := context.Background()
+
+	assert.Error(t, d.svc.SetSpaceTTL(ctx, 1, 0))
+	assert.Error(t, d.svc.Invalidate(ctx, 0))
+
+	d.cacheRepo.EXPECT().SetSpaceTTL(gomock.Any(), int64(1), time.Hour).Return(nil)
+	assert.NoError(t, d.svc.SetSpaceTTL(ctx, 1, time.Hour))
+
+	d.cacheRepo.EXPECT().IncrSpaceGeneration(gomock.Any(), int64(1)).Return(int64(4), nil)
+	assert.NoError(t, d.svc.Invalidate(ctx, 1))
+}
@@ -57,6 +57,7 @@ func NewExptResultService(
 	analysisService IEvaluationAnalysisService,
 	fileProvider rpc.IFileProvider,
 	scoreCalculator IEvaluatorScoreCalculator,
+	costCalculator IExptCostCalculator,
 ) ExptResultService {
 	return &ExptResultServiceImpl{
 		ExptItemResultRepo:          exptItemResultRepo,
@@ -79,6 +80,7 @@ func NewExptResultService(
 		analysisService:             analysisService,
 		fileProvider:                fileProvider,
 		scoreCalculator:             scoreCalculator,
+		costCalculator:              costCalculator,
 	}
 }
 
@@ -106,6 +108,8 @@ type ExptResultServiceImpl struct {
 	analysisService IEvaluationAnalysisService
 
 	scoreCalculator IEvaluatorScoreCalculator
+	// costCalculator 允许为 nil, 为 nil 时不核算花费
+	costCalculator IExptCostCalculator
 }
 
 func (e ExptResultServiceImpl) GetExptItemTurnResults(ctx context.Context, exptID, itemID, spaceID int64, session *entity.Session) ([]*entity.ExptTurnResult, error) {
@@ -190,6 +194,7 @@ func (e ExptResultServiceImpl) RecordItemRunLogs(ctx context.Context, exptID, ex
 	var (
 		turnEvaluatorRefs []*entity.ExptTurnEvaluatorResultRef
 		turn2Result       = gslice.ToMap(turnResults, func(t *entity.ExptTurnResult) (int64, *entity.ExptTurnResult) { return t.TurnID, t })
+		evaluatorRecords  []*entity.EvaluatorRecord
 	)
 
 	for tid, result := range turn2Result {
@@ -203,6 +208,8 @@ func (e ExptResultServiceImpl) RecordItemRunLogs(ctx context.Context, exptID, ex
 		result.ErrMsg = rl.ErrMsg
 		result.LogID = rl.LogID
 		result.ExptRunID = rl.ExptRunID
+		result.CacheHit = rl.CacheHit
+		result.TrialResults = rl.TrialResults
 
 		turnEvaluatorRefs = append(turnEvaluatorRefs, NewTurnEvaluatorResultRefs(0, result.ExptID, result.ID, spaceID, rl.EvaluatorResultIds)...)
 
@@ -230,6 +237,7 @@ func (e ExptResultServiceImpl) RecordItemRunLogs(ctx context.Context, exptID, ex
 				logs.CtxError(ctx, "[ExptEval] RecordItemRunLogs BatchGetEvaluatorRecord failed, expt_id=%v, expt_run_id=%v, item_id=%v, turn_id=%v, err=%v",
 					exptID, exptRunID, itemID, tid, err)
 			} else {
+				evaluatorRecords = append(evaluatorRecords, records...)
 				version2Record := make(map[string]*entity.EvaluatorRecord, len(records))
 				for _, r := range records {
 					if r == nil {
@@ -278,6 +286,8 @@ func (e ExptResultServiceImpl) RecordItemRunLogs(ctx context.Context, exptID, ex
 		return nil, err
 	}
 
+	statsCntOp.CostDelta = e.calcItemCost(ctx, spaceID, exptID, expt, turnRunLogs, evaluatorRecords)
+
 	if err := e.ExptStatsRepo.ArithOperateCount(ctx, exptID, spaceID, statsCntOp); err != nil {
 		return nil, err
 	}
@@ -285,6 +295,70 @@ func (e ExptResultServiceImpl) RecordItemRunLogs(ctx context.Context, exptID, ex
 	return turnEvaluatorRefs, nil
 }
 
+// calcItemCost 核算 item 各 turn 的评测对象与评估器花费之和, 加载评测对象结果失败时只计评估器部分
+func (e ExptResultServiceImpl) calcItemCost(ctx context.Context, spaceID, exptID int64, expt *entity.Experiment, turnRunLogs []*entity.ExptTurnResultRunLog,
+	evaluatorRecords []*entity.EvaluatorRecord,
+) *entity.ExptTurnCost {
+	if e.costCalculator == nil {
+		return nil
+	}
+
+	var (
+		targetRecords      []*entity.EvalTargetRecord
+		trialEvalRecordIDs []int64
+		targetResultIDs    = make([]int64, 0, len(turnRunLogs))
+	)
+	for _, rl := range turnRunLogs {
+		if rl == nil {
+			continue
+		}
+		if rl.TargetResultID > 0 {
+			targetResultIDs = append(targetResultIDs, rl.TargetResultID)
+		}
+		// 重复试验的评测对象与评估器花费一并计入
+		for _, trial := range rl.TrialResults {
+			if trial == nil {
+				continue
+			}
+			if trial.TargetResultID > 0 {
+				targetResultIDs = append(targetResultIDs, trial.TargetResultID)
+			}
+			for _, er := range trial.EvaluatorResults {
+				if er != nil && er.RecordID > 0 {
+					trialEvalRecordIDs = append(trialEvalRecordIDs, er.RecordID)
+				}
+			}
+		}
+	}
+	if len(trialEvalRecordIDs) > 0 {
+		records, err := e.evaluatorRecordService.BatchGetEvaluatorRecord(ctx, trialEvalRecordIDs, false, false)
+		if err != nil {
+			logs.CtxWarn(ctx, "[ExptEval] calcItemCost BatchGetEvaluatorRecord fail, expt_id=%v, err=%v", exptID, err)
+		} else {
+			evaluatorRecords = append(append([]*entity.EvaluatorRecord(nil), evaluatorRecords...), records...)
+		}
+	}
+	if len(targetResultIDs) > 0 && e.evalTargetService != nil {
+		targetSpaceID := spaceID
+		if expt != nil {
+			targetSpaceID = resolveLoadSpaceID(spaceID, expt.TargetSpaceID)
+		}
+		records, err := e.evalTargetService.BatchGetRecordByIDs(ctx, targetSpaceID, targetResultIDs)
+		if err != nil {
+			logs.CtxWarn(ctx, "[ExptEval] calcItemCost BatchGetRecordByIDs fail, expt_id=%v, err=%v", exptID, err)
+		} else {
+			targetRecords = records
+		}
+	}
+
+	total := &entity.ExptTurnCost{}
+	for _, tr := range targetRecords {
+		total.Add(e.costCalculator.CalcTurnCost(ctx, expt, tr, nil))
+	}
+	total.Add(e.costCalculator.CalcTurnCost(ctx, expt, nil, evaluatorRecords))
+	return total
+}
+
 func NewTurnEvaluatorResultRefs(id, exptID, turnResultID, spaceID int64, evaluatorResults *entity.EvaluatorResults) []*entity.ExptTurnEvaluatorResultRef {
 	if evaluatorResults == nil {
 		return nil
@@ -411,7 +485,7 @@ func (e ExptResultServiceImpl) MGetExperimentResult(ctx context.Context, param *
 	}
 
 	// ★ MultiSetConfig: 列定义取所有评测集字段 schema 的并集 (各集列名去重); SingleSet/老实验仍只取主集。
-	columnEvalSetFields, err := e.getColumnEvalSetFieldsMultiSet(ctx, spaceID, baseExpt)
+	columnEvalSetFields, evalSetIDsByFieldKey, err := e.getColumnEvalSetFieldsMultiSet(ctx, spaceID, baseExpt)
 	if err != nil {
 		return nil, err
 	}
@@ -426,6 +500,7 @@ func (e ExptResultServiceImpl) MGetExperimentResult(ctx context.Context, param *
 		ExptColumnEvaluators:  exptColumnEvaluators,
 		ColumnEvalSetFields:   columnEvalSetFields,
 		ExptColumnsEvalTarget: columnsEvalTarget,
+		EvalSetIDsByFieldKey:  evalSetIDsByFieldKey,
 	}
 
 	if baseExpt.ExptType == entity.ExptType_Online && len(exptIDs) > 1 {
@@ -672,6 +747,7 @@ func desensitizeSharedEvalSetResult(res *entity.MGetExperimentReportResult, expt
 	}
 	if anyExecute {
 		res.ColumnEvalSetFields = nil // 抹评测集列定义
+		res.EvalSetIDsByFieldKey = nil
 	}
 	// 逐条抹掉命中 execute 的 Payload.EvalSet (per-set 判定)
 	for _, item := range res.ItemResults {
@@ -1179,36 +1255,40 @@ func collectEvalSetVersionPairs(expt *entity.Experiment) []evalSetVersionPair {
 	return pairs
 }
 
-// getColumnEvalSetFieldsMultiSet 取实验所有评测集的字段 schema 并集 (按列 Key 去重, 主集列在前)。
-// SingleSet/老实验退化为只取主集, 行为与原 getColumnEvalSetFields 一致 (含 evalSetID/version 为 0 的边界)。
-func (e ExptResultServiceImpl) getColumnEvalSetFieldsMultiSet(ctx context.Context, spaceID int64, expt *entity.Experiment) ([]*entity.ColumnEvalSetField, error) {
+// getColumnEvalSetFieldsMultiSet 取实验所有评测集的字段 schema 并集 (按列 Key 去重, 主集列在前),
+// 并返回各列 Key 所属的评测集 ID, 供按评测集分 sheet 导出。
+// SingleSet/老实验退化为只取主集, 行为与原 getColumnEvalSetFields 一致 (含 evalSetID/version 为 0 的边界), 归属为 nil。
+func (e ExptResultServiceImpl) getColumnEvalSetFieldsMultiSet(ctx context.Context, spaceID int64, expt *entity.Experiment) ([]*entity.ColumnEvalSetField, map[string][]int64, error) {
 	// 非 MultiSetConfig: 完全沿用原有单集调用 (含主集 id/version 透传, 不改变任何边界语义)。
 	// 跨空间: 单集来源空间取 expt 冻结列。
 	if expt.EvalSetSourceType != entity.ExptEvalSetSourceType_MultiSetConfig {
-		return e.getColumnEvalSetFieldsWithSource(ctx, spaceID, expt.EvalSetID, expt.EvalSetVersionID, expt.EvalSetSpaceID)
+		fields, err := e.getColumnEvalSetFieldsWithSource(ctx, spaceID, expt.EvalSetID, expt.EvalSetVersionID, expt.EvalSetSpaceID)
+		return fields, nil, err
 	}
 
 	pairs := collectEvalSetVersionPairs(expt)
 	merged := make([]*entity.ColumnEvalSetField, 0)
-	seenKey := make(map[string]struct{})
+	evalSetIDsByKey := make(map[string][]int64)
 	for _, p := range pairs {
 		fields, err := e.getColumnEvalSetFieldsWithSource(ctx, spaceID, p.EvalSetID, p.EvalSetVersionID, p.SourceSpaceID)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		for _, f := range fields {
 			if f == nil {
 				continue
 			}
 			key := gptr.Indirect(f.Key)
-			if _, ok := seenKey[key]; ok {
-				continue
+			ids, ok := evalSetIDsByKey[key]
+			if !ok {
+				merged = append(merged, f)
+			}
+			if !gslice.Contains(ids, p.EvalSetID) {
+				evalSetIDsByKey[key] = append(ids, p.EvalSetID)
 			}
-			seenKey[key] = struct{}{}
-			merged = append(merged, f)
 		}
 	}
-	return merged, nil
+	return merged, evalSetIDsByKey, nil
 }
 
 func (e ExptResultServiceImpl) getColumnAnnotations(ctx context.Context, spaceID int64, exptIDs []int64) ([]*entity.ExptColumnAnnotation, error) {
@@ -1463,6 +1543,7 @@ type ExptResultBuilder struct {
 	turnResultID2ScoreCorrected            map[int64]bool
 	turnResultID2TagKeyID2AnnotateRecord   map[int64]map[int64]*entity.AnnotateRecord // turn_result_id -> tag_key_id -> annotate_record
 	itemIDTurnID2TrajectoryAnalysis        map[int64]map[int64]*entity.AnalysisRecord
+	turnResultID2TrialOutputs              map[int64][]*entity.TurnTrialOutput
 
 	// 错误信息
 	Err error
@@ -1799,6 +1880,7 @@ func (b *PayloadBuilder) fillItemResults(ctx context.Context) error {
 				exptResult.Payload.SystemInfo = exptResultBuilder.getTurnSystemInfo(ctx, itemID, turnID)
 				exptResult.Payload.AnnotateResult = exptResultBuilder.getTurnAnnotateRecord(ctx, itemID, turnID)
 				exptResult.Payload.AnalysisRecord = exptResultBuilder.getAnalysisRecord(ctx, itemID, turnID)
+				exptResult.Payload.TrialOutputs = exptResultBuilder.getTurnTrialOutputs(ctx, itemID, turnID)
 				itemResult.TurnResults[j].ExperimentResults = append(itemResult.TurnResults[j].ExperimentResults, exptResult)
 			}
 		}
@@ -1874,6 +1956,10 @@ func (e *ExptResultBuilder) build(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	err = e.buildTrialOutputs(ctx)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -2454,6 +2540,104 @@ func (e *ExptResultBuilder) getTurnTargetOutput(ctx context.Context, itemID, tur
 	return turnTargetOutput
 }
 
+// buildTrialOutputs 加载重复试验实验中额外试验的评测对象与评估器结果, 无试验结果时不做查询
+func (e *ExptResultBuilder) buildTrialOutputs(ctx context.Context) error {
+	if e.exptDO.ExptType == entity.ExptType_Online {
+		return nil
+	}
+	var targetResultIDs, evaluatorResultIDs []int64
+	for _, turnResult := range e.turnResultDO {
+		for _, trial := range turnResult.TrialResults {
+			if trial == nil {
+				continue
+			}
+			if trial.TargetResultID > 0 {
+				targetResultIDs = append(targetResultIDs, trial.TargetResultID)
+			}
+			for _, er := range trial.EvaluatorResults {
+				if er != nil && er.RecordID > 0 {
+					evaluatorResultIDs = append(evaluatorResultIDs, er.RecordID)
+				}
+			}
+		}
+	}
+	if len(targetResultIDs) == 0 && len(evaluatorResultIDs) == 0 {
+		return nil
+	}
+
+	id2TargetRecord := make(map[int64]*entity.EvalTargetRecord, len(targetResultIDs))
+	if len(targetResultIDs) > 0 {
+		targetRecords, err := e.evalTargetService.BatchGetRecordByIDs(ctx, resolveLoadSpaceID(e.SpaceID, e.exptDO.TargetSpaceID), targetResultIDs)
+		if err != nil {
+			return err
+		}
+		for _, targetRecord := range targetRecords {
+			if targetRecord == nil {
+				continue
+			}
+			if len(e.LoadEvalTargetOutputFieldKeys) > 0 {
+				if err := e.evalTargetService.LoadRecordOutputFields(ctx, targetRecord, e.LoadEvalTargetOutputFieldKeys); err != nil {
+					return err
+				}
+			} else if e.LoadEvalTargetFullContent {
+				if err := e.evalTargetService.LoadRecordFullData(ctx, targetRecord); err != nil {
+					return err
+				}
+			}
+			id2TargetRecord[targetRecord.ID] = targetRecord
+		}
+	}
+
+	id2EvaluatorRecord := make(map[int64]*entity.EvaluatorRecord, len(evaluatorResultIDs))
+	if len(evaluatorResultIDs) > 0 {
+		evaluatorRecords, err := e.evaluatorRecordService.BatchGetEvaluatorRecord(ctx, evaluatorResultIDs, false, e.LoadEvaluatorFullContent)
+		if err != nil {
+			return err
+		}
+		for _, evaluatorRecord := range evaluatorRecords {
+			if evaluatorRecord != nil {
+				id2EvaluatorRecord[evaluatorRecord.ID] = evaluatorRecord
+			}
+		}
+	}
+
+	e.turnResultID2TrialOutputs = make(map[int64][]*entity.TurnTrialOutput)
+	for _, turnResult := range e.turnResultDO {
+		for _, trial := range turnResult.TrialResults {
+			if trial == nil {
+				continue
+			}
+			output := &entity.TurnTrialOutput{
+				TrialIdx:        trial.TrialIdx,
+				TargetOutput:    &entity.TurnTargetOutput{EvalTargetRecord: id2TargetRecord[trial.TargetResultID]},
+				EvaluatorOutput: &entity.TurnEvaluatorOutput{EvaluatorRecords: make(map[int64]*entity.EvaluatorRecord)},
+				ErrMsg:          trial.ErrMsg,
+			}
+			for _, er := range trial.EvaluatorResults {
+				if er == nil {
+					continue
+				}
+				if record, ok := id2EvaluatorRecord[er.RecordID]; ok {
+					output.EvaluatorOutput.EvaluatorRecords[er.VersionID] = record
+				}
+			}
+			e.turnResultID2TrialOutputs[turnResult.ID] = append(e.turnResultID2TrialOutputs[turnResult.ID], output)
+		}
+		sort.Slice(e.turnResultID2TrialOutputs[turnResult.ID], func(i, j int) bool {
+			return e.turnResultID2TrialOutputs[turnResult.ID][i].TrialIdx < e.turnResultID2TrialOutputs[turnResult.ID][j].TrialIdx
+		})
+	}
+	return nil
+}
+
+func (e *ExptResultBuilder) getTurnTrialOutputs(ctx context.Context, itemID, turnID int64) []*entity.TurnTrialOutput {
+	turnResultID, ok := e.ItemIDTurnID2TurnResultID[itemID][turnID]
+	if !ok {
+		return nil
+	}
+	return e.turnResultID2TrialOutputs[turnResultID]
+}
+
 func (e *ExptResultBuilder) getTurnSystemInfo(ctx context.Context, itemID, turnID int64) *entity.TurnSystemInfo {
 	turnResultID2TurnResult := gslice.ToMap(e.turnResultDO, func(t *entity.ExptTurnResult) (int64, *entity.ExptTurnResult) {
 		return t.ID, t
@@ -2263,6 +2263,7 @@ func TestNewExptResultService(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 	)
 
 	impl, ok := svc.(*ExptResultServiceImpl)
@@ -7965,7 +7966,7 @@ func TestGetColumnEvalSetFieldsMultiSet(t *testing.T) {
 				},
 			}, nil, nil).Times(1)
 
-		cols, err := svc.getColumnEvalSetFieldsMultiSet(context.Background(), 7, expt)
+		cols, _, err := svc.getColumnEvalSetFieldsMultiSet(context.Background(), 7, expt)
 		assert.NoError(t, err)
 		assert.Len(t, cols, 2)
 		assert.Equal(t, "input", gptr.Indirect(cols[0].Key))
@@ -8004,8 +8005,9 @@ func TestGetColumnEvalSetFieldsMultiSet(t *testing.T) {
 				},
 			}, nil, nil).Times(1)
 
-		cols, err := svc.getColumnEvalSetFieldsMultiSet(context.Background(), 7, expt)
+		cols, evalSetIDsByKey, err := svc.getColumnEvalSetFieldsMultiSet(context.Background(), 7, expt)
 		assert.NoError(t, err)
+		assert.Equal(t, map[string][]int64{"input": {100, 200}, "output": {100}, "extra": {200}}, evalSetIDsByKey)
 		// 并集去重: input, output (主集) + extra (set2)
 		keys := make([]string, 0, len(cols))
 		for _, c := range cols {
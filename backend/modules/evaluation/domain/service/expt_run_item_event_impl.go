@@ -22,6 +22,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/events"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/contexts"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
 	"github.com/coze-dev/coze-loop/backend/pkg/ctxcache"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
@@ -53,7 +54,10 @@ type ExptItemEventEvalServiceImpl struct {
 	idgen                    idgen.IIDGenerator
 	benefitService           benefit.IBenefitService
 	evalAsyncRepo            repo.IEvalAsyncRepo
+	resultCache              IExptResultCacheService
+	concurCtrl               IExptConcurrencyController
 	itemCompletePublisher    component.IItemCompletePublisher
+	userSimulator            ExptUserSimulator
 	sandboxAgentNotifier     ISandboxAgentNotifier // 传递给 ExptItemEvalCtxExecutor 用于失败行飞书通知
 }
 
@@ -79,7 +83,10 @@ func NewExptRecordEvalService(
 	idgen idgen.IIDGenerator,
 	benefitService benefit.IBenefitService,
 	evalAsyncRepo repo.IEvalAsyncRepo,
+	resultCache IExptResultCacheService,
+	concurCtrl IExptConcurrencyController,
 	itemCompletePublisher component.IItemCompletePublisher,
+	userSimulator ExptUserSimulator,
 	sandboxAgentNotifier ...ISandboxAgentNotifier, // variadic 兼容 wire_gen 未接入通知器
 ) ExptItemEvalEvent {
 	i := &ExptItemEventEvalServiceImpl{
@@ -104,7 +111,10 @@ func NewExptRecordEvalService(
 		idgen:                    idgen,
 		benefitService:           benefitService,
 		evalAsyncRepo:            evalAsyncRepo,
+		resultCache:              resultCache,
+		concurCtrl:               concurCtrl,
 		itemCompletePublisher:    itemCompletePublisher,
+		userSimulator:            userSimulator,
 	}
 	if len(sandboxAgentNotifier) > 0 {
 		i.sandboxAgentNotifier = sandboxAgentNotifier[0]
@@ -114,6 +124,7 @@ func NewExptRecordEvalService(
 		i.HandleEventErr,
 		i.HandleEventCheck,
 		i.HandleEventLock,
+		i.HandleEventAttempt,
 		i.HandleEventExec,
 	)(func(_ context.Context, _ *entity.ExptItemEvalEvent) error { return nil })
 
@@ -249,10 +260,16 @@ func (e *ExptItemEventEvalServiceImpl) completeItemRunOnUnretriableErr(ctx conte
 		"err_msg":      errno.SerializeErr(evalErr),
 		"result_state": int32(entity.ExptItemResultStateLogged),
 	}
-	if err := e.exptItemResultRepo.UpdateItemRunLog(persistCtx, event.ExptID, event.ExptRunID,
-		[]int64{event.EvalSetItemID}, ufields, event.SpaceID); err != nil {
+	updated, err := e.exptItemResultRepo.UpdateItemRunLogByAttempt(persistCtx, event.ExptID, event.ExptRunID,
+		event.EvalSetItemID, event.Attempt, ufields, event.SpaceID)
+	if err != nil {
 		logs.CtxWarn(persistCtx, "completeItemRunOnUnretriableErr update item run log fail, expt_id: %v, expt_run_id: %v, item_id: %v, err: %v",
 			event.ExptID, event.ExptRunID, event.EvalSetItemID, err)
+	} else if !updated {
+		// 该轮执行已被续跑接替, 不覆盖新轮次的状态
+		logs.CtxWarn(persistCtx, "completeItemRunOnUnretriableErr found superseded attempt, expt_id: %v, expt_run_id: %v, item_id: %v, attempt: %v",
+			event.ExptID, event.ExptRunID, event.EvalSetItemID, event.Attempt)
+		return
 	}
 
 	if e.exptTurnResultRepo == nil {
@@ -289,6 +306,29 @@ func (e *ExptItemEventEvalServiceImpl) HandleEventLock(next RecordEvalEndPoint)
 	}
 }
 
+// HandleEventAttempt 在 item 锁内校验事件的执行轮次: 轮次落后于 item run log 说明该执行已被僵尸续跑接替, 丢弃事件。
+// 异步回调事件只是唤醒信号, 结果已落在 record 上, 沿用 item 当前轮次继续执行。
+func (e *ExptItemEventEvalServiceImpl) HandleEventAttempt(next RecordEvalEndPoint) RecordEvalEndPoint {
+	return func(ctx context.Context, event *entity.ExptItemEvalEvent) error {
+		runLogs, err := e.exptItemResultRepo.MGetItemRunLog(contexts.WithCtxWriteDB(ctx), event.ExptID, event.ExptRunID, []int64{event.EvalSetItemID}, event.SpaceID)
+		if err != nil {
+			return err
+		}
+		if len(runLogs) == 0 || runLogs[0] == nil || runLogs[0].Attempt == event.Attempt {
+			return next(ctx, event)
+		}
+
+		if event.AsyncReportTrigger || event.AsyncEvaluatorReportTrigger {
+			event.Attempt = runLogs[0].Attempt
+			return next(ctx, event)
+		}
+
+		logs.CtxWarn(ctx, "ExptRecordEvalConsumer.HandleEventAttempt found superseded item eval event, expt_id: %v, item_id: %v, event_attempt: %v, attempt: %v",
+			event.ExptID, event.EvalSetItemID, event.Attempt, runLogs[0].Attempt)
+		return nil
+	}
+}
+
 func (e *ExptItemEventEvalServiceImpl) HandleEventExec(next RecordEvalEndPoint) RecordEvalEndPoint {
 	return func(ctx context.Context, event *entity.ExptItemEvalEvent) error {
 		if err := e.eval(ctx, event); err != nil {
@@ -326,7 +366,7 @@ func (e *ExptItemEventEvalServiceImpl) eval(ctx context.Context, event *entity.E
 		return err
 	}
 
-	if err := NewExptItemEvaluation(e.exptTurnResultRepo, e.exptItemResultRepo, e.configer, e.metric, e.evaTargetService, e.evaluatorRecordService, e.evaluatorService, e.benefitService, e.evalAsyncRepo, e.evaluationSetItemService, e.itemCompletePublisher, e.sandboxAgentNotifier).
+	if err := NewExptItemEvaluation(e.exptTurnResultRepo, e.exptItemResultRepo, e.configer, e.metric, e.evaTargetService, e.evaluatorRecordService, e.evaluatorService, e.benefitService, e.evalAsyncRepo, e.evaluationSetItemService, e.resultCache, e.concurCtrl, e.itemCompletePublisher, e.userSimulator, e.idgen, e.sandboxAgentNotifier).
 		Eval(ctx, eiec); err != nil {
 		return err
 	}
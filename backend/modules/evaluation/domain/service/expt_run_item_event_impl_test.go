@@ -50,6 +50,9 @@ func TestNewExptRecordEvalService(t *testing.T) {
 		benefitmocks.NewMockIBenefitService(ctrl),
 		repoMocks.NewMockIEvalAsyncRepo(ctrl),
 		nil,
+		nil,
+		nil,
+		nil,
 	)
 	assert.NotNil(t, service)
 }
@@ -403,6 +406,85 @@ func TestExptItemEventEvalServiceImpl_HandleEventLock(t *testing.T) {
 	}
 }
 
+func TestExptItemEventEvalServiceImpl_HandleEventAttempt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockItemResultRepo := repoMocks.NewMockIExptItemResultRepo(ctrl)
+	service := &ExptItemEventEvalServiceImpl{
+		exptItemResultRepo: mockItemResultRepo,
+	}
+
+	tests := []struct {
+		name        string
+		prepare     func()
+		event       *entity.ExptItemEvalEvent
+		wantErr     bool
+		wantNext    bool
+		wantAttempt int32
+	}{
+		{
+			name: "轮次一致, 继续执行",
+			prepare: func() {
+				mockItemResultRepo.EXPECT().MGetItemRunLog(gomock.Any(), int64(1), int64(2), []int64{3}, int64(4)).
+					Return([]*entity.ExptItemResultRunLog{{ItemID: 3, Attempt: 1}}, nil)
+			},
+			event:       &entity.ExptItemEvalEvent{SpaceID: 4, ExptID: 1, ExptRunID: 2, EvalSetItemID: 3, Attempt: 1},
+			wantNext:    true,
+			wantAttempt: 1,
+		},
+		{
+			name: "已被续跑接替, 丢弃事件",
+			prepare: func() {
+				mockItemResultRepo.EXPECT().MGetItemRunLog(gomock.Any(), int64(1), int64(2), []int64{3}, int64(4)).
+					Return([]*entity.ExptItemResultRunLog{{ItemID: 3, Attempt: 1}}, nil)
+			},
+			event: &entity.ExptItemEvalEvent{SpaceID: 4, ExptID: 1, ExptRunID: 2, EvalSetItemID: 3},
+		},
+		{
+			name: "异步回调事件沿用当前轮次",
+			prepare: func() {
+				mockItemResultRepo.EXPECT().MGetItemRunLog(gomock.Any(), int64(1), int64(2), []int64{3}, int64(4)).
+					Return([]*entity.ExptItemResultRunLog{{ItemID: 3, Attempt: 1}}, nil)
+			},
+			event:       &entity.ExptItemEvalEvent{SpaceID: 4, ExptID: 1, ExptRunID: 2, EvalSetItemID: 3, AsyncEvaluatorReportTrigger: true},
+			wantNext:    true,
+			wantAttempt: 1,
+		},
+		{
+			name: "无 run log, 继续执行",
+			prepare: func() {
+				mockItemResultRepo.EXPECT().MGetItemRunLog(gomock.Any(), int64(1), int64(2), []int64{3}, int64(4)).Return(nil, nil)
+			},
+			event:    &entity.ExptItemEvalEvent{SpaceID: 4, ExptID: 1, ExptRunID: 2, EvalSetItemID: 3},
+			wantNext: true,
+		},
+		{
+			name: "读 run log 失败",
+			prepare: func() {
+				mockItemResultRepo.EXPECT().MGetItemRunLog(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			event:   &entity.ExptItemEvalEvent{SpaceID: 4, ExptID: 1, ExptRunID: 2, EvalSetItemID: 3},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			nextCalled := false
+			next := func(ctx context.Context, event *entity.ExptItemEvalEvent) error {
+				nextCalled = true
+				assert.Equal(t, tt.wantAttempt, event.Attempt)
+				return nil
+			}
+			err := service.HandleEventAttempt(next)(context.Background(), tt.event)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantNext, nextCalled)
+		})
+	}
+}
+
 func TestExptItemEventEvalServiceImpl_WithCtx(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
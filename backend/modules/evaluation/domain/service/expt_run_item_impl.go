@@ -5,6 +5,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -12,13 +13,16 @@ import (
 	"time"
 
 	"github.com/bytedance/gg/gptr"
+	"github.com/bytedance/gg/gslice"
 	"github.com/jinzhu/copier"
 
 	"github.com/coze-dev/coze-loop/backend/infra/external/benefit"
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/contexts"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
 	"github.com/coze-dev/coze-loop/backend/pkg/consts"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
@@ -41,7 +45,11 @@ func NewExptItemEvaluation(
 	benefitService benefit.IBenefitService,
 	evalAsyncRepo repo.IEvalAsyncRepo,
 	evalSetItemSvc EvaluationSetItemService,
+	resultCache IExptResultCacheService,
+	concurCtrl IExptConcurrencyController,
 	itemCompletePublisher component.IItemCompletePublisher,
+	userSimulator ExptUserSimulator,
+	idgen idgen.IIDGenerator,
 	sandboxAgentNotifier ...ISandboxAgentNotifier, // variadic 保持已有单测编译通过
 ) ExptItemEvaluation {
 	exec := &ExptItemEvalCtxExecutor{
@@ -55,7 +63,11 @@ func NewExptItemEvaluation(
 		benefitService:         benefitService,
 		evalAsyncRepo:          evalAsyncRepo,
 		evalSetItemSvc:         evalSetItemSvc,
+		resultCache:            resultCache,
+		concurCtrl:             concurCtrl,
 		itemCompletePublisher:  itemCompletePublisher,
+		userSimulator:          userSimulator,
+		idgen:                  idgen,
 	}
 	if len(sandboxAgentNotifier) > 0 {
 		exec.sandboxAgentNotifier = sandboxAgentNotifier[0]
@@ -74,12 +86,28 @@ type ExptItemEvalCtxExecutor struct {
 	benefitService         benefit.IBenefitService
 	evalAsyncRepo          repo.IEvalAsyncRepo
 	evalSetItemSvc         EvaluationSetItemService
+	resultCache            IExptResultCacheService
+	concurCtrl             IExptConcurrencyController // 允许为 nil, 为空时不记录后端观测
 	itemCompletePublisher  component.IItemCompletePublisher
+	userSimulator          ExptUserSimulator // 实验开启模拟用户时驱动多轮对话
+	idgen                  idgen.IIDGenerator
 	sandboxAgentNotifier   ISandboxAgentNotifier // 沙箱 agent 实验单行失败飞书通知; 可空
 }
 
 const exptRunLogPersistTimeout = 5 * time.Second
 
+// errExptItemAttemptSuperseded 本轮执行已被僵尸续跑接替, 结果不再写入
+var errExptItemAttemptSuperseded = errors.New("expt item attempt superseded by resume")
+
+// exptItemAttemptSuperseded 事件的执行轮次是否已落后于 item run log; 读主库, 避免读不到刚认领的轮次
+func exptItemAttemptSuperseded(ctx context.Context, itemResultRepo repo.IExptItemResultRepo, event *entity.ExptItemEvalEvent) (bool, error) {
+	runLogs, err := itemResultRepo.MGetItemRunLog(contexts.WithCtxWriteDB(ctx), event.ExptID, event.ExptRunID, []int64{event.EvalSetItemID}, event.SpaceID)
+	if err != nil {
+		return false, err
+	}
+	return len(runLogs) > 0 && runLogs[0] != nil && runLogs[0].Attempt != event.Attempt, nil
+}
+
 func (e *ExptItemEvalCtxExecutor) Eval(ctx context.Context, eiec *entity.ExptItemEvalCtx) error {
 	// if err := e.SetItemRunProcessing(ctx, event.ExptID, event.ExptRunID, event.EvalSetItemID, event.SpaceID, event.Session); err != nil {
 	//	return err
@@ -104,6 +132,10 @@ func (e *ExptItemEvalCtxExecutor) EvalTurns(ctx context.Context, eiec *entity.Ex
 		return false, fmt.Errorf("EvalTurns with invalid empty eval_set_item")
 	}
 
+	if eiec.Expt != nil && eiec.Expt.EvalConf != nil && eiec.Expt.EvalConf.UserSimulatorConf.IsEnabled() {
+		return e.EvalSimulatedTurns(ctx, eiec)
+	}
+
 	for _, turn := range eiec.EvalSetItem.Turns {
 		etec, err := e.buildExptTurnEvalCtx(ctx, turn, eiec, history)
 		if err != nil {
@@ -112,7 +144,15 @@ func (e *ExptItemEvalCtxExecutor) EvalTurns(ctx context.Context, eiec *entity.Ex
 
 		ctx = context.WithValue(ctx, consts.CtxKeyLogID, etec.GetTurnEvalLogID(ctx, turn.ID)) //nolint:staticcheck
 
-		turnRunRes := NewExptTurnEvaluation(e.Metric, e.evalTargetService, e.evaluatorService, e.benefitService, e.evalAsyncRepo, e.evalSetItemSvc, e.evaluatorRecordService).Eval(ctx, etec)
+		var checkpointer ExptTurnCheckpointer
+		if e.Configer.GetExptExecConf(ctx, eiec.Event.SpaceID).GetCheckpointConf().IsEnabled() {
+			checkpointer = NewExptTurnCheckpointer(e.TurnResultRepo, e.ItemResultRepo, etec)
+		}
+
+		turnRunRes := NewExptTurnEvaluation(e.Metric, e.evalTargetService, e.evaluatorService, e.benefitService, e.evalAsyncRepo, e.evalSetItemSvc, e.evaluatorRecordService, e.resultCache, checkpointer).Eval(ctx, etec)
+		if e.concurCtrl != nil {
+			e.concurCtrl.Observe(ctx, etec.Expt, turnRunRes)
+		}
 
 		if err := e.storeTurnRunResult(ctx, etec, turnRunRes); err != nil {
 			return false, err
@@ -162,6 +202,13 @@ func (e *ExptItemEvalCtxExecutor) storeTurnRunResult(ctx context.Context, etec *
 	if result.TargetResult != nil && result.TargetResult.ID > 0 {
 		clone.TargetResultID = result.TargetResult.ID
 	}
+	clone.CacheHit = result.GetCacheHitFlag()
+	clone.TrialResults = gslice.Map(result.TrialResults, func(t *entity.ExptTurnTrialRunResult) *entity.ExptTurnTrialResult { return t.ToTrialResult() })
+	// turn 结束后检查点仅保留等待异步回调的状态, 供续跑时重新挂接回调
+	clone.Checkpoint = nil
+	if result.AsyncAbort {
+		clone.Checkpoint = entity.BuildExptTurnCheckpoint(turnResultLog.Checkpoint, result.TargetResult, result.EvaluatorResults)
+	}
 
 	if result.TargetResult != nil && result.TargetResult.EvalTargetOutputData != nil && result.TargetResult.EvalTargetOutputData.EvalTargetRunError != nil && result.TargetResult.EvalTargetOutputData.EvalTargetRunError.Code > 0 {
 		evalErr = errno.NewTargetResultErr(result.TargetResult.EvalTargetOutputData.EvalTargetRunError.Message)
@@ -228,6 +275,17 @@ func (e *ExptItemEvalCtxExecutor) storeTurnRunResult(ctx context.Context, etec *
 
 	result.SetEvalErr(evalErr)
 
+	// 仅开启检查点时 item 才可能被续跑接替
+	if e.Configer.GetExptExecConf(persistCtx, etec.Event.SpaceID).GetCheckpointConf().IsEnabled() {
+		superseded, err := exptItemAttemptSuperseded(persistCtx, e.ItemResultRepo, etec.Event)
+		if err != nil {
+			return err
+		}
+		if superseded {
+			return errExptItemAttemptSuperseded
+		}
+	}
+
 	if err := e.TurnResultRepo.SaveTurnRunLogs(persistCtx, []*entity.ExptTurnResultRunLog{clone}); err != nil {
 		return err
 	}
@@ -451,6 +509,11 @@ func (e *ExptItemEvalCtxExecutor) CompleteItemRun(ctx context.Context, eiec *ent
 	persistCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), exptRunLogPersistTimeout)
 	defer cancel()
 
+	if errors.Is(evalErr, errExptItemAttemptSuperseded) {
+		logs.CtxWarn(ctx, "[ExptTurnEval] expt item attempt superseded, drop result, expt_id: %v, item_id: %v, attempt: %v", event.ExptID, event.EvalSetItemID, event.Attempt)
+		return nil
+	}
+
 	if evalErr != nil {
 		if retry, _ := e.evalErrNeedRetry(persistCtx, event, evalErr); retry {
 			return evalErr
@@ -468,9 +531,14 @@ func (e *ExptItemEvalCtxExecutor) CompleteItemRun(ctx context.Context, eiec *ent
 		ufields["status"] = int32(entity.ItemRunState_Success)
 	}
 
-	if err := e.ItemResultRepo.UpdateItemRunLog(persistCtx, event.ExptID, event.ExptRunID, []int64{event.EvalSetItemID}, ufields, event.SpaceID); err != nil {
+	updated, err := e.ItemResultRepo.UpdateItemRunLogByAttempt(persistCtx, event.ExptID, event.ExptRunID, event.EvalSetItemID, event.Attempt, ufields, event.SpaceID)
+	if err != nil {
 		return err
 	}
+	if !updated {
+		logs.CtxWarn(ctx, "[ExptTurnEval] expt item attempt superseded, drop result, expt_id: %v, item_id: %v, attempt: %v", event.ExptID, event.EvalSetItemID, event.Attempt)
+		return nil
+	}
 
 	// 沙箱 agent 实验:单行走到终态失败,立即发一张飞书卡。Notifier 内部做 sandbox agent + Enable 判定,
 	// 非目标 case 静默返回。发送失败仅 log,不阻塞主流程。
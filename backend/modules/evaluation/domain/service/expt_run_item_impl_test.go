@@ -120,6 +120,10 @@ func Test_NewExptItemEvaluation(t *testing.T) {
 				tt.evalAsyncRepo,
 				tt.evalSetItemSvc,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			)
 			assert.NotNil(t, inst)
 		})
@@ -206,7 +210,7 @@ func Test_ExptItemEvalCtxExecutor_Eval(t *testing.T) {
 				},
 			},
 			mockSetup: func() {
-				mockItemResultRepo.EXPECT().UpdateItemRunLog(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				mockItemResultRepo.EXPECT().UpdateItemRunLogByAttempt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
 				mockConfiger.EXPECT().GetErrRetryConf(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&entity.RetryConf{IsInDebt: false, RetryTimes: 1, RetryIntervalSecond: 1})
 				mockEvalTargetService.EXPECT().GetRecordByID(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
 				mockEvaluatorRecordService.EXPECT().BatchGetEvaluatorRecord(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
@@ -232,7 +236,7 @@ func Test_ExptItemEvalCtxExecutor_Eval(t *testing.T) {
 				},
 			},
 			mockSetup: func() {
-				mockItemResultRepo.EXPECT().UpdateItemRunLog(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("mock updateitemrunlog error"))
+				mockItemResultRepo.EXPECT().UpdateItemRunLogByAttempt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, errors.New("mock updateitemrunlog error"))
 				mockConfiger.EXPECT().GetErrRetryConf(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&entity.RetryConf{IsInDebt: false, RetryTimes: 1, RetryIntervalSecond: 1})
 			},
 			wantErr:    true,
@@ -376,30 +380,37 @@ func Test_ExptItemEvalCtxExecutor_CompleteSetItemRun(t *testing.T) {
 	mockConfiger.EXPECT().GetErrRetryConf(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&entity.RetryConf{IsInDebt: false, RetryTimes: 1, RetryIntervalSecond: 1})
 
 	t.Run("正常流程", func(t *testing.T) {
-		mockItemResultRepo.EXPECT().UpdateItemRunLog(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mockItemResultRepo.EXPECT().UpdateItemRunLogByAttempt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
 		eiec := &entity.ExptItemEvalCtx{Event: &entity.ExptItemEvalEvent{ExptID: 1, ExptRunID: 2, EvalSetItemID: 3, SpaceID: 4}}
 		err := executor.CompleteItemRun(context.Background(), eiec, nil)
 		assert.NoError(t, err)
 	})
 
 	t.Run("UpdateItemRunLog返回错误", func(t *testing.T) {
-		mockItemResultRepo.EXPECT().UpdateItemRunLog(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("mock updateitemrunlog error"))
+		mockItemResultRepo.EXPECT().UpdateItemRunLogByAttempt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, errors.New("mock updateitemrunlog error"))
 		eiec := &entity.ExptItemEvalCtx{Event: &entity.ExptItemEvalEvent{ExptID: 1, ExptRunID: 2, EvalSetItemID: 3, SpaceID: 4}}
 		err := executor.CompleteItemRun(context.Background(), eiec, nil)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "mock updateitemrunlog error")
 	})
 
+	t.Run("已被续跑接替时不覆盖新轮次", func(t *testing.T) {
+		mockItemResultRepo.EXPECT().UpdateItemRunLogByAttempt(gomock.Any(), int64(1), int64(2), int64(3), int32(1), gomock.Any(), int64(4)).Return(false, nil)
+		eiec := &entity.ExptItemEvalCtx{Event: &entity.ExptItemEvalEvent{ExptID: 1, ExptRunID: 2, EvalSetItemID: 3, SpaceID: 4, Attempt: 1}}
+		err := executor.CompleteItemRun(context.Background(), eiec, nil)
+		assert.NoError(t, err)
+	})
+
 	t.Run("ctx取消后仍落item失败状态", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
 
 		mockConfiger.EXPECT().GetErrRetryConf(gomock.Any(), int64(4), gomock.Any()).AnyTimes().Return(&entity.RetryConf{IsInDebt: false})
-		mockItemResultRepo.EXPECT().UpdateItemRunLog(gomock.Any(), int64(1), int64(2), []int64{3}, gomock.Any(), int64(4)).
-			DoAndReturn(func(ctx context.Context, _, _ int64, _ []int64, ufields map[string]any, _ int64) error {
+		mockItemResultRepo.EXPECT().UpdateItemRunLogByAttempt(gomock.Any(), int64(1), int64(2), int64(3), int32(0), gomock.Any(), int64(4)).
+			DoAndReturn(func(ctx context.Context, _, _, _ int64, _ int32, ufields map[string]any, _ int64) (bool, error) {
 				require.NoError(t, ctx.Err())
 				assert.Equal(t, int32(entity.ItemRunState_Fail), ufields["status"])
-				return nil
+				return true, nil
 			})
 
 		eiec := &entity.ExptItemEvalCtx{Event: &entity.ExptItemEvalEvent{ExptID: 1, ExptRunID: 2, EvalSetItemID: 3, SpaceID: 4, RetryTimes: 1}}
@@ -467,11 +478,11 @@ func Test_ExptItemEvalCtxExecutor_CompleteItemRun_NoItemCompletePublish(t *testi
 					Times(2).
 					Return(&entity.RetryConf{})
 			}
-			itemResultRepo.EXPECT().UpdateItemRunLog(
-				gomock.Any(), exptID, exptRunID, []int64{itemID}, gomock.Any(), spaceID,
-			).DoAndReturn(func(_ context.Context, _, _ int64, _ []int64, fields map[string]any, _ int64) error {
+			itemResultRepo.EXPECT().UpdateItemRunLogByAttempt(
+				gomock.Any(), exptID, exptRunID, itemID, int32(0), gomock.Any(), spaceID,
+			).DoAndReturn(func(_ context.Context, _, _, _ int64, _ int32, fields map[string]any, _ int64) (bool, error) {
 				require.Equal(t, wantFields, fields)
-				return nil
+				return true, nil
 			})
 
 			executor := &ExptItemEvalCtxExecutor{
@@ -568,6 +579,7 @@ func Test_ExptItemEvalCtxExecutor_storeTurnRunResult(t *testing.T) {
 	mockItemResultRepo := repomocks.NewMockIExptItemResultRepo(ctrl)
 	mockConfiger := configermocks.NewMockIConfiger(ctrl)
 	mockConfiger.EXPECT().BuildEvalExt(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+	mockConfiger.EXPECT().GetExptExecConf(gomock.Any(), gomock.Any()).AnyTimes().Return(&entity.ExptExecConf{})
 	mockMetric := metricsmocks.NewMockExptMetric(ctrl)
 	mockEvalTargetService := servicemocks.NewMockIEvalTargetService(ctrl)
 	mockEvaluatorRecordService := servicemocks.NewMockEvaluatorRecordService(ctrl)
@@ -698,6 +710,33 @@ func Test_ExptItemEvalCtxExecutor_storeTurnRunResult(t *testing.T) {
 		err := executor.storeTurnRunResult(ctx, etec, result)
 		assert.NoError(t, err)
 	})
+
+	t.Run("已被续跑接替的旧轮次不写turn结果", func(t *testing.T) {
+		checkpointConfiger := configermocks.NewMockIConfiger(ctrl)
+		checkpointConfiger.EXPECT().GetExptExecConf(gomock.Any(), int64(4)).Return(&entity.ExptExecConf{CheckpointConf: &entity.ExptCheckpointConf{Enable: true}})
+		mockItemResultRepo.EXPECT().MGetItemRunLog(gomock.Any(), int64(1), int64(3), []int64{2}, int64(4)).Return([]*entity.ExptItemResultRunLog{{ItemID: 2, Attempt: 1}}, nil)
+		mockTurnResultRepo.EXPECT().SaveTurnRunLogs(gomock.Any(), gomock.Any()).Times(0)
+		superseded := &ExptItemEvalCtxExecutor{
+			TurnResultRepo: mockTurnResultRepo,
+			ItemResultRepo: mockItemResultRepo,
+			Configer:       checkpointConfiger,
+		}
+
+		etec := &entity.ExptTurnEvalCtx{
+			Turn: &entity.Turn{ID: 1},
+			ExptItemEvalCtx: &entity.ExptItemEvalCtx{
+				Expt:                &entity.Experiment{ID: 1, SpaceID: 4},
+				Event:               &entity.ExptItemEvalEvent{SpaceID: 4, ExptID: 1, ExptRunID: 3, EvalSetItemID: 2},
+				EvalSetItem:         &entity.EvaluationSetItem{ItemID: 2},
+				ExistItemEvalResult: &entity.ExptItemEvalResult{TurnResultRunLogs: map[int64]*entity.ExptTurnResultRunLog{1: {ID: 1, TurnID: 1}}},
+			},
+		}
+		result := &entity.ExptTurnRunResult{TargetResult: &entity.EvalTargetRecord{ID: 10}}
+		err := superseded.storeTurnRunResult(context.Background(), etec, result)
+		assert.ErrorIs(t, err, errExptItemAttemptSuperseded)
+		// 被接替的结果交给 CompleteItemRun 时直接丢弃, 不写 item run log
+		assert.NoError(t, superseded.CompleteItemRun(context.Background(), etec.ExptItemEvalCtx, err))
+	})
 }
 
 func Test_buildExptTurnEvalCtx(t *testing.T) {
@@ -1199,6 +1238,13 @@ func Test_buildItemCompleteEvent_LinkAB_Equivalence(t *testing.T) {
 	require.Equal(t, fromLinkA, fromLinkB, "链路A与链路B的 item-complete 组装结果必须完全一致")
 }
 
+// checkpointDisabledConfiger 未开启执行检查点, storeTurnRunResult 不校验执行轮次
+func checkpointDisabledConfiger(ctrl *gomock.Controller) *configermocks.MockIConfiger {
+	configer := configermocks.NewMockIConfiger(ctrl)
+	configer.EXPECT().GetExptExecConf(gomock.Any(), gomock.Any()).Return(&entity.ExptExecConf{}).AnyTimes()
+	return configer
+}
+
 func TestExptItemEvalCtxExecutor_storeTurnRunResult_ArmsAsyncEvaluatorAfterSave(t *testing.T) {
 	t.Parallel()
 
@@ -1208,7 +1254,7 @@ func TestExptItemEvalCtxExecutor_storeTurnRunResult_ArmsAsyncEvaluatorAfterSave(
 	turnRepo.EXPECT().SaveTurnRunLogs(gomock.Any(), gomock.Any()).Return(nil)
 	evaluatorSvc.EXPECT().ArmEvaluatorResume(gomock.Any(), int64(100)).Return(nil)
 
-	executor := &ExptItemEvalCtxExecutor{TurnResultRepo: turnRepo, evaluatorService: evaluatorSvc}
+	executor := &ExptItemEvalCtxExecutor{TurnResultRepo: turnRepo, evaluatorService: evaluatorSvc, Configer: checkpointDisabledConfiger(ctrl)}
 	etec := &entity.ExptTurnEvalCtx{
 		Turn: &entity.Turn{ID: 1},
 		ExptItemEvalCtx: &entity.ExptItemEvalCtx{
@@ -1252,7 +1298,7 @@ func TestExptItemEvalCtxExecutor_storeTurnRunResult_ArmingHasIndependentTimeoutB
 		},
 	)
 
-	executor := &ExptItemEvalCtxExecutor{TurnResultRepo: turnRepo, evaluatorService: evaluatorSvc}
+	executor := &ExptItemEvalCtxExecutor{TurnResultRepo: turnRepo, evaluatorService: evaluatorSvc, Configer: checkpointDisabledConfiger(ctrl)}
 	etec := &entity.ExptTurnEvalCtx{
 		Turn: &entity.Turn{ID: 1},
 		ExptItemEvalCtx: &entity.ExptItemEvalCtx{
@@ -1298,7 +1344,7 @@ func TestExptItemEvalCtxExecutor_storeTurnRunResult_ArmsPendingEvaluatorsConcurr
 		},
 	)
 
-	executor := &ExptItemEvalCtxExecutor{TurnResultRepo: turnRepo, evaluatorService: evaluatorSvc}
+	executor := &ExptItemEvalCtxExecutor{TurnResultRepo: turnRepo, evaluatorService: evaluatorSvc, Configer: checkpointDisabledConfiger(ctrl)}
 	etec := &entity.ExptTurnEvalCtx{
 		Turn: &entity.Turn{ID: 1},
 		ExptItemEvalCtx: &entity.ExptItemEvalCtx{
@@ -1328,7 +1374,7 @@ func TestExptItemEvalCtxExecutor_storeTurnRunResult_DoesNotArmWhenSaveFails(t *t
 	turnRepo.EXPECT().SaveTurnRunLogs(gomock.Any(), gomock.Any()).Return(errors.New("save failed"))
 	evaluatorSvc.EXPECT().ArmEvaluatorResume(gomock.Any(), gomock.Any()).Times(0)
 
-	executor := &ExptItemEvalCtxExecutor{TurnResultRepo: turnRepo, evaluatorService: evaluatorSvc}
+	executor := &ExptItemEvalCtxExecutor{TurnResultRepo: turnRepo, evaluatorService: evaluatorSvc, Configer: checkpointDisabledConfiger(ctrl)}
 	etec := &entity.ExptTurnEvalCtx{
 		Turn: &entity.Turn{ID: 1},
 		ExptItemEvalCtx: &entity.ExptItemEvalCtx{
@@ -1355,7 +1401,7 @@ func TestExptItemEvalCtxExecutor_storeTurnRunResult_ArmErrorKeepsAsyncProcessing
 	turnRepo.EXPECT().SaveTurnRunLogs(gomock.Any(), gomock.Any()).Return(nil)
 	evaluatorSvc.EXPECT().ArmEvaluatorResume(gomock.Any(), int64(100)).Return(errors.New("arm failed"))
 
-	executor := &ExptItemEvalCtxExecutor{TurnResultRepo: turnRepo, evaluatorService: evaluatorSvc}
+	executor := &ExptItemEvalCtxExecutor{TurnResultRepo: turnRepo, evaluatorService: evaluatorSvc, Configer: checkpointDisabledConfiger(ctrl)}
 	etec := &entity.ExptTurnEvalCtx{
 		Turn: &entity.Turn{ID: 1},
 		ExptItemEvalCtx: &entity.ExptItemEvalCtx{
@@ -1400,7 +1446,7 @@ func TestExptItemEvalCtxExecutor_storeTurnRunResult_ArmsEveryPendingEvaluator(t
 		evaluatorSvc.EXPECT().ArmEvaluatorResume(gomock.Any(), recordID).Return(nil)
 	}
 
-	executor := &ExptItemEvalCtxExecutor{TurnResultRepo: turnRepo, evaluatorService: evaluatorSvc}
+	executor := &ExptItemEvalCtxExecutor{TurnResultRepo: turnRepo, evaluatorService: evaluatorSvc, Configer: checkpointDisabledConfiger(ctrl)}
 	etec := &entity.ExptTurnEvalCtx{
 		Turn: &entity.Turn{ID: 1},
 		ExptItemEvalCtx: &entity.ExptItemEvalCtx{
@@ -1443,7 +1489,7 @@ func TestExptItemEvalCtxExecutor_storeTurnRunResult_AllEvaluatorsTerminalComplet
 	)
 	evaluatorSvc.EXPECT().ArmEvaluatorResume(gomock.Any(), gomock.Any()).Times(0)
 
-	executor := &ExptItemEvalCtxExecutor{TurnResultRepo: turnRepo, evaluatorService: evaluatorSvc}
+	executor := &ExptItemEvalCtxExecutor{TurnResultRepo: turnRepo, evaluatorService: evaluatorSvc, Configer: checkpointDisabledConfiger(ctrl)}
 	etec := &entity.ExptTurnEvalCtx{
 		Turn: &entity.Turn{ID: 1},
 		ExptItemEvalCtx: &entity.ExptItemEvalCtx{
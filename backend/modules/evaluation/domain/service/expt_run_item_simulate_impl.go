@@ -0,0 +1,313 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/consts"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// EvalSimulatedTurns 由模拟用户驱动多轮对话: 以首个 turn 为种子, 每轮生成用户消息替换种子 turn 的 query 字段后按常规 turn 执行评测对象与评估器,
+// 目标判定评估器判定达成、模拟用户主动结束或达到最大轮数时停止。
+// 首轮沿用种子 turn 的结果行, 后续轮按 turn_idx 复用已有结果行, 不存在时新建; 对话每次执行都重新生成, 不复用上次执行的结果。
+func (e *ExptItemEvalCtxExecutor) EvalSimulatedTurns(ctx context.Context, eiec *entity.ExptItemEvalCtx) (asyncAbort bool, err error) {
+	conf := eiec.Expt.EvalConf.UserSimulatorConf
+	if e.userSimulator == nil || e.idgen == nil {
+		return false, errorx.NewByCode(errno.CommonInternalErrorCode, errorx.WithExtraMsg("user simulator not configured"))
+	}
+	if len(eiec.EvalSetItem.Turns) == 0 || eiec.EvalSetItem.Turns[0] == nil {
+		return false, fmt.Errorf("EvalSimulatedTurns with empty seed turn, item_id: %v", eiec.EvalSetItem.ItemID)
+	}
+
+	seed, err := e.resolveSimulateSeedTurn(ctx, eiec, eiec.EvalSetItem.Turns[0])
+	if err != nil {
+		return false, err
+	}
+	var runConf *entity.ItemRunConf
+	if ic := eiec.ItemConfig; ic != nil && ic.EvalTargetConf != nil {
+		runConf = ic.EvalTargetConf.RunConf
+	}
+	profile := conf.BuildProfile(seed, runConf)
+
+	turnResults, err := e.TurnResultRepo.GetItemTurnResults(ctx, eiec.Event.ExptID, eiec.Event.EvalSetItemID, eiec.Event.SpaceID)
+	if err != nil {
+		return false, err
+	}
+	idx2TurnResult := make(map[int32]*entity.ExptTurnResult, len(turnResults))
+	for _, tr := range turnResults {
+		idx2TurnResult[tr.TurnIdx] = tr
+	}
+
+	var (
+		history  []*entity.Message
+		executed int
+	)
+	for turnIdx := 0; turnIdx < conf.GetMaxTurns(); turnIdx++ {
+		var userMsg string
+		if turnIdx == 0 {
+			userMsg = getTurnFieldText(seed, conf.QueryFieldName)
+		}
+		if len(userMsg) == 0 {
+			msg, finished, err := e.userSimulator.NextUserMessage(ctx, &entity.UserSimulateParam{
+				SpaceID: eiec.Event.SpaceID,
+				Conf:    conf,
+				Profile: profile,
+				History: history,
+			})
+			if err != nil {
+				return false, err
+			}
+			if finished {
+				logs.CtxInfo(ctx, "[ExptTurnEval] user simulator finished conversation, expt_id: %v, item_id: %v, turns: %v", eiec.Event.ExptID, eiec.Event.EvalSetItemID, executed)
+				break
+			}
+			userMsg = msg
+		}
+
+		turn, err := e.prepareSimulatedTurn(ctx, eiec, seed, int32(turnIdx), idx2TurnResult, userMsg)
+		if err != nil {
+			return false, err
+		}
+		etec, err := e.buildExptTurnEvalCtx(ctx, turn, eiec, history)
+		if err != nil {
+			return false, err
+		}
+		etec.ExptTurnRunResult = &entity.ExptTurnRunResult{}
+		etec.History = history
+
+		turnCtx := context.WithValue(ctx, consts.CtxKeyLogID, etec.GetTurnEvalLogID(ctx, turn.ID)) //nolint:staticcheck
+
+		turnRunRes := NewExptTurnEvaluation(e.Metric, e.evalTargetService, e.evaluatorService, e.benefitService, e.evalAsyncRepo, e.evalSetItemSvc, e.evaluatorRecordService, e.resultCache, nil).Eval(turnCtx, etec)
+		if e.concurCtrl != nil {
+			e.concurCtrl.Observe(turnCtx, etec.Expt, turnRunRes)
+		}
+		if turnRunRes.AsyncAbort {
+			turnRunRes.AsyncAbort = false
+			turnRunRes.SetEvalErr(errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("user simulator does not support async target or evaluator")))
+		}
+		if err := e.storeTurnRunResult(turnCtx, etec, turnRunRes); err != nil {
+			return false, err
+		}
+		executed++
+		if err := turnRunRes.GetEvalErr(); err != nil {
+			return false, err
+		}
+
+		history = append(history,
+			entity.NewTextMessage(entity.RoleUser, userMsg),
+			entity.NewTextMessage(entity.RoleAssistant, getSimulatedTargetReply(turnRunRes.TargetResult, conf.GetResponseFieldName())),
+		)
+
+		achieved, record, err := e.userSimulator.JudgeGoal(turnCtx, &entity.UserSimulateGoalJudgeParam{
+			SpaceID:   eiec.Event.SpaceID,
+			ExptID:    eiec.Event.ExptID,
+			ExptRunID: eiec.Event.ExptRunID,
+			ItemID:    eiec.EvalSetItem.ItemID,
+			TurnID:    turn.ID,
+			Conf:      conf,
+			Profile:   profile,
+			History:   history,
+			Ext:       etec.Ext,
+		})
+		if err != nil {
+			// 目标判定失败不影响已完成的 turn, 继续对话直至其他结束条件
+			logs.CtxWarn(turnCtx, "[ExptTurnEval] user simulator judge goal fail, turn_idx: %v, err: %v", turnIdx, err)
+			continue
+		}
+		if achieved {
+			logs.CtxInfo(turnCtx, "[ExptTurnEval] user simulator goal achieved, turn_idx: %v, record_id: %v", turnIdx, record.ID)
+			break
+		}
+	}
+
+	if executed == 0 {
+		return false, errorx.NewByCode(errno.CommonInternalErrorCode, errorx.WithExtraMsg("user simulator ended conversation before the first turn"))
+	}
+
+	if err := e.terminateStaleSimulatedTurns(ctx, eiec, idx2TurnResult, int32(executed)); err != nil {
+		return false, err
+	}
+
+	time.Sleep(time.Second * 1)
+
+	return false, nil
+}
+
+// resolveSimulateSeedTurn 复制种子 turn, 并提前加载被裁剪的大字段: 模拟轮次使用新的 turn_id, 无法再按 turn_id 回查评测集字段
+func (e *ExptItemEvalCtxExecutor) resolveSimulateSeedTurn(ctx context.Context, eiec *entity.ExptItemEvalCtx, turn *entity.Turn) (*entity.Turn, error) {
+	seed := &entity.Turn{
+		ID:            turn.ID,
+		ItemID:        turn.ItemID,
+		EvalSetID:     turn.EvalSetID,
+		FieldDataList: make([]*entity.FieldData, 0, len(turn.FieldDataList)),
+	}
+	for _, fd := range turn.FieldDataList {
+		if fd == nil {
+			continue
+		}
+		cloned := *fd
+		if fd.Content != nil && fd.Content.IsContentOmitted() {
+			got, err := e.evalSetItemSvc.GetEvaluationSetItemField(ctx, &entity.GetEvaluationSetItemFieldParam{
+				SpaceID:         resolveLoadSpaceID(eiec.Event.SpaceID, eiec.EvalSetSourceSpaceID()),
+				EvaluationSetID: turn.EvalSetID,
+				ItemPK:          turn.ItemID,
+				FieldName:       fd.Name,
+				FieldKey:        gptr.Of(fd.Key),
+				TurnID:          gptr.Of(turn.ID),
+			})
+			if err != nil {
+				return nil, err
+			}
+			cloned.Content = got.Content
+		}
+		seed.FieldDataList = append(seed.FieldDataList, &cloned)
+	}
+	return seed, nil
+}
+
+// prepareSimulatedTurn 构造模拟轮次的 turn, 并保证该轮在本次执行中有对应的 turn 结果行与 run_log
+func (e *ExptItemEvalCtxExecutor) prepareSimulatedTurn(ctx context.Context, eiec *entity.ExptItemEvalCtx, seed *entity.Turn, turnIdx int32,
+	idx2TurnResult map[int32]*entity.ExptTurnResult, userMsg string,
+) (*entity.Turn, error) {
+	event := eiec.Event
+	turnID := seed.ID
+	if turnIdx > 0 {
+		if tr := idx2TurnResult[turnIdx]; tr != nil {
+			turnID = tr.TurnID
+		} else {
+			ids, err := e.idgen.GenMultiIDs(ctx, 2)
+			if err != nil {
+				return nil, err
+			}
+			tr := &entity.ExptTurnResult{
+				ID:        ids[0],
+				SpaceID:   event.SpaceID,
+				ExptID:    event.ExptID,
+				ExptRunID: event.ExptRunID,
+				ItemID:    event.EvalSetItemID,
+				TurnID:    ids[1],
+				TurnIdx:   turnIdx,
+				Status:    int32(entity.TurnRunState_Queueing),
+			}
+			if seedResult := idx2TurnResult[0]; seedResult != nil {
+				tr.ItemVersionID = seedResult.ItemVersionID
+			}
+			if err := e.TurnResultRepo.BatchCreateNX(ctx, []*entity.ExptTurnResult{tr}); err != nil {
+				return nil, err
+			}
+			idx2TurnResult[turnIdx] = tr
+			turnID = tr.TurnID
+		}
+	}
+
+	if err := e.ensureSimulatedTurnRunLog(ctx, eiec, turnID); err != nil {
+		return nil, err
+	}
+
+	turn := &entity.Turn{
+		ID:            turnID,
+		ItemID:        seed.ItemID,
+		EvalSetID:     seed.EvalSetID,
+		FieldDataList: make([]*entity.FieldData, 0, len(seed.FieldDataList)+1),
+	}
+	replaced := false
+	for _, fd := range seed.FieldDataList {
+		if fd.Name == eiec.Expt.EvalConf.UserSimulatorConf.QueryFieldName {
+			cloned := *fd
+			cloned.Content = entity.NewTextContent(userMsg)
+			turn.FieldDataList = append(turn.FieldDataList, &cloned)
+			replaced = true
+			continue
+		}
+		turn.FieldDataList = append(turn.FieldDataList, fd)
+	}
+	if !replaced {
+		turn.FieldDataList = append(turn.FieldDataList, &entity.FieldData{
+			Key:     eiec.Expt.EvalConf.UserSimulatorConf.QueryFieldName,
+			Name:    eiec.Expt.EvalConf.UserSimulatorConf.QueryFieldName,
+			Content: entity.NewTextContent(userMsg),
+		})
+	}
+	return turn, nil
+}
+
+func (e *ExptItemEvalCtxExecutor) ensureSimulatedTurnRunLog(ctx context.Context, eiec *entity.ExptItemEvalCtx, turnID int64) error {
+	if eiec.GetExistTurnResultRunLog(turnID) != nil {
+		return nil
+	}
+	id, err := e.idgen.GenID(ctx)
+	if err != nil {
+		return err
+	}
+	runLog := &entity.ExptTurnResultRunLog{
+		ID:        id,
+		SpaceID:   eiec.Event.SpaceID,
+		ExptID:    eiec.Event.ExptID,
+		ExptRunID: eiec.Event.ExptRunID,
+		ItemID:    eiec.Event.EvalSetItemID,
+		TurnID:    turnID,
+		Status:    entity.TurnRunState_Processing,
+		LogID:     logs.GetLogID(ctx),
+	}
+	if err := e.TurnResultRepo.BatchCreateNXRunLog(ctx, []*entity.ExptTurnResultRunLog{runLog}); err != nil {
+		return err
+	}
+	if eiec.ExistItemEvalResult == nil {
+		eiec.ExistItemEvalResult = &entity.ExptItemEvalResult{}
+	}
+	if eiec.ExistItemEvalResult.TurnResultRunLogs == nil {
+		eiec.ExistItemEvalResult.TurnResultRunLogs = make(map[int64]*entity.ExptTurnResultRunLog)
+	}
+	eiec.ExistItemEvalResult.TurnResultRunLogs[turnID] = runLog
+	return nil
+}
+
+// terminateStaleSimulatedTurns 此前执行留下的、本次对话未到达的模拟轮次置为终止, 保证每个 turn 结果行在本次执行中都有 run_log
+func (e *ExptItemEvalCtxExecutor) terminateStaleSimulatedTurns(ctx context.Context, eiec *entity.ExptItemEvalCtx, idx2TurnResult map[int32]*entity.ExptTurnResult, executed int32) error {
+	var stale []*entity.ExptTurnResultRunLog
+	for turnIdx, tr := range idx2TurnResult {
+		if turnIdx < executed || tr == nil {
+			continue
+		}
+		if err := e.ensureSimulatedTurnRunLog(ctx, eiec, tr.TurnID); err != nil {
+			return err
+		}
+		runLog := *eiec.GetExistTurnResultRunLog(tr.TurnID)
+		runLog.Status = entity.TurnRunState_Terminal
+		runLog.TargetResultID = 0
+		runLog.EvaluatorResultIds = nil
+		runLog.ErrMsg = ""
+		runLog.Checkpoint = nil
+		stale = append(stale, &runLog)
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+	return e.TurnResultRepo.SaveTurnRunLogs(ctx, stale)
+}
+
+func getTurnFieldText(turn *entity.Turn, fieldName string) string {
+	for _, fd := range turn.FieldDataList {
+		if fd != nil && fd.Name == fieldName {
+			return fd.Content.GetText()
+		}
+	}
+	return ""
+}
+
+func getSimulatedTargetReply(record *entity.EvalTargetRecord, fieldName string) string {
+	if record == nil || record.EvalTargetOutputData == nil {
+		return ""
+	}
+	return record.EvalTargetOutputData.OutputFields[fieldName].GetText()
+}
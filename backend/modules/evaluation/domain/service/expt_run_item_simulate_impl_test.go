@@ -0,0 +1,199 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	idgenmocks "github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
+	metricsmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics/mocks"
+	configermocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	servicemocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+func newSimulateTestEvalCtx(conf *entity.ExptUserSimulatorConf, seedFields ...*entity.FieldData) *entity.ExptItemEvalCtx {
+	return &entity.ExptItemEvalCtx{
+		Event: &entity.ExptItemEvalEvent{SpaceID: 1, ExptID: 2, ExptRunID: 3, EvalSetItemID: 4},
+		Expt: &entity.Experiment{
+			ID:       2,
+			SpaceID:  1,
+			EvalConf: &entity.EvaluationConfiguration{UserSimulatorConf: conf},
+		},
+		EvalSetItem: &entity.EvaluationSetItem{
+			ItemID:   4,
+			BaseInfo: &entity.BaseInfo{CreatedAt: gptr.Of(int64(1))},
+			Turns:    []*entity.Turn{{ID: 10, ItemID: 4, EvalSetID: 5, FieldDataList: seedFields}},
+		},
+		ExistItemEvalResult: &entity.ExptItemEvalResult{
+			TurnResultRunLogs: map[int64]*entity.ExptTurnResultRunLog{
+				10: {ID: 100, SpaceID: 1, ExptID: 2, ExptRunID: 3, ItemID: 4, TurnID: 10, Status: entity.TurnRunState_Processing},
+			},
+		},
+	}
+}
+
+func TestExptItemEvalCtxExecutor_EvalSimulatedTurns(t *testing.T) {
+	conf := &entity.ExptUserSimulatorConf{
+		ModelConfig:            &entity.ModelConfig{ModelID: gptr.Of(int64(1))},
+		QueryFieldName:         "input",
+		Goal:                   "book a flight",
+		MaxTurns:               4,
+		GoalEvaluatorVersionID: 100,
+	}
+
+	t.Run("第二轮达成目标并终止遗留轮次", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		turnRepo := repomocks.NewMockIExptTurnResultRepo(ctrl)
+		itemRepo := repomocks.NewMockIExptItemResultRepo(ctrl)
+		configer := configermocks.NewMockIConfiger(ctrl)
+		metric := metricsmocks.NewMockExptMetric(ctrl)
+		simulator := servicemocks.NewMockExptUserSimulator(ctrl)
+		idgen := idgenmocks.NewMockIIDGenerator(ctrl)
+
+		itemRepo.EXPECT().BatchGet(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		configer.EXPECT().BuildEvalExt(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		configer.EXPECT().GetExptExecConf(gomock.Any(), gomock.Any()).Return(&entity.ExptExecConf{}).AnyTimes()
+		metric.EXPECT().EmitTurnExecEval(gomock.Any(), gomock.Any()).AnyTimes()
+		metric.EXPECT().EmitTurnExecResult(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+		turnRepo.EXPECT().GetItemTurnResults(gomock.Any(), int64(2), int64(4), int64(1)).Return([]*entity.ExptTurnResult{
+			{ID: 1, TurnID: 10, TurnIdx: 0, ItemVersionID: 7},
+			{ID: 2, TurnID: 99, TurnIdx: 3},
+		}, nil)
+
+		simulator.EXPECT().NextUserMessage(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, param *entity.UserSimulateParam) (string, bool, error) {
+			assert.Equal(t, "book a flight", param.Profile.Goal)
+			require.Len(t, param.History, 2)
+			assert.Equal(t, "hi", param.History[0].Content.GetText())
+			return "to Paris", false, nil
+		})
+		gomock.InOrder(
+			simulator.EXPECT().JudgeGoal(gomock.Any(), gomock.Any()).Return(false, nil, nil),
+			simulator.EXPECT().JudgeGoal(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, param *entity.UserSimulateGoalJudgeParam) (bool, *entity.EvaluatorRecord, error) {
+				assert.Equal(t, int64(202), param.TurnID)
+				assert.Len(t, param.History, 4)
+				return true, &entity.EvaluatorRecord{ID: 1}, nil
+			}),
+		)
+
+		idgen.EXPECT().GenMultiIDs(gomock.Any(), 2).Return([]int64{201, 202}, nil)
+		gomock.InOrder(
+			idgen.EXPECT().GenID(gomock.Any()).Return(int64(301), nil),
+			idgen.EXPECT().GenID(gomock.Any()).Return(int64(302), nil),
+		)
+		turnRepo.EXPECT().BatchCreateNX(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, trs []*entity.ExptTurnResult) error {
+			require.Len(t, trs, 1)
+			assert.Equal(t, int64(201), trs[0].ID)
+			assert.Equal(t, int64(202), trs[0].TurnID)
+			assert.Equal(t, int32(1), trs[0].TurnIdx)
+			assert.Equal(t, int64(7), trs[0].ItemVersionID)
+			return nil
+		})
+		var createdLogTurns []int64
+		turnRepo.EXPECT().BatchCreateNXRunLog(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, logs []*entity.ExptTurnResultRunLog) error {
+			createdLogTurns = append(createdLogTurns, logs[0].TurnID)
+			return nil
+		}).Times(2)
+		savedStatus := make(map[int64]entity.TurnRunState)
+		turnRepo.EXPECT().SaveTurnRunLogs(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, logs []*entity.ExptTurnResultRunLog) error {
+			for _, l := range logs {
+				savedStatus[l.TurnID] = l.Status
+			}
+			return nil
+		}).Times(3)
+
+		executor := &ExptItemEvalCtxExecutor{
+			TurnResultRepo: turnRepo,
+			ItemResultRepo: itemRepo,
+			Configer:       configer,
+			Metric:         metric,
+			userSimulator:  simulator,
+			idgen:          idgen,
+		}
+		eiec := newSimulateTestEvalCtx(conf, &entity.FieldData{Key: "input", Name: "input", Content: entity.NewTextContent("hi")})
+
+		asyncAbort, err := executor.EvalSimulatedTurns(context.Background(), eiec)
+		require.NoError(t, err)
+		assert.False(t, asyncAbort)
+		assert.Equal(t, []int64{202, 99}, createdLogTurns)
+		assert.Equal(t, map[int64]entity.TurnRunState{
+			10:  entity.TurnRunState_Success,
+			202: entity.TurnRunState_Success,
+			99:  entity.TurnRunState_Terminal,
+		}, savedStatus)
+	})
+
+	t.Run("模拟用户首轮即结束", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		turnRepo := repomocks.NewMockIExptTurnResultRepo(ctrl)
+		simulator := servicemocks.NewMockExptUserSimulator(ctrl)
+		turnRepo.EXPECT().GetItemTurnResults(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		simulator.EXPECT().NextUserMessage(gomock.Any(), gomock.Any()).Return("", true, nil)
+
+		executor := &ExptItemEvalCtxExecutor{
+			TurnResultRepo: turnRepo,
+			userSimulator:  simulator,
+			idgen:          idgenmocks.NewMockIIDGenerator(ctrl),
+		}
+		_, err := executor.EvalSimulatedTurns(context.Background(), newSimulateTestEvalCtx(conf))
+		assert.Error(t, err)
+	})
+
+	t.Run("未注入模拟用户", func(t *testing.T) {
+		executor := &ExptItemEvalCtxExecutor{}
+		_, err := executor.EvalSimulatedTurns(context.Background(), newSimulateTestEvalCtx(conf))
+		assert.Error(t, err)
+	})
+}
+
+func TestExptItemEvalCtxExecutor_prepareSimulatedTurn(t *testing.T) {
+	conf := &entity.ExptUserSimulatorConf{ModelConfig: &entity.ModelConfig{}, QueryFieldName: "input"}
+	executor := &ExptItemEvalCtxExecutor{}
+
+	t.Run("替换 query 字段", func(t *testing.T) {
+		ctx := newSimulateTestEvalCtx(conf)
+		seed := &entity.Turn{ID: 10, FieldDataList: []*entity.FieldData{
+			{Name: "input", Content: entity.NewTextContent("hi")},
+			{Name: "reference", Content: entity.NewTextContent("ref")},
+		}}
+		turn, err := executor.prepareSimulatedTurn(context.Background(), ctx, seed, 0, map[int32]*entity.ExptTurnResult{}, "hello")
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), turn.ID)
+		require.Len(t, turn.FieldDataList, 2)
+		assert.Equal(t, "hello", turn.FieldDataList[0].Content.GetText())
+		assert.Equal(t, "ref", turn.FieldDataList[1].Content.GetText())
+		assert.Equal(t, "hi", seed.FieldDataList[0].Content.GetText())
+	})
+
+	t.Run("种子缺少 query 字段时追加", func(t *testing.T) {
+		ctx := newSimulateTestEvalCtx(conf)
+		seed := &entity.Turn{ID: 10}
+		turn, err := executor.prepareSimulatedTurn(context.Background(), ctx, seed, 0, map[int32]*entity.ExptTurnResult{}, "hello")
+		require.NoError(t, err)
+		require.Len(t, turn.FieldDataList, 1)
+		assert.Equal(t, "input", turn.FieldDataList[0].Name)
+		assert.Equal(t, "hello", turn.FieldDataList[0].Content.GetText())
+	})
+
+	t.Run("复用已有轮次结果行", func(t *testing.T) {
+		ctx := newSimulateTestEvalCtx(conf)
+		ctx.ExistItemEvalResult.TurnResultRunLogs[55] = &entity.ExptTurnResultRunLog{TurnID: 55}
+		turn, err := executor.prepareSimulatedTurn(context.Background(), ctx, &entity.Turn{ID: 10}, 2,
+			map[int32]*entity.ExptTurnResult{2: {TurnID: 55, TurnIdx: 2}}, "hello")
+		require.NoError(t, err)
+		assert.Equal(t, int64(55), turn.ID)
+	})
+}
@@ -41,6 +41,8 @@ func NewExptTurnEvaluation(
 	evalAsyncRepo repo.IEvalAsyncRepo,
 	evalSetItemSvc EvaluationSetItemService,
 	evaluatorRecordService EvaluatorRecordService,
+	resultCache IExptResultCacheService,
+	checkpointer ExptTurnCheckpointer,
 ) ExptItemTurnEvaluation {
 	return &DefaultExptTurnEvaluationImpl{
 		metric:                 metric,
@@ -50,6 +52,8 @@ func NewExptTurnEvaluation(
 		evalAsyncRepo:          evalAsyncRepo,
 		evalSetItemSvc:         evalSetItemSvc,
 		evaluatorRecordService: evaluatorRecordService,
+		resultCache:            resultCache,
+		checkpointer:           checkpointer,
 	}
 }
 
@@ -61,6 +65,10 @@ type DefaultExptTurnEvaluationImpl struct {
 	evalAsyncRepo          repo.IEvalAsyncRepo
 	evalSetItemSvc         EvaluationSetItemService
 	evaluatorRecordService EvaluatorRecordService
+	// resultCache 为空或实验未开启 EvalConf.ResultCacheConf 时不走结果缓存
+	resultCache IExptResultCacheService
+	// checkpointer 为空时不写 turn 执行检查点
+	checkpointer ExptTurnCheckpointer
 }
 
 func (e *DefaultExptTurnEvaluationImpl) Eval(ctx context.Context, etec *entity.ExptTurnEvalCtx) (trr *entity.ExptTurnRunResult) {
@@ -83,6 +91,10 @@ func (e *DefaultExptTurnEvaluationImpl) Eval(ctx context.Context, etec *entity.E
 
 	logs.CtxInfo(ctx, "[ExptTurnEval] call target success, target_result: %v", json.Jsonify(targetResult))
 
+	if e.checkpointer != nil {
+		e.checkpointer.SaveTarget(ctx, targetResult)
+	}
+
 	if trr.SetTargetResult(targetResult).AbortWithTargetResult(etec.Expt) {
 		return trr
 	}
@@ -99,9 +111,65 @@ func (e *DefaultExptTurnEvaluationImpl) Eval(ctx context.Context, etec *entity.E
 		return trr
 	}
 
+	trr.TrialResults = e.callTrials(ctx, etec, targetResult)
+
 	return trr
 }
 
+// callTrials 开启重复试验时在第 0 次试验成功后执行第 1~K-1 次试验: 重新调用评测对象并重新评估。
+// 异步评测对象与异步回调触发的执行不做重复试验; 单次试验失败仅记录在试验结果中, 不影响 turn 状态。
+func (e *DefaultExptTurnEvaluationImpl) callTrials(ctx context.Context, etec *entity.ExptTurnEvalCtx, targetResult *entity.EvalTargetRecord) []*entity.ExptTurnTrialRunResult {
+	if etec.Expt.EvalConf == nil || !etec.Expt.EvalConf.TrialConf.IsEnabled() {
+		return nil
+	}
+	if etec.Expt.AsyncCallTarget() || etec.Event.AsyncReportTrigger || etec.Event.AsyncEvaluatorReportTrigger {
+		return nil
+	}
+
+	// 试验结果不属于 turn 的常规结果, 不写入检查点; 试验用于观察多次调用的波动, 不读写结果缓存, 每次都重新调用
+	te := *e
+	te.checkpointer = nil
+	te.resultCache = nil
+
+	trialNum := etec.Expt.EvalConf.TrialConf.GetTrialNum()
+	trials := make([]*entity.ExptTurnTrialRunResult, 0, trialNum-1)
+	for idx := 1; idx < trialNum; idx++ {
+		trial := &entity.ExptTurnTrialRunResult{TrialIdx: int32(idx)}
+		trials = append(trials, trial)
+
+		trialTarget := targetResult
+		if !e.skipTargetNode(etec.Expt) {
+			if err := te.CheckBenefit(ctx, etec.Event.ExptID, etec.Event.SpaceID, etec.Expt.CreditCost == entity.CreditCostFree, etec.Event.Session); err != nil {
+				trial.EvalErr = err
+				break
+			}
+			record, err := te.callTarget(ctx, etec, etec.History, resolveLoadSpaceID(etec.Event.SpaceID, etec.TargetSourceSpaceID()))
+			if err != nil {
+				logs.CtxWarn(ctx, "[ExptTurnEval] trial call target fail, trial_idx: %v, err: %v", idx, err)
+				trial.EvalErr = err
+				continue
+			}
+			trial.TargetResult = record
+			trialTarget = record
+			if record.EvalTargetOutputData != nil && record.EvalTargetOutputData.EvalTargetRunError != nil {
+				continue
+			}
+		}
+
+		// 试验需重新评估, 不复用 turn 已有的评估器结果
+		etec.Event.WithCtxTargetCalled(ctx)
+		evaluatorResults, err := te.CallEvaluators(ctx, etec, trialTarget)
+		if err != nil {
+			logs.CtxWarn(ctx, "[ExptTurnEval] trial call evaluators fail, trial_idx: %v, err: %v", idx, err)
+		}
+		trial.EvaluatorResults = evaluatorResults
+		trial.EvalErr = err
+	}
+
+	logs.CtxInfo(ctx, "[ExptTurnEval] call trials done, trial_num: %v", trialNum)
+	return trials
+}
+
 func (e *DefaultExptTurnEvaluationImpl) CallTarget(ctx context.Context, etec *entity.ExptTurnEvalCtx) (*entity.EvalTargetRecord, error) {
 	if e.skipTargetNode(etec.Expt) {
 		return &entity.EvalTargetRecord{EvalTargetOutputData: &entity.EvalTargetOutputData{OutputFields: make(map[string]*entity.Content)}}, nil
@@ -121,6 +189,10 @@ func (e *DefaultExptTurnEvaluationImpl) CallTarget(ctx context.Context, etec *en
 		logs.CtxInfo(ctx, "CallTarget return with existed target record, record_id: %v", tr.ID)
 		return tr, nil
 	}
+	if etec.Event.ResumeFromCheckpoint && e.pendingAsyncTargetAlive(ctx, tr) {
+		logs.CtxInfo(ctx, "CallTarget resume with pending async target record, record_id: %v", tr.ID)
+		return tr, nil
+	}
 
 	if err := e.CheckBenefit(ctx, etec.Event.ExptID, etec.Event.SpaceID, etec.Expt.CreditCost == entity.CreditCostFree, etec.Event.Session); err != nil {
 		return nil, err
@@ -136,6 +208,19 @@ func (e *DefaultExptTurnEvaluationImpl) CallTarget(ctx context.Context, etec *en
 	return record, nil
 }
 
+// pendingAsyncTargetAlive 续跑时异步评测对象仍在等待回调且回调上下文未过期, 则继续等待回调而不重新调用
+func (e *DefaultExptTurnEvaluationImpl) pendingAsyncTargetAlive(ctx context.Context, tr *entity.EvalTargetRecord) bool {
+	if tr == nil || tr.ID <= 0 || gptr.Indirect(tr.Status) != entity.EvalTargetRunStatusAsyncInvoking {
+		return false
+	}
+	actx, err := e.evalAsyncRepo.GetEvalAsyncCtx(ctx, strconv.FormatInt(tr.ID, 10))
+	if err != nil {
+		logs.CtxWarn(ctx, "[ExptTurnEval] get pending async target ctx fail, record_id: %v, err: %v", tr.ID, err)
+		return false
+	}
+	return actx != nil
+}
+
 func (e *DefaultExptTurnEvaluationImpl) validateEvalTargetCtx(etec *entity.ExptTurnEvalCtx) error {
 	if (etec.Event.AsyncReportTrigger || etec.Event.AsyncEvaluatorReportTrigger) && etec.ExptTurnRunResult.GetTargetResult() == nil {
 		return errorx.NewByCode(errno.CommonInternalErrorCode, errorx.WithExtraMsg("target result must not be nil in async reported event"))
@@ -303,7 +388,7 @@ func (e *DefaultExptTurnEvaluationImpl) callTarget(ctx context.Context, etec *en
 	}
 
 	if !etec.Expt.AsyncCallTarget() {
-		return e.evalTargetService.ExecuteTarget(ctx, spaceID, etec.Expt.Target.ID, etec.Expt.Target.EvalTargetVersion.ID, etc, etid)
+		return e.executeTargetWithResultCache(ctx, etec, spaceID, etc, etid)
 	}
 
 	ts := time.Now()
@@ -333,6 +418,38 @@ func (e *DefaultExptTurnEvaluationImpl) callTarget(ctx context.Context, etec *en
 	return targetRecord, nil
 }
 
+// executeTargetWithResultCache 同步调用评测对象, 实验开启评测对象结果缓存时先按内容寻址 key 复用历史结果,
+// 未命中则实际执行并把成功结果写入缓存。缓存读写失败只打日志, 不影响评测。
+func (e *DefaultExptTurnEvaluationImpl) executeTargetWithResultCache(ctx context.Context, etec *entity.ExptTurnEvalCtx, spaceID int64,
+	etc *entity.ExecuteTargetCtx, etid *entity.EvalTargetInputData,
+) (*entity.EvalTargetRecord, error) {
+	targetID, targetVersionID := etec.Expt.Target.ID, etec.Expt.Target.EvalTargetVersion.ID
+	if e.resultCache == nil || etec.Expt.EvalConf == nil || !etec.Expt.EvalConf.ResultCacheConf.TargetCacheEnabled() {
+		return e.evalTargetService.ExecuteTarget(ctx, spaceID, targetID, targetVersionID, etc, etid)
+	}
+	key, ok := entity.BuildTargetResultCacheKey(targetVersionID, etid)
+	if !ok {
+		return e.evalTargetService.ExecuteTarget(ctx, spaceID, targetID, targetVersionID, etc, etid)
+	}
+
+	record, err := e.resultCache.ReuseTargetRecord(ctx, etec.Event.SpaceID, spaceID, targetID, targetVersionID, key, etc, etid,
+		etec.Expt.EvalConf.ResultCacheConf.MaxAge())
+	if err != nil {
+		logs.CtxWarn(ctx, "[ExptTurnEval] reuse cached target record fail, key: %v, err: %v", key, err)
+	} else if record != nil {
+		return record, nil
+	}
+
+	record, err = e.evalTargetService.ExecuteTarget(ctx, spaceID, targetID, targetVersionID, etc, etid)
+	if err != nil {
+		return record, err
+	}
+	if err := e.resultCache.PutTargetRecord(ctx, etec.Event.SpaceID, key, record); err != nil {
+		logs.CtxWarn(ctx, "[ExptTurnEval] put target record cache fail, key: %v, err: %v", key, err)
+	}
+	return record, nil
+}
+
 // pickTargetID / pickDatasetID / pickItemKey / pickDatasetKey 尽量宽松地从 etec 中提取字段, 缺失时返回零值。
 // 这些字段仅用于沙箱 step 上报 tag 反查, 缺失时上报侧走占位符, 不会 panic。
 func pickTargetID(etec *entity.ExptTurnEvalCtx) int64 {
@@ -488,6 +605,8 @@ func (e *DefaultExptTurnEvaluationImpl) refreshAsyncEvaluatorRecords(ctx context
 type evalRecordCollector struct {
 	mu      sync.Mutex
 	records []*entity.EvaluatorRecord
+	// onStore 每收集到一条结果时回调, 用于写 turn 执行检查点
+	onStore func(r *entity.EvaluatorRecord)
 }
 
 func (c *evalRecordCollector) store(r *entity.EvaluatorRecord) {
@@ -497,13 +616,24 @@ func (c *evalRecordCollector) store(r *entity.EvaluatorRecord) {
 	c.mu.Lock()
 	c.records = append(c.records, r)
 	c.mu.Unlock()
+	if c.onStore != nil {
+		c.onStore(r)
+	}
+}
+
+func (e *DefaultExptTurnEvaluationImpl) newEvalRecordCollector(ctx context.Context) *evalRecordCollector {
+	c := &evalRecordCollector{}
+	if e.checkpointer != nil {
+		c.onStore = func(r *entity.EvaluatorRecord) { e.checkpointer.SaveEvaluator(ctx, r) }
+	}
+	return c
 }
 
 func (e *DefaultExptTurnEvaluationImpl) callEvaluators(ctx context.Context, execEvaluatorVersionIDs []int64, etec *entity.ExptTurnEvalCtx,
 	targetResult *entity.EvalTargetRecord, history []*entity.Message,
 ) ([]*entity.EvaluatorRecord, error) {
 	var (
-		collector evalRecordCollector
+		collector = e.newEvalRecordCollector(ctx)
 		item      = etec.EvalSetItem
 		expt      = etec.Expt
 		turn      = etec.Turn
@@ -608,13 +738,13 @@ func (e *DefaultExptTurnEvaluationImpl) callEvaluators(ctx context.Context, exec
 
 		if evForCapture.IsAsync() {
 			pool.Add(func() error {
-				return e.asyncCallEvaluator(ctx, evForCapture, ecForCapture, etec, inputDataForCapture, &collector)
+				return e.asyncCallEvaluator(ctx, evForCapture, ecForCapture, etec, inputDataForCapture, collector)
 			})
 		} else {
 			pool.Add(func() error {
 				var err error
 				defer e.metric.EmitTurnExecEvaluatorResult(evaluatorSpaceID, err != nil)
-				evaluatorRecord, err := e.evaluatorService.RunEvaluator(ctx, baseRunReq)
+				evaluatorRecord, err := e.runEvaluatorWithResultCache(ctx, etec, baseRunReq)
 				if err != nil {
 					if e.evaluatorService != nil {
 						if failedRecord, createErr := e.evaluatorService.CreateEvaluatorRunFailRecord(ctx, baseRunReq, err); createErr == nil && failedRecord != nil {
@@ -634,6 +764,34 @@ func (e *DefaultExptTurnEvaluationImpl) callEvaluators(ctx context.Context, exec
 	return collector.records, err
 }
 
+// runEvaluatorWithResultCache 同步运行评估器, 实验开启评估器结果缓存时先按内容寻址 key 复用历史结果,
+// 未命中则实际运行并把成功结果写入缓存。缓存读写失败只打日志, 不影响评测。
+func (e *DefaultExptTurnEvaluationImpl) runEvaluatorWithResultCache(ctx context.Context, etec *entity.ExptTurnEvalCtx, req *entity.RunEvaluatorRequest) (*entity.EvaluatorRecord, error) {
+	if e.resultCache == nil || etec.Expt.EvalConf == nil || !etec.Expt.EvalConf.ResultCacheConf.EvaluatorCacheEnabled() {
+		return e.evaluatorService.RunEvaluator(ctx, req)
+	}
+	key, ok := entity.BuildEvaluatorResultCacheKey(req.EvaluatorVersionID, req.InputData, req.EvaluatorRunConf)
+	if !ok {
+		return e.evaluatorService.RunEvaluator(ctx, req)
+	}
+
+	record, err := e.resultCache.ReuseEvaluatorRecord(ctx, key, req, etec.Expt.EvalConf.ResultCacheConf.MaxAge())
+	if err != nil {
+		logs.CtxWarn(ctx, "[ExptTurnEval] reuse cached evaluator record fail, evaluator_version_id: %v, key: %v, err: %v", req.EvaluatorVersionID, key, err)
+	} else if record != nil {
+		return record, nil
+	}
+
+	record, err = e.evaluatorService.RunEvaluator(ctx, req)
+	if err != nil {
+		return record, err
+	}
+	if err := e.resultCache.PutEvaluatorRecord(ctx, req.SpaceID, key, record); err != nil {
+		logs.CtxWarn(ctx, "[ExptTurnEval] put evaluator record cache fail, evaluator_version_id: %v, key: %v, err: %v", req.EvaluatorVersionID, key, err)
+	}
+	return record, nil
+}
+
 // buildAliasRunConf 由 per-alias DynamicParam 合成运行时配置 (动态参数执行):
 // DynamicParam 仅覆盖 runtime_param (固定 key consts.FieldAdapterBuiltinFieldNameRuntimeParam),
 // Env 继承静态 staticRunConf 的 Env。返回 nil 表示无 per-alias 覆盖, 调用方应回退 staticRunConf。
@@ -667,7 +825,7 @@ func (e *DefaultExptTurnEvaluationImpl) callEvaluatorsByItemConfig(
 	ctx context.Context, etec *entity.ExptTurnEvalCtx, targetResult *entity.EvalTargetRecord,
 ) ([]*entity.EvaluatorRecord, error) {
 	var (
-		collector evalRecordCollector
+		collector = e.newEvalRecordCollector(ctx)
 		item      = etec.EvalSetItem
 		expt      = etec.Expt
 		turn      = etec.Turn
@@ -884,7 +1042,7 @@ func (e *DefaultExptTurnEvaluationImpl) callEvaluatorsByItemConfig(
 
 		if evForCapture.IsAsync() {
 			pool.Add(func() error {
-				return e.asyncCallEvaluatorWithAlias(ctx, evForCapture, runConfForCapture, aliasForCapture, etec, inputDataForCapture, &collector)
+				return e.asyncCallEvaluatorWithAlias(ctx, evForCapture, runConfForCapture, aliasForCapture, etec, inputDataForCapture, collector)
 			})
 			continue
 		}
@@ -892,7 +1050,7 @@ func (e *DefaultExptTurnEvaluationImpl) callEvaluatorsByItemConfig(
 		pool.Add(func() error {
 			var err error
 			defer e.metric.EmitTurnExecEvaluatorResult(evaluatorSpaceID, err != nil)
-			evaluatorRecord, err := e.evaluatorService.RunEvaluator(ctx, &entity.RunEvaluatorRequest{
+			evaluatorRecord, err := e.runEvaluatorWithResultCache(ctx, etec, &entity.RunEvaluatorRequest{
 				SpaceID:            evaluatorSpaceID,
 				Name:               "",
 				EvaluatorVersionID: versionIDForCapture,
@@ -1168,7 +1326,8 @@ func (e *DefaultExptTurnEvaluationImpl) buildEvalSetFields(ctx context.Context,
 		if err != nil {
 			return nil, err
 		}
-		if content.IsContentOmitted() {
+		// 表达式引用的列已在上方加载完整内容
+		if content.IsContentOmitted() && !fc.IsExpr() {
 			req := &entity.GetEvaluationSetItemFieldParam{
 				SpaceID:         spaceID,
 				EvaluationSetID: evalSetTurn.EvalSetID,
@@ -1189,11 +1348,14 @@ func (e *DefaultExptTurnEvaluationImpl) buildEvalSetFields(ctx context.Context,
 	return result, nil
 }
 
-// getFieldContent get field content, handling JSON Path logic
+// getFieldContent get field content, handling JSON Path and field expression logic
 func (e *DefaultExptTurnEvaluationImpl) getFieldContent(
 	fc *entity.FieldConf,
 	sourceFields map[string]*entity.Content,
 ) (*entity.Content, error) {
+	if fc.IsExpr() {
+		return evalFieldExpr(fc, sourceFields)
+	}
 	firstField, err := json.GetFirstJSONPathField(fc.FromField)
 	if err != nil {
 		return nil, err
@@ -25,6 +25,7 @@ import (
 	repomocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
 	svcmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/ctxcache"
 )
 
 // mock DenyReason implementation
@@ -41,7 +42,7 @@ func TestNewExptTurnEvaluation(t *testing.T) {
 	mockEvalSetItemSvc := svcmocks.NewMockEvaluationSetItemService(ctrl)
 	mockEvaluatorRecordService := svcmocks.NewMockEvaluatorRecordService(ctrl)
 
-	eval := NewExptTurnEvaluation(mockMetric, mockEvalTargetService, mockEvaluatorService, mockBenefitService, mockEvalAsyncRepo, mockEvalSetItemSvc, mockEvaluatorRecordService)
+	eval := NewExptTurnEvaluation(mockMetric, mockEvalTargetService, mockEvaluatorService, mockBenefitService, mockEvalAsyncRepo, mockEvalSetItemSvc, mockEvaluatorRecordService, nil, nil)
 	assert.NotNil(t, eval)
 
 	impl, ok := eval.(*DefaultExptTurnEvaluationImpl)
@@ -5505,3 +5506,209 @@ func TestDefaultExptTurnEvaluationImpl_MixedEvaluatorCompletionOrder(t *testing.
 		newCase(t, entity.EvaluatorRunStatusAsyncInvoking, true)
 	})
 }
+
+func TestDefaultExptTurnEvaluationImpl_executeTargetWithResultCache(t *testing.T) {
+	ctx := context.Background()
+	newEtec := func(cacheConf *entity.ExptResultCacheConf) *entity.ExptTurnEvalCtx {
+		return &entity.ExptTurnEvalCtx{
+			ExptItemEvalCtx: &entity.ExptItemEvalCtx{
+				Event: &entity.ExptItemEvalEvent{SpaceID: 1},
+				Expt: &entity.Experiment{
+					Target:   &entity.EvalTarget{ID: 3, EvalTargetVersion: &entity.EvalTargetVersion{ID: 4}},
+					EvalConf: &entity.EvaluationConfiguration{ResultCacheConf: cacheConf},
+				},
+			},
+		}
+	}
+	etc := &entity.ExecuteTargetCtx{ItemID: 8, TurnID: 9}
+	etid := &entity.EvalTargetInputData{InputFields: map[string]*entity.Content{"q": {Text: gptr.Of("hi")}}}
+	key, ok := entity.BuildTargetResultCacheKey(4, etid)
+	require.True(t, ok)
+
+	t.Run("未开启缓存直接执行", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		targetSvc := svcmocks.NewMockIEvalTargetService(ctrl)
+		cacheSvc := svcmocks.NewMockIExptResultCacheService(ctrl)
+		record := &entity.EvalTargetRecord{ID: 1}
+		targetSvc.EXPECT().ExecuteTarget(gomock.Any(), int64(2), int64(3), int64(4), etc, etid).Return(record, nil)
+
+		svc := &DefaultExptTurnEvaluationImpl{evalTargetService: targetSvc, resultCache: cacheSvc}
+		got, err := svc.executeTargetWithResultCache(ctx, newEtec(nil), 2, etc, etid)
+		assert.NoError(t, err)
+		assert.Equal(t, record, got)
+	})
+
+	t.Run("命中缓存不执行评测对象", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		targetSvc := svcmocks.NewMockIEvalTargetService(ctrl)
+		cacheSvc := svcmocks.NewMockIExptResultCacheService(ctrl)
+		reused := &entity.EvalTargetRecord{ID: 2}
+		cacheSvc.EXPECT().ReuseTargetRecord(gomock.Any(), int64(1), int64(2), int64(3), int64(4), key, etc, etid, time.Duration(0)).Return(reused, nil)
+
+		svc := &DefaultExptTurnEvaluationImpl{evalTargetService: targetSvc, resultCache: cacheSvc}
+		got, err := svc.executeTargetWithResultCache(ctx, newEtec(&entity.ExptResultCacheConf{EnableTargetCache: true}), 2, etc, etid)
+		assert.NoError(t, err)
+		assert.Equal(t, reused, got)
+	})
+
+	t.Run("未命中执行后写缓存, 缓存异常不影响结果", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		targetSvc := svcmocks.NewMockIEvalTargetService(ctrl)
+		cacheSvc := svcmocks.NewMockIExptResultCacheService(ctrl)
+		record := &entity.EvalTargetRecord{ID: 3}
+		cacheSvc.EXPECT().ReuseTargetRecord(gomock.Any(), int64(1), int64(2), int64(3), int64(4), key, etc, etid, time.Duration(0)).Return(nil, errors.New("redis down"))
+		targetSvc.EXPECT().ExecuteTarget(gomock.Any(), int64(2), int64(3), int64(4), etc, etid).Return(record, nil)
+		cacheSvc.EXPECT().PutTargetRecord(gomock.Any(), int64(1), key, record).Return(errors.New("redis down"))
+
+		svc := &DefaultExptTurnEvaluationImpl{evalTargetService: targetSvc, resultCache: cacheSvc}
+		got, err := svc.executeTargetWithResultCache(ctx, newEtec(&entity.ExptResultCacheConf{EnableTargetCache: true}), 2, etc, etid)
+		assert.NoError(t, err)
+		assert.Equal(t, record, got)
+	})
+}
+
+func TestDefaultExptTurnEvaluationImpl_runEvaluatorWithResultCache(t *testing.T) {
+	ctx := context.Background()
+	etec := &entity.ExptTurnEvalCtx{
+		ExptItemEvalCtx: &entity.ExptItemEvalCtx{
+			Expt: &entity.Experiment{EvalConf: &entity.EvaluationConfiguration{ResultCacheConf: &entity.ExptResultCacheConf{EnableEvaluatorCache: true}}},
+		},
+	}
+	req := &entity.RunEvaluatorRequest{SpaceID: 1, EvaluatorVersionID: 10, InputData: &entity.EvaluatorInputData{}}
+	key, ok := entity.BuildEvaluatorResultCacheKey(10, req.InputData, nil)
+	require.True(t, ok)
+
+	ctrl := gomock.NewController(t)
+	evaluatorSvc := svcmocks.NewMockEvaluatorService(ctrl)
+	cacheSvc := svcmocks.NewMockIExptResultCacheService(ctrl)
+	svc := &DefaultExptTurnEvaluationImpl{evaluatorService: evaluatorSvc, resultCache: cacheSvc}
+
+	// 命中
+	reused := &entity.EvaluatorRecord{ID: 2}
+	cacheSvc.EXPECT().ReuseEvaluatorRecord(gomock.Any(), key, req, time.Duration(0)).Return(reused, nil)
+	got, err := svc.runEvaluatorWithResultCache(ctx, etec, req)
+	assert.NoError(t, err)
+	assert.Equal(t, reused, got)
+
+	// 未命中, 运行失败时不写缓存
+	cacheSvc.EXPECT().ReuseEvaluatorRecord(gomock.Any(), key, req, time.Duration(0)).Return(nil, nil)
+	evaluatorSvc.EXPECT().RunEvaluator(gomock.Any(), req).Return(nil, errors.New("run fail"))
+	_, err = svc.runEvaluatorWithResultCache(ctx, etec, req)
+	assert.Error(t, err)
+
+	// 未命中, 运行成功写缓存
+	record := &entity.EvaluatorRecord{ID: 3}
+	cacheSvc.EXPECT().ReuseEvaluatorRecord(gomock.Any(), key, req, time.Duration(0)).Return(nil, nil)
+	evaluatorSvc.EXPECT().RunEvaluator(gomock.Any(), req).Return(record, nil)
+	cacheSvc.EXPECT().PutEvaluatorRecord(gomock.Any(), int64(1), key, record).Return(nil)
+	got, err = svc.runEvaluatorWithResultCache(ctx, etec, req)
+	assert.NoError(t, err)
+	assert.Equal(t, record, got)
+}
+
+func TestDefaultExptTurnEvaluationImpl_callTrials(t *testing.T) {
+	newEtec := func(trialConf *entity.ExptTrialConf) *entity.ExptTurnEvalCtx {
+		return &entity.ExptTurnEvalCtx{
+			ExptItemEvalCtx: &entity.ExptItemEvalCtx{
+				EvalSetItem: &entity.EvaluationSetItem{ItemID: 1},
+				Event:       &entity.ExptItemEvalEvent{ExptID: 1, SpaceID: 2, Session: &entity.Session{UserID: "1"}},
+				Expt: &entity.Experiment{
+					SpaceID: 2,
+					Evaluators: []*entity.Evaluator{
+						{ID: 1, EvaluatorType: entity.EvaluatorTypePrompt, PromptEvaluatorVersion: &entity.PromptEvaluatorVersion{ID: 1}},
+					},
+					EvalConf: &entity.EvaluationConfiguration{
+						ConnectorConf: entity.Connector{
+							EvaluatorsConf: &entity.EvaluatorsConf{
+								EvaluatorConf: []*entity.EvaluatorConf{{
+									EvaluatorVersionID: 1,
+									IngressConf: &entity.EvaluatorIngressConf{
+										EvalSetAdapter: &entity.FieldAdapter{},
+										TargetAdapter:  &entity.FieldAdapter{},
+									},
+								}},
+							},
+						},
+						TrialConf: trialConf,
+					},
+				},
+			},
+			// 第 0 次试验已有成功的评估结果, 额外试验不应复用
+			ExptTurnRunResult: &entity.ExptTurnRunResult{
+				EvaluatorResults: []*entity.EvaluatorRecord{{ID: 1, EvaluatorVersionID: 1, Status: entity.EvaluatorRunStatusSuccess}},
+			},
+			Turn: &entity.Turn{},
+		}
+	}
+	targetResult := &entity.EvalTargetRecord{EvalTargetOutputData: &entity.EvalTargetOutputData{OutputFields: map[string]*entity.Content{}}}
+
+	t.Run("trial disabled", func(t *testing.T) {
+		service := &DefaultExptTurnEvaluationImpl{}
+		assert.Nil(t, service.callTrials(context.Background(), newEtec(nil), targetResult))
+		assert.Nil(t, service.callTrials(context.Background(), newEtec(&entity.ExptTrialConf{TrialNum: 1}), targetResult))
+	})
+
+	t.Run("async report trigger skips trials", func(t *testing.T) {
+		service := &DefaultExptTurnEvaluationImpl{}
+		etec := newEtec(&entity.ExptTrialConf{TrialNum: 3})
+		etec.Event.AsyncEvaluatorReportTrigger = true
+		assert.Nil(t, service.callTrials(context.Background(), etec, targetResult))
+	})
+
+	t.Run("re-evaluate for each extra trial", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockMetric := metricsmocks.NewMockExptMetric(ctrl)
+		mockEvaluatorService := svcmocks.NewMockEvaluatorService(ctrl)
+		mockBenefitService := benefitmocks.NewMockIBenefitService(ctrl)
+		service := &DefaultExptTurnEvaluationImpl{metric: mockMetric, evaluatorService: mockEvaluatorService, benefitService: mockBenefitService}
+
+		mockMetric.EXPECT().EmitTurnExecEvaluatorResult(gomock.Any(), gomock.Any()).AnyTimes()
+		mockBenefitService.EXPECT().CheckAndDeductEvalBenefit(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+		mockEvaluatorService.EXPECT().ShouldInterceptEvaluator(gomock.Any(), gomock.Any()).Return(nil, false, nil).AnyTimes()
+		var recordID int64 = 10
+		mockEvaluatorService.EXPECT().RunEvaluator(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *entity.RunEvaluatorRequest) (*entity.EvaluatorRecord, error) {
+				recordID++
+				return &entity.EvaluatorRecord{ID: recordID, EvaluatorVersionID: req.EvaluatorVersionID, Status: entity.EvaluatorRunStatusSuccess}, nil
+			}).Times(2)
+
+		trials := service.callTrials(ctxcache.Init(context.Background()), newEtec(&entity.ExptTrialConf{TrialNum: 3}), targetResult)
+		require.Len(t, trials, 2)
+		for i, trial := range trials {
+			assert.Equal(t, int32(i+1), trial.TrialIdx)
+			assert.NoError(t, trial.EvalErr)
+			require.Len(t, trial.EvaluatorResults, 1)
+			assert.Equal(t, int64(11+i), trial.EvaluatorResults[0].ID)
+		}
+	})
+
+	t.Run("trials bypass result cache", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockMetric := metricsmocks.NewMockExptMetric(ctrl)
+		mockEvaluatorService := svcmocks.NewMockEvaluatorService(ctrl)
+		mockBenefitService := benefitmocks.NewMockIBenefitService(ctrl)
+		// 未设置任何预期: 试验读写缓存即失败
+		mockResultCache := svcmocks.NewMockIExptResultCacheService(ctrl)
+		service := &DefaultExptTurnEvaluationImpl{metric: mockMetric, evaluatorService: mockEvaluatorService, benefitService: mockBenefitService, resultCache: mockResultCache}
+
+		mockMetric.EXPECT().EmitTurnExecEvaluatorResult(gomock.Any(), gomock.Any()).AnyTimes()
+		mockBenefitService.EXPECT().CheckAndDeductEvalBenefit(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+		mockEvaluatorService.EXPECT().ShouldInterceptEvaluator(gomock.Any(), gomock.Any()).Return(nil, false, nil).AnyTimes()
+		mockEvaluatorService.EXPECT().RunEvaluator(gomock.Any(), gomock.Any()).
+			Return(&entity.EvaluatorRecord{ID: 20, EvaluatorVersionID: 1, Status: entity.EvaluatorRunStatusSuccess}, nil).Times(2)
+
+		etec := newEtec(&entity.ExptTrialConf{TrialNum: 3})
+		etec.Expt.EvalConf.ResultCacheConf = &entity.ExptResultCacheConf{EnableTargetCache: true, EnableEvaluatorCache: true}
+		trials := service.callTrials(ctxcache.Init(context.Background()), etec, targetResult)
+		require.Len(t, trials, 2)
+		for _, trial := range trials {
+			assert.NoError(t, trial.EvalErr)
+			require.Len(t, trial.EvaluatorResults, 1)
+		}
+		assert.Equal(t, mockResultCache, service.resultCache)
+	})
+}
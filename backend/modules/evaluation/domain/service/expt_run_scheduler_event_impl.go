@@ -69,6 +69,8 @@ type ExptSchedulerImpl struct {
 	sandboxAgentMetrics metrics.SandboxAgentMetrics
 	// sandboxAgentNotifier 每 1h 进度快照飞书通知; 允许为 nil (未接入通知)。
 	sandboxAgentNotifier ISandboxAgentNotifier
+	// concurCtrl 按后端观测自适应调整 item 并发并共享后端提交预算; 允许为 nil, 此时按配置的固定并发调度。
+	concurCtrl IExptConcurrencyController
 }
 
 func NewExptSchedulerSvc(
@@ -94,6 +96,7 @@ func NewExptSchedulerSvc(
 	itemCompletePublisher component.IItemCompletePublisher,
 	exptItemRefRepo repo.IExptItemRefRepo,
 	sandboxAgentMetrics metrics.SandboxAgentMetrics,
+	concurCtrl IExptConcurrencyController,
 	sandboxAgentNotifier ...ISandboxAgentNotifier, // variadic 兼容旧单测
 ) ExptSchedulerEvent {
 	i := &ExptSchedulerImpl{
@@ -119,6 +122,7 @@ func NewExptSchedulerSvc(
 		itemCompletePublisher:    itemCompletePublisher,
 		exptItemRefRepo:          exptItemRefRepo,
 		sandboxAgentMetrics:      sandboxAgentMetrics,
+		concurCtrl:               concurCtrl,
 	}
 	if len(sandboxAgentNotifier) > 0 {
 		i.sandboxAgentNotifier = sandboxAgentNotifier[0]
@@ -417,11 +421,24 @@ func (e *ExptSchedulerImpl) schedule(ctx context.Context, event *entity.ExptSche
 		return err
 	}
 
+	// 花费达到上限后不再提交新 item, 按终止实验收尾
+	budgetExceeded, err := e.checkBudgetExceeded(ctx, event, exptDetail)
+	if err != nil {
+		return err
+	}
+	if budgetExceeded {
+		return nil
+	}
+
+	// 被并发控制暂缓的 item 仍待提交, 按扫描到的数量判断实验是否结束
+	scannedCnt := len(toSubmit)
+	toSubmit = e.throttleToSubmits(ctx, event, exptDetail, toSubmit, len(incomplete))
+
 	if err = e.handleToSubmits(ctx, event, toSubmit); err != nil {
 		return err
 	}
 
-	nextTick, err := mode.ExptEnd(ctx, event, exptDetail, len(toSubmit), len(incomplete))
+	nextTick, err := mode.ExptEnd(ctx, event, exptDetail, scannedCnt, len(incomplete))
 	if err != nil {
 		return err
 	}
@@ -444,6 +461,69 @@ func (e *ExptSchedulerImpl) schedule(ctx context.Context, event *entity.ExptSche
 	return mode.NextTick(ctx, event, nextTick)
 }
 
+// checkBudgetExceeded 实验配置了花费上限且已核算花费达到上限时终止实验, 返回 true 表示已终止
+func (e *ExptSchedulerImpl) checkBudgetExceeded(ctx context.Context, event *entity.ExptScheduleEvent, expt *entity.Experiment) (bool, error) {
+	if expt == nil || expt.EvalConf == nil {
+		return false, nil
+	}
+	budget, ok := expt.EvalConf.CostConf.GetBudgetCap()
+	if !ok {
+		return false, nil
+	}
+
+	stats, err := e.ExptStatsRepo.Get(contexts.WithCtxWriteDB(ctx), event.ExptID, event.SpaceID)
+	if err != nil {
+		return false, err
+	}
+	if !expt.EvalConf.CostConf.IsBudgetExceeded(stats) {
+		return false, nil
+	}
+
+	logs.CtxInfo(ctx, "[ExptEval] expt budget exceeded, terminate expt, expt_id: %v, expt_run_id: %v, total_cost: %v, budget_cap: %v",
+		event.ExptID, event.ExptRunID, stats.GetTotalCost(), budget)
+
+	completeCID := fmt.Sprintf("terminate:budget:%d", event.ExptRunID)
+	if err := e.Manager.CompleteRun(ctx, event.ExptID, event.ExptRunID, event.SpaceID, event.Session, entity.WithStatus(entity.ExptStatus_Terminated),
+		entity.WithCID(completeCID), entity.WithCompleteInterval(time.Second*2)); err != nil {
+		return false, errorx.Wrapf(err, "terminate expt run fail, expt_id: %v, expt_run_id: %v", event.ExptID, event.ExptRunID)
+	}
+	if err := e.Manager.CompleteExpt(ctx, event.ExptID, &event.ExptRunID, event.SpaceID, event.Session, entity.WithStatus(entity.ExptStatus_Terminated),
+		entity.WithStatusMessage(fmt.Sprintf("实验花费 %.4f 已达到花费上限 %v，停止评测", stats.GetTotalCost(), budget)),
+		entity.WithCID(completeCID), entity.WithCompleteInterval(time.Second*2)); err != nil {
+		return false, errorx.Wrapf(err, "complete expt fail, expt_id: %v, expt_run_id: %v", event.ExptID, event.ExptRunID)
+	}
+	return true, nil
+}
+
+// throttleToSubmits 按自适应并发与后端共享预算裁剪本轮待提交 item, 并记录实验当前的有效并发与被共享预算延后的 item 数。
+// 并发控制异常时降级为按配置的固定并发提交。
+func (e *ExptSchedulerImpl) throttleToSubmits(ctx context.Context, event *entity.ExptScheduleEvent, expt *entity.Experiment, toSubmit []*entity.ExptEvalItem, incomplete int) []*entity.ExptEvalItem {
+	if e.concurCtrl == nil {
+		return toSubmit
+	}
+
+	effective, err := e.concurCtrl.Adjust(ctx, expt)
+	if err != nil {
+		logs.CtxWarn(ctx, "[ExptEval] adjust expt concurrency fail, expt_id: %v, err: %v", event.ExptID, err)
+		return toSubmit
+	}
+
+	allowed := min(max(effective-incomplete, 0), len(toSubmit))
+	deferred := 0
+	if allowed > 0 {
+		granted := e.concurCtrl.AcquireBudget(ctx, expt, allowed)
+		deferred, allowed = allowed-granted, granted
+	}
+	if err := e.ExptStatsRepo.UpdateConcurrencyState(ctx, event.ExptID, event.SpaceID, int32(effective), int32(deferred)); err != nil {
+		logs.CtxWarn(ctx, "[ExptEval] update expt concurrency state fail, expt_id: %v, err: %v", event.ExptID, err)
+	}
+	if allowed < len(toSubmit) {
+		logs.CtxInfo(ctx, "[ExptEval] throttle to submit items, expt_id: %v, effective_concurrency: %v, incomplete: %v, scanned: %v, allowed: %v",
+			event.ExptID, effective, incomplete, len(toSubmit), allowed)
+	}
+	return toSubmit[:allowed]
+}
+
 func (e *ExptSchedulerImpl) recordEvalItemRunLogs(ctx context.Context, event *entity.ExptScheduleEvent, completeItems []*entity.ExptEvalItem, mode entity.ExptSchedulerMode, expt *entity.Experiment) error {
 	time.Sleep(time.Millisecond * 1000) // avoid master-slave delay caused by asynchronous and other factors
 
@@ -627,6 +707,7 @@ func (e *ExptSchedulerImpl) handleToSubmits(ctx context.Context, event *entity.E
 			ExptRunID:     event.ExptRunID,
 			ExptRunMode:   event.ExptRunMode,
 			EvalSetItemID: ts.ItemID,
+			Attempt:       ts.Attempt,
 			CreateAt:      now,
 			MaxRetryTimes: event.ItemRetryTimes,
 			Ext:           event.Ext,
@@ -684,7 +765,8 @@ func (e *ExptSchedulerImpl) handleZombies(ctx context.Context, event *entity.Exp
 	if expt != nil {
 		asyncExec = expt.AsyncExec()
 	}
-	zombieSecond := e.Configer.GetConsumerConf(ctx).GetExptExecConf(event.SpaceID).GetExptItemEvalConf().GetItemZombieSecond(asyncExec)
+	execConf := e.Configer.GetConsumerConf(ctx).GetExptExecConf(event.SpaceID)
+	zombieSecond := execConf.GetExptItemEvalConf().GetItemZombieSecond(asyncExec)
 	for _, item := range items {
 		if item.State == entity.ItemRunState_Processing && item.UpdatedAt != nil && !gptr.Indirect(item.UpdatedAt).IsZero() {
 			if time.Since(gptr.Indirect(item.UpdatedAt)).Seconds() > float64(zombieSecond) {
@@ -695,6 +777,9 @@ func (e *ExptSchedulerImpl) handleZombies(ctx context.Context, event *entity.Exp
 		}
 	}
 
+	zombies, resumed := e.resumeZombies(ctx, event, execConf, zombies)
+	alives = append(alives, resumed...)
+
 	zombieItemIDs := gslice.Transform(zombies, func(e *entity.ExptEvalItem, _ int) int64 { return e.ItemID })
 
 	if len(zombies) == 0 {
@@ -757,6 +842,111 @@ func (e *ExptSchedulerImpl) handleZombies(ctx context.Context, event *entity.Exp
 	return alives, zombies, nil
 }
 
+// resumeZombies 开启执行检查点时, 续跑次数未达上限的僵尸 item 按 turn run log 中的检查点重新投递执行, 其余仍按僵尸处理。
+// 续跑复用 run log 中已完成的评测对象/评估器结果, 等待中的异步调用在续跑时重新挂接回调。续跑失败时退回僵尸处理。
+// updated_at 过期不代表原执行已退出, 续跑前以执行轮次 CAS 认领 item: 认领成功后原轮次的写入被拒绝,
+// 认领失败说明 item 已完成或已被其他调度认领, 按仍在执行处理。
+func (e *ExptSchedulerImpl) resumeZombies(ctx context.Context, event *entity.ExptScheduleEvent, execConf *entity.ExptExecConf, zombies []*entity.ExptEvalItem) (remains, alives []*entity.ExptEvalItem) {
+	conf := execConf.GetCheckpointConf()
+	if !conf.IsEnabled() || len(zombies) == 0 {
+		return zombies, nil
+	}
+
+	itemIDs := gslice.Map(zombies, func(item *entity.ExptEvalItem) int64 { return item.ItemID })
+	turnRunLogs, err := e.ExptTurnResultRepo.MGetItemTurnRunLogs(ctx, event.ExptID, event.ExptRunID, itemIDs, event.SpaceID)
+	if err != nil {
+		logs.CtxError(ctx, "[ExptEval] resume zombie items get turn run logs fail, expt_id: %v, expt_run_id: %v, err: %v", event.ExptID, event.ExptRunID, err)
+		return zombies, nil
+	}
+	item2RunLogs := make(map[int64][]*entity.ExptTurnResultRunLog, len(zombies))
+	for _, rl := range turnRunLogs {
+		if rl != nil {
+			item2RunLogs[rl.ItemID] = append(item2RunLogs[rl.ItemID], rl)
+		}
+	}
+
+	var (
+		toSave  []*entity.ExptTurnResultRunLog
+		resumed []*entity.ExptEvalItem
+	)
+	for _, item := range zombies {
+		runLogs := item2RunLogs[item.ItemID]
+		resumeTimes := 0
+		for _, rl := range runLogs {
+			resumeTimes = max(resumeTimes, rl.Checkpoint.GetResumeTimes())
+		}
+		// 无 turn run log 时无处记录续跑次数, 按僵尸处理
+		if len(runLogs) == 0 || resumeTimes >= conf.GetMaxResumeTimes() {
+			remains = append(remains, item)
+			continue
+		}
+		claimed, err := e.ExptItemResultRepo.ClaimItemRunLog(ctx, event.ExptID, event.ExptRunID, item.ItemID, item.Attempt, event.SpaceID)
+		if err != nil {
+			logs.CtxError(ctx, "[ExptEval] resume zombie item claim fail, expt_id: %v, expt_run_id: %v, item_id: %v, err: %v", event.ExptID, event.ExptRunID, item.ItemID, err)
+			remains = append(remains, item)
+			continue
+		}
+		if !claimed {
+			logs.CtxInfo(ctx, "[ExptEval] resume zombie item claimed by others or finished, skip, expt_id: %v, expt_run_id: %v, item_id: %v, attempt: %v", event.ExptID, event.ExptRunID, item.ItemID, item.Attempt)
+			alives = append(alives, item.SetState(entity.ItemRunState_Processing))
+			continue
+		}
+		item.Attempt++
+		for _, rl := range runLogs {
+			cp := &entity.ExptTurnCheckpoint{}
+			if rl.Checkpoint != nil {
+				*cp = *rl.Checkpoint
+			}
+			cp.ResumeTimes = resumeTimes + 1
+			cp.UpdatedAtMS = time.Now().UnixMilli()
+			rl.Checkpoint = cp
+			toSave = append(toSave, rl)
+		}
+		resumed = append(resumed, item)
+	}
+	if len(resumed) == 0 {
+		return remains, alives
+	}
+
+	if err := e.ExptTurnResultRepo.SaveTurnRunLogs(ctx, toSave); err != nil {
+		logs.CtxError(ctx, "[ExptEval] resume zombie items save checkpoint fail, expt_id: %v, expt_run_id: %v, err: %v", event.ExptID, event.ExptRunID, err)
+		return append(remains, resumed...), alives
+	}
+
+	now := time.Now().Unix()
+	resumedItemIDs := make([]int64, 0, len(resumed))
+	itemEvalEvents := make([]*entity.ExptItemEvalEvent, 0, len(resumed))
+	for _, item := range resumed {
+		resumedItemIDs = append(resumedItemIDs, item.ItemID)
+		itemEvalEvents = append(itemEvalEvents, &entity.ExptItemEvalEvent{
+			SpaceID:              event.SpaceID,
+			ExptID:               event.ExptID,
+			ExptRunID:            event.ExptRunID,
+			ExptRunMode:          event.ExptRunMode,
+			EvalSetItemID:        item.ItemID,
+			Attempt:              item.Attempt,
+			ResumeFromCheckpoint: true,
+			CreateAt:             now,
+			MaxRetryTimes:        event.ItemRetryTimes,
+			Ext:                  event.Ext,
+			Session:              event.Session,
+		})
+	}
+	interval := execConf.GetExptItemEvalConf().GetInterval()
+	if err := e.Publisher.BatchPublishExptRecordEvalEvent(ctx, itemEvalEvents, gptr.Of(interval)); err != nil {
+		logs.CtxError(ctx, "[ExptEval] resume zombie items publish fail, expt_id: %v, expt_run_id: %v, err: %v", event.ExptID, event.ExptRunID, err)
+		return append(remains, resumed...), alives
+	}
+
+	// 认领时已刷新 item run log 的 updated_at, 续跑期间不再被判为僵尸
+	for _, item := range resumed {
+		item.SetState(entity.ItemRunState_Processing)
+	}
+
+	logs.CtxWarn(ctx, "[ExptEval] resume zombie items from checkpoint, expt_id: %v, expt_run_id: %v, item_ids: %v", event.ExptID, event.ExptRunID, resumedItemIDs)
+	return remains, append(alives, resumed...)
+}
+
 // terminateZombieEvaluatorRecords 将僵尸 item 关联的、仍处于 AsyncInvoking 状态的 EvaluatorRecord 置为失败。
 // 通过 turn run log 拿到 record id 列表，再按主键过滤与更新，避免在无二级索引的 evaluator_record 表上做条件 UPDATE。
 func (e *ExptSchedulerImpl) terminateZombieEvaluatorRecords(ctx context.Context, event *entity.ExptScheduleEvent, zombieItemIDs []int64) error {
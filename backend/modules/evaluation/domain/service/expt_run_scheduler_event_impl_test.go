@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/bytedance/gg/gslice"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
@@ -503,6 +504,7 @@ func TestNewExptSchedulerSvc(t *testing.T) {
 		nil, // itemCompletePublisher: 开源侧 nil, scheduler 循环内以非空守卫跳过发送
 		exptItemRefRepo,
 		metricsmocks.NewMockSandboxAgentMetrics(ctrl),
+		nil,
 	)
 	assert.NotNil(t, svc)
 	assert.Implements(t, (*ExptSchedulerEvent)(nil), svc)
@@ -2768,3 +2770,241 @@ func TestExptSchedulerImpl_emitSandboxZombieInvokeFinished(t *testing.T) {
 		svc.emitSandboxZombieInvokeFinished(context.Background(), event, sandboxExpt, []int64{500}, map[int64][]int64{500: {10}})
 	})
 }
+
+func TestExptSchedulerImpl_checkBudgetExceeded(t *testing.T) {
+	event := &entity.ExptScheduleEvent{ExptID: 1, ExptRunID: 2, SpaceID: 3, Session: &entity.Session{UserID: "user1"}}
+	budgetExpt := &entity.Experiment{EvalConf: &entity.EvaluationConfiguration{CostConf: &entity.ExptCostConf{BudgetCap: ptr.Of(1.5)}}}
+
+	tests := []struct {
+		name        string
+		expt        *entity.Experiment
+		prepareMock func(manager *svcmocks.MockIExptManager, statsRepo *mock_repo.MockIExptStatsRepo)
+		want        bool
+		wantErr     bool
+	}{
+		{
+			name:        "未配置花费上限",
+			expt:        &entity.Experiment{EvalConf: &entity.EvaluationConfiguration{}},
+			prepareMock: func(manager *svcmocks.MockIExptManager, statsRepo *mock_repo.MockIExptStatsRepo) {},
+		},
+		{
+			name: "未达到上限",
+			expt: budgetExpt,
+			prepareMock: func(manager *svcmocks.MockIExptManager, statsRepo *mock_repo.MockIExptStatsRepo) {
+				statsRepo.EXPECT().Get(gomock.Any(), int64(1), int64(3)).Return(&entity.ExptStats{TargetCost: 0.5, EvaluatorCost: 0.5}, nil)
+			},
+		},
+		{
+			name: "达到上限后终止实验",
+			expt: budgetExpt,
+			prepareMock: func(manager *svcmocks.MockIExptManager, statsRepo *mock_repo.MockIExptStatsRepo) {
+				statsRepo.EXPECT().Get(gomock.Any(), int64(1), int64(3)).Return(&entity.ExptStats{TargetCost: 1, EvaluatorCost: 0.6}, nil)
+				manager.EXPECT().CompleteRun(gomock.Any(), int64(1), int64(2), int64(3), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				manager.EXPECT().CompleteExpt(gomock.Any(), int64(1), gomock.Any(), int64(3), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ int64, _ *int64, _ int64, _ *entity.Session, opts ...entity.CompleteExptOptionFn) error {
+						opt := &entity.CompleteExptOption{}
+						for _, fn := range opts {
+							fn(opt)
+						}
+						assert.Equal(t, entity.ExptStatus_Terminated, opt.Status)
+						assert.Contains(t, opt.StatusMessage, "花费上限")
+						return nil
+					})
+			},
+			want: true,
+		},
+		{
+			name: "查询统计失败",
+			expt: budgetExpt,
+			prepareMock: func(manager *svcmocks.MockIExptManager, statsRepo *mock_repo.MockIExptStatsRepo) {
+				statsRepo.EXPECT().Get(gomock.Any(), int64(1), int64(3)).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			manager := svcmocks.NewMockIExptManager(ctrl)
+			statsRepo := mock_repo.NewMockIExptStatsRepo(ctrl)
+			tt.prepareMock(manager, statsRepo)
+
+			e := &ExptSchedulerImpl{Manager: manager, ExptStatsRepo: statsRepo}
+			got, err := e.checkBudgetExceeded(context.Background(), event, tt.expt)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExptSchedulerImpl_throttleToSubmits(t *testing.T) {
+	event := &entity.ExptScheduleEvent{ExptID: 1, ExptRunID: 2, SpaceID: 3}
+	expt := &entity.Experiment{ID: 1, SpaceID: 3}
+	items := []*entity.ExptEvalItem{{ItemID: 1}, {ItemID: 2}, {ItemID: 3}, {ItemID: 4}}
+
+	tests := []struct {
+		name        string
+		incomplete  int
+		prepareMock func(ctrl *svcmocks.MockIExptConcurrencyController, statsRepo *mock_repo.MockIExptStatsRepo)
+		wantCnt     int
+	}{
+		{
+			name:       "有效并发扣除执行中 item",
+			incomplete: 3,
+			prepareMock: func(ctrl *svcmocks.MockIExptConcurrencyController, statsRepo *mock_repo.MockIExptStatsRepo) {
+				ctrl.EXPECT().Adjust(gomock.Any(), expt).Return(5, nil)
+				statsRepo.EXPECT().UpdateConcurrencyState(gomock.Any(), int64(1), int64(3), int32(5), int32(0)).Return(nil)
+				ctrl.EXPECT().AcquireBudget(gomock.Any(), expt, 2).Return(2)
+			},
+			wantCnt: 2,
+		},
+		{
+			name:       "后端预算不足",
+			incomplete: 0,
+			prepareMock: func(ctrl *svcmocks.MockIExptConcurrencyController, statsRepo *mock_repo.MockIExptStatsRepo) {
+				ctrl.EXPECT().Adjust(gomock.Any(), expt).Return(10, nil)
+				statsRepo.EXPECT().UpdateConcurrencyState(gomock.Any(), int64(1), int64(3), int32(10), int32(3)).Return(nil)
+				ctrl.EXPECT().AcquireBudget(gomock.Any(), expt, 4).Return(1)
+			},
+			wantCnt: 1,
+		},
+		{
+			name:       "执行中 item 已达有效并发",
+			incomplete: 6,
+			prepareMock: func(ctrl *svcmocks.MockIExptConcurrencyController, statsRepo *mock_repo.MockIExptStatsRepo) {
+				ctrl.EXPECT().Adjust(gomock.Any(), expt).Return(4, nil)
+				statsRepo.EXPECT().UpdateConcurrencyState(gomock.Any(), int64(1), int64(3), int32(4), int32(0)).Return(errors.New("db error"))
+			},
+			wantCnt: 0,
+		},
+		{
+			name:       "并发调整失败时不裁剪",
+			incomplete: 0,
+			prepareMock: func(ctrl *svcmocks.MockIExptConcurrencyController, statsRepo *mock_repo.MockIExptStatsRepo) {
+				ctrl.EXPECT().Adjust(gomock.Any(), expt).Return(0, errors.New("redis error"))
+			},
+			wantCnt: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			concurCtrl := svcmocks.NewMockIExptConcurrencyController(ctrl)
+			statsRepo := mock_repo.NewMockIExptStatsRepo(ctrl)
+			tt.prepareMock(concurCtrl, statsRepo)
+
+			e := &ExptSchedulerImpl{ExptStatsRepo: statsRepo, concurCtrl: concurCtrl}
+			got := e.throttleToSubmits(context.Background(), event, expt, items, tt.incomplete)
+			assert.Equal(t, items[:tt.wantCnt], got)
+		})
+	}
+
+	t.Run("未接入并发控制", func(t *testing.T) {
+		e := &ExptSchedulerImpl{}
+		assert.Equal(t, items, e.throttleToSubmits(context.Background(), event, expt, items, 10))
+	})
+}
+
+func TestExptSchedulerImpl_resumeZombies(t *testing.T) {
+	ctx := context.Background()
+	event := &entity.ExptScheduleEvent{ExptID: 1, ExptRunID: 2, SpaceID: 3, ItemRetryTimes: 2}
+	enabledConf := &entity.ExptExecConf{CheckpointConf: &entity.ExptCheckpointConf{Enable: true, MaxResumeTimes: 1}}
+	newZombies := func() []*entity.ExptEvalItem {
+		return []*entity.ExptEvalItem{
+			{ExptID: 1, ItemID: 10, State: entity.ItemRunState_Fail, Attempt: 2},
+			{ExptID: 1, ItemID: 20, State: entity.ItemRunState_Fail},
+			{ExptID: 1, ItemID: 30, State: entity.ItemRunState_Fail},
+		}
+	}
+	newRunLogs := func() []*entity.ExptTurnResultRunLog {
+		return []*entity.ExptTurnResultRunLog{
+			{ItemID: 10, TurnID: 1, TargetResultID: 100, Checkpoint: &entity.ExptTurnCheckpoint{Stage: entity.ExptTurnCheckpointStageTargetDone}},
+			{ItemID: 20, TurnID: 1, Checkpoint: &entity.ExptTurnCheckpoint{ResumeTimes: 1}},
+		}
+	}
+
+	t.Run("未开启检查点, 全部按僵尸处理", func(t *testing.T) {
+		zombies := newZombies()
+		remains, resumed := (&ExptSchedulerImpl{}).resumeZombies(ctx, event, &entity.ExptExecConf{}, zombies)
+		assert.Equal(t, zombies, remains)
+		assert.Empty(t, resumed)
+	})
+
+	t.Run("按检查点续跑, 超出续跑次数或无 run log 的 item 仍为僵尸", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		turnResultRepo := mock_repo.NewMockIExptTurnResultRepo(ctrl)
+		itemResultRepo := mock_repo.NewMockIExptItemResultRepo(ctrl)
+		publisher := eventmocks.NewMockExptEventPublisher(ctrl)
+
+		turnResultRepo.EXPECT().MGetItemTurnRunLogs(gomock.Any(), int64(1), int64(2), []int64{10, 20, 30}, int64(3)).Return(newRunLogs(), nil)
+		itemResultRepo.EXPECT().ClaimItemRunLog(gomock.Any(), int64(1), int64(2), int64(10), int32(2), int64(3)).Return(true, nil)
+		turnResultRepo.EXPECT().SaveTurnRunLogs(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rls []*entity.ExptTurnResultRunLog) error {
+			assert.Len(t, rls, 1)
+			assert.Equal(t, int64(10), rls[0].ItemID)
+			assert.Equal(t, 1, rls[0].Checkpoint.ResumeTimes)
+			assert.Equal(t, entity.ExptTurnCheckpointStageTargetDone, rls[0].Checkpoint.Stage)
+			return nil
+		})
+		publisher.EXPECT().BatchPublishExptRecordEvalEvent(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, events []*entity.ExptItemEvalEvent, _ *time.Duration) error {
+			assert.Len(t, events, 1)
+			assert.Equal(t, int64(10), events[0].EvalSetItemID)
+			assert.True(t, events[0].ResumeFromCheckpoint)
+			assert.Equal(t, 2, events[0].MaxRetryTimes)
+			assert.Equal(t, int32(3), events[0].Attempt)
+			return nil
+		})
+
+		e := &ExptSchedulerImpl{ExptTurnResultRepo: turnResultRepo, ExptItemResultRepo: itemResultRepo, Publisher: publisher}
+		remains, resumed := e.resumeZombies(ctx, event, enabledConf, newZombies())
+		assert.Equal(t, []int64{20, 30}, gslice.Map(remains, func(item *entity.ExptEvalItem) int64 { return item.ItemID }))
+		if assert.Len(t, resumed, 1) {
+			assert.Equal(t, int64(10), resumed[0].ItemID)
+			assert.Equal(t, entity.ItemRunState_Processing, resumed[0].State)
+		}
+	})
+
+	t.Run("投递失败, 全部按僵尸处理", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		turnResultRepo := mock_repo.NewMockIExptTurnResultRepo(ctrl)
+		itemResultRepo := mock_repo.NewMockIExptItemResultRepo(ctrl)
+		publisher := eventmocks.NewMockExptEventPublisher(ctrl)
+
+		turnResultRepo.EXPECT().MGetItemTurnRunLogs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(newRunLogs(), nil)
+		itemResultRepo.EXPECT().ClaimItemRunLog(gomock.Any(), gomock.Any(), gomock.Any(), int64(10), gomock.Any(), gomock.Any()).Return(true, nil)
+		turnResultRepo.EXPECT().SaveTurnRunLogs(gomock.Any(), gomock.Any()).Return(nil)
+		publisher.EXPECT().BatchPublishExptRecordEvalEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("mq error"))
+
+		e := &ExptSchedulerImpl{ExptTurnResultRepo: turnResultRepo, ExptItemResultRepo: itemResultRepo, Publisher: publisher}
+		remains, resumed := e.resumeZombies(ctx, event, enabledConf, newZombies())
+		assert.ElementsMatch(t, []int64{10, 20, 30}, gslice.Map(remains, func(item *entity.ExptEvalItem) int64 { return item.ItemID }))
+		assert.Empty(t, resumed)
+	})
+
+	t.Run("认领失败的 item 已完成或被其他调度续跑, 不再投递也不判僵尸", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		turnResultRepo := mock_repo.NewMockIExptTurnResultRepo(ctrl)
+		itemResultRepo := mock_repo.NewMockIExptItemResultRepo(ctrl)
+		publisher := eventmocks.NewMockExptEventPublisher(ctrl)
+
+		turnResultRepo.EXPECT().MGetItemTurnRunLogs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(newRunLogs(), nil)
+		itemResultRepo.EXPECT().ClaimItemRunLog(gomock.Any(), int64(1), int64(2), int64(10), int32(2), int64(3)).Return(false, nil)
+		turnResultRepo.EXPECT().SaveTurnRunLogs(gomock.Any(), gomock.Any()).Times(0)
+		publisher.EXPECT().BatchPublishExptRecordEvalEvent(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		e := &ExptSchedulerImpl{ExptTurnResultRepo: turnResultRepo, ExptItemResultRepo: itemResultRepo, Publisher: publisher}
+		remains, alives := e.resumeZombies(ctx, event, enabledConf, newZombies())
+		assert.Equal(t, []int64{20, 30}, gslice.Map(remains, func(item *entity.ExptEvalItem) int64 { return item.ItemID }))
+		if assert.Len(t, alives, 1) {
+			assert.Equal(t, int64(10), alives[0].ItemID)
+			assert.Equal(t, int32(2), alives[0].Attempt)
+			assert.Equal(t, entity.ItemRunState_Processing, alives[0].State)
+		}
+	})
+}
@@ -59,6 +59,7 @@ func TestExptSubmitExec_exptStartMultiSet_DraftSet(t *testing.T) {
 	const draftSet = int64(7656754417005232130)
 	var sawVersionID *int64
 	var sawVersionIDSet bool
+	setItemSvc.EXPECT().ListPendingReviewItemIDs(gomock.Any(), int64(3), draftSet).Return(nil, nil).Times(1)
 	setItemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(_ context.Context, p *entity.ListEvaluationSetItemsParam) ([]*entity.EvaluationSetItem, *int64, *int64, *string, error) {
 			sawVersionID = p.VersionID
@@ -95,6 +96,44 @@ func TestExptSubmitExec_exptStartMultiSet_DraftSet(t *testing.T) {
 	}
 }
 
+// 扫描层: 草稿集中待审核的生成行不进入 expt_item_ref, 审核通过前不参与实验。
+func TestExptSubmitExec_exptStartMultiSet_DraftSetExcludesPendingReview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	exec, setItemSvc, itemRefRepo := newExptStartMultiSetTestExec(ctrl)
+
+	var captured []*entity.ExptItemRef
+	itemRefRepo.EXPECT().BatchCreate(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, refs []*entity.ExptItemRef) error {
+			captured = append(captured, refs...)
+			return nil
+		}).AnyTimes()
+
+	const draftSet = int64(7656754417005232130)
+	setItemSvc.EXPECT().ListPendingReviewItemIDs(gomock.Any(), int64(3), draftSet).Return([]int64{2}, nil).Times(1)
+	setItemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).Return([]*entity.EvaluationSetItem{
+		{ItemID: 1, Turns: []*entity.Turn{{ID: 11}}},
+		{ItemID: 2, Turns: []*entity.Turn{{ID: 22}}},
+	}, ptr.Of(int64(2)), nil, nil, nil).Times(1)
+
+	expt := &entity.Experiment{
+		ID:      1,
+		SpaceID: 3,
+		EvalConf: &entity.EvaluationConfiguration{
+			EvalSetConfigs: []*entity.EvalSetConfig{
+				{EvalSetID: draftSet, EvalSetVersionID: draftSet},
+			},
+		},
+	}
+	ctx := session.WithCtxUser(context.Background(), &session.User{ID: "u1"})
+	err := exec.exptStartMultiSet(ctx, &entity.ExptScheduleEvent{ExptID: 1, ExptRunID: 2, SpaceID: 3, Session: &entity.Session{UserID: "u1"}}, expt)
+	assert.NoError(t, err)
+
+	assert.Len(t, captured, 1)
+	assert.Equal(t, int64(1), captured[0].ItemID)
+}
+
 // 扫描层: committed 集维持 ByVersion (VersionID 非 nil) + ref 落真实 version_id 不变 (回归守卫)。
 func TestExptSubmitExec_exptStartMultiSet_CommittedSet_Unchanged(t *testing.T) {
 	ctrl := gomock.NewController(t)
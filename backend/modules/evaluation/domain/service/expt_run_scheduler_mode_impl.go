@@ -319,6 +319,11 @@ func (e *ExptTrialRunExec) ExptStart(ctx context.Context, event *entity.ExptSche
 	orderByDesc := gptr.Of(false)
 	orderByField := gptr.Of("item_id")
 
+	pendingReview, err := loadPendingReviewItemIDs(ctx, e.evaluationSetItemService, resolveLoadSpaceID(event.SpaceID, expt.EvalSetSpaceID), evalSetID, evalSetVersionID)
+	if err != nil {
+		return err
+	}
+
 	for i := 0; i < maxLoop; i++ {
 		logs.CtxInfo(ctx, "ExptTrialRunExec.ExptStart scan item, expt_id: %v, expt_run_id: %v, eval_set_id: %v, eval_set_ver_id: %v, page_token: %v, limit: %v, cur_cnt: %v, total: %v",
 			event.ExptID, event.ExptRunID, evalSetID, evalSetVersionID, gptr.Indirect(pageToken), pageSize, itemCnt, total)
@@ -347,6 +352,8 @@ func (e *ExptTrialRunExec) ExptStart(ctx context.Context, event *entity.ExptSche
 		if t != nil {
 			total = gptr.Indirect(t)
 		}
+		pageLen := len(items)
+		items = excludePendingReviewItems(items, pendingReview)
 
 		remain := limit - itemCnt
 		if remain <= 0 {
@@ -408,7 +415,7 @@ func (e *ExptTrialRunExec) ExptStart(ctx context.Context, event *entity.ExptSche
 			return err
 		}
 
-		if itemCnt >= limit || len(items) == 0 || itemCnt >= int(total) || pageToken == nil || *pageToken == "" {
+		if itemCnt >= limit || pageLen == 0 || itemCnt >= int(total) || pageToken == nil || *pageToken == "" {
 			break
 		}
 
@@ -445,6 +452,11 @@ func (e *ExptTrialRunExec) exptStartByItemIds(ctx context.Context, event *entity
 		pageSize         = int32(100)
 	)
 
+	pendingReview, err := loadPendingReviewItemIDs(ctx, e.evaluationSetItemService, resolveLoadSpaceID(event.SpaceID, expt.EvalSetSpaceID), evalSetID, evalSetVersionID)
+	if err != nil {
+		return err
+	}
+
 	for _, chunk := range gslice.Chunk(itemIds, int(pageSize)) {
 		logs.CtxInfo(ctx, "ExptTrialRunExec.exptStartByItemIds scan item, expt_id: %v, expt_run_id: %v, eval_set_id: %v, eval_set_ver_id: %v, item_ids: %v",
 			event.ExptID, event.ExptRunID, evalSetID, evalSetVersionID, chunk)
@@ -459,6 +471,7 @@ func (e *ExptTrialRunExec) exptStartByItemIds(ctx context.Context, event *entity
 		if err != nil {
 			return err
 		}
+		items = excludePendingReviewItems(items, pendingReview)
 
 		itemCnt += len(items)
 
@@ -541,12 +554,23 @@ func (e *ExptSubmitExec) ExptStart(ctx context.Context, event *entity.ExptSchedu
 		maxLoop = 10000
 		itemIdx = int32(0)
 
-		pageSize  = int32(100)
-		itemCnt   = 0
-		total     = int64(0)
-		pageToken *string
+		pageSize   = int32(100)
+		itemCnt    = 0
+		runItemCnt = 0
+		total      = int64(0)
+		pageToken  *string
 	)
 
+	// 抽样实验只为入选 item 创建结果, 分层抽样时 item 所属分层记入 item 结果 Ext
+	sampled, err := e.sampleItems(ctx, event, expt)
+	if err != nil {
+		return err
+	}
+	pendingReview, err := loadPendingReviewItemIDs(ctx, e.evaluationSetItemService, resolveLoadSpaceID(event.SpaceID, expt.EvalSetSpaceID), evalSetID, evalSetVersionID)
+	if err != nil {
+		return err
+	}
+
 	for i := 0; i < maxLoop; i++ {
 		logs.CtxInfo(ctx, "ExptSubmitExec.ExptStart scan item, expt_id: %v, expt_run_id: %v, eval_set_id: %v, eval_set_ver_id: %v, page_token: %v, limit: %v, cur_cnt: %v, total: %v",
 			event.ExptID, event.ExptRunID, evalSetID, evalSetVersionID, gptr.Indirect(pageToken), pageSize, itemCnt, total)
@@ -571,6 +595,21 @@ func (e *ExptSubmitExec) ExptStart(ctx context.Context, event *entity.ExptSchedu
 		itemCnt += len(items)
 		pageToken = nextPageToken
 		total = gptr.Indirect(t)
+		scanDone := (total > 0 && itemCnt >= int(total)) || len(items) == 0 || pageToken == nil || *pageToken == ""
+
+		if sampled != nil || len(pendingReview) > 0 {
+			items = gslice.Filter(items, func(item *entity.EvaluationSetItem) bool {
+				_, ok := sampled[item.ItemID]
+				return (sampled == nil || ok) && !pendingReview[item.ItemID]
+			})
+			if len(items) == 0 {
+				if scanDone {
+					break
+				}
+				continue
+			}
+		}
+		runItemCnt += len(items)
 
 		turnCnt := 0
 		for _, item := range items {
@@ -596,6 +635,9 @@ func (e *ExptSubmitExec) ExptStart(ctx context.Context, event *entity.ExptSchedu
 				ItemIdx:       itemIdx,
 				Status:        entity.ItemRunState_Queueing,
 			}
+			if stratum := sampled[item.ItemID]; stratum != "" {
+				eir.Ext = map[string]string{entity.ExptItemResultExtKeySamplingStratum: stratum}
+			}
 			eirs = append(eirs, eir)
 			itemIdx++
 			idIdx++
@@ -621,7 +663,7 @@ func (e *ExptSubmitExec) ExptStart(ctx context.Context, event *entity.ExptSchedu
 			return err
 		}
 
-		if (total > 0 && itemCnt >= int(total)) || len(items) == 0 || pageToken == nil || *pageToken == "" {
+		if scanDone {
 			break
 		}
 
@@ -636,7 +678,7 @@ func (e *ExptSubmitExec) ExptStart(ctx context.Context, event *entity.ExptSchedu
 		&entity.ExptStats{
 			ExptID:         event.ExptID,
 			SpaceID:        event.SpaceID,
-			PendingItemCnt: int32(itemCnt),
+			PendingItemCnt: int32(runItemCnt),
 		}); err != nil {
 		return err
 	}
@@ -683,6 +725,26 @@ func (e *ExptSubmitExec) ExptStart(ctx context.Context, event *entity.ExptSchedu
 	return nil
 }
 
+// sampleItems 实验配置了抽样时对评测集抽样并写入 experiment.sampling_result, 返回入选 item_id 到所属分层的映射; 未配置抽样返回 nil
+func (e *ExptSubmitExec) sampleItems(ctx context.Context, event *entity.ExptScheduleEvent, expt *entity.Experiment) (map[int64]string, error) {
+	if expt.EvalConf == nil || !expt.EvalConf.SamplingConf.IsEnabled() {
+		return nil, nil
+	}
+	sampled, res, err := sampleExptEvalSetItems(ctx, e.evaluationSetItemService, event, expt)
+	if err != nil {
+		return nil, err
+	}
+	bytes, err := json.Marshal(res)
+	if err != nil {
+		return nil, errorx.Wrapf(err, "marshal SamplingResult fail, expt_id: %v", event.ExptID)
+	}
+	if err := e.exptRepo.UpdateFields(ctx, event.ExptID, map[string]any{"sampling_result": &bytes}); err != nil {
+		return nil, err
+	}
+	expt.SamplingResult = res
+	return sampled, nil
+}
+
 func (e *ExptSubmitExec) createItemTurnResults(ctx context.Context, eirs []*entity.ExptItemResult, etrs []*entity.ExptTurnResult, session *entity.Session) error {
 	if err := e.exptTurnResultRepo.BatchCreateNX(ctx, etrs); err != nil {
 		return err
@@ -1252,6 +1314,7 @@ func (e *exptBaseExec) scanIncompleteAndComplete(ctx context.Context, event *ent
 			ItemID:           log.ItemID,
 			State:            entity.ItemRunState(log.Status),
 			UpdatedAt:        log.UpdatedAt,
+			Attempt:          log.Attempt,
 		}
 		if log.Status == int32(entity.ItemRunState_Processing) {
 			incomplete = append(incomplete, item)
@@ -1264,13 +1327,21 @@ func (e *exptBaseExec) scanIncompleteAndComplete(ctx context.Context, event *ent
 }
 
 func (e *exptBaseExec) getItemConcurNum(ctx context.Context, expt *entity.Experiment) int {
-	maxItemConcurNum := e.configer.GetExptExecConf(ctx, expt.SpaceID).GetExptItemEvalConf().GetMaxItemConcurNum()
-	if val := gptr.Indirect(expt.EvalConf.ItemConcurNum); val > 0 && val <= maxItemConcurNum {
-		return val
+	concurNum, _ := getExptItemConcurNum(ctx, e.configer, expt)
+	return concurNum
+}
+
+// getExptItemConcurNum 实验配置的 item 并发, explicit 表示由实验显式指定
+func getExptItemConcurNum(ctx context.Context, configer component.IConfiger, expt *entity.Experiment) (concurNum int, explicit bool) {
+	maxItemConcurNum := configer.GetExptExecConf(ctx, expt.SpaceID).GetExptItemEvalConf().GetMaxItemConcurNum()
+	if expt.EvalConf != nil {
+		if val := gptr.Indirect(expt.EvalConf.ItemConcurNum); val > 0 && val <= maxItemConcurNum {
+			return val, true
+		}
 	}
-	concurNum := e.configer.GetExptExecConf(ctx, expt.SpaceID).GetExptItemEvalConf().GetConcurNum()
+	concurNum = configer.GetExptExecConf(ctx, expt.SpaceID).GetExptItemEvalConf().GetConcurNum()
 	logs.CtxInfo(ctx, "GetConcurNum, expt_id: %v, concur_num: %v", expt.ID, concurNum)
-	return concurNum
+	return concurNum, false
 }
 
 func (e *exptBaseExec) scanToSubmit(ctx context.Context, event *entity.ExptScheduleEvent, expt *entity.Experiment, limit int64) (items []*entity.ExptEvalItem, err error) {
@@ -1287,6 +1358,7 @@ func (e *exptBaseExec) scanToSubmit(ctx context.Context, event *entity.ExptSched
 			ItemID:           log.ItemID,
 			State:            entity.ItemRunState(log.Status),
 			UpdatedAt:        log.UpdatedAt,
+			Attempt:          log.Attempt,
 		})
 	}
 	return items, nil
@@ -1660,8 +1732,20 @@ func (e *ExptRetryAllExec) ExptStart(ctx context.Context, event *entity.ExptSche
 		itemCnt   = 0
 		total     = int64(0)
 		pageToken *string
+		sampled   map[int64]string
 	)
 
+	// 抽样实验仅重试入选 item, 抽样由 Seed 决定, 重新计算与启动时一致
+	if expt.EvalConf != nil && expt.EvalConf.SamplingConf.IsEnabled() {
+		if sampled, _, err = sampleExptEvalSetItems(ctx, e.evaluationSetItemService, event, expt); err != nil {
+			return err
+		}
+	}
+	pendingReview, err := loadPendingReviewItemIDs(ctx, e.evaluationSetItemService, resolveLoadSpaceID(event.SpaceID, expt.EvalSetSpaceID), evalSetID, evalSetVersionID)
+	if err != nil {
+		return err
+	}
+
 	for i := 0; i < maxLoop; i++ {
 		logs.CtxInfo(ctx, "ExptRetryAllExec.ExptStart scan item, expt_id: %v, expt_run_id: %v, eval_set_id: %v, eval_set_ver_id: %v, page_token: %v, limit: %v, cur_cnt: %v, total: %v",
 			event.ExptID, event.ExptRunID, evalSetID, evalSetVersionID, gptr.Indirect(pageToken), pageSize, itemCnt, total)
@@ -1686,6 +1770,20 @@ func (e *ExptRetryAllExec) ExptStart(ctx context.Context, event *entity.ExptSche
 		itemCnt += len(items)
 		pageToken = nextPageToken
 		total = gptr.Indirect(t)
+		scanDone := (total > 0 && itemCnt >= int(total)) || len(items) == 0 || pageToken == nil || *pageToken == ""
+
+		if sampled != nil || len(pendingReview) > 0 {
+			items = gslice.Filter(items, func(item *entity.EvaluationSetItem) bool {
+				_, ok := sampled[item.ItemID]
+				return (sampled == nil || ok) && !pendingReview[item.ItemID]
+			})
+			if len(items) == 0 {
+				if scanDone {
+					break
+				}
+				continue
+			}
+		}
 
 		turnCnt := 0
 		for _, item := range items {
@@ -1760,7 +1858,7 @@ func (e *ExptRetryAllExec) ExptStart(ctx context.Context, event *entity.ExptSche
 			}
 		}
 
-		if (total > 0 && itemCnt >= int(total)) || len(items) == 0 || pageToken == nil || *pageToken == "" {
+		if scanDone {
 			break
 		}
 
@@ -2394,9 +2492,14 @@ func (e *ExptSubmitExec) exptStartMultiSet(ctx context.Context, event *entity.Ex
 		// 草稿哨兵: 草稿集读侧走 live (VersionID=nil), ref 落 0; committed 走 ByVersion 冻结。
 		setReadVersionID := resolveSetReadVersionID(setConf.EvalSetID, setConf.EvalSetVersionID)
 		setRefVersionID := resolveSetRefVersionID(setConf.EvalSetID, setConf.EvalSetVersionID)
+		pendingReview, err := loadPendingReviewItemIDs(ctx, e.evaluationSetItemService, resolveLoadSpaceID(event.SpaceID, setConf.SourceSpaceID), setConf.EvalSetID, setConf.EvalSetVersionID)
+		if err != nil {
+			return err
+		}
 
 		// 每批 item → 建 expt_item_ref / item_result / turn_result 并落库 (List 分页 / BatchGet 点选共用)
 		persistBatch := func(items []*entity.EvaluationSetItem) error {
+			items = excludePendingReviewItems(items, pendingReview)
 			if len(items) == 0 {
 				return nil
 			}
@@ -2593,6 +2696,29 @@ func resolveSetReadVersionID(evalSetID, evalSetVersionID int64) *int64 {
 	return &evalSetVersionID
 }
 
+// loadPendingReviewItemIDs 草稿评测集中待审核的生成行, 审核通过前不参与实验;
+// committed 版本创建时已拒绝待审核行, 无需过滤, 返回 nil。
+func loadPendingReviewItemIDs(ctx context.Context, itemService EvaluationSetItemService, spaceID, evalSetID, evalSetVersionID int64) (map[int64]bool, error) {
+	if !isDraftEvalSet(evalSetID, evalSetVersionID) {
+		return nil, nil
+	}
+	itemIDs, err := itemService.ListPendingReviewItemIDs(ctx, spaceID, evalSetID)
+	if err != nil {
+		return nil, err
+	}
+	if len(itemIDs) == 0 {
+		return nil, nil
+	}
+	return gslice.ToMap(itemIDs, func(id int64) (int64, bool) { return id, true }), nil
+}
+
+func excludePendingReviewItems(items []*entity.EvaluationSetItem, pendingReview map[int64]bool) []*entity.EvaluationSetItem {
+	if len(pendingReview) == 0 {
+		return items
+	}
+	return gslice.Filter(items, func(item *entity.EvaluationSetItem) bool { return !pendingReview[item.ItemID] })
+}
+
 // resolveLoadSpaceID 跨空间共享: 执行期加载评测集 item 用的空间。
 // sourceSpaceID>0 (发起冻结的来源空间) 用来源空间; 否则 (同空间/老数据) fallback 消费方空间。
 func resolveLoadSpaceID(consumerSpaceID, sourceSpaceID int64) int64 {
@@ -385,6 +385,48 @@ func TestExptSubmitExec_ExptStart(t *testing.T) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			name: "草稿评测集跳过待审核的生成行",
+			args: args{
+				ctx: session.WithCtxUser(context.Background(), &session.User{ID: testUserID}),
+				event: &entity.ExptScheduleEvent{
+					ExptID:      1,
+					ExptRunID:   2,
+					SpaceID:     3,
+					ExptRunMode: 1,
+					Session:     &entity.Session{UserID: testUserID},
+				},
+				expt: mockExpt,
+			},
+			prepareMock: func(f *fields, ctrl *gomock.Controller, args args) {
+				f.idem.EXPECT().Exist(gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
+				f.exptRepo.EXPECT().GetByID(gomock.Any(), args.event.ExptID, args.event.SpaceID).Return(args.expt, nil).Times(1)
+				f.evaluationSetItemService.EXPECT().ListPendingReviewItemIDs(gomock.Any(), int64(3), int64(1)).Return([]int64{2}, nil).Times(1)
+				f.evaluationSetItemService.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).Return([]*entity.EvaluationSetItem{
+					{ItemID: 1, Turns: []*entity.Turn{{ID: 1}}},
+					{ItemID: 2, Turns: []*entity.Turn{{ID: 2}}},
+				}, ptr.Of(int64(2)), ptr.Of(int64(2)), nil, nil).Times(1)
+				f.idgenerator.EXPECT().GenMultiIDs(gomock.Any(), 2).Return([]int64{1, 2}, nil).Times(1)
+				f.exptTurnResultRepo.EXPECT().BatchCreateNX(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				f.exptItemResultRepo.EXPECT().BatchCreateNX(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, itemResults []*entity.ExptItemResult) error {
+						assert.Len(t, itemResults, 1)
+						assert.Equal(t, int64(1), itemResults[0].ItemID)
+						return nil
+					}).Times(1)
+				f.idgenerator.EXPECT().GenMultiIDs(gomock.Any(), gomock.Any()).Return([]int64{5}, nil).Times(1)
+				f.exptItemResultRepo.EXPECT().BatchCreateNXRunLogs(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				f.exptStatsRepo.EXPECT().UpdateByExptID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				f.exptRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				f.configer.EXPECT().GetExptExecConf(gomock.Any(), gomock.Any()).Return(&entity.ExptExecConf{ZombieIntervalSecond: 1}).Times(1)
+				f.idem.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				f.resultSvc.EXPECT().UpsertExptTurnResultFilter(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			wantErr: false,
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
 		{
 			name: "idem已存在",
 			args: args{
@@ -454,6 +496,8 @@ func TestExptSubmitExec_ExptStart(t *testing.T) {
 			if tt.prepareMock != nil {
 				tt.prepareMock(f, ctrl, tt.args)
 			}
+			// 草稿评测集无待审核的生成行
+			f.evaluationSetItemService.EXPECT().ListPendingReviewItemIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
 			e := &ExptSubmitExec{
 				manager:                  f.manager,
@@ -2799,6 +2843,8 @@ func TestExptSubmitExec_ExptStart_error_scenarios(t *testing.T) {
 			}
 
 			tt.prepareMock(f, ctrl, tt.args)
+			// 草稿评测集无待审核的生成行
+			f.evaluationSetItemService.EXPECT().ListPendingReviewItemIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
 			e := &ExptSubmitExec{
 				manager:                  f.manager,
@@ -3182,7 +3228,7 @@ type exptRetryItemsExecFields struct {
 }
 
 func buildRetryAllExecFields(ctrl *gomock.Controller) *exptRetryAllExecFields {
-	return &exptRetryAllExecFields{
+	f := &exptRetryAllExecFields{
 		manager:                  svcmocks.NewMockIExptManager(ctrl),
 		exptItemResultRepo:       mock_repo.NewMockIExptItemResultRepo(ctrl),
 		exptStatsRepo:            mock_repo.NewMockIExptStatsRepo(ctrl),
@@ -3197,6 +3243,9 @@ func buildRetryAllExecFields(ctrl *gomock.Controller) *exptRetryAllExecFields {
 		templateManager:          svcmocks.NewMockIExptTemplateManager(ctrl),
 		exptItemRefRepo:          mock_repo.NewMockIExptItemRefRepo(ctrl),
 	}
+	// 草稿评测集无待审核的生成行
+	f.evaluationSetItemService.EXPECT().ListPendingReviewItemIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	return f
 }
 
 func buildRetryItemsExecFields(ctrl *gomock.Controller) *exptRetryItemsExecFields {
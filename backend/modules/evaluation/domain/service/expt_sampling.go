@@ -0,0 +1,17 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination ./mocks/expt_sampling.go --package mocks . ExptSamplingService
+type ExptSamplingService interface {
+	// GetSampledAggrResult 按实验抽样结果中的分层权重估计各评估器在全量评测集上的平均得分及置信区间。
+	// 多 turn item 先取 turn 均分作为 item 得分; confidence 非法时取默认 0.95。
+	GetSampledAggrResult(ctx context.Context, spaceID, exptID int64, confidence float64) (*entity.ExptSampledAggrResult, error)
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/infra/backoff"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/stats"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+type ExptSamplingServiceImpl struct {
+	experimentRepo         repo.IExperimentRepo
+	exptItemResultRepo     repo.IExptItemResultRepo
+	exptTurnResultRepo     repo.IExptTurnResultRepo
+	evaluatorRecordService EvaluatorRecordService
+}
+
+func NewExptSamplingService(
+	experimentRepo repo.IExperimentRepo,
+	exptItemResultRepo repo.IExptItemResultRepo,
+	exptTurnResultRepo repo.IExptTurnResultRepo,
+	evaluatorRecordService EvaluatorRecordService,
+) ExptSamplingService {
+	return &ExptSamplingServiceImpl{
+		experimentRepo:         experimentRepo,
+		exptItemResultRepo:     exptItemResultRepo,
+		exptTurnResultRepo:     exptTurnResultRepo,
+		evaluatorRecordService: evaluatorRecordService,
+	}
+}
+
+func (e *ExptSamplingServiceImpl) GetSampledAggrResult(ctx context.Context, spaceID, exptID int64, confidence float64) (*entity.ExptSampledAggrResult, error) {
+	expt, err := e.experimentRepo.GetByID(ctx, exptID, spaceID)
+	if err != nil {
+		return nil, err
+	}
+	if expt.SamplingResult == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("experiment %d has no sampling result", exptID)))
+	}
+	if confidence <= 0 || confidence >= 1 {
+		confidence = entity.DefaultExptSamplingConfidence
+	}
+
+	itemStrata, err := e.scanItemStrata(ctx, spaceID, exptID)
+	if err != nil {
+		return nil, err
+	}
+	scores, err := loadExptTurnScores(ctx, e.exptTurnResultRepo, e.evaluatorRecordService, spaceID, exptID)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceKeys := make([]string, 0, len(scores))
+	for key := range scores {
+		instanceKeys = append(instanceKeys, key)
+	}
+	sort.Strings(instanceKeys)
+
+	res := &entity.ExptSampledAggrResult{
+		ExptID:           exptID,
+		Sampling:         expt.SamplingResult,
+		Confidence:       confidence,
+		EvaluatorResults: make([]*entity.ExptSampledEvaluatorAggr, 0, len(instanceKeys)),
+	}
+	for _, key := range instanceKeys {
+		versionID, alias, err := entity.ParseEvaluatorScoreFieldKey(key)
+		if err != nil {
+			continue
+		}
+		res.EvaluatorResults = append(res.EvaluatorResults,
+			estimateSampledEvaluatorAggr(versionID, alias, scores[key], itemStrata, expt.SamplingResult, confidence))
+	}
+
+	logs.CtxInfo(ctx, "GetSampledAggrResult done, space_id: %v, expt_id: %v, evaluator_cnt: %v", spaceID, exptID, len(res.EvaluatorResults))
+	return res, nil
+}
+
+// scanItemStrata 游标扫描实验下全部 item 结果, 返回 item_id 到所属分层的映射
+func (e *ExptSamplingServiceImpl) scanItemStrata(ctx context.Context, spaceID, exptID int64) (map[int64]string, error) {
+	const scanLimit = int64(500)
+
+	res := make(map[int64]string)
+	for cursor := int64(0); ; {
+		itemResults, ncursor, err := e.exptItemResultRepo.ScanItemResults(ctx, exptID, cursor, scanLimit, nil, spaceID)
+		if err != nil {
+			return nil, err
+		}
+		for _, ir := range itemResults {
+			res[ir.ItemID] = ir.Ext[entity.ExptItemResultExtKeySamplingStratum]
+		}
+		if len(itemResults) == 0 || ncursor == cursor {
+			break
+		}
+		cursor = ncursor
+	}
+	return res, nil
+}
+
+// estimateSampledEvaluatorAggr 先将 turn 得分按 item 取均值, 再按 item 所属分层做分层估计;
+// 抽样结果中不存在的分层 (如实验启动后评测集变更) 的 item 不参与估计。
+func estimateSampledEvaluatorAggr(versionID int64, alias string, turnScores map[entity.ItemTurnID]float64,
+	itemStrata map[int64]string, sampling *entity.ExptSamplingResult, confidence float64,
+) *entity.ExptSampledEvaluatorAggr {
+	itemSums := make(map[int64]float64)
+	itemCnts := make(map[int64]int)
+	for itemTurn, score := range turnScores {
+		itemSums[itemTurn.ItemID] += score
+		itemCnts[itemTurn.ItemID]++
+	}
+	itemIDs := make([]int64, 0, len(itemSums))
+	for itemID := range itemSums {
+		itemIDs = append(itemIDs, itemID)
+	}
+	sort.Slice(itemIDs, func(i, j int) bool { return itemIDs[i] < itemIDs[j] })
+
+	strataValues := make(map[string][]float64, len(sampling.Strata))
+	allValues := make([]float64, 0, len(itemIDs))
+	for _, itemID := range itemIDs {
+		value, ok := itemStrata[itemID]
+		if !ok || sampling.GetStratum(value) == nil {
+			continue
+		}
+		score := itemSums[itemID] / float64(itemCnts[itemID])
+		strataValues[value] = append(strataValues[value], score)
+		allValues = append(allValues, score)
+	}
+
+	strata := make([]stats.Stratum, 0, len(sampling.Strata))
+	for _, s := range sampling.Strata {
+		strata = append(strata, stats.Stratum{Population: s.PopulationCnt, Values: strataValues[s.Value]})
+	}
+	mean, stdErr := stats.StratifiedMean(strata)
+	lower, upper := stats.NormalCI(mean, stdErr, confidence)
+	return &entity.ExptSampledEvaluatorAggr{
+		EvaluatorVersionID: versionID,
+		Alias:              alias,
+		ScoredCnt:          int64(len(allValues)),
+		UnweightedMean:     stats.Mean(allValues),
+		Mean:               mean,
+		StdErr:             stdErr,
+		CILower:            lower,
+		CIUpper:            upper,
+	}
+}
+
+// sampleExptEvalSetItems 扫描单评测集实验的全部 item 并按实验抽样配置抽样, 返回入选 item_id 到所属分层的映射。
+// 抽样仅依赖 Seed 与 item_id, 实验启动与全量重试时重复计算结果一致。
+func sampleExptEvalSetItems(ctx context.Context, itemService EvaluationSetItemService, event *entity.ExptScheduleEvent, expt *entity.Experiment,
+) (map[int64]string, *entity.ExptSamplingResult, error) {
+	conf := expt.EvalConf.SamplingConf
+
+	var (
+		evalSetID        = expt.EvalSet.ID
+		evalSetVersionID = expt.EvalSet.EvaluationSetVersion.ID
+
+		maxLoop    = 10000
+		pageSize   = int32(100)
+		total      = int64(0)
+		pageToken  *string
+		scanned    = 0
+		candidates []*entity.ExptSamplingCandidate
+	)
+	// 待审核的生成行不参与实验, 也不计入抽样总体
+	pendingReview, err := loadPendingReviewItemIDs(ctx, itemService, resolveLoadSpaceID(event.SpaceID, expt.EvalSetSpaceID), evalSetID, evalSetVersionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := 0; i < maxLoop; i++ {
+		var items []*entity.EvaluationSetItem
+		var t *int64
+		var nextPageToken *string
+		if err := backoff.RetryThreeSeconds(ctx, func() error {
+			var retryErr error
+			items, t, _, nextPageToken, retryErr = itemService.ListEvaluationSetItems(ctx, &entity.ListEvaluationSetItemsParam{
+				SpaceID:         resolveLoadSpaceID(event.SpaceID, expt.EvalSetSpaceID),
+				EvaluationSetID: evalSetID,
+				VersionID:       resolveSetReadVersionID(evalSetID, evalSetVersionID),
+				PageSize:        &pageSize,
+				PageToken:       pageToken,
+			})
+			return retryErr
+		}); err != nil {
+			return nil, nil, err
+		}
+
+		pageToken = nextPageToken
+		total = gptr.Indirect(t)
+		scanned += len(items)
+		for _, item := range excludePendingReviewItems(items, pendingReview) {
+			cand := &entity.ExptSamplingCandidate{ItemID: item.ItemID}
+			if conf.Mode == entity.ExptSamplingModeStratified && len(item.Turns) > 0 {
+				cand.Stratum, _ = getFieldTextValue(conf.StratifyField, item, item.Turns[0])
+			}
+			candidates = append(candidates, cand)
+		}
+
+		if (total > 0 && scanned >= int(total)) || len(items) == 0 || pageToken == nil || *pageToken == "" {
+			break
+		}
+	}
+
+	selected, res, err := conf.Sample(candidates)
+	if err != nil {
+		return nil, nil, errorx.NewByCode(errno.ExperimentValidateFailCode, errorx.WithExtraMsg(err.Error()))
+	}
+	logs.CtxInfo(ctx, "sample expt eval set items done, expt_id: %v, mode: %v, seed: %v, population: %v, sample: %v, strata: %v",
+		expt.ID, res.Mode, res.Seed, res.PopulationCnt, res.SampleCnt, len(res.Strata))
+	return selected, res, nil
+}
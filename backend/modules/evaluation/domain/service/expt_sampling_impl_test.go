@@ -0,0 +1,130 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repoMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+func TestExptSamplingServiceImpl_GetSampledAggrResult(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	exptRepo := repoMocks.NewMockIExperimentRepo(ctrl)
+	itemRepo := repoMocks.NewMockIExptItemResultRepo(ctrl)
+	turnRepo := repoMocks.NewMockIExptTurnResultRepo(ctrl)
+	recordSvc := svcMocks.NewMockEvaluatorRecordService(ctrl)
+	svc := NewExptSamplingService(exptRepo, itemRepo, turnRepo, recordSvc)
+
+	const spaceID, exptID, evaluatorVersionID = int64(100), int64(1), int64(11)
+	sampling := &entity.ExptSamplingResult{
+		Mode:          entity.ExptSamplingModeStratified,
+		StratifyField: "category",
+		PopulationCnt: 100,
+		SampleCnt:     4,
+		Strata: []*entity.ExptSamplingStratum{
+			{Value: "a", PopulationCnt: 80, SampleCnt: 2, Weight: 40},
+			{Value: "b", PopulationCnt: 20, SampleCnt: 2, Weight: 10},
+		},
+	}
+	exptRepo.EXPECT().GetByID(gomock.Any(), exptID, spaceID).Return(&entity.Experiment{ID: exptID, SpaceID: spaceID, SamplingResult: sampling}, nil)
+	itemResults := []*entity.ExptItemResult{
+		{ItemID: 1, Ext: map[string]string{entity.ExptItemResultExtKeySamplingStratum: "a"}},
+		{ItemID: 2, Ext: map[string]string{entity.ExptItemResultExtKeySamplingStratum: "a"}},
+		{ItemID: 3, Ext: map[string]string{entity.ExptItemResultExtKeySamplingStratum: "b"}},
+		{ItemID: 4, Ext: map[string]string{entity.ExptItemResultExtKeySamplingStratum: "b"}},
+	}
+	itemRepo.EXPECT().ScanItemResults(gomock.Any(), exptID, int64(0), int64(500), gomock.Nil(), spaceID).Return(itemResults, int64(4), nil)
+	itemRepo.EXPECT().ScanItemResults(gomock.Any(), exptID, int64(4), int64(500), gomock.Nil(), spaceID).Return(nil, int64(4), nil)
+	mockExptTurnScores(turnRepo, recordSvc, spaceID, exptID, evaluatorVersionID, []float64{1, 0.5, 0, 0})
+
+	res, err := svc.GetSampledAggrResult(context.Background(), spaceID, exptID, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.DefaultExptSamplingConfidence, res.Confidence)
+	assert.Equal(t, sampling, res.Sampling)
+	assert.Len(t, res.EvaluatorResults, 1)
+
+	aggr := res.EvaluatorResults[0]
+	assert.Equal(t, evaluatorVersionID, aggr.EvaluatorVersionID)
+	assert.Equal(t, int64(4), aggr.ScoredCnt)
+	assert.InDelta(t, 0.375, aggr.UnweightedMean, 1e-9)
+	// 0.8 * 0.75 + 0.2 * 0
+	assert.InDelta(t, 0.6, aggr.Mean, 1e-9)
+	// sqrt(0.8² * (1 - 2/80) * 0.125 / 2)
+	assert.InDelta(t, 0.19748, aggr.StdErr, 1e-4)
+	assert.True(t, aggr.CILower < aggr.Mean && aggr.Mean < aggr.CIUpper)
+}
+
+func TestExptSamplingServiceImpl_GetSampledAggrResult_NotSampled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	exptRepo := repoMocks.NewMockIExperimentRepo(ctrl)
+	svc := NewExptSamplingService(exptRepo, repoMocks.NewMockIExptItemResultRepo(ctrl), repoMocks.NewMockIExptTurnResultRepo(ctrl), svcMocks.NewMockEvaluatorRecordService(ctrl))
+
+	exptRepo.EXPECT().GetByID(gomock.Any(), int64(1), int64(100)).Return(&entity.Experiment{ID: 1, SpaceID: 100}, nil)
+	_, err := svc.GetSampledAggrResult(context.Background(), 100, 1, 0.9)
+	assert.Error(t, err)
+}
+
+func TestExptSubmitExec_sampleItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemSvc := svcMocks.NewMockEvaluationSetItemService(ctrl)
+	exptRepo := repoMocks.NewMockIExperimentRepo(ctrl)
+	e := &ExptSubmitExec{evaluationSetItemService: itemSvc, exptRepo: exptRepo}
+
+	event := &entity.ExptScheduleEvent{ExptID: 1, ExptRunID: 2, SpaceID: 3}
+	newItem := func(itemID int64, category string) *entity.EvaluationSetItem {
+		return &entity.EvaluationSetItem{ItemID: itemID, Turns: []*entity.Turn{{ID: 1, FieldDataList: []*entity.FieldData{
+			{Name: "category", Content: &entity.Content{Text: gptr.Of(category)}},
+		}}}}
+	}
+
+	t.Run("not sampled", func(t *testing.T) {
+		sampled, err := e.sampleItems(context.Background(), event, &entity.Experiment{ID: 1, EvalConf: &entity.EvaluationConfiguration{}})
+		assert.NoError(t, err)
+		assert.Nil(t, sampled)
+	})
+
+	t.Run("stratified", func(t *testing.T) {
+		expt := &entity.Experiment{
+			ID:      1,
+			SpaceID: 3,
+			EvalSet: &entity.EvaluationSet{ID: 5, EvaluationSetVersion: &entity.EvaluationSetVersion{ID: 6}},
+			EvalConf: &entity.EvaluationConfiguration{SamplingConf: &entity.ExptSamplingConf{
+				Mode: entity.ExptSamplingModeStratified, StratifyField: "category", SampleNum: 2, Seed: 9,
+			}},
+		}
+		itemSvc.EXPECT().ListEvaluationSetItems(gomock.Any(), gomock.Any()).Return(
+			[]*entity.EvaluationSetItem{newItem(1, "x"), newItem(2, "x"), newItem(3, "y"), newItem(4, "y")},
+			gptr.Of(int64(4)), nil, nil, nil)
+		exptRepo.EXPECT().UpdateFields(gomock.Any(), int64(1), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ int64, ufields map[string]any) error {
+				assert.Contains(t, ufields, "sampling_result")
+				return nil
+			})
+
+		sampled, err := e.sampleItems(context.Background(), event, expt)
+		assert.NoError(t, err)
+		assert.Len(t, sampled, 2)
+		strata := map[string]int{}
+		for _, stratum := range sampled {
+			strata[stratum]++
+		}
+		assert.Equal(t, map[string]int{"x": 1, "y": 1}, strata)
+		assert.Equal(t, int64(4), expt.SamplingResult.PopulationCnt)
+		assert.Equal(t, int64(9), expt.SamplingResult.Seed)
+	})
+}
@@ -0,0 +1,17 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination ./mocks/expt_significance.go --package mocks . ExptSignificanceService
+type ExptSignificanceService interface {
+	// CompareExperiments 以基线实验为参照, 按 (item_id, turn_id) 配对候选实验的评估器得分,
+	// 给出均值差、bootstrap 置信区间、配对 t 检验 / Wilcoxon p 值以及胜平负计数。
+	CompareExperiments(ctx context.Context, param *entity.ExptSignificanceParam) (*entity.ExptSignificanceResult, error)
+}
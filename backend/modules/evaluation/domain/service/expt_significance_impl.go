@@ -0,0 +1,277 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/bytedance/gg/gslice"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/stats"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+type ExptSignificanceServiceImpl struct {
+	experimentRepo         repo.IExperimentRepo
+	exptTurnResultRepo     repo.IExptTurnResultRepo
+	evaluatorRecordService EvaluatorRecordService
+}
+
+func NewExptSignificanceService(
+	experimentRepo repo.IExperimentRepo,
+	exptTurnResultRepo repo.IExptTurnResultRepo,
+	evaluatorRecordService EvaluatorRecordService,
+) ExptSignificanceService {
+	return &ExptSignificanceServiceImpl{
+		experimentRepo:         experimentRepo,
+		exptTurnResultRepo:     exptTurnResultRepo,
+		evaluatorRecordService: evaluatorRecordService,
+	}
+}
+
+func (e *ExptSignificanceServiceImpl) CompareExperiments(ctx context.Context, param *entity.ExptSignificanceParam) (*entity.ExptSignificanceResult, error) {
+	if err := e.validateParam(param); err != nil {
+		return nil, err
+	}
+
+	exptIDs := append([]int64{param.BaseExptID}, param.CandidateExptIDs...)
+	expts, err := e.experimentRepo.MGetByID(ctx, exptIDs, param.SpaceID)
+	if err != nil {
+		return nil, err
+	}
+	exptMap := gslice.ToMap(expts, func(expt *entity.Experiment) (int64, *entity.Experiment) { return expt.ID, expt })
+	base, ok := exptMap[param.BaseExptID]
+	if !ok {
+		return nil, errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("base experiment %d not found", param.BaseExptID)))
+	}
+	for _, candidateID := range param.CandidateExptIDs {
+		candidate, ok := exptMap[candidateID]
+		if !ok {
+			return nil, errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("candidate experiment %d not found", candidateID)))
+		}
+		// 配对依赖 item_id 对齐, 只允许同一评测集下的实验相互比较 (版本可不同, item_id 跨版本稳定)
+		if base.EvalSetID > 0 && candidate.EvalSetID > 0 && base.EvalSetID != candidate.EvalSetID {
+			return nil, errorx.NewByCode(errno.CommonInvalidParamCode,
+				errorx.WithExtraMsg(fmt.Sprintf("experiment %d uses eval set %d, different from base eval set %d", candidateID, candidate.EvalSetID, base.EvalSetID)))
+		}
+	}
+
+	baseScores, err := loadExptTurnScores(ctx, e.exptTurnResultRepo, e.evaluatorRecordService, param.SpaceID, param.BaseExptID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &entity.ExptSignificanceResult{
+		BaseExptID:  param.BaseExptID,
+		Comparisons: make([]*entity.ExptPairSignificance, 0, len(param.CandidateExptIDs)),
+	}
+	for _, candidateID := range param.CandidateExptIDs {
+		candidateScores, err := loadExptTurnScores(ctx, e.exptTurnResultRepo, e.evaluatorRecordService, param.SpaceID, candidateID)
+		if err != nil {
+			return nil, err
+		}
+		// 每个候选实验使用独立的、由 Seed 派生的随机源, 保证候选实验顺序不影响结果
+		rng := rand.New(rand.NewSource(param.Seed + candidateID))
+		res.Comparisons = append(res.Comparisons, &entity.ExptPairSignificance{
+			CandidateExptID:  candidateID,
+			EvaluatorResults: compareExptTurnScores(baseScores, candidateScores, param, rng),
+		})
+	}
+
+	logs.CtxInfo(ctx, "CompareExperiments done, space_id: %v, base_expt_id: %v, candidate_expt_ids: %v", param.SpaceID, param.BaseExptID, param.CandidateExptIDs)
+	return res, nil
+}
+
+func (e *ExptSignificanceServiceImpl) validateParam(param *entity.ExptSignificanceParam) error {
+	if param == nil || param.SpaceID <= 0 || param.BaseExptID <= 0 {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("space_id and base_expt_id are required"))
+	}
+	if len(param.CandidateExptIDs) == 0 || len(param.CandidateExptIDs) > entity.MaxSignificanceCandidateExptCnt {
+		return errorx.NewByCode(errno.CommonInvalidParamCode,
+			errorx.WithExtraMsg(fmt.Sprintf("candidate experiment count must be in [1, %d]", entity.MaxSignificanceCandidateExptCnt)))
+	}
+	seen := map[int64]bool{param.BaseExptID: true}
+	for _, id := range param.CandidateExptIDs {
+		if id <= 0 || seen[id] {
+			return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("invalid or duplicated candidate experiment id %d", id)))
+		}
+		seen[id] = true
+	}
+	if param.TieThreshold < 0 {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("tie threshold must not be negative"))
+	}
+	return nil
+}
+
+// exptTurnScores 实验 turn 粒度的评估器得分, key 为评估器实例 key (entity.EncodeEvaluatorInstanceKey)
+type exptTurnScores map[string]map[entity.ItemTurnID]float64
+
+// loadExptTurnScores 加载实验下所有 turn 的 Builtin 评估器成功得分 (有人工修正时取修正分, 口径与聚合一致)。
+// Inline 评估结果无稳定的跨实验标识, 不参与配对。
+func loadExptTurnScores(ctx context.Context, turnResultRepo repo.IExptTurnResultRepo, recordService EvaluatorRecordService,
+	spaceID, exptID int64,
+) (exptTurnScores, error) {
+	turnResults, err := scanExptTurnResults(ctx, turnResultRepo, spaceID, exptID)
+	if err != nil {
+		return nil, err
+	}
+	return loadExptTurnScoresByTurns(ctx, turnResultRepo, recordService, spaceID, exptID, turnResults)
+}
+
+// scanExptTurnResults 游标扫描实验下全部 turn 结果
+func scanExptTurnResults(ctx context.Context, turnResultRepo repo.IExptTurnResultRepo, spaceID, exptID int64) ([]*entity.ExptTurnResult, error) {
+	const scanLimit = int64(500)
+
+	var res []*entity.ExptTurnResult
+	for cursor := int64(0); ; {
+		turnResults, ncursor, err := turnResultRepo.ScanTurnResults(ctx, exptID, nil, cursor, scanLimit, spaceID)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, turnResults...)
+		if len(turnResults) == 0 || ncursor == cursor {
+			break
+		}
+		cursor = ncursor
+	}
+	return res, nil
+}
+
+// loadExptTurnScoresByTurns 同 loadExptTurnScores, turn 结果由调用方预先加载
+func loadExptTurnScoresByTurns(ctx context.Context, turnResultRepo repo.IExptTurnResultRepo, recordService EvaluatorRecordService,
+	spaceID, exptID int64, turnResults []*entity.ExptTurnResult,
+) (exptTurnScores, error) {
+	turnResultID2ItemTurn := make(map[int64]entity.ItemTurnID, len(turnResults))
+	for _, tr := range turnResults {
+		turnResultID2ItemTurn[tr.ID] = entity.ItemTurnID{ItemID: tr.ItemID, TurnID: tr.TurnID}
+	}
+
+	refs, err := turnResultRepo.GetTurnEvaluatorResultRefByExptID(ctx, spaceID, exptID)
+	if err != nil {
+		return nil, err
+	}
+	refs = gslice.Filter(refs, func(ref *entity.ExptTurnEvaluatorResultRef) bool {
+		return ref != nil && ref.SourceType != int32(entity.EvaluatorRecordSourceTypeInline)
+	})
+
+	recordScores := make(map[int64]float64, len(refs))
+	recordIDs := gslice.Map(refs, func(ref *entity.ExptTurnEvaluatorResultRef) int64 { return ref.EvaluatorResultID })
+	for _, chunk := range gslice.Chunk(recordIDs, 200) {
+		records, err := recordService.BatchGetEvaluatorRecordForAggr(ctx, chunk)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range records {
+			if record == nil || record.Status != entity.EvaluatorRunStatusSuccess || record.Score == nil {
+				continue
+			}
+			recordScores[record.ID] = *record.Score
+		}
+	}
+
+	scores := make(exptTurnScores)
+	for _, ref := range refs {
+		itemTurn, ok := turnResultID2ItemTurn[ref.ExptTurnResultID]
+		if !ok {
+			continue
+		}
+		score, ok := recordScores[ref.EvaluatorResultID]
+		if !ok {
+			continue
+		}
+		instanceKey := entity.EncodeEvaluatorInstanceKey(ref.EvaluatorVersionID, ref.Alias)
+		if scores[instanceKey] == nil {
+			scores[instanceKey] = make(map[entity.ItemTurnID]float64)
+		}
+		scores[instanceKey][itemTurn] = score
+	}
+	return scores, nil
+}
+
+// compareExptTurnScores 对两侧都存在的评估器实例逐一做配对统计, 结果按实例 key 排序保证输出稳定
+func compareExptTurnScores(base, candidate exptTurnScores, param *entity.ExptSignificanceParam, rng *rand.Rand) []*entity.EvaluatorSignificance {
+	instanceKeys := make([]string, 0, len(base))
+	for key := range base {
+		if _, ok := candidate[key]; ok {
+			instanceKeys = append(instanceKeys, key)
+		}
+	}
+	sort.Strings(instanceKeys)
+
+	confidence := param.GetConfidence()
+	iterations := param.GetBootstrapIterations()
+	res := make([]*entity.EvaluatorSignificance, 0, len(instanceKeys))
+	for _, key := range instanceKeys {
+		versionID, alias, err := entity.ParseEvaluatorScoreFieldKey(key)
+		if err != nil {
+			continue
+		}
+
+		baseTurns, candidateTurns := base[key], candidate[key]
+		itemTurns := make([]entity.ItemTurnID, 0, len(baseTurns))
+		for itemTurn := range baseTurns {
+			if _, ok := candidateTurns[itemTurn]; ok {
+				itemTurns = append(itemTurns, itemTurn)
+			}
+		}
+		sort.Slice(itemTurns, func(i, j int) bool {
+			if itemTurns[i].ItemID != itemTurns[j].ItemID {
+				return itemTurns[i].ItemID < itemTurns[j].ItemID
+			}
+			return itemTurns[i].TurnID < itemTurns[j].TurnID
+		})
+
+		baseVals := make([]float64, 0, len(itemTurns))
+		candidateVals := make([]float64, 0, len(itemTurns))
+		for _, itemTurn := range itemTurns {
+			baseVals = append(baseVals, baseTurns[itemTurn])
+			candidateVals = append(candidateVals, candidateTurns[itemTurn])
+		}
+
+		res = append(res, buildEvaluatorSignificance(versionID, alias, baseVals, candidateVals, param.TieThreshold, confidence, iterations, rng))
+	}
+	return res
+}
+
+func buildEvaluatorSignificance(versionID int64, alias string, baseVals, candidateVals []float64,
+	tieThreshold, confidence float64, iterations int, rng *rand.Rand,
+) *entity.EvaluatorSignificance {
+	diffs := stats.PairedDiffs(baseVals, candidateVals)
+	sig := &entity.EvaluatorSignificance{
+		EvaluatorVersionID: versionID,
+		Alias:              alias,
+		PairedCount:        int64(len(diffs)),
+		BaseMean:           stats.Mean(baseVals),
+		CandidateMean:      stats.Mean(candidateVals),
+		MeanDelta:          stats.Mean(diffs),
+	}
+	for _, d := range diffs {
+		switch {
+		case math.Abs(d) <= tieThreshold:
+			sig.TieCount++
+		case d > 0:
+			sig.WinCount++
+		default:
+			sig.LossCount++
+		}
+	}
+	if len(diffs) == 0 {
+		sig.TTestPValue, sig.WilcoxonPValue = 1, 1
+		return sig
+	}
+
+	_, sig.TTestPValue = stats.PairedTTest(diffs)
+	_, sig.WilcoxonPValue = stats.WilcoxonSignedRank(diffs)
+	sig.CILower, sig.CIUpper = stats.BootstrapMeanCI(diffs, iterations, confidence, rng)
+	sig.Significant = sig.TTestPValue < 1-confidence && (sig.CILower > 0 || sig.CIUpper < 0)
+	return sig
+}
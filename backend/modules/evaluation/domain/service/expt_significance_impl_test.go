@@ -0,0 +1,138 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repoMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+func mockExptTurnScores(turnRepo *repoMocks.MockIExptTurnResultRepo, recordSvc *svcMocks.MockEvaluatorRecordService,
+	spaceID, exptID, evaluatorVersionID int64, scores []float64,
+) {
+	turnResults := make([]*entity.ExptTurnResult, 0, len(scores))
+	refs := make([]*entity.ExptTurnEvaluatorResultRef, 0, len(scores))
+	records := make([]*entity.EvaluatorRecordAggr, 0, len(scores))
+	recordIDs := make([]int64, 0, len(scores))
+	for i, score := range scores {
+		turnResultID := exptID*1000 + int64(i)
+		recordID := exptID*10000 + int64(i)
+		turnResults = append(turnResults, &entity.ExptTurnResult{ID: turnResultID, ExptID: exptID, ItemID: int64(i + 1), TurnID: 1})
+		refs = append(refs, &entity.ExptTurnEvaluatorResultRef{ExptTurnResultID: turnResultID, EvaluatorVersionID: evaluatorVersionID, EvaluatorResultID: recordID})
+		records = append(records, &entity.EvaluatorRecordAggr{ID: recordID, Status: entity.EvaluatorRunStatusSuccess, Score: gptr.Of(score)})
+		recordIDs = append(recordIDs, recordID)
+	}
+	turnRepo.EXPECT().ScanTurnResults(gomock.Any(), exptID, gomock.Any(), int64(0), int64(500), spaceID).Return(turnResults, int64(len(scores)), nil)
+	turnRepo.EXPECT().ScanTurnResults(gomock.Any(), exptID, gomock.Any(), int64(len(scores)), int64(500), spaceID).Return(nil, int64(len(scores)), nil)
+	turnRepo.EXPECT().GetTurnEvaluatorResultRefByExptID(gomock.Any(), spaceID, exptID).Return(refs, nil)
+	recordSvc.EXPECT().BatchGetEvaluatorRecordForAggr(gomock.Any(), recordIDs).Return(records, nil)
+}
+
+func TestExptSignificanceServiceImpl_CompareExperiments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	exptRepo := repoMocks.NewMockIExperimentRepo(ctrl)
+	turnRepo := repoMocks.NewMockIExptTurnResultRepo(ctrl)
+	recordSvc := svcMocks.NewMockEvaluatorRecordService(ctrl)
+	svc := NewExptSignificanceService(exptRepo, turnRepo, recordSvc)
+
+	const spaceID, baseID, candidateID, evaluatorVersionID = int64(100), int64(1), int64(2), int64(11)
+	exptRepo.EXPECT().MGetByID(gomock.Any(), []int64{baseID, candidateID}, spaceID).Return([]*entity.Experiment{
+		{ID: baseID, SpaceID: spaceID, EvalSetID: 7},
+		{ID: candidateID, SpaceID: spaceID, EvalSetID: 7},
+	}, nil)
+	mockExptTurnScores(turnRepo, recordSvc, spaceID, baseID, evaluatorVersionID, []float64{0.5, 0.6, 0.4, 0.7, 0.5, 0.6, 0.5, 0.4})
+	mockExptTurnScores(turnRepo, recordSvc, spaceID, candidateID, evaluatorVersionID, []float64{0.7, 0.8, 0.6, 0.9, 0.6, 0.6, 0.8, 0.5})
+
+	res, err := svc.CompareExperiments(context.Background(), &entity.ExptSignificanceParam{
+		SpaceID:          spaceID,
+		BaseExptID:       baseID,
+		CandidateExptIDs: []int64{candidateID},
+		Seed:             1,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res.Comparisons, 1)
+	assert.Len(t, res.Comparisons[0].EvaluatorResults, 1)
+
+	sig := res.Comparisons[0].EvaluatorResults[0]
+	assert.Equal(t, evaluatorVersionID, sig.EvaluatorVersionID)
+	assert.Equal(t, int64(8), sig.PairedCount)
+	assert.InDelta(t, 0.1625, sig.MeanDelta, 1e-9)
+	assert.Equal(t, int64(7), sig.WinCount)
+	assert.Equal(t, int64(1), sig.TieCount)
+	assert.Equal(t, int64(0), sig.LossCount)
+	assert.True(t, sig.CILower > 0)
+	assert.True(t, sig.TTestPValue < 0.05)
+	assert.True(t, sig.Significant)
+}
+
+func TestExptSignificanceServiceImpl_CompareExperiments_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	exptRepo := repoMocks.NewMockIExperimentRepo(ctrl)
+	turnRepo := repoMocks.NewMockIExptTurnResultRepo(ctrl)
+	recordSvc := svcMocks.NewMockEvaluatorRecordService(ctrl)
+	svc := NewExptSignificanceService(exptRepo, turnRepo, recordSvc)
+
+	tests := []struct {
+		name  string
+		param *entity.ExptSignificanceParam
+		setup func()
+	}{
+		{name: "nil param", param: nil},
+		{name: "no candidate", param: &entity.ExptSignificanceParam{SpaceID: 1, BaseExptID: 1}},
+		{name: "candidate equals base", param: &entity.ExptSignificanceParam{SpaceID: 1, BaseExptID: 1, CandidateExptIDs: []int64{1}}},
+		{name: "negative tie threshold", param: &entity.ExptSignificanceParam{SpaceID: 1, BaseExptID: 1, CandidateExptIDs: []int64{2}, TieThreshold: -1}},
+		{
+			name:  "candidate not found",
+			param: &entity.ExptSignificanceParam{SpaceID: 1, BaseExptID: 1, CandidateExptIDs: []int64{2}},
+			setup: func() {
+				exptRepo.EXPECT().MGetByID(gomock.Any(), []int64{1, 2}, int64(1)).Return([]*entity.Experiment{{ID: 1}}, nil)
+			},
+		},
+		{
+			name:  "different eval set",
+			param: &entity.ExptSignificanceParam{SpaceID: 1, BaseExptID: 1, CandidateExptIDs: []int64{2}},
+			setup: func() {
+				exptRepo.EXPECT().MGetByID(gomock.Any(), []int64{1, 2}, int64(1)).Return([]*entity.Experiment{{ID: 1, EvalSetID: 3}, {ID: 2, EvalSetID: 4}}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+			_, err := svc.CompareExperiments(context.Background(), tt.param)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCompareExptTurnScores_OnlySharedEvaluators(t *testing.T) {
+	base := exptTurnScores{
+		"1": {{ItemID: 1, TurnID: 1}: 0.2, {ItemID: 2, TurnID: 1}: 0.4},
+		"2": {{ItemID: 1, TurnID: 1}: 1},
+	}
+	candidate := exptTurnScores{
+		"1": {{ItemID: 1, TurnID: 1}: 0.1, {ItemID: 3, TurnID: 1}: 0.9},
+		"3": {{ItemID: 1, TurnID: 1}: 1},
+	}
+	res := compareExptTurnScores(base, candidate, &entity.ExptSignificanceParam{}, nil)
+	assert.Len(t, res, 1)
+	assert.Equal(t, int64(1), res[0].EvaluatorVersionID)
+	assert.Equal(t, int64(1), res[0].PairedCount)
+	assert.Equal(t, int64(1), res[0].LossCount)
+	assert.False(t, res[0].Significant)
+}
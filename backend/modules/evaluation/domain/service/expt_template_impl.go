@@ -115,6 +115,9 @@ func (e *ExptTemplateManagerImpl) Create(ctx context.Context, param *entity.Crea
 			return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
 		}
 	}
+	if err := param.QualityGateConf.Validate(); err != nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
+	}
 
 	// 从 EvaluatorIDVersionItems 构建 evaluatorVersionRefs
 	evaluatorVersionRefs := e.buildEvaluatorVersionRefs(param.EvaluatorIDVersionItems)
@@ -165,6 +168,12 @@ func (e *ExptTemplateManagerImpl) Create(ctx context.Context, param *entity.Crea
 		ExptSource:       param.ExptSource,
 		NotificationConf: param.NotificationConf,
 	}
+	if !param.QualityGateConf.IsEmpty() {
+		if template.TemplateConf == nil {
+			template.TemplateConf = &entity.ExptTemplateConfiguration{}
+		}
+		template.TemplateConf.QualityGateConf = param.QualityGateConf
+	}
 	if param.Visibility != nil {
 		template.Meta.Visibility = *param.Visibility
 	}
@@ -316,6 +325,9 @@ func (e *ExptTemplateManagerImpl) Update(ctx context.Context, param *entity.Upda
 			return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
 		}
 	}
+	if err := param.QualityGateConf.Validate(); err != nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
+	}
 
 	// 从 EvaluatorIDVersionItems 构建 evaluatorVersionRefs
 	evaluatorVersionRefs := e.buildEvaluatorVersionRefs(param.EvaluatorIDVersionItems)
@@ -502,6 +514,19 @@ func (e *ExptTemplateManagerImpl) Update(ctx context.Context, param *entity.Upda
 		updatedTemplate.TemplateConf.ExptSource = param.ExptSource
 	}
 
+	// 质量门禁同 expt_source: 未传时保留已有规则, 显式传入 (含空规则) 时覆盖
+	if param.QualityGateConf != nil {
+		if updatedTemplate.TemplateConf == nil {
+			updatedTemplate.TemplateConf = &entity.ExptTemplateConfiguration{}
+		}
+		updatedTemplate.TemplateConf.QualityGateConf = param.QualityGateConf
+		if param.QualityGateConf.IsEmpty() {
+			updatedTemplate.TemplateConf.QualityGateConf = nil
+		}
+	} else if updatedTemplate.TemplateConf != nil && updatedTemplate.TemplateConf.QualityGateConf == nil && existingTemplate.TemplateConf != nil {
+		updatedTemplate.TemplateConf.QualityGateConf = existingTemplate.TemplateConf.QualityGateConf
+	}
+
 	// 从 TemplateConf 构建 FieldMappingConfig，并根据 EvaluatorConf.ScoreWeight 设置是否启用分数权重
 	e.buildFieldMappingConfigAndEnableScoreWeight(updatedTemplate, updatedTemplate.TemplateConf)
 
@@ -1965,6 +1990,7 @@ func (e *ExptTemplateManagerImpl) buildFieldMappingConfigAndEnableScoreWeight(te
 					FieldName:     fc.FieldName,
 					FromFieldName: fc.FromField,
 					ConstValue:    fc.Value,
+					Expr:          fc.Expr,
 				})
 			}
 		}
@@ -2000,6 +2026,7 @@ func (e *ExptTemplateManagerImpl) buildFieldMappingConfigAndEnableScoreWeight(te
 						FieldName:     fc.FieldName,
 						FromFieldName: fc.FromField,
 						ConstValue:    fc.Value,
+						Expr:          fc.Expr,
 					})
 				}
 			}
@@ -2009,6 +2036,7 @@ func (e *ExptTemplateManagerImpl) buildFieldMappingConfigAndEnableScoreWeight(te
 						FieldName:     fc.FieldName,
 						FromFieldName: fc.FromField,
 						ConstValue:    fc.Value,
+						Expr:          fc.Expr,
 					})
 				}
 			}
@@ -714,6 +714,9 @@ func TestExptTemplateManagerImpl_Update_PreservesExptSourceInTemplateConf(t *tes
 				SourceType: entity.SourceType_Workflow,
 				SourceID:   "42",
 			},
+			QualityGateConf: &entity.ExptQualityGateConf{Rules: []*entity.ExptQualityGateRule{
+				{Metric: entity.QualityGateMetricFailRate, Operator: entity.QualityGateOperatorLT, Threshold: 0.02},
+			}},
 		},
 	}
 
@@ -741,6 +744,8 @@ func TestExptTemplateManagerImpl_Update_PreservesExptSourceInTemplateConf(t *tes
 			assert.Equal(t, entity.SourceType_Workflow, tpl.TemplateConf.ExptSource.SourceType)
 			assert.Equal(t, "42", tpl.TemplateConf.ExptSource.SourceID)
 			assert.Equal(t, newItemConcur, gptr.Indirect(tpl.TemplateConf.ItemConcurNum))
+			// 未传 quality_gate_conf 时保留已有门禁
+			assert.Equal(t, existing.TemplateConf.QualityGateConf, tpl.TemplateConf.QualityGateConf)
 			return nil
 		})
 
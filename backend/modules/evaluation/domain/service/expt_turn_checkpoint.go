@@ -0,0 +1,138 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/jinzhu/copier"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// ExptTurnCheckpointer 在 turn 执行过程中把已完成步骤的结果引用写入 turn run log。
+// 服务重启后调度器按检查点续跑 item 时, 已完成的评测对象/评估器结果直接复用, 不重复调用与扣费。
+// 检查点写入失败只打日志, 不影响评测。
+type ExptTurnCheckpointer interface {
+	SaveTarget(ctx context.Context, record *entity.EvalTargetRecord)
+	SaveEvaluator(ctx context.Context, record *entity.EvaluatorRecord)
+}
+
+// NewExptTurnCheckpointer 以 turn 已有的 run log 与结果为起点构造检查点, run log 缺失时返回 nil
+func NewExptTurnCheckpointer(turnResultRepo repo.IExptTurnResultRepo, itemResultRepo repo.IExptItemResultRepo, etec *entity.ExptTurnEvalCtx) ExptTurnCheckpointer {
+	runLog := etec.GetExistTurnResultLogs()[etec.Turn.ID]
+	if runLog == nil {
+		return nil
+	}
+	cp := &exptTurnCheckpointer{
+		turnResultRepo: turnResultRepo,
+		itemResultRepo: itemResultRepo,
+		runLog:         runLog,
+		event:          etec.Event,
+		ext:            etec.Ext,
+	}
+	if trr := etec.ExptTurnRunResult; trr != nil {
+		cp.targetResult = trr.TargetResult
+		cp.evaluatorRecords = append(cp.evaluatorRecords, trr.EvaluatorResults...)
+	}
+	return cp
+}
+
+type exptTurnCheckpointer struct {
+	turnResultRepo repo.IExptTurnResultRepo
+	itemResultRepo repo.IExptItemResultRepo
+	runLog         *entity.ExptTurnResultRunLog
+	event          *entity.ExptItemEvalEvent
+	ext            map[string]string
+
+	mu               sync.Mutex
+	targetResult     *entity.EvalTargetRecord
+	evaluatorRecords []*entity.EvaluatorRecord
+}
+
+func (c *exptTurnCheckpointer) SaveTarget(ctx context.Context, record *entity.EvalTargetRecord) {
+	if record == nil || record.ID <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.targetResult != nil && c.targetResult.ID == record.ID && gptr.Indirect(c.targetResult.Status) == gptr.Indirect(record.Status) {
+		return
+	}
+	c.targetResult = record
+	c.save(ctx)
+}
+
+func (c *exptTurnCheckpointer) SaveEvaluator(ctx context.Context, record *entity.EvaluatorRecord) {
+	if record == nil || record.ID <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	replaced := false
+	for i, r := range c.evaluatorRecords {
+		if r == nil || r.EvaluatorVersionID != record.EvaluatorVersionID || r.Alias != record.Alias {
+			continue
+		}
+		if r.ID == record.ID && r.Status == record.Status {
+			return
+		}
+		c.evaluatorRecords[i] = record
+		replaced = true
+		break
+	}
+	if !replaced {
+		c.evaluatorRecords = append(c.evaluatorRecords, record)
+	}
+	c.save(ctx)
+}
+
+func (c *exptTurnCheckpointer) save(ctx context.Context) {
+	persistCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), exptRunLogPersistTimeout)
+	defer cancel()
+
+	clone := &entity.ExptTurnResultRunLog{}
+	if err := copier.Copy(clone, c.runLog); err != nil {
+		logs.CtxWarn(ctx, "[ExptTurnEval] checkpoint copy run log fail, err: %v", err)
+		return
+	}
+	clone.ExptRunID = c.event.ExptRunID
+	clone.Ext = c.ext
+	clone.Status = entity.TurnRunState_Processing
+	if c.targetResult != nil {
+		clone.TargetResultID = c.targetResult.ID
+	}
+	clone.EvaluatorResultIds = &entity.EvaluatorResults{
+		Registered: make([]*entity.RegisteredEvalResult, 0, len(c.evaluatorRecords)),
+	}
+	for _, r := range c.evaluatorRecords {
+		if r == nil || r.ID <= 0 {
+			continue
+		}
+		clone.EvaluatorResultIds.Registered = append(clone.EvaluatorResultIds.Registered, &entity.RegisteredEvalResult{
+			VersionID: r.EvaluatorVersionID,
+			Alias:     r.Alias,
+			RecordID:  r.ID,
+		})
+	}
+	clone.Checkpoint = entity.BuildExptTurnCheckpoint(c.runLog.Checkpoint, c.targetResult, c.evaluatorRecords)
+
+	// 已被续跑接替的旧轮次不再写检查点, 避免覆盖新轮次的续跑次数与结果引用
+	if superseded, err := exptItemAttemptSuperseded(persistCtx, c.itemResultRepo, c.event); err != nil || superseded {
+		logs.CtxWarn(ctx, "[ExptTurnEval] skip turn checkpoint, expt_id: %v, item_id: %v, turn_id: %v, superseded: %v, err: %v", clone.ExptID, clone.ItemID, clone.TurnID, superseded, err)
+		return
+	}
+	if err := c.turnResultRepo.SaveTurnRunLogs(persistCtx, []*entity.ExptTurnResultRunLog{clone}); err != nil {
+		logs.CtxWarn(ctx, "[ExptTurnEval] save turn checkpoint fail, expt_id: %v, item_id: %v, turn_id: %v, err: %v", clone.ExptID, clone.ItemID, clone.TurnID, err)
+		return
+	}
+	logs.CtxInfo(ctx, "[ExptTurnEval] save turn checkpoint, expt_id: %v, item_id: %v, turn_id: %v, checkpoint: %v", clone.ExptID, clone.ItemID, clone.TurnID, json.Jsonify(clone.Checkpoint))
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	mock_repo "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+)
+
+func TestExptTurnCheckpointer(t *testing.T) {
+	ctx := context.Background()
+	newEtec := func(runLog *entity.ExptTurnResultRunLog) *entity.ExptTurnEvalCtx {
+		etec := &entity.ExptTurnEvalCtx{
+			ExptItemEvalCtx: &entity.ExptItemEvalCtx{
+				Event:               &entity.ExptItemEvalEvent{ExptID: 1, ExptRunID: 2, SpaceID: 3},
+				ExistItemEvalResult: &entity.ExptItemEvalResult{TurnResultRunLogs: map[int64]*entity.ExptTurnResultRunLog{}},
+			},
+			Turn: &entity.Turn{ID: 11},
+			Ext:  map[string]string{"k": "v"},
+		}
+		if runLog != nil {
+			etec.ExistItemEvalResult.TurnResultRunLogs[11] = runLog
+		}
+		return etec
+	}
+
+	t.Run("run log 缺失时不写检查点", func(t *testing.T) {
+		assert.Nil(t, NewExptTurnCheckpointer(nil, nil, newEtec(nil)))
+	})
+
+	t.Run("逐步写入结果引用与检查点, 重复结果不重复写", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		turnResultRepo := mock_repo.NewMockIExptTurnResultRepo(ctrl)
+		itemResultRepo := mock_repo.NewMockIExptItemResultRepo(ctrl)
+		itemResultRepo.EXPECT().MGetItemRunLog(gomock.Any(), int64(1), int64(2), gomock.Any(), int64(3)).Return([]*entity.ExptItemResultRunLog{{ItemID: 10}}, nil).Times(3)
+
+		runLog := &entity.ExptTurnResultRunLog{ID: 5, ExptID: 1, ItemID: 10, TurnID: 11, Checkpoint: &entity.ExptTurnCheckpoint{ResumeTimes: 1}}
+		cp := NewExptTurnCheckpointer(turnResultRepo, itemResultRepo, newEtec(runLog))
+		assert.NotNil(t, cp)
+
+		var saved []*entity.ExptTurnResultRunLog
+		turnResultRepo.EXPECT().SaveTurnRunLogs(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rls []*entity.ExptTurnResultRunLog) error {
+			saved = append(saved, rls...)
+			return nil
+		}).Times(3)
+
+		target := &entity.EvalTargetRecord{ID: 100, Status: gptr.Of(entity.EvalTargetRunStatusSuccess)}
+		cp.SaveTarget(ctx, target)
+		cp.SaveTarget(ctx, &entity.EvalTargetRecord{ID: 100, Status: gptr.Of(entity.EvalTargetRunStatusSuccess)})
+		cp.SaveEvaluator(ctx, &entity.EvaluatorRecord{ID: 200, EvaluatorVersionID: 7, Alias: "a", Status: entity.EvaluatorRunStatusAsyncInvoking})
+		cp.SaveEvaluator(ctx, &entity.EvaluatorRecord{ID: 200, EvaluatorVersionID: 7, Alias: "a", Status: entity.EvaluatorRunStatusAsyncInvoking})
+		cp.SaveEvaluator(ctx, &entity.EvaluatorRecord{ID: 0, EvaluatorVersionID: 8})
+		cp.SaveEvaluator(ctx, &entity.EvaluatorRecord{ID: 200, EvaluatorVersionID: 7, Alias: "a", Status: entity.EvaluatorRunStatusSuccess})
+
+		if assert.Len(t, saved, 3) {
+			first := saved[0]
+			assert.Equal(t, int64(2), first.ExptRunID)
+			assert.Equal(t, entity.TurnRunState_Processing, first.Status)
+			assert.Equal(t, int64(100), first.TargetResultID)
+			assert.Equal(t, entity.ExptTurnCheckpointStageTargetDone, first.Checkpoint.Stage)
+			assert.Equal(t, 1, first.Checkpoint.ResumeTimes)
+
+			second := saved[1]
+			assert.Equal(t, entity.ExptTurnCheckpointStageEvaluatorAsyncPending, second.Checkpoint.Stage)
+			assert.Equal(t, []int64{200}, second.Checkpoint.PendingInvokeIDs)
+			if assert.Len(t, second.EvaluatorResultIds.Registered, 1) {
+				assert.Equal(t, int64(200), second.EvaluatorResultIds.Registered[0].RecordID)
+				assert.Equal(t, "a", second.EvaluatorResultIds.Registered[0].Alias)
+			}
+
+			third := saved[2]
+			assert.Equal(t, entity.ExptTurnCheckpointStageEvaluating, third.Checkpoint.Stage)
+			assert.Len(t, third.EvaluatorResultIds.Registered, 1)
+		}
+		// 原 run log 不被修改
+		assert.Nil(t, runLog.Checkpoint.PendingInvokeIDs)
+		assert.Equal(t, int64(0), runLog.TargetResultID)
+	})
+
+	t.Run("写入失败不影响评测", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		turnResultRepo := mock_repo.NewMockIExptTurnResultRepo(ctrl)
+		turnResultRepo.EXPECT().SaveTurnRunLogs(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+		itemResultRepo := mock_repo.NewMockIExptItemResultRepo(ctrl)
+		itemResultRepo.EXPECT().MGetItemRunLog(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		cp := NewExptTurnCheckpointer(turnResultRepo, itemResultRepo, newEtec(&entity.ExptTurnResultRunLog{ID: 5}))
+		assert.NotPanics(t, func() {
+			cp.SaveTarget(ctx, &entity.EvalTargetRecord{ID: 100, Status: gptr.Of(entity.EvalTargetRunStatusSuccess)})
+		})
+	})
+
+	t.Run("已被续跑接替的旧轮次不写检查点", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		turnResultRepo := mock_repo.NewMockIExptTurnResultRepo(ctrl)
+		turnResultRepo.EXPECT().SaveTurnRunLogs(gomock.Any(), gomock.Any()).Times(0)
+		itemResultRepo := mock_repo.NewMockIExptItemResultRepo(ctrl)
+		itemResultRepo.EXPECT().MGetItemRunLog(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*entity.ExptItemResultRunLog{{ItemID: 10, Attempt: 1}}, nil)
+
+		cp := NewExptTurnCheckpointer(turnResultRepo, itemResultRepo, newEtec(&entity.ExptTurnResultRunLog{ID: 5}))
+		cp.SaveTarget(ctx, &entity.EvalTargetRecord{ID: 100, Status: gptr.Of(entity.EvalTargetRunStatusSuccess)})
+	})
+}
@@ -0,0 +1,18 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate mockgen -destination ./mocks/expt_user_simulator.go --package mocks . ExptUserSimulator
+type ExptUserSimulator interface {
+	// NextUserMessage 按模拟用户画像与已有对话生成下一轮用户消息, finished 为 true 表示模拟用户主动结束对话
+	NextUserMessage(ctx context.Context, param *entity.UserSimulateParam) (msg string, finished bool, err error)
+	// JudgeGoal 调用目标判定评估器判断对话是否已达成目标, 未配置评估器时恒为 false
+	JudgeGoal(ctx context.Context, param *entity.UserSimulateGoalJudgeParam) (achieved bool, record *entity.EvaluatorRecord, err error)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// userSimulatorKickoffMsg 尚无对话时用于引导模拟用户给出开场消息
+const userSimulatorKickoffMsg = "Start the conversation now."
+
+func NewExptUserSimulator(llmProvider rpc.ILLMProvider, evaluatorService EvaluatorService) ExptUserSimulator {
+	return &ExptUserSimulatorImpl{
+		llmProvider:      llmProvider,
+		evaluatorService: evaluatorService,
+	}
+}
+
+type ExptUserSimulatorImpl struct {
+	llmProvider      rpc.ILLMProvider
+	evaluatorService EvaluatorService
+}
+
+func (s *ExptUserSimulatorImpl) NextUserMessage(ctx context.Context, param *entity.UserSimulateParam) (string, bool, error) {
+	if param == nil || !param.Conf.IsEnabled() {
+		return "", false, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("user simulator conf is not enabled"))
+	}
+
+	reply, err := s.llmProvider.Call(ctx, &entity.LLMCallParam{
+		SpaceID:     param.SpaceID,
+		UserID:      gptr.Of(session.UserIDInCtxOrEmpty(ctx)),
+		Scenario:    entity.ScenarioDefault,
+		Messages:    buildUserSimulatorMessages(param),
+		ModelConfig: param.Conf.ModelConfig,
+	})
+	if err != nil {
+		return "", false, errorx.Wrapf(err, "user simulator call llm fail")
+	}
+
+	var msg string
+	if reply != nil {
+		msg = strings.TrimSpace(gptr.Indirect(reply.Content))
+	}
+	if strings.Contains(msg, entity.UserSimulatorFinishToken) {
+		if len(param.History) > 0 {
+			return "", true, nil
+		}
+		// 对话尚未开始时结束标记无意义, 去掉标记后按普通消息处理
+		msg = strings.TrimSpace(strings.ReplaceAll(msg, entity.UserSimulatorFinishToken, ""))
+	}
+	if len(msg) == 0 {
+		return "", false, errorx.NewByCode(errno.CommonInternalErrorCode, errorx.WithExtraMsg("user simulator returned empty message"))
+	}
+	return msg, false, nil
+}
+
+// buildUserSimulatorMessages 组装模拟用户的模型输入: 模拟用户自身的消息作为 assistant, 评测对象的回复作为 user
+func buildUserSimulatorMessages(param *entity.UserSimulateParam) []*entity.Message {
+	msgs := make([]*entity.Message, 0, len(param.History)+2)
+	msgs = append(msgs, entity.NewTextMessage(entity.RoleSystem, param.Profile.RenderSystemPrompt(param.Conf.GetSystemPrompt())))
+	if len(param.History) == 0 {
+		return append(msgs, entity.NewTextMessage(entity.RoleUser, userSimulatorKickoffMsg))
+	}
+	for _, h := range param.History {
+		if h == nil {
+			continue
+		}
+		role := entity.RoleUser
+		if h.Role == entity.RoleUser {
+			role = entity.RoleAssistant
+		}
+		msgs = append(msgs, entity.NewTextMessage(role, h.Content.GetText()))
+	}
+	return msgs
+}
+
+func (s *ExptUserSimulatorImpl) JudgeGoal(ctx context.Context, param *entity.UserSimulateGoalJudgeParam) (bool, *entity.EvaluatorRecord, error) {
+	if param == nil || param.Conf == nil || param.Conf.GoalEvaluatorVersionID <= 0 {
+		return false, nil, nil
+	}
+
+	var lastResponse string
+	for i := len(param.History) - 1; i >= 0; i-- {
+		if h := param.History[i]; h != nil && h.Role == entity.RoleAssistant {
+			lastResponse = h.Content.GetText()
+			break
+		}
+	}
+	var goal string
+	if param.Profile != nil {
+		goal = param.Profile.Goal
+	}
+
+	record, err := s.evaluatorService.RunEvaluator(ctx, &entity.RunEvaluatorRequest{
+		SpaceID:            param.SpaceID,
+		EvaluatorVersionID: param.Conf.GoalEvaluatorVersionID,
+		InputData: &entity.EvaluatorInputData{
+			HistoryMessages: param.History,
+			InputFields: map[string]*entity.Content{
+				entity.UserSimulatorJudgeFieldGoal:         entity.NewTextContent(goal),
+				entity.UserSimulatorJudgeFieldConversation: entity.NewTextContent(entity.FormatSimulatedConversation(param.History)),
+				entity.UserSimulatorJudgeFieldLastResponse: entity.NewTextContent(lastResponse),
+			},
+			Ext: param.Ext,
+		},
+		ExperimentID:    param.ExptID,
+		ExperimentRunID: param.ExptRunID,
+		ItemID:          param.ItemID,
+		TurnID:          param.TurnID,
+		Ext:             param.Ext,
+	})
+	if err != nil {
+		return false, nil, err
+	}
+
+	score := record.GetScore()
+	if score == nil {
+		logs.CtxWarn(ctx, "[UserSimulator] goal evaluator returned no score, record_id: %v", record.ID)
+		return false, record, nil
+	}
+	return param.Conf.IsGoalAchieved(*score), record, nil
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	rpcmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	servicemocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+func TestExptUserSimulatorImpl_NextUserMessage(t *testing.T) {
+	conf := &entity.ExptUserSimulatorConf{
+		ModelConfig:  &entity.ModelConfig{ModelID: gptr.Of(int64(1))},
+		SystemPrompt: "persona={{persona}} goal={{goal}}",
+	}
+	profile := &entity.UserSimulatorProfile{Goal: "book a flight", Persona: "traveler"}
+	history := []*entity.Message{
+		entity.NewTextMessage(entity.RoleUser, "hi"),
+		entity.NewTextMessage(entity.RoleAssistant, "how can I help"),
+	}
+
+	tests := []struct {
+		name         string
+		history      []*entity.Message
+		reply        *entity.ReplyItem
+		callErr      error
+		wantMsg      string
+		wantFinished bool
+		wantErr      bool
+		checkMsgs    func(t *testing.T, msgs []*entity.Message)
+	}{
+		{
+			name:    "首轮使用引导消息",
+			reply:   &entity.ReplyItem{Content: gptr.Of("  I need a ticket  ")},
+			wantMsg: "I need a ticket",
+			checkMsgs: func(t *testing.T, msgs []*entity.Message) {
+				require.Len(t, msgs, 2)
+				assert.Equal(t, entity.RoleSystem, msgs[0].Role)
+				assert.Equal(t, "persona=traveler goal=book a flight", msgs[0].Content.GetText())
+				assert.Equal(t, entity.RoleUser, msgs[1].Role)
+				assert.Equal(t, userSimulatorKickoffMsg, msgs[1].Content.GetText())
+			},
+		},
+		{
+			name:    "对话角色互换",
+			history: history,
+			reply:   &entity.ReplyItem{Content: gptr.Of("tomorrow please")},
+			wantMsg: "tomorrow please",
+			checkMsgs: func(t *testing.T, msgs []*entity.Message) {
+				require.Len(t, msgs, 3)
+				assert.Equal(t, entity.RoleAssistant, msgs[1].Role)
+				assert.Equal(t, "hi", msgs[1].Content.GetText())
+				assert.Equal(t, entity.RoleUser, msgs[2].Role)
+				assert.Equal(t, "how can I help", msgs[2].Content.GetText())
+			},
+		},
+		{
+			name:         "输出结束标记",
+			history:      history,
+			reply:        &entity.ReplyItem{Content: gptr.Of("thanks, bye " + entity.UserSimulatorFinishToken)},
+			wantFinished: true,
+		},
+		{
+			name:    "首轮结束标记按普通消息处理",
+			reply:   &entity.ReplyItem{Content: gptr.Of("hello " + entity.UserSimulatorFinishToken)},
+			wantMsg: "hello",
+		},
+		{
+			name:    "空消息",
+			history: history,
+			reply:   &entity.ReplyItem{Content: gptr.Of("  ")},
+			wantErr: true,
+		},
+		{
+			name:    "模型调用失败",
+			callErr: errors.New("llm down"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			llm := rpcmocks.NewMockILLMProvider(ctrl)
+			llm.EXPECT().Call(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, param *entity.LLMCallParam) (*entity.ReplyItem, error) {
+				assert.Equal(t, conf.ModelConfig, param.ModelConfig)
+				if tt.checkMsgs != nil {
+					tt.checkMsgs(t, param.Messages)
+				}
+				return tt.reply, tt.callErr
+			})
+
+			s := NewExptUserSimulator(llm, nil)
+			msg, finished, err := s.NextUserMessage(context.Background(), &entity.UserSimulateParam{SpaceID: 1, Conf: conf, Profile: profile, History: tt.history})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFinished, finished)
+			assert.Equal(t, tt.wantMsg, msg)
+		})
+	}
+
+	t.Run("未开启模拟用户", func(t *testing.T) {
+		s := NewExptUserSimulator(nil, nil)
+		_, _, err := s.NextUserMessage(context.Background(), &entity.UserSimulateParam{Conf: &entity.ExptUserSimulatorConf{}})
+		assert.Error(t, err)
+	})
+}
+
+func TestExptUserSimulatorImpl_JudgeGoal(t *testing.T) {
+	history := []*entity.Message{
+		entity.NewTextMessage(entity.RoleUser, "book a flight"),
+		entity.NewTextMessage(entity.RoleAssistant, "booked"),
+	}
+	param := func(conf *entity.ExptUserSimulatorConf) *entity.UserSimulateGoalJudgeParam {
+		return &entity.UserSimulateGoalJudgeParam{
+			SpaceID:   1,
+			ExptID:    2,
+			ExptRunID: 3,
+			ItemID:    4,
+			TurnID:    5,
+			Conf:      conf,
+			Profile:   &entity.UserSimulatorProfile{Goal: "get a ticket"},
+			History:   history,
+		}
+	}
+	scored := func(score float64) *entity.EvaluatorRecord {
+		return &entity.EvaluatorRecord{ID: 9, EvaluatorOutputData: &entity.EvaluatorOutputData{EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(score)}}}
+	}
+
+	t.Run("未配置目标判定评估器", func(t *testing.T) {
+		s := NewExptUserSimulator(nil, nil)
+		achieved, record, err := s.JudgeGoal(context.Background(), param(&entity.ExptUserSimulatorConf{}))
+		assert.NoError(t, err)
+		assert.False(t, achieved)
+		assert.Nil(t, record)
+	})
+
+	t.Run("得分达到阈值", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		evaluatorService := servicemocks.NewMockEvaluatorService(ctrl)
+		evaluatorService.EXPECT().RunEvaluator(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *entity.RunEvaluatorRequest) (*entity.EvaluatorRecord, error) {
+			assert.Equal(t, int64(100), req.EvaluatorVersionID)
+			assert.Equal(t, int64(5), req.TurnID)
+			assert.Equal(t, "get a ticket", req.InputData.InputFields[entity.UserSimulatorJudgeFieldGoal].GetText())
+			assert.Equal(t, "user: book a flight\nassistant: booked", req.InputData.InputFields[entity.UserSimulatorJudgeFieldConversation].GetText())
+			assert.Equal(t, "booked", req.InputData.InputFields[entity.UserSimulatorJudgeFieldLastResponse].GetText())
+			return scored(0.8), nil
+		})
+
+		s := NewExptUserSimulator(nil, evaluatorService)
+		achieved, record, err := s.JudgeGoal(context.Background(), param(&entity.ExptUserSimulatorConf{GoalEvaluatorVersionID: 100, GoalScoreThreshold: gptr.Of(0.6)}))
+		assert.NoError(t, err)
+		assert.True(t, achieved)
+		assert.Equal(t, int64(9), record.ID)
+	})
+
+	t.Run("得分低于默认阈值", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		evaluatorService := servicemocks.NewMockEvaluatorService(ctrl)
+		evaluatorService.EXPECT().RunEvaluator(gomock.Any(), gomock.Any()).Return(scored(0.8), nil)
+
+		s := NewExptUserSimulator(nil, evaluatorService)
+		achieved, _, err := s.JudgeGoal(context.Background(), param(&entity.ExptUserSimulatorConf{GoalEvaluatorVersionID: 100}))
+		assert.NoError(t, err)
+		assert.False(t, achieved)
+	})
+
+	t.Run("评估器无得分", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		evaluatorService := servicemocks.NewMockEvaluatorService(ctrl)
+		evaluatorService.EXPECT().RunEvaluator(gomock.Any(), gomock.Any()).Return(&entity.EvaluatorRecord{ID: 9}, nil)
+
+		s := NewExptUserSimulator(nil, evaluatorService)
+		achieved, _, err := s.JudgeGoal(context.Background(), param(&entity.ExptUserSimulatorConf{GoalEvaluatorVersionID: 100}))
+		assert.NoError(t, err)
+		assert.False(t, achieved)
+	})
+
+	t.Run("评估器执行失败", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		evaluatorService := servicemocks.NewMockEvaluatorService(ctrl)
+		evaluatorService.EXPECT().RunEvaluator(gomock.Any(), gomock.Any()).Return(nil, errors.New("run fail"))
+
+		s := NewExptUserSimulator(nil, evaluatorService)
+		_, _, err := s.JudgeGoal(context.Background(), param(&entity.ExptUserSimulatorConf{GoalEvaluatorVersionID: 100}))
+		assert.Error(t, err)
+	})
+}
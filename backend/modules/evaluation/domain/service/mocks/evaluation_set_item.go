@@ -223,6 +223,21 @@ func (mr *MockEvaluationSetItemServiceMockRecorder) ListEvaluationSetItems(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvaluationSetItems", reflect.TypeOf((*MockEvaluationSetItemService)(nil).ListEvaluationSetItems), arg0, arg1)
 }
 
+// ListPendingReviewItemIDs mocks base method.
+func (m *MockEvaluationSetItemService) ListPendingReviewItemIDs(arg0 context.Context, arg1, arg2 int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingReviewItemIDs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingReviewItemIDs indicates an expected call of ListPendingReviewItemIDs.
+func (mr *MockEvaluationSetItemServiceMockRecorder) ListPendingReviewItemIDs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingReviewItemIDs", reflect.TypeOf((*MockEvaluationSetItemService)(nil).ListPendingReviewItemIDs), arg0, arg1, arg2)
+}
+
 // UpdateEvaluationSetItem mocks base method.
 func (m *MockEvaluationSetItemService) UpdateEvaluationSetItem(arg0 context.Context, arg1, arg2, arg3 int64, arg4 []*entity.Turn, arg5 []*entity.FieldWriteOption, arg6 []*entity.ResourceTagRef) error {
 	m.ctrl.T.Helper()
@@ -250,3 +265,19 @@ func (mr *MockEvaluationSetItemServiceMockRecorder) UpdateEvaluationSetItemVersi
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvaluationSetItemVersion", reflect.TypeOf((*MockEvaluationSetItemService)(nil).UpdateEvaluationSetItemVersion), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 }
+
+// ValidateEvaluationSetItems mocks base method.
+func (m *MockEvaluationSetItemService) ValidateEvaluationSetItems(arg0 context.Context, arg1 *entity.ValidateEvaluationSetItemsParam) ([]int32, []*entity.ItemErrorGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateEvaluationSetItems", arg0, arg1)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].([]*entity.ItemErrorGroup)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ValidateEvaluationSetItems indicates an expected call of ValidateEvaluationSetItems.
+func (mr *MockEvaluationSetItemServiceMockRecorder) ValidateEvaluationSetItems(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateEvaluationSetItems", reflect.TypeOf((*MockEvaluationSetItemService)(nil).ValidateEvaluationSetItems), arg0, arg1)
+}
@@ -1,11 +1,17 @@
 // Code generated by MockGen. DO NOT EDIT.
 // Source: github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service (interfaces: IExptResultExportService)
+//
+// Generated by this command:
+//
+//	mockgen -destination ./mocks/expt_export.go --package mocks . IExptResultExportService
+//
 
 // Package mocks is a generated GoMock package.
 package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	entity "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
@@ -35,6 +41,20 @@ func (m *MockIExptResultExportService) EXPECT() *MockIExptResultExportServiceMoc
 	return m.recorder
 }
 
+// DoExport mocks base method.
+func (m *MockIExptResultExportService) DoExport(arg0 context.Context, arg1, arg2 int64, arg3 string, arg4 bool, arg5 *entity.ExptResultExportColumnSpec, arg6 entity.ExptResultExportFormat) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DoExport", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DoExport indicates an expected call of DoExport.
+func (mr *MockIExptResultExportServiceMockRecorder) DoExport(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoExport", reflect.TypeOf((*MockIExptResultExportService)(nil).DoExport), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
 // DoExportCSV mocks base method.
 func (m *MockIExptResultExportService) DoExportCSV(arg0 context.Context, arg1, arg2 int64, arg3 string, arg4 bool, arg5 *entity.ExptResultExportColumnSpec) error {
 	m.ctrl.T.Helper()
@@ -44,24 +64,38 @@ func (m *MockIExptResultExportService) DoExportCSV(arg0 context.Context, arg1, a
 }
 
 // DoExportCSV indicates an expected call of DoExportCSV.
-func (mr *MockIExptResultExportServiceMockRecorder) DoExportCSV(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+func (mr *MockIExptResultExportServiceMockRecorder) DoExportCSV(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoExportCSV", reflect.TypeOf((*MockIExptResultExportService)(nil).DoExportCSV), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
 // ExportCSV mocks base method.
-func (m *MockIExptResultExportService) ExportCSV(arg0 context.Context, arg1, arg2 int64, arg3 *entity.Session, arg4 *entity.ExptResultExportColumnSpec) (int64, error) {
+func (m *MockIExptResultExportService) ExportCSV(arg0 context.Context, arg1, arg2 int64, arg3 *entity.Session, arg4 *entity.ExptResultExportColumnSpec, arg5 entity.ExptResultExportFormat) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExportCSV", arg0, arg1, arg2, arg3, arg4)
+	ret := m.ctrl.Call(m, "ExportCSV", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ExportCSV indicates an expected call of ExportCSV.
-func (mr *MockIExptResultExportServiceMockRecorder) ExportCSV(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+func (mr *MockIExptResultExportServiceMockRecorder) ExportCSV(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCSV", reflect.TypeOf((*MockIExptResultExportService)(nil).ExportCSV), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// ExportRegressionReport mocks base method.
+func (m *MockIExptResultExportService) ExportRegressionReport(arg0 context.Context, arg1 *entity.ExptRegressionReport, arg2 entity.ExptResultExportFormat, arg3 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportRegressionReport", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportRegressionReport indicates an expected call of ExportRegressionReport.
+func (mr *MockIExptResultExportServiceMockRecorder) ExportRegressionReport(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCSV", reflect.TypeOf((*MockIExptResultExportService)(nil).ExportCSV), arg0, arg1, arg2, arg3, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportRegressionReport", reflect.TypeOf((*MockIExptResultExportService)(nil).ExportRegressionReport), arg0, arg1, arg2, arg3)
 }
 
 // GetExptExportRecord mocks base method.
@@ -74,7 +108,7 @@ func (m *MockIExptResultExportService) GetExptExportRecord(arg0 context.Context,
 }
 
 // GetExptExportRecord indicates an expected call of GetExptExportRecord.
-func (mr *MockIExptResultExportServiceMockRecorder) GetExptExportRecord(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockIExptResultExportServiceMockRecorder) GetExptExportRecord(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExptExportRecord", reflect.TypeOf((*MockIExptResultExportService)(nil).GetExptExportRecord), arg0, arg1, arg2)
 }
@@ -88,7 +122,7 @@ func (m *MockIExptResultExportService) HandleExportEvent(arg0 context.Context, a
 }
 
 // HandleExportEvent indicates an expected call of HandleExportEvent.
-func (mr *MockIExptResultExportServiceMockRecorder) HandleExportEvent(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockIExptResultExportServiceMockRecorder) HandleExportEvent(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleExportEvent", reflect.TypeOf((*MockIExptResultExportService)(nil).HandleExportEvent), arg0, arg1)
 }
@@ -104,7 +138,7 @@ func (m *MockIExptResultExportService) ListExportRecord(arg0 context.Context, ar
 }
 
 // ListExportRecord indicates an expected call of ListExportRecord.
-func (mr *MockIExptResultExportServiceMockRecorder) ListExportRecord(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockIExptResultExportServiceMockRecorder) ListExportRecord(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExportRecord", reflect.TypeOf((*MockIExptResultExportService)(nil).ListExportRecord), arg0, arg1, arg2, arg3)
 }
@@ -118,7 +152,22 @@ func (m *MockIExptResultExportService) UpdateExportRecord(arg0 context.Context,
 }
 
 // UpdateExportRecord indicates an expected call of UpdateExportRecord.
-func (mr *MockIExptResultExportServiceMockRecorder) UpdateExportRecord(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockIExptResultExportServiceMockRecorder) UpdateExportRecord(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExportRecord", reflect.TypeOf((*MockIExptResultExportService)(nil).UpdateExportRecord), arg0, arg1)
 }
+
+// UploadRegressionReport mocks base method.
+func (m *MockIExptResultExportService) UploadRegressionReport(arg0 context.Context, arg1 *entity.ExptRegressionReport, arg2 entity.ExptResultExportFormat) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadRegressionReport", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadRegressionReport indicates an expected call of UploadRegressionReport.
+func (mr *MockIExptResultExportServiceMockRecorder) UploadRegressionReport(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadRegressionReport", reflect.TypeOf((*MockIExptResultExportService)(nil).UploadRegressionReport), arg0, arg1, arg2)
+}
@@ -1,5 +1,10 @@
 // Code generated by MockGen. DO NOT EDIT.
 // Source: github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service (interfaces: ExptResultService,ExptAggrResultService)
+//
+// Generated by this command:
+//
+//	mockgen -destination ./mocks/expt_result.go --package mocks . ExptResultService,ExptAggrResultService
+//
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -46,7 +51,7 @@ func (m *MockExptResultService) CalculateStats(arg0 context.Context, arg1, arg2
 }
 
 // CalculateStats indicates an expected call of CalculateStats.
-func (mr *MockExptResultServiceMockRecorder) CalculateStats(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockExptResultServiceMockRecorder) CalculateStats(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculateStats", reflect.TypeOf((*MockExptResultService)(nil).CalculateStats), arg0, arg1, arg2, arg3)
 }
@@ -60,7 +65,7 @@ func (m *MockExptResultService) CompareExptTurnResultFilters(arg0 context.Contex
 }
 
 // CompareExptTurnResultFilters indicates an expected call of CompareExptTurnResultFilters.
-func (mr *MockExptResultServiceMockRecorder) CompareExptTurnResultFilters(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+func (mr *MockExptResultServiceMockRecorder) CompareExptTurnResultFilters(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareExptTurnResultFilters", reflect.TypeOf((*MockExptResultService)(nil).CompareExptTurnResultFilters), arg0, arg1, arg2, arg3, arg4)
 }
@@ -74,7 +79,7 @@ func (m *MockExptResultService) CreateStats(arg0 context.Context, arg1 *entity.E
 }
 
 // CreateStats indicates an expected call of CreateStats.
-func (mr *MockExptResultServiceMockRecorder) CreateStats(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockExptResultServiceMockRecorder) CreateStats(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStats", reflect.TypeOf((*MockExptResultService)(nil).CreateStats), arg0, arg1, arg2)
 }
@@ -89,7 +94,7 @@ func (m *MockExptResultService) GetExptItemTurnResults(arg0 context.Context, arg
 }
 
 // GetExptItemTurnResults indicates an expected call of GetExptItemTurnResults.
-func (mr *MockExptResultServiceMockRecorder) GetExptItemTurnResults(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+func (mr *MockExptResultServiceMockRecorder) GetExptItemTurnResults(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExptItemTurnResults", reflect.TypeOf((*MockExptResultService)(nil).GetExptItemTurnResults), arg0, arg1, arg2, arg3, arg4)
 }
@@ -104,7 +109,7 @@ func (m *MockExptResultService) GetIncompleteTurns(arg0 context.Context, arg1, a
 }
 
 // GetIncompleteTurns indicates an expected call of GetIncompleteTurns.
-func (mr *MockExptResultServiceMockRecorder) GetIncompleteTurns(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockExptResultServiceMockRecorder) GetIncompleteTurns(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncompleteTurns", reflect.TypeOf((*MockExptResultService)(nil).GetIncompleteTurns), arg0, arg1, arg2, arg3)
 }
@@ -119,7 +124,7 @@ func (m *MockExptResultService) GetItemIDListByExptID(arg0 context.Context, arg1
 }
 
 // GetItemIDListByExptID indicates an expected call of GetItemIDListByExptID.
-func (mr *MockExptResultServiceMockRecorder) GetItemIDListByExptID(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockExptResultServiceMockRecorder) GetItemIDListByExptID(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemIDListByExptID", reflect.TypeOf((*MockExptResultService)(nil).GetItemIDListByExptID), arg0, arg1, arg2)
 }
@@ -134,7 +139,7 @@ func (m *MockExptResultService) GetStats(arg0 context.Context, arg1, arg2 int64,
 }
 
 // GetStats indicates an expected call of GetStats.
-func (mr *MockExptResultServiceMockRecorder) GetStats(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockExptResultServiceMockRecorder) GetStats(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockExptResultService)(nil).GetStats), arg0, arg1, arg2, arg3)
 }
@@ -148,11 +153,28 @@ func (m *MockExptResultService) InsertExptTurnResultFilterKeyMappings(arg0 conte
 }
 
 // InsertExptTurnResultFilterKeyMappings indicates an expected call of InsertExptTurnResultFilterKeyMappings.
-func (mr *MockExptResultServiceMockRecorder) InsertExptTurnResultFilterKeyMappings(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockExptResultServiceMockRecorder) InsertExptTurnResultFilterKeyMappings(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertExptTurnResultFilterKeyMappings", reflect.TypeOf((*MockExptResultService)(nil).InsertExptTurnResultFilterKeyMappings), arg0, arg1)
 }
 
+// ListTurnResult mocks base method.
+func (m *MockExptResultService) ListTurnResult(arg0 context.Context, arg1 *entity.MGetExperimentResultParam, arg2 *entity.Experiment) ([]*entity.ExptTurnResult, map[int64]entity.ItemRunState, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTurnResult", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*entity.ExptTurnResult)
+	ret1, _ := ret[1].(map[int64]entity.ItemRunState)
+	ret2, _ := ret[2].(int64)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// ListTurnResult indicates an expected call of ListTurnResult.
+func (mr *MockExptResultServiceMockRecorder) ListTurnResult(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTurnResult", reflect.TypeOf((*MockExptResultService)(nil).ListTurnResult), arg0, arg1, arg2)
+}
+
 // MGetExperimentResult mocks base method.
 func (m *MockExptResultService) MGetExperimentResult(arg0 context.Context, arg1 *entity.MGetExperimentResultParam) (*entity.MGetExperimentReportResult, error) {
 	m.ctrl.T.Helper()
@@ -163,7 +185,7 @@ func (m *MockExptResultService) MGetExperimentResult(arg0 context.Context, arg1
 }
 
 // MGetExperimentResult indicates an expected call of MGetExperimentResult.
-func (mr *MockExptResultServiceMockRecorder) MGetExperimentResult(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockExptResultServiceMockRecorder) MGetExperimentResult(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGetExperimentResult", reflect.TypeOf((*MockExptResultService)(nil).MGetExperimentResult), arg0, arg1)
 }
@@ -178,7 +200,7 @@ func (m *MockExptResultService) MGetStats(arg0 context.Context, arg1 []int64, ar
 }
 
 // MGetStats indicates an expected call of MGetStats.
-func (mr *MockExptResultServiceMockRecorder) MGetStats(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockExptResultServiceMockRecorder) MGetStats(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGetStats", reflect.TypeOf((*MockExptResultService)(nil).MGetStats), arg0, arg1, arg2, arg3)
 }
@@ -192,7 +214,7 @@ func (m *MockExptResultService) ManualUpsertExptTurnResultFilter(arg0 context.Co
 }
 
 // ManualUpsertExptTurnResultFilter indicates an expected call of ManualUpsertExptTurnResultFilter.
-func (mr *MockExptResultServiceMockRecorder) ManualUpsertExptTurnResultFilter(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockExptResultServiceMockRecorder) ManualUpsertExptTurnResultFilter(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManualUpsertExptTurnResultFilter", reflect.TypeOf((*MockExptResultService)(nil).ManualUpsertExptTurnResultFilter), arg0, arg1, arg2, arg3)
 }
@@ -206,7 +228,7 @@ func (m *MockExptResultService) RecalculateWeightedScore(arg0 context.Context, a
 }
 
 // RecalculateWeightedScore indicates an expected call of RecalculateWeightedScore.
-func (mr *MockExptResultServiceMockRecorder) RecalculateWeightedScore(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+func (mr *MockExptResultServiceMockRecorder) RecalculateWeightedScore(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecalculateWeightedScore", reflect.TypeOf((*MockExptResultService)(nil).RecalculateWeightedScore), arg0, arg1, arg2, arg3, arg4)
 }
@@ -221,7 +243,7 @@ func (m *MockExptResultService) RecordItemRunLogs(arg0 context.Context, arg1, ar
 }
 
 // RecordItemRunLogs indicates an expected call of RecordItemRunLogs.
-func (mr *MockExptResultServiceMockRecorder) RecordItemRunLogs(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+func (mr *MockExptResultServiceMockRecorder) RecordItemRunLogs(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordItemRunLogs", reflect.TypeOf((*MockExptResultService)(nil).RecordItemRunLogs), arg0, arg1, arg2, arg3, arg4, arg5)
 }
@@ -235,7 +257,7 @@ func (m *MockExptResultService) UpsertExptTurnResultFilter(arg0 context.Context,
 }
 
 // UpsertExptTurnResultFilter indicates an expected call of UpsertExptTurnResultFilter.
-func (mr *MockExptResultServiceMockRecorder) UpsertExptTurnResultFilter(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockExptResultServiceMockRecorder) UpsertExptTurnResultFilter(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertExptTurnResultFilter", reflect.TypeOf((*MockExptResultService)(nil).UpsertExptTurnResultFilter), arg0, arg1, arg2, arg3)
 }
@@ -273,7 +295,7 @@ func (m *MockExptAggrResultService) BatchGetExptAggrResultByExperimentIDs(arg0 c
 }
 
 // BatchGetExptAggrResultByExperimentIDs indicates an expected call of BatchGetExptAggrResultByExperimentIDs.
-func (mr *MockExptAggrResultServiceMockRecorder) BatchGetExptAggrResultByExperimentIDs(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockExptAggrResultServiceMockRecorder) BatchGetExptAggrResultByExperimentIDs(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetExptAggrResultByExperimentIDs", reflect.TypeOf((*MockExptAggrResultService)(nil).BatchGetExptAggrResultByExperimentIDs), arg0, arg1, arg2)
 }
@@ -287,7 +309,7 @@ func (m *MockExptAggrResultService) CreateAnnotationAggrResult(arg0 context.Cont
 }
 
 // CreateAnnotationAggrResult indicates an expected call of CreateAnnotationAggrResult.
-func (mr *MockExptAggrResultServiceMockRecorder) CreateAnnotationAggrResult(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockExptAggrResultServiceMockRecorder) CreateAnnotationAggrResult(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnnotationAggrResult", reflect.TypeOf((*MockExptAggrResultService)(nil).CreateAnnotationAggrResult), arg0, arg1)
 }
@@ -301,7 +323,7 @@ func (m *MockExptAggrResultService) CreateExptAggrResult(arg0 context.Context, a
 }
 
 // CreateExptAggrResult indicates an expected call of CreateExptAggrResult.
-func (mr *MockExptAggrResultServiceMockRecorder) CreateExptAggrResult(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockExptAggrResultServiceMockRecorder) CreateExptAggrResult(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExptAggrResult", reflect.TypeOf((*MockExptAggrResultService)(nil).CreateExptAggrResult), arg0, arg1, arg2)
 }
@@ -315,7 +337,7 @@ func (m *MockExptAggrResultService) PublishExptAggrResultEvent(arg0 context.Cont
 }
 
 // PublishExptAggrResultEvent indicates an expected call of PublishExptAggrResultEvent.
-func (mr *MockExptAggrResultServiceMockRecorder) PublishExptAggrResultEvent(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockExptAggrResultServiceMockRecorder) PublishExptAggrResultEvent(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishExptAggrResultEvent", reflect.TypeOf((*MockExptAggrResultService)(nil).PublishExptAggrResultEvent), arg0, arg1, arg2)
 }
@@ -329,7 +351,7 @@ func (m *MockExptAggrResultService) UpdateAnnotationAggrResult(arg0 context.Cont
 }
 
 // UpdateAnnotationAggrResult indicates an expected call of UpdateAnnotationAggrResult.
-func (mr *MockExptAggrResultServiceMockRecorder) UpdateAnnotationAggrResult(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockExptAggrResultServiceMockRecorder) UpdateAnnotationAggrResult(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnnotationAggrResult", reflect.TypeOf((*MockExptAggrResultService)(nil).UpdateAnnotationAggrResult), arg0, arg1)
 }
@@ -343,7 +365,7 @@ func (m *MockExptAggrResultService) UpdateExptAggrResult(arg0 context.Context, a
 }
 
 // UpdateExptAggrResult indicates an expected call of UpdateExptAggrResult.
-func (mr *MockExptAggrResultServiceMockRecorder) UpdateExptAggrResult(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockExptAggrResultServiceMockRecorder) UpdateExptAggrResult(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExptAggrResult", reflect.TypeOf((*MockExptAggrResultService)(nil).UpdateExptAggrResult), arg0, arg1)
 }
@@ -59,8 +59,8 @@ func TestCompleteItemRun_FailBranch_InvokesNotifier(t *testing.T) {
 
 	failErr := errors.New("target timeout")
 	itemResultRepo.EXPECT().
-		UpdateItemRunLog(gomock.Any(), int64(1), int64(2), []int64{3}, gomock.Any(), int64(4)).
-		Return(nil)
+		UpdateItemRunLogByAttempt(gomock.Any(), int64(1), int64(2), int64(3), int32(0), gomock.Any(), int64(4)).
+		Return(true, nil)
 	notifier.EXPECT().
 		NotifyItemFail(gomock.Any(), gomock.Any(), int64(3), failErr).
 		Return(nil).
@@ -81,7 +81,7 @@ func TestCompleteItemRun_SuccessBranch_SkipsNotifier(t *testing.T) {
 	notifier := servicemocks.NewMockISandboxAgentNotifier(ctrl)
 	exec, itemResultRepo, _ := buildFailPathExecutor(t, ctrl, notifier)
 
-	itemResultRepo.EXPECT().UpdateItemRunLog(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	itemResultRepo.EXPECT().UpdateItemRunLogByAttempt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
 	// notifier.EXPECT() 不注册, 表示"未被调用"。
 
 	eiec := &entity.ExptItemEvalCtx{
@@ -97,7 +97,7 @@ func TestCompleteItemRun_FailBranch_NilNotifier(t *testing.T) {
 	defer ctrl.Finish()
 
 	exec, itemResultRepo, _ := buildFailPathExecutor(t, ctrl, nil)
-	itemResultRepo.EXPECT().UpdateItemRunLog(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	itemResultRepo.EXPECT().UpdateItemRunLogByAttempt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
 
 	eiec := &entity.ExptItemEvalCtx{
 		Event: &entity.ExptItemEvalEvent{ExptID: 1, ExptRunID: 2, EvalSetItemID: 3, SpaceID: 4, RetryTimes: 1},
@@ -114,7 +114,7 @@ func TestCompleteItemRun_FailBranch_NotifierErrIgnored(t *testing.T) {
 	notifier := servicemocks.NewMockISandboxAgentNotifier(ctrl)
 	exec, itemResultRepo, _ := buildFailPathExecutor(t, ctrl, notifier)
 
-	itemResultRepo.EXPECT().UpdateItemRunLog(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	itemResultRepo.EXPECT().UpdateItemRunLogByAttempt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
 	notifier.EXPECT().
 		NotifyItemFail(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(errors.New("send timeout"))
@@ -132,14 +132,14 @@ func TestNewExptItemEvaluation_VariadicNotifier(t *testing.T) {
 	defer ctrl.Finish()
 
 	notifier := servicemocks.NewMockISandboxAgentNotifier(ctrl)
-	inst := NewExptItemEvaluation(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, notifier)
+	inst := NewExptItemEvaluation(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, notifier)
 	require.NotNil(t, inst)
 	exec, ok := inst.(*ExptItemEvalCtxExecutor)
 	require.True(t, ok)
 	assert.Same(t, notifier, exec.sandboxAgentNotifier)
 
 	// 不传时应为 nil (兼容旧调用点)
-	inst2 := NewExptItemEvaluation(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	inst2 := NewExptItemEvaluation(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	exec2 := inst2.(*ExptItemEvalCtxExecutor)
 	assert.Nil(t, exec2.sandboxAgentNotifier)
 }
@@ -151,6 +151,7 @@ func TestStoreTurnRunResult_SandboxAgentWhitelist(t *testing.T) {
 
 	turnResultRepo := repomocks.NewMockIExptTurnResultRepo(ctrl)
 	configer := configermocks.NewMockIConfiger(ctrl)
+	configer.EXPECT().GetExptExecConf(gomock.Any(), gomock.Any()).Return(&entity.ExptExecConf{}).AnyTimes()
 	// Configer.GetErrCtrl 不应被调用: 沙箱 agent 分支跳过 ConvertErrMsg。
 	// 若被调用, gomock 会因未注册期望而 fail。
 
@@ -202,6 +203,7 @@ func TestStoreTurnRunResult_NonSandboxGoesThroughConvertErrMsg(t *testing.T) {
 	turnResultRepo := repomocks.NewMockIExptTurnResultRepo(ctrl)
 	configer := configermocks.NewMockIConfiger(ctrl)
 	configer.EXPECT().GetErrCtrl(gomock.Any()).Return(entity.DefaultExptErrCtrl()).Times(1)
+	configer.EXPECT().GetExptExecConf(gomock.Any(), gomock.Any()).Return(&entity.ExptExecConf{}).AnyTimes()
 
 	exec := &ExptItemEvalCtxExecutor{
 		TurnResultRepo: turnResultRepo,
@@ -243,7 +245,7 @@ func TestCompleteItemRun_FailBranch_NotifierReceivesExpectedArgs(t *testing.T) {
 	expt := sandboxExpt()
 	failErr := errors.New("failed reason")
 
-	itemResultRepo.EXPECT().UpdateItemRunLog(gomock.Any(), int64(11), int64(22), []int64{33}, gomock.Any(), int64(44)).Return(nil)
+	itemResultRepo.EXPECT().UpdateItemRunLogByAttempt(gomock.Any(), int64(11), int64(22), int64(33), int32(0), gomock.Any(), int64(44)).Return(true, nil)
 	notifier.EXPECT().
 		NotifyItemFail(gomock.Any(), gomock.AssignableToTypeOf(&entity.Experiment{}), int64(33), failErr).
 		DoAndReturn(func(_ context.Context, gotExpt *entity.Experiment, gotItemID int64, gotErr error) error {
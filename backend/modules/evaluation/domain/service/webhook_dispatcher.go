@@ -244,18 +244,24 @@ func buildWebhookPayload(event *entity.ExptLifecycleEvent, expt *entity.Experime
 	// deliveryID 直接透传 lifecycle 消息的 IdempotentKey，保证全链路幂等一致
 	deliveryID := event.IdempotentKey
 
+	data := map[string]interface{}{
+		"experiment_id":   strconv.FormatInt(expt.ID, 10),
+		"experiment_name": expt.Name,
+		"status":          eventTypeToStatus(eventType),
+		"progress":        progress,
+	}
+	// 配置了质量门禁的实验附带门禁结论，CI 流水线据 quality_gate.passed 单一判断是否放行
+	if expt.QualityGateResult != nil {
+		data["quality_gate"] = expt.QualityGateResult
+	}
+
 	return map[string]interface{}{
 		"delivery_id":   deliveryID,
 		"create_time":   time.Now().Format(time.RFC3339),
 		"event_type":    eventType,
 		"resource_type": "experiment",
 		"summary":       summary,
-		"data": map[string]interface{}{
-			"experiment_id":   strconv.FormatInt(expt.ID, 10),
-			"experiment_name": expt.Name,
-			"status":          eventTypeToStatus(eventType),
-			"progress":        progress,
-		},
+		"data":          data,
 	}
 }
 
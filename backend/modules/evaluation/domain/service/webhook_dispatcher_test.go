@@ -150,6 +150,26 @@ func TestBuildWebhookPayload_BitsUT(t *testing.T) {
 		expt := &entity.Experiment{ID: 3, Name: "expt-3"}
 		payload := buildWebhookPayload(event, expt)
 		assert.Equal(t, "expt_3_0_3_12", payload["delivery_id"])
+		_, ok := payload["data"].(map[string]interface{})["quality_gate"]
+		assert.False(t, ok)
+	})
+
+	t.Run("quality gate result attached to data", func(t *testing.T) {
+		t.Parallel()
+		event := &entity.ExptLifecycleEvent{ExptID: 4, FromStatus: entity.ExptStatus_Processing, ToStatus: entity.ExptStatus_Success}
+		gate := entity.NewExptQualityGateResult([]*entity.ExptQualityGateRuleResult{{
+			Rule:   &entity.ExptQualityGateRule{Metric: entity.QualityGateMetricFailRate, Operator: entity.QualityGateOperatorLT, Threshold: 0.02},
+			Actual: gptr.Of(0.05),
+			Status: entity.QualityGateStatusFailed,
+		}}, 1700000000000)
+		expt := &entity.Experiment{ID: 4, Name: "expt-4", QualityGateResult: gate}
+		payload := buildWebhookPayload(event, expt)
+		data := payload["data"].(map[string]interface{})
+		assert.Equal(t, gate, data["quality_gate"])
+
+		body, err := json.Marshal(payload)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), `"quality_gate":{"status":"failed","passed":false`)
 	})
 }
 
@@ -11,6 +11,7 @@ import (
 	mtr "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
 	evaluatormtr "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/metrics/evaluator"
 	rmqproducer "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/mq/rocket/producer"
 	evaluatorrepo "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/evaluator"
@@ -31,8 +32,17 @@ var ExperimentDomainServiceSet = wire.NewSet(
 	NewExptManager,
 	NewExptResultService,
 	NewExptAggrResultService,
+	NewExptSignificanceService,
+	NewExptSamplingService,
+	NewExptPairwiseRankService,
+	NewExptRegressionService,
+	NewExptGroupedAggrService,
+	NewExptQualityGateService,
+	NewExptResultCacheService,
+	NewExptConcurrencyController,
 	NewExptSchedulerSvc,
 	NewExptRecordEvalService,
+	NewExptUserSimulator,
 	NewExptAnnotateService,
 	NewExptResultExportService,
 	NewInsightAnalysisService,
@@ -71,7 +81,10 @@ var EvaluatorDomainServiceSet = wire.NewSet(
 	NewEvaluatorServiceImpl,
 	NewEvaluatorRecordServiceImpl,
 	NewEvaluatorScoreCalculator,
+	NewExptCostCalculator,
 	NewEvaluatorTemplateService,
+	NewEvaluatorCalibrationService,
+	NewEvaluatorPromotionService,
 	NewEvaluatorSourceServices,
 	NewCodeBuilderFactory,
 	evalconf.NewEvaluatorConfiger,
@@ -90,6 +103,10 @@ var EvaluationSetDomainServiceSet = wire.NewSet(
 	NewEvaluationSetItemServiceImpl,
 	NewEvaluationSetServiceImpl,
 	NewEvaluationSetSchemaServiceImpl,
+	NewEvaluationSetDedupService,
+	NewEvaluationSetVersionDiffService,
+	NewEvaluationSetSynthesisService,
+	NewEvaluationSetItemProvenanceService,
 	// Infrastructure Sets
 	data.DataRPCSet,
 )
@@ -113,6 +130,7 @@ func NewEvaluatorSourceServices(
 	config evalconf.IConfiger,
 	runtimeManager component.IRuntimeManager,
 	codeBuilderFactory CodeBuilderFactory,
+	evaluatorRepo repo.IEvaluatorRepo,
 ) map[entity.EvaluatorType]EvaluatorSourceService {
 	// 设置codeBuilderFactory的runtimeManager依赖
 	codeBuilderFactory.SetRuntimeManager(runtimeManager)
@@ -120,12 +138,20 @@ func NewEvaluatorSourceServices(
 	services := []EvaluatorSourceService{
 		NewEvaluatorSourcePromptServiceImpl(llmProvider, metric, config),
 		NewEvaluatorSourceCodeServiceImpl(runtimeManager, codeBuilderFactory, metric),
+		NewEvaluatorSourcePairwiseServiceImpl(llmProvider, metric),
+		NewEvaluatorSourceRuleServiceImpl(metric),
 	}
 
 	serviceMap := make(map[entity.EvaluatorType]EvaluatorSourceService)
 	for _, svc := range services {
 		serviceMap[svc.EvaluatorType()] = svc
 	}
+	// 组合评估器只能引用上面的非组合评估器, 组件服务表不含自身
+	componentServices := make(map[entity.EvaluatorType]EvaluatorSourceService, len(serviceMap))
+	for evaluatorType, svc := range serviceMap {
+		componentServices[evaluatorType] = svc
+	}
+	serviceMap[entity.EvaluatorTypeComposite] = NewEvaluatorSourceCompositeServiceImpl(evaluatorRepo, componentServices, metric)
 	return serviceMap
 }
 
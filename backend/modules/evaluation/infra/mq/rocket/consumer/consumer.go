@@ -27,7 +27,7 @@ func NewConsumerWorkers(
 		NewExptRecordEvalEventConsumer(NewExptRecordEvalConsumer(exptApp), loader),
 		NewExptAggrCalculateEventConsumer(NewAggrCalculateConsumer(exptApp), loader),
 		NewExptTurnResultFilterEventConsumer(NewExptTurnResultFilterConsumer(exptApp), loader),
-		NewExptExportEventConsumer(NewExptExportConsumer(exptApp, exptApp), loader),
+		NewExptExportEventConsumer(NewExptExportConsumer(exptApp, exptApp, exptApp, exptApp, exptApp), loader),
 		NewExptLifecycleEventConsumer(NewExptLifecycleConsumer(exptApp, webhookHandler), loader),
 	}, nil
 }
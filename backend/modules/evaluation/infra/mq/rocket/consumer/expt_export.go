@@ -18,12 +18,21 @@ import (
 type ExptExportConsumer struct {
 	exptResultExportService    service.IExptResultExportService
 	exptInsightAnalysisService service.IExptInsightAnalysisService
+	exptPairwiseRankService    service.ExptPairwiseRankService
+	calibrationService         service.EvaluatorCalibrationService
+	dedupService               service.EvaluationSetDedupService
 }
 
-func NewExptExportConsumer(exptResultExportService service.IExptResultExportService, exptInsightAnalysisService service.IExptInsightAnalysisService) mq.IConsumerHandler {
+func NewExptExportConsumer(exptResultExportService service.IExptResultExportService, exptInsightAnalysisService service.IExptInsightAnalysisService,
+	exptPairwiseRankService service.ExptPairwiseRankService, calibrationService service.EvaluatorCalibrationService,
+	dedupService service.EvaluationSetDedupService,
+) mq.IConsumerHandler {
 	return &ExptExportConsumer{
 		exptResultExportService:    exptResultExportService,
 		exptInsightAnalysisService: exptInsightAnalysisService,
+		exptPairwiseRankService:    exptPairwiseRankService,
+		calibrationService:         calibrationService,
+		dedupService:               dedupService,
 	}
 }
 
@@ -58,6 +67,24 @@ func (e *ExptExportConsumer) handleEvent(ctx context.Context, event *entity.Expo
 			logs.CtxError(ctx, "ExptExportConsumer GenAnalysisReport fail, expt_id:%v, err: %v", event.ExperimentID, err)
 			return nil
 		}
+	case entity.ExportScenePairwiseRank:
+		err = e.exptPairwiseRankService.HandleRankEvent(ctx, event.SpaceID, event.ExportID)
+		if err != nil {
+			logs.CtxError(ctx, "ExptExportConsumer HandleRankEvent fail, record_id:%v, err: %v", event.ExportID, err)
+			return nil
+		}
+	case entity.ExportSceneEvaluatorCalibration:
+		err = e.calibrationService.HandleCalibrationEvent(ctx, event.SpaceID, event.ExportID)
+		if err != nil {
+			logs.CtxError(ctx, "ExptExportConsumer HandleCalibrationEvent fail, record_id:%v, err: %v", event.ExportID, err)
+			return nil
+		}
+	case entity.ExportSceneEvaluationSetDedup:
+		err = e.dedupService.HandleDedupEvent(ctx, event.SpaceID, event.ExportID)
+		if err != nil {
+			logs.CtxError(ctx, "ExptExportConsumer HandleDedupEvent fail, job_id:%v, err: %v", event.ExportID, err)
+			return nil
+		}
 	default:
 		err = e.exptResultExportService.HandleExportEvent(ctx, event)
 		if err != nil {
@@ -0,0 +1,63 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package evaluator
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/evaluator/mysql"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/evaluator/mysql/convertor"
+)
+
+type EvaluatorCalibrationRepoImpl struct {
+	calibrationRecordDao mysql.EvaluatorCalibrationRecordDAO
+}
+
+func NewEvaluatorCalibrationRepo(calibrationRecordDao mysql.EvaluatorCalibrationRecordDAO) repo.IEvaluatorCalibrationRepo {
+	return &EvaluatorCalibrationRepoImpl{
+		calibrationRecordDao: calibrationRecordDao,
+	}
+}
+
+func (r *EvaluatorCalibrationRepoImpl) CreateCalibrationRecord(ctx context.Context, record *entity.EvaluatorCalibrationRecord) error {
+	po, err := convertor.ConvertEvaluatorCalibrationRecordDO2PO(record)
+	if err != nil {
+		return err
+	}
+	return r.calibrationRecordDao.Create(ctx, po)
+}
+
+func (r *EvaluatorCalibrationRepoImpl) UpdateCalibrationResult(ctx context.Context, record *entity.EvaluatorCalibrationRecord) error {
+	result, errMsg, err := convertor.ConvertEvaluatorCalibrationResultDO2PO(record)
+	if err != nil {
+		return err
+	}
+	return r.calibrationRecordDao.UpdateResult(ctx, record.SpaceID, record.ID, int32(record.Status), result, errMsg)
+}
+
+func (r *EvaluatorCalibrationRepoImpl) GetCalibrationRecord(ctx context.Context, spaceID, recordID int64) (*entity.EvaluatorCalibrationRecord, error) {
+	po, err := r.calibrationRecordDao.Get(ctx, spaceID, recordID)
+	if err != nil {
+		return nil, err
+	}
+	return convertor.ConvertEvaluatorCalibrationRecordPO2DO(po)
+}
+
+func (r *EvaluatorCalibrationRepoImpl) ListCalibrationRecords(ctx context.Context, spaceID, evaluatorID int64, evaluatorVersionIDs []int64) ([]*entity.EvaluatorCalibrationRecord, error) {
+	pos, err := r.calibrationRecordDao.List(ctx, spaceID, evaluatorID, evaluatorVersionIDs)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*entity.EvaluatorCalibrationRecord, 0, len(pos))
+	for _, po := range pos {
+		do, err := convertor.ConvertEvaluatorCalibrationRecordPO2DO(po)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, do)
+	}
+	return records, nil
+}
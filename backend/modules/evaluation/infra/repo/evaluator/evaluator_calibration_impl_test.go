@@ -0,0 +1,77 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package evaluator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/evaluator/mysql/gorm_gen/model"
+	evaluatormocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/evaluator/mysql/mocks"
+)
+
+func TestEvaluatorCalibrationRepoImpl(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	dao := evaluatormocks.NewMockEvaluatorCalibrationRecordDAO(ctrl)
+	r := NewEvaluatorCalibrationRepo(dao)
+	ctx := context.Background()
+
+	record := &entity.EvaluatorCalibrationRecord{
+		ID:                 1,
+		SpaceID:            100,
+		EvaluatorID:        10,
+		EvaluatorVersionID: 11,
+		EvaluationSetID:    3,
+		Status:             entity.EvaluatorCalibrationStatus_Running,
+		Conf:               &entity.EvaluatorCalibrationConf{GroundTruthField: "human_score"},
+		BaseInfo:           &entity.BaseInfo{CreatedBy: &entity.UserInfo{UserID: gptr.Of("u1")}},
+	}
+
+	var saved *model.EvaluatorCalibrationRecord
+	dao.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, po *model.EvaluatorCalibrationRecord, _ ...any) error {
+		saved = po
+		return nil
+	})
+	assert.NoError(t, r.CreateCalibrationRecord(ctx, record))
+	assert.Equal(t, "u1", saved.CreatedBy)
+	assert.Nil(t, saved.Result)
+
+	record.Status = entity.EvaluatorCalibrationStatus_Success
+	record.Result = &entity.EvaluatorCalibrationResult{ItemCnt: 2, PairedCnt: 2, MeanAbsoluteError: gptr.Of(0.5)}
+	dao.EXPECT().UpdateResult(gomock.Any(), int64(100), int64(1), int32(entity.EvaluatorCalibrationStatus_Success), gomock.Not(gomock.Nil()), gomock.Nil()).
+		DoAndReturn(func(_ context.Context, _, _ int64, status int32, result, _ *[]byte, _ ...any) error {
+			saved.Status, saved.Result = status, result
+			return nil
+		})
+	assert.NoError(t, r.UpdateCalibrationResult(ctx, record))
+
+	dao.EXPECT().Get(gomock.Any(), int64(100), int64(1)).Return(saved, nil)
+	got, err := r.GetCalibrationRecord(ctx, 100, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.EvaluatorCalibrationStatus_Success, got.Status)
+	assert.Equal(t, "human_score", got.Conf.GroundTruthField)
+	assert.Equal(t, 2, got.Result.PairedCnt)
+	assert.Equal(t, "u1", gptr.Indirect(got.BaseInfo.CreatedBy.UserID))
+
+	dao.EXPECT().List(gomock.Any(), int64(100), int64(10), []int64{11}).Return([]*model.EvaluatorCalibrationRecord{saved}, nil)
+	list, err := r.ListCalibrationRecords(ctx, 100, 10, []int64{11})
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	dao.EXPECT().List(gomock.Any(), int64(100), int64(10), gomock.Nil()).Return(nil, errors.New("db error"))
+	_, err = r.ListCalibrationRecords(ctx, 100, 10, nil)
+	assert.Error(t, err)
+
+	dao.EXPECT().Get(gomock.Any(), int64(100), int64(2)).Return(nil, nil)
+	got, err = r.GetCalibrationRecord(ctx, 100, 2)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
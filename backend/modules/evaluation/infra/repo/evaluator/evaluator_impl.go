@@ -311,6 +311,42 @@ func (r *EvaluatorRepoImpl) BatchGetEvaluatorByVersionID(ctx context.Context, sp
 				r.setEvaluatorTags(evaluatorDO, evaluatorVersionPO.EvaluatorID, tagsBySourceID)
 			}
 			evaluatorDOList = append(evaluatorDOList, evaluatorDO)
+		case int32(entity.EvaluatorTypePairwise):
+			evaluatorVersionDO, err := convertor.ConvertEvaluatorVersionPO2DO(evaluatorVersionPO)
+			if err != nil {
+				return nil, err
+			}
+			evaluatorDO := convertor.ConvertEvaluatorPO2DO(evaluatorPO)
+			evaluatorDO.PairwiseEvaluatorVersion = evaluatorVersionDO.PairwiseEvaluatorVersion
+			evaluatorDO.EvaluatorType = entity.EvaluatorTypePairwise
+			if withTags {
+				r.setEvaluatorTags(evaluatorDO, evaluatorVersionPO.EvaluatorID, tagsBySourceID)
+			}
+			evaluatorDOList = append(evaluatorDOList, evaluatorDO)
+		case int32(entity.EvaluatorTypeRule):
+			evaluatorVersionDO, err := convertor.ConvertEvaluatorVersionPO2DO(evaluatorVersionPO)
+			if err != nil {
+				return nil, err
+			}
+			evaluatorDO := convertor.ConvertEvaluatorPO2DO(evaluatorPO)
+			evaluatorDO.RuleEvaluatorVersion = evaluatorVersionDO.RuleEvaluatorVersion
+			evaluatorDO.EvaluatorType = entity.EvaluatorTypeRule
+			if withTags {
+				r.setEvaluatorTags(evaluatorDO, evaluatorVersionPO.EvaluatorID, tagsBySourceID)
+			}
+			evaluatorDOList = append(evaluatorDOList, evaluatorDO)
+		case int32(entity.EvaluatorTypeComposite):
+			evaluatorVersionDO, err := convertor.ConvertEvaluatorVersionPO2DO(evaluatorVersionPO)
+			if err != nil {
+				return nil, err
+			}
+			evaluatorDO := convertor.ConvertEvaluatorPO2DO(evaluatorPO)
+			evaluatorDO.CompositeEvaluatorVersion = evaluatorVersionDO.CompositeEvaluatorVersion
+			evaluatorDO.EvaluatorType = entity.EvaluatorTypeComposite
+			if withTags {
+				r.setEvaluatorTags(evaluatorDO, evaluatorVersionPO.EvaluatorID, tagsBySourceID)
+			}
+			evaluatorDOList = append(evaluatorDOList, evaluatorDO)
 		default:
 			continue
 		}
@@ -0,0 +1,39 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package evaluator
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/evaluator/mysql"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/evaluator/mysql/convertor"
+)
+
+type EvaluatorPromotionPolicyRepoImpl struct {
+	promotionPolicyDao mysql.EvaluatorPromotionPolicyDAO
+}
+
+func NewEvaluatorPromotionPolicyRepo(promotionPolicyDao mysql.EvaluatorPromotionPolicyDAO) repo.IEvaluatorPromotionPolicyRepo {
+	return &EvaluatorPromotionPolicyRepoImpl{
+		promotionPolicyDao: promotionPolicyDao,
+	}
+}
+
+func (r *EvaluatorPromotionPolicyRepoImpl) SavePromotionPolicy(ctx context.Context, policy *entity.EvaluatorPromotionPolicy) error {
+	po, err := convertor.ConvertEvaluatorPromotionPolicyDO2PO(policy)
+	if err != nil {
+		return err
+	}
+	return r.promotionPolicyDao.Upsert(ctx, po)
+}
+
+func (r *EvaluatorPromotionPolicyRepoImpl) GetPromotionPolicy(ctx context.Context, spaceID, evaluatorID int64) (*entity.EvaluatorPromotionPolicy, error) {
+	po, err := r.promotionPolicyDao.Get(ctx, spaceID, evaluatorID)
+	if err != nil {
+		return nil, err
+	}
+	return convertor.ConvertEvaluatorPromotionPolicyPO2DO(po)
+}
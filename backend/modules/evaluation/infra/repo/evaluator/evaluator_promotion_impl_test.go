@@ -0,0 +1,67 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package evaluator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/evaluator/mysql/gorm_gen/model"
+	evaluatormocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/evaluator/mysql/mocks"
+)
+
+func TestEvaluatorPromotionPolicyRepoImpl(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	dao := evaluatormocks.NewMockEvaluatorPromotionPolicyDAO(ctrl)
+	r := NewEvaluatorPromotionPolicyRepo(dao)
+	ctx := context.Background()
+
+	policy := &entity.EvaluatorPromotionPolicy{
+		ID:          1,
+		SpaceID:     100,
+		EvaluatorID: 10,
+		Conf: &entity.EvaluatorPromotionConf{
+			Enabled:     true,
+			Action:      entity.EvaluatorPromotionAction_Block,
+			MaxFlipRate: gptr.Of(0.1),
+		},
+		BaseInfo: &entity.BaseInfo{
+			CreatedBy: &entity.UserInfo{UserID: gptr.Of("u1")},
+			UpdatedBy: &entity.UserInfo{UserID: gptr.Of("u2")},
+		},
+	}
+
+	var saved *model.EvaluatorPromotionPolicy
+	dao.EXPECT().Upsert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, po *model.EvaluatorPromotionPolicy, _ ...any) error {
+		saved = po
+		return nil
+	})
+	assert.NoError(t, r.SavePromotionPolicy(ctx, policy))
+	assert.Equal(t, "u1", saved.CreatedBy)
+	assert.Equal(t, "u2", saved.UpdatedBy)
+
+	dao.EXPECT().Get(gomock.Any(), int64(100), int64(10)).Return(saved, nil)
+	got, err := r.GetPromotionPolicy(ctx, 100, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.EvaluatorPromotionAction_Block, got.Conf.Action)
+	assert.True(t, got.Conf.Enabled)
+	assert.Equal(t, 0.1, *got.Conf.MaxFlipRate)
+	assert.Nil(t, got.Conf.MaxMeanAbsDrift)
+
+	dao.EXPECT().Get(gomock.Any(), int64(100), int64(11)).Return(nil, nil)
+	got, err = r.GetPromotionPolicy(ctx, 100, 11)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	dao.EXPECT().Get(gomock.Any(), int64(100), int64(12)).Return(nil, errors.New("db error"))
+	_, err = r.GetPromotionPolicy(ctx, 100, 12)
+	assert.Error(t, err)
+}
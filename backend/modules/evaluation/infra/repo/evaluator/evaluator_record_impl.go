@@ -160,6 +160,31 @@ func (r *EvaluatorRecordRepoImpl) BatchGetEvaluatorRecordForAggr(ctx context.Con
 	return aggrRecords, nil
 }
 
+func (r *EvaluatorRecordRepoImpl) ListSuccessEvaluatorRecordByVersionID(ctx context.Context, spaceID, evaluatorVersionID int64, limit int) ([]*entity.EvaluatorRecord, error) {
+	if limit <= 0 {
+		return []*entity.EvaluatorRecord{}, nil
+	}
+	pos, err := r.evaluatorRecordDao.ListSuccessEvaluatorRecordByVersionID(ctx, spaceID, evaluatorVersionID, limit)
+	if err != nil {
+		return nil, err
+	}
+	evaluatorRecords := make([]*entity.EvaluatorRecord, 0, len(pos))
+	for _, po := range pos {
+		evaluatorRecord, err := convertor.ConvertEvaluatorRecordPO2DO(po)
+		if err != nil {
+			return nil, err
+		}
+		// 记录用于回放, 需要完整输入
+		if r.recordDataStorage != nil {
+			if err := r.recordDataStorage.LoadEvaluatorRecordData(ctx, evaluatorRecord); err != nil {
+				return nil, err
+			}
+		}
+		evaluatorRecords = append(evaluatorRecords, evaluatorRecord)
+	}
+	return evaluatorRecords, nil
+}
+
 func evaluatorRecordResultValues(outputData *entity.EvaluatorOutputData) (score float64, outputDataStr string) {
 	if outputData != nil && outputData.EvaluatorResult != nil && outputData.EvaluatorResult.Score != nil {
 		score = *outputData.EvaluatorResult.Score
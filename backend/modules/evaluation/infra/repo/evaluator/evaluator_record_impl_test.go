@@ -117,6 +117,10 @@ func (f *fakeEvaluatorRecordStorageConfiger) GetExptTurnScoreHookConf(ctx contex
 	return nil, false
 }
 
+func (f *fakeEvaluatorRecordStorageConfiger) GetModelPriceConf(ctx context.Context) *entity.ModelPriceConf {
+	return nil
+}
+
 func (f *fakeEvaluatorRecordStorageConfiger) GetSandboxAgentNotifyConf(ctx context.Context) *entity.SandboxAgentNotifyConf {
 	return nil
 }
@@ -1060,6 +1064,46 @@ func TestEvaluatorRecordRepoImpl_BatchGetEvaluatorRecordForAggr(t *testing.T) {
 	})
 }
 
+func TestEvaluatorRecordRepoImpl_ListSuccessEvaluatorRecordByVersionID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEvaluatorRecordDAO := evaluatormocks.NewMockEvaluatorRecordDAO(ctrl)
+	repo := &EvaluatorRecordRepoImpl{evaluatorRecordDao: mockEvaluatorRecordDAO}
+
+	t.Run("limit 非正数直接返回空, 不打 DAO", func(t *testing.T) {
+		got, err := repo.ListSuccessEvaluatorRecordByVersionID(context.Background(), 100, 11, 0)
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("正常映射 PO->DO", func(t *testing.T) {
+		s := 0.5
+		mockEvaluatorRecordDAO.EXPECT().
+			ListSuccessEvaluatorRecordByVersionID(gomock.Any(), int64(100), int64(11), 2).
+			Return([]*model.EvaluatorRecord{
+				{ID: 2, SpaceID: 100, EvaluatorVersionID: 11, Score: &s, Status: int32(entity.EvaluatorRunStatusSuccess)},
+				{ID: 1, SpaceID: 100, EvaluatorVersionID: 11, Score: &s, Status: int32(entity.EvaluatorRunStatusSuccess)},
+			}, nil)
+
+		got, err := repo.ListSuccessEvaluatorRecordByVersionID(context.Background(), 100, 11, 2)
+		assert.NoError(t, err)
+		if assert.Len(t, got, 2) {
+			assert.Equal(t, int64(2), got[0].ID)
+			assert.Equal(t, int64(11), got[1].EvaluatorVersionID)
+		}
+	})
+
+	t.Run("DAO 报错向上抛", func(t *testing.T) {
+		mockEvaluatorRecordDAO.EXPECT().
+			ListSuccessEvaluatorRecordByVersionID(gomock.Any(), int64(100), int64(12), 5).
+			Return(nil, errors.New("dao err"))
+
+		_, err := repo.ListSuccessEvaluatorRecordByVersionID(context.Background(), 100, 12, 5)
+		assert.Error(t, err)
+	})
+}
+
 func TestEvaluatorRecordRepoImpl_CompareAndSwapEvaluatorRecordResult(t *testing.T) {
 	t.Parallel()
 
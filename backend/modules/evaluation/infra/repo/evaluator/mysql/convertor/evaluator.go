@@ -112,7 +112,10 @@ func ConvertEvaluatorVersionDO2PO(do *evaluatordo.Evaluator) (*model.EvaluatorVe
 	if (do.EvaluatorType == evaluatordo.EvaluatorTypePrompt && do.PromptEvaluatorVersion == nil) ||
 		(do.EvaluatorType == evaluatordo.EvaluatorTypeCode && do.CodeEvaluatorVersion == nil) ||
 		(do.EvaluatorType == evaluatordo.EvaluatorTypeCustomRPC && do.CustomRPCEvaluatorVersion == nil) ||
-		(do.EvaluatorType == evaluatordo.EvaluatorTypeAgent && do.AgentEvaluatorVersion == nil) {
+		(do.EvaluatorType == evaluatordo.EvaluatorTypeAgent && do.AgentEvaluatorVersion == nil) ||
+		(do.EvaluatorType == evaluatordo.EvaluatorTypePairwise && do.PairwiseEvaluatorVersion == nil) ||
+		(do.EvaluatorType == evaluatordo.EvaluatorTypeRule && do.RuleEvaluatorVersion == nil) ||
+		(do.EvaluatorType == evaluatordo.EvaluatorTypeComposite && do.CompositeEvaluatorVersion == nil) {
 		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("evaluator version content is required for the given evaluator type"))
 	}
 
@@ -216,6 +219,48 @@ func ConvertEvaluatorVersionDO2PO(do *evaluatordo.Evaluator) (*model.EvaluatorVe
 		po.Metainfo = ptr.Of(metaInfoByte)
 		po.ReceiveChatHistory = nil
 		po.ID = do.AgentEvaluatorVersion.ID
+	case evaluatordo.EvaluatorTypePairwise:
+		metaInfoByte, err := json.Marshal(do.PairwiseEvaluatorVersion)
+		if err != nil {
+			return nil, err
+		}
+		inputSchemaByte, err := json.Marshal(do.PairwiseEvaluatorVersion.InputSchemas)
+		if err != nil {
+			return nil, err
+		}
+
+		po.InputSchema = ptr.Of(inputSchemaByte)
+		po.Metainfo = ptr.Of(metaInfoByte)
+		po.ReceiveChatHistory = nil
+		po.ID = do.PairwiseEvaluatorVersion.ID
+	case evaluatordo.EvaluatorTypeRule:
+		metaInfoByte, err := json.Marshal(do.RuleEvaluatorVersion)
+		if err != nil {
+			return nil, err
+		}
+		inputSchemaByte, err := json.Marshal(do.RuleEvaluatorVersion.InputSchemas)
+		if err != nil {
+			return nil, err
+		}
+
+		po.InputSchema = ptr.Of(inputSchemaByte)
+		po.Metainfo = ptr.Of(metaInfoByte)
+		po.ReceiveChatHistory = nil
+		po.ID = do.RuleEvaluatorVersion.ID
+	case evaluatordo.EvaluatorTypeComposite:
+		metaInfoByte, err := json.Marshal(do.CompositeEvaluatorVersion)
+		if err != nil {
+			return nil, err
+		}
+		inputSchemaByte, err := json.Marshal(do.CompositeEvaluatorVersion.InputSchemas)
+		if err != nil {
+			return nil, err
+		}
+
+		po.InputSchema = ptr.Of(inputSchemaByte)
+		po.Metainfo = ptr.Of(metaInfoByte)
+		po.ReceiveChatHistory = nil
+		po.ID = do.CompositeEvaluatorVersion.ID
 	default:
 		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("unsupported evaluator type: %d", do.EvaluatorType)))
 	}
@@ -308,6 +353,45 @@ func ConvertEvaluatorVersionPO2DO(po *model.EvaluatorVersion) (*evaluatordo.Eval
 				do.AgentEvaluatorVersion.OutputSchemas = outputSchemas
 			}
 		}
+	case evaluatordo.EvaluatorTypePairwise:
+		do.PairwiseEvaluatorVersion = &evaluatordo.PairwiseEvaluatorVersion{}
+		if po.Metainfo != nil {
+			if err := json.Unmarshal(*po.Metainfo, do.PairwiseEvaluatorVersion); err != nil {
+				return nil, err
+			}
+		}
+		if po.InputSchema != nil {
+			var inputSchemas []*evaluatordo.ArgsSchema
+			if err := json.Unmarshal(*po.InputSchema, &inputSchemas); err == nil {
+				do.PairwiseEvaluatorVersion.InputSchemas = inputSchemas
+			}
+		}
+	case evaluatordo.EvaluatorTypeRule:
+		do.RuleEvaluatorVersion = &evaluatordo.RuleEvaluatorVersion{}
+		if po.Metainfo != nil {
+			if err := json.Unmarshal(*po.Metainfo, do.RuleEvaluatorVersion); err != nil {
+				return nil, err
+			}
+		}
+		if po.InputSchema != nil {
+			var inputSchemas []*evaluatordo.ArgsSchema
+			if err := json.Unmarshal(*po.InputSchema, &inputSchemas); err == nil {
+				do.RuleEvaluatorVersion.InputSchemas = inputSchemas
+			}
+		}
+	case evaluatordo.EvaluatorTypeComposite:
+		do.CompositeEvaluatorVersion = &evaluatordo.CompositeEvaluatorVersion{}
+		if po.Metainfo != nil {
+			if err := json.Unmarshal(*po.Metainfo, do.CompositeEvaluatorVersion); err != nil {
+				return nil, err
+			}
+		}
+		if po.InputSchema != nil {
+			var inputSchemas []*evaluatordo.ArgsSchema
+			if err := json.Unmarshal(*po.InputSchema, &inputSchemas); err == nil {
+				do.CompositeEvaluatorVersion.InputSchemas = inputSchemas
+			}
+		}
 	}
 	do.SetEvaluatorVersionID(po.ID)
 	do.SetVersion(po.Version)
@@ -0,0 +1,98 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/evaluator/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+func ConvertEvaluatorCalibrationRecordDO2PO(do *entity.EvaluatorCalibrationRecord) (*model.EvaluatorCalibrationRecord, error) {
+	if do == nil {
+		return nil, nil
+	}
+	po := &model.EvaluatorCalibrationRecord{
+		ID:                     do.ID,
+		SpaceID:                do.SpaceID,
+		EvaluatorID:            do.EvaluatorID,
+		EvaluatorVersionID:     do.EvaluatorVersionID,
+		EvaluatorVersion:       do.EvaluatorVersion,
+		EvaluationSetID:        do.EvaluationSetID,
+		EvaluationSetVersionID: do.EvaluationSetVersionID,
+		Status:                 int32(do.Status),
+	}
+	if do.Conf != nil {
+		bytes, err := json.Marshal(do.Conf)
+		if err != nil {
+			return nil, err
+		}
+		po.Conf = gptr.Of(bytes)
+	}
+	result, errMsg, err := ConvertEvaluatorCalibrationResultDO2PO(do)
+	if err != nil {
+		return nil, err
+	}
+	po.Result, po.ErrMsg = result, errMsg
+	if do.BaseInfo != nil && do.BaseInfo.CreatedBy != nil {
+		po.CreatedBy = gptr.Indirect(do.BaseInfo.CreatedBy.UserID)
+	}
+	return po, nil
+}
+
+// ConvertEvaluatorCalibrationResultDO2PO 序列化校准结果与错误信息, 为空时返回 nil
+func ConvertEvaluatorCalibrationResultDO2PO(do *entity.EvaluatorCalibrationRecord) (result, errMsg *[]byte, err error) {
+	if do.Result != nil {
+		bytes, err := json.Marshal(do.Result)
+		if err != nil {
+			return nil, nil, err
+		}
+		result = gptr.Of(bytes)
+	}
+	if len(do.ErrMsg) > 0 {
+		errMsg = gptr.Of([]byte(do.ErrMsg))
+	}
+	return result, errMsg, nil
+}
+
+func ConvertEvaluatorCalibrationRecordPO2DO(po *model.EvaluatorCalibrationRecord) (*entity.EvaluatorCalibrationRecord, error) {
+	if po == nil {
+		return nil, nil
+	}
+	do := &entity.EvaluatorCalibrationRecord{
+		ID:                     po.ID,
+		SpaceID:                po.SpaceID,
+		EvaluatorID:            po.EvaluatorID,
+		EvaluatorVersionID:     po.EvaluatorVersionID,
+		EvaluatorVersion:       po.EvaluatorVersion,
+		EvaluationSetID:        po.EvaluationSetID,
+		EvaluationSetVersionID: po.EvaluationSetVersionID,
+		Status:                 entity.EvaluatorCalibrationStatus(po.Status),
+		BaseInfo: &entity.BaseInfo{
+			CreatedBy: &entity.UserInfo{UserID: gptr.Of(po.CreatedBy)},
+			CreatedAt: gptr.Of(po.CreatedAt.UnixMilli()),
+			UpdatedAt: gptr.Of(po.UpdatedAt.UnixMilli()),
+		},
+	}
+	if po.Conf != nil && len(*po.Conf) > 0 {
+		conf := &entity.EvaluatorCalibrationConf{}
+		if err := json.Unmarshal(*po.Conf, conf); err != nil {
+			return nil, err
+		}
+		do.Conf = conf
+	}
+	if po.Result != nil && len(*po.Result) > 0 {
+		result := &entity.EvaluatorCalibrationResult{}
+		if err := json.Unmarshal(*po.Result, result); err != nil {
+			return nil, err
+		}
+		do.Result = result
+	}
+	if po.ErrMsg != nil {
+		do.ErrMsg = string(*po.ErrMsg)
+	}
+	return do, nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/evaluator/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+func ConvertEvaluatorPromotionPolicyDO2PO(do *entity.EvaluatorPromotionPolicy) (*model.EvaluatorPromotionPolicy, error) {
+	if do == nil {
+		return nil, nil
+	}
+	po := &model.EvaluatorPromotionPolicy{
+		ID:          do.ID,
+		SpaceID:     do.SpaceID,
+		EvaluatorID: do.EvaluatorID,
+	}
+	if do.Conf != nil {
+		bytes, err := json.Marshal(do.Conf)
+		if err != nil {
+			return nil, err
+		}
+		po.Conf = gptr.Of(bytes)
+	}
+	if do.BaseInfo != nil {
+		if do.BaseInfo.CreatedBy != nil {
+			po.CreatedBy = gptr.Indirect(do.BaseInfo.CreatedBy.UserID)
+		}
+		if do.BaseInfo.UpdatedBy != nil {
+			po.UpdatedBy = gptr.Indirect(do.BaseInfo.UpdatedBy.UserID)
+		}
+	}
+	return po, nil
+}
+
+func ConvertEvaluatorPromotionPolicyPO2DO(po *model.EvaluatorPromotionPolicy) (*entity.EvaluatorPromotionPolicy, error) {
+	if po == nil {
+		return nil, nil
+	}
+	do := &entity.EvaluatorPromotionPolicy{
+		ID:          po.ID,
+		SpaceID:     po.SpaceID,
+		EvaluatorID: po.EvaluatorID,
+		BaseInfo: &entity.BaseInfo{
+			CreatedBy: &entity.UserInfo{UserID: gptr.Of(po.CreatedBy)},
+			UpdatedBy: &entity.UserInfo{UserID: gptr.Of(po.UpdatedBy)},
+			CreatedAt: gptr.Of(po.CreatedAt.UnixMilli()),
+			UpdatedAt: gptr.Of(po.UpdatedAt.UnixMilli()),
+		},
+	}
+	if po.Conf != nil && len(*po.Conf) > 0 {
+		conf := &entity.EvaluatorPromotionConf{}
+		if err := json.Unmarshal(*po.Conf, conf); err != nil {
+			return nil, err
+		}
+		do.Conf = conf
+	}
+	return do, nil
+}
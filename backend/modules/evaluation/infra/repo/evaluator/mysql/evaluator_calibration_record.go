@@ -0,0 +1,95 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/evaluator/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/contexts"
+)
+
+// EvaluatorCalibrationRecordDAO 定义 EvaluatorCalibrationRecord 的 Dao 接口
+//
+//go:generate mockgen -destination mocks/evaluator_calibration_record_mock.go -package=mocks . EvaluatorCalibrationRecordDAO
+type EvaluatorCalibrationRecordDAO interface {
+	Create(ctx context.Context, po *model.EvaluatorCalibrationRecord, opts ...db.Option) error
+	// UpdateResult 更新校准状态、结果与错误信息
+	UpdateResult(ctx context.Context, spaceID, id int64, status int32, result, errMsg *[]byte, opts ...db.Option) error
+	Get(ctx context.Context, spaceID, id int64, opts ...db.Option) (*model.EvaluatorCalibrationRecord, error)
+	// List 按评估器查询校准记录, evaluatorVersionIDs 非空时只取指定版本, 按 id 倒序
+	List(ctx context.Context, spaceID, evaluatorID int64, evaluatorVersionIDs []int64, opts ...db.Option) ([]*model.EvaluatorCalibrationRecord, error)
+}
+
+var (
+	evaluatorCalibrationRecordDaoOnce      = sync.Once{}
+	singletonEvaluatorCalibrationRecordDao EvaluatorCalibrationRecordDAO
+)
+
+type EvaluatorCalibrationRecordDAOImpl struct {
+	provider db.Provider
+}
+
+func NewEvaluatorCalibrationRecordDAO(p db.Provider) EvaluatorCalibrationRecordDAO {
+	evaluatorCalibrationRecordDaoOnce.Do(func() {
+		singletonEvaluatorCalibrationRecordDao = &EvaluatorCalibrationRecordDAOImpl{
+			provider: p,
+		}
+	})
+	return singletonEvaluatorCalibrationRecordDao
+}
+
+func (dao *EvaluatorCalibrationRecordDAOImpl) Create(ctx context.Context, po *model.EvaluatorCalibrationRecord, opts ...db.Option) error {
+	dbsession := dao.provider.NewSession(ctx, opts...)
+	return dbsession.WithContext(ctx).Create(po).Error
+}
+
+func (dao *EvaluatorCalibrationRecordDAOImpl) UpdateResult(ctx context.Context, spaceID, id int64, status int32, result, errMsg *[]byte, opts ...db.Option) error {
+	dbsession := dao.provider.NewSession(ctx, opts...)
+	return dbsession.WithContext(ctx).
+		Model(&model.EvaluatorCalibrationRecord{}).
+		Where("id = ? AND space_id = ?", id, spaceID).
+		Updates(map[string]interface{}{
+			"status":  status,
+			"result":  result,
+			"err_msg": errMsg,
+		}).Error
+}
+
+func (dao *EvaluatorCalibrationRecordDAOImpl) Get(ctx context.Context, spaceID, id int64, opts ...db.Option) (*model.EvaluatorCalibrationRecord, error) {
+	po := &model.EvaluatorCalibrationRecord{}
+	dbsession := dao.provider.NewSession(ctx, opts...)
+
+	query := dbsession.WithContext(ctx).Where("id = ? AND space_id = ?", id, spaceID)
+	if contexts.CtxWriteDB(ctx) {
+		query = query.Clauses(dbresolver.Write)
+	}
+	if err := query.First(po).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return po, nil
+}
+
+func (dao *EvaluatorCalibrationRecordDAOImpl) List(ctx context.Context, spaceID, evaluatorID int64, evaluatorVersionIDs []int64, opts ...db.Option) ([]*model.EvaluatorCalibrationRecord, error) {
+	var pos []*model.EvaluatorCalibrationRecord
+	dbsession := dao.provider.NewSession(ctx, opts...)
+
+	query := dbsession.WithContext(ctx).Where("space_id = ? AND evaluator_id = ?", spaceID, evaluatorID)
+	if len(evaluatorVersionIDs) > 0 {
+		query = query.Where("evaluator_version_id IN (?)", evaluatorVersionIDs)
+	}
+	if err := query.Order("id DESC").Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return pos, nil
+}
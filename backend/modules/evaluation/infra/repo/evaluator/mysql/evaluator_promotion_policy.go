@@ -0,0 +1,72 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/evaluator/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/contexts"
+)
+
+// EvaluatorPromotionPolicyDAO 定义 EvaluatorPromotionPolicy 的 Dao 接口
+//
+//go:generate mockgen -destination mocks/evaluator_promotion_policy_mock.go -package=mocks . EvaluatorPromotionPolicyDAO
+type EvaluatorPromotionPolicyDAO interface {
+	// Upsert 按 space_id + evaluator_id 唯一键写入, 已存在时覆盖配置与更新人
+	Upsert(ctx context.Context, po *model.EvaluatorPromotionPolicy, opts ...db.Option) error
+	Get(ctx context.Context, spaceID, evaluatorID int64, opts ...db.Option) (*model.EvaluatorPromotionPolicy, error)
+}
+
+var (
+	evaluatorPromotionPolicyDaoOnce      = sync.Once{}
+	singletonEvaluatorPromotionPolicyDao EvaluatorPromotionPolicyDAO
+)
+
+type EvaluatorPromotionPolicyDAOImpl struct {
+	provider db.Provider
+}
+
+func NewEvaluatorPromotionPolicyDAO(p db.Provider) EvaluatorPromotionPolicyDAO {
+	evaluatorPromotionPolicyDaoOnce.Do(func() {
+		singletonEvaluatorPromotionPolicyDao = &EvaluatorPromotionPolicyDAOImpl{
+			provider: p,
+		}
+	})
+	return singletonEvaluatorPromotionPolicyDao
+}
+
+func (dao *EvaluatorPromotionPolicyDAOImpl) Upsert(ctx context.Context, po *model.EvaluatorPromotionPolicy, opts ...db.Option) error {
+	dbsession := dao.provider.NewSession(ctx, opts...)
+	return dbsession.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "space_id"}, {Name: "evaluator_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"conf", "updated_by"}),
+		}).
+		Create(po).Error
+}
+
+func (dao *EvaluatorPromotionPolicyDAOImpl) Get(ctx context.Context, spaceID, evaluatorID int64, opts ...db.Option) (*model.EvaluatorPromotionPolicy, error) {
+	po := &model.EvaluatorPromotionPolicy{}
+	dbsession := dao.provider.NewSession(ctx, opts...)
+
+	query := dbsession.WithContext(ctx).Where("space_id = ? AND evaluator_id = ?", spaceID, evaluatorID)
+	if contexts.CtxWriteDB(ctx) {
+		query = query.Clauses(dbresolver.Write)
+	}
+	if err := query.First(po).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return po, nil
+}
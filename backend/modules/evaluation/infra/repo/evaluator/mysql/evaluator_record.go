@@ -31,6 +31,8 @@ type EvaluatorRecordDAO interface {
 	// BatchGetEvaluatorRecordForAggr 聚合专用窄查询: 只 SELECT id, score, status, 不取 input_data/output_data/ext
 	// 三个 mediumblob, 且只返回 status=Success 且 score 非 NULL 的行 (与内存聚合的 contributing 集一致)。
 	BatchGetEvaluatorRecordForAggr(ctx context.Context, evaluatorRecordIDs []int64, opts ...db.Option) ([]*model.EvaluatorRecord, error)
+	// ListSuccessEvaluatorRecordByVersionID 按 id 倒序取评估器版本最近 limit 条运行成功且有得分的记录
+	ListSuccessEvaluatorRecordByVersionID(ctx context.Context, spaceID, evaluatorVersionID int64, limit int, opts ...db.Option) ([]*model.EvaluatorRecord, error)
 }
 
 var (
@@ -186,3 +188,22 @@ func (dao *EvaluatorRecordDAOImpl) BatchGetEvaluatorRecordForAggr(ctx context.Co
 	}
 	return pos, nil
 }
+
+func (dao *EvaluatorRecordDAOImpl) ListSuccessEvaluatorRecordByVersionID(ctx context.Context, spaceID, evaluatorVersionID int64, limit int, opts ...db.Option) ([]*model.EvaluatorRecord, error) {
+	var pos []*model.EvaluatorRecord
+
+	dbsession := dao.provider.NewSession(ctx, opts...)
+
+	query := dbsession.WithContext(ctx).
+		Where("space_id = ? AND evaluator_version_id = ?", spaceID, evaluatorVersionID).
+		Where("status = ?", int32(entity.EvaluatorRunStatusSuccess)).
+		Where("score IS NOT NULL")
+	if contexts.CtxWriteDB(ctx) {
+		query = query.Clauses(dbresolver.Write)
+	}
+	err := query.Order("id DESC").Limit(limit).Find(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+	return pos, nil
+}
@@ -196,3 +196,23 @@ func (mr *MockEvaluatorRecordDAOMockRecorder) UpdateEvaluatorRecordResult(arg0,
 	varargs := append([]any{arg0, arg1, arg2, arg3, arg4}, arg5...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvaluatorRecordResult", reflect.TypeOf((*MockEvaluatorRecordDAO)(nil).UpdateEvaluatorRecordResult), varargs...)
 }
+
+// ListSuccessEvaluatorRecordByVersionID mocks base method.
+func (m *MockEvaluatorRecordDAO) ListSuccessEvaluatorRecordByVersionID(arg0 context.Context, arg1, arg2 int64, arg3 int, arg4 ...db.Option) ([]*model.EvaluatorRecord, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSuccessEvaluatorRecordByVersionID", varargs...)
+	ret0, _ := ret[0].([]*model.EvaluatorRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSuccessEvaluatorRecordByVersionID indicates an expected call of ListSuccessEvaluatorRecordByVersionID.
+func (mr *MockEvaluatorRecordDAOMockRecorder) ListSuccessEvaluatorRecordByVersionID(arg0, arg1, arg2, arg3 any, arg4 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSuccessEvaluatorRecordByVersionID", reflect.TypeOf((*MockEvaluatorRecordDAO)(nil).ListSuccessEvaluatorRecordByVersionID), varargs...)
+}
@@ -13,4 +13,6 @@ var EvaluatorMySQLDAOSet = wire.NewSet(
 	NewEvaluatorRecordDAO,
 	NewEvaluatorTemplateDAO,
 	NewEvaluatorTagDAO,
+	NewEvaluatorCalibrationRecordDAO,
+	NewEvaluatorPromotionPolicyDAO,
 )
@@ -13,6 +13,8 @@ var EvaluatorRepoSet = wire.NewSet(
 	NewEvaluatorRepo,
 	NewEvaluatorRecordRepo,
 	NewEvaluatorTemplateRepo,
+	NewEvaluatorCalibrationRepo,
+	NewEvaluatorPromotionPolicyRepo,
 	NewRateLimiterImpl,
 	NewPlainRateLimiterImpl,
 	// DAO Sets
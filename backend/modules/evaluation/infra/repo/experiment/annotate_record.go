@@ -176,6 +176,7 @@ func (e ExptAnnotateRepoImpl) SaveAnnotateRecord(ctx context.Context, exptTurnRe
 		return err
 	}
 
+	record.ExptTurnResultID = exptTurnResultID
 	po, err := convert.AnnotateRecordDOToPO(record)
 	if err != nil {
 		return err
@@ -202,6 +203,46 @@ func (e ExptAnnotateRepoImpl) SaveAnnotateRecord(ctx context.Context, exptTurnRe
 	return nil
 }
 
+func (e ExptAnnotateRepoImpl) CreateAnnotateRecord(ctx context.Context, record *entity.AnnotateRecord, opts ...db.Option) error {
+	po, err := convert.AnnotateRecordDOToPO(record)
+	if err != nil {
+		return err
+	}
+	return e.annotateRecordDAO.Save(ctx, po, opts...)
+}
+
+func (e ExptAnnotateRepoImpl) GetAnnotateRecordsByTagKeyID(ctx context.Context, spaceID, exptID, tagKeyID int64) ([]*entity.AnnotateRecord, error) {
+	records, err := e.annotateRecordDAO.ListByTagKeyID(ctx, spaceID, exptID, tagKeyID)
+	if err != nil {
+		return nil, err
+	}
+	annotateRecords := make([]*entity.AnnotateRecord, 0, len(records))
+	for _, record := range records {
+		do, err := convert.AnnotateRecordPOToDO(record)
+		if err != nil {
+			return nil, err
+		}
+		annotateRecords = append(annotateRecords, do)
+	}
+	return annotateRecords, nil
+}
+
+func (e ExptAnnotateRepoImpl) GetAnnotateRecordsByTurnResultID(ctx context.Context, spaceID, exptID, tagKeyID, turnResultID int64) ([]*entity.AnnotateRecord, error) {
+	records, err := e.annotateRecordDAO.ListByTurnResultID(ctx, spaceID, exptID, tagKeyID, turnResultID)
+	if err != nil {
+		return nil, err
+	}
+	annotateRecords := make([]*entity.AnnotateRecord, 0, len(records))
+	for _, record := range records {
+		do, err := convert.AnnotateRecordPOToDO(record)
+		if err != nil {
+			return nil, err
+		}
+		annotateRecords = append(annotateRecords, do)
+	}
+	return annotateRecords, nil
+}
+
 func (e ExptAnnotateRepoImpl) UpdateAnnotateRecord(ctx context.Context, record *entity.AnnotateRecord) error {
 	po, err := convert.AnnotateRecordDOToPO(record)
 	if err != nil {
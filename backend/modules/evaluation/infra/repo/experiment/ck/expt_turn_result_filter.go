@@ -92,6 +92,7 @@ type IExptTurnResultFilterDAO interface {
 	Save(ctx context.Context, filter []*model.ExptTurnResultFilter) error
 	QueryItemIDStates(ctx context.Context, cond *ExptTurnResultFilterQueryCond) (map[string]int32, int64, error)
 	GetByExptIDItemIDs(ctx context.Context, spaceID, exptID, createdDate string, itemIDs []string) ([]*compare_model.ExptTurnResultFilter, error)
+	QueryGroupAggr(ctx context.Context, cond *ExptTurnResultGroupAggrCond) ([]*compare_model.ExptTurnResultGroupAggr, error)
 }
 
 type exptTurnResultFilterDAOImpl struct {
@@ -0,0 +1,101 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	compare_model "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/ck/model"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// ExptTurnResultGroupAggrCond 按某个 map 字段的取值分组聚合评估器得分
+type ExptTurnResultGroupAggrCond struct {
+	SpaceID     string
+	ExptID      string
+	CreatedDate *time.Time
+
+	// GroupFromItemSnapshot 分组取值来自评测集 item 表 (dis) 时为 true, 否则来自主表 (etrf)
+	GroupFromItemSnapshot bool
+	// GroupMapColumns 分组取值所在的 map 列, 多个时取第一个包含 GroupMapKey 的列
+	GroupMapColumns []string
+	GroupMapKey     string
+	// IsOnlineExpt 含义同 ExptTurnResultFilterQueryCond.IsOnlineExpt
+	IsOnlineExpt bool
+}
+
+// 允许参与分组的 map 列, 列名直接拼入 SQL, 必须白名单校验
+var (
+	itemSnapshotGroupColumns = map[string]bool{"string_map": true, "float_map": true, "int_map": true, "bool_map": true}
+	turnResultGroupColumns   = map[string]bool{"annotation_string": true, "annotation_float": true, "annotation_bool": true}
+)
+
+func (d *exptTurnResultFilterDAOImpl) QueryGroupAggr(ctx context.Context, cond *ExptTurnResultGroupAggrCond) ([]*compare_model.ExptTurnResultGroupAggr, error) {
+	sql, args, err := d.buildGroupAggrSQL(cond)
+	if err != nil {
+		return nil, err
+	}
+	logs.CtxInfo(ctx, "QueryGroupAggr sql: %v, args: %v", sql, args)
+	var results []*compare_model.ExptTurnResultGroupAggr
+	if err := d.db.NewSession(ctx).Raw(sql, args...).Scan(&results).Error; err != nil {
+		logs.CtxError(ctx, "QueryGroupAggr failed: %v", err)
+		return nil, err
+	}
+	return results, nil
+}
+
+// buildGroupAggrSQL 子查询取出每个 turn 的分组取值与得分 map, 外层把得分 map 的 key 展开后按 (分组取值, key) 聚合;
+// 展开时额外拼入空 key, 用于统计分组下的 turn 总数 (含未产出得分的 turn)
+func (d *exptTurnResultFilterDAOImpl) buildGroupAggrSQL(cond *ExptTurnResultGroupAggrCond) (string, []interface{}, error) {
+	if cond == nil || cond.SpaceID == "" || cond.ExptID == "" {
+		return "", nil, fmt.Errorf("QueryGroupAggr: space_id and expt_id are required")
+	}
+	if len(cond.GroupMapColumns) == 0 || cond.GroupMapKey == "" {
+		return "", nil, fmt.Errorf("QueryGroupAggr: group map column and key are required")
+	}
+	alias, allowed := "etrf", turnResultGroupColumns
+	if cond.GroupFromItemSnapshot {
+		alias, allowed = "dis", itemSnapshotGroupColumns
+	}
+	key := db.EscapeSQLData(cond.GroupMapKey)
+	branches := make([]string, 0, len(cond.GroupMapColumns)*2)
+	for _, column := range cond.GroupMapColumns {
+		if !allowed[column] {
+			return "", nil, fmt.Errorf("QueryGroupAggr: unsupported group map column %s.%s", alias, column)
+		}
+		branches = append(branches,
+			fmt.Sprintf("mapContains(%s.%s, '%s')", alias, column, key),
+			fmt.Sprintf("toString(%s.%s['%s'])", alias, column, key))
+	}
+	groupExpr := "multiIf(" + strings.Join(branches, ", ") + ", '')"
+
+	dbName := getClickHouseDatabaseName()
+	inner := "SELECT " + groupExpr + " AS group_value, etrf.evaluator_score AS scores FROM " + dbName + ".expt_turn_result_filter etrf FINAL"
+	if cond.GroupFromItemSnapshot {
+		itemTable := "dataset_item_snapshot"
+		joinCond := "etrf.eval_set_version_id = dis.version_id"
+		if cond.IsOnlineExpt {
+			itemTable = "dataset_item_draft"
+			joinCond = "etrf.eval_set_id = dis.dataset_id"
+		}
+		// LEFT JOIN: 评测集 item 缺失时归入取值为空的分组, 不丢 turn
+		inner += " LEFT JOIN " + dbName + "." + itemTable + " dis ON " + joinCond + " AND etrf.item_id = dis.item_id"
+	}
+	inner += " WHERE etrf.space_id = ? AND etrf.expt_id = ?"
+	args := []interface{}{cond.SpaceID, cond.ExptID}
+	if cond.CreatedDate != nil {
+		inner += " AND etrf.created_date = ?"
+		args = append(args, cond.CreatedDate.Format(time.DateOnly))
+	}
+
+	sql := "SELECT t.group_value AS group_value, score_key, count() AS cnt, " +
+		"avg(t.scores[score_key]) AS avg_score, min(t.scores[score_key]) AS min_score, max(t.scores[score_key]) AS max_score " +
+		"FROM (" + inner + ") t ARRAY JOIN arrayConcat([''], mapKeys(t.scores)) AS score_key " +
+		"GROUP BY group_value, score_key"
+	return sql, args, nil
+}
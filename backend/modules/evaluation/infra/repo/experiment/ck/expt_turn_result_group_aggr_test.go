@@ -0,0 +1,73 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ck
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExptTurnResultFilterDAOImpl_buildGroupAggrSQL(t *testing.T) {
+	d := &exptTurnResultFilterDAOImpl{}
+	createdDate := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("评测集字段分组", func(t *testing.T) {
+		sql, args, err := d.buildGroupAggrSQL(&ExptTurnResultGroupAggrCond{
+			SpaceID:               "1",
+			ExptID:                "2",
+			CreatedDate:           &createdDate,
+			GroupFromItemSnapshot: true,
+			GroupMapColumns:       []string{"string_map"},
+			GroupMapKey:           "key3",
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, sql, "multiIf(mapContains(dis.string_map, 'key3'), toString(dis.string_map['key3']), '') AS group_value")
+		assert.Contains(t, sql, "LEFT JOIN `cozeloop-clickhouse`.dataset_item_snapshot dis ON etrf.eval_set_version_id = dis.version_id AND etrf.item_id = dis.item_id")
+		assert.Contains(t, sql, "ARRAY JOIN arrayConcat([''], mapKeys(t.scores)) AS score_key")
+		assert.Contains(t, sql, "GROUP BY group_value, score_key")
+		assert.Equal(t, []interface{}{"1", "2", "2025-06-01"}, args)
+	})
+
+	t.Run("在线实验联表草稿", func(t *testing.T) {
+		sql, _, err := d.buildGroupAggrSQL(&ExptTurnResultGroupAggrCond{
+			SpaceID:               "1",
+			ExptID:                "2",
+			GroupFromItemSnapshot: true,
+			GroupMapColumns:       []string{"int_map"},
+			GroupMapKey:           "key1",
+			IsOnlineExpt:          true,
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, sql, "dataset_item_draft dis ON etrf.eval_set_id = dis.dataset_id")
+		assert.NotContains(t, sql, "created_date")
+	})
+
+	t.Run("人工标注分组", func(t *testing.T) {
+		sql, _, err := d.buildGroupAggrSQL(&ExptTurnResultGroupAggrCond{
+			SpaceID:         "1",
+			ExptID:          "2",
+			GroupMapColumns: []string{"annotation_string", "annotation_float"},
+			GroupMapKey:     "key'1",
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, sql, "multiIf(mapContains(etrf.annotation_string, 'key\\'1'), toString(etrf.annotation_string['key\\'1']), "+
+			"mapContains(etrf.annotation_float, 'key\\'1'), toString(etrf.annotation_float['key\\'1']), '')")
+		assert.NotContains(t, sql, "JOIN `")
+	})
+
+	t.Run("非法条件", func(t *testing.T) {
+		for _, cond := range []*ExptTurnResultGroupAggrCond{
+			nil,
+			{SpaceID: "1", GroupMapColumns: []string{"string_map"}, GroupMapKey: "k"},
+			{SpaceID: "1", ExptID: "2", GroupMapKey: "k"},
+			{SpaceID: "1", ExptID: "2", GroupMapColumns: []string{"string_map"}, GroupMapKey: "k"},
+			{SpaceID: "1", ExptID: "2", GroupFromItemSnapshot: true, GroupMapColumns: []string{"string_map) OR 1=1 --"}, GroupMapKey: "k"},
+		} {
+			_, _, err := d.buildGroupAggrSQL(cond)
+			assert.Error(t, err)
+		}
+	})
+}
@@ -58,6 +58,21 @@ func (mr *MockIExptTurnResultFilterDAOMockRecorder) GetByExptIDItemIDs(ctx, spac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByExptIDItemIDs", reflect.TypeOf((*MockIExptTurnResultFilterDAO)(nil).GetByExptIDItemIDs), ctx, spaceID, exptID, createdDate, itemIDs)
 }
 
+// QueryGroupAggr mocks base method.
+func (m *MockIExptTurnResultFilterDAO) QueryGroupAggr(ctx context.Context, cond *ck.ExptTurnResultGroupAggrCond) ([]*model0.ExptTurnResultGroupAggr, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryGroupAggr", ctx, cond)
+	ret0, _ := ret[0].([]*model0.ExptTurnResultGroupAggr)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryGroupAggr indicates an expected call of QueryGroupAggr.
+func (mr *MockIExptTurnResultFilterDAOMockRecorder) QueryGroupAggr(ctx, cond any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryGroupAggr", reflect.TypeOf((*MockIExptTurnResultFilterDAO)(nil).QueryGroupAggr), ctx, cond)
+}
+
 // QueryItemIDStates mocks base method.
 func (m *MockIExptTurnResultFilterDAO) QueryItemIDStates(ctx context.Context, cond *ck.ExptTurnResultFilterQueryCond) (map[string]int32, int64, error) {
 	m.ctrl.T.Helper()
@@ -30,3 +30,14 @@ type ExptTurnResultFilter struct {
 	UpdatedAt               time.Time `gorm:"column:updated_at"`
 	CreatedAt               time.Time `gorm:"column:created_at"`
 }
+
+// ExptTurnResultGroupAggr 按分组取值与评估器得分 key 聚合的一行结果,
+// ScoreKey 为空的行统计该分组下的 turn 总数
+type ExptTurnResultGroupAggr struct {
+	GroupValue string  `gorm:"column:group_value"`
+	ScoreKey   string  `gorm:"column:score_key"`
+	Cnt        int64   `gorm:"column:cnt"`
+	AvgScore   float64 `gorm:"column:avg_score"`
+	MinScore   float64 `gorm:"column:min_score"`
+	MaxScore   float64 `gorm:"column:max_score"`
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/convert"
+)
+
+type EvaluationSetDedupJobRepoImpl struct {
+	dedupJobDao mysql.EvaluationSetDedupJobDAO
+}
+
+func NewEvaluationSetDedupJobRepo(dedupJobDao mysql.EvaluationSetDedupJobDAO) repo.IEvaluationSetDedupJobRepo {
+	return &EvaluationSetDedupJobRepoImpl{
+		dedupJobDao: dedupJobDao,
+	}
+}
+
+func (r *EvaluationSetDedupJobRepoImpl) CreateDedupJob(ctx context.Context, job *entity.EvaluationSetDedupJob) error {
+	po, err := convert.EvaluationSetDedupJobDO2PO(job)
+	if err != nil {
+		return err
+	}
+	return r.dedupJobDao.Create(ctx, po)
+}
+
+func (r *EvaluationSetDedupJobRepoImpl) UpdateDedupJobResult(ctx context.Context, job *entity.EvaluationSetDedupJob) error {
+	report, errMsg, err := convert.EvaluationSetDedupJobResultDO2PO(job)
+	if err != nil {
+		return err
+	}
+	return r.dedupJobDao.UpdateResult(ctx, job.SpaceID, job.ID, int32(job.Status), report, errMsg)
+}
+
+func (r *EvaluationSetDedupJobRepoImpl) GetDedupJob(ctx context.Context, spaceID, jobID int64) (*entity.EvaluationSetDedupJob, error) {
+	po, err := r.dedupJobDao.Get(ctx, spaceID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	return convert.EvaluationSetDedupJobPO2DO(po)
+}
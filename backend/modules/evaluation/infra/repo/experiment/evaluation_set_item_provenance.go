@@ -0,0 +1,92 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/convert"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/gorm_gen/model"
+)
+
+type EvaluationSetItemProvenanceRepoImpl struct {
+	provenanceDAO mysql.EvaluationSetItemProvenanceDAO
+	idgenerator   idgen.IIDGenerator
+}
+
+func NewEvaluationSetItemProvenanceRepo(provenanceDAO mysql.EvaluationSetItemProvenanceDAO, idgenerator idgen.IIDGenerator) repo.IEvaluationSetItemProvenanceRepo {
+	return &EvaluationSetItemProvenanceRepoImpl{
+		provenanceDAO: provenanceDAO,
+		idgenerator:   idgenerator,
+	}
+}
+
+func (r *EvaluationSetItemProvenanceRepoImpl) BatchUpsert(ctx context.Context, provenances []*entity.EvaluationSetItemProvenance) error {
+	if len(provenances) == 0 {
+		return nil
+	}
+	pos, err := r.toPOsWithID(ctx, provenances)
+	if err != nil {
+		return err
+	}
+	return r.provenanceDAO.BatchUpsert(ctx, pos)
+}
+
+func (r *EvaluationSetItemProvenanceRepoImpl) BatchCreateIfAbsent(ctx context.Context, provenances []*entity.EvaluationSetItemProvenance) error {
+	if len(provenances) == 0 {
+		return nil
+	}
+	pos, err := r.toPOsWithID(ctx, provenances)
+	if err != nil {
+		return err
+	}
+	return r.provenanceDAO.BatchCreateIfAbsent(ctx, pos)
+}
+
+// toPOsWithID 冲突的记录保留原 id, 新生成的 id 仅对首次写入生效
+func (r *EvaluationSetItemProvenanceRepoImpl) toPOsWithID(ctx context.Context, provenances []*entity.EvaluationSetItemProvenance) ([]*model.EvaluationSetItemProvenance, error) {
+	ids, err := r.idgenerator.GenMultiIDs(ctx, len(provenances))
+	if err != nil {
+		return nil, err
+	}
+	pos := make([]*model.EvaluationSetItemProvenance, 0, len(provenances))
+	for i, p := range provenances {
+		po := convert.NewEvaluationSetItemProvenanceConvertor().DO2PO(p)
+		po.ID = ids[i]
+		pos = append(pos, po)
+	}
+	return pos, nil
+}
+
+func (r *EvaluationSetItemProvenanceRepoImpl) UpdateEditor(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64, updatedBy string) error {
+	return r.provenanceDAO.UpdateEditor(ctx, spaceID, evaluationSetID, itemIDs, updatedBy)
+}
+
+func (r *EvaluationSetItemProvenanceRepoImpl) UpdateReviewStatus(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64, reviewStatus string) error {
+	return r.provenanceDAO.UpdateReviewStatus(ctx, spaceID, evaluationSetID, itemIDs, reviewStatus)
+}
+
+func (r *EvaluationSetItemProvenanceRepoImpl) MGet(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64) ([]*entity.EvaluationSetItemProvenance, error) {
+	pos, err := r.provenanceDAO.MGet(ctx, spaceID, evaluationSetID, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+	dos := make([]*entity.EvaluationSetItemProvenance, 0, len(pos))
+	for _, po := range pos {
+		dos = append(dos, convert.NewEvaluationSetItemProvenanceConvertor().PO2DO(po))
+	}
+	return dos, nil
+}
+
+func (r *EvaluationSetItemProvenanceRepoImpl) ListItemIDs(ctx context.Context, spaceID, evaluationSetID int64, filter *entity.EvaluationSetItemProvenanceFilter, page entity.Page) ([]int64, int64, error) {
+	return r.provenanceDAO.ListItemIDs(ctx, spaceID, evaluationSetID, filter, page)
+}
+
+func (r *EvaluationSetItemProvenanceRepoImpl) BatchDelete(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64) error {
+	return r.provenanceDAO.BatchDelete(ctx, spaceID, evaluationSetID, itemIDs)
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	idgenMocks "github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/gorm_gen/model"
+	daoMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/mocks"
+)
+
+func TestEvaluationSetItemProvenanceRepoImpl_BatchUpsert(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockIDGen := idgenMocks.NewMockIIDGenerator(ctrl)
+	mockDAO := daoMocks.NewMockEvaluationSetItemProvenanceDAO(ctrl)
+	r := NewEvaluationSetItemProvenanceRepo(mockDAO, mockIDGen)
+
+	provenances := []*entity.EvaluationSetItemProvenance{
+		{SpaceID: 1, EvaluationSetID: 2, ItemID: 10, SourceType: entity.EvaluationSetItemSourceType_TraceExport, TraceID: "t1", SpanID: "s1", Author: "u1"},
+		{SpaceID: 1, EvaluationSetID: 2, ItemID: 11, SourceType: entity.EvaluationSetItemSourceType_Synthetic, Author: "u1"},
+	}
+
+	tests := []struct {
+		name      string
+		input     []*entity.EvaluationSetItemProvenance
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name:      "empty",
+			input:     nil,
+			mockSetup: func() {},
+		},
+		{
+			name:  "success",
+			input: provenances,
+			mockSetup: func() {
+				mockIDGen.EXPECT().GenMultiIDs(gomock.Any(), 2).Return([]int64{100, 101}, nil)
+				mockDAO.EXPECT().BatchUpsert(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, pos []*model.EvaluationSetItemProvenance, _ ...any) error {
+						assert.Len(t, pos, 2)
+						assert.Equal(t, int64(100), pos[0].ID)
+						assert.Equal(t, "trace_export", pos[0].SourceType)
+						assert.Equal(t, "t1", pos[0].TraceID)
+						assert.Equal(t, "u1", pos[0].CreatedBy)
+						assert.Equal(t, int64(101), pos[1].ID)
+						return nil
+					})
+			},
+		},
+		{
+			name:  "idgen_error",
+			input: provenances,
+			mockSetup: func() {
+				mockIDGen.EXPECT().GenMultiIDs(gomock.Any(), 2).Return(nil, errors.New("idgen error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			err := r.BatchUpsert(context.Background(), tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEvaluationSetItemProvenanceRepoImpl_BatchCreateIfAbsent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockIDGen := idgenMocks.NewMockIIDGenerator(ctrl)
+	mockDAO := daoMocks.NewMockEvaluationSetItemProvenanceDAO(ctrl)
+	r := NewEvaluationSetItemProvenanceRepo(mockDAO, mockIDGen)
+
+	provenances := []*entity.EvaluationSetItemProvenance{
+		{SpaceID: 1, EvaluationSetID: 2, ItemID: 10, SourceType: entity.EvaluationSetItemSourceType_FileImport, ImportJobID: 5, Author: "u1"},
+		{SpaceID: 1, EvaluationSetID: 2, ItemID: 11, SourceType: entity.EvaluationSetItemSourceType_Unknown, Author: "u2"},
+	}
+
+	tests := []struct {
+		name      string
+		input     []*entity.EvaluationSetItemProvenance
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name:      "empty",
+			input:     nil,
+			mockSetup: func() {},
+		},
+		{
+			name:  "success",
+			input: provenances,
+			mockSetup: func() {
+				mockIDGen.EXPECT().GenMultiIDs(gomock.Any(), 2).Return([]int64{100, 101}, nil)
+				mockDAO.EXPECT().BatchCreateIfAbsent(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, pos []*model.EvaluationSetItemProvenance, _ ...any) error {
+						assert.Len(t, pos, 2)
+						assert.Equal(t, int64(100), pos[0].ID)
+						assert.Equal(t, "file_import", pos[0].SourceType)
+						assert.Equal(t, int64(5), pos[0].ImportJobID)
+						assert.Equal(t, "unknown", pos[1].SourceType)
+						assert.Equal(t, "u2", pos[1].CreatedBy)
+						return nil
+					})
+			},
+		},
+		{
+			name:  "dao_error",
+			input: provenances,
+			mockSetup: func() {
+				mockIDGen.EXPECT().GenMultiIDs(gomock.Any(), 2).Return([]int64{100, 101}, nil)
+				mockDAO.EXPECT().BatchCreateIfAbsent(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			err := r.BatchCreateIfAbsent(context.Background(), tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEvaluationSetItemProvenanceRepoImpl_MGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDAO := daoMocks.NewMockEvaluationSetItemProvenanceDAO(ctrl)
+	r := NewEvaluationSetItemProvenanceRepo(mockDAO, idgenMocks.NewMockIIDGenerator(ctrl))
+
+	now := time.Now()
+	mockDAO.EXPECT().MGet(gomock.Any(), int64(1), int64(2), []int64{10}).Return([]*model.EvaluationSetItemProvenance{
+		{ID: 100, SpaceID: 1, EvaluationSetID: 2, ItemID: 10, SourceType: "synthetic", ReviewStatus: "pending_review", ImportJobID: 7, CreatedBy: "u1", UpdatedBy: "u2", CreatedAt: now, UpdatedAt: now},
+	}, nil)
+	got, err := r.MGet(context.Background(), 1, 2, []int64{10})
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, entity.EvaluationSetItemSourceType_Synthetic, got[0].SourceType)
+	assert.Equal(t, entity.EvaluationSetItemReviewStatus_PendingReview, got[0].ReviewStatus)
+	assert.Equal(t, int64(7), got[0].ImportJobID)
+	assert.Equal(t, "u1", got[0].Author)
+	assert.Equal(t, "u2", got[0].UpdatedBy)
+	assert.Equal(t, now, *got[0].CreatedAt)
+
+	mockDAO.EXPECT().MGet(gomock.Any(), int64(1), int64(2), []int64{11}).Return(nil, errors.New("dao error"))
+	_, err = r.MGet(context.Background(), 1, 2, []int64{11})
+	assert.Error(t, err)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/infra/limiter"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/redis/dao"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+type ExptConcurrencyRepoImpl struct {
+	dao.IConcurrencyDAO
+}
+
+func NewExptConcurrencyRepo(dao dao.IConcurrencyDAO) repo.IExptConcurrencyRepo {
+	return &ExptConcurrencyRepoImpl{IConcurrencyDAO: dao}
+}
+
+type ExptBackendBudgetLimiterImpl struct {
+	limiter limiter.IPlainRateLimiter
+}
+
+func NewExptBackendBudgetLimiter(limiterFactory limiter.IPlainRateLimiterFactory) repo.IExptBackendBudgetLimiter {
+	return &ExptBackendBudgetLimiterImpl{
+		limiter: limiterFactory.NewPlainRateLimiter(),
+	}
+}
+
+func (e *ExptBackendBudgetLimiterImpl) makeBudgetKey(backend string) string {
+	return "expt_backend_budget:" + backend
+}
+
+// AcquireN 限流器按 n 整体放行或拒绝, 拒绝时折半重试, 尽量用满剩余额度
+func (e *ExptBackendBudgetLimiterImpl) AcquireN(ctx context.Context, backend string, n int, budget *entity.ExptBackendBudget) int {
+	if budget == nil || n <= 0 {
+		return n
+	}
+	limit := &limiter.Limit{
+		Rate:   budget.Rate,
+		Burst:  budget.GetBurst(),
+		Period: budget.GetPeriod(),
+	}
+	key := e.makeBudgetKey(backend)
+	for ; n > 0; n /= 2 {
+		res, err := e.limiter.AllowN(ctx, key, n, limiter.WithLimit(limit))
+		if err != nil {
+			logs.CtxError(ctx, "[ExptBackendBudget] acquire backend budget fail, backend: %v, n: %v, err: %v", backend, n, err)
+			return n
+		}
+		if res.Allowed {
+			return n
+		}
+	}
+	logs.CtxInfo(ctx, "[ExptBackendBudget] backend budget exhausted, backend: %v", backend)
+	return 0
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/infra/limiter"
+	limiterMocks "github.com/coze-dev/coze-loop/backend/infra/limiter/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func TestExptBackendBudgetLimiterImpl_AcquireN(t *testing.T) {
+	budget := &entity.ExptBackendBudget{Rate: 10, PeriodSecond: 60}
+
+	tests := []struct {
+		name      string
+		n         int
+		budget    *entity.ExptBackendBudget
+		mockSetup func(m *limiterMocks.MockIPlainRateLimiter)
+		want      int
+	}{
+		{
+			name:      "未配置预算直接放行",
+			n:         5,
+			budget:    nil,
+			mockSetup: func(m *limiterMocks.MockIPlainRateLimiter) {},
+			want:      5,
+		},
+		{
+			name:   "额度充足",
+			n:      4,
+			budget: budget,
+			mockSetup: func(m *limiterMocks.MockIPlainRateLimiter) {
+				m.EXPECT().AllowN(gomock.Any(), "expt_backend_budget:model:1", 4, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ int, opts ...limiter.LimitOptionFn) (*limiter.Result, error) {
+						opt := &limiter.LimitOption{}
+						for _, fn := range opts {
+							fn(opt)
+						}
+						assert.Equal(t, &limiter.Limit{Rate: 10, Burst: 10, Period: time.Minute}, opt.Limit)
+						return &limiter.Result{Allowed: true}, nil
+					})
+			},
+			want: 4,
+		},
+		{
+			name:   "额度不足时折半重试",
+			n:      4,
+			budget: budget,
+			mockSetup: func(m *limiterMocks.MockIPlainRateLimiter) {
+				m.EXPECT().AllowN(gomock.Any(), gomock.Any(), 4, gomock.Any()).Return(&limiter.Result{Allowed: false}, nil)
+				m.EXPECT().AllowN(gomock.Any(), gomock.Any(), 2, gomock.Any()).Return(&limiter.Result{Allowed: true}, nil)
+			},
+			want: 2,
+		},
+		{
+			name:   "额度耗尽",
+			n:      2,
+			budget: budget,
+			mockSetup: func(m *limiterMocks.MockIPlainRateLimiter) {
+				m.EXPECT().AllowN(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&limiter.Result{Allowed: false}, nil).Times(2)
+			},
+			want: 0,
+		},
+		{
+			name:   "限流器异常时放行",
+			n:      3,
+			budget: budget,
+			mockSetup: func(m *limiterMocks.MockIPlainRateLimiter) {
+				m.EXPECT().AllowN(gomock.Any(), gomock.Any(), 3, gomock.Any()).Return(nil, assert.AnError)
+			},
+			want: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLimiter := limiterMocks.NewMockIPlainRateLimiter(ctrl)
+			tt.mockSetup(mockLimiter)
+
+			l := &ExptBackendBudgetLimiterImpl{limiter: mockLimiter}
+			assert.Equal(t, tt.want, l.AcquireN(context.Background(), "model:1", tt.n, tt.budget))
+		})
+	}
+}
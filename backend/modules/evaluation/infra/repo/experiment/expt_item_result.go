@@ -113,6 +113,22 @@ func (e ExptItemResultRepoImpl) UpdateItemRunLog(ctx context.Context, exptID, ex
 	return nil
 }
 
+func (e ExptItemResultRepoImpl) UpdateItemRunLogByAttempt(ctx context.Context, exptID, exptRunID, itemID int64, attempt int32, ufields map[string]any, spaceID int64) (bool, error) {
+	updated, err := e.exptItemResultDAO.UpdateItemRunLogByAttempt(ctx, exptID, exptRunID, itemID, attempt, ufields, spaceID)
+	if err != nil {
+		return false, errorx.Wrapf(err, "UpdateItemRunLogByAttempt fail, expt_id: %v, expt_run_id: %v, item_id: %v, attempt: %v", exptID, exptRunID, itemID, attempt)
+	}
+	return updated, nil
+}
+
+func (e ExptItemResultRepoImpl) ClaimItemRunLog(ctx context.Context, exptID, exptRunID, itemID int64, attempt int32, spaceID int64) (bool, error) {
+	claimed, err := e.exptItemResultDAO.ClaimItemRunLog(ctx, exptID, exptRunID, itemID, attempt, spaceID)
+	if err != nil {
+		return false, errorx.Wrapf(err, "ClaimItemRunLog fail, expt_id: %v, expt_run_id: %v, item_id: %v, attempt: %v", exptID, exptRunID, itemID, attempt)
+	}
+	return claimed, nil
+}
+
 func (e ExptItemResultRepoImpl) ScanItemResults(ctx context.Context, exptID, cursor, limit int64, status []int32, spaceID int64) (results []*entity.ExptItemResult, ncursor int64, err error) {
 	pos, ncursor, err := e.exptItemResultDAO.ScanItemResults(ctx, exptID, cursor, limit, status, spaceID)
 	if err != nil {
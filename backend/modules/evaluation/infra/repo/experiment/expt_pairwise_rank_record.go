@@ -0,0 +1,47 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/convert"
+)
+
+type ExptPairwiseRankRecordRepoImpl struct {
+	rankRecordDao mysql.ExptPairwiseRankRecordDAO
+}
+
+func NewExptPairwiseRankRecordRepo(rankRecordDao mysql.ExptPairwiseRankRecordDAO) repo.IExptPairwiseRankRecordRepo {
+	return &ExptPairwiseRankRecordRepoImpl{
+		rankRecordDao: rankRecordDao,
+	}
+}
+
+func (r *ExptPairwiseRankRecordRepoImpl) CreatePairwiseRankRecord(ctx context.Context, record *entity.ExptPairwiseRankRecord) error {
+	po, err := convert.ExptPairwiseRankRecordDO2PO(record)
+	if err != nil {
+		return err
+	}
+	return r.rankRecordDao.Create(ctx, po)
+}
+
+func (r *ExptPairwiseRankRecordRepoImpl) UpdatePairwiseRankResult(ctx context.Context, record *entity.ExptPairwiseRankRecord) error {
+	result, errMsg, err := convert.ExptPairwiseRankResultDO2PO(record)
+	if err != nil {
+		return err
+	}
+	return r.rankRecordDao.UpdateResult(ctx, record.SpaceID, record.ID, int32(record.Status), result, errMsg)
+}
+
+func (r *ExptPairwiseRankRecordRepoImpl) GetPairwiseRankRecord(ctx context.Context, spaceID, recordID int64) (*entity.ExptPairwiseRankRecord, error) {
+	po, err := r.rankRecordDao.Get(ctx, spaceID, recordID)
+	if err != nil {
+		return nil, err
+	}
+	return convert.ExptPairwiseRankRecordPO2DO(po)
+}
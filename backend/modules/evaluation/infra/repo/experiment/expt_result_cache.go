@@ -0,0 +1,17 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/redis/dao"
+)
+
+type ExptResultCacheRepoImpl struct {
+	dao.IResultCacheDAO
+}
+
+func NewExptResultCacheRepo(dao dao.IResultCacheDAO) repo.IExptResultCacheRepo {
+	return &ExptResultCacheRepoImpl{IResultCacheDAO: dao}
+}
@@ -68,3 +68,7 @@ func (e *exptStatsRepo) Save(ctx context.Context, stats *entity.ExptStats) error
 	}
 	return nil
 }
+
+func (e *exptStatsRepo) UpdateConcurrencyState(ctx context.Context, exptID, spaceID int64, concurNum, budgetDeferredCnt int32) error {
+	return e.exptStatsDAO.UpdateConcurrencyState(ctx, exptID, spaceID, concurNum, budgetDeferredCnt)
+}
@@ -238,3 +238,31 @@ func (e *ExptTurnResultFilterRepoImpl) GetByExptIDItemIDs(ctx context.Context, s
 	}
 	return dos, nil
 }
+
+// QueryGroupAggr 按分组取值聚合单个实验的评估器得分, 分组列与 key 由调用方按 key mapping / 评测集快照 mapping 翻译好
+func (e *ExptTurnResultFilterRepoImpl) QueryGroupAggr(ctx context.Context, query *entity.ExptTurnResultGroupAggrQuery) ([]*entity.ExptTurnResultGroupAggrRow, error) {
+	pos, err := e.exptTurnResultFilterDAO.QueryGroupAggr(ctx, &ck.ExptTurnResultGroupAggrCond{
+		SpaceID:               strconv.FormatInt(query.SpaceID, 10),
+		ExptID:                strconv.FormatInt(query.ExptID, 10),
+		CreatedDate:           query.CreatedDate,
+		GroupFromItemSnapshot: query.GroupFromItemSnapshot,
+		GroupMapColumns:       query.GroupMapColumns,
+		GroupMapKey:           query.GroupMapKey,
+		IsOnlineExpt:          query.IsOnlineExpt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]*entity.ExptTurnResultGroupAggrRow, 0, len(pos))
+	for _, po := range pos {
+		rows = append(rows, &entity.ExptTurnResultGroupAggrRow{
+			GroupValue: po.GroupValue,
+			ScoreKey:   po.ScoreKey,
+			Count:      po.Cnt,
+			Avg:        po.AvgScore,
+			Min:        po.MinScore,
+			Max:        po.MaxScore,
+		})
+	}
+	return rows, nil
+}
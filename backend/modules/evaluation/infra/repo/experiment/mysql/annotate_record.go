@@ -22,6 +22,8 @@ type IAnnotateRecordDAO interface {
 	BatchSave(ctx context.Context, annotateRecord []*model.AnnotateRecord, opts ...db.Option) error
 	Update(ctx context.Context, annotateRecord *model.AnnotateRecord, opts ...db.Option) error
 	MGetByID(ctx context.Context, ids []int64) ([]*model.AnnotateRecord, error)
+	ListByTagKeyID(ctx context.Context, spaceID, exptID, tagKeyID int64) ([]*model.AnnotateRecord, error)
+	ListByTurnResultID(ctx context.Context, spaceID, exptID, tagKeyID, turnResultID int64) ([]*model.AnnotateRecord, error)
 }
 
 func NewAnnotateRecordDAO(db db.Provider) IAnnotateRecordDAO {
@@ -76,3 +78,42 @@ func (a annotateRecordDAO) MGetByID(ctx context.Context, ids []int64) ([]*model.
 
 	return annotateRecords, nil
 }
+
+func (a annotateRecordDAO) ListByTagKeyID(ctx context.Context, spaceID, exptID, tagKeyID int64) ([]*model.AnnotateRecord, error) {
+	db := a.db.NewSession(ctx)
+	if contexts.CtxWriteDB(ctx) {
+		db = db.Clauses(dbresolver.Write)
+	}
+	q := query.Use(db).AnnotateRecord
+
+	annotateRecords, err := q.WithContext(ctx).Where(
+		q.SpaceID.Eq(spaceID),
+		q.ExperimentID.Eq(exptID),
+		q.TagKeyID.Eq(tagKeyID),
+	).Find()
+	if err != nil {
+		return nil, errorx.Wrapf(err, "mysql list annotate record fail, expt_id: %v, tag_key_id: %v", exptID, tagKeyID)
+	}
+
+	return annotateRecords, nil
+}
+
+func (a annotateRecordDAO) ListByTurnResultID(ctx context.Context, spaceID, exptID, tagKeyID, turnResultID int64) ([]*model.AnnotateRecord, error) {
+	db := a.db.NewSession(ctx)
+	if contexts.CtxWriteDB(ctx) {
+		db = db.Clauses(dbresolver.Write)
+	}
+	q := query.Use(db).AnnotateRecord
+
+	annotateRecords, err := q.WithContext(ctx).Where(
+		q.SpaceID.Eq(spaceID),
+		q.ExperimentID.Eq(exptID),
+		q.TagKeyID.Eq(tagKeyID),
+		q.ExptTurnResultID.Eq(turnResultID),
+	).Find()
+	if err != nil {
+		return nil, errorx.Wrapf(err, "mysql list annotate record fail, expt_id: %v, tag_key_id: %v, turn_result_id: %v", exptID, tagKeyID, turnResultID)
+	}
+
+	return annotateRecords, nil
+}
@@ -5,6 +5,7 @@ package convert
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/bytedance/gg/gptr"
 
@@ -28,6 +29,13 @@ func AnnotateRecordDOToPO(do *entity.AnnotateRecord) (*model.AnnotateRecord, err
 		TagKeyID:     do.TagKeyID,
 		ExperimentID: do.ExperimentID,
 		TagValueID:   do.TagValueID,
+
+		ExptTurnResultID: do.ExptTurnResultID,
+	}
+	if annotator := do.Annotator(); annotator != "" {
+		if createdBy, err := strconv.ParseInt(annotator, 10, 64); err == nil {
+			po.CreatedBy = createdBy
+		}
 	}
 
 	switch do.AnnotateData.TagContentType {
@@ -64,6 +72,11 @@ func AnnotateRecordPOToDO(po *model.AnnotateRecord) (*entity.AnnotateRecord, err
 		ExperimentID: po.ExperimentID,
 		AnnotateData: annotateData,
 		TagValueID:   po.TagValueID,
+
+		ExptTurnResultID: po.ExptTurnResultID,
+	}
+	if po.CreatedBy > 0 {
+		do.BaseInfo = &entity.BaseInfo{CreatedBy: &entity.UserInfo{UserID: gptr.Of(strconv.FormatInt(po.CreatedBy, 10))}}
 	}
 
 	return do, nil
@@ -0,0 +1,88 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+func EvaluationSetDedupJobDO2PO(do *entity.EvaluationSetDedupJob) (*model.EvaluationSetDedupJob, error) {
+	if do == nil {
+		return nil, nil
+	}
+	po := &model.EvaluationSetDedupJob{
+		ID:              do.ID,
+		SpaceID:         do.SpaceID,
+		EvaluationSetID: do.EvaluationSetID,
+		VersionID:       do.VersionID,
+		Status:          int32(do.Status),
+		CreatedBy:       do.CreatedBy,
+	}
+	if do.Conf != nil {
+		bytes, err := json.Marshal(do.Conf)
+		if err != nil {
+			return nil, err
+		}
+		po.Conf = gptr.Of(bytes)
+	}
+	report, errMsg, err := EvaluationSetDedupJobResultDO2PO(do)
+	if err != nil {
+		return nil, err
+	}
+	po.Report, po.ErrMsg = report, errMsg
+	return po, nil
+}
+
+// EvaluationSetDedupJobResultDO2PO 序列化去重报告与错误信息, 为空时返回 nil
+func EvaluationSetDedupJobResultDO2PO(do *entity.EvaluationSetDedupJob) (report, errMsg *[]byte, err error) {
+	if do.Report != nil {
+		bytes, err := json.Marshal(do.Report)
+		if err != nil {
+			return nil, nil, err
+		}
+		report = gptr.Of(bytes)
+	}
+	if len(do.ErrMsg) > 0 {
+		errMsg = gptr.Of([]byte(do.ErrMsg))
+	}
+	return report, errMsg, nil
+}
+
+func EvaluationSetDedupJobPO2DO(po *model.EvaluationSetDedupJob) (*entity.EvaluationSetDedupJob, error) {
+	if po == nil {
+		return nil, nil
+	}
+	do := &entity.EvaluationSetDedupJob{
+		ID:              po.ID,
+		SpaceID:         po.SpaceID,
+		EvaluationSetID: po.EvaluationSetID,
+		VersionID:       po.VersionID,
+		Status:          entity.EvaluationSetDedupJobStatus(po.Status),
+		CreatedBy:       po.CreatedBy,
+		CreatedAt:       po.CreatedAt,
+		UpdatedAt:       po.UpdatedAt,
+	}
+	if po.Conf != nil && len(*po.Conf) > 0 {
+		conf := &entity.EvaluationSetDedupConf{}
+		if err := json.Unmarshal(*po.Conf, conf); err != nil {
+			return nil, err
+		}
+		do.Conf = conf
+	}
+	if po.Report != nil && len(*po.Report) > 0 {
+		report := &entity.EvaluationSetDedupReport{}
+		if err := json.Unmarshal(*po.Report, report); err != nil {
+			return nil, err
+		}
+		do.Report = report
+	}
+	if po.ErrMsg != nil {
+		do.ErrMsg = string(*po.ErrMsg)
+	}
+	return do, nil
+}
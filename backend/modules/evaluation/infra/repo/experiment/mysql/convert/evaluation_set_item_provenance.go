@@ -0,0 +1,61 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/gorm_gen/model"
+)
+
+func NewEvaluationSetItemProvenanceConvertor() EvaluationSetItemProvenanceConvertor {
+	return EvaluationSetItemProvenanceConvertor{}
+}
+
+type EvaluationSetItemProvenanceConvertor struct{}
+
+func (EvaluationSetItemProvenanceConvertor) DO2PO(do *entity.EvaluationSetItemProvenance) *model.EvaluationSetItemProvenance {
+	if do == nil {
+		return nil
+	}
+	return &model.EvaluationSetItemProvenance{
+		ID:              do.ID,
+		SpaceID:         do.SpaceID,
+		EvaluationSetID: do.EvaluationSetID,
+		ItemID:          do.ItemID,
+		SourceType:      string(do.SourceType),
+		TraceID:         do.TraceID,
+		SpanID:          do.SpanID,
+		ImportJobID:     do.ImportJobID,
+		SourceExptID:    do.SourceExptID,
+		SourceVersionID: do.SourceVersionID,
+		ReviewStatus:    do.ReviewStatus,
+		CreatedBy:       do.Author,
+		UpdatedBy:       do.UpdatedBy,
+	}
+}
+
+func (EvaluationSetItemProvenanceConvertor) PO2DO(po *model.EvaluationSetItemProvenance) *entity.EvaluationSetItemProvenance {
+	if po == nil {
+		return nil
+	}
+	return &entity.EvaluationSetItemProvenance{
+		ID:              po.ID,
+		SpaceID:         po.SpaceID,
+		EvaluationSetID: po.EvaluationSetID,
+		ItemID:          po.ItemID,
+		SourceType:      entity.EvaluationSetItemSourceType(po.SourceType),
+		TraceID:         po.TraceID,
+		SpanID:          po.SpanID,
+		ImportJobID:     po.ImportJobID,
+		SourceExptID:    po.SourceExptID,
+		SourceVersionID: po.SourceVersionID,
+		ReviewStatus:    po.ReviewStatus,
+		Author:          po.CreatedBy,
+		UpdatedBy:       po.UpdatedBy,
+		CreatedAt:       gptr.Of(po.CreatedAt),
+		UpdatedAt:       gptr.Of(po.UpdatedAt),
+	}
+}
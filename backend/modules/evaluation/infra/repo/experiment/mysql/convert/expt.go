@@ -83,6 +83,22 @@ func (ExptConverter) DO2PO(experiment *entity.Experiment) (*model.Experiment, er
 		expt.NotificationConf = &bytes
 	}
 
+	if experiment.QualityGateResult != nil {
+		bytes, err := json.Marshal(experiment.QualityGateResult)
+		if err != nil {
+			return nil, errorx.Wrapf(err, "QualityGateResult json marshal fail")
+		}
+		expt.QualityGateResult = &bytes
+	}
+
+	if experiment.SamplingResult != nil {
+		bytes, err := json.Marshal(experiment.SamplingResult)
+		if err != nil {
+			return nil, errorx.Wrapf(err, "SamplingResult json marshal fail")
+		}
+		expt.SamplingResult = &bytes
+	}
+
 	return expt, nil
 }
 
@@ -154,6 +170,22 @@ func (ExptConverter) PO2DO(expt *model.Experiment, refs []*model.ExptEvaluatorRe
 		res.NotificationConf = notifConf
 	}
 
+	if len(gptr.Indirect(expt.QualityGateResult)) > 0 {
+		gateResult := new(entity.ExptQualityGateResult)
+		if err := json.Unmarshal(gptr.Indirect(expt.QualityGateResult), gateResult); err != nil {
+			return nil, errorx.Wrapf(err, "QualityGateResult json unmarshal fail, expt_id: %v", expt.ID)
+		}
+		res.QualityGateResult = gateResult
+	}
+
+	if len(gptr.Indirect(expt.SamplingResult)) > 0 {
+		samplingResult := new(entity.ExptSamplingResult)
+		if err := json.Unmarshal(gptr.Indirect(expt.SamplingResult), samplingResult); err != nil {
+			return nil, errorx.Wrapf(err, "SamplingResult json unmarshal fail, expt_id: %v", expt.ID)
+		}
+		res.SamplingResult = samplingResult
+	}
+
 	// 如果数据库中有模板 ID，则在 ExptTemplateMeta 中回填 ID，方便上层按模板 ID 查询和聚合
 	if expt.ExptTemplateID != 0 {
 		res.ExptTemplateMeta = &entity.ExptTemplateMeta{
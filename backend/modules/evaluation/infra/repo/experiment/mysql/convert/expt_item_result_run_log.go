@@ -31,6 +31,7 @@ func (c *ExptItemResultRunLogConverter) PO2DO(rl *model.ExptItemResultRunLog) *e
 		ErrMsg:        gptr.Indirect(rl.ErrMsg),
 		LogID:         rl.LogID,
 		ResultState:   gptr.Indirect(rl.ResultState),
+		Attempt:       rl.Attempt,
 		UpdatedAt:     gptr.Of(rl.UpdatedAt),
 	}
 
@@ -52,6 +53,7 @@ func (c *ExptItemResultRunLogConverter) DO2PO(do *entity.ExptItemResultRunLog) *
 		ErrMsg:        gptr.Of(do.ErrMsg),
 		LogID:         do.LogID,
 		ResultState:   gptr.Of(do.ResultState),
+		Attempt:       do.Attempt,
 	}
 
 	if do.UpdatedAt != nil {
@@ -0,0 +1,86 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+func ExptPairwiseRankRecordDO2PO(do *entity.ExptPairwiseRankRecord) (*model.ExptPairwiseRankRecord, error) {
+	if do == nil {
+		return nil, nil
+	}
+	po := &model.ExptPairwiseRankRecord{
+		ID:                 do.ID,
+		SpaceID:            do.SpaceID,
+		EvaluatorVersionID: do.EvaluatorVersionID,
+		Status:             int32(do.Status),
+		CreatedBy:          do.CreatedBy,
+	}
+	if do.Param != nil {
+		bytes, err := json.Marshal(do.Param)
+		if err != nil {
+			return nil, err
+		}
+		po.Param = gptr.Of(bytes)
+	}
+	result, errMsg, err := ExptPairwiseRankResultDO2PO(do)
+	if err != nil {
+		return nil, err
+	}
+	po.Result, po.ErrMsg = result, errMsg
+	return po, nil
+}
+
+// ExptPairwiseRankResultDO2PO 序列化排名结果与错误信息, 为空时返回 nil
+func ExptPairwiseRankResultDO2PO(do *entity.ExptPairwiseRankRecord) (result, errMsg *[]byte, err error) {
+	if do.Result != nil {
+		bytes, err := json.Marshal(do.Result)
+		if err != nil {
+			return nil, nil, err
+		}
+		result = gptr.Of(bytes)
+	}
+	if len(do.ErrMsg) > 0 {
+		errMsg = gptr.Of([]byte(do.ErrMsg))
+	}
+	return result, errMsg, nil
+}
+
+func ExptPairwiseRankRecordPO2DO(po *model.ExptPairwiseRankRecord) (*entity.ExptPairwiseRankRecord, error) {
+	if po == nil {
+		return nil, nil
+	}
+	do := &entity.ExptPairwiseRankRecord{
+		ID:                 po.ID,
+		SpaceID:            po.SpaceID,
+		EvaluatorVersionID: po.EvaluatorVersionID,
+		Status:             entity.ExptPairwiseRankStatus(po.Status),
+		CreatedBy:          po.CreatedBy,
+		CreatedAt:          po.CreatedAt,
+		UpdatedAt:          po.UpdatedAt,
+	}
+	if po.Param != nil && len(*po.Param) > 0 {
+		param := &entity.ExptPairwiseRankParam{}
+		if err := json.Unmarshal(*po.Param, param); err != nil {
+			return nil, err
+		}
+		do.Param = param
+	}
+	if po.Result != nil && len(*po.Result) > 0 {
+		result := &entity.ExptPairwiseRankResult{}
+		if err := json.Unmarshal(*po.Result, result); err != nil {
+			return nil, err
+		}
+		do.Result = result
+	}
+	if po.ErrMsg != nil {
+		do.ErrMsg = string(*po.ErrMsg)
+	}
+	return do, nil
+}
@@ -86,7 +86,7 @@ func NewExptTurnResultConvertor() ExptTurnResultConvertor {
 type ExptTurnResultConvertor struct{}
 
 func (ExptTurnResultConvertor) PO2DO(tr *model.ExptTurnResult, evaluatorResults *entity.EvaluatorResults) *entity.ExptTurnResult {
-	return &entity.ExptTurnResult{
+	do := &entity.ExptTurnResult{
 		ID:             tr.ID,
 		SpaceID:        tr.SpaceID,
 		ExptID:         tr.ExptID,
@@ -104,11 +104,20 @@ func (ExptTurnResultConvertor) PO2DO(tr *model.ExptTurnResult, evaluatorResults
 		// 运行期补充字段
 		EvaluatorResults: evaluatorResults,
 		WeightedScore:    tr.WeightedScore,
+		CacheHit:         entity.ResultCacheHitFlag(tr.CacheHit),
 	}
+	// 反序列化重复试验结果
+	if tr.TrialResults != nil && len(*tr.TrialResults) > 0 {
+		var trials []*entity.ExptTurnTrialResult
+		if err := json.Unmarshal(*tr.TrialResults, &trials); err == nil {
+			do.TrialResults = trials
+		}
+	}
+	return do
 }
 
 func (ExptTurnResultConvertor) DO2PO(tr *entity.ExptTurnResult) *model.ExptTurnResult {
-	return &model.ExptTurnResult{
+	po := &model.ExptTurnResult{
 		ID:             tr.ID,
 		SpaceID:        tr.SpaceID,
 		ExptID:         tr.ExptID,
@@ -124,5 +133,14 @@ func (ExptTurnResultConvertor) DO2PO(tr *entity.ExptTurnResult) *model.ExptTurnR
 		TurnIdx:        gptr.Of(tr.TurnIdx),
 
 		WeightedScore: tr.WeightedScore,
+		CacheHit:      int32(tr.CacheHit),
+	}
+	// 序列化重复试验结果
+	if len(tr.TrialResults) > 0 {
+		trialBytes, err := json.Marshal(tr.TrialResults)
+		if err == nil {
+			po.TrialResults = &trialBytes
+		}
 	}
+	return po
 }
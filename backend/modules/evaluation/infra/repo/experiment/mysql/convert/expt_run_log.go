@@ -38,6 +38,7 @@ func (ExptTurnResultRunLogConvertor) DO2PO(log *entity.ExptTurnResultRunLog) (*m
 		TargetResultID:     log.TargetResultID,
 		EvaluatorResultIds: gptr.Of(evalResIDs),
 		ErrMsg:             gptr.Of(conv.UnsafeStringToBytes(log.ErrMsg)),
+		CacheHit:           int32(log.CacheHit),
 	}
 	if len(log.Ext) > 0 {
 		extBytes, err := json.Marshal(log.Ext)
@@ -46,6 +47,20 @@ func (ExptTurnResultRunLogConvertor) DO2PO(log *entity.ExptTurnResultRunLog) (*m
 		}
 		po.Ext = extBytes
 	}
+	if len(log.TrialResults) > 0 {
+		trialBytes, err := json.Marshal(log.TrialResults)
+		if err != nil {
+			return nil, errorx.Wrapf(err, "ExptTurnResultRunLog TrialResults json marshal fail")
+		}
+		po.TrialResults = gptr.Of(trialBytes)
+	}
+	if log.Checkpoint != nil {
+		checkpointBytes, err := json.Marshal(log.Checkpoint)
+		if err != nil {
+			return nil, errorx.Wrapf(err, "ExptTurnResultRunLog Checkpoint json marshal fail")
+		}
+		po.Checkpoint = gptr.Of(checkpointBytes)
+	}
 	return po, nil
 }
 
@@ -68,6 +83,21 @@ func (ExptTurnResultRunLogConvertor) PO2DO(log *model.ExptTurnResultRunLog) (*en
 		}
 	}
 
+	var trials []*entity.ExptTurnTrialResult
+	if len(gptr.Indirect(log.TrialResults)) > 0 {
+		if err := json.Unmarshal(gptr.Indirect(log.TrialResults), &trials); err != nil {
+			return nil, errorx.Wrapf(err, "ExptTurnResultRunLog TrialResults json unmarshal fail, expt_id: %v, expt_run_id: %v", log.ExptID, log.ExptRunID)
+		}
+	}
+
+	var checkpoint *entity.ExptTurnCheckpoint
+	if len(gptr.Indirect(log.Checkpoint)) > 0 {
+		checkpoint = &entity.ExptTurnCheckpoint{}
+		if err := json.Unmarshal(gptr.Indirect(log.Checkpoint), checkpoint); err != nil {
+			return nil, errorx.Wrapf(err, "ExptTurnResultRunLog Checkpoint json unmarshal fail, expt_id: %v, expt_run_id: %v", log.ExptID, log.ExptRunID)
+		}
+	}
+
 	return &entity.ExptTurnResultRunLog{
 		ID:                 log.ID,
 		SpaceID:            log.SpaceID,
@@ -83,6 +113,9 @@ func (ExptTurnResultRunLogConvertor) PO2DO(log *model.ExptTurnResultRunLog) (*en
 		ErrMsg:             conv.UnsafeBytesToString(gptr.Indirect(log.ErrMsg)),
 		UpdatedAt:          log.UpdatedAt,
 		Ext:                ext,
+		CacheHit:           entity.ResultCacheHitFlag(log.CacheHit),
+		TrialResults:       trials,
+		Checkpoint:         checkpoint,
 	}, nil
 }
 
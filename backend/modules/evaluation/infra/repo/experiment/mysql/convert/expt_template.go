@@ -177,6 +177,7 @@ func (ExptTemplateConverter) PO2DO(po *model.ExptTemplate, refs []*model.ExptTem
 						FieldName:     fc.FieldName,
 						FromFieldName: fc.FromField,
 						ConstValue:    fc.Value,
+						Expr:          fc.Expr,
 					})
 				}
 			}
@@ -212,6 +213,7 @@ func (ExptTemplateConverter) PO2DO(po *model.ExptTemplate, refs []*model.ExptTem
 							FieldName:     fc.FieldName,
 							FromFieldName: fc.FromField,
 							ConstValue:    fc.Value,
+							Expr:          fc.Expr,
 						})
 					}
 				}
@@ -221,6 +223,7 @@ func (ExptTemplateConverter) PO2DO(po *model.ExptTemplate, refs []*model.ExptTem
 							FieldName:     fc.FieldName,
 							FromFieldName: fc.FromField,
 							ConstValue:    fc.Value,
+							Expr:          fc.Expr,
 						})
 					}
 				}
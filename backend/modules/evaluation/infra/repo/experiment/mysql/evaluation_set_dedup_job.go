@@ -0,0 +1,79 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/contexts"
+)
+
+// EvaluationSetDedupJobDAO 定义 EvaluationSetDedupJob 的 Dao 接口
+//
+//go:generate mockgen -destination mocks/evaluation_set_dedup_job.go -package=mocks . EvaluationSetDedupJobDAO
+type EvaluationSetDedupJobDAO interface {
+	Create(ctx context.Context, po *model.EvaluationSetDedupJob, opts ...db.Option) error
+	// UpdateResult 更新去重任务状态、报告与错误信息
+	UpdateResult(ctx context.Context, spaceID, id int64, status int32, report, errMsg *[]byte, opts ...db.Option) error
+	Get(ctx context.Context, spaceID, id int64, opts ...db.Option) (*model.EvaluationSetDedupJob, error)
+}
+
+var (
+	evaluationSetDedupJobDaoOnce      = sync.Once{}
+	singletonEvaluationSetDedupJobDao EvaluationSetDedupJobDAO
+)
+
+type EvaluationSetDedupJobDAOImpl struct {
+	provider db.Provider
+}
+
+func NewEvaluationSetDedupJobDAO(p db.Provider) EvaluationSetDedupJobDAO {
+	evaluationSetDedupJobDaoOnce.Do(func() {
+		singletonEvaluationSetDedupJobDao = &EvaluationSetDedupJobDAOImpl{
+			provider: p,
+		}
+	})
+	return singletonEvaluationSetDedupJobDao
+}
+
+func (dao *EvaluationSetDedupJobDAOImpl) Create(ctx context.Context, po *model.EvaluationSetDedupJob, opts ...db.Option) error {
+	dbsession := dao.provider.NewSession(ctx, opts...)
+	return dbsession.WithContext(ctx).Create(po).Error
+}
+
+func (dao *EvaluationSetDedupJobDAOImpl) UpdateResult(ctx context.Context, spaceID, id int64, status int32, report, errMsg *[]byte, opts ...db.Option) error {
+	dbsession := dao.provider.NewSession(ctx, opts...)
+	return dbsession.WithContext(ctx).
+		Model(&model.EvaluationSetDedupJob{}).
+		Where("id = ? AND space_id = ?", id, spaceID).
+		Updates(map[string]interface{}{
+			"status":  status,
+			"report":  report,
+			"err_msg": errMsg,
+		}).Error
+}
+
+func (dao *EvaluationSetDedupJobDAOImpl) Get(ctx context.Context, spaceID, id int64, opts ...db.Option) (*model.EvaluationSetDedupJob, error) {
+	po := &model.EvaluationSetDedupJob{}
+	dbsession := dao.provider.NewSession(ctx, opts...)
+
+	query := dbsession.WithContext(ctx).Where("id = ? AND space_id = ?", id, spaceID)
+	if contexts.CtxWriteDB(ctx) {
+		query = query.Clauses(dbresolver.Write)
+	}
+	if err := query.First(po).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return po, nil
+}
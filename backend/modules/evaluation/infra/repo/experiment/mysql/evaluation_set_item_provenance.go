@@ -0,0 +1,171 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"context"
+	"sync"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/contexts"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+// EvaluationSetItemProvenanceDAO 定义 EvaluationSetItemProvenance 的 Dao 接口
+//
+//go:generate mockgen -destination mocks/evaluation_set_item_provenance.go -package=mocks . EvaluationSetItemProvenanceDAO
+type EvaluationSetItemProvenanceDAO interface {
+	// BatchUpsert 按 (space_id, evaluation_set_id, item_id) 写入, 冲突时覆盖来源字段并恢复已删除的记录
+	BatchUpsert(ctx context.Context, pos []*model.EvaluationSetItemProvenance, opts ...db.Option) error
+	// BatchCreateIfAbsent 按 (space_id, evaluation_set_id, item_id) 写入, 已存在的记录保持不变
+	BatchCreateIfAbsent(ctx context.Context, pos []*model.EvaluationSetItemProvenance, opts ...db.Option) error
+	UpdateEditor(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64, updatedBy string, opts ...db.Option) error
+	UpdateReviewStatus(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64, reviewStatus string, opts ...db.Option) error
+	MGet(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64, opts ...db.Option) ([]*model.EvaluationSetItemProvenance, error)
+	// ListItemIDs 按来源筛选行 id, 按 item_id 升序分页
+	ListItemIDs(ctx context.Context, spaceID, evaluationSetID int64, filter *entity.EvaluationSetItemProvenanceFilter, page entity.Page, opts ...db.Option) ([]int64, int64, error)
+	BatchDelete(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64, opts ...db.Option) error
+}
+
+var (
+	evaluationSetItemProvenanceDaoOnce      = sync.Once{}
+	singletonEvaluationSetItemProvenanceDao EvaluationSetItemProvenanceDAO
+)
+
+type EvaluationSetItemProvenanceDAOImpl struct {
+	provider db.Provider
+}
+
+func NewEvaluationSetItemProvenanceDAO(p db.Provider) EvaluationSetItemProvenanceDAO {
+	evaluationSetItemProvenanceDaoOnce.Do(func() {
+		singletonEvaluationSetItemProvenanceDao = &EvaluationSetItemProvenanceDAOImpl{
+			provider: p,
+		}
+	})
+	return singletonEvaluationSetItemProvenanceDao
+}
+
+func (dao *EvaluationSetItemProvenanceDAOImpl) BatchUpsert(ctx context.Context, pos []*model.EvaluationSetItemProvenance, opts ...db.Option) error {
+	if len(pos) == 0 {
+		return nil
+	}
+	dbsession := dao.provider.NewSession(ctx, opts...)
+	err := dbsession.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{
+			"source_type", "trace_id", "span_id", "import_job_id", "source_expt_id", "source_version_id",
+			"review_status", "created_by", "updated_by", "deleted_at",
+		}),
+	}).CreateInBatches(pos, 100).Error
+	if err != nil {
+		return errorx.Wrapf(err, "EvaluationSetItemProvenance BatchUpsert fail, count=%d", len(pos))
+	}
+	return nil
+}
+
+func (dao *EvaluationSetItemProvenanceDAOImpl) BatchCreateIfAbsent(ctx context.Context, pos []*model.EvaluationSetItemProvenance, opts ...db.Option) error {
+	if len(pos) == 0 {
+		return nil
+	}
+	dbsession := dao.provider.NewSession(ctx, opts...)
+	err := dbsession.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(pos, 100).Error
+	if err != nil {
+		return errorx.Wrapf(err, "EvaluationSetItemProvenance BatchCreateIfAbsent fail, count=%d", len(pos))
+	}
+	return nil
+}
+
+func (dao *EvaluationSetItemProvenanceDAOImpl) UpdateEditor(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64, updatedBy string, opts ...db.Option) error {
+	if len(itemIDs) == 0 {
+		return nil
+	}
+	dbsession := dao.provider.NewSession(ctx, opts...)
+	return dbsession.WithContext(ctx).
+		Model(&model.EvaluationSetItemProvenance{}).
+		Where("space_id = ? AND evaluation_set_id = ? AND item_id IN (?)", spaceID, evaluationSetID, itemIDs).
+		Update("updated_by", updatedBy).Error
+}
+
+func (dao *EvaluationSetItemProvenanceDAOImpl) UpdateReviewStatus(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64, reviewStatus string, opts ...db.Option) error {
+	if len(itemIDs) == 0 {
+		return nil
+	}
+	dbsession := dao.provider.NewSession(ctx, opts...)
+	return dbsession.WithContext(ctx).
+		Model(&model.EvaluationSetItemProvenance{}).
+		Where("space_id = ? AND evaluation_set_id = ? AND item_id IN (?)", spaceID, evaluationSetID, itemIDs).
+		Update("review_status", reviewStatus).Error
+}
+
+func (dao *EvaluationSetItemProvenanceDAOImpl) MGet(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64, opts ...db.Option) ([]*model.EvaluationSetItemProvenance, error) {
+	if len(itemIDs) == 0 {
+		return nil, nil
+	}
+	var pos []*model.EvaluationSetItemProvenance
+	dbsession := dao.provider.NewSession(ctx, opts...)
+
+	query := dbsession.WithContext(ctx).
+		Where("space_id = ? AND evaluation_set_id = ? AND item_id IN (?)", spaceID, evaluationSetID, itemIDs)
+	if contexts.CtxWriteDB(ctx) {
+		query = query.Clauses(dbresolver.Write)
+	}
+	if err := query.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return pos, nil
+}
+
+func (dao *EvaluationSetItemProvenanceDAOImpl) ListItemIDs(ctx context.Context, spaceID, evaluationSetID int64, filter *entity.EvaluationSetItemProvenanceFilter, page entity.Page, opts ...db.Option) ([]int64, int64, error) {
+	dbsession := dao.provider.NewSession(ctx, opts...)
+
+	query := dbsession.WithContext(ctx).
+		Model(&model.EvaluationSetItemProvenance{}).
+		Where("space_id = ? AND evaluation_set_id = ?", spaceID, evaluationSetID)
+	if filter != nil {
+		if len(filter.SourceTypes) > 0 {
+			query = query.Where("source_type IN (?)", filter.SourceTypes)
+		}
+		if len(filter.TraceIDs) > 0 {
+			query = query.Where("trace_id IN (?)", filter.TraceIDs)
+		}
+		if len(filter.ImportJobIDs) > 0 {
+			query = query.Where("import_job_id IN (?)", filter.ImportJobIDs)
+		}
+		if len(filter.SourceExptIDs) > 0 {
+			query = query.Where("source_expt_id IN (?)", filter.SourceExptIDs)
+		}
+		if len(filter.Authors) > 0 {
+			query = query.Where("created_by IN (?)", filter.Authors)
+		}
+		if len(filter.ReviewStatuses) > 0 {
+			query = query.Where("review_status IN (?)", filter.ReviewStatuses)
+		}
+	}
+
+	var total int64
+	countQuery := query.Session(&gorm.Session{})
+	if err := countQuery.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var itemIDs []int64
+	if err := query.Order("item_id ASC").Offset(page.Offset()).Limit(page.Limit()).Pluck("item_id", &itemIDs).Error; err != nil {
+		return nil, 0, err
+	}
+	return itemIDs, total, nil
+}
+
+func (dao *EvaluationSetItemProvenanceDAOImpl) BatchDelete(ctx context.Context, spaceID, evaluationSetID int64, itemIDs []int64, opts ...db.Option) error {
+	if len(itemIDs) == 0 {
+		return nil
+	}
+	dbsession := dao.provider.NewSession(ctx, opts...)
+	return dbsession.WithContext(ctx).
+		Where("space_id = ? AND evaluation_set_id = ? AND item_id IN (?)", spaceID, evaluationSetID, itemIDs).
+		Delete(&model.EvaluationSetItemProvenance{}).Error
+}
@@ -41,6 +41,8 @@ type IExptItemResultDAO interface {
 	BatchCreateNXRunLogs(ctx context.Context, itemRunLogs []*model.ExptItemResultRunLog, opts ...db.Option) error
 	ScanItemRunLogs(ctx context.Context, exptID, exptRunID int64, filter *entity.ExptItemRunLogFilter, cursor, limit, spaceID int64, opts ...db.Option) ([]*model.ExptItemResultRunLog, int64, error)
 	UpdateItemRunLog(ctx context.Context, exptID, exptRunID int64, itemID []int64, ufields map[string]any, spaceID int64, opts ...db.Option) error
+	UpdateItemRunLogByAttempt(ctx context.Context, exptID, exptRunID, itemID int64, attempt int32, ufields map[string]any, spaceID int64, opts ...db.Option) (bool, error)
+	ClaimItemRunLog(ctx context.Context, exptID, exptRunID, itemID int64, attempt int32, spaceID int64, opts ...db.Option) (bool, error)
 	GetItemRunLog(ctx context.Context, exptID, exptRunID, itemID, spaceID int64, opts ...db.Option) (*model.ExptItemResultRunLog, error)
 	MGetItemRunLog(ctx context.Context, exptID, exptRunID int64, itemIDs []int64, spaceID int64, opts ...db.Option) ([]*model.ExptItemResultRunLog, error)
 }
@@ -140,6 +142,9 @@ func (dao *exptItemResultDAOImpl) GetItemRunLog(ctx context.Context, exptID, exp
 
 func (dao *exptItemResultDAOImpl) MGetItemRunLog(ctx context.Context, exptID, exptRunID int64, itemIDs []int64, spaceID int64, opts ...db.Option) ([]*model.ExptItemResultRunLog, error) {
 	db := dao.provider.NewSession(ctx, opts...)
+	if contexts.CtxWriteDB(ctx) {
+		db = db.Clauses(dbresolver.Write)
+	}
 	q := query.Use(db).ExptItemResultRunLog
 	found, err := q.WithContext(ctx).
 		Where(q.SpaceID.Eq(spaceID),
@@ -171,6 +176,46 @@ func (dao *exptItemResultDAOImpl) UpdateItemRunLog(ctx context.Context, exptID,
 	return nil
 }
 
+// UpdateItemRunLogByAttempt 仅在执行轮次仍为 attempt 时更新, 返回是否更新成功
+func (dao *exptItemResultDAOImpl) UpdateItemRunLogByAttempt(ctx context.Context, exptID, exptRunID, itemID int64, attempt int32, ufields map[string]any, spaceID int64, opts ...db.Option) (bool, error) {
+	logs.CtxInfo(ctx, "UpdateItemRunLogByAttempt, expt_id: %v, expt_run_id: %v, item_id: %v, attempt: %v, ufields: %v", exptID, exptRunID, itemID, attempt, ufields)
+	db := dao.provider.NewSession(ctx, opts...)
+	q := query.Use(db).ExptItemResultRunLog
+	info, err := q.WithContext(ctx).
+		Where(
+			q.SpaceID.Eq(spaceID),
+			q.ExptID.Eq(exptID),
+			q.ExptRunID.Eq(exptRunID),
+			q.ItemID.Eq(itemID),
+			q.Attempt.Eq(attempt),
+		).
+		UpdateColumns(ufields)
+	if err != nil {
+		return false, errorx.Wrapf(err, "ExptItemResultRepo.UpdateItemRunLogByAttempt failed, expt_id: %v, run_id: %v, item_id: %v, attempt: %v", exptID, exptRunID, itemID, attempt)
+	}
+	return info.RowsAffected > 0, nil
+}
+
+// ClaimItemRunLog 以 (attempt, processing) 为条件将执行轮次 +1, updated_at 随行更新刷新; 返回是否认领成功
+func (dao *exptItemResultDAOImpl) ClaimItemRunLog(ctx context.Context, exptID, exptRunID, itemID int64, attempt int32, spaceID int64, opts ...db.Option) (bool, error) {
+	db := dao.provider.NewSession(ctx, opts...)
+	q := query.Use(db).ExptItemResultRunLog
+	info, err := q.WithContext(ctx).
+		Where(
+			q.SpaceID.Eq(spaceID),
+			q.ExptID.Eq(exptID),
+			q.ExptRunID.Eq(exptRunID),
+			q.ItemID.Eq(itemID),
+			q.Attempt.Eq(attempt),
+			q.Status.Eq(int32(entity.ItemRunState_Processing)),
+		).
+		UpdateSimple(q.Attempt.Add(1))
+	if err != nil {
+		return false, errorx.Wrapf(err, "ExptItemResultRepo.ClaimItemRunLog failed, expt_id: %v, run_id: %v, item_id: %v, attempt: %v", exptID, exptRunID, itemID, attempt)
+	}
+	return info.RowsAffected > 0, nil
+}
+
 func (dao *exptItemResultDAOImpl) ScanItemResults(ctx context.Context, exptID, cursor, limit int64, status []int32, spaceID int64, opts ...db.Option) (results []*model.ExptItemResult, ncursor int64, err error) {
 	if len(status) == 0 {
 		return nil, 0, fmt.Errorf("ExptItemResultRepo.ScanItemResults with null status")
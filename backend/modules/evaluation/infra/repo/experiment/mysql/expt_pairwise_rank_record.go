@@ -0,0 +1,79 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/experiment/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/contexts"
+)
+
+// ExptPairwiseRankRecordDAO 定义 ExptPairwiseRankRecord 的 Dao 接口
+//
+//go:generate mockgen -destination mocks/expt_pairwise_rank_record.go -package=mocks . ExptPairwiseRankRecordDAO
+type ExptPairwiseRankRecordDAO interface {
+	Create(ctx context.Context, po *model.ExptPairwiseRankRecord, opts ...db.Option) error
+	// UpdateResult 更新排名状态、结果与错误信息
+	UpdateResult(ctx context.Context, spaceID, id int64, status int32, result, errMsg *[]byte, opts ...db.Option) error
+	Get(ctx context.Context, spaceID, id int64, opts ...db.Option) (*model.ExptPairwiseRankRecord, error)
+}
+
+var (
+	exptPairwiseRankRecordDaoOnce      = sync.Once{}
+	singletonExptPairwiseRankRecordDao ExptPairwiseRankRecordDAO
+)
+
+type ExptPairwiseRankRecordDAOImpl struct {
+	provider db.Provider
+}
+
+func NewExptPairwiseRankRecordDAO(p db.Provider) ExptPairwiseRankRecordDAO {
+	exptPairwiseRankRecordDaoOnce.Do(func() {
+		singletonExptPairwiseRankRecordDao = &ExptPairwiseRankRecordDAOImpl{
+			provider: p,
+		}
+	})
+	return singletonExptPairwiseRankRecordDao
+}
+
+func (dao *ExptPairwiseRankRecordDAOImpl) Create(ctx context.Context, po *model.ExptPairwiseRankRecord, opts ...db.Option) error {
+	dbsession := dao.provider.NewSession(ctx, opts...)
+	return dbsession.WithContext(ctx).Create(po).Error
+}
+
+func (dao *ExptPairwiseRankRecordDAOImpl) UpdateResult(ctx context.Context, spaceID, id int64, status int32, result, errMsg *[]byte, opts ...db.Option) error {
+	dbsession := dao.provider.NewSession(ctx, opts...)
+	return dbsession.WithContext(ctx).
+		Model(&model.ExptPairwiseRankRecord{}).
+		Where("id = ? AND space_id = ?", id, spaceID).
+		Updates(map[string]interface{}{
+			"status":  status,
+			"result":  result,
+			"err_msg": errMsg,
+		}).Error
+}
+
+func (dao *ExptPairwiseRankRecordDAOImpl) Get(ctx context.Context, spaceID, id int64, opts ...db.Option) (*model.ExptPairwiseRankRecord, error) {
+	po := &model.ExptPairwiseRankRecord{}
+	dbsession := dao.provider.NewSession(ctx, opts...)
+
+	query := dbsession.WithContext(ctx).Where("id = ? AND space_id = ?", id, spaceID)
+	if contexts.CtxWriteDB(ctx) {
+		query = query.Clauses(dbresolver.Write)
+	}
+	if err := query.First(po).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return po, nil
+}
@@ -26,6 +26,7 @@ type IExptStatsDAO interface {
 	UpdateByExptID(ctx context.Context, exptID, spaceID int64, stats *model.ExptStats) error
 	ArithOperateCount(ctx context.Context, exptID, spaceID int64, cntArithOp *entity.StatsCntArithOp) error
 	Save(ctx context.Context, stats *model.ExptStats) error
+	UpdateConcurrencyState(ctx context.Context, exptID, spaceID int64, concurNum, budgetDeferredCnt int32) error
 }
 
 func NewExptStatsDAO(db db.Provider) IExptStatsDAO {
@@ -83,6 +84,19 @@ func (e *exptStatsDAOImpl) UpdateByExptID(ctx context.Context, exptID, spaceID i
 	return nil
 }
 
+func (e *exptStatsDAOImpl) UpdateConcurrencyState(ctx context.Context, exptID, spaceID int64, concurNum, budgetDeferredCnt int32) error {
+	_, err := e.query.ExptStats.WithContext(ctx).
+		Where(e.query.ExptStats.ExptID.Eq(exptID), e.query.ExptStats.SpaceID.Eq(spaceID)).
+		Updates(map[string]interface{}{
+			"effective_concur_num": concurNum,
+			"budget_deferred_cnt":  budgetDeferredCnt,
+		})
+	if err != nil {
+		return errorx.Wrapf(err, "update ExptStats concurrency state fail, exptID: %v, spaceID: %v, concur_num: %v, budget_deferred_cnt: %v", exptID, spaceID, concurNum, budgetDeferredCnt)
+	}
+	return nil
+}
+
 func (e *exptStatsDAOImpl) Save(ctx context.Context, stats *model.ExptStats) error {
 	err := e.db.NewSession(ctx).Save(stats).Error
 	if err != nil {
@@ -96,7 +110,7 @@ func (e *exptStatsDAOImpl) ArithOperateCount(ctx context.Context, exptID, spaceI
 		return nil
 	}
 
-	if len(cntArithOp.OpStatusCnt) == 0 {
+	if len(cntArithOp.OpStatusCnt) == 0 && cntArithOp.CostDelta.IsZero() {
 		return nil
 	}
 
@@ -115,6 +129,15 @@ func (e *exptStatsDAOImpl) ArithOperateCount(ctx context.Context, exptID, spaceI
 		db.Update(col, gorm.Expr(col+" + ?", opCnt))
 	}
 
+	if delta := cntArithOp.CostDelta; !delta.IsZero() {
+		update = true
+		// token 列历史数据可能为 NULL
+		db.Update("input_token_cost", gorm.Expr("IFNULL(input_token_cost, 0) + ?", delta.GetInputTokens()))
+		db.Update("output_token_cost", gorm.Expr("IFNULL(output_token_cost, 0) + ?", delta.GetOutputTokens()))
+		db.Update("target_cost", gorm.Expr("target_cost + ?", delta.TargetCost))
+		db.Update("evaluator_cost", gorm.Expr("evaluator_cost + ?", delta.EvaluatorCost))
+	}
+
 	if !update {
 		logs.CtxInfo(ctx, "ArithOperateCount without update, cntArithOp: %v", json.Jsonify(cntArithOp))
 		return nil
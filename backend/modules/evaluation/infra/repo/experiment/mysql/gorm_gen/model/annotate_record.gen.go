@@ -8,18 +8,19 @@ const TableNameAnnotateRecord = "annotate_record"
 
 // AnnotateRecord annotate_record
 type AnnotateRecord struct {
-	ID           int64   `gorm:"column:id;type:bigint(20) unsigned;primaryKey;comment:idgen record id" json:"id"`                                                                 // idgen record id
-	SpaceID      int64   `gorm:"column:space_id;type:bigint(20) unsigned;not null;index:idx_space_id_experiment_id_tag_key_id,priority:1;comment:空间id，分片键" json:"space_id"`       // 空间id，分片键
-	TagKeyID     int64   `gorm:"column:tag_key_id;type:bigint(20) unsigned;not null;index:idx_space_id_experiment_id_tag_key_id,priority:3;comment:标签 id" json:"tag_key_id"`      // 标签 id
-	ExperimentID int64   `gorm:"column:experiment_id;type:bigint(20) unsigned;not null;index:idx_space_id_experiment_id_tag_key_id,priority:2;comment:实验id" json:"experiment_id"` // 实验id
-	Score        float64 `gorm:"column:score;type:decimal(10,4);comment:得分结果" json:"score"`                                                                                       // 得分结果
-	TextValue    string  `gorm:"column:text_value;type:varchar(255) character set utf8mb4;not null;comment:文本结果" json:"text_value"`                                               // 文本结果
-	AnnotateData []byte  `gorm:"column:annotate_data;type:mediumblob binary;comment:标注结果, json" json:"annotate_data"`                                                             // 标注结果, json
-	CreatedAt    int64   `gorm:"column:created_at;type:bigint(20);not null;comment:创建时间" json:"created_at"`                                                                       // 创建时间
-	UpdatedAt    int64   `gorm:"column:updated_at;type:bigint(20);not null;comment:更新时间" json:"updated_at"`                                                                       // 更新时间
-	DeletedAt    int64   `gorm:"column:deleted_at;type:bigint(20);not null;comment:软删除时间" json:"deleted_at"`                                                                      // 软删除时间
-	CreatedBy    int64   `gorm:"column:created_by;type:bigint(20);not null;comment:创建人userID" json:"created_by"`                                                                  // 创建人userID
-	TagValueID   int64   `gorm:"column:tag_value_id;type:bigint(20) unsigned;not null;comment:标签值 id" json:"tag_value_id"`                                                        // 标签值 id
+	ID               int64   `gorm:"column:id;type:bigint(20) unsigned;primaryKey;comment:idgen record id" json:"id"`                                                                 // idgen record id
+	SpaceID          int64   `gorm:"column:space_id;type:bigint(20) unsigned;not null;index:idx_space_id_experiment_id_tag_key_id,priority:1;comment:空间id，分片键" json:"space_id"`       // 空间id，分片键
+	TagKeyID         int64   `gorm:"column:tag_key_id;type:bigint(20) unsigned;not null;index:idx_space_id_experiment_id_tag_key_id,priority:3;comment:标签 id" json:"tag_key_id"`      // 标签 id
+	ExperimentID     int64   `gorm:"column:experiment_id;type:bigint(20) unsigned;not null;index:idx_space_id_experiment_id_tag_key_id,priority:2;comment:实验id" json:"experiment_id"` // 实验id
+	ExptTurnResultID int64   `gorm:"column:expt_turn_result_id;type:bigint(20) unsigned;not null;comment:实验 turn result id" json:"expt_turn_result_id"`                               // 实验 turn result id
+	Score            float64 `gorm:"column:score;type:decimal(10,4);comment:得分结果" json:"score"`                                                                                       // 得分结果
+	TextValue        string  `gorm:"column:text_value;type:varchar(255) character set utf8mb4;not null;comment:文本结果" json:"text_value"`                                               // 文本结果
+	AnnotateData     []byte  `gorm:"column:annotate_data;type:mediumblob binary;comment:标注结果, json" json:"annotate_data"`                                                             // 标注结果, json
+	CreatedAt        int64   `gorm:"column:created_at;type:bigint(20);not null;comment:创建时间" json:"created_at"`                                                                       // 创建时间
+	UpdatedAt        int64   `gorm:"column:updated_at;type:bigint(20);not null;comment:更新时间" json:"updated_at"`                                                                       // 更新时间
+	DeletedAt        int64   `gorm:"column:deleted_at;type:bigint(20);not null;comment:软删除时间" json:"deleted_at"`                                                                      // 软删除时间
+	CreatedBy        int64   `gorm:"column:created_by;type:bigint(20);not null;comment:创建人userID" json:"created_by"`                                                                  // 创建人userID
+	TagValueID       int64   `gorm:"column:tag_value_id;type:bigint(20) unsigned;not null;comment:标签值 id" json:"tag_value_id"`                                                        // 标签值 id
 }
 
 // TableName AnnotateRecord's table name
@@ -50,6 +50,8 @@ type Experiment struct {
 	EvalSetSpaceID            int64          `gorm:"column:eval_set_space_id;type:bigint(20) unsigned;not null;default:0;comment:评测集来源空间(跨空间共享,0=同空间)" json:"eval_set_space_id"`                                                                                                                                                                                                                                                                                                                                                                                                                                      // 评测集来源空间(跨空间共享,0=同空间)
 	TargetSpaceID             int64          `gorm:"column:target_space_id;type:bigint(20) unsigned;not null;default:0;comment:评测对象来源空间(跨空间共享,0=同空间)" json:"target_space_id"`                                                                                                                                                                                                                                                                                                                                                                                                                                         // 评测对象来源空间(跨空间共享,0=同空间)
 	EvalSetAccessLevel        string         `gorm:"column:eval_set_access_level;type:varchar(32) character set utf8mb4;not null;default:'';comment:发起冻结的评测集访问级别(execute/readable/空)" json:"eval_set_access_level"`                                                                                                                                                                                                                                                                                                                                                                                                   // 发起冻结的评测集访问级别
+	QualityGateResult         *[]byte        `gorm:"column:quality_gate_result;type:blob binary;comment:质量门禁评估结果，json格式存储" json:"quality_gate_result"`                                                                                                                                                                                                                                                                                                                                                                                                                                                                // 质量门禁评估结果，json格式存储
+	SamplingResult            *[]byte        `gorm:"column:sampling_result;type:blob binary;comment:抽样结果，json格式存储" json:"sampling_result"`                                                                                                                                                                                                                                                                                                                                                                                                                                                                            // 抽样结果，json格式存储
 }
 
 // TableName Experiment's table name
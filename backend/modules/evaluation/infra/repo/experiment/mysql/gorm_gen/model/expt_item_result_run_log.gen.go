@@ -27,6 +27,7 @@ type ExptItemResultRunLog struct {
 	DeletedAt     gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp;comment:删除时间" json:"deleted_at"`                                                                                                                                             // 删除时间
 	LogID         string         `gorm:"column:log_id;type:varchar(128);not null;comment:日志 id" json:"log_id"`                                                                                                                                        // 日志 id
 	ResultState   *int32         `gorm:"column:result_state;type:int(11);index:idx_expt_run_result_state,priority:4;comment:回写结果表状态" json:"result_state"`                                                                                             // 回写结果表状态
+	Attempt       int32          `gorm:"column:attempt;type:int(11) unsigned;not null;comment:执行轮次; 僵尸续跑认领时 +1, 旧轮次的写入被拒绝" json:"attempt"`                                                                                                            // 执行轮次; 僵尸续跑认领时 +1, 旧轮次的写入被拒绝
 }
 
 // TableName ExptItemResultRunLog's table name
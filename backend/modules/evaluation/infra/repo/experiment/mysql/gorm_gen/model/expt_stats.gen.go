@@ -14,20 +14,24 @@ const TableNameExptStats = "expt_stats"
 
 // ExptStats expt_stats
 type ExptStats struct {
-	ID              int64          `gorm:"column:id;type:bigint(20) unsigned;primaryKey;comment:id" json:"id"`                                                   // id
-	SpaceID         int64          `gorm:"column:space_id;type:bigint(20) unsigned;not null;uniqueIndex:uk_space_expt,priority:1;comment:空间 id" json:"space_id"` // 空间 id
-	ExptID          int64          `gorm:"column:expt_id;type:bigint(20) unsigned;not null;uniqueIndex:uk_space_expt,priority:2;comment:实验 id" json:"expt_id"`   // 实验 id
-	PendingCnt      int32          `gorm:"column:pending_cnt;type:int(11);not null;comment:pending_cnt" json:"pending_cnt"`                                      // pending_cnt
-	SuccessCnt      int32          `gorm:"column:success_cnt;type:int(11);not null;comment:success_cnt" json:"success_cnt"`                                      // success_cnt
-	FailCnt         int32          `gorm:"column:fail_cnt;type:int(11);not null;comment:fail_cnt" json:"fail_cnt"`                                               // fail_cnt
-	CreditCost      float64        `gorm:"column:credit_cost;type:decimal(15,2);not null;default:0.00;comment:credit 消耗" json:"credit_cost"`                     // credit 消耗
-	InputTokenCost  *int64         `gorm:"column:input_token_cost;type:bigint(20);comment:input token 消耗" json:"input_token_cost"`                               // input token 消耗
-	OutputTokenCost *int64         `gorm:"column:output_token_cost;type:bigint(20);comment:output token 消耗" json:"output_token_cost"`                            // output token 消耗
-	CreatedAt       time.Time      `gorm:"column:created_at;type:timestamp;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`                   // 创建时间
-	UpdatedAt       time.Time      `gorm:"column:updated_at;type:timestamp;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`                   // 更新时间
-	DeletedAt       gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp;comment:删除时间" json:"deleted_at"`                                                      // 删除时间
-	ProcessingCnt   int32          `gorm:"column:processing_cnt;type:int(11);not null;comment:processing_cnt" json:"processing_cnt"`                             // processing_cnt
-	TerminatedCnt   int32          `gorm:"column:terminated_cnt;type:int(11);not null;comment:terminated_cnt" json:"terminated_cnt"`                             // terminated_cnt
+	ID                 int64          `gorm:"column:id;type:bigint(20) unsigned;primaryKey;comment:id" json:"id"`                                                    // id
+	SpaceID            int64          `gorm:"column:space_id;type:bigint(20) unsigned;not null;uniqueIndex:uk_space_expt,priority:1;comment:空间 id" json:"space_id"`  // 空间 id
+	ExptID             int64          `gorm:"column:expt_id;type:bigint(20) unsigned;not null;uniqueIndex:uk_space_expt,priority:2;comment:实验 id" json:"expt_id"`    // 实验 id
+	PendingCnt         int32          `gorm:"column:pending_cnt;type:int(11);not null;comment:pending_cnt" json:"pending_cnt"`                                       // pending_cnt
+	SuccessCnt         int32          `gorm:"column:success_cnt;type:int(11);not null;comment:success_cnt" json:"success_cnt"`                                       // success_cnt
+	FailCnt            int32          `gorm:"column:fail_cnt;type:int(11);not null;comment:fail_cnt" json:"fail_cnt"`                                                // fail_cnt
+	CreditCost         float64        `gorm:"column:credit_cost;type:decimal(15,2);not null;default:0.00;comment:credit 消耗" json:"credit_cost"`                      // credit 消耗
+	InputTokenCost     *int64         `gorm:"column:input_token_cost;type:bigint(20);comment:input token 消耗" json:"input_token_cost"`                                // input token 消耗
+	OutputTokenCost    *int64         `gorm:"column:output_token_cost;type:bigint(20);comment:output token 消耗" json:"output_token_cost"`                             // output token 消耗
+	CreatedAt          time.Time      `gorm:"column:created_at;type:timestamp;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`                    // 创建时间
+	UpdatedAt          time.Time      `gorm:"column:updated_at;type:timestamp;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`                    // 更新时间
+	DeletedAt          gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp;comment:删除时间" json:"deleted_at"`                                                       // 删除时间
+	ProcessingCnt      int32          `gorm:"column:processing_cnt;type:int(11);not null;comment:processing_cnt" json:"processing_cnt"`                              // processing_cnt
+	TerminatedCnt      int32          `gorm:"column:terminated_cnt;type:int(11);not null;comment:terminated_cnt" json:"terminated_cnt"`                              // terminated_cnt
+	TargetCost         float64        `gorm:"column:target_cost;type:decimal(20,6);not null;default:0.000000;comment:评测对象花费" json:"target_cost"`                     // 评测对象花费
+	EvaluatorCost      float64        `gorm:"column:evaluator_cost;type:decimal(20,6);not null;default:0.000000;comment:评估器花费" json:"evaluator_cost"`                // 评估器花费
+	EffectiveConcurNum int32          `gorm:"column:effective_concur_num;type:int(11);not null;default:0;comment:当前生效的item并发" json:"effective_concur_num"`           // 当前生效的item并发
+	BudgetDeferredCnt  int32          `gorm:"column:budget_deferred_cnt;type:int(11);not null;default:0;comment:最近一轮调度因后端共享预算延后提交的item数" json:"budget_deferred_cnt"` // 最近一轮调度因后端共享预算延后提交的item数
 }
 
 // TableName ExptStats's table name
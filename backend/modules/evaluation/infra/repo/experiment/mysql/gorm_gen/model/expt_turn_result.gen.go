@@ -28,6 +28,8 @@ type ExptTurnResult struct {
 	TargetResultID int64          `gorm:"column:target_result_id;type:bigint(20) unsigned;not null;comment:target_result_id" json:"target_result_id"`                                                                                        // target_result_id
 	ErrMsg         *[]byte        `gorm:"column:err_msg;type:blob binary;comment:错误信息" json:"err_msg"`                                                                                                                                       // 错误信息
 	WeightedScore  *float64       `gorm:"column:weighted_score;type:decimal(10,4);comment:加权汇总得分" json:"weighted_score"`                                                                                                                     // 加权汇总得分
+	CacheHit       int32          `gorm:"column:cache_hit;type:int(11) unsigned;not null;comment:结果缓存命中标记, bit0=评测对象 bit1=评估器" json:"cache_hit"`                                                                                             // 结果缓存命中标记, bit0=评测对象 bit1=评估器
+	TrialResults   *[]byte        `gorm:"column:trial_results;type:blob binary;comment:重复试验结果, json list 格式" json:"trial_results"`                                                                                                           // 重复试验结果, json list 格式
 	CreatedAt      time.Time      `gorm:"column:created_at;type:timestamp;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`                                                                                                // 创建时间
 	UpdatedAt      time.Time      `gorm:"column:updated_at;type:timestamp;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`                                                                                                // 更新时间
 	DeletedAt      gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp;comment:删除时间" json:"deleted_at"`                                                                                                                                   // 删除时间
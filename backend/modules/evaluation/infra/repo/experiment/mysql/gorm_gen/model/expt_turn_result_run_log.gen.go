@@ -32,6 +32,9 @@ type ExptTurnResultRunLog struct {
 	UpdatedAt          time.Time      `gorm:"column:updated_at;type:timestamp;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`                                                               // 更新时间
 	DeletedAt          gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp;comment:删除时间" json:"deleted_at"`                                                                                                  // 删除时间
 	Ext                datatypes.JSON `gorm:"column:ext;type:json;comment:ext" json:"ext"`                                                                                                                      // ext
+	CacheHit           int32          `gorm:"column:cache_hit;type:int(11) unsigned;not null;comment:结果缓存命中标记, bit0=评测对象 bit1=评估器" json:"cache_hit"`                                                            // 结果缓存命中标记, bit0=评测对象 bit1=评估器
+	TrialResults       *[]byte        `gorm:"column:trial_results;type:blob binary;comment:重复试验结果, json list 格式" json:"trial_results"`                                                                          // 重复试验结果, json list 格式
+	Checkpoint         *[]byte        `gorm:"column:checkpoint;type:blob binary;comment:turn 执行检查点, json 格式" json:"checkpoint"`                                                                                 // turn 执行检查点, json 格式
 }
 
 // TableName ExptTurnResultRunLog's table name
@@ -31,6 +31,7 @@ func newAnnotateRecord(db *gorm.DB, opts ...gen.DOOption) annotateRecord {
 	_annotateRecord.SpaceID = field.NewInt64(tableName, "space_id")
 	_annotateRecord.TagKeyID = field.NewInt64(tableName, "tag_key_id")
 	_annotateRecord.ExperimentID = field.NewInt64(tableName, "experiment_id")
+	_annotateRecord.ExptTurnResultID = field.NewInt64(tableName, "expt_turn_result_id")
 	_annotateRecord.Score = field.NewFloat64(tableName, "score")
 	_annotateRecord.TextValue = field.NewString(tableName, "text_value")
 	_annotateRecord.AnnotateData = field.NewBytes(tableName, "annotate_data")
@@ -49,19 +50,20 @@ func newAnnotateRecord(db *gorm.DB, opts ...gen.DOOption) annotateRecord {
 type annotateRecord struct {
 	annotateRecordDo annotateRecordDo
 
-	ALL          field.Asterisk
-	ID           field.Int64   // idgen record id
-	SpaceID      field.Int64   // 空间id，分片键
-	TagKeyID     field.Int64   // 标签 id
-	ExperimentID field.Int64   // 实验id
-	Score        field.Float64 // 得分结果
-	TextValue    field.String  // 文本结果
-	AnnotateData field.Bytes   // 标注结果, json
-	CreatedAt    field.Int64   // 创建时间
-	UpdatedAt    field.Int64   // 更新时间
-	DeletedAt    field.Int64   // 软删除时间
-	CreatedBy    field.Int64   // 创建人userID
-	TagValueID   field.Int64   // 标签值 id
+	ALL              field.Asterisk
+	ID               field.Int64   // idgen record id
+	SpaceID          field.Int64   // 空间id，分片键
+	TagKeyID         field.Int64   // 标签 id
+	ExperimentID     field.Int64   // 实验id
+	ExptTurnResultID field.Int64   // 实验 turn result id
+	Score            field.Float64 // 得分结果
+	TextValue        field.String  // 文本结果
+	AnnotateData     field.Bytes   // 标注结果, json
+	CreatedAt        field.Int64   // 创建时间
+	UpdatedAt        field.Int64   // 更新时间
+	DeletedAt        field.Int64   // 软删除时间
+	CreatedBy        field.Int64   // 创建人userID
+	TagValueID       field.Int64   // 标签值 id
 
 	fieldMap map[string]field.Expr
 }
@@ -82,6 +84,7 @@ func (a *annotateRecord) updateTableName(table string) *annotateRecord {
 	a.SpaceID = field.NewInt64(table, "space_id")
 	a.TagKeyID = field.NewInt64(table, "tag_key_id")
 	a.ExperimentID = field.NewInt64(table, "experiment_id")
+	a.ExptTurnResultID = field.NewInt64(table, "expt_turn_result_id")
 	a.Score = field.NewFloat64(table, "score")
 	a.TextValue = field.NewString(table, "text_value")
 	a.AnnotateData = field.NewBytes(table, "annotate_data")
@@ -118,11 +121,12 @@ func (a *annotateRecord) GetFieldByName(fieldName string) (field.OrderExpr, bool
 }
 
 func (a *annotateRecord) fillFieldMap() {
-	a.fieldMap = make(map[string]field.Expr, 12)
+	a.fieldMap = make(map[string]field.Expr, 13)
 	a.fieldMap["id"] = a.ID
 	a.fieldMap["space_id"] = a.SpaceID
 	a.fieldMap["tag_key_id"] = a.TagKeyID
 	a.fieldMap["experiment_id"] = a.ExperimentID
+	a.fieldMap["expt_turn_result_id"] = a.ExptTurnResultID
 	a.fieldMap["score"] = a.Score
 	a.fieldMap["text_value"] = a.TextValue
 	a.fieldMap["annotate_data"] = a.AnnotateData
@@ -40,6 +40,7 @@ func newExptItemResultRunLog(db *gorm.DB, opts ...gen.DOOption) exptItemResultRu
 	_exptItemResultRunLog.DeletedAt = field.NewField(tableName, "deleted_at")
 	_exptItemResultRunLog.LogID = field.NewString(tableName, "log_id")
 	_exptItemResultRunLog.ResultState = field.NewInt32(tableName, "result_state")
+	_exptItemResultRunLog.Attempt = field.NewInt32(tableName, "attempt")
 
 	_exptItemResultRunLog.fillFieldMap()
 
@@ -64,6 +65,7 @@ type exptItemResultRunLog struct {
 	DeletedAt     field.Field  // 删除时间
 	LogID         field.String // 日志 id
 	ResultState   field.Int32  // 回写结果表状态
+	Attempt       field.Int32  // 执行轮次; 僵尸续跑认领时 +1, 旧轮次的写入被拒绝
 
 	fieldMap map[string]field.Expr
 }
@@ -93,6 +95,7 @@ func (e *exptItemResultRunLog) updateTableName(table string) *exptItemResultRunL
 	e.DeletedAt = field.NewField(table, "deleted_at")
 	e.LogID = field.NewString(table, "log_id")
 	e.ResultState = field.NewInt32(table, "result_state")
+	e.Attempt = field.NewInt32(table, "attempt")
 
 	e.fillFieldMap()
 
@@ -121,7 +124,7 @@ func (e *exptItemResultRunLog) GetFieldByName(fieldName string) (field.OrderExpr
 }
 
 func (e *exptItemResultRunLog) fillFieldMap() {
-	e.fieldMap = make(map[string]field.Expr, 13)
+	e.fieldMap = make(map[string]field.Expr, 14)
 	e.fieldMap["id"] = e.ID
 	e.fieldMap["space_id"] = e.SpaceID
 	e.fieldMap["expt_id"] = e.ExptID
@@ -135,6 +138,7 @@ func (e *exptItemResultRunLog) fillFieldMap() {
 	e.fieldMap["deleted_at"] = e.DeletedAt
 	e.fieldMap["log_id"] = e.LogID
 	e.fieldMap["result_state"] = e.ResultState
+	e.fieldMap["attempt"] = e.Attempt
 }
 
 func (e exptItemResultRunLog) clone(db *gorm.DB) exptItemResultRunLog {
@@ -41,6 +41,10 @@ func newExptStats(db *gorm.DB, opts ...gen.DOOption) exptStats {
 	_exptStats.DeletedAt = field.NewField(tableName, "deleted_at")
 	_exptStats.ProcessingCnt = field.NewInt32(tableName, "processing_cnt")
 	_exptStats.TerminatedCnt = field.NewInt32(tableName, "terminated_cnt")
+	_exptStats.TargetCost = field.NewFloat64(tableName, "target_cost")
+	_exptStats.EvaluatorCost = field.NewFloat64(tableName, "evaluator_cost")
+	_exptStats.EffectiveConcurNum = field.NewInt32(tableName, "effective_concur_num")
+	_exptStats.BudgetDeferredCnt = field.NewInt32(tableName, "budget_deferred_cnt")
 
 	_exptStats.fillFieldMap()
 
@@ -51,21 +55,25 @@ func newExptStats(db *gorm.DB, opts ...gen.DOOption) exptStats {
 type exptStats struct {
 	exptStatsDo exptStatsDo
 
-	ALL             field.Asterisk
-	ID              field.Int64   // id
-	SpaceID         field.Int64   // 空间 id
-	ExptID          field.Int64   // 实验 id
-	PendingCnt      field.Int32   // pending_cnt
-	SuccessCnt      field.Int32   // success_cnt
-	FailCnt         field.Int32   // fail_cnt
-	CreditCost      field.Float64 // credit 消耗
-	InputTokenCost  field.Int64   // input token 消耗
-	OutputTokenCost field.Int64   // output token 消耗
-	CreatedAt       field.Time    // 创建时间
-	UpdatedAt       field.Time    // 更新时间
-	DeletedAt       field.Field   // 删除时间
-	ProcessingCnt   field.Int32   // processing_cnt
-	TerminatedCnt   field.Int32   // terminated_cnt
+	ALL                field.Asterisk
+	ID                 field.Int64   // id
+	SpaceID            field.Int64   // 空间 id
+	ExptID             field.Int64   // 实验 id
+	PendingCnt         field.Int32   // pending_cnt
+	SuccessCnt         field.Int32   // success_cnt
+	FailCnt            field.Int32   // fail_cnt
+	CreditCost         field.Float64 // credit 消耗
+	InputTokenCost     field.Int64   // input token 消耗
+	OutputTokenCost    field.Int64   // output token 消耗
+	CreatedAt          field.Time    // 创建时间
+	UpdatedAt          field.Time    // 更新时间
+	DeletedAt          field.Field   // 删除时间
+	ProcessingCnt      field.Int32   // processing_cnt
+	TerminatedCnt      field.Int32   // terminated_cnt
+	TargetCost         field.Float64 // 评测对象花费
+	EvaluatorCost      field.Float64 // 评估器花费
+	EffectiveConcurNum field.Int32   // 当前生效的item并发
+	BudgetDeferredCnt  field.Int32   // 最近一轮调度因后端共享预算延后提交的item数
 
 	fieldMap map[string]field.Expr
 }
@@ -96,6 +104,10 @@ func (e *exptStats) updateTableName(table string) *exptStats {
 	e.DeletedAt = field.NewField(table, "deleted_at")
 	e.ProcessingCnt = field.NewInt32(table, "processing_cnt")
 	e.TerminatedCnt = field.NewInt32(table, "terminated_cnt")
+	e.TargetCost = field.NewFloat64(table, "target_cost")
+	e.EvaluatorCost = field.NewFloat64(table, "evaluator_cost")
+	e.EffectiveConcurNum = field.NewInt32(table, "effective_concur_num")
+	e.BudgetDeferredCnt = field.NewInt32(table, "budget_deferred_cnt")
 
 	e.fillFieldMap()
 
@@ -122,7 +134,7 @@ func (e *exptStats) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (e *exptStats) fillFieldMap() {
-	e.fieldMap = make(map[string]field.Expr, 14)
+	e.fieldMap = make(map[string]field.Expr, 18)
 	e.fieldMap["id"] = e.ID
 	e.fieldMap["space_id"] = e.SpaceID
 	e.fieldMap["expt_id"] = e.ExptID
@@ -137,6 +149,10 @@ func (e *exptStats) fillFieldMap() {
 	e.fieldMap["deleted_at"] = e.DeletedAt
 	e.fieldMap["processing_cnt"] = e.ProcessingCnt
 	e.fieldMap["terminated_cnt"] = e.TerminatedCnt
+	e.fieldMap["target_cost"] = e.TargetCost
+	e.fieldMap["evaluator_cost"] = e.EvaluatorCost
+	e.fieldMap["effective_concur_num"] = e.EffectiveConcurNum
+	e.fieldMap["budget_deferred_cnt"] = e.BudgetDeferredCnt
 }
 
 func (e exptStats) clone(db *gorm.DB) exptStats {
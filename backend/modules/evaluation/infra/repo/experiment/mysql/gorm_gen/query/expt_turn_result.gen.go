@@ -41,6 +41,8 @@ func newExptTurnResult(db *gorm.DB, opts ...gen.DOOption) exptTurnResult {
 	_exptTurnResult.TargetResultID = field.NewInt64(tableName, "target_result_id")
 	_exptTurnResult.ErrMsg = field.NewBytes(tableName, "err_msg")
 	_exptTurnResult.WeightedScore = field.NewFloat64(tableName, "weighted_score")
+	_exptTurnResult.CacheHit = field.NewInt32(tableName, "cache_hit")
+	_exptTurnResult.TrialResults = field.NewBytes(tableName, "trial_results")
 	_exptTurnResult.CreatedAt = field.NewTime(tableName, "created_at")
 	_exptTurnResult.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_exptTurnResult.DeletedAt = field.NewField(tableName, "deleted_at")
@@ -69,6 +71,8 @@ type exptTurnResult struct {
 	TargetResultID field.Int64   // target_result_id
 	ErrMsg         field.Bytes   // 错误信息
 	WeightedScore  field.Float64 // 加权汇总得分
+	CacheHit       field.Int32   // 结果缓存命中标记, bit0=评测对象 bit1=评估器
+	TrialResults   field.Bytes   // 重复试验结果, json list 格式
 	CreatedAt      field.Time    // 创建时间
 	UpdatedAt      field.Time    // 更新时间
 	DeletedAt      field.Field   // 删除时间
@@ -102,6 +106,8 @@ func (e *exptTurnResult) updateTableName(table string) *exptTurnResult {
 	e.TargetResultID = field.NewInt64(table, "target_result_id")
 	e.ErrMsg = field.NewBytes(table, "err_msg")
 	e.WeightedScore = field.NewFloat64(table, "weighted_score")
+	e.CacheHit = field.NewInt32(table, "cache_hit")
+	e.TrialResults = field.NewBytes(table, "trial_results")
 	e.CreatedAt = field.NewTime(table, "created_at")
 	e.UpdatedAt = field.NewTime(table, "updated_at")
 	e.DeletedAt = field.NewField(table, "deleted_at")
@@ -133,7 +139,7 @@ func (e *exptTurnResult) GetFieldByName(fieldName string) (field.OrderExpr, bool
 }
 
 func (e *exptTurnResult) fillFieldMap() {
-	e.fieldMap = make(map[string]field.Expr, 17)
+	e.fieldMap = make(map[string]field.Expr, 19)
 	e.fieldMap["id"] = e.ID
 	e.fieldMap["space_id"] = e.SpaceID
 	e.fieldMap["expt_id"] = e.ExptID
@@ -148,6 +154,8 @@ func (e *exptTurnResult) fillFieldMap() {
 	e.fieldMap["target_result_id"] = e.TargetResultID
 	e.fieldMap["err_msg"] = e.ErrMsg
 	e.fieldMap["weighted_score"] = e.WeightedScore
+	e.fieldMap["cache_hit"] = e.CacheHit
+	e.fieldMap["trial_results"] = e.TrialResults
 	e.fieldMap["created_at"] = e.CreatedAt
 	e.fieldMap["updated_at"] = e.UpdatedAt
 	e.fieldMap["deleted_at"] = e.DeletedAt
@@ -44,6 +44,9 @@ func newExptTurnResultRunLog(db *gorm.DB, opts ...gen.DOOption) exptTurnResultRu
 	_exptTurnResultRunLog.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_exptTurnResultRunLog.DeletedAt = field.NewField(tableName, "deleted_at")
 	_exptTurnResultRunLog.Ext = field.NewField(tableName, "ext")
+	_exptTurnResultRunLog.CacheHit = field.NewInt32(tableName, "cache_hit")
+	_exptTurnResultRunLog.TrialResults = field.NewBytes(tableName, "trial_results")
+	_exptTurnResultRunLog.Checkpoint = field.NewBytes(tableName, "checkpoint")
 
 	_exptTurnResultRunLog.fillFieldMap()
 
@@ -72,6 +75,9 @@ type exptTurnResultRunLog struct {
 	UpdatedAt          field.Time   // 更新时间
 	DeletedAt          field.Field  // 删除时间
 	Ext                field.Field  // ext
+	CacheHit           field.Int32  // 结果缓存命中标记, bit0=评测对象 bit1=评估器
+	TrialResults       field.Bytes  // 重复试验结果, json list 格式
+	Checkpoint         field.Bytes  // turn 执行检查点, json 格式
 
 	fieldMap map[string]field.Expr
 }
@@ -105,6 +111,9 @@ func (e *exptTurnResultRunLog) updateTableName(table string) *exptTurnResultRunL
 	e.UpdatedAt = field.NewTime(table, "updated_at")
 	e.DeletedAt = field.NewField(table, "deleted_at")
 	e.Ext = field.NewField(table, "ext")
+	e.CacheHit = field.NewInt32(table, "cache_hit")
+	e.TrialResults = field.NewBytes(table, "trial_results")
+	e.Checkpoint = field.NewBytes(table, "checkpoint")
 
 	e.fillFieldMap()
 
@@ -133,7 +142,7 @@ func (e *exptTurnResultRunLog) GetFieldByName(fieldName string) (field.OrderExpr
 }
 
 func (e *exptTurnResultRunLog) fillFieldMap() {
-	e.fieldMap = make(map[string]field.Expr, 16)
+	e.fieldMap = make(map[string]field.Expr, 19)
 	e.fieldMap["id"] = e.ID
 	e.fieldMap["space_id"] = e.SpaceID
 	e.fieldMap["expt_id"] = e.ExptID
@@ -151,6 +160,9 @@ func (e *exptTurnResultRunLog) fillFieldMap() {
 	e.fieldMap["updated_at"] = e.UpdatedAt
 	e.fieldMap["deleted_at"] = e.DeletedAt
 	e.fieldMap["ext"] = e.Ext
+	e.fieldMap["cache_hit"] = e.CacheHit
+	e.fieldMap["trial_results"] = e.TrialResults
+	e.fieldMap["checkpoint"] = e.Checkpoint
 }
 
 func (e exptTurnResultRunLog) clone(db *gorm.DB) exptTurnResultRunLog {
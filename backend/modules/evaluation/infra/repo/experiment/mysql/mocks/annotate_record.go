@@ -22,7 +22,6 @@ import (
 type MockIAnnotateRecordDAO struct {
 	ctrl     *gomock.Controller
 	recorder *MockIAnnotateRecordDAOMockRecorder
-	isgomock struct{}
 }
 
 // MockIAnnotateRecordDAOMockRecorder is the mock recorder for MockIAnnotateRecordDAO.
@@ -43,10 +42,10 @@ func (m *MockIAnnotateRecordDAO) EXPECT() *MockIAnnotateRecordDAOMockRecorder {
 }
 
 // BatchSave mocks base method.
-func (m *MockIAnnotateRecordDAO) BatchSave(ctx context.Context, annotateRecord []*model.AnnotateRecord, opts ...db.Option) error {
+func (m *MockIAnnotateRecordDAO) BatchSave(arg0 context.Context, arg1 []*model.AnnotateRecord, arg2 ...db.Option) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, annotateRecord}
-	for _, a := range opts {
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "BatchSave", varargs...)
@@ -55,32 +54,62 @@ func (m *MockIAnnotateRecordDAO) BatchSave(ctx context.Context, annotateRecord [
 }
 
 // BatchSave indicates an expected call of BatchSave.
-func (mr *MockIAnnotateRecordDAOMockRecorder) BatchSave(ctx, annotateRecord any, opts ...any) *gomock.Call {
+func (mr *MockIAnnotateRecordDAOMockRecorder) BatchSave(arg0, arg1 any, arg2 ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, annotateRecord}, opts...)
+	varargs := append([]any{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchSave", reflect.TypeOf((*MockIAnnotateRecordDAO)(nil).BatchSave), varargs...)
 }
 
+// ListByTagKeyID mocks base method.
+func (m *MockIAnnotateRecordDAO) ListByTagKeyID(arg0 context.Context, arg1, arg2, arg3 int64) ([]*model.AnnotateRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTagKeyID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*model.AnnotateRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTagKeyID indicates an expected call of ListByTagKeyID.
+func (mr *MockIAnnotateRecordDAOMockRecorder) ListByTagKeyID(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTagKeyID", reflect.TypeOf((*MockIAnnotateRecordDAO)(nil).ListByTagKeyID), arg0, arg1, arg2, arg3)
+}
+
+// ListByTurnResultID mocks base method.
+func (m *MockIAnnotateRecordDAO) ListByTurnResultID(arg0 context.Context, arg1, arg2, arg3, arg4 int64) ([]*model.AnnotateRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTurnResultID", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*model.AnnotateRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTurnResultID indicates an expected call of ListByTurnResultID.
+func (mr *MockIAnnotateRecordDAOMockRecorder) ListByTurnResultID(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTurnResultID", reflect.TypeOf((*MockIAnnotateRecordDAO)(nil).ListByTurnResultID), arg0, arg1, arg2, arg3, arg4)
+}
+
 // MGetByID mocks base method.
-func (m *MockIAnnotateRecordDAO) MGetByID(ctx context.Context, ids []int64) ([]*model.AnnotateRecord, error) {
+func (m *MockIAnnotateRecordDAO) MGetByID(arg0 context.Context, arg1 []int64) ([]*model.AnnotateRecord, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MGetByID", ctx, ids)
+	ret := m.ctrl.Call(m, "MGetByID", arg0, arg1)
 	ret0, _ := ret[0].([]*model.AnnotateRecord)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // MGetByID indicates an expected call of MGetByID.
-func (mr *MockIAnnotateRecordDAOMockRecorder) MGetByID(ctx, ids any) *gomock.Call {
+func (mr *MockIAnnotateRecordDAOMockRecorder) MGetByID(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGetByID", reflect.TypeOf((*MockIAnnotateRecordDAO)(nil).MGetByID), ctx, ids)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGetByID", reflect.TypeOf((*MockIAnnotateRecordDAO)(nil).MGetByID), arg0, arg1)
 }
 
 // Save mocks base method.
-func (m *MockIAnnotateRecordDAO) Save(ctx context.Context, annotateRecord *model.AnnotateRecord, opts ...db.Option) error {
+func (m *MockIAnnotateRecordDAO) Save(arg0 context.Context, arg1 *model.AnnotateRecord, arg2 ...db.Option) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, annotateRecord}
-	for _, a := range opts {
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Save", varargs...)
@@ -89,17 +118,17 @@ func (m *MockIAnnotateRecordDAO) Save(ctx context.Context, annotateRecord *model
 }
 
 // Save indicates an expected call of Save.
-func (mr *MockIAnnotateRecordDAOMockRecorder) Save(ctx, annotateRecord any, opts ...any) *gomock.Call {
+func (mr *MockIAnnotateRecordDAOMockRecorder) Save(arg0, arg1 any, arg2 ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, annotateRecord}, opts...)
+	varargs := append([]any{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIAnnotateRecordDAO)(nil).Save), varargs...)
 }
 
 // Update mocks base method.
-func (m *MockIAnnotateRecordDAO) Update(ctx context.Context, annotateRecord *model.AnnotateRecord, opts ...db.Option) error {
+func (m *MockIAnnotateRecordDAO) Update(arg0 context.Context, arg1 *model.AnnotateRecord, arg2 ...db.Option) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, annotateRecord}
-	for _, a := range opts {
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Update", varargs...)
@@ -108,8 +137,8 @@ func (m *MockIAnnotateRecordDAO) Update(ctx context.Context, annotateRecord *mod
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockIAnnotateRecordDAOMockRecorder) Update(ctx, annotateRecord any, opts ...any) *gomock.Call {
+func (mr *MockIAnnotateRecordDAOMockRecorder) Update(arg0, arg1 any, arg2 ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, annotateRecord}, opts...)
+	varargs := append([]any{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIAnnotateRecordDAO)(nil).Update), varargs...)
 }
@@ -332,6 +332,46 @@ func (mr *MockIExptItemResultDAOMockRecorder) UpdateItemRunLog(ctx, exptID, expt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemRunLog", reflect.TypeOf((*MockIExptItemResultDAO)(nil).UpdateItemRunLog), varargs...)
 }
 
+// UpdateItemRunLogByAttempt mocks base method.
+func (m *MockIExptItemResultDAO) UpdateItemRunLogByAttempt(ctx context.Context, exptID, exptRunID, itemID int64, attempt int32, ufields map[string]any, spaceID int64, opts ...db.Option) (bool, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, exptID, exptRunID, itemID, attempt, ufields, spaceID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateItemRunLogByAttempt", varargs...)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateItemRunLogByAttempt indicates an expected call of UpdateItemRunLogByAttempt.
+func (mr *MockIExptItemResultDAOMockRecorder) UpdateItemRunLogByAttempt(ctx, exptID, exptRunID, itemID, attempt, ufields, spaceID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, exptID, exptRunID, itemID, attempt, ufields, spaceID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemRunLogByAttempt", reflect.TypeOf((*MockIExptItemResultDAO)(nil).UpdateItemRunLogByAttempt), varargs...)
+}
+
+// ClaimItemRunLog mocks base method.
+func (m *MockIExptItemResultDAO) ClaimItemRunLog(ctx context.Context, exptID, exptRunID, itemID int64, attempt int32, spaceID int64, opts ...db.Option) (bool, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, exptID, exptRunID, itemID, attempt, spaceID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ClaimItemRunLog", varargs...)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimItemRunLog indicates an expected call of ClaimItemRunLog.
+func (mr *MockIExptItemResultDAOMockRecorder) ClaimItemRunLog(ctx, exptID, exptRunID, itemID, attempt, spaceID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, exptID, exptRunID, itemID, attempt, spaceID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimItemRunLog", reflect.TypeOf((*MockIExptItemResultDAO)(nil).ClaimItemRunLog), varargs...)
+}
+
 // UpdateItemsResult mocks base method.
 func (m *MockIExptItemResultDAO) UpdateItemsResult(ctx context.Context, spaceID, exptID int64, itemID []int64, ufields map[string]any, opts ...db.Option) error {
 	m.ctrl.T.Helper()
@@ -127,3 +127,17 @@ func (mr *MockIExptStatsDAOMockRecorder) UpdateByExptID(ctx, exptID, spaceID, st
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByExptID", reflect.TypeOf((*MockIExptStatsDAO)(nil).UpdateByExptID), ctx, exptID, spaceID, stats)
 }
+
+// UpdateConcurrencyState mocks base method.
+func (m *MockIExptStatsDAO) UpdateConcurrencyState(ctx context.Context, exptID, spaceID int64, concurNum, budgetDeferredCnt int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConcurrencyState", ctx, exptID, spaceID, concurNum, budgetDeferredCnt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateConcurrencyState indicates an expected call of UpdateConcurrencyState.
+func (mr *MockIExptStatsDAOMockRecorder) UpdateConcurrencyState(ctx, exptID, spaceID, concurNum, budgetDeferredCnt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConcurrencyState", reflect.TypeOf((*MockIExptStatsDAO)(nil).UpdateConcurrencyState), ctx, exptID, spaceID, concurNum, budgetDeferredCnt)
+}
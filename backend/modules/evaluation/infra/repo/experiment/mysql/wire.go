@@ -27,4 +27,7 @@ var ExperimentMySQLDAOSet = wire.NewSet(
 	NewExptInsightAnalysisFeedbackCommentDAO,
 	NewExptTemplateDAO,
 	NewExptTemplateEvaluatorRefDAO,
+	NewEvaluationSetItemProvenanceDAO,
+	NewExptPairwiseRankRecordDAO,
+	NewEvaluationSetDedupJobDAO,
 )
@@ -0,0 +1,124 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/infra/redis"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+// concurrencyStateTTL 观测窗口与并发状态的存活时间, 调度器每个 tick 都会刷新, 实验结束后自然过期
+const concurrencyStateTTL = 24 * time.Hour
+
+const (
+	obsFieldTotal     = "total"
+	obsFieldFailed    = "failed"
+	obsFieldThrottled = "throttled"
+	obsFieldLatencyMS = "latency_ms"
+)
+
+type IConcurrencyDAO interface {
+	IncrBackendObservation(ctx context.Context, exptID int64, backend string, obs *entity.ExptBackendObservation) error
+	PopBackendObservation(ctx context.Context, exptID int64, backend string) (*entity.ExptBackendObservation, error)
+	GetBackendConcurNum(ctx context.Context, exptID int64, backend string) (int, error)
+	SetBackendConcurNum(ctx context.Context, exptID int64, backend string, concurNum int) error
+}
+
+func NewConcurrencyDAO(cmdable redis.Cmdable) IConcurrencyDAO {
+	const table = "experiment"
+	return &concurrencyDAOImpl{cmdable: cmdable, table: table}
+}
+
+type concurrencyDAOImpl struct {
+	cmdable redis.Cmdable
+	table   string
+}
+
+func (c *concurrencyDAOImpl) makeObservationKey(exptID int64, backend string) string {
+	return fmt.Sprintf("[%s]adaptive_concur_obs:%d:%s", c.table, exptID, backend)
+}
+
+func (c *concurrencyDAOImpl) makeConcurNumKey(exptID int64, backend string) string {
+	return fmt.Sprintf("[%s]adaptive_concur_num:%d:%s", c.table, exptID, backend)
+}
+
+func (c *concurrencyDAOImpl) IncrBackendObservation(ctx context.Context, exptID int64, backend string, obs *entity.ExptBackendObservation) error {
+	if obs.GetTotal() == 0 {
+		return nil
+	}
+	key := c.makeObservationKey(exptID, backend)
+	pipe := c.cmdable.Pipeline()
+	pipe.HIncrBy(ctx, key, obsFieldTotal, obs.Total)
+	pipe.HIncrBy(ctx, key, obsFieldFailed, obs.Failed)
+	pipe.HIncrBy(ctx, key, obsFieldThrottled, obs.Throttled)
+	pipe.HIncrBy(ctx, key, obsFieldLatencyMS, obs.LatencyMS)
+	pipe.Expire(ctx, key, concurrencyStateTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errorx.Wrapf(err, "redis incr backend observation fail, key: %v", key)
+	}
+	return nil
+}
+
+// popObservationScript 原子地读取并删除观测窗口, 避免读取与删除之间的新观测丢失
+const popObservationScript = `
+local res = redis.call('HGETALL', KEYS[1])
+redis.call('DEL', KEYS[1])
+return res
+`
+
+func (c *concurrencyDAOImpl) PopBackendObservation(ctx context.Context, exptID int64, backend string) (*entity.ExptBackendObservation, error) {
+	key := c.makeObservationKey(exptID, backend)
+	got, err := c.cmdable.Eval(ctx, popObservationScript, []string{key}).StringSlice()
+	if err != nil {
+		if redis.IsNilError(err) {
+			return &entity.ExptBackendObservation{}, nil
+		}
+		return nil, errorx.Wrapf(err, "redis pop backend observation fail, key: %v", key)
+	}
+
+	obs := &entity.ExptBackendObservation{}
+	for i := 0; i+1 < len(got); i += 2 {
+		val, err := strconv.ParseInt(got[i+1], 10, 64)
+		if err != nil {
+			return nil, errorx.Wrapf(err, "parse backend observation fail, key: %v, field: %v", key, got[i])
+		}
+		switch got[i] {
+		case obsFieldTotal:
+			obs.Total = val
+		case obsFieldFailed:
+			obs.Failed = val
+		case obsFieldThrottled:
+			obs.Throttled = val
+		case obsFieldLatencyMS:
+			obs.LatencyMS = val
+		}
+	}
+	return obs, nil
+}
+
+func (c *concurrencyDAOImpl) GetBackendConcurNum(ctx context.Context, exptID int64, backend string) (int, error) {
+	key := c.makeConcurNumKey(exptID, backend)
+	got, err := c.cmdable.Get(ctx, key).Int()
+	if err != nil {
+		if redis.IsNilError(err) {
+			return 0, nil
+		}
+		return 0, errorx.Wrapf(err, "redis get fail, key: %v", key)
+	}
+	return got, nil
+}
+
+func (c *concurrencyDAOImpl) SetBackendConcurNum(ctx context.Context, exptID int64, backend string, concurNum int) error {
+	key := c.makeConcurNumKey(exptID, backend)
+	if err := c.cmdable.Set(ctx, key, concurNum, concurrencyStateTTL).Err(); err != nil {
+		return errorx.Wrapf(err, "redis set key: %v", key)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	infraredis "github.com/coze-dev/coze-loop/backend/infra/redis"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func TestConcurrencyDAO(t *testing.T) {
+	t.Parallel()
+	dao := NewConcurrencyDAO(infraredis.NewTestRedis(t))
+	ctx := context.Background()
+
+	obs, err := dao.PopBackendObservation(ctx, 1, "model:1")
+	require.NoError(t, err)
+	assert.Equal(t, &entity.ExptBackendObservation{}, obs)
+
+	require.NoError(t, dao.IncrBackendObservation(ctx, 1, "model:1", &entity.ExptBackendObservation{Total: 2, Failed: 1, LatencyMS: 300}))
+	require.NoError(t, dao.IncrBackendObservation(ctx, 1, "model:1", &entity.ExptBackendObservation{Total: 1, Throttled: 1, Failed: 1, LatencyMS: 100}))
+	// 不同实验的观测互不影响
+	require.NoError(t, dao.IncrBackendObservation(ctx, 2, "model:1", &entity.ExptBackendObservation{Total: 5}))
+
+	obs, err = dao.PopBackendObservation(ctx, 1, "model:1")
+	require.NoError(t, err)
+	assert.Equal(t, &entity.ExptBackendObservation{Total: 3, Failed: 2, Throttled: 1, LatencyMS: 400}, obs)
+
+	// 取出后窗口清空
+	obs, err = dao.PopBackendObservation(ctx, 1, "model:1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), obs.Total)
+
+	got, err := dao.GetBackendConcurNum(ctx, 1, "model:1")
+	require.NoError(t, err)
+	assert.Equal(t, 0, got)
+	require.NoError(t, dao.SetBackendConcurNum(ctx, 1, "model:1", 8))
+	got, err = dao.GetBackendConcurNum(ctx, 1, "model:1")
+	require.NoError(t, err)
+	assert.Equal(t, 8, got)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/infra/redis"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/conv"
+)
+
+// defaultResultCacheTTL 空间未单独设置 TTL 时的缓存存活时间
+const defaultResultCacheTTL = 7 * 24 * time.Hour
+
+type IResultCacheDAO interface {
+	GetResultCache(ctx context.Context, conf *entity.ResultCacheSpaceConf, kind entity.ResultCacheKind, key string) (*entity.ResultCacheEntry, error)
+	SetResultCache(ctx context.Context, conf *entity.ResultCacheSpaceConf, kind entity.ResultCacheKind, key string, entry *entity.ResultCacheEntry) error
+	GetSpaceConf(ctx context.Context, spaceID int64) (*entity.ResultCacheSpaceConf, error)
+	SetSpaceTTL(ctx context.Context, spaceID int64, ttl time.Duration) error
+	IncrSpaceGeneration(ctx context.Context, spaceID int64) (int64, error)
+}
+
+func NewResultCacheDAO(cmdable redis.Cmdable) IResultCacheDAO {
+	const table = "experiment"
+	return &resultCacheDAOImpl{cmdable: cmdable, table: table}
+}
+
+type resultCacheDAOImpl struct {
+	cmdable redis.Cmdable
+	table   string
+}
+
+func (r *resultCacheDAOImpl) makeResultCacheKey(spaceID, generation int64, kind entity.ResultCacheKind, key string) string {
+	return fmt.Sprintf("[%s]result_cache:%d:%d:%s:%s", r.table, spaceID, generation, kind, key)
+}
+
+func (r *resultCacheDAOImpl) makeSpaceTTLKey(spaceID int64) string {
+	return fmt.Sprintf("[%s]result_cache_ttl:%d", r.table, spaceID)
+}
+
+func (r *resultCacheDAOImpl) makeSpaceGenerationKey(spaceID int64) string {
+	return fmt.Sprintf("[%s]result_cache_generation:%d", r.table, spaceID)
+}
+
+func (r *resultCacheDAOImpl) GetResultCache(ctx context.Context, conf *entity.ResultCacheSpaceConf, kind entity.ResultCacheKind, key string) (*entity.ResultCacheEntry, error) {
+	redisKey := r.makeResultCacheKey(conf.SpaceID, conf.Generation, kind, key)
+	got, err := r.cmdable.Get(ctx, redisKey).Result()
+	if err != nil {
+		if redis.IsNilError(err) {
+			return nil, nil
+		}
+		return nil, errorx.Wrapf(err, "redis get fail, key: %v", redisKey)
+	}
+	entry := &entity.ResultCacheEntry{}
+	if err := json.Unmarshal(conv.UnsafeStringToBytes(got), entry); err != nil {
+		return nil, errorx.Wrapf(err, "ResultCacheEntry json unmarshal failed, key: %v", redisKey)
+	}
+	return entry, nil
+}
+
+func (r *resultCacheDAOImpl) SetResultCache(ctx context.Context, conf *entity.ResultCacheSpaceConf, kind entity.ResultCacheKind, key string, entry *entity.ResultCacheEntry) error {
+	bytes, err := json.Marshal(entry)
+	if err != nil {
+		return errorx.Wrapf(err, "ResultCacheEntry json marshal failed")
+	}
+	ttl := conf.TTL
+	if ttl <= 0 {
+		ttl = defaultResultCacheTTL
+	}
+	redisKey := r.makeResultCacheKey(conf.SpaceID, conf.Generation, kind, key)
+	if err := r.cmdable.Set(ctx, redisKey, bytes, ttl).Err(); err != nil {
+		return errorx.Wrapf(err, "redis set key: %v", redisKey)
+	}
+	return nil
+}
+
+func (r *resultCacheDAOImpl) GetSpaceConf(ctx context.Context, spaceID int64) (*entity.ResultCacheSpaceConf, error) {
+	conf := &entity.ResultCacheSpaceConf{SpaceID: spaceID, TTL: defaultResultCacheTTL}
+
+	ttlKey := r.makeSpaceTTLKey(spaceID)
+	ttlSecond, err := r.getInt64(ctx, ttlKey)
+	if err != nil {
+		return nil, err
+	}
+	if ttlSecond > 0 {
+		conf.TTL = time.Duration(ttlSecond) * time.Second
+	}
+
+	generation, err := r.getInt64(ctx, r.makeSpaceGenerationKey(spaceID))
+	if err != nil {
+		return nil, err
+	}
+	conf.Generation = generation
+	return conf, nil
+}
+
+func (r *resultCacheDAOImpl) SetSpaceTTL(ctx context.Context, spaceID int64, ttl time.Duration) error {
+	key := r.makeSpaceTTLKey(spaceID)
+	if err := r.cmdable.Set(ctx, key, int64(ttl/time.Second), 0).Err(); err != nil {
+		return errorx.Wrapf(err, "redis set key: %v", key)
+	}
+	return nil
+}
+
+func (r *resultCacheDAOImpl) IncrSpaceGeneration(ctx context.Context, spaceID int64) (int64, error) {
+	key := r.makeSpaceGenerationKey(spaceID)
+	generation, err := r.cmdable.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, errorx.Wrapf(err, "redis incr key: %v", key)
+	}
+	return generation, nil
+}
+
+func (r *resultCacheDAOImpl) getInt64(ctx context.Context, key string) (int64, error) {
+	got, err := r.cmdable.Get(ctx, key).Result()
+	if err != nil {
+		if redis.IsNilError(err) {
+			return 0, nil
+		}
+		return 0, errorx.Wrapf(err, "redis get fail, key: %v", key)
+	}
+	val, err := strconv.ParseInt(got, 10, 64)
+	if err != nil {
+		return 0, errorx.Wrapf(err, "parse redis value fail, key: %v, value: %v", key, got)
+	}
+	return val, nil
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	infraredis "github.com/coze-dev/coze-loop/backend/infra/redis"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func TestResultCacheDAO(t *testing.T) {
+	t.Parallel()
+	dao := NewResultCacheDAO(infraredis.NewTestRedis(t))
+	ctx := context.Background()
+
+	conf, err := dao.GetSpaceConf(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, defaultResultCacheTTL, conf.TTL)
+	assert.Equal(t, int64(0), conf.Generation)
+
+	got, err := dao.GetResultCache(ctx, conf, entity.ResultCacheKindTarget, "k")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	entry := &entity.ResultCacheEntry{RecordSpaceID: 2, RecordID: 100, CreatedAt: 1}
+	require.NoError(t, dao.SetResultCache(ctx, conf, entity.ResultCacheKindTarget, "k", entry))
+	got, err = dao.GetResultCache(ctx, conf, entity.ResultCacheKindTarget, "k")
+	require.NoError(t, err)
+	assert.Equal(t, entry, got)
+
+	// 不同 kind 互不影响
+	got, err = dao.GetResultCache(ctx, conf, entity.ResultCacheKindEvaluator, "k")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	require.NoError(t, dao.SetSpaceTTL(ctx, 1, time.Hour))
+	generation, err := dao.IncrSpaceGeneration(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), generation)
+
+	conf, err = dao.GetSpaceConf(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, conf.TTL)
+	assert.Equal(t, int64(1), conf.Generation)
+
+	// generation 递增后旧缓存不再可见
+	got, err = dao.GetResultCache(ctx, conf, entity.ResultCacheKindTarget, "k")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
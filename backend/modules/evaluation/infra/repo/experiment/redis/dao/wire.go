@@ -10,4 +10,6 @@ import (
 var ExperimentRedisDAOSet = wire.NewSet(
 	NewQuotaDAO,
 	NewEvalAsyncDAO,
+	NewResultCacheDAO,
+	NewConcurrencyDAO,
 )
@@ -26,8 +26,14 @@ var ExperimentRepoSet = wire.NewSet(
 	NewExptResultExportRecordRepo,
 	NewExptInsightAnalysisRecordRepo,
 	NewExptTemplateRepo,
+	NewEvaluationSetItemProvenanceRepo,
+	NewExptPairwiseRankRecordRepo,
+	NewEvaluationSetDedupJobRepo,
 	NewQuotaService,
 	NewEvalAsyncRepo,
+	NewExptResultCacheRepo,
+	NewExptConcurrencyRepo,
+	NewExptBackendBudgetLimiter,
 	idem.NewIdempotentService,
 	// DAO Sets
 	exptmysql.ExperimentMySQLDAOSet,
@@ -2053,6 +2053,10 @@ func (f *fakeRecordStorageConfiger) GetExptTurnScoreHookConf(ctx context.Context
 	return nil, false
 }
 
+func (f *fakeRecordStorageConfiger) GetModelPriceConf(ctx context.Context) *entity.ModelPriceConf {
+	return nil
+}
+
 func (f *fakeRecordStorageConfiger) GetSandboxAgentNotifyConf(ctx context.Context) *entity.SandboxAgentNotifyConf {
 	return nil
 }
@@ -12,6 +12,7 @@ import (
 	datasetdto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/data/dataset"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/data/dataset/datasetservice"
 	domain_dataset "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/data/domain/dataset"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/data/domain/dataset_job"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
@@ -114,6 +115,31 @@ func (a *DatasetRPCAdapter) GetDatasetIOJob(ctx context.Context, spaceID, jobID
 	return convert2DatasetIOJob(ctx, resp.Job), nil
 }
 
+func (a *DatasetRPCAdapter) ListDatasetIOJobs(ctx context.Context, spaceID, datasetID int64, types []entity.JobType) (jobs []*entity.DatasetIOJob, err error) {
+	req := &datasetdto.ListDatasetIOJobsRequest{
+		WorkspaceID: gptr.Of(spaceID),
+		DatasetID:   datasetID,
+	}
+	for _, t := range types {
+		req.Types = append(req.Types, dataset_job.JobType(t))
+	}
+	resp, err := a.client.ListDatasetIOJobs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errorx.NewByCode(errno.CommonRPCErrorCode)
+	}
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		return nil, errorx.NewByCode(resp.BaseResp.StatusCode, errorx.WithExtraMsg(resp.BaseResp.StatusMessage))
+	}
+	jobs = make([]*entity.DatasetIOJob, 0, len(resp.Jobs))
+	for _, job := range resp.Jobs {
+		jobs = append(jobs, convert2DatasetIOJob(ctx, job))
+	}
+	return jobs, nil
+}
+
 func (a *DatasetRPCAdapter) ParseImportSourceFile(ctx context.Context, param *entity.ParseImportSourceFileParam) (*entity.ParseImportSourceFileResult, error) {
 	return nil, errorx.NewByCode(errno.CommonInternalErrorCode, errorx.WithExtraMsg("ParseImportSourceFile not implemented"))
 }
@@ -417,6 +443,40 @@ func (a *DatasetRPCAdapter) BatchDeleteDatasetItems(ctx context.Context, spaceID
 	return nil
 }
 
+func (a *DatasetRPCAdapter) ValidateDatasetItems(ctx context.Context, param *rpc.ValidateDatasetItemsParam) (validIndices []int32, errorGroup []*entity.ItemErrorGroup, err error) {
+	if param == nil {
+		return nil, nil, errorx.NewByCode(errno.CommonInvalidParamCode)
+	}
+	datasetItems, err := convert2DatasetItems(ctx, param.Items)
+	if err != nil {
+		return nil, nil, err
+	}
+	var fieldSchemas []*domain_dataset.FieldSchema
+	if len(param.FieldSchemas) > 0 {
+		fieldSchemas, err = convert2DatasetFieldSchemas(ctx, param.FieldSchemas)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	resp, err := a.client.ValidateDatasetItems(ctx, &datasetdto.ValidateDatasetItemsReq{
+		WorkspaceID:            &param.SpaceID,
+		DatasetID:              &param.EvaluationSetID,
+		Items:                  datasetItems,
+		DatasetFields:          fieldSchemas,
+		IgnoreCurrentItemCount: param.IgnoreCurrentItemCount,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp == nil {
+		return nil, nil, errorx.NewByCode(errno.CommonRPCErrorCode)
+	}
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		return nil, nil, errorx.NewByCode(resp.BaseResp.StatusCode, errorx.WithExtraMsg(resp.BaseResp.StatusMessage))
+	}
+	return resp.GetValidItemIndices(), convert2EvaluationSetErrorGroups(ctx, resp.GetErrors()), nil
+}
+
 func (a *DatasetRPCAdapter) ListDatasetItems(ctx context.Context, param *rpc.ListDatasetItemsParam) (items []*entity.EvaluationSetItem, total, filterTotal *int64, nextPageToken *string, err error) {
 	resp, err := a.client.ListDatasetItems(ctx, &datasetdto.ListDatasetItemsRequest{
 		WorkspaceID: &param.SpaceID,
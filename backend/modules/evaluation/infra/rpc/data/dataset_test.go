@@ -802,3 +802,58 @@ func TestClearEvaluationSetDraftItem(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestValidateDatasetItems(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		adapter, mockClient := newTestAdapter(ctrl)
+
+		mockClient.EXPECT().ValidateDatasetItems(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req *datasetdto.ValidateDatasetItemsReq, _ ...interface{}) (*datasetdto.ValidateDatasetItemsResp, error) {
+				assert.Equal(t, int64(2), req.GetDatasetID())
+				assert.Len(t, req.Items, 2)
+				assert.Len(t, req.DatasetFields, 1)
+				return &datasetdto.ValidateDatasetItemsResp{
+					ValidItemIndices: []int32{0},
+					Errors:           []*domain_dataset.ItemErrorGroup{{Type: gptr.Of(domain_dataset.ItemErrorType_MismatchSchema)}},
+					BaseResp:         &base.BaseResp{StatusCode: 0},
+				}, nil
+			})
+
+		validIndices, errGroups, err := adapter.ValidateDatasetItems(ctx, &rpc.ValidateDatasetItemsParam{
+			SpaceID:         1,
+			EvaluationSetID: 2,
+			Items:           []*entity.EvaluationSetItem{{}, {}},
+			FieldSchemas:    []*entity.FieldSchema{{Name: "input", ContentType: entity.ContentTypeText}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []int32{0}, validIndices)
+		assert.Len(t, errGroups, 1)
+	})
+
+	t.Run("rpc_error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		adapter, mockClient := newTestAdapter(ctrl)
+
+		mockClient.EXPECT().ValidateDatasetItems(gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error"))
+
+		_, _, err := adapter.ValidateDatasetItems(ctx, &rpc.ValidateDatasetItemsParam{SpaceID: 1, EvaluationSetID: 2})
+		assert.Error(t, err)
+	})
+
+	t.Run("nil_param", func(t *testing.T) {
+		t.Parallel()
+		adapter := &DatasetRPCAdapter{}
+		_, _, err := adapter.ValidateDatasetItems(ctx, nil)
+		assert.Error(t, err)
+	})
+}
@@ -60,6 +60,19 @@ func (f *RuntimeFactory) CreateRuntime(languageType entity.LanguageType) (compon
 	var runtime component.IRuntime
 	var err error
 
+	// 本地沙箱模式: 在受限子进程中执行代码, 无需部署 FaaS 服务
+	if useLocalSandboxRuntime() {
+		if languageType != entity.LanguageTypePython && languageType != entity.LanguageTypeJS {
+			return nil, fmt.Errorf("不支持的语言类型: %s", languageType)
+		}
+		runtime, err = NewLocalSandboxRuntime(languageType, f.sandboxConfig, f.logger)
+		if err != nil {
+			return nil, fmt.Errorf("创建本地沙箱运行时失败: %w", err)
+		}
+		f.runtimeCache[languageType] = runtime
+		return runtime, nil
+	}
+
 	switch languageType {
 	case entity.LanguageTypePython:
 		runtime, err = NewPythonRuntime(f.sandboxConfig, f.logger)
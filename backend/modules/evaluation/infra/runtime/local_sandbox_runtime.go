@@ -0,0 +1,615 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	goruntime "runtime"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+const (
+	// EnvCodeRuntimeMode 代码运行时模式, 取值 local 时使用本地沙箱子进程执行, 否则使用 HTTP FaaS
+	EnvCodeRuntimeMode   = "COZE_LOOP_CODE_RUNTIME_MODE"
+	CodeRuntimeModeLocal = "local"
+	// EnvLocalPythonBin / EnvLocalNodeBin 本地沙箱使用的解释器, 默认 python3 / node
+	EnvLocalPythonBin = "COZE_LOOP_LOCAL_PYTHON_BIN"
+	EnvLocalNodeBin   = "COZE_LOOP_LOCAL_NODE_BIN"
+
+	localReturnValStart = "__COZE_RETURN_VAL_START__"
+	localReturnValEnd   = "__COZE_RETURN_VAL_END__"
+
+	// sandboxWorkDir 沙箱内代码所在目录, 宿主机临时目录以只读方式挂载到该路径
+	sandboxWorkDir = "/sandbox"
+	// sandboxInitArg 沙箱初始化进程的 argv[0], 进程以该名称启动时由 RunSandboxInitIfRequested 完成隔离后 exec 解释器
+	sandboxInitArg = "coze-loop-sandbox-init"
+	// sandboxInitExitCode / sandboxInitErrPrefix 沙箱初始化失败时的退出码与日志前缀
+	sandboxInitExitCode  = 125
+	sandboxInitErrPrefix = "coze-loop sandbox init failed: "
+
+	sandboxProbeTimeout = 10 * time.Second
+	sandboxInitLogLimit = 64 * 1024
+)
+
+// sandboxSystemPaths 以只读方式挂载进沙箱根目录的系统路径, 不存在的路径跳过, 符号链接原样保留
+var sandboxSystemPaths = []string{"/bin", "/sbin", "/usr", "/lib", "/lib32", "/lib64", "/libx32", "/etc/ld.so.cache", "/etc/localtime"}
+
+// sandboxNetworkPaths 开启网络时额外挂载的域名解析与证书配置
+var sandboxNetworkPaths = []string{"/etc/resolv.conf", "/etc/hosts", "/etc/nsswitch.conf", "/etc/ssl", "/etc/pki"}
+
+// sandboxSpec 传递给沙箱初始化进程的配置
+type sandboxSpec struct {
+	// RootDir 宿主机上的空目录, 作为新根目录的挂载点
+	RootDir string `json:"root_dir"`
+	// WorkDir 宿主机上存放代码的目录, 只读挂载到 sandboxWorkDir
+	WorkDir string `json:"work_dir"`
+	// ReadOnlyPaths 以相同路径只读挂载进沙箱的宿主机路径
+	ReadOnlyPaths []string `json:"read_only_paths"`
+	Network       bool     `json:"network"`
+	CPUSeconds    uint64   `json:"cpu_seconds"`
+	// MemoryBytes 大于 0 时设置 RLIMIT_AS
+	MemoryBytes uint64 `json:"memory_bytes"`
+	// Args 解释器及其参数, 路径为沙箱内路径
+	Args []string `json:"args"`
+}
+
+// sandboxInitEntrypointCalled 进程是否已调用 RunSandboxInitIfRequested。
+// 未调用时沙箱子进程会按普通方式启动整个服务, 因此不允许创建本地沙箱运行时
+var sandboxInitEntrypointCalled atomic.Bool
+
+// useLocalSandboxRuntime 是否启用本地沙箱运行时
+func useLocalSandboxRuntime() bool {
+	return strings.EqualFold(os.Getenv(EnvCodeRuntimeMode), CodeRuntimeModeLocal)
+}
+
+// CheckCodeRuntimeMode 校验代码运行时模式配置, 服务启动加载配置时调用。
+// 本地沙箱模式仅支持 Linux, 其它平台配置为 local 时直接报错, 不会退化为无隔离运行
+func CheckCodeRuntimeMode() error {
+	if useLocalSandboxRuntime() && !localSandboxSupported {
+		return fmt.Errorf("%s=%s is only supported on linux, current platform: %s", EnvCodeRuntimeMode, CodeRuntimeModeLocal, goruntime.GOOS)
+	}
+	return nil
+}
+
+// LocalSandboxRuntime 本地沙箱运行时, 在受限子进程中执行 Python/JS 评估器代码, 无需部署 FaaS 服务。
+// 隔离由操作系统保证, 当前环境无法建立隔离时拒绝执行 (fail closed), 不会退化为无隔离运行:
+//   - 命名空间: 在独立的 user+mount+pid+ipc+uts namespace 中运行, 未开启网络时同时使用独立的 network namespace
+//   - 文件系统: pivot_root 到仅包含解释器与系统库只读挂载的 tmpfs 根目录, 宿主机其它文件与 /proc 下的其它进程不可见
+//   - 系统调用: seccomp 禁止创建子进程 (fork/vfork/非线程 clone)、挂载与 namespace 操作、ptrace、io_uring 等,
+//     未开启网络时禁止创建 AF_UNIX 以外的 socket
+//   - 环境变量: 子进程只继承固定的最小环境变量集合
+//   - 资源: context 超时后杀死整个进程组, 并通过 RLIMIT_CPU 兜底 CPU 时间; Python 使用 RLIMIT_AS 限制内存,
+//     Node 使用 --max-old-space-size (V8 预留大量虚拟地址, 不适合 RLIMIT_AS); RLIMIT_FSIZE=0
+//
+// 代码前注入的语言层拦截逻辑只用于给出可读的报错信息, 不作为安全边界。
+type LocalSandboxRuntime struct {
+	languageType entity.LanguageType
+	config       *entity.SandboxConfig
+	logger       *logrus.Logger
+	interpreter  string
+	// interpreterRoots 解释器安装目录, 以只读方式挂载进沙箱
+	interpreterRoots []string
+}
+
+// NewLocalSandboxRuntime 创建本地沙箱运行时实例
+func NewLocalSandboxRuntime(languageType entity.LanguageType, config *entity.SandboxConfig, logger *logrus.Logger) (*LocalSandboxRuntime, error) {
+	if config == nil {
+		config = entity.DefaultSandboxConfig()
+	}
+	if logger == nil {
+		logger = logrus.New()
+	}
+
+	if !localSandboxSupported {
+		return nil, &sandboxInitError{msg: fmt.Sprintf("local sandbox isolation is not supported on %s", goruntime.GOOS)}
+	}
+	if !sandboxInitEntrypointCalled.Load() {
+		return nil, fmt.Errorf("本地沙箱初始化入口未注册, 需在 main 开头调用 runtime.RunSandboxInitIfRequested")
+	}
+
+	interpreter, roots, err := resolveLocalInterpreter(languageType)
+	if err != nil {
+		return nil, err
+	}
+
+	lr := &LocalSandboxRuntime{
+		languageType:     languageType,
+		config:           config,
+		logger:           logger,
+		interpreter:      interpreter,
+		interpreterRoots: roots,
+	}
+	if err := lr.probe(); err != nil {
+		return nil, err
+	}
+
+	logger.WithFields(logrus.Fields{
+		"language":    languageType,
+		"interpreter": interpreter,
+	}).Info("本地沙箱运行时创建成功")
+	return lr, nil
+}
+
+// resolveLocalInterpreter 解析解释器的真实路径与安装目录。
+// 子进程运行在清空的环境变量下, pyenv 等 shim 依赖 HOME/PYENV_ROOT, 因此 Python 需解析到实际可执行文件。
+func resolveLocalInterpreter(languageType entity.LanguageType) (string, []string, error) {
+	switch languageType {
+	case entity.LanguageTypePython:
+		bin := os.Getenv(EnvLocalPythonBin)
+		if bin == "" {
+			bin = "python3"
+		}
+		path, err := exec.LookPath(bin)
+		if err != nil {
+			return "", nil, fmt.Errorf("未找到Python解释器 %s: %w", bin, err)
+		}
+		out, err := exec.Command(path, "-c", "import sys; print(sys.executable); print(sys.prefix); print(sys.base_prefix); print(sys.exec_prefix)").Output()
+		if err != nil {
+			return "", nil, fmt.Errorf("解析Python解释器路径失败: %w", err)
+		}
+		lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+		if executable := strings.TrimSpace(lines[0]); executable != "" {
+			path = executable
+		}
+		roots := []string{filepath.Dir(path)}
+		for _, line := range lines[1:] {
+			roots = append(roots, strings.TrimSpace(line))
+		}
+		return path, interpreterRoots(path, roots), nil
+	case entity.LanguageTypeJS:
+		bin := os.Getenv(EnvLocalNodeBin)
+		if bin == "" {
+			bin = "node"
+		}
+		path, err := exec.LookPath(bin)
+		if err != nil {
+			return "", nil, fmt.Errorf("未找到Node解释器 %s: %w", bin, err)
+		}
+		return path, interpreterRoots(path, nil), nil
+	default:
+		return "", nil, fmt.Errorf("本地沙箱不支持的语言类型: %s", languageType)
+	}
+}
+
+// interpreterRoots 计算需要挂载进沙箱的解释器目录, 已被系统路径覆盖的目录不再重复挂载
+func interpreterRoots(interpreter string, roots []string) []string {
+	if real, err := filepath.EvalSymlinks(interpreter); err == nil {
+		// <prefix>/bin/<interpreter>
+		roots = append(roots, filepath.Dir(filepath.Dir(real)))
+	}
+	// 父目录排在前面, 子目录因已被覆盖而跳过
+	sort.Slice(roots, func(i, j int) bool { return len(roots[i]) < len(roots[j]) })
+	res := make([]string, 0, len(roots))
+	for _, root := range roots {
+		if root == "" || !filepath.IsAbs(root) {
+			continue
+		}
+		root = filepath.Clean(root)
+		if pathCovered(root, sandboxSystemPaths) || pathCovered(root, res) {
+			continue
+		}
+		res = append(res, root)
+	}
+	return res
+}
+
+// pathCovered 判断 path 是否为 parents 中某个路径本身或其子路径
+func pathCovered(path string, parents []string) bool {
+	for _, parent := range parents {
+		if path == parent || strings.HasPrefix(path, parent+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetLanguageType 获取语言类型
+func (lr *LocalSandboxRuntime) GetLanguageType() entity.LanguageType {
+	return lr.languageType
+}
+
+// GetReturnValFunction 获取return_val函数实现, 通过标记包裹输出供运行时从stdout中提取ret_val
+func (lr *LocalSandboxRuntime) GetReturnValFunction() string {
+	switch lr.languageType {
+	case entity.LanguageTypePython:
+		return `
+# return_val函数实现
+def return_val(value):
+    ret_val = "" if value is None else str(value)
+    print("` + localReturnValStart + `")
+    print(ret_val)
+    print("` + localReturnValEnd + `")
+`
+	case entity.LanguageTypeJS:
+		return `
+// return_val函数实现
+function return_val(value) {
+    const ret_val = (value === null || value === undefined) ? "" : String(value);
+    console.log("` + localReturnValStart + `");
+    console.log(ret_val);
+    console.log("` + localReturnValEnd + `");
+}
+`
+	default:
+		return ""
+	}
+}
+
+// GetHealthStatus 获取健康状态
+func (lr *LocalSandboxRuntime) GetHealthStatus() map[string]interface{} {
+	return map[string]interface{}{
+		"status":               "healthy",
+		"language":             string(lr.languageType),
+		"interpreter":          lr.interpreter,
+		"os_isolation":         true,
+		"network_enabled":      lr.config.NetworkEnabled,
+		"memory_limit_mb":      lr.config.MemoryLimit,
+		"timeout_limit":        lr.config.TimeoutLimit.String(),
+		"max_output_size_byte": lr.config.MaxOutputSize,
+	}
+}
+
+// GetMetrics 获取运行时指标
+func (lr *LocalSandboxRuntime) GetMetrics() map[string]interface{} {
+	return map[string]interface{}{
+		"runtime_type": "local_sandbox",
+		"language":     string(lr.languageType),
+	}
+}
+
+// RunCode 在本地受限子进程中执行代码
+func (lr *LocalSandboxRuntime) RunCode(ctx context.Context, code, language string, timeoutMS int64, ext map[string]string) (*entity.ExecutionResult, error) {
+	if code == "" {
+		return nil, fmt.Errorf("代码不能为空")
+	}
+
+	timeout := lr.config.TimeoutLimit
+	if timeoutMS > 0 && (timeout <= 0 || time.Duration(timeoutMS)*time.Millisecond < timeout) {
+		timeout = time.Duration(timeoutMS) * time.Millisecond
+	}
+	if timeout <= 0 {
+		timeout = entity.DefaultSandboxConfig().TimeoutLimit
+	}
+
+	stdout, stderr, runCtx, runErr := lr.execute(ctx, code, timeout)
+	var exitErr *exec.ExitError
+	if runErr != nil && !errors.As(runErr, &exitErr) {
+		return nil, runErr
+	}
+
+	stdoutStr, retVal := extractLocalReturnVal(stdout.String())
+	stderrStr := stderr.String()
+	if stdout.truncated || stderr.truncated {
+		stderrStr += fmt.Sprintf("\n输出超过限制 %d 字节, 已截断", lr.config.MaxOutputSize)
+	}
+	result := &entity.ExecutionResult{
+		Output: &entity.ExecutionOutput{
+			Stdout: stdoutStr,
+			Stderr: stderrStr,
+			RetVal: retVal,
+		},
+	}
+
+	if errors.Is(runCtx.Err(), context.DeadlineExceeded) {
+		result.Output.Stderr = strings.TrimPrefix(result.Output.Stderr+"\n执行超时", "\n")
+		return result, fmt.Errorf("本地沙箱执行超时: %v", timeout)
+	}
+	if runErr != nil {
+		// 用户代码异常退出时错误信息已在stderr中, 与FaaS保持一致由上层根据stderr判定失败
+		if result.Output.Stderr == "" {
+			result.Output.Stderr = exitErr.Error()
+		}
+	}
+
+	lr.logger.WithFields(logrus.Fields{
+		"language": language,
+	}).Debug("本地沙箱执行完成")
+	return result, nil
+}
+
+// sandboxInitError 沙箱隔离环境建立失败, 此时用户代码没有被执行
+type sandboxInitError struct {
+	msg string
+}
+
+func (e *sandboxInitError) Error() string {
+	return "本地沙箱隔离环境建立失败, 拒绝执行代码: " + e.msg
+}
+
+// probe 创建运行时时执行一段空代码, 确认当前环境能够建立完整的隔离
+func (lr *LocalSandboxRuntime) probe() error {
+	code := "pass\n"
+	if lr.languageType == entity.LanguageTypeJS {
+		code = ";\n"
+	}
+	_, stderr, _, err := lr.execute(context.Background(), code, sandboxProbeTimeout)
+	if err != nil && stderr != nil && stderr.String() != "" {
+		return fmt.Errorf("%w, stderr: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return err
+}
+
+// execute 将代码写入只读工作目录后在沙箱中运行, 返回的 runCtx 用于判断是否超时
+func (lr *LocalSandboxRuntime) execute(ctx context.Context, code string, timeout time.Duration) (stdout, stderr *limitedBuffer, runCtx context.Context, err error) {
+	baseDir, err := os.MkdirTemp("", "coze-loop-sandbox-*")
+	if err != nil {
+		return nil, nil, ctx, fmt.Errorf("创建沙箱工作目录失败: %w", err)
+	}
+	workDir, rootDir := filepath.Join(baseDir, "work"), filepath.Join(baseDir, "root")
+	defer func() {
+		_ = os.Chmod(workDir, 0o700)
+		_ = os.RemoveAll(baseDir)
+	}()
+	for _, dir := range []string{workDir, rootDir} {
+		if err := os.Mkdir(dir, 0o700); err != nil {
+			return nil, nil, ctx, fmt.Errorf("创建沙箱工作目录失败: %w", err)
+		}
+	}
+
+	scriptName := lr.scriptName()
+	if err := os.WriteFile(filepath.Join(workDir, scriptName), []byte(lr.preamble()+code), 0o400); err != nil {
+		return nil, nil, ctx, fmt.Errorf("写入沙箱代码失败: %w", err)
+	}
+	if err := os.Chmod(workDir, 0o500); err != nil {
+		return nil, nil, ctx, fmt.Errorf("设置沙箱工作目录只读失败: %w", err)
+	}
+
+	spec := lr.spec(rootDir, workDir, path.Join(sandboxWorkDir, scriptName), timeout)
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd, err := newSandboxCommand(runCtx, spec)
+	if err != nil {
+		return nil, nil, runCtx, &sandboxInitError{msg: err.Error()}
+	}
+	// 仅传递最小环境变量集合, 服务进程中的密钥等环境变量不会进入沙箱
+	cmd.Env = []string{
+		"PATH=/usr/local/bin:/usr/bin:/bin",
+		"HOME=" + sandboxWorkDir,
+		"TMPDIR=" + sandboxWorkDir,
+		"LANG=C.UTF-8",
+		"PYTHONIOENCODING=utf-8",
+	}
+	stdout = newLimitedBuffer(lr.config.MaxOutputSize)
+	stderr = newLimitedBuffer(lr.config.MaxOutputSize)
+	err = runSandboxCommand(cmd, stdout, stderr)
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		return stdout, stderr, runCtx, nil
+	case errors.As(err, &exitErr):
+		return stdout, stderr, runCtx, err
+	case runCtx.Err() != nil:
+		return stdout, stderr, runCtx, fmt.Errorf("启动本地沙箱进程失败: %w", err)
+	default:
+		return stdout, stderr, runCtx, err
+	}
+}
+
+// runSandboxCommand 运行沙箱进程。初始化进程自身的 stdout/stderr 只用于记录初始化日志,
+// 解释器的 stdout/stderr 通过 fd 3/4 传入, 避免初始化阶段的输出混入用户代码的输出。
+func runSandboxCommand(cmd *exec.Cmd, stdout, stderr io.Writer) error {
+	initLog := newLimitedBuffer(sandboxInitLogLimit)
+	cmd.Stdin = nil
+	cmd.Stdout = initLog
+	cmd.Stderr = initLog
+
+	var readers []*os.File
+	defer func() {
+		for _, r := range readers {
+			_ = r.Close()
+		}
+	}()
+	for range []io.Writer{stdout, stderr} {
+		r, w, err := os.Pipe()
+		if err != nil {
+			return err
+		}
+		readers = append(readers, r)
+		cmd.ExtraFiles = append(cmd.ExtraFiles, w)
+	}
+	err := cmd.Start()
+	for _, w := range cmd.ExtraFiles {
+		_ = w.Close()
+	}
+	if err != nil {
+		// 进程未能启动, 通常是当前环境禁止创建 namespace
+		return &sandboxInitError{msg: err.Error()}
+	}
+
+	var wg sync.WaitGroup
+	for i, w := range []io.Writer{stdout, stderr} {
+		wg.Add(1)
+		go func(r io.Reader, w io.Writer) {
+			defer wg.Done()
+			_, _ = io.Copy(w, r)
+		}(readers[i], w)
+	}
+	// 解释器作为 pid namespace 的 1 号进程退出后其余进程随之被杀死, 管道写端全部关闭
+	err = cmd.Wait()
+	wg.Wait()
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == sandboxInitExitCode {
+		if log := initLog.String(); strings.Contains(log, sandboxInitErrPrefix) {
+			return &sandboxInitError{msg: strings.TrimSpace(log[strings.Index(log, sandboxInitErrPrefix)+len(sandboxInitErrPrefix):])}
+		}
+	}
+	return err
+}
+
+// spec 构造沙箱配置, 资源限制在沙箱初始化进程中设置, 只作用于解释器进程
+func (lr *LocalSandboxRuntime) spec(rootDir, workDir, scriptPath string, timeout time.Duration) *sandboxSpec {
+	spec := &sandboxSpec{
+		RootDir:       rootDir,
+		WorkDir:       workDir,
+		ReadOnlyPaths: append(append([]string{}, sandboxSystemPaths...), lr.interpreterRoots...),
+		Network:       lr.config.NetworkEnabled,
+		CPUSeconds:    uint64(timeout/time.Second) + 1,
+	}
+	if lr.config.NetworkEnabled {
+		spec.ReadOnlyPaths = append(spec.ReadOnlyPaths, sandboxNetworkPaths...)
+	}
+
+	switch lr.languageType {
+	case entity.LanguageTypePython:
+		if lr.config.MemoryLimit > 0 {
+			spec.MemoryBytes = uint64(lr.config.MemoryLimit) * 1024 * 1024
+		}
+		spec.Args = []string{lr.interpreter, "-I", "-B", scriptPath}
+	default:
+		spec.Args = []string{lr.interpreter}
+		if lr.config.MemoryLimit > 0 {
+			spec.Args = append(spec.Args, fmt.Sprintf("--max-old-space-size=%d", lr.config.MemoryLimit))
+		}
+		spec.Args = append(spec.Args, scriptPath)
+	}
+	return spec
+}
+
+func (lr *LocalSandboxRuntime) scriptName() string {
+	if lr.languageType == entity.LanguageTypePython {
+		return "main.py"
+	}
+	return "main.js"
+}
+
+// preamble 注入在用户代码之前的语言层拦截, 对常见的写文件、子进程与网络 API 给出可读的报错。
+// 拦截可以被绕过, 真正的限制由 namespace、只读挂载与 seccomp 保证。
+func (lr *LocalSandboxRuntime) preamble() string {
+	switch lr.languageType {
+	case entity.LanguageTypePython:
+		p := `
+import builtins as _coze_builtins
+import os as _coze_os
+def _coze_denied(*args, **kwargs):
+    raise PermissionError("operation is not permitted in local sandbox")
+_coze_open = _coze_builtins.open
+def _coze_readonly_open(file, mode="r", *args, **kwargs):
+    if any(m in mode for m in ("w", "a", "x", "+")):
+        _coze_denied()
+    return _coze_open(file, mode, *args, **kwargs)
+_coze_builtins.open = _coze_readonly_open
+for _coze_name in ("system", "popen", "fork", "execv", "execve", "spawnv", "remove", "unlink", "rename", "mkdir", "rmdir"):
+    if hasattr(_coze_os, _coze_name):
+        setattr(_coze_os, _coze_name, _coze_denied)
+`
+		if !lr.config.NetworkEnabled {
+			p += `
+import socket as _coze_socket
+for _coze_name in ("connect", "connect_ex", "bind", "sendto"):
+    setattr(_coze_socket.socket, _coze_name, _coze_denied)
+_coze_socket.create_connection = _coze_denied
+_coze_socket.getaddrinfo = _coze_denied
+`
+		}
+		return p
+	default:
+		blocked := `"child_process", "cluster", "worker_threads"`
+		if !lr.config.NetworkEnabled {
+			blocked += `, "net", "tls", "http", "https", "http2", "dgram", "dns"`
+		}
+		p := `
+(() => {
+    const denied = (what) => () => { throw new Error(what + " is not permitted in local sandbox"); };
+    const Module = require("module");
+    const blocked = new Set([` + blocked + `]);
+    const originalLoad = Module._load;
+    Module._load = function (request, ...rest) {
+        if (blocked.has(String(request).replace(/^node:/, ""))) {
+            throw new Error("module " + request + " is not permitted in local sandbox");
+        }
+        return originalLoad.call(this, request, ...rest);
+    };
+    const fs = require("fs");
+    for (const fn of ["writeFile", "writeFileSync", "appendFile", "appendFileSync", "createWriteStream", "mkdir", "mkdirSync",
+        "rm", "rmSync", "rmdir", "rmdirSync", "unlink", "unlinkSync", "rename", "renameSync", "copyFile", "copyFileSync",
+        "truncate", "truncateSync", "symlink", "symlinkSync"]) {
+        if (fs[fn]) fs[fn] = denied("fs." + fn);
+    }
+    for (const fn of ["writeFile", "appendFile", "mkdir", "rm", "rmdir", "unlink", "rename", "copyFile", "truncate", "symlink"]) {
+        if (fs.promises[fn]) fs.promises[fn] = async () => denied("fs.promises." + fn)();
+    }
+`
+		if !lr.config.NetworkEnabled {
+			p += `    globalThis.fetch = denied("fetch");
+`
+		}
+		return p + `})();
+`
+	}
+}
+
+// extractLocalReturnVal 从stdout中提取最后一段return_val标记之间的内容, 并将其从stdout中移除
+func extractLocalReturnVal(stdout string) (string, string) {
+	start := strings.LastIndex(stdout, localReturnValStart)
+	if start < 0 {
+		return stdout, ""
+	}
+	end := strings.Index(stdout[start:], localReturnValEnd)
+	if end < 0 {
+		return stdout, ""
+	}
+	end += start
+	retVal := strings.TrimSpace(stdout[start+len(localReturnValStart) : end])
+	rest := stdout[:start] + strings.TrimPrefix(stdout[end+len(localReturnValEnd):], "\n")
+	return strings.TrimRight(rest, "\n"), retVal
+}
+
+// limitedBuffer 超过上限后丢弃后续输出, 但继续消费以免子进程因管道阻塞
+type limitedBuffer struct {
+	buf       bytes.Buffer
+	limit     int64
+	truncated bool
+}
+
+func newLimitedBuffer(limit int64) *limitedBuffer {
+	return &limitedBuffer{limit: limit}
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.limit <= 0 {
+		return b.buf.Write(p)
+	}
+	remain := b.limit - int64(b.buf.Len())
+	if remain <= 0 {
+		b.truncated = b.truncated || len(p) > 0
+		return len(p), nil
+	}
+	if int64(len(p)) > remain {
+		b.buf.Write(p[:remain])
+		b.truncated = true
+		return len(p), nil
+	}
+	return b.buf.Write(p)
+}
+
+func (b *limitedBuffer) String() string {
+	return b.buf.String()
+}
+
+func (b *limitedBuffer) Reset() {
+	b.buf.Reset()
+	b.truncated = false
+}
+
+// 确保LocalSandboxRuntime实现IRuntime接口
+var _ component.IRuntime = (*LocalSandboxRuntime)(nil)
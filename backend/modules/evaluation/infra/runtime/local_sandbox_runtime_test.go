@@ -0,0 +1,294 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+// TestMain 测试二进制同样会通过 /proc/self/exe 作为沙箱初始化进程重新启动
+func TestMain(m *testing.M) {
+	RunSandboxInitIfRequested()
+	os.Exit(m.Run())
+}
+
+func newTestLocalSandboxRuntime(t *testing.T, languageType entity.LanguageType, config *entity.SandboxConfig) *LocalSandboxRuntime {
+	t.Helper()
+	bin := "python3"
+	if languageType == entity.LanguageTypeJS {
+		bin = "node"
+	}
+	if _, err := exec.LookPath(bin); err != nil {
+		t.Skipf("%s not found, skip local sandbox test", bin)
+	}
+	logger := logrus.New()
+	logger.SetLevel(logrus.WarnLevel)
+	runtime, err := NewLocalSandboxRuntime(languageType, config, logger)
+	var initErr *sandboxInitError
+	if errors.As(err, &initErr) {
+		t.Skipf("local sandbox isolation unavailable, skip local sandbox test: %v", err)
+	}
+	require.NoError(t, err)
+	return runtime
+}
+
+func TestLocalSandboxRuntime_Python(t *testing.T) {
+	runtime := newTestLocalSandboxRuntime(t, entity.LanguageTypePython, entity.DefaultSandboxConfig())
+	assert.Equal(t, entity.LanguageTypePython, runtime.GetLanguageType())
+
+	tests := []struct {
+		name        string
+		code        string
+		wantRetVal  string
+		wantStdout  string
+		wantStderr  string
+		wantErr     bool
+		timeoutMS   int64
+		checkStderr bool
+	}{
+		{
+			name:       "return value",
+			code:       "print('debug')\nreturn_val('{\"score\": 1.0, \"reason\": \"ok\"}')\n",
+			wantRetVal: `{"score": 1.0, "reason": "ok"}`,
+			wantStdout: "debug",
+		},
+		{
+			name:        "exception goes to stderr",
+			code:        "raise ValueError('boom')\n",
+			wantStderr:  "boom",
+			checkStderr: true,
+		},
+		{
+			name:        "network blocked",
+			code:        "import urllib.request\nurllib.request.urlopen('http://127.0.0.1:1')\n",
+			wantStderr:  "not permitted",
+			checkStderr: true,
+		},
+		{
+			name:        "file write blocked",
+			code:        "open('x.txt', 'w').write('x')\n",
+			wantStderr:  "PermissionError",
+			checkStderr: true,
+		},
+		{
+			name:      "timeout",
+			code:      "while True:\n    pass\n",
+			timeoutMS: 500,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := runtime.RunCode(context.Background(), runtime.GetReturnValFunction()+tt.code, "python", tt.timeoutMS, nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRetVal, result.Output.RetVal)
+			assert.Equal(t, tt.wantStdout, result.Output.Stdout)
+			if tt.checkStderr {
+				assert.Contains(t, result.Output.Stderr, tt.wantStderr)
+			}
+		})
+	}
+}
+
+func TestLocalSandboxRuntime_JavaScript(t *testing.T) {
+	runtime := newTestLocalSandboxRuntime(t, entity.LanguageTypeJS, entity.DefaultSandboxConfig())
+
+	t.Run("return value", func(t *testing.T) {
+		code := runtime.GetReturnValFunction() + "(() => { const result = {score: 0.5, reason: 'half'}; return_val(JSON.stringify(result)); })();\n"
+		result, err := runtime.RunCode(context.Background(), code, "js", 0, nil)
+		require.NoError(t, err)
+		assert.Equal(t, `{"score":0.5,"reason":"half"}`, result.Output.RetVal)
+		assert.Empty(t, result.Output.Stderr)
+	})
+
+	t.Run("blocked modules", func(t *testing.T) {
+		for _, code := range []string{
+			"require('child_process').execSync('ls');",
+			"require('http').get('http://127.0.0.1:1');",
+			"require('fs').writeFileSync('x.txt', 'x');",
+		} {
+			result, err := runtime.RunCode(context.Background(), code, "js", 0, nil)
+			require.NoError(t, err)
+			assert.Contains(t, result.Output.Stderr, "not permitted", code)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		result, err := runtime.RunCode(context.Background(), "while (true) {}", "js", 500, nil)
+		assert.Error(t, err)
+		require.NotNil(t, result)
+		assert.Contains(t, result.Output.Stderr, "执行超时")
+	})
+}
+
+// TestLocalSandboxRuntime_Bypass 绕过语言层拦截后, 由 namespace、只读挂载与 seccomp 拦截
+func TestLocalSandboxRuntime_Bypass(t *testing.T) {
+	runtime := newTestLocalSandboxRuntime(t, entity.LanguageTypePython, entity.DefaultSandboxConfig())
+
+	const secret = "coze-loop-sandbox-secret"
+	t.Setenv("COZE_LOOP_SANDBOX_TEST_SECRET", secret)
+	hostFile := filepath.Join(t.TempDir(), "host.txt")
+	require.NoError(t, os.WriteFile(hostFile, []byte(secret), 0o600))
+
+	tests := []struct {
+		name       string
+		code       string
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name:       "raw socket",
+			code:       "import _socket\n_socket.socket(_socket.AF_INET, _socket.SOCK_STREAM)\nprint('escaped')\n",
+			wantStderr: "PermissionError",
+		},
+		{
+			name:       "subprocess",
+			code:       "import subprocess\nsubprocess.run(['/bin/echo', 'escaped'])\n",
+			wantStderr: "PermissionError",
+		},
+		{
+			name:       "posix_spawn",
+			code:       "import os\nos.posix_spawn('/bin/echo', ['echo', 'escaped'], {})\n",
+			wantStderr: "PermissionError",
+		},
+		{
+			name:       "raw file write",
+			code:       "import _io\n_io.FileIO('/sandbox/x.txt', 'w')\nprint('escaped')\n",
+			wantStderr: "Read-only file system",
+		},
+		{
+			name:       "raw file write outside work dir",
+			code:       "import os\nos.open('/x.txt', os.O_WRONLY | os.O_CREAT)\nprint('escaped')\n",
+			wantStderr: "Read-only file system",
+		},
+		{
+			name:       "host file invisible",
+			code:       "import os\nprint(os.path.exists('" + hostFile + "'))\n",
+			wantStdout: "False",
+		},
+		{
+			name: "environment scrubbed",
+			code: `import os
+leaked = any("` + secret + `" in v for v in os.environ.values())
+for pid in os.listdir("/proc"):
+    if pid.isdigit():
+        try:
+            leaked = leaked or b"` + secret + `" in open("/proc/%s/environ" % pid, "rb").read()
+        except OSError:
+            pass
+print(os.getppid(), leaked)
+`,
+			wantStdout: "0 False",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := runtime.RunCode(context.Background(), tt.code, "python", 0, nil)
+			require.NoError(t, err)
+			assert.NotContains(t, result.Output.Stdout, "escaped")
+			assert.Equal(t, tt.wantStdout, strings.TrimSpace(result.Output.Stdout))
+			assert.Contains(t, result.Output.Stderr, tt.wantStderr)
+		})
+	}
+
+	t.Run("javascript raw file write", func(t *testing.T) {
+		jsRuntime := newTestLocalSandboxRuntime(t, entity.LanguageTypeJS, entity.DefaultSandboxConfig())
+		result, err := jsRuntime.RunCode(context.Background(), "require('fs').openSync('/sandbox/x.txt', 'w'); console.log('escaped');", "js", 0, nil)
+		require.NoError(t, err)
+		assert.NotContains(t, result.Output.Stdout, "escaped")
+		assert.Contains(t, result.Output.Stderr, "EROFS")
+	})
+}
+
+// TestLocalSandboxRuntime_FailClosed 隔离环境建立失败时返回错误, 不执行用户代码
+func TestLocalSandboxRuntime_FailClosed(t *testing.T) {
+	runtime := newTestLocalSandboxRuntime(t, entity.LanguageTypePython, entity.DefaultSandboxConfig())
+
+	t.Run("init failed", func(t *testing.T) {
+		broken := *runtime
+		broken.interpreter = "/nonexistent/python3"
+		result, err := broken.RunCode(context.Background(), "print('hello')\n", "python", 0, nil)
+		assert.Nil(t, result)
+		var initErr *sandboxInitError
+		assert.True(t, errors.As(err, &initErr))
+	})
+
+	t.Run("start failed", func(t *testing.T) {
+		err := runSandboxCommand(exec.Command("/nonexistent/sandbox-init"), newLimitedBuffer(0), newLimitedBuffer(0))
+		var initErr *sandboxInitError
+		assert.True(t, errors.As(err, &initErr))
+	})
+}
+
+func TestInterpreterRoots(t *testing.T) {
+	roots := interpreterRoots("/nonexistent/bin/python3", []string{"/opt/py/bin", "/opt/py", "/usr/lib/python3", "relative", ""})
+	assert.Equal(t, []string{"/opt/py"}, roots)
+}
+
+func TestLocalSandboxRuntime_OutputLimit(t *testing.T) {
+	config := entity.DefaultSandboxConfig()
+	config.MaxOutputSize = 16
+	runtime := newTestLocalSandboxRuntime(t, entity.LanguageTypePython, config)
+
+	result, err := runtime.RunCode(context.Background(), "print('x' * 1024)\n", "python", int64(10*time.Second/time.Millisecond), nil)
+	require.NoError(t, err)
+	assert.Len(t, result.Output.Stdout, 16)
+	assert.Contains(t, result.Output.Stderr, "已截断")
+}
+
+func TestExtractLocalReturnVal(t *testing.T) {
+	stdout, retVal := extractLocalReturnVal("a\n" + localReturnValStart + "\n{\"score\":1}\n" + localReturnValEnd + "\nb\n")
+	assert.Equal(t, `{"score":1}`, retVal)
+	assert.Equal(t, "a\nb", stdout)
+
+	stdout, retVal = extractLocalReturnVal("plain\n")
+	assert.Equal(t, "", retVal)
+	assert.Equal(t, "plain\n", stdout)
+}
+
+func TestRuntimeFactory_LocalSandboxMode(t *testing.T) {
+	if _, err := exec.LookPath("python3"); err != nil {
+		t.Skip("python3 not found, skip local sandbox test")
+	}
+	setEnvSafe(t, EnvCodeRuntimeMode, CodeRuntimeModeLocal)
+	defer unsetEnvSafe(t, EnvCodeRuntimeMode)
+
+	factory := NewRuntimeFactory(logrus.New(), entity.DefaultSandboxConfig())
+	runtime, err := factory.CreateRuntime(entity.LanguageTypePython)
+	require.NoError(t, err)
+	_, ok := runtime.(*LocalSandboxRuntime)
+	assert.True(t, ok)
+
+	_, err = factory.CreateRuntime(entity.LanguageType("go"))
+	assert.Error(t, err)
+}
+
+func TestCheckCodeRuntimeMode(t *testing.T) {
+	t.Setenv(EnvCodeRuntimeMode, "")
+	assert.NoError(t, CheckCodeRuntimeMode())
+
+	t.Setenv(EnvCodeRuntimeMode, CodeRuntimeModeLocal)
+	if localSandboxSupported {
+		assert.NoError(t, CheckCodeRuntimeMode())
+	} else {
+		assert.Error(t, CheckCodeRuntimeMode())
+	}
+}
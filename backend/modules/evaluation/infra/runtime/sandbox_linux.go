@@ -0,0 +1,247 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// secbitNoRoot / secbitNoRootLocked 设置后以 uid 0 执行 execve 不再自动获得 capability
+	secbitNoRoot       = 1 << 0
+	secbitNoRootLocked = 1 << 1
+)
+
+// localSandboxSupported 当前平台能否建立本地沙箱隔离
+const localSandboxSupported = true
+
+// RunSandboxInitIfRequested 本地沙箱初始化进程入口, 使用本地沙箱模式的二进制须在 main 开头、任何初始化之前调用。
+// 当前进程是以 sandboxInitArg 重新启动的沙箱初始化进程时, 完成隔离后 exec 解释器, 不会返回; 否则立即返回。
+func RunSandboxInitIfRequested() {
+	sandboxInitEntrypointCalled.Store(true)
+	if len(os.Args) < 2 || os.Args[0] != sandboxInitArg {
+		return
+	}
+	spec := &sandboxSpec{}
+	err := json.Unmarshal([]byte(os.Args[1]), spec)
+	if err == nil {
+		err = runSandboxInit(spec)
+	}
+	fmt.Fprintln(os.Stderr, sandboxInitErrPrefix+err.Error())
+	os.Exit(sandboxInitExitCode)
+}
+
+// newSandboxCommand 通过 /proc/self/exe 重新启动当前二进制作为沙箱初始化进程。
+// 子进程在新的 user+mount+pid+ipc+uts namespace 中启动, 未开启网络时同时进入新的 network namespace;
+// 子进程独立进程组, 超时时杀死整个进程组。
+func newSandboxCommand(ctx context.Context, spec *sandboxSpec) (*exec.Cmd, error) {
+	bytes, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.CommandContext(ctx, "/proc/self/exe")
+	cmd.Args = []string{sandboxInitArg, string(bytes)}
+
+	cloneFlags := uintptr(syscall.CLONE_NEWUSER | syscall.CLONE_NEWNS | syscall.CLONE_NEWPID | syscall.CLONE_NEWIPC | syscall.CLONE_NEWUTS)
+	if !spec.Network {
+		cloneFlags |= syscall.CLONE_NEWNET
+	}
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setpgid:     true,
+		Pdeathsig:   syscall.SIGKILL,
+		Cloneflags:  cloneFlags,
+		UidMappings: []syscall.SysProcIDMap{{ContainerID: 0, HostID: os.Getuid(), Size: 1}},
+		GidMappings: []syscall.SysProcIDMap{{ContainerID: 0, HostID: os.Getgid(), Size: 1}},
+	}
+	cmd.Cancel = func() error {
+		if cmd.Process == nil {
+			return nil
+		}
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	return cmd, nil
+}
+
+// runSandboxInit 在沙箱初始化进程中建立隔离环境后 exec 解释器, 成功时不会返回
+func runSandboxInit(spec *sandboxSpec) error {
+	if len(spec.Args) == 0 {
+		return fmt.Errorf("empty interpreter args")
+	}
+	if err := setupSandboxRoot(spec); err != nil {
+		return err
+	}
+	if err := unix.Sethostname([]byte("sandbox")); err != nil {
+		return fmt.Errorf("set hostname: %w", err)
+	}
+	if err := setSandboxRlimits(spec); err != nil {
+		return err
+	}
+	// 以 uid 0 exec 解释器时不再获得 namespace 内的 capability
+	if err := unix.Prctl(unix.PR_SET_SECUREBITS, secbitNoRoot|secbitNoRootLocked, 0, 0, 0); err != nil {
+		return fmt.Errorf("set securebits: %w", err)
+	}
+	if err := installSeccompFilter(spec.Network); err != nil {
+		return err
+	}
+	// fd 3/4 为解释器的 stdout/stderr, 保留一份初始化日志 fd 用于 exec 失败时恢复 stderr
+	logFd, err := unix.FcntlInt(2, unix.F_DUPFD_CLOEXEC, 0)
+	if err != nil {
+		return fmt.Errorf("dup init log: %w", err)
+	}
+	for fd, target := range map[int]int{3: 1, 4: 2} {
+		if err := unix.Dup3(fd, target, 0); err != nil {
+			return fmt.Errorf("redirect output: %w", err)
+		}
+		_ = unix.Close(fd)
+	}
+	err = syscall.Exec(spec.Args[0], spec.Args, os.Environ())
+	_ = unix.Dup3(logFd, 2, 0)
+	return fmt.Errorf("exec %s: %w", spec.Args[0], err)
+}
+
+// setupSandboxRoot 构造只读的新根目录并 pivot_root 进入:
+// tmpfs 根目录中只包含系统库与解释器的只读 bind mount、只读的代码目录、最小化的 /dev 以及当前 pid namespace 的 /proc
+func setupSandboxRoot(spec *sandboxSpec) error {
+	// 挂载变更不传播回宿主机
+	if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+		return fmt.Errorf("make mounts private: %w", err)
+	}
+	root := spec.RootDir
+	if err := unix.Mount("tmpfs", root, "tmpfs", unix.MS_NOSUID|unix.MS_NODEV, "size=1m,mode=0755"); err != nil {
+		return fmt.Errorf("mount root tmpfs: %w", err)
+	}
+
+	for _, p := range spec.ReadOnlyPaths {
+		if err := bindReadOnly(root, p, p, false); err != nil {
+			return err
+		}
+	}
+	if err := bindReadOnly(root, spec.WorkDir, sandboxWorkDir, true); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Join(root, "dev"), 0o755); err != nil {
+		return err
+	}
+	for _, dev := range []string{"/dev/null", "/dev/zero", "/dev/random", "/dev/urandom"} {
+		if err := bindReadOnly(root, dev, dev, false); err != nil {
+			return err
+		}
+	}
+
+	// 新的 procfs 只包含沙箱 pid namespace 内的进程, 宿主机进程 (包括服务进程的 environ) 不可见
+	procDir := filepath.Join(root, "proc")
+	if err := os.MkdirAll(procDir, 0o555); err != nil {
+		return err
+	}
+	if err := unix.Mount("proc", procDir, "proc", unix.MS_NOSUID|unix.MS_NODEV|unix.MS_NOEXEC, ""); err != nil {
+		return fmt.Errorf("mount proc: %w", err)
+	}
+
+	oldRoot := filepath.Join(root, ".oldroot")
+	if err := os.Mkdir(oldRoot, 0o700); err != nil {
+		return err
+	}
+	if err := unix.PivotRoot(root, oldRoot); err != nil {
+		return fmt.Errorf("pivot_root: %w", err)
+	}
+	if err := unix.Chdir("/"); err != nil {
+		return err
+	}
+	if err := unix.Unmount("/.oldroot", unix.MNT_DETACH); err != nil {
+		return fmt.Errorf("unmount old root: %w", err)
+	}
+	if err := os.Remove("/.oldroot"); err != nil {
+		return err
+	}
+	if err := unix.Mount("", "/", "", unix.MS_REMOUNT|unix.MS_RDONLY|unix.MS_NOSUID|unix.MS_NODEV, ""); err != nil {
+		return fmt.Errorf("remount root readonly: %w", err)
+	}
+	return unix.Chdir(sandboxWorkDir)
+}
+
+// bindReadOnly 将宿主机路径 src 只读挂载到新根目录下的 dst; src 为符号链接时在新根目录中创建相同的链接。
+// required 为 false 时 src 不存在则跳过。
+func bindReadOnly(root, src, dst string, required bool) error {
+	info, err := os.Lstat(src)
+	if err != nil {
+		if os.IsNotExist(err) && !required {
+			return nil
+		}
+		return err
+	}
+	target := filepath.Join(root, dst)
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		link, err := os.Readlink(src)
+		if err != nil {
+			return err
+		}
+		return os.Symlink(link, target)
+	}
+
+	if info.IsDir() {
+		err = os.Mkdir(target, 0o755)
+	} else {
+		err = os.WriteFile(target, nil, 0o644)
+	}
+	if err != nil {
+		return err
+	}
+	if err := unix.Mount(src, target, "", unix.MS_BIND, ""); err != nil {
+		return fmt.Errorf("bind mount %s: %w", src, err)
+	}
+	// user namespace 中重新挂载时必须保留源挂载点上被锁定的标志位
+	var st unix.Statfs_t
+	if err := unix.Statfs(target, &st); err != nil {
+		return err
+	}
+	flags := uintptr(unix.MS_REMOUNT | unix.MS_BIND | unix.MS_RDONLY)
+	for stFlag, msFlag := range map[int64]uintptr{
+		unix.ST_NOSUID:      unix.MS_NOSUID,
+		unix.ST_NODEV:       unix.MS_NODEV,
+		unix.ST_NOEXEC:      unix.MS_NOEXEC,
+		unix.ST_NOATIME:     unix.MS_NOATIME,
+		unix.ST_NODIRATIME:  unix.MS_NODIRATIME,
+		unix.ST_RELATIME:    unix.MS_RELATIME,
+		unix.ST_SYNCHRONOUS: unix.MS_SYNCHRONOUS,
+	} {
+		if st.Flags&stFlag != 0 {
+			flags |= msFlag
+		}
+	}
+	if err := unix.Mount("", target, "", flags, ""); err != nil {
+		return fmt.Errorf("remount %s readonly: %w", src, err)
+	}
+	return nil
+}
+
+func setSandboxRlimits(spec *sandboxSpec) error {
+	limits := map[int]uint64{
+		unix.RLIMIT_FSIZE: 0,
+		unix.RLIMIT_CORE:  0,
+		unix.RLIMIT_CPU:   spec.CPUSeconds,
+	}
+	if spec.MemoryBytes > 0 {
+		limits[unix.RLIMIT_AS] = spec.MemoryBytes
+	}
+	for resource, limit := range limits {
+		if err := unix.Setrlimit(resource, &unix.Rlimit{Cur: limit, Max: limit}); err != nil {
+			return fmt.Errorf("setrlimit %d: %w", resource, err)
+		}
+	}
+	return nil
+}
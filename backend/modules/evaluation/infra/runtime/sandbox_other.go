@@ -0,0 +1,26 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+// localSandboxSupported 非 Linux 平台无法建立 namespace 与 seccomp 隔离, 本地沙箱模式在启动时被拒绝
+const localSandboxSupported = false
+
+// RunSandboxInitIfRequested 非 Linux 平台不会以沙箱初始化进程启动, 立即返回
+func RunSandboxInitIfRequested() {
+	sandboxInitEntrypointCalled.Store(true)
+}
+
+// newSandboxCommand 非 Linux 平台无法建立 namespace 与 seccomp 隔离, 本地沙箱拒绝执行
+func newSandboxCommand(_ context.Context, _ *sandboxSpec) (*exec.Cmd, error) {
+	return nil, fmt.Errorf("local sandbox isolation is not supported on %s", runtime.GOOS)
+}
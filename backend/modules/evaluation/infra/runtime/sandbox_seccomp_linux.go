@@ -0,0 +1,103 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package runtime
+
+import (
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// seccomp_data 中各字段的偏移, 参数只比较低 32 位 (小端)
+	seccompDataNrOffset   = 0
+	seccompDataArchOffset = 4
+	seccompDataArg0Offset = 16
+
+	// x32SyscallBit x86_64 上 x32 ABI 的系统调用号标志位, 其调用号与 x86_64 不同, 统一拒绝
+	x32SyscallBit = 0x40000000
+)
+
+// seccompDeniedSyscalls 沙箱内统一返回 EPERM 的系统调用: 挂载与 namespace 操作、调试其它进程、内核对象与 io_uring
+// (io_uring 提交的操作不经过 seccomp 检查)
+var seccompDeniedSyscalls = []uint32{
+	unix.SYS_MOUNT, unix.SYS_UMOUNT2, unix.SYS_PIVOT_ROOT, unix.SYS_CHROOT, unix.SYS_UNSHARE, unix.SYS_SETNS,
+	unix.SYS_PTRACE, unix.SYS_PROCESS_VM_READV, unix.SYS_PROCESS_VM_WRITEV, unix.SYS_BPF, unix.SYS_PERF_EVENT_OPEN,
+	unix.SYS_KEYCTL, unix.SYS_ADD_KEY, unix.SYS_REQUEST_KEY, unix.SYS_USERFAULTFD,
+	unix.SYS_IO_URING_SETUP, unix.SYS_IO_URING_ENTER, unix.SYS_IO_URING_REGISTER,
+}
+
+// installSeccompFilter 为当前进程的所有线程安装 seccomp 过滤器, 并在 exec 后继续生效:
+//   - 禁止创建子进程: fork/vfork 与不带 CLONE_THREAD 的 clone 返回 EPERM, clone3 的参数无法检查, 返回 ENOSYS 使 libc 回退到 clone
+//   - network 为 false 时禁止创建 AF_UNIX 以外的 socket
+//   - 非当前架构的系统调用直接杀死进程
+func installSeccompFilter(network bool) error {
+	if seccompAuditArch == 0 {
+		return fmt.Errorf("seccomp is not supported on this architecture")
+	}
+	filter := buildSeccompFilter(network)
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return fmt.Errorf("set no_new_privs: %w", err)
+	}
+	prog := &unix.SockFprog{Len: uint16(len(filter)), Filter: &filter[0]}
+	r1, _, errno := unix.RawSyscall(unix.SYS_SECCOMP, unix.SECCOMP_SET_MODE_FILTER, unix.SECCOMP_FILTER_FLAG_TSYNC, uintptr(unsafe.Pointer(prog)))
+	if errno != 0 {
+		return fmt.Errorf("install seccomp filter: %w", errno)
+	}
+	if r1 != 0 {
+		return fmt.Errorf("install seccomp filter: thread %d not synchronized", r1)
+	}
+	return nil
+}
+
+func buildSeccompFilter(network bool) []unix.SockFilter {
+	errno := func(e unix.Errno) unix.SockFilter {
+		return bpfStmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_ERRNO|uint32(e))
+	}
+	allow := bpfStmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_ALLOW)
+
+	filter := []unix.SockFilter{
+		bpfStmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, seccompDataArchOffset),
+		bpfJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, seccompAuditArch, 1, 0),
+		bpfStmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_KILL_PROCESS),
+		bpfStmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, seccompDataNrOffset),
+		bpfJump(unix.BPF_JMP|unix.BPF_JSET|unix.BPF_K, x32SyscallBit, 0, 1),
+		errno(unix.EPERM),
+	}
+	for _, nr := range append(append([]uint32{}, seccompDeniedSyscalls...), seccompForkSyscalls...) {
+		filter = append(filter, bpfJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, nr, 0, 1), errno(unix.EPERM))
+	}
+	filter = append(filter,
+		bpfJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, unix.SYS_CLONE3, 0, 1),
+		errno(unix.ENOSYS),
+		// clone: 只允许创建线程
+		bpfJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, unix.SYS_CLONE, 0, 4),
+		bpfStmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, seccompDataArg0Offset),
+		bpfJump(unix.BPF_JMP|unix.BPF_JSET|unix.BPF_K, unix.CLONE_THREAD, 1, 0),
+		errno(unix.EPERM),
+		allow,
+	)
+	if !network {
+		// socket: 只允许 AF_UNIX
+		filter = append(filter,
+			bpfJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, unix.SYS_SOCKET, 0, 4),
+			bpfStmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, seccompDataArg0Offset),
+			bpfJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, unix.AF_UNIX, 1, 0),
+			errno(unix.EPERM),
+			allow,
+		)
+	}
+	return append(filter, allow)
+}
+
+func bpfStmt(code uint16, k uint32) unix.SockFilter {
+	return unix.SockFilter{Code: code, K: k}
+}
+
+func bpfJump(code uint16, k uint32, jt, jf uint8) unix.SockFilter {
+	return unix.SockFilter{Code: code, Jt: jt, Jf: jf, K: k}
+}
@@ -0,0 +1,11 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package runtime
+
+import "golang.org/x/sys/unix"
+
+const seccompAuditArch = unix.AUDIT_ARCH_X86_64
+
+// seccompForkSyscalls 当前架构上创建子进程的系统调用
+var seccompForkSyscalls = []uint32{unix.SYS_FORK, unix.SYS_VFORK}
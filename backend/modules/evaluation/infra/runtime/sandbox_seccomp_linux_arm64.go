@@ -0,0 +1,11 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package runtime
+
+import "golang.org/x/sys/unix"
+
+const seccompAuditArch = unix.AUDIT_ARCH_AARCH64
+
+// seccompForkSyscalls arm64 没有 fork/vfork 系统调用, 只能通过 clone 创建子进程
+var seccompForkSyscalls = []uint32{}
@@ -0,0 +1,11 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux && !amd64 && !arm64
+
+package runtime
+
+// seccompAuditArch 为 0 表示当前架构未适配 seccomp 过滤器, 本地沙箱拒绝执行
+const seccompAuditArch = 0
+
+var seccompForkSyscalls = []uint32{}
@@ -79,6 +79,10 @@ func (f *fakeConfiger) GetExptTurnScoreHookConf(ctx context.Context, spaceID, ex
 	return nil, false
 }
 
+func (f *fakeConfiger) GetModelPriceConf(ctx context.Context) *entity.ModelPriceConf {
+	return nil
+}
+
 func (f *fakeConfiger) GetSandboxAgentNotifyConf(ctx context.Context) *entity.SandboxAgentNotifyConf {
 	return nil
 }
@@ -124,6 +124,11 @@ func (c *configer) GetSandboxAgentNotifyConf(ctx context.Context) *entity.Sandbo
 	return entity.DefaultSandboxAgentNotifyConf()
 }
 
+func (c *configer) GetModelPriceConf(ctx context.Context) (mpc *entity.ModelPriceConf) {
+	const key = "model_price_conf"
+	return lo.Ternary(c.loader.UnmarshalKey(ctx, key, &mpc) == nil && mpc != nil, mpc, entity.DefaultModelPriceConf())
+}
+
 func (c *configer) GetMaintainerUserIDs(ctx context.Context) map[string]bool {
 	const key = "system_maintainer_conf"
 	var maintainerConf *entity.SystemMaintainerConf
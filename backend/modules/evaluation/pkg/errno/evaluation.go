@@ -459,6 +459,10 @@ const (
 	sandboxTerminatedBeforeReportMessage           = "沙箱在结果上报前已提前进入终态，该实验行已置为失败"
 	sandboxTerminatedBeforeReportNoAffectStability = false
 
+	EvaluatorPromotionBlockedCode              = 601205087 // 评估器版本提交前回放历史样本的回归检查未通过, 且评估器晋级策略为阻断
+	evaluatorPromotionBlockedMessage           = "evaluator version promotion blocked by regression check"
+	evaluatorPromotionBlockedNoAffectStability = true
+
 	// SandboxAgent 评测对象阶段性错误码 (601206xxx)：按沙箱内执行阶段划分，便于按阶段做 metrics 分类与用户前端展示。
 	SandboxAgentSetupErrorCode              = 601206001 // sandbox agent target setup phase error: agent 初始化 / 环境依赖装载失败
 	sandboxAgentSetupErrorMessage           = "sandbox agent: agent setup failed"
@@ -1163,6 +1167,12 @@ func init() {
 		code.WithAffectStability(!sandboxTerminatedBeforeReportNoAffectStability),
 	)
 
+	code.Register(
+		EvaluatorPromotionBlockedCode,
+		evaluatorPromotionBlockedMessage,
+		code.WithAffectStability(!evaluatorPromotionBlockedNoAffectStability),
+	)
+
 	code.Register(
 		SandboxAgentSetupErrorCode,
 		sandboxAgentSetupErrorMessage,
@@ -0,0 +1,361 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fieldexpr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+// Resolver 按列名取列值, 文本列返回 string, 其余类型的列原样返回, 表达式只做透传
+type Resolver func(column string) (any, bool)
+
+// Eval 求值。字段缺失或下钻路径不存在时结果为 nil, 不报错
+func (e *Expr) Eval(resolve Resolver) (any, error) {
+	return e.root.eval(resolve)
+}
+
+// ToString 将求值结果转为文本: nil 为空串, 数字不带多余的小数位, 对象与数组序列化为 JSON
+func ToString(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case bool:
+		return strconv.FormatBool(val)
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case int, int32, int64:
+		return fmt.Sprint(val)
+	default:
+		return json.Jsonify(val)
+	}
+}
+
+// Truthy 条件判定: nil、false、空串、0 与空数组/对象为假
+func Truthy(v any) bool {
+	switch val := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return val
+	case string:
+		return val != ""
+	case float64:
+		return val != 0
+	case []any:
+		return len(val) > 0
+	case map[string]any:
+		return len(val) > 0
+	default:
+		return true
+	}
+}
+
+type node interface {
+	eval(resolve Resolver) (any, error)
+	children() []node
+}
+
+func walk(n node, fn func(node)) {
+	fn(n)
+	for _, c := range n.children() {
+		walk(c, fn)
+	}
+}
+
+type literalNode struct {
+	value any
+}
+
+func (n *literalNode) eval(Resolver) (any, error) { return n.value, nil }
+func (n *literalNode) children() []node           { return nil }
+
+func (n *literalNode) valueString() (string, bool) {
+	if n == nil {
+		return "", false
+	}
+	s, ok := n.value.(string)
+	return s, ok
+}
+
+type fieldNode struct {
+	column string
+	path   []pathSeg
+	src    string
+}
+
+func (n *fieldNode) eval(resolve Resolver) (any, error) {
+	v, ok := resolve(n.column)
+	if !ok {
+		return nil, nil
+	}
+	if len(n.path) == 0 {
+		return v, nil
+	}
+	// 文本列按 JSON 下钻, 非 JSON 文本视为路径不存在
+	if s, isStr := v.(string); isStr {
+		var parsed any
+		if err := json.Unmarshal([]byte(s), &parsed); err != nil {
+			return nil, nil
+		}
+		v = parsed
+	}
+	return lookup(v, n.path), nil
+}
+
+func (n *fieldNode) children() []node { return nil }
+
+type getNode struct {
+	target node
+	path   []pathSeg
+}
+
+func (n *getNode) eval(resolve Resolver) (any, error) {
+	v, err := n.target.eval(resolve)
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := v.(string); ok && len(n.path) > 0 {
+		var parsed any
+		if err := json.Unmarshal([]byte(s), &parsed); err != nil {
+			return nil, nil
+		}
+		v = parsed
+	}
+	return lookup(v, n.path), nil
+}
+
+func (n *getNode) children() []node { return []node{n.target} }
+
+func lookup(v any, path []pathSeg) any {
+	for _, seg := range path {
+		switch cur := v.(type) {
+		case map[string]any:
+			if seg.isIndex {
+				return nil
+			}
+			v = cur[seg.key]
+		case []any:
+			if !seg.isIndex || seg.index >= len(cur) {
+				return nil
+			}
+			v = cur[seg.index]
+		default:
+			return nil
+		}
+	}
+	return v
+}
+
+type concatNode struct {
+	parts []node
+}
+
+func (n *concatNode) eval(resolve Resolver) (any, error) {
+	var sb strings.Builder
+	for _, p := range n.parts {
+		v, err := p.eval(resolve)
+		if err != nil {
+			return nil, err
+		}
+		sb.WriteString(ToString(v))
+	}
+	return sb.String(), nil
+}
+
+func (n *concatNode) children() []node { return n.parts }
+
+type cmpNode struct {
+	neq         bool
+	left, right node
+}
+
+func (n *cmpNode) eval(resolve Resolver) (any, error) {
+	l, err := n.left.eval(resolve)
+	if err != nil {
+		return nil, err
+	}
+	r, err := n.right.eval(resolve)
+	if err != nil {
+		return nil, err
+	}
+	eq := (l == nil) == (r == nil) && ToString(l) == ToString(r)
+	return eq != n.neq, nil
+}
+
+func (n *cmpNode) children() []node { return []node{n.left, n.right} }
+
+type logicNode struct {
+	and         bool
+	left, right node
+}
+
+func (n *logicNode) eval(resolve Resolver) (any, error) {
+	l, err := n.left.eval(resolve)
+	if err != nil {
+		return nil, err
+	}
+	if Truthy(l) != n.and {
+		return Truthy(l), nil
+	}
+	r, err := n.right.eval(resolve)
+	if err != nil {
+		return nil, err
+	}
+	return Truthy(r), nil
+}
+
+func (n *logicNode) children() []node { return []node{n.left, n.right} }
+
+type notNode struct {
+	operand node
+}
+
+func (n *notNode) eval(resolve Resolver) (any, error) {
+	v, err := n.operand.eval(resolve)
+	if err != nil {
+		return nil, err
+	}
+	return !Truthy(v), nil
+}
+
+func (n *notNode) children() []node { return []node{n.operand} }
+
+type callNode struct {
+	name string
+	fn   funcSpec
+	args []node
+}
+
+func (n *callNode) eval(resolve Resolver) (any, error) {
+	if n.fn.lazy != nil {
+		return n.fn.lazy(n.args, resolve)
+	}
+	args := make([]any, 0, len(n.args))
+	for _, a := range n.args {
+		v, err := a.eval(resolve)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, v)
+	}
+	v, err := n.fn.eager(args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", n.name, err)
+	}
+	return v, nil
+}
+
+func (n *callNode) children() []node { return n.args }
+
+type funcSpec struct {
+	minArgs int
+	// maxArgs 为 -1 表示不限
+	maxArgs int
+	eager   func(args []any) (any, error)
+	// lazy 按需对参数求值, 用于条件类函数
+	lazy func(args []node, resolve Resolver) (any, error)
+}
+
+func (s funcSpec) arity() string {
+	switch {
+	case s.maxArgs < 0:
+		return fmt.Sprintf("at least %d argument(s)", s.minArgs)
+	case s.minArgs == s.maxArgs:
+		return fmt.Sprintf("%d argument(s)", s.minArgs)
+	default:
+		return fmt.Sprintf("%d to %d arguments", s.minArgs, s.maxArgs)
+	}
+}
+
+// funcs 内置函数; template 与 get 在解析期展开, 此处仅登记参数个数
+var funcs map[string]funcSpec
+
+func init() {
+	funcs = map[string]funcSpec{
+		"if":         {minArgs: 3, maxArgs: 3, lazy: evalIf},
+		"coalesce":   {minArgs: 1, maxArgs: -1, lazy: evalCoalesce},
+		"concat":     {minArgs: 1, maxArgs: -1, eager: concatArgs},
+		"template":   {minArgs: 1, maxArgs: 1},
+		"get":        {minArgs: 2, maxArgs: 2},
+		"parse_json": {minArgs: 1, maxArgs: 1, eager: parseJSON},
+		"string":     {minArgs: 1, maxArgs: 1, eager: func(args []any) (any, error) { return ToString(args[0]), nil }},
+		"lower":      {minArgs: 1, maxArgs: 1, eager: func(args []any) (any, error) { return strings.ToLower(ToString(args[0])), nil }},
+		"upper":      {minArgs: 1, maxArgs: 1, eager: func(args []any) (any, error) { return strings.ToUpper(ToString(args[0])), nil }},
+		"trim":       {minArgs: 1, maxArgs: 1, eager: func(args []any) (any, error) { return strings.TrimSpace(ToString(args[0])), nil }},
+		"contains": {minArgs: 2, maxArgs: 2, eager: func(args []any) (any, error) {
+			return strings.Contains(ToString(args[0]), ToString(args[1])), nil
+		}},
+		"len": {minArgs: 1, maxArgs: 1, eager: length},
+	}
+}
+
+func evalIf(args []node, resolve Resolver) (any, error) {
+	cond, err := args[0].eval(resolve)
+	if err != nil {
+		return nil, err
+	}
+	if Truthy(cond) {
+		return args[1].eval(resolve)
+	}
+	return args[2].eval(resolve)
+}
+
+func evalCoalesce(args []node, resolve Resolver) (any, error) {
+	for _, a := range args {
+		v, err := a.eval(resolve)
+		if err != nil {
+			return nil, err
+		}
+		if v != nil && ToString(v) != "" {
+			return v, nil
+		}
+	}
+	return nil, nil
+}
+
+func concatArgs(args []any) (any, error) {
+	var sb strings.Builder
+	for _, a := range args {
+		sb.WriteString(ToString(a))
+	}
+	return sb.String(), nil
+}
+
+func parseJSON(args []any) (any, error) {
+	if args[0] == nil {
+		return nil, nil
+	}
+	s, ok := args[0].(string)
+	if !ok {
+		// 已是结构化值, 原样返回
+		return args[0], nil
+	}
+	var parsed any
+	if err := json.Unmarshal([]byte(s), &parsed); err != nil {
+		return nil, fmt.Errorf("invalid json: %w", err)
+	}
+	return parsed, nil
+}
+
+func length(args []any) (any, error) {
+	switch val := args[0].(type) {
+	case nil:
+		return float64(0), nil
+	case string:
+		return float64(utf8.RuneCountInString(val)), nil
+	case []any:
+		return float64(len(val)), nil
+	case map[string]any:
+		return float64(len(val)), nil
+	default:
+		return float64(len(ToString(val))), nil
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fieldexpr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type image struct{ URL string }
+
+func testResolver(columns map[string]any) Resolver {
+	return func(column string) (any, bool) {
+		v, ok := columns[column]
+		return v, ok
+	}
+}
+
+func TestExpr_Eval(t *testing.T) {
+	img := &image{URL: "https://a/b.png"}
+	resolve := testResolver(map[string]any{
+		"input":   "What is 1+1?",
+		"answer":  `{"text":"2","refs":[{"id":"r1"},{"id":"r2"}]}`,
+		"raw":     `"{\"k\":\"v\"}"`,
+		"lang":    "zh",
+		"empty":   "",
+		"图片":      img,
+		"a b":     `{"x":1.5}`,
+		"plain":   "not json",
+		"numbers": `[1,2,3]`,
+	})
+
+	cases := []struct {
+		name string
+		src  string
+		want any
+	}{
+		{"列引用", "$.input", "What is 1+1?"},
+		{"JSON 下钻", "$.answer.text", "2"},
+		{"数组下标", "$.answer.refs[1].id", "r2"},
+		{"引号列名", "$['a b'].x", 1.5},
+		{"非文本列透传", "$.图片", img},
+		{"缺失列", "$.missing", nil},
+		{"缺失路径", "$.answer.refs[5].id", nil},
+		{"非 JSON 文本下钻", "$.plain.a", nil},
+		{"字符串拼接", `"Q: " + $.input + " A: " + $.answer.text`, "Q: What is 1+1? A: 2"},
+		{"concat", `concat($.lang, "-", 1, true)`, "zh-1true"},
+		{"模板", `template("Q: {{ $.input }}\nA: {{ upper($.lang) }}")`, "Q: What is 1+1?\nA: ZH"},
+		{"条件", `if($.lang == "zh", "中文", "other")`, "中文"},
+		{"条件取反", `if(!($.lang != "zh") && $.empty == "", "ok", "ko")`, "ok"},
+		{"或运算", `$.empty || $.lang`, true},
+		{"coalesce", `coalesce($.empty, $.missing, $.lang)`, "zh"},
+		{"parse_json 字符串列", `get(parse_json(parse_json($.raw)), "$.k")`, "v"},
+		{"get 省略 $", `get($.answer, "refs[0].id")`, "r1"},
+		{"len", `len($.numbers.x) + "/" + len(parse_json($.numbers))`, "0/3"},
+		{"string 序列化对象", `string(get($.answer, "$.refs[0]"))`, `{"id":"r1"}`},
+		{"字符串函数", `trim("  " + lower("AbC") + " ")`, "abc"},
+		{"contains", `contains($.input, "1+1")`, true},
+		{"字面量", `null`, nil},
+		{"转义", `'it\'s'`, "it's"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			expr, err := Compile(c.src)
+			if !assert.NoError(t, err) {
+				return
+			}
+			got, err := expr.Eval(resolve)
+			assert.NoError(t, err)
+			assert.Equal(t, c.want, got)
+		})
+	}
+
+	t.Run("parse_json 非法 JSON", func(t *testing.T) {
+		expr, err := Compile(`parse_json($.plain)`)
+		assert.NoError(t, err)
+		_, err = expr.Eval(resolve)
+		assert.ErrorContains(t, err, "parse_json")
+	})
+}
+
+func TestCompile_Error(t *testing.T) {
+	cases := []struct {
+		name    string
+		src     string
+		wantPos int
+		wantMsg string
+	}{
+		{"空表达式", "  ", 0, "empty expression"},
+		{"未知函数", `foo($.a)`, 0, `unknown function "foo"`},
+		{"参数个数", `if($.a, 1)`, 0, "function if expects 3 argument(s), got 2"},
+		{"未闭合字符串", `"abc`, 0, "unterminated string literal"},
+		{"未闭合括号", `concat($.a`, 10, "expected ',' or ')'"},
+		{"多余 token", `$.a $.b`, 4, `unexpected "$.b"`},
+		{"字段引用缺列名", `$[0]`, 0, "must start with a column name"},
+		{"点号后缺字段", `$.a.`, 4, "expected field name"},
+		{"模板非字面量", `template($.a)`, 9, "template expects a string literal"},
+		{"模板内语法错误", `template("x {{ foo() }}")`, 15, `unknown function "foo"`},
+		{"模板未闭合", `template("x {{ $.a")`, 12, "unterminated '{{'"},
+		{"get 路径非法", `get($.a, "$.b[")`, 10, "invalid path"},
+		{"非法字符", `$.a # 1`, 4, "unexpected character"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := Compile(c.src)
+			var se *SyntaxError
+			if assert.True(t, errors.As(err, &se), "err: %v", err) {
+				assert.Equal(t, c.wantPos, se.Pos)
+				assert.Contains(t, se.Msg, c.wantMsg)
+			}
+		})
+	}
+}
+
+func TestExpr_Fields(t *testing.T) {
+	expr, err := Compile(`template("{{ $.q }} {{ $.a.b }}") + if($.c, $.q, get($['d e'], "$.x"))`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"q", "a", "c", "d e"}, expr.Fields())
+}
+
+func TestToString(t *testing.T) {
+	assert.Equal(t, "", ToString(nil))
+	assert.Equal(t, "3", ToString(float64(3)))
+	assert.Equal(t, "0.25", ToString(0.25))
+	assert.Equal(t, "false", ToString(false))
+	assert.Equal(t, `[1,"a"]`, ToString([]any{float64(1), "a"}))
+}
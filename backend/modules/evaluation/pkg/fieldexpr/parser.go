@@ -0,0 +1,585 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fieldexpr 实现实验字段映射使用的表达式语言。
+//
+// 语法:
+//   - 字段引用: $.col, $.col.a.b, $.col[0], $['带空格的列'].a, 第一级为列名, 其余层级按 JSON 下钻
+//   - 字面量: "str" / 'str', 数字, true / false / null
+//   - 运算: a + b 字符串拼接, a == b / a != b 按字符串形式比较, a && b, a || b, !a
+//   - 函数: if(cond, a, b), coalesce(a, ...), concat(a, ...), template("Q: {{ $.q }}"), parse_json(s), get(v, "$.a[0]"),
+//     string(v), lower(s), upper(s), trim(s), contains(s, sub), len(v)
+package fieldexpr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SyntaxError 表达式语法错误, Pos 为出错位置的字节偏移
+type SyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at position %d: %s", e.Pos, e.Msg)
+}
+
+// Expr 编译后的表达式
+type Expr struct {
+	src  string
+	root node
+}
+
+// Compile 编译表达式, 同时校验函数名与参数个数
+func Compile(src string) (*Expr, error) {
+	if strings.TrimSpace(src) == "" {
+		return nil, &SyntaxError{Pos: 0, Msg: "empty expression"}
+	}
+	p := &parser{lex: &lexer{src: src}}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	root, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokEOF {
+		return nil, &SyntaxError{Pos: p.tok.pos, Msg: fmt.Sprintf("unexpected %q", p.tok.text)}
+	}
+	return &Expr{src: src, root: root}, nil
+}
+
+func (e *Expr) String() string {
+	return e.src
+}
+
+// Fields 表达式引用的列名 (字段引用的第一级), 按首次出现顺序去重
+func (e *Expr) Fields() []string {
+	var fields []string
+	seen := make(map[string]bool)
+	walk(e.root, func(n node) {
+		if f, ok := n.(*fieldNode); ok && !seen[f.column] {
+			seen[f.column] = true
+			fields = append(fields, f.column)
+		}
+	})
+	return fields
+}
+
+type tokKind int
+
+const (
+	tokEOF tokKind = iota
+	tokIdent
+	tokString
+	tokNumber
+	tokField
+	tokLParen
+	tokRParen
+	tokComma
+	tokPlus
+	tokEq
+	tokNeq
+	tokAnd
+	tokOr
+	tokNot
+)
+
+type token struct {
+	kind tokKind
+	text string
+	pos  int
+	// str 字符串字面量的值
+	str string
+	num float64
+	// path 字段引用的路径段
+	path []pathSeg
+}
+
+// pathSeg 字段路径段, key 与 index 二选一
+type pathSeg struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+type lexer struct {
+	src string
+	pos int
+}
+
+func (l *lexer) next() (token, error) {
+	for l.pos < len(l.src) && isSpace(l.src[l.pos]) {
+		l.pos++
+	}
+	start := l.pos
+	if l.pos >= len(l.src) {
+		return token{kind: tokEOF, pos: start, text: "end of expression"}, nil
+	}
+	c := l.src[l.pos]
+	two := ""
+	if l.pos+1 < len(l.src) {
+		two = l.src[l.pos : l.pos+2]
+	}
+	switch {
+	case two == "==":
+		l.pos += 2
+		return token{kind: tokEq, text: two, pos: start}, nil
+	case two == "!=":
+		l.pos += 2
+		return token{kind: tokNeq, text: two, pos: start}, nil
+	case two == "&&":
+		l.pos += 2
+		return token{kind: tokAnd, text: two, pos: start}, nil
+	case two == "||":
+		l.pos += 2
+		return token{kind: tokOr, text: two, pos: start}, nil
+	case c == '!':
+		l.pos++
+		return token{kind: tokNot, text: "!", pos: start}, nil
+	case c == '(':
+		l.pos++
+		return token{kind: tokLParen, text: "(", pos: start}, nil
+	case c == ')':
+		l.pos++
+		return token{kind: tokRParen, text: ")", pos: start}, nil
+	case c == ',':
+		l.pos++
+		return token{kind: tokComma, text: ",", pos: start}, nil
+	case c == '+':
+		l.pos++
+		return token{kind: tokPlus, text: "+", pos: start}, nil
+	case c == '"' || c == '\'':
+		s, err := l.readString()
+		if err != nil {
+			return token{}, err
+		}
+		return token{kind: tokString, text: l.src[start:l.pos], pos: start, str: s}, nil
+	case c == '$':
+		path, err := l.readFieldPath()
+		if err != nil {
+			return token{}, err
+		}
+		return token{kind: tokField, text: l.src[start:l.pos], pos: start, path: path}, nil
+	case c == '-' || isDigit(c):
+		return l.readNumber()
+	case isIdentStart(c):
+		for l.pos < len(l.src) && isIdentPart(l.src[l.pos]) {
+			l.pos++
+		}
+		return token{kind: tokIdent, text: l.src[start:l.pos], pos: start}, nil
+	default:
+		r, _ := utf8.DecodeRuneInString(l.src[l.pos:])
+		return token{}, &SyntaxError{Pos: start, Msg: fmt.Sprintf("unexpected character %q", r)}
+	}
+}
+
+func (l *lexer) readString() (string, error) {
+	start := l.pos
+	quote := l.src[l.pos]
+	l.pos++
+	var sb strings.Builder
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case c == quote:
+			l.pos++
+			return sb.String(), nil
+		case c == '\\':
+			if l.pos+1 >= len(l.src) {
+				return "", &SyntaxError{Pos: l.pos, Msg: "unterminated escape sequence"}
+			}
+			switch esc := l.src[l.pos+1]; esc {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case 'r':
+				sb.WriteByte('\r')
+			case '\\', '"', '\'':
+				sb.WriteByte(esc)
+			default:
+				return "", &SyntaxError{Pos: l.pos, Msg: fmt.Sprintf("unknown escape sequence \\%c", esc)}
+			}
+			l.pos += 2
+		default:
+			sb.WriteByte(c)
+			l.pos++
+		}
+	}
+	return "", &SyntaxError{Pos: start, Msg: "unterminated string literal"}
+}
+
+func (l *lexer) readNumber() (token, error) {
+	start := l.pos
+	if l.src[l.pos] == '-' {
+		l.pos++
+	}
+	for l.pos < len(l.src) && (isDigit(l.src[l.pos]) || l.src[l.pos] == '.' || l.src[l.pos] == 'e' || l.src[l.pos] == 'E' ||
+		((l.src[l.pos] == '+' || l.src[l.pos] == '-') && (l.src[l.pos-1] == 'e' || l.src[l.pos-1] == 'E'))) {
+		l.pos++
+	}
+	text := l.src[start:l.pos]
+	num, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return token{}, &SyntaxError{Pos: start, Msg: fmt.Sprintf("invalid number %q", text)}
+	}
+	return token{kind: tokNumber, text: text, pos: start, num: num}, nil
+}
+
+// readFieldPath 读取 $ 开头的字段引用, 第一段必须为列名
+func (l *lexer) readFieldPath() ([]pathSeg, error) {
+	start := l.pos
+	l.pos++ // $
+	var path []pathSeg
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		if c == '.' {
+			l.pos++
+			keyStart := l.pos
+			for l.pos < len(l.src) && isKeyPart(l.src[l.pos:]) {
+				_, size := utf8.DecodeRuneInString(l.src[l.pos:])
+				l.pos += size
+			}
+			if l.pos == keyStart {
+				return nil, &SyntaxError{Pos: keyStart, Msg: "expected field name after '.'"}
+			}
+			path = append(path, pathSeg{key: l.src[keyStart:l.pos]})
+			continue
+		}
+		if c != '[' {
+			break
+		}
+		l.pos++
+		for l.pos < len(l.src) && isSpace(l.src[l.pos]) {
+			l.pos++
+		}
+		if l.pos >= len(l.src) {
+			return nil, &SyntaxError{Pos: l.pos, Msg: "unterminated '['"}
+		}
+		if q := l.src[l.pos]; q == '"' || q == '\'' {
+			key, err := l.readString()
+			if err != nil {
+				return nil, err
+			}
+			path = append(path, pathSeg{key: key})
+		} else {
+			idxStart := l.pos
+			for l.pos < len(l.src) && isDigit(l.src[l.pos]) {
+				l.pos++
+			}
+			if l.pos == idxStart {
+				return nil, &SyntaxError{Pos: idxStart, Msg: "expected array index or quoted field name in '[]'"}
+			}
+			idx, _ := strconv.Atoi(l.src[idxStart:l.pos])
+			path = append(path, pathSeg{index: idx, isIndex: true})
+		}
+		for l.pos < len(l.src) && isSpace(l.src[l.pos]) {
+			l.pos++
+		}
+		if l.pos >= len(l.src) || l.src[l.pos] != ']' {
+			return nil, &SyntaxError{Pos: l.pos, Msg: "expected ']'"}
+		}
+		l.pos++
+	}
+	if len(path) == 0 || path[0].isIndex {
+		return nil, &SyntaxError{Pos: start, Msg: "field reference must start with a column name, e.g. $.input"}
+	}
+	return path, nil
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || isDigit(c)
+}
+
+// isKeyPart 字段名允许字母 (含中文)、数字、下划线与中划线
+func isKeyPart(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return r == '_' || r == '-' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+type parser struct {
+	lex *lexer
+	tok token
+}
+
+func (p *parser) next() error {
+	tok, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+func (p *parser) expect(kind tokKind, what string) error {
+	if p.tok.kind != kind {
+		return &SyntaxError{Pos: p.tok.pos, Msg: fmt.Sprintf("expected %s, got %q", what, p.tok.text)}
+	}
+	return p.next()
+}
+
+func (p *parser) parseExpr() (node, error) {
+	return p.parseOr()
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokOr {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicNode{and: false, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseCmp()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokAnd {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseCmp()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicNode{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseCmp() (node, error) {
+	left, err := p.parseConcat()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind == tokEq || p.tok.kind == tokNeq {
+		neq := p.tok.kind == tokNeq
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseConcat()
+		if err != nil {
+			return nil, err
+		}
+		return &cmpNode{neq: neq, left: left, right: right}, nil
+	}
+	return left, nil
+}
+
+func (p *parser) parseConcat() (node, error) {
+	first, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokPlus {
+		return first, nil
+	}
+	parts := []node{first}
+	for p.tok.kind == tokPlus {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		n, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, n)
+	}
+	return &concatNode{parts: parts}, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.tok.kind == tokNot {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.tok
+	switch tok.kind {
+	case tokString:
+		return &literalNode{value: tok.str}, p.next()
+	case tokNumber:
+		return &literalNode{value: tok.num}, p.next()
+	case tokField:
+		return &fieldNode{column: tok.path[0].key, path: tok.path[1:], src: tok.text}, p.next()
+	case tokLParen:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		n, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		return n, p.expect(tokRParen, "')'")
+	case tokIdent:
+		switch tok.text {
+		case "true":
+			return &literalNode{value: true}, p.next()
+		case "false":
+			return &literalNode{value: false}, p.next()
+		case "null":
+			return &literalNode{value: nil}, p.next()
+		}
+		return p.parseCall()
+	default:
+		return nil, &SyntaxError{Pos: tok.pos, Msg: fmt.Sprintf("unexpected %q", tok.text)}
+	}
+}
+
+func (p *parser) parseCall() (node, error) {
+	name, pos := p.tok.text, p.tok.pos
+	spec, ok := funcs[name]
+	if !ok {
+		return nil, &SyntaxError{Pos: pos, Msg: fmt.Sprintf("unknown function %q", name)}
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	if err := p.expect(tokLParen, fmt.Sprintf("'(' after function %s", name)); err != nil {
+		return nil, err
+	}
+	var args []node
+	var argToks []token
+	for p.tok.kind != tokRParen {
+		if len(args) > 0 {
+			if err := p.expect(tokComma, "',' or ')'"); err != nil {
+				return nil, err
+			}
+		}
+		argToks = append(argToks, p.tok)
+		arg, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	if len(args) < spec.minArgs || (spec.maxArgs >= 0 && len(args) > spec.maxArgs) {
+		return nil, &SyntaxError{Pos: pos, Msg: fmt.Sprintf("function %s expects %s, got %d", name, spec.arity(), len(args))}
+	}
+
+	switch name {
+	case "template":
+		lit, ok := args[0].(*literalNode)
+		s, isStr := lit.valueString()
+		if !ok || !isStr {
+			return nil, &SyntaxError{Pos: argToks[0].pos, Msg: "template expects a string literal"}
+		}
+		// 模板内表达式的位置相对于模板字面量内容起始
+		return parseTemplate(s, argToks[0].pos+1)
+	case "get":
+		lit, ok := args[1].(*literalNode)
+		s, isStr := lit.valueString()
+		if !ok || !isStr {
+			return nil, &SyntaxError{Pos: argToks[1].pos, Msg: "get expects a string literal path as the second argument"}
+		}
+		path, err := parseGetPath(s, argToks[1].pos+1)
+		if err != nil {
+			return nil, err
+		}
+		return &getNode{target: args[0], path: path}, nil
+	}
+	return &callNode{name: name, fn: spec, args: args}, nil
+}
+
+// parseTemplate 解析 {{ expr }} 占位符, 其余为原样文本
+func parseTemplate(s string, offset int) (node, error) {
+	var parts []node
+	rest, pos := s, 0
+	for {
+		open := strings.Index(rest, "{{")
+		if open < 0 {
+			if rest != "" {
+				parts = append(parts, &literalNode{value: rest})
+			}
+			break
+		}
+		if open > 0 {
+			parts = append(parts, &literalNode{value: rest[:open]})
+		}
+		closeIdx := strings.Index(rest[open+2:], "}}")
+		if closeIdx < 0 {
+			return nil, &SyntaxError{Pos: offset + pos + open, Msg: "unterminated '{{' in template"}
+		}
+		inner := rest[open+2 : open+2+closeIdx]
+		sub, err := Compile(inner)
+		if err != nil {
+			if se, ok := err.(*SyntaxError); ok {
+				return nil, &SyntaxError{Pos: offset + pos + open + 2 + se.Pos, Msg: se.Msg}
+			}
+			return nil, err
+		}
+		parts = append(parts, sub.root)
+		consumed := open + 2 + closeIdx + 2
+		rest, pos = rest[consumed:], pos+consumed
+	}
+	return &concatNode{parts: parts}, nil
+}
+
+// parseGetPath 解析 get 的路径参数, 形如 $.a.b[0] 或 $[0], 可省略 $
+func parseGetPath(s string, offset int) ([]pathSeg, error) {
+	path := strings.TrimSpace(s)
+	if path == "" || path == "$" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(path, "$") {
+		if strings.HasPrefix(path, "[") {
+			path = "$" + path
+		} else {
+			path = "$." + path
+		}
+	}
+	// 借用字段引用的词法, $[0] 开头的路径补一个占位列名后再去掉
+	l := &lexer{src: "$._" + path[1:]}
+	segs, err := l.readFieldPath()
+	if err == nil && l.pos != len(l.src) {
+		err = &SyntaxError{Pos: l.pos - 2, Msg: fmt.Sprintf("unexpected %q in path", l.src[l.pos:])}
+	}
+	if err != nil {
+		if se, ok := err.(*SyntaxError); ok {
+			return nil, &SyntaxError{Pos: offset, Msg: "invalid path: " + se.Msg}
+		}
+		return nil, err
+	}
+	return segs[1:], nil
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// CohenKappa 两名标注者在同一批样本上的 Cohen's kappa, a[i] / b[i] 为第 i 个样本上两人给出的类别。
+// 两切片长度不一致时按较短者截断; 无样本或期望一致率为 1 (两人始终只用同一个类别) 时无定义, ok 返回 false。
+func CohenKappa(a, b []string) (kappa float64, ok bool) {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	if n == 0 {
+		return 0, false
+	}
+	agree := 0
+	countA, countB := make(map[string]int), make(map[string]int)
+	for i := 0; i < n; i++ {
+		if a[i] == b[i] {
+			agree++
+		}
+		countA[a[i]]++
+		countB[b[i]]++
+	}
+	po := float64(agree) / float64(n)
+	var pe float64
+	for c, ca := range countA {
+		pe += float64(ca) / float64(n) * float64(countB[c]) / float64(n)
+	}
+	if pe >= 1 {
+		return 0, false
+	}
+	return (po - pe) / (1 - pe), true
+}
+
+// FleissKappa 多名标注者的 Fleiss' kappa, units[i] 为第 i 个样本上全部标注者给出的类别。
+// 允许各样本标注人数不同 (按样本内两两一致率的均值计算), 少于 2 个标注的样本不参与;
+// 无有效样本或期望一致率为 1 时 ok 返回 false。
+func FleissKappa(units [][]string) (kappa float64, ok bool) {
+	var (
+		sumP     float64
+		unitCnt  int
+		total    int
+		catCount = make(map[string]int)
+	)
+	for _, unit := range units {
+		m := len(unit)
+		if m < 2 {
+			continue
+		}
+		counts := make(map[string]int, m)
+		for _, v := range unit {
+			counts[v]++
+			catCount[v]++
+		}
+		var agreePairs int
+		for _, c := range counts {
+			agreePairs += c * (c - 1)
+		}
+		sumP += float64(agreePairs) / float64(m*(m-1))
+		unitCnt++
+		total += m
+	}
+	if unitCnt == 0 {
+		return 0, false
+	}
+	pBar := sumP / float64(unitCnt)
+	var pe float64
+	for _, c := range catCount {
+		p := float64(c) / float64(total)
+		pe += p * p
+	}
+	if pe >= 1 {
+		return 0, false
+	}
+	return (pBar - pe) / (1 - pe), true
+}
+
+// KrippendorffAlphaNominal 名义尺度的 Krippendorff's alpha, units[i] 为第 i 个样本的全部标注值 (可缺失, 人数可不同)。
+// 少于 2 个标注的样本不可配对, 不参与计算; 可配对值全部相同 (无变异) 时 ok 返回 false。
+func KrippendorffAlphaNominal(units [][]string) (alpha float64, ok bool) {
+	var (
+		observed float64
+		n        int
+		catCount = make(map[string]int)
+	)
+	for _, unit := range units {
+		m := len(unit)
+		if m < 2 {
+			continue
+		}
+		counts := make(map[string]int, m)
+		for _, v := range unit {
+			counts[v]++
+			catCount[v]++
+		}
+		// 样本内有序值对中取值不同的对数: m^2 - Σ m_c^2
+		disagree := m * m
+		for _, c := range counts {
+			disagree -= c * c
+		}
+		observed += float64(disagree) / float64(m-1)
+		n += m
+	}
+	expected := n * n
+	for _, c := range catCount {
+		expected -= c * c
+	}
+	if n < 2 || expected == 0 {
+		return 0, false
+	}
+	return 1 - float64(n-1)*observed/float64(expected), true
+}
+
+// KrippendorffAlphaInterval 区间尺度 (差值平方距离) 的 Krippendorff's alpha, 其余约定同 KrippendorffAlphaNominal
+func KrippendorffAlphaInterval(units [][]float64) (alpha float64, ok bool) {
+	var (
+		observed     float64
+		n            int
+		sum, sumSq   float64
+		unitSumSqDev = func(xs []float64) float64 {
+			// Σ_{i,j} (x_i - x_j)^2 = 2m Σx^2 - 2 (Σx)^2
+			var s, sq float64
+			for _, x := range xs {
+				s += x
+				sq += x * x
+			}
+			return 2*float64(len(xs))*sq - 2*s*s
+		}
+	)
+	for _, unit := range units {
+		m := len(unit)
+		if m < 2 {
+			continue
+		}
+		observed += unitSumSqDev(unit) / float64(m-1)
+		for _, x := range unit {
+			sum += x
+			sumSq += x * x
+		}
+		n += m
+	}
+	expected := 2*float64(n)*sumSq - 2*sum*sum
+	if n < 2 || expected <= 1e-12 {
+		return 0, false
+	}
+	return 1 - float64(n-1)*observed/expected, true
+}
+
+// Pearson 皮尔逊相关系数, 两切片长度不一致时按较短者截断; 样本不足 2 或任一侧方差为 0 时 ok 返回 false
+func Pearson(x, y []float64) (r float64, ok bool) {
+	n := len(x)
+	if len(y) < n {
+		n = len(y)
+	}
+	if n < 2 {
+		return 0, false
+	}
+	mx, my := Mean(x[:n]), Mean(y[:n])
+	var sxy, sxx, syy float64
+	for i := 0; i < n; i++ {
+		dx, dy := x[i]-mx, y[i]-my
+		sxy += dx * dy
+		sxx += dx * dx
+		syy += dy * dy
+	}
+	if sxx == 0 || syy == 0 {
+		return 0, false
+	}
+	return sxy / math.Sqrt(sxx*syy), true
+}
+
+// Spearman 斯皮尔曼秩相关系数 (并列取平均秩), 约定同 Pearson
+func Spearman(x, y []float64) (rho float64, ok bool) {
+	n := len(x)
+	if len(y) < n {
+		n = len(y)
+	}
+	if n < 2 {
+		return 0, false
+	}
+	return Pearson(averageRanks(x[:n]), averageRanks(y[:n]))
+}
+
+// averageRanks 升序平均秩, 从 1 开始
+func averageRanks(xs []float64) []float64 {
+	n := len(xs)
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(a, b int) bool { return xs[idx[a]] < xs[idx[b]] })
+
+	ranks := make([]float64, n)
+	for i := 0; i < n; {
+		j := i
+		for j+1 < n && xs[idx[j+1]] == xs[idx[i]] {
+			j++
+		}
+		avg := float64(i+j)/2 + 1
+		for k := i; k <= j; k++ {
+			ranks[idx[k]] = avg
+		}
+		i = j + 1
+	}
+	return ranks
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCohenKappa(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   []string
+		want   float64
+		wantOK bool
+	}{
+		{name: "empty", wantOK: false},
+		{name: "single category", a: []string{"y", "y"}, b: []string{"y", "y"}, wantOK: false},
+		{name: "perfect agreement", a: []string{"y", "n", "y"}, b: []string{"y", "n", "y"}, want: 1, wantOK: true},
+		{name: "normal case", a: []string{"y", "y", "n", "n", "y"}, b: []string{"y", "n", "n", "n", "y"}, want: 0.61538, wantOK: true},
+		{name: "length mismatch truncated", a: []string{"y", "n", "y"}, b: []string{"y", "n"}, want: 1, wantOK: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := CohenKappa(tt.a, tt.b)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.InDelta(t, tt.want, got, 1e-4)
+		})
+	}
+}
+
+func TestFleissKappa(t *testing.T) {
+	tests := []struct {
+		name   string
+		units  [][]string
+		want   float64
+		wantOK bool
+	}{
+		{name: "no pairable unit", units: [][]string{{"a"}, {"b"}}, wantOK: false},
+		{name: "two raters matches cohen", units: [][]string{{"a", "a"}, {"b", "b"}, {"a", "b"}}, want: 0.33333, wantOK: true},
+		{name: "variable raters per unit", units: [][]string{{"a", "a", "a"}, {"b", "b"}, {"a", "b", "b"}, {"a"}}, want: 0.55556, wantOK: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := FleissKappa(tt.units)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.InDelta(t, tt.want, got, 1e-4)
+		})
+	}
+}
+
+func TestKrippendorffAlpha(t *testing.T) {
+	t.Run("nominal", func(t *testing.T) {
+		alpha, ok := KrippendorffAlphaNominal([][]string{{"a", "a"}, {"b", "b"}, {"a", "b"}})
+		assert.True(t, ok)
+		assert.InDelta(t, 0.44444, alpha, 1e-4)
+
+		alpha, ok = KrippendorffAlphaNominal([][]string{{"a", "a"}, {"b", "b"}, {"c"}})
+		assert.True(t, ok)
+		assert.InDelta(t, 1, alpha, 1e-9)
+
+		_, ok = KrippendorffAlphaNominal([][]string{{"a", "a"}, {"a", "a"}})
+		assert.False(t, ok)
+	})
+
+	t.Run("interval", func(t *testing.T) {
+		alpha, ok := KrippendorffAlphaInterval([][]float64{{1, 1}, {2, 2}, {3, 3}})
+		assert.True(t, ok)
+		assert.InDelta(t, 1, alpha, 1e-9)
+
+		alpha, ok = KrippendorffAlphaInterval([][]float64{{1, 2}, {2, 1}})
+		assert.True(t, ok)
+		assert.InDelta(t, -0.5, alpha, 1e-9)
+
+		_, ok = KrippendorffAlphaInterval([][]float64{{1}, {2}})
+		assert.False(t, ok)
+	})
+}
+
+func TestPearson(t *testing.T) {
+	r, ok := Pearson([]float64{1, 2, 3}, []float64{2, 4, 6})
+	assert.True(t, ok)
+	assert.InDelta(t, 1, r, 1e-9)
+
+	r, ok = Pearson([]float64{1, 2, 3}, []float64{3, 2, 1})
+	assert.True(t, ok)
+	assert.InDelta(t, -1, r, 1e-9)
+
+	_, ok = Pearson([]float64{1, 2, 3}, []float64{1, 1, 1})
+	assert.False(t, ok)
+	_, ok = Pearson([]float64{1}, []float64{1})
+	assert.False(t, ok)
+}
+
+func TestSpearman(t *testing.T) {
+	rho, ok := Spearman([]float64{1, 2, 3, 4}, []float64{1, 4, 9, 16})
+	assert.True(t, ok)
+	assert.InDelta(t, 1, rho, 1e-9)
+
+	rho, ok = Spearman([]float64{1, 2, 2, 3}, []float64{1, 2, 3, 4})
+	assert.True(t, ok)
+	assert.InDelta(t, 0.94868, rho, 1e-4)
+
+	_, ok = Spearman([]float64{1, 1}, []float64{1, 2})
+	assert.False(t, ok)
+}
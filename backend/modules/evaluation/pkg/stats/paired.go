@@ -0,0 +1,162 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package stats 提供评测结果对比、一致性度量等场景使用的统计学工具函数。
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// Mean 算术平均, 空切片返回 0
+func Mean(xs []float64) float64 {
+	if len(xs) == 0 {
+		return 0
+	}
+	return stat.Mean(xs, nil)
+}
+
+// StdDev 样本标准差 (n-1), 样本数不足 2 时返回 0
+func StdDev(xs []float64) float64 {
+	if len(xs) < 2 {
+		return 0
+	}
+	return stat.StdDev(xs, nil)
+}
+
+// PairedDiffs 计算配对差值 candidate[i] - base[i], 两切片长度必须一致, 否则按较短者截断
+func PairedDiffs(base, candidate []float64) []float64 {
+	n := len(base)
+	if len(candidate) < n {
+		n = len(candidate)
+	}
+	diffs := make([]float64, n)
+	for i := 0; i < n; i++ {
+		diffs[i] = candidate[i] - base[i]
+	}
+	return diffs
+}
+
+// PairedTTest 对配对差值做双侧 t 检验 (H0: 均值差为 0), 返回 t 统计量与 p 值。
+// 样本数不足 2 时无法检验, p 值返回 1; 差值方差为 0 时, 均值差为 0 则 p=1, 否则 p=0。
+func PairedTTest(diffs []float64) (tStat, pValue float64) {
+	n := len(diffs)
+	if n < 2 {
+		return 0, 1
+	}
+	mean := Mean(diffs)
+	sd := StdDev(diffs)
+	if sd == 0 {
+		if mean == 0 {
+			return 0, 1
+		}
+		return math.Copysign(math.Inf(1), mean), 0
+	}
+	tStat = mean / (sd / math.Sqrt(float64(n)))
+	dist := distuv.StudentsT{Mu: 0, Sigma: 1, Nu: float64(n - 1)}
+	pValue = 2 * dist.Survival(math.Abs(tStat))
+	return tStat, clampProbability(pValue)
+}
+
+// WilcoxonSignedRank 对配对差值做 Wilcoxon 符号秩检验 (双侧, 正态近似 + 连续性校正 + 结校正),
+// 返回 W+ 统计量与 p 值。差值为 0 的样本按惯例剔除; 剔除后无样本时 p 值返回 1。
+func WilcoxonSignedRank(diffs []float64) (wPlus, pValue float64) {
+	nonZero := make([]float64, 0, len(diffs))
+	for _, d := range diffs {
+		if d != 0 {
+			nonZero = append(nonZero, d)
+		}
+	}
+	n := len(nonZero)
+	if n == 0 {
+		return 0, 1
+	}
+
+	ranks, tieCorrection := absRanks(nonZero)
+	for i, d := range nonZero {
+		if d > 0 {
+			wPlus += ranks[i]
+		}
+	}
+
+	nf := float64(n)
+	mu := nf * (nf + 1) / 4
+	variance := nf*(nf+1)*(2*nf+1)/24 - tieCorrection/48
+	if variance <= 0 {
+		return wPlus, 1
+	}
+	z := math.Abs(wPlus-mu) - 0.5
+	if z < 0 {
+		z = 0
+	}
+	z /= math.Sqrt(variance)
+	pValue = 2 * distuv.UnitNormal.Survival(z)
+	return wPlus, clampProbability(pValue)
+}
+
+// absRanks 按绝对值升序计算平均秩, 同时返回结校正项 sum(t^3 - t)
+func absRanks(xs []float64) ([]float64, float64) {
+	n := len(xs)
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(a, b int) bool { return math.Abs(xs[idx[a]]) < math.Abs(xs[idx[b]]) })
+
+	ranks := make([]float64, n)
+	var tieCorrection float64
+	for i := 0; i < n; {
+		j := i
+		for j+1 < n && math.Abs(xs[idx[j+1]]) == math.Abs(xs[idx[i]]) {
+			j++
+		}
+		avg := float64(i+j)/2 + 1
+		for k := i; k <= j; k++ {
+			ranks[idx[k]] = avg
+		}
+		if t := float64(j - i + 1); t > 1 {
+			tieCorrection += t*t*t - t
+		}
+		i = j + 1
+	}
+	return ranks, tieCorrection
+}
+
+// BootstrapMeanCI 对配对差值做 percentile bootstrap, 返回均值差在置信水平 confidence 下的置信区间。
+// rng 由调用方传入以保证固定种子下结果可复现; 样本为空时返回 (0, 0)。
+func BootstrapMeanCI(diffs []float64, iterations int, confidence float64, rng *rand.Rand) (lower, upper float64) {
+	n := len(diffs)
+	if n == 0 {
+		return 0, 0
+	}
+	if n == 1 || iterations <= 0 {
+		return diffs[0], diffs[0]
+	}
+
+	means := make([]float64, iterations)
+	for it := 0; it < iterations; it++ {
+		var sum float64
+		for i := 0; i < n; i++ {
+			sum += diffs[rng.Intn(n)]
+		}
+		means[it] = sum / float64(n)
+	}
+	sort.Float64s(means)
+
+	alpha := (1 - confidence) / 2
+	lower = stat.Quantile(alpha, stat.Empirical, means, nil)
+	upper = stat.Quantile(1-alpha, stat.Empirical, means, nil)
+	return lower, upper
+}
+
+func clampProbability(p float64) float64 {
+	if math.IsNaN(p) {
+		return 1
+	}
+	return math.Max(0, math.Min(1, p))
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPairedTTest(t *testing.T) {
+	tests := []struct {
+		name      string
+		diffs     []float64
+		wantT     float64
+		wantP     float64
+		tolerance float64
+	}{
+		{name: "insufficient samples", diffs: []float64{1}, wantT: 0, wantP: 1},
+		{name: "all zero diffs", diffs: []float64{0, 0, 0}, wantT: 0, wantP: 1},
+		{name: "constant positive diffs", diffs: []float64{0.5, 0.5, 0.5}, wantT: math.Inf(1), wantP: 0},
+		{name: "normal case", diffs: []float64{1, 2, 3, 4, 5}, wantT: 4.2426, wantP: 0.01324, tolerance: 1e-3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotT, gotP := PairedTTest(tt.diffs)
+			if math.IsInf(tt.wantT, 0) {
+				assert.True(t, math.IsInf(gotT, 1))
+			} else {
+				assert.InDelta(t, tt.wantT, gotT, tt.tolerance)
+			}
+			assert.InDelta(t, tt.wantP, gotP, tt.tolerance)
+		})
+	}
+}
+
+func TestWilcoxonSignedRank(t *testing.T) {
+	tests := []struct {
+		name  string
+		diffs []float64
+		wantW float64
+		wantP float64
+	}{
+		{name: "all zero diffs", diffs: []float64{0, 0}, wantW: 0, wantP: 1},
+		{name: "all positive", diffs: []float64{1, 2, 3, 4, 5}, wantW: 15, wantP: 0.05906},
+		{name: "symmetric", diffs: []float64{-1, 1, -2, 2}, wantW: 5, wantP: 1},
+		{name: "zeros dropped", diffs: []float64{0, 1, 2, 3, 4, 5}, wantW: 15, wantP: 0.05906},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, p := WilcoxonSignedRank(tt.diffs)
+			assert.InDelta(t, tt.wantW, w, 1e-9)
+			assert.InDelta(t, tt.wantP, p, 1e-3)
+		})
+	}
+}
+
+func TestBootstrapMeanCI(t *testing.T) {
+	lower, upper := BootstrapMeanCI(nil, 100, 0.95, rand.New(rand.NewSource(1)))
+	assert.Equal(t, 0.0, lower)
+	assert.Equal(t, 0.0, upper)
+
+	lower, upper = BootstrapMeanCI([]float64{0.3}, 100, 0.95, rand.New(rand.NewSource(1)))
+	assert.Equal(t, 0.3, lower)
+	assert.Equal(t, 0.3, upper)
+
+	diffs := []float64{0.1, 0.2, 0.15, 0.05, 0.12, 0.18, 0.09, 0.11}
+	lower, upper = BootstrapMeanCI(diffs, 2000, 0.95, rand.New(rand.NewSource(42)))
+	mean := Mean(diffs)
+	assert.True(t, lower <= mean && mean <= upper)
+	assert.True(t, lower > 0)
+
+	// 固定种子可复现
+	lower2, upper2 := BootstrapMeanCI(diffs, 2000, 0.95, rand.New(rand.NewSource(42)))
+	assert.Equal(t, lower, lower2)
+	assert.Equal(t, upper, upper2)
+}
+
+func TestPairedDiffs(t *testing.T) {
+	assert.Equal(t, []float64{0.5, -1}, PairedDiffs([]float64{0.5, 2, 3}, []float64{1, 1}))
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"math"
+)
+
+const (
+	DefaultEloK       = 32.0
+	DefaultEloInitial = 1000.0
+
+	bradleyTerryMaxIter   = 1000
+	bradleyTerryTolerance = 1e-9
+)
+
+// PairOutcome 一次成对比较的结果, Score 为 A 侧得分: 1 表示 A 胜, 0.5 平局, 0 表示 B 胜
+type PairOutcome struct {
+	A, B  int
+	Score float64
+}
+
+// Elo 按 outcomes 顺序依次更新 n 个参赛者的 Elo 分, k <= 0 时使用 DefaultEloK
+func Elo(n int, outcomes []PairOutcome, k float64) []float64 {
+	if k <= 0 {
+		k = DefaultEloK
+	}
+	ratings := make([]float64, n)
+	for i := range ratings {
+		ratings[i] = DefaultEloInitial
+	}
+	for _, o := range outcomes {
+		if !validPair(n, o) {
+			continue
+		}
+		expectedA := 1 / (1 + math.Pow(10, (ratings[o.B]-ratings[o.A])/400))
+		delta := k * (o.Score - expectedA)
+		ratings[o.A] += delta
+		ratings[o.B] -= delta
+	}
+	return ratings
+}
+
+// BradleyTerry 使用 MM 算法估计 Bradley-Terry 强度参数, 平局按双方各半胜计入。
+// 为保证无胜场的参赛者也能收敛, 每对已交手的参赛者额外加入 0.5 场虚拟平局作为正则。
+// 返回值归一化为几何平均为 1。
+func BradleyTerry(n int, outcomes []PairOutcome) []float64 {
+	wins := make([][]float64, n)
+	for i := range wins {
+		wins[i] = make([]float64, n)
+	}
+	for _, o := range outcomes {
+		if !validPair(n, o) {
+			continue
+		}
+		wins[o.A][o.B] += o.Score
+		wins[o.B][o.A] += 1 - o.Score
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if wins[i][j]+wins[j][i] > 0 {
+				wins[i][j] += 0.25
+				wins[j][i] += 0.25
+			}
+		}
+	}
+
+	strength := make([]float64, n)
+	for i := range strength {
+		strength[i] = 1
+	}
+	for iter := 0; iter < bradleyTerryMaxIter; iter++ {
+		next := make([]float64, n)
+		for i := 0; i < n; i++ {
+			var w, denom float64
+			for j := 0; j < n; j++ {
+				if i == j {
+					continue
+				}
+				nij := wins[i][j] + wins[j][i]
+				if nij == 0 {
+					continue
+				}
+				w += wins[i][j]
+				denom += nij / (strength[i] + strength[j])
+			}
+			if denom == 0 {
+				next[i] = strength[i]
+				continue
+			}
+			next[i] = w / denom
+		}
+		normalizeGeoMean(next)
+		var maxDiff float64
+		for i := range next {
+			maxDiff = math.Max(maxDiff, math.Abs(next[i]-strength[i]))
+		}
+		strength = next
+		if maxDiff < bradleyTerryTolerance {
+			break
+		}
+	}
+	return strength
+}
+
+func validPair(n int, o PairOutcome) bool {
+	return o.A >= 0 && o.A < n && o.B >= 0 && o.B < n && o.A != o.B
+}
+
+func normalizeGeoMean(vals []float64) {
+	var logSum float64
+	var cnt int
+	for _, v := range vals {
+		if v > 0 {
+			logSum += math.Log(v)
+			cnt++
+		}
+	}
+	if cnt == 0 {
+		return
+	}
+	geoMean := math.Exp(logSum / float64(cnt))
+	for i := range vals {
+		vals[i] /= geoMean
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestElo(t *testing.T) {
+	t.Run("single win from equal ratings", func(t *testing.T) {
+		ratings := Elo(2, []PairOutcome{{A: 0, B: 1, Score: 1}}, 0)
+		assert.InDelta(t, 1016, ratings[0], 1e-9)
+		assert.InDelta(t, 984, ratings[1], 1e-9)
+	})
+	t.Run("tie keeps equal ratings", func(t *testing.T) {
+		ratings := Elo(2, []PairOutcome{{A: 0, B: 1, Score: 0.5}}, 16)
+		assert.InDelta(t, 1000, ratings[0], 1e-9)
+		assert.InDelta(t, 1000, ratings[1], 1e-9)
+	})
+	t.Run("invalid pair ignored", func(t *testing.T) {
+		ratings := Elo(2, []PairOutcome{{A: 0, B: 0, Score: 1}, {A: 0, B: 5, Score: 1}}, 0)
+		assert.Equal(t, []float64{1000, 1000}, ratings)
+	})
+}
+
+func TestBradleyTerry(t *testing.T) {
+	outcomes := make([]PairOutcome, 0)
+	// 0 稳定强于 1, 1 稳定强于 2
+	for i := 0; i < 8; i++ {
+		outcomes = append(outcomes, PairOutcome{A: 0, B: 1, Score: 1}, PairOutcome{A: 1, B: 2, Score: 1}, PairOutcome{A: 0, B: 2, Score: 1})
+	}
+	outcomes = append(outcomes, PairOutcome{A: 0, B: 1, Score: 0}, PairOutcome{A: 1, B: 2, Score: 0.5})
+
+	strength := BradleyTerry(3, outcomes)
+	assert.True(t, strength[0] > strength[1])
+	assert.True(t, strength[1] > strength[2])
+	assert.InDelta(t, 1, strength[0]*strength[1]*strength[2], 1e-6)
+
+	t.Run("no games", func(t *testing.T) {
+		assert.Equal(t, []float64{1, 1}, BradleyTerry(2, nil))
+	})
+}
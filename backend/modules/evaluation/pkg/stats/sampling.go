@@ -0,0 +1,60 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// Stratum 分层抽样中的一层: Population 为层内总体数, Values 为层内样本观测值
+type Stratum struct {
+	Population int64
+	Values     []float64
+}
+
+// StratifiedMean 分层抽样下的总体均值估计 Σ W_h·ȳ_h (W_h = N_h / N) 及其标准误,
+// 方差估计 Σ W_h²·(1 - n_h/N_h)·s_h²/n_h 含有限总体校正; 单层即简单随机抽样。
+// 无样本的层不参与估计, 其总体数也不计入 N; 全部层无样本时返回 (0, 0)。
+func StratifiedMean(strata []Stratum) (mean, stdErr float64) {
+	var population int64
+	for _, s := range strata {
+		if len(s.Values) > 0 {
+			population += s.Population
+		}
+	}
+	if population <= 0 {
+		return 0, 0
+	}
+
+	var variance float64
+	for _, s := range strata {
+		n := len(s.Values)
+		if n == 0 {
+			continue
+		}
+		w := float64(s.Population) / float64(population)
+		mean += w * Mean(s.Values)
+		if n < 2 {
+			continue
+		}
+		fpc := 1 - float64(n)/float64(s.Population)
+		if fpc < 0 {
+			fpc = 0
+		}
+		sd := StdDev(s.Values)
+		variance += w * w * fpc * sd * sd / float64(n)
+	}
+	return mean, math.Sqrt(variance)
+}
+
+// NormalCI 正态近似下估计值 mean 在置信水平 confidence 下的双侧置信区间
+func NormalCI(mean, stdErr, confidence float64) (lower, upper float64) {
+	if stdErr <= 0 {
+		return mean, mean
+	}
+	z := distuv.UnitNormal.Quantile(1 - (1-confidence)/2)
+	return mean - z*stdErr, mean + z*stdErr
+}
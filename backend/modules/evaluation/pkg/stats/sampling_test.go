@@ -0,0 +1,65 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStratifiedMean(t *testing.T) {
+	tests := []struct {
+		name       string
+		strata     []Stratum
+		wantMean   float64
+		wantStdErr float64
+	}{
+		{name: "empty", strata: nil, wantMean: 0, wantStdErr: 0},
+		{name: "census has no sampling error", strata: []Stratum{{Population: 3, Values: []float64{1, 2, 3}}}, wantMean: 2, wantStdErr: 0},
+		{
+			// s² = 1, n = 3, N = 30: sqrt(0.9 * 1 / 3)
+			name:       "simple random sampling",
+			strata:     []Stratum{{Population: 30, Values: []float64{1, 2, 3}}},
+			wantMean:   2,
+			wantStdErr: 0.5477,
+		},
+		{
+			// W = 0.75 / 0.25, 0.75*1 + 0.25*0 = 0.75
+			name: "weighted by stratum population",
+			strata: []Stratum{
+				{Population: 300, Values: []float64{1, 1}},
+				{Population: 100, Values: []float64{0, 0}},
+			},
+			wantMean:   0.75,
+			wantStdErr: 0,
+		},
+		{
+			name: "stratum without samples is skipped",
+			strata: []Stratum{
+				{Population: 10, Values: []float64{0.5}},
+				{Population: 90},
+			},
+			wantMean:   0.5,
+			wantStdErr: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mean, stdErr := StratifiedMean(tt.strata)
+			assert.InDelta(t, tt.wantMean, mean, 1e-4)
+			assert.InDelta(t, tt.wantStdErr, stdErr, 1e-4)
+		})
+	}
+}
+
+func TestNormalCI(t *testing.T) {
+	lower, upper := NormalCI(0.5, 0.1, 0.95)
+	assert.InDelta(t, 0.304, lower, 1e-3)
+	assert.InDelta(t, 0.696, upper, 1e-3)
+
+	lower, upper = NormalCI(0.5, 0, 0.95)
+	assert.Equal(t, 0.5, lower)
+	assert.Equal(t, 0.5, upper)
+}
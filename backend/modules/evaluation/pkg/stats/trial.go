@@ -0,0 +1,34 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+// PassAtK n 次试验中 c 次通过时, 随机取 k 次至少一次通过的概率的无偏估计 1 - C(n-c, k) / C(n, k)。
+// k 超出 [1, n] 或 c 超出 [0, n] 时返回 0。
+func PassAtK(n, c, k int) float64 {
+	if k <= 0 || k > n || c < 0 || c > n {
+		return 0
+	}
+	if n-c < k {
+		return 1
+	}
+	// C(n-c, k) / C(n, k) = Π_{i=n-c+1}^{n} (1 - k/i), 避免大数组合
+	ratio := 1.0
+	for i := n - c + 1; i <= n; i++ {
+		ratio *= 1 - float64(k)/float64(i)
+	}
+	return 1 - ratio
+}
+
+// PassPowK n 次试验中 c 次通过时, 随机取 k 次全部通过的概率的无偏估计 C(c, k) / C(n, k)。
+// k 超出 [1, n] 或 c 超出 [0, n] 时返回 0。
+func PassPowK(n, c, k int) float64 {
+	if k <= 0 || k > n || c < 0 || c > n || c < k {
+		return 0
+	}
+	ratio := 1.0
+	for i := 0; i < k; i++ {
+		ratio *= float64(c-i) / float64(n-i)
+	}
+	return ratio
+}
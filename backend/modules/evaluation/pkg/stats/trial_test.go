@@ -0,0 +1,52 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPassAtK(t *testing.T) {
+	tests := []struct {
+		name    string
+		n, c, k int
+		want    float64
+	}{
+		{name: "invalid k", n: 3, c: 1, k: 0, want: 0},
+		{name: "k exceeds n", n: 3, c: 1, k: 4, want: 0},
+		{name: "no pass", n: 5, c: 0, k: 3, want: 0},
+		{name: "all pass", n: 5, c: 5, k: 1, want: 1},
+		{name: "pass@1 equals pass rate", n: 4, c: 1, k: 1, want: 0.25},
+		// 1 - C(2,2)/C(4,2) = 1 - 1/6
+		{name: "pass@2", n: 4, c: 2, k: 2, want: 0.8333},
+		{name: "k equals n with one pass", n: 3, c: 1, k: 3, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, PassAtK(tt.n, tt.c, tt.k), 1e-4)
+		})
+	}
+}
+
+func TestPassPowK(t *testing.T) {
+	tests := []struct {
+		name    string
+		n, c, k int
+		want    float64
+	}{
+		{name: "invalid k", n: 3, c: 3, k: 0, want: 0},
+		{name: "fewer passes than k", n: 4, c: 1, k: 2, want: 0},
+		{name: "all pass", n: 3, c: 3, k: 3, want: 1},
+		{name: "pass^1 equals pass rate", n: 4, c: 3, k: 1, want: 0.75},
+		// C(3,2)/C(4,2) = 3/6
+		{name: "pass^2", n: 4, c: 3, k: 2, want: 0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, PassPowK(tt.n, tt.c, tt.k), 1e-4)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package textsim
+
+import (
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"strings"
+)
+
+const (
+	DefaultShingleSize  = 3
+	DefaultMinHashNum   = 128
+	DefaultMinHashBands = 32
+)
+
+// NormalizeText 精确去重使用的规范化文本: 分词后以单空格拼接, 忽略大小写、标点与空白差异
+func NormalizeText(s string) string {
+	return strings.Join(Tokenize(s), " ")
+}
+
+// Hash64 64 位 FNV-1a 哈希
+func Hash64(s string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	return h.Sum64()
+}
+
+// Shingles 按连续 k 个 token 切片并去重; token 数不足 k 时整体作为一个片段
+func Shingles(tokens []string, k int) []string {
+	if k <= 0 {
+		k = DefaultShingleSize
+	}
+	if len(tokens) == 0 {
+		return nil
+	}
+	if len(tokens) <= k {
+		return []string{strings.Join(tokens, "\x00")}
+	}
+	seen := make(map[string]struct{}, len(tokens)-k+1)
+	res := make([]string, 0, len(tokens)-k+1)
+	for i := 0; i+k <= len(tokens); i++ {
+		s := strings.Join(tokens[i:i+k], "\x00")
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		res = append(res, s)
+	}
+	return res
+}
+
+// MinHash 计算片段集合的 MinHash 签名, 第 i 个哈希函数为基础哈希与第 i 个种子混合; 空集合返回 nil
+func MinHash(shingles []string, numHashes int) []uint64 {
+	if numHashes <= 0 {
+		numHashes = DefaultMinHashNum
+	}
+	if len(shingles) == 0 {
+		return nil
+	}
+	sig := make([]uint64, numHashes)
+	for i := range sig {
+		sig[i] = math.MaxUint64
+	}
+	for _, s := range shingles {
+		base := Hash64(s)
+		for i := range sig {
+			if h := splitMix64(base ^ splitMix64(uint64(i)+1)); h < sig[i] {
+				sig[i] = h
+			}
+		}
+	}
+	return sig
+}
+
+// MinHashSimilarity 两个签名相同位置取值相等的比例, 为 Jaccard 相似度的估计
+func MinHashSimilarity(a, b []uint64) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var eq int
+	for i := range a {
+		if a[i] == b[i] {
+			eq++
+		}
+	}
+	return float64(eq) / float64(len(a))
+}
+
+// MinHashBands LSH 分桶: 签名切成 bands 段, 每段哈希为一个桶键 (含段序号), 任一桶键相同即为候选对
+func MinHashBands(sig []uint64, bands int) []uint64 {
+	if len(sig) == 0 {
+		return nil
+	}
+	if bands <= 0 || bands > len(sig) {
+		bands = len(sig)
+	}
+	rows := len(sig) / bands
+	keys := make([]uint64, 0, bands)
+	for b := 0; b < bands; b++ {
+		h := splitMix64(uint64(b) + 1)
+		for _, v := range sig[b*rows : (b+1)*rows] {
+			h = splitMix64(h ^ v)
+		}
+		keys = append(keys, h)
+	}
+	return keys
+}
+
+// SimHash 按 token 词频加权计算 64 位 SimHash
+func SimHash(tokens []string) uint64 {
+	if len(tokens) == 0 {
+		return 0
+	}
+	var weights [64]int
+	for _, t := range tokens {
+		h := Hash64(t)
+		for i := 0; i < 64; i++ {
+			if h&(1<<uint(i)) != 0 {
+				weights[i]++
+			} else {
+				weights[i]--
+			}
+		}
+	}
+	var res uint64
+	for i, w := range weights {
+		if w > 0 {
+			res |= 1 << uint(i)
+		}
+	}
+	return res
+}
+
+// SimHashSimilarity 1 - 汉明距离 / 64
+func SimHashSimilarity(a, b uint64) float64 {
+	return 1 - float64(bits.OnesCount64(a^b))/64
+}
+
+// SimHashBands 把 64 位指纹均分为 bands 段作为桶键 (含段序号)。
+// 由抽屉原理, 汉明距离小于 bands 的两个指纹至少有一段完全相同。
+func SimHashBands(h uint64, bands int) []uint64 {
+	if bands <= 0 {
+		bands = 1
+	}
+	if bands > 64 {
+		bands = 64
+	}
+	keys := make([]uint64, 0, bands)
+	start := 0
+	for b := 0; b < bands; b++ {
+		width := (64 - start) / (bands - b)
+		seg := (h >> uint(start)) & (1<<uint(width) - 1)
+		keys = append(keys, splitMix64(uint64(b)<<58^seg))
+		start += width
+	}
+	return keys
+}
+
+func splitMix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package textsim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeText(t *testing.T) {
+	assert.Equal(t, "hello world", NormalizeText("  Hello,   WORLD! "))
+	assert.Equal(t, NormalizeText("我爱Go"), NormalizeText("我 爱 go。"))
+	assert.Equal(t, Hash64("a"), Hash64("a"))
+	assert.NotEqual(t, Hash64("a"), Hash64("b"))
+}
+
+func TestShingles(t *testing.T) {
+	assert.Nil(t, Shingles(nil, 3))
+	assert.Len(t, Shingles([]string{"a", "b"}, 3), 1)
+	assert.Len(t, Shingles([]string{"a", "b", "c", "d"}, 3), 2)
+	// 重复片段只保留一次
+	assert.Len(t, Shingles([]string{"a", "b", "a", "b", "a"}, 2), 2)
+}
+
+func TestMinHash(t *testing.T) {
+	sigOf := func(s string) []uint64 { return MinHash(Shingles(Tokenize(s), 2), 0) }
+	base := sigOf("the quick brown fox jumps over the lazy dog near the river bank")
+	near := sigOf("the quick brown fox jumps over the lazy dog near the river side")
+	far := sigOf("completely different sentence about evaluation datasets and metrics")
+
+	assert.Len(t, base, DefaultMinHashNum)
+	assert.Equal(t, 1.0, MinHashSimilarity(base, base))
+	assert.Greater(t, MinHashSimilarity(base, near), 0.6)
+	assert.Less(t, MinHashSimilarity(base, far), 0.2)
+	assert.Equal(t, 0.0, MinHashSimilarity(base, nil))
+	assert.Nil(t, MinHash(nil, 0))
+
+	bandsA, bandsB := MinHashBands(base, DefaultMinHashBands), MinHashBands(near, DefaultMinHashBands)
+	assert.Len(t, bandsA, DefaultMinHashBands)
+	shared := false
+	for i := range bandsA {
+		if bandsA[i] == bandsB[i] {
+			shared = true
+		}
+	}
+	assert.True(t, shared)
+}
+
+func TestSimHash(t *testing.T) {
+	a := SimHash(Tokenize("the quick brown fox jumps over the lazy dog again and again"))
+	b := SimHash(Tokenize("the quick brown fox jumps over the lazy dog again and again today"))
+	assert.Equal(t, 1.0, SimHashSimilarity(a, a))
+	assert.Greater(t, SimHashSimilarity(a, b), 0.8)
+	assert.Equal(t, 0.0, SimHashSimilarity(0, ^uint64(0)))
+
+	// 汉明距离 < bands 时至少一段相同
+	flipped := a ^ 0b101
+	ka, kb := SimHashBands(a, 4), SimHashBands(flipped, 4)
+	assert.Len(t, ka, 4)
+	same := 0
+	for i := range ka {
+		if ka[i] == kb[i] {
+			same++
+		}
+	}
+	assert.Equal(t, 3, same)
+	// 不同段位置的相同取值不应落入同一个桶
+	assert.NotEqual(t, SimHashBands(0, 2)[0], SimHashBands(0, 2)[1])
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package textsim 提供规则评估器使用的文本相似度指标 (编辑距离、ROUGE-L、BLEU), 以及评测集去重使用的 MinHash / SimHash。
+package textsim
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+const DefaultBLEUMaxN = 4
+
+// Tokenize 分词: 拉丁字母与数字按连续片段切分并转小写, 中日韩字符逐字切分, 标点与空白作为分隔符
+func Tokenize(s string) []string {
+	tokens := make([]string, 0)
+	var sb strings.Builder
+	flush := func() {
+		if sb.Len() > 0 {
+			tokens = append(tokens, sb.String())
+			sb.Reset()
+		}
+	}
+	for _, r := range s {
+		switch {
+		case isCJK(r):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			sb.WriteRune(unicode.ToLower(r))
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// Levenshtein 按 rune 计算编辑距离
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 {
+		return len(rb)
+	}
+	if len(rb) == 0 {
+		return len(ra)
+	}
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+// LevenshteinSimilarity 归一化编辑相似度 1 - dist / max(len(a), len(b)), 两者都为空时为 1
+func LevenshteinSimilarity(a, b string) float64 {
+	maxLen := max(len([]rune(a)), len([]rune(b)))
+	if maxLen == 0 {
+		return 1
+	}
+	return 1 - float64(Levenshtein(a, b))/float64(maxLen)
+}
+
+// RougeL 基于最长公共子序列的 ROUGE-L F1
+func RougeL(candidate, reference []string) float64 {
+	if len(candidate) == 0 || len(reference) == 0 {
+		if len(candidate) == 0 && len(reference) == 0 {
+			return 1
+		}
+		return 0
+	}
+	lcs := lcsLength(candidate, reference)
+	if lcs == 0 {
+		return 0
+	}
+	precision := float64(lcs) / float64(len(candidate))
+	recall := float64(lcs) / float64(len(reference))
+	return 2 * precision * recall / (precision + recall)
+}
+
+func lcsLength(a, b []string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				cur[j] = prev[j-1] + 1
+			} else {
+				cur[j] = max(prev[j], cur[j-1])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// BLEU 句子级 BLEU, 使用 1..maxN 阶 n-gram 等权几何平均并乘以长度惩罚。
+// 高阶 n-gram 采用加一平滑, 避免短句因无匹配直接得 0。
+func BLEU(candidate, reference []string, maxN int) float64 {
+	if maxN <= 0 {
+		maxN = DefaultBLEUMaxN
+	}
+	if len(candidate) == 0 || len(reference) == 0 {
+		return 0
+	}
+	var logSum float64
+	for n := 1; n <= maxN; n++ {
+		candNgrams := ngramCounts(candidate, n)
+		refNgrams := ngramCounts(reference, n)
+		var total, matched int
+		for gram, cnt := range candNgrams {
+			total += cnt
+			matched += min(cnt, refNgrams[gram])
+		}
+		var precision float64
+		if n == 1 {
+			if matched == 0 {
+				return 0
+			}
+			precision = float64(matched) / float64(total)
+		} else {
+			precision = float64(matched+1) / float64(total+1)
+		}
+		logSum += math.Log(precision)
+	}
+	bp := 1.0
+	if len(candidate) < len(reference) {
+		bp = math.Exp(1 - float64(len(reference))/float64(len(candidate)))
+	}
+	return bp * math.Exp(logSum/float64(maxN))
+}
+
+func ngramCounts(tokens []string, n int) map[string]int {
+	res := make(map[string]int)
+	for i := 0; i+n <= len(tokens); i++ {
+		res[strings.Join(tokens[i:i+n], "\x00")]++
+	}
+	return res
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package textsim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	assert.Equal(t, []string{"hello", "world", "42"}, Tokenize("Hello, world! 42"))
+	assert.Equal(t, []string{"我", "爱", "go"}, Tokenize("我爱Go"))
+	assert.Empty(t, Tokenize(" ,. "))
+}
+
+func TestLevenshtein(t *testing.T) {
+	assert.Equal(t, 3, Levenshtein("kitten", "sitting"))
+	assert.Equal(t, 0, Levenshtein("", ""))
+	assert.Equal(t, 2, Levenshtein("你好", ""))
+	assert.Equal(t, 1, Levenshtein("你好", "您好"))
+	assert.InDelta(t, 1-3.0/7, LevenshteinSimilarity("kitten", "sitting"), 1e-9)
+	assert.Equal(t, 1.0, LevenshteinSimilarity("", ""))
+}
+
+func TestRougeL(t *testing.T) {
+	cand := Tokenize("the cat sat on the mat")
+	ref := Tokenize("the cat is on the mat")
+	// LCS = the cat on the mat = 5
+	assert.InDelta(t, 5.0/6, RougeL(cand, ref), 1e-9)
+	assert.Equal(t, 0.0, RougeL(Tokenize("a"), Tokenize("b")))
+	assert.Equal(t, 1.0, RougeL(nil, nil))
+}
+
+func TestBLEU(t *testing.T) {
+	tokens := Tokenize("the quick brown fox jumps over the lazy dog")
+	assert.InDelta(t, 1, BLEU(tokens, tokens, 0), 1e-9)
+	assert.Equal(t, 0.0, BLEU(Tokenize("x y z"), tokens, 4))
+
+	partial := BLEU(Tokenize("the quick brown fox"), tokens, 4)
+	assert.True(t, partial > 0 && partial < 1)
+	// 长度惩罚: 更短的候选得分更低
+	assert.True(t, BLEU(Tokenize("the quick"), tokens, 2) < BLEU(Tokenize("the quick brown fox jumps"), tokens, 2))
+}
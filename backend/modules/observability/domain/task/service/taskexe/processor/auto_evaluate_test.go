@@ -367,6 +367,38 @@ func (f *fakeExperimentClient) MGetExperimentStandardEvalOutputs(ctx context.Con
 	return nil, nil
 }
 
+func (f *fakeExperimentClient) CompareExperiments(ctx context.Context, req *expt.CompareExperimentsRequest, callOptions ...callopt.Option) (*expt.CompareExperimentsResponse, error) {
+	return nil, nil
+}
+
+func (f *fakeExperimentClient) GetExptPairwiseRank(ctx context.Context, req *expt.GetExptPairwiseRankRequest, callOptions ...callopt.Option) (*expt.GetExptPairwiseRankResponse, error) {
+	return nil, nil
+}
+
+func (f *fakeExperimentClient) SubmitExptPairwiseRank(ctx context.Context, req *expt.SubmitExptPairwiseRankRequest, callOptions ...callopt.Option) (*expt.SubmitExptPairwiseRankResponse, error) {
+	return nil, nil
+}
+
+func (f *fakeExperimentClient) ExportExptRegressionReport(ctx context.Context, req *expt.ExportExptRegressionReportRequest, callOptions ...callopt.Option) (*expt.ExportExptRegressionReportResponse, error) {
+	return nil, nil
+}
+
+func (f *fakeExperimentClient) GetExptRegressionReport(ctx context.Context, req *expt.GetExptRegressionReportRequest, callOptions ...callopt.Option) (*expt.GetExptRegressionReportResponse, error) {
+	return nil, nil
+}
+
+func (f *fakeExperimentClient) InvalidateExptResultCache(ctx context.Context, req *expt.InvalidateExptResultCacheRequest, callOptions ...callopt.Option) (*expt.InvalidateExptResultCacheResponse, error) {
+	return nil, nil
+}
+
+func (f *fakeExperimentClient) GetExptSampledAggrResult_(ctx context.Context, req *expt.GetExptSampledAggrResultRequest, callOptions ...callopt.Option) (*expt.GetExptSampledAggrResultResponse, error) {
+	return nil, nil
+}
+
+func (f *fakeExperimentClient) GetExptGroupedAggrResult_(ctx context.Context, req *expt.GetExptGroupedAggrResultRequest, callOptions ...callopt.Option) (*expt.GetExptGroupedAggrResultResponse, error) {
+	return nil, nil
+}
+
 // 使用真实 EvaluationProvider 注入 Processor，验证三种路径：BizStatus、非 BizStatus 包装、成功返回条数
 func TestAutoEvaluateProcessor_Invoke_WithEvaluationProvider_BizStatusPassthrough(t *testing.T) {
 	t.Parallel()
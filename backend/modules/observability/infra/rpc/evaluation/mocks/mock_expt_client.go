@@ -242,6 +242,26 @@ func (mr *MockClientMockRecorder) CloneExperiment(ctx, req any, callOptions ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneExperiment", reflect.TypeOf((*MockClient)(nil).CloneExperiment), varargs...)
 }
 
+// CompareExperiments mocks base method.
+func (m *MockClient) CompareExperiments(ctx context.Context, req *expt.CompareExperimentsRequest, callOptions ...callopt.Option) (*expt.CompareExperimentsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, req}
+	for _, a := range callOptions {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CompareExperiments", varargs...)
+	ret0, _ := ret[0].(*expt.CompareExperimentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareExperiments indicates an expected call of CompareExperiments.
+func (mr *MockClientMockRecorder) CompareExperiments(ctx, req any, callOptions ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, req}, callOptions...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareExperiments", reflect.TypeOf((*MockClient)(nil).CompareExperiments), varargs...)
+}
+
 // CreateAnnotateRecord mocks base method.
 func (m *MockClient) CreateAnnotateRecord(ctx context.Context, req *expt.CreateAnnotateRecordReq, callOptions ...callopt.Option) (*expt.CreateAnnotateRecordResp, error) {
 	m.ctrl.T.Helper()
@@ -382,6 +402,26 @@ func (mr *MockClientMockRecorder) DeleteExptInsightAnalysisRecord(ctx, req any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExptInsightAnalysisRecord", reflect.TypeOf((*MockClient)(nil).DeleteExptInsightAnalysisRecord), varargs...)
 }
 
+// ExportExptRegressionReport mocks base method.
+func (m *MockClient) ExportExptRegressionReport(ctx context.Context, req *expt.ExportExptRegressionReportRequest, callOptions ...callopt.Option) (*expt.ExportExptRegressionReportResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, req}
+	for _, a := range callOptions {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportExptRegressionReport", varargs...)
+	ret0, _ := ret[0].(*expt.ExportExptRegressionReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportExptRegressionReport indicates an expected call of ExportExptRegressionReport.
+func (mr *MockClientMockRecorder) ExportExptRegressionReport(ctx, req any, callOptions ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, req}, callOptions...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportExptRegressionReport", reflect.TypeOf((*MockClient)(nil).ExportExptRegressionReport), varargs...)
+}
+
 // ExportExptResult_ mocks base method.
 func (m *MockClient) ExportExptResult_(ctx context.Context, req *expt.ExportExptResultRequest, callOptions ...callopt.Option) (*expt.ExportExptResultResponse, error) {
 	m.ctrl.T.Helper()
@@ -462,6 +502,26 @@ func (mr *MockClientMockRecorder) GetAnalysisRecordFeedbackVote(ctx, req any, ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalysisRecordFeedbackVote", reflect.TypeOf((*MockClient)(nil).GetAnalysisRecordFeedbackVote), varargs...)
 }
 
+// GetExptGroupedAggrResult_ mocks base method.
+func (m *MockClient) GetExptGroupedAggrResult_(ctx context.Context, req *expt.GetExptGroupedAggrResultRequest, callOptions ...callopt.Option) (*expt.GetExptGroupedAggrResultResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, req}
+	for _, a := range callOptions {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetExptGroupedAggrResult_", varargs...)
+	ret0, _ := ret[0].(*expt.GetExptGroupedAggrResultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExptGroupedAggrResult_ indicates an expected call of GetExptGroupedAggrResult_.
+func (mr *MockClientMockRecorder) GetExptGroupedAggrResult_(ctx, req any, callOptions ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, req}, callOptions...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExptGroupedAggrResult_", reflect.TypeOf((*MockClient)(nil).GetExptGroupedAggrResult_), varargs...)
+}
+
 // GetExptInsightAnalysisRecord mocks base method.
 func (m *MockClient) GetExptInsightAnalysisRecord(ctx context.Context, req *expt.GetExptInsightAnalysisRecordRequest, callOptions ...callopt.Option) (*expt.GetExptInsightAnalysisRecordResponse, error) {
 	m.ctrl.T.Helper()
@@ -482,6 +542,46 @@ func (mr *MockClientMockRecorder) GetExptInsightAnalysisRecord(ctx, req any, cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExptInsightAnalysisRecord", reflect.TypeOf((*MockClient)(nil).GetExptInsightAnalysisRecord), varargs...)
 }
 
+// GetExptPairwiseRank mocks base method.
+func (m *MockClient) GetExptPairwiseRank(ctx context.Context, req *expt.GetExptPairwiseRankRequest, callOptions ...callopt.Option) (*expt.GetExptPairwiseRankResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, req}
+	for _, a := range callOptions {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetExptPairwiseRank", varargs...)
+	ret0, _ := ret[0].(*expt.GetExptPairwiseRankResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExptPairwiseRank indicates an expected call of GetExptPairwiseRank.
+func (mr *MockClientMockRecorder) GetExptPairwiseRank(ctx, req any, callOptions ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, req}, callOptions...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExptPairwiseRank", reflect.TypeOf((*MockClient)(nil).GetExptPairwiseRank), varargs...)
+}
+
+// GetExptRegressionReport mocks base method.
+func (m *MockClient) GetExptRegressionReport(ctx context.Context, req *expt.GetExptRegressionReportRequest, callOptions ...callopt.Option) (*expt.GetExptRegressionReportResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, req}
+	for _, a := range callOptions {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetExptRegressionReport", varargs...)
+	ret0, _ := ret[0].(*expt.GetExptRegressionReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExptRegressionReport indicates an expected call of GetExptRegressionReport.
+func (mr *MockClientMockRecorder) GetExptRegressionReport(ctx, req any, callOptions ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, req}, callOptions...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExptRegressionReport", reflect.TypeOf((*MockClient)(nil).GetExptRegressionReport), varargs...)
+}
+
 // GetExptResultExportRecord mocks base method.
 func (m *MockClient) GetExptResultExportRecord(ctx context.Context, req *expt.GetExptResultExportRecordRequest, callOptions ...callopt.Option) (*expt.GetExptResultExportRecordResponse, error) {
 	m.ctrl.T.Helper()
@@ -502,6 +602,26 @@ func (mr *MockClientMockRecorder) GetExptResultExportRecord(ctx, req any, callOp
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExptResultExportRecord", reflect.TypeOf((*MockClient)(nil).GetExptResultExportRecord), varargs...)
 }
 
+// GetExptSampledAggrResult_ mocks base method.
+func (m *MockClient) GetExptSampledAggrResult_(ctx context.Context, req *expt.GetExptSampledAggrResultRequest, callOptions ...callopt.Option) (*expt.GetExptSampledAggrResultResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, req}
+	for _, a := range callOptions {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetExptSampledAggrResult_", varargs...)
+	ret0, _ := ret[0].(*expt.GetExptSampledAggrResultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExptSampledAggrResult_ indicates an expected call of GetExptSampledAggrResult_.
+func (mr *MockClientMockRecorder) GetExptSampledAggrResult_(ctx, req any, callOptions ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, req}, callOptions...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExptSampledAggrResult_", reflect.TypeOf((*MockClient)(nil).GetExptSampledAggrResult_), varargs...)
+}
+
 // InsightAnalysisExperiment mocks base method.
 func (m *MockClient) InsightAnalysisExperiment(ctx context.Context, req *expt.InsightAnalysisExperimentRequest, callOptions ...callopt.Option) (*expt.InsightAnalysisExperimentResponse, error) {
 	m.ctrl.T.Helper()
@@ -522,6 +642,26 @@ func (mr *MockClientMockRecorder) InsightAnalysisExperiment(ctx, req any, callOp
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsightAnalysisExperiment", reflect.TypeOf((*MockClient)(nil).InsightAnalysisExperiment), varargs...)
 }
 
+// InvalidateExptResultCache mocks base method.
+func (m *MockClient) InvalidateExptResultCache(ctx context.Context, req *expt.InvalidateExptResultCacheRequest, callOptions ...callopt.Option) (*expt.InvalidateExptResultCacheResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, req}
+	for _, a := range callOptions {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InvalidateExptResultCache", varargs...)
+	ret0, _ := ret[0].(*expt.InvalidateExptResultCacheResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvalidateExptResultCache indicates an expected call of InvalidateExptResultCache.
+func (mr *MockClientMockRecorder) InvalidateExptResultCache(ctx, req any, callOptions ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, req}, callOptions...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateExptResultCache", reflect.TypeOf((*MockClient)(nil).InvalidateExptResultCache), varargs...)
+}
+
 // InvokeExperiment mocks base method.
 func (m *MockClient) InvokeExperiment(ctx context.Context, req *expt.InvokeExperimentRequest, callOptions ...callopt.Option) (*expt.InvokeExperimentResponse, error) {
 	m.ctrl.T.Helper()
@@ -762,6 +902,26 @@ func (mr *MockClientMockRecorder) SubmitExptFromTemplate(ctx, req any, callOptio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitExptFromTemplate", reflect.TypeOf((*MockClient)(nil).SubmitExptFromTemplate), varargs...)
 }
 
+// SubmitExptPairwiseRank mocks base method.
+func (m *MockClient) SubmitExptPairwiseRank(ctx context.Context, req *expt.SubmitExptPairwiseRankRequest, callOptions ...callopt.Option) (*expt.SubmitExptPairwiseRankResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, req}
+	for _, a := range callOptions {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubmitExptPairwiseRank", varargs...)
+	ret0, _ := ret[0].(*expt.SubmitExptPairwiseRankResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitExptPairwiseRank indicates an expected call of SubmitExptPairwiseRank.
+func (mr *MockClientMockRecorder) SubmitExptPairwiseRank(ctx, req any, callOptions ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, req}, callOptions...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitExptPairwiseRank", reflect.TypeOf((*MockClient)(nil).SubmitExptPairwiseRank), varargs...)
+}
+
 // UpdateAnnotateRecord mocks base method.
 func (m *MockClient) UpdateAnnotateRecord(ctx context.Context, req *expt.UpdateAnnotateRecordReq, callOptions ...callopt.Option) (*expt.UpdateAnnotateRecordResp, error) {
 	m.ctrl.T.Helper()
@@ -409,6 +409,12 @@ func datasetItemsDO2DTO(items []*entity.DatasetItem) []*eval_set_domain.Evaluati
 			WorkspaceID:     &item.WorkspaceID,
 			EvaluationSetID: &item.DatasetID,
 			ItemKey:         item.ItemKey,
+			// 评测集按此登记行来源
+			Provenance: &eval_set_domain.EvaluationSetItemProvenance{
+				SourceType: gptr.Of(eval_set_domain.EvaluationSetItemSourceTypeTraceExport),
+				TraceID:    gptr.Of(item.TraceID),
+				SpanID:     gptr.Of(item.SpanID),
+			},
 		}
 
 		// 转换FieldData到Turns，增加空值检查
@@ -376,6 +376,14 @@ func TestDatasetItemsDO2DTO(t *testing.T) {
 		got := datasetItemsDO2DTO(items)
 		assert.Equal(t, 1, len(got)) // item should still be included but without turns
 	})
+
+	t.Run("items carry trace provenance", func(t *testing.T) {
+		items := []*entity.DatasetItem{{WorkspaceID: 100, DatasetID: 1, TraceID: "trace-1", SpanID: "span-1"}}
+		got := datasetItemsDO2DTO(items)
+		assert.Equal(t, eval_set_domain.EvaluationSetItemSourceTypeTraceExport, got[0].GetProvenance().GetSourceType())
+		assert.Equal(t, "trace-1", got[0].GetProvenance().GetTraceID())
+		assert.Equal(t, "span-1", got[0].GetProvenance().GetSpanID())
+	})
 }
 
 func TestConvertContentDO2DTO(t *testing.T) {
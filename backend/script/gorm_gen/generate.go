@@ -208,6 +208,9 @@ func generateForEvaluationExpt(db *gorm.DB) {
 		"expt_insight_analysis_feedback_vote",
 		"expt_template",
 		"expt_template_evaluator_ref",
+		"evaluation_set_item_provenance",
+		"expt_pairwise_rank_record",
+		"evaluation_set_dedup_job",
 	}
 
 	var models []any
@@ -250,8 +253,10 @@ func generateForEvaluationEvaluator(db *gorm.DB) {
 	evaluatorTagModel := g.GenerateModelAs("evaluator_tag", "EvaluatorTag")
 	evaluatorTemplateModel := g.GenerateModelAs("evaluator_template", "EvaluatorTemplate")
 	evaluatorRecordModel := g.GenerateModelAs("evaluator_record", "EvaluatorRecord")
+	evaluatorCalibrationRecordModel := g.GenerateModelAs("evaluator_calibration_record", "EvaluatorCalibrationRecord")
+	evaluatorPromotionPolicyModel := g.GenerateModelAs("evaluator_promotion_policy", "EvaluatorPromotionPolicy")
 
-	g.ApplyBasic(evaluatorModel, evaluatorVersionModel, evaluatorTagModel, evaluatorTemplateModel, evaluatorRecordModel)
+	g.ApplyBasic(evaluatorModel, evaluatorVersionModel, evaluatorTagModel, evaluatorTemplateModel, evaluatorRecordModel, evaluatorCalibrationRecordModel, evaluatorPromotionPolicyModel)
 	g.Execute()
 }
 
@@ -3,6 +3,7 @@ namespace go coze.loop.evaluation.eval_set
 include "../../../base.thrift"
 include "domain/eval_set.thrift"
 include "domain/common.thrift"
+include "domain/expt.thrift"
 include "../data/domain/dataset.thrift"
 include "../data/domain/dataset_job.thrift"
 include "../data/domain/data_filter.thrift"
@@ -240,6 +241,43 @@ struct ListEvaluationSetVersionsResponse {
     255: base.BaseResp BaseResp
 }
 
+struct DiffEvaluationSetVersionsRequest {
+    1: required i64 workspace_id (api.js_conv="true", go.tag='json:"workspace_id"'),
+    2: required i64 evaluation_set_id (api.path = "evaluation_set_id", api.js_conv="true", go.tag='json:"evaluation_set_id"'),
+    3: optional i64 base_version_id (api.js_conv="true", go.tag='json:"base_version_id"'),     // 为空表示草稿
+    4: optional i64 target_version_id (api.js_conv="true", go.tag='json:"target_version_id"'), // 为空表示草稿
+
+    255: optional base.Base Base
+}
+
+struct DiffEvaluationSetVersionsResponse {
+    1: optional eval_set.EvaluationSetVersionDiff diff,
+
+    255: base.BaseResp BaseResp
+}
+
+struct MergeEvaluationSetVersionRequest {
+    1: required i64 workspace_id (api.js_conv="true", go.tag='json:"workspace_id"'),
+    2: required i64 evaluation_set_id (api.path = "evaluation_set_id", api.js_conv="true", go.tag='json:"evaluation_set_id"'),
+    3: required i64 version_id (api.path = "version_id", api.js_conv="true", go.tag='json:"version_id"'), // 来源版本, 变更合入草稿
+
+    4: optional list<i64> item_ids (vt.max_size = "1000", api.js_conv="true", go.tag='json:"item_ids"'), // 选中合入的变更行, 删除只在此处显式选中时生效
+    5: optional bool merge_all_items, // 合入全部新增与修改的行, 不删除草稿中的行
+    6: optional bool merge_schema,
+
+    255: optional base.Base Base
+}
+
+struct MergeEvaluationSetVersionResponse {
+    1: optional map<i64, i64> added_item_ids (api.js_conv='true', go.tag='json:"added_item_ids"') // key: 来源版本 item_id, value: 草稿中新建的 item_id
+    2: optional list<i64> updated_item_ids (api.js_conv='true', go.tag='json:"updated_item_ids"')
+    3: optional list<i64> removed_item_ids (api.js_conv='true', go.tag='json:"removed_item_ids"')
+    4: optional bool schema_merged
+    5: optional list<dataset.ItemErrorGroup> errors
+
+    255: base.BaseResp BaseResp
+}
+
 struct UpdateEvaluationSetSchemaRequest {
     1: required i64 workspace_id (api.js_conv="true", go.tag='json:"workspace_id"'),
     2: required i64 evaluation_set_id (api.path = "evaluation_set_id", api.js_conv="true", go.tag='json:"evaluation_set_id"'),
@@ -323,6 +361,139 @@ struct BatchDeleteEvaluationSetItemsResponse {
     255: base.BaseResp BaseResp
 }
 
+struct StartEvaluationSetDedupJobRequest {
+    1: required i64 workspace_id (api.js_conv="true", go.tag='json:"workspace_id"'),
+    2: required i64 evaluation_set_id (api.path = "evaluation_set_id", api.js_conv="true", go.tag='json:"evaluation_set_id"'),
+    3: optional i64 version_id (api.js_conv="true", go.tag='json:"version_id"'), // 为空表示草稿
+    4: optional eval_set.EvaluationSetDedupConf conf,
+
+    255: optional base.Base Base
+}
+
+struct StartEvaluationSetDedupJobResponse {
+    1: optional eval_set.EvaluationSetDedupJob job,
+
+    255: base.BaseResp BaseResp
+}
+
+struct GetEvaluationSetDedupJobRequest {
+    1: required i64 workspace_id (api.query = "workspace_id", api.js_conv="true", go.tag='json:"workspace_id"'),
+    2: required i64 evaluation_set_id (api.path = "evaluation_set_id", api.js_conv="true", go.tag='json:"evaluation_set_id"'),
+    3: required i64 job_id (api.path = "job_id", api.js_conv="true", go.tag='json:"job_id"'),
+
+    255: optional base.Base Base
+}
+
+struct GetEvaluationSetDedupJobResponse {
+    1: optional eval_set.EvaluationSetDedupJob job,
+
+    255: base.BaseResp BaseResp
+}
+
+struct ArchiveEvaluationSetDuplicatesRequest {
+    1: required i64 workspace_id (api.js_conv="true", go.tag='json:"workspace_id"'),
+    2: required i64 evaluation_set_id (api.path = "evaluation_set_id", api.js_conv="true", go.tag='json:"evaluation_set_id"'),
+    3: required i64 job_id (api.path = "job_id", api.js_conv="true", go.tag='json:"job_id"'), // 已成功的草稿去重任务
+    4: optional list<i64> item_ids (api.js_conv="true", go.tag='json:"item_ids"'), // 只归档选中的重复行, 为空时归档报告中全部重复行
+
+    255: optional base.Base Base
+}
+
+struct ArchiveEvaluationSetDuplicatesResponse {
+    1: optional list<i64> archived_item_ids (api.js_conv="true", go.tag='json:"archived_item_ids"'),
+
+    255: base.BaseResp BaseResp
+}
+
+struct GenerateEvaluationSetItemsRequest {
+    1: required i64 workspace_id (api.js_conv="true", go.tag='json:"workspace_id"'),
+    2: required i64 evaluation_set_id (api.path = "evaluation_set_id", api.js_conv="true", go.tag='json:"evaluation_set_id"'),
+    3: optional list<i64> seed_item_ids (api.js_conv="true", go.tag='json:"seed_item_ids"'), // 种子行, 为空时取草稿前 20 行
+    4: optional eval_set.EvaluationSetSynthesisConf conf,
+
+    255: optional base.Base Base
+}
+
+struct GenerateEvaluationSetItemsResponse {
+    1: optional list<i64> item_ids (api.js_conv="true", go.tag='json:"item_ids"'), // 写入草稿并标记为待审核的行
+    2: optional i32 generated_cnt, // 模型返回的行数
+    3: optional i32 duplicate_cnt, // 与种子或其他生成行重复而丢弃的行数
+    4: optional i32 invalid_cnt, // 未通过 schema 校验而丢弃的行数
+    5: optional i32 failed_batch_cnt, // 模型调用或解析失败的批次数
+    6: optional list<dataset.ItemErrorGroup> errors,
+
+    255: base.BaseResp BaseResp
+}
+
+struct ListGeneratedEvaluationSetItemsRequest {
+    1: required i64 workspace_id (api.js_conv="true", go.tag='json:"workspace_id"'),
+    2: required i64 evaluation_set_id (api.path = "evaluation_set_id", api.js_conv="true", go.tag='json:"evaluation_set_id"'),
+
+    100: optional i32 page_number,
+    101: optional i32 page_size,    // 分页大小 (0, 200]，默认为 20
+    102: optional string page_token
+
+    255: optional base.Base Base
+}
+
+struct ListGeneratedEvaluationSetItemsResponse {
+    1: optional list<eval_set.GeneratedEvaluationSetItem> items,
+
+    100: optional i64 total (api.js_conv="true", go.tag='json:"total"'),
+    101: optional string next_page_token
+
+    255: base.BaseResp BaseResp
+}
+
+struct ReviewGeneratedEvaluationSetItemsRequest {
+    1: required i64 workspace_id (api.js_conv="true", go.tag='json:"workspace_id"'),
+    2: required i64 evaluation_set_id (api.path = "evaluation_set_id", api.js_conv="true", go.tag='json:"evaluation_set_id"'),
+    3: optional list<i64> approved_item_ids (api.js_conv="true", go.tag='json:"approved_item_ids"'), // 标记为已通过
+    4: optional list<i64> rejected_item_ids (api.js_conv="true", go.tag='json:"rejected_item_ids"'), // 从草稿删除
+
+    255: optional base.Base Base
+}
+
+struct ReviewGeneratedEvaluationSetItemsResponse {
+    255: base.BaseResp BaseResp
+}
+
+// 行在一次实验中的运行情况
+struct EvaluationSetItemUsage {
+    1: optional i64 expt_id (api.js_conv="true", go.tag='json:"expt_id"'),
+    2: optional string expt_name,
+    3: optional expt.ExptStatus expt_status,
+    4: optional i64 eval_set_version_id (api.js_conv="true", go.tag='json:"eval_set_version_id"'),
+    5: optional i64 item_version_id (api.js_conv="true", go.tag='json:"item_version_id"'),
+    6: optional expt.ItemRunState item_status,
+    7: optional i64 start_at (api.js_conv="true", go.tag='json:"start_at"'),
+    8: optional i64 finished_at (api.js_conv="true", go.tag='json:"finished_at"'), // item 到终态的时间
+    9: optional list<EvaluationSetItemTurnScore> turn_scores,
+}
+
+// 单个 turn 的加权得分与各评估器实例得分
+struct EvaluationSetItemTurnScore {
+    1: optional i64 turn_id (api.js_conv="true", go.tag='json:"turn_id"'),
+    2: optional double weighted_score,
+    3: optional map<string, double> evaluator_scores, // key 为评估器实例标识
+}
+
+struct GetEvaluationSetItemLineageRequest {
+    1: required i64 workspace_id (api.query = "workspace_id", api.js_conv="true", go.tag='json:"workspace_id"'),
+    2: required i64 evaluation_set_id (api.path = "evaluation_set_id", api.js_conv="true", go.tag='json:"evaluation_set_id"'),
+    3: required i64 item_id (api.path = "item_id", api.js_conv="true", go.tag='json:"item_id"'),
+
+    255: optional base.Base Base
+}
+
+struct GetEvaluationSetItemLineageResponse {
+    1: optional eval_set.EvaluationSetItemProvenance provenance,
+    2: optional list<EvaluationSetItemUsage> usages, // 使用过该行的实验, 按实验开始时间升序
+    3: optional bool truncated, // 使用过评测集的实验超过 100 个, 仅回溯了最近的实验
+
+    255: base.BaseResp BaseResp
+}
+
 struct ListEvaluationSetItemsRequest {
     1: required i64 workspace_id (api.js_conv="true", go.tag='json:"workspace_id"'),
     2: required i64 evaluation_set_id (api.path = "evaluation_set_id", api.js_conv="true", go.tag='json:"evaluation_set_id"'),
@@ -337,6 +508,7 @@ struct ListEvaluationSetItemsRequest {
     200: optional list<i64> item_id_not_in (api.js_conv="true", go.tag='json:"item_id_not_in"')
     201: optional data_filter.Filter filter // item 过滤条件
     212: optional eval_set.TagFilter tag_filter // 系统资源标签过滤
+    213: optional eval_set.EvaluationSetItemProvenanceFilter provenance_filter // 行来源过滤, 不可与 filter、tag_filter、item_id_not_in、page_token 同时使用
 
     255: optional base.Base Base
 }
@@ -589,6 +761,12 @@ service EvaluationSetService {
     BatchGetEvaluationSetVersionsResponse BatchGetEvaluationSetVersions(1: BatchGetEvaluationSetVersionsRequest req) (
         api.category="evaluation_set", api.post = "/api/evaluation/v1/evaluation_set_versions/batch_get", api.op_type = 'query', api.tag = 'volc-agentkit'
     )
+    DiffEvaluationSetVersionsResponse DiffEvaluationSetVersions(1: DiffEvaluationSetVersionsRequest req) (
+        api.category="evaluation_set", api.post = "/api/evaluation/v1/evaluation_sets/:evaluation_set_id/versions/diff", api.op_type = 'query', api.tag = 'volc-agentkit'
+    )
+    MergeEvaluationSetVersionResponse MergeEvaluationSetVersion(1: MergeEvaluationSetVersionRequest req) (
+        api.category="evaluation_set", api.post = "/api/evaluation/v1/evaluation_sets/:evaluation_set_id/versions/:version_id/merge", api.op_type = 'update', api.tag = 'volc-agentkit'
+    )
 
     // 字段管理
     UpdateEvaluationSetSchemaResponse UpdateEvaluationSetSchema(1: UpdateEvaluationSetSchemaRequest req) (
@@ -605,6 +783,27 @@ service EvaluationSetService {
     BatchDeleteEvaluationSetItemsResponse BatchDeleteEvaluationSetItems(1: BatchDeleteEvaluationSetItemsRequest req) (
         api.category="evaluation_set", api.post = "/api/evaluation/v1/evaluation_sets/:evaluation_set_id/items/batch_delete", api.op_type = 'delete', api.tag = 'volc-agentkit,open'
     )
+    StartEvaluationSetDedupJobResponse StartEvaluationSetDedupJob(1: StartEvaluationSetDedupJobRequest req) (
+        api.category="evaluation_set", api.post = "/api/evaluation/v1/evaluation_sets/:evaluation_set_id/dedup_jobs", api.op_type = 'create', api.tag = 'volc-agentkit'
+    )
+    GetEvaluationSetDedupJobResponse GetEvaluationSetDedupJob(1: GetEvaluationSetDedupJobRequest req) (
+        api.category="evaluation_set", api.get = "/api/evaluation/v1/evaluation_sets/:evaluation_set_id/dedup_jobs/:job_id", api.op_type = 'query', api.tag = 'volc-agentkit'
+    )
+    ArchiveEvaluationSetDuplicatesResponse ArchiveEvaluationSetDuplicates(1: ArchiveEvaluationSetDuplicatesRequest req) (
+        api.category="evaluation_set", api.post = "/api/evaluation/v1/evaluation_sets/:evaluation_set_id/dedup_jobs/:job_id/archive", api.op_type = 'delete', api.tag = 'volc-agentkit'
+    )
+    GenerateEvaluationSetItemsResponse GenerateEvaluationSetItems(1: GenerateEvaluationSetItemsRequest req) (
+        api.category="evaluation_set", api.post = "/api/evaluation/v1/evaluation_sets/:evaluation_set_id/generated_items", api.op_type = 'create', api.tag = 'volc-agentkit'
+    )
+    ListGeneratedEvaluationSetItemsResponse ListGeneratedEvaluationSetItems(1: ListGeneratedEvaluationSetItemsRequest req) (
+        api.category="evaluation_set", api.post = "/api/evaluation/v1/evaluation_sets/:evaluation_set_id/generated_items/list", api.op_type = 'list', api.tag = 'volc-agentkit'
+    )
+    ReviewGeneratedEvaluationSetItemsResponse ReviewGeneratedEvaluationSetItems(1: ReviewGeneratedEvaluationSetItemsRequest req) (
+        api.category="evaluation_set", api.post = "/api/evaluation/v1/evaluation_sets/:evaluation_set_id/generated_items/review", api.op_type = 'update', api.tag = 'volc-agentkit'
+    )
+    GetEvaluationSetItemLineageResponse GetEvaluationSetItemLineage(1: GetEvaluationSetItemLineageRequest req) (
+        api.category="evaluation_set", api.get = "/api/evaluation/v1/evaluation_sets/:evaluation_set_id/items/:item_id/lineage", api.op_type = 'query', api.tag = 'volc-agentkit'
+    )
     ListEvaluationSetItemsResponse ListEvaluationSetItems(1: ListEvaluationSetItemsRequest req) (
         api.category="evaluation_set", api.post = "/api/evaluation/v1/evaluation_sets/:evaluation_set_id/items/list", api.op_type = 'list', api.tag = 'volc-agentkit,open'
     )
@@ -189,6 +189,8 @@ struct SubmitEvaluatorVersionRequest {
     2: required i64 evaluator_id (api.path='evaluator_id', api.js_conv='true', go.tag='json:"evaluator_id"')
     3: required string version (api.body='version')
     4: optional string description (api.body='description')
+    // 提交前的回归检查, 为空时按评估器已配置的晋级策略检查
+    5: optional evaluator.EvaluatorPromotionCheckConf promotion_check (api.body='promotion_check')
     100: optional string cid (api.body='cid')
 
     255: optional base.Base Base
@@ -196,6 +198,8 @@ struct SubmitEvaluatorVersionRequest {
 
 struct SubmitEvaluatorVersionResponse {
     1: optional evaluator.Evaluator evaluator  (api.body='evaluator')
+    // 回归检查报告, 未执行检查时为空; 检查结论为阻断时提交失败并返回错误
+    2: optional evaluator.EvaluatorPromotionReport promotion_report (api.body='promotion_report')
 
     255: base.BaseResp BaseResp
 }
@@ -533,6 +537,49 @@ struct ListEvaluatorTagsResponse {
 }
 
 
+struct RunEvaluatorCalibrationRequest {
+    1: required i64 workspace_id (api.body='workspace_id', api.js_conv='true', go.tag='json:"workspace_id"')
+    2: required i64 evaluator_version_id (api.path='evaluator_version_id', api.js_conv='true', go.tag='json:"evaluator_version_id"')
+    3: required i64 evaluation_set_id (api.body='evaluation_set_id', api.js_conv='true', go.tag='json:"evaluation_set_id"') // 带人工真值字段的评测集
+    4: optional i64 evaluation_set_version_id (api.body='evaluation_set_version_id', api.js_conv='true', go.tag='json:"evaluation_set_version_id"') // 为空时使用草稿
+    5: required evaluator.EvaluatorCalibrationConf conf (api.body='conf')
+
+    255: optional base.Base Base
+}
+
+struct RunEvaluatorCalibrationResponse {
+    1: optional evaluator.EvaluatorCalibrationRecord record (api.body='record') // 运行中的校准记录, 结果通过 GetEvaluatorCalibrationRecord 轮询
+
+    255: base.BaseResp BaseResp
+}
+
+struct GetEvaluatorCalibrationRecordRequest {
+    1: required i64 workspace_id (api.query='workspace_id', api.js_conv='true', go.tag='json:"workspace_id"')
+    2: required i64 record_id (api.path='record_id', api.js_conv='true', go.tag='json:"record_id"')
+
+    255: optional base.Base Base
+}
+
+struct GetEvaluatorCalibrationRecordResponse {
+    1: optional evaluator.EvaluatorCalibrationRecord record (api.body='record')
+
+    255: base.BaseResp BaseResp
+}
+
+struct ListEvaluatorCalibrationRecordsRequest {
+    1: required i64 workspace_id (api.body='workspace_id', api.js_conv='true', go.tag='json:"workspace_id"')
+    2: required i64 evaluator_id (api.body='evaluator_id', api.js_conv='true', go.tag='json:"evaluator_id"')
+    3: optional list<i64> evaluator_version_ids (api.body='evaluator_version_ids', api.js_conv='true', go.tag='json:"evaluator_version_ids"') // 为空时返回全部版本
+
+    255: optional base.Base Base
+}
+
+struct ListEvaluatorCalibrationRecordsResponse {
+    1: optional list<evaluator.EvaluatorCalibrationRecord> records (api.body='records')
+
+    255: base.BaseResp BaseResp
+}
+
 service EvaluatorService {
     // 评估器
     ListEvaluatorsResponse ListEvaluators(1: ListEvaluatorsRequest request) (
@@ -616,6 +663,17 @@ service EvaluatorService {
         api.post="/api/evaluation/v1/evaluator_records/batch_get"
     ) // 按id批量查询evaluator运行记录详情
 
+    // 评估器校准
+    RunEvaluatorCalibrationResponse RunEvaluatorCalibration(1: RunEvaluatorCalibrationRequest req) (
+        api.post="/api/evaluation/v1/evaluators_versions/:evaluator_version_id/calibrations"
+    ) // 在 golden set 上异步校准评估器版本
+    GetEvaluatorCalibrationRecordResponse GetEvaluatorCalibrationRecord(1: GetEvaluatorCalibrationRecordRequest req) (
+        api.get="/api/evaluation/v1/evaluator_calibrations/:record_id"
+    ) // 获取校准记录
+    ListEvaluatorCalibrationRecordsResponse ListEvaluatorCalibrationRecords(1: ListEvaluatorCalibrationRecordsRequest req) (
+        api.post="/api/evaluation/v1/evaluator_calibrations/list"
+    ) // 按评估器查询各版本校准记录
+
     // 评估器验证
     ValidateEvaluatorResponse ValidateEvaluator(1: ValidateEvaluatorRequest request) (
         api.post="/api/evaluation/v1/evaluators/validate", api.op_type = 'query', api.tag = 'volc-agentkit', api.category = 'evaluator'
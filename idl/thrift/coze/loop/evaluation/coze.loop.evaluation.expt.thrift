@@ -64,6 +64,18 @@ struct CreateExperimentRequest {
 
     // 通知配置
     110: optional expt.ExptNotificationConf notification_conf (api.body = 'notification_conf')
+    // 质量门禁配置, 实验结束后异步评估
+    111: optional expt.ExptQualityGateConf quality_gate_conf (api.body = 'quality_gate_conf')
+    // 成本核算与花费上限配置
+    112: optional expt.ExptCostConf cost_conf (api.body = 'cost_conf')
+    // 评测对象/评估器结果缓存配置, 为空不启用
+    113: optional expt.ExptResultCacheConf result_cache_conf (api.body = 'result_cache_conf')
+    // 抽样配置, 为空运行全量评测集; 仅单评测集实验生效
+    114: optional expt.ExptSamplingConf sampling_conf (api.body = 'sampling_conf')
+    // 重复试验配置, 为空每个 turn 仅执行一次
+    115: optional expt.ExptTrialConf trial_conf (api.body = 'trial_conf')
+    // 模拟用户配置, 为空按评测集 turn 执行
+    116: optional expt.ExptUserSimulatorConf user_simulator_conf (api.body = 'user_simulator_conf')
 
     100: optional map<string, string> ext (api.body = 'ext')
 
@@ -141,6 +153,18 @@ struct SubmitExperimentRequest {
 
     // 通知配置
     110: optional expt.ExptNotificationConf notification_conf (api.body = 'notification_conf')
+    // 质量门禁配置, 实验结束后异步评估
+    111: optional expt.ExptQualityGateConf quality_gate_conf (api.body = 'quality_gate_conf')
+    // 成本核算与花费上限配置
+    112: optional expt.ExptCostConf cost_conf (api.body = 'cost_conf')
+    // 评测对象/评估器结果缓存配置, 为空不启用
+    113: optional expt.ExptResultCacheConf result_cache_conf (api.body = 'result_cache_conf')
+    // 抽样配置, 为空运行全量评测集; 仅单评测集实验生效
+    114: optional expt.ExptSamplingConf sampling_conf (api.body = 'sampling_conf')
+    // 重复试验配置, 为空每个 turn 仅执行一次
+    115: optional expt.ExptTrialConf trial_conf (api.body = 'trial_conf')
+    // 模拟用户配置, 为空按评测集 turn 执行
+    116: optional expt.ExptUserSimulatorConf user_simulator_conf (api.body = 'user_simulator_conf')
 
     200: optional common.Session session
 
@@ -569,6 +593,8 @@ struct CreateExperimentTemplateRequest {
 
     // 通知配置
     40: optional expt.ExptNotificationConf notification_conf (api.body = 'notification_conf')
+    // 质量门禁配置, 基于模板创建的实验结束后按此评估
+    41: optional expt.ExptQualityGateConf quality_gate_conf (api.body = 'quality_gate_conf')
 
     200: optional common.Session session
     255: optional base.Base Base
@@ -634,6 +660,8 @@ struct UpdateExperimentTemplateRequest {
 
     // 通知配置
     40: optional expt.ExptNotificationConf notification_conf (api.body = 'notification_conf')
+    // 质量门禁配置, 基于模板创建的实验结束后按此评估
+    41: optional expt.ExptQualityGateConf quality_gate_conf (api.body = 'quality_gate_conf')
 
     255: optional base.Base Base
 }
@@ -698,6 +726,8 @@ struct SubmitExptFromTemplateRequest {
 
     // 通知配置（可选覆盖模板配置）
     10: optional expt.ExptNotificationConf notification_conf (api.body = 'notification_conf')
+    // 质量门禁配置（可选覆盖模板配置）
+    11: optional expt.ExptQualityGateConf quality_gate_conf (api.body = 'quality_gate_conf')
 
     200: optional common.Session session
     255: optional base.Base Base
@@ -855,6 +885,139 @@ struct GetExptResultExportRecordResponse {
 }
 
 
+struct SubmitExptPairwiseRankRequest {
+    1: required i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"')
+    2: required list<i64> expt_ids (api.body = 'expt_ids', api.js_conv = 'true', go.tag = 'json:"expt_ids"') // 2~10 个, 须属于同一评测集
+    3: required i64 evaluator_version_id (api.body = 'evaluator_version_id', api.js_conv = 'true', go.tag = 'json:"evaluator_version_id"') // 成对评估器版本
+    4: optional string output_field_key // 评测对象输出字段, 默认 actual_output
+    5: optional i32 max_turn_cnt // 每对实验最多比较的 turn 数, 默认 200
+    6: optional double elo_k // Elo 更新系数, 默认 32
+    7: optional i32 concurrency // 评估器调用并发数, 默认 5, 上限 20
+
+    200: optional common.Session session
+    255: optional base.Base Base
+}
+
+struct SubmitExptPairwiseRankResponse {
+    1: required i64 record_id (api.body = "record_id", api.js_conv = 'true', go.tag = 'json:"record_id"')
+
+    255: base.BaseResp BaseResp
+}
+
+struct GetExptPairwiseRankRequest {
+    1: required i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"')
+    2: required i64 record_id (api.path = 'record_id', api.js_conv = 'true', go.tag = 'json:"record_id"')
+
+    200: optional common.Session session
+    255: optional base.Base Base
+}
+
+struct GetExptPairwiseRankResponse {
+    1: optional expt.ExptPairwiseRankRecord record
+
+    255: base.BaseResp BaseResp
+}
+
+struct CompareExperimentsRequest {
+    1: required i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"')
+    2: required i64 base_expt_id (api.body = 'base_expt_id', api.js_conv = 'true', go.tag = 'json:"base_expt_id"')
+    3: required list<i64> candidate_expt_ids (api.body = 'candidate_expt_ids', api.js_conv = 'true', go.tag = 'json:"candidate_expt_ids"') // 1~10 个, 须与基线属于同一评测集
+    4: optional double confidence // 置信水平, 默认 0.95
+    5: optional i32 bootstrap_iterations // bootstrap 重采样次数, 默认 1000, 上限 10000
+    6: optional i64 seed (api.js_conv = 'true', go.tag = 'json:"seed"') // bootstrap 随机种子, 固定后结果可复现
+    7: optional double tie_threshold // 分差绝对值不超过该值视为平局, 默认 0
+
+    255: optional base.Base Base
+}
+
+struct CompareExperimentsResponse {
+    1: optional i64 base_expt_id (api.body = "base_expt_id", api.js_conv = 'true', go.tag = 'json:"base_expt_id"')
+    2: optional list<expt.ExptPairSignificance> comparisons
+
+    255: base.BaseResp BaseResp
+}
+
+struct GetExptRegressionReportRequest {
+    1: required i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"')
+    2: required i64 base_expt_id (api.body = 'base_expt_id', api.js_conv = 'true', go.tag = 'json:"base_expt_id"')
+    3: required list<i64> candidate_expt_ids (api.body = 'candidate_expt_ids', api.js_conv = 'true', go.tag = 'json:"candidate_expt_ids"') // 1~10 个, 须与基线属于同一评测集
+    4: optional double threshold // |候选 - 基线| 超过该值视为变化, 默认 0
+    5: optional list<expt.ExptRegressionChangeType> change_types // 非空时仅返回指定变化方向的 turn
+    6: optional expt.ExperimentFilter filter (api.body = 'filter') // 作用于基线实验, 与实验详情页筛选口径一致
+    7: optional bool use_accelerator
+    8: optional list<string> output_field_keys // 并排展示的评测对象输出字段, 空表示全部
+    9: optional i32 max_item_cnt // 返回的 turn 数上限, 默认 1000, 上限 10000
+
+    255: optional base.Base Base
+}
+
+struct GetExptRegressionReportResponse {
+    1: optional expt.ExptRegressionReport report
+
+    255: base.BaseResp BaseResp
+}
+
+struct ExportExptRegressionReportRequest {
+    1: required i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"')
+    2: required i64 base_expt_id (api.body = 'base_expt_id', api.js_conv = 'true', go.tag = 'json:"base_expt_id"')
+    3: required list<i64> candidate_expt_ids (api.body = 'candidate_expt_ids', api.js_conv = 'true', go.tag = 'json:"candidate_expt_ids"')
+    4: optional double threshold
+    5: optional list<expt.ExptRegressionChangeType> change_types
+    6: optional expt.ExperimentFilter filter (api.body = 'filter')
+    7: optional bool use_accelerator
+    8: optional list<string> output_field_keys
+    9: optional i32 max_item_cnt
+    10: optional expt.ExptResultExportType export_type (api.body = "export_type") // CSV / XLSX / JSONL / Parquet, 默认 CSV
+
+    255: optional base.Base Base
+}
+
+struct ExportExptRegressionReportResponse {
+    1: optional string url // 导出文件签名下载链接, 24 小时有效
+
+    255: base.BaseResp BaseResp
+}
+
+struct GetExptGroupedAggrResultRequest {
+    1: required i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"')
+    2: required list<i64> expt_ids (api.body = 'expt_ids', api.js_conv = 'true', go.tag = 'json:"expt_ids"') // 最多 10 个, 结果与之顺序一致
+    3: required expt.ExptGroupDimension dimension (api.body = 'dimension')
+    4: optional i32 max_groups (api.body = 'max_groups') // 每个实验最多返回的分组数, 默认 50
+
+    255: optional base.Base Base
+}
+
+struct GetExptGroupedAggrResultResponse {
+    1: optional expt.ExptGroupDimension dimension
+    2: optional list<expt.ExptGroupedAggr> expt_results
+
+    255: base.BaseResp BaseResp
+}
+
+struct GetExptSampledAggrResultRequest {
+    1: required i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"')
+    2: required i64 expt_id (api.path = 'expt_id', api.js_conv = 'true', go.tag = 'json:"expt_id"')
+    3: optional double confidence (api.body = 'confidence') // 置信水平, 取值 (0, 1), 默认 0.95
+
+    255: optional base.Base Base
+}
+
+struct GetExptSampledAggrResultResponse {
+    1: optional expt.ExptSampledAggrResult result
+
+    255: base.BaseResp BaseResp
+}
+
+struct InvalidateExptResultCacheRequest {
+    1: required i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"')
+
+    255: optional base.Base Base
+}
+
+struct InvalidateExptResultCacheResponse {
+    255: base.BaseResp BaseResp
+}
+
 struct GetExptInsightAnalysisRecordRequest {
     1: required i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"')
     2: required i64 expt_id (api.path = 'expt_id' , api.js_conv = 'true', go.tag = 'json:"expt_id"')
@@ -1074,6 +1237,26 @@ service ExperimentService {
     ListExptInsightAnalysisCommentResponse ListExptInsightAnalysisComment(1: ListExptInsightAnalysisCommentRequest req) (api.post="/api/evaluation/v1/experiments/:expt_id/insight_analysis_records/:insight_analysis_record_id/comments/list")
     GetAnalysisRecordFeedbackVoteResponse GetAnalysisRecordFeedbackVote(1: GetAnalysisRecordFeedbackVoteRequest req) (api.get="/api/evaluation/v1/experiments/insight_analysis_records/:insight_analysis_record_id/feedback_vote")
 
+    // 显著性对比
+    CompareExperimentsResponse CompareExperiments(1: CompareExperimentsRequest req) (api.post="/api/evaluation/v1/experiments/compare")
+
+    // 跨实验回归报告
+    GetExptRegressionReportResponse GetExptRegressionReport(1: GetExptRegressionReportRequest req) (api.post="/api/evaluation/v1/experiments/regression_report")
+    ExportExptRegressionReportResponse ExportExptRegressionReport(1: ExportExptRegressionReportRequest req) (api.post="/api/evaluation/v1/experiments/regression_report/export")
+
+    // 分组聚合: 按评测集字段或人工标注取值切分一个或多个实验的评估器得分
+    GetExptGroupedAggrResultResponse GetExptGroupedAggrResult(1: GetExptGroupedAggrResultRequest req) (api.post="/api/evaluation/v1/experiments/grouped_aggr_result")
+
+    // 抽样实验: 按分层权重估计全量评测集上的评估器得分及置信区间
+    GetExptSampledAggrResultResponse GetExptSampledAggrResult(1: GetExptSampledAggrResultRequest req) (api.post="/api/evaluation/v1/experiments/:expt_id/sampled_aggr_result")
+
+    // 结果缓存: 使空间内已有的评测对象/评估器结果缓存全部失效
+    InvalidateExptResultCacheResponse InvalidateExptResultCache(1: InvalidateExptResultCacheRequest req) (api.post="/api/evaluation/v1/experiments/result_cache/invalidate")
+
+    // 成对排名
+    SubmitExptPairwiseRankResponse SubmitExptPairwiseRank(1: SubmitExptPairwiseRankRequest req) (api.post="/api/evaluation/v1/experiments/pairwise_rank")
+    GetExptPairwiseRankResponse GetExptPairwiseRank(1: GetExptPairwiseRankRequest req) (api.post="/api/evaluation/v1/experiments/pairwise_rank/:record_id")
+
     // 实验模板
     CreateExperimentTemplateResponse CreateExperimentTemplate(1: CreateExperimentTemplateRequest req) (
         api.post = '/api/evaluation/v1/experiment_templates', api.op_type = 'create', api.tag = 'volc-agentkit', api.category = 'experiment'
@@ -647,6 +647,8 @@ struct SubmitExperimentOApiRequest {
 
     // 通知配置
     50: optional experiment.ExptNotificationConf notification_conf (api.body = 'notification_conf')
+    // 质量门禁配置, 实验结束后异步评估, 结果通过 GetExperimentsOApi 与 lifecycle webhook 下发
+    51: optional experiment.ExptQualityGateConf quality_gate_conf (api.body = 'quality_gate_conf')
 
     100: optional map<string, string> ext (api.body = 'ext')
 
@@ -847,6 +849,32 @@ struct GetExperimentAggrResultOpenAPIData {
     2: optional experiment.EvalTargetAggregateResult eval_target_aggr_result
 }
 
+struct CompareExperimentsOApiRequest {
+    1: optional i64 workspace_id (api.body = 'workspace_id', api.js_conv = "true", go.tag = 'json:"workspace_id"')
+    2: optional i64 base_experiment_id (api.body = 'base_experiment_id', api.js_conv = "true", go.tag = 'json:"base_experiment_id"')
+    3: optional list<i64> candidate_experiment_ids (api.body = 'candidate_experiment_ids', api.js_conv = "true", go.tag = 'json:"candidate_experiment_ids"')
+    4: optional double confidence (api.body = 'confidence')
+    5: optional i32 bootstrap_iterations (api.body = 'bootstrap_iterations')
+    6: optional i64 seed (api.body = 'seed', api.js_conv = "true", go.tag = 'json:"seed"')
+    7: optional double tie_threshold (api.body = 'tie_threshold')
+
+    254: optional extra.Extra extra (agw.source = "not_body_struct")
+    255: optional base.Base Base
+}
+
+struct CompareExperimentsOApiResponse {
+    1: optional i32 code
+    2: optional string msg
+    3: optional CompareExperimentsOpenAPIData data
+
+    255: base.BaseResp BaseResp
+}
+
+struct CompareExperimentsOpenAPIData {
+    1: optional i64 base_experiment_id (api.js_conv = "true", go.tag = 'json:"base_experiment_id"')
+    2: optional list<experiment.ExperimentPairSignificance> comparisons
+}
+
 struct RetryExperimentOApiRequest {
     1: optional i64 workspace_id (api.body = 'workspace_id', api.js_conv = "true", go.tag = 'json:"workspace_id"')
     2: optional i64 experiment_id (api.path = "experiment_id", api.js_conv = "true", go.tag = 'json:"experiment_id"')
@@ -1297,6 +1325,8 @@ struct CreateExptTemplateOApiRequest {
 
     // 通知配置
     30: optional experiment.ExptNotificationConf notification_conf (api.body="notification_conf")
+    // 质量门禁配置
+    31: optional experiment.ExptQualityGateConf quality_gate_conf (api.body="quality_gate_conf")
 
     254: optional extra.Extra extra (agw.source = "not_body_struct")
     255: optional base.Base Base
@@ -1370,6 +1400,8 @@ struct UpdateExptTemplateOApiRequest {
 
     // 通知配置
     30: optional experiment.ExptNotificationConf notification_conf (api.body="notification_conf")
+    // 质量门禁配置
+    31: optional experiment.ExptQualityGateConf quality_gate_conf (api.body="quality_gate_conf")
 
     254: optional extra.Extra extra (agw.source = "not_body_struct")
     255: optional base.Base Base
@@ -1440,6 +1472,8 @@ struct SubmitExptFromTemplateOApiRequest {
 
     // 通知配置（可选覆盖模板配置）
     10: optional experiment.ExptNotificationConf notification_conf (api.body="notification_conf")
+    // 质量门禁配置（可选覆盖模板配置）
+    11: optional experiment.ExptQualityGateConf quality_gate_conf (api.body="quality_gate_conf")
 
     // 创建实验时，判断不为空则替换模板上的信息
     20: optional common.RuntimeParam target_runtime_param (api.body = "target_runtime_param")
@@ -1547,6 +1581,8 @@ service EvaluationOpenAPIService {
     ListExperimentResultOApiResponse ListExperimentResultOApi(1: ListExperimentResultOApiRequest req) (api.category = "openapi", api.post = "/v1/loop/evaluation/experiments/:experiment_id/results")
     // 获取聚合结果
     GetExperimentAggrResultOApiResponse GetExperimentAggrResultOApi(1: GetExperimentAggrResultOApiRequest req) (api.category = "openapi", api.post = "/v1/loop/evaluation/experiments/:experiment_id/aggr_results")
+    // 实验显著性对比
+    CompareExperimentsOApiResponse CompareExperimentsOApi(1: CompareExperimentsOApiRequest req) (api.category = "openapi", api.post = "/v1/loop/evaluation/experiments/compare")
     // 重试实验
     RetryExperimentOApiResponse RetryExperimentOApi(1: RetryExperimentOApiRequest req) (api.category = "openapi", api.post = "/v1/loop/evaluation/experiments/:experiment_id/retry")
     // 终止实验
@@ -133,6 +133,7 @@ struct EvaluationSetItem {
     20: optional i64 item_version_id (api.js_conv='true', go.tag='json:"item_version_id"'),
     21: optional string item_version,
     24: optional list<ResourceTag> tags,                // 系统资源标签
+    25: optional EvaluationSetItemProvenance provenance, // 行来源, 按来源筛选时返回
 
     // 系统信息
     100: optional common.BaseInfo base_info
@@ -179,3 +180,162 @@ const BizCategory BizCategory_FromOnlineTrace = "from_online_trace" // 标识来
 typedef string EvaluationSetType(ts.enum="true")
 const EvaluationSetType EvaluationSetType_Default = "default"
 const EvaluationSetType EvaluationSetType_VersionedItem = "versioned_item"
+
+typedef string EvaluationSetChangeType(ts.enum="true")
+const EvaluationSetChangeType EvaluationSetChangeType_Added = "added"
+const EvaluationSetChangeType EvaluationSetChangeType_Removed = "removed"
+const EvaluationSetChangeType EvaluationSetChangeType_Modified = "modified"
+
+// 字段 schema 的变更, 按字段 key 匹配
+struct EvaluationSetSchemaFieldDiff {
+    1: optional string key,
+    2: optional string name,
+    3: optional EvaluationSetChangeType change_type,
+    4: optional FieldSchema base,
+    5: optional FieldSchema target,
+    6: optional list<string> changed_attrs, // 发生变化的属性, 仅 modified 时有值
+}
+
+// 单个 turn 中单个字段内容的变更
+struct EvaluationSetFieldDiff {
+    1: optional i32 turn_idx,
+    2: optional string field_key,
+    3: optional string field_name,
+    4: optional EvaluationSetChangeType change_type,
+    5: optional common.Content base,
+    6: optional common.Content target,
+}
+
+// 行级变更, 按 item_id 匹配
+struct EvaluationSetItemDiff {
+    1: optional i64 item_id (api.js_conv='true', go.tag='json:"item_id"'),
+    2: optional string item_key,
+    3: optional EvaluationSetChangeType change_type,
+    4: optional EvaluationSetItem base_item,   // 新增时为空
+    5: optional EvaluationSetItem target_item, // 删除时为空
+    6: optional list<EvaluationSetFieldDiff> field_diffs, // 仅 modified 时有值
+}
+
+// 从 base 到 target 的变更, 版本 ID 为空表示草稿
+struct EvaluationSetVersionDiff {
+    1: optional i64 evaluation_set_id (api.js_conv='true', go.tag='json:"evaluation_set_id"'),
+    2: optional i64 base_version_id (api.js_conv='true', go.tag='json:"base_version_id"'),
+    3: optional i64 target_version_id (api.js_conv='true', go.tag='json:"target_version_id"'),
+    4: optional list<EvaluationSetSchemaFieldDiff> schema_diffs,
+    5: optional list<EvaluationSetItemDiff> item_diffs,
+    6: optional i32 added_item_cnt,
+    7: optional i32 removed_item_cnt,
+    8: optional i32 modified_item_cnt,
+    9: optional i32 unchanged_item_cnt,
+    10: optional bool truncated, // 行数超出对比上限, 行级对比不完整
+}
+
+typedef string EvaluationSetDedupMethod(ts.enum="true")
+const EvaluationSetDedupMethod EvaluationSetDedupMethod_MinHash = "minhash" // token 片段集合的 Jaccard 相似度
+const EvaluationSetDedupMethod EvaluationSetDedupMethod_SimHash = "simhash" // 词频指纹的汉明距离
+
+typedef string EvaluationSetDuplicateKind(ts.enum="true")
+const EvaluationSetDuplicateKind EvaluationSetDuplicateKind_Exact = "exact"
+const EvaluationSetDuplicateKind EvaluationSetDuplicateKind_Near = "near"
+
+typedef string EvaluationSetDedupJobStatus(ts.enum="true")
+const EvaluationSetDedupJobStatus EvaluationSetDedupJobStatus_Unknown = "Unknown"
+const EvaluationSetDedupJobStatus EvaluationSetDedupJobStatus_Running = "Running"
+const EvaluationSetDedupJobStatus EvaluationSetDedupJobStatus_Success = "Success"
+const EvaluationSetDedupJobStatus EvaluationSetDedupJobStatus_Failed = "Failed"
+
+struct EvaluationSetDedupConf {
+    1: optional list<string> field_names, // 参与去重的字段, 为空时使用全部文本字段
+    2: optional EvaluationSetDedupMethod method, // 默认 minhash
+    3: optional double threshold, // 近似重复相似度阈值 (0, 1], 默认 0.85
+    4: optional i32 shingle_size, // MinHash 片段长度 (token 数), 默认 3
+    5: optional bool exact_only, // 仅检测精确重复
+}
+
+// 一组互为重复的行, 保留 keep_item_id, 其余为待归档的重复行
+struct EvaluationSetDuplicateCluster {
+    1: optional EvaluationSetDuplicateKind kind,
+    2: optional i64 keep_item_id (api.js_conv='true', go.tag='json:"keep_item_id"'),
+    3: optional list<i64> duplicate_item_ids (api.js_conv='true', go.tag='json:"duplicate_item_ids"'),
+    4: optional double similarity, // 簇内最低相似度, 精确重复为 1
+}
+
+struct EvaluationSetDedupReport {
+    1: optional i32 scanned_item_cnt,
+    2: optional i32 skipped_item_cnt, // 所选字段无文本内容的行数
+    3: optional bool truncated, // 行数超出扫描上限, 仅检测了前 50000 行
+    4: optional i32 exact_duplicate_cnt,
+    5: optional i32 near_duplicate_cnt,
+    6: optional list<EvaluationSetDuplicateCluster> clusters,
+}
+
+// 评测集去重检测任务
+struct EvaluationSetDedupJob {
+    1: required i64 job_id (api.js_conv='true', go.tag='json:"job_id"'),
+    2: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"'),
+    3: required i64 evaluation_set_id (api.js_conv='true', go.tag='json:"evaluation_set_id"'),
+    4: optional i64 version_id (api.js_conv='true', go.tag='json:"version_id"'), // 为空表示草稿
+    5: required EvaluationSetDedupJobStatus status,
+    6: optional EvaluationSetDedupConf conf,
+    7: optional EvaluationSetDedupReport report, // 成功后有值
+    8: optional string error_msg,
+    9: optional common.BaseInfo base_info,
+}
+
+typedef string EvaluationSetSynthesisStrategy(ts.enum="true")
+const EvaluationSetSynthesisStrategy EvaluationSetSynthesisStrategy_Paraphrase = "paraphrase" // 改写种子数据, 语义与期望结果保持一致
+const EvaluationSetSynthesisStrategy EvaluationSetSynthesisStrategy_Adversarial = "adversarial" // 含误导、干扰信息的对抗样本
+const EvaluationSetSynthesisStrategy EvaluationSetSynthesisStrategy_EdgeCase = "edge_case" // 空值、超长、罕见格式等边界输入
+
+typedef string GeneratedItemReviewStatus(ts.enum="true")
+const GeneratedItemReviewStatus GeneratedItemReviewStatus_PendingReview = "pending_review"
+const GeneratedItemReviewStatus GeneratedItemReviewStatus_Approved = "approved"
+
+struct EvaluationSetSynthesisConf {
+    1: optional common.ModelConfig model_config,
+    2: optional i32 count, // 生成行数 (0, 200]
+    3: optional list<EvaluationSetSynthesisStrategy> strategies, // 各批次轮流使用, 为空时仅改写
+    4: optional list<string> field_names, // 需要生成的字段, 为空时生成全部文本字段
+    5: optional string instruction, // 附加的生成要求, 如领域、语言、难度
+    6: optional i32 batch_size, // 单次模型调用生成的行数, 默认 10
+}
+
+// 模型生成的行及其审核状态
+struct GeneratedEvaluationSetItem {
+    1: optional EvaluationSetItem item,
+    2: optional GeneratedItemReviewStatus review_status, // 未标记时为空
+}
+
+typedef string EvaluationSetItemSourceType(ts.enum="true")
+const EvaluationSetItemSourceType EvaluationSetItemSourceType_Manual = "manual" // 页面手动新增或编辑
+const EvaluationSetItemSourceType EvaluationSetItemSourceType_FileImport = "file_import" // 文件导入
+const EvaluationSetItemSourceType EvaluationSetItemSourceType_TraceExport = "trace_export" // 由 trace 导出
+const EvaluationSetItemSourceType EvaluationSetItemSourceType_OpenAPI = "openapi" // OpenAPI 写入
+const EvaluationSetItemSourceType EvaluationSetItemSourceType_Synthetic = "synthetic" // 模型生成
+const EvaluationSetItemSourceType EvaluationSetItemSourceType_VersionMerge = "version_merge" // 由历史版本合并回草稿
+const EvaluationSetItemSourceType EvaluationSetItemSourceType_ExptResult = "expt_result" // 由实验结果回流
+const EvaluationSetItemSourceType EvaluationSetItemSourceType_Unknown = "unknown" // 来源登记上线前写入、且无法按导入任务归因的历史行
+
+// 评测集行的来源信息, item_id 跨版本稳定, 来源对草稿与各版本通用
+struct EvaluationSetItemProvenance {
+    1: optional i64 item_id (api.js_conv='true', go.tag='json:"item_id"'),
+    2: optional EvaluationSetItemSourceType source_type,
+    3: optional string trace_id, // trace 导出时的来源 span
+    4: optional string span_id,
+    5: optional i64 import_job_id (api.js_conv='true', go.tag='json:"import_job_id"'), // 文件导入任务
+    6: optional i64 source_expt_id (api.js_conv='true', go.tag='json:"source_expt_id"'), // 由实验结果回流时的来源实验
+    7: optional i64 source_version_id (api.js_conv='true', go.tag='json:"source_version_id"'), // 由版本合并写入时的来源版本
+    8: optional string author, // 创建者
+    9: optional string updated_by, // 最后编辑者, 编辑不改变来源
+    10: optional i64 created_at (api.js_conv='true', go.tag='json:"created_at"'),
+    11: optional i64 updated_at (api.js_conv='true', go.tag='json:"updated_at"'),
+}
+
+// 按来源筛选评测集行, 各条件之间为且, 条件内多值为或
+struct EvaluationSetItemProvenanceFilter {
+    1: optional list<EvaluationSetItemSourceType> source_types,
+    2: optional list<string> trace_ids,
+    3: optional list<i64> import_job_ids (api.js_conv='true', go.tag='json:"import_job_ids"'),
+    4: optional list<i64> source_expt_ids (api.js_conv='true', go.tag='json:"source_expt_ids"'),
+    5: optional list<string> authors,
+}
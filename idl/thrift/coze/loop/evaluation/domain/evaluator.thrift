@@ -8,6 +8,9 @@ enum EvaluatorType {
     Code = 2
     CustomRPC = 3
     Agent = 4
+    Pairwise = 5 // 成对 (A-vs-B) 评估器, 同时接收两个实验的输出并给出偏好
+    Rule = 6 // 规则评估器, 在服务进程内按声明式规则计算
+    Composite = 7 // 组合评估器, 合并多个组件评估器版本的得分
 }
 
 typedef string LanguageType(ts.enum="true")
@@ -131,6 +134,62 @@ struct CustomRPCEvaluator {
     13: optional bool is_async // 是否异步执行；true 时调用 AsyncInvokeEvaluator
 }
 
+// 成对评估器, judge prompt 中以 {{output_a}} / {{output_b}} 引用两侧输出, 模型须输出 {"preference": "A"|"B"|"tie", "reason": "..."}
+struct PairwiseEvaluator {
+    1: optional list<common.Message> message_list
+    2: optional common.ModelConfig model_config
+    3: optional bool disable_position_swap // 默认交换 A/B 位置再判一次, 两次不一致记为平局; 置 true 只判一次
+}
+
+typedef string RuleType(ts.enum="true")
+const RuleType RuleType_ExactMatch = "exact_match"
+const RuleType RuleType_RegexMatch = "regex_match"
+const RuleType RuleType_JSONSchema = "json_schema"
+const RuleType RuleType_Contains = "contains"
+const RuleType RuleType_NumericTolerance = "numeric_tolerance"
+const RuleType RuleType_Levenshtein = "levenshtein"
+const RuleType RuleType_RougeL = "rouge_l"
+const RuleType RuleType_BLEU = "bleu"
+
+// 规则评估器
+struct RuleEvaluator {
+    1: optional RuleType rule_type
+    2: optional string actual_field_key // 待评估内容所在的输入字段, 默认 actual_output
+    3: optional string reference_field_key // 参考答案所在的输入字段, 默认 reference_output
+    4: optional bool ignore_case
+    5: optional bool trim_space
+    6: optional string pattern // regex_match
+    7: optional string json_schema // json_schema
+    8: optional list<string> keywords // contains
+    9: optional bool contains_all // contains, 为 true 时得分为命中关键词占比
+    10: optional double tolerance // numeric_tolerance
+    11: optional bool relative // numeric_tolerance, 为 true 时按 |reference| * tolerance 计算
+    12: optional i32 bleu_max_n // bleu, 默认 4
+    13: optional double pass_threshold // 非空时将相似度类得分按阈值二值化
+}
+
+typedef string CompositeStrategy(ts.enum="true")
+const CompositeStrategy CompositeStrategy_Mean = "mean"
+const CompositeStrategy CompositeStrategy_Median = "median"
+const CompositeStrategy CompositeStrategy_Majority = "majority"
+const CompositeStrategy CompositeStrategy_Min = "min"
+const CompositeStrategy CompositeStrategy_Weighted = "weighted"
+const CompositeStrategy CompositeStrategy_Code = "code"
+
+struct CompositeComponent {
+    1: optional i64 evaluator_version_id (api.js_conv = 'true', go.tag = 'json:"evaluator_version_id"')
+    2: optional double weight // 仅 weighted 策略使用
+}
+
+// 组合评估器
+struct CompositeEvaluator {
+    1: optional list<CompositeComponent> components
+    2: optional CompositeStrategy strategy
+    3: optional i32 min_success_cnt // 参与合并所需的最少成功组件数, 为 0 时要求全部组件成功
+    4: optional string code_content // code 策略的合并代码
+    5: optional LanguageType language_type
+}
+
 struct EvaluatorVersion {
     1: optional i64 id (api.js_conv = 'true', go.tag = 'json:"id"')          // 版本id
     3: optional string version
@@ -149,6 +208,9 @@ struct EvaluatorContent {
     102: optional CodeEvaluator code_evaluator
     103: optional CustomRPCEvaluator custom_rpc_evaluator
     104: optional AgentEvaluator agent_evaluator
+    105: optional PairwiseEvaluator pairwise_evaluator
+    106: optional RuleEvaluator rule_evaluator
+    107: optional CompositeEvaluator composite_evaluator
 }
 
 // 明确有顺序的 evaluator 与版本映射元素
@@ -326,3 +388,140 @@ struct EvaluatorProgressMessage {
     3: optional string message    // 如 Check current user identity and working directory
     4: optional i64 created_at_ms
 }
+
+typedef string EvaluatorCalibrationStatus(ts.enum="true")
+
+const EvaluatorCalibrationStatus EvaluatorCalibrationStatus_Unknown = "Unknown"
+const EvaluatorCalibrationStatus EvaluatorCalibrationStatus_Running = "Running"
+const EvaluatorCalibrationStatus EvaluatorCalibrationStatus_Success = "Success"
+const EvaluatorCalibrationStatus EvaluatorCalibrationStatus_Failed = "Failed"
+
+// 字段映射, field_name 为评估器侧字段, from_field 为评测集字段
+struct CalibrationFieldConf {
+    1: optional string field_name
+    2: optional string from_field
+}
+
+struct EvaluatorCalibrationConf {
+    1: optional string ground_truth_field // 评测集中人工真值分数所在字段名, 内容需可解析为数值
+    2: optional list<CalibrationFieldConf> field_confs // 为空时按同名透传除真值字段外的全部字段
+    3: optional list<CalibrationFieldConf> target_output_field_confs // 作为评测对象输出提供给评估器的评测集字段
+    4: optional i32 worst_item_cnt // 结果中保留的最不一致样本数, 默认 20
+    5: optional i32 concurrency // 评估器并发数, 默认 5
+    6: optional double max_evaluator_fail_rate // 评估器运行失败样本占比超过该值时校准置为失败, 默认 0.5
+}
+
+// counts[i][j] 为人工真值为 labels[i] 且评估器输出为 labels[j] 的样本数
+struct CalibrationConfusionMatrix {
+    1: optional list<string> labels
+    2: optional list<list<i64>> counts
+    3: optional double accuracy
+}
+
+struct CalibrationItemResult {
+    1: optional i64 item_id (api.js_conv='true', go.tag='json:"item_id"')
+    2: optional i64 turn_id (api.js_conv='true', go.tag='json:"turn_id"')
+    3: optional double ground_truth
+    4: optional double evaluator_score
+    5: optional double abs_error
+    6: optional string reasoning
+}
+
+struct EvaluatorCalibrationResult {
+    1: optional i32 item_cnt // 参与校准的 turn 数
+    2: optional i32 paired_cnt // 评估器得分与人工真值均有效的 turn 数, 以下指标均基于这部分样本
+    3: optional i32 evaluator_fail_cnt
+    4: optional i32 invalid_ground_truth_cnt
+    5: optional double pearson_correlation
+    6: optional double spearman_correlation
+    7: optional double mean_absolute_error
+    8: optional CalibrationConfusionMatrix confusion_matrix // 仅分类输出时给出
+    9: optional list<CalibrationItemResult> worst_items // 按绝对误差降序的最不一致样本
+}
+
+// 评估器版本的一次校准记录
+struct EvaluatorCalibrationRecord {
+    1: optional i64 record_id (api.js_conv='true', go.tag='json:"record_id"')
+    2: optional i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"')
+    3: optional i64 evaluator_id (api.js_conv='true', go.tag='json:"evaluator_id"')
+    4: optional i64 evaluator_version_id (api.js_conv='true', go.tag='json:"evaluator_version_id"')
+    5: optional string evaluator_version
+    6: optional i64 evaluation_set_id (api.js_conv='true', go.tag='json:"evaluation_set_id"')
+    7: optional i64 evaluation_set_version_id (api.js_conv='true', go.tag='json:"evaluation_set_version_id"') // 0 表示草稿
+    8: optional EvaluatorCalibrationStatus status
+    9: optional EvaluatorCalibrationConf conf
+    10: optional EvaluatorCalibrationResult result
+    11: optional string error_msg
+    12: optional common.BaseInfo base_info
+}
+
+// 版本提交前回归检查未通过时的处理方式
+typedef string EvaluatorPromotionAction(ts.enum="true")
+
+const EvaluatorPromotionAction EvaluatorPromotionAction_Warn = "Warn" // 仅告警, 提交照常进行
+const EvaluatorPromotionAction EvaluatorPromotionAction_Block = "Block" // 阻断提交
+
+typedef string EvaluatorPromotionVerdict(ts.enum="true")
+
+const EvaluatorPromotionVerdict EvaluatorPromotionVerdict_Pass = "Pass"
+const EvaluatorPromotionVerdict EvaluatorPromotionVerdict_Warn = "Warn"
+const EvaluatorPromotionVerdict EvaluatorPromotionVerdict_Block = "Block"
+const EvaluatorPromotionVerdict EvaluatorPromotionVerdict_Skipped = "Skipped" // 无基线版本或无可回放的历史记录
+
+// 版本提交前的回归检查配置: 在基线版本最近的历史记录输入上回放草稿并与基线得分比较, 阈值为空表示不检查该项
+struct EvaluatorPromotionCheckConf {
+    1: optional string base_version // 基线版本号, 为空取评估器当前最新版本
+    2: optional EvaluatorPromotionAction action // 默认 Block
+    3: optional i32 sample_size // 回放的历史记录数, 默认 50, 上限 200
+    4: optional double pass_threshold // 得分不低于该值视为通过, 前后版本通过与否不同即为翻转; 为空时得分有任何变化即视为翻转
+
+    10: optional double max_mean_abs_drift
+    11: optional double max_flip_rate
+    12: optional double max_draft_fail_rate
+    13: optional double max_latency_increase_ratio // 如 0.5 表示平均耗时最多增加 50%
+    14: optional double max_token_increase_ratio
+}
+
+struct EvaluatorPromotionViolation {
+    1: optional string metric // mean_abs_drift / flip_rate / draft_fail_rate / latency_increase_ratio / token_increase_ratio
+    2: optional double value
+    3: optional double threshold
+}
+
+struct EvaluatorPromotionDriftItem {
+    1: optional i64 record_id (api.js_conv='true', go.tag='json:"record_id"')
+    2: optional i64 item_id (api.js_conv='true', go.tag='json:"item_id"')
+    3: optional i64 turn_id (api.js_conv='true', go.tag='json:"turn_id"')
+    4: optional double base_score
+    5: optional double draft_score
+    6: optional double drift
+    7: optional bool flipped
+    8: optional string draft_reasoning
+}
+
+// 草稿相对基线版本的回归检查报告
+struct EvaluatorPromotionReport {
+    1: optional i64 base_version_id (api.js_conv='true', go.tag='json:"base_version_id"')
+    2: optional string base_version
+    3: optional EvaluatorPromotionVerdict verdict
+
+    4: optional i32 sample_cnt
+    5: optional i32 paired_cnt // 草稿回放成功且得分有效的样本数, 得分类指标均基于这部分样本
+    6: optional i32 draft_fail_cnt
+
+    7: optional double mean_drift // 草稿得分减基线得分的均值
+    8: optional double mean_abs_drift
+    9: optional double max_abs_drift
+    10: optional i32 flip_cnt
+    11: optional double flip_rate
+
+    12: optional double base_avg_latency_ms
+    13: optional double draft_avg_latency_ms
+    14: optional double base_avg_tokens
+    15: optional double draft_avg_tokens
+    16: optional double latency_change_ratio
+    17: optional double token_change_ratio
+
+    18: optional list<EvaluatorPromotionViolation> violations
+    19: optional list<EvaluatorPromotionDriftItem> drift_items // 按漂移绝对值降序
+}
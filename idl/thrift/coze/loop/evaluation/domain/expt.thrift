@@ -199,6 +199,20 @@ struct Experiment {
     // 实验级多轮/SUA 跑法配置回显: 从 experiment.eval_conf.run_mode_config 反序列化, 与 Create/Submit 入参 run_mode_config 同构。
     // 仅 SandboxAgent + MultiSetConfig 实验非空。SUA 模型的 api_key/base_url 是运行时从 TCC 解析注入 case-file, 绝不回显。
     115: optional RunModeConfig run_mode_config
+    // 质量门禁配置与评估结果; 结果在实验结束后异步评估写入, 评估完成前为空
+    116: optional ExptQualityGateConf quality_gate_conf
+    117: optional ExptQualityGateResult quality_gate_result
+    // 成本核算与花费上限配置
+    118: optional ExptCostConf cost_conf
+    // 评测对象/评估器结果缓存配置
+    119: optional ExptResultCacheConf result_cache_conf
+    // 抽样配置与抽样结果; 抽样结果在实验启动扫描评测集时写入
+    120: optional ExptSamplingConf sampling_conf
+    121: optional ExptSamplingResult sampling_result
+    // 重复试验配置
+    122: optional ExptTrialConf trial_conf
+    // 模拟用户配置
+    123: optional ExptUserSimulatorConf user_simulator_conf
 }
 
 // 实验模板基础信息
@@ -249,6 +263,8 @@ struct ExptTemplate {
 
     // 通知配置
     10: optional ExptNotificationConf notification_conf
+    // 质量门禁配置, 基于模板创建实验时带入实验
+    11: optional ExptQualityGateConf quality_gate_conf
 
     255: optional common.BaseInfo base_info
 }
@@ -313,6 +329,12 @@ struct ExptStatistics {
     6: optional i32 fail_turn_cnt
     7: optional i32 terminated_turn_cnt
     8: optional i32 processing_turn_cnt
+    // 按模型价目表核算的花费 (币种同价目表), item 结果落库时累加
+    9: optional ExptCostStats cost_stats
+    // 调度器当前生效的 item 并发, 开启自适应并发时随后端负载调整
+    10: optional i32 effective_concur_num
+    // 最近一轮调度中因后端共享提交预算不足而延后提交的 item 数
+    11: optional i32 budget_deferred_item_cnt
 }
 
 struct EvaluatorFmtResult {
@@ -337,6 +359,8 @@ struct FieldMapping {
     1: optional string field_name
     2: optional string const_value
     3: optional string from_field_name
+    // 字段映射表达式 (如 template('Q: {{ $.input }}')), 非空时优先于 from_field_name 与 const_value
+    4: optional string expr
 }
 
 // ===============================
@@ -687,6 +711,8 @@ struct EvaluatorAggregateResult {
     // alias 多实例别名 (default/judge_b 等); 同 version 多实例时区分, 老数据为空串。
     // 注意: evaluator_results 为 map<i64> 时同 version 多 alias 会撞 key 只保留一个, 要拿全部 alias 走 list 出口。
     5: optional string alias
+    // 重复试验实验的 pass@k / 一致率指标, 未开启重复试验时为空
+    6: optional EvaluatorTrialAggregate trial
 }
 
 // 人工标注项粒度聚合结果
@@ -694,6 +720,30 @@ struct AnnotationAggregateResult {
     1: required i64 tag_key_id (api.js_conv = 'true', go.tag = 'json:"tag_key_id"')
     2: optional list<AggregatorResult> aggregator_results
     3: optional string name
+    // 多标注者一致性及评估器-人工一致性, 无多人标注且无评估器可对比时为空
+    4: optional AnnotationAgreement agreement
+}
+
+// 人工标注标签的一致性度量, 仅统计被 2 名及以上标注者标注过的 turn
+// 分类/布尔标签按名义尺度计算, 连续数值标签按区间尺度计算 (kappa 仅适用于分类/布尔)
+struct AnnotationAgreement {
+    1: optional i32 annotator_cnt
+    2: optional i32 unit_cnt // 被 2 名及以上标注者标注过的 turn 数
+    3: optional double cohen_kappa // 两两标注者 Cohen's kappa 的均值
+    4: optional double fleiss_kappa
+    5: optional double krippendorff_alpha
+    6: optional list<JudgeHumanAgreement> judge_agreements // 各评估器实例与人工标注的一致性, 仅数值与布尔标签计算
+}
+
+// 单个评估器实例得分与人工标注 (多人时取均值/众数) 的一致性
+struct JudgeHumanAgreement {
+    1: required i64 evaluator_version_id (api.js_conv = 'true', go.tag = 'json:"evaluator_version_id"')
+    2: optional string alias
+    3: optional i32 paired_cnt // 同时具备评估器得分与人工标注的 turn 数
+    4: optional double pearson_correlation
+    5: optional double mean_absolute_error
+    6: optional double krippendorff_alpha // 将评估器视为一名额外标注者的区间尺度 alpha
+    7: optional double cohen_kappa // 仅布尔标签: 评估器得分按 0.5 二值化后的 kappa
 }
 
 // 一种聚合器类型的聚合结果
@@ -939,3 +989,349 @@ struct ExptEvalSetDetail {
     5: optional eval_set.EvaluationSet eval_set       // Get 填充详情; List 不填
     6: optional string dataset_key                    // 评测集业务唯一键; 便于 GetExperiment 直接展示/定位
 }
+
+typedef string ExptPairwiseRankStatus(ts.enum="true")
+
+const ExptPairwiseRankStatus ExptPairwiseRankStatus_Unknown = "Unknown"
+const ExptPairwiseRankStatus ExptPairwiseRankStatus_Running = "Running"
+const ExptPairwiseRankStatus ExptPairwiseRankStatus_Success = "Success"
+const ExptPairwiseRankStatus ExptPairwiseRankStatus_Failed = "Failed"
+
+// 一对实验的胜负统计, 以 expt_id_a 视角
+struct ExptPairwiseStat {
+    1: optional i64 expt_id_a (api.js_conv='true', go.tag='json:"expt_id_a"')
+    2: optional i64 expt_id_b (api.js_conv='true', go.tag='json:"expt_id_b"')
+    3: optional i64 a_wins (api.js_conv='true', go.tag='json:"a_wins"')
+    4: optional i64 b_wins (api.js_conv='true', go.tag='json:"b_wins"')
+    5: optional i64 ties (api.js_conv='true', go.tag='json:"ties"')
+    6: optional i64 failed (api.js_conv='true', go.tag='json:"failed"') // 评估器执行失败或缺少输出的 turn 数, 不计入胜负
+}
+
+struct ExptPairwiseRanking {
+    1: optional i64 expt_id (api.js_conv='true', go.tag='json:"expt_id"')
+    2: optional i32 rank
+    3: optional i64 wins (api.js_conv='true', go.tag='json:"wins"')
+    4: optional i64 losses (api.js_conv='true', go.tag='json:"losses"')
+    5: optional i64 ties (api.js_conv='true', go.tag='json:"ties"')
+    6: optional double win_rate
+    7: optional double elo
+    8: optional double bt_score // Bradley-Terry 强度, 几何平均归一为 1
+}
+
+// 成对排名任务
+struct ExptPairwiseRankRecord {
+    1: required i64 record_id (api.js_conv='true', go.tag='json:"record_id"')
+    2: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"')
+    3: required i64 evaluator_version_id (api.js_conv='true', go.tag='json:"evaluator_version_id"')
+    4: optional list<i64> expt_ids (api.js_conv='true', go.tag='json:"expt_ids"')
+    5: required ExptPairwiseRankStatus status
+    6: optional list<ExptPairwiseStat> pair_results
+    7: optional list<ExptPairwiseRanking> rankings // 按 Bradley-Terry 强度降序
+    8: optional string error_msg
+    9: optional common.BaseInfo base_info
+}
+
+// 单个评估器实例在基线与候选实验间的配对显著性统计, 以候选实验视角
+struct ExptEvaluatorSignificance {
+    1: optional i64 evaluator_version_id (api.js_conv='true', go.tag='json:"evaluator_version_id"')
+    2: optional string alias
+    3: optional i64 paired_count (api.js_conv='true', go.tag='json:"paired_count"') // 参与配对的 turn 数
+    4: optional double base_mean
+    5: optional double candidate_mean
+    6: optional double mean_delta // candidate_mean - base_mean
+    7: optional double ci_lower // 配对 bootstrap 置信区间下界
+    8: optional double ci_upper // 配对 bootstrap 置信区间上界
+    9: optional double t_test_p_value // 配对 t 检验双侧 p 值
+    10: optional double wilcoxon_p_value // Wilcoxon 符号秩检验双侧 p 值
+    11: optional i64 win_count (api.js_conv='true', go.tag='json:"win_count"')
+    12: optional i64 tie_count (api.js_conv='true', go.tag='json:"tie_count"')
+    13: optional i64 loss_count (api.js_conv='true', go.tag='json:"loss_count"')
+    14: optional bool significant // t 检验 p 值小于 1 - confidence 且置信区间不跨 0
+}
+
+// 基线实验与一个候选实验的对比
+struct ExptPairSignificance {
+    1: optional i64 candidate_expt_id (api.js_conv='true', go.tag='json:"candidate_expt_id"')
+    2: optional list<ExptEvaluatorSignificance> evaluator_significances // 仅包含两侧实验都绑定的评估器实例
+}
+
+// ===============================
+// 质量门禁
+// ===============================
+
+// 门禁规则度量指标
+typedef string QualityGateMetric (ts.enum="true")
+const QualityGateMetric QualityGateMetric_EvaluatorAvgScore = "evaluator_avg_score" // 指定评估器实例的平均得分
+const QualityGateMetric QualityGateMetric_WeightedAvgScore = "weighted_avg_score"   // 加权得分的平均值
+const QualityGateMetric QualityGateMetric_FailRate = "fail_rate"                    // 失败 item 比例, 取值 [0, 1]
+const QualityGateMetric QualityGateMetric_RegressionRate = "regression_rate"        // 相对基线实验退化的 turn 比例, 取值 [0, 1]
+
+// 门禁评估结论
+typedef string QualityGateStatus (ts.enum="true")
+const QualityGateStatus QualityGateStatus_Passed = "passed"
+const QualityGateStatus QualityGateStatus_Failed = "failed"
+const QualityGateStatus QualityGateStatus_Error = "error" // 存在无法计算的规则, 对流水线而言等同未通过
+
+struct ExptQualityGateRule {
+    1: optional string name // 规则展示名, 为空时按 metric 生成
+    2: optional QualityGateMetric metric
+    3: optional string operator // >= / > / <= / < / ==
+    4: optional double threshold
+    5: optional i64 evaluator_version_id (api.js_conv='true', go.tag='json:"evaluator_version_id"') // 仅 evaluator_avg_score 使用
+    6: optional string evaluator_alias // 仅 evaluator_avg_score 使用
+    7: optional i64 baseline_expt_id (api.js_conv='true', go.tag='json:"baseline_expt_id"') // 仅 regression_rate 使用
+    8: optional double score_tolerance // 仅 regression_rate 使用, 单个 turn 得分下降超过该值才视为退化
+}
+
+// 质量门禁配置, 实验结束时逐条评估, 全部规则通过才算通过
+struct ExptQualityGateConf {
+    1: optional list<ExptQualityGateRule> rules
+}
+
+struct ExptQualityGateRuleResult {
+    1: optional ExptQualityGateRule rule
+    2: optional double actual // 实际度量值, 无法计算时为空
+    3: optional QualityGateStatus status
+    4: optional string message
+}
+
+// 质量门禁评估结果, 实验结束后异步写入
+struct ExptQualityGateResult {
+    1: optional QualityGateStatus status
+    2: optional bool passed
+    3: optional list<ExptQualityGateRuleResult> rule_results
+    4: optional i64 evaluated_at (api.js_conv='true', go.tag='json:"evaluated_at"') // 毫秒时间戳
+}
+
+typedef string ExptRegressionChangeType(ts.enum="true")
+const ExptRegressionChangeType ExptRegressionChangeType_Improved = "improved"
+const ExptRegressionChangeType ExptRegressionChangeType_Regressed = "regressed"
+const ExptRegressionChangeType ExptRegressionChangeType_Mixed = "mixed" // 部分得分上升、部分得分下降
+
+// 候选实验相对基线的变化统计
+struct ExptRegressionSummary {
+    1: optional i64 candidate_expt_id (api.js_conv='true', go.tag='json:"candidate_expt_id"')
+    2: optional i64 paired_turn_cnt (api.js_conv='true', go.tag='json:"paired_turn_cnt"') // 两侧都存在的 turn 数 (经基线筛选后)
+    3: optional i64 improved_cnt (api.js_conv='true', go.tag='json:"improved_cnt"')
+    4: optional i64 regressed_cnt (api.js_conv='true', go.tag='json:"regressed_cnt"')
+    5: optional i64 mixed_cnt (api.js_conv='true', go.tag='json:"mixed_cnt"')
+    6: optional i64 unchanged_cnt (api.js_conv='true', go.tag='json:"unchanged_cnt"')
+}
+
+// 某实验在该 turn 上的结果
+struct ExptRegressionTurnResult {
+    1: optional i64 expt_id (api.js_conv='true', go.tag='json:"expt_id"')
+    2: optional map<string, common.Content> target_outputs
+    3: optional double weighted_score
+    4: optional map<string, double> evaluator_scores // key 为评估器实例 key
+}
+
+struct ExptRegressionEvaluatorDelta {
+    1: optional i64 evaluator_version_id (api.js_conv='true', go.tag='json:"evaluator_version_id"')
+    2: optional string alias
+    3: optional double base_score
+    4: optional double candidate_score
+    5: optional double delta // candidate_score - base_score
+    6: optional bool changed // |delta| 超过阈值
+}
+
+struct ExptRegressionCandidateResult {
+    1: optional ExptRegressionTurnResult result
+    2: optional ExptRegressionChangeType change // 为空表示该候选实验在此 turn 上无超过阈值的变化
+    3: optional double weighted_score_delta
+    4: optional list<ExptRegressionEvaluatorDelta> evaluator_deltas // 仅包含两侧都有得分的评估器实例
+}
+
+// 发生变化的 turn, 基线与各候选实验的结果并排展示
+struct ExptRegressionItem {
+    1: optional i64 item_id (api.js_conv='true', go.tag='json:"item_id"')
+    2: optional i64 turn_id (api.js_conv='true', go.tag='json:"turn_id"')
+    3: optional ExptRegressionTurnResult base
+    4: optional list<ExptRegressionCandidateResult> candidates
+}
+
+// 跨实验回归报告
+struct ExptRegressionReport {
+    1: optional i64 base_expt_id (api.js_conv='true', go.tag='json:"base_expt_id"')
+    2: optional list<ExptRegressionSummary> summaries
+    3: optional list<ExptRegressionItem> items // 按 (item_id, turn_id) 升序
+    4: optional bool truncated // 发生变化的 turn 数超过 max_item_cnt, items 已被截断 (summaries 仍为全量统计)
+}
+
+// ===============================
+// 成本核算
+// ===============================
+
+// 单个模型的单价, 价格单位为每百万 token
+struct ModelPrice {
+    1: optional i64 model_id (api.js_conv='true', go.tag='json:"model_id"')
+    2: optional double input_price_per_million_tokens
+    3: optional double output_price_per_million_tokens
+}
+
+// 模型价目表: models 按 model_id 精确匹配, 未命中时回落 default, default 为空则不计费
+struct ModelPriceConf {
+    1: optional string currency // 价格币种, 仅用于展示
+    2: optional ModelPrice default_price
+    3: optional list<ModelPrice> models
+}
+
+struct ExptCostConf {
+    1: optional double budget_cap // 花费上限 (与价目表同币种), 已核算花费达到上限后停止提交新 item 并终止实验; 为空不限制
+    2: optional i64 target_model_id (api.js_conv='true', go.tag='json:"target_model_id"') // 评测对象自身不携带模型信息时用于核算评测对象花费
+    3: optional ModelPriceConf price_conf // 实验级价目表, 为空时使用全局价目表
+}
+
+struct ExptCostStats {
+    1: optional double target_cost
+    2: optional double evaluator_cost
+    3: optional double total_cost
+}
+
+// ===============================
+// 结果缓存
+// ===============================
+
+// 实验结果缓存配置 (opt-in): 同空间内评测对象版本 + 输入一致、评估器版本 + 输入一致的调用直接复用历史结果
+struct ExptResultCacheConf {
+    1: optional bool enable_target_cache
+    2: optional bool enable_evaluator_cache
+    3: optional i64 ttl_seconds (api.js_conv='true', go.tag='json:"ttl_seconds"') // 仅复用该时长内写入的缓存结果, 为空时以空间缓存存活时间为准
+}
+
+// ===============================
+// 抽样评测
+// ===============================
+
+typedef string ExptSamplingMode (ts.enum="true")
+const ExptSamplingMode ExptSamplingMode_RandomN = "random_n"       // 简单随机抽取 sample_num 条
+const ExptSamplingMode ExptSamplingMode_Percentage = "percentage"  // 简单随机抽取 percentage% 条
+const ExptSamplingMode ExptSamplingMode_Stratified = "stratified"  // 按 stratify_field 分层, 样本量按层大小等比例分配
+
+// 实验抽样配置, 仅单评测集实验生效; 同一 seed 与评测集版本下抽样结果稳定
+struct ExptSamplingConf {
+    1: optional ExptSamplingMode mode
+    2: optional i32 sample_num // random_n 必填; stratified 下与 percentage 二选一
+    3: optional double percentage // 取值 (0, 100]
+    4: optional string stratify_field // 分层字段名 (评测集列名), stratified 必填
+    5: optional i64 seed (api.js_conv='true', go.tag='json:"seed"') // 为空时自动生成并回写
+}
+
+struct ExptSamplingStratum {
+    1: optional string value
+    2: optional i64 population_cnt (api.js_conv='true', go.tag='json:"population_cnt"')
+    3: optional i64 sample_cnt (api.js_conv='true', go.tag='json:"sample_cnt"')
+    4: optional double weight // 每条样本代表的总体 item 数
+}
+
+struct ExptSamplingResult {
+    1: optional ExptSamplingMode mode
+    2: optional i64 seed (api.js_conv='true', go.tag='json:"seed"')
+    3: optional string stratify_field
+    4: optional i64 population_cnt (api.js_conv='true', go.tag='json:"population_cnt"')
+    5: optional i64 sample_cnt (api.js_conv='true', go.tag='json:"sample_cnt"')
+    6: optional list<ExptSamplingStratum> strata
+}
+
+// 单个评估器实例按抽样权重估计的总体均值及置信区间
+struct ExptSampledEvaluatorAggr {
+    1: optional i64 evaluator_version_id (api.js_conv='true', go.tag='json:"evaluator_version_id"')
+    2: optional string alias
+    3: optional i64 scored_cnt (api.js_conv='true', go.tag='json:"scored_cnt"') // 参与估计的有成功得分的样本 item 数
+    4: optional double unweighted_mean // 样本算术平均
+    5: optional double mean // 按分层权重估计的总体均值
+    6: optional double std_err // 含有限总体校正
+    7: optional double ci_lower
+    8: optional double ci_upper
+}
+
+struct ExptSampledAggrResult {
+    1: optional i64 expt_id (api.js_conv='true', go.tag='json:"expt_id"')
+    2: optional ExptSamplingResult sampling
+    3: optional double confidence
+    4: optional list<ExptSampledEvaluatorAggr> evaluator_results
+}
+
+// ===============================
+// 重复试验
+// ===============================
+
+// 实验重复试验配置, 开启后每个 turn 执行 trial_num 次评测对象调用与评估
+struct ExptTrialConf {
+    1: optional i32 trial_num // 每个 turn 的试验次数 (含第 0 次), 大于 1 时生效, 上限 10
+    2: optional double pass_threshold // 单次试验判定通过的得分阈值, 默认 1.0
+}
+
+// 单个评估器实例在重复试验实验中的聚合指标, unit 为 (item, turn)
+struct EvaluatorTrialAggregate {
+    1: optional i32 trial_num
+    2: optional double pass_threshold
+    3: optional i64 unit_cnt (api.js_conv='true', go.tag='json:"unit_cnt"') // 所有试验均有得分的 unit 数
+    4: optional double mean_score
+    5: optional double mean_std // unit 内试验得分标准差的均值
+    6: optional double consistency_rate // 所有试验通过判定一致的 unit 占比
+    7: optional double pass_at_1
+    8: optional double pass_at_k // 至少一次通过的概率估计
+    9: optional double pass_pow_k // k 次全部通过的概率估计
+}
+
+// ===============================
+// 模拟用户
+// ===============================
+
+// 模拟用户配置: 每个 item 以首个 turn 为种子, 由模拟用户逐轮生成用户消息并调用评测对象,
+// 直至目标达成、模拟用户主动结束或达到最大轮数
+struct ExptUserSimulatorConf {
+    1: optional common.ModelConfig model_config // 模拟用户使用的模型, 必填
+    2: optional string system_prompt // 支持 {{goal}} / {{persona}} / {{constraints}} 占位符, 为空使用内置模板
+
+    // 实验级默认的目标/人设/行为约束
+    3: optional string goal
+    4: optional string persona
+    5: optional string behavioral_constraints
+    // 从评测集字段读取题目级目标/人设, 优先于实验级默认值
+    6: optional string goal_field_name
+    7: optional string persona_field_name
+
+    8: optional string query_field_name // 评测集中承载用户消息的字段, 必填
+    9: optional string response_field_name // 评测对象输出中承载回复的字段, 为空取 actual_output
+
+    10: optional i32 max_turns // 最大轮数, 为空取 5, 上限 30
+    11: optional i64 goal_evaluator_version_id (api.js_conv='true', go.tag='json:"goal_evaluator_version_id"') // 目标判定评估器版本, 为空不判定
+    12: optional double goal_score_threshold // 目标判定得分阈值, 默认 1.0
+}
+
+// ===============================
+// 分组聚合
+// ===============================
+
+// 分组维度来源
+enum ExptGroupDimensionType {
+    EvalSetField = 1 // 评测集字段, key 为评测集 schema 的 field_key
+    AnnotationTag = 2 // 人工标注标签, key 为 tag_key_id
+}
+
+struct ExptGroupDimension {
+    1: optional ExptGroupDimensionType type
+    2: optional string key
+}
+
+struct ExptGroupEvaluatorAggr {
+    1: optional i64 evaluator_version_id (api.js_conv='true', go.tag='json:"evaluator_version_id"')
+    2: optional string alias
+    3: optional i64 count (api.js_conv='true', go.tag='json:"count"') // 分组内产出该评估器得分的 turn 数
+    4: optional list<AggregatorResult> aggregator_results // Average / Max / Min
+}
+
+struct ExptAggrGroup {
+    1: optional string value // 分组取值; 字段缺失时为空串, 分类/布尔标签为 tag_value_id
+    2: optional i64 turn_count (api.js_conv='true', go.tag='json:"turn_count"')
+    3: optional list<ExptGroupEvaluatorAggr> evaluator_results
+}
+
+// 单个实验的分组聚合结果
+struct ExptGroupedAggr {
+    1: optional i64 expt_id (api.js_conv='true', go.tag='json:"expt_id"')
+    2: optional list<ExptAggrGroup> groups
+    3: optional bool truncated // 分组数超过 max_groups, 仅返回 turn 数最多的前 max_groups 组
+}
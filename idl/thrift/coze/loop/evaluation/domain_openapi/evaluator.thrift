@@ -8,6 +8,9 @@ const EvaluatorType EvaluatorType_Prompt = "prompt"
 const EvaluatorType EvaluatorType_Code = "code"
 const EvaluatorType EvaluatorType_CustomRPC = "custom_rpc"
 const EvaluatorType EvaluatorType_Agent = "agent"
+const EvaluatorType EvaluatorType_Pairwise = "pairwise"
+const EvaluatorType EvaluatorType_Rule = "rule"
+const EvaluatorType EvaluatorType_Composite = "composite"
 
 // 语言类型
 typedef string LanguageType(ts.enum="true")
@@ -85,6 +88,45 @@ struct AgentEvaluator {
     4: optional AgentEvaluatorPromptConfig prompt_config
 }
 
+// 成对评估器, 与 domain/evaluator 对齐
+struct PairwiseEvaluator {
+    1: optional list<common.Message> messages
+    2: optional common.ModelConfig model_config
+    3: optional bool disable_position_swap
+}
+
+// 规则评估器, 与 domain/evaluator 对齐
+struct RuleEvaluator {
+    1: optional string rule_type
+    2: optional string actual_field_key
+    3: optional string reference_field_key
+    4: optional bool ignore_case
+    5: optional bool trim_space
+    6: optional string pattern
+    7: optional string json_schema
+    8: optional list<string> keywords
+    9: optional bool contains_all
+    10: optional double tolerance
+    11: optional bool relative
+    12: optional i32 bleu_max_n
+    13: optional double pass_threshold
+}
+
+// 组合评估器组件
+struct CompositeComponent {
+    1: optional i64 evaluator_version_id (api.js_conv = 'true', go.tag = 'json:"evaluator_version_id"')
+    2: optional double weight
+}
+
+// 组合评估器, 与 domain/evaluator 对齐
+struct CompositeEvaluator {
+    1: optional list<CompositeComponent> components
+    2: optional string strategy
+    3: optional i32 min_success_cnt
+    4: optional string code_content
+    5: optional LanguageType language_type
+}
+
 // 评估器内容
 struct EvaluatorContent {
     1: optional bool is_receive_chat_history
@@ -96,6 +138,9 @@ struct EvaluatorContent {
     102: optional CodeEvaluator code_evaluator
     103: optional CustomRPCEvaluator custom_rpc_evaluator
     104: optional AgentEvaluator agent_evaluator
+    105: optional PairwiseEvaluator pairwise_evaluator
+    106: optional RuleEvaluator rule_evaluator
+    107: optional CompositeEvaluator composite_evaluator
 }
 
 // 评估器版本
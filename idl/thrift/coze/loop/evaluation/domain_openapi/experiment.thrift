@@ -301,6 +301,9 @@ struct Experiment {
     // 读模型对照 —— 它们是同一个概念的两种表示 (本文件用字符串枚举, domain 用整数枚举)。
     // 用本文件已有的 RunModeConfig (字符串枚举), 不要 include domain/expt.thrift —— 会符号冲突。
     115: optional RunModeConfig run_mode_config
+    // 质量门禁配置与评估结果 (与 domain Experiment 116~117 同义); 结果在实验结束后异步写入, 评估完成前为空
+    116: optional ExptQualityGateConf quality_gate_conf
+    117: optional ExptQualityGateResult quality_gate_result
 
 
     100: optional common.BaseInfo base_info
@@ -439,6 +442,8 @@ struct ExptTemplate {
 
     // 通知配置
     10: optional ExptNotificationConf notification_conf
+    // 质量门禁配置
+    11: optional ExptQualityGateConf quality_gate_conf
 
     100: optional common.BaseInfo base_info
 }
@@ -636,3 +641,74 @@ struct FeishuNotificationConf {
     1: optional bool enable
     2: optional string user_id          // 通知目标用户 ID（为空时默认用实验创建者）
 }
+
+// 单个评估器实例在基线与候选实验间的配对显著性统计, 以候选实验视角
+struct ExperimentEvaluatorSignificance {
+    1: optional i64 evaluator_version_id (api.js_conv = 'true', go.tag = 'json:"evaluator_version_id"')
+    2: optional string alias
+    3: optional i64 paired_count (api.js_conv = 'true', go.tag = 'json:"paired_count"')
+    4: optional double base_mean
+    5: optional double candidate_mean
+    6: optional double mean_delta
+    7: optional double ci_lower
+    8: optional double ci_upper
+    9: optional double t_test_p_value
+    10: optional double wilcoxon_p_value
+    11: optional i64 win_count (api.js_conv = 'true', go.tag = 'json:"win_count"')
+    12: optional i64 tie_count (api.js_conv = 'true', go.tag = 'json:"tie_count"')
+    13: optional i64 loss_count (api.js_conv = 'true', go.tag = 'json:"loss_count"')
+    14: optional bool significant
+}
+
+struct ExperimentPairSignificance {
+    1: optional i64 candidate_experiment_id (api.js_conv = 'true', go.tag = 'json:"candidate_experiment_id"')
+    2: optional list<ExperimentEvaluatorSignificance> evaluator_significances
+}
+
+// ===============================
+// 质量门禁
+// ===============================
+
+// 门禁规则度量指标
+typedef string QualityGateMetric (ts.enum="true")
+const QualityGateMetric QualityGateMetric_EvaluatorAvgScore = "evaluator_avg_score" // 指定评估器实例的平均得分
+const QualityGateMetric QualityGateMetric_WeightedAvgScore = "weighted_avg_score"   // 加权得分的平均值
+const QualityGateMetric QualityGateMetric_FailRate = "fail_rate"                    // 失败 item 比例, 取值 [0, 1]
+const QualityGateMetric QualityGateMetric_RegressionRate = "regression_rate"        // 相对基线实验退化的 turn 比例, 取值 [0, 1]
+
+// 门禁评估结论
+typedef string QualityGateStatus (ts.enum="true")
+const QualityGateStatus QualityGateStatus_Passed = "passed"
+const QualityGateStatus QualityGateStatus_Failed = "failed"
+const QualityGateStatus QualityGateStatus_Error = "error" // 存在无法计算的规则, 对流水线而言等同未通过
+
+struct ExptQualityGateRule {
+    1: optional string name // 规则展示名, 为空时按 metric 生成
+    2: optional QualityGateMetric metric
+    3: optional string operator // >= / > / <= / < / ==
+    4: optional double threshold
+    5: optional i64 evaluator_version_id (api.js_conv='true', go.tag='json:"evaluator_version_id"') // 仅 evaluator_avg_score 使用
+    6: optional string evaluator_alias // 仅 evaluator_avg_score 使用
+    7: optional i64 baseline_expt_id (api.js_conv='true', go.tag='json:"baseline_expt_id"') // 仅 regression_rate 使用
+    8: optional double score_tolerance // 仅 regression_rate 使用, 单个 turn 得分下降超过该值才视为退化
+}
+
+// 质量门禁配置, 实验结束时逐条评估, 全部规则通过才算通过
+struct ExptQualityGateConf {
+    1: optional list<ExptQualityGateRule> rules
+}
+
+struct ExptQualityGateRuleResult {
+    1: optional ExptQualityGateRule rule
+    2: optional double actual // 实际度量值, 无法计算时为空
+    3: optional QualityGateStatus status
+    4: optional string message
+}
+
+// 质量门禁评估结果, 实验结束后异步写入, 与 domain/expt.thrift 同构
+struct ExptQualityGateResult {
+    1: optional QualityGateStatus status
+    2: optional bool passed
+    3: optional list<ExptQualityGateRuleResult> rule_results
+    4: optional i64 evaluated_at (api.js_conv='true', go.tag='json:"evaluated_at"') // 毫秒时间戳
+}
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS `evaluation_set_item_provenance` (
+                                             `id` bigint unsigned NOT NULL COMMENT 'idgen id',
+                                             `space_id` bigint unsigned NOT NULL COMMENT '空间id',
+                                             `evaluation_set_id` bigint unsigned NOT NULL COMMENT '评测集id',
+                                             `item_id` bigint unsigned NOT NULL COMMENT '评测集行id, 跨版本稳定',
+                                             `source_type` varchar(32) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '来源类型',
+                                             `trace_id` varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '来源 trace id',
+                                             `span_id` varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '来源 span id',
+                                             `import_job_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT '导入任务id',
+                                             `source_expt_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT '来源实验id',
+                                             `source_version_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT '来源评测集版本id',
+                                             `review_status` varchar(32) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '生成行的审核状态, 非生成行为空',
+                                             `created_by` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '创建者 id',
+                                             `updated_by` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '最后编辑者 id',
+                                             `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                             `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                                             `deleted_at` timestamp NULL DEFAULT NULL COMMENT '删除时间',
+                                             PRIMARY KEY (`id`),
+                                             UNIQUE KEY `uniq_space_id_evaluation_set_id_item_id` (`space_id`,`evaluation_set_id`,`item_id`),
+                                             KEY `idx_space_id_evaluation_set_id_source_type` (`space_id`,`evaluation_set_id`,`source_type`),
+                                             KEY `idx_space_id_trace_id` (`space_id`,`trace_id`),
+                                             KEY `idx_space_id_source_expt_id` (`space_id`,`source_expt_id`),
+                                             KEY `idx_space_id_evaluation_set_id_review_status` (`space_id`,`evaluation_set_id`,`review_status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='评测集行来源表';
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS `evaluator_calibration_record` (
+                                             `id` bigint unsigned NOT NULL COMMENT 'idgen id',
+                                             `space_id` bigint unsigned NOT NULL COMMENT '空间id',
+                                             `evaluator_id` bigint unsigned NOT NULL COMMENT '评估器id',
+                                             `evaluator_version_id` bigint unsigned NOT NULL COMMENT '评估器版本id',
+                                             `evaluator_version` varchar(255) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '评估器版本号',
+                                             `evaluation_set_id` bigint unsigned NOT NULL COMMENT '校准使用的评测集id',
+                                             `evaluation_set_version_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT '评测集版本id, 0 表示草稿',
+                                             `status` int NOT NULL COMMENT '校准状态：1-运行中, 2-成功 3-失败',
+                                             `conf` blob COMMENT '校准配置, json',
+                                             `result` mediumblob COMMENT '校准结果, json',
+                                             `err_msg` blob COMMENT '错误信息',
+                                             `created_by` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '创建者 id',
+                                             `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                             `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                                             `deleted_at` timestamp NULL DEFAULT NULL COMMENT '删除时间',
+                                             PRIMARY KEY (`id`),
+                                             KEY `idx_space_id_evaluator_id` (`space_id`,`evaluator_id`),
+                                             KEY `idx_space_id_evaluator_version_id` (`space_id`,`evaluator_version_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='评估器校准记录表';
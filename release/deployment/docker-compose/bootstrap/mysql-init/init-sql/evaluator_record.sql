@@ -24,7 +24,8 @@ CREATE TABLE IF NOT EXISTS `evaluator_record`
     `created_by`           varchar(128)     NOT NULL DEFAULT '0' COMMENT '创建人',
     `updated_by`           varchar(128)     NOT NULL DEFAULT '0' COMMENT '更新人',
     `ext`                  mediumblob COMMENT '补充信息, json',
-    PRIMARY KEY (`id`)
+    PRIMARY KEY (`id`),
+    KEY `idx_space_id_evaluator_version_id` (`space_id`, `evaluator_version_id`)
 ) ENGINE = InnoDB
   DEFAULT CHARSET = utf8mb4
   COLLATE = utf8mb4_general_ci COMMENT ='NDB_SHARE_TABLE;评估器执行结果';
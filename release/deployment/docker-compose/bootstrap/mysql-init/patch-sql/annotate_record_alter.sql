@@ -0,0 +1,12 @@
+ALTER TABLE `annotate_record`
+    ADD COLUMN `expt_turn_result_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT '实验 turn result id; 同一 turn/tag 多名标注者时用于归属' AFTER `experiment_id`;
+
+ALTER TABLE `annotate_record`
+    ADD INDEX `idx_space_id_experiment_id_tag_key_id_turn` (`space_id`, `experiment_id`, `tag_key_id`, `expt_turn_result_id`);
+
+-- 存量首条标注记录的 expt_turn_result_id 为 0, 唯一键通过生成列忽略这部分数据 (NULL 不参与唯一约束)
+ALTER TABLE `annotate_record`
+    ADD COLUMN `annotator_turn_result_id` bigint unsigned GENERATED ALWAYS AS (NULLIF(`expt_turn_result_id`, 0)) VIRTUAL COMMENT '唯一键用 turn result id, 未归属 turn 时为 NULL' AFTER `expt_turn_result_id`;
+
+ALTER TABLE `annotate_record`
+    ADD UNIQUE INDEX `uniq_turn_result_id_tag_key_id_created_by` (`annotator_turn_result_id`, `tag_key_id`, `created_by`);
@@ -9,3 +9,6 @@ ALTER TABLE `evaluator_record`
 
 ALTER TABLE `evaluator_record`
     ADD COLUMN `item_version_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT 'item 自身版本号; 0=旧数据/无版本概念; 从 expt_item_ref 同步' AFTER `item_id`;
+
+ALTER TABLE `evaluator_record`
+    ADD INDEX `idx_space_id_evaluator_version_id` (`space_id`, `evaluator_version_id`);
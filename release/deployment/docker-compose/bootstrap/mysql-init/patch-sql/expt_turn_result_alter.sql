@@ -3,3 +3,9 @@ ALTER TABLE `expt_turn_result`
 
 ALTER TABLE `expt_turn_result`
     ADD COLUMN `item_version_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT 'item 自身版本号; 0=旧数据/无版本概念; turn 级筛选用; 真值源 expt_item_ref' AFTER `item_id`;
+
+ALTER TABLE `expt_turn_result`
+    ADD COLUMN `cache_hit` int unsigned NOT NULL DEFAULT '0' COMMENT '结果缓存命中标记, bit0=评测对象 bit1=评估器' AFTER `weighted_score`;
+
+ALTER TABLE `expt_turn_result`
+    ADD COLUMN `trial_results` blob COMMENT '重复试验结果, json list 格式' AFTER `cache_hit`;
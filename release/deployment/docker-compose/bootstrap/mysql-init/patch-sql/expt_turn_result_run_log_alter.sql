@@ -2,3 +2,12 @@ ALTER TABLE `expt_turn_result_run_log` ADD COLUMN `ext` json DEFAULT NULL COMMEN
 
 ALTER TABLE `expt_turn_result_run_log`
     ADD COLUMN `item_version_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT 'item 自身版本号; 0=旧数据/无版本概念; 真值源 expt_item_ref' AFTER `item_id`;
+
+ALTER TABLE `expt_turn_result_run_log`
+    ADD COLUMN `cache_hit` int unsigned NOT NULL DEFAULT '0' COMMENT '结果缓存命中标记, bit0=评测对象 bit1=评估器' AFTER `ext`;
+
+ALTER TABLE `expt_turn_result_run_log`
+    ADD COLUMN `trial_results` blob COMMENT '重复试验结果, json list 格式' AFTER `cache_hit`;
+
+ALTER TABLE `expt_turn_result_run_log`
+    ADD COLUMN `checkpoint` blob COMMENT 'turn 执行检查点, json 格式' AFTER `trial_results`;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `evaluation_set_dedup_job` (
+                                             `id` bigint unsigned NOT NULL COMMENT 'idgen id',
+                                             `space_id` bigint unsigned NOT NULL COMMENT '空间id',
+                                             `evaluation_set_id` bigint unsigned NOT NULL COMMENT '评测集id',
+                                             `version_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT '评测集版本id, 0 表示草稿',
+                                             `status` int NOT NULL COMMENT '任务状态：1-运行中, 2-成功 3-失败',
+                                             `conf` blob COMMENT '去重配置, json',
+                                             `report` mediumblob COMMENT '去重报告, json',
+                                             `err_msg` blob COMMENT '错误信息',
+                                             `created_by` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '创建者 id',
+                                             `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                             `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                                             `deleted_at` timestamp NULL DEFAULT NULL COMMENT '删除时间',
+                                             PRIMARY KEY (`id`),
+                                             KEY `idx_space_id_evaluation_set_id` (`space_id`,`evaluation_set_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='评测集去重检测任务表';
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS `evaluator_promotion_policy` (
+                                             `id` bigint unsigned NOT NULL COMMENT 'idgen id',
+                                             `space_id` bigint unsigned NOT NULL COMMENT '空间id',
+                                             `evaluator_id` bigint unsigned NOT NULL COMMENT '评估器id',
+                                             `conf` blob COMMENT '晋级策略配置, json',
+                                             `created_by` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '创建者 id',
+                                             `updated_by` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '更新者 id',
+                                             `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                             `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                                             `deleted_at` timestamp NULL DEFAULT NULL COMMENT '删除时间',
+                                             PRIMARY KEY (`id`),
+                                             UNIQUE KEY `uk_space_id_evaluator_id` (`space_id`,`evaluator_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='评估器版本晋级策略表';
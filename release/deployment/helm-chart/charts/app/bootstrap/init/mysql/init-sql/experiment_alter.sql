@@ -37,3 +37,9 @@ ALTER TABLE `experiment`
 
 ALTER TABLE `experiment`
     ADD COLUMN `eval_set_access_level` varchar(32) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '发起冻结的评测集访问级别(execute/readable/空=同空间)' AFTER `target_space_id`;
+
+ALTER TABLE `experiment`
+    ADD COLUMN `quality_gate_result` blob COMMENT '质量门禁评估结果，json格式存储' AFTER `eval_set_access_level`;
+
+ALTER TABLE `experiment`
+    ADD COLUMN `sampling_result` blob COMMENT '抽样结果，json格式存储' AFTER `quality_gate_result`;
@@ -1,2 +1,5 @@
 ALTER TABLE `expt_item_result_run_log`
     ADD COLUMN `item_version_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT 'item 自身版本号; 0=旧数据/无版本概念; 真值源 expt_item_ref' AFTER `item_id`;
+
+ALTER TABLE `expt_item_result_run_log`
+    ADD COLUMN `attempt` int unsigned NOT NULL DEFAULT '0' COMMENT '执行轮次; 僵尸续跑认领时 +1, 旧轮次的写入被拒绝' AFTER `result_state`;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS `expt_pairwise_rank_record` (
+                                             `id` bigint unsigned NOT NULL COMMENT 'idgen id',
+                                             `space_id` bigint unsigned NOT NULL COMMENT '空间id',
+                                             `evaluator_version_id` bigint unsigned NOT NULL COMMENT '成对评估器版本id',
+                                             `status` int NOT NULL COMMENT '排名状态：1-运行中, 2-成功 3-失败',
+                                             `param` blob COMMENT '排名参数, json',
+                                             `result` mediumblob COMMENT '排名结果, json',
+                                             `err_msg` blob COMMENT '错误信息',
+                                             `created_by` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '创建者 id',
+                                             `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                             `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                                             `deleted_at` timestamp NULL DEFAULT NULL COMMENT '删除时间',
+                                             PRIMARY KEY (`id`),
+                                             KEY `idx_space_id_evaluator_version_id` (`space_id`,`evaluator_version_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='实验成对排名记录表';
@@ -0,0 +1,11 @@
+ALTER TABLE `expt_stats`
+    ADD COLUMN `target_cost` decimal(20, 6) NOT NULL DEFAULT '0.000000' COMMENT '评测对象花费' AFTER `terminated_cnt`;
+
+ALTER TABLE `expt_stats`
+    ADD COLUMN `evaluator_cost` decimal(20, 6) NOT NULL DEFAULT '0.000000' COMMENT '评估器花费' AFTER `target_cost`;
+
+ALTER TABLE `expt_stats`
+    ADD COLUMN `effective_concur_num` int(11) NOT NULL DEFAULT '0' COMMENT '当前生效的item并发' AFTER `evaluator_cost`;
+
+ALTER TABLE `expt_stats`
+    ADD COLUMN `budget_deferred_cnt` int(11) NOT NULL DEFAULT '0' COMMENT '最近一轮调度因后端共享预算延后提交的item数' AFTER `effective_concur_num`;